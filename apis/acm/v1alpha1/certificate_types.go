@@ -55,14 +55,17 @@ type CertificateExternalStatus struct {
 	CertificateARN string `json:"certificateARN,omitempty"`
 
 	// Flag to check eligibility for renewal status
+	// +crossplane:aws:model=acm.CertificateDetail.RenewalEligibility
 	// +kubebuilder:validation:Enum=ELIGIBLE;INELIGIBLE
 	RenewalEligibility acm.RenewalEligibility `json:"renewalEligibility,omitempty"`
 
 	// Status of the certificate
+	// +crossplane:aws:model=acm.CertificateDetail.Status
 	// +kubebuilder:validation:Enum=PENDING_VALIDATION;ISSUED;INACTIVE;EXPIRED;VALIDATION_TIMED_OUT;REVOKED;FAILED
 	Status acm.CertificateStatus `json:"status,omitempty"`
 
 	// Type of the certificate
+	// +crossplane:aws:model=acm.CertificateDetail.Type
 	// +kubebuilder:validation:Enum=IMPORTED;AMAZON_ISSUED;PRIVATE
 	Type acm.CertificateType `json:"type,omitempty"`
 }
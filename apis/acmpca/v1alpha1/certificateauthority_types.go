@@ -86,11 +86,13 @@ type RevocationConfiguration struct {
 type CertificateAuthorityConfiguration struct {
 
 	// Type of the public key algorithm
-	// +kubebuilder:validation:Enum=RSA_2048;EC_secp384r1;EC_prime256v1;RSA_4096
+	// +crossplane:aws:model=acmpca.CertificateAuthorityConfiguration.KeyAlgorithm
+	// +kubebuilder:validation:Enum=RSA_2048;RSA_4096;EC_prime256v1;EC_secp384r1
 	KeyAlgorithm acmpca.KeyAlgorithm `json:"keyAlgorithm"`
 
 	// Algorithm that private CA uses to sign certificate requests
-	// +kubebuilder:validation:Enum=SHA512WITHECDSA;SHA256WITHECDSA;SHA384WITHECDSA;SHA512WITHRSA;SHA256WITHRSA;SHA384WITHRSA
+	// +crossplane:aws:model=acmpca.CertificateAuthorityConfiguration.SigningAlgorithm
+	// +kubebuilder:validation:Enum=SHA256WITHECDSA;SHA384WITHECDSA;SHA512WITHECDSA;SHA256WITHRSA;SHA384WITHRSA;SHA512WITHRSA
 	SigningAlgorithm acmpca.SigningAlgorithm `json:"signingAlgorithm"`
 
 	// Subject is information of Certificate Authority
@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// EnvironmentVariable is an environment variable of an app or branch.
+type EnvironmentVariable struct {
+	// Name of the environment variable.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Value of the environment variable.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// ValueSecretRef references the key of a secret that contains the value
+	// of the environment variable. It takes precedence over Value.
+	// +optional
+	ValueSecretRef *runtimev1alpha1.SecretKeySelector `json:"valueSecretRef,omitempty"`
+}
+
+// CustomRule is a redirect or rewrite rule of an app.
+type CustomRule struct {
+	// Source is the source pattern of the rule.
+	Source string `json:"source"`
+
+	// Target is the target pattern of the rule.
+	Target string `json:"target"`
+
+	// Status is the status code of the rule, like 301 or 200 for a rewrite.
+	// +optional
+	Status *string `json:"status,omitempty"`
+
+	// Condition is the condition of a 302 rule, like a country code.
+	// +optional
+	Condition *string `json:"condition,omitempty"`
+}
+
+// AppParameters define the desired state of an AWS Amplify app.
+type AppParameters struct {
+	// Region is the region you'd like your App to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description of the app.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Repository is the URL of the Git repository of the app.
+	// +optional
+	Repository *string `json:"repository,omitempty"`
+
+	// AccessTokenSecretRef references the key of a secret that contains the
+	// personal access token that Amplify uses to connect to the repository.
+	// +optional
+	AccessTokenSecretRef *runtimev1alpha1.SecretKeySelector `json:"accessTokenSecretRef,omitempty"`
+
+	// Platform of the app.
+	// +kubebuilder:validation:Enum=WEB
+	// +optional
+	Platform *string `json:"platform,omitempty"`
+
+	// IAMServiceRoleARN is the ARN of the IAM role that Amplify assumes to
+	// build and deploy the app.
+	// +optional
+	IAMServiceRoleARN *string `json:"iamServiceRoleArn,omitempty"`
+
+	// IAMServiceRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	IAMServiceRoleARNRef *runtimev1alpha1.Reference `json:"iamServiceRoleArnRef,omitempty"`
+
+	// IAMServiceRoleARNSelector selects a reference to an IAMRole to
+	// retrieve its ARN.
+	// +optional
+	IAMServiceRoleARNSelector *runtimev1alpha1.Selector `json:"iamServiceRoleArnSelector,omitempty"`
+
+	// BuildSpec is the build specification of the app in YAML.
+	// +optional
+	BuildSpec *string `json:"buildSpec,omitempty"`
+
+	// EnvironmentVariables of the app, shared by all of its branches.
+	// +optional
+	EnvironmentVariables []EnvironmentVariable `json:"environmentVariables,omitempty"`
+
+	// EnableBranchAutoBuild enables automatic builds of the branches of the
+	// app.
+	// +optional
+	EnableBranchAutoBuild *bool `json:"enableBranchAutoBuild,omitempty"`
+
+	// CustomRules are the redirect and rewrite rules of the app.
+	// +optional
+	CustomRules []CustomRule `json:"customRules,omitempty"`
+
+	// Tags to add to the app.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// AppObservation keeps the state for the external resource
+type AppObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the app.
+	ARN string `json:"arn,omitempty"`
+
+	// DefaultDomain is the default domain of the app.
+	DefaultDomain string `json:"defaultDomain,omitempty"`
+}
+
+// An AppSpec defines the desired state of an App.
+type AppSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AppParameters `json:"forProvider"`
+}
+
+// An AppStatus represents the observed state of an App.
+type AppStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AppObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An App is a managed resource that represents an AWS Amplify app. Its
+// external name is the ID of the app.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".status.atProvider.defaultDomain"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type App struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppSpec   `json:"spec"`
+	Status AppStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AppList contains a list of Apps
+type AppList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []App `json:"items"`
+}
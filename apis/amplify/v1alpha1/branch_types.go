@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// BranchParameters define the desired state of an AWS Amplify branch.
+type BranchParameters struct {
+	// Region is the region you'd like your Branch to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// AppID is the ID of the app of the branch.
+	// +immutable
+	// +optional
+	AppID *string `json:"appId,omitempty"`
+
+	// AppIDRef references an App to retrieve its ID.
+	// +immutable
+	// +optional
+	AppIDRef *runtimev1alpha1.Reference `json:"appIdRef,omitempty"`
+
+	// AppIDSelector selects a reference to an App to retrieve its ID.
+	// +immutable
+	// +optional
+	AppIDSelector *runtimev1alpha1.Selector `json:"appIdSelector,omitempty"`
+
+	// Description of the branch.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Stage of the branch.
+	// +kubebuilder:validation:Enum=PRODUCTION;BETA;DEVELOPMENT;EXPERIMENTAL;PULL_REQUEST
+	// +optional
+	Stage *string `json:"stage,omitempty"`
+
+	// Framework of the branch, like React or Next.js - SSG.
+	// +optional
+	Framework *string `json:"framework,omitempty"`
+
+	// EnableAutoBuild enables automatic builds of the branch.
+	// +optional
+	EnableAutoBuild *bool `json:"enableAutoBuild,omitempty"`
+
+	// EnablePullRequestPreview enables preview deployments of the pull
+	// requests to the branch.
+	// +optional
+	EnablePullRequestPreview *bool `json:"enablePullRequestPreview,omitempty"`
+
+	// BuildSpec is the build specification of the branch in YAML. It
+	// overrides the one of the app.
+	// +optional
+	BuildSpec *string `json:"buildSpec,omitempty"`
+
+	// EnvironmentVariables of the branch. They override the ones of the app.
+	// +optional
+	EnvironmentVariables []EnvironmentVariable `json:"environmentVariables,omitempty"`
+
+	// Tags to add to the branch.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// BranchObservation keeps the state for the external resource
+type BranchObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the branch.
+	ARN string `json:"arn,omitempty"`
+
+	// DisplayName is the name of the branch in its default domain.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// ActiveJobID is the ID of the active job of the branch.
+	ActiveJobID string `json:"activeJobId,omitempty"`
+
+	// CustomDomains of the branch.
+	CustomDomains []string `json:"customDomains,omitempty"`
+}
+
+// A BranchSpec defines the desired state of a Branch.
+type BranchSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  BranchParameters `json:"forProvider"`
+}
+
+// A BranchStatus represents the observed state of a Branch.
+type BranchStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     BranchObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Branch is a managed resource that represents an AWS Amplify branch. Its
+// external name is the name of the Git branch.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="APP",type="string",JSONPath=".spec.forProvider.appId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Branch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BranchSpec   `json:"spec"`
+	Status BranchStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BranchList contains a list of Branches
+type BranchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Branch `json:"items"`
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SubDomainSetting maps a prefix of the domain to a branch.
+type SubDomainSetting struct {
+	// Prefix of the sub domain. An empty prefix maps the root of the domain.
+	Prefix string `json:"prefix"`
+
+	// BranchName is the name of the branch that the sub domain serves.
+	// +optional
+	BranchName *string `json:"branchName,omitempty"`
+
+	// BranchNameRef references a Branch to retrieve its name.
+	// +optional
+	BranchNameRef *runtimev1alpha1.Reference `json:"branchNameRef,omitempty"`
+
+	// BranchNameSelector selects a reference to a Branch to retrieve its
+	// name.
+	// +optional
+	BranchNameSelector *runtimev1alpha1.Selector `json:"branchNameSelector,omitempty"`
+}
+
+// DomainAssociationParameters define the desired state of an AWS Amplify
+// domain association.
+type DomainAssociationParameters struct {
+	// Region is the region you'd like your DomainAssociation to be created
+	// in.
+	// +immutable
+	Region string `json:"region"`
+
+	// AppID is the ID of the app that the domain is associated with.
+	// +immutable
+	// +optional
+	AppID *string `json:"appId,omitempty"`
+
+	// AppIDRef references an App to retrieve its ID.
+	// +immutable
+	// +optional
+	AppIDRef *runtimev1alpha1.Reference `json:"appIdRef,omitempty"`
+
+	// AppIDSelector selects a reference to an App to retrieve its ID.
+	// +immutable
+	// +optional
+	AppIDSelector *runtimev1alpha1.Selector `json:"appIdSelector,omitempty"`
+
+	// EnableAutoSubDomain creates sub domains for new branches
+	// automatically.
+	// +optional
+	EnableAutoSubDomain *bool `json:"enableAutoSubDomain,omitempty"`
+
+	// SubDomainSettings map the sub domains of the domain to branches.
+	// +kubebuilder:validation:MinItems=1
+	SubDomainSettings []SubDomainSetting `json:"subDomainSettings"`
+}
+
+// SubDomain is the observed state of a sub domain.
+type SubDomain struct {
+	// Prefix of the sub domain.
+	Prefix string `json:"prefix,omitempty"`
+
+	// BranchName is the name of the branch that the sub domain serves.
+	BranchName string `json:"branchName,omitempty"`
+
+	// DNSRecord is the DNS record of the sub domain.
+	DNSRecord string `json:"dnsRecord,omitempty"`
+
+	// Verified is whether the sub domain is verified.
+	Verified bool `json:"verified,omitempty"`
+}
+
+// DomainAssociationObservation keeps the state for the external resource
+type DomainAssociationObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the domain association.
+	ARN string `json:"arn,omitempty"`
+
+	// DomainStatus is the current status of the domain association.
+	DomainStatus string `json:"domainStatus,omitempty"`
+
+	// StatusReason is the reason of the current status.
+	StatusReason string `json:"statusReason,omitempty"`
+
+	// CertificateVerificationDNSRecord is the DNS record that verifies the
+	// ownership of the domain for its certificate.
+	CertificateVerificationDNSRecord string `json:"certificateVerificationDnsRecord,omitempty"`
+
+	// SubDomains of the domain association.
+	SubDomains []SubDomain `json:"subDomains,omitempty"`
+}
+
+// A DomainAssociationSpec defines the desired state of a DomainAssociation.
+type DomainAssociationSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DomainAssociationParameters `json:"forProvider"`
+}
+
+// A DomainAssociationStatus represents the observed state of a
+// DomainAssociation.
+type DomainAssociationStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DomainAssociationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DomainAssociation is a managed resource that represents a custom domain
+// of an AWS Amplify app. Its external name is the domain name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.domainStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DomainAssociation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainAssociationSpec   `json:"spec"`
+	Status DomainAssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainAssociationList contains a list of DomainAssociations
+type DomainAssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DomainAssociation `json:"items"`
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "amplify.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// App type metadata.
+var (
+	AppKind             = reflect.TypeOf(App{}).Name()
+	AppGroupKind        = schema.GroupKind{Group: Group, Kind: AppKind}.String()
+	AppKindAPIVersion   = AppKind + "." + SchemeGroupVersion.String()
+	AppGroupVersionKind = SchemeGroupVersion.WithKind(AppKind)
+)
+
+// Branch type metadata.
+var (
+	BranchKind             = reflect.TypeOf(Branch{}).Name()
+	BranchGroupKind        = schema.GroupKind{Group: Group, Kind: BranchKind}.String()
+	BranchKindAPIVersion   = BranchKind + "." + SchemeGroupVersion.String()
+	BranchGroupVersionKind = SchemeGroupVersion.WithKind(BranchKind)
+)
+
+// DomainAssociation type metadata.
+var (
+	DomainAssociationKind             = reflect.TypeOf(DomainAssociation{}).Name()
+	DomainAssociationGroupKind        = schema.GroupKind{Group: Group, Kind: DomainAssociationKind}.String()
+	DomainAssociationKindAPIVersion   = DomainAssociationKind + "." + SchemeGroupVersion.String()
+	DomainAssociationGroupVersionKind = SchemeGroupVersion.WithKind(DomainAssociationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&App{}, &AppList{})
+	SchemeBuilder.Register(&Branch{}, &BranchList{})
+	SchemeBuilder.Register(&DomainAssociation{}, &DomainAssociationList{})
+}
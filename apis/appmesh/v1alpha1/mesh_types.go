@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// App Mesh resource states, shared by meshes, virtual nodes and virtual
+// services.
+const (
+	// The resource is active.
+	StatusActive = "ACTIVE"
+	// The resource is being deleted.
+	StatusDeleted = "DELETED"
+	// The resource is inactive.
+	StatusInactive = "INACTIVE"
+)
+
+// Tag is a key-value pair that is attached to an App Mesh resource.
+type Tag struct {
+	// Key is the name of the tag.
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// EgressFilter determines the egress traffic that is allowed from the
+// virtual nodes of a mesh.
+type EgressFilter struct {
+	// Type is the egress filter type. ALLOW_ALL allows egress to any
+	// endpoint inside or outside of the mesh, DROP_ALL only allows egress
+	// to other resources in the mesh and to AWS APIs.
+	// +crossplane:aws:model=appmesh.EgressFilter.Type
+	// +kubebuilder:validation:Enum=ALLOW_ALL;DROP_ALL
+	Type string `json:"type"`
+}
+
+// MeshParameters define the desired state of an AWS App Mesh service mesh.
+type MeshParameters struct {
+	// Region is the region you'd like the Mesh to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// EgressFilter determines the egress traffic that is allowed from the
+	// virtual nodes of the mesh.
+	// Default: DROP_ALL
+	// +optional
+	EgressFilter *EgressFilter `json:"egressFilter,omitempty"`
+
+	// Tags to assign to the mesh.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// MeshObservation is the representation of the current state that is
+// observed.
+type MeshObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the mesh.
+	ARN string `json:"arn,omitempty"`
+
+	// UID is the unique identifier of the mesh.
+	UID string `json:"uid,omitempty"`
+
+	// MeshOwner is the AWS account ID of the owner of the mesh.
+	MeshOwner string `json:"meshOwner,omitempty"`
+
+	// ResourceOwner is the AWS account ID of the owner of the resource.
+	ResourceOwner string `json:"resourceOwner,omitempty"`
+
+	// Version of the mesh, which is incremented on every update.
+	Version int64 `json:"version,omitempty"`
+
+	// Status is the current state of the mesh.
+	Status string `json:"status,omitempty"`
+}
+
+// MeshSpec defines the desired state of an AWS App Mesh Mesh.
+type MeshSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  MeshParameters `json:"forProvider"`
+}
+
+// MeshStatus represents the observed state of an AWS App Mesh Mesh.
+type MeshStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     MeshObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Mesh is a managed resource that represents an AWS App Mesh service mesh,
+// the logical boundary for the network traffic between the services that
+// reside within it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Mesh struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MeshSpec   `json:"spec"`
+	Status MeshStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MeshList contains a list of Mesh
+type MeshList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Mesh `json:"items"`
+}
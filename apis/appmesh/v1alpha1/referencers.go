@@ -0,0 +1,211 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+)
+
+// ResolveReferences of this VirtualNode
+func (mg *VirtualNode) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.meshName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.MeshName),
+		Reference:    mg.Spec.ForProvider.MeshNameRef,
+		Selector:     mg.Spec.ForProvider.MeshNameSelector,
+		To:           reference.To{Managed: &Mesh{}, List: &MeshList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.meshName")
+	}
+	mg.Spec.ForProvider.MeshName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.MeshNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this VirtualService
+func (mg *VirtualService) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.meshName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.MeshName),
+		Reference:    mg.Spec.ForProvider.MeshNameRef,
+		Selector:     mg.Spec.ForProvider.MeshNameSelector,
+		To:           reference.To{Managed: &Mesh{}, List: &MeshList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.meshName")
+	}
+	mg.Spec.ForProvider.MeshName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.MeshNameRef = rsp.ResolvedReference
+
+	if mg.Spec.ForProvider.Provider == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.provider.virtualNode.virtualNodeName
+	if vn := mg.Spec.ForProvider.Provider.VirtualNode; vn != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(vn.VirtualNodeName),
+			Reference:    vn.VirtualNodeNameRef,
+			Selector:     vn.VirtualNodeNameSelector,
+			To:           reference.To{Managed: &VirtualNode{}, List: &VirtualNodeList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.provider.virtualNode.virtualNodeName")
+		}
+		vn.VirtualNodeName = reference.ToPtrValue(rsp.ResolvedValue)
+		vn.VirtualNodeNameRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.provider.virtualRouter.virtualRouterName
+	if vr := mg.Spec.ForProvider.Provider.VirtualRouter; vr != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(vr.VirtualRouterName),
+			Reference:    vr.VirtualRouterNameRef,
+			Selector:     vr.VirtualRouterNameSelector,
+			To:           reference.To{Managed: &VirtualRouter{}, List: &VirtualRouterList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.provider.virtualRouter.virtualRouterName")
+		}
+		vr.VirtualRouterName = reference.ToPtrValue(rsp.ResolvedValue)
+		vr.VirtualRouterNameRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this VirtualRouter
+func (mg *VirtualRouter) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.meshName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.MeshName),
+		Reference:    mg.Spec.ForProvider.MeshNameRef,
+		Selector:     mg.Spec.ForProvider.MeshNameSelector,
+		To:           reference.To{Managed: &Mesh{}, List: &MeshList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.meshName")
+	}
+	mg.Spec.ForProvider.MeshName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.MeshNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Route
+func (mg *Route) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.meshName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.MeshName),
+		Reference:    mg.Spec.ForProvider.MeshNameRef,
+		Selector:     mg.Spec.ForProvider.MeshNameSelector,
+		To:           reference.To{Managed: &Mesh{}, List: &MeshList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.meshName")
+	}
+	mg.Spec.ForProvider.MeshName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.MeshNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.virtualRouterName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VirtualRouterName),
+		Reference:    mg.Spec.ForProvider.VirtualRouterNameRef,
+		Selector:     mg.Spec.ForProvider.VirtualRouterNameSelector,
+		To:           reference.To{Managed: &VirtualRouter{}, List: &VirtualRouterList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.virtualRouterName")
+	}
+	mg.Spec.ForProvider.VirtualRouterName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VirtualRouterNameRef = rsp.ResolvedReference
+
+	// Resolve the virtual nodes of the weighted targets of each route.
+	for _, rt := range []struct {
+		path   string
+		action *RouteAction
+	}{
+		{path: "httpRoute", action: httpRouteAction(mg.Spec.ForProvider.HTTPRoute)},
+		{path: "http2Route", action: httpRouteAction(mg.Spec.ForProvider.HTTP2Route)},
+		{path: "grpcRoute", action: grpcRouteAction(mg.Spec.ForProvider.GRPCRoute)},
+		{path: "tcpRoute", action: tcpRouteAction(mg.Spec.ForProvider.TCPRoute)},
+	} {
+		if rt.action == nil {
+			continue
+		}
+		for i := range rt.action.WeightedTargets {
+			t := &rt.action.WeightedTargets[i]
+			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(t.VirtualNodeName),
+				Reference:    t.VirtualNodeNameRef,
+				Selector:     t.VirtualNodeNameSelector,
+				To:           reference.To{Managed: &VirtualNode{}, List: &VirtualNodeList{}},
+				Extract:      reference.ExternalName(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.%s.action.weightedTargets[%d].virtualNodeName", rt.path, i)
+			}
+			t.VirtualNodeName = reference.ToPtrValue(rsp.ResolvedValue)
+			t.VirtualNodeNameRef = rsp.ResolvedReference
+		}
+	}
+
+	return nil
+}
+
+func httpRouteAction(r *HTTPRoute) *RouteAction {
+	if r == nil {
+		return nil
+	}
+	return &r.Action
+}
+
+func grpcRouteAction(r *GRPCRoute) *RouteAction {
+	if r == nil {
+		return nil
+	}
+	return &r.Action
+}
+
+func tcpRouteAction(r *TCPRoute) *RouteAction {
+	if r == nil {
+		return nil
+	}
+	return &r.Action
+}
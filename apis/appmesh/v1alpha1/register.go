@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "appmesh.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Mesh type metadata.
+var (
+	MeshKind             = reflect.TypeOf(Mesh{}).Name()
+	MeshGroupKind        = schema.GroupKind{Group: Group, Kind: MeshKind}.String()
+	MeshKindAPIVersion   = MeshKind + "." + SchemeGroupVersion.String()
+	MeshGroupVersionKind = SchemeGroupVersion.WithKind(MeshKind)
+)
+
+// VirtualNode type metadata.
+var (
+	VirtualNodeKind             = reflect.TypeOf(VirtualNode{}).Name()
+	VirtualNodeGroupKind        = schema.GroupKind{Group: Group, Kind: VirtualNodeKind}.String()
+	VirtualNodeKindAPIVersion   = VirtualNodeKind + "." + SchemeGroupVersion.String()
+	VirtualNodeGroupVersionKind = SchemeGroupVersion.WithKind(VirtualNodeKind)
+)
+
+// VirtualService type metadata.
+var (
+	VirtualServiceKind             = reflect.TypeOf(VirtualService{}).Name()
+	VirtualServiceGroupKind        = schema.GroupKind{Group: Group, Kind: VirtualServiceKind}.String()
+	VirtualServiceKindAPIVersion   = VirtualServiceKind + "." + SchemeGroupVersion.String()
+	VirtualServiceGroupVersionKind = SchemeGroupVersion.WithKind(VirtualServiceKind)
+)
+
+// VirtualRouter type metadata.
+var (
+	VirtualRouterKind             = reflect.TypeOf(VirtualRouter{}).Name()
+	VirtualRouterGroupKind        = schema.GroupKind{Group: Group, Kind: VirtualRouterKind}.String()
+	VirtualRouterKindAPIVersion   = VirtualRouterKind + "." + SchemeGroupVersion.String()
+	VirtualRouterGroupVersionKind = SchemeGroupVersion.WithKind(VirtualRouterKind)
+)
+
+// Route type metadata.
+var (
+	RouteKind             = reflect.TypeOf(Route{}).Name()
+	RouteGroupKind        = schema.GroupKind{Group: Group, Kind: RouteKind}.String()
+	RouteKindAPIVersion   = RouteKind + "." + SchemeGroupVersion.String()
+	RouteGroupVersionKind = SchemeGroupVersion.WithKind(RouteKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Mesh{}, &MeshList{})
+	SchemeBuilder.Register(&VirtualNode{}, &VirtualNodeList{})
+	SchemeBuilder.Register(&VirtualService{}, &VirtualServiceList{})
+	SchemeBuilder.Register(&VirtualRouter{}, &VirtualRouterList{})
+	SchemeBuilder.Register(&Route{}, &RouteList{})
+}
@@ -0,0 +1,406 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// WeightedTarget is a virtual node that a route sends a share of its traffic
+// to.
+type WeightedTarget struct {
+	// VirtualNodeName is the name of the virtual node.
+	// +optional
+	VirtualNodeName *string `json:"virtualNodeName,omitempty"`
+
+	// VirtualNodeNameRef is a reference to a VirtualNode used to set
+	// VirtualNodeName.
+	// +optional
+	VirtualNodeNameRef *runtimev1alpha1.Reference `json:"virtualNodeNameRef,omitempty"`
+
+	// VirtualNodeNameSelector selects a reference to a VirtualNode used to
+	// set VirtualNodeName.
+	// +optional
+	VirtualNodeNameSelector *runtimev1alpha1.Selector `json:"virtualNodeNameSelector,omitempty"`
+
+	// Weight of the target relative to the other targets of the route.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int64 `json:"weight"`
+}
+
+// RouteAction is where a route sends the traffic it matches.
+type RouteAction struct {
+	// WeightedTargets are the virtual nodes that the traffic is distributed
+	// to.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	WeightedTargets []WeightedTarget `json:"weightedTargets"`
+}
+
+// MatchRange is an inclusive range of integers.
+type MatchRange struct {
+	// Start of the range.
+	Start int64 `json:"start"`
+
+	// End of the range.
+	End int64 `json:"end"`
+}
+
+// HeaderMatchMethod is how the value of a header is matched. Exactly one of
+// its fields must be set.
+type HeaderMatchMethod struct {
+	// Exact matches values that are equal to the given value.
+	// +optional
+	Exact *string `json:"exact,omitempty"`
+
+	// Prefix matches values that start with the given value.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Suffix matches values that end with the given value.
+	// +optional
+	Suffix *string `json:"suffix,omitempty"`
+
+	// Regex matches values against the given regular expression.
+	// +optional
+	Regex *string `json:"regex,omitempty"`
+
+	// Range matches integer values within the given range.
+	// +optional
+	Range *MatchRange `json:"range,omitempty"`
+}
+
+// HTTPRouteHeader matches a header of a request.
+type HTTPRouteHeader struct {
+	// Name of the header.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Invert the match, so that requests whose header does not match are
+	// selected.
+	// Default: false
+	// +optional
+	Invert *bool `json:"invert,omitempty"`
+
+	// Match is how the value of the header is matched. Requests that have
+	// the header are matched if it is not set.
+	// +optional
+	Match *HeaderMatchMethod `json:"match,omitempty"`
+}
+
+// HTTPRouteMatch selects the requests that an HTTP route applies to.
+type HTTPRouteMatch struct {
+	// Prefix of the path of the requests, which must start with /.
+	// +kubebuilder:validation:Pattern=`^/`
+	Prefix string `json:"prefix"`
+
+	// Method of the requests.
+	// +crossplane:aws:model=appmesh.HttpRouteMatch.Method
+	// +kubebuilder:validation:Enum=CONNECT;DELETE;GET;HEAD;OPTIONS;PATCH;POST;PUT;TRACE
+	// +optional
+	Method *string `json:"method,omitempty"`
+
+	// Scheme of the requests.
+	// +crossplane:aws:model=appmesh.HttpRouteMatch.Scheme
+	// +kubebuilder:validation:Enum=http;https
+	// +optional
+	Scheme *string `json:"scheme,omitempty"`
+
+	// Headers of the requests.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	Headers []HTTPRouteHeader `json:"headers,omitempty"`
+}
+
+// Duration is an amount of time.
+type Duration struct {
+	// Unit of the duration.
+	// +crossplane:aws:model=appmesh.Duration.Unit
+	// +kubebuilder:validation:Enum=ms;s
+	Unit string `json:"unit"`
+
+	// Value of the duration in the given unit.
+	// +kubebuilder:validation:Minimum=0
+	Value int64 `json:"value"`
+}
+
+// TCPRetryEvent is a TCP event that a request is retried on.
+// +kubebuilder:validation:Enum=connection-error
+type TCPRetryEvent string
+
+// GRPCRetryEvent is a gRPC status that a request is retried on.
+// +kubebuilder:validation:Enum=cancelled;deadline-exceeded;internal;resource-exhausted;unavailable
+type GRPCRetryEvent string
+
+// HTTPRetryPolicy is the retry policy of an HTTP route. At least one of
+// HTTPRetryEvents and TCPRetryEvents must be set.
+type HTTPRetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is retried.
+	// +kubebuilder:validation:Minimum=0
+	MaxRetries int64 `json:"maxRetries"`
+
+	// PerRetryTimeout is the timeout of each retry.
+	PerRetryTimeout Duration `json:"perRetryTimeout"`
+
+	// HTTPRetryEvents are the HTTP events that a request is retried on:
+	// server-error, gateway-error, client-error or stream-error.
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	HTTPRetryEvents []string `json:"httpRetryEvents,omitempty"`
+
+	// TCPRetryEvents are the TCP events that a request is retried on.
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	TCPRetryEvents []TCPRetryEvent `json:"tcpRetryEvents,omitempty"`
+}
+
+// HTTPRoute routes HTTP or HTTP/2 traffic.
+type HTTPRoute struct {
+	// Action is where the matched requests are sent.
+	Action RouteAction `json:"action"`
+
+	// Match selects the requests that the route applies to.
+	Match HTTPRouteMatch `json:"match"`
+
+	// RetryPolicy is how failed requests are retried.
+	// +optional
+	RetryPolicy *HTTPRetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// TCPRoute routes TCP traffic.
+type TCPRoute struct {
+	// Action is where the traffic is sent.
+	Action RouteAction `json:"action"`
+}
+
+// GRPCRouteMetadata matches a metadata entry of a request.
+type GRPCRouteMetadata struct {
+	// Name of the metadata entry.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Invert the match, so that requests whose metadata entry does not
+	// match are selected.
+	// Default: false
+	// +optional
+	Invert *bool `json:"invert,omitempty"`
+
+	// Match is how the value of the metadata entry is matched. Requests
+	// that have the entry are matched if it is not set.
+	// +optional
+	Match *HeaderMatchMethod `json:"match,omitempty"`
+}
+
+// GRPCRouteMatch selects the requests that a gRPC route applies to.
+type GRPCRouteMatch struct {
+	// ServiceName is the fully qualified name of the gRPC service.
+	// +optional
+	ServiceName *string `json:"serviceName,omitempty"`
+
+	// MethodName is the name of the gRPC method. ServiceName must be set
+	// along with it.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	MethodName *string `json:"methodName,omitempty"`
+
+	// Metadata of the requests.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	Metadata []GRPCRouteMetadata `json:"metadata,omitempty"`
+}
+
+// GRPCRetryPolicy is the retry policy of a gRPC route. At least one of
+// GRPCRetryEvents, HTTPRetryEvents and TCPRetryEvents must be set.
+type GRPCRetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is retried.
+	// +kubebuilder:validation:Minimum=0
+	MaxRetries int64 `json:"maxRetries"`
+
+	// PerRetryTimeout is the timeout of each retry.
+	PerRetryTimeout Duration `json:"perRetryTimeout"`
+
+	// GRPCRetryEvents are the gRPC statuses that a request is retried on.
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	GRPCRetryEvents []GRPCRetryEvent `json:"grpcRetryEvents,omitempty"`
+
+	// HTTPRetryEvents are the HTTP events that a request is retried on:
+	// server-error, gateway-error, client-error or stream-error.
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	HTTPRetryEvents []string `json:"httpRetryEvents,omitempty"`
+
+	// TCPRetryEvents are the TCP events that a request is retried on.
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	TCPRetryEvents []TCPRetryEvent `json:"tcpRetryEvents,omitempty"`
+}
+
+// GRPCRoute routes gRPC traffic.
+type GRPCRoute struct {
+	// Action is where the matched requests are sent.
+	Action RouteAction `json:"action"`
+
+	// Match selects the requests that the route applies to.
+	Match GRPCRouteMatch `json:"match"`
+
+	// RetryPolicy is how failed requests are retried.
+	// +optional
+	RetryPolicy *GRPCRetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// RouteParameters define the desired state of an AWS App Mesh route. Exactly
+// one of HTTPRoute, HTTP2Route, GRPCRoute and TCPRoute must be set.
+type RouteParameters struct {
+	// Region is the region you'd like the Route to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// MeshName is the name of the mesh of the virtual router.
+	// +immutable
+	// +optional
+	MeshName *string `json:"meshName,omitempty"`
+
+	// MeshNameRef is a reference to a Mesh used to set MeshName.
+	// +immutable
+	// +optional
+	MeshNameRef *runtimev1alpha1.Reference `json:"meshNameRef,omitempty"`
+
+	// MeshNameSelector selects a reference to a Mesh used to set MeshName.
+	// +immutable
+	// +optional
+	MeshNameSelector *runtimev1alpha1.Selector `json:"meshNameSelector,omitempty"`
+
+	// MeshOwner is the AWS account ID of the owner of the mesh, if the mesh
+	// is shared with this account.
+	// +immutable
+	// +optional
+	MeshOwner *string `json:"meshOwner,omitempty"`
+
+	// VirtualRouterName is the name of the virtual router to create the
+	// route in.
+	// +immutable
+	// +optional
+	VirtualRouterName *string `json:"virtualRouterName,omitempty"`
+
+	// VirtualRouterNameRef is a reference to a VirtualRouter used to set
+	// VirtualRouterName.
+	// +immutable
+	// +optional
+	VirtualRouterNameRef *runtimev1alpha1.Reference `json:"virtualRouterNameRef,omitempty"`
+
+	// VirtualRouterNameSelector selects a reference to a VirtualRouter used
+	// to set VirtualRouterName.
+	// +immutable
+	// +optional
+	VirtualRouterNameSelector *runtimev1alpha1.Selector `json:"virtualRouterNameSelector,omitempty"`
+
+	// Priority of the route. Routes with a lower value are matched first,
+	// and routes without a priority are matched last.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	Priority *int64 `json:"priority,omitempty"`
+
+	// HTTPRoute routes HTTP traffic.
+	// +optional
+	HTTPRoute *HTTPRoute `json:"httpRoute,omitempty"`
+
+	// HTTP2Route routes HTTP/2 traffic.
+	// +optional
+	HTTP2Route *HTTPRoute `json:"http2Route,omitempty"`
+
+	// GRPCRoute routes gRPC traffic.
+	// +optional
+	GRPCRoute *GRPCRoute `json:"grpcRoute,omitempty"`
+
+	// TCPRoute routes TCP traffic.
+	// +optional
+	TCPRoute *TCPRoute `json:"tcpRoute,omitempty"`
+
+	// Tags to assign to the route.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// RouteObservation is the representation of the current state that is
+// observed.
+type RouteObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the route.
+	ARN string `json:"arn,omitempty"`
+
+	// UID is the unique identifier of the route.
+	UID string `json:"uid,omitempty"`
+
+	// MeshOwner is the AWS account ID of the owner of the mesh.
+	MeshOwner string `json:"meshOwner,omitempty"`
+
+	// ResourceOwner is the AWS account ID of the owner of the route.
+	ResourceOwner string `json:"resourceOwner,omitempty"`
+
+	// Version of the route, which is incremented on every update.
+	Version int64 `json:"version,omitempty"`
+
+	// Status is the current state of the route.
+	Status string `json:"status,omitempty"`
+}
+
+// RouteSpec defines the desired state of an AWS App Mesh Route.
+type RouteSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  RouteParameters `json:"forProvider"`
+}
+
+// RouteStatus represents the observed state of an AWS App Mesh Route.
+type RouteStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     RouteObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Route is a managed resource that represents an AWS App Mesh route, which
+// matches the traffic of a virtual router and sends it to virtual nodes.
+// Its external name is the name of the route.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="MESH",type="string",JSONPath=".spec.forProvider.meshName"
+// +kubebuilder:printcolumn:name="ROUTER",type="string",JSONPath=".spec.forProvider.virtualRouterName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Route struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RouteSpec   `json:"spec"`
+	Status RouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RouteList contains a list of Route
+type RouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Route `json:"items"`
+}
@@ -0,0 +1,411 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// PortMapping is the port and protocol of a listener.
+type PortMapping struct {
+	// Port that the listener listens on.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+
+	// Protocol of the listener.
+	// +crossplane:aws:model=appmesh.PortMapping.Protocol
+	// +kubebuilder:validation:Enum=grpc;http;http2;tcp
+	Protocol string `json:"protocol"`
+}
+
+// HealthCheckPolicy is the health check policy of a listener.
+type HealthCheckPolicy struct {
+	// HealthyThreshold is the number of consecutive successful health checks
+	// before the listener is considered healthy.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	HealthyThreshold int64 `json:"healthyThreshold"`
+
+	// UnhealthyThreshold is the number of consecutive failed health checks
+	// before the listener is considered unhealthy.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	UnhealthyThreshold int64 `json:"unhealthyThreshold"`
+
+	// IntervalMillis is the time between health checks in milliseconds.
+	// +kubebuilder:validation:Minimum=5000
+	// +kubebuilder:validation:Maximum=300000
+	IntervalMillis int64 `json:"intervalMillis"`
+
+	// TimeoutMillis is the time to wait for a health check response in
+	// milliseconds.
+	// +kubebuilder:validation:Minimum=2000
+	// +kubebuilder:validation:Maximum=60000
+	TimeoutMillis int64 `json:"timeoutMillis"`
+
+	// Protocol of the health check. The path is only used by http and
+	// http2 health checks.
+	// +crossplane:aws:model=appmesh.HealthCheckPolicy.Protocol
+	// +kubebuilder:validation:Enum=grpc;http;http2;tcp
+	Protocol string `json:"protocol"`
+
+	// Path of the health check.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// Port of the health check.
+	// Default: the port of the listener.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+}
+
+// ListenerTLSACMCertificate is an AWS Certificate Manager (ACM) certificate.
+type ListenerTLSACMCertificate struct {
+	// CertificateARN is the Amazon Resource Name (ARN) of the certificate.
+	CertificateARN string `json:"certificateArn"`
+}
+
+// ListenerTLSFileCertificate is a certificate on the file system of the
+// Envoy proxy of a virtual node.
+type ListenerTLSFileCertificate struct {
+	// CertificateChain is the path of the certificate chain.
+	// +kubebuilder:validation:MinLength=1
+	CertificateChain string `json:"certificateChain"`
+
+	// PrivateKey is the path of the private key of the certificate.
+	// +kubebuilder:validation:MinLength=1
+	PrivateKey string `json:"privateKey"`
+}
+
+// ListenerTLSCertificate is the certificate of a listener. Exactly one of
+// ACM and File must be set.
+type ListenerTLSCertificate struct {
+	// ACM is an AWS Certificate Manager (ACM) certificate.
+	// +optional
+	ACM *ListenerTLSACMCertificate `json:"acm,omitempty"`
+
+	// File is a certificate on the file system of the Envoy proxy.
+	// +optional
+	File *ListenerTLSFileCertificate `json:"file,omitempty"`
+}
+
+// ListenerTLS is the Transport Layer Security (TLS) configuration of a
+// listener.
+type ListenerTLS struct {
+	// Certificate of the listener.
+	Certificate ListenerTLSCertificate `json:"certificate"`
+
+	// Mode of the listener. STRICT only accepts TLS connections, PERMISSIVE
+	// accepts both TLS and plain text connections, DISABLED only accepts
+	// plain text connections.
+	// +crossplane:aws:model=appmesh.ListenerTls.Mode
+	// +kubebuilder:validation:Enum=DISABLED;PERMISSIVE;STRICT
+	Mode string `json:"mode"`
+}
+
+// Listener is the inbound traffic listener of a virtual node.
+type Listener struct {
+	// PortMapping is the port and protocol of the listener.
+	PortMapping PortMapping `json:"portMapping"`
+
+	// HealthCheck is the health check policy of the listener.
+	// +optional
+	HealthCheck *HealthCheckPolicy `json:"healthCheck,omitempty"`
+
+	// TLS is the Transport Layer Security (TLS) configuration of the
+	// listener.
+	// +optional
+	TLS *ListenerTLS `json:"tls,omitempty"`
+}
+
+// TLSValidationContextACMTrust trusts the certificates of AWS Certificate
+// Manager (ACM) Private Certificate Authorities.
+type TLSValidationContextACMTrust struct {
+	// CertificateAuthorityARNs are the Amazon Resource Names (ARNs) of the
+	// trusted certificate authorities.
+	// +kubebuilder:validation:MinItems=1
+	CertificateAuthorityARNs []string `json:"certificateAuthorityArns"`
+}
+
+// TLSValidationContextFileTrust trusts a certificate chain on the file
+// system of the Envoy proxy of a virtual node.
+type TLSValidationContextFileTrust struct {
+	// CertificateChain is the path of the trusted certificate chain.
+	// +kubebuilder:validation:MinLength=1
+	CertificateChain string `json:"certificateChain"`
+}
+
+// TLSValidationContextTrust is the trust of a TLS validation context.
+// Exactly one of ACM and File must be set.
+type TLSValidationContextTrust struct {
+	// ACM trusts ACM Private Certificate Authorities.
+	// +optional
+	ACM *TLSValidationContextACMTrust `json:"acm,omitempty"`
+
+	// File trusts a certificate chain on the file system.
+	// +optional
+	File *TLSValidationContextFileTrust `json:"file,omitempty"`
+}
+
+// TLSValidationContext is how the certificates of backends are validated.
+type TLSValidationContext struct {
+	// Trust is the certificates that are trusted.
+	Trust TLSValidationContextTrust `json:"trust"`
+}
+
+// ClientPolicyTLS is the Transport Layer Security (TLS) policy of the
+// connections to backends.
+type ClientPolicyTLS struct {
+	// Enforce TLS for the connections to backends.
+	// Default: true
+	// +optional
+	Enforce *bool `json:"enforce,omitempty"`
+
+	// Ports that TLS is enforced for.
+	// Default: all ports.
+	// +optional
+	Ports []int64 `json:"ports,omitempty"`
+
+	// Validation is how the certificates of backends are validated.
+	Validation TLSValidationContext `json:"validation"`
+}
+
+// ClientPolicy is the policy of the connections to backends.
+type ClientPolicy struct {
+	// TLS is the Transport Layer Security (TLS) policy.
+	// +optional
+	TLS *ClientPolicyTLS `json:"tls,omitempty"`
+}
+
+// BackendDefaults are the default settings of all backends of a virtual
+// node.
+type BackendDefaults struct {
+	// ClientPolicy is the default policy of the connections to backends.
+	// +optional
+	ClientPolicy *ClientPolicy `json:"clientPolicy,omitempty"`
+}
+
+// VirtualServiceBackend is a virtual service that a virtual node sends
+// outbound traffic to.
+type VirtualServiceBackend struct {
+	// VirtualServiceName is the name of the virtual service.
+	VirtualServiceName string `json:"virtualServiceName"`
+
+	// ClientPolicy is the policy of the connections to the virtual service.
+	// +optional
+	ClientPolicy *ClientPolicy `json:"clientPolicy,omitempty"`
+}
+
+// Backend is a backend of a virtual node.
+type Backend struct {
+	// VirtualService is a virtual service backend.
+	// +optional
+	VirtualService *VirtualServiceBackend `json:"virtualService,omitempty"`
+}
+
+// FileAccessLog is an access log that is written to a file.
+type FileAccessLog struct {
+	// Path of the file, for example /dev/stdout.
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+}
+
+// AccessLog is the access log of a virtual node.
+type AccessLog struct {
+	// File writes the access log to a file.
+	// +optional
+	File *FileAccessLog `json:"file,omitempty"`
+}
+
+// Logging is the logging configuration of a virtual node.
+type Logging struct {
+	// AccessLog is the access log of the virtual node.
+	// +optional
+	AccessLog *AccessLog `json:"accessLog,omitempty"`
+}
+
+// DNSServiceDiscovery discovers a virtual node through DNS.
+type DNSServiceDiscovery struct {
+	// Hostname is the DNS hostname of the virtual node.
+	Hostname string `json:"hostname"`
+}
+
+// AWSCloudMapInstanceAttribute is a key-value pair that filters the AWS
+// Cloud Map instances of a virtual node.
+type AWSCloudMapInstanceAttribute struct {
+	// Key of the attribute.
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Value of the attribute.
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+}
+
+// AWSCloudMapServiceDiscovery discovers a virtual node through AWS Cloud
+// Map.
+type AWSCloudMapServiceDiscovery struct {
+	// NamespaceName is the name of the AWS Cloud Map namespace.
+	// +kubebuilder:validation:MinLength=1
+	NamespaceName string `json:"namespaceName"`
+
+	// ServiceName is the name of the AWS Cloud Map service.
+	// +kubebuilder:validation:MinLength=1
+	ServiceName string `json:"serviceName"`
+
+	// Attributes filter the instances of the AWS Cloud Map service. Only
+	// the instances with all of the attributes are returned.
+	// +optional
+	Attributes []AWSCloudMapInstanceAttribute `json:"attributes,omitempty"`
+}
+
+// ServiceDiscovery is how a virtual node is discovered. Exactly one of DNS
+// and AWSCloudMap must be set.
+type ServiceDiscovery struct {
+	// DNS discovers the virtual node through DNS.
+	// +optional
+	DNS *DNSServiceDiscovery `json:"dns,omitempty"`
+
+	// AWSCloudMap discovers the virtual node through AWS Cloud Map.
+	// +optional
+	AWSCloudMap *AWSCloudMapServiceDiscovery `json:"awsCloudMap,omitempty"`
+}
+
+// VirtualNodeParameters define the desired state of an AWS App Mesh virtual
+// node.
+type VirtualNodeParameters struct {
+	// Region is the region you'd like the VirtualNode to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// MeshName is the name of the mesh to create the virtual node in.
+	// +immutable
+	// +optional
+	MeshName *string `json:"meshName,omitempty"`
+
+	// MeshNameRef is a reference to a Mesh used to set MeshName.
+	// +immutable
+	// +optional
+	MeshNameRef *runtimev1alpha1.Reference `json:"meshNameRef,omitempty"`
+
+	// MeshNameSelector selects a reference to a Mesh used to set MeshName.
+	// +immutable
+	// +optional
+	MeshNameSelector *runtimev1alpha1.Selector `json:"meshNameSelector,omitempty"`
+
+	// MeshOwner is the AWS account ID of the owner of the mesh, if the mesh
+	// is shared with this account.
+	// +immutable
+	// +optional
+	MeshOwner *string `json:"meshOwner,omitempty"`
+
+	// Listeners are the inbound traffic listeners of the virtual node.
+	// +kubebuilder:validation:MaxItems=1
+	// +optional
+	Listeners []Listener `json:"listeners,omitempty"`
+
+	// Backends are the virtual services that the virtual node sends
+	// outbound traffic to.
+	// +optional
+	Backends []Backend `json:"backends,omitempty"`
+
+	// BackendDefaults are the default settings of all backends.
+	// +optional
+	BackendDefaults *BackendDefaults `json:"backendDefaults,omitempty"`
+
+	// ServiceDiscovery is how the virtual node is discovered. It must be set
+	// if the virtual node has a listener.
+	// +optional
+	ServiceDiscovery *ServiceDiscovery `json:"serviceDiscovery,omitempty"`
+
+	// Logging is the logging configuration of the virtual node.
+	// +optional
+	Logging *Logging `json:"logging,omitempty"`
+
+	// Tags to assign to the virtual node.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// VirtualNodeObservation is the representation of the current state that is
+// observed.
+type VirtualNodeObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the virtual node.
+	ARN string `json:"arn,omitempty"`
+
+	// UID is the unique identifier of the virtual node.
+	UID string `json:"uid,omitempty"`
+
+	// MeshOwner is the AWS account ID of the owner of the mesh.
+	MeshOwner string `json:"meshOwner,omitempty"`
+
+	// ResourceOwner is the AWS account ID of the owner of the virtual node.
+	ResourceOwner string `json:"resourceOwner,omitempty"`
+
+	// Version of the virtual node, which is incremented on every update.
+	Version int64 `json:"version,omitempty"`
+
+	// Status is the current state of the virtual node.
+	Status string `json:"status,omitempty"`
+}
+
+// VirtualNodeSpec defines the desired state of an AWS App Mesh VirtualNode.
+type VirtualNodeSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VirtualNodeParameters `json:"forProvider"`
+}
+
+// VirtualNodeStatus represents the observed state of an AWS App Mesh
+// VirtualNode.
+type VirtualNodeStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VirtualNodeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VirtualNode is a managed resource that represents an AWS App Mesh
+// virtual node, a logical pointer to a discoverable service such as an ECS
+// service or a Kubernetes deployment.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="MESH",type="string",JSONPath=".spec.forProvider.meshName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VirtualNode struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualNodeSpec   `json:"spec"`
+	Status VirtualNodeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualNodeList contains a list of VirtualNode
+type VirtualNodeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualNode `json:"items"`
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// VirtualRouterListener is the inbound traffic listener of a virtual router.
+type VirtualRouterListener struct {
+	// PortMapping is the port and protocol of the listener.
+	PortMapping PortMapping `json:"portMapping"`
+}
+
+// VirtualRouterParameters define the desired state of an AWS App Mesh
+// virtual router.
+type VirtualRouterParameters struct {
+	// Region is the region you'd like the VirtualRouter to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// MeshName is the name of the mesh to create the virtual router in.
+	// +immutable
+	// +optional
+	MeshName *string `json:"meshName,omitempty"`
+
+	// MeshNameRef is a reference to a Mesh used to set MeshName.
+	// +immutable
+	// +optional
+	MeshNameRef *runtimev1alpha1.Reference `json:"meshNameRef,omitempty"`
+
+	// MeshNameSelector selects a reference to a Mesh used to set MeshName.
+	// +immutable
+	// +optional
+	MeshNameSelector *runtimev1alpha1.Selector `json:"meshNameSelector,omitempty"`
+
+	// MeshOwner is the AWS account ID of the owner of the mesh, if the mesh
+	// is shared with this account.
+	// +immutable
+	// +optional
+	MeshOwner *string `json:"meshOwner,omitempty"`
+
+	// Listeners are the inbound traffic listeners of the virtual router.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=1
+	Listeners []VirtualRouterListener `json:"listeners"`
+
+	// Tags to assign to the virtual router.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// VirtualRouterObservation is the representation of the current state that
+// is observed.
+type VirtualRouterObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the virtual router.
+	ARN string `json:"arn,omitempty"`
+
+	// UID is the unique identifier of the virtual router.
+	UID string `json:"uid,omitempty"`
+
+	// MeshOwner is the AWS account ID of the owner of the mesh.
+	MeshOwner string `json:"meshOwner,omitempty"`
+
+	// ResourceOwner is the AWS account ID of the owner of the virtual
+	// router.
+	ResourceOwner string `json:"resourceOwner,omitempty"`
+
+	// Version of the virtual router, which is incremented on every update.
+	Version int64 `json:"version,omitempty"`
+
+	// Status is the current state of the virtual router.
+	Status string `json:"status,omitempty"`
+}
+
+// VirtualRouterSpec defines the desired state of an AWS App Mesh
+// VirtualRouter.
+type VirtualRouterSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VirtualRouterParameters `json:"forProvider"`
+}
+
+// VirtualRouterStatus represents the observed state of an AWS App Mesh
+// VirtualRouter.
+type VirtualRouterStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VirtualRouterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VirtualRouter is a managed resource that represents an AWS App Mesh
+// virtual router, which distributes the traffic of a virtual service to
+// virtual nodes according to its routes. Its external name is the name of
+// the router.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="MESH",type="string",JSONPath=".spec.forProvider.meshName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VirtualRouter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualRouterSpec   `json:"spec"`
+	Status VirtualRouterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualRouterList contains a list of VirtualRouter
+type VirtualRouterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualRouter `json:"items"`
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// VirtualNodeServiceProvider routes the traffic of a virtual service to a
+// virtual node.
+type VirtualNodeServiceProvider struct {
+	// VirtualNodeName is the name of the virtual node.
+	// +optional
+	VirtualNodeName *string `json:"virtualNodeName,omitempty"`
+
+	// VirtualNodeNameRef is a reference to a VirtualNode used to set
+	// VirtualNodeName.
+	// +optional
+	VirtualNodeNameRef *runtimev1alpha1.Reference `json:"virtualNodeNameRef,omitempty"`
+
+	// VirtualNodeNameSelector selects a reference to a VirtualNode used to
+	// set VirtualNodeName.
+	// +optional
+	VirtualNodeNameSelector *runtimev1alpha1.Selector `json:"virtualNodeNameSelector,omitempty"`
+}
+
+// VirtualRouterServiceProvider routes the traffic of a virtual service to a
+// virtual router.
+type VirtualRouterServiceProvider struct {
+	// VirtualRouterName is the name of the virtual router.
+	// +optional
+	VirtualRouterName *string `json:"virtualRouterName,omitempty"`
+
+	// VirtualRouterNameRef is a reference to a VirtualRouter used to set
+	// VirtualRouterName.
+	// +optional
+	VirtualRouterNameRef *runtimev1alpha1.Reference `json:"virtualRouterNameRef,omitempty"`
+
+	// VirtualRouterNameSelector selects a reference to a VirtualRouter used
+	// to set VirtualRouterName.
+	// +optional
+	VirtualRouterNameSelector *runtimev1alpha1.Selector `json:"virtualRouterNameSelector,omitempty"`
+}
+
+// VirtualServiceProvider is the provider of a virtual service. At most one
+// of VirtualNode and VirtualRouter can be set.
+type VirtualServiceProvider struct {
+	// VirtualNode routes the traffic of the virtual service to a virtual
+	// node.
+	// +optional
+	VirtualNode *VirtualNodeServiceProvider `json:"virtualNode,omitempty"`
+
+	// VirtualRouter routes the traffic of the virtual service to a virtual
+	// router.
+	// +optional
+	VirtualRouter *VirtualRouterServiceProvider `json:"virtualRouter,omitempty"`
+}
+
+// VirtualServiceParameters define the desired state of an AWS App Mesh
+// virtual service.
+type VirtualServiceParameters struct {
+	// Region is the region you'd like the VirtualService to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// MeshName is the name of the mesh to create the virtual service in.
+	// +immutable
+	// +optional
+	MeshName *string `json:"meshName,omitempty"`
+
+	// MeshNameRef is a reference to a Mesh used to set MeshName.
+	// +immutable
+	// +optional
+	MeshNameRef *runtimev1alpha1.Reference `json:"meshNameRef,omitempty"`
+
+	// MeshNameSelector selects a reference to a Mesh used to set MeshName.
+	// +immutable
+	// +optional
+	MeshNameSelector *runtimev1alpha1.Selector `json:"meshNameSelector,omitempty"`
+
+	// MeshOwner is the AWS account ID of the owner of the mesh, if the mesh
+	// is shared with this account.
+	// +immutable
+	// +optional
+	MeshOwner *string `json:"meshOwner,omitempty"`
+
+	// Provider is the virtual node or virtual router that the traffic of
+	// the virtual service is routed to.
+	// +optional
+	Provider *VirtualServiceProvider `json:"provider,omitempty"`
+
+	// Tags to assign to the virtual service.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// VirtualServiceObservation is the representation of the current state that
+// is observed.
+type VirtualServiceObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the virtual service.
+	ARN string `json:"arn,omitempty"`
+
+	// UID is the unique identifier of the virtual service.
+	UID string `json:"uid,omitempty"`
+
+	// MeshOwner is the AWS account ID of the owner of the mesh.
+	MeshOwner string `json:"meshOwner,omitempty"`
+
+	// ResourceOwner is the AWS account ID of the owner of the virtual
+	// service.
+	ResourceOwner string `json:"resourceOwner,omitempty"`
+
+	// Version of the virtual service, which is incremented on every update.
+	Version int64 `json:"version,omitempty"`
+
+	// Status is the current state of the virtual service.
+	Status string `json:"status,omitempty"`
+}
+
+// VirtualServiceSpec defines the desired state of an AWS App Mesh
+// VirtualService.
+type VirtualServiceSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VirtualServiceParameters `json:"forProvider"`
+}
+
+// VirtualServiceStatus represents the observed state of an AWS App Mesh
+// VirtualService.
+type VirtualServiceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VirtualServiceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VirtualService is a managed resource that represents an AWS App Mesh
+// virtual service, an abstraction of a real service that is provided by a
+// virtual node or a virtual router. Its external name is the name of the
+// service, usually its DNS name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="MESH",type="string",JSONPath=".spec.forProvider.meshName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VirtualService struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualServiceSpec   `json:"spec"`
+	Status VirtualServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualServiceList contains a list of VirtualService
+type VirtualServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualService `json:"items"`
+}
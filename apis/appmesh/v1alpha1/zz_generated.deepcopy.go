@@ -0,0 +1,1778 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCloudMapInstanceAttribute) DeepCopyInto(out *AWSCloudMapInstanceAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCloudMapInstanceAttribute.
+func (in *AWSCloudMapInstanceAttribute) DeepCopy() *AWSCloudMapInstanceAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCloudMapInstanceAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCloudMapServiceDiscovery) DeepCopyInto(out *AWSCloudMapServiceDiscovery) {
+	*out = *in
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make([]AWSCloudMapInstanceAttribute, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCloudMapServiceDiscovery.
+func (in *AWSCloudMapServiceDiscovery) DeepCopy() *AWSCloudMapServiceDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCloudMapServiceDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLog) DeepCopyInto(out *AccessLog) {
+	*out = *in
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileAccessLog)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLog.
+func (in *AccessLog) DeepCopy() *AccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Backend) DeepCopyInto(out *Backend) {
+	*out = *in
+	if in.VirtualService != nil {
+		in, out := &in.VirtualService, &out.VirtualService
+		*out = new(VirtualServiceBackend)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backend.
+func (in *Backend) DeepCopy() *Backend {
+	if in == nil {
+		return nil
+	}
+	out := new(Backend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendDefaults) DeepCopyInto(out *BackendDefaults) {
+	*out = *in
+	if in.ClientPolicy != nil {
+		in, out := &in.ClientPolicy, &out.ClientPolicy
+		*out = new(ClientPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendDefaults.
+func (in *BackendDefaults) DeepCopy() *BackendDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientPolicy) DeepCopyInto(out *ClientPolicy) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ClientPolicyTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientPolicy.
+func (in *ClientPolicy) DeepCopy() *ClientPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientPolicyTLS) DeepCopyInto(out *ClientPolicyTLS) {
+	*out = *in
+	if in.Enforce != nil {
+		in, out := &in.Enforce, &out.Enforce
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	in.Validation.DeepCopyInto(&out.Validation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientPolicyTLS.
+func (in *ClientPolicyTLS) DeepCopy() *ClientPolicyTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientPolicyTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSServiceDiscovery) DeepCopyInto(out *DNSServiceDiscovery) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSServiceDiscovery.
+func (in *DNSServiceDiscovery) DeepCopy() *DNSServiceDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSServiceDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Duration) DeepCopyInto(out *Duration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Duration.
+func (in *Duration) DeepCopy() *Duration {
+	if in == nil {
+		return nil
+	}
+	out := new(Duration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressFilter) DeepCopyInto(out *EgressFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressFilter.
+func (in *EgressFilter) DeepCopy() *EgressFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileAccessLog) DeepCopyInto(out *FileAccessLog) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileAccessLog.
+func (in *FileAccessLog) DeepCopy() *FileAccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(FileAccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRetryPolicy) DeepCopyInto(out *GRPCRetryPolicy) {
+	*out = *in
+	out.PerRetryTimeout = in.PerRetryTimeout
+	if in.GRPCRetryEvents != nil {
+		in, out := &in.GRPCRetryEvents, &out.GRPCRetryEvents
+		*out = make([]GRPCRetryEvent, len(*in))
+		copy(*out, *in)
+	}
+	if in.HTTPRetryEvents != nil {
+		in, out := &in.HTTPRetryEvents, &out.HTTPRetryEvents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TCPRetryEvents != nil {
+		in, out := &in.TCPRetryEvents, &out.TCPRetryEvents
+		*out = make([]TCPRetryEvent, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRetryPolicy.
+func (in *GRPCRetryPolicy) DeepCopy() *GRPCRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRoute) DeepCopyInto(out *GRPCRoute) {
+	*out = *in
+	in.Action.DeepCopyInto(&out.Action)
+	in.Match.DeepCopyInto(&out.Match)
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(GRPCRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRoute.
+func (in *GRPCRoute) DeepCopy() *GRPCRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRouteMatch) DeepCopyInto(out *GRPCRouteMatch) {
+	*out = *in
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MethodName != nil {
+		in, out := &in.MethodName, &out.MethodName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make([]GRPCRouteMetadata, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteMatch.
+func (in *GRPCRouteMatch) DeepCopy() *GRPCRouteMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRouteMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRouteMetadata) DeepCopyInto(out *GRPCRouteMetadata) {
+	*out = *in
+	if in.Invert != nil {
+		in, out := &in.Invert, &out.Invert
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(HeaderMatchMethod)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteMetadata.
+func (in *GRPCRouteMetadata) DeepCopy() *GRPCRouteMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRouteMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRetryPolicy) DeepCopyInto(out *HTTPRetryPolicy) {
+	*out = *in
+	out.PerRetryTimeout = in.PerRetryTimeout
+	if in.HTTPRetryEvents != nil {
+		in, out := &in.HTTPRetryEvents, &out.HTTPRetryEvents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TCPRetryEvents != nil {
+		in, out := &in.TCPRetryEvents, &out.TCPRetryEvents
+		*out = make([]TCPRetryEvent, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRetryPolicy.
+func (in *HTTPRetryPolicy) DeepCopy() *HTTPRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
+	*out = *in
+	in.Action.DeepCopyInto(&out.Action)
+	in.Match.DeepCopyInto(&out.Match)
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(HTTPRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRoute.
+func (in *HTTPRoute) DeepCopy() *HTTPRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteHeader) DeepCopyInto(out *HTTPRouteHeader) {
+	*out = *in
+	if in.Invert != nil {
+		in, out := &in.Invert, &out.Invert
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(HeaderMatchMethod)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteHeader.
+func (in *HTTPRouteHeader) DeepCopy() *HTTPRouteHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteMatch) DeepCopyInto(out *HTTPRouteMatch) {
+	*out = *in
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(string)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPRouteHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteMatch.
+func (in *HTTPRouteMatch) DeepCopy() *HTTPRouteMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderMatchMethod) DeepCopyInto(out *HeaderMatchMethod) {
+	*out = *in
+	if in.Exact != nil {
+		in, out := &in.Exact, &out.Exact
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Suffix != nil {
+		in, out := &in.Suffix, &out.Suffix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Regex != nil {
+		in, out := &in.Regex, &out.Regex
+		*out = new(string)
+		**out = **in
+	}
+	if in.Range != nil {
+		in, out := &in.Range, &out.Range
+		*out = new(MatchRange)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderMatchMethod.
+func (in *HeaderMatchMethod) DeepCopy() *HeaderMatchMethod {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderMatchMethod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckPolicy) DeepCopyInto(out *HealthCheckPolicy) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckPolicy.
+func (in *HealthCheckPolicy) DeepCopy() *HealthCheckPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Listener) DeepCopyInto(out *Listener) {
+	*out = *in
+	out.PortMapping = in.PortMapping
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheckPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ListenerTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Listener.
+func (in *Listener) DeepCopy() *Listener {
+	if in == nil {
+		return nil
+	}
+	out := new(Listener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerTLS) DeepCopyInto(out *ListenerTLS) {
+	*out = *in
+	in.Certificate.DeepCopyInto(&out.Certificate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerTLS.
+func (in *ListenerTLS) DeepCopy() *ListenerTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerTLSACMCertificate) DeepCopyInto(out *ListenerTLSACMCertificate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerTLSACMCertificate.
+func (in *ListenerTLSACMCertificate) DeepCopy() *ListenerTLSACMCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerTLSACMCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerTLSCertificate) DeepCopyInto(out *ListenerTLSCertificate) {
+	*out = *in
+	if in.ACM != nil {
+		in, out := &in.ACM, &out.ACM
+		*out = new(ListenerTLSACMCertificate)
+		**out = **in
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(ListenerTLSFileCertificate)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerTLSCertificate.
+func (in *ListenerTLSCertificate) DeepCopy() *ListenerTLSCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerTLSCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerTLSFileCertificate) DeepCopyInto(out *ListenerTLSFileCertificate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerTLSFileCertificate.
+func (in *ListenerTLSFileCertificate) DeepCopy() *ListenerTLSFileCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerTLSFileCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Logging) DeepCopyInto(out *Logging) {
+	*out = *in
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(AccessLog)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
+func (in *Logging) DeepCopy() *Logging {
+	if in == nil {
+		return nil
+	}
+	out := new(Logging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchRange) DeepCopyInto(out *MatchRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchRange.
+func (in *MatchRange) DeepCopy() *MatchRange {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Mesh) DeepCopyInto(out *Mesh) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mesh.
+func (in *Mesh) DeepCopy() *Mesh {
+	if in == nil {
+		return nil
+	}
+	out := new(Mesh)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Mesh) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshList) DeepCopyInto(out *MeshList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Mesh, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshList.
+func (in *MeshList) DeepCopy() *MeshList {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshObservation) DeepCopyInto(out *MeshObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshObservation.
+func (in *MeshObservation) DeepCopy() *MeshObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshParameters) DeepCopyInto(out *MeshParameters) {
+	*out = *in
+	if in.EgressFilter != nil {
+		in, out := &in.EgressFilter, &out.EgressFilter
+		*out = new(EgressFilter)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshParameters.
+func (in *MeshParameters) DeepCopy() *MeshParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshSpec) DeepCopyInto(out *MeshSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshSpec.
+func (in *MeshSpec) DeepCopy() *MeshSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshStatus) DeepCopyInto(out *MeshStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshStatus.
+func (in *MeshStatus) DeepCopy() *MeshStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortMapping) DeepCopyInto(out *PortMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortMapping.
+func (in *PortMapping) DeepCopy() *PortMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(PortMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Route) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteAction) DeepCopyInto(out *RouteAction) {
+	*out = *in
+	if in.WeightedTargets != nil {
+		in, out := &in.WeightedTargets, &out.WeightedTargets
+		*out = make([]WeightedTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteAction.
+func (in *RouteAction) DeepCopy() *RouteAction {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteList) DeepCopyInto(out *RouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Route, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteList.
+func (in *RouteList) DeepCopy() *RouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteObservation) DeepCopyInto(out *RouteObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteObservation.
+func (in *RouteObservation) DeepCopy() *RouteObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteParameters) DeepCopyInto(out *RouteParameters) {
+	*out = *in
+	if in.MeshName != nil {
+		in, out := &in.MeshName, &out.MeshName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MeshNameRef != nil {
+		in, out := &in.MeshNameRef, &out.MeshNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.MeshNameSelector != nil {
+		in, out := &in.MeshNameSelector, &out.MeshNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MeshOwner != nil {
+		in, out := &in.MeshOwner, &out.MeshOwner
+		*out = new(string)
+		**out = **in
+	}
+	if in.VirtualRouterName != nil {
+		in, out := &in.VirtualRouterName, &out.VirtualRouterName
+		*out = new(string)
+		**out = **in
+	}
+	if in.VirtualRouterNameRef != nil {
+		in, out := &in.VirtualRouterNameRef, &out.VirtualRouterNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.VirtualRouterNameSelector != nil {
+		in, out := &in.VirtualRouterNameSelector, &out.VirtualRouterNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.HTTPRoute != nil {
+		in, out := &in.HTTPRoute, &out.HTTPRoute
+		*out = new(HTTPRoute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP2Route != nil {
+		in, out := &in.HTTP2Route, &out.HTTP2Route
+		*out = new(HTTPRoute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPCRoute != nil {
+		in, out := &in.GRPCRoute, &out.GRPCRoute
+		*out = new(GRPCRoute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TCPRoute != nil {
+		in, out := &in.TCPRoute, &out.TCPRoute
+		*out = new(TCPRoute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteParameters.
+func (in *RouteParameters) DeepCopy() *RouteParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
+func (in *RouteSpec) DeepCopy() *RouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteStatus) DeepCopyInto(out *RouteStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteStatus.
+func (in *RouteStatus) DeepCopy() *RouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceDiscovery) DeepCopyInto(out *ServiceDiscovery) {
+	*out = *in
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSServiceDiscovery)
+		**out = **in
+	}
+	if in.AWSCloudMap != nil {
+		in, out := &in.AWSCloudMap, &out.AWSCloudMap
+		*out = new(AWSCloudMapServiceDiscovery)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceDiscovery.
+func (in *ServiceDiscovery) DeepCopy() *ServiceDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPRoute) DeepCopyInto(out *TCPRoute) {
+	*out = *in
+	in.Action.DeepCopyInto(&out.Action)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPRoute.
+func (in *TCPRoute) DeepCopy() *TCPRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSValidationContext) DeepCopyInto(out *TLSValidationContext) {
+	*out = *in
+	in.Trust.DeepCopyInto(&out.Trust)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSValidationContext.
+func (in *TLSValidationContext) DeepCopy() *TLSValidationContext {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSValidationContext)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSValidationContextACMTrust) DeepCopyInto(out *TLSValidationContextACMTrust) {
+	*out = *in
+	if in.CertificateAuthorityARNs != nil {
+		in, out := &in.CertificateAuthorityARNs, &out.CertificateAuthorityARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSValidationContextACMTrust.
+func (in *TLSValidationContextACMTrust) DeepCopy() *TLSValidationContextACMTrust {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSValidationContextACMTrust)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSValidationContextFileTrust) DeepCopyInto(out *TLSValidationContextFileTrust) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSValidationContextFileTrust.
+func (in *TLSValidationContextFileTrust) DeepCopy() *TLSValidationContextFileTrust {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSValidationContextFileTrust)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSValidationContextTrust) DeepCopyInto(out *TLSValidationContextTrust) {
+	*out = *in
+	if in.ACM != nil {
+		in, out := &in.ACM, &out.ACM
+		*out = new(TLSValidationContextACMTrust)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(TLSValidationContextFileTrust)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSValidationContextTrust.
+func (in *TLSValidationContextTrust) DeepCopy() *TLSValidationContextTrust {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSValidationContextTrust)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNode) DeepCopyInto(out *VirtualNode) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNode.
+func (in *VirtualNode) DeepCopy() *VirtualNode {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualNode) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNodeList) DeepCopyInto(out *VirtualNodeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNodeList.
+func (in *VirtualNodeList) DeepCopy() *VirtualNodeList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNodeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualNodeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNodeObservation) DeepCopyInto(out *VirtualNodeObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNodeObservation.
+func (in *VirtualNodeObservation) DeepCopy() *VirtualNodeObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNodeObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNodeParameters) DeepCopyInto(out *VirtualNodeParameters) {
+	*out = *in
+	if in.MeshName != nil {
+		in, out := &in.MeshName, &out.MeshName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MeshNameRef != nil {
+		in, out := &in.MeshNameRef, &out.MeshNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.MeshNameSelector != nil {
+		in, out := &in.MeshNameSelector, &out.MeshNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MeshOwner != nil {
+		in, out := &in.MeshOwner, &out.MeshOwner
+		*out = new(string)
+		**out = **in
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]Listener, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]Backend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BackendDefaults != nil {
+		in, out := &in.BackendDefaults, &out.BackendDefaults
+		*out = new(BackendDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceDiscovery != nil {
+		in, out := &in.ServiceDiscovery, &out.ServiceDiscovery
+		*out = new(ServiceDiscovery)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNodeParameters.
+func (in *VirtualNodeParameters) DeepCopy() *VirtualNodeParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNodeParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNodeServiceProvider) DeepCopyInto(out *VirtualNodeServiceProvider) {
+	*out = *in
+	if in.VirtualNodeName != nil {
+		in, out := &in.VirtualNodeName, &out.VirtualNodeName
+		*out = new(string)
+		**out = **in
+	}
+	if in.VirtualNodeNameRef != nil {
+		in, out := &in.VirtualNodeNameRef, &out.VirtualNodeNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.VirtualNodeNameSelector != nil {
+		in, out := &in.VirtualNodeNameSelector, &out.VirtualNodeNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNodeServiceProvider.
+func (in *VirtualNodeServiceProvider) DeepCopy() *VirtualNodeServiceProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNodeServiceProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNodeSpec) DeepCopyInto(out *VirtualNodeSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNodeSpec.
+func (in *VirtualNodeSpec) DeepCopy() *VirtualNodeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNodeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNodeStatus) DeepCopyInto(out *VirtualNodeStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNodeStatus.
+func (in *VirtualNodeStatus) DeepCopy() *VirtualNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualRouter) DeepCopyInto(out *VirtualRouter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualRouter.
+func (in *VirtualRouter) DeepCopy() *VirtualRouter {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualRouter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualRouter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualRouterList) DeepCopyInto(out *VirtualRouterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualRouter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualRouterList.
+func (in *VirtualRouterList) DeepCopy() *VirtualRouterList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualRouterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualRouterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualRouterListener) DeepCopyInto(out *VirtualRouterListener) {
+	*out = *in
+	out.PortMapping = in.PortMapping
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualRouterListener.
+func (in *VirtualRouterListener) DeepCopy() *VirtualRouterListener {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualRouterListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualRouterObservation) DeepCopyInto(out *VirtualRouterObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualRouterObservation.
+func (in *VirtualRouterObservation) DeepCopy() *VirtualRouterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualRouterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualRouterParameters) DeepCopyInto(out *VirtualRouterParameters) {
+	*out = *in
+	if in.MeshName != nil {
+		in, out := &in.MeshName, &out.MeshName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MeshNameRef != nil {
+		in, out := &in.MeshNameRef, &out.MeshNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.MeshNameSelector != nil {
+		in, out := &in.MeshNameSelector, &out.MeshNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MeshOwner != nil {
+		in, out := &in.MeshOwner, &out.MeshOwner
+		*out = new(string)
+		**out = **in
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]VirtualRouterListener, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualRouterParameters.
+func (in *VirtualRouterParameters) DeepCopy() *VirtualRouterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualRouterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualRouterServiceProvider) DeepCopyInto(out *VirtualRouterServiceProvider) {
+	*out = *in
+	if in.VirtualRouterName != nil {
+		in, out := &in.VirtualRouterName, &out.VirtualRouterName
+		*out = new(string)
+		**out = **in
+	}
+	if in.VirtualRouterNameRef != nil {
+		in, out := &in.VirtualRouterNameRef, &out.VirtualRouterNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.VirtualRouterNameSelector != nil {
+		in, out := &in.VirtualRouterNameSelector, &out.VirtualRouterNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualRouterServiceProvider.
+func (in *VirtualRouterServiceProvider) DeepCopy() *VirtualRouterServiceProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualRouterServiceProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualRouterSpec) DeepCopyInto(out *VirtualRouterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualRouterSpec.
+func (in *VirtualRouterSpec) DeepCopy() *VirtualRouterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualRouterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualRouterStatus) DeepCopyInto(out *VirtualRouterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualRouterStatus.
+func (in *VirtualRouterStatus) DeepCopy() *VirtualRouterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualRouterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualService) DeepCopyInto(out *VirtualService) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualService.
+func (in *VirtualService) DeepCopy() *VirtualService {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualService) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualServiceBackend) DeepCopyInto(out *VirtualServiceBackend) {
+	*out = *in
+	if in.ClientPolicy != nil {
+		in, out := &in.ClientPolicy, &out.ClientPolicy
+		*out = new(ClientPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualServiceBackend.
+func (in *VirtualServiceBackend) DeepCopy() *VirtualServiceBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualServiceBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualServiceList) DeepCopyInto(out *VirtualServiceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualServiceList.
+func (in *VirtualServiceList) DeepCopy() *VirtualServiceList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualServiceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualServiceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualServiceObservation) DeepCopyInto(out *VirtualServiceObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualServiceObservation.
+func (in *VirtualServiceObservation) DeepCopy() *VirtualServiceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualServiceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualServiceParameters) DeepCopyInto(out *VirtualServiceParameters) {
+	*out = *in
+	if in.MeshName != nil {
+		in, out := &in.MeshName, &out.MeshName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MeshNameRef != nil {
+		in, out := &in.MeshNameRef, &out.MeshNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.MeshNameSelector != nil {
+		in, out := &in.MeshNameSelector, &out.MeshNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MeshOwner != nil {
+		in, out := &in.MeshOwner, &out.MeshOwner
+		*out = new(string)
+		**out = **in
+	}
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(VirtualServiceProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualServiceParameters.
+func (in *VirtualServiceParameters) DeepCopy() *VirtualServiceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualServiceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualServiceProvider) DeepCopyInto(out *VirtualServiceProvider) {
+	*out = *in
+	if in.VirtualNode != nil {
+		in, out := &in.VirtualNode, &out.VirtualNode
+		*out = new(VirtualNodeServiceProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VirtualRouter != nil {
+		in, out := &in.VirtualRouter, &out.VirtualRouter
+		*out = new(VirtualRouterServiceProvider)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualServiceProvider.
+func (in *VirtualServiceProvider) DeepCopy() *VirtualServiceProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualServiceProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualServiceSpec) DeepCopyInto(out *VirtualServiceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualServiceSpec.
+func (in *VirtualServiceSpec) DeepCopy() *VirtualServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualServiceStatus) DeepCopyInto(out *VirtualServiceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualServiceStatus.
+func (in *VirtualServiceStatus) DeepCopy() *VirtualServiceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualServiceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedTarget) DeepCopyInto(out *WeightedTarget) {
+	*out = *in
+	if in.VirtualNodeName != nil {
+		in, out := &in.VirtualNodeName, &out.VirtualNodeName
+		*out = new(string)
+		**out = **in
+	}
+	if in.VirtualNodeNameRef != nil {
+		in, out := &in.VirtualNodeNameRef, &out.VirtualNodeNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.VirtualNodeNameSelector != nil {
+		in, out := &in.VirtualNodeNameSelector, &out.VirtualNodeNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedTarget.
+func (in *WeightedTarget) DeepCopy() *WeightedTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedTarget)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// NamedQueryParameters define the desired state of an AWS Athena named
+// query. A named query can't be modified once it is created.
+type NamedQueryParameters struct {
+	// Region is the region you'd like your NamedQuery to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name of the query.
+	// +immutable
+	Name string `json:"name"`
+
+	// Database the query is run against.
+	// +immutable
+	Database string `json:"database"`
+
+	// Description of the query.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// QueryString is the SQL query statements that comprise the query.
+	// +immutable
+	QueryString string `json:"queryString"`
+
+	// WorkGroup is the name of the workgroup the query is created in. The
+	// primary workgroup is used if it isn't set.
+	// +immutable
+	// +optional
+	WorkGroup *string `json:"workGroup,omitempty"`
+
+	// WorkGroupRef references a WorkGroup to set the WorkGroup.
+	// +optional
+	WorkGroupRef *runtimev1alpha1.Reference `json:"workGroupRef,omitempty"`
+
+	// WorkGroupSelector selects a reference to a WorkGroup to set the
+	// WorkGroup.
+	// +optional
+	WorkGroupSelector *runtimev1alpha1.Selector `json:"workGroupSelector,omitempty"`
+}
+
+// A NamedQuerySpec defines the desired state of a NamedQuery.
+type NamedQuerySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  NamedQueryParameters `json:"forProvider"`
+}
+
+// A NamedQueryStatus represents the observed state of a NamedQuery.
+type NamedQueryStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NamedQuery is a managed resource that represents an AWS Athena named
+// query. Its external name is the ID of the named query.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NamedQuery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NamedQuerySpec   `json:"spec"`
+	Status NamedQueryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NamedQueryList contains a list of NamedQueries
+type NamedQueryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamedQuery `json:"items"`
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// BucketOutputLocation returns the S3 URL of the root of a Bucket, e.g.
+// s3://example-bucket/.
+func BucketOutputLocation() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		name := meta.GetExternalName(mg)
+		if name == "" {
+			return ""
+		}
+		return "s3://" + name + "/"
+	}
+}
+
+// ResolveReferences of this WorkGroup
+func (mg *WorkGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	if mg.Spec.ForProvider.Configuration == nil || mg.Spec.ForProvider.Configuration.ResultConfiguration == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, mg)
+	rc := mg.Spec.ForProvider.Configuration.ResultConfiguration
+
+	// Resolve spec.forProvider.configuration.resultConfiguration.outputLocation
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(rc.OutputLocation),
+		Reference:    rc.OutputLocationBucketRef,
+		Selector:     rc.OutputLocationBucketSelector,
+		To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+		Extract:      BucketOutputLocation(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.configuration.resultConfiguration.outputLocation")
+	}
+	rc.OutputLocation = reference.ToPtrValue(rsp.ResolvedValue)
+	rc.OutputLocationBucketRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this NamedQuery
+func (mg *NamedQuery) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.workGroup
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.WorkGroup),
+		Reference:    mg.Spec.ForProvider.WorkGroupRef,
+		Selector:     mg.Spec.ForProvider.WorkGroupSelector,
+		To:           reference.To{Managed: &WorkGroup{}, List: &WorkGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.workGroup")
+	}
+	mg.Spec.ForProvider.WorkGroup = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.WorkGroupRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag is a key-value pair that is assigned to a workgroup.
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// EncryptionConfiguration is the encryption of the query results that are
+// stored in Amazon S3.
+type EncryptionConfiguration struct {
+	// EncryptionOption is whether server-side encryption with Amazon
+	// S3-managed keys (SSE_S3), server-side encryption with KMS-managed keys
+	// (SSE_KMS) or client-side encryption with KMS-managed keys (CSE_KMS) is
+	// used.
+	// +kubebuilder:validation:Enum=SSE_S3;SSE_KMS;CSE_KMS
+	EncryptionOption string `json:"encryptionOption"`
+
+	// KMSKey is the ARN or ID of the KMS key that is used for SSE_KMS and
+	// CSE_KMS.
+	// +optional
+	KMSKey *string `json:"kmsKey,omitempty"`
+}
+
+// ResultConfiguration is the location in Amazon S3 where query results are
+// stored and their encryption.
+type ResultConfiguration struct {
+	// OutputLocation is the location in Amazon S3 where query results are
+	// stored, such as s3://path/to/query/bucket/.
+	// +optional
+	OutputLocation *string `json:"outputLocation,omitempty"`
+
+	// OutputLocationBucketRef references a Bucket whose root is used as the
+	// OutputLocation.
+	// +optional
+	OutputLocationBucketRef *runtimev1alpha1.Reference `json:"outputLocationBucketRef,omitempty"`
+
+	// OutputLocationBucketSelector selects a reference to a Bucket whose root
+	// is used as the OutputLocation.
+	// +optional
+	OutputLocationBucketSelector *runtimev1alpha1.Selector `json:"outputLocationBucketSelector,omitempty"`
+
+	// EncryptionConfiguration is the encryption of the query results.
+	// +optional
+	EncryptionConfiguration *EncryptionConfiguration `json:"encryptionConfiguration,omitempty"`
+}
+
+// WorkGroupConfiguration is the configuration of a workgroup.
+type WorkGroupConfiguration struct {
+	// BytesScannedCutoffPerQuery is the upper limit of bytes a single query
+	// in the workgroup is allowed to scan.
+	// +kubebuilder:validation:Minimum=10000000
+	// +optional
+	BytesScannedCutoffPerQuery *int64 `json:"bytesScannedCutoffPerQuery,omitempty"`
+
+	// EnforceWorkGroupConfiguration indicates whether the settings of the
+	// workgroup override client-side settings.
+	// +optional
+	EnforceWorkGroupConfiguration *bool `json:"enforceWorkGroupConfiguration,omitempty"`
+
+	// PublishCloudWatchMetricsEnabled indicates whether Amazon CloudWatch
+	// metrics are enabled for the workgroup.
+	// +optional
+	PublishCloudWatchMetricsEnabled *bool `json:"publishCloudWatchMetricsEnabled,omitempty"`
+
+	// RequesterPaysEnabled indicates whether members of the workgroup can
+	// query Amazon S3 Requester Pays buckets.
+	// +optional
+	RequesterPaysEnabled *bool `json:"requesterPaysEnabled,omitempty"`
+
+	// ResultConfiguration is the location and encryption of the query
+	// results.
+	// +optional
+	ResultConfiguration *ResultConfiguration `json:"resultConfiguration,omitempty"`
+}
+
+// WorkGroupParameters define the desired state of an AWS Athena workgroup.
+type WorkGroupParameters struct {
+	// Region is the region you'd like your WorkGroup to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description of the workgroup.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Configuration of the workgroup.
+	// +optional
+	Configuration *WorkGroupConfiguration `json:"configuration,omitempty"`
+
+	// State of the workgroup. Queries can't be run in a disabled workgroup.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	State *string `json:"state,omitempty"`
+
+	// Tags to assign to the workgroup when it is created.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// WorkGroupObservation is the observed state of a WorkGroup.
+type WorkGroupObservation struct {
+	// State of the workgroup.
+	State string `json:"state,omitempty"`
+}
+
+// A WorkGroupSpec defines the desired state of a WorkGroup.
+type WorkGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  WorkGroupParameters `json:"forProvider"`
+}
+
+// A WorkGroupStatus represents the observed state of a WorkGroup.
+type WorkGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     WorkGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WorkGroup is a managed resource that represents an AWS Athena workgroup.
+// Its external name is the name of the workgroup.
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type WorkGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkGroupSpec   `json:"spec"`
+	Status WorkGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkGroupList contains a list of WorkGroups
+type WorkGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkGroup `json:"items"`
+}
@@ -0,0 +1,406 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionConfiguration) DeepCopyInto(out *EncryptionConfiguration) {
+	*out = *in
+	if in.KMSKey != nil {
+		in, out := &in.KMSKey, &out.KMSKey
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionConfiguration.
+func (in *EncryptionConfiguration) DeepCopy() *EncryptionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedQuery) DeepCopyInto(out *NamedQuery) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedQuery.
+func (in *NamedQuery) DeepCopy() *NamedQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamedQuery) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedQueryList) DeepCopyInto(out *NamedQueryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NamedQuery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedQueryList.
+func (in *NamedQueryList) DeepCopy() *NamedQueryList {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedQueryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamedQueryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedQueryParameters) DeepCopyInto(out *NamedQueryParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.WorkGroup != nil {
+		in, out := &in.WorkGroup, &out.WorkGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.WorkGroupRef != nil {
+		in, out := &in.WorkGroupRef, &out.WorkGroupRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.WorkGroupSelector != nil {
+		in, out := &in.WorkGroupSelector, &out.WorkGroupSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedQueryParameters.
+func (in *NamedQueryParameters) DeepCopy() *NamedQueryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedQueryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedQuerySpec) DeepCopyInto(out *NamedQuerySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedQuerySpec.
+func (in *NamedQuerySpec) DeepCopy() *NamedQuerySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedQuerySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedQueryStatus) DeepCopyInto(out *NamedQueryStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedQueryStatus.
+func (in *NamedQueryStatus) DeepCopy() *NamedQueryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedQueryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultConfiguration) DeepCopyInto(out *ResultConfiguration) {
+	*out = *in
+	if in.OutputLocation != nil {
+		in, out := &in.OutputLocation, &out.OutputLocation
+		*out = new(string)
+		**out = **in
+	}
+	if in.OutputLocationBucketRef != nil {
+		in, out := &in.OutputLocationBucketRef, &out.OutputLocationBucketRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.OutputLocationBucketSelector != nil {
+		in, out := &in.OutputLocationBucketSelector, &out.OutputLocationBucketSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EncryptionConfiguration != nil {
+		in, out := &in.EncryptionConfiguration, &out.EncryptionConfiguration
+		*out = new(EncryptionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultConfiguration.
+func (in *ResultConfiguration) DeepCopy() *ResultConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkGroup) DeepCopyInto(out *WorkGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkGroup.
+func (in *WorkGroup) DeepCopy() *WorkGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkGroupConfiguration) DeepCopyInto(out *WorkGroupConfiguration) {
+	*out = *in
+	if in.BytesScannedCutoffPerQuery != nil {
+		in, out := &in.BytesScannedCutoffPerQuery, &out.BytesScannedCutoffPerQuery
+		*out = new(int64)
+		**out = **in
+	}
+	if in.EnforceWorkGroupConfiguration != nil {
+		in, out := &in.EnforceWorkGroupConfiguration, &out.EnforceWorkGroupConfiguration
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PublishCloudWatchMetricsEnabled != nil {
+		in, out := &in.PublishCloudWatchMetricsEnabled, &out.PublishCloudWatchMetricsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequesterPaysEnabled != nil {
+		in, out := &in.RequesterPaysEnabled, &out.RequesterPaysEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResultConfiguration != nil {
+		in, out := &in.ResultConfiguration, &out.ResultConfiguration
+		*out = new(ResultConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkGroupConfiguration.
+func (in *WorkGroupConfiguration) DeepCopy() *WorkGroupConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkGroupConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkGroupList) DeepCopyInto(out *WorkGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkGroupList.
+func (in *WorkGroupList) DeepCopy() *WorkGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkGroupObservation) DeepCopyInto(out *WorkGroupObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkGroupObservation.
+func (in *WorkGroupObservation) DeepCopy() *WorkGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkGroupParameters) DeepCopyInto(out *WorkGroupParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(WorkGroupConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkGroupParameters.
+func (in *WorkGroupParameters) DeepCopy() *WorkGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkGroupSpec) DeepCopyInto(out *WorkGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkGroupSpec.
+func (in *WorkGroupSpec) DeepCopy() *WorkGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkGroupStatus) DeepCopyInto(out *WorkGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkGroupStatus.
+func (in *WorkGroupStatus) DeepCopy() *WorkGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
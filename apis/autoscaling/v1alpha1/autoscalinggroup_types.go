@@ -0,0 +1,290 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag is a tag of an AutoScalingGroup.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// PropagateAtLaunch indicates whether the tag is applied to the
+	// instances launched by the group.
+	// +optional
+	PropagateAtLaunch *bool `json:"propagateAtLaunch,omitempty"`
+}
+
+// LaunchTemplateSpecification identifies the launch template, and its
+// version, the instances of an AutoScalingGroup are launched from.
+type LaunchTemplateSpecification struct {
+	// LaunchTemplateID is the ID of the launch template.
+	// +optional
+	LaunchTemplateID *string `json:"launchTemplateId,omitempty"`
+
+	// LaunchTemplateIDRef references a LaunchTemplate to retrieve its ID.
+	// +optional
+	LaunchTemplateIDRef *runtimev1alpha1.Reference `json:"launchTemplateIdRef,omitempty"`
+
+	// LaunchTemplateIDSelector selects a reference to a LaunchTemplate to
+	// retrieve its ID.
+	// +optional
+	LaunchTemplateIDSelector *runtimev1alpha1.Selector `json:"launchTemplateIdSelector,omitempty"`
+
+	// Version of the launch template, either a version number, $Latest or
+	// $Default. Defaults to $Default.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// LaunchTemplateOverrides override the instance type of a launch template
+// in a MixedInstancesPolicy.
+type LaunchTemplateOverrides struct {
+	// InstanceType is the type of the instances.
+	InstanceType string `json:"instanceType"`
+
+	// WeightedCapacity is the number of capacity units an instance of this
+	// type counts for towards the desired capacity of the group.
+	// +optional
+	WeightedCapacity *string `json:"weightedCapacity,omitempty"`
+}
+
+// MixedInstancesLaunchTemplate is the launch template, and the instance
+// types it is overridden with, of a MixedInstancesPolicy.
+type MixedInstancesLaunchTemplate struct {
+	// LaunchTemplateSpecification identifies the launch template.
+	LaunchTemplateSpecification LaunchTemplateSpecification `json:"launchTemplateSpecification"`
+
+	// Overrides are the instance types the group launches instances of.
+	// +optional
+	Overrides []LaunchTemplateOverrides `json:"overrides,omitempty"`
+}
+
+// InstancesDistribution configures the distribution of On-Demand and Spot
+// instances of a MixedInstancesPolicy.
+type InstancesDistribution struct {
+	// OnDemandAllocationStrategy is how the instance types are allocated
+	// to fulfill the On-Demand capacity.
+	// +kubebuilder:validation:Enum=prioritized
+	// +optional
+	OnDemandAllocationStrategy *string `json:"onDemandAllocationStrategy,omitempty"`
+
+	// OnDemandBaseCapacity is the minimum capacity fulfilled by On-Demand
+	// instances.
+	// +optional
+	OnDemandBaseCapacity *int64 `json:"onDemandBaseCapacity,omitempty"`
+
+	// OnDemandPercentageAboveBaseCapacity is the percentage of On-Demand
+	// instances in the capacity above OnDemandBaseCapacity.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	OnDemandPercentageAboveBaseCapacity *int64 `json:"onDemandPercentageAboveBaseCapacity,omitempty"`
+
+	// SpotAllocationStrategy is how the instance types are allocated to
+	// fulfill the Spot capacity.
+	// +kubebuilder:validation:Enum=lowest-price;capacity-optimized
+	// +optional
+	SpotAllocationStrategy *string `json:"spotAllocationStrategy,omitempty"`
+
+	// SpotInstancePools is the number of the lowest priced Spot instance
+	// pools the Spot capacity is allocated across. It is only used with the
+	// lowest-price allocation strategy.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	// +optional
+	SpotInstancePools *int64 `json:"spotInstancePools,omitempty"`
+
+	// SpotMaxPrice is the maximum price per unit hour to pay for a Spot
+	// instance. Defaults to the On-Demand price.
+	// +optional
+	SpotMaxPrice *string `json:"spotMaxPrice,omitempty"`
+}
+
+// MixedInstancesPolicy configures a group that launches instances of
+// several instance types and purchase options.
+type MixedInstancesPolicy struct {
+	// LaunchTemplate is the launch template and the instance types of the
+	// group.
+	LaunchTemplate MixedInstancesLaunchTemplate `json:"launchTemplate"`
+
+	// InstancesDistribution configures the distribution of On-Demand and
+	// Spot instances.
+	// +optional
+	InstancesDistribution *InstancesDistribution `json:"instancesDistribution,omitempty"`
+}
+
+// AutoScalingGroupParameters define the desired state of an AWS Auto
+// Scaling Group.
+type AutoScalingGroupParameters struct {
+	// Region is the region you'd like your AutoScalingGroup to be created
+	// in.
+	// +immutable
+	Region string `json:"region"`
+
+	// MinSize is the minimum size of the group.
+	// +kubebuilder:validation:Minimum=0
+	MinSize int64 `json:"minSize"`
+
+	// MaxSize is the maximum size of the group.
+	// +kubebuilder:validation:Minimum=0
+	MaxSize int64 `json:"maxSize"`
+
+	// DesiredCapacity is the number of instances the group should have. It
+	// must be between MinSize and MaxSize and defaults to MinSize.
+	// +optional
+	DesiredCapacity *int64 `json:"desiredCapacity,omitempty"`
+
+	// LaunchTemplate is the launch template the instances are launched
+	// from. Either LaunchTemplate or MixedInstancesPolicy is required.
+	// +optional
+	LaunchTemplate *LaunchTemplateSpecification `json:"launchTemplate,omitempty"`
+
+	// MixedInstancesPolicy configures the group to launch instances of
+	// several instance types and purchase options.
+	// +optional
+	MixedInstancesPolicy *MixedInstancesPolicy `json:"mixedInstancesPolicy,omitempty"`
+
+	// AvailabilityZones the instances are launched in. It is only required
+	// if no SubnetIDs are given.
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets the instances are launched in.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// TargetGroupARNs are the ARNs of the elbv2 target groups the instances
+	// are registered with.
+	// +optional
+	TargetGroupARNs []string `json:"targetGroupArns,omitempty"`
+
+	// TargetGroupARNRefs references TargetGroups to retrieve their ARNs.
+	// +optional
+	TargetGroupARNRefs []runtimev1alpha1.Reference `json:"targetGroupArnRefs,omitempty"`
+
+	// TargetGroupARNSelector selects references to TargetGroups to retrieve
+	// their ARNs.
+	// +optional
+	TargetGroupARNSelector *runtimev1alpha1.Selector `json:"targetGroupArnSelector,omitempty"`
+
+	// HealthCheckType is the service used to check the health of the
+	// instances.
+	// +kubebuilder:validation:Enum=EC2;ELB
+	// +optional
+	HealthCheckType *string `json:"healthCheckType,omitempty"`
+
+	// HealthCheckGracePeriod is the number of seconds after an instance
+	// comes into service before its health is checked.
+	// +optional
+	HealthCheckGracePeriod *int64 `json:"healthCheckGracePeriod,omitempty"`
+
+	// DefaultCooldown is the number of seconds after a scaling activity
+	// completes before another one can start.
+	// +optional
+	DefaultCooldown *int64 `json:"defaultCooldown,omitempty"`
+
+	// Tags to assign to the group.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An AutoScalingGroupSpec defines the desired state of an AutoScalingGroup.
+type AutoScalingGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AutoScalingGroupParameters `json:"forProvider"`
+}
+
+// Instance is an instance of an AutoScalingGroup.
+type Instance struct {
+	// InstanceID is the ID of the instance.
+	InstanceID string `json:"instanceId"`
+
+	// AvailabilityZone of the instance.
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// LifecycleState of the instance, e.g. InService.
+	LifecycleState string `json:"lifecycleState,omitempty"`
+
+	// HealthStatus of the instance, either Healthy or Unhealthy.
+	HealthStatus string `json:"healthStatus,omitempty"`
+}
+
+// AutoScalingGroupObservation keeps the state for the external resource.
+type AutoScalingGroupObservation struct {
+	// AutoScalingGroupARN is the ARN of the group.
+	AutoScalingGroupARN string `json:"autoScalingGroupArn,omitempty"`
+
+	// Status of the group. It is only set while the group is being
+	// deleted.
+	Status string `json:"status,omitempty"`
+
+	// Instances of the group.
+	Instances []Instance `json:"instances,omitempty"`
+}
+
+// An AutoScalingGroupStatus represents the observed state of an
+// AutoScalingGroup.
+type AutoScalingGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AutoScalingGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AutoScalingGroup is a managed resource that represents an AWS Auto
+// Scaling Group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="MIN",type="integer",JSONPath=".spec.forProvider.minSize"
+// +kubebuilder:printcolumn:name="MAX",type="integer",JSONPath=".spec.forProvider.maxSize"
+// +kubebuilder:printcolumn:name="DESIRED",type="integer",JSONPath=".spec.forProvider.desiredCapacity"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AutoScalingGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AutoScalingGroupSpec   `json:"spec"`
+	Status AutoScalingGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AutoScalingGroupList contains a list of AutoScalingGroups
+type AutoScalingGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AutoScalingGroup `json:"items"`
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	ec2v1alpha1 "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	elbv2 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+// ResolveReferences of this AutoScalingGroup
+func (mg *AutoScalingGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
+		References:    mg.Spec.ForProvider.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
+		To:            reference.To{Managed: &ec2v1beta1.Subnet{}, List: &ec2v1beta1.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetIds")
+	}
+	mg.Spec.ForProvider.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.targetGroupArns
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.TargetGroupARNs,
+		References:    mg.Spec.ForProvider.TargetGroupARNRefs,
+		Selector:      mg.Spec.ForProvider.TargetGroupARNSelector,
+		To:            reference.To{Managed: &elbv2.TargetGroup{}, List: &elbv2.TargetGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.targetGroupArns")
+	}
+	mg.Spec.ForProvider.TargetGroupARNs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.TargetGroupARNRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.launchTemplate.launchTemplateId
+	if lt := mg.Spec.ForProvider.LaunchTemplate; lt != nil {
+		if err := resolveLaunchTemplateID(ctx, r, lt); err != nil {
+			return errors.Wrap(err, "spec.forProvider.launchTemplate.launchTemplateId")
+		}
+	}
+
+	// Resolve spec.forProvider.mixedInstancesPolicy.launchTemplate.launchTemplateSpecification.launchTemplateId
+	if p := mg.Spec.ForProvider.MixedInstancesPolicy; p != nil {
+		if err := resolveLaunchTemplateID(ctx, r, &p.LaunchTemplate.LaunchTemplateSpecification); err != nil {
+			return errors.Wrap(err, "spec.forProvider.mixedInstancesPolicy.launchTemplate.launchTemplateSpecification.launchTemplateId")
+		}
+	}
+
+	return nil
+}
+
+func resolveLaunchTemplateID(ctx context.Context, r *reference.APIResolver, lt *LaunchTemplateSpecification) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(lt.LaunchTemplateID),
+		Reference:    lt.LaunchTemplateIDRef,
+		Selector:     lt.LaunchTemplateIDSelector,
+		To:           reference.To{Managed: &ec2v1alpha1.LaunchTemplate{}, List: &ec2v1alpha1.LaunchTemplateList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	lt.LaunchTemplateID = reference.ToPtrValue(rsp.ResolvedValue)
+	lt.LaunchTemplateIDRef = rsp.ResolvedReference
+	return nil
+}
@@ -22,27 +22,68 @@ import (
 
 	acmv1alpha1 "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
 	acmpcav1alpha1 "github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
+	amplifyv1alpha1 "github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	appmeshv1alpha1 "github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+	athenav1alpha1 "github.com/crossplane/provider-aws/apis/athena/v1alpha1"
+	autoscalingv1alpha1 "github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
+	backupv1alpha1 "github.com/crossplane/provider-aws/apis/backup/v1alpha1"
+	batchv1alpha1 "github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+	budgetsv1alpha1 "github.com/crossplane/provider-aws/apis/budgets/v1alpha1"
 	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
 	cachev1beta1 "github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	cloudtrailv1alpha1 "github.com/crossplane/provider-aws/apis/cloudtrail/v1alpha1"
+	cloudwatchv1alpha1 "github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	codebuildv1alpha1 "github.com/crossplane/provider-aws/apis/codebuild/v1alpha1"
+	codecommitv1alpha1 "github.com/crossplane/provider-aws/apis/codecommit/v1alpha1"
+	codepipelinev1alpha1 "github.com/crossplane/provider-aws/apis/codepipeline/v1alpha1"
+	configservicev1alpha1 "github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
 	databasev1alpha1 "github.com/crossplane/provider-aws/apis/database/v1alpha1"
 	databasev1beta1 "github.com/crossplane/provider-aws/apis/database/v1beta1"
+	datasyncv1alpha1 "github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+	docdbv1alpha1 "github.com/crossplane/provider-aws/apis/docdb/v1alpha1"
 	ec2v1alpha1 "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	ec2v1alpha4 "github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
 	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	ecrv1alpha1 "github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
 	eksv1alpha1 "github.com/crossplane/provider-aws/apis/eks/v1alpha1"
 	eksv1beta1 "github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	elasticbeanstalkv1alpha1 "github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
 	elasticloadbalancingv1alpha1 "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+	elbv2v1alpha1 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	emrv1alpha1 "github.com/crossplane/provider-aws/apis/emr/v1alpha1"
+	factsv1alpha1 "github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+	fsxv1alpha1 "github.com/crossplane/provider-aws/apis/fsx/v1alpha1"
+	globalacceleratorv1alpha1 "github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	gluev1alpha1 "github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	guarddutyv1alpha1 "github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
 	identityv1alpha1 "github.com/crossplane/provider-aws/apis/identity/v1alpha1"
 	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	lakeformationv1alpha1 "github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+	lightsailv1alpha1 "github.com/crossplane/provider-aws/apis/lightsail/v1alpha1"
+	macie2v1alpha1 "github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
+	mqv1alpha1 "github.com/crossplane/provider-aws/apis/mq/v1alpha1"
+	neptunev1alpha1 "github.com/crossplane/provider-aws/apis/neptune/v1alpha1"
 	notificationv1alpha3 "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	opensearchservicev1alpha1 "github.com/crossplane/provider-aws/apis/opensearchservice/v1alpha1"
+	organizationsv1alpha1 "github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	qldbv1alpha1 "github.com/crossplane/provider-aws/apis/qldb/v1alpha1"
+	ramv1alpha1 "github.com/crossplane/provider-aws/apis/ram/v1alpha1"
 	redshiftv1alpha1 "github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
 	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+	route53resolverv1alpha1 "github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
 	s3v1alpha1 "github.com/crossplane/provider-aws/apis/s3/v1alpha1"
 	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	sagemakerv1alpha1 "github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	securityhubv1alpha1 "github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
+	servicecatalogv1alpha1 "github.com/crossplane/provider-aws/apis/servicecatalog/v1alpha1"
+	servicediscoveryv1alpha1 "github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	shieldv1alpha1 "github.com/crossplane/provider-aws/apis/shield/v1alpha1"
 	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	syntheticsv1alpha1 "github.com/crossplane/provider-aws/apis/synthetics/v1alpha1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+	wafv2v1alpha1 "github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+	xrayv1alpha1 "github.com/crossplane/provider-aws/apis/xray/v1alpha1"
 )
 
 func init() {
@@ -71,6 +112,47 @@ func init() {
 		redshiftv1alpha1.SchemeBuilder.AddToScheme,
 		eksv1alpha1.SchemeBuilder.AddToScheme,
 		ecrv1alpha1.SchemeBuilder.AddToScheme,
+		guarddutyv1alpha1.SchemeBuilder.AddToScheme,
+		wafv2v1alpha1.SchemeBuilder.AddToScheme,
+		configservicev1alpha1.SchemeBuilder.AddToScheme,
+		cloudtrailv1alpha1.SchemeBuilder.AddToScheme,
+		autoscalingv1alpha1.SchemeBuilder.AddToScheme,
+		elbv2v1alpha1.SchemeBuilder.AddToScheme,
+		cloudwatchv1alpha1.SchemeBuilder.AddToScheme,
+		emrv1alpha1.SchemeBuilder.AddToScheme,
+		sagemakerv1alpha1.SchemeBuilder.AddToScheme,
+		neptunev1alpha1.SchemeBuilder.AddToScheme,
+		docdbv1alpha1.SchemeBuilder.AddToScheme,
+		opensearchservicev1alpha1.SchemeBuilder.AddToScheme,
+		mqv1alpha1.SchemeBuilder.AddToScheme,
+		appmeshv1alpha1.SchemeBuilder.AddToScheme,
+		servicediscoveryv1alpha1.SchemeBuilder.AddToScheme,
+		globalacceleratorv1alpha1.SchemeBuilder.AddToScheme,
+		factsv1alpha1.SchemeBuilder.AddToScheme,
+		backupv1alpha1.SchemeBuilder.AddToScheme,
+		fsxv1alpha1.SchemeBuilder.AddToScheme,
+		datasyncv1alpha1.SchemeBuilder.AddToScheme,
+		batchv1alpha1.SchemeBuilder.AddToScheme,
+		codebuildv1alpha1.SchemeBuilder.AddToScheme,
+		codepipelinev1alpha1.SchemeBuilder.AddToScheme,
+		codecommitv1alpha1.SchemeBuilder.AddToScheme,
+		organizationsv1alpha1.SchemeBuilder.AddToScheme,
+		ramv1alpha1.SchemeBuilder.AddToScheme,
+		servicecatalogv1alpha1.SchemeBuilder.AddToScheme,
+		budgetsv1alpha1.SchemeBuilder.AddToScheme,
+		route53resolverv1alpha1.SchemeBuilder.AddToScheme,
+		athenav1alpha1.SchemeBuilder.AddToScheme,
+		gluev1alpha1.SchemeBuilder.AddToScheme,
+		lakeformationv1alpha1.SchemeBuilder.AddToScheme,
+		syntheticsv1alpha1.SchemeBuilder.AddToScheme,
+		xrayv1alpha1.SchemeBuilder.AddToScheme,
+		securityhubv1alpha1.SchemeBuilder.AddToScheme,
+		macie2v1alpha1.SchemeBuilder.AddToScheme,
+		shieldv1alpha1.SchemeBuilder.AddToScheme,
+		qldbv1alpha1.SchemeBuilder.AddToScheme,
+		amplifyv1alpha1.SchemeBuilder.AddToScheme,
+		lightsailv1alpha1.SchemeBuilder.AddToScheme,
+		elasticbeanstalkv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
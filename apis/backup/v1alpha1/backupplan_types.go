@@ -0,0 +1,185 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Lifecycle defines when the recovery points of a backup rule transition to
+// cold storage and when they expire.
+type Lifecycle struct {
+	// MoveToColdStorageAfterDays is the number of days after creation that a
+	// recovery point is moved to cold storage.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MoveToColdStorageAfterDays *int64 `json:"moveToColdStorageAfterDays,omitempty"`
+
+	// DeleteAfterDays is the number of days after creation that a recovery
+	// point is deleted. It must be at least 90 days more than
+	// MoveToColdStorageAfterDays.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	DeleteAfterDays *int64 `json:"deleteAfterDays,omitempty"`
+}
+
+// CopyAction copies the recovery points of a backup rule to another vault,
+// for example in another region.
+type CopyAction struct {
+	// DestinationBackupVaultARN is the ARN of the vault that recovery points
+	// are copied to.
+	// +optional
+	DestinationBackupVaultARN *string `json:"destinationBackupVaultArn,omitempty"`
+
+	// DestinationBackupVaultARNRef references a BackupVault to retrieve its
+	// ARN.
+	// +optional
+	DestinationBackupVaultARNRef *runtimev1alpha1.Reference `json:"destinationBackupVaultArnRef,omitempty"`
+
+	// DestinationBackupVaultARNSelector selects a reference to a BackupVault
+	// to retrieve its ARN.
+	// +optional
+	DestinationBackupVaultARNSelector *runtimev1alpha1.Selector `json:"destinationBackupVaultArnSelector,omitempty"`
+
+	// Lifecycle of the copied recovery points.
+	// +optional
+	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
+}
+
+// BackupRule is a scheduled task of a backup plan that backs up the selected
+// resources to a vault.
+type BackupRule struct {
+	// RuleName is the name of the rule, which is unique within the plan.
+	RuleName string `json:"ruleName"`
+
+	// TargetBackupVaultName is the name of the vault that the recovery points
+	// are stored in.
+	// +optional
+	TargetBackupVaultName *string `json:"targetBackupVaultName,omitempty"`
+
+	// TargetBackupVaultNameRef references a BackupVault to retrieve its name.
+	// +optional
+	TargetBackupVaultNameRef *runtimev1alpha1.Reference `json:"targetBackupVaultNameRef,omitempty"`
+
+	// TargetBackupVaultNameSelector selects a reference to a BackupVault to
+	// retrieve its name.
+	// +optional
+	TargetBackupVaultNameSelector *runtimev1alpha1.Selector `json:"targetBackupVaultNameSelector,omitempty"`
+
+	// ScheduleExpression is the CRON expression in UTC that schedules the
+	// backups, for example cron(0 5 ? * * *).
+	// +optional
+	ScheduleExpression *string `json:"scheduleExpression,omitempty"`
+
+	// StartWindowMinutes is the number of minutes after a scheduled backup
+	// within which it must start, or it is canceled.
+	// +kubebuilder:validation:Minimum=60
+	// +optional
+	StartWindowMinutes *int64 `json:"startWindowMinutes,omitempty"`
+
+	// CompletionWindowMinutes is the number of minutes after a backup started
+	// within which it must complete, or it is canceled.
+	// +optional
+	CompletionWindowMinutes *int64 `json:"completionWindowMinutes,omitempty"`
+
+	// Lifecycle of the recovery points that the rule creates.
+	// +optional
+	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
+
+	// RecoveryPointTags are the tags of the recovery points that the rule
+	// creates.
+	// +optional
+	RecoveryPointTags map[string]string `json:"recoveryPointTags,omitempty"`
+
+	// CopyActions copy the recovery points that the rule creates to other
+	// vaults.
+	// +optional
+	CopyActions []CopyAction `json:"copyActions,omitempty"`
+}
+
+// BackupPlanParameters define the desired state of an AWS Backup plan.
+type BackupPlanParameters struct {
+	// Region is the region you'd like the BackupPlan to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name is the name of the plan.
+	// +immutable
+	Name string `json:"name"`
+
+	// Rules are the backup rules of the plan.
+	// +kubebuilder:validation:MinItems=1
+	Rules []BackupRule `json:"rules"`
+
+	// Tags are the tags of the plan.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// BackupPlanObservation is the representation of the current state that is
+// observed.
+type BackupPlanObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the plan.
+	ARN string `json:"arn,omitempty"`
+
+	// VersionID is the ID of the current version of the plan, which changes
+	// every time the plan is updated.
+	VersionID string `json:"versionId,omitempty"`
+}
+
+// BackupPlanSpec defines the desired state of an AWS Backup plan.
+type BackupPlanSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  BackupPlanParameters `json:"forProvider"`
+}
+
+// BackupPlanStatus represents the observed state of an AWS Backup plan.
+type BackupPlanStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     BackupPlanObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BackupPlan is a managed resource that represents an AWS Backup plan,
+// which schedules the backups of the resources that are selected by its
+// BackupSelections. Its external name is the ID of the plan.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type BackupPlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupPlanSpec   `json:"spec"`
+	Status BackupPlanStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupPlanList contains a list of BackupPlan
+type BackupPlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupPlan `json:"items"`
+}
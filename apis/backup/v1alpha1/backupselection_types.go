@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// TagCondition selects the resources that have a tag.
+type TagCondition struct {
+	// Type is the operator that compares the tag value with Value.
+	// +crossplane:aws:model=backup.Condition.ConditionType
+	// +kubebuilder:validation:Enum=STRINGEQUALS
+	Type string `json:"type"`
+
+	// Key is the key of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// BackupSelectionParameters define the desired state of an AWS Backup
+// selection. Selections can't be updated.
+type BackupSelectionParameters struct {
+	// Region is the region you'd like the BackupSelection to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name is the name of the selection.
+	// +immutable
+	Name string `json:"name"`
+
+	// BackupPlanID is the ID of the plan that backs up the selected
+	// resources.
+	// +immutable
+	// +optional
+	BackupPlanID *string `json:"backupPlanId,omitempty"`
+
+	// BackupPlanIDRef references a BackupPlan to retrieve its ID.
+	// +immutable
+	// +optional
+	BackupPlanIDRef *runtimev1alpha1.Reference `json:"backupPlanIdRef,omitempty"`
+
+	// BackupPlanIDSelector selects a reference to a BackupPlan to retrieve
+	// its ID.
+	// +immutable
+	// +optional
+	BackupPlanIDSelector *runtimev1alpha1.Selector `json:"backupPlanIdSelector,omitempty"`
+
+	// IAMRoleARN is the ARN of the IAM role that AWS Backup assumes to back
+	// up the selected resources.
+	// +immutable
+	// +optional
+	IAMRoleARN *string `json:"iamRoleArn,omitempty"`
+
+	// IAMRoleARNRef references an IAMRole to retrieve its ARN.
+	// +immutable
+	// +optional
+	IAMRoleARNRef *runtimev1alpha1.Reference `json:"iamRoleArnRef,omitempty"`
+
+	// IAMRoleARNSelector selects a reference to an IAMRole to retrieve its
+	// ARN.
+	// +immutable
+	// +optional
+	IAMRoleARNSelector *runtimev1alpha1.Selector `json:"iamRoleArnSelector,omitempty"`
+
+	// Resources are the ARNs of the selected resources. They may contain
+	// wildcards, like arn:aws:ec2:us-east-1:123456789012:volume/*.
+	// +immutable
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+
+	// RDSInstanceARNs are the ARNs of the selected RDS instances.
+	// +immutable
+	// +optional
+	RDSInstanceARNs []string `json:"rdsInstanceArns,omitempty"`
+
+	// RDSInstanceARNRefs references RDSInstances to retrieve their ARNs.
+	// +immutable
+	// +optional
+	RDSInstanceARNRefs []runtimev1alpha1.Reference `json:"rdsInstanceArnRefs,omitempty"`
+
+	// RDSInstanceARNSelector selects references to RDSInstances to retrieve
+	// their ARNs.
+	// +immutable
+	// +optional
+	RDSInstanceARNSelector *runtimev1alpha1.Selector `json:"rdsInstanceArnSelector,omitempty"`
+
+	// DynamoTableARNs are the ARNs of the selected DynamoDB tables.
+	// +immutable
+	// +optional
+	DynamoTableARNs []string `json:"dynamoTableArns,omitempty"`
+
+	// DynamoTableARNRefs references DynamoTables to retrieve their ARNs.
+	// +immutable
+	// +optional
+	DynamoTableARNRefs []runtimev1alpha1.Reference `json:"dynamoTableArnRefs,omitempty"`
+
+	// DynamoTableARNSelector selects references to DynamoTables to retrieve
+	// their ARNs.
+	// +immutable
+	// +optional
+	DynamoTableARNSelector *runtimev1alpha1.Selector `json:"dynamoTableArnSelector,omitempty"`
+
+	// ListOfTags selects the resources that have all of the given tags.
+	// +immutable
+	// +optional
+	ListOfTags []TagCondition `json:"listOfTags,omitempty"`
+}
+
+// BackupSelectionObservation is the representation of the current state that
+// is observed.
+type BackupSelectionObservation struct {
+	// CreationDate is the time the selection was created.
+	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+}
+
+// BackupSelectionSpec defines the desired state of an AWS Backup selection.
+type BackupSelectionSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  BackupSelectionParameters `json:"forProvider"`
+}
+
+// BackupSelectionStatus represents the observed state of an AWS Backup
+// selection.
+type BackupSelectionStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     BackupSelectionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BackupSelection is a managed resource that represents an AWS Backup
+// selection, which assigns resources to a BackupPlan by their ARNs or tags.
+// Its external name is the ID of the selection.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PLAN",type="string",JSONPath=".spec.forProvider.backupPlanId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type BackupSelection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupSelectionSpec   `json:"spec"`
+	Status BackupSelectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupSelectionList contains a list of BackupSelection
+type BackupSelectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupSelection `json:"items"`
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// BackupVaultParameters define the desired state of an AWS Backup vault.
+type BackupVaultParameters struct {
+	// Region is the region you'd like the BackupVault to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// EncryptionKeyARN is the ARN of the KMS key that encrypts the recovery
+	// points of the vault.
+	// Default: the AWS managed key of AWS Backup
+	// +immutable
+	// +optional
+	EncryptionKeyARN *string `json:"encryptionKeyArn,omitempty"`
+
+	// AccessPolicy is the JSON resource-based policy document that controls
+	// access to the vault.
+	// +optional
+	AccessPolicy *string `json:"accessPolicy,omitempty"`
+
+	// Tags are the tags of the vault.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// BackupVaultObservation is the representation of the current state that is
+// observed.
+type BackupVaultObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the vault.
+	ARN string `json:"arn,omitempty"`
+
+	// NumberOfRecoveryPoints is the number of recovery points that are stored
+	// in the vault. A vault can only be deleted once it is empty.
+	NumberOfRecoveryPoints int64 `json:"numberOfRecoveryPoints,omitempty"`
+}
+
+// BackupVaultSpec defines the desired state of an AWS Backup vault.
+type BackupVaultSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  BackupVaultParameters `json:"forProvider"`
+}
+
+// BackupVaultStatus represents the observed state of an AWS Backup vault.
+type BackupVaultStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     BackupVaultObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BackupVault is a managed resource that represents an AWS Backup vault,
+// which stores the recovery points of backups. Its external name is the name
+// of the vault.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="RECOVERY-POINTS",type="integer",JSONPath=".status.atProvider.numberOfRecoveryPoints"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type BackupVault struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupVaultSpec   `json:"spec"`
+	Status BackupVaultStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupVaultList contains a list of BackupVault
+type BackupVaultList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupVault `json:"items"`
+}
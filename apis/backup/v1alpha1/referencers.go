@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	databasev1alpha1 "github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	databasev1beta1 "github.com/crossplane/provider-aws/apis/database/v1beta1"
+	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+)
+
+// BackupVaultARN returns the status.atProvider.ARN of a BackupVault.
+func BackupVaultARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*BackupVault)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ARN
+	}
+}
+
+// ResolveReferences of this BackupPlan
+func (mg *BackupPlan) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	for i := range mg.Spec.ForProvider.Rules {
+		rule := &mg.Spec.ForProvider.Rules[i]
+
+		// Resolve spec.forProvider.rules[].targetBackupVaultName
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(rule.TargetBackupVaultName),
+			Reference:    rule.TargetBackupVaultNameRef,
+			Selector:     rule.TargetBackupVaultNameSelector,
+			To:           reference.To{Managed: &BackupVault{}, List: &BackupVaultList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.rules[%d].targetBackupVaultName", i)
+		}
+		rule.TargetBackupVaultName = reference.ToPtrValue(rsp.ResolvedValue)
+		rule.TargetBackupVaultNameRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.rules[].copyActions[].destinationBackupVaultArn
+		for j := range rule.CopyActions {
+			ca := &rule.CopyActions[j]
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(ca.DestinationBackupVaultARN),
+				Reference:    ca.DestinationBackupVaultARNRef,
+				Selector:     ca.DestinationBackupVaultARNSelector,
+				To:           reference.To{Managed: &BackupVault{}, List: &BackupVaultList{}},
+				Extract:      BackupVaultARN(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.rules[%d].copyActions[%d].destinationBackupVaultArn", i, j)
+			}
+			ca.DestinationBackupVaultARN = reference.ToPtrValue(rsp.ResolvedValue)
+			ca.DestinationBackupVaultARNRef = rsp.ResolvedReference
+		}
+	}
+
+	return nil
+}
+
+// ResolveReferences of this BackupSelection
+func (mg *BackupSelection) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.backupPlanId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.BackupPlanID),
+		Reference:    mg.Spec.ForProvider.BackupPlanIDRef,
+		Selector:     mg.Spec.ForProvider.BackupPlanIDSelector,
+		To:           reference.To{Managed: &BackupPlan{}, List: &BackupPlanList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.backupPlanId")
+	}
+	mg.Spec.ForProvider.BackupPlanID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.BackupPlanIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.iamRoleArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IAMRoleARN),
+		Reference:    mg.Spec.ForProvider.IAMRoleARNRef,
+		Selector:     mg.Spec.ForProvider.IAMRoleARNSelector,
+		To:           reference.To{Managed: &identityv1beta1.IAMRole{}, List: &identityv1beta1.IAMRoleList{}},
+		Extract:      identityv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.iamRoleArn")
+	}
+	mg.Spec.ForProvider.IAMRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IAMRoleARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.rdsInstanceArns
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.RDSInstanceARNs,
+		References:    mg.Spec.ForProvider.RDSInstanceARNRefs,
+		Selector:      mg.Spec.ForProvider.RDSInstanceARNSelector,
+		To:            reference.To{Managed: &databasev1beta1.RDSInstance{}, List: &databasev1beta1.RDSInstanceList{}},
+		Extract:       databasev1beta1.RDSInstanceARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.rdsInstanceArns")
+	}
+	mg.Spec.ForProvider.RDSInstanceARNs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.RDSInstanceARNRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.dynamoTableArns
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.DynamoTableARNs,
+		References:    mg.Spec.ForProvider.DynamoTableARNRefs,
+		Selector:      mg.Spec.ForProvider.DynamoTableARNSelector,
+		To:            reference.To{Managed: &databasev1alpha1.DynamoTable{}, List: &databasev1alpha1.DynamoTableList{}},
+		Extract:       databasev1alpha1.DynamoTableARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dynamoTableArns")
+	}
+	mg.Spec.ForProvider.DynamoTableARNs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.DynamoTableARNRefs = mrsp.ResolvedReferences
+
+	return nil
+}
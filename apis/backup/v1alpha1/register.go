@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "backup.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// BackupVault type metadata.
+var (
+	BackupVaultKind             = reflect.TypeOf(BackupVault{}).Name()
+	BackupVaultGroupKind        = schema.GroupKind{Group: Group, Kind: BackupVaultKind}.String()
+	BackupVaultKindAPIVersion   = BackupVaultKind + "." + SchemeGroupVersion.String()
+	BackupVaultGroupVersionKind = SchemeGroupVersion.WithKind(BackupVaultKind)
+)
+
+// BackupPlan type metadata.
+var (
+	BackupPlanKind             = reflect.TypeOf(BackupPlan{}).Name()
+	BackupPlanGroupKind        = schema.GroupKind{Group: Group, Kind: BackupPlanKind}.String()
+	BackupPlanKindAPIVersion   = BackupPlanKind + "." + SchemeGroupVersion.String()
+	BackupPlanGroupVersionKind = SchemeGroupVersion.WithKind(BackupPlanKind)
+)
+
+// BackupSelection type metadata.
+var (
+	BackupSelectionKind             = reflect.TypeOf(BackupSelection{}).Name()
+	BackupSelectionGroupKind        = schema.GroupKind{Group: Group, Kind: BackupSelectionKind}.String()
+	BackupSelectionKindAPIVersion   = BackupSelectionKind + "." + SchemeGroupVersion.String()
+	BackupSelectionGroupVersionKind = SchemeGroupVersion.WithKind(BackupSelectionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BackupVault{}, &BackupVaultList{})
+	SchemeBuilder.Register(&BackupPlan{}, &BackupPlanList{})
+	SchemeBuilder.Register(&BackupSelection{}, &BackupSelectionList{})
+}
@@ -0,0 +1,639 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPlan) DeepCopyInto(out *BackupPlan) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPlan.
+func (in *BackupPlan) DeepCopy() *BackupPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupPlan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPlanList) DeepCopyInto(out *BackupPlanList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPlanList.
+func (in *BackupPlanList) DeepCopy() *BackupPlanList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPlanList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupPlanList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPlanObservation) DeepCopyInto(out *BackupPlanObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPlanObservation.
+func (in *BackupPlanObservation) DeepCopy() *BackupPlanObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPlanObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPlanParameters) DeepCopyInto(out *BackupPlanParameters) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]BackupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPlanParameters.
+func (in *BackupPlanParameters) DeepCopy() *BackupPlanParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPlanParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPlanSpec) DeepCopyInto(out *BackupPlanSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPlanSpec.
+func (in *BackupPlanSpec) DeepCopy() *BackupPlanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPlanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPlanStatus) DeepCopyInto(out *BackupPlanStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPlanStatus.
+func (in *BackupPlanStatus) DeepCopy() *BackupPlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRule) DeepCopyInto(out *BackupRule) {
+	*out = *in
+	if in.TargetBackupVaultName != nil {
+		in, out := &in.TargetBackupVaultName, &out.TargetBackupVaultName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetBackupVaultNameRef != nil {
+		in, out := &in.TargetBackupVaultNameRef, &out.TargetBackupVaultNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.TargetBackupVaultNameSelector != nil {
+		in, out := &in.TargetBackupVaultNameSelector, &out.TargetBackupVaultNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScheduleExpression != nil {
+		in, out := &in.ScheduleExpression, &out.ScheduleExpression
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartWindowMinutes != nil {
+		in, out := &in.StartWindowMinutes, &out.StartWindowMinutes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CompletionWindowMinutes != nil {
+		in, out := &in.CompletionWindowMinutes, &out.CompletionWindowMinutes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(Lifecycle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecoveryPointTags != nil {
+		in, out := &in.RecoveryPointTags, &out.RecoveryPointTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CopyActions != nil {
+		in, out := &in.CopyActions, &out.CopyActions
+		*out = make([]CopyAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRule.
+func (in *BackupRule) DeepCopy() *BackupRule {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSelection) DeepCopyInto(out *BackupSelection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSelection.
+func (in *BackupSelection) DeepCopy() *BackupSelection {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupSelection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSelectionList) DeepCopyInto(out *BackupSelectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupSelection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSelectionList.
+func (in *BackupSelectionList) DeepCopy() *BackupSelectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSelectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupSelectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSelectionObservation) DeepCopyInto(out *BackupSelectionObservation) {
+	*out = *in
+	if in.CreationDate != nil {
+		in, out := &in.CreationDate, &out.CreationDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSelectionObservation.
+func (in *BackupSelectionObservation) DeepCopy() *BackupSelectionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSelectionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSelectionParameters) DeepCopyInto(out *BackupSelectionParameters) {
+	*out = *in
+	if in.BackupPlanID != nil {
+		in, out := &in.BackupPlanID, &out.BackupPlanID
+		*out = new(string)
+		**out = **in
+	}
+	if in.BackupPlanIDRef != nil {
+		in, out := &in.BackupPlanIDRef, &out.BackupPlanIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.BackupPlanIDSelector != nil {
+		in, out := &in.BackupPlanIDSelector, &out.BackupPlanIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IAMRoleARN != nil {
+		in, out := &in.IAMRoleARN, &out.IAMRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMRoleARNRef != nil {
+		in, out := &in.IAMRoleARNRef, &out.IAMRoleARNRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.IAMRoleARNSelector != nil {
+		in, out := &in.IAMRoleARNSelector, &out.IAMRoleARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RDSInstanceARNs != nil {
+		in, out := &in.RDSInstanceARNs, &out.RDSInstanceARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RDSInstanceARNRefs != nil {
+		in, out := &in.RDSInstanceARNRefs, &out.RDSInstanceARNRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.RDSInstanceARNSelector != nil {
+		in, out := &in.RDSInstanceARNSelector, &out.RDSInstanceARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DynamoTableARNs != nil {
+		in, out := &in.DynamoTableARNs, &out.DynamoTableARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DynamoTableARNRefs != nil {
+		in, out := &in.DynamoTableARNRefs, &out.DynamoTableARNRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DynamoTableARNSelector != nil {
+		in, out := &in.DynamoTableARNSelector, &out.DynamoTableARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListOfTags != nil {
+		in, out := &in.ListOfTags, &out.ListOfTags
+		*out = make([]TagCondition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSelectionParameters.
+func (in *BackupSelectionParameters) DeepCopy() *BackupSelectionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSelectionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSelectionSpec) DeepCopyInto(out *BackupSelectionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSelectionSpec.
+func (in *BackupSelectionSpec) DeepCopy() *BackupSelectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSelectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSelectionStatus) DeepCopyInto(out *BackupSelectionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSelectionStatus.
+func (in *BackupSelectionStatus) DeepCopy() *BackupSelectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSelectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVault) DeepCopyInto(out *BackupVault) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVault.
+func (in *BackupVault) DeepCopy() *BackupVault {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupVault) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVaultList) DeepCopyInto(out *BackupVaultList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupVault, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVaultList.
+func (in *BackupVaultList) DeepCopy() *BackupVaultList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVaultList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupVaultList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVaultObservation) DeepCopyInto(out *BackupVaultObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVaultObservation.
+func (in *BackupVaultObservation) DeepCopy() *BackupVaultObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVaultObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVaultParameters) DeepCopyInto(out *BackupVaultParameters) {
+	*out = *in
+	if in.EncryptionKeyARN != nil {
+		in, out := &in.EncryptionKeyARN, &out.EncryptionKeyARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessPolicy != nil {
+		in, out := &in.AccessPolicy, &out.AccessPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVaultParameters.
+func (in *BackupVaultParameters) DeepCopy() *BackupVaultParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVaultParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVaultSpec) DeepCopyInto(out *BackupVaultSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVaultSpec.
+func (in *BackupVaultSpec) DeepCopy() *BackupVaultSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVaultSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVaultStatus) DeepCopyInto(out *BackupVaultStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVaultStatus.
+func (in *BackupVaultStatus) DeepCopy() *BackupVaultStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVaultStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CopyAction) DeepCopyInto(out *CopyAction) {
+	*out = *in
+	if in.DestinationBackupVaultARN != nil {
+		in, out := &in.DestinationBackupVaultARN, &out.DestinationBackupVaultARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationBackupVaultARNRef != nil {
+		in, out := &in.DestinationBackupVaultARNRef, &out.DestinationBackupVaultARNRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.DestinationBackupVaultARNSelector != nil {
+		in, out := &in.DestinationBackupVaultARNSelector, &out.DestinationBackupVaultARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(Lifecycle)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CopyAction.
+func (in *CopyAction) DeepCopy() *CopyAction {
+	if in == nil {
+		return nil
+	}
+	out := new(CopyAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Lifecycle) DeepCopyInto(out *Lifecycle) {
+	*out = *in
+	if in.MoveToColdStorageAfterDays != nil {
+		in, out := &in.MoveToColdStorageAfterDays, &out.MoveToColdStorageAfterDays
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DeleteAfterDays != nil {
+		in, out := &in.DeleteAfterDays, &out.DeleteAfterDays
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Lifecycle.
+func (in *Lifecycle) DeepCopy() *Lifecycle {
+	if in == nil {
+		return nil
+	}
+	out := new(Lifecycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagCondition) DeepCopyInto(out *TagCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagCondition.
+func (in *TagCondition) DeepCopy() *TagCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(TagCondition)
+	in.DeepCopyInto(out)
+	return out
+}
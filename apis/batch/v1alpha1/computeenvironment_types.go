@@ -0,0 +1,277 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// LaunchTemplateSpecification selects the EC2 launch template of the
+// instances of a compute environment. Either the ID or the name of the launch
+// template has to be set.
+type LaunchTemplateSpecification struct {
+	// LaunchTemplateID is the ID of the launch template.
+	// +optional
+	LaunchTemplateID *string `json:"launchTemplateId,omitempty"`
+
+	// LaunchTemplateName is the name of the launch template.
+	// +optional
+	LaunchTemplateName *string `json:"launchTemplateName,omitempty"`
+
+	// Version is the version number of the launch template.
+	// Default: $Default
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// ComputeResources define the EC2 instances of a managed compute environment.
+type ComputeResources struct {
+	// Type is the purchasing model of the instances.
+	// +crossplane:aws:model=batch.ComputeResource.Type
+	// +kubebuilder:validation:Enum=EC2;SPOT
+	// +immutable
+	Type string `json:"type"`
+
+	// AllocationStrategy is the strategy that is used to pick the instance
+	// types when no instance of the best fitting type is available.
+	// Default: BEST_FIT
+	// +crossplane:aws:model=batch.ComputeResource.AllocationStrategy
+	// +kubebuilder:validation:Enum=BEST_FIT;BEST_FIT_PROGRESSIVE;SPOT_CAPACITY_OPTIMIZED
+	// +immutable
+	// +optional
+	AllocationStrategy *string `json:"allocationStrategy,omitempty"`
+
+	// MinvCPUs is the minimum number of vCPUs the compute environment keeps
+	// running, even if it has no jobs.
+	// +kubebuilder:validation:Minimum=0
+	MinvCPUs int64 `json:"minvCpus"`
+
+	// MaxvCPUs is the maximum number of vCPUs the compute environment scales
+	// out to.
+	// +kubebuilder:validation:Minimum=0
+	MaxvCPUs int64 `json:"maxvCpus"`
+
+	// DesiredvCPUs is the number of vCPUs the compute environment starts
+	// with. Batch scales the desired vCPUs between MinvCPUs and MaxvCPUs on
+	// its own afterwards, so changes are not reverted.
+	// +kubebuilder:validation:Minimum=0
+	// +immutable
+	// +optional
+	DesiredvCPUs *int64 `json:"desiredvCpus,omitempty"`
+
+	// InstanceTypes are the instance types or families the compute
+	// environment may launch, like c5.large, c5 or optimal.
+	// +kubebuilder:validation:MinItems=1
+	// +immutable
+	InstanceTypes []string `json:"instanceTypes"`
+
+	// ImageID is the ID of the AMI of the instances.
+	// Default: the latest ECS optimized Amazon Linux AMI
+	// +immutable
+	// +optional
+	ImageID *string `json:"imageId,omitempty"`
+
+	// InstanceRole is the name or ARN of the instance profile of the
+	// instances, which needs the permissions of the ECS container agent.
+	// +immutable
+	InstanceRole string `json:"instanceRole"`
+
+	// EC2KeyPair is the name of the EC2 key pair that can be used to log in
+	// to the instances.
+	// +immutable
+	// +optional
+	EC2KeyPair *string `json:"ec2KeyPair,omitempty"`
+
+	// PlacementGroup is the name of the EC2 placement group of the instances.
+	// +immutable
+	// +optional
+	PlacementGroup *string `json:"placementGroup,omitempty"`
+
+	// BidPercentage is the maximum percentage of the On-Demand price that is
+	// paid for SPOT instances.
+	// Default: 100
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +immutable
+	// +optional
+	BidPercentage *int64 `json:"bidPercentage,omitempty"`
+
+	// SpotIAMFleetRoleARN is the ARN of the IAM role of the Spot Fleet of a
+	// SPOT compute environment that uses the BEST_FIT allocation strategy.
+	// +immutable
+	// +optional
+	SpotIAMFleetRoleARN *string `json:"spotIamFleetRoleArn,omitempty"`
+
+	// SpotIAMFleetRoleARNRef references an IAMRole to retrieve its ARN.
+	// +immutable
+	// +optional
+	SpotIAMFleetRoleARNRef *runtimev1alpha1.Reference `json:"spotIamFleetRoleArnRef,omitempty"`
+
+	// SpotIAMFleetRoleARNSelector selects a reference to an IAMRole to
+	// retrieve its ARN.
+	// +optional
+	SpotIAMFleetRoleARNSelector *runtimev1alpha1.Selector `json:"spotIamFleetRoleArnSelector,omitempty"`
+
+	// LaunchTemplate is the EC2 launch template of the instances.
+	// +immutable
+	// +optional
+	LaunchTemplate *LaunchTemplateSpecification `json:"launchTemplate,omitempty"`
+
+	// Subnets are the IDs of the VPC subnets the instances are launched in.
+	// +immutable
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+
+	// SubnetRefs references Subnets to retrieve their IDs.
+	// +immutable
+	// +optional
+	SubnetRefs []runtimev1alpha1.Reference `json:"subnetRefs,omitempty"`
+
+	// SubnetSelector selects references to Subnets to retrieve their IDs.
+	// +optional
+	SubnetSelector *runtimev1alpha1.Selector `json:"subnetSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the instances.
+	// +immutable
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their IDs.
+	// +immutable
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their IDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// Tags are the tags that are applied to the instances.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// ComputeEnvironmentParameters define the desired state of an AWS Batch
+// compute environment.
+type ComputeEnvironmentParameters struct {
+	// Region is the region you'd like the ComputeEnvironment to be created
+	// in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Type is the type of the compute environment. Batch launches and scales
+	// the instances of MANAGED compute environments, while the instances of
+	// UNMANAGED compute environments have to be registered with its ECS
+	// cluster.
+	// +crossplane:aws:model=batch.CreateComputeEnvironmentInput.Type
+	// +kubebuilder:validation:Enum=MANAGED;UNMANAGED
+	// +immutable
+	Type string `json:"type"`
+
+	// State is the state of the compute environment. The jobs of a DISABLED
+	// compute environment keep running, but no new jobs are placed.
+	// Default: ENABLED
+	// +crossplane:aws:model=batch.CreateComputeEnvironmentInput.State
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	State *string `json:"state,omitempty"`
+
+	// ServiceRoleARN is the ARN of the IAM role that allows Batch to call
+	// other AWS services on your behalf.
+	// +optional
+	ServiceRoleARN *string `json:"serviceRoleArn,omitempty"`
+
+	// ServiceRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	ServiceRoleARNRef *runtimev1alpha1.Reference `json:"serviceRoleArnRef,omitempty"`
+
+	// ServiceRoleARNSelector selects a reference to an IAMRole to retrieve
+	// its ARN.
+	// +optional
+	ServiceRoleARNSelector *runtimev1alpha1.Selector `json:"serviceRoleArnSelector,omitempty"`
+
+	// ComputeResources define the instances of a MANAGED compute
+	// environment. Only the vCPU limits can be changed after creation.
+	// +optional
+	ComputeResources *ComputeResources `json:"computeResources,omitempty"`
+}
+
+// ComputeEnvironmentObservation is the representation of the current state
+// that is observed.
+type ComputeEnvironmentObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the compute environment.
+	ARN string `json:"arn,omitempty"`
+
+	// ECSClusterARN is the ARN of the ECS cluster of the compute
+	// environment.
+	ECSClusterARN string `json:"ecsClusterArn,omitempty"`
+
+	// State is the current state of the compute environment.
+	State string `json:"state,omitempty"`
+
+	// Status is the current status of the compute environment.
+	Status string `json:"status,omitempty"`
+
+	// StatusReason is a description of the current status.
+	StatusReason string `json:"statusReason,omitempty"`
+}
+
+// ComputeEnvironmentSpec defines the desired state of an AWS Batch compute
+// environment.
+type ComputeEnvironmentSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ComputeEnvironmentParameters `json:"forProvider"`
+}
+
+// ComputeEnvironmentStatus represents the observed state of an AWS Batch
+// compute environment.
+type ComputeEnvironmentStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ComputeEnvironmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ComputeEnvironment is a managed resource that represents an AWS Batch
+// compute environment, the ECS cluster that runs the jobs of its job queues.
+// Its external name is the name of the compute environment.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ComputeEnvironment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ComputeEnvironmentSpec   `json:"spec"`
+	Status ComputeEnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ComputeEnvironmentList contains a list of ComputeEnvironment
+type ComputeEnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComputeEnvironment `json:"items"`
+}
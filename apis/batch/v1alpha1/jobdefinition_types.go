@@ -0,0 +1,240 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// KeyValuePair is an environment variable of a container.
+type KeyValuePair struct {
+	// Name of the environment variable. It must not start with AWS_BATCH.
+	Name string `json:"name"`
+
+	// Value of the environment variable.
+	Value string `json:"value"`
+}
+
+// ResourceRequirement reserves a resource for a container.
+type ResourceRequirement struct {
+	// Type of the resource.
+	// +crossplane:aws:model=batch.ResourceRequirement.Type
+	// +kubebuilder:validation:Enum=GPU
+	Type string `json:"type"`
+
+	// Value is the quantity of the resource that is reserved.
+	Value string `json:"value"`
+}
+
+// MountPoint mounts a volume into a container.
+type MountPoint struct {
+	// ContainerPath is the path in the container the volume is mounted at.
+	ContainerPath string `json:"containerPath"`
+
+	// SourceVolume is the name of the volume.
+	SourceVolume string `json:"sourceVolume"`
+
+	// ReadOnly mounts the volume read-only.
+	// +optional
+	ReadOnly *bool `json:"readOnly,omitempty"`
+}
+
+// Volume is a volume of the containers of a job.
+type Volume struct {
+	// Name of the volume, which mount points refer to.
+	Name string `json:"name"`
+
+	// SourcePath is the path on the host instance that is mounted. The
+	// volume is an empty data volume of the job if it is not set.
+	// +optional
+	SourcePath *string `json:"sourcePath,omitempty"`
+}
+
+// Ulimit sets a ulimit of a container.
+type Ulimit struct {
+	// Name is the type of the ulimit, like nofile.
+	Name string `json:"name"`
+
+	// SoftLimit is the soft limit.
+	SoftLimit int64 `json:"softLimit"`
+
+	// HardLimit is the hard limit.
+	HardLimit int64 `json:"hardLimit"`
+}
+
+// ContainerProperties define the container a job runs in.
+type ContainerProperties struct {
+	// Image is the Docker image of the container.
+	Image string `json:"image"`
+
+	// VCPUs is the number of vCPUs that are reserved for the container.
+	// +kubebuilder:validation:Minimum=1
+	VCPUs int64 `json:"vcpus"`
+
+	// Memory is the hard limit of the memory of the container, in MiB.
+	// +kubebuilder:validation:Minimum=4
+	Memory int64 `json:"memory"`
+
+	// Command is the command of the container. It may use the parameters
+	// of the job definition as Ref::<name> placeholders.
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// Environment are the environment variables of the container.
+	// +optional
+	Environment []KeyValuePair `json:"environment,omitempty"`
+
+	// JobRoleARN is the ARN of the IAM role the container can assume.
+	// +optional
+	JobRoleARN *string `json:"jobRoleArn,omitempty"`
+
+	// JobRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	JobRoleARNRef *runtimev1alpha1.Reference `json:"jobRoleArnRef,omitempty"`
+
+	// JobRoleARNSelector selects a reference to an IAMRole to retrieve its
+	// ARN.
+	// +optional
+	JobRoleARNSelector *runtimev1alpha1.Selector `json:"jobRoleArnSelector,omitempty"`
+
+	// ResourceRequirements reserve additional resources, like GPUs, for the
+	// container.
+	// +optional
+	ResourceRequirements []ResourceRequirement `json:"resourceRequirements,omitempty"`
+
+	// MountPoints mount the volumes into the container.
+	// +optional
+	MountPoints []MountPoint `json:"mountPoints,omitempty"`
+
+	// Volumes are the volumes of the job.
+	// +optional
+	Volumes []Volume `json:"volumes,omitempty"`
+
+	// Ulimits are the ulimits of the container.
+	// +optional
+	Ulimits []Ulimit `json:"ulimits,omitempty"`
+
+	// Privileged gives the container root privileges on the host instance.
+	// +optional
+	Privileged *bool `json:"privileged,omitempty"`
+
+	// ReadonlyRootFilesystem makes the root file system of the container
+	// read-only.
+	// +optional
+	ReadonlyRootFilesystem *bool `json:"readonlyRootFilesystem,omitempty"`
+
+	// User is the user name the container runs as.
+	// +optional
+	User *string `json:"user,omitempty"`
+}
+
+// RetryStrategy defines how often a failed job is retried.
+type RetryStrategy struct {
+	// Attempts is the number of times a job is tried, including the first
+	// attempt.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	Attempts int64 `json:"attempts"`
+}
+
+// JobTimeout defines after how long the attempts of a job are terminated.
+type JobTimeout struct {
+	// AttemptDurationSeconds is the number of seconds after which an
+	// unfinished attempt of a job is terminated.
+	// +kubebuilder:validation:Minimum=60
+	AttemptDurationSeconds int64 `json:"attemptDurationSeconds"`
+}
+
+// JobDefinitionParameters define the desired state of an AWS Batch job
+// definition.
+type JobDefinitionParameters struct {
+	// Region is the region you'd like the JobDefinition to be registered in.
+	// +immutable
+	Region string `json:"region"`
+
+	// ContainerProperties define the container the jobs run in.
+	ContainerProperties ContainerProperties `json:"containerProperties"`
+
+	// Parameters are the default values of the placeholders of the command.
+	// They can be overridden when a job is submitted.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// RetryStrategy defines how often failed jobs are retried.
+	// +optional
+	RetryStrategy *RetryStrategy `json:"retryStrategy,omitempty"`
+
+	// Timeout defines after how long the attempts of jobs are terminated.
+	// +optional
+	Timeout *JobTimeout `json:"timeout,omitempty"`
+}
+
+// JobDefinitionObservation is the representation of the current state that
+// is observed.
+type JobDefinitionObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the current revision of the
+	// job definition.
+	ARN string `json:"arn,omitempty"`
+
+	// Revision is the current revision of the job definition.
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// JobDefinitionSpec defines the desired state of an AWS Batch job
+// definition.
+type JobDefinitionSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  JobDefinitionParameters `json:"forProvider"`
+}
+
+// JobDefinitionStatus represents the observed state of an AWS Batch job
+// definition.
+type JobDefinitionStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     JobDefinitionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A JobDefinition is a managed resource that represents an AWS Batch job
+// definition of container jobs. Its external name is the name of the job
+// definition. Job definitions are immutable in AWS, so every change registers
+// a new revision and deregisters the previous one.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REVISION",type="integer",JSONPath=".status.atProvider.revision"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type JobDefinition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JobDefinitionSpec   `json:"spec"`
+	Status JobDefinitionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JobDefinitionList contains a list of JobDefinition
+type JobDefinitionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JobDefinition `json:"items"`
+}
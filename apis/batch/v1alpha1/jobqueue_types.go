@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ComputeEnvironmentOrder places a compute environment in the order in which
+// a job queue tries to place its jobs.
+type ComputeEnvironmentOrder struct {
+	// Order is the position of the compute environment. Compute environments
+	// with a lower order are tried first.
+	// +kubebuilder:validation:Minimum=0
+	Order int64 `json:"order"`
+
+	// ComputeEnvironmentARN is the ARN of the compute environment.
+	// +optional
+	ComputeEnvironmentARN *string `json:"computeEnvironmentArn,omitempty"`
+
+	// ComputeEnvironmentARNRef references a ComputeEnvironment to retrieve
+	// its ARN.
+	// +optional
+	ComputeEnvironmentARNRef *runtimev1alpha1.Reference `json:"computeEnvironmentArnRef,omitempty"`
+
+	// ComputeEnvironmentARNSelector selects a reference to a
+	// ComputeEnvironment to retrieve its ARN.
+	// +optional
+	ComputeEnvironmentARNSelector *runtimev1alpha1.Selector `json:"computeEnvironmentArnSelector,omitempty"`
+}
+
+// JobQueueParameters define the desired state of an AWS Batch job queue.
+type JobQueueParameters struct {
+	// Region is the region you'd like the JobQueue to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Priority is the priority of the job queue. Job queues with a higher
+	// priority are scheduled first when they share compute environments.
+	// +kubebuilder:validation:Minimum=0
+	Priority int64 `json:"priority"`
+
+	// State is the state of the job queue. A DISABLED job queue does not
+	// accept new jobs, but finishes the jobs it already has.
+	// Default: ENABLED
+	// +crossplane:aws:model=batch.CreateJobQueueInput.State
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	State *string `json:"state,omitempty"`
+
+	// ComputeEnvironmentOrder are the compute environments of the job queue.
+	// All of them have to be of the same type.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=3
+	ComputeEnvironmentOrder []ComputeEnvironmentOrder `json:"computeEnvironmentOrder"`
+}
+
+// JobQueueObservation is the representation of the current state that is
+// observed.
+type JobQueueObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the job queue.
+	ARN string `json:"arn,omitempty"`
+
+	// State is the current state of the job queue.
+	State string `json:"state,omitempty"`
+
+	// Status is the current status of the job queue.
+	Status string `json:"status,omitempty"`
+
+	// StatusReason is a description of the current status.
+	StatusReason string `json:"statusReason,omitempty"`
+}
+
+// JobQueueSpec defines the desired state of an AWS Batch job queue.
+type JobQueueSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  JobQueueParameters `json:"forProvider"`
+}
+
+// JobQueueStatus represents the observed state of an AWS Batch job queue.
+type JobQueueStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     JobQueueObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A JobQueue is a managed resource that represents an AWS Batch job queue,
+// which holds submitted jobs until they are placed in one of its compute
+// environments. Its external name is the name of the job queue.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PRIORITY",type="integer",JSONPath=".spec.forProvider.priority"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type JobQueue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JobQueueSpec   `json:"spec"`
+	Status JobQueueStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JobQueueList contains a list of JobQueue
+type JobQueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JobQueue `json:"items"`
+}
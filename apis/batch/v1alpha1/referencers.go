@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+)
+
+// ComputeEnvironmentARN returns the status.atProvider.ARN of a
+// ComputeEnvironment.
+func ComputeEnvironmentARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*ComputeEnvironment)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ARN
+	}
+}
+
+// ResolveReferences of this ComputeEnvironment
+func (mg *ComputeEnvironment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.serviceRoleArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ServiceRoleARN),
+		Reference:    mg.Spec.ForProvider.ServiceRoleARNRef,
+		Selector:     mg.Spec.ForProvider.ServiceRoleARNSelector,
+		To:           reference.To{Managed: &identityv1beta1.IAMRole{}, List: &identityv1beta1.IAMRoleList{}},
+		Extract:      identityv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serviceRoleArn")
+	}
+	mg.Spec.ForProvider.ServiceRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ServiceRoleARNRef = rsp.ResolvedReference
+
+	cr := mg.Spec.ForProvider.ComputeResources
+	if cr == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.computeResources.spotIamFleetRoleArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(cr.SpotIAMFleetRoleARN),
+		Reference:    cr.SpotIAMFleetRoleARNRef,
+		Selector:     cr.SpotIAMFleetRoleARNSelector,
+		To:           reference.To{Managed: &identityv1beta1.IAMRole{}, List: &identityv1beta1.IAMRoleList{}},
+		Extract:      identityv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.computeResources.spotIamFleetRoleArn")
+	}
+	cr.SpotIAMFleetRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.SpotIAMFleetRoleARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.computeResources.subnets
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: cr.Subnets,
+		References:    cr.SubnetRefs,
+		Selector:      cr.SubnetSelector,
+		To:            reference.To{Managed: &ec2.Subnet{}, List: &ec2.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.computeResources.subnets")
+	}
+	cr.Subnets = mrsp.ResolvedValues
+	cr.SubnetRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.computeResources.securityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: cr.SecurityGroupIDs,
+		References:    cr.SecurityGroupIDRefs,
+		Selector:      cr.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &ec2.SecurityGroup{}, List: &ec2.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.computeResources.securityGroupIds")
+	}
+	cr.SecurityGroupIDs = mrsp.ResolvedValues
+	cr.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this JobQueue
+func (mg *JobQueue) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	for i := range mg.Spec.ForProvider.ComputeEnvironmentOrder {
+		o := &mg.Spec.ForProvider.ComputeEnvironmentOrder[i]
+
+		// Resolve spec.forProvider.computeEnvironmentOrder[].computeEnvironmentArn
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(o.ComputeEnvironmentARN),
+			Reference:    o.ComputeEnvironmentARNRef,
+			Selector:     o.ComputeEnvironmentARNSelector,
+			To:           reference.To{Managed: &ComputeEnvironment{}, List: &ComputeEnvironmentList{}},
+			Extract:      ComputeEnvironmentARN(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.computeEnvironmentOrder[%d].computeEnvironmentArn", i)
+		}
+		o.ComputeEnvironmentARN = reference.ToPtrValue(rsp.ResolvedValue)
+		o.ComputeEnvironmentARNRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this JobDefinition
+func (mg *JobDefinition) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.containerProperties.jobRoleArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ContainerProperties.JobRoleARN),
+		Reference:    mg.Spec.ForProvider.ContainerProperties.JobRoleARNRef,
+		Selector:     mg.Spec.ForProvider.ContainerProperties.JobRoleARNSelector,
+		To:           reference.To{Managed: &identityv1beta1.IAMRole{}, List: &identityv1beta1.IAMRoleList{}},
+		Extract:      identityv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.containerProperties.jobRoleArn")
+	}
+	mg.Spec.ForProvider.ContainerProperties.JobRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ContainerProperties.JobRoleARNRef = rsp.ResolvedReference
+
+	return nil
+}
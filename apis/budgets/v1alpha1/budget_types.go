@@ -0,0 +1,223 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Spend is an amount of cost or usage.
+type Spend struct {
+	// Amount of cost or usage, e.g. 100.0.
+	Amount string `json:"amount"`
+
+	// Unit of the amount, e.g. USD or GB.
+	Unit string `json:"unit"`
+}
+
+// CostTypes specify which costs are included in a cost budget.
+type CostTypes struct {
+	// IncludeCredit specifies whether credits are included.
+	// +optional
+	IncludeCredit *bool `json:"includeCredit,omitempty"`
+
+	// IncludeDiscount specifies whether discounts are included.
+	// +optional
+	IncludeDiscount *bool `json:"includeDiscount,omitempty"`
+
+	// IncludeOtherSubscription specifies whether non-RI subscription costs
+	// are included.
+	// +optional
+	IncludeOtherSubscription *bool `json:"includeOtherSubscription,omitempty"`
+
+	// IncludeRecurring specifies whether recurring fees are included.
+	// +optional
+	IncludeRecurring *bool `json:"includeRecurring,omitempty"`
+
+	// IncludeRefund specifies whether refunds are included.
+	// +optional
+	IncludeRefund *bool `json:"includeRefund,omitempty"`
+
+	// IncludeSubscription specifies whether subscriptions are included.
+	// +optional
+	IncludeSubscription *bool `json:"includeSubscription,omitempty"`
+
+	// IncludeSupport specifies whether support costs are included.
+	// +optional
+	IncludeSupport *bool `json:"includeSupport,omitempty"`
+
+	// IncludeTax specifies whether taxes are included.
+	// +optional
+	IncludeTax *bool `json:"includeTax,omitempty"`
+
+	// IncludeUpfront specifies whether upfront RI costs are included.
+	// +optional
+	IncludeUpfront *bool `json:"includeUpfront,omitempty"`
+
+	// UseAmortized specifies whether amortized costs are used.
+	// +optional
+	UseAmortized *bool `json:"useAmortized,omitempty"`
+
+	// UseBlended specifies whether blended costs are used.
+	// +optional
+	UseBlended *bool `json:"useBlended,omitempty"`
+}
+
+// TimePeriod is the period a budget covers.
+type TimePeriod struct {
+	// Start of the period. Defaults to the start of the current time unit.
+	// +optional
+	Start *metav1.Time `json:"start,omitempty"`
+
+	// End of the period. Defaults to 2087-06-15.
+	// +optional
+	End *metav1.Time `json:"end,omitempty"`
+}
+
+// Notification is sent to its subscribers when the actual or forecasted
+// spend of a budget crosses its threshold.
+type Notification struct {
+	// NotificationType specifies whether the notification is for the actual
+	// or the forecasted spend.
+	// +kubebuilder:validation:Enum=ACTUAL;FORECASTED
+	NotificationType string `json:"notificationType"`
+
+	// ComparisonOperator compares the spend with the threshold.
+	// +kubebuilder:validation:Enum=GREATER_THAN;LESS_THAN;EQUAL_TO
+	ComparisonOperator string `json:"comparisonOperator"`
+
+	// Threshold of the notification, e.g. 80 or 12.5.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	Threshold string `json:"threshold"`
+
+	// ThresholdType specifies whether the threshold is a percentage of the
+	// budget limit or an absolute value. Defaults to PERCENTAGE.
+	// +kubebuilder:validation:Enum=PERCENTAGE;ABSOLUTE_VALUE
+	// +optional
+	ThresholdType *string `json:"thresholdType,omitempty"`
+
+	// SubscriberEmailAddresses are the email addresses that are notified.
+	// +optional
+	SubscriberEmailAddresses []string `json:"subscriberEmailAddresses,omitempty"`
+
+	// SubscriberSNSTopicARN is the ARN of the SNS topic that is notified.
+	// +optional
+	SubscriberSNSTopicARN *string `json:"subscriberSnsTopicArn,omitempty"`
+
+	// SubscriberSNSTopicARNRef references an SNSTopic to set the
+	// SubscriberSNSTopicARN.
+	// +optional
+	SubscriberSNSTopicARNRef *runtimev1alpha1.Reference `json:"subscriberSnsTopicArnRef,omitempty"`
+
+	// SubscriberSNSTopicARNSelector selects a reference to an SNSTopic to set
+	// the SubscriberSNSTopicARN.
+	// +optional
+	SubscriberSNSTopicARNSelector *runtimev1alpha1.Selector `json:"subscriberSnsTopicArnSelector,omitempty"`
+}
+
+// BudgetParameters define the desired state of an AWS budget.
+type BudgetParameters struct {
+	// AccountID is the ID of the AWS account the budget is created in.
+	// Defaults to the account of the provider credentials.
+	// +immutable
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// BudgetType specifies whether the budget tracks cost or usage.
+	// +immutable
+	// +kubebuilder:validation:Enum=COST;USAGE
+	BudgetType string `json:"budgetType"`
+
+	// TimeUnit is the length of time until the budget resets.
+	// +kubebuilder:validation:Enum=DAILY;MONTHLY;QUARTERLY;ANNUALLY
+	TimeUnit string `json:"timeUnit"`
+
+	// BudgetLimit is the cost or usage the budget tracks against.
+	BudgetLimit Spend `json:"budgetLimit"`
+
+	// CostFilters limit the cost or usage the budget tracks, e.g. to a
+	// service or to resources with the tag user:team$platform.
+	// +optional
+	CostFilters map[string][]string `json:"costFilters,omitempty"`
+
+	// CostTypes specify which costs are included in a cost budget.
+	// +optional
+	CostTypes *CostTypes `json:"costTypes,omitempty"`
+
+	// TimePeriod is the period the budget covers.
+	// +optional
+	TimePeriod *TimePeriod `json:"timePeriod,omitempty"`
+
+	// Notifications of the budget.
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	Notifications []Notification `json:"notifications,omitempty"`
+}
+
+// BudgetObservation is the observed state of a Budget.
+type BudgetObservation struct {
+	// ActualSpend is the cost or usage that has been incurred in the current
+	// time period.
+	ActualSpend *Spend `json:"actualSpend,omitempty"`
+
+	// ForecastedSpend is the cost or usage that is forecasted for the
+	// current time period.
+	ForecastedSpend *Spend `json:"forecastedSpend,omitempty"`
+}
+
+// A BudgetSpec defines the desired state of a Budget.
+type BudgetSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  BudgetParameters `json:"forProvider"`
+}
+
+// A BudgetStatus represents the observed state of a Budget.
+type BudgetStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     BudgetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Budget is a managed resource that represents an AWS budget. Its external
+// name is the name of the budget.
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.budgetType"
+// +kubebuilder:printcolumn:name="LIMIT",type="string",JSONPath=".spec.forProvider.budgetLimit.amount"
+// +kubebuilder:printcolumn:name="ACTUAL",type="string",JSONPath=".status.atProvider.actualSpend.amount"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Budget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BudgetSpec   `json:"spec"`
+	Status BudgetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BudgetList contains a list of Budgets
+type BudgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Budget `json:"items"`
+}
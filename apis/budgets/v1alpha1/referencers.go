@@ -0,0 +1,53 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	snsv1alpha1 "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// ResolveReferences of this Budget
+func (mg *Budget) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.notifications[].subscriberSnsTopicArn
+	for i := range mg.Spec.ForProvider.Notifications {
+		n := &mg.Spec.ForProvider.Notifications[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(n.SubscriberSNSTopicARN),
+			Reference:    n.SubscriberSNSTopicARNRef,
+			Selector:     n.SubscriberSNSTopicARNSelector,
+			To:           reference.To{Managed: &snsv1alpha1.SNSTopic{}, List: &snsv1alpha1.SNSTopicList{}},
+			Extract:      s3v1beta1.SNSTopicARN(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.notifications[%d].subscriberSnsTopicArn", i)
+		}
+		n.SubscriberSNSTopicARN = reference.ToPtrValue(rsp.ResolvedValue)
+		n.SubscriberSNSTopicARNRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
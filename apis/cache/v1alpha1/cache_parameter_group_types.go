@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A ParameterNameValue is a cache engine parameter of a cache parameter group.
+type ParameterNameValue struct {
+	// ParameterName is the name of the parameter.
+	ParameterName string `json:"parameterName"`
+
+	// ParameterValue is the value of the parameter.
+	ParameterValue string `json:"parameterValue"`
+}
+
+// CacheParameterGroupParameters define the desired state of an AWS
+// ElastiCache Parameter Group.
+type CacheParameterGroupParameters struct {
+	// Region is the region you'd like your CacheParameterGroup to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// CacheParameterGroupFamily is the name of the cache parameter group
+	// family that the cache parameter group can be used with, e.g. redis5.0
+	// or memcached1.5.
+	// +immutable
+	CacheParameterGroupFamily string `json:"cacheParameterGroupFamily"`
+
+	// A description for the cache parameter group.
+	// +immutable
+	Description string `json:"description"`
+
+	// Parameters are the engine parameters that are set by the cache
+	// parameter group. Parameters that are not listed keep the default value
+	// of the cache parameter group family, and parameters that are removed
+	// from this list are reset to their default value.
+	// +optional
+	Parameters []ParameterNameValue `json:"parameters,omitempty"`
+}
+
+// A CacheParameterGroupSpec defines the desired state of a CacheParameterGroup.
+type CacheParameterGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CacheParameterGroupParameters `json:"forProvider"`
+}
+
+// CacheParameterGroupObservation keeps the state for the external resource.
+type CacheParameterGroupObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the cache parameter group.
+	ARN string `json:"arn,omitempty"`
+
+	// IsGlobal indicates whether the cache parameter group is associated
+	// with a Global Datastore.
+	IsGlobal bool `json:"isGlobal,omitempty"`
+}
+
+// A CacheParameterGroupResourceStatus represents the observed state of a
+// CacheParameterGroup. It is not named CacheParameterGroupStatus, which is
+// the status of the cache parameter group of a CacheCluster.
+type CacheParameterGroupResourceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CacheParameterGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CacheParameterGroup is a managed resource that represents an AWS
+// Parameter Group for ElastiCache.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FAMILY",type="string",JSONPath=".spec.forProvider.cacheParameterGroupFamily"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CacheParameterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CacheParameterGroupSpec           `json:"spec"`
+	Status CacheParameterGroupResourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CacheParameterGroupList contains a list of CacheParameterGroup
+type CacheParameterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CacheParameterGroup `json:"items"`
+}
@@ -204,6 +204,14 @@ type CacheClusterParameters struct {
 	// +optional
 	CacheParameterGroupName *string `json:"cacheParameterGroupName,omitempty"`
 
+	// A referencer to retrieve the name of a CacheParameterGroup
+	// +optional
+	CacheParameterGroupNameRef *runtimev1alpha1.Reference `json:"cacheParameterGroupNameRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the name of a CacheParameterGroup
+	// +optional
+	CacheParameterGroupNameSelector *runtimev1alpha1.Selector `json:"cacheParameterGroupNameSelector,omitempty"`
+
 	// A list of security group names to associate with this cluster.
 	// +optional
 	CacheSecurityGroupNames []string `json:"cacheSecurityGroupNames,omitempty"`
@@ -257,6 +265,14 @@ type CacheClusterParameters struct {
 	// +optional
 	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
 
+	// MaintenanceWindowRef references a MaintenanceWindow that the
+	// PreferredMaintenanceWindow is set from. Updates of the cluster that
+	// change its node type, engine version or parameter group, or remove
+	// nodes, are held back until the referenced window opens, unless the
+	// aws.crossplane.io/force-update annotation is set to "true".
+	// +optional
+	MaintenanceWindowRef *runtimev1alpha1.Reference `json:"maintenanceWindowRef,omitempty"`
+
 	// The ID of the replication group to which this cluster should belong.
 	// +optional
 	// +immutable
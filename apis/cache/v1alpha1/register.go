@@ -45,6 +45,14 @@ var (
 	CacheSubnetGroupGroupVersionKind = SchemeGroupVersion.WithKind(CacheSubnetGroupKind)
 )
 
+// CacheParameterGroup type metadata.
+var (
+	CacheParameterGroupKind             = reflect.TypeOf(CacheParameterGroup{}).Name()
+	CacheParameterGroupGroupKind        = schema.GroupKind{Group: Group, Kind: CacheParameterGroupKind}.String()
+	CacheParameterGroupKindAPIVersion   = CacheParameterGroupKind + "." + SchemeGroupVersion.String()
+	CacheParameterGroupGroupVersionKind = SchemeGroupVersion.WithKind(CacheParameterGroupKind)
+)
+
 // CacheCluster type metadata.
 var (
 	CacheClusterKind             = reflect.TypeOf(CacheCluster{}).Name()
@@ -56,4 +64,5 @@ var (
 func init() {
 	SchemeBuilder.Register(&CacheCluster{}, &CacheClusterList{})
 	SchemeBuilder.Register(&CacheSubnetGroup{}, &CacheSubnetGroupList{})
+	SchemeBuilder.Register(&CacheParameterGroup{}, &CacheParameterGroupList{})
 }
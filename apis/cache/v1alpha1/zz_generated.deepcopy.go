@@ -143,6 +143,16 @@ func (in *CacheClusterParameters) DeepCopyInto(out *CacheClusterParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CacheParameterGroupNameRef != nil {
+		in, out := &in.CacheParameterGroupNameRef, &out.CacheParameterGroupNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.CacheParameterGroupNameSelector != nil {
+		in, out := &in.CacheParameterGroupNameSelector, &out.CacheParameterGroupNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CacheSecurityGroupNames != nil {
 		in, out := &in.CacheSecurityGroupNames, &out.CacheSecurityGroupNames
 		*out = make([]string, len(*in))
@@ -198,6 +208,11 @@ func (in *CacheClusterParameters) DeepCopyInto(out *CacheClusterParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.MaintenanceWindowRef != nil {
+		in, out := &in.MaintenanceWindowRef, &out.MaintenanceWindowRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
 	if in.ReplicationGroupID != nil {
 		in, out := &in.ReplicationGroupID, &out.ReplicationGroupID
 		*out = new(string)
@@ -316,6 +331,134 @@ func (in *CacheNode) DeepCopy() *CacheNode {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroup) DeepCopyInto(out *CacheParameterGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroup.
+func (in *CacheParameterGroup) DeepCopy() *CacheParameterGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CacheParameterGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupList) DeepCopyInto(out *CacheParameterGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CacheParameterGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupList.
+func (in *CacheParameterGroupList) DeepCopy() *CacheParameterGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CacheParameterGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupObservation) DeepCopyInto(out *CacheParameterGroupObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupObservation.
+func (in *CacheParameterGroupObservation) DeepCopy() *CacheParameterGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupParameters) DeepCopyInto(out *CacheParameterGroupParameters) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ParameterNameValue, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupParameters.
+func (in *CacheParameterGroupParameters) DeepCopy() *CacheParameterGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupResourceStatus) DeepCopyInto(out *CacheParameterGroupResourceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupResourceStatus.
+func (in *CacheParameterGroupResourceStatus) DeepCopy() *CacheParameterGroupResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroupResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupSpec) DeepCopyInto(out *CacheParameterGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupSpec.
+func (in *CacheParameterGroupSpec) DeepCopy() *CacheParameterGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CacheParameterGroupStatus) DeepCopyInto(out *CacheParameterGroupStatus) {
 	*out = *in
@@ -509,6 +652,21 @@ func (in *NotificationConfiguration) DeepCopy() *NotificationConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterNameValue) DeepCopyInto(out *ParameterNameValue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterNameValue.
+func (in *ParameterNameValue) DeepCopy() *ParameterNameValue {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterNameValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PendingModifiedValues) DeepCopyInto(out *PendingModifiedValues) {
 	*out = *in
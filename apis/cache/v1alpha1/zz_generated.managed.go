@@ -76,6 +76,62 @@ func (mg *CacheCluster) SetWriteConnectionSecretToReference(r *runtimev1alpha1.S
 	mg.Spec.WriteConnectionSecretToReference = r
 }
 
+// GetCondition of this CacheParameterGroup.
+func (mg *CacheParameterGroup) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this CacheParameterGroup.
+func (mg *CacheParameterGroup) GetDeletionPolicy() runtimev1alpha1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this CacheParameterGroup.
+func (mg *CacheParameterGroup) GetProviderConfigReference() *runtimev1alpha1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this CacheParameterGroup.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *CacheParameterGroup) GetProviderReference() *runtimev1alpha1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetWriteConnectionSecretToReference of this CacheParameterGroup.
+func (mg *CacheParameterGroup) GetWriteConnectionSecretToReference() *runtimev1alpha1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this CacheParameterGroup.
+func (mg *CacheParameterGroup) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this CacheParameterGroup.
+func (mg *CacheParameterGroup) SetDeletionPolicy(r runtimev1alpha1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this CacheParameterGroup.
+func (mg *CacheParameterGroup) SetProviderConfigReference(r *runtimev1alpha1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this CacheParameterGroup.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *CacheParameterGroup) SetProviderReference(r *runtimev1alpha1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetWriteConnectionSecretToReference of this CacheParameterGroup.
+func (mg *CacheParameterGroup) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
 // GetCondition of this CacheSubnetGroup.
 func (mg *CacheSubnetGroup) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
 	return mg.Status.GetCondition(ct)
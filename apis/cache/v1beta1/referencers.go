@@ -46,6 +46,20 @@ func (mg *ReplicationGroup) ResolveReferences(ctx context.Context, c client.Read
 	mg.Spec.ForProvider.CacheSubnetGroupName = reference.ToPtrValue(resp.ResolvedValue)
 	mg.Spec.ForProvider.CacheSubnetGroupNameRef = resp.ResolvedReference
 
+	// Resolve spec.forProvider.cacheParameterGroupName
+	resp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CacheParameterGroupName),
+		Reference:    mg.Spec.ForProvider.CacheParameterGroupNameRef,
+		Selector:     mg.Spec.ForProvider.CacheParameterGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha1.CacheParameterGroup{}, List: &v1alpha1.CacheParameterGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.cacheParameterGroupName")
+	}
+	mg.Spec.ForProvider.CacheParameterGroupName = reference.ToPtrValue(resp.ResolvedValue)
+	mg.Spec.ForProvider.CacheParameterGroupNameRef = resp.ResolvedReference
+
 	// Resolve spec.forProvider.securityGroupIds
 	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
 		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
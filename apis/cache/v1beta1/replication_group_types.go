@@ -319,6 +319,16 @@ type ReplicationGroupParameters struct {
 	// +optional
 	CacheParameterGroupName *string `json:"cacheParameterGroupName,omitempty"`
 
+	// CacheParameterGroupNameRef is a reference to a CacheParameterGroup used
+	// to set the CacheParameterGroupName.
+	// +optional
+	CacheParameterGroupNameRef *runtimev1alpha1.Reference `json:"cacheParameterGroupNameRef,omitempty"`
+
+	// CacheParameterGroupNameSelector selects a reference to a
+	// CacheParameterGroup used to set the CacheParameterGroupName.
+	// +optional
+	CacheParameterGroupNameSelector *runtimev1alpha1.Selector `json:"cacheParameterGroupNameSelector,omitempty"`
+
 	// CacheSecurityGroupNames specifies a list of cache security group names to
 	// associate with this replication group. Only for EC2-Classic mode.
 	// +optional
@@ -460,6 +470,14 @@ type ReplicationGroupParameters struct {
 	// +optional
 	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
 
+	// MaintenanceWindowRef references a MaintenanceWindow that the
+	// PreferredMaintenanceWindow is set from. Updates of the replication group
+	// that change its node type, engine version or parameter group are held
+	// back until the referenced window opens, unless the
+	// aws.crossplane.io/force-update annotation is set to "true".
+	// +optional
+	MaintenanceWindowRef *runtimev1alpha1.Reference `json:"maintenanceWindowRef,omitempty"`
+
 	// PrimaryClusterId is the identifier of the cluster that serves as the
 	// primary for this replication group. This cluster must already exist
 	// and have a status of available.
@@ -563,6 +581,9 @@ type ReplicationGroupParameters struct {
 	//
 	// For HIPAA compliance, you must specify TransitEncryptionEnabled as true,
 	// an AuthToken, and a CacheSubnetGroup.
+	//
+	// When enabled, the connection secret also contains a "tls" key set to
+	// "true" and a "serverName" key holding the hostname to verify.
 	// +immutable
 	// +optional
 	TransitEncryptionEnabled *bool `json:"transitEncryptionEnabled,omitempty"`
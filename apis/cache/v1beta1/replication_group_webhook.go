@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-cache-aws-crossplane-io-v1beta1-replicationgroup,mutating=false,failurePolicy=fail,groups=cache.aws.crossplane.io,resources=replicationgroups,verbs=create;update,versions=v1beta1,name=replicationgroups.cache.aws.crossplane.io
+
+var _ webhook.Validator = &ReplicationGroup{}
+
+// ValidateCreate validates a ReplicationGroup on creation.
+func (mg *ReplicationGroup) ValidateCreate() error {
+	return mg.validate()
+}
+
+// ValidateUpdate validates a ReplicationGroup on update.
+func (mg *ReplicationGroup) ValidateUpdate(_ runtime.Object) error {
+	return mg.validate()
+}
+
+// ValidateDelete validates a ReplicationGroup on deletion.
+func (mg *ReplicationGroup) ValidateDelete() error {
+	return nil
+}
+
+// validate checks the constraints between the fields of a ReplicationGroup
+// that AWS would otherwise only report once the group is created.
+func (mg *ReplicationGroup) validate() error {
+	p := mg.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	var errs field.ErrorList
+
+	if p.NumCacheClusters != nil {
+		if p.ReplicasPerNodeGroup != nil {
+			errs = append(errs, field.Forbidden(path.Child("numCacheClusters"), "cannot be set together with replicasPerNodeGroup"))
+		}
+		if p.AutomaticFailoverEnabled != nil && *p.AutomaticFailoverEnabled && *p.NumCacheClusters < 2 {
+			errs = append(errs, field.Invalid(path.Child("numCacheClusters"), *p.NumCacheClusters, "must be at least 2 if automaticFailoverEnabled is true"))
+		}
+		if len(p.PreferredCacheClusterAZs) != 0 && len(p.PreferredCacheClusterAZs) != *p.NumCacheClusters {
+			errs = append(errs, field.Invalid(path.Child("preferredCacheClusterAzs"), p.PreferredCacheClusterAZs, "must list as many availability zones as numCacheClusters"))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(ReplicationGroupGroupVersionKind.GroupKind(), mg.GetName(), errs)
+}
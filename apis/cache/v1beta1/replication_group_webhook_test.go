@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type replicationGroupModifier func(*ReplicationGroup)
+
+func withNumCacheClusters(n int) replicationGroupModifier {
+	return func(r *ReplicationGroup) { r.Spec.ForProvider.NumCacheClusters = &n }
+}
+
+func withReplicasPerNodeGroup(n int) replicationGroupModifier {
+	return func(r *ReplicationGroup) { r.Spec.ForProvider.ReplicasPerNodeGroup = &n }
+}
+
+func withAutomaticFailoverEnabled(b bool) replicationGroupModifier {
+	return func(r *ReplicationGroup) { r.Spec.ForProvider.AutomaticFailoverEnabled = &b }
+}
+
+func withPreferredCacheClusterAZs(azs ...string) replicationGroupModifier {
+	return func(r *ReplicationGroup) { r.Spec.ForProvider.PreferredCacheClusterAZs = azs }
+}
+
+func replicationGroup(m ...replicationGroupModifier) *ReplicationGroup {
+	cr := &ReplicationGroup{}
+	cr.SetName("cache")
+	cr.Spec.ForProvider.Engine = "redis"
+	cr.Spec.ForProvider.CacheNodeType = "cache.t3.micro"
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestReplicationGroupValidate(t *testing.T) {
+	path := field.NewPath("spec", "forProvider")
+	invalid := func(errs ...*field.Error) error {
+		return apierrors.NewInvalid(ReplicationGroupGroupVersionKind.GroupKind(), "cache", errs)
+	}
+
+	cases := map[string]struct {
+		cr   *ReplicationGroup
+		want error
+	}{
+		"NoConstraints": {
+			cr: replicationGroup(),
+		},
+		"ReplicasPerNodeGroup": {
+			cr: replicationGroup(withReplicasPerNodeGroup(2), withAutomaticFailoverEnabled(true)),
+		},
+		"NumCacheClustersWithFailover": {
+			cr: replicationGroup(withNumCacheClusters(2), withAutomaticFailoverEnabled(true), withPreferredCacheClusterAZs("us-east-1a", "us-east-1b")),
+		},
+		"NumCacheClustersAndReplicasPerNodeGroup": {
+			cr:   replicationGroup(withNumCacheClusters(2), withReplicasPerNodeGroup(1)),
+			want: invalid(field.Forbidden(path.Child("numCacheClusters"), "cannot be set together with replicasPerNodeGroup")),
+		},
+		"SingleCacheClusterWithFailover": {
+			cr:   replicationGroup(withNumCacheClusters(1), withAutomaticFailoverEnabled(true)),
+			want: invalid(field.Invalid(path.Child("numCacheClusters"), 1, "must be at least 2 if automaticFailoverEnabled is true")),
+		},
+		"TooFewPreferredCacheClusterAZs": {
+			cr:   replicationGroup(withNumCacheClusters(3), withPreferredCacheClusterAZs("us-east-1a", "us-east-1b")),
+			want: invalid(field.Invalid(path.Child("preferredCacheClusterAzs"), []string{"us-east-1a", "us-east-1b"}, "must list as many availability zones as numCacheClusters")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.cr.ValidateCreate(), test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateCreate(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.cr.ValidateUpdate(replicationGroup()), test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateUpdate(...): -want, +got:\n%s", diff)
+			}
+			if err := tc.cr.ValidateDelete(); err != nil {
+				t.Errorf("ValidateDelete(...): %s", err)
+			}
+		})
+	}
+}
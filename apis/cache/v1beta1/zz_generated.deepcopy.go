@@ -22,7 +22,7 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -228,6 +228,16 @@ func (in *ReplicationGroupParameters) DeepCopyInto(out *ReplicationGroupParamete
 		*out = new(string)
 		**out = **in
 	}
+	if in.CacheParameterGroupNameRef != nil {
+		in, out := &in.CacheParameterGroupNameRef, &out.CacheParameterGroupNameRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.CacheParameterGroupNameSelector != nil {
+		in, out := &in.CacheParameterGroupNameSelector, &out.CacheParameterGroupNameSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CacheSecurityGroupNames != nil {
 		in, out := &in.CacheSecurityGroupNames, &out.CacheSecurityGroupNames
 		*out = make([]string, len(*in))
@@ -305,6 +315,11 @@ func (in *ReplicationGroupParameters) DeepCopyInto(out *ReplicationGroupParamete
 		*out = new(string)
 		**out = **in
 	}
+	if in.MaintenanceWindowRef != nil {
+		in, out := &in.MaintenanceWindowRef, &out.MaintenanceWindowRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
 	if in.PrimaryClusterID != nil {
 		in, out := &in.PrimaryClusterID, &out.PrimaryClusterID
 		*out = new(string)
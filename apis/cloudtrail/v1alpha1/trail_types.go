@@ -0,0 +1,214 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag represents user-provided metadata that can be associated
+// with a CloudTrail trail.
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// DataResource specifies the S3 objects or Lambda functions for which data
+// events are logged.
+type DataResource struct {
+	// Type is the resource type in which to log data events, e.g.
+	// AWS::S3::Object or AWS::Lambda::Function.
+	Type string `json:"type"`
+
+	// Values is a list of ARNs or ARN prefixes of the resources to log data
+	// events for.
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// EventSelector configures which management and data events are logged by a
+// trail.
+type EventSelector struct {
+	// ReadWriteType specifies whether read-only events, write-only events or
+	// all events are logged.
+	// +crossplane:aws:model=cloudtrail.EventSelector.ReadWriteType
+	// +kubebuilder:validation:Enum=ReadOnly;WriteOnly;All
+	// +optional
+	ReadWriteType *string `json:"readWriteType,omitempty"`
+
+	// IncludeManagementEvents specifies whether management events are logged.
+	// +optional
+	IncludeManagementEvents *bool `json:"includeManagementEvents,omitempty"`
+
+	// ExcludeManagementEventSources is a list of event sources whose
+	// management events are not logged, e.g. kms.amazonaws.com.
+	// +optional
+	ExcludeManagementEventSources []string `json:"excludeManagementEventSources,omitempty"`
+
+	// DataResources configure the data events that are logged.
+	// +optional
+	DataResources []DataResource `json:"dataResources,omitempty"`
+}
+
+// TrailParameters define the desired state of an AWS CloudTrail trail.
+type TrailParameters struct {
+	// Region is the home region of the trail.
+	// +immutable
+	Region string `json:"region"`
+
+	// S3BucketName is the name of the S3 bucket the log files are delivered
+	// to.
+	// +optional
+	S3BucketName *string `json:"s3BucketName,omitempty"`
+
+	// S3BucketNameRef references a Bucket to retrieve its name.
+	// +optional
+	S3BucketNameRef *runtimev1alpha1.Reference `json:"s3BucketNameRef,omitempty"`
+
+	// S3BucketNameSelector selects a reference to a Bucket to retrieve its
+	// name.
+	// +optional
+	S3BucketNameSelector *runtimev1alpha1.Selector `json:"s3BucketNameSelector,omitempty"`
+
+	// S3KeyPrefix is the prefix of the S3 keys of the log files.
+	// +optional
+	S3KeyPrefix *string `json:"s3KeyPrefix,omitempty"`
+
+	// SNSTopicName is the name or ARN of the SNS topic that is notified when
+	// log files are delivered.
+	// +optional
+	SNSTopicName *string `json:"snsTopicName,omitempty"`
+
+	// IsMultiRegionTrail specifies whether the trail logs events from all
+	// regions.
+	// +optional
+	IsMultiRegionTrail *bool `json:"isMultiRegionTrail,omitempty"`
+
+	// IsOrganizationTrail specifies whether the trail logs events for all
+	// accounts of the organization.
+	// +optional
+	IsOrganizationTrail *bool `json:"isOrganizationTrail,omitempty"`
+
+	// IncludeGlobalServiceEvents specifies whether events from global
+	// services such as IAM are logged.
+	// +optional
+	IncludeGlobalServiceEvents *bool `json:"includeGlobalServiceEvents,omitempty"`
+
+	// EnableLogFileValidation specifies whether log file integrity validation
+	// is enabled.
+	// +optional
+	EnableLogFileValidation *bool `json:"enableLogFileValidation,omitempty"`
+
+	// KMSKeyID is the KMS key ID, alias or ARN used to encrypt the log
+	// files.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// CloudWatchLogsLogGroupARN is the ARN of the CloudWatch Logs log group
+	// the logs are delivered to.
+	// +optional
+	CloudWatchLogsLogGroupARN *string `json:"cloudWatchLogsLogGroupArn,omitempty"`
+
+	// CloudWatchLogsRoleARN is the ARN of the role CloudTrail assumes to
+	// write to the CloudWatch Logs log group.
+	// +optional
+	CloudWatchLogsRoleARN *string `json:"cloudWatchLogsRoleArn,omitempty"`
+
+	// CloudWatchLogsRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	CloudWatchLogsRoleARNRef *runtimev1alpha1.Reference `json:"cloudWatchLogsRoleArnRef,omitempty"`
+
+	// CloudWatchLogsRoleARNSelector selects a reference to an IAMRole to
+	// retrieve its ARN.
+	// +optional
+	CloudWatchLogsRoleARNSelector *runtimev1alpha1.Selector `json:"cloudWatchLogsRoleArnSelector,omitempty"`
+
+	// EnableLogging specifies whether the trail is recording events.
+	// Defaults to true.
+	// +optional
+	EnableLogging *bool `json:"enableLogging,omitempty"`
+
+	// EventSelectors configure the management and data events that are
+	// logged by the trail.
+	// +optional
+	EventSelectors []EventSelector `json:"eventSelectors,omitempty"`
+
+	// Tags to be added to the trail on creation.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// TrailObservation keeps the state for the external resource
+type TrailObservation struct {
+	// TrailARN is the ARN of the trail.
+	TrailARN string `json:"trailArn,omitempty"`
+
+	// HomeRegion is the region in which the trail was created.
+	HomeRegion string `json:"homeRegion,omitempty"`
+
+	// IsLogging indicates whether the trail is currently recording events.
+	IsLogging bool `json:"isLogging,omitempty"`
+
+	// LatestDeliveryError is the last error CloudTrail encountered when
+	// delivering log files to the S3 bucket.
+	LatestDeliveryError string `json:"latestDeliveryError,omitempty"`
+}
+
+// A TrailSpec defines the desired state of a Trail.
+type TrailSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TrailParameters `json:"forProvider"`
+}
+
+// A TrailStatus represents the observed state of a Trail.
+type TrailStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TrailObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Trail is a managed resource that represents an AWS CloudTrail trail.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.trailArn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Trail struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrailSpec   `json:"spec"`
+	Status TrailStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrailList contains a list of Trails
+type TrailList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Trail `json:"items"`
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Dimension is a name/value pair that is part of the identity of a metric.
+type Dimension struct {
+	// Name of the dimension.
+	Name string `json:"name"`
+
+	// Value of the dimension.
+	Value string `json:"value"`
+}
+
+// TimeRange is a range of time.
+type TimeRange struct {
+	// StartTime of the range.
+	StartTime metav1.Time `json:"startTime"`
+
+	// EndTime of the range.
+	EndTime metav1.Time `json:"endTime"`
+}
+
+// AnomalyDetectorConfiguration configures how the model of an anomaly
+// detector is trained.
+type AnomalyDetectorConfiguration struct {
+	// ExcludedTimeRanges are the periods of time that are not used to train
+	// the model, e.g. deployments or other unusual events.
+	// +optional
+	ExcludedTimeRanges []TimeRange `json:"excludedTimeRanges,omitempty"`
+
+	// MetricTimezone is the time zone used for daylight saving time
+	// adjustments, e.g. America/New_York. Defaults to UTC.
+	// +optional
+	MetricTimezone *string `json:"metricTimezone,omitempty"`
+}
+
+// AnomalyDetectorParameters define the desired state of an AWS CloudWatch
+// anomaly detector.
+type AnomalyDetectorParameters struct {
+	// Region is the region the anomaly detector is created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Namespace of the metric, e.g. AWS/EC2.
+	// +immutable
+	Namespace string `json:"namespace"`
+
+	// MetricName is the name of the metric.
+	// +immutable
+	MetricName string `json:"metricName"`
+
+	// Stat is the statistic of the metric the model is trained on, e.g.
+	// Average or p99.
+	// +immutable
+	Stat string `json:"stat"`
+
+	// Dimensions of the metric.
+	// +immutable
+	// +optional
+	Dimensions []Dimension `json:"dimensions,omitempty"`
+
+	// Configuration of the model of the anomaly detector.
+	// +optional
+	Configuration *AnomalyDetectorConfiguration `json:"configuration,omitempty"`
+}
+
+// AnomalyDetectorObservation keeps the state for the external resource
+type AnomalyDetectorObservation struct {
+	// StateValue is the current state of the model, e.g.
+	// PENDING_TRAINING, TRAINED_INSUFFICIENT_DATA or TRAINED.
+	StateValue string `json:"stateValue,omitempty"`
+}
+
+// An AnomalyDetectorSpec defines the desired state of an AnomalyDetector.
+type AnomalyDetectorSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AnomalyDetectorParameters `json:"forProvider"`
+}
+
+// An AnomalyDetectorStatus represents the observed state of an
+// AnomalyDetector.
+type AnomalyDetectorStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AnomalyDetectorObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AnomalyDetector is a managed resource that represents an AWS CloudWatch
+// anomaly detector. It is identified by the namespace, name, statistic and
+// dimensions of its metric.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAMESPACE",type="string",JSONPath=".spec.forProvider.namespace"
+// +kubebuilder:printcolumn:name="METRIC",type="string",JSONPath=".spec.forProvider.metricName"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.stateValue"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AnomalyDetector struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AnomalyDetectorSpec   `json:"spec"`
+	Status AnomalyDetectorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AnomalyDetectorList contains a list of AnomalyDetectors
+type AnomalyDetectorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AnomalyDetector `json:"items"`
+}
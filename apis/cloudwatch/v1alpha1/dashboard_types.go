@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// A ConfigMapKeySelector is a reference to a key of a ConfigMap in an
+// arbitrary namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// DashboardParameters define the desired state of an AWS CloudWatch
+// dashboard.
+type DashboardParameters struct {
+	// Region is the region the dashboard is created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// DashboardBody is the JSON document that describes the widgets of the
+	// dashboard. One of dashboardBody or dashboardBodyConfigMapRef must be
+	// set.
+	// +optional
+	DashboardBody *string `json:"dashboardBody,omitempty"`
+
+	// DashboardBodyConfigMapRef references a key of a ConfigMap that
+	// contains the JSON document that describes the widgets of the
+	// dashboard.
+	// +optional
+	DashboardBodyConfigMapRef *ConfigMapKeySelector `json:"dashboardBodyConfigMapRef,omitempty"`
+
+	// DashboardBodyVariables are used to render the dashboard body as a Go
+	// template, e.g. {{ .instanceId }}, so that a single body can be shared
+	// by several dashboards. The body is used verbatim if no variables are
+	// given.
+	// +optional
+	DashboardBodyVariables map[string]string `json:"dashboardBodyVariables,omitempty"`
+}
+
+// DashboardObservation keeps the state for the external resource
+type DashboardObservation struct {
+	// DashboardARN is the Amazon Resource Name (ARN) of the dashboard.
+	DashboardARN string `json:"dashboardArn,omitempty"`
+}
+
+// A DashboardSpec defines the desired state of a Dashboard.
+type DashboardSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DashboardParameters `json:"forProvider"`
+}
+
+// A DashboardStatus represents the observed state of a Dashboard.
+type DashboardStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DashboardObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Dashboard is a managed resource that represents an AWS CloudWatch
+// dashboard.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Dashboard struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DashboardSpec   `json:"spec"`
+	Status DashboardStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DashboardList contains a list of Dashboards
+type DashboardList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Dashboard `json:"items"`
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag is a key-value pair that is assigned to a project.
+type Tag struct {
+	// Key is the name of the tag.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=127
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	// +kubebuilder:validation:MaxLength=255
+	Value string `json:"value"`
+}
+
+// ProjectSource is the source code of a project.
+type ProjectSource struct {
+	// Type of the repository that contains the source code.
+	// +kubebuilder:validation:Enum=CODECOMMIT;GITHUB;S3
+	Type string `json:"type"`
+
+	// Location of the source code. It is the HTTPS clone URL of a CodeCommit
+	// or GitHub repository, or the <bucket>/<key> of a ZIP file in S3.
+	// GitHub repositories can only be accessed once an OAuth token or
+	// personal access token was imported as source credentials of the
+	// account.
+	Location string `json:"location"`
+
+	// Buildspec is the inline build specification or the path of the
+	// buildspec file relative to the root of the source. It defaults to
+	// buildspec.yml in the root of the source.
+	// +optional
+	Buildspec *string `json:"buildspec,omitempty"`
+
+	// GitCloneDepth is the depth of the history that is cloned. A depth of 0
+	// clones the full history.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	GitCloneDepth *int64 `json:"gitCloneDepth,omitempty"`
+
+	// FetchSubmodules fetches the Git submodules of the repository.
+	// +optional
+	FetchSubmodules *bool `json:"fetchSubmodules,omitempty"`
+
+	// ReportBuildStatus reports the status of builds that were started by a
+	// webhook back to the GitHub pull request or commit.
+	// +optional
+	ReportBuildStatus *bool `json:"reportBuildStatus,omitempty"`
+
+	// InsecureSSL ignores SSL warnings while connecting to the repository.
+	// +optional
+	InsecureSSL *bool `json:"insecureSsl,omitempty"`
+}
+
+// EnvironmentVariable is an environment variable of the build environment.
+type EnvironmentVariable struct {
+	// Name of the environment variable.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Type of the environment variable. PARAMETER_STORE and SECRETS_MANAGER
+	// variables are resolved by CodeBuild from the parameter or secret that
+	// their value names.
+	// +crossplane:aws:model=codebuild.EnvironmentVariable.Type
+	// +kubebuilder:validation:Enum=PLAINTEXT;PARAMETER_STORE;SECRETS_MANAGER
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// Value of the environment variable.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// ValueSecretRef references the key of a secret that contains the value
+	// of the environment variable. It takes precedence over Value.
+	// +optional
+	ValueSecretRef *runtimev1alpha1.SecretKeySelector `json:"valueSecretRef,omitempty"`
+}
+
+// ProjectEnvironment is the build environment of a project.
+type ProjectEnvironment struct {
+	// Type of the build environment.
+	// +crossplane:aws:model=codebuild.ProjectEnvironment.Type
+	// +kubebuilder:validation:Enum=WINDOWS_CONTAINER;LINUX_CONTAINER;LINUX_GPU_CONTAINER;ARM_CONTAINER
+	Type string `json:"type"`
+
+	// ComputeType is the compute capacity of the build environment.
+	// +crossplane:aws:model=codebuild.ProjectEnvironment.ComputeType
+	// +kubebuilder:validation:Enum=BUILD_GENERAL1_SMALL;BUILD_GENERAL1_MEDIUM;BUILD_GENERAL1_LARGE;BUILD_GENERAL1_2XLARGE
+	ComputeType string `json:"computeType"`
+
+	// Image is the Docker image of the build environment, for example
+	// aws/codebuild/standard:4.0.
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+
+	// ImagePullCredentialsType is the type of credentials that CodeBuild
+	// uses to pull the image.
+	// +crossplane:aws:model=codebuild.ProjectEnvironment.ImagePullCredentialsType
+	// +kubebuilder:validation:Enum=CODEBUILD;SERVICE_ROLE
+	// +optional
+	ImagePullCredentialsType *string `json:"imagePullCredentialsType,omitempty"`
+
+	// PrivilegedMode runs the Docker daemon inside the build container, for
+	// example to build Docker images.
+	// +optional
+	PrivilegedMode *bool `json:"privilegedMode,omitempty"`
+
+	// Certificate is the ARN of the S3 object of a PEM-encoded certificate
+	// for the build environment.
+	// +optional
+	Certificate *string `json:"certificate,omitempty"`
+
+	// EnvironmentVariables of the build environment.
+	// +optional
+	EnvironmentVariables []EnvironmentVariable `json:"environmentVariables,omitempty"`
+}
+
+// ProjectArtifacts are the build output artifacts of a project.
+type ProjectArtifacts struct {
+	// Type of the build output artifacts.
+	// +crossplane:aws:model=codebuild.ProjectArtifacts.Type
+	// +kubebuilder:validation:Enum=CODEPIPELINE;S3;NO_ARTIFACTS
+	Type string `json:"type"`
+
+	// Location is the name of the S3 bucket of S3 artifacts.
+	// +optional
+	Location *string `json:"location,omitempty"`
+
+	// Name of the artifacts object or folder in the bucket.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Path of the artifacts in the bucket.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// NamespaceType adds the build ID to the path of the artifacts when it
+	// is BUILD_ID.
+	// +crossplane:aws:model=codebuild.ProjectArtifacts.NamespaceType
+	// +kubebuilder:validation:Enum=NONE;BUILD_ID
+	// +optional
+	NamespaceType *string `json:"namespaceType,omitempty"`
+
+	// Packaging of the artifacts.
+	// +crossplane:aws:model=codebuild.ProjectArtifacts.Packaging
+	// +kubebuilder:validation:Enum=NONE;ZIP
+	// +optional
+	Packaging *string `json:"packaging,omitempty"`
+
+	// EncryptionDisabled disables the encryption of S3 artifacts.
+	// +optional
+	EncryptionDisabled *bool `json:"encryptionDisabled,omitempty"`
+
+	// OverrideArtifactName lets the buildspec override the name of the
+	// artifacts.
+	// +optional
+	OverrideArtifactName *bool `json:"overrideArtifactName,omitempty"`
+}
+
+// VPCConfig is the VPC that builds run in.
+type VPCConfig struct {
+	// VPCID is the ID of the VPC.
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its ID.
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its ID.
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets that builds run in.
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the builds.
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their IDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their IDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+}
+
+// WebhookFilter is a filter on the events of a webhook.
+type WebhookFilter struct {
+	// Type of the filter.
+	// +crossplane:aws:model=codebuild.WebhookFilter.Type
+	// +kubebuilder:validation:Enum=EVENT;BASE_REF;HEAD_REF;ACTOR_ACCOUNT_ID;FILE_PATH;COMMIT_MESSAGE
+	Type string `json:"type"`
+
+	// Pattern is the regular expression that the filter matches. A filter of
+	// type EVENT takes a comma-separated list of events instead, for example
+	// PUSH, PULL_REQUEST_CREATED.
+	Pattern string `json:"pattern"`
+
+	// ExcludeMatchedPattern inverts the filter.
+	// +optional
+	ExcludeMatchedPattern *bool `json:"excludeMatchedPattern,omitempty"`
+}
+
+// WebhookFilterGroup is a group of filters that must all pass for a webhook
+// event to start a build.
+type WebhookFilterGroup struct {
+	// Filters of the group. One of them must be of type EVENT.
+	// +kubebuilder:validation:MinItems=1
+	Filters []WebhookFilter `json:"filters"`
+}
+
+// Webhook starts a build when code is pushed to the GitHub repository of a
+// project.
+type Webhook struct {
+	// FilterGroups decide which events start a build. An event starts a
+	// build if it passes any of the groups. Every event starts a build if
+	// there are no groups.
+	// +optional
+	FilterGroups []WebhookFilterGroup `json:"filterGroups,omitempty"`
+}
+
+// ProjectParameters define the desired state of an AWS CodeBuild project.
+type ProjectParameters struct {
+	// Region is the region you'd like your Project to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description of the project.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Source is the source code of the project.
+	Source ProjectSource `json:"source"`
+
+	// SourceVersion is the branch, tag, commit ID or S3 object version that
+	// is built by default.
+	// +optional
+	SourceVersion *string `json:"sourceVersion,omitempty"`
+
+	// Environment is the build environment of the project.
+	Environment ProjectEnvironment `json:"environment"`
+
+	// Artifacts are the build output artifacts of the project.
+	Artifacts ProjectArtifacts `json:"artifacts"`
+
+	// ServiceRoleARN is the ARN of the IAM role that CodeBuild assumes to
+	// run builds.
+	// +optional
+	ServiceRoleARN *string `json:"serviceRoleArn,omitempty"`
+
+	// ServiceRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	ServiceRoleARNRef *runtimev1alpha1.Reference `json:"serviceRoleArnRef,omitempty"`
+
+	// ServiceRoleARNSelector selects a reference to an IAMRole to retrieve
+	// its ARN.
+	// +optional
+	ServiceRoleARNSelector *runtimev1alpha1.Selector `json:"serviceRoleArnSelector,omitempty"`
+
+	// EncryptionKey is the ARN or alias of the KMS key that encrypts the
+	// build output artifacts. It defaults to the AWS managed key of S3.
+	// +optional
+	EncryptionKey *string `json:"encryptionKey,omitempty"`
+
+	// TimeoutInMinutes is the time after which a build that is not complete
+	// is stopped.
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=480
+	// +optional
+	TimeoutInMinutes *int64 `json:"timeoutInMinutes,omitempty"`
+
+	// QueuedTimeoutInMinutes is the time after which a build that is still
+	// queued is stopped.
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=480
+	// +optional
+	QueuedTimeoutInMinutes *int64 `json:"queuedTimeoutInMinutes,omitempty"`
+
+	// BadgeEnabled generates a publicly accessible URL of a badge that shows
+	// the status of the latest build.
+	// +optional
+	BadgeEnabled *bool `json:"badgeEnabled,omitempty"`
+
+	// VPCConfig makes builds run in a VPC so that they can access its
+	// resources.
+	// +optional
+	VPCConfig *VPCConfig `json:"vpcConfig,omitempty"`
+
+	// Webhook starts builds when code is pushed to the repository. It is
+	// only supported for GITHUB sources.
+	// +optional
+	Webhook *Webhook `json:"webhook,omitempty"`
+
+	// Tags to assign to the project.
+	// +kubebuilder:validation:MaxItems=50
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ProjectObservation is the observed state of a Project.
+type ProjectObservation struct {
+	// ARN of the project.
+	ARN string `json:"arn,omitempty"`
+
+	// BadgeRequestURL is the URL of the build badge of the project.
+	BadgeRequestURL string `json:"badgeRequestUrl,omitempty"`
+
+	// WebhookURL is the URL of the webhook in the repository.
+	WebhookURL string `json:"webhookUrl,omitempty"`
+
+	// WebhookPayloadURL is the URL that the webhook sends events to.
+	WebhookPayloadURL string `json:"webhookPayloadUrl,omitempty"`
+}
+
+// A ProjectSpec defines the desired state of a Project.
+type ProjectSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ProjectParameters `json:"forProvider"`
+}
+
+// A ProjectStatus represents the observed state of a Project.
+type ProjectStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ProjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Project is a managed resource that represents an AWS CodeBuild project.
+// Its external name is the name of the project.
+// +kubebuilder:printcolumn:name="SOURCE",type="string",JSONPath=".spec.forProvider.source.type"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Project struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectSpec   `json:"spec"`
+	Status ProjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectList contains a list of Projects
+type ProjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Project `json:"items"`
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+)
+
+// ResolveReferences of this Project
+func (mg *Project) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.serviceRoleArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ServiceRoleARN),
+		Reference:    mg.Spec.ForProvider.ServiceRoleARNRef,
+		Selector:     mg.Spec.ForProvider.ServiceRoleARNSelector,
+		To:           reference.To{Managed: &identityv1beta1.IAMRole{}, List: &identityv1beta1.IAMRoleList{}},
+		Extract:      identityv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serviceRoleArn")
+	}
+	mg.Spec.ForProvider.ServiceRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ServiceRoleARNRef = rsp.ResolvedReference
+
+	vpc := mg.Spec.ForProvider.VPCConfig
+	if vpc == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.vpcConfig.vpcId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(vpc.VPCID),
+		Reference:    vpc.VPCIDRef,
+		Selector:     vpc.VPCIDSelector,
+		To:           reference.To{Managed: &ec2.VPC{}, List: &ec2.VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcConfig.vpcId")
+	}
+	vpc.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+	vpc.VPCIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.vpcConfig.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: vpc.SubnetIDs,
+		References:    vpc.SubnetIDRefs,
+		Selector:      vpc.SubnetIDSelector,
+		To:            reference.To{Managed: &ec2.Subnet{}, List: &ec2.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcConfig.subnetIds")
+	}
+	vpc.SubnetIDs = mrsp.ResolvedValues
+	vpc.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.vpcConfig.securityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: vpc.SecurityGroupIDs,
+		References:    vpc.SecurityGroupIDRefs,
+		Selector:      vpc.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &ec2.SecurityGroup{}, List: &ec2.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcConfig.securityGroupIds")
+	}
+	vpc.SecurityGroupIDs = mrsp.ResolvedValues
+	vpc.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
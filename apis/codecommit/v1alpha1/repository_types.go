@@ -0,0 +1,164 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag is a key-value pair that is assigned to a repository.
+type Tag struct {
+	// Key is the name of the tag.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	// +kubebuilder:validation:MaxLength=256
+	Value string `json:"value"`
+}
+
+// RepositoryTrigger notifies an SNS topic or invokes a Lambda function on
+// events of a repository.
+type RepositoryTrigger struct {
+	// Name of the trigger.
+	Name string `json:"name"`
+
+	// DestinationARN is the ARN of the SNS topic or Lambda function that is
+	// notified of the events.
+	// +optional
+	DestinationARN *string `json:"destinationArn,omitempty"`
+
+	// DestinationARNRef references an SNSTopic to retrieve its ARN.
+	// +optional
+	DestinationARNRef *runtimev1alpha1.Reference `json:"destinationArnRef,omitempty"`
+
+	// DestinationARNSelector selects a reference to an SNSTopic to retrieve
+	// its ARN.
+	// +optional
+	DestinationARNSelector *runtimev1alpha1.Selector `json:"destinationArnSelector,omitempty"`
+
+	// Events of the repository that fire the trigger. The all event can not
+	// be combined with other events.
+	// +kubebuilder:validation:MinItems=1
+	// +crossplane:aws:model=codecommit.RepositoryTrigger.Events
+	// +kubebuilder:validation:Enum=all;updateReference;createReference;deleteReference
+	Events []string `json:"events"`
+
+	// Branches that fire the trigger. The trigger fires for all branches if
+	// none are specified.
+	// +optional
+	Branches []string `json:"branches,omitempty"`
+
+	// CustomData is included in the information sent to the destination.
+	// +optional
+	CustomData *string `json:"customData,omitempty"`
+}
+
+// RepositoryParameters define the desired state of an AWS CodeCommit
+// repository.
+type RepositoryParameters struct {
+	// Region is the region you'd like your Repository to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description of the repository.
+	// +kubebuilder:validation:MaxLength=1000
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// DefaultBranch is the name of the branch that is checked out by clones
+	// of the repository. It can only be changed once the branch exists and
+	// defaults to the first branch that is pushed.
+	// +optional
+	DefaultBranch *string `json:"defaultBranch,omitempty"`
+
+	// Triggers of the repository.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	Triggers []RepositoryTrigger `json:"triggers,omitempty"`
+
+	// ApprovalRuleTemplateNames are the names of the approval rule templates
+	// that are associated with the repository. Their rules are applied to
+	// all pull requests that are created in the repository.
+	// +optional
+	ApprovalRuleTemplateNames []string `json:"approvalRuleTemplateNames,omitempty"`
+
+	// Tags to assign to the repository.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// RepositoryObservation is the observed state of a Repository.
+type RepositoryObservation struct {
+	// ARN of the repository.
+	ARN string `json:"arn,omitempty"`
+
+	// RepositoryID is the ID of the repository.
+	RepositoryID string `json:"repositoryId,omitempty"`
+
+	// AccountID is the ID of the AWS account that owns the repository.
+	AccountID string `json:"accountId,omitempty"`
+
+	// CloneURLHTTP is the URL to clone the repository over HTTPS.
+	CloneURLHTTP string `json:"cloneUrlHttp,omitempty"`
+
+	// CloneURLSSH is the URL to clone the repository over SSH.
+	CloneURLSSH string `json:"cloneUrlSsh,omitempty"`
+}
+
+// A RepositorySpec defines the desired state of a Repository.
+type RepositorySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  RepositoryParameters `json:"forProvider"`
+}
+
+// A RepositoryStatus represents the observed state of a Repository.
+type RepositoryStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     RepositoryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Repository is a managed resource that represents an AWS CodeCommit
+// repository. Its external name is the name of the repository. The HTTPS
+// and SSH clone URLs are written to its connection secret.
+// +kubebuilder:printcolumn:name="HTTP-URL",type="string",JSONPath=".status.atProvider.cloneUrlHttp"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Repository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositorySpec   `json:"spec"`
+	Status RepositoryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryList contains a list of Repositories
+type RepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Repository `json:"items"`
+}
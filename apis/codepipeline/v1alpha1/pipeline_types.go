@@ -0,0 +1,283 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag is a key-value pair that is assigned to a pipeline.
+type Tag struct {
+	// Key is the name of the tag.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	// +kubebuilder:validation:MaxLength=256
+	Value string `json:"value"`
+}
+
+// EncryptionKey is the KMS key that encrypts the artifacts of a pipeline.
+type EncryptionKey struct {
+	// ID is the ID, ARN or alias ARN of the key.
+	ID string `json:"id"`
+
+	// Type of the key. Only KMS is supported.
+	// +crossplane:aws:model=codepipeline.EncryptionKey.Type
+	// +kubebuilder:validation:Enum=KMS
+	Type string `json:"type"`
+}
+
+// ArtifactStore is the S3 bucket that a pipeline stores its artifacts in.
+type ArtifactStore struct {
+	// Location is the name of the bucket. It must be in the same region as
+	// the pipeline.
+	// +optional
+	Location *string `json:"location,omitempty"`
+
+	// LocationRef references a Bucket to retrieve its name.
+	// +optional
+	LocationRef *runtimev1alpha1.Reference `json:"locationRef,omitempty"`
+
+	// LocationSelector selects a reference to a Bucket to retrieve its name.
+	// +optional
+	LocationSelector *runtimev1alpha1.Selector `json:"locationSelector,omitempty"`
+
+	// EncryptionKey encrypts the artifacts. The AWS managed key of S3 is used
+	// if it is not set.
+	// +optional
+	EncryptionKey *EncryptionKey `json:"encryptionKey,omitempty"`
+}
+
+// ActionTypeID identifies the type of an action.
+type ActionTypeID struct {
+	// Category of the action.
+	// +crossplane:aws:model=codepipeline.ActionTypeId.Category
+	// +kubebuilder:validation:Enum=Source;Build;Deploy;Test;Invoke;Approval
+	Category string `json:"category"`
+
+	// Owner of the action type.
+	// +crossplane:aws:model=codepipeline.ActionTypeId.Owner
+	// +kubebuilder:validation:Enum=AWS;ThirdParty;Custom
+	Owner string `json:"owner"`
+
+	// Provider of the action, for example CodeCommit, GitHub, CodeBuild or
+	// CloudFormation.
+	Provider string `json:"provider"`
+
+	// Version of the action type.
+	Version string `json:"version"`
+}
+
+// Action is an action of a stage.
+type Action struct {
+	// Name of the action. It must be unique within the stage.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9.@\-_]+$`
+	Name string `json:"name"`
+
+	// ActionTypeID is the type of the action.
+	ActionTypeID ActionTypeID `json:"actionTypeId"`
+
+	// Configuration of the action. Its keys depend on the provider of the
+	// action. Secret values, like the OAuthToken of a GitHub source action,
+	// are masked by CodePipeline and are not compared with their desired
+	// value.
+	// +optional
+	Configuration map[string]string `json:"configuration,omitempty"`
+
+	// InputArtifacts are the names of the artifacts that the action consumes.
+	// +optional
+	InputArtifacts []string `json:"inputArtifacts,omitempty"`
+
+	// OutputArtifacts are the names of the artifacts that the action
+	// produces.
+	// +optional
+	OutputArtifacts []string `json:"outputArtifacts,omitempty"`
+
+	// Namespace of the output variables of the action.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Region of the action, if it is not the region of the pipeline.
+	// +optional
+	Region *string `json:"region,omitempty"`
+
+	// RoleARN is the ARN of the IAM role that the action assumes instead of
+	// the role of the pipeline.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RunOrder is the order in which the actions of the stage run. Actions
+	// with the same run order run in parallel.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=999
+	// +optional
+	RunOrder *int64 `json:"runOrder,omitempty"`
+}
+
+// Stage is a stage of a pipeline.
+type Stage struct {
+	// Name of the stage. It must be unique within the pipeline.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9.@\-_]+$`
+	Name string `json:"name"`
+
+	// Actions of the stage.
+	// +kubebuilder:validation:MinItems=1
+	Actions []Action `json:"actions"`
+}
+
+// WebhookFilterRule is a rule that the payload of a webhook event must match
+// to start the pipeline.
+type WebhookFilterRule struct {
+	// JSONPath selects a value in the payload of the event.
+	JSONPath string `json:"jsonPath"`
+
+	// MatchEquals is the value that the selected value must be equal to. It
+	// can reference the configuration of the target action, like
+	// refs/heads/{Branch}.
+	// +optional
+	MatchEquals *string `json:"matchEquals,omitempty"`
+}
+
+// Webhook starts a pipeline when a third party, like GitHub, notifies it
+// about an event.
+type Webhook struct {
+	// TargetAction is the name of the source action of the pipeline that the
+	// webhook triggers.
+	TargetAction string `json:"targetAction"`
+
+	// Authentication is how the webhook authenticates the events.
+	// +crossplane:aws:model=codepipeline.WebhookDefinition.Authentication
+	// +kubebuilder:validation:Enum=GITHUB_HMAC;IP;UNAUTHENTICATED
+	Authentication string `json:"authentication"`
+
+	// SecretTokenSecretRef references the key of a secret that contains the
+	// token that GITHUB_HMAC authentication verifies events with.
+	// +optional
+	SecretTokenSecretRef *runtimev1alpha1.SecretKeySelector `json:"secretTokenSecretRef,omitempty"`
+
+	// AllowedIPRange is the CIDR block that IP authentication accepts events
+	// from.
+	// +optional
+	AllowedIPRange *string `json:"allowedIpRange,omitempty"`
+
+	// Filters that an event must match to start the pipeline.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=5
+	Filters []WebhookFilterRule `json:"filters"`
+
+	// RegisterWithThirdParty registers the webhook with the repository of
+	// the target action, which is only supported for GitHub.
+	// +optional
+	// +immutable
+	RegisterWithThirdParty *bool `json:"registerWithThirdParty,omitempty"`
+}
+
+// PipelineParameters define the desired state of an AWS CodePipeline
+// pipeline.
+type PipelineParameters struct {
+	// Region is the region you'd like your Pipeline to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// RoleARN is the ARN of the IAM role that CodePipeline assumes to run
+	// the actions of the pipeline.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNRef *runtimev1alpha1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNSelector *runtimev1alpha1.Selector `json:"roleArnSelector,omitempty"`
+
+	// ArtifactStore is the S3 bucket that the pipeline stores its artifacts
+	// in.
+	ArtifactStore ArtifactStore `json:"artifactStore"`
+
+	// Stages of the pipeline. The first stage must only contain source
+	// actions.
+	// +kubebuilder:validation:MinItems=2
+	Stages []Stage `json:"stages"`
+
+	// Webhook starts the pipeline on events of a third party. Its name is the
+	// name of the pipeline.
+	// +optional
+	Webhook *Webhook `json:"webhook,omitempty"`
+
+	// Tags to assign to the pipeline.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// PipelineObservation is the observed state of a Pipeline.
+type PipelineObservation struct {
+	// ARN of the pipeline.
+	ARN string `json:"arn,omitempty"`
+
+	// Version of the pipeline. It is incremented by every update.
+	Version int64 `json:"version,omitempty"`
+
+	// WebhookARN is the ARN of the webhook of the pipeline.
+	WebhookARN string `json:"webhookArn,omitempty"`
+}
+
+// A PipelineSpec defines the desired state of a Pipeline.
+type PipelineSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  PipelineParameters `json:"forProvider"`
+}
+
+// A PipelineStatus represents the observed state of a Pipeline.
+type PipelineStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     PipelineObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Pipeline is a managed resource that represents an AWS CodePipeline
+// pipeline. Its external name is the name of the pipeline. The URL of its
+// webhook is written to its connection secret, since it contains the secret
+// that authorizes the webhook.
+// +kubebuilder:printcolumn:name="VERSION",type="integer",JSONPath=".status.atProvider.version"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Pipeline struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PipelineSpec   `json:"spec"`
+	Status PipelineStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PipelineList contains a list of Pipelines
+type PipelineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Pipeline `json:"items"`
+}
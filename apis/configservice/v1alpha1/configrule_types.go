@@ -0,0 +1,188 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag represents user-provided metadata that can be associated with an AWS
+// Config rule.
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// Scope defines which resources can trigger an evaluation of a rule.
+type Scope struct {
+	// ComplianceResourceID is the ID of the only AWS resource that triggers
+	// an evaluation. It requires a single resource type in
+	// ComplianceResourceTypes.
+	// +optional
+	ComplianceResourceID *string `json:"complianceResourceId,omitempty"`
+
+	// ComplianceResourceTypes are the resource types that trigger an
+	// evaluation, e.g. AWS::S3::Bucket.
+	// +optional
+	ComplianceResourceTypes []string `json:"complianceResourceTypes,omitempty"`
+
+	// TagKey is the tag key applied to the resources that trigger an
+	// evaluation.
+	// +optional
+	TagKey *string `json:"tagKey,omitempty"`
+
+	// TagValue is the tag value applied to the resources that trigger an
+	// evaluation. It requires TagKey.
+	// +optional
+	TagValue *string `json:"tagValue,omitempty"`
+}
+
+// SourceDetail is a source and message type that triggers a custom rule.
+type SourceDetail struct {
+	// EventSource is the source of the event that triggers the evaluation.
+	// +crossplane:aws:model=configservice.SourceDetail.EventSource
+	// +kubebuilder:validation:Enum=aws.config
+	// +optional
+	EventSource *string `json:"eventSource,omitempty"`
+
+	// MessageType is the type of notification that triggers the
+	// evaluation.
+	// +crossplane:aws:model=configservice.SourceDetail.MessageType
+	// +kubebuilder:validation:Enum=ConfigurationItemChangeNotification;ConfigurationSnapshotDeliveryCompleted;ScheduledNotification;OversizedConfigurationItemChangeNotification
+	// +optional
+	MessageType *string `json:"messageType,omitempty"`
+
+	// MaximumExecutionFrequency is the frequency at which periodic
+	// evaluations are run. It requires MessageType ScheduledNotification.
+	// +crossplane:aws:model=configservice.ConfigRule.MaximumExecutionFrequency
+	// +kubebuilder:validation:Enum=One_Hour;Three_Hours;Six_Hours;Twelve_Hours;TwentyFour_Hours
+	// +optional
+	MaximumExecutionFrequency *string `json:"maximumExecutionFrequency,omitempty"`
+}
+
+// Source specifies the owner of the rule and the function that evaluates
+// the resources.
+type Source struct {
+	// Owner of the rule, AWS for AWS managed rules and CUSTOM_LAMBDA for
+	// custom rules backed by a Lambda function.
+	// +crossplane:aws:model=configservice.Source.Owner
+	// +kubebuilder:validation:Enum=CUSTOM_LAMBDA;AWS
+	Owner string `json:"owner"`
+
+	// SourceIdentifier is the identifier of an AWS managed rule, e.g.
+	// S3_BUCKET_VERSIONING_ENABLED, or the ARN of the Lambda function of a
+	// custom rule.
+	SourceIdentifier string `json:"sourceIdentifier"`
+
+	// SourceDetails are the sources and message types that trigger a custom
+	// rule.
+	// +optional
+	SourceDetails []SourceDetail `json:"sourceDetails,omitempty"`
+}
+
+// ConfigRuleParameters define the desired state of an AWS Config rule.
+type ConfigRuleParameters struct {
+	// Region is the region of the rule.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// InputParameters are the parameters passed to the function of the rule
+	// as a JSON document.
+	// +optional
+	InputParameters *string `json:"inputParameters,omitempty"`
+
+	// MaximumExecutionFrequency is the frequency at which AWS Config runs
+	// periodic evaluations of the rule.
+	// +crossplane:aws:model=configservice.ConfigRule.MaximumExecutionFrequency
+	// +kubebuilder:validation:Enum=One_Hour;Three_Hours;Six_Hours;Twelve_Hours;TwentyFour_Hours
+	// +optional
+	MaximumExecutionFrequency *string `json:"maximumExecutionFrequency,omitempty"`
+
+	// Scope defines which resources can trigger an evaluation of the rule.
+	// +optional
+	Scope *Scope `json:"scope,omitempty"`
+
+	// Source specifies the owner of the rule and the function that
+	// evaluates the resources.
+	Source Source `json:"source"`
+
+	// Tags to be added to the rule on creation.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ConfigRuleObservation keeps the state for the external resource
+type ConfigRuleObservation struct {
+	// ConfigRuleARN is the ARN of the rule.
+	ConfigRuleARN string `json:"configRuleArn,omitempty"`
+
+	// ConfigRuleID is the ID of the rule.
+	ConfigRuleID string `json:"configRuleId,omitempty"`
+
+	// ConfigRuleState indicates whether the rule is active or is being
+	// deleted.
+	ConfigRuleState string `json:"configRuleState,omitempty"`
+}
+
+// A ConfigRuleSpec defines the desired state of a ConfigRule.
+type ConfigRuleSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ConfigRuleParameters `json:"forProvider"`
+}
+
+// A ConfigRuleStatus represents the observed state of a ConfigRule.
+type ConfigRuleStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ConfigRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ConfigRule is a managed resource that represents an AWS Config rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.configRuleArn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ConfigRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigRuleSpec   `json:"spec"`
+	Status ConfigRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConfigRuleList contains a list of ConfigRules
+type ConfigRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConfigRule `json:"items"`
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// RecordingGroup specifies the types of AWS resource for which AWS Config
+// records configuration changes.
+type RecordingGroup struct {
+	// AllSupported specifies whether AWS Config records configuration
+	// changes for every supported type of regional resource.
+	// +optional
+	AllSupported *bool `json:"allSupported,omitempty"`
+
+	// IncludeGlobalResourceTypes specifies whether AWS Config includes all
+	// supported types of global resources, e.g. IAM resources, with the
+	// resources that it records. It requires AllSupported to be true.
+	// +optional
+	IncludeGlobalResourceTypes *bool `json:"includeGlobalResourceTypes,omitempty"`
+
+	// ResourceTypes is a list of the resource types that are recorded if
+	// AllSupported is false, e.g. AWS::EC2::Instance.
+	// +optional
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+}
+
+// ConfigurationRecorderParameters define the desired state of an AWS Config
+// configuration recorder.
+type ConfigurationRecorderParameters struct {
+	// Region is the region of the configuration recorder.
+	// +immutable
+	Region string `json:"region"`
+
+	// RoleARN is the ARN of the IAM role that is used to describe the AWS
+	// resources associated with the account.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNRef *runtimev1alpha1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNSelector *runtimev1alpha1.Selector `json:"roleArnSelector,omitempty"`
+
+	// RecordingGroup specifies the types of AWS resources that are recorded.
+	// +optional
+	RecordingGroup *RecordingGroup `json:"recordingGroup,omitempty"`
+
+	// Recording specifies whether the configuration recorder records
+	// configuration changes. A delivery channel must exist before the
+	// recording can be started. Defaults to true.
+	// +optional
+	Recording *bool `json:"recording,omitempty"`
+}
+
+// ConfigurationRecorderObservation keeps the state for the external resource
+type ConfigurationRecorderObservation struct {
+	// Recording indicates whether the recorder is currently recording.
+	Recording bool `json:"recording,omitempty"`
+
+	// LastStatus is the status of the latest recording event processed by
+	// the recorder.
+	LastStatus string `json:"lastStatus,omitempty"`
+
+	// LastErrorCode is the error code of the latest recording failure.
+	LastErrorCode string `json:"lastErrorCode,omitempty"`
+
+	// LastErrorMessage is the message of the latest recording failure.
+	LastErrorMessage string `json:"lastErrorMessage,omitempty"`
+}
+
+// A ConfigurationRecorderSpec defines the desired state of a ConfigurationRecorder.
+type ConfigurationRecorderSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ConfigurationRecorderParameters `json:"forProvider"`
+}
+
+// A ConfigurationRecorderStatus represents the observed state of a ConfigurationRecorder.
+type ConfigurationRecorderStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ConfigurationRecorderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ConfigurationRecorder is a managed resource that represents an AWS Config configuration recorder.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="RECORDING",type="boolean",JSONPath=".status.atProvider.recording"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ConfigurationRecorder struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigurationRecorderSpec   `json:"spec"`
+	Status ConfigurationRecorderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConfigurationRecorderList contains a list of ConfigurationRecorders
+type ConfigurationRecorderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConfigurationRecorder `json:"items"`
+}
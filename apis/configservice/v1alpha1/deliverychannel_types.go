@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// DeliveryChannelParameters define the desired state of an AWS Config
+// delivery channel.
+type DeliveryChannelParameters struct {
+	// Region is the region of the delivery channel.
+	// +immutable
+	Region string `json:"region"`
+
+	// S3BucketName is the name of the S3 bucket to which AWS Config delivers
+	// configuration snapshots and history files.
+	// +optional
+	S3BucketName *string `json:"s3BucketName,omitempty"`
+
+	// S3BucketNameRef references a Bucket to retrieve its name.
+	// +optional
+	S3BucketNameRef *runtimev1alpha1.Reference `json:"s3BucketNameRef,omitempty"`
+
+	// S3BucketNameSelector selects a reference to a Bucket to retrieve its
+	// name.
+	// +optional
+	S3BucketNameSelector *runtimev1alpha1.Selector `json:"s3BucketNameSelector,omitempty"`
+
+	// S3KeyPrefix is the prefix for the specified S3 bucket.
+	// +optional
+	S3KeyPrefix *string `json:"s3KeyPrefix,omitempty"`
+
+	// SNSTopicARN is the ARN of the SNS topic to which AWS Config sends
+	// notifications about configuration changes.
+	// +optional
+	SNSTopicARN *string `json:"snsTopicArn,omitempty"`
+
+	// SNSTopicARNRef references an SNSTopic to retrieve its ARN.
+	// +optional
+	SNSTopicARNRef *runtimev1alpha1.Reference `json:"snsTopicArnRef,omitempty"`
+
+	// SNSTopicARNSelector selects a reference to an SNSTopic to retrieve its
+	// ARN.
+	// +optional
+	SNSTopicARNSelector *runtimev1alpha1.Selector `json:"snsTopicArnSelector,omitempty"`
+
+	// DeliveryFrequency is the frequency with which AWS Config delivers
+	// configuration snapshots.
+	// +crossplane:aws:model=configservice.ConfigSnapshotDeliveryProperties.DeliveryFrequency
+	// +kubebuilder:validation:Enum=One_Hour;Three_Hours;Six_Hours;Twelve_Hours;TwentyFour_Hours
+	// +optional
+	DeliveryFrequency *string `json:"deliveryFrequency,omitempty"`
+}
+
+// A DeliveryChannelSpec defines the desired state of a DeliveryChannel.
+type DeliveryChannelSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DeliveryChannelParameters `json:"forProvider"`
+}
+
+// A DeliveryChannelStatus represents the observed state of a DeliveryChannel.
+type DeliveryChannelStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DeliveryChannel is a managed resource that represents an AWS Config
+// delivery channel.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.s3BucketName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DeliveryChannel struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeliveryChannelSpec   `json:"spec"`
+	Status DeliveryChannelStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeliveryChannelList contains a list of DeliveryChannels
+type DeliveryChannelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeliveryChannel `json:"items"`
+}
@@ -0,0 +1,609 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigRule) DeepCopyInto(out *ConfigRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigRule.
+func (in *ConfigRule) DeepCopy() *ConfigRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigRuleList) DeepCopyInto(out *ConfigRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ConfigRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigRuleList.
+func (in *ConfigRuleList) DeepCopy() *ConfigRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigRuleObservation) DeepCopyInto(out *ConfigRuleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigRuleObservation.
+func (in *ConfigRuleObservation) DeepCopy() *ConfigRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigRuleParameters) DeepCopyInto(out *ConfigRuleParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.InputParameters != nil {
+		in, out := &in.InputParameters, &out.InputParameters
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaximumExecutionFrequency != nil {
+		in, out := &in.MaximumExecutionFrequency, &out.MaximumExecutionFrequency
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(Scope)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Source.DeepCopyInto(&out.Source)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigRuleParameters.
+func (in *ConfigRuleParameters) DeepCopy() *ConfigRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigRuleSpec) DeepCopyInto(out *ConfigRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigRuleSpec.
+func (in *ConfigRuleSpec) DeepCopy() *ConfigRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigRuleStatus) DeepCopyInto(out *ConfigRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigRuleStatus.
+func (in *ConfigRuleStatus) DeepCopy() *ConfigRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationRecorder) DeepCopyInto(out *ConfigurationRecorder) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationRecorder.
+func (in *ConfigurationRecorder) DeepCopy() *ConfigurationRecorder {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationRecorder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigurationRecorder) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationRecorderList) DeepCopyInto(out *ConfigurationRecorderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ConfigurationRecorder, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationRecorderList.
+func (in *ConfigurationRecorderList) DeepCopy() *ConfigurationRecorderList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationRecorderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigurationRecorderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationRecorderObservation) DeepCopyInto(out *ConfigurationRecorderObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationRecorderObservation.
+func (in *ConfigurationRecorderObservation) DeepCopy() *ConfigurationRecorderObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationRecorderObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationRecorderParameters) DeepCopyInto(out *ConfigurationRecorderParameters) {
+	*out = *in
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARNRef != nil {
+		in, out := &in.RoleARNRef, &out.RoleARNRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.RoleARNSelector != nil {
+		in, out := &in.RoleARNSelector, &out.RoleARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecordingGroup != nil {
+		in, out := &in.RecordingGroup, &out.RecordingGroup
+		*out = new(RecordingGroup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Recording != nil {
+		in, out := &in.Recording, &out.Recording
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationRecorderParameters.
+func (in *ConfigurationRecorderParameters) DeepCopy() *ConfigurationRecorderParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationRecorderParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationRecorderSpec) DeepCopyInto(out *ConfigurationRecorderSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationRecorderSpec.
+func (in *ConfigurationRecorderSpec) DeepCopy() *ConfigurationRecorderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationRecorderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationRecorderStatus) DeepCopyInto(out *ConfigurationRecorderStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationRecorderStatus.
+func (in *ConfigurationRecorderStatus) DeepCopy() *ConfigurationRecorderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationRecorderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeliveryChannel) DeepCopyInto(out *DeliveryChannel) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeliveryChannel.
+func (in *DeliveryChannel) DeepCopy() *DeliveryChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(DeliveryChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeliveryChannel) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeliveryChannelList) DeepCopyInto(out *DeliveryChannelList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeliveryChannel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeliveryChannelList.
+func (in *DeliveryChannelList) DeepCopy() *DeliveryChannelList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeliveryChannelList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeliveryChannelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeliveryChannelParameters) DeepCopyInto(out *DeliveryChannelParameters) {
+	*out = *in
+	if in.S3BucketName != nil {
+		in, out := &in.S3BucketName, &out.S3BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3BucketNameRef != nil {
+		in, out := &in.S3BucketNameRef, &out.S3BucketNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.S3BucketNameSelector != nil {
+		in, out := &in.S3BucketNameSelector, &out.S3BucketNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3KeyPrefix != nil {
+		in, out := &in.S3KeyPrefix, &out.S3KeyPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.SNSTopicARN != nil {
+		in, out := &in.SNSTopicARN, &out.SNSTopicARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SNSTopicARNRef != nil {
+		in, out := &in.SNSTopicARNRef, &out.SNSTopicARNRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.SNSTopicARNSelector != nil {
+		in, out := &in.SNSTopicARNSelector, &out.SNSTopicARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeliveryFrequency != nil {
+		in, out := &in.DeliveryFrequency, &out.DeliveryFrequency
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeliveryChannelParameters.
+func (in *DeliveryChannelParameters) DeepCopy() *DeliveryChannelParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DeliveryChannelParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeliveryChannelSpec) DeepCopyInto(out *DeliveryChannelSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeliveryChannelSpec.
+func (in *DeliveryChannelSpec) DeepCopy() *DeliveryChannelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeliveryChannelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeliveryChannelStatus) DeepCopyInto(out *DeliveryChannelStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeliveryChannelStatus.
+func (in *DeliveryChannelStatus) DeepCopy() *DeliveryChannelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeliveryChannelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordingGroup) DeepCopyInto(out *RecordingGroup) {
+	*out = *in
+	if in.AllSupported != nil {
+		in, out := &in.AllSupported, &out.AllSupported
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncludeGlobalResourceTypes != nil {
+		in, out := &in.IncludeGlobalResourceTypes, &out.IncludeGlobalResourceTypes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResourceTypes != nil {
+		in, out := &in.ResourceTypes, &out.ResourceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordingGroup.
+func (in *RecordingGroup) DeepCopy() *RecordingGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordingGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Scope) DeepCopyInto(out *Scope) {
+	*out = *in
+	if in.ComplianceResourceID != nil {
+		in, out := &in.ComplianceResourceID, &out.ComplianceResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ComplianceResourceTypes != nil {
+		in, out := &in.ComplianceResourceTypes, &out.ComplianceResourceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TagKey != nil {
+		in, out := &in.TagKey, &out.TagKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.TagValue != nil {
+		in, out := &in.TagValue, &out.TagValue
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scope.
+func (in *Scope) DeepCopy() *Scope {
+	if in == nil {
+		return nil
+	}
+	out := new(Scope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Source) DeepCopyInto(out *Source) {
+	*out = *in
+	if in.SourceDetails != nil {
+		in, out := &in.SourceDetails, &out.SourceDetails
+		*out = make([]SourceDetail, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Source.
+func (in *Source) DeepCopy() *Source {
+	if in == nil {
+		return nil
+	}
+	out := new(Source)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceDetail) DeepCopyInto(out *SourceDetail) {
+	*out = *in
+	if in.EventSource != nil {
+		in, out := &in.EventSource, &out.EventSource
+		*out = new(string)
+		**out = **in
+	}
+	if in.MessageType != nil {
+		in, out := &in.MessageType, &out.MessageType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaximumExecutionFrequency != nil {
+		in, out := &in.MaximumExecutionFrequency, &out.MaximumExecutionFrequency
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceDetail.
+func (in *SourceDetail) DeepCopy() *SourceDetail {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceDetail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,368 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// RDS DB cluster states.
+const (
+	// The cluster is healthy and available.
+	DBClusterStateAvailable = "available"
+	// The cluster is being created or restored from a snapshot.
+	DBClusterStateCreating = "creating"
+	// The cluster is being deleted.
+	DBClusterStateDeleting = "deleting"
+	// The cluster is being modified.
+	DBClusterStateModifying = "modifying"
+	// The cluster is being backed up.
+	DBClusterStateBackingUp = "backing-up"
+)
+
+// ScalingConfiguration contains the scaling configuration of an Aurora DB
+// cluster in serverless engine mode.
+type ScalingConfiguration struct {
+	// MinCapacity is the minimum capacity in Aurora capacity units (ACUs).
+	// Valid values are 1, 2, 4, 8, 16, 32, 64, 128 and 256 for aurora-mysql
+	// and 2, 4, 8, 16, 32, 64, 192 and 384 for aurora-postgresql.
+	// +optional
+	MinCapacity *int64 `json:"minCapacity,omitempty"`
+
+	// MaxCapacity is the maximum capacity in Aurora capacity units (ACUs).
+	// It takes the same values as MinCapacity.
+	// +optional
+	MaxCapacity *int64 `json:"maxCapacity,omitempty"`
+
+	// AutoPause allows the DB cluster to be paused when it has no
+	// connections.
+	// +optional
+	AutoPause *bool `json:"autoPause,omitempty"`
+
+	// SecondsUntilAutoPause is the time, in seconds, before an idle DB
+	// cluster is paused.
+	// +kubebuilder:validation:Minimum=300
+	// +kubebuilder:validation:Maximum=86400
+	// +optional
+	SecondsUntilAutoPause *int64 `json:"secondsUntilAutoPause,omitempty"`
+
+	// TimeoutAction is the action to take when a scaling point cannot be
+	// found: ForceApplyCapacityChange or RollbackCapacityChange.
+	// +kubebuilder:validation:Enum=ForceApplyCapacityChange;RollbackCapacityChange
+	// +optional
+	TimeoutAction *string `json:"timeoutAction,omitempty"`
+}
+
+// DBClusterParameters define the desired state of an AWS RDS Aurora DB
+// cluster.
+type DBClusterParameters struct {
+	// Region is the region you'd like the DBCluster to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Engine is the database engine of the DB cluster: aurora (MySQL 5.6
+	// compatible), aurora-mysql or aurora-postgresql.
+	// +kubebuilder:validation:Enum=aurora;aurora-mysql;aurora-postgresql
+	// +immutable
+	Engine string `json:"engine"`
+
+	// EngineVersion is the version number of the database engine to use.
+	// Default: the default version of the engine in the region.
+	// +optional
+	EngineVersion *string `json:"engineVersion,omitempty"`
+
+	// EngineMode is the DB engine mode of the DB cluster: provisioned,
+	// serverless, parallelquery, global or multimaster.
+	// Default: provisioned
+	// +immutable
+	// +optional
+	EngineMode *string `json:"engineMode,omitempty"`
+
+	// ScalingConfiguration is the scaling configuration of a DB cluster in
+	// serverless engine mode.
+	// +optional
+	ScalingConfiguration *ScalingConfiguration `json:"scalingConfiguration,omitempty"`
+
+	// GlobalClusterIdentifier is the identifier of the Aurora global
+	// database that the DB cluster is added to. A secondary DB cluster of a
+	// global database has no master user, since it is replicated from the
+	// primary DB cluster.
+	// +immutable
+	// +optional
+	GlobalClusterIdentifier *string `json:"globalClusterIdentifier,omitempty"`
+
+	// DatabaseName is the name of the database that is created with the DB
+	// cluster.
+	// +immutable
+	// +optional
+	DatabaseName *string `json:"databaseName,omitempty"`
+
+	// MasterUsername is the name of the master user of the DB cluster. It is
+	// published to the connection secret together with the password. It
+	// must be set unless the DB cluster is a secondary DB cluster of a
+	// global database or restored from a snapshot.
+	// +immutable
+	// +optional
+	MasterUsername *string `json:"masterUsername,omitempty"`
+
+	// MasterPasswordSecretRef references the secret key that contains the
+	// password of the master user. A password is generated if it is not set.
+	// Changing the referenced password changes the password of the master
+	// user.
+	// +optional
+	MasterPasswordSecretRef *runtimev1alpha1.SecretKeySelector `json:"masterPasswordSecretRef,omitempty"`
+
+	// Port is the port number on which the instances in the DB cluster
+	// accept connections.
+	// Default: 3306 for aurora and aurora-mysql, 5432 for aurora-postgresql
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// AvailabilityZones are the EC2 Availability Zones that instances in the
+	// DB cluster can be created in.
+	// +immutable
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// DBClusterParameterGroupName is the name of the DB cluster parameter
+	// group to associate with this DB cluster.
+	// Default: the default DB cluster parameter group of the engine
+	// +optional
+	DBClusterParameterGroupName *string `json:"dbClusterParameterGroupName,omitempty"`
+
+	// DBSubnetGroupName is the DB subnet group to associate with this DB
+	// cluster.
+	// +immutable
+	// +optional
+	DBSubnetGroupName *string `json:"dbSubnetGroupName,omitempty"`
+
+	// DBSubnetGroupNameRef is a reference to a DBSubnetGroup used to set
+	// DBSubnetGroupName.
+	// +immutable
+	// +optional
+	DBSubnetGroupNameRef *runtimev1alpha1.Reference `json:"dbSubnetGroupNameRef,omitempty"`
+
+	// DBSubnetGroupNameSelector selects a reference to a DBSubnetGroup used to
+	// set DBSubnetGroupName.
+	// +immutable
+	// +optional
+	DBSubnetGroupNameSelector *runtimev1alpha1.Selector `json:"dbSubnetGroupNameSelector,omitempty"`
+
+	// VPCSecurityGroupIDs is a list of EC2 VPC security groups to associate
+	// with this DB cluster.
+	// +optional
+	VPCSecurityGroupIDs []string `json:"vpcSecurityGroupIds,omitempty"`
+
+	// VPCSecurityGroupIDRefs are references to VPCSecurityGroups used to set
+	// the VPCSecurityGroupIDs.
+	// +optional
+	VPCSecurityGroupIDRefs []runtimev1alpha1.Reference `json:"vpcSecurityGroupIdRefs,omitempty"`
+
+	// VPCSecurityGroupIDSelector selects references to VPCSecurityGroups used
+	// to set the VPCSecurityGroupIDs.
+	// +optional
+	VPCSecurityGroupIDSelector *runtimev1alpha1.Selector `json:"vpcSecurityGroupIdSelector,omitempty"`
+
+	// BackupRetentionPeriod is the number of days for which automated backups
+	// are retained.
+	// Default: 1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=35
+	// +optional
+	BackupRetentionPeriod *int64 `json:"backupRetentionPeriod,omitempty"`
+
+	// PreferredBackupWindow is the daily time range during which automated
+	// backups are created, in the format hh24:mi-hh24:mi in UTC.
+	// +optional
+	PreferredBackupWindow *string `json:"preferredBackupWindow,omitempty"`
+
+	// PreferredMaintenanceWindow is the weekly time range during which system
+	// maintenance can occur, in the format ddd:hh24:mi-ddd:hh24:mi in UTC.
+	// +optional
+	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
+
+	// StorageEncrypted specifies whether the DB cluster is encrypted.
+	// +immutable
+	// +optional
+	StorageEncrypted *bool `json:"storageEncrypted,omitempty"`
+
+	// KMSKeyID is the AWS KMS key identifier for an encrypted DB cluster.
+	// If StorageEncrypted is true and KMSKeyID is not set, the default
+	// encryption key of the account is used.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// EnableIAMDatabaseAuthentication specifies whether IAM accounts can be
+	// mapped to database accounts.
+	// +optional
+	EnableIAMDatabaseAuthentication *bool `json:"enableIAMDatabaseAuthentication,omitempty"`
+
+	// CopyTagsToSnapshot specifies whether the tags of the DB cluster are
+	// copied to its snapshots.
+	// +optional
+	CopyTagsToSnapshot *bool `json:"copyTagsToSnapshot,omitempty"`
+
+	// DeletionProtection specifies whether the DB cluster can be deleted.
+	// The DB cluster can't be deleted while it is set to true.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+
+	// EnableCloudwatchLogsExports is the list of log types to export to
+	// CloudWatch Logs, e.g. audit, error, general and slowquery for
+	// aurora-mysql, or postgresql for aurora-postgresql.
+	// +optional
+	EnableCloudwatchLogsExports []string `json:"enableCloudwatchLogsExports,omitempty"`
+
+	// SnapshotIdentifier is the identifier of the DB cluster snapshot to
+	// restore the DB cluster from. The DB cluster is created empty if it is
+	// not set.
+	// +immutable
+	// +optional
+	SnapshotIdentifier *string `json:"snapshotIdentifier,omitempty"`
+
+	// ApplyImmediately specifies whether modifications are applied
+	// immediately, or during the next maintenance window.
+	// Default: false
+	// +optional
+	ApplyImmediately *bool `json:"applyImmediately,omitempty"`
+
+	// SkipFinalSnapshot determines whether a final DB cluster snapshot is
+	// created before the DB cluster is deleted.
+	// FinalDBSnapshotIdentifier must be set if SkipFinalSnapshot is false.
+	// Default: false
+	// +optional
+	SkipFinalSnapshot *bool `json:"skipFinalSnapshot,omitempty"`
+
+	// FinalDBSnapshotIdentifier is the identifier of the DB cluster snapshot
+	// created when SkipFinalSnapshot is false.
+	// +optional
+	FinalDBSnapshotIdentifier *string `json:"finalDBSnapshotIdentifier,omitempty"`
+
+	// Tags to assign to the DB cluster.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// DBClusterMember is an instance that is part of a DB cluster.
+type DBClusterMember struct {
+	// DBInstanceIdentifier is the identifier of the instance.
+	DBInstanceIdentifier string `json:"dbInstanceIdentifier,omitempty"`
+
+	// IsClusterWriter is true if the instance is the primary instance of the
+	// DB cluster.
+	IsClusterWriter bool `json:"isClusterWriter,omitempty"`
+
+	// PromotionTier is the order in which a replica is promoted to the
+	// primary instance after a failure of the existing primary instance.
+	PromotionTier int64 `json:"promotionTier,omitempty"`
+}
+
+// DBClusterObservation is the representation of the current state that is
+// observed.
+type DBClusterObservation struct {
+	// DBClusterARN is the Amazon Resource Name (ARN) of the DB cluster.
+	DBClusterARN string `json:"dbClusterArn,omitempty"`
+
+	// DBClusterResourceID is the AWS Region-unique, immutable identifier of
+	// the DB cluster.
+	DBClusterResourceID string `json:"dbClusterResourceId,omitempty"`
+
+	// Status is the current state of the DB cluster.
+	Status string `json:"status,omitempty"`
+
+	// Endpoint is the connection endpoint of the writer instance of the DB
+	// cluster.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// ReaderEndpoint is the endpoint that load-balances connections across
+	// the reader instances of the DB cluster.
+	ReaderEndpoint string `json:"readerEndpoint,omitempty"`
+
+	// Port is the port that the DB cluster listens on.
+	Port int64 `json:"port,omitempty"`
+
+	// EngineMode is the DB engine mode of the DB cluster.
+	EngineMode string `json:"engineMode,omitempty"`
+
+	// Capacity is the current capacity of a DB cluster in serverless engine
+	// mode, in Aurora capacity units. It is 0 while the DB cluster is paused.
+	Capacity int64 `json:"capacity,omitempty"`
+
+	// DBClusterParameterGroup is the name of the DB cluster parameter group
+	// of the DB cluster.
+	DBClusterParameterGroup string `json:"dbClusterParameterGroup,omitempty"`
+
+	// HostedZoneID is the ID that Amazon Route 53 assigns when you create a
+	// hosted zone.
+	HostedZoneID string `json:"hostedZoneId,omitempty"`
+
+	// MultiAZ specifies whether the DB cluster has instances in multiple
+	// Availability Zones.
+	MultiAZ bool `json:"multiAZ,omitempty"`
+
+	// ReplicationSourceIdentifier is the identifier of the source DB cluster
+	// if this DB cluster is a read replica.
+	ReplicationSourceIdentifier string `json:"replicationSourceIdentifier,omitempty"`
+
+	// Members are the instances that make up the DB cluster.
+	Members []DBClusterMember `json:"members,omitempty"`
+}
+
+// DBClusterSpec defines the desired state of an AWS RDS Aurora DBCluster.
+type DBClusterSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DBClusterParameters `json:"forProvider"`
+}
+
+// DBClusterStatus represents the observed state of an AWS RDS Aurora
+// DBCluster.
+type DBClusterStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DBClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBCluster is a managed resource that represents an AWS RDS Aurora DB
+// cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="ENGINE",type="string",JSONPath=".spec.forProvider.engine"
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBClusterSpec   `json:"spec"`
+	Status DBClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBClusterList contains a list of DBCluster
+type DBClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBCluster `json:"items"`
+}
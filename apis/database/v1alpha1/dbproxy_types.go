@@ -0,0 +1,202 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// RDS DB proxy states.
+const (
+	// The proxy is ready to handle requests.
+	DBProxyStateAvailable = "available"
+	// The proxy is being created.
+	DBProxyStateCreating = "creating"
+	// The proxy is being modified.
+	DBProxyStateModifying = "modifying"
+	// The proxy is being deleted.
+	DBProxyStateDeleting = "deleting"
+)
+
+// UserAuthConfig specifies how the proxy authenticates to the database as a
+// database user.
+type UserAuthConfig struct {
+	// AuthScheme is the type of authentication that the proxy uses for
+	// connections from the proxy to the database. SECRETS is the only
+	// supported scheme.
+	// +kubebuilder:validation:Enum=SECRETS
+	// +optional
+	AuthScheme *string `json:"authScheme,omitempty"`
+
+	// Description of the user authentication.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// IAMAuth specifies whether clients must use IAM authentication to
+	// connect to the proxy.
+	// +kubebuilder:validation:Enum=DISABLED;REQUIRED
+	// +optional
+	IAMAuth *string `json:"iamAuth,omitempty"`
+
+	// SecretARN is the ARN of the AWS Secrets Manager secret that contains
+	// the database credentials of the user.
+	SecretARN string `json:"secretArn"`
+
+	// UserName is the name of the database user that the proxy connects as.
+	// +optional
+	UserName *string `json:"userName,omitempty"`
+}
+
+// DBProxyParameters define the desired state of an AWS RDS DB proxy.
+type DBProxyParameters struct {
+	// Region is the region you'd like the DBProxy to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// EngineFamily is the kind of database engine that the proxy connects
+	// to: MYSQL or POSTGRESQL.
+	// +kubebuilder:validation:Enum=MYSQL;POSTGRESQL
+	// +immutable
+	EngineFamily string `json:"engineFamily"`
+
+	// Auth are the authorization mechanisms that the proxy uses to connect
+	// to the database.
+	// +kubebuilder:validation:MinItems=1
+	Auth []UserAuthConfig `json:"auth"`
+
+	// RoleARN is the ARN of the IAM role that the proxy uses to access the
+	// secrets in AWS Secrets Manager.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef is a reference to an IAMRole used to set RoleARN.
+	// +optional
+	RoleARNRef *runtimev1alpha1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole used to set RoleARN.
+	// +optional
+	RoleARNSelector *runtimev1alpha1.Selector `json:"roleArnSelector,omitempty"`
+
+	// VPCSubnetIDs are the IDs of the subnets that the proxy is placed in.
+	// +immutable
+	// +optional
+	VPCSubnetIDs []string `json:"vpcSubnetIds,omitempty"`
+
+	// VPCSubnetIDRefs are references to Subnets used to set the VPCSubnetIDs.
+	// +immutable
+	// +optional
+	VPCSubnetIDRefs []runtimev1alpha1.Reference `json:"vpcSubnetIdRefs,omitempty"`
+
+	// VPCSubnetIDSelector selects references to Subnets used to set the
+	// VPCSubnetIDs.
+	// +immutable
+	// +optional
+	VPCSubnetIDSelector *runtimev1alpha1.Selector `json:"vpcSubnetIdSelector,omitempty"`
+
+	// VPCSecurityGroupIDs are the IDs of the VPC security groups of the
+	// proxy.
+	// +optional
+	VPCSecurityGroupIDs []string `json:"vpcSecurityGroupIds,omitempty"`
+
+	// VPCSecurityGroupIDRefs are references to SecurityGroups used to set the
+	// VPCSecurityGroupIDs.
+	// +optional
+	VPCSecurityGroupIDRefs []runtimev1alpha1.Reference `json:"vpcSecurityGroupIdRefs,omitempty"`
+
+	// VPCSecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the VPCSecurityGroupIDs.
+	// +optional
+	VPCSecurityGroupIDSelector *runtimev1alpha1.Selector `json:"vpcSecurityGroupIdSelector,omitempty"`
+
+	// IdleClientTimeout is the number of seconds that a client connection to
+	// the proxy can be inactive before the proxy drops it.
+	// Default: 1800
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=28800
+	// +optional
+	IdleClientTimeout *int64 `json:"idleClientTimeout,omitempty"`
+
+	// RequireTLS specifies whether connections to the proxy must use
+	// Transport Layer Security (TLS) encryption.
+	// +optional
+	RequireTLS *bool `json:"requireTLS,omitempty"`
+
+	// DebugLogging specifies whether the proxy logs detailed information
+	// about SQL statements. Only enable it while debugging, since the logs
+	// may contain sensitive information.
+	// +optional
+	DebugLogging *bool `json:"debugLogging,omitempty"`
+
+	// Tags to assign to the DB proxy.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// DBProxyObservation is the representation of the current state that is
+// observed.
+type DBProxyObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the DB proxy.
+	ARN string `json:"arn,omitempty"`
+
+	// Status is the current state of the DB proxy.
+	Status string `json:"status,omitempty"`
+
+	// Endpoint is the endpoint that clients connect to the proxy with.
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// DBProxySpec defines the desired state of an AWS RDS DBProxy.
+type DBProxySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DBProxyParameters `json:"forProvider"`
+}
+
+// DBProxyStatus represents the observed state of an AWS RDS DBProxy.
+type DBProxyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DBProxyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBProxy is a managed resource that represents an AWS RDS DB proxy. The
+// proxy endpoint is published to its connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBProxy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBProxySpec   `json:"spec"`
+	Status DBProxyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBProxyList contains a list of DBProxy
+type DBProxyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBProxy `json:"items"`
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// DefaultDBProxyTargetGroupName is the name of the target group that RDS
+// creates together with a DB proxy.
+const DefaultDBProxyTargetGroupName = "default"
+
+// ConnectionPoolConfiguration contains the settings that control the size
+// and behavior of the connection pool of a DB proxy target group.
+type ConnectionPoolConfiguration struct {
+	// ConnectionBorrowTimeout is the number of seconds for a proxy to wait
+	// for a connection to become available in the connection pool.
+	// Default: 120
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	ConnectionBorrowTimeout *int64 `json:"connectionBorrowTimeout,omitempty"`
+
+	// InitQuery is one or more SQL statements that the proxy runs when
+	// opening each new database connection.
+	// +optional
+	InitQuery *string `json:"initQuery,omitempty"`
+
+	// MaxConnectionsPercent is the maximum size of the connection pool as a
+	// percentage of the max_connections setting of the database.
+	// Default: 100
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxConnectionsPercent *int64 `json:"maxConnectionsPercent,omitempty"`
+
+	// MaxIdleConnectionsPercent controls how actively the proxy closes idle
+	// database connections in the connection pool, as a percentage of the
+	// max_connections setting of the database.
+	// Default: 50
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxIdleConnectionsPercent *int64 `json:"maxIdleConnectionsPercent,omitempty"`
+
+	// SessionPinningFilters are the kinds of database operations for which
+	// the proxy doesn't pin the client session to a database connection,
+	// e.g. EXCLUDE_VARIABLE_SETS.
+	// +optional
+	SessionPinningFilters []string `json:"sessionPinningFilters,omitempty"`
+}
+
+// DBProxyTargetGroupParameters define the desired state of the target group
+// of an AWS RDS DB proxy.
+type DBProxyTargetGroupParameters struct {
+	// Region is the region of the DB proxy of the target group.
+	// +immutable
+	Region string `json:"region"`
+
+	// DBProxyName is the name of the DB proxy of the target group.
+	// +immutable
+	// +optional
+	DBProxyName *string `json:"dbProxyName,omitempty"`
+
+	// DBProxyNameRef is a reference to a DBProxy used to set DBProxyName.
+	// +immutable
+	// +optional
+	DBProxyNameRef *runtimev1alpha1.Reference `json:"dbProxyNameRef,omitempty"`
+
+	// DBProxyNameSelector selects a reference to a DBProxy used to set
+	// DBProxyName.
+	// +immutable
+	// +optional
+	DBProxyNameSelector *runtimev1alpha1.Selector `json:"dbProxyNameSelector,omitempty"`
+
+	// ConnectionPoolConfig is the connection pool configuration of the
+	// target group.
+	// +optional
+	ConnectionPoolConfig *ConnectionPoolConfiguration `json:"connectionPoolConfig,omitempty"`
+
+	// DBInstanceIdentifiers are the identifiers of the DB instances that the
+	// proxy connects to.
+	// +optional
+	DBInstanceIdentifiers []string `json:"dbInstanceIdentifiers,omitempty"`
+
+	// DBInstanceIdentifierRefs are references to RDSInstances used to set the
+	// DBInstanceIdentifiers.
+	// +optional
+	DBInstanceIdentifierRefs []runtimev1alpha1.Reference `json:"dbInstanceIdentifierRefs,omitempty"`
+
+	// DBInstanceIdentifierSelector selects references to RDSInstances used to
+	// set the DBInstanceIdentifiers.
+	// +optional
+	DBInstanceIdentifierSelector *runtimev1alpha1.Selector `json:"dbInstanceIdentifierSelector,omitempty"`
+
+	// DBClusterIdentifiers are the identifiers of the Aurora DB clusters that
+	// the proxy connects to.
+	// +optional
+	DBClusterIdentifiers []string `json:"dbClusterIdentifiers,omitempty"`
+
+	// DBClusterIdentifierRefs are references to DBClusters used to set the
+	// DBClusterIdentifiers.
+	// +optional
+	DBClusterIdentifierRefs []runtimev1alpha1.Reference `json:"dbClusterIdentifierRefs,omitempty"`
+
+	// DBClusterIdentifierSelector selects references to DBClusters used to
+	// set the DBClusterIdentifiers.
+	// +optional
+	DBClusterIdentifierSelector *runtimev1alpha1.Selector `json:"dbClusterIdentifierSelector,omitempty"`
+}
+
+// DBProxyTargetGroupObservation is the representation of the current state
+// that is observed.
+type DBProxyTargetGroupObservation struct {
+	// TargetGroupARN is the Amazon Resource Name (ARN) of the target group.
+	TargetGroupARN string `json:"targetGroupArn,omitempty"`
+
+	// Status is the current state of the target group.
+	Status string `json:"status,omitempty"`
+
+	// IsDefault specifies whether this is the default target group of the
+	// DB proxy.
+	IsDefault bool `json:"isDefault,omitempty"`
+}
+
+// DBProxyTargetGroupSpec defines the desired state of an AWS RDS
+// DBProxyTargetGroup.
+type DBProxyTargetGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DBProxyTargetGroupParameters `json:"forProvider"`
+}
+
+// DBProxyTargetGroupStatus represents the observed state of an AWS RDS
+// DBProxyTargetGroup.
+type DBProxyTargetGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DBProxyTargetGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBProxyTargetGroup is a managed resource that represents the target
+// group of an AWS RDS DB proxy, i.e. its connection pool configuration and
+// the DB instances and clusters it connects to. RDS creates the target
+// group together with the DB proxy, so the external name of a
+// DBProxyTargetGroup is the name of an existing target group and defaults
+// to "default". Deleting it deregisters its targets.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROXY",type="string",JSONPath=".spec.forProvider.dbProxyName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBProxyTargetGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBProxyTargetGroupSpec   `json:"spec"`
+	Status DBProxyTargetGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBProxyTargetGroupList contains a list of DBProxyTargetGroup
+type DBProxyTargetGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBProxyTargetGroup `json:"items"`
+}
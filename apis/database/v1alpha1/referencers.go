@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	network "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+)
+
+// DynamoTableARN returns the status.atProvider.tableArn of a DynamoTable.
+func DynamoTableARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*DynamoTable)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.TableArn
+	}
+}
+
+// ResolveReferences of this DBCluster
+func (mg *DBCluster) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.dbSubnetGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBSubnetGroupName),
+		Reference:    mg.Spec.ForProvider.DBSubnetGroupNameRef,
+		Selector:     mg.Spec.ForProvider.DBSubnetGroupNameSelector,
+		To:           reference.To{Managed: &v1beta1.DBSubnetGroup{}, List: &v1beta1.DBSubnetGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dbSubnetGroupName")
+	}
+	mg.Spec.ForProvider.DBSubnetGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DBSubnetGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.vpcSecurityGroupIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.VPCSecurityGroupIDs,
+		References:    mg.Spec.ForProvider.VPCSecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.VPCSecurityGroupIDSelector,
+		To:            reference.To{Managed: &network.SecurityGroup{}, List: &network.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcSecurityGroupIds")
+	}
+	mg.Spec.ForProvider.VPCSecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.VPCSecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this DBProxy
+func (mg *DBProxy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.roleArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RoleARN),
+		Reference:    mg.Spec.ForProvider.RoleARNRef,
+		Selector:     mg.Spec.ForProvider.RoleARNSelector,
+		To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+		Extract:      iamv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.roleArn")
+	}
+	mg.Spec.ForProvider.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RoleARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.vpcSubnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.VPCSubnetIDs,
+		References:    mg.Spec.ForProvider.VPCSubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.VPCSubnetIDSelector,
+		To:            reference.To{Managed: &network.Subnet{}, List: &network.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcSubnetIds")
+	}
+	mg.Spec.ForProvider.VPCSubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.VPCSubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.vpcSecurityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.VPCSecurityGroupIDs,
+		References:    mg.Spec.ForProvider.VPCSecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.VPCSecurityGroupIDSelector,
+		To:            reference.To{Managed: &network.SecurityGroup{}, List: &network.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcSecurityGroupIds")
+	}
+	mg.Spec.ForProvider.VPCSecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.VPCSecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this DBProxyTargetGroup
+func (mg *DBProxyTargetGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.dbProxyName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBProxyName),
+		Reference:    mg.Spec.ForProvider.DBProxyNameRef,
+		Selector:     mg.Spec.ForProvider.DBProxyNameSelector,
+		To:           reference.To{Managed: &DBProxy{}, List: &DBProxyList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dbProxyName")
+	}
+	mg.Spec.ForProvider.DBProxyName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DBProxyNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.dbInstanceIdentifiers
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.DBInstanceIdentifiers,
+		References:    mg.Spec.ForProvider.DBInstanceIdentifierRefs,
+		Selector:      mg.Spec.ForProvider.DBInstanceIdentifierSelector,
+		To:            reference.To{Managed: &v1beta1.RDSInstance{}, List: &v1beta1.RDSInstanceList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dbInstanceIdentifiers")
+	}
+	mg.Spec.ForProvider.DBInstanceIdentifiers = mrsp.ResolvedValues
+	mg.Spec.ForProvider.DBInstanceIdentifierRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.dbClusterIdentifiers
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.DBClusterIdentifiers,
+		References:    mg.Spec.ForProvider.DBClusterIdentifierRefs,
+		Selector:      mg.Spec.ForProvider.DBClusterIdentifierSelector,
+		To:            reference.To{Managed: &DBCluster{}, List: &DBClusterList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dbClusterIdentifiers")
+	}
+	mg.Spec.ForProvider.DBClusterIdentifiers = mrsp.ResolvedValues
+	mg.Spec.ForProvider.DBClusterIdentifierRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -45,6 +45,33 @@ var (
 	DynamoTableGroupVersionKind = SchemeGroupVersion.WithKind(DynamoTableKind)
 )
 
+// DBCluster type metadata.
+var (
+	DBClusterKind             = reflect.TypeOf(DBCluster{}).Name()
+	DBClusterGroupKind        = schema.GroupKind{Group: Group, Kind: DBClusterKind}.String()
+	DBClusterKindAPIVersion   = DBClusterKind + "." + SchemeGroupVersion.String()
+	DBClusterGroupVersionKind = SchemeGroupVersion.WithKind(DBClusterKind)
+)
+
+// DBProxy type metadata.
+var (
+	DBProxyKind             = reflect.TypeOf(DBProxy{}).Name()
+	DBProxyGroupKind        = schema.GroupKind{Group: Group, Kind: DBProxyKind}.String()
+	DBProxyKindAPIVersion   = DBProxyKind + "." + SchemeGroupVersion.String()
+	DBProxyGroupVersionKind = SchemeGroupVersion.WithKind(DBProxyKind)
+)
+
+// DBProxyTargetGroup type metadata.
+var (
+	DBProxyTargetGroupKind             = reflect.TypeOf(DBProxyTargetGroup{}).Name()
+	DBProxyTargetGroupGroupKind        = schema.GroupKind{Group: Group, Kind: DBProxyTargetGroupKind}.String()
+	DBProxyTargetGroupKindAPIVersion   = DBProxyTargetGroupKind + "." + SchemeGroupVersion.String()
+	DBProxyTargetGroupGroupVersionKind = SchemeGroupVersion.WithKind(DBProxyTargetGroupKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&DynamoTable{}, &DynamoTableList{})
+	SchemeBuilder.Register(&DBCluster{}, &DBClusterList{})
+	SchemeBuilder.Register(&DBProxy{}, &DBProxyList{})
+	SchemeBuilder.Register(&DBProxyTargetGroup{}, &DBProxyTargetGroupList{})
 }
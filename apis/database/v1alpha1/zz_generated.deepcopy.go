@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -39,6 +40,707 @@ func (in *AttributeDefinition) DeepCopy() *AttributeDefinition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionPoolConfiguration) DeepCopyInto(out *ConnectionPoolConfiguration) {
+	*out = *in
+	if in.ConnectionBorrowTimeout != nil {
+		in, out := &in.ConnectionBorrowTimeout, &out.ConnectionBorrowTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.InitQuery != nil {
+		in, out := &in.InitQuery, &out.InitQuery
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxConnectionsPercent != nil {
+		in, out := &in.MaxConnectionsPercent, &out.MaxConnectionsPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxIdleConnectionsPercent != nil {
+		in, out := &in.MaxIdleConnectionsPercent, &out.MaxIdleConnectionsPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SessionPinningFilters != nil {
+		in, out := &in.SessionPinningFilters, &out.SessionPinningFilters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolConfiguration.
+func (in *ConnectionPoolConfiguration) DeepCopy() *ConnectionPoolConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionPoolConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBCluster) DeepCopyInto(out *DBCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBCluster.
+func (in *DBCluster) DeepCopy() *DBCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(DBCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterList) DeepCopyInto(out *DBClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterList.
+func (in *DBClusterList) DeepCopy() *DBClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterMember) DeepCopyInto(out *DBClusterMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterMember.
+func (in *DBClusterMember) DeepCopy() *DBClusterMember {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterObservation) DeepCopyInto(out *DBClusterObservation) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]DBClusterMember, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterObservation.
+func (in *DBClusterObservation) DeepCopy() *DBClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterParameters) DeepCopyInto(out *DBClusterParameters) {
+	*out = *in
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineMode != nil {
+		in, out := &in.EngineMode, &out.EngineMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScalingConfiguration != nil {
+		in, out := &in.ScalingConfiguration, &out.ScalingConfiguration
+		*out = new(ScalingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GlobalClusterIdentifier != nil {
+		in, out := &in.GlobalClusterIdentifier, &out.GlobalClusterIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseName != nil {
+		in, out := &in.DatabaseName, &out.DatabaseName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUsername != nil {
+		in, out := &in.MasterUsername, &out.MasterUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterPasswordSecretRef != nil {
+		in, out := &in.MasterPasswordSecretRef, &out.MasterPasswordSecretRef
+		*out = new(corev1alpha1.SecretKeySelector)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DBClusterParameterGroupName != nil {
+		in, out := &in.DBClusterParameterGroupName, &out.DBClusterParameterGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBSubnetGroupName != nil {
+		in, out := &in.DBSubnetGroupName, &out.DBSubnetGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBSubnetGroupNameRef != nil {
+		in, out := &in.DBSubnetGroupNameRef, &out.DBSubnetGroupNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.DBSubnetGroupNameSelector != nil {
+		in, out := &in.DBSubnetGroupNameSelector, &out.DBSubnetGroupNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCSecurityGroupIDs != nil {
+		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSecurityGroupIDRefs != nil {
+		in, out := &in.VPCSecurityGroupIDRefs, &out.VPCSecurityGroupIDRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSecurityGroupIDSelector != nil {
+		in, out := &in.VPCSecurityGroupIDSelector, &out.VPCSecurityGroupIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupRetentionPeriod != nil {
+		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PreferredBackupWindow != nil {
+		in, out := &in.PreferredBackupWindow, &out.PreferredBackupWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferredMaintenanceWindow != nil {
+		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageEncrypted != nil {
+		in, out := &in.StorageEncrypted, &out.StorageEncrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableIAMDatabaseAuthentication != nil {
+		in, out := &in.EnableIAMDatabaseAuthentication, &out.EnableIAMDatabaseAuthentication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CopyTagsToSnapshot != nil {
+		in, out := &in.CopyTagsToSnapshot, &out.CopyTagsToSnapshot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableCloudwatchLogsExports != nil {
+		in, out := &in.EnableCloudwatchLogsExports, &out.EnableCloudwatchLogsExports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SnapshotIdentifier != nil {
+		in, out := &in.SnapshotIdentifier, &out.SnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyImmediately != nil {
+		in, out := &in.ApplyImmediately, &out.ApplyImmediately
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipFinalSnapshot != nil {
+		in, out := &in.SkipFinalSnapshot, &out.SkipFinalSnapshot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FinalDBSnapshotIdentifier != nil {
+		in, out := &in.FinalDBSnapshotIdentifier, &out.FinalDBSnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameters.
+func (in *DBClusterParameters) DeepCopy() *DBClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterSpec.
+func (in *DBClusterSpec) DeepCopy() *DBClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterStatus) DeepCopyInto(out *DBClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterStatus.
+func (in *DBClusterStatus) DeepCopy() *DBClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxy) DeepCopyInto(out *DBProxy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxy.
+func (in *DBProxy) DeepCopy() *DBProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyList) DeepCopyInto(out *DBProxyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBProxy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyList.
+func (in *DBProxyList) DeepCopy() *DBProxyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyObservation) DeepCopyInto(out *DBProxyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyObservation.
+func (in *DBProxyObservation) DeepCopy() *DBProxyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyParameters) DeepCopyInto(out *DBProxyParameters) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = make([]UserAuthConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARNRef != nil {
+		in, out := &in.RoleARNRef, &out.RoleARNRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.RoleARNSelector != nil {
+		in, out := &in.RoleARNSelector, &out.RoleARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCSubnetIDs != nil {
+		in, out := &in.VPCSubnetIDs, &out.VPCSubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSubnetIDRefs != nil {
+		in, out := &in.VPCSubnetIDRefs, &out.VPCSubnetIDRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSubnetIDSelector != nil {
+		in, out := &in.VPCSubnetIDSelector, &out.VPCSubnetIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCSecurityGroupIDs != nil {
+		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSecurityGroupIDRefs != nil {
+		in, out := &in.VPCSecurityGroupIDRefs, &out.VPCSecurityGroupIDRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSecurityGroupIDSelector != nil {
+		in, out := &in.VPCSecurityGroupIDSelector, &out.VPCSecurityGroupIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdleClientTimeout != nil {
+		in, out := &in.IdleClientTimeout, &out.IdleClientTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RequireTLS != nil {
+		in, out := &in.RequireTLS, &out.RequireTLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DebugLogging != nil {
+		in, out := &in.DebugLogging, &out.DebugLogging
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyParameters.
+func (in *DBProxyParameters) DeepCopy() *DBProxyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxySpec) DeepCopyInto(out *DBProxySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxySpec.
+func (in *DBProxySpec) DeepCopy() *DBProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyStatus) DeepCopyInto(out *DBProxyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyStatus.
+func (in *DBProxyStatus) DeepCopy() *DBProxyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroup) DeepCopyInto(out *DBProxyTargetGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroup.
+func (in *DBProxyTargetGroup) DeepCopy() *DBProxyTargetGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxyTargetGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroupList) DeepCopyInto(out *DBProxyTargetGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBProxyTargetGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroupList.
+func (in *DBProxyTargetGroupList) DeepCopy() *DBProxyTargetGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxyTargetGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroupObservation) DeepCopyInto(out *DBProxyTargetGroupObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroupObservation.
+func (in *DBProxyTargetGroupObservation) DeepCopy() *DBProxyTargetGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroupParameters) DeepCopyInto(out *DBProxyTargetGroupParameters) {
+	*out = *in
+	if in.DBProxyName != nil {
+		in, out := &in.DBProxyName, &out.DBProxyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBProxyNameRef != nil {
+		in, out := &in.DBProxyNameRef, &out.DBProxyNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.DBProxyNameSelector != nil {
+		in, out := &in.DBProxyNameSelector, &out.DBProxyNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionPoolConfig != nil {
+		in, out := &in.ConnectionPoolConfig, &out.ConnectionPoolConfig
+		*out = new(ConnectionPoolConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DBInstanceIdentifiers != nil {
+		in, out := &in.DBInstanceIdentifiers, &out.DBInstanceIdentifiers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DBInstanceIdentifierRefs != nil {
+		in, out := &in.DBInstanceIdentifierRefs, &out.DBInstanceIdentifierRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DBInstanceIdentifierSelector != nil {
+		in, out := &in.DBInstanceIdentifierSelector, &out.DBInstanceIdentifierSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DBClusterIdentifiers != nil {
+		in, out := &in.DBClusterIdentifiers, &out.DBClusterIdentifiers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DBClusterIdentifierRefs != nil {
+		in, out := &in.DBClusterIdentifierRefs, &out.DBClusterIdentifierRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DBClusterIdentifierSelector != nil {
+		in, out := &in.DBClusterIdentifierSelector, &out.DBClusterIdentifierSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroupParameters.
+func (in *DBProxyTargetGroupParameters) DeepCopy() *DBProxyTargetGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroupSpec) DeepCopyInto(out *DBProxyTargetGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroupSpec.
+func (in *DBProxyTargetGroupSpec) DeepCopy() *DBProxyTargetGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroupStatus) DeepCopyInto(out *DBProxyTargetGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroupStatus.
+func (in *DBProxyTargetGroupStatus) DeepCopy() *DBProxyTargetGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DynamoTable) DeepCopyInto(out *DynamoTable) {
 	*out = *in
@@ -386,6 +1088,46 @@ func (in *SSESpecification) DeepCopy() *SSESpecification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingConfiguration) DeepCopyInto(out *ScalingConfiguration) {
+	*out = *in
+	if in.MinCapacity != nil {
+		in, out := &in.MinCapacity, &out.MinCapacity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxCapacity != nil {
+		in, out := &in.MaxCapacity, &out.MaxCapacity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AutoPause != nil {
+		in, out := &in.AutoPause, &out.AutoPause
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecondsUntilAutoPause != nil {
+		in, out := &in.SecondsUntilAutoPause, &out.SecondsUntilAutoPause
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TimeoutAction != nil {
+		in, out := &in.TimeoutAction, &out.TimeoutAction
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingConfiguration.
+func (in *ScalingConfiguration) DeepCopy() *ScalingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StreamSpecification) DeepCopyInto(out *StreamSpecification) {
 	*out = *in
@@ -425,3 +1167,38 @@ func (in *Tag) DeepCopy() *Tag {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserAuthConfig) DeepCopyInto(out *UserAuthConfig) {
+	*out = *in
+	if in.AuthScheme != nil {
+		in, out := &in.AuthScheme, &out.AuthScheme
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMAuth != nil {
+		in, out := &in.IAMAuth, &out.IAMAuth
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserAuthConfig.
+func (in *UserAuthConfig) DeepCopy() *UserAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UserAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
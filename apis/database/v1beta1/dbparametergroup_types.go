@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Parameter is a DB engine parameter of a DB parameter group.
+type Parameter struct {
+	// ParameterName is the name of the parameter.
+	ParameterName string `json:"parameterName"`
+
+	// ParameterValue is the value of the parameter.
+	ParameterValue string `json:"parameterValue"`
+
+	// ApplyMethod is when the parameter change is applied. Static parameters
+	// can only be applied on the next reboot of the DB instance, so they
+	// require pending-reboot.
+	// Default: immediate
+	// +kubebuilder:validation:Enum=immediate;pending-reboot
+	// +optional
+	ApplyMethod *string `json:"applyMethod,omitempty"`
+}
+
+// DBParameterGroupParameters define the desired state of an AWS RDS DB
+// parameter group.
+type DBParameterGroupParameters struct {
+	// Region is the region you'd like your DBParameterGroup to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// DBParameterGroupFamily is the DB parameter group family name, e.g.
+	// mysql8.0 or postgres12. A DB parameter group can be associated with
+	// DB instances of one DB parameter group family only.
+	// +immutable
+	DBParameterGroupFamily string `json:"dbParameterGroupFamily"`
+
+	// Description of the DB parameter group.
+	// +immutable
+	Description string `json:"description"`
+
+	// Parameters are the engine parameters that are set by the DB parameter
+	// group. Parameters that are not listed keep the default value of the
+	// DB parameter group family, and parameters that are removed from this
+	// list are reset to their default value.
+	// +optional
+	Parameters []Parameter `json:"parameters,omitempty"`
+
+	// A list of tags. For more information, see Tagging Amazon RDS Resources (http://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_Tagging.html)
+	// in the Amazon RDS User Guide.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DBParameterGroupSpec defines the desired state of a DBParameterGroup.
+type DBParameterGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DBParameterGroupParameters `json:"forProvider"`
+}
+
+// DBParameterGroupObservation is the representation of the current state
+// that is observed.
+type DBParameterGroupObservation struct {
+	// ARN is the Amazon Resource Name (ARN) for this DB parameter group.
+	ARN string `json:"arn,omitempty"`
+}
+
+// A DBParameterGroupResourceStatus represents the observed state of a
+// DBParameterGroup. It is not named DBParameterGroupStatus, which is the
+// status of a DB parameter group of an RDSInstance.
+type DBParameterGroupResourceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DBParameterGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBParameterGroup is a managed resource that represents an AWS RDS DB
+// parameter group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FAMILY",type="string",JSONPath=".spec.forProvider.dbParameterGroupFamily"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBParameterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBParameterGroupSpec           `json:"spec"`
+	Status DBParameterGroupResourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBParameterGroupList contains a list of DBParameterGroups
+type DBParameterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBParameterGroup `json:"items"`
+}
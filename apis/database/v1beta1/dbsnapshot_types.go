@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// DB snapshot states.
+const (
+	// The snapshot is complete and can be restored from.
+	DBSnapshotStateAvailable = "available"
+	// The snapshot is being created.
+	DBSnapshotStateCreating = "creating"
+	// The snapshot is being deleted.
+	DBSnapshotStateDeleting = "deleting"
+)
+
+// DBSnapshotParameters define the desired state of an AWS RDS DB snapshot.
+type DBSnapshotParameters struct {
+	// Region is the region you'd like your DBSnapshot to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// DBInstanceIdentifier is the identifier of the DB instance to take the
+	// snapshot of.
+	// +immutable
+	// +optional
+	DBInstanceIdentifier *string `json:"dbInstanceIdentifier,omitempty"`
+
+	// DBInstanceIdentifierRef is a reference to an RDSInstance used to set
+	// DBInstanceIdentifier.
+	// +immutable
+	// +optional
+	DBInstanceIdentifierRef *runtimev1alpha1.Reference `json:"dbInstanceIdentifierRef,omitempty"`
+
+	// DBInstanceIdentifierSelector selects a reference to an RDSInstance used
+	// to set DBInstanceIdentifier.
+	// +immutable
+	// +optional
+	DBInstanceIdentifierSelector *runtimev1alpha1.Selector `json:"dbInstanceIdentifierSelector,omitempty"`
+
+	// A list of tags. For more information, see Tagging Amazon RDS Resources (http://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_Tagging.html)
+	// in the Amazon RDS User Guide.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DBSnapshotSpec defines the desired state of a DBSnapshot.
+type DBSnapshotSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DBSnapshotParameters `json:"forProvider"`
+}
+
+// DBSnapshotObservation is the representation of the current state that is
+// observed.
+type DBSnapshotObservation struct {
+	// ARN is the Amazon Resource Name (ARN) for this DB snapshot.
+	ARN string `json:"arn,omitempty"`
+
+	// Status is the current state of this DB snapshot.
+	Status string `json:"status,omitempty"`
+
+	// SnapshotCreateTime is the time when the snapshot was taken.
+	SnapshotCreateTime *metav1.Time `json:"snapshotCreateTime,omitempty"`
+
+	// PercentProgress is the percentage of the estimated data that has been
+	// transferred.
+	PercentProgress int64 `json:"percentProgress,omitempty"`
+
+	// Engine is the database engine of the DB snapshot.
+	Engine string `json:"engine,omitempty"`
+
+	// EngineVersion is the version of the database engine of the DB
+	// snapshot.
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// AllocatedStorage is the allocated storage size in gibibytes (GiB).
+	AllocatedStorage int64 `json:"allocatedStorage,omitempty"`
+
+	// Encrypted specifies whether the DB snapshot is encrypted.
+	Encrypted bool `json:"encrypted,omitempty"`
+
+	// SnapshotType is the type of the DB snapshot, e.g. manual.
+	SnapshotType string `json:"snapshotType,omitempty"`
+}
+
+// A DBSnapshotStatus represents the observed state of a DBSnapshot.
+type DBSnapshotStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DBSnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBSnapshot is a managed resource that represents a manual AWS RDS DB
+// snapshot.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.dbInstanceIdentifier"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBSnapshotSpec   `json:"spec"`
+	Status DBSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBSnapshotList contains a list of DBSnapshots
+type DBSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBSnapshot `json:"items"`
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// OptionSetting is a setting of an option.
+type OptionSetting struct {
+	// Name of the option setting.
+	Name string `json:"name"`
+
+	// Value of the option setting.
+	Value string `json:"value"`
+}
+
+// OptionConfiguration is an option that is added to an option group.
+type OptionConfiguration struct {
+	// OptionName is the name of the option, e.g. MEMCACHED, TDE or
+	// SQLSERVER_BACKUP_RESTORE.
+	OptionName string `json:"optionName"`
+
+	// OptionVersion is the version of the option.
+	// +optional
+	OptionVersion *string `json:"optionVersion,omitempty"`
+
+	// Port is the port that the option uses, for options that listen on a
+	// port.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// OptionSettings are the settings of the option. Settings that are not
+	// listed keep their default value.
+	// +optional
+	OptionSettings []OptionSetting `json:"optionSettings,omitempty"`
+
+	// VPCSecurityGroupMemberships is a list of EC2 VPC security groups that
+	// are allowed to access the option.
+	// +optional
+	VPCSecurityGroupMemberships []string `json:"vpcSecurityGroupMemberships,omitempty"`
+}
+
+// OptionGroupParameters define the desired state of an AWS RDS option group.
+type OptionGroupParameters struct {
+	// Region is the region you'd like your OptionGroup to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// EngineName is the name of the engine that the option group can be
+	// applied to, e.g. mysql, oracle-ee or sqlserver-se.
+	// +immutable
+	EngineName string `json:"engineName"`
+
+	// MajorEngineVersion is the major version of the engine that the option
+	// group can be applied to, e.g. 8.0 or 19.
+	// +immutable
+	MajorEngineVersion string `json:"majorEngineVersion"`
+
+	// Description of the option group.
+	// +immutable
+	Description string `json:"description"`
+
+	// Options are the options that are enabled in the option group. Options
+	// that are removed from this list are removed from the option group,
+	// except for permanent options, which can't be removed.
+	// +optional
+	Options []OptionConfiguration `json:"options,omitempty"`
+
+	// ApplyImmediately specifies whether option changes are applied
+	// immediately to the DB instances that use the option group, or during
+	// their next maintenance window.
+	// Default: false
+	// +optional
+	ApplyImmediately *bool `json:"applyImmediately,omitempty"`
+
+	// A list of tags. For more information, see Tagging Amazon RDS Resources (http://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_Tagging.html)
+	// in the Amazon RDS User Guide.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An OptionGroupSpec defines the desired state of an OptionGroup.
+type OptionGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  OptionGroupParameters `json:"forProvider"`
+}
+
+// OptionGroupObservation is the representation of the current state that is
+// observed.
+type OptionGroupObservation struct {
+	// ARN is the Amazon Resource Name (ARN) for this option group.
+	ARN string `json:"arn,omitempty"`
+
+	// VPCID is the ID of the VPC that the option group is restricted to, if
+	// any.
+	VPCID string `json:"vpcId,omitempty"`
+}
+
+// An OptionGroupStatus represents the observed state of an OptionGroup.
+type OptionGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     OptionGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OptionGroup is a managed resource that represents an AWS RDS option
+// group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENGINE",type="string",JSONPath=".spec.forProvider.engineName"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.majorEngineVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type OptionGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OptionGroupSpec   `json:"spec"`
+	Status OptionGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OptionGroupList contains a list of OptionGroups
+type OptionGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OptionGroup `json:"items"`
+}
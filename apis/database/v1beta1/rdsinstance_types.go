@@ -440,7 +440,8 @@ type RDSInstanceParameters struct {
 
 	// MasterPasswordSecretRef references the secret that contains the password used
 	// in the creation of this RDS instance. If no reference is given, a password
-	// will be auto-generated.
+	// will be auto-generated, unless the instance is restored from a snapshot
+	// or a point in time, in which case no password is published.
 	// +optional
 	// +immutable
 	MasterPasswordSecretRef *runtimev1alpha1.SecretKeySelector `json:"masterPasswordSecretRef,omitempty"`
@@ -550,6 +551,15 @@ type RDSInstanceParameters struct {
 	// +optional
 	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
 
+	// MaintenanceWindowRef references a MaintenanceWindow that the
+	// PreferredMaintenanceWindow is set from. Updates of the instance that
+	// restart it, like changing its class, engine version, storage type,
+	// parameter group, certificate authority or port, are held back until the
+	// referenced window opens, unless the aws.crossplane.io/force-update
+	// annotation is set to "true".
+	// +optional
+	MaintenanceWindowRef *runtimev1alpha1.Reference `json:"maintenanceWindowRef,omitempty"`
+
 	// ProcessorFeatures is the number of CPU cores and the number of threads per core for the DB instance
 	// class of the DB instance.
 	// +optional
@@ -692,6 +702,16 @@ type RDSInstanceParameters struct {
 	// +optional
 	DBParameterGroupName *string `json:"dbParameterGroupName,omitempty"`
 
+	// DBParameterGroupNameRef is a reference to a DBParameterGroup used to set
+	// DBParameterGroupName.
+	// +optional
+	DBParameterGroupNameRef *runtimev1alpha1.Reference `json:"dbParameterGroupNameRef,omitempty"`
+
+	// DBParameterGroupNameSelector selects a reference to a DBParameterGroup
+	// used to set DBParameterGroupName.
+	// +optional
+	DBParameterGroupNameSelector *runtimev1alpha1.Selector `json:"dbParameterGroupNameSelector,omitempty"`
+
 	// Domain specifies the Active Directory Domain to create the instance in.
 	// +optional
 	Domain *string `json:"domain,omitempty"`
@@ -721,6 +741,16 @@ type RDSInstanceParameters struct {
 	// +optional
 	OptionGroupName *string `json:"optionGroupName,omitempty"`
 
+	// OptionGroupNameRef is a reference to an OptionGroup used to set
+	// OptionGroupName.
+	// +optional
+	OptionGroupNameRef *runtimev1alpha1.Reference `json:"optionGroupNameRef,omitempty"`
+
+	// OptionGroupNameSelector selects a reference to an OptionGroup used to
+	// set OptionGroupName.
+	// +optional
+	OptionGroupNameSelector *runtimev1alpha1.Selector `json:"optionGroupNameSelector,omitempty"`
+
 	// A value that specifies that the DB instance class of the DB instance uses
 	// its default processor features.
 	UseDefaultProcessorFeatures *bool `json:"useDefaultProcessorFeatures,omitempty"`
@@ -747,6 +777,79 @@ type RDSInstanceParameters struct {
 	//    * Cannot end with a hyphen or contain two consecutive hyphens
 	//    * Cannot be specified when deleting a Read Replica.
 	FinalDBSnapshotIdentifier *string `json:"finalDBSnapshotIdentifier,omitempty"`
+
+	// RestoreFrom specifies the DB snapshot or the point in time of another
+	// DB instance that the DB instance is restored from. The DB instance is
+	// created empty if it is not set. A restored DB instance keeps the master
+	// user and password of its source, and no password is generated for it,
+	// so the connection secret holds no password unless
+	// masterPasswordSecretRef is set. The referenced password is applied and
+	// published once the restored DB instance is available.
+	// +immutable
+	// +optional
+	RestoreFrom *RestoreFrom `json:"restoreFrom,omitempty"`
+}
+
+// RestoreFrom specifies the source of a restored DB instance. Exactly one of
+// Snapshot and PointInTime must be set.
+type RestoreFrom struct {
+	// Snapshot restores the DB instance from a DB snapshot.
+	// +optional
+	Snapshot *SnapshotRestore `json:"snapshot,omitempty"`
+
+	// PointInTime restores the DB instance to a point in time of another DB
+	// instance.
+	// +optional
+	PointInTime *PointInTimeRestore `json:"pointInTime,omitempty"`
+}
+
+// SnapshotRestore specifies the DB snapshot that a DB instance is restored
+// from.
+type SnapshotRestore struct {
+	// DBSnapshotIdentifier is the identifier, or the ARN for a shared DB
+	// snapshot, of the DB snapshot to restore from.
+	// +optional
+	DBSnapshotIdentifier *string `json:"dbSnapshotIdentifier,omitempty"`
+
+	// DBSnapshotIdentifierRef is a reference to a DBSnapshot used to set
+	// DBSnapshotIdentifier.
+	// +optional
+	DBSnapshotIdentifierRef *runtimev1alpha1.Reference `json:"dbSnapshotIdentifierRef,omitempty"`
+
+	// DBSnapshotIdentifierSelector selects a reference to a DBSnapshot used to
+	// set DBSnapshotIdentifier.
+	// +optional
+	DBSnapshotIdentifierSelector *runtimev1alpha1.Selector `json:"dbSnapshotIdentifierSelector,omitempty"`
+}
+
+// PointInTimeRestore specifies the DB instance and the point in time that a
+// DB instance is restored to. Exactly one of RestoreTime and
+// UseLatestRestorableTime must be set.
+type PointInTimeRestore struct {
+	// SourceDBInstanceIdentifier is the identifier of the DB instance to
+	// restore from.
+	// +optional
+	SourceDBInstanceIdentifier *string `json:"sourceDBInstanceIdentifier,omitempty"`
+
+	// SourceDBInstanceIdentifierRef is a reference to an RDSInstance used to
+	// set SourceDBInstanceIdentifier.
+	// +optional
+	SourceDBInstanceIdentifierRef *runtimev1alpha1.Reference `json:"sourceDBInstanceIdentifierRef,omitempty"`
+
+	// SourceDBInstanceIdentifierSelector selects a reference to an
+	// RDSInstance used to set SourceDBInstanceIdentifier.
+	// +optional
+	SourceDBInstanceIdentifierSelector *runtimev1alpha1.Selector `json:"sourceDBInstanceIdentifierSelector,omitempty"`
+
+	// RestoreTime is the date and time to restore from. It must be before
+	// the latest restorable time of the source DB instance.
+	// +optional
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+
+	// UseLatestRestorableTime restores the DB instance from the latest
+	// backup time of the source DB instance.
+	// +optional
+	UseLatestRestorableTime *bool `json:"useLatestRestorableTime,omitempty"`
 }
 
 // An RDSInstanceSpec defines the desired state of an RDSInstance.
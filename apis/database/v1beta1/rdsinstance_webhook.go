@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-database-aws-crossplane-io-v1beta1-rdsinstance,mutating=false,failurePolicy=fail,groups=database.aws.crossplane.io,resources=rdsinstances,verbs=create;update,versions=v1beta1,name=rdsinstances.database.aws.crossplane.io
+
+var _ webhook.Validator = &RDSInstance{}
+
+// ValidateCreate validates an RDSInstance on creation.
+func (mg *RDSInstance) ValidateCreate() error {
+	return mg.validate(nil)
+}
+
+// ValidateUpdate validates an RDSInstance on update.
+func (mg *RDSInstance) ValidateUpdate(old runtime.Object) error {
+	o, _ := old.(*RDSInstance)
+	return mg.validate(o)
+}
+
+// ValidateDelete validates an RDSInstance on deletion.
+func (mg *RDSInstance) ValidateDelete() error {
+	return nil
+}
+
+// validate checks the constraints between the fields of an RDSInstance that
+// AWS would otherwise only report once the instance is created. The given old
+// RDSInstance is nil on creation.
+func (mg *RDSInstance) validate(old *RDSInstance) error {
+	p := mg.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	var errs field.ErrorList
+
+	// Multi-AZ instances created before this check may already have their
+	// primary availability zone set, so only a change of either field is
+	// rejected on update.
+	changed := old == nil ||
+		aws.BoolValue(old.Spec.ForProvider.MultiAZ) != aws.BoolValue(p.MultiAZ) ||
+		aws.StringValue(old.Spec.ForProvider.AvailabilityZone) != aws.StringValue(p.AvailabilityZone)
+	if changed && aws.BoolValue(p.MultiAZ) && p.AvailabilityZone != nil {
+		errs = append(errs, field.Forbidden(path.Child("availabilityZone"), "cannot be set if multiAZ is true"))
+	}
+
+	if r := p.RestoreFrom; r != nil {
+		rpath := path.Child("restoreFrom")
+		if (r.Snapshot == nil) == (r.PointInTime == nil) {
+			errs = append(errs, field.Invalid(rpath, r, "exactly one of snapshot and pointInTime must be set"))
+		}
+		if pit := r.PointInTime; pit != nil && (pit.RestoreTime == nil) == !aws.BoolValue(pit.UseLatestRestorableTime) {
+			errs = append(errs, field.Invalid(rpath.Child("pointInTime"), pit, "exactly one of restoreTime and useLatestRestorableTime must be set"))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(RDSInstanceGroupVersionKind.GroupKind(), mg.GetName(), errs)
+}
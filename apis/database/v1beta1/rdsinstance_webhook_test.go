@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type rdsInstanceModifier func(*RDSInstance)
+
+func withMultiAZ(b bool) rdsInstanceModifier {
+	return func(r *RDSInstance) { r.Spec.ForProvider.MultiAZ = &b }
+}
+
+func withAvailabilityZone(az string) rdsInstanceModifier {
+	return func(r *RDSInstance) { r.Spec.ForProvider.AvailabilityZone = &az }
+}
+
+func withRestoreFrom(rf *RestoreFrom) rdsInstanceModifier {
+	return func(r *RDSInstance) { r.Spec.ForProvider.RestoreFrom = rf }
+}
+
+func rdsInstance(m ...rdsInstanceModifier) *RDSInstance {
+	cr := &RDSInstance{}
+	cr.SetName("db")
+	cr.Spec.ForProvider.Engine = PostgresqlEngine
+	cr.Spec.ForProvider.DBInstanceClass = "db.t3.micro"
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestRDSInstanceValidate(t *testing.T) {
+	path := field.NewPath("spec", "forProvider")
+	invalid := func(errs ...*field.Error) error {
+		return apierrors.NewInvalid(RDSInstanceGroupVersionKind.GroupKind(), "db", errs)
+	}
+	snapshot := &SnapshotRestore{DBSnapshotIdentifier: aws.String("db-snapshot")}
+	latest := &PointInTimeRestore{SourceDBInstanceIdentifier: aws.String("source"), UseLatestRestorableTime: aws.Bool(true)}
+	restoreTime := &metav1.Time{Time: time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)}
+	both := &PointInTimeRestore{SourceDBInstanceIdentifier: aws.String("source"), RestoreTime: restoreTime, UseLatestRestorableTime: aws.Bool(true)}
+	neither := &PointInTimeRestore{SourceDBInstanceIdentifier: aws.String("source")}
+
+	cases := map[string]struct {
+		cr   *RDSInstance
+		want error
+	}{
+		"NoConstraints": {
+			cr: rdsInstance(),
+		},
+		"MultiAZ": {
+			cr: rdsInstance(withMultiAZ(true)),
+		},
+		"SingleAZ": {
+			cr: rdsInstance(withMultiAZ(false), withAvailabilityZone("us-east-1a")),
+		},
+		"MultiAZWithAvailabilityZone": {
+			cr:   rdsInstance(withMultiAZ(true), withAvailabilityZone("us-east-1a")),
+			want: invalid(field.Forbidden(path.Child("availabilityZone"), "cannot be set if multiAZ is true")),
+		},
+		"RestoreFromSnapshot": {
+			cr: rdsInstance(withRestoreFrom(&RestoreFrom{Snapshot: snapshot})),
+		},
+		"RestoreToLatestRestorableTime": {
+			cr: rdsInstance(withRestoreFrom(&RestoreFrom{PointInTime: latest})),
+		},
+		"RestoreToRestoreTime": {
+			cr: rdsInstance(withRestoreFrom(&RestoreFrom{PointInTime: &PointInTimeRestore{SourceDBInstanceIdentifier: aws.String("source"), RestoreTime: restoreTime}})),
+		},
+		"RestoreFromNothing": {
+			cr:   rdsInstance(withRestoreFrom(&RestoreFrom{})),
+			want: invalid(field.Invalid(path.Child("restoreFrom"), &RestoreFrom{}, "exactly one of snapshot and pointInTime must be set")),
+		},
+		"RestoreFromSnapshotAndPointInTime": {
+			cr:   rdsInstance(withRestoreFrom(&RestoreFrom{Snapshot: snapshot, PointInTime: latest})),
+			want: invalid(field.Invalid(path.Child("restoreFrom"), &RestoreFrom{Snapshot: snapshot, PointInTime: latest}, "exactly one of snapshot and pointInTime must be set")),
+		},
+		"RestoreToBothTimes": {
+			cr:   rdsInstance(withRestoreFrom(&RestoreFrom{PointInTime: both})),
+			want: invalid(field.Invalid(path.Child("restoreFrom", "pointInTime"), both, "exactly one of restoreTime and useLatestRestorableTime must be set")),
+		},
+		"RestoreToNoTime": {
+			cr:   rdsInstance(withRestoreFrom(&RestoreFrom{PointInTime: neither})),
+			want: invalid(field.Invalid(path.Child("restoreFrom", "pointInTime"), neither, "exactly one of restoreTime and useLatestRestorableTime must be set")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.cr.ValidateCreate(), test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateCreate(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.cr.ValidateUpdate(rdsInstance()), test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateUpdate(...): -want, +got:\n%s", diff)
+			}
+			if err := tc.cr.ValidateDelete(); err != nil {
+				t.Errorf("ValidateDelete(...): %s", err)
+			}
+		})
+	}
+}
+
+func TestRDSInstanceValidateUpdate(t *testing.T) {
+	path := field.NewPath("spec", "forProvider")
+	invalid := func(errs ...*field.Error) error {
+		return apierrors.NewInvalid(RDSInstanceGroupVersionKind.GroupKind(), "db", errs)
+	}
+
+	cases := map[string]struct {
+		old  *RDSInstance
+		cr   *RDSInstance
+		want error
+	}{
+		"UnchangedMultiAZWithAvailabilityZone": {
+			old: rdsInstance(withMultiAZ(true), withAvailabilityZone("us-east-1a")),
+			cr:  rdsInstance(withMultiAZ(true), withAvailabilityZone("us-east-1a")),
+		},
+		"AvailabilityZoneAddedToMultiAZ": {
+			old:  rdsInstance(withMultiAZ(true)),
+			cr:   rdsInstance(withMultiAZ(true), withAvailabilityZone("us-east-1a")),
+			want: invalid(field.Forbidden(path.Child("availabilityZone"), "cannot be set if multiAZ is true")),
+		},
+		"MultiAZEnabledWithAvailabilityZone": {
+			old:  rdsInstance(withAvailabilityZone("us-east-1a")),
+			cr:   rdsInstance(withMultiAZ(true), withAvailabilityZone("us-east-1a")),
+			want: invalid(field.Forbidden(path.Child("availabilityZone"), "cannot be set if multiAZ is true")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.cr.ValidateUpdate(tc.old), test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,11 +23,24 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	network "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
 )
 
+// RDSInstanceARN returns the status.atProvider.dbInstanceArn of an
+// RDSInstance.
+func RDSInstanceARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*RDSInstance)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.DBInstanceArn
+	}
+}
+
 // ResolveReferences of this DBSubnetGroup
 func (mg *DBSubnetGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -67,6 +80,66 @@ func (mg *RDSInstance) ResolveReferences(ctx context.Context, c client.Reader) e
 	mg.Spec.ForProvider.DBSubnetGroupName = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.DBSubnetGroupNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.dbParameterGroupName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBParameterGroupName),
+		Reference:    mg.Spec.ForProvider.DBParameterGroupNameRef,
+		Selector:     mg.Spec.ForProvider.DBParameterGroupNameSelector,
+		To:           reference.To{Managed: &DBParameterGroup{}, List: &DBParameterGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dbParameterGroupName")
+	}
+	mg.Spec.ForProvider.DBParameterGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DBParameterGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.optionGroupName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.OptionGroupName),
+		Reference:    mg.Spec.ForProvider.OptionGroupNameRef,
+		Selector:     mg.Spec.ForProvider.OptionGroupNameSelector,
+		To:           reference.To{Managed: &OptionGroup{}, List: &OptionGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.optionGroupName")
+	}
+	mg.Spec.ForProvider.OptionGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.OptionGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.restoreFrom.snapshot.dbSnapshotIdentifier
+	if rf := mg.Spec.ForProvider.RestoreFrom; rf != nil && rf.Snapshot != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(rf.Snapshot.DBSnapshotIdentifier),
+			Reference:    rf.Snapshot.DBSnapshotIdentifierRef,
+			Selector:     rf.Snapshot.DBSnapshotIdentifierSelector,
+			To:           reference.To{Managed: &DBSnapshot{}, List: &DBSnapshotList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.restoreFrom.snapshot.dbSnapshotIdentifier")
+		}
+		rf.Snapshot.DBSnapshotIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+		rf.Snapshot.DBSnapshotIdentifierRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.restoreFrom.pointInTime.sourceDBInstanceIdentifier
+	if rf := mg.Spec.ForProvider.RestoreFrom; rf != nil && rf.PointInTime != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(rf.PointInTime.SourceDBInstanceIdentifier),
+			Reference:    rf.PointInTime.SourceDBInstanceIdentifierRef,
+			Selector:     rf.PointInTime.SourceDBInstanceIdentifierSelector,
+			To:           reference.To{Managed: &RDSInstance{}, List: &RDSInstanceList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.restoreFrom.pointInTime.sourceDBInstanceIdentifier")
+		}
+		rf.PointInTime.SourceDBInstanceIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+		rf.PointInTime.SourceDBInstanceIdentifierRef = rsp.ResolvedReference
+	}
+
 	// Resolve spec.forProvider.domainIAMRoleName
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DomainIAMRoleName),
@@ -111,3 +184,24 @@ func (mg *RDSInstance) ResolveReferences(ctx context.Context, c client.Reader) e
 
 	return nil
 }
+
+// ResolveReferences of this DBSnapshot
+func (mg *DBSnapshot) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.dbInstanceIdentifier
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBInstanceIdentifier),
+		Reference:    mg.Spec.ForProvider.DBInstanceIdentifierRef,
+		Selector:     mg.Spec.ForProvider.DBInstanceIdentifierSelector,
+		To:           reference.To{Managed: &RDSInstance{}, List: &RDSInstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dbInstanceIdentifier")
+	}
+	mg.Spec.ForProvider.DBInstanceIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DBInstanceIdentifierRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -53,7 +53,34 @@ var (
 	DBSubnetGroupGroupVersionKind = SchemeGroupVersion.WithKind(DBSubnetGroupKind)
 )
 
+// DBParameterGroup type metadata.
+var (
+	DBParameterGroupKind             = reflect.TypeOf(DBParameterGroup{}).Name()
+	DBParameterGroupGroupKind        = schema.GroupKind{Group: Group, Kind: DBParameterGroupKind}.String()
+	DBParameterGroupKindAPIVersion   = DBParameterGroupKind + "." + SchemeGroupVersion.String()
+	DBParameterGroupGroupVersionKind = SchemeGroupVersion.WithKind(DBParameterGroupKind)
+)
+
+// OptionGroup type metadata.
+var (
+	OptionGroupKind             = reflect.TypeOf(OptionGroup{}).Name()
+	OptionGroupGroupKind        = schema.GroupKind{Group: Group, Kind: OptionGroupKind}.String()
+	OptionGroupKindAPIVersion   = OptionGroupKind + "." + SchemeGroupVersion.String()
+	OptionGroupGroupVersionKind = SchemeGroupVersion.WithKind(OptionGroupKind)
+)
+
+// DBSnapshot type metadata.
+var (
+	DBSnapshotKind             = reflect.TypeOf(DBSnapshot{}).Name()
+	DBSnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: DBSnapshotKind}.String()
+	DBSnapshotKindAPIVersion   = DBSnapshotKind + "." + SchemeGroupVersion.String()
+	DBSnapshotGroupVersionKind = SchemeGroupVersion.WithKind(DBSnapshotKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&RDSInstance{}, &RDSInstanceList{})
 	SchemeBuilder.Register(&DBSubnetGroup{}, &DBSubnetGroupList{})
+	SchemeBuilder.Register(&DBParameterGroup{}, &DBParameterGroupList{})
+	SchemeBuilder.Register(&OptionGroup{}, &OptionGroupList{})
+	SchemeBuilder.Register(&DBSnapshot{}, &DBSnapshotList{})
 }
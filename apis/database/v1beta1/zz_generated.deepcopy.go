@@ -22,7 +22,7 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -80,6 +80,141 @@ func (in *DBInstanceStatusInfo) DeepCopy() *DBInstanceStatusInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroup) DeepCopyInto(out *DBParameterGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroup.
+func (in *DBParameterGroup) DeepCopy() *DBParameterGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBParameterGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupList) DeepCopyInto(out *DBParameterGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBParameterGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupList.
+func (in *DBParameterGroupList) DeepCopy() *DBParameterGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBParameterGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupObservation) DeepCopyInto(out *DBParameterGroupObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupObservation.
+func (in *DBParameterGroupObservation) DeepCopy() *DBParameterGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupParameters) DeepCopyInto(out *DBParameterGroupParameters) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupParameters.
+func (in *DBParameterGroupParameters) DeepCopy() *DBParameterGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupResourceStatus) DeepCopyInto(out *DBParameterGroupResourceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupResourceStatus.
+func (in *DBParameterGroupResourceStatus) DeepCopy() *DBParameterGroupResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupSpec) DeepCopyInto(out *DBParameterGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupSpec.
+func (in *DBParameterGroupSpec) DeepCopy() *DBParameterGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DBParameterGroupStatus) DeepCopyInto(out *DBParameterGroupStatus) {
 	*out = *in
@@ -110,6 +245,153 @@ func (in *DBSecurityGroupMembership) DeepCopy() *DBSecurityGroupMembership {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSnapshot) DeepCopyInto(out *DBSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshot.
+func (in *DBSnapshot) DeepCopy() *DBSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSnapshotList) DeepCopyInto(out *DBSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotList.
+func (in *DBSnapshotList) DeepCopy() *DBSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSnapshotObservation) DeepCopyInto(out *DBSnapshotObservation) {
+	*out = *in
+	if in.SnapshotCreateTime != nil {
+		in, out := &in.SnapshotCreateTime, &out.SnapshotCreateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotObservation.
+func (in *DBSnapshotObservation) DeepCopy() *DBSnapshotObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSnapshotObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSnapshotParameters) DeepCopyInto(out *DBSnapshotParameters) {
+	*out = *in
+	if in.DBInstanceIdentifier != nil {
+		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstanceIdentifierRef != nil {
+		in, out := &in.DBInstanceIdentifierRef, &out.DBInstanceIdentifierRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.DBInstanceIdentifierSelector != nil {
+		in, out := &in.DBInstanceIdentifierSelector, &out.DBInstanceIdentifierSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotParameters.
+func (in *DBSnapshotParameters) DeepCopy() *DBSnapshotParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSnapshotParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSnapshotSpec) DeepCopyInto(out *DBSnapshotSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotSpec.
+func (in *DBSnapshotSpec) DeepCopy() *DBSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSnapshotStatus) DeepCopyInto(out *DBSnapshotStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotStatus.
+func (in *DBSnapshotStatus) DeepCopy() *DBSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DBSubnetGroup) DeepCopyInto(out *DBSubnetGroup) {
 	*out = *in
@@ -313,6 +595,100 @@ func (in *Endpoint) DeepCopy() *Endpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionConfiguration) DeepCopyInto(out *OptionConfiguration) {
+	*out = *in
+	if in.OptionVersion != nil {
+		in, out := &in.OptionVersion, &out.OptionVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OptionSettings != nil {
+		in, out := &in.OptionSettings, &out.OptionSettings
+		*out = make([]OptionSetting, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSecurityGroupMemberships != nil {
+		in, out := &in.VPCSecurityGroupMemberships, &out.VPCSecurityGroupMemberships
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionConfiguration.
+func (in *OptionConfiguration) DeepCopy() *OptionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionGroup) DeepCopyInto(out *OptionGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroup.
+func (in *OptionGroup) DeepCopy() *OptionGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OptionGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionGroupList) DeepCopyInto(out *OptionGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OptionGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupList.
+func (in *OptionGroupList) DeepCopy() *OptionGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OptionGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OptionGroupMembership) DeepCopyInto(out *OptionGroupMembership) {
 	*out = *in
@@ -328,6 +704,122 @@ func (in *OptionGroupMembership) DeepCopy() *OptionGroupMembership {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionGroupObservation) DeepCopyInto(out *OptionGroupObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupObservation.
+func (in *OptionGroupObservation) DeepCopy() *OptionGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionGroupParameters) DeepCopyInto(out *OptionGroupParameters) {
+	*out = *in
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]OptionConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ApplyImmediately != nil {
+		in, out := &in.ApplyImmediately, &out.ApplyImmediately
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupParameters.
+func (in *OptionGroupParameters) DeepCopy() *OptionGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionGroupSpec) DeepCopyInto(out *OptionGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupSpec.
+func (in *OptionGroupSpec) DeepCopy() *OptionGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionGroupStatus) DeepCopyInto(out *OptionGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupStatus.
+func (in *OptionGroupStatus) DeepCopy() *OptionGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionSetting) DeepCopyInto(out *OptionSetting) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionSetting.
+func (in *OptionSetting) DeepCopy() *OptionSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Parameter) DeepCopyInto(out *Parameter) {
+	*out = *in
+	if in.ApplyMethod != nil {
+		in, out := &in.ApplyMethod, &out.ApplyMethod
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Parameter.
+func (in *Parameter) DeepCopy() *Parameter {
+	if in == nil {
+		return nil
+	}
+	out := new(Parameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PendingCloudwatchLogsExports) DeepCopyInto(out *PendingCloudwatchLogsExports) {
 	*out = *in
@@ -374,6 +866,45 @@ func (in *PendingModifiedValues) DeepCopy() *PendingModifiedValues {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PointInTimeRestore) DeepCopyInto(out *PointInTimeRestore) {
+	*out = *in
+	if in.SourceDBInstanceIdentifier != nil {
+		in, out := &in.SourceDBInstanceIdentifier, &out.SourceDBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceDBInstanceIdentifierRef != nil {
+		in, out := &in.SourceDBInstanceIdentifierRef, &out.SourceDBInstanceIdentifierRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.SourceDBInstanceIdentifierSelector != nil {
+		in, out := &in.SourceDBInstanceIdentifierSelector, &out.SourceDBInstanceIdentifierSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RestoreTime != nil {
+		in, out := &in.RestoreTime, &out.RestoreTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UseLatestRestorableTime != nil {
+		in, out := &in.UseLatestRestorableTime, &out.UseLatestRestorableTime
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PointInTimeRestore.
+func (in *PointInTimeRestore) DeepCopy() *PointInTimeRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(PointInTimeRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProcessorFeature) DeepCopyInto(out *ProcessorFeature) {
 	*out = *in
@@ -687,6 +1218,11 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.MaintenanceWindowRef != nil {
+		in, out := &in.MaintenanceWindowRef, &out.MaintenanceWindowRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
 	if in.ProcessorFeatures != nil {
 		in, out := &in.ProcessorFeatures, &out.ProcessorFeatures
 		*out = make([]ProcessorFeature, len(*in))
@@ -762,6 +1298,16 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DBParameterGroupNameRef != nil {
+		in, out := &in.DBParameterGroupNameRef, &out.DBParameterGroupNameRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.DBParameterGroupNameSelector != nil {
+		in, out := &in.DBParameterGroupNameSelector, &out.DBParameterGroupNameSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Domain != nil {
 		in, out := &in.Domain, &out.Domain
 		*out = new(string)
@@ -787,6 +1333,16 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OptionGroupNameRef != nil {
+		in, out := &in.OptionGroupNameRef, &out.OptionGroupNameRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.OptionGroupNameSelector != nil {
+		in, out := &in.OptionGroupNameSelector, &out.OptionGroupNameSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.UseDefaultProcessorFeatures != nil {
 		in, out := &in.UseDefaultProcessorFeatures, &out.UseDefaultProcessorFeatures
 		*out = new(bool)
@@ -802,6 +1358,11 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(RestoreFrom)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSInstanceParameters.
@@ -848,6 +1409,31 @@ func (in *RDSInstanceStatus) DeepCopy() *RDSInstanceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreFrom) DeepCopyInto(out *RestoreFrom) {
+	*out = *in
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(SnapshotRestore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PointInTime != nil {
+		in, out := &in.PointInTime, &out.PointInTime
+		*out = new(PointInTimeRestore)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFrom.
+func (in *RestoreFrom) DeepCopy() *RestoreFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalingConfiguration) DeepCopyInto(out *ScalingConfiguration) {
 	*out = *in
@@ -883,6 +1469,36 @@ func (in *ScalingConfiguration) DeepCopy() *ScalingConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotRestore) DeepCopyInto(out *SnapshotRestore) {
+	*out = *in
+	if in.DBSnapshotIdentifier != nil {
+		in, out := &in.DBSnapshotIdentifier, &out.DBSnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBSnapshotIdentifierRef != nil {
+		in, out := &in.DBSnapshotIdentifierRef, &out.DBSnapshotIdentifierRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.DBSnapshotIdentifierSelector != nil {
+		in, out := &in.DBSnapshotIdentifierSelector, &out.DBSnapshotIdentifierSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotRestore.
+func (in *SnapshotRestore) DeepCopy() *SnapshotRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
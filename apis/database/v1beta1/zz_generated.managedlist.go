@@ -20,6 +20,24 @@ package v1beta1
 
 import resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 
+// GetItems of this DBParameterGroupList.
+func (l *DBParameterGroupList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this DBSnapshotList.
+func (l *DBSnapshotList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this DBSubnetGroupList.
 func (l *DBSubnetGroupList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -29,6 +47,15 @@ func (l *DBSubnetGroupList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this OptionGroupList.
+func (l *OptionGroupList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this RDSInstanceList.
 func (l *RDSInstanceList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
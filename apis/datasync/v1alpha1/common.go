@@ -0,0 +1,35 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// Tag defines a key value pair that can be attached to a DataSync location or
+// task.
+type Tag struct {
+	// Key is the name of the tag.
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+}
+
+// LocationObservation keeps the state of a DataSync location.
+type LocationObservation struct {
+	// LocationURI is the URI of the location, e.g. s3://bucket/prefix/.
+	LocationURI string `json:"locationUri,omitempty"`
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// LocationEFSParameters define the desired state of an AWS DataSync Amazon
+// EFS location.
+type LocationEFSParameters struct {
+	// Region is the region you'd like your LocationEFS to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// EFSFileSystemARN is the ARN of the EFS file system of the location.
+	// +immutable
+	EFSFileSystemARN string `json:"efsFileSystemArn"`
+
+	// SubnetARN is the ARN of the subnet DataSync uses to reach a mount
+	// target of the file system.
+	// +immutable
+	SubnetARN string `json:"subnetArn"`
+
+	// SecurityGroupARNs are the ARNs of the security groups of the mount
+	// target of the file system.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=5
+	// +immutable
+	SecurityGroupARNs []string `json:"securityGroupArns"`
+
+	// Subdirectory is the path in the file system that is read from or
+	// written to.
+	// +optional
+	// +immutable
+	Subdirectory *string `json:"subdirectory,omitempty"`
+
+	// Tags to assign to the location.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A LocationEFSSpec defines the desired state of a LocationEFS.
+type LocationEFSSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LocationEFSParameters `json:"forProvider"`
+}
+
+// A LocationEFSStatus represents the observed state of a LocationEFS.
+type LocationEFSStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LocationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LocationEFS is a managed resource that represents an AWS DataSync
+// location in an Amazon EFS file system. Its external name is the ARN of the
+// location.
+// +kubebuilder:printcolumn:name="URI",type="string",JSONPath=".status.atProvider.locationUri"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LocationEFS struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LocationEFSSpec   `json:"spec"`
+	Status LocationEFSStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LocationEFSList contains a list of LocationEFSs
+type LocationEFSList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LocationEFS `json:"items"`
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// LocationNFSParameters define the desired state of an AWS DataSync NFS
+// location.
+type LocationNFSParameters struct {
+	// Region is the region you'd like your LocationNFS to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// ServerHostname is the DNS name or IP address of the NFS server.
+	// +immutable
+	ServerHostname string `json:"serverHostname"`
+
+	// Subdirectory is the path exported by the NFS server that is read from
+	// or written to.
+	// +immutable
+	Subdirectory string `json:"subdirectory"`
+
+	// AgentARNs are the ARNs of the DataSync agents that connect to the NFS
+	// server.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=4
+	// +immutable
+	AgentARNs []string `json:"agentArns"`
+
+	// Version of NFS used to mount the export.
+	// +crossplane:aws:model=datasync.NfsMountOptions.Version
+	// +kubebuilder:validation:Enum=AUTOMATIC;NFS3;NFS4_0;NFS4_1
+	// +optional
+	// +immutable
+	Version *string `json:"version,omitempty"`
+
+	// Tags to assign to the location.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A LocationNFSSpec defines the desired state of a LocationNFS.
+type LocationNFSSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LocationNFSParameters `json:"forProvider"`
+}
+
+// A LocationNFSStatus represents the observed state of a LocationNFS.
+type LocationNFSStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LocationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LocationNFS is a managed resource that represents an AWS DataSync
+// location on an NFS server that is reached through DataSync agents. Its
+// external name is the ARN of the location.
+// +kubebuilder:printcolumn:name="URI",type="string",JSONPath=".status.atProvider.locationUri"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LocationNFS struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LocationNFSSpec   `json:"spec"`
+	Status LocationNFSStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LocationNFSList contains a list of LocationNFSs
+type LocationNFSList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LocationNFS `json:"items"`
+}
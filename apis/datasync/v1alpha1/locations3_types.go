@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// LocationS3Parameters define the desired state of an AWS DataSync Amazon S3
+// location.
+type LocationS3Parameters struct {
+	// Region is the region you'd like your LocationS3 to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// S3BucketARN is the ARN of the S3 bucket of the location.
+	// +optional
+	// +immutable
+	S3BucketARN *string `json:"s3BucketArn,omitempty"`
+
+	// S3BucketARNRef references a Bucket to retrieve its ARN.
+	// +optional
+	S3BucketARNRef *runtimev1alpha1.Reference `json:"s3BucketArnRef,omitempty"`
+
+	// S3BucketARNSelector selects a reference to a Bucket to retrieve its
+	// ARN.
+	// +optional
+	S3BucketARNSelector *runtimev1alpha1.Selector `json:"s3BucketArnSelector,omitempty"`
+
+	// BucketAccessRoleARN is the ARN of the IAM role DataSync assumes to
+	// access the bucket.
+	// +optional
+	// +immutable
+	BucketAccessRoleARN *string `json:"bucketAccessRoleArn,omitempty"`
+
+	// BucketAccessRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	BucketAccessRoleARNRef *runtimev1alpha1.Reference `json:"bucketAccessRoleArnRef,omitempty"`
+
+	// BucketAccessRoleARNSelector selects a reference to an IAMRole to
+	// retrieve its ARN.
+	// +optional
+	BucketAccessRoleARNSelector *runtimev1alpha1.Selector `json:"bucketAccessRoleArnSelector,omitempty"`
+
+	// S3StorageClass is the storage class of the objects written to the
+	// bucket when it is a destination.
+	// +crossplane:aws:model=datasync.CreateLocationS3Input.S3StorageClass
+	// +kubebuilder:validation:Enum=STANDARD;STANDARD_IA;ONEZONE_IA;INTELLIGENT_TIERING;GLACIER;DEEP_ARCHIVE
+	// +optional
+	// +immutable
+	S3StorageClass *string `json:"s3StorageClass,omitempty"`
+
+	// Subdirectory is the prefix in the bucket that is read from or written
+	// to.
+	// +optional
+	// +immutable
+	Subdirectory *string `json:"subdirectory,omitempty"`
+
+	// Tags to assign to the location.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A LocationS3Spec defines the desired state of a LocationS3.
+type LocationS3Spec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LocationS3Parameters `json:"forProvider"`
+}
+
+// A LocationS3Status represents the observed state of a LocationS3.
+type LocationS3Status struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LocationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LocationS3 is a managed resource that represents an AWS DataSync location
+// in an Amazon S3 bucket. Its external name is the ARN of the location.
+// +kubebuilder:printcolumn:name="URI",type="string",JSONPath=".status.atProvider.locationUri"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LocationS3 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LocationS3Spec   `json:"spec"`
+	Status LocationS3Status `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LocationS3List contains a list of LocationS3s
+type LocationS3List struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LocationS3 `json:"items"`
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// ResolveReferences of this LocationS3
+func (mg *LocationS3) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.s3BucketArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.S3BucketARN),
+		Reference:    mg.Spec.ForProvider.S3BucketARNRef,
+		Selector:     mg.Spec.ForProvider.S3BucketARNSelector,
+		To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+		Extract:      s3v1beta1.BucketARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.s3BucketArn")
+	}
+	mg.Spec.ForProvider.S3BucketARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.S3BucketARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.bucketAccessRoleArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.BucketAccessRoleARN),
+		Reference:    mg.Spec.ForProvider.BucketAccessRoleARNRef,
+		Selector:     mg.Spec.ForProvider.BucketAccessRoleARNSelector,
+		To:           reference.To{Managed: &identityv1beta1.IAMRole{}, List: &identityv1beta1.IAMRoleList{}},
+		Extract:      identityv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucketAccessRoleArn")
+	}
+	mg.Spec.ForProvider.BucketAccessRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.BucketAccessRoleARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Task. The source and destination locations can be
+// referenced by any of the location kinds; the first reference that is set
+// is resolved, since resolution is a no-op once the ARN is set.
+func (mg *Task) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.sourceLocationArn from a LocationS3
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceLocationARN),
+		Reference:    mg.Spec.ForProvider.SourceLocationS3Ref,
+		Selector:     mg.Spec.ForProvider.SourceLocationS3Selector,
+		To:           reference.To{Managed: &LocationS3{}, List: &LocationS3List{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceLocationArn")
+	}
+	mg.Spec.ForProvider.SourceLocationARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceLocationS3Ref = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.sourceLocationArn from a LocationEFS
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceLocationARN),
+		Reference:    mg.Spec.ForProvider.SourceLocationEFSRef,
+		Selector:     mg.Spec.ForProvider.SourceLocationEFSSelector,
+		To:           reference.To{Managed: &LocationEFS{}, List: &LocationEFSList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceLocationArn")
+	}
+	mg.Spec.ForProvider.SourceLocationARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceLocationEFSRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.sourceLocationArn from a LocationNFS
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceLocationARN),
+		Reference:    mg.Spec.ForProvider.SourceLocationNFSRef,
+		Selector:     mg.Spec.ForProvider.SourceLocationNFSSelector,
+		To:           reference.To{Managed: &LocationNFS{}, List: &LocationNFSList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceLocationArn")
+	}
+	mg.Spec.ForProvider.SourceLocationARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceLocationNFSRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.destinationLocationArn from a LocationS3
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DestinationLocationARN),
+		Reference:    mg.Spec.ForProvider.DestinationLocationS3Ref,
+		Selector:     mg.Spec.ForProvider.DestinationLocationS3Selector,
+		To:           reference.To{Managed: &LocationS3{}, List: &LocationS3List{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.destinationLocationArn")
+	}
+	mg.Spec.ForProvider.DestinationLocationARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DestinationLocationS3Ref = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.destinationLocationArn from a LocationEFS
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DestinationLocationARN),
+		Reference:    mg.Spec.ForProvider.DestinationLocationEFSRef,
+		Selector:     mg.Spec.ForProvider.DestinationLocationEFSSelector,
+		To:           reference.To{Managed: &LocationEFS{}, List: &LocationEFSList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.destinationLocationArn")
+	}
+	mg.Spec.ForProvider.DestinationLocationARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DestinationLocationEFSRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.destinationLocationArn from a LocationNFS
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DestinationLocationARN),
+		Reference:    mg.Spec.ForProvider.DestinationLocationNFSRef,
+		Selector:     mg.Spec.ForProvider.DestinationLocationNFSSelector,
+		To:           reference.To{Managed: &LocationNFS{}, List: &LocationNFSList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.destinationLocationArn")
+	}
+	mg.Spec.ForProvider.DestinationLocationARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DestinationLocationNFSRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "datasync.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// LocationS3 type metadata.
+var (
+	LocationS3Kind             = reflect.TypeOf(LocationS3{}).Name()
+	LocationS3GroupKind        = schema.GroupKind{Group: Group, Kind: LocationS3Kind}.String()
+	LocationS3KindAPIVersion   = LocationS3Kind + "." + SchemeGroupVersion.String()
+	LocationS3GroupVersionKind = SchemeGroupVersion.WithKind(LocationS3Kind)
+)
+
+// LocationEFS type metadata.
+var (
+	LocationEFSKind             = reflect.TypeOf(LocationEFS{}).Name()
+	LocationEFSGroupKind        = schema.GroupKind{Group: Group, Kind: LocationEFSKind}.String()
+	LocationEFSKindAPIVersion   = LocationEFSKind + "." + SchemeGroupVersion.String()
+	LocationEFSGroupVersionKind = SchemeGroupVersion.WithKind(LocationEFSKind)
+)
+
+// LocationNFS type metadata.
+var (
+	LocationNFSKind             = reflect.TypeOf(LocationNFS{}).Name()
+	LocationNFSGroupKind        = schema.GroupKind{Group: Group, Kind: LocationNFSKind}.String()
+	LocationNFSKindAPIVersion   = LocationNFSKind + "." + SchemeGroupVersion.String()
+	LocationNFSGroupVersionKind = SchemeGroupVersion.WithKind(LocationNFSKind)
+)
+
+// Task type metadata.
+var (
+	TaskKind             = reflect.TypeOf(Task{}).Name()
+	TaskGroupKind        = schema.GroupKind{Group: Group, Kind: TaskKind}.String()
+	TaskKindAPIVersion   = TaskKind + "." + SchemeGroupVersion.String()
+	TaskGroupVersionKind = SchemeGroupVersion.WithKind(TaskKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LocationS3{}, &LocationS3List{})
+	SchemeBuilder.Register(&LocationEFS{}, &LocationEFSList{})
+	SchemeBuilder.Register(&LocationNFS{}, &LocationNFSList{})
+	SchemeBuilder.Register(&Task{}, &TaskList{})
+}
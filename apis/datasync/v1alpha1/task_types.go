@@ -0,0 +1,279 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// FilterRule selects the files that are excluded from a transfer.
+type FilterRule struct {
+	// FilterType is the type of the filter.
+	// +crossplane:aws:model=datasync.FilterRule.FilterType
+	// +kubebuilder:validation:Enum=SIMPLE_PATTERN
+	FilterType string `json:"filterType"`
+
+	// Value is a list of patterns separated by |, e.g. /folder1|/folder2.
+	Value string `json:"value"`
+}
+
+// TaskOptions configure how the files of a task are transferred. Unset
+// options are late initialized with the defaults of DataSync.
+type TaskOptions struct {
+	// Atime specifies whether the access times of the files are preserved.
+	// +crossplane:aws:model=datasync.Options.Atime
+	// +kubebuilder:validation:Enum=NONE;BEST_EFFORT
+	// +optional
+	Atime *string `json:"atime,omitempty"`
+
+	// BytesPerSecond limits the bandwidth of the task. -1 uses all of the
+	// available bandwidth.
+	// +optional
+	BytesPerSecond *int64 `json:"bytesPerSecond,omitempty"`
+
+	// GID specifies how the POSIX group IDs of the files are preserved.
+	// +crossplane:aws:model=datasync.Options.Gid
+	// +kubebuilder:validation:Enum=NONE;INT_VALUE;NAME;BOTH
+	// +optional
+	GID *string `json:"gid,omitempty"`
+
+	// LogLevel is the level of the logs published to CloudWatch Logs.
+	// +crossplane:aws:model=datasync.Options.LogLevel
+	// +kubebuilder:validation:Enum=OFF;BASIC;TRANSFER
+	// +optional
+	LogLevel *string `json:"logLevel,omitempty"`
+
+	// Mtime specifies whether the modification times of the files are
+	// preserved.
+	// +crossplane:aws:model=datasync.Options.Mtime
+	// +kubebuilder:validation:Enum=NONE;PRESERVE
+	// +optional
+	Mtime *string `json:"mtime,omitempty"`
+
+	// OverwriteMode specifies whether files that changed in the destination
+	// are overwritten.
+	// +crossplane:aws:model=datasync.Options.OverwriteMode
+	// +kubebuilder:validation:Enum=ALWAYS;NEVER
+	// +optional
+	OverwriteMode *string `json:"overwriteMode,omitempty"`
+
+	// PosixPermissions specifies whether the POSIX permissions of the files
+	// are preserved.
+	// +crossplane:aws:model=datasync.Options.PosixPermissions
+	// +kubebuilder:validation:Enum=NONE;PRESERVE
+	// +optional
+	PosixPermissions *string `json:"posixPermissions,omitempty"`
+
+	// PreserveDeletedFiles specifies whether files that are deleted in the
+	// source are kept in the destination.
+	// +crossplane:aws:model=datasync.Options.PreserveDeletedFiles
+	// +kubebuilder:validation:Enum=PRESERVE;REMOVE
+	// +optional
+	PreserveDeletedFiles *string `json:"preserveDeletedFiles,omitempty"`
+
+	// PreserveDevices specifies whether device and special files are
+	// preserved.
+	// +crossplane:aws:model=datasync.Options.PreserveDevices
+	// +kubebuilder:validation:Enum=NONE;PRESERVE
+	// +optional
+	PreserveDevices *string `json:"preserveDevices,omitempty"`
+
+	// TaskQueueing specifies whether executions of the task are queued while
+	// other executions share its locations.
+	// +crossplane:aws:model=datasync.Options.TaskQueueing
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	TaskQueueing *string `json:"taskQueueing,omitempty"`
+
+	// UID specifies how the POSIX user IDs of the files are preserved.
+	// +crossplane:aws:model=datasync.Options.Uid
+	// +kubebuilder:validation:Enum=NONE;INT_VALUE;NAME;BOTH
+	// +optional
+	UID *string `json:"uid,omitempty"`
+
+	// VerifyMode specifies whether and how the transferred data is verified.
+	// +crossplane:aws:model=datasync.Options.VerifyMode
+	// +kubebuilder:validation:Enum=POINT_IN_TIME_CONSISTENT;ONLY_FILES_TRANSFERRED;NONE
+	// +optional
+	VerifyMode *string `json:"verifyMode,omitempty"`
+}
+
+// TaskParameters define the desired state of an AWS DataSync task.
+type TaskParameters struct {
+	// Region is the region you'd like your Task to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name of the task.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// SourceLocationARN is the ARN of the location that is read from.
+	// +optional
+	// +immutable
+	SourceLocationARN *string `json:"sourceLocationArn,omitempty"`
+
+	// SourceLocationS3Ref references a LocationS3 to retrieve its ARN as the
+	// SourceLocationARN.
+	// +optional
+	SourceLocationS3Ref *runtimev1alpha1.Reference `json:"sourceLocationS3Ref,omitempty"`
+
+	// SourceLocationS3Selector selects a reference to a LocationS3 to
+	// retrieve its ARN as the SourceLocationARN.
+	// +optional
+	SourceLocationS3Selector *runtimev1alpha1.Selector `json:"sourceLocationS3Selector,omitempty"`
+
+	// SourceLocationEFSRef references a LocationEFS to retrieve its ARN as
+	// the SourceLocationARN.
+	// +optional
+	SourceLocationEFSRef *runtimev1alpha1.Reference `json:"sourceLocationEfsRef,omitempty"`
+
+	// SourceLocationEFSSelector selects a reference to a LocationEFS to
+	// retrieve its ARN as the SourceLocationARN.
+	// +optional
+	SourceLocationEFSSelector *runtimev1alpha1.Selector `json:"sourceLocationEfsSelector,omitempty"`
+
+	// SourceLocationNFSRef references a LocationNFS to retrieve its ARN as
+	// the SourceLocationARN.
+	// +optional
+	SourceLocationNFSRef *runtimev1alpha1.Reference `json:"sourceLocationNfsRef,omitempty"`
+
+	// SourceLocationNFSSelector selects a reference to a LocationNFS to
+	// retrieve its ARN as the SourceLocationARN.
+	// +optional
+	SourceLocationNFSSelector *runtimev1alpha1.Selector `json:"sourceLocationNfsSelector,omitempty"`
+
+	// DestinationLocationARN is the ARN of the location that is written to.
+	// +optional
+	// +immutable
+	DestinationLocationARN *string `json:"destinationLocationArn,omitempty"`
+
+	// DestinationLocationS3Ref references a LocationS3 to retrieve its ARN
+	// as the DestinationLocationARN.
+	// +optional
+	DestinationLocationS3Ref *runtimev1alpha1.Reference `json:"destinationLocationS3Ref,omitempty"`
+
+	// DestinationLocationS3Selector selects a reference to a LocationS3 to
+	// retrieve its ARN as the DestinationLocationARN.
+	// +optional
+	DestinationLocationS3Selector *runtimev1alpha1.Selector `json:"destinationLocationS3Selector,omitempty"`
+
+	// DestinationLocationEFSRef references a LocationEFS to retrieve its ARN
+	// as the DestinationLocationARN.
+	// +optional
+	DestinationLocationEFSRef *runtimev1alpha1.Reference `json:"destinationLocationEfsRef,omitempty"`
+
+	// DestinationLocationEFSSelector selects a reference to a LocationEFS to
+	// retrieve its ARN as the DestinationLocationARN.
+	// +optional
+	DestinationLocationEFSSelector *runtimev1alpha1.Selector `json:"destinationLocationEfsSelector,omitempty"`
+
+	// DestinationLocationNFSRef references a LocationNFS to retrieve its ARN
+	// as the DestinationLocationARN.
+	// +optional
+	DestinationLocationNFSRef *runtimev1alpha1.Reference `json:"destinationLocationNfsRef,omitempty"`
+
+	// DestinationLocationNFSSelector selects a reference to a LocationNFS to
+	// retrieve its ARN as the DestinationLocationARN.
+	// +optional
+	DestinationLocationNFSSelector *runtimev1alpha1.Selector `json:"destinationLocationNfsSelector,omitempty"`
+
+	// CloudWatchLogGroupARN is the ARN of the CloudWatch Logs log group the
+	// task publishes its logs to.
+	// +optional
+	CloudWatchLogGroupARN *string `json:"cloudWatchLogGroupArn,omitempty"`
+
+	// ScheduleExpression is the cron or rate expression the task is run on,
+	// e.g. cron(0 12 ? * SUN,WED *). Tasks without a schedule only run when
+	// they are started.
+	// +optional
+	ScheduleExpression *string `json:"scheduleExpression,omitempty"`
+
+	// Excludes are the filters of the files that are not transferred.
+	// +kubebuilder:validation:MaxItems=1
+	// +optional
+	Excludes []FilterRule `json:"excludes,omitempty"`
+
+	// Options of the transfers of the task.
+	// +optional
+	Options *TaskOptions `json:"options,omitempty"`
+
+	// Tags to assign to the task.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TaskSpec defines the desired state of a Task.
+type TaskSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TaskParameters `json:"forProvider"`
+}
+
+// TaskObservation keeps the state for the external resource.
+type TaskObservation struct {
+	// Status of the task, i.e. AVAILABLE, CREATING, QUEUED, RUNNING or
+	// UNAVAILABLE.
+	Status string `json:"status,omitempty"`
+
+	// CurrentTaskExecutionARN is the ARN of the running execution of the
+	// task.
+	CurrentTaskExecutionARN string `json:"currentTaskExecutionArn,omitempty"`
+
+	// ErrorCode is the code of the error that made the task unavailable.
+	ErrorCode string `json:"errorCode,omitempty"`
+
+	// ErrorDetail describes the error that made the task unavailable.
+	ErrorDetail string `json:"errorDetail,omitempty"`
+}
+
+// A TaskStatus represents the observed state of a Task.
+type TaskStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TaskObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Task is a managed resource that represents an AWS DataSync task, which
+// transfers files from a source to a destination location, optionally on a
+// schedule. Its external name is the ARN of the task.
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="SCHEDULE",type="string",JSONPath=".spec.forProvider.scheduleExpression"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Task struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TaskSpec   `json:"spec"`
+	Status TaskStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaskList contains a list of Tasks
+type TaskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Task `json:"items"`
+}
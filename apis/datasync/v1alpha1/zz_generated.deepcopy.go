@@ -0,0 +1,768 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterRule) DeepCopyInto(out *FilterRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterRule.
+func (in *FilterRule) DeepCopy() *FilterRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationEFS) DeepCopyInto(out *LocationEFS) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationEFS.
+func (in *LocationEFS) DeepCopy() *LocationEFS {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationEFS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocationEFS) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationEFSList) DeepCopyInto(out *LocationEFSList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LocationEFS, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationEFSList.
+func (in *LocationEFSList) DeepCopy() *LocationEFSList {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationEFSList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocationEFSList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationEFSParameters) DeepCopyInto(out *LocationEFSParameters) {
+	*out = *in
+	if in.SecurityGroupARNs != nil {
+		in, out := &in.SecurityGroupARNs, &out.SecurityGroupARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subdirectory != nil {
+		in, out := &in.Subdirectory, &out.Subdirectory
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationEFSParameters.
+func (in *LocationEFSParameters) DeepCopy() *LocationEFSParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationEFSParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationEFSSpec) DeepCopyInto(out *LocationEFSSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationEFSSpec.
+func (in *LocationEFSSpec) DeepCopy() *LocationEFSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationEFSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationEFSStatus) DeepCopyInto(out *LocationEFSStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationEFSStatus.
+func (in *LocationEFSStatus) DeepCopy() *LocationEFSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationEFSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationNFS) DeepCopyInto(out *LocationNFS) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationNFS.
+func (in *LocationNFS) DeepCopy() *LocationNFS {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationNFS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocationNFS) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationNFSList) DeepCopyInto(out *LocationNFSList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LocationNFS, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationNFSList.
+func (in *LocationNFSList) DeepCopy() *LocationNFSList {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationNFSList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocationNFSList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationNFSParameters) DeepCopyInto(out *LocationNFSParameters) {
+	*out = *in
+	if in.AgentARNs != nil {
+		in, out := &in.AgentARNs, &out.AgentARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationNFSParameters.
+func (in *LocationNFSParameters) DeepCopy() *LocationNFSParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationNFSParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationNFSSpec) DeepCopyInto(out *LocationNFSSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationNFSSpec.
+func (in *LocationNFSSpec) DeepCopy() *LocationNFSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationNFSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationNFSStatus) DeepCopyInto(out *LocationNFSStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationNFSStatus.
+func (in *LocationNFSStatus) DeepCopy() *LocationNFSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationNFSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationObservation) DeepCopyInto(out *LocationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationObservation.
+func (in *LocationObservation) DeepCopy() *LocationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationS3) DeepCopyInto(out *LocationS3) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationS3.
+func (in *LocationS3) DeepCopy() *LocationS3 {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationS3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocationS3) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationS3List) DeepCopyInto(out *LocationS3List) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LocationS3, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationS3List.
+func (in *LocationS3List) DeepCopy() *LocationS3List {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationS3List)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocationS3List) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationS3Parameters) DeepCopyInto(out *LocationS3Parameters) {
+	*out = *in
+	if in.S3BucketARN != nil {
+		in, out := &in.S3BucketARN, &out.S3BucketARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3BucketARNRef != nil {
+		in, out := &in.S3BucketARNRef, &out.S3BucketARNRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.S3BucketARNSelector != nil {
+		in, out := &in.S3BucketARNSelector, &out.S3BucketARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketAccessRoleARN != nil {
+		in, out := &in.BucketAccessRoleARN, &out.BucketAccessRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketAccessRoleARNRef != nil {
+		in, out := &in.BucketAccessRoleARNRef, &out.BucketAccessRoleARNRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.BucketAccessRoleARNSelector != nil {
+		in, out := &in.BucketAccessRoleARNSelector, &out.BucketAccessRoleARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3StorageClass != nil {
+		in, out := &in.S3StorageClass, &out.S3StorageClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subdirectory != nil {
+		in, out := &in.Subdirectory, &out.Subdirectory
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationS3Parameters.
+func (in *LocationS3Parameters) DeepCopy() *LocationS3Parameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationS3Parameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationS3Spec) DeepCopyInto(out *LocationS3Spec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationS3Spec.
+func (in *LocationS3Spec) DeepCopy() *LocationS3Spec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationS3Spec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationS3Status) DeepCopyInto(out *LocationS3Status) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationS3Status.
+func (in *LocationS3Status) DeepCopy() *LocationS3Status {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationS3Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Task) DeepCopyInto(out *Task) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Task.
+func (in *Task) DeepCopy() *Task {
+	if in == nil {
+		return nil
+	}
+	out := new(Task)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Task) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskList) DeepCopyInto(out *TaskList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Task, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskList.
+func (in *TaskList) DeepCopy() *TaskList {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TaskList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskObservation) DeepCopyInto(out *TaskObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskObservation.
+func (in *TaskObservation) DeepCopy() *TaskObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskOptions) DeepCopyInto(out *TaskOptions) {
+	*out = *in
+	if in.Atime != nil {
+		in, out := &in.Atime, &out.Atime
+		*out = new(string)
+		**out = **in
+	}
+	if in.BytesPerSecond != nil {
+		in, out := &in.BytesPerSecond, &out.BytesPerSecond
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GID != nil {
+		in, out := &in.GID, &out.GID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogLevel != nil {
+		in, out := &in.LogLevel, &out.LogLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mtime != nil {
+		in, out := &in.Mtime, &out.Mtime
+		*out = new(string)
+		**out = **in
+	}
+	if in.OverwriteMode != nil {
+		in, out := &in.OverwriteMode, &out.OverwriteMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.PosixPermissions != nil {
+		in, out := &in.PosixPermissions, &out.PosixPermissions
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreserveDeletedFiles != nil {
+		in, out := &in.PreserveDeletedFiles, &out.PreserveDeletedFiles
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreserveDevices != nil {
+		in, out := &in.PreserveDevices, &out.PreserveDevices
+		*out = new(string)
+		**out = **in
+	}
+	if in.TaskQueueing != nil {
+		in, out := &in.TaskQueueing, &out.TaskQueueing
+		*out = new(string)
+		**out = **in
+	}
+	if in.UID != nil {
+		in, out := &in.UID, &out.UID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VerifyMode != nil {
+		in, out := &in.VerifyMode, &out.VerifyMode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskOptions.
+func (in *TaskOptions) DeepCopy() *TaskOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskParameters) DeepCopyInto(out *TaskParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceLocationARN != nil {
+		in, out := &in.SourceLocationARN, &out.SourceLocationARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceLocationS3Ref != nil {
+		in, out := &in.SourceLocationS3Ref, &out.SourceLocationS3Ref
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.SourceLocationS3Selector != nil {
+		in, out := &in.SourceLocationS3Selector, &out.SourceLocationS3Selector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceLocationEFSRef != nil {
+		in, out := &in.SourceLocationEFSRef, &out.SourceLocationEFSRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.SourceLocationEFSSelector != nil {
+		in, out := &in.SourceLocationEFSSelector, &out.SourceLocationEFSSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceLocationNFSRef != nil {
+		in, out := &in.SourceLocationNFSRef, &out.SourceLocationNFSRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.SourceLocationNFSSelector != nil {
+		in, out := &in.SourceLocationNFSSelector, &out.SourceLocationNFSSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationLocationARN != nil {
+		in, out := &in.DestinationLocationARN, &out.DestinationLocationARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationLocationS3Ref != nil {
+		in, out := &in.DestinationLocationS3Ref, &out.DestinationLocationS3Ref
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.DestinationLocationS3Selector != nil {
+		in, out := &in.DestinationLocationS3Selector, &out.DestinationLocationS3Selector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationLocationEFSRef != nil {
+		in, out := &in.DestinationLocationEFSRef, &out.DestinationLocationEFSRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.DestinationLocationEFSSelector != nil {
+		in, out := &in.DestinationLocationEFSSelector, &out.DestinationLocationEFSSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationLocationNFSRef != nil {
+		in, out := &in.DestinationLocationNFSRef, &out.DestinationLocationNFSRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.DestinationLocationNFSSelector != nil {
+		in, out := &in.DestinationLocationNFSSelector, &out.DestinationLocationNFSSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudWatchLogGroupARN != nil {
+		in, out := &in.CloudWatchLogGroupARN, &out.CloudWatchLogGroupARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScheduleExpression != nil {
+		in, out := &in.ScheduleExpression, &out.ScheduleExpression
+		*out = new(string)
+		**out = **in
+	}
+	if in.Excludes != nil {
+		in, out := &in.Excludes, &out.Excludes
+		*out = make([]FilterRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(TaskOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskParameters.
+func (in *TaskParameters) DeepCopy() *TaskParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskSpec) DeepCopyInto(out *TaskSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskSpec.
+func (in *TaskSpec) DeepCopy() *TaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskStatus) DeepCopyInto(out *TaskStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskStatus.
+func (in *TaskStatus) DeepCopy() *TaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// DocumentDB DB instance states.
+const (
+	// The instance is healthy and available.
+	DBInstanceStateAvailable = "available"
+	// The instance is being created.
+	DBInstanceStateCreating = "creating"
+	// The instance is being deleted.
+	DBInstanceStateDeleting = "deleting"
+	// The instance is being modified.
+	DBInstanceStateModifying = "modifying"
+)
+
+// DBInstanceParameters define the desired state of an AWS DocumentDB DB
+// instance. DocumentDB instances always belong to a DB cluster, which holds the
+// storage, network and backup settings that they share.
+type DBInstanceParameters struct {
+	// Region is the region you'd like the DBInstance to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// DBInstanceClass is the compute and memory capacity of the instance,
+	// for example db.r5.large.
+	DBInstanceClass string `json:"dbInstanceClass"`
+
+	// DBClusterIdentifier is the identifier of the DB cluster that the
+	// instance belongs to.
+	// +immutable
+	// +optional
+	DBClusterIdentifier *string `json:"dbClusterIdentifier,omitempty"`
+
+	// DBClusterIdentifierRef is a reference to a DBCluster used to set
+	// DBClusterIdentifier.
+	// +immutable
+	// +optional
+	DBClusterIdentifierRef *runtimev1alpha1.Reference `json:"dbClusterIdentifierRef,omitempty"`
+
+	// DBClusterIdentifierSelector selects a reference to a DBCluster used to
+	// set DBClusterIdentifier.
+	// +immutable
+	// +optional
+	DBClusterIdentifierSelector *runtimev1alpha1.Selector `json:"dbClusterIdentifierSelector,omitempty"`
+
+	// AvailabilityZone is the EC2 Availability Zone that the instance is
+	// created in.
+	// Default: a random Availability Zone of the region.
+	// +immutable
+	// +optional
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+
+	// CACertificateIdentifier is the identifier of the certificate authority
+	// certificate that the instance presents to TLS clients.
+	// Default: the current default certificate authority of the region, for
+	// example rds-ca-2019.
+	// +optional
+	CACertificateIdentifier *string `json:"caCertificateIdentifier,omitempty"`
+
+	// AutoMinorVersionUpgrade indicates that minor engine upgrades are
+	// applied automatically to the instance during the maintenance window.
+	// Default: true
+	// +optional
+	AutoMinorVersionUpgrade *bool `json:"autoMinorVersionUpgrade,omitempty"`
+
+	// PreferredMaintenanceWindow is the weekly time range during which system
+	// maintenance can occur, in the format ddd:hh24:mi-ddd:hh24:mi in UTC.
+	// +optional
+	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
+
+	// PromotionTier is the order in which a read replica is promoted to the
+	// primary instance after a failure of the existing primary instance.
+	// Default: 1
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=15
+	// +optional
+	PromotionTier *int64 `json:"promotionTier,omitempty"`
+
+	// ApplyImmediately specifies whether modifications are applied
+	// immediately, or during the next maintenance window.
+	// Default: false
+	// +optional
+	ApplyImmediately *bool `json:"applyImmediately,omitempty"`
+
+	// Tags to assign to the instance.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// DBInstanceObservation is the representation of the current state that is
+// observed.
+type DBInstanceObservation struct {
+	// DBInstanceARN is the Amazon Resource Name (ARN) of the instance.
+	DBInstanceARN string `json:"dbInstanceArn,omitempty"`
+
+	// DBInstanceStatus is the current state of the instance.
+	DBInstanceStatus string `json:"dbInstanceStatus,omitempty"`
+
+	// DBIResourceID is the AWS Region-unique, immutable identifier of the
+	// instance.
+	DBIResourceID string `json:"dbiResourceId,omitempty"`
+
+	// EndpointAddress is the DNS address of the instance.
+	EndpointAddress string `json:"endpointAddress,omitempty"`
+
+	// EndpointPort is the port that the instance listens on.
+	EndpointPort int64 `json:"endpointPort,omitempty"`
+
+	// EngineVersion is the version of the DocumentDB engine that the instance
+	// runs.
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// PendingDBInstanceClass is the instance class that the instance is
+	// changed to during the next maintenance window.
+	PendingDBInstanceClass string `json:"pendingDBInstanceClass,omitempty"`
+}
+
+// DBInstanceSpec defines the desired state of an AWS DocumentDB DBInstance.
+type DBInstanceSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DBInstanceParameters `json:"forProvider"`
+}
+
+// DBInstanceStatus represents the observed state of an AWS DocumentDB
+// DBInstance.
+type DBInstanceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DBInstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBInstance is a managed resource that represents an AWS DocumentDB DB
+// instance of a DB cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.dbInstanceStatus"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.forProvider.dbInstanceClass"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBInstanceSpec   `json:"spec"`
+	Status DBInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBInstanceList contains a list of DBInstance
+type DBInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBInstance `json:"items"`
+}
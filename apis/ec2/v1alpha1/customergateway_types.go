@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Known Customer Gateway states.
+const (
+	CustomerGatewayStatePending   = "pending"
+	CustomerGatewayStateAvailable = "available"
+	CustomerGatewayStateDeleting  = "deleting"
+	CustomerGatewayStateDeleted   = "deleted"
+)
+
+// CustomerGatewayParameters define the desired state of an AWS Customer
+// Gateway.
+type CustomerGatewayParameters struct {
+	// Region is the region you'd like your CustomerGateway to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// BGPASN is the Border Gateway Protocol Autonomous System Number of the
+	// customer gateway device.
+	// +immutable
+	BGPASN int64 `json:"bgpAsn"`
+
+	// IPAddress is the Internet-routable IP address of the customer gateway's
+	// outside interface. The address must be static.
+	// +immutable
+	// +optional
+	IPAddress *string `json:"ipAddress,omitempty"`
+
+	// CertificateARN is the Amazon Resource Name of the private certificate
+	// used to authenticate the customer gateway device.
+	// +immutable
+	// +optional
+	CertificateARN *string `json:"certificateArn,omitempty"`
+
+	// DeviceName is a name for the customer gateway device.
+	// +immutable
+	// +optional
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// Type of VPN connection that the customer gateway supports.
+	// +crossplane:aws:model=ec2.CreateCustomerGatewayInput.Type
+	// +kubebuilder:validation:Enum=ipsec.1
+	// +immutable
+	Type string `json:"type"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// CustomerGatewayObservation keeps the state for the external resource
+type CustomerGatewayObservation struct {
+	// CustomerGatewayID is the ID of the customer gateway.
+	CustomerGatewayID string `json:"customerGatewayId,omitempty"`
+
+	// State of the customer gateway.
+	State string `json:"state,omitempty"`
+}
+
+// A CustomerGatewaySpec defines the desired state of a CustomerGateway.
+type CustomerGatewaySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CustomerGatewayParameters `json:"forProvider"`
+}
+
+// A CustomerGatewayStatus represents the observed state of a
+// CustomerGateway.
+type CustomerGatewayStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CustomerGatewayObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CustomerGateway is a managed resource that represents the customer side
+// of an AWS Site-to-Site VPN connection.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".spec.forProvider.ipAddress"
+// +kubebuilder:printcolumn:name="ASN",type="integer",JSONPath=".spec.forProvider.bgpAsn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CustomerGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomerGatewaySpec   `json:"spec"`
+	Status CustomerGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CustomerGatewayList contains a list of CustomerGateways
+type CustomerGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CustomerGateway `json:"items"`
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// EgressOnlyInternetGatewayParameters define the desired state of an AWS VPC
+// egress-only internet gateway.
+type EgressOnlyInternetGatewayParameters struct {
+	// Region is the region you'd like your EgressOnlyInternetGateway to be
+	// created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// VPCID is the ID of the VPC the gateway is created in.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its ID.
+	// +immutable
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its ID.
+	// +immutable
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// EgressOnlyInternetGatewayObservation keeps the state for the external
+// resource
+type EgressOnlyInternetGatewayObservation struct {
+	// EgressOnlyInternetGatewayID is the ID of the gateway.
+	EgressOnlyInternetGatewayID string `json:"egressOnlyInternetGatewayId,omitempty"`
+
+	// Attachments are the VPCs attached to the gateway.
+	Attachments []ec2v1beta1.InternetGatewayAttachment `json:"attachments,omitempty"`
+}
+
+// An EgressOnlyInternetGatewaySpec defines the desired state of an
+// EgressOnlyInternetGateway.
+type EgressOnlyInternetGatewaySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  EgressOnlyInternetGatewayParameters `json:"forProvider"`
+}
+
+// An EgressOnlyInternetGatewayStatus represents the observed state of an
+// EgressOnlyInternetGateway.
+type EgressOnlyInternetGatewayStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     EgressOnlyInternetGatewayObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EgressOnlyInternetGateway is a managed resource that represents an AWS
+// VPC egress-only internet gateway, which allows outbound IPv6 traffic from
+// a VPC to the internet but no inbound connections.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type EgressOnlyInternetGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EgressOnlyInternetGatewaySpec   `json:"spec"`
+	Status EgressOnlyInternetGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EgressOnlyInternetGatewayList contains a list of EgressOnlyInternetGateways
+type EgressOnlyInternetGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EgressOnlyInternetGateway `json:"items"`
+}
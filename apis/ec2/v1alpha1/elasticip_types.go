@@ -46,6 +46,7 @@ type ElasticIPParameters struct {
 	// Default: The address is for use with instances in EC2-Classic.
 	// +optional
 	// +immutable
+	// +crossplane:aws:model=ec2.AllocateAddressInput.Domain
 	// +kubebuilder:validation:Enum=vpc;standard
 	Domain *string `json:"domain,omitempty"`
 
@@ -73,6 +74,24 @@ type ElasticIPParameters struct {
 	// +immutable
 	PublicIPv4Pool *string `json:"publicIpv4Pool,omitempty"`
 
+	// InstanceID is the ID of the instance to associate the address with.
+	// For instances with more than one network interface, specify the
+	// NetworkInterfaceID instead. The association of the address is only
+	// managed if either InstanceID or NetworkInterfaceID is set.
+	// +optional
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// NetworkInterfaceID is the ID of the network interface to associate the
+	// address with.
+	// +optional
+	NetworkInterfaceID *string `json:"networkInterfaceId,omitempty"`
+
+	// PrivateIPAddress is the primary or secondary private IP address of the
+	// network interface to associate the address with. The primary private IP
+	// address is used if it is not set.
+	// +optional
+	PrivateIPAddress *string `json:"privateIpAddress,omitempty"`
+
 	// Tags represents to current ec2 tags.
 	// +optional
 	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
@@ -133,12 +152,14 @@ type ElasticIPStatus struct {
 
 // +kubebuilder:object:root=true
 
-// A ElasticIP is a managed resource that represents an AWS VPC Security
-// Group.
+// A ElasticIP is a managed resource that represents an AWS Elastic IP
+// address.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.publicIp"
+// +kubebuilder:printcolumn:name="ALLOCATION ID",type="string",JSONPath=".status.atProvider.allocationId",priority=1
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".status.atProvider.instanceId",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
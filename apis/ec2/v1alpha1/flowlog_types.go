@@ -0,0 +1,206 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Destination types of a FlowLog.
+const (
+	FlowLogDestinationTypeCloudWatchLogs = "cloud-watch-logs"
+	FlowLogDestinationTypeS3             = "s3"
+)
+
+// FlowLogParameters define the desired state of an AWS VPC Flow Log. Exactly
+// one of the VPC, subnet or network interface has to be specified.
+type FlowLogParameters struct {
+	// Region is the region you'd like your FlowLog to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// VPCID is the ID of the VPC whose traffic is logged.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its ID.
+	// +immutable
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its ID.
+	// +immutable
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// SubnetID is the ID of the subnet whose traffic is logged.
+	// +immutable
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its ID.
+	// +immutable
+	// +optional
+	SubnetIDRef *runtimev1alpha1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet to retrieve its ID.
+	// +immutable
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// NetworkInterfaceID is the ID of the network interface whose traffic is
+	// logged.
+	// +immutable
+	// +optional
+	NetworkInterfaceID *string `json:"networkInterfaceId,omitempty"`
+
+	// TrafficType is the type of traffic that is logged.
+	// +crossplane:aws:model=ec2.CreateFlowLogsInput.TrafficType
+	// +kubebuilder:validation:Enum=ACCEPT;REJECT;ALL
+	// +immutable
+	TrafficType string `json:"trafficType"`
+
+	// LogDestinationType is the type of the destination the flow log is
+	// published to. Defaults to cloud-watch-logs.
+	// +crossplane:aws:model=ec2.CreateFlowLogsInput.LogDestinationType
+	// +kubebuilder:validation:Enum=cloud-watch-logs;s3
+	// +immutable
+	// +optional
+	LogDestinationType *string `json:"logDestinationType,omitempty"`
+
+	// LogGroupName is the name of the CloudWatch Logs log group the flow log
+	// is published to. Either it or LogDestination is required for the
+	// cloud-watch-logs destination type.
+	// +immutable
+	// +optional
+	LogGroupName *string `json:"logGroupName,omitempty"`
+
+	// LogDestination is the ARN of the CloudWatch Logs log group or of the
+	// S3 bucket, optionally followed by a folder, the flow log is published
+	// to.
+	// +immutable
+	// +optional
+	LogDestination *string `json:"logDestination,omitempty"`
+
+	// LogDestinationRef references a Bucket to retrieve its ARN.
+	// +immutable
+	// +optional
+	LogDestinationRef *runtimev1alpha1.Reference `json:"logDestinationRef,omitempty"`
+
+	// LogDestinationSelector selects a reference to a Bucket to retrieve its
+	// ARN.
+	// +immutable
+	// +optional
+	LogDestinationSelector *runtimev1alpha1.Selector `json:"logDestinationSelector,omitempty"`
+
+	// DeliverLogsPermissionARN is the ARN of the IAM role that allows the
+	// flow log to publish to CloudWatch Logs.
+	// +immutable
+	// +optional
+	DeliverLogsPermissionARN *string `json:"deliverLogsPermissionArn,omitempty"`
+
+	// DeliverLogsPermissionARNRef references an IAMRole to retrieve its ARN.
+	// +immutable
+	// +optional
+	DeliverLogsPermissionARNRef *runtimev1alpha1.Reference `json:"deliverLogsPermissionArnRef,omitempty"`
+
+	// DeliverLogsPermissionARNSelector selects a reference to an IAMRole to
+	// retrieve its ARN.
+	// +immutable
+	// +optional
+	DeliverLogsPermissionARNSelector *runtimev1alpha1.Selector `json:"deliverLogsPermissionArnSelector,omitempty"`
+
+	// LogFormat is the format of the flow log records, e.g.
+	// "${srcaddr} ${dstaddr} ${action}". Defaults to the AWS default format.
+	// +immutable
+	// +optional
+	LogFormat *string `json:"logFormat,omitempty"`
+
+	// MaxAggregationInterval is the maximum interval of time, in seconds,
+	// during which a flow of packets is aggregated into a record.
+	// +kubebuilder:validation:Enum=60;600
+	// +immutable
+	// +optional
+	MaxAggregationInterval *int64 `json:"maxAggregationInterval,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A FlowLogSpec defines the desired state of a FlowLog.
+type FlowLogSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  FlowLogParameters `json:"forProvider"`
+}
+
+// FlowLogObservation keeps the state for the external resource.
+type FlowLogObservation struct {
+	// FlowLogID is the ID of the flow log.
+	FlowLogID string `json:"flowLogId,omitempty"`
+
+	// FlowLogStatus is the status of the flow log, e.g. ACTIVE.
+	FlowLogStatus string `json:"flowLogStatus,omitempty"`
+
+	// DeliverLogsStatus is the status of the publishing of the logs, i.e.
+	// SUCCESS or FAILED.
+	DeliverLogsStatus string `json:"deliverLogsStatus,omitempty"`
+
+	// DeliverLogsErrorMessage describes why the logs could not be
+	// published.
+	DeliverLogsErrorMessage string `json:"deliverLogsErrorMessage,omitempty"`
+}
+
+// A FlowLogStatus represents the observed state of a FlowLog.
+type FlowLogStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     FlowLogObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FlowLog is a managed resource that represents an AWS VPC Flow Log. A
+// flow log cannot be modified, except for its tags.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TRAFFIC",type="string",JSONPath=".spec.forProvider.trafficType"
+// +kubebuilder:printcolumn:name="DELIVERY",type="string",JSONPath=".status.atProvider.deliverLogsStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type FlowLog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FlowLogSpec   `json:"spec"`
+	Status FlowLogStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FlowLogList contains a list of FlowLogs
+type FlowLogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FlowLog `json:"items"`
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Desired states of an Instance.
+const (
+	InstanceStateRunning = "running"
+	InstanceStateStopped = "stopped"
+)
+
+// EBSBlockDevice describes an EBS volume attached to an Instance at launch.
+type EBSBlockDevice struct {
+	// DeleteOnTermination indicates whether the volume is deleted when the
+	// instance is terminated.
+	// +optional
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+
+	// Encrypted indicates whether the volume is encrypted.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// IOPS is the number of I/O operations per second that the volume
+	// supports. Only valid for io1 and io2 volumes.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+
+	// KMSKeyID is the identifier of the customer managed KMS key used to
+	// encrypt the volume.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// SnapshotID is the ID of the snapshot the volume is created from.
+	// +optional
+	SnapshotID *string `json:"snapshotId,omitempty"`
+
+	// VolumeSize is the size of the volume, in GiB.
+	// +optional
+	VolumeSize *int64 `json:"volumeSize,omitempty"`
+
+	// VolumeType is the type of the volume.
+	// +kubebuilder:validation:Enum=standard;io1;io2;gp2;gp3;sc1;st1
+	// +optional
+	VolumeType *string `json:"volumeType,omitempty"`
+}
+
+// BlockDeviceMapping describes a block device attached to an Instance at
+// launch.
+type BlockDeviceMapping struct {
+	// DeviceName is the device name, e.g. /dev/sdh or xvdh.
+	DeviceName string `json:"deviceName"`
+
+	// EBS configures the EBS volume of the device.
+	// +optional
+	EBS *EBSBlockDevice `json:"ebs,omitempty"`
+}
+
+// InstanceIAMInstanceProfile identifies the IAM instance profile of an
+// Instance, either by ARN or by name.
+type InstanceIAMInstanceProfile struct {
+	// ARN of the instance profile.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
+
+	// Name of the instance profile.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// NameRef references an InstanceProfile to retrieve its name.
+	// +optional
+	NameRef *runtimev1alpha1.Reference `json:"nameRef,omitempty"`
+
+	// NameSelector selects a reference to an InstanceProfile to retrieve its
+	// name.
+	// +optional
+	NameSelector *runtimev1alpha1.Selector `json:"nameSelector,omitempty"`
+}
+
+// InstanceParameters define the desired state of an AWS EC2 Instance.
+type InstanceParameters struct {
+	// Region is the region you'd like your Instance to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// ImageID is the ID of the AMI the instance is launched from.
+	// +immutable
+	ImageID string `json:"imageId"`
+
+	// InstanceType of the instance, e.g. t3.micro.
+	// +immutable
+	InstanceType string `json:"instanceType"`
+
+	// KeyName is the name of the key pair used to log into the instance.
+	// +immutable
+	// +optional
+	KeyName *string `json:"keyName,omitempty"`
+
+	// UserData is the base64-encoded user data made available to the
+	// instance.
+	// +immutable
+	// +optional
+	UserData *string `json:"userData,omitempty"`
+
+	// EBSOptimized indicates whether the instance is optimized for EBS I/O.
+	// +immutable
+	// +optional
+	EBSOptimized *bool `json:"ebsOptimized,omitempty"`
+
+	// BlockDeviceMappings are the block devices attached to the instance
+	// at launch, in addition to the ones of the AMI.
+	// +immutable
+	// +optional
+	BlockDeviceMappings []BlockDeviceMapping `json:"blockDeviceMappings,omitempty"`
+
+	// IAMInstanceProfile is the IAM instance profile of the instance.
+	// +immutable
+	// +optional
+	IAMInstanceProfile *InstanceIAMInstanceProfile `json:"iamInstanceProfile,omitempty"`
+
+	// SubnetID is the ID of the subnet the instance is launched in.
+	// +immutable
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its ID.
+	// +immutable
+	// +optional
+	SubnetIDRef *runtimev1alpha1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet to retrieve its ID.
+	// +immutable
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the instance.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their IDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their IDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// MaintenanceWindowRef references a MaintenanceWindow. Stopping the
+	// instance is held back until the referenced window opens, unless the
+	// aws.crossplane.io/force-update annotation is set to "true".
+	// +optional
+	MaintenanceWindowRef *runtimev1alpha1.Reference `json:"maintenanceWindowRef,omitempty"`
+
+	// State is the desired state of the instance. Setting it to stopped
+	// stops a running instance, and setting it to running starts a stopped
+	// one. Defaults to running.
+	// +kubebuilder:validation:Enum=running;stopped
+	// +optional
+	State *string `json:"state,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// An InstanceSpec defines the desired state of an Instance.
+type InstanceSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  InstanceParameters `json:"forProvider"`
+}
+
+// InstanceObservation keeps the state for the external resource.
+type InstanceObservation struct {
+	// InstanceID is the ID of the instance.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// State is the current state of the instance, e.g. pending, running or
+	// stopped.
+	State string `json:"state,omitempty"`
+
+	// AvailabilityZone the instance runs in.
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// VPCID is the ID of the VPC the instance runs in.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// PrivateDNSName is the private DNS hostname of the instance.
+	PrivateDNSName string `json:"privateDnsName,omitempty"`
+
+	// PrivateIPAddress is the private IPv4 address of the instance.
+	PrivateIPAddress string `json:"privateIpAddress,omitempty"`
+
+	// PublicDNSName is the public DNS hostname of the instance, if any.
+	PublicDNSName string `json:"publicDnsName,omitempty"`
+
+	// PublicIPAddress is the public IPv4 address of the instance, if any.
+	PublicIPAddress string `json:"publicIpAddress,omitempty"`
+}
+
+// An InstanceStatus represents the observed state of an Instance.
+type InstanceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     InstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Instance is a managed resource that represents an AWS EC2 Instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Instance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceSpec   `json:"spec"`
+	Status InstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceList contains a list of Instances
+type InstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Instance `json:"items"`
+}
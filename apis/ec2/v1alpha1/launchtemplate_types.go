@@ -0,0 +1,201 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// LaunchTemplateIAMInstanceProfile identifies the IAM instance profile of
+// the instances launched from a LaunchTemplate, either by ARN or by name.
+type LaunchTemplateIAMInstanceProfile struct {
+	// ARN of the instance profile.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
+
+	// Name of the instance profile.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// NameRef references an InstanceProfile to retrieve its name.
+	// +optional
+	NameRef *runtimev1alpha1.Reference `json:"nameRef,omitempty"`
+
+	// NameSelector selects a reference to an InstanceProfile to retrieve its
+	// name.
+	// +optional
+	NameSelector *runtimev1alpha1.Selector `json:"nameSelector,omitempty"`
+}
+
+// LaunchTemplateMetadataOptions configure the instance metadata service of
+// the instances launched from a LaunchTemplate.
+type LaunchTemplateMetadataOptions struct {
+	// HTTPEndpoint enables or disables the HTTP metadata endpoint of the
+	// instances.
+	// +crossplane:aws:model=ec2.LaunchTemplateInstanceMetadataOptionsRequest.HttpEndpoint
+	// +kubebuilder:validation:Enum=disabled;enabled
+	// +optional
+	HTTPEndpoint *string `json:"httpEndpoint,omitempty"`
+
+	// HTTPTokens is the state of token usage for metadata requests. Set it
+	// to required to enforce IMDSv2, i.e. session tokens on every request.
+	// +crossplane:aws:model=ec2.LaunchTemplateInstanceMetadataOptionsRequest.HttpTokens
+	// +kubebuilder:validation:Enum=optional;required
+	// +optional
+	HTTPTokens *string `json:"httpTokens,omitempty"`
+
+	// HTTPPutResponseHopLimit is the desired HTTP PUT response hop limit
+	// for metadata requests. The larger the number, the further metadata
+	// requests can travel.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	// +optional
+	HTTPPutResponseHopLimit *int64 `json:"httpPutResponseHopLimit,omitempty"`
+}
+
+// LaunchTemplateData is the configuration of the instances launched from a
+// LaunchTemplate.
+type LaunchTemplateData struct {
+	// ImageID is the ID of the AMI the instances are launched from.
+	// +optional
+	ImageID *string `json:"imageId,omitempty"`
+
+	// InstanceType of the instances, e.g. t3.micro.
+	// +optional
+	InstanceType *string `json:"instanceType,omitempty"`
+
+	// KeyName is the name of the key pair used to log into the instances.
+	// +optional
+	KeyName *string `json:"keyName,omitempty"`
+
+	// UserData is the base64-encoded user data made available to the
+	// instances.
+	// +optional
+	UserData *string `json:"userData,omitempty"`
+
+	// EBSOptimized indicates whether the instances are optimized for EBS
+	// I/O.
+	// +optional
+	EBSOptimized *bool `json:"ebsOptimized,omitempty"`
+
+	// IAMInstanceProfile is the IAM instance profile of the instances.
+	// +optional
+	IAMInstanceProfile *LaunchTemplateIAMInstanceProfile `json:"iamInstanceProfile,omitempty"`
+
+	// MetadataOptions configure the instance metadata service of the
+	// instances.
+	// +optional
+	MetadataOptions *LaunchTemplateMetadataOptions `json:"metadataOptions,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the instances.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their IDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their IDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+}
+
+// LaunchTemplateParameters define the desired state of an AWS EC2 Launch
+// Template.
+type LaunchTemplateParameters struct {
+	// Region is the region you'd like your LaunchTemplate to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// LaunchTemplateName is the name of the launch template. It must be
+	// unique per region and account.
+	// +immutable
+	LaunchTemplateName string `json:"launchTemplateName"`
+
+	// VersionDescription is the description of the versions created for
+	// the launch template data.
+	// +optional
+	VersionDescription *string `json:"versionDescription,omitempty"`
+
+	// LaunchTemplateData is the configuration of the instances. Changing it
+	// creates a new version of the launch template and makes it the
+	// default version.
+	LaunchTemplateData LaunchTemplateData `json:"launchTemplateData"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A LaunchTemplateSpec defines the desired state of a LaunchTemplate.
+type LaunchTemplateSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LaunchTemplateParameters `json:"forProvider"`
+}
+
+// LaunchTemplateObservation keeps the state for the external resource.
+type LaunchTemplateObservation struct {
+	// LaunchTemplateID is the ID of the launch template.
+	LaunchTemplateID string `json:"launchTemplateId,omitempty"`
+
+	// DefaultVersionNumber is the number of the default version of the
+	// launch template.
+	DefaultVersionNumber int64 `json:"defaultVersionNumber,omitempty"`
+
+	// LatestVersionNumber is the number of the latest version of the
+	// launch template.
+	LatestVersionNumber int64 `json:"latestVersionNumber,omitempty"`
+}
+
+// A LaunchTemplateStatus represents the observed state of a LaunchTemplate.
+type LaunchTemplateStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LaunchTemplateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LaunchTemplate is a managed resource that represents an AWS EC2 Launch
+// Template.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VERSION",type="integer",JSONPath=".status.atProvider.defaultVersionNumber"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LaunchTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LaunchTemplateSpec   `json:"spec"`
+	Status LaunchTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LaunchTemplateList contains a list of LaunchTemplates
+type LaunchTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LaunchTemplate `json:"items"`
+}
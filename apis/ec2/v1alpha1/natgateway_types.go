@@ -105,6 +105,7 @@ type NATGatewayStatus struct {
 // +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".status.atProvider.vpcId"
 // +kubebuilder:printcolumn:name="SUBNET",type="string",JSONPath=".spec.forProvider.subnetId"
 // +kubebuilder:printcolumn:name="ALLOCATION ID",type="string",JSONPath=".spec.forProvider.allocationId"
+// +kubebuilder:printcolumn:name="PUBLIC IP",type="string",JSONPath=".status.atProvider.natGatewayAddresses[0].publicIp"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
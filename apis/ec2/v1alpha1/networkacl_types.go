@@ -0,0 +1,206 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// PortRange describes a range of ports.
+type PortRange struct {
+	// From is the first port in the range.
+	From int64 `json:"from"`
+
+	// To is the last port in the range.
+	To int64 `json:"to"`
+}
+
+// ICMPTypeCode describes the ICMP type and code.
+type ICMPTypeCode struct {
+	// Type is the ICMP type. A value of -1 means all types.
+	Type int64 `json:"type"`
+
+	// Code is the ICMP code. A value of -1 means all codes for the given
+	// type.
+	Code int64 `json:"code"`
+}
+
+// NetworkACLEntry describes a rule of a network ACL. Rules are evaluated in
+// order of their rule number, starting with the lowest.
+type NetworkACLEntry struct {
+	// RuleNumber of the entry, between 1 and 32766. It must be unique among
+	// the ingress and egress entries respectively.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	RuleNumber int64 `json:"ruleNumber"`
+
+	// Egress indicates whether this is an egress rule, i.e. a rule that is
+	// applied to traffic leaving the subnet.
+	// +optional
+	Egress bool `json:"egress,omitempty"`
+
+	// Protocol number of the traffic the rule applies to, e.g. 6 for TCP,
+	// 17 for UDP or 1 for ICMP. A value of -1 means all protocols.
+	Protocol string `json:"protocol"`
+
+	// RuleAction is whether to allow or deny the traffic that matches the
+	// rule.
+	// +crossplane:aws:model=ec2.CreateNetworkAclEntryInput.RuleAction
+	// +kubebuilder:validation:Enum=allow;deny
+	RuleAction string `json:"ruleAction"`
+
+	// CIDRBlock is the IPv4 network range to allow or deny.
+	// +optional
+	CIDRBlock *string `json:"cidrBlock,omitempty"`
+
+	// IPv6CIDRBlock is the IPv6 network range to allow or deny.
+	// +optional
+	IPv6CIDRBlock *string `json:"ipv6CidrBlock,omitempty"`
+
+	// PortRange is the range of ports the rule applies to. Required for the
+	// TCP and UDP protocols.
+	// +optional
+	PortRange *PortRange `json:"portRange,omitempty"`
+
+	// ICMPTypeCode is the ICMP type and code the rule applies to. Required
+	// for the ICMP protocol.
+	// +optional
+	ICMPTypeCode *ICMPTypeCode `json:"icmpTypeCode,omitempty"`
+}
+
+// NetworkACLAssociation describes an association between a network ACL and
+// a subnet.
+type NetworkACLAssociation struct {
+	// SubnetID is the ID of the subnet.
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDRef *runtimev1alpha1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+}
+
+// NetworkACLParameters define the desired state of an AWS VPC Network ACL.
+type NetworkACLParameters struct {
+	// Region is the region you'd like your NetworkACL to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// VPCID is the ID of the VPC.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its ID.
+	// +immutable
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its ID.
+	// +immutable
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// Entries are the rules of the network ACL. The default rules that deny
+	// all traffic not matched by any other rule cannot be modified and are
+	// not included.
+	// +optional
+	Entries []NetworkACLEntry `json:"entries,omitempty"`
+
+	// Associations are the subnets associated with the network ACL. A
+	// subnet that is removed from the network ACL is associated with the
+	// default network ACL of the VPC again.
+	// +optional
+	Associations []NetworkACLAssociation `json:"associations,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// NetworkACLAssociationState describes an observed association between a
+// network ACL and a subnet.
+type NetworkACLAssociationState struct {
+	// AssociationID is the ID of the association.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// SubnetID is the ID of the subnet.
+	SubnetID string `json:"subnetId,omitempty"`
+}
+
+// NetworkACLObservation keeps the state for the external resource
+type NetworkACLObservation struct {
+	// NetworkACLID is the ID of the network ACL.
+	NetworkACLID string `json:"networkAclId,omitempty"`
+
+	// OwnerID is the ID of the AWS account that owns the network ACL.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// IsDefault indicates whether this is the default network ACL of the
+	// VPC.
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// Associations are the observed associations with subnets.
+	Associations []NetworkACLAssociationState `json:"associations,omitempty"`
+}
+
+// A NetworkACLSpec defines the desired state of a NetworkACL.
+type NetworkACLSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  NetworkACLParameters `json:"forProvider"`
+}
+
+// A NetworkACLStatus represents the observed state of a NetworkACL.
+type NetworkACLStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     NetworkACLObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkACL is a managed resource that represents an AWS VPC Network ACL.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NetworkACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkACLSpec   `json:"spec"`
+	Status NetworkACLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkACLList contains a list of NetworkACLs
+type NetworkACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkACL `json:"items"`
+}
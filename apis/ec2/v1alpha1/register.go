@@ -56,7 +56,142 @@ var (
 	NATGatewayGroupVersionKind = SchemeGroupVersion.WithKind(NATGatewayKind)
 )
 
+// TransitGateway type metadata.
+var (
+	TransitGatewayKind             = reflect.TypeOf(TransitGateway{}).Name()
+	TransitGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayKind}.String()
+	TransitGatewayKindAPIVersion   = TransitGatewayKind + "." + SchemeGroupVersion.String()
+	TransitGatewayGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayKind)
+)
+
+// TransitGatewayVPCAttachment type metadata.
+var (
+	TransitGatewayVPCAttachmentKind             = reflect.TypeOf(TransitGatewayVPCAttachment{}).Name()
+	TransitGatewayVPCAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayVPCAttachmentKind}.String()
+	TransitGatewayVPCAttachmentKindAPIVersion   = TransitGatewayVPCAttachmentKind + "." + SchemeGroupVersion.String()
+	TransitGatewayVPCAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayVPCAttachmentKind)
+)
+
+// TransitGatewayRouteTable type metadata.
+var (
+	TransitGatewayRouteTableKind             = reflect.TypeOf(TransitGatewayRouteTable{}).Name()
+	TransitGatewayRouteTableGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayRouteTableKind}.String()
+	TransitGatewayRouteTableKindAPIVersion   = TransitGatewayRouteTableKind + "." + SchemeGroupVersion.String()
+	TransitGatewayRouteTableGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayRouteTableKind)
+)
+
+// VPCPeeringConnection type metadata.
+var (
+	VPCPeeringConnectionKind             = reflect.TypeOf(VPCPeeringConnection{}).Name()
+	VPCPeeringConnectionGroupKind        = schema.GroupKind{Group: Group, Kind: VPCPeeringConnectionKind}.String()
+	VPCPeeringConnectionKindAPIVersion   = VPCPeeringConnectionKind + "." + SchemeGroupVersion.String()
+	VPCPeeringConnectionGroupVersionKind = SchemeGroupVersion.WithKind(VPCPeeringConnectionKind)
+)
+
+// CustomerGateway type metadata.
+var (
+	CustomerGatewayKind             = reflect.TypeOf(CustomerGateway{}).Name()
+	CustomerGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: CustomerGatewayKind}.String()
+	CustomerGatewayKindAPIVersion   = CustomerGatewayKind + "." + SchemeGroupVersion.String()
+	CustomerGatewayGroupVersionKind = SchemeGroupVersion.WithKind(CustomerGatewayKind)
+)
+
+// VPNGateway type metadata.
+var (
+	VPNGatewayKind             = reflect.TypeOf(VPNGateway{}).Name()
+	VPNGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: VPNGatewayKind}.String()
+	VPNGatewayKindAPIVersion   = VPNGatewayKind + "." + SchemeGroupVersion.String()
+	VPNGatewayGroupVersionKind = SchemeGroupVersion.WithKind(VPNGatewayKind)
+)
+
+// VPNConnection type metadata.
+var (
+	VPNConnectionKind             = reflect.TypeOf(VPNConnection{}).Name()
+	VPNConnectionGroupKind        = schema.GroupKind{Group: Group, Kind: VPNConnectionKind}.String()
+	VPNConnectionKindAPIVersion   = VPNConnectionKind + "." + SchemeGroupVersion.String()
+	VPNConnectionGroupVersionKind = SchemeGroupVersion.WithKind(VPNConnectionKind)
+)
+
+// LaunchTemplate type metadata.
+var (
+	LaunchTemplateKind             = reflect.TypeOf(LaunchTemplate{}).Name()
+	LaunchTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: LaunchTemplateKind}.String()
+	LaunchTemplateKindAPIVersion   = LaunchTemplateKind + "." + SchemeGroupVersion.String()
+	LaunchTemplateGroupVersionKind = SchemeGroupVersion.WithKind(LaunchTemplateKind)
+)
+
+// Instance type metadata.
+var (
+	InstanceKind             = reflect.TypeOf(Instance{}).Name()
+	InstanceGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceKind}.String()
+	InstanceKindAPIVersion   = InstanceKind + "." + SchemeGroupVersion.String()
+	InstanceGroupVersionKind = SchemeGroupVersion.WithKind(InstanceKind)
+)
+
+// Volume type metadata.
+var (
+	VolumeKind             = reflect.TypeOf(Volume{}).Name()
+	VolumeGroupKind        = schema.GroupKind{Group: Group, Kind: VolumeKind}.String()
+	VolumeKindAPIVersion   = VolumeKind + "." + SchemeGroupVersion.String()
+	VolumeGroupVersionKind = SchemeGroupVersion.WithKind(VolumeKind)
+)
+
+// Snapshot type metadata.
+var (
+	SnapshotKind             = reflect.TypeOf(Snapshot{}).Name()
+	SnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: SnapshotKind}.String()
+	SnapshotKindAPIVersion   = SnapshotKind + "." + SchemeGroupVersion.String()
+	SnapshotGroupVersionKind = SchemeGroupVersion.WithKind(SnapshotKind)
+)
+
+// FlowLog type metadata.
+var (
+	FlowLogKind             = reflect.TypeOf(FlowLog{}).Name()
+	FlowLogGroupKind        = schema.GroupKind{Group: Group, Kind: FlowLogKind}.String()
+	FlowLogKindAPIVersion   = FlowLogKind + "." + SchemeGroupVersion.String()
+	FlowLogGroupVersionKind = SchemeGroupVersion.WithKind(FlowLogKind)
+)
+
+// NetworkACL type metadata.
+var (
+	NetworkACLKind             = reflect.TypeOf(NetworkACL{}).Name()
+	NetworkACLGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkACLKind}.String()
+	NetworkACLKindAPIVersion   = NetworkACLKind + "." + SchemeGroupVersion.String()
+	NetworkACLGroupVersionKind = SchemeGroupVersion.WithKind(NetworkACLKind)
+)
+
+// DHCPOptions type metadata.
+var (
+	DHCPOptionsKind             = reflect.TypeOf(DHCPOptions{}).Name()
+	DHCPOptionsGroupKind        = schema.GroupKind{Group: Group, Kind: DHCPOptionsKind}.String()
+	DHCPOptionsKindAPIVersion   = DHCPOptionsKind + "." + SchemeGroupVersion.String()
+	DHCPOptionsGroupVersionKind = SchemeGroupVersion.WithKind(DHCPOptionsKind)
+)
+
+// EgressOnlyInternetGateway type metadata.
+var (
+	EgressOnlyInternetGatewayKind             = reflect.TypeOf(EgressOnlyInternetGateway{}).Name()
+	EgressOnlyInternetGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: EgressOnlyInternetGatewayKind}.String()
+	EgressOnlyInternetGatewayKindAPIVersion   = EgressOnlyInternetGatewayKind + "." + SchemeGroupVersion.String()
+	EgressOnlyInternetGatewayGroupVersionKind = SchemeGroupVersion.WithKind(EgressOnlyInternetGatewayKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&ElasticIP{}, &ElasticIPList{})
 	SchemeBuilder.Register(&NATGateway{}, &NATGatewayList{})
+	SchemeBuilder.Register(&TransitGateway{}, &TransitGatewayList{})
+	SchemeBuilder.Register(&TransitGatewayVPCAttachment{}, &TransitGatewayVPCAttachmentList{})
+	SchemeBuilder.Register(&TransitGatewayRouteTable{}, &TransitGatewayRouteTableList{})
+	SchemeBuilder.Register(&VPCPeeringConnection{}, &VPCPeeringConnectionList{})
+	SchemeBuilder.Register(&CustomerGateway{}, &CustomerGatewayList{})
+	SchemeBuilder.Register(&VPNGateway{}, &VPNGatewayList{})
+	SchemeBuilder.Register(&VPNConnection{}, &VPNConnectionList{})
+	SchemeBuilder.Register(&LaunchTemplate{}, &LaunchTemplateList{})
+	SchemeBuilder.Register(&Instance{}, &InstanceList{})
+	SchemeBuilder.Register(&Volume{}, &VolumeList{})
+	SchemeBuilder.Register(&Snapshot{}, &SnapshotList{})
+	SchemeBuilder.Register(&FlowLog{}, &FlowLogList{})
+	SchemeBuilder.Register(&NetworkACL{}, &NetworkACLList{})
+	SchemeBuilder.Register(&DHCPOptions{}, &DHCPOptionsList{})
+	SchemeBuilder.Register(&EgressOnlyInternetGateway{}, &EgressOnlyInternetGatewayList{})
 }
@@ -0,0 +1,114 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// SnapshotParameters define the desired state of an AWS EBS Snapshot.
+type SnapshotParameters struct {
+	// Region is the region you'd like your Snapshot to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// VolumeID is the ID of the EBS volume to take a snapshot of.
+	// +immutable
+	// +optional
+	VolumeID *string `json:"volumeId,omitempty"`
+
+	// VolumeIDRef references a Volume to retrieve its ID.
+	// +immutable
+	// +optional
+	VolumeIDRef *runtimev1alpha1.Reference `json:"volumeIdRef,omitempty"`
+
+	// VolumeIDSelector selects a reference to a Volume to retrieve its ID.
+	// +immutable
+	// +optional
+	VolumeIDSelector *runtimev1alpha1.Selector `json:"volumeIdSelector,omitempty"`
+
+	// Description of the snapshot.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A SnapshotSpec defines the desired state of a Snapshot.
+type SnapshotSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SnapshotParameters `json:"forProvider"`
+}
+
+// SnapshotObservation keeps the state for the external resource.
+type SnapshotObservation struct {
+	// SnapshotID is the ID of the snapshot.
+	SnapshotID string `json:"snapshotId,omitempty"`
+
+	// State of the snapshot, i.e. pending, completed or error.
+	State string `json:"state,omitempty"`
+
+	// Progress of the snapshot, as a percentage.
+	Progress string `json:"progress,omitempty"`
+
+	// VolumeSize is the size of the volume the snapshot was taken of, in
+	// GiBs.
+	VolumeSize int64 `json:"volumeSize,omitempty"`
+
+	// Encrypted indicates whether the snapshot is encrypted.
+	Encrypted bool `json:"encrypted,omitempty"`
+}
+
+// A SnapshotStatus represents the observed state of a Snapshot.
+type SnapshotStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     SnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Snapshot is a managed resource that represents an AWS EBS Snapshot.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Snapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotSpec   `json:"spec"`
+	Status SnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnapshotList contains a list of Snapshots
+type SnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Snapshot `json:"items"`
+}
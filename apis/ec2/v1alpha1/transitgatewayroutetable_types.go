@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Known Transit Gateway route table states.
+const (
+	TransitGatewayRouteTableStatePending   = "pending"
+	TransitGatewayRouteTableStateAvailable = "available"
+	TransitGatewayRouteTableStateDeleting  = "deleting"
+	TransitGatewayRouteTableStateDeleted   = "deleted"
+)
+
+// TransitGatewayRouteTableParameters define the desired state of an AWS
+// Transit Gateway route table.
+type TransitGatewayRouteTableParameters struct {
+	// Region is the region you'd like your TransitGatewayRouteTable to be
+	// created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// TransitGatewayID is the ID of the transit gateway.
+	// +immutable
+	// +optional
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// TransitGatewayIDRef references a TransitGateway to retrieve its ID.
+	// +immutable
+	// +optional
+	TransitGatewayIDRef *runtimev1alpha1.Reference `json:"transitGatewayIdRef,omitempty"`
+
+	// TransitGatewayIDSelector selects a reference to a TransitGateway to
+	// retrieve its ID.
+	// +immutable
+	// +optional
+	TransitGatewayIDSelector *runtimev1alpha1.Selector `json:"transitGatewayIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// TransitGatewayRouteTableObservation keeps the state for the external
+// resource
+type TransitGatewayRouteTableObservation struct {
+	// State of the route table.
+	State string `json:"state,omitempty"`
+
+	// DefaultAssociationRouteTable indicates whether this is the default
+	// association route table of the transit gateway.
+	DefaultAssociationRouteTable bool `json:"defaultAssociationRouteTable,omitempty"`
+
+	// DefaultPropagationRouteTable indicates whether this is the default
+	// propagation route table of the transit gateway.
+	DefaultPropagationRouteTable bool `json:"defaultPropagationRouteTable,omitempty"`
+}
+
+// A TransitGatewayRouteTableSpec defines the desired state of a
+// TransitGatewayRouteTable.
+type TransitGatewayRouteTableSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TransitGatewayRouteTableParameters `json:"forProvider"`
+}
+
+// A TransitGatewayRouteTableStatus represents the observed state of a
+// TransitGatewayRouteTable.
+type TransitGatewayRouteTableStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TransitGatewayRouteTableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayRouteTable is a managed resource that represents an AWS
+// Transit Gateway route table.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TRANSIT GATEWAY",type="string",JSONPath=".spec.forProvider.transitGatewayId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayRouteTable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayRouteTableSpec   `json:"spec"`
+	Status TransitGatewayRouteTableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayRouteTableList contains a list of TransitGatewayRouteTables
+type TransitGatewayRouteTableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayRouteTable `json:"items"`
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Known Transit Gateway attachment states.
+const (
+	TransitGatewayAttachmentStateInitiating        = "initiating"
+	TransitGatewayAttachmentStatePendingAcceptance = "pendingAcceptance"
+	TransitGatewayAttachmentStatePending           = "pending"
+	TransitGatewayAttachmentStateAvailable         = "available"
+	TransitGatewayAttachmentStateModifying         = "modifying"
+	TransitGatewayAttachmentStateDeleting          = "deleting"
+	TransitGatewayAttachmentStateDeleted           = "deleted"
+	TransitGatewayAttachmentStateFailed            = "failed"
+	TransitGatewayAttachmentStateRejected          = "rejected"
+)
+
+// TransitGatewayVPCAttachmentParameters define the desired state of an AWS
+// Transit Gateway VPC attachment.
+type TransitGatewayVPCAttachmentParameters struct {
+	// Region is the region you'd like your TransitGatewayVPCAttachment to be
+	// created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// TransitGatewayID is the ID of the transit gateway.
+	// +immutable
+	// +optional
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// TransitGatewayIDRef references a TransitGateway to retrieve its ID.
+	// +immutable
+	// +optional
+	TransitGatewayIDRef *runtimev1alpha1.Reference `json:"transitGatewayIdRef,omitempty"`
+
+	// TransitGatewayIDSelector selects a reference to a TransitGateway to
+	// retrieve its ID.
+	// +immutable
+	// +optional
+	TransitGatewayIDSelector *runtimev1alpha1.Selector `json:"transitGatewayIdSelector,omitempty"`
+
+	// VPCID is the ID of the VPC.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its ID.
+	// +immutable
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its ID.
+	// +immutable
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets of the VPC, one per Availability
+	// Zone, in which the transit gateway places a network interface.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a set of references that each retrieve the subnetID
+	// from the referenced Subnet.
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects a set of references that each retrieve the
+	// subnetID from the referenced Subnet.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// DNSSupport enables or disables DNS support.
+	// +crossplane:aws:model=ec2.CreateTransitGatewayVpcAttachmentRequestOptions.DnsSupport
+	// +kubebuilder:validation:Enum=enable;disable
+	// +optional
+	DNSSupport *string `json:"dnsSupport,omitempty"`
+
+	// IPv6Support enables or disables IPv6 support.
+	// +crossplane:aws:model=ec2.CreateTransitGatewayVpcAttachmentRequestOptions.Ipv6Support
+	// +kubebuilder:validation:Enum=enable;disable
+	// +optional
+	IPv6Support *string `json:"ipv6Support,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// TransitGatewayVPCAttachmentObservation keeps the state for the external
+// resource
+type TransitGatewayVPCAttachmentObservation struct {
+	// State of the attachment.
+	State string `json:"state,omitempty"`
+
+	// VPCOwnerID is the ID of the AWS account that owns the VPC.
+	VPCOwnerID string `json:"vpcOwnerId,omitempty"`
+}
+
+// A TransitGatewayVPCAttachmentSpec defines the desired state of a
+// TransitGatewayVPCAttachment.
+type TransitGatewayVPCAttachmentSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TransitGatewayVPCAttachmentParameters `json:"forProvider"`
+}
+
+// A TransitGatewayVPCAttachmentStatus represents the observed state of a
+// TransitGatewayVPCAttachment.
+type TransitGatewayVPCAttachmentStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TransitGatewayVPCAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayVPCAttachment is a managed resource that represents an AWS
+// Transit Gateway VPC attachment.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TRANSIT GATEWAY",type="string",JSONPath=".spec.forProvider.transitGatewayId"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayVPCAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayVPCAttachmentSpec   `json:"spec"`
+	Status TransitGatewayVPCAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayVPCAttachmentList contains a list of
+// TransitGatewayVPCAttachments
+type TransitGatewayVPCAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayVPCAttachment `json:"items"`
+}
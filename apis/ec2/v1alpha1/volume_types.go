@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// VolumeParameters define the desired state of an AWS EBS Volume.
+type VolumeParameters struct {
+	// Region is the region you'd like your Volume to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// AvailabilityZone in which to create the volume.
+	// +immutable
+	AvailabilityZone string `json:"availabilityZone"`
+
+	// Size of the volume, in GiBs. It is required unless the volume is
+	// created from a snapshot. A volume can only grow, and it can be
+	// modified at most once every six hours.
+	// +optional
+	Size *int64 `json:"size,omitempty"`
+
+	// VolumeType of the volume.
+	// +kubebuilder:validation:Enum=standard;io1;io2;gp2;gp3;sc1;st1
+	// +optional
+	VolumeType *string `json:"volumeType,omitempty"`
+
+	// IOPS is the number of I/O operations per second that the volume
+	// supports. Required for io1 and io2 volumes.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+
+	// Encrypted indicates whether the volume is encrypted.
+	// +immutable
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// KMSKeyID is the identifier of the customer managed KMS key used to
+	// encrypt the volume.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// MultiAttachEnabled allows the volume to be attached to up to 16
+	// Nitro-based instances in the same Availability Zone. Only io1 and io2
+	// volumes support it.
+	// +immutable
+	// +optional
+	MultiAttachEnabled *bool `json:"multiAttachEnabled,omitempty"`
+
+	// SnapshotID is the ID of the snapshot the volume is created from.
+	// +immutable
+	// +optional
+	SnapshotID *string `json:"snapshotId,omitempty"`
+
+	// SnapshotIDRef references a Snapshot to retrieve its ID.
+	// +immutable
+	// +optional
+	SnapshotIDRef *runtimev1alpha1.Reference `json:"snapshotIdRef,omitempty"`
+
+	// SnapshotIDSelector selects a reference to a Snapshot to retrieve its
+	// ID.
+	// +immutable
+	// +optional
+	SnapshotIDSelector *runtimev1alpha1.Selector `json:"snapshotIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A VolumeSpec defines the desired state of a Volume.
+type VolumeSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VolumeParameters `json:"forProvider"`
+}
+
+// VolumeObservation keeps the state for the external resource.
+type VolumeObservation struct {
+	// VolumeID is the ID of the volume.
+	VolumeID string `json:"volumeId,omitempty"`
+
+	// State of the volume, e.g. available or in-use.
+	State string `json:"state,omitempty"`
+
+	// ModificationState is the state of the latest modification of the
+	// volume, if any.
+	ModificationState string `json:"modificationState,omitempty"`
+
+	// AttachedInstanceIDs are the IDs of the instances the volume is
+	// attached to.
+	AttachedInstanceIDs []string `json:"attachedInstanceIds,omitempty"`
+}
+
+// A VolumeStatus represents the observed state of a Volume.
+type VolumeStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VolumeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Volume is a managed resource that represents an AWS EBS Volume.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Volume struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeSpec   `json:"spec"`
+	Status VolumeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VolumeList contains a list of Volumes
+type VolumeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Volume `json:"items"`
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Known VPC peering connection states.
+const (
+	VPCPeeringConnectionStateInitiatingRequest = "initiating-request"
+	VPCPeeringConnectionStatePendingAcceptance = "pending-acceptance"
+	VPCPeeringConnectionStateActive            = "active"
+	VPCPeeringConnectionStateDeleted           = "deleted"
+	VPCPeeringConnectionStateRejected          = "rejected"
+	VPCPeeringConnectionStateFailed            = "failed"
+	VPCPeeringConnectionStateExpired           = "expired"
+	VPCPeeringConnectionStateProvisioning      = "provisioning"
+	VPCPeeringConnectionStateDeleting          = "deleting"
+)
+
+// VPCPeeringConnectionOptions are the options of one side of a VPC peering
+// connection.
+type VPCPeeringConnectionOptions struct {
+	// AllowDNSResolutionFromRemoteVPC enables the resolution of public DNS
+	// hostnames of this side of the connection to private IP addresses when
+	// queried from instances in the peer VPC.
+	// +optional
+	AllowDNSResolutionFromRemoteVPC *bool `json:"allowDnsResolutionFromRemoteVpc,omitempty"`
+}
+
+// VPCPeeringConnectionParameters define the desired state of an AWS VPC
+// peering connection.
+type VPCPeeringConnectionParameters struct {
+	// Region is the region of the requester VPC.
+	// +immutable
+	Region string `json:"region"`
+
+	// VPCID is the ID of the requester VPC.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its ID.
+	// +immutable
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its ID.
+	// +immutable
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// PeerVPCID is the ID of the accepter VPC.
+	// +immutable
+	// +optional
+	PeerVPCID *string `json:"peerVpcId,omitempty"`
+
+	// PeerVPCIDRef references a VPC to retrieve its ID as the accepter VPC.
+	// +immutable
+	// +optional
+	PeerVPCIDRef *runtimev1alpha1.Reference `json:"peerVpcIdRef,omitempty"`
+
+	// PeerVPCIDSelector selects a reference to a VPC to retrieve its ID as
+	// the accepter VPC.
+	// +immutable
+	// +optional
+	PeerVPCIDSelector *runtimev1alpha1.Selector `json:"peerVpcIdSelector,omitempty"`
+
+	// PeerOwnerID is the ID of the AWS account that owns the accepter VPC.
+	// Defaults to the account of the requester.
+	// +immutable
+	// +optional
+	PeerOwnerID *string `json:"peerOwnerId,omitempty"`
+
+	// PeerRegion is the region of the accepter VPC. Defaults to the region of
+	// the requester.
+	// +immutable
+	// +optional
+	PeerRegion *string `json:"peerRegion,omitempty"`
+
+	// AccepterProviderConfigRef references the ProviderConfig whose
+	// credentials are used to accept the peering connection and to manage
+	// the options of the accepter VPC. It is required to accept peering
+	// connections to VPCs of other accounts, which otherwise have to be
+	// accepted by the owner of the accepter VPC. Defaults to the
+	// ProviderConfig of the resource.
+	// +optional
+	AccepterProviderConfigRef *runtimev1alpha1.Reference `json:"accepterProviderConfigRef,omitempty"`
+
+	// RequesterPeeringOptions are the options of the requester VPC.
+	// +optional
+	RequesterPeeringOptions *VPCPeeringConnectionOptions `json:"requesterPeeringOptions,omitempty"`
+
+	// AccepterPeeringOptions are the options of the accepter VPC.
+	// +optional
+	AccepterPeeringOptions *VPCPeeringConnectionOptions `json:"accepterPeeringOptions,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// VPCPeeringConnectionVPCInfo describes a VPC of a VPC peering connection.
+type VPCPeeringConnectionVPCInfo struct {
+	// CIDRBlock is the IPv4 CIDR block of the VPC.
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
+	// OwnerID is the ID of the AWS account that owns the VPC.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// Region is the region of the VPC.
+	Region string `json:"region,omitempty"`
+
+	// VPCID is the ID of the VPC.
+	VPCID string `json:"vpcId,omitempty"`
+}
+
+// VPCPeeringConnectionObservation keeps the state for the external resource
+type VPCPeeringConnectionObservation struct {
+	// State of the peering connection.
+	State string `json:"state,omitempty"`
+
+	// StateMessage is the message of the state of the peering connection.
+	StateMessage string `json:"stateMessage,omitempty"`
+
+	// ExpirationTime is the time at which an unaccepted peering connection
+	// expires.
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+
+	// AccepterVPCInfo describes the accepter VPC.
+	AccepterVPCInfo VPCPeeringConnectionVPCInfo `json:"accepterVpcInfo,omitempty"`
+
+	// RequesterVPCInfo describes the requester VPC.
+	RequesterVPCInfo VPCPeeringConnectionVPCInfo `json:"requesterVpcInfo,omitempty"`
+}
+
+// A VPCPeeringConnectionSpec defines the desired state of a
+// VPCPeeringConnection.
+type VPCPeeringConnectionSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VPCPeeringConnectionParameters `json:"forProvider"`
+}
+
+// A VPCPeeringConnectionStatus represents the observed state of a
+// VPCPeeringConnection.
+type VPCPeeringConnectionStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VPCPeeringConnectionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCPeeringConnection is a managed resource that represents an AWS VPC
+// peering connection.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCPeeringConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCPeeringConnectionSpec   `json:"spec"`
+	Status VPCPeeringConnectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCPeeringConnectionList contains a list of VPCPeeringConnections
+type VPCPeeringConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCPeeringConnection `json:"items"`
+}
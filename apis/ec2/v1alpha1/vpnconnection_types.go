@@ -0,0 +1,296 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Known VPN Connection states.
+const (
+	VPNConnectionStatePending   = "pending"
+	VPNConnectionStateAvailable = "available"
+	VPNConnectionStateDeleting  = "deleting"
+	VPNConnectionStateDeleted   = "deleted"
+)
+
+// VPNTunnelOptions are the options of a single tunnel of a VPN connection.
+type VPNTunnelOptions struct {
+	// TunnelInsideCIDR is the range of inside IP addresses for the tunnel.
+	// It must be a /30 CIDR block from the 169.254.0.0/16 range.
+	// +optional
+	TunnelInsideCIDR *string `json:"tunnelInsideCidr,omitempty"`
+
+	// PreSharedKeySecretRef references the secret that contains the
+	// pre-shared key used to establish the initial authentication between
+	// the virtual private gateway and the customer gateway. If no reference
+	// is given, a pre-shared key is generated by AWS. The key in use is
+	// published only to the connection secret of the VPNConnection.
+	// +optional
+	PreSharedKeySecretRef *runtimev1alpha1.SecretKeySelector `json:"preSharedKeySecretRef,omitempty"`
+
+	// DPDTimeoutSeconds is the number of seconds after which a dead peer
+	// detection timeout occurs.
+	// +optional
+	DPDTimeoutSeconds *int64 `json:"dpdTimeoutSeconds,omitempty"`
+
+	// IKEVersions that are permitted for the tunnel.
+	// +optional
+	IKEVersions []string `json:"ikeVersions,omitempty"`
+
+	// Phase1DHGroupNumbers are the Diffie-Hellman group numbers that are
+	// permitted for phase 1 IKE negotiations.
+	// +optional
+	Phase1DHGroupNumbers []int64 `json:"phase1DHGroupNumbers,omitempty"`
+
+	// Phase1EncryptionAlgorithms that are permitted for phase 1 IKE
+	// negotiations.
+	// +optional
+	Phase1EncryptionAlgorithms []string `json:"phase1EncryptionAlgorithms,omitempty"`
+
+	// Phase1IntegrityAlgorithms that are permitted for phase 1 IKE
+	// negotiations.
+	// +optional
+	Phase1IntegrityAlgorithms []string `json:"phase1IntegrityAlgorithms,omitempty"`
+
+	// Phase1LifetimeSeconds is the lifetime for phase 1 of the IKE
+	// negotiation, in seconds.
+	// +optional
+	Phase1LifetimeSeconds *int64 `json:"phase1LifetimeSeconds,omitempty"`
+
+	// Phase2DHGroupNumbers are the Diffie-Hellman group numbers that are
+	// permitted for phase 2 IKE negotiations.
+	// +optional
+	Phase2DHGroupNumbers []int64 `json:"phase2DHGroupNumbers,omitempty"`
+
+	// Phase2EncryptionAlgorithms that are permitted for phase 2 IKE
+	// negotiations.
+	// +optional
+	Phase2EncryptionAlgorithms []string `json:"phase2EncryptionAlgorithms,omitempty"`
+
+	// Phase2IntegrityAlgorithms that are permitted for phase 2 IKE
+	// negotiations.
+	// +optional
+	Phase2IntegrityAlgorithms []string `json:"phase2IntegrityAlgorithms,omitempty"`
+
+	// Phase2LifetimeSeconds is the lifetime for phase 2 of the IKE
+	// negotiation, in seconds.
+	// +optional
+	Phase2LifetimeSeconds *int64 `json:"phase2LifetimeSeconds,omitempty"`
+
+	// RekeyFuzzPercentage is the percentage of the rekey window during which
+	// the rekey time is randomly selected.
+	// +optional
+	RekeyFuzzPercentage *int64 `json:"rekeyFuzzPercentage,omitempty"`
+
+	// RekeyMarginTimeSeconds is the margin time, in seconds, before the
+	// phase 2 lifetime expires, during which a rekey is performed.
+	// +optional
+	RekeyMarginTimeSeconds *int64 `json:"rekeyMarginTimeSeconds,omitempty"`
+
+	// ReplayWindowSize is the number of packets in an IKE replay window.
+	// +optional
+	ReplayWindowSize *int64 `json:"replayWindowSize,omitempty"`
+}
+
+// VPNConnectionParameters define the desired state of an AWS Site-to-Site
+// VPN Connection.
+type VPNConnectionParameters struct {
+	// Region is the region you'd like your VPNConnection to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Type of VPN connection.
+	// +crossplane:aws:model=ec2.CreateCustomerGatewayInput.Type
+	// +kubebuilder:validation:Enum=ipsec.1
+	// +immutable
+	Type string `json:"type"`
+
+	// CustomerGatewayID is the ID of the customer gateway.
+	// +immutable
+	// +optional
+	CustomerGatewayID *string `json:"customerGatewayId,omitempty"`
+
+	// CustomerGatewayIDRef references a CustomerGateway to retrieve its
+	// customerGatewayId
+	// +immutable
+	// +optional
+	CustomerGatewayIDRef *runtimev1alpha1.Reference `json:"customerGatewayIdRef,omitempty"`
+
+	// CustomerGatewayIDSelector selects a reference to a CustomerGateway to
+	// retrieve its customerGatewayId
+	// +optional
+	CustomerGatewayIDSelector *runtimev1alpha1.Selector `json:"customerGatewayIdSelector,omitempty"`
+
+	// VPNGatewayID is the ID of the virtual private gateway. Either a
+	// virtual private gateway or a transit gateway has to be given.
+	// +immutable
+	// +optional
+	VPNGatewayID *string `json:"vpnGatewayId,omitempty"`
+
+	// VPNGatewayIDRef references a VPNGateway to retrieve its vpnGatewayId
+	// +immutable
+	// +optional
+	VPNGatewayIDRef *runtimev1alpha1.Reference `json:"vpnGatewayIdRef,omitempty"`
+
+	// VPNGatewayIDSelector selects a reference to a VPNGateway to retrieve
+	// its vpnGatewayId
+	// +optional
+	VPNGatewayIDSelector *runtimev1alpha1.Selector `json:"vpnGatewayIdSelector,omitempty"`
+
+	// TransitGatewayID is the ID of the transit gateway. Either a virtual
+	// private gateway or a transit gateway has to be given.
+	// +immutable
+	// +optional
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// TransitGatewayIDRef references a TransitGateway to retrieve its
+	// transitGatewayId
+	// +immutable
+	// +optional
+	TransitGatewayIDRef *runtimev1alpha1.Reference `json:"transitGatewayIdRef,omitempty"`
+
+	// TransitGatewayIDSelector selects a reference to a TransitGateway to
+	// retrieve its transitGatewayId
+	// +optional
+	TransitGatewayIDSelector *runtimev1alpha1.Selector `json:"transitGatewayIdSelector,omitempty"`
+
+	// StaticRoutesOnly indicates whether the VPN connection uses static
+	// routes only. Static routes must be used for devices that don't support
+	// BGP.
+	// +immutable
+	// +optional
+	StaticRoutesOnly *bool `json:"staticRoutesOnly,omitempty"`
+
+	// EnableAcceleration indicates whether to enable acceleration for the
+	// VPN connection. Only supported for connections to a transit gateway.
+	// +immutable
+	// +optional
+	EnableAcceleration *bool `json:"enableAcceleration,omitempty"`
+
+	// TunnelOptions for the two tunnels of the VPN connection.
+	// +kubebuilder:validation:MaxItems=2
+	// +immutable
+	// +optional
+	TunnelOptions []VPNTunnelOptions `json:"tunnelOptions,omitempty"`
+
+	// StaticRoutes are the CIDR blocks associated with the local subnets of
+	// the customer network that are routed through the VPN connection. They
+	// can only be used when StaticRoutesOnly is set and the connection is
+	// terminated by a virtual private gateway.
+	// +optional
+	StaticRoutes []string `json:"staticRoutes,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// VPNStaticRoute describes a static route of a VPN connection.
+type VPNStaticRoute struct {
+	// DestinationCIDRBlock of the route.
+	DestinationCIDRBlock string `json:"destinationCidrBlock"`
+
+	// Source indicates how the route was provided.
+	Source string `json:"source,omitempty"`
+
+	// State of the route.
+	State string `json:"state,omitempty"`
+}
+
+// VPNTunnelTelemetry describes the status of a tunnel of a VPN connection.
+type VPNTunnelTelemetry struct {
+	// OutsideIPAddress is the Internet-routable IP address of the virtual
+	// private gateway's outside interface.
+	OutsideIPAddress string `json:"outsideIpAddress,omitempty"`
+
+	// Status of the tunnel.
+	Status string `json:"status,omitempty"`
+
+	// StatusMessage is the reason for the current status of the tunnel.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// AcceptedRouteCount is the number of accepted routes.
+	AcceptedRouteCount int64 `json:"acceptedRouteCount,omitempty"`
+
+	// LastStatusChange is the date and time of the last change in status.
+	LastStatusChange *metav1.Time `json:"lastStatusChange,omitempty"`
+}
+
+// VPNConnectionObservation keeps the state for the external resource
+type VPNConnectionObservation struct {
+	// VPNConnectionID is the ID of the VPN connection.
+	VPNConnectionID string `json:"vpnConnectionId,omitempty"`
+
+	// Category of the VPN connection.
+	Category string `json:"category,omitempty"`
+
+	// State of the VPN connection.
+	State string `json:"state,omitempty"`
+
+	// Routes are the static routes associated with the VPN connection.
+	Routes []VPNStaticRoute `json:"routes,omitempty"`
+
+	// Telemetry of the tunnels of the VPN connection.
+	Telemetry []VPNTunnelTelemetry `json:"telemetry,omitempty"`
+}
+
+// A VPNConnectionSpec defines the desired state of a VPNConnection.
+type VPNConnectionSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VPNConnectionParameters `json:"forProvider"`
+}
+
+// A VPNConnectionStatus represents the observed state of a VPNConnection.
+type VPNConnectionStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VPNConnectionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPNConnection is a managed resource that represents an AWS Site-to-Site
+// VPN Connection. The customer gateway configuration and the pre-shared keys
+// of its tunnels are published to the connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="CUSTOMER GATEWAY",type="string",JSONPath=".spec.forProvider.customerGatewayId"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPNConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPNConnectionSpec   `json:"spec"`
+	Status VPNConnectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPNConnectionList contains a list of VPNConnections
+type VPNConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPNConnection `json:"items"`
+}
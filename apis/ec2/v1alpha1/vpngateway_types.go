@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Known VPN Gateway states.
+const (
+	VPNGatewayStatePending   = "pending"
+	VPNGatewayStateAvailable = "available"
+	VPNGatewayStateDeleting  = "deleting"
+	VPNGatewayStateDeleted   = "deleted"
+)
+
+// Known VPC attachment states of a VPN Gateway.
+const (
+	VPNGatewayAttachmentStateAttaching = "attaching"
+	VPNGatewayAttachmentStateAttached  = "attached"
+	VPNGatewayAttachmentStateDetaching = "detaching"
+	VPNGatewayAttachmentStateDetached  = "detached"
+)
+
+// VPNGatewayParameters define the desired state of an AWS Virtual Private
+// Gateway.
+type VPNGatewayParameters struct {
+	// Region is the region you'd like your VPNGateway to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Type of VPN connection that the virtual private gateway supports.
+	// +crossplane:aws:model=ec2.CreateCustomerGatewayInput.Type
+	// +kubebuilder:validation:Enum=ipsec.1
+	// +immutable
+	Type string `json:"type"`
+
+	// AmazonSideASN is the private Autonomous System Number (ASN) for the
+	// Amazon side of a BGP session. If you don't specify an ASN, the
+	// virtual private gateway is created with the default ASN.
+	// +immutable
+	// +optional
+	AmazonSideASN *int64 `json:"amazonSideAsn,omitempty"`
+
+	// AvailabilityZone in which to create the virtual private gateway.
+	// +immutable
+	// +optional
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+
+	// VPCID is the ID of the VPC the virtual private gateway is attached to.
+	// The gateway is detached when this field is removed.
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// VPNGatewayAttachment describes the attachment of a virtual private
+// gateway to a VPC.
+type VPNGatewayAttachment struct {
+	// VPCID is the ID of the attached VPC.
+	VPCID string `json:"vpcId"`
+
+	// State of the attachment.
+	State string `json:"state,omitempty"`
+}
+
+// VPNGatewayObservation keeps the state for the external resource
+type VPNGatewayObservation struct {
+	// VPNGatewayID is the ID of the virtual private gateway.
+	VPNGatewayID string `json:"vpnGatewayId,omitempty"`
+
+	// State of the virtual private gateway.
+	State string `json:"state,omitempty"`
+
+	// VPCAttachments of the virtual private gateway.
+	VPCAttachments []VPNGatewayAttachment `json:"vpcAttachments,omitempty"`
+}
+
+// A VPNGatewaySpec defines the desired state of a VPNGateway.
+type VPNGatewaySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VPNGatewayParameters `json:"forProvider"`
+}
+
+// A VPNGatewayStatus represents the observed state of a VPNGateway.
+type VPNGatewayStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VPNGatewayObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPNGateway is a managed resource that represents an AWS Virtual Private
+// Gateway, the Amazon side of a Site-to-Site VPN connection.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPNGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPNGatewaySpec   `json:"spec"`
+	Status VPNGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPNGatewayList contains a list of VPNGateways
+type VPNGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPNGateway `json:"items"`
+}
@@ -48,6 +48,24 @@ var (
 	RouteTableGroupVersionKind = SchemeGroupVersion.WithKind(RouteTableKind)
 )
 
+// VPCEndpoint type metadata.
+var (
+	VPCEndpointKind             = reflect.TypeOf(VPCEndpoint{}).Name()
+	VPCEndpointGroupKind        = schema.GroupKind{Group: Group, Kind: VPCEndpointKind}.String()
+	VPCEndpointKindAPIVersion   = VPCEndpointKind + "." + SchemeGroupVersion.String()
+	VPCEndpointGroupVersionKind = SchemeGroupVersion.WithKind(VPCEndpointKind)
+)
+
+// VPCEndpointServiceConfiguration type metadata.
+var (
+	VPCEndpointServiceConfigurationKind             = reflect.TypeOf(VPCEndpointServiceConfiguration{}).Name()
+	VPCEndpointServiceConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: VPCEndpointServiceConfigurationKind}.String()
+	VPCEndpointServiceConfigurationKindAPIVersion   = VPCEndpointServiceConfigurationKind + "." + SchemeGroupVersion.String()
+	VPCEndpointServiceConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(VPCEndpointServiceConfigurationKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&RouteTable{}, &RouteTableList{})
+	SchemeBuilder.Register(&VPCEndpoint{}, &VPCEndpointList{})
+	SchemeBuilder.Register(&VPCEndpointServiceConfiguration{}, &VPCEndpointServiceConfigurationList{})
 }
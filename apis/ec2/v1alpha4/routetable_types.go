@@ -41,6 +41,18 @@ type Route struct {
 
 	// A selector to select a referencer to retrieve the ID of a gateway
 	GatewayIDSelector *runtimev1alpha1.Selector `json:"gatewayIdSelector,omitempty"`
+
+	// The ID of a NAT gateway in your VPC.
+	// +optional
+	NatGatewayID *string `json:"natGatewayId,omitempty"`
+
+	// A referencer to retrieve the ID of a NAT gateway
+	// +optional
+	NatGatewayIDRef *runtimev1alpha1.Reference `json:"natGatewayIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a NAT gateway
+	// +optional
+	NatGatewayIDSelector *runtimev1alpha1.Selector `json:"natGatewayIdSelector,omitempty"`
 }
 
 // RouteState describes a route state in the route table.
@@ -57,6 +69,9 @@ type RouteState struct {
 	// The ID of an internet gateway or virtual private gateway attached to your
 	// VPC.
 	GatewayID string `json:"gatewayId,omitempty"`
+
+	// The ID of a NAT gateway.
+	NatGatewayID string `json:"natGatewayId,omitempty"`
 }
 
 // Association describes an association between a route table and a subnet.
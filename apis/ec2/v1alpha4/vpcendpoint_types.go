@@ -0,0 +1,191 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Types of a VPCEndpoint.
+const (
+	VPCEndpointTypeInterface = "Interface"
+	VPCEndpointTypeGateway   = "Gateway"
+)
+
+// VPCEndpointParameters define the desired state of an AWS VPC Endpoint.
+type VPCEndpointParameters struct {
+	// Region is the region you'd like your VPCEndpoint to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// VPCID is the ID of the VPC the endpoint is created in.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its ID.
+	// +immutable
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its ID.
+	// +immutable
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// ServiceName is the name of the service the endpoint connects to, e.g.
+	// com.amazonaws.us-east-1.s3.
+	// +immutable
+	ServiceName string `json:"serviceName"`
+
+	// VPCEndpointType is the type of the endpoint. Gateway endpoints are
+	// associated with route tables, interface endpoints with subnets and
+	// security groups. Defaults to Gateway.
+	// +crossplane:aws:model=ec2.CreateVpcEndpointInput.VpcEndpointType
+	// +kubebuilder:validation:Enum=Interface;Gateway
+	// +immutable
+	// +optional
+	VPCEndpointType *string `json:"vpcEndpointType,omitempty"`
+
+	// PolicyDocument is the JSON policy document that controls access to
+	// the service through the endpoint. Defaults to full access.
+	// +optional
+	PolicyDocument *string `json:"policyDocument,omitempty"`
+
+	// PrivateDNSEnabled indicates whether the private hosted zone of the
+	// service is associated with the VPC. Only valid for interface
+	// endpoints.
+	// +optional
+	PrivateDNSEnabled *bool `json:"privateDnsEnabled,omitempty"`
+
+	// RouteTableIDs are the IDs of the route tables a gateway endpoint is
+	// associated with.
+	// +optional
+	RouteTableIDs []string `json:"routeTableIds,omitempty"`
+
+	// RouteTableIDRefs references RouteTables to retrieve their IDs.
+	// +optional
+	RouteTableIDRefs []runtimev1alpha1.Reference `json:"routeTableIdRefs,omitempty"`
+
+	// RouteTableIDSelector selects references to RouteTables to retrieve
+	// their IDs.
+	// +optional
+	RouteTableIDSelector *runtimev1alpha1.Selector `json:"routeTableIdSelector,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets an interface endpoint creates
+	// network interfaces in.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups associated with
+	// the network interfaces of an interface endpoint.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their IDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their IDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A VPCEndpointSpec defines the desired state of a VPCEndpoint.
+type VPCEndpointSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VPCEndpointParameters `json:"forProvider"`
+}
+
+// VPCEndpointDNSEntry describes a DNS entry of a VPCEndpoint.
+type VPCEndpointDNSEntry struct {
+	// DNSName is the DNS name.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// HostedZoneID is the ID of the private hosted zone.
+	HostedZoneID string `json:"hostedZoneId,omitempty"`
+}
+
+// VPCEndpointObservation keeps the state for the external resource.
+type VPCEndpointObservation struct {
+	// VPCEndpointID is the ID of the endpoint.
+	VPCEndpointID string `json:"vpcEndpointId,omitempty"`
+
+	// State of the endpoint, e.g. pendingAcceptance, pending, available or
+	// failed.
+	State string `json:"state,omitempty"`
+
+	// OwnerID is the ID of the AWS account that owns the endpoint.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// NetworkInterfaceIDs are the IDs of the network interfaces of an
+	// interface endpoint.
+	NetworkInterfaceIDs []string `json:"networkInterfaceIds,omitempty"`
+
+	// DNSEntries are the DNS entries of an interface endpoint.
+	DNSEntries []VPCEndpointDNSEntry `json:"dnsEntries,omitempty"`
+}
+
+// A VPCEndpointStatus represents the observed state of a VPCEndpoint.
+type VPCEndpointStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VPCEndpointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCEndpoint is a managed resource that represents an AWS VPC Endpoint.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.serviceName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCEndpointSpec   `json:"spec"`
+	Status VPCEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCEndpointList contains a list of VPCEndpoints
+type VPCEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCEndpoint `json:"items"`
+}
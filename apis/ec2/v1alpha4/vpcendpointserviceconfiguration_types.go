@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// VPCEndpointServiceConfigurationParameters define the desired state of an
+// AWS VPC Endpoint Service, i.e. a service published with PrivateLink.
+type VPCEndpointServiceConfigurationParameters struct {
+	// Region is the region you'd like your VPCEndpointServiceConfiguration
+	// to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// AcceptanceRequired indicates whether requests from service consumers
+	// to create an endpoint to the service must be accepted.
+	// +optional
+	AcceptanceRequired *bool `json:"acceptanceRequired,omitempty"`
+
+	// NetworkLoadBalancerARNs are the ARNs of the Network Load Balancers
+	// that serve the service.
+	// +optional
+	NetworkLoadBalancerARNs []string `json:"networkLoadBalancerArns,omitempty"`
+
+	// NetworkLoadBalancerARNRefs references LoadBalancers to retrieve their
+	// ARNs.
+	// +optional
+	NetworkLoadBalancerARNRefs []runtimev1alpha1.Reference `json:"networkLoadBalancerArnRefs,omitempty"`
+
+	// NetworkLoadBalancerARNSelector selects references to LoadBalancers to
+	// retrieve their ARNs.
+	// +optional
+	NetworkLoadBalancerARNSelector *runtimev1alpha1.Selector `json:"networkLoadBalancerArnSelector,omitempty"`
+
+	// PrivateDNSName is the private DNS name of the service. AWS only uses
+	// it once the ownership of its domain has been verified, see
+	// status.atProvider.privateDnsNameConfiguration.
+	// +optional
+	PrivateDNSName *string `json:"privateDnsName,omitempty"`
+
+	// AllowedPrincipals are the ARNs of the principals, e.g. accounts, users
+	// or roles, that are allowed to create endpoints to the service. Other
+	// principals are removed.
+	// +optional
+	AllowedPrincipals []string `json:"allowedPrincipals,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A VPCEndpointServiceConfigurationSpec defines the desired state of a
+// VPCEndpointServiceConfiguration.
+type VPCEndpointServiceConfigurationSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VPCEndpointServiceConfigurationParameters `json:"forProvider"`
+}
+
+// PrivateDNSNameConfiguration describes the TXT record that has to be
+// created to verify the ownership of the domain of a private DNS name.
+type PrivateDNSNameConfiguration struct {
+	// Name of the TXT record.
+	Name string `json:"name,omitempty"`
+
+	// State of the verification, i.e. pendingVerification, verified or
+	// failed.
+	State string `json:"state,omitempty"`
+
+	// Type of the record.
+	Type string `json:"type,omitempty"`
+
+	// Value of the TXT record.
+	Value string `json:"value,omitempty"`
+}
+
+// VPCEndpointServiceConfigurationObservation keeps the state for the
+// external resource.
+type VPCEndpointServiceConfigurationObservation struct {
+	// ServiceID is the ID of the service.
+	ServiceID string `json:"serviceId,omitempty"`
+
+	// ServiceName is the name of the service that consumers use to create
+	// endpoints to it.
+	ServiceName string `json:"serviceName,omitempty"`
+
+	// ServiceState is the state of the service, e.g. Pending, Available or
+	// Failed.
+	ServiceState string `json:"serviceState,omitempty"`
+
+	// AvailabilityZones the service is available in.
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// BaseEndpointDNSNames are the DNS names of the service.
+	BaseEndpointDNSNames []string `json:"baseEndpointDnsNames,omitempty"`
+
+	// PrivateDNSNameConfiguration describes how to verify the private DNS
+	// name of the service.
+	PrivateDNSNameConfiguration *PrivateDNSNameConfiguration `json:"privateDnsNameConfiguration,omitempty"`
+}
+
+// A VPCEndpointServiceConfigurationStatus represents the observed state of a
+// VPCEndpointServiceConfiguration.
+type VPCEndpointServiceConfigurationStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VPCEndpointServiceConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCEndpointServiceConfiguration is a managed resource that represents an
+// AWS VPC Endpoint Service. The verification of its private DNS name is
+// started as soon as it is pending and restarted whenever it fails, until the
+// TXT record described in status.atProvider.privateDnsNameConfiguration has
+// been created.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".status.atProvider.serviceName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCEndpointServiceConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCEndpointServiceConfigurationSpec   `json:"spec"`
+	Status VPCEndpointServiceConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCEndpointServiceConfigurationList contains a list of
+// VPCEndpointServiceConfigurations
+type VPCEndpointServiceConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCEndpointServiceConfiguration `json:"items"`
+}
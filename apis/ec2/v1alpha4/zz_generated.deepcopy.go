@@ -71,6 +71,21 @@ func (in *AssociationState) DeepCopy() *AssociationState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateDNSNameConfiguration) DeepCopyInto(out *PrivateDNSNameConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSNameConfiguration.
+func (in *PrivateDNSNameConfiguration) DeepCopy() *PrivateDNSNameConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateDNSNameConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Route) DeepCopyInto(out *Route) {
 	*out = *in
@@ -94,6 +109,21 @@ func (in *Route) DeepCopyInto(out *Route) {
 		*out = new(v1alpha1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NatGatewayID != nil {
+		in, out := &in.NatGatewayID, &out.NatGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NatGatewayIDRef != nil {
+		in, out := &in.NatGatewayIDRef, &out.NatGatewayIDRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.NatGatewayIDSelector != nil {
+		in, out := &in.NatGatewayIDSelector, &out.NatGatewayIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
@@ -287,3 +317,404 @@ func (in *RouteTableStatus) DeepCopy() *RouteTableStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpoint) DeepCopyInto(out *VPCEndpoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpoint.
+func (in *VPCEndpoint) DeepCopy() *VPCEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCEndpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointDNSEntry) DeepCopyInto(out *VPCEndpointDNSEntry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointDNSEntry.
+func (in *VPCEndpointDNSEntry) DeepCopy() *VPCEndpointDNSEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointDNSEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointList) DeepCopyInto(out *VPCEndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPCEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointList.
+func (in *VPCEndpointList) DeepCopy() *VPCEndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCEndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointObservation) DeepCopyInto(out *VPCEndpointObservation) {
+	*out = *in
+	if in.NetworkInterfaceIDs != nil {
+		in, out := &in.NetworkInterfaceIDs, &out.NetworkInterfaceIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNSEntries != nil {
+		in, out := &in.DNSEntries, &out.DNSEntries
+		*out = make([]VPCEndpointDNSEntry, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointObservation.
+func (in *VPCEndpointObservation) DeepCopy() *VPCEndpointObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointParameters) DeepCopyInto(out *VPCEndpointParameters) {
+	*out = *in
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCEndpointType != nil {
+		in, out := &in.VPCEndpointType, &out.VPCEndpointType
+		*out = new(string)
+		**out = **in
+	}
+	if in.PolicyDocument != nil {
+		in, out := &in.PolicyDocument, &out.PolicyDocument
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateDNSEnabled != nil {
+		in, out := &in.PrivateDNSEnabled, &out.PrivateDNSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RouteTableIDs != nil {
+		in, out := &in.RouteTableIDs, &out.RouteTableIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RouteTableIDRefs != nil {
+		in, out := &in.RouteTableIDRefs, &out.RouteTableIDRefs
+		*out = make([]v1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.RouteTableIDSelector != nil {
+		in, out := &in.RouteTableIDSelector, &out.RouteTableIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDRefs != nil {
+		in, out := &in.SubnetIDRefs, &out.SubnetIDRefs
+		*out = make([]v1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDRefs != nil {
+		in, out := &in.SecurityGroupIDRefs, &out.SecurityGroupIDRefs
+		*out = make([]v1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDSelector != nil {
+		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointParameters.
+func (in *VPCEndpointParameters) DeepCopy() *VPCEndpointParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointServiceConfiguration) DeepCopyInto(out *VPCEndpointServiceConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointServiceConfiguration.
+func (in *VPCEndpointServiceConfiguration) DeepCopy() *VPCEndpointServiceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointServiceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCEndpointServiceConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointServiceConfigurationList) DeepCopyInto(out *VPCEndpointServiceConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPCEndpointServiceConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointServiceConfigurationList.
+func (in *VPCEndpointServiceConfigurationList) DeepCopy() *VPCEndpointServiceConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointServiceConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCEndpointServiceConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointServiceConfigurationObservation) DeepCopyInto(out *VPCEndpointServiceConfigurationObservation) {
+	*out = *in
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BaseEndpointDNSNames != nil {
+		in, out := &in.BaseEndpointDNSNames, &out.BaseEndpointDNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateDNSNameConfiguration != nil {
+		in, out := &in.PrivateDNSNameConfiguration, &out.PrivateDNSNameConfiguration
+		*out = new(PrivateDNSNameConfiguration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointServiceConfigurationObservation.
+func (in *VPCEndpointServiceConfigurationObservation) DeepCopy() *VPCEndpointServiceConfigurationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointServiceConfigurationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointServiceConfigurationParameters) DeepCopyInto(out *VPCEndpointServiceConfigurationParameters) {
+	*out = *in
+	if in.AcceptanceRequired != nil {
+		in, out := &in.AcceptanceRequired, &out.AcceptanceRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NetworkLoadBalancerARNs != nil {
+		in, out := &in.NetworkLoadBalancerARNs, &out.NetworkLoadBalancerARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkLoadBalancerARNRefs != nil {
+		in, out := &in.NetworkLoadBalancerARNRefs, &out.NetworkLoadBalancerARNRefs
+		*out = make([]v1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkLoadBalancerARNSelector != nil {
+		in, out := &in.NetworkLoadBalancerARNSelector, &out.NetworkLoadBalancerARNSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedPrincipals != nil {
+		in, out := &in.AllowedPrincipals, &out.AllowedPrincipals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointServiceConfigurationParameters.
+func (in *VPCEndpointServiceConfigurationParameters) DeepCopy() *VPCEndpointServiceConfigurationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointServiceConfigurationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointServiceConfigurationSpec) DeepCopyInto(out *VPCEndpointServiceConfigurationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointServiceConfigurationSpec.
+func (in *VPCEndpointServiceConfigurationSpec) DeepCopy() *VPCEndpointServiceConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointServiceConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointServiceConfigurationStatus) DeepCopyInto(out *VPCEndpointServiceConfigurationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointServiceConfigurationStatus.
+func (in *VPCEndpointServiceConfigurationStatus) DeepCopy() *VPCEndpointServiceConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointServiceConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointSpec) DeepCopyInto(out *VPCEndpointSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointSpec.
+func (in *VPCEndpointSpec) DeepCopy() *VPCEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointStatus) DeepCopyInto(out *VPCEndpointStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointStatus.
+func (in *VPCEndpointStatus) DeepCopy() *VPCEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
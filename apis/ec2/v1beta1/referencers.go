@@ -38,6 +38,17 @@ func SecurityGroupName() reference.ExtractValueFn {
 	}
 }
 
+// SubnetARN returns the status.atProvider.subnetArn of a Subnet.
+func SubnetARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		s, ok := mg.(*Subnet)
+		if !ok {
+			return ""
+		}
+		return s.Status.AtProvider.SubnetARN
+	}
+}
+
 // ResolveReferences of this InternetGateway
 func (mg *InternetGateway) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -38,6 +38,7 @@ type RepositoryParameters struct {
 	// be overwritten. If IMMUTABLE is specified, all image tags within the repository
 	// will be immutable which will prevent them from being overwritten.
 	// +optional
+	// +crossplane:aws:model=ecr.CreateRepositoryInput.ImageTagMutability
 	// +kubebuilder:validation:Enum=MUTABLE;IMMUTABLE
 	ImageTagMutability *string `json:"imageTagMutability,omitempty"`
 
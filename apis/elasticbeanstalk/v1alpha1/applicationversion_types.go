@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SourceBundle is the Amazon S3 location of the source bundle of an
+// application version.
+type SourceBundle struct {
+	// S3Bucket is the name of the bucket that holds the source bundle.
+	// +optional
+	S3Bucket *string `json:"s3Bucket,omitempty"`
+
+	// S3BucketRef references a Bucket to retrieve its name.
+	// +optional
+	S3BucketRef *runtimev1alpha1.Reference `json:"s3BucketRef,omitempty"`
+
+	// S3BucketSelector selects a reference to a Bucket to retrieve its name.
+	// +optional
+	S3BucketSelector *runtimev1alpha1.Selector `json:"s3BucketSelector,omitempty"`
+
+	// S3Key is the key of the source bundle in the bucket, like
+	// releases/app-1.0.0.zip.
+	S3Key string `json:"s3Key"`
+}
+
+// ApplicationVersionParameters define the desired state of an AWS Elastic
+// Beanstalk application version.
+type ApplicationVersionParameters struct {
+	// Region is the region you'd like your ApplicationVersion to be created
+	// in.
+	// +immutable
+	Region string `json:"region"`
+
+	// ApplicationName is the name of the application of the version.
+	// +immutable
+	// +optional
+	ApplicationName *string `json:"applicationName,omitempty"`
+
+	// ApplicationNameRef references an Application to retrieve its name.
+	// +optional
+	ApplicationNameRef *runtimev1alpha1.Reference `json:"applicationNameRef,omitempty"`
+
+	// ApplicationNameSelector selects a reference to an Application to
+	// retrieve its name.
+	// +optional
+	ApplicationNameSelector *runtimev1alpha1.Selector `json:"applicationNameSelector,omitempty"`
+
+	// Description of the application version.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// SourceBundle is the Amazon S3 location of the source bundle.
+	// +immutable
+	SourceBundle SourceBundle `json:"sourceBundle"`
+
+	// Process validates the source bundle and its configuration files before
+	// the version is deployed.
+	// +immutable
+	// +optional
+	Process *bool `json:"process,omitempty"`
+
+	// DeleteSourceBundle deletes the source bundle from Amazon S3 when the
+	// version is deleted.
+	// +optional
+	DeleteSourceBundle *bool `json:"deleteSourceBundle,omitempty"`
+
+	// Tags to add to the application version.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// ApplicationVersionObservation keeps the state for the external resource
+type ApplicationVersionObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the application version.
+	ARN string `json:"arn,omitempty"`
+
+	// Status of the processing of the application version.
+	Status string `json:"status,omitempty"`
+}
+
+// An ApplicationVersionSpec defines the desired state of an
+// ApplicationVersion.
+type ApplicationVersionSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ApplicationVersionParameters `json:"forProvider"`
+}
+
+// An ApplicationVersionStatus represents the observed state of an
+// ApplicationVersion.
+type ApplicationVersionStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ApplicationVersionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ApplicationVersion is a managed resource that represents an AWS Elastic
+// Beanstalk application version. Its external name is the version label.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ApplicationVersion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationVersionSpec   `json:"spec"`
+	Status ApplicationVersionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationVersionList contains a list of ApplicationVersions
+type ApplicationVersionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationVersion `json:"items"`
+}
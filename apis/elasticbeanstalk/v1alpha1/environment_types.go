@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Elastic Beanstalk environment statuses.
+const (
+	// The environment is being launched.
+	EnvironmentStatusLaunching = "Launching"
+	// The environment is being updated.
+	EnvironmentStatusUpdating = "Updating"
+	// The environment is available to serve requests.
+	EnvironmentStatusReady = "Ready"
+	// The environment is being terminated.
+	EnvironmentStatusTerminating = "Terminating"
+	// The environment is terminated.
+	EnvironmentStatusTerminated = "Terminated"
+)
+
+// OptionSetting is a configuration option of an environment.
+type OptionSetting struct {
+	// Namespace of the option, like aws:autoscaling:launchconfiguration.
+	Namespace string `json:"namespace"`
+
+	// OptionName is the name of the option, like InstanceType.
+	OptionName string `json:"optionName"`
+
+	// ResourceName is the name of the Auto Scaling scheduled action the
+	// option applies to, if any.
+	// +optional
+	ResourceName *string `json:"resourceName,omitempty"`
+
+	// Value of the option.
+	Value string `json:"value"`
+}
+
+// EnvironmentTier is the tier of an environment.
+type EnvironmentTier struct {
+	// Name of the tier.
+	// +kubebuilder:validation:Enum=WebServer;Worker
+	Name string `json:"name"`
+
+	// Type of the tier, Standard for WebServer or SQS/HTTP for Worker.
+	// +kubebuilder:validation:Enum=Standard;SQS/HTTP
+	Type string `json:"type"`
+}
+
+// EnvironmentParameters define the desired state of an AWS Elastic Beanstalk
+// environment.
+type EnvironmentParameters struct {
+	// Region is the region you'd like your Environment to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// ApplicationName is the name of the application of the environment.
+	// +immutable
+	// +optional
+	ApplicationName *string `json:"applicationName,omitempty"`
+
+	// ApplicationNameRef references an Application to retrieve its name.
+	// +optional
+	ApplicationNameRef *runtimev1alpha1.Reference `json:"applicationNameRef,omitempty"`
+
+	// ApplicationNameSelector selects a reference to an Application to
+	// retrieve its name.
+	// +optional
+	ApplicationNameSelector *runtimev1alpha1.Selector `json:"applicationNameSelector,omitempty"`
+
+	// Description of the environment.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// SolutionStackName is the name of the solution stack of the
+	// environment, like "64bit Amazon Linux 2 v3.1.2 running Python 3.7".
+	// Exactly one of SolutionStackName and PlatformARN must be set.
+	// +optional
+	SolutionStackName *string `json:"solutionStackName,omitempty"`
+
+	// PlatformARN is the ARN of the platform version of the environment.
+	// Exactly one of SolutionStackName and PlatformARN must be set.
+	// +optional
+	PlatformARN *string `json:"platformArn,omitempty"`
+
+	// VersionLabel is the label of the application version that is deployed
+	// to the environment. The sample application is deployed if it is not
+	// set.
+	// +optional
+	VersionLabel *string `json:"versionLabel,omitempty"`
+
+	// VersionLabelRef references an ApplicationVersion to retrieve its
+	// label.
+	// +optional
+	VersionLabelRef *runtimev1alpha1.Reference `json:"versionLabelRef,omitempty"`
+
+	// VersionLabelSelector selects a reference to an ApplicationVersion to
+	// retrieve its label.
+	// +optional
+	VersionLabelSelector *runtimev1alpha1.Selector `json:"versionLabelSelector,omitempty"`
+
+	// CNAMEPrefix is the prefix of the CNAME of the environment. A prefix is
+	// generated from the environment name if it is not set.
+	// +immutable
+	// +optional
+	CNAMEPrefix *string `json:"cnamePrefix,omitempty"`
+
+	// Tier of the environment. WebServer is used if it is not set.
+	// +immutable
+	// +optional
+	Tier *EnvironmentTier `json:"tier,omitempty"`
+
+	// OptionSettings configure the environment. Options that are not listed
+	// keep their current values.
+	// +optional
+	OptionSettings []OptionSetting `json:"optionSettings,omitempty"`
+
+	// Tags to add to the environment.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// EnvironmentObservation keeps the state for the external resource
+type EnvironmentObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the environment.
+	ARN string `json:"arn,omitempty"`
+
+	// EnvironmentID is the ID of the environment.
+	EnvironmentID string `json:"environmentId,omitempty"`
+
+	// Status is the current status of the environment.
+	Status string `json:"status,omitempty"`
+
+	// Health is the health of the environment, like Green or Red.
+	Health string `json:"health,omitempty"`
+
+	// CNAME is the domain name of the environment.
+	CNAME string `json:"cname,omitempty"`
+
+	// EndpointURL is the URL of the load balancer of the environment.
+	EndpointURL string `json:"endpointUrl,omitempty"`
+}
+
+// An EnvironmentSpec defines the desired state of an Environment.
+type EnvironmentSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  EnvironmentParameters `json:"forProvider"`
+}
+
+// An EnvironmentStatus represents the observed state of an Environment.
+type EnvironmentStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     EnvironmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Environment is a managed resource that represents an AWS Elastic
+// Beanstalk environment. Its external name is the name of the environment.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="HEALTH",type="string",JSONPath=".status.atProvider.health"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Environment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentSpec   `json:"spec"`
+	Status EnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentList contains a list of Environments
+type EnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Environment `json:"items"`
+}
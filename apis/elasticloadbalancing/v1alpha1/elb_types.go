@@ -101,6 +101,12 @@ type ELBParameters struct {
 	// Information about the health checks conducted on the load balancer.
 	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
 
+	// CrossZoneLoadBalancing distributes the traffic evenly across the
+	// registered instances of all the enabled Availability Zones instead of
+	// evenly across the zones.
+	// +optional
+	CrossZoneLoadBalancing *bool `json:"crossZoneLoadBalancing,omitempty"`
+
 	// The listeners for this ELB.
 	Listeners []Listener `json:"listeners"`
 
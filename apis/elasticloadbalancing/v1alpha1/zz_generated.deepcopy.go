@@ -272,6 +272,11 @@ func (in *ELBParameters) DeepCopyInto(out *ELBParameters) {
 		*out = new(HealthCheck)
 		**out = **in
 	}
+	if in.CrossZoneLoadBalancing != nil {
+		in, out := &in.CrossZoneLoadBalancing, &out.CrossZoneLoadBalancing
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Listeners != nil {
 		in, out := &in.Listeners, &out.Listeners
 		*out = make([]Listener, len(*in))
@@ -0,0 +1,211 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// RedirectConfig redirects requests from one URL to another. The host,
+// path, port, protocol and query default to the ones of the original
+// request, and can contain the #{host}, #{path}, #{port}, #{protocol} and
+// #{query} placeholders.
+type RedirectConfig struct {
+	// Host of the redirect URL.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// Path of the redirect URL, starting with a slash.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// Port of the redirect URL.
+	// +optional
+	Port *string `json:"port,omitempty"`
+
+	// Protocol of the redirect URL, i.e. HTTP or HTTPS.
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// Query of the redirect URL, without the leading question mark.
+	// +optional
+	Query *string `json:"query,omitempty"`
+
+	// StatusCode of the redirect, i.e. permanent or temporary.
+	// +crossplane:aws:model=elasticloadbalancingv2.RedirectActionConfig.StatusCode
+	// +kubebuilder:validation:Enum=HTTP_301;HTTP_302
+	StatusCode string `json:"statusCode"`
+}
+
+// FixedResponseConfig returns a custom response.
+type FixedResponseConfig struct {
+	// StatusCode of the response, i.e. 2XX, 4XX or 5XX.
+	// +kubebuilder:validation:Pattern=`^(2|4|5)\d\d$`
+	StatusCode string `json:"statusCode"`
+
+	// ContentType of the response, e.g. text/plain.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// MessageBody of the response.
+	// +optional
+	MessageBody *string `json:"messageBody,omitempty"`
+}
+
+// An Action is taken on the requests that reach a Listener or match a
+// ListenerRule.
+type Action struct {
+	// Type of the action. Network load balancers only support forward.
+	// +kubebuilder:validation:Enum=forward;redirect;fixed-response
+	Type string `json:"type"`
+
+	// Order of the action, the action with the lowest order is performed
+	// first. It is required if there are multiple actions.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=50000
+	// +optional
+	Order *int64 `json:"order,omitempty"`
+
+	// TargetGroupARN is the ARN of the target group requests are forwarded
+	// to by forward actions.
+	// +optional
+	TargetGroupARN *string `json:"targetGroupArn,omitempty"`
+
+	// TargetGroupARNRef references a TargetGroup to retrieve its ARN.
+	// +optional
+	TargetGroupARNRef *runtimev1alpha1.Reference `json:"targetGroupArnRef,omitempty"`
+
+	// TargetGroupARNSelector selects a reference to a TargetGroup to
+	// retrieve its ARN.
+	// +optional
+	TargetGroupARNSelector *runtimev1alpha1.Selector `json:"targetGroupArnSelector,omitempty"`
+
+	// RedirectConfig configures redirect actions.
+	// +optional
+	RedirectConfig *RedirectConfig `json:"redirectConfig,omitempty"`
+
+	// FixedResponseConfig configures fixed-response actions.
+	// +optional
+	FixedResponseConfig *FixedResponseConfig `json:"fixedResponseConfig,omitempty"`
+}
+
+// ListenerParameters define the desired state of an AWS Elastic Load
+// Balancing v2 Listener.
+type ListenerParameters struct {
+	// Region is the region you'd like your Listener to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// LoadBalancerARN is the ARN of the load balancer of the listener.
+	// +optional
+	// +immutable
+	LoadBalancerARN *string `json:"loadBalancerArn,omitempty"`
+
+	// LoadBalancerARNRef references a LoadBalancer to retrieve its ARN.
+	// +optional
+	LoadBalancerARNRef *runtimev1alpha1.Reference `json:"loadBalancerArnRef,omitempty"`
+
+	// LoadBalancerARNSelector selects a reference to a LoadBalancer to
+	// retrieve its ARN.
+	// +optional
+	LoadBalancerARNSelector *runtimev1alpha1.Selector `json:"loadBalancerArnSelector,omitempty"`
+
+	// Port the load balancer listens on.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+
+	// Protocol of the connections from clients to the load balancer.
+	// Application load balancers support HTTP and HTTPS, network load
+	// balancers TCP, TLS, UDP and TCP_UDP.
+	// +crossplane:aws:model=elasticloadbalancingv2.CreateListenerInput.Protocol
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP
+	Protocol string `json:"protocol"`
+
+	// SSLPolicy is the security policy that defines the supported protocols
+	// and ciphers of HTTPS and TLS listeners.
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
+
+	// CertificateARNs are the ARNs of the certificates of HTTPS and TLS
+	// listeners. The first one is the default certificate, the others are
+	// selected using SNI.
+	// +optional
+	CertificateARNs []string `json:"certificateArns,omitempty"`
+
+	// CertificateARNRefs references ACM Certificates to retrieve their
+	// ARNs.
+	// +optional
+	CertificateARNRefs []runtimev1alpha1.Reference `json:"certificateArnRefs,omitempty"`
+
+	// CertificateARNSelector selects references to ACM Certificates to
+	// retrieve their ARNs.
+	// +optional
+	CertificateARNSelector *runtimev1alpha1.Selector `json:"certificateArnSelector,omitempty"`
+
+	// DefaultActions are taken on the requests that match no ListenerRule.
+	// +kubebuilder:validation:MinItems=1
+	DefaultActions []Action `json:"defaultActions"`
+}
+
+// A ListenerSpec defines the desired state of a Listener.
+type ListenerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ListenerParameters `json:"forProvider"`
+}
+
+// ListenerObservation keeps the state for the external resource.
+type ListenerObservation struct {
+	// ListenerARN is the ARN of the listener.
+	ListenerARN string `json:"listenerArn,omitempty"`
+}
+
+// A ListenerStatus represents the observed state of a Listener.
+type ListenerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ListenerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Listener is a managed resource that represents an AWS Elastic Load
+// Balancing v2 Listener.
+// +kubebuilder:printcolumn:name="PORT",type="integer",JSONPath=".spec.forProvider.port"
+// +kubebuilder:printcolumn:name="PROTOCOL",type="string",JSONPath=".spec.forProvider.protocol"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Listener struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ListenerSpec   `json:"spec"`
+	Status ListenerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerList contains a list of Listeners
+type ListenerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Listener `json:"items"`
+}
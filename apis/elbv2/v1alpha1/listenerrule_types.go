@@ -0,0 +1,123 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// A RuleCondition matches requests to a ListenerRule.
+type RuleCondition struct {
+	// Field of the request the condition matches, i.e. the host header, the
+	// path, an HTTP header, the HTTP method or the source IP address.
+	// +kubebuilder:validation:Enum=host-header;path-pattern;http-header;http-request-method;source-ip
+	Field string `json:"field"`
+
+	// HTTPHeaderName is the name of the HTTP header of http-header
+	// conditions.
+	// +optional
+	HTTPHeaderName *string `json:"httpHeaderName,omitempty"`
+
+	// Values the field matches. Host headers, paths and HTTP headers can
+	// contain the * and ? wildcards, source IP addresses are CIDR blocks.
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values"`
+}
+
+// ListenerRuleParameters define the desired state of an AWS Elastic Load
+// Balancing v2 Listener Rule.
+type ListenerRuleParameters struct {
+	// Region is the region you'd like your ListenerRule to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// ListenerARN is the ARN of the listener of the rule.
+	// +optional
+	// +immutable
+	ListenerARN *string `json:"listenerArn,omitempty"`
+
+	// ListenerARNRef references a Listener to retrieve its ARN.
+	// +optional
+	ListenerARNRef *runtimev1alpha1.Reference `json:"listenerArnRef,omitempty"`
+
+	// ListenerARNSelector selects a reference to a Listener to retrieve its
+	// ARN.
+	// +optional
+	ListenerARNSelector *runtimev1alpha1.Selector `json:"listenerArnSelector,omitempty"`
+
+	// Priority of the rule, the rules of a listener are evaluated in order
+	// of priority, from the lowest to the highest value. It must be unique
+	// per listener.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=50000
+	Priority int64 `json:"priority"`
+
+	// Conditions that requests must all match for the actions to be taken.
+	// +kubebuilder:validation:MinItems=1
+	Conditions []RuleCondition `json:"conditions"`
+
+	// Actions that are taken on the requests that match the conditions.
+	// +kubebuilder:validation:MinItems=1
+	Actions []Action `json:"actions"`
+}
+
+// A ListenerRuleSpec defines the desired state of a ListenerRule.
+type ListenerRuleSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ListenerRuleParameters `json:"forProvider"`
+}
+
+// ListenerRuleObservation keeps the state for the external resource.
+type ListenerRuleObservation struct {
+	// RuleARN is the ARN of the rule.
+	RuleARN string `json:"ruleArn,omitempty"`
+}
+
+// A ListenerRuleStatus represents the observed state of a ListenerRule.
+type ListenerRuleStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ListenerRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ListenerRule is a managed resource that represents a rule of an AWS
+// Application Load Balancer Listener.
+// +kubebuilder:printcolumn:name="PRIORITY",type="integer",JSONPath=".spec.forProvider.priority"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ListenerRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ListenerRuleSpec   `json:"spec"`
+	Status ListenerRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerRuleList contains a list of ListenerRules
+type ListenerRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ListenerRule `json:"items"`
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag defines a key value pair that can be attached to a LoadBalancer or a
+// TargetGroup.
+type Tag struct {
+	// The key of the tag.
+	Key string `json:"key"`
+
+	// The value of the tag.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// SubnetMapping specifies a subnet of a network LoadBalancer along with the
+// Elastic IP address or private IP address of the load balancer in it.
+type SubnetMapping struct {
+	// SubnetID is the ID of the subnet.
+	SubnetID string `json:"subnetId"`
+
+	// AllocationID is the allocation ID of the Elastic IP address of an
+	// internet-facing load balancer in the subnet.
+	// +optional
+	AllocationID *string `json:"allocationId,omitempty"`
+
+	// PrivateIPv4Address is the private IPv4 address of an internal load
+	// balancer in the subnet.
+	// +optional
+	PrivateIPv4Address *string `json:"privateIPv4Address,omitempty"`
+}
+
+// LoadBalancerParameters define the desired state of an AWS Application or
+// Network Load Balancer.
+type LoadBalancerParameters struct {
+	// Region is the region you'd like your LoadBalancer to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name of the load balancer. It must be unique per region and account,
+	// can have at most 32 alphanumeric characters or hyphens, and must not
+	// begin or end with a hyphen.
+	// +immutable
+	Name string `json:"name"`
+
+	// Type of the load balancer.
+	// +crossplane:aws:model=elasticloadbalancingv2.CreateLoadBalancerInput.Type
+	// +kubebuilder:validation:Enum=application;network
+	// +optional
+	// +immutable
+	Type *string `json:"type,omitempty"`
+
+	// Scheme of the load balancer. Internal load balancers only route
+	// requests from clients with access to the VPC of the load balancer.
+	// +crossplane:aws:model=elasticloadbalancingv2.CreateLoadBalancerInput.Scheme
+	// +kubebuilder:validation:Enum=internet-facing;internal
+	// +optional
+	// +immutable
+	Scheme *string `json:"scheme,omitempty"`
+
+	// IPAddressType is the type of IP addresses used by the subnets of the
+	// load balancer. Internal load balancers must use ipv4.
+	// +crossplane:aws:model=elasticloadbalancingv2.CreateLoadBalancerInput.IpAddressType
+	// +kubebuilder:validation:Enum=ipv4;dualstack
+	// +optional
+	IPAddressType *string `json:"ipAddressType,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets of the load balancer, at most one
+	// per Availability Zone. Either subnetIds or subnetMappings must be set.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references Subnets to retrieve their SubnetIDs.
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets to retrieve their
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SubnetMappings are the subnets of a network load balancer along with
+	// the addresses of the load balancer in them.
+	// +optional
+	SubnetMappings []SubnetMapping `json:"subnetMappings,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of an application
+	// load balancer.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their
+	// SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// Tags to assign to the load balancer.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A LoadBalancerSpec defines the desired state of a LoadBalancer.
+type LoadBalancerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LoadBalancerParameters `json:"forProvider"`
+}
+
+// LoadBalancerObservation keeps the state for the external resource.
+type LoadBalancerObservation struct {
+	// LoadBalancerARN is the ARN of the load balancer.
+	LoadBalancerARN string `json:"loadBalancerArn,omitempty"`
+
+	// DNSName is the public DNS name of the load balancer.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// CanonicalHostedZoneID is the ID of the Route 53 hosted zone of the
+	// load balancer, used for alias records.
+	CanonicalHostedZoneID string `json:"canonicalHostedZoneId,omitempty"`
+
+	// VPCID is the ID of the VPC of the load balancer.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// State of the load balancer, i.e. provisioning, active,
+	// active_impaired or failed.
+	State string `json:"state,omitempty"`
+}
+
+// A LoadBalancerStatus represents the observed state of a LoadBalancer.
+type LoadBalancerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LoadBalancerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LoadBalancer is a managed resource that represents an AWS Application or
+// Network Load Balancer. The DNS name of the load balancer is published to
+// the connection secret as endpoint.
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="DNSNAME",type="string",JSONPath=".status.atProvider.dnsName"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadBalancerSpec   `json:"spec"`
+	Status LoadBalancerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerList contains a list of LoadBalancers
+type LoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancer `json:"items"`
+}
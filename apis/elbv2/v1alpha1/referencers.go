@@ -0,0 +1,185 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	acm "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// LoadBalancerDNSName returns the status.atProvider.dnsName of a LoadBalancer.
+func LoadBalancerDNSName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*LoadBalancer)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.DNSName
+	}
+}
+
+// LoadBalancerCanonicalHostedZoneID returns the
+// status.atProvider.canonicalHostedZoneId of a LoadBalancer.
+func LoadBalancerCanonicalHostedZoneID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*LoadBalancer)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.CanonicalHostedZoneID
+	}
+}
+
+// ResolveReferences of this LoadBalancer
+func (mg *LoadBalancer) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
+		References:    mg.Spec.ForProvider.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
+		To:            reference.To{Managed: &ec2.Subnet{}, List: &ec2.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetIds")
+	}
+	mg.Spec.ForProvider.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.securityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
+		References:    mg.Spec.ForProvider.SecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &ec2.SecurityGroup{}, List: &ec2.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.securityGroupIds")
+	}
+	mg.Spec.ForProvider.SecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this TargetGroup
+func (mg *TargetGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vpcId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPCID),
+		Reference:    mg.Spec.ForProvider.VPCIDRef,
+		Selector:     mg.Spec.ForProvider.VPCIDSelector,
+		To:           reference.To{Managed: &ec2.VPC{}, List: &ec2.VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcId")
+	}
+	mg.Spec.ForProvider.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Listener
+func (mg *Listener) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.loadBalancerArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LoadBalancerARN),
+		Reference:    mg.Spec.ForProvider.LoadBalancerARNRef,
+		Selector:     mg.Spec.ForProvider.LoadBalancerARNSelector,
+		To:           reference.To{Managed: &LoadBalancer{}, List: &LoadBalancerList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.loadBalancerArn")
+	}
+	mg.Spec.ForProvider.LoadBalancerARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LoadBalancerARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.certificateArns
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.CertificateARNs,
+		References:    mg.Spec.ForProvider.CertificateARNRefs,
+		Selector:      mg.Spec.ForProvider.CertificateARNSelector,
+		To:            reference.To{Managed: &acm.Certificate{}, List: &acm.CertificateList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.certificateArns")
+	}
+	mg.Spec.ForProvider.CertificateARNs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.CertificateARNRefs = mrsp.ResolvedReferences
+
+	return errors.Wrap(resolveActions(ctx, r, mg.Spec.ForProvider.DefaultActions), "spec.forProvider.defaultActions")
+}
+
+// ResolveReferences of this ListenerRule
+func (mg *ListenerRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.listenerArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ListenerARN),
+		Reference:    mg.Spec.ForProvider.ListenerARNRef,
+		Selector:     mg.Spec.ForProvider.ListenerARNSelector,
+		To:           reference.To{Managed: &Listener{}, List: &ListenerList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.listenerArn")
+	}
+	mg.Spec.ForProvider.ListenerARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ListenerARNRef = rsp.ResolvedReference
+
+	return errors.Wrap(resolveActions(ctx, r, mg.Spec.ForProvider.Actions), "spec.forProvider.actions")
+}
+
+// resolveActions resolves the target group of each of the given actions.
+func resolveActions(ctx context.Context, r *reference.APIResolver, actions []Action) error {
+	for i := range actions {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(actions[i].TargetGroupARN),
+			Reference:    actions[i].TargetGroupARNRef,
+			Selector:     actions[i].TargetGroupARNSelector,
+			To:           reference.To{Managed: &TargetGroup{}, List: &TargetGroupList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("[%d].targetGroupArn", i))
+		}
+		actions[i].TargetGroupARN = reference.ToPtrValue(rsp.ResolvedValue)
+		actions[i].TargetGroupARNRef = rsp.ResolvedReference
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// HealthCheck configures the health checks of the targets of a
+// TargetGroup.
+type HealthCheck struct {
+	// Enabled indicates whether health checks are enabled. They are always
+	// enabled for target groups of type instance or ip.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// IntervalSeconds is the approximate amount of time between health
+	// checks of an individual target.
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=300
+	// +optional
+	IntervalSeconds *int64 `json:"intervalSeconds,omitempty"`
+
+	// Path is the ping path of HTTP or HTTPS health checks.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// Port the load balancer uses for health checks, either a port number
+	// or traffic-port, i.e. the port each target receives traffic on.
+	// +optional
+	Port *string `json:"port,omitempty"`
+
+	// Protocol the load balancer uses for health checks.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// TimeoutSeconds is the amount of time during which no response from a
+	// target means a failed health check.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=120
+	// +optional
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+
+	// HealthyThresholdCount is the number of consecutive successful health
+	// checks required before an unhealthy target is considered healthy.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	HealthyThresholdCount *int64 `json:"healthyThresholdCount,omitempty"`
+
+	// UnhealthyThresholdCount is the number of consecutive failed health
+	// checks required before a target is considered unhealthy.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	UnhealthyThresholdCount *int64 `json:"unhealthyThresholdCount,omitempty"`
+
+	// Matcher are the HTTP codes of a successful response from a target,
+	// e.g. 200, 200,202 or 200-299.
+	// +optional
+	Matcher *string `json:"matcher,omitempty"`
+}
+
+// TargetGroupParameters define the desired state of an AWS Elastic Load
+// Balancing v2 Target Group.
+type TargetGroupParameters struct {
+	// Region is the region you'd like your TargetGroup to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name of the target group. It must be unique per region and account,
+	// can have at most 32 alphanumeric characters or hyphens, and must not
+	// begin or end with a hyphen.
+	// +immutable
+	Name string `json:"name"`
+
+	// TargetType is the type of the targets that are registered with the
+	// target group, i.e. instance IDs, IP addresses or a Lambda function.
+	// +crossplane:aws:model=elasticloadbalancingv2.CreateTargetGroupInput.TargetType
+	// +kubebuilder:validation:Enum=instance;ip;lambda
+	// +optional
+	// +immutable
+	TargetType *string `json:"targetType,omitempty"`
+
+	// Protocol the load balancer uses to route traffic to the targets. It is
+	// required unless the target type is lambda.
+	// +crossplane:aws:model=elasticloadbalancingv2.CreateListenerInput.Protocol
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP
+	// +optional
+	// +immutable
+	Protocol *string `json:"protocol,omitempty"`
+
+	// Port the targets receive traffic on. It is required unless the target
+	// type is lambda.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	// +immutable
+	Port *int64 `json:"port,omitempty"`
+
+	// VPCID is the ID of the VPC of the targets. It is required unless the
+	// target type is lambda.
+	// +optional
+	// +immutable
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its VPCID.
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its VPCID.
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// HealthCheck configures the health checks of the targets.
+	// +optional
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
+
+	// Tags to assign to the target group.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TargetGroupSpec defines the desired state of a TargetGroup.
+type TargetGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TargetGroupParameters `json:"forProvider"`
+}
+
+// TargetGroupObservation keeps the state for the external resource.
+type TargetGroupObservation struct {
+	// TargetGroupARN is the ARN of the target group.
+	TargetGroupARN string `json:"targetGroupArn,omitempty"`
+
+	// LoadBalancerARNs are the ARNs of the load balancers that route
+	// traffic to the target group.
+	LoadBalancerARNs []string `json:"loadBalancerArns,omitempty"`
+}
+
+// A TargetGroupStatus represents the observed state of a TargetGroup.
+type TargetGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TargetGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TargetGroup is a managed resource that represents an AWS Elastic Load
+// Balancing v2 Target Group.
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="TARGETTYPE",type="string",JSONPath=".spec.forProvider.targetType"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TargetGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TargetGroupSpec   `json:"spec"`
+	Status TargetGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetGroupList contains a list of TargetGroups
+type TargetGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetGroup `json:"items"`
+}
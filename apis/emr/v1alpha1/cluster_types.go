@@ -0,0 +1,531 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Cluster states.
+const (
+	ClusterStateStarting             = "STARTING"
+	ClusterStateBootstrapping        = "BOOTSTRAPPING"
+	ClusterStateRunning              = "RUNNING"
+	ClusterStateWaiting              = "WAITING"
+	ClusterStateTerminating          = "TERMINATING"
+	ClusterStateTerminated           = "TERMINATED"
+	ClusterStateTerminatedWithErrors = "TERMINATED_WITH_ERRORS"
+)
+
+// Tag represents user-provided metadata that can be associated with an EMR
+// cluster.
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// Application is an application that is installed on the cluster, e.g.
+// Hadoop, Spark or Hive.
+type Application struct {
+	// Name of the application.
+	Name string `json:"name"`
+
+	// Args are the arguments passed to the application.
+	// +optional
+	Args []string `json:"args,omitempty"`
+}
+
+// BootstrapAction is a script that is run on every node of the cluster
+// before the applications are started.
+type BootstrapAction struct {
+	// Name of the bootstrap action.
+	Name string `json:"name"`
+
+	// Path is the location of the script, e.g. in S3.
+	Path string `json:"path"`
+
+	// Args are the arguments passed to the script.
+	// +optional
+	Args []string `json:"args,omitempty"`
+}
+
+// Configuration overrides the default configuration of an application.
+type Configuration struct {
+	// Classification of the configuration, e.g. spark-defaults.
+	Classification string `json:"classification"`
+
+	// Properties of the configuration.
+	// +optional
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// EBSVolume is an EBS volume that is attached to every instance of an
+// instance group or fleet.
+type EBSVolume struct {
+	// VolumeType is the type of the volume, e.g. gp2 or io1.
+	VolumeType string `json:"volumeType"`
+
+	// SizeInGB is the size of the volume.
+	SizeInGB int64 `json:"sizeInGB"`
+
+	// IOPS is the number of I/O operations per second of io1 volumes.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+
+	// VolumesPerInstance is the number of volumes of this specification
+	// attached to every instance. Defaults to 1.
+	// +optional
+	VolumesPerInstance *int64 `json:"volumesPerInstance,omitempty"`
+}
+
+// EBSConfiguration configures the EBS volumes of the instances of an
+// instance group or fleet.
+type EBSConfiguration struct {
+	// EBSOptimized specifies whether the instances are EBS optimized.
+	// +optional
+	EBSOptimized *bool `json:"ebsOptimized,omitempty"`
+
+	// Volumes attached to every instance.
+	// +optional
+	Volumes []EBSVolume `json:"volumes,omitempty"`
+}
+
+// InstanceGroup is a group of instances of the same type with a role in the
+// cluster.
+type InstanceGroup struct {
+	// Name of the instance group.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// InstanceRole is the role of the instance group in the cluster.
+	// +crossplane:aws:model=emr.InstanceGroupConfig.InstanceRole
+	// +kubebuilder:validation:Enum=MASTER;CORE;TASK
+	InstanceRole string `json:"instanceRole"`
+
+	// InstanceType is the EC2 instance type of the instances.
+	InstanceType string `json:"instanceType"`
+
+	// InstanceCount is the number of instances. Core and task instance
+	// groups are resized when it is changed, unless managed scaling is
+	// enabled.
+	InstanceCount int64 `json:"instanceCount"`
+
+	// Market is the market the instances are launched in.
+	// +crossplane:aws:model=emr.InstanceGroupConfig.Market
+	// +kubebuilder:validation:Enum=ON_DEMAND;SPOT
+	// +optional
+	Market *string `json:"market,omitempty"`
+
+	// BidPrice is the maximum spot price in USD of spot instances. Defaults
+	// to the on-demand price.
+	// +optional
+	BidPrice *string `json:"bidPrice,omitempty"`
+
+	// EBSConfiguration configures the EBS volumes of the instances.
+	// +optional
+	EBSConfiguration *EBSConfiguration `json:"ebsConfiguration,omitempty"`
+}
+
+// InstanceTypeConfig is an instance type an instance fleet can provision.
+type InstanceTypeConfig struct {
+	// InstanceType is the EC2 instance type.
+	InstanceType string `json:"instanceType"`
+
+	// WeightedCapacity is the number of units an instance of this type
+	// counts towards the target capacities of the fleet. Defaults to 1.
+	// +optional
+	WeightedCapacity *int64 `json:"weightedCapacity,omitempty"`
+
+	// BidPrice is the maximum spot price in USD of spot instances of this
+	// type.
+	// +optional
+	BidPrice *string `json:"bidPrice,omitempty"`
+
+	// EBSConfiguration configures the EBS volumes of the instances.
+	// +optional
+	EBSConfiguration *EBSConfiguration `json:"ebsConfiguration,omitempty"`
+}
+
+// SpotProvisioning configures how spot instances of an instance fleet are
+// provisioned.
+type SpotProvisioning struct {
+	// TimeoutDurationMinutes is the time spot instances are waited for
+	// before the timeout action is taken.
+	TimeoutDurationMinutes int64 `json:"timeoutDurationMinutes"`
+
+	// TimeoutAction is the action taken when no spot instances could be
+	// provisioned within the timeout.
+	// +crossplane:aws:model=emr.SpotProvisioningSpecification.TimeoutAction
+	// +kubebuilder:validation:Enum=SWITCH_TO_ON_DEMAND;TERMINATE_CLUSTER
+	TimeoutAction string `json:"timeoutAction"`
+
+	// BlockDurationMinutes is the duration spot instances run without
+	// interruption.
+	// +optional
+	BlockDurationMinutes *int64 `json:"blockDurationMinutes,omitempty"`
+}
+
+// InstanceFleet is a set of instances of several types with a role in the
+// cluster, provisioned to meet target capacities.
+type InstanceFleet struct {
+	// Name of the instance fleet.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// InstanceFleetType is the role of the instance fleet in the cluster.
+	// +crossplane:aws:model=emr.InstanceFleetConfig.InstanceFleetType
+	// +kubebuilder:validation:Enum=MASTER;CORE;TASK
+	InstanceFleetType string `json:"instanceFleetType"`
+
+	// TargetOnDemandCapacity is the target capacity of on-demand instances.
+	// Core and task instance fleets are resized when it is changed, unless
+	// managed scaling is enabled.
+	// +optional
+	TargetOnDemandCapacity *int64 `json:"targetOnDemandCapacity,omitempty"`
+
+	// TargetSpotCapacity is the target capacity of spot instances. Core and
+	// task instance fleets are resized when it is changed, unless managed
+	// scaling is enabled.
+	// +optional
+	TargetSpotCapacity *int64 `json:"targetSpotCapacity,omitempty"`
+
+	// InstanceTypeConfigs are the instance types the fleet provisions.
+	InstanceTypeConfigs []InstanceTypeConfig `json:"instanceTypeConfigs"`
+
+	// SpotProvisioning configures how spot instances are provisioned.
+	// +optional
+	SpotProvisioning *SpotProvisioning `json:"spotProvisioning,omitempty"`
+}
+
+// ClusterInstances configure the EC2 instances of the cluster. Either
+// instance groups or instance fleets can be used.
+type ClusterInstances struct {
+	// InstanceGroups of the cluster.
+	// +optional
+	InstanceGroups []InstanceGroup `json:"instanceGroups,omitempty"`
+
+	// InstanceFleets of the cluster.
+	// +optional
+	InstanceFleets []InstanceFleet `json:"instanceFleets,omitempty"`
+
+	// EC2SubnetID is the ID of the subnet the cluster is launched in.
+	// +optional
+	EC2SubnetID *string `json:"ec2SubnetId,omitempty"`
+
+	// EC2SubnetIDRef references a Subnet to retrieve its ID.
+	// +optional
+	EC2SubnetIDRef *runtimev1alpha1.Reference `json:"ec2SubnetIdRef,omitempty"`
+
+	// EC2SubnetIDSelector selects a reference to a Subnet to retrieve its
+	// ID.
+	// +optional
+	EC2SubnetIDSelector *runtimev1alpha1.Selector `json:"ec2SubnetIdSelector,omitempty"`
+
+	// EC2SubnetIDs are the IDs of the subnets instance fleets choose from.
+	// +optional
+	EC2SubnetIDs []string `json:"ec2SubnetIds,omitempty"`
+
+	// EC2KeyName is the name of the EC2 key pair that allows SSH access to
+	// the master node.
+	// +optional
+	EC2KeyName *string `json:"ec2KeyName,omitempty"`
+
+	// EMRManagedMasterSecurityGroup is the ID of the security group of the
+	// master node that is managed by EMR.
+	// +optional
+	EMRManagedMasterSecurityGroup *string `json:"emrManagedMasterSecurityGroup,omitempty"`
+
+	// EMRManagedSlaveSecurityGroup is the ID of the security group of the
+	// core and task nodes that is managed by EMR.
+	// +optional
+	EMRManagedSlaveSecurityGroup *string `json:"emrManagedSlaveSecurityGroup,omitempty"`
+
+	// ServiceAccessSecurityGroup is the ID of the security group EMR uses
+	// to access clusters in private subnets.
+	// +optional
+	ServiceAccessSecurityGroup *string `json:"serviceAccessSecurityGroup,omitempty"`
+
+	// AdditionalMasterSecurityGroups are the IDs of additional security
+	// groups of the master node.
+	// +optional
+	AdditionalMasterSecurityGroups []string `json:"additionalMasterSecurityGroups,omitempty"`
+
+	// AdditionalSlaveSecurityGroups are the IDs of additional security
+	// groups of the core and task nodes.
+	// +optional
+	AdditionalSlaveSecurityGroups []string `json:"additionalSlaveSecurityGroups,omitempty"`
+
+	// KeepJobFlowAliveWhenNoSteps specifies whether the cluster keeps
+	// running when it has no steps left. Transient clusters, the default,
+	// terminate once their steps completed and are not started again.
+	// Keep-alive clusters are started again when they were terminated while
+	// the Cluster still exists.
+	// +optional
+	KeepJobFlowAliveWhenNoSteps *bool `json:"keepJobFlowAliveWhenNoSteps,omitempty"`
+
+	// TerminationProtected specifies whether the cluster is protected from
+	// termination. It needs to be disabled before the Cluster can be
+	// deleted.
+	// +optional
+	TerminationProtected *bool `json:"terminationProtected,omitempty"`
+}
+
+// HadoopJarStep is the JAR a step runs.
+type HadoopJarStep struct {
+	// Jar is the path of the JAR, e.g. in S3, or command-runner.jar.
+	Jar string `json:"jar"`
+
+	// MainClass is the class whose main function is run. Defaults to the
+	// main class of the manifest of the JAR.
+	// +optional
+	MainClass *string `json:"mainClass,omitempty"`
+
+	// Args are the arguments passed to the main function.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Properties are the Java properties set when the step runs.
+	// +optional
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// Step is a unit of work the cluster runs after it started.
+type Step struct {
+	// Name of the step.
+	Name string `json:"name"`
+
+	// ActionOnFailure is the action taken when the step fails.
+	// +crossplane:aws:model=emr.StepConfig.ActionOnFailure
+	// +kubebuilder:validation:Enum=TERMINATE_JOB_FLOW;TERMINATE_CLUSTER;CANCEL_AND_WAIT;CONTINUE
+	// +optional
+	ActionOnFailure *string `json:"actionOnFailure,omitempty"`
+
+	// HadoopJarStep is the JAR the step runs.
+	HadoopJarStep HadoopJarStep `json:"hadoopJarStep"`
+}
+
+// ComputeLimits are the limits managed scaling resizes the cluster within.
+type ComputeLimits struct {
+	// UnitType is the unit of the capacities.
+	// +crossplane:aws:model=emr.ComputeLimits.UnitType
+	// +kubebuilder:validation:Enum=InstanceFleetUnits;Instances;VCPU
+	UnitType string `json:"unitType"`
+
+	// MinimumCapacityUnits is the lower limit of the capacity of the core
+	// and task nodes.
+	MinimumCapacityUnits int64 `json:"minimumCapacityUnits"`
+
+	// MaximumCapacityUnits is the upper limit of the capacity of the core
+	// and task nodes.
+	MaximumCapacityUnits int64 `json:"maximumCapacityUnits"`
+
+	// MaximumOnDemandCapacityUnits is the upper limit of the capacity of
+	// on-demand core and task nodes.
+	// +optional
+	MaximumOnDemandCapacityUnits *int64 `json:"maximumOnDemandCapacityUnits,omitempty"`
+}
+
+// ManagedScalingPolicy lets EMR resize the cluster based on its workload.
+type ManagedScalingPolicy struct {
+	// ComputeLimits are the limits the cluster is resized within.
+	ComputeLimits ComputeLimits `json:"computeLimits"`
+}
+
+// ClusterParameters define the desired state of an AWS EMR cluster.
+type ClusterParameters struct {
+	// Region is the region of the cluster.
+	// +immutable
+	Region string `json:"region"`
+
+	// ReleaseLabel is the EMR release the cluster runs, e.g. emr-5.30.0.
+	// +immutable
+	ReleaseLabel string `json:"releaseLabel"`
+
+	// Instances configure the EC2 instances of the cluster. Apart from the
+	// capacities of core and task instance groups and fleets, and the
+	// termination protection, they cannot be changed after creation.
+	Instances ClusterInstances `json:"instances"`
+
+	// Applications installed on the cluster.
+	// +immutable
+	// +optional
+	Applications []Application `json:"applications,omitempty"`
+
+	// BootstrapActions run on every node before the applications are
+	// started.
+	// +immutable
+	// +optional
+	BootstrapActions []BootstrapAction `json:"bootstrapActions,omitempty"`
+
+	// Configurations override the default configuration of the
+	// applications.
+	// +immutable
+	// +optional
+	Configurations []Configuration `json:"configurations,omitempty"`
+
+	// Steps run once the cluster started.
+	// +immutable
+	// +optional
+	Steps []Step `json:"steps,omitempty"`
+
+	// ServiceRole is the name or ARN of the IAM role EMR assumes to manage
+	// the cluster.
+	// +immutable
+	// +optional
+	ServiceRole *string `json:"serviceRole,omitempty"`
+
+	// ServiceRoleRef references an IAMRole to retrieve its name.
+	// +optional
+	ServiceRoleRef *runtimev1alpha1.Reference `json:"serviceRoleRef,omitempty"`
+
+	// ServiceRoleSelector selects a reference to an IAMRole to retrieve its
+	// name.
+	// +optional
+	ServiceRoleSelector *runtimev1alpha1.Selector `json:"serviceRoleSelector,omitempty"`
+
+	// JobFlowRole is the name of the EC2 instance profile of the instances
+	// of the cluster.
+	// +immutable
+	// +optional
+	JobFlowRole *string `json:"jobFlowRole,omitempty"`
+
+	// AutoScalingRole is the name or ARN of the IAM role used by automatic
+	// scaling policies of instance groups.
+	// +immutable
+	// +optional
+	AutoScalingRole *string `json:"autoScalingRole,omitempty"`
+
+	// LogURI is the S3 location the log files of the cluster are written
+	// to.
+	// +immutable
+	// +optional
+	LogURI *string `json:"logUri,omitempty"`
+
+	// SecurityConfiguration is the name of the security configuration of
+	// the cluster.
+	// +immutable
+	// +optional
+	SecurityConfiguration *string `json:"securityConfiguration,omitempty"`
+
+	// CustomAMIID is the ID of a custom AMI the instances are launched
+	// from.
+	// +immutable
+	// +optional
+	CustomAMIID *string `json:"customAmiId,omitempty"`
+
+	// EBSRootVolumeSize is the size in GiB of the root volume of the
+	// instances.
+	// +immutable
+	// +optional
+	EBSRootVolumeSize *int64 `json:"ebsRootVolumeSize,omitempty"`
+
+	// ScaleDownBehavior specifies how instances are terminated when the
+	// cluster is scaled in.
+	// +crossplane:aws:model=emr.RunJobFlowInput.ScaleDownBehavior
+	// +kubebuilder:validation:Enum=TERMINATE_AT_INSTANCE_HOUR;TERMINATE_AT_TASK_COMPLETION
+	// +immutable
+	// +optional
+	ScaleDownBehavior *string `json:"scaleDownBehavior,omitempty"`
+
+	// ManagedScalingPolicy lets EMR resize the cluster based on its
+	// workload.
+	// +optional
+	ManagedScalingPolicy *ManagedScalingPolicy `json:"managedScalingPolicy,omitempty"`
+
+	// StepConcurrencyLevel is the number of steps that can run at the same
+	// time.
+	// +optional
+	StepConcurrencyLevel *int64 `json:"stepConcurrencyLevel,omitempty"`
+
+	// VisibleToAllUsers specifies whether all IAM users of the account can
+	// see and manage the cluster.
+	// +optional
+	VisibleToAllUsers *bool `json:"visibleToAllUsers,omitempty"`
+
+	// Tags of the cluster.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ClusterObservation keeps the state for the external resource
+type ClusterObservation struct {
+	// ClusterARN is the ARN of the cluster.
+	ClusterARN string `json:"clusterArn,omitempty"`
+
+	// State is the state of the cluster.
+	State string `json:"state,omitempty"`
+
+	// StateChangeReason is the reason of the last state change of the
+	// cluster.
+	StateChangeReason string `json:"stateChangeReason,omitempty"`
+
+	// MasterPublicDNSName is the public DNS name of the master node.
+	MasterPublicDNSName string `json:"masterPublicDnsName,omitempty"`
+
+	// AutoTerminate indicates whether the cluster terminates once its steps
+	// completed.
+	AutoTerminate bool `json:"autoTerminate,omitempty"`
+
+	// NormalizedInstanceHours is an approximation of the instance hours the
+	// cluster used.
+	NormalizedInstanceHours int64 `json:"normalizedInstanceHours,omitempty"`
+}
+
+// A ClusterSpec defines the desired state of a Cluster.
+type ClusterSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ClusterParameters `json:"forProvider"`
+}
+
+// A ClusterStatus represents the observed state of a Cluster.
+type ClusterStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Cluster is a managed resource that represents an AWS EMR cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterSpec   `json:"spec"`
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterList contains a list of Clusters
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cluster `json:"items"`
+}
@@ -0,0 +1,689 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Application) DeepCopyInto(out *Application) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Application.
+func (in *Application) DeepCopy() *Application {
+	if in == nil {
+		return nil
+	}
+	out := new(Application)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapAction) DeepCopyInto(out *BootstrapAction) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapAction.
+func (in *BootstrapAction) DeepCopy() *BootstrapAction {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cluster) DeepCopyInto(out *Cluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cluster.
+func (in *Cluster) DeepCopy() *Cluster {
+	if in == nil {
+		return nil
+	}
+	out := new(Cluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Cluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterInstances) DeepCopyInto(out *ClusterInstances) {
+	*out = *in
+	if in.InstanceGroups != nil {
+		in, out := &in.InstanceGroups, &out.InstanceGroups
+		*out = make([]InstanceGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InstanceFleets != nil {
+		in, out := &in.InstanceFleets, &out.InstanceFleets
+		*out = make([]InstanceFleet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EC2SubnetID != nil {
+		in, out := &in.EC2SubnetID, &out.EC2SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EC2SubnetIDRef != nil {
+		in, out := &in.EC2SubnetIDRef, &out.EC2SubnetIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.EC2SubnetIDSelector != nil {
+		in, out := &in.EC2SubnetIDSelector, &out.EC2SubnetIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EC2SubnetIDs != nil {
+		in, out := &in.EC2SubnetIDs, &out.EC2SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EC2KeyName != nil {
+		in, out := &in.EC2KeyName, &out.EC2KeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EMRManagedMasterSecurityGroup != nil {
+		in, out := &in.EMRManagedMasterSecurityGroup, &out.EMRManagedMasterSecurityGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.EMRManagedSlaveSecurityGroup != nil {
+		in, out := &in.EMRManagedSlaveSecurityGroup, &out.EMRManagedSlaveSecurityGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceAccessSecurityGroup != nil {
+		in, out := &in.ServiceAccessSecurityGroup, &out.ServiceAccessSecurityGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdditionalMasterSecurityGroups != nil {
+		in, out := &in.AdditionalMasterSecurityGroups, &out.AdditionalMasterSecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalSlaveSecurityGroups != nil {
+		in, out := &in.AdditionalSlaveSecurityGroups, &out.AdditionalSlaveSecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeepJobFlowAliveWhenNoSteps != nil {
+		in, out := &in.KeepJobFlowAliveWhenNoSteps, &out.KeepJobFlowAliveWhenNoSteps
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TerminationProtected != nil {
+		in, out := &in.TerminationProtected, &out.TerminationProtected
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterInstances.
+func (in *ClusterInstances) DeepCopy() *ClusterInstances {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterInstances)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Cluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
+func (in *ClusterList) DeepCopy() *ClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterObservation) DeepCopyInto(out *ClusterObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservation.
+func (in *ClusterObservation) DeepCopy() *ClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
+	*out = *in
+	in.Instances.DeepCopyInto(&out.Instances)
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = make([]Application, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BootstrapActions != nil {
+		in, out := &in.BootstrapActions, &out.BootstrapActions
+		*out = make([]BootstrapAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Configurations != nil {
+		in, out := &in.Configurations, &out.Configurations
+		*out = make([]Configuration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]Step, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceRole != nil {
+		in, out := &in.ServiceRole, &out.ServiceRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceRoleRef != nil {
+		in, out := &in.ServiceRoleRef, &out.ServiceRoleRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ServiceRoleSelector != nil {
+		in, out := &in.ServiceRoleSelector, &out.ServiceRoleSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JobFlowRole != nil {
+		in, out := &in.JobFlowRole, &out.JobFlowRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoScalingRole != nil {
+		in, out := &in.AutoScalingRole, &out.AutoScalingRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogURI != nil {
+		in, out := &in.LogURI, &out.LogURI
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityConfiguration != nil {
+		in, out := &in.SecurityConfiguration, &out.SecurityConfiguration
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomAMIID != nil {
+		in, out := &in.CustomAMIID, &out.CustomAMIID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EBSRootVolumeSize != nil {
+		in, out := &in.EBSRootVolumeSize, &out.EBSRootVolumeSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScaleDownBehavior != nil {
+		in, out := &in.ScaleDownBehavior, &out.ScaleDownBehavior
+		*out = new(string)
+		**out = **in
+	}
+	if in.ManagedScalingPolicy != nil {
+		in, out := &in.ManagedScalingPolicy, &out.ManagedScalingPolicy
+		*out = new(ManagedScalingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StepConcurrencyLevel != nil {
+		in, out := &in.StepConcurrencyLevel, &out.StepConcurrencyLevel
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VisibleToAllUsers != nil {
+		in, out := &in.VisibleToAllUsers, &out.VisibleToAllUsers
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterParameters.
+func (in *ClusterParameters) DeepCopy() *ClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
+func (in *ClusterSpec) DeepCopy() *ClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
+func (in *ClusterStatus) DeepCopy() *ClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeLimits) DeepCopyInto(out *ComputeLimits) {
+	*out = *in
+	if in.MaximumOnDemandCapacityUnits != nil {
+		in, out := &in.MaximumOnDemandCapacityUnits, &out.MaximumOnDemandCapacityUnits
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeLimits.
+func (in *ComputeLimits) DeepCopy() *ComputeLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Configuration) DeepCopyInto(out *Configuration) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Configuration.
+func (in *Configuration) DeepCopy() *Configuration {
+	if in == nil {
+		return nil
+	}
+	out := new(Configuration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EBSConfiguration) DeepCopyInto(out *EBSConfiguration) {
+	*out = *in
+	if in.EBSOptimized != nil {
+		in, out := &in.EBSOptimized, &out.EBSOptimized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]EBSVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSConfiguration.
+func (in *EBSConfiguration) DeepCopy() *EBSConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EBSConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EBSVolume) DeepCopyInto(out *EBSVolume) {
+	*out = *in
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VolumesPerInstance != nil {
+		in, out := &in.VolumesPerInstance, &out.VolumesPerInstance
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSVolume.
+func (in *EBSVolume) DeepCopy() *EBSVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(EBSVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HadoopJarStep) DeepCopyInto(out *HadoopJarStep) {
+	*out = *in
+	if in.MainClass != nil {
+		in, out := &in.MainClass, &out.MainClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HadoopJarStep.
+func (in *HadoopJarStep) DeepCopy() *HadoopJarStep {
+	if in == nil {
+		return nil
+	}
+	out := new(HadoopJarStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceFleet) DeepCopyInto(out *InstanceFleet) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetOnDemandCapacity != nil {
+		in, out := &in.TargetOnDemandCapacity, &out.TargetOnDemandCapacity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TargetSpotCapacity != nil {
+		in, out := &in.TargetSpotCapacity, &out.TargetSpotCapacity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.InstanceTypeConfigs != nil {
+		in, out := &in.InstanceTypeConfigs, &out.InstanceTypeConfigs
+		*out = make([]InstanceTypeConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SpotProvisioning != nil {
+		in, out := &in.SpotProvisioning, &out.SpotProvisioning
+		*out = new(SpotProvisioning)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceFleet.
+func (in *InstanceFleet) DeepCopy() *InstanceFleet {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceFleet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceGroup) DeepCopyInto(out *InstanceGroup) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Market != nil {
+		in, out := &in.Market, &out.Market
+		*out = new(string)
+		**out = **in
+	}
+	if in.BidPrice != nil {
+		in, out := &in.BidPrice, &out.BidPrice
+		*out = new(string)
+		**out = **in
+	}
+	if in.EBSConfiguration != nil {
+		in, out := &in.EBSConfiguration, &out.EBSConfiguration
+		*out = new(EBSConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceGroup.
+func (in *InstanceGroup) DeepCopy() *InstanceGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTypeConfig) DeepCopyInto(out *InstanceTypeConfig) {
+	*out = *in
+	if in.WeightedCapacity != nil {
+		in, out := &in.WeightedCapacity, &out.WeightedCapacity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BidPrice != nil {
+		in, out := &in.BidPrice, &out.BidPrice
+		*out = new(string)
+		**out = **in
+	}
+	if in.EBSConfiguration != nil {
+		in, out := &in.EBSConfiguration, &out.EBSConfiguration
+		*out = new(EBSConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTypeConfig.
+func (in *InstanceTypeConfig) DeepCopy() *InstanceTypeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTypeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedScalingPolicy) DeepCopyInto(out *ManagedScalingPolicy) {
+	*out = *in
+	in.ComputeLimits.DeepCopyInto(&out.ComputeLimits)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedScalingPolicy.
+func (in *ManagedScalingPolicy) DeepCopy() *ManagedScalingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedScalingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotProvisioning) DeepCopyInto(out *SpotProvisioning) {
+	*out = *in
+	if in.BlockDurationMinutes != nil {
+		in, out := &in.BlockDurationMinutes, &out.BlockDurationMinutes
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotProvisioning.
+func (in *SpotProvisioning) DeepCopy() *SpotProvisioning {
+	if in == nil {
+		return nil
+	}
+	out := new(SpotProvisioning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Step) DeepCopyInto(out *Step) {
+	*out = *in
+	if in.ActionOnFailure != nil {
+		in, out := &in.ActionOnFailure, &out.ActionOnFailure
+		*out = new(string)
+		**out = **in
+	}
+	in.HadoopJarStep.DeepCopyInto(&out.HadoopJarStep)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Step.
+func (in *Step) DeepCopy() *Step {
+	if in == nil {
+		return nil
+	}
+	out := new(Step)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
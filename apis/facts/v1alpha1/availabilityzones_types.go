@@ -0,0 +1,109 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// AvailabilityZonesParameters define the Availability Zones that an
+// AvailabilityZones observes.
+type AvailabilityZonesParameters struct {
+	// Region is the region whose Availability Zones are observed.
+	// +immutable
+	Region string `json:"region"`
+
+	// IncludeUnavailable includes the Availability Zones that are not
+	// available, for example because they are impaired or the account has
+	// not opted in to them.
+	// Default: false
+	// +optional
+	IncludeUnavailable *bool `json:"includeUnavailable,omitempty"`
+}
+
+// AvailabilityZoneObservation is the observed state of an Availability Zone.
+type AvailabilityZoneObservation struct {
+	// Name of the Availability Zone, for example us-east-1a. Names are mapped
+	// to different zones for each account.
+	Name string `json:"name"`
+
+	// ID of the Availability Zone, for example use1-az1. IDs identify the
+	// same zone across accounts.
+	ID string `json:"id"`
+
+	// State of the Availability Zone, for example available.
+	State string `json:"state,omitempty"`
+
+	// GroupName is the zone group of the Availability Zone, which is the
+	// region for regular Availability Zones.
+	GroupName string `json:"groupName,omitempty"`
+}
+
+// AvailabilityZonesObservation is the observed state of the Availability
+// Zones of a region.
+type AvailabilityZonesObservation struct {
+	// Names are the names of the Availability Zones in alphabetical order.
+	Names []string `json:"names,omitempty"`
+
+	// IDs are the IDs of the Availability Zones in the order of Names.
+	IDs []string `json:"ids,omitempty"`
+
+	// Zones are the Availability Zones in the order of Names.
+	Zones []AvailabilityZoneObservation `json:"zones,omitempty"`
+}
+
+// AvailabilityZonesSpec defines the desired state of an AvailabilityZones.
+type AvailabilityZonesSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AvailabilityZonesParameters `json:"forProvider"`
+}
+
+// AvailabilityZonesStatus represents the observed state of an
+// AvailabilityZones.
+type AvailabilityZonesStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AvailabilityZonesObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AvailabilityZones is a read-only managed resource that observes the
+// Availability Zones of a region of an AWS account.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AvailabilityZones struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AvailabilityZonesSpec   `json:"spec"`
+	Status AvailabilityZonesStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AvailabilityZonesList contains a list of AvailabilityZones
+type AvailabilityZonesList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AvailabilityZones `json:"items"`
+}
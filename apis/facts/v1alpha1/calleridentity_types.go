@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// CallerIdentityParameters define how a CallerIdentity observes the identity
+// of its ProviderConfig.
+type CallerIdentityParameters struct {
+	// Region of the AWS Security Token Service (STS) endpoint to use. It must
+	// be set for accounts outside of the aws partition, like aws-cn.
+	// Default: the global endpoint of the aws partition.
+	// +optional
+	Region *string `json:"region,omitempty"`
+}
+
+// CallerIdentityObservation is the identity that the ProviderConfig of the
+// CallerIdentity authenticates as.
+type CallerIdentityObservation struct {
+	// AccountID is the ID of the AWS account.
+	AccountID string `json:"accountId,omitempty"`
+
+	// ARN is the Amazon Resource Name (ARN) of the IAM user or role.
+	ARN string `json:"arn,omitempty"`
+
+	// UserID is the unique identifier of the IAM user or role.
+	UserID string `json:"userId,omitempty"`
+
+	// Partition is the AWS partition of the account, for example aws or
+	// aws-cn.
+	Partition string `json:"partition,omitempty"`
+}
+
+// CallerIdentitySpec defines the desired state of a CallerIdentity.
+type CallerIdentitySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CallerIdentityParameters `json:"forProvider,omitempty"`
+}
+
+// CallerIdentityStatus represents the observed state of a CallerIdentity.
+type CallerIdentityStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CallerIdentityObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CallerIdentity is a read-only managed resource that observes the AWS
+// account and the IAM identity of its ProviderConfig.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".status.atProvider.accountId"
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.arn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CallerIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CallerIdentitySpec   `json:"spec"`
+	Status CallerIdentityStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CallerIdentityList contains a list of CallerIdentity
+type CallerIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CallerIdentity `json:"items"`
+}
@@ -0,0 +1,23 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 contains managed resources that observe facts of an AWS
+// account, like its ID or its default VPC. They never create, update or
+// delete anything in AWS.
+// +kubebuilder:object:generate=true
+// +groupName=facts.aws.crossplane.io
+// +versionName=v1alpha1
+package v1alpha1
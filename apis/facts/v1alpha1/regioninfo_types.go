@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// RegionInfoParameters define the region that a RegionInfo observes.
+type RegionInfoParameters struct {
+	// Region is the region to observe.
+	// +immutable
+	Region string `json:"region"`
+}
+
+// RegionInfoObservation is the observed state of a region.
+type RegionInfoObservation struct {
+	// Partition is the AWS partition of the region, for example aws or
+	// aws-cn.
+	Partition string `json:"partition,omitempty"`
+
+	// Endpoint is the EC2 endpoint of the region.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// OptInStatus is the opt-in status of the region for the account, either
+	// opt-in-not-required, opted-in or not-opted-in.
+	OptInStatus string `json:"optInStatus,omitempty"`
+}
+
+// RegionInfoSpec defines the desired state of a RegionInfo.
+type RegionInfoSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  RegionInfoParameters `json:"forProvider"`
+}
+
+// RegionInfoStatus represents the observed state of a RegionInfo.
+type RegionInfoStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     RegionInfoObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RegionInfo is a read-only managed resource that observes a region of an
+// AWS account. It is only ready if the account can use the region.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="PARTITION",type="string",JSONPath=".status.atProvider.partition"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=regioninfos,scope=Cluster,categories={crossplane,managed,aws}
+type RegionInfo struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RegionInfoSpec   `json:"spec"`
+	Status RegionInfoStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RegionInfoList contains a list of RegionInfo
+type RegionInfoList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RegionInfo `json:"items"`
+}
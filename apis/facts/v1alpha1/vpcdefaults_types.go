@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// VPCDefaultsParameters define the region whose default VPC a VPCDefaults
+// observes.
+type VPCDefaultsParameters struct {
+	// Region is the region whose default VPC is observed.
+	// +immutable
+	Region string `json:"region"`
+}
+
+// VPCDefaultsObservation is the observed state of the default VPC of a
+// region.
+type VPCDefaultsObservation struct {
+	// VPCID is the ID of the default VPC.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// CIDRBlock is the primary IPv4 CIDR block of the default VPC.
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
+	// SubnetIDs are the IDs of the default subnets of the default VPC, one
+	// for each Availability Zone, ordered by Availability Zone.
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SecurityGroupID is the ID of the default security group of the default
+	// VPC.
+	SecurityGroupID string `json:"securityGroupId,omitempty"`
+}
+
+// VPCDefaultsSpec defines the desired state of a VPCDefaults.
+type VPCDefaultsSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VPCDefaultsParameters `json:"forProvider"`
+}
+
+// VPCDefaultsStatus represents the observed state of a VPCDefaults.
+type VPCDefaultsStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VPCDefaultsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCDefaults is a read-only managed resource that observes the default
+// VPC of a region of an AWS account. It is not ready if the region has no
+// default VPC.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".status.atProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCDefaults struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCDefaultsSpec   `json:"spec"`
+	Status VPCDefaultsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCDefaultsList contains a list of VPCDefaults
+type VPCDefaultsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCDefaults `json:"items"`
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag defines a key value pair that can be attached to a FileSystem.
+type Tag struct {
+	// Key is the name of the tag.
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// LustreConfiguration is the configuration of a Lustre file system.
+type LustreConfiguration struct {
+	// DeploymentType of the file system. Scratch file systems are meant for
+	// temporary storage, persistent ones replicate data within their
+	// Availability Zone.
+	// +crossplane:aws:model=fsx.CreateFileSystemLustreConfiguration.DeploymentType
+	// +kubebuilder:validation:Enum=SCRATCH_1;SCRATCH_2;PERSISTENT_1
+	// +optional
+	// +immutable
+	DeploymentType *string `json:"deploymentType,omitempty"`
+
+	// ImportPath is the path of an S3 bucket, e.g. s3://bucket/prefix, whose
+	// objects are loaded into the file system.
+	// +optional
+	// +immutable
+	ImportPath *string `json:"importPath,omitempty"`
+
+	// ExportPath is the path of the S3 bucket that new and changed files of
+	// the file system are exported to. It defaults to the import path.
+	// +optional
+	// +immutable
+	ExportPath *string `json:"exportPath,omitempty"`
+
+	// ImportedFileChunkSize is the stripe size in MiB of the files imported
+	// from the import path.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=512000
+	// +optional
+	// +immutable
+	ImportedFileChunkSize *int64 `json:"importedFileChunkSize,omitempty"`
+
+	// PerUnitStorageThroughput is the read and write throughput in MB/s/TiB
+	// of a persistent file system.
+	// +kubebuilder:validation:Enum=50;100;200
+	// +optional
+	// +immutable
+	PerUnitStorageThroughput *int64 `json:"perUnitStorageThroughput,omitempty"`
+
+	// WeeklyMaintenanceStartTime is the UTC start time of the weekly
+	// maintenance window in d:HH:MM format, where d is the day of the week
+	// from 1 (Monday) to 7 (Sunday).
+	// +kubebuilder:validation:Pattern=`^[1-7]:([01]\d|2[0-3]):[0-5]\d$`
+	// +optional
+	WeeklyMaintenanceStartTime *string `json:"weeklyMaintenanceStartTime,omitempty"`
+}
+
+// WindowsConfiguration is the configuration of a Windows File Server file
+// system.
+type WindowsConfiguration struct {
+	// ActiveDirectoryID is the ID of the AWS Managed Microsoft Active
+	// Directory that the file system joins.
+	// +optional
+	// +immutable
+	ActiveDirectoryID *string `json:"activeDirectoryId,omitempty"`
+
+	// DeploymentType of the file system.
+	// +crossplane:aws:model=fsx.CreateFileSystemWindowsConfiguration.DeploymentType
+	// +kubebuilder:validation:Enum=MULTI_AZ_1;SINGLE_AZ_1;SINGLE_AZ_2
+	// +optional
+	// +immutable
+	DeploymentType *string `json:"deploymentType,omitempty"`
+
+	// PreferredSubnetID is the subnet of the preferred file server of a
+	// MULTI_AZ_1 file system.
+	// +optional
+	// +immutable
+	PreferredSubnetID *string `json:"preferredSubnetId,omitempty"`
+
+	// ThroughputCapacity is the throughput of the file system in MB/s, a
+	// power of 2 from 8 to 2048.
+	// +kubebuilder:validation:Minimum=8
+	// +kubebuilder:validation:Maximum=2048
+	// +immutable
+	ThroughputCapacity int64 `json:"throughputCapacity"`
+
+	// AutomaticBackupRetentionDays is the number of days automatic backups
+	// are kept. 0 disables automatic backups.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=90
+	// +optional
+	AutomaticBackupRetentionDays *int64 `json:"automaticBackupRetentionDays,omitempty"`
+
+	// DailyAutomaticBackupStartTime is the UTC time of the daily automatic
+	// backup in HH:MM format.
+	// +kubebuilder:validation:Pattern=`^([01]\d|2[0-3]):[0-5]\d$`
+	// +optional
+	DailyAutomaticBackupStartTime *string `json:"dailyAutomaticBackupStartTime,omitempty"`
+
+	// CopyTagsToBackups copies the tags of the file system to its backups.
+	// +optional
+	// +immutable
+	CopyTagsToBackups *bool `json:"copyTagsToBackups,omitempty"`
+
+	// WeeklyMaintenanceStartTime is the UTC start time of the weekly
+	// maintenance window in d:HH:MM format, where d is the day of the week
+	// from 1 (Monday) to 7 (Sunday).
+	// +kubebuilder:validation:Pattern=`^[1-7]:([01]\d|2[0-3]):[0-5]\d$`
+	// +optional
+	WeeklyMaintenanceStartTime *string `json:"weeklyMaintenanceStartTime,omitempty"`
+}
+
+// FileSystemParameters define the desired state of an Amazon FSx file
+// system.
+type FileSystemParameters struct {
+	// Region is the region you'd like your FileSystem to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// FileSystemType is the type of the file system.
+	// +crossplane:aws:model=fsx.CreateFileSystemInput.FileSystemType
+	// +kubebuilder:validation:Enum=WINDOWS;LUSTRE
+	// +immutable
+	FileSystemType string `json:"fileSystemType"`
+
+	// StorageCapacity is the storage capacity of the file system in GiB.
+	// Lustre file systems support 1200, 2400 and multiples of 2400 or 3600,
+	// depending on their deployment type. Windows file systems support 32 to
+	// 65536 on SSD and 2000 to 65536 on HDD storage.
+	// +kubebuilder:validation:Minimum=32
+	// +immutable
+	StorageCapacity int64 `json:"storageCapacity"`
+
+	// StorageType is the type of the storage of the file system. HDD is only
+	// supported by Windows file systems.
+	// +crossplane:aws:model=fsx.CreateFileSystemInput.StorageType
+	// +kubebuilder:validation:Enum=SSD;HDD
+	// +optional
+	// +immutable
+	StorageType *string `json:"storageType,omitempty"`
+
+	// KMSKeyID is the ID of the KMS key used to encrypt the data of a
+	// persistent Lustre or a Windows file system.
+	// +optional
+	// +immutable
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets of the file system. Only
+	// MULTI_AZ_1 Windows file systems use two subnets, all others use one.
+	// +optional
+	// +immutable
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references Subnets to retrieve their SubnetIDs.
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets to retrieve their
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the network
+	// interfaces of the file system.
+	// +optional
+	// +immutable
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their
+	// SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// LustreConfiguration is the configuration of a LUSTRE file system.
+	// +optional
+	LustreConfiguration *LustreConfiguration `json:"lustreConfiguration,omitempty"`
+
+	// WindowsConfiguration is the configuration of a WINDOWS file system.
+	// +optional
+	WindowsConfiguration *WindowsConfiguration `json:"windowsConfiguration,omitempty"`
+
+	// Tags to assign to the file system.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A FileSystemSpec defines the desired state of a FileSystem.
+type FileSystemSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  FileSystemParameters `json:"forProvider"`
+}
+
+// FileSystemObservation keeps the state for the external resource.
+type FileSystemObservation struct {
+	// ARN of the file system.
+	ARN string `json:"arn,omitempty"`
+
+	// DNSName is the DNS name of the file system.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// MountName is the name a Lustre file system is mounted with.
+	MountName string `json:"mountName,omitempty"`
+
+	// Lifecycle is the state of the file system, i.e. AVAILABLE, CREATING,
+	// FAILED, DELETING, MISCONFIGURED or UPDATING.
+	Lifecycle string `json:"lifecycle,omitempty"`
+
+	// VPCID is the ID of the VPC of the file system.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// NetworkInterfaceIDs are the IDs of the elastic network interfaces the
+	// file system is accessed through.
+	NetworkInterfaceIDs []string `json:"networkInterfaceIds,omitempty"`
+
+	// OwnerID is the ID of the AWS account that owns the file system.
+	OwnerID string `json:"ownerId,omitempty"`
+}
+
+// A FileSystemStatus represents the observed state of a FileSystem.
+type FileSystemStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     FileSystemObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FileSystem is a managed resource that represents an Amazon FSx for Lustre
+// or Windows File Server file system. Its external name is the ID of the file
+// system. The DNS name of the file system is published to the connection
+// secret as endpoint and the mount name of a Lustre file system as
+// mountName.
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.fileSystemType"
+// +kubebuilder:printcolumn:name="DNSNAME",type="string",JSONPath=".status.atProvider.dnsName"
+// +kubebuilder:printcolumn:name="LIFECYCLE",type="string",JSONPath=".status.atProvider.lifecycle"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type FileSystem struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FileSystemSpec   `json:"spec"`
+	Status FileSystemStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FileSystemList contains a list of FileSystems
+type FileSystemList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FileSystem `json:"items"`
+}
@@ -22,12 +22,21 @@ limitations under the License.
 // Remove existing CRDs
 //go:generate rm -rf ../package/crds
 
+// Derive validation markers of fields with model markers from the AWS API models
+//go:generate go run ../cmd/modelmarkers --apis .
+
 // Generate deepcopy methodsets and CRD manifests
-//go:generate go run -tags generate sigs.k8s.io/controller-tools/cmd/controller-gen object:headerFile=../hack/boilerplate.go.txt paths=./... crd:trivialVersions=true output:artifacts:config=../package/crds
+//go:generate go run -tags generate sigs.k8s.io/controller-tools/cmd/controller-gen object:headerFile=../hack/boilerplate.go.txt paths=./... crd:trivialVersions=true,preserveUnknownFields=false output:artifacts:config=../package/crds
+
+// Generate the validating webhook configuration from the webhook markers
+//go:generate go run -tags generate sigs.k8s.io/controller-tools/cmd/controller-gen webhook paths=./... output:webhook:artifacts:config=../cluster/webhook
 
 // Generate crossplane-runtime methodsets (resource.Claim, etc)
 //go:generate go run -tags generate github.com/crossplane/crossplane-tools/cmd/angryjet generate-methodsets --header-file=../hack/boilerplate.go.txt ./...
 
+// Generate an example of each managed resource kind from its CRD manifest
+//go:generate go run ../cmd/examples --crds ../package/crds --output ../examples/generated
+
 package apis
 
 import (
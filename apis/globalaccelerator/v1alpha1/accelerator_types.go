@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Global Accelerator accelerator states.
+const (
+	// The accelerator is deployed to the AWS edge network.
+	AcceleratorStatusDeployed = "DEPLOYED"
+	// A change of the accelerator is being deployed.
+	AcceleratorStatusInProgress = "IN_PROGRESS"
+)
+
+// Tag is a key-value pair that is attached to a Global Accelerator resource.
+type Tag struct {
+	// Key is the name of the tag.
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// AcceleratorParameters define the desired state of an AWS Global
+// Accelerator accelerator. Accelerators are global resources, so they have no
+// region.
+type AcceleratorParameters struct {
+	// Name of the accelerator. It can contain only alphanumeric characters
+	// and hyphens, and can't begin or end with a hyphen.
+	// +kubebuilder:validation:MaxLength=32
+	Name string `json:"name"`
+
+	// Enabled indicates whether the accelerator accepts traffic. An
+	// accelerator must be disabled before it can be deleted, which is done
+	// automatically when the Accelerator is deleted.
+	// Default: true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// IPAddressType is the type of the static IP addresses of the
+	// accelerator.
+	// Default: IPV4
+	// +kubebuilder:validation:Enum=IPV4
+	// +optional
+	IPAddressType *string `json:"ipAddressType,omitempty"`
+
+	// IPAddresses are up to two static IP addresses from your own IP address
+	// pools (BYOIP) to use for the accelerator. Amazon provided static IP
+	// addresses are used if it is not set.
+	// +kubebuilder:validation:MaxItems=2
+	// +immutable
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+
+	// Tags to assign to the accelerator.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// IPSet is a set of static IP addresses of an accelerator.
+type IPSet struct {
+	// IPFamily is the type of the IP addresses, for example IPv4.
+	IPFamily string `json:"ipFamily,omitempty"`
+
+	// IPAddresses are the static IP addresses of the set.
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+}
+
+// AcceleratorObservation is the representation of the current state that is
+// observed.
+type AcceleratorObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the accelerator.
+	ARN string `json:"arn,omitempty"`
+
+	// DNSName is the DNS name of the accelerator that points to its static
+	// IP addresses.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// Status is the current state of the accelerator.
+	Status string `json:"status,omitempty"`
+
+	// IPSets are the static IP addresses of the accelerator.
+	IPSets []IPSet `json:"ipSets,omitempty"`
+}
+
+// AcceleratorSpec defines the desired state of an AWS Global Accelerator
+// Accelerator.
+type AcceleratorSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AcceleratorParameters `json:"forProvider"`
+}
+
+// AcceleratorStatus represents the observed state of an AWS Global
+// Accelerator Accelerator.
+type AcceleratorStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AcceleratorObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Accelerator is a managed resource that represents an AWS Global
+// Accelerator accelerator, which directs traffic that it receives on its
+// static anycast IP addresses to the endpoints of its listeners.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="DNS",type="string",JSONPath=".status.atProvider.dnsName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Accelerator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AcceleratorSpec   `json:"spec"`
+	Status AcceleratorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AcceleratorList contains a list of Accelerator
+type AcceleratorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Accelerator `json:"items"`
+}
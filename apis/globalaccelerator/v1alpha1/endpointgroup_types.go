@@ -0,0 +1,199 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// EndpointConfiguration is an endpoint of an endpoint group, which is either
+// an Application Load Balancer, a Network Load Balancer, an Elastic IP
+// address or an EC2 instance.
+type EndpointConfiguration struct {
+	// EndpointID is the ARN of the load balancer, the allocation ID of the
+	// Elastic IP address or the ID of the EC2 instance.
+	// +optional
+	EndpointID *string `json:"endpointId,omitempty"`
+
+	// EndpointIDRef is a reference to a LoadBalancer used to set EndpointID.
+	// +optional
+	EndpointIDRef *runtimev1alpha1.Reference `json:"endpointIdRef,omitempty"`
+
+	// EndpointIDSelector selects a reference to a LoadBalancer used to set
+	// EndpointID.
+	// +optional
+	EndpointIDSelector *runtimev1alpha1.Selector `json:"endpointIdSelector,omitempty"`
+
+	// Weight of the endpoint, which determines its share of the traffic of
+	// the endpoint group relative to the other endpoints.
+	// Default: 128
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	// +optional
+	Weight *int64 `json:"weight,omitempty"`
+
+	// ClientIPPreservationEnabled indicates whether the IP addresses of
+	// clients are preserved for an Application Load Balancer endpoint.
+	// +optional
+	ClientIPPreservationEnabled *bool `json:"clientIPPreservationEnabled,omitempty"`
+}
+
+// EndpointGroupParameters define the desired state of an AWS Global
+// Accelerator endpoint group.
+type EndpointGroupParameters struct {
+	// ListenerARN is the ARN of the listener of the endpoint group.
+	// +immutable
+	// +optional
+	ListenerARN *string `json:"listenerArn,omitempty"`
+
+	// ListenerARNRef is a reference to a Listener used to set ListenerARN.
+	// +immutable
+	// +optional
+	ListenerARNRef *runtimev1alpha1.Reference `json:"listenerArnRef,omitempty"`
+
+	// ListenerARNSelector selects a reference to a Listener used to set
+	// ListenerARN.
+	// +immutable
+	// +optional
+	ListenerARNSelector *runtimev1alpha1.Selector `json:"listenerArnSelector,omitempty"`
+
+	// EndpointGroupRegion is the region of the endpoints of the endpoint
+	// group. A listener can have only one endpoint group per region.
+	// +immutable
+	EndpointGroupRegion string `json:"endpointGroupRegion"`
+
+	// EndpointConfigurations are the endpoints of the endpoint group.
+	// +optional
+	EndpointConfigurations []EndpointConfiguration `json:"endpointConfigurations,omitempty"`
+
+	// NOTE: Type of TrafficDialPercentage is float64 in AWS SDK but float is
+	// not supported by controller-runtime. Whole percentages suffice to shift
+	// traffic between regions.
+	// See https://github.com/kubernetes-sigs/controller-tools/issues/245
+
+	// TrafficDialPercentage is the percentage of the traffic of the listener
+	// that is directed to the endpoint group. Lower it to shift traffic to
+	// the endpoint groups of other regions, for example during a deployment.
+	// Default: 100
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	TrafficDialPercentage *int64 `json:"trafficDialPercentage,omitempty"`
+
+	// HealthCheckProtocol is the protocol of the health checks of the
+	// endpoints.
+	// Default: TCP
+	// +kubebuilder:validation:Enum=TCP;HTTP;HTTPS
+	// +optional
+	HealthCheckProtocol *string `json:"healthCheckProtocol,omitempty"`
+
+	// HealthCheckPort is the port of the health checks of the endpoints.
+	// Default: the port of the listener.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	HealthCheckPort *int64 `json:"healthCheckPort,omitempty"`
+
+	// HealthCheckPath is the path of HTTP and HTTPS health checks.
+	// Default: /
+	// +optional
+	HealthCheckPath *string `json:"healthCheckPath,omitempty"`
+
+	// HealthCheckIntervalSeconds is the time between the health checks of
+	// an endpoint.
+	// Default: 30
+	// +kubebuilder:validation:Enum=10;30
+	// +optional
+	HealthCheckIntervalSeconds *int64 `json:"healthCheckIntervalSeconds,omitempty"`
+
+	// ThresholdCount is the number of consecutive health checks that are
+	// required to change the health state of an endpoint.
+	// Default: 3
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	ThresholdCount *int64 `json:"thresholdCount,omitempty"`
+}
+
+// EndpointObservation is the observed state of an endpoint of an endpoint
+// group.
+type EndpointObservation struct {
+	// EndpointID is the ID of the endpoint.
+	EndpointID string `json:"endpointId,omitempty"`
+
+	// HealthState is the health of the endpoint.
+	HealthState string `json:"healthState,omitempty"`
+
+	// HealthReason is the reason of an unhealthy or initial health state.
+	HealthReason string `json:"healthReason,omitempty"`
+}
+
+// EndpointGroupObservation is the representation of the current state that
+// is observed.
+type EndpointGroupObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the endpoint group.
+	ARN string `json:"arn,omitempty"`
+
+	// Endpoints are the observed states of the endpoints of the endpoint
+	// group.
+	Endpoints []EndpointObservation `json:"endpoints,omitempty"`
+}
+
+// EndpointGroupSpec defines the desired state of an AWS Global Accelerator
+// EndpointGroup.
+type EndpointGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  EndpointGroupParameters `json:"forProvider"`
+}
+
+// EndpointGroupStatus represents the observed state of an AWS Global
+// Accelerator EndpointGroup.
+type EndpointGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     EndpointGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EndpointGroup is a managed resource that represents an AWS Global
+// Accelerator endpoint group, which directs the traffic of a listener to the
+// endpoints of a region.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.endpointGroupRegion"
+// +kubebuilder:printcolumn:name="DIAL",type="integer",JSONPath=".spec.forProvider.trafficDialPercentage"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type EndpointGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EndpointGroupSpec   `json:"spec"`
+	Status EndpointGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EndpointGroupList contains a list of EndpointGroup
+type EndpointGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EndpointGroup `json:"items"`
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// PortRange is a range of ports that a listener accepts traffic on.
+type PortRange struct {
+	// FromPort is the first port of the range.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	FromPort int64 `json:"fromPort"`
+
+	// ToPort is the last port of the range.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	ToPort int64 `json:"toPort"`
+}
+
+// ListenerParameters define the desired state of an AWS Global Accelerator
+// listener.
+type ListenerParameters struct {
+	// AcceleratorARN is the ARN of the accelerator of the listener.
+	// +immutable
+	// +optional
+	AcceleratorARN *string `json:"acceleratorArn,omitempty"`
+
+	// AcceleratorARNRef is a reference to an Accelerator used to set
+	// AcceleratorARN.
+	// +immutable
+	// +optional
+	AcceleratorARNRef *runtimev1alpha1.Reference `json:"acceleratorArnRef,omitempty"`
+
+	// AcceleratorARNSelector selects a reference to an Accelerator used to
+	// set AcceleratorARN.
+	// +immutable
+	// +optional
+	AcceleratorARNSelector *runtimev1alpha1.Selector `json:"acceleratorArnSelector,omitempty"`
+
+	// Protocol is the protocol of the connections from clients to the
+	// accelerator.
+	// +kubebuilder:validation:Enum=TCP;UDP
+	Protocol string `json:"protocol"`
+
+	// PortRanges are the ranges of ports that the listener accepts traffic
+	// on.
+	// +kubebuilder:validation:MinItems=1
+	PortRanges []PortRange `json:"portRanges"`
+
+	// ClientAffinity determines whether the connections of a client are
+	// always directed to the same endpoint. SOURCE_IP directs all
+	// connections from the same source IP address to the same endpoint.
+	// Default: NONE
+	// +kubebuilder:validation:Enum=NONE;SOURCE_IP
+	// +optional
+	ClientAffinity *string `json:"clientAffinity,omitempty"`
+}
+
+// ListenerObservation is the representation of the current state that is
+// observed.
+type ListenerObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the listener.
+	ARN string `json:"arn,omitempty"`
+}
+
+// ListenerSpec defines the desired state of an AWS Global Accelerator
+// Listener.
+type ListenerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ListenerParameters `json:"forProvider"`
+}
+
+// ListenerStatus represents the observed state of an AWS Global Accelerator
+// Listener.
+type ListenerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ListenerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Listener is a managed resource that represents an AWS Global Accelerator
+// listener, which processes the connections to a range of ports of an
+// accelerator.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROTOCOL",type="string",JSONPath=".spec.forProvider.protocol"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Listener struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ListenerSpec   `json:"spec"`
+	Status ListenerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerList contains a list of Listener
+type ListenerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Listener `json:"items"`
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	elbv2 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+// ResolveReferences of this Listener
+func (mg *Listener) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.acceleratorArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AcceleratorARN),
+		Reference:    mg.Spec.ForProvider.AcceleratorARNRef,
+		Selector:     mg.Spec.ForProvider.AcceleratorARNSelector,
+		To:           reference.To{Managed: &Accelerator{}, List: &AcceleratorList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.acceleratorArn")
+	}
+	mg.Spec.ForProvider.AcceleratorARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AcceleratorARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this EndpointGroup
+func (mg *EndpointGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.listenerArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ListenerARN),
+		Reference:    mg.Spec.ForProvider.ListenerARNRef,
+		Selector:     mg.Spec.ForProvider.ListenerARNSelector,
+		To:           reference.To{Managed: &Listener{}, List: &ListenerList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.listenerArn")
+	}
+	mg.Spec.ForProvider.ListenerARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ListenerARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.endpointConfigurations[].endpointId
+	for i := range mg.Spec.ForProvider.EndpointConfigurations {
+		ec := &mg.Spec.ForProvider.EndpointConfigurations[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(ec.EndpointID),
+			Reference:    ec.EndpointIDRef,
+			Selector:     ec.EndpointIDSelector,
+			To:           reference.To{Managed: &elbv2.LoadBalancer{}, List: &elbv2.LoadBalancerList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.endpointConfigurations[%d].endpointId", i)
+		}
+		ec.EndpointID = reference.ToPtrValue(rsp.ResolvedValue)
+		ec.EndpointIDRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
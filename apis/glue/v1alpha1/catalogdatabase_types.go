@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// CatalogDatabaseParameters define the desired state of a database in the
+// AWS Glue Data Catalog.
+type CatalogDatabaseParameters struct {
+	// Region is the region you'd like your CatalogDatabase to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// CatalogID is the ID of the Data Catalog in which to create the
+	// database. Defaults to the AWS account ID.
+	// +immutable
+	// +optional
+	CatalogID *string `json:"catalogId,omitempty"`
+
+	// Description of the database.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// LocationURI is the location of the database, for example an HDFS path.
+	// +optional
+	LocationURI *string `json:"locationUri,omitempty"`
+
+	// Parameters are key-value pairs that define parameters and properties of
+	// the database.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// CatalogDatabaseObservation is the observed state of a CatalogDatabase.
+type CatalogDatabaseObservation struct {
+	// CreateTime is the time at which the database was created.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+}
+
+// A CatalogDatabaseSpec defines the desired state of a CatalogDatabase.
+type CatalogDatabaseSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CatalogDatabaseParameters `json:"forProvider"`
+}
+
+// A CatalogDatabaseStatus represents the observed state of a
+// CatalogDatabase.
+type CatalogDatabaseStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CatalogDatabaseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CatalogDatabase is a managed resource that represents a database in the
+// AWS Glue Data Catalog. Its external name is the name of the database.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CatalogDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CatalogDatabaseSpec   `json:"spec"`
+	Status CatalogDatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CatalogDatabaseList contains a list of CatalogDatabases
+type CatalogDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CatalogDatabase `json:"items"`
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// S3Target specifies an Amazon S3 path to crawl.
+type S3Target struct {
+	// Path to the Amazon S3 target, such as s3://bucket/prefix/.
+	Path string `json:"path"`
+
+	// Exclusions is a list of glob patterns used to exclude objects from the
+	// crawl.
+	// +optional
+	Exclusions []string `json:"exclusions,omitempty"`
+}
+
+// CrawlerTargets specify the data stores to crawl.
+type CrawlerTargets struct {
+	// S3Targets specify the Amazon S3 paths to crawl.
+	// +kubebuilder:validation:MinItems=1
+	S3Targets []S3Target `json:"s3Targets"`
+}
+
+// SchemaChangePolicy specifies how the crawler handles changes to the schema
+// of the crawled data.
+type SchemaChangePolicy struct {
+	// UpdateBehavior is the behavior when the crawler finds a changed
+	// schema.
+	// +kubebuilder:validation:Enum=LOG;UPDATE_IN_DATABASE
+	// +optional
+	UpdateBehavior *string `json:"updateBehavior,omitempty"`
+
+	// DeleteBehavior is the behavior when the crawler finds a deleted
+	// object.
+	// +kubebuilder:validation:Enum=LOG;DELETE_FROM_DATABASE;DEPRECATE_IN_DATABASE
+	// +optional
+	DeleteBehavior *string `json:"deleteBehavior,omitempty"`
+}
+
+// CrawlerParameters define the desired state of an AWS Glue crawler.
+type CrawlerParameters struct {
+	// Region is the region you'd like your Crawler to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// RoleARN is the ARN of the IAM role the crawler uses to access customer
+	// resources.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNRef *runtimev1alpha1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNSelector *runtimev1alpha1.Selector `json:"roleArnSelector,omitempty"`
+
+	// DatabaseName is the Glue database where results are written.
+	// +optional
+	DatabaseName *string `json:"databaseName,omitempty"`
+
+	// DatabaseNameRef references a CatalogDatabase to retrieve its name.
+	// +optional
+	DatabaseNameRef *runtimev1alpha1.Reference `json:"databaseNameRef,omitempty"`
+
+	// DatabaseNameSelector selects a reference to a CatalogDatabase to
+	// retrieve its name.
+	// +optional
+	DatabaseNameSelector *runtimev1alpha1.Selector `json:"databaseNameSelector,omitempty"`
+
+	// Targets are the data stores to crawl.
+	Targets CrawlerTargets `json:"targets"`
+
+	// Description of the crawler.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Schedule is a cron expression used to specify the schedule of the
+	// crawler, such as cron(15 12 * * ? *).
+	// +optional
+	Schedule *string `json:"schedule,omitempty"`
+
+	// SchemaChangePolicy is the update and delete behavior of the crawler.
+	// +optional
+	SchemaChangePolicy *SchemaChangePolicy `json:"schemaChangePolicy,omitempty"`
+
+	// TablePrefix is the prefix added to the names of tables that are
+	// created.
+	// +optional
+	TablePrefix *string `json:"tablePrefix,omitempty"`
+
+	// Classifiers is a list of custom classifier names.
+	// +optional
+	Classifiers []string `json:"classifiers,omitempty"`
+
+	// Configuration is the crawler configuration as a JSON string.
+	// +optional
+	Configuration *string `json:"configuration,omitempty"`
+
+	// CrawlerSecurityConfiguration is the name of the security configuration
+	// used by the crawler.
+	// +optional
+	CrawlerSecurityConfiguration *string `json:"crawlerSecurityConfiguration,omitempty"`
+
+	// Tags to assign to the crawler when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// CrawlerObservation is the observed state of a Crawler.
+type CrawlerObservation struct {
+	// State of the crawler.
+	State string `json:"state,omitempty"`
+
+	// ScheduleState is the state of the schedule of the crawler.
+	ScheduleState string `json:"scheduleState,omitempty"`
+
+	// Version of the crawler.
+	Version int64 `json:"version,omitempty"`
+}
+
+// A CrawlerSpec defines the desired state of a Crawler.
+type CrawlerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CrawlerParameters `json:"forProvider"`
+}
+
+// A CrawlerStatus represents the observed state of a Crawler.
+type CrawlerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CrawlerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Crawler is a managed resource that represents an AWS Glue crawler. Its
+// external name is the name of the crawler.
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Crawler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CrawlerSpec   `json:"spec"`
+	Status CrawlerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CrawlerList contains a list of Crawlers
+type CrawlerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Crawler `json:"items"`
+}
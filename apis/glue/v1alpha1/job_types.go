@@ -0,0 +1,190 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// JobCommand specifies the code executed when a job is run.
+type JobCommand struct {
+	// Name of the job command: glueetl for an Apache Spark ETL job,
+	// pythonshell for a Python shell job or gluestreaming for a streaming
+	// ETL job.
+	// +kubebuilder:validation:Enum=glueetl;pythonshell;gluestreaming
+	Name string `json:"name"`
+
+	// PythonVersion is the Python version used to run the script, 2 or 3.
+	// +kubebuilder:validation:Enum="2";"3"
+	// +optional
+	PythonVersion *string `json:"pythonVersion,omitempty"`
+
+	// ScriptLocation is the Amazon S3 path to the script, such as
+	// s3://bucket/scripts/job.py. It takes precedence over ScriptBucket and
+	// ScriptKey.
+	// +optional
+	ScriptLocation *string `json:"scriptLocation,omitempty"`
+
+	// ScriptBucket is the name of the Amazon S3 bucket that holds the
+	// script. It is used together with ScriptKey when ScriptLocation is not
+	// set.
+	// +optional
+	ScriptBucket *string `json:"scriptBucket,omitempty"`
+
+	// ScriptBucketRef references a Bucket to retrieve its name.
+	// +optional
+	ScriptBucketRef *runtimev1alpha1.Reference `json:"scriptBucketRef,omitempty"`
+
+	// ScriptBucketSelector selects a reference to a Bucket to retrieve its
+	// name.
+	// +optional
+	ScriptBucketSelector *runtimev1alpha1.Selector `json:"scriptBucketSelector,omitempty"`
+
+	// ScriptKey is the key of the script in ScriptBucket, such as
+	// scripts/job.py.
+	// +optional
+	ScriptKey *string `json:"scriptKey,omitempty"`
+}
+
+// JobParameters define the desired state of an AWS Glue job.
+type JobParameters struct {
+	// Region is the region you'd like your Job to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// RoleARN is the ARN of the IAM role associated with the job.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNRef *runtimev1alpha1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNSelector *runtimev1alpha1.Selector `json:"roleArnSelector,omitempty"`
+
+	// Command is the code executed by the job.
+	Command JobCommand `json:"command"`
+
+	// Description of the job.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Connections are the names of the Glue connections used by the job.
+	// +optional
+	Connections []string `json:"connections,omitempty"`
+
+	// DefaultArguments are the default arguments passed to the job script.
+	// +optional
+	DefaultArguments map[string]string `json:"defaultArguments,omitempty"`
+
+	// NonOverridableArguments are arguments passed to the job script that
+	// can't be overridden when the job is run.
+	// +optional
+	NonOverridableArguments map[string]string `json:"nonOverridableArguments,omitempty"`
+
+	// GlueVersion determines the versions of Apache Spark and Python that
+	// the job uses, such as 2.0.
+	// +optional
+	GlueVersion *string `json:"glueVersion,omitempty"`
+
+	// WorkerType is the type of predefined worker allocated when the job
+	// runs.
+	// +kubebuilder:validation:Enum=Standard;G.1X;G.2X
+	// +optional
+	WorkerType *string `json:"workerType,omitempty"`
+
+	// NumberOfWorkers is the number of workers of WorkerType allocated when
+	// the job runs.
+	// +optional
+	NumberOfWorkers *int64 `json:"numberOfWorkers,omitempty"`
+
+	// MaxConcurrentRuns is the maximum number of concurrent runs allowed for
+	// the job.
+	// +optional
+	MaxConcurrentRuns *int64 `json:"maxConcurrentRuns,omitempty"`
+
+	// MaxRetries is the maximum number of times to retry the job if it
+	// fails.
+	// +optional
+	MaxRetries *int64 `json:"maxRetries,omitempty"`
+
+	// Timeout is the job timeout in minutes.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Timeout *int64 `json:"timeout,omitempty"`
+
+	// SecurityConfiguration is the name of the security configuration used
+	// by the job.
+	// +optional
+	SecurityConfiguration *string `json:"securityConfiguration,omitempty"`
+
+	// Tags to assign to the job when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// JobObservation is the observed state of a Job.
+type JobObservation struct {
+	// CreatedOn is the time at which the job was created.
+	CreatedOn *metav1.Time `json:"createdOn,omitempty"`
+
+	// LastModifiedOn is the last time the job was modified.
+	LastModifiedOn *metav1.Time `json:"lastModifiedOn,omitempty"`
+}
+
+// A JobSpec defines the desired state of a Job.
+type JobSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  JobParameters `json:"forProvider"`
+}
+
+// A JobStatus represents the observed state of a Job.
+type JobStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     JobObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Job is a managed resource that represents an AWS Glue job. Its external
+// name is the name of the job.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Job struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JobSpec   `json:"spec"`
+	Status JobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JobList contains a list of Jobs
+type JobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Job `json:"items"`
+}
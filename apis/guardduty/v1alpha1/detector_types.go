@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag represents user-provided metadata that can be associated
+// with a GuardDuty resource.
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// DetectorParameters define the desired state of an AWS GuardDuty detector.
+type DetectorParameters struct {
+	// Region is the region the detector is enabled in. Only one detector
+	// can exist per account and region.
+	// +immutable
+	Region string `json:"region"`
+
+	// Enable specifies whether the detector is enabled. A disabled detector
+	// keeps its configuration but stops producing findings.
+	Enable bool `json:"enable"`
+
+	// FindingPublishingFrequency specifies how frequently updated findings
+	// are exported to CloudWatch Events.
+	// +crossplane:aws:model=guardduty.CreateDetectorInput.FindingPublishingFrequency
+	// +kubebuilder:validation:Enum=FIFTEEN_MINUTES;ONE_HOUR;SIX_HOURS
+	// +optional
+	FindingPublishingFrequency *string `json:"findingPublishingFrequency,omitempty"`
+
+	// Tags to be added to the detector on creation.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// DetectorObservation keeps the state for the external resource
+type DetectorObservation struct {
+	// ServiceRole is the GuardDuty service role of the detector.
+	ServiceRole string `json:"serviceRole,omitempty"`
+
+	// Status is the current status of the detector.
+	Status string `json:"status,omitempty"`
+
+	// CreatedAt is the timestamp of when the detector was created.
+	CreatedAt string `json:"createdAt,omitempty"`
+}
+
+// A DetectorSpec defines the desired state of a Detector.
+type DetectorSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DetectorParameters `json:"forProvider"`
+}
+
+// A DetectorStatus represents the observed state of a Detector.
+type DetectorStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DetectorObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Detector is a managed resource that represents an AWS GuardDuty detector.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Detector struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DetectorSpec   `json:"spec"`
+	Status DetectorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DetectorList contains a list of Detectors
+type DetectorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Detector `json:"items"`
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// MemberParameters define the desired state of an AWS GuardDuty member
+// account.
+type MemberParameters struct {
+	// Region is the region of the master detector.
+	// +immutable
+	Region string `json:"region"`
+
+	// DetectorID is the ID of the master detector that the member account is
+	// added to.
+	// +immutable
+	// +optional
+	DetectorID *string `json:"detectorId,omitempty"`
+
+	// DetectorIDRef references a Detector to retrieve its ID.
+	// +optional
+	DetectorIDRef *runtimev1alpha1.Reference `json:"detectorIdRef,omitempty"`
+
+	// DetectorIDSelector selects a reference to a Detector to retrieve its
+	// ID.
+	// +optional
+	DetectorIDSelector *runtimev1alpha1.Selector `json:"detectorIdSelector,omitempty"`
+
+	// AccountID is the ID of the AWS account to add as a member.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// Email is the email address of the root user of the member account.
+	// +immutable
+	Email string `json:"email"`
+
+	// Invite specifies whether an invitation is sent to the member account
+	// once it has been added.
+	// +optional
+	Invite *bool `json:"invite,omitempty"`
+
+	// DisableEmailNotification specifies whether the invitation email to the
+	// member account is suppressed.
+	// +optional
+	DisableEmailNotification *bool `json:"disableEmailNotification,omitempty"`
+
+	// InvitationMessage is the message included in the invitation email.
+	// +optional
+	InvitationMessage *string `json:"invitationMessage,omitempty"`
+}
+
+// MemberObservation keeps the state for the external resource
+type MemberObservation struct {
+	// RelationshipStatus is the status of the relationship between the
+	// member account and its master account.
+	RelationshipStatus string `json:"relationshipStatus,omitempty"`
+
+	// MasterID is the ID of the master account.
+	MasterID string `json:"masterId,omitempty"`
+
+	// InvitedAt is the timestamp of when the invitation was sent.
+	InvitedAt string `json:"invitedAt,omitempty"`
+}
+
+// A MemberSpec defines the desired state of a Member.
+type MemberSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  MemberParameters `json:"forProvider"`
+}
+
+// A MemberStatus represents the observed state of a Member.
+type MemberStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     MemberObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Member is a managed resource that represents a member account of an AWS GuardDuty master detector.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT-ID",type="string",JSONPath=".spec.forProvider.accountId"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.relationshipStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Member struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MemberSpec   `json:"spec"`
+	Status MemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MemberList contains a list of Members
+type MemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Member `json:"items"`
+}
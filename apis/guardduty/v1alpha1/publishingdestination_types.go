@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// PublishingDestinationParameters define the desired state of an AWS
+// GuardDuty publishing destination.
+type PublishingDestinationParameters struct {
+	// Region is the region of the detector.
+	// +immutable
+	Region string `json:"region"`
+
+	// DetectorID is the ID of the detector whose findings are exported.
+	// +immutable
+	// +optional
+	DetectorID *string `json:"detectorId,omitempty"`
+
+	// DetectorIDRef references a Detector to retrieve its ID.
+	// +optional
+	DetectorIDRef *runtimev1alpha1.Reference `json:"detectorIdRef,omitempty"`
+
+	// DetectorIDSelector selects a reference to a Detector to retrieve its
+	// ID.
+	// +optional
+	DetectorIDSelector *runtimev1alpha1.Selector `json:"detectorIdSelector,omitempty"`
+
+	// DestinationType is the type of the resource the findings are exported
+	// to.
+	// +crossplane:aws:model=guardduty.CreatePublishingDestinationInput.DestinationType
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Enum=S3
+	// +immutable
+	DestinationType string `json:"destinationType"`
+
+	// DestinationARN is the ARN of the resource the findings are exported
+	// to, e.g. the ARN of an S3 bucket, optionally followed by a prefix.
+	// +optional
+	DestinationARN *string `json:"destinationArn,omitempty"`
+
+	// DestinationARNRef references a Bucket to retrieve its ARN.
+	// +optional
+	DestinationARNRef *runtimev1alpha1.Reference `json:"destinationArnRef,omitempty"`
+
+	// DestinationARNSelector selects a reference to a Bucket to retrieve its
+	// ARN.
+	// +optional
+	DestinationARNSelector *runtimev1alpha1.Selector `json:"destinationArnSelector,omitempty"`
+
+	// KMSKeyARN is the ARN of the KMS key that is used to encrypt the
+	// exported findings.
+	KMSKeyARN string `json:"kmsKeyArn"`
+}
+
+// PublishingDestinationObservation keeps the state for the external resource
+type PublishingDestinationObservation struct {
+	// Status is the status of the publishing destination, e.g. PUBLISHING
+	// or UNABLE_TO_PUBLISH_FIX_DESTINATION_PROPERTY.
+	Status string `json:"status,omitempty"`
+
+	// PublishingFailureStartTimestamp is the time, in epoch milliseconds,
+	// since which findings could not be exported to the destination.
+	PublishingFailureStartTimestamp int64 `json:"publishingFailureStartTimestamp,omitempty"`
+}
+
+// A PublishingDestinationSpec defines the desired state of a
+// PublishingDestination.
+type PublishingDestinationSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  PublishingDestinationParameters `json:"forProvider"`
+}
+
+// A PublishingDestinationStatus represents the observed state of a
+// PublishingDestination.
+type PublishingDestinationStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     PublishingDestinationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PublishingDestination is a managed resource that represents a
+// destination that the findings of an AWS GuardDuty detector are exported to.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DESTINATION",type="string",JSONPath=".spec.forProvider.destinationArn"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type PublishingDestination struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PublishingDestinationSpec   `json:"spec"`
+	Status PublishingDestinationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PublishingDestinationList contains a list of PublishingDestinations
+type PublishingDestinationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PublishingDestination `json:"items"`
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// ResolveReferences of this Member
+func (mg *Member) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.detectorId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DetectorID),
+		Reference:    mg.Spec.ForProvider.DetectorIDRef,
+		Selector:     mg.Spec.ForProvider.DetectorIDSelector,
+		To:           reference.To{Managed: &Detector{}, List: &DetectorList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.detectorId")
+	}
+	mg.Spec.ForProvider.DetectorID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DetectorIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this PublishingDestination
+func (mg *PublishingDestination) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.detectorId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DetectorID),
+		Reference:    mg.Spec.ForProvider.DetectorIDRef,
+		Selector:     mg.Spec.ForProvider.DetectorIDSelector,
+		To:           reference.To{Managed: &Detector{}, List: &DetectorList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.detectorId")
+	}
+	mg.Spec.ForProvider.DetectorID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DetectorIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.destinationArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DestinationARN),
+		Reference:    mg.Spec.ForProvider.DestinationARNRef,
+		Selector:     mg.Spec.ForProvider.DestinationARNSelector,
+		To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+		Extract:      s3v1beta1.BucketARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.destinationArn")
+	}
+	mg.Spec.ForProvider.DestinationARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DestinationARNRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,480 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Detector) DeepCopyInto(out *Detector) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Detector.
+func (in *Detector) DeepCopy() *Detector {
+	if in == nil {
+		return nil
+	}
+	out := new(Detector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Detector) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DetectorList) DeepCopyInto(out *DetectorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Detector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DetectorList.
+func (in *DetectorList) DeepCopy() *DetectorList {
+	if in == nil {
+		return nil
+	}
+	out := new(DetectorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DetectorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DetectorObservation) DeepCopyInto(out *DetectorObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DetectorObservation.
+func (in *DetectorObservation) DeepCopy() *DetectorObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DetectorObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DetectorParameters) DeepCopyInto(out *DetectorParameters) {
+	*out = *in
+	if in.FindingPublishingFrequency != nil {
+		in, out := &in.FindingPublishingFrequency, &out.FindingPublishingFrequency
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DetectorParameters.
+func (in *DetectorParameters) DeepCopy() *DetectorParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DetectorParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DetectorSpec) DeepCopyInto(out *DetectorSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DetectorSpec.
+func (in *DetectorSpec) DeepCopy() *DetectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DetectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DetectorStatus) DeepCopyInto(out *DetectorStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DetectorStatus.
+func (in *DetectorStatus) DeepCopy() *DetectorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DetectorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Member) DeepCopyInto(out *Member) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
+func (in *Member) DeepCopy() *Member {
+	if in == nil {
+		return nil
+	}
+	out := new(Member)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Member) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberList) DeepCopyInto(out *MemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Member, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberList.
+func (in *MemberList) DeepCopy() *MemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberObservation) DeepCopyInto(out *MemberObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberObservation.
+func (in *MemberObservation) DeepCopy() *MemberObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
+	*out = *in
+	if in.DetectorID != nil {
+		in, out := &in.DetectorID, &out.DetectorID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DetectorIDRef != nil {
+		in, out := &in.DetectorIDRef, &out.DetectorIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.DetectorIDSelector != nil {
+		in, out := &in.DetectorIDSelector, &out.DetectorIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Invite != nil {
+		in, out := &in.Invite, &out.Invite
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableEmailNotification != nil {
+		in, out := &in.DisableEmailNotification, &out.DisableEmailNotification
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InvitationMessage != nil {
+		in, out := &in.InvitationMessage, &out.InvitationMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberParameters.
+func (in *MemberParameters) DeepCopy() *MemberParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberSpec) DeepCopyInto(out *MemberSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberSpec.
+func (in *MemberSpec) DeepCopy() *MemberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberStatus.
+func (in *MemberStatus) DeepCopy() *MemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublishingDestination) DeepCopyInto(out *PublishingDestination) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishingDestination.
+func (in *PublishingDestination) DeepCopy() *PublishingDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(PublishingDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublishingDestination) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublishingDestinationList) DeepCopyInto(out *PublishingDestinationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PublishingDestination, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishingDestinationList.
+func (in *PublishingDestinationList) DeepCopy() *PublishingDestinationList {
+	if in == nil {
+		return nil
+	}
+	out := new(PublishingDestinationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublishingDestinationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublishingDestinationObservation) DeepCopyInto(out *PublishingDestinationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishingDestinationObservation.
+func (in *PublishingDestinationObservation) DeepCopy() *PublishingDestinationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PublishingDestinationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublishingDestinationParameters) DeepCopyInto(out *PublishingDestinationParameters) {
+	*out = *in
+	if in.DetectorID != nil {
+		in, out := &in.DetectorID, &out.DetectorID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DetectorIDRef != nil {
+		in, out := &in.DetectorIDRef, &out.DetectorIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.DetectorIDSelector != nil {
+		in, out := &in.DetectorIDSelector, &out.DetectorIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationARN != nil {
+		in, out := &in.DestinationARN, &out.DestinationARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationARNRef != nil {
+		in, out := &in.DestinationARNRef, &out.DestinationARNRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.DestinationARNSelector != nil {
+		in, out := &in.DestinationARNSelector, &out.DestinationARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishingDestinationParameters.
+func (in *PublishingDestinationParameters) DeepCopy() *PublishingDestinationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PublishingDestinationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublishingDestinationSpec) DeepCopyInto(out *PublishingDestinationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishingDestinationSpec.
+func (in *PublishingDestinationSpec) DeepCopy() *PublishingDestinationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PublishingDestinationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublishingDestinationStatus) DeepCopyInto(out *PublishingDestinationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishingDestinationStatus.
+func (in *PublishingDestinationStatus) DeepCopy() *PublishingDestinationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PublishingDestinationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
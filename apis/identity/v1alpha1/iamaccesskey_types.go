@@ -0,0 +1,114 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Statuses of an IAMAccessKey.
+const (
+	AccessKeyStatusActive   = "Active"
+	AccessKeyStatusInactive = "Inactive"
+)
+
+// IAMAccessKeyParameters define the desired state of an AWS IAM access key.
+type IAMAccessKeyParameters struct {
+	// UserName is the name of the IAMUser that the access key belongs to.
+	// +immutable
+	UserName string `json:"userName,omitempty"`
+
+	// UserNameRef references to an IAMUser to retrieve its userName
+	// +optional
+	// +immutable
+	UserNameRef *runtimev1alpha1.Reference `json:"userNameRef,omitempty"`
+
+	// UserNameSelector selects a reference to an IAMUser to retrieve its userName
+	// +optional
+	UserNameSelector *runtimev1alpha1.Selector `json:"userNameSelector,omitempty"`
+
+	// Status of the access key. Active means that the key can be used for
+	// API calls to AWS, while Inactive means that it can not.
+	// +optional
+	// +kubebuilder:validation:Enum=Active;Inactive
+	Status *string `json:"status,omitempty"`
+
+	// RotationTrigger is an arbitrary value whose change causes a new access
+	// key to be created and published to the connection secret. The previous
+	// access key is only deleted once the connection secret holds the new
+	// one. Note that a user can have at most two access keys, so a rotation
+	// fails while the user has another access key.
+	// +optional
+	RotationTrigger *string `json:"rotationTrigger,omitempty"`
+}
+
+// An IAMAccessKeySpec defines the desired state of an IAMAccessKey.
+type IAMAccessKeySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  IAMAccessKeyParameters `json:"forProvider"`
+}
+
+// IAMAccessKeyObservation keeps the state for the external resource. The
+// access key ID and secret are only published to the connection secret.
+type IAMAccessKeyObservation struct {
+	// Status of the access key.
+	Status string `json:"status,omitempty"`
+
+	// CreateDate is the date when the access key was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// RotationTrigger is the value of spec.forProvider.rotationTrigger when
+	// the current access key was created. It is recorded from the spec when
+	// the access key is observed for the first time, e.g. after an import.
+	RotationTrigger *string `json:"rotationTrigger,omitempty"`
+}
+
+// An IAMAccessKeyStatus represents the observed state of an IAMAccessKey.
+type IAMAccessKeyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     IAMAccessKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IAMAccessKey is a managed resource that represents an AWS IAM access key
+// of an IAM user. Its external name is the access key ID.
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".spec.forProvider.userName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IAMAccessKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IAMAccessKeySpec   `json:"spec"`
+	Status IAMAccessKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IAMAccessKeyList contains a list of IAMAccessKeys
+type IAMAccessKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IAMAccessKey `json:"items"`
+}
@@ -77,6 +77,8 @@ type IAMGroupPolicyAttachmentStatus struct {
 
 // An IAMGroupPolicyAttachment is a managed resource that represents an AWS IAM
 // Group policy attachment.
+// Its external name is <group name>/<policy ARN>, so an existing one can be
+// imported by setting that as the external name annotation.
 // +kubebuilder:printcolumn:name="GROUPNAME",type="string",JSONPath=".spec.forProvider.groupName"
 // +kubebuilder:printcolumn:name="POLICYARN",type="string",JSONPath=".spec.forProvider.policyArn"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
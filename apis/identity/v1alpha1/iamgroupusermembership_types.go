@@ -78,6 +78,8 @@ type IAMGroupUserMembershipStatus struct {
 
 // An IAMGroupUserMembership is a managed resource that represents an AWS IAM
 // User group membership.
+// Its external name is <group name>/<user name>, so an existing one can be
+// imported by setting that as the external name annotation.
 // +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".spec.forProvider.userName"
 // +kubebuilder:printcolumn:name="GROUPNAME",type="string",JSONPath=".spec.forProvider.groupName"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
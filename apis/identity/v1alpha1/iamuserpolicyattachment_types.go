@@ -77,6 +77,8 @@ type IAMUserPolicyAttachmentStatus struct {
 
 // An IAMUserPolicyAttachment is a managed resource that represents an AWS IAM
 // User policy attachment.
+// Its external name is <user name>/<policy ARN>, so an existing one can be
+// imported by setting that as the external name annotation.
 // +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".spec.forProvider.userName"
 // +kubebuilder:printcolumn:name="POLICYARN",type="string",JSONPath=".spec.forProvider.policyArn"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
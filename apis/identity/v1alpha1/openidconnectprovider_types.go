@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// OpenIDConnectProviderParameters define the desired state of an AWS IAM
+// OpenID Connect identity provider.
+type OpenIDConnectProviderParameters struct {
+	// The URL of the identity provider, such as the OpenID Connect issuer URL
+	// of an EKS cluster. The URL must begin with https:// and should
+	// correspond to the iss claim in the provider's OpenID Connect ID tokens.
+	// +immutable
+	URL string `json:"url"`
+
+	// A list of client IDs, also known as audiences, that are allowed to
+	// authenticate with the identity provider.
+	// +optional
+	ClientIDList []string `json:"clientIdList,omitempty"`
+
+	// A list of server certificate thumbprints for the identity provider's
+	// server certificates. If omitted, the SHA-1 thumbprint of the top
+	// intermediate certificate authority served by the identity provider is
+	// computed when the provider is created.
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	ThumbprintList []string `json:"thumbprintList,omitempty"`
+}
+
+// An OpenIDConnectProviderSpec defines the desired state of an
+// OpenIDConnectProvider.
+type OpenIDConnectProviderSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  OpenIDConnectProviderParameters `json:"forProvider"`
+}
+
+// OpenIDConnectProviderObservation keeps the state for the external resource.
+type OpenIDConnectProviderObservation struct {
+	// The Amazon Resource Name (ARN) of the identity provider.
+	ARN string `json:"arn,omitempty"`
+
+	// The date and time when the identity provider was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+}
+
+// An OpenIDConnectProviderStatus represents the observed state of an
+// OpenIDConnectProvider.
+type OpenIDConnectProviderStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     OpenIDConnectProviderObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OpenIDConnectProvider is a managed resource that represents an AWS IAM
+// OpenID Connect identity provider, such as the issuer of an EKS cluster's
+// service account tokens. Its external name is the ARN of the provider.
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.forProvider.url"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type OpenIDConnectProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenIDConnectProviderSpec   `json:"spec"`
+	Status OpenIDConnectProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenIDConnectProviderList contains a list of OpenIDConnectProviders
+type OpenIDConnectProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenIDConnectProvider `json:"items"`
+}
@@ -138,3 +138,24 @@ func (mg *IAMGroupPolicyAttachment) ResolveReferences(ctx context.Context, c cli
 
 	return nil
 }
+
+// ResolveReferences of this IAMAccessKey
+func (mg *IAMAccessKey) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.userName
+	user, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.UserName,
+		Reference:    mg.Spec.ForProvider.UserNameRef,
+		Selector:     mg.Spec.ForProvider.UserNameSelector,
+		To:           reference.To{Managed: &IAMUser{}, List: &IAMUserList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.userName")
+	}
+	mg.Spec.ForProvider.UserName = user.ResolvedValue
+	mg.Spec.ForProvider.UserNameRef = user.ResolvedReference
+
+	return nil
+}
@@ -88,6 +88,30 @@ var (
 	IAMGroupPolicyAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(IAMGroupPolicyAttachmentKind)
 )
 
+// OpenIDConnectProvider type metadata.
+var (
+	OpenIDConnectProviderKind             = reflect.TypeOf(OpenIDConnectProvider{}).Name()
+	OpenIDConnectProviderGroupKind        = schema.GroupKind{Group: Group, Kind: OpenIDConnectProviderKind}.String()
+	OpenIDConnectProviderKindAPIVersion   = OpenIDConnectProviderKind + "." + SchemeGroupVersion.String()
+	OpenIDConnectProviderGroupVersionKind = SchemeGroupVersion.WithKind(OpenIDConnectProviderKind)
+)
+
+// SAMLProvider type metadata.
+var (
+	SAMLProviderKind             = reflect.TypeOf(SAMLProvider{}).Name()
+	SAMLProviderGroupKind        = schema.GroupKind{Group: Group, Kind: SAMLProviderKind}.String()
+	SAMLProviderKindAPIVersion   = SAMLProviderKind + "." + SchemeGroupVersion.String()
+	SAMLProviderGroupVersionKind = SchemeGroupVersion.WithKind(SAMLProviderKind)
+)
+
+// IAMAccessKey type metadata.
+var (
+	IAMAccessKeyKind             = reflect.TypeOf(IAMAccessKey{}).Name()
+	IAMAccessKeyGroupKind        = schema.GroupKind{Group: Group, Kind: IAMAccessKeyKind}.String()
+	IAMAccessKeyKindAPIVersion   = IAMAccessKeyKind + "." + SchemeGroupVersion.String()
+	IAMAccessKeyGroupVersionKind = SchemeGroupVersion.WithKind(IAMAccessKeyKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&IAMUser{}, &IAMUserList{})
 	SchemeBuilder.Register(&IAMPolicy{}, &IAMPolicyList{})
@@ -95,4 +119,7 @@ func init() {
 	SchemeBuilder.Register(&IAMGroup{}, &IAMGroupList{})
 	SchemeBuilder.Register(&IAMGroupUserMembership{}, &IAMGroupUserMembershipList{})
 	SchemeBuilder.Register(&IAMGroupPolicyAttachment{}, &IAMGroupPolicyAttachmentList{})
+	SchemeBuilder.Register(&OpenIDConnectProvider{}, &OpenIDConnectProviderList{})
+	SchemeBuilder.Register(&SAMLProvider{}, &SAMLProviderList{})
+	SchemeBuilder.Register(&IAMAccessKey{}, &IAMAccessKeyList{})
 }
@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SAMLProviderParameters define the desired state of an AWS IAM SAML
+// identity provider. Exactly one of the metadata document sources must be
+// set.
+type SAMLProviderParameters struct {
+	// The name of the provider.
+	// +immutable
+	Name string `json:"name"`
+
+	// An XML document generated by an identity provider that supports SAML
+	// 2.0, which includes the issuer's name, expiration information and keys
+	// that can be used to validate the SAML authentication response.
+	// +optional
+	SAMLMetadataDocument *string `json:"samlMetadataDocument,omitempty"`
+
+	// SAMLMetadataDocumentSecretRef references the key of a secret that
+	// contains the SAML metadata document.
+	// +optional
+	SAMLMetadataDocumentSecretRef *runtimev1alpha1.SecretKeySelector `json:"samlMetadataDocumentSecretRef,omitempty"`
+
+	// SAMLMetadataDocumentConfigMapRef references the key of a config map
+	// that contains the SAML metadata document.
+	// +optional
+	SAMLMetadataDocumentConfigMapRef *ConfigMapKeySelector `json:"samlMetadataDocumentConfigMapRef,omitempty"`
+}
+
+// A SAMLProviderSpec defines the desired state of a SAMLProvider.
+type SAMLProviderSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SAMLProviderParameters `json:"forProvider"`
+}
+
+// SAMLProviderObservation keeps the state for the external resource.
+type SAMLProviderObservation struct {
+	// The Amazon Resource Name (ARN) of the provider.
+	ARN string `json:"arn,omitempty"`
+
+	// The date and time when the provider was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// The expiration date and time for the provider.
+	ValidUntil *metav1.Time `json:"validUntil,omitempty"`
+}
+
+// A SAMLProviderStatus represents the observed state of a SAMLProvider.
+type SAMLProviderStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     SAMLProviderObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SAMLProvider is a managed resource that represents an AWS IAM SAML 2.0
+// identity provider. Its external name is the ARN of the provider.
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.arn"
+// +kubebuilder:printcolumn:name="VALID-UNTIL",type="string",JSONPath=".status.atProvider.validUntil"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SAMLProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SAMLProviderSpec   `json:"spec"`
+	Status SAMLProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SAMLProviderList contains a list of SAMLProviders
+type SAMLProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SAMLProvider `json:"items"`
+}
@@ -25,6 +25,173 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMAccessKey) DeepCopyInto(out *IAMAccessKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMAccessKey.
+func (in *IAMAccessKey) DeepCopy() *IAMAccessKey {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMAccessKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IAMAccessKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMAccessKeyList) DeepCopyInto(out *IAMAccessKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IAMAccessKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMAccessKeyList.
+func (in *IAMAccessKeyList) DeepCopy() *IAMAccessKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMAccessKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IAMAccessKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMAccessKeyObservation) DeepCopyInto(out *IAMAccessKeyObservation) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+	if in.RotationTrigger != nil {
+		in, out := &in.RotationTrigger, &out.RotationTrigger
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMAccessKeyObservation.
+func (in *IAMAccessKeyObservation) DeepCopy() *IAMAccessKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMAccessKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMAccessKeyParameters) DeepCopyInto(out *IAMAccessKeyParameters) {
+	*out = *in
+	if in.UserNameRef != nil {
+		in, out := &in.UserNameRef, &out.UserNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.UserNameSelector != nil {
+		in, out := &in.UserNameSelector, &out.UserNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.RotationTrigger != nil {
+		in, out := &in.RotationTrigger, &out.RotationTrigger
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMAccessKeyParameters.
+func (in *IAMAccessKeyParameters) DeepCopy() *IAMAccessKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMAccessKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMAccessKeySpec) DeepCopyInto(out *IAMAccessKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMAccessKeySpec.
+func (in *IAMAccessKeySpec) DeepCopy() *IAMAccessKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMAccessKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMAccessKeyStatus) DeepCopyInto(out *IAMAccessKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMAccessKeyStatus.
+func (in *IAMAccessKeyStatus) DeepCopy() *IAMAccessKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMAccessKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMGroup) DeepCopyInto(out *IAMGroup) {
 	*out = *in
@@ -853,6 +1020,289 @@ func (in *IAMUserStatus) DeepCopy() *IAMUserStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDConnectProvider) DeepCopyInto(out *OpenIDConnectProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProvider.
+func (in *OpenIDConnectProvider) DeepCopy() *OpenIDConnectProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDConnectProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenIDConnectProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDConnectProviderList) DeepCopyInto(out *OpenIDConnectProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenIDConnectProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderList.
+func (in *OpenIDConnectProviderList) DeepCopy() *OpenIDConnectProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDConnectProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenIDConnectProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDConnectProviderObservation) DeepCopyInto(out *OpenIDConnectProviderObservation) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderObservation.
+func (in *OpenIDConnectProviderObservation) DeepCopy() *OpenIDConnectProviderObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDConnectProviderObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDConnectProviderParameters) DeepCopyInto(out *OpenIDConnectProviderParameters) {
+	*out = *in
+	if in.ClientIDList != nil {
+		in, out := &in.ClientIDList, &out.ClientIDList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ThumbprintList != nil {
+		in, out := &in.ThumbprintList, &out.ThumbprintList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderParameters.
+func (in *OpenIDConnectProviderParameters) DeepCopy() *OpenIDConnectProviderParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDConnectProviderParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDConnectProviderSpec) DeepCopyInto(out *OpenIDConnectProviderSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderSpec.
+func (in *OpenIDConnectProviderSpec) DeepCopy() *OpenIDConnectProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDConnectProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDConnectProviderStatus) DeepCopyInto(out *OpenIDConnectProviderStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderStatus.
+func (in *OpenIDConnectProviderStatus) DeepCopy() *OpenIDConnectProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDConnectProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProvider) DeepCopyInto(out *SAMLProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProvider.
+func (in *SAMLProvider) DeepCopy() *SAMLProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SAMLProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProviderList) DeepCopyInto(out *SAMLProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SAMLProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderList.
+func (in *SAMLProviderList) DeepCopy() *SAMLProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SAMLProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProviderObservation) DeepCopyInto(out *SAMLProviderObservation) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderObservation.
+func (in *SAMLProviderObservation) DeepCopy() *SAMLProviderObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProviderObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProviderParameters) DeepCopyInto(out *SAMLProviderParameters) {
+	*out = *in
+	if in.SAMLMetadataDocument != nil {
+		in, out := &in.SAMLMetadataDocument, &out.SAMLMetadataDocument
+		*out = new(string)
+		**out = **in
+	}
+	if in.SAMLMetadataDocumentSecretRef != nil {
+		in, out := &in.SAMLMetadataDocumentSecretRef, &out.SAMLMetadataDocumentSecretRef
+		*out = new(corev1alpha1.SecretKeySelector)
+		**out = **in
+	}
+	if in.SAMLMetadataDocumentConfigMapRef != nil {
+		in, out := &in.SAMLMetadataDocumentConfigMapRef, &out.SAMLMetadataDocumentConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderParameters.
+func (in *SAMLProviderParameters) DeepCopy() *SAMLProviderParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProviderParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProviderSpec) DeepCopyInto(out *SAMLProviderSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderSpec.
+func (in *SAMLProviderSpec) DeepCopy() *SAMLProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProviderStatus) DeepCopyInto(out *SAMLProviderStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderStatus.
+func (in *SAMLProviderStatus) DeepCopy() *SAMLProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -78,6 +78,8 @@ type IAMRolePolicyAttachmentStatus struct {
 
 // An IAMRolePolicyAttachment is a managed resource that represents an AWS IAM
 // Role policy attachment.
+// Its external name is <role name>/<policy ARN>, so an existing one can be
+// imported by setting that as the external name annotation.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="ROLENAME",type="string",JSONPath=".spec.forProvider.roleName"
@@ -56,7 +56,16 @@ var (
 	IAMRolePolicyAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(IAMRolePolicyAttachmentKind)
 )
 
+// InstanceProfile type metadata.
+var (
+	InstanceProfileKind             = reflect.TypeOf(InstanceProfile{}).Name()
+	InstanceProfileGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceProfileKind}.String()
+	InstanceProfileKindAPIVersion   = InstanceProfileKind + "." + SchemeGroupVersion.String()
+	InstanceProfileGroupVersionKind = SchemeGroupVersion.WithKind(InstanceProfileKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&IAMRole{}, &IAMRoleList{})
 	SchemeBuilder.Register(&IAMRolePolicyAttachment{}, &IAMRolePolicyAttachmentList{})
+	SchemeBuilder.Register(&InstanceProfile{}, &InstanceProfileList{})
 }
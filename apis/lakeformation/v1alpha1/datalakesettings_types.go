@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// PrincipalPermissions are the permissions granted to a principal.
+type PrincipalPermissions struct {
+	// Principal is the ARN of an IAM user or role, or IAM_ALLOWED_PRINCIPALS
+	// to leave access control to IAM.
+	Principal string `json:"principal"`
+
+	// Permissions granted to the principal.
+	Permissions []Permission `json:"permissions"`
+}
+
+// DataLakeSettingsParameters define the desired state of the Lake Formation
+// settings of a Data Catalog.
+type DataLakeSettingsParameters struct {
+	// Region is the region you'd like your DataLakeSettings to be created
+	// in.
+	// +immutable
+	Region string `json:"region"`
+
+	// CatalogID is the ID of the Data Catalog. Defaults to the AWS account
+	// ID.
+	// +immutable
+	// +optional
+	CatalogID *string `json:"catalogId,omitempty"`
+
+	// DataLakeAdmins are the ARNs of the IAM users and roles that administer
+	// the data lake. Administrators that are not listed are removed.
+	// +optional
+	DataLakeAdmins []string `json:"dataLakeAdmins,omitempty"`
+
+	// DataLakeAdminRefs references IAMRoles to retrieve their ARNs.
+	// +optional
+	DataLakeAdminRefs []runtimev1alpha1.Reference `json:"dataLakeAdminRefs,omitempty"`
+
+	// DataLakeAdminSelector selects references to IAMRoles to retrieve
+	// their ARNs.
+	// +optional
+	DataLakeAdminSelector *runtimev1alpha1.Selector `json:"dataLakeAdminSelector,omitempty"`
+
+	// CreateDatabaseDefaultPermissions are the permissions granted by
+	// default on newly created databases. Leaving it empty removes the
+	// default grant of ALL to IAM_ALLOWED_PRINCIPALS, so that only Lake
+	// Formation permissions control access to new databases.
+	// +optional
+	CreateDatabaseDefaultPermissions []PrincipalPermissions `json:"createDatabaseDefaultPermissions,omitempty"`
+
+	// CreateTableDefaultPermissions are the permissions granted by default
+	// on newly created tables. Leaving it empty removes the default grant of
+	// ALL to IAM_ALLOWED_PRINCIPALS, so that only Lake Formation permissions
+	// control access to new tables.
+	// +optional
+	CreateTableDefaultPermissions []PrincipalPermissions `json:"createTableDefaultPermissions,omitempty"`
+}
+
+// A DataLakeSettingsSpec defines the desired state of a DataLakeSettings.
+type DataLakeSettingsSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DataLakeSettingsParameters `json:"forProvider"`
+}
+
+// A DataLakeSettingsStatus represents the observed state of a
+// DataLakeSettings.
+type DataLakeSettingsStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DataLakeSettings is a managed resource that represents the Lake
+// Formation settings of a Data Catalog. Every Data Catalog has exactly one
+// set of settings, so deleting a DataLakeSettings resets them to the
+// defaults of Lake Formation instead of deleting them.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DataLakeSettings struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataLakeSettingsSpec   `json:"spec"`
+	Status DataLakeSettingsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DataLakeSettingsList contains a list of DataLakeSettings
+type DataLakeSettingsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataLakeSettings `json:"items"`
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Permission is a Lake Formation permission.
+// +kubebuilder:validation:Enum=ALL;SELECT;ALTER;DROP;DELETE;INSERT;CREATE_DATABASE;CREATE_TABLE;DATA_LOCATION_ACCESS
+type Permission string
+
+// CatalogResource is the Data Catalog itself. It has no fields.
+type CatalogResource struct{}
+
+// DatabaseResource is a database in the Data Catalog.
+type DatabaseResource struct {
+	// Name of the database.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// NameRef references a CatalogDatabase to retrieve its name.
+	// +optional
+	NameRef *runtimev1alpha1.Reference `json:"nameRef,omitempty"`
+
+	// NameSelector selects a reference to a CatalogDatabase to retrieve its
+	// name.
+	// +optional
+	NameSelector *runtimev1alpha1.Selector `json:"nameSelector,omitempty"`
+}
+
+// TableResource is a table in the Data Catalog.
+type TableResource struct {
+	// DatabaseName is the name of the database of the table.
+	// +optional
+	DatabaseName *string `json:"databaseName,omitempty"`
+
+	// DatabaseNameRef references a CatalogDatabase to retrieve its name.
+	// +optional
+	DatabaseNameRef *runtimev1alpha1.Reference `json:"databaseNameRef,omitempty"`
+
+	// DatabaseNameSelector selects a reference to a CatalogDatabase to
+	// retrieve its name.
+	// +optional
+	DatabaseNameSelector *runtimev1alpha1.Selector `json:"databaseNameSelector,omitempty"`
+
+	// Name of the table.
+	Name string `json:"name"`
+}
+
+// ColumnWildcard selects all columns of a table except the excluded ones.
+type ColumnWildcard struct {
+	// ExcludedColumnNames are the columns that are excluded.
+	// +optional
+	ExcludedColumnNames []string `json:"excludedColumnNames,omitempty"`
+}
+
+// TableWithColumnsResource is a set of columns of a table in the Data
+// Catalog. Either ColumnNames or ColumnWildcard must be set.
+type TableWithColumnsResource struct {
+	TableResource `json:",inline"`
+
+	// ColumnNames are the columns the permissions apply to.
+	// +optional
+	ColumnNames []string `json:"columnNames,omitempty"`
+
+	// ColumnWildcard selects all columns except the excluded ones.
+	// +optional
+	ColumnWildcard *ColumnWildcard `json:"columnWildcard,omitempty"`
+}
+
+// DataLocationResource is an Amazon S3 location registered with Lake
+// Formation.
+type DataLocationResource struct {
+	// ResourceARN is the ARN of the registered Amazon S3 location.
+	ResourceARN string `json:"resourceArn"`
+}
+
+// PermissionsResource is the resource the permissions apply to. Exactly one
+// of its fields must be set.
+type PermissionsResource struct {
+	// Catalog is the Data Catalog itself, which is used to grant
+	// CREATE_DATABASE.
+	// +optional
+	Catalog *CatalogResource `json:"catalog,omitempty"`
+
+	// Database is a database in the Data Catalog.
+	// +optional
+	Database *DatabaseResource `json:"database,omitempty"`
+
+	// Table is a table in the Data Catalog.
+	// +optional
+	Table *TableResource `json:"table,omitempty"`
+
+	// TableWithColumns is a set of columns of a table in the Data Catalog.
+	// +optional
+	TableWithColumns *TableWithColumnsResource `json:"tableWithColumns,omitempty"`
+
+	// DataLocation is a registered Amazon S3 location.
+	// +optional
+	DataLocation *DataLocationResource `json:"dataLocation,omitempty"`
+}
+
+// LakeFormationPermissionsParameters define the desired state of the Lake
+// Formation permissions of a principal on a resource.
+type LakeFormationPermissionsParameters struct {
+	// Region is the region you'd like your LakeFormationPermissions to be
+	// created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// CatalogID is the ID of the Data Catalog. Defaults to the AWS account
+	// ID.
+	// +immutable
+	// +optional
+	CatalogID *string `json:"catalogId,omitempty"`
+
+	// Principal is the ARN of the IAM user or role the permissions are
+	// granted to.
+	// +immutable
+	// +optional
+	Principal *string `json:"principal,omitempty"`
+
+	// PrincipalRef references an IAMRole to retrieve its ARN.
+	// +immutable
+	// +optional
+	PrincipalRef *runtimev1alpha1.Reference `json:"principalRef,omitempty"`
+
+	// PrincipalSelector selects a reference to an IAMRole to retrieve its
+	// ARN.
+	// +immutable
+	// +optional
+	PrincipalSelector *runtimev1alpha1.Selector `json:"principalSelector,omitempty"`
+
+	// Resource the permissions apply to.
+	// +immutable
+	Resource PermissionsResource `json:"resource"`
+
+	// Permissions granted to the principal on the resource.
+	// +kubebuilder:validation:MinItems=1
+	Permissions []Permission `json:"permissions"`
+
+	// PermissionsWithGrantOption are the permissions the principal can pass
+	// on to other principals. They must be a subset of Permissions.
+	// +optional
+	PermissionsWithGrantOption []Permission `json:"permissionsWithGrantOption,omitempty"`
+}
+
+// A LakeFormationPermissionsSpec defines the desired state of a
+// LakeFormationPermissions.
+type LakeFormationPermissionsSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LakeFormationPermissionsParameters `json:"forProvider"`
+}
+
+// A LakeFormationPermissionsStatus represents the observed state of a
+// LakeFormationPermissions.
+type LakeFormationPermissionsStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LakeFormationPermissions is a managed resource that represents the Lake
+// Formation permissions of a principal on a Data Catalog resource or data
+// location. It owns all permissions of the principal on the resource while
+// it exists: permissions that are not declared in its spec are revoked.
+// Deleting it only revokes the declared permissions, so that permissions
+// granted by other means after it was last reconciled are kept.
+// +kubebuilder:printcolumn:name="PRINCIPAL",type="string",JSONPath=".spec.forProvider.principal"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LakeFormationPermissions struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LakeFormationPermissionsSpec   `json:"spec"`
+	Status LakeFormationPermissionsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LakeFormationPermissionsList contains a list of LakeFormationPermissions
+type LakeFormationPermissionsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LakeFormationPermissions `json:"items"`
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	gluev1alpha1 "github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+)
+
+// ResolveReferences of this DataLakeSettings
+func (mg *DataLakeSettings) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.dataLakeAdmins
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.DataLakeAdmins,
+		References:    mg.Spec.ForProvider.DataLakeAdminRefs,
+		Selector:      mg.Spec.ForProvider.DataLakeAdminSelector,
+		To:            reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+		Extract:       iamv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dataLakeAdmins")
+	}
+	mg.Spec.ForProvider.DataLakeAdmins = mrsp.ResolvedValues
+	mg.Spec.ForProvider.DataLakeAdminRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+func resolveTableDatabaseName(ctx context.Context, r *reference.APIResolver, t *TableResource) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(t.DatabaseName),
+		Reference:    t.DatabaseNameRef,
+		Selector:     t.DatabaseNameSelector,
+		To:           reference.To{Managed: &gluev1alpha1.CatalogDatabase{}, List: &gluev1alpha1.CatalogDatabaseList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	t.DatabaseName = reference.ToPtrValue(rsp.ResolvedValue)
+	t.DatabaseNameRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this LakeFormationPermissions
+func (mg *LakeFormationPermissions) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.principal
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Principal),
+		Reference:    mg.Spec.ForProvider.PrincipalRef,
+		Selector:     mg.Spec.ForProvider.PrincipalSelector,
+		To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+		Extract:      iamv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.principal")
+	}
+	mg.Spec.ForProvider.Principal = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PrincipalRef = rsp.ResolvedReference
+
+	res := &mg.Spec.ForProvider.Resource
+
+	// Resolve spec.forProvider.resource.database.name
+	if db := res.Database; db != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(db.Name),
+			Reference:    db.NameRef,
+			Selector:     db.NameSelector,
+			To:           reference.To{Managed: &gluev1alpha1.CatalogDatabase{}, List: &gluev1alpha1.CatalogDatabaseList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.resource.database.name")
+		}
+		db.Name = reference.ToPtrValue(rsp.ResolvedValue)
+		db.NameRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.resource.table.databaseName
+	if res.Table != nil {
+		if err := resolveTableDatabaseName(ctx, r, res.Table); err != nil {
+			return errors.Wrap(err, "spec.forProvider.resource.table.databaseName")
+		}
+	}
+
+	// Resolve spec.forProvider.resource.tableWithColumns.databaseName
+	if res.TableWithColumns != nil {
+		if err := resolveTableDatabaseName(ctx, r, &res.TableWithColumns.TableResource); err != nil {
+			return errors.Wrap(err, "spec.forProvider.resource.tableWithColumns.databaseName")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,501 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogResource) DeepCopyInto(out *CatalogResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogResource.
+func (in *CatalogResource) DeepCopy() *CatalogResource {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ColumnWildcard) DeepCopyInto(out *ColumnWildcard) {
+	*out = *in
+	if in.ExcludedColumnNames != nil {
+		in, out := &in.ExcludedColumnNames, &out.ExcludedColumnNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ColumnWildcard.
+func (in *ColumnWildcard) DeepCopy() *ColumnWildcard {
+	if in == nil {
+		return nil
+	}
+	out := new(ColumnWildcard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataLakeSettings) DeepCopyInto(out *DataLakeSettings) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLakeSettings.
+func (in *DataLakeSettings) DeepCopy() *DataLakeSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(DataLakeSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataLakeSettings) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataLakeSettingsList) DeepCopyInto(out *DataLakeSettingsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataLakeSettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLakeSettingsList.
+func (in *DataLakeSettingsList) DeepCopy() *DataLakeSettingsList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataLakeSettingsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataLakeSettingsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataLakeSettingsParameters) DeepCopyInto(out *DataLakeSettingsParameters) {
+	*out = *in
+	if in.CatalogID != nil {
+		in, out := &in.CatalogID, &out.CatalogID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DataLakeAdmins != nil {
+		in, out := &in.DataLakeAdmins, &out.DataLakeAdmins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DataLakeAdminRefs != nil {
+		in, out := &in.DataLakeAdminRefs, &out.DataLakeAdminRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DataLakeAdminSelector != nil {
+		in, out := &in.DataLakeAdminSelector, &out.DataLakeAdminSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreateDatabaseDefaultPermissions != nil {
+		in, out := &in.CreateDatabaseDefaultPermissions, &out.CreateDatabaseDefaultPermissions
+		*out = make([]PrincipalPermissions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CreateTableDefaultPermissions != nil {
+		in, out := &in.CreateTableDefaultPermissions, &out.CreateTableDefaultPermissions
+		*out = make([]PrincipalPermissions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLakeSettingsParameters.
+func (in *DataLakeSettingsParameters) DeepCopy() *DataLakeSettingsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DataLakeSettingsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataLakeSettingsSpec) DeepCopyInto(out *DataLakeSettingsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLakeSettingsSpec.
+func (in *DataLakeSettingsSpec) DeepCopy() *DataLakeSettingsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataLakeSettingsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataLakeSettingsStatus) DeepCopyInto(out *DataLakeSettingsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLakeSettingsStatus.
+func (in *DataLakeSettingsStatus) DeepCopy() *DataLakeSettingsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataLakeSettingsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataLocationResource) DeepCopyInto(out *DataLocationResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLocationResource.
+func (in *DataLocationResource) DeepCopy() *DataLocationResource {
+	if in == nil {
+		return nil
+	}
+	out := new(DataLocationResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseResource) DeepCopyInto(out *DatabaseResource) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameRef != nil {
+		in, out := &in.NameRef, &out.NameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.NameSelector != nil {
+		in, out := &in.NameSelector, &out.NameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseResource.
+func (in *DatabaseResource) DeepCopy() *DatabaseResource {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LakeFormationPermissions) DeepCopyInto(out *LakeFormationPermissions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LakeFormationPermissions.
+func (in *LakeFormationPermissions) DeepCopy() *LakeFormationPermissions {
+	if in == nil {
+		return nil
+	}
+	out := new(LakeFormationPermissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LakeFormationPermissions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LakeFormationPermissionsList) DeepCopyInto(out *LakeFormationPermissionsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LakeFormationPermissions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LakeFormationPermissionsList.
+func (in *LakeFormationPermissionsList) DeepCopy() *LakeFormationPermissionsList {
+	if in == nil {
+		return nil
+	}
+	out := new(LakeFormationPermissionsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LakeFormationPermissionsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LakeFormationPermissionsParameters) DeepCopyInto(out *LakeFormationPermissionsParameters) {
+	*out = *in
+	if in.CatalogID != nil {
+		in, out := &in.CatalogID, &out.CatalogID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Principal != nil {
+		in, out := &in.Principal, &out.Principal
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrincipalRef != nil {
+		in, out := &in.PrincipalRef, &out.PrincipalRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.PrincipalSelector != nil {
+		in, out := &in.PrincipalSelector, &out.PrincipalSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Resource.DeepCopyInto(&out.Resource)
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]Permission, len(*in))
+		copy(*out, *in)
+	}
+	if in.PermissionsWithGrantOption != nil {
+		in, out := &in.PermissionsWithGrantOption, &out.PermissionsWithGrantOption
+		*out = make([]Permission, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LakeFormationPermissionsParameters.
+func (in *LakeFormationPermissionsParameters) DeepCopy() *LakeFormationPermissionsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LakeFormationPermissionsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LakeFormationPermissionsSpec) DeepCopyInto(out *LakeFormationPermissionsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LakeFormationPermissionsSpec.
+func (in *LakeFormationPermissionsSpec) DeepCopy() *LakeFormationPermissionsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LakeFormationPermissionsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LakeFormationPermissionsStatus) DeepCopyInto(out *LakeFormationPermissionsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LakeFormationPermissionsStatus.
+func (in *LakeFormationPermissionsStatus) DeepCopy() *LakeFormationPermissionsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LakeFormationPermissionsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionsResource) DeepCopyInto(out *PermissionsResource) {
+	*out = *in
+	if in.Catalog != nil {
+		in, out := &in.Catalog, &out.Catalog
+		*out = new(CatalogResource)
+		**out = **in
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(DatabaseResource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Table != nil {
+		in, out := &in.Table, &out.Table
+		*out = new(TableResource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TableWithColumns != nil {
+		in, out := &in.TableWithColumns, &out.TableWithColumns
+		*out = new(TableWithColumnsResource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataLocation != nil {
+		in, out := &in.DataLocation, &out.DataLocation
+		*out = new(DataLocationResource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionsResource.
+func (in *PermissionsResource) DeepCopy() *PermissionsResource {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionsResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrincipalPermissions) DeepCopyInto(out *PrincipalPermissions) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]Permission, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrincipalPermissions.
+func (in *PrincipalPermissions) DeepCopy() *PrincipalPermissions {
+	if in == nil {
+		return nil
+	}
+	out := new(PrincipalPermissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableResource) DeepCopyInto(out *TableResource) {
+	*out = *in
+	if in.DatabaseName != nil {
+		in, out := &in.DatabaseName, &out.DatabaseName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseNameRef != nil {
+		in, out := &in.DatabaseNameRef, &out.DatabaseNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.DatabaseNameSelector != nil {
+		in, out := &in.DatabaseNameSelector, &out.DatabaseNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableResource.
+func (in *TableResource) DeepCopy() *TableResource {
+	if in == nil {
+		return nil
+	}
+	out := new(TableResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableWithColumnsResource) DeepCopyInto(out *TableWithColumnsResource) {
+	*out = *in
+	in.TableResource.DeepCopyInto(&out.TableResource)
+	if in.ColumnNames != nil {
+		in, out := &in.ColumnNames, &out.ColumnNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ColumnWildcard != nil {
+		in, out := &in.ColumnWildcard, &out.ColumnWildcard
+		*out = new(ColumnWildcard)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableWithColumnsResource.
+func (in *TableWithColumnsResource) DeepCopy() *TableWithColumnsResource {
+	if in == nil {
+		return nil
+	}
+	out := new(TableWithColumnsResource)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Lightsail database states.
+const (
+	// The database is healthy and available.
+	DatabaseStateAvailable = "available"
+	// The database is being created.
+	DatabaseStateCreating = "creating"
+	// The database is being deleted.
+	DatabaseStateDeleting = "deleting"
+	// The database is being backed up.
+	DatabaseStateBackingUp = "backing-up"
+)
+
+// DatabaseParameters define the desired state of an Amazon Lightsail
+// database.
+type DatabaseParameters struct {
+	// Region is the region you'd like your Database to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// AvailabilityZone of the database, like us-east-1a. A random zone of
+	// the region is used if it is not set.
+	// +immutable
+	// +optional
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+
+	// BlueprintID is the ID of the engine and version of the database, like
+	// mysql_8_0.
+	// +immutable
+	BlueprintID string `json:"blueprintId"`
+
+	// BundleID is the ID of the hardware bundle of the database, like
+	// micro_2_0.
+	// +immutable
+	BundleID string `json:"bundleId"`
+
+	// MasterDatabaseName is the name of the database that is created with
+	// the Lightsail database.
+	// +immutable
+	MasterDatabaseName string `json:"masterDatabaseName"`
+
+	// MasterUsername is the name of the master user of the database.
+	// +immutable
+	MasterUsername string `json:"masterUsername"`
+
+	// MasterUserPasswordSecretRef references the key of a secret that
+	// contains the password of the master user. A password is generated if
+	// it is not set.
+	// +optional
+	MasterUserPasswordSecretRef *runtimev1alpha1.SecretKeySelector `json:"masterUserPasswordSecretRef,omitempty"`
+
+	// PreferredBackupWindow is the daily time range in UTC during which
+	// backups are created, like 16:00-16:30.
+	// +optional
+	PreferredBackupWindow *string `json:"preferredBackupWindow,omitempty"`
+
+	// PreferredMaintenanceWindow is the weekly time range in UTC during
+	// which maintenance can occur, like Tue:17:00-Tue:17:30.
+	// +optional
+	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
+
+	// PubliclyAccessible makes the database reachable from outside of
+	// Lightsail.
+	// +optional
+	PubliclyAccessible *bool `json:"publiclyAccessible,omitempty"`
+
+	// BackupRetentionEnabled enables automated backups of the database.
+	// +optional
+	BackupRetentionEnabled *bool `json:"backupRetentionEnabled,omitempty"`
+
+	// ApplyImmediately applies the changes to the database right away
+	// instead of during its next maintenance window.
+	// +optional
+	ApplyImmediately *bool `json:"applyImmediately,omitempty"`
+
+	// SkipFinalSnapshot skips the snapshot of the database before it is
+	// deleted.
+	// +optional
+	SkipFinalSnapshot *bool `json:"skipFinalSnapshot,omitempty"`
+
+	// FinalSnapshotName is the name of the snapshot that is created before
+	// the database is deleted. It is required unless SkipFinalSnapshot is
+	// set.
+	// +optional
+	FinalSnapshotName *string `json:"finalSnapshotName,omitempty"`
+
+	// Tags to add to the database.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// DatabaseObservation keeps the state for the external resource
+type DatabaseObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the database.
+	ARN string `json:"arn,omitempty"`
+
+	// State is the current state of the database.
+	State string `json:"state,omitempty"`
+
+	// Engine of the database.
+	Engine string `json:"engine,omitempty"`
+
+	// EngineVersion of the database.
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// Endpoint is the address of the database.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Port of the database.
+	Port int64 `json:"port,omitempty"`
+}
+
+// A DatabaseSpec defines the desired state of a Database.
+type DatabaseSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DatabaseParameters `json:"forProvider"`
+}
+
+// A DatabaseStatus represents the observed state of a Database.
+type DatabaseStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DatabaseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Database is a managed resource that represents an Amazon Lightsail
+// database. Its external name is the name of the database.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="ENGINE",type="string",JSONPath=".status.atProvider.engine"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Database struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatabaseSpec   `json:"spec"`
+	Status DatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatabaseList contains a list of Databases
+type DatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Database `json:"items"`
+}
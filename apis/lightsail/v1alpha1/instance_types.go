@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Lightsail instance states.
+const (
+	// The instance is being launched.
+	InstanceStatePending = "pending"
+	// The instance is running.
+	InstanceStateRunning = "running"
+	// The instance is being stopped.
+	InstanceStateStopping = "stopping"
+	// The instance is stopped.
+	InstanceStateStopped = "stopped"
+	// The instance is being deleted.
+	InstanceStateShuttingDown = "shutting-down"
+)
+
+// InstanceParameters define the desired state of an Amazon Lightsail
+// instance.
+type InstanceParameters struct {
+	// Region is the region you'd like your Instance to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// AvailabilityZone of the instance, like us-east-1a.
+	// +immutable
+	AvailabilityZone string `json:"availabilityZone"`
+
+	// BlueprintID is the ID of the image of the instance, like
+	// amazon_linux_2 or wordpress.
+	// +immutable
+	BlueprintID string `json:"blueprintId"`
+
+	// BundleID is the ID of the hardware bundle of the instance, like
+	// nano_2_0.
+	// +immutable
+	BundleID string `json:"bundleId"`
+
+	// KeyPairName is the name of the Lightsail key pair that can be used to
+	// connect to the instance. The default key pair of the region is used if
+	// it is not set.
+	// +immutable
+	// +optional
+	KeyPairName *string `json:"keyPairName,omitempty"`
+
+	// UserData is the launch script of the instance.
+	// +immutable
+	// +optional
+	UserData *string `json:"userData,omitempty"`
+
+	// Tags to add to the instance.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// InstanceObservation keeps the state for the external resource
+type InstanceObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the instance.
+	ARN string `json:"arn,omitempty"`
+
+	// State is the current state of the instance.
+	State string `json:"state,omitempty"`
+
+	// PublicIPAddress is the public IP address of the instance.
+	PublicIPAddress string `json:"publicIpAddress,omitempty"`
+
+	// PrivateIPAddress is the private IP address of the instance.
+	PrivateIPAddress string `json:"privateIpAddress,omitempty"`
+
+	// Username is the user name to connect to the instance with.
+	Username string `json:"username,omitempty"`
+
+	// IsStaticIP is whether the public IP address of the instance is a
+	// static IP.
+	IsStaticIP bool `json:"isStaticIp,omitempty"`
+}
+
+// An InstanceSpec defines the desired state of an Instance.
+type InstanceSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  InstanceParameters `json:"forProvider"`
+}
+
+// An InstanceStatus represents the observed state of an Instance.
+type InstanceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     InstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Instance is a managed resource that represents an Amazon Lightsail
+// instance. Its external name is the name of the instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.publicIpAddress"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Instance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceSpec   `json:"spec"`
+	Status InstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceList contains a list of Instances
+type InstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Instance `json:"items"`
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// StaticIPParameters define the desired state of an Amazon Lightsail static
+// IP.
+type StaticIPParameters struct {
+	// Region is the region you'd like your StaticIP to be allocated in.
+	// +immutable
+	Region string `json:"region"`
+
+	// InstanceName is the name of the instance that the static IP is
+	// attached to.
+	// +optional
+	InstanceName *string `json:"instanceName,omitempty"`
+
+	// InstanceNameRef references an Instance to retrieve its name.
+	// +optional
+	InstanceNameRef *runtimev1alpha1.Reference `json:"instanceNameRef,omitempty"`
+
+	// InstanceNameSelector selects a reference to an Instance to retrieve
+	// its name.
+	// +optional
+	InstanceNameSelector *runtimev1alpha1.Selector `json:"instanceNameSelector,omitempty"`
+}
+
+// StaticIPObservation keeps the state for the external resource
+type StaticIPObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the static IP.
+	ARN string `json:"arn,omitempty"`
+
+	// IPAddress is the allocated IP address.
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// AttachedTo is the name of the instance that the static IP is attached
+	// to.
+	AttachedTo string `json:"attachedTo,omitempty"`
+}
+
+// A StaticIPSpec defines the desired state of a StaticIP.
+type StaticIPSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  StaticIPParameters `json:"forProvider"`
+}
+
+// A StaticIPStatus represents the observed state of a StaticIP.
+type StaticIPStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     StaticIPObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A StaticIP is a managed resource that represents an Amazon Lightsail
+// static IP. Its external name is the name of the static IP.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.ipAddress"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".status.atProvider.attachedTo"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type StaticIP struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StaticIPSpec   `json:"spec"`
+	Status StaticIPStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StaticIPList contains a list of StaticIPs
+type StaticIPList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StaticIP `json:"items"`
+}
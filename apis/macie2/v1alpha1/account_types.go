@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// AccountParameters define the desired state of Amazon Macie in an account
+// and region.
+type AccountParameters struct {
+	// Region is the region Macie is enabled in. Macie can only be enabled
+	// once per account and region.
+	// +immutable
+	Region string `json:"region"`
+
+	// FindingPublishingFrequency specifies how frequently updated findings
+	// are published to AWS Security Hub and Amazon EventBridge.
+	// +kubebuilder:validation:Enum=FIFTEEN_MINUTES;ONE_HOUR;SIX_HOURS
+	// +optional
+	FindingPublishingFrequency *string `json:"findingPublishingFrequency,omitempty"`
+
+	// Status specifies whether Macie is running in the account. Pausing
+	// Macie stops all of its activities but keeps its configuration and
+	// findings. Defaults to ENABLED.
+	// +kubebuilder:validation:Enum=ENABLED;PAUSED
+	// +optional
+	Status *string `json:"status,omitempty"`
+}
+
+// AccountObservation keeps the state for the external resource
+type AccountObservation struct {
+	// ServiceRole is the ARN of the service-linked role that allows Macie to
+	// monitor and analyze data in AWS resources.
+	ServiceRole string `json:"serviceRole,omitempty"`
+
+	// CreatedAt is the timestamp of when Macie was enabled.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the timestamp of the most recent change to the status of
+	// Macie.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// An AccountSpec defines the desired state of an Account.
+type AccountSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AccountParameters `json:"forProvider"`
+}
+
+// An AccountStatus represents the observed state of an Account.
+type AccountStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AccountObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Account is a managed resource that enables Amazon Macie in the account
+// and region of its provider. Deleting it disables Macie, which deletes all
+// of its configuration and findings in the region.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".spec.forProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Account struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountSpec   `json:"spec"`
+	Status AccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountList contains a list of Accounts
+type AccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Account `json:"items"`
+}
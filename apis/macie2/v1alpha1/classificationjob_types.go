@@ -0,0 +1,178 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// S3BucketDefinition specifies the S3 buckets of an account that a
+// classification job analyzes.
+type S3BucketDefinition struct {
+	// AccountID is the ID of the AWS account that owns the buckets.
+	AccountID string `json:"accountId"`
+
+	// Buckets are the names of the buckets.
+	// +optional
+	Buckets []string `json:"buckets,omitempty"`
+
+	// BucketRefs references Buckets to retrieve their names.
+	// +optional
+	BucketRefs []runtimev1alpha1.Reference `json:"bucketRefs,omitempty"`
+
+	// BucketSelector selects references to Buckets to retrieve their names.
+	// +optional
+	BucketSelector *runtimev1alpha1.Selector `json:"bucketSelector,omitempty"`
+}
+
+// JobSchedule specifies the recurrence of a scheduled classification job.
+type JobSchedule struct {
+	// Frequency specifies how often the job runs.
+	// +kubebuilder:validation:Enum=DAILY;WEEKLY;MONTHLY
+	Frequency string `json:"frequency"`
+
+	// DayOfWeek is the day of the week that a weekly job runs on.
+	// +kubebuilder:validation:Enum=SUNDAY;MONDAY;TUESDAY;WEDNESDAY;THURSDAY;FRIDAY;SATURDAY
+	// +optional
+	DayOfWeek *string `json:"dayOfWeek,omitempty"`
+
+	// DayOfMonth is the day of the month that a monthly job runs on. Jobs
+	// scheduled for a day that a month doesn't have run on its last day.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=31
+	// +optional
+	DayOfMonth *int64 `json:"dayOfMonth,omitempty"`
+}
+
+// ClassificationJobParameters define the desired state of an Amazon Macie
+// classification job.
+type ClassificationJobParameters struct {
+	// Region is the region you'd like your ClassificationJob to be created
+	// in. Macie must be enabled in the region.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description of the job.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// JobType specifies whether the job runs once or on a schedule.
+	// +kubebuilder:validation:Enum=ONE_TIME;SCHEDULED
+	// +immutable
+	JobType string `json:"jobType"`
+
+	// Schedule is the recurrence of a SCHEDULED job.
+	// +immutable
+	// +optional
+	Schedule *JobSchedule `json:"schedule,omitempty"`
+
+	// InitialRun specifies whether a SCHEDULED job also analyzes all
+	// existing objects right after it is created.
+	// +immutable
+	// +optional
+	InitialRun *bool `json:"initialRun,omitempty"`
+
+	// BucketDefinitions specify the S3 buckets that the job analyzes.
+	// +immutable
+	BucketDefinitions []S3BucketDefinition `json:"bucketDefinitions"`
+
+	// SamplingPercentage is the percentage of eligible objects that the
+	// job analyzes. Defaults to 100.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +immutable
+	// +optional
+	SamplingPercentage *int64 `json:"samplingPercentage,omitempty"`
+
+	// CustomDataIdentifierIDs are the IDs of the custom data identifiers
+	// that the job uses in addition to the managed data identifiers of
+	// Macie.
+	// +immutable
+	// +optional
+	CustomDataIdentifierIDs []string `json:"customDataIdentifierIds,omitempty"`
+
+	// Paused specifies whether the job is paused. Macie cancels jobs that
+	// stay paused for more than 30 days.
+	// +optional
+	Paused *bool `json:"paused,omitempty"`
+
+	// Tags to add to the job.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// ClassificationJobObservation keeps the state for the external resource
+type ClassificationJobObservation struct {
+	// JobARN is the Amazon Resource Name (ARN) of the job.
+	JobARN string `json:"jobArn,omitempty"`
+
+	// JobStatus is the current status of the job.
+	JobStatus string `json:"jobStatus,omitempty"`
+
+	// CreatedAt is the timestamp of when the job was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// LastRunTime is the timestamp of when the job last started to run.
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+}
+
+// A ClassificationJobSpec defines the desired state of a ClassificationJob.
+type ClassificationJobSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ClassificationJobParameters `json:"forProvider"`
+}
+
+// A ClassificationJobStatus represents the observed state of a
+// ClassificationJob.
+type ClassificationJobStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ClassificationJobObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClassificationJob is a managed resource that represents an Amazon Macie
+// job that analyzes S3 objects for sensitive data. Its external name is the
+// ID of the job. Macie jobs can't be deleted, so deleting a
+// ClassificationJob cancels its job.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.jobType"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.jobStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ClassificationJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClassificationJobSpec   `json:"spec"`
+	Status ClassificationJobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClassificationJobList contains a list of ClassificationJobs
+type ClassificationJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClassificationJob `json:"items"`
+}
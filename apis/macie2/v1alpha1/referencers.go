@@ -0,0 +1,53 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// ResolveReferences of this ClassificationJob
+func (mg *ClassificationJob) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	for i := range mg.Spec.ForProvider.BucketDefinitions {
+		d := &mg.Spec.ForProvider.BucketDefinitions[i]
+
+		// Resolve spec.forProvider.bucketDefinitions[].buckets
+		mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: d.Buckets,
+			References:    d.BucketRefs,
+			Selector:      d.BucketSelector,
+			To:            reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+			Extract:       reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.bucketDefinitions[%d].buckets", i)
+		}
+		d.Buckets = mrsp.ResolvedValues
+		d.BucketRefs = mrsp.ResolvedReferences
+	}
+
+	return nil
+}
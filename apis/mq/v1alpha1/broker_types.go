@@ -0,0 +1,320 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Amazon MQ broker states.
+const (
+	// The broker is being created.
+	BrokerStateCreationInProgress = "CREATION_IN_PROGRESS"
+	// The broker could not be created.
+	BrokerStateCreationFailed = "CREATION_FAILED"
+	// The broker is being deleted.
+	BrokerStateDeletionInProgress = "DELETION_IN_PROGRESS"
+	// The broker is running and can be used.
+	BrokerStateRunning = "RUNNING"
+	// The broker is being rebooted.
+	BrokerStateRebootInProgress = "REBOOT_IN_PROGRESS"
+)
+
+// Amazon MQ broker engine types.
+const (
+	// EngineTypeActiveMQ is the Apache ActiveMQ engine.
+	EngineTypeActiveMQ = "ACTIVEMQ"
+	// EngineTypeRabbitMQ is the RabbitMQ engine.
+	EngineTypeRabbitMQ = "RABBITMQ"
+)
+
+// Tag is a key-value pair that is attached to an Amazon MQ broker.
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// User is a user of a broker that is able to access its queues and topics.
+type User struct {
+	// Username is the name of the user. It can contain only alphanumeric
+	// characters, dashes, periods, underscores, and tildes.
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=100
+	Username string `json:"username"`
+
+	// PasswordSecretRef references the key of a secret that contains the
+	// password of the user. The password must be at least 12 characters
+	// long.
+	PasswordSecretRef runtimev1alpha1.SecretKeySelector `json:"passwordSecretRef"`
+
+	// ConsoleAccess enables access to the ActiveMQ Web Console for the user.
+	// +optional
+	ConsoleAccess *bool `json:"consoleAccess,omitempty"`
+
+	// Groups are the ActiveMQ groups that the user belongs to.
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// WeeklyStartTime is the start of the weekly maintenance window of a broker.
+type WeeklyStartTime struct {
+	// DayOfWeek is the day of the week that the maintenance window starts.
+	// +crossplane:aws:model=mq.WeeklyStartTime.DayOfWeek
+	// +kubebuilder:validation:Enum=MONDAY;TUESDAY;WEDNESDAY;THURSDAY;FRIDAY;SATURDAY;SUNDAY
+	DayOfWeek string `json:"dayOfWeek"`
+
+	// TimeOfDay is the time that the maintenance window starts, in the
+	// 24-hour format hh:mm.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	TimeOfDay string `json:"timeOfDay"`
+
+	// TimeZone is the time zone of TimeOfDay, either in the Country/City or
+	// in the UTC offset format.
+	// Default: UTC
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// ConfigurationID is a revision of an Amazon MQ configuration.
+type ConfigurationID struct {
+	// ID is the unique ID of the configuration.
+	ID string `json:"id"`
+
+	// Revision of the configuration.
+	// Default: the latest revision of the configuration.
+	// +optional
+	Revision *int64 `json:"revision,omitempty"`
+}
+
+// EncryptionOptions are the encryption at rest settings of a broker.
+type EncryptionOptions struct {
+	// KMSKeyID is the customer master key (CMK) to use for the encryption
+	// of the broker data. It is only used if UseAWSOwnedKey is false.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// UseAWSOwnedKey enables the use of an AWS owned CMK instead of a CMK
+	// that is managed by the customer.
+	UseAWSOwnedKey bool `json:"useAwsOwnedKey"`
+}
+
+// Logs are the types of logs that a broker publishes to CloudWatch Logs.
+type Logs struct {
+	// Audit enables audit logging. It is only supported by ActiveMQ
+	// brokers.
+	// +optional
+	Audit *bool `json:"audit,omitempty"`
+
+	// General enables general logging.
+	// +optional
+	General *bool `json:"general,omitempty"`
+}
+
+// BrokerParameters define the desired state of an Amazon MQ broker.
+type BrokerParameters struct {
+	// Region is the region you'd like the Broker to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// EngineType is the message broker engine of the broker.
+	// +kubebuilder:validation:Enum=ACTIVEMQ;RABBITMQ
+	// +immutable
+	EngineType string `json:"engineType"`
+
+	// EngineVersion is the version of the broker engine.
+	EngineVersion string `json:"engineVersion"`
+
+	// HostInstanceType is the instance type of the broker instances, for
+	// example mq.m5.large.
+	HostInstanceType string `json:"hostInstanceType"`
+
+	// DeploymentMode is the deployment mode of the broker. ActiveMQ brokers
+	// support SINGLE_INSTANCE and ACTIVE_STANDBY_MULTI_AZ, RabbitMQ brokers
+	// support SINGLE_INSTANCE and CLUSTER_MULTI_AZ.
+	// +kubebuilder:validation:Enum=SINGLE_INSTANCE;ACTIVE_STANDBY_MULTI_AZ;CLUSTER_MULTI_AZ
+	// +immutable
+	DeploymentMode string `json:"deploymentMode"`
+
+	// StorageType is the storage type of the broker. EFS is only supported
+	// by ActiveMQ brokers.
+	// Default: EFS for ActiveMQ brokers, EBS for RabbitMQ brokers.
+	// +crossplane:aws:model=mq.CreateBrokerInput.StorageType
+	// +kubebuilder:validation:Enum=EBS;EFS
+	// +immutable
+	// +optional
+	StorageType *string `json:"storageType,omitempty"`
+
+	// Users are the users of the broker. At least one user is required. The
+	// users of a RabbitMQ broker can only be set when the broker is created,
+	// and only the first user is used.
+	// +kubebuilder:validation:MinItems=1
+	Users []User `json:"users"`
+
+	// AutoMinorVersionUpgrade enables the automatic upgrade to new minor
+	// versions of the broker engine during the maintenance window.
+	// Default: false
+	// +optional
+	AutoMinorVersionUpgrade *bool `json:"autoMinorVersionUpgrade,omitempty"`
+
+	// MaintenanceWindowStartTime is the start of the weekly maintenance
+	// window of the broker, during which pending changes are applied.
+	// +immutable
+	// +optional
+	MaintenanceWindowStartTime *WeeklyStartTime `json:"maintenanceWindowStartTime,omitempty"`
+
+	// Configuration is the configuration revision to apply to the broker.
+	// It is only supported by ActiveMQ brokers.
+	// +optional
+	Configuration *ConfigurationID `json:"configuration,omitempty"`
+
+	// EncryptionOptions are the encryption at rest settings of the broker.
+	// +immutable
+	// +optional
+	EncryptionOptions *EncryptionOptions `json:"encryptionOptions,omitempty"`
+
+	// Logs are the types of logs that the broker publishes to CloudWatch
+	// Logs.
+	// +optional
+	Logs *Logs `json:"logs,omitempty"`
+
+	// PubliclyAccessible enables connections from applications outside of
+	// the VPC that hosts the broker subnets.
+	// Default: false
+	// +immutable
+	// +optional
+	PubliclyAccessible *bool `json:"publiclyAccessible,omitempty"`
+
+	// SubnetIDs are the subnets of the broker instances. A SINGLE_INSTANCE
+	// broker needs one subnet, an ACTIVE_STANDBY_MULTI_AZ broker needs two.
+	// Default: subnets of the default VPC.
+	// +immutable
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs are references to Subnets used to set the SubnetIDs.
+	// +immutable
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set the
+	// SubnetIDs.
+	// +immutable
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the security groups of the broker instances.
+	// Default: the default security group of the VPC.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs are references to SecurityGroups used to set the
+	// SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// Tags to assign to the broker.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// BrokerInstance is an instance of a broker.
+type BrokerInstance struct {
+	// ConsoleURL is the URL of the web console of the instance.
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
+	// Endpoints are the wire-level protocol endpoints of the instance.
+	Endpoints []string `json:"endpoints,omitempty"`
+
+	// IPAddress is the IP address of the Elastic Network Interface (ENI) of
+	// the instance.
+	IPAddress string `json:"ipAddress,omitempty"`
+}
+
+// BrokerObservation is the representation of the current state that is
+// observed.
+type BrokerObservation struct {
+	// BrokerARN is the Amazon Resource Name (ARN) of the broker.
+	BrokerARN string `json:"brokerArn,omitempty"`
+
+	// BrokerID is the unique ID that Amazon MQ generates for the broker.
+	BrokerID string `json:"brokerId,omitempty"`
+
+	// BrokerState is the current state of the broker.
+	BrokerState string `json:"brokerState,omitempty"`
+
+	// BrokerInstances are the instances of the broker.
+	BrokerInstances []BrokerInstance `json:"brokerInstances,omitempty"`
+
+	// PendingEngineVersion is the engine version that the broker is upgraded
+	// to during the next maintenance window.
+	PendingEngineVersion string `json:"pendingEngineVersion,omitempty"`
+
+	// PendingHostInstanceType is the instance type that the broker is
+	// changed to during the next maintenance window.
+	PendingHostInstanceType string `json:"pendingHostInstanceType,omitempty"`
+}
+
+// BrokerSpec defines the desired state of an Amazon MQ Broker.
+type BrokerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  BrokerParameters `json:"forProvider"`
+}
+
+// BrokerStatus represents the observed state of an Amazon MQ Broker.
+type BrokerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     BrokerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Broker is a managed resource that represents an Amazon MQ message broker
+// that runs either ActiveMQ or RabbitMQ.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.brokerState"
+// +kubebuilder:printcolumn:name="ENGINE",type="string",JSONPath=".spec.forProvider.engineType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Broker struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BrokerSpec   `json:"spec"`
+	Status BrokerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BrokerList contains a list of Broker
+type BrokerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Broker `json:"items"`
+}
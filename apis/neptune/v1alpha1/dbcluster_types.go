@@ -0,0 +1,279 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Neptune DB cluster states.
+const (
+	// The cluster is healthy and available.
+	DBClusterStateAvailable = "available"
+	// The cluster is being created or restored from a snapshot.
+	DBClusterStateCreating = "creating"
+	// The cluster is being deleted.
+	DBClusterStateDeleting = "deleting"
+	// The cluster is being modified.
+	DBClusterStateModifying = "modifying"
+	// The cluster is being backed up.
+	DBClusterStateBackingUp = "backing-up"
+)
+
+// LogTypeAudit is the only log type that Neptune exports to CloudWatch Logs.
+const LogTypeAudit = "audit"
+
+// Tag is a key-value pair that is attached to a Neptune resource.
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// DBClusterParameters define the desired state of an AWS Neptune DB cluster.
+type DBClusterParameters struct {
+	// Region is the region you'd like the DBCluster to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// EngineVersion is the version number of the Neptune engine to use.
+	// Default: the latest version available in the region.
+	// +optional
+	EngineVersion *string `json:"engineVersion,omitempty"`
+
+	// Port is the port number on which the instances in the DB cluster
+	// accept connections.
+	// Default: 8182
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// AvailabilityZones are the EC2 Availability Zones that instances in the
+	// DB cluster can be created in.
+	// +immutable
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// DBClusterParameterGroupName is the name of the DB cluster parameter
+	// group to associate with this DB cluster.
+	// Default: default.neptune1
+	// +optional
+	DBClusterParameterGroupName *string `json:"dbClusterParameterGroupName,omitempty"`
+
+	// DBSubnetGroupName is the DB subnet group to associate with this DB
+	// cluster.
+	// +immutable
+	// +optional
+	DBSubnetGroupName *string `json:"dbSubnetGroupName,omitempty"`
+
+	// DBSubnetGroupNameRef is a reference to a DBSubnetGroup used to set
+	// DBSubnetGroupName.
+	// +immutable
+	// +optional
+	DBSubnetGroupNameRef *runtimev1alpha1.Reference `json:"dbSubnetGroupNameRef,omitempty"`
+
+	// DBSubnetGroupNameSelector selects a reference to a DBSubnetGroup used to
+	// set DBSubnetGroupName.
+	// +immutable
+	// +optional
+	DBSubnetGroupNameSelector *runtimev1alpha1.Selector `json:"dbSubnetGroupNameSelector,omitempty"`
+
+	// VPCSecurityGroupIDs is a list of EC2 VPC security groups to associate
+	// with this DB cluster.
+	// +optional
+	VPCSecurityGroupIDs []string `json:"vpcSecurityGroupIds,omitempty"`
+
+	// VPCSecurityGroupIDRefs are references to VPCSecurityGroups used to set
+	// the VPCSecurityGroupIDs.
+	// +optional
+	VPCSecurityGroupIDRefs []runtimev1alpha1.Reference `json:"vpcSecurityGroupIdRefs,omitempty"`
+
+	// VPCSecurityGroupIDSelector selects references to VPCSecurityGroups used
+	// to set the VPCSecurityGroupIDs.
+	// +optional
+	VPCSecurityGroupIDSelector *runtimev1alpha1.Selector `json:"vpcSecurityGroupIdSelector,omitempty"`
+
+	// BackupRetentionPeriod is the number of days for which automated backups
+	// are retained.
+	// Default: 1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=35
+	// +optional
+	BackupRetentionPeriod *int64 `json:"backupRetentionPeriod,omitempty"`
+
+	// PreferredBackupWindow is the daily time range during which automated
+	// backups are created, in the format hh24:mi-hh24:mi in UTC.
+	// +optional
+	PreferredBackupWindow *string `json:"preferredBackupWindow,omitempty"`
+
+	// PreferredMaintenanceWindow is the weekly time range during which system
+	// maintenance can occur, in the format ddd:hh24:mi-ddd:hh24:mi in UTC.
+	// +optional
+	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
+
+	// StorageEncrypted specifies whether the DB cluster is encrypted.
+	// +immutable
+	// +optional
+	StorageEncrypted *bool `json:"storageEncrypted,omitempty"`
+
+	// KMSKeyID is the AWS KMS key identifier for an encrypted DB cluster.
+	// If StorageEncrypted is true and KMSKeyID is not set, the default
+	// encryption key of the account is used.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// DeletionProtection specifies whether the DB cluster can be deleted.
+	// The DB cluster can't be deleted while it is set to true.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+
+	// EnableIAMDatabaseAuthentication specifies whether AWS Identity and
+	// Access Management (IAM) accounts are mapped to database accounts.
+	// Default: false
+	// +optional
+	EnableIAMDatabaseAuthentication *bool `json:"enableIamDatabaseAuthentication,omitempty"`
+
+	// EnableCloudwatchLogsExports is the list of log types to export to
+	// CloudWatch Logs. The only log type of Neptune is audit, which also needs
+	// the neptune_enable_audit_log parameter of the DB cluster parameter group
+	// to be set.
+	// +optional
+	EnableCloudwatchLogsExports []string `json:"enableCloudwatchLogsExports,omitempty"`
+
+	// SnapshotIdentifier is the identifier of the DB cluster snapshot to
+	// restore the DB cluster from. The DB cluster is created empty if it is
+	// not set.
+	// +immutable
+	// +optional
+	SnapshotIdentifier *string `json:"snapshotIdentifier,omitempty"`
+
+	// ApplyImmediately specifies whether modifications are applied
+	// immediately, or during the next maintenance window.
+	// Default: false
+	// +optional
+	ApplyImmediately *bool `json:"applyImmediately,omitempty"`
+
+	// SkipFinalSnapshot determines whether a final DB cluster snapshot is
+	// created before the DB cluster is deleted.
+	// FinalDBSnapshotIdentifier must be set if SkipFinalSnapshot is false.
+	// Default: false
+	// +optional
+	SkipFinalSnapshot *bool `json:"skipFinalSnapshot,omitempty"`
+
+	// FinalDBSnapshotIdentifier is the identifier of the DB cluster snapshot
+	// created when SkipFinalSnapshot is false.
+	// +optional
+	FinalDBSnapshotIdentifier *string `json:"finalDBSnapshotIdentifier,omitempty"`
+
+	// Tags to assign to the DB cluster.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// DBClusterMember is an instance that is part of a DB cluster.
+type DBClusterMember struct {
+	// DBInstanceIdentifier is the identifier of the instance.
+	DBInstanceIdentifier string `json:"dbInstanceIdentifier,omitempty"`
+
+	// IsClusterWriter is true if the instance is the primary instance of the
+	// DB cluster.
+	IsClusterWriter bool `json:"isClusterWriter,omitempty"`
+
+	// PromotionTier is the order in which a replica is promoted to the
+	// primary instance after a failure of the existing primary instance.
+	PromotionTier int64 `json:"promotionTier,omitempty"`
+}
+
+// DBClusterObservation is the representation of the current state that is
+// observed.
+type DBClusterObservation struct {
+	// DBClusterARN is the Amazon Resource Name (ARN) of the DB cluster.
+	DBClusterARN string `json:"dbClusterArn,omitempty"`
+
+	// DBClusterResourceID is the AWS Region-unique, immutable identifier of
+	// the DB cluster.
+	DBClusterResourceID string `json:"dbClusterResourceId,omitempty"`
+
+	// Status is the current state of the DB cluster.
+	Status string `json:"status,omitempty"`
+
+	// Endpoint is the connection endpoint of the primary instance of the DB
+	// cluster.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// ReaderEndpoint is the endpoint that load-balances connections across
+	// the read replicas of the DB cluster.
+	ReaderEndpoint string `json:"readerEndpoint,omitempty"`
+
+	// Port is the port that the DB cluster listens on.
+	Port int64 `json:"port,omitempty"`
+
+	// HostedZoneID is the ID that Amazon Route 53 assigns when you create a
+	// hosted zone.
+	HostedZoneID string `json:"hostedZoneId,omitempty"`
+
+	// MultiAZ specifies whether the DB cluster has instances in multiple
+	// Availability Zones.
+	MultiAZ bool `json:"multiAZ,omitempty"`
+
+	// Members are the instances that make up the DB cluster.
+	Members []DBClusterMember `json:"members,omitempty"`
+}
+
+// DBClusterSpec defines the desired state of an AWS Neptune DBCluster.
+type DBClusterSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DBClusterParameters `json:"forProvider"`
+}
+
+// DBClusterStatus represents the observed state of an AWS Neptune DBCluster.
+type DBClusterStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DBClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBCluster is a managed resource that represents an AWS Neptune DB
+// cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBClusterSpec   `json:"spec"`
+	Status DBClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBClusterList contains a list of DBCluster
+type DBClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBCluster `json:"items"`
+}
@@ -0,0 +1,380 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag is a key-value pair that is attached to an OpenSearch Service domain.
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// ClusterConfig configures the instances of a domain.
+type ClusterConfig struct {
+	// InstanceType is the instance type of the data nodes, for example
+	// r5.large.elasticsearch.
+	InstanceType string `json:"instanceType"`
+
+	// InstanceCount is the number of data nodes.
+	// Default: 1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	InstanceCount *int64 `json:"instanceCount,omitempty"`
+
+	// DedicatedMasterEnabled specifies whether the domain uses dedicated
+	// master nodes.
+	// Default: false
+	// +optional
+	DedicatedMasterEnabled *bool `json:"dedicatedMasterEnabled,omitempty"`
+
+	// DedicatedMasterType is the instance type of the dedicated master nodes.
+	// +optional
+	DedicatedMasterType *string `json:"dedicatedMasterType,omitempty"`
+
+	// DedicatedMasterCount is the number of dedicated master nodes.
+	// +kubebuilder:validation:Minimum=3
+	// +optional
+	DedicatedMasterCount *int64 `json:"dedicatedMasterCount,omitempty"`
+
+	// ZoneAwarenessEnabled specifies whether the nodes are spread across
+	// Availability Zones.
+	// Default: false
+	// +optional
+	ZoneAwarenessEnabled *bool `json:"zoneAwarenessEnabled,omitempty"`
+
+	// AvailabilityZoneCount is the number of Availability Zones the nodes are
+	// spread across when zone awareness is enabled.
+	// Default: 2
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=3
+	// +optional
+	AvailabilityZoneCount *int64 `json:"availabilityZoneCount,omitempty"`
+
+	// WarmEnabled specifies whether the domain uses UltraWarm nodes.
+	// Default: false
+	// +optional
+	WarmEnabled *bool `json:"warmEnabled,omitempty"`
+
+	// WarmType is the instance type of the UltraWarm nodes.
+	// +optional
+	WarmType *string `json:"warmType,omitempty"`
+
+	// WarmCount is the number of UltraWarm nodes.
+	// +kubebuilder:validation:Minimum=2
+	// +optional
+	WarmCount *int64 `json:"warmCount,omitempty"`
+}
+
+// EBSOptions configures the EBS volumes attached to the data nodes.
+type EBSOptions struct {
+	// EBSEnabled specifies whether EBS volumes are attached to the data
+	// nodes. Instance types without instance storage require EBS volumes.
+	EBSEnabled bool `json:"ebsEnabled"`
+
+	// VolumeType is the type of the EBS volumes.
+	// +crossplane:aws:model=elasticsearchservice.EBSOptions.VolumeType
+	// +kubebuilder:validation:Enum=standard;gp2;io1
+	// +optional
+	VolumeType *string `json:"volumeType,omitempty"`
+
+	// VolumeSize is the size of each EBS volume in GiB.
+	// +kubebuilder:validation:Minimum=10
+	// +optional
+	VolumeSize *int64 `json:"volumeSize,omitempty"`
+
+	// IOPS is the baseline I/O performance of each io1 EBS volume.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+}
+
+// VPCOptions places a domain in a VPC. A domain is publicly accessible if it
+// is not placed in a VPC.
+type VPCOptions struct {
+	// SubnetIDs are the subnets that the domain has endpoints in. One subnet
+	// is needed per Availability Zone of the domain.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs are references to Subnets used to set the SubnetIDs.
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the security groups of the endpoints of the
+	// domain.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs are references to SecurityGroups used to set the
+	// SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+}
+
+// AdvancedSecurityOptions configures fine-grained access control of a
+// domain. Fine-grained access control needs node-to-node encryption,
+// encryption at rest and enforced HTTPS.
+type AdvancedSecurityOptions struct {
+	// Enabled specifies whether fine-grained access control is enabled.
+	Enabled bool `json:"enabled"`
+
+	// InternalUserDatabaseEnabled specifies whether the master user is
+	// stored in the internal user database of the domain. Otherwise the
+	// master user is an IAM principal.
+	// Default: false
+	// +optional
+	InternalUserDatabaseEnabled *bool `json:"internalUserDatabaseEnabled,omitempty"`
+
+	// MasterUserARN is the ARN of the IAM principal that is the master user.
+	// It can only be set if InternalUserDatabaseEnabled is false.
+	// +optional
+	MasterUserARN *string `json:"masterUserArn,omitempty"`
+
+	// MasterUserName is the name of the master user in the internal user
+	// database.
+	// +optional
+	MasterUserName *string `json:"masterUserName,omitempty"`
+
+	// MasterUserPasswordSecretRef references the secret key that contains
+	// the password of the master user in the internal user database.
+	// Changing the referenced password changes the password of the master
+	// user.
+	// +optional
+	MasterUserPasswordSecretRef *runtimev1alpha1.SecretKeySelector `json:"masterUserPasswordSecretRef,omitempty"`
+}
+
+// EncryptionAtRestOptions configures the encryption of the data of a domain.
+type EncryptionAtRestOptions struct {
+	// Enabled specifies whether the data of the domain is encrypted.
+	Enabled bool `json:"enabled"`
+
+	// KMSKeyID is the AWS KMS key used to encrypt the data. The AWS managed
+	// key of the service is used if it is not set.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+}
+
+// DomainEndpointOptions configures the HTTPS endpoint of a domain.
+type DomainEndpointOptions struct {
+	// EnforceHTTPS specifies whether plain HTTP requests are rejected.
+	// Default: false
+	// +optional
+	EnforceHTTPS *bool `json:"enforceHttps,omitempty"`
+
+	// TLSSecurityPolicy is the TLS security policy of the HTTPS endpoint.
+	// Default: Policy-Min-TLS-1-0-2019-07
+	// +crossplane:aws:model=elasticsearchservice.DomainEndpointOptions.TLSSecurityPolicy
+	// +kubebuilder:validation:Enum=Policy-Min-TLS-1-0-2019-07;Policy-Min-TLS-1-2-2019-07
+	// +optional
+	TLSSecurityPolicy *string `json:"tlsSecurityPolicy,omitempty"`
+}
+
+// DomainParameters define the desired state of an AWS OpenSearch Service
+// domain.
+type DomainParameters struct {
+	// Region is the region you'd like the Domain to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// ElasticsearchVersion is the version of the search engine of the
+	// domain, for example 7.9.
+	// Default: 1.5
+	// +immutable
+	// +optional
+	ElasticsearchVersion *string `json:"elasticsearchVersion,omitempty"`
+
+	// ClusterConfig configures the instances of the domain.
+	// +optional
+	ClusterConfig *ClusterConfig `json:"clusterConfig,omitempty"`
+
+	// EBSOptions configures the EBS volumes of the data nodes.
+	// +optional
+	EBSOptions *EBSOptions `json:"ebsOptions,omitempty"`
+
+	// VPCOptions places the domain in a VPC.
+	// +immutable
+	// +optional
+	VPCOptions *VPCOptions `json:"vpcOptions,omitempty"`
+
+	// AccessPolicies is the IAM policy document that controls access to the
+	// domain.
+	// +optional
+	AccessPolicies *string `json:"accessPolicies,omitempty"`
+
+	// AdvancedOptions are advanced cluster settings, for example
+	// rest.action.multi.allow_explicit_index. Settings that are not listed
+	// keep the values chosen by AWS.
+	// +optional
+	AdvancedOptions map[string]string `json:"advancedOptions,omitempty"`
+
+	// AdvancedSecurityOptions configures fine-grained access control.
+	// +optional
+	AdvancedSecurityOptions *AdvancedSecurityOptions `json:"advancedSecurityOptions,omitempty"`
+
+	// EncryptionAtRestOptions configures the encryption of the data of the
+	// domain.
+	// +immutable
+	// +optional
+	EncryptionAtRestOptions *EncryptionAtRestOptions `json:"encryptionAtRestOptions,omitempty"`
+
+	// NodeToNodeEncryptionEnabled specifies whether the traffic between the
+	// nodes of the domain is encrypted.
+	// Default: false
+	// +immutable
+	// +optional
+	NodeToNodeEncryptionEnabled *bool `json:"nodeToNodeEncryptionEnabled,omitempty"`
+
+	// DomainEndpointOptions configures the HTTPS endpoint of the domain.
+	// +optional
+	DomainEndpointOptions *DomainEndpointOptions `json:"domainEndpointOptions,omitempty"`
+
+	// AutomatedSnapshotStartHour is the hour in UTC at which the daily
+	// automated snapshot of the domain is taken.
+	// Default: 0
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	// +optional
+	AutomatedSnapshotStartHour *int64 `json:"automatedSnapshotStartHour,omitempty"`
+
+	// AutoServiceSoftwareUpdate specifies whether available service software
+	// updates are started as soon as they are observed. Otherwise they are
+	// applied by AWS on their automated update date.
+	// Default: false
+	// +optional
+	AutoServiceSoftwareUpdate *bool `json:"autoServiceSoftwareUpdate,omitempty"`
+
+	// Tags to assign to the domain.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ServiceSoftwareObservation is the observed state of the service software
+// of a domain.
+type ServiceSoftwareObservation struct {
+	// CurrentVersion is the version of the service software that the domain
+	// runs.
+	CurrentVersion string `json:"currentVersion,omitempty"`
+
+	// NewVersion is the version of the available service software update.
+	NewVersion string `json:"newVersion,omitempty"`
+
+	// UpdateAvailable is true if a service software update is available.
+	UpdateAvailable bool `json:"updateAvailable,omitempty"`
+
+	// UpdateStatus is the status of the service software update, one of
+	// PENDING_UPDATE, IN_PROGRESS, COMPLETED, NOT_ELIGIBLE or ELIGIBLE.
+	UpdateStatus string `json:"updateStatus,omitempty"`
+
+	// AutomatedUpdateDate is the time at which AWS applies the service
+	// software update if it is not started before.
+	AutomatedUpdateDate *metav1.Time `json:"automatedUpdateDate,omitempty"`
+}
+
+// DomainObservation is the representation of the current state that is
+// observed.
+type DomainObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the domain.
+	ARN string `json:"arn,omitempty"`
+
+	// DomainID is the unique identifier of the domain.
+	DomainID string `json:"domainId,omitempty"`
+
+	// Endpoint is the endpoint of a domain that is not placed in a VPC.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// VPCEndpoint is the endpoint of a domain that is placed in a VPC.
+	VPCEndpoint string `json:"vpcEndpoint,omitempty"`
+
+	// VPCID is the ID of the VPC that the domain is placed in.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// Created is true once the domain has been created. The domain may not
+	// be able to serve requests until its endpoint is observed.
+	Created bool `json:"created,omitempty"`
+
+	// Deleted is true while the domain is being deleted.
+	Deleted bool `json:"deleted,omitempty"`
+
+	// Processing is true while a configuration change is being applied to
+	// the domain with a blue/green deployment.
+	Processing bool `json:"processing,omitempty"`
+
+	// UpgradeProcessing is true while the search engine version of the
+	// domain is being upgraded.
+	UpgradeProcessing bool `json:"upgradeProcessing,omitempty"`
+
+	// ServiceSoftware is the state of the service software of the domain.
+	ServiceSoftware ServiceSoftwareObservation `json:"serviceSoftware,omitempty"`
+}
+
+// DomainSpec defines the desired state of an AWS OpenSearch Service Domain.
+type DomainSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DomainParameters `json:"forProvider"`
+}
+
+// DomainStatus represents the observed state of an AWS OpenSearch Service
+// Domain.
+type DomainStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Domain is a managed resource that represents an AWS OpenSearch Service
+// (formerly Elasticsearch Service) domain.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.elasticsearchVersion"
+// +kubebuilder:printcolumn:name="PROCESSING",type="boolean",JSONPath=".status.atProvider.processing"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Domain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainSpec   `json:"spec"`
+	Status DomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainList contains a list of Domain
+type DomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Domain `json:"items"`
+}
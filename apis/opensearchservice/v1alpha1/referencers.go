@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	network "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// ResolveReferences of this Domain
+func (mg *Domain) ResolveReferences(ctx context.Context, c client.Reader) error {
+	if mg.Spec.ForProvider.VPCOptions == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, mg)
+	vpc := mg.Spec.ForProvider.VPCOptions
+
+	// Resolve spec.forProvider.vpcOptions.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: vpc.SubnetIDs,
+		References:    vpc.SubnetIDRefs,
+		Selector:      vpc.SubnetIDSelector,
+		To:            reference.To{Managed: &network.Subnet{}, List: &network.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcOptions.subnetIds")
+	}
+	vpc.SubnetIDs = mrsp.ResolvedValues
+	vpc.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.vpcOptions.securityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: vpc.SecurityGroupIDs,
+		References:    vpc.SecurityGroupIDRefs,
+		Selector:      vpc.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &network.SecurityGroup{}, List: &network.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcOptions.securityGroupIds")
+	}
+	vpc.SecurityGroupIDs = mrsp.ResolvedValues
+	vpc.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -0,0 +1,466 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdvancedSecurityOptions) DeepCopyInto(out *AdvancedSecurityOptions) {
+	*out = *in
+	if in.InternalUserDatabaseEnabled != nil {
+		in, out := &in.InternalUserDatabaseEnabled, &out.InternalUserDatabaseEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MasterUserARN != nil {
+		in, out := &in.MasterUserARN, &out.MasterUserARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUserName != nil {
+		in, out := &in.MasterUserName, &out.MasterUserName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUserPasswordSecretRef != nil {
+		in, out := &in.MasterUserPasswordSecretRef, &out.MasterUserPasswordSecretRef
+		*out = new(corev1alpha1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvancedSecurityOptions.
+func (in *AdvancedSecurityOptions) DeepCopy() *AdvancedSecurityOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AdvancedSecurityOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
+	*out = *in
+	if in.InstanceCount != nil {
+		in, out := &in.InstanceCount, &out.InstanceCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DedicatedMasterEnabled != nil {
+		in, out := &in.DedicatedMasterEnabled, &out.DedicatedMasterEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DedicatedMasterType != nil {
+		in, out := &in.DedicatedMasterType, &out.DedicatedMasterType
+		*out = new(string)
+		**out = **in
+	}
+	if in.DedicatedMasterCount != nil {
+		in, out := &in.DedicatedMasterCount, &out.DedicatedMasterCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ZoneAwarenessEnabled != nil {
+		in, out := &in.ZoneAwarenessEnabled, &out.ZoneAwarenessEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AvailabilityZoneCount != nil {
+		in, out := &in.AvailabilityZoneCount, &out.AvailabilityZoneCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WarmEnabled != nil {
+		in, out := &in.WarmEnabled, &out.WarmEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WarmType != nil {
+		in, out := &in.WarmType, &out.WarmType
+		*out = new(string)
+		**out = **in
+	}
+	if in.WarmCount != nil {
+		in, out := &in.WarmCount, &out.WarmCount
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfig.
+func (in *ClusterConfig) DeepCopy() *ClusterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Domain) DeepCopyInto(out *Domain) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Domain.
+func (in *Domain) DeepCopy() *Domain {
+	if in == nil {
+		return nil
+	}
+	out := new(Domain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Domain) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainEndpointOptions) DeepCopyInto(out *DomainEndpointOptions) {
+	*out = *in
+	if in.EnforceHTTPS != nil {
+		in, out := &in.EnforceHTTPS, &out.EnforceHTTPS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSSecurityPolicy != nil {
+		in, out := &in.TLSSecurityPolicy, &out.TLSSecurityPolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainEndpointOptions.
+func (in *DomainEndpointOptions) DeepCopy() *DomainEndpointOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainEndpointOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainList) DeepCopyInto(out *DomainList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Domain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainList.
+func (in *DomainList) DeepCopy() *DomainList {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DomainList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainObservation) DeepCopyInto(out *DomainObservation) {
+	*out = *in
+	in.ServiceSoftware.DeepCopyInto(&out.ServiceSoftware)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainObservation.
+func (in *DomainObservation) DeepCopy() *DomainObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainParameters) DeepCopyInto(out *DomainParameters) {
+	*out = *in
+	if in.ElasticsearchVersion != nil {
+		in, out := &in.ElasticsearchVersion, &out.ElasticsearchVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClusterConfig != nil {
+		in, out := &in.ClusterConfig, &out.ClusterConfig
+		*out = new(ClusterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EBSOptions != nil {
+		in, out := &in.EBSOptions, &out.EBSOptions
+		*out = new(EBSOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCOptions != nil {
+		in, out := &in.VPCOptions, &out.VPCOptions
+		*out = new(VPCOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessPolicies != nil {
+		in, out := &in.AccessPolicies, &out.AccessPolicies
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvancedOptions != nil {
+		in, out := &in.AdvancedOptions, &out.AdvancedOptions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AdvancedSecurityOptions != nil {
+		in, out := &in.AdvancedSecurityOptions, &out.AdvancedSecurityOptions
+		*out = new(AdvancedSecurityOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EncryptionAtRestOptions != nil {
+		in, out := &in.EncryptionAtRestOptions, &out.EncryptionAtRestOptions
+		*out = new(EncryptionAtRestOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeToNodeEncryptionEnabled != nil {
+		in, out := &in.NodeToNodeEncryptionEnabled, &out.NodeToNodeEncryptionEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DomainEndpointOptions != nil {
+		in, out := &in.DomainEndpointOptions, &out.DomainEndpointOptions
+		*out = new(DomainEndpointOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutomatedSnapshotStartHour != nil {
+		in, out := &in.AutomatedSnapshotStartHour, &out.AutomatedSnapshotStartHour
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AutoServiceSoftwareUpdate != nil {
+		in, out := &in.AutoServiceSoftwareUpdate, &out.AutoServiceSoftwareUpdate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainParameters.
+func (in *DomainParameters) DeepCopy() *DomainParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSpec.
+func (in *DomainSpec) DeepCopy() *DomainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainStatus) DeepCopyInto(out *DomainStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainStatus.
+func (in *DomainStatus) DeepCopy() *DomainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EBSOptions) DeepCopyInto(out *EBSOptions) {
+	*out = *in
+	if in.VolumeType != nil {
+		in, out := &in.VolumeType, &out.VolumeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeSize != nil {
+		in, out := &in.VolumeSize, &out.VolumeSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSOptions.
+func (in *EBSOptions) DeepCopy() *EBSOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EBSOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionAtRestOptions) DeepCopyInto(out *EncryptionAtRestOptions) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionAtRestOptions.
+func (in *EncryptionAtRestOptions) DeepCopy() *EncryptionAtRestOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionAtRestOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceSoftwareObservation) DeepCopyInto(out *ServiceSoftwareObservation) {
+	*out = *in
+	if in.AutomatedUpdateDate != nil {
+		in, out := &in.AutomatedUpdateDate, &out.AutomatedUpdateDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSoftwareObservation.
+func (in *ServiceSoftwareObservation) DeepCopy() *ServiceSoftwareObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceSoftwareObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCOptions) DeepCopyInto(out *VPCOptions) {
+	*out = *in
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDRefs != nil {
+		in, out := &in.SubnetIDRefs, &out.SubnetIDRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDRefs != nil {
+		in, out := &in.SecurityGroupIDRefs, &out.SecurityGroupIDRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDSelector != nil {
+		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCOptions.
+func (in *VPCOptions) DeepCopy() *VPCOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCOptions)
+	in.DeepCopyInto(out)
+	return out
+}
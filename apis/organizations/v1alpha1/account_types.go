@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// AccountParameters define the desired state of an AWS Organizations account.
+type AccountParameters struct {
+	// Name of the account.
+	// +immutable
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=50
+	Name string `json:"name"`
+
+	// Email is the email address of the root user of the account. It must
+	// not be used by another AWS account.
+	// +immutable
+	// +kubebuilder:validation:MinLength=6
+	// +kubebuilder:validation:MaxLength=64
+	Email string `json:"email"`
+
+	// RoleName is the name of the IAM role that is created in the account
+	// and grants administrator access to users of the management account.
+	// Defaults to OrganizationAccountAccessRole.
+	// +immutable
+	// +optional
+	RoleName *string `json:"roleName,omitempty"`
+
+	// IAMUserAccessToBilling specifies whether IAM users and roles of the
+	// account can access its billing information. Defaults to ALLOW.
+	// +immutable
+	// +optional
+	// +crossplane:aws:model=organizations.CreateAccountInput.IamUserAccessToBilling
+	// +kubebuilder:validation:Enum=ALLOW;DENY
+	IAMUserAccessToBilling *string `json:"iamUserAccessToBilling,omitempty"`
+
+	// ParentID is the ID of the root or organizational unit that contains
+	// the account. Defaults to the root of the organization.
+	// +optional
+	ParentID *string `json:"parentId,omitempty"`
+
+	// ParentIDRef references an OrganizationalUnit to retrieve its ID.
+	// +optional
+	ParentIDRef *runtimev1alpha1.Reference `json:"parentIdRef,omitempty"`
+
+	// ParentIDSelector selects a reference to an OrganizationalUnit to
+	// retrieve its ID.
+	// +optional
+	ParentIDSelector *runtimev1alpha1.Selector `json:"parentIdSelector,omitempty"`
+}
+
+// AccountObservation is the observed state of an Account.
+type AccountObservation struct {
+	// ARN of the account.
+	ARN string `json:"arn,omitempty"`
+
+	// Status of the account.
+	Status string `json:"status,omitempty"`
+
+	// JoinedMethod is the method by which the account joined the
+	// organization.
+	JoinedMethod string `json:"joinedMethod,omitempty"`
+
+	// CreateAccountState is the state of the request that creates the
+	// account.
+	CreateAccountState string `json:"createAccountState,omitempty"`
+
+	// FailureReason is the reason why the account could not be created.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// An AccountSpec defines the desired state of an Account.
+type AccountSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AccountParameters `json:"forProvider"`
+}
+
+// An AccountStatus represents the observed state of an Account.
+type AccountStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AccountObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Account is a managed resource that represents an AWS Organizations
+// member account. Its external name is the ID of the account, or the ID of
+// the request that creates the account until it is created. An account can
+// only be removed from the organization once it has the information that is
+// required to operate as a standalone account, so Accounts should usually be
+// orphaned on deletion.
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Account struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountSpec   `json:"spec"`
+	Status AccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountList contains a list of Accounts
+type AccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Account `json:"items"`
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// OrganizationalUnitParameters define the desired state of an AWS
+// Organizations organizational unit.
+type OrganizationalUnitParameters struct {
+	// Name of the organizational unit.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	Name string `json:"name"`
+
+	// ParentID is the ID of the root or organizational unit that contains
+	// the organizational unit.
+	// +immutable
+	// +optional
+	ParentID *string `json:"parentId,omitempty"`
+
+	// ParentIDRef references an OrganizationalUnit to retrieve its ID.
+	// +immutable
+	// +optional
+	ParentIDRef *runtimev1alpha1.Reference `json:"parentIdRef,omitempty"`
+
+	// ParentIDSelector selects a reference to an OrganizationalUnit to
+	// retrieve its ID.
+	// +immutable
+	// +optional
+	ParentIDSelector *runtimev1alpha1.Selector `json:"parentIdSelector,omitempty"`
+}
+
+// OrganizationalUnitObservation is the observed state of an
+// OrganizationalUnit.
+type OrganizationalUnitObservation struct {
+	// ARN of the organizational unit.
+	ARN string `json:"arn,omitempty"`
+}
+
+// An OrganizationalUnitSpec defines the desired state of an
+// OrganizationalUnit.
+type OrganizationalUnitSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  OrganizationalUnitParameters `json:"forProvider"`
+}
+
+// An OrganizationalUnitStatus represents the observed state of an
+// OrganizationalUnit.
+type OrganizationalUnitStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     OrganizationalUnitObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationalUnit is a managed resource that represents an AWS
+// Organizations organizational unit. Its external name is the ID of the
+// organizational unit.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type OrganizationalUnit struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationalUnitSpec   `json:"spec"`
+	Status OrganizationalUnitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationalUnitList contains a list of OrganizationalUnits
+type OrganizationalUnitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationalUnit `json:"items"`
+}
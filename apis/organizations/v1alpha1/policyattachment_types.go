@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// PolicyAttachmentParameters define the desired state of an AWS
+// Organizations policy attachment.
+type PolicyAttachmentParameters struct {
+	// PolicyID is the ID of the attached policy.
+	// +immutable
+	// +optional
+	PolicyID string `json:"policyId,omitempty"`
+
+	// PolicyIDRef references a Policy to retrieve its ID.
+	// +immutable
+	// +optional
+	PolicyIDRef *runtimev1alpha1.Reference `json:"policyIdRef,omitempty"`
+
+	// PolicyIDSelector selects a reference to a Policy to retrieve its ID.
+	// +immutable
+	// +optional
+	PolicyIDSelector *runtimev1alpha1.Selector `json:"policyIdSelector,omitempty"`
+
+	// TargetID is the ID of the root, organizational unit or account that
+	// the policy is attached to.
+	// +immutable
+	// +optional
+	TargetID string `json:"targetId,omitempty"`
+
+	// TargetIDRef references an OrganizationalUnit to retrieve its ID.
+	// +immutable
+	// +optional
+	TargetIDRef *runtimev1alpha1.Reference `json:"targetIdRef,omitempty"`
+
+	// TargetIDSelector selects a reference to an OrganizationalUnit to
+	// retrieve its ID.
+	// +immutable
+	// +optional
+	TargetIDSelector *runtimev1alpha1.Selector `json:"targetIdSelector,omitempty"`
+}
+
+// PolicyAttachmentObservation is the observed state of a PolicyAttachment.
+type PolicyAttachmentObservation struct {
+	// TargetType is the type of the target the policy is attached to.
+	TargetType string `json:"targetType,omitempty"`
+}
+
+// A PolicyAttachmentSpec defines the desired state of a PolicyAttachment.
+type PolicyAttachmentSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  PolicyAttachmentParameters `json:"forProvider"`
+}
+
+// A PolicyAttachmentStatus represents the observed state of a
+// PolicyAttachment.
+type PolicyAttachmentStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     PolicyAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PolicyAttachment is a managed resource that represents the attachment
+// of an AWS Organizations policy to a root, organizational unit or account.
+// Its external name is composed of the policy ID and the target ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type PolicyAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicyAttachmentSpec   `json:"spec"`
+	Status PolicyAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyAttachmentList contains a list of PolicyAttachments
+type PolicyAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PolicyAttachment `json:"items"`
+}
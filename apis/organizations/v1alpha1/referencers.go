@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+)
+
+// ResolveReferences of this Account
+func (mg *Account) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.parentId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ParentID),
+		Reference:    mg.Spec.ForProvider.ParentIDRef,
+		Selector:     mg.Spec.ForProvider.ParentIDSelector,
+		To:           reference.To{Managed: &OrganizationalUnit{}, List: &OrganizationalUnitList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parentId")
+	}
+	mg.Spec.ForProvider.ParentID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ParentIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this OrganizationalUnit
+func (mg *OrganizationalUnit) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.parentId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ParentID),
+		Reference:    mg.Spec.ForProvider.ParentIDRef,
+		Selector:     mg.Spec.ForProvider.ParentIDSelector,
+		To:           reference.To{Managed: &OrganizationalUnit{}, List: &OrganizationalUnitList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parentId")
+	}
+	mg.Spec.ForProvider.ParentID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ParentIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this PolicyAttachment
+func (mg *PolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.policyId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.PolicyID,
+		Reference:    mg.Spec.ForProvider.PolicyIDRef,
+		Selector:     mg.Spec.ForProvider.PolicyIDSelector,
+		To:           reference.To{Managed: &Policy{}, List: &PolicyList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.policyId")
+	}
+	mg.Spec.ForProvider.PolicyID = rsp.ResolvedValue
+	mg.Spec.ForProvider.PolicyIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.targetId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.TargetID,
+		Reference:    mg.Spec.ForProvider.TargetIDRef,
+		Selector:     mg.Spec.ForProvider.TargetIDSelector,
+		To:           reference.To{Managed: &OrganizationalUnit{}, List: &OrganizationalUnitList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.targetId")
+	}
+	mg.Spec.ForProvider.TargetID = rsp.ResolvedValue
+	mg.Spec.ForProvider.TargetIDRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// LedgerParameters define the desired state of an Amazon QLDB ledger.
+type LedgerParameters struct {
+	// Region is the region you'd like your Ledger to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// PermissionsMode is the permissions mode of the ledger. ALLOW_ALL
+	// allows IAM principals with access to the ledger to access all of its
+	// tables.
+	// +kubebuilder:validation:Enum=ALLOW_ALL
+	// +immutable
+	PermissionsMode string `json:"permissionsMode"`
+
+	// DeletionProtection prevents the ledger from being deleted. It has to
+	// be disabled before the Ledger can be deleted. Defaults to true.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+
+	// Tags to add to the ledger.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// LedgerObservation keeps the state for the external resource
+type LedgerObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the ledger.
+	ARN string `json:"arn,omitempty"`
+
+	// State is the current state of the ledger.
+	State string `json:"state,omitempty"`
+
+	// CreationDateTime is the timestamp of when the ledger was created.
+	CreationDateTime *metav1.Time `json:"creationDateTime,omitempty"`
+}
+
+// A LedgerSpec defines the desired state of a Ledger.
+type LedgerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LedgerParameters `json:"forProvider"`
+}
+
+// A LedgerStatus represents the observed state of a Ledger.
+type LedgerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LedgerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Ledger is a managed resource that represents an Amazon QLDB ledger. Its
+// external name is the name of the ledger.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Ledger struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LedgerSpec   `json:"spec"`
+	Status LedgerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LedgerList contains a list of Ledgers
+type LedgerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Ledger `json:"items"`
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	ec2v1alpha1 "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// ResolveReferences of this ResourceShare
+func (mg *ResourceShare) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.transitGatewayArns
+	tgw, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.TransitGatewayARNs,
+		References:    mg.Spec.ForProvider.TransitGatewayARNRefs,
+		Selector:      mg.Spec.ForProvider.TransitGatewayARNSelector,
+		To:            reference.To{Managed: &ec2v1alpha1.TransitGateway{}, List: &ec2v1alpha1.TransitGatewayList{}},
+		Extract:       ec2v1alpha1.TransitGatewayARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayArns")
+	}
+	mg.Spec.ForProvider.TransitGatewayARNs = tgw.ResolvedValues
+	mg.Spec.ForProvider.TransitGatewayARNRefs = tgw.ResolvedReferences
+
+	// Resolve spec.forProvider.subnetArns
+	subnets, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetARNs,
+		References:    mg.Spec.ForProvider.SubnetARNRefs,
+		Selector:      mg.Spec.ForProvider.SubnetARNSelector,
+		To:            reference.To{Managed: &ec2v1beta1.Subnet{}, List: &ec2v1beta1.SubnetList{}},
+		Extract:       ec2v1beta1.SubnetARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetArns")
+	}
+	mg.Spec.ForProvider.SubnetARNs = subnets.ResolvedValues
+	mg.Spec.ForProvider.SubnetARNRefs = subnets.ResolvedReferences
+
+	return nil
+}
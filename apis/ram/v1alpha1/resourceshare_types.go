@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag is a key-value pair that is assigned to a resource share.
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// ResourceShareParameters define the desired state of an AWS RAM resource
+// share.
+type ResourceShareParameters struct {
+	// Region is the region you'd like your ResourceShare to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name of the resource share.
+	Name string `json:"name"`
+
+	// AllowExternalPrincipals indicates whether the resource share can be
+	// associated with principals outside of the organization.
+	// +optional
+	AllowExternalPrincipals *bool `json:"allowExternalPrincipals,omitempty"`
+
+	// ResourceARNs are the ARNs of the resources that are shared.
+	// +optional
+	ResourceARNs []string `json:"resourceArns,omitempty"`
+
+	// TransitGatewayARNs are the ARNs of the transit gateways that are
+	// shared.
+	// +optional
+	TransitGatewayARNs []string `json:"transitGatewayArns,omitempty"`
+
+	// TransitGatewayARNRefs are references to TransitGateways used to set
+	// the TransitGatewayARNs.
+	// +optional
+	TransitGatewayARNRefs []runtimev1alpha1.Reference `json:"transitGatewayArnRefs,omitempty"`
+
+	// TransitGatewayARNSelector selects references to TransitGateways used
+	// to set the TransitGatewayARNs.
+	// +optional
+	TransitGatewayARNSelector *runtimev1alpha1.Selector `json:"transitGatewayArnSelector,omitempty"`
+
+	// SubnetARNs are the ARNs of the subnets that are shared.
+	// +optional
+	SubnetARNs []string `json:"subnetArns,omitempty"`
+
+	// SubnetARNRefs are references to Subnets used to set the SubnetARNs.
+	// +optional
+	SubnetARNRefs []runtimev1alpha1.Reference `json:"subnetArnRefs,omitempty"`
+
+	// SubnetARNSelector selects references to Subnets used to set the
+	// SubnetARNs.
+	// +optional
+	SubnetARNSelector *runtimev1alpha1.Selector `json:"subnetArnSelector,omitempty"`
+
+	// Principals the resources are shared with. A principal is the ID of an
+	// AWS account, or the ARN of an organizational unit or organization.
+	// +optional
+	Principals []string `json:"principals,omitempty"`
+
+	// Tags to assign to the resource share.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ResourceShareObservation is the observed state of a ResourceShare.
+type ResourceShareObservation struct {
+	// ARN of the resource share.
+	ARN string `json:"arn,omitempty"`
+
+	// OwningAccountID is the ID of the AWS account that owns the resource
+	// share.
+	OwningAccountID string `json:"owningAccountId,omitempty"`
+
+	// Status of the resource share.
+	Status string `json:"status,omitempty"`
+
+	// StatusMessage explains the status of the resource share.
+	StatusMessage string `json:"statusMessage,omitempty"`
+}
+
+// A ResourceShareSpec defines the desired state of a ResourceShare.
+type ResourceShareSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ResourceShareParameters `json:"forProvider"`
+}
+
+// A ResourceShareStatus represents the observed state of a ResourceShare.
+type ResourceShareStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ResourceShareObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResourceShare is a managed resource that represents an AWS RAM resource
+// share. Its external name is the ARN of the resource share. The shared
+// resources are the union of the resource, transit gateway and subnet ARNs.
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ResourceShare struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceShareSpec   `json:"spec"`
+	Status ResourceShareStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceShareList contains a list of ResourceShares
+type ResourceShareList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceShare `json:"items"`
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// HealthCheckParameters define the desired state of an AWS Route53 Health
+// Check.
+type HealthCheckParameters struct {
+	// Type of the health check: HTTP, HTTPS, HTTP_STR_MATCH, HTTPS_STR_MATCH,
+	// TCP, CALCULATED or CLOUDWATCH_METRIC.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;HTTP_STR_MATCH;HTTPS_STR_MATCH;TCP;CALCULATED;CLOUDWATCH_METRIC
+	// +immutable
+	Type string `json:"type"`
+
+	// IPAddress of the endpoint that Route 53 performs health checks on. If
+	// it is omitted, Route 53 resolves FullyQualifiedDomainName.
+	// +optional
+	IPAddress *string `json:"ipAddress,omitempty"`
+
+	// Port on the endpoint that Route 53 performs health checks on. It
+	// defaults to 80 for HTTP and 443 for HTTPS health checks.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// ResourcePath that Route 53 requests when it performs HTTP(S) health
+	// checks, e.g. /health.
+	// +optional
+	ResourcePath *string `json:"resourcePath,omitempty"`
+
+	// FullyQualifiedDomainName of the endpoint. It is used to resolve the
+	// endpoint when IPAddress is omitted, and as the Host header of HTTP(S)
+	// health checks otherwise.
+	// +optional
+	FullyQualifiedDomainName *string `json:"fullyQualifiedDomainName,omitempty"`
+
+	// SearchString that has to appear in the first 5120 bytes of the response
+	// body for HTTP_STR_MATCH and HTTPS_STR_MATCH health checks.
+	// +optional
+	SearchString *string `json:"searchString,omitempty"`
+
+	// RequestInterval is the number of seconds between the health checks of
+	// each health checker, either 10 or 30.
+	// +kubebuilder:validation:Enum=10;30
+	// +immutable
+	// +optional
+	RequestInterval *int64 `json:"requestInterval,omitempty"`
+
+	// FailureThreshold is the number of consecutive health checks that an
+	// endpoint has to pass or fail to change its status.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	FailureThreshold *int64 `json:"failureThreshold,omitempty"`
+
+	// MeasureLatency shows the latency between the health checkers and the
+	// endpoint on the Route 53 console.
+	// +immutable
+	// +optional
+	MeasureLatency *bool `json:"measureLatency,omitempty"`
+
+	// Inverted reverses the status of the health check.
+	// +optional
+	Inverted *bool `json:"inverted,omitempty"`
+
+	// Disabled stops the health checks and considers the endpoint healthy.
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// EnableSNI sends the host name of FullyQualifiedDomainName to the
+	// endpoint in the TLS negotiation of HTTPS health checks.
+	// +optional
+	EnableSNI *bool `json:"enableSNI,omitempty"`
+
+	// Regions from which Route 53 performs health checks. At least three
+	// regions have to be specified; all regions are used if it is omitted.
+	// +optional
+	Regions []string `json:"regions,omitempty"`
+
+	// HealthThreshold is the number of child health checks that have to be
+	// healthy for a CALCULATED health check to be healthy.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=256
+	// +optional
+	HealthThreshold *int64 `json:"healthThreshold,omitempty"`
+
+	// ChildHealthChecks are the IDs of the health checks that a CALCULATED
+	// health check monitors.
+	// +optional
+	ChildHealthChecks []string `json:"childHealthChecks,omitempty"`
+
+	// ChildHealthCheckRefs references HealthChecks to retrieve their IDs.
+	// +optional
+	ChildHealthCheckRefs []runtimev1alpha1.Reference `json:"childHealthCheckRefs,omitempty"`
+
+	// ChildHealthCheckSelector selects references to HealthChecks to retrieve
+	// their IDs.
+	// +optional
+	ChildHealthCheckSelector *runtimev1alpha1.Selector `json:"childHealthCheckSelector,omitempty"`
+
+	// AlarmIdentifier identifies the CloudWatch alarm that a
+	// CLOUDWATCH_METRIC health check monitors.
+	// +optional
+	AlarmIdentifier *AlarmIdentifier `json:"alarmIdentifier,omitempty"`
+
+	// InsufficientDataHealthStatus is the status of a CLOUDWATCH_METRIC
+	// health check when CloudWatch doesn't have enough data to determine the
+	// state of the alarm: Healthy, Unhealthy or LastKnownStatus.
+	// +kubebuilder:validation:Enum=Healthy;Unhealthy;LastKnownStatus
+	// +optional
+	InsufficientDataHealthStatus *string `json:"insufficientDataHealthStatus,omitempty"`
+
+	// Tags to assign to the health check.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// AlarmIdentifier identifies a CloudWatch alarm.
+type AlarmIdentifier struct {
+	// Name of the CloudWatch alarm.
+	Name string `json:"name"`
+
+	// Region of the CloudWatch alarm.
+	Region string `json:"region"`
+}
+
+// Tag is a key-value pair to tag a Route 53 resource.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// HealthCheckObservation keeps the state for the external resource.
+type HealthCheckObservation struct {
+	// CallerReference that was used to create the health check.
+	CallerReference string `json:"callerReference,omitempty"`
+
+	// HealthCheckVersion is incremented every time the health check is
+	// updated.
+	HealthCheckVersion int64 `json:"healthCheckVersion,omitempty"`
+}
+
+// HealthCheckSpec defines the desired state of an AWS Route53 Health Check.
+type HealthCheckSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  HealthCheckParameters `json:"forProvider"`
+}
+
+// HealthCheckStatus represents the observed state of a HealthCheck.
+type HealthCheckStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     HealthCheckObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HealthCheck is a managed resource that represents an AWS Route53 Health
+// Check.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type HealthCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HealthCheckSpec   `json:"spec"`
+	Status HealthCheckStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HealthCheckList contains a list of HealthChecks.
+type HealthCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HealthCheck `json:"items"`
+}
@@ -22,11 +22,25 @@ import (
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	elbv2 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
 )
 
+// HostedZoneARN returns the ARN of a HostedZone, which is derived from its
+// external name.
+func HostedZoneARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		if meta.GetExternalName(mg) == "" {
+			return ""
+		}
+		return "arn:aws:route53:::hostedzone/" + meta.GetExternalName(mg)
+	}
+}
+
 // ResolveReferences of this Zone
 func (mg *ResourceRecordSet) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -45,6 +59,52 @@ func (mg *ResourceRecordSet) ResolveReferences(ctx context.Context, c client.Rea
 	mg.Spec.ForProvider.ZoneID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.ZoneIDRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.healthCheckId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.HealthCheckID),
+		Reference:    mg.Spec.ForProvider.HealthCheckIDRef,
+		Selector:     mg.Spec.ForProvider.HealthCheckIDSelector,
+		To:           reference.To{Managed: &HealthCheck{}, List: &HealthCheckList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.healthCheckId")
+	}
+	mg.Spec.ForProvider.HealthCheckID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.HealthCheckIDRef = rsp.ResolvedReference
+
+	if mg.Spec.ForProvider.AliasTarget == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.aliasTarget.dnsName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.AliasTarget.DNSName,
+		Reference:    mg.Spec.ForProvider.AliasTarget.DNSNameRef,
+		Selector:     mg.Spec.ForProvider.AliasTarget.DNSNameSelector,
+		To:           reference.To{Managed: &elbv2.LoadBalancer{}, List: &elbv2.LoadBalancerList{}},
+		Extract:      elbv2.LoadBalancerDNSName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.aliasTarget.dnsName")
+	}
+	mg.Spec.ForProvider.AliasTarget.DNSName = rsp.ResolvedValue
+	mg.Spec.ForProvider.AliasTarget.DNSNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.aliasTarget.hostedZoneId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.AliasTarget.HostedZoneID,
+		Reference:    mg.Spec.ForProvider.AliasTarget.HostedZoneIDRef,
+		Selector:     mg.Spec.ForProvider.AliasTarget.HostedZoneIDSelector,
+		To:           reference.To{Managed: &elbv2.LoadBalancer{}, List: &elbv2.LoadBalancerList{}},
+		Extract:      elbv2.LoadBalancerCanonicalHostedZoneID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.aliasTarget.hostedZoneId")
+	}
+	mg.Spec.ForProvider.AliasTarget.HostedZoneID = rsp.ResolvedValue
+	mg.Spec.ForProvider.AliasTarget.HostedZoneIDRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -72,3 +132,24 @@ func (mg *HostedZone) ResolveReferences(ctx context.Context, c client.Reader) er
 
 	return nil
 }
+
+// ResolveReferences of the child health checks of a HealthCheck
+func (mg *HealthCheck) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.childHealthChecks
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.ChildHealthChecks,
+		References:    mg.Spec.ForProvider.ChildHealthCheckRefs,
+		Selector:      mg.Spec.ForProvider.ChildHealthCheckSelector,
+		To:            reference.To{Managed: &HealthCheck{}, List: &HealthCheckList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.childHealthChecks")
+	}
+	mg.Spec.ForProvider.ChildHealthChecks = mrsp.ResolvedValues
+	mg.Spec.ForProvider.ChildHealthCheckRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -56,7 +56,16 @@ var (
 	ResourceRecordSetGroupVersionKind = SchemeGroupVersion.WithKind(ResourceRecordSetKind)
 )
 
+// HealthCheck type metadata.
+var (
+	HealthCheckKind             = reflect.TypeOf(HealthCheck{}).Name()
+	HealthCheckGroupKind        = schema.GroupKind{Group: Group, Kind: HealthCheckKind}.String()
+	HealthCheckKindAPIVersion   = HealthCheckKind + "." + SchemeGroupVersion.String()
+	HealthCheckGroupVersionKind = SchemeGroupVersion.WithKind(HealthCheckKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&HostedZone{}, &HostedZoneList{})
 	SchemeBuilder.Register(&ResourceRecordSet{}, &ResourceRecordSetList{})
+	SchemeBuilder.Register(&HealthCheck{}, &HealthCheckList{})
 }
@@ -224,6 +224,15 @@ type ResourceRecordSetParameters struct {
 	// +optional
 	HealthCheckID *string `json:"healthCheckId,omitempty"`
 
+	// HealthCheckIDRef references a HealthCheck to retrieve its ID.
+	// +optional
+	HealthCheckIDRef *runtimev1alpha1.Reference `json:"healthCheckIdRef,omitempty"`
+
+	// HealthCheckIDSelector selects a reference to a HealthCheck to retrieve
+	// its ID.
+	// +optional
+	HealthCheckIDSelector *runtimev1alpha1.Selector `json:"healthCheckIdSelector,omitempty"`
+
 	// Multivalue answer resource record sets only: To route traffic approximately
 	// randomly to multiple resources, such as web servers, create one multivalue
 	// answer record for each resource and specify true for MultiValueAnswer. Note
@@ -573,7 +582,17 @@ type AliasTarget struct {
 	// for which the value of Type is CNAME. This is because the alias record must
 	// have the same type as the record that you're routing traffic to, and creating
 	// a CNAME record for the zone apex isn't supported even for an alias record.
-	DNSName string `json:"dnsName"`
+	// +optional
+	DNSName string `json:"dnsName,omitempty"`
+
+	// DNSNameRef references an elbv2 LoadBalancer to retrieve its DNSName.
+	// +optional
+	DNSNameRef *runtimev1alpha1.Reference `json:"dnsNameRef,omitempty"`
+
+	// DNSNameSelector selects a reference to an elbv2 LoadBalancer to retrieve
+	// its DNSName.
+	// +optional
+	DNSNameSelector *runtimev1alpha1.Selector `json:"dnsNameSelector,omitempty"`
 
 	// Applies only to alias, failover alias, geolocation alias, latency alias,
 	// and weighted alias resource record sets: When EvaluateTargetHealth is true,
@@ -723,7 +742,18 @@ type AliasTarget struct {
 	//
 	// Specify the hosted zone ID of your hosted zone. (An alias resource record
 	// set can't reference a resource record set in a different hosted zone.)
-	HostedZoneID string `json:"hostedZoneId"`
+	// +optional
+	HostedZoneID string `json:"hostedZoneId,omitempty"`
+
+	// HostedZoneIDRef references an elbv2 LoadBalancer to retrieve its
+	// canonical hosted zone ID.
+	// +optional
+	HostedZoneIDRef *runtimev1alpha1.Reference `json:"hostedZoneIdRef,omitempty"`
+
+	// HostedZoneIDSelector selects a reference to an elbv2 LoadBalancer to
+	// retrieve its canonical hosted zone ID.
+	// +optional
+	HostedZoneIDSelector *runtimev1alpha1.Selector `json:"hostedZoneIdSelector,omitempty"`
 }
 
 // GeoLocation lets you control how Amazon Route 53 responds to DNS queries
@@ -25,9 +25,44 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlarmIdentifier) DeepCopyInto(out *AlarmIdentifier) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmIdentifier.
+func (in *AlarmIdentifier) DeepCopy() *AlarmIdentifier {
+	if in == nil {
+		return nil
+	}
+	out := new(AlarmIdentifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AliasTarget) DeepCopyInto(out *AliasTarget) {
 	*out = *in
+	if in.DNSNameRef != nil {
+		in, out := &in.DNSNameRef, &out.DNSNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.DNSNameSelector != nil {
+		in, out := &in.DNSNameSelector, &out.DNSNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostedZoneIDRef != nil {
+		in, out := &in.HostedZoneIDRef, &out.HostedZoneIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.HostedZoneIDSelector != nil {
+		in, out := &in.HostedZoneIDSelector, &out.HostedZoneIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasTarget.
@@ -115,6 +150,224 @@ func (in *GeoLocation) DeepCopy() *GeoLocation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheck.
+func (in *HealthCheck) DeepCopy() *HealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HealthCheck) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckList) DeepCopyInto(out *HealthCheckList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckList.
+func (in *HealthCheckList) DeepCopy() *HealthCheckList {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HealthCheckList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckObservation) DeepCopyInto(out *HealthCheckObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckObservation.
+func (in *HealthCheckObservation) DeepCopy() *HealthCheckObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckParameters) DeepCopyInto(out *HealthCheckParameters) {
+	*out = *in
+	if in.IPAddress != nil {
+		in, out := &in.IPAddress, &out.IPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ResourcePath != nil {
+		in, out := &in.ResourcePath, &out.ResourcePath
+		*out = new(string)
+		**out = **in
+	}
+	if in.FullyQualifiedDomainName != nil {
+		in, out := &in.FullyQualifiedDomainName, &out.FullyQualifiedDomainName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SearchString != nil {
+		in, out := &in.SearchString, &out.SearchString
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequestInterval != nil {
+		in, out := &in.RequestInterval, &out.RequestInterval
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MeasureLatency != nil {
+		in, out := &in.MeasureLatency, &out.MeasureLatency
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Inverted != nil {
+		in, out := &in.Inverted, &out.Inverted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableSNI != nil {
+		in, out := &in.EnableSNI, &out.EnableSNI
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthThreshold != nil {
+		in, out := &in.HealthThreshold, &out.HealthThreshold
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ChildHealthChecks != nil {
+		in, out := &in.ChildHealthChecks, &out.ChildHealthChecks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ChildHealthCheckRefs != nil {
+		in, out := &in.ChildHealthCheckRefs, &out.ChildHealthCheckRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ChildHealthCheckSelector != nil {
+		in, out := &in.ChildHealthCheckSelector, &out.ChildHealthCheckSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlarmIdentifier != nil {
+		in, out := &in.AlarmIdentifier, &out.AlarmIdentifier
+		*out = new(AlarmIdentifier)
+		**out = **in
+	}
+	if in.InsufficientDataHealthStatus != nil {
+		in, out := &in.InsufficientDataHealthStatus, &out.InsufficientDataHealthStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckParameters.
+func (in *HealthCheckParameters) DeepCopy() *HealthCheckParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckSpec) DeepCopyInto(out *HealthCheckSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckSpec.
+func (in *HealthCheckSpec) DeepCopy() *HealthCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckStatus) DeepCopyInto(out *HealthCheckStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckStatus.
+func (in *HealthCheckStatus) DeepCopy() *HealthCheckStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostedZone) DeepCopyInto(out *HostedZone) {
 	*out = *in
@@ -371,7 +624,7 @@ func (in *ResourceRecordSetParameters) DeepCopyInto(out *ResourceRecordSetParame
 	if in.AliasTarget != nil {
 		in, out := &in.AliasTarget, &out.AliasTarget
 		*out = new(AliasTarget)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.GeoLocation != nil {
 		in, out := &in.GeoLocation, &out.GeoLocation
@@ -383,6 +636,16 @@ func (in *ResourceRecordSetParameters) DeepCopyInto(out *ResourceRecordSetParame
 		*out = new(string)
 		**out = **in
 	}
+	if in.HealthCheckIDRef != nil {
+		in, out := &in.HealthCheckIDRef, &out.HealthCheckIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.HealthCheckIDSelector != nil {
+		in, out := &in.HealthCheckIDSelector, &out.HealthCheckIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MultiValueAnswer != nil {
 		in, out := &in.MultiValueAnswer, &out.MultiValueAnswer
 		*out = new(bool)
@@ -473,6 +736,21 @@ func (in *ResourceRecordSetStatus) DeepCopy() *ResourceRecordSetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPC) DeepCopyInto(out *VPC) {
 	*out = *in
@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "route53resolver.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// ResolverEndpoint type metadata.
+var (
+	ResolverEndpointKind             = reflect.TypeOf(ResolverEndpoint{}).Name()
+	ResolverEndpointGroupKind        = schema.GroupKind{Group: Group, Kind: ResolverEndpointKind}.String()
+	ResolverEndpointKindAPIVersion   = ResolverEndpointKind + "." + SchemeGroupVersion.String()
+	ResolverEndpointGroupVersionKind = SchemeGroupVersion.WithKind(ResolverEndpointKind)
+)
+
+// ResolverRule type metadata.
+var (
+	ResolverRuleKind             = reflect.TypeOf(ResolverRule{}).Name()
+	ResolverRuleGroupKind        = schema.GroupKind{Group: Group, Kind: ResolverRuleKind}.String()
+	ResolverRuleKindAPIVersion   = ResolverRuleKind + "." + SchemeGroupVersion.String()
+	ResolverRuleGroupVersionKind = SchemeGroupVersion.WithKind(ResolverRuleKind)
+)
+
+// ResolverRuleAssociation type metadata.
+var (
+	ResolverRuleAssociationKind             = reflect.TypeOf(ResolverRuleAssociation{}).Name()
+	ResolverRuleAssociationGroupKind        = schema.GroupKind{Group: Group, Kind: ResolverRuleAssociationKind}.String()
+	ResolverRuleAssociationKindAPIVersion   = ResolverRuleAssociationKind + "." + SchemeGroupVersion.String()
+	ResolverRuleAssociationGroupVersionKind = SchemeGroupVersion.WithKind(ResolverRuleAssociationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ResolverEndpoint{}, &ResolverEndpointList{})
+	SchemeBuilder.Register(&ResolverRule{}, &ResolverRuleList{})
+	SchemeBuilder.Register(&ResolverRuleAssociation{}, &ResolverRuleAssociationList{})
+}
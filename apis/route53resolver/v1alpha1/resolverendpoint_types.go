@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag is a key-value pair that is assigned to a Route53 Resolver resource.
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// IPAddress is an IP address of a resolver endpoint in a subnet.
+type IPAddress struct {
+	// SubnetID is the ID of the subnet that contains the IP address.
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its subnetId
+	// +optional
+	SubnetIDRef *runtimev1alpha1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet to retrieve its
+	// subnetId
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// IP is the IP address in the subnet. An available IP address of the
+	// subnet is chosen if it is omitted.
+	// +optional
+	IP *string `json:"ip,omitempty"`
+}
+
+// ResolverEndpointParameters define the desired state of an AWS Route53
+// Resolver endpoint.
+type ResolverEndpointParameters struct {
+	// Region is the region you'd like your ResolverEndpoint to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name of the resolver endpoint.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Direction specifies whether the endpoint forwards DNS queries from the
+	// VPC to the network (OUTBOUND) or from the network to the VPC (INBOUND).
+	// +immutable
+	// +kubebuilder:validation:Enum=INBOUND;OUTBOUND
+	Direction string `json:"direction"`
+
+	// IPAddresses are the IP addresses the endpoint uses in the subnets of
+	// the VPC. At least two IP addresses are required for redundancy.
+	// +kubebuilder:validation:MinItems=2
+	IPAddresses []IPAddress `json:"ipAddresses"`
+
+	// SecurityGroupIDs are the IDs of the security groups that control the
+	// traffic to and from the endpoint.
+	// +immutable
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs are references to SecurityGroups used to set the
+	// SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// Tags to assign to the resolver endpoint.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ResolverEndpointObservation is the observed state of a ResolverEndpoint.
+type ResolverEndpointObservation struct {
+	// ARN of the resolver endpoint.
+	ARN string `json:"arn,omitempty"`
+
+	// HostVPCID is the ID of the VPC of the resolver endpoint.
+	HostVPCID string `json:"hostVpcId,omitempty"`
+
+	// IPAddressCount is the number of IP addresses of the resolver endpoint.
+	IPAddressCount int64 `json:"ipAddressCount,omitempty"`
+
+	// Status of the resolver endpoint.
+	Status string `json:"status,omitempty"`
+
+	// StatusMessage explains the status of the resolver endpoint.
+	StatusMessage string `json:"statusMessage,omitempty"`
+}
+
+// A ResolverEndpointSpec defines the desired state of a ResolverEndpoint.
+type ResolverEndpointSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ResolverEndpointParameters `json:"forProvider"`
+}
+
+// A ResolverEndpointStatus represents the observed state of a
+// ResolverEndpoint.
+type ResolverEndpointStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ResolverEndpointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResolverEndpoint is a managed resource that represents an AWS Route53
+// Resolver endpoint. Its external name is the ID of the resolver endpoint.
+// +kubebuilder:printcolumn:name="DIRECTION",type="string",JSONPath=".spec.forProvider.direction"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ResolverEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResolverEndpointSpec   `json:"spec"`
+	Status ResolverEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResolverEndpointList contains a list of ResolverEndpoints
+type ResolverEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResolverEndpoint `json:"items"`
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// TargetAddress is a DNS resolver that DNS queries are forwarded to.
+type TargetAddress struct {
+	// IP is the IPv4 address of the DNS resolver.
+	IP string `json:"ip"`
+
+	// Port of the DNS resolver. Defaults to 53.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+}
+
+// ResolverRuleParameters define the desired state of an AWS Route53 Resolver
+// rule.
+type ResolverRuleParameters struct {
+	// Region is the region you'd like your ResolverRule to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name of the resolver rule.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// RuleType is FORWARD to forward the DNS queries for the domain to the
+	// target IPs, or SYSTEM to have Resolver answer them even if they are a
+	// subdomain of a forwarded domain.
+	// +immutable
+	// +kubebuilder:validation:Enum=FORWARD;SYSTEM;RECURSIVE
+	RuleType string `json:"ruleType"`
+
+	// DomainName is the domain name of the DNS queries the rule applies to.
+	// +immutable
+	DomainName string `json:"domainName"`
+
+	// TargetIPs are the DNS resolvers the DNS queries are forwarded to. They
+	// are required for FORWARD rules.
+	// +optional
+	TargetIPs []TargetAddress `json:"targetIps,omitempty"`
+
+	// ResolverEndpointID is the ID of the outbound resolver endpoint the DNS
+	// queries are forwarded through. It is required for FORWARD rules.
+	// +optional
+	ResolverEndpointID *string `json:"resolverEndpointId,omitempty"`
+
+	// ResolverEndpointIDRef references a ResolverEndpoint to retrieve its
+	// resolverEndpointId
+	// +optional
+	ResolverEndpointIDRef *runtimev1alpha1.Reference `json:"resolverEndpointIdRef,omitempty"`
+
+	// ResolverEndpointIDSelector selects a reference to a ResolverEndpoint
+	// to retrieve its resolverEndpointId
+	// +optional
+	ResolverEndpointIDSelector *runtimev1alpha1.Selector `json:"resolverEndpointIdSelector,omitempty"`
+
+	// Tags to assign to the resolver rule.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ResolverRuleObservation is the observed state of a ResolverRule.
+type ResolverRuleObservation struct {
+	// ARN of the resolver rule.
+	ARN string `json:"arn,omitempty"`
+
+	// OwnerID is the ID of the AWS account that created the resolver rule.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// ShareStatus is whether the resolver rule is shared with or by other
+	// AWS accounts.
+	ShareStatus string `json:"shareStatus,omitempty"`
+
+	// Status of the resolver rule.
+	Status string `json:"status,omitempty"`
+
+	// StatusMessage explains the status of the resolver rule.
+	StatusMessage string `json:"statusMessage,omitempty"`
+}
+
+// A ResolverRuleSpec defines the desired state of a ResolverRule.
+type ResolverRuleSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ResolverRuleParameters `json:"forProvider"`
+}
+
+// A ResolverRuleStatus represents the observed state of a ResolverRule.
+type ResolverRuleStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ResolverRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResolverRule is a managed resource that represents an AWS Route53
+// Resolver rule. Its external name is the ID of the resolver rule.
+// +kubebuilder:printcolumn:name="DOMAINNAME",type="string",JSONPath=".spec.forProvider.domainName"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.ruleType"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ResolverRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResolverRuleSpec   `json:"spec"`
+	Status ResolverRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResolverRuleList contains a list of ResolverRules
+type ResolverRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResolverRule `json:"items"`
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ResolverRuleAssociationParameters define the desired state of an AWS
+// Route53 Resolver rule association.
+type ResolverRuleAssociationParameters struct {
+	// Region is the region you'd like your ResolverRuleAssociation to be
+	// created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name of the resolver rule association.
+	// +immutable
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// ResolverRuleID is the ID of the resolver rule that is associated with
+	// the VPC.
+	// +immutable
+	// +optional
+	ResolverRuleID *string `json:"resolverRuleId,omitempty"`
+
+	// ResolverRuleIDRef references a ResolverRule to retrieve its
+	// resolverRuleId
+	// +optional
+	ResolverRuleIDRef *runtimev1alpha1.Reference `json:"resolverRuleIdRef,omitempty"`
+
+	// ResolverRuleIDSelector selects a reference to a ResolverRule to
+	// retrieve its resolverRuleId
+	// +optional
+	ResolverRuleIDSelector *runtimev1alpha1.Selector `json:"resolverRuleIdSelector,omitempty"`
+
+	// VPCID is the ID of the VPC the resolver rule is associated with.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+}
+
+// ResolverRuleAssociationObservation is the observed state of a
+// ResolverRuleAssociation.
+type ResolverRuleAssociationObservation struct {
+	// Status of the resolver rule association.
+	Status string `json:"status,omitempty"`
+
+	// StatusMessage explains the status of the resolver rule association.
+	StatusMessage string `json:"statusMessage,omitempty"`
+}
+
+// A ResolverRuleAssociationSpec defines the desired state of a
+// ResolverRuleAssociation.
+type ResolverRuleAssociationSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ResolverRuleAssociationParameters `json:"forProvider"`
+}
+
+// A ResolverRuleAssociationStatus represents the observed state of a
+// ResolverRuleAssociation.
+type ResolverRuleAssociationStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ResolverRuleAssociationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResolverRuleAssociation is a managed resource that represents the
+// association of an AWS Route53 Resolver rule with a VPC. Its external name
+// is the ID of the association.
+// +kubebuilder:printcolumn:name="RULEID",type="string",JSONPath=".spec.forProvider.resolverRuleId"
+// +kubebuilder:printcolumn:name="VPCID",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ResolverRuleAssociation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResolverRuleAssociationSpec   `json:"spec"`
+	Status ResolverRuleAssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResolverRuleAssociationList contains a list of ResolverRuleAssociations
+type ResolverRuleAssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResolverRuleAssociation `json:"items"`
+}
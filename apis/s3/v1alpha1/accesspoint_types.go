@@ -0,0 +1,163 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// AccessPointVPCConfiguration restricts access to an access point to
+// requests from a VPC.
+type AccessPointVPCConfiguration struct {
+	// VPCID is the ID of the VPC that requests to the access point must
+	// originate from.
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+}
+
+// AccessPointPublicAccessBlockConfiguration is the public access block
+// configuration of an access point.
+type AccessPointPublicAccessBlockConfiguration struct {
+	// BlockPublicACLs specifies whether Amazon S3 rejects calls that set a
+	// public ACL through the access point.
+	// +optional
+	BlockPublicACLs *bool `json:"blockPublicAcls,omitempty"`
+
+	// BlockPublicPolicy specifies whether Amazon S3 rejects calls that set
+	// a public access point policy.
+	// +optional
+	BlockPublicPolicy *bool `json:"blockPublicPolicy,omitempty"`
+
+	// IgnorePublicACLs specifies whether Amazon S3 ignores public ACLs on
+	// requests made through the access point.
+	// +optional
+	IgnorePublicACLs *bool `json:"ignorePublicAcls,omitempty"`
+
+	// RestrictPublicBuckets specifies whether Amazon S3 restricts access
+	// through the access point if it has a public policy.
+	// +optional
+	RestrictPublicBuckets *bool `json:"restrictPublicBuckets,omitempty"`
+}
+
+// AccessPointParameters define the desired state of an AWS S3 access point.
+type AccessPointParameters struct {
+	// Region is where the Bucket referenced by this AccessPoint resides.
+	// +immutable
+	Region string `json:"region"`
+
+	// AccountID is the ID of the AWS account that owns the bucket.
+	// Defaults to the account of the provider credentials.
+	// +immutable
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// BucketName is the name of the bucket the access point is associated
+	// with.
+	// +immutable
+	// +optional
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references a Bucket to retrieve its bucketName
+	// +optional
+	BucketNameRef *runtimev1alpha1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to a Bucket to retrieve its
+	// bucketName
+	// +optional
+	BucketNameSelector *runtimev1alpha1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// VPCConfiguration restricts access to the access point to requests
+	// from the given VPC. The access point is reachable from the internet if
+	// it is omitted.
+	// +immutable
+	// +optional
+	VPCConfiguration *AccessPointVPCConfiguration `json:"vpcConfiguration,omitempty"`
+
+	// PublicAccessBlockConfiguration is the public access block
+	// configuration of the access point.
+	// +immutable
+	// +optional
+	PublicAccessBlockConfiguration *AccessPointPublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+
+	// Policy is the JSON policy document of the access point. Its resources
+	// must be given as access point ARNs.
+	// +optional
+	Policy *string `json:"policy,omitempty"`
+}
+
+// An AccessPointSpec defines the desired state of an AccessPoint.
+type AccessPointSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AccessPointParameters `json:"forProvider"`
+}
+
+// AccessPointObservation keeps the state for the external resource
+type AccessPointObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the access point.
+	ARN string `json:"arn,omitempty"`
+
+	// NetworkOrigin is VPC if the access point is restricted to a VPC and
+	// Internet otherwise.
+	NetworkOrigin string `json:"networkOrigin,omitempty"`
+
+	// CreationDate is the date when the access point was created.
+	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+}
+
+// An AccessPointStatus represents the observed state of an AccessPoint.
+type AccessPointStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AccessPointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessPoint is a managed resource that represents an AWS S3 access
+// point. Its external name is the name of the access point.
+// +kubebuilder:printcolumn:name="BUCKETNAME",type="string",JSONPath=".spec.forProvider.bucketName"
+// +kubebuilder:printcolumn:name="NETWORKORIGIN",type="string",JSONPath=".status.atProvider.networkOrigin"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccessPoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessPointSpec   `json:"spec"`
+	Status AccessPointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessPointList contains a list of AccessPoints
+type AccessPointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessPoint `json:"items"`
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// AccountPublicAccessBlockParameters define the desired state of the public
+// access block configuration of an AWS account. Settings that are not given
+// are false.
+type AccountPublicAccessBlockParameters struct {
+	// Region is the region of the S3 Control endpoint that is used to
+	// configure the account.
+	// +immutable
+	Region string `json:"region"`
+
+	// AccountID is the ID of the AWS account to configure.
+	// Defaults to the account of the provider credentials.
+	// +immutable
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// BlockPublicACLs specifies whether Amazon S3 rejects calls that set a
+	// public ACL on the buckets and objects of the account.
+	// +optional
+	BlockPublicACLs *bool `json:"blockPublicAcls,omitempty"`
+
+	// BlockPublicPolicy specifies whether Amazon S3 rejects calls that set
+	// a public bucket policy on the buckets of the account.
+	// +optional
+	BlockPublicPolicy *bool `json:"blockPublicPolicy,omitempty"`
+
+	// IgnorePublicACLs specifies whether Amazon S3 ignores public ACLs on
+	// the buckets and objects of the account.
+	// +optional
+	IgnorePublicACLs *bool `json:"ignorePublicAcls,omitempty"`
+
+	// RestrictPublicBuckets specifies whether Amazon S3 restricts access to
+	// the buckets of the account that have a public policy to AWS service
+	// principals and authorized users of the account.
+	// +optional
+	RestrictPublicBuckets *bool `json:"restrictPublicBuckets,omitempty"`
+}
+
+// An AccountPublicAccessBlockSpec defines the desired state of an
+// AccountPublicAccessBlock.
+type AccountPublicAccessBlockSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AccountPublicAccessBlockParameters `json:"forProvider"`
+}
+
+// An AccountPublicAccessBlockStatus represents the observed state of an
+// AccountPublicAccessBlock.
+type AccountPublicAccessBlockStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccountPublicAccessBlock is a managed resource that represents the
+// public access block configuration of an AWS account, which applies to all
+// of its S3 buckets in addition to their own configuration. An account has
+// at most one, so only one AccountPublicAccessBlock should exist per
+// account.
+// +kubebuilder:printcolumn:name="ACCOUNTID",type="string",JSONPath=".spec.forProvider.accountId"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccountPublicAccessBlock struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountPublicAccessBlockSpec   `json:"spec"`
+	Status AccountPublicAccessBlockStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountPublicAccessBlockList contains a list of AccountPublicAccessBlocks
+type AccountPublicAccessBlockList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountPublicAccessBlock `json:"items"`
+}
@@ -28,14 +28,26 @@ type BucketPolicyParameters struct {
 	// Region is where the Bucket referenced by this BucketPolicy resides.
 	Region string `json:"region"`
 
+	// RawPolicy is the JSON policy document of the bucket. It is an
+	// alternative to version, id and statement and is used as is, so it
+	// must contain the full ARNs of the principals and resources. Exactly
+	// one of rawPolicy and statement must be set.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	RawPolicy *string `json:"rawPolicy,omitempty"`
+
 	// This is the current IAM policy version
-	PolicyVersion string `json:"version"`
+	// +optional
+	// +kubebuilder:validation:Enum="2012-10-17";"2008-10-17"
+	PolicyVersion string `json:"version,omitempty"`
 
 	// This is the policy's optional identifier
+	// +optional
 	PolicyID string `json:"id,omitempty"`
 
 	// This is the list of statement this policy applies
-	PolicyStatement []BucketPolicyStatement `json:"statement"`
+	// +optional
+	PolicyStatement []BucketPolicyStatement `json:"statement,omitempty"`
 
 	// BucketName presents the name of the bucket.
 	// +optional
@@ -90,6 +102,7 @@ type BucketPolicyStatement struct {
 
 	// The effect is required and specifies whether the statement results
 	// in an allow or an explicit deny. Valid values for Effect are Allow and Deny.
+	// +kubebuilder:validation:Enum=Allow;Deny
 	Effect string `json:"effect"`
 
 	// Used with the S3 policy to specify the principal that is allowed
@@ -102,10 +115,14 @@ type BucketPolicyStatement struct {
 
 	// Each element of the PolicyAction array describes the specific
 	// action or actions that will be allowed or denied with this PolicyStatement.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
 	PolicyAction []string `json:"action,omitempty"`
 
 	// Each element of the NotPolicyAction array will allow the property to match
 	// all but the listed actions.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
 	NotPolicyAction []string `json:"notAction,omitempty"`
 
 	// This flag indicates that this policy should apply to the IAMUsername
@@ -114,10 +131,14 @@ type BucketPolicyStatement struct {
 	ApplyToIAMUser bool `json:"effectIAMUser,omitempty"`
 
 	// The paths on which this resource will apply
+	// +optional
+	// +kubebuilder:validation:MinItems=1
 	ResourcePath []string `json:"resource,omitempty"`
 
 	// This will explicitly match all resource paths except the ones
 	// specified in this array
+	// +optional
+	// +kubebuilder:validation:MinItems=1
 	NotResourcePath []string `json:"notResource,omitempty"`
 }
 
@@ -170,6 +191,8 @@ type BucketPrincipal struct {
 
 	// This list contains the all of the AWS IAM users which are affected
 	// by the policy statement
+	// +optional
+	// +kubebuilder:validation:MinItems=1
 	AWSPrincipal []string `json:"aws,omitempty"`
 }
 
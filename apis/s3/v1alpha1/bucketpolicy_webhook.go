@@ -0,0 +1,63 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-s3-aws-crossplane-io-v1alpha1-bucketpolicy,mutating=false,failurePolicy=fail,groups=s3.aws.crossplane.io,resources=bucketpolicies,verbs=create;update,versions=v1alpha1,name=bucketpolicies.s3.aws.crossplane.io
+
+var _ webhook.Validator = &BucketPolicy{}
+
+// ValidateCreate validates a BucketPolicy on creation.
+func (mg *BucketPolicy) ValidateCreate() error {
+	return mg.validate()
+}
+
+// ValidateUpdate validates a BucketPolicy on update.
+func (mg *BucketPolicy) ValidateUpdate(_ runtime.Object) error {
+	return mg.validate()
+}
+
+// ValidateDelete validates a BucketPolicy on deletion.
+func (mg *BucketPolicy) ValidateDelete() error {
+	return nil
+}
+
+// validate checks that a BucketPolicy specifies its policy either as a raw
+// policy document or as a list of statements.
+func (mg *BucketPolicy) validate() error {
+	p := mg.Spec.PolicyBody
+	path := field.NewPath("spec", "forProvider")
+	var errs field.ErrorList
+
+	switch raw, stmt := p.RawPolicy != nil, len(p.PolicyStatement) != 0; {
+	case raw && stmt:
+		errs = append(errs, field.Forbidden(path.Child("statement"), "must not be set together with rawPolicy"))
+	case !raw && !stmt:
+		errs = append(errs, field.Required(path.Child("statement"), "either rawPolicy or statement must be set"))
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(BucketPolicyGroupVersionKind.GroupKind(), mg.GetName(), errs)
+}
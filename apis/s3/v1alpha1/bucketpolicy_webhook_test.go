@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func bucketPolicy(p BucketPolicyParameters) *BucketPolicy {
+	cr := &BucketPolicy{Spec: BucketPolicySpec{PolicyBody: p}}
+	cr.SetName("policy")
+	return cr
+}
+
+func TestBucketPolicyValidate(t *testing.T) {
+	path := field.NewPath("spec", "forProvider")
+	invalid := func(errs ...*field.Error) error {
+		return apierrors.NewInvalid(BucketPolicyGroupVersionKind.GroupKind(), "policy", errs)
+	}
+	raw := aws.String(`{"Version":"2012-10-17","Statement":[]}`)
+	statement := []BucketPolicyStatement{{Effect: "Allow", PolicyAction: []string{"s3:GetObject"}}}
+
+	cases := map[string]struct {
+		cr   *BucketPolicy
+		want error
+	}{
+		"RawPolicy": {
+			cr: bucketPolicy(BucketPolicyParameters{RawPolicy: raw}),
+		},
+		"Statement": {
+			cr: bucketPolicy(BucketPolicyParameters{PolicyStatement: statement}),
+		},
+		"RawPolicyAndStatement": {
+			cr:   bucketPolicy(BucketPolicyParameters{RawPolicy: raw, PolicyStatement: statement}),
+			want: invalid(field.Forbidden(path.Child("statement"), "must not be set together with rawPolicy")),
+		},
+		"NeitherRawPolicyNorStatement": {
+			cr:   bucketPolicy(BucketPolicyParameters{}),
+			want: invalid(field.Required(path.Child("statement"), "either rawPolicy or statement must be set")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.cr.ValidateCreate(), test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateCreate(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.cr.ValidateUpdate(bucketPolicy(BucketPolicyParameters{})), test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateUpdate(...): -want, +got:\n%s", diff)
+			}
+			if err := tc.cr.ValidateDelete(); err != nil {
+				t.Errorf("ValidateDelete(...): %s", err)
+			}
+		})
+	}
+}
@@ -45,6 +45,24 @@ var (
 	BucketPolicyGroupVersionKind = SchemeGroupVersion.WithKind(BucketPolicyKind)
 )
 
+// AccessPoint type metadata.
+var (
+	AccessPointKind             = reflect.TypeOf(AccessPoint{}).Name()
+	AccessPointGroupKind        = schema.GroupKind{Group: Group, Kind: AccessPointKind}.String()
+	AccessPointKindAPIVersion   = AccessPointKind + "." + SchemeGroupVersion.String()
+	AccessPointGroupVersionKind = SchemeGroupVersion.WithKind(AccessPointKind)
+)
+
+// AccountPublicAccessBlock type metadata.
+var (
+	AccountPublicAccessBlockKind             = reflect.TypeOf(AccountPublicAccessBlock{}).Name()
+	AccountPublicAccessBlockGroupKind        = schema.GroupKind{Group: Group, Kind: AccountPublicAccessBlockKind}.String()
+	AccountPublicAccessBlockKindAPIVersion   = AccountPublicAccessBlockKind + "." + SchemeGroupVersion.String()
+	AccountPublicAccessBlockGroupVersionKind = SchemeGroupVersion.WithKind(AccountPublicAccessBlockKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&BucketPolicy{}, &BucketPolicyList{})
+	SchemeBuilder.Register(&AccessPoint{}, &AccessPointList{})
+	SchemeBuilder.Register(&AccountPublicAccessBlock{}, &AccountPublicAccessBlockList{})
 }
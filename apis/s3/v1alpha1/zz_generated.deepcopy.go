@@ -22,9 +22,368 @@ package v1alpha1
 
 import (
 	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPoint) DeepCopyInto(out *AccessPoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPoint.
+func (in *AccessPoint) DeepCopy() *AccessPoint {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessPoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointList) DeepCopyInto(out *AccessPointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessPoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointList.
+func (in *AccessPointList) DeepCopy() *AccessPointList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessPointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointObservation) DeepCopyInto(out *AccessPointObservation) {
+	*out = *in
+	if in.CreationDate != nil {
+		in, out := &in.CreationDate, &out.CreationDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointObservation.
+func (in *AccessPointObservation) DeepCopy() *AccessPointObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointParameters) DeepCopyInto(out *AccessPointParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketName != nil {
+		in, out := &in.BucketName, &out.BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketNameRef != nil {
+		in, out := &in.BucketNameRef, &out.BucketNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.BucketNameSelector != nil {
+		in, out := &in.BucketNameSelector, &out.BucketNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCConfiguration != nil {
+		in, out := &in.VPCConfiguration, &out.VPCConfiguration
+		*out = new(AccessPointVPCConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublicAccessBlockConfiguration != nil {
+		in, out := &in.PublicAccessBlockConfiguration, &out.PublicAccessBlockConfiguration
+		*out = new(AccessPointPublicAccessBlockConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointParameters.
+func (in *AccessPointParameters) DeepCopy() *AccessPointParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointPublicAccessBlockConfiguration) DeepCopyInto(out *AccessPointPublicAccessBlockConfiguration) {
+	*out = *in
+	if in.BlockPublicACLs != nil {
+		in, out := &in.BlockPublicACLs, &out.BlockPublicACLs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlockPublicPolicy != nil {
+		in, out := &in.BlockPublicPolicy, &out.BlockPublicPolicy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IgnorePublicACLs != nil {
+		in, out := &in.IgnorePublicACLs, &out.IgnorePublicACLs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestrictPublicBuckets != nil {
+		in, out := &in.RestrictPublicBuckets, &out.RestrictPublicBuckets
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointPublicAccessBlockConfiguration.
+func (in *AccessPointPublicAccessBlockConfiguration) DeepCopy() *AccessPointPublicAccessBlockConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointPublicAccessBlockConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointSpec) DeepCopyInto(out *AccessPointSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointSpec.
+func (in *AccessPointSpec) DeepCopy() *AccessPointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointStatus) DeepCopyInto(out *AccessPointStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointStatus.
+func (in *AccessPointStatus) DeepCopy() *AccessPointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointVPCConfiguration) DeepCopyInto(out *AccessPointVPCConfiguration) {
+	*out = *in
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointVPCConfiguration.
+func (in *AccessPointVPCConfiguration) DeepCopy() *AccessPointVPCConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointVPCConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPublicAccessBlock) DeepCopyInto(out *AccountPublicAccessBlock) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPublicAccessBlock.
+func (in *AccountPublicAccessBlock) DeepCopy() *AccountPublicAccessBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPublicAccessBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountPublicAccessBlock) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPublicAccessBlockList) DeepCopyInto(out *AccountPublicAccessBlockList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccountPublicAccessBlock, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPublicAccessBlockList.
+func (in *AccountPublicAccessBlockList) DeepCopy() *AccountPublicAccessBlockList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPublicAccessBlockList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountPublicAccessBlockList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPublicAccessBlockParameters) DeepCopyInto(out *AccountPublicAccessBlockParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.BlockPublicACLs != nil {
+		in, out := &in.BlockPublicACLs, &out.BlockPublicACLs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlockPublicPolicy != nil {
+		in, out := &in.BlockPublicPolicy, &out.BlockPublicPolicy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IgnorePublicACLs != nil {
+		in, out := &in.IgnorePublicACLs, &out.IgnorePublicACLs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestrictPublicBuckets != nil {
+		in, out := &in.RestrictPublicBuckets, &out.RestrictPublicBuckets
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPublicAccessBlockParameters.
+func (in *AccountPublicAccessBlockParameters) DeepCopy() *AccountPublicAccessBlockParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPublicAccessBlockParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPublicAccessBlockSpec) DeepCopyInto(out *AccountPublicAccessBlockSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPublicAccessBlockSpec.
+func (in *AccountPublicAccessBlockSpec) DeepCopy() *AccountPublicAccessBlockSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPublicAccessBlockSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPublicAccessBlockStatus) DeepCopyInto(out *AccountPublicAccessBlockStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPublicAccessBlockStatus.
+func (in *AccountPublicAccessBlockStatus) DeepCopy() *AccountPublicAccessBlockStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPublicAccessBlockStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketPolicy) DeepCopyInto(out *BucketPolicy) {
 	*out = *in
@@ -87,6 +446,11 @@ func (in *BucketPolicyList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketPolicyParameters) DeepCopyInto(out *BucketPolicyParameters) {
 	*out = *in
+	if in.RawPolicy != nil {
+		in, out := &in.RawPolicy, &out.RawPolicy
+		*out = new(string)
+		**out = **in
+	}
 	if in.PolicyStatement != nil {
 		in, out := &in.PolicyStatement, &out.PolicyStatement
 		*out = make([]BucketPolicyStatement, len(*in))
@@ -22,6 +22,7 @@ package v1beta1
 // in the Amazon Simple Storage Service Developer Guide.
 type AccelerateConfiguration struct {
 	// Status specifies the transfer acceleration status of the bucket.
+	// +crossplane:aws:model=s3.AccelerateConfiguration.Status
 	// +kubebuilder:validation:Enum=Enabled;Suspended
 	Status string `json:"status"`
 }
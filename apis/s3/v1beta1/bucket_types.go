@@ -24,6 +24,7 @@ import (
 // BucketParameters are parameters for configuring the calls made to AWS Bucket API.
 type BucketParameters struct {
 	// The canned ACL to apply to the bucket.
+	// +crossplane:aws:model=s3.CreateBucketInput.ACL
 	// +kubebuilder:validation:Enum=private;public-read;public-read-write;authenticated-read
 	// +optional
 	ACL *string `json:"acl,omitempty"`
@@ -131,6 +132,14 @@ type BucketParameters struct {
 	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/NotificationHowTo.html).
 	// +optional
 	NotificationConfiguration *NotificationConfiguration `json:"notificationConfiguration,omitempty"`
+
+	// PublicAccessBlockConfiguration that you want to apply to this Amazon
+	// S3 bucket. For more information about when Amazon S3 considers a bucket
+	// or object public, see The Meaning of "Public"
+	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/access-control-block-public-access.html#access-control-block-public-access-policy-status)
+	// in the Amazon Simple Storage Service Developer Guide.
+	// +optional
+	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
 }
 
 // BucketSpec represents the desired state of the Bucket.
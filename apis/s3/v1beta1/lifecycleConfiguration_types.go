@@ -71,6 +71,7 @@ type LifecycleRule struct {
 	// is not currently being applied.
 	//
 	// Status is a required field, valid values are Enabled or Disabled
+	// +crossplane:aws:model=s3.DeleteMarkerReplication.Status
 	// +kubebuilder:validation:Enum=Enabled;Disabled
 	Status string `json:"status"`
 
@@ -165,6 +166,7 @@ type NoncurrentVersionTransition struct {
 
 	// The class of storage used to store the object.
 	// Valid values are: GLACIER, STANDARD_IA, ONEZONE_IA, INTELLIGENT_TIERING, DEEP_ARCHIVE
+	// +crossplane:aws:model=s3.NoncurrentVersionTransition.StorageClass
 	// +kubebuilder:validation:Enum=GLACIER;STANDARD_IA;ONEZONE_IA;INTELLIGENT_TIERING;DEEP_ARCHIVE
 	StorageClass string `json:"storageClass"`
 }
@@ -185,6 +187,7 @@ type Transition struct {
 
 	// The storage class to which you want the object to transition.
 	// Valid values are: GLACIER, STANDARD_IA, ONEZONE_IA, INTELLIGENT_TIERING, DEEP_ARCHIVE
+	// +crossplane:aws:model=s3.NoncurrentVersionTransition.StorageClass
 	// +kubebuilder:validation:Enum=GLACIER;STANDARD_IA;ONEZONE_IA;INTELLIGENT_TIERING;DEEP_ARCHIVE
 	StorageClass string `json:"storageClass"`
 }
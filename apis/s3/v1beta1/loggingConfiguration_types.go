@@ -50,6 +50,7 @@ type TargetGrant struct {
 
 	// Logging permissions assigned to the Grantee for the bucket.
 	// Valid values are "FULL_CONTROL", "READ", "WRITE"
+	// +crossplane:aws:model=s3.TargetGrant.Permission
 	// +kubebuilder:validation:Enum=FULL_CONTROL;READ;WRITE
 	Permission string `json:"bucketLogsPermission"`
 }
@@ -70,6 +71,7 @@ type TargetGrantee struct {
 
 	// Type of grantee
 	// Type is a required field
+	// +crossplane:aws:model=s3.Grantee.Type
 	// +kubebuilder:validation:Enum=CanonicalUser;AmazonCustomerByEmail;Group
 	Type string `json:"type"`
 
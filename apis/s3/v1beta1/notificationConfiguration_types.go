@@ -87,9 +87,17 @@ type QueueConfiguration struct {
 
 	// The Amazon Resource Name (ARN) of the Amazon SQS queue to which Amazon S3
 	// publishes a message when it detects events of the specified type.
-	//
-	// QueueArn is a required field
-	QueueArn string `json:"queueArn"`
+	// At least one of queueArn, queueRef or queueSelector is required.
+	// +optional
+	QueueArn *string `json:"queueArn,omitempty"`
+
+	// QueueArnRef references an SQS Queue to retrieve its Arn
+	// +optional
+	QueueArnRef *runtimev1alpha1.Reference `json:"queueRef,omitempty"`
+
+	// QueueArnSelector selects a reference to an SQS Queue to retrieve its Arn
+	// +optional
+	QueueArnSelector *runtimev1alpha1.Selector `json:"queueSelector,omitempty"`
 }
 
 // TopicConfiguration specifies the configuration for publication of messages
@@ -154,6 +162,7 @@ type FilterRule struct {
 	// Event Notifications (https://docs.aws.amazon.com/AmazonS3/latest/dev/NotificationHowTo.html)
 	// in the Amazon Simple Storage Service Developer Guide.
 	// Valid values are "prefix" or "suffix"
+	// +crossplane:aws:model=s3.FilterRule.Name
 	// +kubebuilder:validation:Enum=prefix;suffix
 	Name string `json:"name"`
 
@@ -24,6 +24,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
 	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
 )
 
 // SNSTopicARN returns a function that returns the ARN of the given SNS Topic.
@@ -37,6 +38,28 @@ func SNSTopicARN() reference.ExtractValueFn {
 	}
 }
 
+// SQSQueueARN returns a function that returns the ARN of the given SQS Queue.
+func SQSQueueARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*sqsv1beta1.Queue)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ARN
+	}
+}
+
+// BucketARN returns a function that returns the ARN of the given Bucket.
+func BucketARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Bucket)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ARN
+	}
+}
+
 // ResolveReferences of this Bucket
 func (mg *Bucket) ResolveReferences(ctx context.Context, c client.Reader) error { // nolint:gocyclo
 	r := reference.NewAPIResolver(c, mg)
@@ -58,6 +81,20 @@ func (mg *Bucket) ResolveReferences(ctx context.Context, c client.Reader) error
 			mg.Spec.ForProvider.NotificationConfiguration.TopicConfigurations[i].TopicArn = reference.ToPtrValue(rsp.ResolvedValue)
 			mg.Spec.ForProvider.NotificationConfiguration.TopicConfigurations[i].TopicArnRef = rsp.ResolvedReference
 		}
+		for i, v := range mg.Spec.ForProvider.NotificationConfiguration.QueueConfigurations {
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(v.QueueArn),
+				Reference:    v.QueueArnRef,
+				Selector:     v.QueueArnSelector,
+				To:           reference.To{Managed: &sqsv1beta1.Queue{}, List: &sqsv1beta1.QueueList{}},
+				Extract:      SQSQueueARN(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.notificationConfiguration.queueConfigurations[%d].queueArn", i)
+			}
+			mg.Spec.ForProvider.NotificationConfiguration.QueueConfigurations[i].QueueArn = reference.ToPtrValue(rsp.ResolvedValue)
+			mg.Spec.ForProvider.NotificationConfiguration.QueueConfigurations[i].QueueArnRef = rsp.ResolvedReference
+		}
 	}
 
 	// Resolve spec.forProvider.loggingConfiguration.targetBucket
@@ -100,7 +137,7 @@ func (mg *Bucket) ResolveReferences(ctx context.Context, c client.Reader) error
 				Reference:    v.Destination.BucketRef,
 				Selector:     v.Destination.BucketSelector,
 				To:           reference.To{Managed: &Bucket{}, List: &BucketList{}},
-				Extract:      reference.ExternalName(),
+				Extract:      BucketARN(),
 			})
 			if err != nil {
 				return errors.Wrapf(err, "spec.forProvider.replicationConfiguration.rules[%d].bucket", i)
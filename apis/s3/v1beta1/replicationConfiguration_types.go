@@ -32,11 +32,11 @@ type ReplicationConfiguration struct {
 	// +optional
 	Role *string `json:"role,omitempty"`
 
-	// RoleRef references an IAMRole to retrieve its Name
+	// RoleRef references an IAMRole to retrieve its ARN
 	// +optional
 	RoleRef *runtimev1alpha1.Reference `json:"roleRef,omitempty"`
 
-	// RoleSelector selects a reference to an IAMRole to retrieve its Name
+	// RoleSelector selects a reference to an IAMRole to retrieve its ARN
 	// +optional
 	RoleSelector *runtimev1alpha1.Selector `json:"roleSelector,omitempty"`
 
@@ -106,6 +106,7 @@ type ReplicationRule struct {
 	//
 	// Status is a required field
 	// Valid values are "Enabled" or "Disabled"
+	// +crossplane:aws:model=s3.DeleteMarkerReplication.Status
 	// +kubebuilder:validation:Enum=Enabled;Disabled
 	Status string `json:"status"`
 }
@@ -154,11 +155,11 @@ type Destination struct {
 	// +optional
 	Bucket *string `json:"bucket,omitempty"`
 
-	// BucketRef references a Bucket to retrieve its Name
+	// BucketRef references a Bucket to retrieve its ARN
 	// +optional
 	BucketRef *runtimev1alpha1.Reference `json:"bucketRef,omitempty"`
 
-	// BucketSelector selects a reference to a Bucket to retrieve its Name
+	// BucketSelector selects a reference to a Bucket to retrieve its ARN
 	// +optional
 	BucketSelector *runtimev1alpha1.Selector `json:"bucketSelector,omitempty"`
 
@@ -183,6 +184,7 @@ type Destination struct {
 	// For valid values, see the StorageClass element of the PUT Bucket replication
 	// (https://docs.aws.amazon.com/AmazonS3/latest/API/RESTBucketPUTreplication.html)
 	// action in the Amazon Simple Storage Service API Reference.
+	// +crossplane:aws:model=s3.NoncurrentVersionTransition.StorageClass
 	// +kubebuilder:validation:Enum=GLACIER;STANDARD_IA;ONEZONE_IA;INTELLIGENT_TIERING;DEEP_ARCHIVE
 	// +optional
 	StorageClass *string `json:"storageClass"`
@@ -221,6 +223,7 @@ type Metrics struct {
 	// Specifies whether the replication metrics are enabled.
 	//
 	// Status is a required field, valid values are "Enabled" and "Disabled"
+	// +crossplane:aws:model=s3.DeleteMarkerReplication.Status
 	// +kubebuilder:validation:Enum=Enabled;Disabled
 	Status string `json:"status"`
 }
@@ -242,6 +245,7 @@ type ReplicationTime struct {
 	// Specifies whether the replication time is enabled
 	// Status is a required field
 	// Valid values are "Enabled" and "Disabled"
+	// +crossplane:aws:model=s3.DeleteMarkerReplication.Status
 	// +kubebuilder:validation:Enum=Enabled;Disabled
 	Status string `json:"status"`
 
@@ -258,6 +262,7 @@ type ReplicationTime struct {
 type ExistingObjectReplication struct {
 	// Status is a required field
 	// Valid values are "Enabled" and "Disabled"
+	// +crossplane:aws:model=s3.DeleteMarkerReplication.Status
 	// +kubebuilder:validation:Enum=Enabled;Disabled
 	Status string `json:"status"`
 }
@@ -325,6 +330,7 @@ type SseKmsEncryptedObjects struct {
 	//
 	// Status is a required field
 	// Valid values are "Enabled" or "Disabled"
+	// +crossplane:aws:model=s3.DeleteMarkerReplication.Status
 	// +kubebuilder:validation:Enum=Enabled;Disabled
 	Status string `json:"status"`
 }
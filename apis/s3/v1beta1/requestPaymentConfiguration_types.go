@@ -20,6 +20,7 @@ package v1beta1
 type PaymentConfiguration struct {
 	// Payer is a required field, detailing who pays
 	// Valid values are "Requester" and "BucketOwner"
+	// +crossplane:aws:model=s3.RequestPaymentConfiguration.Payer
 	// +kubebuilder:validation:Enum=Requester;BucketOwner
 	Payer string `json:"payer"`
 }
@@ -21,10 +21,12 @@ type VersioningConfiguration struct {
 	// MFADelete specifies whether MFA delete is enabled in the bucket versioning configuration.
 	// This element is only returned if the bucket has been configured with MFA
 	// delete. If the bucket has never been so configured, this element is not returned.
+	// +crossplane:aws:model=s3.VersioningConfiguration.MFADelete
 	// +kubebuilder:validation:Enum=Enabled;Disabled
 	MFADelete *string `json:"mfaDelete,omitempty"`
 
 	// Status is the desired versioning state of the bucket.
+	// +crossplane:aws:model=s3.AccelerateConfiguration.Status
 	// +kubebuilder:validation:Enum=Enabled;Suspended
 	Status *string `json:"status,omitempty"`
 }
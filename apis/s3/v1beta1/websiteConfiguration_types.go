@@ -60,6 +60,7 @@ type RedirectAllRequestsTo struct {
 
 	// Protocol to use when redirecting requests. The default is the protocol that
 	// is used in the original request.
+	// +crossplane:aws:model=s3.Redirect.Protocol
 	// +kubebuilder:validation:Enum=http;https
 	Protocol string `json:"protocol"`
 }
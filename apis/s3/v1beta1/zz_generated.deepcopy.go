@@ -259,6 +259,11 @@ func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 		*out = new(NotificationConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PublicAccessBlockConfiguration != nil {
+		in, out := &in.PublicAccessBlockConfiguration, &out.PublicAccessBlockConfiguration
+		*out = new(PublicAccessBlockConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketParameters.
@@ -879,6 +884,41 @@ func (in *PaymentConfiguration) DeepCopy() *PaymentConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicAccessBlockConfiguration) DeepCopyInto(out *PublicAccessBlockConfiguration) {
+	*out = *in
+	if in.BlockPublicACLs != nil {
+		in, out := &in.BlockPublicACLs, &out.BlockPublicACLs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlockPublicPolicy != nil {
+		in, out := &in.BlockPublicPolicy, &out.BlockPublicPolicy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IgnorePublicACLs != nil {
+		in, out := &in.IgnorePublicACLs, &out.IgnorePublicACLs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestrictPublicBuckets != nil {
+		in, out := &in.RestrictPublicBuckets, &out.RestrictPublicBuckets
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicAccessBlockConfiguration.
+func (in *PublicAccessBlockConfiguration) DeepCopy() *PublicAccessBlockConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicAccessBlockConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueueConfiguration) DeepCopyInto(out *QueueConfiguration) {
 	*out = *in
@@ -897,6 +937,21 @@ func (in *QueueConfiguration) DeepCopyInto(out *QueueConfiguration) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.QueueArn != nil {
+		in, out := &in.QueueArn, &out.QueueArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueArnRef != nil {
+		in, out := &in.QueueArnRef, &out.QueueArnRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.QueueArnSelector != nil {
+		in, out := &in.QueueArnSelector, &out.QueueArnSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueConfiguration.
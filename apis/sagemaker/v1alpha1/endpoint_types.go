@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Endpoint statuses.
+const (
+	EndpointStatusOutOfService   = "OutOfService"
+	EndpointStatusCreating       = "Creating"
+	EndpointStatusUpdating       = "Updating"
+	EndpointStatusSystemUpdating = "SystemUpdating"
+	EndpointStatusRollingBack    = "RollingBack"
+	EndpointStatusInService      = "InService"
+	EndpointStatusDeleting       = "Deleting"
+	EndpointStatusFailed         = "Failed"
+)
+
+// EndpointParameters define the desired state of an AWS SageMaker endpoint.
+type EndpointParameters struct {
+	// Region is the region of the endpoint.
+	// +immutable
+	Region string `json:"region"`
+
+	// EndpointConfigName is the name of the endpoint configuration the
+	// endpoint is deployed with. Changing it performs a blue/green
+	// deployment: SageMaker provisions the new configuration, shifts the
+	// traffic to it once it is healthy and then releases the resources of
+	// the previous configuration, so the endpoint keeps serving throughout.
+	// +optional
+	EndpointConfigName *string `json:"endpointConfigName,omitempty"`
+
+	// EndpointConfigNameRef references an EndpointConfig to retrieve its
+	// name.
+	// +optional
+	EndpointConfigNameRef *runtimev1alpha1.Reference `json:"endpointConfigNameRef,omitempty"`
+
+	// EndpointConfigNameSelector selects an EndpointConfig to retrieve its
+	// name.
+	// +optional
+	EndpointConfigNameSelector *runtimev1alpha1.Selector `json:"endpointConfigNameSelector,omitempty"`
+
+	// RetainAllVariantProperties keeps the weights and instance counts of
+	// the variants that were changed after the deployment when the endpoint
+	// configuration changes.
+	// +optional
+	RetainAllVariantProperties *bool `json:"retainAllVariantProperties,omitempty"`
+
+	// Tags of the endpoint.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ProductionVariantSummary describes the deployed state of a production
+// variant.
+type ProductionVariantSummary struct {
+	// VariantName is the name of the production variant.
+	VariantName string `json:"variantName"`
+
+	// CurrentInstanceCount is the number of instances hosting the variant.
+	CurrentInstanceCount int64 `json:"currentInstanceCount,omitempty"`
+
+	// DesiredInstanceCount is the number of instances the variant is
+	// scaled to.
+	DesiredInstanceCount int64 `json:"desiredInstanceCount,omitempty"`
+}
+
+// EndpointObservation keeps the state for the external resource
+type EndpointObservation struct {
+	// EndpointARN is the ARN of the endpoint.
+	EndpointARN string `json:"endpointArn,omitempty"`
+
+	// EndpointStatus is the status of the endpoint.
+	EndpointStatus string `json:"endpointStatus,omitempty"`
+
+	// EndpointConfigName is the name of the endpoint configuration the
+	// endpoint currently serves. It differs from the desired one while a
+	// deployment is in progress.
+	EndpointConfigName string `json:"endpointConfigName,omitempty"`
+
+	// FailureReason is the reason the last deployment of the endpoint
+	// failed, if it did.
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// ProductionVariants are the deployed production variants.
+	ProductionVariants []ProductionVariantSummary `json:"productionVariants,omitempty"`
+}
+
+// An EndpointSpec defines the desired state of an Endpoint.
+type EndpointSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  EndpointParameters `json:"forProvider"`
+}
+
+// An EndpointStatus represents the observed state of an Endpoint.
+type EndpointStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     EndpointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Endpoint is a managed resource that represents an AWS SageMaker
+// endpoint.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.endpointStatus"
+// +kubebuilder:printcolumn:name="CONFIG",type="string",JSONPath=".status.atProvider.endpointConfigName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Endpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EndpointSpec   `json:"spec"`
+	Status EndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EndpointList contains a list of Endpoints
+type EndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Endpoint `json:"items"`
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ProductionVariant describes a model that is deployed to an endpoint and
+// the resources that host it.
+type ProductionVariant struct {
+	// VariantName is the name of the production variant.
+	VariantName string `json:"variantName"`
+
+	// ModelName is the name of the model that the variant hosts.
+	// +optional
+	ModelName *string `json:"modelName,omitempty"`
+
+	// ModelNameRef references a Model to retrieve its name.
+	// +optional
+	ModelNameRef *runtimev1alpha1.Reference `json:"modelNameRef,omitempty"`
+
+	// ModelNameSelector selects a Model to retrieve its name.
+	// +optional
+	ModelNameSelector *runtimev1alpha1.Selector `json:"modelNameSelector,omitempty"`
+
+	// InstanceType is the ML compute instance type, e.g. ml.m5.large.
+	InstanceType string `json:"instanceType"`
+
+	// InitialInstanceCount is the number of instances the variant starts
+	// with.
+	// +kubebuilder:validation:Minimum=1
+	InitialInstanceCount int64 `json:"initialInstanceCount"`
+
+	// NOTE: Type of InitialVariantWeight is float64 in AWS SDK but float is
+	// not supported by controller-runtime. Weights are relative, so whole
+	// numbers suffice.
+	// See https://github.com/kubernetes-sigs/controller-tools/issues/245
+
+	// InitialVariantWeight determines the share of the traffic the variant
+	// receives relative to the other variants.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	InitialVariantWeight *int64 `json:"initialVariantWeight,omitempty"`
+
+	// AcceleratorType is the Elastic Inference accelerator type attached to
+	// the instances.
+	// +optional
+	AcceleratorType *string `json:"acceleratorType,omitempty"`
+}
+
+// CaptureContentTypeHeader specifies the content types whose payload is
+// captured as CSV or JSON.
+type CaptureContentTypeHeader struct {
+	// CSVContentTypes are the content types captured as CSV.
+	// +optional
+	CSVContentTypes []string `json:"csvContentTypes,omitempty"`
+
+	// JSONContentTypes are the content types captured as JSON.
+	// +optional
+	JSONContentTypes []string `json:"jsonContentTypes,omitempty"`
+}
+
+// DataCaptureConfig configures the capture of the requests and responses of
+// an endpoint.
+type DataCaptureConfig struct {
+	// EnableCapture enables data capture.
+	// +optional
+	EnableCapture *bool `json:"enableCapture,omitempty"`
+
+	// InitialSamplingPercentage is the percentage of the requests that are
+	// captured.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	InitialSamplingPercentage int64 `json:"initialSamplingPercentage"`
+
+	// DestinationS3URI is the S3 location the captured data is stored in.
+	DestinationS3URI string `json:"destinationS3Uri"`
+
+	// KMSKeyID is the ID of the KMS key used to encrypt the captured data.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// CaptureModes are the parts of the traffic that are captured.
+	// +kubebuilder:validation:MinItems=1
+	CaptureModes []string `json:"captureModes"`
+
+	// CaptureContentTypeHeader specifies how the payload is encoded.
+	// +optional
+	CaptureContentTypeHeader *CaptureContentTypeHeader `json:"captureContentTypeHeader,omitempty"`
+}
+
+// EndpointConfigParameters define the desired state of an AWS SageMaker
+// endpoint configuration. Apart from its tags, an endpoint configuration
+// cannot be changed after creation; create a new one and point the Endpoint
+// to it instead.
+type EndpointConfigParameters struct {
+	// Region is the region of the endpoint configuration.
+	// +immutable
+	Region string `json:"region"`
+
+	// ProductionVariants are the models deployed to the endpoint.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	ProductionVariants []ProductionVariant `json:"productionVariants"`
+
+	// DataCaptureConfig configures the capture of the traffic of the
+	// endpoint.
+	// +immutable
+	// +optional
+	DataCaptureConfig *DataCaptureConfig `json:"dataCaptureConfig,omitempty"`
+
+	// KMSKeyID is the ID of the KMS key used to encrypt the storage volumes
+	// of the instances hosting the endpoint.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// Tags of the endpoint configuration.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// EndpointConfigObservation keeps the state for the external resource
+type EndpointConfigObservation struct {
+	// EndpointConfigARN is the ARN of the endpoint configuration.
+	EndpointConfigARN string `json:"endpointConfigArn,omitempty"`
+}
+
+// An EndpointConfigSpec defines the desired state of an EndpointConfig.
+type EndpointConfigSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  EndpointConfigParameters `json:"forProvider"`
+}
+
+// An EndpointConfigStatus represents the observed state of an
+// EndpointConfig.
+type EndpointConfigStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     EndpointConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EndpointConfig is a managed resource that represents an AWS SageMaker
+// endpoint configuration.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type EndpointConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EndpointConfigSpec   `json:"spec"`
+	Status EndpointConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EndpointConfigList contains a list of EndpointConfigs
+type EndpointConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EndpointConfig `json:"items"`
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ContainerDefinition describes a container that serves the model.
+type ContainerDefinition struct {
+	// ContainerHostname is the DNS host name of the container.
+	// +optional
+	ContainerHostname *string `json:"containerHostname,omitempty"`
+
+	// Environment variables set in the container.
+	// +optional
+	Environment map[string]string `json:"environment,omitempty"`
+
+	// Image is the path of the ECR registry image that contains the
+	// inference code.
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// Mode specifies whether the container hosts a single or multiple
+	// models.
+	// +crossplane:aws:model=sagemaker.ContainerDefinition.Mode
+	// +kubebuilder:validation:Enum=SingleModel;MultiModel
+	// +optional
+	Mode *string `json:"mode,omitempty"`
+
+	// ModelDataURL is the S3 path of the model artifacts.
+	// +optional
+	ModelDataURL *string `json:"modelDataUrl,omitempty"`
+
+	// ModelPackageName is the name or ARN of the model package used to
+	// create the model.
+	// +optional
+	ModelPackageName *string `json:"modelPackageName,omitempty"`
+}
+
+// ModelParameters define the desired state of an AWS SageMaker model. Apart
+// from its tags, a model cannot be changed after creation.
+type ModelParameters struct {
+	// Region is the region of the model.
+	// +immutable
+	Region string `json:"region"`
+
+	// ExecutionRoleARN is the ARN of the IAM role that SageMaker assumes to
+	// access the model artifacts and the container images.
+	// +immutable
+	// +optional
+	ExecutionRoleARN *string `json:"executionRoleArn,omitempty"`
+
+	// ExecutionRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	ExecutionRoleARNRef *runtimev1alpha1.Reference `json:"executionRoleArnRef,omitempty"`
+
+	// ExecutionRoleARNSelector selects an IAMRole to retrieve its ARN.
+	// +optional
+	ExecutionRoleARNSelector *runtimev1alpha1.Selector `json:"executionRoleArnSelector,omitempty"`
+
+	// PrimaryContainer is the container that serves the model. Either the
+	// primary container or containers must be set.
+	// +immutable
+	// +optional
+	PrimaryContainer *ContainerDefinition `json:"primaryContainer,omitempty"`
+
+	// Containers form an inference pipeline that serves the model.
+	// +immutable
+	// +optional
+	Containers []ContainerDefinition `json:"containers,omitempty"`
+
+	// VerifyImages checks that the ECR images of the containers exist before
+	// the model is created, so that a missing tag or digest is reported on
+	// the resource instead of by a failed creation. Images that are not
+	// stored in ECR are not checked.
+	// +optional
+	VerifyImages *bool `json:"verifyImages,omitempty"`
+
+	// EnableNetworkIsolation disables network access of the containers.
+	// +immutable
+	// +optional
+	EnableNetworkIsolation *bool `json:"enableNetworkIsolation,omitempty"`
+
+	// VPCConfig specifies the VPC the containers connect to.
+	// +immutable
+	// +optional
+	VPCConfig *VPCConfig `json:"vpcConfig,omitempty"`
+
+	// Tags of the model.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ModelObservation keeps the state for the external resource
+type ModelObservation struct {
+	// ModelARN is the ARN of the model.
+	ModelARN string `json:"modelArn,omitempty"`
+}
+
+// A ModelSpec defines the desired state of a Model.
+type ModelSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ModelParameters `json:"forProvider"`
+}
+
+// A ModelStatus represents the observed state of a Model.
+type ModelStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ModelObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Model is a managed resource that represents an AWS SageMaker model.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Model struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ModelSpec   `json:"spec"`
+	Status ModelStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ModelList contains a list of Models
+type ModelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Model `json:"items"`
+}
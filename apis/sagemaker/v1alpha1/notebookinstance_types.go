@@ -0,0 +1,199 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// NotebookInstance statuses.
+const (
+	NotebookInstanceStatusPending   = "Pending"
+	NotebookInstanceStatusInService = "InService"
+	NotebookInstanceStatusStopping  = "Stopping"
+	NotebookInstanceStatusStopped   = "Stopped"
+	NotebookInstanceStatusFailed    = "Failed"
+	NotebookInstanceStatusDeleting  = "Deleting"
+	NotebookInstanceStatusUpdating  = "Updating"
+)
+
+// NotebookInstanceParameters define the desired state of an AWS SageMaker
+// notebook instance.
+type NotebookInstanceParameters struct {
+	// Region is the region of the notebook instance.
+	// +immutable
+	Region string `json:"region"`
+
+	// InstanceType is the ML compute instance type of the notebook instance,
+	// e.g. ml.t2.medium.
+	InstanceType string `json:"instanceType"`
+
+	// RoleARN is the ARN of the IAM role that SageMaker assumes to access
+	// other AWS services on behalf of the notebook instance.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNRef *runtimev1alpha1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNSelector *runtimev1alpha1.Selector `json:"roleArnSelector,omitempty"`
+
+	// SubnetID is the ID of the subnet the network interface of the notebook
+	// instance is placed in.
+	// +immutable
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDRef *runtimev1alpha1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the network
+	// interface of the notebook instance.
+	// +immutable
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their IDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects SecurityGroups to retrieve their IDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// KMSKeyID is the ID of the KMS key used to encrypt the storage volume
+	// of the notebook instance.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// DirectInternetAccess specifies whether the notebook instance has
+	// direct internet access. It can only be disabled when the notebook
+	// instance is placed in a subnet.
+	// +immutable
+	// +crossplane:aws:model=sagemaker.CreateNotebookInstanceInput.DirectInternetAccess
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	DirectInternetAccess *string `json:"directInternetAccess,omitempty"`
+
+	// RootAccess specifies whether users have root access on the notebook
+	// instance.
+	// +crossplane:aws:model=sagemaker.CreateNotebookInstanceInput.RootAccess
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	RootAccess *string `json:"rootAccess,omitempty"`
+
+	// VolumeSizeInGB is the size of the ML storage volume attached to the
+	// notebook instance.
+	// +kubebuilder:validation:Minimum=5
+	// +optional
+	VolumeSizeInGB *int64 `json:"volumeSizeInGB,omitempty"`
+
+	// AcceleratorTypes are the Elastic Inference accelerator types
+	// associated with the notebook instance.
+	// +optional
+	AcceleratorTypes []string `json:"acceleratorTypes,omitempty"`
+
+	// LifecycleConfigName is the name of the lifecycle configuration that
+	// runs when the notebook instance is created or started.
+	// +optional
+	LifecycleConfigName *string `json:"lifecycleConfigName,omitempty"`
+
+	// DefaultCodeRepository is the Git repository, or the name of a
+	// SageMaker code repository, that is cloned into the notebook instance.
+	// +optional
+	DefaultCodeRepository *string `json:"defaultCodeRepository,omitempty"`
+
+	// AdditionalCodeRepositories are further Git repositories that are
+	// cloned into the notebook instance.
+	// +optional
+	AdditionalCodeRepositories []string `json:"additionalCodeRepositories,omitempty"`
+
+	// Tags of the notebook instance.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// NotebookInstanceObservation keeps the state for the external resource
+type NotebookInstanceObservation struct {
+	// NotebookInstanceARN is the ARN of the notebook instance.
+	NotebookInstanceARN string `json:"notebookInstanceArn,omitempty"`
+
+	// NotebookInstanceStatus is the status of the notebook instance.
+	NotebookInstanceStatus string `json:"notebookInstanceStatus,omitempty"`
+
+	// URL is the URL used to connect to the Jupyter server of the notebook
+	// instance.
+	URL string `json:"url,omitempty"`
+
+	// NetworkInterfaceID is the ID of the network interface of the notebook
+	// instance.
+	NetworkInterfaceID string `json:"networkInterfaceId,omitempty"`
+
+	// FailureReason is the reason the notebook instance failed, if it did.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// A NotebookInstanceSpec defines the desired state of a NotebookInstance.
+type NotebookInstanceSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  NotebookInstanceParameters `json:"forProvider"`
+}
+
+// A NotebookInstanceStatus represents the observed state of a
+// NotebookInstance.
+type NotebookInstanceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     NotebookInstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NotebookInstance is a managed resource that represents an AWS SageMaker
+// notebook instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.notebookInstanceStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NotebookInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotebookInstanceSpec   `json:"spec"`
+	Status NotebookInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotebookInstanceList contains a list of NotebookInstances
+type NotebookInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotebookInstance `json:"items"`
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "sagemaker.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// NotebookInstance type metadata.
+var (
+	NotebookInstanceKind             = reflect.TypeOf(NotebookInstance{}).Name()
+	NotebookInstanceGroupKind        = schema.GroupKind{Group: Group, Kind: NotebookInstanceKind}.String()
+	NotebookInstanceKindAPIVersion   = NotebookInstanceKind + "." + SchemeGroupVersion.String()
+	NotebookInstanceGroupVersionKind = SchemeGroupVersion.WithKind(NotebookInstanceKind)
+)
+
+// Model type metadata.
+var (
+	ModelKind             = reflect.TypeOf(Model{}).Name()
+	ModelGroupKind        = schema.GroupKind{Group: Group, Kind: ModelKind}.String()
+	ModelKindAPIVersion   = ModelKind + "." + SchemeGroupVersion.String()
+	ModelGroupVersionKind = SchemeGroupVersion.WithKind(ModelKind)
+)
+
+// EndpointConfig type metadata.
+var (
+	EndpointConfigKind             = reflect.TypeOf(EndpointConfig{}).Name()
+	EndpointConfigGroupKind        = schema.GroupKind{Group: Group, Kind: EndpointConfigKind}.String()
+	EndpointConfigKindAPIVersion   = EndpointConfigKind + "." + SchemeGroupVersion.String()
+	EndpointConfigGroupVersionKind = SchemeGroupVersion.WithKind(EndpointConfigKind)
+)
+
+// Endpoint type metadata.
+var (
+	EndpointKind             = reflect.TypeOf(Endpoint{}).Name()
+	EndpointGroupKind        = schema.GroupKind{Group: Group, Kind: EndpointKind}.String()
+	EndpointKindAPIVersion   = EndpointKind + "." + SchemeGroupVersion.String()
+	EndpointGroupVersionKind = SchemeGroupVersion.WithKind(EndpointKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NotebookInstance{}, &NotebookInstanceList{})
+	SchemeBuilder.Register(&Model{}, &ModelList{})
+	SchemeBuilder.Register(&EndpointConfig{}, &EndpointConfigList{})
+	SchemeBuilder.Register(&Endpoint{}, &EndpointList{})
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SecurityHubAccountParameters define the desired state of AWS Security Hub
+// in an account and region.
+type SecurityHubAccountParameters struct {
+	// Region is the region Security Hub is enabled in. Security Hub can only
+	// be enabled once per account and region.
+	// +immutable
+	Region string `json:"region"`
+
+	// EnableDefaultStandards specifies whether the standards that Security
+	// Hub designates as default are subscribed to when it is enabled. Use
+	// SecurityHubStandardsSubscriptions to manage the standards instead.
+	// Defaults to true.
+	// +immutable
+	// +optional
+	EnableDefaultStandards *bool `json:"enableDefaultStandards,omitempty"`
+
+	// Tags to add to the hub when Security Hub is enabled.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// SecurityHubAccountObservation keeps the state for the external resource
+type SecurityHubAccountObservation struct {
+	// HubARN is the Amazon Resource Name (ARN) of the hub.
+	HubARN string `json:"hubArn,omitempty"`
+
+	// SubscribedAt is the timestamp of when Security Hub was enabled.
+	SubscribedAt string `json:"subscribedAt,omitempty"`
+}
+
+// A SecurityHubAccountSpec defines the desired state of a
+// SecurityHubAccount.
+type SecurityHubAccountSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SecurityHubAccountParameters `json:"forProvider"`
+}
+
+// A SecurityHubAccountStatus represents the observed state of a
+// SecurityHubAccount.
+type SecurityHubAccountStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     SecurityHubAccountObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecurityHubAccount is a managed resource that enables AWS Security Hub in
+// the account and region of its provider. Deleting it disables Security Hub,
+// which also removes all standards subscriptions of the region.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SecurityHubAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecurityHubAccountSpec   `json:"spec"`
+	Status SecurityHubAccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityHubAccountList contains a list of SecurityHubAccounts
+type SecurityHubAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityHubAccount `json:"items"`
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SecurityHubStandardsSubscriptionParameters define the desired state of an
+// AWS Security Hub standards subscription.
+type SecurityHubStandardsSubscriptionParameters struct {
+	// Region is the region you'd like your
+	// SecurityHubStandardsSubscription to be created in. Security Hub must
+	// be enabled in the region.
+	// +immutable
+	Region string `json:"region"`
+
+	// StandardsARN is the ARN of the standard to subscribe to, such as
+	// arn:aws:securityhub:us-east-1::standards/aws-foundational-security-best-practices/v/1.0.0.
+	// +immutable
+	StandardsARN string `json:"standardsArn"`
+
+	// StandardsInput are the parameters of the standard.
+	// +immutable
+	// +optional
+	StandardsInput map[string]string `json:"standardsInput,omitempty"`
+}
+
+// SecurityHubStandardsSubscriptionObservation keeps the state for the
+// external resource
+type SecurityHubStandardsSubscriptionObservation struct {
+	// StandardsStatus is the status of the subscription.
+	StandardsStatus string `json:"standardsStatus,omitempty"`
+}
+
+// A SecurityHubStandardsSubscriptionSpec defines the desired state of a
+// SecurityHubStandardsSubscription.
+type SecurityHubStandardsSubscriptionSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SecurityHubStandardsSubscriptionParameters `json:"forProvider"`
+}
+
+// A SecurityHubStandardsSubscriptionStatus represents the observed state of
+// a SecurityHubStandardsSubscription.
+type SecurityHubStandardsSubscriptionStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     SecurityHubStandardsSubscriptionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecurityHubStandardsSubscription is a managed resource that represents
+// the subscription of an account to an AWS Security Hub standard. Its
+// external name is the ARN of the subscription.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.standardsStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SecurityHubStandardsSubscription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecurityHubStandardsSubscriptionSpec   `json:"spec"`
+	Status SecurityHubStandardsSubscriptionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityHubStandardsSubscriptionList contains a list of
+// SecurityHubStandardsSubscriptions
+type SecurityHubStandardsSubscriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityHubStandardsSubscription `json:"items"`
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// PortfolioParameters define the desired state of an AWS Service Catalog
+// portfolio.
+type PortfolioParameters struct {
+	// Region is the region you'd like your Portfolio to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// DisplayName is the name to use for display purposes.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=100
+	DisplayName string `json:"displayName"`
+
+	// Description of the portfolio.
+	// +kubebuilder:validation:MaxLength=2000
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// ProviderName is the name of the portfolio provider.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=50
+	ProviderName string `json:"providerName"`
+
+	// PrincipalARNs are the ARNs of the IAM users, groups or roles that are
+	// granted access to the portfolio.
+	// +optional
+	PrincipalARNs []string `json:"principalArns,omitempty"`
+
+	// PrincipalARNRefs are references to IAMRoles used to set the
+	// PrincipalARNs.
+	// +optional
+	PrincipalARNRefs []runtimev1alpha1.Reference `json:"principalArnRefs,omitempty"`
+
+	// PrincipalARNSelector selects references to IAMRoles used to set the
+	// PrincipalARNs.
+	// +optional
+	PrincipalARNSelector *runtimev1alpha1.Selector `json:"principalArnSelector,omitempty"`
+
+	// Tags to assign to the portfolio.
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// PortfolioObservation is the observed state of a Portfolio.
+type PortfolioObservation struct {
+	// ARN of the portfolio.
+	ARN string `json:"arn,omitempty"`
+}
+
+// A PortfolioSpec defines the desired state of a Portfolio.
+type PortfolioSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  PortfolioParameters `json:"forProvider"`
+}
+
+// A PortfolioStatus represents the observed state of a Portfolio.
+type PortfolioStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     PortfolioObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Portfolio is a managed resource that represents an AWS Service Catalog
+// portfolio. Its external name is the ID of the portfolio.
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Portfolio struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PortfolioSpec   `json:"spec"`
+	Status PortfolioStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PortfolioList contains a list of Portfolios
+type PortfolioList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Portfolio `json:"items"`
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ProvisioningArtifactParameters describe the initial version of a product.
+type ProvisioningArtifactParameters struct {
+	// Name of the provisioning artifact, e.g. v1.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Description of the provisioning artifact.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// TemplateURL is the URL of the CloudFormation template in Amazon S3.
+	// It is derived from TemplateBucket and TemplateKey when omitted.
+	// +optional
+	TemplateURL *string `json:"templateUrl,omitempty"`
+
+	// TemplateBucket is the name of the S3 bucket that holds the
+	// CloudFormation template.
+	// +optional
+	TemplateBucket *string `json:"templateBucket,omitempty"`
+
+	// TemplateBucketRef references a Bucket to set the TemplateBucket.
+	// +optional
+	TemplateBucketRef *runtimev1alpha1.Reference `json:"templateBucketRef,omitempty"`
+
+	// TemplateBucketSelector selects a reference to a Bucket to set the
+	// TemplateBucket.
+	// +optional
+	TemplateBucketSelector *runtimev1alpha1.Selector `json:"templateBucketSelector,omitempty"`
+
+	// TemplateKey is the key of the CloudFormation template object in the
+	// TemplateBucket.
+	// +optional
+	TemplateKey *string `json:"templateKey,omitempty"`
+
+	// DisableTemplateValidation skips the validation of the template.
+	// +optional
+	DisableTemplateValidation *bool `json:"disableTemplateValidation,omitempty"`
+}
+
+// ProductParameters define the desired state of an AWS Service Catalog
+// product.
+type ProductParameters struct {
+	// Region is the region you'd like your Product to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name of the product.
+	// +kubebuilder:validation:MaxLength=8191
+	Name string `json:"name"`
+
+	// Owner of the product.
+	// +kubebuilder:validation:MaxLength=8191
+	Owner string `json:"owner"`
+
+	// Description of the product.
+	// +kubebuilder:validation:MaxLength=8191
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Distributor of the product.
+	// +kubebuilder:validation:MaxLength=8191
+	// +optional
+	Distributor *string `json:"distributor,omitempty"`
+
+	// SupportDescription contains information about how to get support for
+	// the product.
+	// +kubebuilder:validation:MaxLength=8191
+	// +optional
+	SupportDescription *string `json:"supportDescription,omitempty"`
+
+	// SupportEmail is the contact email for product support.
+	// +kubebuilder:validation:MaxLength=254
+	// +optional
+	SupportEmail *string `json:"supportEmail,omitempty"`
+
+	// SupportURL is the contact URL for product support.
+	// +kubebuilder:validation:MaxLength=2083
+	// +optional
+	SupportURL *string `json:"supportUrl,omitempty"`
+
+	// ProvisioningArtifact is the CloudFormation template the product is
+	// created with.
+	// +immutable
+	ProvisioningArtifact ProvisioningArtifactParameters `json:"provisioningArtifact"`
+
+	// PortfolioIDs are the IDs of the portfolios the product is associated
+	// with.
+	// +optional
+	PortfolioIDs []string `json:"portfolioIds,omitempty"`
+
+	// PortfolioIDRefs are references to Portfolios used to set the
+	// PortfolioIDs.
+	// +optional
+	PortfolioIDRefs []runtimev1alpha1.Reference `json:"portfolioIdRefs,omitempty"`
+
+	// PortfolioIDSelector selects references to Portfolios used to set the
+	// PortfolioIDs.
+	// +optional
+	PortfolioIDSelector *runtimev1alpha1.Selector `json:"portfolioIdSelector,omitempty"`
+
+	// LaunchRoleARN is the ARN of the IAM role Service Catalog assumes to
+	// launch the product. It is added as a launch constraint in each of the
+	// portfolios of the product.
+	// +optional
+	LaunchRoleARN *string `json:"launchRoleArn,omitempty"`
+
+	// LaunchRoleARNRef references an IAMRole to set the LaunchRoleARN.
+	// +optional
+	LaunchRoleARNRef *runtimev1alpha1.Reference `json:"launchRoleArnRef,omitempty"`
+
+	// LaunchRoleARNSelector selects a reference to an IAMRole to set the
+	// LaunchRoleARN.
+	// +optional
+	LaunchRoleARNSelector *runtimev1alpha1.Selector `json:"launchRoleArnSelector,omitempty"`
+
+	// Tags to assign to the product.
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ProductObservation is the observed state of a Product.
+type ProductObservation struct {
+	// ARN of the product.
+	ARN string `json:"arn,omitempty"`
+
+	// ProductViewID is the ID of the product view.
+	ProductViewID string `json:"productViewId,omitempty"`
+
+	// ProvisioningArtifactID is the ID of the provisioning artifact the
+	// product was created with.
+	ProvisioningArtifactID string `json:"provisioningArtifactId,omitempty"`
+
+	// Status of the product.
+	Status string `json:"status,omitempty"`
+}
+
+// A ProductSpec defines the desired state of a Product.
+type ProductSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ProductParameters `json:"forProvider"`
+}
+
+// A ProductStatus represents the observed state of a Product.
+type ProductStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ProductObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Product is a managed resource that represents an AWS Service Catalog
+// product. Its external name is the ID of the product.
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Product struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProductSpec   `json:"spec"`
+	Status ProductStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProductList contains a list of Products
+type ProductList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Product `json:"items"`
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// ResolveReferences of this Portfolio
+func (mg *Portfolio) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.principalArns
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.PrincipalARNs,
+		References:    mg.Spec.ForProvider.PrincipalARNRefs,
+		Selector:      mg.Spec.ForProvider.PrincipalARNSelector,
+		To:            reference.To{Managed: &identityv1beta1.IAMRole{}, List: &identityv1beta1.IAMRoleList{}},
+		Extract:       identityv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.principalArns")
+	}
+	mg.Spec.ForProvider.PrincipalARNs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.PrincipalARNRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this Product
+func (mg *Product) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.provisioningArtifact.templateBucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ProvisioningArtifact.TemplateBucket),
+		Reference:    mg.Spec.ForProvider.ProvisioningArtifact.TemplateBucketRef,
+		Selector:     mg.Spec.ForProvider.ProvisioningArtifact.TemplateBucketSelector,
+		To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.provisioningArtifact.templateBucket")
+	}
+	mg.Spec.ForProvider.ProvisioningArtifact.TemplateBucket = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ProvisioningArtifact.TemplateBucketRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.portfolioIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.PortfolioIDs,
+		References:    mg.Spec.ForProvider.PortfolioIDRefs,
+		Selector:      mg.Spec.ForProvider.PortfolioIDSelector,
+		To:            reference.To{Managed: &Portfolio{}, List: &PortfolioList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.portfolioIds")
+	}
+	mg.Spec.ForProvider.PortfolioIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.PortfolioIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.launchRoleArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LaunchRoleARN),
+		Reference:    mg.Spec.ForProvider.LaunchRoleARNRef,
+		Selector:     mg.Spec.ForProvider.LaunchRoleARNSelector,
+		To:           reference.To{Managed: &identityv1beta1.IAMRole{}, List: &identityv1beta1.IAMRoleList{}},
+		Extract:      identityv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.launchRoleArn")
+	}
+	mg.Spec.ForProvider.LaunchRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LaunchRoleARNRef = rsp.ResolvedReference
+
+	return nil
+}
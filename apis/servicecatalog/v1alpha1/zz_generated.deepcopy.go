@@ -0,0 +1,428 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Portfolio) DeepCopyInto(out *Portfolio) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Portfolio.
+func (in *Portfolio) DeepCopy() *Portfolio {
+	if in == nil {
+		return nil
+	}
+	out := new(Portfolio)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Portfolio) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortfolioList) DeepCopyInto(out *PortfolioList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Portfolio, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortfolioList.
+func (in *PortfolioList) DeepCopy() *PortfolioList {
+	if in == nil {
+		return nil
+	}
+	out := new(PortfolioList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PortfolioList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortfolioObservation) DeepCopyInto(out *PortfolioObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortfolioObservation.
+func (in *PortfolioObservation) DeepCopy() *PortfolioObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PortfolioObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortfolioParameters) DeepCopyInto(out *PortfolioParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrincipalARNs != nil {
+		in, out := &in.PrincipalARNs, &out.PrincipalARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrincipalARNRefs != nil {
+		in, out := &in.PrincipalARNRefs, &out.PrincipalARNRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrincipalARNSelector != nil {
+		in, out := &in.PrincipalARNSelector, &out.PrincipalARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortfolioParameters.
+func (in *PortfolioParameters) DeepCopy() *PortfolioParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PortfolioParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortfolioSpec) DeepCopyInto(out *PortfolioSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortfolioSpec.
+func (in *PortfolioSpec) DeepCopy() *PortfolioSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PortfolioSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortfolioStatus) DeepCopyInto(out *PortfolioStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortfolioStatus.
+func (in *PortfolioStatus) DeepCopy() *PortfolioStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PortfolioStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Product) DeepCopyInto(out *Product) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Product.
+func (in *Product) DeepCopy() *Product {
+	if in == nil {
+		return nil
+	}
+	out := new(Product)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Product) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductList) DeepCopyInto(out *ProductList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Product, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductList.
+func (in *ProductList) DeepCopy() *ProductList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProductList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductObservation) DeepCopyInto(out *ProductObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductObservation.
+func (in *ProductObservation) DeepCopy() *ProductObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductParameters) DeepCopyInto(out *ProductParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Distributor != nil {
+		in, out := &in.Distributor, &out.Distributor
+		*out = new(string)
+		**out = **in
+	}
+	if in.SupportDescription != nil {
+		in, out := &in.SupportDescription, &out.SupportDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.SupportEmail != nil {
+		in, out := &in.SupportEmail, &out.SupportEmail
+		*out = new(string)
+		**out = **in
+	}
+	if in.SupportURL != nil {
+		in, out := &in.SupportURL, &out.SupportURL
+		*out = new(string)
+		**out = **in
+	}
+	in.ProvisioningArtifact.DeepCopyInto(&out.ProvisioningArtifact)
+	if in.PortfolioIDs != nil {
+		in, out := &in.PortfolioIDs, &out.PortfolioIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PortfolioIDRefs != nil {
+		in, out := &in.PortfolioIDRefs, &out.PortfolioIDRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.PortfolioIDSelector != nil {
+		in, out := &in.PortfolioIDSelector, &out.PortfolioIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LaunchRoleARN != nil {
+		in, out := &in.LaunchRoleARN, &out.LaunchRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.LaunchRoleARNRef != nil {
+		in, out := &in.LaunchRoleARNRef, &out.LaunchRoleARNRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.LaunchRoleARNSelector != nil {
+		in, out := &in.LaunchRoleARNSelector, &out.LaunchRoleARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductParameters.
+func (in *ProductParameters) DeepCopy() *ProductParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductSpec) DeepCopyInto(out *ProductSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductSpec.
+func (in *ProductSpec) DeepCopy() *ProductSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductStatus) DeepCopyInto(out *ProductStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductStatus.
+func (in *ProductStatus) DeepCopy() *ProductStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningArtifactParameters) DeepCopyInto(out *ProvisioningArtifactParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.TemplateURL != nil {
+		in, out := &in.TemplateURL, &out.TemplateURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.TemplateBucket != nil {
+		in, out := &in.TemplateBucket, &out.TemplateBucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.TemplateBucketRef != nil {
+		in, out := &in.TemplateBucketRef, &out.TemplateBucketRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.TemplateBucketSelector != nil {
+		in, out := &in.TemplateBucketSelector, &out.TemplateBucketSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemplateKey != nil {
+		in, out := &in.TemplateKey, &out.TemplateKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisableTemplateValidation != nil {
+		in, out := &in.DisableTemplateValidation, &out.DisableTemplateValidation
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningArtifactParameters.
+func (in *ProvisioningArtifactParameters) DeepCopy() *ProvisioningArtifactParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningArtifactParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
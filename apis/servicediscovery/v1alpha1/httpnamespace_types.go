@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// HTTPNamespaceParameters define the desired state of an AWS Cloud Map HTTP
+// namespace. The services of an HTTP namespace are only discoverable by
+// DiscoverInstances API calls, not by DNS queries.
+type HTTPNamespaceParameters struct {
+	// Region is the region you'd like the HTTPNamespace to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name is the name of the namespace.
+	// +immutable
+	// +kubebuilder:validation:MaxLength=1024
+	Name string `json:"name"`
+
+	// Description of the namespace.
+	// +immutable
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// HTTPNamespaceObservation is the representation of the current state
+// that is observed.
+type HTTPNamespaceObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the namespace.
+	ARN string `json:"arn,omitempty"`
+
+	// HTTPName is the name that DiscoverInstances API calls use to find the
+	// services of the namespace.
+	HTTPName string `json:"httpName,omitempty"`
+
+	// ServiceCount is the number of services in the namespace.
+	ServiceCount int64 `json:"serviceCount,omitempty"`
+}
+
+// HTTPNamespaceSpec defines the desired state of an AWS Cloud Map
+// HTTPNamespace.
+type HTTPNamespaceSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  HTTPNamespaceParameters `json:"forProvider"`
+}
+
+// HTTPNamespaceStatus represents the observed state of an AWS Cloud
+// Map HTTPNamespace.
+type HTTPNamespaceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     HTTPNamespaceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An HTTPNamespace is a managed resource that represents an AWS Cloud Map
+// namespace whose services are only discoverable by API calls.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type HTTPNamespace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HTTPNamespaceSpec   `json:"spec"`
+	Status HTTPNamespaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HTTPNamespaceList contains a list of HTTPNamespace
+type HTTPNamespaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HTTPNamespace `json:"items"`
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// PublicDNSNamespaceParameters define the desired state of an AWS Cloud Map
+// public DNS namespace. A public DNS namespace is backed by a Route 53 public
+// hosted zone, so its domain name must be registered for the services to be
+// discoverable on the internet.
+type PublicDNSNamespaceParameters struct {
+	// Region is the region you'd like the PublicDNSNamespace to be created
+	// in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name is the domain name of the namespace, e.g. example.com.
+	// +immutable
+	// +kubebuilder:validation:MaxLength=1024
+	Name string `json:"name"`
+
+	// Description of the namespace.
+	// +immutable
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// PublicDNSNamespaceObservation is the representation of the current state
+// that is observed.
+type PublicDNSNamespaceObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the namespace.
+	ARN string `json:"arn,omitempty"`
+
+	// HostedZoneID is the ID of the Route 53 public hosted zone that backs
+	// the namespace.
+	HostedZoneID string `json:"hostedZoneId,omitempty"`
+
+	// ServiceCount is the number of services in the namespace.
+	ServiceCount int64 `json:"serviceCount,omitempty"`
+}
+
+// PublicDNSNamespaceSpec defines the desired state of an AWS Cloud Map
+// PublicDNSNamespace.
+type PublicDNSNamespaceSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  PublicDNSNamespaceParameters `json:"forProvider"`
+}
+
+// PublicDNSNamespaceStatus represents the observed state of an AWS Cloud
+// Map PublicDNSNamespace.
+type PublicDNSNamespaceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     PublicDNSNamespaceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PublicDNSNamespace is a managed resource that represents an AWS Cloud
+// Map namespace whose services are discoverable by public DNS queries.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type PublicDNSNamespace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PublicDNSNamespaceSpec   `json:"spec"`
+	Status PublicDNSNamespaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PublicDNSNamespaceList contains a list of PublicDNSNamespace
+type PublicDNSNamespaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PublicDNSNamespace `json:"items"`
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// ResolveReferences of this PrivateDNSNamespace
+func (mg *PrivateDNSNamespace) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vpc
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPC),
+		Reference:    mg.Spec.ForProvider.VPCRef,
+		Selector:     mg.Spec.ForProvider.VPCSelector,
+		To:           reference.To{Managed: &ec2v1beta1.VPC{}, List: &ec2v1beta1.VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpc")
+	}
+	mg.Spec.ForProvider.VPC = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Service. The namespace ID can be resolved from
+// a reference to any of the namespace kinds.
+func (mg *Service) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.namespaceId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.NamespaceID),
+		Reference:    mg.Spec.ForProvider.PrivateDNSNamespaceIDRef,
+		Selector:     mg.Spec.ForProvider.PrivateDNSNamespaceIDSelector,
+		To:           reference.To{Managed: &PrivateDNSNamespace{}, List: &PrivateDNSNamespaceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.namespaceId")
+	}
+	mg.Spec.ForProvider.NamespaceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PrivateDNSNamespaceIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.NamespaceID),
+		Reference:    mg.Spec.ForProvider.PublicDNSNamespaceIDRef,
+		Selector:     mg.Spec.ForProvider.PublicDNSNamespaceIDSelector,
+		To:           reference.To{Managed: &PublicDNSNamespace{}, List: &PublicDNSNamespaceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.namespaceId")
+	}
+	mg.Spec.ForProvider.NamespaceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PublicDNSNamespaceIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.NamespaceID),
+		Reference:    mg.Spec.ForProvider.HTTPNamespaceIDRef,
+		Selector:     mg.Spec.ForProvider.HTTPNamespaceIDSelector,
+		To:           reference.To{Managed: &HTTPNamespace{}, List: &HTTPNamespaceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.namespaceId")
+	}
+	mg.Spec.ForProvider.NamespaceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.HTTPNamespaceIDRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "servicediscovery.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// PrivateDNSNamespace type metadata.
+var (
+	PrivateDNSNamespaceKind             = reflect.TypeOf(PrivateDNSNamespace{}).Name()
+	PrivateDNSNamespaceGroupKind        = schema.GroupKind{Group: Group, Kind: PrivateDNSNamespaceKind}.String()
+	PrivateDNSNamespaceKindAPIVersion   = PrivateDNSNamespaceKind + "." + SchemeGroupVersion.String()
+	PrivateDNSNamespaceGroupVersionKind = SchemeGroupVersion.WithKind(PrivateDNSNamespaceKind)
+)
+
+// PublicDNSNamespace type metadata.
+var (
+	PublicDNSNamespaceKind             = reflect.TypeOf(PublicDNSNamespace{}).Name()
+	PublicDNSNamespaceGroupKind        = schema.GroupKind{Group: Group, Kind: PublicDNSNamespaceKind}.String()
+	PublicDNSNamespaceKindAPIVersion   = PublicDNSNamespaceKind + "." + SchemeGroupVersion.String()
+	PublicDNSNamespaceGroupVersionKind = SchemeGroupVersion.WithKind(PublicDNSNamespaceKind)
+)
+
+// HTTPNamespace type metadata.
+var (
+	HTTPNamespaceKind             = reflect.TypeOf(HTTPNamespace{}).Name()
+	HTTPNamespaceGroupKind        = schema.GroupKind{Group: Group, Kind: HTTPNamespaceKind}.String()
+	HTTPNamespaceKindAPIVersion   = HTTPNamespaceKind + "." + SchemeGroupVersion.String()
+	HTTPNamespaceGroupVersionKind = SchemeGroupVersion.WithKind(HTTPNamespaceKind)
+)
+
+// Service type metadata.
+var (
+	ServiceKind             = reflect.TypeOf(Service{}).Name()
+	ServiceGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceKind}.String()
+	ServiceKindAPIVersion   = ServiceKind + "." + SchemeGroupVersion.String()
+	ServiceGroupVersionKind = SchemeGroupVersion.WithKind(ServiceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PrivateDNSNamespace{}, &PrivateDNSNamespaceList{})
+	SchemeBuilder.Register(&PublicDNSNamespace{}, &PublicDNSNamespaceList{})
+	SchemeBuilder.Register(&HTTPNamespace{}, &HTTPNamespaceList{})
+	SchemeBuilder.Register(&Service{}, &ServiceList{})
+}
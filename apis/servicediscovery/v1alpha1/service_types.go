@@ -0,0 +1,216 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// DNSRecord is a DNS record that Route 53 creates for each instance of a
+// service.
+type DNSRecord struct {
+	// Type is the type of the record. A service with a CNAME record can't
+	// have an A, AAAA or SRV record.
+	// +crossplane:aws:model=servicediscovery.DnsRecord.Type
+	// +kubebuilder:validation:Enum=SRV;A;AAAA;CNAME
+	Type string `json:"type"`
+
+	// TTL is the time in seconds that DNS resolvers cache the record.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2147483647
+	TTL int64 `json:"ttl"`
+}
+
+// DNSConfig is the DNS records that Route 53 creates for the instances of a
+// service. It is only supported by services of DNS namespaces.
+type DNSConfig struct {
+	// RoutingPolicy is how Route 53 answers DNS queries for the service
+	// when it has several healthy instances. WEIGHTED can't be used with
+	// SRV records.
+	// Default: MULTIVALUE
+	// +immutable
+	// +crossplane:aws:model=servicediscovery.DnsConfig.RoutingPolicy
+	// +kubebuilder:validation:Enum=MULTIVALUE;WEIGHTED
+	// +optional
+	RoutingPolicy *string `json:"routingPolicy,omitempty"`
+
+	// DNSRecords are the records that Route 53 creates for each instance.
+	// +kubebuilder:validation:MinItems=1
+	DNSRecords []DNSRecord `json:"dnsRecords"`
+}
+
+// HealthCheckConfig is the Route 53 health check of the instances of a
+// service. It is only supported by services of public DNS namespaces.
+type HealthCheckConfig struct {
+	// Type is the protocol that Route 53 uses to check the health of the
+	// instances.
+	// +crossplane:aws:model=servicediscovery.HealthCheckConfig.Type
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP
+	Type string `json:"type"`
+
+	// ResourcePath is the path that Route 53 requests for HTTP and HTTPS
+	// health checks.
+	// Default: /
+	// +optional
+	ResourcePath *string `json:"resourcePath,omitempty"`
+
+	// FailureThreshold is the number of consecutive health checks that an
+	// instance must pass or fail to change its health status.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	FailureThreshold *int64 `json:"failureThreshold,omitempty"`
+}
+
+// HealthCheckCustomConfig is the custom health check of the instances of a
+// service, whose status is reported by a third-party health checker.
+type HealthCheckCustomConfig struct {
+	// FailureThreshold is the number of 30 second intervals that Cloud Map
+	// waits after an UpdateInstanceCustomHealthStatus request before it
+	// changes the health status of the instance.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	FailureThreshold *int64 `json:"failureThreshold,omitempty"`
+}
+
+// ServiceParameters define the desired state of an AWS Cloud Map service.
+type ServiceParameters struct {
+	// Region is the region you'd like the Service to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name is the name of the service. It is the first label of the DNS
+	// records of a service of a DNS namespace.
+	// +immutable
+	Name string `json:"name"`
+
+	// Description of the service.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// NamespaceID is the ID of the namespace that the service is created in.
+	// +immutable
+	// +optional
+	NamespaceID *string `json:"namespaceId,omitempty"`
+
+	// PrivateDNSNamespaceIDRef references a PrivateDNSNamespace to retrieve
+	// its ID.
+	// +immutable
+	// +optional
+	PrivateDNSNamespaceIDRef *runtimev1alpha1.Reference `json:"privateDnsNamespaceIdRef,omitempty"`
+
+	// PrivateDNSNamespaceIDSelector selects a reference to a
+	// PrivateDNSNamespace to retrieve its ID.
+	// +immutable
+	// +optional
+	PrivateDNSNamespaceIDSelector *runtimev1alpha1.Selector `json:"privateDnsNamespaceIdSelector,omitempty"`
+
+	// PublicDNSNamespaceIDRef references a PublicDNSNamespace to retrieve
+	// its ID.
+	// +immutable
+	// +optional
+	PublicDNSNamespaceIDRef *runtimev1alpha1.Reference `json:"publicDnsNamespaceIdRef,omitempty"`
+
+	// PublicDNSNamespaceIDSelector selects a reference to a
+	// PublicDNSNamespace to retrieve its ID.
+	// +immutable
+	// +optional
+	PublicDNSNamespaceIDSelector *runtimev1alpha1.Selector `json:"publicDnsNamespaceIdSelector,omitempty"`
+
+	// HTTPNamespaceIDRef references an HTTPNamespace to retrieve its ID.
+	// +immutable
+	// +optional
+	HTTPNamespaceIDRef *runtimev1alpha1.Reference `json:"httpNamespaceIdRef,omitempty"`
+
+	// HTTPNamespaceIDSelector selects a reference to an HTTPNamespace to
+	// retrieve its ID.
+	// +immutable
+	// +optional
+	HTTPNamespaceIDSelector *runtimev1alpha1.Selector `json:"httpNamespaceIdSelector,omitempty"`
+
+	// DNSConfig is the DNS records that Route 53 creates for the instances
+	// of the service.
+	// +optional
+	DNSConfig *DNSConfig `json:"dnsConfig,omitempty"`
+
+	// HealthCheckConfig is the Route 53 health check of the instances of
+	// the service. It can't be set together with HealthCheckCustomConfig.
+	// +optional
+	HealthCheckConfig *HealthCheckConfig `json:"healthCheckConfig,omitempty"`
+
+	// HealthCheckCustomConfig is the custom health check of the instances
+	// of the service. It can't be set together with HealthCheckConfig.
+	// +immutable
+	// +optional
+	HealthCheckCustomConfig *HealthCheckCustomConfig `json:"healthCheckCustomConfig,omitempty"`
+}
+
+// ServiceObservation is the representation of the current state that is
+// observed.
+type ServiceObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the service.
+	ARN string `json:"arn,omitempty"`
+
+	// InstanceCount is the number of instances that are registered with the
+	// service.
+	InstanceCount int64 `json:"instanceCount,omitempty"`
+}
+
+// ServiceSpec defines the desired state of an AWS Cloud Map Service.
+type ServiceSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ServiceParameters `json:"forProvider"`
+}
+
+// ServiceStatus represents the observed state of an AWS Cloud Map Service.
+type ServiceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ServiceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Service is a managed resource that represents an AWS Cloud Map service,
+// which holds the DNS records and health check settings of the instances
+// that are registered with it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Service struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceSpec   `json:"spec"`
+	Status ServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceList contains a list of Service
+type ServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Service `json:"items"`
+}
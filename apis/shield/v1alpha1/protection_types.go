@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ProtectionParameters define the desired state of an AWS Shield Advanced
+// protection.
+type ProtectionParameters struct {
+	// ResourceARN is the ARN of the protected resource: an Application Load
+	// Balancer, a Classic Load Balancer, a CloudFront distribution, a
+	// Route 53 hosted zone, a Global Accelerator accelerator or an Elastic IP
+	// allocation, such as
+	// arn:aws:ec2:us-east-1:123456789012:eip-allocation/eipalloc-0a1b2c3d.
+	// +immutable
+	// +optional
+	ResourceARN *string `json:"resourceArn,omitempty"`
+
+	// LoadBalancerRef references a LoadBalancer to retrieve its ARN as
+	// resourceArn.
+	// +optional
+	LoadBalancerRef *runtimev1alpha1.Reference `json:"loadBalancerRef,omitempty"`
+
+	// LoadBalancerSelector selects a reference to a LoadBalancer to retrieve
+	// its ARN as resourceArn.
+	// +optional
+	LoadBalancerSelector *runtimev1alpha1.Selector `json:"loadBalancerSelector,omitempty"`
+
+	// HostedZoneRef references a HostedZone to retrieve its ARN as
+	// resourceArn.
+	// +optional
+	HostedZoneRef *runtimev1alpha1.Reference `json:"hostedZoneRef,omitempty"`
+
+	// HostedZoneSelector selects a reference to a HostedZone to retrieve its
+	// ARN as resourceArn.
+	// +optional
+	HostedZoneSelector *runtimev1alpha1.Selector `json:"hostedZoneSelector,omitempty"`
+}
+
+// A ProtectionSpec defines the desired state of a Protection.
+type ProtectionSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ProtectionParameters `json:"forProvider"`
+}
+
+// A ProtectionStatus represents the observed state of a Protection.
+type ProtectionStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Protection is a managed resource that represents an AWS Shield Advanced
+// protection of a resource. Its external name is the ID of the protection,
+// and the name of the protection is the name of the Protection. The account
+// must be subscribed to Shield Advanced.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="RESOURCE",type="string",JSONPath=".spec.forProvider.resourceArn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Protection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProtectionSpec   `json:"spec"`
+	Status ProtectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProtectionList contains a list of Protections
+type ProtectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Protection `json:"items"`
+}
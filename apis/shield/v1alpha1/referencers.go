@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	elbv2v1alpha1 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+)
+
+// ResolveReferences of this Protection
+func (mg *Protection) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceArn from a LoadBalancer
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ResourceARN),
+		Reference:    mg.Spec.ForProvider.LoadBalancerRef,
+		Selector:     mg.Spec.ForProvider.LoadBalancerSelector,
+		To:           reference.To{Managed: &elbv2v1alpha1.LoadBalancer{}, List: &elbv2v1alpha1.LoadBalancerList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceArn")
+	}
+	mg.Spec.ForProvider.ResourceARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LoadBalancerRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.resourceArn from a HostedZone
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ResourceARN),
+		Reference:    mg.Spec.ForProvider.HostedZoneRef,
+		Selector:     mg.Spec.ForProvider.HostedZoneSelector,
+		To:           reference.To{Managed: &route53v1alpha1.HostedZone{}, List: &route53v1alpha1.HostedZoneList{}},
+		Extract:      route53v1alpha1.HostedZoneARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceArn")
+	}
+	mg.Spec.ForProvider.ResourceARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.HostedZoneRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+)
+
+const fifoQueueSuffix = ".fifo"
+
+// +kubebuilder:webhook:path=/validate-sqs-aws-crossplane-io-v1beta1-queue,mutating=false,failurePolicy=fail,groups=sqs.aws.crossplane.io,resources=queues,verbs=create;update,versions=v1beta1,name=queues.sqs.aws.crossplane.io
+
+var _ webhook.Validator = &Queue{}
+
+// ValidateCreate validates a Queue on creation.
+func (mg *Queue) ValidateCreate() error {
+	return mg.validate()
+}
+
+// ValidateUpdate validates a Queue on update.
+func (mg *Queue) ValidateUpdate(_ runtime.Object) error {
+	return mg.validate()
+}
+
+// ValidateDelete validates a Queue on deletion.
+func (mg *Queue) ValidateDelete() error {
+	return nil
+}
+
+// validate checks the constraints between the fields of a Queue that AWS
+// would otherwise only report once the queue is created.
+func (mg *Queue) validate() error {
+	p := mg.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	var errs field.ErrorList
+
+	// The queue is named after its external name, which defaults to the
+	// name of the resource.
+	name := meta.GetExternalName(mg)
+	if name == "" {
+		name = mg.GetName()
+	}
+	fifo := p.FIFOQueue != nil && *p.FIFOQueue
+	if fifo != strings.HasSuffix(name, fifoQueueSuffix) {
+		errs = append(errs, field.Invalid(path.Child("fifoQueue"), fifo, "the name of a queue must end with "+fifoQueueSuffix+" if and only if it is a FIFO queue"))
+	}
+	if !fifo && p.ContentBasedDeduplication != nil && *p.ContentBasedDeduplication {
+		errs = append(errs, field.Forbidden(path.Child("contentBasedDeduplication"), "can only be enabled for FIFO queues"))
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(QueueGroupVersionKind.GroupKind(), mg.GetName(), errs)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type queueModifier func(*Queue)
+
+func withExternalName(n string) queueModifier {
+	return func(q *Queue) { meta.SetExternalName(q, n) }
+}
+
+func withFIFOQueue(b bool) queueModifier {
+	return func(q *Queue) { q.Spec.ForProvider.FIFOQueue = &b }
+}
+
+func withContentBasedDeduplication(b bool) queueModifier {
+	return func(q *Queue) { q.Spec.ForProvider.ContentBasedDeduplication = &b }
+}
+
+func queue(name string, m ...queueModifier) *Queue {
+	cr := &Queue{}
+	cr.SetName(name)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestQueueValidate(t *testing.T) {
+	path := field.NewPath("spec", "forProvider")
+	invalid := func(name string, errs ...*field.Error) error {
+		return apierrors.NewInvalid(QueueGroupVersionKind.GroupKind(), name, errs)
+	}
+	suffix := "the name of a queue must end with .fifo if and only if it is a FIFO queue"
+
+	cases := map[string]struct {
+		cr   *Queue
+		want error
+	}{
+		"StandardQueue": {
+			cr: queue("orders"),
+		},
+		"FIFOQueue": {
+			cr: queue("orders.fifo", withFIFOQueue(true), withContentBasedDeduplication(true)),
+		},
+		"FIFOQueueExternalName": {
+			cr: queue("orders", withExternalName("orders.fifo"), withFIFOQueue(true)),
+		},
+		"FIFOQueueWithoutSuffix": {
+			cr:   queue("orders", withFIFOQueue(true)),
+			want: invalid("orders", field.Invalid(path.Child("fifoQueue"), true, suffix)),
+		},
+		"StandardQueueWithSuffix": {
+			cr:   queue("orders.fifo"),
+			want: invalid("orders.fifo", field.Invalid(path.Child("fifoQueue"), false, suffix)),
+		},
+		"StandardQueueExternalNameWithSuffix": {
+			cr:   queue("orders", withExternalName("orders.fifo"), withFIFOQueue(false)),
+			want: invalid("orders", field.Invalid(path.Child("fifoQueue"), false, suffix)),
+		},
+		"ContentBasedDeduplicationOfStandardQueue": {
+			cr:   queue("orders", withContentBasedDeduplication(true)),
+			want: invalid("orders", field.Forbidden(path.Child("contentBasedDeduplication"), "can only be enabled for FIFO queues")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.cr.ValidateCreate(), test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateCreate(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.cr.ValidateUpdate(queue("orders")), test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateUpdate(...): -want, +got:\n%s", diff)
+			}
+			if err := tc.cr.ValidateDelete(); err != nil {
+				t.Errorf("ValidateDelete(...): %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// CanaryCode is the script the canary runs. It is either read from an
+// Amazon S3 object or given inline as a zip file.
+type CanaryCode struct {
+	// Handler is the entry point of the script, such as
+	// pageLoadBlueprint.handler.
+	Handler string `json:"handler"`
+
+	// S3Bucket is the name of the Amazon S3 bucket that holds the zipped
+	// script.
+	// +optional
+	S3Bucket *string `json:"s3Bucket,omitempty"`
+
+	// S3BucketRef references a Bucket to retrieve its name.
+	// +optional
+	S3BucketRef *runtimev1alpha1.Reference `json:"s3BucketRef,omitempty"`
+
+	// S3BucketSelector selects a reference to a Bucket to retrieve its name.
+	// +optional
+	S3BucketSelector *runtimev1alpha1.Selector `json:"s3BucketSelector,omitempty"`
+
+	// S3Key is the key of the zipped script in S3Bucket.
+	// +optional
+	S3Key *string `json:"s3Key,omitempty"`
+
+	// S3Version is the version of the S3Key object to use.
+	// +optional
+	S3Version *string `json:"s3Version,omitempty"`
+
+	// ZipFile is the base64 encoded zip file that contains the script. It
+	// is used when S3Bucket is not set.
+	// +optional
+	ZipFile []byte `json:"zipFile,omitempty"`
+}
+
+// CanarySchedule specifies how often the canary runs.
+type CanarySchedule struct {
+	// Expression is a rate or cron expression, such as rate(5 minutes).
+	// rate(0 minute) runs the canary only once when it is started.
+	Expression string `json:"expression"`
+
+	// DurationInSeconds is how long the canary keeps running on its
+	// schedule after it is started. 0 means it runs until it is stopped.
+	// +optional
+	DurationInSeconds *int64 `json:"durationInSeconds,omitempty"`
+}
+
+// CanaryRunConfig configures a single run of the canary.
+type CanaryRunConfig struct {
+	// TimeoutInSeconds is how long a single run is allowed to take before
+	// it is stopped.
+	// +kubebuilder:validation:Minimum=60
+	TimeoutInSeconds int64 `json:"timeoutInSeconds"`
+
+	// MemoryInMB is the amount of memory available to the canary while it
+	// runs.
+	// +kubebuilder:validation:Minimum=960
+	// +optional
+	MemoryInMB *int64 `json:"memoryInMB,omitempty"`
+}
+
+// CanaryVPCConfig specifies the VPC the canary runs in to test endpoints
+// that aren't publicly reachable.
+type CanaryVPCConfig struct {
+	// SubnetIDs are the IDs of the subnets the canary runs in.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the canary.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their IDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their IDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+}
+
+// CanaryParameters define the desired state of an AWS CloudWatch Synthetics
+// canary.
+type CanaryParameters struct {
+	// Region is the region you'd like your Canary to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// ArtifactS3Location is the Amazon S3 location the results of the
+	// canary runs are stored in, such as s3://bucket/canaries. It takes
+	// precedence over ArtifactBucket and ArtifactPrefix.
+	// +immutable
+	// +optional
+	ArtifactS3Location *string `json:"artifactS3Location,omitempty"`
+
+	// ArtifactBucket is the name of the Amazon S3 bucket the results of the
+	// canary runs are stored in. It is used together with ArtifactPrefix
+	// when ArtifactS3Location is not set.
+	// +immutable
+	// +optional
+	ArtifactBucket *string `json:"artifactBucket,omitempty"`
+
+	// ArtifactBucketRef references a Bucket to retrieve its name.
+	// +immutable
+	// +optional
+	ArtifactBucketRef *runtimev1alpha1.Reference `json:"artifactBucketRef,omitempty"`
+
+	// ArtifactBucketSelector selects a reference to a Bucket to retrieve its
+	// name.
+	// +immutable
+	// +optional
+	ArtifactBucketSelector *runtimev1alpha1.Selector `json:"artifactBucketSelector,omitempty"`
+
+	// ArtifactPrefix is the prefix of the results in ArtifactBucket.
+	// +immutable
+	// +optional
+	ArtifactPrefix *string `json:"artifactPrefix,omitempty"`
+
+	// Code is the script the canary runs. Only its handler is compared with
+	// the deployed canary since AWS doesn't report where the script was read
+	// from, so changing the script alone doesn't trigger an update.
+	Code CanaryCode `json:"code"`
+
+	// ExecutionRoleARN is the ARN of the IAM role the canary runs with.
+	// +optional
+	ExecutionRoleARN *string `json:"executionRoleArn,omitempty"`
+
+	// ExecutionRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	ExecutionRoleARNRef *runtimev1alpha1.Reference `json:"executionRoleArnRef,omitempty"`
+
+	// ExecutionRoleARNSelector selects a reference to an IAMRole to retrieve
+	// its ARN.
+	// +optional
+	ExecutionRoleARNSelector *runtimev1alpha1.Selector `json:"executionRoleArnSelector,omitempty"`
+
+	// RuntimeVersion is the version of the runtime the script runs on, such
+	// as syn-nodejs-2.0.
+	RuntimeVersion string `json:"runtimeVersion"`
+
+	// Schedule specifies how often the canary runs.
+	Schedule CanarySchedule `json:"schedule"`
+
+	// RunConfig configures a single run of the canary.
+	// +optional
+	RunConfig *CanaryRunConfig `json:"runConfig,omitempty"`
+
+	// FailureRetentionPeriodInDays is the number of days to retain data
+	// about failed runs.
+	// +optional
+	FailureRetentionPeriodInDays *int64 `json:"failureRetentionPeriodInDays,omitempty"`
+
+	// SuccessRetentionPeriodInDays is the number of days to retain data
+	// about successful runs.
+	// +optional
+	SuccessRetentionPeriodInDays *int64 `json:"successRetentionPeriodInDays,omitempty"`
+
+	// VPCConfig specifies the VPC the canary runs in.
+	// +optional
+	VPCConfig *CanaryVPCConfig `json:"vpcConfig,omitempty"`
+
+	// StartCanary indicates whether the canary is started so that it runs
+	// on its schedule. Defaults to true.
+	// +optional
+	StartCanary *bool `json:"startCanary,omitempty"`
+
+	// Tags to add to the canary.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// CanaryObservation keeps the state for the external resource
+type CanaryObservation struct {
+	// ID is the unique ID of the canary.
+	ID string `json:"id,omitempty"`
+
+	// State of the canary, such as READY, RUNNING or STOPPED.
+	State string `json:"state,omitempty"`
+
+	// StateReason explains why the canary is in its current state.
+	StateReason string `json:"stateReason,omitempty"`
+
+	// EngineARN is the ARN of the Lambda function that runs the canary.
+	EngineARN string `json:"engineArn,omitempty"`
+
+	// SourceLocationARN is the ARN of the Lambda layer that holds the
+	// script of the canary.
+	SourceLocationARN string `json:"sourceLocationArn,omitempty"`
+
+	// VPCID is the ID of the VPC the canary runs in.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// LastStarted is the last time the canary was started.
+	LastStarted *metav1.Time `json:"lastStarted,omitempty"`
+}
+
+// A CanarySpec defines the desired state of a Canary.
+type CanarySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CanaryParameters `json:"forProvider"`
+}
+
+// A CanaryStatus represents the observed state of a Canary.
+type CanaryStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CanaryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Canary is a managed resource that represents an AWS CloudWatch
+// Synthetics canary. Its external name is the name of the canary, which can
+// be at most 21 lowercase characters long.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Canary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CanarySpec   `json:"spec"`
+	Status CanaryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CanaryList contains a list of Canaries
+type CanaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Canary `json:"items"`
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// ResolveReferences of this Canary
+func (mg *Canary) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.artifactBucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ArtifactBucket),
+		Reference:    mg.Spec.ForProvider.ArtifactBucketRef,
+		Selector:     mg.Spec.ForProvider.ArtifactBucketSelector,
+		To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.artifactBucket")
+	}
+	mg.Spec.ForProvider.ArtifactBucket = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ArtifactBucketRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.code.s3Bucket
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Code.S3Bucket),
+		Reference:    mg.Spec.ForProvider.Code.S3BucketRef,
+		Selector:     mg.Spec.ForProvider.Code.S3BucketSelector,
+		To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.code.s3Bucket")
+	}
+	mg.Spec.ForProvider.Code.S3Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.Code.S3BucketRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.executionRoleArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ExecutionRoleARN),
+		Reference:    mg.Spec.ForProvider.ExecutionRoleARNRef,
+		Selector:     mg.Spec.ForProvider.ExecutionRoleARNSelector,
+		To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+		Extract:      iamv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.executionRoleArn")
+	}
+	mg.Spec.ForProvider.ExecutionRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ExecutionRoleARNRef = rsp.ResolvedReference
+
+	if mg.Spec.ForProvider.VPCConfig == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.vpcConfig.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.VPCConfig.SubnetIDs,
+		References:    mg.Spec.ForProvider.VPCConfig.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.VPCConfig.SubnetIDSelector,
+		To:            reference.To{Managed: &ec2v1beta1.Subnet{}, List: &ec2v1beta1.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcConfig.subnetIds")
+	}
+	mg.Spec.ForProvider.VPCConfig.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.VPCConfig.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.vpcConfig.securityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.VPCConfig.SecurityGroupIDs,
+		References:    mg.Spec.ForProvider.VPCConfig.SecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.VPCConfig.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &ec2v1beta1.SecurityGroup{}, List: &ec2v1beta1.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcConfig.securityGroupIds")
+	}
+	mg.Spec.ForProvider.VPCConfig.SecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.VPCConfig.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -0,0 +1,357 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Canary) DeepCopyInto(out *Canary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Canary.
+func (in *Canary) DeepCopy() *Canary {
+	if in == nil {
+		return nil
+	}
+	out := new(Canary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Canary) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryCode) DeepCopyInto(out *CanaryCode) {
+	*out = *in
+	if in.S3Bucket != nil {
+		in, out := &in.S3Bucket, &out.S3Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3BucketRef != nil {
+		in, out := &in.S3BucketRef, &out.S3BucketRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.S3BucketSelector != nil {
+		in, out := &in.S3BucketSelector, &out.S3BucketSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3Key != nil {
+		in, out := &in.S3Key, &out.S3Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3Version != nil {
+		in, out := &in.S3Version, &out.S3Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZipFile != nil {
+		in, out := &in.ZipFile, &out.ZipFile
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryCode.
+func (in *CanaryCode) DeepCopy() *CanaryCode {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryList) DeepCopyInto(out *CanaryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Canary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryList.
+func (in *CanaryList) DeepCopy() *CanaryList {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CanaryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryObservation) DeepCopyInto(out *CanaryObservation) {
+	*out = *in
+	if in.LastStarted != nil {
+		in, out := &in.LastStarted, &out.LastStarted
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryObservation.
+func (in *CanaryObservation) DeepCopy() *CanaryObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryParameters) DeepCopyInto(out *CanaryParameters) {
+	*out = *in
+	if in.ArtifactS3Location != nil {
+		in, out := &in.ArtifactS3Location, &out.ArtifactS3Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.ArtifactBucket != nil {
+		in, out := &in.ArtifactBucket, &out.ArtifactBucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.ArtifactBucketRef != nil {
+		in, out := &in.ArtifactBucketRef, &out.ArtifactBucketRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ArtifactBucketSelector != nil {
+		in, out := &in.ArtifactBucketSelector, &out.ArtifactBucketSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtifactPrefix != nil {
+		in, out := &in.ArtifactPrefix, &out.ArtifactPrefix
+		*out = new(string)
+		**out = **in
+	}
+	in.Code.DeepCopyInto(&out.Code)
+	if in.ExecutionRoleARN != nil {
+		in, out := &in.ExecutionRoleARN, &out.ExecutionRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExecutionRoleARNRef != nil {
+		in, out := &in.ExecutionRoleARNRef, &out.ExecutionRoleARNRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ExecutionRoleARNSelector != nil {
+		in, out := &in.ExecutionRoleARNSelector, &out.ExecutionRoleARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Schedule.DeepCopyInto(&out.Schedule)
+	if in.RunConfig != nil {
+		in, out := &in.RunConfig, &out.RunConfig
+		*out = new(CanaryRunConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailureRetentionPeriodInDays != nil {
+		in, out := &in.FailureRetentionPeriodInDays, &out.FailureRetentionPeriodInDays
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SuccessRetentionPeriodInDays != nil {
+		in, out := &in.SuccessRetentionPeriodInDays, &out.SuccessRetentionPeriodInDays
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VPCConfig != nil {
+		in, out := &in.VPCConfig, &out.VPCConfig
+		*out = new(CanaryVPCConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartCanary != nil {
+		in, out := &in.StartCanary, &out.StartCanary
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryParameters.
+func (in *CanaryParameters) DeepCopy() *CanaryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryRunConfig) DeepCopyInto(out *CanaryRunConfig) {
+	*out = *in
+	if in.MemoryInMB != nil {
+		in, out := &in.MemoryInMB, &out.MemoryInMB
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryRunConfig.
+func (in *CanaryRunConfig) DeepCopy() *CanaryRunConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryRunConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanarySchedule) DeepCopyInto(out *CanarySchedule) {
+	*out = *in
+	if in.DurationInSeconds != nil {
+		in, out := &in.DurationInSeconds, &out.DurationInSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanarySchedule.
+func (in *CanarySchedule) DeepCopy() *CanarySchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(CanarySchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanarySpec) DeepCopyInto(out *CanarySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanarySpec.
+func (in *CanarySpec) DeepCopy() *CanarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CanarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStatus.
+func (in *CanaryStatus) DeepCopy() *CanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryVPCConfig) DeepCopyInto(out *CanaryVPCConfig) {
+	*out = *in
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDRefs != nil {
+		in, out := &in.SubnetIDRefs, &out.SubnetIDRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDRefs != nil {
+		in, out := &in.SecurityGroupIDRefs, &out.SecurityGroupIDRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDSelector != nil {
+		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryVPCConfig.
+func (in *CanaryVPCConfig) DeepCopy() *CanaryVPCConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryVPCConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A MaintenanceWindowSpec defines a weekly window during which updates of the
+// managed resources that reference it are applied.
+type MaintenanceWindowSpec struct {
+	// DayOfWeek the window starts on.
+	// +kubebuilder:validation:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+	DayOfWeek string `json:"dayOfWeek"`
+
+	// StartTime of the window in UTC, in the format hh:mm.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+
+	// DurationMinutes is the length of the window. ElastiCache requires
+	// windows of at least 60 minutes, and all services limit them to one
+	// day.
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=1440
+	DurationMinutes int64 `json:"durationMinutes"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MaintenanceWindow is a weekly change window of managed resources. It is
+// translated to the preferred maintenance window of the services that have
+// one, like RDS and ElastiCache, and disruptive updates that the provider
+// would make to a referencing resource outside of the window, like changing
+// its instance class or engine version, are held back until the window opens,
+// unless the resource has the aws.crossplane.io/force-update annotation set
+// to "true". Other updates are made right away.
+// +kubebuilder:printcolumn:name="DAY",type="string",JSONPath=".spec.dayOfWeek"
+// +kubebuilder:printcolumn:name="START",type="string",JSONPath=".spec.startTime"
+// +kubebuilder:printcolumn:name="DURATION",type="integer",JSONPath=".spec.durationMinutes"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,aws}
+type MaintenanceWindow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MaintenanceWindowSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// MaintenanceWindowList contains a list of MaintenanceWindow
+type MaintenanceWindowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MaintenanceWindow `json:"items"`
+}
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 )
@@ -25,11 +26,132 @@ import (
 // A ProviderConfigSpec defines the desired state of a ProviderConfig.
 type ProviderConfigSpec struct {
 	runtimev1alpha1.ProviderConfigSpec `json:",inline"`
+
+	// Defaults are default values of the managed resources that use this
+	// ProviderConfig. They are merged into the spec.forProvider of a managed
+	// resource of the given kind whenever it is reconciled, filling only
+	// the fields that are not set.
+	// +optional
+	Defaults []ResourceDefaults `json:"defaults,omitempty"`
+
+	// AuditPermissions enables the permissions audit of this ProviderConfig.
+	// The audit simulates the AWS API calls the controllers of the provider
+	// make with the policies of the principal of the credentials and reports
+	// the calls that would be denied in the status. It is run whenever the
+	// spec of the ProviderConfig changes.
+	// +optional
+	AuditPermissions bool `json:"auditPermissions,omitempty"`
+
+	// BillingAlarm provisions a cost alert for the resources managed with
+	// this ProviderConfig. It is removed when the field is unset or the
+	// ProviderConfig is deleted.
+	// +optional
+	BillingAlarm *BillingAlarm `json:"billingAlarm,omitempty"`
+}
+
+// A BillingAlarm is a monthly AWS Budget on the cost of the resources tagged
+// with the name of a ProviderConfig, i.e. the crossplane-providerconfig tag
+// that is added to the managed resources of the kinds that support external
+// tags, e.g. VPC or RDSInstance. The crossplane-providerconfig tag must be
+// activated as a cost allocation tag in the billing console of the
+// account for their cost to be attributed. The budget notifies an SNS topic
+// when the actual cost exceeds the limit.
+type BillingAlarm struct {
+	// Limit is the monthly cost in USD, e.g. 100.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	Limit string `json:"limit"`
+
+	// ThresholdPercentage of the limit above which the alarm is raised.
+	// Defaults to 100.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ThresholdPercentage *int64 `json:"thresholdPercentage,omitempty"`
+
+	// Emails that are subscribed to the SNS topic of the alarm. Every
+	// address has to confirm its subscription.
+	// +optional
+	Emails []string `json:"emails,omitempty"`
+}
+
+// ResourceDefaults are the default spec.forProvider values of a kind of
+// managed resource.
+type ResourceDefaults struct {
+	// Kind of the managed resources the defaults apply to, e.g. RDSInstance.
+	Kind string `json:"kind"`
+
+	// APIGroup of the managed resources the defaults apply to, e.g.
+	// database.aws.crossplane.io. Kinds of all groups match if it is not
+	// set.
+	// +optional
+	APIGroup *string `json:"apiGroup,omitempty"`
+
+	// ForProvider contains the default values in the same structure as the
+	// spec.forProvider field of the kind.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	ForProvider runtime.RawExtension `json:"forProvider"`
+}
+
+// MissingPermissions are the IAM actions a kind of managed resource requires
+// that are denied to the principal of a ProviderConfig.
+type MissingPermissions struct {
+	// Kind of the managed resources that require the actions, e.g.
+	// RDSInstance.database.aws.crossplane.io.
+	Kind string `json:"kind"`
+
+	// Actions that are denied, e.g. rds:CreateDBInstance.
+	Actions []string `json:"actions"`
+}
+
+// PermissionsAuditStatus is the result of the last permissions audit of a
+// ProviderConfig.
+type PermissionsAuditStatus struct {
+	// ObservedGeneration is the generation of the ProviderConfig that was
+	// audited.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastAuditTime is the time of the last audit.
+	LastAuditTime *metav1.Time `json:"lastAuditTime,omitempty"`
+
+	// Principal is the ARN of the IAM user or role whose policies were
+	// simulated.
+	Principal string `json:"principal,omitempty"`
+
+	// MissingPermissions are the actions that are denied to the principal,
+	// by kind of managed resource.
+	MissingPermissions []MissingPermissions `json:"missingPermissions,omitempty"`
+
+	// Error is the reason the audit could not be run.
+	Error string `json:"error,omitempty"`
+}
+
+// BillingAlarmStatus is the observed state of the billing alarm of a
+// ProviderConfig.
+type BillingAlarmStatus struct {
+	// ObservedGeneration is the generation of the ProviderConfig the alarm
+	// was last configured for.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// BudgetName is the name of the AWS Budget of the alarm.
+	BudgetName string `json:"budgetName,omitempty"`
+
+	// TopicARN is the ARN of the SNS topic the alarm notifies.
+	TopicARN string `json:"topicArn,omitempty"`
+
+	// Error is the reason the alarm could not be configured.
+	Error string `json:"error,omitempty"`
 }
 
 // A ProviderConfigStatus represents the status of a ProviderConfig.
 type ProviderConfigStatus struct {
 	runtimev1alpha1.ProviderConfigStatus `json:",inline"`
+
+	// PermissionsAudit is the result of the last permissions audit.
+	// +optional
+	PermissionsAudit *PermissionsAuditStatus `json:"permissionsAudit,omitempty"`
+
+	// BillingAlarm is the observed state of the billing alarm.
+	// +optional
+	BillingAlarm *BillingAlarmStatus `json:"billingAlarm,omitempty"`
 }
 
 // +kubebuilder:object:root=true
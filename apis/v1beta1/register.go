@@ -58,7 +58,16 @@ var (
 	ProviderConfigUsageListGroupVersionKind = SchemeGroupVersion.WithKind(ProviderConfigUsageListKind)
 )
 
+// MaintenanceWindow type metadata.
+var (
+	MaintenanceWindowKind             = reflect.TypeOf(MaintenanceWindow{}).Name()
+	MaintenanceWindowGroupKind        = schema.GroupKind{Group: Group, Kind: MaintenanceWindowKind}.String()
+	MaintenanceWindowKindAPIVersion   = MaintenanceWindowKind + "." + SchemeGroupVersion.String()
+	MaintenanceWindowGroupVersionKind = SchemeGroupVersion.WithKind(MaintenanceWindowKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&ProviderConfig{}, &ProviderConfigList{})
 	SchemeBuilder.Register(&ProviderConfigUsage{}, &ProviderConfigUsageList{})
+	SchemeBuilder.Register(&MaintenanceWindow{}, &MaintenanceWindowList{})
 }
@@ -0,0 +1,59 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// WebACLARN returns the status.atProvider.arn of a WebACL.
+func WebACLARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*WebACL)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ARN
+	}
+}
+
+// ResolveReferences of this WebACLAssociation
+func (mg *WebACLAssociation) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.webAclArn
+	acl, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.WebACLARN,
+		Reference:    mg.Spec.ForProvider.WebACLARNRef,
+		Selector:     mg.Spec.ForProvider.WebACLARNSelector,
+		To:           reference.To{Managed: &WebACL{}, List: &WebACLList{}},
+		Extract:      WebACLARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.webAclArn")
+	}
+	mg.Spec.ForProvider.WebACLARN = acl.ResolvedValue
+	mg.Spec.ForProvider.WebACLARNRef = acl.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Scopes of a WebACL.
+const (
+	ScopeRegional   = "REGIONAL"
+	ScopeCloudFront = "CLOUDFRONT"
+)
+
+// Actions that can be taken on a web request.
+const (
+	ActionAllow = "Allow"
+	ActionBlock = "Block"
+	ActionCount = "Count"
+	ActionNone  = "None"
+)
+
+// Tag is a key-value pair attached to a WAFv2 resource.
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// VisibilityConfig defines and enables Amazon CloudWatch metrics and web
+// request sample collection.
+type VisibilityConfig struct {
+	// CloudWatchMetricsEnabled indicates whether the associated resource sends
+	// metrics to CloudWatch.
+	CloudWatchMetricsEnabled bool `json:"cloudWatchMetricsEnabled"`
+
+	// MetricName is the name of the CloudWatch metric. The name can contain only
+	// alphanumeric characters (A-Z, a-z, 0-9) and can't contain whitespace.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	MetricName string `json:"metricName"`
+
+	// SampledRequestsEnabled indicates whether AWS WAF should store a sampling
+	// of the web requests that match the rules.
+	SampledRequestsEnabled bool `json:"sampledRequestsEnabled"`
+}
+
+// ManagedRuleGroupStatement runs the rules that are defined in a managed rule
+// group, such as the AWS Managed Rules.
+type ManagedRuleGroupStatement struct {
+	// VendorName is the name of the managed rule group vendor, e.g. AWS.
+	VendorName string `json:"vendorName"`
+
+	// Name is the name of the managed rule group, e.g. AWSManagedRulesCommonRuleSet.
+	Name string `json:"name"`
+
+	// ExcludedRules are the names of the rules in the group whose actions are
+	// set to COUNT, effectively excluding them from acting on web requests.
+	// +optional
+	ExcludedRules []string `json:"excludedRules,omitempty"`
+}
+
+// RateBasedStatement tracks the rate of requests for each originating IP
+// address and triggers the rule action when the rate exceeds the limit in any
+// 5-minute time span.
+type RateBasedStatement struct {
+	// Limit is the number of requests per 5-minute period allowed for a single
+	// originating IP address.
+	// +kubebuilder:validation:Minimum=100
+	Limit int64 `json:"limit"`
+
+	// AggregateKeyType indicates how to aggregate the request counts.
+	// +optional
+	// +crossplane:aws:model=wafv2.RateBasedStatement.AggregateKeyType
+	// +kubebuilder:validation:Enum=IP
+	AggregateKeyType *string `json:"aggregateKeyType,omitempty"`
+}
+
+// Statement is the processing statement of a rule. Exactly one of its fields
+// should be set.
+type Statement struct {
+	// ManagedRuleGroupStatement references a managed rule group.
+	// +optional
+	ManagedRuleGroupStatement *ManagedRuleGroupStatement `json:"managedRuleGroupStatement,omitempty"`
+
+	// RateBasedStatement blocks requests from IP addresses that exceed the
+	// configured rate.
+	// +optional
+	RateBasedStatement *RateBasedStatement `json:"rateBasedStatement,omitempty"`
+}
+
+// Rule of a WebACL.
+type Rule struct {
+	// Name of the rule. It can't be changed after creation.
+	Name string `json:"name"`
+
+	// Priority determines the order in which the rules are evaluated. Rules
+	// with lower priority are processed first. Priorities must be unique
+	// within a WebACL.
+	// +kubebuilder:validation:Minimum=0
+	Priority int64 `json:"priority"`
+
+	// Action to take on a web request that matches the rule statement. Used
+	// only for statements that don't reference a rule group.
+	// +optional
+	// +kubebuilder:validation:Enum=Allow;Block;Count
+	Action *string `json:"action,omitempty"`
+
+	// OverrideAction to apply to the rules in a rule group. Used only for
+	// statements that reference a rule group, like ManagedRuleGroupStatement.
+	// +optional
+	// +kubebuilder:validation:Enum=None;Count
+	OverrideAction *string `json:"overrideAction,omitempty"`
+
+	// Statement used to identify matching web requests.
+	Statement Statement `json:"statement"`
+
+	// VisibilityConfig of the rule.
+	VisibilityConfig VisibilityConfig `json:"visibilityConfig"`
+}
+
+// WebACLParameters define the desired state of an AWS WAFv2 WebACL.
+type WebACLParameters struct {
+	// Region is the region you'd like your WebACL to be created in. WebACLs
+	// with CLOUDFRONT scope must be created in us-east-1.
+	// +immutable
+	Region string `json:"region"`
+
+	// Scope specifies whether this is for an AWS CloudFront distribution or for
+	// a regional application such as an Application Load Balancer.
+	// +immutable
+	// +crossplane:aws:model=wafv2.CreateWebACLInput.Scope
+	// +kubebuilder:validation:Enum=CLOUDFRONT;REGIONAL
+	Scope string `json:"scope"`
+
+	// DefaultAction is the action to perform if none of the rules contained in
+	// the WebACL match.
+	// +kubebuilder:validation:Enum=Allow;Block
+	DefaultAction string `json:"defaultAction"`
+
+	// Description of the WebACL.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Rules used to identify the web requests that you want to allow, block or
+	// count.
+	// +optional
+	Rules []Rule `json:"rules,omitempty"`
+
+	// VisibilityConfig of the WebACL.
+	VisibilityConfig VisibilityConfig `json:"visibilityConfig"`
+
+	// Tags to add to the WebACL.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A WebACLSpec defines the desired state of a WebACL.
+type WebACLSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  WebACLParameters `json:"forProvider"`
+}
+
+// WebACLObservation keeps the state for the external resource.
+type WebACLObservation struct {
+	// ARN of the WebACL.
+	ARN string `json:"arn,omitempty"`
+
+	// ID is the unique identifier of the WebACL.
+	ID string `json:"id,omitempty"`
+
+	// Capacity is the number of web ACL capacity units (WCUs) currently being
+	// used by this WebACL.
+	Capacity int64 `json:"capacity,omitempty"`
+
+	// ManagedByFirewallManager indicates whether this WebACL is managed by AWS
+	// Firewall Manager.
+	ManagedByFirewallManager bool `json:"managedByFirewallManager,omitempty"`
+}
+
+// A WebACLStatus represents the observed state of a WebACL.
+type WebACLStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     WebACLObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WebACL is a managed resource that represents an AWS WAFv2 web access
+// control list.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SCOPE",type="string",JSONPath=".spec.forProvider.scope"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type WebACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WebACLSpec   `json:"spec"`
+	Status WebACLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WebACLList contains a list of WebACLs
+type WebACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WebACL `json:"items"`
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// WebACLAssociationParameters define the desired state of an association
+// between a regional WebACL and an AWS resource.
+type WebACLAssociationParameters struct {
+	// Region is the region of the WebACL and the associated resource.
+	// +immutable
+	Region string `json:"region"`
+
+	// ResourceARN is the ARN of the resource to associate with the WebACL,
+	// e.g. an Application Load Balancer or an API Gateway stage. CloudFront
+	// distributions are associated through the distribution configuration
+	// instead.
+	// +immutable
+	ResourceARN string `json:"resourceArn"`
+
+	// WebACLARN is the ARN of the WebACL to associate with the resource.
+	// +immutable
+	// +optional
+	WebACLARN string `json:"webAclArn,omitempty"`
+
+	// WebACLARNRef references a WebACL to retrieve its ARN.
+	// +optional
+	WebACLARNRef *runtimev1alpha1.Reference `json:"webAclArnRef,omitempty"`
+
+	// WebACLARNSelector selects a reference to a WebACL to retrieve its ARN.
+	// +optional
+	WebACLARNSelector *runtimev1alpha1.Selector `json:"webAclArnSelector,omitempty"`
+}
+
+// A WebACLAssociationSpec defines the desired state of a WebACLAssociation.
+type WebACLAssociationSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  WebACLAssociationParameters `json:"forProvider"`
+}
+
+// A WebACLAssociationStatus represents the observed state of a
+// WebACLAssociation.
+type WebACLAssociationStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WebACLAssociation is a managed resource that represents the association
+// of an AWS WAFv2 WebACL with a regional resource.
+// +kubebuilder:printcolumn:name="WEBACL",type="string",JSONPath=".spec.forProvider.webAclArn"
+// +kubebuilder:printcolumn:name="RESOURCE",type="string",JSONPath=".spec.forProvider.resourceArn"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type WebACLAssociation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WebACLAssociationSpec   `json:"spec"`
+	Status WebACLAssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WebACLAssociationList contains a list of WebACLAssociations
+type WebACLAssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WebACLAssociation `json:"items"`
+}
@@ -0,0 +1,406 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRuleGroupStatement) DeepCopyInto(out *ManagedRuleGroupStatement) {
+	*out = *in
+	if in.ExcludedRules != nil {
+		in, out := &in.ExcludedRules, &out.ExcludedRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRuleGroupStatement.
+func (in *ManagedRuleGroupStatement) DeepCopy() *ManagedRuleGroupStatement {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRuleGroupStatement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateBasedStatement) DeepCopyInto(out *RateBasedStatement) {
+	*out = *in
+	if in.AggregateKeyType != nil {
+		in, out := &in.AggregateKeyType, &out.AggregateKeyType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateBasedStatement.
+func (in *RateBasedStatement) DeepCopy() *RateBasedStatement {
+	if in == nil {
+		return nil
+	}
+	out := new(RateBasedStatement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rule) DeepCopyInto(out *Rule) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(string)
+		**out = **in
+	}
+	if in.OverrideAction != nil {
+		in, out := &in.OverrideAction, &out.OverrideAction
+		*out = new(string)
+		**out = **in
+	}
+	in.Statement.DeepCopyInto(&out.Statement)
+	out.VisibilityConfig = in.VisibilityConfig
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rule.
+func (in *Rule) DeepCopy() *Rule {
+	if in == nil {
+		return nil
+	}
+	out := new(Rule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Statement) DeepCopyInto(out *Statement) {
+	*out = *in
+	if in.ManagedRuleGroupStatement != nil {
+		in, out := &in.ManagedRuleGroupStatement, &out.ManagedRuleGroupStatement
+		*out = new(ManagedRuleGroupStatement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateBasedStatement != nil {
+		in, out := &in.RateBasedStatement, &out.RateBasedStatement
+		*out = new(RateBasedStatement)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Statement.
+func (in *Statement) DeepCopy() *Statement {
+	if in == nil {
+		return nil
+	}
+	out := new(Statement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VisibilityConfig) DeepCopyInto(out *VisibilityConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VisibilityConfig.
+func (in *VisibilityConfig) DeepCopy() *VisibilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VisibilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACL) DeepCopyInto(out *WebACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACL.
+func (in *WebACL) DeepCopy() *WebACL {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLAssociation) DeepCopyInto(out *WebACLAssociation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLAssociation.
+func (in *WebACLAssociation) DeepCopy() *WebACLAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebACLAssociation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLAssociationList) DeepCopyInto(out *WebACLAssociationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WebACLAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLAssociationList.
+func (in *WebACLAssociationList) DeepCopy() *WebACLAssociationList {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLAssociationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebACLAssociationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLAssociationParameters) DeepCopyInto(out *WebACLAssociationParameters) {
+	*out = *in
+	if in.WebACLARNRef != nil {
+		in, out := &in.WebACLARNRef, &out.WebACLARNRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.WebACLARNSelector != nil {
+		in, out := &in.WebACLARNSelector, &out.WebACLARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLAssociationParameters.
+func (in *WebACLAssociationParameters) DeepCopy() *WebACLAssociationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLAssociationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLAssociationSpec) DeepCopyInto(out *WebACLAssociationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLAssociationSpec.
+func (in *WebACLAssociationSpec) DeepCopy() *WebACLAssociationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLAssociationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLAssociationStatus) DeepCopyInto(out *WebACLAssociationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLAssociationStatus.
+func (in *WebACLAssociationStatus) DeepCopy() *WebACLAssociationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLAssociationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLList) DeepCopyInto(out *WebACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WebACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLList.
+func (in *WebACLList) DeepCopy() *WebACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLObservation) DeepCopyInto(out *WebACLObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLObservation.
+func (in *WebACLObservation) DeepCopy() *WebACLObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLParameters) DeepCopyInto(out *WebACLParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]Rule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.VisibilityConfig = in.VisibilityConfig
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLParameters.
+func (in *WebACLParameters) DeepCopy() *WebACLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLSpec) DeepCopyInto(out *WebACLSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLSpec.
+func (in *WebACLSpec) DeepCopy() *WebACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLStatus) DeepCopyInto(out *WebACLStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLStatus.
+func (in *WebACLStatus) DeepCopy() *WebACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SamplingRuleParameters define the desired state of an AWS X-Ray sampling
+// rule. A request is sampled by the matching rule with the lowest priority.
+// The matching fields accept * and ? wildcards and default to *.
+type SamplingRuleParameters struct {
+	// Region is the region you'd like your SamplingRule to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Priority of the rule. Rules are evaluated in ascending order of their
+	// priority.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9999
+	Priority int64 `json:"priority"`
+
+	// NOTE: Type of FixedRate is float64 in AWS SDK but float is not
+	// supported by controller-runtime, so it is given as a decimal string.
+	// See https://github.com/kubernetes-sigs/controller-tools/issues/245
+
+	// FixedRate is the fraction of the matching requests to sample after
+	// the reservoir is used up, between 0 and 1, such as 0.05.
+	FixedRate string `json:"fixedRate"`
+
+	// ReservoirSize is the number of matching requests per second that are
+	// sampled before FixedRate applies.
+	// +kubebuilder:validation:Minimum=0
+	ReservoirSize int64 `json:"reservoirSize"`
+
+	// ServiceName matches the name of the service of the request.
+	// +optional
+	ServiceName *string `json:"serviceName,omitempty"`
+
+	// ServiceType matches the origin of the service of the request, such as
+	// AWS::EC2::Instance.
+	// +optional
+	ServiceType *string `json:"serviceType,omitempty"`
+
+	// Host matches the hostname of the request.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// HTTPMethod matches the HTTP method of the request.
+	// +optional
+	HTTPMethod *string `json:"httpMethod,omitempty"`
+
+	// URLPath matches the URL path of the request.
+	// +optional
+	URLPath *string `json:"urlPath,omitempty"`
+
+	// ResourceARN matches the ARN of the AWS resource that serves the
+	// request.
+	// +optional
+	ResourceARN *string `json:"resourceArn,omitempty"`
+
+	// Attributes match the segment attributes of the request.
+	// +optional
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// SamplingRuleObservation keeps the state for the external resource
+type SamplingRuleObservation struct {
+	// RuleARN is the Amazon Resource Name (ARN) of the sampling rule.
+	RuleARN string `json:"ruleArn,omitempty"`
+
+	// CreatedAt is the time at which the sampling rule was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// ModifiedAt is the last time the sampling rule was modified.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+}
+
+// A SamplingRuleSpec defines the desired state of a SamplingRule.
+type SamplingRuleSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SamplingRuleParameters `json:"forProvider"`
+}
+
+// A SamplingRuleStatus represents the observed state of a SamplingRule.
+type SamplingRuleStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     SamplingRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SamplingRule is a managed resource that represents an AWS X-Ray sampling
+// rule. Its external name is the name of the rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PRIORITY",type="integer",JSONPath=".spec.forProvider.priority"
+// +kubebuilder:printcolumn:name="RATE",type="string",JSONPath=".spec.forProvider.fixedRate"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SamplingRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SamplingRuleSpec   `json:"spec"`
+	Status SamplingRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SamplingRuleList contains a list of SamplingRules
+type SamplingRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SamplingRule `json:"items"`
+}
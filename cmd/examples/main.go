@@ -0,0 +1,39 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/crossplane/provider-aws/pkg/examples"
+)
+
+func main() {
+	var (
+		app    = kingpin.New(filepath.Base(os.Args[0]), "Generate an example of each managed resource kind from its CustomResourceDefinition.").DefaultEnvars()
+		crds   = app.Flag("crds", "Directory of the CustomResourceDefinitions.").Default("package/crds").ExistingDir()
+		output = app.Flag("output", "Directory to write the examples to.").Default("examples/generated").String()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	defs, err := examples.LoadCRDs(*crds)
+	kingpin.FatalIfError(err, "Cannot load CustomResourceDefinitions")
+	kingpin.FatalIfError(examples.Write(defs, *output), "Cannot write examples")
+}
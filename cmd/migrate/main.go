@@ -0,0 +1,87 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/alecthomas/kingpin.v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	"github.com/crossplane/provider-aws/pkg/migration"
+)
+
+func main() {
+	var (
+		app   = kingpin.New(filepath.Base(os.Args[0]), "Convert managed resources of other AWS providers to the managed resources of this provider.").DefaultEnvars()
+		files = app.Arg("files", "YAML files of the managed resources to convert. Reads from stdin if none are given.").ExistingFiles()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	c := migration.NewConverter(migration.Conversions...)
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush() // nolint:errcheck
+
+	if len(*files) == 0 {
+		kingpin.FatalIfError(convert(c, os.Stdin, "stdin", w), "Cannot convert managed resources")
+		return
+	}
+	for _, f := range *files {
+		r, err := os.Open(filepath.Clean(f))
+		kingpin.FatalIfError(err, "Cannot open %s", f)
+		kingpin.FatalIfError(convert(c, r, f, w), "Cannot convert managed resources")
+		_ = r.Close()
+	}
+}
+
+func convert(c *migration.Converter, r io.Reader, source string, w io.Writer) error {
+	d := yaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		in := &unstructured.Unstructured{}
+		if err := d.Decode(&in.Object); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return errors.Wrap(err, source)
+		}
+		if len(in.Object) == 0 {
+			continue
+		}
+		res, err := c.Convert(in)
+		if err != nil {
+			return errors.Wrapf(err, "%s: %s/%s", source, in.GetKind(), in.GetName())
+		}
+		if len(res.Ignored) > 0 {
+			fmt.Fprintf(os.Stderr, "%s: %s/%s: fields not converted: %s\n", source, in.GetKind(), in.GetName(), strings.Join(res.Ignored, ", "))
+		}
+		b, err := sigsyaml.Marshal(res.Object.Object)
+		if err != nil {
+			return errors.Wrapf(err, "%s: %s/%s", source, in.GetKind(), in.GetName())
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", b); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/crossplane/provider-aws/pkg/modelmarkers"
+)
+
+func main() {
+	var (
+		app  = kingpin.New(filepath.Base(os.Args[0]), "Sync the validation markers of API fields with the AWS API models of the SDK.").DefaultEnvars()
+		apis = app.Flag("apis", "Directory of the API types.").Default("apis").ExistingDir()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	m := modelmarkers.NewModel()
+	kingpin.FatalIfError(modelmarkers.RewriteDir(*apis, m.Constraints), "Cannot rewrite validation markers")
+}
@@ -28,7 +28,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 
 	"github.com/crossplane/provider-aws/apis"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/controller"
+	"github.com/crossplane/provider-aws/pkg/controller/config"
+	"github.com/crossplane/provider-aws/pkg/webhook"
 )
 
 func main() {
@@ -36,6 +39,11 @@ func main() {
 		app        = kingpin.New(filepath.Base(os.Args[0]), "AWS support for Crossplane.").DefaultEnvars()
 		debug      = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
 		syncPeriod = app.Flag("sync", "Controller manager sync period duration such as 300ms, 1.5h or 2h45m").Short('s').Default("1h").Duration()
+		webhooks   = app.Flag("enable-webhooks", "Serve validating admission webhooks for managed resources.").Default("false").Bool()
+		certDir    = app.Flag("webhook-cert-dir", "Directory containing the tls.crt and tls.key files of the webhook server.").Default("/tmp/k8s-webhook-server/serving-certs").String()
+		port       = app.Flag("webhook-port", "Port the webhook server listens on.").Default("9443").Int()
+		audit      = app.Flag("audit-permissions", "Audit the IAM permissions of all ProviderConfigs, not only of those that enable the audit.").Default("false").Bool()
+		grace      = app.Flag("create-grace-period", "Time after creating an external resource during which it is assumed to exist while AWS has not propagated it yet. Zero disables it.").Default(awsclients.DefaultCreateGracePeriod.String()).Duration()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -48,16 +56,22 @@ func main() {
 		ctrl.SetLogger(zl)
 	}
 
-	log.Debug("Starting", "sync-period", syncPeriod.String())
+	log.Debug("Starting", "sync-period", syncPeriod.String(), "create-grace-period", grace.String())
+	awsclients.SetCreateGracePeriod(*grace)
 
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
-	mgr, err := ctrl.NewManager(cfg, ctrl.Options{SyncPeriod: syncPeriod})
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{SyncPeriod: syncPeriod, CertDir: *certDir, Port: *port})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add AWS APIs to scheme")
 	kingpin.FatalIfError(controller.Setup(mgr, log), "Cannot setup AWS controllers")
+	kingpin.FatalIfError(config.SetupPermissionsAudit(mgr, log, *audit), "Cannot setup permissions audit")
+	kingpin.FatalIfError(config.SetupBillingAlarm(mgr, log), "Cannot setup billing alarm")
+	if *webhooks {
+		kingpin.FatalIfError(webhook.Setup(mgr), "Cannot setup AWS webhooks")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 
 }
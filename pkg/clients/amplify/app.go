@@ -0,0 +1,211 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package amplify
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetEnvironmentVariableSecret = "cannot get the value secret of an environment variable"
+	errGetAccessTokenSecret         = "cannot get the access token secret"
+)
+
+// AppClient is the external client used for App Custom Resource
+type AppClient interface {
+	GetAppRequest(*amplify.GetAppInput) amplify.GetAppRequest
+	CreateAppRequest(*amplify.CreateAppInput) amplify.CreateAppRequest
+	UpdateAppRequest(*amplify.UpdateAppInput) amplify.UpdateAppRequest
+	DeleteAppRequest(*amplify.DeleteAppInput) amplify.DeleteAppRequest
+}
+
+// NewAppClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewAppClient(cfg aws.Config) AppClient {
+	return amplify.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the app, branch or domain
+// association doesn't exist.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && (awsErr.Code() == amplify.ErrCodeNotFoundException || awsErr.Code() == amplify.ErrCodeResourceNotFoundException)
+}
+
+// GetEnvironmentVariables returns the given environment variables by name,
+// reading the values that are stored in a secret.
+func GetEnvironmentVariables(ctx context.Context, kube client.Client, vars []v1alpha1.EnvironmentVariable) (map[string]string, error) {
+	if len(vars) == 0 {
+		return nil, nil
+	}
+	env := make(map[string]string, len(vars))
+	for _, v := range vars {
+		ref := v.ValueSecretRef
+		if ref == nil {
+			env[v.Name] = aws.StringValue(v.Value)
+			continue
+		}
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+			return nil, errors.Wrap(err, errGetEnvironmentVariableSecret)
+		}
+		env[v.Name] = string(s.Data[ref.Key])
+	}
+	return env, nil
+}
+
+// GetAccessToken returns the repository access token of the given app, or an
+// empty string if it has none.
+func GetAccessToken(ctx context.Context, kube client.Client, p v1alpha1.AppParameters) (string, error) {
+	ref := p.AccessTokenSecretRef
+	if ref == nil {
+		return "", nil
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(err, errGetAccessTokenSecret)
+	}
+	return string(s.Data[ref.Key]), nil
+}
+
+// GenerateCreateAppInput returns the input to create an app with the given
+// name, environment variables and repository access token.
+func GenerateCreateAppInput(name string, p v1alpha1.AppParameters, env map[string]string, accessToken string) *amplify.CreateAppInput {
+	in := &amplify.CreateAppInput{
+		Name:                  aws.String(name),
+		Description:           p.Description,
+		Repository:            p.Repository,
+		Platform:              amplify.Platform(aws.StringValue(p.Platform)),
+		IamServiceRoleArn:     p.IAMServiceRoleARN,
+		BuildSpec:             p.BuildSpec,
+		EnvironmentVariables:  env,
+		EnableBranchAutoBuild: p.EnableBranchAutoBuild,
+		CustomRules:           generateCustomRules(p.CustomRules),
+	}
+	if accessToken != "" {
+		in.AccessToken = aws.String(accessToken)
+	}
+	if len(p.Tags) != 0 {
+		in.Tags = make(map[string]string, len(p.Tags))
+		for k, v := range p.Tags {
+			in.Tags[k] = v
+		}
+	}
+	return in
+}
+
+// GenerateUpdateAppInput returns the input to update the app with the given
+// ID, environment variables and repository access token.
+func GenerateUpdateAppInput(id string, p v1alpha1.AppParameters, env map[string]string, accessToken string) *amplify.UpdateAppInput {
+	in := &amplify.UpdateAppInput{
+		AppId:                 aws.String(id),
+		Description:           p.Description,
+		Repository:            p.Repository,
+		Platform:              amplify.Platform(aws.StringValue(p.Platform)),
+		IamServiceRoleArn:     p.IAMServiceRoleARN,
+		BuildSpec:             p.BuildSpec,
+		EnvironmentVariables:  env,
+		EnableBranchAutoBuild: p.EnableBranchAutoBuild,
+		CustomRules:           generateCustomRules(p.CustomRules),
+	}
+	if in.EnvironmentVariables == nil {
+		in.EnvironmentVariables = map[string]string{}
+	}
+	if accessToken != "" {
+		in.AccessToken = aws.String(accessToken)
+	}
+	return in
+}
+
+// GenerateAppObservation returns the observation of the given app.
+func GenerateAppObservation(o amplify.App) v1alpha1.AppObservation {
+	return v1alpha1.AppObservation{
+		ARN:           aws.StringValue(o.AppArn),
+		DefaultDomain: aws.StringValue(o.DefaultDomain),
+	}
+}
+
+// LateInitializeApp fills the empty fields of the given parameters with the
+// values of the observed app.
+func LateInitializeApp(p *v1alpha1.AppParameters, o amplify.App) {
+	p.Repository = awsclients.LateInitializeStringPtr(p.Repository, awsclients.String(aws.StringValue(o.Repository)))
+	p.Platform = awsclients.LateInitializeStringPtr(p.Platform, awsclients.String(string(o.Platform)))
+	p.IAMServiceRoleARN = awsclients.LateInitializeStringPtr(p.IAMServiceRoleARN, awsclients.String(aws.StringValue(o.IamServiceRoleArn)))
+	p.BuildSpec = awsclients.LateInitializeStringPtr(p.BuildSpec, awsclients.String(aws.StringValue(o.BuildSpec)))
+	p.EnableBranchAutoBuild = awsclients.LateInitializeBoolPtr(p.EnableBranchAutoBuild, o.EnableBranchAutoBuild)
+	if len(p.CustomRules) == 0 {
+		p.CustomRules = generateObservedCustomRules(o.CustomRules)
+	}
+}
+
+// IsAppUpToDate returns whether the observed app is up to date with the given
+// parameters and environment variables.
+func IsAppUpToDate(p v1alpha1.AppParameters, o amplify.App, env map[string]string) bool {
+	return aws.StringValue(p.Description) == aws.StringValue(o.Description) &&
+		aws.StringValue(p.Repository) == aws.StringValue(o.Repository) &&
+		aws.StringValue(p.Platform) == string(o.Platform) &&
+		aws.StringValue(p.IAMServiceRoleARN) == aws.StringValue(o.IamServiceRoleArn) &&
+		aws.StringValue(p.BuildSpec) == aws.StringValue(o.BuildSpec) &&
+		aws.BoolValue(p.EnableBranchAutoBuild) == aws.BoolValue(o.EnableBranchAutoBuild) &&
+		cmp.Equal(env, o.EnvironmentVariables, cmpopts.EquateEmpty()) &&
+		cmp.Equal(p.CustomRules, generateObservedCustomRules(o.CustomRules), cmpopts.EquateEmpty())
+}
+
+func generateCustomRules(rules []v1alpha1.CustomRule) []amplify.CustomRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	res := make([]amplify.CustomRule, len(rules))
+	for i, r := range rules {
+		res[i] = amplify.CustomRule{
+			Source:    aws.String(r.Source),
+			Target:    aws.String(r.Target),
+			Status:    r.Status,
+			Condition: r.Condition,
+		}
+	}
+	return res
+}
+
+func generateObservedCustomRules(rules []amplify.CustomRule) []v1alpha1.CustomRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	res := make([]v1alpha1.CustomRule, len(rules))
+	for i, r := range rules {
+		res[i] = v1alpha1.CustomRule{
+			Source:    aws.StringValue(r.Source),
+			Target:    aws.StringValue(r.Target),
+			Status:    r.Status,
+			Condition: r.Condition,
+		}
+	}
+	return res
+}
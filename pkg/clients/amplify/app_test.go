@@ -0,0 +1,192 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package amplify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+)
+
+var (
+	repository = "https://github.com/example/marketing-site"
+
+	errBoom = errors.New("boom")
+)
+
+func appParams(m ...func(*v1alpha1.AppParameters)) v1alpha1.AppParameters {
+	p := v1alpha1.AppParameters{
+		Region:                "us-east-1",
+		Repository:            aws.String(repository),
+		Platform:              aws.String(string(amplify.PlatformWeb)),
+		EnableBranchAutoBuild: aws.Bool(true),
+		CustomRules:           []v1alpha1.CustomRule{{Source: "/<*>", Target: "/index.html", Status: aws.String("404-200")}},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func app(m ...func(*amplify.App)) amplify.App {
+	o := amplify.App{
+		Description:           aws.String(""),
+		Repository:            aws.String(repository),
+		Platform:              amplify.PlatformWeb,
+		EnableBranchAutoBuild: aws.Bool(true),
+		EnvironmentVariables:  map[string]string{},
+		CustomRules:           []amplify.CustomRule{{Source: aws.String("/<*>"), Target: aws.String("/index.html"), Status: aws.String("404-200")}},
+	}
+	for _, f := range m {
+		f(&o)
+	}
+	return o
+}
+
+func TestGetEnvironmentVariables(t *testing.T) {
+	secret := &runtimev1alpha1.SecretKeySelector{
+		SecretReference: runtimev1alpha1.SecretReference{Name: "api", Namespace: "default"},
+		Key:             "key",
+	}
+
+	type want struct {
+		env map[string]string
+		err error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		vars []v1alpha1.EnvironmentVariable
+		want
+	}{
+		"Empty": {},
+		"Values": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"key": []byte("s3cr3t")}
+					return nil
+				},
+			},
+			vars: []v1alpha1.EnvironmentVariable{{Name: "STAGE", Value: aws.String("prod")}, {Name: "API_KEY", ValueSecretRef: secret}},
+			want: want{
+				env: map[string]string{"STAGE": "prod", "API_KEY": "s3cr3t"},
+			},
+		},
+		"SecretError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			vars: []v1alpha1.EnvironmentVariable{{Name: "API_KEY", ValueSecretRef: secret}},
+			want: want{
+				err: errors.Wrap(errBoom, errGetEnvironmentVariableSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			env, err := GetEnvironmentVariables(context.Background(), tc.kube, tc.vars)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.env, env); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeApp(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.AppParameters
+		o    amplify.App
+		want v1alpha1.AppParameters
+	}{
+		"AllSet": {
+			in:   appParams(),
+			o:    app(func(o *amplify.App) { o.EnableBranchAutoBuild = aws.Bool(false) }),
+			want: appParams(),
+		},
+		"Empty": {
+			in: v1alpha1.AppParameters{Region: "us-east-1"},
+			o: app(func(o *amplify.App) {
+				o.BuildSpec = aws.String("")
+				o.IamServiceRoleArn = aws.String("")
+			}),
+			want: appParams(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeApp(&tc.in, tc.o)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAppUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.AppParameters
+		o    amplify.App
+		env  map[string]string
+		want bool
+	}{
+		"UpToDate": {
+			p:    appParams(),
+			o:    app(),
+			want: true,
+		},
+		"EnvironmentVariables": {
+			p:    appParams(),
+			o:    app(func(o *amplify.App) { o.EnvironmentVariables = map[string]string{"STAGE": "dev"} }),
+			env:  map[string]string{"STAGE": "prod"},
+			want: false,
+		},
+		"CustomRules": {
+			p:    appParams(func(p *v1alpha1.AppParameters) { p.CustomRules = nil }),
+			o:    app(),
+			want: false,
+		},
+		"Description": {
+			p:    appParams(func(p *v1alpha1.AppParameters) { p.Description = aws.String("Marketing site") }),
+			o:    app(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAppUpToDate(tc.p, tc.o, tc.env)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package amplify
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// BranchClient is the external client used for Branch Custom Resource
+type BranchClient interface {
+	GetBranchRequest(*amplify.GetBranchInput) amplify.GetBranchRequest
+	CreateBranchRequest(*amplify.CreateBranchInput) amplify.CreateBranchRequest
+	UpdateBranchRequest(*amplify.UpdateBranchInput) amplify.UpdateBranchRequest
+	DeleteBranchRequest(*amplify.DeleteBranchInput) amplify.DeleteBranchRequest
+}
+
+// NewBranchClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewBranchClient(cfg aws.Config) BranchClient {
+	return amplify.New(cfg)
+}
+
+// GenerateCreateBranchInput returns the input to create a branch with the
+// given name and environment variables.
+func GenerateCreateBranchInput(name string, p v1alpha1.BranchParameters, env map[string]string) *amplify.CreateBranchInput {
+	in := &amplify.CreateBranchInput{
+		AppId:                    p.AppID,
+		BranchName:               aws.String(name),
+		Description:              p.Description,
+		Stage:                    amplify.Stage(aws.StringValue(p.Stage)),
+		Framework:                p.Framework,
+		EnableAutoBuild:          p.EnableAutoBuild,
+		EnablePullRequestPreview: p.EnablePullRequestPreview,
+		BuildSpec:                p.BuildSpec,
+		EnvironmentVariables:     env,
+	}
+	if len(p.Tags) != 0 {
+		in.Tags = make(map[string]string, len(p.Tags))
+		for k, v := range p.Tags {
+			in.Tags[k] = v
+		}
+	}
+	return in
+}
+
+// GenerateUpdateBranchInput returns the input to update the branch with the
+// given name and environment variables.
+func GenerateUpdateBranchInput(name string, p v1alpha1.BranchParameters, env map[string]string) *amplify.UpdateBranchInput {
+	in := &amplify.UpdateBranchInput{
+		AppId:                    p.AppID,
+		BranchName:               aws.String(name),
+		Description:              p.Description,
+		Stage:                    amplify.Stage(aws.StringValue(p.Stage)),
+		Framework:                p.Framework,
+		EnableAutoBuild:          p.EnableAutoBuild,
+		EnablePullRequestPreview: p.EnablePullRequestPreview,
+		BuildSpec:                p.BuildSpec,
+		EnvironmentVariables:     env,
+	}
+	if in.EnvironmentVariables == nil {
+		in.EnvironmentVariables = map[string]string{}
+	}
+	return in
+}
+
+// GenerateBranchObservation returns the observation of the given branch.
+func GenerateBranchObservation(o amplify.Branch) v1alpha1.BranchObservation {
+	return v1alpha1.BranchObservation{
+		ARN:           aws.StringValue(o.BranchArn),
+		DisplayName:   aws.StringValue(o.DisplayName),
+		ActiveJobID:   aws.StringValue(o.ActiveJobId),
+		CustomDomains: o.CustomDomains,
+	}
+}
+
+// LateInitializeBranch fills the empty fields of the given parameters with
+// the values of the observed branch.
+func LateInitializeBranch(p *v1alpha1.BranchParameters, o amplify.Branch) {
+	p.Framework = awsclients.LateInitializeStringPtr(p.Framework, awsclients.String(aws.StringValue(o.Framework)))
+	p.EnableAutoBuild = awsclients.LateInitializeBoolPtr(p.EnableAutoBuild, o.EnableAutoBuild)
+	p.EnablePullRequestPreview = awsclients.LateInitializeBoolPtr(p.EnablePullRequestPreview, o.EnablePullRequestPreview)
+	p.BuildSpec = awsclients.LateInitializeStringPtr(p.BuildSpec, awsclients.String(aws.StringValue(o.BuildSpec)))
+}
+
+// IsBranchUpToDate returns whether the observed branch is up to date with the
+// given parameters and environment variables. The stage is only compared if
+// it is set, since Amplify reports branches without a stage as NONE.
+func IsBranchUpToDate(p v1alpha1.BranchParameters, o amplify.Branch, env map[string]string) bool {
+	return aws.StringValue(p.Description) == aws.StringValue(o.Description) &&
+		(p.Stage == nil || *p.Stage == string(o.Stage)) &&
+		aws.StringValue(p.Framework) == aws.StringValue(o.Framework) &&
+		aws.BoolValue(p.EnableAutoBuild) == aws.BoolValue(o.EnableAutoBuild) &&
+		aws.BoolValue(p.EnablePullRequestPreview) == aws.BoolValue(o.EnablePullRequestPreview) &&
+		aws.StringValue(p.BuildSpec) == aws.StringValue(o.BuildSpec) &&
+		cmp.Equal(env, o.EnvironmentVariables, cmpopts.EquateEmpty())
+}
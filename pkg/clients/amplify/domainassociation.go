@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package amplify
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// DomainAssociationClient is the external client used for DomainAssociation
+// Custom Resource
+type DomainAssociationClient interface {
+	GetDomainAssociationRequest(*amplify.GetDomainAssociationInput) amplify.GetDomainAssociationRequest
+	CreateDomainAssociationRequest(*amplify.CreateDomainAssociationInput) amplify.CreateDomainAssociationRequest
+	UpdateDomainAssociationRequest(*amplify.UpdateDomainAssociationInput) amplify.UpdateDomainAssociationRequest
+	DeleteDomainAssociationRequest(*amplify.DeleteDomainAssociationInput) amplify.DeleteDomainAssociationRequest
+}
+
+// NewDomainAssociationClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewDomainAssociationClient(cfg aws.Config) DomainAssociationClient {
+	return amplify.New(cfg)
+}
+
+// GenerateCreateDomainAssociationInput returns the input to associate the
+// given domain with an app.
+func GenerateCreateDomainAssociationInput(domain string, p v1alpha1.DomainAssociationParameters) *amplify.CreateDomainAssociationInput {
+	return &amplify.CreateDomainAssociationInput{
+		AppId:               p.AppID,
+		DomainName:          aws.String(domain),
+		EnableAutoSubDomain: p.EnableAutoSubDomain,
+		SubDomainSettings:   generateSubDomainSettings(p.SubDomainSettings),
+	}
+}
+
+// GenerateUpdateDomainAssociationInput returns the input to update the
+// association of the given domain.
+func GenerateUpdateDomainAssociationInput(domain string, p v1alpha1.DomainAssociationParameters) *amplify.UpdateDomainAssociationInput {
+	return &amplify.UpdateDomainAssociationInput{
+		AppId:               p.AppID,
+		DomainName:          aws.String(domain),
+		EnableAutoSubDomain: p.EnableAutoSubDomain,
+		SubDomainSettings:   generateSubDomainSettings(p.SubDomainSettings),
+	}
+}
+
+// GenerateDomainAssociationObservation returns the observation of the given
+// domain association.
+func GenerateDomainAssociationObservation(o amplify.DomainAssociation) v1alpha1.DomainAssociationObservation {
+	res := v1alpha1.DomainAssociationObservation{
+		ARN:                              aws.StringValue(o.DomainAssociationArn),
+		DomainStatus:                     string(o.DomainStatus),
+		StatusReason:                     aws.StringValue(o.StatusReason),
+		CertificateVerificationDNSRecord: aws.StringValue(o.CertificateVerificationDNSRecord),
+	}
+	for _, s := range o.SubDomains {
+		sd := v1alpha1.SubDomain{
+			DNSRecord: aws.StringValue(s.DnsRecord),
+			Verified:  aws.BoolValue(s.Verified),
+		}
+		if s.SubDomainSetting != nil {
+			sd.Prefix = aws.StringValue(s.SubDomainSetting.Prefix)
+			sd.BranchName = aws.StringValue(s.SubDomainSetting.BranchName)
+		}
+		res.SubDomains = append(res.SubDomains, sd)
+	}
+	return res
+}
+
+// LateInitializeDomainAssociation fills the empty fields of the given
+// parameters with the values of the observed domain association.
+func LateInitializeDomainAssociation(p *v1alpha1.DomainAssociationParameters, o amplify.DomainAssociation) {
+	p.EnableAutoSubDomain = awsclients.LateInitializeBoolPtr(p.EnableAutoSubDomain, o.EnableAutoSubDomain)
+}
+
+// IsDomainAssociationUpToDate returns whether the observed domain association
+// is up to date with the given parameters. Amplify adds sub domains for new
+// branches if automatic sub domains are enabled, so only the desired sub
+// domains are compared in that case.
+func IsDomainAssociationUpToDate(p v1alpha1.DomainAssociationParameters, o amplify.DomainAssociation) bool {
+	if aws.BoolValue(p.EnableAutoSubDomain) != aws.BoolValue(o.EnableAutoSubDomain) {
+		return false
+	}
+	observed := map[string]string{}
+	for _, s := range o.SubDomains {
+		if s.SubDomainSetting != nil {
+			observed[aws.StringValue(s.SubDomainSetting.Prefix)] = aws.StringValue(s.SubDomainSetting.BranchName)
+		}
+	}
+	if !aws.BoolValue(p.EnableAutoSubDomain) && len(observed) != len(p.SubDomainSettings) {
+		return false
+	}
+	for _, s := range p.SubDomainSettings {
+		b, ok := observed[s.Prefix]
+		if !ok || b != aws.StringValue(s.BranchName) {
+			return false
+		}
+	}
+	return true
+}
+
+func generateSubDomainSettings(settings []v1alpha1.SubDomainSetting) []amplify.SubDomainSetting {
+	res := make([]amplify.SubDomainSetting, len(settings))
+	for i, s := range settings {
+		res[i] = amplify.SubDomainSetting{
+			Prefix:     aws.String(s.Prefix),
+			BranchName: s.BranchName,
+		}
+	}
+	return res
+}
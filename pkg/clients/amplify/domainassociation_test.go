@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package amplify
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+)
+
+func subDomain(prefix, branch string) amplify.SubDomain {
+	return amplify.SubDomain{
+		SubDomainSetting: &amplify.SubDomainSetting{Prefix: aws.String(prefix), BranchName: aws.String(branch)},
+	}
+}
+
+func TestIsDomainAssociationUpToDate(t *testing.T) {
+	settings := []v1alpha1.SubDomainSetting{
+		{Prefix: "", BranchName: aws.String("main")},
+		{Prefix: "www", BranchName: aws.String("main")},
+	}
+
+	cases := map[string]struct {
+		auto     *bool
+		observed []amplify.SubDomain
+		autoObs  bool
+		want     bool
+	}{
+		"UpToDate": {
+			observed: []amplify.SubDomain{subDomain("www", "main"), subDomain("", "main")},
+			want:     true,
+		},
+		"DifferentBranch": {
+			observed: []amplify.SubDomain{subDomain("", "main"), subDomain("www", "dev")},
+			want:     false,
+		},
+		"ExtraSubDomain": {
+			observed: []amplify.SubDomain{subDomain("", "main"), subDomain("www", "main"), subDomain("dev", "dev")},
+			want:     false,
+		},
+		"AutoSubDomain": {
+			auto:     aws.Bool(true),
+			autoObs:  true,
+			observed: []amplify.SubDomain{subDomain("", "main"), subDomain("www", "main"), subDomain("dev", "dev")},
+			want:     true,
+		},
+		"MissingSubDomain": {
+			auto:     aws.Bool(true),
+			autoObs:  true,
+			observed: []amplify.SubDomain{subDomain("", "main")},
+			want:     false,
+		},
+		"AutoSubDomainChanged": {
+			auto:     aws.Bool(true),
+			observed: []amplify.SubDomain{subDomain("", "main"), subDomain("www", "main")},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1alpha1.DomainAssociationParameters{EnableAutoSubDomain: tc.auto, SubDomainSettings: settings}
+			o := amplify.DomainAssociation{EnableAutoSubDomain: aws.Bool(tc.autoObs), SubDomains: tc.observed}
+			got := IsDomainAssociationUpToDate(p, o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
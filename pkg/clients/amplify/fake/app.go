@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/amplify"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AppClient = (*MockAppClient)(nil)
+
+// MockAppClient is a type that implements all the methods for AppClient interface
+type MockAppClient struct {
+	MockGetApp    func(*amplify.GetAppInput) amplify.GetAppRequest
+	MockCreateApp func(*amplify.CreateAppInput) amplify.CreateAppRequest
+	MockUpdateApp func(*amplify.UpdateAppInput) amplify.UpdateAppRequest
+	MockDeleteApp func(*amplify.DeleteAppInput) amplify.DeleteAppRequest
+}
+
+// GetAppRequest mocks GetAppRequest method
+func (m *MockAppClient) GetAppRequest(input *amplify.GetAppInput) amplify.GetAppRequest {
+	return m.MockGetApp(input)
+}
+
+// CreateAppRequest mocks CreateAppRequest method
+func (m *MockAppClient) CreateAppRequest(input *amplify.CreateAppInput) amplify.CreateAppRequest {
+	return m.MockCreateApp(input)
+}
+
+// UpdateAppRequest mocks UpdateAppRequest method
+func (m *MockAppClient) UpdateAppRequest(input *amplify.UpdateAppInput) amplify.UpdateAppRequest {
+	return m.MockUpdateApp(input)
+}
+
+// DeleteAppRequest mocks DeleteAppRequest method
+func (m *MockAppClient) DeleteAppRequest(input *amplify.DeleteAppInput) amplify.DeleteAppRequest {
+	return m.MockDeleteApp(input)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/amplify"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.BranchClient = (*MockBranchClient)(nil)
+
+// MockBranchClient is a type that implements all the methods for BranchClient interface
+type MockBranchClient struct {
+	MockGetBranch    func(*amplify.GetBranchInput) amplify.GetBranchRequest
+	MockCreateBranch func(*amplify.CreateBranchInput) amplify.CreateBranchRequest
+	MockUpdateBranch func(*amplify.UpdateBranchInput) amplify.UpdateBranchRequest
+	MockDeleteBranch func(*amplify.DeleteBranchInput) amplify.DeleteBranchRequest
+}
+
+// GetBranchRequest mocks GetBranchRequest method
+func (m *MockBranchClient) GetBranchRequest(input *amplify.GetBranchInput) amplify.GetBranchRequest {
+	return m.MockGetBranch(input)
+}
+
+// CreateBranchRequest mocks CreateBranchRequest method
+func (m *MockBranchClient) CreateBranchRequest(input *amplify.CreateBranchInput) amplify.CreateBranchRequest {
+	return m.MockCreateBranch(input)
+}
+
+// UpdateBranchRequest mocks UpdateBranchRequest method
+func (m *MockBranchClient) UpdateBranchRequest(input *amplify.UpdateBranchInput) amplify.UpdateBranchRequest {
+	return m.MockUpdateBranch(input)
+}
+
+// DeleteBranchRequest mocks DeleteBranchRequest method
+func (m *MockBranchClient) DeleteBranchRequest(input *amplify.DeleteBranchInput) amplify.DeleteBranchRequest {
+	return m.MockDeleteBranch(input)
+}
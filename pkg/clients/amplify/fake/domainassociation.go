@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/amplify"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DomainAssociationClient = (*MockDomainAssociationClient)(nil)
+
+// MockDomainAssociationClient is a type that implements all the methods for DomainAssociationClient interface
+type MockDomainAssociationClient struct {
+	MockGetDomainAssociation    func(*amplify.GetDomainAssociationInput) amplify.GetDomainAssociationRequest
+	MockCreateDomainAssociation func(*amplify.CreateDomainAssociationInput) amplify.CreateDomainAssociationRequest
+	MockUpdateDomainAssociation func(*amplify.UpdateDomainAssociationInput) amplify.UpdateDomainAssociationRequest
+	MockDeleteDomainAssociation func(*amplify.DeleteDomainAssociationInput) amplify.DeleteDomainAssociationRequest
+}
+
+// GetDomainAssociationRequest mocks GetDomainAssociationRequest method
+func (m *MockDomainAssociationClient) GetDomainAssociationRequest(input *amplify.GetDomainAssociationInput) amplify.GetDomainAssociationRequest {
+	return m.MockGetDomainAssociation(input)
+}
+
+// CreateDomainAssociationRequest mocks CreateDomainAssociationRequest method
+func (m *MockDomainAssociationClient) CreateDomainAssociationRequest(input *amplify.CreateDomainAssociationInput) amplify.CreateDomainAssociationRequest {
+	return m.MockCreateDomainAssociation(input)
+}
+
+// UpdateDomainAssociationRequest mocks UpdateDomainAssociationRequest method
+func (m *MockDomainAssociationClient) UpdateDomainAssociationRequest(input *amplify.UpdateDomainAssociationInput) amplify.UpdateDomainAssociationRequest {
+	return m.MockUpdateDomainAssociation(input)
+}
+
+// DeleteDomainAssociationRequest mocks DeleteDomainAssociationRequest method
+func (m *MockDomainAssociationClient) DeleteDomainAssociationRequest(input *amplify.DeleteDomainAssociationInput) amplify.DeleteDomainAssociationRequest {
+	return m.MockDeleteDomainAssociation(input)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/appmesh"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.MeshClient = (*MockMeshClient)(nil)
+
+// MockMeshClient is a type that implements all the methods for MeshClient interface
+type MockMeshClient struct {
+	MockDescribeMesh        func(*appmesh.DescribeMeshInput) appmesh.DescribeMeshRequest
+	MockCreateMesh          func(*appmesh.CreateMeshInput) appmesh.CreateMeshRequest
+	MockUpdateMesh          func(*appmesh.UpdateMeshInput) appmesh.UpdateMeshRequest
+	MockDeleteMesh          func(*appmesh.DeleteMeshInput) appmesh.DeleteMeshRequest
+	MockListTagsForResource func(*appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest
+	MockTagResource         func(*appmesh.TagResourceInput) appmesh.TagResourceRequest
+	MockUntagResource       func(*appmesh.UntagResourceInput) appmesh.UntagResourceRequest
+}
+
+// DescribeMeshRequest mocks DescribeMeshRequest method
+func (m *MockMeshClient) DescribeMeshRequest(input *appmesh.DescribeMeshInput) appmesh.DescribeMeshRequest {
+	return m.MockDescribeMesh(input)
+}
+
+// CreateMeshRequest mocks CreateMeshRequest method
+func (m *MockMeshClient) CreateMeshRequest(input *appmesh.CreateMeshInput) appmesh.CreateMeshRequest {
+	return m.MockCreateMesh(input)
+}
+
+// UpdateMeshRequest mocks UpdateMeshRequest method
+func (m *MockMeshClient) UpdateMeshRequest(input *appmesh.UpdateMeshInput) appmesh.UpdateMeshRequest {
+	return m.MockUpdateMesh(input)
+}
+
+// DeleteMeshRequest mocks DeleteMeshRequest method
+func (m *MockMeshClient) DeleteMeshRequest(input *appmesh.DeleteMeshInput) appmesh.DeleteMeshRequest {
+	return m.MockDeleteMesh(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockMeshClient) ListTagsForResourceRequest(input *appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockMeshClient) TagResourceRequest(input *appmesh.TagResourceInput) appmesh.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockMeshClient) UntagResourceRequest(input *appmesh.UntagResourceInput) appmesh.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
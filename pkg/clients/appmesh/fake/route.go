@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/appmesh"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.RouteClient = (*MockRouteClient)(nil)
+
+// MockRouteClient is a type that implements all the methods for RouteClient interface
+type MockRouteClient struct {
+	MockDescribeRoute       func(*appmesh.DescribeRouteInput) appmesh.DescribeRouteRequest
+	MockCreateRoute         func(*appmesh.CreateRouteInput) appmesh.CreateRouteRequest
+	MockUpdateRoute         func(*appmesh.UpdateRouteInput) appmesh.UpdateRouteRequest
+	MockDeleteRoute         func(*appmesh.DeleteRouteInput) appmesh.DeleteRouteRequest
+	MockListTagsForResource func(*appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest
+	MockTagResource         func(*appmesh.TagResourceInput) appmesh.TagResourceRequest
+	MockUntagResource       func(*appmesh.UntagResourceInput) appmesh.UntagResourceRequest
+}
+
+// DescribeRouteRequest mocks DescribeRouteRequest method
+func (m *MockRouteClient) DescribeRouteRequest(input *appmesh.DescribeRouteInput) appmesh.DescribeRouteRequest {
+	return m.MockDescribeRoute(input)
+}
+
+// CreateRouteRequest mocks CreateRouteRequest method
+func (m *MockRouteClient) CreateRouteRequest(input *appmesh.CreateRouteInput) appmesh.CreateRouteRequest {
+	return m.MockCreateRoute(input)
+}
+
+// UpdateRouteRequest mocks UpdateRouteRequest method
+func (m *MockRouteClient) UpdateRouteRequest(input *appmesh.UpdateRouteInput) appmesh.UpdateRouteRequest {
+	return m.MockUpdateRoute(input)
+}
+
+// DeleteRouteRequest mocks DeleteRouteRequest method
+func (m *MockRouteClient) DeleteRouteRequest(input *appmesh.DeleteRouteInput) appmesh.DeleteRouteRequest {
+	return m.MockDeleteRoute(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockRouteClient) ListTagsForResourceRequest(input *appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockRouteClient) TagResourceRequest(input *appmesh.TagResourceInput) appmesh.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockRouteClient) UntagResourceRequest(input *appmesh.UntagResourceInput) appmesh.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/appmesh"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.VirtualNodeClient = (*MockVirtualNodeClient)(nil)
+
+// MockVirtualNodeClient is a type that implements all the methods for VirtualNodeClient interface
+type MockVirtualNodeClient struct {
+	MockDescribeVirtualNode func(*appmesh.DescribeVirtualNodeInput) appmesh.DescribeVirtualNodeRequest
+	MockCreateVirtualNode   func(*appmesh.CreateVirtualNodeInput) appmesh.CreateVirtualNodeRequest
+	MockUpdateVirtualNode   func(*appmesh.UpdateVirtualNodeInput) appmesh.UpdateVirtualNodeRequest
+	MockDeleteVirtualNode   func(*appmesh.DeleteVirtualNodeInput) appmesh.DeleteVirtualNodeRequest
+	MockListTagsForResource func(*appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest
+	MockTagResource         func(*appmesh.TagResourceInput) appmesh.TagResourceRequest
+	MockUntagResource       func(*appmesh.UntagResourceInput) appmesh.UntagResourceRequest
+}
+
+// DescribeVirtualNodeRequest mocks DescribeVirtualNodeRequest method
+func (m *MockVirtualNodeClient) DescribeVirtualNodeRequest(input *appmesh.DescribeVirtualNodeInput) appmesh.DescribeVirtualNodeRequest {
+	return m.MockDescribeVirtualNode(input)
+}
+
+// CreateVirtualNodeRequest mocks CreateVirtualNodeRequest method
+func (m *MockVirtualNodeClient) CreateVirtualNodeRequest(input *appmesh.CreateVirtualNodeInput) appmesh.CreateVirtualNodeRequest {
+	return m.MockCreateVirtualNode(input)
+}
+
+// UpdateVirtualNodeRequest mocks UpdateVirtualNodeRequest method
+func (m *MockVirtualNodeClient) UpdateVirtualNodeRequest(input *appmesh.UpdateVirtualNodeInput) appmesh.UpdateVirtualNodeRequest {
+	return m.MockUpdateVirtualNode(input)
+}
+
+// DeleteVirtualNodeRequest mocks DeleteVirtualNodeRequest method
+func (m *MockVirtualNodeClient) DeleteVirtualNodeRequest(input *appmesh.DeleteVirtualNodeInput) appmesh.DeleteVirtualNodeRequest {
+	return m.MockDeleteVirtualNode(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockVirtualNodeClient) ListTagsForResourceRequest(input *appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockVirtualNodeClient) TagResourceRequest(input *appmesh.TagResourceInput) appmesh.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockVirtualNodeClient) UntagResourceRequest(input *appmesh.UntagResourceInput) appmesh.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
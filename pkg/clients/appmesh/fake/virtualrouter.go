@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/appmesh"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.VirtualRouterClient = (*MockVirtualRouterClient)(nil)
+
+// MockVirtualRouterClient is a type that implements all the methods for VirtualRouterClient interface
+type MockVirtualRouterClient struct {
+	MockDescribeVirtualRouter func(*appmesh.DescribeVirtualRouterInput) appmesh.DescribeVirtualRouterRequest
+	MockCreateVirtualRouter   func(*appmesh.CreateVirtualRouterInput) appmesh.CreateVirtualRouterRequest
+	MockUpdateVirtualRouter   func(*appmesh.UpdateVirtualRouterInput) appmesh.UpdateVirtualRouterRequest
+	MockDeleteVirtualRouter   func(*appmesh.DeleteVirtualRouterInput) appmesh.DeleteVirtualRouterRequest
+	MockListTagsForResource   func(*appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest
+	MockTagResource           func(*appmesh.TagResourceInput) appmesh.TagResourceRequest
+	MockUntagResource         func(*appmesh.UntagResourceInput) appmesh.UntagResourceRequest
+}
+
+// DescribeVirtualRouterRequest mocks DescribeVirtualRouterRequest method
+func (m *MockVirtualRouterClient) DescribeVirtualRouterRequest(input *appmesh.DescribeVirtualRouterInput) appmesh.DescribeVirtualRouterRequest {
+	return m.MockDescribeVirtualRouter(input)
+}
+
+// CreateVirtualRouterRequest mocks CreateVirtualRouterRequest method
+func (m *MockVirtualRouterClient) CreateVirtualRouterRequest(input *appmesh.CreateVirtualRouterInput) appmesh.CreateVirtualRouterRequest {
+	return m.MockCreateVirtualRouter(input)
+}
+
+// UpdateVirtualRouterRequest mocks UpdateVirtualRouterRequest method
+func (m *MockVirtualRouterClient) UpdateVirtualRouterRequest(input *appmesh.UpdateVirtualRouterInput) appmesh.UpdateVirtualRouterRequest {
+	return m.MockUpdateVirtualRouter(input)
+}
+
+// DeleteVirtualRouterRequest mocks DeleteVirtualRouterRequest method
+func (m *MockVirtualRouterClient) DeleteVirtualRouterRequest(input *appmesh.DeleteVirtualRouterInput) appmesh.DeleteVirtualRouterRequest {
+	return m.MockDeleteVirtualRouter(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockVirtualRouterClient) ListTagsForResourceRequest(input *appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockVirtualRouterClient) TagResourceRequest(input *appmesh.TagResourceInput) appmesh.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockVirtualRouterClient) UntagResourceRequest(input *appmesh.UntagResourceInput) appmesh.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/appmesh"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.VirtualServiceClient = (*MockVirtualServiceClient)(nil)
+
+// MockVirtualServiceClient is a type that implements all the methods for VirtualServiceClient interface
+type MockVirtualServiceClient struct {
+	MockDescribeVirtualService func(*appmesh.DescribeVirtualServiceInput) appmesh.DescribeVirtualServiceRequest
+	MockCreateVirtualService   func(*appmesh.CreateVirtualServiceInput) appmesh.CreateVirtualServiceRequest
+	MockUpdateVirtualService   func(*appmesh.UpdateVirtualServiceInput) appmesh.UpdateVirtualServiceRequest
+	MockDeleteVirtualService   func(*appmesh.DeleteVirtualServiceInput) appmesh.DeleteVirtualServiceRequest
+	MockListTagsForResource    func(*appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest
+	MockTagResource            func(*appmesh.TagResourceInput) appmesh.TagResourceRequest
+	MockUntagResource          func(*appmesh.UntagResourceInput) appmesh.UntagResourceRequest
+}
+
+// DescribeVirtualServiceRequest mocks DescribeVirtualServiceRequest method
+func (m *MockVirtualServiceClient) DescribeVirtualServiceRequest(input *appmesh.DescribeVirtualServiceInput) appmesh.DescribeVirtualServiceRequest {
+	return m.MockDescribeVirtualService(input)
+}
+
+// CreateVirtualServiceRequest mocks CreateVirtualServiceRequest method
+func (m *MockVirtualServiceClient) CreateVirtualServiceRequest(input *appmesh.CreateVirtualServiceInput) appmesh.CreateVirtualServiceRequest {
+	return m.MockCreateVirtualService(input)
+}
+
+// UpdateVirtualServiceRequest mocks UpdateVirtualServiceRequest method
+func (m *MockVirtualServiceClient) UpdateVirtualServiceRequest(input *appmesh.UpdateVirtualServiceInput) appmesh.UpdateVirtualServiceRequest {
+	return m.MockUpdateVirtualService(input)
+}
+
+// DeleteVirtualServiceRequest mocks DeleteVirtualServiceRequest method
+func (m *MockVirtualServiceClient) DeleteVirtualServiceRequest(input *appmesh.DeleteVirtualServiceInput) appmesh.DeleteVirtualServiceRequest {
+	return m.MockDeleteVirtualService(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockVirtualServiceClient) ListTagsForResourceRequest(input *appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockVirtualServiceClient) TagResourceRequest(input *appmesh.TagResourceInput) appmesh.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockVirtualServiceClient) UntagResourceRequest(input *appmesh.UntagResourceInput) appmesh.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appmesh
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// MeshClient is the external client used for Mesh Custom Resource
+type MeshClient interface {
+	DescribeMeshRequest(*appmesh.DescribeMeshInput) appmesh.DescribeMeshRequest
+	CreateMeshRequest(*appmesh.CreateMeshInput) appmesh.CreateMeshRequest
+	UpdateMeshRequest(*appmesh.UpdateMeshInput) appmesh.UpdateMeshRequest
+	DeleteMeshRequest(*appmesh.DeleteMeshInput) appmesh.DeleteMeshRequest
+	ListTagsForResourceRequest(*appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest
+	TagResourceRequest(*appmesh.TagResourceInput) appmesh.TagResourceRequest
+	UntagResourceRequest(*appmesh.UntagResourceInput) appmesh.UntagResourceRequest
+}
+
+// NewMeshClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewMeshClient(cfg aws.Config) MeshClient {
+	return appmesh.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the App Mesh resource
+// doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == appmesh.ErrCodeNotFoundException {
+		return true
+	}
+	return false
+}
+
+// GenerateTags returns the App Mesh tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) []appmesh.TagRef {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]appmesh.TagRef, len(tags))
+	for i, t := range tags {
+		res[i] = appmesh.TagRef{Key: aws.String(t.Key), Value: t.Value}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from an App Mesh resource.
+func DiffTags(desired []v1alpha1.Tag, observed []appmesh.TagRef) (add []appmesh.TagRef, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = aws.StringValue(t.Value)
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, appmesh.TagRef{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
+}
+
+// GenerateMeshSpec returns the App Mesh spec of a mesh with the given
+// parameters.
+func GenerateMeshSpec(p v1alpha1.MeshParameters) *appmesh.MeshSpec {
+	s := &appmesh.MeshSpec{}
+	if p.EgressFilter != nil {
+		s.EgressFilter = &appmesh.EgressFilter{Type: appmesh.EgressFilterType(p.EgressFilter.Type)}
+	}
+	return s
+}
+
+// GenerateCreateMeshInput returns the create input of a mesh with the given
+// name and parameters.
+func GenerateCreateMeshInput(name string, p v1alpha1.MeshParameters) *appmesh.CreateMeshInput {
+	return &appmesh.CreateMeshInput{
+		MeshName: aws.String(name),
+		Spec:     GenerateMeshSpec(p),
+		Tags:     GenerateTags(p.Tags),
+	}
+}
+
+// GenerateMeshObservation returns the observation of the given mesh.
+func GenerateMeshObservation(o appmesh.MeshData) v1alpha1.MeshObservation {
+	res := v1alpha1.MeshObservation{}
+	if m := o.Metadata; m != nil {
+		res.ARN = aws.StringValue(m.Arn)
+		res.UID = aws.StringValue(m.Uid)
+		res.MeshOwner = aws.StringValue(m.MeshOwner)
+		res.ResourceOwner = aws.StringValue(m.ResourceOwner)
+		res.Version = aws.Int64Value(m.Version)
+	}
+	if o.Status != nil {
+		res.Status = string(o.Status.Status)
+	}
+	return res
+}
+
+// LateInitializeMesh fills the empty fields of the mesh parameters with the
+// values of the observed mesh.
+func LateInitializeMesh(in *v1alpha1.MeshParameters, o *appmesh.MeshData) {
+	if o == nil || o.Spec == nil {
+		return
+	}
+	if in.EgressFilter == nil && o.Spec.EgressFilter != nil {
+		in.EgressFilter = &v1alpha1.EgressFilter{Type: string(o.Spec.EgressFilter.Type)}
+	}
+}
+
+// IsMeshUpToDate returns true if the observed mesh matches the parameters.
+func IsMeshUpToDate(p v1alpha1.MeshParameters, o appmesh.MeshData) bool {
+	if p.EgressFilter == nil {
+		return true
+	}
+	return o.Spec != nil && o.Spec.EgressFilter != nil && string(o.Spec.EgressFilter.Type) == p.EgressFilter.Type
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appmesh
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+)
+
+// RouteClient is the external client used for Route Custom Resource
+type RouteClient interface {
+	DescribeRouteRequest(*appmesh.DescribeRouteInput) appmesh.DescribeRouteRequest
+	CreateRouteRequest(*appmesh.CreateRouteInput) appmesh.CreateRouteRequest
+	UpdateRouteRequest(*appmesh.UpdateRouteInput) appmesh.UpdateRouteRequest
+	DeleteRouteRequest(*appmesh.DeleteRouteInput) appmesh.DeleteRouteRequest
+	ListTagsForResourceRequest(*appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest
+	TagResourceRequest(*appmesh.TagResourceInput) appmesh.TagResourceRequest
+	UntagResourceRequest(*appmesh.UntagResourceInput) appmesh.UntagResourceRequest
+}
+
+// NewRouteClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewRouteClient(cfg aws.Config) RouteClient {
+	return appmesh.New(cfg)
+}
+
+// GenerateRouteSpec returns the App Mesh spec of a route with the given
+// parameters.
+func GenerateRouteSpec(p v1alpha1.RouteParameters) *appmesh.RouteSpec {
+	s := &appmesh.RouteSpec{
+		Priority:   p.Priority,
+		HttpRoute:  generateHTTPRoute(p.HTTPRoute),
+		Http2Route: generateHTTPRoute(p.HTTP2Route),
+	}
+	if r := p.GRPCRoute; r != nil {
+		s.GrpcRoute = &appmesh.GrpcRoute{
+			Action: &appmesh.GrpcRouteAction{WeightedTargets: generateWeightedTargets(r.Action.WeightedTargets)},
+			Match: &appmesh.GrpcRouteMatch{
+				ServiceName: r.Match.ServiceName,
+				MethodName:  r.Match.MethodName,
+			},
+		}
+		for _, m := range r.Match.Metadata {
+			s.GrpcRoute.Match.Metadata = append(s.GrpcRoute.Match.Metadata, appmesh.GrpcRouteMetadata{
+				Name:   aws.String(m.Name),
+				Invert: m.Invert,
+				Match:  (*appmesh.GrpcRouteMetadataMatchMethod)(generateHeaderMatchMethod(m.Match)),
+			})
+		}
+		if rp := r.RetryPolicy; rp != nil {
+			s.GrpcRoute.RetryPolicy = &appmesh.GrpcRetryPolicy{
+				MaxRetries:      aws.Int64(rp.MaxRetries),
+				PerRetryTimeout: generateDuration(rp.PerRetryTimeout),
+				HttpRetryEvents: rp.HTTPRetryEvents,
+				TcpRetryEvents:  generateTCPRetryEvents(rp.TCPRetryEvents),
+			}
+			for _, e := range rp.GRPCRetryEvents {
+				s.GrpcRoute.RetryPolicy.GrpcRetryEvents = append(s.GrpcRoute.RetryPolicy.GrpcRetryEvents, appmesh.GrpcRetryPolicyEvent(e))
+			}
+		}
+	}
+	if r := p.TCPRoute; r != nil {
+		s.TcpRoute = &appmesh.TcpRoute{
+			Action: &appmesh.TcpRouteAction{WeightedTargets: generateWeightedTargets(r.Action.WeightedTargets)},
+		}
+	}
+	return s
+}
+
+// GenerateCreateRouteInput returns the create input of a route with the
+// given name and parameters.
+func GenerateCreateRouteInput(name string, p v1alpha1.RouteParameters) *appmesh.CreateRouteInput {
+	return &appmesh.CreateRouteInput{
+		RouteName:         aws.String(name),
+		MeshName:          p.MeshName,
+		MeshOwner:         p.MeshOwner,
+		VirtualRouterName: p.VirtualRouterName,
+		Spec:              GenerateRouteSpec(p),
+		Tags:              GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateRouteInput returns the update input of a route with the
+// given name and parameters.
+func GenerateUpdateRouteInput(name string, p v1alpha1.RouteParameters) *appmesh.UpdateRouteInput {
+	return &appmesh.UpdateRouteInput{
+		RouteName:         aws.String(name),
+		MeshName:          p.MeshName,
+		MeshOwner:         p.MeshOwner,
+		VirtualRouterName: p.VirtualRouterName,
+		Spec:              GenerateRouteSpec(p),
+	}
+}
+
+// GenerateRouteObservation returns the observation of the given route.
+func GenerateRouteObservation(o appmesh.RouteData) v1alpha1.RouteObservation {
+	res := v1alpha1.RouteObservation{}
+	if m := o.Metadata; m != nil {
+		res.ARN = aws.StringValue(m.Arn)
+		res.UID = aws.StringValue(m.Uid)
+		res.MeshOwner = aws.StringValue(m.MeshOwner)
+		res.ResourceOwner = aws.StringValue(m.ResourceOwner)
+		res.Version = aws.Int64Value(m.Version)
+	}
+	if o.Status != nil {
+		res.Status = string(o.Status.Status)
+	}
+	return res
+}
+
+// IsRouteUpToDate returns true if the observed route matches the
+// parameters.
+func IsRouteUpToDate(p v1alpha1.RouteParameters, o appmesh.RouteData) bool {
+	return cmp.Equal(GenerateRouteSpec(p), o.Spec, cmpopts.EquateEmpty())
+}
+
+func generateHTTPRoute(r *v1alpha1.HTTPRoute) *appmesh.HttpRoute {
+	if r == nil {
+		return nil
+	}
+	res := &appmesh.HttpRoute{
+		Action: &appmesh.HttpRouteAction{WeightedTargets: generateWeightedTargets(r.Action.WeightedTargets)},
+		Match: &appmesh.HttpRouteMatch{
+			Prefix: aws.String(r.Match.Prefix),
+			Method: appmesh.HttpMethod(aws.StringValue(r.Match.Method)),
+			Scheme: appmesh.HttpScheme(aws.StringValue(r.Match.Scheme)),
+		},
+	}
+	for _, h := range r.Match.Headers {
+		res.Match.Headers = append(res.Match.Headers, appmesh.HttpRouteHeader{
+			Name:   aws.String(h.Name),
+			Invert: h.Invert,
+			Match:  generateHeaderMatchMethod(h.Match),
+		})
+	}
+	if rp := r.RetryPolicy; rp != nil {
+		res.RetryPolicy = &appmesh.HttpRetryPolicy{
+			MaxRetries:      aws.Int64(rp.MaxRetries),
+			PerRetryTimeout: generateDuration(rp.PerRetryTimeout),
+			HttpRetryEvents: rp.HTTPRetryEvents,
+			TcpRetryEvents:  generateTCPRetryEvents(rp.TCPRetryEvents),
+		}
+	}
+	return res
+}
+
+func generateWeightedTargets(ts []v1alpha1.WeightedTarget) []appmesh.WeightedTarget {
+	if len(ts) == 0 {
+		return nil
+	}
+	res := make([]appmesh.WeightedTarget, len(ts))
+	for i, t := range ts {
+		res[i] = appmesh.WeightedTarget{
+			VirtualNode: t.VirtualNodeName,
+			Weight:      aws.Int64(t.Weight),
+		}
+	}
+	return res
+}
+
+func generateHeaderMatchMethod(m *v1alpha1.HeaderMatchMethod) *appmesh.HeaderMatchMethod {
+	if m == nil {
+		return nil
+	}
+	res := &appmesh.HeaderMatchMethod{
+		Exact:  m.Exact,
+		Prefix: m.Prefix,
+		Suffix: m.Suffix,
+		Regex:  m.Regex,
+	}
+	if r := m.Range; r != nil {
+		res.Range = &appmesh.MatchRange{Start: aws.Int64(r.Start), End: aws.Int64(r.End)}
+	}
+	return res
+}
+
+func generateDuration(d v1alpha1.Duration) *appmesh.Duration {
+	return &appmesh.Duration{Unit: appmesh.DurationUnit(d.Unit), Value: aws.Int64(d.Value)}
+}
+
+func generateTCPRetryEvents(es []v1alpha1.TCPRetryEvent) []appmesh.TcpRetryPolicyEvent {
+	if len(es) == 0 {
+		return nil
+	}
+	res := make([]appmesh.TcpRetryPolicyEvent, len(es))
+	for i, e := range es {
+		res[i] = appmesh.TcpRetryPolicyEvent(e)
+	}
+	return res
+}
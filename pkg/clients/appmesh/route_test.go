@@ -0,0 +1,195 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appmesh
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+)
+
+func routeParameters() v1alpha1.RouteParameters {
+	return v1alpha1.RouteParameters{
+		MeshName:          aws.String("some-mesh"),
+		VirtualRouterName: aws.String("some-router"),
+		Priority:          aws.Int64(10),
+		HTTPRoute: &v1alpha1.HTTPRoute{
+			Action: v1alpha1.RouteAction{WeightedTargets: []v1alpha1.WeightedTarget{
+				{VirtualNodeName: aws.String("blue"), Weight: 90},
+				{VirtualNodeName: aws.String("green"), Weight: 10},
+			}},
+			Match: v1alpha1.HTTPRouteMatch{
+				Prefix: "/",
+				Method: aws.String("GET"),
+				Headers: []v1alpha1.HTTPRouteHeader{{
+					Name:  "x-canary",
+					Match: &v1alpha1.HeaderMatchMethod{Exact: aws.String("true")},
+				}},
+			},
+			RetryPolicy: &v1alpha1.HTTPRetryPolicy{
+				MaxRetries:      3,
+				PerRetryTimeout: v1alpha1.Duration{Unit: "s", Value: 5},
+				HTTPRetryEvents: []string{"server-error"},
+				TCPRetryEvents:  []v1alpha1.TCPRetryEvent{"connection-error"},
+			},
+		},
+	}
+}
+
+func observedRoute() appmesh.RouteData {
+	return appmesh.RouteData{
+		MeshName:          aws.String("some-mesh"),
+		VirtualRouterName: aws.String("some-router"),
+		RouteName:         aws.String("some-route"),
+		Spec: &appmesh.RouteSpec{
+			Priority: aws.Int64(10),
+			HttpRoute: &appmesh.HttpRoute{
+				Action: &appmesh.HttpRouteAction{WeightedTargets: []appmesh.WeightedTarget{
+					{VirtualNode: aws.String("blue"), Weight: aws.Int64(90)},
+					{VirtualNode: aws.String("green"), Weight: aws.Int64(10)},
+				}},
+				Match: &appmesh.HttpRouteMatch{
+					Prefix: aws.String("/"),
+					Method: appmesh.HttpMethodGet,
+					Headers: []appmesh.HttpRouteHeader{{
+						Name:  aws.String("x-canary"),
+						Match: &appmesh.HeaderMatchMethod{Exact: aws.String("true")},
+					}},
+				},
+				RetryPolicy: &appmesh.HttpRetryPolicy{
+					MaxRetries:      aws.Int64(3),
+					PerRetryTimeout: &appmesh.Duration{Unit: appmesh.DurationUnitS, Value: aws.Int64(5)},
+					HttpRetryEvents: []string{"server-error"},
+					TcpRetryEvents:  []appmesh.TcpRetryPolicyEvent{appmesh.TcpRetryPolicyEventConnectionError},
+				},
+			},
+		},
+	}
+}
+
+func TestIsRouteUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    func(*v1alpha1.RouteParameters)
+		want bool
+	}{
+		"UpToDate": {
+			p:    func(*v1alpha1.RouteParameters) {},
+			want: true,
+		},
+		"WeightChanged": {
+			p: func(p *v1alpha1.RouteParameters) {
+				p.HTTPRoute.Action.WeightedTargets[0].Weight = 50
+				p.HTTPRoute.Action.WeightedTargets[1].Weight = 50
+			},
+			want: false,
+		},
+		"HeaderMatchChanged": {
+			p: func(p *v1alpha1.RouteParameters) {
+				p.HTTPRoute.Match.Headers[0].Match = &v1alpha1.HeaderMatchMethod{Prefix: aws.String("t")}
+			},
+			want: false,
+		},
+		"RetryPolicyRemoved": {
+			p: func(p *v1alpha1.RouteParameters) {
+				p.HTTPRoute.RetryPolicy = nil
+			},
+			want: false,
+		},
+		"PriorityChanged": {
+			p: func(p *v1alpha1.RouteParameters) {
+				p.Priority = aws.Int64(20)
+			},
+			want: false,
+		},
+		"ChangedToHTTP2": {
+			p: func(p *v1alpha1.RouteParameters) {
+				p.HTTP2Route, p.HTTPRoute = p.HTTPRoute, nil
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := routeParameters()
+			tc.p(&p)
+			got := IsRouteUpToDate(p, observedRoute())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRouteSpec(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.RouteParameters
+		want *appmesh.RouteSpec
+	}{
+		"GRPCRoute": {
+			p: v1alpha1.RouteParameters{GRPCRoute: &v1alpha1.GRPCRoute{
+				Action: v1alpha1.RouteAction{WeightedTargets: []v1alpha1.WeightedTarget{{VirtualNodeName: aws.String("blue"), Weight: 1}}},
+				Match: v1alpha1.GRPCRouteMatch{
+					ServiceName: aws.String("pkg.Service"),
+					Metadata: []v1alpha1.GRPCRouteMetadata{{
+						Name:  "tenant",
+						Match: &v1alpha1.HeaderMatchMethod{Range: &v1alpha1.MatchRange{Start: 1, End: 10}},
+					}},
+				},
+				RetryPolicy: &v1alpha1.GRPCRetryPolicy{
+					MaxRetries:      2,
+					PerRetryTimeout: v1alpha1.Duration{Unit: "ms", Value: 500},
+					GRPCRetryEvents: []v1alpha1.GRPCRetryEvent{"unavailable"},
+				},
+			}},
+			want: &appmesh.RouteSpec{GrpcRoute: &appmesh.GrpcRoute{
+				Action: &appmesh.GrpcRouteAction{WeightedTargets: []appmesh.WeightedTarget{{VirtualNode: aws.String("blue"), Weight: aws.Int64(1)}}},
+				Match: &appmesh.GrpcRouteMatch{
+					ServiceName: aws.String("pkg.Service"),
+					Metadata: []appmesh.GrpcRouteMetadata{{
+						Name:  aws.String("tenant"),
+						Match: &appmesh.GrpcRouteMetadataMatchMethod{Range: &appmesh.MatchRange{Start: aws.Int64(1), End: aws.Int64(10)}},
+					}},
+				},
+				RetryPolicy: &appmesh.GrpcRetryPolicy{
+					MaxRetries:      aws.Int64(2),
+					PerRetryTimeout: &appmesh.Duration{Unit: appmesh.DurationUnitMs, Value: aws.Int64(500)},
+					GrpcRetryEvents: []appmesh.GrpcRetryPolicyEvent{appmesh.GrpcRetryPolicyEventUnavailable},
+				},
+			}},
+		},
+		"TCPRoute": {
+			p: v1alpha1.RouteParameters{TCPRoute: &v1alpha1.TCPRoute{
+				Action: v1alpha1.RouteAction{WeightedTargets: []v1alpha1.WeightedTarget{{VirtualNodeName: aws.String("blue"), Weight: 1}}},
+			}},
+			want: &appmesh.RouteSpec{TcpRoute: &appmesh.TcpRoute{
+				Action: &appmesh.TcpRouteAction{WeightedTargets: []appmesh.WeightedTarget{{VirtualNode: aws.String("blue"), Weight: aws.Int64(1)}}},
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRouteSpec(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
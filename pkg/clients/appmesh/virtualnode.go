@@ -0,0 +1,266 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appmesh
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+)
+
+// VirtualNodeClient is the external client used for VirtualNode Custom
+// Resource
+type VirtualNodeClient interface {
+	DescribeVirtualNodeRequest(*appmesh.DescribeVirtualNodeInput) appmesh.DescribeVirtualNodeRequest
+	CreateVirtualNodeRequest(*appmesh.CreateVirtualNodeInput) appmesh.CreateVirtualNodeRequest
+	UpdateVirtualNodeRequest(*appmesh.UpdateVirtualNodeInput) appmesh.UpdateVirtualNodeRequest
+	DeleteVirtualNodeRequest(*appmesh.DeleteVirtualNodeInput) appmesh.DeleteVirtualNodeRequest
+	ListTagsForResourceRequest(*appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest
+	TagResourceRequest(*appmesh.TagResourceInput) appmesh.TagResourceRequest
+	UntagResourceRequest(*appmesh.UntagResourceInput) appmesh.UntagResourceRequest
+}
+
+// NewVirtualNodeClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewVirtualNodeClient(cfg aws.Config) VirtualNodeClient {
+	return appmesh.New(cfg)
+}
+
+// GenerateVirtualNodeSpec returns the App Mesh spec of a virtual node with
+// the given parameters.
+func GenerateVirtualNodeSpec(p v1alpha1.VirtualNodeParameters) *appmesh.VirtualNodeSpec {
+	s := &appmesh.VirtualNodeSpec{
+		Listeners:        generateListeners(p.Listeners),
+		Backends:         generateBackends(p.Backends),
+		ServiceDiscovery: generateServiceDiscovery(p.ServiceDiscovery),
+		Logging:          generateLogging(p.Logging),
+	}
+	if p.BackendDefaults != nil {
+		s.BackendDefaults = &appmesh.BackendDefaults{ClientPolicy: generateClientPolicy(p.BackendDefaults.ClientPolicy)}
+	}
+	return s
+}
+
+// GenerateCreateVirtualNodeInput returns the create input of a virtual node
+// with the given name and parameters.
+func GenerateCreateVirtualNodeInput(name string, p v1alpha1.VirtualNodeParameters) *appmesh.CreateVirtualNodeInput {
+	return &appmesh.CreateVirtualNodeInput{
+		VirtualNodeName: aws.String(name),
+		MeshName:        p.MeshName,
+		MeshOwner:       p.MeshOwner,
+		Spec:            GenerateVirtualNodeSpec(p),
+		Tags:            GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateVirtualNodeInput returns the update input of a virtual node
+// with the given name and parameters.
+func GenerateUpdateVirtualNodeInput(name string, p v1alpha1.VirtualNodeParameters) *appmesh.UpdateVirtualNodeInput {
+	return &appmesh.UpdateVirtualNodeInput{
+		VirtualNodeName: aws.String(name),
+		MeshName:        p.MeshName,
+		MeshOwner:       p.MeshOwner,
+		Spec:            GenerateVirtualNodeSpec(p),
+	}
+}
+
+// GenerateVirtualNodeObservation returns the observation of the given
+// virtual node.
+func GenerateVirtualNodeObservation(o appmesh.VirtualNodeData) v1alpha1.VirtualNodeObservation {
+	res := v1alpha1.VirtualNodeObservation{}
+	if m := o.Metadata; m != nil {
+		res.ARN = aws.StringValue(m.Arn)
+		res.UID = aws.StringValue(m.Uid)
+		res.MeshOwner = aws.StringValue(m.MeshOwner)
+		res.ResourceOwner = aws.StringValue(m.ResourceOwner)
+		res.Version = aws.Int64Value(m.Version)
+	}
+	if o.Status != nil {
+		res.Status = string(o.Status.Status)
+	}
+	return res
+}
+
+// LateInitializeVirtualNode fills the empty fields of the virtual node
+// parameters with the defaults that App Mesh applied to the observed virtual
+// node. Listeners and backends are matched by their position.
+func LateInitializeVirtualNode(in *v1alpha1.VirtualNodeParameters, o *appmesh.VirtualNodeData) {
+	if o == nil || o.Spec == nil {
+		return
+	}
+	for i := range in.Listeners {
+		if i >= len(o.Spec.Listeners) {
+			break
+		}
+		// The health check port defaults to the port of the listener, so it
+		// is only late initialized while the listener port is unchanged.
+		l, ol := in.Listeners[i], o.Spec.Listeners[i]
+		if ol.PortMapping == nil || aws.Int64Value(ol.PortMapping.Port) != l.PortMapping.Port {
+			continue
+		}
+		hc, ohc := l.HealthCheck, ol.HealthCheck
+		if hc != nil && hc.Port == nil && ohc != nil {
+			hc.Port = ohc.Port
+		}
+	}
+	for i := range in.Backends {
+		if i >= len(o.Spec.Backends) {
+			break
+		}
+		vs, ovs := in.Backends[i].VirtualService, o.Spec.Backends[i].VirtualService
+		if vs != nil && ovs != nil {
+			lateInitializeClientPolicy(vs.ClientPolicy, ovs.ClientPolicy)
+		}
+	}
+	if in.BackendDefaults != nil && o.Spec.BackendDefaults != nil {
+		lateInitializeClientPolicy(in.BackendDefaults.ClientPolicy, o.Spec.BackendDefaults.ClientPolicy)
+	}
+}
+
+// IsVirtualNodeUpToDate returns true if the observed virtual node matches the
+// parameters.
+func IsVirtualNodeUpToDate(p v1alpha1.VirtualNodeParameters, o appmesh.VirtualNodeData) bool {
+	return cmp.Equal(GenerateVirtualNodeSpec(p), o.Spec, cmpopts.EquateEmpty())
+}
+
+func lateInitializeClientPolicy(in *v1alpha1.ClientPolicy, o *appmesh.Policy) {
+	if in == nil || in.TLS == nil || o == nil || o.Tls == nil {
+		return
+	}
+	if in.TLS.Enforce == nil {
+		in.TLS.Enforce = o.Tls.Enforce
+	}
+}
+
+func generateListeners(ls []v1alpha1.Listener) []appmesh.Listener {
+	if len(ls) == 0 {
+		return nil
+	}
+	res := make([]appmesh.Listener, len(ls))
+	for i, l := range ls {
+		res[i] = appmesh.Listener{
+			PortMapping: &appmesh.PortMapping{
+				Port:     aws.Int64(l.PortMapping.Port),
+				Protocol: appmesh.PortProtocol(l.PortMapping.Protocol),
+			},
+		}
+		if hc := l.HealthCheck; hc != nil {
+			res[i].HealthCheck = &appmesh.HealthCheckPolicy{
+				HealthyThreshold:   aws.Int64(hc.HealthyThreshold),
+				UnhealthyThreshold: aws.Int64(hc.UnhealthyThreshold),
+				IntervalMillis:     aws.Int64(hc.IntervalMillis),
+				TimeoutMillis:      aws.Int64(hc.TimeoutMillis),
+				Protocol:           appmesh.PortProtocol(hc.Protocol),
+				Path:               hc.Path,
+				Port:               hc.Port,
+			}
+		}
+		if t := l.TLS; t != nil {
+			res[i].Tls = &appmesh.ListenerTls{
+				Mode:        appmesh.ListenerTlsMode(t.Mode),
+				Certificate: &appmesh.ListenerTlsCertificate{},
+			}
+			if c := t.Certificate.ACM; c != nil {
+				res[i].Tls.Certificate.Acm = &appmesh.ListenerTlsAcmCertificate{CertificateArn: aws.String(c.CertificateARN)}
+			}
+			if c := t.Certificate.File; c != nil {
+				res[i].Tls.Certificate.File = &appmesh.ListenerTlsFileCertificate{
+					CertificateChain: aws.String(c.CertificateChain),
+					PrivateKey:       aws.String(c.PrivateKey),
+				}
+			}
+		}
+	}
+	return res
+}
+
+func generateClientPolicy(p *v1alpha1.ClientPolicy) *appmesh.Policy {
+	if p == nil {
+		return nil
+	}
+	res := &appmesh.Policy{}
+	if t := p.TLS; t != nil {
+		res.Tls = &appmesh.PolicyTls{
+			Enforce:    t.Enforce,
+			Ports:      t.Ports,
+			Validation: &appmesh.TlsValidationContext{Trust: &appmesh.TlsValidationContextTrust{}},
+		}
+		if a := t.Validation.Trust.ACM; a != nil {
+			res.Tls.Validation.Trust.Acm = &appmesh.TlsValidationContextAcmTrust{CertificateAuthorityArns: a.CertificateAuthorityARNs}
+		}
+		if f := t.Validation.Trust.File; f != nil {
+			res.Tls.Validation.Trust.File = &appmesh.TlsValidationContextFileTrust{CertificateChain: aws.String(f.CertificateChain)}
+		}
+	}
+	return res
+}
+
+func generateBackends(bs []v1alpha1.Backend) []appmesh.Backend {
+	if len(bs) == 0 {
+		return nil
+	}
+	res := make([]appmesh.Backend, len(bs))
+	for i, b := range bs {
+		if vs := b.VirtualService; vs != nil {
+			res[i].VirtualService = &appmesh.VirtualServiceBackend{
+				VirtualServiceName: aws.String(vs.VirtualServiceName),
+				ClientPolicy:       generateClientPolicy(vs.ClientPolicy),
+			}
+		}
+	}
+	return res
+}
+
+func generateServiceDiscovery(sd *v1alpha1.ServiceDiscovery) *appmesh.ServiceDiscovery {
+	if sd == nil {
+		return nil
+	}
+	res := &appmesh.ServiceDiscovery{}
+	if d := sd.DNS; d != nil {
+		res.Dns = &appmesh.DnsServiceDiscovery{Hostname: aws.String(d.Hostname)}
+	}
+	if m := sd.AWSCloudMap; m != nil {
+		res.AwsCloudMap = &appmesh.AwsCloudMapServiceDiscovery{
+			NamespaceName: aws.String(m.NamespaceName),
+			ServiceName:   aws.String(m.ServiceName),
+		}
+		for _, a := range m.Attributes {
+			res.AwsCloudMap.Attributes = append(res.AwsCloudMap.Attributes, appmesh.AwsCloudMapInstanceAttribute{
+				Key:   aws.String(a.Key),
+				Value: aws.String(a.Value),
+			})
+		}
+	}
+	return res
+}
+
+func generateLogging(l *v1alpha1.Logging) *appmesh.Logging {
+	if l == nil {
+		return nil
+	}
+	res := &appmesh.Logging{}
+	if l.AccessLog != nil {
+		res.AccessLog = &appmesh.AccessLog{}
+		if f := l.AccessLog.File; f != nil {
+			res.AccessLog.File = &appmesh.FileAccessLog{Path: aws.String(f.Path)}
+		}
+	}
+	return res
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appmesh
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+)
+
+func virtualNodeParameters() v1alpha1.VirtualNodeParameters {
+	return v1alpha1.VirtualNodeParameters{
+		MeshName: aws.String("some-mesh"),
+		Listeners: []v1alpha1.Listener{{
+			PortMapping: v1alpha1.PortMapping{Port: 8080, Protocol: "http"},
+			HealthCheck: &v1alpha1.HealthCheckPolicy{
+				HealthyThreshold:   2,
+				UnhealthyThreshold: 2,
+				IntervalMillis:     5000,
+				TimeoutMillis:      2000,
+				Protocol:           "http",
+				Path:               aws.String("/ping"),
+			},
+		}},
+		Backends: []v1alpha1.Backend{{
+			VirtualService: &v1alpha1.VirtualServiceBackend{
+				VirtualServiceName: "backend.local",
+				ClientPolicy: &v1alpha1.ClientPolicy{TLS: &v1alpha1.ClientPolicyTLS{
+					Validation: v1alpha1.TLSValidationContext{Trust: v1alpha1.TLSValidationContextTrust{
+						File: &v1alpha1.TLSValidationContextFileTrust{CertificateChain: "/certs/ca.pem"},
+					}},
+				}},
+			},
+		}},
+		ServiceDiscovery: &v1alpha1.ServiceDiscovery{DNS: &v1alpha1.DNSServiceDiscovery{Hostname: "app.local"}},
+	}
+}
+
+func observedVirtualNode() appmesh.VirtualNodeData {
+	return appmesh.VirtualNodeData{
+		MeshName:        aws.String("some-mesh"),
+		VirtualNodeName: aws.String("some-node"),
+		Spec: &appmesh.VirtualNodeSpec{
+			Listeners: []appmesh.Listener{{
+				PortMapping: &appmesh.PortMapping{Port: aws.Int64(8080), Protocol: appmesh.PortProtocolHttp},
+				HealthCheck: &appmesh.HealthCheckPolicy{
+					HealthyThreshold:   aws.Int64(2),
+					UnhealthyThreshold: aws.Int64(2),
+					IntervalMillis:     aws.Int64(5000),
+					TimeoutMillis:      aws.Int64(2000),
+					Protocol:           appmesh.PortProtocolHttp,
+					Path:               aws.String("/ping"),
+					Port:               aws.Int64(8080),
+				},
+			}},
+			Backends: []appmesh.Backend{{
+				VirtualService: &appmesh.VirtualServiceBackend{
+					VirtualServiceName: aws.String("backend.local"),
+					ClientPolicy: &appmesh.Policy{Tls: &appmesh.PolicyTls{
+						Enforce: aws.Bool(true),
+						Validation: &appmesh.TlsValidationContext{Trust: &appmesh.TlsValidationContextTrust{
+							File: &appmesh.TlsValidationContextFileTrust{CertificateChain: aws.String("/certs/ca.pem")},
+						}},
+					}},
+				},
+			}},
+			ServiceDiscovery: &appmesh.ServiceDiscovery{Dns: &appmesh.DnsServiceDiscovery{Hostname: aws.String("app.local")}},
+		},
+	}
+}
+
+func TestLateInitializeVirtualNode(t *testing.T) {
+	want := virtualNodeParameters()
+	want.Listeners[0].HealthCheck.Port = aws.Int64(8080)
+	want.Backends[0].VirtualService.ClientPolicy.TLS.Enforce = aws.Bool(true)
+
+	got := virtualNodeParameters()
+	o := observedVirtualNode()
+	LateInitializeVirtualNode(&got, &o)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsVirtualNodeUpToDate(t *testing.T) {
+	lateInitialized := func() v1alpha1.VirtualNodeParameters {
+		p := virtualNodeParameters()
+		o := observedVirtualNode()
+		LateInitializeVirtualNode(&p, &o)
+		return p
+	}
+	cases := map[string]struct {
+		p    v1alpha1.VirtualNodeParameters
+		o    appmesh.VirtualNodeData
+		want bool
+	}{
+		"UpToDate": {
+			p:    lateInitialized(),
+			o:    observedVirtualNode(),
+			want: true,
+		},
+		"DefaultsNotLateInitialized": {
+			p:    virtualNodeParameters(),
+			o:    observedVirtualNode(),
+			want: false,
+		},
+		"ListenerPortChanged": {
+			p: func() v1alpha1.VirtualNodeParameters {
+				p := lateInitialized()
+				p.Listeners[0].PortMapping.Port = 9090
+				return p
+			}(),
+			o:    observedVirtualNode(),
+			want: false,
+		},
+		"BackendRemoved": {
+			p: func() v1alpha1.VirtualNodeParameters {
+				p := lateInitialized()
+				p.Backends = nil
+				return p
+			}(),
+			o:    observedVirtualNode(),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVirtualNodeUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
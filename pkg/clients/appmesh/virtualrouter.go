@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appmesh
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+)
+
+// VirtualRouterClient is the external client used for VirtualRouter Custom
+// Resource
+type VirtualRouterClient interface {
+	DescribeVirtualRouterRequest(*appmesh.DescribeVirtualRouterInput) appmesh.DescribeVirtualRouterRequest
+	CreateVirtualRouterRequest(*appmesh.CreateVirtualRouterInput) appmesh.CreateVirtualRouterRequest
+	UpdateVirtualRouterRequest(*appmesh.UpdateVirtualRouterInput) appmesh.UpdateVirtualRouterRequest
+	DeleteVirtualRouterRequest(*appmesh.DeleteVirtualRouterInput) appmesh.DeleteVirtualRouterRequest
+	ListTagsForResourceRequest(*appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest
+	TagResourceRequest(*appmesh.TagResourceInput) appmesh.TagResourceRequest
+	UntagResourceRequest(*appmesh.UntagResourceInput) appmesh.UntagResourceRequest
+}
+
+// NewVirtualRouterClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewVirtualRouterClient(cfg aws.Config) VirtualRouterClient {
+	return appmesh.New(cfg)
+}
+
+// GenerateVirtualRouterSpec returns the App Mesh spec of a virtual router
+// with the given parameters.
+func GenerateVirtualRouterSpec(p v1alpha1.VirtualRouterParameters) *appmesh.VirtualRouterSpec {
+	s := &appmesh.VirtualRouterSpec{}
+	for _, l := range p.Listeners {
+		s.Listeners = append(s.Listeners, appmesh.VirtualRouterListener{
+			PortMapping: &appmesh.PortMapping{
+				Port:     aws.Int64(l.PortMapping.Port),
+				Protocol: appmesh.PortProtocol(l.PortMapping.Protocol),
+			},
+		})
+	}
+	return s
+}
+
+// GenerateCreateVirtualRouterInput returns the create input of a virtual
+// router with the given name and parameters.
+func GenerateCreateVirtualRouterInput(name string, p v1alpha1.VirtualRouterParameters) *appmesh.CreateVirtualRouterInput {
+	return &appmesh.CreateVirtualRouterInput{
+		VirtualRouterName: aws.String(name),
+		MeshName:          p.MeshName,
+		MeshOwner:         p.MeshOwner,
+		Spec:              GenerateVirtualRouterSpec(p),
+		Tags:              GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateVirtualRouterInput returns the update input of a virtual
+// router with the given name and parameters.
+func GenerateUpdateVirtualRouterInput(name string, p v1alpha1.VirtualRouterParameters) *appmesh.UpdateVirtualRouterInput {
+	return &appmesh.UpdateVirtualRouterInput{
+		VirtualRouterName: aws.String(name),
+		MeshName:          p.MeshName,
+		MeshOwner:         p.MeshOwner,
+		Spec:              GenerateVirtualRouterSpec(p),
+	}
+}
+
+// GenerateVirtualRouterObservation returns the observation of the given
+// virtual router.
+func GenerateVirtualRouterObservation(o appmesh.VirtualRouterData) v1alpha1.VirtualRouterObservation {
+	res := v1alpha1.VirtualRouterObservation{}
+	if m := o.Metadata; m != nil {
+		res.ARN = aws.StringValue(m.Arn)
+		res.UID = aws.StringValue(m.Uid)
+		res.MeshOwner = aws.StringValue(m.MeshOwner)
+		res.ResourceOwner = aws.StringValue(m.ResourceOwner)
+		res.Version = aws.Int64Value(m.Version)
+	}
+	if o.Status != nil {
+		res.Status = string(o.Status.Status)
+	}
+	return res
+}
+
+// IsVirtualRouterUpToDate returns true if the observed virtual router
+// matches the parameters.
+func IsVirtualRouterUpToDate(p v1alpha1.VirtualRouterParameters, o appmesh.VirtualRouterData) bool {
+	return cmp.Equal(GenerateVirtualRouterSpec(p), o.Spec, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appmesh
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+)
+
+func TestIsVirtualRouterUpToDate(t *testing.T) {
+	observed := appmesh.VirtualRouterData{
+		MeshName:          aws.String("some-mesh"),
+		VirtualRouterName: aws.String("some-router"),
+		Spec: &appmesh.VirtualRouterSpec{Listeners: []appmesh.VirtualRouterListener{{
+			PortMapping: &appmesh.PortMapping{Port: aws.Int64(8080), Protocol: appmesh.PortProtocolHttp},
+		}}},
+	}
+	listener := func(port int64, protocol string) []v1alpha1.VirtualRouterListener {
+		return []v1alpha1.VirtualRouterListener{{PortMapping: v1alpha1.PortMapping{Port: port, Protocol: protocol}}}
+	}
+	cases := map[string]struct {
+		p    v1alpha1.VirtualRouterParameters
+		o    appmesh.VirtualRouterData
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.VirtualRouterParameters{Listeners: listener(8080, "http")},
+			o:    observed,
+			want: true,
+		},
+		"PortChanged": {
+			p:    v1alpha1.VirtualRouterParameters{Listeners: listener(9090, "http")},
+			o:    observed,
+			want: false,
+		},
+		"ProtocolChanged": {
+			p:    v1alpha1.VirtualRouterParameters{Listeners: listener(8080, "grpc")},
+			o:    observed,
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVirtualRouterUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appmesh
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+)
+
+// VirtualServiceClient is the external client used for VirtualService Custom
+// Resource
+type VirtualServiceClient interface {
+	DescribeVirtualServiceRequest(*appmesh.DescribeVirtualServiceInput) appmesh.DescribeVirtualServiceRequest
+	CreateVirtualServiceRequest(*appmesh.CreateVirtualServiceInput) appmesh.CreateVirtualServiceRequest
+	UpdateVirtualServiceRequest(*appmesh.UpdateVirtualServiceInput) appmesh.UpdateVirtualServiceRequest
+	DeleteVirtualServiceRequest(*appmesh.DeleteVirtualServiceInput) appmesh.DeleteVirtualServiceRequest
+	ListTagsForResourceRequest(*appmesh.ListTagsForResourceInput) appmesh.ListTagsForResourceRequest
+	TagResourceRequest(*appmesh.TagResourceInput) appmesh.TagResourceRequest
+	UntagResourceRequest(*appmesh.UntagResourceInput) appmesh.UntagResourceRequest
+}
+
+// NewVirtualServiceClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewVirtualServiceClient(cfg aws.Config) VirtualServiceClient {
+	return appmesh.New(cfg)
+}
+
+// GenerateVirtualServiceSpec returns the App Mesh spec of a virtual service
+// with the given parameters.
+func GenerateVirtualServiceSpec(p v1alpha1.VirtualServiceParameters) *appmesh.VirtualServiceSpec {
+	s := &appmesh.VirtualServiceSpec{}
+	if p.Provider == nil {
+		return s
+	}
+	s.Provider = &appmesh.VirtualServiceProvider{}
+	if vn := p.Provider.VirtualNode; vn != nil {
+		s.Provider.VirtualNode = &appmesh.VirtualNodeServiceProvider{VirtualNodeName: vn.VirtualNodeName}
+	}
+	if vr := p.Provider.VirtualRouter; vr != nil {
+		s.Provider.VirtualRouter = &appmesh.VirtualRouterServiceProvider{VirtualRouterName: vr.VirtualRouterName}
+	}
+	return s
+}
+
+// GenerateCreateVirtualServiceInput returns the create input of a virtual
+// service with the given name and parameters.
+func GenerateCreateVirtualServiceInput(name string, p v1alpha1.VirtualServiceParameters) *appmesh.CreateVirtualServiceInput {
+	return &appmesh.CreateVirtualServiceInput{
+		VirtualServiceName: aws.String(name),
+		MeshName:           p.MeshName,
+		MeshOwner:          p.MeshOwner,
+		Spec:               GenerateVirtualServiceSpec(p),
+		Tags:               GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateVirtualServiceInput returns the update input of a virtual
+// service with the given name and parameters.
+func GenerateUpdateVirtualServiceInput(name string, p v1alpha1.VirtualServiceParameters) *appmesh.UpdateVirtualServiceInput {
+	return &appmesh.UpdateVirtualServiceInput{
+		VirtualServiceName: aws.String(name),
+		MeshName:           p.MeshName,
+		MeshOwner:          p.MeshOwner,
+		Spec:               GenerateVirtualServiceSpec(p),
+	}
+}
+
+// GenerateVirtualServiceObservation returns the observation of the given
+// virtual service.
+func GenerateVirtualServiceObservation(o appmesh.VirtualServiceData) v1alpha1.VirtualServiceObservation {
+	res := v1alpha1.VirtualServiceObservation{}
+	if m := o.Metadata; m != nil {
+		res.ARN = aws.StringValue(m.Arn)
+		res.UID = aws.StringValue(m.Uid)
+		res.MeshOwner = aws.StringValue(m.MeshOwner)
+		res.ResourceOwner = aws.StringValue(m.ResourceOwner)
+		res.Version = aws.Int64Value(m.Version)
+	}
+	if o.Status != nil {
+		res.Status = string(o.Status.Status)
+	}
+	return res
+}
+
+// IsVirtualServiceUpToDate returns true if the observed virtual service
+// matches the parameters.
+func IsVirtualServiceUpToDate(p v1alpha1.VirtualServiceParameters, o appmesh.VirtualServiceData) bool {
+	return cmp.Equal(GenerateVirtualServiceSpec(p), o.Spec, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appmesh
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+)
+
+func TestIsVirtualServiceUpToDate(t *testing.T) {
+	observed := appmesh.VirtualServiceData{
+		MeshName:           aws.String("some-mesh"),
+		VirtualServiceName: aws.String("app.local"),
+		Spec: &appmesh.VirtualServiceSpec{Provider: &appmesh.VirtualServiceProvider{
+			VirtualNode: &appmesh.VirtualNodeServiceProvider{VirtualNodeName: aws.String("some-node")},
+		}},
+	}
+	cases := map[string]struct {
+		p    v1alpha1.VirtualServiceParameters
+		o    appmesh.VirtualServiceData
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.VirtualServiceParameters{Provider: &v1alpha1.VirtualServiceProvider{
+				VirtualNode: &v1alpha1.VirtualNodeServiceProvider{VirtualNodeName: aws.String("some-node")},
+			}},
+			o:    observed,
+			want: true,
+		},
+		"VirtualNodeChanged": {
+			p: v1alpha1.VirtualServiceParameters{Provider: &v1alpha1.VirtualServiceProvider{
+				VirtualNode: &v1alpha1.VirtualNodeServiceProvider{VirtualNodeName: aws.String("other-node")},
+			}},
+			o:    observed,
+			want: false,
+		},
+		"ProviderChangedToVirtualRouter": {
+			p: v1alpha1.VirtualServiceParameters{Provider: &v1alpha1.VirtualServiceProvider{
+				VirtualRouter: &v1alpha1.VirtualRouterServiceProvider{VirtualRouterName: aws.String("some-router")},
+			}},
+			o:    observed,
+			want: false,
+		},
+		"ProviderRemoved": {
+			p:    v1alpha1.VirtualServiceParameters{},
+			o:    observed,
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVirtualServiceUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/athena"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.WorkGroupClient = (*MockWorkGroupClient)(nil)
+
+// MockWorkGroupClient is a type that implements all the methods for WorkGroupClient interface
+type MockWorkGroupClient struct {
+	MockGetWorkGroup    func(*athena.GetWorkGroupInput) athena.GetWorkGroupRequest
+	MockCreateWorkGroup func(*athena.CreateWorkGroupInput) athena.CreateWorkGroupRequest
+	MockUpdateWorkGroup func(*athena.UpdateWorkGroupInput) athena.UpdateWorkGroupRequest
+	MockDeleteWorkGroup func(*athena.DeleteWorkGroupInput) athena.DeleteWorkGroupRequest
+}
+
+// GetWorkGroupRequest mocks GetWorkGroupRequest method
+func (m *MockWorkGroupClient) GetWorkGroupRequest(input *athena.GetWorkGroupInput) athena.GetWorkGroupRequest {
+	return m.MockGetWorkGroup(input)
+}
+
+// CreateWorkGroupRequest mocks CreateWorkGroupRequest method
+func (m *MockWorkGroupClient) CreateWorkGroupRequest(input *athena.CreateWorkGroupInput) athena.CreateWorkGroupRequest {
+	return m.MockCreateWorkGroup(input)
+}
+
+// UpdateWorkGroupRequest mocks UpdateWorkGroupRequest method
+func (m *MockWorkGroupClient) UpdateWorkGroupRequest(input *athena.UpdateWorkGroupInput) athena.UpdateWorkGroupRequest {
+	return m.MockUpdateWorkGroup(input)
+}
+
+// DeleteWorkGroupRequest mocks DeleteWorkGroupRequest method
+func (m *MockWorkGroupClient) DeleteWorkGroupRequest(input *athena.DeleteWorkGroupInput) athena.DeleteWorkGroupRequest {
+	return m.MockDeleteWorkGroup(input)
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package athena
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+
+	"github.com/crossplane/provider-aws/apis/athena/v1alpha1"
+)
+
+// NamedQueryClient is the external client used for NamedQuery Custom
+// Resource
+type NamedQueryClient interface {
+	GetNamedQueryRequest(*athena.GetNamedQueryInput) athena.GetNamedQueryRequest
+	CreateNamedQueryRequest(*athena.CreateNamedQueryInput) athena.CreateNamedQueryRequest
+	DeleteNamedQueryRequest(*athena.DeleteNamedQueryInput) athena.DeleteNamedQueryRequest
+}
+
+// NewNamedQueryClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewNamedQueryClient(cfg aws.Config) NamedQueryClient {
+	return athena.New(cfg)
+}
+
+// GenerateCreateNamedQueryInput returns the create input of the named query
+// with the given parameters.
+func GenerateCreateNamedQueryInput(p v1alpha1.NamedQueryParameters) *athena.CreateNamedQueryInput {
+	return &athena.CreateNamedQueryInput{
+		Name:        aws.String(p.Name),
+		Database:    aws.String(p.Database),
+		Description: p.Description,
+		QueryString: aws.String(p.QueryString),
+		WorkGroup:   p.WorkGroup,
+	}
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package athena
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+
+	"github.com/crossplane/provider-aws/apis/athena/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// WorkGroupClient is the external client used for WorkGroup Custom Resource
+type WorkGroupClient interface {
+	GetWorkGroupRequest(*athena.GetWorkGroupInput) athena.GetWorkGroupRequest
+	CreateWorkGroupRequest(*athena.CreateWorkGroupInput) athena.CreateWorkGroupRequest
+	UpdateWorkGroupRequest(*athena.UpdateWorkGroupInput) athena.UpdateWorkGroupRequest
+	DeleteWorkGroupRequest(*athena.DeleteWorkGroupInput) athena.DeleteWorkGroupRequest
+}
+
+// NewWorkGroupClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewWorkGroupClient(cfg aws.Config) WorkGroupClient {
+	return athena.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the workgroup or named
+// query doesn't exist. Athena reports missing resources as invalid requests.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	if awsErr.Code() == athena.ErrCodeResourceNotFoundException {
+		return true
+	}
+	return awsErr.Code() == athena.ErrCodeInvalidRequestException && strings.Contains(awsErr.Message(), "not found")
+}
+
+// GenerateTags returns the Athena tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) []athena.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]athena.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = athena.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+func generateEncryptionConfiguration(e *v1alpha1.EncryptionConfiguration) *athena.EncryptionConfiguration {
+	if e == nil {
+		return nil
+	}
+	return &athena.EncryptionConfiguration{
+		EncryptionOption: athena.EncryptionOption(e.EncryptionOption),
+		KmsKey:           e.KMSKey,
+	}
+}
+
+// GenerateWorkGroupConfiguration returns the Athena configuration of the
+// given workgroup configuration.
+func GenerateWorkGroupConfiguration(c *v1alpha1.WorkGroupConfiguration) *athena.WorkGroupConfiguration {
+	if c == nil {
+		return nil
+	}
+	out := &athena.WorkGroupConfiguration{
+		BytesScannedCutoffPerQuery:      c.BytesScannedCutoffPerQuery,
+		EnforceWorkGroupConfiguration:   c.EnforceWorkGroupConfiguration,
+		PublishCloudWatchMetricsEnabled: c.PublishCloudWatchMetricsEnabled,
+		RequesterPaysEnabled:            c.RequesterPaysEnabled,
+	}
+	if rc := c.ResultConfiguration; rc != nil {
+		out.ResultConfiguration = &athena.ResultConfiguration{
+			OutputLocation:          rc.OutputLocation,
+			EncryptionConfiguration: generateEncryptionConfiguration(rc.EncryptionConfiguration),
+		}
+	}
+	return out
+}
+
+// GenerateCreateWorkGroupInput returns the create input of the workgroup
+// with the given name and parameters.
+func GenerateCreateWorkGroupInput(name string, p v1alpha1.WorkGroupParameters) *athena.CreateWorkGroupInput {
+	return &athena.CreateWorkGroupInput{
+		Name:          aws.String(name),
+		Description:   p.Description,
+		Configuration: GenerateWorkGroupConfiguration(p.Configuration),
+		Tags:          GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateWorkGroupInput returns the update input that brings the
+// observed workgroup in line with the given parameters. Settings that are
+// removed from the parameters are removed from the workgroup.
+func GenerateUpdateWorkGroupInput(name string, p v1alpha1.WorkGroupParameters, o athena.WorkGroup) *athena.UpdateWorkGroupInput {
+	in := &athena.UpdateWorkGroupInput{
+		WorkGroup:   aws.String(name),
+		Description: p.Description,
+		State:       athena.WorkGroupState(aws.StringValue(p.State)),
+	}
+	desired := GenerateWorkGroupConfiguration(p.Configuration)
+	if desired == nil {
+		desired = &athena.WorkGroupConfiguration{}
+	}
+	observed := o.Configuration
+	if observed == nil {
+		observed = &athena.WorkGroupConfiguration{}
+	}
+	u := &athena.WorkGroupConfigurationUpdates{
+		BytesScannedCutoffPerQuery:      desired.BytesScannedCutoffPerQuery,
+		EnforceWorkGroupConfiguration:   desired.EnforceWorkGroupConfiguration,
+		PublishCloudWatchMetricsEnabled: desired.PublishCloudWatchMetricsEnabled,
+		RequesterPaysEnabled:            desired.RequesterPaysEnabled,
+	}
+	if desired.BytesScannedCutoffPerQuery == nil && observed.BytesScannedCutoffPerQuery != nil {
+		u.RemoveBytesScannedCutoffPerQuery = aws.Bool(true)
+	}
+	drc, orc := desired.ResultConfiguration, observed.ResultConfiguration
+	if drc == nil {
+		drc = &athena.ResultConfiguration{}
+	}
+	if orc == nil {
+		orc = &athena.ResultConfiguration{}
+	}
+	rcu := &athena.ResultConfigurationUpdates{
+		OutputLocation:          drc.OutputLocation,
+		EncryptionConfiguration: drc.EncryptionConfiguration,
+	}
+	if drc.OutputLocation == nil && orc.OutputLocation != nil {
+		rcu.RemoveOutputLocation = aws.Bool(true)
+	}
+	if drc.EncryptionConfiguration == nil && orc.EncryptionConfiguration != nil {
+		rcu.RemoveEncryptionConfiguration = aws.Bool(true)
+	}
+	u.ResultConfigurationUpdates = rcu
+	in.ConfigurationUpdates = u
+	return in
+}
+
+// GenerateWorkGroupObservation returns the observation of the given
+// workgroup.
+func GenerateWorkGroupObservation(o athena.WorkGroup) v1alpha1.WorkGroupObservation {
+	return v1alpha1.WorkGroupObservation{
+		State: string(o.State),
+	}
+}
+
+// LateInitializeWorkGroup fills the empty fields of the given parameters
+// with the values of the observed workgroup.
+func LateInitializeWorkGroup(p *v1alpha1.WorkGroupParameters, o athena.WorkGroup) {
+	p.Description = awsclients.LateInitializeStringPtr(p.Description, o.Description)
+	if o.State != "" {
+		p.State = awsclients.LateInitializeStringPtr(p.State, aws.String(string(o.State)))
+	}
+	if o.Configuration == nil {
+		return
+	}
+	if p.Configuration == nil {
+		p.Configuration = &v1alpha1.WorkGroupConfiguration{}
+	}
+	c := p.Configuration
+	c.EnforceWorkGroupConfiguration = awsclients.LateInitializeBoolPtr(c.EnforceWorkGroupConfiguration, o.Configuration.EnforceWorkGroupConfiguration)
+	c.PublishCloudWatchMetricsEnabled = awsclients.LateInitializeBoolPtr(c.PublishCloudWatchMetricsEnabled, o.Configuration.PublishCloudWatchMetricsEnabled)
+	c.RequesterPaysEnabled = awsclients.LateInitializeBoolPtr(c.RequesterPaysEnabled, o.Configuration.RequesterPaysEnabled)
+}
+
+// IsWorkGroupUpToDate returns whether the observed workgroup is up to date
+// with the given parameters.
+func IsWorkGroupUpToDate(p v1alpha1.WorkGroupParameters, o athena.WorkGroup) bool { // nolint:gocyclo
+	if aws.StringValue(p.Description) != aws.StringValue(o.Description) ||
+		aws.StringValue(p.State) != string(o.State) {
+		return false
+	}
+	desired := GenerateWorkGroupConfiguration(p.Configuration)
+	if desired == nil {
+		desired = &athena.WorkGroupConfiguration{}
+	}
+	observed := o.Configuration
+	if observed == nil {
+		observed = &athena.WorkGroupConfiguration{}
+	}
+	if aws.Int64Value(desired.BytesScannedCutoffPerQuery) != aws.Int64Value(observed.BytesScannedCutoffPerQuery) ||
+		aws.BoolValue(desired.EnforceWorkGroupConfiguration) != aws.BoolValue(observed.EnforceWorkGroupConfiguration) ||
+		aws.BoolValue(desired.PublishCloudWatchMetricsEnabled) != aws.BoolValue(observed.PublishCloudWatchMetricsEnabled) ||
+		aws.BoolValue(desired.RequesterPaysEnabled) != aws.BoolValue(observed.RequesterPaysEnabled) {
+		return false
+	}
+	drc, orc := desired.ResultConfiguration, observed.ResultConfiguration
+	if drc == nil {
+		drc = &athena.ResultConfiguration{}
+	}
+	if orc == nil {
+		orc = &athena.ResultConfiguration{}
+	}
+	if aws.StringValue(drc.OutputLocation) != aws.StringValue(orc.OutputLocation) {
+		return false
+	}
+	de, oe := drc.EncryptionConfiguration, orc.EncryptionConfiguration
+	if de == nil || oe == nil {
+		return de == nil && oe == nil
+	}
+	return de.EncryptionOption == oe.EncryptionOption && aws.StringValue(de.KmsKey) == aws.StringValue(oe.KmsKey)
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package athena
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/athena/v1alpha1"
+)
+
+var (
+	workGroupName  = "analytics"
+	outputLocation = "s3://example-bucket/"
+	kmsKey         = "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+)
+
+func TestIsNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"NotFound": {
+			err:  awserr.New(athena.ErrCodeInvalidRequestException, "WorkGroup analytics is not found.", nil),
+			want: true,
+		},
+		"ResourceNotFound": {
+			err:  awserr.New(athena.ErrCodeResourceNotFoundException, "", nil),
+			want: true,
+		},
+		"OtherInvalidRequest": {
+			err:  awserr.New(athena.ErrCodeInvalidRequestException, "WorkGroup is not empty", nil),
+			want: false,
+		},
+		"NotAWSError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsWorkGroupUpToDate(t *testing.T) {
+	type args struct {
+		p v1alpha1.WorkGroupParameters
+		o athena.WorkGroup
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: v1alpha1.WorkGroupParameters{
+					State: aws.String("ENABLED"),
+					Configuration: &v1alpha1.WorkGroupConfiguration{
+						BytesScannedCutoffPerQuery:    aws.Int64(10000000),
+						EnforceWorkGroupConfiguration: aws.Bool(true),
+						ResultConfiguration: &v1alpha1.ResultConfiguration{
+							OutputLocation: aws.String(outputLocation),
+							EncryptionConfiguration: &v1alpha1.EncryptionConfiguration{
+								EncryptionOption: "SSE_KMS",
+								KMSKey:           aws.String(kmsKey),
+							},
+						},
+					},
+				},
+				o: athena.WorkGroup{
+					Name:  aws.String(workGroupName),
+					State: athena.WorkGroupStateEnabled,
+					Configuration: &athena.WorkGroupConfiguration{
+						BytesScannedCutoffPerQuery:    aws.Int64(10000000),
+						EnforceWorkGroupConfiguration: aws.Bool(true),
+						ResultConfiguration: &athena.ResultConfiguration{
+							OutputLocation: aws.String(outputLocation),
+							EncryptionConfiguration: &athena.EncryptionConfiguration{
+								EncryptionOption: athena.EncryptionOptionSseKms,
+								KmsKey:           aws.String(kmsKey),
+							},
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		"CutoffChanged": {
+			args: args{
+				p: v1alpha1.WorkGroupParameters{
+					Configuration: &v1alpha1.WorkGroupConfiguration{
+						BytesScannedCutoffPerQuery: aws.Int64(20000000),
+					},
+				},
+				o: athena.WorkGroup{
+					Configuration: &athena.WorkGroupConfiguration{
+						BytesScannedCutoffPerQuery: aws.Int64(10000000),
+					},
+				},
+			},
+			want: false,
+		},
+		"EncryptionRemoved": {
+			args: args{
+				p: v1alpha1.WorkGroupParameters{
+					Configuration: &v1alpha1.WorkGroupConfiguration{
+						ResultConfiguration: &v1alpha1.ResultConfiguration{
+							OutputLocation: aws.String(outputLocation),
+						},
+					},
+				},
+				o: athena.WorkGroup{
+					Configuration: &athena.WorkGroupConfiguration{
+						ResultConfiguration: &athena.ResultConfiguration{
+							OutputLocation: aws.String(outputLocation),
+							EncryptionConfiguration: &athena.EncryptionConfiguration{
+								EncryptionOption: athena.EncryptionOptionSseS3,
+							},
+						},
+					},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsWorkGroupUpToDate(tc.args.p, tc.args.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateWorkGroupInput(t *testing.T) {
+	type args struct {
+		p v1alpha1.WorkGroupParameters
+		o athena.WorkGroup
+	}
+
+	cases := map[string]struct {
+		args args
+		want *athena.UpdateWorkGroupInput
+	}{
+		"SetSettings": {
+			args: args{
+				p: v1alpha1.WorkGroupParameters{
+					Description: aws.String("desc"),
+					State:       aws.String("DISABLED"),
+					Configuration: &v1alpha1.WorkGroupConfiguration{
+						BytesScannedCutoffPerQuery: aws.Int64(10000000),
+						ResultConfiguration: &v1alpha1.ResultConfiguration{
+							OutputLocation: aws.String(outputLocation),
+						},
+					},
+				},
+			},
+			want: &athena.UpdateWorkGroupInput{
+				WorkGroup:   aws.String(workGroupName),
+				Description: aws.String("desc"),
+				State:       athena.WorkGroupStateDisabled,
+				ConfigurationUpdates: &athena.WorkGroupConfigurationUpdates{
+					BytesScannedCutoffPerQuery: aws.Int64(10000000),
+					ResultConfigurationUpdates: &athena.ResultConfigurationUpdates{
+						OutputLocation: aws.String(outputLocation),
+					},
+				},
+			},
+		},
+		"RemoveSettings": {
+			args: args{
+				p: v1alpha1.WorkGroupParameters{},
+				o: athena.WorkGroup{
+					Configuration: &athena.WorkGroupConfiguration{
+						BytesScannedCutoffPerQuery: aws.Int64(10000000),
+						ResultConfiguration: &athena.ResultConfiguration{
+							OutputLocation: aws.String(outputLocation),
+							EncryptionConfiguration: &athena.EncryptionConfiguration{
+								EncryptionOption: athena.EncryptionOptionSseS3,
+							},
+						},
+					},
+				},
+			},
+			want: &athena.UpdateWorkGroupInput{
+				WorkGroup: aws.String(workGroupName),
+				ConfigurationUpdates: &athena.WorkGroupConfigurationUpdates{
+					RemoveBytesScannedCutoffPerQuery: aws.Bool(true),
+					ResultConfigurationUpdates: &athena.ResultConfigurationUpdates{
+						RemoveOutputLocation:          aws.Bool(true),
+						RemoveEncryptionConfiguration: aws.Bool(true),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateWorkGroupInput(workGroupName, tc.args.p, tc.args.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeWorkGroup(t *testing.T) {
+	p := v1alpha1.WorkGroupParameters{}
+	LateInitializeWorkGroup(&p, athena.WorkGroup{
+		State: athena.WorkGroupStateEnabled,
+		Configuration: &athena.WorkGroupConfiguration{
+			EnforceWorkGroupConfiguration:   aws.Bool(true),
+			PublishCloudWatchMetricsEnabled: aws.Bool(true),
+			RequesterPaysEnabled:            aws.Bool(false),
+		},
+	})
+	want := v1alpha1.WorkGroupParameters{
+		State: aws.String("ENABLED"),
+		Configuration: &v1alpha1.WorkGroupConfiguration{
+			EnforceWorkGroupConfiguration:   aws.Bool(true),
+			PublishCloudWatchMetricsEnabled: aws.Bool(true),
+			RequesterPaysEnabled:            aws.Bool(false),
+		},
+	}
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,364 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// StatusDeleteInProgress is the status of an AutoScalingGroup that is
+	// being deleted.
+	StatusDeleteInProgress = "Delete in progress"
+
+	tagResourceType = "auto-scaling-group"
+)
+
+// AutoScalingGroupClient is the external client used for AutoScalingGroup
+// Custom Resource
+type AutoScalingGroupClient interface {
+	DescribeAutoScalingGroupsRequest(input *autoscaling.DescribeAutoScalingGroupsInput) autoscaling.DescribeAutoScalingGroupsRequest
+	CreateAutoScalingGroupRequest(input *autoscaling.CreateAutoScalingGroupInput) autoscaling.CreateAutoScalingGroupRequest
+	UpdateAutoScalingGroupRequest(input *autoscaling.UpdateAutoScalingGroupInput) autoscaling.UpdateAutoScalingGroupRequest
+	DeleteAutoScalingGroupRequest(input *autoscaling.DeleteAutoScalingGroupInput) autoscaling.DeleteAutoScalingGroupRequest
+	AttachLoadBalancerTargetGroupsRequest(input *autoscaling.AttachLoadBalancerTargetGroupsInput) autoscaling.AttachLoadBalancerTargetGroupsRequest
+	DetachLoadBalancerTargetGroupsRequest(input *autoscaling.DetachLoadBalancerTargetGroupsInput) autoscaling.DetachLoadBalancerTargetGroupsRequest
+	CreateOrUpdateTagsRequest(input *autoscaling.CreateOrUpdateTagsInput) autoscaling.CreateOrUpdateTagsRequest
+	DeleteTagsRequest(input *autoscaling.DeleteTagsInput) autoscaling.DeleteTagsRequest
+}
+
+// NewAutoScalingGroupClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewAutoScalingGroupClient(cfg aws.Config) AutoScalingGroupClient {
+	return autoscaling.New(cfg)
+}
+
+// IsAutoScalingGroupNotFoundErr returns true if the error is because the item
+// doesn't exist. Auto Scaling reports it as a generic validation error.
+func IsAutoScalingGroupNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ValidationError" &&
+		strings.Contains(awsErr.Message(), "not found") {
+		return true
+	}
+	return false
+}
+
+// GenerateLaunchTemplateSpecification returns the
+// autoscaling.LaunchTemplateSpecification of the given specification.
+func GenerateLaunchTemplateSpecification(lt *v1alpha1.LaunchTemplateSpecification) *autoscaling.LaunchTemplateSpecification {
+	if lt == nil {
+		return nil
+	}
+	return &autoscaling.LaunchTemplateSpecification{
+		LaunchTemplateId: lt.LaunchTemplateID,
+		Version:          lt.Version,
+	}
+}
+
+// GenerateMixedInstancesPolicy returns the autoscaling.MixedInstancesPolicy
+// of the given policy.
+func GenerateMixedInstancesPolicy(p *v1alpha1.MixedInstancesPolicy) *autoscaling.MixedInstancesPolicy {
+	if p == nil {
+		return nil
+	}
+	policy := &autoscaling.MixedInstancesPolicy{
+		LaunchTemplate: &autoscaling.LaunchTemplate{
+			LaunchTemplateSpecification: GenerateLaunchTemplateSpecification(&p.LaunchTemplate.LaunchTemplateSpecification),
+		},
+	}
+	for _, o := range p.LaunchTemplate.Overrides {
+		policy.LaunchTemplate.Overrides = append(policy.LaunchTemplate.Overrides, autoscaling.LaunchTemplateOverrides{
+			InstanceType:     aws.String(o.InstanceType),
+			WeightedCapacity: o.WeightedCapacity,
+		})
+	}
+	if d := p.InstancesDistribution; d != nil {
+		policy.InstancesDistribution = &autoscaling.InstancesDistribution{
+			OnDemandAllocationStrategy:          d.OnDemandAllocationStrategy,
+			OnDemandBaseCapacity:                d.OnDemandBaseCapacity,
+			OnDemandPercentageAboveBaseCapacity: d.OnDemandPercentageAboveBaseCapacity,
+			SpotAllocationStrategy:              d.SpotAllocationStrategy,
+			SpotInstancePools:                   d.SpotInstancePools,
+			SpotMaxPrice:                        d.SpotMaxPrice,
+		}
+	}
+	return policy
+}
+
+// BuildTags returns the autoscaling.Tags of the group with the given name.
+func BuildTags(name string, tags []v1alpha1.Tag) []autoscaling.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]autoscaling.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = autoscaling.Tag{
+			Key:               aws.String(t.Key),
+			Value:             t.Value,
+			PropagateAtLaunch: t.PropagateAtLaunch,
+			ResourceId:        aws.String(name),
+			ResourceType:      aws.String(tagResourceType),
+		}
+	}
+	return res
+}
+
+// GenerateCreateAutoScalingGroupInput returns the input for a
+// CreateAutoScalingGroup request built from the given parameters.
+func GenerateCreateAutoScalingGroupInput(name string, p v1alpha1.AutoScalingGroupParameters) *autoscaling.CreateAutoScalingGroupInput {
+	in := &autoscaling.CreateAutoScalingGroupInput{
+		AutoScalingGroupName:   aws.String(name),
+		MinSize:                aws.Int64(p.MinSize),
+		MaxSize:                aws.Int64(p.MaxSize),
+		DesiredCapacity:        p.DesiredCapacity,
+		LaunchTemplate:         GenerateLaunchTemplateSpecification(p.LaunchTemplate),
+		MixedInstancesPolicy:   GenerateMixedInstancesPolicy(p.MixedInstancesPolicy),
+		AvailabilityZones:      p.AvailabilityZones,
+		TargetGroupARNs:        p.TargetGroupARNs,
+		HealthCheckType:        p.HealthCheckType,
+		HealthCheckGracePeriod: p.HealthCheckGracePeriod,
+		DefaultCooldown:        p.DefaultCooldown,
+		Tags:                   BuildTags(name, p.Tags),
+	}
+	if len(p.SubnetIDs) != 0 {
+		in.VPCZoneIdentifier = aws.String(strings.Join(p.SubnetIDs, ","))
+	}
+	return in
+}
+
+// GenerateUpdateAutoScalingGroupInput returns the input for an
+// UpdateAutoScalingGroup request built from the given parameters.
+func GenerateUpdateAutoScalingGroupInput(name string, p v1alpha1.AutoScalingGroupParameters) *autoscaling.UpdateAutoScalingGroupInput {
+	in := &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName:   aws.String(name),
+		MinSize:                aws.Int64(p.MinSize),
+		MaxSize:                aws.Int64(p.MaxSize),
+		DesiredCapacity:        p.DesiredCapacity,
+		LaunchTemplate:         GenerateLaunchTemplateSpecification(p.LaunchTemplate),
+		MixedInstancesPolicy:   GenerateMixedInstancesPolicy(p.MixedInstancesPolicy),
+		AvailabilityZones:      p.AvailabilityZones,
+		HealthCheckType:        p.HealthCheckType,
+		HealthCheckGracePeriod: p.HealthCheckGracePeriod,
+		DefaultCooldown:        p.DefaultCooldown,
+	}
+	if len(p.SubnetIDs) != 0 {
+		in.VPCZoneIdentifier = aws.String(strings.Join(p.SubnetIDs, ","))
+	}
+	return in
+}
+
+// GenerateAutoScalingGroupObservation is used to produce
+// v1alpha1.AutoScalingGroupObservation from autoscaling.AutoScalingGroup.
+func GenerateAutoScalingGroupObservation(g autoscaling.AutoScalingGroup) v1alpha1.AutoScalingGroupObservation {
+	o := v1alpha1.AutoScalingGroupObservation{
+		AutoScalingGroupARN: aws.StringValue(g.AutoScalingGroupARN),
+		Status:              aws.StringValue(g.Status),
+	}
+	for _, i := range g.Instances {
+		o.Instances = append(o.Instances, v1alpha1.Instance{
+			InstanceID:       aws.StringValue(i.InstanceId),
+			AvailabilityZone: aws.StringValue(i.AvailabilityZone),
+			LifecycleState:   string(i.LifecycleState),
+			HealthStatus:     aws.StringValue(i.HealthStatus),
+		})
+	}
+	return o
+}
+
+func generateLaunchTemplateSpecification(lt *autoscaling.LaunchTemplateSpecification) *v1alpha1.LaunchTemplateSpecification {
+	if lt == nil {
+		return nil
+	}
+	return &v1alpha1.LaunchTemplateSpecification{
+		LaunchTemplateID: lt.LaunchTemplateId,
+		Version:          lt.Version,
+	}
+}
+
+func generateMixedInstancesPolicy(p *autoscaling.MixedInstancesPolicy) *v1alpha1.MixedInstancesPolicy {
+	if p == nil {
+		return nil
+	}
+	policy := &v1alpha1.MixedInstancesPolicy{}
+	if p.LaunchTemplate != nil {
+		if lt := generateLaunchTemplateSpecification(p.LaunchTemplate.LaunchTemplateSpecification); lt != nil {
+			policy.LaunchTemplate.LaunchTemplateSpecification = *lt
+		}
+		for _, o := range p.LaunchTemplate.Overrides {
+			policy.LaunchTemplate.Overrides = append(policy.LaunchTemplate.Overrides, v1alpha1.LaunchTemplateOverrides{
+				InstanceType:     aws.StringValue(o.InstanceType),
+				WeightedCapacity: o.WeightedCapacity,
+			})
+		}
+	}
+	if d := p.InstancesDistribution; d != nil {
+		policy.InstancesDistribution = &v1alpha1.InstancesDistribution{
+			OnDemandAllocationStrategy:          d.OnDemandAllocationStrategy,
+			OnDemandBaseCapacity:                d.OnDemandBaseCapacity,
+			OnDemandPercentageAboveBaseCapacity: d.OnDemandPercentageAboveBaseCapacity,
+			SpotAllocationStrategy:              d.SpotAllocationStrategy,
+			SpotInstancePools:                   d.SpotInstancePools,
+			SpotMaxPrice:                        d.SpotMaxPrice,
+		}
+	}
+	return policy
+}
+
+func subnetIDs(g autoscaling.AutoScalingGroup) []string {
+	if aws.StringValue(g.VPCZoneIdentifier) == "" {
+		return nil
+	}
+	return strings.Split(aws.StringValue(g.VPCZoneIdentifier), ",")
+}
+
+// LateInitializeAutoScalingGroup fills the empty fields in
+// *v1alpha1.AutoScalingGroupParameters with the values seen in
+// autoscaling.AutoScalingGroup. The desired capacity is left to scaling
+// policies unless it is set explicitly.
+func LateInitializeAutoScalingGroup(in *v1alpha1.AutoScalingGroupParameters, g *autoscaling.AutoScalingGroup) { // nolint:gocyclo
+	if g == nil {
+		return
+	}
+
+	in.HealthCheckType = awsclients.LateInitializeStringPtr(in.HealthCheckType, g.HealthCheckType)
+	in.HealthCheckGracePeriod = awsclients.LateInitializeInt64Ptr(in.HealthCheckGracePeriod, g.HealthCheckGracePeriod)
+	in.DefaultCooldown = awsclients.LateInitializeInt64Ptr(in.DefaultCooldown, g.DefaultCooldown)
+	if len(in.SubnetIDs) == 0 {
+		in.SubnetIDs = subnetIDs(*g)
+	}
+	if in.LaunchTemplate != nil && g.LaunchTemplate != nil {
+		in.LaunchTemplate.Version = awsclients.LateInitializeStringPtr(in.LaunchTemplate.Version, g.LaunchTemplate.Version)
+	}
+	if in.MixedInstancesPolicy != nil && g.MixedInstancesPolicy != nil {
+		o := generateMixedInstancesPolicy(g.MixedInstancesPolicy)
+		lt := &in.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification
+		lt.Version = awsclients.LateInitializeStringPtr(lt.Version, o.LaunchTemplate.LaunchTemplateSpecification.Version)
+		if o.InstancesDistribution != nil {
+			if in.MixedInstancesPolicy.InstancesDistribution == nil {
+				in.MixedInstancesPolicy.InstancesDistribution = &v1alpha1.InstancesDistribution{}
+			}
+			d := in.MixedInstancesPolicy.InstancesDistribution
+			d.OnDemandAllocationStrategy = awsclients.LateInitializeStringPtr(d.OnDemandAllocationStrategy, o.InstancesDistribution.OnDemandAllocationStrategy)
+			d.OnDemandBaseCapacity = awsclients.LateInitializeInt64Ptr(d.OnDemandBaseCapacity, o.InstancesDistribution.OnDemandBaseCapacity)
+			d.OnDemandPercentageAboveBaseCapacity = awsclients.LateInitializeInt64Ptr(d.OnDemandPercentageAboveBaseCapacity, o.InstancesDistribution.OnDemandPercentageAboveBaseCapacity)
+			d.SpotAllocationStrategy = awsclients.LateInitializeStringPtr(d.SpotAllocationStrategy, o.InstancesDistribution.SpotAllocationStrategy)
+			d.SpotInstancePools = awsclients.LateInitializeInt64Ptr(d.SpotInstancePools, o.InstancesDistribution.SpotInstancePools)
+			d.SpotMaxPrice = awsclients.LateInitializeStringPtr(d.SpotMaxPrice, o.InstancesDistribution.SpotMaxPrice)
+		}
+	}
+	if len(in.Tags) == 0 && len(g.Tags) != 0 {
+		in.Tags = make([]v1alpha1.Tag, len(g.Tags))
+		for i, t := range g.Tags {
+			in.Tags[i] = v1alpha1.Tag{Key: aws.StringValue(t.Key), Value: t.Value, PropagateAtLaunch: t.PropagateAtLaunch}
+		}
+	}
+}
+
+// IsAutoScalingGroupUpToDate checks whether the given
+// autoscaling.AutoScalingGroup matches the desired parameters, apart from
+// its target groups and tags.
+func IsAutoScalingGroupUpToDate(p v1alpha1.AutoScalingGroupParameters, g autoscaling.AutoScalingGroup) bool { // nolint:gocyclo
+	switch {
+	case p.MinSize != aws.Int64Value(g.MinSize),
+		p.MaxSize != aws.Int64Value(g.MaxSize),
+		p.DesiredCapacity != nil && aws.Int64Value(p.DesiredCapacity) != aws.Int64Value(g.DesiredCapacity),
+		aws.StringValue(p.HealthCheckType) != aws.StringValue(g.HealthCheckType),
+		aws.Int64Value(p.HealthCheckGracePeriod) != aws.Int64Value(g.HealthCheckGracePeriod),
+		aws.Int64Value(p.DefaultCooldown) != aws.Int64Value(g.DefaultCooldown):
+		return false
+	}
+
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	if !cmp.Equal(p.SubnetIDs, subnetIDs(g), cmpopts.EquateEmpty(), sortStrings) {
+		return false
+	}
+	if len(p.AvailabilityZones) != 0 && !cmp.Equal(p.AvailabilityZones, g.AvailabilityZones, sortStrings) {
+		return false
+	}
+
+	ignoreRefs := cmpopts.IgnoreFields(v1alpha1.LaunchTemplateSpecification{}, "LaunchTemplateIDRef", "LaunchTemplateIDSelector")
+	return cmp.Equal(p.LaunchTemplate, generateLaunchTemplateSpecification(g.LaunchTemplate), ignoreRefs) &&
+		cmp.Equal(p.MixedInstancesPolicy, generateMixedInstancesPolicy(g.MixedInstancesPolicy), ignoreRefs, cmpopts.EquateEmpty())
+}
+
+// DiffTargetGroupARNs returns the ARNs of the target groups that have to be
+// attached to and detached from the group for the observed target groups to
+// match the desired ones.
+func DiffTargetGroupARNs(desired, observed []string) (attach, detach []string) {
+	o := make(map[string]bool, len(observed))
+	for _, arn := range observed {
+		o[arn] = true
+	}
+	d := make(map[string]bool, len(desired))
+	for _, arn := range desired {
+		d[arn] = true
+		if !o[arn] {
+			attach = append(attach, arn)
+		}
+	}
+	for _, arn := range observed {
+		if !d[arn] {
+			detach = append(detach, arn)
+		}
+	}
+	return attach, detach
+}
+
+// DiffTags returns the tags that have to be added or updated and the tags
+// that have to be removed for the observed tags of the group with the given
+// name to match the desired ones.
+func DiffTags(name string, desired []v1alpha1.Tag, observed []autoscaling.TagDescription) (add, remove []autoscaling.Tag) {
+	o := make(map[string]autoscaling.TagDescription, len(observed))
+	for _, t := range observed {
+		o[aws.StringValue(t.Key)] = t
+	}
+	d := make(map[string]bool, len(desired))
+	for _, t := range BuildTags(name, desired) {
+		key := aws.StringValue(t.Key)
+		d[key] = true
+		ot, ok := o[key]
+		if !ok || aws.StringValue(ot.Value) != aws.StringValue(t.Value) ||
+			aws.BoolValue(ot.PropagateAtLaunch) != aws.BoolValue(t.PropagateAtLaunch) {
+			add = append(add, t)
+		}
+	}
+	keys := make([]string, 0, len(observed))
+	for k := range o {
+		if !d[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		remove = append(remove, autoscaling.Tag{
+			Key:          aws.String(k),
+			ResourceId:   aws.String(name),
+			ResourceType: aws.String(tagResourceType),
+		})
+	}
+	return add, remove
+}
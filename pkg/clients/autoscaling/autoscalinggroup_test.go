@@ -0,0 +1,184 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
+)
+
+var (
+	groupName = "sample-asg"
+	ltID      = "lt-0123456789"
+)
+
+func group() autoscaling.AutoScalingGroup {
+	return autoscaling.AutoScalingGroup{
+		AutoScalingGroupName:   aws.String(groupName),
+		MinSize:                aws.Int64(1),
+		MaxSize:                aws.Int64(3),
+		DesiredCapacity:        aws.Int64(2),
+		HealthCheckType:        aws.String("EC2"),
+		HealthCheckGracePeriod: aws.Int64(300),
+		DefaultCooldown:        aws.Int64(300),
+		VPCZoneIdentifier:      aws.String("subnet-1,subnet-2"),
+		LaunchTemplate: &autoscaling.LaunchTemplateSpecification{
+			LaunchTemplateId: aws.String(ltID),
+			Version:          aws.String("$Default"),
+		},
+	}
+}
+
+func params() v1alpha1.AutoScalingGroupParameters {
+	return v1alpha1.AutoScalingGroupParameters{
+		MinSize:                1,
+		MaxSize:                3,
+		HealthCheckType:        aws.String("EC2"),
+		HealthCheckGracePeriod: aws.Int64(300),
+		DefaultCooldown:        aws.Int64(300),
+		SubnetIDs:              []string{"subnet-2", "subnet-1"},
+		LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{
+			LaunchTemplateID:    aws.String(ltID),
+			LaunchTemplateIDRef: &runtimev1alpha1.Reference{Name: "lt"},
+			Version:             aws.String("$Default"),
+		},
+	}
+}
+
+func TestIsAutoScalingGroupUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    func() v1alpha1.AutoScalingGroupParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    params,
+			want: true,
+		},
+		"DesiredCapacityDiffers": {
+			p: func() v1alpha1.AutoScalingGroupParameters {
+				p := params()
+				p.DesiredCapacity = aws.Int64(3)
+				return p
+			},
+			want: false,
+		},
+		"MaxSizeDiffers": {
+			p: func() v1alpha1.AutoScalingGroupParameters {
+				p := params()
+				p.MaxSize = 5
+				return p
+			},
+			want: false,
+		},
+		"SubnetsDiffer": {
+			p: func() v1alpha1.AutoScalingGroupParameters {
+				p := params()
+				p.SubnetIDs = []string{"subnet-1"}
+				return p
+			},
+			want: false,
+		},
+		"LaunchTemplateVersionDiffers": {
+			p: func() v1alpha1.AutoScalingGroupParameters {
+				p := params()
+				p.LaunchTemplate.Version = aws.String("2")
+				return p
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAutoScalingGroupUpToDate(tc.p(), group())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeAutoScalingGroup(t *testing.T) {
+	in := v1alpha1.AutoScalingGroupParameters{
+		MinSize: 1,
+		MaxSize: 3,
+		LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{
+			LaunchTemplateID: aws.String(ltID),
+		},
+	}
+	want := v1alpha1.AutoScalingGroupParameters{
+		MinSize:                1,
+		MaxSize:                3,
+		HealthCheckType:        aws.String("EC2"),
+		HealthCheckGracePeriod: aws.Int64(300),
+		DefaultCooldown:        aws.Int64(300),
+		SubnetIDs:              []string{"subnet-1", "subnet-2"},
+		LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{
+			LaunchTemplateID: aws.String(ltID),
+			Version:          aws.String("$Default"),
+		},
+	}
+	g := group()
+	LateInitializeAutoScalingGroup(&in, &g)
+	if diff := cmp.Diff(want, in); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDiffTargetGroupARNs(t *testing.T) {
+	attach, detach := DiffTargetGroupARNs([]string{"a", "b"}, []string{"b", "c"})
+	if diff := cmp.Diff([]string{"a"}, attach); diff != "" {
+		t.Errorf("attach: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"c"}, detach); diff != "" {
+		t.Errorf("detach: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	desired := []v1alpha1.Tag{
+		{Key: "same", Value: aws.String("v"), PropagateAtLaunch: aws.Bool(true)},
+		{Key: "changed", Value: aws.String("new")},
+		{Key: "added", Value: aws.String("v")},
+	}
+	observed := []autoscaling.TagDescription{
+		{Key: aws.String("same"), Value: aws.String("v"), PropagateAtLaunch: aws.Bool(true)},
+		{Key: aws.String("changed"), Value: aws.String("old")},
+		{Key: aws.String("removed"), Value: aws.String("v")},
+	}
+	add, remove := DiffTags(groupName, desired, observed)
+	wantAdd := []autoscaling.Tag{
+		{Key: aws.String("changed"), Value: aws.String("new"), ResourceId: aws.String(groupName), ResourceType: aws.String(tagResourceType)},
+		{Key: aws.String("added"), Value: aws.String("v"), ResourceId: aws.String(groupName), ResourceType: aws.String(tagResourceType)},
+	}
+	wantRemove := []autoscaling.Tag{
+		{Key: aws.String("removed"), ResourceId: aws.String(groupName), ResourceType: aws.String(tagResourceType)},
+	}
+	if diff := cmp.Diff(wantAdd, add); diff != "" {
+		t.Errorf("add: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(wantRemove, remove); diff != "" {
+		t.Errorf("remove: -want, +got:\n%s", diff)
+	}
+}
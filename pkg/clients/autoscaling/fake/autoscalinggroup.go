@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/autoscaling"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AutoScalingGroupClient = (*MockAutoScalingGroupClient)(nil)
+
+// MockAutoScalingGroupClient is a type that implements all the methods for AutoScalingGroupClient interface
+type MockAutoScalingGroupClient struct {
+	MockDescribeAutoScalingGroups      func(*autoscaling.DescribeAutoScalingGroupsInput) autoscaling.DescribeAutoScalingGroupsRequest
+	MockCreateAutoScalingGroup         func(*autoscaling.CreateAutoScalingGroupInput) autoscaling.CreateAutoScalingGroupRequest
+	MockUpdateAutoScalingGroup         func(*autoscaling.UpdateAutoScalingGroupInput) autoscaling.UpdateAutoScalingGroupRequest
+	MockDeleteAutoScalingGroup         func(*autoscaling.DeleteAutoScalingGroupInput) autoscaling.DeleteAutoScalingGroupRequest
+	MockAttachLoadBalancerTargetGroups func(*autoscaling.AttachLoadBalancerTargetGroupsInput) autoscaling.AttachLoadBalancerTargetGroupsRequest
+	MockDetachLoadBalancerTargetGroups func(*autoscaling.DetachLoadBalancerTargetGroupsInput) autoscaling.DetachLoadBalancerTargetGroupsRequest
+	MockCreateOrUpdateTags             func(*autoscaling.CreateOrUpdateTagsInput) autoscaling.CreateOrUpdateTagsRequest
+	MockDeleteTags                     func(*autoscaling.DeleteTagsInput) autoscaling.DeleteTagsRequest
+}
+
+// DescribeAutoScalingGroupsRequest mocks DescribeAutoScalingGroupsRequest method
+func (m *MockAutoScalingGroupClient) DescribeAutoScalingGroupsRequest(input *autoscaling.DescribeAutoScalingGroupsInput) autoscaling.DescribeAutoScalingGroupsRequest {
+	return m.MockDescribeAutoScalingGroups(input)
+}
+
+// CreateAutoScalingGroupRequest mocks CreateAutoScalingGroupRequest method
+func (m *MockAutoScalingGroupClient) CreateAutoScalingGroupRequest(input *autoscaling.CreateAutoScalingGroupInput) autoscaling.CreateAutoScalingGroupRequest {
+	return m.MockCreateAutoScalingGroup(input)
+}
+
+// UpdateAutoScalingGroupRequest mocks UpdateAutoScalingGroupRequest method
+func (m *MockAutoScalingGroupClient) UpdateAutoScalingGroupRequest(input *autoscaling.UpdateAutoScalingGroupInput) autoscaling.UpdateAutoScalingGroupRequest {
+	return m.MockUpdateAutoScalingGroup(input)
+}
+
+// DeleteAutoScalingGroupRequest mocks DeleteAutoScalingGroupRequest method
+func (m *MockAutoScalingGroupClient) DeleteAutoScalingGroupRequest(input *autoscaling.DeleteAutoScalingGroupInput) autoscaling.DeleteAutoScalingGroupRequest {
+	return m.MockDeleteAutoScalingGroup(input)
+}
+
+// AttachLoadBalancerTargetGroupsRequest mocks AttachLoadBalancerTargetGroupsRequest method
+func (m *MockAutoScalingGroupClient) AttachLoadBalancerTargetGroupsRequest(input *autoscaling.AttachLoadBalancerTargetGroupsInput) autoscaling.AttachLoadBalancerTargetGroupsRequest {
+	return m.MockAttachLoadBalancerTargetGroups(input)
+}
+
+// DetachLoadBalancerTargetGroupsRequest mocks DetachLoadBalancerTargetGroupsRequest method
+func (m *MockAutoScalingGroupClient) DetachLoadBalancerTargetGroupsRequest(input *autoscaling.DetachLoadBalancerTargetGroupsInput) autoscaling.DetachLoadBalancerTargetGroupsRequest {
+	return m.MockDetachLoadBalancerTargetGroups(input)
+}
+
+// CreateOrUpdateTagsRequest mocks CreateOrUpdateTagsRequest method
+func (m *MockAutoScalingGroupClient) CreateOrUpdateTagsRequest(input *autoscaling.CreateOrUpdateTagsInput) autoscaling.CreateOrUpdateTagsRequest {
+	return m.MockCreateOrUpdateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockAutoScalingGroupClient) DeleteTagsRequest(input *autoscaling.DeleteTagsInput) autoscaling.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
@@ -91,6 +91,23 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
+	return useProviderConfigCredentials(ctx, c, pc, region)
+}
+
+// UseNamedProviderConfig produces a config that can be used to authenticate
+// to AWS with the credentials of the ProviderConfig with the given name. It is
+// used by resources that act in a second account, in addition to the one of
+// the ProviderConfig they reference. The usage of the named ProviderConfig
+// is not tracked.
+func UseNamedProviderConfig(ctx context.Context, c client.Client, name, region string) (*aws.Config, error) {
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: name}, pc); err != nil {
+		return nil, errors.Wrap(err, "cannot get referenced Provider")
+	}
+	return useProviderConfigCredentials(ctx, c, pc, region)
+}
+
+func useProviderConfigCredentials(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case runtimev1alpha1.CredentialsSourceInjectedIdentity:
 		return UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
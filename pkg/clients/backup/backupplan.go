@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/backup/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// BackupPlanClient is the external client used for BackupPlan Custom
+// Resource
+type BackupPlanClient interface {
+	GetBackupPlanRequest(*backup.GetBackupPlanInput) backup.GetBackupPlanRequest
+	CreateBackupPlanRequest(*backup.CreateBackupPlanInput) backup.CreateBackupPlanRequest
+	UpdateBackupPlanRequest(*backup.UpdateBackupPlanInput) backup.UpdateBackupPlanRequest
+	DeleteBackupPlanRequest(*backup.DeleteBackupPlanInput) backup.DeleteBackupPlanRequest
+	ListTagsRequest(*backup.ListTagsInput) backup.ListTagsRequest
+	TagResourceRequest(*backup.TagResourceInput) backup.TagResourceRequest
+	UntagResourceRequest(*backup.UntagResourceInput) backup.UntagResourceRequest
+}
+
+// NewBackupPlanClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewBackupPlanClient(cfg aws.Config) BackupPlanClient {
+	return backup.New(cfg)
+}
+
+func generateLifecycle(l *v1alpha1.Lifecycle) *backup.Lifecycle {
+	if l == nil {
+		return nil
+	}
+	return &backup.Lifecycle{
+		DeleteAfterDays:            l.DeleteAfterDays,
+		MoveToColdStorageAfterDays: l.MoveToColdStorageAfterDays,
+	}
+}
+
+// GenerateBackupPlanInput returns the plan input of the given parameters.
+func GenerateBackupPlanInput(p v1alpha1.BackupPlanParameters) *backup.BackupPlanInput {
+	in := &backup.BackupPlanInput{BackupPlanName: aws.String(p.Name)}
+	for _, r := range p.Rules {
+		rule := backup.BackupRuleInput{
+			RuleName:                aws.String(r.RuleName),
+			TargetBackupVaultName:   r.TargetBackupVaultName,
+			ScheduleExpression:      r.ScheduleExpression,
+			StartWindowMinutes:      r.StartWindowMinutes,
+			CompletionWindowMinutes: r.CompletionWindowMinutes,
+			Lifecycle:               generateLifecycle(r.Lifecycle),
+			RecoveryPointTags:       r.RecoveryPointTags,
+		}
+		for _, ca := range r.CopyActions {
+			rule.CopyActions = append(rule.CopyActions, backup.CopyAction{
+				DestinationBackupVaultArn: ca.DestinationBackupVaultARN,
+				Lifecycle:                 generateLifecycle(ca.Lifecycle),
+			})
+		}
+		in.Rules = append(in.Rules, rule)
+	}
+	return in
+}
+
+// GenerateCreateBackupPlanInput returns the create input of a plan with the
+// given parameters. The idempotency token makes sure that retries don't
+// create more than one plan.
+func GenerateCreateBackupPlanInput(idempotencyToken string, p v1alpha1.BackupPlanParameters) *backup.CreateBackupPlanInput {
+	return &backup.CreateBackupPlanInput{
+		BackupPlan:       GenerateBackupPlanInput(p),
+		BackupPlanTags:   p.Tags,
+		CreatorRequestId: aws.String(idempotencyToken),
+	}
+}
+
+// GenerateUpdateBackupPlanInput returns the update input of the plan with the
+// given ID and parameters.
+func GenerateUpdateBackupPlanInput(id string, p v1alpha1.BackupPlanParameters) *backup.UpdateBackupPlanInput {
+	return &backup.UpdateBackupPlanInput{
+		BackupPlanId: aws.String(id),
+		BackupPlan:   GenerateBackupPlanInput(p),
+	}
+}
+
+// GenerateBackupPlanObservation returns the observation of the given plan.
+func GenerateBackupPlanObservation(o backup.GetBackupPlanOutput) v1alpha1.BackupPlanObservation {
+	return v1alpha1.BackupPlanObservation{
+		ARN:       aws.StringValue(o.BackupPlanArn),
+		VersionID: aws.StringValue(o.VersionId),
+	}
+}
+
+// LateInitializeBackupPlan fills the empty fields of the rules of the plan
+// parameters with the values of the observed rules of the same name, which
+// AWS Backup defaults.
+func LateInitializeBackupPlan(in *v1alpha1.BackupPlanParameters, o *backup.BackupPlan) {
+	if o == nil {
+		return
+	}
+	observed := make(map[string]backup.BackupRule, len(o.Rules))
+	for _, r := range o.Rules {
+		observed[aws.StringValue(r.RuleName)] = r
+	}
+	for i := range in.Rules {
+		r := &in.Rules[i]
+		or, ok := observed[r.RuleName]
+		if !ok {
+			continue
+		}
+		r.ScheduleExpression = awsclients.LateInitializeStringPtr(r.ScheduleExpression, or.ScheduleExpression)
+		r.StartWindowMinutes = awsclients.LateInitializeInt64Ptr(r.StartWindowMinutes, or.StartWindowMinutes)
+		r.CompletionWindowMinutes = awsclients.LateInitializeInt64Ptr(r.CompletionWindowMinutes, or.CompletionWindowMinutes)
+	}
+}
+
+// normalizeLifecycle returns nil for lifecycles without any transition, which
+// AWS Backup returns for rules without a lifecycle.
+func normalizeLifecycle(l *backup.Lifecycle) *backup.Lifecycle {
+	if l == nil || (l.DeleteAfterDays == nil && l.MoveToColdStorageAfterDays == nil) {
+		return nil
+	}
+	return l
+}
+
+func normalizeRules(rules []backup.BackupRuleInput) []backup.BackupRuleInput {
+	res := make([]backup.BackupRuleInput, len(rules))
+	for i, r := range rules {
+		r.Lifecycle = normalizeLifecycle(r.Lifecycle)
+		cas := make([]backup.CopyAction, len(r.CopyActions))
+		for j, ca := range r.CopyActions {
+			ca.Lifecycle = normalizeLifecycle(ca.Lifecycle)
+			cas[j] = ca
+		}
+		r.CopyActions = cas
+		res[i] = r
+	}
+	sort.Slice(res, func(i, j int) bool { return aws.StringValue(res[i].RuleName) < aws.StringValue(res[j].RuleName) })
+	return res
+}
+
+// IsBackupPlanUpToDate returns true if the rules of the observed plan match
+// the parameters.
+func IsBackupPlanUpToDate(p v1alpha1.BackupPlanParameters, o backup.BackupPlan) bool {
+	observed := make([]backup.BackupRuleInput, len(o.Rules))
+	for i, r := range o.Rules {
+		observed[i] = backup.BackupRuleInput{
+			RuleName:                r.RuleName,
+			TargetBackupVaultName:   r.TargetBackupVaultName,
+			ScheduleExpression:      r.ScheduleExpression,
+			StartWindowMinutes:      r.StartWindowMinutes,
+			CompletionWindowMinutes: r.CompletionWindowMinutes,
+			Lifecycle:               r.Lifecycle,
+			RecoveryPointTags:       r.RecoveryPointTags,
+			CopyActions:             r.CopyActions,
+		}
+	}
+	return cmp.Equal(normalizeRules(GenerateBackupPlanInput(p).Rules), normalizeRules(observed), cmpopts.EquateEmpty())
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/backup/v1alpha1"
+)
+
+var (
+	planName  = "example"
+	ruleName  = "daily"
+	vaultName = "example-vault"
+	vaultARN  = "arn:aws:backup:us-west-2:123456789012:backup-vault:example-vault"
+	schedule  = "cron(0 5 ? * * *)"
+)
+
+func planParameters(m ...func(*v1alpha1.BackupPlanParameters)) v1alpha1.BackupPlanParameters {
+	p := v1alpha1.BackupPlanParameters{
+		Name: planName,
+		Rules: []v1alpha1.BackupRule{{
+			RuleName:                ruleName,
+			TargetBackupVaultName:   aws.String(vaultName),
+			ScheduleExpression:      aws.String(schedule),
+			StartWindowMinutes:      aws.Int64(480),
+			CompletionWindowMinutes: aws.Int64(10080),
+			Lifecycle:               &v1alpha1.Lifecycle{DeleteAfterDays: aws.Int64(35)},
+			CopyActions: []v1alpha1.CopyAction{{
+				DestinationBackupVaultARN: aws.String(vaultARN),
+			}},
+		}},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func observedPlan(m ...func(*backup.BackupPlan)) backup.BackupPlan {
+	o := backup.BackupPlan{
+		BackupPlanName: aws.String(planName),
+		Rules: []backup.BackupRule{{
+			RuleId:                  aws.String("rule-id"),
+			RuleName:                aws.String(ruleName),
+			TargetBackupVaultName:   aws.String(vaultName),
+			ScheduleExpression:      aws.String(schedule),
+			StartWindowMinutes:      aws.Int64(480),
+			CompletionWindowMinutes: aws.Int64(10080),
+			Lifecycle:               &backup.Lifecycle{DeleteAfterDays: aws.Int64(35)},
+			CopyActions: []backup.CopyAction{{
+				DestinationBackupVaultArn: aws.String(vaultARN),
+				Lifecycle:                 &backup.Lifecycle{},
+			}},
+		}},
+	}
+	for _, f := range m {
+		f(&o)
+	}
+	return o
+}
+
+func TestLateInitializeBackupPlan(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.BackupPlanParameters
+		o    *backup.BackupPlan
+		want v1alpha1.BackupPlanParameters
+	}{
+		"NoObservation": {
+			p:    v1alpha1.BackupPlanParameters{Name: planName, Rules: []v1alpha1.BackupRule{{RuleName: ruleName}}},
+			want: v1alpha1.BackupPlanParameters{Name: planName, Rules: []v1alpha1.BackupRule{{RuleName: ruleName}}},
+		},
+		"Defaults": {
+			p: planParameters(func(p *v1alpha1.BackupPlanParameters) {
+				p.Rules[0].ScheduleExpression = nil
+				p.Rules[0].StartWindowMinutes = nil
+				p.Rules[0].CompletionWindowMinutes = nil
+			}),
+			o:    func() *backup.BackupPlan { o := observedPlan(); return &o }(),
+			want: planParameters(),
+		},
+		"UnknownRule": {
+			p:    v1alpha1.BackupPlanParameters{Name: planName, Rules: []v1alpha1.BackupRule{{RuleName: "weekly"}}},
+			o:    func() *backup.BackupPlan { o := observedPlan(); return &o }(),
+			want: v1alpha1.BackupPlanParameters{Name: planName, Rules: []v1alpha1.BackupRule{{RuleName: "weekly"}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeBackupPlan(&tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsBackupPlanUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.BackupPlanParameters
+		o    backup.BackupPlan
+		want bool
+	}{
+		"UpToDate": {
+			p:    planParameters(),
+			o:    observedPlan(),
+			want: true,
+		},
+		"ScheduleChanged": {
+			p: planParameters(func(p *v1alpha1.BackupPlanParameters) {
+				p.Rules[0].ScheduleExpression = aws.String("cron(0 1 ? * * *)")
+			}),
+			o:    observedPlan(),
+			want: false,
+		},
+		"LifecycleRemoved": {
+			p: planParameters(func(p *v1alpha1.BackupPlanParameters) {
+				p.Rules[0].Lifecycle = nil
+			}),
+			o:    observedPlan(),
+			want: false,
+		},
+		"RuleAdded": {
+			p: planParameters(func(p *v1alpha1.BackupPlanParameters) {
+				p.Rules = append(p.Rules, v1alpha1.BackupRule{RuleName: "weekly", TargetBackupVaultName: aws.String(vaultName)})
+			}),
+			o:    observedPlan(),
+			want: false,
+		},
+		"RulesReordered": {
+			p: planParameters(func(p *v1alpha1.BackupPlanParameters) {
+				p.Rules = append([]v1alpha1.BackupRule{{RuleName: "weekly", TargetBackupVaultName: aws.String(vaultName)}}, p.Rules...)
+			}),
+			o: observedPlan(func(o *backup.BackupPlan) {
+				o.Rules = append(o.Rules, backup.BackupRule{RuleName: aws.String("weekly"), TargetBackupVaultName: aws.String(vaultName)})
+			}),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsBackupPlanUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
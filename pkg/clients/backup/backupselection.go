@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/backup/v1alpha1"
+)
+
+// BackupSelectionClient is the external client used for BackupSelection
+// Custom Resource
+type BackupSelectionClient interface {
+	GetBackupSelectionRequest(*backup.GetBackupSelectionInput) backup.GetBackupSelectionRequest
+	CreateBackupSelectionRequest(*backup.CreateBackupSelectionInput) backup.CreateBackupSelectionRequest
+	DeleteBackupSelectionRequest(*backup.DeleteBackupSelectionInput) backup.DeleteBackupSelectionRequest
+}
+
+// NewBackupSelectionClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewBackupSelectionClient(cfg aws.Config) BackupSelectionClient {
+	return backup.New(cfg)
+}
+
+// GenerateCreateBackupSelectionInput returns the create input of a selection
+// with the given parameters. The resources that are referenced by ARN are
+// selected together with Resources. The idempotency token makes sure that
+// retries don't create more than one selection.
+func GenerateCreateBackupSelectionInput(idempotencyToken string, p v1alpha1.BackupSelectionParameters) *backup.CreateBackupSelectionInput {
+	sel := &backup.BackupSelection{
+		SelectionName: aws.String(p.Name),
+		IamRoleArn:    p.IAMRoleARN,
+	}
+	sel.Resources = append(sel.Resources, p.Resources...)
+	sel.Resources = append(sel.Resources, p.RDSInstanceARNs...)
+	sel.Resources = append(sel.Resources, p.DynamoTableARNs...)
+	for _, c := range p.ListOfTags {
+		sel.ListOfTags = append(sel.ListOfTags, backup.Condition{
+			ConditionType:  backup.ConditionType(c.Type),
+			ConditionKey:   aws.String(c.Key),
+			ConditionValue: aws.String(c.Value),
+		})
+	}
+	return &backup.CreateBackupSelectionInput{
+		BackupPlanId:     p.BackupPlanID,
+		BackupSelection:  sel,
+		CreatorRequestId: aws.String(idempotencyToken),
+	}
+}
+
+// GenerateBackupSelectionObservation returns the observation of the given
+// selection.
+func GenerateBackupSelectionObservation(o backup.GetBackupSelectionOutput) v1alpha1.BackupSelectionObservation {
+	res := v1alpha1.BackupSelectionObservation{}
+	if o.CreationDate != nil {
+		t := metav1.NewTime(*o.CreationDate)
+		res.CreationDate = &t
+	}
+	return res
+}
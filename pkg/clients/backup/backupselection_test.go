@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/backup/v1alpha1"
+)
+
+func TestGenerateCreateBackupSelectionInput(t *testing.T) {
+	roleARN := "arn:aws:iam::123456789012:role/backup"
+	dbARN := "arn:aws:rds:us-west-2:123456789012:db:example"
+	tableARN := "arn:aws:dynamodb:us-west-2:123456789012:table/example"
+	volumes := "arn:aws:ec2:us-west-2:123456789012:volume/*"
+
+	cases := map[string]struct {
+		p    v1alpha1.BackupSelectionParameters
+		want *backup.CreateBackupSelectionInput
+	}{
+		"Tags": {
+			p: v1alpha1.BackupSelectionParameters{
+				Name:         "example",
+				BackupPlanID: aws.String("plan-id"),
+				IAMRoleARN:   aws.String(roleARN),
+				ListOfTags:   []v1alpha1.TagCondition{{Type: "STRINGEQUALS", Key: "backup", Value: "daily"}},
+			},
+			want: &backup.CreateBackupSelectionInput{
+				BackupPlanId:     aws.String("plan-id"),
+				CreatorRequestId: aws.String("uid"),
+				BackupSelection: &backup.BackupSelection{
+					SelectionName: aws.String("example"),
+					IamRoleArn:    aws.String(roleARN),
+					ListOfTags: []backup.Condition{{
+						ConditionType:  backup.ConditionTypeStringequals,
+						ConditionKey:   aws.String("backup"),
+						ConditionValue: aws.String("daily"),
+					}},
+				},
+			},
+		},
+		"Resources": {
+			p: v1alpha1.BackupSelectionParameters{
+				Name:            "example",
+				BackupPlanID:    aws.String("plan-id"),
+				IAMRoleARN:      aws.String(roleARN),
+				Resources:       []string{volumes},
+				RDSInstanceARNs: []string{dbARN},
+				DynamoTableARNs: []string{tableARN},
+			},
+			want: &backup.CreateBackupSelectionInput{
+				BackupPlanId:     aws.String("plan-id"),
+				CreatorRequestId: aws.String("uid"),
+				BackupSelection: &backup.BackupSelection{
+					SelectionName: aws.String("example"),
+					IamRoleArn:    aws.String(roleARN),
+					Resources:     []string{volumes, dbARN, tableARN},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateBackupSelectionInput("uid", tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/backup/v1alpha1"
+)
+
+// BackupVaultClient is the external client used for BackupVault Custom
+// Resource
+type BackupVaultClient interface {
+	DescribeBackupVaultRequest(*backup.DescribeBackupVaultInput) backup.DescribeBackupVaultRequest
+	CreateBackupVaultRequest(*backup.CreateBackupVaultInput) backup.CreateBackupVaultRequest
+	DeleteBackupVaultRequest(*backup.DeleteBackupVaultInput) backup.DeleteBackupVaultRequest
+	GetBackupVaultAccessPolicyRequest(*backup.GetBackupVaultAccessPolicyInput) backup.GetBackupVaultAccessPolicyRequest
+	PutBackupVaultAccessPolicyRequest(*backup.PutBackupVaultAccessPolicyInput) backup.PutBackupVaultAccessPolicyRequest
+	DeleteBackupVaultAccessPolicyRequest(*backup.DeleteBackupVaultAccessPolicyInput) backup.DeleteBackupVaultAccessPolicyRequest
+	ListTagsRequest(*backup.ListTagsInput) backup.ListTagsRequest
+	TagResourceRequest(*backup.TagResourceInput) backup.TagResourceRequest
+	UntagResourceRequest(*backup.UntagResourceInput) backup.UntagResourceRequest
+}
+
+// NewBackupVaultClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewBackupVaultClient(cfg aws.Config) BackupVaultClient {
+	return backup.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the vault, plan, selection
+// or vault access policy doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == backup.ErrCodeResourceNotFoundException {
+		return true
+	}
+	return false
+}
+
+// GenerateCreateBackupVaultInput returns the create input of the vault with
+// the given name and parameters. The access policy is put separately.
+func GenerateCreateBackupVaultInput(name, idempotencyToken string, p v1alpha1.BackupVaultParameters) *backup.CreateBackupVaultInput {
+	return &backup.CreateBackupVaultInput{
+		BackupVaultName:  aws.String(name),
+		BackupVaultTags:  p.Tags,
+		CreatorRequestId: aws.String(idempotencyToken),
+		EncryptionKeyArn: p.EncryptionKeyARN,
+	}
+}
+
+// GenerateBackupVaultObservation returns the observation of the given vault.
+func GenerateBackupVaultObservation(o backup.DescribeBackupVaultOutput) v1alpha1.BackupVaultObservation {
+	return v1alpha1.BackupVaultObservation{
+		ARN:                    aws.StringValue(o.BackupVaultArn),
+		NumberOfRecoveryPoints: aws.Int64Value(o.NumberOfRecoveryPoints),
+	}
+}
+
+// LateInitializeBackupVault fills the empty fields of the vault parameters
+// with the values of the observed vault.
+func LateInitializeBackupVault(in *v1alpha1.BackupVaultParameters, o *backup.DescribeBackupVaultOutput) {
+	if o == nil {
+		return
+	}
+	if in.EncryptionKeyARN == nil {
+		in.EncryptionKeyARN = o.EncryptionKeyArn
+	}
+}
+
+// IsAccessPolicyUpToDate returns true if the observed access policy of a
+// vault is semantically equal to the desired one. A vault without a desired
+// access policy must not have one.
+func IsAccessPolicyUpToDate(p v1alpha1.BackupVaultParameters, observed string) bool {
+	if p.AccessPolicy == nil {
+		return observed == ""
+	}
+	var d, o interface{}
+	if err := json.Unmarshal([]byte(*p.AccessPolicy), &d); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(observed), &o); err != nil {
+		return false
+	}
+	return cmp.Equal(d, o)
+}
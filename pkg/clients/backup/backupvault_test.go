@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/backup/v1alpha1"
+)
+
+func TestIsAccessPolicyUpToDate(t *testing.T) {
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"backup:DeleteRecoveryPoint","Resource":"*"}]}`
+	reordered := `{"Statement":[{"Resource":"*","Action":"backup:DeleteRecoveryPoint","Principal":"*","Effect":"Deny"}],"Version":"2012-10-17"}`
+
+	cases := map[string]struct {
+		p        v1alpha1.BackupVaultParameters
+		observed string
+		want     bool
+	}{
+		"NoPolicy": {
+			want: true,
+		},
+		"PolicyToRemove": {
+			observed: policy,
+			want:     false,
+		},
+		"PolicyToPut": {
+			p:    v1alpha1.BackupVaultParameters{AccessPolicy: aws.String(policy)},
+			want: false,
+		},
+		"SemanticallyEqual": {
+			p:        v1alpha1.BackupVaultParameters{AccessPolicy: aws.String(policy)},
+			observed: reordered,
+			want:     true,
+		},
+		"Different": {
+			p:        v1alpha1.BackupVaultParameters{AccessPolicy: aws.String(policy)},
+			observed: `{"Version":"2012-10-17","Statement":[]}`,
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAccessPolicyUpToDate(tc.p, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
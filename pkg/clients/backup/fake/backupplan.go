@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/backup"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.BackupPlanClient = (*MockBackupPlanClient)(nil)
+
+// MockBackupPlanClient is a type that implements all the methods for BackupPlanClient interface
+type MockBackupPlanClient struct {
+	MockGetBackupPlan    func(*backup.GetBackupPlanInput) backup.GetBackupPlanRequest
+	MockCreateBackupPlan func(*backup.CreateBackupPlanInput) backup.CreateBackupPlanRequest
+	MockUpdateBackupPlan func(*backup.UpdateBackupPlanInput) backup.UpdateBackupPlanRequest
+	MockDeleteBackupPlan func(*backup.DeleteBackupPlanInput) backup.DeleteBackupPlanRequest
+	MockListTags         func(*backup.ListTagsInput) backup.ListTagsRequest
+	MockTagResource      func(*backup.TagResourceInput) backup.TagResourceRequest
+	MockUntagResource    func(*backup.UntagResourceInput) backup.UntagResourceRequest
+}
+
+// GetBackupPlanRequest mocks GetBackupPlanRequest method
+func (m *MockBackupPlanClient) GetBackupPlanRequest(input *backup.GetBackupPlanInput) backup.GetBackupPlanRequest {
+	return m.MockGetBackupPlan(input)
+}
+
+// CreateBackupPlanRequest mocks CreateBackupPlanRequest method
+func (m *MockBackupPlanClient) CreateBackupPlanRequest(input *backup.CreateBackupPlanInput) backup.CreateBackupPlanRequest {
+	return m.MockCreateBackupPlan(input)
+}
+
+// UpdateBackupPlanRequest mocks UpdateBackupPlanRequest method
+func (m *MockBackupPlanClient) UpdateBackupPlanRequest(input *backup.UpdateBackupPlanInput) backup.UpdateBackupPlanRequest {
+	return m.MockUpdateBackupPlan(input)
+}
+
+// DeleteBackupPlanRequest mocks DeleteBackupPlanRequest method
+func (m *MockBackupPlanClient) DeleteBackupPlanRequest(input *backup.DeleteBackupPlanInput) backup.DeleteBackupPlanRequest {
+	return m.MockDeleteBackupPlan(input)
+}
+
+// ListTagsRequest mocks ListTagsRequest method
+func (m *MockBackupPlanClient) ListTagsRequest(input *backup.ListTagsInput) backup.ListTagsRequest {
+	return m.MockListTags(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockBackupPlanClient) TagResourceRequest(input *backup.TagResourceInput) backup.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockBackupPlanClient) UntagResourceRequest(input *backup.UntagResourceInput) backup.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
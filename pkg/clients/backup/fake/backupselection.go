@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/backup"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.BackupSelectionClient = (*MockBackupSelectionClient)(nil)
+
+// MockBackupSelectionClient is a type that implements all the methods for BackupSelectionClient interface
+type MockBackupSelectionClient struct {
+	MockGetBackupSelection    func(*backup.GetBackupSelectionInput) backup.GetBackupSelectionRequest
+	MockCreateBackupSelection func(*backup.CreateBackupSelectionInput) backup.CreateBackupSelectionRequest
+	MockDeleteBackupSelection func(*backup.DeleteBackupSelectionInput) backup.DeleteBackupSelectionRequest
+}
+
+// GetBackupSelectionRequest mocks GetBackupSelectionRequest method
+func (m *MockBackupSelectionClient) GetBackupSelectionRequest(input *backup.GetBackupSelectionInput) backup.GetBackupSelectionRequest {
+	return m.MockGetBackupSelection(input)
+}
+
+// CreateBackupSelectionRequest mocks CreateBackupSelectionRequest method
+func (m *MockBackupSelectionClient) CreateBackupSelectionRequest(input *backup.CreateBackupSelectionInput) backup.CreateBackupSelectionRequest {
+	return m.MockCreateBackupSelection(input)
+}
+
+// DeleteBackupSelectionRequest mocks DeleteBackupSelectionRequest method
+func (m *MockBackupSelectionClient) DeleteBackupSelectionRequest(input *backup.DeleteBackupSelectionInput) backup.DeleteBackupSelectionRequest {
+	return m.MockDeleteBackupSelection(input)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/backup"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.BackupVaultClient = (*MockBackupVaultClient)(nil)
+
+// MockBackupVaultClient is a type that implements all the methods for BackupVaultClient interface
+type MockBackupVaultClient struct {
+	MockDescribeBackupVault           func(*backup.DescribeBackupVaultInput) backup.DescribeBackupVaultRequest
+	MockCreateBackupVault             func(*backup.CreateBackupVaultInput) backup.CreateBackupVaultRequest
+	MockDeleteBackupVault             func(*backup.DeleteBackupVaultInput) backup.DeleteBackupVaultRequest
+	MockGetBackupVaultAccessPolicy    func(*backup.GetBackupVaultAccessPolicyInput) backup.GetBackupVaultAccessPolicyRequest
+	MockPutBackupVaultAccessPolicy    func(*backup.PutBackupVaultAccessPolicyInput) backup.PutBackupVaultAccessPolicyRequest
+	MockDeleteBackupVaultAccessPolicy func(*backup.DeleteBackupVaultAccessPolicyInput) backup.DeleteBackupVaultAccessPolicyRequest
+	MockListTags                      func(*backup.ListTagsInput) backup.ListTagsRequest
+	MockTagResource                   func(*backup.TagResourceInput) backup.TagResourceRequest
+	MockUntagResource                 func(*backup.UntagResourceInput) backup.UntagResourceRequest
+}
+
+// DescribeBackupVaultRequest mocks DescribeBackupVaultRequest method
+func (m *MockBackupVaultClient) DescribeBackupVaultRequest(input *backup.DescribeBackupVaultInput) backup.DescribeBackupVaultRequest {
+	return m.MockDescribeBackupVault(input)
+}
+
+// CreateBackupVaultRequest mocks CreateBackupVaultRequest method
+func (m *MockBackupVaultClient) CreateBackupVaultRequest(input *backup.CreateBackupVaultInput) backup.CreateBackupVaultRequest {
+	return m.MockCreateBackupVault(input)
+}
+
+// DeleteBackupVaultRequest mocks DeleteBackupVaultRequest method
+func (m *MockBackupVaultClient) DeleteBackupVaultRequest(input *backup.DeleteBackupVaultInput) backup.DeleteBackupVaultRequest {
+	return m.MockDeleteBackupVault(input)
+}
+
+// GetBackupVaultAccessPolicyRequest mocks GetBackupVaultAccessPolicyRequest method
+func (m *MockBackupVaultClient) GetBackupVaultAccessPolicyRequest(input *backup.GetBackupVaultAccessPolicyInput) backup.GetBackupVaultAccessPolicyRequest {
+	return m.MockGetBackupVaultAccessPolicy(input)
+}
+
+// PutBackupVaultAccessPolicyRequest mocks PutBackupVaultAccessPolicyRequest method
+func (m *MockBackupVaultClient) PutBackupVaultAccessPolicyRequest(input *backup.PutBackupVaultAccessPolicyInput) backup.PutBackupVaultAccessPolicyRequest {
+	return m.MockPutBackupVaultAccessPolicy(input)
+}
+
+// DeleteBackupVaultAccessPolicyRequest mocks DeleteBackupVaultAccessPolicyRequest method
+func (m *MockBackupVaultClient) DeleteBackupVaultAccessPolicyRequest(input *backup.DeleteBackupVaultAccessPolicyInput) backup.DeleteBackupVaultAccessPolicyRequest {
+	return m.MockDeleteBackupVaultAccessPolicy(input)
+}
+
+// ListTagsRequest mocks ListTagsRequest method
+func (m *MockBackupVaultClient) ListTagsRequest(input *backup.ListTagsInput) backup.ListTagsRequest {
+	return m.MockListTags(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockBackupVaultClient) TagResourceRequest(input *backup.TagResourceInput) backup.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockBackupVaultClient) UntagResourceRequest(input *backup.UntagResourceInput) backup.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ComputeEnvironmentClient is the external client used for
+// ComputeEnvironment Custom Resource
+type ComputeEnvironmentClient interface {
+	DescribeComputeEnvironmentsRequest(*batch.DescribeComputeEnvironmentsInput) batch.DescribeComputeEnvironmentsRequest
+	CreateComputeEnvironmentRequest(*batch.CreateComputeEnvironmentInput) batch.CreateComputeEnvironmentRequest
+	UpdateComputeEnvironmentRequest(*batch.UpdateComputeEnvironmentInput) batch.UpdateComputeEnvironmentRequest
+	DeleteComputeEnvironmentRequest(*batch.DeleteComputeEnvironmentInput) batch.DeleteComputeEnvironmentRequest
+}
+
+// NewComputeEnvironmentClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewComputeEnvironmentClient(cfg aws.Config) ComputeEnvironmentClient {
+	return batch.New(cfg)
+}
+
+// GenerateCreateComputeEnvironmentInput returns the create input of the
+// compute environment with the given name and parameters.
+func GenerateCreateComputeEnvironmentInput(name string, p v1alpha1.ComputeEnvironmentParameters) *batch.CreateComputeEnvironmentInput {
+	in := &batch.CreateComputeEnvironmentInput{
+		ComputeEnvironmentName: aws.String(name),
+		Type:                   batch.CEType(p.Type),
+		ServiceRole:            p.ServiceRoleARN,
+		State:                  batch.CEState(aws.StringValue(p.State)),
+	}
+	if cr := p.ComputeResources; cr != nil {
+		in.ComputeResources = &batch.ComputeResource{
+			Type:               batch.CRType(cr.Type),
+			AllocationStrategy: batch.CRAllocationStrategy(aws.StringValue(cr.AllocationStrategy)),
+			MinvCpus:           aws.Int64(cr.MinvCPUs),
+			MaxvCpus:           aws.Int64(cr.MaxvCPUs),
+			DesiredvCpus:       cr.DesiredvCPUs,
+			InstanceTypes:      cr.InstanceTypes,
+			ImageId:            cr.ImageID,
+			InstanceRole:       aws.String(cr.InstanceRole),
+			Ec2KeyPair:         cr.EC2KeyPair,
+			PlacementGroup:     cr.PlacementGroup,
+			BidPercentage:      cr.BidPercentage,
+			SpotIamFleetRole:   cr.SpotIAMFleetRoleARN,
+			Subnets:            cr.Subnets,
+			SecurityGroupIds:   cr.SecurityGroupIDs,
+			Tags:               cr.Tags,
+		}
+		if lt := cr.LaunchTemplate; lt != nil {
+			in.ComputeResources.LaunchTemplate = &batch.LaunchTemplateSpecification{
+				LaunchTemplateId:   lt.LaunchTemplateID,
+				LaunchTemplateName: lt.LaunchTemplateName,
+				Version:            lt.Version,
+			}
+		}
+	}
+	return in
+}
+
+// GenerateUpdateComputeEnvironmentInput returns the update input of the
+// compute environment with the given name and parameters. Only the state,
+// the service role and the vCPU limits can be updated.
+func GenerateUpdateComputeEnvironmentInput(name string, p v1alpha1.ComputeEnvironmentParameters) *batch.UpdateComputeEnvironmentInput {
+	in := &batch.UpdateComputeEnvironmentInput{
+		ComputeEnvironment: aws.String(name),
+		ServiceRole:        p.ServiceRoleARN,
+		State:              batch.CEState(aws.StringValue(p.State)),
+	}
+	if p.ComputeResources != nil {
+		in.ComputeResources = &batch.ComputeResourceUpdate{
+			MinvCpus: aws.Int64(p.ComputeResources.MinvCPUs),
+			MaxvCpus: aws.Int64(p.ComputeResources.MaxvCPUs),
+		}
+	}
+	return in
+}
+
+// GenerateComputeEnvironmentObservation returns the observation of the given
+// compute environment.
+func GenerateComputeEnvironmentObservation(o batch.ComputeEnvironmentDetail) v1alpha1.ComputeEnvironmentObservation {
+	return v1alpha1.ComputeEnvironmentObservation{
+		ARN:           aws.StringValue(o.ComputeEnvironmentArn),
+		ECSClusterARN: aws.StringValue(o.EcsClusterArn),
+		State:         string(o.State),
+		Status:        string(o.Status),
+		StatusReason:  aws.StringValue(o.StatusReason),
+	}
+}
+
+// LateInitializeComputeEnvironment fills the empty fields of the compute
+// environment parameters with the values of the observed compute
+// environment. The desired vCPUs are not late initialized because Batch
+// changes them while it scales.
+func LateInitializeComputeEnvironment(in *v1alpha1.ComputeEnvironmentParameters, o *batch.ComputeEnvironmentDetail) {
+	if o == nil {
+		return
+	}
+	in.State = awsclients.LateInitializeStringPtr(in.State, awsclients.String(string(o.State)))
+	in.ServiceRoleARN = awsclients.LateInitializeStringPtr(in.ServiceRoleARN, o.ServiceRole)
+	if in.ComputeResources == nil || o.ComputeResources == nil {
+		return
+	}
+	cr, ocr := in.ComputeResources, o.ComputeResources
+	cr.AllocationStrategy = awsclients.LateInitializeStringPtr(cr.AllocationStrategy, awsclients.String(string(ocr.AllocationStrategy)))
+	cr.ImageID = awsclients.LateInitializeStringPtr(cr.ImageID, ocr.ImageId)
+	cr.EC2KeyPair = awsclients.LateInitializeStringPtr(cr.EC2KeyPair, ocr.Ec2KeyPair)
+	cr.PlacementGroup = awsclients.LateInitializeStringPtr(cr.PlacementGroup, ocr.PlacementGroup)
+	cr.BidPercentage = awsclients.LateInitializeInt64Ptr(cr.BidPercentage, ocr.BidPercentage)
+	if len(cr.SecurityGroupIDs) == 0 && len(cr.SecurityGroupIDRefs) == 0 && cr.SecurityGroupIDSelector == nil {
+		cr.SecurityGroupIDs = ocr.SecurityGroupIds
+	}
+}
+
+// IsComputeEnvironmentUpToDate returns true if the updatable fields of the
+// observed compute environment match the parameters.
+func IsComputeEnvironmentUpToDate(p v1alpha1.ComputeEnvironmentParameters, o batch.ComputeEnvironmentDetail) bool {
+	if p.State != nil && *p.State != string(o.State) {
+		return false
+	}
+	if p.ServiceRoleARN != nil && *p.ServiceRoleARN != aws.StringValue(o.ServiceRole) {
+		return false
+	}
+	if p.ComputeResources == nil || o.ComputeResources == nil {
+		return true
+	}
+	return p.ComputeResources.MinvCPUs == aws.Int64Value(o.ComputeResources.MinvCpus) &&
+		p.ComputeResources.MaxvCPUs == aws.Int64Value(o.ComputeResources.MaxvCpus)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+)
+
+var (
+	serviceRole = "arn:aws:iam::123456789012:role/AWSBatchServiceRole"
+	sg          = "sg-0123456789"
+)
+
+func TestLateInitializeComputeEnvironment(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.ComputeEnvironmentParameters
+		observed *batch.ComputeEnvironmentDetail
+		want     v1alpha1.ComputeEnvironmentParameters
+	}{
+		"AllFilled": {
+			in: v1alpha1.ComputeEnvironmentParameters{
+				ComputeResources: &v1alpha1.ComputeResources{MinvCPUs: 0, MaxvCPUs: 16},
+			},
+			observed: &batch.ComputeEnvironmentDetail{
+				State:       batch.CEStateEnabled,
+				ServiceRole: aws.String(serviceRole),
+				ComputeResources: &batch.ComputeResource{
+					AllocationStrategy: batch.CRAllocationStrategyBestFit,
+					ImageId:            aws.String("ami-123"),
+					SecurityGroupIds:   []string{sg},
+				},
+			},
+			want: v1alpha1.ComputeEnvironmentParameters{
+				State:          aws.String("ENABLED"),
+				ServiceRoleARN: aws.String(serviceRole),
+				ComputeResources: &v1alpha1.ComputeResources{
+					MinvCPUs:           0,
+					MaxvCPUs:           16,
+					AllocationStrategy: aws.String("BEST_FIT"),
+					ImageID:            aws.String("ami-123"),
+					SecurityGroupIDs:   []string{sg},
+				},
+			},
+		},
+		"KeepSecurityGroupSelector": {
+			in: v1alpha1.ComputeEnvironmentParameters{
+				State:            aws.String("DISABLED"),
+				ComputeResources: &v1alpha1.ComputeResources{SecurityGroupIDSelector: &runtimev1alpha1.Selector{}},
+			},
+			observed: &batch.ComputeEnvironmentDetail{
+				State:            batch.CEStateEnabled,
+				ComputeResources: &batch.ComputeResource{SecurityGroupIds: []string{sg}},
+			},
+			want: v1alpha1.ComputeEnvironmentParameters{
+				State:            aws.String("DISABLED"),
+				ComputeResources: &v1alpha1.ComputeResources{SecurityGroupIDSelector: &runtimev1alpha1.Selector{}},
+			},
+		},
+		"NoObservation": {
+			in:   v1alpha1.ComputeEnvironmentParameters{State: aws.String("ENABLED")},
+			want: v1alpha1.ComputeEnvironmentParameters{State: aws.String("ENABLED")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeComputeEnvironment(&tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsComputeEnvironmentUpToDate(t *testing.T) {
+	observed := batch.ComputeEnvironmentDetail{
+		State:       batch.CEStateEnabled,
+		ServiceRole: aws.String(serviceRole),
+		ComputeResources: &batch.ComputeResource{
+			MinvCpus:     aws.Int64(0),
+			MaxvCpus:     aws.Int64(16),
+			DesiredvCpus: aws.Int64(4),
+		},
+	}
+	cases := map[string]struct {
+		p    v1alpha1.ComputeEnvironmentParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.ComputeEnvironmentParameters{
+				State:            aws.String("ENABLED"),
+				ServiceRoleARN:   aws.String(serviceRole),
+				ComputeResources: &v1alpha1.ComputeResources{MinvCPUs: 0, MaxvCPUs: 16, DesiredvCPUs: aws.Int64(0)},
+			},
+			want: true,
+		},
+		"StateChanged": {
+			p: v1alpha1.ComputeEnvironmentParameters{
+				State:            aws.String("DISABLED"),
+				ComputeResources: &v1alpha1.ComputeResources{MinvCPUs: 0, MaxvCPUs: 16},
+			},
+			want: false,
+		},
+		"MaxvCPUsChanged": {
+			p: v1alpha1.ComputeEnvironmentParameters{
+				ComputeResources: &v1alpha1.ComputeResources{MinvCPUs: 0, MaxvCPUs: 32},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsComputeEnvironmentUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/batch"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ComputeEnvironmentClient = (*MockComputeEnvironmentClient)(nil)
+
+// MockComputeEnvironmentClient is a type that implements all the methods for ComputeEnvironmentClient interface
+type MockComputeEnvironmentClient struct {
+	MockDescribeComputeEnvironments func(*batch.DescribeComputeEnvironmentsInput) batch.DescribeComputeEnvironmentsRequest
+	MockCreateComputeEnvironment    func(*batch.CreateComputeEnvironmentInput) batch.CreateComputeEnvironmentRequest
+	MockUpdateComputeEnvironment    func(*batch.UpdateComputeEnvironmentInput) batch.UpdateComputeEnvironmentRequest
+	MockDeleteComputeEnvironment    func(*batch.DeleteComputeEnvironmentInput) batch.DeleteComputeEnvironmentRequest
+}
+
+// DescribeComputeEnvironmentsRequest mocks DescribeComputeEnvironmentsRequest method
+func (m *MockComputeEnvironmentClient) DescribeComputeEnvironmentsRequest(input *batch.DescribeComputeEnvironmentsInput) batch.DescribeComputeEnvironmentsRequest {
+	return m.MockDescribeComputeEnvironments(input)
+}
+
+// CreateComputeEnvironmentRequest mocks CreateComputeEnvironmentRequest method
+func (m *MockComputeEnvironmentClient) CreateComputeEnvironmentRequest(input *batch.CreateComputeEnvironmentInput) batch.CreateComputeEnvironmentRequest {
+	return m.MockCreateComputeEnvironment(input)
+}
+
+// UpdateComputeEnvironmentRequest mocks UpdateComputeEnvironmentRequest method
+func (m *MockComputeEnvironmentClient) UpdateComputeEnvironmentRequest(input *batch.UpdateComputeEnvironmentInput) batch.UpdateComputeEnvironmentRequest {
+	return m.MockUpdateComputeEnvironment(input)
+}
+
+// DeleteComputeEnvironmentRequest mocks DeleteComputeEnvironmentRequest method
+func (m *MockComputeEnvironmentClient) DeleteComputeEnvironmentRequest(input *batch.DeleteComputeEnvironmentInput) batch.DeleteComputeEnvironmentRequest {
+	return m.MockDeleteComputeEnvironment(input)
+}
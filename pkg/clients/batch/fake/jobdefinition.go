@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/batch"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.JobDefinitionClient = (*MockJobDefinitionClient)(nil)
+
+// MockJobDefinitionClient is a type that implements all the methods for JobDefinitionClient interface
+type MockJobDefinitionClient struct {
+	MockDescribeJobDefinitions  func(*batch.DescribeJobDefinitionsInput) batch.DescribeJobDefinitionsRequest
+	MockRegisterJobDefinition   func(*batch.RegisterJobDefinitionInput) batch.RegisterJobDefinitionRequest
+	MockDeregisterJobDefinition func(*batch.DeregisterJobDefinitionInput) batch.DeregisterJobDefinitionRequest
+}
+
+// DescribeJobDefinitionsRequest mocks DescribeJobDefinitionsRequest method
+func (m *MockJobDefinitionClient) DescribeJobDefinitionsRequest(input *batch.DescribeJobDefinitionsInput) batch.DescribeJobDefinitionsRequest {
+	return m.MockDescribeJobDefinitions(input)
+}
+
+// RegisterJobDefinitionRequest mocks RegisterJobDefinitionRequest method
+func (m *MockJobDefinitionClient) RegisterJobDefinitionRequest(input *batch.RegisterJobDefinitionInput) batch.RegisterJobDefinitionRequest {
+	return m.MockRegisterJobDefinition(input)
+}
+
+// DeregisterJobDefinitionRequest mocks DeregisterJobDefinitionRequest method
+func (m *MockJobDefinitionClient) DeregisterJobDefinitionRequest(input *batch.DeregisterJobDefinitionInput) batch.DeregisterJobDefinitionRequest {
+	return m.MockDeregisterJobDefinition(input)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/batch"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.JobQueueClient = (*MockJobQueueClient)(nil)
+
+// MockJobQueueClient is a type that implements all the methods for JobQueueClient interface
+type MockJobQueueClient struct {
+	MockDescribeJobQueues func(*batch.DescribeJobQueuesInput) batch.DescribeJobQueuesRequest
+	MockCreateJobQueue    func(*batch.CreateJobQueueInput) batch.CreateJobQueueRequest
+	MockUpdateJobQueue    func(*batch.UpdateJobQueueInput) batch.UpdateJobQueueRequest
+	MockDeleteJobQueue    func(*batch.DeleteJobQueueInput) batch.DeleteJobQueueRequest
+}
+
+// DescribeJobQueuesRequest mocks DescribeJobQueuesRequest method
+func (m *MockJobQueueClient) DescribeJobQueuesRequest(input *batch.DescribeJobQueuesInput) batch.DescribeJobQueuesRequest {
+	return m.MockDescribeJobQueues(input)
+}
+
+// CreateJobQueueRequest mocks CreateJobQueueRequest method
+func (m *MockJobQueueClient) CreateJobQueueRequest(input *batch.CreateJobQueueInput) batch.CreateJobQueueRequest {
+	return m.MockCreateJobQueue(input)
+}
+
+// UpdateJobQueueRequest mocks UpdateJobQueueRequest method
+func (m *MockJobQueueClient) UpdateJobQueueRequest(input *batch.UpdateJobQueueInput) batch.UpdateJobQueueRequest {
+	return m.MockUpdateJobQueue(input)
+}
+
+// DeleteJobQueueRequest mocks DeleteJobQueueRequest method
+func (m *MockJobQueueClient) DeleteJobQueueRequest(input *batch.DeleteJobQueueInput) batch.DeleteJobQueueRequest {
+	return m.MockDeleteJobQueue(input)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+)
+
+// JobDefinitionStatusActive is the status of the revisions of a job
+// definition that have not been deregistered.
+const JobDefinitionStatusActive = "ACTIVE"
+
+// JobDefinitionClient is the external client used for JobDefinition Custom
+// Resource
+type JobDefinitionClient interface {
+	DescribeJobDefinitionsRequest(*batch.DescribeJobDefinitionsInput) batch.DescribeJobDefinitionsRequest
+	RegisterJobDefinitionRequest(*batch.RegisterJobDefinitionInput) batch.RegisterJobDefinitionRequest
+	DeregisterJobDefinitionRequest(*batch.DeregisterJobDefinitionInput) batch.DeregisterJobDefinitionRequest
+}
+
+// NewJobDefinitionClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewJobDefinitionClient(cfg aws.Config) JobDefinitionClient {
+	return batch.New(cfg)
+}
+
+// GenerateRegisterJobDefinitionInput returns the register input of a new
+// revision of the container job definition with the given name and
+// parameters.
+func GenerateRegisterJobDefinitionInput(name string, p v1alpha1.JobDefinitionParameters) *batch.RegisterJobDefinitionInput {
+	in := &batch.RegisterJobDefinitionInput{
+		JobDefinitionName:   aws.String(name),
+		Type:                batch.JobDefinitionTypeContainer,
+		ContainerProperties: generateContainerProperties(p.ContainerProperties),
+		Parameters:          p.Parameters,
+	}
+	if p.RetryStrategy != nil {
+		in.RetryStrategy = &batch.RetryStrategy{Attempts: aws.Int64(p.RetryStrategy.Attempts)}
+	}
+	if p.Timeout != nil {
+		in.Timeout = &batch.JobTimeout{AttemptDurationSeconds: aws.Int64(p.Timeout.AttemptDurationSeconds)}
+	}
+	return in
+}
+
+// LatestRevision returns the job definition with the highest revision of
+// the given ones, or nil if there are none.
+func LatestRevision(defs []batch.JobDefinition) *batch.JobDefinition {
+	var latest *batch.JobDefinition
+	for i := range defs {
+		if latest == nil || aws.Int64Value(defs[i].Revision) > aws.Int64Value(latest.Revision) {
+			latest = &defs[i]
+		}
+	}
+	return latest
+}
+
+// GenerateJobDefinitionObservation returns the observation of the given job
+// definition revision.
+func GenerateJobDefinitionObservation(o batch.JobDefinition) v1alpha1.JobDefinitionObservation {
+	return v1alpha1.JobDefinitionObservation{
+		ARN:      aws.StringValue(o.JobDefinitionArn),
+		Revision: aws.Int64Value(o.Revision),
+	}
+}
+
+// IsJobDefinitionUpToDate returns true if the given revision of a job
+// definition matches the parameters.
+func IsJobDefinitionUpToDate(p v1alpha1.JobDefinitionParameters, o batch.JobDefinition) bool {
+	observed := generateJobDefinitionParameters(o)
+	return cmp.Equal(&p, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.JobDefinitionParameters{}, "Region"),
+		cmpopts.IgnoreFields(v1alpha1.ContainerProperties{}, "JobRoleARNRef", "JobRoleARNSelector"))
+}
+
+func generateContainerProperties(c v1alpha1.ContainerProperties) *batch.ContainerProperties {
+	res := &batch.ContainerProperties{
+		Image:                  aws.String(c.Image),
+		Vcpus:                  aws.Int64(c.VCPUs),
+		Memory:                 aws.Int64(c.Memory),
+		Command:                c.Command,
+		JobRoleArn:             c.JobRoleARN,
+		Privileged:             c.Privileged,
+		ReadonlyRootFilesystem: c.ReadonlyRootFilesystem,
+		User:                   c.User,
+	}
+	for _, e := range c.Environment {
+		res.Environment = append(res.Environment, batch.KeyValuePair{Name: aws.String(e.Name), Value: aws.String(e.Value)})
+	}
+	for _, r := range c.ResourceRequirements {
+		res.ResourceRequirements = append(res.ResourceRequirements, batch.ResourceRequirement{Type: batch.ResourceType(r.Type), Value: aws.String(r.Value)})
+	}
+	for _, m := range c.MountPoints {
+		res.MountPoints = append(res.MountPoints, batch.MountPoint{ContainerPath: aws.String(m.ContainerPath), SourceVolume: aws.String(m.SourceVolume), ReadOnly: m.ReadOnly})
+	}
+	for _, v := range c.Volumes {
+		vol := batch.Volume{Name: aws.String(v.Name)}
+		if v.SourcePath != nil {
+			vol.Host = &batch.Host{SourcePath: v.SourcePath}
+		}
+		res.Volumes = append(res.Volumes, vol)
+	}
+	for _, u := range c.Ulimits {
+		res.Ulimits = append(res.Ulimits, batch.Ulimit{Name: aws.String(u.Name), SoftLimit: aws.Int64(u.SoftLimit), HardLimit: aws.Int64(u.HardLimit)})
+	}
+	return res
+}
+
+func generateJobDefinitionParameters(o batch.JobDefinition) *v1alpha1.JobDefinitionParameters {
+	p := &v1alpha1.JobDefinitionParameters{Parameters: o.Parameters}
+	if o.RetryStrategy != nil {
+		p.RetryStrategy = &v1alpha1.RetryStrategy{Attempts: aws.Int64Value(o.RetryStrategy.Attempts)}
+	}
+	if o.Timeout != nil {
+		p.Timeout = &v1alpha1.JobTimeout{AttemptDurationSeconds: aws.Int64Value(o.Timeout.AttemptDurationSeconds)}
+	}
+	c := o.ContainerProperties
+	if c == nil {
+		return p
+	}
+	p.ContainerProperties = v1alpha1.ContainerProperties{
+		Image:                  aws.StringValue(c.Image),
+		VCPUs:                  aws.Int64Value(c.Vcpus),
+		Memory:                 aws.Int64Value(c.Memory),
+		Command:                c.Command,
+		JobRoleARN:             c.JobRoleArn,
+		Privileged:             c.Privileged,
+		ReadonlyRootFilesystem: c.ReadonlyRootFilesystem,
+		User:                   c.User,
+	}
+	for _, e := range c.Environment {
+		p.ContainerProperties.Environment = append(p.ContainerProperties.Environment, v1alpha1.KeyValuePair{Name: aws.StringValue(e.Name), Value: aws.StringValue(e.Value)})
+	}
+	for _, r := range c.ResourceRequirements {
+		p.ContainerProperties.ResourceRequirements = append(p.ContainerProperties.ResourceRequirements, v1alpha1.ResourceRequirement{Type: string(r.Type), Value: aws.StringValue(r.Value)})
+	}
+	for _, m := range c.MountPoints {
+		p.ContainerProperties.MountPoints = append(p.ContainerProperties.MountPoints, v1alpha1.MountPoint{ContainerPath: aws.StringValue(m.ContainerPath), SourceVolume: aws.StringValue(m.SourceVolume), ReadOnly: m.ReadOnly})
+	}
+	for _, v := range c.Volumes {
+		vol := v1alpha1.Volume{Name: aws.StringValue(v.Name)}
+		if v.Host != nil {
+			vol.SourcePath = v.Host.SourcePath
+		}
+		p.ContainerProperties.Volumes = append(p.ContainerProperties.Volumes, vol)
+	}
+	for _, u := range c.Ulimits {
+		p.ContainerProperties.Ulimits = append(p.ContainerProperties.Ulimits, v1alpha1.Ulimit{Name: aws.StringValue(u.Name), SoftLimit: aws.Int64Value(u.SoftLimit), HardLimit: aws.Int64Value(u.HardLimit)})
+	}
+	return p
+}
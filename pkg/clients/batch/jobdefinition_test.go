@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+)
+
+func TestLatestRevision(t *testing.T) {
+	cases := map[string]struct {
+		defs []batch.JobDefinition
+		want *batch.JobDefinition
+	}{
+		"Latest": {
+			defs: []batch.JobDefinition{{Revision: aws.Int64(1)}, {Revision: aws.Int64(3)}, {Revision: aws.Int64(2)}},
+			want: &batch.JobDefinition{Revision: aws.Int64(3)},
+		},
+		"Empty": {
+			want: nil,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := LatestRevision(tc.defs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsJobDefinitionUpToDate(t *testing.T) {
+	observed := batch.JobDefinition{
+		Type: aws.String("container"),
+		ContainerProperties: &batch.ContainerProperties{
+			Image:       aws.String("busybox"),
+			Vcpus:       aws.Int64(1),
+			Memory:      aws.Int64(512),
+			Command:     []string{"echo", "hello"},
+			Environment: []batch.KeyValuePair{{Name: aws.String("FOO"), Value: aws.String("bar")}},
+		},
+		RetryStrategy: &batch.RetryStrategy{Attempts: aws.Int64(2)},
+	}
+	params := func(m ...func(*v1alpha1.JobDefinitionParameters)) v1alpha1.JobDefinitionParameters {
+		p := v1alpha1.JobDefinitionParameters{
+			Region: "us-east-1",
+			ContainerProperties: v1alpha1.ContainerProperties{
+				Image:       "busybox",
+				VCPUs:       1,
+				Memory:      512,
+				Command:     []string{"echo", "hello"},
+				Environment: []v1alpha1.KeyValuePair{{Name: "FOO", Value: "bar"}},
+			},
+			RetryStrategy: &v1alpha1.RetryStrategy{Attempts: 2},
+		}
+		for _, f := range m {
+			f(&p)
+		}
+		return p
+	}
+	cases := map[string]struct {
+		p    v1alpha1.JobDefinitionParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    params(),
+			want: true,
+		},
+		"ImageChanged": {
+			p:    params(func(p *v1alpha1.JobDefinitionParameters) { p.ContainerProperties.Image = "alpine" }),
+			want: false,
+		},
+		"EnvironmentChanged": {
+			p:    params(func(p *v1alpha1.JobDefinitionParameters) { p.ContainerProperties.Environment = nil }),
+			want: false,
+		},
+		"RetryStrategyRemoved": {
+			p:    params(func(p *v1alpha1.JobDefinitionParameters) { p.RetryStrategy = nil }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsJobDefinitionUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
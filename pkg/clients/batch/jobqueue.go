@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// JobQueueClient is the external client used for JobQueue Custom Resource
+type JobQueueClient interface {
+	DescribeJobQueuesRequest(*batch.DescribeJobQueuesInput) batch.DescribeJobQueuesRequest
+	CreateJobQueueRequest(*batch.CreateJobQueueInput) batch.CreateJobQueueRequest
+	UpdateJobQueueRequest(*batch.UpdateJobQueueInput) batch.UpdateJobQueueRequest
+	DeleteJobQueueRequest(*batch.DeleteJobQueueInput) batch.DeleteJobQueueRequest
+}
+
+// NewJobQueueClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewJobQueueClient(cfg aws.Config) JobQueueClient {
+	return batch.New(cfg)
+}
+
+// GenerateCreateJobQueueInput returns the create input of the job queue with
+// the given name and parameters.
+func GenerateCreateJobQueueInput(name string, p v1alpha1.JobQueueParameters) *batch.CreateJobQueueInput {
+	return &batch.CreateJobQueueInput{
+		JobQueueName:            aws.String(name),
+		Priority:                aws.Int64(p.Priority),
+		State:                   batch.JQState(aws.StringValue(p.State)),
+		ComputeEnvironmentOrder: generateComputeEnvironmentOrder(p.ComputeEnvironmentOrder),
+	}
+}
+
+// GenerateUpdateJobQueueInput returns the update input of the job queue with
+// the given name and parameters.
+func GenerateUpdateJobQueueInput(name string, p v1alpha1.JobQueueParameters) *batch.UpdateJobQueueInput {
+	return &batch.UpdateJobQueueInput{
+		JobQueue:                aws.String(name),
+		Priority:                aws.Int64(p.Priority),
+		State:                   batch.JQState(aws.StringValue(p.State)),
+		ComputeEnvironmentOrder: generateComputeEnvironmentOrder(p.ComputeEnvironmentOrder),
+	}
+}
+
+// GenerateJobQueueObservation returns the observation of the given job
+// queue.
+func GenerateJobQueueObservation(o batch.JobQueueDetail) v1alpha1.JobQueueObservation {
+	return v1alpha1.JobQueueObservation{
+		ARN:          aws.StringValue(o.JobQueueArn),
+		State:        string(o.State),
+		Status:       string(o.Status),
+		StatusReason: aws.StringValue(o.StatusReason),
+	}
+}
+
+// LateInitializeJobQueue fills the empty fields of the job queue parameters
+// with the values of the observed job queue.
+func LateInitializeJobQueue(in *v1alpha1.JobQueueParameters, o *batch.JobQueueDetail) {
+	if o == nil {
+		return
+	}
+	in.State = awsclients.LateInitializeStringPtr(in.State, awsclients.String(string(o.State)))
+}
+
+// IsJobQueueUpToDate returns true if the observed job queue matches the
+// parameters. The compute environments are compared by their order.
+func IsJobQueueUpToDate(p v1alpha1.JobQueueParameters, o batch.JobQueueDetail) bool {
+	if p.Priority != aws.Int64Value(o.Priority) {
+		return false
+	}
+	if p.State != nil && *p.State != string(o.State) {
+		return false
+	}
+	desired := generateComputeEnvironmentOrder(p.ComputeEnvironmentOrder)
+	if len(desired) != len(o.ComputeEnvironmentOrder) {
+		return false
+	}
+	observed := make([]batch.ComputeEnvironmentOrder, len(o.ComputeEnvironmentOrder))
+	copy(observed, o.ComputeEnvironmentOrder)
+	sortComputeEnvironmentOrder(desired)
+	sortComputeEnvironmentOrder(observed)
+	for i := range desired {
+		if aws.Int64Value(desired[i].Order) != aws.Int64Value(observed[i].Order) ||
+			aws.StringValue(desired[i].ComputeEnvironment) != aws.StringValue(observed[i].ComputeEnvironment) {
+			return false
+		}
+	}
+	return true
+}
+
+func generateComputeEnvironmentOrder(order []v1alpha1.ComputeEnvironmentOrder) []batch.ComputeEnvironmentOrder {
+	res := make([]batch.ComputeEnvironmentOrder, len(order))
+	for i, o := range order {
+		res[i] = batch.ComputeEnvironmentOrder{
+			Order:              aws.Int64(o.Order),
+			ComputeEnvironment: o.ComputeEnvironmentARN,
+		}
+	}
+	return res
+}
+
+func sortComputeEnvironmentOrder(order []batch.ComputeEnvironmentOrder) {
+	sort.Slice(order, func(i, j int) bool {
+		return aws.Int64Value(order[i].Order) < aws.Int64Value(order[j].Order)
+	})
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+)
+
+var (
+	ceOnDemand = "arn:aws:batch:us-east-1:123456789012:compute-environment/on-demand"
+	ceSpot     = "arn:aws:batch:us-east-1:123456789012:compute-environment/spot"
+)
+
+func TestIsJobQueueUpToDate(t *testing.T) {
+	observed := batch.JobQueueDetail{
+		Priority: aws.Int64(1),
+		State:    batch.JQStateEnabled,
+		ComputeEnvironmentOrder: []batch.ComputeEnvironmentOrder{
+			{Order: aws.Int64(2), ComputeEnvironment: aws.String(ceSpot)},
+			{Order: aws.Int64(1), ComputeEnvironment: aws.String(ceOnDemand)},
+		},
+	}
+	cases := map[string]struct {
+		p    v1alpha1.JobQueueParameters
+		want bool
+	}{
+		"UpToDateInAnyOrder": {
+			p: v1alpha1.JobQueueParameters{
+				Priority: 1,
+				State:    aws.String("ENABLED"),
+				ComputeEnvironmentOrder: []v1alpha1.ComputeEnvironmentOrder{
+					{Order: 1, ComputeEnvironmentARN: aws.String(ceOnDemand)},
+					{Order: 2, ComputeEnvironmentARN: aws.String(ceSpot)},
+				},
+			},
+			want: true,
+		},
+		"PriorityChanged": {
+			p: v1alpha1.JobQueueParameters{
+				Priority: 5,
+				ComputeEnvironmentOrder: []v1alpha1.ComputeEnvironmentOrder{
+					{Order: 1, ComputeEnvironmentARN: aws.String(ceOnDemand)},
+					{Order: 2, ComputeEnvironmentARN: aws.String(ceSpot)},
+				},
+			},
+			want: false,
+		},
+		"OrderSwapped": {
+			p: v1alpha1.JobQueueParameters{
+				Priority: 1,
+				ComputeEnvironmentOrder: []v1alpha1.ComputeEnvironmentOrder{
+					{Order: 1, ComputeEnvironmentARN: aws.String(ceSpot)},
+					{Order: 2, ComputeEnvironmentARN: aws.String(ceOnDemand)},
+				},
+			},
+			want: false,
+		},
+		"EnvironmentRemoved": {
+			p: v1alpha1.JobQueueParameters{
+				Priority: 1,
+				ComputeEnvironmentOrder: []v1alpha1.ComputeEnvironmentOrder{
+					{Order: 1, ComputeEnvironmentARN: aws.String(ceOnDemand)},
+				},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsJobQueueUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budgets
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	billingAlarmPrefix = "crossplane-billing-"
+	protocolEmail      = "email"
+
+	// NOTE: Subscriptions that are not confirmed yet have no ARN and cannot
+	// be removed, they expire after three days.
+	pendingConfirmation = "PendingConfirmation"
+
+	errGetCallerIdentity = "cannot get caller identity"
+	errCreateTopic       = "cannot create SNS topic"
+	errSetTopicPolicy    = "cannot set policy of SNS topic"
+	errListSubscriptions = "cannot list subscriptions of SNS topic"
+	errSubscribe         = "cannot subscribe to SNS topic"
+	errUnsubscribe       = "cannot unsubscribe from SNS topic"
+	errDeleteTopic       = "cannot delete SNS topic"
+	errDescribeBudget    = "cannot describe budget"
+	errCreateBudget      = "cannot create budget"
+	errUpdateBudget      = "cannot update budget"
+	errDeleteBudget      = "cannot delete budget"
+	errDescribeNotif     = "cannot describe notifications of budget"
+	errCreateNotif       = "cannot create notification of budget"
+	errUpdateNotif       = "cannot update notification of budget"
+)
+
+// BillingAlarmClient is the external client used to manage the billing alarm
+// of a ProviderConfig.
+type BillingAlarmClient interface {
+	GetCallerIdentityRequest(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+	CreateTopicRequest(*sns.CreateTopicInput) sns.CreateTopicRequest
+	SetTopicAttributesRequest(*sns.SetTopicAttributesInput) sns.SetTopicAttributesRequest
+	ListSubscriptionsByTopicRequest(*sns.ListSubscriptionsByTopicInput) sns.ListSubscriptionsByTopicRequest
+	SubscribeRequest(*sns.SubscribeInput) sns.SubscribeRequest
+	UnsubscribeRequest(*sns.UnsubscribeInput) sns.UnsubscribeRequest
+	DeleteTopicRequest(*sns.DeleteTopicInput) sns.DeleteTopicRequest
+	DescribeBudgetRequest(*budgets.DescribeBudgetInput) budgets.DescribeBudgetRequest
+	CreateBudgetRequest(*budgets.CreateBudgetInput) budgets.CreateBudgetRequest
+	UpdateBudgetRequest(*budgets.UpdateBudgetInput) budgets.UpdateBudgetRequest
+	DeleteBudgetRequest(*budgets.DeleteBudgetInput) budgets.DeleteBudgetRequest
+	DescribeNotificationsForBudgetRequest(*budgets.DescribeNotificationsForBudgetInput) budgets.DescribeNotificationsForBudgetRequest
+	CreateNotificationRequest(*budgets.CreateNotificationInput) budgets.CreateNotificationRequest
+	UpdateNotificationRequest(*budgets.UpdateNotificationInput) budgets.UpdateNotificationRequest
+}
+
+type billingAlarmClient struct {
+	*budgets.Client
+	sns *sns.Client
+	sts *sts.Client
+}
+
+// NewBillingAlarmClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewBillingAlarmClient(cfg aws.Config) BillingAlarmClient {
+	return &billingAlarmClient{Client: budgets.New(cfg), sns: sns.New(cfg), sts: sts.New(cfg)}
+}
+
+func (c *billingAlarmClient) GetCallerIdentityRequest(in *sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+	return c.sts.GetCallerIdentityRequest(in)
+}
+
+func (c *billingAlarmClient) CreateTopicRequest(in *sns.CreateTopicInput) sns.CreateTopicRequest {
+	return c.sns.CreateTopicRequest(in)
+}
+
+func (c *billingAlarmClient) SetTopicAttributesRequest(in *sns.SetTopicAttributesInput) sns.SetTopicAttributesRequest {
+	return c.sns.SetTopicAttributesRequest(in)
+}
+
+func (c *billingAlarmClient) ListSubscriptionsByTopicRequest(in *sns.ListSubscriptionsByTopicInput) sns.ListSubscriptionsByTopicRequest {
+	return c.sns.ListSubscriptionsByTopicRequest(in)
+}
+
+func (c *billingAlarmClient) SubscribeRequest(in *sns.SubscribeInput) sns.SubscribeRequest {
+	return c.sns.SubscribeRequest(in)
+}
+
+func (c *billingAlarmClient) UnsubscribeRequest(in *sns.UnsubscribeInput) sns.UnsubscribeRequest {
+	return c.sns.UnsubscribeRequest(in)
+}
+
+func (c *billingAlarmClient) DeleteTopicRequest(in *sns.DeleteTopicInput) sns.DeleteTopicRequest {
+	return c.sns.DeleteTopicRequest(in)
+}
+
+// IsBudgetNotFound returns true if the error is because the budget does not
+// exist.
+func IsBudgetNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == budgets.ErrCodeNotFoundException {
+		return true
+	}
+	return false
+}
+
+// BillingAlarmName returns the name of the budget and the SNS topic of the
+// billing alarm of the ProviderConfig with the given name.
+func BillingAlarmName(providerConfig string) string {
+	return billingAlarmPrefix + providerConfig
+}
+
+// GenerateBudget returns the budget of the billing alarm of the
+// ProviderConfig with the given name.
+func GenerateBudget(providerConfig string, ba v1beta1.BillingAlarm) *budgets.Budget {
+	return &budgets.Budget{
+		BudgetName:  aws.String(BillingAlarmName(providerConfig)),
+		BudgetType:  budgets.BudgetTypeCost,
+		TimeUnit:    budgets.TimeUnitMonthly,
+		BudgetLimit: &budgets.Spend{Amount: aws.String(ba.Limit), Unit: aws.String("USD")},
+		CostFilters: map[string][]string{
+			// NOTE: Tag filters are of the form user:<key>$<value>.
+			"TagKeyValue": {"user:" + resource.ExternalResourceTagKeyProvider + "$" + providerConfig},
+		},
+	}
+}
+
+// GenerateNotification returns the notification of the budget of the given
+// billing alarm.
+func GenerateNotification(ba v1beta1.BillingAlarm) *budgets.Notification {
+	threshold := int64(100)
+	if ba.ThresholdPercentage != nil {
+		threshold = *ba.ThresholdPercentage
+	}
+	return &budgets.Notification{
+		NotificationType:   budgets.NotificationTypeActual,
+		ComparisonOperator: budgets.ComparisonOperatorGreaterThan,
+		Threshold:          aws.Float64(float64(threshold)),
+		ThresholdType:      budgets.ThresholdTypePercentage,
+	}
+}
+
+// GenerateTopicPolicy returns a policy that allows AWS Budgets to publish to
+// the given topic.
+func GenerateTopicPolicy(topicARN string) (string, error) {
+	p := map[string]interface{}{
+		"Version": "2012-10-17",
+		"Statement": []map[string]interface{}{{
+			"Sid":       "AllowBudgets",
+			"Effect":    "Allow",
+			"Principal": map[string]string{"Service": "budgets.amazonaws.com"},
+			"Action":    "SNS:Publish",
+			"Resource":  topicARN,
+		}},
+	}
+	b, err := json.Marshal(p)
+	return string(b), err
+}
+
+// IsBudgetUpToDate returns whether the limit of the observed budget matches
+// the desired one.
+func IsBudgetUpToDate(desired, observed *budgets.Budget) bool {
+	if observed.BudgetLimit == nil {
+		return false
+	}
+	d, err := strconv.ParseFloat(aws.StringValue(desired.BudgetLimit.Amount), 64)
+	if err != nil {
+		return false
+	}
+	o, err := strconv.ParseFloat(aws.StringValue(observed.BudgetLimit.Amount), 64)
+	return err == nil && d == o
+}
+
+// EnsureTopic creates the SNS topic of the billing alarm if it does not
+// exist, allows AWS Budgets to publish to it and subscribes the given emails,
+// removing the subscriptions of emails that are not given. The ARN of the
+// topic is returned as soon as it exists, even if an error is returned.
+func EnsureTopic(ctx context.Context, c BillingAlarmClient, name string, emails []string) (string, error) { // nolint:gocyclo
+	// NOTE: CreateTopic returns the existing topic if there is one with the
+	// same name.
+	t, err := c.CreateTopicRequest(&sns.CreateTopicInput{Name: aws.String(name)}).Send(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, errCreateTopic)
+	}
+	arn := aws.StringValue(t.TopicArn)
+	policy, err := GenerateTopicPolicy(arn)
+	if err != nil {
+		return arn, errors.Wrap(err, errSetTopicPolicy)
+	}
+	if _, err := c.SetTopicAttributesRequest(&sns.SetTopicAttributesInput{
+		TopicArn:       aws.String(arn),
+		AttributeName:  aws.String("Policy"),
+		AttributeValue: aws.String(policy),
+	}).Send(ctx); err != nil {
+		return arn, errors.Wrap(err, errSetTopicPolicy)
+	}
+
+	desired := map[string]bool{}
+	for _, e := range emails {
+		desired[e] = true
+	}
+	existing := map[string]bool{}
+	in := &sns.ListSubscriptionsByTopicInput{TopicArn: aws.String(arn)}
+	for {
+		out, err := c.ListSubscriptionsByTopicRequest(in).Send(ctx)
+		if err != nil {
+			return arn, errors.Wrap(err, errListSubscriptions)
+		}
+		for _, s := range out.Subscriptions {
+			if aws.StringValue(s.Protocol) != protocolEmail {
+				continue
+			}
+			e := aws.StringValue(s.Endpoint)
+			existing[e] = true
+			if desired[e] || aws.StringValue(s.SubscriptionArn) == pendingConfirmation {
+				continue
+			}
+			if _, err := c.UnsubscribeRequest(&sns.UnsubscribeInput{SubscriptionArn: s.SubscriptionArn}).Send(ctx); err != nil {
+				return arn, errors.Wrap(err, errUnsubscribe)
+			}
+		}
+		if out.NextToken == nil {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+
+	missing := []string{}
+	for e := range desired {
+		if !existing[e] {
+			missing = append(missing, e)
+		}
+	}
+	sort.Strings(missing)
+	for _, e := range missing {
+		if _, err := c.SubscribeRequest(&sns.SubscribeInput{
+			TopicArn: aws.String(arn),
+			Protocol: aws.String(protocolEmail),
+			Endpoint: aws.String(e),
+		}).Send(ctx); err != nil {
+			return arn, errors.Wrap(err, errSubscribe)
+		}
+	}
+	return arn, nil
+}
+
+// EnsureBudget creates the budget of the billing alarm of the given
+// ProviderConfig, notifying the given topic, or updates its limit and
+// notification if it exists.
+func EnsureBudget(ctx context.Context, c BillingAlarmClient, accountID, providerConfig, topicARN string, ba v1beta1.BillingAlarm) error {
+	desired := GenerateBudget(providerConfig, ba)
+	subscribers := []budgets.Subscriber{{SubscriptionType: budgets.SubscriptionTypeSns, Address: aws.String(topicARN)}}
+	out, err := c.DescribeBudgetRequest(&budgets.DescribeBudgetInput{AccountId: aws.String(accountID), BudgetName: desired.BudgetName}).Send(ctx)
+	if IsBudgetNotFound(err) {
+		_, err := c.CreateBudgetRequest(&budgets.CreateBudgetInput{
+			AccountId:                    aws.String(accountID),
+			Budget:                       desired,
+			NotificationsWithSubscribers: []budgets.NotificationWithSubscribers{{Notification: GenerateNotification(ba), Subscribers: subscribers}},
+		}).Send(ctx)
+		return errors.Wrap(err, errCreateBudget)
+	}
+	if err != nil {
+		return errors.Wrap(err, errDescribeBudget)
+	}
+	if !IsBudgetUpToDate(desired, out.Budget) {
+		if _, err := c.UpdateBudgetRequest(&budgets.UpdateBudgetInput{AccountId: aws.String(accountID), NewBudget: desired}).Send(ctx); err != nil {
+			return errors.Wrap(err, errUpdateBudget)
+		}
+	}
+	return ensureNotification(ctx, c, accountID, desired.BudgetName, GenerateNotification(ba), subscribers)
+}
+
+func ensureNotification(ctx context.Context, c BillingAlarmClient, accountID string, budget *string, desired *budgets.Notification, subscribers []budgets.Subscriber) error {
+	out, err := c.DescribeNotificationsForBudgetRequest(&budgets.DescribeNotificationsForBudgetInput{AccountId: aws.String(accountID), BudgetName: budget}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(err, errDescribeNotif)
+	}
+	// NOTE: The billing alarm has a single notification on the actual cost,
+	// which is identified by its type, operator and threshold.
+	for i := range out.Notifications {
+		n := &out.Notifications[i]
+		if n.NotificationType != desired.NotificationType {
+			continue
+		}
+		if aws.Float64Value(n.Threshold) == aws.Float64Value(desired.Threshold) && n.ComparisonOperator == desired.ComparisonOperator {
+			return nil
+		}
+		_, err := c.UpdateNotificationRequest(&budgets.UpdateNotificationInput{
+			AccountId:       aws.String(accountID),
+			BudgetName:      budget,
+			OldNotification: n,
+			NewNotification: desired,
+		}).Send(ctx)
+		return errors.Wrap(err, errUpdateNotif)
+	}
+	_, err = c.CreateNotificationRequest(&budgets.CreateNotificationInput{
+		AccountId:    aws.String(accountID),
+		BudgetName:   budget,
+		Notification: desired,
+		Subscribers:  subscribers,
+	}).Send(ctx)
+	return errors.Wrap(err, errCreateNotif)
+}
+
+// callerIdentityGetter returns the identity of the credentials of a client.
+type callerIdentityGetter interface {
+	GetCallerIdentityRequest(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+}
+
+// AccountID returns the ID of the account of the credentials of the given
+// client.
+func AccountID(ctx context.Context, c callerIdentityGetter) (string, error) {
+	id, err := c.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{}).Send(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, errGetCallerIdentity)
+	}
+	return aws.StringValue(id.Account), nil
+}
+
+// DeleteBillingAlarm deletes the budget and the SNS topic of the billing
+// alarm of the given ProviderConfig. Resources that do not exist are
+// ignored.
+func DeleteBillingAlarm(ctx context.Context, c BillingAlarmClient, accountID, providerConfig, topicARN string) error {
+	_, err := c.DeleteBudgetRequest(&budgets.DeleteBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(BillingAlarmName(providerConfig)),
+	}).Send(ctx)
+	if err != nil && !IsBudgetNotFound(err) {
+		return errors.Wrap(err, errDeleteBudget)
+	}
+	if topicARN == "" {
+		return nil
+	}
+	// NOTE: DeleteTopic succeeds if the topic does not exist.
+	_, err = c.DeleteTopicRequest(&sns.DeleteTopicInput{TopicArn: aws.String(topicARN)}).Send(ctx)
+	return errors.Wrap(err, errDeleteTopic)
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budgets
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	providerConfig = "team-a"
+	topicARN       = "arn:aws:sns:us-east-1:123456789012:crossplane-billing-team-a"
+)
+
+var errBoom = errors.New("boom")
+
+// mockTopicClient implements the SNS methods of a BillingAlarmClient.
+type mockTopicClient struct {
+	BillingAlarmClient
+
+	createTopic       func(*sns.CreateTopicInput) sns.CreateTopicRequest
+	setAttributes     func(*sns.SetTopicAttributesInput) sns.SetTopicAttributesRequest
+	listSubscriptions func(*sns.ListSubscriptionsByTopicInput) sns.ListSubscriptionsByTopicRequest
+	subscribe         func(*sns.SubscribeInput) sns.SubscribeRequest
+	unsubscribe       func(*sns.UnsubscribeInput) sns.UnsubscribeRequest
+}
+
+func (m *mockTopicClient) CreateTopicRequest(in *sns.CreateTopicInput) sns.CreateTopicRequest {
+	return m.createTopic(in)
+}
+
+func (m *mockTopicClient) SetTopicAttributesRequest(in *sns.SetTopicAttributesInput) sns.SetTopicAttributesRequest {
+	return m.setAttributes(in)
+}
+
+func (m *mockTopicClient) ListSubscriptionsByTopicRequest(in *sns.ListSubscriptionsByTopicInput) sns.ListSubscriptionsByTopicRequest {
+	return m.listSubscriptions(in)
+}
+
+func (m *mockTopicClient) SubscribeRequest(in *sns.SubscribeInput) sns.SubscribeRequest {
+	return m.subscribe(in)
+}
+
+func (m *mockTopicClient) UnsubscribeRequest(in *sns.UnsubscribeInput) sns.UnsubscribeRequest {
+	return m.unsubscribe(in)
+}
+
+func TestGenerateBudget(t *testing.T) {
+	want := &budgets.Budget{
+		BudgetName:  aws.String("crossplane-billing-team-a"),
+		BudgetType:  budgets.BudgetTypeCost,
+		TimeUnit:    budgets.TimeUnitMonthly,
+		BudgetLimit: &budgets.Spend{Amount: aws.String("100"), Unit: aws.String("USD")},
+		CostFilters: map[string][]string{"TagKeyValue": {"user:crossplane-providerconfig$team-a"}},
+	}
+	got := GenerateBudget(providerConfig, v1beta1.BillingAlarm{Limit: "100"})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateBudget(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateNotification(t *testing.T) {
+	cases := map[string]struct {
+		ba   v1beta1.BillingAlarm
+		want float64
+	}{
+		"DefaultThreshold": {
+			ba:   v1beta1.BillingAlarm{Limit: "100"},
+			want: 100,
+		},
+		"Threshold": {
+			ba:   v1beta1.BillingAlarm{Limit: "100", ThresholdPercentage: aws.Int64(80)},
+			want: 80,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateNotification(tc.ba)
+			if diff := cmp.Diff(tc.want, aws.Float64Value(got.Threshold)); diff != "" {
+				t.Errorf("GenerateNotification(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsBudgetUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		observed *budgets.Budget
+		want     bool
+	}{
+		"SameAmount": {
+			observed: &budgets.Budget{BudgetLimit: &budgets.Spend{Amount: aws.String("100.0"), Unit: aws.String("USD")}},
+			want:     true,
+		},
+		"DifferentAmount": {
+			observed: &budgets.Budget{BudgetLimit: &budgets.Spend{Amount: aws.String("50.0"), Unit: aws.String("USD")}},
+		},
+		"NoLimit": {
+			observed: &budgets.Budget{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsBudgetUpToDate(GenerateBudget(providerConfig, v1beta1.BillingAlarm{Limit: "100"}), tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsBudgetUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnsureTopic(t *testing.T) {
+	type want struct {
+		arn          string
+		err          error
+		subscribed   []string
+		unsubscribed []string
+	}
+
+	cases := map[string]struct {
+		subscriptions []sns.Subscription
+		createErr     error
+		emails        []string
+		want          want
+	}{
+		"NewTopic": {
+			emails: []string{"b@example.com", "a@example.com"},
+			want:   want{arn: topicARN, subscribed: []string{"a@example.com", "b@example.com"}},
+		},
+		"SubscriptionsChanged": {
+			subscriptions: []sns.Subscription{
+				{Protocol: aws.String("email"), Endpoint: aws.String("a@example.com"), SubscriptionArn: aws.String("sub-a")},
+				{Protocol: aws.String("email"), Endpoint: aws.String("b@example.com"), SubscriptionArn: aws.String("sub-b")},
+				{Protocol: aws.String("email"), Endpoint: aws.String("c@example.com"), SubscriptionArn: aws.String(pendingConfirmation)},
+				{Protocol: aws.String("https"), Endpoint: aws.String("https://example.com"), SubscriptionArn: aws.String("sub-https")},
+			},
+			emails: []string{"a@example.com", "d@example.com"},
+			want:   want{arn: topicARN, subscribed: []string{"d@example.com"}, unsubscribed: []string{"sub-b"}},
+		},
+		"CreateFailed": {
+			createErr: errBoom,
+			want:      want{err: errors.Wrap(errBoom, errCreateTopic)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var subscribed, unsubscribed []string
+			c := &mockTopicClient{
+				createTopic: func(*sns.CreateTopicInput) sns.CreateTopicRequest {
+					return sns.CreateTopicRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &sns.CreateTopicOutput{TopicArn: aws.String(topicARN)}, Error: tc.createErr},
+					}
+				},
+				setAttributes: func(*sns.SetTopicAttributesInput) sns.SetTopicAttributesRequest {
+					return sns.SetTopicAttributesRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &sns.SetTopicAttributesOutput{}},
+					}
+				},
+				listSubscriptions: func(*sns.ListSubscriptionsByTopicInput) sns.ListSubscriptionsByTopicRequest {
+					return sns.ListSubscriptionsByTopicRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &sns.ListSubscriptionsByTopicOutput{Subscriptions: tc.subscriptions}},
+					}
+				},
+				subscribe: func(in *sns.SubscribeInput) sns.SubscribeRequest {
+					subscribed = append(subscribed, aws.StringValue(in.Endpoint))
+					return sns.SubscribeRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &sns.SubscribeOutput{}},
+					}
+				},
+				unsubscribe: func(in *sns.UnsubscribeInput) sns.UnsubscribeRequest {
+					unsubscribed = append(unsubscribed, aws.StringValue(in.SubscriptionArn))
+					return sns.UnsubscribeRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &sns.UnsubscribeOutput{}},
+					}
+				},
+			}
+			arn, err := EnsureTopic(context.Background(), c, BillingAlarmName(providerConfig), tc.emails)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.arn, arn); diff != "" {
+				t.Errorf("arn: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.subscribed, subscribed); diff != "" {
+				t.Errorf("subscribed: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.unsubscribed, unsubscribed); diff != "" {
+				t.Errorf("unsubscribed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budgets
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/budgets/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// BudgetClient is the external client used for Budget Custom Resource
+type BudgetClient interface {
+	GetCallerIdentityRequest(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+	DescribeBudgetRequest(*budgets.DescribeBudgetInput) budgets.DescribeBudgetRequest
+	CreateBudgetRequest(*budgets.CreateBudgetInput) budgets.CreateBudgetRequest
+	UpdateBudgetRequest(*budgets.UpdateBudgetInput) budgets.UpdateBudgetRequest
+	DeleteBudgetRequest(*budgets.DeleteBudgetInput) budgets.DeleteBudgetRequest
+	DescribeNotificationsForBudgetRequest(*budgets.DescribeNotificationsForBudgetInput) budgets.DescribeNotificationsForBudgetRequest
+	DescribeSubscribersForNotificationRequest(*budgets.DescribeSubscribersForNotificationInput) budgets.DescribeSubscribersForNotificationRequest
+	CreateNotificationRequest(*budgets.CreateNotificationInput) budgets.CreateNotificationRequest
+	DeleteNotificationRequest(*budgets.DeleteNotificationInput) budgets.DeleteNotificationRequest
+	CreateSubscriberRequest(*budgets.CreateSubscriberInput) budgets.CreateSubscriberRequest
+	DeleteSubscriberRequest(*budgets.DeleteSubscriberInput) budgets.DeleteSubscriberRequest
+}
+
+type budgetClient struct {
+	*budgets.Client
+	sts *sts.Client
+}
+
+// NewBudgetClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewBudgetClient(cfg aws.Config) BudgetClient {
+	return &budgetClient{Client: budgets.New(cfg), sts: sts.New(cfg)}
+}
+
+func (c *budgetClient) GetCallerIdentityRequest(in *sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+	return c.sts.GetCallerIdentityRequest(in)
+}
+
+// GenerateBudgetDefinition returns the budget with the given name and
+// parameters.
+func GenerateBudgetDefinition(name string, p v1alpha1.BudgetParameters) *budgets.Budget {
+	b := &budgets.Budget{
+		BudgetName:  aws.String(name),
+		BudgetType:  budgets.BudgetType(p.BudgetType),
+		TimeUnit:    budgets.TimeUnit(p.TimeUnit),
+		BudgetLimit: &budgets.Spend{Amount: aws.String(p.BudgetLimit.Amount), Unit: aws.String(p.BudgetLimit.Unit)},
+		CostFilters: p.CostFilters,
+	}
+	if p.CostTypes != nil {
+		b.CostTypes = &budgets.CostTypes{
+			IncludeCredit:            p.CostTypes.IncludeCredit,
+			IncludeDiscount:          p.CostTypes.IncludeDiscount,
+			IncludeOtherSubscription: p.CostTypes.IncludeOtherSubscription,
+			IncludeRecurring:         p.CostTypes.IncludeRecurring,
+			IncludeRefund:            p.CostTypes.IncludeRefund,
+			IncludeSubscription:      p.CostTypes.IncludeSubscription,
+			IncludeSupport:           p.CostTypes.IncludeSupport,
+			IncludeTax:               p.CostTypes.IncludeTax,
+			IncludeUpfront:           p.CostTypes.IncludeUpfront,
+			UseAmortized:             p.CostTypes.UseAmortized,
+			UseBlended:               p.CostTypes.UseBlended,
+		}
+	}
+	if p.TimePeriod != nil {
+		b.TimePeriod = &budgets.TimePeriod{}
+		if p.TimePeriod.Start != nil {
+			b.TimePeriod.Start = &p.TimePeriod.Start.Time
+		}
+		if p.TimePeriod.End != nil {
+			b.TimePeriod.End = &p.TimePeriod.End.Time
+		}
+	}
+	return b
+}
+
+// GenerateBudgetNotification returns the notification of the given
+// parameters.
+func GenerateBudgetNotification(n v1alpha1.Notification) budgets.Notification {
+	thresholdType := budgets.ThresholdTypePercentage
+	if n.ThresholdType != nil {
+		thresholdType = budgets.ThresholdType(*n.ThresholdType)
+	}
+	// NOTE: The threshold is validated to be a decimal number.
+	threshold, _ := strconv.ParseFloat(n.Threshold, 64)
+	return budgets.Notification{
+		NotificationType:   budgets.NotificationType(n.NotificationType),
+		ComparisonOperator: budgets.ComparisonOperator(n.ComparisonOperator),
+		Threshold:          aws.Float64(threshold),
+		ThresholdType:      thresholdType,
+	}
+}
+
+// GenerateSubscribers returns the subscribers of the given notification.
+func GenerateSubscribers(n v1alpha1.Notification) []budgets.Subscriber {
+	subscribers := make([]budgets.Subscriber, 0, len(n.SubscriberEmailAddresses)+1)
+	for _, e := range n.SubscriberEmailAddresses {
+		subscribers = append(subscribers, budgets.Subscriber{SubscriptionType: budgets.SubscriptionTypeEmail, Address: aws.String(e)})
+	}
+	if n.SubscriberSNSTopicARN != nil {
+		subscribers = append(subscribers, budgets.Subscriber{SubscriptionType: budgets.SubscriptionTypeSns, Address: n.SubscriberSNSTopicARN})
+	}
+	return subscribers
+}
+
+// GenerateNotificationsWithSubscribers returns the notifications of the
+// budget with the given parameters along with their subscribers.
+func GenerateNotificationsWithSubscribers(p v1alpha1.BudgetParameters) []budgets.NotificationWithSubscribers {
+	if len(p.Notifications) == 0 {
+		return nil
+	}
+	res := make([]budgets.NotificationWithSubscribers, len(p.Notifications))
+	for i, n := range p.Notifications {
+		notification := GenerateBudgetNotification(n)
+		res[i] = budgets.NotificationWithSubscribers{Notification: &notification, Subscribers: GenerateSubscribers(n)}
+	}
+	return res
+}
+
+// NotificationKey returns a key that identifies the given notification. AWS
+// Budgets identifies notifications by all of their attributes.
+func NotificationKey(n budgets.Notification) string {
+	thresholdType := n.ThresholdType
+	if thresholdType == "" {
+		thresholdType = budgets.ThresholdTypePercentage
+	}
+	return fmt.Sprintf("%s/%s/%g/%s", n.NotificationType, n.ComparisonOperator, aws.Float64Value(n.Threshold), thresholdType)
+}
+
+// DiffSubscribers returns the subscribers that need to be added to and
+// removed from a notification to match the desired subscribers.
+func DiffSubscribers(desired, observed []budgets.Subscriber) (add, remove []budgets.Subscriber) {
+	key := func(s budgets.Subscriber) string {
+		return string(s.SubscriptionType) + "/" + aws.StringValue(s.Address)
+	}
+	current := make(map[string]bool, len(observed))
+	for _, s := range observed {
+		current[key(s)] = true
+	}
+	wanted := make(map[string]bool, len(desired))
+	for _, s := range desired {
+		wanted[key(s)] = true
+		if !current[key(s)] {
+			add = append(add, s)
+		}
+	}
+	for _, s := range observed {
+		if !wanted[key(s)] {
+			remove = append(remove, s)
+		}
+	}
+	return add, remove
+}
+
+// GenerateBudgetObservation returns the observation of the given budget.
+func GenerateBudgetObservation(o budgets.Budget) v1alpha1.BudgetObservation {
+	obs := v1alpha1.BudgetObservation{}
+	if o.CalculatedSpend == nil {
+		return obs
+	}
+	if s := o.CalculatedSpend.ActualSpend; s != nil {
+		obs.ActualSpend = &v1alpha1.Spend{Amount: aws.StringValue(s.Amount), Unit: aws.StringValue(s.Unit)}
+	}
+	if s := o.CalculatedSpend.ForecastedSpend; s != nil {
+		obs.ForecastedSpend = &v1alpha1.Spend{Amount: aws.StringValue(s.Amount), Unit: aws.StringValue(s.Unit)}
+	}
+	return obs
+}
+
+// LateInitializeBudget fills the empty fields of the given parameters with
+// the values of the observed budget.
+func LateInitializeBudget(in *v1alpha1.BudgetParameters, o *budgets.Budget) {
+	if o == nil {
+		return
+	}
+	if o.CostTypes != nil {
+		if in.CostTypes == nil {
+			in.CostTypes = &v1alpha1.CostTypes{}
+		}
+		ct := in.CostTypes
+		ct.IncludeCredit = awsclients.LateInitializeBoolPtr(ct.IncludeCredit, o.CostTypes.IncludeCredit)
+		ct.IncludeDiscount = awsclients.LateInitializeBoolPtr(ct.IncludeDiscount, o.CostTypes.IncludeDiscount)
+		ct.IncludeOtherSubscription = awsclients.LateInitializeBoolPtr(ct.IncludeOtherSubscription, o.CostTypes.IncludeOtherSubscription)
+		ct.IncludeRecurring = awsclients.LateInitializeBoolPtr(ct.IncludeRecurring, o.CostTypes.IncludeRecurring)
+		ct.IncludeRefund = awsclients.LateInitializeBoolPtr(ct.IncludeRefund, o.CostTypes.IncludeRefund)
+		ct.IncludeSubscription = awsclients.LateInitializeBoolPtr(ct.IncludeSubscription, o.CostTypes.IncludeSubscription)
+		ct.IncludeSupport = awsclients.LateInitializeBoolPtr(ct.IncludeSupport, o.CostTypes.IncludeSupport)
+		ct.IncludeTax = awsclients.LateInitializeBoolPtr(ct.IncludeTax, o.CostTypes.IncludeTax)
+		ct.IncludeUpfront = awsclients.LateInitializeBoolPtr(ct.IncludeUpfront, o.CostTypes.IncludeUpfront)
+		ct.UseAmortized = awsclients.LateInitializeBoolPtr(ct.UseAmortized, o.CostTypes.UseAmortized)
+		ct.UseBlended = awsclients.LateInitializeBoolPtr(ct.UseBlended, o.CostTypes.UseBlended)
+	}
+	if o.TimePeriod != nil {
+		if in.TimePeriod == nil {
+			in.TimePeriod = &v1alpha1.TimePeriod{}
+		}
+		if in.TimePeriod.Start == nil && o.TimePeriod.Start != nil {
+			t := metav1.NewTime(*o.TimePeriod.Start)
+			in.TimePeriod.Start = &t
+		}
+		if in.TimePeriod.End == nil && o.TimePeriod.End != nil {
+			t := metav1.NewTime(*o.TimePeriod.End)
+			in.TimePeriod.End = &t
+		}
+	}
+}
+
+// IsBudgetDefinitionUpToDate returns whether the observed budget is up to date
+// with the given parameters. Notifications are not taken into account.
+func IsBudgetDefinitionUpToDate(p v1alpha1.BudgetParameters, o budgets.Budget) bool {
+	desired := GenerateBudgetDefinition(aws.StringValue(o.BudgetName), p)
+	if !IsBudgetUpToDate(desired, &o) || aws.StringValue(desired.BudgetLimit.Unit) != aws.StringValue(o.BudgetLimit.Unit) {
+		return false
+	}
+	if desired.TimeUnit != o.TimeUnit || !cmp.Equal(desired.CostFilters, o.CostFilters, cmpopts.EquateEmpty()) {
+		return false
+	}
+	if desired.CostTypes != nil && !cmp.Equal(desired.CostTypes, o.CostTypes, cmpopts.IgnoreUnexported(budgets.CostTypes{})) {
+		return false
+	}
+	if desired.TimePeriod == nil {
+		return true
+	}
+	if o.TimePeriod == nil {
+		return false
+	}
+	return equalTimes(desired.TimePeriod.Start, o.TimePeriod.Start) && equalTimes(desired.TimePeriod.End, o.TimePeriod.End)
+}
+
+// equalTimes returns whether the given desired time is unset or equal to the
+// given observed time.
+func equalTimes(desired, observed *time.Time) bool {
+	if desired == nil {
+		return true
+	}
+	return observed != nil && desired.Equal(*observed)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budgets
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/budgets/v1alpha1"
+)
+
+var snsTopicARN = "arn:aws:sns:us-east-1:123456789012:finops"
+
+func budgetParameters() v1alpha1.BudgetParameters {
+	return v1alpha1.BudgetParameters{
+		BudgetType:  "COST",
+		TimeUnit:    "MONTHLY",
+		BudgetLimit: v1alpha1.Spend{Amount: "100", Unit: "USD"},
+		CostFilters: map[string][]string{"TagKeyValue": {"user:team$platform"}},
+		Notifications: []v1alpha1.Notification{{
+			NotificationType:         "ACTUAL",
+			ComparisonOperator:       "GREATER_THAN",
+			Threshold:                "80",
+			SubscriberEmailAddresses: []string{"finops@example.com"},
+			SubscriberSNSTopicARN:    aws.String(snsTopicARN),
+		}},
+	}
+}
+
+func TestGenerateNotificationsWithSubscribers(t *testing.T) {
+	want := []budgets.NotificationWithSubscribers{{
+		Notification: &budgets.Notification{
+			NotificationType:   budgets.NotificationTypeActual,
+			ComparisonOperator: budgets.ComparisonOperatorGreaterThan,
+			Threshold:          aws.Float64(80),
+			ThresholdType:      budgets.ThresholdTypePercentage,
+		},
+		Subscribers: []budgets.Subscriber{
+			{SubscriptionType: budgets.SubscriptionTypeEmail, Address: aws.String("finops@example.com")},
+			{SubscriptionType: budgets.SubscriptionTypeSns, Address: aws.String(snsTopicARN)},
+		},
+	}}
+	got := GenerateNotificationsWithSubscribers(budgetParameters())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDiffSubscribers(t *testing.T) {
+	email := func(a string) budgets.Subscriber {
+		return budgets.Subscriber{SubscriptionType: budgets.SubscriptionTypeEmail, Address: aws.String(a)}
+	}
+	type want struct {
+		add    []budgets.Subscriber
+		remove []budgets.Subscriber
+	}
+	cases := map[string]struct {
+		desired  []budgets.Subscriber
+		observed []budgets.Subscriber
+		want     want
+	}{
+		"InSync": {
+			desired:  []budgets.Subscriber{email("a@example.com")},
+			observed: []budgets.Subscriber{email("a@example.com")},
+		},
+		"AddAndRemove": {
+			desired:  []budgets.Subscriber{email("a@example.com"), email("b@example.com")},
+			observed: []budgets.Subscriber{email("a@example.com"), email("c@example.com")},
+			want: want{
+				add:    []budgets.Subscriber{email("b@example.com")},
+				remove: []budgets.Subscriber{email("c@example.com")},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffSubscribers(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsBudgetDefinitionUpToDate(t *testing.T) {
+	observed := func(amount string) budgets.Budget {
+		return budgets.Budget{
+			BudgetName:  aws.String("team"),
+			BudgetType:  budgets.BudgetTypeCost,
+			TimeUnit:    budgets.TimeUnitMonthly,
+			BudgetLimit: &budgets.Spend{Amount: aws.String(amount), Unit: aws.String("USD")},
+			CostFilters: map[string][]string{"TagKeyValue": {"user:team$platform"}},
+			CostTypes:   &budgets.CostTypes{IncludeTax: aws.Bool(true)},
+		}
+	}
+	cases := map[string]struct {
+		p    v1alpha1.BudgetParameters
+		o    budgets.Budget
+		want bool
+	}{
+		"UpToDate": {
+			p:    budgetParameters(),
+			o:    observed("100.0"),
+			want: true,
+		},
+		"LimitChanged": {
+			p:    budgetParameters(),
+			o:    observed("50.0"),
+			want: false,
+		},
+		"CostTypesChanged": {
+			p: func() v1alpha1.BudgetParameters {
+				p := budgetParameters()
+				p.CostTypes = &v1alpha1.CostTypes{IncludeTax: aws.Bool(false)}
+				return p
+			}(),
+			o:    observed("100.0"),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsBudgetDefinitionUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeBudget(t *testing.T) {
+	p := budgetParameters()
+	LateInitializeBudget(&p, &budgets.Budget{CostTypes: &budgets.CostTypes{IncludeTax: aws.Bool(true)}})
+	want := budgetParameters()
+	want.CostTypes = &v1alpha1.CostTypes{IncludeTax: aws.Bool(true)}
+	if diff := cmp.Diff(want, p, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/budgets"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.BillingAlarmClient = (*MockBillingAlarmClient)(nil)
+
+// MockBillingAlarmClient is a type that implements all the methods for
+// BillingAlarmClient interface
+type MockBillingAlarmClient struct {
+	MockGetCallerIdentity              func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+	MockCreateTopic                    func(*sns.CreateTopicInput) sns.CreateTopicRequest
+	MockSetTopicAttributes             func(*sns.SetTopicAttributesInput) sns.SetTopicAttributesRequest
+	MockListSubscriptionsByTopic       func(*sns.ListSubscriptionsByTopicInput) sns.ListSubscriptionsByTopicRequest
+	MockSubscribe                      func(*sns.SubscribeInput) sns.SubscribeRequest
+	MockUnsubscribe                    func(*sns.UnsubscribeInput) sns.UnsubscribeRequest
+	MockDeleteTopic                    func(*sns.DeleteTopicInput) sns.DeleteTopicRequest
+	MockDescribeBudget                 func(*budgets.DescribeBudgetInput) budgets.DescribeBudgetRequest
+	MockCreateBudget                   func(*budgets.CreateBudgetInput) budgets.CreateBudgetRequest
+	MockUpdateBudget                   func(*budgets.UpdateBudgetInput) budgets.UpdateBudgetRequest
+	MockDeleteBudget                   func(*budgets.DeleteBudgetInput) budgets.DeleteBudgetRequest
+	MockDescribeNotificationsForBudget func(*budgets.DescribeNotificationsForBudgetInput) budgets.DescribeNotificationsForBudgetRequest
+	MockCreateNotification             func(*budgets.CreateNotificationInput) budgets.CreateNotificationRequest
+	MockUpdateNotification             func(*budgets.UpdateNotificationInput) budgets.UpdateNotificationRequest
+}
+
+// GetCallerIdentityRequest mocks GetCallerIdentityRequest method
+func (m *MockBillingAlarmClient) GetCallerIdentityRequest(input *sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+	return m.MockGetCallerIdentity(input)
+}
+
+// CreateTopicRequest mocks CreateTopicRequest method
+func (m *MockBillingAlarmClient) CreateTopicRequest(input *sns.CreateTopicInput) sns.CreateTopicRequest {
+	return m.MockCreateTopic(input)
+}
+
+// SetTopicAttributesRequest mocks SetTopicAttributesRequest method
+func (m *MockBillingAlarmClient) SetTopicAttributesRequest(input *sns.SetTopicAttributesInput) sns.SetTopicAttributesRequest {
+	return m.MockSetTopicAttributes(input)
+}
+
+// ListSubscriptionsByTopicRequest mocks ListSubscriptionsByTopicRequest method
+func (m *MockBillingAlarmClient) ListSubscriptionsByTopicRequest(input *sns.ListSubscriptionsByTopicInput) sns.ListSubscriptionsByTopicRequest {
+	return m.MockListSubscriptionsByTopic(input)
+}
+
+// SubscribeRequest mocks SubscribeRequest method
+func (m *MockBillingAlarmClient) SubscribeRequest(input *sns.SubscribeInput) sns.SubscribeRequest {
+	return m.MockSubscribe(input)
+}
+
+// UnsubscribeRequest mocks UnsubscribeRequest method
+func (m *MockBillingAlarmClient) UnsubscribeRequest(input *sns.UnsubscribeInput) sns.UnsubscribeRequest {
+	return m.MockUnsubscribe(input)
+}
+
+// DeleteTopicRequest mocks DeleteTopicRequest method
+func (m *MockBillingAlarmClient) DeleteTopicRequest(input *sns.DeleteTopicInput) sns.DeleteTopicRequest {
+	return m.MockDeleteTopic(input)
+}
+
+// DescribeBudgetRequest mocks DescribeBudgetRequest method
+func (m *MockBillingAlarmClient) DescribeBudgetRequest(input *budgets.DescribeBudgetInput) budgets.DescribeBudgetRequest {
+	return m.MockDescribeBudget(input)
+}
+
+// CreateBudgetRequest mocks CreateBudgetRequest method
+func (m *MockBillingAlarmClient) CreateBudgetRequest(input *budgets.CreateBudgetInput) budgets.CreateBudgetRequest {
+	return m.MockCreateBudget(input)
+}
+
+// UpdateBudgetRequest mocks UpdateBudgetRequest method
+func (m *MockBillingAlarmClient) UpdateBudgetRequest(input *budgets.UpdateBudgetInput) budgets.UpdateBudgetRequest {
+	return m.MockUpdateBudget(input)
+}
+
+// DeleteBudgetRequest mocks DeleteBudgetRequest method
+func (m *MockBillingAlarmClient) DeleteBudgetRequest(input *budgets.DeleteBudgetInput) budgets.DeleteBudgetRequest {
+	return m.MockDeleteBudget(input)
+}
+
+// DescribeNotificationsForBudgetRequest mocks DescribeNotificationsForBudgetRequest method
+func (m *MockBillingAlarmClient) DescribeNotificationsForBudgetRequest(input *budgets.DescribeNotificationsForBudgetInput) budgets.DescribeNotificationsForBudgetRequest {
+	return m.MockDescribeNotificationsForBudget(input)
+}
+
+// CreateNotificationRequest mocks CreateNotificationRequest method
+func (m *MockBillingAlarmClient) CreateNotificationRequest(input *budgets.CreateNotificationInput) budgets.CreateNotificationRequest {
+	return m.MockCreateNotification(input)
+}
+
+// UpdateNotificationRequest mocks UpdateNotificationRequest method
+func (m *MockBillingAlarmClient) UpdateNotificationRequest(input *budgets.UpdateNotificationInput) budgets.UpdateNotificationRequest {
+	return m.MockUpdateNotification(input)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/budgets"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.BudgetClient = (*MockBudgetClient)(nil)
+
+// MockBudgetClient is a type that implements all the methods for BudgetClient interface
+type MockBudgetClient struct {
+	MockGetCallerIdentity                  func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+	MockDescribeBudget                     func(*budgets.DescribeBudgetInput) budgets.DescribeBudgetRequest
+	MockCreateBudget                       func(*budgets.CreateBudgetInput) budgets.CreateBudgetRequest
+	MockUpdateBudget                       func(*budgets.UpdateBudgetInput) budgets.UpdateBudgetRequest
+	MockDeleteBudget                       func(*budgets.DeleteBudgetInput) budgets.DeleteBudgetRequest
+	MockDescribeNotificationsForBudget     func(*budgets.DescribeNotificationsForBudgetInput) budgets.DescribeNotificationsForBudgetRequest
+	MockDescribeSubscribersForNotification func(*budgets.DescribeSubscribersForNotificationInput) budgets.DescribeSubscribersForNotificationRequest
+	MockCreateNotification                 func(*budgets.CreateNotificationInput) budgets.CreateNotificationRequest
+	MockDeleteNotification                 func(*budgets.DeleteNotificationInput) budgets.DeleteNotificationRequest
+	MockCreateSubscriber                   func(*budgets.CreateSubscriberInput) budgets.CreateSubscriberRequest
+	MockDeleteSubscriber                   func(*budgets.DeleteSubscriberInput) budgets.DeleteSubscriberRequest
+}
+
+// GetCallerIdentityRequest mocks GetCallerIdentityRequest method
+func (m *MockBudgetClient) GetCallerIdentityRequest(input *sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+	return m.MockGetCallerIdentity(input)
+}
+
+// DescribeBudgetRequest mocks DescribeBudgetRequest method
+func (m *MockBudgetClient) DescribeBudgetRequest(input *budgets.DescribeBudgetInput) budgets.DescribeBudgetRequest {
+	return m.MockDescribeBudget(input)
+}
+
+// CreateBudgetRequest mocks CreateBudgetRequest method
+func (m *MockBudgetClient) CreateBudgetRequest(input *budgets.CreateBudgetInput) budgets.CreateBudgetRequest {
+	return m.MockCreateBudget(input)
+}
+
+// UpdateBudgetRequest mocks UpdateBudgetRequest method
+func (m *MockBudgetClient) UpdateBudgetRequest(input *budgets.UpdateBudgetInput) budgets.UpdateBudgetRequest {
+	return m.MockUpdateBudget(input)
+}
+
+// DeleteBudgetRequest mocks DeleteBudgetRequest method
+func (m *MockBudgetClient) DeleteBudgetRequest(input *budgets.DeleteBudgetInput) budgets.DeleteBudgetRequest {
+	return m.MockDeleteBudget(input)
+}
+
+// DescribeNotificationsForBudgetRequest mocks DescribeNotificationsForBudgetRequest method
+func (m *MockBudgetClient) DescribeNotificationsForBudgetRequest(input *budgets.DescribeNotificationsForBudgetInput) budgets.DescribeNotificationsForBudgetRequest {
+	return m.MockDescribeNotificationsForBudget(input)
+}
+
+// DescribeSubscribersForNotificationRequest mocks DescribeSubscribersForNotificationRequest method
+func (m *MockBudgetClient) DescribeSubscribersForNotificationRequest(input *budgets.DescribeSubscribersForNotificationInput) budgets.DescribeSubscribersForNotificationRequest {
+	return m.MockDescribeSubscribersForNotification(input)
+}
+
+// CreateNotificationRequest mocks CreateNotificationRequest method
+func (m *MockBudgetClient) CreateNotificationRequest(input *budgets.CreateNotificationInput) budgets.CreateNotificationRequest {
+	return m.MockCreateNotification(input)
+}
+
+// DeleteNotificationRequest mocks DeleteNotificationRequest method
+func (m *MockBudgetClient) DeleteNotificationRequest(input *budgets.DeleteNotificationInput) budgets.DeleteNotificationRequest {
+	return m.MockDeleteNotification(input)
+}
+
+// CreateSubscriberRequest mocks CreateSubscriberRequest method
+func (m *MockBudgetClient) CreateSubscriberRequest(input *budgets.CreateSubscriberInput) budgets.CreateSubscriberRequest {
+	return m.MockCreateSubscriber(input)
+}
+
+// DeleteSubscriberRequest mocks DeleteSubscriberRequest method
+func (m *MockBudgetClient) DeleteSubscriberRequest(input *budgets.DeleteSubscriberInput) budgets.DeleteSubscriberRequest {
+	return m.MockDeleteSubscriber(input)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/cloudtrail"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TrailClient = (*MockTrailClient)(nil)
+
+// MockTrailClient is a type that implements all the methods for TrailClient interface
+type MockTrailClient struct {
+	MockCreateTrail       func(*cloudtrail.CreateTrailInput) cloudtrail.CreateTrailRequest
+	MockGetTrail          func(*cloudtrail.GetTrailInput) cloudtrail.GetTrailRequest
+	MockGetTrailStatus    func(*cloudtrail.GetTrailStatusInput) cloudtrail.GetTrailStatusRequest
+	MockGetEventSelectors func(*cloudtrail.GetEventSelectorsInput) cloudtrail.GetEventSelectorsRequest
+	MockUpdateTrail       func(*cloudtrail.UpdateTrailInput) cloudtrail.UpdateTrailRequest
+	MockPutEventSelectors func(*cloudtrail.PutEventSelectorsInput) cloudtrail.PutEventSelectorsRequest
+	MockStartLogging      func(*cloudtrail.StartLoggingInput) cloudtrail.StartLoggingRequest
+	MockStopLogging       func(*cloudtrail.StopLoggingInput) cloudtrail.StopLoggingRequest
+	MockDeleteTrail       func(*cloudtrail.DeleteTrailInput) cloudtrail.DeleteTrailRequest
+}
+
+// CreateTrailRequest mocks CreateTrailRequest method
+func (m *MockTrailClient) CreateTrailRequest(input *cloudtrail.CreateTrailInput) cloudtrail.CreateTrailRequest {
+	return m.MockCreateTrail(input)
+}
+
+// GetTrailRequest mocks GetTrailRequest method
+func (m *MockTrailClient) GetTrailRequest(input *cloudtrail.GetTrailInput) cloudtrail.GetTrailRequest {
+	return m.MockGetTrail(input)
+}
+
+// GetTrailStatusRequest mocks GetTrailStatusRequest method
+func (m *MockTrailClient) GetTrailStatusRequest(input *cloudtrail.GetTrailStatusInput) cloudtrail.GetTrailStatusRequest {
+	return m.MockGetTrailStatus(input)
+}
+
+// GetEventSelectorsRequest mocks GetEventSelectorsRequest method
+func (m *MockTrailClient) GetEventSelectorsRequest(input *cloudtrail.GetEventSelectorsInput) cloudtrail.GetEventSelectorsRequest {
+	return m.MockGetEventSelectors(input)
+}
+
+// UpdateTrailRequest mocks UpdateTrailRequest method
+func (m *MockTrailClient) UpdateTrailRequest(input *cloudtrail.UpdateTrailInput) cloudtrail.UpdateTrailRequest {
+	return m.MockUpdateTrail(input)
+}
+
+// PutEventSelectorsRequest mocks PutEventSelectorsRequest method
+func (m *MockTrailClient) PutEventSelectorsRequest(input *cloudtrail.PutEventSelectorsInput) cloudtrail.PutEventSelectorsRequest {
+	return m.MockPutEventSelectors(input)
+}
+
+// StartLoggingRequest mocks StartLoggingRequest method
+func (m *MockTrailClient) StartLoggingRequest(input *cloudtrail.StartLoggingInput) cloudtrail.StartLoggingRequest {
+	return m.MockStartLogging(input)
+}
+
+// StopLoggingRequest mocks StopLoggingRequest method
+func (m *MockTrailClient) StopLoggingRequest(input *cloudtrail.StopLoggingInput) cloudtrail.StopLoggingRequest {
+	return m.MockStopLogging(input)
+}
+
+// DeleteTrailRequest mocks DeleteTrailRequest method
+func (m *MockTrailClient) DeleteTrailRequest(input *cloudtrail.DeleteTrailInput) cloudtrail.DeleteTrailRequest {
+	return m.MockDeleteTrail(input)
+}
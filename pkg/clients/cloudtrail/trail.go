@@ -0,0 +1,203 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudtrail
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/cloudtrail/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// TrailClient is the external client used for Trail Custom Resource
+type TrailClient interface {
+	CreateTrailRequest(*cloudtrail.CreateTrailInput) cloudtrail.CreateTrailRequest
+	GetTrailRequest(*cloudtrail.GetTrailInput) cloudtrail.GetTrailRequest
+	GetTrailStatusRequest(*cloudtrail.GetTrailStatusInput) cloudtrail.GetTrailStatusRequest
+	GetEventSelectorsRequest(*cloudtrail.GetEventSelectorsInput) cloudtrail.GetEventSelectorsRequest
+	UpdateTrailRequest(*cloudtrail.UpdateTrailInput) cloudtrail.UpdateTrailRequest
+	PutEventSelectorsRequest(*cloudtrail.PutEventSelectorsInput) cloudtrail.PutEventSelectorsRequest
+	StartLoggingRequest(*cloudtrail.StartLoggingInput) cloudtrail.StartLoggingRequest
+	StopLoggingRequest(*cloudtrail.StopLoggingInput) cloudtrail.StopLoggingRequest
+	DeleteTrailRequest(*cloudtrail.DeleteTrailInput) cloudtrail.DeleteTrailRequest
+}
+
+// NewTrailClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewTrailClient(cfg aws.Config) TrailClient {
+	return cloudtrail.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the trail doesn't exist
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == cloudtrail.ErrCodeTrailNotFoundException {
+		return true
+	}
+	return false
+}
+
+// GenerateCreateTrailInput returns a create input from the given parameters.
+func GenerateCreateTrailInput(name string, p v1alpha1.TrailParameters) *cloudtrail.CreateTrailInput {
+	input := &cloudtrail.CreateTrailInput{
+		Name:                       aws.String(name),
+		S3BucketName:               p.S3BucketName,
+		S3KeyPrefix:                p.S3KeyPrefix,
+		SnsTopicName:               p.SNSTopicName,
+		IsMultiRegionTrail:         p.IsMultiRegionTrail,
+		IsOrganizationTrail:        p.IsOrganizationTrail,
+		IncludeGlobalServiceEvents: p.IncludeGlobalServiceEvents,
+		EnableLogFileValidation:    p.EnableLogFileValidation,
+		KmsKeyId:                   p.KMSKeyID,
+		CloudWatchLogsLogGroupArn:  p.CloudWatchLogsLogGroupARN,
+		CloudWatchLogsRoleArn:      p.CloudWatchLogsRoleARN,
+	}
+	for _, t := range p.Tags {
+		input.TagsList = append(input.TagsList, cloudtrail.Tag{Key: aws.String(t.Key), Value: t.Value})
+	}
+	return input
+}
+
+// GenerateUpdateTrailInput returns an update input from the given
+// parameters.
+func GenerateUpdateTrailInput(name string, p v1alpha1.TrailParameters) *cloudtrail.UpdateTrailInput {
+	return &cloudtrail.UpdateTrailInput{
+		Name:                       aws.String(name),
+		S3BucketName:               p.S3BucketName,
+		S3KeyPrefix:                p.S3KeyPrefix,
+		SnsTopicName:               p.SNSTopicName,
+		IsMultiRegionTrail:         p.IsMultiRegionTrail,
+		IsOrganizationTrail:        p.IsOrganizationTrail,
+		IncludeGlobalServiceEvents: p.IncludeGlobalServiceEvents,
+		EnableLogFileValidation:    p.EnableLogFileValidation,
+		KmsKeyId:                   p.KMSKeyID,
+		CloudWatchLogsLogGroupArn:  p.CloudWatchLogsLogGroupARN,
+		CloudWatchLogsRoleArn:      p.CloudWatchLogsRoleARN,
+	}
+}
+
+// GeneratePutEventSelectorsInput returns the input to configure the event
+// selectors of the trail with the given name.
+func GeneratePutEventSelectorsInput(name string, p v1alpha1.TrailParameters) *cloudtrail.PutEventSelectorsInput {
+	input := &cloudtrail.PutEventSelectorsInput{
+		TrailName:      aws.String(name),
+		EventSelectors: make([]cloudtrail.EventSelector, len(p.EventSelectors)),
+	}
+	for i, s := range p.EventSelectors {
+		input.EventSelectors[i] = cloudtrail.EventSelector{
+			ReadWriteType:                 cloudtrail.ReadWriteType(aws.StringValue(s.ReadWriteType)),
+			IncludeManagementEvents:       s.IncludeManagementEvents,
+			ExcludeManagementEventSources: s.ExcludeManagementEventSources,
+		}
+		for _, d := range s.DataResources {
+			input.EventSelectors[i].DataResources = append(input.EventSelectors[i].DataResources,
+				cloudtrail.DataResource{Type: aws.String(d.Type), Values: d.Values})
+		}
+	}
+	return input
+}
+
+// GenerateTrailObservation is used to produce v1alpha1.TrailObservation from
+// cloudtrail.Trail and its status.
+func GenerateTrailObservation(t cloudtrail.Trail, s cloudtrail.GetTrailStatusOutput) v1alpha1.TrailObservation {
+	return v1alpha1.TrailObservation{
+		TrailARN:            aws.StringValue(t.TrailARN),
+		HomeRegion:          aws.StringValue(t.HomeRegion),
+		IsLogging:           aws.BoolValue(s.IsLogging),
+		LatestDeliveryError: aws.StringValue(s.LatestDeliveryError),
+	}
+}
+
+// LateInitializeTrail fills the empty fields in *v1alpha1.TrailParameters
+// with the values seen in cloudtrail.Trail.
+func LateInitializeTrail(in *v1alpha1.TrailParameters, t *cloudtrail.Trail) {
+	if t == nil {
+		return
+	}
+	in.S3KeyPrefix = awsclients.LateInitializeStringPtr(in.S3KeyPrefix, t.S3KeyPrefix)
+	in.IsMultiRegionTrail = awsclients.LateInitializeBoolPtr(in.IsMultiRegionTrail, t.IsMultiRegionTrail)
+	in.IsOrganizationTrail = awsclients.LateInitializeBoolPtr(in.IsOrganizationTrail, t.IsOrganizationTrail)
+	in.IncludeGlobalServiceEvents = awsclients.LateInitializeBoolPtr(in.IncludeGlobalServiceEvents, t.IncludeGlobalServiceEvents)
+	in.EnableLogFileValidation = awsclients.LateInitializeBoolPtr(in.EnableLogFileValidation, t.LogFileValidationEnabled)
+	in.KMSKeyID = awsclients.LateInitializeStringPtr(in.KMSKeyID, t.KmsKeyId)
+	in.CloudWatchLogsLogGroupARN = awsclients.LateInitializeStringPtr(in.CloudWatchLogsLogGroupARN, t.CloudWatchLogsLogGroupArn)
+	in.CloudWatchLogsRoleARN = awsclients.LateInitializeStringPtr(in.CloudWatchLogsRoleARN, t.CloudWatchLogsRoleArn)
+}
+
+// IsTrailUpToDate checks whether there is a change in any of the modifiable
+// fields.
+func IsTrailUpToDate(p v1alpha1.TrailParameters, t cloudtrail.Trail, isLogging bool, selectors []cloudtrail.EventSelector) bool { // nolint:gocyclo
+	switch {
+	case aws.StringValue(p.S3BucketName) != aws.StringValue(t.S3BucketName),
+		aws.StringValue(p.S3KeyPrefix) != aws.StringValue(t.S3KeyPrefix),
+		aws.BoolValue(p.IsMultiRegionTrail) != aws.BoolValue(t.IsMultiRegionTrail),
+		aws.BoolValue(p.IsOrganizationTrail) != aws.BoolValue(t.IsOrganizationTrail),
+		aws.BoolValue(p.IncludeGlobalServiceEvents) != aws.BoolValue(t.IncludeGlobalServiceEvents),
+		aws.BoolValue(p.EnableLogFileValidation) != aws.BoolValue(t.LogFileValidationEnabled),
+		aws.StringValue(p.CloudWatchLogsLogGroupARN) != aws.StringValue(t.CloudWatchLogsLogGroupArn),
+		aws.StringValue(p.CloudWatchLogsRoleARN) != aws.StringValue(t.CloudWatchLogsRoleArn):
+		return false
+	}
+	// The KMS key can be given as an ID, alias or ARN but is always reported
+	// as an ARN.
+	if !strings.HasSuffix(aws.StringValue(t.KmsKeyId), aws.StringValue(p.KMSKeyID)) {
+		return false
+	}
+	if !isSameTopic(aws.StringValue(p.SNSTopicName), aws.StringValue(t.SnsTopicARN)) {
+		return false
+	}
+	if aws.BoolValue(awsclients.LateInitializeBoolPtr(p.EnableLogging, aws.Bool(true))) != isLogging {
+		return false
+	}
+	// Trails are created with a default event selector that logs all
+	// management events; it is only managed if configured explicitly.
+	if len(p.EventSelectors) == 0 {
+		return true
+	}
+	return cmp.Equal(p.EventSelectors, buildEventSelectors(selectors), cmpopts.EquateEmpty())
+}
+
+// isSameTopic returns whether the SNS topic given by its name or ARN is the
+// topic with the given ARN.
+func isSameTopic(topic, arn string) bool {
+	if topic == "" || strings.HasPrefix(topic, "arn:") {
+		return topic == arn
+	}
+	return strings.HasSuffix(arn, ":"+topic)
+}
+
+func buildEventSelectors(selectors []cloudtrail.EventSelector) []v1alpha1.EventSelector {
+	res := make([]v1alpha1.EventSelector, len(selectors))
+	for i, s := range selectors {
+		res[i] = v1alpha1.EventSelector{
+			IncludeManagementEvents:       s.IncludeManagementEvents,
+			ExcludeManagementEventSources: s.ExcludeManagementEventSources,
+		}
+		if s.ReadWriteType != "" {
+			res[i].ReadWriteType = aws.String(string(s.ReadWriteType))
+		}
+		for _, d := range s.DataResources {
+			res[i].DataResources = append(res[i].DataResources, v1alpha1.DataResource{Type: aws.StringValue(d.Type), Values: d.Values})
+		}
+	}
+	return res
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudtrail
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/cloudtrail/v1alpha1"
+)
+
+var (
+	trailName = "some-trail"
+	bucket    = "some-bucket"
+	topicARN  = "arn:aws:sns:us-east-1:123456789012:some-topic"
+	keyARN    = "arn:aws:kms:us-east-1:123456789012:key/1234abcd"
+)
+
+func TestGenerateCreateTrailInput(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.TrailParameters
+		out *cloudtrail.CreateTrailInput
+	}{
+		"AllFilled": {
+			in: v1alpha1.TrailParameters{
+				S3BucketName:       aws.String(bucket),
+				S3KeyPrefix:        aws.String("prefix"),
+				IsMultiRegionTrail: aws.Bool(true),
+				KMSKeyID:           aws.String("1234abcd"),
+				Tags:               []v1alpha1.Tag{{Key: "k", Value: aws.String("v")}},
+			},
+			out: &cloudtrail.CreateTrailInput{
+				Name:               aws.String(trailName),
+				S3BucketName:       aws.String(bucket),
+				S3KeyPrefix:        aws.String("prefix"),
+				IsMultiRegionTrail: aws.Bool(true),
+				KmsKeyId:           aws.String("1234abcd"),
+				TagsList:           []cloudtrail.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateCreateTrailInput(trailName, tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GenerateCreateTrailInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTrailUpToDate(t *testing.T) {
+	observed := cloudtrail.Trail{
+		S3BucketName: aws.String(bucket),
+		KmsKeyId:     aws.String(keyARN),
+		SnsTopicARN:  aws.String(topicARN),
+	}
+	selectors := []cloudtrail.EventSelector{{
+		ReadWriteType:           cloudtrail.ReadWriteTypeAll,
+		IncludeManagementEvents: aws.Bool(true),
+		DataResources:           []cloudtrail.DataResource{{Type: aws.String("AWS::S3::Object"), Values: []string{"arn:aws:s3:::"}}},
+	}}
+	desiredSelectors := []v1alpha1.EventSelector{{
+		ReadWriteType:           aws.String("All"),
+		IncludeManagementEvents: aws.Bool(true),
+		DataResources:           []v1alpha1.DataResource{{Type: "AWS::S3::Object", Values: []string{"arn:aws:s3:::"}}},
+	}}
+
+	type args struct {
+		p         v1alpha1.TrailParameters
+		isLogging bool
+	}
+	cases := map[string]struct {
+		args
+		out bool
+	}{
+		"SameFields": {
+			args: args{
+				p: v1alpha1.TrailParameters{
+					S3BucketName:   aws.String(bucket),
+					KMSKeyID:       aws.String("1234abcd"),
+					SNSTopicName:   aws.String("some-topic"),
+					EventSelectors: desiredSelectors,
+				},
+				isLogging: true,
+			},
+			out: true,
+		},
+		"DifferentBucket": {
+			args: args{
+				p: v1alpha1.TrailParameters{
+					S3BucketName: aws.String("other-bucket"),
+					KMSKeyID:     aws.String(keyARN),
+					SNSTopicName: aws.String(topicARN),
+				},
+				isLogging: true,
+			},
+			out: false,
+		},
+		"DifferentSelectors": {
+			args: args{
+				p: v1alpha1.TrailParameters{
+					S3BucketName:   aws.String(bucket),
+					KMSKeyID:       aws.String(keyARN),
+					SNSTopicName:   aws.String(topicARN),
+					EventSelectors: []v1alpha1.EventSelector{{ReadWriteType: aws.String("ReadOnly")}},
+				},
+				isLogging: true,
+			},
+			out: false,
+		},
+		"NotLogging": {
+			args: args{
+				p: v1alpha1.TrailParameters{
+					S3BucketName: aws.String(bucket),
+					KMSKeyID:     aws.String(keyARN),
+					SNSTopicName: aws.String(topicARN),
+				},
+				isLogging: false,
+			},
+			out: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := IsTrailUpToDate(tc.args.p, observed, tc.args.isLogging, selectors)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("IsTrailUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
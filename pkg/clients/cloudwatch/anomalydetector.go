@@ -0,0 +1,182 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AnomalyDetectorClient is the external client used for AnomalyDetector
+// Custom Resource
+type AnomalyDetectorClient interface {
+	PutAnomalyDetectorRequest(*cloudwatch.PutAnomalyDetectorInput) cloudwatch.PutAnomalyDetectorRequest
+	DescribeAnomalyDetectorsRequest(*cloudwatch.DescribeAnomalyDetectorsInput) cloudwatch.DescribeAnomalyDetectorsRequest
+	DeleteAnomalyDetectorRequest(*cloudwatch.DeleteAnomalyDetectorInput) cloudwatch.DeleteAnomalyDetectorRequest
+}
+
+// NewAnomalyDetectorClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewAnomalyDetectorClient(cfg aws.Config) AnomalyDetectorClient {
+	return cloudwatch.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the anomaly detector
+// doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == cloudwatch.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// GenerateDimensions returns the cloudwatch dimensions of the given
+// dimensions.
+func GenerateDimensions(d []v1alpha1.Dimension) []cloudwatch.Dimension {
+	if len(d) == 0 {
+		return nil
+	}
+	res := make([]cloudwatch.Dimension, len(d))
+	for i, dim := range d {
+		res[i] = cloudwatch.Dimension{Name: aws.String(dim.Name), Value: aws.String(dim.Value)}
+	}
+	return res
+}
+
+// GenerateDescribeAnomalyDetectorsInput returns the input of a
+// DescribeAnomalyDetectors request that filters by the metric of the given
+// parameters.
+func GenerateDescribeAnomalyDetectorsInput(p v1alpha1.AnomalyDetectorParameters) *cloudwatch.DescribeAnomalyDetectorsInput {
+	return &cloudwatch.DescribeAnomalyDetectorsInput{
+		Namespace:  aws.String(p.Namespace),
+		MetricName: aws.String(p.MetricName),
+		Dimensions: GenerateDimensions(p.Dimensions),
+	}
+}
+
+// GeneratePutAnomalyDetectorInput returns the input of a PutAnomalyDetector
+// request that creates or updates the anomaly detector of the given
+// parameters.
+func GeneratePutAnomalyDetectorInput(p v1alpha1.AnomalyDetectorParameters) *cloudwatch.PutAnomalyDetectorInput {
+	in := &cloudwatch.PutAnomalyDetectorInput{
+		Namespace:  aws.String(p.Namespace),
+		MetricName: aws.String(p.MetricName),
+		Stat:       aws.String(p.Stat),
+		Dimensions: GenerateDimensions(p.Dimensions),
+	}
+	if p.Configuration != nil {
+		in.Configuration = &cloudwatch.AnomalyDetectorConfiguration{
+			MetricTimezone: p.Configuration.MetricTimezone,
+		}
+		for _, r := range p.Configuration.ExcludedTimeRanges {
+			in.Configuration.ExcludedTimeRanges = append(in.Configuration.ExcludedTimeRanges, cloudwatch.Range{
+				StartTime: aws.Time(r.StartTime.Time),
+				EndTime:   aws.Time(r.EndTime.Time),
+			})
+		}
+	}
+	return in
+}
+
+// GenerateDeleteAnomalyDetectorInput returns the input of a
+// DeleteAnomalyDetector request for the anomaly detector of the given
+// parameters.
+func GenerateDeleteAnomalyDetectorInput(p v1alpha1.AnomalyDetectorParameters) *cloudwatch.DeleteAnomalyDetectorInput {
+	return &cloudwatch.DeleteAnomalyDetectorInput{
+		Namespace:  aws.String(p.Namespace),
+		MetricName: aws.String(p.MetricName),
+		Stat:       aws.String(p.Stat),
+		Dimensions: GenerateDimensions(p.Dimensions),
+	}
+}
+
+func dimensionsKey(d []cloudwatch.Dimension) []string {
+	res := make([]string, len(d))
+	for i, dim := range d {
+		res[i] = aws.StringValue(dim.Name) + "=" + aws.StringValue(dim.Value)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// FindAnomalyDetector returns the anomaly detector of the given parameters
+// among the given ones, or nil if there is none. Anomaly detectors have no
+// identifier and are identified by their metric and statistic instead.
+func FindAnomalyDetector(p v1alpha1.AnomalyDetectorParameters, detectors []cloudwatch.AnomalyDetector) *cloudwatch.AnomalyDetector {
+	dims := dimensionsKey(GenerateDimensions(p.Dimensions))
+	for i, d := range detectors {
+		if aws.StringValue(d.Namespace) == p.Namespace &&
+			aws.StringValue(d.MetricName) == p.MetricName &&
+			aws.StringValue(d.Stat) == p.Stat &&
+			cmp.Equal(dims, dimensionsKey(d.Dimensions), cmpopts.EquateEmpty()) {
+			return &detectors[i]
+		}
+	}
+	return nil
+}
+
+// GenerateAnomalyDetectorObservation is used to produce
+// v1alpha1.AnomalyDetectorObservation from cloudwatch.AnomalyDetector.
+func GenerateAnomalyDetectorObservation(d cloudwatch.AnomalyDetector) v1alpha1.AnomalyDetectorObservation {
+	return v1alpha1.AnomalyDetectorObservation{
+		StateValue: string(d.StateValue),
+	}
+}
+
+// LateInitializeAnomalyDetector fills the empty fields in
+// *v1alpha1.AnomalyDetectorParameters with the values seen in
+// cloudwatch.AnomalyDetector.
+func LateInitializeAnomalyDetector(in *v1alpha1.AnomalyDetectorParameters, d *cloudwatch.AnomalyDetector) {
+	if d == nil || d.Configuration == nil || d.Configuration.MetricTimezone == nil {
+		return
+	}
+	if in.Configuration == nil {
+		in.Configuration = &v1alpha1.AnomalyDetectorConfiguration{}
+	}
+	in.Configuration.MetricTimezone = awsclients.LateInitializeStringPtr(in.Configuration.MetricTimezone, d.Configuration.MetricTimezone)
+}
+
+// IsAnomalyDetectorUpToDate checks whether the configuration of the observed
+// anomaly detector matches the desired one.
+func IsAnomalyDetectorUpToDate(p v1alpha1.AnomalyDetectorParameters, d cloudwatch.AnomalyDetector) bool {
+	desired := GeneratePutAnomalyDetectorInput(p).Configuration
+	if desired == nil {
+		desired = &cloudwatch.AnomalyDetectorConfiguration{}
+	}
+	observed := d.Configuration
+	if observed == nil {
+		observed = &cloudwatch.AnomalyDetectorConfiguration{}
+	}
+	if desired.MetricTimezone != nil && aws.StringValue(desired.MetricTimezone) != aws.StringValue(observed.MetricTimezone) {
+		return false
+	}
+	return cmp.Equal(desired.ExcludedTimeRanges, observed.ExcludedTimeRanges,
+		cmpopts.EquateEmpty(),
+		// Times in the spec have a precision of seconds.
+		cmp.Comparer(func(a, b cloudwatch.Range) bool {
+			return aws.TimeValue(a.StartTime).Unix() == aws.TimeValue(b.StartTime).Unix() &&
+				aws.TimeValue(a.EndTime).Unix() == aws.TimeValue(b.EndTime).Unix()
+		}))
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+)
+
+var (
+	namespace  = "AWS/EC2"
+	metricName = "CPUUtilization"
+	stat       = "Average"
+	timezone   = "Europe/Berlin"
+	start      = time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)
+	end        = time.Date(2020, 11, 2, 0, 0, 0, 0, time.UTC)
+)
+
+func parameters(dims ...v1alpha1.Dimension) v1alpha1.AnomalyDetectorParameters {
+	return v1alpha1.AnomalyDetectorParameters{
+		Namespace:  namespace,
+		MetricName: metricName,
+		Stat:       stat,
+		Dimensions: dims,
+	}
+}
+
+func TestFindAnomalyDetector(t *testing.T) {
+	detector := func(statistic string, dims ...cloudwatch.Dimension) cloudwatch.AnomalyDetector {
+		return cloudwatch.AnomalyDetector{
+			Namespace:  aws.String(namespace),
+			MetricName: aws.String(metricName),
+			Stat:       aws.String(statistic),
+			Dimensions: dims,
+		}
+	}
+	a := cloudwatch.Dimension{Name: aws.String("a"), Value: aws.String("1")}
+	b := cloudwatch.Dimension{Name: aws.String("b"), Value: aws.String("2")}
+
+	cases := map[string]struct {
+		p         v1alpha1.AnomalyDetectorParameters
+		detectors []cloudwatch.AnomalyDetector
+		want      *cloudwatch.AnomalyDetector
+	}{
+		"Found": {
+			p:         parameters(),
+			detectors: []cloudwatch.AnomalyDetector{detector("p99"), detector(stat)},
+			want:      &cloudwatch.AnomalyDetector{Namespace: aws.String(namespace), MetricName: aws.String(metricName), Stat: aws.String(stat)},
+		},
+		"DimensionsInAnyOrder": {
+			p:         parameters(v1alpha1.Dimension{Name: "a", Value: "1"}, v1alpha1.Dimension{Name: "b", Value: "2"}),
+			detectors: []cloudwatch.AnomalyDetector{detector(stat, a), detector(stat, b, a)},
+			want:      &cloudwatch.AnomalyDetector{Namespace: aws.String(namespace), MetricName: aws.String(metricName), Stat: aws.String(stat), Dimensions: []cloudwatch.Dimension{b, a}},
+		},
+		"NotFound": {
+			p:         parameters(v1alpha1.Dimension{Name: "a", Value: "1"}),
+			detectors: []cloudwatch.AnomalyDetector{detector(stat), detector("p99", a)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FindAnomalyDetector(tc.p, tc.detectors)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FindAnomalyDetector(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAnomalyDetectorUpToDate(t *testing.T) {
+	withConfiguration := func(c *v1alpha1.AnomalyDetectorConfiguration) v1alpha1.AnomalyDetectorParameters {
+		p := parameters()
+		p.Configuration = c
+		return p
+	}
+
+	cases := map[string]struct {
+		p        v1alpha1.AnomalyDetectorParameters
+		detector cloudwatch.AnomalyDetector
+		want     bool
+	}{
+		"NoConfiguration": {
+			p:        parameters(),
+			detector: cloudwatch.AnomalyDetector{Configuration: &cloudwatch.AnomalyDetectorConfiguration{MetricTimezone: aws.String(timezone)}},
+			want:     true,
+		},
+		"SameConfiguration": {
+			p: withConfiguration(&v1alpha1.AnomalyDetectorConfiguration{
+				MetricTimezone:     aws.String(timezone),
+				ExcludedTimeRanges: []v1alpha1.TimeRange{{StartTime: metav1.NewTime(start), EndTime: metav1.NewTime(end)}},
+			}),
+			detector: cloudwatch.AnomalyDetector{Configuration: &cloudwatch.AnomalyDetectorConfiguration{
+				MetricTimezone:     aws.String(timezone),
+				ExcludedTimeRanges: []cloudwatch.Range{{StartTime: aws.Time(start.Local()), EndTime: aws.Time(end.Local())}},
+			}},
+			want: true,
+		},
+		"TimezoneChanged": {
+			p:        withConfiguration(&v1alpha1.AnomalyDetectorConfiguration{MetricTimezone: aws.String(timezone)}),
+			detector: cloudwatch.AnomalyDetector{Configuration: &cloudwatch.AnomalyDetectorConfiguration{MetricTimezone: aws.String("UTC")}},
+			want:     false,
+		},
+		"ExcludedTimeRangeAdded": {
+			p: withConfiguration(&v1alpha1.AnomalyDetectorConfiguration{
+				ExcludedTimeRanges: []v1alpha1.TimeRange{{StartTime: metav1.NewTime(start), EndTime: metav1.NewTime(end)}},
+			}),
+			detector: cloudwatch.AnomalyDetector{},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAnomalyDetectorUpToDate(tc.p, tc.detector)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsAnomalyDetectorUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"text/template"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+)
+
+const (
+	errNoDashboardBody       = "one of dashboardBody or dashboardBodyConfigMapRef must be set"
+	errGetDashboardBodyCM    = "cannot get the config map that contains the dashboard body"
+	errRenderDashboardBody   = "cannot render the dashboard body template"
+	errDashboardBodyNotFound = "the config map does not contain the dashboard body key"
+)
+
+// DashboardClient is the external client used for Dashboard Custom Resource
+type DashboardClient interface {
+	GetDashboardRequest(*cloudwatch.GetDashboardInput) cloudwatch.GetDashboardRequest
+	PutDashboardRequest(*cloudwatch.PutDashboardInput) cloudwatch.PutDashboardRequest
+	DeleteDashboardsRequest(*cloudwatch.DeleteDashboardsInput) cloudwatch.DeleteDashboardsRequest
+}
+
+// NewDashboardClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewDashboardClient(cfg aws.Config) DashboardClient {
+	return cloudwatch.New(cfg)
+}
+
+// IsDashboardNotFound returns true if the error is because the dashboard
+// doesn't exist.
+func IsDashboardNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == cloudwatch.ErrCodeDashboardNotFoundError
+	}
+	return false
+}
+
+// GetDashboardBody returns the dashboard body of the given parameters,
+// reading it from the referenced ConfigMap if necessary and rendering it with
+// the given variables.
+func GetDashboardBody(ctx context.Context, kube client.Client, p v1alpha1.DashboardParameters) (string, error) {
+	var body string
+	switch {
+	case p.DashboardBody != nil:
+		body = *p.DashboardBody
+	case p.DashboardBodyConfigMapRef != nil:
+		ref := p.DashboardBodyConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+			return "", errors.Wrap(err, errGetDashboardBodyCM)
+		}
+		b, ok := cm.Data[ref.Key]
+		if !ok {
+			return "", errors.New(errDashboardBodyNotFound)
+		}
+		body = b
+	default:
+		return "", errors.New(errNoDashboardBody)
+	}
+	if len(p.DashboardBodyVariables) == 0 {
+		return body, nil
+	}
+	return renderDashboardBody(body, p.DashboardBodyVariables)
+}
+
+func renderDashboardBody(body string, vars map[string]string) (string, error) {
+	t, err := template.New("dashboardBody").Option("missingkey=error").Parse(body)
+	if err != nil {
+		return "", errors.Wrap(err, errRenderDashboardBody)
+	}
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, vars); err != nil {
+		return "", errors.Wrap(err, errRenderDashboardBody)
+	}
+	return buf.String(), nil
+}
+
+// GenerateDashboardObservation returns the observation of the given dashboard.
+func GenerateDashboardObservation(o cloudwatch.GetDashboardOutput) v1alpha1.DashboardObservation {
+	return v1alpha1.DashboardObservation{DashboardARN: aws.StringValue(o.DashboardArn)}
+}
+
+// IsDashboardUpToDate checks whether the observed dashboard body is
+// semantically equal to the desired one.
+func IsDashboardUpToDate(body string, o cloudwatch.GetDashboardOutput) bool {
+	var desired, observed interface{}
+	if err := json.Unmarshal([]byte(body), &desired); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(aws.StringValue(o.DashboardBody)), &observed); err != nil {
+		return false
+	}
+	return cmp.Equal(desired, observed)
+}
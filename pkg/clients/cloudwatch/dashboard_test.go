@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+)
+
+var (
+	dashboardBody         = `{"widgets":[{"type":"text","properties":{"markdown":"hello"}}]}`
+	dashboardBodyTemplate = `{"widgets":[{"type":"text","properties":{"markdown":"{{ .greeting }}"}}]}`
+)
+
+func TestGetDashboardBody(t *testing.T) {
+	errBoom := errors.New("boom")
+	ref := &v1alpha1.ConfigMapKeySelector{Name: "dashboards", Namespace: "default", Key: "body.json"}
+	type want struct {
+		body string
+		err  error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		p    v1alpha1.DashboardParameters
+		want want
+	}{
+		"Inline": {
+			p:    v1alpha1.DashboardParameters{DashboardBody: aws.String(dashboardBody)},
+			want: want{body: dashboardBody},
+		},
+		"ConfigMap": {
+			kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+				obj.(*corev1.ConfigMap).Data = map[string]string{"body.json": dashboardBody}
+				return nil
+			}},
+			p:    v1alpha1.DashboardParameters{DashboardBodyConfigMapRef: ref},
+			want: want{body: dashboardBody},
+		},
+		"ConfigMapTemplate": {
+			kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+				obj.(*corev1.ConfigMap).Data = map[string]string{"body.json": dashboardBodyTemplate}
+				return nil
+			}},
+			p: v1alpha1.DashboardParameters{
+				DashboardBodyConfigMapRef: ref,
+				DashboardBodyVariables:    map[string]string{"greeting": "hello"},
+			},
+			want: want{body: dashboardBody},
+		},
+		"ConfigMapError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p:    v1alpha1.DashboardParameters{DashboardBodyConfigMapRef: ref},
+			want: want{err: errors.Wrap(errBoom, errGetDashboardBodyCM)},
+		},
+		"ConfigMapMissingKey": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			p:    v1alpha1.DashboardParameters{DashboardBodyConfigMapRef: ref},
+			want: want{err: errors.New(errDashboardBodyNotFound)},
+		},
+		"MissingVariable": {
+			p: v1alpha1.DashboardParameters{
+				DashboardBody:          aws.String(dashboardBodyTemplate),
+				DashboardBodyVariables: map[string]string{"name": "hello"},
+			},
+			want: want{err: errors.Wrap(errors.New(`template: dashboardBody:1:56: executing "dashboardBody" at <.greeting>: map has no entry for key "greeting"`), errRenderDashboardBody)},
+		},
+		"NoBody": {
+			want: want{err: errors.New(errNoDashboardBody)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetDashboardBody(context.Background(), tc.kube, tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.body, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDashboardUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		body string
+		o    cloudwatch.GetDashboardOutput
+		want bool
+	}{
+		"Equal": {
+			body: dashboardBody,
+			o:    cloudwatch.GetDashboardOutput{DashboardBody: aws.String(dashboardBody)},
+			want: true,
+		},
+		"SemanticallyEqual": {
+			body: dashboardBody,
+			o: cloudwatch.GetDashboardOutput{DashboardBody: aws.String(`{
+  "widgets": [{"properties": {"markdown": "hello"}, "type": "text"}]
+}`)},
+			want: true,
+		},
+		"Drifted": {
+			body: dashboardBody,
+			o:    cloudwatch.GetDashboardOutput{DashboardBody: aws.String(`{"widgets":[]}`)},
+			want: false,
+		},
+		"InvalidJSON": {
+			body: "{",
+			o:    cloudwatch.GetDashboardOutput{DashboardBody: aws.String(dashboardBody)},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDashboardUpToDate(tc.body, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
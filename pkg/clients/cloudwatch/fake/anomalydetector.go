@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/cloudwatch"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AnomalyDetectorClient = (*MockAnomalyDetectorClient)(nil)
+
+// MockAnomalyDetectorClient is a type that implements all the methods for AnomalyDetectorClient interface
+type MockAnomalyDetectorClient struct {
+	MockPutAnomalyDetector       func(*cloudwatch.PutAnomalyDetectorInput) cloudwatch.PutAnomalyDetectorRequest
+	MockDescribeAnomalyDetectors func(*cloudwatch.DescribeAnomalyDetectorsInput) cloudwatch.DescribeAnomalyDetectorsRequest
+	MockDeleteAnomalyDetector    func(*cloudwatch.DeleteAnomalyDetectorInput) cloudwatch.DeleteAnomalyDetectorRequest
+}
+
+// PutAnomalyDetectorRequest mocks PutAnomalyDetectorRequest method
+func (m *MockAnomalyDetectorClient) PutAnomalyDetectorRequest(input *cloudwatch.PutAnomalyDetectorInput) cloudwatch.PutAnomalyDetectorRequest {
+	return m.MockPutAnomalyDetector(input)
+}
+
+// DescribeAnomalyDetectorsRequest mocks DescribeAnomalyDetectorsRequest method
+func (m *MockAnomalyDetectorClient) DescribeAnomalyDetectorsRequest(input *cloudwatch.DescribeAnomalyDetectorsInput) cloudwatch.DescribeAnomalyDetectorsRequest {
+	return m.MockDescribeAnomalyDetectors(input)
+}
+
+// DeleteAnomalyDetectorRequest mocks DeleteAnomalyDetectorRequest method
+func (m *MockAnomalyDetectorClient) DeleteAnomalyDetectorRequest(input *cloudwatch.DeleteAnomalyDetectorInput) cloudwatch.DeleteAnomalyDetectorRequest {
+	return m.MockDeleteAnomalyDetector(input)
+}
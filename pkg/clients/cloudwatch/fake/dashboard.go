@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/cloudwatch"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DashboardClient = (*MockDashboardClient)(nil)
+
+// MockDashboardClient is a type that implements all the methods for DashboardClient interface
+type MockDashboardClient struct {
+	MockGetDashboard     func(*cloudwatch.GetDashboardInput) cloudwatch.GetDashboardRequest
+	MockPutDashboard     func(*cloudwatch.PutDashboardInput) cloudwatch.PutDashboardRequest
+	MockDeleteDashboards func(*cloudwatch.DeleteDashboardsInput) cloudwatch.DeleteDashboardsRequest
+}
+
+// GetDashboardRequest mocks GetDashboardRequest method
+func (m *MockDashboardClient) GetDashboardRequest(input *cloudwatch.GetDashboardInput) cloudwatch.GetDashboardRequest {
+	return m.MockGetDashboard(input)
+}
+
+// PutDashboardRequest mocks PutDashboardRequest method
+func (m *MockDashboardClient) PutDashboardRequest(input *cloudwatch.PutDashboardInput) cloudwatch.PutDashboardRequest {
+	return m.MockPutDashboard(input)
+}
+
+// DeleteDashboardsRequest mocks DeleteDashboardsRequest method
+func (m *MockDashboardClient) DeleteDashboardsRequest(input *cloudwatch.DeleteDashboardsInput) cloudwatch.DeleteDashboardsRequest {
+	return m.MockDeleteDashboards(input)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/codebuild"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ProjectClient = (*MockProjectClient)(nil)
+
+// MockProjectClient is a type that implements all the methods for ProjectClient interface
+type MockProjectClient struct {
+	MockBatchGetProjects func(*codebuild.BatchGetProjectsInput) codebuild.BatchGetProjectsRequest
+	MockCreateProject    func(*codebuild.CreateProjectInput) codebuild.CreateProjectRequest
+	MockUpdateProject    func(*codebuild.UpdateProjectInput) codebuild.UpdateProjectRequest
+	MockDeleteProject    func(*codebuild.DeleteProjectInput) codebuild.DeleteProjectRequest
+	MockCreateWebhook    func(*codebuild.CreateWebhookInput) codebuild.CreateWebhookRequest
+	MockUpdateWebhook    func(*codebuild.UpdateWebhookInput) codebuild.UpdateWebhookRequest
+	MockDeleteWebhook    func(*codebuild.DeleteWebhookInput) codebuild.DeleteWebhookRequest
+}
+
+// BatchGetProjectsRequest mocks BatchGetProjectsRequest method
+func (m *MockProjectClient) BatchGetProjectsRequest(input *codebuild.BatchGetProjectsInput) codebuild.BatchGetProjectsRequest {
+	return m.MockBatchGetProjects(input)
+}
+
+// CreateProjectRequest mocks CreateProjectRequest method
+func (m *MockProjectClient) CreateProjectRequest(input *codebuild.CreateProjectInput) codebuild.CreateProjectRequest {
+	return m.MockCreateProject(input)
+}
+
+// UpdateProjectRequest mocks UpdateProjectRequest method
+func (m *MockProjectClient) UpdateProjectRequest(input *codebuild.UpdateProjectInput) codebuild.UpdateProjectRequest {
+	return m.MockUpdateProject(input)
+}
+
+// DeleteProjectRequest mocks DeleteProjectRequest method
+func (m *MockProjectClient) DeleteProjectRequest(input *codebuild.DeleteProjectInput) codebuild.DeleteProjectRequest {
+	return m.MockDeleteProject(input)
+}
+
+// CreateWebhookRequest mocks CreateWebhookRequest method
+func (m *MockProjectClient) CreateWebhookRequest(input *codebuild.CreateWebhookInput) codebuild.CreateWebhookRequest {
+	return m.MockCreateWebhook(input)
+}
+
+// UpdateWebhookRequest mocks UpdateWebhookRequest method
+func (m *MockProjectClient) UpdateWebhookRequest(input *codebuild.UpdateWebhookInput) codebuild.UpdateWebhookRequest {
+	return m.MockUpdateWebhook(input)
+}
+
+// DeleteWebhookRequest mocks DeleteWebhookRequest method
+func (m *MockProjectClient) DeleteWebhookRequest(input *codebuild.DeleteWebhookInput) codebuild.DeleteWebhookRequest {
+	return m.MockDeleteWebhook(input)
+}
@@ -0,0 +1,378 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codebuild
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-aws/apis/codebuild/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetEnvironmentVariableSecret = "cannot get the value secret of an environment variable"
+)
+
+// ProjectClient is the external client used for Project Custom Resource
+type ProjectClient interface {
+	BatchGetProjectsRequest(*codebuild.BatchGetProjectsInput) codebuild.BatchGetProjectsRequest
+	CreateProjectRequest(*codebuild.CreateProjectInput) codebuild.CreateProjectRequest
+	UpdateProjectRequest(*codebuild.UpdateProjectInput) codebuild.UpdateProjectRequest
+	DeleteProjectRequest(*codebuild.DeleteProjectInput) codebuild.DeleteProjectRequest
+	CreateWebhookRequest(*codebuild.CreateWebhookInput) codebuild.CreateWebhookRequest
+	UpdateWebhookRequest(*codebuild.UpdateWebhookInput) codebuild.UpdateWebhookRequest
+	DeleteWebhookRequest(*codebuild.DeleteWebhookInput) codebuild.DeleteWebhookRequest
+}
+
+// NewProjectClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewProjectClient(cfg aws.Config) ProjectClient {
+	return codebuild.New(cfg)
+}
+
+// GetEnvironmentVariableValues returns the values of the environment
+// variables of the given project that are read from a secret, by variable
+// name.
+func GetEnvironmentVariableValues(ctx context.Context, kube client.Client, p v1alpha1.ProjectParameters) (map[string]string, error) {
+	values := map[string]string{}
+	for _, v := range p.Environment.EnvironmentVariables {
+		ref := v.ValueSecretRef
+		if ref == nil {
+			continue
+		}
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+			return nil, errors.Wrap(err, errGetEnvironmentVariableSecret)
+		}
+		values[v.Name] = string(s.Data[ref.Key])
+	}
+	return values, nil
+}
+
+// GenerateCreateProjectInput returns the create input of a project with the
+// given name. The values of the environment variables that are read from a
+// secret are taken from secretValues.
+func GenerateCreateProjectInput(name string, p v1alpha1.ProjectParameters, secretValues map[string]string) *codebuild.CreateProjectInput {
+	return &codebuild.CreateProjectInput{
+		Name:                   aws.String(name),
+		Description:            p.Description,
+		Source:                 generateSource(p.Source),
+		SourceVersion:          p.SourceVersion,
+		Environment:            generateEnvironment(p.Environment, secretValues),
+		Artifacts:              generateArtifacts(p.Artifacts),
+		ServiceRole:            p.ServiceRoleARN,
+		EncryptionKey:          p.EncryptionKey,
+		TimeoutInMinutes:       p.TimeoutInMinutes,
+		QueuedTimeoutInMinutes: p.QueuedTimeoutInMinutes,
+		BadgeEnabled:           p.BadgeEnabled,
+		VpcConfig:              generateVPCConfig(p.VPCConfig),
+		Tags:                   generateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateProjectInput returns the update input of the project with the
+// given name. The VPC configuration is always sent so that it is removed from
+// the project once it is removed from the parameters.
+func GenerateUpdateProjectInput(name string, p v1alpha1.ProjectParameters, secretValues map[string]string) *codebuild.UpdateProjectInput {
+	in := &codebuild.UpdateProjectInput{
+		Name:                   aws.String(name),
+		Description:            p.Description,
+		Source:                 generateSource(p.Source),
+		SourceVersion:          p.SourceVersion,
+		Environment:            generateEnvironment(p.Environment, secretValues),
+		Artifacts:              generateArtifacts(p.Artifacts),
+		ServiceRole:            p.ServiceRoleARN,
+		EncryptionKey:          p.EncryptionKey,
+		TimeoutInMinutes:       p.TimeoutInMinutes,
+		QueuedTimeoutInMinutes: p.QueuedTimeoutInMinutes,
+		BadgeEnabled:           p.BadgeEnabled,
+		VpcConfig:              generateVPCConfig(p.VPCConfig),
+		Tags:                   generateTags(p.Tags),
+	}
+	if in.VpcConfig == nil {
+		in.VpcConfig = &codebuild.VpcConfig{}
+	}
+	return in
+}
+
+// GenerateProjectObservation returns the observation of the given project.
+func GenerateProjectObservation(o codebuild.Project) v1alpha1.ProjectObservation {
+	obs := v1alpha1.ProjectObservation{
+		ARN: aws.StringValue(o.Arn),
+	}
+	if o.Badge != nil {
+		obs.BadgeRequestURL = aws.StringValue(o.Badge.BadgeRequestUrl)
+	}
+	if o.Webhook != nil {
+		obs.WebhookURL = aws.StringValue(o.Webhook.Url)
+		obs.WebhookPayloadURL = aws.StringValue(o.Webhook.PayloadUrl)
+	}
+	return obs
+}
+
+// LateInitializeProject fills the empty fields of the given parameters with
+// the defaults that CodeBuild assigned to the project.
+func LateInitializeProject(in *v1alpha1.ProjectParameters, o *codebuild.Project) { // nolint:gocyclo
+	if o == nil {
+		return
+	}
+	in.EncryptionKey = awsclients.LateInitializeStringPtr(in.EncryptionKey, o.EncryptionKey)
+	in.TimeoutInMinutes = awsclients.LateInitializeInt64Ptr(in.TimeoutInMinutes, o.TimeoutInMinutes)
+	in.QueuedTimeoutInMinutes = awsclients.LateInitializeInt64Ptr(in.QueuedTimeoutInMinutes, o.QueuedTimeoutInMinutes)
+	if o.Badge != nil {
+		in.BadgeEnabled = awsclients.LateInitializeBoolPtr(in.BadgeEnabled, o.Badge.BadgeEnabled)
+	}
+	if o.Source != nil {
+		in.Source.GitCloneDepth = awsclients.LateInitializeInt64Ptr(in.Source.GitCloneDepth, o.Source.GitCloneDepth)
+		in.Source.InsecureSSL = awsclients.LateInitializeBoolPtr(in.Source.InsecureSSL, o.Source.InsecureSsl)
+		in.Source.ReportBuildStatus = awsclients.LateInitializeBoolPtr(in.Source.ReportBuildStatus, o.Source.ReportBuildStatus)
+	}
+	if o.Environment != nil {
+		e := &in.Environment
+		e.ImagePullCredentialsType = awsclients.LateInitializeStringPtr(e.ImagePullCredentialsType, awsclients.String(string(o.Environment.ImagePullCredentialsType)))
+		e.PrivilegedMode = awsclients.LateInitializeBoolPtr(e.PrivilegedMode, o.Environment.PrivilegedMode)
+		if len(e.EnvironmentVariables) == len(o.Environment.EnvironmentVariables) {
+			for i := range e.EnvironmentVariables {
+				v, ov := &e.EnvironmentVariables[i], o.Environment.EnvironmentVariables[i]
+				if v.Name == aws.StringValue(ov.Name) {
+					v.Type = awsclients.LateInitializeStringPtr(v.Type, awsclients.String(string(ov.Type)))
+				}
+			}
+		}
+	}
+	if o.Artifacts != nil {
+		a := &in.Artifacts
+		a.NamespaceType = awsclients.LateInitializeStringPtr(a.NamespaceType, awsclients.String(string(o.Artifacts.NamespaceType)))
+		a.Packaging = awsclients.LateInitializeStringPtr(a.Packaging, awsclients.String(string(o.Artifacts.Packaging)))
+		a.EncryptionDisabled = awsclients.LateInitializeBoolPtr(a.EncryptionDisabled, o.Artifacts.EncryptionDisabled)
+		a.OverrideArtifactName = awsclients.LateInitializeBoolPtr(a.OverrideArtifactName, o.Artifacts.OverrideArtifactName)
+	}
+}
+
+// IsProjectUpToDate returns whether the observed project is up to date with
+// the given parameters, apart from its webhook. The values of the environment
+// variables that are read from a secret are taken from secretValues.
+func IsProjectUpToDate(p v1alpha1.ProjectParameters, o codebuild.Project, secretValues map[string]string) bool {
+	desired := p.DeepCopy()
+	for i := range desired.Environment.EnvironmentVariables {
+		v := &desired.Environment.EnvironmentVariables[i]
+		if v.ValueSecretRef != nil {
+			v.Value = awsclients.String(secretValues[v.Name])
+		}
+	}
+	return cmp.Equal(desired, generateProjectParameters(o), cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.ProjectParameters{}, "Region", "Webhook"),
+		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, []runtimev1alpha1.Reference{}, &runtimev1alpha1.SecretKeySelector{}),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.SortSlices(func(a, b v1alpha1.Tag) bool { return a.Key < b.Key }))
+}
+
+// GenerateWebhookFilterGroups returns the filter groups of the given webhook.
+func GenerateWebhookFilterGroups(w v1alpha1.Webhook) [][]codebuild.WebhookFilter {
+	if len(w.FilterGroups) == 0 {
+		return nil
+	}
+	groups := make([][]codebuild.WebhookFilter, len(w.FilterGroups))
+	for i, g := range w.FilterGroups {
+		groups[i] = make([]codebuild.WebhookFilter, len(g.Filters))
+		for j, f := range g.Filters {
+			groups[i][j] = codebuild.WebhookFilter{
+				Type:                  codebuild.WebhookFilterType(f.Type),
+				Pattern:               aws.String(f.Pattern),
+				ExcludeMatchedPattern: f.ExcludeMatchedPattern,
+			}
+		}
+	}
+	return groups
+}
+
+// IsWebhookUpToDate returns whether the observed webhook has the filter groups
+// of the given webhook.
+func IsWebhookUpToDate(w v1alpha1.Webhook, o codebuild.Webhook) bool {
+	return cmp.Equal(generateWebhook(GenerateWebhookFilterGroups(w)), generateWebhook(o.FilterGroups), cmpopts.EquateEmpty())
+}
+
+func generateWebhook(groups [][]codebuild.WebhookFilter) v1alpha1.Webhook {
+	w := v1alpha1.Webhook{}
+	for _, g := range groups {
+		fg := v1alpha1.WebhookFilterGroup{}
+		for _, f := range g {
+			fg.Filters = append(fg.Filters, v1alpha1.WebhookFilter{
+				Type:                  string(f.Type),
+				Pattern:               aws.StringValue(f.Pattern),
+				ExcludeMatchedPattern: awsclients.Bool(aws.BoolValue(f.ExcludeMatchedPattern)),
+			})
+		}
+		w.FilterGroups = append(w.FilterGroups, fg)
+	}
+	return w
+}
+
+func generateSource(s v1alpha1.ProjectSource) *codebuild.ProjectSource {
+	o := &codebuild.ProjectSource{
+		Type:              codebuild.SourceType(s.Type),
+		Location:          aws.String(s.Location),
+		Buildspec:         s.Buildspec,
+		GitCloneDepth:     s.GitCloneDepth,
+		ReportBuildStatus: s.ReportBuildStatus,
+		InsecureSsl:       s.InsecureSSL,
+	}
+	if s.FetchSubmodules != nil {
+		o.GitSubmodulesConfig = &codebuild.GitSubmodulesConfig{FetchSubmodules: s.FetchSubmodules}
+	}
+	return o
+}
+
+func generateEnvironment(e v1alpha1.ProjectEnvironment, secretValues map[string]string) *codebuild.ProjectEnvironment {
+	o := &codebuild.ProjectEnvironment{
+		Type:                     codebuild.EnvironmentType(e.Type),
+		ComputeType:              codebuild.ComputeType(e.ComputeType),
+		Image:                    aws.String(e.Image),
+		ImagePullCredentialsType: codebuild.ImagePullCredentialsType(aws.StringValue(e.ImagePullCredentialsType)),
+		PrivilegedMode:           e.PrivilegedMode,
+		Certificate:              e.Certificate,
+	}
+	for _, v := range e.EnvironmentVariables {
+		value := aws.StringValue(v.Value)
+		if v.ValueSecretRef != nil {
+			value = secretValues[v.Name]
+		}
+		o.EnvironmentVariables = append(o.EnvironmentVariables, codebuild.EnvironmentVariable{
+			Name:  aws.String(v.Name),
+			Type:  codebuild.EnvironmentVariableType(aws.StringValue(v.Type)),
+			Value: aws.String(value),
+		})
+	}
+	return o
+}
+
+func generateArtifacts(a v1alpha1.ProjectArtifacts) *codebuild.ProjectArtifacts {
+	return &codebuild.ProjectArtifacts{
+		Type:                 codebuild.ArtifactsType(a.Type),
+		Location:             a.Location,
+		Name:                 a.Name,
+		Path:                 a.Path,
+		NamespaceType:        codebuild.ArtifactNamespace(aws.StringValue(a.NamespaceType)),
+		Packaging:            codebuild.ArtifactPackaging(aws.StringValue(a.Packaging)),
+		EncryptionDisabled:   a.EncryptionDisabled,
+		OverrideArtifactName: a.OverrideArtifactName,
+	}
+}
+
+func generateVPCConfig(v *v1alpha1.VPCConfig) *codebuild.VpcConfig {
+	if v == nil {
+		return nil
+	}
+	return &codebuild.VpcConfig{
+		VpcId:            v.VPCID,
+		Subnets:          v.SubnetIDs,
+		SecurityGroupIds: v.SecurityGroupIDs,
+	}
+}
+
+func generateTags(tags []v1alpha1.Tag) []codebuild.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]codebuild.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = codebuild.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	sort.Slice(res, func(i, j int) bool { return aws.StringValue(res[i].Key) < aws.StringValue(res[j].Key) })
+	return res
+}
+
+// generateProjectParameters returns the parameters of the observed project.
+// Optional fields that are unset or false are left empty.
+func generateProjectParameters(o codebuild.Project) *v1alpha1.ProjectParameters { // nolint:gocyclo
+	p := &v1alpha1.ProjectParameters{
+		Description:            awsclients.String(aws.StringValue(o.Description)),
+		SourceVersion:          awsclients.String(aws.StringValue(o.SourceVersion)),
+		ServiceRoleARN:         o.ServiceRole,
+		EncryptionKey:          o.EncryptionKey,
+		TimeoutInMinutes:       o.TimeoutInMinutes,
+		QueuedTimeoutInMinutes: o.QueuedTimeoutInMinutes,
+	}
+	if o.Badge != nil {
+		p.BadgeEnabled = o.Badge.BadgeEnabled
+	}
+	if s := o.Source; s != nil {
+		p.Source = v1alpha1.ProjectSource{
+			Type:              string(s.Type),
+			Location:          aws.StringValue(s.Location),
+			Buildspec:         awsclients.String(aws.StringValue(s.Buildspec)),
+			GitCloneDepth:     s.GitCloneDepth,
+			ReportBuildStatus: s.ReportBuildStatus,
+			InsecureSSL:       s.InsecureSsl,
+		}
+		if s.GitSubmodulesConfig != nil {
+			p.Source.FetchSubmodules = s.GitSubmodulesConfig.FetchSubmodules
+		}
+	}
+	if e := o.Environment; e != nil {
+		p.Environment = v1alpha1.ProjectEnvironment{
+			Type:                     string(e.Type),
+			ComputeType:              string(e.ComputeType),
+			Image:                    aws.StringValue(e.Image),
+			ImagePullCredentialsType: awsclients.String(string(e.ImagePullCredentialsType)),
+			PrivilegedMode:           e.PrivilegedMode,
+			Certificate:              awsclients.String(aws.StringValue(e.Certificate)),
+		}
+		for _, v := range e.EnvironmentVariables {
+			p.Environment.EnvironmentVariables = append(p.Environment.EnvironmentVariables, v1alpha1.EnvironmentVariable{
+				Name:  aws.StringValue(v.Name),
+				Type:  awsclients.String(string(v.Type)),
+				Value: awsclients.String(aws.StringValue(v.Value)),
+			})
+		}
+	}
+	if a := o.Artifacts; a != nil {
+		p.Artifacts = v1alpha1.ProjectArtifacts{
+			Type:                 string(a.Type),
+			Location:             awsclients.String(aws.StringValue(a.Location)),
+			Name:                 awsclients.String(aws.StringValue(a.Name)),
+			Path:                 awsclients.String(aws.StringValue(a.Path)),
+			NamespaceType:        awsclients.String(string(a.NamespaceType)),
+			Packaging:            awsclients.String(string(a.Packaging)),
+			EncryptionDisabled:   a.EncryptionDisabled,
+			OverrideArtifactName: a.OverrideArtifactName,
+		}
+	}
+	if v := o.VpcConfig; v != nil && (v.VpcId != nil || len(v.Subnets) != 0 || len(v.SecurityGroupIds) != 0) {
+		p.VPCConfig = &v1alpha1.VPCConfig{
+			VPCID:            v.VpcId,
+			SubnetIDs:        v.Subnets,
+			SecurityGroupIDs: v.SecurityGroupIds,
+		}
+	}
+	for _, t := range o.Tags {
+		p.Tags = append(p.Tags, v1alpha1.Tag{Key: aws.StringValue(t.Key), Value: aws.StringValue(t.Value)})
+	}
+	return p
+}
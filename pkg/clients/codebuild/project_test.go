@@ -0,0 +1,223 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codebuild
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-aws/apis/codebuild/v1alpha1"
+)
+
+var (
+	repository = "https://git-codecommit.us-east-1.amazonaws.com/v1/repos/sample"
+	image      = "aws/codebuild/standard:4.0"
+)
+
+func params(m ...func(*v1alpha1.ProjectParameters)) v1alpha1.ProjectParameters {
+	p := v1alpha1.ProjectParameters{
+		Region: "us-east-1",
+		Source: v1alpha1.ProjectSource{Type: "CODECOMMIT", Location: repository},
+		Environment: v1alpha1.ProjectEnvironment{
+			Type:        "LINUX_CONTAINER",
+			ComputeType: "BUILD_GENERAL1_SMALL",
+			Image:       image,
+		},
+		Artifacts:      v1alpha1.ProjectArtifacts{Type: "NO_ARTIFACTS"},
+		ServiceRoleARN: aws.String("arn:aws:iam::123456789012:role/codebuild"),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func project(m ...func(*codebuild.Project)) codebuild.Project {
+	o := codebuild.Project{
+		Source: &codebuild.ProjectSource{Type: codebuild.SourceTypeCodecommit, Location: aws.String(repository)},
+		Environment: &codebuild.ProjectEnvironment{
+			Type:        codebuild.EnvironmentTypeLinuxContainer,
+			ComputeType: codebuild.ComputeTypeBuildGeneral1Small,
+			Image:       aws.String(image),
+		},
+		Artifacts:   &codebuild.ProjectArtifacts{Type: codebuild.ArtifactsTypeNoArtifacts},
+		ServiceRole: aws.String("arn:aws:iam::123456789012:role/codebuild"),
+	}
+	for _, f := range m {
+		f(&o)
+	}
+	return o
+}
+
+func TestLateInitializeProject(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.ProjectParameters
+		observed *codebuild.Project
+		want     v1alpha1.ProjectParameters
+	}{
+		"AllFilled": {
+			in: params(func(p *v1alpha1.ProjectParameters) {
+				p.TimeoutInMinutes = aws.Int64(30)
+				p.Environment.EnvironmentVariables = []v1alpha1.EnvironmentVariable{{Name: "STAGE", Value: aws.String("dev")}}
+			}),
+			observed: &codebuild.Project{
+				TimeoutInMinutes:       aws.Int64(60),
+				QueuedTimeoutInMinutes: aws.Int64(480),
+				EncryptionKey:          aws.String("alias/aws/s3"),
+				Badge:                  &codebuild.ProjectBadge{BadgeEnabled: aws.Bool(false)},
+				Source:                 &codebuild.ProjectSource{InsecureSsl: aws.Bool(false)},
+				Environment: &codebuild.ProjectEnvironment{
+					ImagePullCredentialsType: codebuild.ImagePullCredentialsTypeCodebuild,
+					PrivilegedMode:           aws.Bool(false),
+					EnvironmentVariables: []codebuild.EnvironmentVariable{
+						{Name: aws.String("STAGE"), Type: codebuild.EnvironmentVariableTypePlaintext, Value: aws.String("dev")},
+					},
+				},
+				Artifacts: &codebuild.ProjectArtifacts{Packaging: codebuild.ArtifactPackagingNone},
+			},
+			want: params(func(p *v1alpha1.ProjectParameters) {
+				p.TimeoutInMinutes = aws.Int64(30)
+				p.QueuedTimeoutInMinutes = aws.Int64(480)
+				p.EncryptionKey = aws.String("alias/aws/s3")
+				p.BadgeEnabled = aws.Bool(false)
+				p.Source.InsecureSSL = aws.Bool(false)
+				p.Environment.ImagePullCredentialsType = aws.String("CODEBUILD")
+				p.Environment.PrivilegedMode = aws.Bool(false)
+				p.Environment.EnvironmentVariables = []v1alpha1.EnvironmentVariable{{Name: "STAGE", Type: aws.String("PLAINTEXT"), Value: aws.String("dev")}}
+				p.Artifacts.Packaging = aws.String("NONE")
+			}),
+		},
+		"NoObservation": {
+			in:   params(),
+			want: params(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeProject(&tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsProjectUpToDate(t *testing.T) {
+	secret := &runtimev1alpha1.SecretKeySelector{Key: "token"}
+	cases := map[string]struct {
+		p      v1alpha1.ProjectParameters
+		o      codebuild.Project
+		values map[string]string
+		want   bool
+	}{
+		"UpToDate": {
+			p: params(func(p *v1alpha1.ProjectParameters) {
+				p.Tags = []v1alpha1.Tag{{Key: "b", Value: "2"}, {Key: "a", Value: "1"}}
+				p.Webhook = &v1alpha1.Webhook{}
+			}),
+			o: project(func(o *codebuild.Project) {
+				o.Tags = []codebuild.Tag{{Key: aws.String("a"), Value: aws.String("1")}, {Key: aws.String("b"), Value: aws.String("2")}}
+				o.VpcConfig = &codebuild.VpcConfig{}
+			}),
+			want: true,
+		},
+		"SecretValueUpToDate": {
+			p: params(func(p *v1alpha1.ProjectParameters) {
+				p.Environment.EnvironmentVariables = []v1alpha1.EnvironmentVariable{{Name: "TOKEN", ValueSecretRef: secret}}
+			}),
+			o: project(func(o *codebuild.Project) {
+				o.Environment.EnvironmentVariables = []codebuild.EnvironmentVariable{{Name: aws.String("TOKEN"), Value: aws.String("s3cr3t")}}
+			}),
+			values: map[string]string{"TOKEN": "s3cr3t"},
+			want:   true,
+		},
+		"SecretValueChanged": {
+			p: params(func(p *v1alpha1.ProjectParameters) {
+				p.Environment.EnvironmentVariables = []v1alpha1.EnvironmentVariable{{Name: "TOKEN", ValueSecretRef: secret}}
+			}),
+			o: project(func(o *codebuild.Project) {
+				o.Environment.EnvironmentVariables = []codebuild.EnvironmentVariable{{Name: aws.String("TOKEN"), Value: aws.String("old")}}
+			}),
+			values: map[string]string{"TOKEN": "new"},
+			want:   false,
+		},
+		"VPCConfigRemoved": {
+			p: params(),
+			o: project(func(o *codebuild.Project) {
+				o.VpcConfig = &codebuild.VpcConfig{VpcId: aws.String("vpc-1"), Subnets: []string{"subnet-1"}}
+			}),
+			want: false,
+		},
+		"ImageChanged": {
+			p:    params(func(p *v1alpha1.ProjectParameters) { p.Environment.Image = "aws/codebuild/standard:5.0" }),
+			o:    project(),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsProjectUpToDate(tc.p, tc.o, tc.values)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsWebhookUpToDate(t *testing.T) {
+	push := codebuild.WebhookFilter{Type: codebuild.WebhookFilterTypeEvent, Pattern: aws.String("PUSH"), ExcludeMatchedPattern: aws.Bool(false)}
+	cases := map[string]struct {
+		w    v1alpha1.Webhook
+		o    codebuild.Webhook
+		want bool
+	}{
+		"UpToDate": {
+			w: v1alpha1.Webhook{FilterGroups: []v1alpha1.WebhookFilterGroup{{
+				Filters: []v1alpha1.WebhookFilter{{Type: "EVENT", Pattern: "PUSH"}},
+			}}},
+			o:    codebuild.Webhook{FilterGroups: [][]codebuild.WebhookFilter{{push}}},
+			want: true,
+		},
+		"NoFilters": {
+			o:    codebuild.Webhook{},
+			want: true,
+		},
+		"FilterAdded": {
+			w: v1alpha1.Webhook{FilterGroups: []v1alpha1.WebhookFilterGroup{{
+				Filters: []v1alpha1.WebhookFilter{
+					{Type: "EVENT", Pattern: "PUSH"},
+					{Type: "HEAD_REF", Pattern: "^refs/heads/master$"},
+				},
+			}}},
+			o:    codebuild.Webhook{FilterGroups: [][]codebuild.WebhookFilter{{push}}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsWebhookUpToDate(tc.w, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
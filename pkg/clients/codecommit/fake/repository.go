@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/codecommit"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/codecommit"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.RepositoryClient = (*MockRepositoryClient)(nil)
+
+// MockRepositoryClient is a type that implements all the methods for RepositoryClient interface
+type MockRepositoryClient struct {
+	MockGetRepository                                    func(*codecommit.GetRepositoryInput) codecommit.GetRepositoryRequest
+	MockCreateRepository                                 func(*codecommit.CreateRepositoryInput) codecommit.CreateRepositoryRequest
+	MockDeleteRepository                                 func(*codecommit.DeleteRepositoryInput) codecommit.DeleteRepositoryRequest
+	MockUpdateRepositoryDescription                      func(*codecommit.UpdateRepositoryDescriptionInput) codecommit.UpdateRepositoryDescriptionRequest
+	MockUpdateDefaultBranch                              func(*codecommit.UpdateDefaultBranchInput) codecommit.UpdateDefaultBranchRequest
+	MockGetRepositoryTriggers                            func(*codecommit.GetRepositoryTriggersInput) codecommit.GetRepositoryTriggersRequest
+	MockPutRepositoryTriggers                            func(*codecommit.PutRepositoryTriggersInput) codecommit.PutRepositoryTriggersRequest
+	MockListAssociatedApprovalRuleTemplatesForRepository func(*codecommit.ListAssociatedApprovalRuleTemplatesForRepositoryInput) codecommit.ListAssociatedApprovalRuleTemplatesForRepositoryRequest
+	MockAssociateApprovalRuleTemplateWithRepository      func(*codecommit.AssociateApprovalRuleTemplateWithRepositoryInput) codecommit.AssociateApprovalRuleTemplateWithRepositoryRequest
+	MockDisassociateApprovalRuleTemplateFromRepository   func(*codecommit.DisassociateApprovalRuleTemplateFromRepositoryInput) codecommit.DisassociateApprovalRuleTemplateFromRepositoryRequest
+	MockListTagsForResource                              func(*codecommit.ListTagsForResourceInput) codecommit.ListTagsForResourceRequest
+	MockTagResource                                      func(*codecommit.TagResourceInput) codecommit.TagResourceRequest
+	MockUntagResource                                    func(*codecommit.UntagResourceInput) codecommit.UntagResourceRequest
+}
+
+// GetRepositoryRequest mocks GetRepositoryRequest method
+func (m *MockRepositoryClient) GetRepositoryRequest(input *codecommit.GetRepositoryInput) codecommit.GetRepositoryRequest {
+	return m.MockGetRepository(input)
+}
+
+// CreateRepositoryRequest mocks CreateRepositoryRequest method
+func (m *MockRepositoryClient) CreateRepositoryRequest(input *codecommit.CreateRepositoryInput) codecommit.CreateRepositoryRequest {
+	return m.MockCreateRepository(input)
+}
+
+// DeleteRepositoryRequest mocks DeleteRepositoryRequest method
+func (m *MockRepositoryClient) DeleteRepositoryRequest(input *codecommit.DeleteRepositoryInput) codecommit.DeleteRepositoryRequest {
+	return m.MockDeleteRepository(input)
+}
+
+// UpdateRepositoryDescriptionRequest mocks UpdateRepositoryDescriptionRequest method
+func (m *MockRepositoryClient) UpdateRepositoryDescriptionRequest(input *codecommit.UpdateRepositoryDescriptionInput) codecommit.UpdateRepositoryDescriptionRequest {
+	return m.MockUpdateRepositoryDescription(input)
+}
+
+// UpdateDefaultBranchRequest mocks UpdateDefaultBranchRequest method
+func (m *MockRepositoryClient) UpdateDefaultBranchRequest(input *codecommit.UpdateDefaultBranchInput) codecommit.UpdateDefaultBranchRequest {
+	return m.MockUpdateDefaultBranch(input)
+}
+
+// GetRepositoryTriggersRequest mocks GetRepositoryTriggersRequest method
+func (m *MockRepositoryClient) GetRepositoryTriggersRequest(input *codecommit.GetRepositoryTriggersInput) codecommit.GetRepositoryTriggersRequest {
+	return m.MockGetRepositoryTriggers(input)
+}
+
+// PutRepositoryTriggersRequest mocks PutRepositoryTriggersRequest method
+func (m *MockRepositoryClient) PutRepositoryTriggersRequest(input *codecommit.PutRepositoryTriggersInput) codecommit.PutRepositoryTriggersRequest {
+	return m.MockPutRepositoryTriggers(input)
+}
+
+// ListAssociatedApprovalRuleTemplatesForRepositoryRequest mocks ListAssociatedApprovalRuleTemplatesForRepositoryRequest method
+func (m *MockRepositoryClient) ListAssociatedApprovalRuleTemplatesForRepositoryRequest(input *codecommit.ListAssociatedApprovalRuleTemplatesForRepositoryInput) codecommit.ListAssociatedApprovalRuleTemplatesForRepositoryRequest {
+	return m.MockListAssociatedApprovalRuleTemplatesForRepository(input)
+}
+
+// AssociateApprovalRuleTemplateWithRepositoryRequest mocks AssociateApprovalRuleTemplateWithRepositoryRequest method
+func (m *MockRepositoryClient) AssociateApprovalRuleTemplateWithRepositoryRequest(input *codecommit.AssociateApprovalRuleTemplateWithRepositoryInput) codecommit.AssociateApprovalRuleTemplateWithRepositoryRequest {
+	return m.MockAssociateApprovalRuleTemplateWithRepository(input)
+}
+
+// DisassociateApprovalRuleTemplateFromRepositoryRequest mocks DisassociateApprovalRuleTemplateFromRepositoryRequest method
+func (m *MockRepositoryClient) DisassociateApprovalRuleTemplateFromRepositoryRequest(input *codecommit.DisassociateApprovalRuleTemplateFromRepositoryInput) codecommit.DisassociateApprovalRuleTemplateFromRepositoryRequest {
+	return m.MockDisassociateApprovalRuleTemplateFromRepository(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockRepositoryClient) ListTagsForResourceRequest(input *codecommit.ListTagsForResourceInput) codecommit.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockRepositoryClient) TagResourceRequest(input *codecommit.TagResourceInput) codecommit.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockRepositoryClient) UntagResourceRequest(input *codecommit.UntagResourceInput) codecommit.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
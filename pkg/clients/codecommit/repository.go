@@ -0,0 +1,192 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codecommit
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/codecommit"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/codecommit/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// ConnectionDetailsCloneURLHTTP is the key of the HTTPS clone URL in the
+	// connection secret of a Repository.
+	ConnectionDetailsCloneURLHTTP = "cloneUrlHttp"
+
+	// ConnectionDetailsCloneURLSSH is the key of the SSH clone URL in the
+	// connection secret of a Repository.
+	ConnectionDetailsCloneURLSSH = "cloneUrlSsh"
+)
+
+// RepositoryClient is the external client used for Repository Custom Resource
+type RepositoryClient interface {
+	GetRepositoryRequest(*codecommit.GetRepositoryInput) codecommit.GetRepositoryRequest
+	CreateRepositoryRequest(*codecommit.CreateRepositoryInput) codecommit.CreateRepositoryRequest
+	DeleteRepositoryRequest(*codecommit.DeleteRepositoryInput) codecommit.DeleteRepositoryRequest
+	UpdateRepositoryDescriptionRequest(*codecommit.UpdateRepositoryDescriptionInput) codecommit.UpdateRepositoryDescriptionRequest
+	UpdateDefaultBranchRequest(*codecommit.UpdateDefaultBranchInput) codecommit.UpdateDefaultBranchRequest
+	GetRepositoryTriggersRequest(*codecommit.GetRepositoryTriggersInput) codecommit.GetRepositoryTriggersRequest
+	PutRepositoryTriggersRequest(*codecommit.PutRepositoryTriggersInput) codecommit.PutRepositoryTriggersRequest
+	ListAssociatedApprovalRuleTemplatesForRepositoryRequest(*codecommit.ListAssociatedApprovalRuleTemplatesForRepositoryInput) codecommit.ListAssociatedApprovalRuleTemplatesForRepositoryRequest
+	AssociateApprovalRuleTemplateWithRepositoryRequest(*codecommit.AssociateApprovalRuleTemplateWithRepositoryInput) codecommit.AssociateApprovalRuleTemplateWithRepositoryRequest
+	DisassociateApprovalRuleTemplateFromRepositoryRequest(*codecommit.DisassociateApprovalRuleTemplateFromRepositoryInput) codecommit.DisassociateApprovalRuleTemplateFromRepositoryRequest
+	ListTagsForResourceRequest(*codecommit.ListTagsForResourceInput) codecommit.ListTagsForResourceRequest
+	TagResourceRequest(*codecommit.TagResourceInput) codecommit.TagResourceRequest
+	UntagResourceRequest(*codecommit.UntagResourceInput) codecommit.UntagResourceRequest
+}
+
+// NewRepositoryClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewRepositoryClient(cfg aws.Config) RepositoryClient {
+	return codecommit.New(cfg)
+}
+
+// IsRepositoryNotFound returns true if the error is because the repository
+// doesn't exist.
+func IsRepositoryNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == codecommit.ErrCodeRepositoryDoesNotExistException
+}
+
+// GenerateCreateRepositoryInput returns the create input of the repository
+// with the given name.
+func GenerateCreateRepositoryInput(name string, p v1alpha1.RepositoryParameters) *codecommit.CreateRepositoryInput {
+	return &codecommit.CreateRepositoryInput{
+		RepositoryName:        aws.String(name),
+		RepositoryDescription: p.Description,
+		Tags:                  GenerateTags(p.Tags),
+	}
+}
+
+// GenerateRepositoryObservation returns the observation of the given
+// repository.
+func GenerateRepositoryObservation(o codecommit.RepositoryMetadata) v1alpha1.RepositoryObservation {
+	return v1alpha1.RepositoryObservation{
+		ARN:          aws.StringValue(o.Arn),
+		RepositoryID: aws.StringValue(o.RepositoryId),
+		AccountID:    aws.StringValue(o.AccountId),
+		CloneURLHTTP: aws.StringValue(o.CloneUrlHttp),
+		CloneURLSSH:  aws.StringValue(o.CloneUrlSsh),
+	}
+}
+
+// LateInitializeRepository fills the empty fields of the given parameters
+// with the values of the observed repository.
+func LateInitializeRepository(in *v1alpha1.RepositoryParameters, o *codecommit.RepositoryMetadata) {
+	if o == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.RepositoryDescription)
+	in.DefaultBranch = awsclients.LateInitializeStringPtr(in.DefaultBranch, o.DefaultBranch)
+}
+
+// IsRepositoryUpToDate returns whether the description and default branch of
+// the observed repository are up to date with the given parameters. The
+// default branch of an empty repository can not be set, so it is considered
+// up to date until the first branch is pushed.
+func IsRepositoryUpToDate(p v1alpha1.RepositoryParameters, o codecommit.RepositoryMetadata) bool {
+	if aws.StringValue(p.Description) != aws.StringValue(o.RepositoryDescription) {
+		return false
+	}
+	return o.DefaultBranch == nil || p.DefaultBranch == nil || *p.DefaultBranch == *o.DefaultBranch
+}
+
+// GenerateTriggers returns the CodeCommit triggers of the given triggers.
+func GenerateTriggers(triggers []v1alpha1.RepositoryTrigger) []codecommit.RepositoryTrigger {
+	res := make([]codecommit.RepositoryTrigger, len(triggers))
+	for i, t := range triggers {
+		res[i] = codecommit.RepositoryTrigger{
+			Name:           aws.String(t.Name),
+			DestinationArn: t.DestinationARN,
+			Branches:       t.Branches,
+			CustomData:     t.CustomData,
+		}
+		for _, e := range t.Events {
+			res[i].Events = append(res[i].Events, codecommit.RepositoryTriggerEventEnum(e))
+		}
+	}
+	return res
+}
+
+// AreTriggersUpToDate returns whether the observed triggers of a repository
+// are up to date with the given triggers, regardless of their order.
+func AreTriggersUpToDate(triggers []v1alpha1.RepositoryTrigger, observed []codecommit.RepositoryTrigger) bool {
+	return cmp.Equal(GenerateTriggers(triggers), observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(codecommit.RepositoryTrigger{}),
+		cmpopts.SortSlices(func(a, b codecommit.RepositoryTrigger) bool {
+			return aws.StringValue(a.Name) < aws.StringValue(b.Name)
+		}))
+}
+
+// DiffApprovalRuleTemplates returns the names of the approval rule templates
+// that need to be associated with and disassociated from a repository.
+func DiffApprovalRuleTemplates(desired, observed []string) (associate, disassociate []string) {
+	current := make(map[string]bool, len(observed))
+	for _, n := range observed {
+		current[n] = true
+	}
+	for _, n := range desired {
+		if !current[n] {
+			associate = append(associate, n)
+		}
+		delete(current, n)
+	}
+	for n := range current {
+		disassociate = append(disassociate, n)
+	}
+	sort.Strings(disassociate)
+	return associate, disassociate
+}
+
+// GenerateTags returns the CodeCommit tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make(map[string]string, len(tags))
+	for _, t := range tags {
+		res[t.Key] = t.Value
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from a repository.
+func DiffTags(desired []v1alpha1.Tag, observed map[string]string) (add map[string]string, remove []string) {
+	local := GenerateTags(desired)
+	if local == nil {
+		local = map[string]string{}
+	}
+	return awsclients.DiffTags(local, observed)
+}
+
+// GetConnectionDetails returns the clone URLs of the given repository.
+func GetConnectionDetails(o codecommit.RepositoryMetadata) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		ConnectionDetailsCloneURLHTTP: []byte(aws.StringValue(o.CloneUrlHttp)),
+		ConnectionDetailsCloneURLSSH:  []byte(aws.StringValue(o.CloneUrlSsh)),
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codecommit
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codecommit"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/codecommit/v1alpha1"
+)
+
+var topicARN = "arn:aws:sns:us-east-1:123456789012:commits"
+
+func TestLateInitializeRepository(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.RepositoryParameters
+		observed *codecommit.RepositoryMetadata
+		want     v1alpha1.RepositoryParameters
+	}{
+		"AllFilled": {
+			in: v1alpha1.RepositoryParameters{Description: aws.String("desired")},
+			observed: &codecommit.RepositoryMetadata{
+				RepositoryDescription: aws.String("observed"),
+				DefaultBranch:         aws.String("master"),
+			},
+			want: v1alpha1.RepositoryParameters{Description: aws.String("desired"), DefaultBranch: aws.String("master")},
+		},
+		"EmptyRepository": {
+			in:       v1alpha1.RepositoryParameters{},
+			observed: &codecommit.RepositoryMetadata{},
+			want:     v1alpha1.RepositoryParameters{},
+		},
+		"NoObservation": {
+			in:   v1alpha1.RepositoryParameters{DefaultBranch: aws.String("main")},
+			want: v1alpha1.RepositoryParameters{DefaultBranch: aws.String("main")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeRepository(&tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRepositoryUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.RepositoryParameters
+		o    codecommit.RepositoryMetadata
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.RepositoryParameters{Description: aws.String("d"), DefaultBranch: aws.String("main")},
+			o:    codecommit.RepositoryMetadata{RepositoryDescription: aws.String("d"), DefaultBranch: aws.String("main")},
+			want: true,
+		},
+		"EmptyRepository": {
+			p:    v1alpha1.RepositoryParameters{DefaultBranch: aws.String("main")},
+			o:    codecommit.RepositoryMetadata{},
+			want: true,
+		},
+		"DefaultBranchChanged": {
+			p: v1alpha1.RepositoryParameters{DefaultBranch: aws.String("main")},
+			o: codecommit.RepositoryMetadata{DefaultBranch: aws.String("master")},
+		},
+		"DescriptionChanged": {
+			p: v1alpha1.RepositoryParameters{Description: aws.String("new")},
+			o: codecommit.RepositoryMetadata{RepositoryDescription: aws.String("old")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsRepositoryUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAreTriggersUpToDate(t *testing.T) {
+	triggers := []v1alpha1.RepositoryTrigger{
+		{Name: "all", DestinationARN: aws.String(topicARN), Events: []string{"all"}},
+		{Name: "main", DestinationARN: aws.String(topicARN), Events: []string{"updateReference"}, Branches: []string{"main"}},
+	}
+	cases := map[string]struct {
+		triggers []v1alpha1.RepositoryTrigger
+		observed []codecommit.RepositoryTrigger
+		want     bool
+	}{
+		"UpToDateInOtherOrder": {
+			triggers: triggers,
+			observed: []codecommit.RepositoryTrigger{
+				{Name: aws.String("main"), DestinationArn: aws.String(topicARN), Events: []codecommit.RepositoryTriggerEventEnum{"updateReference"}, Branches: []string{"main"}},
+				{Name: aws.String("all"), DestinationArn: aws.String(topicARN), Events: []codecommit.RepositoryTriggerEventEnum{"all"}},
+			},
+			want: true,
+		},
+		"NoTriggers": {
+			want: true,
+		},
+		"EventsChanged": {
+			triggers: triggers[:1],
+			observed: []codecommit.RepositoryTrigger{
+				{Name: aws.String("all"), DestinationArn: aws.String(topicARN), Events: []codecommit.RepositoryTriggerEventEnum{"createReference"}},
+			},
+		},
+		"TriggerRemoved": {
+			triggers: triggers[:1],
+			observed: GenerateTriggers(triggers),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := AreTriggersUpToDate(tc.triggers, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffApprovalRuleTemplates(t *testing.T) {
+	type want struct {
+		associate    []string
+		disassociate []string
+	}
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		want     want
+	}{
+		"Same": {
+			desired:  []string{"a", "b"},
+			observed: []string{"b", "a"},
+		},
+		"AssociateAndDisassociate": {
+			desired:  []string{"a", "c"},
+			observed: []string{"a", "b"},
+			want: want{
+				associate:    []string{"c"},
+				disassociate: []string{"b"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			associate, disassociate := DiffApprovalRuleTemplates(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.associate, associate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disassociate, disassociate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/codepipeline"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.PipelineClient = (*MockPipelineClient)(nil)
+
+// MockPipelineClient is a type that implements all the methods for PipelineClient interface
+type MockPipelineClient struct {
+	MockGetPipeline                     func(*codepipeline.GetPipelineInput) codepipeline.GetPipelineRequest
+	MockCreatePipeline                  func(*codepipeline.CreatePipelineInput) codepipeline.CreatePipelineRequest
+	MockUpdatePipeline                  func(*codepipeline.UpdatePipelineInput) codepipeline.UpdatePipelineRequest
+	MockDeletePipeline                  func(*codepipeline.DeletePipelineInput) codepipeline.DeletePipelineRequest
+	MockListTagsForResource             func(*codepipeline.ListTagsForResourceInput) codepipeline.ListTagsForResourceRequest
+	MockTagResource                     func(*codepipeline.TagResourceInput) codepipeline.TagResourceRequest
+	MockUntagResource                   func(*codepipeline.UntagResourceInput) codepipeline.UntagResourceRequest
+	MockListWebhooks                    func(*codepipeline.ListWebhooksInput) codepipeline.ListWebhooksRequest
+	MockPutWebhook                      func(*codepipeline.PutWebhookInput) codepipeline.PutWebhookRequest
+	MockDeleteWebhook                   func(*codepipeline.DeleteWebhookInput) codepipeline.DeleteWebhookRequest
+	MockRegisterWebhookWithThirdParty   func(*codepipeline.RegisterWebhookWithThirdPartyInput) codepipeline.RegisterWebhookWithThirdPartyRequest
+	MockDeregisterWebhookWithThirdParty func(*codepipeline.DeregisterWebhookWithThirdPartyInput) codepipeline.DeregisterWebhookWithThirdPartyRequest
+}
+
+// GetPipelineRequest mocks GetPipelineRequest method
+func (m *MockPipelineClient) GetPipelineRequest(input *codepipeline.GetPipelineInput) codepipeline.GetPipelineRequest {
+	return m.MockGetPipeline(input)
+}
+
+// CreatePipelineRequest mocks CreatePipelineRequest method
+func (m *MockPipelineClient) CreatePipelineRequest(input *codepipeline.CreatePipelineInput) codepipeline.CreatePipelineRequest {
+	return m.MockCreatePipeline(input)
+}
+
+// UpdatePipelineRequest mocks UpdatePipelineRequest method
+func (m *MockPipelineClient) UpdatePipelineRequest(input *codepipeline.UpdatePipelineInput) codepipeline.UpdatePipelineRequest {
+	return m.MockUpdatePipeline(input)
+}
+
+// DeletePipelineRequest mocks DeletePipelineRequest method
+func (m *MockPipelineClient) DeletePipelineRequest(input *codepipeline.DeletePipelineInput) codepipeline.DeletePipelineRequest {
+	return m.MockDeletePipeline(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockPipelineClient) ListTagsForResourceRequest(input *codepipeline.ListTagsForResourceInput) codepipeline.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockPipelineClient) TagResourceRequest(input *codepipeline.TagResourceInput) codepipeline.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockPipelineClient) UntagResourceRequest(input *codepipeline.UntagResourceInput) codepipeline.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
+
+// ListWebhooksRequest mocks ListWebhooksRequest method
+func (m *MockPipelineClient) ListWebhooksRequest(input *codepipeline.ListWebhooksInput) codepipeline.ListWebhooksRequest {
+	return m.MockListWebhooks(input)
+}
+
+// PutWebhookRequest mocks PutWebhookRequest method
+func (m *MockPipelineClient) PutWebhookRequest(input *codepipeline.PutWebhookInput) codepipeline.PutWebhookRequest {
+	return m.MockPutWebhook(input)
+}
+
+// DeleteWebhookRequest mocks DeleteWebhookRequest method
+func (m *MockPipelineClient) DeleteWebhookRequest(input *codepipeline.DeleteWebhookInput) codepipeline.DeleteWebhookRequest {
+	return m.MockDeleteWebhook(input)
+}
+
+// RegisterWebhookWithThirdPartyRequest mocks RegisterWebhookWithThirdPartyRequest method
+func (m *MockPipelineClient) RegisterWebhookWithThirdPartyRequest(input *codepipeline.RegisterWebhookWithThirdPartyInput) codepipeline.RegisterWebhookWithThirdPartyRequest {
+	return m.MockRegisterWebhookWithThirdParty(input)
+}
+
+// DeregisterWebhookWithThirdPartyRequest mocks DeregisterWebhookWithThirdPartyRequest method
+func (m *MockPipelineClient) DeregisterWebhookWithThirdPartyRequest(input *codepipeline.DeregisterWebhookWithThirdPartyInput) codepipeline.DeregisterWebhookWithThirdPartyRequest {
+	return m.MockDeregisterWebhookWithThirdParty(input)
+}
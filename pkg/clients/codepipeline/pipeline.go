@@ -0,0 +1,305 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codepipeline
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/codepipeline/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetSecretToken = "cannot get the secret token of the webhook"
+
+	// ConnectionDetailsWebhookURL is the key of the URL of the webhook in
+	// the connection secret of a Pipeline.
+	ConnectionDetailsWebhookURL = "webhookURL"
+
+	// maskedValue is the value that CodePipeline returns instead of the
+	// secret values of an action configuration.
+	maskedValue = "****"
+)
+
+// PipelineClient is the external client used for Pipeline Custom Resource
+type PipelineClient interface {
+	GetPipelineRequest(*codepipeline.GetPipelineInput) codepipeline.GetPipelineRequest
+	CreatePipelineRequest(*codepipeline.CreatePipelineInput) codepipeline.CreatePipelineRequest
+	UpdatePipelineRequest(*codepipeline.UpdatePipelineInput) codepipeline.UpdatePipelineRequest
+	DeletePipelineRequest(*codepipeline.DeletePipelineInput) codepipeline.DeletePipelineRequest
+	ListTagsForResourceRequest(*codepipeline.ListTagsForResourceInput) codepipeline.ListTagsForResourceRequest
+	TagResourceRequest(*codepipeline.TagResourceInput) codepipeline.TagResourceRequest
+	UntagResourceRequest(*codepipeline.UntagResourceInput) codepipeline.UntagResourceRequest
+	ListWebhooksRequest(*codepipeline.ListWebhooksInput) codepipeline.ListWebhooksRequest
+	PutWebhookRequest(*codepipeline.PutWebhookInput) codepipeline.PutWebhookRequest
+	DeleteWebhookRequest(*codepipeline.DeleteWebhookInput) codepipeline.DeleteWebhookRequest
+	RegisterWebhookWithThirdPartyRequest(*codepipeline.RegisterWebhookWithThirdPartyInput) codepipeline.RegisterWebhookWithThirdPartyRequest
+	DeregisterWebhookWithThirdPartyRequest(*codepipeline.DeregisterWebhookWithThirdPartyInput) codepipeline.DeregisterWebhookWithThirdPartyRequest
+}
+
+// NewPipelineClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewPipelineClient(cfg aws.Config) PipelineClient {
+	return codepipeline.New(cfg)
+}
+
+// IsPipelineNotFound returns true if the error is because the pipeline
+// doesn't exist.
+func IsPipelineNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == codepipeline.ErrCodePipelineNotFoundException
+}
+
+// IsWebhookNotFound returns true if the error is because the webhook doesn't
+// exist.
+func IsWebhookNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == codepipeline.ErrCodeWebhookNotFoundException
+}
+
+// GeneratePipelineDeclaration returns the declaration of the pipeline with
+// the given name.
+func GeneratePipelineDeclaration(name string, p v1alpha1.PipelineParameters) *codepipeline.PipelineDeclaration {
+	d := &codepipeline.PipelineDeclaration{
+		Name:    aws.String(name),
+		RoleArn: p.RoleARN,
+		ArtifactStore: &codepipeline.ArtifactStore{
+			Type:     codepipeline.ArtifactStoreTypeS3,
+			Location: p.ArtifactStore.Location,
+		},
+	}
+	if k := p.ArtifactStore.EncryptionKey; k != nil {
+		d.ArtifactStore.EncryptionKey = &codepipeline.EncryptionKey{Id: aws.String(k.ID), Type: codepipeline.EncryptionKeyType(k.Type)}
+	}
+	for _, s := range p.Stages {
+		stage := codepipeline.StageDeclaration{Name: aws.String(s.Name)}
+		for _, a := range s.Actions {
+			action := codepipeline.ActionDeclaration{
+				Name: aws.String(a.Name),
+				ActionTypeId: &codepipeline.ActionTypeId{
+					Category: codepipeline.ActionCategory(a.ActionTypeID.Category),
+					Owner:    codepipeline.ActionOwner(a.ActionTypeID.Owner),
+					Provider: aws.String(a.ActionTypeID.Provider),
+					Version:  aws.String(a.ActionTypeID.Version),
+				},
+				Configuration: a.Configuration,
+				Namespace:     a.Namespace,
+				Region:        a.Region,
+				RoleArn:       a.RoleARN,
+				RunOrder:      a.RunOrder,
+			}
+			for _, in := range a.InputArtifacts {
+				action.InputArtifacts = append(action.InputArtifacts, codepipeline.InputArtifact{Name: aws.String(in)})
+			}
+			for _, out := range a.OutputArtifacts {
+				action.OutputArtifacts = append(action.OutputArtifacts, codepipeline.OutputArtifact{Name: aws.String(out)})
+			}
+			stage.Actions = append(stage.Actions, action)
+		}
+		d.Stages = append(d.Stages, stage)
+	}
+	return d
+}
+
+// GenerateCreatePipelineInput returns the create input of the pipeline with
+// the given name.
+func GenerateCreatePipelineInput(name string, p v1alpha1.PipelineParameters) *codepipeline.CreatePipelineInput {
+	return &codepipeline.CreatePipelineInput{
+		Pipeline: GeneratePipelineDeclaration(name, p),
+		Tags:     GenerateTags(p.Tags),
+	}
+}
+
+// GeneratePipelineObservation returns the observation of the given pipeline.
+func GeneratePipelineObservation(o codepipeline.GetPipelineOutput) v1alpha1.PipelineObservation {
+	obs := v1alpha1.PipelineObservation{}
+	if o.Metadata != nil {
+		obs.ARN = aws.StringValue(o.Metadata.PipelineArn)
+	}
+	if o.Pipeline != nil {
+		obs.Version = aws.Int64Value(o.Pipeline.Version)
+	}
+	return obs
+}
+
+// LateInitializePipeline fills the empty fields of the given parameters with
+// the defaults that CodePipeline assigned to the pipeline.
+func LateInitializePipeline(in *v1alpha1.PipelineParameters, o *codepipeline.PipelineDeclaration) {
+	if o == nil || len(in.Stages) != len(o.Stages) {
+		return
+	}
+	for i := range in.Stages {
+		s, os := &in.Stages[i], o.Stages[i]
+		if s.Name != aws.StringValue(os.Name) || len(s.Actions) != len(os.Actions) {
+			continue
+		}
+		for j := range s.Actions {
+			a, oa := &s.Actions[j], os.Actions[j]
+			if a.Name == aws.StringValue(oa.Name) {
+				a.RunOrder = awsclients.LateInitializeInt64Ptr(a.RunOrder, oa.RunOrder)
+			}
+		}
+	}
+}
+
+// IsPipelineUpToDate returns whether the observed pipeline is up to date with
+// the given parameters. Masked configuration values of the observed actions
+// are considered to be equal to their desired value.
+func IsPipelineUpToDate(p v1alpha1.PipelineParameters, o codepipeline.PipelineDeclaration) bool {
+	desired := GeneratePipelineDeclaration(aws.StringValue(o.Name), p)
+	desired.Version = o.Version
+	observed := o
+	observed.Stages = make([]codepipeline.StageDeclaration, len(o.Stages))
+	for i, s := range o.Stages {
+		observed.Stages[i] = s
+		observed.Stages[i].Actions = make([]codepipeline.ActionDeclaration, len(s.Actions))
+		for j, a := range s.Actions {
+			observed.Stages[i].Actions[j] = a
+			observed.Stages[i].Actions[j].Configuration = unmaskConfiguration(a.Configuration, desiredConfiguration(desired, i, j))
+		}
+	}
+	return cmp.Equal(*desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(codepipeline.PipelineDeclaration{}, codepipeline.ArtifactStore{}, codepipeline.EncryptionKey{},
+			codepipeline.StageDeclaration{}, codepipeline.ActionDeclaration{}, codepipeline.ActionTypeId{},
+			codepipeline.InputArtifact{}, codepipeline.OutputArtifact{}, codepipeline.BlockerDeclaration{}))
+}
+
+// GenerateTags returns the CodePipeline tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) []codepipeline.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]codepipeline.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = codepipeline.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from a pipeline.
+func DiffTags(desired []v1alpha1.Tag, observed []codepipeline.Tag) (add []codepipeline.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, codepipeline.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
+}
+
+// GetWebhookSecretToken returns the secret token of the given webhook, or an
+// empty string if it has none.
+func GetWebhookSecretToken(ctx context.Context, kube client.Client, w v1alpha1.Webhook) (string, error) {
+	ref := w.SecretTokenSecretRef
+	if ref == nil {
+		return "", nil
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(err, errGetSecretToken)
+	}
+	return string(s.Data[ref.Key]), nil
+}
+
+// GeneratePutWebhookInput returns the put input of the webhook with the given
+// name that triggers the given pipeline.
+func GeneratePutWebhookInput(name string, w v1alpha1.Webhook, secretToken string) *codepipeline.PutWebhookInput {
+	return &codepipeline.PutWebhookInput{
+		Webhook: &codepipeline.WebhookDefinition{
+			Name:           aws.String(name),
+			TargetPipeline: aws.String(name),
+			TargetAction:   aws.String(w.TargetAction),
+			Authentication: codepipeline.WebhookAuthenticationType(w.Authentication),
+			AuthenticationConfiguration: &codepipeline.WebhookAuthConfiguration{
+				AllowedIPRange: w.AllowedIPRange,
+				SecretToken:    awsclients.String(secretToken),
+			},
+			Filters: generateWebhookFilters(w.Filters),
+		},
+	}
+}
+
+// IsWebhookUpToDate returns whether the observed webhook definition is up to
+// date with the given webhook. Changes of the secret token are not detected.
+func IsWebhookUpToDate(w v1alpha1.Webhook, o codepipeline.WebhookDefinition) bool {
+	if w.TargetAction != aws.StringValue(o.TargetAction) || w.Authentication != string(o.Authentication) {
+		return false
+	}
+	if o.AuthenticationConfiguration != nil && aws.StringValue(w.AllowedIPRange) != aws.StringValue(o.AuthenticationConfiguration.AllowedIPRange) {
+		return false
+	}
+	return cmp.Equal(generateWebhookFilters(w.Filters), o.Filters, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(codepipeline.WebhookFilterRule{}))
+}
+
+// GetWebhookConnectionDetails returns the connection details of the given
+// webhook.
+func GetWebhookConnectionDetails(o codepipeline.ListWebhookItem) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		ConnectionDetailsWebhookURL: []byte(aws.StringValue(o.Url)),
+	}
+}
+
+func generateWebhookFilters(filters []v1alpha1.WebhookFilterRule) []codepipeline.WebhookFilterRule {
+	res := make([]codepipeline.WebhookFilterRule, len(filters))
+	for i, f := range filters {
+		res[i] = codepipeline.WebhookFilterRule{JsonPath: aws.String(f.JSONPath), MatchEquals: f.MatchEquals}
+	}
+	return res
+}
+
+func desiredConfiguration(d *codepipeline.PipelineDeclaration, stage, action int) map[string]string {
+	if stage >= len(d.Stages) || action >= len(d.Stages[stage].Actions) {
+		return nil
+	}
+	return d.Stages[stage].Actions[action].Configuration
+}
+
+// unmaskConfiguration returns the observed configuration with its masked
+// values replaced by the desired ones.
+func unmaskConfiguration(observed, desired map[string]string) map[string]string {
+	if observed == nil {
+		return nil
+	}
+	res := make(map[string]string, len(observed))
+	for k, v := range observed {
+		if d, ok := desired[k]; ok && v == maskedValue {
+			v = d
+		}
+		res[k] = v
+	}
+	return res
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codepipeline
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/codepipeline/v1alpha1"
+)
+
+var (
+	pipelineName = "sample-pipeline"
+	roleARN      = "arn:aws:iam::123456789012:role/codepipeline"
+	bucket       = "sample-artifacts"
+)
+
+func params(m ...func(*v1alpha1.PipelineParameters)) v1alpha1.PipelineParameters {
+	p := v1alpha1.PipelineParameters{
+		Region:        "us-east-1",
+		RoleARN:       aws.String(roleARN),
+		ArtifactStore: v1alpha1.ArtifactStore{Location: aws.String(bucket)},
+		Stages: []v1alpha1.Stage{
+			{
+				Name: "Source",
+				Actions: []v1alpha1.Action{{
+					Name:            "Source",
+					ActionTypeID:    v1alpha1.ActionTypeID{Category: "Source", Owner: "ThirdParty", Provider: "GitHub", Version: "1"},
+					Configuration:   map[string]string{"Owner": "crossplane", "Repo": "provider-aws", "Branch": "master", "OAuthToken": "token"},
+					OutputArtifacts: []string{"source"},
+				}},
+			},
+			{
+				Name: "Build",
+				Actions: []v1alpha1.Action{{
+					Name:           "Build",
+					ActionTypeID:   v1alpha1.ActionTypeID{Category: "Build", Owner: "AWS", Provider: "CodeBuild", Version: "1"},
+					Configuration:  map[string]string{"ProjectName": "sample-project"},
+					InputArtifacts: []string{"source"},
+				}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func declaration(m ...func(*codepipeline.PipelineDeclaration)) codepipeline.PipelineDeclaration {
+	d := *GeneratePipelineDeclaration(pipelineName, params())
+	d.Version = aws.Int64(1)
+	for _, s := range d.Stages {
+		for j := range s.Actions {
+			s.Actions[j].RunOrder = aws.Int64(1)
+		}
+	}
+	d.Stages[0].Actions[0].Configuration = map[string]string{"Owner": "crossplane", "Repo": "provider-aws", "Branch": "master", "OAuthToken": maskedValue}
+	for _, f := range m {
+		f(&d)
+	}
+	return d
+}
+
+func TestLateInitializePipeline(t *testing.T) {
+	observed := declaration()
+	cases := map[string]struct {
+		in       v1alpha1.PipelineParameters
+		observed *codepipeline.PipelineDeclaration
+		want     v1alpha1.PipelineParameters
+	}{
+		"RunOrder": {
+			in:       params(),
+			observed: &observed,
+			want: params(func(p *v1alpha1.PipelineParameters) {
+				p.Stages[0].Actions[0].RunOrder = aws.Int64(1)
+				p.Stages[1].Actions[0].RunOrder = aws.Int64(1)
+			}),
+		},
+		"StagesChanged": {
+			in: params(func(p *v1alpha1.PipelineParameters) {
+				p.Stages = p.Stages[:1]
+			}),
+			observed: &observed,
+			want: params(func(p *v1alpha1.PipelineParameters) {
+				p.Stages = p.Stages[:1]
+			}),
+		},
+		"NoObservation": {
+			in:   params(),
+			want: params(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializePipeline(&tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPipelineUpToDate(t *testing.T) {
+	runOrder := func(p *v1alpha1.PipelineParameters) {
+		p.Stages[0].Actions[0].RunOrder = aws.Int64(1)
+		p.Stages[1].Actions[0].RunOrder = aws.Int64(1)
+	}
+	cases := map[string]struct {
+		p    v1alpha1.PipelineParameters
+		o    codepipeline.PipelineDeclaration
+		want bool
+	}{
+		"UpToDateWithMaskedValue": {
+			p:    params(runOrder),
+			o:    declaration(),
+			want: true,
+		},
+		"ConfigurationChanged": {
+			p: params(runOrder, func(p *v1alpha1.PipelineParameters) {
+				p.Stages[0].Actions[0].Configuration["Branch"] = "main"
+			}),
+			o: declaration(),
+		},
+		"ArtifactStoreChanged": {
+			p: params(runOrder, func(p *v1alpha1.PipelineParameters) {
+				p.ArtifactStore.Location = aws.String("other-artifacts")
+			}),
+			o: declaration(),
+		},
+		"StageRemoved": {
+			p: params(runOrder),
+			o: declaration(func(d *codepipeline.PipelineDeclaration) {
+				d.Stages = d.Stages[:1]
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsPipelineUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []codepipeline.Tag
+		remove []string
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []codepipeline.Tag
+		want     want
+	}{
+		"Same": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			observed: []codepipeline.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			want: want{
+				remove: []string{},
+			},
+		},
+		"AddAndRemove": {
+			desired: []v1alpha1.Tag{{Key: "k", Value: "new"}},
+			observed: []codepipeline.Tag{
+				{Key: aws.String("k"), Value: aws.String("old")},
+				{Key: aws.String("stale"), Value: aws.String("v")},
+			},
+			want: want{
+				add:    []codepipeline.Tag{{Key: aws.String("k"), Value: aws.String("new")}},
+				remove: []string{"k", "stale"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			sort.Strings(remove)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsWebhookUpToDate(t *testing.T) {
+	webhook := v1alpha1.Webhook{
+		TargetAction:   "Source",
+		Authentication: "GITHUB_HMAC",
+		Filters:        []v1alpha1.WebhookFilterRule{{JSONPath: "$.ref", MatchEquals: aws.String("refs/heads/{Branch}")}},
+	}
+	cases := map[string]struct {
+		w    v1alpha1.Webhook
+		o    codepipeline.WebhookDefinition
+		want bool
+	}{
+		"UpToDate": {
+			w:    webhook,
+			o:    *GeneratePutWebhookInput(pipelineName, webhook, "secret").Webhook,
+			want: true,
+		},
+		"FiltersChanged": {
+			w: webhook,
+			o: func() codepipeline.WebhookDefinition {
+				d := *GeneratePutWebhookInput(pipelineName, webhook, "").Webhook
+				d.Filters = nil
+				return d
+			}(),
+		},
+		"AuthenticationChanged": {
+			w: webhook,
+			o: func() codepipeline.WebhookDefinition {
+				d := *GeneratePutWebhookInput(pipelineName, webhook, "").Webhook
+				d.Authentication = codepipeline.WebhookAuthenticationTypeUnauthenticated
+				return d
+			}(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsWebhookUpToDate(tc.w, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
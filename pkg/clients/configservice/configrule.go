@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configservice
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ConfigRuleClient is the external client used for ConfigRule Custom
+// Resource
+type ConfigRuleClient interface {
+	DescribeConfigRulesRequest(*configservice.DescribeConfigRulesInput) configservice.DescribeConfigRulesRequest
+	PutConfigRuleRequest(*configservice.PutConfigRuleInput) configservice.PutConfigRuleRequest
+	DeleteConfigRuleRequest(*configservice.DeleteConfigRuleInput) configservice.DeleteConfigRuleRequest
+}
+
+// NewConfigRuleClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewConfigRuleClient(cfg aws.Config) ConfigRuleClient {
+	return configservice.New(cfg)
+}
+
+// IsConfigRuleNotFound returns true if the error is because the rule doesn't
+// exist
+func IsConfigRuleNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == configservice.ErrCodeNoSuchConfigRuleException {
+		return true
+	}
+	return false
+}
+
+// GeneratePutConfigRuleInput returns a put input from the given parameters.
+// Tags are only set on creation.
+func GeneratePutConfigRuleInput(name string, p v1alpha1.ConfigRuleParameters, withTags bool) *configservice.PutConfigRuleInput {
+	r := &configservice.ConfigRule{
+		ConfigRuleName:            aws.String(name),
+		Description:               p.Description,
+		InputParameters:           p.InputParameters,
+		MaximumExecutionFrequency: configservice.MaximumExecutionFrequency(aws.StringValue(p.MaximumExecutionFrequency)),
+		Source: &configservice.Source{
+			Owner:            configservice.Owner(p.Source.Owner),
+			SourceIdentifier: aws.String(p.Source.SourceIdentifier),
+		},
+	}
+	for _, d := range p.Source.SourceDetails {
+		r.Source.SourceDetails = append(r.Source.SourceDetails, configservice.SourceDetail{
+			EventSource:               configservice.EventSource(aws.StringValue(d.EventSource)),
+			MessageType:               configservice.MessageType(aws.StringValue(d.MessageType)),
+			MaximumExecutionFrequency: configservice.MaximumExecutionFrequency(aws.StringValue(d.MaximumExecutionFrequency)),
+		})
+	}
+	if p.Scope != nil {
+		r.Scope = &configservice.Scope{
+			ComplianceResourceId:    p.Scope.ComplianceResourceID,
+			ComplianceResourceTypes: p.Scope.ComplianceResourceTypes,
+			TagKey:                  p.Scope.TagKey,
+			TagValue:                p.Scope.TagValue,
+		}
+	}
+	input := &configservice.PutConfigRuleInput{ConfigRule: r}
+	if withTags {
+		for _, t := range p.Tags {
+			input.Tags = append(input.Tags, configservice.Tag{Key: aws.String(t.Key), Value: t.Value})
+		}
+	}
+	return input
+}
+
+// GenerateConfigRuleObservation is used to produce
+// v1alpha1.ConfigRuleObservation from configservice.ConfigRule.
+func GenerateConfigRuleObservation(r configservice.ConfigRule) v1alpha1.ConfigRuleObservation {
+	return v1alpha1.ConfigRuleObservation{
+		ConfigRuleARN:   aws.StringValue(r.ConfigRuleArn),
+		ConfigRuleID:    aws.StringValue(r.ConfigRuleId),
+		ConfigRuleState: string(r.ConfigRuleState),
+	}
+}
+
+// LateInitializeConfigRule fills the empty fields in
+// *v1alpha1.ConfigRuleParameters with the values seen in
+// configservice.ConfigRule.
+func LateInitializeConfigRule(in *v1alpha1.ConfigRuleParameters, r *configservice.ConfigRule) {
+	if r == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, r.Description)
+	if r.MaximumExecutionFrequency != "" {
+		in.MaximumExecutionFrequency = awsclients.LateInitializeStringPtr(in.MaximumExecutionFrequency,
+			aws.String(string(r.MaximumExecutionFrequency)))
+	}
+}
+
+// IsConfigRuleUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsConfigRuleUpToDate(p v1alpha1.ConfigRuleParameters, r configservice.ConfigRule) bool {
+	switch {
+	case aws.StringValue(p.Description) != aws.StringValue(r.Description),
+		aws.StringValue(p.InputParameters) != aws.StringValue(r.InputParameters),
+		aws.StringValue(p.MaximumExecutionFrequency) != string(r.MaximumExecutionFrequency):
+		return false
+	}
+	if r.Source == nil || !cmp.Equal(p.Source, buildSource(*r.Source), cmpopts.EquateEmpty()) {
+		return false
+	}
+	// AWS managed rules may come with a default scope; it is only managed
+	// if configured explicitly.
+	if p.Scope == nil {
+		return true
+	}
+	return r.Scope != nil && cmp.Equal(*p.Scope, buildScope(*r.Scope), cmpopts.EquateEmpty())
+}
+
+func buildSource(s configservice.Source) v1alpha1.Source {
+	res := v1alpha1.Source{
+		Owner:            string(s.Owner),
+		SourceIdentifier: aws.StringValue(s.SourceIdentifier),
+	}
+	for _, d := range s.SourceDetails {
+		res.SourceDetails = append(res.SourceDetails, v1alpha1.SourceDetail{
+			EventSource:               awsclients.String(string(d.EventSource)),
+			MessageType:               awsclients.String(string(d.MessageType)),
+			MaximumExecutionFrequency: awsclients.String(string(d.MaximumExecutionFrequency)),
+		})
+	}
+	return res
+}
+
+func buildScope(s configservice.Scope) v1alpha1.Scope {
+	return v1alpha1.Scope{
+		ComplianceResourceID:    s.ComplianceResourceId,
+		ComplianceResourceTypes: s.ComplianceResourceTypes,
+		TagKey:                  s.TagKey,
+		TagValue:                s.TagValue,
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configservice
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+)
+
+var (
+	ruleName   = "some-rule"
+	lambdaARN  = "arn:aws:lambda:us-east-1:123456789012:function:some-function"
+	resType    = "AWS::S3::Bucket"
+	tagKey     = "some-key"
+	tagValue   = "some-value"
+	parameters = `{"key":"value"}`
+)
+
+func customParameters() v1alpha1.ConfigRuleParameters {
+	return v1alpha1.ConfigRuleParameters{
+		InputParameters: aws.String(parameters),
+		Scope: &v1alpha1.Scope{
+			ComplianceResourceTypes: []string{resType},
+		},
+		Source: v1alpha1.Source{
+			Owner:            "CUSTOM_LAMBDA",
+			SourceIdentifier: lambdaARN,
+			SourceDetails: []v1alpha1.SourceDetail{{
+				EventSource: aws.String("aws.config"),
+				MessageType: aws.String("ConfigurationItemChangeNotification"),
+			}},
+		},
+		Tags: []v1alpha1.Tag{{Key: tagKey, Value: aws.String(tagValue)}},
+	}
+}
+
+func customRule() configservice.ConfigRule {
+	return configservice.ConfigRule{
+		ConfigRuleName:  aws.String(ruleName),
+		InputParameters: aws.String(parameters),
+		Scope: &configservice.Scope{
+			ComplianceResourceTypes: []string{resType},
+		},
+		Source: &configservice.Source{
+			Owner:            configservice.OwnerCustomLambda,
+			SourceIdentifier: aws.String(lambdaARN),
+			SourceDetails: []configservice.SourceDetail{{
+				EventSource: configservice.EventSourceAwsConfig,
+				MessageType: configservice.MessageTypeConfigurationItemChangeNotification,
+			}},
+		},
+	}
+}
+
+func TestGeneratePutConfigRuleInput(t *testing.T) {
+	type args struct {
+		p        v1alpha1.ConfigRuleParameters
+		withTags bool
+	}
+	cases := map[string]struct {
+		args args
+		want *configservice.PutConfigRuleInput
+	}{
+		"Create": {
+			args: args{p: customParameters(), withTags: true},
+			want: &configservice.PutConfigRuleInput{
+				ConfigRule: func() *configservice.ConfigRule { r := customRule(); return &r }(),
+				Tags:       []configservice.Tag{{Key: aws.String(tagKey), Value: aws.String(tagValue)}},
+			},
+		},
+		"Update": {
+			args: args{p: customParameters()},
+			want: &configservice.PutConfigRuleInput{
+				ConfigRule: func() *configservice.ConfigRule { r := customRule(); return &r }(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePutConfigRuleInput(ruleName, tc.args.p, tc.args.withTags)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsConfigRuleUpToDate(t *testing.T) {
+	type args struct {
+		p v1alpha1.ConfigRuleParameters
+		r configservice.ConfigRule
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{p: customParameters(), r: customRule()},
+			want: true,
+		},
+		"DifferentParameters": {
+			args: args{
+				p: func() v1alpha1.ConfigRuleParameters {
+					p := customParameters()
+					p.InputParameters = aws.String(`{}`)
+					return p
+				}(),
+				r: customRule(),
+			},
+			want: false,
+		},
+		"DifferentSourceDetails": {
+			args: args{
+				p: func() v1alpha1.ConfigRuleParameters {
+					p := customParameters()
+					p.Source.SourceDetails[0].MessageType = aws.String("ScheduledNotification")
+					return p
+				}(),
+				r: customRule(),
+			},
+			want: false,
+		},
+		"UnmanagedScope": {
+			args: args{
+				p: func() v1alpha1.ConfigRuleParameters {
+					p := customParameters()
+					p.Scope = nil
+					return p
+				}(),
+				r: customRule(),
+			},
+			want: true,
+		},
+		"DifferentScope": {
+			args: args{
+				p: func() v1alpha1.ConfigRuleParameters {
+					p := customParameters()
+					p.Scope.TagKey = aws.String(tagKey)
+					return p
+				}(),
+				r: customRule(),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsConfigRuleUpToDate(tc.args.p, tc.args.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configservice
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ConfigurationRecorderClient is the external client used for
+// ConfigurationRecorder Custom Resource
+type ConfigurationRecorderClient interface {
+	DescribeConfigurationRecordersRequest(*configservice.DescribeConfigurationRecordersInput) configservice.DescribeConfigurationRecordersRequest
+	DescribeConfigurationRecorderStatusRequest(*configservice.DescribeConfigurationRecorderStatusInput) configservice.DescribeConfigurationRecorderStatusRequest
+	PutConfigurationRecorderRequest(*configservice.PutConfigurationRecorderInput) configservice.PutConfigurationRecorderRequest
+	StartConfigurationRecorderRequest(*configservice.StartConfigurationRecorderInput) configservice.StartConfigurationRecorderRequest
+	StopConfigurationRecorderRequest(*configservice.StopConfigurationRecorderInput) configservice.StopConfigurationRecorderRequest
+	DeleteConfigurationRecorderRequest(*configservice.DeleteConfigurationRecorderInput) configservice.DeleteConfigurationRecorderRequest
+}
+
+// NewConfigurationRecorderClient returns a new client using AWS credentials
+// as JSON encoded data.
+func NewConfigurationRecorderClient(cfg aws.Config) ConfigurationRecorderClient {
+	return configservice.New(cfg)
+}
+
+// IsConfigurationRecorderNotFound returns true if the error is because the
+// configuration recorder doesn't exist
+func IsConfigurationRecorderNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == configservice.ErrCodeNoSuchConfigurationRecorderException {
+		return true
+	}
+	return false
+}
+
+// GeneratePutConfigurationRecorderInput returns a put input from the given
+// parameters.
+func GeneratePutConfigurationRecorderInput(name string, p v1alpha1.ConfigurationRecorderParameters) *configservice.PutConfigurationRecorderInput {
+	r := &configservice.ConfigurationRecorder{
+		Name:    aws.String(name),
+		RoleARN: p.RoleARN,
+	}
+	if p.RecordingGroup != nil {
+		r.RecordingGroup = &configservice.RecordingGroup{
+			AllSupported:               p.RecordingGroup.AllSupported,
+			IncludeGlobalResourceTypes: p.RecordingGroup.IncludeGlobalResourceTypes,
+		}
+		for _, t := range p.RecordingGroup.ResourceTypes {
+			r.RecordingGroup.ResourceTypes = append(r.RecordingGroup.ResourceTypes, configservice.ResourceType(t))
+		}
+	}
+	return &configservice.PutConfigurationRecorderInput{ConfigurationRecorder: r}
+}
+
+// GenerateConfigurationRecorderObservation is used to produce
+// v1alpha1.ConfigurationRecorderObservation from the status of a
+// configuration recorder.
+func GenerateConfigurationRecorderObservation(s configservice.ConfigurationRecorderStatus) v1alpha1.ConfigurationRecorderObservation {
+	return v1alpha1.ConfigurationRecorderObservation{
+		Recording:        aws.BoolValue(s.Recording),
+		LastStatus:       string(s.LastStatus),
+		LastErrorCode:    aws.StringValue(s.LastErrorCode),
+		LastErrorMessage: aws.StringValue(s.LastErrorMessage),
+	}
+}
+
+// LateInitializeConfigurationRecorder fills the empty fields in
+// *v1alpha1.ConfigurationRecorderParameters with the values seen in
+// configservice.ConfigurationRecorder.
+func LateInitializeConfigurationRecorder(in *v1alpha1.ConfigurationRecorderParameters, r *configservice.ConfigurationRecorder) {
+	if r == nil {
+		return
+	}
+	in.RoleARN = awsclients.LateInitializeStringPtr(in.RoleARN, r.RoleARN)
+	if in.RecordingGroup == nil && r.RecordingGroup != nil {
+		in.RecordingGroup = buildRecordingGroup(*r.RecordingGroup)
+	}
+}
+
+// IsConfigurationRecorderUpToDate checks whether there is a change in any of
+// the modifiable fields.
+func IsConfigurationRecorderUpToDate(p v1alpha1.ConfigurationRecorderParameters, r configservice.ConfigurationRecorder, recording bool) bool {
+	if aws.StringValue(p.RoleARN) != aws.StringValue(r.RoleARN) {
+		return false
+	}
+	if aws.BoolValue(awsclients.LateInitializeBoolPtr(p.Recording, aws.Bool(true))) != recording {
+		return false
+	}
+	if p.RecordingGroup == nil {
+		return true
+	}
+	var current *v1alpha1.RecordingGroup
+	if r.RecordingGroup != nil {
+		current = buildRecordingGroup(*r.RecordingGroup)
+	}
+	return cmp.Equal(p.RecordingGroup, current, cmpopts.EquateEmpty())
+}
+
+func buildRecordingGroup(g configservice.RecordingGroup) *v1alpha1.RecordingGroup {
+	res := &v1alpha1.RecordingGroup{
+		AllSupported:               g.AllSupported,
+		IncludeGlobalResourceTypes: g.IncludeGlobalResourceTypes,
+	}
+	for _, t := range g.ResourceTypes {
+		res.ResourceTypes = append(res.ResourceTypes, string(t))
+	}
+	return res
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configservice
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+)
+
+var (
+	recorderName = "default"
+	roleARN      = "some-arn"
+	instanceType = "AWS::EC2::Instance"
+)
+
+func TestGeneratePutConfigurationRecorderInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ConfigurationRecorderParameters
+		want *configservice.PutConfigurationRecorderInput
+	}{
+		"AllFields": {
+			p: v1alpha1.ConfigurationRecorderParameters{
+				RoleARN: aws.String(roleARN),
+				RecordingGroup: &v1alpha1.RecordingGroup{
+					AllSupported:  aws.Bool(false),
+					ResourceTypes: []string{instanceType},
+				},
+			},
+			want: &configservice.PutConfigurationRecorderInput{
+				ConfigurationRecorder: &configservice.ConfigurationRecorder{
+					Name:    aws.String(recorderName),
+					RoleARN: aws.String(roleARN),
+					RecordingGroup: &configservice.RecordingGroup{
+						AllSupported:  aws.Bool(false),
+						ResourceTypes: []configservice.ResourceType{configservice.ResourceTypeAwsEc2Instance},
+					},
+				},
+			},
+		},
+		"NoRecordingGroup": {
+			p: v1alpha1.ConfigurationRecorderParameters{
+				RoleARN: aws.String(roleARN),
+			},
+			want: &configservice.PutConfigurationRecorderInput{
+				ConfigurationRecorder: &configservice.ConfigurationRecorder{
+					Name:    aws.String(recorderName),
+					RoleARN: aws.String(roleARN),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePutConfigurationRecorderInput(recorderName, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsConfigurationRecorderUpToDate(t *testing.T) {
+	recorder := configservice.ConfigurationRecorder{
+		Name:    aws.String(recorderName),
+		RoleARN: aws.String(roleARN),
+		RecordingGroup: &configservice.RecordingGroup{
+			AllSupported:               aws.Bool(true),
+			IncludeGlobalResourceTypes: aws.Bool(false),
+		},
+	}
+	type args struct {
+		p         v1alpha1.ConfigurationRecorderParameters
+		recording bool
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: v1alpha1.ConfigurationRecorderParameters{
+					RoleARN: aws.String(roleARN),
+					RecordingGroup: &v1alpha1.RecordingGroup{
+						AllSupported:               aws.Bool(true),
+						IncludeGlobalResourceTypes: aws.Bool(false),
+					},
+				},
+				recording: true,
+			},
+			want: true,
+		},
+		"NotRecording": {
+			args: args{
+				p: v1alpha1.ConfigurationRecorderParameters{
+					RoleARN: aws.String(roleARN),
+				},
+			},
+			want: false,
+		},
+		"StoppedRecording": {
+			args: args{
+				p: v1alpha1.ConfigurationRecorderParameters{
+					RoleARN:   aws.String(roleARN),
+					Recording: aws.Bool(false),
+				},
+			},
+			want: true,
+		},
+		"DifferentRecordingGroup": {
+			args: args{
+				p: v1alpha1.ConfigurationRecorderParameters{
+					RoleARN: aws.String(roleARN),
+					RecordingGroup: &v1alpha1.RecordingGroup{
+						AllSupported:               aws.Bool(true),
+						IncludeGlobalResourceTypes: aws.Bool(true),
+					},
+				},
+				recording: true,
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsConfigurationRecorderUpToDate(tc.args.p, recorder, tc.args.recording)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configservice
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+
+	"github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// DeliveryChannelClient is the external client used for DeliveryChannel
+// Custom Resource
+type DeliveryChannelClient interface {
+	DescribeDeliveryChannelsRequest(*configservice.DescribeDeliveryChannelsInput) configservice.DescribeDeliveryChannelsRequest
+	PutDeliveryChannelRequest(*configservice.PutDeliveryChannelInput) configservice.PutDeliveryChannelRequest
+	DeleteDeliveryChannelRequest(*configservice.DeleteDeliveryChannelInput) configservice.DeleteDeliveryChannelRequest
+}
+
+// NewDeliveryChannelClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewDeliveryChannelClient(cfg aws.Config) DeliveryChannelClient {
+	return configservice.New(cfg)
+}
+
+// IsDeliveryChannelNotFound returns true if the error is because the
+// delivery channel doesn't exist
+func IsDeliveryChannelNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == configservice.ErrCodeNoSuchDeliveryChannelException {
+		return true
+	}
+	return false
+}
+
+// GeneratePutDeliveryChannelInput returns a put input from the given
+// parameters.
+func GeneratePutDeliveryChannelInput(name string, p v1alpha1.DeliveryChannelParameters) *configservice.PutDeliveryChannelInput {
+	c := &configservice.DeliveryChannel{
+		Name:         aws.String(name),
+		S3BucketName: p.S3BucketName,
+		S3KeyPrefix:  p.S3KeyPrefix,
+		SnsTopicARN:  p.SNSTopicARN,
+	}
+	if p.DeliveryFrequency != nil {
+		c.ConfigSnapshotDeliveryProperties = &configservice.ConfigSnapshotDeliveryProperties{
+			DeliveryFrequency: configservice.MaximumExecutionFrequency(aws.StringValue(p.DeliveryFrequency)),
+		}
+	}
+	return &configservice.PutDeliveryChannelInput{DeliveryChannel: c}
+}
+
+// LateInitializeDeliveryChannel fills the empty fields in
+// *v1alpha1.DeliveryChannelParameters with the values seen in
+// configservice.DeliveryChannel.
+func LateInitializeDeliveryChannel(in *v1alpha1.DeliveryChannelParameters, c *configservice.DeliveryChannel) {
+	if c == nil {
+		return
+	}
+	in.S3BucketName = awsclients.LateInitializeStringPtr(in.S3BucketName, c.S3BucketName)
+	in.S3KeyPrefix = awsclients.LateInitializeStringPtr(in.S3KeyPrefix, c.S3KeyPrefix)
+	in.SNSTopicARN = awsclients.LateInitializeStringPtr(in.SNSTopicARN, c.SnsTopicARN)
+	if c.ConfigSnapshotDeliveryProperties != nil && c.ConfigSnapshotDeliveryProperties.DeliveryFrequency != "" {
+		in.DeliveryFrequency = awsclients.LateInitializeStringPtr(in.DeliveryFrequency,
+			aws.String(string(c.ConfigSnapshotDeliveryProperties.DeliveryFrequency)))
+	}
+}
+
+// IsDeliveryChannelUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsDeliveryChannelUpToDate(p v1alpha1.DeliveryChannelParameters, c configservice.DeliveryChannel) bool {
+	frequency := ""
+	if c.ConfigSnapshotDeliveryProperties != nil {
+		frequency = string(c.ConfigSnapshotDeliveryProperties.DeliveryFrequency)
+	}
+	return aws.StringValue(p.S3BucketName) == aws.StringValue(c.S3BucketName) &&
+		aws.StringValue(p.S3KeyPrefix) == aws.StringValue(c.S3KeyPrefix) &&
+		aws.StringValue(p.SNSTopicARN) == aws.StringValue(c.SnsTopicARN) &&
+		aws.StringValue(p.DeliveryFrequency) == frequency
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/configservice"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ConfigRuleClient = (*MockConfigRuleClient)(nil)
+
+// MockConfigRuleClient is a type that implements all the methods for ConfigRuleClient interface
+type MockConfigRuleClient struct {
+	MockDescribeConfigRules func(*configservice.DescribeConfigRulesInput) configservice.DescribeConfigRulesRequest
+	MockPutConfigRule       func(*configservice.PutConfigRuleInput) configservice.PutConfigRuleRequest
+	MockDeleteConfigRule    func(*configservice.DeleteConfigRuleInput) configservice.DeleteConfigRuleRequest
+}
+
+// DescribeConfigRulesRequest mocks DescribeConfigRulesRequest method
+func (m *MockConfigRuleClient) DescribeConfigRulesRequest(input *configservice.DescribeConfigRulesInput) configservice.DescribeConfigRulesRequest {
+	return m.MockDescribeConfigRules(input)
+}
+
+// PutConfigRuleRequest mocks PutConfigRuleRequest method
+func (m *MockConfigRuleClient) PutConfigRuleRequest(input *configservice.PutConfigRuleInput) configservice.PutConfigRuleRequest {
+	return m.MockPutConfigRule(input)
+}
+
+// DeleteConfigRuleRequest mocks DeleteConfigRuleRequest method
+func (m *MockConfigRuleClient) DeleteConfigRuleRequest(input *configservice.DeleteConfigRuleInput) configservice.DeleteConfigRuleRequest {
+	return m.MockDeleteConfigRule(input)
+}
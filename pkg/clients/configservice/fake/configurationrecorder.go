@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/configservice"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ConfigurationRecorderClient = (*MockConfigurationRecorderClient)(nil)
+
+// MockConfigurationRecorderClient is a type that implements all the methods for ConfigurationRecorderClient interface
+type MockConfigurationRecorderClient struct {
+	MockDescribeConfigurationRecorders      func(*configservice.DescribeConfigurationRecordersInput) configservice.DescribeConfigurationRecordersRequest
+	MockDescribeConfigurationRecorderStatus func(*configservice.DescribeConfigurationRecorderStatusInput) configservice.DescribeConfigurationRecorderStatusRequest
+	MockPutConfigurationRecorder            func(*configservice.PutConfigurationRecorderInput) configservice.PutConfigurationRecorderRequest
+	MockStartConfigurationRecorder          func(*configservice.StartConfigurationRecorderInput) configservice.StartConfigurationRecorderRequest
+	MockStopConfigurationRecorder           func(*configservice.StopConfigurationRecorderInput) configservice.StopConfigurationRecorderRequest
+	MockDeleteConfigurationRecorder         func(*configservice.DeleteConfigurationRecorderInput) configservice.DeleteConfigurationRecorderRequest
+}
+
+// DescribeConfigurationRecordersRequest mocks DescribeConfigurationRecordersRequest method
+func (m *MockConfigurationRecorderClient) DescribeConfigurationRecordersRequest(input *configservice.DescribeConfigurationRecordersInput) configservice.DescribeConfigurationRecordersRequest {
+	return m.MockDescribeConfigurationRecorders(input)
+}
+
+// DescribeConfigurationRecorderStatusRequest mocks DescribeConfigurationRecorderStatusRequest method
+func (m *MockConfigurationRecorderClient) DescribeConfigurationRecorderStatusRequest(input *configservice.DescribeConfigurationRecorderStatusInput) configservice.DescribeConfigurationRecorderStatusRequest {
+	return m.MockDescribeConfigurationRecorderStatus(input)
+}
+
+// PutConfigurationRecorderRequest mocks PutConfigurationRecorderRequest method
+func (m *MockConfigurationRecorderClient) PutConfigurationRecorderRequest(input *configservice.PutConfigurationRecorderInput) configservice.PutConfigurationRecorderRequest {
+	return m.MockPutConfigurationRecorder(input)
+}
+
+// StartConfigurationRecorderRequest mocks StartConfigurationRecorderRequest method
+func (m *MockConfigurationRecorderClient) StartConfigurationRecorderRequest(input *configservice.StartConfigurationRecorderInput) configservice.StartConfigurationRecorderRequest {
+	return m.MockStartConfigurationRecorder(input)
+}
+
+// StopConfigurationRecorderRequest mocks StopConfigurationRecorderRequest method
+func (m *MockConfigurationRecorderClient) StopConfigurationRecorderRequest(input *configservice.StopConfigurationRecorderInput) configservice.StopConfigurationRecorderRequest {
+	return m.MockStopConfigurationRecorder(input)
+}
+
+// DeleteConfigurationRecorderRequest mocks DeleteConfigurationRecorderRequest method
+func (m *MockConfigurationRecorderClient) DeleteConfigurationRecorderRequest(input *configservice.DeleteConfigurationRecorderInput) configservice.DeleteConfigurationRecorderRequest {
+	return m.MockDeleteConfigurationRecorder(input)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/configservice"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DeliveryChannelClient = (*MockDeliveryChannelClient)(nil)
+
+// MockDeliveryChannelClient is a type that implements all the methods for DeliveryChannelClient interface
+type MockDeliveryChannelClient struct {
+	MockDescribeDeliveryChannels func(*configservice.DescribeDeliveryChannelsInput) configservice.DescribeDeliveryChannelsRequest
+	MockPutDeliveryChannel       func(*configservice.PutDeliveryChannelInput) configservice.PutDeliveryChannelRequest
+	MockDeleteDeliveryChannel    func(*configservice.DeleteDeliveryChannelInput) configservice.DeleteDeliveryChannelRequest
+}
+
+// DescribeDeliveryChannelsRequest mocks DescribeDeliveryChannelsRequest method
+func (m *MockDeliveryChannelClient) DescribeDeliveryChannelsRequest(input *configservice.DescribeDeliveryChannelsInput) configservice.DescribeDeliveryChannelsRequest {
+	return m.MockDescribeDeliveryChannels(input)
+}
+
+// PutDeliveryChannelRequest mocks PutDeliveryChannelRequest method
+func (m *MockDeliveryChannelClient) PutDeliveryChannelRequest(input *configservice.PutDeliveryChannelInput) configservice.PutDeliveryChannelRequest {
+	return m.MockPutDeliveryChannel(input)
+}
+
+// DeleteDeliveryChannelRequest mocks DeleteDeliveryChannelRequest method
+func (m *MockDeliveryChannelClient) DeleteDeliveryChannelRequest(input *configservice.DeleteDeliveryChannelInput) configservice.DeleteDeliveryChannelRequest {
+	return m.MockDeleteDeliveryChannel(input)
+}
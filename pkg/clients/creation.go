@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// DefaultCreateGracePeriod is the default time after the creation of an
+// external resource during which it is assumed to exist even if AWS does not
+// return it yet.
+const DefaultCreateGracePeriod = 30 * time.Second
+
+// A CreateTracker remembers when the external resources of managed resources
+// were created, so that a resource that AWS has not propagated yet is not
+// created a second time.
+type CreateTracker struct {
+	mu      sync.Mutex
+	created map[types.UID]time.Time
+
+	now   func() time.Time
+	grace time.Duration
+}
+
+// NewCreateTracker returns a new CreateTracker with the given grace period.
+func NewCreateTracker(grace time.Duration) *CreateTracker {
+	return &CreateTracker{
+		created: map[types.UID]time.Time{},
+		now:     time.Now,
+		grace:   grace,
+	}
+}
+
+// defaultCreateTracker is shared by all controllers of the provider so that
+// the grace period can be configured once.
+var defaultCreateTracker = NewCreateTracker(DefaultCreateGracePeriod)
+
+// SetCreateGracePeriod sets the grace period of the CreateTracker used by
+// WithCreateGracePeriod. A zero period disables it.
+func SetCreateGracePeriod(d time.Duration) {
+	defaultCreateTracker.mu.Lock()
+	defer defaultCreateTracker.mu.Unlock()
+	defaultCreateTracker.grace = d
+}
+
+// Created records that the external resource of the given managed resource
+// has just been created.
+func (t *CreateTracker) Created(mg resource.Managed) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	for uid, c := range t.created {
+		if now.Sub(c) >= t.grace {
+			delete(t.created, uid)
+		}
+	}
+	t.created[mg.GetUID()] = now
+}
+
+// Observed records that the external resource of the given managed resource
+// has been seen, after which it no longer needs a grace period.
+func (t *CreateTracker) Observed(mg resource.Managed) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.created, mg.GetUID())
+}
+
+// InGracePeriod returns true if the external resource of the given managed
+// resource was created less than the grace period ago.
+func (t *CreateTracker) InGracePeriod(mg resource.Managed) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	c, ok := t.created[mg.GetUID()]
+	if !ok {
+		return false
+	}
+	if t.now().Sub(c) >= t.grace {
+		delete(t.created, mg.GetUID())
+		return false
+	}
+	return true
+}
+
+// WithCreateGracePeriod wraps the given ExternalConnecter so that an
+// external resource that is not found shortly after it was created is
+// reported as existing and up to date. AWS APIs are eventually consistent,
+// and a resource that has just been created, like an IAM role or a security
+// group, is not always returned right away. Without the grace period it would
+// be created again, which either fails because the resource already exists
+// or leaks a duplicate.
+func WithCreateGracePeriod(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &gracefulConnecter{ExternalConnecter: c, tracker: defaultCreateTracker}
+}
+
+type gracefulConnecter struct {
+	managed.ExternalConnecter
+	tracker *CreateTracker
+}
+
+func (c *gracefulConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &gracefulExternal{ExternalClient: e, tracker: c.tracker}, nil
+}
+
+type gracefulExternal struct {
+	managed.ExternalClient
+	tracker *CreateTracker
+}
+
+func (e *gracefulExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil {
+		return o, err
+	}
+	if o.ResourceExists {
+		e.tracker.Observed(mg)
+		return o, nil
+	}
+	if !meta.WasDeleted(mg) && e.tracker.InGracePeriod(mg) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	return o, nil
+}
+
+func (e *gracefulExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	if err == nil {
+		e.tracker.Created(mg)
+	}
+	return c, err
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+func createdVPC() *v1beta1.VPC {
+	cr := vpc(v1beta1.VPCParameters{})
+	cr.SetName("vpc")
+	cr.SetUID(types.UID("vpc-uid"))
+	return cr
+}
+
+func TestCreateTrackerInGracePeriod(t *testing.T) {
+	now := time.Now()
+	tr := NewCreateTracker(30 * time.Second)
+	tr.now = func() time.Time { return now }
+	cr := createdVPC()
+
+	if tr.InGracePeriod(cr) {
+		t.Errorf("InGracePeriod(...): want false before Created")
+	}
+	tr.Created(cr)
+	now = now.Add(10 * time.Second)
+	if !tr.InGracePeriod(cr) {
+		t.Errorf("InGracePeriod(...): want true within the grace period")
+	}
+	now = now.Add(30 * time.Second)
+	if tr.InGracePeriod(cr) {
+		t.Errorf("InGracePeriod(...): want false after the grace period")
+	}
+
+	tr.Created(cr)
+	tr.Observed(cr)
+	if tr.InGracePeriod(cr) {
+		t.Errorf("InGracePeriod(...): want false after Observed")
+	}
+}
+
+func TestGracefulExternalObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		created bool
+		cr      resource.Managed
+		o       managed.ExternalObservation
+		err     error
+	}
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotCreated": {
+			args: args{
+				cr: createdVPC(),
+			},
+			want: want{},
+		},
+		"NotPropagatedYet": {
+			args: args{
+				created: true,
+				cr:      createdVPC(),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Exists": {
+			args: args{
+				created: true,
+				cr:      createdVPC(),
+				o:       managed.ExternalObservation{ResourceExists: true},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"Deleted": {
+			args: args{
+				created: true,
+				cr:      deletedVPC(),
+			},
+			want: want{},
+		},
+		"ObserveFailed": {
+			args: args{
+				created: true,
+				cr:      createdVPC(),
+				err:     errBoom,
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tr := NewCreateTracker(DefaultCreateGracePeriod)
+			if tc.created {
+				tr.Created(tc.cr)
+			}
+			e := &gracefulExternal{
+				ExternalClient: managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return tc.args.o, tc.args.err
+					},
+				},
+				tracker: tr,
+			}
+			o, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGracefulExternalCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Successful": {
+			want: true,
+		},
+		"CreateFailed": {
+			err:  errBoom,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tr := NewCreateTracker(DefaultCreateGracePeriod)
+			e := &gracefulExternal{
+				ExternalClient: managed.ExternalClientFns{
+					CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+						return managed.ExternalCreation{}, tc.err
+					},
+				},
+				tracker: tr,
+			}
+			cr := createdVPC()
+			if _, err := e.Create(context.Background(), cr); err != tc.err {
+				t.Errorf("Create(...): want error %v, got %v", tc.err, err)
+			}
+			if diff := cmp.Diff(tc.want, tr.InGracePeriod(cr)); diff != "" {
+				t.Errorf("InGracePeriod(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasync
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// IsNotFound returns true if the error is because the location or task
+// doesn't exist. DataSync reports missing resources as invalid requests.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok || awsErr.Code() != datasync.ErrCodeInvalidRequestException {
+		return false
+	}
+	return strings.Contains(awsErr.Message(), "not found") || strings.Contains(awsErr.Message(), "does not exist")
+}
+
+// GenerateTags returns the DataSync tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) []datasync.TagListEntry {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]datasync.TagListEntry, len(tags))
+	for i, t := range tags {
+		res[i] = datasync.TagListEntry{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from a location or task.
+func DiffTags(desired []v1alpha1.Tag, observed []datasync.TagListEntry) (add []datasync.TagListEntry, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, datasync.TagListEntry{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasync
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+)
+
+func TestIsNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"LocationNotFound": {
+			err:  awserr.New(datasync.ErrCodeInvalidRequestException, "Location loc-0123456789abcdef0 is not found.", nil),
+			want: true,
+		},
+		"TaskDoesNotExist": {
+			err:  awserr.New(datasync.ErrCodeInvalidRequestException, "Task task-0123456789abcdef0 does not exist.", nil),
+			want: true,
+		},
+		"OtherInvalidRequest": {
+			err:  awserr.New(datasync.ErrCodeInvalidRequestException, "Invalid S3 bucket ARN.", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errors.New("not found"),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []datasync.TagListEntry
+		remove []string
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []datasync.TagListEntry
+		want     want
+	}{
+		"Same": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			observed: []datasync.TagListEntry{{Key: aws.String("k"), Value: aws.String("v")}},
+			want:     want{remove: []string{}},
+		},
+		"AddAndRemove": {
+			desired: []v1alpha1.Tag{{Key: "k", Value: "new"}, {Key: "added", Value: "v"}},
+			observed: []datasync.TagListEntry{
+				{Key: aws.String("k"), Value: aws.String("old")},
+				{Key: aws.String("removed"), Value: aws.String("v")},
+			},
+			want: want{
+				add: []datasync.TagListEntry{
+					{Key: aws.String("added"), Value: aws.String("v")},
+					{Key: aws.String("k"), Value: aws.String("new")},
+				},
+				remove: []string{"k", "removed"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			sort.Slice(add, func(i, j int) bool { return aws.StringValue(add[i].Key) < aws.StringValue(add[j].Key) })
+			sort.Strings(remove)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/datasync"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LocationEFSClient = (*MockLocationEFSClient)(nil)
+
+// MockLocationEFSClient is a type that implements all the methods for LocationEFSClient interface
+type MockLocationEFSClient struct {
+	MockDescribeLocationEfs func(*datasync.DescribeLocationEfsInput) datasync.DescribeLocationEfsRequest
+	MockCreateLocationEfs   func(*datasync.CreateLocationEfsInput) datasync.CreateLocationEfsRequest
+	MockDeleteLocation      func(*datasync.DeleteLocationInput) datasync.DeleteLocationRequest
+	MockListTagsForResource func(*datasync.ListTagsForResourceInput) datasync.ListTagsForResourceRequest
+	MockTagResource         func(*datasync.TagResourceInput) datasync.TagResourceRequest
+	MockUntagResource       func(*datasync.UntagResourceInput) datasync.UntagResourceRequest
+}
+
+// DescribeLocationEfsRequest mocks DescribeLocationEfsRequest method
+func (m *MockLocationEFSClient) DescribeLocationEfsRequest(input *datasync.DescribeLocationEfsInput) datasync.DescribeLocationEfsRequest {
+	return m.MockDescribeLocationEfs(input)
+}
+
+// CreateLocationEfsRequest mocks CreateLocationEfsRequest method
+func (m *MockLocationEFSClient) CreateLocationEfsRequest(input *datasync.CreateLocationEfsInput) datasync.CreateLocationEfsRequest {
+	return m.MockCreateLocationEfs(input)
+}
+
+// DeleteLocationRequest mocks DeleteLocationRequest method
+func (m *MockLocationEFSClient) DeleteLocationRequest(input *datasync.DeleteLocationInput) datasync.DeleteLocationRequest {
+	return m.MockDeleteLocation(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockLocationEFSClient) ListTagsForResourceRequest(input *datasync.ListTagsForResourceInput) datasync.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockLocationEFSClient) TagResourceRequest(input *datasync.TagResourceInput) datasync.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockLocationEFSClient) UntagResourceRequest(input *datasync.UntagResourceInput) datasync.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/datasync"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LocationNFSClient = (*MockLocationNFSClient)(nil)
+
+// MockLocationNFSClient is a type that implements all the methods for LocationNFSClient interface
+type MockLocationNFSClient struct {
+	MockDescribeLocationNfs func(*datasync.DescribeLocationNfsInput) datasync.DescribeLocationNfsRequest
+	MockCreateLocationNfs   func(*datasync.CreateLocationNfsInput) datasync.CreateLocationNfsRequest
+	MockDeleteLocation      func(*datasync.DeleteLocationInput) datasync.DeleteLocationRequest
+	MockListTagsForResource func(*datasync.ListTagsForResourceInput) datasync.ListTagsForResourceRequest
+	MockTagResource         func(*datasync.TagResourceInput) datasync.TagResourceRequest
+	MockUntagResource       func(*datasync.UntagResourceInput) datasync.UntagResourceRequest
+}
+
+// DescribeLocationNfsRequest mocks DescribeLocationNfsRequest method
+func (m *MockLocationNFSClient) DescribeLocationNfsRequest(input *datasync.DescribeLocationNfsInput) datasync.DescribeLocationNfsRequest {
+	return m.MockDescribeLocationNfs(input)
+}
+
+// CreateLocationNfsRequest mocks CreateLocationNfsRequest method
+func (m *MockLocationNFSClient) CreateLocationNfsRequest(input *datasync.CreateLocationNfsInput) datasync.CreateLocationNfsRequest {
+	return m.MockCreateLocationNfs(input)
+}
+
+// DeleteLocationRequest mocks DeleteLocationRequest method
+func (m *MockLocationNFSClient) DeleteLocationRequest(input *datasync.DeleteLocationInput) datasync.DeleteLocationRequest {
+	return m.MockDeleteLocation(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockLocationNFSClient) ListTagsForResourceRequest(input *datasync.ListTagsForResourceInput) datasync.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockLocationNFSClient) TagResourceRequest(input *datasync.TagResourceInput) datasync.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockLocationNFSClient) UntagResourceRequest(input *datasync.UntagResourceInput) datasync.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/datasync"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LocationS3Client = (*MockLocationS3Client)(nil)
+
+// MockLocationS3Client is a type that implements all the methods for LocationS3Client interface
+type MockLocationS3Client struct {
+	MockDescribeLocationS3  func(*datasync.DescribeLocationS3Input) datasync.DescribeLocationS3Request
+	MockCreateLocationS3    func(*datasync.CreateLocationS3Input) datasync.CreateLocationS3Request
+	MockDeleteLocation      func(*datasync.DeleteLocationInput) datasync.DeleteLocationRequest
+	MockListTagsForResource func(*datasync.ListTagsForResourceInput) datasync.ListTagsForResourceRequest
+	MockTagResource         func(*datasync.TagResourceInput) datasync.TagResourceRequest
+	MockUntagResource       func(*datasync.UntagResourceInput) datasync.UntagResourceRequest
+}
+
+// DescribeLocationS3Request mocks DescribeLocationS3Request method
+func (m *MockLocationS3Client) DescribeLocationS3Request(input *datasync.DescribeLocationS3Input) datasync.DescribeLocationS3Request {
+	return m.MockDescribeLocationS3(input)
+}
+
+// CreateLocationS3Request mocks CreateLocationS3Request method
+func (m *MockLocationS3Client) CreateLocationS3Request(input *datasync.CreateLocationS3Input) datasync.CreateLocationS3Request {
+	return m.MockCreateLocationS3(input)
+}
+
+// DeleteLocationRequest mocks DeleteLocationRequest method
+func (m *MockLocationS3Client) DeleteLocationRequest(input *datasync.DeleteLocationInput) datasync.DeleteLocationRequest {
+	return m.MockDeleteLocation(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockLocationS3Client) ListTagsForResourceRequest(input *datasync.ListTagsForResourceInput) datasync.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockLocationS3Client) TagResourceRequest(input *datasync.TagResourceInput) datasync.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockLocationS3Client) UntagResourceRequest(input *datasync.UntagResourceInput) datasync.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/datasync"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TaskClient = (*MockTaskClient)(nil)
+
+// MockTaskClient is a type that implements all the methods for TaskClient interface
+type MockTaskClient struct {
+	MockDescribeTask        func(*datasync.DescribeTaskInput) datasync.DescribeTaskRequest
+	MockCreateTask          func(*datasync.CreateTaskInput) datasync.CreateTaskRequest
+	MockUpdateTask          func(*datasync.UpdateTaskInput) datasync.UpdateTaskRequest
+	MockDeleteTask          func(*datasync.DeleteTaskInput) datasync.DeleteTaskRequest
+	MockListTagsForResource func(*datasync.ListTagsForResourceInput) datasync.ListTagsForResourceRequest
+	MockTagResource         func(*datasync.TagResourceInput) datasync.TagResourceRequest
+	MockUntagResource       func(*datasync.UntagResourceInput) datasync.UntagResourceRequest
+}
+
+// DescribeTaskRequest mocks DescribeTaskRequest method
+func (m *MockTaskClient) DescribeTaskRequest(input *datasync.DescribeTaskInput) datasync.DescribeTaskRequest {
+	return m.MockDescribeTask(input)
+}
+
+// CreateTaskRequest mocks CreateTaskRequest method
+func (m *MockTaskClient) CreateTaskRequest(input *datasync.CreateTaskInput) datasync.CreateTaskRequest {
+	return m.MockCreateTask(input)
+}
+
+// UpdateTaskRequest mocks UpdateTaskRequest method
+func (m *MockTaskClient) UpdateTaskRequest(input *datasync.UpdateTaskInput) datasync.UpdateTaskRequest {
+	return m.MockUpdateTask(input)
+}
+
+// DeleteTaskRequest mocks DeleteTaskRequest method
+func (m *MockTaskClient) DeleteTaskRequest(input *datasync.DeleteTaskInput) datasync.DeleteTaskRequest {
+	return m.MockDeleteTask(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockTaskClient) ListTagsForResourceRequest(input *datasync.ListTagsForResourceInput) datasync.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockTaskClient) TagResourceRequest(input *datasync.TagResourceInput) datasync.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockTaskClient) UntagResourceRequest(input *datasync.UntagResourceInput) datasync.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasync
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+)
+
+// LocationEFSClient is the external client used for LocationEFS Custom
+// Resource
+type LocationEFSClient interface {
+	DescribeLocationEfsRequest(*datasync.DescribeLocationEfsInput) datasync.DescribeLocationEfsRequest
+	CreateLocationEfsRequest(*datasync.CreateLocationEfsInput) datasync.CreateLocationEfsRequest
+	DeleteLocationRequest(*datasync.DeleteLocationInput) datasync.DeleteLocationRequest
+	ListTagsForResourceRequest(*datasync.ListTagsForResourceInput) datasync.ListTagsForResourceRequest
+	TagResourceRequest(*datasync.TagResourceInput) datasync.TagResourceRequest
+	UntagResourceRequest(*datasync.UntagResourceInput) datasync.UntagResourceRequest
+}
+
+// NewLocationEFSClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewLocationEFSClient(cfg aws.Config) LocationEFSClient {
+	return datasync.New(cfg)
+}
+
+// GenerateCreateLocationEFSInput returns the create input of an EFS location
+// with the given parameters.
+func GenerateCreateLocationEFSInput(p v1alpha1.LocationEFSParameters) *datasync.CreateLocationEfsInput {
+	return &datasync.CreateLocationEfsInput{
+		EfsFilesystemArn: aws.String(p.EFSFileSystemARN),
+		Ec2Config: &datasync.Ec2Config{
+			SubnetArn:         aws.String(p.SubnetARN),
+			SecurityGroupArns: p.SecurityGroupARNs,
+		},
+		Subdirectory: p.Subdirectory,
+		Tags:         GenerateTags(p.Tags),
+	}
+}
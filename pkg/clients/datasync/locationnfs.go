@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasync
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+)
+
+// LocationNFSClient is the external client used for LocationNFS Custom
+// Resource
+type LocationNFSClient interface {
+	DescribeLocationNfsRequest(*datasync.DescribeLocationNfsInput) datasync.DescribeLocationNfsRequest
+	CreateLocationNfsRequest(*datasync.CreateLocationNfsInput) datasync.CreateLocationNfsRequest
+	DeleteLocationRequest(*datasync.DeleteLocationInput) datasync.DeleteLocationRequest
+	ListTagsForResourceRequest(*datasync.ListTagsForResourceInput) datasync.ListTagsForResourceRequest
+	TagResourceRequest(*datasync.TagResourceInput) datasync.TagResourceRequest
+	UntagResourceRequest(*datasync.UntagResourceInput) datasync.UntagResourceRequest
+}
+
+// NewLocationNFSClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewLocationNFSClient(cfg aws.Config) LocationNFSClient {
+	return datasync.New(cfg)
+}
+
+// GenerateCreateLocationNFSInput returns the create input of an NFS location
+// with the given parameters.
+func GenerateCreateLocationNFSInput(p v1alpha1.LocationNFSParameters) *datasync.CreateLocationNfsInput {
+	in := &datasync.CreateLocationNfsInput{
+		ServerHostname: aws.String(p.ServerHostname),
+		Subdirectory:   aws.String(p.Subdirectory),
+		OnPremConfig:   &datasync.OnPremConfig{AgentArns: p.AgentARNs},
+		Tags:           GenerateTags(p.Tags),
+	}
+	if p.Version != nil {
+		in.MountOptions = &datasync.NfsMountOptions{Version: datasync.NfsVersion(*p.Version)}
+	}
+	return in
+}
+
+// LateInitializeLocationNFS fills the empty fields of the NFS location
+// parameters with the values of the observed location.
+func LateInitializeLocationNFS(in *v1alpha1.LocationNFSParameters, o *datasync.DescribeLocationNfsOutput) {
+	if o == nil || o.MountOptions == nil {
+		return
+	}
+	if in.Version == nil && o.MountOptions.Version != "" {
+		in.Version = aws.String(string(o.MountOptions.Version))
+	}
+}
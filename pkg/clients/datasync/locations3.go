@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasync
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+)
+
+// LocationS3Client is the external client used for LocationS3 Custom Resource
+type LocationS3Client interface {
+	DescribeLocationS3Request(*datasync.DescribeLocationS3Input) datasync.DescribeLocationS3Request
+	CreateLocationS3Request(*datasync.CreateLocationS3Input) datasync.CreateLocationS3Request
+	DeleteLocationRequest(*datasync.DeleteLocationInput) datasync.DeleteLocationRequest
+	ListTagsForResourceRequest(*datasync.ListTagsForResourceInput) datasync.ListTagsForResourceRequest
+	TagResourceRequest(*datasync.TagResourceInput) datasync.TagResourceRequest
+	UntagResourceRequest(*datasync.UntagResourceInput) datasync.UntagResourceRequest
+}
+
+// NewLocationS3Client returns a new client using AWS credentials as JSON
+// encoded data.
+func NewLocationS3Client(cfg aws.Config) LocationS3Client {
+	return datasync.New(cfg)
+}
+
+// GenerateCreateLocationS3Input returns the create input of an S3 location
+// with the given parameters.
+func GenerateCreateLocationS3Input(p v1alpha1.LocationS3Parameters) *datasync.CreateLocationS3Input {
+	in := &datasync.CreateLocationS3Input{
+		S3BucketArn:  p.S3BucketARN,
+		S3Config:     &datasync.S3Config{BucketAccessRoleArn: p.BucketAccessRoleARN},
+		Subdirectory: p.Subdirectory,
+		Tags:         GenerateTags(p.Tags),
+	}
+	if p.S3StorageClass != nil {
+		in.S3StorageClass = datasync.S3StorageClass(*p.S3StorageClass)
+	}
+	return in
+}
+
+// LateInitializeLocationS3 fills the empty fields of the S3 location
+// parameters with the values of the observed location.
+func LateInitializeLocationS3(in *v1alpha1.LocationS3Parameters, o *datasync.DescribeLocationS3Output) {
+	if o == nil {
+		return
+	}
+	if in.S3StorageClass == nil && o.S3StorageClass != "" {
+		in.S3StorageClass = aws.String(string(o.S3StorageClass))
+	}
+}
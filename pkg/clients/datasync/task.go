@@ -0,0 +1,216 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasync
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// TaskClient is the external client used for Task Custom Resource
+type TaskClient interface {
+	DescribeTaskRequest(*datasync.DescribeTaskInput) datasync.DescribeTaskRequest
+	CreateTaskRequest(*datasync.CreateTaskInput) datasync.CreateTaskRequest
+	UpdateTaskRequest(*datasync.UpdateTaskInput) datasync.UpdateTaskRequest
+	DeleteTaskRequest(*datasync.DeleteTaskInput) datasync.DeleteTaskRequest
+	ListTagsForResourceRequest(*datasync.ListTagsForResourceInput) datasync.ListTagsForResourceRequest
+	TagResourceRequest(*datasync.TagResourceInput) datasync.TagResourceRequest
+	UntagResourceRequest(*datasync.UntagResourceInput) datasync.UntagResourceRequest
+}
+
+// NewTaskClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewTaskClient(cfg aws.Config) TaskClient {
+	return datasync.New(cfg)
+}
+
+// GenerateCreateTaskInput returns the create input of a task with the given
+// parameters.
+func GenerateCreateTaskInput(p v1alpha1.TaskParameters) *datasync.CreateTaskInput {
+	return &datasync.CreateTaskInput{
+		Name:                   p.Name,
+		SourceLocationArn:      p.SourceLocationARN,
+		DestinationLocationArn: p.DestinationLocationARN,
+		CloudWatchLogGroupArn:  p.CloudWatchLogGroupARN,
+		Excludes:               generateFilterRules(p.Excludes),
+		Options:                generateOptions(p.Options),
+		Schedule:               generateSchedule(p.ScheduleExpression),
+		Tags:                   GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateTaskInput returns the update input of the task with the given
+// ARN and parameters.
+func GenerateUpdateTaskInput(arn string, p v1alpha1.TaskParameters) *datasync.UpdateTaskInput {
+	return &datasync.UpdateTaskInput{
+		TaskArn:               aws.String(arn),
+		Name:                  p.Name,
+		CloudWatchLogGroupArn: p.CloudWatchLogGroupARN,
+		Excludes:              generateFilterRules(p.Excludes),
+		Options:               generateOptions(p.Options),
+		Schedule:              generateSchedule(p.ScheduleExpression),
+	}
+}
+
+// GenerateTaskObservation returns the observation of the given task.
+func GenerateTaskObservation(o datasync.DescribeTaskOutput) v1alpha1.TaskObservation {
+	return v1alpha1.TaskObservation{
+		Status:                  string(o.Status),
+		CurrentTaskExecutionARN: aws.StringValue(o.CurrentTaskExecutionArn),
+		ErrorCode:               aws.StringValue(o.ErrorCode),
+		ErrorDetail:             aws.StringValue(o.ErrorDetail),
+	}
+}
+
+// LateInitializeTask fills the empty fields of the task parameters with the
+// values of the observed task.
+func LateInitializeTask(in *v1alpha1.TaskParameters, o *datasync.DescribeTaskOutput) {
+	if o == nil {
+		return
+	}
+	in.Name = awsclients.LateInitializeStringPtr(in.Name, o.Name)
+	in.CloudWatchLogGroupARN = awsclients.LateInitializeStringPtr(in.CloudWatchLogGroupARN, o.CloudWatchLogGroupArn)
+	if in.ScheduleExpression == nil && o.Schedule != nil {
+		in.ScheduleExpression = o.Schedule.ScheduleExpression
+	}
+	if in.Excludes == nil {
+		in.Excludes = generateTaskFilterRules(o.Excludes)
+	}
+	if o.Options != nil {
+		if in.Options == nil {
+			in.Options = &v1alpha1.TaskOptions{}
+		}
+		lateInitializeTaskOptions(in.Options, generateTaskOptions(o.Options))
+	}
+}
+
+// IsTaskUpToDate returns true if the observed task matches the parameters.
+// Tags are compared separately.
+func IsTaskUpToDate(p v1alpha1.TaskParameters, o datasync.DescribeTaskOutput) bool {
+	if p.Name != nil && *p.Name != aws.StringValue(o.Name) {
+		return false
+	}
+	if p.CloudWatchLogGroupARN != nil && *p.CloudWatchLogGroupARN != aws.StringValue(o.CloudWatchLogGroupArn) {
+		return false
+	}
+	var schedule *string
+	if o.Schedule != nil {
+		schedule = o.Schedule.ScheduleExpression
+	}
+	if p.ScheduleExpression != nil && *p.ScheduleExpression != aws.StringValue(schedule) {
+		return false
+	}
+	if !cmp.Equal(p.Excludes, generateTaskFilterRules(o.Excludes), cmpopts.EquateEmpty()) {
+		return false
+	}
+	if p.Options == nil {
+		return true
+	}
+	observed := generateTaskOptions(o.Options)
+	desired := p.Options.DeepCopy()
+	lateInitializeTaskOptions(desired, observed)
+	return cmp.Equal(desired, observed)
+}
+
+func generateSchedule(expression *string) *datasync.TaskSchedule {
+	if expression == nil {
+		return nil
+	}
+	return &datasync.TaskSchedule{ScheduleExpression: expression}
+}
+
+func generateFilterRules(rules []v1alpha1.FilterRule) []datasync.FilterRule {
+	if rules == nil {
+		return nil
+	}
+	res := make([]datasync.FilterRule, len(rules))
+	for i, r := range rules {
+		res[i] = datasync.FilterRule{FilterType: datasync.FilterType(r.FilterType), Value: aws.String(r.Value)}
+	}
+	return res
+}
+
+func generateTaskFilterRules(rules []datasync.FilterRule) []v1alpha1.FilterRule {
+	if rules == nil {
+		return nil
+	}
+	res := make([]v1alpha1.FilterRule, len(rules))
+	for i, r := range rules {
+		res[i] = v1alpha1.FilterRule{FilterType: string(r.FilterType), Value: aws.StringValue(r.Value)}
+	}
+	return res
+}
+
+func generateOptions(o *v1alpha1.TaskOptions) *datasync.Options {
+	if o == nil {
+		return nil
+	}
+	return &datasync.Options{
+		Atime:                datasync.Atime(aws.StringValue(o.Atime)),
+		BytesPerSecond:       o.BytesPerSecond,
+		Gid:                  datasync.Gid(aws.StringValue(o.GID)),
+		LogLevel:             datasync.LogLevel(aws.StringValue(o.LogLevel)),
+		Mtime:                datasync.Mtime(aws.StringValue(o.Mtime)),
+		OverwriteMode:        datasync.OverwriteMode(aws.StringValue(o.OverwriteMode)),
+		PosixPermissions:     datasync.PosixPermissions(aws.StringValue(o.PosixPermissions)),
+		PreserveDeletedFiles: datasync.PreserveDeletedFiles(aws.StringValue(o.PreserveDeletedFiles)),
+		PreserveDevices:      datasync.PreserveDevices(aws.StringValue(o.PreserveDevices)),
+		TaskQueueing:         datasync.TaskQueueing(aws.StringValue(o.TaskQueueing)),
+		Uid:                  datasync.Uid(aws.StringValue(o.UID)),
+		VerifyMode:           datasync.VerifyMode(aws.StringValue(o.VerifyMode)),
+	}
+}
+
+func generateTaskOptions(o *datasync.Options) *v1alpha1.TaskOptions {
+	if o == nil {
+		return &v1alpha1.TaskOptions{}
+	}
+	return &v1alpha1.TaskOptions{
+		Atime:                awsclients.String(string(o.Atime)),
+		BytesPerSecond:       o.BytesPerSecond,
+		GID:                  awsclients.String(string(o.Gid)),
+		LogLevel:             awsclients.String(string(o.LogLevel)),
+		Mtime:                awsclients.String(string(o.Mtime)),
+		OverwriteMode:        awsclients.String(string(o.OverwriteMode)),
+		PosixPermissions:     awsclients.String(string(o.PosixPermissions)),
+		PreserveDeletedFiles: awsclients.String(string(o.PreserveDeletedFiles)),
+		PreserveDevices:      awsclients.String(string(o.PreserveDevices)),
+		TaskQueueing:         awsclients.String(string(o.TaskQueueing)),
+		UID:                  awsclients.String(string(o.Uid)),
+		VerifyMode:           awsclients.String(string(o.VerifyMode)),
+	}
+}
+
+func lateInitializeTaskOptions(in, from *v1alpha1.TaskOptions) {
+	in.Atime = awsclients.LateInitializeStringPtr(in.Atime, from.Atime)
+	in.BytesPerSecond = awsclients.LateInitializeInt64Ptr(in.BytesPerSecond, from.BytesPerSecond)
+	in.GID = awsclients.LateInitializeStringPtr(in.GID, from.GID)
+	in.LogLevel = awsclients.LateInitializeStringPtr(in.LogLevel, from.LogLevel)
+	in.Mtime = awsclients.LateInitializeStringPtr(in.Mtime, from.Mtime)
+	in.OverwriteMode = awsclients.LateInitializeStringPtr(in.OverwriteMode, from.OverwriteMode)
+	in.PosixPermissions = awsclients.LateInitializeStringPtr(in.PosixPermissions, from.PosixPermissions)
+	in.PreserveDeletedFiles = awsclients.LateInitializeStringPtr(in.PreserveDeletedFiles, from.PreserveDeletedFiles)
+	in.PreserveDevices = awsclients.LateInitializeStringPtr(in.PreserveDevices, from.PreserveDevices)
+	in.TaskQueueing = awsclients.LateInitializeStringPtr(in.TaskQueueing, from.TaskQueueing)
+	in.UID = awsclients.LateInitializeStringPtr(in.UID, from.UID)
+	in.VerifyMode = awsclients.LateInitializeStringPtr(in.VerifyMode, from.VerifyMode)
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasync
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+)
+
+func TestLateInitializeTask(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.TaskParameters
+		observed *datasync.DescribeTaskOutput
+		want     v1alpha1.TaskParameters
+	}{
+		"AllFilled": {
+			in: v1alpha1.TaskParameters{Options: &v1alpha1.TaskOptions{VerifyMode: aws.String("NONE")}},
+			observed: &datasync.DescribeTaskOutput{
+				Name:     aws.String("backup"),
+				Schedule: &datasync.TaskSchedule{ScheduleExpression: aws.String("rate(1 day)")},
+				Excludes: []datasync.FilterRule{{FilterType: datasync.FilterTypeSimplePattern, Value: aws.String("*.tmp")}},
+				Options: &datasync.Options{
+					OverwriteMode: datasync.OverwriteModeAlways,
+					VerifyMode:    datasync.VerifyModePointInTimeConsistent,
+				},
+			},
+			want: v1alpha1.TaskParameters{
+				Name:               aws.String("backup"),
+				ScheduleExpression: aws.String("rate(1 day)"),
+				Excludes:           []v1alpha1.FilterRule{{FilterType: "SIMPLE_PATTERN", Value: "*.tmp"}},
+				Options: &v1alpha1.TaskOptions{
+					OverwriteMode: aws.String("ALWAYS"),
+					VerifyMode:    aws.String("NONE"),
+				},
+			},
+		},
+		"NoObservation": {
+			in:   v1alpha1.TaskParameters{Name: aws.String("backup")},
+			want: v1alpha1.TaskParameters{Name: aws.String("backup")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeTask(&tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTaskUpToDate(t *testing.T) {
+	observed := datasync.DescribeTaskOutput{
+		Name:     aws.String("backup"),
+		Schedule: &datasync.TaskSchedule{ScheduleExpression: aws.String("rate(1 day)")},
+		Options: &datasync.Options{
+			OverwriteMode: datasync.OverwriteModeAlways,
+			VerifyMode:    datasync.VerifyModePointInTimeConsistent,
+		},
+	}
+	cases := map[string]struct {
+		p    v1alpha1.TaskParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.TaskParameters{
+				Name:               aws.String("backup"),
+				ScheduleExpression: aws.String("rate(1 day)"),
+				Options:            &v1alpha1.TaskOptions{OverwriteMode: aws.String("ALWAYS")},
+			},
+			want: true,
+		},
+		"ScheduleChanged": {
+			p: v1alpha1.TaskParameters{
+				Name:               aws.String("backup"),
+				ScheduleExpression: aws.String("rate(1 hour)"),
+			},
+			want: false,
+		},
+		"ExcludesChanged": {
+			p: v1alpha1.TaskParameters{
+				Excludes: []v1alpha1.FilterRule{{FilterType: "SIMPLE_PATTERN", Value: "*.tmp"}},
+			},
+			want: false,
+		},
+		"OptionsChanged": {
+			p: v1alpha1.TaskParameters{
+				Options: &v1alpha1.TaskOptions{VerifyMode: aws.String("NONE")},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTaskUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
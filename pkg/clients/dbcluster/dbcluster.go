@@ -0,0 +1,411 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetPasswordSecret = "cannot get the master password secret"
+
+	// ConnectionDetailsReaderEndpoint is the key of the reader endpoint of a
+	// DBCluster in its connection secret.
+	ConnectionDetailsReaderEndpoint = "readerEndpoint"
+)
+
+// Client is the external client used for DBCluster Custom Resource
+type Client interface {
+	DescribeDBClustersRequest(*rds.DescribeDBClustersInput) rds.DescribeDBClustersRequest
+	CreateDBClusterRequest(*rds.CreateDBClusterInput) rds.CreateDBClusterRequest
+	RestoreDBClusterFromSnapshotRequest(*rds.RestoreDBClusterFromSnapshotInput) rds.RestoreDBClusterFromSnapshotRequest
+	ModifyDBClusterRequest(*rds.ModifyDBClusterInput) rds.ModifyDBClusterRequest
+	DeleteDBClusterRequest(*rds.DeleteDBClusterInput) rds.DeleteDBClusterRequest
+	ListTagsForResourceRequest(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	AddTagsToResourceRequest(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	RemoveTagsFromResourceRequest(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return rds.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the DB cluster doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == rds.ErrCodeDBClusterNotFoundFault {
+		return true
+	}
+	return false
+}
+
+// GenerateTags returns the RDS tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) []rds.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]rds.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = rds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from an RDS resource.
+func DiffTags(desired []v1alpha1.Tag, observed []rds.Tag) (add []rds.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, rds.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
+}
+
+// GenerateScalingConfiguration returns the RDS scaling configuration of the
+// given one.
+func GenerateScalingConfiguration(sc *v1alpha1.ScalingConfiguration) *rds.ScalingConfiguration {
+	if sc == nil {
+		return nil
+	}
+	return &rds.ScalingConfiguration{
+		MinCapacity:           sc.MinCapacity,
+		MaxCapacity:           sc.MaxCapacity,
+		AutoPause:             sc.AutoPause,
+		SecondsUntilAutoPause: sc.SecondsUntilAutoPause,
+		TimeoutAction:         sc.TimeoutAction,
+	}
+}
+
+// GenerateCreateDBClusterInput returns the create input of a DB cluster with
+// the given name, master password and parameters. The master user is omitted
+// if no master username is given, e.g. for a secondary DB cluster of a global
+// database.
+func GenerateCreateDBClusterInput(name, password string, p v1alpha1.DBClusterParameters) *rds.CreateDBClusterInput {
+	in := &rds.CreateDBClusterInput{
+		DBClusterIdentifier:             aws.String(name),
+		Engine:                          aws.String(p.Engine),
+		EngineVersion:                   p.EngineVersion,
+		EngineMode:                      p.EngineMode,
+		ScalingConfiguration:            GenerateScalingConfiguration(p.ScalingConfiguration),
+		GlobalClusterIdentifier:         p.GlobalClusterIdentifier,
+		DatabaseName:                    p.DatabaseName,
+		Port:                            p.Port,
+		AvailabilityZones:               p.AvailabilityZones,
+		DBClusterParameterGroupName:     p.DBClusterParameterGroupName,
+		DBSubnetGroupName:               p.DBSubnetGroupName,
+		VpcSecurityGroupIds:             p.VPCSecurityGroupIDs,
+		BackupRetentionPeriod:           p.BackupRetentionPeriod,
+		PreferredBackupWindow:           p.PreferredBackupWindow,
+		PreferredMaintenanceWindow:      p.PreferredMaintenanceWindow,
+		StorageEncrypted:                p.StorageEncrypted,
+		KmsKeyId:                        p.KMSKeyID,
+		EnableIAMDatabaseAuthentication: p.EnableIAMDatabaseAuthentication,
+		CopyTagsToSnapshot:              p.CopyTagsToSnapshot,
+		DeletionProtection:              p.DeletionProtection,
+		EnableCloudwatchLogsExports:     p.EnableCloudwatchLogsExports,
+		Tags:                            GenerateTags(p.Tags),
+	}
+	if p.MasterUsername != nil {
+		in.MasterUsername = p.MasterUsername
+		in.MasterUserPassword = aws.String(password)
+	}
+	return in
+}
+
+// GenerateRestoreDBClusterFromSnapshotInput returns the input to restore a DB
+// cluster with the given name and parameters from the snapshot in the
+// parameters. The backup and maintenance settings and the master password
+// are not part of a restore and are applied by a subsequent update.
+func GenerateRestoreDBClusterFromSnapshotInput(name string, p v1alpha1.DBClusterParameters) *rds.RestoreDBClusterFromSnapshotInput {
+	return &rds.RestoreDBClusterFromSnapshotInput{
+		DBClusterIdentifier:             aws.String(name),
+		SnapshotIdentifier:              p.SnapshotIdentifier,
+		Engine:                          aws.String(p.Engine),
+		EngineVersion:                   p.EngineVersion,
+		EngineMode:                      p.EngineMode,
+		ScalingConfiguration:            GenerateScalingConfiguration(p.ScalingConfiguration),
+		DatabaseName:                    p.DatabaseName,
+		Port:                            p.Port,
+		AvailabilityZones:               p.AvailabilityZones,
+		DBClusterParameterGroupName:     p.DBClusterParameterGroupName,
+		DBSubnetGroupName:               p.DBSubnetGroupName,
+		VpcSecurityGroupIds:             p.VPCSecurityGroupIDs,
+		KmsKeyId:                        p.KMSKeyID,
+		EnableIAMDatabaseAuthentication: p.EnableIAMDatabaseAuthentication,
+		CopyTagsToSnapshot:              p.CopyTagsToSnapshot,
+		DeletionProtection:              p.DeletionProtection,
+		EnableCloudwatchLogsExports:     p.EnableCloudwatchLogsExports,
+		Tags:                            GenerateTags(p.Tags),
+	}
+}
+
+// GenerateModifyDBClusterInput returns the modify input that changes the
+// given observed DB cluster into the desired one. Only the fields that
+// differ are set.
+func GenerateModifyDBClusterInput(name string, p v1alpha1.DBClusterParameters, o rds.DBCluster) *rds.ModifyDBClusterInput { // nolint:gocyclo
+	in := &rds.ModifyDBClusterInput{
+		DBClusterIdentifier: aws.String(name),
+		ApplyImmediately:    p.ApplyImmediately,
+	}
+	if p.EngineVersion != nil && aws.StringValue(p.EngineVersion) != aws.StringValue(o.EngineVersion) {
+		in.EngineVersion = p.EngineVersion
+	}
+	if p.Port != nil && aws.Int64Value(p.Port) != aws.Int64Value(o.Port) {
+		in.Port = p.Port
+	}
+	if p.DBClusterParameterGroupName != nil && aws.StringValue(p.DBClusterParameterGroupName) != aws.StringValue(o.DBClusterParameterGroup) {
+		in.DBClusterParameterGroupName = p.DBClusterParameterGroupName
+	}
+	if p.BackupRetentionPeriod != nil && aws.Int64Value(p.BackupRetentionPeriod) != aws.Int64Value(o.BackupRetentionPeriod) {
+		in.BackupRetentionPeriod = p.BackupRetentionPeriod
+	}
+	if p.PreferredBackupWindow != nil && aws.StringValue(p.PreferredBackupWindow) != aws.StringValue(o.PreferredBackupWindow) {
+		in.PreferredBackupWindow = p.PreferredBackupWindow
+	}
+	if p.PreferredMaintenanceWindow != nil && aws.StringValue(p.PreferredMaintenanceWindow) != aws.StringValue(o.PreferredMaintenanceWindow) {
+		in.PreferredMaintenanceWindow = p.PreferredMaintenanceWindow
+	}
+	if p.EnableIAMDatabaseAuthentication != nil && aws.BoolValue(p.EnableIAMDatabaseAuthentication) != aws.BoolValue(o.IAMDatabaseAuthenticationEnabled) {
+		in.EnableIAMDatabaseAuthentication = p.EnableIAMDatabaseAuthentication
+	}
+	if p.CopyTagsToSnapshot != nil && aws.BoolValue(p.CopyTagsToSnapshot) != aws.BoolValue(o.CopyTagsToSnapshot) {
+		in.CopyTagsToSnapshot = p.CopyTagsToSnapshot
+	}
+	if p.DeletionProtection != nil && aws.BoolValue(p.DeletionProtection) != aws.BoolValue(o.DeletionProtection) {
+		in.DeletionProtection = p.DeletionProtection
+	}
+	if !isScalingConfigurationUpToDate(p.ScalingConfiguration, o.ScalingConfigurationInfo) {
+		in.ScalingConfiguration = GenerateScalingConfiguration(p.ScalingConfiguration)
+	}
+	if len(p.VPCSecurityGroupIDs) != 0 && !cmp.Equal(p.VPCSecurityGroupIDs, observedSecurityGroupIDs(o), sortStrings()) {
+		in.VpcSecurityGroupIds = p.VPCSecurityGroupIDs
+	}
+	enable, disable := diffLogTypes(p.EnableCloudwatchLogsExports, o.EnabledCloudwatchLogsExports)
+	if len(enable) != 0 || len(disable) != 0 {
+		in.CloudwatchLogsExportConfiguration = &rds.CloudwatchLogsExportConfiguration{
+			EnableLogTypes:  enable,
+			DisableLogTypes: disable,
+		}
+	}
+	return in
+}
+
+// GenerateDeleteDBClusterInput returns the delete input of the DB cluster
+// with the given name.
+func GenerateDeleteDBClusterInput(name string, p v1alpha1.DBClusterParameters) *rds.DeleteDBClusterInput {
+	return &rds.DeleteDBClusterInput{
+		DBClusterIdentifier:       aws.String(name),
+		SkipFinalSnapshot:         p.SkipFinalSnapshot,
+		FinalDBSnapshotIdentifier: p.FinalDBSnapshotIdentifier,
+	}
+}
+
+// GenerateObservation is used to produce v1alpha1.DBClusterObservation from
+// rds.DBCluster.
+func GenerateObservation(o rds.DBCluster) v1alpha1.DBClusterObservation {
+	obs := v1alpha1.DBClusterObservation{
+		DBClusterARN:                aws.StringValue(o.DBClusterArn),
+		DBClusterResourceID:         aws.StringValue(o.DbClusterResourceId),
+		Status:                      aws.StringValue(o.Status),
+		Endpoint:                    aws.StringValue(o.Endpoint),
+		ReaderEndpoint:              aws.StringValue(o.ReaderEndpoint),
+		Port:                        aws.Int64Value(o.Port),
+		EngineMode:                  aws.StringValue(o.EngineMode),
+		Capacity:                    aws.Int64Value(o.Capacity),
+		DBClusterParameterGroup:     aws.StringValue(o.DBClusterParameterGroup),
+		HostedZoneID:                aws.StringValue(o.HostedZoneId),
+		MultiAZ:                     aws.BoolValue(o.MultiAZ),
+		ReplicationSourceIdentifier: aws.StringValue(o.ReplicationSourceIdentifier),
+	}
+	for _, m := range o.DBClusterMembers {
+		obs.Members = append(obs.Members, v1alpha1.DBClusterMember{
+			DBInstanceIdentifier: aws.StringValue(m.DBInstanceIdentifier),
+			IsClusterWriter:      aws.BoolValue(m.IsClusterWriter),
+			PromotionTier:        aws.Int64Value(m.PromotionTier),
+		})
+	}
+	return obs
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.DBClusterParameters with
+// the values seen in rds.DBCluster.
+func LateInitialize(in *v1alpha1.DBClusterParameters, o *rds.DBCluster) {
+	if o == nil {
+		return
+	}
+	in.EngineVersion = awsclients.LateInitializeStringPtr(in.EngineVersion, o.EngineVersion)
+	in.EngineMode = awsclients.LateInitializeStringPtr(in.EngineMode, o.EngineMode)
+	in.DatabaseName = awsclients.LateInitializeStringPtr(in.DatabaseName, o.DatabaseName)
+	in.Port = awsclients.LateInitializeInt64Ptr(in.Port, o.Port)
+	in.DBClusterParameterGroupName = awsclients.LateInitializeStringPtr(in.DBClusterParameterGroupName, o.DBClusterParameterGroup)
+	in.DBSubnetGroupName = awsclients.LateInitializeStringPtr(in.DBSubnetGroupName, o.DBSubnetGroup)
+	in.BackupRetentionPeriod = awsclients.LateInitializeInt64Ptr(in.BackupRetentionPeriod, o.BackupRetentionPeriod)
+	in.PreferredBackupWindow = awsclients.LateInitializeStringPtr(in.PreferredBackupWindow, o.PreferredBackupWindow)
+	in.PreferredMaintenanceWindow = awsclients.LateInitializeStringPtr(in.PreferredMaintenanceWindow, o.PreferredMaintenanceWindow)
+	in.StorageEncrypted = awsclients.LateInitializeBoolPtr(in.StorageEncrypted, o.StorageEncrypted)
+	in.KMSKeyID = awsclients.LateInitializeStringPtr(in.KMSKeyID, o.KmsKeyId)
+	in.EnableIAMDatabaseAuthentication = awsclients.LateInitializeBoolPtr(in.EnableIAMDatabaseAuthentication, o.IAMDatabaseAuthenticationEnabled)
+	in.CopyTagsToSnapshot = awsclients.LateInitializeBoolPtr(in.CopyTagsToSnapshot, o.CopyTagsToSnapshot)
+	in.DeletionProtection = awsclients.LateInitializeBoolPtr(in.DeletionProtection, o.DeletionProtection)
+	if len(in.AvailabilityZones) == 0 {
+		in.AvailabilityZones = o.AvailabilityZones
+	}
+	if len(in.VPCSecurityGroupIDs) == 0 {
+		in.VPCSecurityGroupIDs = observedSecurityGroupIDs(*o)
+	}
+}
+
+// IsUpToDate checks whether there is a change in any of the modifiable
+// fields.
+func IsUpToDate(p v1alpha1.DBClusterParameters, o rds.DBCluster) bool {
+	in := GenerateModifyDBClusterInput(aws.StringValue(o.DBClusterIdentifier), p, o)
+	return cmp.Equal(&rds.ModifyDBClusterInput{DBClusterIdentifier: in.DBClusterIdentifier, ApplyImmediately: in.ApplyImmediately}, in,
+		cmpopts.IgnoreUnexported(rds.ModifyDBClusterInput{}))
+}
+
+// GetPassword returns the master password referenced by the given DBCluster,
+// if any, and whether it differs from the password in its connection secret.
+func GetPassword(ctx context.Context, kube client.Client, cr *v1alpha1.DBCluster) (pwd string, changed bool, err error) {
+	ref := cr.Spec.ForProvider.MasterPasswordSecretRef
+	if ref == nil {
+		return "", false, nil
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", false, errors.Wrap(err, errGetPasswordSecret)
+	}
+	pwd = string(s.Data[ref.Key])
+
+	if cr.Spec.WriteConnectionSecretToReference != nil {
+		conn := &corev1.Secret{}
+		nn := types.NamespacedName{
+			Name:      cr.Spec.WriteConnectionSecretToReference.Name,
+			Namespace: cr.Spec.WriteConnectionSecretToReference.Namespace,
+		}
+		// The connection secret doesn't exist until the DB cluster is created.
+		if err := kube.Get(ctx, nn, conn); resource.IgnoreNotFound(err) != nil {
+			return "", false, err
+		}
+		changed = pwd != "" && pwd != string(conn.Data[runtimev1alpha1.ResourceCredentialsSecretPasswordKey])
+	}
+	return pwd, changed, nil
+}
+
+// GetConnectionDetails returns the connection details of the given
+// DBCluster, i.e. its writer endpoint, reader endpoint, port and master
+// username.
+func GetConnectionDetails(cr v1alpha1.DBCluster) managed.ConnectionDetails {
+	o := cr.Status.AtProvider
+	if o.Endpoint == "" {
+		return nil
+	}
+	cd := managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(o.Endpoint),
+		runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.FormatInt(o.Port, 10)),
+	}
+	if cr.Spec.ForProvider.MasterUsername != nil {
+		cd[runtimev1alpha1.ResourceCredentialsSecretUserKey] = []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername))
+	}
+	if o.ReaderEndpoint != "" {
+		cd[ConnectionDetailsReaderEndpoint] = []byte(o.ReaderEndpoint)
+	}
+	return cd
+}
+
+// isScalingConfigurationUpToDate returns false if any of the desired scaling
+// settings differs from the observed one. Unset settings are ignored.
+func isScalingConfigurationUpToDate(sc *v1alpha1.ScalingConfiguration, o *rds.ScalingConfigurationInfo) bool {
+	if sc == nil {
+		return true
+	}
+	if o == nil {
+		return false
+	}
+	switch {
+	case sc.MinCapacity != nil && aws.Int64Value(sc.MinCapacity) != aws.Int64Value(o.MinCapacity),
+		sc.MaxCapacity != nil && aws.Int64Value(sc.MaxCapacity) != aws.Int64Value(o.MaxCapacity),
+		sc.AutoPause != nil && aws.BoolValue(sc.AutoPause) != aws.BoolValue(o.AutoPause),
+		sc.SecondsUntilAutoPause != nil && aws.Int64Value(sc.SecondsUntilAutoPause) != aws.Int64Value(o.SecondsUntilAutoPause),
+		sc.TimeoutAction != nil && aws.StringValue(sc.TimeoutAction) != aws.StringValue(o.TimeoutAction):
+		return false
+	}
+	return true
+}
+
+func observedSecurityGroupIDs(o rds.DBCluster) []string {
+	if len(o.VpcSecurityGroups) == 0 {
+		return nil
+	}
+	res := make([]string, len(o.VpcSecurityGroups))
+	for i, sg := range o.VpcSecurityGroups {
+		res[i] = aws.StringValue(sg.VpcSecurityGroupId)
+	}
+	return res
+}
+
+// diffLogTypes returns the log types that need to be enabled and disabled
+// to export the desired log types.
+func diffLogTypes(desired, observed []string) (enable, disable []string) {
+	d := make(map[string]bool, len(desired))
+	for _, t := range desired {
+		d[t] = true
+	}
+	o := make(map[string]bool, len(observed))
+	for _, t := range observed {
+		o[t] = true
+		if !d[t] {
+			disable = append(disable, t)
+		}
+	}
+	for _, t := range desired {
+		if !o[t] {
+			enable = append(enable, t)
+		}
+	}
+	return enable, disable
+}
+
+func sortStrings() cmp.Option {
+	return cmpopts.SortSlices(func(a, b string) bool { return a < b })
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+var clusterName = "some-cluster"
+
+func observedCluster() rds.DBCluster {
+	return rds.DBCluster{
+		DBClusterIdentifier:     aws.String(clusterName),
+		Engine:                  aws.String("aurora-postgresql"),
+		EngineVersion:           aws.String("11.7"),
+		EngineMode:              aws.String("serverless"),
+		Port:                    aws.Int64(5432),
+		DBClusterParameterGroup: aws.String("default.aurora-postgresql11"),
+		BackupRetentionPeriod:   aws.Int64(1),
+		DeletionProtection:      aws.Bool(false),
+		ScalingConfigurationInfo: &rds.ScalingConfigurationInfo{
+			MinCapacity: aws.Int64(2),
+			MaxCapacity: aws.Int64(8),
+			AutoPause:   aws.Bool(true),
+		},
+		VpcSecurityGroups: []rds.VpcSecurityGroupMembership{
+			{VpcSecurityGroupId: aws.String("sg-1")},
+			{VpcSecurityGroupId: aws.String("sg-2")},
+		},
+	}
+}
+
+func TestGenerateCreateDBClusterInput(t *testing.T) {
+	cases := map[string]struct {
+		p   v1alpha1.DBClusterParameters
+		out *rds.CreateDBClusterInput
+	}{
+		"WithMasterUser": {
+			p: v1alpha1.DBClusterParameters{
+				Engine:         "aurora-mysql",
+				MasterUsername: aws.String("admin"),
+			},
+			out: &rds.CreateDBClusterInput{
+				DBClusterIdentifier: aws.String(clusterName),
+				Engine:              aws.String("aurora-mysql"),
+				MasterUsername:      aws.String("admin"),
+				MasterUserPassword:  aws.String("pwd"),
+			},
+		},
+		"GlobalSecondary": {
+			p: v1alpha1.DBClusterParameters{
+				Engine:                  "aurora-postgresql",
+				GlobalClusterIdentifier: aws.String("global"),
+			},
+			out: &rds.CreateDBClusterInput{
+				DBClusterIdentifier:     aws.String(clusterName),
+				Engine:                  aws.String("aurora-postgresql"),
+				GlobalClusterIdentifier: aws.String("global"),
+			},
+		},
+		"Serverless": {
+			p: v1alpha1.DBClusterParameters{
+				Engine:         "aurora-postgresql",
+				EngineMode:     aws.String("serverless"),
+				MasterUsername: aws.String("admin"),
+				ScalingConfiguration: &v1alpha1.ScalingConfiguration{
+					MinCapacity: aws.Int64(2),
+					MaxCapacity: aws.Int64(8),
+				},
+			},
+			out: &rds.CreateDBClusterInput{
+				DBClusterIdentifier: aws.String(clusterName),
+				Engine:              aws.String("aurora-postgresql"),
+				EngineMode:          aws.String("serverless"),
+				MasterUsername:      aws.String("admin"),
+				MasterUserPassword:  aws.String("pwd"),
+				ScalingConfiguration: &rds.ScalingConfiguration{
+					MinCapacity: aws.Int64(2),
+					MaxCapacity: aws.Int64(8),
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateDBClusterInput(clusterName, "pwd", tc.p)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyDBClusterInput(t *testing.T) {
+	cases := map[string]struct {
+		p   v1alpha1.DBClusterParameters
+		o   rds.DBCluster
+		out *rds.ModifyDBClusterInput
+	}{
+		"NoChange": {
+			p: v1alpha1.DBClusterParameters{
+				EngineVersion: aws.String("11.7"),
+				Port:          aws.Int64(5432),
+				ScalingConfiguration: &v1alpha1.ScalingConfiguration{
+					MinCapacity: aws.Int64(2),
+				},
+				VPCSecurityGroupIDs: []string{"sg-2", "sg-1"},
+			},
+			o:   observedCluster(),
+			out: &rds.ModifyDBClusterInput{DBClusterIdentifier: aws.String(clusterName)},
+		},
+		"ParameterGroupAndBackups": {
+			p: v1alpha1.DBClusterParameters{
+				BackupRetentionPeriod:       aws.Int64(7),
+				DBClusterParameterGroupName: aws.String("custom"),
+				ApplyImmediately:            aws.Bool(true),
+			},
+			o: observedCluster(),
+			out: &rds.ModifyDBClusterInput{
+				DBClusterIdentifier:         aws.String(clusterName),
+				ApplyImmediately:            aws.Bool(true),
+				BackupRetentionPeriod:       aws.Int64(7),
+				DBClusterParameterGroupName: aws.String("custom"),
+			},
+		},
+		"ScalingChanged": {
+			p: v1alpha1.DBClusterParameters{
+				ScalingConfiguration: &v1alpha1.ScalingConfiguration{
+					MinCapacity: aws.Int64(2),
+					MaxCapacity: aws.Int64(16),
+				},
+			},
+			o: observedCluster(),
+			out: &rds.ModifyDBClusterInput{
+				DBClusterIdentifier: aws.String(clusterName),
+				ScalingConfiguration: &rds.ScalingConfiguration{
+					MinCapacity: aws.Int64(2),
+					MaxCapacity: aws.Int64(16),
+				},
+			},
+		},
+		"EnableLogs": {
+			p: v1alpha1.DBClusterParameters{
+				EnableCloudwatchLogsExports: []string{"postgresql"},
+			},
+			o: observedCluster(),
+			out: &rds.ModifyDBClusterInput{
+				DBClusterIdentifier: aws.String(clusterName),
+				CloudwatchLogsExportConfiguration: &rds.CloudwatchLogsExportConfiguration{
+					EnableLogTypes: []string{"postgresql"},
+				},
+			},
+		},
+		"SecurityGroupsChanged": {
+			p: v1alpha1.DBClusterParameters{
+				VPCSecurityGroupIDs: []string{"sg-3"},
+			},
+			o: observedCluster(),
+			out: &rds.ModifyDBClusterInput{
+				DBClusterIdentifier: aws.String(clusterName),
+				VpcSecurityGroupIds: []string{"sg-3"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyDBClusterInput(clusterName, tc.p, tc.o)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DBClusterParameters
+		o    rds.DBCluster
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.DBClusterParameters{
+				Port:             aws.Int64(5432),
+				ApplyImmediately: aws.Bool(true),
+			},
+			o:    observedCluster(),
+			want: true,
+		},
+		"EngineVersionChanged": {
+			p: v1alpha1.DBClusterParameters{
+				EngineVersion: aws.String("11.8"),
+			},
+			o:    observedCluster(),
+			want: false,
+		},
+		"AutoPauseChanged": {
+			p: v1alpha1.DBClusterParameters{
+				ScalingConfiguration: &v1alpha1.ScalingConfiguration{
+					AutoPause: aws.Bool(false),
+				},
+			},
+			o:    observedCluster(),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DBClusterParameters
+		o    v1alpha1.DBClusterObservation
+		want managed.ConnectionDetails
+	}{
+		"NoEndpoint": {
+			o:    v1alpha1.DBClusterObservation{},
+			want: nil,
+		},
+		"Endpoints": {
+			p: v1alpha1.DBClusterParameters{MasterUsername: aws.String("admin")},
+			o: v1alpha1.DBClusterObservation{
+				Endpoint:       "cluster.rds.amazonaws.com",
+				ReaderEndpoint: "cluster-ro.rds.amazonaws.com",
+				Port:           5432,
+			},
+			want: managed.ConnectionDetails{
+				runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte("cluster.rds.amazonaws.com"),
+				runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte("5432"),
+				runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte("admin"),
+				ConnectionDetailsReaderEndpoint:                      []byte("cluster-ro.rds.amazonaws.com"),
+			},
+		},
+		"NoMasterUser": {
+			o: v1alpha1.DBClusterObservation{
+				Endpoint: "cluster.rds.amazonaws.com",
+				Port:     5432,
+			},
+			want: managed.ConnectionDetails{
+				runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte("cluster.rds.amazonaws.com"),
+				runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte("5432"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetConnectionDetails(v1alpha1.DBCluster{
+				Spec:   v1alpha1.DBClusterSpec{ForProvider: tc.p},
+				Status: v1alpha1.DBClusterStatus{AtProvider: tc.o},
+			})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/dbcluster"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockDBClusterClient)(nil)
+
+// MockDBClusterClient is a type that implements all the methods for Client interface
+type MockDBClusterClient struct {
+	MockDescribeDBClusters           func(*rds.DescribeDBClustersInput) rds.DescribeDBClustersRequest
+	MockCreateDBCluster              func(*rds.CreateDBClusterInput) rds.CreateDBClusterRequest
+	MockRestoreDBClusterFromSnapshot func(*rds.RestoreDBClusterFromSnapshotInput) rds.RestoreDBClusterFromSnapshotRequest
+	MockModifyDBCluster              func(*rds.ModifyDBClusterInput) rds.ModifyDBClusterRequest
+	MockDeleteDBCluster              func(*rds.DeleteDBClusterInput) rds.DeleteDBClusterRequest
+	MockListTagsForResource          func(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	MockAddTagsToResource            func(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	MockRemoveTagsFromResource       func(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// DescribeDBClustersRequest mocks DescribeDBClustersRequest method
+func (m *MockDBClusterClient) DescribeDBClustersRequest(input *rds.DescribeDBClustersInput) rds.DescribeDBClustersRequest {
+	return m.MockDescribeDBClusters(input)
+}
+
+// CreateDBClusterRequest mocks CreateDBClusterRequest method
+func (m *MockDBClusterClient) CreateDBClusterRequest(input *rds.CreateDBClusterInput) rds.CreateDBClusterRequest {
+	return m.MockCreateDBCluster(input)
+}
+
+// RestoreDBClusterFromSnapshotRequest mocks RestoreDBClusterFromSnapshotRequest method
+func (m *MockDBClusterClient) RestoreDBClusterFromSnapshotRequest(input *rds.RestoreDBClusterFromSnapshotInput) rds.RestoreDBClusterFromSnapshotRequest {
+	return m.MockRestoreDBClusterFromSnapshot(input)
+}
+
+// ModifyDBClusterRequest mocks ModifyDBClusterRequest method
+func (m *MockDBClusterClient) ModifyDBClusterRequest(input *rds.ModifyDBClusterInput) rds.ModifyDBClusterRequest {
+	return m.MockModifyDBCluster(input)
+}
+
+// DeleteDBClusterRequest mocks DeleteDBClusterRequest method
+func (m *MockDBClusterClient) DeleteDBClusterRequest(input *rds.DeleteDBClusterInput) rds.DeleteDBClusterRequest {
+	return m.MockDeleteDBCluster(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockDBClusterClient) ListTagsForResourceRequest(input *rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// AddTagsToResourceRequest mocks AddTagsToResourceRequest method
+func (m *MockDBClusterClient) AddTagsToResourceRequest(input *rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest {
+	return m.MockAddTagsToResource(input)
+}
+
+// RemoveTagsFromResourceRequest mocks RemoveTagsFromResourceRequest method
+func (m *MockDBClusterClient) RemoveTagsFromResourceRequest(input *rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest {
+	return m.MockRemoveTagsFromResource(input)
+}
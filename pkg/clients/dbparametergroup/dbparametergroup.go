@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbparametergroup
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// SourceUser is the source of the parameters that are modified from
+	// their default value.
+	SourceUser = "user"
+
+	// applyTypeStatic is the apply type of parameters that are applied on
+	// the next reboot only.
+	applyTypeStatic = "static"
+)
+
+// Client is the external client used for DBParameterGroup Custom Resource
+type Client interface {
+	DescribeDBParameterGroupsRequest(*rds.DescribeDBParameterGroupsInput) rds.DescribeDBParameterGroupsRequest
+	CreateDBParameterGroupRequest(*rds.CreateDBParameterGroupInput) rds.CreateDBParameterGroupRequest
+	DeleteDBParameterGroupRequest(*rds.DeleteDBParameterGroupInput) rds.DeleteDBParameterGroupRequest
+	DescribeDBParametersRequest(*rds.DescribeDBParametersInput) rds.DescribeDBParametersRequest
+	ModifyDBParameterGroupRequest(*rds.ModifyDBParameterGroupInput) rds.ModifyDBParameterGroupRequest
+	ResetDBParameterGroupRequest(*rds.ResetDBParameterGroupInput) rds.ResetDBParameterGroupRequest
+	ListTagsForResourceRequest(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	AddTagsToResourceRequest(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	RemoveTagsFromResourceRequest(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return rds.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the DB parameter group
+// doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == rds.ErrCodeDBParameterGroupNotFoundFault {
+		return true
+	}
+	return false
+}
+
+// GenerateTags returns the RDS tags of the given tags.
+func GenerateTags(tags []v1beta1.Tag) []rds.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]rds.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = rds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from an RDS resource.
+func DiffTags(desired []v1beta1.Tag, observed []rds.Tag) (add []rds.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, rds.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
+}
+
+// GenerateCreateDBParameterGroupInput returns the create input of a DB
+// parameter group with the given name and parameters. The engine parameters
+// can't be set on creation and are applied by a subsequent update.
+func GenerateCreateDBParameterGroupInput(name string, p v1beta1.DBParameterGroupParameters) *rds.CreateDBParameterGroupInput {
+	return &rds.CreateDBParameterGroupInput{
+		DBParameterGroupName:   aws.String(name),
+		DBParameterGroupFamily: aws.String(p.DBParameterGroupFamily),
+		Description:            aws.String(p.Description),
+		Tags:                   GenerateTags(p.Tags),
+	}
+}
+
+// GenerateObservation is used to produce v1beta1.DBParameterGroupObservation
+// from rds.DBParameterGroup.
+func GenerateObservation(o rds.DBParameterGroup) v1beta1.DBParameterGroupObservation {
+	return v1beta1.DBParameterGroupObservation{
+		ARN: aws.StringValue(o.DBParameterGroupArn),
+	}
+}
+
+// DiffParameters returns the parameters that need to be modified and the
+// ones that need to be reset to their default value so that the observed
+// user parameters of a DB parameter group match the desired ones.
+func DiffParameters(desired []v1beta1.Parameter, observed []rds.Parameter) (modify, reset []rds.Parameter) {
+	o := make(map[string]string, len(observed))
+	for _, p := range observed {
+		o[aws.StringValue(p.ParameterName)] = aws.StringValue(p.ParameterValue)
+	}
+	d := make(map[string]bool, len(desired))
+	for _, p := range desired {
+		d[p.ParameterName] = true
+		if v, ok := o[p.ParameterName]; ok && v == p.ParameterValue {
+			continue
+		}
+		method := rds.ApplyMethodImmediate
+		if p.ApplyMethod != nil {
+			method = rds.ApplyMethod(aws.StringValue(p.ApplyMethod))
+		}
+		modify = append(modify, rds.Parameter{
+			ParameterName:  aws.String(p.ParameterName),
+			ParameterValue: aws.String(p.ParameterValue),
+			ApplyMethod:    method,
+		})
+	}
+	for _, p := range observed {
+		if d[aws.StringValue(p.ParameterName)] {
+			continue
+		}
+		method := rds.ApplyMethodImmediate
+		if aws.StringValue(p.ApplyType) == applyTypeStatic {
+			method = rds.ApplyMethodPendingReboot
+		}
+		reset = append(reset, rds.Parameter{
+			ParameterName: p.ParameterName,
+			ApplyMethod:   method,
+		})
+	}
+	return modify, reset
+}
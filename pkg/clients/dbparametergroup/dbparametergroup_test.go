@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbparametergroup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+)
+
+func TestDiffParameters(t *testing.T) {
+	type want struct {
+		modify []rds.Parameter
+		reset  []rds.Parameter
+	}
+	cases := map[string]struct {
+		desired  []v1beta1.Parameter
+		observed []rds.Parameter
+		want
+	}{
+		"UpToDate": {
+			desired: []v1beta1.Parameter{
+				{ParameterName: "max_connections", ParameterValue: "100"},
+			},
+			observed: []rds.Parameter{
+				{ParameterName: aws.String("max_connections"), ParameterValue: aws.String("100")},
+			},
+		},
+		"Modify": {
+			desired: []v1beta1.Parameter{
+				{ParameterName: "max_connections", ParameterValue: "200"},
+				{ParameterName: "innodb_buffer_pool_size", ParameterValue: "1073741824", ApplyMethod: aws.String("pending-reboot")},
+			},
+			observed: []rds.Parameter{
+				{ParameterName: aws.String("max_connections"), ParameterValue: aws.String("100")},
+			},
+			want: want{
+				modify: []rds.Parameter{
+					{ParameterName: aws.String("max_connections"), ParameterValue: aws.String("200"), ApplyMethod: rds.ApplyMethodImmediate},
+					{ParameterName: aws.String("innodb_buffer_pool_size"), ParameterValue: aws.String("1073741824"), ApplyMethod: rds.ApplyMethodPendingReboot},
+				},
+			},
+		},
+		"Reset": {
+			observed: []rds.Parameter{
+				{ParameterName: aws.String("max_connections"), ParameterValue: aws.String("100"), ApplyType: aws.String("dynamic")},
+				{ParameterName: aws.String("innodb_buffer_pool_size"), ParameterValue: aws.String("1073741824"), ApplyType: aws.String("static")},
+			},
+			want: want{
+				reset: []rds.Parameter{
+					{ParameterName: aws.String("max_connections"), ApplyMethod: rds.ApplyMethodImmediate},
+					{ParameterName: aws.String("innodb_buffer_pool_size"), ApplyMethod: rds.ApplyMethodPendingReboot},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			modify, reset := DiffParameters(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.modify, modify); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reset, reset); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/dbparametergroup"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockDBParameterGroupClient)(nil)
+
+// MockDBParameterGroupClient is a type that implements all the methods for Client interface
+type MockDBParameterGroupClient struct {
+	MockDescribeDBParameterGroups func(*rds.DescribeDBParameterGroupsInput) rds.DescribeDBParameterGroupsRequest
+	MockCreateDBParameterGroup    func(*rds.CreateDBParameterGroupInput) rds.CreateDBParameterGroupRequest
+	MockDeleteDBParameterGroup    func(*rds.DeleteDBParameterGroupInput) rds.DeleteDBParameterGroupRequest
+	MockDescribeDBParameters      func(*rds.DescribeDBParametersInput) rds.DescribeDBParametersRequest
+	MockModifyDBParameterGroup    func(*rds.ModifyDBParameterGroupInput) rds.ModifyDBParameterGroupRequest
+	MockResetDBParameterGroup     func(*rds.ResetDBParameterGroupInput) rds.ResetDBParameterGroupRequest
+	MockListTagsForResource       func(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	MockAddTagsToResource         func(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	MockRemoveTagsFromResource    func(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// DescribeDBParameterGroupsRequest mocks DescribeDBParameterGroupsRequest method
+func (m *MockDBParameterGroupClient) DescribeDBParameterGroupsRequest(input *rds.DescribeDBParameterGroupsInput) rds.DescribeDBParameterGroupsRequest {
+	return m.MockDescribeDBParameterGroups(input)
+}
+
+// CreateDBParameterGroupRequest mocks CreateDBParameterGroupRequest method
+func (m *MockDBParameterGroupClient) CreateDBParameterGroupRequest(input *rds.CreateDBParameterGroupInput) rds.CreateDBParameterGroupRequest {
+	return m.MockCreateDBParameterGroup(input)
+}
+
+// DeleteDBParameterGroupRequest mocks DeleteDBParameterGroupRequest method
+func (m *MockDBParameterGroupClient) DeleteDBParameterGroupRequest(input *rds.DeleteDBParameterGroupInput) rds.DeleteDBParameterGroupRequest {
+	return m.MockDeleteDBParameterGroup(input)
+}
+
+// DescribeDBParametersRequest mocks DescribeDBParametersRequest method
+func (m *MockDBParameterGroupClient) DescribeDBParametersRequest(input *rds.DescribeDBParametersInput) rds.DescribeDBParametersRequest {
+	return m.MockDescribeDBParameters(input)
+}
+
+// ModifyDBParameterGroupRequest mocks ModifyDBParameterGroupRequest method
+func (m *MockDBParameterGroupClient) ModifyDBParameterGroupRequest(input *rds.ModifyDBParameterGroupInput) rds.ModifyDBParameterGroupRequest {
+	return m.MockModifyDBParameterGroup(input)
+}
+
+// ResetDBParameterGroupRequest mocks ResetDBParameterGroupRequest method
+func (m *MockDBParameterGroupClient) ResetDBParameterGroupRequest(input *rds.ResetDBParameterGroupInput) rds.ResetDBParameterGroupRequest {
+	return m.MockResetDBParameterGroup(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockDBParameterGroupClient) ListTagsForResourceRequest(input *rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// AddTagsToResourceRequest mocks AddTagsToResourceRequest method
+func (m *MockDBParameterGroupClient) AddTagsToResourceRequest(input *rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest {
+	return m.MockAddTagsToResource(input)
+}
+
+// RemoveTagsFromResourceRequest mocks RemoveTagsFromResourceRequest method
+func (m *MockDBParameterGroupClient) RemoveTagsFromResourceRequest(input *rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest {
+	return m.MockRemoveTagsFromResource(input)
+}
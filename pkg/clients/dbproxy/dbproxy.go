@@ -0,0 +1,222 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbproxy
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client is the external client used for DBProxy Custom Resource
+type Client interface {
+	DescribeDBProxiesRequest(*rds.DescribeDBProxiesInput) rds.DescribeDBProxiesRequest
+	CreateDBProxyRequest(*rds.CreateDBProxyInput) rds.CreateDBProxyRequest
+	ModifyDBProxyRequest(*rds.ModifyDBProxyInput) rds.ModifyDBProxyRequest
+	DeleteDBProxyRequest(*rds.DeleteDBProxyInput) rds.DeleteDBProxyRequest
+	ListTagsForResourceRequest(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	AddTagsToResourceRequest(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	RemoveTagsFromResourceRequest(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return rds.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the DB proxy doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == rds.ErrCodeDBProxyNotFoundFault {
+		return true
+	}
+	return false
+}
+
+// GenerateTags returns the RDS tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) []rds.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]rds.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = rds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from an RDS resource.
+func DiffTags(desired []v1alpha1.Tag, observed []rds.Tag) (add []rds.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, rds.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
+}
+
+// GenerateAuth returns the RDS user authentication configurations of the
+// given ones.
+func GenerateAuth(in []v1alpha1.UserAuthConfig) []rds.UserAuthConfig {
+	if len(in) == 0 {
+		return nil
+	}
+	res := make([]rds.UserAuthConfig, len(in))
+	for i, a := range in {
+		res[i] = rds.UserAuthConfig{
+			AuthScheme:  rds.AuthScheme(aws.StringValue(a.AuthScheme)),
+			Description: a.Description,
+			IAMAuth:     rds.IAMAuthMode(aws.StringValue(a.IAMAuth)),
+			SecretArn:   aws.String(a.SecretARN),
+			UserName:    a.UserName,
+		}
+	}
+	return res
+}
+
+// GenerateCreateDBProxyInput returns the create input of a DB proxy with the
+// given name and parameters.
+func GenerateCreateDBProxyInput(name string, p v1alpha1.DBProxyParameters) *rds.CreateDBProxyInput {
+	return &rds.CreateDBProxyInput{
+		DBProxyName:         aws.String(name),
+		EngineFamily:        rds.EngineFamily(p.EngineFamily),
+		Auth:                GenerateAuth(p.Auth),
+		RoleArn:             p.RoleARN,
+		VpcSubnetIds:        p.VPCSubnetIDs,
+		VpcSecurityGroupIds: p.VPCSecurityGroupIDs,
+		IdleClientTimeout:   p.IdleClientTimeout,
+		RequireTLS:          p.RequireTLS,
+		DebugLogging:        p.DebugLogging,
+		Tags:                GenerateTags(p.Tags),
+	}
+}
+
+// GenerateModifyDBProxyInput returns the modify input that changes the given
+// observed DB proxy into the desired one. Only the fields that differ are
+// set.
+func GenerateModifyDBProxyInput(name string, p v1alpha1.DBProxyParameters, o rds.DBProxy) *rds.ModifyDBProxyInput {
+	in := &rds.ModifyDBProxyInput{DBProxyName: aws.String(name)}
+	if !isAuthUpToDate(p.Auth, o.Auth) {
+		in.Auth = GenerateAuth(p.Auth)
+	}
+	if p.RoleARN != nil && aws.StringValue(p.RoleARN) != aws.StringValue(o.RoleArn) {
+		in.RoleArn = p.RoleARN
+	}
+	if p.IdleClientTimeout != nil && aws.Int64Value(p.IdleClientTimeout) != aws.Int64Value(o.IdleClientTimeout) {
+		in.IdleClientTimeout = p.IdleClientTimeout
+	}
+	if p.RequireTLS != nil && aws.BoolValue(p.RequireTLS) != aws.BoolValue(o.RequireTLS) {
+		in.RequireTLS = p.RequireTLS
+	}
+	if p.DebugLogging != nil && aws.BoolValue(p.DebugLogging) != aws.BoolValue(o.DebugLogging) {
+		in.DebugLogging = p.DebugLogging
+	}
+	if len(p.VPCSecurityGroupIDs) != 0 && !cmp.Equal(p.VPCSecurityGroupIDs, o.VpcSecurityGroupIds, sortStrings()) {
+		in.SecurityGroups = p.VPCSecurityGroupIDs
+	}
+	return in
+}
+
+// IsUpToDate checks whether there is a change in any of the modifiable
+// fields.
+func IsUpToDate(p v1alpha1.DBProxyParameters, o rds.DBProxy) bool {
+	in := GenerateModifyDBProxyInput(aws.StringValue(o.DBProxyName), p, o)
+	return cmp.Equal(&rds.ModifyDBProxyInput{DBProxyName: in.DBProxyName}, in,
+		cmpopts.IgnoreUnexported(rds.ModifyDBProxyInput{}))
+}
+
+// GenerateObservation is used to produce v1alpha1.DBProxyObservation from
+// rds.DBProxy.
+func GenerateObservation(o rds.DBProxy) v1alpha1.DBProxyObservation {
+	return v1alpha1.DBProxyObservation{
+		ARN:      aws.StringValue(o.DBProxyArn),
+		Status:   string(o.Status),
+		Endpoint: aws.StringValue(o.Endpoint),
+	}
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.DBProxyParameters with
+// the values seen in rds.DBProxy.
+func LateInitialize(in *v1alpha1.DBProxyParameters, o *rds.DBProxy) {
+	if o == nil {
+		return
+	}
+	in.RoleARN = awsclients.LateInitializeStringPtr(in.RoleARN, o.RoleArn)
+	in.IdleClientTimeout = awsclients.LateInitializeInt64Ptr(in.IdleClientTimeout, o.IdleClientTimeout)
+	in.RequireTLS = awsclients.LateInitializeBoolPtr(in.RequireTLS, o.RequireTLS)
+	in.DebugLogging = awsclients.LateInitializeBoolPtr(in.DebugLogging, o.DebugLogging)
+	if len(in.VPCSecurityGroupIDs) == 0 {
+		in.VPCSecurityGroupIDs = o.VpcSecurityGroupIds
+	}
+}
+
+// GetConnectionDetails returns the connection details of the given DBProxy,
+// i.e. its endpoint.
+func GetConnectionDetails(cr v1alpha1.DBProxy) managed.ConnectionDetails {
+	if cr.Status.AtProvider.Endpoint == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.Endpoint),
+	}
+}
+
+// isAuthUpToDate returns false if the desired and observed authentication
+// configurations don't match by secret ARN, or if any of the desired
+// settings of a configuration differs from the observed one. Unset settings
+// are ignored.
+func isAuthUpToDate(desired []v1alpha1.UserAuthConfig, observed []rds.UserAuthConfigInfo) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	o := make(map[string]rds.UserAuthConfigInfo, len(observed))
+	for _, a := range observed {
+		o[aws.StringValue(a.SecretArn)] = a
+	}
+	for _, d := range desired {
+		obs, ok := o[d.SecretARN]
+		switch {
+		case !ok,
+			d.AuthScheme != nil && aws.StringValue(d.AuthScheme) != string(obs.AuthScheme),
+			d.IAMAuth != nil && aws.StringValue(d.IAMAuth) != string(obs.IAMAuth),
+			d.Description != nil && aws.StringValue(d.Description) != aws.StringValue(obs.Description),
+			d.UserName != nil && aws.StringValue(d.UserName) != aws.StringValue(obs.UserName):
+			return false
+		}
+	}
+	return true
+}
+
+func sortStrings() cmp.Option {
+	return cmpopts.SortSlices(func(a, b string) bool { return a < b })
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbproxy
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+var (
+	proxyName = "some-proxy"
+	secretARN = "arn:aws:secretsmanager:us-east-1:123456789012:secret:db"
+	roleARN   = "arn:aws:iam::123456789012:role/proxy"
+)
+
+func proxy() rds.DBProxy {
+	return rds.DBProxy{
+		DBProxyName: aws.String(proxyName),
+		Auth: []rds.UserAuthConfigInfo{{
+			AuthScheme: rds.AuthSchemeSecrets,
+			IAMAuth:    rds.IAMAuthModeDisabled,
+			SecretArn:  aws.String(secretARN),
+		}},
+		RoleArn:             aws.String(roleARN),
+		IdleClientTimeout:   aws.Int64(1800),
+		RequireTLS:          aws.Bool(false),
+		DebugLogging:        aws.Bool(false),
+		VpcSecurityGroupIds: []string{"sg-1", "sg-2"},
+	}
+}
+
+func TestGenerateModifyDBProxyInput(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.DBProxyParameters
+		o        rds.DBProxy
+		want     *rds.ModifyDBProxyInput
+		upToDate bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.DBProxyParameters{
+				Auth:                []v1alpha1.UserAuthConfig{{SecretARN: secretARN}},
+				RoleARN:             aws.String(roleARN),
+				IdleClientTimeout:   aws.Int64(1800),
+				VPCSecurityGroupIDs: []string{"sg-2", "sg-1"},
+			},
+			o:        proxy(),
+			want:     &rds.ModifyDBProxyInput{DBProxyName: aws.String(proxyName)},
+			upToDate: true,
+		},
+		"Changed": {
+			p: v1alpha1.DBProxyParameters{
+				Auth:                []v1alpha1.UserAuthConfig{{SecretARN: secretARN, IAMAuth: aws.String("REQUIRED")}},
+				IdleClientTimeout:   aws.Int64(600),
+				RequireTLS:          aws.Bool(true),
+				VPCSecurityGroupIDs: []string{"sg-3"},
+			},
+			o: proxy(),
+			want: &rds.ModifyDBProxyInput{
+				DBProxyName: aws.String(proxyName),
+				Auth: []rds.UserAuthConfig{{
+					IAMAuth:   rds.IAMAuthModeRequired,
+					SecretArn: aws.String(secretARN),
+				}},
+				IdleClientTimeout: aws.Int64(600),
+				RequireTLS:        aws.Bool(true),
+				SecurityGroups:    []string{"sg-3"},
+			},
+		},
+		"AuthRemoved": {
+			p: v1alpha1.DBProxyParameters{
+				Auth: []v1alpha1.UserAuthConfig{{SecretARN: "other"}},
+			},
+			o: proxy(),
+			want: &rds.ModifyDBProxyInput{
+				DBProxyName: aws.String(proxyName),
+				Auth:        []rds.UserAuthConfig{{SecretArn: aws.String("other")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyDBProxyInput(proxyName, tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.upToDate, IsUpToDate(tc.p, tc.o)); diff != "" {
+				t.Errorf("IsUpToDate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
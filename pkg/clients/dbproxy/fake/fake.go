@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/dbproxy"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockDBProxyClient)(nil)
+
+// MockDBProxyClient is a type that implements all the methods for Client interface
+type MockDBProxyClient struct {
+	MockDescribeDBProxies      func(*rds.DescribeDBProxiesInput) rds.DescribeDBProxiesRequest
+	MockCreateDBProxy          func(*rds.CreateDBProxyInput) rds.CreateDBProxyRequest
+	MockModifyDBProxy          func(*rds.ModifyDBProxyInput) rds.ModifyDBProxyRequest
+	MockDeleteDBProxy          func(*rds.DeleteDBProxyInput) rds.DeleteDBProxyRequest
+	MockListTagsForResource    func(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	MockAddTagsToResource      func(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	MockRemoveTagsFromResource func(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// DescribeDBProxiesRequest mocks DescribeDBProxiesRequest method
+func (m *MockDBProxyClient) DescribeDBProxiesRequest(input *rds.DescribeDBProxiesInput) rds.DescribeDBProxiesRequest {
+	return m.MockDescribeDBProxies(input)
+}
+
+// CreateDBProxyRequest mocks CreateDBProxyRequest method
+func (m *MockDBProxyClient) CreateDBProxyRequest(input *rds.CreateDBProxyInput) rds.CreateDBProxyRequest {
+	return m.MockCreateDBProxy(input)
+}
+
+// ModifyDBProxyRequest mocks ModifyDBProxyRequest method
+func (m *MockDBProxyClient) ModifyDBProxyRequest(input *rds.ModifyDBProxyInput) rds.ModifyDBProxyRequest {
+	return m.MockModifyDBProxy(input)
+}
+
+// DeleteDBProxyRequest mocks DeleteDBProxyRequest method
+func (m *MockDBProxyClient) DeleteDBProxyRequest(input *rds.DeleteDBProxyInput) rds.DeleteDBProxyRequest {
+	return m.MockDeleteDBProxy(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockDBProxyClient) ListTagsForResourceRequest(input *rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// AddTagsToResourceRequest mocks AddTagsToResourceRequest method
+func (m *MockDBProxyClient) AddTagsToResourceRequest(input *rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest {
+	return m.MockAddTagsToResource(input)
+}
+
+// RemoveTagsFromResourceRequest mocks RemoveTagsFromResourceRequest method
+func (m *MockDBProxyClient) RemoveTagsFromResourceRequest(input *rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest {
+	return m.MockRemoveTagsFromResource(input)
+}
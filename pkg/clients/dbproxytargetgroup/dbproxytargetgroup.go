@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbproxytargetgroup
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+// Client is the external client used for DBProxyTargetGroup Custom Resource
+type Client interface {
+	DescribeDBProxyTargetGroupsRequest(*rds.DescribeDBProxyTargetGroupsInput) rds.DescribeDBProxyTargetGroupsRequest
+	ModifyDBProxyTargetGroupRequest(*rds.ModifyDBProxyTargetGroupInput) rds.ModifyDBProxyTargetGroupRequest
+	DescribeDBProxyTargetsRequest(*rds.DescribeDBProxyTargetsInput) rds.DescribeDBProxyTargetsRequest
+	RegisterDBProxyTargetsRequest(*rds.RegisterDBProxyTargetsInput) rds.RegisterDBProxyTargetsRequest
+	DeregisterDBProxyTargetsRequest(*rds.DeregisterDBProxyTargetsInput) rds.DeregisterDBProxyTargetsRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return rds.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the target group or its
+// DB proxy doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch awsErr.Code() {
+		case rds.ErrCodeDBProxyTargetGroupNotFoundFault, rds.ErrCodeDBProxyNotFoundFault:
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateConnectionPoolConfiguration returns the RDS connection pool
+// configuration of the given one.
+func GenerateConnectionPoolConfiguration(c *v1alpha1.ConnectionPoolConfiguration) *rds.ConnectionPoolConfiguration {
+	if c == nil {
+		return nil
+	}
+	return &rds.ConnectionPoolConfiguration{
+		ConnectionBorrowTimeout:   c.ConnectionBorrowTimeout,
+		InitQuery:                 c.InitQuery,
+		MaxConnectionsPercent:     c.MaxConnectionsPercent,
+		MaxIdleConnectionsPercent: c.MaxIdleConnectionsPercent,
+		SessionPinningFilters:     c.SessionPinningFilters,
+	}
+}
+
+// IsConnectionPoolUpToDate returns false if any of the desired connection
+// pool settings differs from the observed one. Unset settings are ignored.
+func IsConnectionPoolUpToDate(c *v1alpha1.ConnectionPoolConfiguration, o *rds.ConnectionPoolConfigurationInfo) bool {
+	if c == nil {
+		return true
+	}
+	if o == nil {
+		return false
+	}
+	switch {
+	case c.ConnectionBorrowTimeout != nil && aws.Int64Value(c.ConnectionBorrowTimeout) != aws.Int64Value(o.ConnectionBorrowTimeout),
+		c.InitQuery != nil && aws.StringValue(c.InitQuery) != aws.StringValue(o.InitQuery),
+		c.MaxConnectionsPercent != nil && aws.Int64Value(c.MaxConnectionsPercent) != aws.Int64Value(o.MaxConnectionsPercent),
+		c.MaxIdleConnectionsPercent != nil && aws.Int64Value(c.MaxIdleConnectionsPercent) != aws.Int64Value(o.MaxIdleConnectionsPercent),
+		c.SessionPinningFilters != nil && !cmp.Equal(c.SessionPinningFilters, o.SessionPinningFilters, cmpopts.EquateEmpty(), sortStrings()):
+		return false
+	}
+	return true
+}
+
+// GenerateObservation is used to produce
+// v1alpha1.DBProxyTargetGroupObservation from rds.DBProxyTargetGroup.
+func GenerateObservation(o rds.DBProxyTargetGroup) v1alpha1.DBProxyTargetGroupObservation {
+	return v1alpha1.DBProxyTargetGroupObservation{
+		TargetGroupARN: aws.StringValue(o.TargetGroupArn),
+		Status:         aws.StringValue(o.Status),
+		IsDefault:      aws.BoolValue(o.IsDefault),
+	}
+}
+
+// Targets are the DB instances and clusters registered with a target group.
+type Targets struct {
+	DBInstanceIdentifiers []string
+	DBClusterIdentifiers  []string
+}
+
+// Empty returns true if there are no targets.
+func (t Targets) Empty() bool {
+	return len(t.DBInstanceIdentifiers) == 0 && len(t.DBClusterIdentifiers) == 0
+}
+
+// ObservedTargets returns the DB instances and clusters that the given
+// proxy targets belong to. The instances of a registered DB cluster are
+// tracked by RDS and not reported as DB instance targets.
+func ObservedTargets(observed []rds.DBProxyTarget) Targets {
+	t := Targets{}
+	for _, o := range observed {
+		switch o.Type {
+		case rds.TargetTypeTrackedCluster:
+			t.DBClusterIdentifiers = append(t.DBClusterIdentifiers, aws.StringValue(o.TrackedClusterId))
+		case rds.TargetTypeRdsInstance:
+			if o.TrackedClusterId == nil {
+				t.DBInstanceIdentifiers = append(t.DBInstanceIdentifiers, aws.StringValue(o.RdsResourceId))
+			}
+		}
+	}
+	return t
+}
+
+// DiffTargets returns the targets that need to be registered with and
+// deregistered from a target group so that its observed targets match the
+// desired ones.
+func DiffTargets(p v1alpha1.DBProxyTargetGroupParameters, observed []rds.DBProxyTarget) (register, deregister Targets) {
+	o := ObservedTargets(observed)
+	register.DBInstanceIdentifiers, deregister.DBInstanceIdentifiers = diff(p.DBInstanceIdentifiers, o.DBInstanceIdentifiers)
+	register.DBClusterIdentifiers, deregister.DBClusterIdentifiers = diff(p.DBClusterIdentifiers, o.DBClusterIdentifiers)
+	return register, deregister
+}
+
+func diff(desired, observed []string) (add, remove []string) {
+	d := make(map[string]bool, len(desired))
+	for _, s := range desired {
+		d[s] = true
+	}
+	o := make(map[string]bool, len(observed))
+	for _, s := range observed {
+		o[s] = true
+		if !d[s] {
+			remove = append(remove, s)
+		}
+	}
+	for _, s := range desired {
+		if !o[s] {
+			add = append(add, s)
+		}
+	}
+	return add, remove
+}
+
+func sortStrings() cmp.Option {
+	return cmpopts.SortSlices(func(a, b string) bool { return a < b })
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbproxytargetgroup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+func TestDiffTargets(t *testing.T) {
+	type want struct {
+		register   Targets
+		deregister Targets
+	}
+
+	observed := []rds.DBProxyTarget{
+		{Type: rds.TargetTypeRdsInstance, RdsResourceId: aws.String("db-1")},
+		{Type: rds.TargetTypeTrackedCluster, TrackedClusterId: aws.String("cluster-1")},
+		{Type: rds.TargetTypeRdsInstance, RdsResourceId: aws.String("cluster-1-instance-1"), TrackedClusterId: aws.String("cluster-1")},
+	}
+
+	cases := map[string]struct {
+		p        v1alpha1.DBProxyTargetGroupParameters
+		observed []rds.DBProxyTarget
+		want     want
+	}{
+		"UpToDate": {
+			p: v1alpha1.DBProxyTargetGroupParameters{
+				DBInstanceIdentifiers: []string{"db-1"},
+				DBClusterIdentifiers:  []string{"cluster-1"},
+			},
+			observed: observed,
+		},
+		"Changed": {
+			p: v1alpha1.DBProxyTargetGroupParameters{
+				DBInstanceIdentifiers: []string{"db-2"},
+			},
+			observed: observed,
+			want: want{
+				register:   Targets{DBInstanceIdentifiers: []string{"db-2"}},
+				deregister: Targets{DBInstanceIdentifiers: []string{"db-1"}, DBClusterIdentifiers: []string{"cluster-1"}},
+			},
+		},
+		"NoTargets": {
+			p: v1alpha1.DBProxyTargetGroupParameters{
+				DBClusterIdentifiers: []string{"cluster-1"},
+			},
+			want: want{
+				register: Targets{DBClusterIdentifiers: []string{"cluster-1"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			register, deregister := DiffTargets(tc.p, tc.observed)
+			if diff := cmp.Diff(tc.want.register, register); diff != "" {
+				t.Errorf("register: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deregister, deregister); diff != "" {
+				t.Errorf("deregister: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsConnectionPoolUpToDate(t *testing.T) {
+	observed := &rds.ConnectionPoolConfigurationInfo{
+		ConnectionBorrowTimeout:   aws.Int64(120),
+		MaxConnectionsPercent:     aws.Int64(100),
+		MaxIdleConnectionsPercent: aws.Int64(50),
+	}
+
+	cases := map[string]struct {
+		c        *v1alpha1.ConnectionPoolConfiguration
+		observed *rds.ConnectionPoolConfigurationInfo
+		want     bool
+	}{
+		"NotSet": {
+			observed: observed,
+			want:     true,
+		},
+		"UpToDate": {
+			c:        &v1alpha1.ConnectionPoolConfiguration{MaxConnectionsPercent: aws.Int64(100)},
+			observed: observed,
+			want:     true,
+		},
+		"Changed": {
+			c:        &v1alpha1.ConnectionPoolConfiguration{MaxConnectionsPercent: aws.Int64(80)},
+			observed: observed,
+		},
+		"SessionPinningFiltersChanged": {
+			c:        &v1alpha1.ConnectionPoolConfiguration{SessionPinningFilters: []string{"EXCLUDE_VARIABLE_SETS"}},
+			observed: observed,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsConnectionPoolUpToDate(tc.c, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/dbproxytargetgroup"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockDBProxyTargetGroupClient)(nil)
+
+// MockDBProxyTargetGroupClient is a type that implements all the methods for Client interface
+type MockDBProxyTargetGroupClient struct {
+	MockDescribeDBProxyTargetGroups func(*rds.DescribeDBProxyTargetGroupsInput) rds.DescribeDBProxyTargetGroupsRequest
+	MockModifyDBProxyTargetGroup    func(*rds.ModifyDBProxyTargetGroupInput) rds.ModifyDBProxyTargetGroupRequest
+	MockDescribeDBProxyTargets      func(*rds.DescribeDBProxyTargetsInput) rds.DescribeDBProxyTargetsRequest
+	MockRegisterDBProxyTargets      func(*rds.RegisterDBProxyTargetsInput) rds.RegisterDBProxyTargetsRequest
+	MockDeregisterDBProxyTargets    func(*rds.DeregisterDBProxyTargetsInput) rds.DeregisterDBProxyTargetsRequest
+}
+
+// DescribeDBProxyTargetGroupsRequest mocks DescribeDBProxyTargetGroupsRequest method
+func (m *MockDBProxyTargetGroupClient) DescribeDBProxyTargetGroupsRequest(input *rds.DescribeDBProxyTargetGroupsInput) rds.DescribeDBProxyTargetGroupsRequest {
+	return m.MockDescribeDBProxyTargetGroups(input)
+}
+
+// ModifyDBProxyTargetGroupRequest mocks ModifyDBProxyTargetGroupRequest method
+func (m *MockDBProxyTargetGroupClient) ModifyDBProxyTargetGroupRequest(input *rds.ModifyDBProxyTargetGroupInput) rds.ModifyDBProxyTargetGroupRequest {
+	return m.MockModifyDBProxyTargetGroup(input)
+}
+
+// DescribeDBProxyTargetsRequest mocks DescribeDBProxyTargetsRequest method
+func (m *MockDBProxyTargetGroupClient) DescribeDBProxyTargetsRequest(input *rds.DescribeDBProxyTargetsInput) rds.DescribeDBProxyTargetsRequest {
+	return m.MockDescribeDBProxyTargets(input)
+}
+
+// RegisterDBProxyTargetsRequest mocks RegisterDBProxyTargetsRequest method
+func (m *MockDBProxyTargetGroupClient) RegisterDBProxyTargetsRequest(input *rds.RegisterDBProxyTargetsInput) rds.RegisterDBProxyTargetsRequest {
+	return m.MockRegisterDBProxyTargets(input)
+}
+
+// DeregisterDBProxyTargetsRequest mocks DeregisterDBProxyTargetsRequest method
+func (m *MockDBProxyTargetGroupClient) DeregisterDBProxyTargetsRequest(input *rds.DeregisterDBProxyTargetsInput) rds.DeregisterDBProxyTargetsRequest {
+	return m.MockDeregisterDBProxyTargets(input)
+}
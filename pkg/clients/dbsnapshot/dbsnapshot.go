@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbsnapshot
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client is the external client used for DBSnapshot Custom Resource
+type Client interface {
+	DescribeDBSnapshotsRequest(*rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest
+	CreateDBSnapshotRequest(*rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest
+	DeleteDBSnapshotRequest(*rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest
+	ListTagsForResourceRequest(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	AddTagsToResourceRequest(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	RemoveTagsFromResourceRequest(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return rds.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the DB snapshot doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == rds.ErrCodeDBSnapshotNotFoundFault {
+		return true
+	}
+	return false
+}
+
+// GenerateTags returns the RDS tags of the given tags.
+func GenerateTags(tags []v1beta1.Tag) []rds.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]rds.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = rds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from an RDS resource.
+func DiffTags(desired []v1beta1.Tag, observed []rds.Tag) (add []rds.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, rds.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
+}
+
+// GenerateCreateDBSnapshotInput returns the create input of a DB snapshot with
+// the given name and parameters.
+func GenerateCreateDBSnapshotInput(name string, p v1beta1.DBSnapshotParameters) *rds.CreateDBSnapshotInput {
+	return &rds.CreateDBSnapshotInput{
+		DBSnapshotIdentifier: aws.String(name),
+		DBInstanceIdentifier: p.DBInstanceIdentifier,
+		Tags:                 GenerateTags(p.Tags),
+	}
+}
+
+// GenerateObservation is used to produce v1beta1.DBSnapshotObservation from
+// rds.DBSnapshot.
+func GenerateObservation(s rds.DBSnapshot) v1beta1.DBSnapshotObservation {
+	o := v1beta1.DBSnapshotObservation{
+		ARN:              aws.StringValue(s.DBSnapshotArn),
+		Status:           aws.StringValue(s.Status),
+		PercentProgress:  aws.Int64Value(s.PercentProgress),
+		Engine:           aws.StringValue(s.Engine),
+		EngineVersion:    aws.StringValue(s.EngineVersion),
+		AllocatedStorage: aws.Int64Value(s.AllocatedStorage),
+		Encrypted:        aws.BoolValue(s.Encrypted),
+		SnapshotType:     aws.StringValue(s.SnapshotType),
+	}
+	if s.SnapshotCreateTime != nil {
+		t := metav1.NewTime(*s.SnapshotCreateTime)
+		o.SnapshotCreateTime = &t
+	}
+	return o
+}
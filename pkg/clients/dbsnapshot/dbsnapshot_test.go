@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbsnapshot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+)
+
+func TestGenerateObservation(t *testing.T) {
+	created := time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC)
+	createdMeta := metav1.NewTime(created)
+
+	cases := map[string]struct {
+		in   rds.DBSnapshot
+		want v1beta1.DBSnapshotObservation
+	}{
+		"AllFields": {
+			in: rds.DBSnapshot{
+				DBSnapshotArn:      aws.String("arn"),
+				Status:             aws.String(v1beta1.DBSnapshotStateAvailable),
+				SnapshotCreateTime: &created,
+				PercentProgress:    aws.Int64(100),
+				Engine:             aws.String("postgres"),
+				EngineVersion:      aws.String("12.3"),
+				AllocatedStorage:   aws.Int64(20),
+				Encrypted:          aws.Bool(true),
+				SnapshotType:       aws.String("manual"),
+			},
+			want: v1beta1.DBSnapshotObservation{
+				ARN:                "arn",
+				Status:             v1beta1.DBSnapshotStateAvailable,
+				SnapshotCreateTime: &createdMeta,
+				PercentProgress:    100,
+				Engine:             "postgres",
+				EngineVersion:      "12.3",
+				AllocatedStorage:   20,
+				Encrypted:          true,
+				SnapshotType:       "manual",
+			},
+		},
+		"Empty": {
+			in:   rds.DBSnapshot{},
+			want: v1beta1.DBSnapshotObservation{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
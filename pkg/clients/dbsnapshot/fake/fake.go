@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/dbsnapshot"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockDBSnapshotClient)(nil)
+
+// MockDBSnapshotClient is a type that implements all the methods for Client interface
+type MockDBSnapshotClient struct {
+	MockDescribeDBSnapshots    func(*rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest
+	MockCreateDBSnapshot       func(*rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest
+	MockDeleteDBSnapshot       func(*rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest
+	MockListTagsForResource    func(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	MockAddTagsToResource      func(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	MockRemoveTagsFromResource func(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// DescribeDBSnapshotsRequest mocks DescribeDBSnapshotsRequest method
+func (m *MockDBSnapshotClient) DescribeDBSnapshotsRequest(input *rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest {
+	return m.MockDescribeDBSnapshots(input)
+}
+
+// CreateDBSnapshotRequest mocks CreateDBSnapshotRequest method
+func (m *MockDBSnapshotClient) CreateDBSnapshotRequest(input *rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest {
+	return m.MockCreateDBSnapshot(input)
+}
+
+// DeleteDBSnapshotRequest mocks DeleteDBSnapshotRequest method
+func (m *MockDBSnapshotClient) DeleteDBSnapshotRequest(input *rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest {
+	return m.MockDeleteDBSnapshot(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockDBSnapshotClient) ListTagsForResourceRequest(input *rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// AddTagsToResourceRequest mocks AddTagsToResourceRequest method
+func (m *MockDBSnapshotClient) AddTagsToResourceRequest(input *rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest {
+	return m.MockAddTagsToResource(input)
+}
+
+// RemoveTagsFromResourceRequest mocks RemoveTagsFromResourceRequest method
+func (m *MockDBSnapshotClient) RemoveTagsFromResourceRequest(input *rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest {
+	return m.MockRemoveTagsFromResource(input)
+}
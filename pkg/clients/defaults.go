@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	errGetProviderConfig = "cannot get referenced ProviderConfig"
+	errGetKind           = "cannot determine the kind of the managed resource"
+	errConvertManaged    = "cannot convert managed resource"
+	errParseDefaults     = "cannot parse the defaults of the ProviderConfig"
+	errUpdateDefaults    = "cannot update managed resource with the defaults of the ProviderConfig"
+)
+
+// ProviderConfigDefaulter fills the unset spec.forProvider fields of a
+// managed resource with the defaults of its kind configured in the
+// referenced ProviderConfig.
+type ProviderConfigDefaulter struct {
+	kube   client.Client
+	scheme *runtime.Scheme
+}
+
+// NewProviderConfigDefaulter returns a new ProviderConfigDefaulter. The kind
+// of managed resources is looked up in the given scheme, since their type
+// metadata is not always set.
+func NewProviderConfigDefaulter(c client.Client, s *runtime.Scheme) *ProviderConfigDefaulter {
+	return &ProviderConfigDefaulter{kube: c, scheme: s}
+}
+
+// Initialize the given managed resource.
+func (d *ProviderConfigDefaulter) Initialize(ctx context.Context, mg resource.Managed) error {
+	if mg.GetProviderConfigReference() == nil {
+		return nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := d.kube.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return errors.Wrap(err, errGetProviderConfig)
+	}
+	gvk, err := apiutil.GVKForObject(mg, d.scheme)
+	if err != nil {
+		return errors.Wrap(err, errGetKind)
+	}
+	defaults := DefaultsForKind(pc.Spec.Defaults, gvk)
+	if len(defaults) == 0 {
+		return nil
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return errors.Wrap(err, errConvertManaged)
+	}
+	spec, _ := obj["spec"].(map[string]interface{})
+	if spec == nil {
+		return nil
+	}
+	forProvider, _ := spec["forProvider"].(map[string]interface{})
+	if forProvider == nil {
+		forProvider = map[string]interface{}{}
+	}
+	changed := false
+	for _, rd := range defaults {
+		values := map[string]interface{}{}
+		if err := json.Unmarshal(rd.ForProvider.Raw, &values); err != nil {
+			return errors.Wrap(err, errParseDefaults)
+		}
+		if MergeDefaults(forProvider, values) {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	spec["forProvider"] = forProvider
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, mg); err != nil {
+		return errors.Wrap(err, errConvertManaged)
+	}
+	return errors.Wrap(d.kube.Update(ctx, mg), errUpdateDefaults)
+}
+
+// DefaultsForKind returns the defaults in the given list that apply to the
+// given kind.
+func DefaultsForKind(defaults []v1beta1.ResourceDefaults, gvk schema.GroupVersionKind) []v1beta1.ResourceDefaults {
+	var res []v1beta1.ResourceDefaults
+	for _, rd := range defaults {
+		if rd.Kind != gvk.Kind {
+			continue
+		}
+		if rd.APIGroup != nil && *rd.APIGroup != gvk.Group {
+			continue
+		}
+		res = append(res, rd)
+	}
+	return res
+}
+
+// MergeDefaults sets the values of the given defaults in the given object
+// where they are not set yet. Nested objects are merged recursively, all
+// other values including lists are only set as a whole. It returns whether
+// the object has been changed.
+func MergeDefaults(obj, defaults map[string]interface{}) bool {
+	changed := false
+	for k, dv := range defaults {
+		v, ok := obj[k]
+		if !ok || v == nil {
+			obj[k] = dv
+			changed = true
+			continue
+		}
+		om, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if dm, ok := dv.(map[string]interface{}); ok && MergeDefaults(om, dm) {
+			changed = true
+		}
+	}
+	return changed
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+var (
+	defaultRegion = "us-east-1"
+	otherRegion   = "eu-west-1"
+	ec2Group      = "ec2.aws.crossplane.io"
+	otherGroup    = "other.aws.crossplane.io"
+)
+
+func vpc(p v1beta1.VPCParameters) *v1beta1.VPC {
+	return &v1beta1.VPC{
+		Spec: v1beta1.VPCSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderConfigReference: &runtimev1alpha1.Reference{Name: "example"},
+			},
+			ForProvider: p,
+		},
+	}
+}
+
+func TestMergeDefaults(t *testing.T) {
+	type want struct {
+		obj     map[string]interface{}
+		changed bool
+	}
+	cases := map[string]struct {
+		obj      map[string]interface{}
+		defaults map[string]interface{}
+		want     want
+	}{
+		"FillsMissing": {
+			obj:      map[string]interface{}{"a": "x"},
+			defaults: map[string]interface{}{"a": "y", "b": "z"},
+			want: want{
+				obj:     map[string]interface{}{"a": "x", "b": "z"},
+				changed: true,
+			},
+		},
+		"FillsNil": {
+			obj:      map[string]interface{}{"a": nil},
+			defaults: map[string]interface{}{"a": "y"},
+			want: want{
+				obj:     map[string]interface{}{"a": "y"},
+				changed: true,
+			},
+		},
+		"MergesNested": {
+			obj: map[string]interface{}{"a": map[string]interface{}{"b": "x"}},
+			defaults: map[string]interface{}{"a": map[string]interface{}{
+				"b": "y",
+				"c": "z",
+			}},
+			want: want{
+				obj:     map[string]interface{}{"a": map[string]interface{}{"b": "x", "c": "z"}},
+				changed: true,
+			},
+		},
+		"KeepsLists": {
+			obj:      map[string]interface{}{"a": []interface{}{"x"}},
+			defaults: map[string]interface{}{"a": []interface{}{"y", "z"}},
+			want: want{
+				obj: map[string]interface{}{"a": []interface{}{"x"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := MergeDefaults(tc.obj, tc.defaults)
+			if diff := cmp.Diff(tc.want.obj, tc.obj); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDefaultsForKind(t *testing.T) {
+	cases := map[string]struct {
+		defaults []awsv1beta1.ResourceDefaults
+		want     []awsv1beta1.ResourceDefaults
+	}{
+		"MatchesKind": {
+			defaults: []awsv1beta1.ResourceDefaults{{Kind: "VPC"}, {Kind: "Subnet"}},
+			want:     []awsv1beta1.ResourceDefaults{{Kind: "VPC"}},
+		},
+		"MatchesGroup": {
+			defaults: []awsv1beta1.ResourceDefaults{
+				{Kind: "VPC", APIGroup: &ec2Group},
+				{Kind: "VPC", APIGroup: &otherGroup},
+			},
+			want: []awsv1beta1.ResourceDefaults{{Kind: "VPC", APIGroup: &ec2Group}},
+		},
+		"NoMatch": {
+			defaults: []awsv1beta1.ResourceDefaults{{Kind: "Subnet"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DefaultsForKind(tc.defaults, v1beta1.VPCGroupVersionKind)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderConfigDefaulterInitialize(t *testing.T) {
+	errBoom := errors.New("boom")
+	withGroupDefaults := func(group *string, raw string) test.ObjectFn {
+		return func(obj runtime.Object) error {
+			pc := obj.(*awsv1beta1.ProviderConfig)
+			pc.Spec.Defaults = []awsv1beta1.ResourceDefaults{{
+				Kind:        "VPC",
+				APIGroup:    group,
+				ForProvider: runtime.RawExtension{Raw: []byte(raw)},
+			}}
+			return nil
+		}
+	}
+	withDefaults := func(raw string) test.ObjectFn { return withGroupDefaults(nil, raw) }
+	s := runtime.NewScheme()
+	if err := v1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		mg   resource.Managed
+		want want
+	}{
+		"Successful": {
+			kube: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withDefaults(`{"region":"us-east-1","enableDnsSupport":true}`)),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			mg: vpc(v1beta1.VPCParameters{CIDRBlock: "10.0.0.0/16"}),
+			want: want{
+				mg: vpc(v1beta1.VPCParameters{
+					CIDRBlock:        "10.0.0.0/16",
+					Region:           &defaultRegion,
+					EnableDNSSupport: func(b bool) *bool { return &b }(true),
+				}),
+			},
+		},
+		"MatchesGroupWithoutTypeMeta": {
+			kube: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withGroupDefaults(&ec2Group, `{"region":"us-east-1"}`)),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			mg: vpc(v1beta1.VPCParameters{}),
+			want: want{
+				mg: vpc(v1beta1.VPCParameters{Region: &defaultRegion}),
+			},
+		},
+		"SkipsOtherGroupWithoutTypeMeta": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, withGroupDefaults(&otherGroup, `{"region":"us-east-1"}`)),
+			},
+			mg: vpc(v1beta1.VPCParameters{}),
+			want: want{
+				mg: vpc(v1beta1.VPCParameters{}),
+			},
+		},
+		"KeepsSetValues": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, withDefaults(`{"region":"us-east-1"}`)),
+			},
+			mg: vpc(v1beta1.VPCParameters{Region: &otherRegion}),
+			want: want{
+				mg: vpc(v1beta1.VPCParameters{Region: &otherRegion}),
+			},
+		},
+		"GetFailed": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			mg: vpc(v1beta1.VPCParameters{}),
+			want: want{
+				mg:  vpc(v1beta1.VPCParameters{}),
+				err: errors.Wrap(errBoom, errGetProviderConfig),
+			},
+		},
+		"InvalidDefaults": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, withDefaults(`[]`)),
+			},
+			mg: vpc(v1beta1.VPCParameters{}),
+			want: want{
+				mg:  vpc(v1beta1.VPCParameters{}),
+				err: errors.Wrap(errors.New("json: cannot unmarshal array into Go value of type map[string]interface {}"), errParseDefaults),
+			},
+		},
+		"UpdateFailed": {
+			kube: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withDefaults(`{"region":"us-east-1"}`)),
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			mg: vpc(v1beta1.VPCParameters{}),
+			want: want{
+				mg:  vpc(v1beta1.VPCParameters{Region: &defaultRegion}),
+				err: errors.Wrap(errBoom, errUpdateDefaults),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewProviderConfigDefaulter(tc.kube, s).Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
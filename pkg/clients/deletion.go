@@ -0,0 +1,379 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	errWaitForDependents   = "waiting for %s to be deleted first"
+	errDeleteBackoff       = "deletion is blocked by a dependent resource, retrying in %s"
+	errDependencyViolation = "deletion is blocked by a dependent resource"
+
+	// defaultDeleteBackoff is the wait after the first deletion attempt that
+	// failed because of a dependent resource. It is doubled after every
+	// subsequent failure up to defaultMaxDeleteBackoff.
+	defaultDeleteBackoff    = 30 * time.Second
+	defaultMaxDeleteBackoff = 10 * time.Minute
+
+	// defaultPendingDeletionTTL is the time after which a pending deletion
+	// that has not been observed again is forgotten, e.g. because its
+	// managed resource was orphaned.
+	defaultPendingDeletionTTL = 5 * time.Minute
+)
+
+// dependencyViolationCodes are the error codes AWS returns when a resource
+// cannot be deleted because another resource still depends on it.
+var dependencyViolationCodes = map[string]bool{
+	"DependencyViolation":            true,
+	"InvalidDBSubnetGroupStateFault": true,
+	"CacheSubnetGroupInUse":          true,
+	"InvalidClusterSubnetGroupState": true,
+	"ResourceInUse":                  true,
+	// Global Accelerator refuses to delete accelerators with listeners and
+	// listeners with endpoint groups.
+	"AssociatedListenerFoundException":      true,
+	"AssociatedEndpointGroupFoundException": true,
+}
+
+// A pendingDependentsError is returned when the deletion of a resource waits
+// for its dependents to be deleted first.
+type pendingDependentsError struct {
+	msg string
+}
+
+func (e *pendingDependentsError) Error() string {
+	return e.msg
+}
+
+// IsDependencyViolation returns true if the error is because the resource has
+// dependents that need to be deleted first.
+func IsDependencyViolation(err error) bool {
+	for err != nil {
+		switch e := err.(type) {
+		case *pendingDependentsError:
+			return true
+		case awserr.Error:
+			return dependencyViolationCodes[e.Code()]
+		}
+		c, ok := err.(interface{ Cause() error })
+		if !ok {
+			return false
+		}
+		err = c.Cause()
+	}
+	return false
+}
+
+// A DeletionTier orders the deletion of managed resources. Resources of a
+// tier are only deleted once no resource of a lower tier that references them
+// and uses the same ProviderConfig is pending deletion. Resources that do not
+// reference each other are not ordered.
+type DeletionTier int
+
+// Deletion tiers, from the first to be deleted to the last.
+const (
+	// DeletionTierWorkload is the tier of resources that run in a network,
+	// like database instances or cache clusters.
+	DeletionTierWorkload DeletionTier = iota
+	// DeletionTierAttachment is the tier of resources that attach workloads
+	// to a network, like subnet groups or NAT gateways.
+	DeletionTierAttachment
+	// DeletionTierNetwork is the tier of resources that make up a network,
+	// like subnets or security groups.
+	DeletionTierNetwork
+	// DeletionTierVPC is the tier of VPCs and transit gateways.
+	DeletionTierVPC
+)
+
+type pendingDeletion struct {
+	scope    string
+	name     string
+	refs     map[string]bool
+	tier     DeletionTier
+	lastSeen time.Time
+}
+
+type deleteAttempt struct {
+	failures int
+	next     time.Time
+	message  string
+}
+
+// A DeleteQueue orders the deletion of managed resources by their deletion
+// tier and the references between them, and throttles the deletion attempts of resources that are blocked by
+// their dependents.
+type DeleteQueue struct {
+	mu       sync.Mutex
+	pending  map[types.UID]pendingDeletion
+	attempts map[types.UID]deleteAttempt
+
+	now        func() time.Time
+	backoff    time.Duration
+	maxBackoff time.Duration
+	ttl        time.Duration
+}
+
+// A DeleteQueueOption configures a DeleteQueue.
+type DeleteQueueOption func(*DeleteQueue)
+
+// WithDeleteBackoff sets the initial and maximum wait between deletion
+// attempts that failed because of a dependent resource.
+func WithDeleteBackoff(initial, max time.Duration) DeleteQueueOption {
+	return func(q *DeleteQueue) {
+		q.backoff = initial
+		q.maxBackoff = max
+	}
+}
+
+// WithClock sets the function the DeleteQueue uses to get the current time.
+func WithClock(now func() time.Time) DeleteQueueOption {
+	return func(q *DeleteQueue) {
+		q.now = now
+	}
+}
+
+// NewDeleteQueue returns a new DeleteQueue.
+func NewDeleteQueue(o ...DeleteQueueOption) *DeleteQueue {
+	q := &DeleteQueue{
+		pending:    map[types.UID]pendingDeletion{},
+		attempts:   map[types.UID]deleteAttempt{},
+		now:        time.Now,
+		backoff:    defaultDeleteBackoff,
+		maxBackoff: defaultMaxDeleteBackoff,
+		ttl:        defaultPendingDeletionTTL,
+	}
+	for _, f := range o {
+		f(q)
+	}
+	return q
+}
+
+// defaultDeleteQueue is shared by all controllers of the provider so that
+// the deletions of different kinds are ordered against each other.
+var defaultDeleteQueue = NewDeleteQueue()
+
+// Pending records that the given managed resource of the given tier is
+// waiting for its external resource to be deleted.
+func (q *DeleteQueue) Pending(mg resource.Managed, tier DeletionTier) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.pending[mg.GetUID()] = pendingDeletion{
+		scope:    deletionScope(mg),
+		name:     kindOf(mg) + "/" + mg.GetName(),
+		refs:     referencedNames(mg),
+		tier:     tier,
+		lastSeen: q.now(),
+	}
+}
+
+// Done records that the external resource of the given managed resource has
+// been deleted.
+func (q *DeleteQueue) Done(mg resource.Managed) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.pending, mg.GetUID())
+	delete(q.attempts, mg.GetUID())
+}
+
+// Blocker returns the name of a resource of a lower tier that references the
+// given managed resource of the given tier and thus has to be deleted before
+// it, or an empty string if there is none.
+func (q *DeleteQueue) Blocker(mg resource.Managed, tier DeletionTier) string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	scope := deletionScope(mg)
+	var blockers []string
+	for uid, p := range q.pending {
+		if q.now().Sub(p.lastSeen) > q.ttl {
+			delete(q.pending, uid)
+			continue
+		}
+		if p.scope == scope && p.tier < tier && p.refs[mg.GetName()] {
+			blockers = append(blockers, p.name)
+		}
+	}
+	if len(blockers) == 0 {
+		return ""
+	}
+	sort.Strings(blockers)
+	return blockers[0]
+}
+
+// Backoff returns how long the deletion of the given managed resource should
+// still wait after it has been blocked by a dependent, along with the message
+// of the last failed attempt.
+func (q *DeleteQueue) Backoff(mg resource.Managed) (time.Duration, string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	a, ok := q.attempts[mg.GetUID()]
+	if !ok {
+		return 0, ""
+	}
+	return a.next.Sub(q.now()), a.message
+}
+
+// Blocked records that the deletion of the given managed resource failed
+// because of a dependent resource and escalates its backoff.
+func (q *DeleteQueue) Blocked(mg resource.Managed, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	a := q.attempts[mg.GetUID()]
+	wait := q.backoff << uint(a.failures)
+	if wait > q.maxBackoff || wait <= 0 {
+		wait = q.maxBackoff
+	}
+	a.failures++
+	a.next = q.now().Add(wait)
+	a.message = err.Error()
+	q.attempts[mg.GetUID()] = a
+}
+
+// WithDeleteThrottle wraps the given ExternalConnecter so that the deletions
+// of the external resources it connects to are ordered by the given tier and
+// throttled while they are blocked by dependent resources.
+func WithDeleteThrottle(c managed.ExternalConnecter, tier DeletionTier) managed.ExternalConnecter {
+	return &throttledConnecter{ExternalConnecter: c, queue: defaultDeleteQueue, tier: tier}
+}
+
+type throttledConnecter struct {
+	managed.ExternalConnecter
+	queue *DeleteQueue
+	tier  DeletionTier
+}
+
+func (c *throttledConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &throttledExternal{ExternalClient: e, queue: c.queue, tier: c.tier}, nil
+}
+
+type throttledExternal struct {
+	managed.ExternalClient
+	queue *DeleteQueue
+	tier  DeletionTier
+}
+
+func (e *throttledExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil || !meta.WasDeleted(mg) {
+		return o, err
+	}
+	if o.ResourceExists && mg.GetDeletionPolicy() != runtimev1alpha1.DeletionOrphan {
+		e.queue.Pending(mg, e.tier)
+	} else {
+		e.queue.Done(mg)
+	}
+	return o, nil
+}
+
+func (e *throttledExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	e.queue.Pending(mg, e.tier)
+	if b := e.queue.Blocker(mg, e.tier); b != "" {
+		return &pendingDependentsError{msg: fmt.Sprintf(errWaitForDependents, b)}
+	}
+	if wait, msg := e.queue.Backoff(mg); wait > 0 {
+		return errors.Wrap(&pendingDependentsError{msg: msg}, fmt.Sprintf(errDeleteBackoff, wait.Round(time.Second)))
+	}
+	err := e.ExternalClient.Delete(ctx, mg)
+	if IsDependencyViolation(err) {
+		e.queue.Blocked(mg, err)
+		return errors.Wrap(err, errDependencyViolation)
+	}
+	return err
+}
+
+// deletionScope returns the scope in which the deletions of managed resources
+// are ordered against each other. Resources that use different
+// ProviderConfigs usually live in different accounts and are not ordered.
+func deletionScope(mg resource.Managed) string {
+	if ref := mg.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+var (
+	referenceType    = reflect.TypeOf(runtimev1alpha1.Reference{})
+	resourceSpecType = reflect.TypeOf(runtimev1alpha1.ResourceSpec{})
+)
+
+// referencedNames returns the names of the managed resources that the spec of
+// the given managed resource references, e.g. through a vpcIdRef. References
+// only name the resource they refer to, so resources of different kinds with
+// the same name are not told apart.
+func referencedNames(mg resource.Managed) map[string]bool {
+	names := map[string]bool{}
+	if v := reflect.Indirect(reflect.ValueOf(mg)); v.Kind() == reflect.Struct {
+		if spec := v.FieldByName("Spec"); spec.IsValid() {
+			collectReferences(spec, names)
+		}
+	}
+	return names
+}
+
+func collectReferences(v reflect.Value, names map[string]bool) {
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			collectReferences(v.Elem(), names)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			collectReferences(v.Index(i), names)
+		}
+	case reflect.Struct:
+		switch v.Type() {
+		case referenceType:
+			if name := v.FieldByName("Name").String(); name != "" {
+				names[name] = true
+			}
+		case resourceSpecType:
+			// The ProviderConfig and connection secret references are not
+			// dependencies of the resource.
+		default:
+			for i := 0; i < v.NumField(); i++ {
+				collectReferences(v.Field(i), names)
+			}
+		}
+	}
+}
+
+// kindOf returns the kind of the given managed resource.
+func kindOf(mg resource.Managed) string {
+	if kind := mg.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+	return reflect.TypeOf(mg).Elem().Name()
+}
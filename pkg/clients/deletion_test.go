@@ -0,0 +1,297 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+var errDependency = awserr.New("DependencyViolation", "The vpc 'vpc-1' has dependencies and cannot be deleted.", nil)
+
+func deletedVPC() *v1beta1.VPC {
+	cr := vpc(v1beta1.VPCParameters{})
+	cr.SetName("vpc")
+	cr.SetUID(types.UID("vpc-uid"))
+	cr.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+	return cr
+}
+
+func deletedSubnet() *v1beta1.Subnet {
+	cr := &v1beta1.Subnet{
+		Spec: v1beta1.SubnetSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderConfigReference: &runtimev1alpha1.Reference{Name: "example"},
+			},
+			ForProvider: v1beta1.SubnetParameters{
+				VPCIDRef: &runtimev1alpha1.Reference{Name: "vpc"},
+			},
+		},
+	}
+	cr.SetName("subnet")
+	cr.SetUID(types.UID("subnet-uid"))
+	cr.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+	return cr
+}
+
+func TestIsDependencyViolation(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil": {
+			err:  nil,
+			want: false,
+		},
+		"DependencyViolation": {
+			err:  errDependency,
+			want: true,
+		},
+		"Wrapped": {
+			err:  errors.Wrap(errDependency, "cannot delete"),
+			want: true,
+		},
+		"OtherError": {
+			err:  awserr.New("InvalidVpcID.NotFound", "", nil),
+			want: false,
+		},
+		"PendingDependents": {
+			err:  &pendingDependentsError{msg: fmt.Sprintf(errWaitForDependents, "Subnet/subnet")},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsDependencyViolation(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// unrelatedSubnet returns a deleted subnet that does not reference the VPC
+// returned by deletedVPC.
+func unrelatedSubnet() *v1beta1.Subnet {
+	cr := deletedSubnet()
+	cr.SetName("unrelated")
+	cr.SetUID(types.UID("unrelated-uid"))
+	cr.Spec.ForProvider.VPCIDRef = nil
+	return cr
+}
+
+func TestReferencedNames(t *testing.T) {
+	cr := deletedSubnet()
+	cr.Spec.WriteConnectionSecretToReference = &runtimev1alpha1.SecretReference{Name: "secret", Namespace: "default"}
+
+	if diff := cmp.Diff(map[string]bool{"vpc": true}, referencedNames(cr)); diff != "" {
+		t.Errorf("referencedNames(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]bool{}, referencedNames(unrelatedSubnet())); diff != "" {
+		t.Errorf("referencedNames(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestDeleteQueueBlocker(t *testing.T) {
+	q := NewDeleteQueue()
+	q.Pending(deletedSubnet(), DeletionTierNetwork)
+
+	if diff := cmp.Diff("Subnet/subnet", q.Blocker(deletedVPC(), DeletionTierVPC)); diff != "" {
+		t.Errorf("Blocker(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("", q.Blocker(deletedSubnet(), DeletionTierNetwork)); diff != "" {
+		t.Errorf("Blocker(...): -want, +got:\n%s", diff)
+	}
+
+	other := deletedVPC()
+	other.SetProviderConfigReference(&runtimev1alpha1.Reference{Name: "other"})
+	if diff := cmp.Diff("", q.Blocker(other, DeletionTierVPC)); diff != "" {
+		t.Errorf("Blocker(...): -want, +got:\n%s", diff)
+	}
+
+	unrelated := deletedVPC()
+	unrelated.SetName("unrelated")
+	if diff := cmp.Diff("", q.Blocker(unrelated, DeletionTierVPC)); diff != "" {
+		t.Errorf("Blocker(...): -want, +got:\n%s", diff)
+	}
+
+	q.Done(deletedSubnet())
+	if diff := cmp.Diff("", q.Blocker(deletedVPC(), DeletionTierVPC)); diff != "" {
+		t.Errorf("Blocker(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestDeleteQueueBackoff(t *testing.T) {
+	now := time.Now()
+	q := NewDeleteQueue(WithClock(func() time.Time { return now }), WithDeleteBackoff(10*time.Second, 30*time.Second))
+	cr := deletedVPC()
+
+	want := []time.Duration{10 * time.Second, 20 * time.Second, 30 * time.Second, 30 * time.Second}
+	for i, w := range want {
+		q.Blocked(cr, errDependency)
+		got, msg := q.Backoff(cr)
+		if diff := cmp.Diff(w, got); diff != "" {
+			t.Errorf("attempt %d: Backoff(...): -want, +got:\n%s", i, diff)
+		}
+		if diff := cmp.Diff(errDependency.Error(), msg); diff != "" {
+			t.Errorf("attempt %d: Backoff(...): -want, +got:\n%s", i, diff)
+		}
+	}
+
+	q.Done(cr)
+	if got, _ := q.Backoff(cr); got != 0 {
+		t.Errorf("Backoff(...): want 0 after Done, got %s", got)
+	}
+}
+
+func TestThrottledExternalDelete(t *testing.T) {
+	type args struct {
+		pending []resource.Managed
+		blocked error
+		deleted error
+		cr      resource.Managed
+	}
+	type want struct {
+		err    error
+		called bool
+	}
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr: deletedVPC(),
+			},
+			want: want{
+				called: true,
+			},
+		},
+		"WaitForLowerTier": {
+			args: args{
+				pending: []resource.Managed{deletedSubnet()},
+				cr:      deletedVPC(),
+			},
+			want: want{
+				err: &pendingDependentsError{msg: fmt.Sprintf(errWaitForDependents, "Subnet/subnet")},
+			},
+		},
+		"UnrelatedLowerTier": {
+			args: args{
+				pending: []resource.Managed{unrelatedSubnet()},
+				cr:      deletedVPC(),
+			},
+			want: want{
+				called: true,
+			},
+		},
+		"DependencyViolation": {
+			args: args{
+				deleted: errDependency,
+				cr:      deletedVPC(),
+			},
+			want: want{
+				err:    errors.Wrap(errDependency, errDependencyViolation),
+				called: true,
+			},
+		},
+		"BackingOff": {
+			args: args{
+				blocked: errDependency,
+				cr:      deletedVPC(),
+			},
+			want: want{
+				err: errors.Wrap(&pendingDependentsError{msg: errDependency.Error()}, "deletion is blocked by a dependent resource, retrying in 30s"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+			q := NewDeleteQueue(WithClock(func() time.Time { return now }))
+			for _, p := range tc.pending {
+				q.Pending(p, DeletionTierNetwork)
+			}
+			if tc.blocked != nil {
+				q.Blocked(tc.cr, tc.blocked)
+			}
+			called := false
+			e := &throttledExternal{
+				ExternalClient: managed.ExternalClientFns{
+					DeleteFn: func(_ context.Context, _ resource.Managed) error {
+						called = true
+						return tc.deleted
+					},
+				},
+				queue: q,
+				tier:  DeletionTierVPC,
+			}
+			err := e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestThrottledExternalObserve(t *testing.T) {
+	q := NewDeleteQueue()
+	exists := true
+	e := &throttledExternal{
+		ExternalClient: managed.ExternalClientFns{
+			ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return managed.ExternalObservation{ResourceExists: exists}, nil
+			},
+		},
+		queue: q,
+		tier:  DeletionTierNetwork,
+	}
+
+	if _, err := e.Observe(context.Background(), deletedSubnet()); err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	if diff := cmp.Diff("Subnet/subnet", q.Blocker(deletedVPC(), DeletionTierVPC)); diff != "" {
+		t.Errorf("Blocker(...): -want, +got:\n%s", diff)
+	}
+
+	exists = false
+	if _, err := e.Observe(context.Background(), deletedSubnet()); err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	if diff := cmp.Diff("", q.Blocker(deletedVPC(), DeletionTierVPC)); diff != "" {
+		t.Errorf("Blocker(...): -want, +got:\n%s", diff)
+	}
+}
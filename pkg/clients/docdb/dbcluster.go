@@ -0,0 +1,345 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docdb
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/docdb/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// engine is the only database engine of DocumentDB.
+	engine = "docdb"
+
+	errGetPasswordSecret = "cannot get the master password secret"
+
+	// ConnectionDetailsReaderEndpoint is the key of the reader endpoint of a
+	// DBCluster in its connection secret.
+	ConnectionDetailsReaderEndpoint = "readerEndpoint"
+)
+
+// DBClusterClient is the external client used for DBCluster Custom Resource
+type DBClusterClient interface {
+	DescribeDBClustersRequest(*docdb.DescribeDBClustersInput) docdb.DescribeDBClustersRequest
+	CreateDBClusterRequest(*docdb.CreateDBClusterInput) docdb.CreateDBClusterRequest
+	RestoreDBClusterFromSnapshotRequest(*docdb.RestoreDBClusterFromSnapshotInput) docdb.RestoreDBClusterFromSnapshotRequest
+	ModifyDBClusterRequest(*docdb.ModifyDBClusterInput) docdb.ModifyDBClusterRequest
+	DeleteDBClusterRequest(*docdb.DeleteDBClusterInput) docdb.DeleteDBClusterRequest
+	ListTagsForResourceRequest(*docdb.ListTagsForResourceInput) docdb.ListTagsForResourceRequest
+	AddTagsToResourceRequest(*docdb.AddTagsToResourceInput) docdb.AddTagsToResourceRequest
+	RemoveTagsFromResourceRequest(*docdb.RemoveTagsFromResourceInput) docdb.RemoveTagsFromResourceRequest
+}
+
+// NewDBClusterClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewDBClusterClient(cfg aws.Config) DBClusterClient {
+	return docdb.New(cfg)
+}
+
+// IsDBClusterNotFound returns true if the error is because the DB cluster
+// doesn't exist.
+func IsDBClusterNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == docdb.ErrCodeDBClusterNotFoundFault {
+		return true
+	}
+	return false
+}
+
+// GenerateTags returns the DocumentDB tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) []docdb.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]docdb.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = docdb.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from a DocumentDB resource.
+func DiffTags(desired []v1alpha1.Tag, observed []docdb.Tag) (add []docdb.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, docdb.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
+}
+
+// GenerateCreateDBClusterInput returns the create input of a DB cluster with
+// the given name, master password and parameters.
+func GenerateCreateDBClusterInput(name, password string, p v1alpha1.DBClusterParameters) *docdb.CreateDBClusterInput {
+	return &docdb.CreateDBClusterInput{
+		DBClusterIdentifier:         aws.String(name),
+		Engine:                      aws.String(engine),
+		EngineVersion:               p.EngineVersion,
+		MasterUsername:              aws.String(p.MasterUsername),
+		MasterUserPassword:          aws.String(password),
+		Port:                        p.Port,
+		AvailabilityZones:           p.AvailabilityZones,
+		DBClusterParameterGroupName: p.DBClusterParameterGroupName,
+		DBSubnetGroupName:           p.DBSubnetGroupName,
+		VpcSecurityGroupIds:         p.VPCSecurityGroupIDs,
+		BackupRetentionPeriod:       p.BackupRetentionPeriod,
+		PreferredBackupWindow:       p.PreferredBackupWindow,
+		PreferredMaintenanceWindow:  p.PreferredMaintenanceWindow,
+		StorageEncrypted:            p.StorageEncrypted,
+		KmsKeyId:                    p.KMSKeyID,
+		DeletionProtection:          p.DeletionProtection,
+		EnableCloudwatchLogsExports: p.EnableCloudwatchLogsExports,
+		Tags:                        GenerateTags(p.Tags),
+	}
+}
+
+// GenerateRestoreDBClusterFromSnapshotInput returns the input to restore a DB
+// cluster with the given name and parameters from the snapshot in the
+// parameters. The backup and maintenance settings, the DB cluster parameter
+// group and the master password are not part of a restore and are applied by
+// a subsequent update.
+func GenerateRestoreDBClusterFromSnapshotInput(name string, p v1alpha1.DBClusterParameters) *docdb.RestoreDBClusterFromSnapshotInput {
+	return &docdb.RestoreDBClusterFromSnapshotInput{
+		DBClusterIdentifier:         aws.String(name),
+		SnapshotIdentifier:          p.SnapshotIdentifier,
+		Engine:                      aws.String(engine),
+		EngineVersion:               p.EngineVersion,
+		Port:                        p.Port,
+		AvailabilityZones:           p.AvailabilityZones,
+		DBSubnetGroupName:           p.DBSubnetGroupName,
+		VpcSecurityGroupIds:         p.VPCSecurityGroupIDs,
+		KmsKeyId:                    p.KMSKeyID,
+		DeletionProtection:          p.DeletionProtection,
+		EnableCloudwatchLogsExports: p.EnableCloudwatchLogsExports,
+		Tags:                        GenerateTags(p.Tags),
+	}
+}
+
+// GenerateModifyDBClusterInput returns the modify input that changes the
+// given observed DB cluster into the desired one. Only the fields that
+// differ are set.
+func GenerateModifyDBClusterInput(name string, p v1alpha1.DBClusterParameters, o docdb.DBCluster) *docdb.ModifyDBClusterInput { // nolint:gocyclo
+	in := &docdb.ModifyDBClusterInput{
+		DBClusterIdentifier: aws.String(name),
+		ApplyImmediately:    p.ApplyImmediately,
+	}
+	if p.EngineVersion != nil && aws.StringValue(p.EngineVersion) != aws.StringValue(o.EngineVersion) {
+		in.EngineVersion = p.EngineVersion
+	}
+	if p.Port != nil && aws.Int64Value(p.Port) != aws.Int64Value(o.Port) {
+		in.Port = p.Port
+	}
+	if p.DBClusterParameterGroupName != nil && aws.StringValue(p.DBClusterParameterGroupName) != aws.StringValue(o.DBClusterParameterGroup) {
+		in.DBClusterParameterGroupName = p.DBClusterParameterGroupName
+	}
+	if p.BackupRetentionPeriod != nil && aws.Int64Value(p.BackupRetentionPeriod) != aws.Int64Value(o.BackupRetentionPeriod) {
+		in.BackupRetentionPeriod = p.BackupRetentionPeriod
+	}
+	if p.PreferredBackupWindow != nil && aws.StringValue(p.PreferredBackupWindow) != aws.StringValue(o.PreferredBackupWindow) {
+		in.PreferredBackupWindow = p.PreferredBackupWindow
+	}
+	if p.PreferredMaintenanceWindow != nil && aws.StringValue(p.PreferredMaintenanceWindow) != aws.StringValue(o.PreferredMaintenanceWindow) {
+		in.PreferredMaintenanceWindow = p.PreferredMaintenanceWindow
+	}
+	if p.DeletionProtection != nil && aws.BoolValue(p.DeletionProtection) != aws.BoolValue(o.DeletionProtection) {
+		in.DeletionProtection = p.DeletionProtection
+	}
+	if len(p.VPCSecurityGroupIDs) != 0 && !cmp.Equal(p.VPCSecurityGroupIDs, observedSecurityGroupIDs(o), sortStrings()) {
+		in.VpcSecurityGroupIds = p.VPCSecurityGroupIDs
+	}
+	enable, disable := diffLogTypes(p.EnableCloudwatchLogsExports, o.EnabledCloudwatchLogsExports)
+	if len(enable) != 0 || len(disable) != 0 {
+		in.CloudwatchLogsExportConfiguration = &docdb.CloudwatchLogsExportConfiguration{
+			EnableLogTypes:  enable,
+			DisableLogTypes: disable,
+		}
+	}
+	return in
+}
+
+// GenerateDeleteDBClusterInput returns the delete input of the DB cluster
+// with the given name.
+func GenerateDeleteDBClusterInput(name string, p v1alpha1.DBClusterParameters) *docdb.DeleteDBClusterInput {
+	return &docdb.DeleteDBClusterInput{
+		DBClusterIdentifier:       aws.String(name),
+		SkipFinalSnapshot:         p.SkipFinalSnapshot,
+		FinalDBSnapshotIdentifier: p.FinalDBSnapshotIdentifier,
+	}
+}
+
+// GenerateDBClusterObservation is used to produce
+// v1alpha1.DBClusterObservation from docdb.DBCluster.
+func GenerateDBClusterObservation(o docdb.DBCluster) v1alpha1.DBClusterObservation {
+	obs := v1alpha1.DBClusterObservation{
+		DBClusterARN:            aws.StringValue(o.DBClusterArn),
+		DBClusterResourceID:     aws.StringValue(o.DbClusterResourceId),
+		Status:                  aws.StringValue(o.Status),
+		Endpoint:                aws.StringValue(o.Endpoint),
+		ReaderEndpoint:          aws.StringValue(o.ReaderEndpoint),
+		Port:                    aws.Int64Value(o.Port),
+		DBClusterParameterGroup: aws.StringValue(o.DBClusterParameterGroup),
+		HostedZoneID:            aws.StringValue(o.HostedZoneId),
+		MultiAZ:                 aws.BoolValue(o.MultiAZ),
+	}
+	for _, m := range o.DBClusterMembers {
+		obs.Members = append(obs.Members, v1alpha1.DBClusterMember{
+			DBInstanceIdentifier: aws.StringValue(m.DBInstanceIdentifier),
+			IsClusterWriter:      aws.BoolValue(m.IsClusterWriter),
+			PromotionTier:        aws.Int64Value(m.PromotionTier),
+		})
+	}
+	return obs
+}
+
+// LateInitializeDBCluster fills the empty fields in
+// *v1alpha1.DBClusterParameters with the values seen in docdb.DBCluster.
+func LateInitializeDBCluster(in *v1alpha1.DBClusterParameters, o *docdb.DBCluster) {
+	if o == nil {
+		return
+	}
+	in.EngineVersion = awsclients.LateInitializeStringPtr(in.EngineVersion, o.EngineVersion)
+	in.Port = awsclients.LateInitializeInt64Ptr(in.Port, o.Port)
+	in.DBClusterParameterGroupName = awsclients.LateInitializeStringPtr(in.DBClusterParameterGroupName, o.DBClusterParameterGroup)
+	in.DBSubnetGroupName = awsclients.LateInitializeStringPtr(in.DBSubnetGroupName, o.DBSubnetGroup)
+	in.BackupRetentionPeriod = awsclients.LateInitializeInt64Ptr(in.BackupRetentionPeriod, o.BackupRetentionPeriod)
+	in.PreferredBackupWindow = awsclients.LateInitializeStringPtr(in.PreferredBackupWindow, o.PreferredBackupWindow)
+	in.PreferredMaintenanceWindow = awsclients.LateInitializeStringPtr(in.PreferredMaintenanceWindow, o.PreferredMaintenanceWindow)
+	in.StorageEncrypted = awsclients.LateInitializeBoolPtr(in.StorageEncrypted, o.StorageEncrypted)
+	in.KMSKeyID = awsclients.LateInitializeStringPtr(in.KMSKeyID, o.KmsKeyId)
+	in.DeletionProtection = awsclients.LateInitializeBoolPtr(in.DeletionProtection, o.DeletionProtection)
+	if len(in.AvailabilityZones) == 0 {
+		in.AvailabilityZones = o.AvailabilityZones
+	}
+	if len(in.VPCSecurityGroupIDs) == 0 {
+		in.VPCSecurityGroupIDs = observedSecurityGroupIDs(*o)
+	}
+}
+
+// IsDBClusterUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsDBClusterUpToDate(p v1alpha1.DBClusterParameters, o docdb.DBCluster) bool {
+	in := GenerateModifyDBClusterInput(aws.StringValue(o.DBClusterIdentifier), p, o)
+	return cmp.Equal(&docdb.ModifyDBClusterInput{DBClusterIdentifier: in.DBClusterIdentifier, ApplyImmediately: in.ApplyImmediately}, in,
+		cmpopts.IgnoreUnexported(docdb.ModifyDBClusterInput{}))
+}
+
+// GetPassword returns the master password referenced by the given DBCluster,
+// if any, and whether it differs from the password in its connection secret.
+func GetPassword(ctx context.Context, kube client.Client, cr *v1alpha1.DBCluster) (pwd string, changed bool, err error) {
+	ref := cr.Spec.ForProvider.MasterPasswordSecretRef
+	if ref == nil {
+		return "", false, nil
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", false, errors.Wrap(err, errGetPasswordSecret)
+	}
+	pwd = string(s.Data[ref.Key])
+
+	if cr.Spec.WriteConnectionSecretToReference != nil {
+		conn := &corev1.Secret{}
+		nn := types.NamespacedName{
+			Name:      cr.Spec.WriteConnectionSecretToReference.Name,
+			Namespace: cr.Spec.WriteConnectionSecretToReference.Namespace,
+		}
+		// The connection secret doesn't exist until the DB cluster is created.
+		if err := kube.Get(ctx, nn, conn); resource.IgnoreNotFound(err) != nil {
+			return "", false, err
+		}
+		changed = pwd != "" && pwd != string(conn.Data[runtimev1alpha1.ResourceCredentialsSecretPasswordKey])
+	}
+	return pwd, changed, nil
+}
+
+// GetDBClusterConnectionDetails returns the connection details of the given
+// DBCluster, i.e. its endpoint, reader endpoint, port and master username.
+func GetDBClusterConnectionDetails(cr v1alpha1.DBCluster) managed.ConnectionDetails {
+	o := cr.Status.AtProvider
+	if o.Endpoint == "" {
+		return nil
+	}
+	cd := managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(o.Endpoint),
+		runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.FormatInt(o.Port, 10)),
+		runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(cr.Spec.ForProvider.MasterUsername),
+	}
+	if o.ReaderEndpoint != "" {
+		cd[ConnectionDetailsReaderEndpoint] = []byte(o.ReaderEndpoint)
+	}
+	return cd
+}
+
+func observedSecurityGroupIDs(o docdb.DBCluster) []string {
+	if len(o.VpcSecurityGroups) == 0 {
+		return nil
+	}
+	res := make([]string, len(o.VpcSecurityGroups))
+	for i, sg := range o.VpcSecurityGroups {
+		res[i] = aws.StringValue(sg.VpcSecurityGroupId)
+	}
+	return res
+}
+
+// diffLogTypes returns the log types that need to be enabled and disabled
+// to export the desired log types.
+func diffLogTypes(desired, observed []string) (enable, disable []string) {
+	d := make(map[string]bool, len(desired))
+	for _, t := range desired {
+		d[t] = true
+	}
+	o := make(map[string]bool, len(observed))
+	for _, t := range observed {
+		o[t] = true
+		if !d[t] {
+			disable = append(disable, t)
+		}
+	}
+	for _, t := range desired {
+		if !o[t] {
+			enable = append(enable, t)
+		}
+	}
+	return enable, disable
+}
+
+func sortStrings() cmp.Option {
+	return cmpopts.SortSlices(func(a, b string) bool { return a < b })
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docdb
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/docdb/v1alpha1"
+)
+
+func TestIsDBInstanceUpToDate(t *testing.T) {
+	observed := docdb.DBInstance{
+		DBInstanceIdentifier:    aws.String("some-instance"),
+		DBInstanceClass:         aws.String("db.r5.large"),
+		PromotionTier:           aws.Int64(1),
+		CACertificateIdentifier: aws.String("rds-ca-2019"),
+	}
+	cases := map[string]struct {
+		p    v1alpha1.DBInstanceParameters
+		o    docdb.DBInstance
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.DBInstanceParameters{
+				DBInstanceClass:         "db.r5.large",
+				CACertificateIdentifier: aws.String("rds-ca-2019"),
+				PromotionTier:           aws.Int64(1),
+			},
+			o:    observed,
+			want: true,
+		},
+		"InstanceClassChanged": {
+			p: v1alpha1.DBInstanceParameters{
+				DBInstanceClass: "db.r5.xlarge",
+			},
+			o:    observed,
+			want: false,
+		},
+		"InstanceClassChangePending": {
+			p: v1alpha1.DBInstanceParameters{
+				DBInstanceClass: "db.r5.xlarge",
+			},
+			o: func() docdb.DBInstance {
+				o := observed
+				o.PendingModifiedValues = &docdb.PendingModifiedValues{DBInstanceClass: aws.String("db.r5.xlarge")}
+				return o
+			}(),
+			want: true,
+		},
+		"CACertificateChanged": {
+			p: v1alpha1.DBInstanceParameters{
+				DBInstanceClass:         "db.r5.large",
+				CACertificateIdentifier: aws.String("rds-ca-2020"),
+			},
+			o:    observed,
+			want: false,
+		},
+		"PromotionTierChanged": {
+			p: v1alpha1.DBInstanceParameters{
+				DBInstanceClass: "db.r5.large",
+				PromotionTier:   aws.Int64(0),
+			},
+			o:    observed,
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDBInstanceUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
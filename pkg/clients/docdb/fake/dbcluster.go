@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/docdb"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DBClusterClient = (*MockDBClusterClient)(nil)
+
+// MockDBClusterClient is a type that implements all the methods for DBClusterClient interface
+type MockDBClusterClient struct {
+	MockDescribeDBClusters           func(*docdb.DescribeDBClustersInput) docdb.DescribeDBClustersRequest
+	MockCreateDBCluster              func(*docdb.CreateDBClusterInput) docdb.CreateDBClusterRequest
+	MockRestoreDBClusterFromSnapshot func(*docdb.RestoreDBClusterFromSnapshotInput) docdb.RestoreDBClusterFromSnapshotRequest
+	MockModifyDBCluster              func(*docdb.ModifyDBClusterInput) docdb.ModifyDBClusterRequest
+	MockDeleteDBCluster              func(*docdb.DeleteDBClusterInput) docdb.DeleteDBClusterRequest
+	MockListTagsForResource          func(*docdb.ListTagsForResourceInput) docdb.ListTagsForResourceRequest
+	MockAddTagsToResource            func(*docdb.AddTagsToResourceInput) docdb.AddTagsToResourceRequest
+	MockRemoveTagsFromResource       func(*docdb.RemoveTagsFromResourceInput) docdb.RemoveTagsFromResourceRequest
+}
+
+// DescribeDBClustersRequest mocks DescribeDBClustersRequest method
+func (m *MockDBClusterClient) DescribeDBClustersRequest(input *docdb.DescribeDBClustersInput) docdb.DescribeDBClustersRequest {
+	return m.MockDescribeDBClusters(input)
+}
+
+// CreateDBClusterRequest mocks CreateDBClusterRequest method
+func (m *MockDBClusterClient) CreateDBClusterRequest(input *docdb.CreateDBClusterInput) docdb.CreateDBClusterRequest {
+	return m.MockCreateDBCluster(input)
+}
+
+// RestoreDBClusterFromSnapshotRequest mocks RestoreDBClusterFromSnapshotRequest method
+func (m *MockDBClusterClient) RestoreDBClusterFromSnapshotRequest(input *docdb.RestoreDBClusterFromSnapshotInput) docdb.RestoreDBClusterFromSnapshotRequest {
+	return m.MockRestoreDBClusterFromSnapshot(input)
+}
+
+// ModifyDBClusterRequest mocks ModifyDBClusterRequest method
+func (m *MockDBClusterClient) ModifyDBClusterRequest(input *docdb.ModifyDBClusterInput) docdb.ModifyDBClusterRequest {
+	return m.MockModifyDBCluster(input)
+}
+
+// DeleteDBClusterRequest mocks DeleteDBClusterRequest method
+func (m *MockDBClusterClient) DeleteDBClusterRequest(input *docdb.DeleteDBClusterInput) docdb.DeleteDBClusterRequest {
+	return m.MockDeleteDBCluster(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockDBClusterClient) ListTagsForResourceRequest(input *docdb.ListTagsForResourceInput) docdb.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// AddTagsToResourceRequest mocks AddTagsToResourceRequest method
+func (m *MockDBClusterClient) AddTagsToResourceRequest(input *docdb.AddTagsToResourceInput) docdb.AddTagsToResourceRequest {
+	return m.MockAddTagsToResource(input)
+}
+
+// RemoveTagsFromResourceRequest mocks RemoveTagsFromResourceRequest method
+func (m *MockDBClusterClient) RemoveTagsFromResourceRequest(input *docdb.RemoveTagsFromResourceInput) docdb.RemoveTagsFromResourceRequest {
+	return m.MockRemoveTagsFromResource(input)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// CustomerGatewayIDNotFound is the code that is returned by ec2 when the given CustomerGatewayID is not valid
+	CustomerGatewayIDNotFound = "InvalidCustomerGatewayID.NotFound"
+)
+
+// CustomerGatewayClient is the external client used for CustomerGateway Custom Resource
+type CustomerGatewayClient interface {
+	CreateCustomerGatewayRequest(input *ec2.CreateCustomerGatewayInput) ec2.CreateCustomerGatewayRequest
+	DescribeCustomerGatewaysRequest(input *ec2.DescribeCustomerGatewaysInput) ec2.DescribeCustomerGatewaysRequest
+	DeleteCustomerGatewayRequest(input *ec2.DeleteCustomerGatewayInput) ec2.DeleteCustomerGatewayRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewCustomerGatewayClient returns a new client using AWS credentials as JSON encoded data.
+func NewCustomerGatewayClient(cfg aws.Config) CustomerGatewayClient {
+	return ec2.New(cfg)
+}
+
+// IsCustomerGatewayNotFoundErr returns true if the error is because the item doesn't exist
+func IsCustomerGatewayNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == CustomerGatewayIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateCustomerGatewayInput returns the input for a
+// CreateCustomerGateway request built from the given parameters.
+func GenerateCreateCustomerGatewayInput(p v1alpha1.CustomerGatewayParameters) *ec2.CreateCustomerGatewayInput {
+	return &ec2.CreateCustomerGatewayInput{
+		BgpAsn:         aws.Int64(p.BGPASN),
+		PublicIp:       p.IPAddress,
+		CertificateArn: p.CertificateARN,
+		DeviceName:     p.DeviceName,
+		Type:           ec2.GatewayType(p.Type),
+	}
+}
+
+// GenerateCustomerGatewayObservation is used to produce
+// v1alpha1.CustomerGatewayObservation from ec2.CustomerGateway.
+func GenerateCustomerGatewayObservation(cgw ec2.CustomerGateway) v1alpha1.CustomerGatewayObservation {
+	return v1alpha1.CustomerGatewayObservation{
+		CustomerGatewayID: aws.StringValue(cgw.CustomerGatewayId),
+		State:             aws.StringValue(cgw.State),
+	}
+}
+
+// LateInitializeCustomerGateway fills the empty fields in
+// *v1alpha1.CustomerGatewayParameters with the values seen in
+// ec2.CustomerGateway.
+func LateInitializeCustomerGateway(in *v1alpha1.CustomerGatewayParameters, cgw *ec2.CustomerGateway) {
+	if cgw == nil {
+		return
+	}
+
+	in.IPAddress = awsclients.LateInitializeStringPtr(in.IPAddress, cgw.IpAddress)
+	in.CertificateARN = awsclients.LateInitializeStringPtr(in.CertificateARN, cgw.CertificateArn)
+	in.DeviceName = awsclients.LateInitializeStringPtr(in.DeviceName, cgw.DeviceName)
+
+	if len(in.Tags) == 0 && len(cgw.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(cgw.Tags)
+	}
+}
@@ -0,0 +1,102 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+const (
+	// DHCPOptionsIDNotFound is the code that is returned by ec2 when the given DHCPOptionsID is not valid
+	DHCPOptionsIDNotFound = "InvalidDhcpOptionID.NotFound"
+
+	// DefaultDHCPOptionsID is the ID that associates a VPC with the default
+	// DHCP options of the region.
+	DefaultDHCPOptionsID = "default"
+)
+
+// DHCP option keys.
+const (
+	dhcpOptionDomainName         = "domain-name"
+	dhcpOptionDomainNameServers  = "domain-name-servers"
+	dhcpOptionNTPServers         = "ntp-servers"
+	dhcpOptionNetBIOSNameServers = "netbios-name-servers"
+	dhcpOptionNetBIOSNodeType    = "netbios-node-type"
+)
+
+// DHCPOptionsClient is the external client used for DHCPOptions Custom Resource
+type DHCPOptionsClient interface {
+	CreateDhcpOptionsRequest(input *ec2.CreateDhcpOptionsInput) ec2.CreateDhcpOptionsRequest
+	DescribeDhcpOptionsRequest(input *ec2.DescribeDhcpOptionsInput) ec2.DescribeDhcpOptionsRequest
+	DeleteDhcpOptionsRequest(input *ec2.DeleteDhcpOptionsInput) ec2.DeleteDhcpOptionsRequest
+	AssociateDhcpOptionsRequest(input *ec2.AssociateDhcpOptionsInput) ec2.AssociateDhcpOptionsRequest
+	DescribeVpcsRequest(input *ec2.DescribeVpcsInput) ec2.DescribeVpcsRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewDHCPOptionsClient returns a new client using AWS credentials as JSON encoded data.
+func NewDHCPOptionsClient(cfg aws.Config) DHCPOptionsClient {
+	return ec2.New(cfg)
+}
+
+// IsDHCPOptionsNotFoundErr returns true if the error is because the item doesn't exist
+func IsDHCPOptionsNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == DHCPOptionsIDNotFound
+	}
+	return false
+}
+
+// GenerateCreateDhcpOptionsInput returns the input for a CreateDhcpOptions
+// request built from the given parameters.
+func GenerateCreateDhcpOptionsInput(p v1alpha1.DHCPOptionsParameters) *ec2.CreateDhcpOptionsInput {
+	in := &ec2.CreateDhcpOptionsInput{}
+	add := func(key string, values ...string) {
+		if len(values) != 0 {
+			in.DhcpConfigurations = append(in.DhcpConfigurations, ec2.NewDhcpConfiguration{Key: aws.String(key), Values: values})
+		}
+	}
+	if p.DomainName != nil {
+		add(dhcpOptionDomainName, aws.StringValue(p.DomainName))
+	}
+	add(dhcpOptionDomainNameServers, p.DomainNameServers...)
+	add(dhcpOptionNTPServers, p.NTPServers...)
+	add(dhcpOptionNetBIOSNameServers, p.NetBIOSNameServers...)
+	if p.NetBIOSNodeType != nil {
+		add(dhcpOptionNetBIOSNodeType, aws.StringValue(p.NetBIOSNodeType))
+	}
+	return in
+}
+
+// GenerateDHCPOptionsObservation is used to produce
+// v1alpha1.DHCPOptionsObservation from ec2.DhcpOptions.
+func GenerateDHCPOptionsObservation(o ec2.DhcpOptions) v1alpha1.DHCPOptionsObservation {
+	return v1alpha1.DHCPOptionsObservation{
+		DHCPOptionsID: aws.StringValue(o.DhcpOptionsId),
+		OwnerID:       aws.StringValue(o.OwnerId),
+	}
+}
+
+// LateInitializeDHCPOptions fills the empty fields in
+// *v1alpha1.DHCPOptionsParameters with the values seen in ec2.DhcpOptions.
+func LateInitializeDHCPOptions(in *v1alpha1.DHCPOptionsParameters, o *ec2.DhcpOptions) {
+	if o == nil {
+		return
+	}
+	if len(in.Tags) == 0 && len(o.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(o.Tags)
+	}
+}
+
+// IsDHCPOptionsUpToDate checks whether the tags of the DHCP options set are up
+// to date and whether it is associated with the desired VPC, if any.
+func IsDHCPOptionsUpToDate(p v1alpha1.DHCPOptionsParameters, o ec2.DhcpOptions, vpc *ec2.Vpc) bool {
+	if p.VPCID != nil && (vpc == nil || aws.StringValue(vpc.DhcpOptionsId) != aws.StringValue(o.DhcpOptionsId)) {
+		return false
+	}
+	return v1beta1.CompareTags(p.Tags, o.Tags)
+}
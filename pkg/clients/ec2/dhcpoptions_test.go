@@ -0,0 +1,101 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestGenerateCreateDhcpOptionsInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DHCPOptionsParameters
+		want *ec2.CreateDhcpOptionsInput
+	}{
+		"AllOptions": {
+			p: v1alpha1.DHCPOptionsParameters{
+				DomainName:         aws.String("example.internal"),
+				DomainNameServers:  []string{"10.0.0.2", "10.0.0.3"},
+				NTPServers:         []string{"169.254.169.123"},
+				NetBIOSNameServers: []string{"10.0.0.4"},
+				NetBIOSNodeType:    aws.String("2"),
+			},
+			want: &ec2.CreateDhcpOptionsInput{
+				DhcpConfigurations: []ec2.NewDhcpConfiguration{
+					{Key: aws.String("domain-name"), Values: []string{"example.internal"}},
+					{Key: aws.String("domain-name-servers"), Values: []string{"10.0.0.2", "10.0.0.3"}},
+					{Key: aws.String("ntp-servers"), Values: []string{"169.254.169.123"}},
+					{Key: aws.String("netbios-name-servers"), Values: []string{"10.0.0.4"}},
+					{Key: aws.String("netbios-node-type"), Values: []string{"2"}},
+				},
+			},
+		},
+		"EmptyOptionsOmitted": {
+			p: v1alpha1.DHCPOptionsParameters{
+				DomainNameServers: []string{"AmazonProvidedDNS"},
+			},
+			want: &ec2.CreateDhcpOptionsInput{
+				DhcpConfigurations: []ec2.NewDhcpConfiguration{
+					{Key: aws.String("domain-name-servers"), Values: []string{"AmazonProvidedDNS"}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateDhcpOptionsInput(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDHCPOptionsUpToDate(t *testing.T) {
+	id := "dopt-1"
+	tags := []v1beta1.Tag{{Key: "k", Value: "v"}}
+
+	cases := map[string]struct {
+		p    v1alpha1.DHCPOptionsParameters
+		o    ec2.DhcpOptions
+		vpc  *ec2.Vpc
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.DHCPOptionsParameters{VPCID: aws.String("vpc-1"), Tags: tags},
+			o:    ec2.DhcpOptions{DhcpOptionsId: aws.String(id), Tags: []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}}},
+			vpc:  &ec2.Vpc{VpcId: aws.String("vpc-1"), DhcpOptionsId: aws.String(id)},
+			want: true,
+		},
+		"NoVPC": {
+			p:    v1alpha1.DHCPOptionsParameters{},
+			o:    ec2.DhcpOptions{DhcpOptionsId: aws.String(id)},
+			want: true,
+		},
+		"VPCNotAssociated": {
+			p:    v1alpha1.DHCPOptionsParameters{VPCID: aws.String("vpc-1")},
+			o:    ec2.DhcpOptions{DhcpOptionsId: aws.String(id)},
+			vpc:  &ec2.Vpc{VpcId: aws.String("vpc-1"), DhcpOptionsId: aws.String("default")},
+			want: false,
+		},
+		"TagsChanged": {
+			p:    v1alpha1.DHCPOptionsParameters{Tags: tags},
+			o:    ec2.DhcpOptions{DhcpOptionsId: aws.String(id)},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDHCPOptionsUpToDate(tc.p, tc.o, tc.vpc)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
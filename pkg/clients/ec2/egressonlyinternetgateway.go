@@ -0,0 +1,78 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// EgressOnlyInternetGatewayIDNotFound is the code that is returned by ec2 when the given EgressOnlyInternetGatewayID is not valid
+	EgressOnlyInternetGatewayIDNotFound = "InvalidGatewayID.NotFound"
+)
+
+// EgressOnlyInternetGatewayClient is the external client used for EgressOnlyInternetGateway Custom Resource
+type EgressOnlyInternetGatewayClient interface {
+	CreateEgressOnlyInternetGatewayRequest(input *ec2.CreateEgressOnlyInternetGatewayInput) ec2.CreateEgressOnlyInternetGatewayRequest
+	DescribeEgressOnlyInternetGatewaysRequest(input *ec2.DescribeEgressOnlyInternetGatewaysInput) ec2.DescribeEgressOnlyInternetGatewaysRequest
+	DeleteEgressOnlyInternetGatewayRequest(input *ec2.DeleteEgressOnlyInternetGatewayInput) ec2.DeleteEgressOnlyInternetGatewayRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewEgressOnlyInternetGatewayClient returns a new client using AWS credentials as JSON encoded data.
+func NewEgressOnlyInternetGatewayClient(cfg aws.Config) EgressOnlyInternetGatewayClient {
+	return ec2.New(cfg)
+}
+
+// IsEgressOnlyInternetGatewayNotFoundErr returns true if the error is because the item doesn't exist
+func IsEgressOnlyInternetGatewayNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == EgressOnlyInternetGatewayIDNotFound
+	}
+	return false
+}
+
+// GenerateEgressOnlyInternetGatewayObservation is used to produce
+// v1alpha1.EgressOnlyInternetGatewayObservation from
+// ec2.EgressOnlyInternetGateway.
+func GenerateEgressOnlyInternetGatewayObservation(g ec2.EgressOnlyInternetGateway) v1alpha1.EgressOnlyInternetGatewayObservation {
+	o := v1alpha1.EgressOnlyInternetGatewayObservation{
+		EgressOnlyInternetGatewayID: aws.StringValue(g.EgressOnlyInternetGatewayId),
+	}
+	if len(g.Attachments) > 0 {
+		o.Attachments = make([]v1beta1.InternetGatewayAttachment, len(g.Attachments))
+		for i, a := range g.Attachments {
+			o.Attachments[i] = v1beta1.InternetGatewayAttachment{
+				AttachmentStatus: string(a.State),
+				VPCID:            aws.StringValue(a.VpcId),
+			}
+		}
+	}
+	return o
+}
+
+// LateInitializeEgressOnlyInternetGateway fills the empty fields in
+// *v1alpha1.EgressOnlyInternetGatewayParameters with the values seen in
+// ec2.EgressOnlyInternetGateway.
+func LateInitializeEgressOnlyInternetGateway(in *v1alpha1.EgressOnlyInternetGatewayParameters, g *ec2.EgressOnlyInternetGateway) {
+	if g == nil {
+		return
+	}
+	if len(g.Attachments) != 0 {
+		in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, g.Attachments[0].VpcId)
+	}
+	if len(in.Tags) == 0 && len(g.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(g.Tags)
+	}
+}
+
+// IsEgressOnlyInternetGatewayUpToDate checks whether the tags of the gateway,
+// the only field that can be modified, are up to date.
+func IsEgressOnlyInternetGatewayUpToDate(p v1alpha1.EgressOnlyInternetGatewayParameters, g ec2.EgressOnlyInternetGateway) bool {
+	return v1beta1.CompareTags(p.Tags, g.Tags)
+}
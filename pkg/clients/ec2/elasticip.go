@@ -22,6 +22,8 @@ type ElasticIPClient interface {
 	AllocateAddressRequest(input *ec2.AllocateAddressInput) ec2.AllocateAddressRequest
 	DescribeAddressesRequest(input *ec2.DescribeAddressesInput) ec2.DescribeAddressesRequest
 	ReleaseAddressRequest(input *ec2.ReleaseAddressInput) ec2.ReleaseAddressRequest
+	AssociateAddressRequest(input *ec2.AssociateAddressInput) ec2.AssociateAddressRequest
+	DisassociateAddressRequest(input *ec2.DisassociateAddressInput) ec2.DisassociateAddressRequest
 	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
 }
 
@@ -72,7 +74,49 @@ func LateInitializeElasticIP(in *v1alpha1.ElasticIPParameters, a *ec2.Address) {
 
 // IsElasticIPUpToDate checks whether there is a change in any of the modifiable fields.
 func IsElasticIPUpToDate(e v1alpha1.ElasticIPParameters, a ec2.Address) bool {
-	return v1beta1.CompareTags(e.Tags, a.Tags)
+	return v1beta1.CompareTags(e.Tags, a.Tags) && IsElasticIPAssociationUpToDate(e, GenerateElasticIPObservation(a))
+}
+
+// IsElasticIPAssociationManaged returns whether the association of the
+// address is managed, i.e. an instance or network interface is specified.
+func IsElasticIPAssociationManaged(e v1alpha1.ElasticIPParameters) bool {
+	return e.InstanceID != nil || e.NetworkInterfaceID != nil
+}
+
+// IsElasticIPAssociationUpToDate checks whether the address is associated
+// with the specified instance or network interface. Addresses whose
+// association is not managed are always up to date.
+func IsElasticIPAssociationUpToDate(e v1alpha1.ElasticIPParameters, o v1alpha1.ElasticIPObservation) bool {
+	if !IsElasticIPAssociationManaged(e) {
+		return true
+	}
+	switch {
+	case e.InstanceID != nil && aws.StringValue(e.InstanceID) != o.InstanceID:
+		return false
+	case e.NetworkInterfaceID != nil && aws.StringValue(e.NetworkInterfaceID) != o.NetworkInterfaceID:
+		return false
+	case e.PrivateIPAddress != nil && aws.StringValue(e.PrivateIPAddress) != o.PrivateIPAddress:
+		return false
+	}
+	return true
+}
+
+// GenerateAssociateAddressInput returns the input to associate the address
+// with the given external name with the specified instance or network
+// interface.
+func GenerateAssociateAddressInput(name string, e v1alpha1.ElasticIPParameters) *ec2.AssociateAddressInput {
+	in := &ec2.AssociateAddressInput{
+		InstanceId:         e.InstanceID,
+		NetworkInterfaceId: e.NetworkInterfaceID,
+		PrivateIpAddress:   e.PrivateIPAddress,
+	}
+	if IsStandardDomain(e) {
+		in.PublicIp = aws.String(name)
+		return in
+	}
+	in.AllocationId = aws.String(name)
+	in.AllowReassociation = aws.Bool(true)
+	return in
 }
 
 // IsStandardDomain checks whether it is set for standard domain
@@ -106,6 +106,38 @@ func TestIsEIPUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"UnmanagedAssociation": {
+			args: args{
+				eip: ec2.Address{
+					InstanceId: aws.String("i-1"),
+				},
+				e: v1alpha1.ElasticIPParameters{},
+			},
+			want: true,
+		},
+		"DifferentInstance": {
+			args: args{
+				eip: ec2.Address{
+					InstanceId: aws.String("i-1"),
+				},
+				e: v1alpha1.ElasticIPParameters{
+					InstanceID: aws.String("i-2"),
+				},
+			},
+			want: false,
+		},
+		"SameNetworkInterface": {
+			args: args{
+				eip: ec2.Address{
+					InstanceId:         aws.String("i-1"),
+					NetworkInterfaceId: aws.String("eni-1"),
+				},
+				e: v1alpha1.ElasticIPParameters{
+					NetworkInterfaceID: aws.String("eni-1"),
+				},
+			},
+			want: true,
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.CustomerGatewayClient = (*MockCustomerGatewayClient)(nil)
+
+// MockCustomerGatewayClient is a type that implements all the methods for CustomerGatewayClient interface
+type MockCustomerGatewayClient struct {
+	MockCreateCustomerGateway    func(*ec2.CreateCustomerGatewayInput) ec2.CreateCustomerGatewayRequest
+	MockDescribeCustomerGateways func(*ec2.DescribeCustomerGatewaysInput) ec2.DescribeCustomerGatewaysRequest
+	MockDeleteCustomerGateway    func(*ec2.DeleteCustomerGatewayInput) ec2.DeleteCustomerGatewayRequest
+	MockCreateTags               func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags               func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateCustomerGatewayRequest mocks CreateCustomerGatewayRequest method
+func (m *MockCustomerGatewayClient) CreateCustomerGatewayRequest(input *ec2.CreateCustomerGatewayInput) ec2.CreateCustomerGatewayRequest {
+	return m.MockCreateCustomerGateway(input)
+}
+
+// DescribeCustomerGatewaysRequest mocks DescribeCustomerGatewaysRequest method
+func (m *MockCustomerGatewayClient) DescribeCustomerGatewaysRequest(input *ec2.DescribeCustomerGatewaysInput) ec2.DescribeCustomerGatewaysRequest {
+	return m.MockDescribeCustomerGateways(input)
+}
+
+// DeleteCustomerGatewayRequest mocks DeleteCustomerGatewayRequest method
+func (m *MockCustomerGatewayClient) DeleteCustomerGatewayRequest(input *ec2.DeleteCustomerGatewayInput) ec2.DeleteCustomerGatewayRequest {
+	return m.MockDeleteCustomerGateway(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockCustomerGatewayClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockCustomerGatewayClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.EgressOnlyInternetGatewayClient = (*MockEgressOnlyInternetGatewayClient)(nil)
+
+// MockEgressOnlyInternetGatewayClient is a type that implements all the methods for EgressOnlyInternetGatewayClient interface
+type MockEgressOnlyInternetGatewayClient struct {
+	MockCreateEgressOnlyInternetGateway    func(*ec2.CreateEgressOnlyInternetGatewayInput) ec2.CreateEgressOnlyInternetGatewayRequest
+	MockDescribeEgressOnlyInternetGateways func(*ec2.DescribeEgressOnlyInternetGatewaysInput) ec2.DescribeEgressOnlyInternetGatewaysRequest
+	MockDeleteEgressOnlyInternetGateway    func(*ec2.DeleteEgressOnlyInternetGatewayInput) ec2.DeleteEgressOnlyInternetGatewayRequest
+	MockCreateTags                         func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags                         func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateEgressOnlyInternetGatewayRequest mocks CreateEgressOnlyInternetGatewayRequest method
+func (m *MockEgressOnlyInternetGatewayClient) CreateEgressOnlyInternetGatewayRequest(input *ec2.CreateEgressOnlyInternetGatewayInput) ec2.CreateEgressOnlyInternetGatewayRequest {
+	return m.MockCreateEgressOnlyInternetGateway(input)
+}
+
+// DescribeEgressOnlyInternetGatewaysRequest mocks DescribeEgressOnlyInternetGatewaysRequest method
+func (m *MockEgressOnlyInternetGatewayClient) DescribeEgressOnlyInternetGatewaysRequest(input *ec2.DescribeEgressOnlyInternetGatewaysInput) ec2.DescribeEgressOnlyInternetGatewaysRequest {
+	return m.MockDescribeEgressOnlyInternetGateways(input)
+}
+
+// DeleteEgressOnlyInternetGatewayRequest mocks DeleteEgressOnlyInternetGatewayRequest method
+func (m *MockEgressOnlyInternetGatewayClient) DeleteEgressOnlyInternetGatewayRequest(input *ec2.DeleteEgressOnlyInternetGatewayInput) ec2.DeleteEgressOnlyInternetGatewayRequest {
+	return m.MockDeleteEgressOnlyInternetGateway(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockEgressOnlyInternetGatewayClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockEgressOnlyInternetGatewayClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
@@ -31,6 +31,8 @@ type MockElasticIPClient struct {
 	MockRelease           func(*ec2.ReleaseAddressInput) ec2.ReleaseAddressRequest
 	MockDescribe          func(*ec2.DescribeAddressesInput) ec2.DescribeAddressesRequest
 	MockCreateTagsRequest func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockAssociate         func(*ec2.AssociateAddressInput) ec2.AssociateAddressRequest
+	MockDisassociate      func(*ec2.DisassociateAddressInput) ec2.DisassociateAddressRequest
 }
 
 // AllocateAddressRequest mocks AllocateAddressRequest method
@@ -52,3 +54,13 @@ func (m *MockElasticIPClient) DescribeAddressesRequest(input *ec2.DescribeAddres
 func (m *MockElasticIPClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
 	return m.MockCreateTagsRequest(input)
 }
+
+// AssociateAddressRequest mocks AssociateAddressRequest method
+func (m *MockElasticIPClient) AssociateAddressRequest(input *ec2.AssociateAddressInput) ec2.AssociateAddressRequest {
+	return m.MockAssociate(input)
+}
+
+// DisassociateAddressRequest mocks DisassociateAddressRequest method
+func (m *MockElasticIPClient) DisassociateAddressRequest(input *ec2.DisassociateAddressInput) ec2.DisassociateAddressRequest {
+	return m.MockDisassociate(input)
+}
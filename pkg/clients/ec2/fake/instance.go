@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.InstanceClient = (*MockInstanceClient)(nil)
+
+// MockInstanceClient is a type that implements all the methods for InstanceClient interface
+type MockInstanceClient struct {
+	MockRunInstances            func(*ec2.RunInstancesInput) ec2.RunInstancesRequest
+	MockDescribeInstances       func(*ec2.DescribeInstancesInput) ec2.DescribeInstancesRequest
+	MockStartInstances          func(*ec2.StartInstancesInput) ec2.StartInstancesRequest
+	MockStopInstances           func(*ec2.StopInstancesInput) ec2.StopInstancesRequest
+	MockModifyInstanceAttribute func(*ec2.ModifyInstanceAttributeInput) ec2.ModifyInstanceAttributeRequest
+	MockTerminateInstances      func(*ec2.TerminateInstancesInput) ec2.TerminateInstancesRequest
+	MockCreateTags              func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags              func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// RunInstancesRequest mocks RunInstancesRequest method
+func (m *MockInstanceClient) RunInstancesRequest(input *ec2.RunInstancesInput) ec2.RunInstancesRequest {
+	return m.MockRunInstances(input)
+}
+
+// DescribeInstancesRequest mocks DescribeInstancesRequest method
+func (m *MockInstanceClient) DescribeInstancesRequest(input *ec2.DescribeInstancesInput) ec2.DescribeInstancesRequest {
+	return m.MockDescribeInstances(input)
+}
+
+// StartInstancesRequest mocks StartInstancesRequest method
+func (m *MockInstanceClient) StartInstancesRequest(input *ec2.StartInstancesInput) ec2.StartInstancesRequest {
+	return m.MockStartInstances(input)
+}
+
+// StopInstancesRequest mocks StopInstancesRequest method
+func (m *MockInstanceClient) StopInstancesRequest(input *ec2.StopInstancesInput) ec2.StopInstancesRequest {
+	return m.MockStopInstances(input)
+}
+
+// ModifyInstanceAttributeRequest mocks ModifyInstanceAttributeRequest method
+func (m *MockInstanceClient) ModifyInstanceAttributeRequest(input *ec2.ModifyInstanceAttributeInput) ec2.ModifyInstanceAttributeRequest {
+	return m.MockModifyInstanceAttribute(input)
+}
+
+// TerminateInstancesRequest mocks TerminateInstancesRequest method
+func (m *MockInstanceClient) TerminateInstancesRequest(input *ec2.TerminateInstancesInput) ec2.TerminateInstancesRequest {
+	return m.MockTerminateInstances(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockInstanceClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockInstanceClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
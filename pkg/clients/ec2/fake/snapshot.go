@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.SnapshotClient = (*MockSnapshotClient)(nil)
+
+// MockSnapshotClient is a type that implements all the methods for SnapshotClient interface
+type MockSnapshotClient struct {
+	MockCreateSnapshot    func(*ec2.CreateSnapshotInput) ec2.CreateSnapshotRequest
+	MockDescribeSnapshots func(*ec2.DescribeSnapshotsInput) ec2.DescribeSnapshotsRequest
+	MockDeleteSnapshot    func(*ec2.DeleteSnapshotInput) ec2.DeleteSnapshotRequest
+	MockCreateTags        func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags        func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateSnapshotRequest mocks CreateSnapshotRequest method
+func (m *MockSnapshotClient) CreateSnapshotRequest(input *ec2.CreateSnapshotInput) ec2.CreateSnapshotRequest {
+	return m.MockCreateSnapshot(input)
+}
+
+// DescribeSnapshotsRequest mocks DescribeSnapshotsRequest method
+func (m *MockSnapshotClient) DescribeSnapshotsRequest(input *ec2.DescribeSnapshotsInput) ec2.DescribeSnapshotsRequest {
+	return m.MockDescribeSnapshots(input)
+}
+
+// DeleteSnapshotRequest mocks DeleteSnapshotRequest method
+func (m *MockSnapshotClient) DeleteSnapshotRequest(input *ec2.DeleteSnapshotInput) ec2.DeleteSnapshotRequest {
+	return m.MockDeleteSnapshot(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockSnapshotClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockSnapshotClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TransitGatewayClient = (*MockTransitGatewayClient)(nil)
+
+// MockTransitGatewayClient is a type that implements all the methods for TransitGatewayClient interface
+type MockTransitGatewayClient struct {
+	MockCreateTransitGateway    func(*ec2.CreateTransitGatewayInput) ec2.CreateTransitGatewayRequest
+	MockDescribeTransitGateways func(*ec2.DescribeTransitGatewaysInput) ec2.DescribeTransitGatewaysRequest
+	MockDeleteTransitGateway    func(*ec2.DeleteTransitGatewayInput) ec2.DeleteTransitGatewayRequest
+	MockCreateTags              func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags              func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateTransitGatewayRequest mocks CreateTransitGatewayRequest method
+func (m *MockTransitGatewayClient) CreateTransitGatewayRequest(input *ec2.CreateTransitGatewayInput) ec2.CreateTransitGatewayRequest {
+	return m.MockCreateTransitGateway(input)
+}
+
+// DescribeTransitGatewaysRequest mocks DescribeTransitGatewaysRequest method
+func (m *MockTransitGatewayClient) DescribeTransitGatewaysRequest(input *ec2.DescribeTransitGatewaysInput) ec2.DescribeTransitGatewaysRequest {
+	return m.MockDescribeTransitGateways(input)
+}
+
+// DeleteTransitGatewayRequest mocks DeleteTransitGatewayRequest method
+func (m *MockTransitGatewayClient) DeleteTransitGatewayRequest(input *ec2.DeleteTransitGatewayInput) ec2.DeleteTransitGatewayRequest {
+	return m.MockDeleteTransitGateway(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockTransitGatewayClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockTransitGatewayClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
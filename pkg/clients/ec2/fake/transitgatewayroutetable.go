@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TransitGatewayRouteTableClient = (*MockTransitGatewayRouteTableClient)(nil)
+
+// MockTransitGatewayRouteTableClient is a type that implements all the methods for TransitGatewayRouteTableClient interface
+type MockTransitGatewayRouteTableClient struct {
+	MockCreateTransitGatewayRouteTable    func(*ec2.CreateTransitGatewayRouteTableInput) ec2.CreateTransitGatewayRouteTableRequest
+	MockDescribeTransitGatewayRouteTables func(*ec2.DescribeTransitGatewayRouteTablesInput) ec2.DescribeTransitGatewayRouteTablesRequest
+	MockDeleteTransitGatewayRouteTable    func(*ec2.DeleteTransitGatewayRouteTableInput) ec2.DeleteTransitGatewayRouteTableRequest
+	MockCreateTags                        func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags                        func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateTransitGatewayRouteTableRequest mocks CreateTransitGatewayRouteTableRequest method
+func (m *MockTransitGatewayRouteTableClient) CreateTransitGatewayRouteTableRequest(input *ec2.CreateTransitGatewayRouteTableInput) ec2.CreateTransitGatewayRouteTableRequest {
+	return m.MockCreateTransitGatewayRouteTable(input)
+}
+
+// DescribeTransitGatewayRouteTablesRequest mocks DescribeTransitGatewayRouteTablesRequest method
+func (m *MockTransitGatewayRouteTableClient) DescribeTransitGatewayRouteTablesRequest(input *ec2.DescribeTransitGatewayRouteTablesInput) ec2.DescribeTransitGatewayRouteTablesRequest {
+	return m.MockDescribeTransitGatewayRouteTables(input)
+}
+
+// DeleteTransitGatewayRouteTableRequest mocks DeleteTransitGatewayRouteTableRequest method
+func (m *MockTransitGatewayRouteTableClient) DeleteTransitGatewayRouteTableRequest(input *ec2.DeleteTransitGatewayRouteTableInput) ec2.DeleteTransitGatewayRouteTableRequest {
+	return m.MockDeleteTransitGatewayRouteTable(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockTransitGatewayRouteTableClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockTransitGatewayRouteTableClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TransitGatewayVPCAttachmentClient = (*MockTransitGatewayVPCAttachmentClient)(nil)
+
+// MockTransitGatewayVPCAttachmentClient is a type that implements all the methods for TransitGatewayVPCAttachmentClient interface
+type MockTransitGatewayVPCAttachmentClient struct {
+	MockCreateTransitGatewayVpcAttachment    func(*ec2.CreateTransitGatewayVpcAttachmentInput) ec2.CreateTransitGatewayVpcAttachmentRequest
+	MockDescribeTransitGatewayVpcAttachments func(*ec2.DescribeTransitGatewayVpcAttachmentsInput) ec2.DescribeTransitGatewayVpcAttachmentsRequest
+	MockModifyTransitGatewayVpcAttachment    func(*ec2.ModifyTransitGatewayVpcAttachmentInput) ec2.ModifyTransitGatewayVpcAttachmentRequest
+	MockDeleteTransitGatewayVpcAttachment    func(*ec2.DeleteTransitGatewayVpcAttachmentInput) ec2.DeleteTransitGatewayVpcAttachmentRequest
+	MockCreateTags                           func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags                           func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateTransitGatewayVpcAttachmentRequest mocks CreateTransitGatewayVpcAttachmentRequest method
+func (m *MockTransitGatewayVPCAttachmentClient) CreateTransitGatewayVpcAttachmentRequest(input *ec2.CreateTransitGatewayVpcAttachmentInput) ec2.CreateTransitGatewayVpcAttachmentRequest {
+	return m.MockCreateTransitGatewayVpcAttachment(input)
+}
+
+// DescribeTransitGatewayVpcAttachmentsRequest mocks DescribeTransitGatewayVpcAttachmentsRequest method
+func (m *MockTransitGatewayVPCAttachmentClient) DescribeTransitGatewayVpcAttachmentsRequest(input *ec2.DescribeTransitGatewayVpcAttachmentsInput) ec2.DescribeTransitGatewayVpcAttachmentsRequest {
+	return m.MockDescribeTransitGatewayVpcAttachments(input)
+}
+
+// ModifyTransitGatewayVpcAttachmentRequest mocks ModifyTransitGatewayVpcAttachmentRequest method
+func (m *MockTransitGatewayVPCAttachmentClient) ModifyTransitGatewayVpcAttachmentRequest(input *ec2.ModifyTransitGatewayVpcAttachmentInput) ec2.ModifyTransitGatewayVpcAttachmentRequest {
+	return m.MockModifyTransitGatewayVpcAttachment(input)
+}
+
+// DeleteTransitGatewayVpcAttachmentRequest mocks DeleteTransitGatewayVpcAttachmentRequest method
+func (m *MockTransitGatewayVPCAttachmentClient) DeleteTransitGatewayVpcAttachmentRequest(input *ec2.DeleteTransitGatewayVpcAttachmentInput) ec2.DeleteTransitGatewayVpcAttachmentRequest {
+	return m.MockDeleteTransitGatewayVpcAttachment(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockTransitGatewayVPCAttachmentClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockTransitGatewayVPCAttachmentClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.VPCEndpointServiceConfigurationClient = (*MockVPCEndpointServiceConfigurationClient)(nil)
+
+// MockVPCEndpointServiceConfigurationClient is a type that implements all the methods for VPCEndpointServiceConfigurationClient interface
+type MockVPCEndpointServiceConfigurationClient struct {
+	MockCreateVpcEndpointServiceConfiguration         func(*ec2.CreateVpcEndpointServiceConfigurationInput) ec2.CreateVpcEndpointServiceConfigurationRequest
+	MockDescribeVpcEndpointServiceConfigurations      func(*ec2.DescribeVpcEndpointServiceConfigurationsInput) ec2.DescribeVpcEndpointServiceConfigurationsRequest
+	MockModifyVpcEndpointServiceConfiguration         func(*ec2.ModifyVpcEndpointServiceConfigurationInput) ec2.ModifyVpcEndpointServiceConfigurationRequest
+	MockDeleteVpcEndpointServiceConfigurations        func(*ec2.DeleteVpcEndpointServiceConfigurationsInput) ec2.DeleteVpcEndpointServiceConfigurationsRequest
+	MockDescribeVpcEndpointServicePermissions         func(*ec2.DescribeVpcEndpointServicePermissionsInput) ec2.DescribeVpcEndpointServicePermissionsRequest
+	MockModifyVpcEndpointServicePermissions           func(*ec2.ModifyVpcEndpointServicePermissionsInput) ec2.ModifyVpcEndpointServicePermissionsRequest
+	MockStartVpcEndpointServicePrivateDnsVerification func(*ec2.StartVpcEndpointServicePrivateDnsVerificationInput) ec2.StartVpcEndpointServicePrivateDnsVerificationRequest
+	MockCreateTags                                    func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags                                    func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateVpcEndpointServiceConfigurationRequest mocks CreateVpcEndpointServiceConfigurationRequest method
+func (m *MockVPCEndpointServiceConfigurationClient) CreateVpcEndpointServiceConfigurationRequest(input *ec2.CreateVpcEndpointServiceConfigurationInput) ec2.CreateVpcEndpointServiceConfigurationRequest {
+	return m.MockCreateVpcEndpointServiceConfiguration(input)
+}
+
+// DescribeVpcEndpointServiceConfigurationsRequest mocks DescribeVpcEndpointServiceConfigurationsRequest method
+func (m *MockVPCEndpointServiceConfigurationClient) DescribeVpcEndpointServiceConfigurationsRequest(input *ec2.DescribeVpcEndpointServiceConfigurationsInput) ec2.DescribeVpcEndpointServiceConfigurationsRequest {
+	return m.MockDescribeVpcEndpointServiceConfigurations(input)
+}
+
+// ModifyVpcEndpointServiceConfigurationRequest mocks ModifyVpcEndpointServiceConfigurationRequest method
+func (m *MockVPCEndpointServiceConfigurationClient) ModifyVpcEndpointServiceConfigurationRequest(input *ec2.ModifyVpcEndpointServiceConfigurationInput) ec2.ModifyVpcEndpointServiceConfigurationRequest {
+	return m.MockModifyVpcEndpointServiceConfiguration(input)
+}
+
+// DeleteVpcEndpointServiceConfigurationsRequest mocks DeleteVpcEndpointServiceConfigurationsRequest method
+func (m *MockVPCEndpointServiceConfigurationClient) DeleteVpcEndpointServiceConfigurationsRequest(input *ec2.DeleteVpcEndpointServiceConfigurationsInput) ec2.DeleteVpcEndpointServiceConfigurationsRequest {
+	return m.MockDeleteVpcEndpointServiceConfigurations(input)
+}
+
+// DescribeVpcEndpointServicePermissionsRequest mocks DescribeVpcEndpointServicePermissionsRequest method
+func (m *MockVPCEndpointServiceConfigurationClient) DescribeVpcEndpointServicePermissionsRequest(input *ec2.DescribeVpcEndpointServicePermissionsInput) ec2.DescribeVpcEndpointServicePermissionsRequest {
+	return m.MockDescribeVpcEndpointServicePermissions(input)
+}
+
+// ModifyVpcEndpointServicePermissionsRequest mocks ModifyVpcEndpointServicePermissionsRequest method
+func (m *MockVPCEndpointServiceConfigurationClient) ModifyVpcEndpointServicePermissionsRequest(input *ec2.ModifyVpcEndpointServicePermissionsInput) ec2.ModifyVpcEndpointServicePermissionsRequest {
+	return m.MockModifyVpcEndpointServicePermissions(input)
+}
+
+// StartVpcEndpointServicePrivateDnsVerificationRequest mocks StartVpcEndpointServicePrivateDnsVerificationRequest method
+func (m *MockVPCEndpointServiceConfigurationClient) StartVpcEndpointServicePrivateDnsVerificationRequest(input *ec2.StartVpcEndpointServicePrivateDnsVerificationInput) ec2.StartVpcEndpointServicePrivateDnsVerificationRequest {
+	return m.MockStartVpcEndpointServicePrivateDnsVerification(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockVPCEndpointServiceConfigurationClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockVPCEndpointServiceConfigurationClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
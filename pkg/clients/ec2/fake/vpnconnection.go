@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.VPNConnectionClient = (*MockVPNConnectionClient)(nil)
+
+// MockVPNConnectionClient is a type that implements all the methods for VPNConnectionClient interface
+type MockVPNConnectionClient struct {
+	MockCreateVpnConnection      func(*ec2.CreateVpnConnectionInput) ec2.CreateVpnConnectionRequest
+	MockDescribeVpnConnections   func(*ec2.DescribeVpnConnectionsInput) ec2.DescribeVpnConnectionsRequest
+	MockDeleteVpnConnection      func(*ec2.DeleteVpnConnectionInput) ec2.DeleteVpnConnectionRequest
+	MockCreateVpnConnectionRoute func(*ec2.CreateVpnConnectionRouteInput) ec2.CreateVpnConnectionRouteRequest
+	MockDeleteVpnConnectionRoute func(*ec2.DeleteVpnConnectionRouteInput) ec2.DeleteVpnConnectionRouteRequest
+	MockCreateTags               func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags               func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateVpnConnectionRequest mocks CreateVpnConnectionRequest method
+func (m *MockVPNConnectionClient) CreateVpnConnectionRequest(input *ec2.CreateVpnConnectionInput) ec2.CreateVpnConnectionRequest {
+	return m.MockCreateVpnConnection(input)
+}
+
+// DescribeVpnConnectionsRequest mocks DescribeVpnConnectionsRequest method
+func (m *MockVPNConnectionClient) DescribeVpnConnectionsRequest(input *ec2.DescribeVpnConnectionsInput) ec2.DescribeVpnConnectionsRequest {
+	return m.MockDescribeVpnConnections(input)
+}
+
+// DeleteVpnConnectionRequest mocks DeleteVpnConnectionRequest method
+func (m *MockVPNConnectionClient) DeleteVpnConnectionRequest(input *ec2.DeleteVpnConnectionInput) ec2.DeleteVpnConnectionRequest {
+	return m.MockDeleteVpnConnection(input)
+}
+
+// CreateVpnConnectionRouteRequest mocks CreateVpnConnectionRouteRequest method
+func (m *MockVPNConnectionClient) CreateVpnConnectionRouteRequest(input *ec2.CreateVpnConnectionRouteInput) ec2.CreateVpnConnectionRouteRequest {
+	return m.MockCreateVpnConnectionRoute(input)
+}
+
+// DeleteVpnConnectionRouteRequest mocks DeleteVpnConnectionRouteRequest method
+func (m *MockVPNConnectionClient) DeleteVpnConnectionRouteRequest(input *ec2.DeleteVpnConnectionRouteInput) ec2.DeleteVpnConnectionRouteRequest {
+	return m.MockDeleteVpnConnectionRoute(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockVPNConnectionClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockVPNConnectionClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
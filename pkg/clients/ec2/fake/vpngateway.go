@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.VPNGatewayClient = (*MockVPNGatewayClient)(nil)
+
+// MockVPNGatewayClient is a type that implements all the methods for VPNGatewayClient interface
+type MockVPNGatewayClient struct {
+	MockCreateVpnGateway    func(*ec2.CreateVpnGatewayInput) ec2.CreateVpnGatewayRequest
+	MockDescribeVpnGateways func(*ec2.DescribeVpnGatewaysInput) ec2.DescribeVpnGatewaysRequest
+	MockDeleteVpnGateway    func(*ec2.DeleteVpnGatewayInput) ec2.DeleteVpnGatewayRequest
+	MockAttachVpnGateway    func(*ec2.AttachVpnGatewayInput) ec2.AttachVpnGatewayRequest
+	MockDetachVpnGateway    func(*ec2.DetachVpnGatewayInput) ec2.DetachVpnGatewayRequest
+	MockCreateTags          func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags          func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateVpnGatewayRequest mocks CreateVpnGatewayRequest method
+func (m *MockVPNGatewayClient) CreateVpnGatewayRequest(input *ec2.CreateVpnGatewayInput) ec2.CreateVpnGatewayRequest {
+	return m.MockCreateVpnGateway(input)
+}
+
+// DescribeVpnGatewaysRequest mocks DescribeVpnGatewaysRequest method
+func (m *MockVPNGatewayClient) DescribeVpnGatewaysRequest(input *ec2.DescribeVpnGatewaysInput) ec2.DescribeVpnGatewaysRequest {
+	return m.MockDescribeVpnGateways(input)
+}
+
+// DeleteVpnGatewayRequest mocks DeleteVpnGatewayRequest method
+func (m *MockVPNGatewayClient) DeleteVpnGatewayRequest(input *ec2.DeleteVpnGatewayInput) ec2.DeleteVpnGatewayRequest {
+	return m.MockDeleteVpnGateway(input)
+}
+
+// AttachVpnGatewayRequest mocks AttachVpnGatewayRequest method
+func (m *MockVPNGatewayClient) AttachVpnGatewayRequest(input *ec2.AttachVpnGatewayInput) ec2.AttachVpnGatewayRequest {
+	return m.MockAttachVpnGateway(input)
+}
+
+// DetachVpnGatewayRequest mocks DetachVpnGatewayRequest method
+func (m *MockVPNGatewayClient) DetachVpnGatewayRequest(input *ec2.DetachVpnGatewayInput) ec2.DetachVpnGatewayRequest {
+	return m.MockDetachVpnGateway(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockVPNGatewayClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockVPNGatewayClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
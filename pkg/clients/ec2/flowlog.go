@@ -0,0 +1,129 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// FlowLogIDNotFound is the code that is returned by ec2 when the given FlowLogID is not valid
+	FlowLogIDNotFound = "InvalidFlowLogId.NotFound"
+
+	errFlowLogResource = "exactly one of vpcId, subnetId and networkInterfaceId must be set"
+)
+
+// FlowLogClient is the external client used for FlowLog Custom Resource
+type FlowLogClient interface {
+	CreateFlowLogsRequest(input *ec2.CreateFlowLogsInput) ec2.CreateFlowLogsRequest
+	DescribeFlowLogsRequest(input *ec2.DescribeFlowLogsInput) ec2.DescribeFlowLogsRequest
+	DeleteFlowLogsRequest(input *ec2.DeleteFlowLogsInput) ec2.DeleteFlowLogsRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewFlowLogClient returns a new client using AWS credentials as JSON encoded data.
+func NewFlowLogClient(cfg aws.Config) FlowLogClient {
+	return ec2.New(cfg)
+}
+
+// IsFlowLogNotFoundErr returns true if the error is because the item doesn't exist
+func IsFlowLogNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == FlowLogIDNotFound
+	}
+	return false
+}
+
+// flowLogResource returns the type and ID of the resource whose traffic is
+// logged.
+func flowLogResource(p v1alpha1.FlowLogParameters) (ec2.FlowLogsResourceType, string, error) {
+	var t ec2.FlowLogsResourceType
+	var id string
+	n := 0
+	if p.VPCID != nil {
+		t, id = ec2.FlowLogsResourceTypeVpc, aws.StringValue(p.VPCID)
+		n++
+	}
+	if p.SubnetID != nil {
+		t, id = ec2.FlowLogsResourceTypeSubnet, aws.StringValue(p.SubnetID)
+		n++
+	}
+	if p.NetworkInterfaceID != nil {
+		t, id = ec2.FlowLogsResourceTypeNetworkInterface, aws.StringValue(p.NetworkInterfaceID)
+		n++
+	}
+	if n != 1 {
+		return "", "", errors.New(errFlowLogResource)
+	}
+	return t, id, nil
+}
+
+// GenerateCreateFlowLogsInput returns the input for a CreateFlowLogs request
+// built from the given parameters.
+func GenerateCreateFlowLogsInput(p v1alpha1.FlowLogParameters) (*ec2.CreateFlowLogsInput, error) {
+	t, id, err := flowLogResource(p)
+	if err != nil {
+		return nil, err
+	}
+	in := &ec2.CreateFlowLogsInput{
+		ResourceType:             t,
+		ResourceIds:              []string{id},
+		TrafficType:              ec2.TrafficType(p.TrafficType),
+		LogDestinationType:       ec2.LogDestinationType(aws.StringValue(p.LogDestinationType)),
+		LogDestination:           p.LogDestination,
+		LogGroupName:             p.LogGroupName,
+		DeliverLogsPermissionArn: p.DeliverLogsPermissionARN,
+		LogFormat:                p.LogFormat,
+		MaxAggregationInterval:   p.MaxAggregationInterval,
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeVpcFlowLog,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return in, nil
+}
+
+// GenerateFlowLogObservation is used to produce v1alpha1.FlowLogObservation
+// from ec2.FlowLog.
+func GenerateFlowLogObservation(f ec2.FlowLog) v1alpha1.FlowLogObservation {
+	return v1alpha1.FlowLogObservation{
+		FlowLogID:               aws.StringValue(f.FlowLogId),
+		FlowLogStatus:           aws.StringValue(f.FlowLogStatus),
+		DeliverLogsStatus:       aws.StringValue(f.DeliverLogsStatus),
+		DeliverLogsErrorMessage: aws.StringValue(f.DeliverLogsErrorMessage),
+	}
+}
+
+// LateInitializeFlowLog fills the empty fields in *v1alpha1.FlowLogParameters
+// with the values seen in ec2.FlowLog.
+func LateInitializeFlowLog(in *v1alpha1.FlowLogParameters, f *ec2.FlowLog) {
+	if f == nil {
+		return
+	}
+
+	in.LogDestinationType = awsclients.LateInitializeStringPtr(in.LogDestinationType, awsclients.String(string(f.LogDestinationType)))
+	in.LogDestination = awsclients.LateInitializeStringPtr(in.LogDestination, f.LogDestination)
+	in.LogGroupName = awsclients.LateInitializeStringPtr(in.LogGroupName, f.LogGroupName)
+	in.DeliverLogsPermissionARN = awsclients.LateInitializeStringPtr(in.DeliverLogsPermissionARN, f.DeliverLogsPermissionArn)
+	in.LogFormat = awsclients.LateInitializeStringPtr(in.LogFormat, f.LogFormat)
+	in.MaxAggregationInterval = awsclients.LateInitializeInt64Ptr(in.MaxAggregationInterval, f.MaxAggregationInterval)
+	if len(in.Tags) == 0 && len(f.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(f.Tags)
+	}
+}
+
+// IsFlowLogUpToDate checks whether the tags of the flow log, the only field
+// that can be modified, are up to date.
+func IsFlowLogUpToDate(p v1alpha1.FlowLogParameters, f ec2.FlowLog) bool {
+	return v1beta1.CompareTags(p.Tags, f.Tags)
+}
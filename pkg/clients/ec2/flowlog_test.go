@@ -0,0 +1,83 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestGenerateCreateFlowLogsInput(t *testing.T) {
+	type want struct {
+		in  *ec2.CreateFlowLogsInput
+		err error
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.FlowLogParameters
+		want want
+	}{
+		"Subnet": {
+			p: v1alpha1.FlowLogParameters{
+				SubnetID:               aws.String("subnet-1"),
+				TrafficType:            "REJECT",
+				LogDestinationType:     aws.String(v1alpha1.FlowLogDestinationTypeS3),
+				LogDestination:         aws.String("arn:aws:s3:::flow-logs"),
+				MaxAggregationInterval: aws.Int64(60),
+			},
+			want: want{
+				in: &ec2.CreateFlowLogsInput{
+					ResourceType:           ec2.FlowLogsResourceTypeSubnet,
+					ResourceIds:            []string{"subnet-1"},
+					TrafficType:            ec2.TrafficTypeReject,
+					LogDestinationType:     ec2.LogDestinationTypeS3,
+					LogDestination:         aws.String("arn:aws:s3:::flow-logs"),
+					MaxAggregationInterval: aws.Int64(60),
+				},
+			},
+		},
+		"NetworkInterface": {
+			p: v1alpha1.FlowLogParameters{
+				NetworkInterfaceID: aws.String("eni-1"),
+				TrafficType:        "ALL",
+				LogGroupName:       aws.String("flow-logs"),
+			},
+			want: want{
+				in: &ec2.CreateFlowLogsInput{
+					ResourceType: ec2.FlowLogsResourceTypeNetworkInterface,
+					ResourceIds:  []string{"eni-1"},
+					TrafficType:  ec2.TrafficTypeAll,
+					LogGroupName: aws.String("flow-logs"),
+				},
+			},
+		},
+		"MultipleResources": {
+			p: v1alpha1.FlowLogParameters{
+				VPCID:       aws.String("vpc-1"),
+				SubnetID:    aws.String("subnet-1"),
+				TrafficType: "ALL",
+			},
+			want: want{
+				err: errors.New(errFlowLogResource),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateCreateFlowLogsInput(tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.in, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// InstanceIDNotFound is the code that is returned by ec2 when the given InstanceID is not valid
+	InstanceIDNotFound = "InvalidInstanceID.NotFound"
+	// InstanceIDMalformed is the code that is returned by ec2 when the given InstanceID is not well formed
+	InstanceIDMalformed = "InvalidInstanceID.Malformed"
+)
+
+// InstanceClient is the external client used for Instance Custom Resource
+type InstanceClient interface {
+	RunInstancesRequest(input *ec2.RunInstancesInput) ec2.RunInstancesRequest
+	DescribeInstancesRequest(input *ec2.DescribeInstancesInput) ec2.DescribeInstancesRequest
+	StartInstancesRequest(input *ec2.StartInstancesInput) ec2.StartInstancesRequest
+	StopInstancesRequest(input *ec2.StopInstancesInput) ec2.StopInstancesRequest
+	ModifyInstanceAttributeRequest(input *ec2.ModifyInstanceAttributeInput) ec2.ModifyInstanceAttributeRequest
+	TerminateInstancesRequest(input *ec2.TerminateInstancesInput) ec2.TerminateInstancesRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewInstanceClient returns a new client using AWS credentials as JSON encoded data.
+func NewInstanceClient(cfg aws.Config) InstanceClient {
+	return ec2.New(cfg)
+}
+
+// IsInstanceNotFoundErr returns true if the error is because the item doesn't exist
+func IsInstanceNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == InstanceIDNotFound || awsErr.Code() == InstanceIDMalformed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DesiredInstanceState returns the desired state of the instance described
+// by the given parameters, i.e. running unless stopped is requested.
+func DesiredInstanceState(p v1alpha1.InstanceParameters) string {
+	if aws.StringValue(p.State) == v1alpha1.InstanceStateStopped {
+		return v1alpha1.InstanceStateStopped
+	}
+	return v1alpha1.InstanceStateRunning
+}
+
+// GenerateRunInstancesInput returns the input for a RunInstances request
+// that launches a single instance with the given parameters.
+func GenerateRunInstancesInput(p v1alpha1.InstanceParameters) *ec2.RunInstancesInput {
+	in := &ec2.RunInstancesInput{
+		ImageId:          aws.String(p.ImageID),
+		InstanceType:     ec2.InstanceType(p.InstanceType),
+		KeyName:          p.KeyName,
+		UserData:         p.UserData,
+		EbsOptimized:     p.EBSOptimized,
+		SubnetId:         p.SubnetID,
+		SecurityGroupIds: p.SecurityGroupIDs,
+		MinCount:         aws.Int64(1),
+		MaxCount:         aws.Int64(1),
+	}
+	for _, m := range p.BlockDeviceMappings {
+		bdm := ec2.BlockDeviceMapping{DeviceName: aws.String(m.DeviceName)}
+		if m.EBS != nil {
+			bdm.Ebs = &ec2.EbsBlockDevice{
+				DeleteOnTermination: m.EBS.DeleteOnTermination,
+				Encrypted:           m.EBS.Encrypted,
+				Iops:                m.EBS.IOPS,
+				KmsKeyId:            m.EBS.KMSKeyID,
+				SnapshotId:          m.EBS.SnapshotID,
+				VolumeSize:          m.EBS.VolumeSize,
+				VolumeType:          ec2.VolumeType(aws.StringValue(m.EBS.VolumeType)),
+			}
+		}
+		in.BlockDeviceMappings = append(in.BlockDeviceMappings, bdm)
+	}
+	if p.IAMInstanceProfile != nil {
+		in.IamInstanceProfile = &ec2.IamInstanceProfileSpecification{
+			Arn:  p.IAMInstanceProfile.ARN,
+			Name: p.IAMInstanceProfile.Name,
+		}
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeInstance,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return in
+}
+
+// GenerateInstanceObservation is used to produce v1alpha1.InstanceObservation
+// from ec2.Instance.
+func GenerateInstanceObservation(i ec2.Instance) v1alpha1.InstanceObservation {
+	o := v1alpha1.InstanceObservation{
+		InstanceID:       aws.StringValue(i.InstanceId),
+		VPCID:            aws.StringValue(i.VpcId),
+		PrivateDNSName:   aws.StringValue(i.PrivateDnsName),
+		PrivateIPAddress: aws.StringValue(i.PrivateIpAddress),
+		PublicDNSName:    aws.StringValue(i.PublicDnsName),
+		PublicIPAddress:  aws.StringValue(i.PublicIpAddress),
+	}
+	if i.State != nil {
+		o.State = string(i.State.Name)
+	}
+	if i.Placement != nil {
+		o.AvailabilityZone = aws.StringValue(i.Placement.AvailabilityZone)
+	}
+	return o
+}
+
+func instanceSecurityGroupIDs(i ec2.Instance) []string {
+	ids := make([]string, len(i.SecurityGroups))
+	for k, g := range i.SecurityGroups {
+		ids[k] = aws.StringValue(g.GroupId)
+	}
+	return ids
+}
+
+// LateInitializeInstance fills the empty fields in *v1alpha1.InstanceParameters
+// with the values seen in ec2.Instance.
+func LateInitializeInstance(in *v1alpha1.InstanceParameters, i *ec2.Instance) {
+	if i == nil {
+		return
+	}
+
+	in.KeyName = awsclients.LateInitializeStringPtr(in.KeyName, i.KeyName)
+	in.EBSOptimized = awsclients.LateInitializeBoolPtr(in.EBSOptimized, i.EbsOptimized)
+	in.SubnetID = awsclients.LateInitializeStringPtr(in.SubnetID, i.SubnetId)
+	if len(in.SecurityGroupIDs) == 0 && len(i.SecurityGroups) != 0 {
+		in.SecurityGroupIDs = instanceSecurityGroupIDs(*i)
+	}
+	if in.IAMInstanceProfile == nil && i.IamInstanceProfile != nil {
+		in.IAMInstanceProfile = &v1alpha1.InstanceIAMInstanceProfile{ARN: i.IamInstanceProfile.Arn}
+	}
+	if len(in.Tags) == 0 && len(i.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(i.Tags)
+	}
+}
+
+// IsInstanceStateUpToDate checks whether the instance is in, or on its way
+// to, the desired state.
+func IsInstanceStateUpToDate(p v1alpha1.InstanceParameters, i ec2.Instance) bool {
+	if i.State == nil {
+		return false
+	}
+	switch i.State.Name {
+	case ec2.InstanceStateNamePending, ec2.InstanceStateNameRunning:
+		return DesiredInstanceState(p) == v1alpha1.InstanceStateRunning
+	case ec2.InstanceStateNameStopping, ec2.InstanceStateNameStopped:
+		return DesiredInstanceState(p) == v1alpha1.InstanceStateStopped
+	}
+	return false
+}
+
+// AreInstanceSecurityGroupsUpToDate checks whether the instance has the
+// desired security groups.
+func AreInstanceSecurityGroupsUpToDate(p v1alpha1.InstanceParameters, i ec2.Instance) bool {
+	return cmp.Equal(p.SecurityGroupIDs, instanceSecurityGroupIDs(i),
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
+
+// IsInstanceUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsInstanceUpToDate(p v1alpha1.InstanceParameters, i ec2.Instance) bool {
+	return IsInstanceStateUpToDate(p, i) &&
+		AreInstanceSecurityGroupsUpToDate(p, i) &&
+		v1beta1.CompareTags(p.Tags, i.Tags)
+}
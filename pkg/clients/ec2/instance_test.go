@@ -0,0 +1,139 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	instanceSubnetID = "subnet-1"
+	instanceSGs      = []string{"sg-1", "sg-2"}
+	instanceProfile  = "arn:aws:iam::123456789012:instance-profile/sample"
+)
+
+func instanceWith(state ec2.InstanceStateName, sgs ...string) ec2.Instance {
+	i := ec2.Instance{
+		State: &ec2.InstanceState{Name: state},
+		Tags:  []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}
+	for _, id := range sgs {
+		i.SecurityGroups = append(i.SecurityGroups, ec2.GroupIdentifier{GroupId: aws.String(id)})
+	}
+	return i
+}
+
+func TestIsInstanceUpToDate(t *testing.T) {
+	tags := []v1beta1.Tag{{Key: "k", Value: "v"}}
+
+	cases := map[string]struct {
+		p    v1alpha1.InstanceParameters
+		i    ec2.Instance
+		want bool
+	}{
+		"Running": {
+			p:    v1alpha1.InstanceParameters{SecurityGroupIDs: instanceSGs, Tags: tags},
+			i:    instanceWith(ec2.InstanceStateNameRunning, instanceSGs[1], instanceSGs[0]),
+			want: true,
+		},
+		"Pending": {
+			p:    v1alpha1.InstanceParameters{SecurityGroupIDs: instanceSGs, Tags: tags},
+			i:    instanceWith(ec2.InstanceStateNamePending, instanceSGs...),
+			want: true,
+		},
+		"Stopping": {
+			p:    v1alpha1.InstanceParameters{State: aws.String(v1alpha1.InstanceStateStopped), SecurityGroupIDs: instanceSGs, Tags: tags},
+			i:    instanceWith(ec2.InstanceStateNameStopping, instanceSGs...),
+			want: true,
+		},
+		"StopRequested": {
+			p:    v1alpha1.InstanceParameters{State: aws.String(v1alpha1.InstanceStateStopped), SecurityGroupIDs: instanceSGs, Tags: tags},
+			i:    instanceWith(ec2.InstanceStateNameRunning, instanceSGs...),
+			want: false,
+		},
+		"StartRequested": {
+			p:    v1alpha1.InstanceParameters{SecurityGroupIDs: instanceSGs, Tags: tags},
+			i:    instanceWith(ec2.InstanceStateNameStopped, instanceSGs...),
+			want: false,
+		},
+		"DifferentSecurityGroups": {
+			p:    v1alpha1.InstanceParameters{SecurityGroupIDs: instanceSGs[:1], Tags: tags},
+			i:    instanceWith(ec2.InstanceStateNameRunning, instanceSGs...),
+			want: false,
+		},
+		"DifferentTags": {
+			p:    v1alpha1.InstanceParameters{SecurityGroupIDs: instanceSGs},
+			i:    instanceWith(ec2.InstanceStateNameRunning, instanceSGs...),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstanceUpToDate(tc.p, tc.i)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeInstance(t *testing.T) {
+	type args struct {
+		p *v1alpha1.InstanceParameters
+		i *ec2.Instance
+	}
+
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.InstanceParameters
+	}{
+		"AllFilled": {
+			args: args{
+				p: &v1alpha1.InstanceParameters{
+					SubnetID:         aws.String(instanceSubnetID),
+					SecurityGroupIDs: instanceSGs[:1],
+				},
+				i: &ec2.Instance{
+					SubnetId:           aws.String("subnet-2"),
+					SecurityGroups:     []ec2.GroupIdentifier{{GroupId: aws.String(instanceSGs[1])}},
+					IamInstanceProfile: &ec2.IamInstanceProfile{Arn: aws.String(instanceProfile)},
+				},
+			},
+			want: &v1alpha1.InstanceParameters{
+				SubnetID:           aws.String(instanceSubnetID),
+				SecurityGroupIDs:   instanceSGs[:1],
+				IAMInstanceProfile: &v1alpha1.InstanceIAMInstanceProfile{ARN: aws.String(instanceProfile)},
+			},
+		},
+		"PartialFilled": {
+			args: args{
+				p: &v1alpha1.InstanceParameters{},
+				i: &ec2.Instance{
+					SubnetId:       aws.String(instanceSubnetID),
+					SecurityGroups: []ec2.GroupIdentifier{{GroupId: aws.String(instanceSGs[0])}, {GroupId: aws.String(instanceSGs[1])}},
+					Tags:           []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				},
+			},
+			want: &v1alpha1.InstanceParameters{
+				SubnetID:         aws.String(instanceSubnetID),
+				SecurityGroupIDs: instanceSGs,
+				Tags:             []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeInstance(tc.args.p, tc.args.i)
+			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
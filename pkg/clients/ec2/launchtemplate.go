@@ -0,0 +1,180 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// LaunchTemplateIDNotFound is the code that is returned by ec2 when the given LaunchTemplateID is not valid
+	LaunchTemplateIDNotFound = "InvalidLaunchTemplateId.NotFound"
+	// LaunchTemplateIDMalformed is the code that is returned by ec2 when the given LaunchTemplateID is not well formed
+	LaunchTemplateIDMalformed = "InvalidLaunchTemplateId.Malformed"
+)
+
+// LaunchTemplateClient is the external client used for LaunchTemplate Custom Resource
+type LaunchTemplateClient interface {
+	CreateLaunchTemplateRequest(input *ec2.CreateLaunchTemplateInput) ec2.CreateLaunchTemplateRequest
+	DescribeLaunchTemplatesRequest(input *ec2.DescribeLaunchTemplatesInput) ec2.DescribeLaunchTemplatesRequest
+	DescribeLaunchTemplateVersionsRequest(input *ec2.DescribeLaunchTemplateVersionsInput) ec2.DescribeLaunchTemplateVersionsRequest
+	CreateLaunchTemplateVersionRequest(input *ec2.CreateLaunchTemplateVersionInput) ec2.CreateLaunchTemplateVersionRequest
+	ModifyLaunchTemplateRequest(input *ec2.ModifyLaunchTemplateInput) ec2.ModifyLaunchTemplateRequest
+	DeleteLaunchTemplateRequest(input *ec2.DeleteLaunchTemplateInput) ec2.DeleteLaunchTemplateRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewLaunchTemplateClient returns a new client using AWS credentials as JSON encoded data.
+func NewLaunchTemplateClient(cfg aws.Config) LaunchTemplateClient {
+	return ec2.New(cfg)
+}
+
+// IsLaunchTemplateNotFoundErr returns true if the error is because the item doesn't exist
+func IsLaunchTemplateNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == LaunchTemplateIDNotFound || awsErr.Code() == LaunchTemplateIDMalformed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateRequestLaunchTemplateData returns the launch template data of a
+// CreateLaunchTemplate or CreateLaunchTemplateVersion request built from the
+// given parameters.
+func GenerateRequestLaunchTemplateData(d v1alpha1.LaunchTemplateData) *ec2.RequestLaunchTemplateData {
+	data := &ec2.RequestLaunchTemplateData{
+		ImageId:          d.ImageID,
+		InstanceType:     ec2.InstanceType(aws.StringValue(d.InstanceType)),
+		KeyName:          d.KeyName,
+		UserData:         d.UserData,
+		EbsOptimized:     d.EBSOptimized,
+		SecurityGroupIds: d.SecurityGroupIDs,
+	}
+	if d.IAMInstanceProfile != nil {
+		data.IamInstanceProfile = &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{
+			Arn:  d.IAMInstanceProfile.ARN,
+			Name: d.IAMInstanceProfile.Name,
+		}
+	}
+	if d.MetadataOptions != nil {
+		data.MetadataOptions = &ec2.LaunchTemplateInstanceMetadataOptionsRequest{
+			HttpEndpoint:            ec2.LaunchTemplateInstanceMetadataEndpointState(aws.StringValue(d.MetadataOptions.HTTPEndpoint)),
+			HttpTokens:              ec2.LaunchTemplateHttpTokensState(aws.StringValue(d.MetadataOptions.HTTPTokens)),
+			HttpPutResponseHopLimit: d.MetadataOptions.HTTPPutResponseHopLimit,
+		}
+	}
+	return data
+}
+
+// GenerateCreateLaunchTemplateInput returns the input for a
+// CreateLaunchTemplate request built from the given parameters.
+func GenerateCreateLaunchTemplateInput(p v1alpha1.LaunchTemplateParameters) *ec2.CreateLaunchTemplateInput {
+	in := &ec2.CreateLaunchTemplateInput{
+		LaunchTemplateName: aws.String(p.LaunchTemplateName),
+		VersionDescription: p.VersionDescription,
+		LaunchTemplateData: GenerateRequestLaunchTemplateData(p.LaunchTemplateData),
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeLaunchTemplate,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return in
+}
+
+// GenerateLaunchTemplateData returns the v1alpha1.LaunchTemplateData of the
+// given ec2.ResponseLaunchTemplateData.
+func GenerateLaunchTemplateData(data ec2.ResponseLaunchTemplateData) v1alpha1.LaunchTemplateData {
+	d := v1alpha1.LaunchTemplateData{
+		ImageID:          data.ImageId,
+		InstanceType:     awsclients.String(string(data.InstanceType)),
+		KeyName:          data.KeyName,
+		UserData:         data.UserData,
+		EBSOptimized:     data.EbsOptimized,
+		SecurityGroupIDs: data.SecurityGroupIds,
+	}
+	if data.IamInstanceProfile != nil {
+		d.IAMInstanceProfile = &v1alpha1.LaunchTemplateIAMInstanceProfile{
+			ARN:  data.IamInstanceProfile.Arn,
+			Name: data.IamInstanceProfile.Name,
+		}
+	}
+	if data.MetadataOptions != nil {
+		d.MetadataOptions = &v1alpha1.LaunchTemplateMetadataOptions{
+			HTTPEndpoint:            awsclients.String(string(data.MetadataOptions.HttpEndpoint)),
+			HTTPTokens:              awsclients.String(string(data.MetadataOptions.HttpTokens)),
+			HTTPPutResponseHopLimit: data.MetadataOptions.HttpPutResponseHopLimit,
+		}
+	}
+	return d
+}
+
+// GenerateLaunchTemplateObservation is used to produce
+// v1alpha1.LaunchTemplateObservation from ec2.LaunchTemplate.
+func GenerateLaunchTemplateObservation(lt ec2.LaunchTemplate) v1alpha1.LaunchTemplateObservation {
+	return v1alpha1.LaunchTemplateObservation{
+		LaunchTemplateID:     aws.StringValue(lt.LaunchTemplateId),
+		DefaultVersionNumber: aws.Int64Value(lt.DefaultVersionNumber),
+		LatestVersionNumber:  aws.Int64Value(lt.LatestVersionNumber),
+	}
+}
+
+// LateInitializeLaunchTemplate fills the empty fields in
+// *v1alpha1.LaunchTemplateParameters with the values seen in
+// ec2.LaunchTemplate and the data of its default version.
+func LateInitializeLaunchTemplate(in *v1alpha1.LaunchTemplateParameters, lt *ec2.LaunchTemplate, version *ec2.LaunchTemplateVersion) {
+	if lt == nil {
+		return
+	}
+
+	if len(in.Tags) == 0 && len(lt.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(lt.Tags)
+	}
+
+	if version == nil || version.LaunchTemplateData == nil {
+		return
+	}
+	in.VersionDescription = awsclients.LateInitializeStringPtr(in.VersionDescription, version.VersionDescription)
+
+	o := GenerateLaunchTemplateData(*version.LaunchTemplateData)
+	d := &in.LaunchTemplateData
+	d.ImageID = awsclients.LateInitializeStringPtr(d.ImageID, o.ImageID)
+	d.InstanceType = awsclients.LateInitializeStringPtr(d.InstanceType, o.InstanceType)
+	d.KeyName = awsclients.LateInitializeStringPtr(d.KeyName, o.KeyName)
+	d.UserData = awsclients.LateInitializeStringPtr(d.UserData, o.UserData)
+	d.EBSOptimized = awsclients.LateInitializeBoolPtr(d.EBSOptimized, o.EBSOptimized)
+	if len(d.SecurityGroupIDs) == 0 {
+		d.SecurityGroupIDs = o.SecurityGroupIDs
+	}
+	if d.IAMInstanceProfile == nil {
+		d.IAMInstanceProfile = o.IAMInstanceProfile
+	}
+	if d.MetadataOptions == nil {
+		d.MetadataOptions = o.MetadataOptions
+	}
+}
+
+// IsLaunchTemplateDataUpToDate checks whether the data of the given
+// ec2.LaunchTemplateVersion matches the desired parameters.
+func IsLaunchTemplateDataUpToDate(p v1alpha1.LaunchTemplateParameters, version ec2.LaunchTemplateVersion) bool {
+	if version.LaunchTemplateData == nil {
+		return false
+	}
+	return cmp.Equal(p.LaunchTemplateData, GenerateLaunchTemplateData(*version.LaunchTemplateData),
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.IgnoreFields(v1alpha1.LaunchTemplateData{}, "SecurityGroupIDRefs", "SecurityGroupIDSelector"),
+		cmpopts.IgnoreFields(v1alpha1.LaunchTemplateIAMInstanceProfile{}, "NameRef", "NameSelector"))
+}
@@ -0,0 +1,82 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	ltImageID      = "ami-0123456789"
+	ltInstanceType = "t3.micro"
+	ltSGs          = []string{"sg-1", "sg-2"}
+)
+
+func TestIsLaunchTemplateDataUpToDate(t *testing.T) {
+	version := ec2.LaunchTemplateVersion{
+		LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+			ImageId:          aws.String(ltImageID),
+			InstanceType:     ec2.InstanceType(ltInstanceType),
+			SecurityGroupIds: []string{ltSGs[1], ltSGs[0]},
+			MetadataOptions: &ec2.LaunchTemplateInstanceMetadataOptions{
+				HttpTokens: ec2.LaunchTemplateHttpTokensStateRequired,
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		p       v1alpha1.LaunchTemplateParameters
+		version ec2.LaunchTemplateVersion
+		want    bool
+	}{
+		"SameData": {
+			p: v1alpha1.LaunchTemplateParameters{
+				LaunchTemplateData: v1alpha1.LaunchTemplateData{
+					ImageID:             aws.String(ltImageID),
+					InstanceType:        aws.String(ltInstanceType),
+					SecurityGroupIDs:    ltSGs,
+					SecurityGroupIDRefs: []runtimev1alpha1.Reference{{Name: "sg"}},
+					MetadataOptions: &v1alpha1.LaunchTemplateMetadataOptions{
+						HTTPTokens: aws.String("required"),
+					},
+				},
+			},
+			version: version,
+			want:    true,
+		},
+		"DifferentMetadataOptions": {
+			p: v1alpha1.LaunchTemplateParameters{
+				LaunchTemplateData: v1alpha1.LaunchTemplateData{
+					ImageID:          aws.String(ltImageID),
+					InstanceType:     aws.String(ltInstanceType),
+					SecurityGroupIDs: ltSGs,
+					MetadataOptions: &v1alpha1.LaunchTemplateMetadataOptions{
+						HTTPTokens: aws.String("optional"),
+					},
+				},
+			},
+			version: version,
+			want:    false,
+		},
+		"NoData": {
+			p:       v1alpha1.LaunchTemplateParameters{},
+			version: ec2.LaunchTemplateVersion{},
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLaunchTemplateDataUpToDate(tc.p, tc.version)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package ec2
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// NetworkACLIDNotFound is the code that is returned by ec2 when the given NetworkACLID is not valid
+	NetworkACLIDNotFound = "InvalidNetworkAclID.NotFound"
+
+	// NetworkACLEntryNotFound is the code that is returned by ec2 when the given entry does not exist
+	NetworkACLEntryNotFound = "InvalidNetworkAclEntry.NotFound"
+
+	// maxNetworkACLRuleNumber is the highest rule number that can be used.
+	// The default rules that deny all remaining traffic have higher numbers.
+	maxNetworkACLRuleNumber = 32766
+)
+
+// NetworkACLClient is the external client used for NetworkACL Custom Resource
+type NetworkACLClient interface {
+	CreateNetworkAclRequest(input *ec2.CreateNetworkAclInput) ec2.CreateNetworkAclRequest
+	DescribeNetworkAclsRequest(input *ec2.DescribeNetworkAclsInput) ec2.DescribeNetworkAclsRequest
+	DeleteNetworkAclRequest(input *ec2.DeleteNetworkAclInput) ec2.DeleteNetworkAclRequest
+	CreateNetworkAclEntryRequest(input *ec2.CreateNetworkAclEntryInput) ec2.CreateNetworkAclEntryRequest
+	ReplaceNetworkAclEntryRequest(input *ec2.ReplaceNetworkAclEntryInput) ec2.ReplaceNetworkAclEntryRequest
+	DeleteNetworkAclEntryRequest(input *ec2.DeleteNetworkAclEntryInput) ec2.DeleteNetworkAclEntryRequest
+	ReplaceNetworkAclAssociationRequest(input *ec2.ReplaceNetworkAclAssociationInput) ec2.ReplaceNetworkAclAssociationRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewNetworkACLClient returns a new client using AWS credentials as JSON encoded data.
+func NewNetworkACLClient(cfg aws.Config) NetworkACLClient {
+	return ec2.New(cfg)
+}
+
+// IsNetworkACLNotFoundErr returns true if the error is because the item doesn't exist
+func IsNetworkACLNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == NetworkACLIDNotFound
+	}
+	return false
+}
+
+// IsNetworkACLEntryNotFoundErr returns true if the error is because the entry
+// doesn't exist
+func IsNetworkACLEntryNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == NetworkACLEntryNotFound
+	}
+	return false
+}
+
+// GenerateNetworkACLEntry returns the ec2.NetworkAclEntry of the given
+// v1alpha1.NetworkACLEntry.
+func GenerateNetworkACLEntry(e v1alpha1.NetworkACLEntry) ec2.NetworkAclEntry {
+	o := ec2.NetworkAclEntry{
+		RuleNumber:    aws.Int64(e.RuleNumber),
+		Egress:        aws.Bool(e.Egress),
+		Protocol:      aws.String(e.Protocol),
+		RuleAction:    ec2.RuleAction(e.RuleAction),
+		CidrBlock:     e.CIDRBlock,
+		Ipv6CidrBlock: e.IPv6CIDRBlock,
+	}
+	if e.PortRange != nil {
+		o.PortRange = &ec2.PortRange{
+			From: aws.Int64(e.PortRange.From),
+			To:   aws.Int64(e.PortRange.To),
+		}
+	}
+	if e.ICMPTypeCode != nil {
+		o.IcmpTypeCode = &ec2.IcmpTypeCode{
+			Type: aws.Int64(e.ICMPTypeCode.Type),
+			Code: aws.Int64(e.ICMPTypeCode.Code),
+		}
+	}
+	return o
+}
+
+// GenerateCreateNetworkACLEntryInput returns the input for a
+// CreateNetworkAclEntry request that adds the given entry to the network ACL.
+func GenerateCreateNetworkACLEntryInput(id string, e ec2.NetworkAclEntry) *ec2.CreateNetworkAclEntryInput {
+	return &ec2.CreateNetworkAclEntryInput{
+		NetworkAclId:  aws.String(id),
+		RuleNumber:    e.RuleNumber,
+		Egress:        e.Egress,
+		Protocol:      e.Protocol,
+		RuleAction:    e.RuleAction,
+		CidrBlock:     e.CidrBlock,
+		Ipv6CidrBlock: e.Ipv6CidrBlock,
+		PortRange:     e.PortRange,
+		IcmpTypeCode:  e.IcmpTypeCode,
+	}
+}
+
+// GenerateReplaceNetworkACLEntryInput returns the input for a
+// ReplaceNetworkAclEntry request that replaces the entry of the network ACL
+// with the same rule number with the given one.
+func GenerateReplaceNetworkACLEntryInput(id string, e ec2.NetworkAclEntry) *ec2.ReplaceNetworkAclEntryInput {
+	return &ec2.ReplaceNetworkAclEntryInput{
+		NetworkAclId:  aws.String(id),
+		RuleNumber:    e.RuleNumber,
+		Egress:        e.Egress,
+		Protocol:      e.Protocol,
+		RuleAction:    e.RuleAction,
+		CidrBlock:     e.CidrBlock,
+		Ipv6CidrBlock: e.Ipv6CidrBlock,
+		PortRange:     e.PortRange,
+		IcmpTypeCode:  e.IcmpTypeCode,
+	}
+}
+
+type networkACLEntryKey struct {
+	egress     bool
+	ruleNumber int64
+}
+
+func isPortRangeEqual(a, b *ec2.PortRange) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return aws.Int64Value(a.From) == aws.Int64Value(b.From) && aws.Int64Value(a.To) == aws.Int64Value(b.To)
+}
+
+func isICMPTypeCodeEqual(a, b *ec2.IcmpTypeCode) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return aws.Int64Value(a.Type) == aws.Int64Value(b.Type) && aws.Int64Value(a.Code) == aws.Int64Value(b.Code)
+}
+
+func isNetworkACLEntryEqual(a, b ec2.NetworkAclEntry) bool {
+	return aws.StringValue(a.Protocol) == aws.StringValue(b.Protocol) &&
+		a.RuleAction == b.RuleAction &&
+		aws.StringValue(a.CidrBlock) == aws.StringValue(b.CidrBlock) &&
+		aws.StringValue(a.Ipv6CidrBlock) == aws.StringValue(b.Ipv6CidrBlock) &&
+		isPortRangeEqual(a.PortRange, b.PortRange) &&
+		isICMPTypeCodeEqual(a.IcmpTypeCode, b.IcmpTypeCode)
+}
+
+// DiffNetworkACLEntries returns the entries that have to be created, replaced
+// and deleted so that the observed entries of a network ACL match the
+// desired ones. Entries are identified by their direction and rule number,
+// and the default rules are ignored.
+func DiffNetworkACLEntries(desired []v1alpha1.NetworkACLEntry, observed []ec2.NetworkAclEntry) (create, replace, remove []ec2.NetworkAclEntry) {
+	o := make(map[networkACLEntryKey]ec2.NetworkAclEntry, len(observed))
+	for _, e := range observed {
+		if aws.Int64Value(e.RuleNumber) > maxNetworkACLRuleNumber {
+			continue
+		}
+		o[networkACLEntryKey{egress: aws.BoolValue(e.Egress), ruleNumber: aws.Int64Value(e.RuleNumber)}] = e
+	}
+	d := make(map[networkACLEntryKey]struct{}, len(desired))
+	for _, de := range desired {
+		k := networkACLEntryKey{egress: de.Egress, ruleNumber: de.RuleNumber}
+		d[k] = struct{}{}
+		e := GenerateNetworkACLEntry(de)
+		oe, ok := o[k]
+		switch {
+		case !ok:
+			create = append(create, e)
+		case !isNetworkACLEntryEqual(e, oe):
+			replace = append(replace, e)
+		}
+	}
+	for _, e := range observed {
+		k := networkACLEntryKey{egress: aws.BoolValue(e.Egress), ruleNumber: aws.Int64Value(e.RuleNumber)}
+		if _, ok := o[k]; !ok {
+			continue
+		}
+		if _, ok := d[k]; !ok {
+			remove = append(remove, e)
+		}
+	}
+	return create, replace, remove
+}
+
+// DiffNetworkACLAssociations returns the IDs of the subnets that have to be
+// associated with and disassociated from the network ACL so that its
+// observed associations match the desired ones.
+func DiffNetworkACLAssociations(p v1alpha1.NetworkACLParameters, acl ec2.NetworkAcl) (add, remove []string) {
+	desired := make([]string, 0, len(p.Associations))
+	for _, a := range p.Associations {
+		if a.SubnetID != nil {
+			desired = append(desired, aws.StringValue(a.SubnetID))
+		}
+	}
+	observed := make([]string, len(acl.Associations))
+	for i, a := range acl.Associations {
+		observed[i] = aws.StringValue(a.SubnetId)
+	}
+	return diffIDs(desired, observed)
+}
+
+// FindNetworkACLAssociationID returns the ID of the association of the given
+// subnet among the associations of the given network ACLs, or an empty string
+// if there is none.
+func FindNetworkACLAssociationID(acls []ec2.NetworkAcl, subnetID string) string {
+	for _, acl := range acls {
+		for _, a := range acl.Associations {
+			if aws.StringValue(a.SubnetId) == subnetID {
+				return aws.StringValue(a.NetworkAclAssociationId)
+			}
+		}
+	}
+	return ""
+}
+
+// GenerateNetworkACLObservation is used to produce
+// v1alpha1.NetworkACLObservation from ec2.NetworkAcl.
+func GenerateNetworkACLObservation(acl ec2.NetworkAcl) v1alpha1.NetworkACLObservation {
+	o := v1alpha1.NetworkACLObservation{
+		NetworkACLID: aws.StringValue(acl.NetworkAclId),
+		OwnerID:      aws.StringValue(acl.OwnerId),
+		IsDefault:    aws.BoolValue(acl.IsDefault),
+	}
+	if len(acl.Associations) > 0 {
+		o.Associations = make([]v1alpha1.NetworkACLAssociationState, len(acl.Associations))
+		for i, a := range acl.Associations {
+			o.Associations[i] = v1alpha1.NetworkACLAssociationState{
+				AssociationID: aws.StringValue(a.NetworkAclAssociationId),
+				SubnetID:      aws.StringValue(a.SubnetId),
+			}
+		}
+		sort.Slice(o.Associations, func(i, j int) bool {
+			return o.Associations[i].SubnetID < o.Associations[j].SubnetID
+		})
+	}
+	return o
+}
+
+// LateInitializeNetworkACL fills the empty fields in
+// *v1alpha1.NetworkACLParameters with the values seen in ec2.NetworkAcl.
+func LateInitializeNetworkACL(in *v1alpha1.NetworkACLParameters, acl *ec2.NetworkAcl) {
+	if acl == nil {
+		return
+	}
+
+	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, acl.VpcId)
+	if len(in.Tags) == 0 && len(acl.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(acl.Tags)
+	}
+}
+
+// IsNetworkACLUpToDate checks whether the entries, associations and tags of
+// the network ACL are up to date.
+func IsNetworkACLUpToDate(p v1alpha1.NetworkACLParameters, acl ec2.NetworkAcl) bool {
+	create, replace, remove := DiffNetworkACLEntries(p.Entries, acl.Entries)
+	if len(create) != 0 || len(replace) != 0 || len(remove) != 0 {
+		return false
+	}
+	add, disassociate := DiffNetworkACLAssociations(p, acl)
+	if len(add) != 0 || len(disassociate) != 0 {
+		return false
+	}
+	return v1beta1.CompareTags(p.Tags, acl.Tags)
+}
@@ -0,0 +1,168 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	networkACLHTTPS = v1alpha1.NetworkACLEntry{
+		RuleNumber: 100,
+		Protocol:   "6",
+		RuleAction: "allow",
+		CIDRBlock:  aws.String("0.0.0.0/0"),
+		PortRange:  &v1alpha1.PortRange{From: 443, To: 443},
+	}
+	networkACLEgress = v1alpha1.NetworkACLEntry{
+		RuleNumber: 100,
+		Egress:     true,
+		Protocol:   "-1",
+		RuleAction: "allow",
+		CIDRBlock:  aws.String("0.0.0.0/0"),
+	}
+)
+
+func networkACLEntry(ruleNumber int, egress bool, protocol, action, cidr string, pr *ec2.PortRange) ec2.NetworkAclEntry {
+	return ec2.NetworkAclEntry{
+		RuleNumber: aws.Int64(ruleNumber, aws.FieldRequired),
+		Egress:     aws.Bool(egress, aws.FieldRequired),
+		Protocol:   aws.String(protocol),
+		RuleAction: ec2.RuleAction(action),
+		CidrBlock:  aws.String(cidr),
+		PortRange:  pr,
+	}
+}
+
+func TestDiffNetworkACLEntries(t *testing.T) {
+	https := &ec2.PortRange{From: aws.Int64(443), To: aws.Int64(443)}
+	type want struct {
+		create  []ec2.NetworkAclEntry
+		replace []ec2.NetworkAclEntry
+		remove  []ec2.NetworkAclEntry
+	}
+
+	cases := map[string]struct {
+		desired  []v1alpha1.NetworkACLEntry
+		observed []ec2.NetworkAclEntry
+		want     want
+	}{
+		"UpToDate": {
+			desired: []v1alpha1.NetworkACLEntry{networkACLHTTPS, networkACLEgress},
+			observed: []ec2.NetworkAclEntry{
+				networkACLEntry(100, false, "6", "allow", "0.0.0.0/0", https),
+				networkACLEntry(100, true, "-1", "allow", "0.0.0.0/0", nil),
+				networkACLEntry(32767, false, "-1", "deny", "0.0.0.0/0", nil),
+				networkACLEntry(32767, true, "-1", "deny", "0.0.0.0/0", nil),
+			},
+		},
+		"Create": {
+			desired: []v1alpha1.NetworkACLEntry{networkACLHTTPS, networkACLEgress},
+			observed: []ec2.NetworkAclEntry{
+				networkACLEntry(100, false, "6", "allow", "0.0.0.0/0", https),
+				networkACLEntry(32767, true, "-1", "deny", "0.0.0.0/0", nil),
+			},
+			want: want{
+				create: []ec2.NetworkAclEntry{networkACLEntry(100, true, "-1", "allow", "0.0.0.0/0", nil)},
+			},
+		},
+		"Replace": {
+			desired: []v1alpha1.NetworkACLEntry{networkACLHTTPS},
+			observed: []ec2.NetworkAclEntry{
+				networkACLEntry(100, false, "6", "deny", "0.0.0.0/0", https),
+			},
+			want: want{
+				replace: []ec2.NetworkAclEntry{networkACLEntry(100, false, "6", "allow", "0.0.0.0/0", https)},
+			},
+		},
+		"Remove": {
+			observed: []ec2.NetworkAclEntry{
+				networkACLEntry(100, false, "6", "allow", "0.0.0.0/0", https),
+				networkACLEntry(32767, false, "-1", "deny", "0.0.0.0/0", nil),
+			},
+			want: want{
+				remove: []ec2.NetworkAclEntry{networkACLEntry(100, false, "6", "allow", "0.0.0.0/0", https)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, replace, remove := DiffNetworkACLEntries(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.replace, replace); diff != "" {
+				t.Errorf("replace: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsNetworkACLUpToDate(t *testing.T) {
+	acl := ec2.NetworkAcl{
+		Associations: []ec2.NetworkAclAssociation{{
+			NetworkAclAssociationId: aws.String("aclassoc-1"),
+			SubnetId:                aws.String("subnet-1"),
+		}},
+		Entries: []ec2.NetworkAclEntry{
+			networkACLEntry(100, true, "-1", "allow", "0.0.0.0/0", nil),
+			networkACLEntry(32767, true, "-1", "deny", "0.0.0.0/0", nil),
+		},
+		Tags: []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.NetworkACLParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.NetworkACLParameters{
+				Entries:      []v1alpha1.NetworkACLEntry{networkACLEgress},
+				Associations: []v1alpha1.NetworkACLAssociation{{SubnetID: aws.String("subnet-1")}},
+				Tags:         []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: true,
+		},
+		"EntriesChanged": {
+			p: v1alpha1.NetworkACLParameters{
+				Entries:      []v1alpha1.NetworkACLEntry{networkACLEgress, networkACLHTTPS},
+				Associations: []v1alpha1.NetworkACLAssociation{{SubnetID: aws.String("subnet-1")}},
+				Tags:         []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: false,
+		},
+		"AssociationsChanged": {
+			p: v1alpha1.NetworkACLParameters{
+				Entries:      []v1alpha1.NetworkACLEntry{networkACLEgress},
+				Associations: []v1alpha1.NetworkACLAssociation{{SubnetID: aws.String("subnet-2")}},
+				Tags:         []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			p: v1alpha1.NetworkACLParameters{
+				Entries:      []v1alpha1.NetworkACLEntry{networkACLEgress},
+				Associations: []v1alpha1.NetworkACLAssociation{{SubnetID: aws.String("subnet-1")}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsNetworkACLUpToDate(tc.p, acl)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -91,6 +91,7 @@ func GenerateRTObservation(rt ec2.RouteTable) v1alpha4.RouteTableObservation {
 				State:                string(rt.State),
 				DestinationCIDRBlock: aws.StringValue(rt.DestinationCidrBlock),
 				GatewayID:            aws.StringValue(rt.GatewayId),
+				NatGatewayID:         aws.StringValue(rt.NatGatewayId),
 			}
 		}
 	}
@@ -124,6 +125,7 @@ func LateInitializeRT(in *v1alpha4.RouteTableParameters, rt *ec2.RouteTable) { /
 			in.Routes[i] = v1alpha4.Route{
 				DestinationCIDRBlock: val.DestinationCidrBlock,
 				GatewayID:            val.GatewayId,
+				NatGatewayID:         val.NatGatewayId,
 			}
 		}
 	}
@@ -152,7 +154,7 @@ func CreateRTPatch(in ec2.RouteTable, target v1alpha4.RouteTableParameters) (*v1
 
 	// Add the default route for fair comparison.
 	for _, val := range in.Routes {
-		if *val.GatewayId == LocalGatewayID {
+		if aws.StringValue(val.GatewayId) == LocalGatewayID {
 			target.Routes = append([]v1alpha4.Route{{
 				GatewayID:            val.GatewayId,
 				DestinationCIDRBlock: val.DestinationCidrBlock,
@@ -16,6 +16,9 @@ var (
 	rtID       = "some RT Id"
 	rtSubnetID = "some subnet"
 	rtOwner    = "some owner"
+
+	rtDestination  = "0.0.0.0/0"
+	rtNatGatewayID = "some nat gateway"
 )
 
 func specAssociations() []v1alpha4.Association {
@@ -101,6 +104,25 @@ func TestGenerateRTObservation(t *testing.T) {
 				RouteTableID: rtID,
 			},
 		},
+		"NATGatewayRoute": {
+			in: ec2.RouteTable{
+				RouteTableId: aws.String(rtID),
+				Routes: []ec2.Route{{
+					DestinationCidrBlock: aws.String(rtDestination),
+					NatGatewayId:         aws.String(rtNatGatewayID),
+					State:                ec2.RouteStateActive,
+				}},
+			},
+			out: v1alpha4.RouteTableObservation{
+				RouteTableID: rtID,
+				Routes: []v1alpha4.RouteState{{
+					State:                string(ec2.RouteStateActive),
+					DestinationCIDRBlock: rtDestination,
+					NatGatewayID:         rtNatGatewayID,
+				}},
+				Associations: []v1alpha4.AssociationState{},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -159,6 +181,29 @@ func TestCreateRTPatch(t *testing.T) {
 				},
 			},
 		},
+		"SameNATGatewayRoute": {
+			args: args{
+				rt: ec2.RouteTable{
+					Associations: rtAssociations(),
+					VpcId:        aws.String(rtVPC),
+					Routes: []ec2.Route{{
+						DestinationCidrBlock: aws.String(rtDestination),
+						NatGatewayId:         aws.String(rtNatGatewayID),
+					}},
+				},
+				p: &v1alpha4.RouteTableParameters{
+					Associations: specAssociations(),
+					VPCID:        aws.String(rtVPC),
+					Routes: []v1alpha4.Route{{
+						DestinationCIDRBlock: aws.String(rtDestination),
+						NatGatewayID:         aws.String(rtNatGatewayID),
+					}},
+				},
+			},
+			want: want{
+				patch: &v1alpha4.RouteTableParameters{},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,87 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// SnapshotIDNotFound is the code that is returned by ec2 when the given SnapshotID is not valid
+	SnapshotIDNotFound = "InvalidSnapshot.NotFound"
+	// SnapshotIDMalformed is the code that is returned by ec2 when the given SnapshotID is not well formed
+	SnapshotIDMalformed = "InvalidSnapshotID.Malformed"
+)
+
+// SnapshotClient is the external client used for Snapshot Custom Resource
+type SnapshotClient interface {
+	CreateSnapshotRequest(input *ec2.CreateSnapshotInput) ec2.CreateSnapshotRequest
+	DescribeSnapshotsRequest(input *ec2.DescribeSnapshotsInput) ec2.DescribeSnapshotsRequest
+	DeleteSnapshotRequest(input *ec2.DeleteSnapshotInput) ec2.DeleteSnapshotRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewSnapshotClient returns a new client using AWS credentials as JSON encoded data.
+func NewSnapshotClient(cfg aws.Config) SnapshotClient {
+	return ec2.New(cfg)
+}
+
+// IsSnapshotNotFoundErr returns true if the error is because the item doesn't exist
+func IsSnapshotNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == SnapshotIDNotFound || awsErr.Code() == SnapshotIDMalformed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateSnapshotInput returns the input for a CreateSnapshot request
+// built from the given parameters.
+func GenerateCreateSnapshotInput(p v1alpha1.SnapshotParameters) *ec2.CreateSnapshotInput {
+	in := &ec2.CreateSnapshotInput{
+		VolumeId:    p.VolumeID,
+		Description: p.Description,
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeSnapshot,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return in
+}
+
+// GenerateSnapshotObservation is used to produce
+// v1alpha1.SnapshotObservation from ec2.Snapshot.
+func GenerateSnapshotObservation(s ec2.Snapshot) v1alpha1.SnapshotObservation {
+	return v1alpha1.SnapshotObservation{
+		SnapshotID: aws.StringValue(s.SnapshotId),
+		State:      string(s.State),
+		Progress:   aws.StringValue(s.Progress),
+		VolumeSize: aws.Int64Value(s.VolumeSize),
+		Encrypted:  aws.BoolValue(s.Encrypted),
+	}
+}
+
+// LateInitializeSnapshot fills the empty fields in
+// *v1alpha1.SnapshotParameters with the values seen in ec2.Snapshot.
+func LateInitializeSnapshot(in *v1alpha1.SnapshotParameters, s *ec2.Snapshot) {
+	if s == nil {
+		return
+	}
+
+	in.VolumeID = awsclients.LateInitializeStringPtr(in.VolumeID, s.VolumeId)
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, awsclients.String(aws.StringValue(s.Description)))
+	if len(in.Tags) == 0 && len(s.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(s.Tags)
+	}
+}
@@ -46,6 +46,7 @@ func GenerateSubnetObservation(subnet ec2.Subnet) v1beta1.SubnetObservation {
 		AvailableIPAddressCount: aws.Int64Value(subnet.AvailableIpAddressCount),
 		DefaultForAZ:            aws.BoolValue(subnet.DefaultForAz),
 		SubnetID:                aws.StringValue(subnet.SubnetId),
+		SubnetARN:               aws.StringValue(subnet.SubnetArn),
 		SubnetState:             string(subnet.State),
 	}
 
@@ -0,0 +1,103 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// TransitGatewayIDNotFound is the code that is returned by ec2 when the given TransitGatewayID is not valid
+	TransitGatewayIDNotFound = "InvalidTransitGatewayID.NotFound"
+)
+
+// TransitGatewayClient is the external client used for TransitGateway Custom Resource
+type TransitGatewayClient interface {
+	CreateTransitGatewayRequest(input *ec2.CreateTransitGatewayInput) ec2.CreateTransitGatewayRequest
+	DescribeTransitGatewaysRequest(input *ec2.DescribeTransitGatewaysInput) ec2.DescribeTransitGatewaysRequest
+	DeleteTransitGatewayRequest(input *ec2.DeleteTransitGatewayInput) ec2.DeleteTransitGatewayRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewTransitGatewayClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayClient(cfg aws.Config) TransitGatewayClient {
+	return ec2.New(cfg)
+}
+
+// IsTransitGatewayNotFoundErr returns true if the error is because the item doesn't exist
+func IsTransitGatewayNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == TransitGatewayIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateTransitGatewayInput returns the input for a
+// CreateTransitGateway request built from the given parameters.
+func GenerateCreateTransitGatewayInput(p v1alpha1.TransitGatewayParameters) *ec2.CreateTransitGatewayInput {
+	return &ec2.CreateTransitGatewayInput{
+		Description: p.Description,
+		Options: &ec2.TransitGatewayRequestOptions{
+			AmazonSideAsn:                p.AmazonSideASN,
+			AutoAcceptSharedAttachments:  ec2.AutoAcceptSharedAttachmentsValue(aws.StringValue(p.AutoAcceptSharedAttachments)),
+			DefaultRouteTableAssociation: ec2.DefaultRouteTableAssociationValue(aws.StringValue(p.DefaultRouteTableAssociation)),
+			DefaultRouteTablePropagation: ec2.DefaultRouteTablePropagationValue(aws.StringValue(p.DefaultRouteTablePropagation)),
+			DnsSupport:                   ec2.DnsSupportValue(aws.StringValue(p.DNSSupport)),
+			MulticastSupport:             ec2.MulticastSupportValue(aws.StringValue(p.MulticastSupport)),
+			VpnEcmpSupport:               ec2.VpnEcmpSupportValue(aws.StringValue(p.VPNECMPSupport)),
+		},
+		TagSpecifications: []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeTransitGateway,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		},
+	}
+}
+
+// GenerateTransitGatewayObservation is used to produce
+// v1alpha1.TransitGatewayObservation from ec2.TransitGateway.
+func GenerateTransitGatewayObservation(tgw ec2.TransitGateway) v1alpha1.TransitGatewayObservation {
+	o := v1alpha1.TransitGatewayObservation{
+		TransitGatewayARN: aws.StringValue(tgw.TransitGatewayArn),
+		OwnerID:           aws.StringValue(tgw.OwnerId),
+		State:             string(tgw.State),
+	}
+	if tgw.Options != nil {
+		o.AssociationDefaultRouteTableID = aws.StringValue(tgw.Options.AssociationDefaultRouteTableId)
+		o.PropagationDefaultRouteTableID = aws.StringValue(tgw.Options.PropagationDefaultRouteTableId)
+	}
+	return o
+}
+
+// LateInitializeTransitGateway fills the empty fields in
+// *v1alpha1.TransitGatewayParameters with the values seen in
+// ec2.TransitGateway.
+func LateInitializeTransitGateway(in *v1alpha1.TransitGatewayParameters, tgw *ec2.TransitGateway) {
+	if tgw == nil {
+		return
+	}
+
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, tgw.Description)
+	if tgw.Options != nil {
+		in.AmazonSideASN = awsclients.LateInitializeInt64Ptr(in.AmazonSideASN, tgw.Options.AmazonSideAsn)
+		in.AutoAcceptSharedAttachments = awsclients.LateInitializeStringPtr(in.AutoAcceptSharedAttachments, awsclients.String(string(tgw.Options.AutoAcceptSharedAttachments)))
+		in.DefaultRouteTableAssociation = awsclients.LateInitializeStringPtr(in.DefaultRouteTableAssociation, awsclients.String(string(tgw.Options.DefaultRouteTableAssociation)))
+		in.DefaultRouteTablePropagation = awsclients.LateInitializeStringPtr(in.DefaultRouteTablePropagation, awsclients.String(string(tgw.Options.DefaultRouteTablePropagation)))
+		in.DNSSupport = awsclients.LateInitializeStringPtr(in.DNSSupport, awsclients.String(string(tgw.Options.DnsSupport)))
+		in.MulticastSupport = awsclients.LateInitializeStringPtr(in.MulticastSupport, awsclients.String(string(tgw.Options.MulticastSupport)))
+		in.VPNECMPSupport = awsclients.LateInitializeStringPtr(in.VPNECMPSupport, awsclients.String(string(tgw.Options.VpnEcmpSupport)))
+	}
+
+	if len(in.Tags) == 0 && len(tgw.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(tgw.Tags)
+	}
+}
@@ -0,0 +1,67 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// TransitGatewayRouteTableIDNotFound is the code that is returned by ec2 when the given TransitGatewayRouteTableID is not valid
+	TransitGatewayRouteTableIDNotFound = "InvalidRouteTableID.NotFound"
+)
+
+// TransitGatewayRouteTableClient is the external client used for
+// TransitGatewayRouteTable Custom Resource
+type TransitGatewayRouteTableClient interface {
+	CreateTransitGatewayRouteTableRequest(input *ec2.CreateTransitGatewayRouteTableInput) ec2.CreateTransitGatewayRouteTableRequest
+	DescribeTransitGatewayRouteTablesRequest(input *ec2.DescribeTransitGatewayRouteTablesInput) ec2.DescribeTransitGatewayRouteTablesRequest
+	DeleteTransitGatewayRouteTableRequest(input *ec2.DeleteTransitGatewayRouteTableInput) ec2.DeleteTransitGatewayRouteTableRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewTransitGatewayRouteTableClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayRouteTableClient(cfg aws.Config) TransitGatewayRouteTableClient {
+	return ec2.New(cfg)
+}
+
+// IsTransitGatewayRouteTableNotFoundErr returns true if the error is because the item doesn't exist
+func IsTransitGatewayRouteTableNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == TransitGatewayRouteTableIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateTransitGatewayRouteTableObservation is used to produce
+// v1alpha1.TransitGatewayRouteTableObservation from
+// ec2.TransitGatewayRouteTable.
+func GenerateTransitGatewayRouteTableObservation(rt ec2.TransitGatewayRouteTable) v1alpha1.TransitGatewayRouteTableObservation {
+	return v1alpha1.TransitGatewayRouteTableObservation{
+		State:                        string(rt.State),
+		DefaultAssociationRouteTable: aws.BoolValue(rt.DefaultAssociationRouteTable),
+		DefaultPropagationRouteTable: aws.BoolValue(rt.DefaultPropagationRouteTable),
+	}
+}
+
+// LateInitializeTransitGatewayRouteTable fills the empty fields in
+// *v1alpha1.TransitGatewayRouteTableParameters with the values seen in
+// ec2.TransitGatewayRouteTable.
+func LateInitializeTransitGatewayRouteTable(in *v1alpha1.TransitGatewayRouteTableParameters, rt *ec2.TransitGatewayRouteTable) {
+	if rt == nil {
+		return
+	}
+
+	in.TransitGatewayID = awsclients.LateInitializeStringPtr(in.TransitGatewayID, rt.TransitGatewayId)
+	if len(in.Tags) == 0 && len(rt.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(rt.Tags)
+	}
+}
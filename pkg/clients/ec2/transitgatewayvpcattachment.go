@@ -0,0 +1,158 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// TransitGatewayAttachmentIDNotFound is the code that is returned by ec2 when the given TransitGatewayAttachmentID is not valid
+	TransitGatewayAttachmentIDNotFound = "InvalidTransitGatewayAttachmentID.NotFound"
+)
+
+// TransitGatewayVPCAttachmentClient is the external client used for
+// TransitGatewayVPCAttachment Custom Resource
+type TransitGatewayVPCAttachmentClient interface {
+	CreateTransitGatewayVpcAttachmentRequest(input *ec2.CreateTransitGatewayVpcAttachmentInput) ec2.CreateTransitGatewayVpcAttachmentRequest
+	DescribeTransitGatewayVpcAttachmentsRequest(input *ec2.DescribeTransitGatewayVpcAttachmentsInput) ec2.DescribeTransitGatewayVpcAttachmentsRequest
+	ModifyTransitGatewayVpcAttachmentRequest(input *ec2.ModifyTransitGatewayVpcAttachmentInput) ec2.ModifyTransitGatewayVpcAttachmentRequest
+	DeleteTransitGatewayVpcAttachmentRequest(input *ec2.DeleteTransitGatewayVpcAttachmentInput) ec2.DeleteTransitGatewayVpcAttachmentRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewTransitGatewayVPCAttachmentClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayVPCAttachmentClient(cfg aws.Config) TransitGatewayVPCAttachmentClient {
+	return ec2.New(cfg)
+}
+
+// IsTransitGatewayAttachmentNotFoundErr returns true if the error is because the item doesn't exist
+func IsTransitGatewayAttachmentNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == TransitGatewayAttachmentIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateTransitGatewayVPCAttachmentInput returns the input for a
+// CreateTransitGatewayVpcAttachment request built from the given parameters.
+func GenerateCreateTransitGatewayVPCAttachmentInput(p v1alpha1.TransitGatewayVPCAttachmentParameters) *ec2.CreateTransitGatewayVpcAttachmentInput {
+	return &ec2.CreateTransitGatewayVpcAttachmentInput{
+		TransitGatewayId: p.TransitGatewayID,
+		VpcId:            p.VPCID,
+		SubnetIds:        p.SubnetIDs,
+		Options: &ec2.CreateTransitGatewayVpcAttachmentRequestOptions{
+			DnsSupport:  ec2.DnsSupportValue(aws.StringValue(p.DNSSupport)),
+			Ipv6Support: ec2.Ipv6SupportValue(aws.StringValue(p.IPv6Support)),
+		},
+		TagSpecifications: []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeTransitGatewayAttachment,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		},
+	}
+}
+
+// GenerateModifyTransitGatewayVPCAttachmentInput returns the input for a
+// ModifyTransitGatewayVpcAttachment request that brings the observed
+// attachment to the state described by the given parameters.
+func GenerateModifyTransitGatewayVPCAttachmentInput(id string, p v1alpha1.TransitGatewayVPCAttachmentParameters, a ec2.TransitGatewayVpcAttachment) *ec2.ModifyTransitGatewayVpcAttachmentInput {
+	add, remove := DiffTransitGatewayVPCAttachmentSubnets(p.SubnetIDs, a.SubnetIds)
+	return &ec2.ModifyTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(id),
+		AddSubnetIds:               add,
+		RemoveSubnetIds:            remove,
+		Options: &ec2.ModifyTransitGatewayVpcAttachmentRequestOptions{
+			DnsSupport:  ec2.DnsSupportValue(aws.StringValue(p.DNSSupport)),
+			Ipv6Support: ec2.Ipv6SupportValue(aws.StringValue(p.IPv6Support)),
+		},
+	}
+}
+
+// DiffTransitGatewayVPCAttachmentSubnets returns the subnets that need to be
+// added to and removed from the observed list to match the desired one.
+func DiffTransitGatewayVPCAttachmentSubnets(desired, observed []string) (add, remove []string) {
+	d := make(map[string]struct{}, len(desired))
+	for _, s := range desired {
+		d[s] = struct{}{}
+	}
+	o := make(map[string]struct{}, len(observed))
+	for _, s := range observed {
+		o[s] = struct{}{}
+		if _, ok := d[s]; !ok {
+			remove = append(remove, s)
+		}
+	}
+	for _, s := range desired {
+		if _, ok := o[s]; !ok {
+			add = append(add, s)
+		}
+	}
+	return add, remove
+}
+
+// GenerateTransitGatewayVPCAttachmentObservation is used to produce
+// v1alpha1.TransitGatewayVPCAttachmentObservation from
+// ec2.TransitGatewayVpcAttachment.
+func GenerateTransitGatewayVPCAttachmentObservation(a ec2.TransitGatewayVpcAttachment) v1alpha1.TransitGatewayVPCAttachmentObservation {
+	return v1alpha1.TransitGatewayVPCAttachmentObservation{
+		State:      string(a.State),
+		VPCOwnerID: aws.StringValue(a.VpcOwnerId),
+	}
+}
+
+// LateInitializeTransitGatewayVPCAttachment fills the empty fields in
+// *v1alpha1.TransitGatewayVPCAttachmentParameters with the values seen in
+// ec2.TransitGatewayVpcAttachment.
+func LateInitializeTransitGatewayVPCAttachment(in *v1alpha1.TransitGatewayVPCAttachmentParameters, a *ec2.TransitGatewayVpcAttachment) {
+	if a == nil {
+		return
+	}
+
+	in.TransitGatewayID = awsclients.LateInitializeStringPtr(in.TransitGatewayID, a.TransitGatewayId)
+	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, a.VpcId)
+	if len(in.SubnetIDs) == 0 && len(a.SubnetIds) != 0 {
+		in.SubnetIDs = a.SubnetIds
+	}
+	if a.Options != nil {
+		in.DNSSupport = awsclients.LateInitializeStringPtr(in.DNSSupport, awsclients.String(string(a.Options.DnsSupport)))
+		in.IPv6Support = awsclients.LateInitializeStringPtr(in.IPv6Support, awsclients.String(string(a.Options.Ipv6Support)))
+	}
+
+	if len(in.Tags) == 0 && len(a.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(a.Tags)
+	}
+}
+
+// IsTransitGatewayVPCAttachmentUpToDate checks whether there is a change in
+// any of the modifiable fields.
+func IsTransitGatewayVPCAttachmentUpToDate(p v1alpha1.TransitGatewayVPCAttachmentParameters, a ec2.TransitGatewayVpcAttachment) bool {
+	return IsTransitGatewayVPCAttachmentConfigUpToDate(p, a) && v1beta1.CompareTags(p.Tags, a.Tags)
+}
+
+// IsTransitGatewayVPCAttachmentConfigUpToDate checks whether there is a change
+// in the subnets or options of the attachment, i.e. the fields that are
+// updated with a ModifyTransitGatewayVpcAttachment request.
+func IsTransitGatewayVPCAttachmentConfigUpToDate(p v1alpha1.TransitGatewayVPCAttachmentParameters, a ec2.TransitGatewayVpcAttachment) bool {
+	if add, remove := DiffTransitGatewayVPCAttachmentSubnets(p.SubnetIDs, a.SubnetIds); len(add) != 0 || len(remove) != 0 {
+		return false
+	}
+	if a.Options != nil {
+		if p.DNSSupport != nil && *p.DNSSupport != string(a.Options.DnsSupport) {
+			return false
+		}
+		if p.IPv6Support != nil && *p.IPv6Support != string(a.Options.Ipv6Support) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,107 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	tgwAttachmentEnable = "enable"
+)
+
+func TestDiffTransitGatewayVPCAttachmentSubnets(t *testing.T) {
+	type want struct {
+		add    []string
+		remove []string
+	}
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		want     want
+	}{
+		"Same": {
+			desired:  []string{"a", "b"},
+			observed: []string{"b", "a"},
+		},
+		"AddAndRemove": {
+			desired:  []string{"a", "c"},
+			observed: []string{"a", "b"},
+			want: want{
+				add:    []string{"c"},
+				remove: []string{"b"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTransitGatewayVPCAttachmentSubnets(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTransitGatewayVPCAttachmentUpToDate(t *testing.T) {
+	observed := ec2.TransitGatewayVpcAttachment{
+		SubnetIds: []string{"a"},
+		Options: &ec2.TransitGatewayVpcAttachmentOptions{
+			DnsSupport:  ec2.DnsSupportValueEnable,
+			Ipv6Support: ec2.Ipv6SupportValueDisable,
+		},
+		Tags: []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}
+	cases := map[string]struct {
+		p    v1alpha1.TransitGatewayVPCAttachmentParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs:  []string{"a"},
+				DNSSupport: &tgwAttachmentEnable,
+				Tags:       []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: true,
+		},
+		"SubnetsDiffer": {
+			p: v1alpha1.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs: []string{"a", "b"},
+				Tags:      []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: false,
+		},
+		"OptionsDiffer": {
+			p: v1alpha1.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs:   []string{"a"},
+				IPv6Support: &tgwAttachmentEnable,
+				Tags:        []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: false,
+		},
+		"TagsDiffer": {
+			p: v1alpha1.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs: []string{"a"},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTransitGatewayVPCAttachmentUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VolumeIDNotFound is the code that is returned by ec2 when the given VolumeID is not valid
+	VolumeIDNotFound = "InvalidVolume.NotFound"
+	// VolumeIDMalformed is the code that is returned by ec2 when the given VolumeID is not well formed
+	VolumeIDMalformed = "InvalidVolumeID.Malformed"
+	// VolumeModificationNotFound is the code that is returned by ec2 when the given volume was never modified
+	VolumeModificationNotFound = "InvalidVolumeModification.NotFound"
+)
+
+// VolumeClient is the external client used for Volume Custom Resource
+type VolumeClient interface {
+	CreateVolumeRequest(input *ec2.CreateVolumeInput) ec2.CreateVolumeRequest
+	DescribeVolumesRequest(input *ec2.DescribeVolumesInput) ec2.DescribeVolumesRequest
+	DescribeVolumesModificationsRequest(input *ec2.DescribeVolumesModificationsInput) ec2.DescribeVolumesModificationsRequest
+	ModifyVolumeRequest(input *ec2.ModifyVolumeInput) ec2.ModifyVolumeRequest
+	DeleteVolumeRequest(input *ec2.DeleteVolumeInput) ec2.DeleteVolumeRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewVolumeClient returns a new client using AWS credentials as JSON encoded data.
+func NewVolumeClient(cfg aws.Config) VolumeClient {
+	return ec2.New(cfg)
+}
+
+// IsVolumeNotFoundErr returns true if the error is because the item doesn't exist
+func IsVolumeNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VolumeIDNotFound || awsErr.Code() == VolumeIDMalformed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsVolumeModificationNotFoundErr returns true if the error is because the
+// volume was never modified.
+func IsVolumeModificationNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == VolumeModificationNotFound
+	}
+	return false
+}
+
+// hasProvisionedIOPS returns true if the IOPS of volumes of the given type
+// are provisioned rather than derived from their size.
+func hasProvisionedIOPS(volumeType string) bool {
+	return volumeType == string(ec2.VolumeTypeIo1) || volumeType == "io2"
+}
+
+// GenerateCreateVolumeInput returns the input for a CreateVolume request
+// built from the given parameters.
+func GenerateCreateVolumeInput(p v1alpha1.VolumeParameters) *ec2.CreateVolumeInput {
+	in := &ec2.CreateVolumeInput{
+		AvailabilityZone:   aws.String(p.AvailabilityZone),
+		Size:               p.Size,
+		VolumeType:         ec2.VolumeType(aws.StringValue(p.VolumeType)),
+		Iops:               p.IOPS,
+		Encrypted:          p.Encrypted,
+		KmsKeyId:           p.KMSKeyID,
+		MultiAttachEnabled: p.MultiAttachEnabled,
+		SnapshotId:         p.SnapshotID,
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeVolume,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return in
+}
+
+// GenerateModifyVolumeInput returns the input for a ModifyVolume request
+// that changes the size, type and IOPS of the given volume to the desired
+// ones.
+func GenerateModifyVolumeInput(id string, p v1alpha1.VolumeParameters) *ec2.ModifyVolumeInput {
+	in := &ec2.ModifyVolumeInput{
+		VolumeId:   aws.String(id),
+		Size:       p.Size,
+		VolumeType: ec2.VolumeType(aws.StringValue(p.VolumeType)),
+	}
+	if hasProvisionedIOPS(aws.StringValue(p.VolumeType)) {
+		in.Iops = p.IOPS
+	}
+	return in
+}
+
+// GenerateVolumeObservation is used to produce v1alpha1.VolumeObservation
+// from ec2.Volume and its latest modification, if any.
+func GenerateVolumeObservation(v ec2.Volume, m *ec2.VolumeModification) v1alpha1.VolumeObservation {
+	o := v1alpha1.VolumeObservation{
+		VolumeID: aws.StringValue(v.VolumeId),
+		State:    string(v.State),
+	}
+	if m != nil {
+		o.ModificationState = string(m.ModificationState)
+	}
+	for _, a := range v.Attachments {
+		o.AttachedInstanceIDs = append(o.AttachedInstanceIDs, aws.StringValue(a.InstanceId))
+	}
+	return o
+}
+
+// LateInitializeVolume fills the empty fields in *v1alpha1.VolumeParameters
+// with the values seen in ec2.Volume.
+func LateInitializeVolume(in *v1alpha1.VolumeParameters, v *ec2.Volume) {
+	if v == nil {
+		return
+	}
+
+	in.Size = awsclients.LateInitializeInt64Ptr(in.Size, v.Size)
+	in.VolumeType = awsclients.LateInitializeStringPtr(in.VolumeType, awsclients.String(string(v.VolumeType)))
+	// EC2 reports the baseline IOPS of all volume types, but they can only
+	// be set for the types with provisioned IOPS.
+	if hasProvisionedIOPS(aws.StringValue(in.VolumeType)) {
+		in.IOPS = awsclients.LateInitializeInt64Ptr(in.IOPS, v.Iops)
+	}
+	in.Encrypted = awsclients.LateInitializeBoolPtr(in.Encrypted, v.Encrypted)
+	in.KMSKeyID = awsclients.LateInitializeStringPtr(in.KMSKeyID, v.KmsKeyId)
+	in.MultiAttachEnabled = awsclients.LateInitializeBoolPtr(in.MultiAttachEnabled, v.MultiAttachEnabled)
+	in.SnapshotID = awsclients.LateInitializeStringPtr(in.SnapshotID, awsclients.String(aws.StringValue(v.SnapshotId)))
+	if len(in.Tags) == 0 && len(v.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(v.Tags)
+	}
+}
+
+// IsVolumeUpToDate checks whether the size, type and IOPS of the given
+// volume match the desired ones. While a modification of the volume is in
+// progress, its target values are compared instead.
+func IsVolumeUpToDate(p v1alpha1.VolumeParameters, v ec2.Volume, m *ec2.VolumeModification) bool {
+	size, volumeType, iops := v.Size, v.VolumeType, v.Iops
+	if m != nil && (m.ModificationState == ec2.VolumeModificationStateModifying || m.ModificationState == ec2.VolumeModificationStateOptimizing) {
+		size, volumeType, iops = m.TargetSize, m.TargetVolumeType, m.TargetIops
+	}
+	switch {
+	case p.Size != nil && aws.Int64Value(p.Size) != aws.Int64Value(size):
+		return false
+	case p.VolumeType != nil && aws.StringValue(p.VolumeType) != string(volumeType):
+		return false
+	case p.IOPS != nil && hasProvisionedIOPS(aws.StringValue(p.VolumeType)) && aws.Int64Value(p.IOPS) != aws.Int64Value(iops):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsVolumeUpToDate(t *testing.T) {
+	gp2 := ec2.Volume{Size: aws.Int64(10), VolumeType: ec2.VolumeTypeGp2, Iops: aws.Int64(100)}
+	io1 := ec2.Volume{Size: aws.Int64(10), VolumeType: ec2.VolumeTypeIo1, Iops: aws.Int64(500)}
+
+	type args struct {
+		p v1alpha1.VolumeParameters
+		v ec2.Volume
+		m *ec2.VolumeModification
+	}
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"Same": {
+			args: args{
+				p: v1alpha1.VolumeParameters{Size: aws.Int64(10), VolumeType: aws.String("gp2")},
+				v: gp2,
+			},
+			want: true,
+		},
+		"BaselineIOPSIgnored": {
+			args: args{
+				p: v1alpha1.VolumeParameters{Size: aws.Int64(10), VolumeType: aws.String("gp2"), IOPS: aws.Int64(300)},
+				v: gp2,
+			},
+			want: true,
+		},
+		"DifferentSize": {
+			args: args{
+				p: v1alpha1.VolumeParameters{Size: aws.Int64(20), VolumeType: aws.String("gp2")},
+				v: gp2,
+			},
+			want: false,
+		},
+		"DifferentType": {
+			args: args{
+				p: v1alpha1.VolumeParameters{Size: aws.Int64(10), VolumeType: aws.String("io1"), IOPS: aws.Int64(500)},
+				v: gp2,
+			},
+			want: false,
+		},
+		"DifferentProvisionedIOPS": {
+			args: args{
+				p: v1alpha1.VolumeParameters{Size: aws.Int64(10), VolumeType: aws.String("io1"), IOPS: aws.Int64(1000)},
+				v: io1,
+			},
+			want: false,
+		},
+		"ModificationInProgress": {
+			args: args{
+				p: v1alpha1.VolumeParameters{Size: aws.Int64(20), VolumeType: aws.String("gp2")},
+				v: gp2,
+				m: &ec2.VolumeModification{
+					ModificationState: ec2.VolumeModificationStateOptimizing,
+					TargetSize:        aws.Int64(20),
+					TargetVolumeType:  ec2.VolumeTypeGp2,
+				},
+			},
+			want: true,
+		},
+		"ModificationFailed": {
+			args: args{
+				p: v1alpha1.VolumeParameters{Size: aws.Int64(20), VolumeType: aws.String("gp2")},
+				v: gp2,
+				m: &ec2.VolumeModification{
+					ModificationState: ec2.VolumeModificationStateFailed,
+					TargetSize:        aws.Int64(20),
+					TargetVolumeType:  ec2.VolumeTypeGp2,
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVolumeUpToDate(tc.p, tc.v, tc.m)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeVolume(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.VolumeParameters
+		v    *ec2.Volume
+		want *v1alpha1.VolumeParameters
+	}{
+		"BaselineIOPS": {
+			p: &v1alpha1.VolumeParameters{},
+			v: &ec2.Volume{Size: aws.Int64(10), VolumeType: ec2.VolumeTypeGp2, Iops: aws.Int64(100), Encrypted: aws.Bool(false)},
+			want: &v1alpha1.VolumeParameters{
+				Size:       aws.Int64(10),
+				VolumeType: aws.String("gp2"),
+				Encrypted:  aws.Bool(false),
+			},
+		},
+		"ProvisionedIOPS": {
+			p: &v1alpha1.VolumeParameters{Size: aws.Int64(20)},
+			v: &ec2.Volume{Size: aws.Int64(10), VolumeType: ec2.VolumeTypeIo1, Iops: aws.Int64(500), SnapshotId: aws.String("")},
+			want: &v1alpha1.VolumeParameters{
+				Size:       aws.Int64(20),
+				VolumeType: aws.String("io1"),
+				IOPS:       aws.Int64(500),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeVolume(tc.p, tc.v)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
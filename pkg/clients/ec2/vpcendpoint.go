@@ -0,0 +1,200 @@
+package ec2
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VPCEndpointIDNotFound is the code that is returned by ec2 when the given VPCEndpointID is not valid
+	VPCEndpointIDNotFound = "InvalidVpcEndpointId.NotFound"
+	// VPCEndpointIDMalformed is the code that is returned by ec2 when the given VPCEndpointID is not well formed
+	VPCEndpointIDMalformed = "InvalidVpcEndpointId.Malformed"
+
+	// The SDK in use has no constant for this resource type yet.
+	resourceTypeVPCEndpoint ec2.ResourceType = "vpc-endpoint"
+)
+
+// VPCEndpointClient is the external client used for VPCEndpoint Custom Resource
+type VPCEndpointClient interface {
+	CreateVpcEndpointRequest(input *ec2.CreateVpcEndpointInput) ec2.CreateVpcEndpointRequest
+	DescribeVpcEndpointsRequest(input *ec2.DescribeVpcEndpointsInput) ec2.DescribeVpcEndpointsRequest
+	ModifyVpcEndpointRequest(input *ec2.ModifyVpcEndpointInput) ec2.ModifyVpcEndpointRequest
+	DeleteVpcEndpointsRequest(input *ec2.DeleteVpcEndpointsInput) ec2.DeleteVpcEndpointsRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewVPCEndpointClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPCEndpointClient(cfg aws.Config) VPCEndpointClient {
+	return ec2.New(cfg)
+}
+
+// IsVPCEndpointNotFoundErr returns true if the error is because the item doesn't exist
+func IsVPCEndpointNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPCEndpointIDNotFound || awsErr.Code() == VPCEndpointIDMalformed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateVPCEndpointInput returns the input for a CreateVpcEndpoint
+// request built from the given parameters.
+func GenerateCreateVPCEndpointInput(p v1alpha4.VPCEndpointParameters) *ec2.CreateVpcEndpointInput {
+	in := &ec2.CreateVpcEndpointInput{
+		VpcId:             p.VPCID,
+		ServiceName:       aws.String(p.ServiceName),
+		VpcEndpointType:   ec2.VpcEndpointType(aws.StringValue(p.VPCEndpointType)),
+		PolicyDocument:    p.PolicyDocument,
+		PrivateDnsEnabled: p.PrivateDNSEnabled,
+		RouteTableIds:     p.RouteTableIDs,
+		SubnetIds:         p.SubnetIDs,
+		SecurityGroupIds:  p.SecurityGroupIDs,
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: resourceTypeVPCEndpoint,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return in
+}
+
+// GenerateVPCEndpointObservation is used to produce
+// v1alpha4.VPCEndpointObservation from ec2.VpcEndpoint.
+func GenerateVPCEndpointObservation(e ec2.VpcEndpoint) v1alpha4.VPCEndpointObservation {
+	o := v1alpha4.VPCEndpointObservation{
+		VPCEndpointID:       aws.StringValue(e.VpcEndpointId),
+		State:               string(e.State),
+		OwnerID:             aws.StringValue(e.OwnerId),
+		NetworkInterfaceIDs: e.NetworkInterfaceIds,
+	}
+	for _, d := range e.DnsEntries {
+		o.DNSEntries = append(o.DNSEntries, v1alpha4.VPCEndpointDNSEntry{
+			DNSName:      aws.StringValue(d.DnsName),
+			HostedZoneID: aws.StringValue(d.HostedZoneId),
+		})
+	}
+	return o
+}
+
+func vpcEndpointSecurityGroupIDs(e ec2.VpcEndpoint) []string {
+	ids := make([]string, len(e.Groups))
+	for k, g := range e.Groups {
+		ids[k] = aws.StringValue(g.GroupId)
+	}
+	return ids
+}
+
+// LateInitializeVPCEndpoint fills the empty fields in
+// *v1alpha4.VPCEndpointParameters with the values seen in ec2.VpcEndpoint.
+func LateInitializeVPCEndpoint(in *v1alpha4.VPCEndpointParameters, e *ec2.VpcEndpoint) {
+	if e == nil {
+		return
+	}
+
+	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, e.VpcId)
+	in.VPCEndpointType = awsclients.LateInitializeStringPtr(in.VPCEndpointType, awsclients.String(string(e.VpcEndpointType)))
+	in.PolicyDocument = awsclients.LateInitializeStringPtr(in.PolicyDocument, e.PolicyDocument)
+	in.PrivateDNSEnabled = awsclients.LateInitializeBoolPtr(in.PrivateDNSEnabled, e.PrivateDnsEnabled)
+	if len(in.RouteTableIDs) == 0 {
+		in.RouteTableIDs = e.RouteTableIds
+	}
+	if len(in.SubnetIDs) == 0 {
+		in.SubnetIDs = e.SubnetIds
+	}
+	if len(in.SecurityGroupIDs) == 0 && len(e.Groups) != 0 {
+		in.SecurityGroupIDs = vpcEndpointSecurityGroupIDs(*e)
+	}
+	if len(in.Tags) == 0 && len(e.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(e.Tags)
+	}
+}
+
+// IsVPCEndpointPolicyUpToDate checks whether the observed policy document is
+// semantically equal to the desired one. The policy document is not managed
+// if it is not specified.
+func IsVPCEndpointPolicyUpToDate(p v1alpha4.VPCEndpointParameters, e ec2.VpcEndpoint) bool {
+	if p.PolicyDocument == nil {
+		return true
+	}
+	var desired, observed interface{}
+	if err := json.Unmarshal([]byte(aws.StringValue(p.PolicyDocument)), &desired); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(aws.StringValue(e.PolicyDocument)), &observed); err != nil {
+		return false
+	}
+	return cmp.Equal(desired, observed)
+}
+
+// diffIDs returns the IDs that need to be added to and removed from the
+// observed list to match the desired one.
+func diffIDs(desired, observed []string) (add, remove []string) {
+	d := make(map[string]struct{}, len(desired))
+	for _, s := range desired {
+		d[s] = struct{}{}
+	}
+	o := make(map[string]struct{}, len(observed))
+	for _, s := range observed {
+		o[s] = struct{}{}
+		if _, ok := d[s]; !ok {
+			remove = append(remove, s)
+		}
+	}
+	for _, s := range desired {
+		if _, ok := o[s]; !ok {
+			add = append(add, s)
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+	return add, remove
+}
+
+// GenerateModifyVPCEndpointInput returns the input for a ModifyVpcEndpoint
+// request that brings the observed ec2.VpcEndpoint to the desired state. It
+// returns nil if no modification is needed.
+func GenerateModifyVPCEndpointInput(id string, p v1alpha4.VPCEndpointParameters, e ec2.VpcEndpoint) *ec2.ModifyVpcEndpointInput {
+	in := &ec2.ModifyVpcEndpointInput{VpcEndpointId: aws.String(id)}
+	in.AddRouteTableIds, in.RemoveRouteTableIds = diffIDs(p.RouteTableIDs, e.RouteTableIds)
+	in.AddSubnetIds, in.RemoveSubnetIds = diffIDs(p.SubnetIDs, e.SubnetIds)
+	in.AddSecurityGroupIds, in.RemoveSecurityGroupIds = diffIDs(p.SecurityGroupIDs, vpcEndpointSecurityGroupIDs(e))
+	changed := len(in.AddRouteTableIds)+len(in.RemoveRouteTableIds)+
+		len(in.AddSubnetIds)+len(in.RemoveSubnetIds)+
+		len(in.AddSecurityGroupIds)+len(in.RemoveSecurityGroupIds) != 0
+
+	if !IsVPCEndpointPolicyUpToDate(p, e) {
+		in.PolicyDocument = p.PolicyDocument
+		changed = true
+	}
+	if p.PrivateDNSEnabled != nil && aws.BoolValue(p.PrivateDNSEnabled) != aws.BoolValue(e.PrivateDnsEnabled) {
+		in.PrivateDnsEnabled = p.PrivateDNSEnabled
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return in
+}
+
+// IsVPCEndpointUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsVPCEndpointUpToDate(p v1alpha4.VPCEndpointParameters, e ec2.VpcEndpoint) bool {
+	return GenerateModifyVPCEndpointInput(aws.StringValue(e.VpcEndpointId), p, e) == nil &&
+		v1beta1.CompareTags(p.Tags, e.Tags)
+}
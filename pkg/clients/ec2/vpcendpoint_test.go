@@ -0,0 +1,116 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestGenerateModifyVPCEndpointInput(t *testing.T) {
+	id := "vpce-123"
+	observed := ec2.VpcEndpoint{
+		VpcEndpointId:  aws.String(id),
+		PolicyDocument: aws.String(`{"Version": "2008-10-17", "Statement": [{"Effect": "Allow", "Principal": "*", "Action": "*", "Resource": "*"}]}`),
+		RouteTableIds:  []string{"rtb-1", "rtb-2"},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha4.VPCEndpointParameters
+		want *ec2.ModifyVpcEndpointInput
+	}{
+		"UpToDate": {
+			p: v1alpha4.VPCEndpointParameters{
+				PolicyDocument: aws.String(`{"Statement":[{"Action":"*","Effect":"Allow","Principal":"*","Resource":"*"}],"Version":"2008-10-17"}`),
+				RouteTableIDs:  []string{"rtb-2", "rtb-1"},
+			},
+		},
+		"PolicyNotManaged": {
+			p: v1alpha4.VPCEndpointParameters{
+				RouteTableIDs: []string{"rtb-1", "rtb-2"},
+			},
+		},
+		"RouteTablesChanged": {
+			p: v1alpha4.VPCEndpointParameters{
+				RouteTableIDs: []string{"rtb-1", "rtb-3"},
+			},
+			want: &ec2.ModifyVpcEndpointInput{
+				VpcEndpointId:       aws.String(id),
+				AddRouteTableIds:    []string{"rtb-3"},
+				RemoveRouteTableIds: []string{"rtb-2"},
+			},
+		},
+		"PolicyAndPrivateDNSChanged": {
+			p: v1alpha4.VPCEndpointParameters{
+				PolicyDocument:    aws.String(`{"Statement":[]}`),
+				PrivateDNSEnabled: aws.Bool(true),
+				RouteTableIDs:     []string{"rtb-1", "rtb-2"},
+			},
+			want: &ec2.ModifyVpcEndpointInput{
+				VpcEndpointId:     aws.String(id),
+				PolicyDocument:    aws.String(`{"Statement":[]}`),
+				PrivateDnsEnabled: aws.Bool(true),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyVPCEndpointInput(id, tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeVPCEndpoint(t *testing.T) {
+	disabled := false
+	observed := &ec2.VpcEndpoint{
+		VpcId:             aws.String("vpc-1"),
+		VpcEndpointType:   ec2.VpcEndpointTypeInterface,
+		PrivateDnsEnabled: aws.Bool(true),
+		SubnetIds:         []string{"subnet-1"},
+		Groups:            []ec2.SecurityGroupIdentifier{{GroupId: aws.String("sg-1")}},
+	}
+
+	cases := map[string]struct {
+		in   v1alpha4.VPCEndpointParameters
+		want v1alpha4.VPCEndpointParameters
+	}{
+		"Empty": {
+			want: v1alpha4.VPCEndpointParameters{
+				VPCID:             aws.String("vpc-1"),
+				VPCEndpointType:   aws.String("Interface"),
+				PrivateDNSEnabled: aws.Bool(true),
+				SubnetIDs:         []string{"subnet-1"},
+				SecurityGroupIDs:  []string{"sg-1"},
+			},
+		},
+		"NotOverridden": {
+			in: v1alpha4.VPCEndpointParameters{
+				PrivateDNSEnabled: &disabled,
+				SecurityGroupIDs:  []string{"sg-2"},
+			},
+			want: v1alpha4.VPCEndpointParameters{
+				VPCID:             aws.String("vpc-1"),
+				VPCEndpointType:   aws.String("Interface"),
+				PrivateDNSEnabled: &disabled,
+				SubnetIDs:         []string{"subnet-1"},
+				SecurityGroupIDs:  []string{"sg-2"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeVPCEndpoint(&tc.in, observed)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VPCEndpointServiceIDNotFound is the code that is returned by ec2 when the given service ID is not valid
+	VPCEndpointServiceIDNotFound = "InvalidVpcEndpointServiceId.NotFound"
+	// VPCEndpointServiceIDMalformed is the code that is returned by ec2 when the given service ID is not well formed
+	VPCEndpointServiceIDMalformed = "InvalidVpcEndpointServiceId.Malformed"
+
+	// The SDK in use has no constant for this resource type yet.
+	resourceTypeVPCEndpointService ec2.ResourceType = "vpc-endpoint-service"
+)
+
+// VPCEndpointServiceConfigurationClient is the external client used for
+// VPCEndpointServiceConfiguration Custom Resource
+type VPCEndpointServiceConfigurationClient interface {
+	CreateVpcEndpointServiceConfigurationRequest(input *ec2.CreateVpcEndpointServiceConfigurationInput) ec2.CreateVpcEndpointServiceConfigurationRequest
+	DescribeVpcEndpointServiceConfigurationsRequest(input *ec2.DescribeVpcEndpointServiceConfigurationsInput) ec2.DescribeVpcEndpointServiceConfigurationsRequest
+	ModifyVpcEndpointServiceConfigurationRequest(input *ec2.ModifyVpcEndpointServiceConfigurationInput) ec2.ModifyVpcEndpointServiceConfigurationRequest
+	DeleteVpcEndpointServiceConfigurationsRequest(input *ec2.DeleteVpcEndpointServiceConfigurationsInput) ec2.DeleteVpcEndpointServiceConfigurationsRequest
+	DescribeVpcEndpointServicePermissionsRequest(input *ec2.DescribeVpcEndpointServicePermissionsInput) ec2.DescribeVpcEndpointServicePermissionsRequest
+	ModifyVpcEndpointServicePermissionsRequest(input *ec2.ModifyVpcEndpointServicePermissionsInput) ec2.ModifyVpcEndpointServicePermissionsRequest
+	StartVpcEndpointServicePrivateDnsVerificationRequest(input *ec2.StartVpcEndpointServicePrivateDnsVerificationInput) ec2.StartVpcEndpointServicePrivateDnsVerificationRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewVPCEndpointServiceConfigurationClient returns a new client using AWS
+// credentials as JSON encoded data.
+func NewVPCEndpointServiceConfigurationClient(cfg aws.Config) VPCEndpointServiceConfigurationClient {
+	return ec2.New(cfg)
+}
+
+// IsVPCEndpointServiceNotFoundErr returns true if the error is because the
+// item doesn't exist
+func IsVPCEndpointServiceNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPCEndpointServiceIDNotFound || awsErr.Code() == VPCEndpointServiceIDMalformed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateVPCEndpointServiceConfigurationInput returns the input for a
+// CreateVpcEndpointServiceConfiguration request built from the given
+// parameters.
+func GenerateCreateVPCEndpointServiceConfigurationInput(p v1alpha4.VPCEndpointServiceConfigurationParameters) *ec2.CreateVpcEndpointServiceConfigurationInput {
+	in := &ec2.CreateVpcEndpointServiceConfigurationInput{
+		AcceptanceRequired:      p.AcceptanceRequired,
+		NetworkLoadBalancerArns: p.NetworkLoadBalancerARNs,
+		PrivateDnsName:          p.PrivateDNSName,
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: resourceTypeVPCEndpointService,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return in
+}
+
+// GenerateVPCEndpointServiceConfigurationObservation is used to produce
+// v1alpha4.VPCEndpointServiceConfigurationObservation from
+// ec2.ServiceConfiguration.
+func GenerateVPCEndpointServiceConfigurationObservation(c ec2.ServiceConfiguration) v1alpha4.VPCEndpointServiceConfigurationObservation {
+	o := v1alpha4.VPCEndpointServiceConfigurationObservation{
+		ServiceID:            aws.StringValue(c.ServiceId),
+		ServiceName:          aws.StringValue(c.ServiceName),
+		ServiceState:         string(c.ServiceState),
+		AvailabilityZones:    c.AvailabilityZones,
+		BaseEndpointDNSNames: c.BaseEndpointDnsNames,
+	}
+	if d := c.PrivateDnsNameConfiguration; d != nil {
+		o.PrivateDNSNameConfiguration = &v1alpha4.PrivateDNSNameConfiguration{
+			Name:  aws.StringValue(d.Name),
+			State: string(d.State),
+			Type:  aws.StringValue(d.Type),
+			Value: aws.StringValue(d.Value),
+		}
+	}
+	return o
+}
+
+// LateInitializeVPCEndpointServiceConfiguration fills the empty fields in
+// *v1alpha4.VPCEndpointServiceConfigurationParameters with the values seen in
+// ec2.ServiceConfiguration.
+func LateInitializeVPCEndpointServiceConfiguration(in *v1alpha4.VPCEndpointServiceConfigurationParameters, c *ec2.ServiceConfiguration) {
+	if c == nil {
+		return
+	}
+
+	in.AcceptanceRequired = awsclients.LateInitializeBoolPtr(in.AcceptanceRequired, c.AcceptanceRequired)
+	in.PrivateDNSName = awsclients.LateInitializeStringPtr(in.PrivateDNSName, c.PrivateDnsName)
+	if len(in.NetworkLoadBalancerARNs) == 0 {
+		in.NetworkLoadBalancerARNs = c.NetworkLoadBalancerArns
+	}
+	if len(in.Tags) == 0 && len(c.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(c.Tags)
+	}
+}
+
+// GenerateModifyVPCEndpointServiceConfigurationInput returns the input for a
+// ModifyVpcEndpointServiceConfiguration request that brings the observed
+// ec2.ServiceConfiguration to the desired state. It returns nil if no
+// modification is needed.
+func GenerateModifyVPCEndpointServiceConfigurationInput(id string, p v1alpha4.VPCEndpointServiceConfigurationParameters, c ec2.ServiceConfiguration) *ec2.ModifyVpcEndpointServiceConfigurationInput {
+	in := &ec2.ModifyVpcEndpointServiceConfigurationInput{ServiceId: aws.String(id)}
+	in.AddNetworkLoadBalancerArns, in.RemoveNetworkLoadBalancerArns = diffIDs(p.NetworkLoadBalancerARNs, c.NetworkLoadBalancerArns)
+	changed := len(in.AddNetworkLoadBalancerArns)+len(in.RemoveNetworkLoadBalancerArns) != 0
+
+	if p.AcceptanceRequired != nil && aws.BoolValue(p.AcceptanceRequired) != aws.BoolValue(c.AcceptanceRequired) {
+		in.AcceptanceRequired = p.AcceptanceRequired
+		changed = true
+	}
+	if p.PrivateDNSName != nil && aws.StringValue(p.PrivateDNSName) != aws.StringValue(c.PrivateDnsName) {
+		in.PrivateDnsName = p.PrivateDNSName
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return in
+}
+
+// GenerateModifyVPCEndpointServicePermissionsInput returns the input for a
+// ModifyVpcEndpointServicePermissions request that makes the observed allowed
+// principals match the desired ones. It returns nil if they already match.
+func GenerateModifyVPCEndpointServicePermissionsInput(id string, p v1alpha4.VPCEndpointServiceConfigurationParameters, principals []ec2.AllowedPrincipal) *ec2.ModifyVpcEndpointServicePermissionsInput {
+	observed := make([]string, len(principals))
+	for i, a := range principals {
+		observed[i] = aws.StringValue(a.Principal)
+	}
+	add, remove := diffIDs(p.AllowedPrincipals, observed)
+	if len(add)+len(remove) == 0 {
+		return nil
+	}
+	return &ec2.ModifyVpcEndpointServicePermissionsInput{
+		ServiceId:               aws.String(id),
+		AddAllowedPrincipals:    add,
+		RemoveAllowedPrincipals: remove,
+	}
+}
+
+// IsPrivateDNSNameVerificationNeeded returns true if the ownership of the
+// domain of the private DNS name of the service has not been verified yet,
+// or its last verification failed.
+func IsPrivateDNSNameVerificationNeeded(c ec2.ServiceConfiguration) bool {
+	if c.PrivateDnsNameConfiguration == nil {
+		return false
+	}
+	switch c.PrivateDnsNameConfiguration.State {
+	case ec2.DnsNameStatePendingVerification, ec2.DnsNameStateFailed:
+		return true
+	}
+	return false
+}
+
+// IsVPCEndpointServiceConfigurationUpToDate checks whether there is a change
+// in any of the modifiable fields.
+func IsVPCEndpointServiceConfigurationUpToDate(p v1alpha4.VPCEndpointServiceConfigurationParameters, c ec2.ServiceConfiguration, principals []ec2.AllowedPrincipal) bool {
+	id := aws.StringValue(c.ServiceId)
+	return GenerateModifyVPCEndpointServiceConfigurationInput(id, p, c) == nil &&
+		GenerateModifyVPCEndpointServicePermissionsInput(id, p, principals) == nil &&
+		!IsPrivateDNSNameVerificationNeeded(c) &&
+		v1beta1.CompareTags(p.Tags, c.Tags)
+}
@@ -0,0 +1,111 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestGenerateModifyVPCEndpointServicePermissionsInput(t *testing.T) {
+	id := "vpce-svc-123"
+	observed := []ec2.AllowedPrincipal{
+		{Principal: aws.String("arn:aws:iam::111111111111:root")},
+		{Principal: aws.String("arn:aws:iam::222222222222:root")},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha4.VPCEndpointServiceConfigurationParameters
+		want *ec2.ModifyVpcEndpointServicePermissionsInput
+	}{
+		"UpToDate": {
+			p: v1alpha4.VPCEndpointServiceConfigurationParameters{
+				AllowedPrincipals: []string{"arn:aws:iam::222222222222:root", "arn:aws:iam::111111111111:root"},
+			},
+		},
+		"PrincipalsChanged": {
+			p: v1alpha4.VPCEndpointServiceConfigurationParameters{
+				AllowedPrincipals: []string{"arn:aws:iam::111111111111:root", "arn:aws:iam::333333333333:root"},
+			},
+			want: &ec2.ModifyVpcEndpointServicePermissionsInput{
+				ServiceId:               aws.String(id),
+				AddAllowedPrincipals:    []string{"arn:aws:iam::333333333333:root"},
+				RemoveAllowedPrincipals: []string{"arn:aws:iam::222222222222:root"},
+			},
+		},
+		"AllRemoved": {
+			p: v1alpha4.VPCEndpointServiceConfigurationParameters{},
+			want: &ec2.ModifyVpcEndpointServicePermissionsInput{
+				ServiceId:               aws.String(id),
+				RemoveAllowedPrincipals: []string{"arn:aws:iam::111111111111:root", "arn:aws:iam::222222222222:root"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyVPCEndpointServicePermissionsInput(id, tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsVPCEndpointServiceConfigurationUpToDate(t *testing.T) {
+	observed := func(state ec2.DnsNameState) ec2.ServiceConfiguration {
+		return ec2.ServiceConfiguration{
+			ServiceId:               aws.String("vpce-svc-123"),
+			AcceptanceRequired:      aws.Bool(true),
+			NetworkLoadBalancerArns: []string{"arn:nlb-1"},
+			PrivateDnsName:          aws.String("service.example.com"),
+			PrivateDnsNameConfiguration: &ec2.PrivateDnsNameConfiguration{
+				Name:  aws.String("_vpce"),
+				State: state,
+			},
+		}
+	}
+	params := v1alpha4.VPCEndpointServiceConfigurationParameters{
+		AcceptanceRequired:      aws.Bool(true),
+		NetworkLoadBalancerARNs: []string{"arn:nlb-1"},
+		PrivateDNSName:          aws.String("service.example.com"),
+	}
+
+	cases := map[string]struct {
+		p    v1alpha4.VPCEndpointServiceConfigurationParameters
+		c    ec2.ServiceConfiguration
+		want bool
+	}{
+		"UpToDate": {
+			p:    params,
+			c:    observed(ec2.DnsNameStateVerified),
+			want: true,
+		},
+		"PendingVerification": {
+			p: params,
+			c: observed(ec2.DnsNameStatePendingVerification),
+		},
+		"VerificationFailed": {
+			p: params,
+			c: observed(ec2.DnsNameStateFailed),
+		},
+		"LoadBalancersChanged": {
+			p: v1alpha4.VPCEndpointServiceConfigurationParameters{
+				NetworkLoadBalancerARNs: []string{"arn:nlb-1", "arn:nlb-2"},
+			},
+			c: observed(ec2.DnsNameStateVerified),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVPCEndpointServiceConfigurationUpToDate(tc.p, tc.c, nil)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
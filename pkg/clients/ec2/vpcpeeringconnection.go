@@ -0,0 +1,134 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VPCPeeringConnectionIDNotFound is the code that is returned by ec2 when the given VPCPeeringConnectionID is not valid
+	VPCPeeringConnectionIDNotFound = "InvalidVpcPeeringConnectionID.NotFound"
+)
+
+// VPCPeeringConnectionClient is the external client used for
+// VPCPeeringConnection Custom Resource
+type VPCPeeringConnectionClient interface {
+	CreateVpcPeeringConnectionRequest(input *ec2.CreateVpcPeeringConnectionInput) ec2.CreateVpcPeeringConnectionRequest
+	DescribeVpcPeeringConnectionsRequest(input *ec2.DescribeVpcPeeringConnectionsInput) ec2.DescribeVpcPeeringConnectionsRequest
+	AcceptVpcPeeringConnectionRequest(input *ec2.AcceptVpcPeeringConnectionInput) ec2.AcceptVpcPeeringConnectionRequest
+	ModifyVpcPeeringConnectionOptionsRequest(input *ec2.ModifyVpcPeeringConnectionOptionsInput) ec2.ModifyVpcPeeringConnectionOptionsRequest
+	DeleteVpcPeeringConnectionRequest(input *ec2.DeleteVpcPeeringConnectionInput) ec2.DeleteVpcPeeringConnectionRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewVPCPeeringConnectionClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPCPeeringConnectionClient(cfg aws.Config) VPCPeeringConnectionClient {
+	return ec2.New(cfg)
+}
+
+// IsVPCPeeringConnectionNotFoundErr returns true if the error is because the item doesn't exist
+func IsVPCPeeringConnectionNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPCPeeringConnectionIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateVPCPeeringConnectionInput returns the input for a
+// CreateVpcPeeringConnection request built from the given parameters.
+func GenerateCreateVPCPeeringConnectionInput(p v1alpha1.VPCPeeringConnectionParameters) *ec2.CreateVpcPeeringConnectionInput {
+	return &ec2.CreateVpcPeeringConnectionInput{
+		VpcId:       p.VPCID,
+		PeerVpcId:   p.PeerVPCID,
+		PeerOwnerId: p.PeerOwnerID,
+		PeerRegion:  p.PeerRegion,
+	}
+}
+
+func generateVPCInfo(in *ec2.VpcPeeringConnectionVpcInfo) v1alpha1.VPCPeeringConnectionVPCInfo {
+	if in == nil {
+		return v1alpha1.VPCPeeringConnectionVPCInfo{}
+	}
+	return v1alpha1.VPCPeeringConnectionVPCInfo{
+		CIDRBlock: aws.StringValue(in.CidrBlock),
+		OwnerID:   aws.StringValue(in.OwnerId),
+		Region:    aws.StringValue(in.Region),
+		VPCID:     aws.StringValue(in.VpcId),
+	}
+}
+
+// GenerateVPCPeeringConnectionObservation is used to produce
+// v1alpha1.VPCPeeringConnectionObservation from ec2.VpcPeeringConnection.
+func GenerateVPCPeeringConnectionObservation(pc ec2.VpcPeeringConnection) v1alpha1.VPCPeeringConnectionObservation {
+	o := v1alpha1.VPCPeeringConnectionObservation{
+		AccepterVPCInfo:  generateVPCInfo(pc.AccepterVpcInfo),
+		RequesterVPCInfo: generateVPCInfo(pc.RequesterVpcInfo),
+	}
+	if pc.Status != nil {
+		o.State = string(pc.Status.Code)
+		o.StateMessage = aws.StringValue(pc.Status.Message)
+	}
+	if pc.ExpirationTime != nil {
+		o.ExpirationTime = &metav1.Time{Time: *pc.ExpirationTime}
+	}
+	return o
+}
+
+// LateInitializeVPCPeeringConnection fills the empty fields in
+// *v1alpha1.VPCPeeringConnectionParameters with the values seen in
+// ec2.VpcPeeringConnection.
+func LateInitializeVPCPeeringConnection(in *v1alpha1.VPCPeeringConnectionParameters, pc *ec2.VpcPeeringConnection) {
+	if pc == nil {
+		return
+	}
+	if pc.RequesterVpcInfo != nil {
+		in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, pc.RequesterVpcInfo.VpcId)
+	}
+	if pc.AccepterVpcInfo != nil {
+		in.PeerVPCID = awsclients.LateInitializeStringPtr(in.PeerVPCID, pc.AccepterVpcInfo.VpcId)
+		in.PeerOwnerID = awsclients.LateInitializeStringPtr(in.PeerOwnerID, pc.AccepterVpcInfo.OwnerId)
+		in.PeerRegion = awsclients.LateInitializeStringPtr(in.PeerRegion, pc.AccepterVpcInfo.Region)
+	}
+}
+
+func isPeeringOptionsUpToDate(p *v1alpha1.VPCPeeringConnectionOptions, info *ec2.VpcPeeringConnectionVpcInfo) bool {
+	if p == nil || p.AllowDNSResolutionFromRemoteVPC == nil {
+		return true
+	}
+	if info == nil || info.PeeringOptions == nil {
+		return false
+	}
+	return *p.AllowDNSResolutionFromRemoteVPC == aws.BoolValue(info.PeeringOptions.AllowDnsResolutionFromRemoteVpc)
+}
+
+// IsRequesterPeeringOptionsUpToDate checks whether the options of the
+// requester VPC are as desired.
+func IsRequesterPeeringOptionsUpToDate(p v1alpha1.VPCPeeringConnectionParameters, pc ec2.VpcPeeringConnection) bool {
+	return isPeeringOptionsUpToDate(p.RequesterPeeringOptions, pc.RequesterVpcInfo)
+}
+
+// IsAccepterPeeringOptionsUpToDate checks whether the options of the
+// accepter VPC are as desired.
+func IsAccepterPeeringOptionsUpToDate(p v1alpha1.VPCPeeringConnectionParameters, pc ec2.VpcPeeringConnection) bool {
+	return isPeeringOptionsUpToDate(p.AccepterPeeringOptions, pc.AccepterVpcInfo)
+}
+
+// GeneratePeeringConnectionOptionsRequest returns the request options for
+// the given peering options.
+func GeneratePeeringConnectionOptionsRequest(o *v1alpha1.VPCPeeringConnectionOptions) *ec2.PeeringConnectionOptionsRequest {
+	if o == nil {
+		return nil
+	}
+	return &ec2.PeeringConnectionOptionsRequest{
+		AllowDnsResolutionFromRemoteVpc: o.AllowDNSResolutionFromRemoteVPC,
+	}
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VPNConnectionIDNotFound is the code that is returned by ec2 when the given VPNConnectionID is not valid
+	VPNConnectionIDNotFound = "InvalidVpnConnectionID.NotFound"
+
+	// ConnectionDetailsCustomerGatewayConfiguration is the key of the
+	// configuration of the customer gateway device in the connection secret.
+	ConnectionDetailsCustomerGatewayConfiguration = "customerGatewayConfiguration"
+
+	errNotSingleVPNConnection = "either no or multiple VPNConnections retrieved for the given vpnConnectionId"
+	errNoVPNConnection        = "no VPNConnection returned"
+	errUnexpectedOutput       = "unexpected output type of the VPNConnection request"
+)
+
+// VPNConnectionClient is the external client used for VPNConnection Custom Resource
+type VPNConnectionClient interface {
+	CreateVpnConnectionRequest(input *ec2.CreateVpnConnectionInput) ec2.CreateVpnConnectionRequest
+	DescribeVpnConnectionsRequest(input *ec2.DescribeVpnConnectionsInput) ec2.DescribeVpnConnectionsRequest
+	DeleteVpnConnectionRequest(input *ec2.DeleteVpnConnectionInput) ec2.DeleteVpnConnectionRequest
+	CreateVpnConnectionRouteRequest(input *ec2.CreateVpnConnectionRouteInput) ec2.CreateVpnConnectionRouteRequest
+	DeleteVpnConnectionRouteRequest(input *ec2.DeleteVpnConnectionRouteInput) ec2.DeleteVpnConnectionRouteRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewVPNConnectionClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPNConnectionClient(cfg aws.Config) VPNConnectionClient {
+	return ec2.New(cfg)
+}
+
+// IsVPNConnectionNotFoundErr returns true if the error is because the item doesn't exist
+func IsVPNConnectionNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPNConnectionIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// VPNConnection describes a Site-to-Site VPN connection. The ec2.VpnConnection
+// type of the SDK describes a Client VPN connection instead, so the responses
+// of the Site-to-Site VPN connection operations are decoded into this type.
+type VPNConnection struct {
+	_ struct{} `type:"structure"`
+
+	Category                     *string                   `locationName:"category" type:"string"`
+	CustomerGatewayConfiguration *string                   `locationName:"customerGatewayConfiguration" type:"string"`
+	CustomerGatewayID            *string                   `locationName:"customerGatewayId" type:"string"`
+	Options                      *ec2.VpnConnectionOptions `locationName:"options" type:"structure"`
+	Routes                       []ec2.VpnStaticRoute      `locationName:"routes" locationNameList:"item" type:"list"`
+	State                        ec2.VpnState              `locationName:"state" type:"string" enum:"true"`
+	Tags                         []ec2.Tag                 `locationName:"tagSet" locationNameList:"item" type:"list"`
+	TransitGatewayID             *string                   `locationName:"transitGatewayId" type:"string"`
+	Type                         ec2.GatewayType           `locationName:"type" type:"string" enum:"true"`
+	VgwTelemetry                 []ec2.VgwTelemetry        `locationName:"vgwTelemetry" locationNameList:"item" type:"list"`
+	VPNConnectionID              *string                   `locationName:"vpnConnectionId" type:"string"`
+	VPNGatewayID                 *string                   `locationName:"vpnGatewayId" type:"string"`
+}
+
+// DescribeVPNConnectionsOutput is the Site-to-Site shaped output of a
+// DescribeVpnConnections request.
+type DescribeVPNConnectionsOutput struct {
+	_ struct{} `type:"structure"`
+
+	VpnConnections []VPNConnection `locationName:"vpnConnectionSet" locationNameList:"item" type:"list"`
+}
+
+// CreateVPNConnectionOutput is the Site-to-Site shaped output of a
+// CreateVpnConnection request.
+type CreateVPNConnectionOutput struct {
+	_ struct{} `type:"structure"`
+
+	VpnConnection *VPNConnection `locationName:"vpnConnection" type:"structure"`
+}
+
+// sendVPNConnectionRequest sends the given request, decoding its response
+// into out unless the request already carries an output of that type.
+func sendVPNConnectionRequest(ctx context.Context, r *aws.Request, out interface{}) (interface{}, error) {
+	switch r.Data.(type) {
+	case *ec2.DescribeVpnConnectionsOutput, *ec2.CreateVpnConnectionOutput:
+		r.Data = out
+	}
+	r.SetContext(ctx)
+	if err := r.Send(); err != nil {
+		return nil, err
+	}
+	return r.Data, nil
+}
+
+// DescribeVPNConnection returns the Site-to-Site VPN connection with the
+// given ID.
+func DescribeVPNConnection(ctx context.Context, c VPNConnectionClient, id string) (*VPNConnection, error) {
+	req := c.DescribeVpnConnectionsRequest(&ec2.DescribeVpnConnectionsInput{
+		VpnConnectionIds: []string{id},
+	})
+	data, err := sendVPNConnectionRequest(ctx, req.Request, &DescribeVPNConnectionsOutput{})
+	if err != nil {
+		return nil, err
+	}
+	out, ok := data.(*DescribeVPNConnectionsOutput)
+	if !ok {
+		return nil, errors.New(errUnexpectedOutput)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(out.VpnConnections) != 1 {
+		return nil, errors.New(errNotSingleVPNConnection)
+	}
+	return &out.VpnConnections[0], nil
+}
+
+// CreateVPNConnection creates a Site-to-Site VPN connection and returns it.
+func CreateVPNConnection(ctx context.Context, c VPNConnectionClient, input *ec2.CreateVpnConnectionInput) (*VPNConnection, error) {
+	req := c.CreateVpnConnectionRequest(input)
+	data, err := sendVPNConnectionRequest(ctx, req.Request, &CreateVPNConnectionOutput{})
+	if err != nil {
+		return nil, err
+	}
+	out, ok := data.(*CreateVPNConnectionOutput)
+	if !ok {
+		return nil, errors.New(errUnexpectedOutput)
+	}
+	if out.VpnConnection == nil {
+		return nil, errors.New(errNoVPNConnection)
+	}
+	return out.VpnConnection, nil
+}
+
+// GenerateCreateVPNConnectionInput returns the input for a
+// CreateVpnConnection request built from the given parameters. The
+// pre-shared keys are matched to the tunnel options by index; an empty key
+// lets AWS generate one.
+func GenerateCreateVPNConnectionInput(p v1alpha1.VPNConnectionParameters, preSharedKeys []string) *ec2.CreateVpnConnectionInput {
+	in := &ec2.CreateVpnConnectionInput{
+		CustomerGatewayId: p.CustomerGatewayID,
+		VpnGatewayId:      p.VPNGatewayID,
+		TransitGatewayId:  p.TransitGatewayID,
+		Type:              aws.String(p.Type),
+	}
+	if p.StaticRoutesOnly == nil && p.EnableAcceleration == nil && len(p.TunnelOptions) == 0 {
+		return in
+	}
+	in.Options = &ec2.VpnConnectionOptionsSpecification{
+		StaticRoutesOnly:   p.StaticRoutesOnly,
+		EnableAcceleration: p.EnableAcceleration,
+	}
+	for i, t := range p.TunnelOptions {
+		o := ec2.VpnTunnelOptionsSpecification{
+			TunnelInsideCidr:       t.TunnelInsideCIDR,
+			DPDTimeoutSeconds:      t.DPDTimeoutSeconds,
+			Phase1LifetimeSeconds:  t.Phase1LifetimeSeconds,
+			Phase2LifetimeSeconds:  t.Phase2LifetimeSeconds,
+			RekeyFuzzPercentage:    t.RekeyFuzzPercentage,
+			RekeyMarginTimeSeconds: t.RekeyMarginTimeSeconds,
+			ReplayWindowSize:       t.ReplayWindowSize,
+		}
+		if i < len(preSharedKeys) && preSharedKeys[i] != "" {
+			o.PreSharedKey = aws.String(preSharedKeys[i])
+		}
+		for _, v := range t.IKEVersions {
+			o.IKEVersions = append(o.IKEVersions, ec2.IKEVersionsRequestListValue{Value: aws.String(v)})
+		}
+		for _, v := range t.Phase1DHGroupNumbers {
+			o.Phase1DHGroupNumbers = append(o.Phase1DHGroupNumbers, ec2.Phase1DHGroupNumbersRequestListValue{Value: aws.Int64(v)})
+		}
+		for _, v := range t.Phase1EncryptionAlgorithms {
+			o.Phase1EncryptionAlgorithms = append(o.Phase1EncryptionAlgorithms, ec2.Phase1EncryptionAlgorithmsRequestListValue{Value: aws.String(v)})
+		}
+		for _, v := range t.Phase1IntegrityAlgorithms {
+			o.Phase1IntegrityAlgorithms = append(o.Phase1IntegrityAlgorithms, ec2.Phase1IntegrityAlgorithmsRequestListValue{Value: aws.String(v)})
+		}
+		for _, v := range t.Phase2DHGroupNumbers {
+			o.Phase2DHGroupNumbers = append(o.Phase2DHGroupNumbers, ec2.Phase2DHGroupNumbersRequestListValue{Value: aws.Int64(v)})
+		}
+		for _, v := range t.Phase2EncryptionAlgorithms {
+			o.Phase2EncryptionAlgorithms = append(o.Phase2EncryptionAlgorithms, ec2.Phase2EncryptionAlgorithmsRequestListValue{Value: aws.String(v)})
+		}
+		for _, v := range t.Phase2IntegrityAlgorithms {
+			o.Phase2IntegrityAlgorithms = append(o.Phase2IntegrityAlgorithms, ec2.Phase2IntegrityAlgorithmsRequestListValue{Value: aws.String(v)})
+		}
+		in.Options.TunnelOptions = append(in.Options.TunnelOptions, o)
+	}
+	return in
+}
+
+// GenerateVPNConnectionObservation is used to produce
+// v1alpha1.VPNConnectionObservation from VPNConnection. The pre-shared keys
+// of the tunnels are never part of the observation.
+func GenerateVPNConnectionObservation(c VPNConnection) v1alpha1.VPNConnectionObservation {
+	o := v1alpha1.VPNConnectionObservation{
+		VPNConnectionID: aws.StringValue(c.VPNConnectionID),
+		Category:        aws.StringValue(c.Category),
+		State:           string(c.State),
+	}
+	for _, r := range c.Routes {
+		o.Routes = append(o.Routes, v1alpha1.VPNStaticRoute{
+			DestinationCIDRBlock: aws.StringValue(r.DestinationCidrBlock),
+			Source:               string(r.Source),
+			State:                string(r.State),
+		})
+	}
+	for _, t := range c.VgwTelemetry {
+		tt := v1alpha1.VPNTunnelTelemetry{
+			OutsideIPAddress:   aws.StringValue(t.OutsideIpAddress),
+			Status:             string(t.Status),
+			StatusMessage:      aws.StringValue(t.StatusMessage),
+			AcceptedRouteCount: aws.Int64Value(t.AcceptedRouteCount),
+		}
+		if t.LastStatusChange != nil {
+			tt.LastStatusChange = &metav1.Time{Time: *t.LastStatusChange}
+		}
+		o.Telemetry = append(o.Telemetry, tt)
+	}
+	return o
+}
+
+// GetVPNConnectionDetails returns the connection details of the given
+// VPNConnection, i.e. the configuration of the customer gateway device and
+// the outside address and pre-shared key of each tunnel.
+func GetVPNConnectionDetails(c VPNConnection) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if c.CustomerGatewayConfiguration != nil {
+		cd[ConnectionDetailsCustomerGatewayConfiguration] = []byte(aws.StringValue(c.CustomerGatewayConfiguration))
+	}
+	if c.Options == nil {
+		return cd
+	}
+	for i, t := range c.Options.TunnelOptions {
+		if t.OutsideIpAddress != nil {
+			cd[fmt.Sprintf("tunnel%dAddress", i+1)] = []byte(aws.StringValue(t.OutsideIpAddress))
+		}
+		if t.PreSharedKey != nil {
+			cd[fmt.Sprintf("tunnel%dPreSharedKey", i+1)] = []byte(aws.StringValue(t.PreSharedKey))
+		}
+	}
+	return cd
+}
+
+// LateInitializeVPNConnection fills the empty fields in
+// *v1alpha1.VPNConnectionParameters with the values seen in VPNConnection.
+func LateInitializeVPNConnection(in *v1alpha1.VPNConnectionParameters, c *VPNConnection) {
+	if c == nil {
+		return
+	}
+
+	in.CustomerGatewayID = awsclients.LateInitializeStringPtr(in.CustomerGatewayID, c.CustomerGatewayID)
+	in.VPNGatewayID = awsclients.LateInitializeStringPtr(in.VPNGatewayID, c.VPNGatewayID)
+	in.TransitGatewayID = awsclients.LateInitializeStringPtr(in.TransitGatewayID, c.TransitGatewayID)
+	if c.Options != nil {
+		in.StaticRoutesOnly = awsclients.LateInitializeBoolPtr(in.StaticRoutesOnly, c.Options.StaticRoutesOnly)
+		in.EnableAcceleration = awsclients.LateInitializeBoolPtr(in.EnableAcceleration, c.Options.EnableAcceleration)
+	}
+
+	if len(in.Tags) == 0 && len(c.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(c.Tags)
+	}
+}
+
+// DiffVPNConnectionStaticRoutes returns the destination CIDR blocks of the
+// static routes that need to be created and deleted so that the observed
+// routes match the desired ones. Routes that are being deleted are ignored.
+func DiffVPNConnectionStaticRoutes(desired []string, observed []ec2.VpnStaticRoute) (add, remove []string) {
+	d := make(map[string]struct{}, len(desired))
+	for _, r := range desired {
+		d[r] = struct{}{}
+	}
+	o := make(map[string]struct{}, len(observed))
+	for _, r := range observed {
+		if r.State == ec2.VpnStateDeleting || r.State == ec2.VpnStateDeleted {
+			continue
+		}
+		cidr := aws.StringValue(r.DestinationCidrBlock)
+		o[cidr] = struct{}{}
+		if _, ok := d[cidr]; !ok {
+			remove = append(remove, cidr)
+		}
+	}
+	for _, r := range desired {
+		if _, ok := o[r]; !ok {
+			add = append(add, r)
+		}
+	}
+	return add, remove
+}
+
+// IsVPNConnectionUpToDate checks whether the static routes and the tags of
+// the given VPNConnection match the desired parameters.
+func IsVPNConnectionUpToDate(p v1alpha1.VPNConnectionParameters, c VPNConnection) bool {
+	if add, remove := DiffVPNConnectionStaticRoutes(p.StaticRoutes, c.Routes); len(add) != 0 || len(remove) != 0 {
+		return false
+	}
+	return v1beta1.CompareTags(p.Tags, c.Tags)
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/defaults"
+	"github.com/aws/aws-sdk-go-v2/private/protocol/ec2query"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+const describeVPNConnectionsResponse = `<DescribeVpnConnectionsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
+  <vpnConnectionSet>
+    <item>
+      <vpnConnectionId>vpn-44a8938f</vpnConnectionId>
+      <state>available</state>
+      <customerGatewayConfiguration>config</customerGatewayConfiguration>
+      <type>ipsec.1</type>
+      <customerGatewayId>cgw-b4dc3961</customerGatewayId>
+      <vpnGatewayId>vgw-8db04f81</vpnGatewayId>
+      <category>VPN</category>
+      <options>
+        <staticRoutesOnly>true</staticRoutesOnly>
+        <tunnelOptionSet>
+          <item>
+            <outsideIpAddress>203.0.113.1</outsideIpAddress>
+            <preSharedKey>key_1</preSharedKey>
+          </item>
+          <item>
+            <outsideIpAddress>203.0.113.2</outsideIpAddress>
+            <preSharedKey>key_2</preSharedKey>
+          </item>
+        </tunnelOptionSet>
+      </options>
+      <routes>
+        <item>
+          <destinationCidrBlock>192.168.0.0/24</destinationCidrBlock>
+          <source>Static</source>
+          <state>available</state>
+        </item>
+      </routes>
+      <tagSet>
+        <item>
+          <key>k</key>
+          <value>v</value>
+        </item>
+      </tagSet>
+    </item>
+  </vpnConnectionSet>
+</DescribeVpnConnectionsResponse>`
+
+func siteToSiteVPNConnection() VPNConnection {
+	return VPNConnection{
+		VPNConnectionID:              aws.String("vpn-44a8938f"),
+		State:                        ec2.VpnStateAvailable,
+		CustomerGatewayConfiguration: aws.String("config"),
+		Type:                         ec2.GatewayTypeIpsec1,
+		CustomerGatewayID:            aws.String("cgw-b4dc3961"),
+		VPNGatewayID:                 aws.String("vgw-8db04f81"),
+		Category:                     aws.String("VPN"),
+		Options: &ec2.VpnConnectionOptions{
+			StaticRoutesOnly: aws.Bool(true),
+			TunnelOptions: []ec2.TunnelOption{
+				{OutsideIpAddress: aws.String("203.0.113.1"), PreSharedKey: aws.String("key_1")},
+				{OutsideIpAddress: aws.String("203.0.113.2"), PreSharedKey: aws.String("key_2")},
+			},
+		},
+		Routes: []ec2.VpnStaticRoute{
+			{DestinationCidrBlock: aws.String("192.168.0.0/24"), Source: ec2.VpnStaticRouteSourceStatic, State: ec2.VpnStateAvailable},
+		},
+		Tags: []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}
+}
+
+func TestDescribeVPNConnection(t *testing.T) {
+	cfg := defaults.Config()
+	cfg.Region = "us-east-1"
+	c := ec2.New(cfg)
+	c.Handlers.Clear()
+	c.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	c.Handlers.Send.PushBack(func(r *aws.Request) {
+		r.HTTPResponse = &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(describeVPNConnectionsResponse)),
+		}
+	})
+	c.Retryer = aws.NoOpRetryer{}
+
+	got, err := DescribeVPNConnection(context.Background(), c, "vpn-44a8938f")
+	if err != nil {
+		t.Fatalf("DescribeVPNConnection(...): %s", err)
+	}
+	if diff := cmp.Diff(siteToSiteVPNConnection(), *got, cmpopts.IgnoreUnexported(VPNConnection{}, ec2.VpnConnectionOptions{}, ec2.TunnelOption{}, ec2.VpnStaticRoute{}, ec2.Tag{})); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetVPNConnectionDetails(t *testing.T) {
+	want := managed.ConnectionDetails{
+		ConnectionDetailsCustomerGatewayConfiguration: []byte("config"),
+		"tunnel1Address":      []byte("203.0.113.1"),
+		"tunnel1PreSharedKey": []byte("key_1"),
+		"tunnel2Address":      []byte("203.0.113.2"),
+		"tunnel2PreSharedKey": []byte("key_2"),
+	}
+	if diff := cmp.Diff(want, GetVPNConnectionDetails(siteToSiteVPNConnection())); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateCreateVPNConnectionInput(t *testing.T) {
+	p := v1alpha1.VPNConnectionParameters{
+		Type:              "ipsec.1",
+		CustomerGatewayID: aws.String("cgw"),
+		VPNGatewayID:      aws.String("vgw"),
+		StaticRoutesOnly:  aws.Bool(true),
+		TunnelOptions: []v1alpha1.VPNTunnelOptions{
+			{TunnelInsideCIDR: aws.String("169.254.10.0/30"), IKEVersions: []string{"ikev2"}},
+			{TunnelInsideCIDR: aws.String("169.254.11.0/30")},
+		},
+	}
+	want := &ec2.CreateVpnConnectionInput{
+		Type:              aws.String("ipsec.1"),
+		CustomerGatewayId: aws.String("cgw"),
+		VpnGatewayId:      aws.String("vgw"),
+		Options: &ec2.VpnConnectionOptionsSpecification{
+			StaticRoutesOnly: aws.Bool(true),
+			TunnelOptions: []ec2.VpnTunnelOptionsSpecification{
+				{
+					TunnelInsideCidr: aws.String("169.254.10.0/30"),
+					PreSharedKey:     aws.String("key_1"),
+					IKEVersions:      []ec2.IKEVersionsRequestListValue{{Value: aws.String("ikev2")}},
+				},
+				{TunnelInsideCidr: aws.String("169.254.11.0/30")},
+			},
+		},
+	}
+	got := GenerateCreateVPNConnectionInput(p, []string{"key_1", ""})
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(ec2.CreateVpnConnectionInput{}, ec2.VpnConnectionOptionsSpecification{}, ec2.VpnTunnelOptionsSpecification{}, ec2.IKEVersionsRequestListValue{})); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsVPNConnectionUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.VPNConnectionParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.VPNConnectionParameters{
+				StaticRoutes: []string{"192.168.0.0/24"},
+				Tags:         []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: true,
+		},
+		"RoutesDiffer": {
+			p: v1alpha1.VPNConnectionParameters{
+				StaticRoutes: []string{"192.168.1.0/24"},
+				Tags:         []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: false,
+		},
+		"TagsDiffer": {
+			p: v1alpha1.VPNConnectionParameters{
+				StaticRoutes: []string{"192.168.0.0/24"},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVPNConnectionUpToDate(tc.p, siteToSiteVPNConnection())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
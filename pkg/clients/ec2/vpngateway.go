@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VPNGatewayIDNotFound is the code that is returned by ec2 when the given VPNGatewayID is not valid
+	VPNGatewayIDNotFound = "InvalidVpnGatewayID.NotFound"
+)
+
+// VPNGatewayClient is the external client used for VPNGateway Custom Resource
+type VPNGatewayClient interface {
+	CreateVpnGatewayRequest(input *ec2.CreateVpnGatewayInput) ec2.CreateVpnGatewayRequest
+	DescribeVpnGatewaysRequest(input *ec2.DescribeVpnGatewaysInput) ec2.DescribeVpnGatewaysRequest
+	DeleteVpnGatewayRequest(input *ec2.DeleteVpnGatewayInput) ec2.DeleteVpnGatewayRequest
+	AttachVpnGatewayRequest(input *ec2.AttachVpnGatewayInput) ec2.AttachVpnGatewayRequest
+	DetachVpnGatewayRequest(input *ec2.DetachVpnGatewayInput) ec2.DetachVpnGatewayRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewVPNGatewayClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPNGatewayClient(cfg aws.Config) VPNGatewayClient {
+	return ec2.New(cfg)
+}
+
+// IsVPNGatewayNotFoundErr returns true if the error is because the item doesn't exist
+func IsVPNGatewayNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPNGatewayIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateVPNGatewayInput returns the input for a CreateVpnGateway
+// request built from the given parameters.
+func GenerateCreateVPNGatewayInput(p v1alpha1.VPNGatewayParameters) *ec2.CreateVpnGatewayInput {
+	return &ec2.CreateVpnGatewayInput{
+		AmazonSideAsn:    p.AmazonSideASN,
+		AvailabilityZone: p.AvailabilityZone,
+		Type:             ec2.GatewayType(p.Type),
+	}
+}
+
+// AttachedVPCID returns the ID of the VPC the given ec2.VpnGateway is
+// attached to or being attached to, or an empty string if there is none.
+func AttachedVPCID(gw ec2.VpnGateway) string {
+	for _, a := range gw.VpcAttachments {
+		if a.State == ec2.AttachmentStatusAttaching || a.State == ec2.AttachmentStatusAttached {
+			return aws.StringValue(a.VpcId)
+		}
+	}
+	return ""
+}
+
+// GenerateVPNGatewayObservation is used to produce
+// v1alpha1.VPNGatewayObservation from ec2.VpnGateway.
+func GenerateVPNGatewayObservation(gw ec2.VpnGateway) v1alpha1.VPNGatewayObservation {
+	o := v1alpha1.VPNGatewayObservation{
+		VPNGatewayID: aws.StringValue(gw.VpnGatewayId),
+		State:        string(gw.State),
+	}
+	for _, a := range gw.VpcAttachments {
+		o.VPCAttachments = append(o.VPCAttachments, v1alpha1.VPNGatewayAttachment{
+			VPCID: aws.StringValue(a.VpcId),
+			State: string(a.State),
+		})
+	}
+	return o
+}
+
+// LateInitializeVPNGateway fills the empty fields in
+// *v1alpha1.VPNGatewayParameters with the values seen in ec2.VpnGateway.
+// The VPC attachment is deliberately not late-initialized so that removing
+// vpcId from the spec detaches the gateway.
+func LateInitializeVPNGateway(in *v1alpha1.VPNGatewayParameters, gw *ec2.VpnGateway) {
+	if gw == nil {
+		return
+	}
+
+	in.AmazonSideASN = awsclients.LateInitializeInt64Ptr(in.AmazonSideASN, gw.AmazonSideAsn)
+	in.AvailabilityZone = awsclients.LateInitializeStringPtr(in.AvailabilityZone, gw.AvailabilityZone)
+
+	if len(in.Tags) == 0 && len(gw.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(gw.Tags)
+	}
+}
+
+// IsVPNGatewayUpToDate checks whether the VPC attachment and the tags of the
+// given ec2.VpnGateway match the desired parameters.
+func IsVPNGatewayUpToDate(p v1alpha1.VPNGatewayParameters, gw ec2.VpnGateway) bool {
+	return aws.StringValue(p.VPCID) == AttachedVPCID(gw) && v1beta1.CompareTags(p.Tags, gw.Tags)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+func TestIsVPNGatewayUpToDate(t *testing.T) {
+	attached := ec2.VpnGateway{
+		VpcAttachments: []ec2.VpcAttachment{
+			{VpcId: aws.String("vpc-old"), State: ec2.AttachmentStatusDetached},
+			{VpcId: aws.String("vpc"), State: ec2.AttachmentStatusAttached},
+		},
+		Tags: []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}
+	cases := map[string]struct {
+		p    v1alpha1.VPNGatewayParameters
+		gw   ec2.VpnGateway
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.VPNGatewayParameters{
+				VPCID: aws.String("vpc"),
+				Tags:  []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+			gw:   attached,
+			want: true,
+		},
+		"NeedsAttach": {
+			p: v1alpha1.VPNGatewayParameters{
+				VPCID: aws.String("vpc"),
+			},
+			gw:   ec2.VpnGateway{},
+			want: false,
+		},
+		"NeedsDetach": {
+			p: v1alpha1.VPNGatewayParameters{
+				Tags: []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+			gw:   attached,
+			want: false,
+		},
+		"TagsDiffer": {
+			p: v1alpha1.VPNGatewayParameters{
+				VPCID: aws.String("vpc"),
+			},
+			gw:   attached,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVPNGatewayUpToDate(tc.p, tc.gw)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecr
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ImageNotFoundException image was not found in the repository
+	ImageNotFoundException = "ImageNotFoundException"
+
+	errNotECRImage    = "image URI does not point to an ECR repository"
+	errDescribeImages = "cannot describe the image in the ECR repository"
+)
+
+// ImageClient is the external client used to check the images stored in ECR
+// repositories.
+type ImageClient interface {
+	DescribeImagesRequest(input *ecr.DescribeImagesInput) ecr.DescribeImagesRequest
+}
+
+// NewImageClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewImageClient(cfg aws.Config) ImageClient {
+	return ecr.New(cfg)
+}
+
+// ImageURI is the parsed form of an image URI that points to an ECR
+// repository, e.g.
+// 123456789012.dkr.ecr.us-east-1.amazonaws.com/repository:tag
+type ImageURI struct {
+	RegistryID     string
+	Region         string
+	RepositoryName string
+	Tag            string
+	Digest         string
+}
+
+// ParseImageURI parses the given image URI. An error is returned if it does
+// not point to an ECR repository.
+func ParseImageURI(uri string) (ImageURI, error) {
+	parts := strings.SplitN(uri, "/", 2)
+	if len(parts) != 2 {
+		return ImageURI{}, errors.New(errNotECRImage)
+	}
+	host := strings.Split(parts[0], ".")
+	if len(host) < 6 || host[1] != "dkr" || host[2] != "ecr" {
+		return ImageURI{}, errors.New(errNotECRImage)
+	}
+	img := ImageURI{RegistryID: host[0], Region: host[3]}
+
+	name := parts[1]
+	if i := strings.Index(name, "@"); i != -1 {
+		img.Digest = name[i+1:]
+		name = name[:i]
+	} else if i := strings.LastIndex(name, ":"); i != -1 {
+		img.Tag = name[i+1:]
+		name = name[:i]
+	}
+	if name == "" {
+		return ImageURI{}, errors.New(errNotECRImage)
+	}
+	img.RepositoryName = name
+	if img.Tag == "" && img.Digest == "" {
+		img.Tag = "latest"
+	}
+	return img, nil
+}
+
+// IsImageNotFoundErr returns true if the error is because the image doesn't
+// exist in the repository.
+func IsImageNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == ImageNotFoundException || awsErr.Code() == RepositoryNotFoundException
+	}
+	return false
+}
+
+// ImageExists returns whether the given image exists in its ECR repository.
+// The repository is looked up in the region of the image URI, which may be
+// different from the region of the kind that deploys the image, so the client
+// is created by newClient from a copy of cfg with that region set.
+func ImageExists(ctx context.Context, cfg aws.Config, newClient func(aws.Config) ImageClient, img ImageURI) (bool, error) {
+	regional := cfg.Copy()
+	regional.Region = img.Region
+	id := ecr.ImageIdentifier{}
+	if img.Digest != "" {
+		id.ImageDigest = aws.String(img.Digest)
+	} else {
+		id.ImageTag = aws.String(img.Tag)
+	}
+	res, err := newClient(regional).DescribeImagesRequest(&ecr.DescribeImagesInput{
+		RegistryId:     aws.String(img.RegistryID),
+		RepositoryName: aws.String(img.RepositoryName),
+		ImageIds:       []ecr.ImageIdentifier{id},
+	}).Send(ctx)
+	if IsImageNotFoundErr(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errDescribeImages)
+	}
+	return len(res.ImageDetails) != 0, nil
+}
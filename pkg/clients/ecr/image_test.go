@@ -0,0 +1,74 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecr
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestParseImageURI(t *testing.T) {
+	type want struct {
+		img ImageURI
+		err error
+	}
+	cases := map[string]struct {
+		uri string
+		want
+	}{
+		"Tag": {
+			uri: "123456789012.dkr.ecr.us-east-1.amazonaws.com/team/app:v1",
+			want: want{
+				img: ImageURI{RegistryID: "123456789012", Region: "us-east-1", RepositoryName: "team/app", Tag: "v1"},
+			},
+		},
+		"Digest": {
+			uri: "123456789012.dkr.ecr.eu-west-1.amazonaws.com/app@sha256:abc",
+			want: want{
+				img: ImageURI{RegistryID: "123456789012", Region: "eu-west-1", RepositoryName: "app", Digest: "sha256:abc"},
+			},
+		},
+		"DefaultTag": {
+			uri: "123456789012.dkr.ecr.us-east-1.amazonaws.com/app",
+			want: want{
+				img: ImageURI{RegistryID: "123456789012", Region: "us-east-1", RepositoryName: "app", Tag: "latest"},
+			},
+		},
+		"NotECR": {
+			uri: "docker.io/library/nginx:latest",
+			want: want{
+				err: errors.New(errNotECRImage),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			img, err := ParseImageURI(tc.uri)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.img, img); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
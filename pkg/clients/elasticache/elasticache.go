@@ -39,6 +39,10 @@ import (
 
 const errCheckUpToDate = "unable to determine if external resource is up to date"
 
+// ParameterSourceUser is the source of the cache parameters that are modified
+// from their default value.
+const ParameterSourceUser = "user"
+
 // A Client handles CRUD operations for ElastiCache resources. This interface is
 // compatible with the upstream AWS redis client.
 type Client elasticacheiface.ClientAPI
@@ -211,6 +215,25 @@ func ReplicationGroupNeedsUpdate(kube v1beta1.ReplicationGroupParameters, rg ela
 	return false
 }
 
+// ReplicationGroupNeedsDisruptiveUpdate returns true if updating the supplied
+// ReplicationGroup to the given desired state changes its node type, or the
+// engine version or parameter group of its member clusters, which restarts or
+// replaces its nodes.
+func ReplicationGroupNeedsDisruptiveUpdate(kube v1beta1.ReplicationGroupParameters, rg elasticache.ReplicationGroup, ccList []elasticache.CacheCluster) bool {
+	if !reflect.DeepEqual(&kube.CacheNodeType, rg.CacheNodeType) {
+		return true
+	}
+	for _, cc := range ccList {
+		if !reflect.DeepEqual(kube.EngineVersion, cc.EngineVersion) {
+			return true
+		}
+		if pg, name := cc.CacheParameterGroup, kube.CacheParameterGroupName; pg != nil && !reflect.DeepEqual(name, pg.CacheParameterGroupName) {
+			return true
+		}
+	}
+	return false
+}
+
 func automaticFailoverEnabled(af elasticache.AutomaticFailoverStatus) *bool {
 	if af == "" {
 		return nil
@@ -346,18 +369,13 @@ func newEndpoint(e *elasticache.Endpoint) v1beta1.Endpoint {
 	return v1beta1.Endpoint{Address: clients.StringValue(e.Address), Port: int(aws.Int64Value(e.Port))}
 }
 
-// ConnectionEndpoint returns the connection endpoint for a Replication Group.
-// https://docs.aws.amazon.com/AmazonElastiCache/latest/red-ug/Endpoints.html
-func ConnectionEndpoint(rg elasticache.ReplicationGroup) managed.ConnectionDetails {
+func connectionEndpoint(rg elasticache.ReplicationGroup) *elasticache.Endpoint {
 	// "Cluster enabled" Replication Groups have multiple node groups, and an
 	// explicit configuration endpoint that should be used for read and write.
 	if aws.BoolValue(rg.ClusterEnabled) &&
 		rg.ConfigurationEndpoint != nil &&
 		rg.ConfigurationEndpoint.Address != nil {
-		return managed.ConnectionDetails{
-			runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(aws.StringValue(rg.ConfigurationEndpoint.Address)),
-			runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(int(aws.Int64Value(rg.ConfigurationEndpoint.Port)))),
-		}
+		return rg.ConfigurationEndpoint
 	}
 
 	// "Cluster disabled" Replication Groups have a single node group, with a
@@ -367,16 +385,48 @@ func ConnectionEndpoint(rg elasticache.ReplicationGroup) managed.ConnectionDetai
 	if len(rg.NodeGroups) > 0 &&
 		rg.NodeGroups[0].PrimaryEndpoint != nil &&
 		rg.NodeGroups[0].PrimaryEndpoint.Address != nil {
-		return managed.ConnectionDetails{
-			runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(aws.StringValue(rg.NodeGroups[0].PrimaryEndpoint.Address)),
-			runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(int(aws.Int64Value(rg.NodeGroups[0].PrimaryEndpoint.Port)))),
-		}
+		return rg.NodeGroups[0].PrimaryEndpoint
 	}
 
 	// If the AWS API docs are to be believed we should never get here.
 	return nil
 }
 
+// Connection secret keys that describe how to connect to a Replication Group
+// with in-transit encryption enabled.
+const (
+	// ConnectionSecretTLSKey is set to "true" when clients must connect
+	// using TLS. ElastiCache serves TLS on the Replication Group's regular
+	// port, so no separate port is published.
+	ConnectionSecretTLSKey = "tls"
+
+	// ConnectionSecretServerNameKey is the hostname clients should use for
+	// SNI and certificate verification. ElastiCache certificates are issued
+	// by Amazon Trust Services, whose roots ship in the default trust store
+	// of common operating systems and language runtimes.
+	ConnectionSecretServerNameKey = "serverName"
+)
+
+// ConnectionEndpoint returns the connection endpoint for a Replication Group.
+// https://docs.aws.amazon.com/AmazonElastiCache/latest/red-ug/Endpoints.html
+func ConnectionEndpoint(rg elasticache.ReplicationGroup) managed.ConnectionDetails {
+	e := connectionEndpoint(rg)
+	if e == nil {
+		return nil
+	}
+
+	cd := managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(aws.StringValue(e.Address)),
+		runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(int(aws.Int64Value(e.Port)))),
+	}
+	// https://docs.aws.amazon.com/AmazonElastiCache/latest/red-ug/in-transit-encryption.html
+	if aws.BoolValue(rg.TransitEncryptionEnabled) {
+		cd[ConnectionSecretTLSKey] = []byte("true")
+		cd[ConnectionSecretServerNameKey] = []byte(aws.StringValue(e.Address))
+	}
+	return cd
+}
+
 // IsNotFound returns true if the supplied error indicates a Replication Group
 // was not found.
 func IsNotFound(err error) bool {
@@ -429,6 +479,60 @@ func IsSubnetGroupUpToDate(p cachev1alpha1.CacheSubnetGroupParameters, sg elasti
 	return true
 }
 
+// IsParameterGroupNotFound returns true if the supplied error indicates a
+// Cache Parameter Group was not found.
+func IsParameterGroupNotFound(err error) bool {
+	return isErrorCodeEqual(elasticache.ErrCodeCacheParameterGroupNotFoundFault, err)
+}
+
+// GenerateCreateCacheParameterGroupInput returns Cache Parameter Group
+// creation input. The engine parameters can't be set on creation and are
+// applied by a subsequent update.
+func GenerateCreateCacheParameterGroupInput(name string, p cachev1alpha1.CacheParameterGroupParameters) *elasticache.CreateCacheParameterGroupInput {
+	return &elasticache.CreateCacheParameterGroupInput{
+		CacheParameterGroupName:   aws.String(name),
+		CacheParameterGroupFamily: aws.String(p.CacheParameterGroupFamily),
+		Description:               aws.String(p.Description),
+	}
+}
+
+// GenerateParameterGroupObservation produces a CacheParameterGroupObservation
+// from elasticache.CacheParameterGroup.
+func GenerateParameterGroupObservation(pg elasticache.CacheParameterGroup) cachev1alpha1.CacheParameterGroupObservation {
+	return cachev1alpha1.CacheParameterGroupObservation{
+		ARN:      aws.StringValue(pg.ARN),
+		IsGlobal: aws.BoolValue(pg.IsGlobal),
+	}
+}
+
+// DiffParameters returns the parameters that need to be modified and the
+// ones that need to be reset to their default value so that the observed
+// user parameters of a Cache Parameter Group match the desired ones.
+func DiffParameters(desired []cachev1alpha1.ParameterNameValue, observed []elasticache.Parameter) (modify, reset []elasticache.ParameterNameValue) {
+	o := make(map[string]string, len(observed))
+	for _, p := range observed {
+		o[aws.StringValue(p.ParameterName)] = aws.StringValue(p.ParameterValue)
+	}
+	d := make(map[string]bool, len(desired))
+	for _, p := range desired {
+		d[p.ParameterName] = true
+		if v, ok := o[p.ParameterName]; ok && v == p.ParameterValue {
+			continue
+		}
+		modify = append(modify, elasticache.ParameterNameValue{
+			ParameterName:  aws.String(p.ParameterName),
+			ParameterValue: aws.String(p.ParameterValue),
+		})
+	}
+	for _, p := range observed {
+		if d[aws.StringValue(p.ParameterName)] {
+			continue
+		}
+		reset = append(reset, elasticache.ParameterNameValue{ParameterName: p.ParameterName})
+	}
+	return modify, reset
+}
+
 // GenerateCreateCacheClusterInput returns Cache Cluster creation input
 func GenerateCreateCacheClusterInput(p cachev1alpha1.CacheClusterParameters, id string) *elasticache.CreateCacheClusterInput {
 	c := &elasticache.CreateCacheClusterInput{
@@ -580,6 +684,18 @@ func GenerateCluster(name string, p cachev1alpha1.CacheClusterParameters, c *ela
 	}
 }
 
+// IsClusterUpdateDisruptive returns true if updating the observed cluster to
+// the given set of parameters changes its node type, engine version or
+// parameter group, or removes nodes from it.
+func IsClusterUpdateDisruptive(in *cachev1alpha1.CacheClusterParameters, observed *elasticache.CacheCluster) bool {
+	if pg := observed.CacheParameterGroup; pg != nil && !reflect.DeepEqual(in.CacheParameterGroupName, pg.CacheParameterGroupName) {
+		return true
+	}
+	return in.CacheNodeType != aws.StringValue(observed.CacheNodeType) ||
+		!reflect.DeepEqual(in.EngineVersion, observed.EngineVersion) ||
+		in.NumCacheNodes < aws.Int64Value(observed.NumCacheNodes)
+}
+
 // IsClusterUpToDate checks whether current state is up-to-date compared to the given
 // set of parameters.
 func IsClusterUpToDate(name string, in *cachev1alpha1.CacheClusterParameters, observed *elasticache.CacheCluster) (bool, error) {
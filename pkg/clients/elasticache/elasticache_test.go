@@ -684,6 +684,58 @@ func TestReplicationGroupNeedsUpdate(t *testing.T) {
 	}
 }
 
+func TestReplicationGroupNeedsDisruptiveUpdate(t *testing.T) {
+	cases := []struct {
+		name   string
+		kube   v1beta1.ReplicationGroupParameters
+		rg     elasticache.ReplicationGroup
+		ccList []elasticache.CacheCluster
+		want   bool
+	}{
+		{
+			name: "NeedsNewCacheNodeType",
+			kube: replicationGroup.Spec.ForProvider,
+			rg:   elasticache.ReplicationGroup{CacheNodeType: aws.String("n1.insufficiently.cool")},
+			want: true,
+		},
+		{
+			name: "NeedsNewEngineVersion",
+			kube: replicationGroup.Spec.ForProvider,
+			rg:   elasticache.ReplicationGroup{CacheNodeType: aws.String(cacheNodeType)},
+			ccList: []elasticache.CacheCluster{
+				{
+					EngineVersion: aws.String("4.0.0"),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "NeedsNewSnapshotWindow",
+			kube: replicationGroup.Spec.ForProvider,
+			rg: elasticache.ReplicationGroup{
+				CacheNodeType:  aws.String(cacheNodeType),
+				SnapshotWindow: aws.String("yesterday"),
+			},
+			ccList: []elasticache.CacheCluster{
+				{
+					EngineVersion:       aws.String(engineVersion),
+					CacheParameterGroup: &elasticache.CacheParameterGroupStatus{CacheParameterGroupName: aws.String(cacheParameterGroupName)},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ReplicationGroupNeedsDisruptiveUpdate(tc.kube, tc.rg, tc.ccList)
+			if got != tc.want {
+				t.Errorf("ReplicationGroupNeedsDisruptiveUpdate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
 func TestCacheClusterNeedsUpdate(t *testing.T) {
 	cases := []struct {
 		name string
@@ -852,6 +904,23 @@ func TestConnectionEndpoint(t *testing.T) {
 				v1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
 			},
 		},
+		{
+			name: "ClusterModeEnabledTransitEncryption",
+			rg: elasticache.ReplicationGroup{
+				ClusterEnabled:           aws.Bool(true),
+				TransitEncryptionEnabled: aws.Bool(true),
+				ConfigurationEndpoint: &elasticache.Endpoint{
+					Address: aws.String(host),
+					Port:    aws.Int64(port),
+				},
+			},
+			want: managed.ConnectionDetails{
+				v1alpha1.ResourceCredentialsSecretEndpointKey: []byte(host),
+				v1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
+				ConnectionSecretTLSKey:                        []byte("true"),
+				ConnectionSecretServerNameKey:                 []byte(host),
+			},
+		},
 		{
 			name: "ClusterModeEnabledMissingConfigurationEndpoint",
 			rg: elasticache.ReplicationGroup{
@@ -874,6 +943,24 @@ func TestConnectionEndpoint(t *testing.T) {
 				v1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
 			},
 		},
+		{
+			name: "ClusterModeDisabledTransitEncryption",
+			rg: elasticache.ReplicationGroup{
+				TransitEncryptionEnabled: aws.Bool(true),
+				NodeGroups: []elasticache.NodeGroup{{
+					PrimaryEndpoint: &elasticache.Endpoint{
+						Address: aws.String(host),
+						Port:    aws.Int64(port),
+					}},
+				},
+			},
+			want: managed.ConnectionDetails{
+				v1alpha1.ResourceCredentialsSecretEndpointKey: []byte(host),
+				v1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
+				ConnectionSecretTLSKey:                        []byte("true"),
+				ConnectionSecretServerNameKey:                 []byte(host),
+			},
+		},
 		{
 			name: "ClusterModeDisabledMissingPrimaryEndpoint",
 			rg:   elasticache.ReplicationGroup{NodeGroups: []elasticache.NodeGroup{{}}},
@@ -956,3 +1043,58 @@ func TestIsSubnetGroupUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffParameters(t *testing.T) {
+	type args struct {
+		desired  []cachev1alpha1.ParameterNameValue
+		observed []elasticache.Parameter
+	}
+	type want struct {
+		modify []elasticache.ParameterNameValue
+		reset  []elasticache.ParameterNameValue
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"InSync": {
+			args: args{
+				desired:  []cachev1alpha1.ParameterNameValue{{ParameterName: "timeout", ParameterValue: "300"}},
+				observed: []elasticache.Parameter{{ParameterName: aws.String("timeout"), ParameterValue: aws.String("300")}},
+			},
+			want: want{},
+		},
+		"ValueChanged": {
+			args: args{
+				desired:  []cachev1alpha1.ParameterNameValue{{ParameterName: "timeout", ParameterValue: "600"}},
+				observed: []elasticache.Parameter{{ParameterName: aws.String("timeout"), ParameterValue: aws.String("300")}},
+			},
+			want: want{
+				modify: []elasticache.ParameterNameValue{{ParameterName: aws.String("timeout"), ParameterValue: aws.String("600")}},
+			},
+		},
+		"AddedAndRemoved": {
+			args: args{
+				desired:  []cachev1alpha1.ParameterNameValue{{ParameterName: "maxmemory-policy", ParameterValue: "allkeys-lru"}},
+				observed: []elasticache.Parameter{{ParameterName: aws.String("timeout"), ParameterValue: aws.String("300")}},
+			},
+			want: want{
+				modify: []elasticache.ParameterNameValue{{ParameterName: aws.String("maxmemory-policy"), ParameterValue: aws.String("allkeys-lru")}},
+				reset:  []elasticache.ParameterNameValue{{ParameterName: aws.String("timeout")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			modify, reset := DiffParameters(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.modify, modify); diff != "" {
+				t.Errorf("modify: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reset, reset); diff != "" {
+				t.Errorf("reset: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -225,6 +225,63 @@ func TestIsClusterUpToDate(t *testing.T) {
 	}
 }
 
+func TestIsClusterUpdateDisruptive(t *testing.T) {
+	type args struct {
+		c awscache.CacheCluster
+		p v1alpha1.CacheClusterParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameFields": {
+			args: args{
+				c: *cluster(),
+				p: *clusterParams(),
+			},
+			want: false,
+		},
+		"NotDisruptive": {
+			args: args{
+				c: *cluster(),
+				p: *clusterParams(func(c *v1alpha1.CacheClusterParameters) {
+					c.SnapshotRetentionLimit = aws.Int64(7)
+					c.NumCacheNodes = 3
+				}),
+			},
+			want: false,
+		},
+		"NodeTypeChanged": {
+			args: args{
+				c: *cluster(),
+				p: *clusterParams(func(c *v1alpha1.CacheClusterParameters) {
+					c.CacheNodeType = "t2.large"
+				}),
+			},
+			want: true,
+		},
+		"NodesRemoved": {
+			args: args{
+				c: *cluster(),
+				p: *clusterParams(func(c *v1alpha1.CacheClusterParameters) {
+					c.NumCacheNodes = 1
+				}),
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsClusterUpdateDisruptive(&tc.args.p, &tc.args.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateClusterObservation(t *testing.T) {
 	cases := map[string]struct {
 		in  awscache.CacheCluster
@@ -37,6 +37,13 @@ type MockClient struct {
 	MockModifyCacheSubnetGroupRequest    func(*elasticache.ModifyCacheSubnetGroupInput) elasticache.ModifyCacheSubnetGroupRequest
 	MockDeleteCacheSubnetGroupRequest    func(*elasticache.DeleteCacheSubnetGroupInput) elasticache.DeleteCacheSubnetGroupRequest
 
+	MockDescribeCacheParameterGroupsRequest func(*elasticache.DescribeCacheParameterGroupsInput) elasticache.DescribeCacheParameterGroupsRequest
+	MockCreateCacheParameterGroupRequest    func(*elasticache.CreateCacheParameterGroupInput) elasticache.CreateCacheParameterGroupRequest
+	MockDeleteCacheParameterGroupRequest    func(*elasticache.DeleteCacheParameterGroupInput) elasticache.DeleteCacheParameterGroupRequest
+	MockDescribeCacheParametersRequest      func(*elasticache.DescribeCacheParametersInput) elasticache.DescribeCacheParametersRequest
+	MockModifyCacheParameterGroupRequest    func(*elasticache.ModifyCacheParameterGroupInput) elasticache.ModifyCacheParameterGroupRequest
+	MockResetCacheParameterGroupRequest     func(*elasticache.ResetCacheParameterGroupInput) elasticache.ResetCacheParameterGroupRequest
+
 	MockDescribeCacheClustersRequest func(*elasticache.DescribeCacheClustersInput) elasticache.DescribeCacheClustersRequest
 	MockCreateCacheClusterRequest    func(*elasticache.CreateCacheClusterInput) elasticache.CreateCacheClusterRequest
 	MockDeleteCacheClusterRequest    func(*elasticache.DeleteCacheClusterInput) elasticache.DeleteCacheClusterRequest
@@ -97,6 +104,42 @@ func (c *MockClient) DeleteCacheSubnetGroupRequest(i *elasticache.DeleteCacheSub
 	return c.MockDeleteCacheSubnetGroupRequest(i)
 }
 
+// DescribeCacheParameterGroupsRequest calls the underlying
+// MockDescribeCacheParameterGroupsRequest method.
+func (c *MockClient) DescribeCacheParameterGroupsRequest(i *elasticache.DescribeCacheParameterGroupsInput) elasticache.DescribeCacheParameterGroupsRequest {
+	return c.MockDescribeCacheParameterGroupsRequest(i)
+}
+
+// CreateCacheParameterGroupRequest calls the underlying
+// MockCreateCacheParameterGroupRequest method.
+func (c *MockClient) CreateCacheParameterGroupRequest(i *elasticache.CreateCacheParameterGroupInput) elasticache.CreateCacheParameterGroupRequest {
+	return c.MockCreateCacheParameterGroupRequest(i)
+}
+
+// DeleteCacheParameterGroupRequest calls the underlying
+// MockDeleteCacheParameterGroupRequest method.
+func (c *MockClient) DeleteCacheParameterGroupRequest(i *elasticache.DeleteCacheParameterGroupInput) elasticache.DeleteCacheParameterGroupRequest {
+	return c.MockDeleteCacheParameterGroupRequest(i)
+}
+
+// DescribeCacheParametersRequest calls the underlying
+// MockDescribeCacheParametersRequest method.
+func (c *MockClient) DescribeCacheParametersRequest(i *elasticache.DescribeCacheParametersInput) elasticache.DescribeCacheParametersRequest {
+	return c.MockDescribeCacheParametersRequest(i)
+}
+
+// ModifyCacheParameterGroupRequest calls the underlying
+// MockModifyCacheParameterGroupRequest method.
+func (c *MockClient) ModifyCacheParameterGroupRequest(i *elasticache.ModifyCacheParameterGroupInput) elasticache.ModifyCacheParameterGroupRequest {
+	return c.MockModifyCacheParameterGroupRequest(i)
+}
+
+// ResetCacheParameterGroupRequest calls the underlying
+// MockResetCacheParameterGroupRequest method.
+func (c *MockClient) ResetCacheParameterGroupRequest(i *elasticache.ResetCacheParameterGroupInput) elasticache.ResetCacheParameterGroupRequest {
+	return c.MockResetCacheParameterGroupRequest(i)
+}
+
 // CreateCacheClusterRequest calls the underlying
 // MockCreateCacheClusterRequest method.
 func (c *MockClient) CreateCacheClusterRequest(i *elasticache.CreateCacheClusterInput) elasticache.CreateCacheClusterRequest {
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticbeanstalk
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+
+	"github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+)
+
+// ApplicationClient is the external client used for Application Custom
+// Resource
+type ApplicationClient interface {
+	DescribeApplicationsRequest(*elasticbeanstalk.DescribeApplicationsInput) elasticbeanstalk.DescribeApplicationsRequest
+	CreateApplicationRequest(*elasticbeanstalk.CreateApplicationInput) elasticbeanstalk.CreateApplicationRequest
+	UpdateApplicationRequest(*elasticbeanstalk.UpdateApplicationInput) elasticbeanstalk.UpdateApplicationRequest
+	DeleteApplicationRequest(*elasticbeanstalk.DeleteApplicationInput) elasticbeanstalk.DeleteApplicationRequest
+}
+
+// NewApplicationClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewApplicationClient(cfg aws.Config) ApplicationClient {
+	return elasticbeanstalk.New(cfg)
+}
+
+// GenerateTags returns the Elastic Beanstalk tags of the given tag map,
+// sorted by key.
+func GenerateTags(tags map[string]string) []elasticbeanstalk.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]elasticbeanstalk.Tag, 0, len(tags))
+	for k, v := range tags {
+		res = append(res, elasticbeanstalk.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(res, func(i, j int) bool { return aws.StringValue(res[i].Key) < aws.StringValue(res[j].Key) })
+	return res
+}
+
+// GenerateCreateApplicationInput returns the input to create an application
+// with the given name and parameters.
+func GenerateCreateApplicationInput(name string, p v1alpha1.ApplicationParameters) *elasticbeanstalk.CreateApplicationInput {
+	return &elasticbeanstalk.CreateApplicationInput{
+		ApplicationName: aws.String(name),
+		Description:     p.Description,
+		Tags:            GenerateTags(p.Tags),
+	}
+}
+
+// LateInitializeApplication fills the empty fields of the given parameters
+// with the values of the observed application.
+func LateInitializeApplication(p *v1alpha1.ApplicationParameters, o elasticbeanstalk.ApplicationDescription) {
+	if p.Description == nil && aws.StringValue(o.Description) != "" {
+		p.Description = o.Description
+	}
+}
+
+// IsApplicationUpToDate returns true if the description of the application
+// is the desired one.
+func IsApplicationUpToDate(p v1alpha1.ApplicationParameters, o elasticbeanstalk.ApplicationDescription) bool {
+	return p.Description == nil || aws.StringValue(p.Description) == aws.StringValue(o.Description)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticbeanstalk
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+
+	"github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+)
+
+// ApplicationVersionClient is the external client used for
+// ApplicationVersion Custom Resource
+type ApplicationVersionClient interface {
+	DescribeApplicationVersionsRequest(*elasticbeanstalk.DescribeApplicationVersionsInput) elasticbeanstalk.DescribeApplicationVersionsRequest
+	CreateApplicationVersionRequest(*elasticbeanstalk.CreateApplicationVersionInput) elasticbeanstalk.CreateApplicationVersionRequest
+	UpdateApplicationVersionRequest(*elasticbeanstalk.UpdateApplicationVersionInput) elasticbeanstalk.UpdateApplicationVersionRequest
+	DeleteApplicationVersionRequest(*elasticbeanstalk.DeleteApplicationVersionInput) elasticbeanstalk.DeleteApplicationVersionRequest
+}
+
+// NewApplicationVersionClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewApplicationVersionClient(cfg aws.Config) ApplicationVersionClient {
+	return elasticbeanstalk.New(cfg)
+}
+
+// GenerateCreateApplicationVersionInput returns the input to create an
+// application version with the given label and parameters.
+func GenerateCreateApplicationVersionInput(label string, p v1alpha1.ApplicationVersionParameters) *elasticbeanstalk.CreateApplicationVersionInput {
+	return &elasticbeanstalk.CreateApplicationVersionInput{
+		ApplicationName: p.ApplicationName,
+		VersionLabel:    aws.String(label),
+		Description:     p.Description,
+		SourceBundle: &elasticbeanstalk.S3Location{
+			S3Bucket: p.SourceBundle.S3Bucket,
+			S3Key:    aws.String(p.SourceBundle.S3Key),
+		},
+		Process: p.Process,
+		Tags:    GenerateTags(p.Tags),
+	}
+}
+
+// GenerateApplicationVersionObservation returns the observation of the given
+// application version.
+func GenerateApplicationVersionObservation(o elasticbeanstalk.ApplicationVersionDescription) v1alpha1.ApplicationVersionObservation {
+	return v1alpha1.ApplicationVersionObservation{
+		ARN:    aws.StringValue(o.ApplicationVersionArn),
+		Status: string(o.Status),
+	}
+}
+
+// LateInitializeApplicationVersion fills the empty fields of the given
+// parameters with the values of the observed application version.
+func LateInitializeApplicationVersion(p *v1alpha1.ApplicationVersionParameters, o elasticbeanstalk.ApplicationVersionDescription) {
+	if p.Description == nil && aws.StringValue(o.Description) != "" {
+		p.Description = o.Description
+	}
+}
+
+// IsApplicationVersionUpToDate returns true if the description of the
+// application version is the desired one.
+func IsApplicationVersionUpToDate(p v1alpha1.ApplicationVersionParameters, o elasticbeanstalk.ApplicationVersionDescription) bool {
+	return p.Description == nil || aws.StringValue(p.Description) == aws.StringValue(o.Description)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticbeanstalk
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// EnvironmentClient is the external client used for Environment Custom
+// Resource
+type EnvironmentClient interface {
+	DescribeEnvironmentsRequest(*elasticbeanstalk.DescribeEnvironmentsInput) elasticbeanstalk.DescribeEnvironmentsRequest
+	DescribeConfigurationSettingsRequest(*elasticbeanstalk.DescribeConfigurationSettingsInput) elasticbeanstalk.DescribeConfigurationSettingsRequest
+	CreateEnvironmentRequest(*elasticbeanstalk.CreateEnvironmentInput) elasticbeanstalk.CreateEnvironmentRequest
+	UpdateEnvironmentRequest(*elasticbeanstalk.UpdateEnvironmentInput) elasticbeanstalk.UpdateEnvironmentRequest
+	TerminateEnvironmentRequest(*elasticbeanstalk.TerminateEnvironmentInput) elasticbeanstalk.TerminateEnvironmentRequest
+}
+
+// NewEnvironmentClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewEnvironmentClient(cfg aws.Config) EnvironmentClient {
+	return elasticbeanstalk.New(cfg)
+}
+
+// GenerateOptionSettings returns the configuration option settings of the
+// given option settings.
+func GenerateOptionSettings(settings []v1alpha1.OptionSetting) []elasticbeanstalk.ConfigurationOptionSetting {
+	if len(settings) == 0 {
+		return nil
+	}
+	res := make([]elasticbeanstalk.ConfigurationOptionSetting, len(settings))
+	for i, s := range settings {
+		res[i] = elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:    aws.String(s.Namespace),
+			OptionName:   aws.String(s.OptionName),
+			ResourceName: s.ResourceName,
+			Value:        aws.String(s.Value),
+		}
+	}
+	return res
+}
+
+// GenerateCreateEnvironmentInput returns the input to create an environment
+// with the given name and parameters.
+func GenerateCreateEnvironmentInput(name string, p v1alpha1.EnvironmentParameters) *elasticbeanstalk.CreateEnvironmentInput {
+	in := &elasticbeanstalk.CreateEnvironmentInput{
+		ApplicationName:   p.ApplicationName,
+		EnvironmentName:   aws.String(name),
+		Description:       p.Description,
+		SolutionStackName: p.SolutionStackName,
+		PlatformArn:       p.PlatformARN,
+		VersionLabel:      p.VersionLabel,
+		CNAMEPrefix:       p.CNAMEPrefix,
+		OptionSettings:    GenerateOptionSettings(p.OptionSettings),
+		Tags:              GenerateTags(p.Tags),
+	}
+	if p.Tier != nil {
+		in.Tier = &elasticbeanstalk.EnvironmentTier{
+			Name: aws.String(p.Tier.Name),
+			Type: aws.String(p.Tier.Type),
+		}
+	}
+	return in
+}
+
+// GenerateEnvironmentObservation returns the observation of the given
+// environment.
+func GenerateEnvironmentObservation(o elasticbeanstalk.EnvironmentDescription) v1alpha1.EnvironmentObservation {
+	return v1alpha1.EnvironmentObservation{
+		ARN:           aws.StringValue(o.EnvironmentArn),
+		EnvironmentID: aws.StringValue(o.EnvironmentId),
+		Status:        string(o.Status),
+		Health:        string(o.Health),
+		CNAME:         aws.StringValue(o.CNAME),
+		EndpointURL:   aws.StringValue(o.EndpointURL),
+	}
+}
+
+// LateInitializeEnvironment fills the empty fields of the given parameters
+// with the values of the observed environment. The solution stack is only
+// late initialized if no platform is given, since the two are exclusive.
+func LateInitializeEnvironment(p *v1alpha1.EnvironmentParameters, o elasticbeanstalk.EnvironmentDescription) {
+	p.Description = awsclients.LateInitializeStringPtr(p.Description, awsclients.String(aws.StringValue(o.Description)))
+	if p.PlatformARN == nil {
+		p.SolutionStackName = awsclients.LateInitializeStringPtr(p.SolutionStackName, o.SolutionStackName)
+	}
+}
+
+// GenerateUpdateEnvironmentInput returns the update input that changes the
+// given observed environment and its observed option settings into the
+// desired one. Only the fields and option settings that differ are set.
+func GenerateUpdateEnvironmentInput(name string, p v1alpha1.EnvironmentParameters, o elasticbeanstalk.EnvironmentDescription, settings []elasticbeanstalk.ConfigurationOptionSetting) *elasticbeanstalk.UpdateEnvironmentInput {
+	in := &elasticbeanstalk.UpdateEnvironmentInput{
+		EnvironmentName: aws.String(name),
+	}
+	if p.Description != nil && aws.StringValue(p.Description) != aws.StringValue(o.Description) {
+		in.Description = p.Description
+	}
+	if p.PlatformARN != nil {
+		if aws.StringValue(p.PlatformARN) != aws.StringValue(o.PlatformArn) {
+			in.PlatformArn = p.PlatformARN
+		}
+	} else if p.SolutionStackName != nil && aws.StringValue(p.SolutionStackName) != aws.StringValue(o.SolutionStackName) {
+		in.SolutionStackName = p.SolutionStackName
+	}
+	if p.VersionLabel != nil && aws.StringValue(p.VersionLabel) != aws.StringValue(o.VersionLabel) {
+		in.VersionLabel = p.VersionLabel
+	}
+	for _, s := range GenerateOptionSettings(p.OptionSettings) {
+		if !hasOptionSetting(settings, s) {
+			in.OptionSettings = append(in.OptionSettings, s)
+		}
+	}
+	return in
+}
+
+// IsEnvironmentUpToDate checks whether there is a change in any of the
+// modifiable fields or option settings.
+func IsEnvironmentUpToDate(p v1alpha1.EnvironmentParameters, o elasticbeanstalk.EnvironmentDescription, settings []elasticbeanstalk.ConfigurationOptionSetting) bool {
+	in := GenerateUpdateEnvironmentInput(aws.StringValue(o.EnvironmentName), p, o, settings)
+	return cmp.Equal(&elasticbeanstalk.UpdateEnvironmentInput{EnvironmentName: in.EnvironmentName}, in,
+		cmpopts.IgnoreUnexported(elasticbeanstalk.UpdateEnvironmentInput{}))
+}
+
+// GetEnvironmentConnectionDetails returns the connection details of the
+// given Environment, i.e. its CNAME.
+func GetEnvironmentConnectionDetails(cr v1alpha1.Environment) managed.ConnectionDetails {
+	if cr.Status.AtProvider.CNAME == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.CNAME),
+	}
+}
+
+// hasOptionSetting returns true if the given option is set to the same value
+// in the given option settings.
+func hasOptionSetting(settings []elasticbeanstalk.ConfigurationOptionSetting, s elasticbeanstalk.ConfigurationOptionSetting) bool {
+	for _, o := range settings {
+		if aws.StringValue(o.Namespace) == aws.StringValue(s.Namespace) &&
+			aws.StringValue(o.OptionName) == aws.StringValue(s.OptionName) &&
+			aws.StringValue(o.ResourceName) == aws.StringValue(s.ResourceName) {
+			return aws.StringValue(o.Value) == aws.StringValue(s.Value)
+		}
+	}
+	return false
+}
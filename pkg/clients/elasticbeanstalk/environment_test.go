@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticbeanstalk
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+)
+
+var (
+	environmentName = "web-prod"
+	solutionStack   = "64bit Amazon Linux 2 v3.1.2 running Python 3.7"
+)
+
+func observedEnvironment() elasticbeanstalk.EnvironmentDescription {
+	return elasticbeanstalk.EnvironmentDescription{
+		EnvironmentName:   aws.String(environmentName),
+		Description:       aws.String("production"),
+		SolutionStackName: aws.String(solutionStack),
+		PlatformArn:       aws.String("arn:aws:elasticbeanstalk:us-east-1::platform/Python 3.7 running on 64bit Amazon Linux 2/3.1.2"),
+		VersionLabel:      aws.String("v1"),
+	}
+}
+
+func observedSettings() []elasticbeanstalk.ConfigurationOptionSetting {
+	return []elasticbeanstalk.ConfigurationOptionSetting{
+		{Namespace: aws.String("aws:autoscaling:launchconfiguration"), OptionName: aws.String("InstanceType"), Value: aws.String("t3.micro")},
+		{Namespace: aws.String("aws:autoscaling:asg"), OptionName: aws.String("MaxSize"), Value: aws.String("4")},
+	}
+}
+
+func TestGenerateUpdateEnvironmentInput(t *testing.T) {
+	cases := map[string]struct {
+		p   v1alpha1.EnvironmentParameters
+		out *elasticbeanstalk.UpdateEnvironmentInput
+	}{
+		"NoChange": {
+			p: v1alpha1.EnvironmentParameters{
+				SolutionStackName: aws.String(solutionStack),
+				VersionLabel:      aws.String("v1"),
+				OptionSettings: []v1alpha1.OptionSetting{
+					{Namespace: "aws:autoscaling:asg", OptionName: "MaxSize", Value: "4"},
+				},
+			},
+			out: &elasticbeanstalk.UpdateEnvironmentInput{EnvironmentName: aws.String(environmentName)},
+		},
+		"DeployVersion": {
+			p: v1alpha1.EnvironmentParameters{
+				VersionLabel: aws.String("v2"),
+			},
+			out: &elasticbeanstalk.UpdateEnvironmentInput{
+				EnvironmentName: aws.String(environmentName),
+				VersionLabel:    aws.String("v2"),
+			},
+		},
+		"PlatformTakesPrecedence": {
+			p: v1alpha1.EnvironmentParameters{
+				SolutionStackName: aws.String("64bit Amazon Linux 2 v3.1.3 running Python 3.7"),
+				PlatformARN:       aws.String("arn:aws:elasticbeanstalk:us-east-1::platform/Python 3.7 running on 64bit Amazon Linux 2/3.1.3"),
+			},
+			out: &elasticbeanstalk.UpdateEnvironmentInput{
+				EnvironmentName: aws.String(environmentName),
+				PlatformArn:     aws.String("arn:aws:elasticbeanstalk:us-east-1::platform/Python 3.7 running on 64bit Amazon Linux 2/3.1.3"),
+			},
+		},
+		"ChangedAndNewOptionSettings": {
+			p: v1alpha1.EnvironmentParameters{
+				OptionSettings: []v1alpha1.OptionSetting{
+					{Namespace: "aws:autoscaling:launchconfiguration", OptionName: "InstanceType", Value: "t3.small"},
+					{Namespace: "aws:autoscaling:asg", OptionName: "MaxSize", Value: "4"},
+					{Namespace: "aws:elasticbeanstalk:application:environment", OptionName: "DEBUG", Value: "false"},
+				},
+			},
+			out: &elasticbeanstalk.UpdateEnvironmentInput{
+				EnvironmentName: aws.String(environmentName),
+				OptionSettings: []elasticbeanstalk.ConfigurationOptionSetting{
+					{Namespace: aws.String("aws:autoscaling:launchconfiguration"), OptionName: aws.String("InstanceType"), Value: aws.String("t3.small")},
+					{Namespace: aws.String("aws:elasticbeanstalk:application:environment"), OptionName: aws.String("DEBUG"), Value: aws.String("false")},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateEnvironmentInput(environmentName, tc.p, observedEnvironment(), observedSettings())
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsEnvironmentUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.EnvironmentParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.EnvironmentParameters{
+				Description: aws.String("production"),
+				OptionSettings: []v1alpha1.OptionSetting{
+					{Namespace: "aws:autoscaling:launchconfiguration", OptionName: "InstanceType", Value: "t3.micro"},
+				},
+			},
+			want: true,
+		},
+		"OptionSettingChanged": {
+			p: v1alpha1.EnvironmentParameters{
+				OptionSettings: []v1alpha1.OptionSetting{
+					{Namespace: "aws:autoscaling:asg", OptionName: "MaxSize", Value: "8"},
+				},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsEnvironmentUpToDate(tc.p, observedEnvironment(), observedSettings())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeEnvironment(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.EnvironmentParameters
+		want v1alpha1.EnvironmentParameters
+	}{
+		"SolutionStack": {
+			p: v1alpha1.EnvironmentParameters{},
+			want: v1alpha1.EnvironmentParameters{
+				Description:       aws.String("production"),
+				SolutionStackName: aws.String(solutionStack),
+			},
+		},
+		"PlatformGiven": {
+			p: v1alpha1.EnvironmentParameters{
+				PlatformARN: aws.String("arn"),
+			},
+			want: v1alpha1.EnvironmentParameters{
+				Description: aws.String("production"),
+				PlatformARN: aws.String("arn"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeEnvironment(&tc.p, observedEnvironment())
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
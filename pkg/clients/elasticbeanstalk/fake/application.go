@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ApplicationClient = (*MockApplicationClient)(nil)
+
+// MockApplicationClient is a type that implements all the methods for ApplicationClient interface
+type MockApplicationClient struct {
+	MockDescribeApplications func(*elasticbeanstalk.DescribeApplicationsInput) elasticbeanstalk.DescribeApplicationsRequest
+	MockCreateApplication    func(*elasticbeanstalk.CreateApplicationInput) elasticbeanstalk.CreateApplicationRequest
+	MockUpdateApplication    func(*elasticbeanstalk.UpdateApplicationInput) elasticbeanstalk.UpdateApplicationRequest
+	MockDeleteApplication    func(*elasticbeanstalk.DeleteApplicationInput) elasticbeanstalk.DeleteApplicationRequest
+}
+
+// DescribeApplicationsRequest mocks DescribeApplicationsRequest method
+func (m *MockApplicationClient) DescribeApplicationsRequest(input *elasticbeanstalk.DescribeApplicationsInput) elasticbeanstalk.DescribeApplicationsRequest {
+	return m.MockDescribeApplications(input)
+}
+
+// CreateApplicationRequest mocks CreateApplicationRequest method
+func (m *MockApplicationClient) CreateApplicationRequest(input *elasticbeanstalk.CreateApplicationInput) elasticbeanstalk.CreateApplicationRequest {
+	return m.MockCreateApplication(input)
+}
+
+// UpdateApplicationRequest mocks UpdateApplicationRequest method
+func (m *MockApplicationClient) UpdateApplicationRequest(input *elasticbeanstalk.UpdateApplicationInput) elasticbeanstalk.UpdateApplicationRequest {
+	return m.MockUpdateApplication(input)
+}
+
+// DeleteApplicationRequest mocks DeleteApplicationRequest method
+func (m *MockApplicationClient) DeleteApplicationRequest(input *elasticbeanstalk.DeleteApplicationInput) elasticbeanstalk.DeleteApplicationRequest {
+	return m.MockDeleteApplication(input)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ApplicationVersionClient = (*MockApplicationVersionClient)(nil)
+
+// MockApplicationVersionClient is a type that implements all the methods for ApplicationVersionClient interface
+type MockApplicationVersionClient struct {
+	MockDescribeApplicationVersions func(*elasticbeanstalk.DescribeApplicationVersionsInput) elasticbeanstalk.DescribeApplicationVersionsRequest
+	MockCreateApplicationVersion    func(*elasticbeanstalk.CreateApplicationVersionInput) elasticbeanstalk.CreateApplicationVersionRequest
+	MockUpdateApplicationVersion    func(*elasticbeanstalk.UpdateApplicationVersionInput) elasticbeanstalk.UpdateApplicationVersionRequest
+	MockDeleteApplicationVersion    func(*elasticbeanstalk.DeleteApplicationVersionInput) elasticbeanstalk.DeleteApplicationVersionRequest
+}
+
+// DescribeApplicationVersionsRequest mocks DescribeApplicationVersionsRequest method
+func (m *MockApplicationVersionClient) DescribeApplicationVersionsRequest(input *elasticbeanstalk.DescribeApplicationVersionsInput) elasticbeanstalk.DescribeApplicationVersionsRequest {
+	return m.MockDescribeApplicationVersions(input)
+}
+
+// CreateApplicationVersionRequest mocks CreateApplicationVersionRequest method
+func (m *MockApplicationVersionClient) CreateApplicationVersionRequest(input *elasticbeanstalk.CreateApplicationVersionInput) elasticbeanstalk.CreateApplicationVersionRequest {
+	return m.MockCreateApplicationVersion(input)
+}
+
+// UpdateApplicationVersionRequest mocks UpdateApplicationVersionRequest method
+func (m *MockApplicationVersionClient) UpdateApplicationVersionRequest(input *elasticbeanstalk.UpdateApplicationVersionInput) elasticbeanstalk.UpdateApplicationVersionRequest {
+	return m.MockUpdateApplicationVersion(input)
+}
+
+// DeleteApplicationVersionRequest mocks DeleteApplicationVersionRequest method
+func (m *MockApplicationVersionClient) DeleteApplicationVersionRequest(input *elasticbeanstalk.DeleteApplicationVersionInput) elasticbeanstalk.DeleteApplicationVersionRequest {
+	return m.MockDeleteApplicationVersion(input)
+}
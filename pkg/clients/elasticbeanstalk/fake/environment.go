@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.EnvironmentClient = (*MockEnvironmentClient)(nil)
+
+// MockEnvironmentClient is a type that implements all the methods for EnvironmentClient interface
+type MockEnvironmentClient struct {
+	MockDescribeEnvironments          func(*elasticbeanstalk.DescribeEnvironmentsInput) elasticbeanstalk.DescribeEnvironmentsRequest
+	MockDescribeConfigurationSettings func(*elasticbeanstalk.DescribeConfigurationSettingsInput) elasticbeanstalk.DescribeConfigurationSettingsRequest
+	MockCreateEnvironment             func(*elasticbeanstalk.CreateEnvironmentInput) elasticbeanstalk.CreateEnvironmentRequest
+	MockUpdateEnvironment             func(*elasticbeanstalk.UpdateEnvironmentInput) elasticbeanstalk.UpdateEnvironmentRequest
+	MockTerminateEnvironment          func(*elasticbeanstalk.TerminateEnvironmentInput) elasticbeanstalk.TerminateEnvironmentRequest
+}
+
+// DescribeEnvironmentsRequest mocks DescribeEnvironmentsRequest method
+func (m *MockEnvironmentClient) DescribeEnvironmentsRequest(input *elasticbeanstalk.DescribeEnvironmentsInput) elasticbeanstalk.DescribeEnvironmentsRequest {
+	return m.MockDescribeEnvironments(input)
+}
+
+// DescribeConfigurationSettingsRequest mocks DescribeConfigurationSettingsRequest method
+func (m *MockEnvironmentClient) DescribeConfigurationSettingsRequest(input *elasticbeanstalk.DescribeConfigurationSettingsInput) elasticbeanstalk.DescribeConfigurationSettingsRequest {
+	return m.MockDescribeConfigurationSettings(input)
+}
+
+// CreateEnvironmentRequest mocks CreateEnvironmentRequest method
+func (m *MockEnvironmentClient) CreateEnvironmentRequest(input *elasticbeanstalk.CreateEnvironmentInput) elasticbeanstalk.CreateEnvironmentRequest {
+	return m.MockCreateEnvironment(input)
+}
+
+// UpdateEnvironmentRequest mocks UpdateEnvironmentRequest method
+func (m *MockEnvironmentClient) UpdateEnvironmentRequest(input *elasticbeanstalk.UpdateEnvironmentInput) elasticbeanstalk.UpdateEnvironmentRequest {
+	return m.MockUpdateEnvironment(input)
+}
+
+// TerminateEnvironmentRequest mocks TerminateEnvironmentRequest method
+func (m *MockEnvironmentClient) TerminateEnvironmentRequest(input *elasticbeanstalk.TerminateEnvironmentInput) elasticbeanstalk.TerminateEnvironmentRequest {
+	return m.MockTerminateEnvironment(input)
+}
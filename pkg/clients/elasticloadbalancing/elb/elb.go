@@ -66,6 +66,16 @@ func LateInitializeELB(in *v1alpha1.ELBParameters, v *elb.LoadBalancerDescriptio
 
 	in.Scheme = clients.LateInitializeStringPtr(in.Scheme, v.Scheme)
 
+	if in.HealthCheck == nil && v.HealthCheck != nil {
+		in.HealthCheck = &v1alpha1.HealthCheck{
+			HealthyThreshold:   aws.Int64Value(v.HealthCheck.HealthyThreshold),
+			Interval:           aws.Int64Value(v.HealthCheck.Interval),
+			Target:             aws.StringValue(v.HealthCheck.Target),
+			Timeout:            aws.Int64Value(v.HealthCheck.Timeout),
+			UnhealthyThreshold: aws.Int64Value(v.HealthCheck.UnhealthyThreshold),
+		}
+	}
+
 	if len(in.AvailabilityZones) == 0 && len(v.AvailabilityZones) != 0 {
 		in.AvailabilityZones = v.AvailabilityZones
 	}
@@ -102,6 +112,37 @@ func LateInitializeELB(in *v1alpha1.ELBParameters, v *elb.LoadBalancerDescriptio
 	}
 }
 
+// LateInitializeELBAttributes fills the empty fields in
+// *v1alpha1.ELBParameters with the values seen in
+// elasticLoadBalancing.LoadBalancerAttributes.
+func LateInitializeELBAttributes(in *v1alpha1.ELBParameters, v *elb.LoadBalancerAttributes) {
+	if v == nil || v.CrossZoneLoadBalancing == nil {
+		return
+	}
+	in.CrossZoneLoadBalancing = clients.LateInitializeBoolPtr(in.CrossZoneLoadBalancing, v.CrossZoneLoadBalancing.Enabled)
+}
+
+// AreELBAttributesUpToDate checks whether the attributes of the load
+// balancer match the desired parameters.
+func AreELBAttributesUpToDate(p v1alpha1.ELBParameters, v *elb.LoadBalancerAttributes) bool {
+	if p.CrossZoneLoadBalancing == nil {
+		return true
+	}
+	return v != nil && v.CrossZoneLoadBalancing != nil &&
+		aws.BoolValue(p.CrossZoneLoadBalancing) == aws.BoolValue(v.CrossZoneLoadBalancing.Enabled)
+}
+
+// GenerateModifyELBAttributesInput returns the input for a
+// ModifyLoadBalancerAttributes request built from the given parameters.
+func GenerateModifyELBAttributesInput(name string, p v1alpha1.ELBParameters) *elb.ModifyLoadBalancerAttributesInput {
+	return &elb.ModifyLoadBalancerAttributesInput{
+		LoadBalancerName: aws.String(name),
+		LoadBalancerAttributes: &elb.LoadBalancerAttributes{
+			CrossZoneLoadBalancing: &elb.CrossZoneLoadBalancing{Enabled: p.CrossZoneLoadBalancing},
+		},
+	}
+}
+
 // IsELBNotFound returns true if the error is because the item doesn't exist.
 func IsELBNotFound(err error) bool {
 	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == elb.ErrCodeAccessPointNotFoundException {
@@ -166,7 +207,9 @@ func CreatePatch(in elb.LoadBalancerDescription, target v1alpha1.ELBParameters,
 	return patch, nil
 }
 
-// IsUpToDate checks whether there is a change in any of the modifiable fields.
+// IsUpToDate checks whether there is a change in any of the modifiable fields
+// of the load balancer description. The attributes of the load balancer are
+// checked by AreELBAttributesUpToDate.
 func IsUpToDate(p v1alpha1.ELBParameters, elb elb.LoadBalancerDescription, elbTags []elb.Tag) (bool, error) {
 	patch, err := CreatePatch(elb, p, elbTags)
 	if err != nil {
@@ -174,7 +217,7 @@ func IsUpToDate(p v1alpha1.ELBParameters, elb elb.LoadBalancerDescription, elbTa
 	}
 	return cmp.Equal(&v1alpha1.ELBParameters{}, patch,
 		cmpopts.IgnoreTypes([]corev1alpha1.Reference{}, []corev1alpha1.Selector{}),
-		cmpopts.IgnoreFields(v1alpha1.ELBParameters{}, "Region")), nil
+		cmpopts.IgnoreFields(v1alpha1.ELBParameters{}, "Region", "CrossZoneLoadBalancing")), nil
 }
 
 // BuildELBListeners builds a list of elb.Listener from given list of v1alpha1.Listener.
@@ -127,6 +127,29 @@ func TestLateInitializeELB(t *testing.T) {
 			},
 			want: elbParams(),
 		},
+		"HealthCheck": {
+			args: args{
+				spec: elbParams(),
+				in: *loadBalancer(func(lb *elb.LoadBalancerDescription) {
+					lb.HealthCheck = &elb.HealthCheck{
+						HealthyThreshold:   aws.Int64(10),
+						Interval:           aws.Int64(30),
+						Target:             aws.String("TCP:80"),
+						Timeout:            aws.Int64(5),
+						UnhealthyThreshold: aws.Int64(2),
+					}
+				}),
+			},
+			want: elbParams(func(p *v1alpha1.ELBParameters) {
+				p.HealthCheck = &v1alpha1.HealthCheck{
+					HealthyThreshold:   10,
+					Interval:           30,
+					Target:             "TCP:80",
+					Timeout:            5,
+					UnhealthyThreshold: 2,
+				}
+			}),
+		},
 		"Tags": {
 			args: args{
 				spec: elbParams(),
@@ -378,3 +401,42 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestAreELBAttributesUpToDate(t *testing.T) {
+	attributes := func(enabled bool) *elb.LoadBalancerAttributes {
+		return &elb.LoadBalancerAttributes{
+			CrossZoneLoadBalancing: &elb.CrossZoneLoadBalancing{Enabled: aws.Bool(enabled)},
+		}
+	}
+
+	cases := map[string]struct {
+		p     v1alpha1.ELBParameters
+		attrs *elb.LoadBalancerAttributes
+		want  bool
+	}{
+		"Unset": {
+			p:     v1alpha1.ELBParameters{},
+			attrs: attributes(true),
+			want:  true,
+		},
+		"SameValue": {
+			p:     v1alpha1.ELBParameters{CrossZoneLoadBalancing: aws.Bool(true)},
+			attrs: attributes(true),
+			want:  true,
+		},
+		"DifferentValue": {
+			p:     v1alpha1.ELBParameters{CrossZoneLoadBalancing: aws.Bool(true)},
+			attrs: attributes(false),
+			want:  false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := AreELBAttributesUpToDate(tc.p, tc.attrs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("AreELBAttributesUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
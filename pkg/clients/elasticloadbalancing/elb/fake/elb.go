@@ -41,6 +41,8 @@ type MockClient struct {
 	MockRegisterInstancesWithLoadBalancerRequest       func(*elb.RegisterInstancesWithLoadBalancerInput) elb.RegisterInstancesWithLoadBalancerRequest
 	MockDeregisterInstancesFromLoadBalancerRequest     func(*elb.DeregisterInstancesFromLoadBalancerInput) elb.DeregisterInstancesFromLoadBalancerRequest
 	MockDescribeTagsRequest                            func(*elb.DescribeTagsInput) elb.DescribeTagsRequest
+	MockDescribeLoadBalancerAttributesRequest          func(*elb.DescribeLoadBalancerAttributesInput) elb.DescribeLoadBalancerAttributesRequest
+	MockModifyLoadBalancerAttributesRequest            func(*elb.ModifyLoadBalancerAttributesInput) elb.ModifyLoadBalancerAttributesRequest
 }
 
 // DescribeLoadBalancersRequest calls the underlying
@@ -120,3 +122,15 @@ func (c *MockClient) DeregisterInstancesFromLoadBalancerRequest(i *elasticloadba
 func (c *MockClient) DescribeTagsRequest(i *elasticloadbalancing.DescribeTagsInput) elasticloadbalancing.DescribeTagsRequest {
 	return c.MockDescribeTagsRequest(i)
 }
+
+// DescribeLoadBalancerAttributesRequest calls the underlying
+// MockDescribeLoadBalancerAttributesRequest method.
+func (c *MockClient) DescribeLoadBalancerAttributesRequest(i *elasticloadbalancing.DescribeLoadBalancerAttributesInput) elasticloadbalancing.DescribeLoadBalancerAttributesRequest {
+	return c.MockDescribeLoadBalancerAttributesRequest(i)
+}
+
+// ModifyLoadBalancerAttributesRequest calls the underlying
+// MockModifyLoadBalancerAttributesRequest method.
+func (c *MockClient) ModifyLoadBalancerAttributesRequest(i *elasticloadbalancing.ModifyLoadBalancerAttributesInput) elasticloadbalancing.ModifyLoadBalancerAttributesRequest {
+	return c.MockModifyLoadBalancerAttributesRequest(i)
+}
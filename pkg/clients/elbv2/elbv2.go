@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// BuildTags returns the elbv2.Tags of the given tags.
+func BuildTags(tags []v1alpha1.Tag) []elbv2.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]elbv2.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = elbv2.Tag{Key: aws.String(t.Key), Value: t.Value}
+	}
+	return res
+}
+
+// BuildFromTags returns the tags of the given elbv2.Tags.
+func BuildFromTags(tags []elbv2.Tag) []v1alpha1.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]v1alpha1.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = v1alpha1.Tag{Key: aws.StringValue(t.Key), Value: t.Value}
+	}
+	return res
+}
+
+// DiffTags returns the tags that have to be added or updated and the keys of
+// the tags that have to be removed for the observed tags to match the
+// desired ones.
+func DiffTags(desired []v1alpha1.Tag, observed []elbv2.Tag) ([]elbv2.Tag, []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = aws.StringValue(t.Value)
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	add, _ := awsclients.DiffTags(local, remote)
+	var res []elbv2.Tag
+	for k, v := range add {
+		res = append(res, elbv2.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(res, func(i, j int) bool { return aws.StringValue(res[i].Key) < aws.StringValue(res[j].Key) })
+	// NOTE: AddTags overwrites the values of the existing keys, so only the
+	// keys that are not desired anymore have to be removed.
+	var remove []string
+	for k := range remote {
+		if _, ok := local[k]; !ok {
+			remove = append(remove, k)
+		}
+	}
+	sort.Strings(remove)
+	return res, remove
+}
+
+// AreTagsUpToDate returns whether the observed tags match the desired ones.
+func AreTagsUpToDate(desired []v1alpha1.Tag, observed []elbv2.Tag) bool {
+	add, remove := DiffTags(desired, observed)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// sameStrings returns whether a and b contain the same strings, regardless
+// of their order.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := make(map[string]int, len(a))
+	for _, s := range a {
+		count[s]++
+	}
+	for _, s := range b {
+		if count[s] == 0 {
+			return false
+		}
+		count[s]--
+	}
+	return true
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []elasticloadbalancingv2.Tag
+		remove []string
+	}
+
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []elasticloadbalancingv2.Tag
+		want
+	}{
+		"UpToDate": {
+			desired:  []v1alpha1.Tag{{Key: "k1", Value: aws.String("v1")}},
+			observed: []elasticloadbalancingv2.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+		},
+		"AddUpdateAndRemove": {
+			desired: []v1alpha1.Tag{
+				{Key: "k1", Value: aws.String("v1")},
+				{Key: "k2", Value: aws.String("new")},
+			},
+			observed: []elasticloadbalancingv2.Tag{
+				{Key: aws.String("k2"), Value: aws.String("old")},
+				{Key: aws.String("k3"), Value: aws.String("v3")},
+			},
+			want: want{
+				add: []elasticloadbalancingv2.Tag{
+					{Key: aws.String("k1"), Value: aws.String("v1")},
+					{Key: aws.String("k2"), Value: aws.String("new")},
+				},
+				remove: []string{"k3"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
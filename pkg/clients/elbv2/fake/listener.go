@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ListenerClient = (*MockListenerClient)(nil)
+
+// MockListenerClient is a type that implements all the methods for ListenerClient interface
+type MockListenerClient struct {
+	MockDescribeListeners            func(*elbv2.DescribeListenersInput) elbv2.DescribeListenersRequest
+	MockCreateListener               func(*elbv2.CreateListenerInput) elbv2.CreateListenerRequest
+	MockModifyListener               func(*elbv2.ModifyListenerInput) elbv2.ModifyListenerRequest
+	MockDeleteListener               func(*elbv2.DeleteListenerInput) elbv2.DeleteListenerRequest
+	MockDescribeListenerCertificates func(*elbv2.DescribeListenerCertificatesInput) elbv2.DescribeListenerCertificatesRequest
+	MockAddListenerCertificates      func(*elbv2.AddListenerCertificatesInput) elbv2.AddListenerCertificatesRequest
+	MockRemoveListenerCertificates   func(*elbv2.RemoveListenerCertificatesInput) elbv2.RemoveListenerCertificatesRequest
+}
+
+// DescribeListenersRequest mocks DescribeListenersRequest method
+func (m *MockListenerClient) DescribeListenersRequest(input *elbv2.DescribeListenersInput) elbv2.DescribeListenersRequest {
+	return m.MockDescribeListeners(input)
+}
+
+// CreateListenerRequest mocks CreateListenerRequest method
+func (m *MockListenerClient) CreateListenerRequest(input *elbv2.CreateListenerInput) elbv2.CreateListenerRequest {
+	return m.MockCreateListener(input)
+}
+
+// ModifyListenerRequest mocks ModifyListenerRequest method
+func (m *MockListenerClient) ModifyListenerRequest(input *elbv2.ModifyListenerInput) elbv2.ModifyListenerRequest {
+	return m.MockModifyListener(input)
+}
+
+// DeleteListenerRequest mocks DeleteListenerRequest method
+func (m *MockListenerClient) DeleteListenerRequest(input *elbv2.DeleteListenerInput) elbv2.DeleteListenerRequest {
+	return m.MockDeleteListener(input)
+}
+
+// DescribeListenerCertificatesRequest mocks DescribeListenerCertificatesRequest method
+func (m *MockListenerClient) DescribeListenerCertificatesRequest(input *elbv2.DescribeListenerCertificatesInput) elbv2.DescribeListenerCertificatesRequest {
+	return m.MockDescribeListenerCertificates(input)
+}
+
+// AddListenerCertificatesRequest mocks AddListenerCertificatesRequest method
+func (m *MockListenerClient) AddListenerCertificatesRequest(input *elbv2.AddListenerCertificatesInput) elbv2.AddListenerCertificatesRequest {
+	return m.MockAddListenerCertificates(input)
+}
+
+// RemoveListenerCertificatesRequest mocks RemoveListenerCertificatesRequest method
+func (m *MockListenerClient) RemoveListenerCertificatesRequest(input *elbv2.RemoveListenerCertificatesInput) elbv2.RemoveListenerCertificatesRequest {
+	return m.MockRemoveListenerCertificates(input)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ListenerRuleClient = (*MockListenerRuleClient)(nil)
+
+// MockListenerRuleClient is a type that implements all the methods for ListenerRuleClient interface
+type MockListenerRuleClient struct {
+	MockDescribeRules     func(*elbv2.DescribeRulesInput) elbv2.DescribeRulesRequest
+	MockCreateRule        func(*elbv2.CreateRuleInput) elbv2.CreateRuleRequest
+	MockModifyRule        func(*elbv2.ModifyRuleInput) elbv2.ModifyRuleRequest
+	MockSetRulePriorities func(*elbv2.SetRulePrioritiesInput) elbv2.SetRulePrioritiesRequest
+	MockDeleteRule        func(*elbv2.DeleteRuleInput) elbv2.DeleteRuleRequest
+}
+
+// DescribeRulesRequest mocks DescribeRulesRequest method
+func (m *MockListenerRuleClient) DescribeRulesRequest(input *elbv2.DescribeRulesInput) elbv2.DescribeRulesRequest {
+	return m.MockDescribeRules(input)
+}
+
+// CreateRuleRequest mocks CreateRuleRequest method
+func (m *MockListenerRuleClient) CreateRuleRequest(input *elbv2.CreateRuleInput) elbv2.CreateRuleRequest {
+	return m.MockCreateRule(input)
+}
+
+// ModifyRuleRequest mocks ModifyRuleRequest method
+func (m *MockListenerRuleClient) ModifyRuleRequest(input *elbv2.ModifyRuleInput) elbv2.ModifyRuleRequest {
+	return m.MockModifyRule(input)
+}
+
+// SetRulePrioritiesRequest mocks SetRulePrioritiesRequest method
+func (m *MockListenerRuleClient) SetRulePrioritiesRequest(input *elbv2.SetRulePrioritiesInput) elbv2.SetRulePrioritiesRequest {
+	return m.MockSetRulePriorities(input)
+}
+
+// DeleteRuleRequest mocks DeleteRuleRequest method
+func (m *MockListenerRuleClient) DeleteRuleRequest(input *elbv2.DeleteRuleInput) elbv2.DeleteRuleRequest {
+	return m.MockDeleteRule(input)
+}
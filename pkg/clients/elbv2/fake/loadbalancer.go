@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LoadBalancerClient = (*MockLoadBalancerClient)(nil)
+
+// MockLoadBalancerClient is a type that implements all the methods for LoadBalancerClient interface
+type MockLoadBalancerClient struct {
+	MockDescribeLoadBalancers func(*elbv2.DescribeLoadBalancersInput) elbv2.DescribeLoadBalancersRequest
+	MockCreateLoadBalancer    func(*elbv2.CreateLoadBalancerInput) elbv2.CreateLoadBalancerRequest
+	MockDeleteLoadBalancer    func(*elbv2.DeleteLoadBalancerInput) elbv2.DeleteLoadBalancerRequest
+	MockSetSecurityGroups     func(*elbv2.SetSecurityGroupsInput) elbv2.SetSecurityGroupsRequest
+	MockSetSubnets            func(*elbv2.SetSubnetsInput) elbv2.SetSubnetsRequest
+	MockSetIpAddressType      func(*elbv2.SetIpAddressTypeInput) elbv2.SetIpAddressTypeRequest
+	MockDescribeTags          func(*elbv2.DescribeTagsInput) elbv2.DescribeTagsRequest
+	MockAddTags               func(*elbv2.AddTagsInput) elbv2.AddTagsRequest
+	MockRemoveTags            func(*elbv2.RemoveTagsInput) elbv2.RemoveTagsRequest
+}
+
+// DescribeLoadBalancersRequest mocks DescribeLoadBalancersRequest method
+func (m *MockLoadBalancerClient) DescribeLoadBalancersRequest(input *elbv2.DescribeLoadBalancersInput) elbv2.DescribeLoadBalancersRequest {
+	return m.MockDescribeLoadBalancers(input)
+}
+
+// CreateLoadBalancerRequest mocks CreateLoadBalancerRequest method
+func (m *MockLoadBalancerClient) CreateLoadBalancerRequest(input *elbv2.CreateLoadBalancerInput) elbv2.CreateLoadBalancerRequest {
+	return m.MockCreateLoadBalancer(input)
+}
+
+// DeleteLoadBalancerRequest mocks DeleteLoadBalancerRequest method
+func (m *MockLoadBalancerClient) DeleteLoadBalancerRequest(input *elbv2.DeleteLoadBalancerInput) elbv2.DeleteLoadBalancerRequest {
+	return m.MockDeleteLoadBalancer(input)
+}
+
+// SetSecurityGroupsRequest mocks SetSecurityGroupsRequest method
+func (m *MockLoadBalancerClient) SetSecurityGroupsRequest(input *elbv2.SetSecurityGroupsInput) elbv2.SetSecurityGroupsRequest {
+	return m.MockSetSecurityGroups(input)
+}
+
+// SetSubnetsRequest mocks SetSubnetsRequest method
+func (m *MockLoadBalancerClient) SetSubnetsRequest(input *elbv2.SetSubnetsInput) elbv2.SetSubnetsRequest {
+	return m.MockSetSubnets(input)
+}
+
+// SetIpAddressTypeRequest mocks SetIpAddressTypeRequest method
+func (m *MockLoadBalancerClient) SetIpAddressTypeRequest(input *elbv2.SetIpAddressTypeInput) elbv2.SetIpAddressTypeRequest {
+	return m.MockSetIpAddressType(input)
+}
+
+// DescribeTagsRequest mocks DescribeTagsRequest method
+func (m *MockLoadBalancerClient) DescribeTagsRequest(input *elbv2.DescribeTagsInput) elbv2.DescribeTagsRequest {
+	return m.MockDescribeTags(input)
+}
+
+// AddTagsRequest mocks AddTagsRequest method
+func (m *MockLoadBalancerClient) AddTagsRequest(input *elbv2.AddTagsInput) elbv2.AddTagsRequest {
+	return m.MockAddTags(input)
+}
+
+// RemoveTagsRequest mocks RemoveTagsRequest method
+func (m *MockLoadBalancerClient) RemoveTagsRequest(input *elbv2.RemoveTagsInput) elbv2.RemoveTagsRequest {
+	return m.MockRemoveTags(input)
+}
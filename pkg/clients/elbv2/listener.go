@@ -0,0 +1,279 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ListenerClient is the external client used for Listener Custom Resource
+type ListenerClient interface {
+	DescribeListenersRequest(input *elbv2.DescribeListenersInput) elbv2.DescribeListenersRequest
+	CreateListenerRequest(input *elbv2.CreateListenerInput) elbv2.CreateListenerRequest
+	ModifyListenerRequest(input *elbv2.ModifyListenerInput) elbv2.ModifyListenerRequest
+	DeleteListenerRequest(input *elbv2.DeleteListenerInput) elbv2.DeleteListenerRequest
+	DescribeListenerCertificatesRequest(input *elbv2.DescribeListenerCertificatesInput) elbv2.DescribeListenerCertificatesRequest
+	AddListenerCertificatesRequest(input *elbv2.AddListenerCertificatesInput) elbv2.AddListenerCertificatesRequest
+	RemoveListenerCertificatesRequest(input *elbv2.RemoveListenerCertificatesInput) elbv2.RemoveListenerCertificatesRequest
+}
+
+// NewListenerClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewListenerClient(cfg aws.Config) ListenerClient {
+	return elbv2.New(cfg)
+}
+
+// IsListenerNotFoundErr returns true if the error is because the item
+// doesn't exist
+func IsListenerNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == elbv2.ErrCodeListenerNotFoundException {
+		return true
+	}
+	return false
+}
+
+// GenerateActions returns the elbv2.Actions of the given actions.
+func GenerateActions(actions []v1alpha1.Action) []elbv2.Action {
+	res := make([]elbv2.Action, len(actions))
+	for i, a := range actions {
+		res[i] = elbv2.Action{
+			Type:           elbv2.ActionTypeEnum(a.Type),
+			Order:          a.Order,
+			TargetGroupArn: a.TargetGroupARN,
+		}
+		if c := a.RedirectConfig; c != nil {
+			res[i].RedirectConfig = &elbv2.RedirectActionConfig{
+				Host:       c.Host,
+				Path:       c.Path,
+				Port:       c.Port,
+				Protocol:   c.Protocol,
+				Query:      c.Query,
+				StatusCode: elbv2.RedirectActionStatusCodeEnum(c.StatusCode),
+			}
+		}
+		if c := a.FixedResponseConfig; c != nil {
+			res[i].FixedResponseConfig = &elbv2.FixedResponseActionConfig{
+				StatusCode:  aws.String(c.StatusCode),
+				ContentType: c.ContentType,
+				MessageBody: c.MessageBody,
+			}
+		}
+	}
+	return res
+}
+
+// GenerateFromActions returns the actions of the given elbv2.Actions.
+func GenerateFromActions(actions []elbv2.Action) []v1alpha1.Action {
+	res := make([]v1alpha1.Action, len(actions))
+	for i, a := range actions {
+		res[i] = v1alpha1.Action{
+			Type:           string(a.Type),
+			Order:          a.Order,
+			TargetGroupARN: a.TargetGroupArn,
+		}
+		// NOTE: Forward actions to a single target group are described with
+		// a forward config as well.
+		if res[i].TargetGroupARN == nil && a.ForwardConfig != nil && len(a.ForwardConfig.TargetGroups) == 1 {
+			res[i].TargetGroupARN = a.ForwardConfig.TargetGroups[0].TargetGroupArn
+		}
+		if c := a.RedirectConfig; c != nil {
+			res[i].RedirectConfig = &v1alpha1.RedirectConfig{
+				Host:       c.Host,
+				Path:       c.Path,
+				Port:       c.Port,
+				Protocol:   c.Protocol,
+				Query:      c.Query,
+				StatusCode: string(c.StatusCode),
+			}
+		}
+		if c := a.FixedResponseConfig; c != nil {
+			res[i].FixedResponseConfig = &v1alpha1.FixedResponseConfig{
+				StatusCode:  aws.StringValue(c.StatusCode),
+				ContentType: c.ContentType,
+				MessageBody: c.MessageBody,
+			}
+		}
+	}
+	return res
+}
+
+// normalizeActions returns a copy of the given actions with the values that
+// AWS fills in when they are not set, sorted by order.
+func normalizeActions(actions []v1alpha1.Action) []v1alpha1.Action {
+	res := make([]v1alpha1.Action, len(actions))
+	for i := range actions {
+		a := actions[i].DeepCopy()
+		if a.Order == nil {
+			a.Order = aws.Int64(int64(i + 1))
+		}
+		if c := a.RedirectConfig; c != nil {
+			c.Host = awsclients.LateInitializeStringPtr(c.Host, aws.String("#{host}"))
+			c.Path = awsclients.LateInitializeStringPtr(c.Path, aws.String("/#{path}"))
+			c.Port = awsclients.LateInitializeStringPtr(c.Port, aws.String("#{port}"))
+			c.Protocol = awsclients.LateInitializeStringPtr(c.Protocol, aws.String("#{protocol}"))
+			c.Query = awsclients.LateInitializeStringPtr(c.Query, aws.String("#{query}"))
+		}
+		res[i] = *a
+	}
+	sort.SliceStable(res, func(i, j int) bool { return aws.Int64Value(res[i].Order) < aws.Int64Value(res[j].Order) })
+	return res
+}
+
+// AreActionsUpToDate returns whether the observed elbv2.Actions match the
+// desired actions.
+func AreActionsUpToDate(desired []v1alpha1.Action, observed []elbv2.Action) bool {
+	return cmp.Equal(normalizeActions(desired), normalizeActions(GenerateFromActions(observed)),
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}))
+}
+
+// GenerateCreateListenerInput returns the input for a CreateListener request
+// built from the given parameters.
+func GenerateCreateListenerInput(p v1alpha1.ListenerParameters) *elbv2.CreateListenerInput {
+	in := &elbv2.CreateListenerInput{
+		LoadBalancerArn: p.LoadBalancerARN,
+		Port:            aws.Int64(p.Port),
+		Protocol:        elbv2.ProtocolEnum(p.Protocol),
+		SslPolicy:       p.SSLPolicy,
+		DefaultActions:  GenerateActions(p.DefaultActions),
+	}
+	if len(p.CertificateARNs) != 0 {
+		in.Certificates = []elbv2.Certificate{{CertificateArn: aws.String(p.CertificateARNs[0])}}
+	}
+	return in
+}
+
+// GenerateModifyListenerInput returns the input for a ModifyListener request
+// built from the given parameters.
+func GenerateModifyListenerInput(arn string, p v1alpha1.ListenerParameters) *elbv2.ModifyListenerInput {
+	c := GenerateCreateListenerInput(p)
+	return &elbv2.ModifyListenerInput{
+		ListenerArn:    aws.String(arn),
+		Port:           c.Port,
+		Protocol:       c.Protocol,
+		SslPolicy:      c.SslPolicy,
+		Certificates:   c.Certificates,
+		DefaultActions: c.DefaultActions,
+	}
+}
+
+// GenerateListenerObservation is used to produce
+// v1alpha1.ListenerObservation from elbv2.Listener.
+func GenerateListenerObservation(l elbv2.Listener) v1alpha1.ListenerObservation {
+	return v1alpha1.ListenerObservation{
+		ListenerARN: aws.StringValue(l.ListenerArn),
+	}
+}
+
+// LateInitializeListener fills the empty fields in
+// *v1alpha1.ListenerParameters with the values seen in elbv2.Listener.
+func LateInitializeListener(in *v1alpha1.ListenerParameters, l *elbv2.Listener) {
+	if l == nil {
+		return
+	}
+	in.SSLPolicy = awsclients.LateInitializeStringPtr(in.SSLPolicy, l.SslPolicy)
+}
+
+// DefaultCertificateARN returns the ARN of the default certificate of the
+// given elbv2.Listener.
+func DefaultCertificateARN(l elbv2.Listener) string {
+	for _, c := range l.Certificates {
+		if c.IsDefault == nil || aws.BoolValue(c.IsDefault) {
+			return aws.StringValue(c.CertificateArn)
+		}
+	}
+	return ""
+}
+
+// AdditionalCertificateARNs returns the ARNs of the given
+// elbv2.Certificates that are not the default certificate of a listener.
+func AdditionalCertificateARNs(certs []elbv2.Certificate) []string {
+	var res []string
+	for _, c := range certs {
+		if !aws.BoolValue(c.IsDefault) {
+			res = append(res, aws.StringValue(c.CertificateArn))
+		}
+	}
+	return res
+}
+
+// DiffListenerCertificates returns the ARNs of the additional certificates
+// that have to be added to and removed from a listener with the given
+// additional certificates.
+func DiffListenerCertificates(p v1alpha1.ListenerParameters, observed []string) (add, remove []string) {
+	var desired []string
+	if len(p.CertificateARNs) > 1 {
+		desired = p.CertificateARNs[1:]
+	}
+	d := make(map[string]bool, len(desired))
+	for _, c := range desired {
+		d[c] = true
+	}
+	o := make(map[string]bool, len(observed))
+	for _, c := range observed {
+		o[c] = true
+		if !d[c] {
+			remove = append(remove, c)
+		}
+	}
+	for _, c := range desired {
+		if !o[c] {
+			add = append(add, c)
+		}
+	}
+	return add, remove
+}
+
+// IsListenerConfigUpToDate returns true if the port, protocol, SSL policy,
+// default certificate and default actions of the listener match the desired
+// parameters.
+func IsListenerConfigUpToDate(p v1alpha1.ListenerParameters, l elbv2.Listener) bool {
+	if p.Port != aws.Int64Value(l.Port) || p.Protocol != string(l.Protocol) {
+		return false
+	}
+	if aws.StringValue(p.SSLPolicy) != aws.StringValue(l.SslPolicy) {
+		return false
+	}
+	defaultCert := ""
+	if len(p.CertificateARNs) != 0 {
+		defaultCert = p.CertificateARNs[0]
+	}
+	if defaultCert != DefaultCertificateARN(l) {
+		return false
+	}
+	return AreActionsUpToDate(p.DefaultActions, l.DefaultActions)
+}
+
+// IsListenerUpToDate returns true if the observed listener and its
+// additional certificates match the desired parameters.
+func IsListenerUpToDate(p v1alpha1.ListenerParameters, l elbv2.Listener, additionalCerts []string) bool {
+	if add, remove := DiffListenerCertificates(p, additionalCerts); len(add) != 0 || len(remove) != 0 {
+		return false
+	}
+	return IsListenerConfigUpToDate(p, l)
+}
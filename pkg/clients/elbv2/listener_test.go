@@ -0,0 +1,228 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+var (
+	tgARN    = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"
+	certARN1 = "arn:aws:acm:us-east-1:123456789012:certificate/1"
+	certARN2 = "arn:aws:acm:us-east-1:123456789012:certificate/2"
+	certARN3 = "arn:aws:acm:us-east-1:123456789012:certificate/3"
+)
+
+func TestAreActionsUpToDate(t *testing.T) {
+	type args struct {
+		desired  []v1alpha1.Action
+		observed []elasticloadbalancingv2.Action
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"ForwardWithDefaultOrder": {
+			args: args{
+				desired: []v1alpha1.Action{{
+					Type:           "forward",
+					TargetGroupARN: aws.String(tgARN),
+					TargetGroupARNRef: &runtimev1alpha1.Reference{
+						Name: "my-tg",
+					},
+				}},
+				observed: []elasticloadbalancingv2.Action{{
+					Type:  elasticloadbalancingv2.ActionTypeEnumForward,
+					Order: aws.Int64(1),
+					ForwardConfig: &elasticloadbalancingv2.ForwardActionConfig{
+						TargetGroups: []elasticloadbalancingv2.TargetGroupTuple{{TargetGroupArn: aws.String(tgARN)}},
+					},
+				}},
+			},
+			want: true,
+		},
+		"RedirectWithPlaceholders": {
+			args: args{
+				desired: []v1alpha1.Action{{
+					Type: "redirect",
+					RedirectConfig: &v1alpha1.RedirectConfig{
+						Port:       aws.String("443"),
+						Protocol:   aws.String("HTTPS"),
+						StatusCode: "HTTP_301",
+					},
+				}},
+				observed: []elasticloadbalancingv2.Action{{
+					Type:  elasticloadbalancingv2.ActionTypeEnumRedirect,
+					Order: aws.Int64(1),
+					RedirectConfig: &elasticloadbalancingv2.RedirectActionConfig{
+						Host:       aws.String("#{host}"),
+						Path:       aws.String("/#{path}"),
+						Port:       aws.String("443"),
+						Protocol:   aws.String("HTTPS"),
+						Query:      aws.String("#{query}"),
+						StatusCode: elasticloadbalancingv2.RedirectActionStatusCodeEnumHttp301,
+					},
+				}},
+			},
+			want: true,
+		},
+		"DifferentTargetGroup": {
+			args: args{
+				desired: []v1alpha1.Action{{
+					Type:           "forward",
+					TargetGroupARN: aws.String("other"),
+				}},
+				observed: []elasticloadbalancingv2.Action{{
+					Type:           elasticloadbalancingv2.ActionTypeEnumForward,
+					Order:          aws.Int64(1),
+					TargetGroupArn: aws.String(tgARN),
+				}},
+			},
+			want: false,
+		},
+		"FixedResponseStatusChanged": {
+			args: args{
+				desired: []v1alpha1.Action{{
+					Type:                "fixed-response",
+					FixedResponseConfig: &v1alpha1.FixedResponseConfig{StatusCode: "404"},
+				}},
+				observed: []elasticloadbalancingv2.Action{{
+					Type:                elasticloadbalancingv2.ActionTypeEnumFixedResponse,
+					Order:               aws.Int64(1),
+					FixedResponseConfig: &elasticloadbalancingv2.FixedResponseActionConfig{StatusCode: aws.String("503")},
+				}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := AreActionsUpToDate(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffListenerCertificates(t *testing.T) {
+	type want struct {
+		add    []string
+		remove []string
+	}
+
+	cases := map[string]struct {
+		params   v1alpha1.ListenerParameters
+		observed []string
+		want
+	}{
+		"NoAdditionalCertificates": {
+			params: v1alpha1.ListenerParameters{CertificateARNs: []string{certARN1}},
+		},
+		"UpToDate": {
+			params:   v1alpha1.ListenerParameters{CertificateARNs: []string{certARN1, certARN2}},
+			observed: []string{certARN2},
+		},
+		"AddAndRemove": {
+			params:   v1alpha1.ListenerParameters{CertificateARNs: []string{certARN1, certARN2}},
+			observed: []string{certARN3},
+			want: want{
+				add:    []string{certARN2},
+				remove: []string{certARN3},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffListenerCertificates(tc.params, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsListenerUpToDate(t *testing.T) {
+	listener := elasticloadbalancingv2.Listener{
+		Port:      aws.Int64(443),
+		Protocol:  elasticloadbalancingv2.ProtocolEnumHttps,
+		SslPolicy: aws.String("ELBSecurityPolicy-2016-08"),
+		Certificates: []elasticloadbalancingv2.Certificate{
+			{CertificateArn: aws.String(certARN1)},
+		},
+		DefaultActions: []elasticloadbalancingv2.Action{{
+			Type:           elasticloadbalancingv2.ActionTypeEnumForward,
+			Order:          aws.Int64(1),
+			TargetGroupArn: aws.String(tgARN),
+		}},
+	}
+	params := func(certs ...string) v1alpha1.ListenerParameters {
+		return v1alpha1.ListenerParameters{
+			Port:            443,
+			Protocol:        "HTTPS",
+			SSLPolicy:       aws.String("ELBSecurityPolicy-2016-08"),
+			CertificateARNs: certs,
+			DefaultActions: []v1alpha1.Action{{
+				Type:           "forward",
+				TargetGroupARN: aws.String(tgARN),
+			}},
+		}
+	}
+
+	cases := map[string]struct {
+		params          v1alpha1.ListenerParameters
+		additionalCerts []string
+		want            bool
+	}{
+		"UpToDate": {
+			params:          params(certARN1, certARN2),
+			additionalCerts: []string{certARN2},
+			want:            true,
+		},
+		"DefaultCertificateChanged": {
+			params: params(certARN2),
+			want:   false,
+		},
+		"AdditionalCertificateMissing": {
+			params: params(certARN1, certARN2),
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsListenerUpToDate(tc.params, listener, tc.additionalCerts)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
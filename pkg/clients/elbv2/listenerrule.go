@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+// Fields of the conditions of a ListenerRule.
+const (
+	ConditionFieldHostHeader        = "host-header"
+	ConditionFieldPathPattern       = "path-pattern"
+	ConditionFieldHTTPHeader        = "http-header"
+	ConditionFieldHTTPRequestMethod = "http-request-method"
+	ConditionFieldSourceIP          = "source-ip"
+)
+
+// ListenerRuleClient is the external client used for ListenerRule Custom
+// Resource
+type ListenerRuleClient interface {
+	DescribeRulesRequest(input *elbv2.DescribeRulesInput) elbv2.DescribeRulesRequest
+	CreateRuleRequest(input *elbv2.CreateRuleInput) elbv2.CreateRuleRequest
+	ModifyRuleRequest(input *elbv2.ModifyRuleInput) elbv2.ModifyRuleRequest
+	SetRulePrioritiesRequest(input *elbv2.SetRulePrioritiesInput) elbv2.SetRulePrioritiesRequest
+	DeleteRuleRequest(input *elbv2.DeleteRuleInput) elbv2.DeleteRuleRequest
+}
+
+// NewListenerRuleClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewListenerRuleClient(cfg aws.Config) ListenerRuleClient {
+	return elbv2.New(cfg)
+}
+
+// IsListenerRuleNotFoundErr returns true if the error is because the item
+// doesn't exist
+func IsListenerRuleNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == elbv2.ErrCodeRuleNotFoundException {
+		return true
+	}
+	return false
+}
+
+// GenerateConditions returns the elbv2.RuleConditions of the given
+// conditions.
+func GenerateConditions(conditions []v1alpha1.RuleCondition) []elbv2.RuleCondition {
+	res := make([]elbv2.RuleCondition, len(conditions))
+	for i, c := range conditions {
+		res[i] = elbv2.RuleCondition{Field: aws.String(c.Field)}
+		switch c.Field {
+		case ConditionFieldHostHeader:
+			res[i].HostHeaderConfig = &elbv2.HostHeaderConditionConfig{Values: c.Values}
+		case ConditionFieldPathPattern:
+			res[i].PathPatternConfig = &elbv2.PathPatternConditionConfig{Values: c.Values}
+		case ConditionFieldHTTPHeader:
+			res[i].HttpHeaderConfig = &elbv2.HttpHeaderConditionConfig{HttpHeaderName: c.HTTPHeaderName, Values: c.Values}
+		case ConditionFieldHTTPRequestMethod:
+			res[i].HttpRequestMethodConfig = &elbv2.HttpRequestMethodConditionConfig{Values: c.Values}
+		case ConditionFieldSourceIP:
+			res[i].SourceIpConfig = &elbv2.SourceIpConditionConfig{Values: c.Values}
+		}
+	}
+	return res
+}
+
+// GenerateFromConditions returns the conditions of the given
+// elbv2.RuleConditions.
+func GenerateFromConditions(conditions []elbv2.RuleCondition) []v1alpha1.RuleCondition {
+	res := make([]v1alpha1.RuleCondition, len(conditions))
+	for i, c := range conditions {
+		// NOTE: Host header and path pattern conditions may only be described
+		// with the legacy values field.
+		res[i] = v1alpha1.RuleCondition{Field: aws.StringValue(c.Field), Values: c.Values}
+		switch {
+		case c.HostHeaderConfig != nil:
+			res[i].Values = c.HostHeaderConfig.Values
+		case c.PathPatternConfig != nil:
+			res[i].Values = c.PathPatternConfig.Values
+		case c.HttpHeaderConfig != nil:
+			res[i].HTTPHeaderName = c.HttpHeaderConfig.HttpHeaderName
+			res[i].Values = c.HttpHeaderConfig.Values
+		case c.HttpRequestMethodConfig != nil:
+			res[i].Values = c.HttpRequestMethodConfig.Values
+		case c.SourceIpConfig != nil:
+			res[i].Values = c.SourceIpConfig.Values
+		}
+	}
+	return res
+}
+
+// GenerateCreateListenerRuleInput returns the input for a CreateRule request
+// built from the given parameters.
+func GenerateCreateListenerRuleInput(p v1alpha1.ListenerRuleParameters) *elbv2.CreateRuleInput {
+	return &elbv2.CreateRuleInput{
+		ListenerArn: p.ListenerARN,
+		Priority:    aws.Int64(p.Priority),
+		Conditions:  GenerateConditions(p.Conditions),
+		Actions:     GenerateActions(p.Actions),
+	}
+}
+
+// GenerateListenerRuleObservation is used to produce
+// v1alpha1.ListenerRuleObservation from elbv2.Rule.
+func GenerateListenerRuleObservation(r elbv2.Rule) v1alpha1.ListenerRuleObservation {
+	return v1alpha1.ListenerRuleObservation{
+		RuleARN: aws.StringValue(r.RuleArn),
+	}
+}
+
+// IsListenerRulePriorityUpToDate checks whether the priority of the given
+// elbv2.Rule matches the desired parameters.
+func IsListenerRulePriorityUpToDate(p v1alpha1.ListenerRuleParameters, r elbv2.Rule) bool {
+	return strconv.FormatInt(p.Priority, 10) == aws.StringValue(r.Priority)
+}
+
+// AreListenerRuleConditionsUpToDate checks whether the conditions of the
+// given elbv2.Rule match the desired parameters.
+func AreListenerRuleConditionsUpToDate(p v1alpha1.ListenerRuleParameters, r elbv2.Rule) bool {
+	return cmp.Equal(p.Conditions, GenerateFromConditions(r.Conditions), cmpopts.EquateEmpty())
+}
+
+// IsListenerRuleUpToDate checks whether the given elbv2.Rule matches the
+// desired parameters.
+func IsListenerRuleUpToDate(p v1alpha1.ListenerRuleParameters, r elbv2.Rule) bool {
+	return IsListenerRulePriorityUpToDate(p, r) &&
+		AreListenerRuleConditionsUpToDate(p, r) &&
+		AreActionsUpToDate(p.Actions, r.Actions)
+}
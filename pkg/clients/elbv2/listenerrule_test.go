@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+func TestIsListenerRuleUpToDate(t *testing.T) {
+	rule := elasticloadbalancingv2.Rule{
+		Priority: aws.String("10"),
+		Conditions: []elasticloadbalancingv2.RuleCondition{
+			{
+				Field:  aws.String(ConditionFieldHostHeader),
+				Values: []string{"example.com"},
+			},
+			{
+				Field: aws.String(ConditionFieldHTTPHeader),
+				HttpHeaderConfig: &elasticloadbalancingv2.HttpHeaderConditionConfig{
+					HttpHeaderName: aws.String("X-Env"),
+					Values:         []string{"staging"},
+				},
+			},
+		},
+		Actions: []elasticloadbalancingv2.Action{{
+			Type:           elasticloadbalancingv2.ActionTypeEnumForward,
+			Order:          aws.Int64(1),
+			TargetGroupArn: aws.String(tgARN),
+		}},
+	}
+	params := func(priority int64, host string) v1alpha1.ListenerRuleParameters {
+		return v1alpha1.ListenerRuleParameters{
+			Priority: priority,
+			Conditions: []v1alpha1.RuleCondition{
+				{Field: ConditionFieldHostHeader, Values: []string{host}},
+				{Field: ConditionFieldHTTPHeader, HTTPHeaderName: aws.String("X-Env"), Values: []string{"staging"}},
+			},
+			Actions: []v1alpha1.Action{{
+				Type:           "forward",
+				TargetGroupARN: aws.String(tgARN),
+			}},
+		}
+	}
+
+	cases := map[string]struct {
+		params v1alpha1.ListenerRuleParameters
+		want   bool
+	}{
+		"UpToDate": {
+			params: params(10, "example.com"),
+			want:   true,
+		},
+		"PriorityChanged": {
+			params: params(20, "example.com"),
+			want:   false,
+		},
+		"ConditionChanged": {
+			params: params(10, "example.org"),
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsListenerRuleUpToDate(tc.params, rule)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// LoadBalancerClient is the external client used for LoadBalancer Custom
+// Resource
+type LoadBalancerClient interface {
+	DescribeLoadBalancersRequest(input *elbv2.DescribeLoadBalancersInput) elbv2.DescribeLoadBalancersRequest
+	CreateLoadBalancerRequest(input *elbv2.CreateLoadBalancerInput) elbv2.CreateLoadBalancerRequest
+	DeleteLoadBalancerRequest(input *elbv2.DeleteLoadBalancerInput) elbv2.DeleteLoadBalancerRequest
+	SetSecurityGroupsRequest(input *elbv2.SetSecurityGroupsInput) elbv2.SetSecurityGroupsRequest
+	SetSubnetsRequest(input *elbv2.SetSubnetsInput) elbv2.SetSubnetsRequest
+	SetIpAddressTypeRequest(input *elbv2.SetIpAddressTypeInput) elbv2.SetIpAddressTypeRequest
+	DescribeTagsRequest(input *elbv2.DescribeTagsInput) elbv2.DescribeTagsRequest
+	AddTagsRequest(input *elbv2.AddTagsInput) elbv2.AddTagsRequest
+	RemoveTagsRequest(input *elbv2.RemoveTagsInput) elbv2.RemoveTagsRequest
+}
+
+// NewLoadBalancerClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewLoadBalancerClient(cfg aws.Config) LoadBalancerClient {
+	return elbv2.New(cfg)
+}
+
+// IsLoadBalancerNotFoundErr returns true if the error is because the item
+// doesn't exist
+func IsLoadBalancerNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == elbv2.ErrCodeLoadBalancerNotFoundException {
+		return true
+	}
+	return false
+}
+
+// GenerateCreateLoadBalancerInput returns the input for a CreateLoadBalancer
+// request built from the given parameters.
+func GenerateCreateLoadBalancerInput(p v1alpha1.LoadBalancerParameters) *elbv2.CreateLoadBalancerInput {
+	in := &elbv2.CreateLoadBalancerInput{
+		Name:           aws.String(p.Name),
+		Type:           elbv2.LoadBalancerTypeEnum(aws.StringValue(p.Type)),
+		Scheme:         elbv2.LoadBalancerSchemeEnum(aws.StringValue(p.Scheme)),
+		IpAddressType:  elbv2.IpAddressType(aws.StringValue(p.IPAddressType)),
+		Subnets:        p.SubnetIDs,
+		SecurityGroups: p.SecurityGroupIDs,
+		Tags:           BuildTags(p.Tags),
+	}
+	for _, m := range p.SubnetMappings {
+		in.SubnetMappings = append(in.SubnetMappings, elbv2.SubnetMapping{
+			SubnetId:           aws.String(m.SubnetID),
+			AllocationId:       m.AllocationID,
+			PrivateIPv4Address: m.PrivateIPv4Address,
+		})
+	}
+	return in
+}
+
+// GenerateLoadBalancerObservation is used to produce
+// v1alpha1.LoadBalancerObservation from elbv2.LoadBalancer.
+func GenerateLoadBalancerObservation(lb elbv2.LoadBalancer) v1alpha1.LoadBalancerObservation {
+	o := v1alpha1.LoadBalancerObservation{
+		LoadBalancerARN:       aws.StringValue(lb.LoadBalancerArn),
+		DNSName:               aws.StringValue(lb.DNSName),
+		CanonicalHostedZoneID: aws.StringValue(lb.CanonicalHostedZoneId),
+		VPCID:                 aws.StringValue(lb.VpcId),
+	}
+	if lb.State != nil {
+		o.State = string(lb.State.Code)
+	}
+	return o
+}
+
+// LoadBalancerSubnetIDs returns the IDs of the subnets of the given
+// elbv2.LoadBalancer.
+func LoadBalancerSubnetIDs(lb elbv2.LoadBalancer) []string {
+	ids := make([]string, 0, len(lb.AvailabilityZones))
+	for _, az := range lb.AvailabilityZones {
+		ids = append(ids, aws.StringValue(az.SubnetId))
+	}
+	return ids
+}
+
+// LateInitializeLoadBalancer fills the empty fields in
+// *v1alpha1.LoadBalancerParameters with the values seen in
+// elbv2.LoadBalancer.
+func LateInitializeLoadBalancer(in *v1alpha1.LoadBalancerParameters, lb *elbv2.LoadBalancer, tags []elbv2.Tag) {
+	if lb == nil {
+		return
+	}
+
+	in.Type = awsclients.LateInitializeStringPtr(in.Type, awsclients.String(string(lb.Type)))
+	in.Scheme = awsclients.LateInitializeStringPtr(in.Scheme, awsclients.String(string(lb.Scheme)))
+	in.IPAddressType = awsclients.LateInitializeStringPtr(in.IPAddressType, awsclients.String(string(lb.IpAddressType)))
+
+	if len(in.SubnetIDs) == 0 && len(in.SubnetMappings) == 0 && len(lb.AvailabilityZones) != 0 {
+		in.SubnetIDs = LoadBalancerSubnetIDs(*lb)
+	}
+	if len(in.SecurityGroupIDs) == 0 && len(lb.SecurityGroups) != 0 {
+		in.SecurityGroupIDs = lb.SecurityGroups
+	}
+	if len(in.Tags) == 0 && len(tags) != 0 {
+		in.Tags = BuildFromTags(tags)
+	}
+}
+
+// AreLoadBalancerSubnetsUpToDate checks whether the subnets of the given
+// elbv2.LoadBalancer match the desired parameters. Subnet mappings cannot be
+// changed and are not compared.
+func AreLoadBalancerSubnetsUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer) bool {
+	return len(p.SubnetIDs) == 0 || sameStrings(p.SubnetIDs, LoadBalancerSubnetIDs(lb))
+}
+
+// AreLoadBalancerSecurityGroupsUpToDate checks whether the security groups
+// of the given elbv2.LoadBalancer match the desired parameters.
+func AreLoadBalancerSecurityGroupsUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer) bool {
+	return sameStrings(p.SecurityGroupIDs, lb.SecurityGroups)
+}
+
+// IsLoadBalancerUpToDate checks whether the subnets, security groups, IP
+// address type and tags of the given elbv2.LoadBalancer match the desired
+// parameters.
+func IsLoadBalancerUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer, tags []elbv2.Tag) bool {
+	return AreLoadBalancerSubnetsUpToDate(p, lb) &&
+		AreLoadBalancerSecurityGroupsUpToDate(p, lb) &&
+		aws.StringValue(p.IPAddressType) == string(lb.IpAddressType) &&
+		AreTagsUpToDate(p.Tags, tags)
+}
+
+// GetLoadBalancerConnectionDetails returns the connection details of the
+// given LoadBalancer, i.e. its DNS name.
+func GetLoadBalancerConnectionDetails(cr v1alpha1.LoadBalancer) managed.ConnectionDetails {
+	if cr.Status.AtProvider.DNSName == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.DNSName),
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// TargetGroupClient is the external client used for TargetGroup Custom
+// Resource
+type TargetGroupClient interface {
+	DescribeTargetGroupsRequest(input *elbv2.DescribeTargetGroupsInput) elbv2.DescribeTargetGroupsRequest
+	CreateTargetGroupRequest(input *elbv2.CreateTargetGroupInput) elbv2.CreateTargetGroupRequest
+	ModifyTargetGroupRequest(input *elbv2.ModifyTargetGroupInput) elbv2.ModifyTargetGroupRequest
+	DeleteTargetGroupRequest(input *elbv2.DeleteTargetGroupInput) elbv2.DeleteTargetGroupRequest
+	DescribeTagsRequest(input *elbv2.DescribeTagsInput) elbv2.DescribeTagsRequest
+	AddTagsRequest(input *elbv2.AddTagsInput) elbv2.AddTagsRequest
+	RemoveTagsRequest(input *elbv2.RemoveTagsInput) elbv2.RemoveTagsRequest
+}
+
+// NewTargetGroupClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewTargetGroupClient(cfg aws.Config) TargetGroupClient {
+	return elbv2.New(cfg)
+}
+
+// IsTargetGroupNotFoundErr returns true if the error is because the item
+// doesn't exist
+func IsTargetGroupNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == elbv2.ErrCodeTargetGroupNotFoundException {
+		return true
+	}
+	return false
+}
+
+// GenerateCreateTargetGroupInput returns the input for a CreateTargetGroup
+// request built from the given parameters.
+func GenerateCreateTargetGroupInput(p v1alpha1.TargetGroupParameters) *elbv2.CreateTargetGroupInput {
+	in := &elbv2.CreateTargetGroupInput{
+		Name:       aws.String(p.Name),
+		TargetType: elbv2.TargetTypeEnum(aws.StringValue(p.TargetType)),
+		Protocol:   elbv2.ProtocolEnum(aws.StringValue(p.Protocol)),
+		Port:       p.Port,
+		VpcId:      p.VPCID,
+	}
+	if hc := p.HealthCheck; hc != nil {
+		in.HealthCheckEnabled = hc.Enabled
+		in.HealthCheckIntervalSeconds = hc.IntervalSeconds
+		in.HealthCheckPath = hc.Path
+		in.HealthCheckPort = hc.Port
+		in.HealthCheckProtocol = elbv2.ProtocolEnum(aws.StringValue(hc.Protocol))
+		in.HealthCheckTimeoutSeconds = hc.TimeoutSeconds
+		in.HealthyThresholdCount = hc.HealthyThresholdCount
+		in.UnhealthyThresholdCount = hc.UnhealthyThresholdCount
+		if hc.Matcher != nil {
+			in.Matcher = &elbv2.Matcher{HttpCode: hc.Matcher}
+		}
+	}
+	return in
+}
+
+// GenerateModifyTargetGroupInput returns the input for a ModifyTargetGroup
+// request that applies the health check of the given parameters.
+func GenerateModifyTargetGroupInput(arn string, p v1alpha1.TargetGroupParameters) *elbv2.ModifyTargetGroupInput {
+	in := &elbv2.ModifyTargetGroupInput{TargetGroupArn: aws.String(arn)}
+	if hc := p.HealthCheck; hc != nil {
+		in.HealthCheckEnabled = hc.Enabled
+		in.HealthCheckIntervalSeconds = hc.IntervalSeconds
+		in.HealthCheckPath = hc.Path
+		in.HealthCheckPort = hc.Port
+		in.HealthCheckProtocol = elbv2.ProtocolEnum(aws.StringValue(hc.Protocol))
+		in.HealthCheckTimeoutSeconds = hc.TimeoutSeconds
+		in.HealthyThresholdCount = hc.HealthyThresholdCount
+		in.UnhealthyThresholdCount = hc.UnhealthyThresholdCount
+		if hc.Matcher != nil {
+			in.Matcher = &elbv2.Matcher{HttpCode: hc.Matcher}
+		}
+	}
+	return in
+}
+
+// GenerateTargetGroupObservation is used to produce
+// v1alpha1.TargetGroupObservation from elbv2.TargetGroup.
+func GenerateTargetGroupObservation(tg elbv2.TargetGroup) v1alpha1.TargetGroupObservation {
+	return v1alpha1.TargetGroupObservation{
+		TargetGroupARN:   aws.StringValue(tg.TargetGroupArn),
+		LoadBalancerARNs: tg.LoadBalancerArns,
+	}
+}
+
+// GenerateHealthCheck returns the health check of the given
+// elbv2.TargetGroup.
+func GenerateHealthCheck(tg elbv2.TargetGroup) v1alpha1.HealthCheck {
+	hc := v1alpha1.HealthCheck{
+		Enabled:                 tg.HealthCheckEnabled,
+		IntervalSeconds:         tg.HealthCheckIntervalSeconds,
+		Path:                    tg.HealthCheckPath,
+		Port:                    tg.HealthCheckPort,
+		Protocol:                awsclients.String(string(tg.HealthCheckProtocol)),
+		TimeoutSeconds:          tg.HealthCheckTimeoutSeconds,
+		HealthyThresholdCount:   tg.HealthyThresholdCount,
+		UnhealthyThresholdCount: tg.UnhealthyThresholdCount,
+	}
+	if tg.Matcher != nil {
+		hc.Matcher = tg.Matcher.HttpCode
+	}
+	return hc
+}
+
+// LateInitializeTargetGroup fills the empty fields in
+// *v1alpha1.TargetGroupParameters with the values seen in elbv2.TargetGroup.
+func LateInitializeTargetGroup(in *v1alpha1.TargetGroupParameters, tg *elbv2.TargetGroup, tags []elbv2.Tag) {
+	if tg == nil {
+		return
+	}
+
+	in.TargetType = awsclients.LateInitializeStringPtr(in.TargetType, awsclients.String(string(tg.TargetType)))
+	in.Protocol = awsclients.LateInitializeStringPtr(in.Protocol, awsclients.String(string(tg.Protocol)))
+	in.Port = awsclients.LateInitializeInt64Ptr(in.Port, tg.Port)
+	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, tg.VpcId)
+
+	if in.HealthCheck == nil {
+		in.HealthCheck = &v1alpha1.HealthCheck{}
+	}
+	o := GenerateHealthCheck(*tg)
+	hc := in.HealthCheck
+	hc.Enabled = awsclients.LateInitializeBoolPtr(hc.Enabled, o.Enabled)
+	hc.IntervalSeconds = awsclients.LateInitializeInt64Ptr(hc.IntervalSeconds, o.IntervalSeconds)
+	hc.Path = awsclients.LateInitializeStringPtr(hc.Path, o.Path)
+	hc.Port = awsclients.LateInitializeStringPtr(hc.Port, o.Port)
+	hc.Protocol = awsclients.LateInitializeStringPtr(hc.Protocol, o.Protocol)
+	hc.TimeoutSeconds = awsclients.LateInitializeInt64Ptr(hc.TimeoutSeconds, o.TimeoutSeconds)
+	hc.HealthyThresholdCount = awsclients.LateInitializeInt64Ptr(hc.HealthyThresholdCount, o.HealthyThresholdCount)
+	hc.UnhealthyThresholdCount = awsclients.LateInitializeInt64Ptr(hc.UnhealthyThresholdCount, o.UnhealthyThresholdCount)
+	hc.Matcher = awsclients.LateInitializeStringPtr(hc.Matcher, o.Matcher)
+
+	if len(in.Tags) == 0 && len(tags) != 0 {
+		in.Tags = BuildFromTags(tags)
+	}
+}
+
+// IsTargetGroupHealthCheckUpToDate checks whether the health check of the
+// given elbv2.TargetGroup matches the desired parameters.
+func IsTargetGroupHealthCheckUpToDate(p v1alpha1.TargetGroupParameters, tg elbv2.TargetGroup) bool {
+	if p.HealthCheck == nil {
+		return true
+	}
+	return cmp.Equal(*p.HealthCheck, GenerateHealthCheck(tg))
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+func targetGroup() elasticloadbalancingv2.TargetGroup {
+	return elasticloadbalancingv2.TargetGroup{
+		TargetGroupArn:             aws.String(tgARN),
+		TargetType:                 elasticloadbalancingv2.TargetTypeEnumIp,
+		Protocol:                   elasticloadbalancingv2.ProtocolEnumHttp,
+		Port:                       aws.Int64(80),
+		VpcId:                      aws.String("vpc-1"),
+		HealthCheckEnabled:         aws.Bool(true),
+		HealthCheckIntervalSeconds: aws.Int64(30),
+		HealthCheckPath:            aws.String("/"),
+		HealthCheckPort:            aws.String("traffic-port"),
+		HealthCheckProtocol:        elasticloadbalancingv2.ProtocolEnumHttp,
+		HealthCheckTimeoutSeconds:  aws.Int64(5),
+		HealthyThresholdCount:      aws.Int64(5),
+		UnhealthyThresholdCount:    aws.Int64(2),
+		Matcher:                    &elasticloadbalancingv2.Matcher{HttpCode: aws.String("200")},
+	}
+}
+
+func healthCheck() *v1alpha1.HealthCheck {
+	return &v1alpha1.HealthCheck{
+		Enabled:                 aws.Bool(true),
+		IntervalSeconds:         aws.Int64(30),
+		Path:                    aws.String("/"),
+		Port:                    aws.String("traffic-port"),
+		Protocol:                aws.String("HTTP"),
+		TimeoutSeconds:          aws.Int64(5),
+		HealthyThresholdCount:   aws.Int64(5),
+		UnhealthyThresholdCount: aws.Int64(2),
+		Matcher:                 aws.String("200"),
+	}
+}
+
+func TestLateInitializeTargetGroup(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.TargetGroupParameters
+		tg   *elasticloadbalancingv2.TargetGroup
+		tags []elasticloadbalancingv2.Tag
+	}
+
+	tg := targetGroup()
+	path := healthCheck()
+	path.Path = aws.String("/healthz")
+
+	cases := map[string]struct {
+		args
+		want *v1alpha1.TargetGroupParameters
+	}{
+		"AllFilledNoDiff": {
+			args: args{
+				spec: &v1alpha1.TargetGroupParameters{
+					TargetType:  aws.String("ip"),
+					Protocol:    aws.String("HTTP"),
+					Port:        aws.Int64(80),
+					VPCID:       aws.String("vpc-1"),
+					HealthCheck: path,
+				},
+				tg: &tg,
+			},
+			want: &v1alpha1.TargetGroupParameters{
+				TargetType:  aws.String("ip"),
+				Protocol:    aws.String("HTTP"),
+				Port:        aws.Int64(80),
+				VPCID:       aws.String("vpc-1"),
+				HealthCheck: path,
+			},
+		},
+		"AllUnfilled": {
+			args: args{
+				spec: &v1alpha1.TargetGroupParameters{},
+				tg:   &tg,
+				tags: []elasticloadbalancingv2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			},
+			want: &v1alpha1.TargetGroupParameters{
+				TargetType:  aws.String("ip"),
+				Protocol:    aws.String("HTTP"),
+				Port:        aws.Int64(80),
+				VPCID:       aws.String("vpc-1"),
+				HealthCheck: healthCheck(),
+				Tags:        []v1alpha1.Tag{{Key: "k", Value: aws.String("v")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeTargetGroup(tc.args.spec, tc.args.tg, tc.args.tags)
+			if diff := cmp.Diff(tc.want, tc.args.spec); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTargetGroupHealthCheckUpToDate(t *testing.T) {
+	path := healthCheck()
+	path.Path = aws.String("/healthz")
+
+	cases := map[string]struct {
+		params v1alpha1.TargetGroupParameters
+		want   bool
+	}{
+		"NoHealthCheck": {
+			params: v1alpha1.TargetGroupParameters{},
+			want:   true,
+		},
+		"UpToDate": {
+			params: v1alpha1.TargetGroupParameters{HealthCheck: healthCheck()},
+			want:   true,
+		},
+		"PathChanged": {
+			params: v1alpha1.TargetGroupParameters{HealthCheck: path},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTargetGroupHealthCheckUpToDate(tc.params, targetGroup())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
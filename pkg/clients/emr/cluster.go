@@ -0,0 +1,365 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package emr
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+
+	"github.com/crossplane/provider-aws/apis/emr/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ClusterClient is the external client used for Cluster Custom Resource
+type ClusterClient interface {
+	RunJobFlowRequest(*emr.RunJobFlowInput) emr.RunJobFlowRequest
+	DescribeClusterRequest(*emr.DescribeClusterInput) emr.DescribeClusterRequest
+	TerminateJobFlowsRequest(*emr.TerminateJobFlowsInput) emr.TerminateJobFlowsRequest
+	SetTerminationProtectionRequest(*emr.SetTerminationProtectionInput) emr.SetTerminationProtectionRequest
+	SetVisibleToAllUsersRequest(*emr.SetVisibleToAllUsersInput) emr.SetVisibleToAllUsersRequest
+	ModifyClusterRequest(*emr.ModifyClusterInput) emr.ModifyClusterRequest
+	AddTagsRequest(*emr.AddTagsInput) emr.AddTagsRequest
+	RemoveTagsRequest(*emr.RemoveTagsInput) emr.RemoveTagsRequest
+	ListInstanceGroupsRequest(*emr.ListInstanceGroupsInput) emr.ListInstanceGroupsRequest
+	ModifyInstanceGroupsRequest(*emr.ModifyInstanceGroupsInput) emr.ModifyInstanceGroupsRequest
+	ListInstanceFleetsRequest(*emr.ListInstanceFleetsInput) emr.ListInstanceFleetsRequest
+	ModifyInstanceFleetRequest(*emr.ModifyInstanceFleetInput) emr.ModifyInstanceFleetRequest
+	GetManagedScalingPolicyRequest(*emr.GetManagedScalingPolicyInput) emr.GetManagedScalingPolicyRequest
+	PutManagedScalingPolicyRequest(*emr.PutManagedScalingPolicyInput) emr.PutManagedScalingPolicyRequest
+	RemoveManagedScalingPolicyRequest(*emr.RemoveManagedScalingPolicyInput) emr.RemoveManagedScalingPolicyRequest
+}
+
+// NewClusterClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewClusterClient(cfg aws.Config) ClusterClient {
+	return emr.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the cluster doesn't exist.
+// EMR reports unknown cluster IDs as invalid requests.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == emr.ErrCodeInvalidRequestException &&
+		strings.Contains(awsErr.Message(), "is not valid") {
+		return true
+	}
+	return false
+}
+
+// IsTerminated returns whether the cluster in the given state has terminated.
+func IsTerminated(state emr.ClusterState) bool {
+	return state == emr.ClusterStateTerminated || state == emr.ClusterStateTerminatedWithErrors
+}
+
+// IsKeepAlive returns whether the cluster keeps running when it has no steps
+// left.
+func IsKeepAlive(p v1alpha1.ClusterParameters) bool {
+	return aws.BoolValue(p.Instances.KeepJobFlowAliveWhenNoSteps)
+}
+
+func generateConfigurations(cfgs []v1alpha1.Configuration) []emr.Configuration {
+	if len(cfgs) == 0 {
+		return nil
+	}
+	res := make([]emr.Configuration, len(cfgs))
+	for i, c := range cfgs {
+		res[i] = emr.Configuration{Classification: aws.String(c.Classification), Properties: c.Properties}
+	}
+	return res
+}
+
+func generateEBSConfiguration(c *v1alpha1.EBSConfiguration) *emr.EbsConfiguration {
+	if c == nil {
+		return nil
+	}
+	res := &emr.EbsConfiguration{EbsOptimized: c.EBSOptimized}
+	for _, v := range c.Volumes {
+		res.EbsBlockDeviceConfigs = append(res.EbsBlockDeviceConfigs, emr.EbsBlockDeviceConfig{
+			VolumeSpecification: &emr.VolumeSpecification{
+				VolumeType: aws.String(v.VolumeType),
+				SizeInGB:   aws.Int64(v.SizeInGB),
+				Iops:       v.IOPS,
+			},
+			VolumesPerInstance: v.VolumesPerInstance,
+		})
+	}
+	return res
+}
+
+func generateInstances(p v1alpha1.ClusterInstances) *emr.JobFlowInstancesConfig { // nolint:gocyclo
+	res := &emr.JobFlowInstancesConfig{
+		Ec2SubnetId:                    p.EC2SubnetID,
+		Ec2SubnetIds:                   p.EC2SubnetIDs,
+		Ec2KeyName:                     p.EC2KeyName,
+		EmrManagedMasterSecurityGroup:  p.EMRManagedMasterSecurityGroup,
+		EmrManagedSlaveSecurityGroup:   p.EMRManagedSlaveSecurityGroup,
+		ServiceAccessSecurityGroup:     p.ServiceAccessSecurityGroup,
+		AdditionalMasterSecurityGroups: p.AdditionalMasterSecurityGroups,
+		AdditionalSlaveSecurityGroups:  p.AdditionalSlaveSecurityGroups,
+		KeepJobFlowAliveWhenNoSteps:    aws.Bool(aws.BoolValue(p.KeepJobFlowAliveWhenNoSteps)),
+		TerminationProtected:           p.TerminationProtected,
+	}
+	for _, g := range p.InstanceGroups {
+		res.InstanceGroups = append(res.InstanceGroups, emr.InstanceGroupConfig{
+			Name:             g.Name,
+			InstanceRole:     emr.InstanceRoleType(g.InstanceRole),
+			InstanceType:     aws.String(g.InstanceType),
+			InstanceCount:    aws.Int64(g.InstanceCount),
+			Market:           emr.MarketType(aws.StringValue(g.Market)),
+			BidPrice:         g.BidPrice,
+			EbsConfiguration: generateEBSConfiguration(g.EBSConfiguration),
+		})
+	}
+	for _, f := range p.InstanceFleets {
+		fleet := emr.InstanceFleetConfig{
+			Name:                   f.Name,
+			InstanceFleetType:      emr.InstanceFleetType(f.InstanceFleetType),
+			TargetOnDemandCapacity: f.TargetOnDemandCapacity,
+			TargetSpotCapacity:     f.TargetSpotCapacity,
+		}
+		for _, t := range f.InstanceTypeConfigs {
+			fleet.InstanceTypeConfigs = append(fleet.InstanceTypeConfigs, emr.InstanceTypeConfig{
+				InstanceType:     aws.String(t.InstanceType),
+				WeightedCapacity: t.WeightedCapacity,
+				BidPrice:         t.BidPrice,
+				EbsConfiguration: generateEBSConfiguration(t.EBSConfiguration),
+			})
+		}
+		if s := f.SpotProvisioning; s != nil {
+			fleet.LaunchSpecifications = &emr.InstanceFleetProvisioningSpecifications{
+				SpotSpecification: &emr.SpotProvisioningSpecification{
+					TimeoutDurationMinutes: aws.Int64(s.TimeoutDurationMinutes),
+					TimeoutAction:          emr.SpotProvisioningTimeoutAction(s.TimeoutAction),
+					BlockDurationMinutes:   s.BlockDurationMinutes,
+				},
+			}
+		}
+		res.InstanceFleets = append(res.InstanceFleets, fleet)
+	}
+	return res
+}
+
+// GenerateManagedScalingPolicy returns the managed scaling policy of the
+// given parameters.
+func GenerateManagedScalingPolicy(p *v1alpha1.ManagedScalingPolicy) *emr.ManagedScalingPolicy {
+	if p == nil {
+		return nil
+	}
+	return &emr.ManagedScalingPolicy{
+		ComputeLimits: &emr.ComputeLimits{
+			UnitType:                     emr.ComputeLimitsUnitType(p.ComputeLimits.UnitType),
+			MinimumCapacityUnits:         aws.Int64(p.ComputeLimits.MinimumCapacityUnits),
+			MaximumCapacityUnits:         aws.Int64(p.ComputeLimits.MaximumCapacityUnits),
+			MaximumOnDemandCapacityUnits: p.ComputeLimits.MaximumOnDemandCapacityUnits,
+		},
+	}
+}
+
+// GenerateRunJobFlowInput returns a create input from the given parameters.
+func GenerateRunJobFlowInput(name string, p v1alpha1.ClusterParameters) *emr.RunJobFlowInput {
+	input := &emr.RunJobFlowInput{
+		Name:                  aws.String(name),
+		ReleaseLabel:          aws.String(p.ReleaseLabel),
+		Instances:             generateInstances(p.Instances),
+		Configurations:        generateConfigurations(p.Configurations),
+		ServiceRole:           p.ServiceRole,
+		JobFlowRole:           p.JobFlowRole,
+		AutoScalingRole:       p.AutoScalingRole,
+		LogUri:                p.LogURI,
+		SecurityConfiguration: p.SecurityConfiguration,
+		CustomAmiId:           p.CustomAMIID,
+		EbsRootVolumeSize:     p.EBSRootVolumeSize,
+		ScaleDownBehavior:     emr.ScaleDownBehavior(aws.StringValue(p.ScaleDownBehavior)),
+		ManagedScalingPolicy:  GenerateManagedScalingPolicy(p.ManagedScalingPolicy),
+		StepConcurrencyLevel:  p.StepConcurrencyLevel,
+		VisibleToAllUsers:     p.VisibleToAllUsers,
+	}
+	for _, a := range p.Applications {
+		input.Applications = append(input.Applications, emr.Application{Name: aws.String(a.Name), Args: a.Args})
+	}
+	for _, b := range p.BootstrapActions {
+		input.BootstrapActions = append(input.BootstrapActions, emr.BootstrapActionConfig{
+			Name:                  aws.String(b.Name),
+			ScriptBootstrapAction: &emr.ScriptBootstrapActionConfig{Path: aws.String(b.Path), Args: b.Args},
+		})
+	}
+	for _, s := range p.Steps {
+		step := emr.StepConfig{
+			Name:            aws.String(s.Name),
+			ActionOnFailure: emr.ActionOnFailure(aws.StringValue(s.ActionOnFailure)),
+			HadoopJarStep: &emr.HadoopJarStepConfig{
+				Jar:       aws.String(s.HadoopJarStep.Jar),
+				MainClass: s.HadoopJarStep.MainClass,
+				Args:      s.HadoopJarStep.Args,
+			},
+		}
+		for k, v := range s.HadoopJarStep.Properties {
+			step.HadoopJarStep.Properties = append(step.HadoopJarStep.Properties, emr.KeyValue{Key: aws.String(k), Value: aws.String(v)})
+		}
+		input.Steps = append(input.Steps, step)
+	}
+	input.Tags = GenerateTags(p.Tags)
+	return input
+}
+
+// GenerateTags returns the EMR tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) []emr.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]emr.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = emr.Tag{Key: aws.String(t.Key), Value: t.Value}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from the cluster.
+func DiffTags(desired []v1alpha1.Tag, observed []emr.Tag) (add []emr.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = aws.StringValue(t.Value)
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, emr.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
+}
+
+// GenerateClusterObservation is used to produce v1alpha1.ClusterObservation
+// from emr.Cluster.
+func GenerateClusterObservation(c emr.Cluster) v1alpha1.ClusterObservation {
+	o := v1alpha1.ClusterObservation{
+		ClusterARN:              aws.StringValue(c.ClusterArn),
+		MasterPublicDNSName:     aws.StringValue(c.MasterPublicDnsName),
+		AutoTerminate:           aws.BoolValue(c.AutoTerminate),
+		NormalizedInstanceHours: aws.Int64Value(c.NormalizedInstanceHours),
+	}
+	if c.Status != nil {
+		o.State = string(c.Status.State)
+		if c.Status.StateChangeReason != nil {
+			o.StateChangeReason = aws.StringValue(c.Status.StateChangeReason.Message)
+		}
+	}
+	return o
+}
+
+// LateInitializeCluster fills the empty fields in *v1alpha1.ClusterParameters
+// with the values seen in emr.Cluster.
+func LateInitializeCluster(in *v1alpha1.ClusterParameters, c *emr.Cluster) {
+	if c == nil {
+		return
+	}
+	in.ServiceRole = awsclients.LateInitializeStringPtr(in.ServiceRole, c.ServiceRole)
+	in.LogURI = awsclients.LateInitializeStringPtr(in.LogURI, c.LogUri)
+	in.EBSRootVolumeSize = awsclients.LateInitializeInt64Ptr(in.EBSRootVolumeSize, c.EbsRootVolumeSize)
+	in.StepConcurrencyLevel = awsclients.LateInitializeInt64Ptr(in.StepConcurrencyLevel, c.StepConcurrencyLevel)
+	in.VisibleToAllUsers = awsclients.LateInitializeBoolPtr(in.VisibleToAllUsers, c.VisibleToAllUsers)
+	in.Instances.TerminationProtected = awsclients.LateInitializeBoolPtr(in.Instances.TerminationProtected, c.TerminationProtected)
+	if in.ScaleDownBehavior == nil && c.ScaleDownBehavior != "" {
+		in.ScaleDownBehavior = aws.String(string(c.ScaleDownBehavior))
+	}
+	if c.Ec2InstanceAttributes != nil {
+		in.JobFlowRole = awsclients.LateInitializeStringPtr(in.JobFlowRole, c.Ec2InstanceAttributes.IamInstanceProfile)
+	}
+}
+
+// IsClusterUpToDate checks whether there is a change in any of the modifiable
+// fields of the cluster itself.
+func IsClusterUpToDate(p v1alpha1.ClusterParameters, c emr.Cluster) bool {
+	switch {
+	case aws.BoolValue(p.VisibleToAllUsers) != aws.BoolValue(c.VisibleToAllUsers),
+		aws.BoolValue(p.Instances.TerminationProtected) != aws.BoolValue(c.TerminationProtected),
+		p.StepConcurrencyLevel != nil && aws.Int64Value(p.StepConcurrencyLevel) != aws.Int64Value(c.StepConcurrencyLevel):
+		return false
+	}
+	add, remove := DiffTags(p.Tags, c.Tags)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// IsManagedScalingPolicyUpToDate checks whether the observed managed scaling
+// policy is the desired one.
+func IsManagedScalingPolicyUpToDate(p *v1alpha1.ManagedScalingPolicy, observed *emr.ManagedScalingPolicy) bool {
+	if observed == nil || observed.ComputeLimits == nil {
+		return p == nil
+	}
+	if p == nil {
+		return false
+	}
+	l := observed.ComputeLimits
+	return p.ComputeLimits.UnitType == string(l.UnitType) &&
+		p.ComputeLimits.MinimumCapacityUnits == aws.Int64Value(l.MinimumCapacityUnits) &&
+		p.ComputeLimits.MaximumCapacityUnits == aws.Int64Value(l.MaximumCapacityUnits) &&
+		aws.Int64Value(p.ComputeLimits.MaximumOnDemandCapacityUnits) == aws.Int64Value(l.MaximumOnDemandCapacityUnits)
+}
+
+// GenerateInstanceGroupModifications returns the modifications that resize
+// the observed core and task instance groups to their desired instance
+// counts. Instance groups are matched by their role and, if given, name.
+func GenerateInstanceGroupModifications(desired []v1alpha1.InstanceGroup, observed []emr.InstanceGroup) []emr.InstanceGroupModifyConfig {
+	var res []emr.InstanceGroupModifyConfig
+	for _, d := range desired {
+		if d.InstanceRole == string(emr.InstanceRoleTypeMaster) {
+			continue
+		}
+		for _, o := range observed {
+			if string(o.InstanceGroupType) != d.InstanceRole || (d.Name != nil && aws.StringValue(o.Name) != aws.StringValue(d.Name)) {
+				continue
+			}
+			if aws.Int64Value(o.RequestedInstanceCount) != d.InstanceCount {
+				res = append(res, emr.InstanceGroupModifyConfig{InstanceGroupId: o.Id, InstanceCount: aws.Int64(d.InstanceCount)})
+			}
+			break
+		}
+	}
+	return res
+}
+
+// GenerateInstanceFleetModifications returns the modifications that resize
+// the observed core and task instance fleets to their desired target
+// capacities. Instance fleets are matched by their type.
+func GenerateInstanceFleetModifications(desired []v1alpha1.InstanceFleet, observed []emr.InstanceFleet) []emr.InstanceFleetModifyConfig {
+	var res []emr.InstanceFleetModifyConfig
+	for _, d := range desired {
+		if d.InstanceFleetType == string(emr.InstanceFleetTypeMaster) {
+			continue
+		}
+		for _, o := range observed {
+			if string(o.InstanceFleetType) != d.InstanceFleetType {
+				continue
+			}
+			if aws.Int64Value(o.TargetOnDemandCapacity) != aws.Int64Value(d.TargetOnDemandCapacity) ||
+				aws.Int64Value(o.TargetSpotCapacity) != aws.Int64Value(d.TargetSpotCapacity) {
+				res = append(res, emr.InstanceFleetModifyConfig{
+					InstanceFleetId:        o.Id,
+					TargetOnDemandCapacity: aws.Int64(aws.Int64Value(d.TargetOnDemandCapacity)),
+					TargetSpotCapacity:     aws.Int64(aws.Int64Value(d.TargetSpotCapacity)),
+				})
+			}
+			break
+		}
+	}
+	return res
+}
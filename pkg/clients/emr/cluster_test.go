@@ -0,0 +1,310 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package emr
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/emr/v1alpha1"
+)
+
+var (
+	clusterName = "some-cluster"
+	subnetID    = "subnet-1234"
+	groupID     = "ig-1234"
+	fleetID     = "if-1234"
+)
+
+func TestGenerateRunJobFlowInput(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.ClusterParameters
+		out *emr.RunJobFlowInput
+	}{
+		"Transient": {
+			in: v1alpha1.ClusterParameters{
+				ReleaseLabel: "emr-5.30.0",
+				Instances: v1alpha1.ClusterInstances{
+					EC2SubnetID: aws.String(subnetID),
+					InstanceGroups: []v1alpha1.InstanceGroup{
+						{InstanceRole: "MASTER", InstanceType: "m5.xlarge", InstanceCount: 1},
+					},
+				},
+				Applications:     []v1alpha1.Application{{Name: "Spark"}},
+				BootstrapActions: []v1alpha1.BootstrapAction{{Name: "setup", Path: "s3://bucket/setup.sh", Args: []string{"-v"}}},
+				Steps: []v1alpha1.Step{{
+					Name:            "job",
+					ActionOnFailure: aws.String("TERMINATE_CLUSTER"),
+					HadoopJarStep:   v1alpha1.HadoopJarStep{Jar: "command-runner.jar", Args: []string{"spark-submit"}},
+				}},
+				Tags: []v1alpha1.Tag{{Key: "k", Value: aws.String("v")}},
+			},
+			out: &emr.RunJobFlowInput{
+				Name:         aws.String(clusterName),
+				ReleaseLabel: aws.String("emr-5.30.0"),
+				Instances: &emr.JobFlowInstancesConfig{
+					Ec2SubnetId:                 aws.String(subnetID),
+					KeepJobFlowAliveWhenNoSteps: aws.Bool(false),
+					InstanceGroups: []emr.InstanceGroupConfig{
+						{InstanceRole: emr.InstanceRoleTypeMaster, InstanceType: aws.String("m5.xlarge"), InstanceCount: aws.Int64(1)},
+					},
+				},
+				Applications: []emr.Application{{Name: aws.String("Spark")}},
+				BootstrapActions: []emr.BootstrapActionConfig{{
+					Name:                  aws.String("setup"),
+					ScriptBootstrapAction: &emr.ScriptBootstrapActionConfig{Path: aws.String("s3://bucket/setup.sh"), Args: []string{"-v"}},
+				}},
+				Steps: []emr.StepConfig{{
+					Name:            aws.String("job"),
+					ActionOnFailure: emr.ActionOnFailureTerminateCluster,
+					HadoopJarStep:   &emr.HadoopJarStepConfig{Jar: aws.String("command-runner.jar"), Args: []string{"spark-submit"}},
+				}},
+				Tags: []emr.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			},
+		},
+		"KeepAliveWithFleetsAndScaling": {
+			in: v1alpha1.ClusterParameters{
+				ReleaseLabel: "emr-5.30.0",
+				Instances: v1alpha1.ClusterInstances{
+					KeepJobFlowAliveWhenNoSteps: aws.Bool(true),
+					InstanceFleets: []v1alpha1.InstanceFleet{{
+						InstanceFleetType:  "CORE",
+						TargetSpotCapacity: aws.Int64(4),
+						InstanceTypeConfigs: []v1alpha1.InstanceTypeConfig{{
+							InstanceType:     "m5.xlarge",
+							EBSConfiguration: &v1alpha1.EBSConfiguration{Volumes: []v1alpha1.EBSVolume{{VolumeType: "gp2", SizeInGB: 100}}},
+						}},
+						SpotProvisioning: &v1alpha1.SpotProvisioning{TimeoutDurationMinutes: 10, TimeoutAction: "SWITCH_TO_ON_DEMAND"},
+					}},
+				},
+				ManagedScalingPolicy: &v1alpha1.ManagedScalingPolicy{
+					ComputeLimits: v1alpha1.ComputeLimits{UnitType: "InstanceFleetUnits", MinimumCapacityUnits: 2, MaximumCapacityUnits: 8},
+				},
+			},
+			out: &emr.RunJobFlowInput{
+				Name:         aws.String(clusterName),
+				ReleaseLabel: aws.String("emr-5.30.0"),
+				Instances: &emr.JobFlowInstancesConfig{
+					KeepJobFlowAliveWhenNoSteps: aws.Bool(true),
+					InstanceFleets: []emr.InstanceFleetConfig{{
+						InstanceFleetType:  emr.InstanceFleetTypeCore,
+						TargetSpotCapacity: aws.Int64(4),
+						InstanceTypeConfigs: []emr.InstanceTypeConfig{{
+							InstanceType: aws.String("m5.xlarge"),
+							EbsConfiguration: &emr.EbsConfiguration{EbsBlockDeviceConfigs: []emr.EbsBlockDeviceConfig{{
+								VolumeSpecification: &emr.VolumeSpecification{VolumeType: aws.String("gp2"), SizeInGB: aws.Int64(100)},
+							}}},
+						}},
+						LaunchSpecifications: &emr.InstanceFleetProvisioningSpecifications{
+							SpotSpecification: &emr.SpotProvisioningSpecification{
+								TimeoutDurationMinutes: aws.Int64(10),
+								TimeoutAction:          emr.SpotProvisioningTimeoutActionSwitchToOnDemand,
+							},
+						},
+					}},
+				},
+				ManagedScalingPolicy: &emr.ManagedScalingPolicy{ComputeLimits: &emr.ComputeLimits{
+					UnitType:             emr.ComputeLimitsUnitTypeInstanceFleetUnits,
+					MinimumCapacityUnits: aws.Int64(2),
+					MaximumCapacityUnits: aws.Int64(8),
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateRunJobFlowInput(clusterName, tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GenerateRunJobFlowInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsClusterUpToDate(t *testing.T) {
+	observed := emr.Cluster{
+		VisibleToAllUsers:    aws.Bool(true),
+		TerminationProtected: aws.Bool(false),
+		StepConcurrencyLevel: aws.Int64(1),
+		Tags:                 []emr.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}
+
+	cases := map[string]struct {
+		p   v1alpha1.ClusterParameters
+		out bool
+	}{
+		"SameFields": {
+			p: v1alpha1.ClusterParameters{
+				VisibleToAllUsers: aws.Bool(true),
+				Tags:              []v1alpha1.Tag{{Key: "k", Value: aws.String("v")}},
+			},
+			out: true,
+		},
+		"DifferentProtection": {
+			p: v1alpha1.ClusterParameters{
+				VisibleToAllUsers: aws.Bool(true),
+				Instances:         v1alpha1.ClusterInstances{TerminationProtected: aws.Bool(true)},
+				Tags:              []v1alpha1.Tag{{Key: "k", Value: aws.String("v")}},
+			},
+			out: false,
+		},
+		"DifferentStepConcurrencyLevel": {
+			p: v1alpha1.ClusterParameters{
+				VisibleToAllUsers:    aws.Bool(true),
+				StepConcurrencyLevel: aws.Int64(5),
+				Tags:                 []v1alpha1.Tag{{Key: "k", Value: aws.String("v")}},
+			},
+			out: false,
+		},
+		"DifferentTags": {
+			p: v1alpha1.ClusterParameters{
+				VisibleToAllUsers: aws.Bool(true),
+				Tags:              []v1alpha1.Tag{{Key: "k", Value: aws.String("other")}},
+			},
+			out: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := IsClusterUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("IsClusterUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsManagedScalingPolicyUpToDate(t *testing.T) {
+	policy := &v1alpha1.ManagedScalingPolicy{
+		ComputeLimits: v1alpha1.ComputeLimits{UnitType: "Instances", MinimumCapacityUnits: 2, MaximumCapacityUnits: 10},
+	}
+
+	cases := map[string]struct {
+		p        *v1alpha1.ManagedScalingPolicy
+		observed *emr.ManagedScalingPolicy
+		out      bool
+	}{
+		"NoPolicy": {
+			out: true,
+		},
+		"SamePolicy": {
+			p: policy,
+			observed: &emr.ManagedScalingPolicy{ComputeLimits: &emr.ComputeLimits{
+				UnitType: emr.ComputeLimitsUnitTypeInstances, MinimumCapacityUnits: aws.Int64(2), MaximumCapacityUnits: aws.Int64(10),
+			}},
+			out: true,
+		},
+		"DifferentLimits": {
+			p: policy,
+			observed: &emr.ManagedScalingPolicy{ComputeLimits: &emr.ComputeLimits{
+				UnitType: emr.ComputeLimitsUnitTypeInstances, MinimumCapacityUnits: aws.Int64(2), MaximumCapacityUnits: aws.Int64(4),
+			}},
+			out: false,
+		},
+		"PolicyMissing": {
+			p:   policy,
+			out: false,
+		},
+		"PolicyRemoved": {
+			observed: &emr.ManagedScalingPolicy{ComputeLimits: &emr.ComputeLimits{
+				UnitType: emr.ComputeLimitsUnitTypeInstances, MinimumCapacityUnits: aws.Int64(2), MaximumCapacityUnits: aws.Int64(10),
+			}},
+			out: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := IsManagedScalingPolicyUpToDate(tc.p, tc.observed)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("IsManagedScalingPolicyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateInstanceGroupModifications(t *testing.T) {
+	observed := []emr.InstanceGroup{
+		{Id: aws.String("ig-master"), InstanceGroupType: emr.InstanceGroupTypeMaster, RequestedInstanceCount: aws.Int64(1)},
+		{Id: aws.String(groupID), InstanceGroupType: emr.InstanceGroupTypeCore, RequestedInstanceCount: aws.Int64(2)},
+	}
+
+	cases := map[string]struct {
+		desired []v1alpha1.InstanceGroup
+		out     []emr.InstanceGroupModifyConfig
+	}{
+		"SameCounts": {
+			desired: []v1alpha1.InstanceGroup{
+				{InstanceRole: "MASTER", InstanceCount: 1},
+				{InstanceRole: "CORE", InstanceCount: 2},
+			},
+		},
+		"ResizeCore": {
+			desired: []v1alpha1.InstanceGroup{
+				{InstanceRole: "MASTER", InstanceCount: 3},
+				{InstanceRole: "CORE", InstanceCount: 4},
+			},
+			out: []emr.InstanceGroupModifyConfig{{InstanceGroupId: aws.String(groupID), InstanceCount: aws.Int64(4)}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateInstanceGroupModifications(tc.desired, observed)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GenerateInstanceGroupModifications(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateInstanceFleetModifications(t *testing.T) {
+	observed := []emr.InstanceFleet{
+		{Id: aws.String(fleetID), InstanceFleetType: emr.InstanceFleetTypeTask, TargetOnDemandCapacity: aws.Int64(0), TargetSpotCapacity: aws.Int64(4)},
+	}
+
+	cases := map[string]struct {
+		desired []v1alpha1.InstanceFleet
+		out     []emr.InstanceFleetModifyConfig
+	}{
+		"SameCapacities": {
+			desired: []v1alpha1.InstanceFleet{{InstanceFleetType: "TASK", TargetSpotCapacity: aws.Int64(4)}},
+		},
+		"ResizeTask": {
+			desired: []v1alpha1.InstanceFleet{{InstanceFleetType: "TASK", TargetSpotCapacity: aws.Int64(8)}},
+			out: []emr.InstanceFleetModifyConfig{{
+				InstanceFleetId:        aws.String(fleetID),
+				TargetOnDemandCapacity: aws.Int64(0),
+				TargetSpotCapacity:     aws.Int64(8),
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateInstanceFleetModifications(tc.desired, observed)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GenerateInstanceFleetModifications(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
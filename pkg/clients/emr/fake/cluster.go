@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/emr"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ClusterClient = (*MockClusterClient)(nil)
+
+// MockClusterClient is a type that implements all the methods for ClusterClient interface
+type MockClusterClient struct {
+	MockRunJobFlow                 func(*emr.RunJobFlowInput) emr.RunJobFlowRequest
+	MockDescribeCluster            func(*emr.DescribeClusterInput) emr.DescribeClusterRequest
+	MockTerminateJobFlows          func(*emr.TerminateJobFlowsInput) emr.TerminateJobFlowsRequest
+	MockSetTerminationProtection   func(*emr.SetTerminationProtectionInput) emr.SetTerminationProtectionRequest
+	MockSetVisibleToAllUsers       func(*emr.SetVisibleToAllUsersInput) emr.SetVisibleToAllUsersRequest
+	MockModifyCluster              func(*emr.ModifyClusterInput) emr.ModifyClusterRequest
+	MockAddTags                    func(*emr.AddTagsInput) emr.AddTagsRequest
+	MockRemoveTags                 func(*emr.RemoveTagsInput) emr.RemoveTagsRequest
+	MockListInstanceGroups         func(*emr.ListInstanceGroupsInput) emr.ListInstanceGroupsRequest
+	MockModifyInstanceGroups       func(*emr.ModifyInstanceGroupsInput) emr.ModifyInstanceGroupsRequest
+	MockListInstanceFleets         func(*emr.ListInstanceFleetsInput) emr.ListInstanceFleetsRequest
+	MockModifyInstanceFleet        func(*emr.ModifyInstanceFleetInput) emr.ModifyInstanceFleetRequest
+	MockGetManagedScalingPolicy    func(*emr.GetManagedScalingPolicyInput) emr.GetManagedScalingPolicyRequest
+	MockPutManagedScalingPolicy    func(*emr.PutManagedScalingPolicyInput) emr.PutManagedScalingPolicyRequest
+	MockRemoveManagedScalingPolicy func(*emr.RemoveManagedScalingPolicyInput) emr.RemoveManagedScalingPolicyRequest
+}
+
+// RunJobFlowRequest mocks RunJobFlowRequest method
+func (m *MockClusterClient) RunJobFlowRequest(input *emr.RunJobFlowInput) emr.RunJobFlowRequest {
+	return m.MockRunJobFlow(input)
+}
+
+// DescribeClusterRequest mocks DescribeClusterRequest method
+func (m *MockClusterClient) DescribeClusterRequest(input *emr.DescribeClusterInput) emr.DescribeClusterRequest {
+	return m.MockDescribeCluster(input)
+}
+
+// TerminateJobFlowsRequest mocks TerminateJobFlowsRequest method
+func (m *MockClusterClient) TerminateJobFlowsRequest(input *emr.TerminateJobFlowsInput) emr.TerminateJobFlowsRequest {
+	return m.MockTerminateJobFlows(input)
+}
+
+// SetTerminationProtectionRequest mocks SetTerminationProtectionRequest method
+func (m *MockClusterClient) SetTerminationProtectionRequest(input *emr.SetTerminationProtectionInput) emr.SetTerminationProtectionRequest {
+	return m.MockSetTerminationProtection(input)
+}
+
+// SetVisibleToAllUsersRequest mocks SetVisibleToAllUsersRequest method
+func (m *MockClusterClient) SetVisibleToAllUsersRequest(input *emr.SetVisibleToAllUsersInput) emr.SetVisibleToAllUsersRequest {
+	return m.MockSetVisibleToAllUsers(input)
+}
+
+// ModifyClusterRequest mocks ModifyClusterRequest method
+func (m *MockClusterClient) ModifyClusterRequest(input *emr.ModifyClusterInput) emr.ModifyClusterRequest {
+	return m.MockModifyCluster(input)
+}
+
+// AddTagsRequest mocks AddTagsRequest method
+func (m *MockClusterClient) AddTagsRequest(input *emr.AddTagsInput) emr.AddTagsRequest {
+	return m.MockAddTags(input)
+}
+
+// RemoveTagsRequest mocks RemoveTagsRequest method
+func (m *MockClusterClient) RemoveTagsRequest(input *emr.RemoveTagsInput) emr.RemoveTagsRequest {
+	return m.MockRemoveTags(input)
+}
+
+// ListInstanceGroupsRequest mocks ListInstanceGroupsRequest method
+func (m *MockClusterClient) ListInstanceGroupsRequest(input *emr.ListInstanceGroupsInput) emr.ListInstanceGroupsRequest {
+	return m.MockListInstanceGroups(input)
+}
+
+// ModifyInstanceGroupsRequest mocks ModifyInstanceGroupsRequest method
+func (m *MockClusterClient) ModifyInstanceGroupsRequest(input *emr.ModifyInstanceGroupsInput) emr.ModifyInstanceGroupsRequest {
+	return m.MockModifyInstanceGroups(input)
+}
+
+// ListInstanceFleetsRequest mocks ListInstanceFleetsRequest method
+func (m *MockClusterClient) ListInstanceFleetsRequest(input *emr.ListInstanceFleetsInput) emr.ListInstanceFleetsRequest {
+	return m.MockListInstanceFleets(input)
+}
+
+// ModifyInstanceFleetRequest mocks ModifyInstanceFleetRequest method
+func (m *MockClusterClient) ModifyInstanceFleetRequest(input *emr.ModifyInstanceFleetInput) emr.ModifyInstanceFleetRequest {
+	return m.MockModifyInstanceFleet(input)
+}
+
+// GetManagedScalingPolicyRequest mocks GetManagedScalingPolicyRequest method
+func (m *MockClusterClient) GetManagedScalingPolicyRequest(input *emr.GetManagedScalingPolicyInput) emr.GetManagedScalingPolicyRequest {
+	return m.MockGetManagedScalingPolicy(input)
+}
+
+// PutManagedScalingPolicyRequest mocks PutManagedScalingPolicyRequest method
+func (m *MockClusterClient) PutManagedScalingPolicyRequest(input *emr.PutManagedScalingPolicyInput) emr.PutManagedScalingPolicyRequest {
+	return m.MockPutManagedScalingPolicy(input)
+}
+
+// RemoveManagedScalingPolicyRequest mocks RemoveManagedScalingPolicyRequest method
+func (m *MockClusterClient) RemoveManagedScalingPolicyRequest(input *emr.RemoveManagedScalingPolicyInput) emr.RemoveManagedScalingPolicyRequest {
+	return m.MockRemoveManagedScalingPolicy(input)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+)
+
+// ExternalNameSeparator separates the parts of a composite external name.
+//
+// Some AWS resources, mostly attachments and associations, have no identifier
+// of their own and are identified by a tuple instead, e.g. an IAM role policy
+// attachment by its role name and policy ARN. The external name of such a
+// resource is the composite of those parts joined by the separator, e.g.
+// "my-role/arn:aws:iam::aws:policy/ReadOnlyAccess". Only the last part may
+// contain the separator, so parts like ARNs or CIDR blocks that may contain it
+// should come last.
+const ExternalNameSeparator = "/"
+
+// JoinExternalName returns the composite external name of the given parts.
+func JoinExternalName(parts ...string) string {
+	return strings.Join(parts, ExternalNameSeparator)
+}
+
+// SplitExternalName splits the given composite external name into n parts.
+// It returns false if the name doesn't consist of n non-empty parts.
+func SplitExternalName(name string, n int) ([]string, bool) {
+	if n < 1 {
+		return nil, false
+	}
+	parts := strings.SplitN(name, ExternalNameSeparator, n)
+	if len(parts) != n {
+		return nil, false
+	}
+	for _, p := range parts {
+		if p == "" {
+			return nil, false
+		}
+	}
+	return parts, true
+}
+
+// LateInitializeFromExternalName fills the empty fields with the parts of the
+// given composite external name, in order. It is a no-op if the name doesn't
+// consist of as many parts as there are fields, which allows resources to be
+// imported by annotating them with their composite external name.
+func LateInitializeFromExternalName(name string, fields ...*string) {
+	parts, ok := SplitExternalName(name, len(fields))
+	if !ok {
+		return
+	}
+	for i, f := range fields {
+		if *f == "" {
+			*f = parts[i]
+		}
+	}
+}
+
+// SetCompositeExternalName sets the external name of the given object to the
+// composite of the given parts and reports whether it has changed.
+func SetCompositeExternalName(o metav1.Object, parts ...string) bool {
+	name := JoinExternalName(parts...)
+	if meta.GetExternalName(o) == name {
+		return false
+	}
+	meta.SetExternalName(o, name)
+	return true
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSplitExternalName(t *testing.T) {
+	type args struct {
+		name string
+		n    int
+	}
+	type want struct {
+		parts []string
+		ok    bool
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"TwoParts": {
+			args: args{name: "my-role/arn:aws:iam::aws:policy/service-role/Policy", n: 2},
+			want: want{parts: []string{"my-role", "arn:aws:iam::aws:policy/service-role/Policy"}, ok: true},
+		},
+		"CIDRLast": {
+			args: args{name: "rtb-123/10.0.0.0/16", n: 2},
+			want: want{parts: []string{"rtb-123", "10.0.0.0/16"}, ok: true},
+		},
+		"ThreeParts": {
+			args: args{name: "a/b/c", n: 3},
+			want: want{parts: []string{"a", "b", "c"}, ok: true},
+		},
+		"TooFewParts": {
+			args: args{name: "my-role", n: 2},
+		},
+		"EmptyPart": {
+			args: args{name: "/arn", n: 2},
+		},
+		"EmptyName": {
+			args: args{name: "", n: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			parts, ok := SplitExternalName(tc.args.name, tc.args.n)
+			if diff := cmp.Diff(tc.want.parts, parts); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ok, ok); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if ok {
+				if diff := cmp.Diff(tc.args.name, JoinExternalName(parts...)); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestLateInitializeFromExternalName(t *testing.T) {
+	cases := map[string]struct {
+		name   string
+		fields []string
+		want   []string
+	}{
+		"AllEmpty": {
+			name:   "my-role/arn",
+			fields: []string{"", ""},
+			want:   []string{"my-role", "arn"},
+		},
+		"SomeSet": {
+			name:   "my-role/arn",
+			fields: []string{"other-role", ""},
+			want:   []string{"other-role", "arn"},
+		},
+		"NotComposite": {
+			name:   "my-attachment",
+			fields: []string{"", ""},
+			want:   []string{"", ""},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeFromExternalName(tc.name, &tc.fields[0], &tc.fields[1])
+			if diff := cmp.Diff(tc.want, tc.fields); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
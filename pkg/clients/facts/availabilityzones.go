@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package facts
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+)
+
+// AvailabilityZonesClient is the external client used for AvailabilityZones
+// Custom Resource
+type AvailabilityZonesClient interface {
+	DescribeAvailabilityZonesRequest(*ec2.DescribeAvailabilityZonesInput) ec2.DescribeAvailabilityZonesRequest
+}
+
+// NewAvailabilityZonesClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewAvailabilityZonesClient(cfg aws.Config) AvailabilityZonesClient {
+	return ec2.New(cfg)
+}
+
+// GenerateDescribeAvailabilityZonesInput returns the input that describes the
+// Availability Zones selected by the given parameters.
+func GenerateDescribeAvailabilityZonesInput(p v1alpha1.AvailabilityZonesParameters) *ec2.DescribeAvailabilityZonesInput {
+	if aws.BoolValue(p.IncludeUnavailable) {
+		return &ec2.DescribeAvailabilityZonesInput{AllAvailabilityZones: aws.Bool(true)}
+	}
+	return &ec2.DescribeAvailabilityZonesInput{
+		Filters: []ec2.Filter{{Name: aws.String("state"), Values: []string{string(ec2.AvailabilityZoneStateAvailable)}}},
+	}
+}
+
+// GenerateAvailabilityZonesObservation returns the observation of the given
+// Availability Zones, ordered by name.
+func GenerateAvailabilityZonesObservation(zones []ec2.AvailabilityZone) v1alpha1.AvailabilityZonesObservation {
+	res := v1alpha1.AvailabilityZonesObservation{}
+	for _, z := range zones {
+		res.Zones = append(res.Zones, v1alpha1.AvailabilityZoneObservation{
+			Name:      aws.StringValue(z.ZoneName),
+			ID:        aws.StringValue(z.ZoneId),
+			State:     string(z.State),
+			GroupName: aws.StringValue(z.GroupName),
+		})
+	}
+	sort.Slice(res.Zones, func(i, j int) bool { return res.Zones[i].Name < res.Zones[j].Name })
+	for _, z := range res.Zones {
+		res.Names = append(res.Names, z.Name)
+		res.IDs = append(res.IDs, z.ID)
+	}
+	return res
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package facts
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+)
+
+func TestGenerateDescribeAvailabilityZonesInput(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.AvailabilityZonesParameters
+		want *ec2.DescribeAvailabilityZonesInput
+	}{
+		"Available": {
+			in: v1alpha1.AvailabilityZonesParameters{Region: "us-east-1"},
+			want: &ec2.DescribeAvailabilityZonesInput{
+				Filters: []ec2.Filter{{Name: aws.String("state"), Values: []string{"available"}}},
+			},
+		},
+		"IncludeUnavailable": {
+			in:   v1alpha1.AvailabilityZonesParameters{Region: "us-east-1", IncludeUnavailable: aws.Bool(true)},
+			want: &ec2.DescribeAvailabilityZonesInput{AllAvailabilityZones: aws.Bool(true)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateDescribeAvailabilityZonesInput(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAvailabilityZonesObservation(t *testing.T) {
+	cases := map[string]struct {
+		in   []ec2.AvailabilityZone
+		want v1alpha1.AvailabilityZonesObservation
+	}{
+		"Empty": {},
+		"Ordered": {
+			in: []ec2.AvailabilityZone{
+				{ZoneName: aws.String("us-east-1c"), ZoneId: aws.String("use1-az1"), State: ec2.AvailabilityZoneStateAvailable},
+				{ZoneName: aws.String("us-east-1a"), ZoneId: aws.String("use1-az4"), State: ec2.AvailabilityZoneStateImpaired},
+			},
+			want: v1alpha1.AvailabilityZonesObservation{
+				Names: []string{"us-east-1a", "us-east-1c"},
+				IDs:   []string{"use1-az4", "use1-az1"},
+				Zones: []v1alpha1.AvailabilityZoneObservation{
+					{Name: "us-east-1a", ID: "use1-az4", State: "impaired"},
+					{Name: "us-east-1c", ID: "use1-az1", State: "available"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateAvailabilityZonesObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package facts
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+)
+
+// CallerIdentityClient is the external client used for CallerIdentity Custom
+// Resource
+type CallerIdentityClient interface {
+	GetCallerIdentityRequest(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+}
+
+// NewCallerIdentityClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewCallerIdentityClient(cfg aws.Config) CallerIdentityClient {
+	return sts.New(cfg)
+}
+
+// GenerateCallerIdentityObservation returns the observation of the given
+// caller identity.
+func GenerateCallerIdentityObservation(o sts.GetCallerIdentityOutput) v1alpha1.CallerIdentityObservation {
+	res := v1alpha1.CallerIdentityObservation{
+		AccountID: aws.StringValue(o.Account),
+		ARN:       aws.StringValue(o.Arn),
+		UserID:    aws.StringValue(o.UserId),
+	}
+	if a, err := arn.Parse(res.ARN); err == nil {
+		res.Partition = a.Partition
+	}
+	return res
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package facts
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+)
+
+func TestGenerateCallerIdentityObservation(t *testing.T) {
+	cases := map[string]struct {
+		in   sts.GetCallerIdentityOutput
+		want v1alpha1.CallerIdentityObservation
+	}{
+		"AWS": {
+			in: sts.GetCallerIdentityOutput{
+				Account: aws.String("123456789012"),
+				Arn:     aws.String("arn:aws:sts::123456789012:assumed-role/crossplane/session"),
+				UserId:  aws.String("AROAEXAMPLE:session"),
+			},
+			want: v1alpha1.CallerIdentityObservation{
+				AccountID: "123456789012",
+				ARN:       "arn:aws:sts::123456789012:assumed-role/crossplane/session",
+				UserID:    "AROAEXAMPLE:session",
+				Partition: "aws",
+			},
+		},
+		"China": {
+			in: sts.GetCallerIdentityOutput{
+				Account: aws.String("123456789012"),
+				Arn:     aws.String("arn:aws-cn:iam::123456789012:user/crossplane"),
+			},
+			want: v1alpha1.CallerIdentityObservation{
+				AccountID: "123456789012",
+				ARN:       "arn:aws-cn:iam::123456789012:user/crossplane",
+				Partition: "aws-cn",
+			},
+		},
+		"InvalidARN": {
+			in: sts.GetCallerIdentityOutput{
+				Account: aws.String("123456789012"),
+				Arn:     aws.String("crossplane"),
+			},
+			want: v1alpha1.CallerIdentityObservation{
+				AccountID: "123456789012",
+				ARN:       "crossplane",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCallerIdentityObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
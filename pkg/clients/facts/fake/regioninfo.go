@@ -0,0 +1,36 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/facts"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.RegionInfoClient = (*MockRegionInfoClient)(nil)
+
+// MockRegionInfoClient is a type that implements all the methods for RegionInfoClient interface
+type MockRegionInfoClient struct {
+	MockDescribeRegions func(*ec2.DescribeRegionsInput) ec2.DescribeRegionsRequest
+}
+
+// DescribeRegionsRequest mocks DescribeRegionsRequest method
+func (m *MockRegionInfoClient) DescribeRegionsRequest(input *ec2.DescribeRegionsInput) ec2.DescribeRegionsRequest {
+	return m.MockDescribeRegions(input)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/facts"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.VPCDefaultsClient = (*MockVPCDefaultsClient)(nil)
+
+// MockVPCDefaultsClient is a type that implements all the methods for VPCDefaultsClient interface
+type MockVPCDefaultsClient struct {
+	MockDescribeVpcs           func(*ec2.DescribeVpcsInput) ec2.DescribeVpcsRequest
+	MockDescribeSubnets        func(*ec2.DescribeSubnetsInput) ec2.DescribeSubnetsRequest
+	MockDescribeSecurityGroups func(*ec2.DescribeSecurityGroupsInput) ec2.DescribeSecurityGroupsRequest
+}
+
+// DescribeVpcsRequest mocks DescribeVpcsRequest method
+func (m *MockVPCDefaultsClient) DescribeVpcsRequest(input *ec2.DescribeVpcsInput) ec2.DescribeVpcsRequest {
+	return m.MockDescribeVpcs(input)
+}
+
+// DescribeSubnetsRequest mocks DescribeSubnetsRequest method
+func (m *MockVPCDefaultsClient) DescribeSubnetsRequest(input *ec2.DescribeSubnetsInput) ec2.DescribeSubnetsRequest {
+	return m.MockDescribeSubnets(input)
+}
+
+// DescribeSecurityGroupsRequest mocks DescribeSecurityGroupsRequest method
+func (m *MockVPCDefaultsClient) DescribeSecurityGroupsRequest(input *ec2.DescribeSecurityGroupsInput) ec2.DescribeSecurityGroupsRequest {
+	return m.MockDescribeSecurityGroups(input)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package facts
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// The opt-in status of a region that the account can't use.
+const regionNotOptedIn = "not-opted-in"
+
+// RegionInfoClient is the external client used for RegionInfo Custom Resource
+type RegionInfoClient interface {
+	DescribeRegionsRequest(*ec2.DescribeRegionsInput) ec2.DescribeRegionsRequest
+}
+
+// NewRegionInfoClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewRegionInfoClient(cfg aws.Config) RegionInfoClient {
+	return ec2.New(cfg)
+}
+
+// GenerateDescribeRegionsInput returns the input that describes the given
+// region, whether the account opted in to it or not.
+func GenerateDescribeRegionsInput(region string) *ec2.DescribeRegionsInput {
+	return &ec2.DescribeRegionsInput{
+		AllRegions:  aws.Bool(true),
+		RegionNames: []string{region},
+	}
+}
+
+// GenerateRegionInfoObservation returns the observation of the given region.
+func GenerateRegionInfoObservation(o ec2.Region) v1alpha1.RegionInfoObservation {
+	return v1alpha1.RegionInfoObservation{
+		Partition:   awsclients.PartitionForRegion(aws.StringValue(o.RegionName)),
+		Endpoint:    aws.StringValue(o.Endpoint),
+		OptInStatus: aws.StringValue(o.OptInStatus),
+	}
+}
+
+// IsRegionUsable returns true if the account can use the observed region.
+func IsRegionUsable(o v1alpha1.RegionInfoObservation) bool {
+	return o.OptInStatus != regionNotOptedIn
+}
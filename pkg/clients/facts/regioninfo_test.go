@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package facts
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+)
+
+func TestGenerateRegionInfoObservation(t *testing.T) {
+	cases := map[string]struct {
+		in   ec2.Region
+		want v1alpha1.RegionInfoObservation
+	}{
+		"AWS": {
+			in: ec2.Region{
+				RegionName:  aws.String("us-east-1"),
+				Endpoint:    aws.String("ec2.us-east-1.amazonaws.com"),
+				OptInStatus: aws.String("opt-in-not-required"),
+			},
+			want: v1alpha1.RegionInfoObservation{
+				Partition:   "aws",
+				Endpoint:    "ec2.us-east-1.amazonaws.com",
+				OptInStatus: "opt-in-not-required",
+			},
+		},
+		"China": {
+			in: ec2.Region{
+				RegionName:  aws.String("cn-north-1"),
+				Endpoint:    aws.String("ec2.cn-north-1.amazonaws.com.cn"),
+				OptInStatus: aws.String("opt-in-not-required"),
+			},
+			want: v1alpha1.RegionInfoObservation{
+				Partition:   "aws-cn",
+				Endpoint:    "ec2.cn-north-1.amazonaws.com.cn",
+				OptInStatus: "opt-in-not-required",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRegionInfoObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRegionUsable(t *testing.T) {
+	cases := map[string]struct {
+		optInStatus string
+		want        bool
+	}{
+		"NotRequired": {optInStatus: "opt-in-not-required", want: true},
+		"OptedIn":     {optInStatus: "opted-in", want: true},
+		"NotOptedIn":  {optInStatus: "not-opted-in", want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsRegionUsable(v1alpha1.RegionInfoObservation{OptInStatus: tc.optInStatus})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package facts
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+)
+
+// VPCDefaultsClient is the external client used for VPCDefaults Custom
+// Resource
+type VPCDefaultsClient interface {
+	DescribeVpcsRequest(*ec2.DescribeVpcsInput) ec2.DescribeVpcsRequest
+	DescribeSubnetsRequest(*ec2.DescribeSubnetsInput) ec2.DescribeSubnetsRequest
+	DescribeSecurityGroupsRequest(*ec2.DescribeSecurityGroupsInput) ec2.DescribeSecurityGroupsRequest
+}
+
+// NewVPCDefaultsClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewVPCDefaultsClient(cfg aws.Config) VPCDefaultsClient {
+	return ec2.New(cfg)
+}
+
+// GenerateDescribeDefaultVPCInput returns the input that describes the
+// default VPC of a region.
+func GenerateDescribeDefaultVPCInput() *ec2.DescribeVpcsInput {
+	return &ec2.DescribeVpcsInput{
+		Filters: []ec2.Filter{{Name: aws.String("isDefault"), Values: []string{"true"}}},
+	}
+}
+
+// GenerateDescribeDefaultSubnetsInput returns the input that describes the
+// default subnets of the given VPC.
+func GenerateDescribeDefaultSubnetsInput(vpcID string) *ec2.DescribeSubnetsInput {
+	return &ec2.DescribeSubnetsInput{
+		Filters: []ec2.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+			{Name: aws.String("default-for-az"), Values: []string{"true"}},
+		},
+	}
+}
+
+// GenerateDescribeDefaultSecurityGroupInput returns the input that describes
+// the default security group of the given VPC.
+func GenerateDescribeDefaultSecurityGroupInput(vpcID string) *ec2.DescribeSecurityGroupsInput {
+	return &ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+			{Name: aws.String("group-name"), Values: []string{"default"}},
+		},
+	}
+}
+
+// GenerateVPCDefaultsObservation returns the observation of the given default
+// VPC, its default subnets and its default security group.
+func GenerateVPCDefaultsObservation(vpc ec2.Vpc, subnets []ec2.Subnet, sgs []ec2.SecurityGroup) v1alpha1.VPCDefaultsObservation {
+	res := v1alpha1.VPCDefaultsObservation{
+		VPCID:     aws.StringValue(vpc.VpcId),
+		CIDRBlock: aws.StringValue(vpc.CidrBlock),
+	}
+	sort.Slice(subnets, func(i, j int) bool {
+		return aws.StringValue(subnets[i].AvailabilityZone) < aws.StringValue(subnets[j].AvailabilityZone)
+	})
+	for _, s := range subnets {
+		res.SubnetIDs = append(res.SubnetIDs, aws.StringValue(s.SubnetId))
+	}
+	if len(sgs) > 0 {
+		res.SecurityGroupID = aws.StringValue(sgs[0].GroupId)
+	}
+	return res
+}
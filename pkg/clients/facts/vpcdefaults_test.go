@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package facts
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+)
+
+func TestGenerateVPCDefaultsObservation(t *testing.T) {
+	vpc := ec2.Vpc{VpcId: aws.String("vpc-1"), CidrBlock: aws.String("172.31.0.0/16")}
+
+	cases := map[string]struct {
+		subnets []ec2.Subnet
+		sgs     []ec2.SecurityGroup
+		want    v1alpha1.VPCDefaultsObservation
+	}{
+		"VPCOnly": {
+			want: v1alpha1.VPCDefaultsObservation{VPCID: "vpc-1", CIDRBlock: "172.31.0.0/16"},
+		},
+		"Full": {
+			subnets: []ec2.Subnet{
+				{SubnetId: aws.String("subnet-c"), AvailabilityZone: aws.String("us-east-1c")},
+				{SubnetId: aws.String("subnet-a"), AvailabilityZone: aws.String("us-east-1a")},
+				{SubnetId: aws.String("subnet-b"), AvailabilityZone: aws.String("us-east-1b")},
+			},
+			sgs: []ec2.SecurityGroup{{GroupId: aws.String("sg-1")}},
+			want: v1alpha1.VPCDefaultsObservation{
+				VPCID:           "vpc-1",
+				CIDRBlock:       "172.31.0.0/16",
+				SubnetIDs:       []string{"subnet-a", "subnet-b", "subnet-c"},
+				SecurityGroupID: "sg-1",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateVPCDefaultsObservation(vpc, tc.subnets, tc.sgs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
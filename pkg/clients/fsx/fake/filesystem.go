@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/fsx"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.FileSystemClient = (*MockFileSystemClient)(nil)
+
+// MockFileSystemClient is a type that implements all the methods for FileSystemClient interface
+type MockFileSystemClient struct {
+	MockDescribeFileSystems func(*fsx.DescribeFileSystemsInput) fsx.DescribeFileSystemsRequest
+	MockCreateFileSystem    func(*fsx.CreateFileSystemInput) fsx.CreateFileSystemRequest
+	MockUpdateFileSystem    func(*fsx.UpdateFileSystemInput) fsx.UpdateFileSystemRequest
+	MockDeleteFileSystem    func(*fsx.DeleteFileSystemInput) fsx.DeleteFileSystemRequest
+	MockTagResource         func(*fsx.TagResourceInput) fsx.TagResourceRequest
+	MockUntagResource       func(*fsx.UntagResourceInput) fsx.UntagResourceRequest
+}
+
+// DescribeFileSystemsRequest mocks DescribeFileSystemsRequest method
+func (m *MockFileSystemClient) DescribeFileSystemsRequest(input *fsx.DescribeFileSystemsInput) fsx.DescribeFileSystemsRequest {
+	return m.MockDescribeFileSystems(input)
+}
+
+// CreateFileSystemRequest mocks CreateFileSystemRequest method
+func (m *MockFileSystemClient) CreateFileSystemRequest(input *fsx.CreateFileSystemInput) fsx.CreateFileSystemRequest {
+	return m.MockCreateFileSystem(input)
+}
+
+// UpdateFileSystemRequest mocks UpdateFileSystemRequest method
+func (m *MockFileSystemClient) UpdateFileSystemRequest(input *fsx.UpdateFileSystemInput) fsx.UpdateFileSystemRequest {
+	return m.MockUpdateFileSystem(input)
+}
+
+// DeleteFileSystemRequest mocks DeleteFileSystemRequest method
+func (m *MockFileSystemClient) DeleteFileSystemRequest(input *fsx.DeleteFileSystemInput) fsx.DeleteFileSystemRequest {
+	return m.MockDeleteFileSystem(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockFileSystemClient) TagResourceRequest(input *fsx.TagResourceInput) fsx.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockFileSystemClient) UntagResourceRequest(input *fsx.UntagResourceInput) fsx.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
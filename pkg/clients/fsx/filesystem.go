@@ -0,0 +1,250 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fsx
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/fsx/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ConnectionDetailsMountNameKey is the key of the mount name of a Lustre file
+// system in its connection details.
+const ConnectionDetailsMountNameKey = "mountName"
+
+// FileSystemClient is the external client used for FileSystem Custom Resource
+type FileSystemClient interface {
+	DescribeFileSystemsRequest(*fsx.DescribeFileSystemsInput) fsx.DescribeFileSystemsRequest
+	CreateFileSystemRequest(*fsx.CreateFileSystemInput) fsx.CreateFileSystemRequest
+	UpdateFileSystemRequest(*fsx.UpdateFileSystemInput) fsx.UpdateFileSystemRequest
+	DeleteFileSystemRequest(*fsx.DeleteFileSystemInput) fsx.DeleteFileSystemRequest
+	TagResourceRequest(*fsx.TagResourceInput) fsx.TagResourceRequest
+	UntagResourceRequest(*fsx.UntagResourceInput) fsx.UntagResourceRequest
+}
+
+// NewFileSystemClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewFileSystemClient(cfg aws.Config) FileSystemClient {
+	return fsx.New(cfg)
+}
+
+// IsFileSystemNotFound returns true if the error is because the file system
+// doesn't exist.
+func IsFileSystemNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == fsx.ErrCodeFileSystemNotFound {
+		return true
+	}
+	return false
+}
+
+// GenerateTags returns the FSx tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) []fsx.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]fsx.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = fsx.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from a file system.
+func DiffTags(desired []v1alpha1.Tag, observed []fsx.Tag) (add []fsx.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, fsx.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
+}
+
+// GenerateCreateFileSystemInput returns the create input of a file system
+// with the given parameters. The idempotency token makes sure that retries
+// don't create more than one file system.
+func GenerateCreateFileSystemInput(idempotencyToken string, p v1alpha1.FileSystemParameters) *fsx.CreateFileSystemInput {
+	in := &fsx.CreateFileSystemInput{
+		ClientRequestToken: aws.String(idempotencyToken),
+		FileSystemType:     fsx.FileSystemType(p.FileSystemType),
+		KmsKeyId:           p.KMSKeyID,
+		SecurityGroupIds:   p.SecurityGroupIDs,
+		StorageCapacity:    aws.Int64(p.StorageCapacity),
+		SubnetIds:          p.SubnetIDs,
+		Tags:               GenerateTags(p.Tags),
+	}
+	if p.StorageType != nil {
+		in.StorageType = fsx.StorageType(*p.StorageType)
+	}
+	if c := p.LustreConfiguration; c != nil {
+		in.LustreConfiguration = &fsx.CreateFileSystemLustreConfiguration{
+			ExportPath:                 c.ExportPath,
+			ImportPath:                 c.ImportPath,
+			ImportedFileChunkSize:      c.ImportedFileChunkSize,
+			PerUnitStorageThroughput:   c.PerUnitStorageThroughput,
+			WeeklyMaintenanceStartTime: c.WeeklyMaintenanceStartTime,
+		}
+		if c.DeploymentType != nil {
+			in.LustreConfiguration.DeploymentType = fsx.LustreDeploymentType(*c.DeploymentType)
+		}
+	}
+	if c := p.WindowsConfiguration; c != nil {
+		in.WindowsConfiguration = &fsx.CreateFileSystemWindowsConfiguration{
+			ActiveDirectoryId:             c.ActiveDirectoryID,
+			AutomaticBackupRetentionDays:  c.AutomaticBackupRetentionDays,
+			CopyTagsToBackups:             c.CopyTagsToBackups,
+			DailyAutomaticBackupStartTime: c.DailyAutomaticBackupStartTime,
+			PreferredSubnetId:             c.PreferredSubnetID,
+			ThroughputCapacity:            aws.Int64(c.ThroughputCapacity),
+			WeeklyMaintenanceStartTime:    c.WeeklyMaintenanceStartTime,
+		}
+		if c.DeploymentType != nil {
+			in.WindowsConfiguration.DeploymentType = fsx.WindowsDeploymentType(*c.DeploymentType)
+		}
+	}
+	return in
+}
+
+// GenerateUpdateFileSystemInput returns the update input of the file system
+// with the given ID and parameters. Only the maintenance and backup windows
+// of a file system can be updated.
+func GenerateUpdateFileSystemInput(id string, p v1alpha1.FileSystemParameters) *fsx.UpdateFileSystemInput {
+	in := &fsx.UpdateFileSystemInput{FileSystemId: aws.String(id)}
+	if c := p.LustreConfiguration; c != nil {
+		in.LustreConfiguration = &fsx.UpdateFileSystemLustreConfiguration{
+			WeeklyMaintenanceStartTime: c.WeeklyMaintenanceStartTime,
+		}
+	}
+	if c := p.WindowsConfiguration; c != nil {
+		in.WindowsConfiguration = &fsx.UpdateFileSystemWindowsConfiguration{
+			AutomaticBackupRetentionDays:  c.AutomaticBackupRetentionDays,
+			DailyAutomaticBackupStartTime: c.DailyAutomaticBackupStartTime,
+			WeeklyMaintenanceStartTime:    c.WeeklyMaintenanceStartTime,
+		}
+	}
+	return in
+}
+
+// GenerateFileSystemObservation returns the observation of the given file
+// system.
+func GenerateFileSystemObservation(o fsx.FileSystem) v1alpha1.FileSystemObservation {
+	res := v1alpha1.FileSystemObservation{
+		ARN:                 aws.StringValue(o.ResourceARN),
+		DNSName:             aws.StringValue(o.DNSName),
+		Lifecycle:           string(o.Lifecycle),
+		VPCID:               aws.StringValue(o.VpcId),
+		NetworkInterfaceIDs: o.NetworkInterfaceIds,
+		OwnerID:             aws.StringValue(o.OwnerId),
+	}
+	if o.LustreConfiguration != nil {
+		res.MountName = aws.StringValue(o.LustreConfiguration.MountName)
+	}
+	return res
+}
+
+// LateInitializeFileSystem fills the empty fields of the file system
+// parameters with the values of the observed file system.
+func LateInitializeFileSystem(in *v1alpha1.FileSystemParameters, o *fsx.FileSystem) {
+	if o == nil {
+		return
+	}
+	if in.StorageType == nil && o.StorageType != "" {
+		in.StorageType = aws.String(string(o.StorageType))
+	}
+	in.KMSKeyID = awsclients.LateInitializeStringPtr(in.KMSKeyID, o.KmsKeyId)
+
+	if o.LustreConfiguration != nil {
+		if in.LustreConfiguration == nil {
+			in.LustreConfiguration = &v1alpha1.LustreConfiguration{}
+		}
+		c, oc := in.LustreConfiguration, o.LustreConfiguration
+		if c.DeploymentType == nil && oc.DeploymentType != "" {
+			c.DeploymentType = aws.String(string(oc.DeploymentType))
+		}
+		c.PerUnitStorageThroughput = awsclients.LateInitializeInt64Ptr(c.PerUnitStorageThroughput, oc.PerUnitStorageThroughput)
+		c.WeeklyMaintenanceStartTime = awsclients.LateInitializeStringPtr(c.WeeklyMaintenanceStartTime, oc.WeeklyMaintenanceStartTime)
+	}
+
+	if o.WindowsConfiguration != nil && in.WindowsConfiguration != nil {
+		c, oc := in.WindowsConfiguration, o.WindowsConfiguration
+		c.ActiveDirectoryID = awsclients.LateInitializeStringPtr(c.ActiveDirectoryID, oc.ActiveDirectoryId)
+		if c.DeploymentType == nil && oc.DeploymentType != "" {
+			c.DeploymentType = aws.String(string(oc.DeploymentType))
+		}
+		c.PreferredSubnetID = awsclients.LateInitializeStringPtr(c.PreferredSubnetID, oc.PreferredSubnetId)
+		c.AutomaticBackupRetentionDays = awsclients.LateInitializeInt64Ptr(c.AutomaticBackupRetentionDays, oc.AutomaticBackupRetentionDays)
+		c.DailyAutomaticBackupStartTime = awsclients.LateInitializeStringPtr(c.DailyAutomaticBackupStartTime, oc.DailyAutomaticBackupStartTime)
+		c.CopyTagsToBackups = awsclients.LateInitializeBoolPtr(c.CopyTagsToBackups, oc.CopyTagsToBackups)
+		c.WeeklyMaintenanceStartTime = awsclients.LateInitializeStringPtr(c.WeeklyMaintenanceStartTime, oc.WeeklyMaintenanceStartTime)
+	}
+}
+
+// IsFileSystemUpToDate returns true if the maintenance and backup windows and
+// the tags of the observed file system match the parameters.
+func IsFileSystemUpToDate(p v1alpha1.FileSystemParameters, o fsx.FileSystem) bool {
+	if c, oc := p.LustreConfiguration, o.LustreConfiguration; c != nil && oc != nil {
+		if !isStringPtrUpToDate(c.WeeklyMaintenanceStartTime, oc.WeeklyMaintenanceStartTime) {
+			return false
+		}
+	}
+	if c, oc := p.WindowsConfiguration, o.WindowsConfiguration; c != nil && oc != nil {
+		if !isStringPtrUpToDate(c.WeeklyMaintenanceStartTime, oc.WeeklyMaintenanceStartTime) ||
+			!isStringPtrUpToDate(c.DailyAutomaticBackupStartTime, oc.DailyAutomaticBackupStartTime) {
+			return false
+		}
+		if c.AutomaticBackupRetentionDays != nil && *c.AutomaticBackupRetentionDays != aws.Int64Value(oc.AutomaticBackupRetentionDays) {
+			return false
+		}
+	}
+	add, remove := DiffTags(p.Tags, o.Tags)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// isStringPtrUpToDate returns true if the desired value is unset or equal to
+// the observed one.
+func isStringPtrUpToDate(desired, observed *string) bool {
+	return desired == nil || *desired == aws.StringValue(observed)
+}
+
+// GetFileSystemConnectionDetails returns the DNS name of the file system as
+// the endpoint of its connection details, along with the mount name of a
+// Lustre file system.
+func GetFileSystemConnectionDetails(cr v1alpha1.FileSystem) managed.ConnectionDetails {
+	if cr.Status.AtProvider.DNSName == "" {
+		return nil
+	}
+	cd := managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.DNSName),
+	}
+	if cr.Status.AtProvider.MountName != "" {
+		cd[ConnectionDetailsMountNameKey] = []byte(cr.Status.AtProvider.MountName)
+	}
+	return cd
+}
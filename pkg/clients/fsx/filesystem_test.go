@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fsx
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/fsx/v1alpha1"
+)
+
+func TestLateInitializeFileSystem(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.FileSystemParameters
+		observed *fsx.FileSystem
+		want     v1alpha1.FileSystemParameters
+	}{
+		"Lustre": {
+			in: v1alpha1.FileSystemParameters{FileSystemType: "LUSTRE"},
+			observed: &fsx.FileSystem{
+				StorageType: fsx.StorageTypeSsd,
+				LustreConfiguration: &fsx.LustreFileSystemConfiguration{
+					DeploymentType:             fsx.LustreDeploymentTypeScratch2,
+					WeeklyMaintenanceStartTime: aws.String("1:05:00"),
+				},
+			},
+			want: v1alpha1.FileSystemParameters{
+				FileSystemType: "LUSTRE",
+				StorageType:    aws.String("SSD"),
+				LustreConfiguration: &v1alpha1.LustreConfiguration{
+					DeploymentType:             aws.String("SCRATCH_2"),
+					WeeklyMaintenanceStartTime: aws.String("1:05:00"),
+				},
+			},
+		},
+		"Windows": {
+			in: v1alpha1.FileSystemParameters{
+				FileSystemType:       "WINDOWS",
+				KMSKeyID:             aws.String("key"),
+				WindowsConfiguration: &v1alpha1.WindowsConfiguration{ThroughputCapacity: 8, AutomaticBackupRetentionDays: aws.Int64(0)},
+			},
+			observed: &fsx.FileSystem{
+				KmsKeyId: aws.String("other"),
+				WindowsConfiguration: &fsx.WindowsFileSystemConfiguration{
+					DeploymentType:                fsx.WindowsDeploymentTypeSingleAz2,
+					AutomaticBackupRetentionDays:  aws.Int64(7),
+					DailyAutomaticBackupStartTime: aws.String("05:00"),
+					CopyTagsToBackups:             aws.Bool(false),
+				},
+			},
+			want: v1alpha1.FileSystemParameters{
+				FileSystemType: "WINDOWS",
+				KMSKeyID:       aws.String("key"),
+				WindowsConfiguration: &v1alpha1.WindowsConfiguration{
+					ThroughputCapacity:            8,
+					DeploymentType:                aws.String("SINGLE_AZ_2"),
+					AutomaticBackupRetentionDays:  aws.Int64(0),
+					DailyAutomaticBackupStartTime: aws.String("05:00"),
+					CopyTagsToBackups:             aws.Bool(false),
+				},
+			},
+		},
+		"NoObservation": {
+			in:   v1alpha1.FileSystemParameters{FileSystemType: "LUSTRE"},
+			want: v1alpha1.FileSystemParameters{FileSystemType: "LUSTRE"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeFileSystem(&tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsFileSystemUpToDate(t *testing.T) {
+	observed := fsx.FileSystem{
+		WindowsConfiguration: &fsx.WindowsFileSystemConfiguration{
+			AutomaticBackupRetentionDays:  aws.Int64(7),
+			DailyAutomaticBackupStartTime: aws.String("05:00"),
+			WeeklyMaintenanceStartTime:    aws.String("1:05:00"),
+		},
+		Tags: []fsx.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}
+	cases := map[string]struct {
+		p    v1alpha1.FileSystemParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.FileSystemParameters{
+				WindowsConfiguration: &v1alpha1.WindowsConfiguration{
+					AutomaticBackupRetentionDays:  aws.Int64(7),
+					DailyAutomaticBackupStartTime: aws.String("05:00"),
+					WeeklyMaintenanceStartTime:    aws.String("1:05:00"),
+				},
+				Tags: []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: true,
+		},
+		"MaintenanceWindowChanged": {
+			p: v1alpha1.FileSystemParameters{
+				WindowsConfiguration: &v1alpha1.WindowsConfiguration{WeeklyMaintenanceStartTime: aws.String("7:23:30")},
+				Tags:                 []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: false,
+		},
+		"BackupRetentionChanged": {
+			p: v1alpha1.FileSystemParameters{
+				WindowsConfiguration: &v1alpha1.WindowsConfiguration{AutomaticBackupRetentionDays: aws.Int64(0)},
+				Tags:                 []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			p:    v1alpha1.FileSystemParameters{},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsFileSystemUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalaccelerator
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// APIRegion is the region of the Global Accelerator API. Accelerators are
+// global resources, but they can only be managed through this region.
+const APIRegion = "us-west-2"
+
+// AcceleratorClient is the external client used for Accelerator Custom
+// Resource
+type AcceleratorClient interface {
+	DescribeAcceleratorRequest(*globalaccelerator.DescribeAcceleratorInput) globalaccelerator.DescribeAcceleratorRequest
+	CreateAcceleratorRequest(*globalaccelerator.CreateAcceleratorInput) globalaccelerator.CreateAcceleratorRequest
+	UpdateAcceleratorRequest(*globalaccelerator.UpdateAcceleratorInput) globalaccelerator.UpdateAcceleratorRequest
+	DeleteAcceleratorRequest(*globalaccelerator.DeleteAcceleratorInput) globalaccelerator.DeleteAcceleratorRequest
+	ListTagsForResourceRequest(*globalaccelerator.ListTagsForResourceInput) globalaccelerator.ListTagsForResourceRequest
+	TagResourceRequest(*globalaccelerator.TagResourceInput) globalaccelerator.TagResourceRequest
+	UntagResourceRequest(*globalaccelerator.UntagResourceInput) globalaccelerator.UntagResourceRequest
+}
+
+// NewAcceleratorClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewAcceleratorClient(cfg aws.Config) AcceleratorClient {
+	return globalaccelerator.New(cfg)
+}
+
+// IsAcceleratorNotFound returns true if the error is because the accelerator
+// doesn't exist.
+func IsAcceleratorNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == globalaccelerator.ErrCodeAcceleratorNotFoundException {
+		return true
+	}
+	return false
+}
+
+// GenerateTags returns the Global Accelerator tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) []globalaccelerator.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]globalaccelerator.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = globalaccelerator.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from an accelerator.
+func DiffTags(desired []v1alpha1.Tag, observed []globalaccelerator.Tag) (add []globalaccelerator.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, globalaccelerator.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
+}
+
+// GenerateCreateAcceleratorInput returns the create input of an accelerator
+// with the given parameters. The idempotency token makes sure that retries
+// don't create more than one accelerator.
+func GenerateCreateAcceleratorInput(idempotencyToken string, p v1alpha1.AcceleratorParameters) *globalaccelerator.CreateAcceleratorInput {
+	in := &globalaccelerator.CreateAcceleratorInput{
+		Name:             aws.String(p.Name),
+		Enabled:          p.Enabled,
+		IdempotencyToken: aws.String(idempotencyToken),
+		IpAddresses:      p.IPAddresses,
+		Tags:             GenerateTags(p.Tags),
+	}
+	if p.IPAddressType != nil {
+		in.IpAddressType = globalaccelerator.IpAddressType(*p.IPAddressType)
+	}
+	return in
+}
+
+// GenerateUpdateAcceleratorInput returns the update input of the accelerator
+// with the given ARN and parameters.
+func GenerateUpdateAcceleratorInput(arn string, p v1alpha1.AcceleratorParameters) *globalaccelerator.UpdateAcceleratorInput {
+	in := &globalaccelerator.UpdateAcceleratorInput{
+		AcceleratorArn: aws.String(arn),
+		Name:           aws.String(p.Name),
+		Enabled:        p.Enabled,
+	}
+	if p.IPAddressType != nil {
+		in.IpAddressType = globalaccelerator.IpAddressType(*p.IPAddressType)
+	}
+	return in
+}
+
+// GenerateAcceleratorObservation returns the observation of the given
+// accelerator.
+func GenerateAcceleratorObservation(o globalaccelerator.Accelerator) v1alpha1.AcceleratorObservation {
+	res := v1alpha1.AcceleratorObservation{
+		ARN:     aws.StringValue(o.AcceleratorArn),
+		DNSName: aws.StringValue(o.DnsName),
+		Status:  string(o.Status),
+	}
+	for _, s := range o.IpSets {
+		res.IPSets = append(res.IPSets, v1alpha1.IPSet{
+			IPFamily:    aws.StringValue(s.IpFamily),
+			IPAddresses: s.IpAddresses,
+		})
+	}
+	return res
+}
+
+// LateInitializeAccelerator fills the empty fields of the accelerator
+// parameters with the values of the observed accelerator.
+func LateInitializeAccelerator(in *v1alpha1.AcceleratorParameters, o *globalaccelerator.Accelerator) {
+	if o == nil {
+		return
+	}
+	in.Enabled = awsclients.LateInitializeBoolPtr(in.Enabled, o.Enabled)
+	if in.IPAddressType == nil && o.IpAddressType != "" {
+		in.IPAddressType = aws.String(string(o.IpAddressType))
+	}
+}
+
+// IsAcceleratorUpToDate returns true if the observed accelerator matches the
+// parameters.
+func IsAcceleratorUpToDate(p v1alpha1.AcceleratorParameters, o globalaccelerator.Accelerator) bool {
+	if p.Name != aws.StringValue(o.Name) {
+		return false
+	}
+	if p.Enabled != nil && *p.Enabled != aws.BoolValue(o.Enabled) {
+		return false
+	}
+	return p.IPAddressType == nil || *p.IPAddressType == string(o.IpAddressType)
+}
+
+// GetAcceleratorConnectionDetails returns the DNS name of the accelerator as
+// the endpoint of its connection details.
+func GetAcceleratorConnectionDetails(cr v1alpha1.Accelerator) managed.ConnectionDetails {
+	if cr.Status.AtProvider.DNSName == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.DNSName),
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalaccelerator
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+)
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []globalaccelerator.Tag
+		remove []string
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []globalaccelerator.Tag
+		want     want
+	}{
+		"NoChange": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			observed: []globalaccelerator.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			want:     want{remove: []string{}},
+		},
+		"AddAndRemove": {
+			desired: []v1alpha1.Tag{
+				{Key: "changed", Value: "new"},
+				{Key: "added", Value: "v"},
+			},
+			observed: []globalaccelerator.Tag{
+				{Key: aws.String("changed"), Value: aws.String("old")},
+				{Key: aws.String("removed"), Value: aws.String("v")},
+			},
+			want: want{
+				add: []globalaccelerator.Tag{
+					{Key: aws.String("added"), Value: aws.String("v")},
+					{Key: aws.String("changed"), Value: aws.String("new")},
+				},
+				remove: []string{"changed", "removed"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			sort.Slice(add, func(i, j int) bool { return *add[i].Key < *add[j].Key })
+			sort.Strings(remove)
+			if diff := cmp.Diff(tc.want.add, add, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAcceleratorUpToDate(t *testing.T) {
+	observed := globalaccelerator.Accelerator{
+		Name:          aws.String("example"),
+		Enabled:       aws.Bool(true),
+		IpAddressType: globalaccelerator.IpAddressTypeIpv4,
+	}
+	cases := map[string]struct {
+		p    v1alpha1.AcceleratorParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.AcceleratorParameters{Name: "example", Enabled: aws.Bool(true), IPAddressType: aws.String("IPV4")},
+			want: true,
+		},
+		"Unset": {
+			p:    v1alpha1.AcceleratorParameters{Name: "example"},
+			want: true,
+		},
+		"NameChanged": {
+			p:    v1alpha1.AcceleratorParameters{Name: "other"},
+			want: false,
+		},
+		"Disabled": {
+			p:    v1alpha1.AcceleratorParameters{Name: "example", Enabled: aws.Bool(false)},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAcceleratorUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
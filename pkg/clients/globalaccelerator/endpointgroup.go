@@ -0,0 +1,189 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalaccelerator
+
+import (
+	"math"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// EndpointGroupClient is the external client used for EndpointGroup Custom
+// Resource
+type EndpointGroupClient interface {
+	DescribeEndpointGroupRequest(*globalaccelerator.DescribeEndpointGroupInput) globalaccelerator.DescribeEndpointGroupRequest
+	CreateEndpointGroupRequest(*globalaccelerator.CreateEndpointGroupInput) globalaccelerator.CreateEndpointGroupRequest
+	UpdateEndpointGroupRequest(*globalaccelerator.UpdateEndpointGroupInput) globalaccelerator.UpdateEndpointGroupRequest
+	DeleteEndpointGroupRequest(*globalaccelerator.DeleteEndpointGroupInput) globalaccelerator.DeleteEndpointGroupRequest
+}
+
+// NewEndpointGroupClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewEndpointGroupClient(cfg aws.Config) EndpointGroupClient {
+	return globalaccelerator.New(cfg)
+}
+
+// IsEndpointGroupNotFound returns true if the error is because the endpoint
+// group doesn't exist.
+func IsEndpointGroupNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == globalaccelerator.ErrCodeEndpointGroupNotFoundException {
+		return true
+	}
+	return false
+}
+
+// GenerateEndpointConfigurations returns the Global Accelerator endpoint
+// configurations of the given endpoints. The returned list is never nil, so
+// that an update removes all endpoints if none is given.
+func GenerateEndpointConfigurations(in []v1alpha1.EndpointConfiguration) []globalaccelerator.EndpointConfiguration {
+	res := make([]globalaccelerator.EndpointConfiguration, len(in))
+	for i, e := range in {
+		res[i] = globalaccelerator.EndpointConfiguration{
+			EndpointId:                  e.EndpointID,
+			Weight:                      e.Weight,
+			ClientIPPreservationEnabled: e.ClientIPPreservationEnabled,
+		}
+	}
+	return res
+}
+
+func trafficDialPercentage(p *int64) *float64 {
+	if p == nil {
+		return nil
+	}
+	return aws.Float64(float64(*p))
+}
+
+// GenerateCreateEndpointGroupInput returns the create input of an endpoint
+// group with the given parameters. The idempotency token makes sure that
+// retries don't create more than one endpoint group.
+func GenerateCreateEndpointGroupInput(idempotencyToken string, p v1alpha1.EndpointGroupParameters) *globalaccelerator.CreateEndpointGroupInput {
+	in := &globalaccelerator.CreateEndpointGroupInput{
+		ListenerArn:                p.ListenerARN,
+		EndpointGroupRegion:        aws.String(p.EndpointGroupRegion),
+		EndpointConfigurations:     GenerateEndpointConfigurations(p.EndpointConfigurations),
+		IdempotencyToken:           aws.String(idempotencyToken),
+		TrafficDialPercentage:      trafficDialPercentage(p.TrafficDialPercentage),
+		HealthCheckPort:            p.HealthCheckPort,
+		HealthCheckPath:            p.HealthCheckPath,
+		HealthCheckIntervalSeconds: p.HealthCheckIntervalSeconds,
+		ThresholdCount:             p.ThresholdCount,
+	}
+	if p.HealthCheckProtocol != nil {
+		in.HealthCheckProtocol = globalaccelerator.HealthCheckProtocol(*p.HealthCheckProtocol)
+	}
+	return in
+}
+
+// GenerateUpdateEndpointGroupInput returns the update input of the endpoint
+// group with the given ARN and parameters. It replaces all endpoints of the
+// endpoint group.
+func GenerateUpdateEndpointGroupInput(arn string, p v1alpha1.EndpointGroupParameters) *globalaccelerator.UpdateEndpointGroupInput {
+	in := &globalaccelerator.UpdateEndpointGroupInput{
+		EndpointGroupArn:           aws.String(arn),
+		EndpointConfigurations:     GenerateEndpointConfigurations(p.EndpointConfigurations),
+		TrafficDialPercentage:      trafficDialPercentage(p.TrafficDialPercentage),
+		HealthCheckPort:            p.HealthCheckPort,
+		HealthCheckPath:            p.HealthCheckPath,
+		HealthCheckIntervalSeconds: p.HealthCheckIntervalSeconds,
+		ThresholdCount:             p.ThresholdCount,
+	}
+	if p.HealthCheckProtocol != nil {
+		in.HealthCheckProtocol = globalaccelerator.HealthCheckProtocol(*p.HealthCheckProtocol)
+	}
+	return in
+}
+
+// GenerateEndpointGroupObservation returns the observation of the given
+// endpoint group.
+func GenerateEndpointGroupObservation(o globalaccelerator.EndpointGroup) v1alpha1.EndpointGroupObservation {
+	res := v1alpha1.EndpointGroupObservation{ARN: aws.StringValue(o.EndpointGroupArn)}
+	for _, e := range o.EndpointDescriptions {
+		res.Endpoints = append(res.Endpoints, v1alpha1.EndpointObservation{
+			EndpointID:   aws.StringValue(e.EndpointId),
+			HealthState:  string(e.HealthState),
+			HealthReason: aws.StringValue(e.HealthReason),
+		})
+	}
+	return res
+}
+
+// LateInitializeEndpointGroup fills the empty fields of the endpoint group
+// parameters with the values of the observed endpoint group.
+func LateInitializeEndpointGroup(in *v1alpha1.EndpointGroupParameters, o *globalaccelerator.EndpointGroup) {
+	if o == nil {
+		return
+	}
+	if in.TrafficDialPercentage == nil && o.TrafficDialPercentage != nil {
+		in.TrafficDialPercentage = aws.Int64(int64(math.Round(*o.TrafficDialPercentage)))
+	}
+	if in.HealthCheckProtocol == nil && o.HealthCheckProtocol != "" {
+		in.HealthCheckProtocol = aws.String(string(o.HealthCheckProtocol))
+	}
+	in.HealthCheckPort = awsclients.LateInitializeInt64Ptr(in.HealthCheckPort, o.HealthCheckPort)
+	in.HealthCheckPath = awsclients.LateInitializeStringPtr(in.HealthCheckPath, o.HealthCheckPath)
+	in.HealthCheckIntervalSeconds = awsclients.LateInitializeInt64Ptr(in.HealthCheckIntervalSeconds, o.HealthCheckIntervalSeconds)
+	in.ThresholdCount = awsclients.LateInitializeInt64Ptr(in.ThresholdCount, o.ThresholdCount)
+}
+
+// IsEndpointGroupUpToDate returns true if the observed endpoint group matches
+// the parameters.
+func IsEndpointGroupUpToDate(p v1alpha1.EndpointGroupParameters, o globalaccelerator.EndpointGroup) bool { // nolint:gocyclo
+	switch {
+	case p.TrafficDialPercentage != nil && (o.TrafficDialPercentage == nil || *p.TrafficDialPercentage != int64(math.Round(*o.TrafficDialPercentage))):
+		return false
+	case p.HealthCheckProtocol != nil && *p.HealthCheckProtocol != string(o.HealthCheckProtocol):
+		return false
+	case p.HealthCheckPort != nil && *p.HealthCheckPort != aws.Int64Value(o.HealthCheckPort):
+		return false
+	case p.HealthCheckPath != nil && *p.HealthCheckPath != aws.StringValue(o.HealthCheckPath):
+		return false
+	case p.HealthCheckIntervalSeconds != nil && *p.HealthCheckIntervalSeconds != aws.Int64Value(o.HealthCheckIntervalSeconds):
+		return false
+	case p.ThresholdCount != nil && *p.ThresholdCount != aws.Int64Value(o.ThresholdCount):
+		return false
+	}
+	return areEndpointsUpToDate(p.EndpointConfigurations, o.EndpointDescriptions)
+}
+
+func areEndpointsUpToDate(desired []v1alpha1.EndpointConfiguration, observed []globalaccelerator.EndpointDescription) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	byID := make(map[string]globalaccelerator.EndpointDescription, len(observed))
+	for _, e := range observed {
+		byID[aws.StringValue(e.EndpointId)] = e
+	}
+	for _, e := range desired {
+		o, ok := byID[aws.StringValue(e.EndpointID)]
+		if !ok {
+			return false
+		}
+		if e.Weight != nil && *e.Weight != aws.Int64Value(o.Weight) {
+			return false
+		}
+		if e.ClientIPPreservationEnabled != nil && *e.ClientIPPreservationEnabled != aws.BoolValue(o.ClientIPPreservationEnabled) {
+			return false
+		}
+	}
+	return true
+}
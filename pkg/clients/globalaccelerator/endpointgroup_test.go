@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalaccelerator
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+)
+
+var (
+	lbARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/example/50dc6c495c0c9188"
+
+	observedEndpointGroup = globalaccelerator.EndpointGroup{
+		EndpointGroupArn:           aws.String("arn:aws:globalaccelerator::123456789012:accelerator/1234/listener/abcd/endpoint-group/ef01"),
+		EndpointGroupRegion:        aws.String("us-east-1"),
+		TrafficDialPercentage:      aws.Float64(100),
+		HealthCheckProtocol:        globalaccelerator.HealthCheckProtocolTcp,
+		HealthCheckPort:            aws.Int64(443),
+		HealthCheckIntervalSeconds: aws.Int64(30),
+		ThresholdCount:             aws.Int64(3),
+		EndpointDescriptions: []globalaccelerator.EndpointDescription{{
+			EndpointId:                  aws.String(lbARN),
+			Weight:                      aws.Int64(128),
+			ClientIPPreservationEnabled: aws.Bool(false),
+			HealthState:                 globalaccelerator.HealthStateHealthy,
+		}},
+	}
+)
+
+func TestLateInitializeEndpointGroup(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.EndpointGroupParameters
+		want v1alpha1.EndpointGroupParameters
+	}{
+		"AllUnset": {
+			p: v1alpha1.EndpointGroupParameters{EndpointGroupRegion: "us-east-1"},
+			want: v1alpha1.EndpointGroupParameters{
+				EndpointGroupRegion:        "us-east-1",
+				TrafficDialPercentage:      aws.Int64(100),
+				HealthCheckProtocol:        aws.String("TCP"),
+				HealthCheckPort:            aws.Int64(443),
+				HealthCheckIntervalSeconds: aws.Int64(30),
+				ThresholdCount:             aws.Int64(3),
+			},
+		},
+		"TrafficDialSet": {
+			p: v1alpha1.EndpointGroupParameters{TrafficDialPercentage: aws.Int64(0)},
+			want: v1alpha1.EndpointGroupParameters{
+				TrafficDialPercentage:      aws.Int64(0),
+				HealthCheckProtocol:        aws.String("TCP"),
+				HealthCheckPort:            aws.Int64(443),
+				HealthCheckIntervalSeconds: aws.Int64(30),
+				ThresholdCount:             aws.Int64(3),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeEndpointGroup(&tc.p, &observedEndpointGroup)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsEndpointGroupUpToDate(t *testing.T) {
+	endpoints := []v1alpha1.EndpointConfiguration{{EndpointID: aws.String(lbARN), Weight: aws.Int64(128)}}
+	cases := map[string]struct {
+		p    v1alpha1.EndpointGroupParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.EndpointGroupParameters{TrafficDialPercentage: aws.Int64(100), EndpointConfigurations: endpoints},
+			want: true,
+		},
+		"TrafficDialChanged": {
+			p:    v1alpha1.EndpointGroupParameters{TrafficDialPercentage: aws.Int64(50), EndpointConfigurations: endpoints},
+			want: false,
+		},
+		"HealthCheckChanged": {
+			p:    v1alpha1.EndpointGroupParameters{HealthCheckPath: aws.String("/healthz"), EndpointConfigurations: endpoints},
+			want: false,
+		},
+		"WeightChanged": {
+			p:    v1alpha1.EndpointGroupParameters{EndpointConfigurations: []v1alpha1.EndpointConfiguration{{EndpointID: aws.String(lbARN), Weight: aws.Int64(0)}}},
+			want: false,
+		},
+		"EndpointRemoved": {
+			p:    v1alpha1.EndpointGroupParameters{},
+			want: false,
+		},
+		"EndpointReplaced": {
+			p:    v1alpha1.EndpointGroupParameters{EndpointConfigurations: []v1alpha1.EndpointConfiguration{{EndpointID: aws.String("eipalloc-12345678")}}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsEndpointGroupUpToDate(tc.p, observedEndpointGroup)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
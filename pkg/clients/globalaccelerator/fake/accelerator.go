@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/globalaccelerator"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AcceleratorClient = (*MockAcceleratorClient)(nil)
+
+// MockAcceleratorClient is a type that implements all the methods for AcceleratorClient interface
+type MockAcceleratorClient struct {
+	MockDescribeAccelerator func(*globalaccelerator.DescribeAcceleratorInput) globalaccelerator.DescribeAcceleratorRequest
+	MockCreateAccelerator   func(*globalaccelerator.CreateAcceleratorInput) globalaccelerator.CreateAcceleratorRequest
+	MockUpdateAccelerator   func(*globalaccelerator.UpdateAcceleratorInput) globalaccelerator.UpdateAcceleratorRequest
+	MockDeleteAccelerator   func(*globalaccelerator.DeleteAcceleratorInput) globalaccelerator.DeleteAcceleratorRequest
+	MockListTagsForResource func(*globalaccelerator.ListTagsForResourceInput) globalaccelerator.ListTagsForResourceRequest
+	MockTagResource         func(*globalaccelerator.TagResourceInput) globalaccelerator.TagResourceRequest
+	MockUntagResource       func(*globalaccelerator.UntagResourceInput) globalaccelerator.UntagResourceRequest
+}
+
+// DescribeAcceleratorRequest mocks DescribeAcceleratorRequest method
+func (m *MockAcceleratorClient) DescribeAcceleratorRequest(input *globalaccelerator.DescribeAcceleratorInput) globalaccelerator.DescribeAcceleratorRequest {
+	return m.MockDescribeAccelerator(input)
+}
+
+// CreateAcceleratorRequest mocks CreateAcceleratorRequest method
+func (m *MockAcceleratorClient) CreateAcceleratorRequest(input *globalaccelerator.CreateAcceleratorInput) globalaccelerator.CreateAcceleratorRequest {
+	return m.MockCreateAccelerator(input)
+}
+
+// UpdateAcceleratorRequest mocks UpdateAcceleratorRequest method
+func (m *MockAcceleratorClient) UpdateAcceleratorRequest(input *globalaccelerator.UpdateAcceleratorInput) globalaccelerator.UpdateAcceleratorRequest {
+	return m.MockUpdateAccelerator(input)
+}
+
+// DeleteAcceleratorRequest mocks DeleteAcceleratorRequest method
+func (m *MockAcceleratorClient) DeleteAcceleratorRequest(input *globalaccelerator.DeleteAcceleratorInput) globalaccelerator.DeleteAcceleratorRequest {
+	return m.MockDeleteAccelerator(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockAcceleratorClient) ListTagsForResourceRequest(input *globalaccelerator.ListTagsForResourceInput) globalaccelerator.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockAcceleratorClient) TagResourceRequest(input *globalaccelerator.TagResourceInput) globalaccelerator.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockAcceleratorClient) UntagResourceRequest(input *globalaccelerator.UntagResourceInput) globalaccelerator.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
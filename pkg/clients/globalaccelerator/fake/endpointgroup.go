@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/globalaccelerator"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.EndpointGroupClient = (*MockEndpointGroupClient)(nil)
+
+// MockEndpointGroupClient is a type that implements all the methods for EndpointGroupClient interface
+type MockEndpointGroupClient struct {
+	MockDescribeEndpointGroup func(*globalaccelerator.DescribeEndpointGroupInput) globalaccelerator.DescribeEndpointGroupRequest
+	MockCreateEndpointGroup   func(*globalaccelerator.CreateEndpointGroupInput) globalaccelerator.CreateEndpointGroupRequest
+	MockUpdateEndpointGroup   func(*globalaccelerator.UpdateEndpointGroupInput) globalaccelerator.UpdateEndpointGroupRequest
+	MockDeleteEndpointGroup   func(*globalaccelerator.DeleteEndpointGroupInput) globalaccelerator.DeleteEndpointGroupRequest
+}
+
+// DescribeEndpointGroupRequest mocks DescribeEndpointGroupRequest method
+func (m *MockEndpointGroupClient) DescribeEndpointGroupRequest(input *globalaccelerator.DescribeEndpointGroupInput) globalaccelerator.DescribeEndpointGroupRequest {
+	return m.MockDescribeEndpointGroup(input)
+}
+
+// CreateEndpointGroupRequest mocks CreateEndpointGroupRequest method
+func (m *MockEndpointGroupClient) CreateEndpointGroupRequest(input *globalaccelerator.CreateEndpointGroupInput) globalaccelerator.CreateEndpointGroupRequest {
+	return m.MockCreateEndpointGroup(input)
+}
+
+// UpdateEndpointGroupRequest mocks UpdateEndpointGroupRequest method
+func (m *MockEndpointGroupClient) UpdateEndpointGroupRequest(input *globalaccelerator.UpdateEndpointGroupInput) globalaccelerator.UpdateEndpointGroupRequest {
+	return m.MockUpdateEndpointGroup(input)
+}
+
+// DeleteEndpointGroupRequest mocks DeleteEndpointGroupRequest method
+func (m *MockEndpointGroupClient) DeleteEndpointGroupRequest(input *globalaccelerator.DeleteEndpointGroupInput) globalaccelerator.DeleteEndpointGroupRequest {
+	return m.MockDeleteEndpointGroup(input)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/globalaccelerator"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ListenerClient = (*MockListenerClient)(nil)
+
+// MockListenerClient is a type that implements all the methods for ListenerClient interface
+type MockListenerClient struct {
+	MockDescribeListener func(*globalaccelerator.DescribeListenerInput) globalaccelerator.DescribeListenerRequest
+	MockCreateListener   func(*globalaccelerator.CreateListenerInput) globalaccelerator.CreateListenerRequest
+	MockUpdateListener   func(*globalaccelerator.UpdateListenerInput) globalaccelerator.UpdateListenerRequest
+	MockDeleteListener   func(*globalaccelerator.DeleteListenerInput) globalaccelerator.DeleteListenerRequest
+}
+
+// DescribeListenerRequest mocks DescribeListenerRequest method
+func (m *MockListenerClient) DescribeListenerRequest(input *globalaccelerator.DescribeListenerInput) globalaccelerator.DescribeListenerRequest {
+	return m.MockDescribeListener(input)
+}
+
+// CreateListenerRequest mocks CreateListenerRequest method
+func (m *MockListenerClient) CreateListenerRequest(input *globalaccelerator.CreateListenerInput) globalaccelerator.CreateListenerRequest {
+	return m.MockCreateListener(input)
+}
+
+// UpdateListenerRequest mocks UpdateListenerRequest method
+func (m *MockListenerClient) UpdateListenerRequest(input *globalaccelerator.UpdateListenerInput) globalaccelerator.UpdateListenerRequest {
+	return m.MockUpdateListener(input)
+}
+
+// DeleteListenerRequest mocks DeleteListenerRequest method
+func (m *MockListenerClient) DeleteListenerRequest(input *globalaccelerator.DeleteListenerInput) globalaccelerator.DeleteListenerRequest {
+	return m.MockDeleteListener(input)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalaccelerator
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+)
+
+// ListenerClient is the external client used for Listener Custom Resource
+type ListenerClient interface {
+	DescribeListenerRequest(*globalaccelerator.DescribeListenerInput) globalaccelerator.DescribeListenerRequest
+	CreateListenerRequest(*globalaccelerator.CreateListenerInput) globalaccelerator.CreateListenerRequest
+	UpdateListenerRequest(*globalaccelerator.UpdateListenerInput) globalaccelerator.UpdateListenerRequest
+	DeleteListenerRequest(*globalaccelerator.DeleteListenerInput) globalaccelerator.DeleteListenerRequest
+}
+
+// NewListenerClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewListenerClient(cfg aws.Config) ListenerClient {
+	return globalaccelerator.New(cfg)
+}
+
+// IsListenerNotFound returns true if the error is because the listener
+// doesn't exist.
+func IsListenerNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == globalaccelerator.ErrCodeListenerNotFoundException {
+		return true
+	}
+	return false
+}
+
+// GeneratePortRanges returns the Global Accelerator port ranges of the given
+// port ranges.
+func GeneratePortRanges(in []v1alpha1.PortRange) []globalaccelerator.PortRange {
+	if len(in) == 0 {
+		return nil
+	}
+	res := make([]globalaccelerator.PortRange, len(in))
+	for i, r := range in {
+		res[i] = globalaccelerator.PortRange{FromPort: aws.Int64(r.FromPort), ToPort: aws.Int64(r.ToPort)}
+	}
+	return res
+}
+
+// GenerateCreateListenerInput returns the create input of a listener with the
+// given parameters. The idempotency token makes sure that retries don't
+// create more than one listener.
+func GenerateCreateListenerInput(idempotencyToken string, p v1alpha1.ListenerParameters) *globalaccelerator.CreateListenerInput {
+	in := &globalaccelerator.CreateListenerInput{
+		AcceleratorArn:   p.AcceleratorARN,
+		IdempotencyToken: aws.String(idempotencyToken),
+		PortRanges:       GeneratePortRanges(p.PortRanges),
+		Protocol:         globalaccelerator.Protocol(p.Protocol),
+	}
+	if p.ClientAffinity != nil {
+		in.ClientAffinity = globalaccelerator.Affinity(*p.ClientAffinity)
+	}
+	return in
+}
+
+// GenerateUpdateListenerInput returns the update input of the listener with
+// the given ARN and parameters.
+func GenerateUpdateListenerInput(arn string, p v1alpha1.ListenerParameters) *globalaccelerator.UpdateListenerInput {
+	in := &globalaccelerator.UpdateListenerInput{
+		ListenerArn: aws.String(arn),
+		PortRanges:  GeneratePortRanges(p.PortRanges),
+		Protocol:    globalaccelerator.Protocol(p.Protocol),
+	}
+	if p.ClientAffinity != nil {
+		in.ClientAffinity = globalaccelerator.Affinity(*p.ClientAffinity)
+	}
+	return in
+}
+
+// GenerateListenerObservation returns the observation of the given listener.
+func GenerateListenerObservation(o globalaccelerator.Listener) v1alpha1.ListenerObservation {
+	return v1alpha1.ListenerObservation{ARN: aws.StringValue(o.ListenerArn)}
+}
+
+// LateInitializeListener fills the empty fields of the listener parameters
+// with the values of the observed listener.
+func LateInitializeListener(in *v1alpha1.ListenerParameters, o *globalaccelerator.Listener) {
+	if o == nil {
+		return
+	}
+	if in.ClientAffinity == nil && o.ClientAffinity != "" {
+		in.ClientAffinity = aws.String(string(o.ClientAffinity))
+	}
+}
+
+// IsListenerUpToDate returns true if the observed listener matches the
+// parameters.
+func IsListenerUpToDate(p v1alpha1.ListenerParameters, o globalaccelerator.Listener) bool {
+	if p.Protocol != string(o.Protocol) {
+		return false
+	}
+	if p.ClientAffinity != nil && *p.ClientAffinity != string(o.ClientAffinity) {
+		return false
+	}
+	if len(p.PortRanges) != len(o.PortRanges) {
+		return false
+	}
+	observed := make(map[v1alpha1.PortRange]bool, len(o.PortRanges))
+	for _, r := range o.PortRanges {
+		observed[v1alpha1.PortRange{FromPort: aws.Int64Value(r.FromPort), ToPort: aws.Int64Value(r.ToPort)}] = true
+	}
+	for _, r := range p.PortRanges {
+		if !observed[r] {
+			return false
+		}
+	}
+	return true
+}
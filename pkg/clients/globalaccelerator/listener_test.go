@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalaccelerator
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+)
+
+func TestIsListenerUpToDate(t *testing.T) {
+	observed := globalaccelerator.Listener{
+		Protocol:       globalaccelerator.ProtocolTcp,
+		ClientAffinity: globalaccelerator.AffinityNone,
+		PortRanges: []globalaccelerator.PortRange{
+			{FromPort: aws.Int64(80), ToPort: aws.Int64(80)},
+			{FromPort: aws.Int64(443), ToPort: aws.Int64(443)},
+		},
+	}
+	ports := []v1alpha1.PortRange{{FromPort: 443, ToPort: 443}, {FromPort: 80, ToPort: 80}}
+	cases := map[string]struct {
+		p    v1alpha1.ListenerParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.ListenerParameters{Protocol: "TCP", PortRanges: ports, ClientAffinity: aws.String("NONE")},
+			want: true,
+		},
+		"ProtocolChanged": {
+			p:    v1alpha1.ListenerParameters{Protocol: "UDP", PortRanges: ports},
+			want: false,
+		},
+		"ClientAffinityChanged": {
+			p:    v1alpha1.ListenerParameters{Protocol: "TCP", PortRanges: ports, ClientAffinity: aws.String("SOURCE_IP")},
+			want: false,
+		},
+		"PortRangeRemoved": {
+			p:    v1alpha1.ListenerParameters{Protocol: "TCP", PortRanges: ports[:1]},
+			want: false,
+		},
+		"PortRangeChanged": {
+			p:    v1alpha1.ListenerParameters{Protocol: "TCP", PortRanges: []v1alpha1.PortRange{{FromPort: 443, ToPort: 443}, {FromPort: 8080, ToPort: 8080}}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsListenerUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
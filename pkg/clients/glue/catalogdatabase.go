@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package glue
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// CatalogDatabaseClient is the external client used for CatalogDatabase
+// Custom Resource
+type CatalogDatabaseClient interface {
+	GetDatabaseRequest(*glue.GetDatabaseInput) glue.GetDatabaseRequest
+	CreateDatabaseRequest(*glue.CreateDatabaseInput) glue.CreateDatabaseRequest
+	UpdateDatabaseRequest(*glue.UpdateDatabaseInput) glue.UpdateDatabaseRequest
+	DeleteDatabaseRequest(*glue.DeleteDatabaseInput) glue.DeleteDatabaseRequest
+}
+
+// NewCatalogDatabaseClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewCatalogDatabaseClient(cfg aws.Config) CatalogDatabaseClient {
+	return glue.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the Glue resource doesn't
+// exist.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == glue.ErrCodeEntityNotFoundException
+}
+
+// GenerateDatabaseInput returns the database input of the database with the
+// given name and parameters.
+func GenerateDatabaseInput(name string, p v1alpha1.CatalogDatabaseParameters) *glue.DatabaseInput {
+	return &glue.DatabaseInput{
+		Name:        aws.String(name),
+		Description: p.Description,
+		LocationUri: p.LocationURI,
+		Parameters:  p.Parameters,
+	}
+}
+
+// GenerateCatalogDatabaseObservation returns the observation of the given
+// database.
+func GenerateCatalogDatabaseObservation(o glue.Database) v1alpha1.CatalogDatabaseObservation {
+	obs := v1alpha1.CatalogDatabaseObservation{}
+	if o.CreateTime != nil {
+		t := metav1.NewTime(*o.CreateTime)
+		obs.CreateTime = &t
+	}
+	return obs
+}
+
+// LateInitializeCatalogDatabase fills the empty fields of the given
+// parameters with the values of the observed database.
+func LateInitializeCatalogDatabase(p *v1alpha1.CatalogDatabaseParameters, o glue.Database) {
+	p.Description = awsclients.LateInitializeStringPtr(p.Description, o.Description)
+	p.LocationURI = awsclients.LateInitializeStringPtr(p.LocationURI, o.LocationUri)
+	if p.Parameters == nil && len(o.Parameters) != 0 {
+		p.Parameters = o.Parameters
+	}
+}
+
+// IsCatalogDatabaseUpToDate returns whether the observed database is up to
+// date with the given parameters.
+func IsCatalogDatabaseUpToDate(p v1alpha1.CatalogDatabaseParameters, o glue.Database) bool {
+	return aws.StringValue(p.Description) == aws.StringValue(o.Description) &&
+		aws.StringValue(p.LocationURI) == aws.StringValue(o.LocationUri) &&
+		cmp.Equal(p.Parameters, o.Parameters, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package glue
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// CrawlerClient is the external client used for Crawler Custom Resource
+type CrawlerClient interface {
+	GetCrawlerRequest(*glue.GetCrawlerInput) glue.GetCrawlerRequest
+	CreateCrawlerRequest(*glue.CreateCrawlerInput) glue.CreateCrawlerRequest
+	UpdateCrawlerRequest(*glue.UpdateCrawlerInput) glue.UpdateCrawlerRequest
+	DeleteCrawlerRequest(*glue.DeleteCrawlerInput) glue.DeleteCrawlerRequest
+}
+
+// NewCrawlerClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewCrawlerClient(cfg aws.Config) CrawlerClient {
+	return glue.New(cfg)
+}
+
+func generateCrawlerTargets(t v1alpha1.CrawlerTargets) *glue.CrawlerTargets {
+	s3 := make([]glue.S3Target, len(t.S3Targets))
+	for i, s := range t.S3Targets {
+		s3[i] = glue.S3Target{Path: aws.String(s.Path), Exclusions: s.Exclusions}
+	}
+	return &glue.CrawlerTargets{S3Targets: s3}
+}
+
+func generateSchemaChangePolicy(s *v1alpha1.SchemaChangePolicy) *glue.SchemaChangePolicy {
+	if s == nil {
+		return nil
+	}
+	return &glue.SchemaChangePolicy{
+		UpdateBehavior: glue.UpdateBehavior(aws.StringValue(s.UpdateBehavior)),
+		DeleteBehavior: glue.DeleteBehavior(aws.StringValue(s.DeleteBehavior)),
+	}
+}
+
+// GenerateCreateCrawlerInput returns the create input of the crawler with
+// the given name and parameters.
+func GenerateCreateCrawlerInput(name string, p v1alpha1.CrawlerParameters) *glue.CreateCrawlerInput {
+	return &glue.CreateCrawlerInput{
+		Name:                         aws.String(name),
+		Role:                         p.RoleARN,
+		DatabaseName:                 p.DatabaseName,
+		Targets:                      generateCrawlerTargets(p.Targets),
+		Description:                  p.Description,
+		Schedule:                     p.Schedule,
+		SchemaChangePolicy:           generateSchemaChangePolicy(p.SchemaChangePolicy),
+		TablePrefix:                  p.TablePrefix,
+		Classifiers:                  p.Classifiers,
+		Configuration:                p.Configuration,
+		CrawlerSecurityConfiguration: p.CrawlerSecurityConfiguration,
+		Tags:                         p.Tags,
+	}
+}
+
+// GenerateUpdateCrawlerInput returns the update input of the crawler with
+// the given name and parameters.
+func GenerateUpdateCrawlerInput(name string, p v1alpha1.CrawlerParameters) *glue.UpdateCrawlerInput {
+	return &glue.UpdateCrawlerInput{
+		Name:                         aws.String(name),
+		Role:                         p.RoleARN,
+		DatabaseName:                 p.DatabaseName,
+		Targets:                      generateCrawlerTargets(p.Targets),
+		Description:                  p.Description,
+		Schedule:                     p.Schedule,
+		SchemaChangePolicy:           generateSchemaChangePolicy(p.SchemaChangePolicy),
+		TablePrefix:                  p.TablePrefix,
+		Classifiers:                  p.Classifiers,
+		Configuration:                p.Configuration,
+		CrawlerSecurityConfiguration: p.CrawlerSecurityConfiguration,
+	}
+}
+
+// GenerateCrawlerObservation returns the observation of the given crawler.
+func GenerateCrawlerObservation(o glue.Crawler) v1alpha1.CrawlerObservation {
+	obs := v1alpha1.CrawlerObservation{
+		State:   string(o.State),
+		Version: aws.Int64Value(o.Version),
+	}
+	if o.Schedule != nil {
+		obs.ScheduleState = string(o.Schedule.State)
+	}
+	return obs
+}
+
+// LateInitializeCrawler fills the empty fields of the given parameters with
+// the values of the observed crawler.
+func LateInitializeCrawler(p *v1alpha1.CrawlerParameters, o glue.Crawler) {
+	p.Description = awsclients.LateInitializeStringPtr(p.Description, o.Description)
+	p.TablePrefix = awsclients.LateInitializeStringPtr(p.TablePrefix, o.TablePrefix)
+	if o.SchemaChangePolicy == nil {
+		return
+	}
+	if p.SchemaChangePolicy == nil {
+		p.SchemaChangePolicy = &v1alpha1.SchemaChangePolicy{}
+	}
+	if o.SchemaChangePolicy.UpdateBehavior != "" {
+		p.SchemaChangePolicy.UpdateBehavior = awsclients.LateInitializeStringPtr(p.SchemaChangePolicy.UpdateBehavior, aws.String(string(o.SchemaChangePolicy.UpdateBehavior)))
+	}
+	if o.SchemaChangePolicy.DeleteBehavior != "" {
+		p.SchemaChangePolicy.DeleteBehavior = awsclients.LateInitializeStringPtr(p.SchemaChangePolicy.DeleteBehavior, aws.String(string(o.SchemaChangePolicy.DeleteBehavior)))
+	}
+}
+
+// isSameRole returns whether the two roles are the same. Glue accepts both
+// the name and the ARN of a role but may report either of them.
+func isSameRole(a, b string) bool {
+	return a[strings.LastIndex(a, "/")+1:] == b[strings.LastIndex(b, "/")+1:]
+}
+
+// IsCrawlerUpToDate returns whether the observed crawler is up to date with
+// the given parameters.
+func IsCrawlerUpToDate(p v1alpha1.CrawlerParameters, o glue.Crawler) bool {
+	if !isSameRole(aws.StringValue(p.RoleARN), aws.StringValue(o.Role)) {
+		return false
+	}
+	desired := GenerateUpdateCrawlerInput(aws.StringValue(o.Name), p)
+	desired.Role = nil
+	observed := &glue.UpdateCrawlerInput{
+		Name:                         o.Name,
+		DatabaseName:                 o.DatabaseName,
+		Targets:                      &glue.CrawlerTargets{},
+		Description:                  o.Description,
+		SchemaChangePolicy:           o.SchemaChangePolicy,
+		TablePrefix:                  o.TablePrefix,
+		Classifiers:                  o.Classifiers,
+		Configuration:                o.Configuration,
+		CrawlerSecurityConfiguration: o.CrawlerSecurityConfiguration,
+	}
+	if o.Targets != nil {
+		observed.Targets.S3Targets = o.Targets.S3Targets
+	}
+	if o.Schedule != nil {
+		observed.Schedule = o.Schedule.ScheduleExpression
+	}
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(glue.UpdateCrawlerInput{}, glue.CrawlerTargets{}, glue.S3Target{}, glue.SchemaChangePolicy{}),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package glue
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+)
+
+func TestIsCrawlerUpToDate(t *testing.T) {
+	params := func() v1alpha1.CrawlerParameters {
+		return v1alpha1.CrawlerParameters{
+			RoleARN:      aws.String("arn:aws:iam::123456789012:role/service-role/glue-crawler"),
+			DatabaseName: aws.String("events"),
+			Targets: v1alpha1.CrawlerTargets{S3Targets: []v1alpha1.S3Target{
+				{Path: "s3://events/raw/"},
+			}},
+			Schedule:    aws.String("cron(15 12 * * ? *)"),
+			Classifiers: []string{"b", "a"},
+		}
+	}
+	crawler := func() glue.Crawler {
+		return glue.Crawler{
+			Name:         aws.String("events"),
+			Role:         aws.String("glue-crawler"),
+			DatabaseName: aws.String("events"),
+			Targets: &glue.CrawlerTargets{S3Targets: []glue.S3Target{
+				{Path: aws.String("s3://events/raw/"), Exclusions: []string{}},
+			}},
+			Schedule:    &glue.Schedule{ScheduleExpression: aws.String("cron(15 12 * * ? *)")},
+			Classifiers: []string{"a", "b"},
+		}
+	}
+
+	cases := map[string]struct {
+		p    func(*v1alpha1.CrawlerParameters)
+		o    func(*glue.Crawler)
+		want bool
+	}{
+		"UpToDate": {
+			want: true,
+		},
+		"RoleChanged": {
+			o:    func(o *glue.Crawler) { o.Role = aws.String("other") },
+			want: false,
+		},
+		"TargetChanged": {
+			p: func(p *v1alpha1.CrawlerParameters) {
+				p.Targets.S3Targets[0].Exclusions = []string{"*.tmp"}
+			},
+			want: false,
+		},
+		"ScheduleRemoved": {
+			p:    func(p *v1alpha1.CrawlerParameters) { p.Schedule = nil },
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p, o := params(), crawler()
+			if tc.p != nil {
+				tc.p(&p)
+			}
+			if tc.o != nil {
+				tc.o(&o)
+			}
+			if diff := cmp.Diff(tc.want, IsCrawlerUpToDate(p, o)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeCrawler(t *testing.T) {
+	p := v1alpha1.CrawlerParameters{}
+	LateInitializeCrawler(&p, glue.Crawler{
+		Description: aws.String("desc"),
+		SchemaChangePolicy: &glue.SchemaChangePolicy{
+			UpdateBehavior: glue.UpdateBehaviorUpdateInDatabase,
+			DeleteBehavior: glue.DeleteBehaviorDeprecateInDatabase,
+		},
+	})
+	want := v1alpha1.CrawlerParameters{
+		Description: aws.String("desc"),
+		SchemaChangePolicy: &v1alpha1.SchemaChangePolicy{
+			UpdateBehavior: aws.String("UPDATE_IN_DATABASE"),
+			DeleteBehavior: aws.String("DEPRECATE_IN_DATABASE"),
+		},
+	}
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/glue"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.CatalogDatabaseClient = (*MockCatalogDatabaseClient)(nil)
+
+// MockCatalogDatabaseClient is a type that implements all the methods for CatalogDatabaseClient interface
+type MockCatalogDatabaseClient struct {
+	MockGetDatabase    func(*glue.GetDatabaseInput) glue.GetDatabaseRequest
+	MockCreateDatabase func(*glue.CreateDatabaseInput) glue.CreateDatabaseRequest
+	MockUpdateDatabase func(*glue.UpdateDatabaseInput) glue.UpdateDatabaseRequest
+	MockDeleteDatabase func(*glue.DeleteDatabaseInput) glue.DeleteDatabaseRequest
+}
+
+// GetDatabaseRequest mocks GetDatabaseRequest method
+func (m *MockCatalogDatabaseClient) GetDatabaseRequest(input *glue.GetDatabaseInput) glue.GetDatabaseRequest {
+	return m.MockGetDatabase(input)
+}
+
+// CreateDatabaseRequest mocks CreateDatabaseRequest method
+func (m *MockCatalogDatabaseClient) CreateDatabaseRequest(input *glue.CreateDatabaseInput) glue.CreateDatabaseRequest {
+	return m.MockCreateDatabase(input)
+}
+
+// UpdateDatabaseRequest mocks UpdateDatabaseRequest method
+func (m *MockCatalogDatabaseClient) UpdateDatabaseRequest(input *glue.UpdateDatabaseInput) glue.UpdateDatabaseRequest {
+	return m.MockUpdateDatabase(input)
+}
+
+// DeleteDatabaseRequest mocks DeleteDatabaseRequest method
+func (m *MockCatalogDatabaseClient) DeleteDatabaseRequest(input *glue.DeleteDatabaseInput) glue.DeleteDatabaseRequest {
+	return m.MockDeleteDatabase(input)
+}
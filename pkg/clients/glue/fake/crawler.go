@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/glue"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.CrawlerClient = (*MockCrawlerClient)(nil)
+
+// MockCrawlerClient is a type that implements all the methods for CrawlerClient interface
+type MockCrawlerClient struct {
+	MockGetCrawler    func(*glue.GetCrawlerInput) glue.GetCrawlerRequest
+	MockCreateCrawler func(*glue.CreateCrawlerInput) glue.CreateCrawlerRequest
+	MockUpdateCrawler func(*glue.UpdateCrawlerInput) glue.UpdateCrawlerRequest
+	MockDeleteCrawler func(*glue.DeleteCrawlerInput) glue.DeleteCrawlerRequest
+}
+
+// GetCrawlerRequest mocks GetCrawlerRequest method
+func (m *MockCrawlerClient) GetCrawlerRequest(input *glue.GetCrawlerInput) glue.GetCrawlerRequest {
+	return m.MockGetCrawler(input)
+}
+
+// CreateCrawlerRequest mocks CreateCrawlerRequest method
+func (m *MockCrawlerClient) CreateCrawlerRequest(input *glue.CreateCrawlerInput) glue.CreateCrawlerRequest {
+	return m.MockCreateCrawler(input)
+}
+
+// UpdateCrawlerRequest mocks UpdateCrawlerRequest method
+func (m *MockCrawlerClient) UpdateCrawlerRequest(input *glue.UpdateCrawlerInput) glue.UpdateCrawlerRequest {
+	return m.MockUpdateCrawler(input)
+}
+
+// DeleteCrawlerRequest mocks DeleteCrawlerRequest method
+func (m *MockCrawlerClient) DeleteCrawlerRequest(input *glue.DeleteCrawlerInput) glue.DeleteCrawlerRequest {
+	return m.MockDeleteCrawler(input)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/glue"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.JobClient = (*MockJobClient)(nil)
+
+// MockJobClient is a type that implements all the methods for JobClient interface
+type MockJobClient struct {
+	MockGetJob    func(*glue.GetJobInput) glue.GetJobRequest
+	MockCreateJob func(*glue.CreateJobInput) glue.CreateJobRequest
+	MockUpdateJob func(*glue.UpdateJobInput) glue.UpdateJobRequest
+	MockDeleteJob func(*glue.DeleteJobInput) glue.DeleteJobRequest
+}
+
+// GetJobRequest mocks GetJobRequest method
+func (m *MockJobClient) GetJobRequest(input *glue.GetJobInput) glue.GetJobRequest {
+	return m.MockGetJob(input)
+}
+
+// CreateJobRequest mocks CreateJobRequest method
+func (m *MockJobClient) CreateJobRequest(input *glue.CreateJobInput) glue.CreateJobRequest {
+	return m.MockCreateJob(input)
+}
+
+// UpdateJobRequest mocks UpdateJobRequest method
+func (m *MockJobClient) UpdateJobRequest(input *glue.UpdateJobInput) glue.UpdateJobRequest {
+	return m.MockUpdateJob(input)
+}
+
+// DeleteJobRequest mocks DeleteJobRequest method
+func (m *MockJobClient) DeleteJobRequest(input *glue.DeleteJobInput) glue.DeleteJobRequest {
+	return m.MockDeleteJob(input)
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package glue
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// JobClient is the external client used for Job Custom Resource
+type JobClient interface {
+	GetJobRequest(*glue.GetJobInput) glue.GetJobRequest
+	CreateJobRequest(*glue.CreateJobInput) glue.CreateJobRequest
+	UpdateJobRequest(*glue.UpdateJobInput) glue.UpdateJobRequest
+	DeleteJobRequest(*glue.DeleteJobInput) glue.DeleteJobRequest
+}
+
+// NewJobClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewJobClient(cfg aws.Config) JobClient {
+	return glue.New(cfg)
+}
+
+// ScriptLocation returns the Amazon S3 path of the script of the given
+// command. ScriptLocation takes precedence over ScriptBucket and ScriptKey.
+func ScriptLocation(c v1alpha1.JobCommand) *string {
+	if c.ScriptLocation != nil || c.ScriptBucket == nil {
+		return c.ScriptLocation
+	}
+	return aws.String("s3://" + aws.StringValue(c.ScriptBucket) + "/" + aws.StringValue(c.ScriptKey))
+}
+
+// GenerateJobUpdate returns the definition of the job with the given
+// parameters. Glue replaces the whole definition of a job on update.
+func GenerateJobUpdate(p v1alpha1.JobParameters) *glue.JobUpdate {
+	u := &glue.JobUpdate{
+		Role: p.RoleARN,
+		Command: &glue.JobCommand{
+			Name:           aws.String(p.Command.Name),
+			PythonVersion:  p.Command.PythonVersion,
+			ScriptLocation: ScriptLocation(p.Command),
+		},
+		Description:             p.Description,
+		DefaultArguments:        p.DefaultArguments,
+		NonOverridableArguments: p.NonOverridableArguments,
+		GlueVersion:             p.GlueVersion,
+		WorkerType:              glue.WorkerType(aws.StringValue(p.WorkerType)),
+		NumberOfWorkers:         p.NumberOfWorkers,
+		MaxRetries:              p.MaxRetries,
+		Timeout:                 p.Timeout,
+		SecurityConfiguration:   p.SecurityConfiguration,
+	}
+	if len(p.Connections) != 0 {
+		u.Connections = &glue.ConnectionsList{Connections: p.Connections}
+	}
+	if p.MaxConcurrentRuns != nil {
+		u.ExecutionProperty = &glue.ExecutionProperty{MaxConcurrentRuns: p.MaxConcurrentRuns}
+	}
+	return u
+}
+
+// GenerateCreateJobInput returns the create input of the job with the given
+// name and parameters.
+func GenerateCreateJobInput(name string, p v1alpha1.JobParameters) *glue.CreateJobInput {
+	u := GenerateJobUpdate(p)
+	return &glue.CreateJobInput{
+		Name:                    aws.String(name),
+		Role:                    u.Role,
+		Command:                 u.Command,
+		Description:             u.Description,
+		Connections:             u.Connections,
+		DefaultArguments:        u.DefaultArguments,
+		NonOverridableArguments: u.NonOverridableArguments,
+		GlueVersion:             u.GlueVersion,
+		WorkerType:              u.WorkerType,
+		NumberOfWorkers:         u.NumberOfWorkers,
+		ExecutionProperty:       u.ExecutionProperty,
+		MaxRetries:              u.MaxRetries,
+		Timeout:                 u.Timeout,
+		SecurityConfiguration:   u.SecurityConfiguration,
+		Tags:                    p.Tags,
+	}
+}
+
+// GenerateJobObservation returns the observation of the given job.
+func GenerateJobObservation(o glue.Job) v1alpha1.JobObservation {
+	obs := v1alpha1.JobObservation{}
+	if o.CreatedOn != nil {
+		t := metav1.NewTime(*o.CreatedOn)
+		obs.CreatedOn = &t
+	}
+	if o.LastModifiedOn != nil {
+		t := metav1.NewTime(*o.LastModifiedOn)
+		obs.LastModifiedOn = &t
+	}
+	return obs
+}
+
+// LateInitializeJob fills the empty fields of the given parameters with the
+// values of the observed job.
+func LateInitializeJob(p *v1alpha1.JobParameters, o glue.Job) {
+	p.Description = awsclients.LateInitializeStringPtr(p.Description, o.Description)
+	p.GlueVersion = awsclients.LateInitializeStringPtr(p.GlueVersion, o.GlueVersion)
+	p.NumberOfWorkers = awsclients.LateInitializeInt64Ptr(p.NumberOfWorkers, o.NumberOfWorkers)
+	p.MaxRetries = awsclients.LateInitializeInt64Ptr(p.MaxRetries, o.MaxRetries)
+	p.Timeout = awsclients.LateInitializeInt64Ptr(p.Timeout, o.Timeout)
+	if o.WorkerType != "" {
+		p.WorkerType = awsclients.LateInitializeStringPtr(p.WorkerType, aws.String(string(o.WorkerType)))
+	}
+	if o.ExecutionProperty != nil {
+		p.MaxConcurrentRuns = awsclients.LateInitializeInt64Ptr(p.MaxConcurrentRuns, o.ExecutionProperty.MaxConcurrentRuns)
+	}
+	if o.Command != nil {
+		p.Command.PythonVersion = awsclients.LateInitializeStringPtr(p.Command.PythonVersion, o.Command.PythonVersion)
+	}
+}
+
+// IsJobUpToDate returns whether the observed job is up to date with the
+// given parameters.
+func IsJobUpToDate(p v1alpha1.JobParameters, o glue.Job) bool {
+	if !isSameRole(aws.StringValue(p.RoleARN), aws.StringValue(o.Role)) {
+		return false
+	}
+	desired := GenerateJobUpdate(p)
+	desired.Role = nil
+	observed := &glue.JobUpdate{
+		Command:                 o.Command,
+		Description:             o.Description,
+		Connections:             o.Connections,
+		DefaultArguments:        o.DefaultArguments,
+		NonOverridableArguments: o.NonOverridableArguments,
+		GlueVersion:             o.GlueVersion,
+		WorkerType:              o.WorkerType,
+		NumberOfWorkers:         o.NumberOfWorkers,
+		ExecutionProperty:       o.ExecutionProperty,
+		MaxRetries:              o.MaxRetries,
+		Timeout:                 o.Timeout,
+		SecurityConfiguration:   o.SecurityConfiguration,
+	}
+	if observed.Connections != nil && len(observed.Connections.Connections) == 0 {
+		observed.Connections = nil
+	}
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(glue.JobUpdate{}, glue.JobCommand{}, glue.ConnectionsList{}, glue.ExecutionProperty{}),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
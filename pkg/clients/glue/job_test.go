@@ -0,0 +1,122 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package glue
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+)
+
+func TestScriptLocation(t *testing.T) {
+	cases := map[string]struct {
+		c    v1alpha1.JobCommand
+		want *string
+	}{
+		"Location": {
+			c:    v1alpha1.JobCommand{ScriptLocation: aws.String("s3://a/b.py"), ScriptBucket: aws.String("c")},
+			want: aws.String("s3://a/b.py"),
+		},
+		"BucketAndKey": {
+			c:    v1alpha1.JobCommand{ScriptBucket: aws.String("scripts"), ScriptKey: aws.String("etl/job.py")},
+			want: aws.String("s3://scripts/etl/job.py"),
+		},
+		"Neither": {
+			c: v1alpha1.JobCommand{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ScriptLocation(tc.c)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsJobUpToDate(t *testing.T) {
+	params := func() v1alpha1.JobParameters {
+		return v1alpha1.JobParameters{
+			RoleARN: aws.String("arn:aws:iam::123456789012:role/glue-job"),
+			Command: v1alpha1.JobCommand{
+				Name:         "glueetl",
+				ScriptBucket: aws.String("scripts"),
+				ScriptKey:    aws.String("etl/job.py"),
+			},
+			Connections:       []string{"warehouse"},
+			WorkerType:        aws.String("G.1X"),
+			NumberOfWorkers:   aws.Int64(2),
+			MaxConcurrentRuns: aws.Int64(1),
+		}
+	}
+	job := func() glue.Job {
+		return glue.Job{
+			Role: aws.String("arn:aws:iam::123456789012:role/glue-job"),
+			Command: &glue.JobCommand{
+				Name:           aws.String("glueetl"),
+				ScriptLocation: aws.String("s3://scripts/etl/job.py"),
+			},
+			Connections:       &glue.ConnectionsList{Connections: []string{"warehouse"}},
+			WorkerType:        glue.WorkerTypeG1x,
+			NumberOfWorkers:   aws.Int64(2),
+			ExecutionProperty: &glue.ExecutionProperty{MaxConcurrentRuns: aws.Int64(1)},
+			MaxCapacity:       aws.Float64(2),
+		}
+	}
+
+	cases := map[string]struct {
+		p    func(*v1alpha1.JobParameters)
+		o    func(*glue.Job)
+		want bool
+	}{
+		"UpToDate": {
+			want: true,
+		},
+		"ScriptChanged": {
+			p:    func(p *v1alpha1.JobParameters) { p.Command.ScriptKey = aws.String("etl/other.py") },
+			want: false,
+		},
+		"ConnectionRemoved": {
+			p:    func(p *v1alpha1.JobParameters) { p.Connections = nil },
+			want: false,
+		},
+		"WorkersChanged": {
+			o:    func(o *glue.Job) { o.NumberOfWorkers = aws.Int64(10) },
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p, o := params(), job()
+			if tc.p != nil {
+				tc.p(&p)
+			}
+			if tc.o != nil {
+				tc.o(&o)
+			}
+			if diff := cmp.Diff(tc.want, IsJobUpToDate(p, o)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardduty
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+
+	"github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// errMsgNotOwned is returned by GuardDuty in a BadRequestException when
+	// the given detector does not exist in the account.
+	errMsgNotOwned = "not owned by the current account"
+)
+
+// DetectorClient is the external client used for Detector Custom Resource
+type DetectorClient interface {
+	CreateDetectorRequest(*guardduty.CreateDetectorInput) guardduty.CreateDetectorRequest
+	GetDetectorRequest(*guardduty.GetDetectorInput) guardduty.GetDetectorRequest
+	UpdateDetectorRequest(*guardduty.UpdateDetectorInput) guardduty.UpdateDetectorRequest
+	DeleteDetectorRequest(*guardduty.DeleteDetectorInput) guardduty.DeleteDetectorRequest
+}
+
+// NewDetectorClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewDetectorClient(cfg aws.Config) DetectorClient {
+	return guardduty.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the detector doesn't exist
+// in the account.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == guardduty.ErrCodeBadRequestException {
+		return strings.Contains(awsErr.Message(), errMsgNotOwned)
+	}
+	return false
+}
+
+// GenerateCreateDetectorInput returns a create input from the given
+// parameters.
+func GenerateCreateDetectorInput(p v1alpha1.DetectorParameters) *guardduty.CreateDetectorInput {
+	input := &guardduty.CreateDetectorInput{
+		Enable:                     aws.Bool(p.Enable),
+		FindingPublishingFrequency: guardduty.FindingPublishingFrequency(aws.StringValue(p.FindingPublishingFrequency)),
+	}
+	if len(p.Tags) != 0 {
+		input.Tags = make(map[string]string, len(p.Tags))
+		for _, t := range p.Tags {
+			input.Tags[t.Key] = t.Value
+		}
+	}
+	return input
+}
+
+// GenerateUpdateDetectorInput returns an update input from the given
+// parameters.
+func GenerateUpdateDetectorInput(id string, p v1alpha1.DetectorParameters) *guardduty.UpdateDetectorInput {
+	return &guardduty.UpdateDetectorInput{
+		DetectorId:                 aws.String(id),
+		Enable:                     aws.Bool(p.Enable),
+		FindingPublishingFrequency: guardduty.FindingPublishingFrequency(aws.StringValue(p.FindingPublishingFrequency)),
+	}
+}
+
+// GenerateDetectorObservation is used to produce v1alpha1.DetectorObservation
+// from guardduty.GetDetectorOutput.
+func GenerateDetectorObservation(o guardduty.GetDetectorOutput) v1alpha1.DetectorObservation {
+	return v1alpha1.DetectorObservation{
+		ServiceRole: aws.StringValue(o.ServiceRole),
+		Status:      string(o.Status),
+		CreatedAt:   aws.StringValue(o.CreatedAt),
+	}
+}
+
+// LateInitializeDetector fills the empty fields in
+// *v1alpha1.DetectorParameters with the values seen in
+// guardduty.GetDetectorOutput.
+func LateInitializeDetector(in *v1alpha1.DetectorParameters, o *guardduty.GetDetectorOutput) {
+	if o == nil {
+		return
+	}
+	in.FindingPublishingFrequency = awsclients.LateInitializeStringPtr(in.FindingPublishingFrequency, aws.String(string(o.FindingPublishingFrequency)))
+}
+
+// IsDetectorUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsDetectorUpToDate(p v1alpha1.DetectorParameters, o guardduty.GetDetectorOutput) bool {
+	if p.Enable != (o.Status == guardduty.DetectorStatusEnabled) {
+		return false
+	}
+	return aws.StringValue(p.FindingPublishingFrequency) == string(o.FindingPublishingFrequency)
+}
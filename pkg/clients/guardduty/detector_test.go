@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardduty
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
+)
+
+func TestGenerateCreateDetectorInput(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.DetectorParameters
+		out *guardduty.CreateDetectorInput
+	}{
+		"AllFilled": {
+			in: v1alpha1.DetectorParameters{
+				Enable:                     true,
+				FindingPublishingFrequency: aws.String("ONE_HOUR"),
+				Tags:                       []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			},
+			out: &guardduty.CreateDetectorInput{
+				Enable:                     aws.Bool(true),
+				FindingPublishingFrequency: guardduty.FindingPublishingFrequencyOneHour,
+				Tags:                       map[string]string{"k": "v"},
+			},
+		},
+		"Empty": {
+			in: v1alpha1.DetectorParameters{},
+			out: &guardduty.CreateDetectorInput{
+				Enable: aws.Bool(false),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateCreateDetectorInput(tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GenerateCreateDetectorInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDetectorUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p   v1alpha1.DetectorParameters
+		o   guardduty.GetDetectorOutput
+		out bool
+	}{
+		"SameFields": {
+			p: v1alpha1.DetectorParameters{Enable: true, FindingPublishingFrequency: aws.String("SIX_HOURS")},
+			o: guardduty.GetDetectorOutput{
+				Status:                     guardduty.DetectorStatusEnabled,
+				FindingPublishingFrequency: guardduty.FindingPublishingFrequencySixHours,
+			},
+			out: true,
+		},
+		"DifferentStatus": {
+			p: v1alpha1.DetectorParameters{Enable: false, FindingPublishingFrequency: aws.String("SIX_HOURS")},
+			o: guardduty.GetDetectorOutput{
+				Status:                     guardduty.DetectorStatusEnabled,
+				FindingPublishingFrequency: guardduty.FindingPublishingFrequencySixHours,
+			},
+			out: false,
+		},
+		"DifferentFrequency": {
+			p: v1alpha1.DetectorParameters{Enable: true, FindingPublishingFrequency: aws.String("ONE_HOUR")},
+			o: guardduty.GetDetectorOutput{
+				Status:                     guardduty.DetectorStatusEnabled,
+				FindingPublishingFrequency: guardduty.FindingPublishingFrequencySixHours,
+			},
+			out: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := IsDetectorUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("IsDetectorUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/guardduty"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DetectorClient = (*MockDetectorClient)(nil)
+
+// MockDetectorClient is a type that implements all the methods for DetectorClient interface
+type MockDetectorClient struct {
+	MockCreate func(*guardduty.CreateDetectorInput) guardduty.CreateDetectorRequest
+	MockGet    func(*guardduty.GetDetectorInput) guardduty.GetDetectorRequest
+	MockUpdate func(*guardduty.UpdateDetectorInput) guardduty.UpdateDetectorRequest
+	MockDelete func(*guardduty.DeleteDetectorInput) guardduty.DeleteDetectorRequest
+}
+
+// CreateDetectorRequest mocks CreateDetectorRequest method
+func (m *MockDetectorClient) CreateDetectorRequest(input *guardduty.CreateDetectorInput) guardduty.CreateDetectorRequest {
+	return m.MockCreate(input)
+}
+
+// GetDetectorRequest mocks GetDetectorRequest method
+func (m *MockDetectorClient) GetDetectorRequest(input *guardduty.GetDetectorInput) guardduty.GetDetectorRequest {
+	return m.MockGet(input)
+}
+
+// UpdateDetectorRequest mocks UpdateDetectorRequest method
+func (m *MockDetectorClient) UpdateDetectorRequest(input *guardduty.UpdateDetectorInput) guardduty.UpdateDetectorRequest {
+	return m.MockUpdate(input)
+}
+
+// DeleteDetectorRequest mocks DeleteDetectorRequest method
+func (m *MockDetectorClient) DeleteDetectorRequest(input *guardduty.DeleteDetectorInput) guardduty.DeleteDetectorRequest {
+	return m.MockDelete(input)
+}
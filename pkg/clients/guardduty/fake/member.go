@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/guardduty"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.MemberClient = (*MockMemberClient)(nil)
+
+// MockMemberClient is a type that implements all the methods for MemberClient interface
+type MockMemberClient struct {
+	MockCreate       func(*guardduty.CreateMembersInput) guardduty.CreateMembersRequest
+	MockGet          func(*guardduty.GetMembersInput) guardduty.GetMembersRequest
+	MockInvite       func(*guardduty.InviteMembersInput) guardduty.InviteMembersRequest
+	MockDisassociate func(*guardduty.DisassociateMembersInput) guardduty.DisassociateMembersRequest
+	MockDelete       func(*guardduty.DeleteMembersInput) guardduty.DeleteMembersRequest
+}
+
+// CreateMembersRequest mocks CreateMembersRequest method
+func (m *MockMemberClient) CreateMembersRequest(input *guardduty.CreateMembersInput) guardduty.CreateMembersRequest {
+	return m.MockCreate(input)
+}
+
+// GetMembersRequest mocks GetMembersRequest method
+func (m *MockMemberClient) GetMembersRequest(input *guardduty.GetMembersInput) guardduty.GetMembersRequest {
+	return m.MockGet(input)
+}
+
+// InviteMembersRequest mocks InviteMembersRequest method
+func (m *MockMemberClient) InviteMembersRequest(input *guardduty.InviteMembersInput) guardduty.InviteMembersRequest {
+	return m.MockInvite(input)
+}
+
+// DisassociateMembersRequest mocks DisassociateMembersRequest method
+func (m *MockMemberClient) DisassociateMembersRequest(input *guardduty.DisassociateMembersInput) guardduty.DisassociateMembersRequest {
+	return m.MockDisassociate(input)
+}
+
+// DeleteMembersRequest mocks DeleteMembersRequest method
+func (m *MockMemberClient) DeleteMembersRequest(input *guardduty.DeleteMembersInput) guardduty.DeleteMembersRequest {
+	return m.MockDelete(input)
+}
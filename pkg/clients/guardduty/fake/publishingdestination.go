@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/guardduty"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.PublishingDestinationClient = (*MockPublishingDestinationClient)(nil)
+
+// MockPublishingDestinationClient is a type that implements all the methods for PublishingDestinationClient interface
+type MockPublishingDestinationClient struct {
+	MockCreate   func(*guardduty.CreatePublishingDestinationInput) guardduty.CreatePublishingDestinationRequest
+	MockDescribe func(*guardduty.DescribePublishingDestinationInput) guardduty.DescribePublishingDestinationRequest
+	MockUpdate   func(*guardduty.UpdatePublishingDestinationInput) guardduty.UpdatePublishingDestinationRequest
+	MockDelete   func(*guardduty.DeletePublishingDestinationInput) guardduty.DeletePublishingDestinationRequest
+}
+
+// CreatePublishingDestinationRequest mocks CreatePublishingDestinationRequest method
+func (m *MockPublishingDestinationClient) CreatePublishingDestinationRequest(input *guardduty.CreatePublishingDestinationInput) guardduty.CreatePublishingDestinationRequest {
+	return m.MockCreate(input)
+}
+
+// DescribePublishingDestinationRequest mocks DescribePublishingDestinationRequest method
+func (m *MockPublishingDestinationClient) DescribePublishingDestinationRequest(input *guardduty.DescribePublishingDestinationInput) guardduty.DescribePublishingDestinationRequest {
+	return m.MockDescribe(input)
+}
+
+// UpdatePublishingDestinationRequest mocks UpdatePublishingDestinationRequest method
+func (m *MockPublishingDestinationClient) UpdatePublishingDestinationRequest(input *guardduty.UpdatePublishingDestinationInput) guardduty.UpdatePublishingDestinationRequest {
+	return m.MockUpdate(input)
+}
+
+// DeletePublishingDestinationRequest mocks DeletePublishingDestinationRequest method
+func (m *MockPublishingDestinationClient) DeletePublishingDestinationRequest(input *guardduty.DeletePublishingDestinationInput) guardduty.DeletePublishingDestinationRequest {
+	return m.MockDelete(input)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardduty
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+
+	"github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
+)
+
+const (
+	// RelationshipStatusCreated is the relationship status of a member
+	// account that has been added but not invited yet.
+	RelationshipStatusCreated = "Created"
+)
+
+// MemberClient is the external client used for Member Custom Resource
+type MemberClient interface {
+	CreateMembersRequest(*guardduty.CreateMembersInput) guardduty.CreateMembersRequest
+	GetMembersRequest(*guardduty.GetMembersInput) guardduty.GetMembersRequest
+	InviteMembersRequest(*guardduty.InviteMembersInput) guardduty.InviteMembersRequest
+	DisassociateMembersRequest(*guardduty.DisassociateMembersInput) guardduty.DisassociateMembersRequest
+	DeleteMembersRequest(*guardduty.DeleteMembersInput) guardduty.DeleteMembersRequest
+}
+
+// NewMemberClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewMemberClient(cfg aws.Config) MemberClient {
+	return guardduty.New(cfg)
+}
+
+// GenerateInviteMembersInput returns an invite input from the given
+// parameters.
+func GenerateInviteMembersInput(p v1alpha1.MemberParameters) *guardduty.InviteMembersInput {
+	return &guardduty.InviteMembersInput{
+		DetectorId:               p.DetectorID,
+		AccountIds:               []string{p.AccountID},
+		DisableEmailNotification: p.DisableEmailNotification,
+		Message:                  p.InvitationMessage,
+	}
+}
+
+// GenerateMemberObservation is used to produce v1alpha1.MemberObservation
+// from guardduty.Member.
+func GenerateMemberObservation(m guardduty.Member) v1alpha1.MemberObservation {
+	return v1alpha1.MemberObservation{
+		RelationshipStatus: aws.StringValue(m.RelationshipStatus),
+		MasterID:           aws.StringValue(m.MasterId),
+		InvitedAt:          aws.StringValue(m.InvitedAt),
+	}
+}
+
+// IsMemberUpToDate checks whether the member account has been invited if
+// that is desired.
+func IsMemberUpToDate(p v1alpha1.MemberParameters, m guardduty.Member) bool {
+	return !aws.BoolValue(p.Invite) || aws.StringValue(m.RelationshipStatus) != RelationshipStatusCreated
+}
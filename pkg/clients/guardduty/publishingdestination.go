@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardduty
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+
+	"github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
+)
+
+const (
+	// errMsgInvalidParameters is returned by GuardDuty in a
+	// BadRequestException when the given publishing destination does not
+	// exist.
+	errMsgInvalidParameters = "one or more input parameters have invalid values"
+)
+
+// PublishingDestinationClient is the external client used for
+// PublishingDestination Custom Resource
+type PublishingDestinationClient interface {
+	CreatePublishingDestinationRequest(*guardduty.CreatePublishingDestinationInput) guardduty.CreatePublishingDestinationRequest
+	DescribePublishingDestinationRequest(*guardduty.DescribePublishingDestinationInput) guardduty.DescribePublishingDestinationRequest
+	UpdatePublishingDestinationRequest(*guardduty.UpdatePublishingDestinationInput) guardduty.UpdatePublishingDestinationRequest
+	DeletePublishingDestinationRequest(*guardduty.DeletePublishingDestinationInput) guardduty.DeletePublishingDestinationRequest
+}
+
+// NewPublishingDestinationClient returns a new client using AWS credentials
+// as JSON encoded data.
+func NewPublishingDestinationClient(cfg aws.Config) PublishingDestinationClient {
+	return guardduty.New(cfg)
+}
+
+// IsPublishingDestinationNotFound returns true if the error is because the
+// publishing destination or its detector doesn't exist.
+func IsPublishingDestinationNotFound(err error) bool {
+	if IsNotFound(err) {
+		return true
+	}
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == guardduty.ErrCodeBadRequestException {
+		return strings.Contains(awsErr.Message(), errMsgInvalidParameters)
+	}
+	return false
+}
+
+// GenerateDestinationProperties returns the destination properties of the
+// given parameters.
+func GenerateDestinationProperties(p v1alpha1.PublishingDestinationParameters) *guardduty.DestinationProperties {
+	return &guardduty.DestinationProperties{
+		DestinationArn: p.DestinationARN,
+		KmsKeyArn:      aws.String(p.KMSKeyARN),
+	}
+}
+
+// GenerateCreatePublishingDestinationInput returns a create input from the
+// given parameters.
+func GenerateCreatePublishingDestinationInput(p v1alpha1.PublishingDestinationParameters) *guardduty.CreatePublishingDestinationInput {
+	return &guardduty.CreatePublishingDestinationInput{
+		DetectorId:            p.DetectorID,
+		DestinationType:       guardduty.DestinationType(p.DestinationType),
+		DestinationProperties: GenerateDestinationProperties(p),
+	}
+}
+
+// GenerateUpdatePublishingDestinationInput returns an update input from the
+// given parameters.
+func GenerateUpdatePublishingDestinationInput(id string, p v1alpha1.PublishingDestinationParameters) *guardduty.UpdatePublishingDestinationInput {
+	return &guardduty.UpdatePublishingDestinationInput{
+		DestinationId:         aws.String(id),
+		DetectorId:            p.DetectorID,
+		DestinationProperties: GenerateDestinationProperties(p),
+	}
+}
+
+// GeneratePublishingDestinationObservation is used to produce
+// v1alpha1.PublishingDestinationObservation from
+// guardduty.DescribePublishingDestinationOutput.
+func GeneratePublishingDestinationObservation(o guardduty.DescribePublishingDestinationOutput) v1alpha1.PublishingDestinationObservation {
+	return v1alpha1.PublishingDestinationObservation{
+		Status:                          string(o.Status),
+		PublishingFailureStartTimestamp: aws.Int64Value(o.PublishingFailureStartTimestamp),
+	}
+}
+
+// IsPublishingDestinationUpToDate checks whether the destination and KMS key
+// of the publishing destination are up to date.
+func IsPublishingDestinationUpToDate(p v1alpha1.PublishingDestinationParameters, o guardduty.DescribePublishingDestinationOutput) bool {
+	if o.DestinationProperties == nil {
+		return false
+	}
+	return aws.StringValue(p.DestinationARN) == aws.StringValue(o.DestinationProperties.DestinationArn) &&
+		p.KMSKeyARN == aws.StringValue(o.DestinationProperties.KmsKeyArn)
+}
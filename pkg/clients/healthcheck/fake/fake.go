@@ -0,0 +1,61 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+)
+
+// MockHealthCheckClient is a type that implements all the methods for Health Check Client interface
+type MockHealthCheckClient struct {
+	MockCreateHealthCheckRequest     func(input *route53.CreateHealthCheckInput) route53.CreateHealthCheckRequest
+	MockGetHealthCheckRequest        func(input *route53.GetHealthCheckInput) route53.GetHealthCheckRequest
+	MockUpdateHealthCheckRequest     func(input *route53.UpdateHealthCheckInput) route53.UpdateHealthCheckRequest
+	MockDeleteHealthCheckRequest     func(input *route53.DeleteHealthCheckInput) route53.DeleteHealthCheckRequest
+	MockListTagsForResourceRequest   func(input *route53.ListTagsForResourceInput) route53.ListTagsForResourceRequest
+	MockChangeTagsForResourceRequest func(input *route53.ChangeTagsForResourceInput) route53.ChangeTagsForResourceRequest
+}
+
+// CreateHealthCheckRequest mocks CreateHealthCheckRequest method
+func (m *MockHealthCheckClient) CreateHealthCheckRequest(input *route53.CreateHealthCheckInput) route53.CreateHealthCheckRequest {
+	return m.MockCreateHealthCheckRequest(input)
+}
+
+// GetHealthCheckRequest mocks GetHealthCheckRequest method
+func (m *MockHealthCheckClient) GetHealthCheckRequest(input *route53.GetHealthCheckInput) route53.GetHealthCheckRequest {
+	return m.MockGetHealthCheckRequest(input)
+}
+
+// UpdateHealthCheckRequest mocks UpdateHealthCheckRequest method
+func (m *MockHealthCheckClient) UpdateHealthCheckRequest(input *route53.UpdateHealthCheckInput) route53.UpdateHealthCheckRequest {
+	return m.MockUpdateHealthCheckRequest(input)
+}
+
+// DeleteHealthCheckRequest mocks DeleteHealthCheckRequest method
+func (m *MockHealthCheckClient) DeleteHealthCheckRequest(input *route53.DeleteHealthCheckInput) route53.DeleteHealthCheckRequest {
+	return m.MockDeleteHealthCheckRequest(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockHealthCheckClient) ListTagsForResourceRequest(input *route53.ListTagsForResourceInput) route53.ListTagsForResourceRequest {
+	return m.MockListTagsForResourceRequest(input)
+}
+
+// ChangeTagsForResourceRequest mocks ChangeTagsForResourceRequest method
+func (m *MockHealthCheckClient) ChangeTagsForResourceRequest(input *route53.ChangeTagsForResourceInput) route53.ChangeTagsForResourceRequest {
+	return m.MockChangeTagsForResourceRequest(input)
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines Route53 Health Check operations
+type Client interface {
+	CreateHealthCheckRequest(input *route53.CreateHealthCheckInput) route53.CreateHealthCheckRequest
+	GetHealthCheckRequest(input *route53.GetHealthCheckInput) route53.GetHealthCheckRequest
+	UpdateHealthCheckRequest(input *route53.UpdateHealthCheckInput) route53.UpdateHealthCheckRequest
+	DeleteHealthCheckRequest(input *route53.DeleteHealthCheckInput) route53.DeleteHealthCheckRequest
+	ListTagsForResourceRequest(input *route53.ListTagsForResourceInput) route53.ListTagsForResourceRequest
+	ChangeTagsForResourceRequest(input *route53.ChangeTagsForResourceInput) route53.ChangeTagsForResourceRequest
+}
+
+// NewClient returns a new Route53 client for health checks.
+func NewClient(cfg aws.Config) Client {
+	return route53.New(cfg)
+}
+
+// IsNotFound returns true if the error code indicates that the requested
+// health check was not found.
+func IsNotFound(err error) bool {
+	if hcErr, ok := err.(awserr.Error); ok && hcErr.Code() == route53.ErrCodeNoSuchHealthCheck {
+		return true
+	}
+	return false
+}
+
+// GenerateHealthCheckConfig returns the health check configuration of the
+// given parameters.
+func GenerateHealthCheckConfig(p v1alpha1.HealthCheckParameters) *route53.HealthCheckConfig {
+	c := &route53.HealthCheckConfig{
+		Type:                     route53.HealthCheckType(p.Type),
+		IPAddress:                p.IPAddress,
+		Port:                     p.Port,
+		ResourcePath:             p.ResourcePath,
+		FullyQualifiedDomainName: p.FullyQualifiedDomainName,
+		SearchString:             p.SearchString,
+		RequestInterval:          p.RequestInterval,
+		FailureThreshold:         p.FailureThreshold,
+		MeasureLatency:           p.MeasureLatency,
+		Inverted:                 p.Inverted,
+		Disabled:                 p.Disabled,
+		EnableSNI:                p.EnableSNI,
+		HealthThreshold:          p.HealthThreshold,
+		ChildHealthChecks:        p.ChildHealthChecks,
+	}
+	for _, r := range p.Regions {
+		c.Regions = append(c.Regions, route53.HealthCheckRegion(r))
+	}
+	if p.AlarmIdentifier != nil {
+		c.AlarmIdentifier = &route53.AlarmIdentifier{
+			Name:   aws.String(p.AlarmIdentifier.Name),
+			Region: route53.CloudWatchRegion(p.AlarmIdentifier.Region),
+		}
+	}
+	if p.InsufficientDataHealthStatus != nil {
+		c.InsufficientDataHealthStatus = route53.InsufficientDataHealthStatus(*p.InsufficientDataHealthStatus)
+	}
+	return c
+}
+
+// GenerateCreateHealthCheckInput returns the create input of a health check
+// with the given parameters. The caller reference makes sure that retries
+// don't create more than one health check.
+func GenerateCreateHealthCheckInput(callerReference string, p v1alpha1.HealthCheckParameters) *route53.CreateHealthCheckInput {
+	return &route53.CreateHealthCheckInput{
+		CallerReference:   aws.String(callerReference),
+		HealthCheckConfig: GenerateHealthCheckConfig(p),
+	}
+}
+
+// GenerateUpdateHealthCheckInput returns the update input of the health check
+// with the given ID and parameters.
+func GenerateUpdateHealthCheckInput(id string, p v1alpha1.HealthCheckParameters) *route53.UpdateHealthCheckInput {
+	c := GenerateHealthCheckConfig(p)
+	return &route53.UpdateHealthCheckInput{
+		HealthCheckId:                aws.String(id),
+		IPAddress:                    c.IPAddress,
+		Port:                         c.Port,
+		ResourcePath:                 c.ResourcePath,
+		FullyQualifiedDomainName:     c.FullyQualifiedDomainName,
+		SearchString:                 c.SearchString,
+		FailureThreshold:             c.FailureThreshold,
+		Inverted:                     c.Inverted,
+		Disabled:                     c.Disabled,
+		EnableSNI:                    c.EnableSNI,
+		HealthThreshold:              c.HealthThreshold,
+		ChildHealthChecks:            c.ChildHealthChecks,
+		Regions:                      c.Regions,
+		AlarmIdentifier:              c.AlarmIdentifier,
+		InsufficientDataHealthStatus: c.InsufficientDataHealthStatus,
+	}
+}
+
+// GenerateObservation returns the observation of the given health check.
+func GenerateObservation(o route53.HealthCheck) v1alpha1.HealthCheckObservation {
+	return v1alpha1.HealthCheckObservation{
+		CallerReference:    aws.StringValue(o.CallerReference),
+		HealthCheckVersion: aws.Int64Value(o.HealthCheckVersion),
+	}
+}
+
+// LateInitialize fills the empty fields of the given parameters with the
+// values of the observed health check.
+func LateInitialize(in *v1alpha1.HealthCheckParameters, o route53.HealthCheck) {
+	c := o.HealthCheckConfig
+	if c == nil {
+		return
+	}
+	in.IPAddress = awsclients.LateInitializeStringPtr(in.IPAddress, c.IPAddress)
+	in.Port = awsclients.LateInitializeInt64Ptr(in.Port, c.Port)
+	in.ResourcePath = awsclients.LateInitializeStringPtr(in.ResourcePath, c.ResourcePath)
+	in.FullyQualifiedDomainName = awsclients.LateInitializeStringPtr(in.FullyQualifiedDomainName, c.FullyQualifiedDomainName)
+	in.SearchString = awsclients.LateInitializeStringPtr(in.SearchString, c.SearchString)
+	in.RequestInterval = awsclients.LateInitializeInt64Ptr(in.RequestInterval, c.RequestInterval)
+	in.FailureThreshold = awsclients.LateInitializeInt64Ptr(in.FailureThreshold, c.FailureThreshold)
+	in.MeasureLatency = awsclients.LateInitializeBoolPtr(in.MeasureLatency, c.MeasureLatency)
+	in.Inverted = awsclients.LateInitializeBoolPtr(in.Inverted, c.Inverted)
+	in.Disabled = awsclients.LateInitializeBoolPtr(in.Disabled, c.Disabled)
+	in.EnableSNI = awsclients.LateInitializeBoolPtr(in.EnableSNI, c.EnableSNI)
+	in.HealthThreshold = awsclients.LateInitializeInt64Ptr(in.HealthThreshold, c.HealthThreshold)
+	if len(in.Regions) == 0 {
+		for _, r := range c.Regions {
+			in.Regions = append(in.Regions, string(r))
+		}
+	}
+	if in.InsufficientDataHealthStatus == nil && c.InsufficientDataHealthStatus != "" {
+		in.InsufficientDataHealthStatus = aws.String(string(c.InsufficientDataHealthStatus))
+	}
+}
+
+// IsUpToDate returns whether the observed health check is up to date with the
+// given parameters. Fields that cannot be updated are ignored.
+func IsUpToDate(p v1alpha1.HealthCheckParameters, o route53.HealthCheck) bool {
+	if o.HealthCheckConfig == nil {
+		return false
+	}
+	desired := GenerateHealthCheckConfig(p)
+	observed := *o.HealthCheckConfig
+	desired.Type = observed.Type
+	desired.RequestInterval = observed.RequestInterval
+	desired.MeasureLatency = observed.MeasureLatency
+	return cmp.Equal(*desired, observed,
+		cmpopts.IgnoreUnexported(route53.HealthCheckConfig{}, route53.AlarmIdentifier{}),
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.SortSlices(func(a, b route53.HealthCheckRegion) bool { return a < b }))
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from a health check. Tags whose value changed are
+// only added, since Route 53 overwrites them.
+func DiffTags(desired []v1alpha1.Tag, observed []route53.Tag) (add []route53.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, removeKeys := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, route53.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	for _, k := range removeKeys {
+		if _, ok := addMap[k]; !ok {
+			remove = append(remove, k)
+		}
+	}
+	return add, remove
+}
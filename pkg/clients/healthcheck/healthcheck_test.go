@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.HealthCheckParameters
+		o    route53.HealthCheck
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.HealthCheckParameters{
+				Type:         "HTTPS_STR_MATCH",
+				IPAddress:    aws.String("192.0.2.10"),
+				ResourcePath: aws.String("/health"),
+				SearchString: aws.String("ok"),
+				Regions:      []string{"us-west-1", "us-east-1", "eu-west-1"},
+			},
+			o: route53.HealthCheck{HealthCheckConfig: &route53.HealthCheckConfig{
+				Type:            route53.HealthCheckTypeHttpsStrMatch,
+				IPAddress:       aws.String("192.0.2.10"),
+				ResourcePath:    aws.String("/health"),
+				SearchString:    aws.String("ok"),
+				RequestInterval: aws.Int64(30),
+				Regions:         []route53.HealthCheckRegion{"eu-west-1", "us-east-1", "us-west-1"},
+			}},
+			want: true,
+		},
+		"SearchStringChanged": {
+			p: v1alpha1.HealthCheckParameters{
+				Type:         "HTTP_STR_MATCH",
+				SearchString: aws.String("healthy"),
+			},
+			o: route53.HealthCheck{HealthCheckConfig: &route53.HealthCheckConfig{
+				Type:         route53.HealthCheckTypeHttpStrMatch,
+				SearchString: aws.String("ok"),
+			}},
+		},
+		"AlarmChanged": {
+			p: v1alpha1.HealthCheckParameters{
+				Type:            "CLOUDWATCH_METRIC",
+				AlarmIdentifier: &v1alpha1.AlarmIdentifier{Name: "latency", Region: "us-east-1"},
+			},
+			o: route53.HealthCheck{HealthCheckConfig: &route53.HealthCheckConfig{
+				Type:            route53.HealthCheckTypeCloudwatchMetric,
+				AlarmIdentifier: &route53.AlarmIdentifier{Name: aws.String("errors"), Region: route53.CloudWatchRegionUsEast1},
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []route53.Tag
+		remove []string
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []route53.Tag
+		want     want
+	}{
+		"AddChangeRemove": {
+			desired: []v1alpha1.Tag{{Key: "team", Value: "dns"}, {Key: "env", Value: "prod"}},
+			observed: []route53.Tag{
+				{Key: aws.String("team"), Value: aws.String("networking")},
+				{Key: aws.String("owner"), Value: aws.String("me")},
+			},
+			want: want{
+				add: []route53.Tag{
+					{Key: aws.String("env"), Value: aws.String("prod")},
+					{Key: aws.String("team"), Value: aws.String("dns")},
+				},
+				remove: []string{"owner"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			sortTags := cmpopts.SortSlices(func(a, b route53.Tag) bool { return *a.Key < *b.Key })
+			if diff := cmp.Diff(tc.want.add, add, sortTags, cmpopts.IgnoreUnexported(route53.Tag{})); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AccessKeyClient = (*MockAccessKeyClient)(nil)
+
+// MockAccessKeyClient is a type that implements all the methods for AccessKeyClient interface
+type MockAccessKeyClient struct {
+	MockListAccessKeysRequest  func(*iam.ListAccessKeysInput) iam.ListAccessKeysRequest
+	MockCreateAccessKeyRequest func(*iam.CreateAccessKeyInput) iam.CreateAccessKeyRequest
+	MockUpdateAccessKeyRequest func(*iam.UpdateAccessKeyInput) iam.UpdateAccessKeyRequest
+	MockDeleteAccessKeyRequest func(*iam.DeleteAccessKeyInput) iam.DeleteAccessKeyRequest
+}
+
+// ListAccessKeysRequest mocks ListAccessKeysRequest method
+func (m *MockAccessKeyClient) ListAccessKeysRequest(input *iam.ListAccessKeysInput) iam.ListAccessKeysRequest {
+	return m.MockListAccessKeysRequest(input)
+}
+
+// CreateAccessKeyRequest mocks CreateAccessKeyRequest method
+func (m *MockAccessKeyClient) CreateAccessKeyRequest(input *iam.CreateAccessKeyInput) iam.CreateAccessKeyRequest {
+	return m.MockCreateAccessKeyRequest(input)
+}
+
+// UpdateAccessKeyRequest mocks UpdateAccessKeyRequest method
+func (m *MockAccessKeyClient) UpdateAccessKeyRequest(input *iam.UpdateAccessKeyInput) iam.UpdateAccessKeyRequest {
+	return m.MockUpdateAccessKeyRequest(input)
+}
+
+// DeleteAccessKeyRequest mocks DeleteAccessKeyRequest method
+func (m *MockAccessKeyClient) DeleteAccessKeyRequest(input *iam.DeleteAccessKeyInput) iam.DeleteAccessKeyRequest {
+	return m.MockDeleteAccessKeyRequest(input)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.InstanceProfileClient = (*MockInstanceProfileClient)(nil)
+
+// MockInstanceProfileClient is a type that implements all the methods for InstanceProfileClient interface
+type MockInstanceProfileClient struct {
+	MockCreateInstanceProfileRequest         func(*iam.CreateInstanceProfileInput) iam.CreateInstanceProfileRequest
+	MockGetInstanceProfileRequest            func(*iam.GetInstanceProfileInput) iam.GetInstanceProfileRequest
+	MockDeleteInstanceProfileRequest         func(*iam.DeleteInstanceProfileInput) iam.DeleteInstanceProfileRequest
+	MockAddRoleToInstanceProfileRequest      func(*iam.AddRoleToInstanceProfileInput) iam.AddRoleToInstanceProfileRequest
+	MockRemoveRoleFromInstanceProfileRequest func(*iam.RemoveRoleFromInstanceProfileInput) iam.RemoveRoleFromInstanceProfileRequest
+}
+
+// CreateInstanceProfileRequest mocks CreateInstanceProfileRequest method
+func (m *MockInstanceProfileClient) CreateInstanceProfileRequest(input *iam.CreateInstanceProfileInput) iam.CreateInstanceProfileRequest {
+	return m.MockCreateInstanceProfileRequest(input)
+}
+
+// GetInstanceProfileRequest mocks GetInstanceProfileRequest method
+func (m *MockInstanceProfileClient) GetInstanceProfileRequest(input *iam.GetInstanceProfileInput) iam.GetInstanceProfileRequest {
+	return m.MockGetInstanceProfileRequest(input)
+}
+
+// DeleteInstanceProfileRequest mocks DeleteInstanceProfileRequest method
+func (m *MockInstanceProfileClient) DeleteInstanceProfileRequest(input *iam.DeleteInstanceProfileInput) iam.DeleteInstanceProfileRequest {
+	return m.MockDeleteInstanceProfileRequest(input)
+}
+
+// AddRoleToInstanceProfileRequest mocks AddRoleToInstanceProfileRequest method
+func (m *MockInstanceProfileClient) AddRoleToInstanceProfileRequest(input *iam.AddRoleToInstanceProfileInput) iam.AddRoleToInstanceProfileRequest {
+	return m.MockAddRoleToInstanceProfileRequest(input)
+}
+
+// RemoveRoleFromInstanceProfileRequest mocks RemoveRoleFromInstanceProfileRequest method
+func (m *MockInstanceProfileClient) RemoveRoleFromInstanceProfileRequest(input *iam.RemoveRoleFromInstanceProfileInput) iam.RemoveRoleFromInstanceProfileRequest {
+	return m.MockRemoveRoleFromInstanceProfileRequest(input)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.OpenIDConnectProviderClient = (*MockOpenIDConnectProviderClient)(nil)
+
+// MockOpenIDConnectProviderClient is a type that implements all the methods for OpenIDConnectProviderClient interface
+type MockOpenIDConnectProviderClient struct {
+	MockCreateOpenIDConnectProviderRequest             func(*iam.CreateOpenIDConnectProviderInput) iam.CreateOpenIDConnectProviderRequest
+	MockGetOpenIDConnectProviderRequest                func(*iam.GetOpenIDConnectProviderInput) iam.GetOpenIDConnectProviderRequest
+	MockDeleteOpenIDConnectProviderRequest             func(*iam.DeleteOpenIDConnectProviderInput) iam.DeleteOpenIDConnectProviderRequest
+	MockUpdateOpenIDConnectProviderThumbprintRequest   func(*iam.UpdateOpenIDConnectProviderThumbprintInput) iam.UpdateOpenIDConnectProviderThumbprintRequest
+	MockAddClientIDToOpenIDConnectProviderRequest      func(*iam.AddClientIDToOpenIDConnectProviderInput) iam.AddClientIDToOpenIDConnectProviderRequest
+	MockRemoveClientIDFromOpenIDConnectProviderRequest func(*iam.RemoveClientIDFromOpenIDConnectProviderInput) iam.RemoveClientIDFromOpenIDConnectProviderRequest
+}
+
+// CreateOpenIDConnectProviderRequest mocks CreateOpenIDConnectProviderRequest method
+func (m *MockOpenIDConnectProviderClient) CreateOpenIDConnectProviderRequest(input *iam.CreateOpenIDConnectProviderInput) iam.CreateOpenIDConnectProviderRequest {
+	return m.MockCreateOpenIDConnectProviderRequest(input)
+}
+
+// GetOpenIDConnectProviderRequest mocks GetOpenIDConnectProviderRequest method
+func (m *MockOpenIDConnectProviderClient) GetOpenIDConnectProviderRequest(input *iam.GetOpenIDConnectProviderInput) iam.GetOpenIDConnectProviderRequest {
+	return m.MockGetOpenIDConnectProviderRequest(input)
+}
+
+// DeleteOpenIDConnectProviderRequest mocks DeleteOpenIDConnectProviderRequest method
+func (m *MockOpenIDConnectProviderClient) DeleteOpenIDConnectProviderRequest(input *iam.DeleteOpenIDConnectProviderInput) iam.DeleteOpenIDConnectProviderRequest {
+	return m.MockDeleteOpenIDConnectProviderRequest(input)
+}
+
+// UpdateOpenIDConnectProviderThumbprintRequest mocks UpdateOpenIDConnectProviderThumbprintRequest method
+func (m *MockOpenIDConnectProviderClient) UpdateOpenIDConnectProviderThumbprintRequest(input *iam.UpdateOpenIDConnectProviderThumbprintInput) iam.UpdateOpenIDConnectProviderThumbprintRequest {
+	return m.MockUpdateOpenIDConnectProviderThumbprintRequest(input)
+}
+
+// AddClientIDToOpenIDConnectProviderRequest mocks AddClientIDToOpenIDConnectProviderRequest method
+func (m *MockOpenIDConnectProviderClient) AddClientIDToOpenIDConnectProviderRequest(input *iam.AddClientIDToOpenIDConnectProviderInput) iam.AddClientIDToOpenIDConnectProviderRequest {
+	return m.MockAddClientIDToOpenIDConnectProviderRequest(input)
+}
+
+// RemoveClientIDFromOpenIDConnectProviderRequest mocks RemoveClientIDFromOpenIDConnectProviderRequest method
+func (m *MockOpenIDConnectProviderClient) RemoveClientIDFromOpenIDConnectProviderRequest(input *iam.RemoveClientIDFromOpenIDConnectProviderInput) iam.RemoveClientIDFromOpenIDConnectProviderRequest {
+	return m.MockRemoveClientIDFromOpenIDConnectProviderRequest(input)
+}
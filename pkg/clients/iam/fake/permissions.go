@@ -0,0 +1,50 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.PermissionsAuditClient = (*MockPermissionsAuditClient)(nil)
+
+// MockPermissionsAuditClient is a type that implements all the methods for
+// PermissionsAuditClient interface
+type MockPermissionsAuditClient struct {
+	MockGetCallerIdentity       func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+	MockGetRole                 func(*iam.GetRoleInput) iam.GetRoleRequest
+	MockSimulatePrincipalPolicy func(*iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest
+}
+
+// GetCallerIdentityRequest mocks GetCallerIdentityRequest method
+func (m *MockPermissionsAuditClient) GetCallerIdentityRequest(input *sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+	return m.MockGetCallerIdentity(input)
+}
+
+// GetRoleRequest mocks GetRoleRequest method
+func (m *MockPermissionsAuditClient) GetRoleRequest(input *iam.GetRoleInput) iam.GetRoleRequest {
+	return m.MockGetRole(input)
+}
+
+// SimulatePrincipalPolicyRequest mocks SimulatePrincipalPolicyRequest method
+func (m *MockPermissionsAuditClient) SimulatePrincipalPolicyRequest(input *iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest {
+	return m.MockSimulatePrincipalPolicy(input)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.SAMLProviderClient = (*MockSAMLProviderClient)(nil)
+
+// MockSAMLProviderClient is a type that implements all the methods for SAMLProviderClient interface
+type MockSAMLProviderClient struct {
+	MockCreateSAMLProviderRequest func(*iam.CreateSAMLProviderInput) iam.CreateSAMLProviderRequest
+	MockGetSAMLProviderRequest    func(*iam.GetSAMLProviderInput) iam.GetSAMLProviderRequest
+	MockUpdateSAMLProviderRequest func(*iam.UpdateSAMLProviderInput) iam.UpdateSAMLProviderRequest
+	MockDeleteSAMLProviderRequest func(*iam.DeleteSAMLProviderInput) iam.DeleteSAMLProviderRequest
+}
+
+// CreateSAMLProviderRequest mocks CreateSAMLProviderRequest method
+func (m *MockSAMLProviderClient) CreateSAMLProviderRequest(input *iam.CreateSAMLProviderInput) iam.CreateSAMLProviderRequest {
+	return m.MockCreateSAMLProviderRequest(input)
+}
+
+// GetSAMLProviderRequest mocks GetSAMLProviderRequest method
+func (m *MockSAMLProviderClient) GetSAMLProviderRequest(input *iam.GetSAMLProviderInput) iam.GetSAMLProviderRequest {
+	return m.MockGetSAMLProviderRequest(input)
+}
+
+// UpdateSAMLProviderRequest mocks UpdateSAMLProviderRequest method
+func (m *MockSAMLProviderClient) UpdateSAMLProviderRequest(input *iam.UpdateSAMLProviderInput) iam.UpdateSAMLProviderRequest {
+	return m.MockUpdateSAMLProviderRequest(input)
+}
+
+// DeleteSAMLProviderRequest mocks DeleteSAMLProviderRequest method
+func (m *MockSAMLProviderClient) DeleteSAMLProviderRequest(input *iam.DeleteSAMLProviderInput) iam.DeleteSAMLProviderRequest {
+	return m.MockDeleteSAMLProviderRequest(input)
+}
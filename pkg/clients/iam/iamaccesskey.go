@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AccessKeyClient is the external client used for IAMAccessKey Custom Resource
+type AccessKeyClient interface {
+	ListAccessKeysRequest(*iam.ListAccessKeysInput) iam.ListAccessKeysRequest
+	CreateAccessKeyRequest(*iam.CreateAccessKeyInput) iam.CreateAccessKeyRequest
+	UpdateAccessKeyRequest(*iam.UpdateAccessKeyInput) iam.UpdateAccessKeyRequest
+	DeleteAccessKeyRequest(*iam.DeleteAccessKeyInput) iam.DeleteAccessKeyRequest
+}
+
+// NewAccessKeyClient returns a new client using AWS credentials as JSON encoded data.
+func NewAccessKeyClient(cfg aws.Config) AccessKeyClient {
+	return iam.New(cfg)
+}
+
+// GenerateAccessKeyObservation returns the observation of the given access
+// key metadata. The rotation trigger of the current key is not part of it.
+func GenerateAccessKeyObservation(o iam.AccessKeyMetadata) v1alpha1.IAMAccessKeyObservation {
+	obs := v1alpha1.IAMAccessKeyObservation{Status: string(o.Status)}
+	if o.CreateDate != nil {
+		t := metav1.NewTime(*o.CreateDate)
+		obs.CreateDate = &t
+	}
+	return obs
+}
+
+// LateInitializeAccessKey fills the empty fields in
+// *v1alpha1.IAMAccessKeyParameters with the values seen in
+// iam.AccessKeyMetadata.
+func LateInitializeAccessKey(in *v1alpha1.IAMAccessKeyParameters, o iam.AccessKeyMetadata) {
+	if o.Status != "" {
+		in.Status = awsclients.LateInitializeStringPtr(in.Status, aws.String(string(o.Status)))
+	}
+}
+
+// AnnotationKeyRetiredAccessKeyID is the annotation that holds the ID of the
+// access key that was replaced by a rotation. It is deleted once the
+// connection details of its successor are published.
+const AnnotationKeyRetiredAccessKeyID = "identity.aws.crossplane.io/retired-access-key-id"
+
+// AnnotationKeyUnpublishedAccessKeyID is the annotation that holds the ID of
+// an access key that was created by the controller and whose connection
+// details are not known to be published yet. Imported access keys never have
+// it, so they are never replaced.
+const AnnotationKeyUnpublishedAccessKeyID = "identity.aws.crossplane.io/unpublished-access-key-id"
+
+// IsAccessKeyRotationRequired returns whether the rotation trigger of the
+// given IAMAccessKey changed since its current access key was created. A key
+// whose trigger is unknown, e.g. because it was imported, is never rotated.
+func IsAccessKeyRotationRequired(cr *v1alpha1.IAMAccessKey) bool {
+	if cr.Status.AtProvider.RotationTrigger == nil {
+		return false
+	}
+	return aws.StringValue(cr.Spec.ForProvider.RotationTrigger) != *cr.Status.AtProvider.RotationTrigger
+}
+
+// IsAccessKeyUpToDate checks whether there is a change in any of the
+// modifiable fields of the IAMAccessKey.
+func IsAccessKeyUpToDate(cr *v1alpha1.IAMAccessKey, o iam.AccessKeyMetadata) bool {
+	if IsAccessKeyRotationRequired(cr) {
+		return false
+	}
+	return cr.Spec.ForProvider.Status == nil || *cr.Spec.ForProvider.Status == string(o.Status)
+}
+
+// GetAccessKeyConnectionDetails returns the connection details of the given
+// access key, i.e. its ID and secret.
+func GetAccessKeyConnectionDetails(k iam.AccessKey) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(aws.StringValue(k.AccessKeyId)),
+		runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(aws.StringValue(k.SecretAccessKey)),
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+)
+
+func TestIsAccessKeyUpToDate(t *testing.T) {
+	type args struct {
+		p   v1alpha1.IAMAccessKeyParameters
+		obs v1alpha1.IAMAccessKeyObservation
+		o   iam.AccessKeyMetadata
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p:   v1alpha1.IAMAccessKeyParameters{Status: aws.String(v1alpha1.AccessKeyStatusActive), RotationTrigger: aws.String("1")},
+				obs: v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("1")},
+				o:   iam.AccessKeyMetadata{Status: iam.StatusTypeActive},
+			},
+			want: true,
+		},
+		"NoStatus": {
+			args: args{
+				o: iam.AccessKeyMetadata{Status: iam.StatusTypeInactive},
+			},
+			want: true,
+		},
+		"StatusChanged": {
+			args: args{
+				p: v1alpha1.IAMAccessKeyParameters{Status: aws.String(v1alpha1.AccessKeyStatusInactive)},
+				o: iam.AccessKeyMetadata{Status: iam.StatusTypeActive},
+			},
+			want: false,
+		},
+		"RotationTriggerChanged": {
+			args: args{
+				p:   v1alpha1.IAMAccessKeyParameters{RotationTrigger: aws.String("2")},
+				obs: v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("1")},
+				o:   iam.AccessKeyMetadata{Status: iam.StatusTypeActive},
+			},
+			want: false,
+		},
+		"RotationTriggerAdded": {
+			args: args{
+				p:   v1alpha1.IAMAccessKeyParameters{RotationTrigger: aws.String("1")},
+				obs: v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("")},
+				o:   iam.AccessKeyMetadata{Status: iam.StatusTypeActive},
+			},
+			want: false,
+		},
+		"RotationTriggerUnknown": {
+			args: args{
+				p: v1alpha1.IAMAccessKeyParameters{RotationTrigger: aws.String("1")},
+				o: iam.AccessKeyMetadata{Status: iam.StatusTypeActive},
+			},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.IAMAccessKey{
+				Spec:   v1alpha1.IAMAccessKeySpec{ForProvider: tc.args.p},
+				Status: v1alpha1.IAMAccessKeyStatus{AtProvider: tc.args.obs},
+			}
+			got := IsAccessKeyUpToDate(cr, tc.args.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
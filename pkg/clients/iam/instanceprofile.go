@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// InstanceProfileClient is the external client used for InstanceProfile
+// Custom Resource
+type InstanceProfileClient interface {
+	CreateInstanceProfileRequest(*iam.CreateInstanceProfileInput) iam.CreateInstanceProfileRequest
+	GetInstanceProfileRequest(*iam.GetInstanceProfileInput) iam.GetInstanceProfileRequest
+	DeleteInstanceProfileRequest(*iam.DeleteInstanceProfileInput) iam.DeleteInstanceProfileRequest
+	AddRoleToInstanceProfileRequest(*iam.AddRoleToInstanceProfileInput) iam.AddRoleToInstanceProfileRequest
+	RemoveRoleFromInstanceProfileRequest(*iam.RemoveRoleFromInstanceProfileInput) iam.RemoveRoleFromInstanceProfileRequest
+}
+
+// NewInstanceProfileClient returns a new client using AWS credentials as JSON encoded data.
+func NewInstanceProfileClient(cfg aws.Config) InstanceProfileClient {
+	return iam.New(cfg)
+}
+
+// GenerateInstanceProfileObservation returns the observation of the given
+// instance profile.
+func GenerateInstanceProfileObservation(o iam.InstanceProfile) v1beta1.InstanceProfileObservation {
+	return v1beta1.InstanceProfileObservation{
+		ARN:               aws.StringValue(o.Arn),
+		InstanceProfileID: aws.StringValue(o.InstanceProfileId),
+	}
+}
+
+// LateInitializeInstanceProfile fills the empty fields of the given
+// parameters with the values of the observed instance profile. The role is
+// not late initialized so that it can be removed by omitting it.
+func LateInitializeInstanceProfile(in *v1beta1.InstanceProfileParameters, o iam.InstanceProfile) {
+	in.Path = awsclients.LateInitializeStringPtr(in.Path, o.Path)
+}
+
+// DiffInstanceProfileRoles returns the names of the roles that need to be
+// added to and removed from the given instance profile to match the given
+// parameters.
+func DiffInstanceProfileRoles(in v1beta1.InstanceProfileParameters, o iam.InstanceProfile) (add, remove []string) {
+	desired := aws.StringValue(in.RoleName)
+	found := false
+	for _, r := range o.Roles {
+		if name := aws.StringValue(r.RoleName); name != desired {
+			remove = append(remove, name)
+			continue
+		}
+		found = true
+	}
+	if desired != "" && !found {
+		add = append(add, desired)
+	}
+	return add, remove
+}
+
+// IsInstanceProfileUpToDate returns whether the observed instance profile is
+// up to date with the given parameters.
+func IsInstanceProfileUpToDate(in v1beta1.InstanceProfileParameters, o iam.InstanceProfile) bool {
+	add, remove := DiffInstanceProfileRoles(in, o)
+	return len(add) == 0 && len(remove) == 0
+}
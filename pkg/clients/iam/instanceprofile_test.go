@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
+)
+
+func TestDiffInstanceProfileRoles(t *testing.T) {
+	type want struct {
+		add    []string
+		remove []string
+	}
+	cases := map[string]struct {
+		p    v1beta1.InstanceProfileParameters
+		o    iam.InstanceProfile
+		want want
+	}{
+		"InSync": {
+			p: v1beta1.InstanceProfileParameters{RoleName: aws.String("web")},
+			o: iam.InstanceProfile{Roles: []iam.Role{{RoleName: aws.String("web")}}},
+		},
+		"AddRole": {
+			p:    v1beta1.InstanceProfileParameters{RoleName: aws.String("web")},
+			o:    iam.InstanceProfile{},
+			want: want{add: []string{"web"}},
+		},
+		"ReplaceRole": {
+			p:    v1beta1.InstanceProfileParameters{RoleName: aws.String("web")},
+			o:    iam.InstanceProfile{Roles: []iam.Role{{RoleName: aws.String("old")}}},
+			want: want{add: []string{"web"}, remove: []string{"old"}},
+		},
+		"RemoveRole": {
+			p:    v1beta1.InstanceProfileParameters{},
+			o:    iam.InstanceProfile{Roles: []iam.Role{{RoleName: aws.String("old")}}},
+			want: want{remove: []string{"old"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffInstanceProfileRoles(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
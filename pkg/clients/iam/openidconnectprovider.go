@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"crypto/sha1" // nolint:gosec
+	"crypto/tls"
+	"encoding/hex"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+)
+
+const (
+	errParseIssuerURL = "cannot parse the URL of the identity provider"
+	errDialIssuer     = "cannot connect to the identity provider"
+	errNoCertificates = "identity provider did not serve any certificates"
+
+	thumbprintDialTimeout = 10 * time.Second
+)
+
+// OpenIDConnectProviderClient is the external client used for
+// OpenIDConnectProvider Custom Resource
+type OpenIDConnectProviderClient interface {
+	CreateOpenIDConnectProviderRequest(*iam.CreateOpenIDConnectProviderInput) iam.CreateOpenIDConnectProviderRequest
+	GetOpenIDConnectProviderRequest(*iam.GetOpenIDConnectProviderInput) iam.GetOpenIDConnectProviderRequest
+	DeleteOpenIDConnectProviderRequest(*iam.DeleteOpenIDConnectProviderInput) iam.DeleteOpenIDConnectProviderRequest
+	UpdateOpenIDConnectProviderThumbprintRequest(*iam.UpdateOpenIDConnectProviderThumbprintInput) iam.UpdateOpenIDConnectProviderThumbprintRequest
+	AddClientIDToOpenIDConnectProviderRequest(*iam.AddClientIDToOpenIDConnectProviderInput) iam.AddClientIDToOpenIDConnectProviderRequest
+	RemoveClientIDFromOpenIDConnectProviderRequest(*iam.RemoveClientIDFromOpenIDConnectProviderInput) iam.RemoveClientIDFromOpenIDConnectProviderRequest
+}
+
+// NewOpenIDConnectProviderClient returns a new client using AWS credentials as JSON encoded data.
+func NewOpenIDConnectProviderClient(cfg aws.Config) OpenIDConnectProviderClient {
+	return iam.New(cfg)
+}
+
+// Thumbprint returns the SHA-1 thumbprint of the top intermediate certificate
+// authority in the certificate chain served by the identity provider with the
+// given URL, which is what IAM expects in the thumbprint list.
+func Thumbprint(issuer string) (string, error) {
+	u, err := url.Parse(issuer)
+	if err != nil {
+		return "", errors.Wrap(err, errParseIssuerURL)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "443")
+	}
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: thumbprintDialTimeout}, "tcp", host, &tls.Config{
+		ServerName: u.Hostname(),
+		MinVersion: tls.VersionTLS12,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, errDialIssuer)
+	}
+	defer conn.Close() // nolint:errcheck
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return "", errors.New(errNoCertificates)
+	}
+	sum := sha1.Sum(certs[len(certs)-1].Raw) // nolint:gosec
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// GenerateOpenIDConnectProviderObservation returns the observation of the
+// identity provider with the given ARN.
+func GenerateOpenIDConnectProviderObservation(arn string, o iam.GetOpenIDConnectProviderOutput) v1alpha1.OpenIDConnectProviderObservation {
+	obs := v1alpha1.OpenIDConnectProviderObservation{ARN: arn}
+	if o.CreateDate != nil {
+		t := metav1.NewTime(*o.CreateDate)
+		obs.CreateDate = &t
+	}
+	return obs
+}
+
+// LateInitializeOpenIDConnectProvider fills the empty fields of the given
+// parameters with the values of the observed identity provider.
+func LateInitializeOpenIDConnectProvider(in *v1alpha1.OpenIDConnectProviderParameters, o iam.GetOpenIDConnectProviderOutput) {
+	if len(in.ClientIDList) == 0 && len(o.ClientIDList) != 0 {
+		in.ClientIDList = o.ClientIDList
+	}
+	if len(in.ThumbprintList) == 0 && len(o.ThumbprintList) != 0 {
+		in.ThumbprintList = o.ThumbprintList
+	}
+}
+
+// DiffClientIDs returns the client IDs that need to be added to and removed
+// from the identity provider to match the desired ones.
+func DiffClientIDs(desired, observed []string) (add, remove []string) {
+	current := make(map[string]bool, len(observed))
+	for _, id := range observed {
+		current[id] = true
+	}
+	wanted := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		wanted[id] = true
+		if !current[id] {
+			add = append(add, id)
+		}
+	}
+	for _, id := range observed {
+		if !wanted[id] {
+			remove = append(remove, id)
+		}
+	}
+	return add, remove
+}
+
+// IsThumbprintListUpToDate returns whether the given thumbprints match the
+// observed ones regardless of their order and case.
+func IsThumbprintListUpToDate(desired, observed []string) bool {
+	normalize := func(l []string) []string {
+		res := make([]string, len(l))
+		for i, t := range l {
+			res[i] = strings.ToLower(t)
+		}
+		sort.Strings(res)
+		return res
+	}
+	return cmp.Equal(normalize(desired), normalize(observed), cmpopts.EquateEmpty())
+}
+
+// IsOpenIDConnectProviderUpToDate returns whether the observed identity
+// provider is up to date with the given parameters.
+func IsOpenIDConnectProviderUpToDate(in v1alpha1.OpenIDConnectProviderParameters, o iam.GetOpenIDConnectProviderOutput) bool {
+	if add, remove := DiffClientIDs(in.ClientIDList, o.ClientIDList); len(add) != 0 || len(remove) != 0 {
+		return false
+	}
+	return IsThumbprintListUpToDate(in.ThumbprintList, o.ThumbprintList)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+)
+
+var thumbprint = "9e99a48a9960b14926bb7f3b02e22da2b0ab7280"
+
+func TestDiffClientIDs(t *testing.T) {
+	type want struct {
+		add    []string
+		remove []string
+	}
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		want     want
+	}{
+		"InSync": {
+			desired:  []string{"sts.amazonaws.com"},
+			observed: []string{"sts.amazonaws.com"},
+		},
+		"AddAndRemove": {
+			desired:  []string{"sts.amazonaws.com", "new"},
+			observed: []string{"sts.amazonaws.com", "old"},
+			want:     want{add: []string{"new"}, remove: []string{"old"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffClientIDs(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsOpenIDConnectProviderUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.OpenIDConnectProviderParameters
+		o    iam.GetOpenIDConnectProviderOutput
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.OpenIDConnectProviderParameters{
+				ClientIDList:   []string{"sts.amazonaws.com"},
+				ThumbprintList: []string{"9E99A48A9960B14926BB7F3B02E22DA2B0AB7280"},
+			},
+			o: iam.GetOpenIDConnectProviderOutput{
+				ClientIDList:   []string{"sts.amazonaws.com"},
+				ThumbprintList: []string{thumbprint},
+			},
+			want: true,
+		},
+		"ClientIDsChanged": {
+			p: v1alpha1.OpenIDConnectProviderParameters{
+				ClientIDList:   []string{"sts.amazonaws.com", "other"},
+				ThumbprintList: []string{thumbprint},
+			},
+			o: iam.GetOpenIDConnectProviderOutput{
+				ClientIDList:   []string{"sts.amazonaws.com"},
+				ThumbprintList: []string{thumbprint},
+			},
+			want: false,
+		},
+		"ThumbprintsChanged": {
+			p: v1alpha1.OpenIDConnectProviderParameters{
+				ClientIDList:   []string{"sts.amazonaws.com"},
+				ThumbprintList: []string{thumbprint, "a031c46782e6e6c662c2c87c76da9aa62ccabd8e"},
+			},
+			o: iam.GetOpenIDConnectProviderOutput{
+				ClientIDList:   []string{"sts.amazonaws.com"},
+				ThumbprintList: []string{thumbprint},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsOpenIDConnectProviderUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+)
+
+const (
+	errGetCallerIdentity   = "cannot get caller identity"
+	errParseCallerARN      = "cannot parse caller ARN"
+	errGetRole             = "cannot get role of assumed role"
+	errUnsupportedIdentity = "cannot audit permissions of identity"
+	errSimulate            = "cannot simulate principal policy"
+)
+
+// PermissionsAuditClient is the external client used to audit the
+// permissions of the principal of a set of credentials.
+type PermissionsAuditClient interface {
+	GetCallerIdentityRequest(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+	GetRoleRequest(*iam.GetRoleInput) iam.GetRoleRequest
+	SimulatePrincipalPolicyRequest(*iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest
+}
+
+type permissionsAuditClient struct {
+	iam *iam.Client
+	sts *sts.Client
+}
+
+// NewPermissionsAuditClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewPermissionsAuditClient(cfg aws.Config) PermissionsAuditClient {
+	return &permissionsAuditClient{iam: iam.New(cfg), sts: sts.New(cfg)}
+}
+
+func (c *permissionsAuditClient) GetCallerIdentityRequest(in *sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+	return c.sts.GetCallerIdentityRequest(in)
+}
+
+func (c *permissionsAuditClient) GetRoleRequest(in *iam.GetRoleInput) iam.GetRoleRequest {
+	return c.iam.GetRoleRequest(in)
+}
+
+func (c *permissionsAuditClient) SimulatePrincipalPolicyRequest(in *iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest {
+	return c.iam.SimulatePrincipalPolicyRequest(in)
+}
+
+// PrincipalARN returns the ARN of the IAM user or role whose policies apply to
+// the calls made with the credentials of the given client. The ARN of the
+// root user of an account is returned as is, its calls are never denied.
+func PrincipalARN(ctx context.Context, c PermissionsAuditClient) (string, error) {
+	id, err := c.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{}).Send(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, errGetCallerIdentity)
+	}
+	caller, err := arn.Parse(aws.StringValue(id.Arn))
+	if err != nil {
+		return "", errors.Wrap(err, errParseCallerARN)
+	}
+	switch {
+	case caller.Resource == "root", strings.HasPrefix(caller.Resource, "user/"):
+		return caller.String(), nil
+	case strings.HasPrefix(caller.Resource, "assumed-role/"):
+		// NOTE: The resource of an assumed role is assumed-role/<name>/<session>
+		// and does not contain the path of the role, so we need to look it up.
+		name := strings.Split(caller.Resource, "/")[1]
+		role, err := c.GetRoleRequest(&iam.GetRoleInput{RoleName: aws.String(name)}).Send(ctx)
+		if err != nil {
+			return "", errors.Wrap(err, errGetRole)
+		}
+		return aws.StringValue(role.Role.Arn), nil
+	}
+	return "", errors.Errorf("%s %s", errUnsupportedIdentity, caller.String())
+}
+
+// IsRootPrincipal returns whether the given ARN is the ARN of the root user of
+// an account.
+func IsRootPrincipal(principal string) bool {
+	a, err := arn.Parse(principal)
+	return err == nil && a.Resource == "root"
+}
+
+// DeniedActions returns the given actions that the policies of the given
+// principal do not allow.
+func DeniedActions(ctx context.Context, c PermissionsAuditClient, principal string, actions []string) ([]string, error) {
+	var denied []string
+	in := &iam.SimulatePrincipalPolicyInput{PolicySourceArn: aws.String(principal), ActionNames: actions}
+	for {
+		out, err := c.SimulatePrincipalPolicyRequest(in).Send(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, errSimulate)
+		}
+		for _, r := range out.EvaluationResults {
+			if r.EvalDecision != iam.PolicyEvaluationDecisionTypeAllowed {
+				denied = append(denied, aws.StringValue(r.EvalActionName))
+			}
+		}
+		if !aws.BoolValue(out.IsTruncated) {
+			return denied, nil
+		}
+		in.Marker = out.Marker
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type mockPermissionsAuditClient struct {
+	getCallerIdentity func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+	getRole           func(*iam.GetRoleInput) iam.GetRoleRequest
+	simulate          func(*iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest
+}
+
+func (m *mockPermissionsAuditClient) GetCallerIdentityRequest(in *sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+	return m.getCallerIdentity(in)
+}
+
+func (m *mockPermissionsAuditClient) GetRoleRequest(in *iam.GetRoleInput) iam.GetRoleRequest {
+	return m.getRole(in)
+}
+
+func (m *mockPermissionsAuditClient) SimulatePrincipalPolicyRequest(in *iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest {
+	return m.simulate(in)
+}
+
+func callerIdentity(a string) func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+	return func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+		return sts.GetCallerIdentityRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &sts.GetCallerIdentityOutput{Arn: aws.String(a)}},
+		}
+	}
+}
+
+func TestPrincipalARN(t *testing.T) {
+	errBoom := errors.New("boom")
+	roleARN := "arn:aws:iam::123456789012:role/path/crossplane"
+
+	type want struct {
+		arn string
+		err error
+	}
+
+	cases := map[string]struct {
+		client PermissionsAuditClient
+		want   want
+	}{
+		"User": {
+			client: &mockPermissionsAuditClient{getCallerIdentity: callerIdentity("arn:aws:iam::123456789012:user/crossplane")},
+			want:   want{arn: "arn:aws:iam::123456789012:user/crossplane"},
+		},
+		"Root": {
+			client: &mockPermissionsAuditClient{getCallerIdentity: callerIdentity("arn:aws:iam::123456789012:root")},
+			want:   want{arn: "arn:aws:iam::123456789012:root"},
+		},
+		"AssumedRole": {
+			client: &mockPermissionsAuditClient{
+				getCallerIdentity: callerIdentity("arn:aws:sts::123456789012:assumed-role/crossplane/session"),
+				getRole: func(in *iam.GetRoleInput) iam.GetRoleRequest {
+					if aws.StringValue(in.RoleName) != "crossplane" {
+						t.Errorf("unexpected role name %s", aws.StringValue(in.RoleName))
+					}
+					return iam.GetRoleRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &iam.GetRoleOutput{Role: &iam.Role{Arn: aws.String(roleARN)}}},
+					}
+				},
+			},
+			want: want{arn: roleARN},
+		},
+		"FederatedUser": {
+			client: &mockPermissionsAuditClient{getCallerIdentity: callerIdentity("arn:aws:sts::123456789012:federated-user/crossplane")},
+			want:   want{err: errors.Errorf("%s %s", errUnsupportedIdentity, "arn:aws:sts::123456789012:federated-user/crossplane")},
+		},
+		"GetCallerIdentityFailed": {
+			client: &mockPermissionsAuditClient{getCallerIdentity: func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+				return sts.GetCallerIdentityRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+				}
+			}},
+			want: want{err: errors.Wrap(errBoom, errGetCallerIdentity)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			arn, err := PrincipalARN(context.Background(), tc.client)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.arn, arn); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeniedActions(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		denied []string
+		err    error
+	}
+
+	cases := map[string]struct {
+		client PermissionsAuditClient
+		want   want
+	}{
+		"Paginated": {
+			client: &mockPermissionsAuditClient{simulate: func(in *iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest {
+				out := &iam.SimulatePrincipalPolicyOutput{
+					EvaluationResults: []iam.EvaluationResult{
+						{EvalActionName: aws.String("ec2:CreateVpc"), EvalDecision: iam.PolicyEvaluationDecisionTypeAllowed},
+						{EvalActionName: aws.String("ec2:DeleteVpc"), EvalDecision: iam.PolicyEvaluationDecisionTypeExplicitDeny},
+					},
+					IsTruncated: aws.Bool(true),
+					Marker:      aws.String("next"),
+				}
+				if in.Marker != nil {
+					out = &iam.SimulatePrincipalPolicyOutput{
+						EvaluationResults: []iam.EvaluationResult{
+							{EvalActionName: aws.String("ec2:CreateTags"), EvalDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny},
+						},
+					}
+				}
+				return iam.SimulatePrincipalPolicyRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+				}
+			}},
+			want: want{denied: []string{"ec2:DeleteVpc", "ec2:CreateTags"}},
+		},
+		"SimulateFailed": {
+			client: &mockPermissionsAuditClient{simulate: func(*iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest {
+				return iam.SimulatePrincipalPolicyRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+				}
+			}},
+			want: want{err: errors.Wrap(errBoom, errSimulate)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			denied, err := DeniedActions(context.Background(), tc.client, "arn", []string{"ec2:CreateVpc", "ec2:DeleteVpc", "ec2:CreateTags"})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.denied, denied); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+)
+
+const (
+	errNoSAMLMetadataDocument        = "one of samlMetadataDocument, samlMetadataDocumentSecretRef or samlMetadataDocumentConfigMapRef must be set"
+	errGetSAMLMetadataDocumentSecret = "cannot get the secret that contains the SAML metadata document"
+	errGetSAMLMetadataDocumentCM     = "cannot get the config map that contains the SAML metadata document"
+)
+
+// SAMLProviderClient is the external client used for SAMLProvider Custom
+// Resource
+type SAMLProviderClient interface {
+	CreateSAMLProviderRequest(*iam.CreateSAMLProviderInput) iam.CreateSAMLProviderRequest
+	GetSAMLProviderRequest(*iam.GetSAMLProviderInput) iam.GetSAMLProviderRequest
+	UpdateSAMLProviderRequest(*iam.UpdateSAMLProviderInput) iam.UpdateSAMLProviderRequest
+	DeleteSAMLProviderRequest(*iam.DeleteSAMLProviderInput) iam.DeleteSAMLProviderRequest
+}
+
+// NewSAMLProviderClient returns a new client using AWS credentials as JSON encoded data.
+func NewSAMLProviderClient(cfg aws.Config) SAMLProviderClient {
+	return iam.New(cfg)
+}
+
+// GetSAMLMetadataDocument returns the SAML metadata document of the given
+// parameters, reading it from the referenced secret or config map if it is
+// not given inline.
+func GetSAMLMetadataDocument(ctx context.Context, kube client.Client, p v1alpha1.SAMLProviderParameters) (string, error) {
+	switch {
+	case p.SAMLMetadataDocument != nil:
+		return *p.SAMLMetadataDocument, nil
+	case p.SAMLMetadataDocumentSecretRef != nil:
+		ref := p.SAMLMetadataDocumentSecretRef
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+			return "", errors.Wrap(err, errGetSAMLMetadataDocumentSecret)
+		}
+		return string(s.Data[ref.Key]), nil
+	case p.SAMLMetadataDocumentConfigMapRef != nil:
+		ref := p.SAMLMetadataDocumentConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+			return "", errors.Wrap(err, errGetSAMLMetadataDocumentCM)
+		}
+		return cm.Data[ref.Key], nil
+	}
+	return "", errors.New(errNoSAMLMetadataDocument)
+}
+
+// GenerateSAMLProviderObservation returns the observation of the SAML
+// provider with the given ARN.
+func GenerateSAMLProviderObservation(arn string, o iam.GetSAMLProviderOutput) v1alpha1.SAMLProviderObservation {
+	obs := v1alpha1.SAMLProviderObservation{ARN: arn}
+	if o.CreateDate != nil {
+		t := metav1.NewTime(*o.CreateDate)
+		obs.CreateDate = &t
+	}
+	if o.ValidUntil != nil {
+		t := metav1.NewTime(*o.ValidUntil)
+		obs.ValidUntil = &t
+	}
+	return obs
+}
+
+// IsSAMLProviderUpToDate returns whether the metadata document of the
+// observed SAML provider matches the given one.
+func IsSAMLProviderUpToDate(document string, o iam.GetSAMLProviderOutput) bool {
+	return strings.TrimSpace(document) == strings.TrimSpace(aws.StringValue(o.SAMLMetadataDocument))
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+)
+
+var samlMetadataDocument = "<EntityDescriptor/>"
+
+func TestGetSAMLMetadataDocument(t *testing.T) {
+	errBoom := errors.New("boom")
+	type want struct {
+		document string
+		err      error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		p    v1alpha1.SAMLProviderParameters
+		want want
+	}{
+		"Inline": {
+			p:    v1alpha1.SAMLProviderParameters{SAMLMetadataDocument: aws.String(samlMetadataDocument)},
+			want: want{document: samlMetadataDocument},
+		},
+		"Secret": {
+			kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+				obj.(*corev1.Secret).Data = map[string][]byte{"metadata.xml": []byte(samlMetadataDocument)}
+				return nil
+			}},
+			p: v1alpha1.SAMLProviderParameters{SAMLMetadataDocumentSecretRef: &runtimev1alpha1.SecretKeySelector{
+				SecretReference: runtimev1alpha1.SecretReference{Name: "idp", Namespace: "default"},
+				Key:             "metadata.xml",
+			}},
+			want: want{document: samlMetadataDocument},
+		},
+		"ConfigMap": {
+			kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+				obj.(*corev1.ConfigMap).Data = map[string]string{"metadata.xml": samlMetadataDocument}
+				return nil
+			}},
+			p: v1alpha1.SAMLProviderParameters{SAMLMetadataDocumentConfigMapRef: &v1alpha1.ConfigMapKeySelector{
+				Name: "idp", Namespace: "default", Key: "metadata.xml",
+			}},
+			want: want{document: samlMetadataDocument},
+		},
+		"ConfigMapError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p: v1alpha1.SAMLProviderParameters{SAMLMetadataDocumentConfigMapRef: &v1alpha1.ConfigMapKeySelector{
+				Name: "idp", Namespace: "default", Key: "metadata.xml",
+			}},
+			want: want{err: errors.Wrap(errBoom, errGetSAMLMetadataDocumentCM)},
+		},
+		"NoDocument": {
+			want: want{err: errors.New(errNoSAMLMetadataDocument)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetSAMLMetadataDocument(context.Background(), tc.kube, tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.document, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lakeformation
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+)
+
+// IAMAllowedPrincipals is the group of all IAM users and roles. Granting it
+// all permissions leaves access control to IAM, which is the default of Lake
+// Formation.
+const IAMAllowedPrincipals = "IAM_ALLOWED_PRINCIPALS"
+
+// DataLakeSettingsClient is the external client used for DataLakeSettings
+// Custom Resource
+type DataLakeSettingsClient interface {
+	GetDataLakeSettingsRequest(*lakeformation.GetDataLakeSettingsInput) lakeformation.GetDataLakeSettingsRequest
+	PutDataLakeSettingsRequest(*lakeformation.PutDataLakeSettingsInput) lakeformation.PutDataLakeSettingsRequest
+}
+
+// NewDataLakeSettingsClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewDataLakeSettingsClient(cfg aws.Config) DataLakeSettingsClient {
+	return lakeformation.New(cfg)
+}
+
+// DefaultDataLakeSettingsParameters returns the parameters of the settings
+// of a Data Catalog that Lake Formation has not been set up for: no data
+// lake administrators and IAM-only access control.
+func DefaultDataLakeSettingsParameters() v1alpha1.DataLakeSettingsParameters {
+	all := []v1alpha1.PrincipalPermissions{{
+		Principal:   IAMAllowedPrincipals,
+		Permissions: []v1alpha1.Permission{v1alpha1.Permission(lakeformation.PermissionAll)},
+	}}
+	return v1alpha1.DataLakeSettingsParameters{
+		CreateDatabaseDefaultPermissions: all,
+		CreateTableDefaultPermissions:    all,
+	}
+}
+
+func generatePrincipalPermissions(in []v1alpha1.PrincipalPermissions) []lakeformation.PrincipalPermissions {
+	if in == nil {
+		return nil
+	}
+	out := make([]lakeformation.PrincipalPermissions, len(in))
+	for i, pp := range in {
+		out[i] = lakeformation.PrincipalPermissions{
+			Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(pp.Principal)},
+			Permissions: GeneratePermissions(pp.Permissions),
+		}
+	}
+	return out
+}
+
+// GenerateDataLakeSettings returns the Lake Formation settings of the given
+// parameters.
+func GenerateDataLakeSettings(p v1alpha1.DataLakeSettingsParameters) *lakeformation.DataLakeSettings {
+	s := &lakeformation.DataLakeSettings{
+		CreateDatabaseDefaultPermissions: generatePrincipalPermissions(p.CreateDatabaseDefaultPermissions),
+		CreateTableDefaultPermissions:    generatePrincipalPermissions(p.CreateTableDefaultPermissions),
+	}
+	for _, a := range p.DataLakeAdmins {
+		s.DataLakeAdmins = append(s.DataLakeAdmins, lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(a)})
+	}
+	return s
+}
+
+// principalPermissionSets returns the permissions of every principal as
+// sorted lists so they can be compared regardless of order.
+func principalPermissionSets(in []lakeformation.PrincipalPermissions) map[string][]string {
+	out := map[string][]string{}
+	for _, pp := range in {
+		principal := ""
+		if pp.Principal != nil {
+			principal = aws.StringValue(pp.Principal.DataLakePrincipalIdentifier)
+		}
+		perms := append(out[principal], permissionStrings(pp.Permissions)...)
+		sort.Strings(perms)
+		out[principal] = perms
+	}
+	return out
+}
+
+// IsDataLakeSettingsUpToDate returns whether the observed settings are up to
+// date with the given parameters.
+func IsDataLakeSettingsUpToDate(p v1alpha1.DataLakeSettingsParameters, o lakeformation.DataLakeSettings) bool {
+	desired := GenerateDataLakeSettings(p)
+	admins := func(in []lakeformation.DataLakePrincipal) []string {
+		out := make([]string, len(in))
+		for i, a := range in {
+			out[i] = aws.StringValue(a.DataLakePrincipalIdentifier)
+		}
+		return out
+	}
+	return cmp.Equal(admins(desired.DataLakeAdmins), admins(o.DataLakeAdmins), cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b })) &&
+		cmp.Equal(principalPermissionSets(desired.CreateDatabaseDefaultPermissions), principalPermissionSets(o.CreateDatabaseDefaultPermissions), cmpopts.EquateEmpty()) &&
+		cmp.Equal(principalPermissionSets(desired.CreateTableDefaultPermissions), principalPermissionSets(o.CreateTableDefaultPermissions), cmpopts.EquateEmpty())
+}
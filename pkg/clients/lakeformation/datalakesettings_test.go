@@ -0,0 +1,74 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lakeformation
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+)
+
+func defaultSettings() lakeformation.DataLakeSettings {
+	all := []lakeformation.PrincipalPermissions{{
+		Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(IAMAllowedPrincipals)},
+		Permissions: []lakeformation.Permission{lakeformation.PermissionAll},
+	}}
+	return lakeformation.DataLakeSettings{
+		CreateDatabaseDefaultPermissions: all,
+		CreateTableDefaultPermissions:    all,
+	}
+}
+
+func TestIsDataLakeSettingsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DataLakeSettingsParameters
+		o    lakeformation.DataLakeSettings
+		want bool
+	}{
+		"Defaults": {
+			p:    DefaultDataLakeSettingsParameters(),
+			o:    defaultSettings(),
+			want: true,
+		},
+		"AdminAdded": {
+			p: func() v1alpha1.DataLakeSettingsParameters {
+				p := DefaultDataLakeSettingsParameters()
+				p.DataLakeAdmins = []string{"arn:aws:iam::123456789012:role/admin"}
+				return p
+			}(),
+			o:    defaultSettings(),
+			want: false,
+		},
+		"IAMAccessControlDisabled": {
+			p:    v1alpha1.DataLakeSettingsParameters{},
+			o:    defaultSettings(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsDataLakeSettingsUpToDate(tc.p, tc.o)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/lakeformation"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.PermissionsClient = (*MockPermissionsClient)(nil)
+
+// MockPermissionsClient is a type that implements all the methods for PermissionsClient interface
+type MockPermissionsClient struct {
+	MockListPermissions   func(*lakeformation.ListPermissionsInput) lakeformation.ListPermissionsRequest
+	MockGrantPermissions  func(*lakeformation.GrantPermissionsInput) lakeformation.GrantPermissionsRequest
+	MockRevokePermissions func(*lakeformation.RevokePermissionsInput) lakeformation.RevokePermissionsRequest
+}
+
+// ListPermissionsRequest mocks ListPermissionsRequest method
+func (m *MockPermissionsClient) ListPermissionsRequest(input *lakeformation.ListPermissionsInput) lakeformation.ListPermissionsRequest {
+	return m.MockListPermissions(input)
+}
+
+// GrantPermissionsRequest mocks GrantPermissionsRequest method
+func (m *MockPermissionsClient) GrantPermissionsRequest(input *lakeformation.GrantPermissionsInput) lakeformation.GrantPermissionsRequest {
+	return m.MockGrantPermissions(input)
+}
+
+// RevokePermissionsRequest mocks RevokePermissionsRequest method
+func (m *MockPermissionsClient) RevokePermissionsRequest(input *lakeformation.RevokePermissionsInput) lakeformation.RevokePermissionsRequest {
+	return m.MockRevokePermissions(input)
+}
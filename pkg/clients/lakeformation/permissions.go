@@ -0,0 +1,228 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lakeformation
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+)
+
+// PermissionsClient is the external client used for
+// LakeFormationPermissions Custom Resource
+type PermissionsClient interface {
+	ListPermissionsRequest(*lakeformation.ListPermissionsInput) lakeformation.ListPermissionsRequest
+	GrantPermissionsRequest(*lakeformation.GrantPermissionsInput) lakeformation.GrantPermissionsRequest
+	RevokePermissionsRequest(*lakeformation.RevokePermissionsInput) lakeformation.RevokePermissionsRequest
+}
+
+// NewPermissionsClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewPermissionsClient(cfg aws.Config) PermissionsClient {
+	return lakeformation.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the resource the
+// permissions apply to doesn't exist.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == lakeformation.ErrCodeEntityNotFoundException
+}
+
+// GeneratePermissions returns the Lake Formation permissions of the given
+// permissions.
+func GeneratePermissions(in []v1alpha1.Permission) []lakeformation.Permission {
+	if in == nil {
+		return nil
+	}
+	out := make([]lakeformation.Permission, len(in))
+	for i, p := range in {
+		out[i] = lakeformation.Permission(p)
+	}
+	return out
+}
+
+func permissionStrings(in []lakeformation.Permission) []string {
+	out := make([]string, len(in))
+	for i, p := range in {
+		out[i] = string(p)
+	}
+	return out
+}
+
+func generateTableResource(t v1alpha1.TableResource) *lakeformation.TableResource {
+	return &lakeformation.TableResource{
+		DatabaseName: t.DatabaseName,
+		Name:         aws.String(t.Name),
+	}
+}
+
+// GenerateResource returns the Lake Formation resource of the given
+// resource.
+func GenerateResource(r v1alpha1.PermissionsResource) *lakeformation.Resource {
+	out := &lakeformation.Resource{}
+	switch {
+	case r.Catalog != nil:
+		out.Catalog = &lakeformation.CatalogResource{}
+	case r.Database != nil:
+		out.Database = &lakeformation.DatabaseResource{Name: r.Database.Name}
+	case r.Table != nil:
+		out.Table = generateTableResource(*r.Table)
+	case r.TableWithColumns != nil:
+		out.TableWithColumns = &lakeformation.TableWithColumnsResource{
+			DatabaseName: r.TableWithColumns.DatabaseName,
+			Name:         aws.String(r.TableWithColumns.Name),
+			ColumnNames:  r.TableWithColumns.ColumnNames,
+		}
+		if w := r.TableWithColumns.ColumnWildcard; w != nil {
+			out.TableWithColumns.ColumnWildcard = &lakeformation.ColumnWildcard{ExcludedColumnNames: w.ExcludedColumnNames}
+		}
+	case r.DataLocation != nil:
+		out.DataLocation = &lakeformation.DataLocationResource{ResourceArn: aws.String(r.DataLocation.ResourceARN)}
+	}
+	return out
+}
+
+// GeneratePrincipal returns the Lake Formation principal of the given
+// parameters.
+func GeneratePrincipal(p v1alpha1.LakeFormationPermissionsParameters) *lakeformation.DataLakePrincipal {
+	return &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: p.Principal}
+}
+
+// ObservedPermissions returns the union of the permissions and the
+// permissions with grant option of the given listed permissions, sorted.
+func ObservedPermissions(in []lakeformation.PrincipalResourcePermissions) (permissions, withGrantOption []lakeformation.Permission) {
+	perms, grants := map[lakeformation.Permission]bool{}, map[lakeformation.Permission]bool{}
+	for _, prp := range in {
+		for _, p := range prp.Permissions {
+			perms[p] = true
+		}
+		for _, p := range prp.PermissionsWithGrantOption {
+			grants[p] = true
+		}
+	}
+	return sortedPermissions(perms), sortedPermissions(grants)
+}
+
+func sortedPermissions(set map[lakeformation.Permission]bool) []lakeformation.Permission {
+	if len(set) == 0 {
+		return nil
+	}
+	out := make([]lakeformation.Permission, 0, len(set))
+	for p := range set {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// DiffPermissions returns the permissions in observed that are not in
+// desired.
+func DiffPermissions(desired, observed []lakeformation.Permission) []lakeformation.Permission {
+	want := map[lakeformation.Permission]bool{}
+	for _, p := range desired {
+		want[p] = true
+	}
+	extra := map[lakeformation.Permission]bool{}
+	for _, p := range observed {
+		if !want[p] {
+			extra[p] = true
+		}
+	}
+	return sortedPermissions(extra)
+}
+
+func intersectPermissions(a, b []lakeformation.Permission) []lakeformation.Permission {
+	inA := map[lakeformation.Permission]bool{}
+	for _, p := range a {
+		inA[p] = true
+	}
+	both := map[lakeformation.Permission]bool{}
+	for _, p := range b {
+		if inA[p] {
+			both[p] = true
+		}
+	}
+	return sortedPermissions(both)
+}
+
+// ArePermissionsUpToDate returns whether the observed permissions and
+// permissions with grant option match the given parameters.
+func ArePermissionsUpToDate(p v1alpha1.LakeFormationPermissionsParameters, permissions, withGrantOption []lakeformation.Permission) bool {
+	desired, desiredGrant := GeneratePermissions(p.Permissions), GeneratePermissions(p.PermissionsWithGrantOption)
+	return len(DiffPermissions(desired, permissions)) == 0 && len(DiffPermissions(permissions, desired)) == 0 &&
+		len(DiffPermissions(desiredGrant, withGrantOption)) == 0 && len(DiffPermissions(withGrantOption, desiredGrant)) == 0
+}
+
+// GenerateRevokePermissionsInput returns the input that revokes the
+// observed permissions and permissions with grant option that are not in
+// the given parameters. It returns nil if there is nothing to revoke.
+// Revoking a grant option also revokes the permission itself, which is
+// granted again afterwards if it is still desired.
+func GenerateRevokePermissionsInput(p v1alpha1.LakeFormationPermissionsParameters, permissions, withGrantOption []lakeformation.Permission) *lakeformation.RevokePermissionsInput {
+	extraGrant := DiffPermissions(GeneratePermissions(p.PermissionsWithGrantOption), withGrantOption)
+	extra := DiffPermissions(GeneratePermissions(p.Permissions), permissions)
+	if len(extra) == 0 && len(extraGrant) == 0 {
+		return nil
+	}
+	set := map[lakeformation.Permission]bool{}
+	for _, perm := range append(extra, extraGrant...) {
+		set[perm] = true
+	}
+	return &lakeformation.RevokePermissionsInput{
+		CatalogId:                  p.CatalogID,
+		Principal:                  GeneratePrincipal(p),
+		Resource:                   GenerateResource(p.Resource),
+		Permissions:                sortedPermissions(set),
+		PermissionsWithGrantOption: extraGrant,
+	}
+}
+
+// GenerateRevokeDeclaredPermissionsInput returns the input that revokes the
+// observed permissions and permissions with grant option that are in the
+// given parameters. Permissions the principal was granted by other means
+// are left alone. It returns nil if there is nothing to revoke.
+func GenerateRevokeDeclaredPermissionsInput(p v1alpha1.LakeFormationPermissionsParameters, permissions, withGrantOption []lakeformation.Permission) *lakeformation.RevokePermissionsInput {
+	declared := intersectPermissions(GeneratePermissions(p.Permissions), permissions)
+	declaredGrant := intersectPermissions(GeneratePermissions(p.PermissionsWithGrantOption), withGrantOption)
+	if len(declared) == 0 && len(declaredGrant) == 0 {
+		return nil
+	}
+	return &lakeformation.RevokePermissionsInput{
+		CatalogId:                  p.CatalogID,
+		Principal:                  GeneratePrincipal(p),
+		Resource:                   GenerateResource(p.Resource),
+		Permissions:                declared,
+		PermissionsWithGrantOption: declaredGrant,
+	}
+}
+
+// GenerateGrantPermissionsInput returns the input that grants the
+// permissions of the given parameters.
+func GenerateGrantPermissionsInput(p v1alpha1.LakeFormationPermissionsParameters) *lakeformation.GrantPermissionsInput {
+	return &lakeformation.GrantPermissionsInput{
+		CatalogId:                  p.CatalogID,
+		Principal:                  GeneratePrincipal(p),
+		Resource:                   GenerateResource(p.Resource),
+		Permissions:                GeneratePermissions(p.Permissions),
+		PermissionsWithGrantOption: GeneratePermissions(p.PermissionsWithGrantOption),
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lakeformation
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+)
+
+var (
+	principal = "arn:aws:iam::123456789012:role/analyst"
+
+	sel    = lakeformation.PermissionSelect
+	insert = lakeformation.PermissionInsert
+	alter  = lakeformation.PermissionAlter
+)
+
+func params(perms, grant []v1alpha1.Permission) v1alpha1.LakeFormationPermissionsParameters {
+	return v1alpha1.LakeFormationPermissionsParameters{
+		Principal: aws.String(principal),
+		Resource: v1alpha1.PermissionsResource{
+			Table: &v1alpha1.TableResource{DatabaseName: aws.String("events"), Name: "clicks"},
+		},
+		Permissions:                perms,
+		PermissionsWithGrantOption: grant,
+	}
+}
+
+func TestObservedPermissions(t *testing.T) {
+	perms, grant := ObservedPermissions([]lakeformation.PrincipalResourcePermissions{
+		{Permissions: []lakeformation.Permission{sel, insert}, PermissionsWithGrantOption: []lakeformation.Permission{sel}},
+		{Permissions: []lakeformation.Permission{alter, sel}},
+	})
+	if diff := cmp.Diff([]lakeformation.Permission{alter, insert, sel}, perms); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]lakeformation.Permission{sel}, grant); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestArePermissionsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p     v1alpha1.LakeFormationPermissionsParameters
+		perms []lakeformation.Permission
+		grant []lakeformation.Permission
+		want  bool
+	}{
+		"UpToDate": {
+			p:     params([]v1alpha1.Permission{"SELECT", "INSERT"}, []v1alpha1.Permission{"SELECT"}),
+			perms: []lakeformation.Permission{insert, sel},
+			grant: []lakeformation.Permission{sel},
+			want:  true,
+		},
+		"MissingPermission": {
+			p:     params([]v1alpha1.Permission{"SELECT", "INSERT"}, nil),
+			perms: []lakeformation.Permission{sel},
+			want:  false,
+		},
+		"ExtraGrantOption": {
+			p:     params([]v1alpha1.Permission{"SELECT"}, nil),
+			perms: []lakeformation.Permission{sel},
+			grant: []lakeformation.Permission{sel},
+			want:  false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ArePermissionsUpToDate(tc.p, tc.perms, tc.grant)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRevokePermissionsInput(t *testing.T) {
+	cases := map[string]struct {
+		p     v1alpha1.LakeFormationPermissionsParameters
+		perms []lakeformation.Permission
+		grant []lakeformation.Permission
+		want  *lakeformation.RevokePermissionsInput
+	}{
+		"NothingToRevoke": {
+			p:     params([]v1alpha1.Permission{"SELECT", "INSERT"}, nil),
+			perms: []lakeformation.Permission{sel},
+		},
+		"ExtraPermission": {
+			p:     params([]v1alpha1.Permission{"SELECT"}, nil),
+			perms: []lakeformation.Permission{alter, sel},
+			want: &lakeformation.RevokePermissionsInput{
+				Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+				Resource:    &lakeformation.Resource{Table: &lakeformation.TableResource{DatabaseName: aws.String("events"), Name: aws.String("clicks")}},
+				Permissions: []lakeformation.Permission{alter},
+			},
+		},
+		"ExtraGrantOption": {
+			p:     params([]v1alpha1.Permission{"SELECT"}, nil),
+			perms: []lakeformation.Permission{sel},
+			grant: []lakeformation.Permission{sel},
+			want: &lakeformation.RevokePermissionsInput{
+				Principal:                  &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+				Resource:                   &lakeformation.Resource{Table: &lakeformation.TableResource{DatabaseName: aws.String("events"), Name: aws.String("clicks")}},
+				Permissions:                []lakeformation.Permission{sel},
+				PermissionsWithGrantOption: []lakeformation.Permission{sel},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRevokePermissionsInput(tc.p, tc.perms, tc.grant)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(
+				lakeformation.RevokePermissionsInput{}, lakeformation.DataLakePrincipal{},
+				lakeformation.Resource{}, lakeformation.TableResource{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRevokeDeclaredPermissionsInput(t *testing.T) {
+	cases := map[string]struct {
+		p     v1alpha1.LakeFormationPermissionsParameters
+		perms []lakeformation.Permission
+		grant []lakeformation.Permission
+		want  *lakeformation.RevokePermissionsInput
+	}{
+		"NothingDeclaredGranted": {
+			p:     params([]v1alpha1.Permission{"SELECT"}, nil),
+			perms: []lakeformation.Permission{alter},
+		},
+		"UndeclaredKept": {
+			p:     params([]v1alpha1.Permission{"SELECT", "INSERT"}, []v1alpha1.Permission{"SELECT"}),
+			perms: []lakeformation.Permission{alter, sel},
+			grant: []lakeformation.Permission{alter, sel},
+			want: &lakeformation.RevokePermissionsInput{
+				Principal:                  &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+				Resource:                   &lakeformation.Resource{Table: &lakeformation.TableResource{DatabaseName: aws.String("events"), Name: aws.String("clicks")}},
+				Permissions:                []lakeformation.Permission{sel},
+				PermissionsWithGrantOption: []lakeformation.Permission{sel},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRevokeDeclaredPermissionsInput(tc.p, tc.perms, tc.grant)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(
+				lakeformation.RevokePermissionsInput{}, lakeformation.DataLakePrincipal{},
+				lakeformation.Resource{}, lakeformation.TableResource{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
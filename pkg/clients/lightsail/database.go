@@ -0,0 +1,188 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lightsail
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/lightsail/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetPasswordSecret = "cannot get the master password secret"
+)
+
+// DatabaseClient is the external client used for Database Custom Resource
+type DatabaseClient interface {
+	GetRelationalDatabaseRequest(*lightsail.GetRelationalDatabaseInput) lightsail.GetRelationalDatabaseRequest
+	CreateRelationalDatabaseRequest(*lightsail.CreateRelationalDatabaseInput) lightsail.CreateRelationalDatabaseRequest
+	UpdateRelationalDatabaseRequest(*lightsail.UpdateRelationalDatabaseInput) lightsail.UpdateRelationalDatabaseRequest
+	DeleteRelationalDatabaseRequest(*lightsail.DeleteRelationalDatabaseInput) lightsail.DeleteRelationalDatabaseRequest
+}
+
+// NewDatabaseClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewDatabaseClient(cfg aws.Config) DatabaseClient {
+	return lightsail.New(cfg)
+}
+
+// GenerateCreateRelationalDatabaseInput returns the input to create a
+// database with the given name, master password and parameters.
+func GenerateCreateRelationalDatabaseInput(name, password string, p v1alpha1.DatabaseParameters) *lightsail.CreateRelationalDatabaseInput {
+	return &lightsail.CreateRelationalDatabaseInput{
+		RelationalDatabaseName:        aws.String(name),
+		AvailabilityZone:              p.AvailabilityZone,
+		RelationalDatabaseBlueprintId: aws.String(p.BlueprintID),
+		RelationalDatabaseBundleId:    aws.String(p.BundleID),
+		MasterDatabaseName:            aws.String(p.MasterDatabaseName),
+		MasterUsername:                aws.String(p.MasterUsername),
+		MasterUserPassword:            awsclients.String(password),
+		PreferredBackupWindow:         p.PreferredBackupWindow,
+		PreferredMaintenanceWindow:    p.PreferredMaintenanceWindow,
+		PubliclyAccessible:            p.PubliclyAccessible,
+		Tags:                          GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateRelationalDatabaseInput returns the update input that changes
+// the given observed database into the desired one. Only the fields that
+// differ are set.
+func GenerateUpdateRelationalDatabaseInput(name string, p v1alpha1.DatabaseParameters, o lightsail.RelationalDatabase) *lightsail.UpdateRelationalDatabaseInput {
+	in := &lightsail.UpdateRelationalDatabaseInput{
+		RelationalDatabaseName: aws.String(name),
+		ApplyImmediately:       p.ApplyImmediately,
+	}
+	if p.PreferredBackupWindow != nil && aws.StringValue(p.PreferredBackupWindow) != aws.StringValue(o.PreferredBackupWindow) {
+		in.PreferredBackupWindow = p.PreferredBackupWindow
+	}
+	if p.PreferredMaintenanceWindow != nil && aws.StringValue(p.PreferredMaintenanceWindow) != aws.StringValue(o.PreferredMaintenanceWindow) {
+		in.PreferredMaintenanceWindow = p.PreferredMaintenanceWindow
+	}
+	if p.PubliclyAccessible != nil && aws.BoolValue(p.PubliclyAccessible) != aws.BoolValue(o.PubliclyAccessible) {
+		in.PubliclyAccessible = p.PubliclyAccessible
+	}
+	if p.BackupRetentionEnabled != nil && aws.BoolValue(p.BackupRetentionEnabled) != aws.BoolValue(o.BackupRetentionEnabled) {
+		if aws.BoolValue(p.BackupRetentionEnabled) {
+			in.EnableBackupRetention = aws.Bool(true)
+		} else {
+			in.DisableBackupRetention = aws.Bool(true)
+		}
+	}
+	return in
+}
+
+// GenerateDeleteRelationalDatabaseInput returns the delete input of the
+// database with the given name.
+func GenerateDeleteRelationalDatabaseInput(name string, p v1alpha1.DatabaseParameters) *lightsail.DeleteRelationalDatabaseInput {
+	return &lightsail.DeleteRelationalDatabaseInput{
+		RelationalDatabaseName:              aws.String(name),
+		SkipFinalSnapshot:                   p.SkipFinalSnapshot,
+		FinalRelationalDatabaseSnapshotName: p.FinalSnapshotName,
+	}
+}
+
+// GenerateDatabaseObservation returns the observation of the given database.
+func GenerateDatabaseObservation(o lightsail.RelationalDatabase) v1alpha1.DatabaseObservation {
+	res := v1alpha1.DatabaseObservation{
+		ARN:           aws.StringValue(o.Arn),
+		State:         aws.StringValue(o.State),
+		Engine:        aws.StringValue(o.Engine),
+		EngineVersion: aws.StringValue(o.EngineVersion),
+	}
+	if o.MasterEndpoint != nil {
+		res.Endpoint = aws.StringValue(o.MasterEndpoint.Address)
+		res.Port = aws.Int64Value(o.MasterEndpoint.Port)
+	}
+	return res
+}
+
+// LateInitializeDatabase fills the empty fields of the given parameters with
+// the values of the observed database.
+func LateInitializeDatabase(p *v1alpha1.DatabaseParameters, o lightsail.RelationalDatabase) {
+	if o.Location != nil {
+		p.AvailabilityZone = awsclients.LateInitializeStringPtr(p.AvailabilityZone, o.Location.AvailabilityZone)
+	}
+	p.PreferredBackupWindow = awsclients.LateInitializeStringPtr(p.PreferredBackupWindow, o.PreferredBackupWindow)
+	p.PreferredMaintenanceWindow = awsclients.LateInitializeStringPtr(p.PreferredMaintenanceWindow, o.PreferredMaintenanceWindow)
+	p.PubliclyAccessible = awsclients.LateInitializeBoolPtr(p.PubliclyAccessible, o.PubliclyAccessible)
+	p.BackupRetentionEnabled = awsclients.LateInitializeBoolPtr(p.BackupRetentionEnabled, o.BackupRetentionEnabled)
+}
+
+// IsDatabaseUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsDatabaseUpToDate(p v1alpha1.DatabaseParameters, o lightsail.RelationalDatabase) bool {
+	in := GenerateUpdateRelationalDatabaseInput(aws.StringValue(o.Name), p, o)
+	return cmp.Equal(&lightsail.UpdateRelationalDatabaseInput{RelationalDatabaseName: in.RelationalDatabaseName, ApplyImmediately: in.ApplyImmediately}, in,
+		cmpopts.IgnoreUnexported(lightsail.UpdateRelationalDatabaseInput{}))
+}
+
+// GetPassword returns the master password referenced by the given Database,
+// if any, and whether it differs from the password in its connection secret.
+func GetPassword(ctx context.Context, kube client.Client, cr *v1alpha1.Database) (pwd string, changed bool, err error) {
+	ref := cr.Spec.ForProvider.MasterUserPasswordSecretRef
+	if ref == nil {
+		return "", false, nil
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", false, errors.Wrap(err, errGetPasswordSecret)
+	}
+	pwd = string(s.Data[ref.Key])
+
+	if cr.Spec.WriteConnectionSecretToReference != nil {
+		conn := &corev1.Secret{}
+		nn := types.NamespacedName{
+			Name:      cr.Spec.WriteConnectionSecretToReference.Name,
+			Namespace: cr.Spec.WriteConnectionSecretToReference.Namespace,
+		}
+		// The connection secret doesn't exist until the database is created.
+		if err := kube.Get(ctx, nn, conn); resource.IgnoreNotFound(err) != nil {
+			return "", false, err
+		}
+		changed = pwd != "" && pwd != string(conn.Data[runtimev1alpha1.ResourceCredentialsSecretPasswordKey])
+	}
+	return pwd, changed, nil
+}
+
+// GetDatabaseConnectionDetails returns the connection details of the given
+// Database, i.e. its endpoint, port and master username.
+func GetDatabaseConnectionDetails(cr v1alpha1.Database) managed.ConnectionDetails {
+	o := cr.Status.AtProvider
+	if o.Endpoint == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(o.Endpoint),
+		runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.FormatInt(o.Port, 10)),
+		runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(cr.Spec.ForProvider.MasterUsername),
+	}
+}
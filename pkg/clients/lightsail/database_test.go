@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lightsail
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/lightsail/v1alpha1"
+)
+
+var databaseName = "some-database"
+
+func observedDatabase() lightsail.RelationalDatabase {
+	return lightsail.RelationalDatabase{
+		Name:                       aws.String(databaseName),
+		PreferredBackupWindow:      aws.String("16:00-16:30"),
+		PreferredMaintenanceWindow: aws.String("Tue:17:00-Tue:17:30"),
+		PubliclyAccessible:         aws.Bool(false),
+		BackupRetentionEnabled:     aws.Bool(true),
+	}
+}
+
+func TestGenerateUpdateRelationalDatabaseInput(t *testing.T) {
+	cases := map[string]struct {
+		p   v1alpha1.DatabaseParameters
+		o   lightsail.RelationalDatabase
+		out *lightsail.UpdateRelationalDatabaseInput
+	}{
+		"NoChange": {
+			p: v1alpha1.DatabaseParameters{
+				PreferredBackupWindow: aws.String("16:00-16:30"),
+				PubliclyAccessible:    aws.Bool(false),
+			},
+			o:   observedDatabase(),
+			out: &lightsail.UpdateRelationalDatabaseInput{RelationalDatabaseName: aws.String(databaseName)},
+		},
+		"WindowsAndAccess": {
+			p: v1alpha1.DatabaseParameters{
+				PreferredMaintenanceWindow: aws.String("Sun:03:00-Sun:03:30"),
+				PubliclyAccessible:         aws.Bool(true),
+				ApplyImmediately:           aws.Bool(true),
+			},
+			o: observedDatabase(),
+			out: &lightsail.UpdateRelationalDatabaseInput{
+				RelationalDatabaseName:     aws.String(databaseName),
+				ApplyImmediately:           aws.Bool(true),
+				PreferredMaintenanceWindow: aws.String("Sun:03:00-Sun:03:30"),
+				PubliclyAccessible:         aws.Bool(true),
+			},
+		},
+		"DisableBackupRetention": {
+			p: v1alpha1.DatabaseParameters{
+				BackupRetentionEnabled: aws.Bool(false),
+			},
+			o: observedDatabase(),
+			out: &lightsail.UpdateRelationalDatabaseInput{
+				RelationalDatabaseName: aws.String(databaseName),
+				DisableBackupRetention: aws.Bool(true),
+			},
+		},
+		"EnableBackupRetention": {
+			p: v1alpha1.DatabaseParameters{
+				BackupRetentionEnabled: aws.Bool(true),
+			},
+			o: func() lightsail.RelationalDatabase {
+				o := observedDatabase()
+				o.BackupRetentionEnabled = aws.Bool(false)
+				return o
+			}(),
+			out: &lightsail.UpdateRelationalDatabaseInput{
+				RelationalDatabaseName: aws.String(databaseName),
+				EnableBackupRetention:  aws.Bool(true),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateRelationalDatabaseInput(databaseName, tc.p, tc.o)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDatabaseUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DatabaseParameters
+		o    lightsail.RelationalDatabase
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.DatabaseParameters{
+				BackupRetentionEnabled: aws.Bool(true),
+				ApplyImmediately:       aws.Bool(true),
+			},
+			o:    observedDatabase(),
+			want: true,
+		},
+		"BackupWindowChanged": {
+			p: v1alpha1.DatabaseParameters{
+				PreferredBackupWindow: aws.String("04:00-04:30"),
+			},
+			o:    observedDatabase(),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDatabaseUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateTags(t *testing.T) {
+	cases := map[string]struct {
+		tags map[string]string
+		want []lightsail.Tag
+	}{
+		"Empty": {},
+		"SortedByKey": {
+			tags: map[string]string{"team": "web", "env": "dev"},
+			want: []lightsail.Tag{
+				{Key: aws.String("env"), Value: aws.String("dev")},
+				{Key: aws.String("team"), Value: aws.String("web")},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateTags(tc.tags)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/lightsail"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DatabaseClient = (*MockDatabaseClient)(nil)
+
+// MockDatabaseClient is a type that implements all the methods for DatabaseClient interface
+type MockDatabaseClient struct {
+	MockGetRelationalDatabase    func(*lightsail.GetRelationalDatabaseInput) lightsail.GetRelationalDatabaseRequest
+	MockCreateRelationalDatabase func(*lightsail.CreateRelationalDatabaseInput) lightsail.CreateRelationalDatabaseRequest
+	MockUpdateRelationalDatabase func(*lightsail.UpdateRelationalDatabaseInput) lightsail.UpdateRelationalDatabaseRequest
+	MockDeleteRelationalDatabase func(*lightsail.DeleteRelationalDatabaseInput) lightsail.DeleteRelationalDatabaseRequest
+}
+
+// GetRelationalDatabaseRequest mocks GetRelationalDatabaseRequest method
+func (m *MockDatabaseClient) GetRelationalDatabaseRequest(input *lightsail.GetRelationalDatabaseInput) lightsail.GetRelationalDatabaseRequest {
+	return m.MockGetRelationalDatabase(input)
+}
+
+// CreateRelationalDatabaseRequest mocks CreateRelationalDatabaseRequest method
+func (m *MockDatabaseClient) CreateRelationalDatabaseRequest(input *lightsail.CreateRelationalDatabaseInput) lightsail.CreateRelationalDatabaseRequest {
+	return m.MockCreateRelationalDatabase(input)
+}
+
+// UpdateRelationalDatabaseRequest mocks UpdateRelationalDatabaseRequest method
+func (m *MockDatabaseClient) UpdateRelationalDatabaseRequest(input *lightsail.UpdateRelationalDatabaseInput) lightsail.UpdateRelationalDatabaseRequest {
+	return m.MockUpdateRelationalDatabase(input)
+}
+
+// DeleteRelationalDatabaseRequest mocks DeleteRelationalDatabaseRequest method
+func (m *MockDatabaseClient) DeleteRelationalDatabaseRequest(input *lightsail.DeleteRelationalDatabaseInput) lightsail.DeleteRelationalDatabaseRequest {
+	return m.MockDeleteRelationalDatabase(input)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/lightsail"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.InstanceClient = (*MockInstanceClient)(nil)
+
+// MockInstanceClient is a type that implements all the methods for InstanceClient interface
+type MockInstanceClient struct {
+	MockGetInstance     func(*lightsail.GetInstanceInput) lightsail.GetInstanceRequest
+	MockCreateInstances func(*lightsail.CreateInstancesInput) lightsail.CreateInstancesRequest
+	MockDeleteInstance  func(*lightsail.DeleteInstanceInput) lightsail.DeleteInstanceRequest
+}
+
+// GetInstanceRequest mocks GetInstanceRequest method
+func (m *MockInstanceClient) GetInstanceRequest(input *lightsail.GetInstanceInput) lightsail.GetInstanceRequest {
+	return m.MockGetInstance(input)
+}
+
+// CreateInstancesRequest mocks CreateInstancesRequest method
+func (m *MockInstanceClient) CreateInstancesRequest(input *lightsail.CreateInstancesInput) lightsail.CreateInstancesRequest {
+	return m.MockCreateInstances(input)
+}
+
+// DeleteInstanceRequest mocks DeleteInstanceRequest method
+func (m *MockInstanceClient) DeleteInstanceRequest(input *lightsail.DeleteInstanceInput) lightsail.DeleteInstanceRequest {
+	return m.MockDeleteInstance(input)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/lightsail"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.StaticIPClient = (*MockStaticIPClient)(nil)
+
+// MockStaticIPClient is a type that implements all the methods for StaticIPClient interface
+type MockStaticIPClient struct {
+	MockGetStaticIp      func(*lightsail.GetStaticIpInput) lightsail.GetStaticIpRequest
+	MockAllocateStaticIp func(*lightsail.AllocateStaticIpInput) lightsail.AllocateStaticIpRequest
+	MockAttachStaticIp   func(*lightsail.AttachStaticIpInput) lightsail.AttachStaticIpRequest
+	MockDetachStaticIp   func(*lightsail.DetachStaticIpInput) lightsail.DetachStaticIpRequest
+	MockReleaseStaticIp  func(*lightsail.ReleaseStaticIpInput) lightsail.ReleaseStaticIpRequest
+}
+
+// GetStaticIpRequest mocks GetStaticIpRequest method
+func (m *MockStaticIPClient) GetStaticIpRequest(input *lightsail.GetStaticIpInput) lightsail.GetStaticIpRequest {
+	return m.MockGetStaticIp(input)
+}
+
+// AllocateStaticIpRequest mocks AllocateStaticIpRequest method
+func (m *MockStaticIPClient) AllocateStaticIpRequest(input *lightsail.AllocateStaticIpInput) lightsail.AllocateStaticIpRequest {
+	return m.MockAllocateStaticIp(input)
+}
+
+// AttachStaticIpRequest mocks AttachStaticIpRequest method
+func (m *MockStaticIPClient) AttachStaticIpRequest(input *lightsail.AttachStaticIpInput) lightsail.AttachStaticIpRequest {
+	return m.MockAttachStaticIp(input)
+}
+
+// DetachStaticIpRequest mocks DetachStaticIpRequest method
+func (m *MockStaticIPClient) DetachStaticIpRequest(input *lightsail.DetachStaticIpInput) lightsail.DetachStaticIpRequest {
+	return m.MockDetachStaticIp(input)
+}
+
+// ReleaseStaticIpRequest mocks ReleaseStaticIpRequest method
+func (m *MockStaticIPClient) ReleaseStaticIpRequest(input *lightsail.ReleaseStaticIpInput) lightsail.ReleaseStaticIpRequest {
+	return m.MockReleaseStaticIp(input)
+}
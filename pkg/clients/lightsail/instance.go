@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lightsail
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/lightsail/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// InstanceClient is the external client used for Instance Custom Resource
+type InstanceClient interface {
+	GetInstanceRequest(*lightsail.GetInstanceInput) lightsail.GetInstanceRequest
+	CreateInstancesRequest(*lightsail.CreateInstancesInput) lightsail.CreateInstancesRequest
+	DeleteInstanceRequest(*lightsail.DeleteInstanceInput) lightsail.DeleteInstanceRequest
+}
+
+// NewInstanceClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewInstanceClient(cfg aws.Config) InstanceClient {
+	return lightsail.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the resource doesn't exist.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == lightsail.ErrCodeNotFoundException
+}
+
+// GenerateTags returns the Lightsail tags of the given tag map, sorted by key.
+func GenerateTags(tags map[string]string) []lightsail.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]lightsail.Tag, 0, len(tags))
+	for k, v := range tags {
+		res = append(res, lightsail.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(res, func(i, j int) bool { return aws.StringValue(res[i].Key) < aws.StringValue(res[j].Key) })
+	return res
+}
+
+// GenerateCreateInstancesInput returns the input to create an instance with
+// the given name and parameters.
+func GenerateCreateInstancesInput(name string, p v1alpha1.InstanceParameters) *lightsail.CreateInstancesInput {
+	return &lightsail.CreateInstancesInput{
+		InstanceNames:    []string{name},
+		AvailabilityZone: aws.String(p.AvailabilityZone),
+		BlueprintId:      aws.String(p.BlueprintID),
+		BundleId:         aws.String(p.BundleID),
+		KeyPairName:      p.KeyPairName,
+		UserData:         p.UserData,
+		Tags:             GenerateTags(p.Tags),
+	}
+}
+
+// GenerateInstanceObservation returns the observation of the given instance.
+func GenerateInstanceObservation(o lightsail.Instance) v1alpha1.InstanceObservation {
+	res := v1alpha1.InstanceObservation{
+		ARN:              aws.StringValue(o.Arn),
+		PublicIPAddress:  aws.StringValue(o.PublicIpAddress),
+		PrivateIPAddress: aws.StringValue(o.PrivateIpAddress),
+		Username:         aws.StringValue(o.Username),
+		IsStaticIP:       aws.BoolValue(o.IsStaticIp),
+	}
+	if o.State != nil {
+		res.State = aws.StringValue(o.State.Name)
+	}
+	return res
+}
+
+// LateInitializeInstance fills the empty fields of the given parameters with
+// the values of the observed instance.
+func LateInitializeInstance(p *v1alpha1.InstanceParameters, o lightsail.Instance) {
+	p.KeyPairName = awsclients.LateInitializeStringPtr(p.KeyPairName, awsclients.String(aws.StringValue(o.SshKeyName)))
+}
+
+// GetInstanceConnectionDetails returns the connection details of the given
+// Instance, i.e. its public IP address and the user name to connect with.
+func GetInstanceConnectionDetails(cr v1alpha1.Instance) managed.ConnectionDetails {
+	o := cr.Status.AtProvider
+	if o.PublicIPAddress == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(o.PublicIPAddress),
+		runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(o.Username),
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lightsail
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+
+	"github.com/crossplane/provider-aws/apis/lightsail/v1alpha1"
+)
+
+// StaticIPClient is the external client used for StaticIP Custom Resource
+type StaticIPClient interface {
+	GetStaticIpRequest(*lightsail.GetStaticIpInput) lightsail.GetStaticIpRequest
+	AllocateStaticIpRequest(*lightsail.AllocateStaticIpInput) lightsail.AllocateStaticIpRequest
+	AttachStaticIpRequest(*lightsail.AttachStaticIpInput) lightsail.AttachStaticIpRequest
+	DetachStaticIpRequest(*lightsail.DetachStaticIpInput) lightsail.DetachStaticIpRequest
+	ReleaseStaticIpRequest(*lightsail.ReleaseStaticIpInput) lightsail.ReleaseStaticIpRequest
+}
+
+// NewStaticIPClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewStaticIPClient(cfg aws.Config) StaticIPClient {
+	return lightsail.New(cfg)
+}
+
+// GenerateStaticIPObservation returns the observation of the given static IP.
+func GenerateStaticIPObservation(o lightsail.StaticIp) v1alpha1.StaticIPObservation {
+	return v1alpha1.StaticIPObservation{
+		ARN:        aws.StringValue(o.Arn),
+		IPAddress:  aws.StringValue(o.IpAddress),
+		AttachedTo: attachedTo(o),
+	}
+}
+
+// IsStaticIPUpToDate returns true if the static IP is attached to the desired
+// instance, or detached if there is none.
+func IsStaticIPUpToDate(p v1alpha1.StaticIPParameters, o lightsail.StaticIp) bool {
+	return aws.StringValue(p.InstanceName) == attachedTo(o)
+}
+
+func attachedTo(o lightsail.StaticIp) string {
+	if !aws.BoolValue(o.IsAttached) {
+		return ""
+	}
+	return aws.StringValue(o.AttachedTo)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package macie2
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AccountClient is the external client used for Account Custom Resource
+type AccountClient interface {
+	GetMacieSessionRequest(*macie2.GetMacieSessionInput) macie2.GetMacieSessionRequest
+	EnableMacieRequest(*macie2.EnableMacieInput) macie2.EnableMacieRequest
+	UpdateMacieSessionRequest(*macie2.UpdateMacieSessionInput) macie2.UpdateMacieSessionRequest
+	DisableMacieRequest(*macie2.DisableMacieInput) macie2.DisableMacieRequest
+}
+
+// NewAccountClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewAccountClient(cfg aws.Config) AccountClient {
+	return macie2.New(cfg)
+}
+
+// IsNotFound returns true if the error is because Macie or the
+// classification job doesn't exist. Macie denies access to accounts it is
+// not enabled in.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	switch awsErr.Code() {
+	case macie2.ErrCodeResourceNotFoundException:
+		return true
+	case macie2.ErrCodeAccessDeniedException:
+		return strings.Contains(strings.ToLower(awsErr.Message()), "not enabled")
+	}
+	return false
+}
+
+// GenerateEnableMacieInput returns the input to enable Macie with the given
+// parameters.
+func GenerateEnableMacieInput(p v1alpha1.AccountParameters) *macie2.EnableMacieInput {
+	return &macie2.EnableMacieInput{
+		FindingPublishingFrequency: macie2.FindingPublishingFrequency(aws.StringValue(p.FindingPublishingFrequency)),
+		Status:                     macie2.MacieStatus(aws.StringValue(p.Status)),
+	}
+}
+
+// GenerateUpdateMacieSessionInput returns the input to update Macie with the
+// given parameters.
+func GenerateUpdateMacieSessionInput(p v1alpha1.AccountParameters) *macie2.UpdateMacieSessionInput {
+	return &macie2.UpdateMacieSessionInput{
+		FindingPublishingFrequency: macie2.FindingPublishingFrequency(aws.StringValue(p.FindingPublishingFrequency)),
+		Status:                     macie2.MacieStatus(aws.StringValue(p.Status)),
+	}
+}
+
+// GenerateAccountObservation returns the observation of the given Macie
+// session.
+func GenerateAccountObservation(o macie2.GetMacieSessionOutput) v1alpha1.AccountObservation {
+	res := v1alpha1.AccountObservation{
+		ServiceRole: aws.StringValue(o.ServiceRole),
+	}
+	if o.CreatedAt != nil {
+		t := metav1.NewTime(*o.CreatedAt)
+		res.CreatedAt = &t
+	}
+	if o.UpdatedAt != nil {
+		t := metav1.NewTime(*o.UpdatedAt)
+		res.UpdatedAt = &t
+	}
+	return res
+}
+
+// LateInitializeAccount fills the empty fields of the given parameters with
+// the values of the observed Macie session.
+func LateInitializeAccount(p *v1alpha1.AccountParameters, o macie2.GetMacieSessionOutput) {
+	p.FindingPublishingFrequency = awsclients.LateInitializeStringPtr(p.FindingPublishingFrequency, awsclients.String(string(o.FindingPublishingFrequency)))
+	p.Status = awsclients.LateInitializeStringPtr(p.Status, awsclients.String(string(o.Status)))
+}
+
+// IsAccountUpToDate returns whether the observed Macie session is up to date
+// with the given parameters.
+func IsAccountUpToDate(p v1alpha1.AccountParameters, o macie2.GetMacieSessionOutput) bool {
+	return aws.StringValue(p.FindingPublishingFrequency) == string(o.FindingPublishingFrequency) &&
+		aws.StringValue(p.Status) == string(o.Status)
+}
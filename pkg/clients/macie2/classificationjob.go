@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package macie2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
+)
+
+// Schedule frequencies of classification jobs.
+const (
+	ScheduleFrequencyDaily   = "DAILY"
+	ScheduleFrequencyWeekly  = "WEEKLY"
+	ScheduleFrequencyMonthly = "MONTHLY"
+)
+
+// ClassificationJobClient is the external client used for ClassificationJob
+// Custom Resource
+type ClassificationJobClient interface {
+	DescribeClassificationJobRequest(*macie2.DescribeClassificationJobInput) macie2.DescribeClassificationJobRequest
+	CreateClassificationJobRequest(*macie2.CreateClassificationJobInput) macie2.CreateClassificationJobRequest
+	UpdateClassificationJobRequest(*macie2.UpdateClassificationJobInput) macie2.UpdateClassificationJobRequest
+}
+
+// NewClassificationJobClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewClassificationJobClient(cfg aws.Config) ClassificationJobClient {
+	return macie2.New(cfg)
+}
+
+func generateJobScheduleFrequency(s *v1alpha1.JobSchedule) *macie2.JobScheduleFrequency {
+	if s == nil {
+		return nil
+	}
+	switch s.Frequency {
+	case ScheduleFrequencyDaily:
+		return &macie2.JobScheduleFrequency{DailySchedule: &macie2.DailySchedule{}}
+	case ScheduleFrequencyWeekly:
+		return &macie2.JobScheduleFrequency{WeeklySchedule: &macie2.WeeklySchedule{
+			DayOfWeek: macie2.DayOfWeek(aws.StringValue(s.DayOfWeek)),
+		}}
+	case ScheduleFrequencyMonthly:
+		return &macie2.JobScheduleFrequency{MonthlySchedule: &macie2.MonthlySchedule{
+			DayOfMonth: s.DayOfMonth,
+		}}
+	}
+	return nil
+}
+
+// GenerateCreateClassificationJobInput returns the input to create a
+// classification job with the given name, idempotency token and parameters.
+func GenerateCreateClassificationJobInput(name, token string, p v1alpha1.ClassificationJobParameters) *macie2.CreateClassificationJobInput {
+	in := &macie2.CreateClassificationJobInput{
+		ClientToken:             aws.String(token),
+		Name:                    aws.String(name),
+		Description:             p.Description,
+		JobType:                 macie2.JobType(p.JobType),
+		ScheduleFrequency:       generateJobScheduleFrequency(p.Schedule),
+		InitialRun:              p.InitialRun,
+		SamplingPercentage:      p.SamplingPercentage,
+		CustomDataIdentifierIds: p.CustomDataIdentifierIDs,
+		S3JobDefinition:         &macie2.S3JobDefinition{},
+	}
+	for _, d := range p.BucketDefinitions {
+		in.S3JobDefinition.BucketDefinitions = append(in.S3JobDefinition.BucketDefinitions, macie2.S3BucketDefinitionForJob{
+			AccountId: aws.String(d.AccountID),
+			Buckets:   d.Buckets,
+		})
+	}
+	if len(p.Tags) != 0 {
+		in.Tags = p.Tags
+	}
+	return in
+}
+
+// GenerateClassificationJobObservation returns the observation of the given
+// classification job.
+func GenerateClassificationJobObservation(o macie2.DescribeClassificationJobOutput) v1alpha1.ClassificationJobObservation {
+	res := v1alpha1.ClassificationJobObservation{
+		JobARN:    aws.StringValue(o.JobArn),
+		JobStatus: string(o.JobStatus),
+	}
+	if o.CreatedAt != nil {
+		t := metav1.NewTime(*o.CreatedAt)
+		res.CreatedAt = &t
+	}
+	if o.LastRunTime != nil {
+		t := metav1.NewTime(*o.LastRunTime)
+		res.LastRunTime = &t
+	}
+	return res
+}
+
+// IsClassificationJobActive returns whether a job of the given status can
+// still be paused, resumed or cancelled. Completed and cancelled jobs can't
+// be changed anymore.
+func IsClassificationJobActive(s macie2.JobStatus) bool {
+	switch s {
+	case macie2.JobStatusRunning, macie2.JobStatusPaused, macie2.JobStatusIdle:
+		return true
+	}
+	return false
+}
+
+// DesiredClassificationJobStatus returns the status that a job with the
+// given parameters should be updated to.
+func DesiredClassificationJobStatus(p v1alpha1.ClassificationJobParameters) macie2.JobStatus {
+	if aws.BoolValue(p.Paused) {
+		return macie2.JobStatusPaused
+	}
+	return macie2.JobStatusRunning
+}
+
+// IsClassificationJobUpToDate returns whether the observed classification
+// job is up to date with the given parameters. All parameters but whether
+// the job is paused are immutable.
+func IsClassificationJobUpToDate(p v1alpha1.ClassificationJobParameters, o macie2.DescribeClassificationJobOutput) bool {
+	if !IsClassificationJobActive(o.JobStatus) {
+		return true
+	}
+	return aws.BoolValue(p.Paused) == (o.JobStatus == macie2.JobStatusPaused)
+}
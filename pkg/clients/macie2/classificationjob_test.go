@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package macie2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
+)
+
+var (
+	jobName   = "weekly-data-lake-scan"
+	token     = "f2c3b9f4-b1e0-4c5d-9a34-0c2f4b8e4f4b"
+	accountID = "123456789012"
+	bucket    = "data-lake"
+)
+
+func TestGenerateCreateClassificationJobInput(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.ClassificationJobParameters
+		want *macie2.CreateClassificationJobInput
+	}{
+		"OneTime": {
+			in: v1alpha1.ClassificationJobParameters{
+				JobType:           string(macie2.JobTypeOneTime),
+				BucketDefinitions: []v1alpha1.S3BucketDefinition{{AccountID: accountID, Buckets: []string{bucket}}},
+			},
+			want: &macie2.CreateClassificationJobInput{
+				ClientToken: aws.String(token),
+				Name:        aws.String(jobName),
+				JobType:     macie2.JobTypeOneTime,
+				S3JobDefinition: &macie2.S3JobDefinition{
+					BucketDefinitions: []macie2.S3BucketDefinitionForJob{{AccountId: aws.String(accountID), Buckets: []string{bucket}}},
+				},
+			},
+		},
+		"Weekly": {
+			in: v1alpha1.ClassificationJobParameters{
+				JobType: string(macie2.JobTypeScheduled),
+				Schedule: &v1alpha1.JobSchedule{
+					Frequency: ScheduleFrequencyWeekly,
+					DayOfWeek: aws.String(string(macie2.DayOfWeekMonday)),
+				},
+				InitialRun:         aws.Bool(true),
+				SamplingPercentage: aws.Int64(50),
+				BucketDefinitions:  []v1alpha1.S3BucketDefinition{{AccountID: accountID, Buckets: []string{bucket}}},
+				Tags:               map[string]string{"team": "governance"},
+			},
+			want: &macie2.CreateClassificationJobInput{
+				ClientToken: aws.String(token),
+				Name:        aws.String(jobName),
+				JobType:     macie2.JobTypeScheduled,
+				ScheduleFrequency: &macie2.JobScheduleFrequency{
+					WeeklySchedule: &macie2.WeeklySchedule{DayOfWeek: macie2.DayOfWeekMonday},
+				},
+				InitialRun:         aws.Bool(true),
+				SamplingPercentage: aws.Int64(50),
+				S3JobDefinition: &macie2.S3JobDefinition{
+					BucketDefinitions: []macie2.S3BucketDefinitionForJob{{AccountId: aws.String(accountID), Buckets: []string{bucket}}},
+				},
+				Tags: map[string]string{"team": "governance"},
+			},
+		},
+		"Monthly": {
+			in: v1alpha1.ClassificationJobParameters{
+				JobType: string(macie2.JobTypeScheduled),
+				Schedule: &v1alpha1.JobSchedule{
+					Frequency:  ScheduleFrequencyMonthly,
+					DayOfMonth: aws.Int64(1),
+				},
+			},
+			want: &macie2.CreateClassificationJobInput{
+				ClientToken: aws.String(token),
+				Name:        aws.String(jobName),
+				JobType:     macie2.JobTypeScheduled,
+				ScheduleFrequency: &macie2.JobScheduleFrequency{
+					MonthlySchedule: &macie2.MonthlySchedule{DayOfMonth: aws.Int64(1)},
+				},
+				S3JobDefinition: &macie2.S3JobDefinition{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateClassificationJobInput(jobName, token, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsClassificationJobUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		paused *bool
+		status macie2.JobStatus
+		want   bool
+	}{
+		"Running": {
+			status: macie2.JobStatusRunning,
+			want:   true,
+		},
+		"Idle": {
+			paused: aws.Bool(false),
+			status: macie2.JobStatusIdle,
+			want:   true,
+		},
+		"Paused": {
+			paused: aws.Bool(true),
+			status: macie2.JobStatusPaused,
+			want:   true,
+		},
+		"NeedsPause": {
+			paused: aws.Bool(true),
+			status: macie2.JobStatusIdle,
+			want:   false,
+		},
+		"NeedsResume": {
+			status: macie2.JobStatusPaused,
+			want:   false,
+		},
+		"Complete": {
+			paused: aws.Bool(true),
+			status: macie2.JobStatusComplete,
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsClassificationJobUpToDate(v1alpha1.ClassificationJobParameters{Paused: tc.paused}, macie2.DescribeClassificationJobOutput{JobStatus: tc.status})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/macie2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ClassificationJobClient = (*MockClassificationJobClient)(nil)
+
+// MockClassificationJobClient is a type that implements all the methods for ClassificationJobClient interface
+type MockClassificationJobClient struct {
+	MockDescribeClassificationJob func(*macie2.DescribeClassificationJobInput) macie2.DescribeClassificationJobRequest
+	MockCreateClassificationJob   func(*macie2.CreateClassificationJobInput) macie2.CreateClassificationJobRequest
+	MockUpdateClassificationJob   func(*macie2.UpdateClassificationJobInput) macie2.UpdateClassificationJobRequest
+}
+
+// DescribeClassificationJobRequest mocks DescribeClassificationJobRequest method
+func (m *MockClassificationJobClient) DescribeClassificationJobRequest(input *macie2.DescribeClassificationJobInput) macie2.DescribeClassificationJobRequest {
+	return m.MockDescribeClassificationJob(input)
+}
+
+// CreateClassificationJobRequest mocks CreateClassificationJobRequest method
+func (m *MockClassificationJobClient) CreateClassificationJobRequest(input *macie2.CreateClassificationJobInput) macie2.CreateClassificationJobRequest {
+	return m.MockCreateClassificationJob(input)
+}
+
+// UpdateClassificationJobRequest mocks UpdateClassificationJobRequest method
+func (m *MockClassificationJobClient) UpdateClassificationJobRequest(input *macie2.UpdateClassificationJobInput) macie2.UpdateClassificationJobRequest {
+	return m.MockUpdateClassificationJob(input)
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// AnnotationKeyForceUpdate is the annotation that, when set to "true", lets
+// updates of a managed resource through outside of its maintenance window.
+const AnnotationKeyForceUpdate = "aws.crossplane.io/force-update"
+
+// TypeUpdateHeld is the type of the condition that indicates whether an
+// update of a managed resource is held back until its maintenance window.
+const TypeUpdateHeld runtimev1alpha1.ConditionType = "UpdateHeld"
+
+const (
+	errGetMaintenanceWindow    = "cannot get referenced MaintenanceWindow"
+	errUpdateMaintenanceWindow = "cannot update managed resource with the referenced MaintenanceWindow"
+
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+)
+
+var weekdays = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+// UpdateHeld returns a condition that indicates an update of a managed
+// resource is held back until the given maintenance window opens.
+func UpdateHeld(window string) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeUpdateHeld,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonOutsideMaintenanceWindow,
+		Message:            fmt.Sprintf("update is held until maintenance window %s opens", window),
+	}
+}
+
+// UpdateNotHeld returns a condition that indicates no update of a managed
+// resource is held back.
+func UpdateNotHeld() runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeUpdateHeld,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpdateNotHeld,
+	}
+}
+
+// startMinute returns the minute of the week, counted from Monday 00:00 UTC,
+// the given maintenance window starts at.
+func startMinute(w v1beta1.MaintenanceWindowSpec) int {
+	day := 0
+	for i, d := range weekdays {
+		if strings.EqualFold(d, w.DayOfWeek) {
+			day = i
+		}
+	}
+	start, err := time.Parse("15:04", w.StartTime)
+	if err != nil {
+		return day * minutesPerDay
+	}
+	return day*minutesPerDay + start.Hour()*60 + start.Minute()
+}
+
+func formatMinute(m int) string {
+	m %= minutesPerWeek
+	return fmt.Sprintf("%s:%02d:%02d", strings.ToLower(weekdays[m/minutesPerDay]), m%minutesPerDay/60, m%60)
+}
+
+// FormatMaintenanceWindow returns the given maintenance window in the
+// ddd:hh24:mi-ddd:hh24:mi format of the preferred maintenance windows of RDS
+// and ElastiCache.
+func FormatMaintenanceWindow(w v1beta1.MaintenanceWindowSpec) string {
+	s := startMinute(w)
+	return formatMinute(s) + "-" + formatMinute(s+int(w.DurationMinutes))
+}
+
+// IsInMaintenanceWindow returns whether the given time is in the given
+// maintenance window.
+func IsInMaintenanceWindow(w v1beta1.MaintenanceWindowSpec, t time.Time) bool {
+	t = t.UTC()
+	// time.Weekday counts from Sunday.
+	now := (int(t.Weekday())+6)%7*minutesPerDay + t.Hour()*60 + t.Minute()
+	return (now-startMinute(w)+minutesPerWeek)%minutesPerWeek < int(w.DurationMinutes)
+}
+
+// maintenanceWindowOf returns the name of the MaintenanceWindow referenced
+// by spec.forProvider.maintenanceWindowRef of the given managed resource, if
+// any.
+func maintenanceWindowOf(mg resource.Managed) string {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return ""
+	}
+	name, _, _ := unstructured.NestedString(obj, "spec", "forProvider", "maintenanceWindowRef", "name")
+	return name
+}
+
+// MaintenanceWindowInitializer sets spec.forProvider.preferredMaintenanceWindow
+// of a managed resource to the MaintenanceWindow it references.
+type MaintenanceWindowInitializer struct {
+	kube client.Client
+}
+
+// NewMaintenanceWindowInitializer returns a new MaintenanceWindowInitializer.
+func NewMaintenanceWindowInitializer(c client.Client) *MaintenanceWindowInitializer {
+	return &MaintenanceWindowInitializer{kube: c}
+}
+
+// Initialize the given managed resource.
+func (i *MaintenanceWindowInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
+	name := maintenanceWindowOf(mg)
+	if name == "" {
+		return nil
+	}
+	mw := &v1beta1.MaintenanceWindow{}
+	if err := i.kube.Get(ctx, types.NamespacedName{Name: name}, mw); err != nil {
+		return errors.Wrap(err, errGetMaintenanceWindow)
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return errors.Wrap(err, errConvertManaged)
+	}
+	window := FormatMaintenanceWindow(mw.Spec)
+	if current, _, _ := unstructured.NestedString(obj, "spec", "forProvider", "preferredMaintenanceWindow"); current == window {
+		return nil
+	}
+	if err := unstructured.SetNestedField(obj, window, "spec", "forProvider", "preferredMaintenanceWindow"); err != nil {
+		return errors.Wrap(err, errConvertManaged)
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, mg); err != nil {
+		return errors.Wrap(err, errConvertManaged)
+	}
+	return errors.Wrap(i.kube.Update(ctx, mg), errUpdateMaintenanceWindow)
+}
+
+type disruptiveUpdateKey struct{}
+
+// MarkUpdateDisruptive records that the pending update of the managed resource
+// being observed disrupts its external resource, e.g. by restarting or
+// replacing it. Controllers wrapped with WithMaintenanceWindow call it from
+// Observe when a field they consider disruptive is out of date. It does
+// nothing if the context was not passed by WithMaintenanceWindow.
+func MarkUpdateDisruptive(ctx context.Context) {
+	if d, ok := ctx.Value(disruptiveUpdateKey{}).(*bool); ok {
+		*d = true
+	}
+}
+
+// WithMaintenanceWindow wraps the given ExternalConnecter so that disruptive
+// updates of managed resources that reference a MaintenanceWindow are held
+// back while the window is closed. An update is disruptive if Observe calls
+// MarkUpdateDisruptive; other updates are let through at any time. Resources
+// with a held update are reported as up to date with an UpdateHeld condition
+// instead, unless they are annotated with aws.crossplane.io/force-update set
+// to "true".
+func WithMaintenanceWindow(c managed.ExternalConnecter, kube client.Client) managed.ExternalConnecter {
+	return &maintenanceWindowConnecter{ExternalConnecter: c, kube: kube, now: time.Now}
+}
+
+type maintenanceWindowConnecter struct {
+	managed.ExternalConnecter
+	kube client.Client
+	now  func() time.Time
+}
+
+func (c *maintenanceWindowConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &maintenanceWindowExternal{ExternalClient: e, kube: c.kube, now: c.now}, nil
+}
+
+type maintenanceWindowExternal struct {
+	managed.ExternalClient
+	kube client.Client
+	now  func() time.Time
+}
+
+func (e *maintenanceWindowExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	disruptive := false
+	o, err := e.ExternalClient.Observe(context.WithValue(ctx, disruptiveUpdateKey{}, &disruptive), mg)
+	name := maintenanceWindowOf(mg)
+	if err != nil || name == "" || meta.WasDeleted(mg) {
+		return o, err
+	}
+	if !o.ResourceExists || o.ResourceUpToDate || !disruptive || mg.GetAnnotations()[AnnotationKeyForceUpdate] == "true" {
+		mg.SetConditions(UpdateNotHeld())
+		return o, nil
+	}
+	mw := &v1beta1.MaintenanceWindow{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: name}, mw); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMaintenanceWindow)
+	}
+	if IsInMaintenanceWindow(mw.Spec, e.now()) {
+		mg.SetConditions(UpdateNotHeld())
+		return o, nil
+	}
+	mg.SetConditions(UpdateHeld(FormatMaintenanceWindow(mw.Spec)))
+	o.ResourceUpToDate = true
+	return o, nil
+}
@@ -0,0 +1,307 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+var (
+	windowName = "weekend"
+	// Sun 23:00 to Mon 00:30 UTC.
+	window = awsv1beta1.MaintenanceWindowSpec{DayOfWeek: "Sun", StartTime: "23:00", DurationMinutes: 90}
+)
+
+func rdsInstance(p v1beta1.RDSInstanceParameters, annotations map[string]string) *v1beta1.RDSInstance {
+	cr := &v1beta1.RDSInstance{Spec: v1beta1.RDSInstanceSpec{ForProvider: p}}
+	cr.SetAnnotations(annotations)
+	return cr
+}
+
+func mockGetWindow(err error) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+		if mw, ok := obj.(*awsv1beta1.MaintenanceWindow); ok {
+			mw.Spec = window
+		}
+		return err
+	}
+}
+
+func TestFormatMaintenanceWindow(t *testing.T) {
+	cases := map[string]struct {
+		w    awsv1beta1.MaintenanceWindowSpec
+		want string
+	}{
+		"SameDay": {
+			w:    awsv1beta1.MaintenanceWindowSpec{DayOfWeek: "Tue", StartTime: "03:15", DurationMinutes: 60},
+			want: "tue:03:15-tue:04:15",
+		},
+		"WrapsWeek": {
+			w:    window,
+			want: "sun:23:00-mon:00:30",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, FormatMaintenanceWindow(tc.w)); diff != "" {
+				t.Errorf("FormatMaintenanceWindow(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsInMaintenanceWindow(t *testing.T) {
+	cases := map[string]struct {
+		t    time.Time
+		want bool
+	}{
+		"Start": {
+			t:    time.Date(2020, time.September, 6, 23, 0, 0, 0, time.UTC),
+			want: true,
+		},
+		"AfterWeekWrap": {
+			t:    time.Date(2020, time.September, 7, 0, 29, 0, 0, time.UTC),
+			want: true,
+		},
+		"End": {
+			t:    time.Date(2020, time.September, 7, 0, 30, 0, 0, time.UTC),
+			want: false,
+		},
+		"Before": {
+			t:    time.Date(2020, time.September, 6, 22, 59, 0, 0, time.UTC),
+			want: false,
+		},
+		"OtherTimeZone": {
+			t:    time.Date(2020, time.September, 7, 1, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsInMaintenanceWindow(window, tc.t)); diff != "" {
+				t.Errorf("IsInMaintenanceWindow(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowInitializer(t *testing.T) {
+	type want struct {
+		window  *string
+		updated bool
+		err     error
+	}
+
+	errBoom := errors.New("boom")
+	cases := map[string]struct {
+		cr   *v1beta1.RDSInstance
+		get  error
+		want want
+	}{
+		"NoReference": {
+			cr: rdsInstance(v1beta1.RDSInstanceParameters{PreferredMaintenanceWindow: String("mon:01:00-mon:02:00")}, nil),
+			want: want{
+				window: String("mon:01:00-mon:02:00"),
+			},
+		},
+		"Set": {
+			cr: rdsInstance(v1beta1.RDSInstanceParameters{
+				PreferredMaintenanceWindow: String("mon:01:00-mon:02:00"),
+				MaintenanceWindowRef:       &runtimev1alpha1.Reference{Name: windowName},
+			}, nil),
+			want: want{
+				window:  String("sun:23:00-mon:00:30"),
+				updated: true,
+			},
+		},
+		"AlreadySet": {
+			cr: rdsInstance(v1beta1.RDSInstanceParameters{
+				PreferredMaintenanceWindow: String("sun:23:00-mon:00:30"),
+				MaintenanceWindowRef:       &runtimev1alpha1.Reference{Name: windowName},
+			}, nil),
+			want: want{
+				window: String("sun:23:00-mon:00:30"),
+			},
+		},
+		"GetFailed": {
+			cr: rdsInstance(v1beta1.RDSInstanceParameters{
+				MaintenanceWindowRef: &runtimev1alpha1.Reference{Name: windowName},
+			}, nil),
+			get: errBoom,
+			want: want{
+				err: errors.Wrap(errBoom, errGetMaintenanceWindow),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			updated := false
+			kube := &test.MockClient{
+				MockGet: mockGetWindow(tc.get),
+				MockUpdate: func(_ context.Context, _ runtime.Object, _ ...client.UpdateOption) error {
+					updated = true
+					return nil
+				},
+			}
+			err := NewMaintenanceWindowInitializer(kube).Initialize(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Initialize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("updated: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.window, tc.cr.Spec.ForProvider.PreferredMaintenanceWindow); tc.want.err == nil && diff != "" {
+				t.Errorf("window: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithMaintenanceWindow(t *testing.T) {
+	type want struct {
+		obs       managed.ExternalObservation
+		condition *corev1.ConditionStatus
+	}
+
+	ref := &runtimev1alpha1.Reference{Name: windowName}
+	outside := time.Date(2020, time.September, 9, 12, 0, 0, 0, time.UTC)
+	inside := time.Date(2020, time.September, 6, 23, 30, 0, 0, time.UTC)
+	held := corev1.ConditionTrue
+	notHeld := corev1.ConditionFalse
+
+	cases := map[string]struct {
+		cr         *v1beta1.RDSInstance
+		obs        managed.ExternalObservation
+		disruptive bool
+		now        time.Time
+		want       want
+	}{
+		"NoReference": {
+			cr:  rdsInstance(v1beta1.RDSInstanceParameters{}, nil),
+			obs: managed.ExternalObservation{ResourceExists: true},
+			now: outside,
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"UpToDate": {
+			cr:  rdsInstance(v1beta1.RDSInstanceParameters{MaintenanceWindowRef: ref}, nil),
+			obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			now: outside,
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: &notHeld,
+			},
+		},
+		"NotDisruptive": {
+			cr:  rdsInstance(v1beta1.RDSInstanceParameters{MaintenanceWindowRef: ref}, nil),
+			obs: managed.ExternalObservation{ResourceExists: true},
+			now: outside,
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true},
+				condition: &notHeld,
+			},
+		},
+		"OutsideWindow": {
+			cr:         rdsInstance(v1beta1.RDSInstanceParameters{MaintenanceWindowRef: ref}, nil),
+			obs:        managed.ExternalObservation{ResourceExists: true},
+			disruptive: true,
+			now:        outside,
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: &held,
+			},
+		},
+		"InsideWindow": {
+			cr:         rdsInstance(v1beta1.RDSInstanceParameters{MaintenanceWindowRef: ref}, nil),
+			obs:        managed.ExternalObservation{ResourceExists: true},
+			disruptive: true,
+			now:        inside,
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true},
+				condition: &notHeld,
+			},
+		},
+		"Forced": {
+			cr:         rdsInstance(v1beta1.RDSInstanceParameters{MaintenanceWindowRef: ref}, map[string]string{AnnotationKeyForceUpdate: "true"}),
+			obs:        managed.ExternalObservation{ResourceExists: true},
+			disruptive: true,
+			now:        outside,
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true},
+				condition: &notHeld,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := WithMaintenanceWindow(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &managed.ExternalClientFns{
+					ObserveFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						if tc.disruptive {
+							MarkUpdateDisruptive(ctx)
+						}
+						return tc.obs, nil
+					},
+				}, nil
+			}), &test.MockClient{MockGet: mockGetWindow(nil)})
+			c.(*maintenanceWindowConnecter).now = func() time.Time { return tc.now }
+
+			e, err := c.Connect(context.Background(), tc.cr)
+			if err != nil {
+				t.Fatalf("Connect(...): %s", err)
+			}
+			obs, err := e.Observe(context.Background(), tc.cr)
+			if err != nil {
+				t.Errorf("Observe(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			var got *corev1.ConditionStatus
+			for _, cond := range tc.cr.Status.Conditions {
+				if cond.Type == TypeUpdateHeld {
+					s := cond.Status
+					got = &s
+				}
+			}
+			if diff := cmp.Diff(tc.want.condition, got); diff != "" {
+				t.Errorf("condition: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,450 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mq
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/mq/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetPasswordSecret = "cannot get the password secret of a user"
+
+	// ConnectionDetailsEndpoints is the key of the comma separated endpoints
+	// of all broker instances in the connection secret of a Broker.
+	ConnectionDetailsEndpoints = "endpoints"
+
+	// ConnectionDetailsConsoleURL is the key of the web console URL of the
+	// first broker instance in the connection secret of a Broker.
+	ConnectionDetailsConsoleURL = "consoleURL"
+
+	// ConnectionDetailsUserPasswordPrefix is the prefix of the keys of the
+	// user passwords in the connection secret of a Broker. The key of the
+	// password of a user is the prefix followed by its username.
+	ConnectionDetailsUserPasswordPrefix = "password."
+)
+
+// BrokerClient is the external client used for Broker Custom Resource
+type BrokerClient interface {
+	DescribeBrokerRequest(*mq.DescribeBrokerInput) mq.DescribeBrokerRequest
+	CreateBrokerRequest(*mq.CreateBrokerInput) mq.CreateBrokerRequest
+	UpdateBrokerRequest(*mq.UpdateBrokerInput) mq.UpdateBrokerRequest
+	DeleteBrokerRequest(*mq.DeleteBrokerInput) mq.DeleteBrokerRequest
+	CreateTagsRequest(*mq.CreateTagsInput) mq.CreateTagsRequest
+	DeleteTagsRequest(*mq.DeleteTagsInput) mq.DeleteTagsRequest
+	ListUsersRequest(*mq.ListUsersInput) mq.ListUsersRequest
+	DescribeUserRequest(*mq.DescribeUserInput) mq.DescribeUserRequest
+	CreateUserRequest(*mq.CreateUserInput) mq.CreateUserRequest
+	UpdateUserRequest(*mq.UpdateUserInput) mq.UpdateUserRequest
+	DeleteUserRequest(*mq.DeleteUserInput) mq.DeleteUserRequest
+}
+
+// NewBrokerClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewBrokerClient(cfg aws.Config) BrokerClient {
+	return mq.New(cfg)
+}
+
+// IsBrokerNotFound returns true if the error is because the broker doesn't
+// exist.
+func IsBrokerNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == mq.ErrCodeNotFoundException {
+		return true
+	}
+	return false
+}
+
+// GenerateTags returns the Amazon MQ tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make(map[string]string, len(tags))
+	for _, t := range tags {
+		res[t.Key] = t.Value
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from a broker.
+func DiffTags(desired []v1alpha1.Tag, observed map[string]string) (add map[string]string, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	return awsclients.DiffTags(local, observed)
+}
+
+// IsRabbitMQ returns true if the broker runs the RabbitMQ engine. The users
+// of a RabbitMQ broker can't be managed through the Amazon MQ API once the
+// broker is created.
+func IsRabbitMQ(p v1alpha1.BrokerParameters) bool {
+	return p.EngineType == v1alpha1.EngineTypeRabbitMQ
+}
+
+// GenerateCreateBrokerInput returns the create input of a broker with the
+// given name, user passwords and parameters.
+func GenerateCreateBrokerInput(name string, passwords map[string]string, p v1alpha1.BrokerParameters) *mq.CreateBrokerInput {
+	in := &mq.CreateBrokerInput{
+		BrokerName:              aws.String(name),
+		EngineType:              mq.EngineType(p.EngineType),
+		EngineVersion:           aws.String(p.EngineVersion),
+		HostInstanceType:        aws.String(p.HostInstanceType),
+		DeploymentMode:          mq.DeploymentMode(p.DeploymentMode),
+		AutoMinorVersionUpgrade: aws.Bool(aws.BoolValue(p.AutoMinorVersionUpgrade)),
+		PubliclyAccessible:      aws.Bool(aws.BoolValue(p.PubliclyAccessible)),
+		SubnetIds:               p.SubnetIDs,
+		SecurityGroups:          p.SecurityGroupIDs,
+		Configuration:           generateConfigurationID(p.Configuration),
+		Logs:                    generateLogs(p.Logs),
+		Tags:                    GenerateTags(p.Tags),
+	}
+	if p.StorageType != nil {
+		in.StorageType = mq.BrokerStorageType(*p.StorageType)
+	}
+	if w := p.MaintenanceWindowStartTime; w != nil {
+		in.MaintenanceWindowStartTime = &mq.WeeklyStartTime{
+			DayOfWeek: mq.DayOfWeek(w.DayOfWeek),
+			TimeOfDay: aws.String(w.TimeOfDay),
+			TimeZone:  w.TimeZone,
+		}
+	}
+	if e := p.EncryptionOptions; e != nil {
+		in.EncryptionOptions = &mq.EncryptionOptions{
+			KmsKeyId:       e.KMSKeyID,
+			UseAwsOwnedKey: aws.Bool(e.UseAWSOwnedKey),
+		}
+	}
+	users := p.Users
+	if IsRabbitMQ(p) && len(users) > 1 {
+		users = users[:1]
+	}
+	for _, u := range users {
+		in.Users = append(in.Users, mq.User{
+			Username:      aws.String(u.Username),
+			Password:      aws.String(passwords[u.Username]),
+			ConsoleAccess: u.ConsoleAccess,
+			Groups:        u.Groups,
+		})
+	}
+	return in
+}
+
+// GenerateUpdateBrokerInput returns the update input of the broker with the
+// given ID. Only the settings that differ from the observed ones, or from
+// the pending ones if a change is pending, are set.
+func GenerateUpdateBrokerInput(id string, p v1alpha1.BrokerParameters, o mq.DescribeBrokerOutput) *mq.UpdateBrokerInput { // nolint:gocyclo
+	in := &mq.UpdateBrokerInput{BrokerId: aws.String(id)}
+	if p.AutoMinorVersionUpgrade != nil && aws.BoolValue(p.AutoMinorVersionUpgrade) != aws.BoolValue(o.AutoMinorVersionUpgrade) {
+		in.AutoMinorVersionUpgrade = p.AutoMinorVersionUpgrade
+	}
+	if p.EngineVersion != pending(o.PendingEngineVersion, o.EngineVersion) {
+		in.EngineVersion = aws.String(p.EngineVersion)
+	}
+	if p.HostInstanceType != pending(o.PendingHostInstanceType, o.HostInstanceType) {
+		in.HostInstanceType = aws.String(p.HostInstanceType)
+	}
+	sgs := o.SecurityGroups
+	if len(o.PendingSecurityGroups) > 0 {
+		sgs = o.PendingSecurityGroups
+	}
+	if len(p.SecurityGroupIDs) > 0 && !cmp.Equal(p.SecurityGroupIDs, sgs, cmpopts.EquateEmpty(), sortStrings()) {
+		in.SecurityGroups = p.SecurityGroupIDs
+	}
+	if p.Logs != nil && !isLogsUpToDate(*p.Logs, o.Logs) {
+		in.Logs = generateLogs(p.Logs)
+	}
+	if p.Configuration != nil && !isConfigurationUpToDate(*p.Configuration, o.Configurations) {
+		in.Configuration = generateConfigurationID(p.Configuration)
+	}
+	return in
+}
+
+// IsBrokerUpToDate checks whether there is a change in any of the modifiable
+// fields.
+func IsBrokerUpToDate(p v1alpha1.BrokerParameters, o mq.DescribeBrokerOutput) bool {
+	in := GenerateUpdateBrokerInput(aws.StringValue(o.BrokerId), p, o)
+	return cmp.Equal(&mq.UpdateBrokerInput{BrokerId: in.BrokerId}, in, cmpopts.IgnoreUnexported(mq.UpdateBrokerInput{}))
+}
+
+// GenerateBrokerObservation is used to produce v1alpha1.BrokerObservation
+// from mq.DescribeBrokerOutput.
+func GenerateBrokerObservation(o mq.DescribeBrokerOutput) v1alpha1.BrokerObservation {
+	obs := v1alpha1.BrokerObservation{
+		BrokerARN:               aws.StringValue(o.BrokerArn),
+		BrokerID:                aws.StringValue(o.BrokerId),
+		BrokerState:             string(o.BrokerState),
+		PendingEngineVersion:    aws.StringValue(o.PendingEngineVersion),
+		PendingHostInstanceType: aws.StringValue(o.PendingHostInstanceType),
+	}
+	for _, i := range o.BrokerInstances {
+		obs.BrokerInstances = append(obs.BrokerInstances, v1alpha1.BrokerInstance{
+			ConsoleURL: aws.StringValue(i.ConsoleURL),
+			Endpoints:  i.Endpoints,
+			IPAddress:  aws.StringValue(i.IpAddress),
+		})
+	}
+	return obs
+}
+
+// LateInitializeBroker fills the empty fields in *v1alpha1.BrokerParameters
+// with the values seen in mq.DescribeBrokerOutput.
+func LateInitializeBroker(in *v1alpha1.BrokerParameters, o *mq.DescribeBrokerOutput) { // nolint:gocyclo
+	if o == nil {
+		return
+	}
+	in.AutoMinorVersionUpgrade = awsclients.LateInitializeBoolPtr(in.AutoMinorVersionUpgrade, o.AutoMinorVersionUpgrade)
+	in.PubliclyAccessible = awsclients.LateInitializeBoolPtr(in.PubliclyAccessible, o.PubliclyAccessible)
+	if in.StorageType == nil && o.StorageType != "" {
+		in.StorageType = aws.String(string(o.StorageType))
+	}
+	if len(in.SubnetIDs) == 0 {
+		in.SubnetIDs = o.SubnetIds
+	}
+	if len(in.SecurityGroupIDs) == 0 {
+		in.SecurityGroupIDs = o.SecurityGroups
+	}
+	if in.MaintenanceWindowStartTime == nil && o.MaintenanceWindowStartTime != nil {
+		in.MaintenanceWindowStartTime = &v1alpha1.WeeklyStartTime{
+			DayOfWeek: string(o.MaintenanceWindowStartTime.DayOfWeek),
+			TimeOfDay: aws.StringValue(o.MaintenanceWindowStartTime.TimeOfDay),
+			TimeZone:  o.MaintenanceWindowStartTime.TimeZone,
+		}
+	}
+	if in.EncryptionOptions == nil && o.EncryptionOptions != nil {
+		in.EncryptionOptions = &v1alpha1.EncryptionOptions{
+			KMSKeyID:       o.EncryptionOptions.KmsKeyId,
+			UseAWSOwnedKey: aws.BoolValue(o.EncryptionOptions.UseAwsOwnedKey),
+		}
+	}
+	if in.Logs == nil && o.Logs != nil {
+		in.Logs = &v1alpha1.Logs{Audit: o.Logs.Audit, General: o.Logs.General}
+	}
+	if in.Configuration == nil && o.Configurations != nil && o.Configurations.Current != nil {
+		in.Configuration = &v1alpha1.ConfigurationID{
+			ID:       aws.StringValue(o.Configurations.Current.Id),
+			Revision: o.Configurations.Current.Revision,
+		}
+	}
+}
+
+// GetUserPasswords returns the passwords of the users of the given Broker by
+// username, and the usernames whose password differs from the one in its
+// connection secret.
+func GetUserPasswords(ctx context.Context, kube client.Client, cr *v1alpha1.Broker) (pwds map[string]string, changed map[string]bool, err error) {
+	pwds = make(map[string]string, len(cr.Spec.ForProvider.Users))
+	for _, u := range cr.Spec.ForProvider.Users {
+		ref := u.PasswordSecretRef
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+			return nil, nil, errors.Wrap(err, errGetPasswordSecret)
+		}
+		pwds[u.Username] = string(s.Data[ref.Key])
+	}
+
+	changed = map[string]bool{}
+	if cr.Spec.WriteConnectionSecretToReference == nil {
+		return pwds, changed, nil
+	}
+	conn := &corev1.Secret{}
+	nn := types.NamespacedName{
+		Name:      cr.Spec.WriteConnectionSecretToReference.Name,
+		Namespace: cr.Spec.WriteConnectionSecretToReference.Namespace,
+	}
+	// The connection secret doesn't exist until the broker is created.
+	if err := kube.Get(ctx, nn, conn); resource.IgnoreNotFound(err) != nil {
+		return nil, nil, err
+	}
+	for u, pwd := range pwds {
+		if pwd != string(conn.Data[ConnectionDetailsUserPasswordPrefix+u]) {
+			changed[u] = true
+		}
+	}
+	return pwds, changed, nil
+}
+
+// DiffUsers returns the desired users that need to be created and updated,
+// and the usernames of the users that need to be deleted. Pending changes of
+// the observed users count as applied, since Amazon MQ applies them during
+// the next maintenance window or reboot of the broker.
+func DiffUsers(desired []v1alpha1.User, observed []mq.DescribeUserOutput, changedPasswords map[string]bool) (create, update []v1alpha1.User, remove []string) {
+	current := make(map[string]mq.DescribeUserOutput, len(observed))
+	for _, o := range observed {
+		if o.Pending != nil && o.Pending.PendingChange == mq.ChangeTypeDelete {
+			continue
+		}
+		if o.Pending != nil {
+			o.ConsoleAccess, o.Groups = o.Pending.ConsoleAccess, o.Pending.Groups
+		}
+		current[aws.StringValue(o.Username)] = o
+	}
+	for _, d := range desired {
+		o, ok := current[d.Username]
+		switch {
+		case !ok:
+			create = append(create, d)
+		case changedPasswords[d.Username],
+			aws.BoolValue(d.ConsoleAccess) != aws.BoolValue(o.ConsoleAccess),
+			!cmp.Equal(d.Groups, o.Groups, cmpopts.EquateEmpty(), sortStrings()):
+			update = append(update, d)
+		}
+		delete(current, d.Username)
+	}
+	for u := range current {
+		remove = append(remove, u)
+	}
+	return create, update, remove
+}
+
+// GenerateCreateUserInput returns the input to create the given user of the
+// broker with the given ID.
+func GenerateCreateUserInput(id string, u v1alpha1.User, password string) *mq.CreateUserInput {
+	return &mq.CreateUserInput{
+		BrokerId:      aws.String(id),
+		Username:      aws.String(u.Username),
+		Password:      aws.String(password),
+		ConsoleAccess: u.ConsoleAccess,
+		Groups:        u.Groups,
+	}
+}
+
+// GenerateUpdateUserInput returns the input to update the given user of the
+// broker with the given ID. The password is only changed if it is not empty.
+func GenerateUpdateUserInput(id string, u v1alpha1.User, password string) *mq.UpdateUserInput {
+	in := &mq.UpdateUserInput{
+		BrokerId:      aws.String(id),
+		Username:      aws.String(u.Username),
+		ConsoleAccess: aws.Bool(aws.BoolValue(u.ConsoleAccess)),
+		Groups:        u.Groups,
+	}
+	if password != "" {
+		in.Password = aws.String(password)
+	}
+	return in
+}
+
+// GetBrokerConnectionDetails returns the connection details of the given
+// Broker, i.e. the first endpoint of its first instance, the endpoints of all
+// of its instances, the web console URL and the username of its first user.
+func GetBrokerConnectionDetails(cr v1alpha1.Broker) managed.ConnectionDetails {
+	var endpoints []string
+	for _, i := range cr.Status.AtProvider.BrokerInstances {
+		endpoints = append(endpoints, i.Endpoints...)
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+	cd := managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(endpoints[0]),
+		ConnectionDetailsEndpoints:                           []byte(strings.Join(endpoints, ",")),
+	}
+	if u := cr.Status.AtProvider.BrokerInstances[0].ConsoleURL; u != "" {
+		cd[ConnectionDetailsConsoleURL] = []byte(u)
+	}
+	if len(cr.Spec.ForProvider.Users) > 0 {
+		cd[runtimev1alpha1.ResourceCredentialsSecretUserKey] = []byte(cr.Spec.ForProvider.Users[0].Username)
+	}
+	return cd
+}
+
+// GetUserConnectionDetails returns the connection details of the passwords
+// of the given users. The password of the first user of the Broker is also
+// published with the password key.
+func GetUserConnectionDetails(cr v1alpha1.Broker, users []v1alpha1.User, passwords map[string]string) managed.ConnectionDetails {
+	if len(users) == 0 {
+		return nil
+	}
+	cd := managed.ConnectionDetails{}
+	for _, u := range users {
+		cd[ConnectionDetailsUserPasswordPrefix+u.Username] = []byte(passwords[u.Username])
+		if u.Username == cr.Spec.ForProvider.Users[0].Username {
+			cd[runtimev1alpha1.ResourceCredentialsSecretPasswordKey] = []byte(passwords[u.Username])
+		}
+	}
+	return cd
+}
+
+func generateConfigurationID(c *v1alpha1.ConfigurationID) *mq.ConfigurationId {
+	if c == nil {
+		return nil
+	}
+	return &mq.ConfigurationId{Id: aws.String(c.ID), Revision: c.Revision}
+}
+
+func generateLogs(l *v1alpha1.Logs) *mq.Logs {
+	if l == nil {
+		return nil
+	}
+	return &mq.Logs{Audit: l.Audit, General: l.General}
+}
+
+func isLogsUpToDate(l v1alpha1.Logs, o *mq.LogsSummary) bool {
+	if o == nil {
+		return l.Audit == nil && l.General == nil
+	}
+	audit, general := o.Audit, o.General
+	if o.Pending != nil {
+		audit, general = o.Pending.Audit, o.Pending.General
+	}
+	return (l.Audit == nil || *l.Audit == aws.BoolValue(audit)) &&
+		(l.General == nil || *l.General == aws.BoolValue(general))
+}
+
+func isConfigurationUpToDate(c v1alpha1.ConfigurationID, o *mq.Configurations) bool {
+	if o == nil {
+		return false
+	}
+	current := o.Current
+	if o.Pending != nil {
+		current = o.Pending
+	}
+	if current == nil || c.ID != aws.StringValue(current.Id) {
+		return false
+	}
+	return c.Revision == nil || *c.Revision == aws.Int64Value(current.Revision)
+}
+
+// pending returns the pending value if a change is pending, or the current
+// value otherwise.
+func pending(p, c *string) string {
+	if aws.StringValue(p) != "" {
+		return aws.StringValue(p)
+	}
+	return aws.StringValue(c)
+}
+
+func sortStrings() cmp.Option {
+	return cmpopts.SortSlices(func(a, b string) bool { return a < b })
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mq
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/mq/v1alpha1"
+)
+
+var brokerID = "b-1234"
+
+func observedBroker() mq.DescribeBrokerOutput {
+	return mq.DescribeBrokerOutput{
+		BrokerId:                aws.String(brokerID),
+		AutoMinorVersionUpgrade: aws.Bool(false),
+		EngineVersion:           aws.String("5.15.12"),
+		HostInstanceType:        aws.String("mq.t3.micro"),
+		SecurityGroups:          []string{"sg-1", "sg-2"},
+		Logs:                    &mq.LogsSummary{General: aws.Bool(true), Audit: aws.Bool(false)},
+		Configurations: &mq.Configurations{
+			Current: &mq.ConfigurationId{Id: aws.String("c-1"), Revision: aws.Int64(1)},
+		},
+	}
+}
+
+func TestGenerateUpdateBrokerInput(t *testing.T) {
+	cases := map[string]struct {
+		p   v1alpha1.BrokerParameters
+		o   mq.DescribeBrokerOutput
+		out *mq.UpdateBrokerInput
+	}{
+		"NoChange": {
+			p: v1alpha1.BrokerParameters{
+				EngineVersion:    "5.15.12",
+				HostInstanceType: "mq.t3.micro",
+				SecurityGroupIDs: []string{"sg-2", "sg-1"},
+				Logs:             &v1alpha1.Logs{General: aws.Bool(true)},
+				Configuration:    &v1alpha1.ConfigurationID{ID: "c-1"},
+			},
+			o:   observedBroker(),
+			out: &mq.UpdateBrokerInput{BrokerId: aws.String(brokerID)},
+		},
+		"PendingChanges": {
+			p: v1alpha1.BrokerParameters{
+				EngineVersion:    "5.15.13",
+				HostInstanceType: "mq.m5.large",
+				SecurityGroupIDs: []string{"sg-3"},
+			},
+			o: func() mq.DescribeBrokerOutput {
+				o := observedBroker()
+				o.PendingEngineVersion = aws.String("5.15.13")
+				o.PendingHostInstanceType = aws.String("mq.m5.large")
+				o.PendingSecurityGroups = []string{"sg-3"}
+				return o
+			}(),
+			out: &mq.UpdateBrokerInput{BrokerId: aws.String(brokerID)},
+		},
+		"EngineAndInstanceType": {
+			p: v1alpha1.BrokerParameters{
+				EngineVersion:           "5.15.13",
+				HostInstanceType:        "mq.m5.large",
+				AutoMinorVersionUpgrade: aws.Bool(true),
+			},
+			o: observedBroker(),
+			out: &mq.UpdateBrokerInput{
+				BrokerId:                aws.String(brokerID),
+				AutoMinorVersionUpgrade: aws.Bool(true),
+				EngineVersion:           aws.String("5.15.13"),
+				HostInstanceType:        aws.String("mq.m5.large"),
+			},
+		},
+		"LogsSecurityGroupsAndConfiguration": {
+			p: v1alpha1.BrokerParameters{
+				EngineVersion:    "5.15.12",
+				HostInstanceType: "mq.t3.micro",
+				SecurityGroupIDs: []string{"sg-3"},
+				Logs:             &v1alpha1.Logs{Audit: aws.Bool(true)},
+				Configuration:    &v1alpha1.ConfigurationID{ID: "c-1", Revision: aws.Int64(2)},
+			},
+			o: observedBroker(),
+			out: &mq.UpdateBrokerInput{
+				BrokerId:       aws.String(brokerID),
+				SecurityGroups: []string{"sg-3"},
+				Logs:           &mq.Logs{Audit: aws.Bool(true)},
+				Configuration:  &mq.ConfigurationId{Id: aws.String("c-1"), Revision: aws.Int64(2)},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateBrokerInput(brokerID, tc.p, tc.o)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(cmp.Equal(&mq.UpdateBrokerInput{BrokerId: aws.String(brokerID)}, got), IsBrokerUpToDate(tc.p, tc.o)); diff != "" {
+				t.Errorf("IsBrokerUpToDate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffUsers(t *testing.T) {
+	type want struct {
+		create []v1alpha1.User
+		update []v1alpha1.User
+		remove []string
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.User
+		observed []mq.DescribeUserOutput
+		changed  map[string]bool
+		want     want
+	}{
+		"UpToDate": {
+			desired: []v1alpha1.User{{Username: "admin", ConsoleAccess: aws.Bool(true), Groups: []string{"b", "a"}}},
+			observed: []mq.DescribeUserOutput{
+				{Username: aws.String("admin"), ConsoleAccess: aws.Bool(true), Groups: []string{"a", "b"}},
+			},
+		},
+		"CreateUpdateAndRemove": {
+			desired: []v1alpha1.User{
+				{Username: "admin", ConsoleAccess: aws.Bool(true)},
+				{Username: "app"},
+			},
+			observed: []mq.DescribeUserOutput{
+				{Username: aws.String("admin")},
+				{Username: aws.String("old")},
+			},
+			want: want{
+				create: []v1alpha1.User{{Username: "app"}},
+				update: []v1alpha1.User{{Username: "admin", ConsoleAccess: aws.Bool(true)}},
+				remove: []string{"old"},
+			},
+		},
+		"PasswordChanged": {
+			desired:  []v1alpha1.User{{Username: "admin"}},
+			observed: []mq.DescribeUserOutput{{Username: aws.String("admin")}},
+			changed:  map[string]bool{"admin": true},
+			want: want{
+				update: []v1alpha1.User{{Username: "admin"}},
+			},
+		},
+		"PendingChanges": {
+			desired: []v1alpha1.User{{Username: "admin", Groups: []string{"ops"}}},
+			observed: []mq.DescribeUserOutput{
+				{
+					Username: aws.String("admin"),
+					Pending:  &mq.UserPendingChanges{PendingChange: mq.ChangeTypeUpdate, Groups: []string{"ops"}},
+				},
+				{
+					Username: aws.String("old"),
+					Pending:  &mq.UserPendingChanges{PendingChange: mq.ChangeTypeDelete},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, update, remove := DiffUsers(tc.desired, tc.observed, tc.changed)
+			sort.Strings(remove)
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.update, update); diff != "" {
+				t.Errorf("update: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetBrokerConnectionDetails(t *testing.T) {
+	users := []v1alpha1.User{{Username: "admin"}}
+	cases := map[string]struct {
+		cr  v1alpha1.Broker
+		out managed.ConnectionDetails
+	}{
+		"NotCreated": {
+			cr: v1alpha1.Broker{Spec: v1alpha1.BrokerSpec{ForProvider: v1alpha1.BrokerParameters{Users: users}}},
+		},
+		"ActiveStandby": {
+			cr: v1alpha1.Broker{
+				Spec: v1alpha1.BrokerSpec{ForProvider: v1alpha1.BrokerParameters{Users: users}},
+				Status: v1alpha1.BrokerStatus{AtProvider: v1alpha1.BrokerObservation{
+					BrokerInstances: []v1alpha1.BrokerInstance{
+						{ConsoleURL: "https://b-1.mq:8162", Endpoints: []string{"ssl://b-1.mq:61617", "amqp+ssl://b-1.mq:5671"}},
+						{ConsoleURL: "https://b-2.mq:8162", Endpoints: []string{"ssl://b-2.mq:61617"}},
+					},
+				}},
+			},
+			out: managed.ConnectionDetails{
+				runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte("ssl://b-1.mq:61617"),
+				runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte("admin"),
+				ConnectionDetailsEndpoints:                           []byte("ssl://b-1.mq:61617,amqp+ssl://b-1.mq:5671,ssl://b-2.mq:61617"),
+				ConnectionDetailsConsoleURL:                          []byte("https://b-1.mq:8162"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetBrokerConnectionDetails(tc.cr)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
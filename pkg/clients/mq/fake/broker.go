@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/mq"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.BrokerClient = (*MockBrokerClient)(nil)
+
+// MockBrokerClient is a type that implements all the methods for BrokerClient interface
+type MockBrokerClient struct {
+	MockDescribeBroker func(*mq.DescribeBrokerInput) mq.DescribeBrokerRequest
+	MockCreateBroker   func(*mq.CreateBrokerInput) mq.CreateBrokerRequest
+	MockUpdateBroker   func(*mq.UpdateBrokerInput) mq.UpdateBrokerRequest
+	MockDeleteBroker   func(*mq.DeleteBrokerInput) mq.DeleteBrokerRequest
+	MockCreateTags     func(*mq.CreateTagsInput) mq.CreateTagsRequest
+	MockDeleteTags     func(*mq.DeleteTagsInput) mq.DeleteTagsRequest
+	MockListUsers      func(*mq.ListUsersInput) mq.ListUsersRequest
+	MockDescribeUser   func(*mq.DescribeUserInput) mq.DescribeUserRequest
+	MockCreateUser     func(*mq.CreateUserInput) mq.CreateUserRequest
+	MockUpdateUser     func(*mq.UpdateUserInput) mq.UpdateUserRequest
+	MockDeleteUser     func(*mq.DeleteUserInput) mq.DeleteUserRequest
+}
+
+// DescribeBrokerRequest mocks DescribeBrokerRequest method
+func (m *MockBrokerClient) DescribeBrokerRequest(input *mq.DescribeBrokerInput) mq.DescribeBrokerRequest {
+	return m.MockDescribeBroker(input)
+}
+
+// CreateBrokerRequest mocks CreateBrokerRequest method
+func (m *MockBrokerClient) CreateBrokerRequest(input *mq.CreateBrokerInput) mq.CreateBrokerRequest {
+	return m.MockCreateBroker(input)
+}
+
+// UpdateBrokerRequest mocks UpdateBrokerRequest method
+func (m *MockBrokerClient) UpdateBrokerRequest(input *mq.UpdateBrokerInput) mq.UpdateBrokerRequest {
+	return m.MockUpdateBroker(input)
+}
+
+// DeleteBrokerRequest mocks DeleteBrokerRequest method
+func (m *MockBrokerClient) DeleteBrokerRequest(input *mq.DeleteBrokerInput) mq.DeleteBrokerRequest {
+	return m.MockDeleteBroker(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockBrokerClient) CreateTagsRequest(input *mq.CreateTagsInput) mq.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockBrokerClient) DeleteTagsRequest(input *mq.DeleteTagsInput) mq.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
+
+// ListUsersRequest mocks ListUsersRequest method
+func (m *MockBrokerClient) ListUsersRequest(input *mq.ListUsersInput) mq.ListUsersRequest {
+	return m.MockListUsers(input)
+}
+
+// DescribeUserRequest mocks DescribeUserRequest method
+func (m *MockBrokerClient) DescribeUserRequest(input *mq.DescribeUserInput) mq.DescribeUserRequest {
+	return m.MockDescribeUser(input)
+}
+
+// CreateUserRequest mocks CreateUserRequest method
+func (m *MockBrokerClient) CreateUserRequest(input *mq.CreateUserInput) mq.CreateUserRequest {
+	return m.MockCreateUser(input)
+}
+
+// UpdateUserRequest mocks UpdateUserRequest method
+func (m *MockBrokerClient) UpdateUserRequest(input *mq.UpdateUserInput) mq.UpdateUserRequest {
+	return m.MockUpdateUser(input)
+}
+
+// DeleteUserRequest mocks DeleteUserRequest method
+func (m *MockBrokerClient) DeleteUserRequest(input *mq.DeleteUserInput) mq.DeleteUserRequest {
+	return m.MockDeleteUser(input)
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package neptune
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptune"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/neptune/v1alpha1"
+)
+
+var clusterName = "some-cluster"
+
+func observedCluster() neptune.DBCluster {
+	return neptune.DBCluster{
+		DBClusterIdentifier:              aws.String(clusterName),
+		EngineVersion:                    aws.String("1.0.3.0"),
+		Port:                             aws.Int64(8182),
+		BackupRetentionPeriod:            aws.Int64(1),
+		DeletionProtection:               aws.Bool(false),
+		IAMDatabaseAuthenticationEnabled: aws.Bool(false),
+		VpcSecurityGroups: []neptune.VpcSecurityGroupMembership{
+			{VpcSecurityGroupId: aws.String("sg-1")},
+			{VpcSecurityGroupId: aws.String("sg-2")},
+		},
+	}
+}
+
+func TestGenerateModifyDBClusterInput(t *testing.T) {
+	cases := map[string]struct {
+		p   v1alpha1.DBClusterParameters
+		o   neptune.DBCluster
+		out *neptune.ModifyDBClusterInput
+	}{
+		"NoChange": {
+			p: v1alpha1.DBClusterParameters{
+				EngineVersion:       aws.String("1.0.3.0"),
+				Port:                aws.Int64(8182),
+				VPCSecurityGroupIDs: []string{"sg-2", "sg-1"},
+			},
+			o:   observedCluster(),
+			out: &neptune.ModifyDBClusterInput{DBClusterIdentifier: aws.String(clusterName)},
+		},
+		"IAMAuthAndBackups": {
+			p: v1alpha1.DBClusterParameters{
+				BackupRetentionPeriod:           aws.Int64(7),
+				EnableIAMDatabaseAuthentication: aws.Bool(true),
+				ApplyImmediately:                aws.Bool(true),
+			},
+			o: observedCluster(),
+			out: &neptune.ModifyDBClusterInput{
+				DBClusterIdentifier:             aws.String(clusterName),
+				ApplyImmediately:                aws.Bool(true),
+				BackupRetentionPeriod:           aws.Int64(7),
+				EnableIAMDatabaseAuthentication: aws.Bool(true),
+			},
+		},
+		"EnableAuditLogs": {
+			p: v1alpha1.DBClusterParameters{
+				EnableCloudwatchLogsExports: []string{v1alpha1.LogTypeAudit},
+			},
+			o: observedCluster(),
+			out: &neptune.ModifyDBClusterInput{
+				DBClusterIdentifier: aws.String(clusterName),
+				CloudwatchLogsExportConfiguration: &neptune.CloudwatchLogsExportConfiguration{
+					EnableLogTypes: []string{v1alpha1.LogTypeAudit},
+				},
+			},
+		},
+		"DisableAuditLogs": {
+			p: v1alpha1.DBClusterParameters{},
+			o: func() neptune.DBCluster {
+				o := observedCluster()
+				o.EnabledCloudwatchLogsExports = []string{v1alpha1.LogTypeAudit}
+				return o
+			}(),
+			out: &neptune.ModifyDBClusterInput{
+				DBClusterIdentifier: aws.String(clusterName),
+				CloudwatchLogsExportConfiguration: &neptune.CloudwatchLogsExportConfiguration{
+					DisableLogTypes: []string{v1alpha1.LogTypeAudit},
+				},
+			},
+		},
+		"SecurityGroupsChanged": {
+			p: v1alpha1.DBClusterParameters{
+				VPCSecurityGroupIDs: []string{"sg-3"},
+			},
+			o: observedCluster(),
+			out: &neptune.ModifyDBClusterInput{
+				DBClusterIdentifier: aws.String(clusterName),
+				VpcSecurityGroupIds: []string{"sg-3"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyDBClusterInput(clusterName, tc.p, tc.o)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDBClusterUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DBClusterParameters
+		o    neptune.DBCluster
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.DBClusterParameters{
+				Port:             aws.Int64(8182),
+				ApplyImmediately: aws.Bool(true),
+			},
+			o:    observedCluster(),
+			want: true,
+		},
+		"EngineVersionChanged": {
+			p: v1alpha1.DBClusterParameters{
+				EngineVersion: aws.String("1.0.4.0"),
+			},
+			o:    observedCluster(),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDBClusterUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetDBClusterConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		o    v1alpha1.DBClusterObservation
+		want managed.ConnectionDetails
+	}{
+		"NoEndpoint": {
+			o:    v1alpha1.DBClusterObservation{},
+			want: nil,
+		},
+		"Endpoints": {
+			o: v1alpha1.DBClusterObservation{
+				Endpoint:       "cluster.neptune.amazonaws.com",
+				ReaderEndpoint: "cluster-ro.neptune.amazonaws.com",
+				Port:           8182,
+			},
+			want: managed.ConnectionDetails{
+				runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte("cluster.neptune.amazonaws.com"),
+				runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte("8182"),
+				ConnectionDetailsReaderEndpoint:                      []byte("cluster-ro.neptune.amazonaws.com"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetDBClusterConnectionDetails(v1alpha1.DBCluster{Status: v1alpha1.DBClusterStatus{AtProvider: tc.o}})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package neptune
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/neptune"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/neptune/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// DBInstanceClient is the external client used for DBInstance Custom Resource
+type DBInstanceClient interface {
+	DescribeDBInstancesRequest(*neptune.DescribeDBInstancesInput) neptune.DescribeDBInstancesRequest
+	CreateDBInstanceRequest(*neptune.CreateDBInstanceInput) neptune.CreateDBInstanceRequest
+	ModifyDBInstanceRequest(*neptune.ModifyDBInstanceInput) neptune.ModifyDBInstanceRequest
+	DeleteDBInstanceRequest(*neptune.DeleteDBInstanceInput) neptune.DeleteDBInstanceRequest
+	ListTagsForResourceRequest(*neptune.ListTagsForResourceInput) neptune.ListTagsForResourceRequest
+	AddTagsToResourceRequest(*neptune.AddTagsToResourceInput) neptune.AddTagsToResourceRequest
+	RemoveTagsFromResourceRequest(*neptune.RemoveTagsFromResourceInput) neptune.RemoveTagsFromResourceRequest
+}
+
+// NewDBInstanceClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewDBInstanceClient(cfg aws.Config) DBInstanceClient {
+	return neptune.New(cfg)
+}
+
+// IsDBInstanceNotFound returns true if the error is because the DB instance
+// doesn't exist.
+func IsDBInstanceNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == neptune.ErrCodeDBInstanceNotFoundFault {
+		return true
+	}
+	return false
+}
+
+// GenerateCreateDBInstanceInput returns the create input of a DB instance
+// with the given name and parameters.
+func GenerateCreateDBInstanceInput(name string, p v1alpha1.DBInstanceParameters) *neptune.CreateDBInstanceInput {
+	return &neptune.CreateDBInstanceInput{
+		DBInstanceIdentifier:       aws.String(name),
+		DBInstanceClass:            aws.String(p.DBInstanceClass),
+		DBClusterIdentifier:        p.DBClusterIdentifier,
+		Engine:                     aws.String(engine),
+		AvailabilityZone:           p.AvailabilityZone,
+		DBParameterGroupName:       p.DBParameterGroupName,
+		AutoMinorVersionUpgrade:    p.AutoMinorVersionUpgrade,
+		PreferredMaintenanceWindow: p.PreferredMaintenanceWindow,
+		PromotionTier:              p.PromotionTier,
+		Tags:                       GenerateTags(p.Tags),
+	}
+}
+
+// GenerateModifyDBInstanceInput returns the modify input that changes the
+// given observed DB instance into the desired one. Only the fields that
+// differ are set.
+func GenerateModifyDBInstanceInput(name string, p v1alpha1.DBInstanceParameters, o neptune.DBInstance) *neptune.ModifyDBInstanceInput {
+	in := &neptune.ModifyDBInstanceInput{
+		DBInstanceIdentifier: aws.String(name),
+		ApplyImmediately:     p.ApplyImmediately,
+	}
+	if p.DBInstanceClass != observedDBInstanceClass(o) {
+		in.DBInstanceClass = aws.String(p.DBInstanceClass)
+	}
+	if p.DBParameterGroupName != nil && aws.StringValue(p.DBParameterGroupName) != observedDBParameterGroupName(o) {
+		in.DBParameterGroupName = p.DBParameterGroupName
+	}
+	if p.AutoMinorVersionUpgrade != nil && aws.BoolValue(p.AutoMinorVersionUpgrade) != aws.BoolValue(o.AutoMinorVersionUpgrade) {
+		in.AutoMinorVersionUpgrade = p.AutoMinorVersionUpgrade
+	}
+	if p.PreferredMaintenanceWindow != nil && aws.StringValue(p.PreferredMaintenanceWindow) != aws.StringValue(o.PreferredMaintenanceWindow) {
+		in.PreferredMaintenanceWindow = p.PreferredMaintenanceWindow
+	}
+	if p.PromotionTier != nil && aws.Int64Value(p.PromotionTier) != aws.Int64Value(o.PromotionTier) {
+		in.PromotionTier = p.PromotionTier
+	}
+	return in
+}
+
+// GenerateDBInstanceObservation is used to produce
+// v1alpha1.DBInstanceObservation from neptune.DBInstance.
+func GenerateDBInstanceObservation(o neptune.DBInstance) v1alpha1.DBInstanceObservation {
+	obs := v1alpha1.DBInstanceObservation{
+		DBInstanceARN:    aws.StringValue(o.DBInstanceArn),
+		DBInstanceStatus: aws.StringValue(o.DBInstanceStatus),
+		DBIResourceID:    aws.StringValue(o.DbiResourceId),
+		EngineVersion:    aws.StringValue(o.EngineVersion),
+	}
+	if o.Endpoint != nil {
+		obs.EndpointAddress = aws.StringValue(o.Endpoint.Address)
+		obs.EndpointPort = aws.Int64Value(o.Endpoint.Port)
+	}
+	if o.PendingModifiedValues != nil {
+		obs.PendingDBInstanceClass = aws.StringValue(o.PendingModifiedValues.DBInstanceClass)
+	}
+	return obs
+}
+
+// LateInitializeDBInstance fills the empty fields in
+// *v1alpha1.DBInstanceParameters with the values seen in neptune.DBInstance.
+func LateInitializeDBInstance(in *v1alpha1.DBInstanceParameters, o *neptune.DBInstance) {
+	if o == nil {
+		return
+	}
+	in.DBClusterIdentifier = awsclients.LateInitializeStringPtr(in.DBClusterIdentifier, o.DBClusterIdentifier)
+	in.AvailabilityZone = awsclients.LateInitializeStringPtr(in.AvailabilityZone, o.AvailabilityZone)
+	in.AutoMinorVersionUpgrade = awsclients.LateInitializeBoolPtr(in.AutoMinorVersionUpgrade, o.AutoMinorVersionUpgrade)
+	in.PreferredMaintenanceWindow = awsclients.LateInitializeStringPtr(in.PreferredMaintenanceWindow, o.PreferredMaintenanceWindow)
+	in.PromotionTier = awsclients.LateInitializeInt64Ptr(in.PromotionTier, o.PromotionTier)
+	if in.DBParameterGroupName == nil && len(o.DBParameterGroups) != 0 {
+		in.DBParameterGroupName = o.DBParameterGroups[0].DBParameterGroupName
+	}
+}
+
+// IsDBInstanceUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsDBInstanceUpToDate(p v1alpha1.DBInstanceParameters, o neptune.DBInstance) bool {
+	in := GenerateModifyDBInstanceInput(aws.StringValue(o.DBInstanceIdentifier), p, o)
+	return cmp.Equal(&neptune.ModifyDBInstanceInput{DBInstanceIdentifier: in.DBInstanceIdentifier, ApplyImmediately: in.ApplyImmediately}, in,
+		cmpopts.IgnoreUnexported(neptune.ModifyDBInstanceInput{}))
+}
+
+// GetDBInstanceConnectionDetails returns the connection details of the given
+// DBInstance, i.e. its endpoint and port.
+func GetDBInstanceConnectionDetails(cr v1alpha1.DBInstance) managed.ConnectionDetails {
+	o := cr.Status.AtProvider
+	if o.EndpointAddress == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(o.EndpointAddress),
+		runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.FormatInt(o.EndpointPort, 10)),
+	}
+}
+
+// observedDBInstanceClass returns the instance class that the instance has
+// or is going to have once the pending modifications are applied, so that
+// a change isn't requested again until the next maintenance window.
+func observedDBInstanceClass(o neptune.DBInstance) string {
+	if o.PendingModifiedValues != nil && o.PendingModifiedValues.DBInstanceClass != nil {
+		return aws.StringValue(o.PendingModifiedValues.DBInstanceClass)
+	}
+	return aws.StringValue(o.DBInstanceClass)
+}
+
+func observedDBParameterGroupName(o neptune.DBInstance) string {
+	if len(o.DBParameterGroups) == 0 {
+		return ""
+	}
+	return aws.StringValue(o.DBParameterGroups[0].DBParameterGroupName)
+}
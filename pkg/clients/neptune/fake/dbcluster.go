@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/neptune"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/neptune"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DBClusterClient = (*MockDBClusterClient)(nil)
+
+// MockDBClusterClient is a type that implements all the methods for DBClusterClient interface
+type MockDBClusterClient struct {
+	MockDescribeDBClusters           func(*neptune.DescribeDBClustersInput) neptune.DescribeDBClustersRequest
+	MockCreateDBCluster              func(*neptune.CreateDBClusterInput) neptune.CreateDBClusterRequest
+	MockRestoreDBClusterFromSnapshot func(*neptune.RestoreDBClusterFromSnapshotInput) neptune.RestoreDBClusterFromSnapshotRequest
+	MockModifyDBCluster              func(*neptune.ModifyDBClusterInput) neptune.ModifyDBClusterRequest
+	MockDeleteDBCluster              func(*neptune.DeleteDBClusterInput) neptune.DeleteDBClusterRequest
+	MockListTagsForResource          func(*neptune.ListTagsForResourceInput) neptune.ListTagsForResourceRequest
+	MockAddTagsToResource            func(*neptune.AddTagsToResourceInput) neptune.AddTagsToResourceRequest
+	MockRemoveTagsFromResource       func(*neptune.RemoveTagsFromResourceInput) neptune.RemoveTagsFromResourceRequest
+}
+
+// DescribeDBClustersRequest mocks DescribeDBClustersRequest method
+func (m *MockDBClusterClient) DescribeDBClustersRequest(input *neptune.DescribeDBClustersInput) neptune.DescribeDBClustersRequest {
+	return m.MockDescribeDBClusters(input)
+}
+
+// CreateDBClusterRequest mocks CreateDBClusterRequest method
+func (m *MockDBClusterClient) CreateDBClusterRequest(input *neptune.CreateDBClusterInput) neptune.CreateDBClusterRequest {
+	return m.MockCreateDBCluster(input)
+}
+
+// RestoreDBClusterFromSnapshotRequest mocks RestoreDBClusterFromSnapshotRequest method
+func (m *MockDBClusterClient) RestoreDBClusterFromSnapshotRequest(input *neptune.RestoreDBClusterFromSnapshotInput) neptune.RestoreDBClusterFromSnapshotRequest {
+	return m.MockRestoreDBClusterFromSnapshot(input)
+}
+
+// ModifyDBClusterRequest mocks ModifyDBClusterRequest method
+func (m *MockDBClusterClient) ModifyDBClusterRequest(input *neptune.ModifyDBClusterInput) neptune.ModifyDBClusterRequest {
+	return m.MockModifyDBCluster(input)
+}
+
+// DeleteDBClusterRequest mocks DeleteDBClusterRequest method
+func (m *MockDBClusterClient) DeleteDBClusterRequest(input *neptune.DeleteDBClusterInput) neptune.DeleteDBClusterRequest {
+	return m.MockDeleteDBCluster(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockDBClusterClient) ListTagsForResourceRequest(input *neptune.ListTagsForResourceInput) neptune.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// AddTagsToResourceRequest mocks AddTagsToResourceRequest method
+func (m *MockDBClusterClient) AddTagsToResourceRequest(input *neptune.AddTagsToResourceInput) neptune.AddTagsToResourceRequest {
+	return m.MockAddTagsToResource(input)
+}
+
+// RemoveTagsFromResourceRequest mocks RemoveTagsFromResourceRequest method
+func (m *MockDBClusterClient) RemoveTagsFromResourceRequest(input *neptune.RemoveTagsFromResourceInput) neptune.RemoveTagsFromResourceRequest {
+	return m.MockRemoveTagsFromResource(input)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/neptune"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/neptune"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DBInstanceClient = (*MockDBInstanceClient)(nil)
+
+// MockDBInstanceClient is a type that implements all the methods for DBInstanceClient interface
+type MockDBInstanceClient struct {
+	MockDescribeDBInstances    func(*neptune.DescribeDBInstancesInput) neptune.DescribeDBInstancesRequest
+	MockCreateDBInstance       func(*neptune.CreateDBInstanceInput) neptune.CreateDBInstanceRequest
+	MockModifyDBInstance       func(*neptune.ModifyDBInstanceInput) neptune.ModifyDBInstanceRequest
+	MockDeleteDBInstance       func(*neptune.DeleteDBInstanceInput) neptune.DeleteDBInstanceRequest
+	MockListTagsForResource    func(*neptune.ListTagsForResourceInput) neptune.ListTagsForResourceRequest
+	MockAddTagsToResource      func(*neptune.AddTagsToResourceInput) neptune.AddTagsToResourceRequest
+	MockRemoveTagsFromResource func(*neptune.RemoveTagsFromResourceInput) neptune.RemoveTagsFromResourceRequest
+}
+
+// DescribeDBInstancesRequest mocks DescribeDBInstancesRequest method
+func (m *MockDBInstanceClient) DescribeDBInstancesRequest(input *neptune.DescribeDBInstancesInput) neptune.DescribeDBInstancesRequest {
+	return m.MockDescribeDBInstances(input)
+}
+
+// CreateDBInstanceRequest mocks CreateDBInstanceRequest method
+func (m *MockDBInstanceClient) CreateDBInstanceRequest(input *neptune.CreateDBInstanceInput) neptune.CreateDBInstanceRequest {
+	return m.MockCreateDBInstance(input)
+}
+
+// ModifyDBInstanceRequest mocks ModifyDBInstanceRequest method
+func (m *MockDBInstanceClient) ModifyDBInstanceRequest(input *neptune.ModifyDBInstanceInput) neptune.ModifyDBInstanceRequest {
+	return m.MockModifyDBInstance(input)
+}
+
+// DeleteDBInstanceRequest mocks DeleteDBInstanceRequest method
+func (m *MockDBInstanceClient) DeleteDBInstanceRequest(input *neptune.DeleteDBInstanceInput) neptune.DeleteDBInstanceRequest {
+	return m.MockDeleteDBInstance(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockDBInstanceClient) ListTagsForResourceRequest(input *neptune.ListTagsForResourceInput) neptune.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// AddTagsToResourceRequest mocks AddTagsToResourceRequest method
+func (m *MockDBInstanceClient) AddTagsToResourceRequest(input *neptune.AddTagsToResourceInput) neptune.AddTagsToResourceRequest {
+	return m.MockAddTagsToResource(input)
+}
+
+// RemoveTagsFromResourceRequest mocks RemoveTagsFromResourceRequest method
+func (m *MockDBInstanceClient) RemoveTagsFromResourceRequest(input *neptune.RemoveTagsFromResourceInput) neptune.RemoveTagsFromResourceRequest {
+	return m.MockRemoveTagsFromResource(input)
+}
@@ -0,0 +1,497 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opensearchservice
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	es "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/opensearchservice/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetPasswordSecret = "cannot get the master user password secret"
+
+	// endpointPort is the port of the HTTPS endpoint of every domain.
+	endpointPort = "443"
+
+	// vpcEndpointKey is the key of the endpoint of a domain in a VPC in the
+	// observed endpoints.
+	vpcEndpointKey = "vpc"
+)
+
+// DomainClient is the external client used for Domain Custom Resource
+type DomainClient interface {
+	DescribeElasticsearchDomainRequest(*es.DescribeElasticsearchDomainInput) es.DescribeElasticsearchDomainRequest
+	CreateElasticsearchDomainRequest(*es.CreateElasticsearchDomainInput) es.CreateElasticsearchDomainRequest
+	UpdateElasticsearchDomainConfigRequest(*es.UpdateElasticsearchDomainConfigInput) es.UpdateElasticsearchDomainConfigRequest
+	DeleteElasticsearchDomainRequest(*es.DeleteElasticsearchDomainInput) es.DeleteElasticsearchDomainRequest
+	StartElasticsearchServiceSoftwareUpdateRequest(*es.StartElasticsearchServiceSoftwareUpdateInput) es.StartElasticsearchServiceSoftwareUpdateRequest
+	ListTagsRequest(*es.ListTagsInput) es.ListTagsRequest
+	AddTagsRequest(*es.AddTagsInput) es.AddTagsRequest
+	RemoveTagsRequest(*es.RemoveTagsInput) es.RemoveTagsRequest
+}
+
+// NewDomainClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewDomainClient(cfg aws.Config) DomainClient {
+	return es.New(cfg)
+}
+
+// IsDomainNotFound returns true if the error is because the domain doesn't
+// exist.
+func IsDomainNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == es.ErrCodeResourceNotFoundException {
+		return true
+	}
+	return false
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from a domain.
+func DiffTags(desired []v1alpha1.Tag, observed []es.Tag) (add []es.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, es.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
+}
+
+// IsChangeInProgress returns true while a blue/green deployment, a version
+// upgrade or a service software update is running on the given domain. The
+// observed configuration of the domain may be in between the old and the
+// new one during that time, and no other change can be applied to it.
+func IsChangeInProgress(o es.ElasticsearchDomainStatus) bool {
+	if aws.BoolValue(o.Processing) || aws.BoolValue(o.UpgradeProcessing) {
+		return true
+	}
+	return o.ServiceSoftwareOptions != nil && o.ServiceSoftwareOptions.UpdateStatus == es.DeploymentStatusInProgress
+}
+
+// IsServiceSoftwareUpdateNeeded returns true if the service software update
+// of the given domain should be started, i.e. automated updates are enabled
+// and an update is available that is neither scheduled nor running.
+func IsServiceSoftwareUpdateNeeded(p v1alpha1.DomainParameters, o es.ElasticsearchDomainStatus) bool {
+	s := o.ServiceSoftwareOptions
+	if !aws.BoolValue(p.AutoServiceSoftwareUpdate) || s == nil || !aws.BoolValue(s.UpdateAvailable) {
+		return false
+	}
+	return s.UpdateStatus != es.DeploymentStatusPendingUpdate && s.UpdateStatus != es.DeploymentStatusInProgress
+}
+
+// GenerateCreateElasticsearchDomainInput returns the create input of a domain
+// with the given name, master user password and parameters.
+func GenerateCreateElasticsearchDomainInput(name, password string, p v1alpha1.DomainParameters) *es.CreateElasticsearchDomainInput {
+	in := &es.CreateElasticsearchDomainInput{
+		DomainName:                 aws.String(name),
+		ElasticsearchVersion:       p.ElasticsearchVersion,
+		ElasticsearchClusterConfig: generateClusterConfig(p.ClusterConfig),
+		EBSOptions:                 generateEBSOptions(p.EBSOptions),
+		VPCOptions:                 generateVPCOptions(p.VPCOptions),
+		AccessPolicies:             p.AccessPolicies,
+		AdvancedOptions:            p.AdvancedOptions,
+		AdvancedSecurityOptions:    generateAdvancedSecurityOptions(p.AdvancedSecurityOptions, password),
+		DomainEndpointOptions:      generateDomainEndpointOptions(p.DomainEndpointOptions),
+	}
+	if p.EncryptionAtRestOptions != nil {
+		in.EncryptionAtRestOptions = &es.EncryptionAtRestOptions{
+			Enabled:  aws.Bool(p.EncryptionAtRestOptions.Enabled),
+			KmsKeyId: p.EncryptionAtRestOptions.KMSKeyID,
+		}
+	}
+	if p.NodeToNodeEncryptionEnabled != nil {
+		in.NodeToNodeEncryptionOptions = &es.NodeToNodeEncryptionOptions{Enabled: p.NodeToNodeEncryptionEnabled}
+	}
+	if p.AutomatedSnapshotStartHour != nil {
+		in.SnapshotOptions = &es.SnapshotOptions{AutomatedSnapshotStartHour: p.AutomatedSnapshotStartHour}
+	}
+	return in
+}
+
+// GenerateUpdateElasticsearchDomainConfigInput returns the update input that
+// changes the given observed domain into the desired one. Only the settings
+// that differ are set. The master user options are set if the given password
+// is not empty.
+func GenerateUpdateElasticsearchDomainConfigInput(name, password string, p v1alpha1.DomainParameters, o es.ElasticsearchDomainStatus) *es.UpdateElasticsearchDomainConfigInput { // nolint:gocyclo
+	in := &es.UpdateElasticsearchDomainConfigInput{DomainName: aws.String(name)}
+	if p.ClusterConfig != nil && !isClusterConfigUpToDate(*p.ClusterConfig, o.ElasticsearchClusterConfig) {
+		in.ElasticsearchClusterConfig = generateClusterConfig(p.ClusterConfig)
+	}
+	if p.EBSOptions != nil && !isEBSOptionsUpToDate(*p.EBSOptions, o.EBSOptions) {
+		in.EBSOptions = generateEBSOptions(p.EBSOptions)
+	}
+	if p.VPCOptions != nil && !isVPCOptionsUpToDate(*p.VPCOptions, o.VPCOptions) {
+		in.VPCOptions = generateVPCOptions(p.VPCOptions)
+	}
+	if p.AccessPolicies != nil && !isPolicyUpToDate(aws.StringValue(p.AccessPolicies), aws.StringValue(o.AccessPolicies)) {
+		in.AccessPolicies = p.AccessPolicies
+	}
+	for k, v := range p.AdvancedOptions {
+		if ov, ok := o.AdvancedOptions[k]; !ok || ov != v {
+			in.AdvancedOptions = p.AdvancedOptions
+			break
+		}
+	}
+	if p.AdvancedSecurityOptions != nil && (password != "" || !isAdvancedSecurityOptionsUpToDate(*p.AdvancedSecurityOptions, o.AdvancedSecurityOptions)) {
+		in.AdvancedSecurityOptions = generateAdvancedSecurityOptions(p.AdvancedSecurityOptions, password)
+	}
+	if p.DomainEndpointOptions != nil && !isDomainEndpointOptionsUpToDate(*p.DomainEndpointOptions, o.DomainEndpointOptions) {
+		in.DomainEndpointOptions = generateDomainEndpointOptions(p.DomainEndpointOptions)
+	}
+	if p.AutomatedSnapshotStartHour != nil && (o.SnapshotOptions == nil || aws.Int64Value(p.AutomatedSnapshotStartHour) != aws.Int64Value(o.SnapshotOptions.AutomatedSnapshotStartHour)) {
+		in.SnapshotOptions = &es.SnapshotOptions{AutomatedSnapshotStartHour: p.AutomatedSnapshotStartHour}
+	}
+	return in
+}
+
+// IsDomainUpToDate checks whether there is a change in any of the modifiable
+// settings of the domain.
+func IsDomainUpToDate(p v1alpha1.DomainParameters, o es.ElasticsearchDomainStatus) bool {
+	in := GenerateUpdateElasticsearchDomainConfigInput(aws.StringValue(o.DomainName), "", p, o)
+	return cmp.Equal(&es.UpdateElasticsearchDomainConfigInput{DomainName: in.DomainName}, in,
+		cmpopts.IgnoreUnexported(es.UpdateElasticsearchDomainConfigInput{}))
+}
+
+// GenerateDomainObservation is used to produce v1alpha1.DomainObservation
+// from es.ElasticsearchDomainStatus.
+func GenerateDomainObservation(o es.ElasticsearchDomainStatus) v1alpha1.DomainObservation {
+	obs := v1alpha1.DomainObservation{
+		ARN:               aws.StringValue(o.ARN),
+		DomainID:          aws.StringValue(o.DomainId),
+		Endpoint:          aws.StringValue(o.Endpoint),
+		VPCEndpoint:       o.Endpoints[vpcEndpointKey],
+		Created:           aws.BoolValue(o.Created),
+		Deleted:           aws.BoolValue(o.Deleted),
+		Processing:        aws.BoolValue(o.Processing),
+		UpgradeProcessing: aws.BoolValue(o.UpgradeProcessing),
+	}
+	if o.VPCOptions != nil {
+		obs.VPCID = aws.StringValue(o.VPCOptions.VPCId)
+	}
+	if s := o.ServiceSoftwareOptions; s != nil {
+		obs.ServiceSoftware = v1alpha1.ServiceSoftwareObservation{
+			CurrentVersion:  aws.StringValue(s.CurrentVersion),
+			NewVersion:      aws.StringValue(s.NewVersion),
+			UpdateAvailable: aws.BoolValue(s.UpdateAvailable),
+			UpdateStatus:    string(s.UpdateStatus),
+		}
+		if s.AutomatedUpdateDate != nil && !s.AutomatedUpdateDate.IsZero() {
+			t := metav1.NewTime(*s.AutomatedUpdateDate)
+			obs.ServiceSoftware.AutomatedUpdateDate = &t
+		}
+	}
+	return obs
+}
+
+// LateInitializeDomain fills the empty fields in *v1alpha1.DomainParameters
+// with the values seen in es.ElasticsearchDomainStatus. Nothing is late
+// initialized while a change is in progress, since the observed settings may
+// not be final.
+func LateInitializeDomain(in *v1alpha1.DomainParameters, o *es.ElasticsearchDomainStatus) { // nolint:gocyclo
+	if o == nil || IsChangeInProgress(*o) {
+		return
+	}
+	in.ElasticsearchVersion = awsclients.LateInitializeStringPtr(in.ElasticsearchVersion, o.ElasticsearchVersion)
+	if c := o.ElasticsearchClusterConfig; c != nil {
+		if in.ClusterConfig == nil {
+			in.ClusterConfig = &v1alpha1.ClusterConfig{InstanceType: string(c.InstanceType)}
+		}
+		cc := in.ClusterConfig
+		cc.InstanceCount = awsclients.LateInitializeInt64Ptr(cc.InstanceCount, c.InstanceCount)
+		cc.DedicatedMasterEnabled = awsclients.LateInitializeBoolPtr(cc.DedicatedMasterEnabled, c.DedicatedMasterEnabled)
+		cc.ZoneAwarenessEnabled = awsclients.LateInitializeBoolPtr(cc.ZoneAwarenessEnabled, c.ZoneAwarenessEnabled)
+		cc.WarmEnabled = awsclients.LateInitializeBoolPtr(cc.WarmEnabled, c.WarmEnabled)
+		if aws.BoolValue(c.DedicatedMasterEnabled) {
+			cc.DedicatedMasterType = awsclients.LateInitializeStringPtr(cc.DedicatedMasterType, enumPtr(string(c.DedicatedMasterType)))
+			cc.DedicatedMasterCount = awsclients.LateInitializeInt64Ptr(cc.DedicatedMasterCount, c.DedicatedMasterCount)
+		}
+		if aws.BoolValue(c.ZoneAwarenessEnabled) && c.ZoneAwarenessConfig != nil {
+			cc.AvailabilityZoneCount = awsclients.LateInitializeInt64Ptr(cc.AvailabilityZoneCount, c.ZoneAwarenessConfig.AvailabilityZoneCount)
+		}
+		if aws.BoolValue(c.WarmEnabled) {
+			cc.WarmType = awsclients.LateInitializeStringPtr(cc.WarmType, enumPtr(string(c.WarmType)))
+			cc.WarmCount = awsclients.LateInitializeInt64Ptr(cc.WarmCount, c.WarmCount)
+		}
+	}
+	if e := o.EBSOptions; e != nil {
+		if in.EBSOptions == nil {
+			in.EBSOptions = &v1alpha1.EBSOptions{EBSEnabled: aws.BoolValue(e.EBSEnabled)}
+		}
+		if in.EBSOptions.EBSEnabled {
+			in.EBSOptions.VolumeType = awsclients.LateInitializeStringPtr(in.EBSOptions.VolumeType, enumPtr(string(e.VolumeType)))
+			in.EBSOptions.VolumeSize = awsclients.LateInitializeInt64Ptr(in.EBSOptions.VolumeSize, e.VolumeSize)
+			in.EBSOptions.IOPS = awsclients.LateInitializeInt64Ptr(in.EBSOptions.IOPS, e.Iops)
+		}
+	}
+	if e := o.DomainEndpointOptions; e != nil {
+		if in.DomainEndpointOptions == nil {
+			in.DomainEndpointOptions = &v1alpha1.DomainEndpointOptions{}
+		}
+		in.DomainEndpointOptions.EnforceHTTPS = awsclients.LateInitializeBoolPtr(in.DomainEndpointOptions.EnforceHTTPS, e.EnforceHTTPS)
+		in.DomainEndpointOptions.TLSSecurityPolicy = awsclients.LateInitializeStringPtr(in.DomainEndpointOptions.TLSSecurityPolicy, enumPtr(string(e.TLSSecurityPolicy)))
+	}
+	if e := o.NodeToNodeEncryptionOptions; e != nil {
+		in.NodeToNodeEncryptionEnabled = awsclients.LateInitializeBoolPtr(in.NodeToNodeEncryptionEnabled, e.Enabled)
+	}
+	if e := o.EncryptionAtRestOptions; e != nil && in.EncryptionAtRestOptions == nil {
+		in.EncryptionAtRestOptions = &v1alpha1.EncryptionAtRestOptions{Enabled: aws.BoolValue(e.Enabled), KMSKeyID: e.KmsKeyId}
+	}
+	if o.SnapshotOptions != nil {
+		in.AutomatedSnapshotStartHour = awsclients.LateInitializeInt64Ptr(in.AutomatedSnapshotStartHour, o.SnapshotOptions.AutomatedSnapshotStartHour)
+	}
+}
+
+// GetMasterUserPassword returns the master user password referenced by the
+// given Domain, if any, and whether it differs from the password in its
+// connection secret.
+func GetMasterUserPassword(ctx context.Context, kube client.Client, cr *v1alpha1.Domain) (pwd string, changed bool, err error) {
+	opts := cr.Spec.ForProvider.AdvancedSecurityOptions
+	if opts == nil || opts.MasterUserPasswordSecretRef == nil {
+		return "", false, nil
+	}
+	ref := opts.MasterUserPasswordSecretRef
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", false, errors.Wrap(err, errGetPasswordSecret)
+	}
+	pwd = string(s.Data[ref.Key])
+
+	if cr.Spec.WriteConnectionSecretToReference != nil {
+		conn := &corev1.Secret{}
+		nn := types.NamespacedName{
+			Name:      cr.Spec.WriteConnectionSecretToReference.Name,
+			Namespace: cr.Spec.WriteConnectionSecretToReference.Namespace,
+		}
+		// The connection secret doesn't exist until the domain is created.
+		if err := kube.Get(ctx, nn, conn); resource.IgnoreNotFound(err) != nil {
+			return "", false, err
+		}
+		changed = pwd != "" && pwd != string(conn.Data[runtimev1alpha1.ResourceCredentialsSecretPasswordKey])
+	}
+	return pwd, changed, nil
+}
+
+// GetDomainConnectionDetails returns the connection details of the given
+// Domain, i.e. its endpoint, port and master user name.
+func GetDomainConnectionDetails(cr v1alpha1.Domain) managed.ConnectionDetails {
+	o := cr.Status.AtProvider
+	endpoint := o.Endpoint
+	if endpoint == "" {
+		endpoint = o.VPCEndpoint
+	}
+	if endpoint == "" {
+		return nil
+	}
+	cd := managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+		runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte(endpointPort),
+	}
+	if opts := cr.Spec.ForProvider.AdvancedSecurityOptions; opts != nil && opts.MasterUserName != nil {
+		cd[runtimev1alpha1.ResourceCredentialsSecretUserKey] = []byte(aws.StringValue(opts.MasterUserName))
+	}
+	return cd
+}
+
+func generateClusterConfig(c *v1alpha1.ClusterConfig) *es.ElasticsearchClusterConfig {
+	if c == nil {
+		return nil
+	}
+	res := &es.ElasticsearchClusterConfig{
+		InstanceType:           es.ESPartitionInstanceType(c.InstanceType),
+		InstanceCount:          c.InstanceCount,
+		DedicatedMasterEnabled: c.DedicatedMasterEnabled,
+		DedicatedMasterType:    es.ESPartitionInstanceType(aws.StringValue(c.DedicatedMasterType)),
+		DedicatedMasterCount:   c.DedicatedMasterCount,
+		ZoneAwarenessEnabled:   c.ZoneAwarenessEnabled,
+		WarmEnabled:            c.WarmEnabled,
+		WarmType:               es.ESWarmPartitionInstanceType(aws.StringValue(c.WarmType)),
+		WarmCount:              c.WarmCount,
+	}
+	if c.AvailabilityZoneCount != nil {
+		res.ZoneAwarenessConfig = &es.ZoneAwarenessConfig{AvailabilityZoneCount: c.AvailabilityZoneCount}
+	}
+	return res
+}
+
+func generateEBSOptions(e *v1alpha1.EBSOptions) *es.EBSOptions {
+	if e == nil {
+		return nil
+	}
+	return &es.EBSOptions{
+		EBSEnabled: aws.Bool(e.EBSEnabled),
+		VolumeType: es.VolumeType(aws.StringValue(e.VolumeType)),
+		VolumeSize: e.VolumeSize,
+		Iops:       e.IOPS,
+	}
+}
+
+func generateVPCOptions(v *v1alpha1.VPCOptions) *es.VPCOptions {
+	if v == nil {
+		return nil
+	}
+	return &es.VPCOptions{
+		SubnetIds:        v.SubnetIDs,
+		SecurityGroupIds: v.SecurityGroupIDs,
+	}
+}
+
+func generateAdvancedSecurityOptions(a *v1alpha1.AdvancedSecurityOptions, password string) *es.AdvancedSecurityOptionsInput {
+	if a == nil {
+		return nil
+	}
+	res := &es.AdvancedSecurityOptionsInput{
+		Enabled:                     aws.Bool(a.Enabled),
+		InternalUserDatabaseEnabled: a.InternalUserDatabaseEnabled,
+	}
+	if a.MasterUserARN != nil || a.MasterUserName != nil || password != "" {
+		res.MasterUserOptions = &es.MasterUserOptions{
+			MasterUserARN:  a.MasterUserARN,
+			MasterUserName: a.MasterUserName,
+		}
+		if password != "" {
+			res.MasterUserOptions.MasterUserPassword = aws.String(password)
+		}
+	}
+	return res
+}
+
+func generateDomainEndpointOptions(d *v1alpha1.DomainEndpointOptions) *es.DomainEndpointOptions {
+	if d == nil {
+		return nil
+	}
+	return &es.DomainEndpointOptions{
+		EnforceHTTPS:      d.EnforceHTTPS,
+		TLSSecurityPolicy: es.TLSSecurityPolicy(aws.StringValue(d.TLSSecurityPolicy)),
+	}
+}
+
+// isClusterConfigUpToDate compares the fields of the desired cluster config
+// that are set with the observed ones.
+func isClusterConfigUpToDate(c v1alpha1.ClusterConfig, o *es.ElasticsearchClusterConfig) bool { // nolint:gocyclo
+	if o == nil {
+		return false
+	}
+	switch {
+	case c.InstanceType != string(o.InstanceType),
+		c.InstanceCount != nil && aws.Int64Value(c.InstanceCount) != aws.Int64Value(o.InstanceCount),
+		c.DedicatedMasterEnabled != nil && aws.BoolValue(c.DedicatedMasterEnabled) != aws.BoolValue(o.DedicatedMasterEnabled),
+		c.DedicatedMasterType != nil && aws.StringValue(c.DedicatedMasterType) != string(o.DedicatedMasterType),
+		c.DedicatedMasterCount != nil && aws.Int64Value(c.DedicatedMasterCount) != aws.Int64Value(o.DedicatedMasterCount),
+		c.ZoneAwarenessEnabled != nil && aws.BoolValue(c.ZoneAwarenessEnabled) != aws.BoolValue(o.ZoneAwarenessEnabled),
+		c.WarmEnabled != nil && aws.BoolValue(c.WarmEnabled) != aws.BoolValue(o.WarmEnabled),
+		c.WarmType != nil && aws.StringValue(c.WarmType) != string(o.WarmType),
+		c.WarmCount != nil && aws.Int64Value(c.WarmCount) != aws.Int64Value(o.WarmCount):
+		return false
+	}
+	if c.AvailabilityZoneCount != nil && (o.ZoneAwarenessConfig == nil || aws.Int64Value(c.AvailabilityZoneCount) != aws.Int64Value(o.ZoneAwarenessConfig.AvailabilityZoneCount)) {
+		return false
+	}
+	return true
+}
+
+func isEBSOptionsUpToDate(e v1alpha1.EBSOptions, o *es.EBSOptions) bool {
+	if o == nil {
+		return false
+	}
+	switch {
+	case e.EBSEnabled != aws.BoolValue(o.EBSEnabled),
+		e.VolumeType != nil && aws.StringValue(e.VolumeType) != string(o.VolumeType),
+		e.VolumeSize != nil && aws.Int64Value(e.VolumeSize) != aws.Int64Value(o.VolumeSize),
+		e.IOPS != nil && aws.Int64Value(e.IOPS) != aws.Int64Value(o.Iops):
+		return false
+	}
+	return true
+}
+
+func isVPCOptionsUpToDate(v v1alpha1.VPCOptions, o *es.VPCDerivedInfo) bool {
+	if o == nil {
+		return false
+	}
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	if len(v.SubnetIDs) != 0 && !cmp.Equal(v.SubnetIDs, o.SubnetIds, sortStrings) {
+		return false
+	}
+	return len(v.SecurityGroupIDs) == 0 || cmp.Equal(v.SecurityGroupIDs, o.SecurityGroupIds, sortStrings)
+}
+
+// isAdvancedSecurityOptionsUpToDate compares the settings of fine-grained
+// access control that are observable. The master user is not returned by
+// AWS, so changes to it are only applied with a changed password.
+func isAdvancedSecurityOptionsUpToDate(a v1alpha1.AdvancedSecurityOptions, o *es.AdvancedSecurityOptions) bool {
+	if o == nil {
+		return !a.Enabled
+	}
+	if a.Enabled != aws.BoolValue(o.Enabled) {
+		return false
+	}
+	return a.InternalUserDatabaseEnabled == nil || aws.BoolValue(a.InternalUserDatabaseEnabled) == aws.BoolValue(o.InternalUserDatabaseEnabled)
+}
+
+func isDomainEndpointOptionsUpToDate(d v1alpha1.DomainEndpointOptions, o *es.DomainEndpointOptions) bool {
+	if o == nil {
+		return false
+	}
+	if d.EnforceHTTPS != nil && aws.BoolValue(d.EnforceHTTPS) != aws.BoolValue(o.EnforceHTTPS) {
+		return false
+	}
+	return d.TLSSecurityPolicy == nil || aws.StringValue(d.TLSSecurityPolicy) == string(o.TLSSecurityPolicy)
+}
+
+// isPolicyUpToDate checks whether the observed policy document is
+// semantically equal to the desired one.
+func isPolicyUpToDate(desired, observed string) bool {
+	var d, o interface{}
+	if err := json.Unmarshal([]byte(desired), &d); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(observed), &o); err != nil {
+		return false
+	}
+	return cmp.Equal(d, o)
+}
+
+// enumPtr returns a pointer to the given enum value, or nil if it is empty.
+func enumPtr(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return aws.String(v)
+}
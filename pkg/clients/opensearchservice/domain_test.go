@@ -0,0 +1,339 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opensearchservice
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	es "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/opensearchservice/v1alpha1"
+)
+
+var (
+	domainName   = "some-domain"
+	instanceType = "r5.large.elasticsearch"
+	policy       = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"es:*"}]}`
+)
+
+func observedDomain() es.ElasticsearchDomainStatus {
+	return es.ElasticsearchDomainStatus{
+		DomainName:           aws.String(domainName),
+		ElasticsearchVersion: aws.String("7.9"),
+		Created:              aws.Bool(true),
+		AccessPolicies:       aws.String(policy),
+		AdvancedOptions:      map[string]string{"rest.action.multi.allow_explicit_index": "true"},
+		ElasticsearchClusterConfig: &es.ElasticsearchClusterConfig{
+			InstanceType:           es.ESPartitionInstanceType(instanceType),
+			InstanceCount:          aws.Int64(2),
+			DedicatedMasterEnabled: aws.Bool(false),
+			ZoneAwarenessEnabled:   aws.Bool(true),
+			ZoneAwarenessConfig:    &es.ZoneAwarenessConfig{AvailabilityZoneCount: aws.Int64(2)},
+			WarmEnabled:            aws.Bool(false),
+		},
+		EBSOptions: &es.EBSOptions{
+			EBSEnabled: aws.Bool(true),
+			VolumeType: es.VolumeTypeGp2,
+			VolumeSize: aws.Int64(10),
+		},
+		VPCOptions: &es.VPCDerivedInfo{
+			SubnetIds:        []string{"subnet-1", "subnet-2"},
+			SecurityGroupIds: []string{"sg-1"},
+			VPCId:            aws.String("vpc-1"),
+		},
+		AdvancedSecurityOptions: &es.AdvancedSecurityOptions{
+			Enabled:                     aws.Bool(true),
+			InternalUserDatabaseEnabled: aws.Bool(true),
+		},
+		DomainEndpointOptions: &es.DomainEndpointOptions{
+			EnforceHTTPS:      aws.Bool(true),
+			TLSSecurityPolicy: es.TLSSecurityPolicyPolicyMinTls10201907,
+		},
+		SnapshotOptions: &es.SnapshotOptions{AutomatedSnapshotStartHour: aws.Int64(0)},
+	}
+}
+
+func TestGenerateUpdateElasticsearchDomainConfigInput(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.DomainParameters
+		password string
+		o        es.ElasticsearchDomainStatus
+		out      *es.UpdateElasticsearchDomainConfigInput
+	}{
+		"NoChange": {
+			p: v1alpha1.DomainParameters{
+				ClusterConfig:   &v1alpha1.ClusterConfig{InstanceType: instanceType, AvailabilityZoneCount: aws.Int64(2)},
+				EBSOptions:      &v1alpha1.EBSOptions{EBSEnabled: true, VolumeSize: aws.Int64(10)},
+				VPCOptions:      &v1alpha1.VPCOptions{SubnetIDs: []string{"subnet-2", "subnet-1"}},
+				AccessPolicies:  aws.String("{\"Statement\":[{\"Action\":\"es:*\",\"Effect\":\"Allow\",\"Principal\":{\"AWS\":\"*\"}}],\"Version\":\"2012-10-17\"}"),
+				AdvancedOptions: map[string]string{"rest.action.multi.allow_explicit_index": "true"},
+				AdvancedSecurityOptions: &v1alpha1.AdvancedSecurityOptions{
+					Enabled:        true,
+					MasterUserName: aws.String("admin"),
+				},
+			},
+			o:   observedDomain(),
+			out: &es.UpdateElasticsearchDomainConfigInput{DomainName: aws.String(domainName)},
+		},
+		"ScaleOut": {
+			p: v1alpha1.DomainParameters{
+				ClusterConfig: &v1alpha1.ClusterConfig{InstanceType: instanceType, InstanceCount: aws.Int64(4)},
+				EBSOptions:    &v1alpha1.EBSOptions{EBSEnabled: true, VolumeSize: aws.Int64(20)},
+			},
+			o: observedDomain(),
+			out: &es.UpdateElasticsearchDomainConfigInput{
+				DomainName: aws.String(domainName),
+				ElasticsearchClusterConfig: &es.ElasticsearchClusterConfig{
+					InstanceType:  es.ESPartitionInstanceType(instanceType),
+					InstanceCount: aws.Int64(4),
+				},
+				EBSOptions: &es.EBSOptions{EBSEnabled: aws.Bool(true), VolumeSize: aws.Int64(20)},
+			},
+		},
+		"AdvancedOptionAdded": {
+			p: v1alpha1.DomainParameters{
+				AdvancedOptions: map[string]string{"indices.fielddata.cache.size": "40"},
+			},
+			o: observedDomain(),
+			out: &es.UpdateElasticsearchDomainConfigInput{
+				DomainName:      aws.String(domainName),
+				AdvancedOptions: map[string]string{"indices.fielddata.cache.size": "40"},
+			},
+		},
+		"MasterUserPasswordChanged": {
+			p: v1alpha1.DomainParameters{
+				AdvancedSecurityOptions: &v1alpha1.AdvancedSecurityOptions{
+					Enabled:        true,
+					MasterUserName: aws.String("admin"),
+				},
+			},
+			password: "new-password",
+			o:        observedDomain(),
+			out: &es.UpdateElasticsearchDomainConfigInput{
+				DomainName: aws.String(domainName),
+				AdvancedSecurityOptions: &es.AdvancedSecurityOptionsInput{
+					Enabled: aws.Bool(true),
+					MasterUserOptions: &es.MasterUserOptions{
+						MasterUserName:     aws.String("admin"),
+						MasterUserPassword: aws.String("new-password"),
+					},
+				},
+			},
+		},
+		"EndpointAndSnapshotChanged": {
+			p: v1alpha1.DomainParameters{
+				DomainEndpointOptions:      &v1alpha1.DomainEndpointOptions{TLSSecurityPolicy: aws.String(string(es.TLSSecurityPolicyPolicyMinTls12201907))},
+				AutomatedSnapshotStartHour: aws.Int64(3),
+			},
+			o: observedDomain(),
+			out: &es.UpdateElasticsearchDomainConfigInput{
+				DomainName:            aws.String(domainName),
+				DomainEndpointOptions: &es.DomainEndpointOptions{TLSSecurityPolicy: es.TLSSecurityPolicyPolicyMinTls12201907},
+				SnapshotOptions:       &es.SnapshotOptions{AutomatedSnapshotStartHour: aws.Int64(3)},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateElasticsearchDomainConfigInput(domainName, tc.password, tc.p, tc.o)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsChangeInProgress(t *testing.T) {
+	cases := map[string]struct {
+		o    es.ElasticsearchDomainStatus
+		want bool
+	}{
+		"Idle": {
+			o: observedDomain(),
+		},
+		"BlueGreenDeployment": {
+			o: func() es.ElasticsearchDomainStatus {
+				o := observedDomain()
+				o.Processing = aws.Bool(true)
+				return o
+			}(),
+			want: true,
+		},
+		"VersionUpgrade": {
+			o: func() es.ElasticsearchDomainStatus {
+				o := observedDomain()
+				o.UpgradeProcessing = aws.Bool(true)
+				return o
+			}(),
+			want: true,
+		},
+		"ServiceSoftwareUpdate": {
+			o: func() es.ElasticsearchDomainStatus {
+				o := observedDomain()
+				o.ServiceSoftwareOptions = &es.ServiceSoftwareOptions{UpdateStatus: es.DeploymentStatusInProgress}
+				return o
+			}(),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsChangeInProgress(tc.o); got != tc.want {
+				t.Errorf("IsChangeInProgress(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsServiceSoftwareUpdateNeeded(t *testing.T) {
+	withSoftware := func(s *es.ServiceSoftwareOptions) es.ElasticsearchDomainStatus {
+		o := observedDomain()
+		o.ServiceSoftwareOptions = s
+		return o
+	}
+	cases := map[string]struct {
+		p    v1alpha1.DomainParameters
+		o    es.ElasticsearchDomainStatus
+		want bool
+	}{
+		"Disabled": {
+			p: v1alpha1.DomainParameters{},
+			o: withSoftware(&es.ServiceSoftwareOptions{UpdateAvailable: aws.Bool(true), UpdateStatus: es.DeploymentStatusEligible}),
+		},
+		"NoUpdate": {
+			p: v1alpha1.DomainParameters{AutoServiceSoftwareUpdate: aws.Bool(true)},
+			o: withSoftware(&es.ServiceSoftwareOptions{UpdateAvailable: aws.Bool(false), UpdateStatus: es.DeploymentStatusCompleted}),
+		},
+		"UpdateAvailable": {
+			p:    v1alpha1.DomainParameters{AutoServiceSoftwareUpdate: aws.Bool(true)},
+			o:    withSoftware(&es.ServiceSoftwareOptions{UpdateAvailable: aws.Bool(true), UpdateStatus: es.DeploymentStatusEligible}),
+			want: true,
+		},
+		"UpdateScheduled": {
+			p: v1alpha1.DomainParameters{AutoServiceSoftwareUpdate: aws.Bool(true)},
+			o: withSoftware(&es.ServiceSoftwareOptions{UpdateAvailable: aws.Bool(true), UpdateStatus: es.DeploymentStatusPendingUpdate}),
+		},
+		"UpdateRunning": {
+			p: v1alpha1.DomainParameters{AutoServiceSoftwareUpdate: aws.Bool(true)},
+			o: withSoftware(&es.ServiceSoftwareOptions{UpdateAvailable: aws.Bool(true), UpdateStatus: es.DeploymentStatusInProgress}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsServiceSoftwareUpdateNeeded(tc.p, tc.o); got != tc.want {
+				t.Errorf("IsServiceSoftwareUpdateNeeded(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLateInitializeDomain(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.DomainParameters
+		o    es.ElasticsearchDomainStatus
+		want v1alpha1.DomainParameters
+	}{
+		"AllEmpty": {
+			in: v1alpha1.DomainParameters{},
+			o:  observedDomain(),
+			want: v1alpha1.DomainParameters{
+				ElasticsearchVersion: aws.String("7.9"),
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					InstanceType:           instanceType,
+					InstanceCount:          aws.Int64(2),
+					DedicatedMasterEnabled: aws.Bool(false),
+					ZoneAwarenessEnabled:   aws.Bool(true),
+					AvailabilityZoneCount:  aws.Int64(2),
+					WarmEnabled:            aws.Bool(false),
+				},
+				EBSOptions: &v1alpha1.EBSOptions{
+					EBSEnabled: true,
+					VolumeType: aws.String("gp2"),
+					VolumeSize: aws.Int64(10),
+				},
+				DomainEndpointOptions: &v1alpha1.DomainEndpointOptions{
+					EnforceHTTPS:      aws.Bool(true),
+					TLSSecurityPolicy: aws.String(string(es.TLSSecurityPolicyPolicyMinTls10201907)),
+				},
+				AutomatedSnapshotStartHour: aws.Int64(0),
+			},
+		},
+		"ChangeInProgress": {
+			in: v1alpha1.DomainParameters{},
+			o: func() es.ElasticsearchDomainStatus {
+				o := observedDomain()
+				o.Processing = aws.Bool(true)
+				return o
+			}(),
+			want: v1alpha1.DomainParameters{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeDomain(&tc.in, &tc.o)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetDomainConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		cr   v1alpha1.Domain
+		want managed.ConnectionDetails
+	}{
+		"NotCreated": {
+			cr: v1alpha1.Domain{},
+		},
+		"Public": {
+			cr: v1alpha1.Domain{
+				Status: v1alpha1.DomainStatus{AtProvider: v1alpha1.DomainObservation{Endpoint: "search-some-domain.es.amazonaws.com"}},
+			},
+			want: managed.ConnectionDetails{
+				runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte("search-some-domain.es.amazonaws.com"),
+				runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte("443"),
+			},
+		},
+		"VPCWithMasterUser": {
+			cr: v1alpha1.Domain{
+				Spec: v1alpha1.DomainSpec{ForProvider: v1alpha1.DomainParameters{
+					AdvancedSecurityOptions: &v1alpha1.AdvancedSecurityOptions{Enabled: true, MasterUserName: aws.String("admin")},
+				}},
+				Status: v1alpha1.DomainStatus{AtProvider: v1alpha1.DomainObservation{VPCEndpoint: "vpc-some-domain.es.amazonaws.com"}},
+			},
+			want: managed.ConnectionDetails{
+				runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte("vpc-some-domain.es.amazonaws.com"),
+				runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte("443"),
+				runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte("admin"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetDomainConnectionDetails(tc.cr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	es "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/opensearchservice"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DomainClient = (*MockDomainClient)(nil)
+
+// MockDomainClient is a type that implements all the methods for DomainClient interface
+type MockDomainClient struct {
+	MockDescribeElasticsearchDomain             func(*es.DescribeElasticsearchDomainInput) es.DescribeElasticsearchDomainRequest
+	MockCreateElasticsearchDomain               func(*es.CreateElasticsearchDomainInput) es.CreateElasticsearchDomainRequest
+	MockUpdateElasticsearchDomainConfig         func(*es.UpdateElasticsearchDomainConfigInput) es.UpdateElasticsearchDomainConfigRequest
+	MockDeleteElasticsearchDomain               func(*es.DeleteElasticsearchDomainInput) es.DeleteElasticsearchDomainRequest
+	MockStartElasticsearchServiceSoftwareUpdate func(*es.StartElasticsearchServiceSoftwareUpdateInput) es.StartElasticsearchServiceSoftwareUpdateRequest
+	MockListTags                                func(*es.ListTagsInput) es.ListTagsRequest
+	MockAddTags                                 func(*es.AddTagsInput) es.AddTagsRequest
+	MockRemoveTags                              func(*es.RemoveTagsInput) es.RemoveTagsRequest
+}
+
+// DescribeElasticsearchDomainRequest mocks DescribeElasticsearchDomainRequest method
+func (m *MockDomainClient) DescribeElasticsearchDomainRequest(input *es.DescribeElasticsearchDomainInput) es.DescribeElasticsearchDomainRequest {
+	return m.MockDescribeElasticsearchDomain(input)
+}
+
+// CreateElasticsearchDomainRequest mocks CreateElasticsearchDomainRequest method
+func (m *MockDomainClient) CreateElasticsearchDomainRequest(input *es.CreateElasticsearchDomainInput) es.CreateElasticsearchDomainRequest {
+	return m.MockCreateElasticsearchDomain(input)
+}
+
+// UpdateElasticsearchDomainConfigRequest mocks UpdateElasticsearchDomainConfigRequest method
+func (m *MockDomainClient) UpdateElasticsearchDomainConfigRequest(input *es.UpdateElasticsearchDomainConfigInput) es.UpdateElasticsearchDomainConfigRequest {
+	return m.MockUpdateElasticsearchDomainConfig(input)
+}
+
+// DeleteElasticsearchDomainRequest mocks DeleteElasticsearchDomainRequest method
+func (m *MockDomainClient) DeleteElasticsearchDomainRequest(input *es.DeleteElasticsearchDomainInput) es.DeleteElasticsearchDomainRequest {
+	return m.MockDeleteElasticsearchDomain(input)
+}
+
+// StartElasticsearchServiceSoftwareUpdateRequest mocks StartElasticsearchServiceSoftwareUpdateRequest method
+func (m *MockDomainClient) StartElasticsearchServiceSoftwareUpdateRequest(input *es.StartElasticsearchServiceSoftwareUpdateInput) es.StartElasticsearchServiceSoftwareUpdateRequest {
+	return m.MockStartElasticsearchServiceSoftwareUpdate(input)
+}
+
+// ListTagsRequest mocks ListTagsRequest method
+func (m *MockDomainClient) ListTagsRequest(input *es.ListTagsInput) es.ListTagsRequest {
+	return m.MockListTags(input)
+}
+
+// AddTagsRequest mocks AddTagsRequest method
+func (m *MockDomainClient) AddTagsRequest(input *es.AddTagsInput) es.AddTagsRequest {
+	return m.MockAddTags(input)
+}
+
+// RemoveTagsRequest mocks RemoveTagsRequest method
+func (m *MockDomainClient) RemoveTagsRequest(input *es.RemoveTagsInput) es.RemoveTagsRequest {
+	return m.MockRemoveTags(input)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/optiongroup"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockOptionGroupClient)(nil)
+
+// MockOptionGroupClient is a type that implements all the methods for Client interface
+type MockOptionGroupClient struct {
+	MockDescribeOptionGroups   func(*rds.DescribeOptionGroupsInput) rds.DescribeOptionGroupsRequest
+	MockCreateOptionGroup      func(*rds.CreateOptionGroupInput) rds.CreateOptionGroupRequest
+	MockModifyOptionGroup      func(*rds.ModifyOptionGroupInput) rds.ModifyOptionGroupRequest
+	MockDeleteOptionGroup      func(*rds.DeleteOptionGroupInput) rds.DeleteOptionGroupRequest
+	MockListTagsForResource    func(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	MockAddTagsToResource      func(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	MockRemoveTagsFromResource func(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// DescribeOptionGroupsRequest mocks DescribeOptionGroupsRequest method
+func (m *MockOptionGroupClient) DescribeOptionGroupsRequest(input *rds.DescribeOptionGroupsInput) rds.DescribeOptionGroupsRequest {
+	return m.MockDescribeOptionGroups(input)
+}
+
+// CreateOptionGroupRequest mocks CreateOptionGroupRequest method
+func (m *MockOptionGroupClient) CreateOptionGroupRequest(input *rds.CreateOptionGroupInput) rds.CreateOptionGroupRequest {
+	return m.MockCreateOptionGroup(input)
+}
+
+// ModifyOptionGroupRequest mocks ModifyOptionGroupRequest method
+func (m *MockOptionGroupClient) ModifyOptionGroupRequest(input *rds.ModifyOptionGroupInput) rds.ModifyOptionGroupRequest {
+	return m.MockModifyOptionGroup(input)
+}
+
+// DeleteOptionGroupRequest mocks DeleteOptionGroupRequest method
+func (m *MockOptionGroupClient) DeleteOptionGroupRequest(input *rds.DeleteOptionGroupInput) rds.DeleteOptionGroupRequest {
+	return m.MockDeleteOptionGroup(input)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockOptionGroupClient) ListTagsForResourceRequest(input *rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest {
+	return m.MockListTagsForResource(input)
+}
+
+// AddTagsToResourceRequest mocks AddTagsToResourceRequest method
+func (m *MockOptionGroupClient) AddTagsToResourceRequest(input *rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest {
+	return m.MockAddTagsToResource(input)
+}
+
+// RemoveTagsFromResourceRequest mocks RemoveTagsFromResourceRequest method
+func (m *MockOptionGroupClient) RemoveTagsFromResourceRequest(input *rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest {
+	return m.MockRemoveTagsFromResource(input)
+}
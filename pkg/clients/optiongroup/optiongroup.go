@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client is the external client used for OptionGroup Custom Resource
+type Client interface {
+	DescribeOptionGroupsRequest(*rds.DescribeOptionGroupsInput) rds.DescribeOptionGroupsRequest
+	CreateOptionGroupRequest(*rds.CreateOptionGroupInput) rds.CreateOptionGroupRequest
+	ModifyOptionGroupRequest(*rds.ModifyOptionGroupInput) rds.ModifyOptionGroupRequest
+	DeleteOptionGroupRequest(*rds.DeleteOptionGroupInput) rds.DeleteOptionGroupRequest
+	ListTagsForResourceRequest(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	AddTagsToResourceRequest(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	RemoveTagsFromResourceRequest(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return rds.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the option group doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == rds.ErrCodeOptionGroupNotFoundFault {
+		return true
+	}
+	return false
+}
+
+// GenerateTags returns the RDS tags of the given tags.
+func GenerateTags(tags []v1beta1.Tag) []rds.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]rds.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = rds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from an RDS resource.
+func DiffTags(desired []v1beta1.Tag, observed []rds.Tag) (add []rds.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, rds.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
+}
+
+// GenerateCreateOptionGroupInput returns the create input of an option group
+// with the given name and parameters. The options can't be set on creation
+// and are added by a subsequent update.
+func GenerateCreateOptionGroupInput(name string, p v1beta1.OptionGroupParameters) *rds.CreateOptionGroupInput {
+	return &rds.CreateOptionGroupInput{
+		OptionGroupName:        aws.String(name),
+		EngineName:             aws.String(p.EngineName),
+		MajorEngineVersion:     aws.String(p.MajorEngineVersion),
+		OptionGroupDescription: aws.String(p.Description),
+		Tags:                   GenerateTags(p.Tags),
+	}
+}
+
+// GenerateObservation is used to produce v1beta1.OptionGroupObservation from
+// rds.OptionGroup.
+func GenerateObservation(o rds.OptionGroup) v1beta1.OptionGroupObservation {
+	return v1beta1.OptionGroupObservation{
+		ARN:   aws.StringValue(o.OptionGroupArn),
+		VPCID: aws.StringValue(o.VpcId),
+	}
+}
+
+// GenerateOptionConfiguration returns the RDS option configuration of the
+// given option.
+func GenerateOptionConfiguration(o v1beta1.OptionConfiguration) rds.OptionConfiguration {
+	res := rds.OptionConfiguration{
+		OptionName:                  aws.String(o.OptionName),
+		OptionVersion:               o.OptionVersion,
+		Port:                        o.Port,
+		VpcSecurityGroupMemberships: o.VPCSecurityGroupMemberships,
+	}
+	for _, s := range o.OptionSettings {
+		res.OptionSettings = append(res.OptionSettings, rds.OptionSetting{Name: aws.String(s.Name), Value: aws.String(s.Value)})
+	}
+	return res
+}
+
+// DiffOptions returns the options that need to be added to or changed in an
+// option group, and the names of the options that need to be removed from
+// it, so that its observed options match the desired ones. Permanent options
+// can't be removed and are never returned for removal.
+func DiffOptions(desired []v1beta1.OptionConfiguration, observed []rds.Option) (include []rds.OptionConfiguration, remove []string) {
+	o := make(map[string]rds.Option, len(observed))
+	for _, opt := range observed {
+		o[aws.StringValue(opt.OptionName)] = opt
+	}
+	d := make(map[string]bool, len(desired))
+	for _, opt := range desired {
+		d[opt.OptionName] = true
+		if obs, ok := o[opt.OptionName]; ok && isOptionUpToDate(opt, obs) {
+			continue
+		}
+		include = append(include, GenerateOptionConfiguration(opt))
+	}
+	for _, opt := range observed {
+		if d[aws.StringValue(opt.OptionName)] || aws.BoolValue(opt.Permanent) {
+			continue
+		}
+		remove = append(remove, aws.StringValue(opt.OptionName))
+	}
+	return include, remove
+}
+
+// isOptionUpToDate returns false if any of the desired settings of an option
+// differs from the observed one. Unset settings are ignored.
+func isOptionUpToDate(d v1beta1.OptionConfiguration, o rds.Option) bool {
+	if d.OptionVersion != nil && aws.StringValue(d.OptionVersion) != aws.StringValue(o.OptionVersion) {
+		return false
+	}
+	if d.Port != nil && aws.Int64Value(d.Port) != aws.Int64Value(o.Port) {
+		return false
+	}
+	if len(d.VPCSecurityGroupMemberships) != 0 {
+		sgs := make([]string, len(o.VpcSecurityGroupMemberships))
+		for i, sg := range o.VpcSecurityGroupMemberships {
+			sgs[i] = aws.StringValue(sg.VpcSecurityGroupId)
+		}
+		if !cmp.Equal(d.VPCSecurityGroupMemberships, sgs, cmpopts.SortSlices(func(a, b string) bool { return a < b })) {
+			return false
+		}
+	}
+	settings := make(map[string]string, len(o.OptionSettings))
+	for _, s := range o.OptionSettings {
+		settings[aws.StringValue(s.Name)] = aws.StringValue(s.Value)
+	}
+	for _, s := range d.OptionSettings {
+		if settings[s.Name] != s.Value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+)
+
+func memcached() rds.Option {
+	return rds.Option{
+		OptionName: aws.String("MEMCACHED"),
+		Port:       aws.Int64(11211),
+		OptionSettings: []rds.OptionSetting{
+			{Name: aws.String("BACKLOG_QUEUE_LIMIT"), Value: aws.String("1024")},
+			{Name: aws.String("CHUNK_SIZE"), Value: aws.String("48")},
+		},
+		VpcSecurityGroupMemberships: []rds.VpcSecurityGroupMembership{
+			{VpcSecurityGroupId: aws.String("sg-1")},
+		},
+	}
+}
+
+func TestDiffOptions(t *testing.T) {
+	type want struct {
+		include []rds.OptionConfiguration
+		remove  []string
+	}
+	cases := map[string]struct {
+		desired  []v1beta1.OptionConfiguration
+		observed []rds.Option
+		want
+	}{
+		"UpToDate": {
+			desired: []v1beta1.OptionConfiguration{{
+				OptionName:                  "MEMCACHED",
+				OptionSettings:              []v1beta1.OptionSetting{{Name: "CHUNK_SIZE", Value: "48"}},
+				VPCSecurityGroupMemberships: []string{"sg-1"},
+			}},
+			observed: []rds.Option{memcached()},
+		},
+		"SettingChanged": {
+			desired: []v1beta1.OptionConfiguration{{
+				OptionName:     "MEMCACHED",
+				OptionSettings: []v1beta1.OptionSetting{{Name: "CHUNK_SIZE", Value: "96"}},
+			}},
+			observed: []rds.Option{memcached()},
+			want: want{
+				include: []rds.OptionConfiguration{{
+					OptionName:     aws.String("MEMCACHED"),
+					OptionSettings: []rds.OptionSetting{{Name: aws.String("CHUNK_SIZE"), Value: aws.String("96")}},
+				}},
+			},
+		},
+		"AddAndRemove": {
+			desired: []v1beta1.OptionConfiguration{{
+				OptionName: "MARIADB_AUDIT_PLUGIN",
+			}},
+			observed: []rds.Option{memcached(), {OptionName: aws.String("TDE"), Permanent: aws.Bool(true)}},
+			want: want{
+				include: []rds.OptionConfiguration{{OptionName: aws.String("MARIADB_AUDIT_PLUGIN")}},
+				remove:  []string{"MEMCACHED"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			include, remove := DiffOptions(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.include, include); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// createAccountRequestIDPrefix is the prefix of the IDs of the requests
+	// that create accounts.
+	createAccountRequestIDPrefix = "car-"
+
+	errNoParent = "no parent found for %s"
+)
+
+// AccountClient is the external client used for Account Custom Resource
+type AccountClient interface {
+	CreateAccountRequest(*organizations.CreateAccountInput) organizations.CreateAccountRequest
+	DescribeCreateAccountStatusRequest(*organizations.DescribeCreateAccountStatusInput) organizations.DescribeCreateAccountStatusRequest
+	DescribeAccountRequest(*organizations.DescribeAccountInput) organizations.DescribeAccountRequest
+	ListParentsRequest(*organizations.ListParentsInput) organizations.ListParentsRequest
+	MoveAccountRequest(*organizations.MoveAccountInput) organizations.MoveAccountRequest
+	RemoveAccountFromOrganizationRequest(*organizations.RemoveAccountFromOrganizationInput) organizations.RemoveAccountFromOrganizationRequest
+}
+
+// NewAccountClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewAccountClient(cfg aws.Config) AccountClient {
+	return organizations.New(cfg)
+}
+
+// IsAccountNotFound returns true if the error is because the account doesn't
+// exist.
+func IsAccountNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == organizations.ErrCodeAccountNotFoundException
+}
+
+// IsCreateAccountRequestID returns true if the given ID is the ID of a request
+// that creates an account rather than the ID of an account.
+func IsCreateAccountRequestID(id string) bool {
+	return strings.HasPrefix(id, createAccountRequestIDPrefix)
+}
+
+// parentLister lists the parents of accounts and organizational units.
+type parentLister interface {
+	ListParentsRequest(*organizations.ListParentsInput) organizations.ListParentsRequest
+}
+
+// GetParentID returns the ID of the root or organizational unit that
+// contains the account or organizational unit with the given ID.
+func GetParentID(ctx context.Context, c parentLister, id string) (string, error) {
+	rsp, err := c.ListParentsRequest(&organizations.ListParentsInput{ChildId: aws.String(id)}).Send(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(rsp.Parents) == 0 {
+		return "", errors.Errorf(errNoParent, id)
+	}
+	return aws.StringValue(rsp.Parents[0].Id), nil
+}
+
+// GenerateCreateAccountInput returns the create input of the account with the
+// given parameters.
+func GenerateCreateAccountInput(p v1alpha1.AccountParameters) *organizations.CreateAccountInput {
+	return &organizations.CreateAccountInput{
+		AccountName:            aws.String(p.Name),
+		Email:                  aws.String(p.Email),
+		RoleName:               p.RoleName,
+		IamUserAccessToBilling: organizations.IAMUserAccessToBilling(aws.StringValue(p.IAMUserAccessToBilling)),
+	}
+}
+
+// GenerateCreateAccountObservation returns the observation of an account
+// that is being created by the given request.
+func GenerateCreateAccountObservation(o organizations.CreateAccountStatus) v1alpha1.AccountObservation {
+	return v1alpha1.AccountObservation{
+		CreateAccountState: string(o.State),
+		FailureReason:      string(o.FailureReason),
+	}
+}
+
+// GenerateAccountObservation returns the observation of the given account.
+func GenerateAccountObservation(o organizations.Account) v1alpha1.AccountObservation {
+	return v1alpha1.AccountObservation{
+		ARN:                aws.StringValue(o.Arn),
+		Status:             string(o.Status),
+		JoinedMethod:       string(o.JoinedMethod),
+		CreateAccountState: string(organizations.CreateAccountStateSucceeded),
+	}
+}
+
+// LateInitializeAccount fills the empty fields of the given parameters with
+// the observed parent of the account.
+func LateInitializeAccount(in *v1alpha1.AccountParameters, parentID *string) {
+	in.ParentID = awsclients.LateInitializeStringPtr(in.ParentID, parentID)
+}
+
+// IsAccountUpToDate returns whether the account is in the desired parent.
+func IsAccountUpToDate(p v1alpha1.AccountParameters, parentID string) bool {
+	return p.ParentID == nil || *p.ParentID == parentID
+}
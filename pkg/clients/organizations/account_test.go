@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+)
+
+func TestIsCreateAccountRequestID(t *testing.T) {
+	cases := map[string]struct {
+		id   string
+		want bool
+	}{
+		"RequestID": {
+			id:   "car-0123456789abcdef0123456789abcdef",
+			want: true,
+		},
+		"AccountID": {
+			id:   "123456789012",
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsCreateAccountRequestID(tc.id)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAccountUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p      v1alpha1.AccountParameters
+		parent string
+		want   bool
+	}{
+		"SameParent": {
+			p:      v1alpha1.AccountParameters{ParentID: aws.String("ou-ab12-cdef3456")},
+			parent: "ou-ab12-cdef3456",
+			want:   true,
+		},
+		"NoParent": {
+			p:      v1alpha1.AccountParameters{},
+			parent: "r-ab12",
+			want:   true,
+		},
+		"DifferentParent": {
+			p:      v1alpha1.AccountParameters{ParentID: aws.String("ou-ab12-cdef3456")},
+			parent: "r-ab12",
+			want:   false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsAccountUpToDate(tc.p, tc.parent)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/organizations"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AccountClient = (*MockAccountClient)(nil)
+
+// MockAccountClient is a type that implements all the methods for AccountClient interface
+type MockAccountClient struct {
+	MockCreateAccount                 func(*organizations.CreateAccountInput) organizations.CreateAccountRequest
+	MockDescribeCreateAccountStatus   func(*organizations.DescribeCreateAccountStatusInput) organizations.DescribeCreateAccountStatusRequest
+	MockDescribeAccount               func(*organizations.DescribeAccountInput) organizations.DescribeAccountRequest
+	MockListParents                   func(*organizations.ListParentsInput) organizations.ListParentsRequest
+	MockMoveAccount                   func(*organizations.MoveAccountInput) organizations.MoveAccountRequest
+	MockRemoveAccountFromOrganization func(*organizations.RemoveAccountFromOrganizationInput) organizations.RemoveAccountFromOrganizationRequest
+}
+
+// CreateAccountRequest mocks CreateAccountRequest method
+func (m *MockAccountClient) CreateAccountRequest(input *organizations.CreateAccountInput) organizations.CreateAccountRequest {
+	return m.MockCreateAccount(input)
+}
+
+// DescribeCreateAccountStatusRequest mocks DescribeCreateAccountStatusRequest method
+func (m *MockAccountClient) DescribeCreateAccountStatusRequest(input *organizations.DescribeCreateAccountStatusInput) organizations.DescribeCreateAccountStatusRequest {
+	return m.MockDescribeCreateAccountStatus(input)
+}
+
+// DescribeAccountRequest mocks DescribeAccountRequest method
+func (m *MockAccountClient) DescribeAccountRequest(input *organizations.DescribeAccountInput) organizations.DescribeAccountRequest {
+	return m.MockDescribeAccount(input)
+}
+
+// ListParentsRequest mocks ListParentsRequest method
+func (m *MockAccountClient) ListParentsRequest(input *organizations.ListParentsInput) organizations.ListParentsRequest {
+	return m.MockListParents(input)
+}
+
+// MoveAccountRequest mocks MoveAccountRequest method
+func (m *MockAccountClient) MoveAccountRequest(input *organizations.MoveAccountInput) organizations.MoveAccountRequest {
+	return m.MockMoveAccount(input)
+}
+
+// RemoveAccountFromOrganizationRequest mocks RemoveAccountFromOrganizationRequest method
+func (m *MockAccountClient) RemoveAccountFromOrganizationRequest(input *organizations.RemoveAccountFromOrganizationInput) organizations.RemoveAccountFromOrganizationRequest {
+	return m.MockRemoveAccountFromOrganization(input)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/organizations"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.OrganizationalUnitClient = (*MockOrganizationalUnitClient)(nil)
+
+// MockOrganizationalUnitClient is a type that implements all the methods for OrganizationalUnitClient interface
+type MockOrganizationalUnitClient struct {
+	MockCreateOrganizationalUnit   func(*organizations.CreateOrganizationalUnitInput) organizations.CreateOrganizationalUnitRequest
+	MockDescribeOrganizationalUnit func(*organizations.DescribeOrganizationalUnitInput) organizations.DescribeOrganizationalUnitRequest
+	MockUpdateOrganizationalUnit   func(*organizations.UpdateOrganizationalUnitInput) organizations.UpdateOrganizationalUnitRequest
+	MockDeleteOrganizationalUnit   func(*organizations.DeleteOrganizationalUnitInput) organizations.DeleteOrganizationalUnitRequest
+	MockListParents                func(*organizations.ListParentsInput) organizations.ListParentsRequest
+}
+
+// CreateOrganizationalUnitRequest mocks CreateOrganizationalUnitRequest method
+func (m *MockOrganizationalUnitClient) CreateOrganizationalUnitRequest(input *organizations.CreateOrganizationalUnitInput) organizations.CreateOrganizationalUnitRequest {
+	return m.MockCreateOrganizationalUnit(input)
+}
+
+// DescribeOrganizationalUnitRequest mocks DescribeOrganizationalUnitRequest method
+func (m *MockOrganizationalUnitClient) DescribeOrganizationalUnitRequest(input *organizations.DescribeOrganizationalUnitInput) organizations.DescribeOrganizationalUnitRequest {
+	return m.MockDescribeOrganizationalUnit(input)
+}
+
+// UpdateOrganizationalUnitRequest mocks UpdateOrganizationalUnitRequest method
+func (m *MockOrganizationalUnitClient) UpdateOrganizationalUnitRequest(input *organizations.UpdateOrganizationalUnitInput) organizations.UpdateOrganizationalUnitRequest {
+	return m.MockUpdateOrganizationalUnit(input)
+}
+
+// DeleteOrganizationalUnitRequest mocks DeleteOrganizationalUnitRequest method
+func (m *MockOrganizationalUnitClient) DeleteOrganizationalUnitRequest(input *organizations.DeleteOrganizationalUnitInput) organizations.DeleteOrganizationalUnitRequest {
+	return m.MockDeleteOrganizationalUnit(input)
+}
+
+// ListParentsRequest mocks ListParentsRequest method
+func (m *MockOrganizationalUnitClient) ListParentsRequest(input *organizations.ListParentsInput) organizations.ListParentsRequest {
+	return m.MockListParents(input)
+}
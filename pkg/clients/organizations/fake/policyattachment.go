@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/organizations"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.PolicyAttachmentClient = (*MockPolicyAttachmentClient)(nil)
+
+// MockPolicyAttachmentClient is a type that implements all the methods for PolicyAttachmentClient interface
+type MockPolicyAttachmentClient struct {
+	MockAttachPolicy         func(*organizations.AttachPolicyInput) organizations.AttachPolicyRequest
+	MockDetachPolicy         func(*organizations.DetachPolicyInput) organizations.DetachPolicyRequest
+	MockListTargetsForPolicy func(*organizations.ListTargetsForPolicyInput) organizations.ListTargetsForPolicyRequest
+}
+
+// AttachPolicyRequest mocks AttachPolicyRequest method
+func (m *MockPolicyAttachmentClient) AttachPolicyRequest(input *organizations.AttachPolicyInput) organizations.AttachPolicyRequest {
+	return m.MockAttachPolicy(input)
+}
+
+// DetachPolicyRequest mocks DetachPolicyRequest method
+func (m *MockPolicyAttachmentClient) DetachPolicyRequest(input *organizations.DetachPolicyInput) organizations.DetachPolicyRequest {
+	return m.MockDetachPolicy(input)
+}
+
+// ListTargetsForPolicyRequest mocks ListTargetsForPolicyRequest method
+func (m *MockPolicyAttachmentClient) ListTargetsForPolicyRequest(input *organizations.ListTargetsForPolicyInput) organizations.ListTargetsForPolicyRequest {
+	return m.MockListTargetsForPolicy(input)
+}
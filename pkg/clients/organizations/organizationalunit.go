@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+)
+
+// OrganizationalUnitClient is the external client used for
+// OrganizationalUnit Custom Resource
+type OrganizationalUnitClient interface {
+	CreateOrganizationalUnitRequest(*organizations.CreateOrganizationalUnitInput) organizations.CreateOrganizationalUnitRequest
+	DescribeOrganizationalUnitRequest(*organizations.DescribeOrganizationalUnitInput) organizations.DescribeOrganizationalUnitRequest
+	UpdateOrganizationalUnitRequest(*organizations.UpdateOrganizationalUnitInput) organizations.UpdateOrganizationalUnitRequest
+	DeleteOrganizationalUnitRequest(*organizations.DeleteOrganizationalUnitInput) organizations.DeleteOrganizationalUnitRequest
+	ListParentsRequest(*organizations.ListParentsInput) organizations.ListParentsRequest
+}
+
+// NewOrganizationalUnitClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewOrganizationalUnitClient(cfg aws.Config) OrganizationalUnitClient {
+	return organizations.New(cfg)
+}
+
+// IsOrganizationalUnitNotFound returns true if the error is because the
+// organizational unit doesn't exist.
+func IsOrganizationalUnitNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == organizations.ErrCodeOrganizationalUnitNotFoundException
+}
+
+// GenerateCreateOrganizationalUnitInput returns the create input of the
+// organizational unit with the given parameters.
+func GenerateCreateOrganizationalUnitInput(p v1alpha1.OrganizationalUnitParameters) *organizations.CreateOrganizationalUnitInput {
+	return &organizations.CreateOrganizationalUnitInput{
+		Name:     aws.String(p.Name),
+		ParentId: p.ParentID,
+	}
+}
+
+// GenerateOrganizationalUnitObservation returns the observation of the given
+// organizational unit.
+func GenerateOrganizationalUnitObservation(o organizations.OrganizationalUnit) v1alpha1.OrganizationalUnitObservation {
+	return v1alpha1.OrganizationalUnitObservation{
+		ARN: aws.StringValue(o.Arn),
+	}
+}
+
+// IsOrganizationalUnitUpToDate returns whether the observed organizational
+// unit is up to date with the given parameters.
+func IsOrganizationalUnitUpToDate(p v1alpha1.OrganizationalUnitParameters, o organizations.OrganizationalUnit) bool {
+	return p.Name == aws.StringValue(o.Name)
+}
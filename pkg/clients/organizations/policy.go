@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// PolicyClient is the external client used for Policy Custom Resource
+type PolicyClient interface {
+	CreatePolicyRequest(*organizations.CreatePolicyInput) organizations.CreatePolicyRequest
+	DescribePolicyRequest(*organizations.DescribePolicyInput) organizations.DescribePolicyRequest
+	UpdatePolicyRequest(*organizations.UpdatePolicyInput) organizations.UpdatePolicyRequest
+	DeletePolicyRequest(*organizations.DeletePolicyInput) organizations.DeletePolicyRequest
+}
+
+// NewPolicyClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewPolicyClient(cfg aws.Config) PolicyClient {
+	return organizations.New(cfg)
+}
+
+// IsPolicyNotFound returns true if the error is because the policy doesn't
+// exist.
+func IsPolicyNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == organizations.ErrCodePolicyNotFoundException
+}
+
+// GenerateCreatePolicyInput returns the create input of the policy with the
+// given parameters.
+func GenerateCreatePolicyInput(p v1alpha1.PolicyParameters) *organizations.CreatePolicyInput {
+	return &organizations.CreatePolicyInput{
+		Name: aws.String(p.Name),
+		// The description is required, but may be empty.
+		Description: aws.String(aws.StringValue(p.Description)),
+		Type:        organizations.PolicyType(p.Type),
+		Content:     aws.String(p.Content),
+	}
+}
+
+// GenerateUpdatePolicyInput returns the update input of the policy with the
+// given ID and parameters.
+func GenerateUpdatePolicyInput(id string, p v1alpha1.PolicyParameters) *organizations.UpdatePolicyInput {
+	return &organizations.UpdatePolicyInput{
+		PolicyId:    aws.String(id),
+		Name:        aws.String(p.Name),
+		Description: aws.String(aws.StringValue(p.Description)),
+		Content:     aws.String(p.Content),
+	}
+}
+
+// GeneratePolicyObservation returns the observation of the given policy.
+func GeneratePolicyObservation(o organizations.Policy) v1alpha1.PolicyObservation {
+	if o.PolicySummary == nil {
+		return v1alpha1.PolicyObservation{}
+	}
+	return v1alpha1.PolicyObservation{
+		ARN: aws.StringValue(o.PolicySummary.Arn),
+	}
+}
+
+// LateInitializePolicy fills the empty fields of the given parameters with
+// the values of the observed policy.
+func LateInitializePolicy(in *v1alpha1.PolicyParameters, o *organizations.Policy) {
+	if o == nil || o.PolicySummary == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.PolicySummary.Description)
+}
+
+// IsPolicyUpToDate returns whether the observed policy is up to date with the
+// given parameters. The policy documents are compared regardless of their
+// formatting.
+func IsPolicyUpToDate(p v1alpha1.PolicyParameters, o organizations.Policy) (bool, error) {
+	if o.PolicySummary == nil {
+		return false, nil
+	}
+	if p.Name != aws.StringValue(o.PolicySummary.Name) || aws.StringValue(p.Description) != aws.StringValue(o.PolicySummary.Description) {
+		return false, nil
+	}
+	desired, err := awsclients.CompactAndEscapeJSON(p.Content)
+	if err != nil {
+		return false, err
+	}
+	observed, err := awsclients.CompactAndEscapeJSON(aws.StringValue(o.Content))
+	if err != nil {
+		return false, err
+	}
+	return desired == observed, nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+)
+
+var (
+	policyContent          = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*"}]}`
+	policyContentFormatted = `{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Deny", "Action": "s3:*", "Resource": "*"}]
+}`
+)
+
+func TestLateInitializePolicy(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.PolicyParameters
+		observed *organizations.Policy
+		want     v1alpha1.PolicyParameters
+	}{
+		"EmptyDescription": {
+			in:       v1alpha1.PolicyParameters{},
+			observed: &organizations.Policy{PolicySummary: &organizations.PolicySummary{Description: aws.String("observed")}},
+			want:     v1alpha1.PolicyParameters{Description: aws.String("observed")},
+		},
+		"FilledDescription": {
+			in:       v1alpha1.PolicyParameters{Description: aws.String("desired")},
+			observed: &organizations.Policy{PolicySummary: &organizations.PolicySummary{Description: aws.String("observed")}},
+			want:     v1alpha1.PolicyParameters{Description: aws.String("desired")},
+		},
+		"NoObservation": {
+			in:   v1alpha1.PolicyParameters{},
+			want: v1alpha1.PolicyParameters{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializePolicy(&tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPolicyUpToDate(t *testing.T) {
+	summary := &organizations.PolicySummary{Name: aws.String("deny-s3"), Description: aws.String("d")}
+	cases := map[string]struct {
+		p    v1alpha1.PolicyParameters
+		o    organizations.Policy
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.PolicyParameters{Name: "deny-s3", Description: aws.String("d"), Content: policyContentFormatted},
+			o:    organizations.Policy{PolicySummary: summary, Content: aws.String(policyContent)},
+			want: true,
+		},
+		"DifferentName": {
+			p:    v1alpha1.PolicyParameters{Name: "deny-ec2", Description: aws.String("d"), Content: policyContent},
+			o:    organizations.Policy{PolicySummary: summary, Content: aws.String(policyContent)},
+			want: false,
+		},
+		"DifferentContent": {
+			p:    v1alpha1.PolicyParameters{Name: "deny-s3", Description: aws.String("d"), Content: `{"Version":"2012-10-17","Statement":[]}`},
+			o:    organizations.Policy{PolicySummary: summary, Content: aws.String(policyContent)},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsPolicyUpToDate(tc.p, tc.o)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+)
+
+// PolicyAttachmentClient is the external client used for PolicyAttachment
+// Custom Resource
+type PolicyAttachmentClient interface {
+	AttachPolicyRequest(*organizations.AttachPolicyInput) organizations.AttachPolicyRequest
+	DetachPolicyRequest(*organizations.DetachPolicyInput) organizations.DetachPolicyRequest
+	ListTargetsForPolicyRequest(*organizations.ListTargetsForPolicyInput) organizations.ListTargetsForPolicyRequest
+}
+
+// NewPolicyAttachmentClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewPolicyAttachmentClient(cfg aws.Config) PolicyAttachmentClient {
+	return organizations.New(cfg)
+}
+
+// IsPolicyNotAttached returns true if the error is because the policy is not
+// attached to the target.
+func IsPolicyNotAttached(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == organizations.ErrCodePolicyNotAttachedException
+}
+
+// FindPolicyTarget returns the target with the given ID, or nil if the given
+// targets don't contain it.
+func FindPolicyTarget(targets []organizations.PolicyTargetSummary, id string) *organizations.PolicyTargetSummary {
+	for i := range targets {
+		if aws.StringValue(targets[i].TargetId) == id {
+			return &targets[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/endpoints"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AWS partitions.
+const (
+	PartitionAWS      = "aws"
+	PartitionChina    = "aws-cn"
+	PartitionGovCloud = "aws-us-gov"
+	PartitionISO      = "aws-iso"
+	PartitionISOB     = "aws-iso-b"
+)
+
+// unavailableAPIGroups are the API groups of the managed resources whose
+// services are not available in a partition. Controllers of these groups are
+// wrapped with WithPartitionGate.
+var unavailableAPIGroups = map[string]map[string]bool{
+	PartitionChina: {
+		"acmpca.aws.crossplane.io":    true,
+		"guardduty.aws.crossplane.io": true,
+		"wafv2.aws.crossplane.io":     true,
+	},
+	PartitionGovCloud: {
+		"wafv2.aws.crossplane.io": true,
+	},
+	PartitionISO: {
+		"acm.aws.crossplane.io":              true,
+		"acmpca.aws.crossplane.io":           true,
+		"appmesh.aws.crossplane.io":          true,
+		"datasync.aws.crossplane.io":         true,
+		"docdb.aws.crossplane.io":            true,
+		"eks.aws.crossplane.io":              true,
+		"fsx.aws.crossplane.io":              true,
+		"guardduty.aws.crossplane.io":        true,
+		"mq.aws.crossplane.io":               true,
+		"neptune.aws.crossplane.io":          true,
+		"sagemaker.aws.crossplane.io":        true,
+		"servicediscovery.aws.crossplane.io": true,
+		"wafv2.aws.crossplane.io":            true,
+	},
+	PartitionISOB: {
+		"acm.aws.crossplane.io":              true,
+		"acmpca.aws.crossplane.io":           true,
+		"appmesh.aws.crossplane.io":          true,
+		"datasync.aws.crossplane.io":         true,
+		"docdb.aws.crossplane.io":            true,
+		"ecr.aws.crossplane.io":              true,
+		"eks.aws.crossplane.io":              true,
+		"fsx.aws.crossplane.io":              true,
+		"guardduty.aws.crossplane.io":        true,
+		"mq.aws.crossplane.io":               true,
+		"neptune.aws.crossplane.io":          true,
+		"sagemaker.aws.crossplane.io":        true,
+		"servicediscovery.aws.crossplane.io": true,
+		"wafv2.aws.crossplane.io":            true,
+	},
+}
+
+// PartitionForRegion returns the partition of the given region. Unknown and
+// empty regions are in the aws partition.
+func PartitionForRegion(region string) string {
+	if region == "" {
+		return PartitionAWS
+	}
+	e, err := endpoints.NewDefaultResolver().ResolveEndpoint("ec2", region)
+	if err != nil || e.PartitionID == "" {
+		return PartitionAWS
+	}
+	return e.PartitionID
+}
+
+// IsAvailableInPartition returns whether the managed resources of the given
+// API group are available in the given partition.
+func IsAvailableInPartition(group, partition string) bool {
+	return !unavailableAPIGroups[partition][group]
+}
+
+// UnsupportedInPartition returns a condition that indicates the kind of a
+// managed resource is not available in the partition of its region.
+func UnsupportedInPartition(kind, partition string) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               runtimev1alpha1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnsupportedInPartition,
+		Message:            fmt.Sprintf("%s is not available in partition %s", kind, partition),
+	}
+}
+
+// WithPartitionGate wraps the given ExternalConnecter of managed resources of
+// the given API group. Managed resources whose region is in a partition the
+// group is not available in are not connected. They are reported as
+// UnsupportedInPartition instead of failing with endpoint errors, and they
+// are deleted without calling AWS since they cannot have been created.
+func WithPartitionGate(c managed.ExternalConnecter, group string) managed.ExternalConnecter {
+	return &partitionGate{ExternalConnecter: c, group: group}
+}
+
+type partitionGate struct {
+	managed.ExternalConnecter
+	group string
+}
+
+func (g *partitionGate) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	p := PartitionForRegion(regionOf(mg))
+	if IsAvailableInPartition(g.group, p) {
+		return g.ExternalConnecter.Connect(ctx, mg)
+	}
+	return &unsupportedExternal{condition: UnsupportedInPartition(kindOf(mg), p)}, nil
+}
+
+type unsupportedExternal struct {
+	condition runtimev1alpha1.Condition
+}
+
+// Observe reports the resource as existing and up to date so that it is not
+// created or updated, unless it is being deleted.
+func (e *unsupportedExternal) Observe(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	mg.SetConditions(e.condition)
+	return managed.ExternalObservation{ResourceExists: !meta.WasDeleted(mg), ResourceUpToDate: true}, nil
+}
+
+func (e *unsupportedExternal) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *unsupportedExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *unsupportedExternal) Delete(_ context.Context, _ resource.Managed) error {
+	return nil
+}
+
+// regionOf returns the spec.forProvider.region of the given managed resource,
+// if any.
+func regionOf(mg resource.Managed) string {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return ""
+	}
+	region, _, _ := unstructured.NestedString(obj, "spec", "forProvider", "region")
+	return region
+}
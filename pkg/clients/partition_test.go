@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+const gatedGroup = "wafv2.aws.crossplane.io"
+
+func TestPartitionForRegion(t *testing.T) {
+	cases := map[string]string{
+		"":               PartitionAWS,
+		"us-east-1":      PartitionAWS,
+		"cn-north-1":     PartitionChina,
+		"us-gov-west-1":  PartitionGovCloud,
+		"us-iso-east-1":  PartitionISO,
+		"us-isob-east-1": PartitionISOB,
+	}
+	for region, want := range cases {
+		t.Run(region, func(t *testing.T) {
+			if diff := cmp.Diff(want, PartitionForRegion(region)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPartitionGate(t *testing.T) {
+	type want struct {
+		connected bool
+		obs       managed.ExternalObservation
+		reason    runtimev1alpha1.ConditionReason
+	}
+
+	cases := map[string]struct {
+		cr   *v1beta1.VPC
+		want want
+	}{
+		"Available": {
+			cr:   vpc(v1beta1.VPCParameters{Region: String("us-east-1")}),
+			want: want{connected: true},
+		},
+		"Unavailable": {
+			cr: vpc(v1beta1.VPCParameters{Region: String("cn-north-1")}),
+			want: want{
+				obs:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				reason: ReasonUnsupportedInPartition,
+			},
+		},
+		"UnavailableDeleted": {
+			cr: func() *v1beta1.VPC {
+				cr := vpc(v1beta1.VPCParameters{Region: String("cn-north-1")})
+				cr.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+				return cr
+			}(),
+			want: want{
+				obs:    managed.ExternalObservation{ResourceUpToDate: true},
+				reason: ReasonUnsupportedInPartition,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			connected := false
+			g := WithPartitionGate(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				connected = true
+				return &managed.ExternalClientFns{}, nil
+			}), gatedGroup)
+
+			e, err := g.Connect(context.Background(), tc.cr)
+			if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Connect(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.connected, connected); diff != "" {
+				t.Errorf("connected: -want, +got:\n%s", diff)
+			}
+			if tc.want.connected {
+				return
+			}
+			obs, err := e.Observe(context.Background(), tc.cr)
+			if err != nil {
+				t.Errorf("Observe(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reason, tc.cr.GetCondition(runtimev1alpha1.TypeReady).Reason); diff != "" {
+				t.Errorf("condition: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/qldb"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/qldb"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LedgerClient = (*MockLedgerClient)(nil)
+
+// MockLedgerClient is a type that implements all the methods for LedgerClient interface
+type MockLedgerClient struct {
+	MockDescribeLedger func(*qldb.DescribeLedgerInput) qldb.DescribeLedgerRequest
+	MockCreateLedger   func(*qldb.CreateLedgerInput) qldb.CreateLedgerRequest
+	MockUpdateLedger   func(*qldb.UpdateLedgerInput) qldb.UpdateLedgerRequest
+	MockDeleteLedger   func(*qldb.DeleteLedgerInput) qldb.DeleteLedgerRequest
+}
+
+// DescribeLedgerRequest mocks DescribeLedgerRequest method
+func (m *MockLedgerClient) DescribeLedgerRequest(input *qldb.DescribeLedgerInput) qldb.DescribeLedgerRequest {
+	return m.MockDescribeLedger(input)
+}
+
+// CreateLedgerRequest mocks CreateLedgerRequest method
+func (m *MockLedgerClient) CreateLedgerRequest(input *qldb.CreateLedgerInput) qldb.CreateLedgerRequest {
+	return m.MockCreateLedger(input)
+}
+
+// UpdateLedgerRequest mocks UpdateLedgerRequest method
+func (m *MockLedgerClient) UpdateLedgerRequest(input *qldb.UpdateLedgerInput) qldb.UpdateLedgerRequest {
+	return m.MockUpdateLedger(input)
+}
+
+// DeleteLedgerRequest mocks DeleteLedgerRequest method
+func (m *MockLedgerClient) DeleteLedgerRequest(input *qldb.DeleteLedgerInput) qldb.DeleteLedgerRequest {
+	return m.MockDeleteLedger(input)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qldb
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/qldb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/qldb/v1alpha1"
+)
+
+// LedgerClient is the external client used for Ledger Custom Resource
+type LedgerClient interface {
+	DescribeLedgerRequest(*qldb.DescribeLedgerInput) qldb.DescribeLedgerRequest
+	CreateLedgerRequest(*qldb.CreateLedgerInput) qldb.CreateLedgerRequest
+	UpdateLedgerRequest(*qldb.UpdateLedgerInput) qldb.UpdateLedgerRequest
+	DeleteLedgerRequest(*qldb.DeleteLedgerInput) qldb.DeleteLedgerRequest
+}
+
+// NewLedgerClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewLedgerClient(cfg aws.Config) LedgerClient {
+	return qldb.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the ledger doesn't exist.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == qldb.ErrCodeResourceNotFoundException
+}
+
+// GenerateCreateLedgerInput returns the input to create a ledger with the
+// given name and parameters.
+func GenerateCreateLedgerInput(name string, p v1alpha1.LedgerParameters) *qldb.CreateLedgerInput {
+	res := &qldb.CreateLedgerInput{
+		Name:               aws.String(name),
+		PermissionsMode:    qldb.PermissionsMode(p.PermissionsMode),
+		DeletionProtection: p.DeletionProtection,
+	}
+	if len(p.Tags) != 0 {
+		res.Tags = make(map[string]string, len(p.Tags))
+		for k, v := range p.Tags {
+			res.Tags[k] = v
+		}
+	}
+	return res
+}
+
+// GenerateLedgerObservation returns the observation of the given ledger.
+func GenerateLedgerObservation(o qldb.DescribeLedgerOutput) v1alpha1.LedgerObservation {
+	res := v1alpha1.LedgerObservation{
+		ARN:   aws.StringValue(o.Arn),
+		State: string(o.State),
+	}
+	if o.CreationDateTime != nil {
+		t := metav1.NewTime(*o.CreationDateTime)
+		res.CreationDateTime = &t
+	}
+	return res
+}
+
+// LateInitializeLedger fills the empty fields of the given parameters with
+// the values of the observed ledger.
+func LateInitializeLedger(p *v1alpha1.LedgerParameters, o qldb.DescribeLedgerOutput) {
+	if p.DeletionProtection == nil {
+		p.DeletionProtection = o.DeletionProtection
+	}
+}
+
+// IsLedgerUpToDate returns whether the observed ledger is up to date with the
+// given parameters.
+func IsLedgerUpToDate(p v1alpha1.LedgerParameters, o qldb.DescribeLedgerOutput) bool {
+	return p.DeletionProtection == nil || aws.BoolValue(p.DeletionProtection) == aws.BoolValue(o.DeletionProtection)
+}
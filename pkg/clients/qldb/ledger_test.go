@@ -0,0 +1,87 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qldb
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qldb"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/qldb/v1alpha1"
+)
+
+func TestLateInitializeLedger(t *testing.T) {
+	cases := map[string]struct {
+		in       *bool
+		observed *bool
+		want     *bool
+	}{
+		"Empty": {
+			observed: aws.Bool(true),
+			want:     aws.Bool(true),
+		},
+		"Set": {
+			in:       aws.Bool(false),
+			observed: aws.Bool(true),
+			want:     aws.Bool(false),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1alpha1.LedgerParameters{DeletionProtection: tc.in}
+			LateInitializeLedger(&p, qldb.DescribeLedgerOutput{DeletionProtection: tc.observed})
+			if diff := cmp.Diff(tc.want, p.DeletionProtection); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLedgerUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  *bool
+		observed *bool
+		want     bool
+	}{
+		"Unset": {
+			observed: aws.Bool(true),
+			want:     true,
+		},
+		"Same": {
+			desired:  aws.Bool(false),
+			observed: aws.Bool(false),
+			want:     true,
+		},
+		"Different": {
+			desired:  aws.Bool(false),
+			observed: aws.Bool(true),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLedgerUpToDate(v1alpha1.LedgerParameters{DeletionProtection: tc.desired}, qldb.DescribeLedgerOutput{DeletionProtection: tc.observed})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
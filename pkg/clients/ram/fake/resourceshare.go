@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ram"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ram"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ResourceShareClient = (*MockResourceShareClient)(nil)
+
+// MockResourceShareClient is a type that implements all the methods for ResourceShareClient interface
+type MockResourceShareClient struct {
+	MockCreateResourceShare          func(*ram.CreateResourceShareInput) ram.CreateResourceShareRequest
+	MockGetResourceShares            func(*ram.GetResourceSharesInput) ram.GetResourceSharesRequest
+	MockUpdateResourceShare          func(*ram.UpdateResourceShareInput) ram.UpdateResourceShareRequest
+	MockDeleteResourceShare          func(*ram.DeleteResourceShareInput) ram.DeleteResourceShareRequest
+	MockGetResourceShareAssociations func(*ram.GetResourceShareAssociationsInput) ram.GetResourceShareAssociationsRequest
+	MockAssociateResourceShare       func(*ram.AssociateResourceShareInput) ram.AssociateResourceShareRequest
+	MockDisassociateResourceShare    func(*ram.DisassociateResourceShareInput) ram.DisassociateResourceShareRequest
+	MockTagResource                  func(*ram.TagResourceInput) ram.TagResourceRequest
+	MockUntagResource                func(*ram.UntagResourceInput) ram.UntagResourceRequest
+}
+
+// CreateResourceShareRequest mocks CreateResourceShareRequest method
+func (m *MockResourceShareClient) CreateResourceShareRequest(input *ram.CreateResourceShareInput) ram.CreateResourceShareRequest {
+	return m.MockCreateResourceShare(input)
+}
+
+// GetResourceSharesRequest mocks GetResourceSharesRequest method
+func (m *MockResourceShareClient) GetResourceSharesRequest(input *ram.GetResourceSharesInput) ram.GetResourceSharesRequest {
+	return m.MockGetResourceShares(input)
+}
+
+// UpdateResourceShareRequest mocks UpdateResourceShareRequest method
+func (m *MockResourceShareClient) UpdateResourceShareRequest(input *ram.UpdateResourceShareInput) ram.UpdateResourceShareRequest {
+	return m.MockUpdateResourceShare(input)
+}
+
+// DeleteResourceShareRequest mocks DeleteResourceShareRequest method
+func (m *MockResourceShareClient) DeleteResourceShareRequest(input *ram.DeleteResourceShareInput) ram.DeleteResourceShareRequest {
+	return m.MockDeleteResourceShare(input)
+}
+
+// GetResourceShareAssociationsRequest mocks GetResourceShareAssociationsRequest method
+func (m *MockResourceShareClient) GetResourceShareAssociationsRequest(input *ram.GetResourceShareAssociationsInput) ram.GetResourceShareAssociationsRequest {
+	return m.MockGetResourceShareAssociations(input)
+}
+
+// AssociateResourceShareRequest mocks AssociateResourceShareRequest method
+func (m *MockResourceShareClient) AssociateResourceShareRequest(input *ram.AssociateResourceShareInput) ram.AssociateResourceShareRequest {
+	return m.MockAssociateResourceShare(input)
+}
+
+// DisassociateResourceShareRequest mocks DisassociateResourceShareRequest method
+func (m *MockResourceShareClient) DisassociateResourceShareRequest(input *ram.DisassociateResourceShareInput) ram.DisassociateResourceShareRequest {
+	return m.MockDisassociateResourceShare(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockResourceShareClient) TagResourceRequest(input *ram.TagResourceInput) ram.TagResourceRequest {
+	return m.MockTagResource(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockResourceShareClient) UntagResourceRequest(input *ram.UntagResourceInput) ram.UntagResourceRequest {
+	return m.MockUntagResource(input)
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ram
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ram"
+
+	"github.com/crossplane/provider-aws/apis/ram/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ResourceShareClient is the external client used for ResourceShare Custom
+// Resource
+type ResourceShareClient interface {
+	CreateResourceShareRequest(*ram.CreateResourceShareInput) ram.CreateResourceShareRequest
+	GetResourceSharesRequest(*ram.GetResourceSharesInput) ram.GetResourceSharesRequest
+	UpdateResourceShareRequest(*ram.UpdateResourceShareInput) ram.UpdateResourceShareRequest
+	DeleteResourceShareRequest(*ram.DeleteResourceShareInput) ram.DeleteResourceShareRequest
+	GetResourceShareAssociationsRequest(*ram.GetResourceShareAssociationsInput) ram.GetResourceShareAssociationsRequest
+	AssociateResourceShareRequest(*ram.AssociateResourceShareInput) ram.AssociateResourceShareRequest
+	DisassociateResourceShareRequest(*ram.DisassociateResourceShareInput) ram.DisassociateResourceShareRequest
+	TagResourceRequest(*ram.TagResourceInput) ram.TagResourceRequest
+	UntagResourceRequest(*ram.UntagResourceInput) ram.UntagResourceRequest
+}
+
+// NewResourceShareClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewResourceShareClient(cfg aws.Config) ResourceShareClient {
+	return ram.New(cfg)
+}
+
+// IsResourceShareNotFound returns true if the error is because the resource
+// share doesn't exist.
+func IsResourceShareNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == ram.ErrCodeUnknownResourceException
+}
+
+// IsResourceShareDeleted returns true if the given resource share is deleted
+// or being deleted.
+func IsResourceShareDeleted(o ram.ResourceShare) bool {
+	return o.Status == ram.ResourceShareStatusDeleting || o.Status == ram.ResourceShareStatusDeleted
+}
+
+// GenerateResourceARNs returns the ARNs of all resources that are shared by
+// the resource share with the given parameters.
+func GenerateResourceARNs(p v1alpha1.ResourceShareParameters) []string {
+	arns := make([]string, 0, len(p.ResourceARNs)+len(p.TransitGatewayARNs)+len(p.SubnetARNs))
+	arns = append(arns, p.ResourceARNs...)
+	arns = append(arns, p.TransitGatewayARNs...)
+	arns = append(arns, p.SubnetARNs...)
+	return arns
+}
+
+// GenerateCreateResourceShareInput returns the create input of the resource
+// share with the given parameters.
+func GenerateCreateResourceShareInput(p v1alpha1.ResourceShareParameters) *ram.CreateResourceShareInput {
+	in := &ram.CreateResourceShareInput{
+		Name:                    aws.String(p.Name),
+		AllowExternalPrincipals: p.AllowExternalPrincipals,
+		Principals:              p.Principals,
+		Tags:                    GenerateTags(p.Tags),
+	}
+	if arns := GenerateResourceARNs(p); len(arns) != 0 {
+		in.ResourceArns = arns
+	}
+	return in
+}
+
+// GenerateResourceShareObservation returns the observation of the given
+// resource share.
+func GenerateResourceShareObservation(o ram.ResourceShare) v1alpha1.ResourceShareObservation {
+	return v1alpha1.ResourceShareObservation{
+		ARN:             aws.StringValue(o.ResourceShareArn),
+		OwningAccountID: aws.StringValue(o.OwningAccountId),
+		Status:          string(o.Status),
+		StatusMessage:   aws.StringValue(o.StatusMessage),
+	}
+}
+
+// LateInitializeResourceShare fills the empty fields of the given parameters
+// with the values of the observed resource share.
+func LateInitializeResourceShare(in *v1alpha1.ResourceShareParameters, o *ram.ResourceShare) {
+	if o == nil {
+		return
+	}
+	in.AllowExternalPrincipals = awsclients.LateInitializeBoolPtr(in.AllowExternalPrincipals, o.AllowExternalPrincipals)
+}
+
+// IsResourceShareUpToDate returns whether the attributes of the observed
+// resource share are up to date with the given parameters.
+func IsResourceShareUpToDate(p v1alpha1.ResourceShareParameters, o ram.ResourceShare) bool {
+	return p.Name == aws.StringValue(o.Name) &&
+		aws.BoolValue(p.AllowExternalPrincipals) == aws.BoolValue(o.AllowExternalPrincipals)
+}
+
+// IsAssociated returns true if the given association is in effect or being
+// put into effect.
+func IsAssociated(a ram.ResourceShareAssociation) bool {
+	return a.Status == ram.ResourceShareAssociationStatusAssociated || a.Status == ram.ResourceShareAssociationStatusAssociating
+}
+
+// DiffAssociations returns the entities that need to be associated with and
+// disassociated from a resource share to match the desired entities.
+func DiffAssociations(desired, observed []string) (associate, disassociate []string) {
+	current := make(map[string]bool, len(observed))
+	for _, e := range observed {
+		current[e] = true
+	}
+	for _, e := range desired {
+		if !current[e] {
+			associate = append(associate, e)
+		}
+		delete(current, e)
+	}
+	for e := range current {
+		disassociate = append(disassociate, e)
+	}
+	sort.Strings(disassociate)
+	return associate, disassociate
+}
+
+// GenerateTags returns the RAM tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) []ram.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]ram.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = ram.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from the observed resource share.
+func DiffTags(desired []v1alpha1.Tag, observed []ram.Tag) (add []ram.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	keys := make([]string, 0, len(addMap))
+	for k := range addMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		add = append(add, ram.Tag{Key: aws.String(k), Value: aws.String(addMap[k])})
+	}
+	sort.Strings(remove)
+	return add, remove
+}
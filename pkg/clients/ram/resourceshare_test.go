@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ram
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ram"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ram/v1alpha1"
+)
+
+var (
+	tgwARN    = "arn:aws:ec2:us-east-1:123456789012:transit-gateway/tgw-0123456789abcdef0"
+	subnetARN = "arn:aws:ec2:us-east-1:123456789012:subnet/subnet-0123456789abcdef0"
+)
+
+func TestGenerateCreateResourceShareInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ResourceShareParameters
+		want *ram.CreateResourceShareInput
+	}{
+		"AllResources": {
+			p: v1alpha1.ResourceShareParameters{
+				Name:               "network",
+				TransitGatewayARNs: []string{tgwARN},
+				SubnetARNs:         []string{subnetARN},
+				Principals:         []string{"123456789012"},
+				Tags:               []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: &ram.CreateResourceShareInput{
+				Name:         aws.String("network"),
+				ResourceArns: []string{tgwARN, subnetARN},
+				Principals:   []string{"123456789012"},
+				Tags:         []ram.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			},
+		},
+		"NoResources": {
+			p: v1alpha1.ResourceShareParameters{
+				Name:                    "network",
+				AllowExternalPrincipals: aws.Bool(false),
+			},
+			want: &ram.CreateResourceShareInput{
+				Name:                    aws.String("network"),
+				AllowExternalPrincipals: aws.Bool(false),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateResourceShareInput(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffAssociations(t *testing.T) {
+	type want struct {
+		associate    []string
+		disassociate []string
+	}
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		want     want
+	}{
+		"Same": {
+			desired:  []string{tgwARN, subnetARN},
+			observed: []string{subnetARN, tgwARN},
+			want:     want{},
+		},
+		"Changed": {
+			desired:  []string{tgwARN},
+			observed: []string{subnetARN},
+			want: want{
+				associate:    []string{tgwARN},
+				disassociate: []string{subnetARN},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			associate, disassociate := DiffAssociations(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.associate, associate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disassociate, disassociate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []ram.Tag
+		remove []string
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []ram.Tag
+		want     want
+	}{
+		"Same": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			observed: []ram.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			want:     want{remove: []string{}},
+		},
+		"Changed": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: "new"}, {Key: "added", Value: "v"}},
+			observed: []ram.Tag{{Key: aws.String("k"), Value: aws.String("old")}, {Key: aws.String("stale"), Value: aws.String("v")}},
+			want: want{
+				add:    []ram.Tag{{Key: aws.String("added"), Value: aws.String("v")}, {Key: aws.String("k"), Value: aws.String("new")}},
+				remove: []string{"k", "stale"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
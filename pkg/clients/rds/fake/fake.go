@@ -27,6 +27,9 @@ type MockRDSClient struct {
 	MockModify   func(*rds.ModifyDBInstanceInput) rds.ModifyDBInstanceRequest
 	MockDelete   func(*rds.DeleteDBInstanceInput) rds.DeleteDBInstanceRequest
 	MockAddTags  func(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+
+	MockRestoreFromSnapshot  func(*rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest
+	MockRestoreToPointInTime func(*rds.RestoreDBInstanceToPointInTimeInput) rds.RestoreDBInstanceToPointInTimeRequest
 }
 
 // DescribeDBInstancesRequest finds RDS Instance by name
@@ -53,3 +56,13 @@ func (m *MockRDSClient) DeleteDBInstanceRequest(i *rds.DeleteDBInstanceInput) rd
 func (m *MockRDSClient) AddTagsToResourceRequest(i *rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest {
 	return m.MockAddTags(i)
 }
+
+// RestoreDBInstanceFromDBSnapshotRequest restores RDS Instance from a DB snapshot
+func (m *MockRDSClient) RestoreDBInstanceFromDBSnapshotRequest(i *rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest {
+	return m.MockRestoreFromSnapshot(i)
+}
+
+// RestoreDBInstanceToPointInTimeRequest restores RDS Instance to a point in time
+func (m *MockRDSClient) RestoreDBInstanceToPointInTimeRequest(i *rds.RestoreDBInstanceToPointInTimeInput) rds.RestoreDBInstanceToPointInTimeRequest {
+	return m.MockRestoreToPointInTime(i)
+}
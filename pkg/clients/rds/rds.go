@@ -51,6 +51,8 @@ type Client interface {
 	ModifyDBInstanceRequest(*rds.ModifyDBInstanceInput) rds.ModifyDBInstanceRequest
 	DeleteDBInstanceRequest(*rds.DeleteDBInstanceInput) rds.DeleteDBInstanceRequest
 	AddTagsToResourceRequest(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	RestoreDBInstanceFromDBSnapshotRequest(*rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest
+	RestoreDBInstanceToPointInTimeRequest(*rds.RestoreDBInstanceToPointInTimeInput) rds.RestoreDBInstanceToPointInTimeRequest
 }
 
 // NewClient creates new RDS RDSClient with provided AWS Configurations/Credentials
@@ -141,6 +143,107 @@ func GenerateCreateDBInstanceInput(name, password string, p *v1beta1.RDSInstance
 	return c
 }
 
+// GenerateRestoreDBInstanceFromDBSnapshotInput returns the input to restore a
+// DB instance with the given name and parameters from the DB snapshot in the
+// restoreFrom parameters. The settings that are not part of a restore, such
+// as the backup settings and the master password, are applied by a subsequent
+// update.
+func GenerateRestoreDBInstanceFromDBSnapshotInput(name string, p *v1beta1.RDSInstanceParameters) *rds.RestoreDBInstanceFromDBSnapshotInput {
+	c := &rds.RestoreDBInstanceFromDBSnapshotInput{
+		DBInstanceIdentifier:            aws.String(name),
+		DBSnapshotIdentifier:            p.RestoreFrom.Snapshot.DBSnapshotIdentifier,
+		AutoMinorVersionUpgrade:         p.AutoMinorVersionUpgrade,
+		AvailabilityZone:                p.AvailabilityZone,
+		CopyTagsToSnapshot:              p.CopyTagsToSnapshot,
+		DBInstanceClass:                 aws.String(p.DBInstanceClass),
+		DBName:                          p.DBName,
+		DBParameterGroupName:            p.DBParameterGroupName,
+		DBSubnetGroupName:               p.DBSubnetGroupName,
+		DeletionProtection:              p.DeletionProtection,
+		Domain:                          p.Domain,
+		DomainIAMRoleName:               p.DomainIAMRoleName,
+		EnableCloudwatchLogsExports:     p.EnableCloudwatchLogsExports,
+		EnableIAMDatabaseAuthentication: p.EnableIAMDatabaseAuthentication,
+		Engine:                          aws.String(p.Engine),
+		Iops:                            awsclients.Int64Address(p.IOPS),
+		LicenseModel:                    p.LicenseModel,
+		MultiAZ:                         p.MultiAZ,
+		OptionGroupName:                 p.OptionGroupName,
+		Port:                            awsclients.Int64Address(p.Port),
+		ProcessorFeatures:               generateProcessorFeatures(p.ProcessorFeatures),
+		PubliclyAccessible:              p.PubliclyAccessible,
+		StorageType:                     p.StorageType,
+		Tags:                            generateTags(p.Tags),
+		UseDefaultProcessorFeatures:     p.UseDefaultProcessorFeatures,
+		VpcSecurityGroupIds:             p.VPCSecurityGroupIDs,
+	}
+	return c
+}
+
+// GenerateRestoreDBInstanceToPointInTimeInput returns the input to restore a
+// DB instance with the given name and parameters to the point in time of the
+// source DB instance in the restoreFrom parameters. The settings that are
+// not part of a restore, such as the backup settings and the master
+// password, are applied by a subsequent update.
+func GenerateRestoreDBInstanceToPointInTimeInput(name string, p *v1beta1.RDSInstanceParameters) *rds.RestoreDBInstanceToPointInTimeInput {
+	pit := p.RestoreFrom.PointInTime
+	c := &rds.RestoreDBInstanceToPointInTimeInput{
+		TargetDBInstanceIdentifier:      aws.String(name),
+		SourceDBInstanceIdentifier:      pit.SourceDBInstanceIdentifier,
+		UseLatestRestorableTime:         pit.UseLatestRestorableTime,
+		AutoMinorVersionUpgrade:         p.AutoMinorVersionUpgrade,
+		AvailabilityZone:                p.AvailabilityZone,
+		CopyTagsToSnapshot:              p.CopyTagsToSnapshot,
+		DBInstanceClass:                 aws.String(p.DBInstanceClass),
+		DBName:                          p.DBName,
+		DBParameterGroupName:            p.DBParameterGroupName,
+		DBSubnetGroupName:               p.DBSubnetGroupName,
+		DeletionProtection:              p.DeletionProtection,
+		Domain:                          p.Domain,
+		DomainIAMRoleName:               p.DomainIAMRoleName,
+		EnableCloudwatchLogsExports:     p.EnableCloudwatchLogsExports,
+		EnableIAMDatabaseAuthentication: p.EnableIAMDatabaseAuthentication,
+		Engine:                          aws.String(p.Engine),
+		Iops:                            awsclients.Int64Address(p.IOPS),
+		LicenseModel:                    p.LicenseModel,
+		MultiAZ:                         p.MultiAZ,
+		OptionGroupName:                 p.OptionGroupName,
+		Port:                            awsclients.Int64Address(p.Port),
+		ProcessorFeatures:               generateProcessorFeatures(p.ProcessorFeatures),
+		PubliclyAccessible:              p.PubliclyAccessible,
+		StorageType:                     p.StorageType,
+		Tags:                            generateTags(p.Tags),
+		UseDefaultProcessorFeatures:     p.UseDefaultProcessorFeatures,
+		VpcSecurityGroupIds:             p.VPCSecurityGroupIDs,
+	}
+	if pit.RestoreTime != nil {
+		c.RestoreTime = &pit.RestoreTime.Time
+	}
+	return c
+}
+
+func generateProcessorFeatures(in []v1beta1.ProcessorFeature) []rds.ProcessorFeature {
+	if len(in) == 0 {
+		return nil
+	}
+	res := make([]rds.ProcessorFeature, len(in))
+	for i, val := range in {
+		res[i] = rds.ProcessorFeature{Name: aws.String(val.Name), Value: aws.String(val.Value)}
+	}
+	return res
+}
+
+func generateTags(in []v1beta1.Tag) []rds.Tag {
+	if len(in) == 0 {
+		return nil
+	}
+	res := make([]rds.Tag, len(in))
+	for i, val := range in {
+		res[i] = rds.Tag{Key: aws.String(val.Key), Value: aws.String(val.Value)}
+	}
+	return res
+}
+
 // CreatePatch creates a *v1beta1.RDSInstanceParameters that has only the changed
 // values between the target *v1beta1.RDSInstanceParameters and the current
 // *rds.DBInstance
@@ -374,7 +477,11 @@ func LateInitialize(in *v1beta1.RDSInstanceParameters, db *rds.DBInstance) { //
 
 	in.AllocatedStorage = awsclients.LateInitializeIntPtr(in.AllocatedStorage, db.AllocatedStorage)
 	in.AutoMinorVersionUpgrade = awsclients.LateInitializeBoolPtr(in.AutoMinorVersionUpgrade, db.AutoMinorVersionUpgrade)
-	in.AvailabilityZone = awsclients.LateInitializeStringPtr(in.AvailabilityZone, db.AvailabilityZone)
+	// The availability zone of a Multi-AZ instance is chosen by AWS and
+	// cannot be set along with multiAZ.
+	if !aws.BoolValue(in.MultiAZ) && !aws.BoolValue(db.MultiAZ) {
+		in.AvailabilityZone = awsclients.LateInitializeStringPtr(in.AvailabilityZone, db.AvailabilityZone)
+	}
 	in.BackupRetentionPeriod = awsclients.LateInitializeIntPtr(in.BackupRetentionPeriod, db.BackupRetentionPeriod)
 	in.CACertificateIdentifier = awsclients.LateInitializeStringPtr(in.CACertificateIdentifier, db.CACertificateIdentifier)
 	in.CharacterSetName = awsclients.LateInitializeStringPtr(in.CharacterSetName, db.CharacterSetName)
@@ -463,9 +570,22 @@ func IsUpToDate(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance,
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "ApplyModificationsImmediately"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "AllowMajorVersionUpgrade"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "MasterPasswordSecretRef"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "RestoreFrom"),
 	) && !pwdChanged, nil
 }
 
+// IsUpdateDisruptive returns true if updating the given RDSInstance changes a
+// field that restarts the DB instance, like its class, engine version, storage
+// type, parameter group, certificate authority or port.
+func IsUpdateDisruptive(r *v1beta1.RDSInstance, db rds.DBInstance) (bool, error) {
+	patch, err := CreatePatch(&db, &r.Spec.ForProvider)
+	if err != nil {
+		return false, err
+	}
+	return patch.DBInstanceClass != "" || patch.EngineVersion != nil || patch.StorageType != nil ||
+		patch.DBParameterGroupName != nil || patch.CACertificateIdentifier != nil || patch.Port != nil, nil
+}
+
 // GetPassword fetches the referenced input password for an RDSInstance CRD and determines whether it has changed or not
 func GetPassword(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance) (newPwd string, changed bool, err error) {
 	if r.Spec.ForProvider.MasterPasswordSecretRef == nil {
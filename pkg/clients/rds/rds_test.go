@@ -329,6 +329,59 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+func TestIsUpdateDisruptive(t *testing.T) {
+	type args struct {
+		db rds.DBInstance
+		p  v1beta1.RDSInstanceParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				db: rds.DBInstance{DBInstanceClass: aws.String("db.t3.small"), AllocatedStorage: aws.Int64(20)},
+				p:  v1beta1.RDSInstanceParameters{DBInstanceClass: "db.t3.small", AllocatedStorage: aws.IntAddress(aws.Int64(20))},
+			},
+			want: false,
+		},
+		"NotDisruptive": {
+			args: args{
+				db: rds.DBInstance{DBInstanceClass: aws.String("db.t3.small"), AllocatedStorage: aws.Int64(20)},
+				p:  v1beta1.RDSInstanceParameters{DBInstanceClass: "db.t3.small", AllocatedStorage: aws.IntAddress(aws.Int64(30))},
+			},
+			want: false,
+		},
+		"InstanceClassChanged": {
+			args: args{
+				db: rds.DBInstance{DBInstanceClass: aws.String("db.t3.small"), AllocatedStorage: aws.Int64(20)},
+				p:  v1beta1.RDSInstanceParameters{DBInstanceClass: "db.t3.large", AllocatedStorage: aws.IntAddress(aws.Int64(20))},
+			},
+			want: true,
+		},
+		"EngineVersionChanged": {
+			args: args{
+				db: rds.DBInstance{DBInstanceClass: aws.String("db.t3.small"), EngineVersion: aws.String("11.8")},
+				p:  v1beta1.RDSInstanceParameters{DBInstanceClass: "db.t3.small", EngineVersion: aws.String("12.4")},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpdateDisruptive(&v1beta1.RDSInstance{Spec: v1beta1.RDSInstanceSpec{ForProvider: tc.args.p}}, tc.args.db)
+			if err != nil {
+				t.Errorf("IsUpdateDisruptive(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGetPassword(t *testing.T) {
 	type args struct {
 		r    v1beta1.RDSInstance
@@ -940,6 +993,16 @@ func TestLateInitialize(t *testing.T) {
 				EngineVersion:       &engine,
 			},
 		},
+		"MultiAZAvailabilityZoneNotSet": {
+			rds: rds.DBInstance{
+				AvailabilityZone: &az,
+				MultiAZ:          &trueFlag,
+			},
+			params: v1beta1.RDSInstanceParameters{},
+			want: v1beta1.RDSInstanceParameters{
+				MultiAZ: &trueFlag,
+			},
+		},
 		"SubnetGroupNameSet": {
 			rds: rds.DBInstance{
 				DBSubnetGroup: &subnetGroup,
@@ -1181,3 +1244,106 @@ func TestGenerateModifyDBInstanceInput(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateRestoreDBInstanceFromDBSnapshotInput(t *testing.T) {
+	snapshotName := "snapshot"
+	cases := map[string]struct {
+		name   string
+		params v1beta1.RDSInstanceParameters
+		want   rds.RestoreDBInstanceFromDBSnapshotInput
+	}{
+		"Snapshot": {
+			name: name,
+			params: v1beta1.RDSInstanceParameters{
+				DBInstanceClass:       instanceClass,
+				Engine:                engine,
+				MasterUsername:        &username,
+				BackupRetentionPeriod: &retention,
+				Port:                  &port,
+				MultiAZ:               &multiAZ,
+				Tags:                  []v1beta1.Tag{{Key: name, Value: value}},
+				RestoreFrom: &v1beta1.RestoreFrom{
+					Snapshot: &v1beta1.SnapshotRestore{DBSnapshotIdentifier: &snapshotName},
+				},
+			},
+			want: rds.RestoreDBInstanceFromDBSnapshotInput{
+				DBInstanceIdentifier: &name,
+				DBSnapshotIdentifier: &snapshotName,
+				DBInstanceClass:      &instanceClass,
+				Engine:               &engine,
+				Port:                 &port64,
+				MultiAZ:              &multiAZ,
+				Tags:                 []rds.Tag{{Key: &name, Value: &value}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRestoreDBInstanceFromDBSnapshotInput(tc.name, &tc.params)
+			if diff := cmp.Diff(&tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRestoreDBInstanceToPointInTimeInput(t *testing.T) {
+	source := "source"
+	restoreTime := metav1.NewTime(time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC))
+	cases := map[string]struct {
+		name   string
+		params v1beta1.RDSInstanceParameters
+		want   rds.RestoreDBInstanceToPointInTimeInput
+	}{
+		"RestoreTime": {
+			name: name,
+			params: v1beta1.RDSInstanceParameters{
+				DBInstanceClass: instanceClass,
+				Engine:          engine,
+				RestoreFrom: &v1beta1.RestoreFrom{
+					PointInTime: &v1beta1.PointInTimeRestore{
+						SourceDBInstanceIdentifier: &source,
+						RestoreTime:                &restoreTime,
+					},
+				},
+			},
+			want: rds.RestoreDBInstanceToPointInTimeInput{
+				TargetDBInstanceIdentifier: &name,
+				SourceDBInstanceIdentifier: &source,
+				RestoreTime:                &restoreTime.Time,
+				DBInstanceClass:            &instanceClass,
+				Engine:                     &engine,
+			},
+		},
+		"UseLatestRestorableTime": {
+			name: name,
+			params: v1beta1.RDSInstanceParameters{
+				DBInstanceClass: instanceClass,
+				Engine:          engine,
+				RestoreFrom: &v1beta1.RestoreFrom{
+					PointInTime: &v1beta1.PointInTimeRestore{
+						SourceDBInstanceIdentifier: &source,
+						UseLatestRestorableTime:    &trueFlag,
+					},
+				},
+			},
+			want: rds.RestoreDBInstanceToPointInTimeInput{
+				TargetDBInstanceIdentifier: &name,
+				SourceDBInstanceIdentifier: &source,
+				UseLatestRestorableTime:    &trueFlag,
+				DBInstanceClass:            &instanceClass,
+				Engine:                     &engine,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRestoreDBInstanceToPointInTimeInput(tc.name, &tc.params)
+			if diff := cmp.Diff(&tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// TypeExternalError is the type of the condition that indicates whether the
+// last call of a managed resource controller to AWS failed. Its reason tells
+// what failed and why, so that it can be matched on without parsing the
+// message of the Synced condition.
+const TypeExternalError runtimev1alpha1.ConditionType = "ExternalError"
+
+// Reasons of the ExternalError condition when observing an external
+// resource failed.
+const (
+	ReasonObserveFailed             runtimev1alpha1.ConditionReason = "ObserveFailed"
+	ReasonObserveFailedThrottled    runtimev1alpha1.ConditionReason = "ObserveFailedThrottled"
+	ReasonObserveFailedAccessDenied runtimev1alpha1.ConditionReason = "ObserveFailedAccessDenied"
+)
+
+// Reasons of the ExternalError condition when creating an external resource
+// failed.
+const (
+	ReasonCreateFailed                 runtimev1alpha1.ConditionReason = "CreateFailed"
+	ReasonCreateFailedThrottled        runtimev1alpha1.ConditionReason = "CreateFailedThrottled"
+	ReasonCreateFailedAccessDenied     runtimev1alpha1.ConditionReason = "CreateFailedAccessDenied"
+	ReasonCreateFailedQuotaExceeded    runtimev1alpha1.ConditionReason = "CreateFailedQuotaExceeded"
+	ReasonCreateFailedInvalidParameter runtimev1alpha1.ConditionReason = "CreateFailedInvalidParameter"
+	ReasonCreateFailedAlreadyExists    runtimev1alpha1.ConditionReason = "CreateFailedAlreadyExists"
+)
+
+// Reasons of the ExternalError condition when updating an external resource
+// failed.
+const (
+	ReasonUpdateFailed                 runtimev1alpha1.ConditionReason = "UpdateFailed"
+	ReasonUpdateFailedThrottled        runtimev1alpha1.ConditionReason = "UpdateFailedThrottled"
+	ReasonUpdateFailedAccessDenied     runtimev1alpha1.ConditionReason = "UpdateFailedAccessDenied"
+	ReasonUpdateFailedQuotaExceeded    runtimev1alpha1.ConditionReason = "UpdateFailedQuotaExceeded"
+	ReasonUpdateFailedInvalidParameter runtimev1alpha1.ConditionReason = "UpdateFailedInvalidParameter"
+	ReasonUpdateRejectedImmutableField runtimev1alpha1.ConditionReason = "UpdateRejectedImmutableField"
+)
+
+// Reasons of the ExternalError condition when deleting an external resource
+// failed.
+const (
+	ReasonDeleteFailed             runtimev1alpha1.ConditionReason = "DeleteFailed"
+	ReasonDeleteFailedThrottled    runtimev1alpha1.ConditionReason = "DeleteFailedThrottled"
+	ReasonDeleteFailedAccessDenied runtimev1alpha1.ConditionReason = "DeleteFailedAccessDenied"
+	ReasonDeletePendingDependency  runtimev1alpha1.ConditionReason = "DeletePendingDependency"
+)
+
+// Reasons of the ExternalError condition that do not depend on the failed
+// operation.
+const (
+	// ReasonAWSInternalError is the reason of any call that failed because
+	// of an error on the AWS side, which is usually transient.
+	ReasonAWSInternalError runtimev1alpha1.ConditionReason = "AWSInternalError"
+	// ReasonNoError is the reason of the ExternalError condition after the
+	// last call to AWS succeeded.
+	ReasonNoError runtimev1alpha1.ConditionReason = "NoError"
+)
+
+// ReasonUnsupportedInPartition is the reason of the Ready condition of
+// managed resources whose kind is not available in the partition of their
+// region.
+const ReasonUnsupportedInPartition runtimev1alpha1.ConditionReason = "UnsupportedInPartition"
+
+// Reasons of the UpdateHeld condition.
+const (
+	ReasonOutsideMaintenanceWindow runtimev1alpha1.ConditionReason = "OutsideMaintenanceWindow"
+	ReasonUpdateNotHeld            runtimev1alpha1.ConditionReason = "NotHeld"
+)
+
+// An Operation is a call of the managed reconciler to an ExternalClient.
+type Operation string
+
+// Operations of an ExternalClient.
+const (
+	OperationObserve Operation = "Observe"
+	OperationCreate  Operation = "Create"
+	OperationUpdate  Operation = "Update"
+	OperationDelete  Operation = "Delete"
+)
+
+// An errorClass groups AWS errors that are handled alike.
+type errorClass int
+
+const (
+	classUnknown errorClass = iota
+	classThrottled
+	classAccessDenied
+	classQuotaExceeded
+	classInvalidParameter
+	classAlreadyExists
+	classImmutableField
+	classPendingDependency
+	classInternal
+)
+
+// errorClasses are the classes of well-known AWS error codes that are shared
+// by many services. Codes that only differ by their resource, like the
+// quota and already exists codes, are matched by classOfCode instead. Note
+// that LimitExceededException is a quota error that retries do not resolve,
+// unlike the RequestLimitExceeded throttling of EC2.
+var errorClasses = map[string]errorClass{
+	"Throttling":                             classThrottled,
+	"ThrottlingException":                    classThrottled,
+	"ThrottledException":                     classThrottled,
+	"RequestThrottled":                       classThrottled,
+	"RequestThrottledException":              classThrottled,
+	"TooManyRequestsException":               classThrottled,
+	"ProvisionedThroughputExceededException": classThrottled,
+	"RequestLimitExceeded":                   classThrottled,
+	"BandwidthLimitExceeded":                 classThrottled,
+	"SlowDown":                               classThrottled,
+	"PriorRequestNotComplete":                classThrottled,
+	"EC2ThrottledException":                  classThrottled,
+
+	"AccessDenied":                classAccessDenied,
+	"AccessDeniedException":       classAccessDenied,
+	"UnauthorizedOperation":       classAccessDenied,
+	"AuthFailure":                 classAccessDenied,
+	"UnrecognizedClientException": classAccessDenied,
+	"InvalidClientTokenId":        classAccessDenied,
+	"ExpiredToken":                classAccessDenied,
+	"ExpiredTokenException":       classAccessDenied,
+	"SignatureDoesNotMatch":       classAccessDenied,
+
+	"ValidationError":                      classInvalidParameter,
+	"ValidationException":                  classInvalidParameter,
+	"InvalidParameter":                     classInvalidParameter,
+	"InvalidParameterException":            classInvalidParameter,
+	"InvalidParameterValue":                classInvalidParameter,
+	"InvalidParameterValueException":       classInvalidParameter,
+	"InvalidParameterCombination":          classInvalidParameter,
+	"InvalidParameterCombinationException": classInvalidParameter,
+	"MissingParameter":                     classInvalidParameter,
+	"InvalidInput":                         classInvalidParameter,
+	"MalformedPolicyDocument":              classInvalidParameter,
+
+	"UnmodifiableEntity":                   classImmutableField,
+	"CannotChangeImmutablePublicKeyFields": classImmutableField,
+
+	"InternalFailure":             classInternal,
+	"InternalError":               classInternal,
+	"InternalErrorException":      classInternal,
+	"InternalServerError":         classInternal,
+	"InternalServerException":     classInternal,
+	"InternalServiceError":        classInternal,
+	"InternalServiceException":    classInternal,
+	"ServiceUnavailable":          classInternal,
+	"ServiceUnavailableException": classInternal,
+	"ServiceFailure":              classInternal,
+	"Unavailable":                 classInternal,
+}
+
+// reasons are the reasons of the error classes of each operation. Errors of
+// classes that are missing for an operation get the generic reason of the
+// operation.
+var reasons = map[Operation]map[errorClass]runtimev1alpha1.ConditionReason{
+	OperationObserve: {
+		classUnknown:      ReasonObserveFailed,
+		classThrottled:    ReasonObserveFailedThrottled,
+		classAccessDenied: ReasonObserveFailedAccessDenied,
+	},
+	OperationCreate: {
+		classUnknown:          ReasonCreateFailed,
+		classThrottled:        ReasonCreateFailedThrottled,
+		classAccessDenied:     ReasonCreateFailedAccessDenied,
+		classQuotaExceeded:    ReasonCreateFailedQuotaExceeded,
+		classInvalidParameter: ReasonCreateFailedInvalidParameter,
+		classAlreadyExists:    ReasonCreateFailedAlreadyExists,
+	},
+	OperationUpdate: {
+		classUnknown:          ReasonUpdateFailed,
+		classThrottled:        ReasonUpdateFailedThrottled,
+		classAccessDenied:     ReasonUpdateFailedAccessDenied,
+		classQuotaExceeded:    ReasonUpdateFailedQuotaExceeded,
+		classInvalidParameter: ReasonUpdateFailedInvalidParameter,
+		classImmutableField:   ReasonUpdateRejectedImmutableField,
+	},
+	OperationDelete: {
+		classUnknown:           ReasonDeleteFailed,
+		classThrottled:         ReasonDeleteFailedThrottled,
+		classAccessDenied:      ReasonDeleteFailedAccessDenied,
+		classPendingDependency: ReasonDeletePendingDependency,
+	},
+}
+
+// classOfCode returns the class of the given AWS error code.
+func classOfCode(code string) errorClass {
+	if c, ok := errorClasses[code]; ok {
+		return c
+	}
+	switch {
+	case strings.Contains(code, "QuotaExceeded"), strings.HasSuffix(code, "LimitExceeded"),
+		strings.HasSuffix(code, "LimitExceededFault"), strings.HasSuffix(code, "LimitExceededException"):
+		return classQuotaExceeded
+	case strings.Contains(code, "AlreadyExists"), strings.HasPrefix(code, "Duplicate"):
+		return classAlreadyExists
+	}
+	return classUnknown
+}
+
+// classOf returns the class of the first AWS error in the cause chain of the
+// given error.
+func classOf(err error) errorClass {
+	if IsDependencyViolation(err) {
+		return classPendingDependency
+	}
+	for err != nil {
+		switch e := err.(type) {
+		case awserr.Error:
+			c := classOfCode(e.Code())
+			if f, ok := err.(awserr.RequestFailure); ok && c == classUnknown && f.StatusCode() >= 500 {
+				return classInternal
+			}
+			return c
+		}
+		c, ok := err.(interface{ Cause() error })
+		if !ok {
+			return classUnknown
+		}
+		err = c.Cause()
+	}
+	return classUnknown
+}
+
+// ReasonFor returns the reason of the ExternalError condition of a managed
+// resource whose given operation failed with the given error.
+func ReasonFor(op Operation, err error) runtimev1alpha1.ConditionReason {
+	c := classOf(err)
+	if c == classInternal {
+		return ReasonAWSInternalError
+	}
+	if r, ok := reasons[op][c]; ok {
+		return r
+	}
+	return reasons[op][classUnknown]
+}
+
+// ExternalError returns a condition that indicates the given operation on the
+// external resource of a managed resource failed with the given error.
+func ExternalError(op Operation, err error) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeExternalError,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonFor(op, err),
+		Message:            err.Error(),
+	}
+}
+
+// NoExternalError returns a condition that indicates the last call to the
+// external resource of a managed resource succeeded.
+func NoExternalError() runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeExternalError,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoError,
+	}
+}
+
+// WithConditionReasons wraps the given ExternalConnecter so that the errors
+// of the external clients it connects are recorded in the ExternalError
+// condition of their managed resources, with a reason from the catalog of
+// this package.
+func WithConditionReasons(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &reasonConnecter{ExternalConnecter: c}
+}
+
+type reasonConnecter struct {
+	managed.ExternalConnecter
+}
+
+func (c *reasonConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &reasonExternal{ExternalClient: e}, nil
+}
+
+type reasonExternal struct {
+	managed.ExternalClient
+}
+
+func setExternalError(mg resource.Managed, op Operation, err error) {
+	if err != nil {
+		mg.SetConditions(ExternalError(op, err))
+		return
+	}
+	mg.SetConditions(NoExternalError())
+}
+
+func (e *reasonExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	setExternalError(mg, OperationObserve, err)
+	return o, err
+}
+
+func (e *reasonExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	setExternalError(mg, OperationCreate, err)
+	return c, err
+}
+
+func (e *reasonExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.ExternalClient.Update(ctx, mg)
+	setExternalError(mg, OperationUpdate, err)
+	return u, err
+}
+
+func (e *reasonExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	err := e.ExternalClient.Delete(ctx, mg)
+	setExternalError(mg, OperationDelete, err)
+	return err
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+)
+
+func TestReasonFor(t *testing.T) {
+	type args struct {
+		op  Operation
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want runtimev1alpha1.ConditionReason
+	}{
+		"Unknown": {
+			args: args{op: OperationObserve, err: errors.New("boom")},
+			want: ReasonObserveFailed,
+		},
+		"ObserveThrottled": {
+			args: args{op: OperationObserve, err: errors.Wrap(awserr.New("Throttling", "Rate exceeded", nil), "cannot describe")},
+			want: ReasonObserveFailedThrottled,
+		},
+		"CreateThrottled": {
+			args: args{op: OperationCreate, err: awserr.New("RequestLimitExceeded", "", nil)},
+			want: ReasonCreateFailedThrottled,
+		},
+		"CreateAccessDenied": {
+			args: args{op: OperationCreate, err: awserr.New("UnauthorizedOperation", "", nil)},
+			want: ReasonCreateFailedAccessDenied,
+		},
+		"CreateQuotaExceeded": {
+			args: args{op: OperationCreate, err: awserr.New("VpcLimitExceeded", "", nil)},
+			want: ReasonCreateFailedQuotaExceeded,
+		},
+		"CreateServiceQuotaExceeded": {
+			args: args{op: OperationCreate, err: awserr.New("ServiceQuotaExceededException", "", nil)},
+			want: ReasonCreateFailedQuotaExceeded,
+		},
+		"CreateLimitExceeded": {
+			args: args{op: OperationCreate, err: awserr.New("LimitExceededException", "", nil)},
+			want: ReasonCreateFailedQuotaExceeded,
+		},
+		"DeleteLimitExceeded": {
+			args: args{op: OperationDelete, err: awserr.New("LimitExceededException", "", nil)},
+			want: ReasonDeleteFailed,
+		},
+		"CreateInvalidParameter": {
+			args: args{op: OperationCreate, err: awserr.New("InvalidParameterValue", "", nil)},
+			want: ReasonCreateFailedInvalidParameter,
+		},
+		"CreateAlreadyExists": {
+			args: args{op: OperationCreate, err: awserr.New("DBInstanceAlreadyExists", "", nil)},
+			want: ReasonCreateFailedAlreadyExists,
+		},
+		"UpdateImmutableField": {
+			args: args{op: OperationUpdate, err: errors.Wrap(awserr.New("CannotChangeImmutablePublicKeyFields", "", nil), "cannot update")},
+			want: ReasonUpdateRejectedImmutableField,
+		},
+		"UpdateUnmodifiableEntity": {
+			args: args{op: OperationUpdate, err: awserr.New("UnmodifiableEntity", "", nil)},
+			want: ReasonUpdateRejectedImmutableField,
+		},
+		"UpdateAlreadyExists": {
+			args: args{op: OperationUpdate, err: awserr.New("EntityAlreadyExists", "", nil)},
+			want: ReasonUpdateFailed,
+		},
+		"DeleteDependencyViolation": {
+			args: args{op: OperationDelete, err: errors.Wrap(errDependency, errDependencyViolation)},
+			want: ReasonDeletePendingDependency,
+		},
+		"DeleteWaitForDependents": {
+			args: args{op: OperationDelete, err: &pendingDependentsError{msg: "waiting"}},
+			want: ReasonDeletePendingDependency,
+		},
+		"InternalError": {
+			args: args{op: OperationDelete, err: awserr.New("InternalFailure", "", nil)},
+			want: ReasonAWSInternalError,
+		},
+		"ServerError": {
+			args: args{op: OperationUpdate, err: awserr.NewRequestFailure(awserr.New("Unknown", "", nil), 503, "id")},
+			want: ReasonAWSInternalError,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ReasonFor(tc.args.op, tc.args.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithConditionReasons(t *testing.T) {
+	type want struct {
+		Status corev1.ConditionStatus
+		Reason runtimev1alpha1.ConditionReason
+	}
+
+	cases := map[string]struct {
+		err  error
+		want want
+	}{
+		"Success": {
+			want: want{Status: corev1.ConditionFalse, Reason: ReasonNoError},
+		},
+		"Failure": {
+			err:  awserr.New("ThrottlingException", "", nil),
+			want: want{Status: corev1.ConditionTrue, Reason: ReasonUpdateFailedThrottled},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := rdsInstance(v1beta1.RDSInstanceParameters{}, nil)
+			c := WithConditionReasons(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &managed.ExternalClientFns{
+					UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+						return managed.ExternalUpdate{}, tc.err
+					},
+				}, nil
+			}))
+
+			e, err := c.Connect(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Connect(...): %s", err)
+			}
+			if _, err := e.Update(context.Background(), cr); err != tc.err {
+				t.Errorf("Update(...): want %v, got %v", tc.err, err)
+			}
+			got := cr.GetCondition(TypeExternalError)
+			if diff := cmp.Diff(tc.want, want{Status: got.Status, Reason: got.Reason}); diff != "" {
+				t.Errorf("condition: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -144,8 +144,7 @@ func IsUpToDate(p v1alpha1.ResourceRecordSetParameters, rrset route53.ResourceRe
 		return false, err
 	}
 	return cmp.Equal(&v1alpha1.ResourceRecordSetParameters{}, patch,
-		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}),
-		cmpopts.IgnoreFields(v1alpha1.ResourceRecordSetParameters{}, "Region")), nil
+		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{})), nil
 }
 
 // LateInitialize fills the empty fields in *v1alpha1.ResourceRecordSetParameters with
@@ -165,6 +164,29 @@ func LateInitialize(in *v1alpha1.ResourceRecordSetParameters, rrSet *route53.Res
 			}
 		}
 	}
+	in.SetIdentifier = awsclients.LateInitializeStringPtr(in.SetIdentifier, rrSet.SetIdentifier)
+	in.Weight = awsclients.LateInitializeInt64Ptr(in.Weight, rrSet.Weight)
+	failover := string(rrSet.Failover)
+	in.Failover = awsclients.LateInitializeString(in.Failover, &failover)
+	region := string(rrSet.Region)
+	in.Region = awsclients.LateInitializeString(in.Region, &region)
+	in.HealthCheckID = awsclients.LateInitializeStringPtr(in.HealthCheckID, rrSet.HealthCheckId)
+	in.MultiValueAnswer = awsclients.LateInitializeBoolPtr(in.MultiValueAnswer, rrSet.MultiValueAnswer)
+	in.TrafficPolicyInstanceID = awsclients.LateInitializeStringPtr(in.TrafficPolicyInstanceID, rrSet.TrafficPolicyInstanceId)
+	if in.AliasTarget == nil && rrSet.AliasTarget != nil {
+		in.AliasTarget = &v1alpha1.AliasTarget{
+			DNSName:              awsclients.StringValue(rrSet.AliasTarget.DNSName),
+			EvaluateTargetHealth: aws.BoolValue(rrSet.AliasTarget.EvaluateTargetHealth),
+			HostedZoneID:         awsclients.StringValue(rrSet.AliasTarget.HostedZoneId),
+		}
+	}
+	if in.GeoLocation == nil && rrSet.GeoLocation != nil {
+		in.GeoLocation = &v1alpha1.GeoLocation{
+			ContinentCode:   rrSet.GeoLocation.ContinentCode,
+			CountryCode:     rrSet.GeoLocation.CountryCode,
+			SubdivisionCode: rrSet.GeoLocation.SubdivisionCode,
+		}
+	}
 }
 
 // CreatePatch creates a *v1beta1.ResourceRecordSetParameters that has only the changed
@@ -178,6 +200,13 @@ func CreatePatch(in *route53.ResourceRecordSet, target *v1alpha1.ResourceRecordS
 	// skip its comparison.
 	currentParams.ZoneID = target.ZoneID
 
+	// Route 53 returns alias DNS names fully qualified and in lower case, so
+	// a target written without the trailing dot shouldn't count as a change.
+	if currentParams.AliasTarget != nil && target.AliasTarget != nil &&
+		strings.EqualFold(strings.TrimSuffix(currentParams.AliasTarget.DNSName, "."), strings.TrimSuffix(target.AliasTarget.DNSName, ".")) {
+		currentParams.AliasTarget.DNSName = target.AliasTarget.DNSName
+	}
+
 	jsonPatch, err := awsclients.CreateJSONPatch(currentParams, target)
 	if err != nil {
 		return nil, err
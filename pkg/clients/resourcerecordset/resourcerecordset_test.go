@@ -19,6 +19,7 @@ package resourcerecordset
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/google/go-cmp/cmp"
 
@@ -74,6 +75,26 @@ func TestCreatePatch(t *testing.T) {
 				},
 			},
 		},
+		"DifferentWeight": {
+			args: args{
+				rrSet: route53.ResourceRecordSet{
+					Name:          &resourceRecordSetName,
+					TTL:           &ttl,
+					SetIdentifier: aws.String("blue"),
+					Weight:        aws.Int64(10),
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					TTL:           &ttl,
+					SetIdentifier: aws.String("blue"),
+					Weight:        aws.Int64(90),
+				},
+			},
+			want: want{
+				patch: &v1alpha1.ResourceRecordSetParameters{
+					Weight: aws.Int64(90),
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -125,6 +146,80 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"SameWeightedRecord": {
+			args: args{
+				rrSet: route53.ResourceRecordSet{
+					Name:          &resourceRecordSetName,
+					TTL:           &ttl,
+					SetIdentifier: aws.String("blue"),
+					Weight:        aws.Int64(10),
+					HealthCheckId: aws.String("hc"),
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					TTL:           &ttl,
+					SetIdentifier: aws.String("blue"),
+					Weight:        aws.Int64(10),
+					HealthCheckID: aws.String("hc"),
+				},
+			},
+			want: true,
+		},
+		"SameFailoverAliasRecord": {
+			args: args{
+				rrSet: route53.ResourceRecordSet{
+					Name:          &resourceRecordSetName,
+					SetIdentifier: aws.String("primary"),
+					Failover:      route53.ResourceRecordSetFailoverPrimary,
+					AliasTarget: &route53.AliasTarget{
+						DNSName:              aws.String("my-lb-1234.us-east-1.elb.amazonaws.com."),
+						EvaluateTargetHealth: aws.Bool(true),
+						HostedZoneId:         aws.String("Z35SXDOTRQ7X7K"),
+					},
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					SetIdentifier: aws.String("primary"),
+					Failover:      "PRIMARY",
+					AliasTarget: &v1alpha1.AliasTarget{
+						DNSName:              "My-LB-1234.us-east-1.elb.amazonaws.com",
+						EvaluateTargetHealth: true,
+						HostedZoneID:         "Z35SXDOTRQ7X7K",
+					},
+				},
+			},
+			want: true,
+		},
+		"DifferentFailover": {
+			args: args{
+				rrSet: route53.ResourceRecordSet{
+					Name:          &resourceRecordSetName,
+					TTL:           &ttl,
+					SetIdentifier: aws.String("primary"),
+					Failover:      route53.ResourceRecordSetFailoverPrimary,
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					TTL:           &ttl,
+					SetIdentifier: aws.String("primary"),
+					Failover:      "SECONDARY",
+				},
+			},
+			want: false,
+		},
+		"DifferentLatencyRegion": {
+			args: args{
+				rrSet: route53.ResourceRecordSet{
+					Name:          &resourceRecordSetName,
+					TTL:           &ttl,
+					SetIdentifier: aws.String("east"),
+					Region:        route53.ResourceRecordSetRegionUsEast1,
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					TTL:           &ttl,
+					SetIdentifier: aws.String("east"),
+					Region:        "us-west-2",
+				},
+			},
+			want: false,
+		},
 		"IgnoresRefs": {
 			args: args{
 				rrSet: route53.ResourceRecordSet{
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/route53resolver"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ResolverEndpointClient = (*MockResolverEndpointClient)(nil)
+
+// MockResolverEndpointClient is a type that implements all the methods for ResolverEndpointClient interface
+type MockResolverEndpointClient struct {
+	MockCreateResolverEndpointRequest                func(*route53resolver.CreateResolverEndpointInput) route53resolver.CreateResolverEndpointRequest
+	MockGetResolverEndpointRequest                   func(*route53resolver.GetResolverEndpointInput) route53resolver.GetResolverEndpointRequest
+	MockUpdateResolverEndpointRequest                func(*route53resolver.UpdateResolverEndpointInput) route53resolver.UpdateResolverEndpointRequest
+	MockDeleteResolverEndpointRequest                func(*route53resolver.DeleteResolverEndpointInput) route53resolver.DeleteResolverEndpointRequest
+	MockListResolverEndpointIpAddressesRequest       func(*route53resolver.ListResolverEndpointIpAddressesInput) route53resolver.ListResolverEndpointIpAddressesRequest
+	MockAssociateResolverEndpointIpAddressRequest    func(*route53resolver.AssociateResolverEndpointIpAddressInput) route53resolver.AssociateResolverEndpointIpAddressRequest
+	MockDisassociateResolverEndpointIpAddressRequest func(*route53resolver.DisassociateResolverEndpointIpAddressInput) route53resolver.DisassociateResolverEndpointIpAddressRequest
+}
+
+// CreateResolverEndpointRequest mocks CreateResolverEndpointRequest method
+func (m *MockResolverEndpointClient) CreateResolverEndpointRequest(input *route53resolver.CreateResolverEndpointInput) route53resolver.CreateResolverEndpointRequest {
+	return m.MockCreateResolverEndpointRequest(input)
+}
+
+// GetResolverEndpointRequest mocks GetResolverEndpointRequest method
+func (m *MockResolverEndpointClient) GetResolverEndpointRequest(input *route53resolver.GetResolverEndpointInput) route53resolver.GetResolverEndpointRequest {
+	return m.MockGetResolverEndpointRequest(input)
+}
+
+// UpdateResolverEndpointRequest mocks UpdateResolverEndpointRequest method
+func (m *MockResolverEndpointClient) UpdateResolverEndpointRequest(input *route53resolver.UpdateResolverEndpointInput) route53resolver.UpdateResolverEndpointRequest {
+	return m.MockUpdateResolverEndpointRequest(input)
+}
+
+// DeleteResolverEndpointRequest mocks DeleteResolverEndpointRequest method
+func (m *MockResolverEndpointClient) DeleteResolverEndpointRequest(input *route53resolver.DeleteResolverEndpointInput) route53resolver.DeleteResolverEndpointRequest {
+	return m.MockDeleteResolverEndpointRequest(input)
+}
+
+// ListResolverEndpointIpAddressesRequest mocks ListResolverEndpointIpAddressesRequest method
+func (m *MockResolverEndpointClient) ListResolverEndpointIpAddressesRequest(input *route53resolver.ListResolverEndpointIpAddressesInput) route53resolver.ListResolverEndpointIpAddressesRequest {
+	return m.MockListResolverEndpointIpAddressesRequest(input)
+}
+
+// AssociateResolverEndpointIpAddressRequest mocks AssociateResolverEndpointIpAddressRequest method
+func (m *MockResolverEndpointClient) AssociateResolverEndpointIpAddressRequest(input *route53resolver.AssociateResolverEndpointIpAddressInput) route53resolver.AssociateResolverEndpointIpAddressRequest {
+	return m.MockAssociateResolverEndpointIpAddressRequest(input)
+}
+
+// DisassociateResolverEndpointIpAddressRequest mocks DisassociateResolverEndpointIpAddressRequest method
+func (m *MockResolverEndpointClient) DisassociateResolverEndpointIpAddressRequest(input *route53resolver.DisassociateResolverEndpointIpAddressInput) route53resolver.DisassociateResolverEndpointIpAddressRequest {
+	return m.MockDisassociateResolverEndpointIpAddressRequest(input)
+}
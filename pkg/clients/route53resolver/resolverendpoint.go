@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route53resolver
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+)
+
+// ResolverEndpointClient is the external client used for ResolverEndpoint
+// Custom Resource
+type ResolverEndpointClient interface {
+	CreateResolverEndpointRequest(*route53resolver.CreateResolverEndpointInput) route53resolver.CreateResolverEndpointRequest
+	GetResolverEndpointRequest(*route53resolver.GetResolverEndpointInput) route53resolver.GetResolverEndpointRequest
+	UpdateResolverEndpointRequest(*route53resolver.UpdateResolverEndpointInput) route53resolver.UpdateResolverEndpointRequest
+	DeleteResolverEndpointRequest(*route53resolver.DeleteResolverEndpointInput) route53resolver.DeleteResolverEndpointRequest
+	ListResolverEndpointIpAddressesRequest(*route53resolver.ListResolverEndpointIpAddressesInput) route53resolver.ListResolverEndpointIpAddressesRequest
+	AssociateResolverEndpointIpAddressRequest(*route53resolver.AssociateResolverEndpointIpAddressInput) route53resolver.AssociateResolverEndpointIpAddressRequest
+	DisassociateResolverEndpointIpAddressRequest(*route53resolver.DisassociateResolverEndpointIpAddressInput) route53resolver.DisassociateResolverEndpointIpAddressRequest
+}
+
+// NewResolverEndpointClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewResolverEndpointClient(cfg aws.Config) ResolverEndpointClient {
+	return route53resolver.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the resource doesn't
+// exist.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == route53resolver.ErrCodeResourceNotFoundException
+}
+
+// GenerateTags returns the Route53 Resolver tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) []route53resolver.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]route53resolver.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = route53resolver.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// GenerateCreateResolverEndpointInput returns the create input of the
+// resolver endpoint with the given parameters. The given request ID makes
+// retried creations idempotent.
+func GenerateCreateResolverEndpointInput(requestID string, p v1alpha1.ResolverEndpointParameters) *route53resolver.CreateResolverEndpointInput {
+	in := &route53resolver.CreateResolverEndpointInput{
+		CreatorRequestId: aws.String(requestID),
+		Direction:        route53resolver.ResolverEndpointDirection(p.Direction),
+		Name:             p.Name,
+		SecurityGroupIds: p.SecurityGroupIDs,
+		Tags:             GenerateTags(p.Tags),
+	}
+	for _, ip := range p.IPAddresses {
+		in.IpAddresses = append(in.IpAddresses, route53resolver.IpAddressRequest{SubnetId: ip.SubnetID, Ip: ip.IP})
+	}
+	return in
+}
+
+// GenerateResolverEndpointObservation returns the observation of the given
+// resolver endpoint.
+func GenerateResolverEndpointObservation(o route53resolver.ResolverEndpoint) v1alpha1.ResolverEndpointObservation {
+	return v1alpha1.ResolverEndpointObservation{
+		ARN:            aws.StringValue(o.Arn),
+		HostVPCID:      aws.StringValue(o.HostVPCId),
+		IPAddressCount: aws.Int64Value(o.IpAddressCount),
+		Status:         string(o.Status),
+		StatusMessage:  aws.StringValue(o.StatusMessage),
+	}
+}
+
+// LateInitializeResolverEndpoint fills the empty fields of the given
+// parameters with the values of the observed resolver endpoint.
+func LateInitializeResolverEndpoint(in *v1alpha1.ResolverEndpointParameters, o route53resolver.ResolverEndpoint) {
+	if in.Name == nil {
+		in.Name = o.Name
+	}
+	if len(in.SecurityGroupIDs) == 0 {
+		in.SecurityGroupIDs = o.SecurityGroupIds
+	}
+}
+
+// IsResolverEndpointUpToDate returns whether the name of the observed
+// resolver endpoint is up to date with the given parameters.
+func IsResolverEndpointUpToDate(p v1alpha1.ResolverEndpointParameters, o route53resolver.ResolverEndpoint) bool {
+	return aws.StringValue(p.Name) == aws.StringValue(o.Name)
+}
+
+// DiffIPAddresses returns the IP addresses that need to be associated with
+// and disassociated from a resolver endpoint to match the desired ones. A
+// desired IP address without an IP matches any observed IP address of its
+// subnet.
+func DiffIPAddresses(desired []v1alpha1.IPAddress, observed []route53resolver.IpAddressResponse) (associate, disassociate []route53resolver.IpAddressUpdate) {
+	matched := make([]bool, len(observed))
+	var unpinned []v1alpha1.IPAddress
+	for _, d := range desired {
+		if d.IP == nil {
+			unpinned = append(unpinned, d)
+			continue
+		}
+		if !matchIPAddress(d, observed, matched) {
+			associate = append(associate, route53resolver.IpAddressUpdate{SubnetId: d.SubnetID, Ip: d.IP})
+		}
+	}
+	for _, d := range unpinned {
+		if !matchIPAddress(d, observed, matched) {
+			associate = append(associate, route53resolver.IpAddressUpdate{SubnetId: d.SubnetID})
+		}
+	}
+	for i, o := range observed {
+		if !matched[i] {
+			disassociate = append(disassociate, route53resolver.IpAddressUpdate{IpId: o.IpId, SubnetId: o.SubnetId, Ip: o.Ip})
+		}
+	}
+	return associate, disassociate
+}
+
+// matchIPAddress marks the first unmatched observed IP address that matches
+// the given desired one and returns whether there was one.
+func matchIPAddress(d v1alpha1.IPAddress, observed []route53resolver.IpAddressResponse, matched []bool) bool {
+	for i, o := range observed {
+		if matched[i] || aws.StringValue(o.SubnetId) != aws.StringValue(d.SubnetID) {
+			continue
+		}
+		if d.IP != nil && aws.StringValue(o.Ip) != *d.IP {
+			continue
+		}
+		matched[i] = true
+		return true
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route53resolver
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+)
+
+var (
+	subnetA = "subnet-a"
+	subnetB = "subnet-b"
+)
+
+func TestDiffIPAddresses(t *testing.T) {
+	type want struct {
+		associate    []route53resolver.IpAddressUpdate
+		disassociate []route53resolver.IpAddressUpdate
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.IPAddress
+		observed []route53resolver.IpAddressResponse
+		want     want
+	}{
+		"UpToDate": {
+			desired: []v1alpha1.IPAddress{
+				{SubnetID: &subnetA},
+				{SubnetID: &subnetB, IP: aws.String("10.0.1.10")},
+			},
+			observed: []route53resolver.IpAddressResponse{
+				{IpId: aws.String("rni-1"), SubnetId: &subnetA, Ip: aws.String("10.0.0.5")},
+				{IpId: aws.String("rni-2"), SubnetId: &subnetB, Ip: aws.String("10.0.1.10")},
+			},
+		},
+		"PinnedMatchedBeforeUnpinned": {
+			desired: []v1alpha1.IPAddress{
+				{SubnetID: &subnetA},
+				{SubnetID: &subnetA, IP: aws.String("10.0.0.5")},
+			},
+			observed: []route53resolver.IpAddressResponse{
+				{IpId: aws.String("rni-1"), SubnetId: &subnetA, Ip: aws.String("10.0.0.5")},
+				{IpId: aws.String("rni-2"), SubnetId: &subnetA, Ip: aws.String("10.0.0.6")},
+			},
+		},
+		"AddAndRemove": {
+			desired: []v1alpha1.IPAddress{
+				{SubnetID: &subnetA},
+				{SubnetID: &subnetB, IP: aws.String("10.0.1.20")},
+			},
+			observed: []route53resolver.IpAddressResponse{
+				{IpId: aws.String("rni-1"), SubnetId: &subnetA, Ip: aws.String("10.0.0.5")},
+				{IpId: aws.String("rni-2"), SubnetId: &subnetB, Ip: aws.String("10.0.1.10")},
+			},
+			want: want{
+				associate: []route53resolver.IpAddressUpdate{
+					{SubnetId: &subnetB, Ip: aws.String("10.0.1.20")},
+				},
+				disassociate: []route53resolver.IpAddressUpdate{
+					{IpId: aws.String("rni-2"), SubnetId: &subnetB, Ip: aws.String("10.0.1.10")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			associate, disassociate := DiffIPAddresses(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.associate, associate, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("associate: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disassociate, disassociate, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("disassociate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
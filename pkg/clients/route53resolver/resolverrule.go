@@ -0,0 +1,143 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route53resolver
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+)
+
+// defaultPort is the port of the target IPs of a resolver rule if none is
+// given.
+const defaultPort = 53
+
+// ResolverRuleClient is the external client used for ResolverRule Custom
+// Resource
+type ResolverRuleClient interface {
+	CreateResolverRuleRequest(*route53resolver.CreateResolverRuleInput) route53resolver.CreateResolverRuleRequest
+	GetResolverRuleRequest(*route53resolver.GetResolverRuleInput) route53resolver.GetResolverRuleRequest
+	UpdateResolverRuleRequest(*route53resolver.UpdateResolverRuleInput) route53resolver.UpdateResolverRuleRequest
+	DeleteResolverRuleRequest(*route53resolver.DeleteResolverRuleInput) route53resolver.DeleteResolverRuleRequest
+}
+
+// NewResolverRuleClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewResolverRuleClient(cfg aws.Config) ResolverRuleClient {
+	return route53resolver.New(cfg)
+}
+
+// GenerateTargetAddresses returns the Route53 Resolver target addresses of
+// the given target addresses.
+func GenerateTargetAddresses(targets []v1alpha1.TargetAddress) []route53resolver.TargetAddress {
+	if len(targets) == 0 {
+		return nil
+	}
+	res := make([]route53resolver.TargetAddress, len(targets))
+	for i, t := range targets {
+		res[i] = route53resolver.TargetAddress{Ip: aws.String(t.IP), Port: t.Port}
+	}
+	return res
+}
+
+// GenerateCreateResolverRuleInput returns the create input of the resolver
+// rule with the given parameters. The given request ID makes retried
+// creations idempotent.
+func GenerateCreateResolverRuleInput(requestID string, p v1alpha1.ResolverRuleParameters) *route53resolver.CreateResolverRuleInput {
+	return &route53resolver.CreateResolverRuleInput{
+		CreatorRequestId:   aws.String(requestID),
+		DomainName:         aws.String(p.DomainName),
+		Name:               p.Name,
+		ResolverEndpointId: p.ResolverEndpointID,
+		RuleType:           route53resolver.RuleTypeOption(p.RuleType),
+		Tags:               GenerateTags(p.Tags),
+		TargetIps:          GenerateTargetAddresses(p.TargetIPs),
+	}
+}
+
+// GenerateResolverRuleConfig returns the configuration of the resolver rule
+// with the given parameters that can be updated.
+func GenerateResolverRuleConfig(p v1alpha1.ResolverRuleParameters) *route53resolver.ResolverRuleConfig {
+	return &route53resolver.ResolverRuleConfig{
+		Name:               p.Name,
+		ResolverEndpointId: p.ResolverEndpointID,
+		TargetIps:          GenerateTargetAddresses(p.TargetIPs),
+	}
+}
+
+// GenerateResolverRuleObservation returns the observation of the given
+// resolver rule.
+func GenerateResolverRuleObservation(o route53resolver.ResolverRule) v1alpha1.ResolverRuleObservation {
+	return v1alpha1.ResolverRuleObservation{
+		ARN:           aws.StringValue(o.Arn),
+		OwnerID:       aws.StringValue(o.OwnerId),
+		ShareStatus:   string(o.ShareStatus),
+		Status:        string(o.Status),
+		StatusMessage: aws.StringValue(o.StatusMessage),
+	}
+}
+
+// LateInitializeResolverRule fills the empty fields of the given parameters
+// with the values of the observed resolver rule.
+func LateInitializeResolverRule(in *v1alpha1.ResolverRuleParameters, o route53resolver.ResolverRule) {
+	if in.Name == nil {
+		in.Name = o.Name
+	}
+	if in.ResolverEndpointID == nil {
+		in.ResolverEndpointID = o.ResolverEndpointId
+	}
+	// The port of a target IP defaults to 53.
+	if len(in.TargetIPs) == len(o.TargetIps) {
+		for i := range in.TargetIPs {
+			if in.TargetIPs[i].Port == nil && aws.StringValue(o.TargetIps[i].Ip) == in.TargetIPs[i].IP {
+				in.TargetIPs[i].Port = o.TargetIps[i].Port
+			}
+		}
+	}
+}
+
+// IsResolverRuleUpToDate returns whether the observed resolver rule is up to
+// date with the given parameters.
+func IsResolverRuleUpToDate(p v1alpha1.ResolverRuleParameters, o route53resolver.ResolverRule) bool {
+	if aws.StringValue(p.Name) != aws.StringValue(o.Name) ||
+		aws.StringValue(p.ResolverEndpointID) != aws.StringValue(o.ResolverEndpointId) {
+		return false
+	}
+	desired := make([]v1alpha1.TargetAddress, len(p.TargetIPs))
+	for i, t := range p.TargetIPs {
+		desired[i] = v1alpha1.TargetAddress{IP: t.IP, Port: aws.Int64(defaultPort)}
+		if t.Port != nil {
+			desired[i].Port = t.Port
+		}
+	}
+	observed := make([]v1alpha1.TargetAddress, len(o.TargetIps))
+	for i, t := range o.TargetIps {
+		observed[i] = v1alpha1.TargetAddress{IP: aws.StringValue(t.Ip), Port: aws.Int64(defaultPort)}
+		if t.Port != nil {
+			observed[i].Port = t.Port
+		}
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b v1alpha1.TargetAddress) bool {
+		if a.IP != b.IP {
+			return a.IP < b.IP
+		}
+		return *a.Port < *b.Port
+	}))
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route53resolver
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+)
+
+func TestIsResolverRuleUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ResolverRuleParameters
+		o    route53resolver.ResolverRule
+		want bool
+	}{
+		"DefaultPortAndOrder": {
+			p: v1alpha1.ResolverRuleParameters{
+				Name: aws.String("corp"),
+				TargetIPs: []v1alpha1.TargetAddress{
+					{IP: "10.1.0.2"},
+					{IP: "10.1.0.3", Port: aws.Int64(5353)},
+				},
+			},
+			o: route53resolver.ResolverRule{
+				Name: aws.String("corp"),
+				TargetIps: []route53resolver.TargetAddress{
+					{Ip: aws.String("10.1.0.3"), Port: aws.Int64(5353)},
+					{Ip: aws.String("10.1.0.2"), Port: aws.Int64(53)},
+				},
+			},
+			want: true,
+		},
+		"TargetChanged": {
+			p: v1alpha1.ResolverRuleParameters{
+				TargetIPs: []v1alpha1.TargetAddress{{IP: "10.1.0.4"}},
+			},
+			o: route53resolver.ResolverRule{
+				TargetIps: []route53resolver.TargetAddress{{Ip: aws.String("10.1.0.2"), Port: aws.Int64(53)}},
+			},
+			want: false,
+		},
+		"EndpointChanged": {
+			p: v1alpha1.ResolverRuleParameters{ResolverEndpointID: aws.String("rslvr-out-2")},
+			o: route53resolver.ResolverRule{ResolverEndpointId: aws.String("rslvr-out-1")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsResolverRuleUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
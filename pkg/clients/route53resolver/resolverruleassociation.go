@@ -0,0 +1,61 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route53resolver
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+)
+
+// ResolverRuleAssociationClient is the external client used for
+// ResolverRuleAssociation Custom Resource
+type ResolverRuleAssociationClient interface {
+	AssociateResolverRuleRequest(*route53resolver.AssociateResolverRuleInput) route53resolver.AssociateResolverRuleRequest
+	GetResolverRuleAssociationRequest(*route53resolver.GetResolverRuleAssociationInput) route53resolver.GetResolverRuleAssociationRequest
+	DisassociateResolverRuleRequest(*route53resolver.DisassociateResolverRuleInput) route53resolver.DisassociateResolverRuleRequest
+}
+
+// NewResolverRuleAssociationClient returns a new client using AWS
+// credentials as JSON encoded data.
+func NewResolverRuleAssociationClient(cfg aws.Config) ResolverRuleAssociationClient {
+	return route53resolver.New(cfg)
+}
+
+// GenerateResolverRuleAssociationObservation returns the observation of the
+// given resolver rule association.
+func GenerateResolverRuleAssociationObservation(o route53resolver.ResolverRuleAssociation) v1alpha1.ResolverRuleAssociationObservation {
+	return v1alpha1.ResolverRuleAssociationObservation{
+		Status:        string(o.Status),
+		StatusMessage: aws.StringValue(o.StatusMessage),
+	}
+}
+
+// LateInitializeResolverRuleAssociation fills the empty fields of the given
+// parameters with the values of the observed resolver rule association.
+func LateInitializeResolverRuleAssociation(in *v1alpha1.ResolverRuleAssociationParameters, o route53resolver.ResolverRuleAssociation) {
+	if in.Name == nil {
+		in.Name = o.Name
+	}
+	if in.ResolverRuleID == nil {
+		in.ResolverRuleID = o.ResolverRuleId
+	}
+	if in.VPCID == nil {
+		in.VPCID = o.VPCId
+	}
+}
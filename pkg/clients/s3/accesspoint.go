@@ -0,0 +1,184 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+)
+
+const (
+	errGetCallerIdentity = "cannot get the identity of the provider credentials"
+
+	accessPointNotFound       = "NoSuchAccessPoint"
+	accessPointPolicyNotFound = "NoSuchAccessPointPolicy"
+)
+
+// AccessPointClient is the external client used for AccessPoint Custom
+// Resource
+type AccessPointClient interface {
+	GetCallerIdentityRequest(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+	GetAccessPointRequest(*s3control.GetAccessPointInput) s3control.GetAccessPointRequest
+	CreateAccessPointRequest(*s3control.CreateAccessPointInput) s3control.CreateAccessPointRequest
+	DeleteAccessPointRequest(*s3control.DeleteAccessPointInput) s3control.DeleteAccessPointRequest
+	GetAccessPointPolicyRequest(*s3control.GetAccessPointPolicyInput) s3control.GetAccessPointPolicyRequest
+	PutAccessPointPolicyRequest(*s3control.PutAccessPointPolicyInput) s3control.PutAccessPointPolicyRequest
+	DeleteAccessPointPolicyRequest(*s3control.DeleteAccessPointPolicyInput) s3control.DeleteAccessPointPolicyRequest
+}
+
+type accessPointClient struct {
+	*s3control.Client
+	sts *sts.Client
+}
+
+// NewAccessPointClient returns a new client given an aws config
+func NewAccessPointClient(cfg aws.Config) AccessPointClient {
+	return &accessPointClient{Client: s3control.New(cfg), sts: sts.New(cfg)}
+}
+
+func (c *accessPointClient) GetCallerIdentityRequest(in *sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+	return c.sts.GetCallerIdentityRequest(in)
+}
+
+// AccessPointAccountID returns the ID of the account that owns the bucket of
+// the given access point, which defaults to the account of the credentials of
+// the given client.
+func AccessPointAccountID(ctx context.Context, c AccessPointClient, p v1alpha1.AccessPointParameters) (string, error) {
+	return accountID(ctx, c, p.AccountID)
+}
+
+type callerIdentityClient interface {
+	GetCallerIdentityRequest(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+}
+
+// accountID returns the given account ID, or the account of the credentials
+// of the given client if it is nil.
+func accountID(ctx context.Context, c callerIdentityClient, id *string) (string, error) {
+	if id != nil {
+		return *id, nil
+	}
+	rsp, err := c.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{}).Send(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, errGetCallerIdentity)
+	}
+	return aws.StringValue(rsp.Account), nil
+}
+
+// IsErrorAccessPointNotFound returns true if the error code indicates that the
+// access point was not found
+func IsErrorAccessPointNotFound(err error) bool {
+	if s3Err, ok := err.(awserr.Error); ok && s3Err.Code() == accessPointNotFound {
+		return true
+	}
+	return false
+}
+
+// IsErrorAccessPointPolicyNotFound returns true if the error code indicates
+// that the access point has no policy
+func IsErrorAccessPointPolicyNotFound(err error) bool {
+	if s3Err, ok := err.(awserr.Error); ok && s3Err.Code() == accessPointPolicyNotFound {
+		return true
+	}
+	return false
+}
+
+// AccessPointARN returns the ARN of the access point with the given name.
+func AccessPointARN(region, accountID, name string) string {
+	return fmt.Sprintf("arn:aws:s3:%s:%s:accesspoint/%s", region, accountID, name)
+}
+
+// GenerateCreateAccessPointInput returns the input to create the access point
+// with the given name and parameters.
+func GenerateCreateAccessPointInput(name, accountID string, p v1alpha1.AccessPointParameters) *s3control.CreateAccessPointInput {
+	in := &s3control.CreateAccessPointInput{
+		AccountId: aws.String(accountID),
+		Bucket:    p.BucketName,
+		Name:      aws.String(name),
+	}
+	if p.VPCConfiguration != nil {
+		in.VpcConfiguration = &s3control.VpcConfiguration{VpcId: p.VPCConfiguration.VPCID}
+	}
+	if c := p.PublicAccessBlockConfiguration; c != nil {
+		in.PublicAccessBlockConfiguration = &s3control.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       c.BlockPublicACLs,
+			BlockPublicPolicy:     c.BlockPublicPolicy,
+			IgnorePublicAcls:      c.IgnorePublicACLs,
+			RestrictPublicBuckets: c.RestrictPublicBuckets,
+		}
+	}
+	return in
+}
+
+// GenerateAccessPointObservation returns the observation of the access point
+// with the given ARN.
+func GenerateAccessPointObservation(arn string, o s3control.GetAccessPointOutput) v1alpha1.AccessPointObservation {
+	obs := v1alpha1.AccessPointObservation{
+		ARN:           arn,
+		NetworkOrigin: string(o.NetworkOrigin),
+	}
+	if o.CreationDate != nil {
+		t := metav1.NewTime(*o.CreationDate)
+		obs.CreationDate = &t
+	}
+	return obs
+}
+
+// LateInitializeAccessPoint fills the empty fields in
+// *v1alpha1.AccessPointParameters with the values seen in
+// s3control.GetAccessPointOutput.
+func LateInitializeAccessPoint(in *v1alpha1.AccessPointParameters, o s3control.GetAccessPointOutput) {
+	if in.BucketName == nil {
+		in.BucketName = o.Bucket
+	}
+	if in.PublicAccessBlockConfiguration == nil && o.PublicAccessBlockConfiguration != nil {
+		c := o.PublicAccessBlockConfiguration
+		in.PublicAccessBlockConfiguration = &v1alpha1.AccessPointPublicAccessBlockConfiguration{
+			BlockPublicACLs:       c.BlockPublicAcls,
+			BlockPublicPolicy:     c.BlockPublicPolicy,
+			IgnorePublicACLs:      c.IgnorePublicAcls,
+			RestrictPublicBuckets: c.RestrictPublicBuckets,
+		}
+	}
+}
+
+// IsAccessPointPolicyUpToDate returns true if the observed policy of an
+// access point is semantically equal to the desired one. An access point
+// without a desired policy must not have one.
+func IsAccessPointPolicyUpToDate(p v1alpha1.AccessPointParameters, observed string) bool {
+	if p.Policy == nil {
+		return observed == ""
+	}
+	var d, o interface{}
+	if err := json.Unmarshal([]byte(*p.Policy), &d); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(observed), &o); err != nil {
+		return false
+	}
+	return cmp.Equal(d, o)
+}
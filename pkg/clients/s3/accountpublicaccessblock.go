@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+)
+
+// AccountPublicAccessBlockClient is the external client used for
+// AccountPublicAccessBlock Custom Resource
+type AccountPublicAccessBlockClient interface {
+	GetCallerIdentityRequest(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+	GetPublicAccessBlockRequest(*s3control.GetPublicAccessBlockInput) s3control.GetPublicAccessBlockRequest
+	PutPublicAccessBlockRequest(*s3control.PutPublicAccessBlockInput) s3control.PutPublicAccessBlockRequest
+	DeletePublicAccessBlockRequest(*s3control.DeletePublicAccessBlockInput) s3control.DeletePublicAccessBlockRequest
+}
+
+type accountPublicAccessBlockClient struct {
+	*s3control.Client
+	sts *sts.Client
+}
+
+// NewAccountPublicAccessBlockClient returns a new client given an aws config
+func NewAccountPublicAccessBlockClient(cfg aws.Config) AccountPublicAccessBlockClient {
+	return &accountPublicAccessBlockClient{Client: s3control.New(cfg), sts: sts.New(cfg)}
+}
+
+func (c *accountPublicAccessBlockClient) GetCallerIdentityRequest(in *sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+	return c.sts.GetCallerIdentityRequest(in)
+}
+
+// AccountPublicAccessBlockAccountID returns the ID of the account of the
+// given public access block, which defaults to the account of the
+// credentials of the given client.
+func AccountPublicAccessBlockAccountID(ctx context.Context, c AccountPublicAccessBlockClient, p v1alpha1.AccountPublicAccessBlockParameters) (string, error) {
+	return accountID(ctx, c, p.AccountID)
+}
+
+// IsErrorAccountPublicAccessBlockNotFound returns true if the error code
+// indicates that the account has no public access block configuration
+func IsErrorAccountPublicAccessBlockNotFound(err error) bool {
+	if s3Err, ok := err.(awserr.Error); ok && s3Err.Code() == s3control.ErrCodeNoSuchPublicAccessBlockConfiguration {
+		return true
+	}
+	return false
+}
+
+// GeneratePutAccountPublicAccessBlockInput returns the input to put the
+// public access block configuration of the given account.
+func GeneratePutAccountPublicAccessBlockInput(accountID string, p v1alpha1.AccountPublicAccessBlockParameters) *s3control.PutPublicAccessBlockInput {
+	return &s3control.PutPublicAccessBlockInput{
+		AccountId: aws.String(accountID),
+		PublicAccessBlockConfiguration: &s3control.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       p.BlockPublicACLs,
+			BlockPublicPolicy:     p.BlockPublicPolicy,
+			IgnorePublicAcls:      p.IgnorePublicACLs,
+			RestrictPublicBuckets: p.RestrictPublicBuckets,
+		},
+	}
+}
+
+// IsAccountPublicAccessBlockUpToDate returns true if the observed public
+// access block configuration matches the parameters. AWS treats the
+// settings that are not given as false.
+func IsAccountPublicAccessBlockUpToDate(p v1alpha1.AccountPublicAccessBlockParameters, o s3control.PublicAccessBlockConfiguration) bool {
+	return aws.BoolValue(p.BlockPublicACLs) == aws.BoolValue(o.BlockPublicAcls) &&
+		aws.BoolValue(p.BlockPublicPolicy) == aws.BoolValue(o.BlockPublicPolicy) &&
+		aws.BoolValue(p.IgnorePublicACLs) == aws.BoolValue(o.IgnorePublicAcls) &&
+		aws.BoolValue(p.RestrictPublicBuckets) == aws.BoolValue(o.RestrictPublicBuckets)
+}
@@ -44,6 +44,8 @@ var (
 	TaggingErrCode = "NoSuchTagSet"
 	// WebsiteErrCode is the error code sent by AWS when the website config does not exist
 	WebsiteErrCode = "NoSuchWebsiteConfiguration"
+	// PublicAccessBlockErrCode is the error code sent by AWS when the public access block config does not exist
+	PublicAccessBlockErrCode = "NoSuchPublicAccessBlockConfiguration"
 )
 
 // BucketClient is the interface for Client for making S3 Bucket requests.
@@ -96,6 +98,10 @@ type BucketClient interface {
 
 	GetBucketAclRequest(*s3.GetBucketAclInput) s3.GetBucketAclRequest
 	PutBucketAclRequest(*s3.PutBucketAclInput) s3.PutBucketAclRequest
+
+	PutPublicAccessBlockRequest(input *s3.PutPublicAccessBlockInput) s3.PutPublicAccessBlockRequest
+	GetPublicAccessBlockRequest(input *s3.GetPublicAccessBlockInput) s3.GetPublicAccessBlockRequest
+	DeletePublicAccessBlockRequest(input *s3.DeletePublicAccessBlockInput) s3.DeletePublicAccessBlockRequest
 }
 
 // NewClient returns a new client using AWS credentials as JSON encoded data.
@@ -198,6 +204,14 @@ func WebsiteConfigurationNotFound(err error) bool {
 	return false
 }
 
+// PublicAccessBlockConfigurationNotFound is parses the aws Error and validates if the public access block configuration does not exist
+func PublicAccessBlockConfigurationNotFound(err error) bool {
+	if s3Err, ok := err.(awserr.Error); ok && s3Err.Code() == PublicAccessBlockErrCode {
+		return true
+	}
+	return false
+}
+
 // UpdateBucketACL creates the ACLInput, sends the request to put an ACL based on the bucket
 func UpdateBucketACL(ctx context.Context, client BucketClient, bucket *v1beta1.Bucket) error {
 	config := &s3.PutBucketAclInput{
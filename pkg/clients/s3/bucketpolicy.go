@@ -17,9 +17,12 @@ limitations under the License.
 package s3
 
 import (
+	"encoding/json"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
 )
 
 // BucketPolicyClient is the external client used for S3BucketPolicy Custom Resource
@@ -49,3 +52,16 @@ func IsErrorBucketNotFound(err error) bool {
 	}
 	return false
 }
+
+// IsBucketPolicyUpToDate returns true if the observed policy document of a
+// bucket is semantically equal to the desired one.
+func IsBucketPolicyUpToDate(desired, observed string) bool {
+	var d, o interface{}
+	if err := json.Unmarshal([]byte(desired), &d); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(observed), &o); err != nil {
+		return false
+	}
+	return cmp.Equal(d, o)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AccessPointClient = (*MockAccessPointClient)(nil)
+
+// MockAccessPointClient is a type that implements all the methods for AccessPointClient interface
+type MockAccessPointClient struct {
+	MockGetCallerIdentityRequest       func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+	MockGetAccessPointRequest          func(*s3control.GetAccessPointInput) s3control.GetAccessPointRequest
+	MockCreateAccessPointRequest       func(*s3control.CreateAccessPointInput) s3control.CreateAccessPointRequest
+	MockDeleteAccessPointRequest       func(*s3control.DeleteAccessPointInput) s3control.DeleteAccessPointRequest
+	MockGetAccessPointPolicyRequest    func(*s3control.GetAccessPointPolicyInput) s3control.GetAccessPointPolicyRequest
+	MockPutAccessPointPolicyRequest    func(*s3control.PutAccessPointPolicyInput) s3control.PutAccessPointPolicyRequest
+	MockDeleteAccessPointPolicyRequest func(*s3control.DeleteAccessPointPolicyInput) s3control.DeleteAccessPointPolicyRequest
+}
+
+// GetCallerIdentityRequest mocks GetCallerIdentityRequest method
+func (m *MockAccessPointClient) GetCallerIdentityRequest(input *sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+	return m.MockGetCallerIdentityRequest(input)
+}
+
+// GetAccessPointRequest mocks GetAccessPointRequest method
+func (m *MockAccessPointClient) GetAccessPointRequest(input *s3control.GetAccessPointInput) s3control.GetAccessPointRequest {
+	return m.MockGetAccessPointRequest(input)
+}
+
+// CreateAccessPointRequest mocks CreateAccessPointRequest method
+func (m *MockAccessPointClient) CreateAccessPointRequest(input *s3control.CreateAccessPointInput) s3control.CreateAccessPointRequest {
+	return m.MockCreateAccessPointRequest(input)
+}
+
+// DeleteAccessPointRequest mocks DeleteAccessPointRequest method
+func (m *MockAccessPointClient) DeleteAccessPointRequest(input *s3control.DeleteAccessPointInput) s3control.DeleteAccessPointRequest {
+	return m.MockDeleteAccessPointRequest(input)
+}
+
+// GetAccessPointPolicyRequest mocks GetAccessPointPolicyRequest method
+func (m *MockAccessPointClient) GetAccessPointPolicyRequest(input *s3control.GetAccessPointPolicyInput) s3control.GetAccessPointPolicyRequest {
+	return m.MockGetAccessPointPolicyRequest(input)
+}
+
+// PutAccessPointPolicyRequest mocks PutAccessPointPolicyRequest method
+func (m *MockAccessPointClient) PutAccessPointPolicyRequest(input *s3control.PutAccessPointPolicyInput) s3control.PutAccessPointPolicyRequest {
+	return m.MockPutAccessPointPolicyRequest(input)
+}
+
+// DeleteAccessPointPolicyRequest mocks DeleteAccessPointPolicyRequest method
+func (m *MockAccessPointClient) DeleteAccessPointPolicyRequest(input *s3control.DeleteAccessPointPolicyInput) s3control.DeleteAccessPointPolicyRequest {
+	return m.MockDeleteAccessPointPolicyRequest(input)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AccountPublicAccessBlockClient = (*MockAccountPublicAccessBlockClient)(nil)
+
+// MockAccountPublicAccessBlockClient is a type that implements all the methods for AccountPublicAccessBlockClient interface
+type MockAccountPublicAccessBlockClient struct {
+	MockGetCallerIdentityRequest       func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+	MockGetPublicAccessBlockRequest    func(*s3control.GetPublicAccessBlockInput) s3control.GetPublicAccessBlockRequest
+	MockPutPublicAccessBlockRequest    func(*s3control.PutPublicAccessBlockInput) s3control.PutPublicAccessBlockRequest
+	MockDeletePublicAccessBlockRequest func(*s3control.DeletePublicAccessBlockInput) s3control.DeletePublicAccessBlockRequest
+}
+
+// GetCallerIdentityRequest mocks GetCallerIdentityRequest method
+func (m *MockAccountPublicAccessBlockClient) GetCallerIdentityRequest(input *sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+	return m.MockGetCallerIdentityRequest(input)
+}
+
+// GetPublicAccessBlockRequest mocks GetPublicAccessBlockRequest method
+func (m *MockAccountPublicAccessBlockClient) GetPublicAccessBlockRequest(input *s3control.GetPublicAccessBlockInput) s3control.GetPublicAccessBlockRequest {
+	return m.MockGetPublicAccessBlockRequest(input)
+}
+
+// PutPublicAccessBlockRequest mocks PutPublicAccessBlockRequest method
+func (m *MockAccountPublicAccessBlockClient) PutPublicAccessBlockRequest(input *s3control.PutPublicAccessBlockInput) s3control.PutPublicAccessBlockRequest {
+	return m.MockPutPublicAccessBlockRequest(input)
+}
+
+// DeletePublicAccessBlockRequest mocks DeletePublicAccessBlockRequest method
+func (m *MockAccountPublicAccessBlockClient) DeletePublicAccessBlockRequest(input *s3control.DeletePublicAccessBlockInput) s3control.DeletePublicAccessBlockRequest {
+	return m.MockDeletePublicAccessBlockRequest(input)
+}
@@ -75,6 +75,10 @@ type MockBucketClient struct {
 
 	MockGetBucketAclRequest func(*s3.GetBucketAclInput) s3.GetBucketAclRequest //nolint
 	MockPutBucketAclRequest func(*s3.PutBucketAclInput) s3.PutBucketAclRequest //nolint
+
+	MockPutPublicAccessBlockRequest    func(input *s3.PutPublicAccessBlockInput) s3.PutPublicAccessBlockRequest
+	MockGetPublicAccessBlockRequest    func(input *s3.GetPublicAccessBlockInput) s3.GetPublicAccessBlockRequest
+	MockDeletePublicAccessBlockRequest func(input *s3.DeletePublicAccessBlockInput) s3.DeletePublicAccessBlockRequest
 }
 
 // HeadBucketRequest is the fake method call to invoke the internal mock method
@@ -251,3 +255,18 @@ func (m MockBucketClient) GetBucketAclRequest(input *s3.GetBucketAclInput) s3.Ge
 func (m MockBucketClient) PutBucketAclRequest(input *s3.PutBucketAclInput) s3.PutBucketAclRequest { //nolint
 	return m.MockPutBucketAclRequest(input)
 }
+
+// PutPublicAccessBlockRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutPublicAccessBlockRequest(input *s3.PutPublicAccessBlockInput) s3.PutPublicAccessBlockRequest {
+	return m.MockPutPublicAccessBlockRequest(input)
+}
+
+// GetPublicAccessBlockRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) GetPublicAccessBlockRequest(input *s3.GetPublicAccessBlockInput) s3.GetPublicAccessBlockRequest {
+	return m.MockGetPublicAccessBlockRequest(input)
+}
+
+// DeletePublicAccessBlockRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeletePublicAccessBlockRequest(input *s3.DeletePublicAccessBlockInput) s3.DeletePublicAccessBlockRequest {
+	return m.MockDeletePublicAccessBlockRequest(input)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sagemaker
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+
+	"github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+)
+
+// EndpointClient is the external client used for Endpoint Custom Resource
+type EndpointClient interface {
+	CreateEndpointRequest(*sagemaker.CreateEndpointInput) sagemaker.CreateEndpointRequest
+	DescribeEndpointRequest(*sagemaker.DescribeEndpointInput) sagemaker.DescribeEndpointRequest
+	UpdateEndpointRequest(*sagemaker.UpdateEndpointInput) sagemaker.UpdateEndpointRequest
+	DeleteEndpointRequest(*sagemaker.DeleteEndpointInput) sagemaker.DeleteEndpointRequest
+	ListTagsRequest(*sagemaker.ListTagsInput) sagemaker.ListTagsRequest
+	AddTagsRequest(*sagemaker.AddTagsInput) sagemaker.AddTagsRequest
+	DeleteTagsRequest(*sagemaker.DeleteTagsInput) sagemaker.DeleteTagsRequest
+}
+
+// NewEndpointClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewEndpointClient(cfg aws.Config) EndpointClient {
+	return sagemaker.New(cfg)
+}
+
+// GenerateCreateEndpointInput returns a create input from the given name and
+// parameters.
+func GenerateCreateEndpointInput(name string, p v1alpha1.EndpointParameters) *sagemaker.CreateEndpointInput {
+	return &sagemaker.CreateEndpointInput{
+		EndpointName:       aws.String(name),
+		EndpointConfigName: p.EndpointConfigName,
+		Tags:               GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateEndpointInput returns an input that deploys the desired
+// endpoint configuration to the endpoint with the given name.
+func GenerateUpdateEndpointInput(name string, p v1alpha1.EndpointParameters) *sagemaker.UpdateEndpointInput {
+	return &sagemaker.UpdateEndpointInput{
+		EndpointName:               aws.String(name),
+		EndpointConfigName:         p.EndpointConfigName,
+		RetainAllVariantProperties: p.RetainAllVariantProperties,
+	}
+}
+
+// GenerateEndpointObservation is used to produce v1alpha1.EndpointObservation
+// from sagemaker.DescribeEndpointOutput.
+func GenerateEndpointObservation(o sagemaker.DescribeEndpointOutput) v1alpha1.EndpointObservation {
+	res := v1alpha1.EndpointObservation{
+		EndpointARN:        aws.StringValue(o.EndpointArn),
+		EndpointStatus:     string(o.EndpointStatus),
+		EndpointConfigName: aws.StringValue(o.EndpointConfigName),
+		FailureReason:      aws.StringValue(o.FailureReason),
+	}
+	for _, v := range o.ProductionVariants {
+		res.ProductionVariants = append(res.ProductionVariants, v1alpha1.ProductionVariantSummary{
+			VariantName:          aws.StringValue(v.VariantName),
+			CurrentInstanceCount: aws.Int64Value(v.CurrentInstanceCount),
+			DesiredInstanceCount: aws.Int64Value(v.DesiredInstanceCount),
+		})
+	}
+	return res
+}
+
+// IsEndpointUpToDate returns whether the endpoint serves the desired endpoint
+// configuration.
+func IsEndpointUpToDate(p v1alpha1.EndpointParameters, o sagemaker.DescribeEndpointOutput) bool {
+	return aws.StringValue(p.EndpointConfigName) == aws.StringValue(o.EndpointConfigName)
+}
+
+// IsEndpointDeploying returns whether a deployment of the endpoint is in
+// progress, during which it cannot be updated.
+func IsEndpointDeploying(status sagemaker.EndpointStatus) bool {
+	switch status {
+	case sagemaker.EndpointStatusCreating, sagemaker.EndpointStatusUpdating,
+		sagemaker.EndpointStatusSystemUpdating, sagemaker.EndpointStatusRollingBack:
+		return true
+	}
+	return false
+}
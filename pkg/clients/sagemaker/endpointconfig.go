@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sagemaker
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+
+	"github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// EndpointConfigClient is the external client used for EndpointConfig Custom
+// Resource
+type EndpointConfigClient interface {
+	CreateEndpointConfigRequest(*sagemaker.CreateEndpointConfigInput) sagemaker.CreateEndpointConfigRequest
+	DescribeEndpointConfigRequest(*sagemaker.DescribeEndpointConfigInput) sagemaker.DescribeEndpointConfigRequest
+	DeleteEndpointConfigRequest(*sagemaker.DeleteEndpointConfigInput) sagemaker.DeleteEndpointConfigRequest
+	ListTagsRequest(*sagemaker.ListTagsInput) sagemaker.ListTagsRequest
+	AddTagsRequest(*sagemaker.AddTagsInput) sagemaker.AddTagsRequest
+	DeleteTagsRequest(*sagemaker.DeleteTagsInput) sagemaker.DeleteTagsRequest
+}
+
+// NewEndpointConfigClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewEndpointConfigClient(cfg aws.Config) EndpointConfigClient {
+	return sagemaker.New(cfg)
+}
+
+func generateDataCaptureConfig(c *v1alpha1.DataCaptureConfig) *sagemaker.DataCaptureConfig {
+	if c == nil {
+		return nil
+	}
+	res := &sagemaker.DataCaptureConfig{
+		EnableCapture:             c.EnableCapture,
+		InitialSamplingPercentage: aws.Int64(c.InitialSamplingPercentage),
+		DestinationS3Uri:          aws.String(c.DestinationS3URI),
+		KmsKeyId:                  c.KMSKeyID,
+	}
+	for _, m := range c.CaptureModes {
+		res.CaptureOptions = append(res.CaptureOptions, sagemaker.CaptureOption{CaptureMode: sagemaker.CaptureMode(m)})
+	}
+	if h := c.CaptureContentTypeHeader; h != nil {
+		res.CaptureContentTypeHeader = &sagemaker.CaptureContentTypeHeader{
+			CsvContentTypes:  h.CSVContentTypes,
+			JsonContentTypes: h.JSONContentTypes,
+		}
+	}
+	return res
+}
+
+// GenerateCreateEndpointConfigInput returns a create input from the given
+// name and parameters.
+func GenerateCreateEndpointConfigInput(name string, p v1alpha1.EndpointConfigParameters) *sagemaker.CreateEndpointConfigInput {
+	in := &sagemaker.CreateEndpointConfigInput{
+		EndpointConfigName: aws.String(name),
+		DataCaptureConfig:  generateDataCaptureConfig(p.DataCaptureConfig),
+		KmsKeyId:           p.KMSKeyID,
+		Tags:               GenerateTags(p.Tags),
+	}
+	for _, v := range p.ProductionVariants {
+		pv := sagemaker.ProductionVariant{
+			VariantName:          aws.String(v.VariantName),
+			ModelName:            v.ModelName,
+			InstanceType:         sagemaker.ProductionVariantInstanceType(v.InstanceType),
+			InitialInstanceCount: aws.Int64(v.InitialInstanceCount),
+			AcceleratorType:      sagemaker.ProductionVariantAcceleratorType(aws.StringValue(v.AcceleratorType)),
+		}
+		if v.InitialVariantWeight != nil {
+			pv.InitialVariantWeight = aws.Float64(float64(aws.Int64Value(v.InitialVariantWeight)))
+		}
+		in.ProductionVariants = append(in.ProductionVariants, pv)
+	}
+	return in
+}
+
+// GenerateEndpointConfigObservation is used to produce
+// v1alpha1.EndpointConfigObservation from
+// sagemaker.DescribeEndpointConfigOutput.
+func GenerateEndpointConfigObservation(o sagemaker.DescribeEndpointConfigOutput) v1alpha1.EndpointConfigObservation {
+	return v1alpha1.EndpointConfigObservation{
+		EndpointConfigARN: aws.StringValue(o.EndpointConfigArn),
+	}
+}
+
+// LateInitializeEndpointConfig fills the empty fields in
+// *v1alpha1.EndpointConfigParameters with the values seen in
+// sagemaker.DescribeEndpointConfigOutput.
+func LateInitializeEndpointConfig(in *v1alpha1.EndpointConfigParameters, o *sagemaker.DescribeEndpointConfigOutput) {
+	if o == nil {
+		return
+	}
+	in.KMSKeyID = awsclients.LateInitializeStringPtr(in.KMSKeyID, o.KmsKeyId)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sagemaker
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+)
+
+func TestGenerateCreateEndpointConfigInput(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.EndpointConfigParameters
+		out *sagemaker.CreateEndpointConfigInput
+	}{
+		"WeightedVariantsWithCapture": {
+			in: v1alpha1.EndpointConfigParameters{
+				ProductionVariants: []v1alpha1.ProductionVariant{
+					{VariantName: "blue", ModelName: aws.String("model-v1"), InstanceType: "ml.m5.large", InitialInstanceCount: 2, InitialVariantWeight: aws.Int64(9)},
+					{VariantName: "green", ModelName: aws.String("model-v2"), InstanceType: "ml.m5.large", InitialInstanceCount: 1, InitialVariantWeight: aws.Int64(1)},
+				},
+				DataCaptureConfig: &v1alpha1.DataCaptureConfig{
+					InitialSamplingPercentage: 10,
+					DestinationS3URI:          "s3://bucket/capture",
+					CaptureModes:              []string{"Input", "Output"},
+					CaptureContentTypeHeader:  &v1alpha1.CaptureContentTypeHeader{JSONContentTypes: []string{"application/json"}},
+				},
+				Tags: []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			},
+			out: &sagemaker.CreateEndpointConfigInput{
+				EndpointConfigName: aws.String("some-config"),
+				ProductionVariants: []sagemaker.ProductionVariant{
+					{VariantName: aws.String("blue"), ModelName: aws.String("model-v1"), InstanceType: sagemaker.ProductionVariantInstanceTypeMlM5Large, InitialInstanceCount: aws.Int64(2), InitialVariantWeight: aws.Float64(9)},
+					{VariantName: aws.String("green"), ModelName: aws.String("model-v2"), InstanceType: sagemaker.ProductionVariantInstanceTypeMlM5Large, InitialInstanceCount: aws.Int64(1), InitialVariantWeight: aws.Float64(1)},
+				},
+				DataCaptureConfig: &sagemaker.DataCaptureConfig{
+					InitialSamplingPercentage: aws.Int64(10),
+					DestinationS3Uri:          aws.String("s3://bucket/capture"),
+					CaptureOptions: []sagemaker.CaptureOption{
+						{CaptureMode: sagemaker.CaptureModeInput},
+						{CaptureMode: sagemaker.CaptureModeOutput},
+					},
+					CaptureContentTypeHeader: &sagemaker.CaptureContentTypeHeader{JsonContentTypes: []string{"application/json"}},
+				},
+				Tags: []sagemaker.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateEndpointConfigInput("some-config", tc.in)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
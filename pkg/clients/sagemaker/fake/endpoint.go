@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/sagemaker"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.EndpointClient = (*MockEndpointClient)(nil)
+
+// MockEndpointClient is a type that implements all the methods for EndpointClient interface
+type MockEndpointClient struct {
+	MockCreateEndpoint   func(*sagemaker.CreateEndpointInput) sagemaker.CreateEndpointRequest
+	MockDescribeEndpoint func(*sagemaker.DescribeEndpointInput) sagemaker.DescribeEndpointRequest
+	MockUpdateEndpoint   func(*sagemaker.UpdateEndpointInput) sagemaker.UpdateEndpointRequest
+	MockDeleteEndpoint   func(*sagemaker.DeleteEndpointInput) sagemaker.DeleteEndpointRequest
+	MockListTags         func(*sagemaker.ListTagsInput) sagemaker.ListTagsRequest
+	MockAddTags          func(*sagemaker.AddTagsInput) sagemaker.AddTagsRequest
+	MockDeleteTags       func(*sagemaker.DeleteTagsInput) sagemaker.DeleteTagsRequest
+}
+
+// CreateEndpointRequest mocks CreateEndpointRequest method
+func (m *MockEndpointClient) CreateEndpointRequest(input *sagemaker.CreateEndpointInput) sagemaker.CreateEndpointRequest {
+	return m.MockCreateEndpoint(input)
+}
+
+// DescribeEndpointRequest mocks DescribeEndpointRequest method
+func (m *MockEndpointClient) DescribeEndpointRequest(input *sagemaker.DescribeEndpointInput) sagemaker.DescribeEndpointRequest {
+	return m.MockDescribeEndpoint(input)
+}
+
+// UpdateEndpointRequest mocks UpdateEndpointRequest method
+func (m *MockEndpointClient) UpdateEndpointRequest(input *sagemaker.UpdateEndpointInput) sagemaker.UpdateEndpointRequest {
+	return m.MockUpdateEndpoint(input)
+}
+
+// DeleteEndpointRequest mocks DeleteEndpointRequest method
+func (m *MockEndpointClient) DeleteEndpointRequest(input *sagemaker.DeleteEndpointInput) sagemaker.DeleteEndpointRequest {
+	return m.MockDeleteEndpoint(input)
+}
+
+// ListTagsRequest mocks ListTagsRequest method
+func (m *MockEndpointClient) ListTagsRequest(input *sagemaker.ListTagsInput) sagemaker.ListTagsRequest {
+	return m.MockListTags(input)
+}
+
+// AddTagsRequest mocks AddTagsRequest method
+func (m *MockEndpointClient) AddTagsRequest(input *sagemaker.AddTagsInput) sagemaker.AddTagsRequest {
+	return m.MockAddTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockEndpointClient) DeleteTagsRequest(input *sagemaker.DeleteTagsInput) sagemaker.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
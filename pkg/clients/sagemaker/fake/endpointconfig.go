@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/sagemaker"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.EndpointConfigClient = (*MockEndpointConfigClient)(nil)
+
+// MockEndpointConfigClient is a type that implements all the methods for EndpointConfigClient interface
+type MockEndpointConfigClient struct {
+	MockCreateEndpointConfig   func(*sagemaker.CreateEndpointConfigInput) sagemaker.CreateEndpointConfigRequest
+	MockDescribeEndpointConfig func(*sagemaker.DescribeEndpointConfigInput) sagemaker.DescribeEndpointConfigRequest
+	MockDeleteEndpointConfig   func(*sagemaker.DeleteEndpointConfigInput) sagemaker.DeleteEndpointConfigRequest
+	MockListTags               func(*sagemaker.ListTagsInput) sagemaker.ListTagsRequest
+	MockAddTags                func(*sagemaker.AddTagsInput) sagemaker.AddTagsRequest
+	MockDeleteTags             func(*sagemaker.DeleteTagsInput) sagemaker.DeleteTagsRequest
+}
+
+// CreateEndpointConfigRequest mocks CreateEndpointConfigRequest method
+func (m *MockEndpointConfigClient) CreateEndpointConfigRequest(input *sagemaker.CreateEndpointConfigInput) sagemaker.CreateEndpointConfigRequest {
+	return m.MockCreateEndpointConfig(input)
+}
+
+// DescribeEndpointConfigRequest mocks DescribeEndpointConfigRequest method
+func (m *MockEndpointConfigClient) DescribeEndpointConfigRequest(input *sagemaker.DescribeEndpointConfigInput) sagemaker.DescribeEndpointConfigRequest {
+	return m.MockDescribeEndpointConfig(input)
+}
+
+// DeleteEndpointConfigRequest mocks DeleteEndpointConfigRequest method
+func (m *MockEndpointConfigClient) DeleteEndpointConfigRequest(input *sagemaker.DeleteEndpointConfigInput) sagemaker.DeleteEndpointConfigRequest {
+	return m.MockDeleteEndpointConfig(input)
+}
+
+// ListTagsRequest mocks ListTagsRequest method
+func (m *MockEndpointConfigClient) ListTagsRequest(input *sagemaker.ListTagsInput) sagemaker.ListTagsRequest {
+	return m.MockListTags(input)
+}
+
+// AddTagsRequest mocks AddTagsRequest method
+func (m *MockEndpointConfigClient) AddTagsRequest(input *sagemaker.AddTagsInput) sagemaker.AddTagsRequest {
+	return m.MockAddTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockEndpointConfigClient) DeleteTagsRequest(input *sagemaker.DeleteTagsInput) sagemaker.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/sagemaker"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ModelClient = (*MockModelClient)(nil)
+
+// MockModelClient is a type that implements all the methods for ModelClient interface
+type MockModelClient struct {
+	MockCreateModel   func(*sagemaker.CreateModelInput) sagemaker.CreateModelRequest
+	MockDescribeModel func(*sagemaker.DescribeModelInput) sagemaker.DescribeModelRequest
+	MockDeleteModel   func(*sagemaker.DeleteModelInput) sagemaker.DeleteModelRequest
+	MockListTags      func(*sagemaker.ListTagsInput) sagemaker.ListTagsRequest
+	MockAddTags       func(*sagemaker.AddTagsInput) sagemaker.AddTagsRequest
+	MockDeleteTags    func(*sagemaker.DeleteTagsInput) sagemaker.DeleteTagsRequest
+}
+
+// CreateModelRequest mocks CreateModelRequest method
+func (m *MockModelClient) CreateModelRequest(input *sagemaker.CreateModelInput) sagemaker.CreateModelRequest {
+	return m.MockCreateModel(input)
+}
+
+// DescribeModelRequest mocks DescribeModelRequest method
+func (m *MockModelClient) DescribeModelRequest(input *sagemaker.DescribeModelInput) sagemaker.DescribeModelRequest {
+	return m.MockDescribeModel(input)
+}
+
+// DeleteModelRequest mocks DeleteModelRequest method
+func (m *MockModelClient) DeleteModelRequest(input *sagemaker.DeleteModelInput) sagemaker.DeleteModelRequest {
+	return m.MockDeleteModel(input)
+}
+
+// ListTagsRequest mocks ListTagsRequest method
+func (m *MockModelClient) ListTagsRequest(input *sagemaker.ListTagsInput) sagemaker.ListTagsRequest {
+	return m.MockListTags(input)
+}
+
+// AddTagsRequest mocks AddTagsRequest method
+func (m *MockModelClient) AddTagsRequest(input *sagemaker.AddTagsInput) sagemaker.AddTagsRequest {
+	return m.MockAddTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockModelClient) DeleteTagsRequest(input *sagemaker.DeleteTagsInput) sagemaker.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/sagemaker"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.NotebookInstanceClient = (*MockNotebookInstanceClient)(nil)
+
+// MockNotebookInstanceClient is a type that implements all the methods for NotebookInstanceClient interface
+type MockNotebookInstanceClient struct {
+	MockCreateNotebookInstance   func(*sagemaker.CreateNotebookInstanceInput) sagemaker.CreateNotebookInstanceRequest
+	MockDescribeNotebookInstance func(*sagemaker.DescribeNotebookInstanceInput) sagemaker.DescribeNotebookInstanceRequest
+	MockUpdateNotebookInstance   func(*sagemaker.UpdateNotebookInstanceInput) sagemaker.UpdateNotebookInstanceRequest
+	MockStartNotebookInstance    func(*sagemaker.StartNotebookInstanceInput) sagemaker.StartNotebookInstanceRequest
+	MockStopNotebookInstance     func(*sagemaker.StopNotebookInstanceInput) sagemaker.StopNotebookInstanceRequest
+	MockDeleteNotebookInstance   func(*sagemaker.DeleteNotebookInstanceInput) sagemaker.DeleteNotebookInstanceRequest
+	MockListTags                 func(*sagemaker.ListTagsInput) sagemaker.ListTagsRequest
+	MockAddTags                  func(*sagemaker.AddTagsInput) sagemaker.AddTagsRequest
+	MockDeleteTags               func(*sagemaker.DeleteTagsInput) sagemaker.DeleteTagsRequest
+}
+
+// CreateNotebookInstanceRequest mocks CreateNotebookInstanceRequest method
+func (m *MockNotebookInstanceClient) CreateNotebookInstanceRequest(input *sagemaker.CreateNotebookInstanceInput) sagemaker.CreateNotebookInstanceRequest {
+	return m.MockCreateNotebookInstance(input)
+}
+
+// DescribeNotebookInstanceRequest mocks DescribeNotebookInstanceRequest method
+func (m *MockNotebookInstanceClient) DescribeNotebookInstanceRequest(input *sagemaker.DescribeNotebookInstanceInput) sagemaker.DescribeNotebookInstanceRequest {
+	return m.MockDescribeNotebookInstance(input)
+}
+
+// UpdateNotebookInstanceRequest mocks UpdateNotebookInstanceRequest method
+func (m *MockNotebookInstanceClient) UpdateNotebookInstanceRequest(input *sagemaker.UpdateNotebookInstanceInput) sagemaker.UpdateNotebookInstanceRequest {
+	return m.MockUpdateNotebookInstance(input)
+}
+
+// StartNotebookInstanceRequest mocks StartNotebookInstanceRequest method
+func (m *MockNotebookInstanceClient) StartNotebookInstanceRequest(input *sagemaker.StartNotebookInstanceInput) sagemaker.StartNotebookInstanceRequest {
+	return m.MockStartNotebookInstance(input)
+}
+
+// StopNotebookInstanceRequest mocks StopNotebookInstanceRequest method
+func (m *MockNotebookInstanceClient) StopNotebookInstanceRequest(input *sagemaker.StopNotebookInstanceInput) sagemaker.StopNotebookInstanceRequest {
+	return m.MockStopNotebookInstance(input)
+}
+
+// DeleteNotebookInstanceRequest mocks DeleteNotebookInstanceRequest method
+func (m *MockNotebookInstanceClient) DeleteNotebookInstanceRequest(input *sagemaker.DeleteNotebookInstanceInput) sagemaker.DeleteNotebookInstanceRequest {
+	return m.MockDeleteNotebookInstance(input)
+}
+
+// ListTagsRequest mocks ListTagsRequest method
+func (m *MockNotebookInstanceClient) ListTagsRequest(input *sagemaker.ListTagsInput) sagemaker.ListTagsRequest {
+	return m.MockListTags(input)
+}
+
+// AddTagsRequest mocks AddTagsRequest method
+func (m *MockNotebookInstanceClient) AddTagsRequest(input *sagemaker.AddTagsInput) sagemaker.AddTagsRequest {
+	return m.MockAddTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockNotebookInstanceClient) DeleteTagsRequest(input *sagemaker.DeleteTagsInput) sagemaker.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
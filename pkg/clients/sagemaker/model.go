@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sagemaker
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+
+	"github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ModelClient is the external client used for Model Custom Resource
+type ModelClient interface {
+	CreateModelRequest(*sagemaker.CreateModelInput) sagemaker.CreateModelRequest
+	DescribeModelRequest(*sagemaker.DescribeModelInput) sagemaker.DescribeModelRequest
+	DeleteModelRequest(*sagemaker.DeleteModelInput) sagemaker.DeleteModelRequest
+	ListTagsRequest(*sagemaker.ListTagsInput) sagemaker.ListTagsRequest
+	AddTagsRequest(*sagemaker.AddTagsInput) sagemaker.AddTagsRequest
+	DeleteTagsRequest(*sagemaker.DeleteTagsInput) sagemaker.DeleteTagsRequest
+}
+
+// NewModelClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewModelClient(cfg aws.Config) ModelClient {
+	return sagemaker.New(cfg)
+}
+
+func generateContainerDefinition(c v1alpha1.ContainerDefinition) sagemaker.ContainerDefinition {
+	return sagemaker.ContainerDefinition{
+		ContainerHostname: c.ContainerHostname,
+		Environment:       c.Environment,
+		Image:             c.Image,
+		Mode:              sagemaker.ContainerMode(aws.StringValue(c.Mode)),
+		ModelDataUrl:      c.ModelDataURL,
+		ModelPackageName:  c.ModelPackageName,
+	}
+}
+
+// GenerateCreateModelInput returns a create input from the given name and
+// parameters.
+func GenerateCreateModelInput(name string, p v1alpha1.ModelParameters) *sagemaker.CreateModelInput {
+	in := &sagemaker.CreateModelInput{
+		ModelName:              aws.String(name),
+		ExecutionRoleArn:       p.ExecutionRoleARN,
+		EnableNetworkIsolation: p.EnableNetworkIsolation,
+		Tags:                   GenerateTags(p.Tags),
+	}
+	if p.PrimaryContainer != nil {
+		c := generateContainerDefinition(*p.PrimaryContainer)
+		in.PrimaryContainer = &c
+	}
+	for _, c := range p.Containers {
+		in.Containers = append(in.Containers, generateContainerDefinition(c))
+	}
+	if p.VPCConfig != nil {
+		in.VpcConfig = &sagemaker.VpcConfig{
+			SecurityGroupIds: p.VPCConfig.SecurityGroupIDs,
+			Subnets:          p.VPCConfig.SubnetIDs,
+		}
+	}
+	return in
+}
+
+// GenerateModelObservation is used to produce v1alpha1.ModelObservation from
+// sagemaker.DescribeModelOutput.
+func GenerateModelObservation(o sagemaker.DescribeModelOutput) v1alpha1.ModelObservation {
+	return v1alpha1.ModelObservation{
+		ModelARN: aws.StringValue(o.ModelArn),
+	}
+}
+
+// LateInitializeModel fills the empty fields in *v1alpha1.ModelParameters
+// with the values seen in sagemaker.DescribeModelOutput.
+func LateInitializeModel(in *v1alpha1.ModelParameters, o *sagemaker.DescribeModelOutput) {
+	if o == nil {
+		return
+	}
+	in.ExecutionRoleARN = awsclients.LateInitializeStringPtr(in.ExecutionRoleARN, o.ExecutionRoleArn)
+	in.EnableNetworkIsolation = awsclients.LateInitializeBoolPtr(in.EnableNetworkIsolation, o.EnableNetworkIsolation)
+}
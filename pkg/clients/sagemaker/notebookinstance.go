@@ -0,0 +1,227 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sagemaker
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const errCodeValidation = "ValidationException"
+
+// NotebookInstanceClient is the external client used for NotebookInstance
+// Custom Resource
+type NotebookInstanceClient interface {
+	CreateNotebookInstanceRequest(*sagemaker.CreateNotebookInstanceInput) sagemaker.CreateNotebookInstanceRequest
+	DescribeNotebookInstanceRequest(*sagemaker.DescribeNotebookInstanceInput) sagemaker.DescribeNotebookInstanceRequest
+	UpdateNotebookInstanceRequest(*sagemaker.UpdateNotebookInstanceInput) sagemaker.UpdateNotebookInstanceRequest
+	StartNotebookInstanceRequest(*sagemaker.StartNotebookInstanceInput) sagemaker.StartNotebookInstanceRequest
+	StopNotebookInstanceRequest(*sagemaker.StopNotebookInstanceInput) sagemaker.StopNotebookInstanceRequest
+	DeleteNotebookInstanceRequest(*sagemaker.DeleteNotebookInstanceInput) sagemaker.DeleteNotebookInstanceRequest
+	ListTagsRequest(*sagemaker.ListTagsInput) sagemaker.ListTagsRequest
+	AddTagsRequest(*sagemaker.AddTagsInput) sagemaker.AddTagsRequest
+	DeleteTagsRequest(*sagemaker.DeleteTagsInput) sagemaker.DeleteTagsRequest
+}
+
+// NewNotebookInstanceClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewNotebookInstanceClient(cfg aws.Config) NotebookInstanceClient {
+	return sagemaker.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the resource doesn't exist.
+// SageMaker reports unknown resources as validation errors.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	switch awsErr.Code() {
+	case sagemaker.ErrCodeResourceNotFound:
+		return true
+	case errCodeValidation:
+		return strings.Contains(awsErr.Message(), "Could not find") ||
+			strings.Contains(awsErr.Message(), "RecordNotFound")
+	}
+	return false
+}
+
+// GenerateTags returns the tags in the form the SageMaker API expects.
+func GenerateTags(tags []v1alpha1.Tag) []sagemaker.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]sagemaker.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = sagemaker.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from a SageMaker resource.
+func DiffTags(desired []v1alpha1.Tag, observed []sagemaker.Tag) (add []sagemaker.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := awsclients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, sagemaker.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return add, remove
+}
+
+func generateAcceleratorTypes(types []string) []sagemaker.NotebookInstanceAcceleratorType {
+	if len(types) == 0 {
+		return nil
+	}
+	res := make([]sagemaker.NotebookInstanceAcceleratorType, len(types))
+	for i, t := range types {
+		res[i] = sagemaker.NotebookInstanceAcceleratorType(t)
+	}
+	return res
+}
+
+// GenerateCreateNotebookInstanceInput returns a create input from the given
+// name and parameters.
+func GenerateCreateNotebookInstanceInput(name string, p v1alpha1.NotebookInstanceParameters) *sagemaker.CreateNotebookInstanceInput {
+	return &sagemaker.CreateNotebookInstanceInput{
+		NotebookInstanceName:       aws.String(name),
+		InstanceType:               sagemaker.InstanceType(p.InstanceType),
+		RoleArn:                    p.RoleARN,
+		SubnetId:                   p.SubnetID,
+		SecurityGroupIds:           p.SecurityGroupIDs,
+		KmsKeyId:                   p.KMSKeyID,
+		DirectInternetAccess:       sagemaker.DirectInternetAccess(aws.StringValue(p.DirectInternetAccess)),
+		RootAccess:                 sagemaker.RootAccess(aws.StringValue(p.RootAccess)),
+		VolumeSizeInGB:             p.VolumeSizeInGB,
+		AcceleratorTypes:           generateAcceleratorTypes(p.AcceleratorTypes),
+		LifecycleConfigName:        p.LifecycleConfigName,
+		DefaultCodeRepository:      p.DefaultCodeRepository,
+		AdditionalCodeRepositories: p.AdditionalCodeRepositories,
+		Tags:                       GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateNotebookInstanceInput returns an update input that brings
+// the observed notebook instance to the given parameters. Settings that are
+// no longer desired are disassociated.
+func GenerateUpdateNotebookInstanceInput(name string, p v1alpha1.NotebookInstanceParameters, o sagemaker.DescribeNotebookInstanceOutput) *sagemaker.UpdateNotebookInstanceInput {
+	in := &sagemaker.UpdateNotebookInstanceInput{
+		NotebookInstanceName:       aws.String(name),
+		InstanceType:               sagemaker.InstanceType(p.InstanceType),
+		RoleArn:                    p.RoleARN,
+		RootAccess:                 sagemaker.RootAccess(aws.StringValue(p.RootAccess)),
+		VolumeSizeInGB:             p.VolumeSizeInGB,
+		AcceleratorTypes:           generateAcceleratorTypes(p.AcceleratorTypes),
+		LifecycleConfigName:        p.LifecycleConfigName,
+		DefaultCodeRepository:      p.DefaultCodeRepository,
+		AdditionalCodeRepositories: p.AdditionalCodeRepositories,
+	}
+	if len(p.AcceleratorTypes) == 0 && len(o.AcceleratorTypes) > 0 {
+		in.DisassociateAcceleratorTypes = aws.Bool(true)
+	}
+	if p.LifecycleConfigName == nil && o.NotebookInstanceLifecycleConfigName != nil {
+		in.DisassociateLifecycleConfig = aws.Bool(true)
+	}
+	if p.DefaultCodeRepository == nil && o.DefaultCodeRepository != nil {
+		in.DisassociateDefaultCodeRepository = aws.Bool(true)
+	}
+	if len(p.AdditionalCodeRepositories) == 0 && len(o.AdditionalCodeRepositories) > 0 {
+		in.DisassociateAdditionalCodeRepositories = aws.Bool(true)
+	}
+	return in
+}
+
+// GenerateNotebookInstanceObservation is used to produce
+// v1alpha1.NotebookInstanceObservation from
+// sagemaker.DescribeNotebookInstanceOutput.
+func GenerateNotebookInstanceObservation(o sagemaker.DescribeNotebookInstanceOutput) v1alpha1.NotebookInstanceObservation {
+	return v1alpha1.NotebookInstanceObservation{
+		NotebookInstanceARN:    aws.StringValue(o.NotebookInstanceArn),
+		NotebookInstanceStatus: string(o.NotebookInstanceStatus),
+		URL:                    aws.StringValue(o.Url),
+		NetworkInterfaceID:     aws.StringValue(o.NetworkInterfaceId),
+		FailureReason:          aws.StringValue(o.FailureReason),
+	}
+}
+
+// LateInitializeNotebookInstance fills the empty fields in
+// *v1alpha1.NotebookInstanceParameters with the values seen in
+// sagemaker.DescribeNotebookInstanceOutput.
+func LateInitializeNotebookInstance(in *v1alpha1.NotebookInstanceParameters, o *sagemaker.DescribeNotebookInstanceOutput) {
+	if o == nil {
+		return
+	}
+	in.RoleARN = awsclients.LateInitializeStringPtr(in.RoleARN, o.RoleArn)
+	in.SubnetID = awsclients.LateInitializeStringPtr(in.SubnetID, o.SubnetId)
+	in.KMSKeyID = awsclients.LateInitializeStringPtr(in.KMSKeyID, o.KmsKeyId)
+	in.VolumeSizeInGB = awsclients.LateInitializeInt64Ptr(in.VolumeSizeInGB, o.VolumeSizeInGB)
+	if len(in.SecurityGroupIDs) == 0 && len(o.SecurityGroups) != 0 {
+		in.SecurityGroupIDs = o.SecurityGroups
+	}
+	if in.DirectInternetAccess == nil && o.DirectInternetAccess != "" {
+		in.DirectInternetAccess = aws.String(string(o.DirectInternetAccess))
+	}
+	if in.RootAccess == nil && o.RootAccess != "" {
+		in.RootAccess = aws.String(string(o.RootAccess))
+	}
+}
+
+// IsNotebookInstanceUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsNotebookInstanceUpToDate(p v1alpha1.NotebookInstanceParameters, o sagemaker.DescribeNotebookInstanceOutput) bool {
+	observed := v1alpha1.NotebookInstanceParameters{
+		InstanceType:               string(o.InstanceType),
+		RoleARN:                    o.RoleArn,
+		VolumeSizeInGB:             o.VolumeSizeInGB,
+		LifecycleConfigName:        o.NotebookInstanceLifecycleConfigName,
+		DefaultCodeRepository:      o.DefaultCodeRepository,
+		AdditionalCodeRepositories: o.AdditionalCodeRepositories,
+	}
+	for _, t := range o.AcceleratorTypes {
+		observed.AcceleratorTypes = append(observed.AcceleratorTypes, string(t))
+	}
+	if o.RootAccess != "" {
+		observed.RootAccess = aws.String(string(o.RootAccess))
+	}
+	desired := v1alpha1.NotebookInstanceParameters{
+		InstanceType:               p.InstanceType,
+		RoleARN:                    p.RoleARN,
+		VolumeSizeInGB:             p.VolumeSizeInGB,
+		LifecycleConfigName:        p.LifecycleConfigName,
+		DefaultCodeRepository:      p.DefaultCodeRepository,
+		AdditionalCodeRepositories: p.AdditionalCodeRepositories,
+		AcceleratorTypes:           p.AcceleratorTypes,
+		RootAccess:                 p.RootAccess,
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sagemaker
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+)
+
+var (
+	notebookName = "some-notebook"
+	roleARN      = "arn:aws:iam::123456789012:role/sagemaker"
+)
+
+func TestIsNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"UnknownModel": {
+			err:  awserr.New(errCodeValidation, "Could not find model \"arn:aws:sagemaker:us-east-1:123456789012:model/some\".", nil),
+			want: true,
+		},
+		"UnknownNotebookInstance": {
+			err:  awserr.New(errCodeValidation, "RecordNotFound", nil),
+			want: true,
+		},
+		"OtherValidationError": {
+			err:  awserr.New(errCodeValidation, "Cannot update in-progress endpoint", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []sagemaker.Tag
+		remove []string
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []sagemaker.Tag
+		want
+	}{
+		"UpToDate": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			observed: []sagemaker.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			want:     want{remove: []string{}},
+		},
+		"Changed": {
+			desired: []v1alpha1.Tag{{Key: "k", Value: "new"}, {Key: "added", Value: "v"}},
+			observed: []sagemaker.Tag{
+				{Key: aws.String("k"), Value: aws.String("old")},
+				{Key: aws.String("removed"), Value: aws.String("v")},
+			},
+			want: want{
+				add: []sagemaker.Tag{
+					{Key: aws.String("added"), Value: aws.String("v")},
+					{Key: aws.String("k"), Value: aws.String("new")},
+				},
+				remove: []string{"k", "removed"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			sort.Slice(add, func(i, j int) bool { return aws.StringValue(add[i].Key) < aws.StringValue(add[j].Key) })
+			sort.Strings(remove)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateNotebookInstanceInput(t *testing.T) {
+	cases := map[string]struct {
+		p   v1alpha1.NotebookInstanceParameters
+		o   sagemaker.DescribeNotebookInstanceOutput
+		out *sagemaker.UpdateNotebookInstanceInput
+	}{
+		"Resize": {
+			p: v1alpha1.NotebookInstanceParameters{
+				InstanceType:        "ml.t3.large",
+				RoleARN:             aws.String(roleARN),
+				VolumeSizeInGB:      aws.Int64(50),
+				LifecycleConfigName: aws.String("setup"),
+			},
+			o: sagemaker.DescribeNotebookInstanceOutput{
+				InstanceType:                        sagemaker.InstanceTypeMlT3Medium,
+				NotebookInstanceLifecycleConfigName: aws.String("setup"),
+			},
+			out: &sagemaker.UpdateNotebookInstanceInput{
+				NotebookInstanceName: aws.String(notebookName),
+				InstanceType:         sagemaker.InstanceTypeMlT3Large,
+				RoleArn:              aws.String(roleARN),
+				VolumeSizeInGB:       aws.Int64(50),
+				LifecycleConfigName:  aws.String("setup"),
+			},
+		},
+		"Disassociate": {
+			p: v1alpha1.NotebookInstanceParameters{
+				InstanceType: "ml.t3.medium",
+			},
+			o: sagemaker.DescribeNotebookInstanceOutput{
+				InstanceType:                        sagemaker.InstanceTypeMlT3Medium,
+				AcceleratorTypes:                    []sagemaker.NotebookInstanceAcceleratorType{sagemaker.NotebookInstanceAcceleratorTypeMlEia1Medium},
+				NotebookInstanceLifecycleConfigName: aws.String("setup"),
+				DefaultCodeRepository:               aws.String("https://github.com/example/notebooks"),
+				AdditionalCodeRepositories:          []string{"https://github.com/example/data"},
+			},
+			out: &sagemaker.UpdateNotebookInstanceInput{
+				NotebookInstanceName:                   aws.String(notebookName),
+				InstanceType:                           sagemaker.InstanceTypeMlT3Medium,
+				DisassociateAcceleratorTypes:           aws.Bool(true),
+				DisassociateLifecycleConfig:            aws.Bool(true),
+				DisassociateDefaultCodeRepository:      aws.Bool(true),
+				DisassociateAdditionalCodeRepositories: aws.Bool(true),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateNotebookInstanceInput(notebookName, tc.p, tc.o)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsNotebookInstanceUpToDate(t *testing.T) {
+	observed := sagemaker.DescribeNotebookInstanceOutput{
+		InstanceType:   sagemaker.InstanceTypeMlT3Medium,
+		RoleArn:        aws.String(roleARN),
+		RootAccess:     sagemaker.RootAccessEnabled,
+		VolumeSizeInGB: aws.Int64(5),
+		SubnetId:       aws.String("subnet-1234"),
+	}
+	cases := map[string]struct {
+		p    v1alpha1.NotebookInstanceParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.NotebookInstanceParameters{
+				InstanceType:   "ml.t3.medium",
+				RoleARN:        aws.String(roleARN),
+				RootAccess:     aws.String("Enabled"),
+				VolumeSizeInGB: aws.Int64(5),
+				SubnetID:       aws.String("subnet-1234"),
+			},
+			want: true,
+		},
+		"InstanceTypeChanged": {
+			p: v1alpha1.NotebookInstanceParameters{
+				InstanceType:   "ml.t3.large",
+				RoleARN:        aws.String(roleARN),
+				RootAccess:     aws.String("Enabled"),
+				VolumeSizeInGB: aws.Int64(5),
+			},
+			want: false,
+		},
+		"RootAccessDisabled": {
+			p: v1alpha1.NotebookInstanceParameters{
+				InstanceType:   "ml.t3.medium",
+				RoleARN:        aws.String(roleARN),
+				RootAccess:     aws.String("Disabled"),
+				VolumeSizeInGB: aws.Int64(5),
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNotebookInstanceUpToDate(tc.p, observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securityhub
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+
+	"github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
+)
+
+// AccountClient is the external client used for SecurityHubAccount Custom
+// Resource
+type AccountClient interface {
+	DescribeHubRequest(*securityhub.DescribeHubInput) securityhub.DescribeHubRequest
+	EnableSecurityHubRequest(*securityhub.EnableSecurityHubInput) securityhub.EnableSecurityHubRequest
+	DisableSecurityHubRequest(*securityhub.DisableSecurityHubInput) securityhub.DisableSecurityHubRequest
+}
+
+// NewAccountClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewAccountClient(cfg aws.Config) AccountClient {
+	return securityhub.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the hub or standards
+// subscription doesn't exist. Security Hub reports accounts it is not
+// enabled in as not subscribed.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	switch awsErr.Code() {
+	case securityhub.ErrCodeResourceNotFoundException:
+		return true
+	case securityhub.ErrCodeInvalidAccessException:
+		return strings.Contains(strings.ToLower(awsErr.Message()), "not subscribed")
+	}
+	return false
+}
+
+// GenerateEnableSecurityHubInput returns the input to enable Security Hub
+// with the given parameters.
+func GenerateEnableSecurityHubInput(p v1alpha1.SecurityHubAccountParameters) *securityhub.EnableSecurityHubInput {
+	in := &securityhub.EnableSecurityHubInput{
+		EnableDefaultStandards: p.EnableDefaultStandards,
+	}
+	if len(p.Tags) != 0 {
+		in.Tags = p.Tags
+	}
+	return in
+}
+
+// GenerateAccountObservation returns the observation of the given hub.
+func GenerateAccountObservation(o securityhub.DescribeHubOutput) v1alpha1.SecurityHubAccountObservation {
+	return v1alpha1.SecurityHubAccountObservation{
+		HubARN:       aws.StringValue(o.HubArn),
+		SubscribedAt: aws.StringValue(o.SubscribedAt),
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securityhub
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
+)
+
+func TestIsNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"ResourceNotFound": {
+			err:  awserr.New(securityhub.ErrCodeResourceNotFoundException, "", nil),
+			want: true,
+		},
+		"NotSubscribed": {
+			err:  awserr.New(securityhub.ErrCodeInvalidAccessException, "Account 123456789012 is not subscribed to AWS Security Hub", nil),
+			want: true,
+		},
+		"AccessDenied": {
+			err:  awserr.New(securityhub.ErrCodeInvalidAccessException, "Account 123456789012 is not authorized to perform this action", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateEnableSecurityHubInput(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.SecurityHubAccountParameters
+		want *securityhub.EnableSecurityHubInput
+	}{
+		"Defaults": {
+			in:   v1alpha1.SecurityHubAccountParameters{Tags: map[string]string{}},
+			want: &securityhub.EnableSecurityHubInput{},
+		},
+		"Full": {
+			in: v1alpha1.SecurityHubAccountParameters{
+				EnableDefaultStandards: aws.Bool(false),
+				Tags:                   map[string]string{"team": "security"},
+			},
+			want: &securityhub.EnableSecurityHubInput{
+				EnableDefaultStandards: aws.Bool(false),
+				Tags:                   map[string]string{"team": "security"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateEnableSecurityHubInput(tc.in)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/securityhub"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AccountClient = (*MockAccountClient)(nil)
+
+// MockAccountClient is a type that implements all the methods for AccountClient interface
+type MockAccountClient struct {
+	MockDescribeHub        func(*securityhub.DescribeHubInput) securityhub.DescribeHubRequest
+	MockEnableSecurityHub  func(*securityhub.EnableSecurityHubInput) securityhub.EnableSecurityHubRequest
+	MockDisableSecurityHub func(*securityhub.DisableSecurityHubInput) securityhub.DisableSecurityHubRequest
+}
+
+// DescribeHubRequest mocks DescribeHubRequest method
+func (m *MockAccountClient) DescribeHubRequest(input *securityhub.DescribeHubInput) securityhub.DescribeHubRequest {
+	return m.MockDescribeHub(input)
+}
+
+// EnableSecurityHubRequest mocks EnableSecurityHubRequest method
+func (m *MockAccountClient) EnableSecurityHubRequest(input *securityhub.EnableSecurityHubInput) securityhub.EnableSecurityHubRequest {
+	return m.MockEnableSecurityHub(input)
+}
+
+// DisableSecurityHubRequest mocks DisableSecurityHubRequest method
+func (m *MockAccountClient) DisableSecurityHubRequest(input *securityhub.DisableSecurityHubInput) securityhub.DisableSecurityHubRequest {
+	return m.MockDisableSecurityHub(input)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/securityhub"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.StandardsSubscriptionClient = (*MockStandardsSubscriptionClient)(nil)
+
+// MockStandardsSubscriptionClient is a type that implements all the methods for StandardsSubscriptionClient interface
+type MockStandardsSubscriptionClient struct {
+	MockGetEnabledStandards   func(*securityhub.GetEnabledStandardsInput) securityhub.GetEnabledStandardsRequest
+	MockBatchEnableStandards  func(*securityhub.BatchEnableStandardsInput) securityhub.BatchEnableStandardsRequest
+	MockBatchDisableStandards func(*securityhub.BatchDisableStandardsInput) securityhub.BatchDisableStandardsRequest
+}
+
+// GetEnabledStandardsRequest mocks GetEnabledStandardsRequest method
+func (m *MockStandardsSubscriptionClient) GetEnabledStandardsRequest(input *securityhub.GetEnabledStandardsInput) securityhub.GetEnabledStandardsRequest {
+	return m.MockGetEnabledStandards(input)
+}
+
+// BatchEnableStandardsRequest mocks BatchEnableStandardsRequest method
+func (m *MockStandardsSubscriptionClient) BatchEnableStandardsRequest(input *securityhub.BatchEnableStandardsInput) securityhub.BatchEnableStandardsRequest {
+	return m.MockBatchEnableStandards(input)
+}
+
+// BatchDisableStandardsRequest mocks BatchDisableStandardsRequest method
+func (m *MockStandardsSubscriptionClient) BatchDisableStandardsRequest(input *securityhub.BatchDisableStandardsInput) securityhub.BatchDisableStandardsRequest {
+	return m.MockBatchDisableStandards(input)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securityhub
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+
+	"github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
+)
+
+// StandardsSubscriptionClient is the external client used for
+// SecurityHubStandardsSubscription Custom Resource
+type StandardsSubscriptionClient interface {
+	GetEnabledStandardsRequest(*securityhub.GetEnabledStandardsInput) securityhub.GetEnabledStandardsRequest
+	BatchEnableStandardsRequest(*securityhub.BatchEnableStandardsInput) securityhub.BatchEnableStandardsRequest
+	BatchDisableStandardsRequest(*securityhub.BatchDisableStandardsInput) securityhub.BatchDisableStandardsRequest
+}
+
+// NewStandardsSubscriptionClient returns a new client using AWS credentials
+// as JSON encoded data.
+func NewStandardsSubscriptionClient(cfg aws.Config) StandardsSubscriptionClient {
+	return securityhub.New(cfg)
+}
+
+// GenerateBatchEnableStandardsInput returns the input to subscribe to the
+// standard of the given parameters.
+func GenerateBatchEnableStandardsInput(p v1alpha1.SecurityHubStandardsSubscriptionParameters) *securityhub.BatchEnableStandardsInput {
+	r := securityhub.StandardsSubscriptionRequest{
+		StandardsArn: aws.String(p.StandardsARN),
+	}
+	if len(p.StandardsInput) != 0 {
+		r.StandardsInput = p.StandardsInput
+	}
+	return &securityhub.BatchEnableStandardsInput{
+		StandardsSubscriptionRequests: []securityhub.StandardsSubscriptionRequest{r},
+	}
+}
+
+// GenerateStandardsSubscriptionObservation returns the observation of the
+// given standards subscription.
+func GenerateStandardsSubscriptionObservation(o securityhub.StandardsSubscription) v1alpha1.SecurityHubStandardsSubscriptionObservation {
+	return v1alpha1.SecurityHubStandardsSubscriptionObservation{
+		StandardsStatus: string(o.StandardsStatus),
+	}
+}
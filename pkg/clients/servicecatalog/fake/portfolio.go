@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/servicecatalog"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.PortfolioClient = (*MockPortfolioClient)(nil)
+
+// MockPortfolioClient is a type that implements all the methods for PortfolioClient interface
+type MockPortfolioClient struct {
+	MockCreatePortfolio                    func(*servicecatalog.CreatePortfolioInput) servicecatalog.CreatePortfolioRequest
+	MockDescribePortfolio                  func(*servicecatalog.DescribePortfolioInput) servicecatalog.DescribePortfolioRequest
+	MockUpdatePortfolio                    func(*servicecatalog.UpdatePortfolioInput) servicecatalog.UpdatePortfolioRequest
+	MockDeletePortfolio                    func(*servicecatalog.DeletePortfolioInput) servicecatalog.DeletePortfolioRequest
+	MockListPrincipalsForPortfolio         func(*servicecatalog.ListPrincipalsForPortfolioInput) servicecatalog.ListPrincipalsForPortfolioRequest
+	MockAssociatePrincipalWithPortfolio    func(*servicecatalog.AssociatePrincipalWithPortfolioInput) servicecatalog.AssociatePrincipalWithPortfolioRequest
+	MockDisassociatePrincipalFromPortfolio func(*servicecatalog.DisassociatePrincipalFromPortfolioInput) servicecatalog.DisassociatePrincipalFromPortfolioRequest
+}
+
+// CreatePortfolioRequest mocks CreatePortfolioRequest method
+func (m *MockPortfolioClient) CreatePortfolioRequest(input *servicecatalog.CreatePortfolioInput) servicecatalog.CreatePortfolioRequest {
+	return m.MockCreatePortfolio(input)
+}
+
+// DescribePortfolioRequest mocks DescribePortfolioRequest method
+func (m *MockPortfolioClient) DescribePortfolioRequest(input *servicecatalog.DescribePortfolioInput) servicecatalog.DescribePortfolioRequest {
+	return m.MockDescribePortfolio(input)
+}
+
+// UpdatePortfolioRequest mocks UpdatePortfolioRequest method
+func (m *MockPortfolioClient) UpdatePortfolioRequest(input *servicecatalog.UpdatePortfolioInput) servicecatalog.UpdatePortfolioRequest {
+	return m.MockUpdatePortfolio(input)
+}
+
+// DeletePortfolioRequest mocks DeletePortfolioRequest method
+func (m *MockPortfolioClient) DeletePortfolioRequest(input *servicecatalog.DeletePortfolioInput) servicecatalog.DeletePortfolioRequest {
+	return m.MockDeletePortfolio(input)
+}
+
+// ListPrincipalsForPortfolioRequest mocks ListPrincipalsForPortfolioRequest method
+func (m *MockPortfolioClient) ListPrincipalsForPortfolioRequest(input *servicecatalog.ListPrincipalsForPortfolioInput) servicecatalog.ListPrincipalsForPortfolioRequest {
+	return m.MockListPrincipalsForPortfolio(input)
+}
+
+// AssociatePrincipalWithPortfolioRequest mocks AssociatePrincipalWithPortfolioRequest method
+func (m *MockPortfolioClient) AssociatePrincipalWithPortfolioRequest(input *servicecatalog.AssociatePrincipalWithPortfolioInput) servicecatalog.AssociatePrincipalWithPortfolioRequest {
+	return m.MockAssociatePrincipalWithPortfolio(input)
+}
+
+// DisassociatePrincipalFromPortfolioRequest mocks DisassociatePrincipalFromPortfolioRequest method
+func (m *MockPortfolioClient) DisassociatePrincipalFromPortfolioRequest(input *servicecatalog.DisassociatePrincipalFromPortfolioInput) servicecatalog.DisassociatePrincipalFromPortfolioRequest {
+	return m.MockDisassociatePrincipalFromPortfolio(input)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/servicecatalog"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ProductClient = (*MockProductClient)(nil)
+
+// MockProductClient is a type that implements all the methods for ProductClient interface
+type MockProductClient struct {
+	MockCreateProduct                    func(*servicecatalog.CreateProductInput) servicecatalog.CreateProductRequest
+	MockDescribeProductAsAdmin           func(*servicecatalog.DescribeProductAsAdminInput) servicecatalog.DescribeProductAsAdminRequest
+	MockUpdateProduct                    func(*servicecatalog.UpdateProductInput) servicecatalog.UpdateProductRequest
+	MockDeleteProduct                    func(*servicecatalog.DeleteProductInput) servicecatalog.DeleteProductRequest
+	MockListPortfoliosForProduct         func(*servicecatalog.ListPortfoliosForProductInput) servicecatalog.ListPortfoliosForProductRequest
+	MockAssociateProductWithPortfolio    func(*servicecatalog.AssociateProductWithPortfolioInput) servicecatalog.AssociateProductWithPortfolioRequest
+	MockDisassociateProductFromPortfolio func(*servicecatalog.DisassociateProductFromPortfolioInput) servicecatalog.DisassociateProductFromPortfolioRequest
+	MockListConstraintsForPortfolio      func(*servicecatalog.ListConstraintsForPortfolioInput) servicecatalog.ListConstraintsForPortfolioRequest
+	MockDescribeConstraint               func(*servicecatalog.DescribeConstraintInput) servicecatalog.DescribeConstraintRequest
+	MockCreateConstraint                 func(*servicecatalog.CreateConstraintInput) servicecatalog.CreateConstraintRequest
+	MockUpdateConstraint                 func(*servicecatalog.UpdateConstraintInput) servicecatalog.UpdateConstraintRequest
+	MockDeleteConstraint                 func(*servicecatalog.DeleteConstraintInput) servicecatalog.DeleteConstraintRequest
+}
+
+// CreateProductRequest mocks CreateProductRequest method
+func (m *MockProductClient) CreateProductRequest(input *servicecatalog.CreateProductInput) servicecatalog.CreateProductRequest {
+	return m.MockCreateProduct(input)
+}
+
+// DescribeProductAsAdminRequest mocks DescribeProductAsAdminRequest method
+func (m *MockProductClient) DescribeProductAsAdminRequest(input *servicecatalog.DescribeProductAsAdminInput) servicecatalog.DescribeProductAsAdminRequest {
+	return m.MockDescribeProductAsAdmin(input)
+}
+
+// UpdateProductRequest mocks UpdateProductRequest method
+func (m *MockProductClient) UpdateProductRequest(input *servicecatalog.UpdateProductInput) servicecatalog.UpdateProductRequest {
+	return m.MockUpdateProduct(input)
+}
+
+// DeleteProductRequest mocks DeleteProductRequest method
+func (m *MockProductClient) DeleteProductRequest(input *servicecatalog.DeleteProductInput) servicecatalog.DeleteProductRequest {
+	return m.MockDeleteProduct(input)
+}
+
+// ListPortfoliosForProductRequest mocks ListPortfoliosForProductRequest method
+func (m *MockProductClient) ListPortfoliosForProductRequest(input *servicecatalog.ListPortfoliosForProductInput) servicecatalog.ListPortfoliosForProductRequest {
+	return m.MockListPortfoliosForProduct(input)
+}
+
+// AssociateProductWithPortfolioRequest mocks AssociateProductWithPortfolioRequest method
+func (m *MockProductClient) AssociateProductWithPortfolioRequest(input *servicecatalog.AssociateProductWithPortfolioInput) servicecatalog.AssociateProductWithPortfolioRequest {
+	return m.MockAssociateProductWithPortfolio(input)
+}
+
+// DisassociateProductFromPortfolioRequest mocks DisassociateProductFromPortfolioRequest method
+func (m *MockProductClient) DisassociateProductFromPortfolioRequest(input *servicecatalog.DisassociateProductFromPortfolioInput) servicecatalog.DisassociateProductFromPortfolioRequest {
+	return m.MockDisassociateProductFromPortfolio(input)
+}
+
+// ListConstraintsForPortfolioRequest mocks ListConstraintsForPortfolioRequest method
+func (m *MockProductClient) ListConstraintsForPortfolioRequest(input *servicecatalog.ListConstraintsForPortfolioInput) servicecatalog.ListConstraintsForPortfolioRequest {
+	return m.MockListConstraintsForPortfolio(input)
+}
+
+// DescribeConstraintRequest mocks DescribeConstraintRequest method
+func (m *MockProductClient) DescribeConstraintRequest(input *servicecatalog.DescribeConstraintInput) servicecatalog.DescribeConstraintRequest {
+	return m.MockDescribeConstraint(input)
+}
+
+// CreateConstraintRequest mocks CreateConstraintRequest method
+func (m *MockProductClient) CreateConstraintRequest(input *servicecatalog.CreateConstraintInput) servicecatalog.CreateConstraintRequest {
+	return m.MockCreateConstraint(input)
+}
+
+// UpdateConstraintRequest mocks UpdateConstraintRequest method
+func (m *MockProductClient) UpdateConstraintRequest(input *servicecatalog.UpdateConstraintInput) servicecatalog.UpdateConstraintRequest {
+	return m.MockUpdateConstraint(input)
+}
+
+// DeleteConstraintRequest mocks DeleteConstraintRequest method
+func (m *MockProductClient) DeleteConstraintRequest(input *servicecatalog.DeleteConstraintInput) servicecatalog.DeleteConstraintRequest {
+	return m.MockDeleteConstraint(input)
+}
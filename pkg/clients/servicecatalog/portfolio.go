@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+
+	"github.com/crossplane/provider-aws/apis/servicecatalog/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// PortfolioClient is the external client used for Portfolio Custom Resource
+type PortfolioClient interface {
+	CreatePortfolioRequest(*servicecatalog.CreatePortfolioInput) servicecatalog.CreatePortfolioRequest
+	DescribePortfolioRequest(*servicecatalog.DescribePortfolioInput) servicecatalog.DescribePortfolioRequest
+	UpdatePortfolioRequest(*servicecatalog.UpdatePortfolioInput) servicecatalog.UpdatePortfolioRequest
+	DeletePortfolioRequest(*servicecatalog.DeletePortfolioInput) servicecatalog.DeletePortfolioRequest
+	ListPrincipalsForPortfolioRequest(*servicecatalog.ListPrincipalsForPortfolioInput) servicecatalog.ListPrincipalsForPortfolioRequest
+	AssociatePrincipalWithPortfolioRequest(*servicecatalog.AssociatePrincipalWithPortfolioInput) servicecatalog.AssociatePrincipalWithPortfolioRequest
+	DisassociatePrincipalFromPortfolioRequest(*servicecatalog.DisassociatePrincipalFromPortfolioInput) servicecatalog.DisassociatePrincipalFromPortfolioRequest
+}
+
+// NewPortfolioClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewPortfolioClient(cfg aws.Config) PortfolioClient {
+	return servicecatalog.New(cfg)
+}
+
+// GenerateCreatePortfolioInput returns the create input of the portfolio with
+// the given parameters.
+func GenerateCreatePortfolioInput(idempotencyToken string, p v1alpha1.PortfolioParameters) *servicecatalog.CreatePortfolioInput {
+	return &servicecatalog.CreatePortfolioInput{
+		IdempotencyToken: aws.String(idempotencyToken),
+		DisplayName:      aws.String(p.DisplayName),
+		Description:      p.Description,
+		ProviderName:     aws.String(p.ProviderName),
+		Tags:             GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdatePortfolioInput returns the update input of the portfolio with
+// the given ID and parameters.
+func GenerateUpdatePortfolioInput(id string, p v1alpha1.PortfolioParameters, addTags []servicecatalog.Tag, removeTags []string) *servicecatalog.UpdatePortfolioInput {
+	in := &servicecatalog.UpdatePortfolioInput{
+		Id:           aws.String(id),
+		DisplayName:  aws.String(p.DisplayName),
+		Description:  p.Description,
+		ProviderName: aws.String(p.ProviderName),
+		AddTags:      addTags,
+	}
+	if len(removeTags) != 0 {
+		in.RemoveTags = removeTags
+	}
+	return in
+}
+
+// GeneratePortfolioObservation returns the observation of the given
+// portfolio.
+func GeneratePortfolioObservation(o servicecatalog.PortfolioDetail) v1alpha1.PortfolioObservation {
+	return v1alpha1.PortfolioObservation{
+		ARN: aws.StringValue(o.ARN),
+	}
+}
+
+// LateInitializePortfolio fills the empty fields of the given parameters with
+// the values of the observed portfolio.
+func LateInitializePortfolio(in *v1alpha1.PortfolioParameters, o *servicecatalog.PortfolioDetail) {
+	if o == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.Description)
+}
+
+// IsPortfolioUpToDate returns whether the attributes of the observed portfolio
+// are up to date with the given parameters.
+func IsPortfolioUpToDate(p v1alpha1.PortfolioParameters, o servicecatalog.PortfolioDetail) bool {
+	return p.DisplayName == aws.StringValue(o.DisplayName) &&
+		aws.StringValue(p.Description) == aws.StringValue(o.Description) &&
+		p.ProviderName == aws.StringValue(o.ProviderName)
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+
+	"github.com/crossplane/provider-aws/apis/servicecatalog/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// ConstraintTypeLaunch is the type of the constraints that specify the
+	// IAM role Service Catalog assumes to launch a product.
+	ConstraintTypeLaunch = "LAUNCH"
+
+	infoLoadTemplateFromURL = "LoadTemplateFromURL"
+)
+
+// ProductClient is the external client used for Product Custom Resource
+type ProductClient interface {
+	CreateProductRequest(*servicecatalog.CreateProductInput) servicecatalog.CreateProductRequest
+	DescribeProductAsAdminRequest(*servicecatalog.DescribeProductAsAdminInput) servicecatalog.DescribeProductAsAdminRequest
+	UpdateProductRequest(*servicecatalog.UpdateProductInput) servicecatalog.UpdateProductRequest
+	DeleteProductRequest(*servicecatalog.DeleteProductInput) servicecatalog.DeleteProductRequest
+	ListPortfoliosForProductRequest(*servicecatalog.ListPortfoliosForProductInput) servicecatalog.ListPortfoliosForProductRequest
+	AssociateProductWithPortfolioRequest(*servicecatalog.AssociateProductWithPortfolioInput) servicecatalog.AssociateProductWithPortfolioRequest
+	DisassociateProductFromPortfolioRequest(*servicecatalog.DisassociateProductFromPortfolioInput) servicecatalog.DisassociateProductFromPortfolioRequest
+	ListConstraintsForPortfolioRequest(*servicecatalog.ListConstraintsForPortfolioInput) servicecatalog.ListConstraintsForPortfolioRequest
+	DescribeConstraintRequest(*servicecatalog.DescribeConstraintInput) servicecatalog.DescribeConstraintRequest
+	CreateConstraintRequest(*servicecatalog.CreateConstraintInput) servicecatalog.CreateConstraintRequest
+	UpdateConstraintRequest(*servicecatalog.UpdateConstraintInput) servicecatalog.UpdateConstraintRequest
+	DeleteConstraintRequest(*servicecatalog.DeleteConstraintInput) servicecatalog.DeleteConstraintRequest
+}
+
+// NewProductClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewProductClient(cfg aws.Config) ProductClient {
+	return servicecatalog.New(cfg)
+}
+
+// GenerateTemplateURL returns the URL of the CloudFormation template of the
+// given provisioning artifact. The URL is derived from the template bucket
+// and key unless it is set explicitly.
+func GenerateTemplateURL(p v1alpha1.ProvisioningArtifactParameters) string {
+	if p.TemplateURL != nil {
+		return aws.StringValue(p.TemplateURL)
+	}
+	return fmt.Sprintf("https://%s.s3.amazonaws.com/%s", aws.StringValue(p.TemplateBucket), aws.StringValue(p.TemplateKey))
+}
+
+// GenerateCreateProductInput returns the create input of the product with the
+// given parameters.
+func GenerateCreateProductInput(idempotencyToken string, p v1alpha1.ProductParameters) *servicecatalog.CreateProductInput {
+	return &servicecatalog.CreateProductInput{
+		IdempotencyToken:   aws.String(idempotencyToken),
+		Name:               aws.String(p.Name),
+		Owner:              aws.String(p.Owner),
+		Description:        p.Description,
+		Distributor:        p.Distributor,
+		SupportDescription: p.SupportDescription,
+		SupportEmail:       p.SupportEmail,
+		SupportUrl:         p.SupportURL,
+		ProductType:        servicecatalog.ProductTypeCloudFormationTemplate,
+		ProvisioningArtifactParameters: &servicecatalog.ProvisioningArtifactProperties{
+			Name:                      p.ProvisioningArtifact.Name,
+			Description:               p.ProvisioningArtifact.Description,
+			DisableTemplateValidation: p.ProvisioningArtifact.DisableTemplateValidation,
+			Info:                      map[string]string{infoLoadTemplateFromURL: GenerateTemplateURL(p.ProvisioningArtifact)},
+			Type:                      servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+		},
+		Tags: GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateProductInput returns the update input of the product with the
+// given ID and parameters.
+func GenerateUpdateProductInput(id string, p v1alpha1.ProductParameters, addTags []servicecatalog.Tag, removeTags []string) *servicecatalog.UpdateProductInput {
+	in := &servicecatalog.UpdateProductInput{
+		Id:                 aws.String(id),
+		Name:               aws.String(p.Name),
+		Owner:              aws.String(p.Owner),
+		Description:        p.Description,
+		Distributor:        p.Distributor,
+		SupportDescription: p.SupportDescription,
+		SupportEmail:       p.SupportEmail,
+		SupportUrl:         p.SupportURL,
+		AddTags:            addTags,
+	}
+	if len(removeTags) != 0 {
+		in.RemoveTags = removeTags
+	}
+	return in
+}
+
+// GenerateProductObservation returns the observation of the given product.
+func GenerateProductObservation(o servicecatalog.DescribeProductAsAdminOutput) v1alpha1.ProductObservation {
+	obs := v1alpha1.ProductObservation{}
+	if o.ProductViewDetail != nil {
+		obs.ARN = aws.StringValue(o.ProductViewDetail.ProductARN)
+		obs.Status = string(o.ProductViewDetail.Status)
+		if o.ProductViewDetail.ProductViewSummary != nil {
+			obs.ProductViewID = aws.StringValue(o.ProductViewDetail.ProductViewSummary.Id)
+		}
+	}
+	if len(o.ProvisioningArtifactSummaries) != 0 {
+		obs.ProvisioningArtifactID = aws.StringValue(o.ProvisioningArtifactSummaries[0].Id)
+	}
+	return obs
+}
+
+// LateInitializeProduct fills the empty fields of the given parameters with
+// the values of the observed product.
+func LateInitializeProduct(in *v1alpha1.ProductParameters, o *servicecatalog.ProductViewSummary) {
+	if o == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.ShortDescription)
+	in.Distributor = awsclients.LateInitializeStringPtr(in.Distributor, o.Distributor)
+	in.SupportDescription = awsclients.LateInitializeStringPtr(in.SupportDescription, o.SupportDescription)
+	in.SupportEmail = awsclients.LateInitializeStringPtr(in.SupportEmail, o.SupportEmail)
+	in.SupportURL = awsclients.LateInitializeStringPtr(in.SupportURL, o.SupportUrl)
+}
+
+// IsProductUpToDate returns whether the attributes of the observed product are
+// up to date with the given parameters.
+func IsProductUpToDate(p v1alpha1.ProductParameters, o servicecatalog.ProductViewSummary) bool {
+	return p.Name == aws.StringValue(o.Name) &&
+		p.Owner == aws.StringValue(o.Owner) &&
+		aws.StringValue(p.Description) == aws.StringValue(o.ShortDescription) &&
+		aws.StringValue(p.Distributor) == aws.StringValue(o.Distributor) &&
+		aws.StringValue(p.SupportDescription) == aws.StringValue(o.SupportDescription) &&
+		aws.StringValue(p.SupportEmail) == aws.StringValue(o.SupportEmail) &&
+		aws.StringValue(p.SupportURL) == aws.StringValue(o.SupportUrl)
+}
+
+type launchConstraintParameters struct {
+	RoleARN string `json:"RoleArn"`
+}
+
+// GenerateLaunchConstraintParameters returns the parameters of a launch
+// constraint that uses the IAM role with the given ARN.
+func GenerateLaunchConstraintParameters(roleARN string) (string, error) {
+	b, err := json.Marshal(launchConstraintParameters{RoleARN: roleARN})
+	return string(b), err
+}
+
+// GetLaunchRoleARN returns the ARN of the IAM role in the given launch
+// constraint parameters, or an empty string if they don't specify one.
+func GetLaunchRoleARN(params *string) string {
+	p := launchConstraintParameters{}
+	if err := json.Unmarshal([]byte(aws.StringValue(params)), &p); err != nil {
+		return ""
+	}
+	return p.RoleARN
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/servicecatalog/v1alpha1"
+)
+
+var (
+	roleARN     = "arn:aws:iam::123456789012:role/launch"
+	templateURL = "https://templates.s3.amazonaws.com/website.yaml"
+)
+
+func TestGenerateTemplateURL(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ProvisioningArtifactParameters
+		want string
+	}{
+		"URL": {
+			p: v1alpha1.ProvisioningArtifactParameters{
+				TemplateURL:    aws.String("https://example.com/website.yaml"),
+				TemplateBucket: aws.String("templates"),
+				TemplateKey:    aws.String("website.yaml"),
+			},
+			want: "https://example.com/website.yaml",
+		},
+		"BucketAndKey": {
+			p: v1alpha1.ProvisioningArtifactParameters{
+				TemplateBucket: aws.String("templates"),
+				TemplateKey:    aws.String("website.yaml"),
+			},
+			want: templateURL,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateTemplateURL(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateProductInput(t *testing.T) {
+	p := v1alpha1.ProductParameters{
+		Name:  "website",
+		Owner: "platform",
+		ProvisioningArtifact: v1alpha1.ProvisioningArtifactParameters{
+			Name:           aws.String("v1"),
+			TemplateBucket: aws.String("templates"),
+			TemplateKey:    aws.String("website.yaml"),
+		},
+		Tags: []v1alpha1.Tag{{Key: "k", Value: "v"}},
+	}
+	want := &servicecatalog.CreateProductInput{
+		IdempotencyToken: aws.String("token"),
+		Name:             aws.String("website"),
+		Owner:            aws.String("platform"),
+		ProductType:      servicecatalog.ProductTypeCloudFormationTemplate,
+		ProvisioningArtifactParameters: &servicecatalog.ProvisioningArtifactProperties{
+			Name: aws.String("v1"),
+			Info: map[string]string{"LoadTemplateFromURL": templateURL},
+			Type: servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+		},
+		Tags: []servicecatalog.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}
+	got := GenerateCreateProductInput("token", p)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsProductUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ProductParameters
+		o    servicecatalog.ProductViewSummary
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.ProductParameters{Name: "website", Owner: "platform", Description: aws.String("d")},
+			o:    servicecatalog.ProductViewSummary{Name: aws.String("website"), Owner: aws.String("platform"), ShortDescription: aws.String("d")},
+			want: true,
+		},
+		"DescriptionChanged": {
+			p:    v1alpha1.ProductParameters{Name: "website", Owner: "platform", Description: aws.String("new")},
+			o:    servicecatalog.ProductViewSummary{Name: aws.String("website"), Owner: aws.String("platform"), ShortDescription: aws.String("old")},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsProductUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLaunchConstraintParameters(t *testing.T) {
+	params, err := GenerateLaunchConstraintParameters(roleARN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(`{"RoleArn":"`+roleARN+`"}`, params); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(roleARN, GetLaunchRoleARN(aws.String(params))); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("", GetLaunchRoleARN(aws.String("{"))); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+
+	"github.com/crossplane/provider-aws/apis/servicecatalog/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// IsNotFound returns true if the error is because the portfolio, product or
+// constraint doesn't exist.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == servicecatalog.ErrCodeResourceNotFoundException
+}
+
+// DiffAssociations returns the entities that need to be associated with and
+// disassociated from a portfolio or product to match the desired entities.
+func DiffAssociations(desired, observed []string) (associate, disassociate []string) {
+	current := make(map[string]bool, len(observed))
+	for _, e := range observed {
+		current[e] = true
+	}
+	for _, e := range desired {
+		if !current[e] {
+			associate = append(associate, e)
+		}
+		delete(current, e)
+	}
+	for e := range current {
+		disassociate = append(disassociate, e)
+	}
+	sort.Strings(disassociate)
+	return associate, disassociate
+}
+
+// GenerateTags returns the Service Catalog tags of the given tags.
+func GenerateTags(tags []v1alpha1.Tag) []servicecatalog.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]servicecatalog.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = servicecatalog.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that need to be added to and the keys of the tags
+// that need to be removed from the observed portfolio or product. Tags whose
+// value changed are only added since both are sent in the same update.
+func DiffTags(desired []v1alpha1.Tag, observed []servicecatalog.Tag) (add []servicecatalog.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, changed := awsclients.DiffTags(local, remote)
+	remove = make([]string, 0, len(changed))
+	for _, k := range changed {
+		if _, ok := local[k]; !ok {
+			remove = append(remove, k)
+		}
+	}
+	keys := make([]string, 0, len(addMap))
+	for k := range addMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		add = append(add, servicecatalog.Tag{Key: aws.String(k), Value: aws.String(addMap[k])})
+	}
+	sort.Strings(remove)
+	return add, remove
+}
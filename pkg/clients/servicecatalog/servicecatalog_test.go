@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/servicecatalog/v1alpha1"
+)
+
+func TestDiffAssociations(t *testing.T) {
+	type want struct {
+		associate    []string
+		disassociate []string
+	}
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		want     want
+	}{
+		"Same": {
+			desired:  []string{"port-a", "port-b"},
+			observed: []string{"port-b", "port-a"},
+			want:     want{},
+		},
+		"Changed": {
+			desired:  []string{"port-a"},
+			observed: []string{"port-c", "port-b"},
+			want: want{
+				associate:    []string{"port-a"},
+				disassociate: []string{"port-b", "port-c"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			associate, disassociate := DiffAssociations(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.associate, associate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disassociate, disassociate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []servicecatalog.Tag
+		remove []string
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []servicecatalog.Tag
+		want     want
+	}{
+		"Same": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			observed: []servicecatalog.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			want:     want{remove: []string{}},
+		},
+		"Changed": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: "new"}, {Key: "added", Value: "v"}},
+			observed: []servicecatalog.Tag{{Key: aws.String("k"), Value: aws.String("old")}, {Key: aws.String("stale"), Value: aws.String("v")}},
+			want: want{
+				add:    []servicecatalog.Tag{{Key: aws.String("added"), Value: aws.String("v")}, {Key: aws.String("k"), Value: aws.String("new")}},
+				remove: []string{"stale"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
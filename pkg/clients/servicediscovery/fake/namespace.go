@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/servicediscovery"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.NamespaceClient = (*MockNamespaceClient)(nil)
+
+// MockNamespaceClient is a type that implements all the methods for NamespaceClient interface
+type MockNamespaceClient struct {
+	MockCreatePrivateDnsNamespace func(*servicediscovery.CreatePrivateDnsNamespaceInput) servicediscovery.CreatePrivateDnsNamespaceRequest
+	MockCreatePublicDnsNamespace  func(*servicediscovery.CreatePublicDnsNamespaceInput) servicediscovery.CreatePublicDnsNamespaceRequest
+	MockCreateHttpNamespace       func(*servicediscovery.CreateHttpNamespaceInput) servicediscovery.CreateHttpNamespaceRequest
+	MockGetNamespace              func(*servicediscovery.GetNamespaceInput) servicediscovery.GetNamespaceRequest
+	MockListNamespaces            func(*servicediscovery.ListNamespacesInput) servicediscovery.ListNamespacesRequest
+	MockDeleteNamespace           func(*servicediscovery.DeleteNamespaceInput) servicediscovery.DeleteNamespaceRequest
+}
+
+// CreatePrivateDnsNamespaceRequest mocks CreatePrivateDnsNamespaceRequest method
+func (m *MockNamespaceClient) CreatePrivateDnsNamespaceRequest(input *servicediscovery.CreatePrivateDnsNamespaceInput) servicediscovery.CreatePrivateDnsNamespaceRequest {
+	return m.MockCreatePrivateDnsNamespace(input)
+}
+
+// CreatePublicDnsNamespaceRequest mocks CreatePublicDnsNamespaceRequest method
+func (m *MockNamespaceClient) CreatePublicDnsNamespaceRequest(input *servicediscovery.CreatePublicDnsNamespaceInput) servicediscovery.CreatePublicDnsNamespaceRequest {
+	return m.MockCreatePublicDnsNamespace(input)
+}
+
+// CreateHttpNamespaceRequest mocks CreateHttpNamespaceRequest method
+func (m *MockNamespaceClient) CreateHttpNamespaceRequest(input *servicediscovery.CreateHttpNamespaceInput) servicediscovery.CreateHttpNamespaceRequest {
+	return m.MockCreateHttpNamespace(input)
+}
+
+// GetNamespaceRequest mocks GetNamespaceRequest method
+func (m *MockNamespaceClient) GetNamespaceRequest(input *servicediscovery.GetNamespaceInput) servicediscovery.GetNamespaceRequest {
+	return m.MockGetNamespace(input)
+}
+
+// ListNamespacesRequest mocks ListNamespacesRequest method
+func (m *MockNamespaceClient) ListNamespacesRequest(input *servicediscovery.ListNamespacesInput) servicediscovery.ListNamespacesRequest {
+	return m.MockListNamespaces(input)
+}
+
+// DeleteNamespaceRequest mocks DeleteNamespaceRequest method
+func (m *MockNamespaceClient) DeleteNamespaceRequest(input *servicediscovery.DeleteNamespaceInput) servicediscovery.DeleteNamespaceRequest {
+	return m.MockDeleteNamespace(input)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/servicediscovery"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ServiceClient = (*MockServiceClient)(nil)
+
+// MockServiceClient is a type that implements all the methods for ServiceClient interface
+type MockServiceClient struct {
+	MockCreateService func(*servicediscovery.CreateServiceInput) servicediscovery.CreateServiceRequest
+	MockGetService    func(*servicediscovery.GetServiceInput) servicediscovery.GetServiceRequest
+	MockUpdateService func(*servicediscovery.UpdateServiceInput) servicediscovery.UpdateServiceRequest
+	MockDeleteService func(*servicediscovery.DeleteServiceInput) servicediscovery.DeleteServiceRequest
+}
+
+// CreateServiceRequest mocks CreateServiceRequest method
+func (m *MockServiceClient) CreateServiceRequest(input *servicediscovery.CreateServiceInput) servicediscovery.CreateServiceRequest {
+	return m.MockCreateService(input)
+}
+
+// GetServiceRequest mocks GetServiceRequest method
+func (m *MockServiceClient) GetServiceRequest(input *servicediscovery.GetServiceInput) servicediscovery.GetServiceRequest {
+	return m.MockGetService(input)
+}
+
+// UpdateServiceRequest mocks UpdateServiceRequest method
+func (m *MockServiceClient) UpdateServiceRequest(input *servicediscovery.UpdateServiceInput) servicediscovery.UpdateServiceRequest {
+	return m.MockUpdateService(input)
+}
+
+// DeleteServiceRequest mocks DeleteServiceRequest method
+func (m *MockServiceClient) DeleteServiceRequest(input *servicediscovery.DeleteServiceInput) servicediscovery.DeleteServiceRequest {
+	return m.MockDeleteService(input)
+}
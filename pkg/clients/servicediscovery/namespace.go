@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicediscovery
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+)
+
+// NamespaceClient is the external client used for the PrivateDNSNamespace,
+// PublicDNSNamespace and HTTPNamespace Custom Resources
+type NamespaceClient interface {
+	CreatePrivateDnsNamespaceRequest(*servicediscovery.CreatePrivateDnsNamespaceInput) servicediscovery.CreatePrivateDnsNamespaceRequest
+	CreatePublicDnsNamespaceRequest(*servicediscovery.CreatePublicDnsNamespaceInput) servicediscovery.CreatePublicDnsNamespaceRequest
+	CreateHttpNamespaceRequest(*servicediscovery.CreateHttpNamespaceInput) servicediscovery.CreateHttpNamespaceRequest
+	GetNamespaceRequest(*servicediscovery.GetNamespaceInput) servicediscovery.GetNamespaceRequest
+	ListNamespacesRequest(*servicediscovery.ListNamespacesInput) servicediscovery.ListNamespacesRequest
+	DeleteNamespaceRequest(*servicediscovery.DeleteNamespaceInput) servicediscovery.DeleteNamespaceRequest
+}
+
+// NewNamespaceClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewNamespaceClient(cfg aws.Config) NamespaceClient {
+	return servicediscovery.New(cfg)
+}
+
+// IsNamespaceNotFound returns true if the error is because the namespace
+// doesn't exist.
+func IsNamespaceNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == servicediscovery.ErrCodeNamespaceNotFound {
+		return true
+	}
+	return false
+}
+
+// IsDuplicateRequest returns true if the error is because a request with the
+// same creator request ID is already in progress.
+func IsDuplicateRequest(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == servicediscovery.ErrCodeDuplicateRequest {
+		return true
+	}
+	return false
+}
+
+// FindNamespace returns the namespace of the given type and name that was
+// created with the given creator request ID, or nil if there is none.
+// Creating a namespace is an asynchronous operation that doesn't return the
+// ID of the namespace, so it can only be found by its creator request ID
+// once the operation has finished.
+func FindNamespace(ctx context.Context, c NamespaceClient, nsType servicediscovery.NamespaceType, name, creatorRequestID string) (*servicediscovery.Namespace, error) {
+	input := &servicediscovery.ListNamespacesInput{
+		Filters: []servicediscovery.NamespaceFilter{{
+			Name:      servicediscovery.NamespaceFilterNameType,
+			Condition: servicediscovery.FilterConditionEq,
+			Values:    []string{string(nsType)},
+		}},
+	}
+	for {
+		rsp, err := c.ListNamespacesRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range rsp.Namespaces {
+			if aws.StringValue(s.Name) != name {
+				continue
+			}
+			ns, err := c.GetNamespaceRequest(&servicediscovery.GetNamespaceInput{Id: s.Id}).Send(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if aws.StringValue(ns.Namespace.CreatorRequestId) == creatorRequestID {
+				return ns.Namespace, nil
+			}
+		}
+		if rsp.NextToken == nil {
+			return nil, nil
+		}
+		input.NextToken = rsp.NextToken
+	}
+}
+
+// GenerateCreatePrivateDNSNamespaceInput returns the create input of a
+// private DNS namespace with the given parameters.
+func GenerateCreatePrivateDNSNamespaceInput(creatorRequestID string, p v1alpha1.PrivateDNSNamespaceParameters) *servicediscovery.CreatePrivateDnsNamespaceInput {
+	return &servicediscovery.CreatePrivateDnsNamespaceInput{
+		CreatorRequestId: aws.String(creatorRequestID),
+		Name:             aws.String(p.Name),
+		Description:      p.Description,
+		Vpc:              p.VPC,
+	}
+}
+
+// GenerateCreatePublicDNSNamespaceInput returns the create input of a public
+// DNS namespace with the given parameters.
+func GenerateCreatePublicDNSNamespaceInput(creatorRequestID string, p v1alpha1.PublicDNSNamespaceParameters) *servicediscovery.CreatePublicDnsNamespaceInput {
+	return &servicediscovery.CreatePublicDnsNamespaceInput{
+		CreatorRequestId: aws.String(creatorRequestID),
+		Name:             aws.String(p.Name),
+		Description:      p.Description,
+	}
+}
+
+// GenerateCreateHTTPNamespaceInput returns the create input of an HTTP
+// namespace with the given parameters.
+func GenerateCreateHTTPNamespaceInput(creatorRequestID string, p v1alpha1.HTTPNamespaceParameters) *servicediscovery.CreateHttpNamespaceInput {
+	return &servicediscovery.CreateHttpNamespaceInput{
+		CreatorRequestId: aws.String(creatorRequestID),
+		Name:             aws.String(p.Name),
+		Description:      p.Description,
+	}
+}
+
+func hostedZoneID(ns servicediscovery.Namespace) string {
+	if ns.Properties == nil || ns.Properties.DnsProperties == nil {
+		return ""
+	}
+	return aws.StringValue(ns.Properties.DnsProperties.HostedZoneId)
+}
+
+// GeneratePrivateDNSNamespaceObservation returns the observation of the
+// given private DNS namespace.
+func GeneratePrivateDNSNamespaceObservation(ns servicediscovery.Namespace) v1alpha1.PrivateDNSNamespaceObservation {
+	return v1alpha1.PrivateDNSNamespaceObservation{
+		ARN:          aws.StringValue(ns.Arn),
+		HostedZoneID: hostedZoneID(ns),
+		ServiceCount: aws.Int64Value(ns.ServiceCount),
+	}
+}
+
+// GeneratePublicDNSNamespaceObservation returns the observation of the given
+// public DNS namespace.
+func GeneratePublicDNSNamespaceObservation(ns servicediscovery.Namespace) v1alpha1.PublicDNSNamespaceObservation {
+	return v1alpha1.PublicDNSNamespaceObservation{
+		ARN:          aws.StringValue(ns.Arn),
+		HostedZoneID: hostedZoneID(ns),
+		ServiceCount: aws.Int64Value(ns.ServiceCount),
+	}
+}
+
+// GenerateHTTPNamespaceObservation returns the observation of the given HTTP
+// namespace.
+func GenerateHTTPNamespaceObservation(ns servicediscovery.Namespace) v1alpha1.HTTPNamespaceObservation {
+	o := v1alpha1.HTTPNamespaceObservation{
+		ARN:          aws.StringValue(ns.Arn),
+		ServiceCount: aws.Int64Value(ns.ServiceCount),
+	}
+	if ns.Properties != nil && ns.Properties.HttpProperties != nil {
+		o.HTTPName = aws.StringValue(ns.Properties.HttpProperties.HttpName)
+	}
+	return o
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicediscovery
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// mockNamespaceClient is defined here since the fake package imports this
+// package.
+type mockNamespaceClient struct {
+	NamespaceClient
+	pages      [][]servicediscovery.NamespaceSummary
+	namespaces map[string]*servicediscovery.Namespace
+}
+
+func (c *mockNamespaceClient) ListNamespacesRequest(input *servicediscovery.ListNamespacesInput) servicediscovery.ListNamespacesRequest {
+	page := 0
+	if input.NextToken != nil {
+		page = 1
+	}
+	out := &servicediscovery.ListNamespacesOutput{Namespaces: c.pages[page]}
+	if page+1 < len(c.pages) {
+		out.NextToken = aws.String("next")
+	}
+	return servicediscovery.ListNamespacesRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+	}
+}
+
+func (c *mockNamespaceClient) GetNamespaceRequest(input *servicediscovery.GetNamespaceInput) servicediscovery.GetNamespaceRequest {
+	return servicediscovery.GetNamespaceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &servicediscovery.GetNamespaceOutput{
+			Namespace: c.namespaces[aws.StringValue(input.Id)],
+		}},
+	}
+}
+
+func TestFindNamespace(t *testing.T) {
+	summary := func(id, name string) servicediscovery.NamespaceSummary {
+		return servicediscovery.NamespaceSummary{Id: aws.String(id), Name: aws.String(name)}
+	}
+	namespaces := map[string]*servicediscovery.Namespace{
+		"ns-other": {Id: aws.String("ns-other"), Name: aws.String("example.local"), CreatorRequestId: aws.String("other-uid")},
+		"ns-mine":  {Id: aws.String("ns-mine"), Name: aws.String("example.local"), CreatorRequestId: aws.String("my-uid")},
+	}
+
+	cases := map[string]struct {
+		pages [][]servicediscovery.NamespaceSummary
+		want  *servicediscovery.Namespace
+	}{
+		"NotFound": {
+			pages: [][]servicediscovery.NamespaceSummary{{summary("ns-other", "example.local")}},
+		},
+		"SecondPage": {
+			pages: [][]servicediscovery.NamespaceSummary{
+				{summary("ns-other", "example.local")},
+				{summary("ns-mine", "example.local")},
+			},
+			want: namespaces["ns-mine"],
+		},
+		"OtherName": {
+			pages: [][]servicediscovery.NamespaceSummary{{summary("ns-mine", "other.local")}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &mockNamespaceClient{pages: tc.pages, namespaces: namespaces}
+			got, err := FindNamespace(context.Background(), c, servicediscovery.NamespaceTypeDnsPrivate, "example.local", "my-uid")
+			if err != nil {
+				t.Fatalf("FindNamespace(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(servicediscovery.Namespace{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicediscovery
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+)
+
+// ServiceClient is the external client used for Service Custom Resource
+type ServiceClient interface {
+	CreateServiceRequest(*servicediscovery.CreateServiceInput) servicediscovery.CreateServiceRequest
+	GetServiceRequest(*servicediscovery.GetServiceInput) servicediscovery.GetServiceRequest
+	UpdateServiceRequest(*servicediscovery.UpdateServiceInput) servicediscovery.UpdateServiceRequest
+	DeleteServiceRequest(*servicediscovery.DeleteServiceInput) servicediscovery.DeleteServiceRequest
+}
+
+// NewServiceClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewServiceClient(cfg aws.Config) ServiceClient {
+	return servicediscovery.New(cfg)
+}
+
+// IsServiceNotFound returns true if the error is because the service doesn't
+// exist.
+func IsServiceNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == servicediscovery.ErrCodeServiceNotFound {
+		return true
+	}
+	return false
+}
+
+func generateDNSRecords(in []v1alpha1.DNSRecord) []servicediscovery.DnsRecord {
+	if in == nil {
+		return nil
+	}
+	res := make([]servicediscovery.DnsRecord, len(in))
+	for i, r := range in {
+		res[i] = servicediscovery.DnsRecord{
+			Type: servicediscovery.RecordType(r.Type),
+			TTL:  aws.Int64(r.TTL),
+		}
+	}
+	return res
+}
+
+func generateHealthCheckConfig(in *v1alpha1.HealthCheckConfig) *servicediscovery.HealthCheckConfig {
+	if in == nil {
+		return nil
+	}
+	return &servicediscovery.HealthCheckConfig{
+		Type:             servicediscovery.HealthCheckType(in.Type),
+		ResourcePath:     in.ResourcePath,
+		FailureThreshold: in.FailureThreshold,
+	}
+}
+
+// GenerateCreateServiceInput returns the create input of a service with the
+// given parameters.
+func GenerateCreateServiceInput(creatorRequestID string, p v1alpha1.ServiceParameters) *servicediscovery.CreateServiceInput {
+	in := &servicediscovery.CreateServiceInput{
+		CreatorRequestId:  aws.String(creatorRequestID),
+		Name:              aws.String(p.Name),
+		Description:       p.Description,
+		NamespaceId:       p.NamespaceID,
+		HealthCheckConfig: generateHealthCheckConfig(p.HealthCheckConfig),
+	}
+	if p.DNSConfig != nil {
+		in.DnsConfig = &servicediscovery.DnsConfig{
+			DnsRecords: generateDNSRecords(p.DNSConfig.DNSRecords),
+		}
+		if p.DNSConfig.RoutingPolicy != nil {
+			in.DnsConfig.RoutingPolicy = servicediscovery.RoutingPolicy(*p.DNSConfig.RoutingPolicy)
+		}
+	}
+	if p.HealthCheckCustomConfig != nil {
+		in.HealthCheckCustomConfig = &servicediscovery.HealthCheckCustomConfig{
+			FailureThreshold: p.HealthCheckCustomConfig.FailureThreshold,
+		}
+	}
+	return in
+}
+
+// GenerateUpdateServiceInput returns the update input of the service with the
+// given ID and parameters. The DNS records and health check of the service
+// are replaced, so they are removed if they are not set.
+func GenerateUpdateServiceInput(id string, p v1alpha1.ServiceParameters) *servicediscovery.UpdateServiceInput {
+	in := &servicediscovery.UpdateServiceInput{
+		Id: aws.String(id),
+		Service: &servicediscovery.ServiceChange{
+			Description:       p.Description,
+			DnsConfig:         &servicediscovery.DnsConfigChange{DnsRecords: []servicediscovery.DnsRecord{}},
+			HealthCheckConfig: generateHealthCheckConfig(p.HealthCheckConfig),
+		},
+	}
+	if p.DNSConfig != nil {
+		in.Service.DnsConfig.DnsRecords = generateDNSRecords(p.DNSConfig.DNSRecords)
+	}
+	return in
+}
+
+// GenerateServiceObservation returns the observation of the given service.
+func GenerateServiceObservation(s servicediscovery.Service) v1alpha1.ServiceObservation {
+	return v1alpha1.ServiceObservation{
+		ARN:           aws.StringValue(s.Arn),
+		InstanceCount: aws.Int64Value(s.InstanceCount),
+	}
+}
+
+// LateInitializeService fills the empty fields of the service parameters with
+// the values of the observed service.
+func LateInitializeService(in *v1alpha1.ServiceParameters, s *servicediscovery.Service) {
+	if s == nil {
+		return
+	}
+	if in.NamespaceID == nil {
+		in.NamespaceID = s.NamespaceId
+	}
+	if in.DNSConfig != nil && in.DNSConfig.RoutingPolicy == nil && s.DnsConfig != nil && s.DnsConfig.RoutingPolicy != "" {
+		in.DNSConfig.RoutingPolicy = aws.String(string(s.DnsConfig.RoutingPolicy))
+	}
+	if in.HealthCheckConfig != nil && s.HealthCheckConfig != nil {
+		if in.HealthCheckConfig.ResourcePath == nil {
+			in.HealthCheckConfig.ResourcePath = s.HealthCheckConfig.ResourcePath
+		}
+		if in.HealthCheckConfig.FailureThreshold == nil {
+			in.HealthCheckConfig.FailureThreshold = s.HealthCheckConfig.FailureThreshold
+		}
+	}
+	if in.HealthCheckCustomConfig != nil && in.HealthCheckCustomConfig.FailureThreshold == nil && s.HealthCheckCustomConfig != nil {
+		in.HealthCheckCustomConfig.FailureThreshold = s.HealthCheckCustomConfig.FailureThreshold
+	}
+}
+
+// IsServiceUpToDate returns true if the updatable settings of the observed
+// service match the parameters.
+func IsServiceUpToDate(p v1alpha1.ServiceParameters, s servicediscovery.Service) bool {
+	if aws.StringValue(p.Description) != aws.StringValue(s.Description) {
+		return false
+	}
+	var records []servicediscovery.DnsRecord
+	if p.DNSConfig != nil {
+		records = generateDNSRecords(p.DNSConfig.DNSRecords)
+	}
+	var observed []servicediscovery.DnsRecord
+	if s.DnsConfig != nil {
+		observed = s.DnsConfig.DnsRecords
+	}
+	if !cmp.Equal(records, observed, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(servicediscovery.DnsRecord{})) {
+		return false
+	}
+	return cmp.Equal(generateHealthCheckConfig(p.HealthCheckConfig), s.HealthCheckConfig, cmpopts.IgnoreUnexported(servicediscovery.HealthCheckConfig{}))
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicediscovery
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+)
+
+func TestIsServiceUpToDate(t *testing.T) {
+	observed := servicediscovery.Service{
+		Description: aws.String("backend"),
+		DnsConfig: &servicediscovery.DnsConfig{
+			RoutingPolicy: servicediscovery.RoutingPolicyMultivalue,
+			DnsRecords:    []servicediscovery.DnsRecord{{Type: servicediscovery.RecordTypeA, TTL: aws.Int64(60)}},
+		},
+		HealthCheckConfig: &servicediscovery.HealthCheckConfig{
+			Type:             servicediscovery.HealthCheckTypeHttp,
+			ResourcePath:     aws.String("/"),
+			FailureThreshold: aws.Int64(1),
+		},
+	}
+	params := func(m ...func(*v1alpha1.ServiceParameters)) v1alpha1.ServiceParameters {
+		p := v1alpha1.ServiceParameters{
+			Description: aws.String("backend"),
+			DNSConfig: &v1alpha1.DNSConfig{
+				RoutingPolicy: aws.String("MULTIVALUE"),
+				DNSRecords:    []v1alpha1.DNSRecord{{Type: "A", TTL: 60}},
+			},
+			HealthCheckConfig: &v1alpha1.HealthCheckConfig{
+				Type:             "HTTP",
+				ResourcePath:     aws.String("/"),
+				FailureThreshold: aws.Int64(1),
+			},
+		}
+		for _, f := range m {
+			f(&p)
+		}
+		return p
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ServiceParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    params(),
+			want: true,
+		},
+		"DescriptionChanged": {
+			p:    params(func(p *v1alpha1.ServiceParameters) { p.Description = aws.String("frontend") }),
+			want: false,
+		},
+		"RecordAdded": {
+			p: params(func(p *v1alpha1.ServiceParameters) {
+				p.DNSConfig.DNSRecords = append(p.DNSConfig.DNSRecords, v1alpha1.DNSRecord{Type: "AAAA", TTL: 60})
+			}),
+			want: false,
+		},
+		"HealthCheckRemoved": {
+			p:    params(func(p *v1alpha1.ServiceParameters) { p.HealthCheckConfig = nil }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsServiceUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateServiceInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ServiceParameters
+		want []servicediscovery.DnsRecord
+	}{
+		"DNSRecords": {
+			p: v1alpha1.ServiceParameters{
+				DNSConfig: &v1alpha1.DNSConfig{DNSRecords: []v1alpha1.DNSRecord{{Type: "SRV", TTL: 10}}},
+			},
+			want: []servicediscovery.DnsRecord{{Type: servicediscovery.RecordTypeSrv, TTL: aws.Int64(10)}},
+		},
+		"NoDNSConfig": {
+			p:    v1alpha1.ServiceParameters{},
+			want: []servicediscovery.DnsRecord{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateServiceInput("srv-1", tc.p)
+			if diff := cmp.Diff(tc.want, got.Service.DnsConfig.DnsRecords); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/shield"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ProtectionClient = (*MockProtectionClient)(nil)
+
+// MockProtectionClient is a type that implements all the methods for ProtectionClient interface
+type MockProtectionClient struct {
+	MockDescribeProtection func(*shield.DescribeProtectionInput) shield.DescribeProtectionRequest
+	MockCreateProtection   func(*shield.CreateProtectionInput) shield.CreateProtectionRequest
+	MockDeleteProtection   func(*shield.DeleteProtectionInput) shield.DeleteProtectionRequest
+}
+
+// DescribeProtectionRequest mocks DescribeProtectionRequest method
+func (m *MockProtectionClient) DescribeProtectionRequest(input *shield.DescribeProtectionInput) shield.DescribeProtectionRequest {
+	return m.MockDescribeProtection(input)
+}
+
+// CreateProtectionRequest mocks CreateProtectionRequest method
+func (m *MockProtectionClient) CreateProtectionRequest(input *shield.CreateProtectionInput) shield.CreateProtectionRequest {
+	return m.MockCreateProtection(input)
+}
+
+// DeleteProtectionRequest mocks DeleteProtectionRequest method
+func (m *MockProtectionClient) DeleteProtectionRequest(input *shield.DeleteProtectionInput) shield.DeleteProtectionRequest {
+	return m.MockDeleteProtection(input)
+}
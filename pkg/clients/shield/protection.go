@@ -0,0 +1,44 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shield
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+)
+
+// ProtectionClient is the external client used for Protection Custom
+// Resource
+type ProtectionClient interface {
+	DescribeProtectionRequest(*shield.DescribeProtectionInput) shield.DescribeProtectionRequest
+	CreateProtectionRequest(*shield.CreateProtectionInput) shield.CreateProtectionRequest
+	DeleteProtectionRequest(*shield.DeleteProtectionInput) shield.DeleteProtectionRequest
+}
+
+// NewProtectionClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewProtectionClient(cfg aws.Config) ProtectionClient {
+	return shield.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the protection doesn't
+// exist.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == shield.ErrCodeResourceNotFoundException
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetics
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/synthetics/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// CanaryClient is the external client used for Canary Custom Resource
+type CanaryClient interface {
+	GetCanaryRequest(*synthetics.GetCanaryInput) synthetics.GetCanaryRequest
+	CreateCanaryRequest(*synthetics.CreateCanaryInput) synthetics.CreateCanaryRequest
+	UpdateCanaryRequest(*synthetics.UpdateCanaryInput) synthetics.UpdateCanaryRequest
+	DeleteCanaryRequest(*synthetics.DeleteCanaryInput) synthetics.DeleteCanaryRequest
+	StartCanaryRequest(*synthetics.StartCanaryInput) synthetics.StartCanaryRequest
+	StopCanaryRequest(*synthetics.StopCanaryInput) synthetics.StopCanaryRequest
+}
+
+// NewCanaryClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewCanaryClient(cfg aws.Config) CanaryClient {
+	return synthetics.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the canary doesn't exist.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == synthetics.ErrCodeResourceNotFoundException
+}
+
+// ArtifactS3Location returns the Amazon S3 location the results of the
+// canary runs are stored in. ArtifactS3Location takes precedence over
+// ArtifactBucket and ArtifactPrefix.
+func ArtifactS3Location(p v1alpha1.CanaryParameters) *string {
+	if p.ArtifactS3Location != nil || p.ArtifactBucket == nil {
+		return p.ArtifactS3Location
+	}
+	return aws.String("s3://" + aws.StringValue(p.ArtifactBucket) + "/" + aws.StringValue(p.ArtifactPrefix))
+}
+
+// GenerateCanaryCodeInput returns the code input of the given code. The
+// script is read from Amazon S3 if S3Bucket is set, otherwise the inline zip
+// file is used.
+func GenerateCanaryCodeInput(c v1alpha1.CanaryCode) *synthetics.CanaryCodeInput {
+	in := &synthetics.CanaryCodeInput{Handler: aws.String(c.Handler)}
+	if c.S3Bucket != nil {
+		in.S3Bucket = c.S3Bucket
+		in.S3Key = c.S3Key
+		in.S3Version = c.S3Version
+		return in
+	}
+	in.ZipFile = c.ZipFile
+	return in
+}
+
+func generateRunConfigInput(c *v1alpha1.CanaryRunConfig) *synthetics.CanaryRunConfigInput {
+	if c == nil {
+		return nil
+	}
+	return &synthetics.CanaryRunConfigInput{
+		TimeoutInSeconds: aws.Int64(c.TimeoutInSeconds),
+		MemoryInMB:       c.MemoryInMB,
+	}
+}
+
+func generateVPCConfigInput(c *v1alpha1.CanaryVPCConfig) *synthetics.VpcConfigInput {
+	if c == nil {
+		return nil
+	}
+	return &synthetics.VpcConfigInput{
+		SubnetIds:        c.SubnetIDs,
+		SecurityGroupIds: c.SecurityGroupIDs,
+	}
+}
+
+// GenerateCreateCanaryInput returns the input of a CreateCanary request
+// that creates the canary with the given name and parameters.
+func GenerateCreateCanaryInput(name string, p v1alpha1.CanaryParameters) *synthetics.CreateCanaryInput {
+	return &synthetics.CreateCanaryInput{
+		Name:               aws.String(name),
+		ArtifactS3Location: ArtifactS3Location(p),
+		Code:               GenerateCanaryCodeInput(p.Code),
+		ExecutionRoleArn:   p.ExecutionRoleARN,
+		RuntimeVersion:     aws.String(p.RuntimeVersion),
+		Schedule: &synthetics.CanaryScheduleInput{
+			Expression:        aws.String(p.Schedule.Expression),
+			DurationInSeconds: p.Schedule.DurationInSeconds,
+		},
+		RunConfig:                    generateRunConfigInput(p.RunConfig),
+		FailureRetentionPeriodInDays: p.FailureRetentionPeriodInDays,
+		SuccessRetentionPeriodInDays: p.SuccessRetentionPeriodInDays,
+		VpcConfig:                    generateVPCConfigInput(p.VPCConfig),
+		Tags:                         p.Tags,
+	}
+}
+
+// GenerateUpdateCanaryInput returns the input of an UpdateCanary request
+// that updates the canary with the given name to match the given parameters.
+func GenerateUpdateCanaryInput(name string, p v1alpha1.CanaryParameters) *synthetics.UpdateCanaryInput {
+	return &synthetics.UpdateCanaryInput{
+		Name:             aws.String(name),
+		Code:             GenerateCanaryCodeInput(p.Code),
+		ExecutionRoleArn: p.ExecutionRoleARN,
+		RuntimeVersion:   aws.String(p.RuntimeVersion),
+		Schedule: &synthetics.CanaryScheduleInput{
+			Expression:        aws.String(p.Schedule.Expression),
+			DurationInSeconds: p.Schedule.DurationInSeconds,
+		},
+		RunConfig:                    generateRunConfigInput(p.RunConfig),
+		FailureRetentionPeriodInDays: p.FailureRetentionPeriodInDays,
+		SuccessRetentionPeriodInDays: p.SuccessRetentionPeriodInDays,
+		VpcConfig:                    generateVPCConfigInput(p.VPCConfig),
+	}
+}
+
+// GenerateCanaryObservation returns the observation of the given canary.
+func GenerateCanaryObservation(o synthetics.Canary) v1alpha1.CanaryObservation {
+	obs := v1alpha1.CanaryObservation{
+		ID:        aws.StringValue(o.Id),
+		EngineARN: aws.StringValue(o.EngineArn),
+	}
+	if o.Status != nil {
+		obs.State = string(o.Status.State)
+		obs.StateReason = aws.StringValue(o.Status.StateReason)
+	}
+	if o.Code != nil {
+		obs.SourceLocationARN = aws.StringValue(o.Code.SourceLocationArn)
+	}
+	if o.VpcConfig != nil {
+		obs.VPCID = aws.StringValue(o.VpcConfig.VpcId)
+	}
+	if o.Timeline != nil && o.Timeline.LastStarted != nil {
+		t := metav1.NewTime(*o.Timeline.LastStarted)
+		obs.LastStarted = &t
+	}
+	return obs
+}
+
+// LateInitializeCanary fills the empty fields of the given parameters with
+// the values of the observed canary.
+func LateInitializeCanary(p *v1alpha1.CanaryParameters, o synthetics.Canary) {
+	p.FailureRetentionPeriodInDays = awsclients.LateInitializeInt64Ptr(p.FailureRetentionPeriodInDays, o.FailureRetentionPeriodInDays)
+	p.SuccessRetentionPeriodInDays = awsclients.LateInitializeInt64Ptr(p.SuccessRetentionPeriodInDays, o.SuccessRetentionPeriodInDays)
+	if o.Schedule != nil {
+		p.Schedule.DurationInSeconds = awsclients.LateInitializeInt64Ptr(p.Schedule.DurationInSeconds, o.Schedule.DurationInSeconds)
+	}
+	if o.RunConfig != nil {
+		if p.RunConfig == nil {
+			p.RunConfig = &v1alpha1.CanaryRunConfig{TimeoutInSeconds: aws.Int64Value(o.RunConfig.TimeoutInSeconds)}
+		}
+		p.RunConfig.MemoryInMB = awsclients.LateInitializeInt64Ptr(p.RunConfig.MemoryInMB, o.RunConfig.MemoryInMB)
+	}
+}
+
+// IsCanaryUpToDate returns whether the configuration of the observed canary
+// is up to date with the given parameters. It doesn't consider whether the
+// canary is started, see IsCanaryRunStateUpToDate.
+func IsCanaryUpToDate(p v1alpha1.CanaryParameters, o synthetics.Canary) bool {
+	if o.Code == nil || p.Code.Handler != aws.StringValue(o.Code.Handler) {
+		return false
+	}
+	desired := GenerateUpdateCanaryInput("", p)
+	desired.Name = nil
+	desired.Code = nil
+	observed := &synthetics.UpdateCanaryInput{
+		ExecutionRoleArn:             o.ExecutionRoleArn,
+		RuntimeVersion:               o.RuntimeVersion,
+		FailureRetentionPeriodInDays: o.FailureRetentionPeriodInDays,
+		SuccessRetentionPeriodInDays: o.SuccessRetentionPeriodInDays,
+	}
+	if o.Schedule != nil {
+		observed.Schedule = &synthetics.CanaryScheduleInput{
+			Expression:        o.Schedule.Expression,
+			DurationInSeconds: o.Schedule.DurationInSeconds,
+		}
+	}
+	if o.RunConfig != nil {
+		observed.RunConfig = &synthetics.CanaryRunConfigInput{
+			TimeoutInSeconds: o.RunConfig.TimeoutInSeconds,
+			MemoryInMB:       o.RunConfig.MemoryInMB,
+		}
+	}
+	if o.VpcConfig != nil && (len(o.VpcConfig.SubnetIds) != 0 || len(o.VpcConfig.SecurityGroupIds) != 0) {
+		observed.VpcConfig = &synthetics.VpcConfigInput{
+			SubnetIds:        o.VpcConfig.SubnetIds,
+			SecurityGroupIds: o.VpcConfig.SecurityGroupIds,
+		}
+	}
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(synthetics.UpdateCanaryInput{}, synthetics.CanaryScheduleInput{}, synthetics.CanaryRunConfigInput{}, synthetics.VpcConfigInput{}),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
+
+// ShouldStart returns whether the given parameters require the observed
+// canary to be started. A canary whose schedule is bounded stops on its own,
+// so it is only started once after it is created.
+func ShouldStart(p v1alpha1.CanaryParameters, o synthetics.Canary) bool {
+	if p.StartCanary != nil && !*p.StartCanary {
+		return false
+	}
+	switch canaryState(o) {
+	case synthetics.CanaryStateReady:
+		return true
+	case synthetics.CanaryStateStopped:
+		return !isBoundedSchedule(p.Schedule)
+	}
+	return false
+}
+
+// ShouldStop returns whether the given parameters require the observed
+// canary to be stopped.
+func ShouldStop(p v1alpha1.CanaryParameters, o synthetics.Canary) bool {
+	return p.StartCanary != nil && !*p.StartCanary && canaryState(o) == synthetics.CanaryStateRunning
+}
+
+// IsCanaryRunStateUpToDate returns whether the observed canary is started or
+// stopped as the given parameters require.
+func IsCanaryRunStateUpToDate(p v1alpha1.CanaryParameters, o synthetics.Canary) bool {
+	return !ShouldStart(p, o) && !ShouldStop(p, o)
+}
+
+func canaryState(o synthetics.Canary) synthetics.CanaryState {
+	if o.Status == nil {
+		return ""
+	}
+	return o.Status.State
+}
+
+func isBoundedSchedule(s v1alpha1.CanarySchedule) bool {
+	return aws.Int64Value(s.DurationInSeconds) > 0 || strings.HasPrefix(strings.ReplaceAll(s.Expression, " ", ""), "rate(0")
+}
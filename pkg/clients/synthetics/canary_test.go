@@ -0,0 +1,276 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetics
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/synthetics/v1alpha1"
+)
+
+var (
+	handler        = "pageLoadBlueprint.handler"
+	roleARN        = "arn:aws:iam::123456789012:role/canary"
+	runtimeVersion = "syn-nodejs-2.0"
+	rate           = "rate(5 minutes)"
+)
+
+func canaryParameters(m ...func(*v1alpha1.CanaryParameters)) v1alpha1.CanaryParameters {
+	p := v1alpha1.CanaryParameters{
+		Code:             v1alpha1.CanaryCode{Handler: handler, ZipFile: []byte("zip")},
+		ExecutionRoleARN: aws.String(roleARN),
+		RuntimeVersion:   runtimeVersion,
+		Schedule:         v1alpha1.CanarySchedule{Expression: rate, DurationInSeconds: aws.Int64(0)},
+		RunConfig:        &v1alpha1.CanaryRunConfig{TimeoutInSeconds: 60, MemoryInMB: aws.Int64(960)},
+
+		FailureRetentionPeriodInDays: aws.Int64(31),
+		SuccessRetentionPeriodInDays: aws.Int64(31),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func canary(m ...func(*synthetics.Canary)) synthetics.Canary {
+	c := synthetics.Canary{
+		Code:             &synthetics.CanaryCodeOutput{Handler: aws.String(handler)},
+		ExecutionRoleArn: aws.String(roleARN),
+		RuntimeVersion:   aws.String(runtimeVersion),
+		Schedule:         &synthetics.CanaryScheduleOutput{Expression: aws.String(rate), DurationInSeconds: aws.Int64(0)},
+		RunConfig:        &synthetics.CanaryRunConfigOutput{TimeoutInSeconds: aws.Int64(60), MemoryInMB: aws.Int64(960)},
+		VpcConfig:        &synthetics.VpcConfigOutput{},
+		Status:           &synthetics.CanaryStatus{State: synthetics.CanaryStateRunning},
+
+		FailureRetentionPeriodInDays: aws.Int64(31),
+		SuccessRetentionPeriodInDays: aws.Int64(31),
+	}
+	for _, f := range m {
+		f(&c)
+	}
+	return c
+}
+
+func withState(s synthetics.CanaryState) func(*synthetics.Canary) {
+	return func(c *synthetics.Canary) { c.Status.State = s }
+}
+
+func TestArtifactS3Location(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.CanaryParameters
+		want *string
+	}{
+		"Location": {
+			p: v1alpha1.CanaryParameters{
+				ArtifactS3Location: aws.String("s3://results/web"),
+				ArtifactBucket:     aws.String("ignored"),
+			},
+			want: aws.String("s3://results/web"),
+		},
+		"BucketAndPrefix": {
+			p: v1alpha1.CanaryParameters{
+				ArtifactBucket: aws.String("results"),
+				ArtifactPrefix: aws.String("web"),
+			},
+			want: aws.String("s3://results/web"),
+		},
+		"Unset": {},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ArtifactS3Location(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCanaryCodeInput(t *testing.T) {
+	cases := map[string]struct {
+		c    v1alpha1.CanaryCode
+		want *synthetics.CanaryCodeInput
+	}{
+		"S3": {
+			c: v1alpha1.CanaryCode{
+				Handler:  handler,
+				S3Bucket: aws.String("scripts"),
+				S3Key:    aws.String("web.zip"),
+				ZipFile:  []byte("ignored"),
+			},
+			want: &synthetics.CanaryCodeInput{
+				Handler:  aws.String(handler),
+				S3Bucket: aws.String("scripts"),
+				S3Key:    aws.String("web.zip"),
+			},
+		},
+		"ZipFile": {
+			c: v1alpha1.CanaryCode{Handler: handler, ZipFile: []byte("zip")},
+			want: &synthetics.CanaryCodeInput{
+				Handler: aws.String(handler),
+				ZipFile: []byte("zip"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCanaryCodeInput(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeCanary(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.CanaryParameters
+		o    synthetics.Canary
+		want v1alpha1.CanaryParameters
+	}{
+		"AllFilled": {
+			p:    canaryParameters(),
+			o:    canary(),
+			want: canaryParameters(),
+		},
+		"DefaultsFromAWS": {
+			p: canaryParameters(func(p *v1alpha1.CanaryParameters) {
+				p.RunConfig = nil
+				p.Schedule.DurationInSeconds = nil
+				p.FailureRetentionPeriodInDays = nil
+				p.SuccessRetentionPeriodInDays = nil
+			}),
+			o:    canary(),
+			want: canaryParameters(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeCanary(&tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsCanaryUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.CanaryParameters
+		o    synthetics.Canary
+		want bool
+	}{
+		"UpToDate": {
+			p:    canaryParameters(),
+			o:    canary(),
+			want: true,
+		},
+		"VPCConfigUpToDate": {
+			p: canaryParameters(func(p *v1alpha1.CanaryParameters) {
+				p.VPCConfig = &v1alpha1.CanaryVPCConfig{SubnetIDs: []string{"subnet-b", "subnet-a"}}
+			}),
+			o: canary(func(c *synthetics.Canary) {
+				c.VpcConfig = &synthetics.VpcConfigOutput{SubnetIds: []string{"subnet-a", "subnet-b"}, VpcId: aws.String("vpc-1")}
+			}),
+			want: true,
+		},
+		"HandlerChanged": {
+			p:    canaryParameters(func(p *v1alpha1.CanaryParameters) { p.Code.Handler = "other.handler" }),
+			o:    canary(),
+			want: false,
+		},
+		"ScheduleChanged": {
+			p:    canaryParameters(func(p *v1alpha1.CanaryParameters) { p.Schedule.Expression = "rate(1 hour)" }),
+			o:    canary(),
+			want: false,
+		},
+		"VPCConfigRemoved": {
+			p: canaryParameters(),
+			o: canary(func(c *synthetics.Canary) {
+				c.VpcConfig = &synthetics.VpcConfigOutput{SubnetIds: []string{"subnet-a"}}
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsCanaryUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestShouldStartStop(t *testing.T) {
+	stopped := func(p *v1alpha1.CanaryParameters) { p.StartCanary = aws.Bool(false) }
+	type want struct {
+		start bool
+		stop  bool
+	}
+	cases := map[string]struct {
+		p    v1alpha1.CanaryParameters
+		o    synthetics.Canary
+		want want
+	}{
+		"Running": {
+			p: canaryParameters(),
+			o: canary(),
+		},
+		"Ready": {
+			p:    canaryParameters(),
+			o:    canary(withState(synthetics.CanaryStateReady)),
+			want: want{start: true},
+		},
+		"Stopped": {
+			p:    canaryParameters(),
+			o:    canary(withState(synthetics.CanaryStateStopped)),
+			want: want{start: true},
+		},
+		"StoppedBoundedSchedule": {
+			p: canaryParameters(func(p *v1alpha1.CanaryParameters) { p.Schedule.Expression = "rate(0 minute)" }),
+			o: canary(withState(synthetics.CanaryStateStopped)),
+		},
+		"Creating": {
+			p: canaryParameters(),
+			o: canary(withState(synthetics.CanaryStateCreating)),
+		},
+		"NotStartedRunning": {
+			p:    canaryParameters(stopped),
+			o:    canary(),
+			want: want{stop: true},
+		},
+		"NotStartedReady": {
+			p: canaryParameters(stopped),
+			o: canary(withState(synthetics.CanaryStateReady)),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want.start, ShouldStart(tc.p, tc.o)); diff != "" {
+				t.Errorf("start: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.stop, ShouldStop(tc.p, tc.o)); diff != "" {
+				t.Errorf("stop: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/synthetics"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.CanaryClient = (*MockCanaryClient)(nil)
+
+// MockCanaryClient is a type that implements all the methods for CanaryClient interface
+type MockCanaryClient struct {
+	MockGetCanary    func(*synthetics.GetCanaryInput) synthetics.GetCanaryRequest
+	MockCreateCanary func(*synthetics.CreateCanaryInput) synthetics.CreateCanaryRequest
+	MockUpdateCanary func(*synthetics.UpdateCanaryInput) synthetics.UpdateCanaryRequest
+	MockDeleteCanary func(*synthetics.DeleteCanaryInput) synthetics.DeleteCanaryRequest
+	MockStartCanary  func(*synthetics.StartCanaryInput) synthetics.StartCanaryRequest
+	MockStopCanary   func(*synthetics.StopCanaryInput) synthetics.StopCanaryRequest
+}
+
+// GetCanaryRequest mocks GetCanaryRequest method
+func (m *MockCanaryClient) GetCanaryRequest(input *synthetics.GetCanaryInput) synthetics.GetCanaryRequest {
+	return m.MockGetCanary(input)
+}
+
+// CreateCanaryRequest mocks CreateCanaryRequest method
+func (m *MockCanaryClient) CreateCanaryRequest(input *synthetics.CreateCanaryInput) synthetics.CreateCanaryRequest {
+	return m.MockCreateCanary(input)
+}
+
+// UpdateCanaryRequest mocks UpdateCanaryRequest method
+func (m *MockCanaryClient) UpdateCanaryRequest(input *synthetics.UpdateCanaryInput) synthetics.UpdateCanaryRequest {
+	return m.MockUpdateCanary(input)
+}
+
+// DeleteCanaryRequest mocks DeleteCanaryRequest method
+func (m *MockCanaryClient) DeleteCanaryRequest(input *synthetics.DeleteCanaryInput) synthetics.DeleteCanaryRequest {
+	return m.MockDeleteCanary(input)
+}
+
+// StartCanaryRequest mocks StartCanaryRequest method
+func (m *MockCanaryClient) StartCanaryRequest(input *synthetics.StartCanaryInput) synthetics.StartCanaryRequest {
+	return m.MockStartCanary(input)
+}
+
+// StopCanaryRequest mocks StopCanaryRequest method
+func (m *MockCanaryClient) StopCanaryRequest(input *synthetics.StopCanaryInput) synthetics.StopCanaryRequest {
+	return m.MockStopCanary(input)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyUniqueExternalName is the annotation that, when set to "true",
+// makes the external name of a globally named resource unique by suffixing
+// it with a hash of the UID of the managed resource. It lets two claims in
+// different namespaces ask for the same name without racing to create, and
+// then fighting over, the same resource in AWS.
+const AnnotationKeyUniqueExternalName = "aws.crossplane.io/unique-external-name"
+
+// Length of the suffix of unique external names, without the separator.
+const uniqueSuffixLength = 8
+
+// Name lengths of the globally named resources whose controllers use the
+// UniqueExternalNameInitializer.
+const (
+	MaxLengthS3BucketName           = 63
+	MaxLengthIAMRoleName            = 64
+	MaxLengthIAMUserName            = 64
+	MaxLengthIAMGroupName           = 128
+	MaxLengthIAMInstanceProfileName = 128
+)
+
+const errUpdateUniqueName = "cannot update managed resource with its unique external name"
+
+func uniqueSuffix(uid types.UID) string {
+	h := sha256.Sum256([]byte(uid))
+	return "-" + hex.EncodeToString(h[:])[:uniqueSuffixLength]
+}
+
+// UniqueExternalName returns the given name suffixed with a hash of the given
+// UID. The name is shortened so that the result is at most maxLength
+// characters long. The hash only contains lowercase letters and digits, so
+// the result is valid wherever the name is.
+func UniqueExternalName(name string, uid types.UID, maxLength int) string {
+	suffix := uniqueSuffix(uid)
+	if l := maxLength - len(suffix); len(name) > l && l >= 0 {
+		name = strings.TrimRight(name[:l], "-.")
+	}
+	return name + suffix
+}
+
+// IsUniqueExternalName returns true if the given name was returned by
+// UniqueExternalName for the given UID.
+func IsUniqueExternalName(name string, uid types.UID) bool {
+	return strings.HasSuffix(name, uniqueSuffix(uid))
+}
+
+// UniqueExternalNameInitializer makes the external name of managed resources
+// that are annotated with aws.crossplane.io/unique-external-name unique. The
+// name of the managed resource is suffixed with a hash of its UID. Since the
+// hash is deterministic, it never changes and needs no coordination between
+// controllers.
+type UniqueExternalNameInitializer struct {
+	kube      client.Client
+	maxLength int
+}
+
+// NewUniqueExternalNameInitializer returns a new
+// UniqueExternalNameInitializer for resources whose names are at most
+// maxLength characters long.
+func NewUniqueExternalNameInitializer(c client.Client, maxLength int) *UniqueExternalNameInitializer {
+	return &UniqueExternalNameInitializer{kube: c, maxLength: maxLength}
+}
+
+// Initialize the given managed resource. A unique name is only generated when
+// the external name is absent or defaults to the name of the managed resource.
+// Any other external name was set by a user, e.g. to import or restore an
+// existing resource, and is never overwritten. Resources that have already
+// been observed keep their external name too, so that annotating an existing
+// resource doesn't orphan its external resource.
+func (i *UniqueExternalNameInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
+	if mg.GetAnnotations()[AnnotationKeyUniqueExternalName] != "true" {
+		return nil
+	}
+	if name := meta.GetExternalName(mg); name != "" && name != mg.GetName() {
+		return nil
+	}
+	if mg.GetCondition(runtimev1alpha1.TypeReady).Status != corev1.ConditionUnknown {
+		return nil
+	}
+	meta.SetExternalName(mg, UniqueExternalName(mg.GetName(), mg.GetUID(), i.maxLength))
+	return errors.Wrap(i.kube.Update(ctx, mg), errUpdateUniqueName)
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+func TestUniqueExternalName(t *testing.T) {
+	uid := types.UID("2b8d4a5e-bbd5-4c5d-9f4b-7d2b9d2c1a3e")
+	suffix := uniqueSuffix(uid)
+
+	cases := map[string]struct {
+		name      string
+		maxLength int
+		want      string
+	}{
+		"Short": {
+			name:      "my-bucket",
+			maxLength: MaxLengthS3BucketName,
+			want:      "my-bucket" + suffix,
+		},
+		"Truncated": {
+			name:      strings.Repeat("a", 60),
+			maxLength: MaxLengthS3BucketName,
+			want:      strings.Repeat("a", 54) + suffix,
+		},
+		"TrailingSeparator": {
+			name:      strings.Repeat("a", 53) + "-b",
+			maxLength: MaxLengthS3BucketName,
+			want:      strings.Repeat("a", 53) + suffix,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := UniqueExternalName(tc.name, uid, tc.maxLength)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if !IsUniqueExternalName(got, uid) {
+				t.Errorf("IsUniqueExternalName(%q): want true", got)
+			}
+			if IsUniqueExternalName(got, types.UID("other")) {
+				t.Errorf("IsUniqueExternalName(%q) of another UID: want false", got)
+			}
+		})
+	}
+}
+
+func TestUniqueExternalNameInitializer(t *testing.T) {
+	uid := types.UID("some-uid")
+	errBoom := errors.New("boom")
+	bucket := func(annotations map[string]string, c ...runtimev1alpha1.Condition) *v1beta1.Bucket {
+		cr := &v1beta1.Bucket{}
+		cr.SetName("my-bucket")
+		cr.SetUID(uid)
+		cr.SetAnnotations(annotations)
+		cr.SetConditions(c...)
+		return cr
+	}
+	unique := map[string]string{AnnotationKeyUniqueExternalName: "true"}
+
+	type want struct {
+		name string
+		err  error
+	}
+
+	cases := map[string]struct {
+		cr   *v1beta1.Bucket
+		kube *test.MockClient
+		want want
+	}{
+		"NotAnnotated": {
+			cr:   bucket(nil),
+			want: want{name: ""},
+		},
+		"FromName": {
+			cr:   bucket(map[string]string{AnnotationKeyUniqueExternalName: "true"}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			want: want{name: "my-bucket" + uniqueSuffix(uid)},
+		},
+		"ExternalNameIsName": {
+			cr: bucket(map[string]string{
+				AnnotationKeyUniqueExternalName: "true",
+				meta.AnnotationKeyExternalName:  "my-bucket",
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			want: want{name: "my-bucket" + uniqueSuffix(uid)},
+		},
+		"UserSetExternalName": {
+			cr: bucket(map[string]string{
+				AnnotationKeyUniqueExternalName: "true",
+				meta.AnnotationKeyExternalName:  "assets",
+			}),
+			want: want{name: "assets"},
+		},
+		"AlreadyUnique": {
+			cr: bucket(map[string]string{
+				AnnotationKeyUniqueExternalName: "true",
+				meta.AnnotationKeyExternalName:  "my-bucket" + uniqueSuffix(uid),
+			}),
+			want: want{name: "my-bucket" + uniqueSuffix(uid)},
+		},
+		"AlreadyObserved": {
+			cr: bucket(map[string]string{
+				AnnotationKeyUniqueExternalName: "true",
+				meta.AnnotationKeyExternalName:  "my-bucket",
+			}, runtimev1alpha1.Available()),
+			want: want{name: "my-bucket"},
+		},
+		"UpdateError": {
+			cr:   bucket(unique),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			want: want{
+				name: "my-bucket" + uniqueSuffix(uid),
+				err:  errors.Wrap(errBoom, errUpdateUniqueName),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewUniqueExternalNameInitializer(tc.kube, MaxLengthS3BucketName).Initialize(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.name, meta.GetExternalName(tc.cr)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
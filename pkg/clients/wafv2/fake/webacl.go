@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/wafv2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.WebACLClient = (*MockWebACLClient)(nil)
+
+// MockWebACLClient is a type that implements all the methods for WebACLClient interface
+type MockWebACLClient struct {
+	MockCreate func(*wafv2.CreateWebACLInput) wafv2.CreateWebACLRequest
+	MockGet    func(*wafv2.GetWebACLInput) wafv2.GetWebACLRequest
+	MockList   func(*wafv2.ListWebACLsInput) wafv2.ListWebACLsRequest
+	MockUpdate func(*wafv2.UpdateWebACLInput) wafv2.UpdateWebACLRequest
+	MockDelete func(*wafv2.DeleteWebACLInput) wafv2.DeleteWebACLRequest
+}
+
+// CreateWebACLRequest mocks CreateWebACLRequest method
+func (m *MockWebACLClient) CreateWebACLRequest(input *wafv2.CreateWebACLInput) wafv2.CreateWebACLRequest {
+	return m.MockCreate(input)
+}
+
+// GetWebACLRequest mocks GetWebACLRequest method
+func (m *MockWebACLClient) GetWebACLRequest(input *wafv2.GetWebACLInput) wafv2.GetWebACLRequest {
+	return m.MockGet(input)
+}
+
+// ListWebACLsRequest mocks ListWebACLsRequest method
+func (m *MockWebACLClient) ListWebACLsRequest(input *wafv2.ListWebACLsInput) wafv2.ListWebACLsRequest {
+	return m.MockList(input)
+}
+
+// UpdateWebACLRequest mocks UpdateWebACLRequest method
+func (m *MockWebACLClient) UpdateWebACLRequest(input *wafv2.UpdateWebACLInput) wafv2.UpdateWebACLRequest {
+	return m.MockUpdate(input)
+}
+
+// DeleteWebACLRequest mocks DeleteWebACLRequest method
+func (m *MockWebACLClient) DeleteWebACLRequest(input *wafv2.DeleteWebACLInput) wafv2.DeleteWebACLRequest {
+	return m.MockDelete(input)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/wafv2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.WebACLAssociationClient = (*MockWebACLAssociationClient)(nil)
+
+// MockWebACLAssociationClient is a type that implements all the methods for WebACLAssociationClient interface
+type MockWebACLAssociationClient struct {
+	MockAssociate      func(*wafv2.AssociateWebACLInput) wafv2.AssociateWebACLRequest
+	MockDisassociate   func(*wafv2.DisassociateWebACLInput) wafv2.DisassociateWebACLRequest
+	MockGetForResource func(*wafv2.GetWebACLForResourceInput) wafv2.GetWebACLForResourceRequest
+}
+
+// AssociateWebACLRequest mocks AssociateWebACLRequest method
+func (m *MockWebACLAssociationClient) AssociateWebACLRequest(input *wafv2.AssociateWebACLInput) wafv2.AssociateWebACLRequest {
+	return m.MockAssociate(input)
+}
+
+// DisassociateWebACLRequest mocks DisassociateWebACLRequest method
+func (m *MockWebACLAssociationClient) DisassociateWebACLRequest(input *wafv2.DisassociateWebACLInput) wafv2.DisassociateWebACLRequest {
+	return m.MockDisassociate(input)
+}
+
+// GetWebACLForResourceRequest mocks GetWebACLForResourceRequest method
+func (m *MockWebACLAssociationClient) GetWebACLForResourceRequest(input *wafv2.GetWebACLForResourceInput) wafv2.GetWebACLForResourceRequest {
+	return m.MockGetForResource(input)
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wafv2
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+)
+
+// WebACLClient is the external client used for WebACL Custom Resource
+type WebACLClient interface {
+	CreateWebACLRequest(*wafv2.CreateWebACLInput) wafv2.CreateWebACLRequest
+	GetWebACLRequest(*wafv2.GetWebACLInput) wafv2.GetWebACLRequest
+	ListWebACLsRequest(*wafv2.ListWebACLsInput) wafv2.ListWebACLsRequest
+	UpdateWebACLRequest(*wafv2.UpdateWebACLInput) wafv2.UpdateWebACLRequest
+	DeleteWebACLRequest(*wafv2.DeleteWebACLInput) wafv2.DeleteWebACLRequest
+}
+
+// NewWebACLClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewWebACLClient(cfg aws.Config) WebACLClient {
+	return wafv2.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the item doesn't exist
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == wafv2.ErrCodeWAFNonexistentItemException {
+		return true
+	}
+	return false
+}
+
+// GenerateCreateWebACLInput returns a create input from the given parameters.
+func GenerateCreateWebACLInput(name string, p v1alpha1.WebACLParameters) *wafv2.CreateWebACLInput {
+	input := &wafv2.CreateWebACLInput{
+		Name:             aws.String(name),
+		Scope:            wafv2.Scope(p.Scope),
+		DefaultAction:    generateDefaultAction(p.DefaultAction),
+		Description:      p.Description,
+		Rules:            generateRules(p.Rules),
+		VisibilityConfig: generateVisibilityConfig(p.VisibilityConfig),
+	}
+	if len(p.Tags) != 0 {
+		input.Tags = make([]wafv2.Tag, len(p.Tags))
+		for i, t := range p.Tags {
+			input.Tags[i] = wafv2.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+		}
+	}
+	return input
+}
+
+// GenerateUpdateWebACLInput returns an update input from the given
+// parameters. The lock token must be the one returned by the latest read of
+// the WebACL.
+func GenerateUpdateWebACLInput(name, id, lockToken string, p v1alpha1.WebACLParameters) *wafv2.UpdateWebACLInput {
+	return &wafv2.UpdateWebACLInput{
+		Name:             aws.String(name),
+		Id:               aws.String(id),
+		LockToken:        aws.String(lockToken),
+		Scope:            wafv2.Scope(p.Scope),
+		DefaultAction:    generateDefaultAction(p.DefaultAction),
+		Description:      p.Description,
+		Rules:            generateRules(p.Rules),
+		VisibilityConfig: generateVisibilityConfig(p.VisibilityConfig),
+	}
+}
+
+// GenerateWebACLObservation is used to produce v1alpha1.WebACLObservation
+// from wafv2.WebACL.
+func GenerateWebACLObservation(acl wafv2.WebACL) v1alpha1.WebACLObservation {
+	return v1alpha1.WebACLObservation{
+		ARN:                      aws.StringValue(acl.ARN),
+		ID:                       aws.StringValue(acl.Id),
+		Capacity:                 aws.Int64Value(acl.Capacity),
+		ManagedByFirewallManager: aws.BoolValue(acl.ManagedByFirewallManager),
+	}
+}
+
+// LateInitializeWebACL fills the empty fields in *v1alpha1.WebACLParameters
+// with the values seen in wafv2.WebACL.
+func LateInitializeWebACL(in *v1alpha1.WebACLParameters, acl *wafv2.WebACL) {
+	if acl == nil {
+		return
+	}
+	if in.Description == nil {
+		in.Description = acl.Description
+	}
+	for i := range in.Rules {
+		s := in.Rules[i].Statement.RateBasedStatement
+		if s != nil && s.AggregateKeyType == nil {
+			s.AggregateKeyType = aws.String(string(wafv2.RateBasedStatementAggregateKeyTypeIp))
+		}
+	}
+}
+
+// IsWebACLUpToDate checks whether there is a change in any of the modifiable
+// fields.
+func IsWebACLUpToDate(p v1alpha1.WebACLParameters, acl wafv2.WebACL) bool {
+	current := v1alpha1.WebACLParameters{
+		DefaultAction: buildDefaultAction(acl.DefaultAction),
+		Description:   acl.Description,
+		Rules:         buildRules(acl.Rules),
+	}
+	if acl.VisibilityConfig != nil {
+		current.VisibilityConfig = buildVisibilityConfig(*acl.VisibilityConfig)
+	}
+	desired := p.DeepCopy()
+	sort.Slice(desired.Rules, func(i, j int) bool { return desired.Rules[i].Priority < desired.Rules[j].Priority })
+
+	return cmp.Equal(current, *desired, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.WebACLParameters{}, "Region", "Scope", "Tags"))
+}
+
+func generateDefaultAction(a string) *wafv2.DefaultAction {
+	if a == v1alpha1.ActionBlock {
+		return &wafv2.DefaultAction{Block: &wafv2.BlockAction{}}
+	}
+	return &wafv2.DefaultAction{Allow: &wafv2.AllowAction{}}
+}
+
+func buildDefaultAction(a *wafv2.DefaultAction) string {
+	if a != nil && a.Block != nil {
+		return v1alpha1.ActionBlock
+	}
+	return v1alpha1.ActionAllow
+}
+
+func generateVisibilityConfig(c v1alpha1.VisibilityConfig) *wafv2.VisibilityConfig {
+	return &wafv2.VisibilityConfig{
+		CloudWatchMetricsEnabled: aws.Bool(c.CloudWatchMetricsEnabled),
+		MetricName:               aws.String(c.MetricName),
+		SampledRequestsEnabled:   aws.Bool(c.SampledRequestsEnabled),
+	}
+}
+
+func buildVisibilityConfig(c wafv2.VisibilityConfig) v1alpha1.VisibilityConfig {
+	return v1alpha1.VisibilityConfig{
+		CloudWatchMetricsEnabled: aws.BoolValue(c.CloudWatchMetricsEnabled),
+		MetricName:               aws.StringValue(c.MetricName),
+		SampledRequestsEnabled:   aws.BoolValue(c.SampledRequestsEnabled),
+	}
+}
+
+func generateRules(rules []v1alpha1.Rule) []wafv2.Rule { // nolint:gocyclo
+	if len(rules) == 0 {
+		return nil
+	}
+	res := make([]wafv2.Rule, len(rules))
+	for i, r := range rules {
+		res[i] = wafv2.Rule{
+			Name:             aws.String(r.Name),
+			Priority:         aws.Int64(r.Priority),
+			Statement:        &wafv2.Statement{},
+			VisibilityConfig: generateVisibilityConfig(r.VisibilityConfig),
+		}
+		switch aws.StringValue(r.Action) {
+		case v1alpha1.ActionAllow:
+			res[i].Action = &wafv2.RuleAction{Allow: &wafv2.AllowAction{}}
+		case v1alpha1.ActionBlock:
+			res[i].Action = &wafv2.RuleAction{Block: &wafv2.BlockAction{}}
+		case v1alpha1.ActionCount:
+			res[i].Action = &wafv2.RuleAction{Count: &wafv2.CountAction{}}
+		}
+		switch aws.StringValue(r.OverrideAction) {
+		case v1alpha1.ActionNone:
+			res[i].OverrideAction = &wafv2.OverrideAction{None: &wafv2.NoneAction{}}
+		case v1alpha1.ActionCount:
+			res[i].OverrideAction = &wafv2.OverrideAction{Count: &wafv2.CountAction{}}
+		}
+		if s := r.Statement.ManagedRuleGroupStatement; s != nil {
+			m := &wafv2.ManagedRuleGroupStatement{
+				Name:       aws.String(s.Name),
+				VendorName: aws.String(s.VendorName),
+			}
+			for _, e := range s.ExcludedRules {
+				m.ExcludedRules = append(m.ExcludedRules, wafv2.ExcludedRule{Name: aws.String(e)})
+			}
+			res[i].Statement.ManagedRuleGroupStatement = m
+		}
+		if s := r.Statement.RateBasedStatement; s != nil {
+			res[i].Statement.RateBasedStatement = &wafv2.RateBasedStatement{
+				Limit:            aws.Int64(s.Limit),
+				AggregateKeyType: wafv2.RateBasedStatementAggregateKeyTypeIp,
+			}
+		}
+	}
+	return res
+}
+
+func buildRules(rules []wafv2.Rule) []v1alpha1.Rule { // nolint:gocyclo
+	if len(rules) == 0 {
+		return nil
+	}
+	res := make([]v1alpha1.Rule, len(rules))
+	for i, r := range rules {
+		res[i] = v1alpha1.Rule{
+			Name:     aws.StringValue(r.Name),
+			Priority: aws.Int64Value(r.Priority),
+		}
+		if r.VisibilityConfig != nil {
+			res[i].VisibilityConfig = buildVisibilityConfig(*r.VisibilityConfig)
+		}
+		if a := r.Action; a != nil {
+			switch {
+			case a.Allow != nil:
+				res[i].Action = aws.String(v1alpha1.ActionAllow)
+			case a.Block != nil:
+				res[i].Action = aws.String(v1alpha1.ActionBlock)
+			case a.Count != nil:
+				res[i].Action = aws.String(v1alpha1.ActionCount)
+			}
+		}
+		if a := r.OverrideAction; a != nil {
+			switch {
+			case a.None != nil:
+				res[i].OverrideAction = aws.String(v1alpha1.ActionNone)
+			case a.Count != nil:
+				res[i].OverrideAction = aws.String(v1alpha1.ActionCount)
+			}
+		}
+		if r.Statement == nil {
+			continue
+		}
+		if s := r.Statement.ManagedRuleGroupStatement; s != nil {
+			m := &v1alpha1.ManagedRuleGroupStatement{
+				Name:       aws.StringValue(s.Name),
+				VendorName: aws.StringValue(s.VendorName),
+			}
+			for _, e := range s.ExcludedRules {
+				m.ExcludedRules = append(m.ExcludedRules, aws.StringValue(e.Name))
+			}
+			res[i].Statement.ManagedRuleGroupStatement = m
+		}
+		if s := r.Statement.RateBasedStatement; s != nil {
+			res[i].Statement.RateBasedStatement = &v1alpha1.RateBasedStatement{
+				Limit:            aws.Int64Value(s.Limit),
+				AggregateKeyType: aws.String(string(s.AggregateKeyType)),
+			}
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Priority < res[j].Priority })
+	return res
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wafv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+)
+
+var (
+	aclName    = "some-acl"
+	metricName = "some-metric"
+	ruleName   = "some-rule"
+
+	params = v1alpha1.WebACLParameters{
+		Scope:         v1alpha1.ScopeRegional,
+		DefaultAction: v1alpha1.ActionBlock,
+		Rules: []v1alpha1.Rule{
+			{
+				Name:             ruleName + "-rate",
+				Priority:         2,
+				Action:           aws.String(v1alpha1.ActionBlock),
+				Statement:        v1alpha1.Statement{RateBasedStatement: &v1alpha1.RateBasedStatement{Limit: 1000, AggregateKeyType: aws.String("IP")}},
+				VisibilityConfig: v1alpha1.VisibilityConfig{MetricName: metricName},
+			},
+			{
+				Name:           ruleName,
+				Priority:       1,
+				OverrideAction: aws.String(v1alpha1.ActionNone),
+				Statement: v1alpha1.Statement{ManagedRuleGroupStatement: &v1alpha1.ManagedRuleGroupStatement{
+					VendorName:    "AWS",
+					Name:          "AWSManagedRulesCommonRuleSet",
+					ExcludedRules: []string{"SizeRestrictions_BODY"},
+				}},
+				VisibilityConfig: v1alpha1.VisibilityConfig{MetricName: metricName},
+			},
+		},
+		VisibilityConfig: v1alpha1.VisibilityConfig{CloudWatchMetricsEnabled: true, MetricName: metricName},
+		Tags:             []v1alpha1.Tag{{Key: "k", Value: "v"}},
+	}
+
+	awsRules = []wafv2.Rule{
+		{
+			Name:           aws.String(ruleName),
+			Priority:       aws.Int64(1),
+			OverrideAction: &wafv2.OverrideAction{None: &wafv2.NoneAction{}},
+			Statement: &wafv2.Statement{ManagedRuleGroupStatement: &wafv2.ManagedRuleGroupStatement{
+				VendorName:    aws.String("AWS"),
+				Name:          aws.String("AWSManagedRulesCommonRuleSet"),
+				ExcludedRules: []wafv2.ExcludedRule{{Name: aws.String("SizeRestrictions_BODY")}},
+			}},
+			VisibilityConfig: &wafv2.VisibilityConfig{MetricName: aws.String(metricName), CloudWatchMetricsEnabled: aws.Bool(false), SampledRequestsEnabled: aws.Bool(false)},
+		},
+		{
+			Name:             aws.String(ruleName + "-rate"),
+			Priority:         aws.Int64(2),
+			Action:           &wafv2.RuleAction{Block: &wafv2.BlockAction{}},
+			Statement:        &wafv2.Statement{RateBasedStatement: &wafv2.RateBasedStatement{Limit: aws.Int64(1000), AggregateKeyType: wafv2.RateBasedStatementAggregateKeyTypeIp}},
+			VisibilityConfig: &wafv2.VisibilityConfig{MetricName: aws.String(metricName), CloudWatchMetricsEnabled: aws.Bool(false), SampledRequestsEnabled: aws.Bool(false)},
+		},
+	}
+)
+
+func TestGenerateCreateWebACLInput(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.WebACLParameters
+		out *wafv2.CreateWebACLInput
+	}{
+		"AllFilled": {
+			in: v1alpha1.WebACLParameters{
+				Scope:            v1alpha1.ScopeRegional,
+				DefaultAction:    v1alpha1.ActionBlock,
+				Description:      aws.String("desc"),
+				Rules:            params.Rules[1:],
+				VisibilityConfig: params.VisibilityConfig,
+				Tags:             params.Tags,
+			},
+			out: &wafv2.CreateWebACLInput{
+				Name:          aws.String(aclName),
+				Scope:         wafv2.ScopeRegional,
+				DefaultAction: &wafv2.DefaultAction{Block: &wafv2.BlockAction{}},
+				Description:   aws.String("desc"),
+				Rules:         awsRules[:1],
+				VisibilityConfig: &wafv2.VisibilityConfig{
+					CloudWatchMetricsEnabled: aws.Bool(true),
+					MetricName:               aws.String(metricName),
+					SampledRequestsEnabled:   aws.Bool(false),
+				},
+				Tags: []wafv2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			},
+		},
+		"DefaultAllow": {
+			in: v1alpha1.WebACLParameters{
+				Scope:         v1alpha1.ScopeCloudFront,
+				DefaultAction: v1alpha1.ActionAllow,
+			},
+			out: &wafv2.CreateWebACLInput{
+				Name:          aws.String(aclName),
+				Scope:         wafv2.ScopeCloudfront,
+				DefaultAction: &wafv2.DefaultAction{Allow: &wafv2.AllowAction{}},
+				VisibilityConfig: &wafv2.VisibilityConfig{
+					CloudWatchMetricsEnabled: aws.Bool(false),
+					MetricName:               aws.String(""),
+					SampledRequestsEnabled:   aws.Bool(false),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateCreateWebACLInput(aclName, tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GenerateCreateWebACLInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsWebACLUpToDate(t *testing.T) {
+	observed := wafv2.WebACL{
+		Name:          aws.String(aclName),
+		DefaultAction: &wafv2.DefaultAction{Block: &wafv2.BlockAction{}},
+		Rules:         awsRules,
+		VisibilityConfig: &wafv2.VisibilityConfig{
+			CloudWatchMetricsEnabled: aws.Bool(true),
+			MetricName:               aws.String(metricName),
+			SampledRequestsEnabled:   aws.Bool(false),
+		},
+	}
+	changedRules := params.DeepCopy()
+	changedRules.Rules[0].Statement.RateBasedStatement.Limit = 2000
+
+	cases := map[string]struct {
+		p   v1alpha1.WebACLParameters
+		acl wafv2.WebACL
+		out bool
+	}{
+		"SameFields": {
+			p:   params,
+			acl: observed,
+			out: true,
+		},
+		"DifferentDefaultAction": {
+			p: func() v1alpha1.WebACLParameters {
+				p := params.DeepCopy()
+				p.DefaultAction = v1alpha1.ActionAllow
+				return *p
+			}(),
+			acl: observed,
+			out: false,
+		},
+		"DifferentRules": {
+			p:   *changedRules,
+			acl: observed,
+			out: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := IsWebACLUpToDate(tc.p, tc.acl)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("IsWebACLUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeWebACL(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.WebACLParameters
+		acl *wafv2.WebACL
+		out v1alpha1.WebACLParameters
+	}{
+		"AllFilled": {
+			in: v1alpha1.WebACLParameters{
+				Rules: []v1alpha1.Rule{{Statement: v1alpha1.Statement{RateBasedStatement: &v1alpha1.RateBasedStatement{Limit: 100}}}},
+			},
+			acl: &wafv2.WebACL{Description: aws.String("desc")},
+			out: v1alpha1.WebACLParameters{
+				Description: aws.String("desc"),
+				Rules: []v1alpha1.Rule{{Statement: v1alpha1.Statement{RateBasedStatement: &v1alpha1.RateBasedStatement{
+					Limit:            100,
+					AggregateKeyType: aws.String("IP"),
+				}}}},
+			},
+		},
+		"NoUpdateExisting": {
+			in:  v1alpha1.WebACLParameters{Description: aws.String("mine")},
+			acl: &wafv2.WebACL{Description: aws.String("desc")},
+			out: v1alpha1.WebACLParameters{Description: aws.String("mine")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeWebACL(&tc.in, tc.acl)
+			if diff := cmp.Diff(tc.out, tc.in); diff != "" {
+				t.Errorf("LateInitializeWebACL(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wafv2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+)
+
+// WebACLAssociationClient is the external client used for WebACLAssociation
+// Custom Resource
+type WebACLAssociationClient interface {
+	AssociateWebACLRequest(*wafv2.AssociateWebACLInput) wafv2.AssociateWebACLRequest
+	DisassociateWebACLRequest(*wafv2.DisassociateWebACLInput) wafv2.DisassociateWebACLRequest
+	GetWebACLForResourceRequest(*wafv2.GetWebACLForResourceInput) wafv2.GetWebACLForResourceRequest
+}
+
+// NewWebACLAssociationClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewWebACLAssociationClient(cfg aws.Config) WebACLAssociationClient {
+	return wafv2.New(cfg)
+}
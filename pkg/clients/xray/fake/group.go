@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/xray"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.GroupClient = (*MockGroupClient)(nil)
+
+// MockGroupClient is a type that implements all the methods for GroupClient interface
+type MockGroupClient struct {
+	MockGetGroup    func(*xray.GetGroupInput) xray.GetGroupRequest
+	MockCreateGroup func(*xray.CreateGroupInput) xray.CreateGroupRequest
+	MockUpdateGroup func(*xray.UpdateGroupInput) xray.UpdateGroupRequest
+	MockDeleteGroup func(*xray.DeleteGroupInput) xray.DeleteGroupRequest
+}
+
+// GetGroupRequest mocks GetGroupRequest method
+func (m *MockGroupClient) GetGroupRequest(input *xray.GetGroupInput) xray.GetGroupRequest {
+	return m.MockGetGroup(input)
+}
+
+// CreateGroupRequest mocks CreateGroupRequest method
+func (m *MockGroupClient) CreateGroupRequest(input *xray.CreateGroupInput) xray.CreateGroupRequest {
+	return m.MockCreateGroup(input)
+}
+
+// UpdateGroupRequest mocks UpdateGroupRequest method
+func (m *MockGroupClient) UpdateGroupRequest(input *xray.UpdateGroupInput) xray.UpdateGroupRequest {
+	return m.MockUpdateGroup(input)
+}
+
+// DeleteGroupRequest mocks DeleteGroupRequest method
+func (m *MockGroupClient) DeleteGroupRequest(input *xray.DeleteGroupInput) xray.DeleteGroupRequest {
+	return m.MockDeleteGroup(input)
+}
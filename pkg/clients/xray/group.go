@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xray
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+
+	"github.com/crossplane/provider-aws/apis/xray/v1alpha1"
+)
+
+// GroupClient is the external client used for XRayGroup Custom Resource
+type GroupClient interface {
+	GetGroupRequest(*xray.GetGroupInput) xray.GetGroupRequest
+	CreateGroupRequest(*xray.CreateGroupInput) xray.CreateGroupRequest
+	UpdateGroupRequest(*xray.UpdateGroupInput) xray.UpdateGroupRequest
+	DeleteGroupRequest(*xray.DeleteGroupInput) xray.DeleteGroupRequest
+}
+
+// NewGroupClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewGroupClient(cfg aws.Config) GroupClient {
+	return xray.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the group or sampling rule
+// doesn't exist. X-Ray reports missing resources as invalid requests.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == xray.ErrCodeInvalidRequestException &&
+		strings.Contains(strings.ToLower(awsErr.Message()), "not found")
+}
+
+// GenerateGroupObservation returns the observation of the given group.
+func GenerateGroupObservation(o xray.Group) v1alpha1.XRayGroupObservation {
+	return v1alpha1.XRayGroupObservation{GroupARN: aws.StringValue(o.GroupARN)}
+}
+
+// IsGroupUpToDate returns whether the observed group is up to date with the
+// given parameters.
+func IsGroupUpToDate(p v1alpha1.XRayGroupParameters, o xray.Group) bool {
+	return aws.StringValue(p.FilterExpression) == aws.StringValue(o.FilterExpression)
+}
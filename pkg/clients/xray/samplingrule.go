@@ -0,0 +1,163 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xray
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/xray/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errParseFixedRate = "cannot parse fixedRate"
+
+	// matchAll is the wildcard the matching fields of a sampling rule
+	// default to.
+	matchAll = "*"
+
+	// samplingRuleVersion is the only version of sampling rules X-Ray
+	// supports.
+	samplingRuleVersion = 1
+)
+
+// SamplingRuleClient is the external client used for SamplingRule Custom
+// Resource
+type SamplingRuleClient interface {
+	GetSamplingRulesRequest(*xray.GetSamplingRulesInput) xray.GetSamplingRulesRequest
+	CreateSamplingRuleRequest(*xray.CreateSamplingRuleInput) xray.CreateSamplingRuleRequest
+	UpdateSamplingRuleRequest(*xray.UpdateSamplingRuleInput) xray.UpdateSamplingRuleRequest
+	DeleteSamplingRuleRequest(*xray.DeleteSamplingRuleInput) xray.DeleteSamplingRuleRequest
+}
+
+// NewSamplingRuleClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewSamplingRuleClient(cfg aws.Config) SamplingRuleClient {
+	return xray.New(cfg)
+}
+
+// FindSamplingRule returns the record of the sampling rule with the given
+// name, or nil if there is none.
+func FindSamplingRule(name string, records []xray.SamplingRuleRecord) *xray.SamplingRuleRecord {
+	for i := range records {
+		if r := records[i].SamplingRule; r != nil && aws.StringValue(r.RuleName) == name {
+			return &records[i]
+		}
+	}
+	return nil
+}
+
+func matcher(s *string) *string {
+	if s == nil {
+		return aws.String(matchAll)
+	}
+	return s
+}
+
+// GenerateSamplingRule returns the sampling rule with the given name and
+// parameters.
+func GenerateSamplingRule(name string, p v1alpha1.SamplingRuleParameters) (*xray.SamplingRule, error) {
+	rate, err := strconv.ParseFloat(p.FixedRate, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseFixedRate)
+	}
+	return &xray.SamplingRule{
+		RuleName:      aws.String(name),
+		Priority:      aws.Int64(p.Priority),
+		FixedRate:     aws.Float64(rate),
+		ReservoirSize: aws.Int64(p.ReservoirSize),
+		ServiceName:   matcher(p.ServiceName),
+		ServiceType:   matcher(p.ServiceType),
+		Host:          matcher(p.Host),
+		HTTPMethod:    matcher(p.HTTPMethod),
+		URLPath:       matcher(p.URLPath),
+		ResourceARN:   matcher(p.ResourceARN),
+		Attributes:    p.Attributes,
+		Version:       aws.Int64(samplingRuleVersion),
+	}, nil
+}
+
+// GenerateSamplingRuleUpdate returns the update of the sampling rule with the
+// given name to the given parameters.
+func GenerateSamplingRuleUpdate(name string, p v1alpha1.SamplingRuleParameters) (*xray.SamplingRuleUpdate, error) {
+	r, err := GenerateSamplingRule(name, p)
+	if err != nil {
+		return nil, err
+	}
+	return &xray.SamplingRuleUpdate{
+		RuleName:      r.RuleName,
+		Priority:      r.Priority,
+		FixedRate:     r.FixedRate,
+		ReservoirSize: r.ReservoirSize,
+		ServiceName:   r.ServiceName,
+		ServiceType:   r.ServiceType,
+		Host:          r.Host,
+		HTTPMethod:    r.HTTPMethod,
+		URLPath:       r.URLPath,
+		ResourceARN:   r.ResourceARN,
+		Attributes:    r.Attributes,
+	}, nil
+}
+
+// GenerateSamplingRuleObservation returns the observation of the given
+// sampling rule record.
+func GenerateSamplingRuleObservation(r xray.SamplingRuleRecord) v1alpha1.SamplingRuleObservation {
+	obs := v1alpha1.SamplingRuleObservation{}
+	if r.SamplingRule != nil {
+		obs.RuleARN = aws.StringValue(r.SamplingRule.RuleARN)
+	}
+	if r.CreatedAt != nil {
+		t := metav1.NewTime(*r.CreatedAt)
+		obs.CreatedAt = &t
+	}
+	if r.ModifiedAt != nil {
+		t := metav1.NewTime(*r.ModifiedAt)
+		obs.ModifiedAt = &t
+	}
+	return obs
+}
+
+// LateInitializeSamplingRule fills the empty fields of the given parameters
+// with the values of the observed sampling rule.
+func LateInitializeSamplingRule(p *v1alpha1.SamplingRuleParameters, r xray.SamplingRule) {
+	p.ServiceName = awsclients.LateInitializeStringPtr(p.ServiceName, r.ServiceName)
+	p.ServiceType = awsclients.LateInitializeStringPtr(p.ServiceType, r.ServiceType)
+	p.Host = awsclients.LateInitializeStringPtr(p.Host, r.Host)
+	p.HTTPMethod = awsclients.LateInitializeStringPtr(p.HTTPMethod, r.HTTPMethod)
+	p.URLPath = awsclients.LateInitializeStringPtr(p.URLPath, r.URLPath)
+	p.ResourceARN = awsclients.LateInitializeStringPtr(p.ResourceARN, r.ResourceARN)
+}
+
+// IsSamplingRuleUpToDate returns whether the observed sampling rule is up to
+// date with the given parameters.
+func IsSamplingRuleUpToDate(p v1alpha1.SamplingRuleParameters, r xray.SamplingRule) bool {
+	desired, err := GenerateSamplingRule(aws.StringValue(r.RuleName), p)
+	if err != nil {
+		return false
+	}
+	return cmp.Equal(desired, &r,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(xray.SamplingRule{}),
+		cmpopts.IgnoreFields(xray.SamplingRule{}, "RuleARN", "Version"))
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xray
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/xray/v1alpha1"
+)
+
+var (
+	ruleName    = "checkout"
+	serviceName = "checkout"
+)
+
+func samplingRuleParameters(m ...func(*v1alpha1.SamplingRuleParameters)) v1alpha1.SamplingRuleParameters {
+	p := v1alpha1.SamplingRuleParameters{
+		Priority:      100,
+		FixedRate:     "0.05",
+		ReservoirSize: 1,
+		ServiceName:   aws.String(serviceName),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func samplingRule(m ...func(*xray.SamplingRule)) xray.SamplingRule {
+	r := xray.SamplingRule{
+		RuleName:      aws.String(ruleName),
+		RuleARN:       aws.String("arn:aws:xray:us-east-1:123456789012:sampling-rule/checkout"),
+		Priority:      aws.Int64(100),
+		FixedRate:     aws.Float64(0.05),
+		ReservoirSize: aws.Int64(1),
+		ServiceName:   aws.String(serviceName),
+		ServiceType:   aws.String("*"),
+		Host:          aws.String("*"),
+		HTTPMethod:    aws.String("*"),
+		URLPath:       aws.String("*"),
+		ResourceARN:   aws.String("*"),
+		Attributes:    map[string]string{},
+		Version:       aws.Int64(1),
+	}
+	for _, f := range m {
+		f(&r)
+	}
+	return r
+}
+
+func TestGenerateSamplingRule(t *testing.T) {
+	_, errParse := strconv.ParseFloat("many", 64)
+	type want struct {
+		rule *xray.SamplingRule
+		err  error
+	}
+	cases := map[string]struct {
+		p    v1alpha1.SamplingRuleParameters
+		want want
+	}{
+		"DefaultMatchers": {
+			p: samplingRuleParameters(),
+			want: want{rule: func() *xray.SamplingRule {
+				r := samplingRule(func(r *xray.SamplingRule) {
+					r.RuleARN = nil
+					r.Attributes = nil
+				})
+				return &r
+			}()},
+		},
+		"InvalidFixedRate": {
+			p:    samplingRuleParameters(func(p *v1alpha1.SamplingRuleParameters) { p.FixedRate = "many" }),
+			want: want{err: errors.Wrap(errParse, errParseFixedRate)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateSamplingRule(ruleName, tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.rule, got, cmpopts.IgnoreUnexported(xray.SamplingRule{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindSamplingRule(t *testing.T) {
+	other := samplingRule(func(r *xray.SamplingRule) { r.RuleName = aws.String("Default") })
+	rule := samplingRule()
+	cases := map[string]struct {
+		records []xray.SamplingRuleRecord
+		want    *xray.SamplingRuleRecord
+	}{
+		"Found": {
+			records: []xray.SamplingRuleRecord{{SamplingRule: &other}, {SamplingRule: &rule}},
+			want:    &xray.SamplingRuleRecord{SamplingRule: &rule},
+		},
+		"NotFound": {
+			records: []xray.SamplingRuleRecord{{SamplingRule: &other}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FindSamplingRule(ruleName, tc.records)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(xray.SamplingRuleRecord{}, xray.SamplingRule{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSamplingRuleUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.SamplingRuleParameters
+		r    xray.SamplingRule
+		want bool
+	}{
+		"UpToDate": {
+			p:    samplingRuleParameters(),
+			r:    samplingRule(),
+			want: true,
+		},
+		"EquivalentFixedRate": {
+			p:    samplingRuleParameters(func(p *v1alpha1.SamplingRuleParameters) { p.FixedRate = "0.050" }),
+			r:    samplingRule(),
+			want: true,
+		},
+		"FixedRateChanged": {
+			p:    samplingRuleParameters(func(p *v1alpha1.SamplingRuleParameters) { p.FixedRate = "0.1" }),
+			r:    samplingRule(),
+			want: false,
+		},
+		"AttributesChanged": {
+			p: samplingRuleParameters(func(p *v1alpha1.SamplingRuleParameters) {
+				p.Attributes = map[string]string{"tenant": "a"}
+			}),
+			r:    samplingRule(),
+			want: false,
+		},
+		"HostChanged": {
+			p:    samplingRuleParameters(func(p *v1alpha1.SamplingRuleParameters) { p.Host = aws.String("shop.example.com") }),
+			r:    samplingRule(),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSamplingRuleUpToDate(tc.p, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -65,10 +65,10 @@ func SetupCertificate(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1alpha1.Certificate{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CertificateGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient}),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithPartitionGate(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient}, v1alpha1.Group)))),
 			managed.WithConnectionPublishers(),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
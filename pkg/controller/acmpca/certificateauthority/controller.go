@@ -63,12 +63,12 @@ func SetupCertificateAuthority(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1alpha1.CertificateAuthority{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CertificateAuthorityGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient}),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithPartitionGate(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient}, v1alpha1.Group)))),
 			managed.WithConnectionPublishers(),
 
 			// TODO: implement tag initializer
 
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -54,10 +54,10 @@ func SetupCertificateAuthorityPermission(mgr ctrl.Manager, l logging.Logger) err
 		For(&v1alpha1.CertificateAuthorityPermission{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CertificateAuthorityPermissionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewCAPermissionClient}),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithPartitionGate(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewCAPermissionClient}, v1alpha1.Group)))),
 			managed.WithConnectionPublishers(),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -0,0 +1,400 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsamplify "github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/amplify"
+	"github.com/crossplane/provider-aws/pkg/clients/amplify/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	appID         = "d2a1b2c3d4e5f6"
+	appARN        = "arn:aws:amplify:us-east-1:123456789012:apps/d2a1b2c3d4e5f6"
+	defaultDomain = "d2a1b2c3d4e5f6.amplifyapp.com"
+	repository    = "https://github.com/example/marketing-site"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsamplify.ErrCodeNotFoundException, "", nil)
+)
+
+type args struct {
+	amplify amplify.AppClient
+	kube    *test.MockClient
+	cr      resource.Managed
+}
+
+type appModifier func(*v1alpha1.App)
+
+func withConditions(c ...runtimev1alpha1.Condition) appModifier {
+	return func(r *v1alpha1.App) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) appModifier {
+	return func(r *v1alpha1.App) { meta.SetExternalName(r, n) }
+}
+
+func withObservation() appModifier {
+	return func(r *v1alpha1.App) {
+		r.Status.AtProvider = v1alpha1.AppObservation{ARN: appARN, DefaultDomain: defaultDomain}
+	}
+}
+
+func withEnableBranchAutoBuild(b *bool) appModifier {
+	return func(r *v1alpha1.App) { r.Spec.ForProvider.EnableBranchAutoBuild = b }
+}
+
+func withEnvironmentVariables(v ...v1alpha1.EnvironmentVariable) appModifier {
+	return func(r *v1alpha1.App) { r.Spec.ForProvider.EnvironmentVariables = v }
+}
+
+func app(m ...appModifier) *v1alpha1.App {
+	cr := &v1alpha1.App{
+		Spec: v1alpha1.AppSpec{
+			ForProvider: v1alpha1.AppParameters{
+				Region:                "us-east-1",
+				Repository:            aws.String(repository),
+				Platform:              aws.String(string(awsamplify.PlatformWeb)),
+				EnableBranchAutoBuild: aws.Bool(true),
+			},
+		},
+	}
+	cr.SetName("marketing-site")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getApp(err error) func(*awsamplify.GetAppInput) awsamplify.GetAppRequest {
+	return func(*awsamplify.GetAppInput) awsamplify.GetAppRequest {
+		return awsamplify.GetAppRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsamplify.GetAppOutput{App: &awsamplify.App{
+				AppArn:                aws.String(appARN),
+				AppId:                 aws.String(appID),
+				DefaultDomain:         aws.String(defaultDomain),
+				Description:           aws.String(""),
+				Repository:            aws.String(repository),
+				Platform:              awsamplify.PlatformWeb,
+				EnableBranchAutoBuild: aws.Bool(true),
+				EnvironmentVariables:  map[string]string{},
+			}}, Error: err},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				amplify: &fake.MockAppClient{MockGetApp: getApp(nil)},
+				cr:      app(withExternalName(appID)),
+			},
+			want: want{
+				cr:     app(withExternalName(appID), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				amplify: &fake.MockAppClient{MockGetApp: getApp(nil)},
+				kube:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:      app(withExternalName(appID), withEnableBranchAutoBuild(nil)),
+			},
+			want: want{
+				cr:     app(withExternalName(appID), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				amplify: &fake.MockAppClient{MockGetApp: getApp(nil)},
+				cr:      app(withExternalName(appID), withEnvironmentVariables(v1alpha1.EnvironmentVariable{Name: "STAGE", Value: aws.String("prod")})),
+			},
+			want: want{
+				cr: app(withExternalName(appID), withEnvironmentVariables(v1alpha1.EnvironmentVariable{Name: "STAGE", Value: aws.String("prod")}),
+					withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: app(),
+			},
+			want: want{
+				cr: app(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				amplify: &fake.MockAppClient{MockGetApp: getApp(errNotFound)},
+				cr:      app(withExternalName(appID)),
+			},
+			want: want{
+				cr: app(withExternalName(appID)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				amplify: &fake.MockAppClient{MockGetApp: getApp(errBoom)},
+				cr:      app(withExternalName(appID)),
+			},
+			want: want{
+				cr:  app(withExternalName(appID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.amplify}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				amplify: &fake.MockAppClient{
+					MockCreateApp: func(in *awsamplify.CreateAppInput) awsamplify.CreateAppRequest {
+						if diff := cmp.Diff("marketing-site", aws.StringValue(in.Name)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsamplify.CreateAppRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsamplify.CreateAppOutput{
+								App: &awsamplify.App{AppId: aws.String(appID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   app(),
+			},
+			want: want{
+				cr: app(withExternalName(appID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				amplify: &fake.MockAppClient{
+					MockCreateApp: func(*awsamplify.CreateAppInput) awsamplify.CreateAppRequest {
+						return awsamplify.CreateAppRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: app(),
+			},
+			want: want{
+				cr:  app(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.amplify}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				amplify: &fake.MockAppClient{
+					MockUpdateApp: func(in *awsamplify.UpdateAppInput) awsamplify.UpdateAppRequest {
+						if diff := cmp.Diff(map[string]string{"STAGE": "prod"}, in.EnvironmentVariables); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsamplify.UpdateAppRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsamplify.UpdateAppOutput{}},
+						}
+					},
+				},
+				cr: app(withExternalName(appID), withEnvironmentVariables(v1alpha1.EnvironmentVariable{Name: "STAGE", Value: aws.String("prod")})),
+			},
+		},
+		"ClientError": {
+			args: args{
+				amplify: &fake.MockAppClient{
+					MockUpdateApp: func(*awsamplify.UpdateAppInput) awsamplify.UpdateAppRequest {
+						return awsamplify.UpdateAppRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.amplify}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteApp := func(err error) func(*awsamplify.DeleteAppInput) awsamplify.DeleteAppRequest {
+		return func(*awsamplify.DeleteAppInput) awsamplify.DeleteAppRequest {
+			return awsamplify.DeleteAppRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsamplify.DeleteAppOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				amplify: &fake.MockAppClient{MockDeleteApp: deleteApp(nil)},
+				cr:      app(withExternalName(appID)),
+			},
+			want: want{
+				cr: app(withExternalName(appID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				amplify: &fake.MockAppClient{MockDeleteApp: deleteApp(errNotFound)},
+				cr:      app(withExternalName(appID)),
+			},
+			want: want{
+				cr: app(withExternalName(appID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				amplify: &fake.MockAppClient{MockDeleteApp: deleteApp(errBoom)},
+				cr:      app(withExternalName(appID)),
+			},
+			want: want{
+				cr:  app(withExternalName(appID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.amplify}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsamplify "github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/amplify"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Branch resource"
+
+	errDescribe   = "failed to describe the Branch resource"
+	errCreate     = "failed to create the Branch resource"
+	errUpdate     = "failed to update the Branch resource"
+	errDelete     = "failed to delete the Branch resource"
+	errSpecUpdate = "cannot update spec of Branch custom resource"
+)
+
+// SetupBranch adds a controller that reconciles Branches.
+func SetupBranch(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.BranchGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Branch{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BranchGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: amplify.NewBranchClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) amplify.BranchClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Branch)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client amplify.BranchClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Branch)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.GetBranchRequest(&awsamplify.GetBranchInput{
+		AppId:      cr.Spec.ForProvider.AppID,
+		BranchName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(amplify.IsNotFound, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	amplify.LateInitializeBranch(&cr.Spec.ForProvider, *rsp.Branch)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = amplify.GenerateBranchObservation(*rsp.Branch)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	env, err := amplify.GetEnvironmentVariables(ctx, e.kube, cr.Spec.ForProvider.EnvironmentVariables)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: amplify.IsBranchUpToDate(cr.Spec.ForProvider, *rsp.Branch, env),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Branch)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	env, err := amplify.GetEnvironmentVariables(ctx, e.kube, cr.Spec.ForProvider.EnvironmentVariables)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	_, err = e.client.CreateBranchRequest(amplify.GenerateCreateBranchInput(meta.GetExternalName(cr), cr.Spec.ForProvider, env)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Branch)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	env, err := amplify.GetEnvironmentVariables(ctx, e.kube, cr.Spec.ForProvider.EnvironmentVariables)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	_, err = e.client.UpdateBranchRequest(amplify.GenerateUpdateBranchInput(meta.GetExternalName(cr), cr.Spec.ForProvider, env)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Branch)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteBranchRequest(&awsamplify.DeleteBranchInput{
+		AppId:      cr.Spec.ForProvider.AppID,
+		BranchName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(amplify.IsNotFound, err), errDelete)
+}
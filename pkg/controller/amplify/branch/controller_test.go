@@ -0,0 +1,387 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branch
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsamplify "github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/amplify"
+	"github.com/crossplane/provider-aws/pkg/clients/amplify/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	appID      = "d2a1b2c3d4e5f6"
+	branchName = "main"
+	branchARN  = "arn:aws:amplify:us-east-1:123456789012:apps/d2a1b2c3d4e5f6/branches/main"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsamplify.ErrCodeNotFoundException, "", nil)
+)
+
+type args struct {
+	amplify amplify.BranchClient
+	kube    *test.MockClient
+	cr      resource.Managed
+}
+
+type branchModifier func(*v1alpha1.Branch)
+
+func withConditions(c ...runtimev1alpha1.Condition) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation() branchModifier {
+	return func(r *v1alpha1.Branch) {
+		r.Status.AtProvider = v1alpha1.BranchObservation{ARN: branchARN, DisplayName: branchName}
+	}
+}
+
+func withEnableAutoBuild(b *bool) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Spec.ForProvider.EnableAutoBuild = b }
+}
+
+func withStage(s string) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Spec.ForProvider.Stage = aws.String(s) }
+}
+
+func branch(m ...branchModifier) *v1alpha1.Branch {
+	cr := &v1alpha1.Branch{
+		Spec: v1alpha1.BranchSpec{
+			ForProvider: v1alpha1.BranchParameters{
+				Region:                   "us-east-1",
+				AppID:                    aws.String(appID),
+				Stage:                    aws.String(string(awsamplify.StageProduction)),
+				Framework:                aws.String("React"),
+				EnableAutoBuild:          aws.Bool(true),
+				EnablePullRequestPreview: aws.Bool(false),
+			},
+		},
+	}
+	meta.SetExternalName(cr, branchName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getBranch(err error) func(*awsamplify.GetBranchInput) awsamplify.GetBranchRequest {
+	return func(*awsamplify.GetBranchInput) awsamplify.GetBranchRequest {
+		return awsamplify.GetBranchRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsamplify.GetBranchOutput{Branch: &awsamplify.Branch{
+				BranchArn:                aws.String(branchARN),
+				BranchName:               aws.String(branchName),
+				DisplayName:              aws.String(branchName),
+				ActiveJobId:              aws.String(""),
+				Description:              aws.String(""),
+				Stage:                    awsamplify.StageProduction,
+				Framework:                aws.String("React"),
+				EnableAutoBuild:          aws.Bool(true),
+				EnablePullRequestPreview: aws.Bool(false),
+				EnvironmentVariables:     map[string]string{},
+			}}, Error: err},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				amplify: &fake.MockBranchClient{MockGetBranch: getBranch(nil)},
+				cr:      branch(),
+			},
+			want: want{
+				cr:     branch(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				amplify: &fake.MockBranchClient{MockGetBranch: getBranch(nil)},
+				kube:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:      branch(withEnableAutoBuild(nil)),
+			},
+			want: want{
+				cr:     branch(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				amplify: &fake.MockBranchClient{MockGetBranch: getBranch(nil)},
+				cr:      branch(withStage(string(awsamplify.StageBeta))),
+			},
+			want: want{
+				cr:     branch(withStage(string(awsamplify.StageBeta)), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				amplify: &fake.MockBranchClient{MockGetBranch: getBranch(errNotFound)},
+				cr:      branch(),
+			},
+			want: want{
+				cr: branch(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				amplify: &fake.MockBranchClient{MockGetBranch: getBranch(errBoom)},
+				cr:      branch(),
+			},
+			want: want{
+				cr:  branch(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.amplify}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				amplify: &fake.MockBranchClient{
+					MockCreateBranch: func(in *awsamplify.CreateBranchInput) awsamplify.CreateBranchRequest {
+						if diff := cmp.Diff(branchName, aws.StringValue(in.BranchName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsamplify.CreateBranchRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsamplify.CreateBranchOutput{}},
+						}
+					},
+				},
+				cr: branch(),
+			},
+			want: want{
+				cr: branch(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				amplify: &fake.MockBranchClient{
+					MockCreateBranch: func(*awsamplify.CreateBranchInput) awsamplify.CreateBranchRequest {
+						return awsamplify.CreateBranchRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: branch(),
+			},
+			want: want{
+				cr:  branch(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.amplify}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				amplify: &fake.MockBranchClient{
+					MockUpdateBranch: func(in *awsamplify.UpdateBranchInput) awsamplify.UpdateBranchRequest {
+						if diff := cmp.Diff(awsamplify.StageBeta, in.Stage); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsamplify.UpdateBranchRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsamplify.UpdateBranchOutput{}},
+						}
+					},
+				},
+				cr: branch(withStage(string(awsamplify.StageBeta))),
+			},
+		},
+		"ClientError": {
+			args: args{
+				amplify: &fake.MockBranchClient{
+					MockUpdateBranch: func(*awsamplify.UpdateBranchInput) awsamplify.UpdateBranchRequest {
+						return awsamplify.UpdateBranchRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: branch(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.amplify}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteBranch := func(err error) func(*awsamplify.DeleteBranchInput) awsamplify.DeleteBranchRequest {
+		return func(*awsamplify.DeleteBranchInput) awsamplify.DeleteBranchRequest {
+			return awsamplify.DeleteBranchRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsamplify.DeleteBranchOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				amplify: &fake.MockBranchClient{MockDeleteBranch: deleteBranch(nil)},
+				cr:      branch(),
+			},
+			want: want{
+				cr: branch(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				amplify: &fake.MockBranchClient{MockDeleteBranch: deleteBranch(errNotFound)},
+				cr:      branch(),
+			},
+			want: want{
+				cr: branch(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				amplify: &fake.MockBranchClient{MockDeleteBranch: deleteBranch(errBoom)},
+				cr:      branch(),
+			},
+			want: want{
+				cr:  branch(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.amplify}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
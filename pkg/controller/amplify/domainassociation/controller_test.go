@@ -0,0 +1,408 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domainassociation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsamplify "github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/amplify"
+	"github.com/crossplane/provider-aws/pkg/clients/amplify/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	appID     = "d2a1b2c3d4e5f6"
+	domain    = "example.com"
+	domainARN = "arn:aws:amplify:us-east-1:123456789012:apps/d2a1b2c3d4e5f6/domains/example.com"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsamplify.ErrCodeNotFoundException, "", nil)
+)
+
+type args struct {
+	amplify amplify.DomainAssociationClient
+	kube    *test.MockClient
+	cr      resource.Managed
+}
+
+type domainAssociationModifier func(*v1alpha1.DomainAssociation)
+
+func withConditions(c ...runtimev1alpha1.Condition) domainAssociationModifier {
+	return func(r *v1alpha1.DomainAssociation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(status awsamplify.DomainStatus) domainAssociationModifier {
+	return func(r *v1alpha1.DomainAssociation) {
+		r.Status.AtProvider = v1alpha1.DomainAssociationObservation{
+			ARN:          domainARN,
+			DomainStatus: string(status),
+			SubDomains:   []v1alpha1.SubDomain{{Prefix: "www", BranchName: "main"}},
+		}
+	}
+}
+
+func withEnableAutoSubDomain(b *bool) domainAssociationModifier {
+	return func(r *v1alpha1.DomainAssociation) { r.Spec.ForProvider.EnableAutoSubDomain = b }
+}
+
+func withBranch(b string) domainAssociationModifier {
+	return func(r *v1alpha1.DomainAssociation) {
+		r.Spec.ForProvider.SubDomainSettings = []v1alpha1.SubDomainSetting{{Prefix: "www", BranchName: aws.String(b)}}
+	}
+}
+
+func domainAssociation(m ...domainAssociationModifier) *v1alpha1.DomainAssociation {
+	cr := &v1alpha1.DomainAssociation{
+		Spec: v1alpha1.DomainAssociationSpec{
+			ForProvider: v1alpha1.DomainAssociationParameters{
+				Region:              "us-east-1",
+				AppID:               aws.String(appID),
+				EnableAutoSubDomain: aws.Bool(false),
+				SubDomainSettings:   []v1alpha1.SubDomainSetting{{Prefix: "www", BranchName: aws.String("main")}},
+			},
+		},
+	}
+	meta.SetExternalName(cr, domain)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getDomainAssociation(status awsamplify.DomainStatus, err error) func(*awsamplify.GetDomainAssociationInput) awsamplify.GetDomainAssociationRequest {
+	return func(*awsamplify.GetDomainAssociationInput) awsamplify.GetDomainAssociationRequest {
+		return awsamplify.GetDomainAssociationRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsamplify.GetDomainAssociationOutput{DomainAssociation: &awsamplify.DomainAssociation{
+				DomainAssociationArn: aws.String(domainARN),
+				DomainName:           aws.String(domain),
+				DomainStatus:         status,
+				EnableAutoSubDomain:  aws.Bool(false),
+				SubDomains: []awsamplify.SubDomain{{
+					SubDomainSetting: &awsamplify.SubDomainSetting{Prefix: aws.String("www"), BranchName: aws.String("main")},
+				}},
+			}}, Error: err},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				amplify: &fake.MockDomainAssociationClient{MockGetDomainAssociation: getDomainAssociation(awsamplify.DomainStatusAvailable, nil)},
+				cr:      domainAssociation(),
+			},
+			want: want{
+				cr:     domainAssociation(withObservation(awsamplify.DomainStatusAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PendingVerification": {
+			args: args{
+				amplify: &fake.MockDomainAssociationClient{MockGetDomainAssociation: getDomainAssociation(awsamplify.DomainStatusPendingVerification, nil)},
+				cr:      domainAssociation(),
+			},
+			want: want{
+				cr:     domainAssociation(withObservation(awsamplify.DomainStatusPendingVerification), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Failed": {
+			args: args{
+				amplify: &fake.MockDomainAssociationClient{MockGetDomainAssociation: getDomainAssociation(awsamplify.DomainStatusFailed, nil)},
+				cr:      domainAssociation(),
+			},
+			want: want{
+				cr:     domainAssociation(withObservation(awsamplify.DomainStatusFailed), withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				amplify: &fake.MockDomainAssociationClient{MockGetDomainAssociation: getDomainAssociation(awsamplify.DomainStatusAvailable, nil)},
+				kube:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:      domainAssociation(withEnableAutoSubDomain(nil)),
+			},
+			want: want{
+				cr:     domainAssociation(withObservation(awsamplify.DomainStatusAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				amplify: &fake.MockDomainAssociationClient{MockGetDomainAssociation: getDomainAssociation(awsamplify.DomainStatusAvailable, nil)},
+				cr:      domainAssociation(withBranch("dev")),
+			},
+			want: want{
+				cr:     domainAssociation(withBranch("dev"), withObservation(awsamplify.DomainStatusAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				amplify: &fake.MockDomainAssociationClient{MockGetDomainAssociation: getDomainAssociation("", errNotFound)},
+				cr:      domainAssociation(),
+			},
+			want: want{
+				cr: domainAssociation(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				amplify: &fake.MockDomainAssociationClient{MockGetDomainAssociation: getDomainAssociation("", errBoom)},
+				cr:      domainAssociation(),
+			},
+			want: want{
+				cr:  domainAssociation(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.amplify}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				amplify: &fake.MockDomainAssociationClient{
+					MockCreateDomainAssociation: func(in *awsamplify.CreateDomainAssociationInput) awsamplify.CreateDomainAssociationRequest {
+						if diff := cmp.Diff(domain, aws.StringValue(in.DomainName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsamplify.CreateDomainAssociationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsamplify.CreateDomainAssociationOutput{}},
+						}
+					},
+				},
+				cr: domainAssociation(),
+			},
+			want: want{
+				cr: domainAssociation(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				amplify: &fake.MockDomainAssociationClient{
+					MockCreateDomainAssociation: func(*awsamplify.CreateDomainAssociationInput) awsamplify.CreateDomainAssociationRequest {
+						return awsamplify.CreateDomainAssociationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: domainAssociation(),
+			},
+			want: want{
+				cr:  domainAssociation(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.amplify}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				amplify: &fake.MockDomainAssociationClient{
+					MockUpdateDomainAssociation: func(in *awsamplify.UpdateDomainAssociationInput) awsamplify.UpdateDomainAssociationRequest {
+						if diff := cmp.Diff("dev", aws.StringValue(in.SubDomainSettings[0].BranchName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsamplify.UpdateDomainAssociationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsamplify.UpdateDomainAssociationOutput{}},
+						}
+					},
+				},
+				cr: domainAssociation(withBranch("dev")),
+			},
+		},
+		"ClientError": {
+			args: args{
+				amplify: &fake.MockDomainAssociationClient{
+					MockUpdateDomainAssociation: func(*awsamplify.UpdateDomainAssociationInput) awsamplify.UpdateDomainAssociationRequest {
+						return awsamplify.UpdateDomainAssociationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: domainAssociation(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.amplify}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteDomainAssociation := func(err error) func(*awsamplify.DeleteDomainAssociationInput) awsamplify.DeleteDomainAssociationRequest {
+		return func(*awsamplify.DeleteDomainAssociationInput) awsamplify.DeleteDomainAssociationRequest {
+			return awsamplify.DeleteDomainAssociationRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsamplify.DeleteDomainAssociationOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				amplify: &fake.MockDomainAssociationClient{MockDeleteDomainAssociation: deleteDomainAssociation(nil)},
+				cr:      domainAssociation(),
+			},
+			want: want{
+				cr: domainAssociation(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				amplify: &fake.MockDomainAssociationClient{MockDeleteDomainAssociation: deleteDomainAssociation(errNotFound)},
+				cr:      domainAssociation(),
+			},
+			want: want{
+				cr: domainAssociation(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				amplify: &fake.MockDomainAssociationClient{MockDeleteDomainAssociation: deleteDomainAssociation(errBoom)},
+				cr:      domainAssociation(),
+			},
+			want: want{
+				cr:  domainAssociation(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.amplify}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesh
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappmesh "github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/appmesh"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an App Mesh Mesh resource"
+
+	errDescribe   = "failed to describe the Mesh resource"
+	errCreate     = "failed to create the Mesh resource"
+	errUpdate     = "failed to update the Mesh resource"
+	errDelete     = "failed to delete the Mesh resource"
+	errListTags   = "failed to list the tags of the Mesh resource"
+	errAddTags    = "failed to add tags to the Mesh resource"
+	errRemoveTags = "failed to remove tags from the Mesh resource"
+	errSpecUpdate = "cannot update spec of the Mesh custom resource"
+)
+
+// SetupMesh adds a controller that reconciles Meshes.
+func SetupMesh(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.MeshGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Mesh{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.MeshGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: appmesh.NewMeshClient}, awsclients.DeletionTierNetwork), v1alpha1.Group)))),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) appmesh.MeshClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Mesh)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client appmesh.MeshClient
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.Mesh) (*awsappmesh.MeshData, error) {
+	rsp, err := e.client.DescribeMeshRequest(&awsappmesh.DescribeMeshInput{
+		MeshName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Mesh, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Mesh)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(appmesh.IsNotFound, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	appmesh.LateInitializeMesh(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = appmesh.GenerateMeshObservation(*observed)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.StatusActive:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.StatusDeleted:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	tags, err := e.client.ListTagsForResourceRequest(&awsappmesh.ListTagsForResourceInput{ResourceArn: aws.String(cr.Status.AtProvider.ARN)}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := appmesh.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(add) == 0 && len(remove) == 0 && appmesh.IsMeshUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Mesh)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateMeshRequest(appmesh.GenerateCreateMeshInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+// Update updates the tags and the egress filter of the mesh.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Mesh)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := aws.String(cr.Status.AtProvider.ARN)
+	tags, err := e.client.ListTagsForResourceRequest(&awsappmesh.ListTagsForResourceInput{ResourceArn: arn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := appmesh.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.UntagResourceRequest(&awsappmesh.UntagResourceInput{ResourceArn: arn, TagKeys: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.TagResourceRequest(&awsappmesh.TagResourceInput{ResourceArn: arn, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if appmesh.IsMeshUpToDate(cr.Spec.ForProvider, *observed) {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.UpdateMeshRequest(&awsappmesh.UpdateMeshInput{
+		MeshName: aws.String(meta.GetExternalName(cr)),
+		Spec:     appmesh.GenerateMeshSpec(cr.Spec.ForProvider),
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+// Delete deletes the mesh. App Mesh refuses to delete a mesh that still
+// contains virtual nodes or virtual services.
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Mesh)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.Status == v1alpha1.StatusDeleted {
+		return nil
+	}
+	_, err := e.client.DeleteMeshRequest(&awsappmesh.DeleteMeshInput{MeshName: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	return errors.Wrap(resource.Ignore(appmesh.IsNotFound, err), errDelete)
+}
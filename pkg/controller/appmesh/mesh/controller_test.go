@@ -0,0 +1,466 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesh
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsappmesh "github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/appmesh"
+	"github.com/crossplane/provider-aws/pkg/clients/appmesh/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	meshName = "some-mesh"
+	meshARN  = "arn:aws:appmesh:us-east-1:123456789012:mesh/some-mesh"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	appmesh appmesh.MeshClient
+	kube    *test.MockClient
+	cr      resource.Managed
+}
+
+type meshModifier func(*v1alpha1.Mesh)
+
+func withConditions(c ...runtimev1alpha1.Condition) meshModifier {
+	return func(r *v1alpha1.Mesh) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s string) meshModifier {
+	return func(r *v1alpha1.Mesh) {
+		r.Status.AtProvider = v1alpha1.MeshObservation{ARN: meshARN, Status: s}
+	}
+}
+
+func withEgressFilter(f string) meshModifier {
+	return func(r *v1alpha1.Mesh) { r.Spec.ForProvider.EgressFilter = &v1alpha1.EgressFilter{Type: f} }
+}
+
+func withTags(tags ...v1alpha1.Tag) meshModifier {
+	return func(r *v1alpha1.Mesh) { r.Spec.ForProvider.Tags = tags }
+}
+
+func mesh(m ...meshModifier) *v1alpha1.Mesh {
+	cr := &v1alpha1.Mesh{}
+	meta.SetExternalName(cr, meshName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(status awsappmesh.MeshStatusCode) func(*awsappmesh.DescribeMeshInput) awsappmesh.DescribeMeshRequest {
+	return func(*awsappmesh.DescribeMeshInput) awsappmesh.DescribeMeshRequest {
+		return awsappmesh.DescribeMeshRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.DescribeMeshOutput{
+				Mesh: &awsappmesh.MeshData{
+					MeshName: aws.String(meshName),
+					Metadata: &awsappmesh.ResourceMetadata{Arn: aws.String(meshARN)},
+					Spec:     &awsappmesh.MeshSpec{EgressFilter: &awsappmesh.EgressFilter{Type: awsappmesh.EgressFilterTypeDropAll}},
+					Status:   &awsappmesh.MeshStatus{Status: status},
+				},
+			}},
+		}
+	}
+}
+
+func noTags(*awsappmesh.ListTagsForResourceInput) awsappmesh.ListTagsForResourceRequest {
+	return awsappmesh.ListTagsForResourceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.ListTagsForResourceOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				appmesh: &fake.MockMeshClient{
+					MockDescribeMesh:        describe(awsappmesh.MeshStatusCodeActive),
+					MockListTagsForResource: noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   mesh(),
+			},
+			want: want{
+				cr: mesh(withEgressFilter("DROP_ALL"), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"EgressFilterChanged": {
+			args: args{
+				appmesh: &fake.MockMeshClient{
+					MockDescribeMesh:        describe(awsappmesh.MeshStatusCodeActive),
+					MockListTagsForResource: noTags,
+				},
+				cr: mesh(withEgressFilter("ALLOW_ALL")),
+			},
+			want: want{
+				cr: mesh(withEgressFilter("ALLOW_ALL"), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				appmesh: &fake.MockMeshClient{
+					MockDescribeMesh:        describe(awsappmesh.MeshStatusCodeActive),
+					MockListTagsForResource: noTags,
+				},
+				cr: mesh(withEgressFilter("DROP_ALL"), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")})),
+			},
+			want: want{
+				cr: mesh(withEgressFilter("DROP_ALL"), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				appmesh: &fake.MockMeshClient{
+					MockDescribeMesh:        describe(awsappmesh.MeshStatusCodeDeleted),
+					MockListTagsForResource: noTags,
+				},
+				cr: mesh(withEgressFilter("DROP_ALL")),
+			},
+			want: want{
+				cr: mesh(withEgressFilter("DROP_ALL"), withStatus(v1alpha1.StatusDeleted), withConditions(runtimev1alpha1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				appmesh: &fake.MockMeshClient{
+					MockDescribeMesh: func(*awsappmesh.DescribeMeshInput) awsappmesh.DescribeMeshRequest {
+						return awsappmesh.DescribeMeshRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsappmesh.ErrCodeNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: mesh(),
+			},
+			want: want{
+				cr: mesh(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockMeshClient{
+					MockDescribeMesh: func(*awsappmesh.DescribeMeshInput) awsappmesh.DescribeMeshRequest {
+						return awsappmesh.DescribeMeshRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: mesh(),
+			},
+			want: want{
+				cr:  mesh(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				appmesh: &fake.MockMeshClient{
+					MockCreateMesh: func(input *awsappmesh.CreateMeshInput) awsappmesh.CreateMeshRequest {
+						if diff := cmp.Diff(meshName, aws.StringValue(input.MeshName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.CreateMeshRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.CreateMeshOutput{}},
+						}
+					},
+				},
+				cr: mesh(),
+			},
+			want: want{
+				cr: mesh(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockMeshClient{
+					MockCreateMesh: func(*awsappmesh.CreateMeshInput) awsappmesh.CreateMeshRequest {
+						return awsappmesh.CreateMeshRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: mesh(),
+			},
+			want: want{
+				cr:  mesh(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ChangeEgressFilter": {
+			args: args{
+				appmesh: &fake.MockMeshClient{
+					MockListTagsForResource: noTags,
+					MockDescribeMesh:        describe(awsappmesh.MeshStatusCodeActive),
+					MockUpdateMesh: func(input *awsappmesh.UpdateMeshInput) awsappmesh.UpdateMeshRequest {
+						if diff := cmp.Diff(awsappmesh.EgressFilterTypeAllowAll, input.Spec.EgressFilter.Type); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.UpdateMeshRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.UpdateMeshOutput{}},
+						}
+					},
+				},
+				cr: mesh(withEgressFilter("ALLOW_ALL"), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: mesh(withEgressFilter("ALLOW_ALL"), withStatus(v1alpha1.StatusActive)),
+			},
+		},
+		"AddTags": {
+			args: args{
+				appmesh: &fake.MockMeshClient{
+					MockListTagsForResource: noTags,
+					MockDescribeMesh:        describe(awsappmesh.MeshStatusCodeActive),
+					MockTagResource: func(input *awsappmesh.TagResourceInput) awsappmesh.TagResourceRequest {
+						if diff := cmp.Diff([]awsappmesh.TagRef{{Key: aws.String("k"), Value: aws.String("v")}}, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.TagResourceOutput{}},
+						}
+					},
+				},
+				cr: mesh(withEgressFilter("DROP_ALL"), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: mesh(withEgressFilter("DROP_ALL"), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockMeshClient{
+					MockListTagsForResource: noTags,
+					MockDescribeMesh:        describe(awsappmesh.MeshStatusCodeActive),
+					MockUpdateMesh: func(*awsappmesh.UpdateMeshInput) awsappmesh.UpdateMeshRequest {
+						return awsappmesh.UpdateMeshRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: mesh(withEgressFilter("ALLOW_ALL"), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr:  mesh(withEgressFilter("ALLOW_ALL"), withStatus(v1alpha1.StatusActive)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				appmesh: &fake.MockMeshClient{
+					MockDeleteMesh: func(*awsappmesh.DeleteMeshInput) awsappmesh.DeleteMeshRequest {
+						return awsappmesh.DeleteMeshRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.DeleteMeshOutput{}},
+						}
+					},
+				},
+				cr: mesh(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: mesh(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				appmesh: &fake.MockMeshClient{},
+				cr:      mesh(withStatus(v1alpha1.StatusDeleted)),
+			},
+			want: want{
+				cr: mesh(withStatus(v1alpha1.StatusDeleted), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				appmesh: &fake.MockMeshClient{
+					MockDeleteMesh: func(*awsappmesh.DeleteMeshInput) awsappmesh.DeleteMeshRequest {
+						return awsappmesh.DeleteMeshRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsappmesh.ErrCodeNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: mesh(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: mesh(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockMeshClient{
+					MockDeleteMesh: func(*awsappmesh.DeleteMeshInput) awsappmesh.DeleteMeshRequest {
+						return awsappmesh.DeleteMeshRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: mesh(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr:  mesh(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,483 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsappmesh "github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/appmesh"
+	"github.com/crossplane/provider-aws/pkg/clients/appmesh/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	meshName   = "some-mesh"
+	routerName = "some-router"
+	nodeName   = "some-node"
+	routeName  = "some-route"
+	routeARN   = "arn:aws:appmesh:us-east-1:123456789012:mesh/some-mesh/virtualRouter/some-router/route/some-route"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	appmesh appmesh.RouteClient
+	kube    *test.MockClient
+	cr      resource.Managed
+}
+
+type routeModifier func(*v1alpha1.Route)
+
+func withConditions(c ...runtimev1alpha1.Condition) routeModifier {
+	return func(r *v1alpha1.Route) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s string) routeModifier {
+	return func(r *v1alpha1.Route) {
+		r.Status.AtProvider = v1alpha1.RouteObservation{ARN: routeARN, Status: s}
+	}
+}
+
+func withVirtualNode(n string) routeModifier {
+	return func(r *v1alpha1.Route) {
+		r.Spec.ForProvider.TCPRoute = &v1alpha1.TCPRoute{Action: v1alpha1.RouteAction{
+			WeightedTargets: []v1alpha1.WeightedTarget{{VirtualNodeName: aws.String(n), Weight: 1}},
+		}}
+	}
+}
+
+func withTags(tags ...v1alpha1.Tag) routeModifier {
+	return func(r *v1alpha1.Route) { r.Spec.ForProvider.Tags = tags }
+}
+
+func route(m ...routeModifier) *v1alpha1.Route {
+	cr := &v1alpha1.Route{
+		Spec: v1alpha1.RouteSpec{
+			ForProvider: v1alpha1.RouteParameters{MeshName: aws.String(meshName), VirtualRouterName: aws.String(routerName)},
+		},
+	}
+	meta.SetExternalName(cr, routeName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(status awsappmesh.RouteStatusCode) func(*awsappmesh.DescribeRouteInput) awsappmesh.DescribeRouteRequest {
+	return func(*awsappmesh.DescribeRouteInput) awsappmesh.DescribeRouteRequest {
+		return awsappmesh.DescribeRouteRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.DescribeRouteOutput{
+				Route: &awsappmesh.RouteData{
+					MeshName:          aws.String(meshName),
+					VirtualRouterName: aws.String(routerName),
+					RouteName:         aws.String(routeName),
+					Metadata:          &awsappmesh.ResourceMetadata{Arn: aws.String(routeARN)},
+					Spec: &awsappmesh.RouteSpec{TcpRoute: &awsappmesh.TcpRoute{Action: &awsappmesh.TcpRouteAction{
+						WeightedTargets: []awsappmesh.WeightedTarget{{VirtualNode: aws.String(nodeName), Weight: aws.Int64(1)}},
+					}}},
+					Status: &awsappmesh.RouteStatus{Status: status},
+				},
+			}},
+		}
+	}
+}
+
+func noTags(*awsappmesh.ListTagsForResourceInput) awsappmesh.ListTagsForResourceRequest {
+	return awsappmesh.ListTagsForResourceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.ListTagsForResourceOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				appmesh: &fake.MockRouteClient{
+					MockDescribeRoute:       describe(awsappmesh.RouteStatusCodeActive),
+					MockListTagsForResource: noTags,
+				},
+				cr: route(withVirtualNode(nodeName)),
+			},
+			want: want{
+				cr: route(withVirtualNode(nodeName), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"VirtualNodeChanged": {
+			args: args{
+				appmesh: &fake.MockRouteClient{
+					MockDescribeRoute:       describe(awsappmesh.RouteStatusCodeActive),
+					MockListTagsForResource: noTags,
+				},
+				cr: route(withVirtualNode("other-node")),
+			},
+			want: want{
+				cr: route(withVirtualNode("other-node"), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				appmesh: &fake.MockRouteClient{
+					MockDescribeRoute:       describe(awsappmesh.RouteStatusCodeActive),
+					MockListTagsForResource: noTags,
+				},
+				cr: route(withVirtualNode(nodeName), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")})),
+			},
+			want: want{
+				cr: route(withVirtualNode(nodeName), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				appmesh: &fake.MockRouteClient{
+					MockDescribeRoute:       describe(awsappmesh.RouteStatusCodeDeleted),
+					MockListTagsForResource: noTags,
+				},
+				cr: route(withVirtualNode(nodeName)),
+			},
+			want: want{
+				cr: route(withVirtualNode(nodeName), withStatus(v1alpha1.StatusDeleted), withConditions(runtimev1alpha1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				appmesh: &fake.MockRouteClient{
+					MockDescribeRoute: func(*awsappmesh.DescribeRouteInput) awsappmesh.DescribeRouteRequest {
+						return awsappmesh.DescribeRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsappmesh.ErrCodeNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: route(),
+			},
+			want: want{
+				cr: route(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockRouteClient{
+					MockDescribeRoute: func(*awsappmesh.DescribeRouteInput) awsappmesh.DescribeRouteRequest {
+						return awsappmesh.DescribeRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: route(),
+			},
+			want: want{
+				cr:  route(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				appmesh: &fake.MockRouteClient{
+					MockCreateRoute: func(input *awsappmesh.CreateRouteInput) awsappmesh.CreateRouteRequest {
+						if diff := cmp.Diff(meshName, aws.StringValue(input.MeshName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(routerName, aws.StringValue(input.VirtualRouterName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.CreateRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.CreateRouteOutput{}},
+						}
+					},
+				},
+				cr: route(),
+			},
+			want: want{
+				cr: route(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockRouteClient{
+					MockCreateRoute: func(*awsappmesh.CreateRouteInput) awsappmesh.CreateRouteRequest {
+						return awsappmesh.CreateRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: route(),
+			},
+			want: want{
+				cr:  route(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ChangeVirtualNode": {
+			args: args{
+				appmesh: &fake.MockRouteClient{
+					MockListTagsForResource: noTags,
+					MockDescribeRoute:       describe(awsappmesh.RouteStatusCodeActive),
+					MockUpdateRoute: func(input *awsappmesh.UpdateRouteInput) awsappmesh.UpdateRouteRequest {
+						if diff := cmp.Diff("other-node", aws.StringValue(input.Spec.TcpRoute.Action.WeightedTargets[0].VirtualNode)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.UpdateRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.UpdateRouteOutput{}},
+						}
+					},
+				},
+				cr: route(withVirtualNode("other-node"), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: route(withVirtualNode("other-node"), withStatus(v1alpha1.StatusActive)),
+			},
+		},
+		"AddTags": {
+			args: args{
+				appmesh: &fake.MockRouteClient{
+					MockListTagsForResource: noTags,
+					MockDescribeRoute:       describe(awsappmesh.RouteStatusCodeActive),
+					MockTagResource: func(input *awsappmesh.TagResourceInput) awsappmesh.TagResourceRequest {
+						if diff := cmp.Diff([]awsappmesh.TagRef{{Key: aws.String("k"), Value: aws.String("v")}}, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.TagResourceOutput{}},
+						}
+					},
+				},
+				cr: route(withVirtualNode(nodeName), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: route(withVirtualNode(nodeName), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockRouteClient{
+					MockListTagsForResource: noTags,
+					MockDescribeRoute:       describe(awsappmesh.RouteStatusCodeActive),
+					MockUpdateRoute: func(*awsappmesh.UpdateRouteInput) awsappmesh.UpdateRouteRequest {
+						return awsappmesh.UpdateRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: route(withVirtualNode("other-node"), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr:  route(withVirtualNode("other-node"), withStatus(v1alpha1.StatusActive)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				appmesh: &fake.MockRouteClient{
+					MockDeleteRoute: func(*awsappmesh.DeleteRouteInput) awsappmesh.DeleteRouteRequest {
+						return awsappmesh.DeleteRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.DeleteRouteOutput{}},
+						}
+					},
+				},
+				cr: route(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: route(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				appmesh: &fake.MockRouteClient{},
+				cr:      route(withStatus(v1alpha1.StatusDeleted)),
+			},
+			want: want{
+				cr: route(withStatus(v1alpha1.StatusDeleted), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				appmesh: &fake.MockRouteClient{
+					MockDeleteRoute: func(*awsappmesh.DeleteRouteInput) awsappmesh.DeleteRouteRequest {
+						return awsappmesh.DeleteRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsappmesh.ErrCodeNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: route(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: route(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockRouteClient{
+					MockDeleteRoute: func(*awsappmesh.DeleteRouteInput) awsappmesh.DeleteRouteRequest {
+						return awsappmesh.DeleteRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: route(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr:  route(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualnode
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappmesh "github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/appmesh"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an App Mesh VirtualNode resource"
+
+	errDescribe   = "failed to describe the VirtualNode resource"
+	errCreate     = "failed to create the VirtualNode resource"
+	errUpdate     = "failed to update the VirtualNode resource"
+	errDelete     = "failed to delete the VirtualNode resource"
+	errListTags   = "failed to list the tags of the VirtualNode resource"
+	errAddTags    = "failed to add tags to the VirtualNode resource"
+	errRemoveTags = "failed to remove tags from the VirtualNode resource"
+	errSpecUpdate = "cannot update spec of the VirtualNode custom resource"
+)
+
+// SetupVirtualNode adds a controller that reconciles VirtualNodes.
+func SetupVirtualNode(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.VirtualNodeGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.VirtualNode{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.VirtualNodeGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: appmesh.NewVirtualNodeClient}, awsclients.DeletionTierAttachment), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) appmesh.VirtualNodeClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.VirtualNode)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client appmesh.VirtualNodeClient
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.VirtualNode) (*awsappmesh.VirtualNodeData, error) {
+	rsp, err := e.client.DescribeVirtualNodeRequest(&awsappmesh.DescribeVirtualNodeInput{
+		VirtualNodeName: aws.String(meta.GetExternalName(cr)),
+		MeshName:        cr.Spec.ForProvider.MeshName,
+		MeshOwner:       cr.Spec.ForProvider.MeshOwner,
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.VirtualNode, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.VirtualNode)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(appmesh.IsNotFound, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	appmesh.LateInitializeVirtualNode(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = appmesh.GenerateVirtualNodeObservation(*observed)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.StatusActive:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.StatusDeleted:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	tags, err := e.client.ListTagsForResourceRequest(&awsappmesh.ListTagsForResourceInput{ResourceArn: aws.String(cr.Status.AtProvider.ARN)}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := appmesh.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(add) == 0 && len(remove) == 0 && appmesh.IsVirtualNodeUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.VirtualNode)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateVirtualNodeRequest(appmesh.GenerateCreateVirtualNodeInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+// Update updates the tags and the spec of the virtual node.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.VirtualNode)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := aws.String(cr.Status.AtProvider.ARN)
+	tags, err := e.client.ListTagsForResourceRequest(&awsappmesh.ListTagsForResourceInput{ResourceArn: arn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := appmesh.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.UntagResourceRequest(&awsappmesh.UntagResourceInput{ResourceArn: arn, TagKeys: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.TagResourceRequest(&awsappmesh.TagResourceInput{ResourceArn: arn, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if appmesh.IsVirtualNodeUpToDate(cr.Spec.ForProvider, *observed) {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.UpdateVirtualNodeRequest(appmesh.GenerateUpdateVirtualNodeInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.VirtualNode)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.Status == v1alpha1.StatusDeleted {
+		return nil
+	}
+	_, err := e.client.DeleteVirtualNodeRequest(&awsappmesh.DeleteVirtualNodeInput{
+		VirtualNodeName: aws.String(meta.GetExternalName(cr)),
+		MeshName:        cr.Spec.ForProvider.MeshName,
+		MeshOwner:       cr.Spec.ForProvider.MeshOwner,
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(appmesh.IsNotFound, err), errDelete)
+}
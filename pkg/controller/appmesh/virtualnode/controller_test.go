@@ -0,0 +1,497 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualnode
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsappmesh "github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/appmesh"
+	"github.com/crossplane/provider-aws/pkg/clients/appmesh/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	meshName = "some-mesh"
+	nodeName = "some-node"
+	nodeARN  = "arn:aws:appmesh:us-east-1:123456789012:mesh/some-mesh/virtualNode/some-node"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	appmesh appmesh.VirtualNodeClient
+	kube    *test.MockClient
+	cr      resource.Managed
+}
+
+type nodeModifier func(*v1alpha1.VirtualNode)
+
+func withConditions(c ...runtimev1alpha1.Condition) nodeModifier {
+	return func(r *v1alpha1.VirtualNode) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s string) nodeModifier {
+	return func(r *v1alpha1.VirtualNode) {
+		r.Status.AtProvider = v1alpha1.VirtualNodeObservation{ARN: nodeARN, Status: s}
+	}
+}
+
+func withListener(port int64) nodeModifier {
+	return func(r *v1alpha1.VirtualNode) {
+		r.Spec.ForProvider.Listeners = []v1alpha1.Listener{{
+			PortMapping: v1alpha1.PortMapping{Port: port, Protocol: "http"},
+			HealthCheck: &v1alpha1.HealthCheckPolicy{
+				HealthyThreshold:   2,
+				UnhealthyThreshold: 2,
+				IntervalMillis:     5000,
+				TimeoutMillis:      2000,
+				Protocol:           "http",
+			},
+		}}
+	}
+}
+
+func withLateInit() nodeModifier {
+	return func(r *v1alpha1.VirtualNode) { r.Spec.ForProvider.Listeners[0].HealthCheck.Port = aws.Int64(8080) }
+}
+
+func withTags(tags ...v1alpha1.Tag) nodeModifier {
+	return func(r *v1alpha1.VirtualNode) { r.Spec.ForProvider.Tags = tags }
+}
+
+func node(m ...nodeModifier) *v1alpha1.VirtualNode {
+	cr := &v1alpha1.VirtualNode{
+		Spec: v1alpha1.VirtualNodeSpec{
+			ForProvider: v1alpha1.VirtualNodeParameters{MeshName: aws.String(meshName)},
+		},
+	}
+	meta.SetExternalName(cr, nodeName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(status awsappmesh.VirtualNodeStatusCode) func(*awsappmesh.DescribeVirtualNodeInput) awsappmesh.DescribeVirtualNodeRequest {
+	return func(*awsappmesh.DescribeVirtualNodeInput) awsappmesh.DescribeVirtualNodeRequest {
+		return awsappmesh.DescribeVirtualNodeRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.DescribeVirtualNodeOutput{
+				VirtualNode: &awsappmesh.VirtualNodeData{
+					MeshName:        aws.String(meshName),
+					VirtualNodeName: aws.String(nodeName),
+					Metadata:        &awsappmesh.ResourceMetadata{Arn: aws.String(nodeARN)},
+					Spec: &awsappmesh.VirtualNodeSpec{Listeners: []awsappmesh.Listener{{
+						PortMapping: &awsappmesh.PortMapping{Port: aws.Int64(8080), Protocol: awsappmesh.PortProtocolHttp},
+						HealthCheck: &awsappmesh.HealthCheckPolicy{
+							HealthyThreshold:   aws.Int64(2),
+							UnhealthyThreshold: aws.Int64(2),
+							IntervalMillis:     aws.Int64(5000),
+							TimeoutMillis:      aws.Int64(2000),
+							Protocol:           awsappmesh.PortProtocolHttp,
+							Port:               aws.Int64(8080),
+						},
+					}}},
+					Status: &awsappmesh.VirtualNodeStatus{Status: status},
+				},
+			}},
+		}
+	}
+}
+
+func noTags(*awsappmesh.ListTagsForResourceInput) awsappmesh.ListTagsForResourceRequest {
+	return awsappmesh.ListTagsForResourceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.ListTagsForResourceOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{
+					MockDescribeVirtualNode: describe(awsappmesh.VirtualNodeStatusCodeActive),
+					MockListTagsForResource: noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   node(withListener(8080)),
+			},
+			want: want{
+				cr: node(withListener(8080), withLateInit(), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ListenerPortChanged": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{
+					MockDescribeVirtualNode: describe(awsappmesh.VirtualNodeStatusCodeActive),
+					MockListTagsForResource: noTags,
+				},
+				cr: node(withListener(9090)),
+			},
+			want: want{
+				cr: node(withListener(9090), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{
+					MockDescribeVirtualNode: describe(awsappmesh.VirtualNodeStatusCodeActive),
+					MockListTagsForResource: noTags,
+				},
+				cr: node(withListener(8080), withLateInit(), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")})),
+			},
+			want: want{
+				cr: node(withListener(8080), withLateInit(), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{
+					MockDescribeVirtualNode: describe(awsappmesh.VirtualNodeStatusCodeDeleted),
+					MockListTagsForResource: noTags,
+				},
+				cr: node(withListener(8080), withLateInit()),
+			},
+			want: want{
+				cr: node(withListener(8080), withLateInit(), withStatus(v1alpha1.StatusDeleted), withConditions(runtimev1alpha1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{
+					MockDescribeVirtualNode: func(*awsappmesh.DescribeVirtualNodeInput) awsappmesh.DescribeVirtualNodeRequest {
+						return awsappmesh.DescribeVirtualNodeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsappmesh.ErrCodeNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: node(),
+			},
+			want: want{
+				cr: node(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{
+					MockDescribeVirtualNode: func(*awsappmesh.DescribeVirtualNodeInput) awsappmesh.DescribeVirtualNodeRequest {
+						return awsappmesh.DescribeVirtualNodeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: node(),
+			},
+			want: want{
+				cr:  node(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{
+					MockCreateVirtualNode: func(input *awsappmesh.CreateVirtualNodeInput) awsappmesh.CreateVirtualNodeRequest {
+						if diff := cmp.Diff(meshName, aws.StringValue(input.MeshName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.CreateVirtualNodeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.CreateVirtualNodeOutput{}},
+						}
+					},
+				},
+				cr: node(),
+			},
+			want: want{
+				cr: node(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{
+					MockCreateVirtualNode: func(*awsappmesh.CreateVirtualNodeInput) awsappmesh.CreateVirtualNodeRequest {
+						return awsappmesh.CreateVirtualNodeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: node(),
+			},
+			want: want{
+				cr:  node(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ChangeListenerPort": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{
+					MockListTagsForResource: noTags,
+					MockDescribeVirtualNode: describe(awsappmesh.VirtualNodeStatusCodeActive),
+					MockUpdateVirtualNode: func(input *awsappmesh.UpdateVirtualNodeInput) awsappmesh.UpdateVirtualNodeRequest {
+						if diff := cmp.Diff(int64(9090), aws.Int64Value(input.Spec.Listeners[0].PortMapping.Port)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.UpdateVirtualNodeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.UpdateVirtualNodeOutput{}},
+						}
+					},
+				},
+				cr: node(withListener(9090), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: node(withListener(9090), withStatus(v1alpha1.StatusActive)),
+			},
+		},
+		"AddTags": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{
+					MockListTagsForResource: noTags,
+					MockDescribeVirtualNode: describe(awsappmesh.VirtualNodeStatusCodeActive),
+					MockTagResource: func(input *awsappmesh.TagResourceInput) awsappmesh.TagResourceRequest {
+						if diff := cmp.Diff([]awsappmesh.TagRef{{Key: aws.String("k"), Value: aws.String("v")}}, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.TagResourceOutput{}},
+						}
+					},
+				},
+				cr: node(withListener(8080), withLateInit(), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: node(withListener(8080), withLateInit(), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{
+					MockListTagsForResource: noTags,
+					MockDescribeVirtualNode: describe(awsappmesh.VirtualNodeStatusCodeActive),
+					MockUpdateVirtualNode: func(*awsappmesh.UpdateVirtualNodeInput) awsappmesh.UpdateVirtualNodeRequest {
+						return awsappmesh.UpdateVirtualNodeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: node(withListener(9090), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr:  node(withListener(9090), withStatus(v1alpha1.StatusActive)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{
+					MockDeleteVirtualNode: func(*awsappmesh.DeleteVirtualNodeInput) awsappmesh.DeleteVirtualNodeRequest {
+						return awsappmesh.DeleteVirtualNodeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.DeleteVirtualNodeOutput{}},
+						}
+					},
+				},
+				cr: node(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: node(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{},
+				cr:      node(withStatus(v1alpha1.StatusDeleted)),
+			},
+			want: want{
+				cr: node(withStatus(v1alpha1.StatusDeleted), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{
+					MockDeleteVirtualNode: func(*awsappmesh.DeleteVirtualNodeInput) awsappmesh.DeleteVirtualNodeRequest {
+						return awsappmesh.DeleteVirtualNodeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsappmesh.ErrCodeNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: node(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: node(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockVirtualNodeClient{
+					MockDeleteVirtualNode: func(*awsappmesh.DeleteVirtualNodeInput) awsappmesh.DeleteVirtualNodeRequest {
+						return awsappmesh.DeleteVirtualNodeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: node(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr:  node(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,478 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualrouter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsappmesh "github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/appmesh"
+	"github.com/crossplane/provider-aws/pkg/clients/appmesh/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	meshName   = "some-mesh"
+	port       = int64(8080)
+	routerName = "some-router"
+	routerARN  = "arn:aws:appmesh:us-east-1:123456789012:mesh/some-mesh/virtualRouter/some-router"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	appmesh appmesh.VirtualRouterClient
+	kube    *test.MockClient
+	cr      resource.Managed
+}
+
+type routerModifier func(*v1alpha1.VirtualRouter)
+
+func withConditions(c ...runtimev1alpha1.Condition) routerModifier {
+	return func(r *v1alpha1.VirtualRouter) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s string) routerModifier {
+	return func(r *v1alpha1.VirtualRouter) {
+		r.Status.AtProvider = v1alpha1.VirtualRouterObservation{ARN: routerARN, Status: s}
+	}
+}
+
+func withPort(p int64) routerModifier {
+	return func(r *v1alpha1.VirtualRouter) {
+		r.Spec.ForProvider.Listeners = []v1alpha1.VirtualRouterListener{{
+			PortMapping: v1alpha1.PortMapping{Port: p, Protocol: "http"},
+		}}
+	}
+}
+
+func withTags(tags ...v1alpha1.Tag) routerModifier {
+	return func(r *v1alpha1.VirtualRouter) { r.Spec.ForProvider.Tags = tags }
+}
+
+func router(m ...routerModifier) *v1alpha1.VirtualRouter {
+	cr := &v1alpha1.VirtualRouter{
+		Spec: v1alpha1.VirtualRouterSpec{
+			ForProvider: v1alpha1.VirtualRouterParameters{MeshName: aws.String(meshName)},
+		},
+	}
+	meta.SetExternalName(cr, routerName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(status awsappmesh.VirtualRouterStatusCode) func(*awsappmesh.DescribeVirtualRouterInput) awsappmesh.DescribeVirtualRouterRequest {
+	return func(*awsappmesh.DescribeVirtualRouterInput) awsappmesh.DescribeVirtualRouterRequest {
+		return awsappmesh.DescribeVirtualRouterRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.DescribeVirtualRouterOutput{
+				VirtualRouter: &awsappmesh.VirtualRouterData{
+					MeshName:          aws.String(meshName),
+					VirtualRouterName: aws.String(routerName),
+					Metadata:          &awsappmesh.ResourceMetadata{Arn: aws.String(routerARN)},
+					Spec: &awsappmesh.VirtualRouterSpec{Listeners: []awsappmesh.VirtualRouterListener{{
+						PortMapping: &awsappmesh.PortMapping{Port: aws.Int64(port), Protocol: awsappmesh.PortProtocolHttp},
+					}}},
+					Status: &awsappmesh.VirtualRouterStatus{Status: status},
+				},
+			}},
+		}
+	}
+}
+
+func noTags(*awsappmesh.ListTagsForResourceInput) awsappmesh.ListTagsForResourceRequest {
+	return awsappmesh.ListTagsForResourceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.ListTagsForResourceOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{
+					MockDescribeVirtualRouter: describe(awsappmesh.VirtualRouterStatusCodeActive),
+					MockListTagsForResource:   noTags,
+				},
+				cr: router(withPort(port)),
+			},
+			want: want{
+				cr: router(withPort(port), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PortChanged": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{
+					MockDescribeVirtualRouter: describe(awsappmesh.VirtualRouterStatusCodeActive),
+					MockListTagsForResource:   noTags,
+				},
+				cr: router(withPort(9090)),
+			},
+			want: want{
+				cr: router(withPort(9090), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{
+					MockDescribeVirtualRouter: describe(awsappmesh.VirtualRouterStatusCodeActive),
+					MockListTagsForResource:   noTags,
+				},
+				cr: router(withPort(port), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")})),
+			},
+			want: want{
+				cr: router(withPort(port), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{
+					MockDescribeVirtualRouter: describe(awsappmesh.VirtualRouterStatusCodeDeleted),
+					MockListTagsForResource:   noTags,
+				},
+				cr: router(withPort(port)),
+			},
+			want: want{
+				cr: router(withPort(port), withStatus(v1alpha1.StatusDeleted), withConditions(runtimev1alpha1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{
+					MockDescribeVirtualRouter: func(*awsappmesh.DescribeVirtualRouterInput) awsappmesh.DescribeVirtualRouterRequest {
+						return awsappmesh.DescribeVirtualRouterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsappmesh.ErrCodeNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: router(),
+			},
+			want: want{
+				cr: router(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{
+					MockDescribeVirtualRouter: func(*awsappmesh.DescribeVirtualRouterInput) awsappmesh.DescribeVirtualRouterRequest {
+						return awsappmesh.DescribeVirtualRouterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: router(),
+			},
+			want: want{
+				cr:  router(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{
+					MockCreateVirtualRouter: func(input *awsappmesh.CreateVirtualRouterInput) awsappmesh.CreateVirtualRouterRequest {
+						if diff := cmp.Diff(meshName, aws.StringValue(input.MeshName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.CreateVirtualRouterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.CreateVirtualRouterOutput{}},
+						}
+					},
+				},
+				cr: router(),
+			},
+			want: want{
+				cr: router(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{
+					MockCreateVirtualRouter: func(*awsappmesh.CreateVirtualRouterInput) awsappmesh.CreateVirtualRouterRequest {
+						return awsappmesh.CreateVirtualRouterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: router(),
+			},
+			want: want{
+				cr:  router(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ChangePort": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{
+					MockListTagsForResource:   noTags,
+					MockDescribeVirtualRouter: describe(awsappmesh.VirtualRouterStatusCodeActive),
+					MockUpdateVirtualRouter: func(input *awsappmesh.UpdateVirtualRouterInput) awsappmesh.UpdateVirtualRouterRequest {
+						if diff := cmp.Diff(int64(9090), aws.Int64Value(input.Spec.Listeners[0].PortMapping.Port)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.UpdateVirtualRouterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.UpdateVirtualRouterOutput{}},
+						}
+					},
+				},
+				cr: router(withPort(9090), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: router(withPort(9090), withStatus(v1alpha1.StatusActive)),
+			},
+		},
+		"AddTags": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{
+					MockListTagsForResource:   noTags,
+					MockDescribeVirtualRouter: describe(awsappmesh.VirtualRouterStatusCodeActive),
+					MockTagResource: func(input *awsappmesh.TagResourceInput) awsappmesh.TagResourceRequest {
+						if diff := cmp.Diff([]awsappmesh.TagRef{{Key: aws.String("k"), Value: aws.String("v")}}, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.TagResourceOutput{}},
+						}
+					},
+				},
+				cr: router(withPort(port), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: router(withPort(port), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{
+					MockListTagsForResource:   noTags,
+					MockDescribeVirtualRouter: describe(awsappmesh.VirtualRouterStatusCodeActive),
+					MockUpdateVirtualRouter: func(*awsappmesh.UpdateVirtualRouterInput) awsappmesh.UpdateVirtualRouterRequest {
+						return awsappmesh.UpdateVirtualRouterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: router(withPort(9090), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr:  router(withPort(9090), withStatus(v1alpha1.StatusActive)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{
+					MockDeleteVirtualRouter: func(*awsappmesh.DeleteVirtualRouterInput) awsappmesh.DeleteVirtualRouterRequest {
+						return awsappmesh.DeleteVirtualRouterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.DeleteVirtualRouterOutput{}},
+						}
+					},
+				},
+				cr: router(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: router(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{},
+				cr:      router(withStatus(v1alpha1.StatusDeleted)),
+			},
+			want: want{
+				cr: router(withStatus(v1alpha1.StatusDeleted), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{
+					MockDeleteVirtualRouter: func(*awsappmesh.DeleteVirtualRouterInput) awsappmesh.DeleteVirtualRouterRequest {
+						return awsappmesh.DeleteVirtualRouterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsappmesh.ErrCodeNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: router(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: router(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockVirtualRouterClient{
+					MockDeleteVirtualRouter: func(*awsappmesh.DeleteVirtualRouterInput) awsappmesh.DeleteVirtualRouterRequest {
+						return awsappmesh.DeleteVirtualRouterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: router(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr:  router(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,478 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualservice
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsappmesh "github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/appmesh"
+	"github.com/crossplane/provider-aws/pkg/clients/appmesh/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	meshName    = "some-mesh"
+	nodeName    = "some-node"
+	serviceName = "app.local"
+	serviceARN  = "arn:aws:appmesh:us-east-1:123456789012:mesh/some-mesh/virtualService/app.local"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	appmesh appmesh.VirtualServiceClient
+	kube    *test.MockClient
+	cr      resource.Managed
+}
+
+type serviceModifier func(*v1alpha1.VirtualService)
+
+func withConditions(c ...runtimev1alpha1.Condition) serviceModifier {
+	return func(r *v1alpha1.VirtualService) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s string) serviceModifier {
+	return func(r *v1alpha1.VirtualService) {
+		r.Status.AtProvider = v1alpha1.VirtualServiceObservation{ARN: serviceARN, Status: s}
+	}
+}
+
+func withVirtualNode(n string) serviceModifier {
+	return func(r *v1alpha1.VirtualService) {
+		r.Spec.ForProvider.Provider = &v1alpha1.VirtualServiceProvider{
+			VirtualNode: &v1alpha1.VirtualNodeServiceProvider{VirtualNodeName: aws.String(n)},
+		}
+	}
+}
+
+func withTags(tags ...v1alpha1.Tag) serviceModifier {
+	return func(r *v1alpha1.VirtualService) { r.Spec.ForProvider.Tags = tags }
+}
+
+func service(m ...serviceModifier) *v1alpha1.VirtualService {
+	cr := &v1alpha1.VirtualService{
+		Spec: v1alpha1.VirtualServiceSpec{
+			ForProvider: v1alpha1.VirtualServiceParameters{MeshName: aws.String(meshName)},
+		},
+	}
+	meta.SetExternalName(cr, serviceName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(status awsappmesh.VirtualServiceStatusCode) func(*awsappmesh.DescribeVirtualServiceInput) awsappmesh.DescribeVirtualServiceRequest {
+	return func(*awsappmesh.DescribeVirtualServiceInput) awsappmesh.DescribeVirtualServiceRequest {
+		return awsappmesh.DescribeVirtualServiceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.DescribeVirtualServiceOutput{
+				VirtualService: &awsappmesh.VirtualServiceData{
+					MeshName:           aws.String(meshName),
+					VirtualServiceName: aws.String(serviceName),
+					Metadata:           &awsappmesh.ResourceMetadata{Arn: aws.String(serviceARN)},
+					Spec: &awsappmesh.VirtualServiceSpec{Provider: &awsappmesh.VirtualServiceProvider{
+						VirtualNode: &awsappmesh.VirtualNodeServiceProvider{VirtualNodeName: aws.String(nodeName)},
+					}},
+					Status: &awsappmesh.VirtualServiceStatus{Status: status},
+				},
+			}},
+		}
+	}
+}
+
+func noTags(*awsappmesh.ListTagsForResourceInput) awsappmesh.ListTagsForResourceRequest {
+	return awsappmesh.ListTagsForResourceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.ListTagsForResourceOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{
+					MockDescribeVirtualService: describe(awsappmesh.VirtualServiceStatusCodeActive),
+					MockListTagsForResource:    noTags,
+				},
+				cr: service(withVirtualNode(nodeName)),
+			},
+			want: want{
+				cr: service(withVirtualNode(nodeName), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"VirtualNodeChanged": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{
+					MockDescribeVirtualService: describe(awsappmesh.VirtualServiceStatusCodeActive),
+					MockListTagsForResource:    noTags,
+				},
+				cr: service(withVirtualNode("other-node")),
+			},
+			want: want{
+				cr: service(withVirtualNode("other-node"), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{
+					MockDescribeVirtualService: describe(awsappmesh.VirtualServiceStatusCodeActive),
+					MockListTagsForResource:    noTags,
+				},
+				cr: service(withVirtualNode(nodeName), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")})),
+			},
+			want: want{
+				cr: service(withVirtualNode(nodeName), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{
+					MockDescribeVirtualService: describe(awsappmesh.VirtualServiceStatusCodeDeleted),
+					MockListTagsForResource:    noTags,
+				},
+				cr: service(withVirtualNode(nodeName)),
+			},
+			want: want{
+				cr: service(withVirtualNode(nodeName), withStatus(v1alpha1.StatusDeleted), withConditions(runtimev1alpha1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{
+					MockDescribeVirtualService: func(*awsappmesh.DescribeVirtualServiceInput) awsappmesh.DescribeVirtualServiceRequest {
+						return awsappmesh.DescribeVirtualServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsappmesh.ErrCodeNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: service(),
+			},
+			want: want{
+				cr: service(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{
+					MockDescribeVirtualService: func(*awsappmesh.DescribeVirtualServiceInput) awsappmesh.DescribeVirtualServiceRequest {
+						return awsappmesh.DescribeVirtualServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: service(),
+			},
+			want: want{
+				cr:  service(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{
+					MockCreateVirtualService: func(input *awsappmesh.CreateVirtualServiceInput) awsappmesh.CreateVirtualServiceRequest {
+						if diff := cmp.Diff(meshName, aws.StringValue(input.MeshName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.CreateVirtualServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.CreateVirtualServiceOutput{}},
+						}
+					},
+				},
+				cr: service(),
+			},
+			want: want{
+				cr: service(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{
+					MockCreateVirtualService: func(*awsappmesh.CreateVirtualServiceInput) awsappmesh.CreateVirtualServiceRequest {
+						return awsappmesh.CreateVirtualServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: service(),
+			},
+			want: want{
+				cr:  service(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ChangeVirtualNode": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{
+					MockListTagsForResource:    noTags,
+					MockDescribeVirtualService: describe(awsappmesh.VirtualServiceStatusCodeActive),
+					MockUpdateVirtualService: func(input *awsappmesh.UpdateVirtualServiceInput) awsappmesh.UpdateVirtualServiceRequest {
+						if diff := cmp.Diff("other-node", aws.StringValue(input.Spec.Provider.VirtualNode.VirtualNodeName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.UpdateVirtualServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.UpdateVirtualServiceOutput{}},
+						}
+					},
+				},
+				cr: service(withVirtualNode("other-node"), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: service(withVirtualNode("other-node"), withStatus(v1alpha1.StatusActive)),
+			},
+		},
+		"AddTags": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{
+					MockListTagsForResource:    noTags,
+					MockDescribeVirtualService: describe(awsappmesh.VirtualServiceStatusCodeActive),
+					MockTagResource: func(input *awsappmesh.TagResourceInput) awsappmesh.TagResourceRequest {
+						if diff := cmp.Diff([]awsappmesh.TagRef{{Key: aws.String("k"), Value: aws.String("v")}}, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappmesh.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.TagResourceOutput{}},
+						}
+					},
+				},
+				cr: service(withVirtualNode(nodeName), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: service(withVirtualNode(nodeName), withTags(v1alpha1.Tag{Key: "k", Value: aws.String("v")}), withStatus(v1alpha1.StatusActive)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{
+					MockListTagsForResource:    noTags,
+					MockDescribeVirtualService: describe(awsappmesh.VirtualServiceStatusCodeActive),
+					MockUpdateVirtualService: func(*awsappmesh.UpdateVirtualServiceInput) awsappmesh.UpdateVirtualServiceRequest {
+						return awsappmesh.UpdateVirtualServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: service(withVirtualNode("other-node"), withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr:  service(withVirtualNode("other-node"), withStatus(v1alpha1.StatusActive)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{
+					MockDeleteVirtualService: func(*awsappmesh.DeleteVirtualServiceInput) awsappmesh.DeleteVirtualServiceRequest {
+						return awsappmesh.DeleteVirtualServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappmesh.DeleteVirtualServiceOutput{}},
+						}
+					},
+				},
+				cr: service(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: service(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{},
+				cr:      service(withStatus(v1alpha1.StatusDeleted)),
+			},
+			want: want{
+				cr: service(withStatus(v1alpha1.StatusDeleted), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{
+					MockDeleteVirtualService: func(*awsappmesh.DeleteVirtualServiceInput) awsappmesh.DeleteVirtualServiceRequest {
+						return awsappmesh.DeleteVirtualServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsappmesh.ErrCodeNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: service(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr: service(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				appmesh: &fake.MockVirtualServiceClient{
+					MockDeleteVirtualService: func(*awsappmesh.DeleteVirtualServiceInput) awsappmesh.DeleteVirtualServiceRequest {
+						return awsappmesh.DeleteVirtualServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: service(withStatus(v1alpha1.StatusActive)),
+			},
+			want: want{
+				cr:  service(withStatus(v1alpha1.StatusActive), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.appmesh, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
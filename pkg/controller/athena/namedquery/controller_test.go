@@ -0,0 +1,314 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namedquery
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsathena "github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/athena/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/athena"
+	"github.com/crossplane/provider-aws/pkg/clients/athena/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	queryID = "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	athena athena.NamedQueryClient
+	kube   *test.MockClient
+	cr     resource.Managed
+}
+
+type queryModifier func(*v1alpha1.NamedQuery)
+
+func withConditions(c ...runtimev1alpha1.Condition) queryModifier {
+	return func(r *v1alpha1.NamedQuery) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) queryModifier {
+	return func(r *v1alpha1.NamedQuery) { meta.SetExternalName(r, n) }
+}
+
+func namedQuery(m ...queryModifier) *v1alpha1.NamedQuery {
+	cr := &v1alpha1.NamedQuery{
+		Spec: v1alpha1.NamedQuerySpec{
+			ForProvider: v1alpha1.NamedQueryParameters{
+				Name:        "daily-events",
+				Database:    "events",
+				QueryString: "SELECT * FROM events LIMIT 10",
+				WorkGroup:   aws.String("analytics"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				athena: &fake.MockNamedQueryClient{
+					MockGetNamedQuery: func(in *awsathena.GetNamedQueryInput) awsathena.GetNamedQueryRequest {
+						if diff := cmp.Diff(queryID, aws.StringValue(in.NamedQueryId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsathena.GetNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsathena.GetNamedQueryOutput{
+								NamedQuery: &awsathena.NamedQuery{NamedQueryId: aws.String(queryID)},
+							}},
+						}
+					},
+				},
+				cr: namedQuery(withExternalName(queryID)),
+			},
+			want: want{
+				cr:     namedQuery(withExternalName(queryID), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: namedQuery(),
+			},
+			want: want{
+				cr: namedQuery(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				athena: &fake.MockNamedQueryClient{
+					MockGetNamedQuery: func(*awsathena.GetNamedQueryInput) awsathena.GetNamedQueryRequest {
+						return awsathena.GetNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsathena.ErrCodeInvalidRequestException, "NamedQuery is not found.", nil)},
+						}
+					},
+				},
+				cr: namedQuery(withExternalName(queryID)),
+			},
+			want: want{
+				cr: namedQuery(withExternalName(queryID)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				athena: &fake.MockNamedQueryClient{
+					MockGetNamedQuery: func(*awsathena.GetNamedQueryInput) awsathena.GetNamedQueryRequest {
+						return awsathena.GetNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: namedQuery(withExternalName(queryID)),
+			},
+			want: want{
+				cr:  namedQuery(withExternalName(queryID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.athena}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				athena: &fake.MockNamedQueryClient{
+					MockCreateNamedQuery: func(in *awsathena.CreateNamedQueryInput) awsathena.CreateNamedQueryRequest {
+						if diff := cmp.Diff("analytics", aws.StringValue(in.WorkGroup)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsathena.CreateNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsathena.CreateNamedQueryOutput{NamedQueryId: aws.String(queryID)}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   namedQuery(),
+			},
+			want: want{
+				cr: namedQuery(withExternalName(queryID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"SpecUpdateFailed": {
+			args: args{
+				athena: &fake.MockNamedQueryClient{
+					MockCreateNamedQuery: func(*awsathena.CreateNamedQueryInput) awsathena.CreateNamedQueryRequest {
+						return awsathena.CreateNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsathena.CreateNamedQueryOutput{NamedQueryId: aws.String(queryID)}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   namedQuery(),
+			},
+			want: want{
+				cr:  namedQuery(withExternalName(queryID), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"ClientError": {
+			args: args{
+				athena: &fake.MockNamedQueryClient{
+					MockCreateNamedQuery: func(*awsathena.CreateNamedQueryInput) awsathena.CreateNamedQueryRequest {
+						return awsathena.CreateNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: namedQuery(),
+			},
+			want: want{
+				cr:  namedQuery(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.athena}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				athena: &fake.MockNamedQueryClient{
+					MockDeleteNamedQuery: func(*awsathena.DeleteNamedQueryInput) awsathena.DeleteNamedQueryRequest {
+						return awsathena.DeleteNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsathena.DeleteNamedQueryOutput{}},
+						}
+					},
+				},
+				cr: namedQuery(withExternalName(queryID)),
+			},
+			want: want{
+				cr: namedQuery(withExternalName(queryID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				athena: &fake.MockNamedQueryClient{
+					MockDeleteNamedQuery: func(*awsathena.DeleteNamedQueryInput) awsathena.DeleteNamedQueryRequest {
+						return awsathena.DeleteNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: namedQuery(withExternalName(queryID)),
+			},
+			want: want{
+				cr:  namedQuery(withExternalName(queryID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.athena}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
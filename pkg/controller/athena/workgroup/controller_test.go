@@ -0,0 +1,391 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsathena "github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/athena/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/athena"
+	"github.com/crossplane/provider-aws/pkg/clients/athena/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	workGroupName  = "analytics"
+	outputLocation = "s3://example-bucket/"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	athena athena.WorkGroupClient
+	kube   *test.MockClient
+	cr     resource.Managed
+}
+
+type workGroupModifier func(*v1alpha1.WorkGroup)
+
+func withConditions(c ...runtimev1alpha1.Condition) workGroupModifier {
+	return func(r *v1alpha1.WorkGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withState(s string) workGroupModifier {
+	return func(r *v1alpha1.WorkGroup) { r.Status.AtProvider.State = s }
+}
+
+func withOutputLocation(l string) workGroupModifier {
+	return func(r *v1alpha1.WorkGroup) {
+		r.Spec.ForProvider.Configuration.ResultConfiguration = &v1alpha1.ResultConfiguration{OutputLocation: aws.String(l)}
+	}
+}
+
+func workGroup(m ...workGroupModifier) *v1alpha1.WorkGroup {
+	cr := &v1alpha1.WorkGroup{
+		Spec: v1alpha1.WorkGroupSpec{
+			ForProvider: v1alpha1.WorkGroupParameters{
+				State: aws.String("ENABLED"),
+				Configuration: &v1alpha1.WorkGroupConfiguration{
+					EnforceWorkGroupConfiguration: aws.Bool(true),
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, workGroupName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getWorkGroup(*awsathena.GetWorkGroupInput) awsathena.GetWorkGroupRequest {
+	return awsathena.GetWorkGroupRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsathena.GetWorkGroupOutput{
+			WorkGroup: &awsathena.WorkGroup{
+				Name:  aws.String(workGroupName),
+				State: awsathena.WorkGroupStateEnabled,
+				Configuration: &awsathena.WorkGroupConfiguration{
+					EnforceWorkGroupConfiguration: aws.Bool(true),
+				},
+			},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				athena: &fake.MockWorkGroupClient{MockGetWorkGroup: getWorkGroup},
+				cr:     workGroup(),
+			},
+			want: want{
+				cr:     workGroup(withState("ENABLED"), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"OutputLocationChanged": {
+			args: args{
+				athena: &fake.MockWorkGroupClient{MockGetWorkGroup: getWorkGroup},
+				cr:     workGroup(withOutputLocation(outputLocation)),
+			},
+			want: want{
+				cr:     workGroup(withOutputLocation(outputLocation), withState("ENABLED"), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				athena: &fake.MockWorkGroupClient{MockGetWorkGroup: getWorkGroup},
+				kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr: workGroup(func(r *v1alpha1.WorkGroup) {
+					r.Spec.ForProvider.State = nil
+				}),
+			},
+			want: want{
+				cr:  workGroup(),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"NotFound": {
+			args: args{
+				athena: &fake.MockWorkGroupClient{
+					MockGetWorkGroup: func(*awsathena.GetWorkGroupInput) awsathena.GetWorkGroupRequest {
+						return awsathena.GetWorkGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsathena.ErrCodeInvalidRequestException, "WorkGroup analytics is not found.", nil)},
+						}
+					},
+				},
+				cr: workGroup(),
+			},
+			want: want{
+				cr: workGroup(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				athena: &fake.MockWorkGroupClient{
+					MockGetWorkGroup: func(*awsathena.GetWorkGroupInput) awsathena.GetWorkGroupRequest {
+						return awsathena.GetWorkGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: workGroup(),
+			},
+			want: want{
+				cr:  workGroup(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.athena}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				athena: &fake.MockWorkGroupClient{
+					MockCreateWorkGroup: func(in *awsathena.CreateWorkGroupInput) awsathena.CreateWorkGroupRequest {
+						if diff := cmp.Diff(workGroupName, aws.StringValue(in.Name)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsathena.CreateWorkGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsathena.CreateWorkGroupOutput{}},
+						}
+					},
+				},
+				cr: workGroup(),
+			},
+			want: want{
+				cr: workGroup(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				athena: &fake.MockWorkGroupClient{
+					MockCreateWorkGroup: func(*awsathena.CreateWorkGroupInput) awsathena.CreateWorkGroupRequest {
+						return awsathena.CreateWorkGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: workGroup(),
+			},
+			want: want{
+				cr:  workGroup(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.athena}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				athena: &fake.MockWorkGroupClient{
+					MockGetWorkGroup: getWorkGroup,
+					MockUpdateWorkGroup: func(in *awsathena.UpdateWorkGroupInput) awsathena.UpdateWorkGroupRequest {
+						if diff := cmp.Diff(outputLocation, aws.StringValue(in.ConfigurationUpdates.ResultConfigurationUpdates.OutputLocation)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsathena.UpdateWorkGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsathena.UpdateWorkGroupOutput{}},
+						}
+					},
+				},
+				cr: workGroup(withOutputLocation(outputLocation)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				athena: &fake.MockWorkGroupClient{
+					MockGetWorkGroup: getWorkGroup,
+					MockUpdateWorkGroup: func(*awsathena.UpdateWorkGroupInput) awsathena.UpdateWorkGroupRequest {
+						return awsathena.UpdateWorkGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: workGroup(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.athena}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				athena: &fake.MockWorkGroupClient{
+					MockDeleteWorkGroup: func(*awsathena.DeleteWorkGroupInput) awsathena.DeleteWorkGroupRequest {
+						return awsathena.DeleteWorkGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsathena.DeleteWorkGroupOutput{}},
+						}
+					},
+				},
+				cr: workGroup(),
+			},
+			want: want{
+				cr: workGroup(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				athena: &fake.MockWorkGroupClient{
+					MockDeleteWorkGroup: func(*awsathena.DeleteWorkGroupInput) awsathena.DeleteWorkGroupRequest {
+						return awsathena.DeleteWorkGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsathena.ErrCodeInvalidRequestException, "WorkGroup analytics is not found.", nil)},
+						}
+					},
+				},
+				cr: workGroup(),
+			},
+			want: want{
+				cr: workGroup(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				athena: &fake.MockWorkGroupClient{
+					MockDeleteWorkGroup: func(*awsathena.DeleteWorkGroupInput) awsathena.DeleteWorkGroupRequest {
+						return awsathena.DeleteWorkGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: workGroup(),
+			},
+			want: want{
+				cr:  workGroup(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.athena}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscalinggroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsautoscaling "github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/autoscaling"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an AutoScalingGroup resource"
+	errDescribe         = "failed to describe AutoScalingGroup"
+	errMultipleItems    = "retrieved multiple AutoScalingGroups for the given name"
+	errSpecUpdate       = "cannot update spec of the AutoScalingGroup resource"
+	errCreate           = "failed to create the AutoScalingGroup resource"
+	errUpdate           = "failed to update the AutoScalingGroup resource"
+	errDelete           = "failed to delete the AutoScalingGroup resource"
+	errAttach           = "failed to attach target groups to the AutoScalingGroup resource"
+	errDetach           = "failed to detach target groups from the AutoScalingGroup resource"
+	errUpdateTags       = "failed to update tags for the AutoScalingGroup resource"
+	errDeleteTags       = "failed to delete tags for the AutoScalingGroup resource"
+)
+
+// SetupAutoScalingGroup adds a controller that reconciles AutoScalingGroups.
+func SetupAutoScalingGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.AutoScalingGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.AutoScalingGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AutoScalingGroupGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: autoscaling.NewAutoScalingGroupClient}, awscommon.DeletionTierWorkload)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) autoscaling.AutoScalingGroupClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client autoscaling.AutoScalingGroupClient
+}
+
+// describe returns the group with the given name, or nil if there is none.
+func (e *external) describe(ctx context.Context, name string) (*awsautoscaling.AutoScalingGroup, error) {
+	response, err := e.client.DescribeAutoScalingGroupsRequest(&awsautoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{name},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(response.AutoScalingGroups) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &response.AutoScalingGroups[0], nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = autoscaling.GenerateAutoScalingGroupObservation(*observed)
+	if cr.Status.AtProvider.Status == autoscaling.StatusDeleteInProgress {
+		cr.SetConditions(runtimev1alpha1.Deleting())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	autoscaling.LateInitializeAutoScalingGroup(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	attach, detach := autoscaling.DiffTargetGroupARNs(cr.Spec.ForProvider.TargetGroupARNs, observed.TargetGroupARNs)
+	add, remove := autoscaling.DiffTags(meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, observed.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: autoscaling.IsAutoScalingGroupUpToDate(cr.Spec.ForProvider, *observed) &&
+			len(attach)+len(detach)+len(add)+len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	_, err := e.client.CreateAutoScalingGroupRequest(autoscaling.GenerateCreateAutoScalingGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	name := meta.GetExternalName(cr)
+	observed, err := e.describe(ctx, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if !autoscaling.IsAutoScalingGroupUpToDate(cr.Spec.ForProvider, *observed) {
+		if _, err := e.client.UpdateAutoScalingGroupRequest(autoscaling.GenerateUpdateAutoScalingGroupInput(name, cr.Spec.ForProvider)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	attach, detach := autoscaling.DiffTargetGroupARNs(cr.Spec.ForProvider.TargetGroupARNs, observed.TargetGroupARNs)
+	if len(detach) > 0 {
+		if _, err := e.client.DetachLoadBalancerTargetGroupsRequest(&awsautoscaling.DetachLoadBalancerTargetGroupsInput{
+			AutoScalingGroupName: aws.String(name),
+			TargetGroupARNs:      detach,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDetach)
+		}
+	}
+	if len(attach) > 0 {
+		if _, err := e.client.AttachLoadBalancerTargetGroupsRequest(&awsautoscaling.AttachLoadBalancerTargetGroupsInput{
+			AutoScalingGroupName: aws.String(name),
+			TargetGroupARNs:      attach,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAttach)
+		}
+	}
+
+	add, remove := autoscaling.DiffTags(name, cr.Spec.ForProvider.Tags, observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsautoscaling.DeleteTagsInput{Tags: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateOrUpdateTagsRequest(&awsautoscaling.CreateOrUpdateTagsInput{Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.Status == autoscaling.StatusDeleteInProgress {
+		return nil
+	}
+
+	// ForceDelete terminates the instances of the group along with it,
+	// otherwise the deletion fails while the group has any.
+	_, err := e.client.DeleteAutoScalingGroupRequest(&awsautoscaling.DeleteAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(meta.GetExternalName(cr)),
+		ForceDelete:          aws.Bool(true),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(autoscaling.IsAutoScalingGroupNotFoundErr, err), errDelete)
+}
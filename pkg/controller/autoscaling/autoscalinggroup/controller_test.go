@@ -0,0 +1,582 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscalinggroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsautoscaling "github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/autoscaling"
+	"github.com/crossplane/provider-aws/pkg/clients/autoscaling/fake"
+)
+
+var (
+	groupName = "sample-asg"
+	groupARN  = "arn:aws:autoscaling:us-east-1:123456789012:autoScalingGroup:uuid:autoScalingGroupName/sample-asg"
+	ltID      = "lt-0123456789"
+	tgARN     = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/sample/0123456789"
+	errBoom   = errors.New("boom")
+)
+
+type asgModifier func(*v1alpha1.AutoScalingGroup)
+
+func withExternalName(name string) asgModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) asgModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.AutoScalingGroupParameters) asgModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.AutoScalingGroupObservation) asgModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { r.Status.AtProvider = s }
+}
+
+func asg(m ...asgModifier) *v1alpha1.AutoScalingGroup {
+	cr := &v1alpha1.AutoScalingGroup{}
+	meta.SetExternalName(cr, groupName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specParams(maxSize int64) v1alpha1.AutoScalingGroupParameters {
+	return v1alpha1.AutoScalingGroupParameters{
+		MinSize:                1,
+		MaxSize:                maxSize,
+		HealthCheckType:        aws.String("ELB"),
+		HealthCheckGracePeriod: aws.Int64(300),
+		DefaultCooldown:        aws.Int64(300),
+		SubnetIDs:              []string{"subnet-1"},
+		TargetGroupARNs:        []string{tgARN},
+		LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{
+			LaunchTemplateID: aws.String(ltID),
+			Version:          aws.String("$Default"),
+		},
+		Tags: []v1alpha1.Tag{{Key: "key1", Value: aws.String("value1"), PropagateAtLaunch: aws.Bool(true)}},
+	}
+}
+
+func describeOutput(status *string, targetGroups []string, tags []awsautoscaling.TagDescription) *awsautoscaling.DescribeAutoScalingGroupsOutput {
+	return &awsautoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []awsautoscaling.AutoScalingGroup{
+			{
+				AutoScalingGroupARN:    aws.String(groupARN),
+				AutoScalingGroupName:   aws.String(groupName),
+				MinSize:                aws.Int64(1),
+				MaxSize:                aws.Int64(3),
+				DesiredCapacity:        aws.Int64(1),
+				HealthCheckType:        aws.String("ELB"),
+				HealthCheckGracePeriod: aws.Int64(300),
+				DefaultCooldown:        aws.Int64(300),
+				VPCZoneIdentifier:      aws.String("subnet-1"),
+				LaunchTemplate: &awsautoscaling.LaunchTemplateSpecification{
+					LaunchTemplateId: aws.String(ltID),
+					Version:          aws.String("$Default"),
+				},
+				Instances: []awsautoscaling.Instance{
+					{
+						InstanceId:       aws.String("i-0123456789"),
+						AvailabilityZone: aws.String("us-east-1a"),
+						HealthStatus:     aws.String("Healthy"),
+						LifecycleState:   awsautoscaling.LifecycleStateInService,
+					},
+				},
+				Status:          status,
+				TargetGroupARNs: targetGroups,
+				Tags:            tags,
+			},
+		},
+	}
+}
+
+func groupTags() []awsautoscaling.TagDescription {
+	return []awsautoscaling.TagDescription{{Key: aws.String("key1"), Value: aws.String("value1"), PropagateAtLaunch: aws.Bool(true)}}
+}
+
+func observation(status string) v1alpha1.AutoScalingGroupObservation {
+	return v1alpha1.AutoScalingGroupObservation{
+		AutoScalingGroupARN: groupARN,
+		Status:              status,
+		Instances: []v1alpha1.Instance{
+			{
+				InstanceID:       "i-0123456789",
+				AvailabilityZone: "us-east-1a",
+				HealthStatus:     "Healthy",
+				LifecycleState:   string(awsautoscaling.LifecycleStateInService),
+			},
+		},
+	}
+}
+
+func describe(out *awsautoscaling.DescribeAutoScalingGroupsOutput) func(*awsautoscaling.DescribeAutoScalingGroupsInput) awsautoscaling.DescribeAutoScalingGroupsRequest {
+	return func(input *awsautoscaling.DescribeAutoScalingGroupsInput) awsautoscaling.DescribeAutoScalingGroupsRequest {
+		return awsautoscaling.DescribeAutoScalingGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	asg  autoscaling.AutoScalingGroupClient
+	kube client.Client
+	cr   *v1alpha1.AutoScalingGroup
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.AutoScalingGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockDescribeAutoScalingGroups: describe(&awsautoscaling.DescribeAutoScalingGroupsOutput{}),
+				},
+				cr: asg(withSpec(specParams(3))),
+			},
+			want: want{
+				cr: asg(withSpec(specParams(3))),
+			},
+		},
+		"Available": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockDescribeAutoScalingGroups: describe(describeOutput(nil, []string{tgARN}, groupTags())),
+				},
+				cr: asg(withSpec(specParams(3))),
+			},
+			want: want{
+				cr: asg(withSpec(specParams(3)),
+					withStatus(observation("")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SizeChanged": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockDescribeAutoScalingGroups: describe(describeOutput(nil, []string{tgARN}, groupTags())),
+				},
+				cr: asg(withSpec(specParams(5))),
+			},
+			want: want{
+				cr: asg(withSpec(specParams(5)),
+					withStatus(observation("")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TargetGroupDetached": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockDescribeAutoScalingGroups: describe(describeOutput(nil, nil, groupTags())),
+				},
+				cr: asg(withSpec(specParams(3))),
+			},
+			want: want{
+				cr: asg(withSpec(specParams(3)),
+					withStatus(observation("")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DeleteInProgress": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockDescribeAutoScalingGroups: describe(describeOutput(aws.String(autoscaling.StatusDeleteInProgress), []string{tgARN}, groupTags())),
+				},
+				cr: asg(withSpec(specParams(3))),
+			},
+			want: want{
+				cr: asg(withSpec(specParams(3)),
+					withStatus(observation(autoscaling.StatusDeleteInProgress)),
+					withConditions(runtimev1alpha1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockDescribeAutoScalingGroups: describe(describeOutput(nil, []string{tgARN}, groupTags())),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: asg(withSpec(v1alpha1.AutoScalingGroupParameters{MinSize: 1, MaxSize: 3})),
+			},
+			want: want{
+				cr: asg(withSpec(v1alpha1.AutoScalingGroupParameters{
+					MinSize:                1,
+					MaxSize:                3,
+					HealthCheckType:        aws.String("ELB"),
+					HealthCheckGracePeriod: aws.Int64(300),
+					DefaultCooldown:        aws.Int64(300),
+					SubnetIDs:              []string{"subnet-1"},
+					Tags:                   specParams(3).Tags,
+				}), withStatus(observation(""))),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockDescribeAutoScalingGroups: func(input *awsautoscaling.DescribeAutoScalingGroupsInput) awsautoscaling.DescribeAutoScalingGroupsRequest {
+						return awsautoscaling.DescribeAutoScalingGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: asg(),
+			},
+			want: want{
+				cr:  asg(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.asg}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.AutoScalingGroup
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockCreateAutoScalingGroup: func(input *awsautoscaling.CreateAutoScalingGroupInput) awsautoscaling.CreateAutoScalingGroupRequest {
+						if diff := cmp.Diff("subnet-1", aws.StringValue(input.VPCZoneIdentifier)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsautoscaling.CreateAutoScalingGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsautoscaling.CreateAutoScalingGroupOutput{}},
+						}
+					},
+				},
+				cr: asg(withSpec(specParams(3))),
+			},
+			want: want{
+				cr: asg(withSpec(specParams(3)), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockCreateAutoScalingGroup: func(input *awsautoscaling.CreateAutoScalingGroupInput) awsautoscaling.CreateAutoScalingGroupRequest {
+						return awsautoscaling.CreateAutoScalingGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: asg(withSpec(specParams(3))),
+			},
+			want: want{
+				cr:  asg(withSpec(specParams(3)), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.asg}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.AutoScalingGroup
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Updated": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockDescribeAutoScalingGroups: describe(describeOutput(nil, []string{"old"}, nil)),
+					MockUpdateAutoScalingGroup: func(input *awsautoscaling.UpdateAutoScalingGroupInput) awsautoscaling.UpdateAutoScalingGroupRequest {
+						if diff := cmp.Diff(int64(5), aws.Int64Value(input.MaxSize)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsautoscaling.UpdateAutoScalingGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsautoscaling.UpdateAutoScalingGroupOutput{}},
+						}
+					},
+					MockDetachLoadBalancerTargetGroups: func(input *awsautoscaling.DetachLoadBalancerTargetGroupsInput) awsautoscaling.DetachLoadBalancerTargetGroupsRequest {
+						if diff := cmp.Diff([]string{"old"}, input.TargetGroupARNs); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsautoscaling.DetachLoadBalancerTargetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsautoscaling.DetachLoadBalancerTargetGroupsOutput{}},
+						}
+					},
+					MockAttachLoadBalancerTargetGroups: func(input *awsautoscaling.AttachLoadBalancerTargetGroupsInput) awsautoscaling.AttachLoadBalancerTargetGroupsRequest {
+						if diff := cmp.Diff([]string{tgARN}, input.TargetGroupARNs); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsautoscaling.AttachLoadBalancerTargetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsautoscaling.AttachLoadBalancerTargetGroupsOutput{}},
+						}
+					},
+					MockCreateOrUpdateTags: func(input *awsautoscaling.CreateOrUpdateTagsInput) awsautoscaling.CreateOrUpdateTagsRequest {
+						return awsautoscaling.CreateOrUpdateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsautoscaling.CreateOrUpdateTagsOutput{}},
+						}
+					},
+				},
+				cr: asg(withSpec(specParams(5))),
+			},
+			want: want{
+				cr: asg(withSpec(specParams(5))),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockDescribeAutoScalingGroups: describe(describeOutput(nil, []string{tgARN}, groupTags())),
+					MockUpdateAutoScalingGroup: func(input *awsautoscaling.UpdateAutoScalingGroupInput) awsautoscaling.UpdateAutoScalingGroupRequest {
+						return awsautoscaling.UpdateAutoScalingGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: asg(withSpec(specParams(5))),
+			},
+			want: want{
+				cr:  asg(withSpec(specParams(5))),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+		"AttachFailed": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockDescribeAutoScalingGroups: describe(describeOutput(nil, nil, groupTags())),
+					MockAttachLoadBalancerTargetGroups: func(input *awsautoscaling.AttachLoadBalancerTargetGroupsInput) awsautoscaling.AttachLoadBalancerTargetGroupsRequest {
+						return awsautoscaling.AttachLoadBalancerTargetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: asg(withSpec(specParams(3))),
+			},
+			want: want{
+				cr:  asg(withSpec(specParams(3))),
+				err: errors.Wrap(errBoom, errAttach),
+			},
+		},
+		"DeleteTagsFailed": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockDescribeAutoScalingGroups: describe(describeOutput(nil, []string{tgARN}, append(groupTags(), awsautoscaling.TagDescription{Key: aws.String("old")}))),
+					MockDeleteTags: func(input *awsautoscaling.DeleteTagsInput) awsautoscaling.DeleteTagsRequest {
+						return awsautoscaling.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: asg(withSpec(specParams(3))),
+			},
+			want: want{
+				cr:  asg(withSpec(specParams(3))),
+				err: errors.Wrap(errBoom, errDeleteTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.asg}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.AutoScalingGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockDeleteAutoScalingGroup: func(input *awsautoscaling.DeleteAutoScalingGroupInput) awsautoscaling.DeleteAutoScalingGroupRequest {
+						if diff := cmp.Diff(true, aws.BoolValue(input.ForceDelete)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsautoscaling.DeleteAutoScalingGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsautoscaling.DeleteAutoScalingGroupOutput{}},
+						}
+					},
+				},
+				cr: asg(),
+			},
+			want: want{
+				cr: asg(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{},
+				cr:  asg(withStatus(observation(autoscaling.StatusDeleteInProgress))),
+			},
+			want: want{
+				cr: asg(withStatus(observation(autoscaling.StatusDeleteInProgress)),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockDeleteAutoScalingGroup: func(input *awsautoscaling.DeleteAutoScalingGroupInput) awsautoscaling.DeleteAutoScalingGroupRequest {
+						return awsautoscaling.DeleteAutoScalingGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New("ValidationError", "AutoScalingGroup name not found - AutoScalingGroup 'sample-asg' not found", nil)},
+						}
+					},
+				},
+				cr: asg(),
+			},
+			want: want{
+				cr: asg(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				asg: &fake.MockAutoScalingGroupClient{
+					MockDeleteAutoScalingGroup: func(input *awsautoscaling.DeleteAutoScalingGroupInput) awsautoscaling.DeleteAutoScalingGroupRequest {
+						return awsautoscaling.DeleteAutoScalingGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: asg(),
+			},
+			want: want{
+				cr:  asg(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.asg}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
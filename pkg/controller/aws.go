@@ -24,25 +24,105 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/acm"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthority"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthoritypermission"
+	amplifyapp "github.com/crossplane/provider-aws/pkg/controller/amplify/app"
+	amplifybranch "github.com/crossplane/provider-aws/pkg/controller/amplify/branch"
+	"github.com/crossplane/provider-aws/pkg/controller/amplify/domainassociation"
+	"github.com/crossplane/provider-aws/pkg/controller/appmesh/mesh"
+	"github.com/crossplane/provider-aws/pkg/controller/appmesh/route"
+	"github.com/crossplane/provider-aws/pkg/controller/appmesh/virtualnode"
+	"github.com/crossplane/provider-aws/pkg/controller/appmesh/virtualrouter"
+	"github.com/crossplane/provider-aws/pkg/controller/appmesh/virtualservice"
+	"github.com/crossplane/provider-aws/pkg/controller/athena/namedquery"
+	"github.com/crossplane/provider-aws/pkg/controller/athena/workgroup"
+	"github.com/crossplane/provider-aws/pkg/controller/autoscaling/autoscalinggroup"
+	"github.com/crossplane/provider-aws/pkg/controller/backup/backupplan"
+	"github.com/crossplane/provider-aws/pkg/controller/backup/backupselection"
+	"github.com/crossplane/provider-aws/pkg/controller/backup/backupvault"
+	"github.com/crossplane/provider-aws/pkg/controller/batch/computeenvironment"
+	"github.com/crossplane/provider-aws/pkg/controller/batch/jobdefinition"
+	"github.com/crossplane/provider-aws/pkg/controller/batch/jobqueue"
+	"github.com/crossplane/provider-aws/pkg/controller/budgets/budget"
 	"github.com/crossplane/provider-aws/pkg/controller/cache"
+	"github.com/crossplane/provider-aws/pkg/controller/cache/cacheparametergroup"
 	"github.com/crossplane/provider-aws/pkg/controller/cache/cachesubnetgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/cache/cluster"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudtrail/trail"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudwatch/anomalydetector"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudwatch/dashboard"
+	"github.com/crossplane/provider-aws/pkg/controller/codebuild/project"
+	codecommitrepository "github.com/crossplane/provider-aws/pkg/controller/codecommit/repository"
+	"github.com/crossplane/provider-aws/pkg/controller/codepipeline/pipeline"
 	"github.com/crossplane/provider-aws/pkg/controller/config"
+	"github.com/crossplane/provider-aws/pkg/controller/configservice/configrule"
+	"github.com/crossplane/provider-aws/pkg/controller/configservice/configurationrecorder"
+	"github.com/crossplane/provider-aws/pkg/controller/configservice/deliverychannel"
 	"github.com/crossplane/provider-aws/pkg/controller/database"
+	rdsdbcluster "github.com/crossplane/provider-aws/pkg/controller/database/dbcluster"
+	"github.com/crossplane/provider-aws/pkg/controller/database/dbparametergroup"
+	"github.com/crossplane/provider-aws/pkg/controller/database/dbproxy"
+	"github.com/crossplane/provider-aws/pkg/controller/database/dbproxytargetgroup"
+	"github.com/crossplane/provider-aws/pkg/controller/database/dbsnapshot"
 	"github.com/crossplane/provider-aws/pkg/controller/database/dbsubnetgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/database/dynamodb"
+	"github.com/crossplane/provider-aws/pkg/controller/database/optiongroup"
+	"github.com/crossplane/provider-aws/pkg/controller/datasync/locationefs"
+	"github.com/crossplane/provider-aws/pkg/controller/datasync/locationnfs"
+	"github.com/crossplane/provider-aws/pkg/controller/datasync/locations3"
+	"github.com/crossplane/provider-aws/pkg/controller/datasync/task"
+	docdbcluster "github.com/crossplane/provider-aws/pkg/controller/docdb/dbcluster"
+	docdbinstance "github.com/crossplane/provider-aws/pkg/controller/docdb/dbinstance"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/customergateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/dhcpoptions"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/egressonlyinternetgateway"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/elasticip"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/flowlog"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/instance"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/internetgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/launchtemplate"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/natgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/networkacl"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/routetable"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/securitygroup"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/snapshot"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/subnet"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayroutetable"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayvpcattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/volume"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpc"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcendpoint"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcendpointserviceconfiguration"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcpeeringconnection"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpnconnection"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpngateway"
 	"github.com/crossplane/provider-aws/pkg/controller/ecr/repository"
 	"github.com/crossplane/provider-aws/pkg/controller/eks"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/nodegroup"
+	elasticbeanstalkapplication "github.com/crossplane/provider-aws/pkg/controller/elasticbeanstalk/application"
+	"github.com/crossplane/provider-aws/pkg/controller/elasticbeanstalk/applicationversion"
+	elasticbeanstalkenvironment "github.com/crossplane/provider-aws/pkg/controller/elasticbeanstalk/environment"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elb"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elbattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/listener"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/listenerrule"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/loadbalancer"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/targetgroup"
+	emrcluster "github.com/crossplane/provider-aws/pkg/controller/emr/cluster"
+	"github.com/crossplane/provider-aws/pkg/controller/facts/availabilityzones"
+	"github.com/crossplane/provider-aws/pkg/controller/facts/calleridentity"
+	"github.com/crossplane/provider-aws/pkg/controller/facts/regioninfo"
+	"github.com/crossplane/provider-aws/pkg/controller/facts/vpcdefaults"
+	"github.com/crossplane/provider-aws/pkg/controller/fsx/filesystem"
+	gaaccelerator "github.com/crossplane/provider-aws/pkg/controller/globalaccelerator/accelerator"
+	gaendpointgroup "github.com/crossplane/provider-aws/pkg/controller/globalaccelerator/endpointgroup"
+	galistener "github.com/crossplane/provider-aws/pkg/controller/globalaccelerator/listener"
+	"github.com/crossplane/provider-aws/pkg/controller/glue/catalogdatabase"
+	gluecrawler "github.com/crossplane/provider-aws/pkg/controller/glue/crawler"
+	gluejob "github.com/crossplane/provider-aws/pkg/controller/glue/job"
+	"github.com/crossplane/provider-aws/pkg/controller/guardduty/detector"
+	"github.com/crossplane/provider-aws/pkg/controller/guardduty/member"
+	"github.com/crossplane/provider-aws/pkg/controller/guardduty/publishingdestination"
+	"github.com/crossplane/provider-aws/pkg/controller/identity/iamaccesskey"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgrouppolicyattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgroupusermembership"
@@ -51,14 +131,58 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamrolepolicyattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamuser"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamuserpolicyattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/identity/instanceprofile"
+	"github.com/crossplane/provider-aws/pkg/controller/identity/openidconnectprovider"
+	"github.com/crossplane/provider-aws/pkg/controller/identity/samlprovider"
+	"github.com/crossplane/provider-aws/pkg/controller/lakeformation/datalakesettings"
+	lakeformationpermissions "github.com/crossplane/provider-aws/pkg/controller/lakeformation/permissions"
+	lightsaildatabase "github.com/crossplane/provider-aws/pkg/controller/lightsail/database"
+	lightsailinstance "github.com/crossplane/provider-aws/pkg/controller/lightsail/instance"
+	"github.com/crossplane/provider-aws/pkg/controller/lightsail/staticip"
+	macieaccount "github.com/crossplane/provider-aws/pkg/controller/macie2/account"
+	"github.com/crossplane/provider-aws/pkg/controller/macie2/classificationjob"
+	"github.com/crossplane/provider-aws/pkg/controller/mq/broker"
+	"github.com/crossplane/provider-aws/pkg/controller/neptune/dbcluster"
+	"github.com/crossplane/provider-aws/pkg/controller/neptune/dbinstance"
 	"github.com/crossplane/provider-aws/pkg/controller/notification/snssubscription"
 	"github.com/crossplane/provider-aws/pkg/controller/notification/snstopic"
+	"github.com/crossplane/provider-aws/pkg/controller/opensearchservice/domain"
+	orgaccount "github.com/crossplane/provider-aws/pkg/controller/organizations/account"
+	orgunit "github.com/crossplane/provider-aws/pkg/controller/organizations/organizationalunit"
+	orgpolicy "github.com/crossplane/provider-aws/pkg/controller/organizations/policy"
+	orgpolicyattachment "github.com/crossplane/provider-aws/pkg/controller/organizations/policyattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/qldb/ledger"
+	"github.com/crossplane/provider-aws/pkg/controller/ram/resourceshare"
 	"github.com/crossplane/provider-aws/pkg/controller/redshift"
+	"github.com/crossplane/provider-aws/pkg/controller/route53/healthcheck"
 	"github.com/crossplane/provider-aws/pkg/controller/route53/hostedzone"
 	"github.com/crossplane/provider-aws/pkg/controller/route53/resourcerecordset"
+	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverendpoint"
+	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverrule"
+	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverruleassociation"
 	"github.com/crossplane/provider-aws/pkg/controller/s3"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/accesspoint"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/accountpublicaccessblock"
 	"github.com/crossplane/provider-aws/pkg/controller/s3/bucketpolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/sagemaker/endpoint"
+	"github.com/crossplane/provider-aws/pkg/controller/sagemaker/endpointconfig"
+	"github.com/crossplane/provider-aws/pkg/controller/sagemaker/model"
+	"github.com/crossplane/provider-aws/pkg/controller/sagemaker/notebookinstance"
+	securityhubaccount "github.com/crossplane/provider-aws/pkg/controller/securityhub/account"
+	"github.com/crossplane/provider-aws/pkg/controller/securityhub/standardssubscription"
+	"github.com/crossplane/provider-aws/pkg/controller/servicecatalog/portfolio"
+	"github.com/crossplane/provider-aws/pkg/controller/servicecatalog/product"
+	sdhttpnamespace "github.com/crossplane/provider-aws/pkg/controller/servicediscovery/httpnamespace"
+	sdprivatednsnamespace "github.com/crossplane/provider-aws/pkg/controller/servicediscovery/privatednsnamespace"
+	sdpublicdnsnamespace "github.com/crossplane/provider-aws/pkg/controller/servicediscovery/publicdnsnamespace"
+	sdservice "github.com/crossplane/provider-aws/pkg/controller/servicediscovery/service"
+	"github.com/crossplane/provider-aws/pkg/controller/shield/protection"
 	"github.com/crossplane/provider-aws/pkg/controller/sqs/queue"
+	"github.com/crossplane/provider-aws/pkg/controller/synthetics/canary"
+	"github.com/crossplane/provider-aws/pkg/controller/wafv2/webacl"
+	"github.com/crossplane/provider-aws/pkg/controller/wafv2/webaclassociation"
+	xraygroup "github.com/crossplane/provider-aws/pkg/controller/xray/group"
+	"github.com/crossplane/provider-aws/pkg/controller/xray/samplingrule"
 )
 
 // Setup creates all AWS controllers with the supplied logger and adds them to
@@ -68,6 +192,7 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 		config.Setup,
 		cache.SetupReplicationGroup,
 		cachesubnetgroup.SetupCacheSubnetGroup,
+		cacheparametergroup.SetupCacheParameterGroup,
 		cluster.SetupCacheCluster,
 		database.SetupRDSInstance,
 		eks.SetupCluster,
@@ -103,6 +228,129 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 		redshift.SetupCluster,
 		elasticip.SetupElasticIP,
 		repository.SetupRepository,
+		webacl.SetupWebACL,
+		webaclassociation.SetupWebACLAssociation,
+		detector.SetupDetector,
+		member.SetupMember,
+		trail.SetupTrail,
+		configurationrecorder.SetupConfigurationRecorder,
+		deliverychannel.SetupDeliveryChannel,
+		configrule.SetupConfigRule,
+		transitgateway.SetupTransitGateway,
+		transitgatewayvpcattachment.SetupTransitGatewayVPCAttachment,
+		transitgatewayroutetable.SetupTransitGatewayRouteTable,
+		vpcpeeringconnection.SetupVPCPeeringConnection,
+		customergateway.SetupCustomerGateway,
+		vpngateway.SetupVPNGateway,
+		vpnconnection.SetupVPNConnection,
+		loadbalancer.SetupLoadBalancer,
+		targetgroup.SetupTargetGroup,
+		listener.SetupListener,
+		listenerrule.SetupListenerRule,
+		launchtemplate.SetupLaunchTemplate,
+		autoscalinggroup.SetupAutoScalingGroup,
+		instance.SetupInstance,
+		volume.SetupVolume,
+		snapshot.SetupSnapshot,
+		vpcendpoint.SetupVPCEndpoint,
+		anomalydetector.SetupAnomalyDetector,
+		dashboard.SetupDashboard,
+		vpcendpointserviceconfiguration.SetupVPCEndpointServiceConfiguration,
+		flowlog.SetupFlowLog,
+		networkacl.SetupNetworkACL,
+		publishingdestination.SetupPublishingDestination,
+		dhcpoptions.SetupDHCPOptions,
+		egressonlyinternetgateway.SetupEgressOnlyInternetGateway,
+		emrcluster.SetupCluster,
+		notebookinstance.SetupNotebookInstance,
+		model.SetupModel,
+		endpointconfig.SetupEndpointConfig,
+		endpoint.SetupEndpoint,
+		dbcluster.SetupDBCluster,
+		dbinstance.SetupDBInstance,
+		docdbcluster.SetupDBCluster,
+		docdbinstance.SetupDBInstance,
+		domain.SetupDomain,
+		broker.SetupBroker,
+		mesh.SetupMesh,
+		virtualnode.SetupVirtualNode,
+		virtualservice.SetupVirtualService,
+		virtualrouter.SetupVirtualRouter,
+		route.SetupRoute,
+		sdprivatednsnamespace.SetupPrivateDNSNamespace,
+		sdpublicdnsnamespace.SetupPublicDNSNamespace,
+		sdhttpnamespace.SetupHTTPNamespace,
+		sdservice.SetupService,
+		gaaccelerator.SetupAccelerator,
+		galistener.SetupListener,
+		gaendpointgroup.SetupEndpointGroup,
+		calleridentity.SetupCallerIdentity,
+		regioninfo.SetupRegionInfo,
+		availabilityzones.SetupAvailabilityZones,
+		vpcdefaults.SetupVPCDefaults,
+		backupvault.SetupBackupVault,
+		backupplan.SetupBackupPlan,
+		backupselection.SetupBackupSelection,
+		filesystem.SetupFileSystem,
+		locations3.SetupLocationS3,
+		locationefs.SetupLocationEFS,
+		locationnfs.SetupLocationNFS,
+		task.SetupTask,
+		computeenvironment.SetupComputeEnvironment,
+		jobqueue.SetupJobQueue,
+		jobdefinition.SetupJobDefinition,
+		project.SetupProject,
+		pipeline.SetupPipeline,
+		codecommitrepository.SetupRepository,
+		orgaccount.SetupAccount,
+		orgunit.SetupOrganizationalUnit,
+		orgpolicy.SetupPolicy,
+		orgpolicyattachment.SetupPolicyAttachment,
+		resourceshare.SetupResourceShare,
+		portfolio.SetupPortfolio,
+		product.SetupProduct,
+		budget.SetupBudget,
+		openidconnectprovider.SetupOpenIDConnectProvider,
+		instanceprofile.SetupInstanceProfile,
+		samlprovider.SetupSAMLProvider,
+		iamaccesskey.SetupIAMAccessKey,
+		accesspoint.SetupAccessPoint,
+		accountpublicaccessblock.SetupAccountPublicAccessBlock,
+		resolverendpoint.SetupResolverEndpoint,
+		resolverrule.SetupResolverRule,
+		resolverruleassociation.SetupResolverRuleAssociation,
+		healthcheck.SetupHealthCheck,
+		rdsdbcluster.SetupDBCluster,
+		dbparametergroup.SetupDBParameterGroup,
+		optiongroup.SetupOptionGroup,
+		dbsnapshot.SetupDBSnapshot,
+		dbproxy.SetupDBProxy,
+		dbproxytargetgroup.SetupDBProxyTargetGroup,
+		workgroup.SetupWorkGroup,
+		namedquery.SetupNamedQuery,
+		catalogdatabase.SetupCatalogDatabase,
+		gluecrawler.SetupCrawler,
+		gluejob.SetupJob,
+		datalakesettings.SetupDataLakeSettings,
+		lakeformationpermissions.SetupLakeFormationPermissions,
+		canary.SetupCanary,
+		xraygroup.SetupXRayGroup,
+		samplingrule.SetupSamplingRule,
+		securityhubaccount.SetupSecurityHubAccount,
+		standardssubscription.SetupSecurityHubStandardsSubscription,
+		macieaccount.SetupAccount,
+		classificationjob.SetupClassificationJob,
+		protection.SetupProtection,
+		ledger.SetupLedger,
+		amplifyapp.SetupApp,
+		amplifybranch.SetupBranch,
+		domainassociation.SetupDomainAssociation,
+		lightsailinstance.SetupInstance,
+		lightsaildatabase.SetupDatabase,
+		staticip.SetupStaticIP,
+		elasticbeanstalkapplication.SetupApplication,
+		applicationversion.SetupApplicationVersion,
+		elasticbeanstalkenvironment.SetupEnvironment,
 	} {
 		if err := setup(mgr, l); err != nil {
 			return err
@@ -0,0 +1,211 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupplan
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsbackup "github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/backup/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/backup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a BackupPlan resource"
+
+	errGet        = "failed to get the BackupPlan resource"
+	errCreate     = "failed to create the BackupPlan resource"
+	errUpdate     = "failed to update the BackupPlan resource"
+	errDelete     = "failed to delete the BackupPlan resource"
+	errListTags   = "failed to list the tags of the BackupPlan resource"
+	errAddTags    = "failed to add tags to the BackupPlan resource"
+	errRemoveTags = "failed to remove tags from the BackupPlan resource"
+	errSpecUpdate = "cannot update spec of the BackupPlan custom resource"
+)
+
+// SetupBackupPlan adds a controller that reconciles BackupPlans.
+func SetupBackupPlan(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.BackupPlanGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.BackupPlan{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BackupPlanGroupVersionKind),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: backup.NewBackupPlanClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) backup.BackupPlanClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.BackupPlan)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client backup.BackupPlanClient
+}
+
+func (e *external) get(ctx context.Context, cr *v1alpha1.BackupPlan) (*awsbackup.GetBackupPlanOutput, error) {
+	rsp, err := e.client.GetBackupPlanRequest(&awsbackup.GetBackupPlanInput{
+		BackupPlanId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.GetBackupPlanOutput, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.BackupPlan)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The ID of a plan is generated by AWS Backup and set as the external
+	// name once the plan is created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(backup.IsNotFound, err), errGet)
+	}
+	// Deleted plans can still be read until AWS Backup forgets them.
+	if observed.DeletionDate != nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	backup.LateInitializeBackupPlan(&cr.Spec.ForProvider, observed.BackupPlan)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = backup.GenerateBackupPlanObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	tags, err := e.client.ListTagsRequest(&awsbackup.ListTagsInput{ResourceArn: observed.BackupPlanArn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := awsclients.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(add) == 0 && len(remove) == 0 && backup.IsBackupPlanUpToDate(cr.Spec.ForProvider, *observed.BackupPlan),
+	}, nil
+}
+
+// Create creates a plan and sets its ID as the external name of the
+// BackupPlan. The UID of the BackupPlan is the creator request ID, so that
+// the same plan is returned if the external name could not be saved.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.BackupPlan)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.CreateBackupPlanRequest(backup.GenerateCreateBackupPlanInput(string(cr.GetUID()), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.BackupPlanId))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+// Update updates the tags and the rules of the plan. Only a change of the
+// rules creates a new version of the plan.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.BackupPlan)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := aws.String(cr.Status.AtProvider.ARN)
+	tags, err := e.client.ListTagsRequest(&awsbackup.ListTagsInput{ResourceArn: arn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := awsclients.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.UntagResourceRequest(&awsbackup.UntagResourceInput{ResourceArn: arn, TagKeyList: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.TagResourceRequest(&awsbackup.TagResourceInput{ResourceArn: arn, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	observed, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGet)
+	}
+	if backup.IsBackupPlanUpToDate(cr.Spec.ForProvider, *observed.BackupPlan) {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.UpdateBackupPlanRequest(backup.GenerateUpdateBackupPlanInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+// Delete deletes the plan. AWS Backup refuses to delete a plan that still
+// has selections.
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.BackupPlan)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteBackupPlanRequest(&awsbackup.DeleteBackupPlanInput{BackupPlanId: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	return errors.Wrap(resource.Ignore(backup.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,551 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupplan
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsbackup "github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/backup/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/backup"
+	"github.com/crossplane/provider-aws/pkg/clients/backup/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	planID    = "1234abcd-12ab-34cd-56ef-1234567890ab"
+	planARN   = "arn:aws:backup:us-west-2:123456789012:backup-plan:1234abcd-12ab-34cd-56ef-1234567890ab"
+	planName  = "example"
+	versionID = "version-1"
+	vaultName = "example-vault"
+	schedule  = "cron(0 5 ? * * *)"
+	uid       = types.UID("some-uid")
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	backup backup.BackupPlanClient
+	kube   *test.MockClient
+	cr     resource.Managed
+}
+
+type planModifier func(*v1alpha1.BackupPlan)
+
+func withConditions(c ...runtimev1alpha1.Condition) planModifier {
+	return func(r *v1alpha1.BackupPlan) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) planModifier {
+	return func(r *v1alpha1.BackupPlan) { meta.SetExternalName(r, n) }
+}
+
+func withSchedule(s string) planModifier {
+	return func(r *v1alpha1.BackupPlan) { r.Spec.ForProvider.Rules[0].ScheduleExpression = aws.String(s) }
+}
+
+func withWindows() planModifier {
+	return func(r *v1alpha1.BackupPlan) {
+		r.Spec.ForProvider.Rules[0].StartWindowMinutes = aws.Int64(480)
+		r.Spec.ForProvider.Rules[0].CompletionWindowMinutes = aws.Int64(10080)
+	}
+}
+
+func withTags(tags map[string]string) planModifier {
+	return func(r *v1alpha1.BackupPlan) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withObservation() planModifier {
+	return func(r *v1alpha1.BackupPlan) {
+		r.Status.AtProvider = v1alpha1.BackupPlanObservation{ARN: planARN, VersionID: versionID}
+	}
+}
+
+func plan(m ...planModifier) *v1alpha1.BackupPlan {
+	cr := &v1alpha1.BackupPlan{
+		Spec: v1alpha1.BackupPlanSpec{
+			ForProvider: v1alpha1.BackupPlanParameters{
+				Name: planName,
+				Rules: []v1alpha1.BackupRule{{
+					RuleName:              "daily",
+					TargetBackupVaultName: aws.String(vaultName),
+				}},
+			},
+		},
+	}
+	cr.SetUID(uid)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func get(deleted bool) func(*awsbackup.GetBackupPlanInput) awsbackup.GetBackupPlanRequest {
+	return func(*awsbackup.GetBackupPlanInput) awsbackup.GetBackupPlanRequest {
+		out := &awsbackup.GetBackupPlanOutput{
+			BackupPlanArn: aws.String(planARN),
+			BackupPlanId:  aws.String(planID),
+			VersionId:     aws.String(versionID),
+			BackupPlan: &awsbackup.BackupPlan{
+				BackupPlanName: aws.String(planName),
+				Rules: []awsbackup.BackupRule{{
+					RuleName:                aws.String("daily"),
+					TargetBackupVaultName:   aws.String(vaultName),
+					ScheduleExpression:      aws.String(schedule),
+					StartWindowMinutes:      aws.Int64(480),
+					CompletionWindowMinutes: aws.Int64(10080),
+				}},
+			},
+		}
+		if deleted {
+			out.DeletionDate = &time.Time{}
+		}
+		return awsbackup.GetBackupPlanRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func listTags(tags map[string]string) func(*awsbackup.ListTagsInput) awsbackup.ListTagsRequest {
+	return func(*awsbackup.ListTagsInput) awsbackup.ListTagsRequest {
+		return awsbackup.ListTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.ListTagsOutput{Tags: tags}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{
+					MockGetBackupPlan: get(false),
+					MockListTags:      listTags(map[string]string{"team": "platform"}),
+				},
+				cr: plan(withExternalName(planID), withSchedule(schedule), withWindows(), withTags(map[string]string{"team": "platform"})),
+			},
+			want: want{
+				cr: plan(withExternalName(planID), withSchedule(schedule), withWindows(), withTags(map[string]string{"team": "platform"}),
+					withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{
+					MockGetBackupPlan: get(false),
+					MockListTags:      listTags(nil),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   plan(withExternalName(planID)),
+			},
+			want: want{
+				cr: plan(withExternalName(planID), withSchedule(schedule), withWindows(), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RulesNotUpToDate": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{
+					MockGetBackupPlan: get(false),
+					MockListTags:      listTags(nil),
+				},
+				cr: plan(withExternalName(planID), withSchedule("cron(0 1 ? * * *)"), withWindows()),
+			},
+			want: want{
+				cr: plan(withExternalName(planID), withSchedule("cron(0 1 ? * * *)"), withWindows(), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotCreatedYet": {
+			args: args{
+				cr: plan(),
+			},
+			want: want{
+				cr: plan(),
+			},
+		},
+		"Deleted": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{MockGetBackupPlan: get(true)},
+				cr:     plan(withExternalName(planID)),
+			},
+			want: want{
+				cr: plan(withExternalName(planID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{
+					MockGetBackupPlan: func(*awsbackup.GetBackupPlanInput) awsbackup.GetBackupPlanRequest {
+						return awsbackup.GetBackupPlanRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsbackup.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: plan(withExternalName(planID)),
+			},
+			want: want{
+				cr: plan(withExternalName(planID)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{
+					MockGetBackupPlan: func(*awsbackup.GetBackupPlanInput) awsbackup.GetBackupPlanRequest {
+						return awsbackup.GetBackupPlanRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: plan(withExternalName(planID)),
+			},
+			want: want{
+				cr:  plan(withExternalName(planID)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.backup, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{
+					MockCreateBackupPlan: func(input *awsbackup.CreateBackupPlanInput) awsbackup.CreateBackupPlanRequest {
+						if aws.StringValue(input.CreatorRequestId) != string(uid) {
+							return awsbackup.CreateBackupPlanRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+							}
+						}
+						return awsbackup.CreateBackupPlanRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.CreateBackupPlanOutput{
+								BackupPlanArn: aws.String(planARN),
+								BackupPlanId:  aws.String(planID),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   plan(),
+			},
+			want: want{
+				cr: plan(withExternalName(planID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{
+					MockCreateBackupPlan: func(*awsbackup.CreateBackupPlanInput) awsbackup.CreateBackupPlanRequest {
+						return awsbackup.CreateBackupPlanRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: plan(),
+			},
+			want: want{
+				cr:  plan(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"SpecUpdateError": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{
+					MockCreateBackupPlan: func(*awsbackup.CreateBackupPlanInput) awsbackup.CreateBackupPlanRequest {
+						return awsbackup.CreateBackupPlanRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.CreateBackupPlanOutput{
+								BackupPlanId: aws.String(planID),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   plan(),
+			},
+			want: want{
+				cr:  plan(withExternalName(planID), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.backup, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	update := func(err error) func(*awsbackup.UpdateBackupPlanInput) awsbackup.UpdateBackupPlanRequest {
+		return func(*awsbackup.UpdateBackupPlanInput) awsbackup.UpdateBackupPlanRequest {
+			return awsbackup.UpdateBackupPlanRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsbackup.UpdateBackupPlanOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateRules": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{
+					MockListTags:      listTags(nil),
+					MockGetBackupPlan: get(false),
+					MockUpdateBackupPlan: func(input *awsbackup.UpdateBackupPlanInput) awsbackup.UpdateBackupPlanRequest {
+						if aws.StringValue(input.BackupPlanId) != planID || aws.StringValue(input.BackupPlan.Rules[0].ScheduleExpression) != "cron(0 1 ? * * *)" {
+							return update(errBoom)(input)
+						}
+						return update(nil)(input)
+					},
+				},
+				cr: plan(withExternalName(planID), withSchedule("cron(0 1 ? * * *)"), withWindows(), withObservation()),
+			},
+			want: want{
+				cr: plan(withExternalName(planID), withSchedule("cron(0 1 ? * * *)"), withWindows(), withObservation()),
+			},
+		},
+		"OnlyTags": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{
+					MockListTags: listTags(nil),
+					MockTagResource: func(*awsbackup.TagResourceInput) awsbackup.TagResourceRequest {
+						return awsbackup.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.TagResourceOutput{}},
+						}
+					},
+					MockGetBackupPlan:    get(false),
+					MockUpdateBackupPlan: update(errBoom),
+				},
+				cr: plan(withExternalName(planID), withSchedule(schedule), withWindows(), withTags(map[string]string{"team": "platform"}), withObservation()),
+			},
+			want: want{
+				cr: plan(withExternalName(planID), withSchedule(schedule), withWindows(), withTags(map[string]string{"team": "platform"}), withObservation()),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{
+					MockListTags:         listTags(nil),
+					MockGetBackupPlan:    get(false),
+					MockUpdateBackupPlan: update(errBoom),
+				},
+				cr: plan(withExternalName(planID), withSchedule("cron(0 1 ? * * *)"), withWindows(), withObservation()),
+			},
+			want: want{
+				cr:  plan(withExternalName(planID), withSchedule("cron(0 1 ? * * *)"), withWindows(), withObservation()),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.backup, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{
+					MockDeleteBackupPlan: func(*awsbackup.DeleteBackupPlanInput) awsbackup.DeleteBackupPlanRequest {
+						return awsbackup.DeleteBackupPlanRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.DeleteBackupPlanOutput{}},
+						}
+					},
+				},
+				cr: plan(withExternalName(planID)),
+			},
+			want: want{
+				cr: plan(withExternalName(planID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{
+					MockDeleteBackupPlan: func(*awsbackup.DeleteBackupPlanInput) awsbackup.DeleteBackupPlanRequest {
+						return awsbackup.DeleteBackupPlanRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsbackup.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: plan(withExternalName(planID)),
+			},
+			want: want{
+				cr: plan(withExternalName(planID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				backup: &fake.MockBackupPlanClient{
+					MockDeleteBackupPlan: func(*awsbackup.DeleteBackupPlanInput) awsbackup.DeleteBackupPlanRequest {
+						return awsbackup.DeleteBackupPlanRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: plan(withExternalName(planID)),
+			},
+			want: want{
+				cr:  plan(withExternalName(planID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.backup, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupselection
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsbackup "github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/backup/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/backup"
+	"github.com/crossplane/provider-aws/pkg/clients/backup/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	planID      = "1234abcd-12ab-34cd-56ef-1234567890ab"
+	selectionID = "abcd1234-ab12-cd34-ef56-abcdef123456"
+	roleARN     = "arn:aws:iam::123456789012:role/backup"
+	created     = time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)
+	uid         = types.UID("some-uid")
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	backup backup.BackupSelectionClient
+	kube   *test.MockClient
+	cr     resource.Managed
+}
+
+type selectionModifier func(*v1alpha1.BackupSelection)
+
+func withConditions(c ...runtimev1alpha1.Condition) selectionModifier {
+	return func(r *v1alpha1.BackupSelection) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) selectionModifier {
+	return func(r *v1alpha1.BackupSelection) { meta.SetExternalName(r, n) }
+}
+
+func withObservation() selectionModifier {
+	return func(r *v1alpha1.BackupSelection) {
+		t := metav1.NewTime(created)
+		r.Status.AtProvider = v1alpha1.BackupSelectionObservation{CreationDate: &t}
+	}
+}
+
+func selection(m ...selectionModifier) *v1alpha1.BackupSelection {
+	cr := &v1alpha1.BackupSelection{
+		Spec: v1alpha1.BackupSelectionSpec{
+			ForProvider: v1alpha1.BackupSelectionParameters{
+				Name:         "example",
+				BackupPlanID: aws.String(planID),
+				IAMRoleARN:   aws.String(roleARN),
+				ListOfTags:   []v1alpha1.TagCondition{{Type: "STRINGEQUALS", Key: "backup", Value: "daily"}},
+			},
+		},
+	}
+	cr.SetUID(uid)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				backup: &fake.MockBackupSelectionClient{
+					MockGetBackupSelection: func(input *awsbackup.GetBackupSelectionInput) awsbackup.GetBackupSelectionRequest {
+						if aws.StringValue(input.BackupPlanId) != planID || aws.StringValue(input.SelectionId) != selectionID {
+							return awsbackup.GetBackupSelectionRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+							}
+						}
+						return awsbackup.GetBackupSelectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.GetBackupSelectionOutput{
+								BackupPlanId: aws.String(planID),
+								SelectionId:  aws.String(selectionID),
+								CreationDate: &created,
+							}},
+						}
+					},
+				},
+				cr: selection(withExternalName(selectionID)),
+			},
+			want: want{
+				cr: selection(withExternalName(selectionID), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotCreatedYet": {
+			args: args{
+				cr: selection(),
+			},
+			want: want{
+				cr: selection(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				backup: &fake.MockBackupSelectionClient{
+					MockGetBackupSelection: func(*awsbackup.GetBackupSelectionInput) awsbackup.GetBackupSelectionRequest {
+						return awsbackup.GetBackupSelectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsbackup.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: selection(withExternalName(selectionID)),
+			},
+			want: want{
+				cr: selection(withExternalName(selectionID)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				backup: &fake.MockBackupSelectionClient{
+					MockGetBackupSelection: func(*awsbackup.GetBackupSelectionInput) awsbackup.GetBackupSelectionRequest {
+						return awsbackup.GetBackupSelectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: selection(withExternalName(selectionID)),
+			},
+			want: want{
+				cr:  selection(withExternalName(selectionID)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.backup, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				backup: &fake.MockBackupSelectionClient{
+					MockCreateBackupSelection: func(input *awsbackup.CreateBackupSelectionInput) awsbackup.CreateBackupSelectionRequest {
+						if aws.StringValue(input.CreatorRequestId) != string(uid) {
+							return awsbackup.CreateBackupSelectionRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+							}
+						}
+						return awsbackup.CreateBackupSelectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.CreateBackupSelectionOutput{
+								BackupPlanId: aws.String(planID),
+								SelectionId:  aws.String(selectionID),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   selection(),
+			},
+			want: want{
+				cr: selection(withExternalName(selectionID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				backup: &fake.MockBackupSelectionClient{
+					MockCreateBackupSelection: func(*awsbackup.CreateBackupSelectionInput) awsbackup.CreateBackupSelectionRequest {
+						return awsbackup.CreateBackupSelectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: selection(),
+			},
+			want: want{
+				cr:  selection(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.backup, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				backup: &fake.MockBackupSelectionClient{
+					MockDeleteBackupSelection: func(*awsbackup.DeleteBackupSelectionInput) awsbackup.DeleteBackupSelectionRequest {
+						return awsbackup.DeleteBackupSelectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.DeleteBackupSelectionOutput{}},
+						}
+					},
+				},
+				cr: selection(withExternalName(selectionID)),
+			},
+			want: want{
+				cr: selection(withExternalName(selectionID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				backup: &fake.MockBackupSelectionClient{
+					MockDeleteBackupSelection: func(*awsbackup.DeleteBackupSelectionInput) awsbackup.DeleteBackupSelectionRequest {
+						return awsbackup.DeleteBackupSelectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsbackup.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: selection(withExternalName(selectionID)),
+			},
+			want: want{
+				cr: selection(withExternalName(selectionID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				backup: &fake.MockBackupSelectionClient{
+					MockDeleteBackupSelection: func(*awsbackup.DeleteBackupSelectionInput) awsbackup.DeleteBackupSelectionRequest {
+						return awsbackup.DeleteBackupSelectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: selection(withExternalName(selectionID)),
+			},
+			want: want{
+				cr:  selection(withExternalName(selectionID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.backup, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupvault
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsbackup "github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/backup/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/backup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a BackupVault resource"
+
+	errDescribe     = "failed to describe the BackupVault resource"
+	errCreate       = "failed to create the BackupVault resource"
+	errDelete       = "failed to delete the BackupVault resource"
+	errGetPolicy    = "failed to get the access policy of the BackupVault resource"
+	errPutPolicy    = "failed to put the access policy of the BackupVault resource"
+	errDeletePolicy = "failed to delete the access policy of the BackupVault resource"
+	errListTags     = "failed to list the tags of the BackupVault resource"
+	errAddTags      = "failed to add tags to the BackupVault resource"
+	errRemoveTags   = "failed to remove tags from the BackupVault resource"
+	errSpecUpdate   = "cannot update spec of the BackupVault custom resource"
+)
+
+// SetupBackupVault adds a controller that reconciles BackupVaults.
+func SetupBackupVault(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.BackupVaultGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.BackupVault{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BackupVaultGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: backup.NewBackupVaultClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) backup.BackupVaultClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.BackupVault)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client backup.BackupVaultClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.BackupVault)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	name := aws.String(meta.GetExternalName(cr))
+	observed, err := e.client.DescribeBackupVaultRequest(&awsbackup.DescribeBackupVaultInput{BackupVaultName: name}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(backup.IsNotFound, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	backup.LateInitializeBackupVault(&cr.Spec.ForProvider, observed.DescribeBackupVaultOutput)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = backup.GenerateBackupVaultObservation(*observed.DescribeBackupVaultOutput)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	policy := ""
+	rsp, err := e.client.GetBackupVaultAccessPolicyRequest(&awsbackup.GetBackupVaultAccessPolicyInput{BackupVaultName: name}).Send(ctx)
+	switch {
+	case backup.IsNotFound(err):
+	case err != nil:
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPolicy)
+	default:
+		policy = aws.StringValue(rsp.Policy)
+	}
+
+	tags, err := e.client.ListTagsRequest(&awsbackup.ListTagsInput{ResourceArn: observed.BackupVaultArn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := awsclients.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(add) == 0 && len(remove) == 0 && backup.IsAccessPolicyUpToDate(cr.Spec.ForProvider, policy),
+	}, nil
+}
+
+// Create creates the vault. Its access policy is put by the following update.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.BackupVault)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateBackupVaultRequest(backup.GenerateCreateBackupVaultInput(meta.GetExternalName(cr), string(cr.GetUID()), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+// Update updates the tags and the access policy of the vault. The encryption
+// key of a vault can't be changed.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.BackupVault)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := aws.String(cr.Status.AtProvider.ARN)
+	tags, err := e.client.ListTagsRequest(&awsbackup.ListTagsInput{ResourceArn: arn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := awsclients.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.UntagResourceRequest(&awsbackup.UntagResourceInput{ResourceArn: arn, TagKeyList: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.TagResourceRequest(&awsbackup.TagResourceInput{ResourceArn: arn, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	name := aws.String(meta.GetExternalName(cr))
+	if cr.Spec.ForProvider.AccessPolicy == nil {
+		_, err := e.client.DeleteBackupVaultAccessPolicyRequest(&awsbackup.DeleteBackupVaultAccessPolicyInput{BackupVaultName: name}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(backup.IsNotFound, err), errDeletePolicy)
+	}
+	_, err = e.client.PutBackupVaultAccessPolicyRequest(&awsbackup.PutBackupVaultAccessPolicyInput{
+		BackupVaultName: name,
+		Policy:          cr.Spec.ForProvider.AccessPolicy,
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errPutPolicy)
+}
+
+// Delete deletes the vault. AWS Backup refuses to delete a vault that still
+// stores recovery points.
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.BackupVault)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteBackupVaultRequest(&awsbackup.DeleteBackupVaultInput{BackupVaultName: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	return errors.Wrap(resource.Ignore(backup.IsNotFound, err), errDelete)
+}
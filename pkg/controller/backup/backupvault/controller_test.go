@@ -0,0 +1,543 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupvault
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsbackup "github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/backup/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/backup"
+	"github.com/crossplane/provider-aws/pkg/clients/backup/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	vaultName = "example"
+	vaultARN  = "arn:aws:backup:us-west-2:123456789012:backup-vault:example"
+	keyARN    = "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	policy    = `{"Version":"2012-10-17","Statement":[]}`
+	uid       = types.UID("some-uid")
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	backup backup.BackupVaultClient
+	kube   *test.MockClient
+	cr     resource.Managed
+}
+
+type vaultModifier func(*v1alpha1.BackupVault)
+
+func withConditions(c ...runtimev1alpha1.Condition) vaultModifier {
+	return func(r *v1alpha1.BackupVault) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withEncryptionKeyARN(arn string) vaultModifier {
+	return func(r *v1alpha1.BackupVault) { r.Spec.ForProvider.EncryptionKeyARN = aws.String(arn) }
+}
+
+func withAccessPolicy(p string) vaultModifier {
+	return func(r *v1alpha1.BackupVault) { r.Spec.ForProvider.AccessPolicy = aws.String(p) }
+}
+
+func withTags(tags map[string]string) vaultModifier {
+	return func(r *v1alpha1.BackupVault) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withObservation() vaultModifier {
+	return func(r *v1alpha1.BackupVault) {
+		r.Status.AtProvider = v1alpha1.BackupVaultObservation{ARN: vaultARN, NumberOfRecoveryPoints: 3}
+	}
+}
+
+func vault(m ...vaultModifier) *v1alpha1.BackupVault {
+	cr := &v1alpha1.BackupVault{}
+	cr.SetUID(uid)
+	meta.SetExternalName(cr, vaultName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(*awsbackup.DescribeBackupVaultInput) awsbackup.DescribeBackupVaultRequest {
+	return awsbackup.DescribeBackupVaultRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.DescribeBackupVaultOutput{
+			BackupVaultArn:         aws.String(vaultARN),
+			BackupVaultName:        aws.String(vaultName),
+			EncryptionKeyArn:       aws.String(keyARN),
+			NumberOfRecoveryPoints: aws.Int64(3),
+		}},
+	}
+}
+
+func getPolicy(p string) func(*awsbackup.GetBackupVaultAccessPolicyInput) awsbackup.GetBackupVaultAccessPolicyRequest {
+	return func(*awsbackup.GetBackupVaultAccessPolicyInput) awsbackup.GetBackupVaultAccessPolicyRequest {
+		if p == "" {
+			return awsbackup.GetBackupVaultAccessPolicyRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+					Error: awserr.New(awsbackup.ErrCodeResourceNotFoundException, "", nil)},
+			}
+		}
+		return awsbackup.GetBackupVaultAccessPolicyRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.GetBackupVaultAccessPolicyOutput{
+				Policy: aws.String(p),
+			}},
+		}
+	}
+}
+
+func listTags(tags map[string]string) func(*awsbackup.ListTagsInput) awsbackup.ListTagsRequest {
+	return func(*awsbackup.ListTagsInput) awsbackup.ListTagsRequest {
+		return awsbackup.ListTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.ListTagsOutput{Tags: tags}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockDescribeBackupVault:        describe,
+					MockGetBackupVaultAccessPolicy: getPolicy(policy),
+					MockListTags:                   listTags(map[string]string{"team": "platform"}),
+				},
+				cr: vault(withEncryptionKeyARN(keyARN), withAccessPolicy(policy), withTags(map[string]string{"team": "platform"})),
+			},
+			want: want{
+				cr: vault(withEncryptionKeyARN(keyARN), withAccessPolicy(policy), withTags(map[string]string{"team": "platform"}),
+					withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockDescribeBackupVault:        describe,
+					MockGetBackupVaultAccessPolicy: getPolicy(""),
+					MockListTags:                   listTags(nil),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   vault(),
+			},
+			want: want{
+				cr: vault(withEncryptionKeyARN(keyARN), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PolicyNotUpToDate": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockDescribeBackupVault:        describe,
+					MockGetBackupVaultAccessPolicy: getPolicy(""),
+					MockListTags:                   listTags(nil),
+				},
+				cr: vault(withEncryptionKeyARN(keyARN), withAccessPolicy(policy)),
+			},
+			want: want{
+				cr: vault(withEncryptionKeyARN(keyARN), withAccessPolicy(policy), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"TagsNotUpToDate": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockDescribeBackupVault:        describe,
+					MockGetBackupVaultAccessPolicy: getPolicy(""),
+					MockListTags:                   listTags(map[string]string{"team": "platform"}),
+				},
+				cr: vault(withEncryptionKeyARN(keyARN)),
+			},
+			want: want{
+				cr: vault(withEncryptionKeyARN(keyARN), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockDescribeBackupVault: func(*awsbackup.DescribeBackupVaultInput) awsbackup.DescribeBackupVaultRequest {
+						return awsbackup.DescribeBackupVaultRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsbackup.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: vault(),
+			},
+			want: want{
+				cr: vault(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockDescribeBackupVault: func(*awsbackup.DescribeBackupVaultInput) awsbackup.DescribeBackupVaultRequest {
+						return awsbackup.DescribeBackupVaultRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: vault(),
+			},
+			want: want{
+				cr:  vault(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"GetPolicyError": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockDescribeBackupVault: describe,
+					MockGetBackupVaultAccessPolicy: func(*awsbackup.GetBackupVaultAccessPolicyInput) awsbackup.GetBackupVaultAccessPolicyRequest {
+						return awsbackup.GetBackupVaultAccessPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: vault(withEncryptionKeyARN(keyARN)),
+			},
+			want: want{
+				cr:  vault(withEncryptionKeyARN(keyARN), withObservation(), withConditions(runtimev1alpha1.Available())),
+				err: errors.Wrap(errBoom, errGetPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.backup, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockCreateBackupVault: func(input *awsbackup.CreateBackupVaultInput) awsbackup.CreateBackupVaultRequest {
+						if aws.StringValue(input.BackupVaultName) != vaultName || aws.StringValue(input.CreatorRequestId) != string(uid) {
+							return awsbackup.CreateBackupVaultRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+							}
+						}
+						return awsbackup.CreateBackupVaultRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.CreateBackupVaultOutput{
+								BackupVaultArn: aws.String(vaultARN),
+							}},
+						}
+					},
+				},
+				cr: vault(),
+			},
+			want: want{
+				cr: vault(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockCreateBackupVault: func(*awsbackup.CreateBackupVaultInput) awsbackup.CreateBackupVaultRequest {
+						return awsbackup.CreateBackupVaultRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: vault(),
+			},
+			want: want{
+				cr:  vault(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.backup, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutPolicyAndTags": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockListTags: listTags(map[string]string{"team": "data", "old": "tag"}),
+					MockUntagResource: func(input *awsbackup.UntagResourceInput) awsbackup.UntagResourceRequest {
+						if len(input.TagKeyList) != 2 {
+							return awsbackup.UntagResourceRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+							}
+						}
+						return awsbackup.UntagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.UntagResourceOutput{}},
+						}
+					},
+					MockTagResource: func(input *awsbackup.TagResourceInput) awsbackup.TagResourceRequest {
+						if input.Tags["team"] != "platform" {
+							return awsbackup.TagResourceRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+							}
+						}
+						return awsbackup.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.TagResourceOutput{}},
+						}
+					},
+					MockPutBackupVaultAccessPolicy: func(*awsbackup.PutBackupVaultAccessPolicyInput) awsbackup.PutBackupVaultAccessPolicyRequest {
+						return awsbackup.PutBackupVaultAccessPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.PutBackupVaultAccessPolicyOutput{}},
+						}
+					},
+				},
+				cr: vault(withAccessPolicy(policy), withTags(map[string]string{"team": "platform"}), withObservation()),
+			},
+			want: want{
+				cr: vault(withAccessPolicy(policy), withTags(map[string]string{"team": "platform"}), withObservation()),
+			},
+		},
+		"DeletePolicy": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockListTags: listTags(nil),
+					MockDeleteBackupVaultAccessPolicy: func(*awsbackup.DeleteBackupVaultAccessPolicyInput) awsbackup.DeleteBackupVaultAccessPolicyRequest {
+						return awsbackup.DeleteBackupVaultAccessPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsbackup.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: vault(withObservation()),
+			},
+			want: want{
+				cr: vault(withObservation()),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"PutPolicyError": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockListTags: listTags(nil),
+					MockPutBackupVaultAccessPolicy: func(*awsbackup.PutBackupVaultAccessPolicyInput) awsbackup.PutBackupVaultAccessPolicyRequest {
+						return awsbackup.PutBackupVaultAccessPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: vault(withAccessPolicy(policy), withObservation()),
+			},
+			want: want{
+				cr:  vault(withAccessPolicy(policy), withObservation()),
+				err: errors.Wrap(errBoom, errPutPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.backup, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockDeleteBackupVault: func(*awsbackup.DeleteBackupVaultInput) awsbackup.DeleteBackupVaultRequest {
+						return awsbackup.DeleteBackupVaultRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbackup.DeleteBackupVaultOutput{}},
+						}
+					},
+				},
+				cr: vault(),
+			},
+			want: want{
+				cr: vault(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockDeleteBackupVault: func(*awsbackup.DeleteBackupVaultInput) awsbackup.DeleteBackupVaultRequest {
+						return awsbackup.DeleteBackupVaultRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsbackup.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: vault(),
+			},
+			want: want{
+				cr: vault(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				backup: &fake.MockBackupVaultClient{
+					MockDeleteBackupVault: func(*awsbackup.DeleteBackupVaultInput) awsbackup.DeleteBackupVaultRequest {
+						return awsbackup.DeleteBackupVaultRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: vault(),
+			},
+			want: want{
+				cr:  vault(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.backup, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
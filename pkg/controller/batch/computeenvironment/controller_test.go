@@ -0,0 +1,535 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computeenvironment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsbatch "github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/batch"
+	"github.com/crossplane/provider-aws/pkg/clients/batch/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	ceName     = "sample-ce"
+	ceARN      = "arn:aws:batch:us-east-1:123456789012:compute-environment/sample-ce"
+	clusterARN = "arn:aws:ecs:us-east-1:123456789012:cluster/sample-ce_Batch_0123"
+	roleARN    = "arn:aws:iam::123456789012:role/AWSBatchServiceRole"
+	enabled    = string(awsbatch.CEStateEnabled)
+	disabled   = string(awsbatch.CEStateDisabled)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	batch batch.ComputeEnvironmentClient
+	kube  *test.MockClient
+	cr    resource.Managed
+}
+
+type ceModifier func(*v1alpha1.ComputeEnvironment)
+
+func withConditions(c ...runtimev1alpha1.Condition) ceModifier {
+	return func(r *v1alpha1.ComputeEnvironment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withState(s *string) ceModifier {
+	return func(r *v1alpha1.ComputeEnvironment) { r.Spec.ForProvider.State = s }
+}
+
+func withMaxvCPUs(n int64) ceModifier {
+	return func(r *v1alpha1.ComputeEnvironment) { r.Spec.ForProvider.ComputeResources.MaxvCPUs = n }
+}
+
+func withObservation(status awsbatch.CEStatus, state awsbatch.CEState) ceModifier {
+	return func(r *v1alpha1.ComputeEnvironment) {
+		r.Status.AtProvider = v1alpha1.ComputeEnvironmentObservation{
+			ARN:           ceARN,
+			ECSClusterARN: clusterARN,
+			State:         string(state),
+			Status:        string(status),
+		}
+	}
+}
+
+func computeEnvironment(m ...ceModifier) *v1alpha1.ComputeEnvironment {
+	cr := &v1alpha1.ComputeEnvironment{
+		Spec: v1alpha1.ComputeEnvironmentSpec{
+			ForProvider: v1alpha1.ComputeEnvironmentParameters{
+				Type:           string(awsbatch.CETypeManaged),
+				State:          aws.String(enabled),
+				ServiceRoleARN: aws.String(roleARN),
+				ComputeResources: &v1alpha1.ComputeResources{
+					Type:               string(awsbatch.CRTypeEc2),
+					AllocationStrategy: aws.String(string(awsbatch.CRAllocationStrategyBestFit)),
+					MinvCPUs:           0,
+					MaxvCPUs:           16,
+					InstanceTypes:      []string{"optimal"},
+					InstanceRole:       "ecsInstanceRole",
+					Subnets:            []string{"subnet-1"},
+					SecurityGroupIDs:   []string{"sg-1"},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, ceName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(status awsbatch.CEStatus, state awsbatch.CEState) func(*awsbatch.DescribeComputeEnvironmentsInput) awsbatch.DescribeComputeEnvironmentsRequest {
+	return func(*awsbatch.DescribeComputeEnvironmentsInput) awsbatch.DescribeComputeEnvironmentsRequest {
+		return awsbatch.DescribeComputeEnvironmentsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.DescribeComputeEnvironmentsOutput{
+				ComputeEnvironments: []awsbatch.ComputeEnvironmentDetail{{
+					ComputeEnvironmentArn:  aws.String(ceARN),
+					ComputeEnvironmentName: aws.String(ceName),
+					EcsClusterArn:          aws.String(clusterARN),
+					ServiceRole:            aws.String(roleARN),
+					Type:                   awsbatch.CETypeManaged,
+					State:                  state,
+					Status:                 status,
+					ComputeResources: &awsbatch.ComputeResource{
+						Type:               awsbatch.CRTypeEc2,
+						AllocationStrategy: awsbatch.CRAllocationStrategyBestFit,
+						MinvCpus:           aws.Int64(0),
+						MaxvCpus:           aws.Int64(16),
+						DesiredvCpus:       aws.Int64(4),
+						InstanceTypes:      []string{"optimal"},
+						InstanceRole:       aws.String("ecsInstanceRole"),
+						Subnets:            []string{"subnet-1"},
+						SecurityGroupIds:   []string{"sg-1"},
+					},
+				}},
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Valid": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{MockDescribeComputeEnvironments: describe(awsbatch.CEStatusValid, awsbatch.CEStateEnabled)},
+				cr:    computeEnvironment(),
+			},
+			want: want{
+				cr: computeEnvironment(withObservation(awsbatch.CEStatusValid, awsbatch.CEStateEnabled),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{MockDescribeComputeEnvironments: describe(awsbatch.CEStatusCreating, awsbatch.CEStateEnabled)},
+				cr:    computeEnvironment(),
+			},
+			want: want{
+				cr: computeEnvironment(withObservation(awsbatch.CEStatusCreating, awsbatch.CEStateEnabled),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Invalid": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{MockDescribeComputeEnvironments: describe(awsbatch.CEStatusInvalid, awsbatch.CEStateEnabled)},
+				cr:    computeEnvironment(),
+			},
+			want: want{
+				cr: computeEnvironment(withObservation(awsbatch.CEStatusInvalid, awsbatch.CEStateEnabled),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{MockDescribeComputeEnvironments: describe(awsbatch.CEStatusValid, awsbatch.CEStateEnabled)},
+				kube:  &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:    computeEnvironment(withState(nil)),
+			},
+			want: want{
+				cr: computeEnvironment(withObservation(awsbatch.CEStatusValid, awsbatch.CEStateEnabled),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{MockDescribeComputeEnvironments: describe(awsbatch.CEStatusValid, awsbatch.CEStateEnabled)},
+				cr:    computeEnvironment(withMaxvCPUs(32)),
+			},
+			want: want{
+				cr: computeEnvironment(withMaxvCPUs(32), withObservation(awsbatch.CEStatusValid, awsbatch.CEStateEnabled),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{MockDescribeComputeEnvironments: describe(awsbatch.CEStatusDeleted, awsbatch.CEStateDisabled)},
+				cr:    computeEnvironment(),
+			},
+			want: want{
+				cr: computeEnvironment(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{
+					MockDescribeComputeEnvironments: func(*awsbatch.DescribeComputeEnvironmentsInput) awsbatch.DescribeComputeEnvironmentsRequest {
+						return awsbatch.DescribeComputeEnvironmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.DescribeComputeEnvironmentsOutput{}},
+						}
+					},
+				},
+				cr: computeEnvironment(),
+			},
+			want: want{
+				cr: computeEnvironment(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{
+					MockDescribeComputeEnvironments: func(*awsbatch.DescribeComputeEnvironmentsInput) awsbatch.DescribeComputeEnvironmentsRequest {
+						return awsbatch.DescribeComputeEnvironmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: computeEnvironment(),
+			},
+			want: want{
+				cr:  computeEnvironment(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.batch, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{
+					MockCreateComputeEnvironment: func(input *awsbatch.CreateComputeEnvironmentInput) awsbatch.CreateComputeEnvironmentRequest {
+						if aws.StringValue(input.ComputeEnvironmentName) != ceName {
+							return awsbatch.CreateComputeEnvironmentRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+							}
+						}
+						return awsbatch.CreateComputeEnvironmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.CreateComputeEnvironmentOutput{
+								ComputeEnvironmentArn: aws.String(ceARN),
+							}},
+						}
+					},
+				},
+				cr: computeEnvironment(),
+			},
+			want: want{
+				cr: computeEnvironment(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{
+					MockCreateComputeEnvironment: func(*awsbatch.CreateComputeEnvironmentInput) awsbatch.CreateComputeEnvironmentRequest {
+						return awsbatch.CreateComputeEnvironmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: computeEnvironment(),
+			},
+			want: want{
+				cr:  computeEnvironment(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.batch, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{
+					MockUpdateComputeEnvironment: func(input *awsbatch.UpdateComputeEnvironmentInput) awsbatch.UpdateComputeEnvironmentRequest {
+						if diff := cmp.Diff(aws.Int64(32), input.ComputeResources.MaxvCpus); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsbatch.UpdateComputeEnvironmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.UpdateComputeEnvironmentOutput{}},
+						}
+					},
+				},
+				cr: computeEnvironment(withMaxvCPUs(32), withObservation(awsbatch.CEStatusValid, awsbatch.CEStateEnabled)),
+			},
+			want: want{
+				cr: computeEnvironment(withMaxvCPUs(32), withObservation(awsbatch.CEStatusValid, awsbatch.CEStateEnabled)),
+			},
+		},
+		"Updating": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{},
+				cr:    computeEnvironment(withMaxvCPUs(32), withObservation(awsbatch.CEStatusUpdating, awsbatch.CEStateEnabled)),
+			},
+			want: want{
+				cr: computeEnvironment(withMaxvCPUs(32), withObservation(awsbatch.CEStatusUpdating, awsbatch.CEStateEnabled)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{
+					MockUpdateComputeEnvironment: func(*awsbatch.UpdateComputeEnvironmentInput) awsbatch.UpdateComputeEnvironmentRequest {
+						return awsbatch.UpdateComputeEnvironmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: computeEnvironment(withObservation(awsbatch.CEStatusValid, awsbatch.CEStateEnabled)),
+			},
+			want: want{
+				cr:  computeEnvironment(withObservation(awsbatch.CEStatusValid, awsbatch.CEStateEnabled)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.batch, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Disable": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{
+					MockUpdateComputeEnvironment: func(input *awsbatch.UpdateComputeEnvironmentInput) awsbatch.UpdateComputeEnvironmentRequest {
+						if input.State != awsbatch.CEStateDisabled {
+							t.Errorf("r: expected the compute environment to be disabled, got state %q", input.State)
+						}
+						return awsbatch.UpdateComputeEnvironmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.UpdateComputeEnvironmentOutput{}},
+						}
+					},
+				},
+				cr: computeEnvironment(withObservation(awsbatch.CEStatusValid, awsbatch.CEStateEnabled)),
+			},
+			want: want{
+				cr: computeEnvironment(withObservation(awsbatch.CEStatusValid, awsbatch.CEStateEnabled),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Successful": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{
+					MockDeleteComputeEnvironment: func(*awsbatch.DeleteComputeEnvironmentInput) awsbatch.DeleteComputeEnvironmentRequest {
+						return awsbatch.DeleteComputeEnvironmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.DeleteComputeEnvironmentOutput{}},
+						}
+					},
+				},
+				cr: computeEnvironment(withObservation(awsbatch.CEStatusValid, awsbatch.CEStateDisabled)),
+			},
+			want: want{
+				cr: computeEnvironment(withObservation(awsbatch.CEStatusValid, awsbatch.CEStateDisabled),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Updating": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{},
+				cr:    computeEnvironment(withObservation(awsbatch.CEStatusUpdating, awsbatch.CEStateDisabled)),
+			},
+			want: want{
+				cr: computeEnvironment(withObservation(awsbatch.CEStatusUpdating, awsbatch.CEStateDisabled),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				batch: &fake.MockComputeEnvironmentClient{
+					MockDeleteComputeEnvironment: func(*awsbatch.DeleteComputeEnvironmentInput) awsbatch.DeleteComputeEnvironmentRequest {
+						return awsbatch.DeleteComputeEnvironmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: computeEnvironment(withState(aws.String(disabled)), withObservation(awsbatch.CEStatusValid, awsbatch.CEStateDisabled)),
+			},
+			want: want{
+				cr: computeEnvironment(withState(aws.String(disabled)), withObservation(awsbatch.CEStatusValid, awsbatch.CEStateDisabled),
+					withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.batch, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobdefinition
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsbatch "github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/batch"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Batch JobDefinition resource"
+
+	errDescribe   = "failed to describe the JobDefinition resource"
+	errRegister   = "failed to register a revision of the JobDefinition resource"
+	errDeregister = "failed to deregister a revision of the JobDefinition resource"
+)
+
+// SetupJobDefinition adds a controller that reconciles JobDefinitions.
+func SetupJobDefinition(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.JobDefinitionGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.JobDefinition{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.JobDefinitionGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: batch.NewJobDefinitionClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) batch.JobDefinitionClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.JobDefinition)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client batch.JobDefinitionClient
+}
+
+// describe returns the active revisions of the job definition.
+func (e *external) describe(ctx context.Context, cr *v1alpha1.JobDefinition) ([]awsbatch.JobDefinition, error) {
+	rsp, err := e.client.DescribeJobDefinitionsRequest(&awsbatch.DescribeJobDefinitionsInput{
+		JobDefinitionName: aws.String(meta.GetExternalName(cr)),
+		Status:            aws.String(batch.JobDefinitionStatusActive),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.JobDefinitions, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.JobDefinition)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	revisions, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+	latest := batch.LatestRevision(revisions)
+	if latest == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = batch.GenerateJobDefinitionObservation(*latest)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: batch.IsJobDefinitionUpToDate(cr.Spec.ForProvider, *latest),
+	}, nil
+}
+
+// Create registers the first revision of the job definition.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.JobDefinition)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.RegisterJobDefinitionRequest(batch.GenerateRegisterJobDefinitionInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errRegister)
+}
+
+// Update registers a new revision of the job definition and deregisters the
+// previous one. Jobs that were submitted with the previous revision keep
+// running.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.JobDefinition)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	previous := cr.Status.AtProvider.ARN
+	rsp, err := e.client.RegisterJobDefinitionRequest(batch.GenerateRegisterJobDefinitionInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRegister)
+	}
+	cr.Status.AtProvider = v1alpha1.JobDefinitionObservation{
+		ARN:      aws.StringValue(rsp.JobDefinitionArn),
+		Revision: aws.Int64Value(rsp.Revision),
+	}
+	if previous == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.DeregisterJobDefinitionRequest(&awsbatch.DeregisterJobDefinitionInput{JobDefinition: aws.String(previous)}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errDeregister)
+}
+
+// Delete deregisters all active revisions of the job definition.
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.JobDefinition)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	revisions, err := e.describe(ctx, cr)
+	if err != nil {
+		return errors.Wrap(err, errDescribe)
+	}
+	for _, r := range revisions {
+		if _, err := e.client.DeregisterJobDefinitionRequest(&awsbatch.DeregisterJobDefinitionInput{JobDefinition: r.JobDefinitionArn}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDeregister)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,440 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobdefinition
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsbatch "github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/batch"
+	"github.com/crossplane/provider-aws/pkg/clients/batch/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	defName  = "sample-job"
+	defARN1  = "arn:aws:batch:us-east-1:123456789012:job-definition/sample-job:1"
+	defARN2  = "arn:aws:batch:us-east-1:123456789012:job-definition/sample-job:2"
+	image    = "busybox"
+	newImage = "alpine"
+	errBoom  = errors.New("boom")
+)
+
+type args struct {
+	batch batch.JobDefinitionClient
+	kube  *test.MockClient
+	cr    resource.Managed
+}
+
+type definitionModifier func(*v1alpha1.JobDefinition)
+
+func withConditions(c ...runtimev1alpha1.Condition) definitionModifier {
+	return func(r *v1alpha1.JobDefinition) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withImage(i string) definitionModifier {
+	return func(r *v1alpha1.JobDefinition) { r.Spec.ForProvider.ContainerProperties.Image = i }
+}
+
+func withObservation(arn string, revision int64) definitionModifier {
+	return func(r *v1alpha1.JobDefinition) {
+		r.Status.AtProvider = v1alpha1.JobDefinitionObservation{ARN: arn, Revision: revision}
+	}
+}
+
+func jobDefinition(m ...definitionModifier) *v1alpha1.JobDefinition {
+	cr := &v1alpha1.JobDefinition{
+		Spec: v1alpha1.JobDefinitionSpec{
+			ForProvider: v1alpha1.JobDefinitionParameters{
+				ContainerProperties: v1alpha1.ContainerProperties{
+					Image:  image,
+					VCPUs:  1,
+					Memory: 512,
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, defName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func revision(arn string, rev int64) awsbatch.JobDefinition {
+	return awsbatch.JobDefinition{
+		JobDefinitionArn:  aws.String(arn),
+		JobDefinitionName: aws.String(defName),
+		Revision:          aws.Int64(rev),
+		Status:            aws.String(batch.JobDefinitionStatusActive),
+		Type:              aws.String("container"),
+		ContainerProperties: &awsbatch.ContainerProperties{
+			Image:  aws.String(image),
+			Vcpus:  aws.Int64(1),
+			Memory: aws.Int64(512),
+		},
+	}
+}
+
+func describe(defs ...awsbatch.JobDefinition) func(*awsbatch.DescribeJobDefinitionsInput) awsbatch.DescribeJobDefinitionsRequest {
+	return func(*awsbatch.DescribeJobDefinitionsInput) awsbatch.DescribeJobDefinitionsRequest {
+		return awsbatch.DescribeJobDefinitionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.DescribeJobDefinitionsOutput{
+				JobDefinitions: defs,
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"LatestRevision": {
+			args: args{
+				batch: &fake.MockJobDefinitionClient{MockDescribeJobDefinitions: describe(revision(defARN1, 1), revision(defARN2, 2))},
+				cr:    jobDefinition(),
+			},
+			want: want{
+				cr: jobDefinition(withObservation(defARN2, 2), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				batch: &fake.MockJobDefinitionClient{MockDescribeJobDefinitions: describe(revision(defARN1, 1))},
+				cr:    jobDefinition(withImage(newImage)),
+			},
+			want: want{
+				cr: jobDefinition(withImage(newImage), withObservation(defARN1, 1), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NoActiveRevision": {
+			args: args{
+				batch: &fake.MockJobDefinitionClient{MockDescribeJobDefinitions: describe()},
+				cr:    jobDefinition(),
+			},
+			want: want{
+				cr: jobDefinition(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				batch: &fake.MockJobDefinitionClient{
+					MockDescribeJobDefinitions: func(*awsbatch.DescribeJobDefinitionsInput) awsbatch.DescribeJobDefinitionsRequest {
+						return awsbatch.DescribeJobDefinitionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: jobDefinition(),
+			},
+			want: want{
+				cr:  jobDefinition(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.batch, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				batch: &fake.MockJobDefinitionClient{
+					MockRegisterJobDefinition: func(input *awsbatch.RegisterJobDefinitionInput) awsbatch.RegisterJobDefinitionRequest {
+						if diff := cmp.Diff(aws.String(defName), input.JobDefinitionName); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsbatch.RegisterJobDefinitionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.RegisterJobDefinitionOutput{
+								JobDefinitionArn: aws.String(defARN1),
+								Revision:         aws.Int64(1),
+							}},
+						}
+					},
+				},
+				cr: jobDefinition(),
+			},
+			want: want{
+				cr: jobDefinition(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				batch: &fake.MockJobDefinitionClient{
+					MockRegisterJobDefinition: func(*awsbatch.RegisterJobDefinitionInput) awsbatch.RegisterJobDefinitionRequest {
+						return awsbatch.RegisterJobDefinitionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: jobDefinition(),
+			},
+			want: want{
+				cr:  jobDefinition(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errRegister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.batch, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	register := func(*awsbatch.RegisterJobDefinitionInput) awsbatch.RegisterJobDefinitionRequest {
+		return awsbatch.RegisterJobDefinitionRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.RegisterJobDefinitionOutput{
+				JobDefinitionArn: aws.String(defARN2),
+				Revision:         aws.Int64(2),
+			}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				batch: &fake.MockJobDefinitionClient{
+					MockRegisterJobDefinition: register,
+					MockDeregisterJobDefinition: func(input *awsbatch.DeregisterJobDefinitionInput) awsbatch.DeregisterJobDefinitionRequest {
+						if diff := cmp.Diff(aws.String(defARN1), input.JobDefinition); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsbatch.DeregisterJobDefinitionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.DeregisterJobDefinitionOutput{}},
+						}
+					},
+				},
+				cr: jobDefinition(withImage(newImage), withObservation(defARN1, 1)),
+			},
+			want: want{
+				cr: jobDefinition(withImage(newImage), withObservation(defARN2, 2)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"RegisterError": {
+			args: args{
+				batch: &fake.MockJobDefinitionClient{
+					MockRegisterJobDefinition: func(*awsbatch.RegisterJobDefinitionInput) awsbatch.RegisterJobDefinitionRequest {
+						return awsbatch.RegisterJobDefinitionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: jobDefinition(withObservation(defARN1, 1)),
+			},
+			want: want{
+				cr:  jobDefinition(withObservation(defARN1, 1)),
+				err: errors.Wrap(errBoom, errRegister),
+			},
+		},
+		"DeregisterError": {
+			args: args{
+				batch: &fake.MockJobDefinitionClient{
+					MockRegisterJobDefinition: register,
+					MockDeregisterJobDefinition: func(*awsbatch.DeregisterJobDefinitionInput) awsbatch.DeregisterJobDefinitionRequest {
+						return awsbatch.DeregisterJobDefinitionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: jobDefinition(withObservation(defARN1, 1)),
+			},
+			want: want{
+				cr:  jobDefinition(withObservation(defARN2, 2)),
+				err: errors.Wrap(errBoom, errDeregister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.batch, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				batch: &fake.MockJobDefinitionClient{
+					MockDescribeJobDefinitions: describe(revision(defARN1, 1), revision(defARN2, 2)),
+					MockDeregisterJobDefinition: func(*awsbatch.DeregisterJobDefinitionInput) awsbatch.DeregisterJobDefinitionRequest {
+						return awsbatch.DeregisterJobDefinitionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.DeregisterJobDefinitionOutput{}},
+						}
+					},
+				},
+				cr: jobDefinition(),
+			},
+			want: want{
+				cr: jobDefinition(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				batch: &fake.MockJobDefinitionClient{
+					MockDescribeJobDefinitions: describe(revision(defARN1, 1)),
+					MockDeregisterJobDefinition: func(*awsbatch.DeregisterJobDefinitionInput) awsbatch.DeregisterJobDefinitionRequest {
+						return awsbatch.DeregisterJobDefinitionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: jobDefinition(),
+			},
+			want: want{
+				cr:  jobDefinition(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDeregister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.batch, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobqueue
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsbatch "github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/batch"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Batch JobQueue resource"
+
+	errDescribe   = "failed to describe the JobQueue resource"
+	errCreate     = "failed to create the JobQueue resource"
+	errUpdate     = "failed to update the JobQueue resource"
+	errDisable    = "failed to disable the JobQueue resource"
+	errDelete     = "failed to delete the JobQueue resource"
+	errSpecUpdate = "cannot update spec of the JobQueue custom resource"
+)
+
+// SetupJobQueue adds a controller that reconciles JobQueues.
+func SetupJobQueue(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.JobQueueGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.JobQueue{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.JobQueueGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: batch.NewJobQueueClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) batch.JobQueueClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.JobQueue)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client batch.JobQueueClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.JobQueue)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.DescribeJobQueuesRequest(&awsbatch.DescribeJobQueuesInput{
+		JobQueues: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+	// Deleted job queues are still described for a while.
+	if len(rsp.JobQueues) == 0 || rsp.JobQueues[0].Status == awsbatch.JQStatusDeleted {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	observed := rsp.JobQueues[0]
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	batch.LateInitializeJobQueue(&cr.Spec.ForProvider, &observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = batch.GenerateJobQueueObservation(observed)
+	switch observed.Status {
+	case awsbatch.JQStatusValid, awsbatch.JQStatusUpdating:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsbatch.JQStatusCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsbatch.JQStatusDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable().WithMessage(aws.StringValue(observed.StatusReason)))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: batch.IsJobQueueUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.JobQueue)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateJobQueueRequest(batch.GenerateCreateJobQueueInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+// Update updates the priority, the state and the compute environments of
+// the job queue. Batch rejects updates while the job queue is being created
+// or updated.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.JobQueue)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	switch awsbatch.JQStatus(cr.Status.AtProvider.Status) {
+	case awsbatch.JQStatusCreating, awsbatch.JQStatusUpdating, awsbatch.JQStatusDeleting:
+		return managed.ExternalUpdate{}, nil
+	}
+
+	_, err := e.client.UpdateJobQueueRequest(batch.GenerateUpdateJobQueueInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+// Delete deletes the job queue. Batch only deletes disabled job queues, so an
+// enabled one is disabled first and deleted by a later reconcile once the
+// update finished.
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.JobQueue)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	switch awsbatch.JQStatus(cr.Status.AtProvider.Status) {
+	case awsbatch.JQStatusCreating, awsbatch.JQStatusUpdating, awsbatch.JQStatusDeleting:
+		return nil
+	}
+	name := aws.String(meta.GetExternalName(cr))
+	if cr.Status.AtProvider.State != string(awsbatch.JQStateDisabled) {
+		_, err := e.client.UpdateJobQueueRequest(&awsbatch.UpdateJobQueueInput{
+			JobQueue: name,
+			State:    awsbatch.JQStateDisabled,
+		}).Send(ctx)
+		return errors.Wrap(err, errDisable)
+	}
+	_, err := e.client.DeleteJobQueueRequest(&awsbatch.DeleteJobQueueInput{JobQueue: name}).Send(ctx)
+	return errors.Wrap(err, errDelete)
+}
@@ -0,0 +1,447 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobqueue
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsbatch "github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/batch"
+	"github.com/crossplane/provider-aws/pkg/clients/batch/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	queueName = "sample-queue"
+	queueARN  = "arn:aws:batch:us-east-1:123456789012:job-queue/sample-queue"
+	ceARN     = "arn:aws:batch:us-east-1:123456789012:compute-environment/sample-ce"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	batch batch.JobQueueClient
+	kube  *test.MockClient
+	cr    resource.Managed
+}
+
+type queueModifier func(*v1alpha1.JobQueue)
+
+func withConditions(c ...runtimev1alpha1.Condition) queueModifier {
+	return func(r *v1alpha1.JobQueue) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPriority(p int64) queueModifier {
+	return func(r *v1alpha1.JobQueue) { r.Spec.ForProvider.Priority = p }
+}
+
+func withObservation(status awsbatch.JQStatus, state awsbatch.JQState) queueModifier {
+	return func(r *v1alpha1.JobQueue) {
+		r.Status.AtProvider = v1alpha1.JobQueueObservation{
+			ARN:    queueARN,
+			State:  string(state),
+			Status: string(status),
+		}
+	}
+}
+
+func jobQueue(m ...queueModifier) *v1alpha1.JobQueue {
+	cr := &v1alpha1.JobQueue{
+		Spec: v1alpha1.JobQueueSpec{
+			ForProvider: v1alpha1.JobQueueParameters{
+				Priority: 1,
+				State:    aws.String(string(awsbatch.JQStateEnabled)),
+				ComputeEnvironmentOrder: []v1alpha1.ComputeEnvironmentOrder{
+					{Order: 1, ComputeEnvironmentARN: aws.String(ceARN)},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, queueName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(status awsbatch.JQStatus) func(*awsbatch.DescribeJobQueuesInput) awsbatch.DescribeJobQueuesRequest {
+	return func(*awsbatch.DescribeJobQueuesInput) awsbatch.DescribeJobQueuesRequest {
+		return awsbatch.DescribeJobQueuesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.DescribeJobQueuesOutput{
+				JobQueues: []awsbatch.JobQueueDetail{{
+					JobQueueArn:  aws.String(queueARN),
+					JobQueueName: aws.String(queueName),
+					Priority:     aws.Int64(1),
+					State:        awsbatch.JQStateEnabled,
+					Status:       status,
+					ComputeEnvironmentOrder: []awsbatch.ComputeEnvironmentOrder{
+						{Order: aws.Int64(1), ComputeEnvironment: aws.String(ceARN)},
+					},
+				}},
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Valid": {
+			args: args{
+				batch: &fake.MockJobQueueClient{MockDescribeJobQueues: describe(awsbatch.JQStatusValid)},
+				cr:    jobQueue(),
+			},
+			want: want{
+				cr: jobQueue(withObservation(awsbatch.JQStatusValid, awsbatch.JQStateEnabled),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deleting": {
+			args: args{
+				batch: &fake.MockJobQueueClient{MockDescribeJobQueues: describe(awsbatch.JQStatusDeleting)},
+				cr:    jobQueue(),
+			},
+			want: want{
+				cr: jobQueue(withObservation(awsbatch.JQStatusDeleting, awsbatch.JQStateEnabled),
+					withConditions(runtimev1alpha1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				batch: &fake.MockJobQueueClient{MockDescribeJobQueues: describe(awsbatch.JQStatusValid)},
+				cr:    jobQueue(withPriority(10)),
+			},
+			want: want{
+				cr: jobQueue(withPriority(10), withObservation(awsbatch.JQStatusValid, awsbatch.JQStateEnabled),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				batch: &fake.MockJobQueueClient{
+					MockDescribeJobQueues: func(*awsbatch.DescribeJobQueuesInput) awsbatch.DescribeJobQueuesRequest {
+						return awsbatch.DescribeJobQueuesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.DescribeJobQueuesOutput{}},
+						}
+					},
+				},
+				cr: jobQueue(),
+			},
+			want: want{
+				cr: jobQueue(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				batch: &fake.MockJobQueueClient{
+					MockDescribeJobQueues: func(*awsbatch.DescribeJobQueuesInput) awsbatch.DescribeJobQueuesRequest {
+						return awsbatch.DescribeJobQueuesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: jobQueue(),
+			},
+			want: want{
+				cr:  jobQueue(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.batch, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				batch: &fake.MockJobQueueClient{
+					MockCreateJobQueue: func(*awsbatch.CreateJobQueueInput) awsbatch.CreateJobQueueRequest {
+						return awsbatch.CreateJobQueueRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.CreateJobQueueOutput{
+								JobQueueArn: aws.String(queueARN),
+							}},
+						}
+					},
+				},
+				cr: jobQueue(),
+			},
+			want: want{
+				cr: jobQueue(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				batch: &fake.MockJobQueueClient{
+					MockCreateJobQueue: func(*awsbatch.CreateJobQueueInput) awsbatch.CreateJobQueueRequest {
+						return awsbatch.CreateJobQueueRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: jobQueue(),
+			},
+			want: want{
+				cr:  jobQueue(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.batch, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				batch: &fake.MockJobQueueClient{
+					MockUpdateJobQueue: func(input *awsbatch.UpdateJobQueueInput) awsbatch.UpdateJobQueueRequest {
+						if diff := cmp.Diff(aws.Int64(10), input.Priority); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsbatch.UpdateJobQueueRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.UpdateJobQueueOutput{}},
+						}
+					},
+				},
+				cr: jobQueue(withPriority(10), withObservation(awsbatch.JQStatusValid, awsbatch.JQStateEnabled)),
+			},
+			want: want{
+				cr: jobQueue(withPriority(10), withObservation(awsbatch.JQStatusValid, awsbatch.JQStateEnabled)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				batch: &fake.MockJobQueueClient{
+					MockUpdateJobQueue: func(*awsbatch.UpdateJobQueueInput) awsbatch.UpdateJobQueueRequest {
+						return awsbatch.UpdateJobQueueRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: jobQueue(withObservation(awsbatch.JQStatusValid, awsbatch.JQStateEnabled)),
+			},
+			want: want{
+				cr:  jobQueue(withObservation(awsbatch.JQStatusValid, awsbatch.JQStateEnabled)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.batch, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Disable": {
+			args: args{
+				batch: &fake.MockJobQueueClient{
+					MockUpdateJobQueue: func(input *awsbatch.UpdateJobQueueInput) awsbatch.UpdateJobQueueRequest {
+						if input.State != awsbatch.JQStateDisabled {
+							t.Errorf("r: expected the job queue to be disabled, got state %q", input.State)
+						}
+						return awsbatch.UpdateJobQueueRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.UpdateJobQueueOutput{}},
+						}
+					},
+				},
+				cr: jobQueue(withObservation(awsbatch.JQStatusValid, awsbatch.JQStateEnabled)),
+			},
+			want: want{
+				cr: jobQueue(withObservation(awsbatch.JQStatusValid, awsbatch.JQStateEnabled),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Successful": {
+			args: args{
+				batch: &fake.MockJobQueueClient{
+					MockDeleteJobQueue: func(*awsbatch.DeleteJobQueueInput) awsbatch.DeleteJobQueueRequest {
+						return awsbatch.DeleteJobQueueRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbatch.DeleteJobQueueOutput{}},
+						}
+					},
+				},
+				cr: jobQueue(withObservation(awsbatch.JQStatusValid, awsbatch.JQStateDisabled)),
+			},
+			want: want{
+				cr: jobQueue(withObservation(awsbatch.JQStatusValid, awsbatch.JQStateDisabled),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				batch: &fake.MockJobQueueClient{
+					MockDeleteJobQueue: func(*awsbatch.DeleteJobQueueInput) awsbatch.DeleteJobQueueRequest {
+						return awsbatch.DeleteJobQueueRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: jobQueue(withObservation(awsbatch.JQStatusValid, awsbatch.JQStateDisabled)),
+			},
+			want: want{
+				cr: jobQueue(withObservation(awsbatch.JQStatusValid, awsbatch.JQStateDisabled),
+					withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.batch, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
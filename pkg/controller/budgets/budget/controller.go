@@ -0,0 +1,335 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsbudgets "github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/budgets/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/budgets"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Budget resource"
+
+	errDescribe           = "failed to describe the Budget resource"
+	errListNotifications  = "failed to list the notifications of the Budget resource"
+	errListSubscribers    = "failed to list the subscribers of a notification of the Budget resource"
+	errCreate             = "failed to create the Budget resource"
+	errUpdate             = "failed to update the Budget resource"
+	errCreateNotification = "failed to create a notification of the Budget resource"
+	errDeleteNotification = "failed to delete a notification of the Budget resource"
+	errCreateSubscriber   = "failed to add a subscriber to a notification of the Budget resource"
+	errDeleteSubscriber   = "failed to remove a subscriber from a notification of the Budget resource"
+	errDelete             = "failed to delete the Budget resource"
+	errSpecUpdate         = "cannot update spec of the Budget custom resource"
+)
+
+// SetupBudget adds a controller that reconciles Budgets.
+func SetupBudget(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.BudgetGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Budget{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BudgetGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: budgets.NewBudgetClient}))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) budgets.BudgetClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, awsclients.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client budgets.BudgetClient
+}
+
+// accountID returns the ID of the account the given budget is created in.
+func (e *external) accountID(ctx context.Context, cr *v1alpha1.Budget) (string, error) {
+	if cr.Spec.ForProvider.AccountID != nil {
+		return *cr.Spec.ForProvider.AccountID, nil
+	}
+	return budgets.AccountID(ctx, e.client)
+}
+
+// notifications returns the notifications of the budget with the given name.
+func (e *external) notifications(ctx context.Context, accountID, name string) ([]awsbudgets.Notification, error) {
+	in := &awsbudgets.DescribeNotificationsForBudgetInput{AccountId: aws.String(accountID), BudgetName: aws.String(name)}
+	var notifications []awsbudgets.Notification
+	for {
+		rsp, err := e.client.DescribeNotificationsForBudgetRequest(in).Send(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, errListNotifications)
+		}
+		notifications = append(notifications, rsp.Notifications...)
+		if rsp.NextToken == nil {
+			return notifications, nil
+		}
+		in.NextToken = rsp.NextToken
+	}
+}
+
+// subscribers returns the subscribers of the given notification of the budget
+// with the given name.
+func (e *external) subscribers(ctx context.Context, accountID, name string, n awsbudgets.Notification) ([]awsbudgets.Subscriber, error) {
+	in := &awsbudgets.DescribeSubscribersForNotificationInput{AccountId: aws.String(accountID), BudgetName: aws.String(name), Notification: &n}
+	var subscribers []awsbudgets.Subscriber
+	for {
+		rsp, err := e.client.DescribeSubscribersForNotificationRequest(in).Send(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, errListSubscribers)
+		}
+		subscribers = append(subscribers, rsp.Subscribers...)
+		if rsp.NextToken == nil {
+			return subscribers, nil
+		}
+		in.NextToken = rsp.NextToken
+	}
+}
+
+// areNotificationsUpToDate returns whether the notifications of the given
+// budget and their subscribers match the desired ones.
+func (e *external) areNotificationsUpToDate(ctx context.Context, accountID string, cr *v1alpha1.Budget) (bool, error) {
+	observed, err := e.notifications(ctx, accountID, meta.GetExternalName(cr))
+	if err != nil {
+		return false, err
+	}
+	desired := budgets.GenerateNotificationsWithSubscribers(cr.Spec.ForProvider)
+	if len(observed) != len(desired) {
+		return false, nil
+	}
+	current := make(map[string]awsbudgets.Notification, len(observed))
+	for _, n := range observed {
+		current[budgets.NotificationKey(n)] = n
+	}
+	for _, d := range desired {
+		n, ok := current[budgets.NotificationKey(*d.Notification)]
+		if !ok {
+			return false, nil
+		}
+		subscribers, err := e.subscribers(ctx, accountID, meta.GetExternalName(cr), n)
+		if err != nil {
+			return false, err
+		}
+		if add, remove := budgets.DiffSubscribers(d.Subscribers, subscribers); len(add) != 0 || len(remove) != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Budget)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	accountID, err := e.accountID(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	rsp, err := e.client.DescribeBudgetRequest(&awsbudgets.DescribeBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(budgets.IsBudgetNotFound, err), errDescribe)
+	}
+	if rsp.Budget == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	cr.Spec.ForProvider.AccountID = awsclients.LateInitializeStringPtr(cr.Spec.ForProvider.AccountID, aws.String(accountID))
+	budgets.LateInitializeBudget(&cr.Spec.ForProvider, rsp.Budget)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = budgets.GenerateBudgetObservation(*rsp.Budget)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	if !budgets.IsBudgetDefinitionUpToDate(cr.Spec.ForProvider, *rsp.Budget) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+	upToDate, err := e.areNotificationsUpToDate(ctx, accountID, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Budget)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	accountID, err := e.accountID(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	_, err = e.client.CreateBudgetRequest(&awsbudgets.CreateBudgetInput{
+		AccountId:                    aws.String(accountID),
+		Budget:                       budgets.GenerateBudgetDefinition(meta.GetExternalName(cr), cr.Spec.ForProvider),
+		NotificationsWithSubscribers: budgets.GenerateNotificationsWithSubscribers(cr.Spec.ForProvider),
+	}).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Budget)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	accountID, err := e.accountID(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	name := meta.GetExternalName(cr)
+	if _, err := e.client.UpdateBudgetRequest(&awsbudgets.UpdateBudgetInput{
+		AccountId: aws.String(accountID),
+		NewBudget: budgets.GenerateBudgetDefinition(name, cr.Spec.ForProvider),
+	}).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	observed, err := e.notifications(ctx, accountID, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	desired := budgets.GenerateNotificationsWithSubscribers(cr.Spec.ForProvider)
+	wanted := make(map[string]bool, len(desired))
+	for _, d := range desired {
+		wanted[budgets.NotificationKey(*d.Notification)] = true
+	}
+	current := make(map[string]awsbudgets.Notification, len(observed))
+	for i := range observed {
+		n := observed[i]
+		current[budgets.NotificationKey(n)] = n
+		if wanted[budgets.NotificationKey(n)] {
+			continue
+		}
+		if _, err := e.client.DeleteNotificationRequest(&awsbudgets.DeleteNotificationInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(name),
+			Notification: &n,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteNotification)
+		}
+	}
+	for _, d := range desired {
+		n, ok := current[budgets.NotificationKey(*d.Notification)]
+		if !ok {
+			if _, err := e.client.CreateNotificationRequest(&awsbudgets.CreateNotificationInput{
+				AccountId:    aws.String(accountID),
+				BudgetName:   aws.String(name),
+				Notification: d.Notification,
+				Subscribers:  d.Subscribers,
+			}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errCreateNotification)
+			}
+			continue
+		}
+		if err := e.updateSubscribers(ctx, accountID, name, n, d.Subscribers); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// updateSubscribers adds and removes the subscribers of the given
+// notification to match the desired ones. Subscribers are added first since a
+// notification must always have at least one subscriber.
+func (e *external) updateSubscribers(ctx context.Context, accountID, name string, n awsbudgets.Notification, desired []awsbudgets.Subscriber) error {
+	observed, err := e.subscribers(ctx, accountID, name, n)
+	if err != nil {
+		return err
+	}
+	add, remove := budgets.DiffSubscribers(desired, observed)
+	for i := range add {
+		if _, err := e.client.CreateSubscriberRequest(&awsbudgets.CreateSubscriberInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(name),
+			Notification: &n,
+			Subscriber:   &add[i],
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateSubscriber)
+		}
+	}
+	for i := range remove {
+		if _, err := e.client.DeleteSubscriberRequest(&awsbudgets.DeleteSubscriberInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(name),
+			Notification: &n,
+			Subscriber:   &remove[i],
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDeleteSubscriber)
+		}
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Budget)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	accountID, err := e.accountID(ctx, cr)
+	if err != nil {
+		return err
+	}
+	_, err = e.client.DeleteBudgetRequest(&awsbudgets.DeleteBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(budgets.IsBudgetNotFound, err), errDelete)
+}
@@ -0,0 +1,556 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsbudgets "github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/budgets/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/budgets"
+	"github.com/crossplane/provider-aws/pkg/clients/budgets/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	accountID  = "123456789012"
+	budgetName = "team-platform-monthly"
+	email      = "finops@example.com"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	budgets budgets.BudgetClient
+	kube    *test.MockClient
+	cr      resource.Managed
+}
+
+type budgetModifier func(*v1alpha1.Budget)
+
+func withConditions(c ...runtimev1alpha1.Condition) budgetModifier {
+	return func(r *v1alpha1.Budget) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAccountID(id *string) budgetModifier {
+	return func(r *v1alpha1.Budget) { r.Spec.ForProvider.AccountID = id }
+}
+
+func withAmount(a string) budgetModifier {
+	return func(r *v1alpha1.Budget) { r.Spec.ForProvider.BudgetLimit.Amount = a }
+}
+
+func withThreshold(t string) budgetModifier {
+	return func(r *v1alpha1.Budget) { r.Spec.ForProvider.Notifications[0].Threshold = t }
+}
+
+func withObservation() budgetModifier {
+	return func(r *v1alpha1.Budget) {
+		r.Status.AtProvider = v1alpha1.BudgetObservation{ActualSpend: &v1alpha1.Spend{Amount: "42.5", Unit: "USD"}}
+	}
+}
+
+func budget(m ...budgetModifier) *v1alpha1.Budget {
+	cr := &v1alpha1.Budget{
+		Spec: v1alpha1.BudgetSpec{
+			ForProvider: v1alpha1.BudgetParameters{
+				AccountID:   aws.String(accountID),
+				BudgetType:  "COST",
+				TimeUnit:    "MONTHLY",
+				BudgetLimit: v1alpha1.Spend{Amount: "100", Unit: "USD"},
+				Notifications: []v1alpha1.Notification{{
+					NotificationType:         "ACTUAL",
+					ComparisonOperator:       "GREATER_THAN",
+					Threshold:                "80",
+					SubscriberEmailAddresses: []string{email},
+				}},
+			},
+		},
+	}
+	meta.SetExternalName(cr, budgetName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func notification(threshold float64) awsbudgets.Notification {
+	return awsbudgets.Notification{
+		NotificationType:   awsbudgets.NotificationTypeActual,
+		ComparisonOperator: awsbudgets.ComparisonOperatorGreaterThan,
+		Threshold:          aws.Float64(threshold),
+		ThresholdType:      awsbudgets.ThresholdTypePercentage,
+	}
+}
+
+func describeBudget(*awsbudgets.DescribeBudgetInput) awsbudgets.DescribeBudgetRequest {
+	return awsbudgets.DescribeBudgetRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbudgets.DescribeBudgetOutput{Budget: &awsbudgets.Budget{
+			BudgetName:      aws.String(budgetName),
+			BudgetType:      awsbudgets.BudgetTypeCost,
+			TimeUnit:        awsbudgets.TimeUnitMonthly,
+			BudgetLimit:     &awsbudgets.Spend{Amount: aws.String("100.0"), Unit: aws.String("USD")},
+			CalculatedSpend: &awsbudgets.CalculatedSpend{ActualSpend: &awsbudgets.Spend{Amount: aws.String("42.5"), Unit: aws.String("USD")}},
+		}}},
+	}
+}
+
+func describeNotifications(threshold float64) func(*awsbudgets.DescribeNotificationsForBudgetInput) awsbudgets.DescribeNotificationsForBudgetRequest {
+	return func(*awsbudgets.DescribeNotificationsForBudgetInput) awsbudgets.DescribeNotificationsForBudgetRequest {
+		return awsbudgets.DescribeNotificationsForBudgetRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbudgets.DescribeNotificationsForBudgetOutput{
+				Notifications: []awsbudgets.Notification{notification(threshold)},
+			}},
+		}
+	}
+}
+
+func describeSubscribers(address string) func(*awsbudgets.DescribeSubscribersForNotificationInput) awsbudgets.DescribeSubscribersForNotificationRequest {
+	return func(*awsbudgets.DescribeSubscribersForNotificationInput) awsbudgets.DescribeSubscribersForNotificationRequest {
+		return awsbudgets.DescribeSubscribersForNotificationRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbudgets.DescribeSubscribersForNotificationOutput{
+				Subscribers: []awsbudgets.Subscriber{{SubscriptionType: awsbudgets.SubscriptionTypeEmail, Address: aws.String(address)}},
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				budgets: &fake.MockBudgetClient{
+					MockDescribeBudget:                     describeBudget,
+					MockDescribeNotificationsForBudget:     describeNotifications(80),
+					MockDescribeSubscribersForNotification: describeSubscribers(email),
+				},
+				cr: budget(),
+			},
+			want: want{
+				cr: budget(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				budgets: &fake.MockBudgetClient{
+					MockGetCallerIdentity: func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+						return sts.GetCallerIdentityRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &sts.GetCallerIdentityOutput{Account: aws.String(accountID)}},
+						}
+					},
+					MockDescribeBudget:                     describeBudget,
+					MockDescribeNotificationsForBudget:     describeNotifications(80),
+					MockDescribeSubscribersForNotification: describeSubscribers(email),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   budget(withAccountID(nil)),
+			},
+			want: want{
+				cr: budget(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				budgets: &fake.MockBudgetClient{
+					MockDescribeBudget: describeBudget,
+				},
+				cr: budget(withAmount("200")),
+			},
+			want: want{
+				cr: budget(withAmount("200"), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"SubscribersNotUpToDate": {
+			args: args{
+				budgets: &fake.MockBudgetClient{
+					MockDescribeBudget:                     describeBudget,
+					MockDescribeNotificationsForBudget:     describeNotifications(80),
+					MockDescribeSubscribersForNotification: describeSubscribers("old@example.com"),
+				},
+				cr: budget(),
+			},
+			want: want{
+				cr: budget(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				budgets: &fake.MockBudgetClient{
+					MockDescribeBudget: func(*awsbudgets.DescribeBudgetInput) awsbudgets.DescribeBudgetRequest {
+						return awsbudgets.DescribeBudgetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsbudgets.ErrCodeNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: budget(),
+			},
+			want: want{
+				cr: budget(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				budgets: &fake.MockBudgetClient{
+					MockDescribeBudget: func(*awsbudgets.DescribeBudgetInput) awsbudgets.DescribeBudgetRequest {
+						return awsbudgets.DescribeBudgetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: budget(),
+			},
+			want: want{
+				cr:  budget(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.budgets, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				budgets: &fake.MockBudgetClient{
+					MockCreateBudget: func(input *awsbudgets.CreateBudgetInput) awsbudgets.CreateBudgetRequest {
+						if diff := cmp.Diff(aws.String(budgetName), input.Budget.BudgetName); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(1, len(input.NotificationsWithSubscribers)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsbudgets.CreateBudgetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbudgets.CreateBudgetOutput{}},
+						}
+					},
+				},
+				cr: budget(),
+			},
+			want: want{
+				cr: budget(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				budgets: &fake.MockBudgetClient{
+					MockCreateBudget: func(*awsbudgets.CreateBudgetInput) awsbudgets.CreateBudgetRequest {
+						return awsbudgets.CreateBudgetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: budget(),
+			},
+			want: want{
+				cr:  budget(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.budgets, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	updateBudget := func(*awsbudgets.UpdateBudgetInput) awsbudgets.UpdateBudgetRequest {
+		return awsbudgets.UpdateBudgetRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbudgets.UpdateBudgetOutput{}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplaceNotification": {
+			args: args{
+				budgets: &fake.MockBudgetClient{
+					MockUpdateBudget:                   updateBudget,
+					MockDescribeNotificationsForBudget: describeNotifications(50),
+					MockDeleteNotification: func(input *awsbudgets.DeleteNotificationInput) awsbudgets.DeleteNotificationRequest {
+						if diff := cmp.Diff(notification(50), *input.Notification); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsbudgets.DeleteNotificationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbudgets.DeleteNotificationOutput{}},
+						}
+					},
+					MockCreateNotification: func(input *awsbudgets.CreateNotificationInput) awsbudgets.CreateNotificationRequest {
+						if diff := cmp.Diff(notification(80), *input.Notification); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsbudgets.CreateNotificationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbudgets.CreateNotificationOutput{}},
+						}
+					},
+				},
+				cr: budget(),
+			},
+			want: want{
+				cr: budget(),
+			},
+		},
+		"ReplaceSubscriber": {
+			args: args{
+				budgets: &fake.MockBudgetClient{
+					MockUpdateBudget:                       updateBudget,
+					MockDescribeNotificationsForBudget:     describeNotifications(80),
+					MockDescribeSubscribersForNotification: describeSubscribers("old@example.com"),
+					MockCreateSubscriber: func(input *awsbudgets.CreateSubscriberInput) awsbudgets.CreateSubscriberRequest {
+						if diff := cmp.Diff(aws.String(email), input.Subscriber.Address); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsbudgets.CreateSubscriberRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbudgets.CreateSubscriberOutput{}},
+						}
+					},
+					MockDeleteSubscriber: func(input *awsbudgets.DeleteSubscriberInput) awsbudgets.DeleteSubscriberRequest {
+						if diff := cmp.Diff(aws.String("old@example.com"), input.Subscriber.Address); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsbudgets.DeleteSubscriberRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbudgets.DeleteSubscriberOutput{}},
+						}
+					},
+				},
+				cr: budget(),
+			},
+			want: want{
+				cr: budget(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				budgets: &fake.MockBudgetClient{
+					MockUpdateBudget: func(*awsbudgets.UpdateBudgetInput) awsbudgets.UpdateBudgetRequest {
+						return awsbudgets.UpdateBudgetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: budget(withThreshold("90")),
+			},
+			want: want{
+				cr:  budget(withThreshold("90")),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.budgets, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				budgets: &fake.MockBudgetClient{
+					MockDeleteBudget: func(*awsbudgets.DeleteBudgetInput) awsbudgets.DeleteBudgetRequest {
+						return awsbudgets.DeleteBudgetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsbudgets.DeleteBudgetOutput{}},
+						}
+					},
+				},
+				cr: budget(),
+			},
+			want: want{
+				cr: budget(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				budgets: &fake.MockBudgetClient{
+					MockDeleteBudget: func(*awsbudgets.DeleteBudgetInput) awsbudgets.DeleteBudgetRequest {
+						return awsbudgets.DeleteBudgetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsbudgets.ErrCodeNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: budget(),
+			},
+			want: want{
+				cr: budget(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				budgets: &fake.MockBudgetClient{
+					MockDeleteBudget: func(*awsbudgets.DeleteBudgetInput) awsbudgets.DeleteBudgetRequest {
+						return awsbudgets.DeleteBudgetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: budget(),
+			},
+			want: want{
+				cr:  budget(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.budgets, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
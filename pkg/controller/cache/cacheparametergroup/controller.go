@@ -0,0 +1,211 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheparametergroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+)
+
+// Error strings.
+const (
+	errNotParameterGroup      = "managed resource is not a Parameter Group"
+	errDescribeParameterGroup = "cannot describe Parameter Group"
+	errNotOne                 = "expected exactly one Parameter Group"
+	errDescribeParameters     = "cannot describe the parameters of Parameter Group"
+	errCreateParameterGroup   = "cannot create Parameter Group"
+	errModifyParameterGroup   = "cannot modify the parameters of Parameter Group"
+	errResetParameterGroup    = "cannot reset the parameters of Parameter Group"
+	errDeleteParameterGroup   = "cannot delete Parameter Group"
+
+	// maxParameters is the maximum number of parameters that can be
+	// modified or reset in a single request.
+	maxParameters = 20
+)
+
+// SetupCacheParameterGroup adds a controller that reconciles ParameterGroups.
+func SetupCacheParameterGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.CacheParameterGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.CacheParameterGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CacheParameterGroupGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}, awsclients.DeletionTierAttachment)))),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) elasticache.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return nil, errors.New(errNotParameterGroup)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client elasticache.Client
+}
+
+// parameters returns the parameters of the Parameter Group with the given
+// name that are modified from their default value.
+func (e *external) parameters(ctx context.Context, name string) ([]awscache.Parameter, error) {
+	var params []awscache.Parameter
+	in := &awscache.DescribeCacheParametersInput{
+		CacheParameterGroupName: aws.String(name),
+		Source:                  aws.String(elasticache.ParameterSourceUser),
+	}
+	for {
+		out, err := e.client.DescribeCacheParametersRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, out.Parameters...)
+		if out.Marker == nil {
+			break
+		}
+		in.Marker = out.Marker
+	}
+	return params, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotParameterGroup)
+	}
+
+	resp, err := e.client.DescribeCacheParameterGroupsRequest(&awscache.DescribeCacheParameterGroupsInput{
+		CacheParameterGroupName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elasticache.IsParameterGroupNotFound, err), errDescribeParameterGroup)
+	}
+	if len(resp.CacheParameterGroups) != 1 {
+		return managed.ExternalObservation{}, errors.New(errNotOne)
+	}
+
+	cr.Status.AtProvider = elasticache.GenerateParameterGroupObservation(resp.CacheParameterGroups[0])
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	params, err := e.parameters(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeParameters)
+	}
+	modify, reset := elasticache.DiffParameters(cr.Spec.ForProvider.Parameters, params)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(modify) == 0 && len(reset) == 0,
+	}, nil
+}
+
+// Create creates the Parameter Group. Its parameters are set by the
+// subsequent update.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotParameterGroup)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	_, err := e.client.CreateCacheParameterGroupRequest(elasticache.GenerateCreateCacheParameterGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateParameterGroup)
+}
+
+// Update resets the parameters that are no longer desired to their default
+// value and modifies the ones that differ from the desired value.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotParameterGroup)
+	}
+
+	name := aws.String(meta.GetExternalName(cr))
+	params, err := e.parameters(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeParameters)
+	}
+	modify, reset := elasticache.DiffParameters(cr.Spec.ForProvider.Parameters, params)
+	for len(reset) > 0 {
+		n := len(reset)
+		if n > maxParameters {
+			n = maxParameters
+		}
+		if _, err := e.client.ResetCacheParameterGroupRequest(&awscache.ResetCacheParameterGroupInput{CacheParameterGroupName: name, ParameterNameValues: reset[:n]}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errResetParameterGroup)
+		}
+		reset = reset[n:]
+	}
+	for len(modify) > 0 {
+		n := len(modify)
+		if n > maxParameters {
+			n = maxParameters
+		}
+		if _, err := e.client.ModifyCacheParameterGroupRequest(&awscache.ModifyCacheParameterGroupInput{CacheParameterGroupName: name, ParameterNameValues: modify[:n]}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyParameterGroup)
+		}
+		modify = modify[n:]
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return errors.New(errNotParameterGroup)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteCacheParameterGroupRequest(&awscache.DeleteCacheParameterGroupInput{
+		CacheParameterGroupName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(elasticache.IsParameterGroupNotFound, err), errDeleteParameterGroup)
+}
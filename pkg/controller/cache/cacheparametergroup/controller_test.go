@@ -0,0 +1,405 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheparametergroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+)
+
+var (
+	pgARN = "arn:aws:elasticache:us-east-1:123456789012:parametergroup:some-group"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	cache elasticache.Client
+	cr    *v1alpha1.CacheParameterGroup
+}
+
+type cpgModifier func(*v1alpha1.CacheParameterGroup)
+
+func withConditions(c ...runtimev1alpha1.Condition) cpgModifier {
+	return func(r *v1alpha1.CacheParameterGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withParameters(p ...v1alpha1.ParameterNameValue) cpgModifier {
+	return func(r *v1alpha1.CacheParameterGroup) { r.Spec.ForProvider.Parameters = p }
+}
+
+func withARN(arn string) cpgModifier {
+	return func(r *v1alpha1.CacheParameterGroup) { r.Status.AtProvider.ARN = arn }
+}
+
+func cpg(m ...cpgModifier) *v1alpha1.CacheParameterGroup {
+	cr := &v1alpha1.CacheParameterGroup{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeGroup(*awscache.DescribeCacheParameterGroupsInput) awscache.DescribeCacheParameterGroupsRequest {
+	return awscache.DescribeCacheParameterGroupsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.DescribeCacheParameterGroupsOutput{
+			CacheParameterGroups: []awscache.CacheParameterGroup{{ARN: aws.String(pgARN)}},
+		}},
+	}
+}
+
+func describeParameters(p ...awscache.Parameter) func(*awscache.DescribeCacheParametersInput) awscache.DescribeCacheParametersRequest {
+	return func(*awscache.DescribeCacheParametersInput) awscache.DescribeCacheParametersRequest {
+		return awscache.DescribeCacheParametersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.DescribeCacheParametersOutput{Parameters: p}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.CacheParameterGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: describeGroup,
+					MockDescribeCacheParametersRequest: describeParameters(awscache.Parameter{
+						ParameterName:  aws.String("maxmemory-policy"),
+						ParameterValue: aws.String("allkeys-lru"),
+					}),
+				},
+				cr: cpg(withParameters(v1alpha1.ParameterNameValue{ParameterName: "maxmemory-policy", ParameterValue: "allkeys-lru"})),
+			},
+			want: want{
+				cr: cpg(withParameters(v1alpha1.ParameterNameValue{ParameterName: "maxmemory-policy", ParameterValue: "allkeys-lru"}),
+					withARN(pgARN), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ParameterDrift": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: describeGroup,
+					MockDescribeCacheParametersRequest: describeParameters(awscache.Parameter{
+						ParameterName:  aws.String("maxmemory-policy"),
+						ParameterValue: aws.String("volatile-lru"),
+					}),
+				},
+				cr: cpg(withParameters(v1alpha1.ParameterNameValue{ParameterName: "maxmemory-policy", ParameterValue: "allkeys-lru"})),
+			},
+			want: want{
+				cr: cpg(withParameters(v1alpha1.ParameterNameValue{ParameterName: "maxmemory-policy", ParameterValue: "allkeys-lru"}),
+					withARN(pgARN), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: func(*awscache.DescribeCacheParameterGroupsInput) awscache.DescribeCacheParameterGroupsRequest {
+						return awscache.DescribeCacheParameterGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awscache.ErrCodeCacheParameterGroupNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: cpg(),
+			},
+			want: want{
+				cr: cpg(),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: func(*awscache.DescribeCacheParameterGroupsInput) awscache.DescribeCacheParameterGroupsRequest {
+						return awscache.DescribeCacheParameterGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cpg(),
+			},
+			want: want{
+				cr:  cpg(),
+				err: errors.Wrap(errBoom, errDescribeParameterGroup),
+			},
+		},
+		"DescribeParametersFail": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: describeGroup,
+					MockDescribeCacheParametersRequest: func(*awscache.DescribeCacheParametersInput) awscache.DescribeCacheParametersRequest {
+						return awscache.DescribeCacheParametersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cpg(),
+			},
+			want: want{
+				cr:  cpg(withARN(pgARN), withConditions(runtimev1alpha1.Available())),
+				err: errors.Wrap(errBoom, errDescribeParameters),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CacheParameterGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cache: &fake.MockClient{
+					MockCreateCacheParameterGroupRequest: func(*awscache.CreateCacheParameterGroupInput) awscache.CreateCacheParameterGroupRequest {
+						return awscache.CreateCacheParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.CreateCacheParameterGroupOutput{}},
+						}
+					},
+				},
+				cr: cpg(),
+			},
+			want: want{
+				cr: cpg(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				cache: &fake.MockClient{
+					MockCreateCacheParameterGroupRequest: func(*awscache.CreateCacheParameterGroupInput) awscache.CreateCacheParameterGroupRequest {
+						return awscache.CreateCacheParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cpg(),
+			},
+			want: want{
+				cr:  cpg(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreateParameterGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheParametersRequest: describeParameters(awscache.Parameter{
+						ParameterName:  aws.String("timeout"),
+						ParameterValue: aws.String("300"),
+					}),
+					MockResetCacheParameterGroupRequest: func(in *awscache.ResetCacheParameterGroupInput) awscache.ResetCacheParameterGroupRequest {
+						if diff := cmp.Diff([]awscache.ParameterNameValue{{ParameterName: aws.String("timeout")}}, in.ParameterNameValues); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscache.ResetCacheParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.ResetCacheParameterGroupOutput{}},
+						}
+					},
+					MockModifyCacheParameterGroupRequest: func(in *awscache.ModifyCacheParameterGroupInput) awscache.ModifyCacheParameterGroupRequest {
+						want := []awscache.ParameterNameValue{{ParameterName: aws.String("maxmemory-policy"), ParameterValue: aws.String("allkeys-lru")}}
+						if diff := cmp.Diff(want, in.ParameterNameValues); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscache.ModifyCacheParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.ModifyCacheParameterGroupOutput{}},
+						}
+					},
+				},
+				cr: cpg(withParameters(v1alpha1.ParameterNameValue{ParameterName: "maxmemory-policy", ParameterValue: "allkeys-lru"})),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheParametersRequest: describeParameters(),
+					MockModifyCacheParameterGroupRequest: func(*awscache.ModifyCacheParameterGroupInput) awscache.ModifyCacheParameterGroupRequest {
+						return awscache.ModifyCacheParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cpg(withParameters(v1alpha1.ParameterNameValue{ParameterName: "maxmemory-policy", ParameterValue: "allkeys-lru"})),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errModifyParameterGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CacheParameterGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDeleteCacheParameterGroupRequest: func(*awscache.DeleteCacheParameterGroupInput) awscache.DeleteCacheParameterGroupRequest {
+						return awscache.DeleteCacheParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.DeleteCacheParameterGroupOutput{}},
+						}
+					},
+				},
+				cr: cpg(),
+			},
+			want: want{
+				cr: cpg(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDeleteCacheParameterGroupRequest: func(*awscache.DeleteCacheParameterGroupInput) awscache.DeleteCacheParameterGroupRequest {
+						return awscache.DeleteCacheParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awscache.ErrCodeCacheParameterGroupNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: cpg(),
+			},
+			want: want{
+				cr: cpg(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDeleteCacheParameterGroupRequest: func(*awscache.DeleteCacheParameterGroupInput) awscache.DeleteCacheParameterGroupRequest {
+						return awscache.DeleteCacheParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cpg(),
+			},
+			want: want{
+				cr:  cpg(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteParameterGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
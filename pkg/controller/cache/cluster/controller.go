@@ -56,7 +56,8 @@ func SetupCacheCluster(mgr ctrl.Manager, l logging.Logger) error {
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CacheClusterGroupVersionKind),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithMaintenanceWindow(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}, awscommon.DeletionTierWorkload)), mgr.GetClient()))),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), awscommon.NewMaintenanceWindowInitializer(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		))
@@ -121,6 +122,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
+	if !upToDate && elasticache.IsClusterUpdateDisruptive(&cr.Spec.ForProvider, &cluster) {
+		awscommon.MarkUpdateDisruptive(ctx)
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
@@ -61,8 +61,8 @@ func SetupReplicationGroup(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1beta1.ReplicationGroup{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.ReplicationGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithMaintenanceWindow(awsclients.WithCreateGracePeriod(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}, awsclients.DeletionTierWorkload)), mgr.GetClient()))),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient()), awsclients.NewMaintenanceWindowInitializer(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -136,6 +136,10 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		cr.Status.SetConditions(runtimev1alpha1.Unavailable())
 	}
 
+	if elasticache.ReplicationGroupNeedsDisruptiveUpdate(cr.Spec.ForProvider, rg, ccList) {
+		awsclients.MarkUpdateDisruptive(ctx)
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  !elasticache.ReplicationGroupNeedsUpdate(cr.Spec.ForProvider, rg, ccList),
@@ -0,0 +1,180 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscloudtrail "github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cloudtrail/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudtrail"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Trail resource"
+
+	errGet          = "failed to get the Trail resource"
+	errGetStatus    = "failed to get the status of the Trail resource"
+	errGetSelectors = "failed to get the event selectors of the Trail resource"
+	errCreate       = "failed to create the Trail resource"
+	errUpdate       = "failed to update the Trail resource"
+	errPutSelectors = "failed to put the event selectors of the Trail resource"
+	errStartLogging = "failed to start logging for the Trail resource"
+	errStopLogging  = "failed to stop logging for the Trail resource"
+	errDelete       = "failed to delete the Trail resource"
+	errSpecUpdate   = "cannot update spec of Trail custom resource"
+)
+
+// SetupTrail adds a controller that reconciles Trails.
+func SetupTrail(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.TrailGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Trail{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TrailGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: cloudtrail.NewTrailClient}))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) cloudtrail.TrailClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Trail)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client cloudtrail.TrailClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Trail)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	name := aws.String(meta.GetExternalName(cr))
+	res, err := e.client.GetTrailRequest(&awscloudtrail.GetTrailInput{Name: name}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(cloudtrail.IsNotFound, err), errGet)
+	}
+	status, err := e.client.GetTrailStatusRequest(&awscloudtrail.GetTrailStatusInput{Name: name}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetStatus)
+	}
+	selectors, err := e.client.GetEventSelectorsRequest(&awscloudtrail.GetEventSelectorsInput{TrailName: name}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSelectors)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	cloudtrail.LateInitializeTrail(&cr.Spec.ForProvider, res.Trail)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+	cr.Status.AtProvider = cloudtrail.GenerateTrailObservation(*res.Trail, *status.GetTrailStatusOutput)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cloudtrail.IsTrailUpToDate(cr.Spec.ForProvider, *res.Trail, aws.BoolValue(status.IsLogging), selectors.EventSelectors),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Trail)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateTrailRequest(cloudtrail.GenerateCreateTrailInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Trail)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	name := meta.GetExternalName(cr)
+	if _, err := e.client.UpdateTrailRequest(cloudtrail.GenerateUpdateTrailInput(name, cr.Spec.ForProvider)).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	if len(cr.Spec.ForProvider.EventSelectors) != 0 {
+		if _, err := e.client.PutEventSelectorsRequest(cloudtrail.GeneratePutEventSelectorsInput(name, cr.Spec.ForProvider)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPutSelectors)
+		}
+	}
+
+	// Logging is started by default as a trail does not record any events
+	// after creation otherwise.
+	if cr.Spec.ForProvider.EnableLogging == nil || aws.BoolValue(cr.Spec.ForProvider.EnableLogging) {
+		_, err := e.client.StartLoggingRequest(&awscloudtrail.StartLoggingInput{Name: aws.String(name)}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errStartLogging)
+	}
+	_, err := e.client.StopLoggingRequest(&awscloudtrail.StopLoggingInput{Name: aws.String(name)}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errStopLogging)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Trail)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteTrailRequest(&awscloudtrail.DeleteTrailInput{
+		Name: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(cloudtrail.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,439 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trail
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscloudtrail "github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudtrail/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudtrail"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudtrail/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	trailName  = "some-trail"
+	trailARN   = "some-arn"
+	bucketName = "some-bucket"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	cloudtrail cloudtrail.TrailClient
+	cr         resource.Managed
+}
+
+type trailModifier func(*v1alpha1.Trail)
+
+func withConditions(c ...runtimev1alpha1.Condition) trailModifier {
+	return func(r *v1alpha1.Trail) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withEnableLogging(b bool) trailModifier {
+	return func(r *v1alpha1.Trail) { r.Spec.ForProvider.EnableLogging = aws.Bool(b) }
+}
+
+func withStatus(isLogging bool) trailModifier {
+	return func(r *v1alpha1.Trail) {
+		r.Status.AtProvider = v1alpha1.TrailObservation{TrailARN: trailARN, IsLogging: isLogging}
+	}
+}
+
+func trail(m ...trailModifier) *v1alpha1.Trail {
+	cr := &v1alpha1.Trail{
+		Spec: v1alpha1.TrailSpec{
+			ForProvider: v1alpha1.TrailParameters{
+				S3BucketName:               aws.String(bucketName),
+				IsMultiRegionTrail:         aws.Bool(true),
+				IsOrganizationTrail:        aws.Bool(false),
+				IncludeGlobalServiceEvents: aws.Bool(true),
+				EnableLogFileValidation:    aws.Bool(true),
+			},
+		},
+	}
+	meta.SetExternalName(cr, trailName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getTrail(*awscloudtrail.GetTrailInput) awscloudtrail.GetTrailRequest {
+	return awscloudtrail.GetTrailRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudtrail.GetTrailOutput{
+			Trail: &awscloudtrail.Trail{
+				Name:                       aws.String(trailName),
+				TrailARN:                   aws.String(trailARN),
+				S3BucketName:               aws.String(bucketName),
+				IsMultiRegionTrail:         aws.Bool(true),
+				IsOrganizationTrail:        aws.Bool(false),
+				IncludeGlobalServiceEvents: aws.Bool(true),
+				LogFileValidationEnabled:   aws.Bool(true),
+			},
+		}},
+	}
+}
+
+func getTrailStatus(isLogging bool) func(*awscloudtrail.GetTrailStatusInput) awscloudtrail.GetTrailStatusRequest {
+	return func(*awscloudtrail.GetTrailStatusInput) awscloudtrail.GetTrailStatusRequest {
+		return awscloudtrail.GetTrailStatusRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudtrail.GetTrailStatusOutput{
+				IsLogging: aws.Bool(isLogging),
+			}},
+		}
+	}
+}
+
+func getEventSelectors(*awscloudtrail.GetEventSelectorsInput) awscloudtrail.GetEventSelectorsRequest {
+	return awscloudtrail.GetEventSelectorsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudtrail.GetEventSelectorsOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				cloudtrail: &fake.MockTrailClient{
+					MockGetTrail:          getTrail,
+					MockGetTrailStatus:    getTrailStatus(true),
+					MockGetEventSelectors: getEventSelectors,
+				},
+				cr: trail(),
+			},
+			want: want{
+				cr: trail(withStatus(true), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotLogging": {
+			args: args{
+				cloudtrail: &fake.MockTrailClient{
+					MockGetTrail:          getTrail,
+					MockGetTrailStatus:    getTrailStatus(false),
+					MockGetEventSelectors: getEventSelectors,
+				},
+				cr: trail(),
+			},
+			want: want{
+				cr: trail(withStatus(false), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				cloudtrail: &fake.MockTrailClient{
+					MockGetTrail: func(*awscloudtrail.GetTrailInput) awscloudtrail.GetTrailRequest {
+						return awscloudtrail.GetTrailRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: trail(),
+			},
+			want: want{
+				cr:  trail(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+		"StatusError": {
+			args: args{
+				cloudtrail: &fake.MockTrailClient{
+					MockGetTrail: getTrail,
+					MockGetTrailStatus: func(*awscloudtrail.GetTrailStatusInput) awscloudtrail.GetTrailStatusRequest {
+						return awscloudtrail.GetTrailStatusRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: trail(),
+			},
+			want: want{
+				cr:  trail(),
+				err: errors.Wrap(errBoom, errGetStatus),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cloudtrail}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				cloudtrail: &fake.MockTrailClient{
+					MockCreateTrail: func(*awscloudtrail.CreateTrailInput) awscloudtrail.CreateTrailRequest {
+						return awscloudtrail.CreateTrailRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudtrail.CreateTrailOutput{}},
+						}
+					},
+				},
+				cr: trail(),
+			},
+			want: want{
+				cr: trail(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				cloudtrail: &fake.MockTrailClient{
+					MockCreateTrail: func(*awscloudtrail.CreateTrailInput) awscloudtrail.CreateTrailRequest {
+						return awscloudtrail.CreateTrailRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: trail(),
+			},
+			want: want{
+				cr:  trail(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cloudtrail}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	updateTrail := func(*awscloudtrail.UpdateTrailInput) awscloudtrail.UpdateTrailRequest {
+		return awscloudtrail.UpdateTrailRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudtrail.UpdateTrailOutput{}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"StartLogging": {
+			args: args{
+				cloudtrail: &fake.MockTrailClient{
+					MockUpdateTrail: updateTrail,
+					MockStartLogging: func(*awscloudtrail.StartLoggingInput) awscloudtrail.StartLoggingRequest {
+						return awscloudtrail.StartLoggingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudtrail.StartLoggingOutput{}},
+						}
+					},
+				},
+				cr: trail(),
+			},
+		},
+		"StopLogging": {
+			args: args{
+				cloudtrail: &fake.MockTrailClient{
+					MockUpdateTrail: updateTrail,
+					MockStopLogging: func(*awscloudtrail.StopLoggingInput) awscloudtrail.StopLoggingRequest {
+						return awscloudtrail.StopLoggingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudtrail.StopLoggingOutput{}},
+						}
+					},
+				},
+				cr: trail(withEnableLogging(false)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				cloudtrail: &fake.MockTrailClient{
+					MockUpdateTrail: func(*awscloudtrail.UpdateTrailInput) awscloudtrail.UpdateTrailRequest {
+						return awscloudtrail.UpdateTrailRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: trail(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cloudtrail}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				cloudtrail: &fake.MockTrailClient{
+					MockDeleteTrail: func(*awscloudtrail.DeleteTrailInput) awscloudtrail.DeleteTrailRequest {
+						return awscloudtrail.DeleteTrailRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudtrail.DeleteTrailOutput{}},
+						}
+					},
+				},
+				cr: trail(),
+			},
+			want: want{
+				cr: trail(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				cloudtrail: &fake.MockTrailClient{
+					MockDeleteTrail: func(*awscloudtrail.DeleteTrailInput) awscloudtrail.DeleteTrailRequest {
+						return awscloudtrail.DeleteTrailRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: trail(),
+			},
+			want: want{
+				cr:  trail(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cloudtrail}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anomalydetector
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscloudwatch "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatch"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an AnomalyDetector resource"
+
+	errDescribe   = "failed to describe the AnomalyDetector resource"
+	errPut        = "failed to put the AnomalyDetector resource"
+	errDelete     = "failed to delete the AnomalyDetector resource"
+	errSpecUpdate = "cannot update spec of AnomalyDetector custom resource"
+)
+
+// SetupAnomalyDetector adds a controller that reconciles AnomalyDetectors.
+func SetupAnomalyDetector(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.AnomalyDetectorGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.AnomalyDetector{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AnomalyDetectorGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: cloudwatch.NewAnomalyDetectorClient}))),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) cloudwatch.AnomalyDetectorClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AnomalyDetector)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client cloudwatch.AnomalyDetectorClient
+}
+
+func (e *external) describe(ctx context.Context, p v1alpha1.AnomalyDetectorParameters) (*awscloudwatch.AnomalyDetector, error) {
+	in := cloudwatch.GenerateDescribeAnomalyDetectorsInput(p)
+	for {
+		res, err := e.client.DescribeAnomalyDetectorsRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if d := cloudwatch.FindAnomalyDetector(p, res.AnomalyDetectors); d != nil {
+			return d, nil
+		}
+		if aws.StringValue(res.NextToken) == "" {
+			return nil, nil
+		}
+		in.NextToken = res.NextToken
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.AnomalyDetector)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	d, err := e.describe(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+	if d == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	cloudwatch.LateInitializeAnomalyDetector(&cr.Spec.ForProvider, d)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+	cr.Status.AtProvider = cloudwatch.GenerateAnomalyDetectorObservation(*d)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cloudwatch.IsAnomalyDetectorUpToDate(cr.Spec.ForProvider, *d),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.AnomalyDetector)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.PutAnomalyDetectorRequest(cloudwatch.GeneratePutAnomalyDetectorInput(cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errPut)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.AnomalyDetector)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// PutAnomalyDetector replaces the configuration of an existing detector.
+	_, err := e.client.PutAnomalyDetectorRequest(cloudwatch.GeneratePutAnomalyDetectorInput(cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errPut)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.AnomalyDetector)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteAnomalyDetectorRequest(cloudwatch.GenerateDeleteAnomalyDetectorInput(cr.Spec.ForProvider)).Send(ctx)
+	return errors.Wrap(resource.Ignore(cloudwatch.IsNotFound, err), errDelete)
+}
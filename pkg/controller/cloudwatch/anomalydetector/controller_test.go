@@ -0,0 +1,448 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anomalydetector
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awscloudwatch "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatch"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatch/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	namespace  = "AWS/EC2"
+	metricName = "CPUUtilization"
+	stat       = "Average"
+	timezone   = "Europe/Berlin"
+	nextToken  = "next"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	cloudwatch cloudwatch.AnomalyDetectorClient
+	kube       *test.MockClient
+	cr         resource.Managed
+}
+
+type detectorModifier func(*v1alpha1.AnomalyDetector)
+
+func withConditions(c ...runtimev1alpha1.Condition) detectorModifier {
+	return func(r *v1alpha1.AnomalyDetector) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTimezone(tz string) detectorModifier {
+	return func(r *v1alpha1.AnomalyDetector) {
+		r.Spec.ForProvider.Configuration = &v1alpha1.AnomalyDetectorConfiguration{MetricTimezone: aws.String(tz)}
+	}
+}
+
+func withStateValue(s string) detectorModifier {
+	return func(r *v1alpha1.AnomalyDetector) { r.Status.AtProvider.StateValue = s }
+}
+
+func anomalyDetector(m ...detectorModifier) *v1alpha1.AnomalyDetector {
+	cr := &v1alpha1.AnomalyDetector{
+		Spec: v1alpha1.AnomalyDetectorSpec{
+			ForProvider: v1alpha1.AnomalyDetectorParameters{
+				Namespace:  namespace,
+				MetricName: metricName,
+				Stat:       stat,
+				Dimensions: []v1alpha1.Dimension{{Name: "InstanceId", Value: "i-1234"}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(statistic string, tz *string) awscloudwatch.AnomalyDetector {
+	return awscloudwatch.AnomalyDetector{
+		Namespace:     aws.String(namespace),
+		MetricName:    aws.String(metricName),
+		Stat:          aws.String(statistic),
+		Dimensions:    []awscloudwatch.Dimension{{Name: aws.String("InstanceId"), Value: aws.String("i-1234")}},
+		Configuration: &awscloudwatch.AnomalyDetectorConfiguration{MetricTimezone: tz},
+		StateValue:    awscloudwatch.AnomalyDetectorStateValueTrained,
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				cloudwatch: &fake.MockAnomalyDetectorClient{
+					MockDescribeAnomalyDetectors: func(*awscloudwatch.DescribeAnomalyDetectorsInput) awscloudwatch.DescribeAnomalyDetectorsRequest {
+						return awscloudwatch.DescribeAnomalyDetectorsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatch.DescribeAnomalyDetectorsOutput{
+								AnomalyDetectors: []awscloudwatch.AnomalyDetector{observed("p99", nil), observed(stat, aws.String(timezone))},
+							}},
+						}
+					},
+				},
+				cr: anomalyDetector(withTimezone(timezone)),
+			},
+			want: want{
+				cr: anomalyDetector(withTimezone(timezone),
+					withStateValue("TRAINED"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NextPage": {
+			args: args{
+				cloudwatch: &fake.MockAnomalyDetectorClient{
+					MockDescribeAnomalyDetectors: func(in *awscloudwatch.DescribeAnomalyDetectorsInput) awscloudwatch.DescribeAnomalyDetectorsRequest {
+						out := &awscloudwatch.DescribeAnomalyDetectorsOutput{
+							AnomalyDetectors: []awscloudwatch.AnomalyDetector{observed("p99", nil)},
+							NextToken:        aws.String(nextToken),
+						}
+						if aws.StringValue(in.NextToken) == nextToken {
+							out = &awscloudwatch.DescribeAnomalyDetectorsOutput{
+								AnomalyDetectors: []awscloudwatch.AnomalyDetector{observed(stat, aws.String(timezone))},
+							}
+						}
+						return awscloudwatch.DescribeAnomalyDetectorsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+						}
+					},
+				},
+				cr: anomalyDetector(withTimezone(timezone)),
+			},
+			want: want{
+				cr: anomalyDetector(withTimezone(timezone),
+					withStateValue("TRAINED"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInit": {
+			args: args{
+				cloudwatch: &fake.MockAnomalyDetectorClient{
+					MockDescribeAnomalyDetectors: func(*awscloudwatch.DescribeAnomalyDetectorsInput) awscloudwatch.DescribeAnomalyDetectorsRequest {
+						return awscloudwatch.DescribeAnomalyDetectorsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatch.DescribeAnomalyDetectorsOutput{
+								AnomalyDetectors: []awscloudwatch.AnomalyDetector{observed(stat, aws.String(timezone))},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   anomalyDetector(),
+			},
+			want: want{
+				cr: anomalyDetector(withTimezone(timezone),
+					withStateValue("TRAINED"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				cloudwatch: &fake.MockAnomalyDetectorClient{
+					MockDescribeAnomalyDetectors: func(*awscloudwatch.DescribeAnomalyDetectorsInput) awscloudwatch.DescribeAnomalyDetectorsRequest {
+						return awscloudwatch.DescribeAnomalyDetectorsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatch.DescribeAnomalyDetectorsOutput{
+								AnomalyDetectors: []awscloudwatch.AnomalyDetector{observed("p99", nil)},
+							}},
+						}
+					},
+				},
+				cr: anomalyDetector(),
+			},
+			want: want{
+				cr: anomalyDetector(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				cloudwatch: &fake.MockAnomalyDetectorClient{
+					MockDescribeAnomalyDetectors: func(*awscloudwatch.DescribeAnomalyDetectorsInput) awscloudwatch.DescribeAnomalyDetectorsRequest {
+						return awscloudwatch.DescribeAnomalyDetectorsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: anomalyDetector(),
+			},
+			want: want{
+				cr:  anomalyDetector(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cloudwatch, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				cloudwatch: &fake.MockAnomalyDetectorClient{
+					MockPutAnomalyDetector: func(*awscloudwatch.PutAnomalyDetectorInput) awscloudwatch.PutAnomalyDetectorRequest {
+						return awscloudwatch.PutAnomalyDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatch.PutAnomalyDetectorOutput{}},
+						}
+					},
+				},
+				cr: anomalyDetector(),
+			},
+			want: want{
+				cr: anomalyDetector(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				cloudwatch: &fake.MockAnomalyDetectorClient{
+					MockPutAnomalyDetector: func(*awscloudwatch.PutAnomalyDetectorInput) awscloudwatch.PutAnomalyDetectorRequest {
+						return awscloudwatch.PutAnomalyDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: anomalyDetector(),
+			},
+			want: want{
+				cr:  anomalyDetector(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cloudwatch, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				cloudwatch: &fake.MockAnomalyDetectorClient{
+					MockPutAnomalyDetector: func(*awscloudwatch.PutAnomalyDetectorInput) awscloudwatch.PutAnomalyDetectorRequest {
+						return awscloudwatch.PutAnomalyDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatch.PutAnomalyDetectorOutput{}},
+						}
+					},
+				},
+				cr: anomalyDetector(withTimezone(timezone)),
+			},
+			want: want{
+				cr: anomalyDetector(withTimezone(timezone)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				cloudwatch: &fake.MockAnomalyDetectorClient{
+					MockPutAnomalyDetector: func(*awscloudwatch.PutAnomalyDetectorInput) awscloudwatch.PutAnomalyDetectorRequest {
+						return awscloudwatch.PutAnomalyDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: anomalyDetector(withTimezone(timezone)),
+			},
+			want: want{
+				cr:  anomalyDetector(withTimezone(timezone)),
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cloudwatch, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				cloudwatch: &fake.MockAnomalyDetectorClient{
+					MockDeleteAnomalyDetector: func(*awscloudwatch.DeleteAnomalyDetectorInput) awscloudwatch.DeleteAnomalyDetectorRequest {
+						return awscloudwatch.DeleteAnomalyDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatch.DeleteAnomalyDetectorOutput{}},
+						}
+					},
+				},
+				cr: anomalyDetector(),
+			},
+			want: want{
+				cr: anomalyDetector(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cloudwatch: &fake.MockAnomalyDetectorClient{
+					MockDeleteAnomalyDetector: func(*awscloudwatch.DeleteAnomalyDetectorInput) awscloudwatch.DeleteAnomalyDetectorRequest {
+						return awscloudwatch.DeleteAnomalyDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awscloudwatch.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: anomalyDetector(),
+			},
+			want: want{
+				cr: anomalyDetector(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				cloudwatch: &fake.MockAnomalyDetectorClient{
+					MockDeleteAnomalyDetector: func(*awscloudwatch.DeleteAnomalyDetectorInput) awscloudwatch.DeleteAnomalyDetectorRequest {
+						return awscloudwatch.DeleteAnomalyDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: anomalyDetector(),
+			},
+			want: want{
+				cr:  anomalyDetector(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cloudwatch, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
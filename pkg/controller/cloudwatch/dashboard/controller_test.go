@@ -0,0 +1,413 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awscloudwatch "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatch"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatch/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	dashboardName = "web"
+	dashboardARN  = "arn:aws:cloudwatch::123456789012:dashboard/web"
+	dashboardBody = `{"widgets":[{"type":"text","properties":{"markdown":"web"}}]}`
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	cloudwatch cloudwatch.DashboardClient
+	kube       *test.MockClient
+	cr         resource.Managed
+}
+
+type dashboardModifier func(*v1alpha1.Dashboard)
+
+func withConditions(c ...runtimev1alpha1.Condition) dashboardModifier {
+	return func(r *v1alpha1.Dashboard) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withARN(arn string) dashboardModifier {
+	return func(r *v1alpha1.Dashboard) { r.Status.AtProvider.DashboardARN = arn }
+}
+
+func withBody(b string) dashboardModifier {
+	return func(r *v1alpha1.Dashboard) { r.Spec.ForProvider.DashboardBody = aws.String(b) }
+}
+
+func withBodyConfigMapRef(vars map[string]string) dashboardModifier {
+	return func(r *v1alpha1.Dashboard) {
+		r.Spec.ForProvider.DashboardBody = nil
+		r.Spec.ForProvider.DashboardBodyConfigMapRef = &v1alpha1.ConfigMapKeySelector{Name: "dashboards", Namespace: "default", Key: "body.json"}
+		r.Spec.ForProvider.DashboardBodyVariables = vars
+	}
+}
+
+func dashboard(m ...dashboardModifier) *v1alpha1.Dashboard {
+	cr := &v1alpha1.Dashboard{
+		Spec: v1alpha1.DashboardSpec{
+			ForProvider: v1alpha1.DashboardParameters{
+				DashboardBody: aws.String(dashboardBody),
+			},
+		},
+	}
+	meta.SetExternalName(cr, dashboardName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getDashboard(*awscloudwatch.GetDashboardInput) awscloudwatch.GetDashboardRequest {
+	return awscloudwatch.GetDashboardRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatch.GetDashboardOutput{
+			DashboardArn:  aws.String(dashboardARN),
+			DashboardName: aws.String(dashboardName),
+			DashboardBody: aws.String(dashboardBody),
+		}},
+	}
+}
+
+func getConfigMap(body string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+		obj.(*corev1.ConfigMap).Data = map[string]string{"body.json": body}
+		return nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				cloudwatch: &fake.MockDashboardClient{MockGetDashboard: getDashboard},
+				cr:         dashboard(),
+			},
+			want: want{
+				cr:     dashboard(withARN(dashboardARN), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"BodyChanged": {
+			args: args{
+				cloudwatch: &fake.MockDashboardClient{MockGetDashboard: getDashboard},
+				cr:         dashboard(withBody(`{"widgets":[]}`)),
+			},
+			want: want{
+				cr:     dashboard(withBody(`{"widgets":[]}`), withARN(dashboardARN), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"TemplatedConfigMapUpToDate": {
+			args: args{
+				cloudwatch: &fake.MockDashboardClient{MockGetDashboard: getDashboard},
+				kube:       &test.MockClient{MockGet: getConfigMap(`{"widgets":[{"type":"text","properties":{"markdown":"{{ .service }}"}}]}`)},
+				cr:         dashboard(withBodyConfigMapRef(map[string]string{"service": "web"})),
+			},
+			want: want{
+				cr:     dashboard(withBodyConfigMapRef(map[string]string{"service": "web"}), withARN(dashboardARN), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				cloudwatch: &fake.MockDashboardClient{
+					MockGetDashboard: func(*awscloudwatch.GetDashboardInput) awscloudwatch.GetDashboardRequest {
+						return awscloudwatch.GetDashboardRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awscloudwatch.ErrCodeDashboardNotFoundError, "", nil)},
+						}
+					},
+				},
+				cr: dashboard(),
+			},
+			want: want{
+				cr: dashboard(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				cloudwatch: &fake.MockDashboardClient{
+					MockGetDashboard: func(*awscloudwatch.GetDashboardInput) awscloudwatch.GetDashboardRequest {
+						return awscloudwatch.GetDashboardRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dashboard(),
+			},
+			want: want{
+				cr:  dashboard(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cloudwatch}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cloudwatch: &fake.MockDashboardClient{
+					MockPutDashboard: func(in *awscloudwatch.PutDashboardInput) awscloudwatch.PutDashboardRequest {
+						if diff := cmp.Diff(dashboardName, aws.StringValue(in.DashboardName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(dashboardBody, aws.StringValue(in.DashboardBody)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscloudwatch.PutDashboardRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatch.PutDashboardOutput{}},
+						}
+					},
+				},
+				cr: dashboard(),
+			},
+			want: want{
+				cr: dashboard(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ConfigMapError": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr:   dashboard(withBodyConfigMapRef(nil)),
+			},
+			want: want{
+				cr:  dashboard(withBodyConfigMapRef(nil), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, "cannot get the config map that contains the dashboard body"),
+			},
+		},
+		"ClientError": {
+			args: args{
+				cloudwatch: &fake.MockDashboardClient{
+					MockPutDashboard: func(*awscloudwatch.PutDashboardInput) awscloudwatch.PutDashboardRequest {
+						return awscloudwatch.PutDashboardRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dashboard(),
+			},
+			want: want{
+				cr:  dashboard(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cloudwatch}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cloudwatch: &fake.MockDashboardClient{
+					MockPutDashboard: func(in *awscloudwatch.PutDashboardInput) awscloudwatch.PutDashboardRequest {
+						if diff := cmp.Diff(`{"widgets":[]}`, aws.StringValue(in.DashboardBody)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscloudwatch.PutDashboardRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatch.PutDashboardOutput{}},
+						}
+					},
+				},
+				cr: dashboard(withBody(`{"widgets":[]}`)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				cloudwatch: &fake.MockDashboardClient{
+					MockPutDashboard: func(*awscloudwatch.PutDashboardInput) awscloudwatch.PutDashboardRequest {
+						return awscloudwatch.PutDashboardRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dashboard(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cloudwatch}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cloudwatch: &fake.MockDashboardClient{
+					MockDeleteDashboards: func(in *awscloudwatch.DeleteDashboardsInput) awscloudwatch.DeleteDashboardsRequest {
+						if diff := cmp.Diff([]string{dashboardName}, in.DashboardNames); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscloudwatch.DeleteDashboardsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatch.DeleteDashboardsOutput{}},
+						}
+					},
+				},
+				cr: dashboard(),
+			},
+			want: want{
+				cr: dashboard(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				cloudwatch: &fake.MockDashboardClient{
+					MockDeleteDashboards: func(*awscloudwatch.DeleteDashboardsInput) awscloudwatch.DeleteDashboardsRequest {
+						return awscloudwatch.DeleteDashboardsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awscloudwatch.ErrCodeDashboardNotFoundError, "", nil)},
+						}
+					},
+				},
+				cr: dashboard(),
+			},
+			want: want{
+				cr: dashboard(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				cloudwatch: &fake.MockDashboardClient{
+					MockDeleteDashboards: func(*awscloudwatch.DeleteDashboardsInput) awscloudwatch.DeleteDashboardsRequest {
+						return awscloudwatch.DeleteDashboardsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dashboard(),
+			},
+			want: want{
+				cr:  dashboard(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cloudwatch}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
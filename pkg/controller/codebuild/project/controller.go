@@ -0,0 +1,224 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscodebuild "github.com/aws/aws-sdk-go-v2/service/codebuild"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/codebuild/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/codebuild"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a CodeBuild Project resource"
+
+	errDescribe      = "failed to describe the Project resource"
+	errCreate        = "failed to create the Project resource"
+	errUpdate        = "failed to update the Project resource"
+	errDelete        = "failed to delete the Project resource"
+	errCreateWebhook = "failed to create the webhook of the Project resource"
+	errUpdateWebhook = "failed to update the webhook of the Project resource"
+	errDeleteWebhook = "failed to delete the webhook of the Project resource"
+	errSpecUpdate    = "cannot update spec of the Project custom resource"
+)
+
+// SetupProject adds a controller that reconciles Projects.
+func SetupProject(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ProjectGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Project{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ProjectGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: codebuild.NewProjectClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) codebuild.ProjectClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client codebuild.ProjectClient
+}
+
+// describe returns the project, or nil if it does not exist.
+func (e *external) describe(ctx context.Context, cr *v1alpha1.Project) (*awscodebuild.Project, error) {
+	rsp, err := e.client.BatchGetProjectsRequest(&awscodebuild.BatchGetProjectsInput{
+		Names: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp.Projects) == 0 {
+		return nil, nil
+	}
+	return &rsp.Projects[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Project)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	codebuild.LateInitializeProject(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = codebuild.GenerateProjectObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	values, err := codebuild.GetEnvironmentVariableValues(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: codebuild.IsProjectUpToDate(cr.Spec.ForProvider, *observed, values) && isWebhookUpToDate(cr.Spec.ForProvider.Webhook, observed.Webhook),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Project)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	values, err := codebuild.GetEnvironmentVariableValues(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	name := meta.GetExternalName(cr)
+	if _, err := e.client.CreateProjectRequest(codebuild.GenerateCreateProjectInput(name, cr.Spec.ForProvider, values)).Send(ctx); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	return managed.ExternalCreation{}, e.syncWebhook(ctx, name, cr.Spec.ForProvider.Webhook, nil)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Project)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	values, err := codebuild.GetEnvironmentVariableValues(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	name := meta.GetExternalName(cr)
+	if !codebuild.IsProjectUpToDate(cr.Spec.ForProvider, *observed, values) {
+		if _, err := e.client.UpdateProjectRequest(codebuild.GenerateUpdateProjectInput(name, cr.Spec.ForProvider, values)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+	return managed.ExternalUpdate{}, e.syncWebhook(ctx, name, cr.Spec.ForProvider.Webhook, observed.Webhook)
+}
+
+// syncWebhook creates, updates or deletes the webhook of the project so that
+// it matches the desired webhook.
+func (e *external) syncWebhook(ctx context.Context, name string, desired *v1alpha1.Webhook, observed *awscodebuild.Webhook) error {
+	switch {
+	case isWebhookUpToDate(desired, observed):
+		return nil
+	case observed == nil:
+		_, err := e.client.CreateWebhookRequest(&awscodebuild.CreateWebhookInput{
+			ProjectName:  aws.String(name),
+			FilterGroups: codebuild.GenerateWebhookFilterGroups(*desired),
+		}).Send(ctx)
+		return errors.Wrap(err, errCreateWebhook)
+	case desired == nil:
+		_, err := e.client.DeleteWebhookRequest(&awscodebuild.DeleteWebhookInput{ProjectName: aws.String(name)}).Send(ctx)
+		return errors.Wrap(err, errDeleteWebhook)
+	default:
+		_, err := e.client.UpdateWebhookRequest(&awscodebuild.UpdateWebhookInput{
+			ProjectName:  aws.String(name),
+			FilterGroups: codebuild.GenerateWebhookFilterGroups(*desired),
+		}).Send(ctx)
+		return errors.Wrap(err, errUpdateWebhook)
+	}
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Project)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteProjectRequest(&awscodebuild.DeleteProjectInput{Name: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	return errors.Wrap(err, errDelete)
+}
+
+// isWebhookUpToDate returns whether the observed webhook of a project matches
+// the desired one. A project without a desired webhook must not have one.
+func isWebhookUpToDate(desired *v1alpha1.Webhook, observed *awscodebuild.Webhook) bool {
+	if desired == nil || observed == nil {
+		return desired == nil && observed == nil
+	}
+	return codebuild.IsWebhookUpToDate(*desired, *observed)
+}
@@ -0,0 +1,614 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscodebuild "github.com/aws/aws-sdk-go-v2/service/codebuild"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/codebuild/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/codebuild"
+	"github.com/crossplane/provider-aws/pkg/clients/codebuild/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	projectName = "sample-project"
+	projectARN  = "arn:aws:codebuild:us-east-1:123456789012:project/sample-project"
+	repository  = "https://github.com/crossplane/provider-aws.git"
+	roleARN     = "arn:aws:iam::123456789012:role/codebuild"
+	kmsKey      = "arn:aws:kms:us-east-1:123456789012:alias/aws/s3"
+	webhookURL  = "https://api.github.com/repos/crossplane/provider-aws/hooks/1"
+	image       = "aws/codebuild/standard:4.0"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	codebuild codebuild.ProjectClient
+	kube      *test.MockClient
+	cr        resource.Managed
+}
+
+type projectModifier func(*v1alpha1.Project)
+
+func withConditions(c ...runtimev1alpha1.Condition) projectModifier {
+	return func(r *v1alpha1.Project) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withImage(i string) projectModifier {
+	return func(r *v1alpha1.Project) { r.Spec.ForProvider.Environment.Image = i }
+}
+
+func withTimeout(t *int64) projectModifier {
+	return func(r *v1alpha1.Project) { r.Spec.ForProvider.TimeoutInMinutes = t }
+}
+
+func withWebhook(w *v1alpha1.Webhook) projectModifier {
+	return func(r *v1alpha1.Project) { r.Spec.ForProvider.Webhook = w }
+}
+
+func withSecretVariable(name string) projectModifier {
+	return func(r *v1alpha1.Project) {
+		r.Spec.ForProvider.Environment.EnvironmentVariables = append(r.Spec.ForProvider.Environment.EnvironmentVariables, v1alpha1.EnvironmentVariable{
+			Name: name,
+			Type: aws.String("PLAINTEXT"),
+			ValueSecretRef: &runtimev1alpha1.SecretKeySelector{
+				SecretReference: runtimev1alpha1.SecretReference{Name: "token", Namespace: "default"},
+				Key:             "token",
+			},
+		})
+	}
+}
+
+func withObservation(webhook bool) projectModifier {
+	return func(r *v1alpha1.Project) {
+		r.Status.AtProvider = v1alpha1.ProjectObservation{ARN: projectARN}
+		if webhook {
+			r.Status.AtProvider.WebhookURL = webhookURL
+		}
+	}
+}
+
+func project(m ...projectModifier) *v1alpha1.Project {
+	cr := &v1alpha1.Project{
+		Spec: v1alpha1.ProjectSpec{
+			ForProvider: v1alpha1.ProjectParameters{
+				Source: v1alpha1.ProjectSource{
+					Type:        "GITHUB",
+					Location:    repository,
+					InsecureSSL: aws.Bool(false),
+				},
+				Environment: v1alpha1.ProjectEnvironment{
+					Type:                     "LINUX_CONTAINER",
+					ComputeType:              "BUILD_GENERAL1_SMALL",
+					Image:                    image,
+					ImagePullCredentialsType: aws.String("CODEBUILD"),
+					PrivilegedMode:           aws.Bool(false),
+				},
+				Artifacts:              v1alpha1.ProjectArtifacts{Type: "NO_ARTIFACTS"},
+				ServiceRoleARN:         aws.String(roleARN),
+				EncryptionKey:          aws.String(kmsKey),
+				TimeoutInMinutes:       aws.Int64(60),
+				QueuedTimeoutInMinutes: aws.Int64(480),
+				BadgeEnabled:           aws.Bool(false),
+			},
+		},
+	}
+	meta.SetExternalName(cr, projectName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(webhook *awscodebuild.Webhook) awscodebuild.Project {
+	return awscodebuild.Project{
+		Arn:  aws.String(projectARN),
+		Name: aws.String(projectName),
+		Source: &awscodebuild.ProjectSource{
+			Type:        awscodebuild.SourceTypeGithub,
+			Location:    aws.String(repository),
+			InsecureSsl: aws.Bool(false),
+		},
+		Environment: &awscodebuild.ProjectEnvironment{
+			Type:                     awscodebuild.EnvironmentTypeLinuxContainer,
+			ComputeType:              awscodebuild.ComputeTypeBuildGeneral1Small,
+			Image:                    aws.String(image),
+			ImagePullCredentialsType: awscodebuild.ImagePullCredentialsTypeCodebuild,
+			PrivilegedMode:           aws.Bool(false),
+		},
+		Artifacts:              &awscodebuild.ProjectArtifacts{Type: awscodebuild.ArtifactsTypeNoArtifacts},
+		ServiceRole:            aws.String(roleARN),
+		EncryptionKey:          aws.String(kmsKey),
+		TimeoutInMinutes:       aws.Int64(60),
+		QueuedTimeoutInMinutes: aws.Int64(480),
+		Badge:                  &awscodebuild.ProjectBadge{BadgeEnabled: aws.Bool(false)},
+		VpcConfig:              &awscodebuild.VpcConfig{},
+		Webhook:                webhook,
+	}
+}
+
+var pushFilter = &v1alpha1.Webhook{
+	FilterGroups: []v1alpha1.WebhookFilterGroup{{
+		Filters: []v1alpha1.WebhookFilter{{Type: "EVENT", Pattern: "PUSH"}},
+	}},
+}
+
+func pushWebhook() *awscodebuild.Webhook {
+	return &awscodebuild.Webhook{
+		Url: aws.String(webhookURL),
+		FilterGroups: [][]awscodebuild.WebhookFilter{{
+			{Type: awscodebuild.WebhookFilterTypeEvent, Pattern: aws.String("PUSH"), ExcludeMatchedPattern: aws.Bool(false)},
+		}},
+	}
+}
+
+func describe(p ...awscodebuild.Project) func(*awscodebuild.BatchGetProjectsInput) awscodebuild.BatchGetProjectsRequest {
+	return func(*awscodebuild.BatchGetProjectsInput) awscodebuild.BatchGetProjectsRequest {
+		return awscodebuild.BatchGetProjectsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodebuild.BatchGetProjectsOutput{Projects: p}},
+		}
+	}
+}
+
+func getSecret(value string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+		s := obj.(*corev1.Secret)
+		s.Data = map[string][]byte{"token": []byte(value)}
+		return nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				codebuild: &fake.MockProjectClient{MockBatchGetProjects: describe(observed(pushWebhook()))},
+				cr:        project(withWebhook(pushFilter)),
+			},
+			want: want{
+				cr: project(withWebhook(pushFilter), withObservation(true), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				codebuild: &fake.MockProjectClient{MockBatchGetProjects: describe(observed(nil))},
+				kube:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:        project(withTimeout(nil)),
+			},
+			want: want{
+				cr: project(withObservation(false), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ProjectNotUpToDate": {
+			args: args{
+				codebuild: &fake.MockProjectClient{MockBatchGetProjects: describe(observed(nil))},
+				cr:        project(withImage("aws/codebuild/standard:5.0")),
+			},
+			want: want{
+				cr: project(withImage("aws/codebuild/standard:5.0"), withObservation(false), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"WebhookNotUpToDate": {
+			args: args{
+				codebuild: &fake.MockProjectClient{MockBatchGetProjects: describe(observed(pushWebhook()))},
+				cr:        project(),
+			},
+			want: want{
+				cr: project(withObservation(true), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"SecretVariableNotUpToDate": {
+			args: args{
+				codebuild: &fake.MockProjectClient{MockBatchGetProjects: describe(func() awscodebuild.Project {
+					p := observed(nil)
+					p.Environment.EnvironmentVariables = []awscodebuild.EnvironmentVariable{
+						{Name: aws.String("TOKEN"), Type: awscodebuild.EnvironmentVariableTypePlaintext, Value: aws.String("old")},
+					}
+					return p
+				}())},
+				kube: &test.MockClient{MockGet: getSecret("new")},
+				cr:   project(withSecretVariable("TOKEN")),
+			},
+			want: want{
+				cr: project(withSecretVariable("TOKEN"), withObservation(false), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				codebuild: &fake.MockProjectClient{MockBatchGetProjects: describe()},
+				cr:        project(),
+			},
+			want: want{
+				cr: project(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				codebuild: &fake.MockProjectClient{
+					MockBatchGetProjects: func(*awscodebuild.BatchGetProjectsInput) awscodebuild.BatchGetProjectsRequest {
+						return awscodebuild.BatchGetProjectsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: project(),
+			},
+			want: want{
+				cr:  project(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.codebuild, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	create := func(input *awscodebuild.CreateProjectInput) awscodebuild.CreateProjectRequest {
+		return awscodebuild.CreateProjectRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodebuild.CreateProjectOutput{}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				codebuild: &fake.MockProjectClient{
+					MockCreateProject: func(input *awscodebuild.CreateProjectInput) awscodebuild.CreateProjectRequest {
+						want := []awscodebuild.EnvironmentVariable{{Name: aws.String("TOKEN"), Type: awscodebuild.EnvironmentVariableTypePlaintext, Value: aws.String("secret")}}
+						if diff := cmp.Diff(want, input.Environment.EnvironmentVariables); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return create(input)
+					},
+				},
+				kube: &test.MockClient{MockGet: getSecret("secret")},
+				cr:   project(withSecretVariable("TOKEN")),
+			},
+			want: want{
+				cr: project(withSecretVariable("TOKEN"), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"WithWebhook": {
+			args: args{
+				codebuild: &fake.MockProjectClient{
+					MockCreateProject: create,
+					MockCreateWebhook: func(input *awscodebuild.CreateWebhookInput) awscodebuild.CreateWebhookRequest {
+						if diff := cmp.Diff(aws.String(projectName), input.ProjectName); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodebuild.CreateWebhookRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodebuild.CreateWebhookOutput{}},
+						}
+					},
+				},
+				cr: project(withWebhook(pushFilter)),
+			},
+			want: want{
+				cr: project(withWebhook(pushFilter), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				codebuild: &fake.MockProjectClient{
+					MockCreateProject: func(*awscodebuild.CreateProjectInput) awscodebuild.CreateProjectRequest {
+						return awscodebuild.CreateProjectRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: project(),
+			},
+			want: want{
+				cr:  project(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"WebhookError": {
+			args: args{
+				codebuild: &fake.MockProjectClient{
+					MockCreateProject: create,
+					MockCreateWebhook: func(*awscodebuild.CreateWebhookInput) awscodebuild.CreateWebhookRequest {
+						return awscodebuild.CreateWebhookRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: project(withWebhook(pushFilter)),
+			},
+			want: want{
+				cr:  project(withWebhook(pushFilter), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreateWebhook),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.codebuild, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateProject": {
+			args: args{
+				codebuild: &fake.MockProjectClient{
+					MockBatchGetProjects: describe(observed(nil)),
+					MockUpdateProject: func(input *awscodebuild.UpdateProjectInput) awscodebuild.UpdateProjectRequest {
+						if diff := cmp.Diff(aws.String("aws/codebuild/standard:5.0"), input.Environment.Image); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodebuild.UpdateProjectRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodebuild.UpdateProjectOutput{}},
+						}
+					},
+				},
+				cr: project(withImage("aws/codebuild/standard:5.0")),
+			},
+			want: want{
+				cr: project(withImage("aws/codebuild/standard:5.0")),
+			},
+		},
+		"UpdateWebhook": {
+			args: args{
+				codebuild: &fake.MockProjectClient{
+					MockBatchGetProjects: describe(observed(pushWebhook())),
+					MockUpdateWebhook: func(input *awscodebuild.UpdateWebhookInput) awscodebuild.UpdateWebhookRequest {
+						if diff := cmp.Diff(2, len(input.FilterGroups)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodebuild.UpdateWebhookRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodebuild.UpdateWebhookOutput{}},
+						}
+					},
+				},
+				cr: project(withWebhook(&v1alpha1.Webhook{FilterGroups: []v1alpha1.WebhookFilterGroup{
+					{Filters: []v1alpha1.WebhookFilter{{Type: "EVENT", Pattern: "PUSH"}}},
+					{Filters: []v1alpha1.WebhookFilter{{Type: "EVENT", Pattern: "PULL_REQUEST_CREATED"}}},
+				}})),
+			},
+			want: want{
+				cr: project(withWebhook(&v1alpha1.Webhook{FilterGroups: []v1alpha1.WebhookFilterGroup{
+					{Filters: []v1alpha1.WebhookFilter{{Type: "EVENT", Pattern: "PUSH"}}},
+					{Filters: []v1alpha1.WebhookFilter{{Type: "EVENT", Pattern: "PULL_REQUEST_CREATED"}}},
+				}})),
+			},
+		},
+		"DeleteWebhook": {
+			args: args{
+				codebuild: &fake.MockProjectClient{
+					MockBatchGetProjects: describe(observed(pushWebhook())),
+					MockDeleteWebhook: func(*awscodebuild.DeleteWebhookInput) awscodebuild.DeleteWebhookRequest {
+						return awscodebuild.DeleteWebhookRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodebuild.DeleteWebhookOutput{}},
+						}
+					},
+				},
+				cr: project(),
+			},
+			want: want{
+				cr: project(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				codebuild: &fake.MockProjectClient{
+					MockBatchGetProjects: describe(observed(nil)),
+					MockUpdateProject: func(*awscodebuild.UpdateProjectInput) awscodebuild.UpdateProjectRequest {
+						return awscodebuild.UpdateProjectRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: project(withImage("aws/codebuild/standard:5.0")),
+			},
+			want: want{
+				cr:  project(withImage("aws/codebuild/standard:5.0")),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.codebuild, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				codebuild: &fake.MockProjectClient{
+					MockDeleteProject: func(*awscodebuild.DeleteProjectInput) awscodebuild.DeleteProjectRequest {
+						return awscodebuild.DeleteProjectRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodebuild.DeleteProjectOutput{}},
+						}
+					},
+				},
+				cr: project(),
+			},
+			want: want{
+				cr: project(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				codebuild: &fake.MockProjectClient{
+					MockDeleteProject: func(*awscodebuild.DeleteProjectInput) awscodebuild.DeleteProjectRequest {
+						return awscodebuild.DeleteProjectRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: project(),
+			},
+			want: want{
+				cr:  project(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.codebuild, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscodecommit "github.com/aws/aws-sdk-go-v2/service/codecommit"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/codecommit/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/codecommit"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a CodeCommit Repository resource"
+
+	errDescribe             = "failed to describe the Repository resource"
+	errCreate               = "failed to create the Repository resource"
+	errDelete               = "failed to delete the Repository resource"
+	errUpdateDescription    = "failed to update the description of the Repository resource"
+	errUpdateDefaultBranch  = "failed to update the default branch of the Repository resource"
+	errGetTriggers          = "failed to get the triggers of the Repository resource"
+	errPutTriggers          = "failed to put the triggers of the Repository resource"
+	errListTemplates        = "failed to list the approval rule templates of the Repository resource"
+	errAssociateTemplate    = "failed to associate an approval rule template with the Repository resource"
+	errDisassociateTemplate = "failed to disassociate an approval rule template from the Repository resource"
+	errListTags             = "failed to list the tags of the Repository resource"
+	errAddTags              = "failed to add tags to the Repository resource"
+	errRemoveTags           = "failed to remove tags from the Repository resource"
+	errSpecUpdate           = "cannot update spec of the Repository custom resource"
+)
+
+// SetupRepository adds a controller that reconciles Repositories.
+func SetupRepository(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.RepositoryGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Repository{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RepositoryGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: codecommit.NewRepositoryClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) codecommit.RepositoryClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Repository)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client codecommit.RepositoryClient
+}
+
+// approvalRuleTemplates returns the names of the approval rule templates
+// that are associated with the repository.
+func (e *external) approvalRuleTemplates(ctx context.Context, name string) ([]string, error) {
+	in := &awscodecommit.ListAssociatedApprovalRuleTemplatesForRepositoryInput{RepositoryName: aws.String(name)}
+	var names []string
+	for {
+		rsp, err := e.client.ListAssociatedApprovalRuleTemplatesForRepositoryRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, rsp.ApprovalRuleTemplateNames...)
+		if rsp.NextToken == nil {
+			return names, nil
+		}
+		in.NextToken = rsp.NextToken
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Repository)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	name := aws.String(meta.GetExternalName(cr))
+	observed, err := e.client.GetRepositoryRequest(&awscodecommit.GetRepositoryInput{RepositoryName: name}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(codecommit.IsRepositoryNotFound, err), errDescribe)
+	}
+	repository := observed.RepositoryMetadata
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	codecommit.LateInitializeRepository(&cr.Spec.ForProvider, repository)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = codecommit.GenerateRepositoryObservation(*repository)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	triggers, err := e.client.GetRepositoryTriggersRequest(&awscodecommit.GetRepositoryTriggersInput{RepositoryName: name}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetTriggers)
+	}
+	templates, err := e.approvalRuleTemplates(ctx, *name)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTemplates)
+	}
+	associate, disassociate := codecommit.DiffApprovalRuleTemplates(cr.Spec.ForProvider.ApprovalRuleTemplateNames, templates)
+	tags, err := e.client.ListTagsForResourceRequest(&awscodecommit.ListTagsForResourceInput{ResourceArn: repository.Arn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := codecommit.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: codecommit.IsRepositoryUpToDate(cr.Spec.ForProvider, *repository) &&
+			codecommit.AreTriggersUpToDate(cr.Spec.ForProvider.Triggers, triggers.Triggers) &&
+			len(associate) == 0 && len(disassociate) == 0 && len(add) == 0 && len(remove) == 0,
+		ConnectionDetails: codecommit.GetConnectionDetails(*repository),
+	}, nil
+}
+
+// Create creates the repository. Its triggers and approval rule templates
+// are set by the following update.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Repository)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.CreateRepositoryRequest(codecommit.GenerateCreateRepositoryInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if rsp.RepositoryMetadata == nil {
+		return managed.ExternalCreation{}, nil
+	}
+	return managed.ExternalCreation{ConnectionDetails: codecommit.GetConnectionDetails(*rsp.RepositoryMetadata)}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Repository)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	name := aws.String(meta.GetExternalName(cr))
+	p := cr.Spec.ForProvider
+	observed, err := e.client.GetRepositoryRequest(&awscodecommit.GetRepositoryInput{RepositoryName: name}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	repository := observed.RepositoryMetadata
+
+	if aws.StringValue(p.Description) != aws.StringValue(repository.RepositoryDescription) {
+		if _, err := e.client.UpdateRepositoryDescriptionRequest(&awscodecommit.UpdateRepositoryDescriptionInput{
+			RepositoryName:        name,
+			RepositoryDescription: p.Description,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDescription)
+		}
+	}
+	// The default branch of an empty repository can not be set.
+	if p.DefaultBranch != nil && repository.DefaultBranch != nil && *p.DefaultBranch != *repository.DefaultBranch {
+		if _, err := e.client.UpdateDefaultBranchRequest(&awscodecommit.UpdateDefaultBranchInput{
+			RepositoryName:    name,
+			DefaultBranchName: p.DefaultBranch,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDefaultBranch)
+		}
+	}
+
+	triggers, err := e.client.GetRepositoryTriggersRequest(&awscodecommit.GetRepositoryTriggersInput{RepositoryName: name}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetTriggers)
+	}
+	if !codecommit.AreTriggersUpToDate(p.Triggers, triggers.Triggers) {
+		if _, err := e.client.PutRepositoryTriggersRequest(&awscodecommit.PutRepositoryTriggersInput{
+			RepositoryName: name,
+			Triggers:       codecommit.GenerateTriggers(p.Triggers),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPutTriggers)
+		}
+	}
+
+	templates, err := e.approvalRuleTemplates(ctx, *name)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTemplates)
+	}
+	associate, disassociate := codecommit.DiffApprovalRuleTemplates(p.ApprovalRuleTemplateNames, templates)
+	for _, t := range disassociate {
+		if _, err := e.client.DisassociateApprovalRuleTemplateFromRepositoryRequest(&awscodecommit.DisassociateApprovalRuleTemplateFromRepositoryInput{
+			RepositoryName:           name,
+			ApprovalRuleTemplateName: aws.String(t),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDisassociateTemplate)
+		}
+	}
+	for _, t := range associate {
+		if _, err := e.client.AssociateApprovalRuleTemplateWithRepositoryRequest(&awscodecommit.AssociateApprovalRuleTemplateWithRepositoryInput{
+			RepositoryName:           name,
+			ApprovalRuleTemplateName: aws.String(t),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAssociateTemplate)
+		}
+	}
+
+	tags, err := e.client.ListTagsForResourceRequest(&awscodecommit.ListTagsForResourceInput{ResourceArn: repository.Arn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := codecommit.DiffTags(p.Tags, tags.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.UntagResourceRequest(&awscodecommit.UntagResourceInput{ResourceArn: repository.Arn, TagKeys: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.TagResourceRequest(&awscodecommit.TagResourceInput{ResourceArn: repository.Arn, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Repository)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteRepositoryRequest(&awscodecommit.DeleteRepositoryInput{RepositoryName: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	return errors.Wrap(resource.Ignore(codecommit.IsRepositoryNotFound, err), errDelete)
+}
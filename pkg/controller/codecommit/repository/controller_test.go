@@ -0,0 +1,600 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awscodecommit "github.com/aws/aws-sdk-go-v2/service/codecommit"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/codecommit/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/codecommit"
+	"github.com/crossplane/provider-aws/pkg/clients/codecommit/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	repositoryName = "sample-repository"
+	repositoryARN  = "arn:aws:codecommit:us-east-1:123456789012:sample-repository"
+	repositoryID   = "f7579e13-b83e-4027-aaef-650c0EXAMPLE"
+	accountID      = "123456789012"
+	cloneURLHTTP   = "https://git-codecommit.us-east-1.amazonaws.com/v1/repos/sample-repository"
+	cloneURLSSH    = "ssh://git-codecommit.us-east-1.amazonaws.com/v1/repos/sample-repository"
+	topicARN       = "arn:aws:sns:us-east-1:123456789012:commits"
+	description    = "Sample repository"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	codecommit codecommit.RepositoryClient
+	kube       *test.MockClient
+	cr         resource.Managed
+}
+
+type repositoryModifier func(*v1alpha1.Repository)
+
+func withConditions(c ...runtimev1alpha1.Condition) repositoryModifier {
+	return func(r *v1alpha1.Repository) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDescription(d *string) repositoryModifier {
+	return func(r *v1alpha1.Repository) { r.Spec.ForProvider.Description = d }
+}
+
+func withDefaultBranch(b string) repositoryModifier {
+	return func(r *v1alpha1.Repository) { r.Spec.ForProvider.DefaultBranch = aws.String(b) }
+}
+
+func withTrigger(events ...string) repositoryModifier {
+	return func(r *v1alpha1.Repository) {
+		r.Spec.ForProvider.Triggers = []v1alpha1.RepositoryTrigger{{Name: "notify", DestinationARN: aws.String(topicARN), Events: events}}
+	}
+}
+
+func withTemplates(n ...string) repositoryModifier {
+	return func(r *v1alpha1.Repository) { r.Spec.ForProvider.ApprovalRuleTemplateNames = n }
+}
+
+func withTags(t ...v1alpha1.Tag) repositoryModifier {
+	return func(r *v1alpha1.Repository) { r.Spec.ForProvider.Tags = t }
+}
+
+func withObservation() repositoryModifier {
+	return func(r *v1alpha1.Repository) {
+		r.Status.AtProvider = v1alpha1.RepositoryObservation{
+			ARN:          repositoryARN,
+			RepositoryID: repositoryID,
+			AccountID:    accountID,
+			CloneURLHTTP: cloneURLHTTP,
+			CloneURLSSH:  cloneURLSSH,
+		}
+	}
+}
+
+func repository(m ...repositoryModifier) *v1alpha1.Repository {
+	cr := &v1alpha1.Repository{
+		Spec: v1alpha1.RepositorySpec{
+			ForProvider: v1alpha1.RepositoryParameters{
+				Description:   aws.String(description),
+				DefaultBranch: aws.String("master"),
+			},
+		},
+	}
+	meta.SetExternalName(cr, repositoryName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var connectionDetails = managed.ConnectionDetails{
+	codecommit.ConnectionDetailsCloneURLHTTP: []byte(cloneURLHTTP),
+	codecommit.ConnectionDetailsCloneURLSSH:  []byte(cloneURLSSH),
+}
+
+func metadata() *awscodecommit.RepositoryMetadata {
+	return &awscodecommit.RepositoryMetadata{
+		Arn:                   aws.String(repositoryARN),
+		RepositoryId:          aws.String(repositoryID),
+		RepositoryName:        aws.String(repositoryName),
+		AccountId:             aws.String(accountID),
+		CloneUrlHttp:          aws.String(cloneURLHTTP),
+		CloneUrlSsh:           aws.String(cloneURLSSH),
+		RepositoryDescription: aws.String(description),
+		DefaultBranch:         aws.String("master"),
+	}
+}
+
+func getRepository(*awscodecommit.GetRepositoryInput) awscodecommit.GetRepositoryRequest {
+	return awscodecommit.GetRepositoryRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodecommit.GetRepositoryOutput{RepositoryMetadata: metadata()}},
+	}
+}
+
+func getTriggers(t ...awscodecommit.RepositoryTrigger) func(*awscodecommit.GetRepositoryTriggersInput) awscodecommit.GetRepositoryTriggersRequest {
+	return func(*awscodecommit.GetRepositoryTriggersInput) awscodecommit.GetRepositoryTriggersRequest {
+		return awscodecommit.GetRepositoryTriggersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodecommit.GetRepositoryTriggersOutput{Triggers: t}},
+		}
+	}
+}
+
+func listTemplates(n ...string) func(*awscodecommit.ListAssociatedApprovalRuleTemplatesForRepositoryInput) awscodecommit.ListAssociatedApprovalRuleTemplatesForRepositoryRequest {
+	return func(*awscodecommit.ListAssociatedApprovalRuleTemplatesForRepositoryInput) awscodecommit.ListAssociatedApprovalRuleTemplatesForRepositoryRequest {
+		return awscodecommit.ListAssociatedApprovalRuleTemplatesForRepositoryRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodecommit.ListAssociatedApprovalRuleTemplatesForRepositoryOutput{ApprovalRuleTemplateNames: n}},
+		}
+	}
+}
+
+func listTags(t map[string]string) func(*awscodecommit.ListTagsForResourceInput) awscodecommit.ListTagsForResourceRequest {
+	return func(*awscodecommit.ListTagsForResourceInput) awscodecommit.ListTagsForResourceRequest {
+		return awscodecommit.ListTagsForResourceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodecommit.ListTagsForResourceOutput{Tags: t}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				codecommit: &fake.MockRepositoryClient{
+					MockGetRepository:                                    getRepository,
+					MockGetRepositoryTriggers:                            getTriggers(),
+					MockListAssociatedApprovalRuleTemplatesForRepository: listTemplates("two-approvers"),
+					MockListTagsForResource:                              listTags(map[string]string{"k": "v"}),
+				},
+				cr: repository(withTemplates("two-approvers"), withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: repository(withTemplates("two-approvers"), withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				codecommit: &fake.MockRepositoryClient{
+					MockGetRepository:                                    getRepository,
+					MockGetRepositoryTriggers:                            getTriggers(),
+					MockListAssociatedApprovalRuleTemplatesForRepository: listTemplates(),
+					MockListTagsForResource:                              listTags(nil),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   repository(withDescription(nil)),
+			},
+			want: want{
+				cr: repository(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails,
+				},
+			},
+		},
+		"TriggersNotUpToDate": {
+			args: args{
+				codecommit: &fake.MockRepositoryClient{
+					MockGetRepository:                                    getRepository,
+					MockGetRepositoryTriggers:                            getTriggers(),
+					MockListAssociatedApprovalRuleTemplatesForRepository: listTemplates(),
+					MockListTagsForResource:                              listTags(nil),
+				},
+				cr: repository(withTrigger("all")),
+			},
+			want: want{
+				cr: repository(withTrigger("all"), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: connectionDetails,
+				},
+			},
+		},
+		"TemplatesNotUpToDate": {
+			args: args{
+				codecommit: &fake.MockRepositoryClient{
+					MockGetRepository:                                    getRepository,
+					MockGetRepositoryTriggers:                            getTriggers(),
+					MockListAssociatedApprovalRuleTemplatesForRepository: listTemplates("stale"),
+					MockListTagsForResource:                              listTags(nil),
+				},
+				cr: repository(),
+			},
+			want: want{
+				cr: repository(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: connectionDetails,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				codecommit: &fake.MockRepositoryClient{
+					MockGetRepository: func(*awscodecommit.GetRepositoryInput) awscodecommit.GetRepositoryRequest {
+						return awscodecommit.GetRepositoryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awscodecommit.ErrCodeRepositoryDoesNotExistException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: repository(),
+			},
+			want: want{
+				cr: repository(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				codecommit: &fake.MockRepositoryClient{
+					MockGetRepository: func(*awscodecommit.GetRepositoryInput) awscodecommit.GetRepositoryRequest {
+						return awscodecommit.GetRepositoryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: repository(),
+			},
+			want: want{
+				cr:  repository(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.codecommit, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				codecommit: &fake.MockRepositoryClient{
+					MockCreateRepository: func(input *awscodecommit.CreateRepositoryInput) awscodecommit.CreateRepositoryRequest {
+						if diff := cmp.Diff(map[string]string{"k": "v"}, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodecommit.CreateRepositoryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodecommit.CreateRepositoryOutput{RepositoryMetadata: metadata()}},
+						}
+					},
+				},
+				cr: repository(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr:     repository(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{ConnectionDetails: connectionDetails},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				codecommit: &fake.MockRepositoryClient{
+					MockCreateRepository: func(*awscodecommit.CreateRepositoryInput) awscodecommit.CreateRepositoryRequest {
+						return awscodecommit.CreateRepositoryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: repository(),
+			},
+			want: want{
+				cr:  repository(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.codecommit, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateRepository": {
+			args: args{
+				codecommit: &fake.MockRepositoryClient{
+					MockGetRepository: getRepository,
+					MockUpdateRepositoryDescription: func(input *awscodecommit.UpdateRepositoryDescriptionInput) awscodecommit.UpdateRepositoryDescriptionRequest {
+						if diff := cmp.Diff(aws.String("new"), input.RepositoryDescription); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodecommit.UpdateRepositoryDescriptionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodecommit.UpdateRepositoryDescriptionOutput{}},
+						}
+					},
+					MockUpdateDefaultBranch: func(input *awscodecommit.UpdateDefaultBranchInput) awscodecommit.UpdateDefaultBranchRequest {
+						if diff := cmp.Diff(aws.String("main"), input.DefaultBranchName); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodecommit.UpdateDefaultBranchRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodecommit.UpdateDefaultBranchOutput{}},
+						}
+					},
+					MockGetRepositoryTriggers:                            getTriggers(),
+					MockListAssociatedApprovalRuleTemplatesForRepository: listTemplates(),
+					MockListTagsForResource:                              listTags(nil),
+				},
+				cr: repository(withDescription(aws.String("new")), withDefaultBranch("main")),
+			},
+			want: want{
+				cr: repository(withDescription(aws.String("new")), withDefaultBranch("main")),
+			},
+		},
+		"UpdateTriggersAndTemplates": {
+			args: args{
+				codecommit: &fake.MockRepositoryClient{
+					MockGetRepository:         getRepository,
+					MockGetRepositoryTriggers: getTriggers(),
+					MockPutRepositoryTriggers: func(input *awscodecommit.PutRepositoryTriggersInput) awscodecommit.PutRepositoryTriggersRequest {
+						if diff := cmp.Diff(aws.String("notify"), input.Triggers[0].Name); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodecommit.PutRepositoryTriggersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodecommit.PutRepositoryTriggersOutput{}},
+						}
+					},
+					MockListAssociatedApprovalRuleTemplatesForRepository: listTemplates("stale"),
+					MockDisassociateApprovalRuleTemplateFromRepository: func(input *awscodecommit.DisassociateApprovalRuleTemplateFromRepositoryInput) awscodecommit.DisassociateApprovalRuleTemplateFromRepositoryRequest {
+						if diff := cmp.Diff(aws.String("stale"), input.ApprovalRuleTemplateName); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodecommit.DisassociateApprovalRuleTemplateFromRepositoryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodecommit.DisassociateApprovalRuleTemplateFromRepositoryOutput{}},
+						}
+					},
+					MockAssociateApprovalRuleTemplateWithRepository: func(input *awscodecommit.AssociateApprovalRuleTemplateWithRepositoryInput) awscodecommit.AssociateApprovalRuleTemplateWithRepositoryRequest {
+						if diff := cmp.Diff(aws.String("two-approvers"), input.ApprovalRuleTemplateName); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodecommit.AssociateApprovalRuleTemplateWithRepositoryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodecommit.AssociateApprovalRuleTemplateWithRepositoryOutput{}},
+						}
+					},
+					MockListTagsForResource: listTags(nil),
+				},
+				cr: repository(withTrigger("all"), withTemplates("two-approvers")),
+			},
+			want: want{
+				cr: repository(withTrigger("all"), withTemplates("two-approvers")),
+			},
+		},
+		"UpdateTags": {
+			args: args{
+				codecommit: &fake.MockRepositoryClient{
+					MockGetRepository:                                    getRepository,
+					MockGetRepositoryTriggers:                            getTriggers(),
+					MockListAssociatedApprovalRuleTemplatesForRepository: listTemplates(),
+					MockListTagsForResource:                              listTags(map[string]string{"stale": "v"}),
+					MockUntagResource: func(input *awscodecommit.UntagResourceInput) awscodecommit.UntagResourceRequest {
+						if diff := cmp.Diff([]string{"stale"}, input.TagKeys); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodecommit.UntagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodecommit.UntagResourceOutput{}},
+						}
+					},
+					MockTagResource: func(input *awscodecommit.TagResourceInput) awscodecommit.TagResourceRequest {
+						if diff := cmp.Diff(map[string]string{"k": "v"}, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodecommit.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodecommit.TagResourceOutput{}},
+						}
+					},
+				},
+				cr: repository(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: repository(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				codecommit: &fake.MockRepositoryClient{
+					MockGetRepository:         getRepository,
+					MockGetRepositoryTriggers: getTriggers(),
+					MockPutRepositoryTriggers: func(*awscodecommit.PutRepositoryTriggersInput) awscodecommit.PutRepositoryTriggersRequest {
+						return awscodecommit.PutRepositoryTriggersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: repository(withTrigger("all")),
+			},
+			want: want{
+				cr:  repository(withTrigger("all")),
+				err: errors.Wrap(errBoom, errPutTriggers),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.codecommit, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				codecommit: &fake.MockRepositoryClient{
+					MockDeleteRepository: func(*awscodecommit.DeleteRepositoryInput) awscodecommit.DeleteRepositoryRequest {
+						return awscodecommit.DeleteRepositoryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodecommit.DeleteRepositoryOutput{}},
+						}
+					},
+				},
+				cr: repository(),
+			},
+			want: want{
+				cr: repository(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				codecommit: &fake.MockRepositoryClient{
+					MockDeleteRepository: func(*awscodecommit.DeleteRepositoryInput) awscodecommit.DeleteRepositoryRequest {
+						return awscodecommit.DeleteRepositoryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: repository(),
+			},
+			want: want{
+				cr:  repository(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.codecommit, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
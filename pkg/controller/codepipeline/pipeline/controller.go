@@ -0,0 +1,282 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipeline
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscodepipeline "github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/codepipeline/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/codepipeline"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a CodePipeline Pipeline resource"
+
+	errDescribe          = "failed to describe the Pipeline resource"
+	errCreate            = "failed to create the Pipeline resource"
+	errUpdate            = "failed to update the Pipeline resource"
+	errDelete            = "failed to delete the Pipeline resource"
+	errListTags          = "failed to list the tags of the Pipeline resource"
+	errAddTags           = "failed to add tags to the Pipeline resource"
+	errRemoveTags        = "failed to remove tags from the Pipeline resource"
+	errListWebhooks      = "failed to list the webhooks of the Pipeline resource"
+	errPutWebhook        = "failed to put the webhook of the Pipeline resource"
+	errRegisterWebhook   = "failed to register the webhook of the Pipeline resource with the third party"
+	errDeregisterWebhook = "failed to deregister the webhook of the Pipeline resource from the third party"
+	errDeleteWebhook     = "failed to delete the webhook of the Pipeline resource"
+	errSpecUpdate        = "cannot update spec of the Pipeline custom resource"
+)
+
+// SetupPipeline adds a controller that reconciles Pipelines.
+func SetupPipeline(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.PipelineGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Pipeline{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PipelineGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: codepipeline.NewPipelineClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) codepipeline.PipelineClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Pipeline)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client codepipeline.PipelineClient
+}
+
+// webhook returns the webhook of the pipeline, or nil if it has none.
+func (e *external) webhook(ctx context.Context, name string) (*awscodepipeline.ListWebhookItem, error) {
+	in := &awscodepipeline.ListWebhooksInput{}
+	for {
+		rsp, err := e.client.ListWebhooksRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rsp.Webhooks {
+			if d := rsp.Webhooks[i].Definition; d != nil && aws.StringValue(d.Name) == name {
+				return &rsp.Webhooks[i], nil
+			}
+		}
+		if rsp.NextToken == nil {
+			return nil, nil
+		}
+		in.NextToken = rsp.NextToken
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Pipeline)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	name := meta.GetExternalName(cr)
+	observed, err := e.client.GetPipelineRequest(&awscodepipeline.GetPipelineInput{Name: aws.String(name)}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(codepipeline.IsPipelineNotFound, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	codepipeline.LateInitializePipeline(&cr.Spec.ForProvider, observed.Pipeline)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = codepipeline.GeneratePipelineObservation(*observed.GetPipelineOutput)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	tags, err := e.client.ListTagsForResourceRequest(&awscodepipeline.ListTagsForResourceInput{ResourceArn: aws.String(cr.Status.AtProvider.ARN)}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := codepipeline.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+
+	webhook, err := e.webhook(ctx, name)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListWebhooks)
+	}
+	var conn managed.ConnectionDetails
+	if webhook != nil {
+		cr.Status.AtProvider.WebhookARN = aws.StringValue(webhook.Arn)
+		conn = codepipeline.GetWebhookConnectionDetails(*webhook)
+	}
+
+	upToDate := len(add) == 0 && len(remove) == 0 &&
+		codepipeline.IsPipelineUpToDate(cr.Spec.ForProvider, *observed.Pipeline) &&
+		isWebhookUpToDate(cr.Spec.ForProvider.Webhook, webhook)
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: conn,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Pipeline)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	name := meta.GetExternalName(cr)
+	if _, err := e.client.CreatePipelineRequest(codepipeline.GenerateCreatePipelineInput(name, cr.Spec.ForProvider)).Send(ctx); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	return managed.ExternalCreation{}, e.syncWebhook(ctx, cr, nil)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Pipeline)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	name := meta.GetExternalName(cr)
+	observed, err := e.client.GetPipelineRequest(&awscodepipeline.GetPipelineInput{Name: aws.String(name)}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if !codepipeline.IsPipelineUpToDate(cr.Spec.ForProvider, *observed.Pipeline) {
+		d := codepipeline.GeneratePipelineDeclaration(name, cr.Spec.ForProvider)
+		d.Version = observed.Pipeline.Version
+		if _, err := e.client.UpdatePipelineRequest(&awscodepipeline.UpdatePipelineInput{Pipeline: d}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	arn := aws.String(cr.Status.AtProvider.ARN)
+	tags, err := e.client.ListTagsForResourceRequest(&awscodepipeline.ListTagsForResourceInput{ResourceArn: arn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := codepipeline.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.UntagResourceRequest(&awscodepipeline.UntagResourceInput{ResourceArn: arn, TagKeys: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.TagResourceRequest(&awscodepipeline.TagResourceInput{ResourceArn: arn, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	webhook, err := e.webhook(ctx, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListWebhooks)
+	}
+	return managed.ExternalUpdate{}, e.syncWebhook(ctx, cr, webhook)
+}
+
+// syncWebhook puts or deletes the webhook of the pipeline so that it matches
+// the desired webhook. A new webhook is registered with the third party if
+// requested.
+func (e *external) syncWebhook(ctx context.Context, cr *v1alpha1.Pipeline, observed *awscodepipeline.ListWebhookItem) error {
+	name := meta.GetExternalName(cr)
+	desired := cr.Spec.ForProvider.Webhook
+	switch {
+	case isWebhookUpToDate(desired, observed):
+		return nil
+	case desired == nil:
+		_, err := e.client.DeleteWebhookRequest(&awscodepipeline.DeleteWebhookInput{Name: aws.String(name)}).Send(ctx)
+		return errors.Wrap(resource.Ignore(codepipeline.IsWebhookNotFound, err), errDeleteWebhook)
+	}
+	token, err := codepipeline.GetWebhookSecretToken(ctx, e.kube, *desired)
+	if err != nil {
+		return err
+	}
+	if _, err := e.client.PutWebhookRequest(codepipeline.GeneratePutWebhookInput(name, *desired, token)).Send(ctx); err != nil {
+		return errors.Wrap(err, errPutWebhook)
+	}
+	if observed != nil || !aws.BoolValue(desired.RegisterWithThirdParty) {
+		return nil
+	}
+	_, err = e.client.RegisterWebhookWithThirdPartyRequest(&awscodepipeline.RegisterWebhookWithThirdPartyInput{WebhookName: aws.String(name)}).Send(ctx)
+	return errors.Wrap(err, errRegisterWebhook)
+}
+
+// Delete deletes the webhook of the pipeline, deregistering it from the third
+// party first if it was registered, and then the pipeline.
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Pipeline)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	name := aws.String(meta.GetExternalName(cr))
+	if cr.Status.AtProvider.WebhookARN != "" {
+		if w := cr.Spec.ForProvider.Webhook; w != nil && aws.BoolValue(w.RegisterWithThirdParty) {
+			_, err := e.client.DeregisterWebhookWithThirdPartyRequest(&awscodepipeline.DeregisterWebhookWithThirdPartyInput{WebhookName: name}).Send(ctx)
+			if resource.Ignore(codepipeline.IsWebhookNotFound, err) != nil {
+				return errors.Wrap(err, errDeregisterWebhook)
+			}
+		}
+		_, err := e.client.DeleteWebhookRequest(&awscodepipeline.DeleteWebhookInput{Name: name}).Send(ctx)
+		if resource.Ignore(codepipeline.IsWebhookNotFound, err) != nil {
+			return errors.Wrap(err, errDeleteWebhook)
+		}
+	}
+	_, err := e.client.DeletePipelineRequest(&awscodepipeline.DeletePipelineInput{Name: name}).Send(ctx)
+	return errors.Wrap(resource.Ignore(codepipeline.IsPipelineNotFound, err), errDelete)
+}
+
+// isWebhookUpToDate returns whether the observed webhook of a pipeline matches
+// the desired one. A pipeline without a desired webhook must not have one.
+func isWebhookUpToDate(desired *v1alpha1.Webhook, observed *awscodepipeline.ListWebhookItem) bool {
+	if desired == nil || observed == nil || observed.Definition == nil {
+		return desired == nil && observed == nil
+	}
+	return codepipeline.IsWebhookUpToDate(*desired, *observed.Definition)
+}
@@ -0,0 +1,676 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awscodepipeline "github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/codepipeline/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/codepipeline"
+	"github.com/crossplane/provider-aws/pkg/clients/codepipeline/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	pipelineName = "sample-pipeline"
+	pipelineARN  = "arn:aws:codepipeline:us-east-1:123456789012:sample-pipeline"
+	webhookARN   = "arn:aws:codepipeline:us-east-1:123456789012:webhook:sample-pipeline"
+	webhookURL   = "https://us-east-1.webhooks.aws/trigger?t=sample"
+	roleARN      = "arn:aws:iam::123456789012:role/codepipeline"
+	bucket       = "sample-artifacts"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	codepipeline codepipeline.PipelineClient
+	kube         *test.MockClient
+	cr           resource.Managed
+}
+
+type pipelineModifier func(*v1alpha1.Pipeline)
+
+func withConditions(c ...runtimev1alpha1.Condition) pipelineModifier {
+	return func(r *v1alpha1.Pipeline) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withBranch(b string) pipelineModifier {
+	return func(r *v1alpha1.Pipeline) { r.Spec.ForProvider.Stages[0].Actions[0].Configuration["Branch"] = b }
+}
+
+func withRunOrder(o *int64) pipelineModifier {
+	return func(r *v1alpha1.Pipeline) { r.Spec.ForProvider.Stages[1].Actions[0].RunOrder = o }
+}
+
+func withTags(t ...v1alpha1.Tag) pipelineModifier {
+	return func(r *v1alpha1.Pipeline) { r.Spec.ForProvider.Tags = t }
+}
+
+func withWebhook(register bool) pipelineModifier {
+	return func(r *v1alpha1.Pipeline) {
+		r.Spec.ForProvider.Webhook = &v1alpha1.Webhook{
+			TargetAction:   "Source",
+			Authentication: "GITHUB_HMAC",
+			SecretTokenSecretRef: &runtimev1alpha1.SecretKeySelector{
+				SecretReference: runtimev1alpha1.SecretReference{Name: "token", Namespace: "default"},
+				Key:             "token",
+			},
+			Filters:                []v1alpha1.WebhookFilterRule{{JSONPath: "$.ref", MatchEquals: aws.String("refs/heads/{Branch}")}},
+			RegisterWithThirdParty: aws.Bool(register),
+		}
+	}
+}
+
+func withObservation(webhook bool) pipelineModifier {
+	return func(r *v1alpha1.Pipeline) {
+		r.Status.AtProvider = v1alpha1.PipelineObservation{ARN: pipelineARN, Version: 1}
+		if webhook {
+			r.Status.AtProvider.WebhookARN = webhookARN
+		}
+	}
+}
+
+func pipeline(m ...pipelineModifier) *v1alpha1.Pipeline {
+	cr := &v1alpha1.Pipeline{
+		Spec: v1alpha1.PipelineSpec{
+			ForProvider: v1alpha1.PipelineParameters{
+				RoleARN:       aws.String(roleARN),
+				ArtifactStore: v1alpha1.ArtifactStore{Location: aws.String(bucket)},
+				Stages: []v1alpha1.Stage{
+					{
+						Name: "Source",
+						Actions: []v1alpha1.Action{{
+							Name:            "Source",
+							ActionTypeID:    v1alpha1.ActionTypeID{Category: "Source", Owner: "ThirdParty", Provider: "GitHub", Version: "1"},
+							Configuration:   map[string]string{"Owner": "crossplane", "Repo": "provider-aws", "Branch": "master"},
+							OutputArtifacts: []string{"source"},
+							RunOrder:        aws.Int64(1),
+						}},
+					},
+					{
+						Name: "Build",
+						Actions: []v1alpha1.Action{{
+							Name:           "Build",
+							ActionTypeID:   v1alpha1.ActionTypeID{Category: "Build", Owner: "AWS", Provider: "CodeBuild", Version: "1"},
+							Configuration:  map[string]string{"ProjectName": "sample-project"},
+							InputArtifacts: []string{"source"},
+							RunOrder:       aws.Int64(1),
+						}},
+					},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, pipelineName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getPipeline() func(*awscodepipeline.GetPipelineInput) awscodepipeline.GetPipelineRequest {
+	d := codepipeline.GeneratePipelineDeclaration(pipelineName, pipeline().Spec.ForProvider)
+	d.Version = aws.Int64(1)
+	return func(*awscodepipeline.GetPipelineInput) awscodepipeline.GetPipelineRequest {
+		return awscodepipeline.GetPipelineRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodepipeline.GetPipelineOutput{
+				Pipeline: d,
+				Metadata: &awscodepipeline.PipelineMetadata{PipelineArn: aws.String(pipelineARN)},
+			}},
+		}
+	}
+}
+
+func listTags(t ...awscodepipeline.Tag) func(*awscodepipeline.ListTagsForResourceInput) awscodepipeline.ListTagsForResourceRequest {
+	return func(*awscodepipeline.ListTagsForResourceInput) awscodepipeline.ListTagsForResourceRequest {
+		return awscodepipeline.ListTagsForResourceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodepipeline.ListTagsForResourceOutput{Tags: t}},
+		}
+	}
+}
+
+func listWebhooks(webhook bool) func(*awscodepipeline.ListWebhooksInput) awscodepipeline.ListWebhooksRequest {
+	var items []awscodepipeline.ListWebhookItem
+	if webhook {
+		w := pipeline(withWebhook(false)).Spec.ForProvider.Webhook
+		items = append(items, awscodepipeline.ListWebhookItem{
+			Arn:        aws.String(webhookARN),
+			Url:        aws.String(webhookURL),
+			Definition: codepipeline.GeneratePutWebhookInput(pipelineName, *w, "").Webhook,
+		})
+	}
+	return func(*awscodepipeline.ListWebhooksInput) awscodepipeline.ListWebhooksRequest {
+		return awscodepipeline.ListWebhooksRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodepipeline.ListWebhooksOutput{Webhooks: items}},
+		}
+	}
+}
+
+func getSecret(value string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+		s := obj.(*corev1.Secret)
+		s.Data = map[string][]byte{"token": []byte(value)}
+		return nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockGetPipeline:         getPipeline(),
+					MockListTagsForResource: listTags(),
+					MockListWebhooks:        listWebhooks(true),
+				},
+				cr: pipeline(withWebhook(false)),
+			},
+			want: want{
+				cr: pipeline(withWebhook(false), withObservation(true), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						codepipeline.ConnectionDetailsWebhookURL: []byte(webhookURL),
+					},
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockGetPipeline:         getPipeline(),
+					MockListTagsForResource: listTags(),
+					MockListWebhooks:        listWebhooks(false),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   pipeline(withRunOrder(nil)),
+			},
+			want: want{
+				cr: pipeline(withObservation(false), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PipelineNotUpToDate": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockGetPipeline:         getPipeline(),
+					MockListTagsForResource: listTags(),
+					MockListWebhooks:        listWebhooks(false),
+				},
+				cr: pipeline(withBranch("main")),
+			},
+			want: want{
+				cr: pipeline(withBranch("main"), withObservation(false), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"TagsNotUpToDate": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockGetPipeline:         getPipeline(),
+					MockListTagsForResource: listTags(),
+					MockListWebhooks:        listWebhooks(false),
+				},
+				cr: pipeline(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: pipeline(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withObservation(false), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"WebhookNotUpToDate": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockGetPipeline:         getPipeline(),
+					MockListTagsForResource: listTags(),
+					MockListWebhooks:        listWebhooks(false),
+				},
+				cr: pipeline(withWebhook(false)),
+			},
+			want: want{
+				cr: pipeline(withWebhook(false), withObservation(false), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockGetPipeline: func(*awscodepipeline.GetPipelineInput) awscodepipeline.GetPipelineRequest {
+						return awscodepipeline.GetPipelineRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awscodepipeline.ErrCodePipelineNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: pipeline(),
+			},
+			want: want{
+				cr: pipeline(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockGetPipeline: func(*awscodepipeline.GetPipelineInput) awscodepipeline.GetPipelineRequest {
+						return awscodepipeline.GetPipelineRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: pipeline(),
+			},
+			want: want{
+				cr:  pipeline(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.codepipeline, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	create := func(*awscodepipeline.CreatePipelineInput) awscodepipeline.CreatePipelineRequest {
+		return awscodepipeline.CreatePipelineRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodepipeline.CreatePipelineOutput{}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockCreatePipeline: func(input *awscodepipeline.CreatePipelineInput) awscodepipeline.CreatePipelineRequest {
+						want := []awscodepipeline.Tag{{Key: aws.String("k"), Value: aws.String("v")}}
+						if diff := cmp.Diff(want, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return create(input)
+					},
+				},
+				cr: pipeline(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: pipeline(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"WithWebhook": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockCreatePipeline: create,
+					MockPutWebhook: func(input *awscodepipeline.PutWebhookInput) awscodepipeline.PutWebhookRequest {
+						if diff := cmp.Diff(aws.String("secret"), input.Webhook.AuthenticationConfiguration.SecretToken); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodepipeline.PutWebhookRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodepipeline.PutWebhookOutput{}},
+						}
+					},
+					MockRegisterWebhookWithThirdParty: func(input *awscodepipeline.RegisterWebhookWithThirdPartyInput) awscodepipeline.RegisterWebhookWithThirdPartyRequest {
+						if diff := cmp.Diff(aws.String(pipelineName), input.WebhookName); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodepipeline.RegisterWebhookWithThirdPartyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodepipeline.RegisterWebhookWithThirdPartyOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: getSecret("secret")},
+				cr:   pipeline(withWebhook(true)),
+			},
+			want: want{
+				cr: pipeline(withWebhook(true), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockCreatePipeline: func(*awscodepipeline.CreatePipelineInput) awscodepipeline.CreatePipelineRequest {
+						return awscodepipeline.CreatePipelineRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: pipeline(),
+			},
+			want: want{
+				cr:  pipeline(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"WebhookError": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockCreatePipeline: create,
+					MockPutWebhook: func(*awscodepipeline.PutWebhookInput) awscodepipeline.PutWebhookRequest {
+						return awscodepipeline.PutWebhookRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: getSecret("secret")},
+				cr:   pipeline(withWebhook(false)),
+			},
+			want: want{
+				cr:  pipeline(withWebhook(false), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errPutWebhook),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.codepipeline, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdatePipeline": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockGetPipeline: getPipeline(),
+					MockUpdatePipeline: func(input *awscodepipeline.UpdatePipelineInput) awscodepipeline.UpdatePipelineRequest {
+						if diff := cmp.Diff("main", input.Pipeline.Stages[0].Actions[0].Configuration["Branch"]); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(aws.Int64(1), input.Pipeline.Version); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodepipeline.UpdatePipelineRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodepipeline.UpdatePipelineOutput{}},
+						}
+					},
+					MockListTagsForResource: listTags(),
+					MockListWebhooks:        listWebhooks(false),
+				},
+				cr: pipeline(withBranch("main")),
+			},
+			want: want{
+				cr: pipeline(withBranch("main")),
+			},
+		},
+		"UpdateTags": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockGetPipeline:         getPipeline(),
+					MockListTagsForResource: listTags(awscodepipeline.Tag{Key: aws.String("stale"), Value: aws.String("v")}),
+					MockUntagResource: func(input *awscodepipeline.UntagResourceInput) awscodepipeline.UntagResourceRequest {
+						if diff := cmp.Diff([]string{"stale"}, input.TagKeys); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodepipeline.UntagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodepipeline.UntagResourceOutput{}},
+						}
+					},
+					MockTagResource: func(input *awscodepipeline.TagResourceInput) awscodepipeline.TagResourceRequest {
+						if diff := cmp.Diff([]awscodepipeline.Tag{{Key: aws.String("k"), Value: aws.String("v")}}, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodepipeline.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodepipeline.TagResourceOutput{}},
+						}
+					},
+					MockListWebhooks: listWebhooks(false),
+				},
+				cr: pipeline(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: pipeline(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+		},
+		"DeleteWebhook": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockGetPipeline:         getPipeline(),
+					MockListTagsForResource: listTags(),
+					MockListWebhooks:        listWebhooks(true),
+					MockDeleteWebhook: func(input *awscodepipeline.DeleteWebhookInput) awscodepipeline.DeleteWebhookRequest {
+						if diff := cmp.Diff(aws.String(pipelineName), input.Name); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscodepipeline.DeleteWebhookRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodepipeline.DeleteWebhookOutput{}},
+						}
+					},
+				},
+				cr: pipeline(),
+			},
+			want: want{
+				cr: pipeline(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockGetPipeline: getPipeline(),
+					MockUpdatePipeline: func(*awscodepipeline.UpdatePipelineInput) awscodepipeline.UpdatePipelineRequest {
+						return awscodepipeline.UpdatePipelineRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: pipeline(withBranch("main")),
+			},
+			want: want{
+				cr:  pipeline(withBranch("main")),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.codepipeline, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deletePipeline := func(*awscodepipeline.DeletePipelineInput) awscodepipeline.DeletePipelineRequest {
+		return awscodepipeline.DeletePipelineRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodepipeline.DeletePipelineOutput{}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{MockDeletePipeline: deletePipeline},
+				cr:           pipeline(),
+			},
+			want: want{
+				cr: pipeline(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"WithWebhook": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockDeregisterWebhookWithThirdParty: func(*awscodepipeline.DeregisterWebhookWithThirdPartyInput) awscodepipeline.DeregisterWebhookWithThirdPartyRequest {
+						return awscodepipeline.DeregisterWebhookWithThirdPartyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscodepipeline.DeregisterWebhookWithThirdPartyOutput{}},
+						}
+					},
+					MockDeleteWebhook: func(*awscodepipeline.DeleteWebhookInput) awscodepipeline.DeleteWebhookRequest {
+						return awscodepipeline.DeleteWebhookRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awscodepipeline.ErrCodeWebhookNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+					MockDeletePipeline: deletePipeline,
+				},
+				cr: pipeline(withWebhook(true), withObservation(true)),
+			},
+			want: want{
+				cr: pipeline(withWebhook(true), withObservation(true), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				codepipeline: &fake.MockPipelineClient{
+					MockDeletePipeline: func(*awscodepipeline.DeletePipelineInput) awscodepipeline.DeletePipelineRequest {
+						return awscodepipeline.DeletePipelineRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: pipeline(),
+			},
+			want: want{
+				cr:  pipeline(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.codepipeline, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
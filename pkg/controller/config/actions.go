@@ -0,0 +1,784 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	acm "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	acmpca "github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
+	amplify "github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	appmesh "github.com/crossplane/provider-aws/apis/appmesh/v1alpha1"
+	athena "github.com/crossplane/provider-aws/apis/athena/v1alpha1"
+	autoscaling "github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
+	backup "github.com/crossplane/provider-aws/apis/backup/v1alpha1"
+	batch "github.com/crossplane/provider-aws/apis/batch/v1alpha1"
+	budgets "github.com/crossplane/provider-aws/apis/budgets/v1alpha1"
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	cachev1beta1 "github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	cloudtrail "github.com/crossplane/provider-aws/apis/cloudtrail/v1alpha1"
+	cloudwatch "github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	codebuild "github.com/crossplane/provider-aws/apis/codebuild/v1alpha1"
+	codecommit "github.com/crossplane/provider-aws/apis/codecommit/v1alpha1"
+	codepipeline "github.com/crossplane/provider-aws/apis/codepipeline/v1alpha1"
+	configservice "github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+	databasev1alpha1 "github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	databasev1beta1 "github.com/crossplane/provider-aws/apis/database/v1beta1"
+	datasync "github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+	docdb "github.com/crossplane/provider-aws/apis/docdb/v1alpha1"
+	ec2v1alpha1 "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	ec2v1alpha4 "github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	ecr "github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	eksv1alpha1 "github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	eksv1beta1 "github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	elasticbeanstalk "github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+	elb "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+	elbv2 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	emr "github.com/crossplane/provider-aws/apis/emr/v1alpha1"
+	facts "github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+	fsx "github.com/crossplane/provider-aws/apis/fsx/v1alpha1"
+	globalaccelerator "github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	glue "github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	guardduty "github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
+	identityv1alpha1 "github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	lakeformation "github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+	lightsail "github.com/crossplane/provider-aws/apis/lightsail/v1alpha1"
+	macie2 "github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
+	mq "github.com/crossplane/provider-aws/apis/mq/v1alpha1"
+	neptune "github.com/crossplane/provider-aws/apis/neptune/v1alpha1"
+	notification "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	opensearchservice "github.com/crossplane/provider-aws/apis/opensearchservice/v1alpha1"
+	organizations "github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	qldb "github.com/crossplane/provider-aws/apis/qldb/v1alpha1"
+	ram "github.com/crossplane/provider-aws/apis/ram/v1alpha1"
+	redshift "github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
+	route53 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+	route53resolver "github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	s3v1alpha1 "github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	sagemaker "github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	securityhub "github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
+	servicecatalog "github.com/crossplane/provider-aws/apis/servicecatalog/v1alpha1"
+	servicediscovery "github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	shield "github.com/crossplane/provider-aws/apis/shield/v1alpha1"
+	sqs "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	synthetics "github.com/crossplane/provider-aws/apis/synthetics/v1alpha1"
+	wafv2 "github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+	xray "github.com/crossplane/provider-aws/apis/xray/v1alpha1"
+)
+
+// ec2TagActions are the actions of the controllers of EC2 kinds that manage
+// tags.
+var ec2TagActions = []string{"ec2:CreateTags", "ec2:DeleteTags"}
+
+func withEC2Tags(actions ...string) []string {
+	return append(actions, ec2TagActions...)
+}
+
+// RequiredActions are the IAM actions the controller of each kind of managed
+// resource calls, by the group kind of the managed resource.
+var RequiredActions = map[string][]string{
+	acm.CertificateGroupKind: {
+		"acm:RequestCertificate", "acm:DescribeCertificate", "acm:DeleteCertificate",
+		"acm:AddTagsToCertificate", "acm:RemoveTagsFromCertificate", "acm:ListTagsForCertificate",
+		"acm:UpdateCertificateOptions", "acm:RenewCertificate",
+	},
+	acmpca.CertificateAuthorityGroupKind: {
+		"acm-pca:CreateCertificateAuthority", "acm-pca:DescribeCertificateAuthority",
+		"acm-pca:UpdateCertificateAuthority", "acm-pca:DeleteCertificateAuthority",
+		"acm-pca:TagCertificateAuthority", "acm-pca:UntagCertificateAuthority", "acm-pca:ListTags",
+	},
+	acmpca.CertificateAuthorityPermissionGroupKind: {
+		"acm-pca:CreatePermission", "acm-pca:ListPermissions", "acm-pca:DeletePermission",
+	},
+	amplify.AppGroupKind: {
+		"amplify:CreateApp", "amplify:GetApp", "amplify:UpdateApp", "amplify:DeleteApp", "amplify:TagResource",
+		"iam:PassRole",
+	},
+	amplify.BranchGroupKind: {
+		"amplify:CreateBranch", "amplify:GetBranch", "amplify:UpdateBranch", "amplify:DeleteBranch",
+		"amplify:TagResource",
+	},
+	amplify.DomainAssociationGroupKind: {
+		"amplify:CreateDomainAssociation", "amplify:GetDomainAssociation", "amplify:UpdateDomainAssociation",
+		"amplify:DeleteDomainAssociation",
+	},
+	appmesh.MeshGroupKind: {
+		"appmesh:CreateMesh", "appmesh:DescribeMesh", "appmesh:UpdateMesh", "appmesh:DeleteMesh",
+		"appmesh:ListTagsForResource", "appmesh:TagResource", "appmesh:UntagResource",
+	},
+	appmesh.VirtualNodeGroupKind: {
+		"appmesh:CreateVirtualNode", "appmesh:DescribeVirtualNode", "appmesh:UpdateVirtualNode", "appmesh:DeleteVirtualNode",
+		"appmesh:ListTagsForResource", "appmesh:TagResource", "appmesh:UntagResource",
+	},
+	appmesh.VirtualServiceGroupKind: {
+		"appmesh:CreateVirtualService", "appmesh:DescribeVirtualService", "appmesh:UpdateVirtualService", "appmesh:DeleteVirtualService",
+		"appmesh:ListTagsForResource", "appmesh:TagResource", "appmesh:UntagResource",
+	},
+	appmesh.VirtualRouterGroupKind: {
+		"appmesh:CreateVirtualRouter", "appmesh:DescribeVirtualRouter", "appmesh:UpdateVirtualRouter", "appmesh:DeleteVirtualRouter",
+		"appmesh:ListTagsForResource", "appmesh:TagResource", "appmesh:UntagResource",
+	},
+	appmesh.RouteGroupKind: {
+		"appmesh:CreateRoute", "appmesh:DescribeRoute", "appmesh:UpdateRoute", "appmesh:DeleteRoute",
+		"appmesh:ListTagsForResource", "appmesh:TagResource", "appmesh:UntagResource",
+	},
+	athena.WorkGroupGroupKind: {
+		"athena:CreateWorkGroup", "athena:GetWorkGroup", "athena:UpdateWorkGroup", "athena:DeleteWorkGroup",
+		"athena:TagResource",
+	},
+	athena.NamedQueryGroupKind: {
+		"athena:CreateNamedQuery", "athena:GetNamedQuery", "athena:DeleteNamedQuery",
+	},
+	autoscaling.AutoScalingGroupGroupKind: {
+		"autoscaling:CreateAutoScalingGroup", "autoscaling:DescribeAutoScalingGroups",
+		"autoscaling:UpdateAutoScalingGroup", "autoscaling:DeleteAutoScalingGroup",
+		"autoscaling:AttachLoadBalancerTargetGroups", "autoscaling:DetachLoadBalancerTargetGroups",
+		"autoscaling:CreateOrUpdateTags", "autoscaling:DeleteTags",
+		"ec2:RunInstances", "iam:PassRole",
+	},
+	backup.BackupVaultGroupKind: {
+		"backup:CreateBackupVault", "backup:DescribeBackupVault", "backup:DeleteBackupVault",
+		"backup:GetBackupVaultAccessPolicy", "backup:PutBackupVaultAccessPolicy", "backup:DeleteBackupVaultAccessPolicy",
+		"backup:ListTags", "backup:TagResource", "backup:UntagResource",
+		"backup-storage:MountCapsule", "kms:CreateGrant", "kms:DescribeKey",
+	},
+	backup.BackupPlanGroupKind: {
+		"backup:CreateBackupPlan", "backup:GetBackupPlan", "backup:UpdateBackupPlan", "backup:DeleteBackupPlan",
+		"backup:ListTags", "backup:TagResource", "backup:UntagResource",
+	},
+	backup.BackupSelectionGroupKind: {
+		"backup:CreateBackupSelection", "backup:GetBackupSelection", "backup:DeleteBackupSelection", "iam:PassRole",
+	},
+	batch.ComputeEnvironmentGroupKind: {
+		"batch:CreateComputeEnvironment", "batch:DescribeComputeEnvironments",
+		"batch:UpdateComputeEnvironment", "batch:DeleteComputeEnvironment",
+		"ec2:DescribeSubnets", "ec2:DescribeSecurityGroups", "iam:CreateServiceLinkedRole", "iam:PassRole",
+	},
+	batch.JobQueueGroupKind: {
+		"batch:CreateJobQueue", "batch:DescribeJobQueues", "batch:UpdateJobQueue", "batch:DeleteJobQueue",
+	},
+	batch.JobDefinitionGroupKind: {
+		"batch:RegisterJobDefinition", "batch:DescribeJobDefinitions", "batch:DeregisterJobDefinition", "iam:PassRole",
+	},
+	budgets.BudgetGroupKind: {
+		"budgets:ViewBudget", "budgets:ModifyBudget", "sts:GetCallerIdentity",
+	},
+	cachev1alpha1.CacheSubnetGroupGroupKind: {
+		"elasticache:CreateCacheSubnetGroup", "elasticache:DescribeCacheSubnetGroups",
+		"elasticache:ModifyCacheSubnetGroup", "elasticache:DeleteCacheSubnetGroup",
+	},
+	cachev1alpha1.CacheParameterGroupGroupKind: {
+		"elasticache:CreateCacheParameterGroup", "elasticache:DescribeCacheParameterGroups",
+		"elasticache:DescribeCacheParameters", "elasticache:ModifyCacheParameterGroup",
+		"elasticache:ResetCacheParameterGroup", "elasticache:DeleteCacheParameterGroup",
+	},
+	cachev1alpha1.CacheClusterGroupKind: {
+		"elasticache:CreateCacheCluster", "elasticache:DescribeCacheClusters",
+		"elasticache:ModifyCacheCluster", "elasticache:DeleteCacheCluster",
+	},
+	cachev1beta1.ReplicationGroupGroupKind: {
+		"elasticache:CreateReplicationGroup", "elasticache:DescribeReplicationGroups",
+		"elasticache:ModifyReplicationGroup", "elasticache:DeleteReplicationGroup",
+		"elasticache:DescribeCacheClusters", "elasticache:AddTagsToResource",
+		"elasticache:ListTagsForResource", "elasticache:RemoveTagsFromResource",
+	},
+	cloudtrail.TrailGroupKind: {
+		"cloudtrail:CreateTrail", "cloudtrail:GetTrail", "cloudtrail:GetTrailStatus",
+		"cloudtrail:UpdateTrail", "cloudtrail:DeleteTrail", "cloudtrail:StartLogging",
+		"cloudtrail:StopLogging", "cloudtrail:AddTags", "cloudtrail:RemoveTags", "cloudtrail:ListTags",
+	},
+	cloudwatch.AnomalyDetectorGroupKind: {
+		"cloudwatch:PutAnomalyDetector", "cloudwatch:DescribeAnomalyDetectors", "cloudwatch:DeleteAnomalyDetector",
+	},
+	cloudwatch.DashboardGroupKind: {
+		"cloudwatch:GetDashboard", "cloudwatch:PutDashboard", "cloudwatch:DeleteDashboards",
+	},
+	codebuild.ProjectGroupKind: {
+		"codebuild:CreateProject", "codebuild:BatchGetProjects", "codebuild:UpdateProject", "codebuild:DeleteProject",
+		"codebuild:CreateWebhook", "codebuild:UpdateWebhook", "codebuild:DeleteWebhook",
+		"ec2:DescribeSubnets", "ec2:DescribeSecurityGroups", "ec2:DescribeVpcs", "iam:PassRole",
+	},
+	codecommit.RepositoryGroupKind: {
+		"codecommit:CreateRepository", "codecommit:GetRepository", "codecommit:DeleteRepository",
+		"codecommit:UpdateRepositoryDescription", "codecommit:UpdateDefaultBranch",
+		"codecommit:GetRepositoryTriggers", "codecommit:PutRepositoryTriggers",
+		"codecommit:ListAssociatedApprovalRuleTemplatesForRepository", "codecommit:AssociateApprovalRuleTemplateWithRepository",
+		"codecommit:DisassociateApprovalRuleTemplateFromRepository",
+		"codecommit:ListTagsForResource", "codecommit:TagResource", "codecommit:UntagResource",
+	},
+	codepipeline.PipelineGroupKind: {
+		"codepipeline:CreatePipeline", "codepipeline:GetPipeline", "codepipeline:UpdatePipeline", "codepipeline:DeletePipeline",
+		"codepipeline:ListTagsForResource", "codepipeline:TagResource", "codepipeline:UntagResource",
+		"codepipeline:PutWebhook", "codepipeline:ListWebhooks", "codepipeline:DeleteWebhook",
+		"codepipeline:RegisterWebhookWithThirdParty", "codepipeline:DeregisterWebhookWithThirdParty", "iam:PassRole",
+	},
+	configservice.ConfigurationRecorderGroupKind: {
+		"config:PutConfigurationRecorder", "config:DescribeConfigurationRecorders",
+		"config:DescribeConfigurationRecorderStatus", "config:StartConfigurationRecorder",
+		"config:StopConfigurationRecorder", "config:DeleteConfigurationRecorder", "iam:PassRole",
+	},
+	configservice.DeliveryChannelGroupKind: {
+		"config:PutDeliveryChannel", "config:DescribeDeliveryChannels", "config:DeleteDeliveryChannel",
+	},
+	configservice.ConfigRuleGroupKind: {
+		"config:PutConfigRule", "config:DescribeConfigRules", "config:DeleteConfigRule",
+		"config:TagResource", "config:UntagResource", "config:ListTagsForResource",
+	},
+	databasev1alpha1.DBClusterGroupKind: {
+		"rds:CreateDBCluster", "rds:RestoreDBClusterFromSnapshot", "rds:DescribeDBClusters",
+		"rds:ModifyDBCluster", "rds:DeleteDBCluster",
+		"rds:ListTagsForResource", "rds:AddTagsToResource", "rds:RemoveTagsFromResource",
+	},
+	databasev1alpha1.DBProxyGroupKind: {
+		"rds:CreateDBProxy", "rds:DescribeDBProxies", "rds:ModifyDBProxy", "rds:DeleteDBProxy",
+		"rds:ListTagsForResource", "rds:AddTagsToResource", "rds:RemoveTagsFromResource", "iam:PassRole",
+	},
+	databasev1alpha1.DBProxyTargetGroupGroupKind: {
+		"rds:DescribeDBProxyTargetGroups", "rds:ModifyDBProxyTargetGroup", "rds:DescribeDBProxyTargets",
+		"rds:RegisterDBProxyTargets", "rds:DeregisterDBProxyTargets",
+	},
+	databasev1alpha1.DynamoTableGroupKind: {
+		"dynamodb:CreateTable", "dynamodb:DescribeTable", "dynamodb:UpdateTable", "dynamodb:DeleteTable",
+		"dynamodb:TagResource", "dynamodb:UntagResource", "dynamodb:ListTagsOfResource",
+	},
+	databasev1beta1.RDSInstanceGroupKind: {
+		"rds:CreateDBInstance", "rds:DescribeDBInstances", "rds:ModifyDBInstance", "rds:DeleteDBInstance",
+		"rds:RestoreDBInstanceFromDBSnapshot", "rds:RestoreDBInstanceToPointInTime",
+		"rds:AddTagsToResource", "rds:RemoveTagsFromResource", "rds:ListTagsForResource",
+	},
+	databasev1beta1.DBSubnetGroupGroupKind: {
+		"rds:CreateDBSubnetGroup", "rds:DescribeDBSubnetGroups", "rds:ModifyDBSubnetGroup",
+		"rds:DeleteDBSubnetGroup", "rds:AddTagsToResource", "rds:ListTagsForResource",
+	},
+	databasev1beta1.DBParameterGroupGroupKind: {
+		"rds:CreateDBParameterGroup", "rds:DescribeDBParameterGroups", "rds:DeleteDBParameterGroup",
+		"rds:DescribeDBParameters", "rds:ModifyDBParameterGroup", "rds:ResetDBParameterGroup",
+		"rds:ListTagsForResource", "rds:AddTagsToResource", "rds:RemoveTagsFromResource",
+	},
+	databasev1beta1.OptionGroupGroupKind: {
+		"rds:CreateOptionGroup", "rds:DescribeOptionGroups", "rds:ModifyOptionGroup", "rds:DeleteOptionGroup",
+		"rds:ListTagsForResource", "rds:AddTagsToResource", "rds:RemoveTagsFromResource",
+	},
+	databasev1beta1.DBSnapshotGroupKind: {
+		"rds:CreateDBSnapshot", "rds:DescribeDBSnapshots", "rds:DeleteDBSnapshot",
+		"rds:ListTagsForResource", "rds:AddTagsToResource", "rds:RemoveTagsFromResource",
+	},
+	datasync.LocationS3GroupKind: {
+		"datasync:CreateLocationS3", "datasync:DescribeLocationS3", "datasync:DeleteLocation",
+		"datasync:ListTagsForResource", "datasync:TagResource", "datasync:UntagResource", "iam:PassRole",
+	},
+	datasync.LocationEFSGroupKind: {
+		"datasync:CreateLocationEfs", "datasync:DescribeLocationEfs", "datasync:DeleteLocation",
+		"datasync:ListTagsForResource", "datasync:TagResource", "datasync:UntagResource",
+		"elasticfilesystem:DescribeFileSystems", "elasticfilesystem:DescribeMountTargets",
+		"ec2:DescribeSubnets", "ec2:DescribeSecurityGroups", "ec2:CreateNetworkInterface",
+	},
+	datasync.LocationNFSGroupKind: {
+		"datasync:CreateLocationNfs", "datasync:DescribeLocationNfs", "datasync:DeleteLocation",
+		"datasync:ListTagsForResource", "datasync:TagResource", "datasync:UntagResource",
+	},
+	datasync.TaskGroupKind: {
+		"datasync:CreateTask", "datasync:DescribeTask", "datasync:UpdateTask", "datasync:DeleteTask",
+		"datasync:ListTagsForResource", "datasync:TagResource", "datasync:UntagResource",
+		"logs:DescribeLogGroups",
+	},
+	docdb.DBClusterGroupKind: {
+		"rds:CreateDBCluster", "rds:RestoreDBClusterFromSnapshot", "rds:DescribeDBClusters",
+		"rds:ModifyDBCluster", "rds:DeleteDBCluster",
+		"rds:ListTagsForResource", "rds:AddTagsToResource", "rds:RemoveTagsFromResource",
+	},
+	docdb.DBInstanceGroupKind: {
+		"rds:CreateDBInstance", "rds:DescribeDBInstances", "rds:ModifyDBInstance", "rds:DeleteDBInstance",
+		"rds:ListTagsForResource", "rds:AddTagsToResource", "rds:RemoveTagsFromResource",
+	},
+	ec2v1alpha1.FlowLogGroupKind: withEC2Tags(
+		"ec2:CreateFlowLogs", "ec2:DescribeFlowLogs", "ec2:DeleteFlowLogs", "iam:PassRole",
+		"logs:CreateLogDelivery", "logs:DeleteLogDelivery",
+	),
+	ec2v1alpha1.NetworkACLGroupKind: withEC2Tags(
+		"ec2:CreateNetworkAcl", "ec2:DescribeNetworkAcls", "ec2:DeleteNetworkAcl",
+		"ec2:CreateNetworkAclEntry", "ec2:ReplaceNetworkAclEntry", "ec2:DeleteNetworkAclEntry",
+		"ec2:ReplaceNetworkAclAssociation",
+	),
+	ec2v1alpha1.DHCPOptionsGroupKind: withEC2Tags(
+		"ec2:CreateDhcpOptions", "ec2:DescribeDhcpOptions", "ec2:DeleteDhcpOptions",
+		"ec2:AssociateDhcpOptions", "ec2:DescribeVpcs",
+	),
+	ec2v1alpha1.EgressOnlyInternetGatewayGroupKind: withEC2Tags(
+		"ec2:CreateEgressOnlyInternetGateway", "ec2:DescribeEgressOnlyInternetGateways",
+		"ec2:DeleteEgressOnlyInternetGateway",
+	),
+	ec2v1alpha1.ElasticIPGroupKind: withEC2Tags(
+		"ec2:AllocateAddress", "ec2:DescribeAddresses", "ec2:ReleaseAddress",
+		"ec2:AssociateAddress", "ec2:DisassociateAddress",
+	),
+	ec2v1alpha1.InstanceGroupKind: withEC2Tags(
+		"ec2:RunInstances", "ec2:DescribeInstances", "ec2:StartInstances", "ec2:StopInstances",
+		"ec2:ModifyInstanceAttribute", "ec2:TerminateInstances", "iam:PassRole",
+	),
+	ec2v1alpha1.LaunchTemplateGroupKind: withEC2Tags(
+		"ec2:CreateLaunchTemplate", "ec2:DescribeLaunchTemplates", "ec2:DescribeLaunchTemplateVersions",
+		"ec2:CreateLaunchTemplateVersion", "ec2:ModifyLaunchTemplate", "ec2:DeleteLaunchTemplate",
+	),
+	ec2v1alpha1.NATGatewayGroupKind: withEC2Tags(
+		"ec2:CreateNatGateway", "ec2:DescribeNatGateways", "ec2:DeleteNatGateway",
+	),
+	ec2v1alpha1.SnapshotGroupKind: withEC2Tags(
+		"ec2:CreateSnapshot", "ec2:DescribeSnapshots", "ec2:DeleteSnapshot",
+	),
+	ec2v1alpha1.TransitGatewayGroupKind: withEC2Tags(
+		"ec2:CreateTransitGateway", "ec2:DescribeTransitGateways", "ec2:DeleteTransitGateway",
+	),
+	ec2v1alpha1.TransitGatewayVPCAttachmentGroupKind: withEC2Tags(
+		"ec2:CreateTransitGatewayVpcAttachment", "ec2:DescribeTransitGatewayVpcAttachments",
+		"ec2:ModifyTransitGatewayVpcAttachment", "ec2:DeleteTransitGatewayVpcAttachment",
+	),
+	ec2v1alpha1.TransitGatewayRouteTableGroupKind: withEC2Tags(
+		"ec2:CreateTransitGatewayRouteTable", "ec2:DescribeTransitGatewayRouteTables",
+		"ec2:DeleteTransitGatewayRouteTable",
+	),
+	ec2v1alpha1.VolumeGroupKind: withEC2Tags(
+		"ec2:CreateVolume", "ec2:DescribeVolumes", "ec2:DescribeVolumesModifications",
+		"ec2:ModifyVolume", "ec2:DeleteVolume",
+	),
+	ec2v1alpha1.VPCPeeringConnectionGroupKind: withEC2Tags(
+		"ec2:CreateVpcPeeringConnection", "ec2:DescribeVpcPeeringConnections",
+		"ec2:AcceptVpcPeeringConnection", "ec2:ModifyVpcPeeringConnectionOptions",
+		"ec2:DeleteVpcPeeringConnection",
+	),
+	ec2v1alpha1.CustomerGatewayGroupKind: withEC2Tags(
+		"ec2:CreateCustomerGateway", "ec2:DescribeCustomerGateways", "ec2:DeleteCustomerGateway",
+	),
+	ec2v1alpha1.VPNGatewayGroupKind: withEC2Tags(
+		"ec2:CreateVpnGateway", "ec2:DescribeVpnGateways", "ec2:AttachVpnGateway",
+		"ec2:DetachVpnGateway", "ec2:DeleteVpnGateway",
+	),
+	ec2v1alpha1.VPNConnectionGroupKind: withEC2Tags(
+		"ec2:CreateVpnConnection", "ec2:DescribeVpnConnections", "ec2:CreateVpnConnectionRoute",
+		"ec2:DeleteVpnConnectionRoute", "ec2:DeleteVpnConnection",
+	),
+	ec2v1alpha4.RouteTableGroupKind: withEC2Tags(
+		"ec2:CreateRouteTable", "ec2:DescribeRouteTables", "ec2:DeleteRouteTable",
+		"ec2:CreateRoute", "ec2:DeleteRoute", "ec2:AssociateRouteTable", "ec2:DisassociateRouteTable",
+	),
+	ec2v1alpha4.VPCEndpointGroupKind: withEC2Tags(
+		"ec2:CreateVpcEndpoint", "ec2:DescribeVpcEndpoints", "ec2:ModifyVpcEndpoint", "ec2:DeleteVpcEndpoints",
+	),
+	ec2v1alpha4.VPCEndpointServiceConfigurationGroupKind: withEC2Tags(
+		"ec2:CreateVpcEndpointServiceConfiguration", "ec2:DescribeVpcEndpointServiceConfigurations",
+		"ec2:ModifyVpcEndpointServiceConfiguration", "ec2:DeleteVpcEndpointServiceConfigurations",
+		"ec2:DescribeVpcEndpointServicePermissions", "ec2:ModifyVpcEndpointServicePermissions",
+		"ec2:StartVpcEndpointServicePrivateDnsVerification",
+	),
+	ec2v1beta1.VPCGroupKind: withEC2Tags(
+		"ec2:CreateVpc", "ec2:DescribeVpcs", "ec2:DescribeVpcAttribute", "ec2:ModifyVpcAttribute",
+		"ec2:ModifyVpcTenancy", "ec2:DeleteVpc",
+	),
+	ec2v1beta1.SubnetGroupKind: withEC2Tags(
+		"ec2:CreateSubnet", "ec2:DescribeSubnets", "ec2:ModifySubnetAttribute", "ec2:DeleteSubnet",
+	),
+	ec2v1beta1.SecurityGroupGroupKind: withEC2Tags(
+		"ec2:CreateSecurityGroup", "ec2:DescribeSecurityGroups", "ec2:DeleteSecurityGroup",
+		"ec2:AuthorizeSecurityGroupIngress", "ec2:AuthorizeSecurityGroupEgress",
+		"ec2:RevokeSecurityGroupIngress", "ec2:RevokeSecurityGroupEgress",
+	),
+	ec2v1beta1.InternetGatewayGroupKind: withEC2Tags(
+		"ec2:CreateInternetGateway", "ec2:DescribeInternetGateways", "ec2:DeleteInternetGateway",
+		"ec2:AttachInternetGateway", "ec2:DetachInternetGateway",
+	),
+	ecr.RepositoryGroupKind: {
+		"ecr:CreateRepository", "ecr:DescribeRepositories", "ecr:DeleteRepository",
+		"ecr:PutImageScanningConfiguration", "ecr:PutImageTagMutability",
+		"ecr:TagResource", "ecr:UntagResource", "ecr:ListTagsForResource",
+	},
+	eksv1beta1.ClusterGroupKind: {
+		"eks:CreateCluster", "eks:DescribeCluster", "eks:UpdateClusterConfig", "eks:UpdateClusterVersion",
+		"eks:DeleteCluster", "eks:TagResource", "eks:UntagResource", "iam:PassRole",
+	},
+	eksv1alpha1.NodeGroupGroupKind: {
+		"eks:CreateNodegroup", "eks:DescribeNodegroup", "eks:UpdateNodegroupConfig",
+		"eks:UpdateNodegroupVersion", "eks:DeleteNodegroup", "eks:TagResource", "eks:UntagResource",
+		"iam:PassRole",
+	},
+	elasticbeanstalk.ApplicationGroupKind: {
+		"elasticbeanstalk:CreateApplication", "elasticbeanstalk:DescribeApplications",
+		"elasticbeanstalk:UpdateApplication", "elasticbeanstalk:DeleteApplication", "elasticbeanstalk:AddTags",
+	},
+	elasticbeanstalk.ApplicationVersionGroupKind: {
+		"elasticbeanstalk:CreateApplicationVersion", "elasticbeanstalk:DescribeApplicationVersions",
+		"elasticbeanstalk:UpdateApplicationVersion", "elasticbeanstalk:DeleteApplicationVersion",
+		"elasticbeanstalk:AddTags", "s3:GetObject", "s3:DeleteObject",
+	},
+	elasticbeanstalk.EnvironmentGroupKind: {
+		"elasticbeanstalk:CreateEnvironment", "elasticbeanstalk:DescribeEnvironments",
+		"elasticbeanstalk:DescribeConfigurationSettings", "elasticbeanstalk:UpdateEnvironment",
+		"elasticbeanstalk:TerminateEnvironment", "elasticbeanstalk:AddTags", "iam:PassRole",
+		"autoscaling:*", "cloudformation:*", "ec2:*", "elasticloadbalancing:*", "s3:*",
+	},
+	elb.ELBGroupKind: {
+		"elasticloadbalancing:CreateLoadBalancer", "elasticloadbalancing:DescribeLoadBalancers",
+		"elasticloadbalancing:DeleteLoadBalancer", "elasticloadbalancing:CreateLoadBalancerListeners",
+		"elasticloadbalancing:DeleteLoadBalancerListeners", "elasticloadbalancing:ConfigureHealthCheck",
+		"elasticloadbalancing:AttachLoadBalancerToSubnets", "elasticloadbalancing:DetachLoadBalancerFromSubnets",
+		"elasticloadbalancing:EnableAvailabilityZonesForLoadBalancer",
+		"elasticloadbalancing:DisableAvailabilityZonesForLoadBalancer",
+		"elasticloadbalancing:ApplySecurityGroupsToLoadBalancer",
+		"elasticloadbalancing:DescribeLoadBalancerAttributes", "elasticloadbalancing:ModifyLoadBalancerAttributes",
+		"elasticloadbalancing:AddTags", "elasticloadbalancing:RemoveTags", "elasticloadbalancing:DescribeTags",
+	},
+	elb.ELBAttachmentGroupKind: {
+		"elasticloadbalancing:DescribeLoadBalancers",
+		"elasticloadbalancing:RegisterInstancesWithLoadBalancer",
+		"elasticloadbalancing:DeregisterInstancesFromLoadBalancer",
+	},
+	elbv2.LoadBalancerGroupKind: {
+		"elasticloadbalancing:CreateLoadBalancer", "elasticloadbalancing:DescribeLoadBalancers",
+		"elasticloadbalancing:DeleteLoadBalancer", "elasticloadbalancing:SetSubnets",
+		"elasticloadbalancing:SetSecurityGroups", "elasticloadbalancing:SetIpAddressType",
+		"elasticloadbalancing:AddTags", "elasticloadbalancing:RemoveTags", "elasticloadbalancing:DescribeTags",
+	},
+	elbv2.TargetGroupGroupKind: {
+		"elasticloadbalancing:CreateTargetGroup", "elasticloadbalancing:DescribeTargetGroups",
+		"elasticloadbalancing:ModifyTargetGroup", "elasticloadbalancing:DeleteTargetGroup",
+		"elasticloadbalancing:AddTags", "elasticloadbalancing:RemoveTags", "elasticloadbalancing:DescribeTags",
+	},
+	elbv2.ListenerGroupKind: {
+		"elasticloadbalancing:CreateListener", "elasticloadbalancing:DescribeListeners",
+		"elasticloadbalancing:ModifyListener", "elasticloadbalancing:DeleteListener",
+		"elasticloadbalancing:DescribeListenerCertificates", "elasticloadbalancing:AddListenerCertificates",
+		"elasticloadbalancing:RemoveListenerCertificates",
+	},
+	elbv2.ListenerRuleGroupKind: {
+		"elasticloadbalancing:CreateRule", "elasticloadbalancing:DescribeRules",
+		"elasticloadbalancing:ModifyRule", "elasticloadbalancing:SetRulePriorities",
+		"elasticloadbalancing:DeleteRule",
+	},
+	emr.ClusterGroupKind: {
+		"elasticmapreduce:RunJobFlow", "elasticmapreduce:DescribeCluster", "elasticmapreduce:TerminateJobFlows",
+		"elasticmapreduce:SetTerminationProtection", "elasticmapreduce:SetVisibleToAllUsers",
+		"elasticmapreduce:ModifyCluster", "elasticmapreduce:AddTags", "elasticmapreduce:RemoveTags",
+		"elasticmapreduce:ListInstanceGroups", "elasticmapreduce:ModifyInstanceGroups",
+		"elasticmapreduce:ListInstanceFleets", "elasticmapreduce:ModifyInstanceFleet",
+		"elasticmapreduce:GetManagedScalingPolicy", "elasticmapreduce:PutManagedScalingPolicy",
+		"elasticmapreduce:RemoveManagedScalingPolicy", "iam:PassRole",
+	},
+	facts.CallerIdentityGroupKind:    {"sts:GetCallerIdentity"},
+	facts.RegionInfoGroupKind:        {"ec2:DescribeRegions"},
+	facts.AvailabilityZonesGroupKind: {"ec2:DescribeAvailabilityZones"},
+	facts.VPCDefaultsGroupKind:       {"ec2:DescribeVpcs", "ec2:DescribeSubnets", "ec2:DescribeSecurityGroups"},
+	fsx.FileSystemGroupKind: {
+		"fsx:CreateFileSystem", "fsx:DescribeFileSystems", "fsx:UpdateFileSystem", "fsx:DeleteFileSystem",
+		"fsx:TagResource", "fsx:UntagResource", "ec2:CreateNetworkInterface", "ec2:DescribeNetworkInterfaces",
+		"ec2:DescribeSubnets", "ec2:DescribeVpcs", "ds:DescribeDirectories", "ds:AuthorizeApplication",
+		"ds:UnauthorizeApplication", "kms:CreateGrant", "kms:DescribeKey", "iam:CreateServiceLinkedRole",
+	},
+	globalaccelerator.AcceleratorGroupKind: {
+		"globalaccelerator:CreateAccelerator", "globalaccelerator:DescribeAccelerator",
+		"globalaccelerator:UpdateAccelerator", "globalaccelerator:DeleteAccelerator",
+		"globalaccelerator:ListTagsForResource", "globalaccelerator:TagResource", "globalaccelerator:UntagResource",
+	},
+	globalaccelerator.ListenerGroupKind: {
+		"globalaccelerator:CreateListener", "globalaccelerator:DescribeListener",
+		"globalaccelerator:UpdateListener", "globalaccelerator:DeleteListener",
+	},
+	globalaccelerator.EndpointGroupGroupKind: {
+		"globalaccelerator:CreateEndpointGroup", "globalaccelerator:DescribeEndpointGroup",
+		"globalaccelerator:UpdateEndpointGroup", "globalaccelerator:DeleteEndpointGroup",
+		"elasticloadbalancing:DescribeLoadBalancers", "ec2:DescribeAddresses", "ec2:DescribeInstances",
+	},
+	glue.CatalogDatabaseGroupKind: {
+		"glue:CreateDatabase", "glue:GetDatabase", "glue:UpdateDatabase", "glue:DeleteDatabase",
+	},
+	glue.CrawlerGroupKind: {
+		"glue:CreateCrawler", "glue:GetCrawler", "glue:UpdateCrawler", "glue:DeleteCrawler",
+		"glue:TagResource", "iam:PassRole",
+	},
+	glue.JobGroupKind: {
+		"glue:CreateJob", "glue:GetJob", "glue:UpdateJob", "glue:DeleteJob",
+		"glue:TagResource", "iam:PassRole",
+	},
+	guardduty.DetectorGroupKind: {
+		"guardduty:CreateDetector", "guardduty:GetDetector", "guardduty:UpdateDetector",
+		"guardduty:DeleteDetector", "guardduty:TagResource", "guardduty:UntagResource",
+	},
+	guardduty.MemberGroupKind: {
+		"guardduty:CreateMembers", "guardduty:GetMembers", "guardduty:InviteMembers",
+		"guardduty:DisassociateMembers", "guardduty:DeleteMembers",
+	},
+	guardduty.PublishingDestinationGroupKind: {
+		"guardduty:CreatePublishingDestination", "guardduty:DescribePublishingDestination",
+		"guardduty:UpdatePublishingDestination", "guardduty:DeletePublishingDestination",
+		"s3:GetBucketLocation", "kms:ListAliases",
+	},
+	identityv1alpha1.IAMUserGroupKind: {
+		"iam:CreateUser", "iam:GetUser", "iam:UpdateUser", "iam:DeleteUser",
+	},
+	identityv1alpha1.IAMAccessKeyGroupKind: {
+		"iam:CreateAccessKey", "iam:ListAccessKeys", "iam:UpdateAccessKey", "iam:DeleteAccessKey",
+	},
+	identityv1alpha1.IAMUserPolicyAttachmentGroupKind: {
+		"iam:AttachUserPolicy", "iam:ListAttachedUserPolicies", "iam:DetachUserPolicy",
+	},
+	identityv1alpha1.IAMPolicyGroupKind: {
+		"iam:CreatePolicy", "iam:GetPolicy", "iam:GetPolicyVersion", "iam:CreatePolicyVersion",
+		"iam:ListPolicyVersions", "iam:DeletePolicyVersion", "iam:DeletePolicy",
+	},
+	identityv1alpha1.IAMGroupGroupKind: {
+		"iam:CreateGroup", "iam:GetGroup", "iam:UpdateGroup", "iam:DeleteGroup",
+	},
+	identityv1alpha1.IAMGroupUserMembershipGroupKind: {
+		"iam:AddUserToGroup", "iam:ListGroupsForUser", "iam:RemoveUserFromGroup",
+	},
+	identityv1alpha1.IAMGroupPolicyAttachmentGroupKind: {
+		"iam:AttachGroupPolicy", "iam:ListAttachedGroupPolicies", "iam:DetachGroupPolicy",
+	},
+	identityv1alpha1.OpenIDConnectProviderGroupKind: {
+		"iam:CreateOpenIDConnectProvider", "iam:GetOpenIDConnectProvider", "iam:UpdateOpenIDConnectProviderThumbprint",
+		"iam:AddClientIDToOpenIDConnectProvider", "iam:RemoveClientIDFromOpenIDConnectProvider",
+		"iam:DeleteOpenIDConnectProvider",
+	},
+	identityv1alpha1.SAMLProviderGroupKind: {
+		"iam:CreateSAMLProvider", "iam:GetSAMLProvider", "iam:UpdateSAMLProvider", "iam:DeleteSAMLProvider",
+	},
+	identityv1beta1.IAMRoleGroupKind: {
+		"iam:CreateRole", "iam:GetRole", "iam:UpdateRole", "iam:UpdateAssumeRolePolicy", "iam:DeleteRole",
+	},
+	identityv1beta1.IAMRolePolicyAttachmentGroupKind: {
+		"iam:AttachRolePolicy", "iam:ListAttachedRolePolicies", "iam:DetachRolePolicy",
+	},
+	identityv1beta1.InstanceProfileGroupKind: {
+		"iam:CreateInstanceProfile", "iam:GetInstanceProfile", "iam:AddRoleToInstanceProfile",
+		"iam:RemoveRoleFromInstanceProfile", "iam:DeleteInstanceProfile", "iam:PassRole",
+	},
+	lakeformation.DataLakeSettingsGroupKind: {
+		"lakeformation:GetDataLakeSettings", "lakeformation:PutDataLakeSettings",
+	},
+	lakeformation.LakeFormationPermissionsGroupKind: {
+		"lakeformation:ListPermissions", "lakeformation:GrantPermissions", "lakeformation:RevokePermissions",
+	},
+	lightsail.DatabaseGroupKind: {
+		"lightsail:CreateRelationalDatabase", "lightsail:GetRelationalDatabase",
+		"lightsail:UpdateRelationalDatabase", "lightsail:DeleteRelationalDatabase", "lightsail:TagResource",
+	},
+	lightsail.InstanceGroupKind: {
+		"lightsail:CreateInstances", "lightsail:GetInstance", "lightsail:DeleteInstance", "lightsail:TagResource",
+	},
+	lightsail.StaticIPGroupKind: {
+		"lightsail:AllocateStaticIp", "lightsail:GetStaticIp", "lightsail:AttachStaticIp",
+		"lightsail:DetachStaticIp", "lightsail:ReleaseStaticIp",
+	},
+	macie2.AccountGroupKind: {
+		"macie2:GetMacieSession", "macie2:EnableMacie", "macie2:UpdateMacieSession", "macie2:DisableMacie",
+		"iam:CreateServiceLinkedRole",
+	},
+	macie2.ClassificationJobGroupKind: {
+		"macie2:DescribeClassificationJob", "macie2:CreateClassificationJob", "macie2:UpdateClassificationJob",
+		"macie2:TagResource",
+	},
+	mq.BrokerGroupKind: {
+		"mq:CreateBroker", "mq:DescribeBroker", "mq:UpdateBroker", "mq:DeleteBroker",
+		"mq:CreateTags", "mq:DeleteTags",
+		"mq:ListUsers", "mq:DescribeUser", "mq:CreateUser", "mq:UpdateUser", "mq:DeleteUser",
+		"ec2:CreateNetworkInterface", "ec2:CreateNetworkInterfacePermission", "ec2:DeleteNetworkInterface",
+		"ec2:DescribeNetworkInterfaces", "ec2:DescribeSubnets", "ec2:DescribeSecurityGroups", "ec2:DescribeVpcs",
+	},
+	neptune.DBClusterGroupKind: {
+		"rds:CreateDBCluster", "rds:RestoreDBClusterFromSnapshot", "rds:DescribeDBClusters",
+		"rds:ModifyDBCluster", "rds:DeleteDBCluster",
+		"rds:ListTagsForResource", "rds:AddTagsToResource", "rds:RemoveTagsFromResource",
+	},
+	neptune.DBInstanceGroupKind: {
+		"rds:CreateDBInstance", "rds:DescribeDBInstances", "rds:ModifyDBInstance", "rds:DeleteDBInstance",
+		"rds:ListTagsForResource", "rds:AddTagsToResource", "rds:RemoveTagsFromResource",
+	},
+	notification.SNSTopicGroupKind: {
+		"sns:CreateTopic", "sns:GetTopicAttributes", "sns:SetTopicAttributes", "sns:DeleteTopic",
+	},
+	notification.SNSSubscriptionGroupKind: {
+		"sns:Subscribe", "sns:GetSubscriptionAttributes", "sns:SetSubscriptionAttributes", "sns:Unsubscribe",
+	},
+	opensearchservice.DomainGroupKind: {
+		"es:CreateElasticsearchDomain", "es:DescribeElasticsearchDomain", "es:UpdateElasticsearchDomainConfig",
+		"es:DeleteElasticsearchDomain", "es:StartElasticsearchServiceSoftwareUpdate",
+		"es:ListTags", "es:AddTags", "es:RemoveTags", "iam:CreateServiceLinkedRole",
+	},
+	organizations.AccountGroupKind: {
+		"organizations:CreateAccount", "organizations:DescribeCreateAccountStatus", "organizations:DescribeAccount",
+		"organizations:ListParents", "organizations:MoveAccount", "organizations:RemoveAccountFromOrganization",
+		"iam:CreateRole",
+	},
+	organizations.OrganizationalUnitGroupKind: {
+		"organizations:CreateOrganizationalUnit", "organizations:DescribeOrganizationalUnit",
+		"organizations:UpdateOrganizationalUnit", "organizations:DeleteOrganizationalUnit", "organizations:ListParents",
+	},
+	organizations.PolicyGroupKind: {
+		"organizations:CreatePolicy", "organizations:DescribePolicy", "organizations:UpdatePolicy",
+		"organizations:DeletePolicy",
+	},
+	organizations.PolicyAttachmentGroupKind: {
+		"organizations:AttachPolicy", "organizations:DetachPolicy", "organizations:ListTargetsForPolicy",
+	},
+	qldb.LedgerGroupKind: {
+		"qldb:CreateLedger", "qldb:DescribeLedger", "qldb:UpdateLedger", "qldb:DeleteLedger",
+		"qldb:TagResource",
+	},
+	ram.ResourceShareGroupKind: {
+		"ram:CreateResourceShare", "ram:GetResourceShares", "ram:UpdateResourceShare", "ram:DeleteResourceShare",
+		"ram:GetResourceShareAssociations", "ram:AssociateResourceShare", "ram:DisassociateResourceShare",
+		"ram:TagResource", "ram:UntagResource",
+	},
+	redshift.ClusterGroupKind: {
+		"redshift:CreateCluster", "redshift:DescribeClusters", "redshift:ModifyCluster", "redshift:DeleteCluster",
+	},
+	route53.HealthCheckGroupKind: {
+		"route53:CreateHealthCheck", "route53:GetHealthCheck", "route53:UpdateHealthCheck",
+		"route53:DeleteHealthCheck", "route53:ListTagsForResource", "route53:ChangeTagsForResource",
+	},
+	route53.HostedZoneGroupKind: {
+		"route53:CreateHostedZone", "route53:GetHostedZone", "route53:UpdateHostedZoneComment",
+		"route53:DeleteHostedZone",
+	},
+	route53.ResourceRecordSetGroupKind: {
+		"route53:ChangeResourceRecordSets", "route53:ListResourceRecordSets",
+	},
+	route53resolver.ResolverEndpointGroupKind: {
+		"route53resolver:CreateResolverEndpoint", "route53resolver:GetResolverEndpoint",
+		"route53resolver:UpdateResolverEndpoint", "route53resolver:DeleteResolverEndpoint",
+		"route53resolver:ListResolverEndpointIpAddresses", "route53resolver:AssociateResolverEndpointIpAddress",
+		"route53resolver:DisassociateResolverEndpointIpAddress", "route53resolver:TagResource",
+		"ec2:CreateNetworkInterface", "ec2:DescribeNetworkInterfaces", "ec2:DeleteNetworkInterface",
+		"ec2:DescribeSubnets", "ec2:DescribeSecurityGroups", "ec2:DescribeVpcs",
+	},
+	route53resolver.ResolverRuleGroupKind: {
+		"route53resolver:CreateResolverRule", "route53resolver:GetResolverRule",
+		"route53resolver:UpdateResolverRule", "route53resolver:DeleteResolverRule",
+		"route53resolver:TagResource",
+	},
+	route53resolver.ResolverRuleAssociationGroupKind: {
+		"route53resolver:AssociateResolverRule", "route53resolver:GetResolverRuleAssociation",
+		"route53resolver:DisassociateResolverRule", "ec2:DescribeVpcs",
+	},
+	s3v1alpha1.AccessPointGroupKind: {
+		"s3:CreateAccessPoint", "s3:GetAccessPoint", "s3:DeleteAccessPoint", "s3:GetAccessPointPolicy",
+		"s3:PutAccessPointPolicy", "s3:DeleteAccessPointPolicy", "sts:GetCallerIdentity",
+	},
+	s3v1alpha1.AccountPublicAccessBlockGroupKind: {
+		"s3:GetAccountPublicAccessBlock", "s3:PutAccountPublicAccessBlock", "sts:GetCallerIdentity",
+	},
+	s3v1alpha1.BucketPolicyGroupKind: {
+		"s3:PutBucketPolicy", "s3:GetBucketPolicy", "s3:DeleteBucketPolicy",
+	},
+	s3v1beta1.BucketGroupKind: {
+		"s3:CreateBucket", "s3:ListBucket", "s3:DeleteBucket", "s3:GetBucketAcl", "s3:PutBucketAcl",
+		"s3:GetBucketTagging", "s3:PutBucketTagging", "s3:GetBucketVersioning", "s3:PutBucketVersioning",
+		"s3:GetEncryptionConfiguration", "s3:PutEncryptionConfiguration",
+		"s3:GetLifecycleConfiguration", "s3:PutLifecycleConfiguration",
+		"s3:GetReplicationConfiguration", "s3:PutReplicationConfiguration",
+		"s3:GetBucketNotification", "s3:PutBucketNotification", "s3:GetBucketCORS", "s3:PutBucketCORS",
+		"s3:GetBucketWebsite", "s3:PutBucketWebsite", "s3:GetBucketLogging", "s3:PutBucketLogging",
+		"s3:GetAccelerateConfiguration", "s3:PutAccelerateConfiguration",
+		"s3:GetBucketRequestPayment", "s3:PutBucketRequestPayment",
+		"s3:GetBucketPublicAccessBlock", "s3:PutBucketPublicAccessBlock",
+	},
+	sagemaker.NotebookInstanceGroupKind: {
+		"sagemaker:CreateNotebookInstance", "sagemaker:DescribeNotebookInstance",
+		"sagemaker:UpdateNotebookInstance", "sagemaker:StartNotebookInstance",
+		"sagemaker:StopNotebookInstance", "sagemaker:DeleteNotebookInstance",
+		"sagemaker:ListTags", "sagemaker:AddTags", "sagemaker:DeleteTags", "iam:PassRole",
+	},
+	sagemaker.ModelGroupKind: {
+		"sagemaker:CreateModel", "sagemaker:DescribeModel", "sagemaker:DeleteModel",
+		"sagemaker:ListTags", "sagemaker:AddTags", "sagemaker:DeleteTags", "iam:PassRole",
+		"ecr:DescribeImages",
+	},
+	sagemaker.EndpointConfigGroupKind: {
+		"sagemaker:CreateEndpointConfig", "sagemaker:DescribeEndpointConfig", "sagemaker:DeleteEndpointConfig",
+		"sagemaker:ListTags", "sagemaker:AddTags", "sagemaker:DeleteTags",
+	},
+	sagemaker.EndpointGroupKind: {
+		"sagemaker:CreateEndpoint", "sagemaker:DescribeEndpoint", "sagemaker:UpdateEndpoint",
+		"sagemaker:DeleteEndpoint", "sagemaker:ListTags", "sagemaker:AddTags", "sagemaker:DeleteTags",
+	},
+	securityhub.SecurityHubAccountGroupKind: {
+		"securityhub:DescribeHub", "securityhub:EnableSecurityHub", "securityhub:DisableSecurityHub",
+		"securityhub:TagResource",
+	},
+	securityhub.SecurityHubStandardsSubscriptionGroupKind: {
+		"securityhub:GetEnabledStandards", "securityhub:BatchEnableStandards", "securityhub:BatchDisableStandards",
+	},
+	servicecatalog.PortfolioGroupKind: {
+		"servicecatalog:CreatePortfolio", "servicecatalog:DescribePortfolio", "servicecatalog:UpdatePortfolio",
+		"servicecatalog:DeletePortfolio", "servicecatalog:ListPrincipalsForPortfolio",
+		"servicecatalog:AssociatePrincipalWithPortfolio", "servicecatalog:DisassociatePrincipalFromPortfolio",
+	},
+	servicecatalog.ProductGroupKind: {
+		"servicecatalog:CreateProduct", "servicecatalog:DescribeProductAsAdmin", "servicecatalog:UpdateProduct",
+		"servicecatalog:DeleteProduct", "servicecatalog:ListPortfoliosForProduct",
+		"servicecatalog:AssociateProductWithPortfolio", "servicecatalog:DisassociateProductFromPortfolio",
+		"servicecatalog:ListConstraintsForPortfolio", "servicecatalog:DescribeConstraint",
+		"servicecatalog:CreateConstraint", "servicecatalog:UpdateConstraint", "servicecatalog:DeleteConstraint",
+		"s3:GetObject", "iam:PassRole",
+	},
+	servicediscovery.PrivateDNSNamespaceGroupKind: {
+		"servicediscovery:CreatePrivateDnsNamespace", "servicediscovery:GetNamespace",
+		"servicediscovery:ListNamespaces", "servicediscovery:DeleteNamespace",
+		"route53:CreateHostedZone", "route53:GetHostedZone", "route53:DeleteHostedZone",
+		"route53:ChangeResourceRecordSets", "ec2:DescribeVpcs",
+	},
+	servicediscovery.PublicDNSNamespaceGroupKind: {
+		"servicediscovery:CreatePublicDnsNamespace", "servicediscovery:GetNamespace",
+		"servicediscovery:ListNamespaces", "servicediscovery:DeleteNamespace",
+		"route53:CreateHostedZone", "route53:GetHostedZone", "route53:DeleteHostedZone",
+		"route53:ChangeResourceRecordSets",
+	},
+	servicediscovery.HTTPNamespaceGroupKind: {
+		"servicediscovery:CreateHttpNamespace", "servicediscovery:GetNamespace",
+		"servicediscovery:ListNamespaces", "servicediscovery:DeleteNamespace",
+	},
+	servicediscovery.ServiceGroupKind: {
+		"servicediscovery:CreateService", "servicediscovery:GetService",
+		"servicediscovery:UpdateService", "servicediscovery:DeleteService",
+		"route53:CreateHealthCheck", "route53:UpdateHealthCheck", "route53:DeleteHealthCheck",
+	},
+	shield.ProtectionGroupKind: {
+		"shield:DescribeProtection", "shield:CreateProtection", "shield:DeleteProtection",
+		"ec2:DescribeAddresses", "elasticloadbalancing:DescribeLoadBalancers", "cloudfront:GetDistribution",
+		"route53:GetHostedZone", "globalaccelerator:DescribeAccelerator",
+	},
+	sqs.QueueGroupKind: {
+		"sqs:CreateQueue", "sqs:GetQueueUrl", "sqs:GetQueueAttributes", "sqs:SetQueueAttributes",
+		"sqs:DeleteQueue", "sqs:TagQueue", "sqs:UntagQueue", "sqs:ListQueueTags",
+	},
+	synthetics.CanaryGroupKind: {
+		"synthetics:CreateCanary", "synthetics:GetCanary", "synthetics:UpdateCanary", "synthetics:DeleteCanary",
+		"synthetics:StartCanary", "synthetics:StopCanary", "iam:PassRole",
+	},
+	wafv2.WebACLGroupKind: {
+		"wafv2:CreateWebACL", "wafv2:GetWebACL", "wafv2:ListWebACLs", "wafv2:UpdateWebACL",
+		"wafv2:DeleteWebACL", "wafv2:TagResource", "wafv2:UntagResource", "wafv2:ListTagsForResource",
+	},
+	wafv2.WebACLAssociationGroupKind: {
+		"wafv2:AssociateWebACL", "wafv2:GetWebACLForResource", "wafv2:DisassociateWebACL",
+	},
+	xray.XRayGroupGroupKind: {
+		"xray:CreateGroup", "xray:GetGroup", "xray:UpdateGroup", "xray:DeleteGroup",
+	},
+	xray.SamplingRuleGroupKind: {
+		"xray:CreateSamplingRule", "xray:GetSamplingRules", "xray:UpdateSamplingRule", "xray:DeleteSamplingRule",
+	},
+}
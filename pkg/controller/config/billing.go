@@ -0,0 +1,247 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/budgets"
+)
+
+const (
+	billingTimeout    = 2 * time.Minute
+	billingRetryAfter = 1 * time.Minute
+
+	// AWS Budgets is a global service that is served from us-east-1, the SNS
+	// topic of the alarm is created there as well.
+	billingRegion = "us-east-1"
+
+	billingFinalizer = "billingalarm.aws.crossplane.io"
+
+	errNewBillingClient    = "cannot create billing alarm client"
+	errGetAccountID        = "cannot determine account of credentials"
+	errEnsureBillingTopic  = "cannot configure SNS topic of billing alarm"
+	errEnsureBudget        = "cannot configure budget of billing alarm"
+	errDeleteBillingAlarm  = "cannot delete billing alarm"
+	errAddBillingFinalizer = "cannot add billing alarm finalizer to ProviderConfig"
+	errRemBillingFinalizer = "cannot remove billing alarm finalizer from ProviderConfig"
+	errUpdateBillingStatus = "cannot update billing alarm status of ProviderConfig"
+
+	reasonBillingAlarmFailed     event.Reason = "CannotConfigureBillingAlarm"
+	reasonBillingAlarmConfigured event.Reason = "ConfiguredBillingAlarm"
+	reasonBillingAlarmDeleted    event.Reason = "DeletedBillingAlarm"
+)
+
+// SetupBillingAlarm adds a controller that manages the billing alarms of
+// ProviderConfigs.
+func SetupBillingAlarm(mgr ctrl.Manager, l logging.Logger) error {
+	name := "billingalarm/" + strings.ToLower(v1beta1.ProviderConfigGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.ProviderConfig{}).
+		Complete(NewBillingAlarmReconciler(mgr,
+			WithBillingLogger(l.WithValues("controller", name)),
+			WithBillingRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// A BillingAlarmOption configures a BillingAlarmReconciler.
+type BillingAlarmOption func(*BillingAlarmReconciler)
+
+// WithBillingLogger specifies how the BillingAlarmReconciler should log
+// messages.
+func WithBillingLogger(l logging.Logger) BillingAlarmOption {
+	return func(r *BillingAlarmReconciler) {
+		r.log = l
+	}
+}
+
+// WithBillingRecorder specifies how the BillingAlarmReconciler should record
+// events.
+func WithBillingRecorder(er event.Recorder) BillingAlarmOption {
+	return func(r *BillingAlarmReconciler) {
+		r.record = er
+	}
+}
+
+// WithBillingClient specifies how the BillingAlarmReconciler should create
+// the client used to manage the billing alarm of a ProviderConfig.
+func WithBillingClient(fn func(ctx context.Context, kube client.Client, pc *v1beta1.ProviderConfig) (budgets.BillingAlarmClient, error)) BillingAlarmOption {
+	return func(r *BillingAlarmReconciler) {
+		r.newClientFn = fn
+	}
+}
+
+// A BillingAlarmReconciler manages the AWS Budget and the SNS topic of the
+// billing alarm of a ProviderConfig, and deletes them when the alarm is
+// removed from the ProviderConfig or the ProviderConfig is deleted.
+type BillingAlarmReconciler struct {
+	kube        client.Client
+	finalizer   resource.Finalizer
+	newClientFn func(ctx context.Context, kube client.Client, pc *v1beta1.ProviderConfig) (budgets.BillingAlarmClient, error)
+
+	log    logging.Logger
+	record event.Recorder
+}
+
+// NewBillingAlarmReconciler returns a BillingAlarmReconciler.
+func NewBillingAlarmReconciler(m ctrl.Manager, o ...BillingAlarmOption) *BillingAlarmReconciler {
+	r := &BillingAlarmReconciler{
+		kube:        m.GetClient(),
+		finalizer:   resource.NewAPIFinalizer(m.GetClient(), billingFinalizer),
+		newClientFn: newBillingClient,
+		log:         logging.NewNopLogger(),
+		record:      event.NewNopRecorder(),
+	}
+	for _, ro := range o {
+		ro(r)
+	}
+	return r
+}
+
+func newBillingClient(ctx context.Context, kube client.Client, pc *v1beta1.ProviderConfig) (budgets.BillingAlarmClient, error) {
+	cfg, err := awsclients.UseNamedProviderConfig(ctx, kube, pc.GetName(), billingRegion)
+	if err != nil {
+		return nil, err
+	}
+	return budgets.NewBillingAlarmClient(*cfg), nil
+}
+
+// Reconcile the billing alarm of a ProviderConfig once per generation.
+func (r *BillingAlarmReconciler) Reconcile(req reconcile.Request) (reconcile.Result, error) { // nolint:gocyclo
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(context.Background(), billingTimeout)
+	defer cancel()
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		log.Debug(errGetProviderConfig, "error", err)
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetProviderConfig)
+	}
+
+	if pc.Spec.BillingAlarm == nil || meta.WasDeleted(pc) {
+		if !meta.FinalizerExists(pc, billingFinalizer) {
+			return reconcile.Result{}, nil
+		}
+		if err := r.delete(ctx, pc); err != nil {
+			log.Debug("Cannot delete billing alarm", "error", err)
+			r.record.Event(pc, event.Warning(reasonBillingAlarmFailed, err))
+			if pc.Status.BillingAlarm == nil {
+				pc.Status.BillingAlarm = &v1beta1.BillingAlarmStatus{}
+			}
+			pc.Status.BillingAlarm.Error = err.Error()
+			return reconcile.Result{RequeueAfter: billingRetryAfter}, errors.Wrap(r.kube.Status().Update(ctx, pc), errUpdateBillingStatus)
+		}
+		r.record.Event(pc, event.Normal(reasonBillingAlarmDeleted, "deleted billing alarm"))
+		if !meta.WasDeleted(pc) {
+			pc.Status.BillingAlarm = nil
+			if err := r.kube.Status().Update(ctx, pc); err != nil {
+				return reconcile.Result{}, errors.Wrap(err, errUpdateBillingStatus)
+			}
+		}
+		return reconcile.Result{}, errors.Wrap(r.finalizer.RemoveFinalizer(ctx, pc), errRemBillingFinalizer)
+	}
+
+	if s := pc.Status.BillingAlarm; s != nil && s.Error == "" && s.ObservedGeneration == pc.GetGeneration() {
+		return reconcile.Result{}, nil
+	}
+
+	// NOTE: The finalizer is added before the alarm is created so that it is
+	// deleted along with the ProviderConfig even if it is only partially
+	// configured.
+	if err := r.finalizer.AddFinalizer(ctx, pc); err != nil {
+		log.Debug(errAddBillingFinalizer, "error", err)
+		return reconcile.Result{}, errors.Wrap(err, errAddBillingFinalizer)
+	}
+
+	status, err := r.configure(ctx, pc)
+	if err != nil {
+		log.Debug("Cannot configure billing alarm", "error", err)
+		r.record.Event(pc, event.Warning(reasonBillingAlarmFailed, err))
+		status.Error = err.Error()
+		pc.Status.BillingAlarm = status
+		return reconcile.Result{RequeueAfter: billingRetryAfter}, errors.Wrap(r.kube.Status().Update(ctx, pc), errUpdateBillingStatus)
+	}
+	status.ObservedGeneration = pc.GetGeneration()
+	pc.Status.BillingAlarm = status
+	r.record.Event(pc, event.Normal(reasonBillingAlarmConfigured, fmt.Sprintf("configured budget %s notifying %s", status.BudgetName, status.TopicARN)))
+	return reconcile.Result{}, errors.Wrap(r.kube.Status().Update(ctx, pc), errUpdateBillingStatus)
+}
+
+// configure the billing alarm of the given ProviderConfig. The returned
+// status is never nil and holds the resources that were configured even if
+// an error is returned.
+func (r *BillingAlarmReconciler) configure(ctx context.Context, pc *v1beta1.ProviderConfig) (*v1beta1.BillingAlarmStatus, error) {
+	status := &v1beta1.BillingAlarmStatus{}
+	if pc.Status.BillingAlarm != nil {
+		status.TopicARN = pc.Status.BillingAlarm.TopicARN
+	}
+	c, err := r.newClientFn(ctx, r.kube, pc)
+	if err != nil {
+		return status, errors.Wrap(err, errNewBillingClient)
+	}
+	account, err := budgets.AccountID(ctx, c)
+	if err != nil {
+		return status, errors.Wrap(err, errGetAccountID)
+	}
+	name := budgets.BillingAlarmName(pc.GetName())
+	arn, err := budgets.EnsureTopic(ctx, c, name, pc.Spec.BillingAlarm.Emails)
+	if arn != "" {
+		status.TopicARN = arn
+	}
+	if err != nil {
+		return status, errors.Wrap(err, errEnsureBillingTopic)
+	}
+	if err := budgets.EnsureBudget(ctx, c, account, pc.GetName(), arn, *pc.Spec.BillingAlarm); err != nil {
+		return status, errors.Wrap(err, errEnsureBudget)
+	}
+	status.BudgetName = name
+	return status, nil
+}
+
+func (r *BillingAlarmReconciler) delete(ctx context.Context, pc *v1beta1.ProviderConfig) error {
+	c, err := r.newClientFn(ctx, r.kube, pc)
+	if err != nil {
+		return errors.Wrap(err, errNewBillingClient)
+	}
+	account, err := budgets.AccountID(ctx, c)
+	if err != nil {
+		return errors.Wrap(err, errGetAccountID)
+	}
+	topic := ""
+	if pc.Status.BillingAlarm != nil {
+		topic = pc.Status.BillingAlarm.TopicARN
+	}
+	return errors.Wrap(budgets.DeleteBillingAlarm(ctx, c, account, pc.GetName(), topic), errDeleteBillingAlarm)
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsbudgets "github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/budgets"
+	budgetsfake "github.com/crossplane/provider-aws/pkg/clients/budgets/fake"
+)
+
+const (
+	billingTopic  = "arn:aws:sns:us-east-1:123456789012:crossplane-billing-default"
+	billingBudget = "crossplane-billing-default"
+)
+
+type billingCalls struct {
+	createdBudget bool
+	deletedBudget bool
+	deletedTopic  bool
+}
+
+func billingProviderConfig(ba *v1beta1.BillingAlarm, finalizer bool, status *v1beta1.BillingAlarmStatus) v1beta1.ProviderConfig {
+	pc := v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: auditName, Generation: 2}}
+	if finalizer {
+		meta.AddFinalizer(&pc, billingFinalizer)
+	}
+	pc.Spec.BillingAlarm = ba
+	pc.Status.BillingAlarm = status
+	return pc
+}
+
+func billingClient(budgetExists bool, calls *billingCalls) budgets.BillingAlarmClient {
+	req := func(data interface{}, err error) *aws.Request {
+		return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+	}
+	return &budgetsfake.MockBillingAlarmClient{
+		MockGetCallerIdentity: func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+			return sts.GetCallerIdentityRequest{Request: req(&sts.GetCallerIdentityOutput{Account: aws.String("123456789012")}, nil)}
+		},
+		MockCreateTopic: func(*sns.CreateTopicInput) sns.CreateTopicRequest {
+			return sns.CreateTopicRequest{Request: req(&sns.CreateTopicOutput{TopicArn: aws.String(billingTopic)}, nil)}
+		},
+		MockSetTopicAttributes: func(*sns.SetTopicAttributesInput) sns.SetTopicAttributesRequest {
+			return sns.SetTopicAttributesRequest{Request: req(&sns.SetTopicAttributesOutput{}, nil)}
+		},
+		MockListSubscriptionsByTopic: func(*sns.ListSubscriptionsByTopicInput) sns.ListSubscriptionsByTopicRequest {
+			return sns.ListSubscriptionsByTopicRequest{Request: req(&sns.ListSubscriptionsByTopicOutput{}, nil)}
+		},
+		MockSubscribe: func(*sns.SubscribeInput) sns.SubscribeRequest {
+			return sns.SubscribeRequest{Request: req(&sns.SubscribeOutput{}, nil)}
+		},
+		MockDeleteTopic: func(*sns.DeleteTopicInput) sns.DeleteTopicRequest {
+			calls.deletedTopic = true
+			return sns.DeleteTopicRequest{Request: req(&sns.DeleteTopicOutput{}, nil)}
+		},
+		MockDescribeBudget: func(*awsbudgets.DescribeBudgetInput) awsbudgets.DescribeBudgetRequest {
+			if !budgetExists {
+				return awsbudgets.DescribeBudgetRequest{Request: req(&awsbudgets.DescribeBudgetOutput{}, awserr.New(awsbudgets.ErrCodeNotFoundException, "", nil))}
+			}
+			return awsbudgets.DescribeBudgetRequest{Request: req(&awsbudgets.DescribeBudgetOutput{Budget: &awsbudgets.Budget{
+				BudgetLimit: &awsbudgets.Spend{Amount: aws.String("100"), Unit: aws.String("USD")},
+			}}, nil)}
+		},
+		MockCreateBudget: func(*awsbudgets.CreateBudgetInput) awsbudgets.CreateBudgetRequest {
+			calls.createdBudget = true
+			return awsbudgets.CreateBudgetRequest{Request: req(&awsbudgets.CreateBudgetOutput{}, nil)}
+		},
+		MockDeleteBudget: func(*awsbudgets.DeleteBudgetInput) awsbudgets.DeleteBudgetRequest {
+			calls.deletedBudget = true
+			return awsbudgets.DeleteBudgetRequest{Request: req(&awsbudgets.DeleteBudgetOutput{}, nil)}
+		},
+		MockDescribeNotificationsForBudget: func(*awsbudgets.DescribeNotificationsForBudgetInput) awsbudgets.DescribeNotificationsForBudgetRequest {
+			return awsbudgets.DescribeNotificationsForBudgetRequest{Request: req(&awsbudgets.DescribeNotificationsForBudgetOutput{
+				Notifications: []awsbudgets.Notification{*budgets.GenerateNotification(v1beta1.BillingAlarm{})},
+			}, nil)}
+		},
+	}
+}
+
+func TestBillingAlarmReconcile(t *testing.T) {
+	alarm := &v1beta1.BillingAlarm{Limit: "100"}
+
+	type args struct {
+		pc           v1beta1.ProviderConfig
+		budgetExists bool
+		clientErr    error
+	}
+	type want struct {
+		result    reconcile.Result
+		err       error
+		updated   bool
+		finalizer bool
+		status    *v1beta1.BillingAlarmStatus
+		calls     billingCalls
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Disabled": {
+			args: args{pc: billingProviderConfig(nil, false, nil)},
+			want: want{},
+		},
+		"AlreadyConfigured": {
+			args: args{pc: billingProviderConfig(alarm, true, &v1beta1.BillingAlarmStatus{ObservedGeneration: 2, BudgetName: billingBudget, TopicARN: billingTopic})},
+			want: want{finalizer: true},
+		},
+		"Create": {
+			args: args{pc: billingProviderConfig(alarm, false, nil)},
+			want: want{
+				updated:   true,
+				finalizer: true,
+				status:    &v1beta1.BillingAlarmStatus{ObservedGeneration: 2, BudgetName: billingBudget, TopicARN: billingTopic},
+				calls:     billingCalls{createdBudget: true},
+			},
+		},
+		"Update": {
+			args: args{pc: billingProviderConfig(alarm, true, &v1beta1.BillingAlarmStatus{ObservedGeneration: 1, BudgetName: billingBudget, TopicARN: billingTopic}), budgetExists: true},
+			want: want{
+				updated:   true,
+				finalizer: true,
+				status:    &v1beta1.BillingAlarmStatus{ObservedGeneration: 2, BudgetName: billingBudget, TopicARN: billingTopic},
+			},
+		},
+		"ClientFailed": {
+			args: args{pc: billingProviderConfig(alarm, false, nil), clientErr: errBoom},
+			want: want{
+				result:    reconcile.Result{RequeueAfter: billingRetryAfter},
+				updated:   true,
+				finalizer: true,
+				status:    &v1beta1.BillingAlarmStatus{Error: errors.Wrap(errBoom, errNewBillingClient).Error()},
+			},
+		},
+		"Removed": {
+			args: args{pc: billingProviderConfig(nil, true, &v1beta1.BillingAlarmStatus{ObservedGeneration: 2, BudgetName: billingBudget, TopicARN: billingTopic})},
+			want: want{
+				updated: true,
+				calls:   billingCalls{deletedBudget: true, deletedTopic: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var updated *v1beta1.ProviderConfig
+			pc := tc.args.pc.DeepCopy()
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if diff := cmp.Diff(types.NamespacedName{Name: auditName}, key); diff != "" {
+						t.Errorf("key: -want, +got:\n%s", diff)
+					}
+					pc.DeepCopyInto(obj.(*v1beta1.ProviderConfig))
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					pc = obj.(*v1beta1.ProviderConfig).DeepCopy()
+					return nil
+				},
+				MockStatusUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					updated = obj.(*v1beta1.ProviderConfig)
+					return nil
+				},
+			}
+			calls := &billingCalls{}
+			r := NewBillingAlarmReconciler(&fake.Manager{Client: kube},
+				WithBillingClient(func(context.Context, client.Client, *v1beta1.ProviderConfig) (budgets.BillingAlarmClient, error) {
+					return billingClient(tc.args.budgetExists, calls), tc.args.clientErr
+				}))
+
+			got, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: auditName}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, *calls, cmp.AllowUnexported(billingCalls{})); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.finalizer, meta.FinalizerExists(pc, billingFinalizer)); diff != "" {
+				t.Errorf("finalizer: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated != nil); diff != "" {
+				t.Errorf("updated: -want, +got:\n%s", diff)
+			}
+			if updated == nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.status, updated.Status.BillingAlarm); diff != "" {
+				t.Errorf("status: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
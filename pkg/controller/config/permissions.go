@@ -0,0 +1,253 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+const (
+	auditTimeout    = 2 * time.Minute
+	auditRetryAfter = 1 * time.Minute
+
+	// IAM is a global service, the region is only used to sign the requests.
+	auditRegion = "us-east-1"
+
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errNewAuditClient    = "cannot create permissions audit client"
+	errGetPrincipal      = "cannot determine principal of credentials"
+	errSimulateActions   = "cannot simulate required actions"
+	errUpdateAuditStatus = "cannot update permissions audit status of ProviderConfig"
+
+	reasonMissingPermissions event.Reason = "MissingPermissions"
+	reasonAuditFailed        event.Reason = "CannotAuditPermissions"
+	reasonAuditSucceeded     event.Reason = "AuditedPermissions"
+)
+
+// SetupPermissionsAudit adds a controller that audits the permissions of the
+// principal of ProviderConfigs that have the audit enabled, or of all
+// ProviderConfigs if all is true.
+func SetupPermissionsAudit(mgr ctrl.Manager, l logging.Logger, all bool) error {
+	name := "permissionsaudit/" + strings.ToLower(v1beta1.ProviderConfigGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.ProviderConfig{}).
+		Complete(NewPermissionsAuditReconciler(mgr,
+			WithAuditAll(all),
+			WithAuditLogger(l.WithValues("controller", name)),
+			WithAuditRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// A PermissionsAuditOption configures a PermissionsAuditReconciler.
+type PermissionsAuditOption func(*PermissionsAuditReconciler)
+
+// WithAuditAll specifies whether all ProviderConfigs should be audited,
+// regardless of whether they have the audit enabled.
+func WithAuditAll(all bool) PermissionsAuditOption {
+	return func(r *PermissionsAuditReconciler) {
+		r.all = all
+	}
+}
+
+// WithAuditLogger specifies how the PermissionsAuditReconciler should log
+// messages.
+func WithAuditLogger(l logging.Logger) PermissionsAuditOption {
+	return func(r *PermissionsAuditReconciler) {
+		r.log = l
+	}
+}
+
+// WithAuditRecorder specifies how the PermissionsAuditReconciler should
+// record events.
+func WithAuditRecorder(er event.Recorder) PermissionsAuditOption {
+	return func(r *PermissionsAuditReconciler) {
+		r.record = er
+	}
+}
+
+// WithAuditClient specifies how the PermissionsAuditReconciler should create
+// the client used to audit a ProviderConfig.
+func WithAuditClient(fn func(ctx context.Context, kube client.Client, pc *v1beta1.ProviderConfig) (iam.PermissionsAuditClient, error)) PermissionsAuditOption {
+	return func(r *PermissionsAuditReconciler) {
+		r.newClientFn = fn
+	}
+}
+
+// WithRequiredActions specifies the IAM actions required by each kind of
+// managed resource.
+func WithRequiredActions(actions map[string][]string) PermissionsAuditOption {
+	return func(r *PermissionsAuditReconciler) {
+		r.actions = actions
+	}
+}
+
+// A PermissionsAuditReconciler simulates the IAM actions the controllers of
+// this provider require with the policies of the principal of a
+// ProviderConfig and reports the denied actions in its status.
+type PermissionsAuditReconciler struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, kube client.Client, pc *v1beta1.ProviderConfig) (iam.PermissionsAuditClient, error)
+	actions     map[string][]string
+	all         bool
+
+	log    logging.Logger
+	record event.Recorder
+}
+
+// NewPermissionsAuditReconciler returns a PermissionsAuditReconciler.
+func NewPermissionsAuditReconciler(m ctrl.Manager, o ...PermissionsAuditOption) *PermissionsAuditReconciler {
+	r := &PermissionsAuditReconciler{
+		kube:        m.GetClient(),
+		newClientFn: newAuditClient,
+		actions:     RequiredActions,
+		log:         logging.NewNopLogger(),
+		record:      event.NewNopRecorder(),
+	}
+	for _, ro := range o {
+		ro(r)
+	}
+	return r
+}
+
+func newAuditClient(ctx context.Context, kube client.Client, pc *v1beta1.ProviderConfig) (iam.PermissionsAuditClient, error) {
+	cfg, err := awsclients.UseNamedProviderConfig(ctx, kube, pc.GetName(), auditRegion)
+	if err != nil {
+		return nil, err
+	}
+	return iam.NewPermissionsAuditClient(*cfg), nil
+}
+
+// Reconcile a ProviderConfig by auditing the permissions of its principal
+// once per generation.
+func (r *PermissionsAuditReconciler) Reconcile(req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(context.Background(), auditTimeout)
+	defer cancel()
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		log.Debug(errGetProviderConfig, "error", err)
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetProviderConfig)
+	}
+	if !r.all && !pc.Spec.AuditPermissions {
+		return reconcile.Result{}, nil
+	}
+	if a := pc.Status.PermissionsAudit; a != nil && a.Error == "" && a.ObservedGeneration == pc.GetGeneration() {
+		return reconcile.Result{}, nil
+	}
+
+	now := metav1.Now()
+	audit, err := r.audit(ctx, pc)
+	if err != nil {
+		log.Debug("Cannot audit permissions", "error", err)
+		r.record.Event(pc, event.Warning(reasonAuditFailed, err))
+		pc.Status.PermissionsAudit = &v1beta1.PermissionsAuditStatus{LastAuditTime: &now, Error: err.Error()}
+		return reconcile.Result{RequeueAfter: auditRetryAfter}, errors.Wrap(r.kube.Status().Update(ctx, pc), errUpdateAuditStatus)
+	}
+	audit.ObservedGeneration = pc.GetGeneration()
+	audit.LastAuditTime = &now
+	pc.Status.PermissionsAudit = audit
+
+	if len(audit.MissingPermissions) > 0 {
+		kinds := make([]string, len(audit.MissingPermissions))
+		for i, mp := range audit.MissingPermissions {
+			kinds[i] = mp.Kind
+		}
+		r.record.Event(pc, event.Warning(reasonMissingPermissions,
+			errors.Errorf("principal %s is missing permissions required by %s", audit.Principal, strings.Join(kinds, ", "))))
+	} else {
+		r.record.Event(pc, event.Normal(reasonAuditSucceeded, fmt.Sprintf("principal %s has all required permissions", audit.Principal)))
+	}
+	return reconcile.Result{}, errors.Wrap(r.kube.Status().Update(ctx, pc), errUpdateAuditStatus)
+}
+
+func (r *PermissionsAuditReconciler) audit(ctx context.Context, pc *v1beta1.ProviderConfig) (*v1beta1.PermissionsAuditStatus, error) {
+	c, err := r.newClientFn(ctx, r.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewAuditClient)
+	}
+	principal, err := iam.PrincipalARN(ctx, c)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPrincipal)
+	}
+	audit := &v1beta1.PermissionsAuditStatus{Principal: principal}
+	if iam.IsRootPrincipal(principal) {
+		return audit, nil
+	}
+
+	seen := map[string]bool{}
+	actions := []string{}
+	for _, as := range r.actions {
+		for _, a := range as {
+			if !seen[a] {
+				seen[a] = true
+				actions = append(actions, a)
+			}
+		}
+	}
+	sort.Strings(actions)
+	denied, err := iam.DeniedActions(ctx, c, principal, actions)
+	if err != nil {
+		return nil, errors.Wrap(err, errSimulateActions)
+	}
+	audit.MissingPermissions = missingPermissions(r.actions, denied)
+	return audit, nil
+}
+
+// missingPermissions returns the denied actions grouped by the kinds that
+// require them, sorted by kind.
+func missingPermissions(required map[string][]string, denied []string) []v1beta1.MissingPermissions {
+	isDenied := make(map[string]bool, len(denied))
+	for _, a := range denied {
+		isDenied[a] = true
+	}
+	var res []v1beta1.MissingPermissions
+	for kind, actions := range required {
+		var missing []string
+		for _, a := range actions {
+			if isDenied[a] {
+				missing = append(missing, a)
+			}
+		}
+		if len(missing) > 0 {
+			res = append(res, v1beta1.MissingPermissions{Kind: kind, Actions: missing})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Kind < res[j].Kind })
+	return res
+}
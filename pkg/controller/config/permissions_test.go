@@ -0,0 +1,201 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	iamfake "github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+const (
+	auditName      = "default"
+	auditPrincipal = "arn:aws:iam::123456789012:user/crossplane"
+)
+
+var errBoom = errors.New("boom")
+
+func providerConfig(audit bool, status *v1beta1.PermissionsAuditStatus) v1beta1.ProviderConfig {
+	pc := v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: auditName, Generation: 2}}
+	pc.Spec.AuditPermissions = audit
+	pc.Status.PermissionsAudit = status
+	return pc
+}
+
+func auditClient(denied ...string) iam.PermissionsAuditClient {
+	return &iamfake.MockPermissionsAuditClient{
+		MockGetCallerIdentity: func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+			return sts.GetCallerIdentityRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &sts.GetCallerIdentityOutput{Arn: aws.String(auditPrincipal)}},
+			}
+		},
+		MockSimulatePrincipalPolicy: func(in *awsiam.SimulatePrincipalPolicyInput) awsiam.SimulatePrincipalPolicyRequest {
+			isDenied := map[string]bool{}
+			for _, a := range denied {
+				isDenied[a] = true
+			}
+			out := &awsiam.SimulatePrincipalPolicyOutput{}
+			for _, a := range in.ActionNames {
+				d := awsiam.PolicyEvaluationDecisionTypeAllowed
+				if isDenied[a] {
+					d = awsiam.PolicyEvaluationDecisionTypeImplicitDeny
+				}
+				out.EvaluationResults = append(out.EvaluationResults, awsiam.EvaluationResult{EvalActionName: aws.String(a), EvalDecision: d})
+			}
+			return awsiam.SimulatePrincipalPolicyRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+			}
+		},
+	}
+}
+
+func TestPermissionsAuditReconcile(t *testing.T) {
+	actions := map[string][]string{
+		"VPC.ec2.aws.crossplane.io":    {"ec2:CreateVpc", "ec2:DeleteVpc", "ec2:CreateTags"},
+		"Subnet.ec2.aws.crossplane.io": {"ec2:CreateSubnet", "ec2:CreateTags"},
+	}
+
+	type args struct {
+		pc       v1beta1.ProviderConfig
+		all      bool
+		client   iam.PermissionsAuditClient
+		clientFn func(context.Context, client.Client, *v1beta1.ProviderConfig) (iam.PermissionsAuditClient, error)
+	}
+	type want struct {
+		result  reconcile.Result
+		err     error
+		updated bool
+		status  *v1beta1.PermissionsAuditStatus
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"AuditDisabled": {
+			args: args{pc: providerConfig(false, nil)},
+			want: want{},
+		},
+		"AlreadyAudited": {
+			args: args{pc: providerConfig(true, &v1beta1.PermissionsAuditStatus{ObservedGeneration: 2, Principal: auditPrincipal})},
+			want: want{},
+		},
+		"MissingPermissions": {
+			args: args{pc: providerConfig(true, nil), client: auditClient("ec2:CreateTags", "ec2:DeleteVpc")},
+			want: want{
+				updated: true,
+				status: &v1beta1.PermissionsAuditStatus{
+					ObservedGeneration: 2,
+					Principal:          auditPrincipal,
+					MissingPermissions: []v1beta1.MissingPermissions{
+						{Kind: "Subnet.ec2.aws.crossplane.io", Actions: []string{"ec2:CreateTags"}},
+						{Kind: "VPC.ec2.aws.crossplane.io", Actions: []string{"ec2:DeleteVpc", "ec2:CreateTags"}},
+					},
+				},
+			},
+		},
+		"AuditAll": {
+			args: args{pc: providerConfig(false, &v1beta1.PermissionsAuditStatus{ObservedGeneration: 1}), all: true, client: auditClient()},
+			want: want{
+				updated: true,
+				status:  &v1beta1.PermissionsAuditStatus{ObservedGeneration: 2, Principal: auditPrincipal},
+			},
+		},
+		"ClientFailed": {
+			args: args{
+				pc: providerConfig(true, nil),
+				clientFn: func(context.Context, client.Client, *v1beta1.ProviderConfig) (iam.PermissionsAuditClient, error) {
+					return nil, errBoom
+				},
+			},
+			want: want{
+				result:  reconcile.Result{RequeueAfter: auditRetryAfter},
+				updated: true,
+				status:  &v1beta1.PermissionsAuditStatus{Error: errors.Wrap(errBoom, errNewAuditClient).Error()},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var updated *v1beta1.ProviderConfig
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if diff := cmp.Diff(types.NamespacedName{Name: auditName}, key); diff != "" {
+						t.Errorf("key: -want, +got:\n%s", diff)
+					}
+					tc.args.pc.DeepCopyInto(obj.(*v1beta1.ProviderConfig))
+					return nil
+				},
+				MockStatusUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					updated = obj.(*v1beta1.ProviderConfig)
+					return nil
+				},
+			}
+			clientFn := tc.args.clientFn
+			if clientFn == nil {
+				clientFn = func(context.Context, client.Client, *v1beta1.ProviderConfig) (iam.PermissionsAuditClient, error) {
+					return tc.args.client, nil
+				}
+			}
+			r := NewPermissionsAuditReconciler(&fake.Manager{Client: kube},
+				WithAuditAll(tc.args.all),
+				WithAuditClient(clientFn),
+				WithRequiredActions(actions))
+
+			got, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: auditName}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated != nil); diff != "" {
+				t.Errorf("updated: -want, +got:\n%s", diff)
+			}
+			if updated == nil {
+				return
+			}
+			if updated.Status.PermissionsAudit.LastAuditTime == nil {
+				t.Errorf("LastAuditTime is not set")
+			}
+			if diff := cmp.Diff(tc.want.status, updated.Status.PermissionsAudit,
+				cmpopts.IgnoreFields(v1beta1.PermissionsAuditStatus{}, "LastAuditTime")); diff != "" {
+				t.Errorf("status: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,393 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configrule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfigservice "github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/configservice"
+	"github.com/crossplane/provider-aws/pkg/clients/configservice/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	ruleName    = "s3-bucket-versioning-enabled"
+	ruleARN     = "some-arn"
+	ruleID      = "some-id"
+	identifier  = "S3_BUCKET_VERSIONING_ENABLED"
+	description = "some description"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	configservice configservice.ConfigRuleClient
+	cr            resource.Managed
+}
+
+type ruleModifier func(*v1alpha1.ConfigRule)
+
+func withConditions(c ...runtimev1alpha1.Condition) ruleModifier {
+	return func(r *v1alpha1.ConfigRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDescription(d string) ruleModifier {
+	return func(r *v1alpha1.ConfigRule) { r.Spec.ForProvider.Description = aws.String(d) }
+}
+
+func withStatus(state string) ruleModifier {
+	return func(r *v1alpha1.ConfigRule) {
+		r.Status.AtProvider = v1alpha1.ConfigRuleObservation{ConfigRuleARN: ruleARN, ConfigRuleID: ruleID, ConfigRuleState: state}
+	}
+}
+
+func rule(m ...ruleModifier) *v1alpha1.ConfigRule {
+	cr := &v1alpha1.ConfigRule{
+		Spec: v1alpha1.ConfigRuleSpec{
+			ForProvider: v1alpha1.ConfigRuleParameters{
+				Source: v1alpha1.Source{
+					Owner:            "AWS",
+					SourceIdentifier: identifier,
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, ruleName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeRules(state awsconfigservice.ConfigRuleState) func(*awsconfigservice.DescribeConfigRulesInput) awsconfigservice.DescribeConfigRulesRequest {
+	return func(*awsconfigservice.DescribeConfigRulesInput) awsconfigservice.DescribeConfigRulesRequest {
+		return awsconfigservice.DescribeConfigRulesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsconfigservice.DescribeConfigRulesOutput{
+				ConfigRules: []awsconfigservice.ConfigRule{{
+					ConfigRuleName:  aws.String(ruleName),
+					ConfigRuleArn:   aws.String(ruleARN),
+					ConfigRuleId:    aws.String(ruleID),
+					ConfigRuleState: state,
+					Source: &awsconfigservice.Source{
+						Owner:            awsconfigservice.OwnerAws,
+						SourceIdentifier: aws.String(identifier),
+					},
+				}},
+			}},
+		}
+	}
+}
+
+func putRule(err error) func(*awsconfigservice.PutConfigRuleInput) awsconfigservice.PutConfigRuleRequest {
+	return func(*awsconfigservice.PutConfigRuleInput) awsconfigservice.PutConfigRuleRequest {
+		return awsconfigservice.PutConfigRuleRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsconfigservice.PutConfigRuleOutput{}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				configservice: &fake.MockConfigRuleClient{
+					MockDescribeConfigRules: describeRules(awsconfigservice.ConfigRuleStateActive),
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withStatus("ACTIVE"), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Evaluating": {
+			args: args{
+				configservice: &fake.MockConfigRuleClient{
+					MockDescribeConfigRules: describeRules(awsconfigservice.ConfigRuleStateEvaluating),
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withStatus("EVALUATING"), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				configservice: &fake.MockConfigRuleClient{
+					MockDescribeConfigRules: describeRules(awsconfigservice.ConfigRuleStateActive),
+				},
+				cr: rule(withDescription(description)),
+			},
+			want: want{
+				cr: rule(withDescription(description), withStatus("ACTIVE"), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				configservice: &fake.MockConfigRuleClient{
+					MockDescribeConfigRules: func(*awsconfigservice.DescribeConfigRulesInput) awsconfigservice.DescribeConfigRulesRequest {
+						return awsconfigservice.DescribeConfigRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.configservice}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				configservice: &fake.MockConfigRuleClient{
+					MockPutConfigRule: putRule(nil),
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				configservice: &fake.MockConfigRuleClient{
+					MockPutConfigRule: putRule(errBoom),
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.configservice}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				configservice: &fake.MockConfigRuleClient{
+					MockPutConfigRule: putRule(nil),
+				},
+				cr: rule(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				configservice: &fake.MockConfigRuleClient{
+					MockPutConfigRule: putRule(errBoom),
+				},
+				cr: rule(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.configservice}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteRule := func(err error) func(*awsconfigservice.DeleteConfigRuleInput) awsconfigservice.DeleteConfigRuleRequest {
+		return func(*awsconfigservice.DeleteConfigRuleInput) awsconfigservice.DeleteConfigRuleRequest {
+			return awsconfigservice.DeleteConfigRuleRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsconfigservice.DeleteConfigRuleOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				configservice: &fake.MockConfigRuleClient{
+					MockDeleteConfigRule: deleteRule(nil),
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				configservice: &fake.MockConfigRuleClient{
+					MockDeleteConfigRule: deleteRule(errBoom),
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.configservice}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
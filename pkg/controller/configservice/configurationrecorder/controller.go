@@ -0,0 +1,183 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configurationrecorder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfigservice "github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/configservice"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a ConfigurationRecorder resource"
+
+	errGet        = "failed to get the ConfigurationRecorder resource"
+	errGetStatus  = "failed to get the status of the ConfigurationRecorder resource"
+	errPut        = "failed to put the ConfigurationRecorder resource"
+	errStart      = "failed to start the ConfigurationRecorder resource"
+	errStop       = "failed to stop the ConfigurationRecorder resource"
+	errDelete     = "failed to delete the ConfigurationRecorder resource"
+	errSpecUpdate = "cannot update spec of ConfigurationRecorder custom resource"
+)
+
+// SetupConfigurationRecorder adds a controller that reconciles
+// ConfigurationRecorders.
+func SetupConfigurationRecorder(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ConfigurationRecorderGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ConfigurationRecorder{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ConfigurationRecorderGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: configservice.NewConfigurationRecorderClient}))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) configservice.ConfigurationRecorderClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ConfigurationRecorder)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client configservice.ConfigurationRecorderClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.ConfigurationRecorder)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	names := []string{meta.GetExternalName(cr)}
+	res, err := e.client.DescribeConfigurationRecordersRequest(&awsconfigservice.DescribeConfigurationRecordersInput{
+		ConfigurationRecorderNames: names,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(configservice.IsConfigurationRecorderNotFound, err), errGet)
+	}
+	if len(res.ConfigurationRecorders) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	recorder := res.ConfigurationRecorders[0]
+
+	status, err := e.client.DescribeConfigurationRecorderStatusRequest(&awsconfigservice.DescribeConfigurationRecorderStatusInput{
+		ConfigurationRecorderNames: names,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetStatus)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	configservice.LateInitializeConfigurationRecorder(&cr.Spec.ForProvider, &recorder)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+	if len(status.ConfigurationRecordersStatus) != 0 {
+		cr.Status.AtProvider = configservice.GenerateConfigurationRecorderObservation(status.ConfigurationRecordersStatus[0])
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: configservice.IsConfigurationRecorderUpToDate(cr.Spec.ForProvider, recorder, cr.Status.AtProvider.Recording),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.ConfigurationRecorder)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.PutConfigurationRecorderRequest(configservice.GeneratePutConfigurationRecorderInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errPut)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.ConfigurationRecorder)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	name := meta.GetExternalName(cr)
+	if _, err := e.client.PutConfigurationRecorderRequest(configservice.GeneratePutConfigurationRecorderInput(name, cr.Spec.ForProvider)).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPut)
+	}
+
+	// A configuration recorder does not record anything after creation
+	// until it is started, so recording is started by default.
+	if cr.Spec.ForProvider.Recording == nil || aws.BoolValue(cr.Spec.ForProvider.Recording) {
+		_, err := e.client.StartConfigurationRecorderRequest(&awsconfigservice.StartConfigurationRecorderInput{
+			ConfigurationRecorderName: aws.String(name),
+		}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errStart)
+	}
+	_, err := e.client.StopConfigurationRecorderRequest(&awsconfigservice.StopConfigurationRecorderInput{
+		ConfigurationRecorderName: aws.String(name),
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errStop)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.ConfigurationRecorder)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteConfigurationRecorderRequest(&awsconfigservice.DeleteConfigurationRecorderInput{
+		ConfigurationRecorderName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(configservice.IsConfigurationRecorderNotFound, err), errDelete)
+}
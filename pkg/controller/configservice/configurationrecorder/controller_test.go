@@ -0,0 +1,449 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configurationrecorder
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfigservice "github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/configservice"
+	"github.com/crossplane/provider-aws/pkg/clients/configservice/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	recorderName = "default"
+	roleARN      = "some-arn"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	configservice configservice.ConfigurationRecorderClient
+	cr            resource.Managed
+}
+
+type recorderModifier func(*v1alpha1.ConfigurationRecorder)
+
+func withConditions(c ...runtimev1alpha1.Condition) recorderModifier {
+	return func(r *v1alpha1.ConfigurationRecorder) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRecording(b bool) recorderModifier {
+	return func(r *v1alpha1.ConfigurationRecorder) { r.Spec.ForProvider.Recording = aws.Bool(b) }
+}
+
+func withStatus(recording bool) recorderModifier {
+	return func(r *v1alpha1.ConfigurationRecorder) {
+		r.Status.AtProvider = v1alpha1.ConfigurationRecorderObservation{Recording: recording, LastStatus: "Success"}
+	}
+}
+
+func recorder(m ...recorderModifier) *v1alpha1.ConfigurationRecorder {
+	cr := &v1alpha1.ConfigurationRecorder{
+		Spec: v1alpha1.ConfigurationRecorderSpec{
+			ForProvider: v1alpha1.ConfigurationRecorderParameters{
+				RoleARN: aws.String(roleARN),
+			},
+		},
+	}
+	meta.SetExternalName(cr, recorderName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeRecorders(recorders ...awsconfigservice.ConfigurationRecorder) func(*awsconfigservice.DescribeConfigurationRecordersInput) awsconfigservice.DescribeConfigurationRecordersRequest {
+	return func(*awsconfigservice.DescribeConfigurationRecordersInput) awsconfigservice.DescribeConfigurationRecordersRequest {
+		return awsconfigservice.DescribeConfigurationRecordersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsconfigservice.DescribeConfigurationRecordersOutput{
+				ConfigurationRecorders: recorders,
+			}},
+		}
+	}
+}
+
+func describeStatus(recording bool) func(*awsconfigservice.DescribeConfigurationRecorderStatusInput) awsconfigservice.DescribeConfigurationRecorderStatusRequest {
+	return func(*awsconfigservice.DescribeConfigurationRecorderStatusInput) awsconfigservice.DescribeConfigurationRecorderStatusRequest {
+		return awsconfigservice.DescribeConfigurationRecorderStatusRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsconfigservice.DescribeConfigurationRecorderStatusOutput{
+				ConfigurationRecordersStatus: []awsconfigservice.ConfigurationRecorderStatus{{
+					Name:       aws.String(recorderName),
+					Recording:  aws.Bool(recording),
+					LastStatus: awsconfigservice.RecorderStatusSuccess,
+				}},
+			}},
+		}
+	}
+}
+
+func putRecorder(*awsconfigservice.PutConfigurationRecorderInput) awsconfigservice.PutConfigurationRecorderRequest {
+	return awsconfigservice.PutConfigurationRecorderRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsconfigservice.PutConfigurationRecorderOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	existing := awsconfigservice.ConfigurationRecorder{Name: aws.String(recorderName), RoleARN: aws.String(roleARN)}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				configservice: &fake.MockConfigurationRecorderClient{
+					MockDescribeConfigurationRecorders:      describeRecorders(existing),
+					MockDescribeConfigurationRecorderStatus: describeStatus(true),
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr: recorder(withStatus(true), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotRecording": {
+			args: args{
+				configservice: &fake.MockConfigurationRecorderClient{
+					MockDescribeConfigurationRecorders:      describeRecorders(existing),
+					MockDescribeConfigurationRecorderStatus: describeStatus(false),
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr: recorder(withStatus(false), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				configservice: &fake.MockConfigurationRecorderClient{
+					MockDescribeConfigurationRecorders: describeRecorders(),
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr: recorder(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				configservice: &fake.MockConfigurationRecorderClient{
+					MockDescribeConfigurationRecorders: func(*awsconfigservice.DescribeConfigurationRecordersInput) awsconfigservice.DescribeConfigurationRecordersRequest {
+						return awsconfigservice.DescribeConfigurationRecordersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr:  recorder(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+		"StatusError": {
+			args: args{
+				configservice: &fake.MockConfigurationRecorderClient{
+					MockDescribeConfigurationRecorders: describeRecorders(existing),
+					MockDescribeConfigurationRecorderStatus: func(*awsconfigservice.DescribeConfigurationRecorderStatusInput) awsconfigservice.DescribeConfigurationRecorderStatusRequest {
+						return awsconfigservice.DescribeConfigurationRecorderStatusRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr:  recorder(),
+				err: errors.Wrap(errBoom, errGetStatus),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.configservice}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				configservice: &fake.MockConfigurationRecorderClient{
+					MockPutConfigurationRecorder: putRecorder,
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr: recorder(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				configservice: &fake.MockConfigurationRecorderClient{
+					MockPutConfigurationRecorder: func(*awsconfigservice.PutConfigurationRecorderInput) awsconfigservice.PutConfigurationRecorderRequest {
+						return awsconfigservice.PutConfigurationRecorderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr:  recorder(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.configservice}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"StartRecording": {
+			args: args{
+				configservice: &fake.MockConfigurationRecorderClient{
+					MockPutConfigurationRecorder: putRecorder,
+					MockStartConfigurationRecorder: func(*awsconfigservice.StartConfigurationRecorderInput) awsconfigservice.StartConfigurationRecorderRequest {
+						return awsconfigservice.StartConfigurationRecorderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsconfigservice.StartConfigurationRecorderOutput{}},
+						}
+					},
+				},
+				cr: recorder(),
+			},
+		},
+		"StopRecording": {
+			args: args{
+				configservice: &fake.MockConfigurationRecorderClient{
+					MockPutConfigurationRecorder: putRecorder,
+					MockStopConfigurationRecorder: func(*awsconfigservice.StopConfigurationRecorderInput) awsconfigservice.StopConfigurationRecorderRequest {
+						return awsconfigservice.StopConfigurationRecorderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsconfigservice.StopConfigurationRecorderOutput{}},
+						}
+					},
+				},
+				cr: recorder(withRecording(false)),
+			},
+		},
+		"StartError": {
+			args: args{
+				configservice: &fake.MockConfigurationRecorderClient{
+					MockPutConfigurationRecorder: putRecorder,
+					MockStartConfigurationRecorder: func(*awsconfigservice.StartConfigurationRecorderInput) awsconfigservice.StartConfigurationRecorderRequest {
+						return awsconfigservice.StartConfigurationRecorderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: recorder(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errStart),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				configservice: &fake.MockConfigurationRecorderClient{
+					MockPutConfigurationRecorder: func(*awsconfigservice.PutConfigurationRecorderInput) awsconfigservice.PutConfigurationRecorderRequest {
+						return awsconfigservice.PutConfigurationRecorderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: recorder(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.configservice}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				configservice: &fake.MockConfigurationRecorderClient{
+					MockDeleteConfigurationRecorder: func(*awsconfigservice.DeleteConfigurationRecorderInput) awsconfigservice.DeleteConfigurationRecorderRequest {
+						return awsconfigservice.DeleteConfigurationRecorderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsconfigservice.DeleteConfigurationRecorderOutput{}},
+						}
+					},
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr: recorder(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				configservice: &fake.MockConfigurationRecorderClient{
+					MockDeleteConfigurationRecorder: func(*awsconfigservice.DeleteConfigurationRecorderInput) awsconfigservice.DeleteConfigurationRecorderRequest {
+						return awsconfigservice.DeleteConfigurationRecorderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr:  recorder(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.configservice}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,375 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deliverychannel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfigservice "github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/configservice"
+	"github.com/crossplane/provider-aws/pkg/clients/configservice/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	channelName = "default"
+	bucketName  = "some-bucket"
+	keyPrefix   = "config"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	configservice configservice.DeliveryChannelClient
+	cr            resource.Managed
+}
+
+type channelModifier func(*v1alpha1.DeliveryChannel)
+
+func withConditions(c ...runtimev1alpha1.Condition) channelModifier {
+	return func(r *v1alpha1.DeliveryChannel) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withKeyPrefix(p string) channelModifier {
+	return func(r *v1alpha1.DeliveryChannel) { r.Spec.ForProvider.S3KeyPrefix = aws.String(p) }
+}
+
+func channel(m ...channelModifier) *v1alpha1.DeliveryChannel {
+	cr := &v1alpha1.DeliveryChannel{
+		Spec: v1alpha1.DeliveryChannelSpec{
+			ForProvider: v1alpha1.DeliveryChannelParameters{
+				S3BucketName: aws.String(bucketName),
+			},
+		},
+	}
+	meta.SetExternalName(cr, channelName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeChannels(channels ...awsconfigservice.DeliveryChannel) func(*awsconfigservice.DescribeDeliveryChannelsInput) awsconfigservice.DescribeDeliveryChannelsRequest {
+	return func(*awsconfigservice.DescribeDeliveryChannelsInput) awsconfigservice.DescribeDeliveryChannelsRequest {
+		return awsconfigservice.DescribeDeliveryChannelsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsconfigservice.DescribeDeliveryChannelsOutput{
+				DeliveryChannels: channels,
+			}},
+		}
+	}
+}
+
+func putChannel(err error) func(*awsconfigservice.PutDeliveryChannelInput) awsconfigservice.PutDeliveryChannelRequest {
+	return func(*awsconfigservice.PutDeliveryChannelInput) awsconfigservice.PutDeliveryChannelRequest {
+		return awsconfigservice.PutDeliveryChannelRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsconfigservice.PutDeliveryChannelOutput{}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				configservice: &fake.MockDeliveryChannelClient{
+					MockDescribeDeliveryChannels: describeChannels(awsconfigservice.DeliveryChannel{
+						Name:         aws.String(channelName),
+						S3BucketName: aws.String(bucketName),
+					}),
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr: channel(withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				configservice: &fake.MockDeliveryChannelClient{
+					MockDescribeDeliveryChannels: describeChannels(awsconfigservice.DeliveryChannel{
+						Name:         aws.String(channelName),
+						S3BucketName: aws.String(bucketName),
+					}),
+				},
+				cr: channel(withKeyPrefix(keyPrefix)),
+			},
+			want: want{
+				cr: channel(withKeyPrefix(keyPrefix), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				configservice: &fake.MockDeliveryChannelClient{
+					MockDescribeDeliveryChannels: describeChannels(),
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr: channel(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				configservice: &fake.MockDeliveryChannelClient{
+					MockDescribeDeliveryChannels: func(*awsconfigservice.DescribeDeliveryChannelsInput) awsconfigservice.DescribeDeliveryChannelsRequest {
+						return awsconfigservice.DescribeDeliveryChannelsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr:  channel(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.configservice}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				configservice: &fake.MockDeliveryChannelClient{
+					MockPutDeliveryChannel: putChannel(nil),
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr: channel(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				configservice: &fake.MockDeliveryChannelClient{
+					MockPutDeliveryChannel: putChannel(errBoom),
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr:  channel(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.configservice}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				configservice: &fake.MockDeliveryChannelClient{
+					MockPutDeliveryChannel: putChannel(nil),
+				},
+				cr: channel(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				configservice: &fake.MockDeliveryChannelClient{
+					MockPutDeliveryChannel: putChannel(errBoom),
+				},
+				cr: channel(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.configservice}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteChannel := func(err error) func(*awsconfigservice.DeleteDeliveryChannelInput) awsconfigservice.DeleteDeliveryChannelRequest {
+		return func(*awsconfigservice.DeleteDeliveryChannelInput) awsconfigservice.DeleteDeliveryChannelRequest {
+			return awsconfigservice.DeleteDeliveryChannelRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsconfigservice.DeleteDeliveryChannelOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				configservice: &fake.MockDeliveryChannelClient{
+					MockDeleteDeliveryChannel: deleteChannel(nil),
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr: channel(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				configservice: &fake.MockDeliveryChannelClient{
+					MockDeleteDeliveryChannel: deleteChannel(errBoom),
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr:  channel(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.configservice}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dbcluster"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an RDS DBCluster resource"
+
+	errDescribe   = "failed to describe the DBCluster resource"
+	errNotOne     = "expected exactly one DBCluster"
+	errCreate     = "failed to create the DBCluster resource"
+	errRestore    = "failed to restore the DBCluster resource from snapshot"
+	errModify     = "failed to modify the DBCluster resource"
+	errDelete     = "failed to delete the DBCluster resource"
+	errListTags   = "failed to list the tags of the DBCluster resource"
+	errAddTags    = "failed to add tags to the DBCluster resource"
+	errRemoveTags = "failed to remove tags from the DBCluster resource"
+	errSpecUpdate = "cannot update spec of the DBCluster custom resource"
+	errPassword   = "cannot get the master password of the DBCluster resource"
+)
+
+// SetupDBCluster adds a controller that reconciles DBClusters.
+func SetupDBCluster(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DBClusterGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.DBCluster{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DBClusterGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: dbcluster.NewClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) dbcluster.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DBCluster)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client dbcluster.Client
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.DBCluster) (*awsrds.DBCluster, error) {
+	rsp, err := e.client.DescribeDBClustersRequest(&awsrds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp.DBClusters) != 1 {
+		return nil, errors.New(errNotOne)
+	}
+	return &rsp.DBClusters[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.DBCluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(dbcluster.IsNotFound, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	dbcluster.LateInitialize(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = dbcluster.GenerateObservation(*observed)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.DBClusterStateAvailable, v1alpha1.DBClusterStateBackingUp:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.DBClusterStateCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha1.DBClusterStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	tags, err := e.client.ListTagsForResourceRequest(&awsrds.ListTagsForResourceInput{ResourceName: observed.DBClusterArn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := dbcluster.DiffTags(cr.Spec.ForProvider.Tags, tags.TagList)
+	_, pwdChanged, err := dbcluster.GetPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errPassword)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  len(add) == 0 && len(remove) == 0 && !pwdChanged && dbcluster.IsUpToDate(cr.Spec.ForProvider, *observed),
+		ConnectionDetails: dbcluster.GetConnectionDetails(*cr),
+	}, nil
+}
+
+// Create creates an empty DB cluster with the referenced or a generated
+// master password, or restores it from a snapshot if a snapshot identifier
+// is given. A DB cluster without a master username, i.e. a secondary DB
+// cluster of a global database, is created without a password. A restored DB cluster keeps the password of the snapshot until
+// the referenced password is applied by an update.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.DBCluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	name := meta.GetExternalName(cr)
+	if cr.Spec.ForProvider.SnapshotIdentifier != nil {
+		_, err := e.client.RestoreDBClusterFromSnapshotRequest(dbcluster.GenerateRestoreDBClusterFromSnapshotInput(name, cr.Spec.ForProvider)).Send(ctx)
+		return managed.ExternalCreation{}, errors.Wrap(err, errRestore)
+	}
+	// A secondary DB cluster of a global database has no master user.
+	if cr.Spec.ForProvider.MasterUsername == nil {
+		_, err := e.client.CreateDBClusterRequest(dbcluster.GenerateCreateDBClusterInput(name, "", cr.Spec.ForProvider)).Send(ctx)
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	pw, _, err := dbcluster.GetPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPassword)
+	}
+	if pw == "" {
+		pw, err = password.Generate()
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errPassword)
+		}
+	}
+	if _, err := e.client.CreateDBClusterRequest(dbcluster.GenerateCreateDBClusterInput(name, pw, cr.Spec.ForProvider)).Send(ctx); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername)),
+			runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, nil
+}
+
+// Update updates the tags and the modifiable settings of the DB cluster. The
+// DB cluster can only be modified while it is available.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.DBCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Status.AtProvider.Status != v1alpha1.DBClusterStateAvailable {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	arn := aws.String(cr.Status.AtProvider.DBClusterARN)
+	tags, err := e.client.ListTagsForResourceRequest(&awsrds.ListTagsForResourceInput{ResourceName: arn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := dbcluster.DiffTags(cr.Spec.ForProvider.Tags, tags.TagList)
+	if len(remove) > 0 {
+		if _, err := e.client.RemoveTagsFromResourceRequest(&awsrds.RemoveTagsFromResourceInput{ResourceName: arn, TagKeys: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.AddTagsToResourceRequest(&awsrds.AddTagsToResourceInput{ResourceName: arn, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	pw, pwdChanged, err := dbcluster.GetPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPassword)
+	}
+	if !pwdChanged && dbcluster.IsUpToDate(cr.Spec.ForProvider, *observed) {
+		return managed.ExternalUpdate{}, nil
+	}
+	in := dbcluster.GenerateModifyDBClusterInput(meta.GetExternalName(cr), cr.Spec.ForProvider, *observed)
+	if pwdChanged {
+		in.MasterUserPassword = aws.String(pw)
+	}
+	if _, err := e.client.ModifyDBClusterRequest(in).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+	}
+	if !pwdChanged {
+		return managed.ExternalUpdate{}, nil
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{
+			runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.DBCluster)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.Status == v1alpha1.DBClusterStateDeleting {
+		return nil
+	}
+	_, err := e.client.DeleteDBClusterRequest(dbcluster.GenerateDeleteDBClusterInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return errors.Wrap(resource.Ignore(dbcluster.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbparametergroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dbparametergroup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a DBParameterGroup resource"
+
+	errDescribe       = "failed to describe the DBParameterGroup resource"
+	errNotOne         = "expected exactly one DBParameterGroup"
+	errDescribeParams = "failed to describe the parameters of the DBParameterGroup resource"
+	errCreate         = "failed to create the DBParameterGroup resource"
+	errModify         = "failed to modify the parameters of the DBParameterGroup resource"
+	errReset          = "failed to reset the parameters of the DBParameterGroup resource"
+	errDelete         = "failed to delete the DBParameterGroup resource"
+	errListTags       = "failed to list the tags of the DBParameterGroup resource"
+	errAddTags        = "failed to add tags to the DBParameterGroup resource"
+	errRemoveTags     = "failed to remove tags from the DBParameterGroup resource"
+
+	// maxParameters is the maximum number of parameters that can be
+	// modified or reset in a single request.
+	maxParameters = 20
+)
+
+// SetupDBParameterGroup adds a controller that reconciles DBParameterGroups.
+func SetupDBParameterGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.DBParameterGroupGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.DBParameterGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.DBParameterGroupGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: dbparametergroup.NewClient}, awsclients.DeletionTierAttachment), v1beta1.Group)))),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) dbparametergroup.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.DBParameterGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client dbparametergroup.Client
+}
+
+// parameters returns the parameters of the DB parameter group with the given
+// name that are modified from their default value.
+func (e *external) parameters(ctx context.Context, name string) ([]awsrds.Parameter, error) {
+	var params []awsrds.Parameter
+	in := &awsrds.DescribeDBParametersInput{
+		DBParameterGroupName: aws.String(name),
+		Source:               aws.String(dbparametergroup.SourceUser),
+	}
+	for {
+		out, err := e.client.DescribeDBParametersRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, out.Parameters...)
+		if out.Marker == nil {
+			break
+		}
+		in.Marker = out.Marker
+	}
+	return params, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1beta1.DBParameterGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.DescribeDBParameterGroupsRequest(&awsrds.DescribeDBParameterGroupsInput{
+		DBParameterGroupName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(dbparametergroup.IsNotFound, err), errDescribe)
+	}
+	if len(rsp.DBParameterGroups) != 1 {
+		return managed.ExternalObservation{}, errors.New(errNotOne)
+	}
+	observed := rsp.DBParameterGroups[0]
+	cr.Status.AtProvider = dbparametergroup.GenerateObservation(observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	params, err := e.parameters(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeParams)
+	}
+	modify, reset := dbparametergroup.DiffParameters(cr.Spec.ForProvider.Parameters, params)
+
+	tags, err := e.client.ListTagsForResourceRequest(&awsrds.ListTagsForResourceInput{ResourceName: observed.DBParameterGroupArn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := dbparametergroup.DiffTags(cr.Spec.ForProvider.Tags, tags.TagList)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(modify) == 0 && len(reset) == 0 && len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+// Create creates the DB parameter group. Its parameters are set by the
+// subsequent update.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1beta1.DBParameterGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateDBParameterGroupRequest(dbparametergroup.GenerateCreateDBParameterGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+// Update updates the tags of the DB parameter group, resets the parameters
+// that are no longer desired to their default value and modifies the ones
+// that differ from the desired value.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1beta1.DBParameterGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := aws.String(cr.Status.AtProvider.ARN)
+	tags, err := e.client.ListTagsForResourceRequest(&awsrds.ListTagsForResourceInput{ResourceName: arn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := dbparametergroup.DiffTags(cr.Spec.ForProvider.Tags, tags.TagList)
+	if len(remove) > 0 {
+		if _, err := e.client.RemoveTagsFromResourceRequest(&awsrds.RemoveTagsFromResourceInput{ResourceName: arn, TagKeys: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.AddTagsToResourceRequest(&awsrds.AddTagsToResourceInput{ResourceName: arn, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	name := aws.String(meta.GetExternalName(cr))
+	params, err := e.parameters(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeParams)
+	}
+	modify, reset := dbparametergroup.DiffParameters(cr.Spec.ForProvider.Parameters, params)
+	for len(reset) > 0 {
+		n := len(reset)
+		if n > maxParameters {
+			n = maxParameters
+		}
+		if _, err := e.client.ResetDBParameterGroupRequest(&awsrds.ResetDBParameterGroupInput{DBParameterGroupName: name, Parameters: reset[:n]}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errReset)
+		}
+		reset = reset[n:]
+	}
+	for len(modify) > 0 {
+		n := len(modify)
+		if n > maxParameters {
+			n = maxParameters
+		}
+		if _, err := e.client.ModifyDBParameterGroupRequest(&awsrds.ModifyDBParameterGroupInput{DBParameterGroupName: name, Parameters: modify[:n]}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+		}
+		modify = modify[n:]
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.DBParameterGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteDBParameterGroupRequest(&awsrds.DeleteDBParameterGroupInput{
+		DBParameterGroupName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(dbparametergroup.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,397 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbparametergroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/dbparametergroup"
+	"github.com/crossplane/provider-aws/pkg/clients/dbparametergroup/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	groupName = "some-group"
+	groupARN  = "arn:aws:rds:us-east-1:123456789012:pg:some-group"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	rds dbparametergroup.Client
+	cr  resource.Managed
+}
+
+type groupModifier func(*v1beta1.DBParameterGroup)
+
+func withConditions(c ...runtimev1alpha1.Condition) groupModifier {
+	return func(r *v1beta1.DBParameterGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withARN() groupModifier {
+	return func(r *v1beta1.DBParameterGroup) { r.Status.AtProvider.ARN = groupARN }
+}
+
+func withParameters(p ...v1beta1.Parameter) groupModifier {
+	return func(r *v1beta1.DBParameterGroup) { r.Spec.ForProvider.Parameters = p }
+}
+
+func group(m ...groupModifier) *v1beta1.DBParameterGroup {
+	cr := &v1beta1.DBParameterGroup{}
+	meta.SetExternalName(cr, groupName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(*awsrds.DescribeDBParameterGroupsInput) awsrds.DescribeDBParameterGroupsRequest {
+	return awsrds.DescribeDBParameterGroupsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBParameterGroupsOutput{
+			DBParameterGroups: []awsrds.DBParameterGroup{{DBParameterGroupName: aws.String(groupName), DBParameterGroupArn: aws.String(groupARN)}},
+		}},
+	}
+}
+
+// userParameters returns the given user parameters in two pages.
+func userParameters(p ...awsrds.Parameter) func(*awsrds.DescribeDBParametersInput) awsrds.DescribeDBParametersRequest {
+	return func(in *awsrds.DescribeDBParametersInput) awsrds.DescribeDBParametersRequest {
+		out := &awsrds.DescribeDBParametersOutput{Marker: aws.String("next")}
+		if in.Marker != nil {
+			out = &awsrds.DescribeDBParametersOutput{Parameters: p}
+		}
+		return awsrds.DescribeDBParametersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func noTags(*awsrds.ListTagsForResourceInput) awsrds.ListTagsForResourceRequest {
+	return awsrds.ListTagsForResourceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ListTagsForResourceOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockDescribeDBParameterGroups: describe,
+					MockDescribeDBParameters:      userParameters(awsrds.Parameter{ParameterName: aws.String("max_connections"), ParameterValue: aws.String("100")}),
+					MockListTagsForResource:       noTags,
+				},
+				cr: group(withParameters(v1beta1.Parameter{ParameterName: "max_connections", ParameterValue: "100"})),
+			},
+			want: want{
+				cr:     group(withParameters(v1beta1.Parameter{ParameterName: "max_connections", ParameterValue: "100"}), withARN(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ParameterDrift": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockDescribeDBParameterGroups: describe,
+					MockDescribeDBParameters:      userParameters(awsrds.Parameter{ParameterName: aws.String("max_connections"), ParameterValue: aws.String("500")}),
+					MockListTagsForResource:       noTags,
+				},
+				cr: group(withParameters(v1beta1.Parameter{ParameterName: "max_connections", ParameterValue: "100"})),
+			},
+			want: want{
+				cr:     group(withParameters(v1beta1.Parameter{ParameterName: "max_connections", ParameterValue: "100"}), withARN(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockDescribeDBParameterGroups: func(*awsrds.DescribeDBParameterGroupsInput) awsrds.DescribeDBParameterGroupsRequest {
+						return awsrds.DescribeDBParameterGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsrds.ErrCodeDBParameterGroupNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockDescribeDBParameterGroups: describe,
+					MockDescribeDBParameters: func(*awsrds.DescribeDBParametersInput) awsrds.DescribeDBParametersRequest {
+						return awsrds.DescribeDBParametersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withARN(), withConditions(runtimev1alpha1.Available())),
+				err: errors.Wrap(errBoom, errDescribeParams),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockCreateDBParameterGroup: func(*awsrds.CreateDBParameterGroupInput) awsrds.CreateDBParameterGroupRequest {
+						return awsrds.CreateDBParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBParameterGroupOutput{}},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockCreateDBParameterGroup: func(*awsrds.CreateDBParameterGroupInput) awsrds.CreateDBParameterGroupRequest {
+						return awsrds.CreateDBParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockListTagsForResource:  noTags,
+					MockDescribeDBParameters: userParameters(awsrds.Parameter{ParameterName: aws.String("wait_timeout"), ParameterValue: aws.String("60")}),
+					MockResetDBParameterGroup: func(in *awsrds.ResetDBParameterGroupInput) awsrds.ResetDBParameterGroupRequest {
+						want := []awsrds.Parameter{{ParameterName: aws.String("wait_timeout"), ApplyMethod: awsrds.ApplyMethodImmediate}}
+						if diff := cmp.Diff(want, in.Parameters); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.ResetDBParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ResetDBParameterGroupOutput{}},
+						}
+					},
+					MockModifyDBParameterGroup: func(in *awsrds.ModifyDBParameterGroupInput) awsrds.ModifyDBParameterGroupRequest {
+						want := []awsrds.Parameter{{ParameterName: aws.String("max_connections"), ParameterValue: aws.String("100"), ApplyMethod: awsrds.ApplyMethodImmediate}}
+						if diff := cmp.Diff(want, in.Parameters); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.ModifyDBParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBParameterGroupOutput{}},
+						}
+					},
+				},
+				cr: group(withARN(), withParameters(v1beta1.Parameter{ParameterName: "max_connections", ParameterValue: "100"})),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockListTagsForResource:  noTags,
+					MockDescribeDBParameters: userParameters(),
+					MockModifyDBParameterGroup: func(*awsrds.ModifyDBParameterGroupInput) awsrds.ModifyDBParameterGroupRequest {
+						return awsrds.ModifyDBParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(withARN(), withParameters(v1beta1.Parameter{ParameterName: "max_connections", ParameterValue: "100"})),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockDeleteDBParameterGroup: func(*awsrds.DeleteDBParameterGroupInput) awsrds.DeleteDBParameterGroupRequest {
+						return awsrds.DeleteDBParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteDBParameterGroupOutput{}},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockDeleteDBParameterGroup: func(*awsrds.DeleteDBParameterGroupInput) awsrds.DeleteDBParameterGroupRequest {
+						return awsrds.DeleteDBParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsrds.ErrCodeDBParameterGroupNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockDeleteDBParameterGroup: func(*awsrds.DeleteDBParameterGroupInput) awsrds.DeleteDBParameterGroupRequest {
+						return awsrds.DeleteDBParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
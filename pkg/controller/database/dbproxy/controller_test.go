@@ -0,0 +1,465 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbproxy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/dbproxy"
+	"github.com/crossplane/provider-aws/pkg/clients/dbproxy/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	proxyName = "some-proxy"
+	proxyARN  = "arn:aws:rds:us-east-1:123456789012:db-proxy:prx-123"
+	endpoint  = "some-proxy.proxy-abc.us-east-1.rds.amazonaws.com"
+	secretARN = "arn:aws:secretsmanager:us-east-1:123456789012:secret:db"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	rds  dbproxy.Client
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type proxyModifier func(*v1alpha1.DBProxy)
+
+func withConditions(c ...runtimev1alpha1.Condition) proxyModifier {
+	return func(r *v1alpha1.DBProxy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s string) proxyModifier {
+	return func(r *v1alpha1.DBProxy) {
+		r.Status.AtProvider = v1alpha1.DBProxyObservation{ARN: proxyARN, Status: s, Endpoint: endpoint}
+	}
+}
+
+func withSpec(p v1alpha1.DBProxyParameters) proxyModifier {
+	return func(r *v1alpha1.DBProxy) { r.Spec.ForProvider = p }
+}
+
+func proxy(m ...proxyModifier) *v1alpha1.DBProxy {
+	cr := &v1alpha1.DBProxy{}
+	meta.SetExternalName(cr, proxyName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params() v1alpha1.DBProxyParameters {
+	return v1alpha1.DBProxyParameters{
+		EngineFamily: "POSTGRESQL",
+		Auth:         []v1alpha1.UserAuthConfig{{SecretARN: secretARN}},
+	}
+}
+
+// lateInitialized are the parameters after observed() is late initialized.
+func lateInitialized() v1alpha1.DBProxyParameters {
+	p := params()
+	p.IdleClientTimeout = aws.Int64(1800)
+	p.RequireTLS = aws.Bool(true)
+	return p
+}
+
+func observed(status awsrds.DBProxyStatus) awsrds.DBProxy {
+	return awsrds.DBProxy{
+		DBProxyName:       aws.String(proxyName),
+		DBProxyArn:        aws.String(proxyARN),
+		Status:            status,
+		Endpoint:          aws.String(endpoint),
+		Auth:              []awsrds.UserAuthConfigInfo{{SecretArn: aws.String(secretARN), AuthScheme: awsrds.AuthSchemeSecrets}},
+		IdleClientTimeout: aws.Int64(1800),
+		RequireTLS:        aws.Bool(true),
+	}
+}
+
+func describe(status awsrds.DBProxyStatus) func(*awsrds.DescribeDBProxiesInput) awsrds.DescribeDBProxiesRequest {
+	return func(*awsrds.DescribeDBProxiesInput) awsrds.DescribeDBProxiesRequest {
+		return awsrds.DescribeDBProxiesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBProxiesOutput{
+				DBProxies: []awsrds.DBProxy{observed(status)},
+			}},
+		}
+	}
+}
+
+func noTags(*awsrds.ListTagsForResourceInput) awsrds.ListTagsForResourceRequest {
+	return awsrds.ListTagsForResourceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ListTagsForResourceOutput{}},
+	}
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				rds: &fake.MockDBProxyClient{
+					MockDescribeDBProxies:   describe(awsrds.DBProxyStatusAvailable),
+					MockListTagsForResource: noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   proxy(withSpec(params())),
+			},
+			want: want{
+				cr: proxy(withSpec(lateInitialized()), withStatus(v1alpha1.DBProxyStateAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"NeedsModification": {
+			args: args{
+				rds: &fake.MockDBProxyClient{
+					MockDescribeDBProxies:   describe(awsrds.DBProxyStatusAvailable),
+					MockListTagsForResource: noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr: proxy(withSpec(v1alpha1.DBProxyParameters{
+					EngineFamily: "POSTGRESQL",
+					Auth:         []v1alpha1.UserAuthConfig{{SecretARN: secretARN}},
+					RequireTLS:   aws.Bool(false),
+				})),
+			},
+			want: want{
+				cr: proxy(withSpec(v1alpha1.DBProxyParameters{
+					EngineFamily:      "POSTGRESQL",
+					Auth:              []v1alpha1.UserAuthConfig{{SecretARN: secretARN}},
+					IdleClientTimeout: aws.Int64(1800),
+					RequireTLS:        aws.Bool(false),
+				}), withStatus(v1alpha1.DBProxyStateAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				rds: &fake.MockDBProxyClient{
+					MockDescribeDBProxies:   describe(awsrds.DBProxyStatusCreating),
+					MockListTagsForResource: noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   proxy(withSpec(params())),
+			},
+			want: want{
+				cr: proxy(withSpec(lateInitialized()), withStatus(v1alpha1.DBProxyStateCreating), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				rds: &fake.MockDBProxyClient{
+					MockDescribeDBProxies: func(*awsrds.DescribeDBProxiesInput) awsrds.DescribeDBProxiesRequest {
+						return awsrds.DescribeDBProxiesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsrds.ErrCodeDBProxyNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: proxy(),
+			},
+			want: want{
+				cr: proxy(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockDBProxyClient{
+					MockDescribeDBProxies: func(*awsrds.DescribeDBProxiesInput) awsrds.DescribeDBProxiesRequest {
+						return awsrds.DescribeDBProxiesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: proxy(),
+			},
+			want: want{
+				cr:  proxy(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBProxyClient{
+					MockCreateDBProxy: func(in *awsrds.CreateDBProxyInput) awsrds.CreateDBProxyRequest {
+						if diff := cmp.Diff(awsrds.EngineFamilyPostgresql, in.EngineFamily); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.CreateDBProxyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBProxyOutput{}},
+						}
+					},
+				},
+				cr: proxy(withSpec(params())),
+			},
+			want: want{
+				cr: proxy(withSpec(params()), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockDBProxyClient{
+					MockCreateDBProxy: func(*awsrds.CreateDBProxyInput) awsrds.CreateDBProxyRequest {
+						return awsrds.CreateDBProxyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: proxy(),
+			},
+			want: want{
+				cr:  proxy(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBProxyClient{
+					MockListTagsForResource: noTags,
+					MockDescribeDBProxies:   describe(awsrds.DBProxyStatusAvailable),
+					MockModifyDBProxy: func(in *awsrds.ModifyDBProxyInput) awsrds.ModifyDBProxyRequest {
+						if diff := cmp.Diff(aws.Bool(false), in.RequireTLS); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.ModifyDBProxyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBProxyOutput{}},
+						}
+					},
+				},
+				cr: proxy(withSpec(v1alpha1.DBProxyParameters{RequireTLS: aws.Bool(false)}), withStatus(v1alpha1.DBProxyStateAvailable)),
+			},
+		},
+		"NotAvailable": {
+			args: args{
+				cr: proxy(withStatus(v1alpha1.DBProxyStateModifying)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockDBProxyClient{
+					MockListTagsForResource: noTags,
+					MockDescribeDBProxies:   describe(awsrds.DBProxyStatusAvailable),
+					MockModifyDBProxy: func(*awsrds.ModifyDBProxyInput) awsrds.ModifyDBProxyRequest {
+						return awsrds.ModifyDBProxyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: proxy(withSpec(v1alpha1.DBProxyParameters{RequireTLS: aws.Bool(false)}), withStatus(v1alpha1.DBProxyStateAvailable)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBProxyClient{
+					MockDeleteDBProxy: func(*awsrds.DeleteDBProxyInput) awsrds.DeleteDBProxyRequest {
+						return awsrds.DeleteDBProxyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteDBProxyOutput{}},
+						}
+					},
+				},
+				cr: proxy(),
+			},
+			want: want{
+				cr: proxy(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: proxy(withStatus(v1alpha1.DBProxyStateDeleting)),
+			},
+			want: want{
+				cr: proxy(withStatus(v1alpha1.DBProxyStateDeleting), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				rds: &fake.MockDBProxyClient{
+					MockDeleteDBProxy: func(*awsrds.DeleteDBProxyInput) awsrds.DeleteDBProxyRequest {
+						return awsrds.DeleteDBProxyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsrds.ErrCodeDBProxyNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: proxy(),
+			},
+			want: want{
+				cr: proxy(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockDBProxyClient{
+					MockDeleteDBProxy: func(*awsrds.DeleteDBProxyInput) awsrds.DeleteDBProxyRequest {
+						return awsrds.DeleteDBProxyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: proxy(),
+			},
+			want: want{
+				cr:  proxy(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbproxytargetgroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dbproxytargetgroup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an RDS DBProxyTargetGroup resource"
+
+	errDescribe        = "failed to describe the DBProxyTargetGroup resource"
+	errNotOne          = "expected exactly one DBProxyTargetGroup"
+	errNoTargetGroup   = "the target group does not exist, it is created together with its DB proxy"
+	errDescribeTargets = "failed to describe the targets of the DBProxyTargetGroup resource"
+	errModify          = "failed to modify the DBProxyTargetGroup resource"
+	errRegister        = "failed to register targets with the DBProxyTargetGroup resource"
+	errDeregister      = "failed to deregister targets from the DBProxyTargetGroup resource"
+)
+
+// SetupDBProxyTargetGroup adds a controller that reconciles
+// DBProxyTargetGroups.
+func SetupDBProxyTargetGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DBProxyTargetGroupGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.DBProxyTargetGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DBProxyTargetGroupGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: dbproxytargetgroup.NewClient}, awsclients.DeletionTierAttachment), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) dbproxytargetgroup.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DBProxyTargetGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client dbproxytargetgroup.Client
+}
+
+// targetGroupName returns the external name of the DBProxyTargetGroup, or
+// the name of the default target group of its DB proxy if it is not set.
+func targetGroupName(cr *v1alpha1.DBProxyTargetGroup) *string {
+	if n := meta.GetExternalName(cr); n != "" {
+		return aws.String(n)
+	}
+	return aws.String(v1alpha1.DefaultDBProxyTargetGroupName)
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.DBProxyTargetGroup) (*awsrds.DBProxyTargetGroup, error) {
+	rsp, err := e.client.DescribeDBProxyTargetGroupsRequest(&awsrds.DescribeDBProxyTargetGroupsInput{
+		DBProxyName:     cr.Spec.ForProvider.DBProxyName,
+		TargetGroupName: targetGroupName(cr),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp.TargetGroups) != 1 {
+		return nil, errors.New(errNotOne)
+	}
+	return &rsp.TargetGroups[0], nil
+}
+
+func (e *external) targets(ctx context.Context, cr *v1alpha1.DBProxyTargetGroup) ([]awsrds.DBProxyTarget, error) {
+	var res []awsrds.DBProxyTarget
+	in := &awsrds.DescribeDBProxyTargetsInput{
+		DBProxyName:     cr.Spec.ForProvider.DBProxyName,
+		TargetGroupName: targetGroupName(cr),
+	}
+	for {
+		rsp, err := e.client.DescribeDBProxyTargetsRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, rsp.Targets...)
+		if rsp.Marker == nil {
+			return res, nil
+		}
+		in.Marker = rsp.Marker
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.DBProxyTargetGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(dbproxytargetgroup.IsNotFound, err), errDescribe)
+	}
+	cr.Status.AtProvider = dbproxytargetgroup.GenerateObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	targets, err := e.targets(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeTargets)
+	}
+	register, deregister := dbproxytargetgroup.DiffTargets(cr.Spec.ForProvider, targets)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: register.Empty() && deregister.Empty() &&
+			dbproxytargetgroup.IsConnectionPoolUpToDate(cr.Spec.ForProvider.ConnectionPoolConfig, observed.ConnectionPoolConfig),
+	}, nil
+}
+
+// Create fails, since target groups can't be created. RDS creates the
+// default target group together with its DB proxy, after which the target
+// group is observed and updated.
+func (e *external) Create(_ context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	if _, ok := mgd.(*v1alpha1.DBProxyTargetGroup); !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalCreation{}, errors.New(errNoTargetGroup)
+}
+
+// Update updates the connection pool configuration of the target group, and
+// registers and deregisters its targets to match the desired ones.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.DBProxyTargetGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if !dbproxytargetgroup.IsConnectionPoolUpToDate(cr.Spec.ForProvider.ConnectionPoolConfig, observed.ConnectionPoolConfig) {
+		if _, err := e.client.ModifyDBProxyTargetGroupRequest(&awsrds.ModifyDBProxyTargetGroupInput{
+			DBProxyName:          cr.Spec.ForProvider.DBProxyName,
+			TargetGroupName:      targetGroupName(cr),
+			ConnectionPoolConfig: dbproxytargetgroup.GenerateConnectionPoolConfiguration(cr.Spec.ForProvider.ConnectionPoolConfig),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+		}
+	}
+
+	targets, err := e.targets(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeTargets)
+	}
+	register, deregister := dbproxytargetgroup.DiffTargets(cr.Spec.ForProvider, targets)
+	if !deregister.Empty() {
+		if err := e.deregister(ctx, cr, deregister); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	if register.Empty() {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.RegisterDBProxyTargetsRequest(&awsrds.RegisterDBProxyTargetsInput{
+		DBProxyName:           cr.Spec.ForProvider.DBProxyName,
+		TargetGroupName:       targetGroupName(cr),
+		DBInstanceIdentifiers: register.DBInstanceIdentifiers,
+		DBClusterIdentifiers:  register.DBClusterIdentifiers,
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errRegister)
+}
+
+// Delete deregisters all targets of the target group. The target group
+// itself is deleted together with its DB proxy.
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.DBProxyTargetGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	targets, err := e.targets(ctx, cr)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(dbproxytargetgroup.IsNotFound, err), errDescribeTargets)
+	}
+	deregister := dbproxytargetgroup.ObservedTargets(targets)
+	if deregister.Empty() {
+		return nil
+	}
+	return e.deregister(ctx, cr, deregister)
+}
+
+func (e *external) deregister(ctx context.Context, cr *v1alpha1.DBProxyTargetGroup, t dbproxytargetgroup.Targets) error {
+	_, err := e.client.DeregisterDBProxyTargetsRequest(&awsrds.DeregisterDBProxyTargetsInput{
+		DBProxyName:           cr.Spec.ForProvider.DBProxyName,
+		TargetGroupName:       targetGroupName(cr),
+		DBInstanceIdentifiers: t.DBInstanceIdentifiers,
+		DBClusterIdentifiers:  t.DBClusterIdentifiers,
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(dbproxytargetgroup.IsNotFound, err), errDeregister)
+}
@@ -0,0 +1,374 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbproxytargetgroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/dbproxytargetgroup"
+	"github.com/crossplane/provider-aws/pkg/clients/dbproxytargetgroup/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	proxyName      = "some-proxy"
+	targetGroupARN = "arn:aws:rds:us-east-1:123456789012:target-group:prx-tg-123"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	rds dbproxytargetgroup.Client
+	cr  resource.Managed
+}
+
+type targetGroupModifier func(*v1alpha1.DBProxyTargetGroup)
+
+func withConditions(c ...runtimev1alpha1.Condition) targetGroupModifier {
+	return func(r *v1alpha1.DBProxyTargetGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation() targetGroupModifier {
+	return func(r *v1alpha1.DBProxyTargetGroup) {
+		r.Status.AtProvider = v1alpha1.DBProxyTargetGroupObservation{TargetGroupARN: targetGroupARN, Status: "available", IsDefault: true}
+	}
+}
+
+func withInstances(ids ...string) targetGroupModifier {
+	return func(r *v1alpha1.DBProxyTargetGroup) { r.Spec.ForProvider.DBInstanceIdentifiers = ids }
+}
+
+func targetGroup(m ...targetGroupModifier) *v1alpha1.DBProxyTargetGroup {
+	cr := &v1alpha1.DBProxyTargetGroup{}
+	cr.Spec.ForProvider.DBProxyName = aws.String(proxyName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(in *awsrds.DescribeDBProxyTargetGroupsInput) awsrds.DescribeDBProxyTargetGroupsRequest {
+	return awsrds.DescribeDBProxyTargetGroupsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBProxyTargetGroupsOutput{
+			TargetGroups: []awsrds.DBProxyTargetGroup{{
+				DBProxyName:     in.DBProxyName,
+				TargetGroupName: in.TargetGroupName,
+				TargetGroupArn:  aws.String(targetGroupARN),
+				Status:          aws.String("available"),
+				IsDefault:       aws.Bool(true),
+			}},
+		}},
+	}
+}
+
+func targets(ids ...string) func(*awsrds.DescribeDBProxyTargetsInput) awsrds.DescribeDBProxyTargetsRequest {
+	return func(*awsrds.DescribeDBProxyTargetsInput) awsrds.DescribeDBProxyTargetsRequest {
+		out := &awsrds.DescribeDBProxyTargetsOutput{}
+		for _, id := range ids {
+			out.Targets = append(out.Targets, awsrds.DBProxyTarget{Type: awsrds.TargetTypeRdsInstance, RdsResourceId: aws.String(id)})
+		}
+		return awsrds.DescribeDBProxyTargetsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				rds: &fake.MockDBProxyTargetGroupClient{
+					MockDescribeDBProxyTargetGroups: func(in *awsrds.DescribeDBProxyTargetGroupsInput) awsrds.DescribeDBProxyTargetGroupsRequest {
+						if diff := cmp.Diff(v1alpha1.DefaultDBProxyTargetGroupName, aws.StringValue(in.TargetGroupName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return describe(in)
+					},
+					MockDescribeDBProxyTargets: targets("db-1"),
+				},
+				cr: targetGroup(withInstances("db-1")),
+			},
+			want: want{
+				cr:     targetGroup(withInstances("db-1"), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"TargetMissing": {
+			args: args{
+				rds: &fake.MockDBProxyTargetGroupClient{
+					MockDescribeDBProxyTargetGroups: describe,
+					MockDescribeDBProxyTargets:      targets(),
+				},
+				cr: targetGroup(withInstances("db-1")),
+			},
+			want: want{
+				cr:     targetGroup(withInstances("db-1"), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				rds: &fake.MockDBProxyTargetGroupClient{
+					MockDescribeDBProxyTargetGroups: func(*awsrds.DescribeDBProxyTargetGroupsInput) awsrds.DescribeDBProxyTargetGroupsRequest {
+						return awsrds.DescribeDBProxyTargetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsrds.ErrCodeDBProxyNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: targetGroup(),
+			},
+			want: want{
+				cr: targetGroup(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockDBProxyTargetGroupClient{
+					MockDescribeDBProxyTargetGroups: func(*awsrds.DescribeDBProxyTargetGroupsInput) awsrds.DescribeDBProxyTargetGroupsRequest {
+						return awsrds.DescribeDBProxyTargetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: targetGroup(),
+			},
+			want: want{
+				cr:  targetGroup(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	e := &external{}
+	_, err := e.Create(context.Background(), targetGroup())
+	if diff := cmp.Diff(errors.New(errNoTargetGroup), err, test.EquateErrors()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBProxyTargetGroupClient{
+					MockDescribeDBProxyTargetGroups: describe,
+					MockModifyDBProxyTargetGroup: func(in *awsrds.ModifyDBProxyTargetGroupInput) awsrds.ModifyDBProxyTargetGroupRequest {
+						if diff := cmp.Diff(aws.Int64(80), in.ConnectionPoolConfig.MaxConnectionsPercent); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.ModifyDBProxyTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBProxyTargetGroupOutput{}},
+						}
+					},
+					MockDescribeDBProxyTargets: targets("db-1"),
+					MockDeregisterDBProxyTargets: func(in *awsrds.DeregisterDBProxyTargetsInput) awsrds.DeregisterDBProxyTargetsRequest {
+						if diff := cmp.Diff([]string{"db-1"}, in.DBInstanceIdentifiers); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.DeregisterDBProxyTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeregisterDBProxyTargetsOutput{}},
+						}
+					},
+					MockRegisterDBProxyTargets: func(in *awsrds.RegisterDBProxyTargetsInput) awsrds.RegisterDBProxyTargetsRequest {
+						if diff := cmp.Diff([]string{"db-2"}, in.DBInstanceIdentifiers); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.RegisterDBProxyTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.RegisterDBProxyTargetsOutput{}},
+						}
+					},
+				},
+				cr: targetGroup(withInstances("db-2"), func(r *v1alpha1.DBProxyTargetGroup) {
+					r.Spec.ForProvider.ConnectionPoolConfig = &v1alpha1.ConnectionPoolConfiguration{MaxConnectionsPercent: aws.Int64(80)}
+				}),
+			},
+		},
+		"RegisterError": {
+			args: args{
+				rds: &fake.MockDBProxyTargetGroupClient{
+					MockDescribeDBProxyTargetGroups: describe,
+					MockDescribeDBProxyTargets:      targets(),
+					MockRegisterDBProxyTargets: func(*awsrds.RegisterDBProxyTargetsInput) awsrds.RegisterDBProxyTargetsRequest {
+						return awsrds.RegisterDBProxyTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: targetGroup(withInstances("db-1")),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errRegister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBProxyTargetGroupClient{
+					MockDescribeDBProxyTargets: targets("db-1"),
+					MockDeregisterDBProxyTargets: func(in *awsrds.DeregisterDBProxyTargetsInput) awsrds.DeregisterDBProxyTargetsRequest {
+						if diff := cmp.Diff([]string{"db-1"}, in.DBInstanceIdentifiers); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.DeregisterDBProxyTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeregisterDBProxyTargetsOutput{}},
+						}
+					},
+				},
+				cr: targetGroup(withInstances("db-1")),
+			},
+			want: want{
+				cr: targetGroup(withInstances("db-1"), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NoTargets": {
+			args: args{
+				rds: &fake.MockDBProxyTargetGroupClient{
+					MockDescribeDBProxyTargets: targets(),
+				},
+				cr: targetGroup(),
+			},
+			want: want{
+				cr: targetGroup(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ProxyDeleted": {
+			args: args{
+				rds: &fake.MockDBProxyTargetGroupClient{
+					MockDescribeDBProxyTargets: func(*awsrds.DescribeDBProxyTargetsInput) awsrds.DescribeDBProxyTargetsRequest {
+						return awsrds.DescribeDBProxyTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsrds.ErrCodeDBProxyNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: targetGroup(),
+			},
+			want: want{
+				cr: targetGroup(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockDBProxyTargetGroupClient{
+					MockDescribeDBProxyTargets: targets("db-1"),
+					MockDeregisterDBProxyTargets: func(*awsrds.DeregisterDBProxyTargetsInput) awsrds.DeregisterDBProxyTargetsRequest {
+						return awsrds.DeregisterDBProxyTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: targetGroup(),
+			},
+			want: want{
+				cr:  targetGroup(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDeregister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
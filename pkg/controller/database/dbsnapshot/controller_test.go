@@ -0,0 +1,395 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbsnapshot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/dbsnapshot"
+	"github.com/crossplane/provider-aws/pkg/clients/dbsnapshot/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	snapshotName = "some-snapshot"
+	snapshotARN  = "arn:aws:rds:us-east-1:123456789012:snapshot:some-snapshot"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	rds dbsnapshot.Client
+	cr  resource.Managed
+}
+
+type snapshotModifier func(*v1beta1.DBSnapshot)
+
+func withConditions(c ...runtimev1alpha1.Condition) snapshotModifier {
+	return func(r *v1beta1.DBSnapshot) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s string) snapshotModifier {
+	return func(r *v1beta1.DBSnapshot) {
+		r.Status.AtProvider.ARN = snapshotARN
+		r.Status.AtProvider.Status = s
+	}
+}
+
+func withTags(t ...v1beta1.Tag) snapshotModifier {
+	return func(r *v1beta1.DBSnapshot) { r.Spec.ForProvider.Tags = t }
+}
+
+func snapshot(m ...snapshotModifier) *v1beta1.DBSnapshot {
+	cr := &v1beta1.DBSnapshot{}
+	meta.SetExternalName(cr, snapshotName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(status string) func(*awsrds.DescribeDBSnapshotsInput) awsrds.DescribeDBSnapshotsRequest {
+	return func(*awsrds.DescribeDBSnapshotsInput) awsrds.DescribeDBSnapshotsRequest {
+		return awsrds.DescribeDBSnapshotsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBSnapshotsOutput{
+				DBSnapshots: []awsrds.DBSnapshot{{DBSnapshotIdentifier: aws.String(snapshotName), DBSnapshotArn: aws.String(snapshotARN), Status: aws.String(status)}},
+			}},
+		}
+	}
+}
+
+func noTags(*awsrds.ListTagsForResourceInput) awsrds.ListTagsForResourceRequest {
+	return awsrds.ListTagsForResourceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ListTagsForResourceOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				rds: &fake.MockDBSnapshotClient{
+					MockDescribeDBSnapshots: describe(v1beta1.DBSnapshotStateAvailable),
+					MockListTagsForResource: noTags,
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr:     snapshot(withStatus(v1beta1.DBSnapshotStateAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Creating": {
+			args: args{
+				rds: &fake.MockDBSnapshotClient{
+					MockDescribeDBSnapshots: describe(v1beta1.DBSnapshotStateCreating),
+					MockListTagsForResource: noTags,
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr:     snapshot(withStatus(v1beta1.DBSnapshotStateCreating), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"TagsMissing": {
+			args: args{
+				rds: &fake.MockDBSnapshotClient{
+					MockDescribeDBSnapshots: describe(v1beta1.DBSnapshotStateAvailable),
+					MockListTagsForResource: noTags,
+				},
+				cr: snapshot(withTags(v1beta1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr:     snapshot(withTags(v1beta1.Tag{Key: "k", Value: "v"}), withStatus(v1beta1.DBSnapshotStateAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				rds: &fake.MockDBSnapshotClient{
+					MockDescribeDBSnapshots: func(*awsrds.DescribeDBSnapshotsInput) awsrds.DescribeDBSnapshotsRequest {
+						return awsrds.DescribeDBSnapshotsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsrds.ErrCodeDBSnapshotNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr: snapshot(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockDBSnapshotClient{
+					MockDescribeDBSnapshots: func(*awsrds.DescribeDBSnapshotsInput) awsrds.DescribeDBSnapshotsRequest {
+						return awsrds.DescribeDBSnapshotsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr:  snapshot(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBSnapshotClient{
+					MockCreateDBSnapshot: func(*awsrds.CreateDBSnapshotInput) awsrds.CreateDBSnapshotRequest {
+						return awsrds.CreateDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBSnapshotOutput{}},
+						}
+					},
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr: snapshot(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockDBSnapshotClient{
+					MockCreateDBSnapshot: func(*awsrds.CreateDBSnapshotInput) awsrds.CreateDBSnapshotRequest {
+						return awsrds.CreateDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr:  snapshot(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBSnapshotClient{
+					MockListTagsForResource: noTags,
+					MockAddTagsToResource: func(in *awsrds.AddTagsToResourceInput) awsrds.AddTagsToResourceRequest {
+						if diff := cmp.Diff([]awsrds.Tag{{Key: aws.String("k"), Value: aws.String("v")}}, in.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.AddTagsToResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.AddTagsToResourceOutput{}},
+						}
+					},
+				},
+				cr: snapshot(withStatus(v1beta1.DBSnapshotStateAvailable), withTags(v1beta1.Tag{Key: "k", Value: "v"})),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockDBSnapshotClient{
+					MockListTagsForResource: noTags,
+					MockAddTagsToResource: func(*awsrds.AddTagsToResourceInput) awsrds.AddTagsToResourceRequest {
+						return awsrds.AddTagsToResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(withStatus(v1beta1.DBSnapshotStateAvailable), withTags(v1beta1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errAddTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBSnapshotClient{
+					MockDeleteDBSnapshot: func(*awsrds.DeleteDBSnapshotInput) awsrds.DeleteDBSnapshotRequest {
+						return awsrds.DeleteDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteDBSnapshotOutput{}},
+						}
+					},
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr: snapshot(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: snapshot(withStatus(v1beta1.DBSnapshotStateDeleting)),
+			},
+			want: want{
+				cr: snapshot(withStatus(v1beta1.DBSnapshotStateDeleting), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				rds: &fake.MockDBSnapshotClient{
+					MockDeleteDBSnapshot: func(*awsrds.DeleteDBSnapshotInput) awsrds.DeleteDBSnapshotRequest {
+						return awsrds.DeleteDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsrds.ErrCodeDBSnapshotNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr: snapshot(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockDBSnapshotClient{
+					MockDeleteDBSnapshot: func(*awsrds.DeleteDBSnapshotInput) awsrds.DeleteDBSnapshotRequest {
+						return awsrds.DeleteDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr:  snapshot(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/optiongroup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an OptionGroup resource"
+
+	errDescribe   = "failed to describe the OptionGroup resource"
+	errNotOne     = "expected exactly one OptionGroup"
+	errCreate     = "failed to create the OptionGroup resource"
+	errModify     = "failed to modify the options of the OptionGroup resource"
+	errDelete     = "failed to delete the OptionGroup resource"
+	errListTags   = "failed to list the tags of the OptionGroup resource"
+	errAddTags    = "failed to add tags to the OptionGroup resource"
+	errRemoveTags = "failed to remove tags from the OptionGroup resource"
+)
+
+// SetupOptionGroup adds a controller that reconciles OptionGroups.
+func SetupOptionGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.OptionGroupGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.OptionGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.OptionGroupGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: optiongroup.NewClient}, awsclients.DeletionTierAttachment), v1beta1.Group)))),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) optiongroup.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.OptionGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client optiongroup.Client
+}
+
+func (e *external) describe(ctx context.Context, cr *v1beta1.OptionGroup) (*awsrds.OptionGroup, error) {
+	rsp, err := e.client.DescribeOptionGroupsRequest(&awsrds.DescribeOptionGroupsInput{
+		OptionGroupName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp.OptionGroupsList) != 1 {
+		return nil, errors.New(errNotOne)
+	}
+	return &rsp.OptionGroupsList[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1beta1.OptionGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(optiongroup.IsNotFound, err), errDescribe)
+	}
+	cr.Status.AtProvider = optiongroup.GenerateObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	include, exclude := optiongroup.DiffOptions(cr.Spec.ForProvider.Options, observed.Options)
+	tags, err := e.client.ListTagsForResourceRequest(&awsrds.ListTagsForResourceInput{ResourceName: observed.OptionGroupArn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := optiongroup.DiffTags(cr.Spec.ForProvider.Tags, tags.TagList)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(include) == 0 && len(exclude) == 0 && len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+// Create creates the option group. Its options are added by the subsequent
+// update.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1beta1.OptionGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateOptionGroupRequest(optiongroup.GenerateCreateOptionGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+// Update updates the tags of the option group, and adds, changes and removes
+// its options to match the desired ones.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1beta1.OptionGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := aws.String(cr.Status.AtProvider.ARN)
+	tags, err := e.client.ListTagsForResourceRequest(&awsrds.ListTagsForResourceInput{ResourceName: arn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := optiongroup.DiffTags(cr.Spec.ForProvider.Tags, tags.TagList)
+	if len(remove) > 0 {
+		if _, err := e.client.RemoveTagsFromResourceRequest(&awsrds.RemoveTagsFromResourceInput{ResourceName: arn, TagKeys: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.AddTagsToResourceRequest(&awsrds.AddTagsToResourceInput{ResourceName: arn, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	include, exclude := optiongroup.DiffOptions(cr.Spec.ForProvider.Options, observed.Options)
+	if len(include) == 0 && len(exclude) == 0 {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.ModifyOptionGroupRequest(&awsrds.ModifyOptionGroupInput{
+		OptionGroupName:  aws.String(meta.GetExternalName(cr)),
+		OptionsToInclude: include,
+		OptionsToRemove:  exclude,
+		ApplyImmediately: cr.Spec.ForProvider.ApplyImmediately,
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.OptionGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteOptionGroupRequest(&awsrds.DeleteOptionGroupInput{
+		OptionGroupName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(optiongroup.IsNotFound, err), errDelete)
+}
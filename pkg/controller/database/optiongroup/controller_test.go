@@ -0,0 +1,376 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/optiongroup"
+	"github.com/crossplane/provider-aws/pkg/clients/optiongroup/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	groupName = "some-group"
+	groupARN  = "arn:aws:rds:us-east-1:123456789012:og:some-group"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	rds optiongroup.Client
+	cr  resource.Managed
+}
+
+type groupModifier func(*v1beta1.OptionGroup)
+
+func withConditions(c ...runtimev1alpha1.Condition) groupModifier {
+	return func(r *v1beta1.OptionGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withARN() groupModifier {
+	return func(r *v1beta1.OptionGroup) { r.Status.AtProvider.ARN = groupARN }
+}
+
+func withOptions(o ...v1beta1.OptionConfiguration) groupModifier {
+	return func(r *v1beta1.OptionGroup) { r.Spec.ForProvider.Options = o }
+}
+
+func group(m ...groupModifier) *v1beta1.OptionGroup {
+	cr := &v1beta1.OptionGroup{}
+	meta.SetExternalName(cr, groupName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(o ...awsrds.Option) func(*awsrds.DescribeOptionGroupsInput) awsrds.DescribeOptionGroupsRequest {
+	return func(*awsrds.DescribeOptionGroupsInput) awsrds.DescribeOptionGroupsRequest {
+		return awsrds.DescribeOptionGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeOptionGroupsOutput{
+				OptionGroupsList: []awsrds.OptionGroup{{OptionGroupName: aws.String(groupName), OptionGroupArn: aws.String(groupARN), Options: o}},
+			}},
+		}
+	}
+}
+
+func noTags(*awsrds.ListTagsForResourceInput) awsrds.ListTagsForResourceRequest {
+	return awsrds.ListTagsForResourceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ListTagsForResourceOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				rds: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroups: describe(awsrds.Option{OptionName: aws.String("MEMCACHED")}),
+					MockListTagsForResource:  noTags,
+				},
+				cr: group(withOptions(v1beta1.OptionConfiguration{OptionName: "MEMCACHED"})),
+			},
+			want: want{
+				cr:     group(withOptions(v1beta1.OptionConfiguration{OptionName: "MEMCACHED"}), withARN(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"OptionMissing": {
+			args: args{
+				rds: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroups: describe(),
+					MockListTagsForResource:  noTags,
+				},
+				cr: group(withOptions(v1beta1.OptionConfiguration{OptionName: "MEMCACHED"})),
+			},
+			want: want{
+				cr:     group(withOptions(v1beta1.OptionConfiguration{OptionName: "MEMCACHED"}), withARN(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				rds: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroups: func(*awsrds.DescribeOptionGroupsInput) awsrds.DescribeOptionGroupsRequest {
+						return awsrds.DescribeOptionGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsrds.ErrCodeOptionGroupNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroups: func(*awsrds.DescribeOptionGroupsInput) awsrds.DescribeOptionGroupsRequest {
+						return awsrds.DescribeOptionGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockOptionGroupClient{
+					MockCreateOptionGroup: func(*awsrds.CreateOptionGroupInput) awsrds.CreateOptionGroupRequest {
+						return awsrds.CreateOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateOptionGroupOutput{}},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockOptionGroupClient{
+					MockCreateOptionGroup: func(*awsrds.CreateOptionGroupInput) awsrds.CreateOptionGroupRequest {
+						return awsrds.CreateOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockOptionGroupClient{
+					MockListTagsForResource:  noTags,
+					MockDescribeOptionGroups: describe(awsrds.Option{OptionName: aws.String("MEMCACHED")}),
+					MockModifyOptionGroup: func(in *awsrds.ModifyOptionGroupInput) awsrds.ModifyOptionGroupRequest {
+						if diff := cmp.Diff([]awsrds.OptionConfiguration{{OptionName: aws.String("MARIADB_AUDIT_PLUGIN")}}, in.OptionsToInclude); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff([]string{"MEMCACHED"}, in.OptionsToRemove); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.ModifyOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyOptionGroupOutput{}},
+						}
+					},
+				},
+				cr: group(withARN(), withOptions(v1beta1.OptionConfiguration{OptionName: "MARIADB_AUDIT_PLUGIN"})),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockOptionGroupClient{
+					MockListTagsForResource:  noTags,
+					MockDescribeOptionGroups: describe(),
+					MockModifyOptionGroup: func(*awsrds.ModifyOptionGroupInput) awsrds.ModifyOptionGroupRequest {
+						return awsrds.ModifyOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(withARN(), withOptions(v1beta1.OptionConfiguration{OptionName: "MEMCACHED"})),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockOptionGroupClient{
+					MockDeleteOptionGroup: func(*awsrds.DeleteOptionGroupInput) awsrds.DeleteOptionGroupRequest {
+						return awsrds.DeleteOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteOptionGroupOutput{}},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				rds: &fake.MockOptionGroupClient{
+					MockDeleteOptionGroup: func(*awsrds.DeleteOptionGroupInput) awsrds.DeleteOptionGroupRequest {
+						return awsrds.DeleteOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsrds.ErrCodeOptionGroupNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				rds: &fake.MockOptionGroupClient{
+					MockDeleteOptionGroup: func(*awsrds.DeleteOptionGroupInput) awsrds.DeleteOptionGroupRequest {
+						return awsrds.DeleteOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.rds}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
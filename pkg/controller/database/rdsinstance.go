@@ -44,6 +44,8 @@ const (
 	errNotRDSInstance          = "managed resource is not an RDS instance custom resource"
 	errKubeUpdateFailed        = "cannot update RDS instance custom resource"
 	errCreateFailed            = "cannot create RDS instance"
+	errRestoreFailed           = "cannot restore RDS instance"
+	errRestoreSource           = "exactly one of snapshot and pointInTime must be set in restoreFrom"
 	errModifyFailed            = "cannot modify RDS instance"
 	errAddTagsFailed           = "cannot add tags to RDS instance"
 	errDeleteFailed            = "cannot delete RDS instance"
@@ -62,8 +64,8 @@ func SetupRDSInstance(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1beta1.RDSInstance{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RDSInstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: rds.NewClient}),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithMaintenanceWindow(awsclients.WithCreateGracePeriod(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: rds.NewClient}, awsclients.DeletionTierWorkload)), mgr.GetClient()))),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient()), awsclients.NewMaintenanceWindowInitializer(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -133,6 +135,15 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
+	if !upToDate {
+		disruptive, err := rds.IsUpdateDisruptive(cr, instance)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
+		}
+		if disruptive {
+			awsclients.MarkUpdateDisruptive(ctx)
+		}
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
@@ -150,6 +161,9 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.DBInstanceStatus == v1beta1.RDSInstanceStateCreating {
 		return managed.ExternalCreation{}, nil
 	}
+	if cr.Spec.ForProvider.RestoreFrom != nil {
+		return e.restore(ctx, cr)
+	}
 	pw, _, err := rds.GetPassword(ctx, e.kube, cr)
 	if err != nil {
 		return managed.ExternalCreation{}, err
@@ -175,6 +189,33 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalCreation{ConnectionDetails: conn}, nil
 }
 
+// restore creates the DB instance from exactly one of a DB snapshot or a
+// point in time of another DB instance. The restored instance keeps the master
+// password of its source, so only the user is published here; a referenced
+// password is applied by the next update and no password is published
+// without one.
+func (e *external) restore(ctx context.Context, cr *v1beta1.RDSInstance) (managed.ExternalCreation, error) {
+	r := cr.Spec.ForProvider.RestoreFrom
+	if (r.Snapshot == nil) == (r.PointInTime == nil) {
+		return managed.ExternalCreation{}, errors.New(errRestoreSource)
+	}
+	var err error
+	if r.Snapshot != nil {
+		_, err = e.client.RestoreDBInstanceFromDBSnapshotRequest(rds.GenerateRestoreDBInstanceFromDBSnapshotInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)).Send(ctx)
+	} else {
+		_, err = e.client.RestoreDBInstanceToPointInTimeRequest(rds.GenerateRestoreDBInstanceToPointInTimeInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)).Send(ctx)
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errRestoreFailed)
+	}
+	if cr.Spec.ForProvider.MasterUsername == nil {
+		return managed.ExternalCreation{}, nil
+	}
+	return managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretUserKey: []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername)),
+	}}, nil
+}
+
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
 	cr, ok := mg.(*v1beta1.RDSInstance)
 	if !ok {
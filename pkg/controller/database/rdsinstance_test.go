@@ -92,6 +92,10 @@ func withPasswordSecretRef(s runtimev1alpha1.SecretKeySelector) rdsModifier {
 	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.MasterPasswordSecretRef = &s }
 }
 
+func withRestoreFrom(r v1beta1.RestoreFrom) rdsModifier {
+	return func(cr *v1beta1.RDSInstance) { cr.Spec.ForProvider.RestoreFrom = &r }
+}
+
 func instance(m ...rdsModifier) *v1beta1.RDSInstance {
 	cr := &v1beta1.RDSInstance{}
 	for _, f := range m {
@@ -312,6 +316,15 @@ func TestObserve(t *testing.T) {
 }
 
 func TestCreate(t *testing.T) {
+	snapshotRestore := v1beta1.RestoreFrom{
+		Snapshot: &v1beta1.SnapshotRestore{DBSnapshotIdentifier: aws.String("snapshot")},
+	}
+	pointInTimeRestore := v1beta1.RestoreFrom{
+		PointInTime: &v1beta1.PointInTimeRestore{
+			SourceDBInstanceIdentifier: aws.String("source"),
+			UseLatestRestorableTime:    aws.Bool(true),
+		},
+	}
 	type want struct {
 		cr     *v1beta1.RDSInstance
 		result managed.ExternalCreation
@@ -442,6 +455,88 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreateFailed),
 			},
 		},
+		"SuccessfulRestoreFromSnapshot": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockRestoreFromSnapshot: func(input *awsrds.RestoreDBInstanceFromDBSnapshotInput) awsrds.RestoreDBInstanceFromDBSnapshotRequest {
+						return awsrds.RestoreDBInstanceFromDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.RestoreDBInstanceFromDBSnapshotOutput{}},
+						}
+					},
+				},
+				cr: instance(withMasterUsername(&masterUsername), withRestoreFrom(snapshotRestore)),
+			},
+			want: want{
+				cr: instance(
+					withMasterUsername(&masterUsername),
+					withRestoreFrom(snapshotRestore),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretUserKey: []byte(masterUsername),
+					},
+				},
+			},
+		},
+		"SuccessfulRestoreToPointInTime": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockRestoreToPointInTime: func(input *awsrds.RestoreDBInstanceToPointInTimeInput) awsrds.RestoreDBInstanceToPointInTimeRequest {
+						return awsrds.RestoreDBInstanceToPointInTimeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.RestoreDBInstanceToPointInTimeOutput{}},
+						}
+					},
+				},
+				cr: instance(withRestoreFrom(pointInTimeRestore)),
+			},
+			want: want{
+				cr: instance(
+					withRestoreFrom(pointInTimeRestore),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRestore": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockRestoreFromSnapshot: func(input *awsrds.RestoreDBInstanceFromDBSnapshotInput) awsrds.RestoreDBInstanceFromDBSnapshotRequest {
+						return awsrds.RestoreDBInstanceFromDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withRestoreFrom(snapshotRestore)),
+			},
+			want: want{
+				cr: instance(
+					withRestoreFrom(snapshotRestore),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errRestoreFailed),
+			},
+		},
+		"RestoreFromNothing": {
+			args: args{
+				rds: &fake.MockRDSClient{},
+				cr:  instance(withRestoreFrom(v1beta1.RestoreFrom{})),
+			},
+			want: want{
+				cr: instance(
+					withRestoreFrom(v1beta1.RestoreFrom{}),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.New(errRestoreSource),
+			},
+		},
+		"RestoreFromSnapshotAndPointInTime": {
+			args: args{
+				rds: &fake.MockRDSClient{},
+				cr:  instance(withRestoreFrom(v1beta1.RestoreFrom{Snapshot: snapshotRestore.Snapshot, PointInTime: pointInTimeRestore.PointInTime})),
+			},
+			want: want{
+				cr: instance(
+					withRestoreFrom(v1beta1.RestoreFrom{Snapshot: snapshotRestore.Snapshot, PointInTime: pointInTimeRestore.PointInTime}),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.New(errRestoreSource),
+			},
+		},
 	}
 
 	for name, tc := range cases {
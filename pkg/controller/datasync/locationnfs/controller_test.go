@@ -0,0 +1,513 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locationnfs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsdatasync "github.com/aws/aws-sdk-go-v2/service/datasync"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/datasync"
+	"github.com/crossplane/provider-aws/pkg/clients/datasync/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	locationARN = "arn:aws:datasync:us-east-1:123456789012:location/loc-0123456789abcdef0"
+	locationURI = "nfs://nfs.example.com/exports/data/"
+	agentARN    = "arn:aws:datasync:us-east-1:123456789012:agent/agent-0123456789abcdef0"
+	version     = "NFS4_1"
+	uid         = types.UID("some-uid")
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsdatasync.ErrCodeInvalidRequestException, "Location loc-0123456789abcdef0 is not found.", nil)
+)
+
+type args struct {
+	datasync datasync.LocationNFSClient
+	kube     *test.MockClient
+	cr       resource.Managed
+}
+
+type locationModifier func(*v1alpha1.LocationNFS)
+
+func withConditions(c ...runtimev1alpha1.Condition) locationModifier {
+	return func(r *v1alpha1.LocationNFS) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) locationModifier {
+	return func(r *v1alpha1.LocationNFS) { meta.SetExternalName(r, n) }
+}
+
+func withLocationURI(u string) locationModifier {
+	return func(r *v1alpha1.LocationNFS) { r.Status.AtProvider.LocationURI = u }
+}
+
+func withVersion(v *string) locationModifier {
+	return func(r *v1alpha1.LocationNFS) { r.Spec.ForProvider.Version = v }
+}
+
+func withTags(tags ...v1alpha1.Tag) locationModifier {
+	return func(r *v1alpha1.LocationNFS) { r.Spec.ForProvider.Tags = tags }
+}
+
+func location(m ...locationModifier) *v1alpha1.LocationNFS {
+	cr := &v1alpha1.LocationNFS{
+		Spec: v1alpha1.LocationNFSSpec{
+			ForProvider: v1alpha1.LocationNFSParameters{
+				ServerHostname: "nfs.example.com",
+				Subdirectory:   "/exports/data",
+				AgentARNs:      []string{agentARN},
+				Version:        aws.String(version),
+			},
+		},
+	}
+	cr.SetUID(uid)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(*awsdatasync.DescribeLocationNfsInput) awsdatasync.DescribeLocationNfsRequest {
+	return awsdatasync.DescribeLocationNfsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.DescribeLocationNfsOutput{
+			LocationArn:  aws.String(locationARN),
+			LocationUri:  aws.String(locationURI),
+			OnPremConfig: &awsdatasync.OnPremConfig{AgentArns: []string{agentARN}},
+			MountOptions: &awsdatasync.NfsMountOptions{Version: awsdatasync.NfsVersionNfs41},
+		}},
+	}
+}
+
+func listTags(tags ...awsdatasync.TagListEntry) func(*awsdatasync.ListTagsForResourceInput) awsdatasync.ListTagsForResourceRequest {
+	return func(*awsdatasync.ListTagsForResourceInput) awsdatasync.ListTagsForResourceRequest {
+		return awsdatasync.ListTagsForResourceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.ListTagsForResourceOutput{Tags: tags}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				datasync: &fake.MockLocationNFSClient{
+					MockDescribeLocationNfs: describe,
+					MockListTagsForResource: listTags(),
+				},
+				cr: location(withExternalName(locationARN)),
+			},
+			want: want{
+				cr: location(withExternalName(locationARN), withLocationURI(locationURI),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				datasync: &fake.MockLocationNFSClient{
+					MockDescribeLocationNfs: describe,
+					MockListTagsForResource: listTags(),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   location(withExternalName(locationARN), withVersion(nil)),
+			},
+			want: want{
+				cr: location(withExternalName(locationARN), withLocationURI(locationURI),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsNotUpToDate": {
+			args: args{
+				datasync: &fake.MockLocationNFSClient{
+					MockDescribeLocationNfs: describe,
+					MockListTagsForResource: listTags(),
+				},
+				cr: location(withExternalName(locationARN), withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: location(withExternalName(locationARN), withTags(v1alpha1.Tag{Key: "k", Value: "v"}),
+					withLocationURI(locationURI), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotCreatedYet": {
+			args: args{
+				cr: location(),
+			},
+			want: want{
+				cr: location(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				datasync: &fake.MockLocationNFSClient{
+					MockDescribeLocationNfs: func(*awsdatasync.DescribeLocationNfsInput) awsdatasync.DescribeLocationNfsRequest {
+						return awsdatasync.DescribeLocationNfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: location(withExternalName(locationARN)),
+			},
+			want: want{
+				cr: location(withExternalName(locationARN)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				datasync: &fake.MockLocationNFSClient{
+					MockDescribeLocationNfs: func(*awsdatasync.DescribeLocationNfsInput) awsdatasync.DescribeLocationNfsRequest {
+						return awsdatasync.DescribeLocationNfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: location(withExternalName(locationARN)),
+			},
+			want: want{
+				cr:  location(withExternalName(locationARN)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"ListTagsError": {
+			args: args{
+				datasync: &fake.MockLocationNFSClient{
+					MockDescribeLocationNfs: describe,
+					MockListTagsForResource: func(*awsdatasync.ListTagsForResourceInput) awsdatasync.ListTagsForResourceRequest {
+						return awsdatasync.ListTagsForResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: location(withExternalName(locationARN)),
+			},
+			want: want{
+				cr: location(withExternalName(locationARN), withLocationURI(locationURI),
+					withConditions(runtimev1alpha1.Available())),
+				err: errors.Wrap(errBoom, errListTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.datasync, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				datasync: &fake.MockLocationNFSClient{
+					MockCreateLocationNfs: func(*awsdatasync.CreateLocationNfsInput) awsdatasync.CreateLocationNfsRequest {
+						return awsdatasync.CreateLocationNfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.CreateLocationNfsOutput{
+								LocationArn: aws.String(locationARN),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   location(),
+			},
+			want: want{
+				cr: location(withExternalName(locationARN), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				datasync: &fake.MockLocationNFSClient{
+					MockCreateLocationNfs: func(*awsdatasync.CreateLocationNfsInput) awsdatasync.CreateLocationNfsRequest {
+						return awsdatasync.CreateLocationNfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: location(),
+			},
+			want: want{
+				cr:  location(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"SpecUpdateError": {
+			args: args{
+				datasync: &fake.MockLocationNFSClient{
+					MockCreateLocationNfs: func(*awsdatasync.CreateLocationNfsInput) awsdatasync.CreateLocationNfsRequest {
+						return awsdatasync.CreateLocationNfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.CreateLocationNfsOutput{
+								LocationArn: aws.String(locationARN),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   location(),
+			},
+			want: want{
+				cr:  location(withExternalName(locationARN), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.datasync, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AddAndRemoveTags": {
+			args: args{
+				datasync: &fake.MockLocationNFSClient{
+					MockListTagsForResource: listTags(awsdatasync.TagListEntry{Key: aws.String("old"), Value: aws.String("v")}),
+					MockUntagResource: func(input *awsdatasync.UntagResourceInput) awsdatasync.UntagResourceRequest {
+						if diff := cmp.Diff([]string{"old"}, input.Keys); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdatasync.UntagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.UntagResourceOutput{}},
+						}
+					},
+					MockTagResource: func(input *awsdatasync.TagResourceInput) awsdatasync.TagResourceRequest {
+						if diff := cmp.Diff([]awsdatasync.TagListEntry{{Key: aws.String("new"), Value: aws.String("v")}}, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdatasync.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.TagResourceOutput{}},
+						}
+					},
+				},
+				cr: location(withExternalName(locationARN), withTags(v1alpha1.Tag{Key: "new", Value: "v"})),
+			},
+			want: want{
+				cr: location(withExternalName(locationARN), withTags(v1alpha1.Tag{Key: "new", Value: "v"})),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"TagError": {
+			args: args{
+				datasync: &fake.MockLocationNFSClient{
+					MockListTagsForResource: listTags(),
+					MockTagResource: func(*awsdatasync.TagResourceInput) awsdatasync.TagResourceRequest {
+						return awsdatasync.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: location(withExternalName(locationARN), withTags(v1alpha1.Tag{Key: "new", Value: "v"})),
+			},
+			want: want{
+				cr:  location(withExternalName(locationARN), withTags(v1alpha1.Tag{Key: "new", Value: "v"})),
+				err: errors.Wrap(errBoom, errAddTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.datasync, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				datasync: &fake.MockLocationNFSClient{
+					MockDeleteLocation: func(*awsdatasync.DeleteLocationInput) awsdatasync.DeleteLocationRequest {
+						return awsdatasync.DeleteLocationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.DeleteLocationOutput{}},
+						}
+					},
+				},
+				cr: location(withExternalName(locationARN)),
+			},
+			want: want{
+				cr: location(withExternalName(locationARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				datasync: &fake.MockLocationNFSClient{
+					MockDeleteLocation: func(*awsdatasync.DeleteLocationInput) awsdatasync.DeleteLocationRequest {
+						return awsdatasync.DeleteLocationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: location(withExternalName(locationARN)),
+			},
+			want: want{
+				cr: location(withExternalName(locationARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				datasync: &fake.MockLocationNFSClient{
+					MockDeleteLocation: func(*awsdatasync.DeleteLocationInput) awsdatasync.DeleteLocationRequest {
+						return awsdatasync.DeleteLocationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: location(withExternalName(locationARN)),
+			},
+			want: want{
+				cr:  location(withExternalName(locationARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.datasync, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
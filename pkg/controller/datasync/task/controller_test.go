@@ -0,0 +1,543 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package task
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsdatasync "github.com/aws/aws-sdk-go-v2/service/datasync"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/datasync"
+	"github.com/crossplane/provider-aws/pkg/clients/datasync/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	taskARN        = "arn:aws:datasync:us-east-1:123456789012:task/task-0123456789abcdef0"
+	sourceARN      = "arn:aws:datasync:us-east-1:123456789012:location/loc-0123456789abcdef0"
+	destinationARN = "arn:aws:datasync:us-east-1:123456789012:location/loc-0123456789abcdef1"
+	taskName       = "backup"
+	schedule       = "rate(1 day)"
+	uid            = types.UID("some-uid")
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsdatasync.ErrCodeInvalidRequestException, "Task task-0123456789abcdef0 does not exist.", nil)
+)
+
+type args struct {
+	datasync datasync.TaskClient
+	kube     *test.MockClient
+	cr       resource.Managed
+}
+
+type taskModifier func(*v1alpha1.Task)
+
+func withConditions(c ...runtimev1alpha1.Condition) taskModifier {
+	return func(r *v1alpha1.Task) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) taskModifier {
+	return func(r *v1alpha1.Task) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s awsdatasync.TaskStatus) taskModifier {
+	return func(r *v1alpha1.Task) { r.Status.AtProvider.Status = string(s) }
+}
+
+func withName(n *string) taskModifier {
+	return func(r *v1alpha1.Task) { r.Spec.ForProvider.Name = n }
+}
+
+func withSchedule(s string) taskModifier {
+	return func(r *v1alpha1.Task) { r.Spec.ForProvider.ScheduleExpression = aws.String(s) }
+}
+
+func task(m ...taskModifier) *v1alpha1.Task {
+	cr := &v1alpha1.Task{
+		Spec: v1alpha1.TaskSpec{
+			ForProvider: v1alpha1.TaskParameters{
+				Name:                   aws.String(taskName),
+				SourceLocationARN:      aws.String(sourceARN),
+				DestinationLocationARN: aws.String(destinationARN),
+				ScheduleExpression:     aws.String(schedule),
+			},
+		},
+	}
+	cr.SetUID(uid)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(s awsdatasync.TaskStatus) func(*awsdatasync.DescribeTaskInput) awsdatasync.DescribeTaskRequest {
+	return func(*awsdatasync.DescribeTaskInput) awsdatasync.DescribeTaskRequest {
+		return awsdatasync.DescribeTaskRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.DescribeTaskOutput{
+				TaskArn:                aws.String(taskARN),
+				Name:                   aws.String(taskName),
+				SourceLocationArn:      aws.String(sourceARN),
+				DestinationLocationArn: aws.String(destinationARN),
+				Schedule:               &awsdatasync.TaskSchedule{ScheduleExpression: aws.String(schedule)},
+				Status:                 s,
+			}},
+		}
+	}
+}
+
+func listTags(tags ...awsdatasync.TagListEntry) func(*awsdatasync.ListTagsForResourceInput) awsdatasync.ListTagsForResourceRequest {
+	return func(*awsdatasync.ListTagsForResourceInput) awsdatasync.ListTagsForResourceRequest {
+		return awsdatasync.ListTagsForResourceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.ListTagsForResourceOutput{Tags: tags}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockDescribeTask:        describe(awsdatasync.TaskStatusAvailable),
+					MockListTagsForResource: listTags(),
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN), withStatus(awsdatasync.TaskStatusAvailable),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Running": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockDescribeTask:        describe(awsdatasync.TaskStatusRunning),
+					MockListTagsForResource: listTags(),
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN), withStatus(awsdatasync.TaskStatusRunning),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockDescribeTask:        describe(awsdatasync.TaskStatusCreating),
+					MockListTagsForResource: listTags(),
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN), withStatus(awsdatasync.TaskStatusCreating),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Unavailable": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockDescribeTask:        describe(awsdatasync.TaskStatusUnavailable),
+					MockListTagsForResource: listTags(),
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN), withStatus(awsdatasync.TaskStatusUnavailable),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockDescribeTask:        describe(awsdatasync.TaskStatusAvailable),
+					MockListTagsForResource: listTags(),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   task(withExternalName(taskARN), withName(nil)),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN), withStatus(awsdatasync.TaskStatusAvailable),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockDescribeTask:        describe(awsdatasync.TaskStatusAvailable),
+					MockListTagsForResource: listTags(),
+				},
+				cr: task(withExternalName(taskARN), withSchedule("rate(1 hour)")),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN), withSchedule("rate(1 hour)"), withStatus(awsdatasync.TaskStatusAvailable),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotCreatedYet": {
+			args: args{
+				cr: task(),
+			},
+			want: want{
+				cr: task(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockDescribeTask: func(*awsdatasync.DescribeTaskInput) awsdatasync.DescribeTaskRequest {
+						return awsdatasync.DescribeTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockDescribeTask: func(*awsdatasync.DescribeTaskInput) awsdatasync.DescribeTaskRequest {
+						return awsdatasync.DescribeTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr:  task(withExternalName(taskARN)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.datasync, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockCreateTask: func(*awsdatasync.CreateTaskInput) awsdatasync.CreateTaskRequest {
+						return awsdatasync.CreateTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.CreateTaskOutput{
+								TaskArn: aws.String(taskARN),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   task(),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockCreateTask: func(*awsdatasync.CreateTaskInput) awsdatasync.CreateTaskRequest {
+						return awsdatasync.CreateTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: task(),
+			},
+			want: want{
+				cr:  task(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.datasync, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateSchedule": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockListTagsForResource: listTags(),
+					MockDescribeTask:        describe(awsdatasync.TaskStatusAvailable),
+					MockUpdateTask: func(input *awsdatasync.UpdateTaskInput) awsdatasync.UpdateTaskRequest {
+						if diff := cmp.Diff(&awsdatasync.TaskSchedule{ScheduleExpression: aws.String("rate(1 hour)")}, input.Schedule); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdatasync.UpdateTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.UpdateTaskOutput{}},
+						}
+					},
+				},
+				cr: task(withExternalName(taskARN), withSchedule("rate(1 hour)")),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN), withSchedule("rate(1 hour)")),
+			},
+		},
+		"OnlyTags": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockListTagsForResource: listTags(awsdatasync.TagListEntry{Key: aws.String("k"), Value: aws.String("v")}),
+					MockUntagResource: func(*awsdatasync.UntagResourceInput) awsdatasync.UntagResourceRequest {
+						return awsdatasync.UntagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.UntagResourceOutput{}},
+						}
+					},
+					MockDescribeTask: describe(awsdatasync.TaskStatusAvailable),
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockListTagsForResource: listTags(),
+					MockDescribeTask:        describe(awsdatasync.TaskStatusAvailable),
+					MockUpdateTask: func(*awsdatasync.UpdateTaskInput) awsdatasync.UpdateTaskRequest {
+						return awsdatasync.UpdateTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: task(withExternalName(taskARN), withSchedule("rate(1 hour)")),
+			},
+			want: want{
+				cr:  task(withExternalName(taskARN), withSchedule("rate(1 hour)")),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.datasync, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockDeleteTask: func(*awsdatasync.DeleteTaskInput) awsdatasync.DeleteTaskRequest {
+						return awsdatasync.DeleteTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.DeleteTaskOutput{}},
+						}
+					},
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockDeleteTask: func(*awsdatasync.DeleteTaskInput) awsdatasync.DeleteTaskRequest {
+						return awsdatasync.DeleteTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				datasync: &fake.MockTaskClient{
+					MockDeleteTask: func(*awsdatasync.DeleteTaskInput) awsdatasync.DeleteTaskRequest {
+						return awsdatasync.DeleteTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr:  task(withExternalName(taskARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.datasync, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,568 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsdocdb "github.com/aws/aws-sdk-go-v2/service/docdb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/docdb/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/docdb"
+	"github.com/crossplane/provider-aws/pkg/clients/docdb/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	clusterName = "some-cluster"
+	clusterARN  = "arn:aws:rds:us-east-1:123456789012:cluster:some-cluster"
+	endpoint    = "some-cluster.cluster-abc.us-east-1.docdb.amazonaws.com"
+	snapshot    = "some-snapshot"
+	username    = "admin"
+	pwd         = "some-password"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	docdb docdb.DBClusterClient
+	kube  *test.MockClient
+	cr    resource.Managed
+}
+
+type clusterModifier func(*v1alpha1.DBCluster)
+
+func withConditions(c ...runtimev1alpha1.Condition) clusterModifier {
+	return func(r *v1alpha1.DBCluster) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s string) clusterModifier {
+	return func(r *v1alpha1.DBCluster) {
+		r.Status.AtProvider = v1alpha1.DBClusterObservation{DBClusterARN: clusterARN, Status: s, Endpoint: endpoint, Port: 27017}
+	}
+}
+
+func withSpec(p v1alpha1.DBClusterParameters) clusterModifier {
+	return func(r *v1alpha1.DBCluster) { r.Spec.ForProvider = p }
+}
+
+func cluster(m ...clusterModifier) *v1alpha1.DBCluster {
+	cr := &v1alpha1.DBCluster{}
+	meta.SetExternalName(cr, clusterName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// lateInitialized are the parameters that observed() late initializes.
+func lateInitialized() v1alpha1.DBClusterParameters {
+	return v1alpha1.DBClusterParameters{
+		Port:                  aws.Int64(27017),
+		BackupRetentionPeriod: aws.Int64(1),
+	}
+}
+
+func observed(status string) awsdocdb.DBCluster {
+	return awsdocdb.DBCluster{
+		DBClusterIdentifier:   aws.String(clusterName),
+		DBClusterArn:          aws.String(clusterARN),
+		Status:                aws.String(status),
+		Endpoint:              aws.String(endpoint),
+		Port:                  aws.Int64(27017),
+		BackupRetentionPeriod: aws.Int64(1),
+	}
+}
+
+func describe(status string) func(*awsdocdb.DescribeDBClustersInput) awsdocdb.DescribeDBClustersRequest {
+	return func(*awsdocdb.DescribeDBClustersInput) awsdocdb.DescribeDBClustersRequest {
+		return awsdocdb.DescribeDBClustersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdocdb.DescribeDBClustersOutput{
+				DBClusters: []awsdocdb.DBCluster{observed(status)},
+			}},
+		}
+	}
+}
+
+func noTags(*awsdocdb.ListTagsForResourceInput) awsdocdb.ListTagsForResourceRequest {
+	return awsdocdb.ListTagsForResourceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdocdb.ListTagsForResourceOutput{}},
+	}
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+		runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte("27017"),
+		runtimev1alpha1.ResourceCredentialsSecretUserKey:     {},
+	}
+}
+
+// withPassword references the master password secret, and the connection
+// secret that holds the given current password.
+func withPassword() clusterModifier {
+	return func(r *v1alpha1.DBCluster) {
+		r.Spec.ForProvider.MasterUsername = username
+		r.Spec.ForProvider.MasterPasswordSecretRef = &runtimev1alpha1.SecretKeySelector{
+			SecretReference: runtimev1alpha1.SecretReference{Name: "password", Namespace: "default"},
+			Key:             "password",
+		}
+		r.Spec.WriteConnectionSecretToReference = &runtimev1alpha1.SecretReference{Name: "connection", Namespace: "default"}
+	}
+}
+
+// getSecrets returns the referenced password, and the given password as the
+// one of the connection secret.
+func getSecrets(current string) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+		s := obj.(*corev1.Secret)
+		if key.Name == "connection" {
+			s.Data = map[string][]byte{runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(current)}
+			return nil
+		}
+		s.Data = map[string][]byte{"password": []byte(pwd)}
+		return nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockDescribeDBClusters:  describe(v1alpha1.DBClusterStateAvailable),
+					MockListTagsForResource: noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   cluster(),
+			},
+			want: want{
+				cr: cluster(withSpec(lateInitialized()), withStatus(v1alpha1.DBClusterStateAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"NeedsModification": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockDescribeDBClusters:  describe(v1alpha1.DBClusterStateAvailable),
+					MockListTagsForResource: noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr: cluster(withSpec(v1alpha1.DBClusterParameters{
+					EnableCloudwatchLogsExports: []string{v1alpha1.LogTypeAudit},
+				})),
+			},
+			want: want{
+				cr: cluster(withSpec(v1alpha1.DBClusterParameters{
+					Port:                        aws.Int64(27017),
+					BackupRetentionPeriod:       aws.Int64(1),
+					EnableCloudwatchLogsExports: []string{v1alpha1.LogTypeAudit},
+				}), withStatus(v1alpha1.DBClusterStateAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockDescribeDBClusters:  describe(v1alpha1.DBClusterStateCreating),
+					MockListTagsForResource: noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   cluster(),
+			},
+			want: want{
+				cr: cluster(withSpec(lateInitialized()), withStatus(v1alpha1.DBClusterStateCreating), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockDescribeDBClusters: func(*awsdocdb.DescribeDBClustersInput) awsdocdb.DescribeDBClustersRequest {
+						return awsdocdb.DescribeDBClustersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsdocdb.ErrCodeDBClusterNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockDescribeDBClusters: func(*awsdocdb.DescribeDBClustersInput) awsdocdb.DescribeDBClustersRequest {
+						return awsdocdb.DescribeDBClustersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.docdb, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr      resource.Managed
+		details managed.ConnectionDetails
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Create": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockCreateDBCluster: func(*awsdocdb.CreateDBClusterInput) awsdocdb.CreateDBClusterRequest {
+						return awsdocdb.CreateDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdocdb.CreateDBClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateWithReferencedPassword": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockCreateDBCluster: func(input *awsdocdb.CreateDBClusterInput) awsdocdb.CreateDBClusterRequest {
+						if diff := cmp.Diff(pwd, aws.StringValue(input.MasterUserPassword)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdocdb.CreateDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdocdb.CreateDBClusterOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: getSecrets("")},
+				cr:   cluster(withPassword()),
+			},
+			want: want{
+				cr: cluster(withPassword(), withConditions(runtimev1alpha1.Creating())),
+				details: managed.ConnectionDetails{
+					runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(username),
+					runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pwd),
+				},
+			},
+		},
+		"RestoreFromSnapshot": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockRestoreDBClusterFromSnapshot: func(input *awsdocdb.RestoreDBClusterFromSnapshotInput) awsdocdb.RestoreDBClusterFromSnapshotRequest {
+						if diff := cmp.Diff(snapshot, aws.StringValue(input.SnapshotIdentifier)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdocdb.RestoreDBClusterFromSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdocdb.RestoreDBClusterFromSnapshotOutput{}},
+						}
+					},
+				},
+				cr: cluster(withSpec(v1alpha1.DBClusterParameters{SnapshotIdentifier: aws.String(snapshot)})),
+			},
+			want: want{
+				cr: cluster(withSpec(v1alpha1.DBClusterParameters{SnapshotIdentifier: aws.String(snapshot)}), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockCreateDBCluster: func(*awsdocdb.CreateDBClusterInput) awsdocdb.CreateDBClusterRequest {
+						return awsdocdb.CreateDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.docdb, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.details, o.ConnectionDetails); tc.want.details != nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	audit := v1alpha1.DBClusterParameters{EnableCloudwatchLogsExports: []string{v1alpha1.LogTypeAudit}}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Modify": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockListTagsForResource: noTags,
+					MockDescribeDBClusters:  describe(v1alpha1.DBClusterStateAvailable),
+					MockModifyDBCluster: func(input *awsdocdb.ModifyDBClusterInput) awsdocdb.ModifyDBClusterRequest {
+						want := &awsdocdb.CloudwatchLogsExportConfiguration{EnableLogTypes: []string{v1alpha1.LogTypeAudit}}
+						if diff := cmp.Diff(want, input.CloudwatchLogsExportConfiguration); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdocdb.ModifyDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdocdb.ModifyDBClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(withSpec(audit), withStatus(v1alpha1.DBClusterStateAvailable)),
+			},
+			want: want{
+				cr: cluster(withSpec(audit), withStatus(v1alpha1.DBClusterStateAvailable)),
+			},
+		},
+		"ModifyPassword": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockListTagsForResource: noTags,
+					MockDescribeDBClusters:  describe(v1alpha1.DBClusterStateAvailable),
+					MockModifyDBCluster: func(input *awsdocdb.ModifyDBClusterInput) awsdocdb.ModifyDBClusterRequest {
+						if diff := cmp.Diff(pwd, aws.StringValue(input.MasterUserPassword)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdocdb.ModifyDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdocdb.ModifyDBClusterOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: getSecrets("old-password")},
+				cr:   cluster(withPassword(), withStatus(v1alpha1.DBClusterStateAvailable)),
+			},
+			want: want{
+				cr: cluster(withPassword(), withStatus(v1alpha1.DBClusterStateAvailable)),
+			},
+		},
+		"NotAvailable": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{},
+				cr:    cluster(withSpec(audit), withStatus(v1alpha1.DBClusterStateModifying)),
+			},
+			want: want{
+				cr: cluster(withSpec(audit), withStatus(v1alpha1.DBClusterStateModifying)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockListTagsForResource: noTags,
+					MockDescribeDBClusters:  describe(v1alpha1.DBClusterStateAvailable),
+					MockModifyDBCluster: func(*awsdocdb.ModifyDBClusterInput) awsdocdb.ModifyDBClusterRequest {
+						return awsdocdb.ModifyDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: cluster(withSpec(audit), withStatus(v1alpha1.DBClusterStateAvailable)),
+			},
+			want: want{
+				cr:  cluster(withSpec(audit), withStatus(v1alpha1.DBClusterStateAvailable)),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.docdb, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockDeleteDBCluster: func(*awsdocdb.DeleteDBClusterInput) awsdocdb.DeleteDBClusterRequest {
+						return awsdocdb.DeleteDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdocdb.DeleteDBClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(withStatus(v1alpha1.DBClusterStateAvailable)),
+			},
+			want: want{
+				cr: cluster(withStatus(v1alpha1.DBClusterStateAvailable), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{},
+				cr:    cluster(withStatus(v1alpha1.DBClusterStateDeleting)),
+			},
+			want: want{
+				cr: cluster(withStatus(v1alpha1.DBClusterStateDeleting), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockDeleteDBCluster: func(*awsdocdb.DeleteDBClusterInput) awsdocdb.DeleteDBClusterRequest {
+						return awsdocdb.DeleteDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsdocdb.ErrCodeDBClusterNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: cluster(withStatus(v1alpha1.DBClusterStateAvailable)),
+			},
+			want: want{
+				cr: cluster(withStatus(v1alpha1.DBClusterStateAvailable), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				docdb: &fake.MockDBClusterClient{
+					MockDeleteDBCluster: func(*awsdocdb.DeleteDBClusterInput) awsdocdb.DeleteDBClusterRequest {
+						return awsdocdb.DeleteDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: cluster(withStatus(v1alpha1.DBClusterStateAvailable)),
+			},
+			want: want{
+				cr:  cluster(withStatus(v1alpha1.DBClusterStateAvailable), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.docdb, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
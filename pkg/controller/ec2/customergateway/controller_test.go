@@ -0,0 +1,529 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customergateway
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	cgwID     = "cgw-0123456789"
+	ipAddress = "203.0.113.12"
+	errBoom   = errors.New("boom")
+)
+
+type cgwModifier func(*v1alpha1.CustomerGateway)
+
+func withExternalName(name string) cgwModifier {
+	return func(r *v1alpha1.CustomerGateway) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) cgwModifier {
+	return func(r *v1alpha1.CustomerGateway) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.CustomerGatewayParameters) cgwModifier {
+	return func(r *v1alpha1.CustomerGateway) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.CustomerGatewayObservation) cgwModifier {
+	return func(r *v1alpha1.CustomerGateway) { r.Status.AtProvider = s }
+}
+
+func cgw(m ...cgwModifier) *v1alpha1.CustomerGateway {
+	cr := &v1alpha1.CustomerGateway{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specTags() []v1beta1.Tag {
+	return []v1beta1.Tag{{Key: "key1", Value: "value1"}}
+}
+
+func cgwTags() []awsec2.Tag {
+	return []awsec2.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}}
+}
+
+func specParams() v1alpha1.CustomerGatewayParameters {
+	return v1alpha1.CustomerGatewayParameters{
+		IPAddress: &ipAddress,
+		Tags:      specTags(),
+	}
+}
+
+func describeOutput(state string, tags []awsec2.Tag) *awsec2.DescribeCustomerGatewaysOutput {
+	return &awsec2.DescribeCustomerGatewaysOutput{
+		CustomerGateways: []awsec2.CustomerGateway{
+			{
+				CustomerGatewayId: aws.String(cgwID),
+				BgpAsn:            aws.String("65000"),
+				IpAddress:         aws.String(ipAddress),
+				State:             aws.String(state),
+				Type:              aws.String("ipsec.1"),
+				Tags:              tags,
+			},
+		},
+	}
+}
+
+func observation(state string) v1alpha1.CustomerGatewayObservation {
+	return v1alpha1.CustomerGatewayObservation{
+		CustomerGatewayID: cgwID,
+		State:             state,
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	cgw  ec2.CustomerGatewayClient
+	kube client.Client
+	cr   *v1alpha1.CustomerGateway
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.CustomerGateway
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{},
+				cr:  cgw(),
+			},
+			want: want{
+				cr: cgw(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribeCustomerGateways: func(input *awsec2.DescribeCustomerGatewaysInput) awsec2.DescribeCustomerGatewaysRequest {
+						return awsec2.DescribeCustomerGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.CustomerGatewayIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: cgw(withExternalName(cgwID)),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID)),
+			},
+		},
+		"Deleted": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribeCustomerGateways: func(input *awsec2.DescribeCustomerGatewaysInput) awsec2.DescribeCustomerGatewaysRequest {
+						return awsec2.DescribeCustomerGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.CustomerGatewayStateDeleted, cgwTags())},
+						}
+					},
+				},
+				cr: cgw(withExternalName(cgwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID), withSpec(specParams())),
+			},
+		},
+		"Available": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribeCustomerGateways: func(input *awsec2.DescribeCustomerGatewaysInput) awsec2.DescribeCustomerGatewaysRequest {
+						return awsec2.DescribeCustomerGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.CustomerGatewayStateAvailable, cgwTags())},
+						}
+					},
+				},
+				cr: cgw(withExternalName(cgwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID), withSpec(specParams()),
+					withStatus(observation(v1alpha1.CustomerGatewayStateAvailable)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribeCustomerGateways: func(input *awsec2.DescribeCustomerGatewaysInput) awsec2.DescribeCustomerGatewaysRequest {
+						return awsec2.DescribeCustomerGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.CustomerGatewayStatePending, nil)},
+						}
+					},
+				},
+				cr: cgw(withExternalName(cgwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID), withSpec(specParams()),
+					withStatus(observation(v1alpha1.CustomerGatewayStatePending)),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribeCustomerGateways: func(input *awsec2.DescribeCustomerGatewaysInput) awsec2.DescribeCustomerGatewaysRequest {
+						return awsec2.DescribeCustomerGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.CustomerGatewayStateAvailable, cgwTags())},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: cgw(withExternalName(cgwID)),
+			},
+			want: want{
+				cr:  cgw(withExternalName(cgwID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribeCustomerGateways: func(input *awsec2.DescribeCustomerGatewaysInput) awsec2.DescribeCustomerGatewaysRequest {
+						return awsec2.DescribeCustomerGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cgw(withExternalName(cgwID)),
+			},
+			want: want{
+				cr:  cgw(withExternalName(cgwID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cgw}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.CustomerGateway
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockCreateCustomerGateway: func(input *awsec2.CreateCustomerGatewayInput) awsec2.CreateCustomerGatewayRequest {
+						return awsec2.CreateCustomerGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateCustomerGatewayOutput{
+								CustomerGateway: &awsec2.CustomerGateway{CustomerGatewayId: aws.String(cgwID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: cgw(withSpec(specParams())),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID), withSpec(specParams())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockCreateCustomerGateway: func(input *awsec2.CreateCustomerGatewayInput) awsec2.CreateCustomerGatewayRequest {
+						return awsec2.CreateCustomerGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cgw(withSpec(specParams())),
+			},
+			want: want{
+				cr:  cgw(withSpec(specParams())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"SpecUpdateFailed": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockCreateCustomerGateway: func(input *awsec2.CreateCustomerGatewayInput) awsec2.CreateCustomerGatewayRequest {
+						return awsec2.CreateCustomerGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateCustomerGatewayOutput{
+								CustomerGateway: &awsec2.CustomerGateway{CustomerGatewayId: aws.String(cgwID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: cgw(withSpec(specParams())),
+			},
+			want: want{
+				cr:  cgw(withExternalName(cgwID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cgw}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.CustomerGateway
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"TagsUpdated": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribeCustomerGateways: func(input *awsec2.DescribeCustomerGatewaysInput) awsec2.DescribeCustomerGatewaysRequest {
+						return awsec2.DescribeCustomerGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.CustomerGatewayStateAvailable, []awsec2.Tag{{Key: aws.String("old"), Value: aws.String("value")}})},
+						}
+					},
+					MockDeleteTags: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTagsOutput{}},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+				},
+				cr: cgw(withExternalName(cgwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID), withSpec(specParams())),
+			},
+		},
+		"CreateTagsFailed": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribeCustomerGateways: func(input *awsec2.DescribeCustomerGatewaysInput) awsec2.DescribeCustomerGatewaysRequest {
+						return awsec2.DescribeCustomerGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.CustomerGatewayStateAvailable, nil)},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cgw(withExternalName(cgwID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  cgw(withExternalName(cgwID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errUpdateTags),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribeCustomerGateways: func(input *awsec2.DescribeCustomerGatewaysInput) awsec2.DescribeCustomerGatewaysRequest {
+						return awsec2.DescribeCustomerGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cgw(withExternalName(cgwID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  cgw(withExternalName(cgwID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cgw}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CustomerGateway
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDeleteCustomerGateway: func(input *awsec2.DeleteCustomerGatewayInput) awsec2.DeleteCustomerGatewayRequest {
+						return awsec2.DeleteCustomerGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteCustomerGatewayOutput{}},
+						}
+					},
+				},
+				cr: cgw(withExternalName(cgwID)),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{},
+				cr:  cgw(withExternalName(cgwID), withStatus(observation(v1alpha1.CustomerGatewayStateDeleting))),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID), withStatus(observation(v1alpha1.CustomerGatewayStateDeleting)),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDeleteCustomerGateway: func(input *awsec2.DeleteCustomerGatewayInput) awsec2.DeleteCustomerGatewayRequest {
+						return awsec2.DeleteCustomerGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.CustomerGatewayIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: cgw(withExternalName(cgwID)),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDeleteCustomerGateway: func(input *awsec2.DeleteCustomerGatewayInput) awsec2.DeleteCustomerGatewayRequest {
+						return awsec2.DeleteCustomerGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cgw(withExternalName(cgwID)),
+			},
+			want: want{
+				cr:  cgw(withExternalName(cgwID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cgw}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcpoptions
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a DHCPOptions resource"
+	errDescribe         = "failed to describe DHCPOptions"
+	errDescribeVPC      = "failed to describe the VPC of the DHCPOptions resource"
+	errMultipleItems    = "retrieved multiple DHCPOptions for the given dhcpOptionsId"
+	errSpecUpdate       = "cannot update spec of the DHCPOptions resource"
+	errCreate           = "failed to create the DHCPOptions resource"
+	errAssociate        = "failed to associate the DHCPOptions resource with the VPC"
+	errDisassociate     = "failed to disassociate the DHCPOptions resource from the VPC"
+	errDelete           = "failed to delete the DHCPOptions resource"
+	errUpdateTags       = "failed to update tags for the DHCPOptions resource"
+	errDeleteTags       = "failed to delete tags for DHCPOptions resource"
+)
+
+// SetupDHCPOptions adds a controller that reconciles DHCPOptions.
+func SetupDHCPOptions(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DHCPOptionsGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.DHCPOptions{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DHCPOptionsGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewDHCPOptionsClient}, awscommon.DeletionTierNetwork)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.DHCPOptionsClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DHCPOptions)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.DHCPOptionsClient
+}
+
+// describe returns the DHCP options set with the given ID, or nil if it does
+// not exist.
+func (e *external) describe(ctx context.Context, id string) (*awsec2.DhcpOptions, error) {
+	response, err := e.client.DescribeDhcpOptionsRequest(&awsec2.DescribeDhcpOptionsInput{
+		DhcpOptionsIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, resource.Ignore(ec2.IsDHCPOptionsNotFoundErr, err)
+	}
+
+	switch len(response.DhcpOptions) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &response.DhcpOptions[0], nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+// describeVPC returns the VPC with the given ID, or nil if no ID is given or
+// the VPC does not exist.
+func (e *external) describeVPC(ctx context.Context, id *string) (*awsec2.Vpc, error) {
+	if id == nil {
+		return nil, nil
+	}
+	response, err := e.client.DescribeVpcsRequest(&awsec2.DescribeVpcsInput{
+		VpcIds: []string{aws.StringValue(id)},
+	}).Send(ctx)
+	if err != nil {
+		return nil, resource.Ignore(ec2.IsVPCNotFoundErr, err)
+	}
+	if len(response.Vpcs) == 0 {
+		return nil, nil
+	}
+	return &response.Vpcs[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.DHCPOptions)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeDHCPOptions(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	vpc, err := e.describeVPC(ctx, cr.Spec.ForProvider.VPCID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeVPC)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateDHCPOptionsObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsDHCPOptionsUpToDate(cr.Spec.ForProvider, *observed, vpc),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.DHCPOptions)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	// The VPC association and the tags of the DHCP options set are applied
+	// by the subsequent update.
+	result, err := e.client.CreateDhcpOptionsRequest(ec2.GenerateCreateDhcpOptionsInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if result.DhcpOptions == nil {
+		return managed.ExternalCreation{}, errors.New(errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(result.DhcpOptions.DhcpOptionsId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.DHCPOptions)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	id := meta.GetExternalName(cr)
+	observed, err := e.describe(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	vpc, err := e.describeVPC(ctx, cr.Spec.ForProvider.VPCID)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeVPC)
+	}
+	if vpc != nil && aws.StringValue(vpc.DhcpOptionsId) != id {
+		if _, err := e.client.AssociateDhcpOptionsRequest(&awsec2.AssociateDhcpOptionsInput{
+			DhcpOptionsId: aws.String(id),
+			VpcId:         vpc.VpcId,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAssociate)
+		}
+	}
+
+	addTags, removeTags := awscommon.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.DHCPOptions)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	id := meta.GetExternalName(cr)
+
+	// A DHCP options set cannot be deleted while it is associated with a VPC,
+	// so the VPC is switched back to the default options first.
+	vpc, err := e.describeVPC(ctx, cr.Spec.ForProvider.VPCID)
+	if err != nil {
+		return errors.Wrap(err, errDescribeVPC)
+	}
+	if vpc != nil && aws.StringValue(vpc.DhcpOptionsId) == id {
+		if _, err := e.client.AssociateDhcpOptionsRequest(&awsec2.AssociateDhcpOptionsInput{
+			DhcpOptionsId: aws.String(ec2.DefaultDHCPOptionsID),
+			VpcId:         vpc.VpcId,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDisassociate)
+		}
+	}
+
+	_, err = e.client.DeleteDhcpOptionsRequest(&awsec2.DeleteDhcpOptionsInput{
+		DhcpOptionsId: aws.String(id),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ec2.IsDHCPOptionsNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,519 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcpoptions
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	dhcpOptionsID = "dopt-0123456789"
+	vpcID         = "vpc-0123456789"
+	domainName    = "example.internal"
+	errBoom       = errors.New("boom")
+)
+
+type dhcpOptionsModifier func(*v1alpha1.DHCPOptions)
+
+func withExternalName(name string) dhcpOptionsModifier {
+	return func(r *v1alpha1.DHCPOptions) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) dhcpOptionsModifier {
+	return func(r *v1alpha1.DHCPOptions) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.DHCPOptionsParameters) dhcpOptionsModifier {
+	return func(r *v1alpha1.DHCPOptions) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.DHCPOptionsObservation) dhcpOptionsModifier {
+	return func(r *v1alpha1.DHCPOptions) { r.Status.AtProvider = s }
+}
+
+func dhcpOptions(m ...dhcpOptionsModifier) *v1alpha1.DHCPOptions {
+	cr := &v1alpha1.DHCPOptions{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specParams(tags ...v1beta1.Tag) v1alpha1.DHCPOptionsParameters {
+	return v1alpha1.DHCPOptionsParameters{
+		DomainName:        aws.String(domainName),
+		DomainNameServers: []string{"AmazonProvidedDNS"},
+		VPCID:             aws.String(vpcID),
+		Tags:              tags,
+	}
+}
+
+func describe(found bool, tags ...awsec2.Tag) func(*awsec2.DescribeDhcpOptionsInput) awsec2.DescribeDhcpOptionsRequest {
+	return func(input *awsec2.DescribeDhcpOptionsInput) awsec2.DescribeDhcpOptionsRequest {
+		out := &awsec2.DescribeDhcpOptionsOutput{}
+		if found {
+			out.DhcpOptions = []awsec2.DhcpOptions{{
+				DhcpOptionsId: aws.String(dhcpOptionsID),
+				Tags:          tags,
+			}}
+		}
+		return awsec2.DescribeDhcpOptionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func describeVPC(optionsID string) func(*awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+	return func(input *awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+		return awsec2.DescribeVpcsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVpcsOutput{
+				Vpcs: []awsec2.Vpc{{VpcId: aws.String(vpcID), DhcpOptionsId: aws.String(optionsID)}},
+			}},
+		}
+	}
+}
+
+func associate(t *testing.T, optionsID string, err error) func(*awsec2.AssociateDhcpOptionsInput) awsec2.AssociateDhcpOptionsRequest {
+	return func(input *awsec2.AssociateDhcpOptionsInput) awsec2.AssociateDhcpOptionsRequest {
+		if diff := cmp.Diff(optionsID, aws.StringValue(input.DhcpOptionsId)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		return awsec2.AssociateDhcpOptionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.AssociateDhcpOptionsOutput{}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	dhcp ec2.DHCPOptionsClient
+	kube client.Client
+	cr   *v1alpha1.DHCPOptions
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DHCPOptions
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{},
+				cr:   dhcpOptions(),
+			},
+			want: want{
+				cr: dhcpOptions(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribeDhcpOptions: describe(false),
+				},
+				cr: dhcpOptions(withExternalName(dhcpOptionsID)),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(dhcpOptionsID)),
+			},
+		},
+		"Available": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribeDhcpOptions: describe(true),
+					MockDescribeVpcs:        describeVPC(dhcpOptionsID),
+				},
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams())),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams()),
+					withStatus(v1alpha1.DHCPOptionsObservation{DHCPOptionsID: dhcpOptionsID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotAssociated": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribeDhcpOptions: describe(true),
+					MockDescribeVpcs:        describeVPC("dopt-other"),
+				},
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams())),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams()),
+					withStatus(v1alpha1.DHCPOptionsObservation{DHCPOptionsID: dhcpOptionsID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TagsLateInitialized": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribeDhcpOptions: describe(true, awsec2.Tag{Key: aws.String("k"), Value: aws.String("v")}),
+					MockDescribeVpcs:        describeVPC(dhcpOptionsID),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams())),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams(v1beta1.Tag{Key: "k", Value: "v"})),
+					withStatus(v1alpha1.DHCPOptionsObservation{DHCPOptionsID: dhcpOptionsID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribeDhcpOptions: func(input *awsec2.DescribeDhcpOptionsInput) awsec2.DescribeDhcpOptionsRequest {
+						return awsec2.DescribeDhcpOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dhcpOptions(withExternalName(dhcpOptionsID)),
+			},
+			want: want{
+				cr:  dhcpOptions(withExternalName(dhcpOptionsID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"DescribeVPCFailed": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribeDhcpOptions: describe(true),
+					MockDescribeVpcs: func(input *awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+						return awsec2.DescribeVpcsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errDescribeVPC),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.dhcp}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DHCPOptions
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockCreateDhcpOptions: func(input *awsec2.CreateDhcpOptionsInput) awsec2.CreateDhcpOptionsRequest {
+						if diff := cmp.Diff(ec2.GenerateCreateDhcpOptionsInput(specParams()), input); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateDhcpOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateDhcpOptionsOutput{
+								DhcpOptions: &awsec2.DhcpOptions{DhcpOptionsId: aws.String(dhcpOptionsID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: dhcpOptions(withSpec(specParams())),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams()),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockCreateDhcpOptions: func(input *awsec2.CreateDhcpOptionsInput) awsec2.CreateDhcpOptionsRequest {
+						return awsec2.CreateDhcpOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dhcpOptions(withSpec(specParams())),
+			},
+			want: want{
+				cr: dhcpOptions(withSpec(specParams()),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.dhcp}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DHCPOptions
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Associated": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribeDhcpOptions:  describe(true),
+					MockDescribeVpcs:         describeVPC(ec2.DefaultDHCPOptionsID),
+					MockAssociateDhcpOptions: associate(t, dhcpOptionsID, nil),
+				},
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams())),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams())),
+			},
+		},
+		"AssociateFailed": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribeDhcpOptions:  describe(true),
+					MockDescribeVpcs:         describeVPC(ec2.DefaultDHCPOptionsID),
+					MockAssociateDhcpOptions: associate(t, dhcpOptionsID, errBoom),
+				},
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errAssociate),
+			},
+		},
+		"TagsUpdated": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribeDhcpOptions: describe(true, awsec2.Tag{Key: aws.String("old"), Value: aws.String("v")}),
+					MockDescribeVpcs:        describeVPC(dhcpOptionsID),
+					MockDeleteTags: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTagsOutput{}},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						if diff := cmp.Diff([]string{dhcpOptionsID}, input.Resources); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+				},
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams(v1beta1.Tag{Key: "new", Value: "v"}))),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams(v1beta1.Tag{Key: "new", Value: "v"}))),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.dhcp}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.DHCPOptions
+		err error
+	}
+
+	deleteResponse := func(err error) func(*awsec2.DeleteDhcpOptionsInput) awsec2.DeleteDhcpOptionsRequest {
+		return func(input *awsec2.DeleteDhcpOptionsInput) awsec2.DeleteDhcpOptionsRequest {
+			return awsec2.DeleteDhcpOptionsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteDhcpOptionsOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribeVpcs:      describeVPC(ec2.DefaultDHCPOptionsID),
+					MockDeleteDhcpOptions: deleteResponse(nil),
+				},
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams())),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams()), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Disassociated": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribeVpcs:         describeVPC(dhcpOptionsID),
+					MockAssociateDhcpOptions: associate(t, ec2.DefaultDHCPOptionsID, nil),
+					MockDeleteDhcpOptions:    deleteResponse(nil),
+				},
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams())),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams()), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DisassociateFailed": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribeVpcs:         describeVPC(dhcpOptionsID),
+					MockAssociateDhcpOptions: associate(t, ec2.DefaultDHCPOptionsID, errBoom),
+				},
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  dhcpOptions(withExternalName(dhcpOptionsID), withSpec(specParams()), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDisassociate),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDeleteDhcpOptions: deleteResponse(awserr.New(ec2.DHCPOptionsIDNotFound, "", nil)),
+				},
+				cr: dhcpOptions(withExternalName(dhcpOptionsID)),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(dhcpOptionsID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDeleteDhcpOptions: deleteResponse(errBoom),
+				},
+				cr: dhcpOptions(withExternalName(dhcpOptionsID)),
+			},
+			want: want{
+				cr:  dhcpOptions(withExternalName(dhcpOptionsID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.dhcp}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,462 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package egressonlyinternetgateway
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	eigwID  = "eigw-0123456789"
+	vpcID   = "vpc-0123456789"
+	errBoom = errors.New("boom")
+)
+
+type eigwModifier func(*v1alpha1.EgressOnlyInternetGateway)
+
+func withExternalName(name string) eigwModifier {
+	return func(r *v1alpha1.EgressOnlyInternetGateway) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) eigwModifier {
+	return func(r *v1alpha1.EgressOnlyInternetGateway) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.EgressOnlyInternetGatewayParameters) eigwModifier {
+	return func(r *v1alpha1.EgressOnlyInternetGateway) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.EgressOnlyInternetGatewayObservation) eigwModifier {
+	return func(r *v1alpha1.EgressOnlyInternetGateway) { r.Status.AtProvider = s }
+}
+
+func eigw(m ...eigwModifier) *v1alpha1.EgressOnlyInternetGateway {
+	cr := &v1alpha1.EgressOnlyInternetGateway{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specParams(tags ...v1beta1.Tag) v1alpha1.EgressOnlyInternetGatewayParameters {
+	return v1alpha1.EgressOnlyInternetGatewayParameters{
+		VPCID: aws.String(vpcID),
+		Tags:  tags,
+	}
+}
+
+func observation(state string) v1alpha1.EgressOnlyInternetGatewayObservation {
+	return v1alpha1.EgressOnlyInternetGatewayObservation{
+		EgressOnlyInternetGatewayID: eigwID,
+		Attachments:                 []v1beta1.InternetGatewayAttachment{{AttachmentStatus: state, VPCID: vpcID}},
+	}
+}
+
+func describe(state awsec2.AttachmentStatus, tags ...awsec2.Tag) func(*awsec2.DescribeEgressOnlyInternetGatewaysInput) awsec2.DescribeEgressOnlyInternetGatewaysRequest {
+	return func(input *awsec2.DescribeEgressOnlyInternetGatewaysInput) awsec2.DescribeEgressOnlyInternetGatewaysRequest {
+		out := &awsec2.DescribeEgressOnlyInternetGatewaysOutput{}
+		if state != "" {
+			out.EgressOnlyInternetGateways = []awsec2.EgressOnlyInternetGateway{{
+				EgressOnlyInternetGatewayId: aws.String(eigwID),
+				Attachments:                 []awsec2.InternetGatewayAttachment{{State: state, VpcId: aws.String(vpcID)}},
+				Tags:                        tags,
+			}}
+		}
+		return awsec2.DescribeEgressOnlyInternetGatewaysRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	eigw ec2.EgressOnlyInternetGatewayClient
+	kube client.Client
+	cr   *v1alpha1.EgressOnlyInternetGateway
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.EgressOnlyInternetGateway
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				eigw: &fake.MockEgressOnlyInternetGatewayClient{},
+				cr:   eigw(),
+			},
+			want: want{
+				cr: eigw(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				eigw: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDescribeEgressOnlyInternetGateways: describe(""),
+				},
+				cr: eigw(withExternalName(eigwID)),
+			},
+			want: want{
+				cr: eigw(withExternalName(eigwID)),
+			},
+		},
+		"Available": {
+			args: args{
+				eigw: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDescribeEgressOnlyInternetGateways: describe(awsec2.AttachmentStatusAttached),
+				},
+				cr: eigw(withExternalName(eigwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: eigw(withExternalName(eigwID), withSpec(specParams()),
+					withStatus(observation("attached")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Unavailable": {
+			args: args{
+				eigw: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDescribeEgressOnlyInternetGateways: describe(awsec2.AttachmentStatusDetached),
+				},
+				cr: eigw(withExternalName(eigwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: eigw(withExternalName(eigwID), withSpec(specParams()),
+					withStatus(observation("detached")),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				eigw: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDescribeEgressOnlyInternetGateways: describe(awsec2.AttachmentStatusAttached),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: eigw(withExternalName(eigwID)),
+			},
+			want: want{
+				cr: eigw(withExternalName(eigwID), withSpec(specParams()),
+					withStatus(observation("attached")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				eigw: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDescribeEgressOnlyInternetGateways: describe(awsec2.AttachmentStatusAttached, awsec2.Tag{Key: aws.String("k"), Value: aws.String("v1")}),
+				},
+				cr: eigw(withExternalName(eigwID), withSpec(specParams(v1beta1.Tag{Key: "k", Value: "v2"}))),
+			},
+			want: want{
+				cr: eigw(withExternalName(eigwID), withSpec(specParams(v1beta1.Tag{Key: "k", Value: "v2"})),
+					withStatus(observation("attached")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				eigw: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDescribeEgressOnlyInternetGateways: func(input *awsec2.DescribeEgressOnlyInternetGatewaysInput) awsec2.DescribeEgressOnlyInternetGatewaysRequest {
+						return awsec2.DescribeEgressOnlyInternetGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: eigw(withExternalName(eigwID)),
+			},
+			want: want{
+				cr:  eigw(withExternalName(eigwID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eigw}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.EgressOnlyInternetGateway
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eigw: &fake.MockEgressOnlyInternetGatewayClient{
+					MockCreateEgressOnlyInternetGateway: func(input *awsec2.CreateEgressOnlyInternetGatewayInput) awsec2.CreateEgressOnlyInternetGatewayRequest {
+						if diff := cmp.Diff(vpcID, aws.StringValue(input.VpcId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateEgressOnlyInternetGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateEgressOnlyInternetGatewayOutput{
+								EgressOnlyInternetGateway: &awsec2.EgressOnlyInternetGateway{EgressOnlyInternetGatewayId: aws.String(eigwID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: eigw(withSpec(specParams())),
+			},
+			want: want{
+				cr: eigw(withExternalName(eigwID), withSpec(specParams()),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				eigw: &fake.MockEgressOnlyInternetGatewayClient{
+					MockCreateEgressOnlyInternetGateway: func(input *awsec2.CreateEgressOnlyInternetGatewayInput) awsec2.CreateEgressOnlyInternetGatewayRequest {
+						return awsec2.CreateEgressOnlyInternetGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: eigw(withSpec(specParams())),
+			},
+			want: want{
+				cr: eigw(withSpec(specParams()),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eigw}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.EgressOnlyInternetGateway
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"TagsUpdated": {
+			args: args{
+				eigw: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDescribeEgressOnlyInternetGateways: describe(awsec2.AttachmentStatusAttached, awsec2.Tag{Key: aws.String("old"), Value: aws.String("v")}),
+					MockDeleteTags: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTagsOutput{}},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						if diff := cmp.Diff([]string{eigwID}, input.Resources); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+				},
+				cr: eigw(withExternalName(eigwID), withSpec(specParams(v1beta1.Tag{Key: "new", Value: "v"}))),
+			},
+			want: want{
+				cr: eigw(withExternalName(eigwID), withSpec(specParams(v1beta1.Tag{Key: "new", Value: "v"}))),
+			},
+		},
+		"DeleteTagsFailed": {
+			args: args{
+				eigw: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDescribeEgressOnlyInternetGateways: describe(awsec2.AttachmentStatusAttached, awsec2.Tag{Key: aws.String("old"), Value: aws.String("v")}),
+					MockDeleteTags: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: eigw(withExternalName(eigwID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  eigw(withExternalName(eigwID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errDeleteTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eigw}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.EgressOnlyInternetGateway
+		err error
+	}
+
+	deleteResponse := func(err error) func(*awsec2.DeleteEgressOnlyInternetGatewayInput) awsec2.DeleteEgressOnlyInternetGatewayRequest {
+		return func(input *awsec2.DeleteEgressOnlyInternetGatewayInput) awsec2.DeleteEgressOnlyInternetGatewayRequest {
+			return awsec2.DeleteEgressOnlyInternetGatewayRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteEgressOnlyInternetGatewayOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eigw: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDeleteEgressOnlyInternetGateway: deleteResponse(nil),
+				},
+				cr: eigw(withExternalName(eigwID)),
+			},
+			want: want{
+				cr: eigw(withExternalName(eigwID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				eigw: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDeleteEgressOnlyInternetGateway: deleteResponse(awserr.New(ec2.EgressOnlyInternetGatewayIDNotFound, "", nil)),
+				},
+				cr: eigw(withExternalName(eigwID)),
+			},
+			want: want{
+				cr: eigw(withExternalName(eigwID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				eigw: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDeleteEgressOnlyInternetGateway: deleteResponse(errBoom),
+				},
+				cr: eigw(withExternalName(eigwID)),
+			},
+			want: want{
+				cr:  eigw(withExternalName(eigwID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eigw}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -49,6 +49,8 @@ const (
 	errCreate        = "failed to create the ElasticIP resource"
 	errCreateTags    = "failed to create tags for the ElasticIP resource"
 	errDelete        = "failed to delete the ElasticIP resource"
+	errAssociate     = "failed to associate the ElasticIP resource"
+	errDisassociate  = "failed to disassociate the ElasticIP resource"
 	errSpecUpdate    = "cannot update spec of ElasticIP custom resource"
 	errStatusUpdate  = "cannot update status of ElasticIP custom resource"
 )
@@ -61,10 +63,10 @@ func SetupElasticIP(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1alpha1.ElasticIP{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.ElasticIPGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient()}, awsclients.DeletionTierNetwork)))),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), &tagger{kube: mgr.GetClient()}),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -192,7 +194,13 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
 	}
 
-	return managed.ExternalUpdate{}, nil
+	// NOTE: Status.AtProvider reflects the address as observed in this
+	// reconcile.
+	if ec2.IsElasticIPAssociationUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider) {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err := e.client.AssociateAddressRequest(ec2.GenerateAssociateAddressInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errAssociate)
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -202,6 +210,19 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	}
 
 	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	// An associated address cannot be released, so we disassociate the
+	// address first if we manage its association.
+	if ec2.IsElasticIPAssociationManaged(cr.Spec.ForProvider) && (cr.Status.AtProvider.AssociationID != "" || cr.Status.AtProvider.InstanceID != "") {
+		in := &awsec2.DisassociateAddressInput{AssociationId: aws.String(cr.Status.AtProvider.AssociationID)}
+		if ec2.IsStandardDomain(cr.Spec.ForProvider) {
+			in = &awsec2.DisassociateAddressInput{PublicIp: aws.String(meta.GetExternalName(cr))}
+		}
+		if _, err := e.client.DisassociateAddressRequest(in).Send(ctx); resource.Ignore(ec2.IsAddressNotFoundErr, err) != nil {
+			return errors.Wrap(err, errDisassociate)
+		}
+	}
+
 	var err error
 	if ec2.IsStandardDomain(cr.Spec.ForProvider) {
 		_, err = e.client.ReleaseAddressRequest(&awsec2.ReleaseAddressInput{
@@ -46,6 +46,8 @@ var (
 	domainVpc      = "vpc"
 	domainStandard = "standard"
 	publicIP       = "1.1.1.1"
+	instanceID     = "some instance"
+	associationID  = "some association"
 	errBoom        = errors.New("boom")
 )
 
@@ -357,6 +359,66 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreateTags),
 			},
 		},
+		"Associate": {
+			args: args{
+				elasticIP: &fake.MockElasticIPClient{
+					MockCreateTagsRequest: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+					MockAssociate: func(input *awsec2.AssociateAddressInput) awsec2.AssociateAddressRequest {
+						if diff := cmp.Diff(&awsec2.AssociateAddressInput{
+							AllocationId:       &allocationID,
+							AllowReassociation: aws.Bool(true),
+							InstanceId:         &instanceID,
+						}, input); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.AssociateAddressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.AssociateAddressOutput{}},
+						}
+					},
+				},
+				cr: elasticIP(withExternalName(allocationID), withSpec(v1alpha1.ElasticIPParameters{
+					Domain:     &domainVpc,
+					InstanceID: &instanceID,
+				})),
+			},
+			want: want{
+				cr: elasticIP(withExternalName(allocationID), withSpec(v1alpha1.ElasticIPParameters{
+					Domain:     &domainVpc,
+					InstanceID: &instanceID,
+				})),
+			},
+		},
+		"AssociateFailed": {
+			args: args{
+				elasticIP: &fake.MockElasticIPClient{
+					MockCreateTagsRequest: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+					MockAssociate: func(input *awsec2.AssociateAddressInput) awsec2.AssociateAddressRequest {
+						return awsec2.AssociateAddressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: elasticIP(withSpec(v1alpha1.ElasticIPParameters{
+					Domain:     &domainVpc,
+					InstanceID: &instanceID,
+				})),
+			},
+			want: want{
+				cr: elasticIP(withSpec(v1alpha1.ElasticIPParameters{
+					Domain:     &domainVpc,
+					InstanceID: &instanceID,
+				})),
+				err: errors.Wrap(errBoom, errAssociate),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -439,6 +501,57 @@ func TestRelease(t *testing.T) {
 				err: errors.Wrap(errBoom, errDelete),
 			},
 		},
+		"DisassociateFirst": {
+			args: args{
+				elasticIP: &fake.MockElasticIPClient{
+					MockDisassociate: func(input *awsec2.DisassociateAddressInput) awsec2.DisassociateAddressRequest {
+						if diff := cmp.Diff(&awsec2.DisassociateAddressInput{AssociationId: &associationID}, input); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.DisassociateAddressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DisassociateAddressOutput{}},
+						}
+					},
+					MockRelease: func(input *awsec2.ReleaseAddressInput) awsec2.ReleaseAddressRequest {
+						return awsec2.ReleaseAddressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ReleaseAddressOutput{}},
+						}
+					},
+				},
+				cr: elasticIP(
+					withSpec(v1alpha1.ElasticIPParameters{InstanceID: &instanceID}),
+					withStatus(v1alpha1.ElasticIPObservation{AssociationID: associationID, InstanceID: instanceID}),
+				),
+			},
+			want: want{
+				cr: elasticIP(withConditions(runtimev1alpha1.Deleting()),
+					withSpec(v1alpha1.ElasticIPParameters{InstanceID: &instanceID}),
+					withStatus(v1alpha1.ElasticIPObservation{AssociationID: associationID, InstanceID: instanceID}),
+				),
+			},
+		},
+		"DisassociateFailed": {
+			args: args{
+				elasticIP: &fake.MockElasticIPClient{
+					MockDisassociate: func(input *awsec2.DisassociateAddressInput) awsec2.DisassociateAddressRequest {
+						return awsec2.DisassociateAddressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: elasticIP(
+					withSpec(v1alpha1.ElasticIPParameters{InstanceID: &instanceID}),
+					withStatus(v1alpha1.ElasticIPObservation{AssociationID: associationID, InstanceID: instanceID}),
+				),
+			},
+			want: want{
+				cr: elasticIP(withConditions(runtimev1alpha1.Deleting()),
+					withSpec(v1alpha1.ElasticIPParameters{InstanceID: &instanceID}),
+					withStatus(v1alpha1.ElasticIPObservation{AssociationID: associationID, InstanceID: instanceID}),
+				),
+				err: errors.Wrap(errBoom, errDisassociate),
+			},
+		},
 	}
 
 	for name, tc := range cases {
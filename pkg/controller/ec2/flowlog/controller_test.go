@@ -0,0 +1,492 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flowlog
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	flowLogID    = "fl-0123456789"
+	vpcID        = "vpc-0123456789"
+	logGroupName = "vpc-flow-logs"
+	roleARN      = "arn:aws:iam::123456789012:role/flow-logs"
+	errBoom      = errors.New("boom")
+)
+
+type flowLogModifier func(*v1alpha1.FlowLog)
+
+func withExternalName(name string) flowLogModifier {
+	return func(r *v1alpha1.FlowLog) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) flowLogModifier {
+	return func(r *v1alpha1.FlowLog) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.FlowLogParameters) flowLogModifier {
+	return func(r *v1alpha1.FlowLog) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.FlowLogObservation) flowLogModifier {
+	return func(r *v1alpha1.FlowLog) { r.Status.AtProvider = s }
+}
+
+func flowLog(m ...flowLogModifier) *v1alpha1.FlowLog {
+	cr := &v1alpha1.FlowLog{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specParams(tags ...v1beta1.Tag) v1alpha1.FlowLogParameters {
+	return v1alpha1.FlowLogParameters{
+		VPCID:                    aws.String(vpcID),
+		TrafficType:              string(awsec2.TrafficTypeAll),
+		LogDestinationType:       aws.String(v1alpha1.FlowLogDestinationTypeCloudWatchLogs),
+		LogGroupName:             aws.String(logGroupName),
+		DeliverLogsPermissionARN: aws.String(roleARN),
+		Tags:                     tags,
+	}
+}
+
+func describe(status string, tags ...awsec2.Tag) func(*awsec2.DescribeFlowLogsInput) awsec2.DescribeFlowLogsRequest {
+	return func(input *awsec2.DescribeFlowLogsInput) awsec2.DescribeFlowLogsRequest {
+		out := &awsec2.DescribeFlowLogsOutput{}
+		if status != "" {
+			out.FlowLogs = []awsec2.FlowLog{{
+				FlowLogId:                aws.String(flowLogID),
+				FlowLogStatus:            aws.String(status),
+				DeliverLogsStatus:        aws.String("SUCCESS"),
+				ResourceId:               aws.String(vpcID),
+				TrafficType:              awsec2.TrafficTypeAll,
+				LogDestinationType:       awsec2.LogDestinationTypeCloudWatchLogs,
+				LogGroupName:             aws.String(logGroupName),
+				DeliverLogsPermissionArn: aws.String(roleARN),
+				Tags:                     tags,
+			}}
+		}
+		return awsec2.DescribeFlowLogsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	flowLog ec2.FlowLogClient
+	kube    client.Client
+	cr      *v1alpha1.FlowLog
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.FlowLog
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{},
+				cr:      flowLog(),
+			},
+			want: want{
+				cr: flowLog(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{
+					MockDescribeFlowLogs: describe(""),
+				},
+				cr: flowLog(withExternalName(flowLogID)),
+			},
+			want: want{
+				cr: flowLog(withExternalName(flowLogID)),
+			},
+		},
+		"Available": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{
+					MockDescribeFlowLogs: describe("ACTIVE"),
+				},
+				cr: flowLog(withExternalName(flowLogID), withSpec(specParams())),
+			},
+			want: want{
+				cr: flowLog(withExternalName(flowLogID), withSpec(specParams()),
+					withStatus(v1alpha1.FlowLogObservation{FlowLogID: flowLogID, FlowLogStatus: "ACTIVE", DeliverLogsStatus: "SUCCESS"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{
+					MockDescribeFlowLogs: describe("ACTIVE"),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: flowLog(withExternalName(flowLogID), withSpec(v1alpha1.FlowLogParameters{
+					VPCID:        aws.String(vpcID),
+					TrafficType:  string(awsec2.TrafficTypeAll),
+					LogGroupName: aws.String(logGroupName),
+				})),
+			},
+			want: want{
+				cr: flowLog(withExternalName(flowLogID), withSpec(specParams()),
+					withStatus(v1alpha1.FlowLogObservation{FlowLogID: flowLogID, FlowLogStatus: "ACTIVE", DeliverLogsStatus: "SUCCESS"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{
+					MockDescribeFlowLogs: describe("ACTIVE", awsec2.Tag{Key: aws.String("k"), Value: aws.String("v1")}),
+				},
+				cr: flowLog(withExternalName(flowLogID), withSpec(specParams(v1beta1.Tag{Key: "k", Value: "v2"}))),
+			},
+			want: want{
+				cr: flowLog(withExternalName(flowLogID), withSpec(specParams(v1beta1.Tag{Key: "k", Value: "v2"})),
+					withStatus(v1alpha1.FlowLogObservation{FlowLogID: flowLogID, FlowLogStatus: "ACTIVE", DeliverLogsStatus: "SUCCESS"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{
+					MockDescribeFlowLogs: func(input *awsec2.DescribeFlowLogsInput) awsec2.DescribeFlowLogsRequest {
+						return awsec2.DescribeFlowLogsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: flowLog(withExternalName(flowLogID)),
+			},
+			want: want{
+				cr:  flowLog(withExternalName(flowLogID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.flowLog}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.FlowLog
+		result managed.ExternalCreation
+		err    error
+	}
+
+	createResponse := func(ids []string, u ...awsec2.UnsuccessfulItem) func(*awsec2.CreateFlowLogsInput) awsec2.CreateFlowLogsRequest {
+		return func(input *awsec2.CreateFlowLogsInput) awsec2.CreateFlowLogsRequest {
+			if diff := cmp.Diff([]string{vpcID}, input.ResourceIds); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awsec2.CreateFlowLogsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateFlowLogsOutput{
+					FlowLogIds:   ids,
+					Unsuccessful: u,
+				}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{
+					MockCreateFlowLogs: createResponse([]string{flowLogID}),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: flowLog(withSpec(specParams())),
+			},
+			want: want{
+				cr: flowLog(withExternalName(flowLogID), withSpec(specParams()),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateUnsuccessful": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{
+					MockCreateFlowLogs: createResponse(nil, awsec2.UnsuccessfulItem{
+						Error: &awsec2.UnsuccessfulItemError{Code: aws.String("InvalidParameter"), Message: aws.String(errBoom.Error())},
+					}),
+				},
+				cr: flowLog(withSpec(specParams())),
+			},
+			want: want{
+				cr: flowLog(withSpec(specParams()),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"NoResource": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{},
+				cr:      flowLog(withSpec(v1alpha1.FlowLogParameters{TrafficType: string(awsec2.TrafficTypeAll)})),
+			},
+			want: want{
+				cr: flowLog(withSpec(v1alpha1.FlowLogParameters{TrafficType: string(awsec2.TrafficTypeAll)}),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errors.New("exactly one of vpcId, subnetId and networkInterfaceId must be set"), errCreate),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{
+					MockCreateFlowLogs: func(input *awsec2.CreateFlowLogsInput) awsec2.CreateFlowLogsRequest {
+						return awsec2.CreateFlowLogsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: flowLog(withSpec(specParams())),
+			},
+			want: want{
+				cr: flowLog(withSpec(specParams()),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.flowLog}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.FlowLog
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"TagsUpdated": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{
+					MockDescribeFlowLogs: describe("ACTIVE", awsec2.Tag{Key: aws.String("old"), Value: aws.String("v")}),
+					MockDeleteTags: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTagsOutput{}},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						if diff := cmp.Diff([]string{flowLogID}, input.Resources); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+				},
+				cr: flowLog(withExternalName(flowLogID), withSpec(specParams(v1beta1.Tag{Key: "new", Value: "v"}))),
+			},
+			want: want{
+				cr: flowLog(withExternalName(flowLogID), withSpec(specParams(v1beta1.Tag{Key: "new", Value: "v"}))),
+			},
+		},
+		"CreateTagsFailed": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{
+					MockDescribeFlowLogs: describe("ACTIVE"),
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: flowLog(withExternalName(flowLogID), withSpec(specParams(v1beta1.Tag{Key: "new", Value: "v"}))),
+			},
+			want: want{
+				cr:  flowLog(withExternalName(flowLogID), withSpec(specParams(v1beta1.Tag{Key: "new", Value: "v"}))),
+				err: errors.Wrap(errBoom, errUpdateTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.flowLog}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.FlowLog
+		err error
+	}
+
+	deleteResponse := func(u ...awsec2.UnsuccessfulItem) func(*awsec2.DeleteFlowLogsInput) awsec2.DeleteFlowLogsRequest {
+		return func(input *awsec2.DeleteFlowLogsInput) awsec2.DeleteFlowLogsRequest {
+			return awsec2.DeleteFlowLogsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteFlowLogsOutput{
+					Unsuccessful: u,
+				}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{
+					MockDeleteFlowLogs: deleteResponse(),
+				},
+				cr: flowLog(withExternalName(flowLogID)),
+			},
+			want: want{
+				cr: flowLog(withExternalName(flowLogID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{
+					MockDeleteFlowLogs: deleteResponse(awsec2.UnsuccessfulItem{
+						Error: &awsec2.UnsuccessfulItemError{Code: aws.String(ec2.FlowLogIDNotFound)},
+					}),
+				},
+				cr: flowLog(withExternalName(flowLogID)),
+			},
+			want: want{
+				cr: flowLog(withExternalName(flowLogID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				flowLog: &fake.MockFlowLogClient{
+					MockDeleteFlowLogs: func(input *awsec2.DeleteFlowLogsInput) awsec2.DeleteFlowLogsRequest {
+						return awsec2.DeleteFlowLogsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: flowLog(withExternalName(flowLogID)),
+			},
+			want: want{
+				cr:  flowLog(withExternalName(flowLogID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.flowLog}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
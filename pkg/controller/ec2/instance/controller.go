@@ -0,0 +1,265 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an Instance resource"
+	errDescribe         = "failed to describe Instance"
+	errNotSingleItem    = "either no or multiple Instances retrieved for the given instanceId"
+	errSpecUpdate       = "cannot update spec of the Instance resource"
+	errCreate           = "failed to create the Instance resource"
+	errModifyGroups     = "failed to modify the security groups of the Instance resource"
+	errStart            = "failed to start the Instance resource"
+	errStop             = "failed to stop the Instance resource"
+	errDelete           = "failed to delete the Instance resource"
+	errUpdateTags       = "failed to update tags for the Instance resource"
+	errDeleteTags       = "failed to delete tags for Instance resource"
+)
+
+// SetupInstance adds a controller that reconciles Instances.
+func SetupInstance(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.InstanceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Instance{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.InstanceGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithMaintenanceWindow(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewInstanceClient}, awscommon.DeletionTierWorkload)), mgr.GetClient()))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.InstanceClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.InstanceClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.Instance, error) {
+	response, err := e.client.DescribeInstancesRequest(&awsec2.DescribeInstancesInput{
+		InstanceIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.Reservations) != 1 || len(response.Reservations[0].Instances) != 1 {
+		return nil, errors.New(errNotSingleItem)
+	}
+	return &response.Reservations[0].Instances[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsInstanceNotFoundErr, err), errDescribe)
+	}
+
+	// Terminated instances stay visible for a while after their deletion.
+	if observed.State != nil && observed.State.Name == awsec2.InstanceStateNameTerminated {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeInstance(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateInstanceObservation(*observed)
+
+	switch cr.Status.AtProvider.State {
+	case string(awsec2.InstanceStateNamePending):
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case string(awsec2.InstanceStateNameShuttingDown):
+		cr.SetConditions(runtimev1alpha1.Deleting())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	case ec2.DesiredInstanceState(cr.Spec.ForProvider):
+		cr.SetConditions(runtimev1alpha1.Available())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	// Stopping the instance is its only disruptive update; security groups
+	// and tags change without interrupting it.
+	if !ec2.IsInstanceStateUpToDate(cr.Spec.ForProvider, *observed) && ec2.DesiredInstanceState(cr.Spec.ForProvider) == v1alpha1.InstanceStateStopped {
+		awscommon.MarkUpdateDisruptive(ctx)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsInstanceUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	rsp, err := e.client.RunInstancesRequest(ec2.GenerateRunInstancesInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if len(rsp.Instances) != 1 {
+		return managed.ExternalCreation{}, errors.New(errNotSingleItem)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.Instances[0].InstanceId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	id := meta.GetExternalName(cr)
+	observed, err := e.describe(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(ec2.IsInstanceNotFoundErr, err), errDescribe)
+	}
+
+	if !ec2.AreInstanceSecurityGroupsUpToDate(cr.Spec.ForProvider, *observed) {
+		if _, err := e.client.ModifyInstanceAttributeRequest(&awsec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			Groups:     cr.Spec.ForProvider.SecurityGroupIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyGroups)
+		}
+	}
+
+	// Instances that are pending or stopping are left alone until they
+	// settle in a state they can be started or stopped from.
+	if observed.State != nil {
+		switch desired := ec2.DesiredInstanceState(cr.Spec.ForProvider); {
+		case desired == v1alpha1.InstanceStateStopped && observed.State.Name == awsec2.InstanceStateNameRunning:
+			if _, err := e.client.StopInstancesRequest(&awsec2.StopInstancesInput{
+				InstanceIds: []string{id},
+			}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errStop)
+			}
+		case desired == v1alpha1.InstanceStateRunning && observed.State.Name == awsec2.InstanceStateNameStopped:
+			if _, err := e.client.StartInstancesRequest(&awsec2.StartInstancesInput{
+				InstanceIds: []string{id},
+			}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errStart)
+			}
+		}
+	}
+
+	addTags, removeTags := awscommon.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.TerminateInstancesRequest(&awsec2.TerminateInstancesInput{
+		InstanceIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(ec2.IsInstanceNotFoundErr, err), errDelete)
+}
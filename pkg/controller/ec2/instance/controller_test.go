@@ -0,0 +1,636 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	instanceID   = "i-0123456789"
+	imageID      = "ami-0123456789"
+	instanceType = "t3.micro"
+	subnetID     = "subnet-0123456789"
+	sgID         = "sg-0123456789"
+	newSGID      = "sg-9876543210"
+	privateIP    = "10.0.0.10"
+	errBoom      = errors.New("boom")
+)
+
+type instanceModifier func(*v1alpha1.Instance)
+
+func withExternalName(name string) instanceModifier {
+	return func(r *v1alpha1.Instance) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.InstanceParameters) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.InstanceObservation) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Status.AtProvider = s }
+}
+
+func instance(m ...instanceModifier) *v1alpha1.Instance {
+	cr := &v1alpha1.Instance{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specParams(state string, sgs ...string) v1alpha1.InstanceParameters {
+	return v1alpha1.InstanceParameters{
+		ImageID:          imageID,
+		InstanceType:     instanceType,
+		SubnetID:         aws.String(subnetID),
+		SecurityGroupIDs: sgs,
+		State:            aws.String(state),
+		Tags:             []v1beta1.Tag{{Key: "key1", Value: "value1"}},
+	}
+}
+
+func observedInstance(state awsec2.InstanceStateName, tags []awsec2.Tag) awsec2.Instance {
+	return awsec2.Instance{
+		InstanceId:       aws.String(instanceID),
+		ImageId:          aws.String(imageID),
+		InstanceType:     awsec2.InstanceType(instanceType),
+		SubnetId:         aws.String(subnetID),
+		PrivateIpAddress: aws.String(privateIP),
+		SecurityGroups:   []awsec2.GroupIdentifier{{GroupId: aws.String(sgID)}},
+		State:            &awsec2.InstanceState{Name: state},
+		Tags:             tags,
+	}
+}
+
+func instanceTags() []awsec2.Tag {
+	return []awsec2.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}}
+}
+
+func observation(state awsec2.InstanceStateName) v1alpha1.InstanceObservation {
+	return v1alpha1.InstanceObservation{
+		InstanceID:       instanceID,
+		State:            string(state),
+		PrivateIPAddress: privateIP,
+	}
+}
+
+func describe(state awsec2.InstanceStateName, tags []awsec2.Tag) func(*awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+	return func(input *awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+		return awsec2.DescribeInstancesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeInstancesOutput{
+				Reservations: []awsec2.Reservation{{Instances: []awsec2.Instance{observedInstance(state, tags)}}},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	instance ec2.InstanceClient
+	kube     client.Client
+	cr       *v1alpha1.Instance
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Instance
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				instance: &fake.MockInstanceClient{},
+				cr:       instance(),
+			},
+			want: want{
+				cr: instance(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(input *awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+						return awsec2.DescribeInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.InstanceIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID)),
+			},
+		},
+		"Terminated": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describe(awsec2.InstanceStateNameTerminated, instanceTags()),
+				},
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID))),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID))),
+			},
+		},
+		"Available": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describe(awsec2.InstanceStateNameRunning, instanceTags()),
+				},
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID))),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID)),
+					withStatus(observation(awsec2.InstanceStateNameRunning)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describe(awsec2.InstanceStateNamePending, instanceTags()),
+				},
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID))),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID)),
+					withStatus(observation(awsec2.InstanceStateNamePending)),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"StopRequested": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describe(awsec2.InstanceStateNameRunning, instanceTags()),
+				},
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateStopped, sgID))),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateStopped, sgID)),
+					withStatus(observation(awsec2.InstanceStateNameRunning)),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Stopped": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describe(awsec2.InstanceStateNameStopped, instanceTags()),
+				},
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateStopped, sgID))),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateStopped, sgID)),
+					withStatus(observation(awsec2.InstanceStateNameStopped)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ShuttingDown": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describe(awsec2.InstanceStateNameShuttingDown, instanceTags()),
+				},
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID))),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID)),
+					withStatus(observation(awsec2.InstanceStateNameShuttingDown)),
+					withConditions(runtimev1alpha1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describe(awsec2.InstanceStateNameRunning, instanceTags()),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: instance(withExternalName(instanceID), withSpec(v1alpha1.InstanceParameters{ImageID: imageID, InstanceType: instanceType})),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(v1alpha1.InstanceParameters{
+					ImageID:          imageID,
+					InstanceType:     instanceType,
+					SubnetID:         aws.String(subnetID),
+					SecurityGroupIDs: []string{sgID},
+					Tags:             []v1beta1.Tag{{Key: "key1", Value: "value1"}},
+				})),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(input *awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+						return awsec2.DescribeInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr:  instance(withExternalName(instanceID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.instance}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Instance
+		result managed.ExternalCreation
+		err    error
+	}
+
+	run := func(input *awsec2.RunInstancesInput) awsec2.RunInstancesRequest {
+		return awsec2.RunInstancesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.RunInstancesOutput{
+				Instances: []awsec2.Instance{{InstanceId: aws.String(instanceID)}},
+			}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockRunInstances: run,
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: instance(withSpec(specParams(v1alpha1.InstanceStateRunning, sgID))),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID)),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockRunInstances: func(input *awsec2.RunInstancesInput) awsec2.RunInstancesRequest {
+						return awsec2.RunInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withSpec(specParams(v1alpha1.InstanceStateRunning, sgID))),
+			},
+			want: want{
+				cr: instance(withSpec(specParams(v1alpha1.InstanceStateRunning, sgID)),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"SpecUpdateFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockRunInstances: run,
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: instance(withSpec(specParams(v1alpha1.InstanceStateRunning, sgID))),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID)),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.instance}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Instance
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Stop": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describe(awsec2.InstanceStateNameRunning, instanceTags()),
+					MockStopInstances: func(input *awsec2.StopInstancesInput) awsec2.StopInstancesRequest {
+						if diff := cmp.Diff([]string{instanceID}, input.InstanceIds); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.StopInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.StopInstancesOutput{}},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateStopped, sgID))),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateStopped, sgID))),
+			},
+		},
+		"Start": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describe(awsec2.InstanceStateNameStopped, instanceTags()),
+					MockStartInstances: func(input *awsec2.StartInstancesInput) awsec2.StartInstancesRequest {
+						return awsec2.StartInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.StartInstancesOutput{}},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID))),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID))),
+			},
+		},
+		"StopFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describe(awsec2.InstanceStateNameRunning, instanceTags()),
+					MockStopInstances: func(input *awsec2.StopInstancesInput) awsec2.StopInstancesRequest {
+						return awsec2.StopInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateStopped, sgID))),
+			},
+			want: want{
+				cr:  instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateStopped, sgID))),
+				err: errors.Wrap(errBoom, errStop),
+			},
+		},
+		"SecurityGroupsModified": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describe(awsec2.InstanceStateNameRunning, instanceTags()),
+					MockModifyInstanceAttribute: func(input *awsec2.ModifyInstanceAttributeInput) awsec2.ModifyInstanceAttributeRequest {
+						if diff := cmp.Diff([]string{sgID, newSGID}, input.Groups); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyInstanceAttributeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyInstanceAttributeOutput{}},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID, newSGID))),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID, newSGID))),
+			},
+		},
+		"ModifySecurityGroupsFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describe(awsec2.InstanceStateNameRunning, instanceTags()),
+					MockModifyInstanceAttribute: func(input *awsec2.ModifyInstanceAttributeInput) awsec2.ModifyInstanceAttributeRequest {
+						return awsec2.ModifyInstanceAttributeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, newSGID))),
+			},
+			want: want{
+				cr:  instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, newSGID))),
+				err: errors.Wrap(errBoom, errModifyGroups),
+			},
+		},
+		"TagsUpdated": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describe(awsec2.InstanceStateNameRunning, []awsec2.Tag{{Key: aws.String("old"), Value: aws.String("value")}}),
+					MockDeleteTags: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTagsOutput{}},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID))),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID))),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(input *awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+						return awsec2.DescribeInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID))),
+			},
+			want: want{
+				cr:  instance(withExternalName(instanceID), withSpec(specParams(v1alpha1.InstanceStateRunning, sgID))),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.instance}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Instance
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockTerminateInstances: func(input *awsec2.TerminateInstancesInput) awsec2.TerminateInstancesRequest {
+						return awsec2.TerminateInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.TerminateInstancesOutput{}},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockTerminateInstances: func(input *awsec2.TerminateInstancesInput) awsec2.TerminateInstancesRequest {
+						return awsec2.TerminateInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.InstanceIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockTerminateInstances: func(input *awsec2.TerminateInstancesInput) awsec2.TerminateInstancesRequest {
+						return awsec2.TerminateInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr:  instance(withExternalName(instanceID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.instance}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package launchtemplate
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a LaunchTemplate resource"
+	errDescribe         = "failed to describe LaunchTemplate"
+	errDescribeVersion  = "failed to describe the default version of the LaunchTemplate"
+	errNotSingleItem    = "either no or multiple LaunchTemplates retrieved for the given launchTemplateId"
+	errSpecUpdate       = "cannot update spec of the LaunchTemplate resource"
+	errCreate           = "failed to create the LaunchTemplate resource"
+	errCreateVersion    = "failed to create a new version of the LaunchTemplate resource"
+	errModify           = "failed to set the default version of the LaunchTemplate resource"
+	errDelete           = "failed to delete the LaunchTemplate resource"
+	errUpdateTags       = "failed to update tags for the LaunchTemplate resource"
+	errDeleteTags       = "failed to delete tags for LaunchTemplate resource"
+
+	// defaultVersion selects the default version of a launch template.
+	defaultVersion = "$Default"
+)
+
+// SetupLaunchTemplate adds a controller that reconciles LaunchTemplates.
+func SetupLaunchTemplate(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.LaunchTemplateGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LaunchTemplate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LaunchTemplateGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewLaunchTemplateClient}, awscommon.DeletionTierAttachment)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.LaunchTemplateClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.LaunchTemplateClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.LaunchTemplate, error) {
+	response, err := e.client.DescribeLaunchTemplatesRequest(&awsec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.LaunchTemplates) != 1 {
+		return nil, errors.New(errNotSingleItem)
+	}
+	return &response.LaunchTemplates[0], nil
+}
+
+func (e *external) describeDefaultVersion(ctx context.Context, id string) (*awsec2.LaunchTemplateVersion, error) {
+	response, err := e.client.DescribeLaunchTemplateVersionsRequest(&awsec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(id),
+		Versions:         []string{defaultVersion},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.LaunchTemplateVersions) != 1 {
+		return nil, errors.New(errNotSingleItem)
+	}
+	return &response.LaunchTemplateVersions[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsLaunchTemplateNotFoundErr, err), errDescribe)
+	}
+
+	version, err := e.describeDefaultVersion(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeVersion)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeLaunchTemplate(&cr.Spec.ForProvider, observed, version)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateLaunchTemplateObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: ec2.IsLaunchTemplateDataUpToDate(cr.Spec.ForProvider, *version) &&
+			v1beta1.CompareTags(cr.Spec.ForProvider.Tags, observed.Tags),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	lt, err := e.client.CreateLaunchTemplateRequest(ec2.GenerateCreateLaunchTemplateInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(lt.LaunchTemplate.LaunchTemplateId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	id := meta.GetExternalName(cr)
+	observed, err := e.describe(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(ec2.IsLaunchTemplateNotFoundErr, err), errDescribe)
+	}
+	version, err := e.describeDefaultVersion(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeVersion)
+	}
+
+	// Launch template versions are immutable, so a change of the data is
+	// rolled out as a new version that becomes the default one.
+	if !ec2.IsLaunchTemplateDataUpToDate(cr.Spec.ForProvider, *version) {
+		rsp, err := e.client.CreateLaunchTemplateVersionRequest(&awsec2.CreateLaunchTemplateVersionInput{
+			LaunchTemplateId:   aws.String(id),
+			VersionDescription: cr.Spec.ForProvider.VersionDescription,
+			LaunchTemplateData: ec2.GenerateRequestLaunchTemplateData(cr.Spec.ForProvider.LaunchTemplateData),
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateVersion)
+		}
+		if _, err := e.client.ModifyLaunchTemplateRequest(&awsec2.ModifyLaunchTemplateInput{
+			LaunchTemplateId: aws.String(id),
+			DefaultVersion:   aws.String(strconv.FormatInt(aws.Int64Value(rsp.LaunchTemplateVersion.VersionNumber), 10)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+		}
+	}
+
+	addTags, removeTags := awscommon.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteLaunchTemplateRequest(&awsec2.DeleteLaunchTemplateInput{
+		LaunchTemplateId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(ec2.IsLaunchTemplateNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,608 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package launchtemplate
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	ltID         = "lt-0123456789"
+	ltName       = "sample-launchtemplate"
+	imageID      = "ami-0123456789"
+	instanceType = "t3.micro"
+	newType      = "t3.large"
+	required     = "required"
+	errBoom      = errors.New("boom")
+)
+
+type ltModifier func(*v1alpha1.LaunchTemplate)
+
+func withExternalName(name string) ltModifier {
+	return func(r *v1alpha1.LaunchTemplate) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) ltModifier {
+	return func(r *v1alpha1.LaunchTemplate) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.LaunchTemplateParameters) ltModifier {
+	return func(r *v1alpha1.LaunchTemplate) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.LaunchTemplateObservation) ltModifier {
+	return func(r *v1alpha1.LaunchTemplate) { r.Status.AtProvider = s }
+}
+
+func lt(m ...ltModifier) *v1alpha1.LaunchTemplate {
+	cr := &v1alpha1.LaunchTemplate{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specTags() []v1beta1.Tag {
+	return []v1beta1.Tag{{Key: "key1", Value: "value1"}}
+}
+
+func ltTags() []awsec2.Tag {
+	return []awsec2.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}}
+}
+
+func specParams(instanceType string) v1alpha1.LaunchTemplateParameters {
+	return v1alpha1.LaunchTemplateParameters{
+		LaunchTemplateName: ltName,
+		LaunchTemplateData: v1alpha1.LaunchTemplateData{
+			ImageID:      aws.String(imageID),
+			InstanceType: aws.String(instanceType),
+			MetadataOptions: &v1alpha1.LaunchTemplateMetadataOptions{
+				HTTPTokens: aws.String(required),
+			},
+		},
+		Tags: specTags(),
+	}
+}
+
+func describeOutput(tags []awsec2.Tag) *awsec2.DescribeLaunchTemplatesOutput {
+	return &awsec2.DescribeLaunchTemplatesOutput{
+		LaunchTemplates: []awsec2.LaunchTemplate{
+			{
+				LaunchTemplateId:     aws.String(ltID),
+				LaunchTemplateName:   aws.String(ltName),
+				DefaultVersionNumber: aws.Int64(1),
+				LatestVersionNumber:  aws.Int64(1),
+				Tags:                 tags,
+			},
+		},
+	}
+}
+
+func describeVersionsOutput() *awsec2.DescribeLaunchTemplateVersionsOutput {
+	return &awsec2.DescribeLaunchTemplateVersionsOutput{
+		LaunchTemplateVersions: []awsec2.LaunchTemplateVersion{
+			{
+				LaunchTemplateId: aws.String(ltID),
+				VersionNumber:    aws.Int64(1),
+				DefaultVersion:   aws.Bool(true),
+				LaunchTemplateData: &awsec2.ResponseLaunchTemplateData{
+					ImageId:      aws.String(imageID),
+					InstanceType: awsec2.InstanceType(instanceType),
+					MetadataOptions: &awsec2.LaunchTemplateInstanceMetadataOptions{
+						HttpTokens: awsec2.LaunchTemplateHttpTokensStateRequired,
+					},
+				},
+			},
+		},
+	}
+}
+
+func observation() v1alpha1.LaunchTemplateObservation {
+	return v1alpha1.LaunchTemplateObservation{
+		LaunchTemplateID:     ltID,
+		DefaultVersionNumber: 1,
+		LatestVersionNumber:  1,
+	}
+}
+
+func describe(tags []awsec2.Tag) func(*awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+	return func(input *awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+		return awsec2.DescribeLaunchTemplatesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(tags)},
+		}
+	}
+}
+
+func describeVersions(input *awsec2.DescribeLaunchTemplateVersionsInput) awsec2.DescribeLaunchTemplateVersionsRequest {
+	return awsec2.DescribeLaunchTemplateVersionsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeVersionsOutput()},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	lt   ec2.LaunchTemplateClient
+	kube client.Client
+	cr   *v1alpha1.LaunchTemplate
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LaunchTemplate
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{},
+				cr: lt(),
+			},
+			want: want{
+				cr: lt(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribeLaunchTemplates: func(input *awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+						return awsec2.DescribeLaunchTemplatesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.LaunchTemplateIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID)),
+			},
+			want: want{
+				cr: lt(withExternalName(ltID)),
+			},
+		},
+		"Available": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribeLaunchTemplates:        describe(ltTags()),
+					MockDescribeLaunchTemplateVersions: describeVersions,
+				},
+				cr: lt(withExternalName(ltID), withSpec(specParams(instanceType))),
+			},
+			want: want{
+				cr: lt(withExternalName(ltID), withSpec(specParams(instanceType)),
+					withStatus(observation()),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DataChanged": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribeLaunchTemplates:        describe(ltTags()),
+					MockDescribeLaunchTemplateVersions: describeVersions,
+				},
+				cr: lt(withExternalName(ltID), withSpec(specParams(newType))),
+			},
+			want: want{
+				cr: lt(withExternalName(ltID), withSpec(specParams(newType)),
+					withStatus(observation()),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribeLaunchTemplates:        describe(ltTags()),
+					MockDescribeLaunchTemplateVersions: describeVersions,
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: lt(withExternalName(ltID), withSpec(v1alpha1.LaunchTemplateParameters{LaunchTemplateName: ltName})),
+			},
+			want: want{
+				cr:  lt(withExternalName(ltID), withSpec(specParams(instanceType))),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"DescribeVersionFailed": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribeLaunchTemplates: describe(ltTags()),
+					MockDescribeLaunchTemplateVersions: func(input *awsec2.DescribeLaunchTemplateVersionsInput) awsec2.DescribeLaunchTemplateVersionsRequest {
+						return awsec2.DescribeLaunchTemplateVersionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID)),
+			},
+			want: want{
+				cr:  lt(withExternalName(ltID)),
+				err: errors.Wrap(errBoom, errDescribeVersion),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribeLaunchTemplates: func(input *awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+						return awsec2.DescribeLaunchTemplatesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID)),
+			},
+			want: want{
+				cr:  lt(withExternalName(ltID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lt}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LaunchTemplate
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockCreateLaunchTemplate: func(input *awsec2.CreateLaunchTemplateInput) awsec2.CreateLaunchTemplateRequest {
+						return awsec2.CreateLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateLaunchTemplateOutput{
+								LaunchTemplate: &awsec2.LaunchTemplate{LaunchTemplateId: aws.String(ltID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: lt(withSpec(specParams(instanceType))),
+			},
+			want: want{
+				cr: lt(withExternalName(ltID), withSpec(specParams(instanceType)),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockCreateLaunchTemplate: func(input *awsec2.CreateLaunchTemplateInput) awsec2.CreateLaunchTemplateRequest {
+						return awsec2.CreateLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lt(withSpec(specParams(instanceType))),
+			},
+			want: want{
+				cr: lt(withSpec(specParams(instanceType)),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"SpecUpdateFailed": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockCreateLaunchTemplate: func(input *awsec2.CreateLaunchTemplateInput) awsec2.CreateLaunchTemplateRequest {
+						return awsec2.CreateLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateLaunchTemplateOutput{
+								LaunchTemplate: &awsec2.LaunchTemplate{LaunchTemplateId: aws.String(ltID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: lt(withSpec(specParams(instanceType))),
+			},
+			want: want{
+				cr: lt(withExternalName(ltID), withSpec(specParams(instanceType)),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lt}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LaunchTemplate
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NewDefaultVersion": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribeLaunchTemplates:        describe(ltTags()),
+					MockDescribeLaunchTemplateVersions: describeVersions,
+					MockCreateLaunchTemplateVersion: func(input *awsec2.CreateLaunchTemplateVersionInput) awsec2.CreateLaunchTemplateVersionRequest {
+						if diff := cmp.Diff(newType, string(input.LaunchTemplateData.InstanceType)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateLaunchTemplateVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateLaunchTemplateVersionOutput{
+								LaunchTemplateVersion: &awsec2.LaunchTemplateVersion{VersionNumber: aws.Int64(2)},
+							}},
+						}
+					},
+					MockModifyLaunchTemplate: func(input *awsec2.ModifyLaunchTemplateInput) awsec2.ModifyLaunchTemplateRequest {
+						if diff := cmp.Diff("2", aws.StringValue(input.DefaultVersion)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyLaunchTemplateOutput{}},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID), withSpec(specParams(newType))),
+			},
+			want: want{
+				cr: lt(withExternalName(ltID), withSpec(specParams(newType))),
+			},
+		},
+		"CreateVersionFailed": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribeLaunchTemplates:        describe(ltTags()),
+					MockDescribeLaunchTemplateVersions: describeVersions,
+					MockCreateLaunchTemplateVersion: func(input *awsec2.CreateLaunchTemplateVersionInput) awsec2.CreateLaunchTemplateVersionRequest {
+						return awsec2.CreateLaunchTemplateVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID), withSpec(specParams(newType))),
+			},
+			want: want{
+				cr:  lt(withExternalName(ltID), withSpec(specParams(newType))),
+				err: errors.Wrap(errBoom, errCreateVersion),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribeLaunchTemplates:        describe(ltTags()),
+					MockDescribeLaunchTemplateVersions: describeVersions,
+					MockCreateLaunchTemplateVersion: func(input *awsec2.CreateLaunchTemplateVersionInput) awsec2.CreateLaunchTemplateVersionRequest {
+						return awsec2.CreateLaunchTemplateVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateLaunchTemplateVersionOutput{
+								LaunchTemplateVersion: &awsec2.LaunchTemplateVersion{VersionNumber: aws.Int64(2)},
+							}},
+						}
+					},
+					MockModifyLaunchTemplate: func(input *awsec2.ModifyLaunchTemplateInput) awsec2.ModifyLaunchTemplateRequest {
+						return awsec2.ModifyLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID), withSpec(specParams(newType))),
+			},
+			want: want{
+				cr:  lt(withExternalName(ltID), withSpec(specParams(newType))),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+		"TagsUpdated": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribeLaunchTemplates:        describe([]awsec2.Tag{{Key: aws.String("old"), Value: aws.String("value")}}),
+					MockDescribeLaunchTemplateVersions: describeVersions,
+					MockDeleteTags: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTagsOutput{}},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID), withSpec(specParams(instanceType))),
+			},
+			want: want{
+				cr: lt(withExternalName(ltID), withSpec(specParams(instanceType))),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribeLaunchTemplates: func(input *awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+						return awsec2.DescribeLaunchTemplatesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID), withSpec(specParams(instanceType))),
+			},
+			want: want{
+				cr:  lt(withExternalName(ltID), withSpec(specParams(instanceType))),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lt}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.LaunchTemplate
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDeleteLaunchTemplate: func(input *awsec2.DeleteLaunchTemplateInput) awsec2.DeleteLaunchTemplateRequest {
+						return awsec2.DeleteLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteLaunchTemplateOutput{}},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID)),
+			},
+			want: want{
+				cr: lt(withExternalName(ltID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDeleteLaunchTemplate: func(input *awsec2.DeleteLaunchTemplateInput) awsec2.DeleteLaunchTemplateRequest {
+						return awsec2.DeleteLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.LaunchTemplateIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID)),
+			},
+			want: want{
+				cr: lt(withExternalName(ltID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDeleteLaunchTemplate: func(input *awsec2.DeleteLaunchTemplateInput) awsec2.DeleteLaunchTemplateRequest {
+						return awsec2.DeleteLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID)),
+			},
+			want: want{
+				cr:  lt(withExternalName(ltID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lt}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
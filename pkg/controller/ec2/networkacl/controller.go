@@ -0,0 +1,346 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject    = "The managed resource is not a NetworkACL resource"
+	errDescribe            = "failed to describe NetworkACL"
+	errMultipleItems       = "retrieved multiple NetworkACLs for the given networkAclId"
+	errSpecUpdate          = "cannot update spec of the NetworkACL resource"
+	errCreate              = "failed to create the NetworkACL resource"
+	errDelete              = "failed to delete the NetworkACL resource"
+	errCreateEntry         = "failed to create an entry of the NetworkACL resource"
+	errReplaceEntry        = "failed to replace an entry of the NetworkACL resource"
+	errDeleteEntry         = "failed to delete an entry of the NetworkACL resource"
+	errAssociateSubnet     = "failed to associate subnet %v to the NetworkACL resource"
+	errDisassociateSubnet  = "failed to disassociate subnet %v from the NetworkACL resource"
+	errDescribeAssociation = "failed to describe the network ACL association of subnet %v"
+	errAssociationNotFound = "cannot find the network ACL association of subnet %v"
+	errDescribeDefault     = "failed to describe the default network ACL of the VPC"
+	errDefaultNotFound     = "cannot find the default network ACL of the VPC"
+	errUpdateTags          = "failed to update tags for the NetworkACL resource"
+	errDeleteTags          = "failed to delete tags for NetworkACL resource"
+)
+
+// SetupNetworkACL adds a controller that reconciles NetworkACLs.
+func SetupNetworkACL(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.NetworkACLGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.NetworkACL{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.NetworkACLGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewNetworkACLClient}, awscommon.DeletionTierNetwork)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.NetworkACLClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.NetworkACL)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.NetworkACLClient
+}
+
+// describe returns the network ACL with the given ID, or nil if it does not
+// exist.
+func (e *external) describe(ctx context.Context, id string) (*awsec2.NetworkAcl, error) {
+	response, err := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, resource.Ignore(ec2.IsNetworkACLNotFoundErr, err)
+	}
+
+	switch len(response.NetworkAcls) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &response.NetworkAcls[0], nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeNetworkACL(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateNetworkACLObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsNetworkACLUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	// The entries, associations and tags of the network ACL are applied by
+	// the subsequent updates.
+	result, err := e.client.CreateNetworkAclRequest(&awsec2.CreateNetworkAclInput{
+		VpcId: cr.Spec.ForProvider.VPCID,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if result.NetworkAcl == nil {
+		return managed.ExternalCreation{}, errors.New(errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(result.NetworkAcl.NetworkAclId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	id := meta.GetExternalName(cr)
+	observed, err := e.describe(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if err := e.updateEntries(ctx, id, cr.Spec.ForProvider.Entries, observed.Entries); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	add, remove := ec2.DiffNetworkACLAssociations(cr.Spec.ForProvider, *observed)
+	if err := e.associate(ctx, id, add); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.disassociate(ctx, *observed, remove); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	addTags, removeTags := awscommon.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.NetworkACL)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return errors.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return nil
+	}
+
+	// A network ACL cannot be deleted while it is associated with subnets.
+	subnets := make([]string, len(observed.Associations))
+	for i, a := range observed.Associations {
+		subnets[i] = aws.StringValue(a.SubnetId)
+	}
+	if err := e.disassociate(ctx, *observed, subnets); err != nil {
+		return err
+	}
+
+	_, err = e.client.DeleteNetworkAclRequest(&awsec2.DeleteNetworkAclInput{
+		NetworkAclId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDelete)
+}
+
+func (e *external) updateEntries(ctx context.Context, id string, desired []v1alpha1.NetworkACLEntry, observed []awsec2.NetworkAclEntry) error {
+	create, replace, remove := ec2.DiffNetworkACLEntries(desired, observed)
+	for _, en := range remove {
+		_, err := e.client.DeleteNetworkAclEntryRequest(&awsec2.DeleteNetworkAclEntryInput{
+			NetworkAclId: aws.String(id),
+			Egress:       en.Egress,
+			RuleNumber:   en.RuleNumber,
+		}).Send(ctx)
+		if resource.Ignore(ec2.IsNetworkACLEntryNotFoundErr, err) != nil {
+			return errors.Wrap(err, errDeleteEntry)
+		}
+	}
+	for _, en := range replace {
+		if _, err := e.client.ReplaceNetworkAclEntryRequest(ec2.GenerateReplaceNetworkACLEntryInput(id, en)).Send(ctx); err != nil {
+			return errors.Wrap(err, errReplaceEntry)
+		}
+	}
+	for _, en := range create {
+		if _, err := e.client.CreateNetworkAclEntryRequest(ec2.GenerateCreateNetworkACLEntryInput(id, en)).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateEntry)
+		}
+	}
+	return nil
+}
+
+// associate associates the given subnets with the network ACL. Every subnet
+// is always associated with exactly one network ACL, so its current
+// association is replaced.
+func (e *external) associate(ctx context.Context, id string, subnets []string) error {
+	for _, s := range subnets {
+		response, err := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+			Filters: []awsec2.Filter{{Name: aws.String("association.subnet-id"), Values: []string{s}}},
+		}).Send(ctx)
+		if err != nil {
+			return errors.Wrapf(err, errDescribeAssociation, s)
+		}
+		associationID := ec2.FindNetworkACLAssociationID(response.NetworkAcls, s)
+		if associationID == "" {
+			return errors.Errorf(errAssociationNotFound, s)
+		}
+		if _, err := e.client.ReplaceNetworkAclAssociationRequest(&awsec2.ReplaceNetworkAclAssociationInput{
+			AssociationId: aws.String(associationID),
+			NetworkAclId:  aws.String(id),
+		}).Send(ctx); err != nil {
+			return errors.Wrapf(err, errAssociateSubnet, s)
+		}
+	}
+	return nil
+}
+
+// disassociate associates the given subnets with the default network ACL of
+// the VPC again.
+func (e *external) disassociate(ctx context.Context, acl awsec2.NetworkAcl, subnets []string) error {
+	if len(subnets) == 0 {
+		return nil
+	}
+	response, err := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{aws.StringValue(acl.VpcId)}},
+			{Name: aws.String("default"), Values: []string{"true"}},
+		},
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(err, errDescribeDefault)
+	}
+	if len(response.NetworkAcls) == 0 {
+		return errors.New(errDefaultNotFound)
+	}
+	defaultID := response.NetworkAcls[0].NetworkAclId
+
+	for _, s := range subnets {
+		associationID := ec2.FindNetworkACLAssociationID([]awsec2.NetworkAcl{acl}, s)
+		if associationID == "" {
+			continue
+		}
+		if _, err := e.client.ReplaceNetworkAclAssociationRequest(&awsec2.ReplaceNetworkAclAssociationInput{
+			AssociationId: aws.String(associationID),
+			NetworkAclId:  defaultID,
+		}).Send(ctx); err != nil {
+			return errors.Wrapf(err, errDisassociateSubnet, s)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,537 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	networkACLID        = "acl-0123456789"
+	defaultNetworkACLID = "acl-default"
+	vpcID               = "vpc-0123456789"
+	subnetID            = "subnet-0123456789"
+	associationID       = "aclassoc-0123456789"
+	errBoom             = errors.New("boom")
+
+	entry = v1alpha1.NetworkACLEntry{
+		RuleNumber: 100,
+		Protocol:   "6",
+		RuleAction: "allow",
+		CIDRBlock:  aws.String("0.0.0.0/0"),
+		PortRange:  &v1alpha1.PortRange{From: 443, To: 443},
+	}
+)
+
+type networkACLModifier func(*v1alpha1.NetworkACL)
+
+func withExternalName(name string) networkACLModifier {
+	return func(r *v1alpha1.NetworkACL) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) networkACLModifier {
+	return func(r *v1alpha1.NetworkACL) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.NetworkACLParameters) networkACLModifier {
+	return func(r *v1alpha1.NetworkACL) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.NetworkACLObservation) networkACLModifier {
+	return func(r *v1alpha1.NetworkACL) { r.Status.AtProvider = s }
+}
+
+func networkACL(m ...networkACLModifier) *v1alpha1.NetworkACL {
+	cr := &v1alpha1.NetworkACL{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specParams(entries []v1alpha1.NetworkACLEntry, subnets ...string) v1alpha1.NetworkACLParameters {
+	p := v1alpha1.NetworkACLParameters{
+		VPCID:   aws.String(vpcID),
+		Entries: entries,
+	}
+	for _, s := range subnets {
+		p.Associations = append(p.Associations, v1alpha1.NetworkACLAssociation{SubnetID: aws.String(s)})
+	}
+	return p
+}
+
+func observedACL(entries []awsec2.NetworkAclEntry, subnets ...string) awsec2.NetworkAcl {
+	acl := awsec2.NetworkAcl{
+		NetworkAclId: aws.String(networkACLID),
+		VpcId:        aws.String(vpcID),
+		IsDefault:    aws.Bool(false),
+		Entries: append(entries, awsec2.NetworkAclEntry{
+			RuleNumber: aws.Int64(32767),
+			Egress:     aws.Bool(false),
+			Protocol:   aws.String("-1"),
+			RuleAction: awsec2.RuleActionDeny,
+			CidrBlock:  aws.String("0.0.0.0/0"),
+		}),
+	}
+	for _, s := range subnets {
+		acl.Associations = append(acl.Associations, awsec2.NetworkAclAssociation{
+			NetworkAclAssociationId: aws.String(associationID),
+			NetworkAclId:            aws.String(networkACLID),
+			SubnetId:                aws.String(s),
+		})
+	}
+	return acl
+}
+
+// describe returns the given network ACLs for requests by ID, the default
+// network ACL of the VPC for requests filtering by default and the network
+// ACL associated with a subnet for requests filtering by subnet.
+func describe(acls ...awsec2.NetworkAcl) func(*awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+	return func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+		out := &awsec2.DescribeNetworkAclsOutput{NetworkAcls: acls}
+		for _, f := range input.Filters {
+			switch aws.StringValue(f.Name) {
+			case "default":
+				out.NetworkAcls = []awsec2.NetworkAcl{{NetworkAclId: aws.String(defaultNetworkACLID), IsDefault: aws.Bool(true)}}
+			case "association.subnet-id":
+				out.NetworkAcls = []awsec2.NetworkAcl{{
+					NetworkAclId: aws.String(defaultNetworkACLID),
+					Associations: []awsec2.NetworkAclAssociation{{
+						NetworkAclAssociationId: aws.String(associationID),
+						SubnetId:                aws.String(f.Values[0]),
+					}},
+				}}
+			}
+		}
+		return awsec2.DescribeNetworkAclsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func replaceAssociation(t *testing.T, wantACLID string) func(*awsec2.ReplaceNetworkAclAssociationInput) awsec2.ReplaceNetworkAclAssociationRequest {
+	return func(input *awsec2.ReplaceNetworkAclAssociationInput) awsec2.ReplaceNetworkAclAssociationRequest {
+		if diff := cmp.Diff(wantACLID, aws.StringValue(input.NetworkAclId)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff(associationID, aws.StringValue(input.AssociationId)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		return awsec2.ReplaceNetworkAclAssociationRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ReplaceNetworkAclAssociationOutput{}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	networkACL ec2.NetworkACLClient
+	kube       client.Client
+	cr         *v1alpha1.NetworkACL
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.NetworkACL
+		result managed.ExternalObservation
+		err    error
+	}
+
+	httpsEntry := ec2.GenerateNetworkACLEntry(entry)
+	status := v1alpha1.NetworkACLObservation{
+		NetworkACLID: networkACLID,
+		Associations: []v1alpha1.NetworkACLAssociationState{{AssociationID: associationID, SubnetID: subnetID}},
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{},
+				cr:         networkACL(),
+			},
+			want: want{
+				cr: networkACL(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{
+					MockDescribeNetworkAcls: describe(),
+				},
+				cr: networkACL(withExternalName(networkACLID)),
+			},
+			want: want{
+				cr: networkACL(withExternalName(networkACLID)),
+			},
+		},
+		"Available": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{
+					MockDescribeNetworkAcls: describe(observedACL([]awsec2.NetworkAclEntry{httpsEntry}, subnetID)),
+				},
+				cr: networkACL(withExternalName(networkACLID), withSpec(specParams([]v1alpha1.NetworkACLEntry{entry}, subnetID))),
+			},
+			want: want{
+				cr: networkACL(withExternalName(networkACLID), withSpec(specParams([]v1alpha1.NetworkACLEntry{entry}, subnetID)),
+					withStatus(status), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{
+					MockDescribeNetworkAcls: describe(observedACL([]awsec2.NetworkAclEntry{httpsEntry}, subnetID)),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: networkACL(withExternalName(networkACLID), withSpec(v1alpha1.NetworkACLParameters{
+					Entries:      []v1alpha1.NetworkACLEntry{entry},
+					Associations: []v1alpha1.NetworkACLAssociation{{SubnetID: aws.String(subnetID)}},
+				})),
+			},
+			want: want{
+				cr: networkACL(withExternalName(networkACLID), withSpec(specParams([]v1alpha1.NetworkACLEntry{entry}, subnetID)),
+					withStatus(status), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"EntriesChanged": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{
+					MockDescribeNetworkAcls: describe(observedACL(nil, subnetID)),
+				},
+				cr: networkACL(withExternalName(networkACLID), withSpec(specParams([]v1alpha1.NetworkACLEntry{entry}, subnetID))),
+			},
+			want: want{
+				cr: networkACL(withExternalName(networkACLID), withSpec(specParams([]v1alpha1.NetworkACLEntry{entry}, subnetID)),
+					withStatus(status), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{
+					MockDescribeNetworkAcls: func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+						return awsec2.DescribeNetworkAclsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: networkACL(withExternalName(networkACLID)),
+			},
+			want: want{
+				cr:  networkACL(withExternalName(networkACLID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.networkACL}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.NetworkACL
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{
+					MockCreateNetworkAcl: func(input *awsec2.CreateNetworkAclInput) awsec2.CreateNetworkAclRequest {
+						if diff := cmp.Diff(vpcID, aws.StringValue(input.VpcId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateNetworkAclRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateNetworkAclOutput{
+								NetworkAcl: &awsec2.NetworkAcl{NetworkAclId: aws.String(networkACLID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: networkACL(withSpec(specParams(nil))),
+			},
+			want: want{
+				cr: networkACL(withExternalName(networkACLID), withSpec(specParams(nil)),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{
+					MockCreateNetworkAcl: func(input *awsec2.CreateNetworkAclInput) awsec2.CreateNetworkAclRequest {
+						return awsec2.CreateNetworkAclRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: networkACL(withSpec(specParams(nil))),
+			},
+			want: want{
+				cr: networkACL(withSpec(specParams(nil)),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.networkACL}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.NetworkACL
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	httpsEntry := ec2.GenerateNetworkACLEntry(entry)
+	oldEntry := ec2.GenerateNetworkACLEntry(v1alpha1.NetworkACLEntry{RuleNumber: 200, Protocol: "-1", RuleAction: "deny", CIDRBlock: aws.String("10.0.0.0/8")})
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"EntriesUpdated": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{
+					MockDescribeNetworkAcls: describe(observedACL([]awsec2.NetworkAclEntry{oldEntry})),
+					MockDeleteNetworkAclEntry: func(input *awsec2.DeleteNetworkAclEntryInput) awsec2.DeleteNetworkAclEntryRequest {
+						if diff := cmp.Diff(int64(200), aws.Int64Value(input.RuleNumber)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.DeleteNetworkAclEntryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteNetworkAclEntryOutput{}},
+						}
+					},
+					MockCreateNetworkAclEntry: func(input *awsec2.CreateNetworkAclEntryInput) awsec2.CreateNetworkAclEntryRequest {
+						if diff := cmp.Diff(ec2.GenerateCreateNetworkACLEntryInput(networkACLID, httpsEntry), input); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateNetworkAclEntryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateNetworkAclEntryOutput{}},
+						}
+					},
+				},
+				cr: networkACL(withExternalName(networkACLID), withSpec(specParams([]v1alpha1.NetworkACLEntry{entry}))),
+			},
+			want: want{
+				cr: networkACL(withExternalName(networkACLID), withSpec(specParams([]v1alpha1.NetworkACLEntry{entry}))),
+			},
+		},
+		"SubnetAssociated": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{
+					MockDescribeNetworkAcls:          describe(observedACL(nil)),
+					MockReplaceNetworkAclAssociation: replaceAssociation(t, networkACLID),
+				},
+				cr: networkACL(withExternalName(networkACLID), withSpec(specParams(nil, subnetID))),
+			},
+			want: want{
+				cr: networkACL(withExternalName(networkACLID), withSpec(specParams(nil, subnetID))),
+			},
+		},
+		"SubnetDisassociated": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{
+					MockDescribeNetworkAcls:          describe(observedACL(nil, subnetID)),
+					MockReplaceNetworkAclAssociation: replaceAssociation(t, defaultNetworkACLID),
+				},
+				cr: networkACL(withExternalName(networkACLID), withSpec(specParams(nil))),
+			},
+			want: want{
+				cr: networkACL(withExternalName(networkACLID), withSpec(specParams(nil))),
+			},
+		},
+		"CreateEntryFailed": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{
+					MockDescribeNetworkAcls: describe(observedACL(nil)),
+					MockCreateNetworkAclEntry: func(input *awsec2.CreateNetworkAclEntryInput) awsec2.CreateNetworkAclEntryRequest {
+						return awsec2.CreateNetworkAclEntryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: networkACL(withExternalName(networkACLID), withSpec(specParams([]v1alpha1.NetworkACLEntry{entry}))),
+			},
+			want: want{
+				cr:  networkACL(withExternalName(networkACLID), withSpec(specParams([]v1alpha1.NetworkACLEntry{entry}))),
+				err: errors.Wrap(errBoom, errCreateEntry),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.networkACL}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.NetworkACL
+		err error
+	}
+
+	deleteResponse := func(err error) func(*awsec2.DeleteNetworkAclInput) awsec2.DeleteNetworkAclRequest {
+		return func(input *awsec2.DeleteNetworkAclInput) awsec2.DeleteNetworkAclRequest {
+			return awsec2.DeleteNetworkAclRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteNetworkAclOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{
+					MockDescribeNetworkAcls:          describe(observedACL(nil, subnetID)),
+					MockReplaceNetworkAclAssociation: replaceAssociation(t, defaultNetworkACLID),
+					MockDeleteNetworkAcl:             deleteResponse(nil),
+				},
+				cr: networkACL(withExternalName(networkACLID)),
+			},
+			want: want{
+				cr: networkACL(withExternalName(networkACLID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{
+					MockDescribeNetworkAcls: describe(),
+				},
+				cr: networkACL(withExternalName(networkACLID)),
+			},
+			want: want{
+				cr: networkACL(withExternalName(networkACLID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				networkACL: &fake.MockNetworkACLClient{
+					MockDescribeNetworkAcls: describe(observedACL(nil)),
+					MockDeleteNetworkAcl:    deleteResponse(errBoom),
+				},
+				cr: networkACL(withExternalName(networkACLID)),
+			},
+			want: want{
+				cr:  networkACL(withExternalName(networkACLID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.networkACL}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -65,9 +65,9 @@ func SetupRouteTable(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1alpha4.RouteTable{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha4.RouteTableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewRouteTableClient}),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewRouteTableClient}, awscommon.DeletionTierNetwork)))),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
 			managed.WithConnectionPublishers(),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -250,7 +250,7 @@ func (e *external) createRoutes(ctx context.Context, tableID string, desired []v
 	for _, rt := range desired {
 		isObserved := false
 		for _, ob := range observed {
-			if ob.GatewayID == aws.StringValue(rt.GatewayID) && ob.DestinationCIDRBlock == aws.StringValue(rt.DestinationCIDRBlock) {
+			if ob.GatewayID == aws.StringValue(rt.GatewayID) && ob.NatGatewayID == aws.StringValue(rt.NatGatewayID) && ob.DestinationCIDRBlock == aws.StringValue(rt.DestinationCIDRBlock) {
 				isObserved = true
 				break
 			}
@@ -261,6 +261,7 @@ func (e *external) createRoutes(ctx context.Context, tableID string, desired []v
 				RouteTableId:         aws.String(tableID),
 				DestinationCidrBlock: rt.DestinationCIDRBlock,
 				GatewayId:            rt.GatewayID,
+				NatGatewayId:         rt.NatGatewayID,
 			}).Send(ctx)
 
 			if err != nil {
@@ -0,0 +1,365 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	snapshotID  = "snap-0123456789"
+	volumeID    = "vol-0123456789"
+	description = "nightly"
+	errBoom     = errors.New("boom")
+)
+
+type snapshotModifier func(*v1alpha1.Snapshot)
+
+func withExternalName(name string) snapshotModifier {
+	return func(r *v1alpha1.Snapshot) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) snapshotModifier {
+	return func(r *v1alpha1.Snapshot) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.SnapshotParameters) snapshotModifier {
+	return func(r *v1alpha1.Snapshot) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.SnapshotObservation) snapshotModifier {
+	return func(r *v1alpha1.Snapshot) { r.Status.AtProvider = s }
+}
+
+func snapshot(m ...snapshotModifier) *v1alpha1.Snapshot {
+	cr := &v1alpha1.Snapshot{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specParams() v1alpha1.SnapshotParameters {
+	return v1alpha1.SnapshotParameters{
+		VolumeID:    aws.String(volumeID),
+		Description: aws.String(description),
+		Tags:        []v1beta1.Tag{{Key: "key1", Value: "value1"}},
+	}
+}
+
+func describe(state awsec2.SnapshotState) func(*awsec2.DescribeSnapshotsInput) awsec2.DescribeSnapshotsRequest {
+	return func(input *awsec2.DescribeSnapshotsInput) awsec2.DescribeSnapshotsRequest {
+		return awsec2.DescribeSnapshotsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeSnapshotsOutput{
+				Snapshots: []awsec2.Snapshot{{
+					SnapshotId:  aws.String(snapshotID),
+					VolumeId:    aws.String(volumeID),
+					Description: aws.String(description),
+					Progress:    aws.String("100%"),
+					VolumeSize:  aws.Int64(10),
+					State:       state,
+					Tags:        []awsec2.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+				}},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	snapshot ec2.SnapshotClient
+	kube     client.Client
+	cr       *v1alpha1.Snapshot
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Snapshot
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				snapshot: &fake.MockSnapshotClient{},
+				cr:       snapshot(),
+			},
+			want: want{
+				cr: snapshot(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				snapshot: &fake.MockSnapshotClient{
+					MockDescribeSnapshots: func(input *awsec2.DescribeSnapshotsInput) awsec2.DescribeSnapshotsRequest {
+						return awsec2.DescribeSnapshotsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.SnapshotIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+		},
+		"Completed": {
+			args: args{
+				snapshot: &fake.MockSnapshotClient{
+					MockDescribeSnapshots: describe(awsec2.SnapshotStateCompleted),
+				},
+				cr: snapshot(withExternalName(snapshotID), withSpec(specParams())),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID), withSpec(specParams()),
+					withStatus(v1alpha1.SnapshotObservation{
+						SnapshotID: snapshotID,
+						State:      string(awsec2.SnapshotStateCompleted),
+						Progress:   "100%",
+						VolumeSize: 10,
+					}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				snapshot: &fake.MockSnapshotClient{
+					MockDescribeSnapshots: describe(awsec2.SnapshotStatePending),
+				},
+				cr: snapshot(withExternalName(snapshotID), withSpec(specParams())),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID), withSpec(specParams()),
+					withStatus(v1alpha1.SnapshotObservation{
+						SnapshotID: snapshotID,
+						State:      string(awsec2.SnapshotStatePending),
+						Progress:   "100%",
+						VolumeSize: 10,
+					}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitFailed": {
+			args: args{
+				snapshot: &fake.MockSnapshotClient{
+					MockDescribeSnapshots: describe(awsec2.SnapshotStateCompleted),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+			want: want{
+				cr:  snapshot(withExternalName(snapshotID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				snapshot: &fake.MockSnapshotClient{
+					MockDescribeSnapshots: func(input *awsec2.DescribeSnapshotsInput) awsec2.DescribeSnapshotsRequest {
+						return awsec2.DescribeSnapshotsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+			want: want{
+				cr:  snapshot(withExternalName(snapshotID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.snapshot}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Snapshot
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				snapshot: &fake.MockSnapshotClient{
+					MockCreateSnapshot: func(input *awsec2.CreateSnapshotInput) awsec2.CreateSnapshotRequest {
+						return awsec2.CreateSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateSnapshotOutput{
+								SnapshotId: aws.String(snapshotID),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: snapshot(withSpec(specParams())),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID), withSpec(specParams()),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				snapshot: &fake.MockSnapshotClient{
+					MockCreateSnapshot: func(input *awsec2.CreateSnapshotInput) awsec2.CreateSnapshotRequest {
+						return awsec2.CreateSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(withSpec(specParams())),
+			},
+			want: want{
+				cr: snapshot(withSpec(specParams()),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.snapshot}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Snapshot
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				snapshot: &fake.MockSnapshotClient{
+					MockDeleteSnapshot: func(input *awsec2.DeleteSnapshotInput) awsec2.DeleteSnapshotRequest {
+						return awsec2.DeleteSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteSnapshotOutput{}},
+						}
+					},
+				},
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				snapshot: &fake.MockSnapshotClient{
+					MockDeleteSnapshot: func(input *awsec2.DeleteSnapshotInput) awsec2.DeleteSnapshotRequest {
+						return awsec2.DeleteSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+			want: want{
+				cr:  snapshot(withExternalName(snapshotID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.snapshot}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
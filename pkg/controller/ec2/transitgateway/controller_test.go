@@ -0,0 +1,534 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgateway
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	tgwID   = "tgw-0123456789"
+	tgwARN  = "arn:aws:ec2:us-east-1:123456789012:transit-gateway/tgw-0123456789"
+	ownerID = "123456789012"
+	enable  = "enable"
+	errBoom = errors.New("boom")
+)
+
+type tgwModifier func(*v1alpha1.TransitGateway)
+
+func withExternalName(name string) tgwModifier {
+	return func(r *v1alpha1.TransitGateway) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) tgwModifier {
+	return func(r *v1alpha1.TransitGateway) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.TransitGatewayParameters) tgwModifier {
+	return func(r *v1alpha1.TransitGateway) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.TransitGatewayObservation) tgwModifier {
+	return func(r *v1alpha1.TransitGateway) { r.Status.AtProvider = s }
+}
+
+func tgw(m ...tgwModifier) *v1alpha1.TransitGateway {
+	cr := &v1alpha1.TransitGateway{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specTags() []v1beta1.Tag {
+	return []v1beta1.Tag{{Key: "key1", Value: "value1"}}
+}
+
+func tgwTags() []awsec2.Tag {
+	return []awsec2.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}}
+}
+
+func specParams() v1alpha1.TransitGatewayParameters {
+	return v1alpha1.TransitGatewayParameters{
+		DNSSupport: &enable,
+		Tags:       specTags(),
+	}
+}
+
+func describeOutput(state awsec2.TransitGatewayState, tags []awsec2.Tag) *awsec2.DescribeTransitGatewaysOutput {
+	return &awsec2.DescribeTransitGatewaysOutput{
+		TransitGateways: []awsec2.TransitGateway{
+			{
+				TransitGatewayId:  aws.String(tgwID),
+				TransitGatewayArn: aws.String(tgwARN),
+				OwnerId:           aws.String(ownerID),
+				State:             state,
+				Options: &awsec2.TransitGatewayOptions{
+					DnsSupport: awsec2.DnsSupportValueEnable,
+				},
+				Tags: tags,
+			},
+		},
+	}
+}
+
+func observation(state string) v1alpha1.TransitGatewayObservation {
+	return v1alpha1.TransitGatewayObservation{
+		TransitGatewayARN: tgwARN,
+		OwnerID:           ownerID,
+		State:             state,
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	tgw  ec2.TransitGatewayClient
+	kube client.Client
+	cr   *v1alpha1.TransitGateway
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TransitGateway
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{},
+				cr:  tgw(),
+			},
+			want: want{
+				cr: tgw(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockDescribeTransitGateways: func(input *awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+						return awsec2.DescribeTransitGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.TransitGatewayIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: tgw(withExternalName(tgwID)),
+			},
+			want: want{
+				cr: tgw(withExternalName(tgwID)),
+			},
+		},
+		"Deleted": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockDescribeTransitGateways: func(input *awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+						return awsec2.DescribeTransitGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayStateDeleted, tgwTags())},
+						}
+					},
+				},
+				cr: tgw(withExternalName(tgwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: tgw(withExternalName(tgwID), withSpec(specParams())),
+			},
+		},
+		"Available": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockDescribeTransitGateways: func(input *awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+						return awsec2.DescribeTransitGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayStateAvailable, tgwTags())},
+						}
+					},
+				},
+				cr: tgw(withExternalName(tgwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: tgw(withExternalName(tgwID), withSpec(specParams()),
+					withStatus(observation(v1alpha1.TransitGatewayStateAvailable)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockDescribeTransitGateways: func(input *awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+						return awsec2.DescribeTransitGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayStatePending, nil)},
+						}
+					},
+				},
+				cr: tgw(withExternalName(tgwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: tgw(withExternalName(tgwID), withSpec(specParams()),
+					withStatus(observation(v1alpha1.TransitGatewayStatePending)),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockDescribeTransitGateways: func(input *awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+						return awsec2.DescribeTransitGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayStateAvailable, tgwTags())},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: tgw(withExternalName(tgwID)),
+			},
+			want: want{
+				cr:  tgw(withExternalName(tgwID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockDescribeTransitGateways: func(input *awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+						return awsec2.DescribeTransitGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tgw(withExternalName(tgwID)),
+			},
+			want: want{
+				cr:  tgw(withExternalName(tgwID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.tgw}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TransitGateway
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockCreateTransitGateway: func(input *awsec2.CreateTransitGatewayInput) awsec2.CreateTransitGatewayRequest {
+						return awsec2.CreateTransitGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTransitGatewayOutput{
+								TransitGateway: &awsec2.TransitGateway{TransitGatewayId: aws.String(tgwID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: tgw(withSpec(specParams())),
+			},
+			want: want{
+				cr: tgw(withExternalName(tgwID), withSpec(specParams())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockCreateTransitGateway: func(input *awsec2.CreateTransitGatewayInput) awsec2.CreateTransitGatewayRequest {
+						return awsec2.CreateTransitGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tgw(withSpec(specParams())),
+			},
+			want: want{
+				cr:  tgw(withSpec(specParams())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"SpecUpdateFailed": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockCreateTransitGateway: func(input *awsec2.CreateTransitGatewayInput) awsec2.CreateTransitGatewayRequest {
+						return awsec2.CreateTransitGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTransitGatewayOutput{
+								TransitGateway: &awsec2.TransitGateway{TransitGatewayId: aws.String(tgwID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: tgw(withSpec(specParams())),
+			},
+			want: want{
+				cr:  tgw(withExternalName(tgwID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.tgw}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TransitGateway
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"TagsUpdated": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockDescribeTransitGateways: func(input *awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+						return awsec2.DescribeTransitGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayStateAvailable, []awsec2.Tag{{Key: aws.String("old"), Value: aws.String("value")}})},
+						}
+					},
+					MockDeleteTags: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTagsOutput{}},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+				},
+				cr: tgw(withExternalName(tgwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: tgw(withExternalName(tgwID), withSpec(specParams())),
+			},
+		},
+		"CreateTagsFailed": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockDescribeTransitGateways: func(input *awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+						return awsec2.DescribeTransitGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayStateAvailable, nil)},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tgw(withExternalName(tgwID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  tgw(withExternalName(tgwID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errUpdateTags),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockDescribeTransitGateways: func(input *awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+						return awsec2.DescribeTransitGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tgw(withExternalName(tgwID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  tgw(withExternalName(tgwID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.tgw}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.TransitGateway
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockDeleteTransitGateway: func(input *awsec2.DeleteTransitGatewayInput) awsec2.DeleteTransitGatewayRequest {
+						return awsec2.DeleteTransitGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTransitGatewayOutput{}},
+						}
+					},
+				},
+				cr: tgw(withExternalName(tgwID)),
+			},
+			want: want{
+				cr: tgw(withExternalName(tgwID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{},
+				cr:  tgw(withExternalName(tgwID), withStatus(observation(v1alpha1.TransitGatewayStateDeleting))),
+			},
+			want: want{
+				cr: tgw(withExternalName(tgwID), withStatus(observation(v1alpha1.TransitGatewayStateDeleting)),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockDeleteTransitGateway: func(input *awsec2.DeleteTransitGatewayInput) awsec2.DeleteTransitGatewayRequest {
+						return awsec2.DeleteTransitGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.TransitGatewayIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: tgw(withExternalName(tgwID)),
+			},
+			want: want{
+				cr: tgw(withExternalName(tgwID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				tgw: &fake.MockTransitGatewayClient{
+					MockDeleteTransitGateway: func(input *awsec2.DeleteTransitGatewayInput) awsec2.DeleteTransitGatewayRequest {
+						return awsec2.DeleteTransitGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tgw(withExternalName(tgwID)),
+			},
+			want: want{
+				cr:  tgw(withExternalName(tgwID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.tgw}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,530 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroutetable
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	tgwID   = "tgw-0123456789"
+	rtID    = "tgw-rtb-0123456789"
+	errBoom = errors.New("boom")
+)
+
+type rtModifier func(*v1alpha1.TransitGatewayRouteTable)
+
+func withExternalName(name string) rtModifier {
+	return func(r *v1alpha1.TransitGatewayRouteTable) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) rtModifier {
+	return func(r *v1alpha1.TransitGatewayRouteTable) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.TransitGatewayRouteTableParameters) rtModifier {
+	return func(r *v1alpha1.TransitGatewayRouteTable) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.TransitGatewayRouteTableObservation) rtModifier {
+	return func(r *v1alpha1.TransitGatewayRouteTable) { r.Status.AtProvider = s }
+}
+
+func rt(m ...rtModifier) *v1alpha1.TransitGatewayRouteTable {
+	cr := &v1alpha1.TransitGatewayRouteTable{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specTags() []v1beta1.Tag {
+	return []v1beta1.Tag{{Key: "key1", Value: "value1"}}
+}
+
+func rtTags() []awsec2.Tag {
+	return []awsec2.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}}
+}
+
+func specParams() v1alpha1.TransitGatewayRouteTableParameters {
+	return v1alpha1.TransitGatewayRouteTableParameters{
+		TransitGatewayID: &tgwID,
+		Tags:             specTags(),
+	}
+}
+
+func describeOutput(state awsec2.TransitGatewayRouteTableState, tags []awsec2.Tag) *awsec2.DescribeTransitGatewayRouteTablesOutput {
+	return &awsec2.DescribeTransitGatewayRouteTablesOutput{
+		TransitGatewayRouteTables: []awsec2.TransitGatewayRouteTable{
+			{
+				TransitGatewayRouteTableId:   aws.String(rtID),
+				TransitGatewayId:             aws.String(tgwID),
+				DefaultAssociationRouteTable: aws.Bool(true),
+				DefaultPropagationRouteTable: aws.Bool(true),
+				State:                        state,
+				Tags:                         tags,
+			},
+		},
+	}
+}
+
+func observation(state string) v1alpha1.TransitGatewayRouteTableObservation {
+	return v1alpha1.TransitGatewayRouteTableObservation{
+		State:                        state,
+		DefaultAssociationRouteTable: true,
+		DefaultPropagationRouteTable: true,
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	rt   ec2.TransitGatewayRouteTableClient
+	kube client.Client
+	cr   *v1alpha1.TransitGatewayRouteTable
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TransitGatewayRouteTable
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{},
+				cr: rt(),
+			},
+			want: want{
+				cr: rt(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribeTransitGatewayRouteTables: func(input *awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+						return awsec2.DescribeTransitGatewayRouteTablesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.TransitGatewayRouteTableIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: rt(withExternalName(rtID)),
+			},
+			want: want{
+				cr: rt(withExternalName(rtID)),
+			},
+		},
+		"Deleted": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribeTransitGatewayRouteTables: func(input *awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+						return awsec2.DescribeTransitGatewayRouteTablesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayRouteTableStateDeleted, rtTags())},
+						}
+					},
+				},
+				cr: rt(withExternalName(rtID), withSpec(specParams())),
+			},
+			want: want{
+				cr: rt(withExternalName(rtID), withSpec(specParams())),
+			},
+		},
+		"Available": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribeTransitGatewayRouteTables: func(input *awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+						return awsec2.DescribeTransitGatewayRouteTablesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayRouteTableStateAvailable, rtTags())},
+						}
+					},
+				},
+				cr: rt(withExternalName(rtID), withSpec(specParams())),
+			},
+			want: want{
+				cr: rt(withExternalName(rtID), withSpec(specParams()),
+					withStatus(observation(v1alpha1.TransitGatewayRouteTableStateAvailable)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribeTransitGatewayRouteTables: func(input *awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+						return awsec2.DescribeTransitGatewayRouteTablesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayRouteTableStatePending, nil)},
+						}
+					},
+				},
+				cr: rt(withExternalName(rtID), withSpec(specParams())),
+			},
+			want: want{
+				cr: rt(withExternalName(rtID), withSpec(specParams()),
+					withStatus(observation(v1alpha1.TransitGatewayRouteTableStatePending)),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribeTransitGatewayRouteTables: func(input *awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+						return awsec2.DescribeTransitGatewayRouteTablesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayRouteTableStateAvailable, rtTags())},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: rt(withExternalName(rtID)),
+			},
+			want: want{
+				cr:  rt(withExternalName(rtID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribeTransitGatewayRouteTables: func(input *awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+						return awsec2.DescribeTransitGatewayRouteTablesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rt(withExternalName(rtID)),
+			},
+			want: want{
+				cr:  rt(withExternalName(rtID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rt}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TransitGatewayRouteTable
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockCreateTransitGatewayRouteTable: func(input *awsec2.CreateTransitGatewayRouteTableInput) awsec2.CreateTransitGatewayRouteTableRequest {
+						return awsec2.CreateTransitGatewayRouteTableRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTransitGatewayRouteTableOutput{
+								TransitGatewayRouteTable: &awsec2.TransitGatewayRouteTable{TransitGatewayRouteTableId: aws.String(rtID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: rt(withSpec(specParams())),
+			},
+			want: want{
+				cr: rt(withExternalName(rtID), withSpec(specParams())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockCreateTransitGatewayRouteTable: func(input *awsec2.CreateTransitGatewayRouteTableInput) awsec2.CreateTransitGatewayRouteTableRequest {
+						return awsec2.CreateTransitGatewayRouteTableRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rt(withSpec(specParams())),
+			},
+			want: want{
+				cr:  rt(withSpec(specParams())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"SpecUpdateFailed": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockCreateTransitGatewayRouteTable: func(input *awsec2.CreateTransitGatewayRouteTableInput) awsec2.CreateTransitGatewayRouteTableRequest {
+						return awsec2.CreateTransitGatewayRouteTableRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTransitGatewayRouteTableOutput{
+								TransitGatewayRouteTable: &awsec2.TransitGatewayRouteTable{TransitGatewayRouteTableId: aws.String(rtID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: rt(withSpec(specParams())),
+			},
+			want: want{
+				cr:  rt(withExternalName(rtID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rt}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TransitGatewayRouteTable
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"TagsUpdated": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribeTransitGatewayRouteTables: func(input *awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+						return awsec2.DescribeTransitGatewayRouteTablesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayRouteTableStateAvailable, []awsec2.Tag{{Key: aws.String("old"), Value: aws.String("value")}})},
+						}
+					},
+					MockDeleteTags: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTagsOutput{}},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+				},
+				cr: rt(withExternalName(rtID), withSpec(specParams())),
+			},
+			want: want{
+				cr: rt(withExternalName(rtID), withSpec(specParams())),
+			},
+		},
+		"CreateTagsFailed": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribeTransitGatewayRouteTables: func(input *awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+						return awsec2.DescribeTransitGatewayRouteTablesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayRouteTableStateAvailable, nil)},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rt(withExternalName(rtID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  rt(withExternalName(rtID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errUpdateTags),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribeTransitGatewayRouteTables: func(input *awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+						return awsec2.DescribeTransitGatewayRouteTablesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rt(withExternalName(rtID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  rt(withExternalName(rtID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rt}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.TransitGatewayRouteTable
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockDeleteTransitGatewayRouteTable: func(input *awsec2.DeleteTransitGatewayRouteTableInput) awsec2.DeleteTransitGatewayRouteTableRequest {
+						return awsec2.DeleteTransitGatewayRouteTableRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTransitGatewayRouteTableOutput{}},
+						}
+					},
+				},
+				cr: rt(withExternalName(rtID)),
+			},
+			want: want{
+				cr: rt(withExternalName(rtID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{},
+				cr: rt(withExternalName(rtID), withStatus(observation(v1alpha1.TransitGatewayRouteTableStateDeleting))),
+			},
+			want: want{
+				cr: rt(withExternalName(rtID), withStatus(observation(v1alpha1.TransitGatewayRouteTableStateDeleting)),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockDeleteTransitGatewayRouteTable: func(input *awsec2.DeleteTransitGatewayRouteTableInput) awsec2.DeleteTransitGatewayRouteTableRequest {
+						return awsec2.DeleteTransitGatewayRouteTableRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.TransitGatewayRouteTableIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: rt(withExternalName(rtID)),
+			},
+			want: want{
+				cr: rt(withExternalName(rtID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				rt: &fake.MockTransitGatewayRouteTableClient{
+					MockDeleteTransitGatewayRouteTable: func(input *awsec2.DeleteTransitGatewayRouteTableInput) awsec2.DeleteTransitGatewayRouteTableRequest {
+						return awsec2.DeleteTransitGatewayRouteTableRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rt(withExternalName(rtID)),
+			},
+			want: want{
+				cr:  rt(withExternalName(rtID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rt}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
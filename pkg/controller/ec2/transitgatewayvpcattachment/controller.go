@@ -0,0 +1,234 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayvpcattachment
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TransitGatewayVPCAttachment resource"
+	errDescribe         = "failed to describe TransitGatewayVPCAttachment"
+	errNotSingleItem    = "either no or multiple TransitGatewayVPCAttachments retrieved for the given transitGatewayAttachmentId"
+	errSpecUpdate       = "cannot update spec of the TransitGatewayVPCAttachment resource"
+	errCreate           = "failed to create the TransitGatewayVPCAttachment resource"
+	errModify           = "failed to modify the TransitGatewayVPCAttachment resource"
+	errDelete           = "failed to delete the TransitGatewayVPCAttachment resource"
+	errUpdateTags       = "failed to update tags for the TransitGatewayVPCAttachment resource"
+	errDeleteTags       = "failed to delete tags for TransitGatewayVPCAttachment resource"
+)
+
+// SetupTransitGatewayVPCAttachment adds a controller that reconciles
+// TransitGatewayVPCAttachments.
+func SetupTransitGatewayVPCAttachment(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.TransitGatewayVPCAttachmentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.TransitGatewayVPCAttachment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TransitGatewayVPCAttachmentGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewTransitGatewayVPCAttachmentClient}, awscommon.DeletionTierAttachment)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.TransitGatewayVPCAttachmentClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.TransitGatewayVPCAttachment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.TransitGatewayVPCAttachmentClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.TransitGatewayVpcAttachment, error) {
+	response, err := e.client.DescribeTransitGatewayVpcAttachmentsRequest(&awsec2.DescribeTransitGatewayVpcAttachmentsInput{
+		TransitGatewayAttachmentIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.TransitGatewayVpcAttachments) != 1 {
+		return nil, errors.New(errNotSingleItem)
+	}
+	return &response.TransitGatewayVpcAttachments[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.TransitGatewayVPCAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsTransitGatewayAttachmentNotFoundErr, err), errDescribe)
+	}
+
+	if string(observed.State) == v1alpha1.TransitGatewayAttachmentStateDeleted {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeTransitGatewayVPCAttachment(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateTransitGatewayVPCAttachmentObservation(*observed)
+
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.TransitGatewayAttachmentStateInitiating, v1alpha1.TransitGatewayAttachmentStatePending:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha1.TransitGatewayAttachmentStatePendingAcceptance:
+		cr.SetConditions(runtimev1alpha1.Unavailable().WithMessage("the attachment has to be accepted by the owner of the transit gateway"))
+	case v1alpha1.TransitGatewayAttachmentStateAvailable, v1alpha1.TransitGatewayAttachmentStateModifying:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.TransitGatewayAttachmentStateFailed, v1alpha1.TransitGatewayAttachmentStateRejected:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	case v1alpha1.TransitGatewayAttachmentStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsTransitGatewayVPCAttachmentUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.TransitGatewayVPCAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	a, err := e.client.CreateTransitGatewayVpcAttachmentRequest(ec2.GenerateCreateTransitGatewayVPCAttachmentInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(a.TransitGatewayVpcAttachment.TransitGatewayAttachmentId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.TransitGatewayVPCAttachment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(ec2.IsTransitGatewayAttachmentNotFoundErr, err), errDescribe)
+	}
+
+	// Only available attachments can be modified.
+	if string(observed.State) == v1alpha1.TransitGatewayAttachmentStateAvailable &&
+		!ec2.IsTransitGatewayVPCAttachmentConfigUpToDate(cr.Spec.ForProvider, *observed) {
+		if _, err := e.client.ModifyTransitGatewayVpcAttachmentRequest(
+			ec2.GenerateModifyTransitGatewayVPCAttachmentInput(meta.GetExternalName(cr), cr.Spec.ForProvider, *observed)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+		}
+	}
+
+	addTags, removeTags := awscommon.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.TransitGatewayVPCAttachment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.State == v1alpha1.TransitGatewayAttachmentStateDeleted ||
+		cr.Status.AtProvider.State == v1alpha1.TransitGatewayAttachmentStateDeleting {
+		return nil
+	}
+
+	_, err := e.client.DeleteTransitGatewayVpcAttachmentRequest(&awsec2.DeleteTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(ec2.IsTransitGatewayAttachmentNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,568 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayvpcattachment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	tgwID        = "tgw-0123456789"
+	attachmentID = "tgw-attach-0123456789"
+	vpcID        = "vpc-0123456789"
+	subnetID     = "subnet-0123456789"
+	ownerID      = "123456789012"
+	enable       = "enable"
+	errBoom      = errors.New("boom")
+)
+
+type attachmentModifier func(*v1alpha1.TransitGatewayVPCAttachment)
+
+func withExternalName(name string) attachmentModifier {
+	return func(r *v1alpha1.TransitGatewayVPCAttachment) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) attachmentModifier {
+	return func(r *v1alpha1.TransitGatewayVPCAttachment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.TransitGatewayVPCAttachmentParameters) attachmentModifier {
+	return func(r *v1alpha1.TransitGatewayVPCAttachment) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.TransitGatewayVPCAttachmentObservation) attachmentModifier {
+	return func(r *v1alpha1.TransitGatewayVPCAttachment) { r.Status.AtProvider = s }
+}
+
+func attachment(m ...attachmentModifier) *v1alpha1.TransitGatewayVPCAttachment {
+	cr := &v1alpha1.TransitGatewayVPCAttachment{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specTags() []v1beta1.Tag {
+	return []v1beta1.Tag{{Key: "key1", Value: "value1"}}
+}
+
+func attachmentTags() []awsec2.Tag {
+	return []awsec2.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}}
+}
+
+func specParams() v1alpha1.TransitGatewayVPCAttachmentParameters {
+	return v1alpha1.TransitGatewayVPCAttachmentParameters{
+		TransitGatewayID: &tgwID,
+		VPCID:            &vpcID,
+		SubnetIDs:        []string{subnetID},
+		DNSSupport:       &enable,
+		IPv6Support:      &enable,
+		Tags:             specTags(),
+	}
+}
+
+func describeOutput(state awsec2.TransitGatewayAttachmentState, tags []awsec2.Tag, subnets ...string) *awsec2.DescribeTransitGatewayVpcAttachmentsOutput {
+	return &awsec2.DescribeTransitGatewayVpcAttachmentsOutput{
+		TransitGatewayVpcAttachments: []awsec2.TransitGatewayVpcAttachment{
+			{
+				TransitGatewayAttachmentId: aws.String(attachmentID),
+				TransitGatewayId:           aws.String(tgwID),
+				VpcId:                      aws.String(vpcID),
+				VpcOwnerId:                 aws.String(ownerID),
+				SubnetIds:                  append([]string{subnetID}, subnets...),
+				State:                      state,
+				Options: &awsec2.TransitGatewayVpcAttachmentOptions{
+					DnsSupport:  awsec2.DnsSupportValueEnable,
+					Ipv6Support: awsec2.Ipv6SupportValueEnable,
+				},
+				Tags: tags,
+			},
+		},
+	}
+}
+
+func observation(state string) v1alpha1.TransitGatewayVPCAttachmentObservation {
+	return v1alpha1.TransitGatewayVPCAttachmentObservation{
+		State:      state,
+		VPCOwnerID: ownerID,
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	attachment ec2.TransitGatewayVPCAttachmentClient
+	kube       client.Client
+	cr         *v1alpha1.TransitGatewayVPCAttachment
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TransitGatewayVPCAttachment
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{},
+				cr:         attachment(),
+			},
+			want: want{
+				cr: attachment(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribeTransitGatewayVpcAttachments: func(input *awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+						return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.TransitGatewayAttachmentIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID)),
+			},
+		},
+		"Deleted": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribeTransitGatewayVpcAttachments: func(input *awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+						return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayAttachmentStateDeleted, attachmentTags())},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID), withSpec(specParams())),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID), withSpec(specParams())),
+			},
+		},
+		"Available": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribeTransitGatewayVpcAttachments: func(input *awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+						return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayAttachmentStateAvailable, attachmentTags())},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID), withSpec(specParams())),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID), withSpec(specParams()),
+					withStatus(observation(v1alpha1.TransitGatewayAttachmentStateAvailable)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribeTransitGatewayVpcAttachments: func(input *awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+						return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayAttachmentStatePending, nil)},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID), withSpec(specParams())),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID), withSpec(specParams()),
+					withStatus(observation(v1alpha1.TransitGatewayAttachmentStatePending)),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribeTransitGatewayVpcAttachments: func(input *awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+						return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayAttachmentStateAvailable, attachmentTags())},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr:  attachment(withExternalName(attachmentID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribeTransitGatewayVpcAttachments: func(input *awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+						return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr:  attachment(withExternalName(attachmentID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.attachment}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TransitGatewayVPCAttachment
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockCreateTransitGatewayVpcAttachment: func(input *awsec2.CreateTransitGatewayVpcAttachmentInput) awsec2.CreateTransitGatewayVpcAttachmentRequest {
+						return awsec2.CreateTransitGatewayVpcAttachmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTransitGatewayVpcAttachmentOutput{
+								TransitGatewayVpcAttachment: &awsec2.TransitGatewayVpcAttachment{TransitGatewayAttachmentId: aws.String(attachmentID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: attachment(withSpec(specParams())),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID), withSpec(specParams())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockCreateTransitGatewayVpcAttachment: func(input *awsec2.CreateTransitGatewayVpcAttachmentInput) awsec2.CreateTransitGatewayVpcAttachmentRequest {
+						return awsec2.CreateTransitGatewayVpcAttachmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(withSpec(specParams())),
+			},
+			want: want{
+				cr:  attachment(withSpec(specParams())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"SpecUpdateFailed": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockCreateTransitGatewayVpcAttachment: func(input *awsec2.CreateTransitGatewayVpcAttachmentInput) awsec2.CreateTransitGatewayVpcAttachmentRequest {
+						return awsec2.CreateTransitGatewayVpcAttachmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTransitGatewayVpcAttachmentOutput{
+								TransitGatewayVpcAttachment: &awsec2.TransitGatewayVpcAttachment{TransitGatewayAttachmentId: aws.String(attachmentID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: attachment(withSpec(specParams())),
+			},
+			want: want{
+				cr:  attachment(withExternalName(attachmentID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.attachment}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TransitGatewayVPCAttachment
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"TagsUpdated": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribeTransitGatewayVpcAttachments: func(input *awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+						return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayAttachmentStateAvailable, []awsec2.Tag{{Key: aws.String("old"), Value: aws.String("value")}}, "subnet-old")},
+						}
+					},
+					MockModifyTransitGatewayVpcAttachment: func(input *awsec2.ModifyTransitGatewayVpcAttachmentInput) awsec2.ModifyTransitGatewayVpcAttachmentRequest {
+						return awsec2.ModifyTransitGatewayVpcAttachmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyTransitGatewayVpcAttachmentOutput{}},
+						}
+					},
+					MockDeleteTags: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTagsOutput{}},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID), withSpec(specParams())),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID), withSpec(specParams())),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribeTransitGatewayVpcAttachments: func(input *awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+						return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayAttachmentStateAvailable, attachmentTags(), "subnet-old")},
+						}
+					},
+					MockModifyTransitGatewayVpcAttachment: func(input *awsec2.ModifyTransitGatewayVpcAttachmentInput) awsec2.ModifyTransitGatewayVpcAttachmentRequest {
+						return awsec2.ModifyTransitGatewayVpcAttachmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  attachment(withExternalName(attachmentID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+		"CreateTagsFailed": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribeTransitGatewayVpcAttachments: func(input *awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+						return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(awsec2.TransitGatewayAttachmentStateAvailable, nil)},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  attachment(withExternalName(attachmentID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errUpdateTags),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribeTransitGatewayVpcAttachments: func(input *awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+						return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  attachment(withExternalName(attachmentID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.attachment}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.TransitGatewayVPCAttachment
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDeleteTransitGatewayVpcAttachment: func(input *awsec2.DeleteTransitGatewayVpcAttachmentInput) awsec2.DeleteTransitGatewayVpcAttachmentRequest {
+						return awsec2.DeleteTransitGatewayVpcAttachmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTransitGatewayVpcAttachmentOutput{}},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{},
+				cr:         attachment(withExternalName(attachmentID), withStatus(observation(v1alpha1.TransitGatewayAttachmentStateDeleting))),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID), withStatus(observation(v1alpha1.TransitGatewayAttachmentStateDeleting)),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDeleteTransitGatewayVpcAttachment: func(input *awsec2.DeleteTransitGatewayVpcAttachmentInput) awsec2.DeleteTransitGatewayVpcAttachmentRequest {
+						return awsec2.DeleteTransitGatewayVpcAttachmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.TransitGatewayAttachmentIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				attachment: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDeleteTransitGatewayVpcAttachment: func(input *awsec2.DeleteTransitGatewayVpcAttachmentInput) awsec2.DeleteTransitGatewayVpcAttachmentRequest {
+						return awsec2.DeleteTransitGatewayVpcAttachmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr:  attachment(withExternalName(attachmentID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.attachment}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
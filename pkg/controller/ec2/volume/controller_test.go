@@ -0,0 +1,533 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	volumeID = "vol-0123456789"
+	zone     = "us-east-1a"
+	size     = int64(10)
+	newSize  = int64(20)
+	errBoom  = errors.New("boom")
+)
+
+type volumeModifier func(*v1alpha1.Volume)
+
+func withExternalName(name string) volumeModifier {
+	return func(r *v1alpha1.Volume) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) volumeModifier {
+	return func(r *v1alpha1.Volume) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.VolumeParameters) volumeModifier {
+	return func(r *v1alpha1.Volume) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.VolumeObservation) volumeModifier {
+	return func(r *v1alpha1.Volume) { r.Status.AtProvider = s }
+}
+
+func volume(m ...volumeModifier) *v1alpha1.Volume {
+	cr := &v1alpha1.Volume{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specParams(size int64) v1alpha1.VolumeParameters {
+	return v1alpha1.VolumeParameters{
+		AvailabilityZone: zone,
+		Size:             aws.Int64(size),
+		VolumeType:       aws.String(string(awsec2.VolumeTypeGp2)),
+		Encrypted:        aws.Bool(true),
+		Tags:             []v1beta1.Tag{{Key: "key1", Value: "value1"}},
+	}
+}
+
+func describe(state awsec2.VolumeState, tags []awsec2.Tag) func(*awsec2.DescribeVolumesInput) awsec2.DescribeVolumesRequest {
+	return func(input *awsec2.DescribeVolumesInput) awsec2.DescribeVolumesRequest {
+		return awsec2.DescribeVolumesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVolumesOutput{
+				Volumes: []awsec2.Volume{{
+					VolumeId:         aws.String(volumeID),
+					AvailabilityZone: aws.String(zone),
+					Size:             aws.Int64(size),
+					VolumeType:       awsec2.VolumeTypeGp2,
+					Iops:             aws.Int64(100),
+					Encrypted:        aws.Bool(true),
+					State:            state,
+					Tags:             tags,
+				}},
+			}},
+		}
+	}
+}
+
+func volumeTags() []awsec2.Tag {
+	return []awsec2.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}}
+}
+
+func describeModifications(m ...awsec2.VolumeModification) func(*awsec2.DescribeVolumesModificationsInput) awsec2.DescribeVolumesModificationsRequest {
+	return func(input *awsec2.DescribeVolumesModificationsInput) awsec2.DescribeVolumesModificationsRequest {
+		return awsec2.DescribeVolumesModificationsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVolumesModificationsOutput{
+				VolumesModifications: m,
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	volume ec2.VolumeClient
+	kube   client.Client
+	cr     *v1alpha1.Volume
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Volume
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				volume: &fake.MockVolumeClient{},
+				cr:     volume(),
+			},
+			want: want{
+				cr: volume(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockDescribeVolumes: func(input *awsec2.DescribeVolumesInput) awsec2.DescribeVolumesRequest {
+						return awsec2.DescribeVolumesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.VolumeIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: volume(withExternalName(volumeID)),
+			},
+			want: want{
+				cr: volume(withExternalName(volumeID)),
+			},
+		},
+		"Available": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockDescribeVolumes:              describe(awsec2.VolumeStateAvailable, volumeTags()),
+					MockDescribeVolumesModifications: describeModifications(),
+				},
+				cr: volume(withExternalName(volumeID), withSpec(specParams(size))),
+			},
+			want: want{
+				cr: volume(withExternalName(volumeID), withSpec(specParams(size)),
+					withStatus(v1alpha1.VolumeObservation{VolumeID: volumeID, State: string(awsec2.VolumeStateAvailable)}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SizeChanged": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockDescribeVolumes:              describe(awsec2.VolumeStateInUse, volumeTags()),
+					MockDescribeVolumesModifications: describeModifications(),
+				},
+				cr: volume(withExternalName(volumeID), withSpec(specParams(newSize))),
+			},
+			want: want{
+				cr: volume(withExternalName(volumeID), withSpec(specParams(newSize)),
+					withStatus(v1alpha1.VolumeObservation{VolumeID: volumeID, State: string(awsec2.VolumeStateInUse)}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ModificationInProgress": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockDescribeVolumes: describe(awsec2.VolumeStateInUse, volumeTags()),
+					MockDescribeVolumesModifications: describeModifications(
+						awsec2.VolumeModification{
+							ModificationState: awsec2.VolumeModificationStateCompleted,
+							StartTime:         aws.Time(time.Unix(0, 0)),
+							TargetSize:        aws.Int64(size),
+							TargetVolumeType:  awsec2.VolumeTypeGp2,
+						},
+						awsec2.VolumeModification{
+							ModificationState: awsec2.VolumeModificationStateModifying,
+							StartTime:         aws.Time(time.Unix(100, 0)),
+							TargetSize:        aws.Int64(newSize),
+							TargetVolumeType:  awsec2.VolumeTypeGp2,
+						},
+					),
+				},
+				cr: volume(withExternalName(volumeID), withSpec(specParams(newSize))),
+			},
+			want: want{
+				cr: volume(withExternalName(volumeID), withSpec(specParams(newSize)),
+					withStatus(v1alpha1.VolumeObservation{
+						VolumeID:          volumeID,
+						State:             string(awsec2.VolumeStateInUse),
+						ModificationState: string(awsec2.VolumeModificationStateModifying),
+					}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockDescribeVolumes: describe(awsec2.VolumeStateDeleted, volumeTags()),
+				},
+				cr: volume(withExternalName(volumeID), withSpec(specParams(size))),
+			},
+			want: want{
+				cr: volume(withExternalName(volumeID), withSpec(specParams(size))),
+			},
+		},
+		"DescribeModificationsFailed": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockDescribeVolumes: describe(awsec2.VolumeStateAvailable, volumeTags()),
+					MockDescribeVolumesModifications: func(input *awsec2.DescribeVolumesModificationsInput) awsec2.DescribeVolumesModificationsRequest {
+						return awsec2.DescribeVolumesModificationsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: volume(withExternalName(volumeID), withSpec(specParams(size))),
+			},
+			want: want{
+				cr:  volume(withExternalName(volumeID), withSpec(specParams(size))),
+				err: errors.Wrap(errBoom, errDescribeModification),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockDescribeVolumes: func(input *awsec2.DescribeVolumesInput) awsec2.DescribeVolumesRequest {
+						return awsec2.DescribeVolumesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: volume(withExternalName(volumeID)),
+			},
+			want: want{
+				cr:  volume(withExternalName(volumeID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.volume}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Volume
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockCreateVolume: func(input *awsec2.CreateVolumeInput) awsec2.CreateVolumeRequest {
+						return awsec2.CreateVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateVolumeOutput{
+								VolumeId: aws.String(volumeID),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: volume(withSpec(specParams(size))),
+			},
+			want: want{
+				cr: volume(withExternalName(volumeID), withSpec(specParams(size)),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockCreateVolume: func(input *awsec2.CreateVolumeInput) awsec2.CreateVolumeRequest {
+						return awsec2.CreateVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: volume(withSpec(specParams(size))),
+			},
+			want: want{
+				cr: volume(withSpec(specParams(size)),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.volume}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Volume
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Modified": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockDescribeVolumes:              describe(awsec2.VolumeStateInUse, volumeTags()),
+					MockDescribeVolumesModifications: describeModifications(),
+					MockModifyVolume: func(input *awsec2.ModifyVolumeInput) awsec2.ModifyVolumeRequest {
+						if diff := cmp.Diff(newSize, aws.Int64Value(input.Size)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if input.Iops != nil {
+							t.Errorf("ModifyVolume(...): unexpected IOPS for a gp2 volume")
+						}
+						return awsec2.ModifyVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyVolumeOutput{}},
+						}
+					},
+				},
+				cr: volume(withExternalName(volumeID), withSpec(specParams(newSize))),
+			},
+			want: want{
+				cr: volume(withExternalName(volumeID), withSpec(specParams(newSize))),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockDescribeVolumes:              describe(awsec2.VolumeStateInUse, volumeTags()),
+					MockDescribeVolumesModifications: describeModifications(),
+					MockModifyVolume: func(input *awsec2.ModifyVolumeInput) awsec2.ModifyVolumeRequest {
+						return awsec2.ModifyVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: volume(withExternalName(volumeID), withSpec(specParams(newSize))),
+			},
+			want: want{
+				cr:  volume(withExternalName(volumeID), withSpec(specParams(newSize))),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+		"TagsUpdated": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockDescribeVolumes:              describe(awsec2.VolumeStateInUse, []awsec2.Tag{{Key: aws.String("old"), Value: aws.String("value")}}),
+					MockDescribeVolumesModifications: describeModifications(),
+					MockDeleteTags: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTagsOutput{}},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+				},
+				cr: volume(withExternalName(volumeID), withSpec(specParams(size))),
+			},
+			want: want{
+				cr: volume(withExternalName(volumeID), withSpec(specParams(size))),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.volume}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Volume
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockDeleteVolume: func(input *awsec2.DeleteVolumeInput) awsec2.DeleteVolumeRequest {
+						return awsec2.DeleteVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVolumeOutput{}},
+						}
+					},
+				},
+				cr: volume(withExternalName(volumeID)),
+			},
+			want: want{
+				cr: volume(withExternalName(volumeID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockDeleteVolume: func(input *awsec2.DeleteVolumeInput) awsec2.DeleteVolumeRequest {
+						return awsec2.DeleteVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.VolumeIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: volume(withExternalName(volumeID)),
+			},
+			want: want{
+				cr: volume(withExternalName(volumeID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				volume: &fake.MockVolumeClient{
+					MockDeleteVolume: func(input *awsec2.DeleteVolumeInput) awsec2.DeleteVolumeRequest {
+						return awsec2.DeleteVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: volume(withExternalName(volumeID)),
+			},
+			want: want{
+				cr:  volume(withExternalName(volumeID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.volume}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
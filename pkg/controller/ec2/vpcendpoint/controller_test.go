@@ -0,0 +1,453 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcendpoint
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	endpointID  = "vpce-0123456789"
+	vpcID       = "vpc-0123456789"
+	serviceName = "com.amazonaws.us-east-1.s3"
+	errBoom     = errors.New("boom")
+)
+
+type endpointModifier func(*v1alpha4.VPCEndpoint)
+
+func withExternalName(name string) endpointModifier {
+	return func(r *v1alpha4.VPCEndpoint) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) endpointModifier {
+	return func(r *v1alpha4.VPCEndpoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha4.VPCEndpointParameters) endpointModifier {
+	return func(r *v1alpha4.VPCEndpoint) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha4.VPCEndpointObservation) endpointModifier {
+	return func(r *v1alpha4.VPCEndpoint) { r.Status.AtProvider = s }
+}
+
+func endpoint(m ...endpointModifier) *v1alpha4.VPCEndpoint {
+	cr := &v1alpha4.VPCEndpoint{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specParams(routeTables ...string) v1alpha4.VPCEndpointParameters {
+	return v1alpha4.VPCEndpointParameters{
+		VPCID:           aws.String(vpcID),
+		ServiceName:     serviceName,
+		VPCEndpointType: aws.String(v1alpha4.VPCEndpointTypeGateway),
+		RouteTableIDs:   routeTables,
+		Tags:            []v1beta1.Tag{{Key: "key1", Value: "value1"}},
+	}
+}
+
+func describe(state awsec2.State, routeTables ...string) func(*awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+	return func(input *awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+		return awsec2.DescribeVpcEndpointsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVpcEndpointsOutput{
+				VpcEndpoints: []awsec2.VpcEndpoint{{
+					VpcEndpointId:   aws.String(endpointID),
+					VpcId:           aws.String(vpcID),
+					ServiceName:     aws.String(serviceName),
+					VpcEndpointType: awsec2.VpcEndpointTypeGateway,
+					RouteTableIds:   routeTables,
+					State:           state,
+					Tags:            []awsec2.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+				}},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	endpoint ec2.VPCEndpointClient
+	kube     client.Client
+	cr       *v1alpha4.VPCEndpoint
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.VPCEndpoint
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				endpoint: &fake.MockVPCEndpointClient{},
+				cr:       endpoint(),
+			},
+			want: want{
+				cr: endpoint(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				endpoint: &fake.MockVPCEndpointClient{
+					MockDescribeVpcEndpoints: func(input *awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+						return awsec2.DescribeVpcEndpointsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.VPCEndpointIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID)),
+			},
+		},
+		"Available": {
+			args: args{
+				endpoint: &fake.MockVPCEndpointClient{
+					MockDescribeVpcEndpoints: describe(awsec2.StateAvailable, "rtb-1"),
+				},
+				cr: endpoint(withExternalName(endpointID), withSpec(specParams("rtb-1"))),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withSpec(specParams("rtb-1")),
+					withStatus(v1alpha4.VPCEndpointObservation{VPCEndpointID: endpointID, State: string(awsec2.StateAvailable)}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RouteTablesChanged": {
+			args: args{
+				endpoint: &fake.MockVPCEndpointClient{
+					MockDescribeVpcEndpoints: describe(awsec2.StatePending, "rtb-1"),
+				},
+				cr: endpoint(withExternalName(endpointID), withSpec(specParams("rtb-1", "rtb-2"))),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withSpec(specParams("rtb-1", "rtb-2")),
+					withStatus(v1alpha4.VPCEndpointObservation{VPCEndpointID: endpointID, State: string(awsec2.StatePending)}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				endpoint: &fake.MockVPCEndpointClient{
+					MockDescribeVpcEndpoints: describe(awsec2.StateDeleted),
+				},
+				cr: endpoint(withExternalName(endpointID), withSpec(specParams())),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withSpec(specParams())),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				endpoint: &fake.MockVPCEndpointClient{
+					MockDescribeVpcEndpoints: func(input *awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+						return awsec2.DescribeVpcEndpointsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr:  endpoint(withExternalName(endpointID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.endpoint}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.VPCEndpoint
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				endpoint: &fake.MockVPCEndpointClient{
+					MockCreateVpcEndpoint: func(input *awsec2.CreateVpcEndpointInput) awsec2.CreateVpcEndpointRequest {
+						return awsec2.CreateVpcEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateVpcEndpointOutput{
+								VpcEndpoint: &awsec2.VpcEndpoint{VpcEndpointId: aws.String(endpointID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: endpoint(withSpec(specParams("rtb-1"))),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withSpec(specParams("rtb-1")),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				endpoint: &fake.MockVPCEndpointClient{
+					MockCreateVpcEndpoint: func(input *awsec2.CreateVpcEndpointInput) awsec2.CreateVpcEndpointRequest {
+						return awsec2.CreateVpcEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: endpoint(withSpec(specParams("rtb-1"))),
+			},
+			want: want{
+				cr: endpoint(withSpec(specParams("rtb-1")),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.endpoint}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.VPCEndpoint
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Modified": {
+			args: args{
+				endpoint: &fake.MockVPCEndpointClient{
+					MockDescribeVpcEndpoints: describe(awsec2.StateAvailable, "rtb-1"),
+					MockModifyVpcEndpoint: func(input *awsec2.ModifyVpcEndpointInput) awsec2.ModifyVpcEndpointRequest {
+						if diff := cmp.Diff([]string{"rtb-2"}, input.AddRouteTableIds); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyVpcEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyVpcEndpointOutput{}},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID), withSpec(specParams("rtb-1", "rtb-2"))),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withSpec(specParams("rtb-1", "rtb-2"))),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				endpoint: &fake.MockVPCEndpointClient{
+					MockDescribeVpcEndpoints: describe(awsec2.StateAvailable, "rtb-1"),
+					MockModifyVpcEndpoint: func(input *awsec2.ModifyVpcEndpointInput) awsec2.ModifyVpcEndpointRequest {
+						return awsec2.ModifyVpcEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID), withSpec(specParams("rtb-2"))),
+			},
+			want: want{
+				cr:  endpoint(withExternalName(endpointID), withSpec(specParams("rtb-2"))),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.endpoint}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha4.VPCEndpoint
+		err error
+	}
+
+	deleteResponse := func(u ...awsec2.UnsuccessfulItem) func(*awsec2.DeleteVpcEndpointsInput) awsec2.DeleteVpcEndpointsRequest {
+		return func(input *awsec2.DeleteVpcEndpointsInput) awsec2.DeleteVpcEndpointsRequest {
+			return awsec2.DeleteVpcEndpointsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVpcEndpointsOutput{
+					Unsuccessful: u,
+				}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				endpoint: &fake.MockVPCEndpointClient{
+					MockDeleteVpcEndpoints: deleteResponse(),
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				endpoint: &fake.MockVPCEndpointClient{
+					MockDeleteVpcEndpoints: deleteResponse(awsec2.UnsuccessfulItem{
+						Error: &awsec2.UnsuccessfulItemError{Code: aws.String(ec2.VPCEndpointIDNotFound)},
+					}),
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteUnsuccessful": {
+			args: args{
+				endpoint: &fake.MockVPCEndpointClient{
+					MockDeleteVpcEndpoints: deleteResponse(awsec2.UnsuccessfulItem{
+						Error: &awsec2.UnsuccessfulItemError{Code: aws.String("Client.Error"), Message: aws.String(errBoom.Error())},
+					}),
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr:  endpoint(withExternalName(endpointID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				endpoint: &fake.MockVPCEndpointClient{
+					MockDeleteVpcEndpoints: func(input *awsec2.DeleteVpcEndpointsInput) awsec2.DeleteVpcEndpointsRequest {
+						return awsec2.DeleteVpcEndpointsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr:  endpoint(withExternalName(endpointID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.endpoint}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcendpointserviceconfiguration
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VPCEndpointServiceConfiguration resource"
+	errDescribe         = "failed to describe VPCEndpointServiceConfiguration"
+	errDescribePerms    = "failed to describe the permissions of the VPCEndpointServiceConfiguration resource"
+	errNotSingleItem    = "either no or multiple VPCEndpointServiceConfigurations retrieved for the given serviceId"
+	errSpecUpdate       = "cannot update spec of the VPCEndpointServiceConfiguration resource"
+	errCreate           = "failed to create the VPCEndpointServiceConfiguration resource"
+	errModify           = "failed to modify the VPCEndpointServiceConfiguration resource"
+	errModifyPerms      = "failed to modify the permissions of the VPCEndpointServiceConfiguration resource"
+	errVerify           = "failed to start the verification of the private DNS name of the VPCEndpointServiceConfiguration resource"
+	errDelete           = "failed to delete the VPCEndpointServiceConfiguration resource"
+	errUpdateTags       = "failed to update tags for the VPCEndpointServiceConfiguration resource"
+	errDeleteTags       = "failed to delete tags for VPCEndpointServiceConfiguration resource"
+)
+
+// SetupVPCEndpointServiceConfiguration adds a controller that reconciles
+// VPCEndpointServiceConfigurations.
+func SetupVPCEndpointServiceConfiguration(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha4.VPCEndpointServiceConfigurationGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha4.VPCEndpointServiceConfiguration{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha4.VPCEndpointServiceConfigurationGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPCEndpointServiceConfigurationClient}, awscommon.DeletionTierWorkload)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.VPCEndpointServiceConfigurationClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha4.VPCEndpointServiceConfiguration)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.VPCEndpointServiceConfigurationClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.ServiceConfiguration, error) {
+	response, err := e.client.DescribeVpcEndpointServiceConfigurationsRequest(&awsec2.DescribeVpcEndpointServiceConfigurationsInput{
+		ServiceIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.ServiceConfigurations) != 1 {
+		return nil, errors.New(errNotSingleItem)
+	}
+	return &response.ServiceConfigurations[0], nil
+}
+
+func (e *external) describePermissions(ctx context.Context, id string) ([]awsec2.AllowedPrincipal, error) {
+	var principals []awsec2.AllowedPrincipal
+	in := &awsec2.DescribeVpcEndpointServicePermissionsInput{ServiceId: aws.String(id)}
+	for {
+		response, err := e.client.DescribeVpcEndpointServicePermissionsRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		principals = append(principals, response.AllowedPrincipals...)
+		if aws.StringValue(response.NextToken) == "" {
+			return principals, nil
+		}
+		in.NextToken = response.NextToken
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha4.VPCEndpointServiceConfiguration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, id)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsVPCEndpointServiceNotFoundErr, err), errDescribe)
+	}
+
+	if observed.ServiceState == awsec2.ServiceStateDeleted {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	principals, err := e.describePermissions(ctx, id)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribePerms)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeVPCEndpointServiceConfiguration(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateVPCEndpointServiceConfigurationObservation(*observed)
+
+	switch observed.ServiceState {
+	case awsec2.ServiceStatePending:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsec2.ServiceStateAvailable:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsec2.ServiceStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsVPCEndpointServiceConfigurationUpToDate(cr.Spec.ForProvider, *observed, principals),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha4.VPCEndpointServiceConfiguration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	resp, err := e.client.CreateVpcEndpointServiceConfigurationRequest(ec2.GenerateCreateVPCEndpointServiceConfigurationInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(resp.ServiceConfiguration.ServiceId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha4.VPCEndpointServiceConfiguration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	id := meta.GetExternalName(cr)
+	observed, err := e.describe(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(ec2.IsVPCEndpointServiceNotFoundErr, err), errDescribe)
+	}
+	principals, err := e.describePermissions(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribePerms)
+	}
+
+	if in := ec2.GenerateModifyVPCEndpointServiceConfigurationInput(id, cr.Spec.ForProvider, *observed); in != nil {
+		if _, err := e.client.ModifyVpcEndpointServiceConfigurationRequest(in).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+		}
+	}
+
+	if in := ec2.GenerateModifyVPCEndpointServicePermissionsInput(id, cr.Spec.ForProvider, principals); in != nil {
+		if _, err := e.client.ModifyVpcEndpointServicePermissionsRequest(in).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyPerms)
+		}
+	}
+
+	addTags, removeTags := awscommon.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+
+	// A private DNS name that has just been changed is verified on the next
+	// reconciliation, once AWS reports it as pending.
+	if ec2.IsPrivateDNSNameVerificationNeeded(*observed) {
+		_, err := e.client.StartVpcEndpointServicePrivateDnsVerificationRequest(&awsec2.StartVpcEndpointServicePrivateDnsVerificationInput{
+			ServiceId: aws.String(id),
+		}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errVerify)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha4.VPCEndpointServiceConfiguration)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	resp, err := e.client.DeleteVpcEndpointServiceConfigurationsRequest(&awsec2.DeleteVpcEndpointServiceConfigurationsInput{
+		ServiceIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ec2.IsVPCEndpointServiceNotFoundErr, err), errDelete)
+	}
+
+	// DeleteVpcEndpointServiceConfigurations reports failures per service
+	// rather than as an error of the request.
+	for _, u := range resp.Unsuccessful {
+		if u.Error == nil {
+			continue
+		}
+		if code := aws.StringValue(u.Error.Code); code != ec2.VPCEndpointServiceIDNotFound && code != ec2.VPCEndpointServiceIDMalformed {
+			return errors.Wrap(errors.New(aws.StringValue(u.Error.Message)), errDelete)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,543 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcendpointserviceconfiguration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	serviceID   = "vpce-svc-0123456789"
+	serviceName = "com.amazonaws.vpce.us-east-1.vpce-svc-0123456789"
+	nlbARN      = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/example/0123456789"
+	principal   = "arn:aws:iam::123456789012:root"
+	errBoom     = errors.New("boom")
+)
+
+type serviceModifier func(*v1alpha4.VPCEndpointServiceConfiguration)
+
+func withExternalName(name string) serviceModifier {
+	return func(r *v1alpha4.VPCEndpointServiceConfiguration) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) serviceModifier {
+	return func(r *v1alpha4.VPCEndpointServiceConfiguration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha4.VPCEndpointServiceConfigurationParameters) serviceModifier {
+	return func(r *v1alpha4.VPCEndpointServiceConfiguration) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha4.VPCEndpointServiceConfigurationObservation) serviceModifier {
+	return func(r *v1alpha4.VPCEndpointServiceConfiguration) { r.Status.AtProvider = s }
+}
+
+func service(m ...serviceModifier) *v1alpha4.VPCEndpointServiceConfiguration {
+	cr := &v1alpha4.VPCEndpointServiceConfiguration{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specParams(principals ...string) v1alpha4.VPCEndpointServiceConfigurationParameters {
+	return v1alpha4.VPCEndpointServiceConfigurationParameters{
+		AcceptanceRequired:      aws.Bool(true),
+		NetworkLoadBalancerARNs: []string{nlbARN},
+		AllowedPrincipals:       principals,
+	}
+}
+
+func observation(state awsec2.ServiceState) v1alpha4.VPCEndpointServiceConfigurationObservation {
+	return v1alpha4.VPCEndpointServiceConfigurationObservation{
+		ServiceID:    serviceID,
+		ServiceName:  serviceName,
+		ServiceState: string(state),
+	}
+}
+
+func describe(state awsec2.ServiceState, dns *awsec2.PrivateDnsNameConfiguration) func(*awsec2.DescribeVpcEndpointServiceConfigurationsInput) awsec2.DescribeVpcEndpointServiceConfigurationsRequest {
+	return func(input *awsec2.DescribeVpcEndpointServiceConfigurationsInput) awsec2.DescribeVpcEndpointServiceConfigurationsRequest {
+		return awsec2.DescribeVpcEndpointServiceConfigurationsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVpcEndpointServiceConfigurationsOutput{
+				ServiceConfigurations: []awsec2.ServiceConfiguration{{
+					ServiceId:                   aws.String(serviceID),
+					ServiceName:                 aws.String(serviceName),
+					ServiceState:                state,
+					AcceptanceRequired:          aws.Bool(true),
+					NetworkLoadBalancerArns:     []string{nlbARN},
+					PrivateDnsNameConfiguration: dns,
+				}},
+			}},
+		}
+	}
+}
+
+func describePermissions(principals ...string) func(*awsec2.DescribeVpcEndpointServicePermissionsInput) awsec2.DescribeVpcEndpointServicePermissionsRequest {
+	return func(input *awsec2.DescribeVpcEndpointServicePermissionsInput) awsec2.DescribeVpcEndpointServicePermissionsRequest {
+		out := &awsec2.DescribeVpcEndpointServicePermissionsOutput{}
+		for _, p := range principals {
+			out.AllowedPrincipals = append(out.AllowedPrincipals, awsec2.AllowedPrincipal{Principal: aws.String(p)})
+		}
+		return awsec2.DescribeVpcEndpointServicePermissionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	service ec2.VPCEndpointServiceConfigurationClient
+	kube    client.Client
+	cr      *v1alpha4.VPCEndpointServiceConfiguration
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.VPCEndpointServiceConfiguration
+		result managed.ExternalObservation
+		err    error
+	}
+
+	pending := &awsec2.PrivateDnsNameConfiguration{
+		Name:  aws.String("_vpce"),
+		State: awsec2.DnsNameStatePendingVerification,
+		Type:  aws.String("TXT"),
+		Value: aws.String("vpce:token"),
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{},
+				cr:      service(),
+			},
+			want: want{
+				cr: service(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockDescribeVpcEndpointServiceConfigurations: func(input *awsec2.DescribeVpcEndpointServiceConfigurationsInput) awsec2.DescribeVpcEndpointServiceConfigurationsRequest {
+						return awsec2.DescribeVpcEndpointServiceConfigurationsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.VPCEndpointServiceIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID)),
+			},
+		},
+		"Available": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockDescribeVpcEndpointServiceConfigurations: describe(awsec2.ServiceStateAvailable, nil),
+					MockDescribeVpcEndpointServicePermissions:    describePermissions(principal),
+				},
+				cr: service(withExternalName(serviceID), withSpec(specParams(principal))),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withSpec(specParams(principal)),
+					withStatus(observation(awsec2.ServiceStateAvailable)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PrincipalsChanged": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockDescribeVpcEndpointServiceConfigurations: describe(awsec2.ServiceStateAvailable, nil),
+					MockDescribeVpcEndpointServicePermissions:    describePermissions(),
+				},
+				cr: service(withExternalName(serviceID), withSpec(specParams(principal))),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withSpec(specParams(principal)),
+					withStatus(observation(awsec2.ServiceStateAvailable)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PendingVerification": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockDescribeVpcEndpointServiceConfigurations: describe(awsec2.ServiceStatePending, pending),
+					MockDescribeVpcEndpointServicePermissions:    describePermissions(),
+				},
+				cr: service(withExternalName(serviceID), withSpec(specParams())),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withSpec(specParams()),
+					withStatus(v1alpha4.VPCEndpointServiceConfigurationObservation{
+						ServiceID:    serviceID,
+						ServiceName:  serviceName,
+						ServiceState: string(awsec2.ServiceStatePending),
+						PrivateDNSNameConfiguration: &v1alpha4.PrivateDNSNameConfiguration{
+							Name:  "_vpce",
+							State: string(awsec2.DnsNameStatePendingVerification),
+							Type:  "TXT",
+							Value: "vpce:token",
+						},
+					}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockDescribeVpcEndpointServiceConfigurations: describe(awsec2.ServiceStateDeleted, nil),
+				},
+				cr: service(withExternalName(serviceID), withSpec(specParams())),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withSpec(specParams())),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockDescribeVpcEndpointServiceConfigurations: func(input *awsec2.DescribeVpcEndpointServiceConfigurationsInput) awsec2.DescribeVpcEndpointServiceConfigurationsRequest {
+						return awsec2.DescribeVpcEndpointServiceConfigurationsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr:  service(withExternalName(serviceID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"DescribePermissionsFailed": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockDescribeVpcEndpointServiceConfigurations: describe(awsec2.ServiceStateAvailable, nil),
+					MockDescribeVpcEndpointServicePermissions: func(input *awsec2.DescribeVpcEndpointServicePermissionsInput) awsec2.DescribeVpcEndpointServicePermissionsRequest {
+						return awsec2.DescribeVpcEndpointServicePermissionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: service(withExternalName(serviceID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  service(withExternalName(serviceID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errDescribePerms),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.service}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.VPCEndpointServiceConfiguration
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockCreateVpcEndpointServiceConfiguration: func(input *awsec2.CreateVpcEndpointServiceConfigurationInput) awsec2.CreateVpcEndpointServiceConfigurationRequest {
+						return awsec2.CreateVpcEndpointServiceConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateVpcEndpointServiceConfigurationOutput{
+								ServiceConfiguration: &awsec2.ServiceConfiguration{ServiceId: aws.String(serviceID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: service(withSpec(specParams())),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withSpec(specParams()),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockCreateVpcEndpointServiceConfiguration: func(input *awsec2.CreateVpcEndpointServiceConfigurationInput) awsec2.CreateVpcEndpointServiceConfigurationRequest {
+						return awsec2.CreateVpcEndpointServiceConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: service(withSpec(specParams())),
+			},
+			want: want{
+				cr: service(withSpec(specParams()),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.service}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.VPCEndpointServiceConfiguration
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PermissionsModified": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockDescribeVpcEndpointServiceConfigurations: describe(awsec2.ServiceStateAvailable, nil),
+					MockDescribeVpcEndpointServicePermissions:    describePermissions(),
+					MockModifyVpcEndpointServicePermissions: func(input *awsec2.ModifyVpcEndpointServicePermissionsInput) awsec2.ModifyVpcEndpointServicePermissionsRequest {
+						if diff := cmp.Diff([]string{principal}, input.AddAllowedPrincipals); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyVpcEndpointServicePermissionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyVpcEndpointServicePermissionsOutput{}},
+						}
+					},
+				},
+				cr: service(withExternalName(serviceID), withSpec(specParams(principal))),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withSpec(specParams(principal))),
+			},
+		},
+		"VerificationStarted": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockDescribeVpcEndpointServiceConfigurations: describe(awsec2.ServiceStateAvailable, &awsec2.PrivateDnsNameConfiguration{State: awsec2.DnsNameStateFailed}),
+					MockDescribeVpcEndpointServicePermissions:    describePermissions(),
+					MockStartVpcEndpointServicePrivateDnsVerification: func(input *awsec2.StartVpcEndpointServicePrivateDnsVerificationInput) awsec2.StartVpcEndpointServicePrivateDnsVerificationRequest {
+						return awsec2.StartVpcEndpointServicePrivateDnsVerificationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.StartVpcEndpointServicePrivateDnsVerificationOutput{}},
+						}
+					},
+				},
+				cr: service(withExternalName(serviceID), withSpec(specParams())),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withSpec(specParams())),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockDescribeVpcEndpointServiceConfigurations: describe(awsec2.ServiceStateAvailable, nil),
+					MockDescribeVpcEndpointServicePermissions:    describePermissions(),
+					MockModifyVpcEndpointServiceConfiguration: func(input *awsec2.ModifyVpcEndpointServiceConfigurationInput) awsec2.ModifyVpcEndpointServiceConfigurationRequest {
+						return awsec2.ModifyVpcEndpointServiceConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: service(withExternalName(serviceID), withSpec(v1alpha4.VPCEndpointServiceConfigurationParameters{AcceptanceRequired: aws.Bool(false)})),
+			},
+			want: want{
+				cr:  service(withExternalName(serviceID), withSpec(v1alpha4.VPCEndpointServiceConfigurationParameters{AcceptanceRequired: aws.Bool(false)})),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.service}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha4.VPCEndpointServiceConfiguration
+		err error
+	}
+
+	deleteResponse := func(u ...awsec2.UnsuccessfulItem) func(*awsec2.DeleteVpcEndpointServiceConfigurationsInput) awsec2.DeleteVpcEndpointServiceConfigurationsRequest {
+		return func(input *awsec2.DeleteVpcEndpointServiceConfigurationsInput) awsec2.DeleteVpcEndpointServiceConfigurationsRequest {
+			return awsec2.DeleteVpcEndpointServiceConfigurationsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVpcEndpointServiceConfigurationsOutput{
+					Unsuccessful: u,
+				}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockDeleteVpcEndpointServiceConfigurations: deleteResponse(),
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockDeleteVpcEndpointServiceConfigurations: deleteResponse(awsec2.UnsuccessfulItem{
+						Error: &awsec2.UnsuccessfulItemError{Code: aws.String(ec2.VPCEndpointServiceIDNotFound)},
+					}),
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteUnsuccessful": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockDeleteVpcEndpointServiceConfigurations: deleteResponse(awsec2.UnsuccessfulItem{
+						Error: &awsec2.UnsuccessfulItemError{Code: aws.String("ExistingVpcEndpointConnections"), Message: aws.String(errBoom.Error())},
+					}),
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr:  service(withExternalName(serviceID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				service: &fake.MockVPCEndpointServiceConfigurationClient{
+					MockDeleteVpcEndpointServiceConfigurations: func(input *awsec2.DeleteVpcEndpointServiceConfigurationsInput) awsec2.DeleteVpcEndpointServiceConfigurationsRequest {
+						return awsec2.DeleteVpcEndpointServiceConfigurationsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr:  service(withExternalName(serviceID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.service}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
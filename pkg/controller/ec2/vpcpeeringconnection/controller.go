@@ -0,0 +1,306 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcpeeringconnection
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject  = "The managed resource is not a VPCPeeringConnection resource"
+	errAccepterConfig    = "cannot get the config of the accepter of the VPCPeeringConnection"
+	errDescribe          = "failed to describe VPCPeeringConnection"
+	errNotSingleItem     = "either no or multiple VPCPeeringConnections retrieved for the given vpcPeeringConnectionId"
+	errSpecUpdate        = "cannot update spec of the VPCPeeringConnection resource"
+	errCreate            = "failed to create the VPCPeeringConnection resource"
+	errAccept            = "failed to accept the VPCPeeringConnection resource"
+	errModifyRequester   = "failed to modify the requester options of the VPCPeeringConnection resource"
+	errModifyAccepter    = "failed to modify the accepter options of the VPCPeeringConnection resource"
+	errDelete            = "failed to delete the VPCPeeringConnection resource"
+	errUpdateTags        = "failed to update tags for the VPCPeeringConnection resource"
+	errDeleteTags        = "failed to delete tags for VPCPeeringConnection resource"
+	msgPendingAcceptance = "waiting for the owner of the accepter VPC to accept the peering connection"
+)
+
+// SetupVPCPeeringConnection adds a controller that reconciles
+// VPCPeeringConnections.
+func SetupVPCPeeringConnection(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.VPCPeeringConnectionGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.VPCPeeringConnection{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.VPCPeeringConnectionGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPCPeeringConnectionClient}, awscommon.DeletionTierNetwork)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.VPCPeeringConnectionClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.VPCPeeringConnection)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	// The peering connection has to be accepted and the options of the
+	// accepter VPC have to be modified in the region of the accepter VPC,
+	// using the credentials of its owner.
+	region := cr.Spec.ForProvider.Region
+	if cr.Spec.ForProvider.PeerRegion != nil {
+		region = aws.StringValue(cr.Spec.ForProvider.PeerRegion)
+	}
+	var accCfg *aws.Config
+	if ref := cr.Spec.ForProvider.AccepterProviderConfigRef; ref != nil {
+		accCfg, err = awscommon.UseNamedProviderConfig(ctx, c.kube, ref.Name, region)
+	} else {
+		accCfg, err = awscommon.GetConfig(ctx, c.kube, mg, region)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errAccepterConfig)
+	}
+	return &external{client: c.newClientFn(*cfg), accepter: c.newClientFn(*accCfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube     client.Client
+	client   ec2.VPCPeeringConnectionClient
+	accepter ec2.VPCPeeringConnectionClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.VpcPeeringConnection, error) {
+	response, err := e.client.DescribeVpcPeeringConnectionsRequest(&awsec2.DescribeVpcPeeringConnectionsInput{
+		VpcPeeringConnectionIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.VpcPeeringConnections) != 1 {
+		return nil, errors.New(errNotSingleItem)
+	}
+	return &response.VpcPeeringConnections[0], nil
+}
+
+// canAccept returns whether the controller is able to accept the peering
+// connection, i.e. whether it has the credentials of the owner of the
+// accepter VPC.
+func canAccept(cr *v1alpha1.VPCPeeringConnection, pc awsec2.VpcPeeringConnection) bool {
+	if cr.Spec.ForProvider.AccepterProviderConfigRef != nil {
+		return true
+	}
+	return pc.AccepterVpcInfo != nil && pc.RequesterVpcInfo != nil &&
+		aws.StringValue(pc.AccepterVpcInfo.OwnerId) == aws.StringValue(pc.RequesterVpcInfo.OwnerId)
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.VPCPeeringConnection)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsVPCPeeringConnectionNotFoundErr, err), errDescribe)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateVPCPeeringConnectionObservation(*observed)
+
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.VPCPeeringConnectionStateDeleted:
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	case v1alpha1.VPCPeeringConnectionStateRejected, v1alpha1.VPCPeeringConnectionStateFailed, v1alpha1.VPCPeeringConnectionStateExpired:
+		// Peering connections in these states cannot be deleted and are
+		// cleaned up by AWS eventually.
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{
+				ResourceExists: false,
+			}, nil
+		}
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeVPCPeeringConnection(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	upToDate := v1beta1.CompareTags(cr.Spec.ForProvider.Tags, observed.Tags)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.VPCPeeringConnectionStateInitiatingRequest, v1alpha1.VPCPeeringConnectionStateProvisioning:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha1.VPCPeeringConnectionStatePendingAcceptance:
+		if canAccept(cr, *observed) {
+			cr.SetConditions(runtimev1alpha1.Creating())
+			upToDate = false
+			break
+		}
+		cr.SetConditions(runtimev1alpha1.Unavailable().WithMessage(msgPendingAcceptance))
+	case v1alpha1.VPCPeeringConnectionStateActive:
+		cr.SetConditions(runtimev1alpha1.Available())
+		upToDate = upToDate &&
+			ec2.IsRequesterPeeringOptionsUpToDate(cr.Spec.ForProvider, *observed) &&
+			ec2.IsAccepterPeeringOptionsUpToDate(cr.Spec.ForProvider, *observed)
+	case v1alpha1.VPCPeeringConnectionStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	case v1alpha1.VPCPeeringConnectionStateRejected, v1alpha1.VPCPeeringConnectionStateFailed, v1alpha1.VPCPeeringConnectionStateExpired:
+		cr.SetConditions(runtimev1alpha1.Unavailable().WithMessage(cr.Status.AtProvider.StateMessage))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.VPCPeeringConnection)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	pc, err := e.client.CreateVpcPeeringConnectionRequest(ec2.GenerateCreateVPCPeeringConnectionInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(pc.VpcPeeringConnection.VpcPeeringConnectionId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.VPCPeeringConnection)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(ec2.IsVPCPeeringConnectionNotFoundErr, err), errDescribe)
+	}
+
+	switch string(observed.Status.Code) {
+	case v1alpha1.VPCPeeringConnectionStatePendingAcceptance:
+		if canAccept(cr, *observed) {
+			if _, err := e.accepter.AcceptVpcPeeringConnectionRequest(&awsec2.AcceptVpcPeeringConnectionInput{
+				VpcPeeringConnectionId: aws.String(meta.GetExternalName(cr)),
+			}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errAccept)
+			}
+		}
+	case v1alpha1.VPCPeeringConnectionStateActive:
+		// The options of each side of a peering connection have to be
+		// modified by the owner of the respective VPC in its region.
+		if !ec2.IsRequesterPeeringOptionsUpToDate(cr.Spec.ForProvider, *observed) {
+			if _, err := e.client.ModifyVpcPeeringConnectionOptionsRequest(&awsec2.ModifyVpcPeeringConnectionOptionsInput{
+				VpcPeeringConnectionId:            aws.String(meta.GetExternalName(cr)),
+				RequesterPeeringConnectionOptions: ec2.GeneratePeeringConnectionOptionsRequest(cr.Spec.ForProvider.RequesterPeeringOptions),
+			}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errModifyRequester)
+			}
+		}
+		if !ec2.IsAccepterPeeringOptionsUpToDate(cr.Spec.ForProvider, *observed) {
+			if _, err := e.accepter.ModifyVpcPeeringConnectionOptionsRequest(&awsec2.ModifyVpcPeeringConnectionOptionsInput{
+				VpcPeeringConnectionId:           aws.String(meta.GetExternalName(cr)),
+				AccepterPeeringConnectionOptions: ec2.GeneratePeeringConnectionOptionsRequest(cr.Spec.ForProvider.AccepterPeeringOptions),
+			}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errModifyAccepter)
+			}
+		}
+	}
+
+	addTags, removeTags := awscommon.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.VPCPeeringConnection)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.VPCPeeringConnectionStateDeleted, v1alpha1.VPCPeeringConnectionStateDeleting:
+		return nil
+	}
+
+	_, err := e.client.DeleteVpcPeeringConnectionRequest(&awsec2.DeleteVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(ec2.IsVPCPeeringConnectionNotFoundErr, err), errDelete)
+}
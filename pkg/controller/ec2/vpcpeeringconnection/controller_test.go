@@ -0,0 +1,528 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcpeeringconnection
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	pcxID         = "pcx-0123456789"
+	vpcID         = "vpc-requester"
+	peerVPCID     = "vpc-accepter"
+	ownerID       = "111111111111"
+	peerOwnerID   = "222222222222"
+	region        = "us-east-1"
+	errBoom       = errors.New("boom")
+	optionsDNSOn  = &v1alpha1.VPCPeeringConnectionOptions{AllowDNSResolutionFromRemoteVPC: aws.Bool(true)}
+	accepterPCRef = &runtimev1alpha1.Reference{Name: "accepter"}
+)
+
+type pcxModifier func(*v1alpha1.VPCPeeringConnection)
+
+func withExternalName(name string) pcxModifier {
+	return func(r *v1alpha1.VPCPeeringConnection) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) pcxModifier {
+	return func(r *v1alpha1.VPCPeeringConnection) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.VPCPeeringConnectionParameters) pcxModifier {
+	return func(r *v1alpha1.VPCPeeringConnection) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.VPCPeeringConnectionObservation) pcxModifier {
+	return func(r *v1alpha1.VPCPeeringConnection) { r.Status.AtProvider = s }
+}
+
+func pcx(m ...pcxModifier) *v1alpha1.VPCPeeringConnection {
+	cr := &v1alpha1.VPCPeeringConnection{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.VPCPeeringConnectionParameters)) v1alpha1.VPCPeeringConnectionParameters {
+	p := v1alpha1.VPCPeeringConnectionParameters{
+		VPCID:       &vpcID,
+		PeerVPCID:   &peerVPCID,
+		PeerOwnerID: &ownerID,
+		PeerRegion:  &region,
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func peeringConnection(state awsec2.VpcPeeringConnectionStateReasonCode, accepterOwner string, dns bool) awsec2.VpcPeeringConnection {
+	return awsec2.VpcPeeringConnection{
+		VpcPeeringConnectionId: aws.String(pcxID),
+		Status:                 &awsec2.VpcPeeringConnectionStateReason{Code: state},
+		RequesterVpcInfo: &awsec2.VpcPeeringConnectionVpcInfo{
+			OwnerId: aws.String(ownerID),
+			Region:  aws.String(region),
+			VpcId:   aws.String(vpcID),
+			PeeringOptions: &awsec2.VpcPeeringConnectionOptionsDescription{
+				AllowDnsResolutionFromRemoteVpc: aws.Bool(dns),
+			},
+		},
+		AccepterVpcInfo: &awsec2.VpcPeeringConnectionVpcInfo{
+			OwnerId: aws.String(accepterOwner),
+			Region:  aws.String(region),
+			VpcId:   aws.String(peerVPCID),
+		},
+	}
+}
+
+func observation(state string, accepterOwner string) v1alpha1.VPCPeeringConnectionObservation {
+	return v1alpha1.VPCPeeringConnectionObservation{
+		State: state,
+		RequesterVPCInfo: v1alpha1.VPCPeeringConnectionVPCInfo{
+			OwnerID: ownerID,
+			Region:  region,
+			VPCID:   vpcID,
+		},
+		AccepterVPCInfo: v1alpha1.VPCPeeringConnectionVPCInfo{
+			OwnerID: accepterOwner,
+			Region:  region,
+			VPCID:   peerVPCID,
+		},
+	}
+}
+
+func describe(pc awsec2.VpcPeeringConnection) func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+	return func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+		return awsec2.DescribeVpcPeeringConnectionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVpcPeeringConnectionsOutput{
+				VpcPeeringConnections: []awsec2.VpcPeeringConnection{pc},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client   ec2.VPCPeeringConnectionClient
+	accepter ec2.VPCPeeringConnectionClient
+	kube     client.Client
+	cr       *v1alpha1.VPCPeeringConnection
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.VPCPeeringConnection
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{},
+				cr:     pcx(),
+			},
+			want: want{
+				cr: pcx(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribeVpcPeeringConnections: func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+						return awsec2.DescribeVpcPeeringConnectionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.VPCPeeringConnectionIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: pcx(withExternalName(pcxID)),
+			},
+			want: want{
+				cr: pcx(withExternalName(pcxID)),
+			},
+		},
+		"Active": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribeVpcPeeringConnections: describe(peeringConnection(awsec2.VpcPeeringConnectionStateReasonCodeActive, ownerID, true)),
+				},
+				cr: pcx(withExternalName(pcxID), withSpec(params(func(p *v1alpha1.VPCPeeringConnectionParameters) { p.RequesterPeeringOptions = optionsDNSOn }))),
+			},
+			want: want{
+				cr: pcx(withExternalName(pcxID),
+					withSpec(params(func(p *v1alpha1.VPCPeeringConnectionParameters) { p.RequesterPeeringOptions = optionsDNSOn })),
+					withStatus(observation(v1alpha1.VPCPeeringConnectionStateActive, ownerID)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ActiveOptionsOutdated": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribeVpcPeeringConnections: describe(peeringConnection(awsec2.VpcPeeringConnectionStateReasonCodeActive, ownerID, false)),
+				},
+				cr: pcx(withExternalName(pcxID), withSpec(params(func(p *v1alpha1.VPCPeeringConnectionParameters) { p.RequesterPeeringOptions = optionsDNSOn }))),
+			},
+			want: want{
+				cr: pcx(withExternalName(pcxID),
+					withSpec(params(func(p *v1alpha1.VPCPeeringConnectionParameters) { p.RequesterPeeringOptions = optionsDNSOn })),
+					withStatus(observation(v1alpha1.VPCPeeringConnectionStateActive, ownerID)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"PendingAcceptanceSameAccount": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribeVpcPeeringConnections: describe(peeringConnection(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, ownerID, false)),
+				},
+				cr: pcx(withExternalName(pcxID), withSpec(params())),
+			},
+			want: want{
+				cr: pcx(withExternalName(pcxID), withSpec(params()),
+					withStatus(observation(v1alpha1.VPCPeeringConnectionStatePendingAcceptance, ownerID)),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"PendingAcceptanceOtherAccount": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribeVpcPeeringConnections: describe(peeringConnection(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, peerOwnerID, false)),
+				},
+				cr: pcx(withExternalName(pcxID), withSpec(params(func(p *v1alpha1.VPCPeeringConnectionParameters) { p.PeerOwnerID = &peerOwnerID }))),
+			},
+			want: want{
+				cr: pcx(withExternalName(pcxID),
+					withSpec(params(func(p *v1alpha1.VPCPeeringConnectionParameters) { p.PeerOwnerID = &peerOwnerID })),
+					withStatus(observation(v1alpha1.VPCPeeringConnectionStatePendingAcceptance, peerOwnerID)),
+					withConditions(runtimev1alpha1.Unavailable().WithMessage(msgPendingAcceptance))),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PendingAcceptanceOtherAccountWithAccepter": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribeVpcPeeringConnections: describe(peeringConnection(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, peerOwnerID, false)),
+				},
+				cr: pcx(withExternalName(pcxID), withSpec(params(func(p *v1alpha1.VPCPeeringConnectionParameters) {
+					p.PeerOwnerID = &peerOwnerID
+					p.AccepterProviderConfigRef = accepterPCRef
+				}))),
+			},
+			want: want{
+				cr: pcx(withExternalName(pcxID),
+					withSpec(params(func(p *v1alpha1.VPCPeeringConnectionParameters) {
+						p.PeerOwnerID = &peerOwnerID
+						p.AccepterProviderConfigRef = accepterPCRef
+					})),
+					withStatus(observation(v1alpha1.VPCPeeringConnectionStatePendingAcceptance, peerOwnerID)),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribeVpcPeeringConnections: describe(peeringConnection(awsec2.VpcPeeringConnectionStateReasonCodeActive, ownerID, false)),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: pcx(withExternalName(pcxID)),
+			},
+			want: want{
+				cr: pcx(withExternalName(pcxID), withSpec(params()),
+					withStatus(observation(v1alpha1.VPCPeeringConnectionStateActive, ownerID))),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, accepter: tc.accepter}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.VPCPeeringConnection
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockCreateVpcPeeringConnection: func(*awsec2.CreateVpcPeeringConnectionInput) awsec2.CreateVpcPeeringConnectionRequest {
+						return awsec2.CreateVpcPeeringConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateVpcPeeringConnectionOutput{
+								VpcPeeringConnection: &awsec2.VpcPeeringConnection{VpcPeeringConnectionId: aws.String(pcxID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: pcx(withSpec(params())),
+			},
+			want: want{
+				cr: pcx(withExternalName(pcxID), withSpec(params())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockCreateVpcPeeringConnection: func(*awsec2.CreateVpcPeeringConnectionInput) awsec2.CreateVpcPeeringConnectionRequest {
+						return awsec2.CreateVpcPeeringConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: pcx(withSpec(params())),
+			},
+			want: want{
+				cr:  pcx(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, accepter: tc.accepter}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		accepted   bool
+		modifiedBy string
+		err        error
+	}
+
+	cases := map[string]struct {
+		pc   awsec2.VpcPeeringConnection
+		p    v1alpha1.VPCPeeringConnectionParameters
+		fail bool
+		want
+	}{
+		"Accept": {
+			pc: peeringConnection(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, ownerID, false),
+			p:  params(),
+			want: want{
+				accepted: true,
+			},
+		},
+		"AcceptFailed": {
+			pc:   peeringConnection(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, ownerID, false),
+			p:    params(),
+			fail: true,
+			want: want{
+				accepted: true,
+				err:      errors.Wrap(errBoom, errAccept),
+			},
+		},
+		"ModifyRequesterOptions": {
+			pc: peeringConnection(awsec2.VpcPeeringConnectionStateReasonCodeActive, ownerID, false),
+			p:  params(func(p *v1alpha1.VPCPeeringConnectionParameters) { p.RequesterPeeringOptions = optionsDNSOn }),
+			want: want{
+				modifiedBy: "requester",
+			},
+		},
+		"ModifyAccepterOptions": {
+			pc: peeringConnection(awsec2.VpcPeeringConnectionStateReasonCodeActive, ownerID, false),
+			p:  params(func(p *v1alpha1.VPCPeeringConnectionParameters) { p.AccepterPeeringOptions = optionsDNSOn }),
+			want: want{
+				modifiedBy: "accepter",
+			},
+		},
+		"ModifyAccepterOptionsFailed": {
+			pc:   peeringConnection(awsec2.VpcPeeringConnectionStateReasonCodeActive, ownerID, false),
+			p:    params(func(p *v1alpha1.VPCPeeringConnectionParameters) { p.AccepterPeeringOptions = optionsDNSOn }),
+			fail: true,
+			want: want{
+				modifiedBy: "accepter",
+				err:        errors.Wrap(errBoom, errModifyAccepter),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			accepted := false
+			modifiedBy := ""
+			var reqErr error
+			if tc.fail {
+				reqErr = errBoom
+			}
+			modify := func(by string) func(*awsec2.ModifyVpcPeeringConnectionOptionsInput) awsec2.ModifyVpcPeeringConnectionOptionsRequest {
+				return func(*awsec2.ModifyVpcPeeringConnectionOptionsInput) awsec2.ModifyVpcPeeringConnectionOptionsRequest {
+					modifiedBy = by
+					return awsec2.ModifyVpcPeeringConnectionOptionsRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyVpcPeeringConnectionOptionsOutput{}, Error: reqErr},
+					}
+				}
+			}
+			e := &external{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribeVpcPeeringConnections:     describe(tc.pc),
+					MockModifyVpcPeeringConnectionOptions: modify("requester"),
+				},
+				accepter: &fake.MockVPCPeeringConnectionClient{
+					MockAcceptVpcPeeringConnection: func(*awsec2.AcceptVpcPeeringConnectionInput) awsec2.AcceptVpcPeeringConnectionRequest {
+						accepted = true
+						return awsec2.AcceptVpcPeeringConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.AcceptVpcPeeringConnectionOutput{}, Error: reqErr},
+						}
+					},
+					MockModifyVpcPeeringConnectionOptions: modify("accepter"),
+				},
+			}
+			_, err := e.Update(context.Background(), pcx(withExternalName(pcxID), withSpec(tc.p)))
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.accepted, accepted); diff != "" {
+				t.Errorf("accepted: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modifiedBy, modifiedBy); diff != "" {
+				t.Errorf("modifiedBy: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.VPCPeeringConnection
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDeleteVpcPeeringConnection: func(*awsec2.DeleteVpcPeeringConnectionInput) awsec2.DeleteVpcPeeringConnectionRequest {
+						return awsec2.DeleteVpcPeeringConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVpcPeeringConnectionOutput{}},
+						}
+					},
+				},
+				cr: pcx(withExternalName(pcxID)),
+			},
+			want: want{
+				cr: pcx(withExternalName(pcxID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{},
+				cr:     pcx(withExternalName(pcxID), withStatus(v1alpha1.VPCPeeringConnectionObservation{State: v1alpha1.VPCPeeringConnectionStateDeleting})),
+			},
+			want: want{
+				cr: pcx(withExternalName(pcxID), withStatus(v1alpha1.VPCPeeringConnectionObservation{State: v1alpha1.VPCPeeringConnectionStateDeleting}),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDeleteVpcPeeringConnection: func(*awsec2.DeleteVpcPeeringConnectionInput) awsec2.DeleteVpcPeeringConnectionRequest {
+						return awsec2.DeleteVpcPeeringConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: pcx(withExternalName(pcxID)),
+			},
+			want: want{
+				cr:  pcx(withExternalName(pcxID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, accepter: tc.accepter}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpnconnection
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VPNConnection resource"
+	errDescribe         = "failed to describe VPNConnection"
+	errSpecUpdate       = "cannot update spec of the VPNConnection resource"
+	errGetPreSharedKey  = "cannot get the pre-shared key of a tunnel of the VPNConnection resource"
+	errCreate           = "failed to create the VPNConnection resource"
+	errDelete           = "failed to delete the VPNConnection resource"
+	errCreateRoute      = "failed to create a static route of the VPNConnection resource"
+	errDeleteRoute      = "failed to delete a static route of the VPNConnection resource"
+	errUpdateTags       = "failed to update tags for the VPNConnection resource"
+	errDeleteTags       = "failed to delete tags for VPNConnection resource"
+)
+
+// SetupVPNConnection adds a controller that reconciles VPNConnections.
+func SetupVPNConnection(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.VPNConnectionGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.VPNConnection{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.VPNConnectionGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPNConnectionClient}, awscommon.DeletionTierAttachment)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.VPNConnectionClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.VPNConnection)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.VPNConnectionClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.VPNConnection)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := ec2.DescribeVPNConnection(ctx, e.client, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsVPNConnectionNotFoundErr, err), errDescribe)
+	}
+
+	if string(observed.State) == v1alpha1.VPNConnectionStateDeleted {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeVPNConnection(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateVPNConnectionObservation(*observed)
+
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.VPNConnectionStatePending:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha1.VPNConnectionStateAvailable:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.VPNConnectionStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  ec2.IsVPNConnectionUpToDate(cr.Spec.ForProvider, *observed),
+		ConnectionDetails: ec2.GetVPNConnectionDetails(*observed),
+	}, nil
+}
+
+// preSharedKeys returns the pre-shared keys referenced by the tunnel options
+// of the given VPNConnection, with an empty key for every tunnel that does not
+// reference one.
+func (e *external) preSharedKeys(ctx context.Context, cr *v1alpha1.VPNConnection) ([]string, error) {
+	keys := make([]string, len(cr.Spec.ForProvider.TunnelOptions))
+	for i, t := range cr.Spec.ForProvider.TunnelOptions {
+		if t.PreSharedKeySecretRef == nil {
+			continue
+		}
+		nn := types.NamespacedName{
+			Name:      t.PreSharedKeySecretRef.Name,
+			Namespace: t.PreSharedKeySecretRef.Namespace,
+		}
+		s := &corev1.Secret{}
+		if err := e.kube.Get(ctx, nn, s); err != nil {
+			return nil, errors.Wrap(err, errGetPreSharedKey)
+		}
+		keys[i] = string(s.Data[t.PreSharedKeySecretRef.Key])
+	}
+	return keys, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.VPNConnection)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	keys, err := e.preSharedKeys(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	c, err := ec2.CreateVPNConnection(ctx, e.client, ec2.GenerateCreateVPNConnectionInput(cr.Spec.ForProvider, keys))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(c.VPNConnectionID))
+
+	return managed.ExternalCreation{ConnectionDetails: ec2.GetVPNConnectionDetails(*c)}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.VPNConnection)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := ec2.DescribeVPNConnection(ctx, e.client, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(ec2.IsVPNConnectionNotFoundErr, err), errDescribe)
+	}
+
+	addRoutes, removeRoutes := ec2.DiffVPNConnectionStaticRoutes(cr.Spec.ForProvider.StaticRoutes, observed.Routes)
+	for _, cidr := range removeRoutes {
+		if _, err := e.client.DeleteVpnConnectionRouteRequest(&awsec2.DeleteVpnConnectionRouteInput{
+			VpnConnectionId:      aws.String(meta.GetExternalName(cr)),
+			DestinationCidrBlock: aws.String(cidr),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteRoute)
+		}
+	}
+	for _, cidr := range addRoutes {
+		if _, err := e.client.CreateVpnConnectionRouteRequest(&awsec2.CreateVpnConnectionRouteInput{
+			VpnConnectionId:      aws.String(meta.GetExternalName(cr)),
+			DestinationCidrBlock: aws.String(cidr),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateRoute)
+		}
+	}
+
+	addTags, removeTags := awscommon.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.VPNConnection)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.State == v1alpha1.VPNConnectionStateDeleted ||
+		cr.Status.AtProvider.State == v1alpha1.VPNConnectionStateDeleting {
+		return nil
+	}
+
+	_, err := e.client.DeleteVpnConnectionRequest(&awsec2.DeleteVpnConnectionInput{
+		VpnConnectionId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(ec2.IsVPNConnectionNotFoundErr, err), errDelete)
+}
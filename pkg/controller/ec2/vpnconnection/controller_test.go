@@ -0,0 +1,612 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpnconnection
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	vpnID    = "vpn-0123456789"
+	cgwID    = "cgw-0123456789"
+	vgwID    = "vgw-0123456789"
+	cidr     = "192.168.0.0/24"
+	psk      = "pre_shared_key"
+	config   = "<vpn_connection/>"
+	address  = "203.0.113.1"
+	category = "VPN"
+	errBoom  = errors.New("boom")
+)
+
+type vpnModifier func(*v1alpha1.VPNConnection)
+
+func withExternalName(name string) vpnModifier {
+	return func(r *v1alpha1.VPNConnection) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) vpnModifier {
+	return func(r *v1alpha1.VPNConnection) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.VPNConnectionParameters) vpnModifier {
+	return func(r *v1alpha1.VPNConnection) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.VPNConnectionObservation) vpnModifier {
+	return func(r *v1alpha1.VPNConnection) { r.Status.AtProvider = s }
+}
+
+func vpn(m ...vpnModifier) *v1alpha1.VPNConnection {
+	cr := &v1alpha1.VPNConnection{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specTags() []v1beta1.Tag {
+	return []v1beta1.Tag{{Key: "key1", Value: "value1"}}
+}
+
+func vpnTags() []awsec2.Tag {
+	return []awsec2.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}}
+}
+
+func specParams() v1alpha1.VPNConnectionParameters {
+	return v1alpha1.VPNConnectionParameters{
+		Type:              "ipsec.1",
+		CustomerGatewayID: &cgwID,
+		VPNGatewayID:      &vgwID,
+		StaticRoutesOnly:  aws.Bool(true),
+		StaticRoutes:      []string{cidr},
+		Tags:              specTags(),
+	}
+}
+
+func connection(state awsec2.VpnState, tags []awsec2.Tag, routes ...awsec2.VpnStaticRoute) *ec2.VPNConnection {
+	return &ec2.VPNConnection{
+		VPNConnectionID:              aws.String(vpnID),
+		CustomerGatewayID:            aws.String(cgwID),
+		VPNGatewayID:                 aws.String(vgwID),
+		CustomerGatewayConfiguration: aws.String(config),
+		Category:                     aws.String(category),
+		State:                        state,
+		Type:                         awsec2.GatewayTypeIpsec1,
+		Options: &awsec2.VpnConnectionOptions{
+			StaticRoutesOnly: aws.Bool(true),
+			TunnelOptions: []awsec2.TunnelOption{
+				{OutsideIpAddress: aws.String(address), PreSharedKey: aws.String(psk)},
+			},
+		},
+		Routes: routes,
+		Tags:   tags,
+	}
+}
+
+func route(state awsec2.VpnState) awsec2.VpnStaticRoute {
+	return awsec2.VpnStaticRoute{DestinationCidrBlock: aws.String(cidr), Source: awsec2.VpnStaticRouteSourceStatic, State: state}
+}
+
+func describeOutput(c *ec2.VPNConnection) *ec2.DescribeVPNConnectionsOutput {
+	return &ec2.DescribeVPNConnectionsOutput{VpnConnections: []ec2.VPNConnection{*c}}
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		ec2.ConnectionDetailsCustomerGatewayConfiguration: []byte(config),
+		"tunnel1Address":      []byte(address),
+		"tunnel1PreSharedKey": []byte(psk),
+	}
+}
+
+func observation(state string, routes ...v1alpha1.VPNStaticRoute) v1alpha1.VPNConnectionObservation {
+	return v1alpha1.VPNConnectionObservation{
+		VPNConnectionID: vpnID,
+		Category:        category,
+		State:           state,
+		Routes:          routes,
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	vpn  ec2.VPNConnectionClient
+	kube client.Client
+	cr   *v1alpha1.VPNConnection
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.VPNConnection
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{},
+				cr:  vpn(),
+			},
+			want: want{
+				cr: vpn(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribeVpnConnections: func(input *awsec2.DescribeVpnConnectionsInput) awsec2.DescribeVpnConnectionsRequest {
+						return awsec2.DescribeVpnConnectionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.VPNConnectionIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: vpn(withExternalName(vpnID)),
+			},
+			want: want{
+				cr: vpn(withExternalName(vpnID)),
+			},
+		},
+		"Deleted": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribeVpnConnections: func(input *awsec2.DescribeVpnConnectionsInput) awsec2.DescribeVpnConnectionsRequest {
+						return awsec2.DescribeVpnConnectionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(connection(awsec2.VpnStateDeleted, vpnTags()))},
+						}
+					},
+				},
+				cr: vpn(withExternalName(vpnID), withSpec(specParams())),
+			},
+			want: want{
+				cr: vpn(withExternalName(vpnID), withSpec(specParams())),
+			},
+		},
+		"Available": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribeVpnConnections: func(input *awsec2.DescribeVpnConnectionsInput) awsec2.DescribeVpnConnectionsRequest {
+						return awsec2.DescribeVpnConnectionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(connection(awsec2.VpnStateAvailable, vpnTags(), route(awsec2.VpnStateAvailable)))},
+						}
+					},
+				},
+				cr: vpn(withExternalName(vpnID), withSpec(specParams())),
+			},
+			want: want{
+				cr: vpn(withExternalName(vpnID), withSpec(specParams()),
+					withStatus(observation(v1alpha1.VPNConnectionStateAvailable, v1alpha1.VPNStaticRoute{DestinationCIDRBlock: cidr, Source: "Static", State: "available"})),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"RoutesMissing": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribeVpnConnections: func(input *awsec2.DescribeVpnConnectionsInput) awsec2.DescribeVpnConnectionsRequest {
+						return awsec2.DescribeVpnConnectionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(connection(awsec2.VpnStatePending, vpnTags()))},
+						}
+					},
+				},
+				cr: vpn(withExternalName(vpnID), withSpec(specParams())),
+			},
+			want: want{
+				cr: vpn(withExternalName(vpnID), withSpec(specParams()),
+					withStatus(observation(v1alpha1.VPNConnectionStatePending)),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribeVpnConnections: func(input *awsec2.DescribeVpnConnectionsInput) awsec2.DescribeVpnConnectionsRequest {
+						return awsec2.DescribeVpnConnectionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(connection(awsec2.VpnStateAvailable, vpnTags()))},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: vpn(withExternalName(vpnID), withSpec(v1alpha1.VPNConnectionParameters{Type: "ipsec.1"})),
+			},
+			want: want{
+				cr: vpn(withExternalName(vpnID), withSpec(v1alpha1.VPNConnectionParameters{
+					Type:              "ipsec.1",
+					CustomerGatewayID: &cgwID,
+					VPNGatewayID:      &vgwID,
+					StaticRoutesOnly:  aws.Bool(true),
+					Tags:              specTags(),
+				})),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribeVpnConnections: func(input *awsec2.DescribeVpnConnectionsInput) awsec2.DescribeVpnConnectionsRequest {
+						return awsec2.DescribeVpnConnectionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: vpn(withExternalName(vpnID)),
+			},
+			want: want{
+				cr:  vpn(withExternalName(vpnID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.vpn}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.VPNConnection
+		result managed.ExternalCreation
+		err    error
+	}
+
+	withPreSharedKey := func() v1alpha1.VPNConnectionParameters {
+		p := specParams()
+		p.TunnelOptions = []v1alpha1.VPNTunnelOptions{{
+			PreSharedKeySecretRef: &runtimev1alpha1.SecretKeySelector{
+				SecretReference: runtimev1alpha1.SecretReference{Name: "vpn", Namespace: "default"},
+				Key:             "psk",
+			},
+		}}
+		return p
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockCreateVpnConnection: func(input *awsec2.CreateVpnConnectionInput) awsec2.CreateVpnConnectionRequest {
+						if diff := cmp.Diff(psk, aws.StringValue(input.Options.TunnelOptions[0].PreSharedKey)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateVpnConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &ec2.CreateVPNConnectionOutput{
+								VpnConnection: connection(awsec2.VpnStatePending, nil),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+						s := obj.(*corev1.Secret)
+						s.Data = map[string][]byte{"psk": []byte(psk)}
+						return nil
+					},
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: vpn(withSpec(withPreSharedKey())),
+			},
+			want: want{
+				cr:     vpn(withExternalName(vpnID), withSpec(withPreSharedKey())),
+				result: managed.ExternalCreation{ConnectionDetails: connectionDetails()},
+			},
+		},
+		"GetPreSharedKeyFailed": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: vpn(withSpec(withPreSharedKey())),
+			},
+			want: want{
+				cr:  vpn(withSpec(withPreSharedKey())),
+				err: errors.Wrap(errBoom, errGetPreSharedKey),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockCreateVpnConnection: func(input *awsec2.CreateVpnConnectionInput) awsec2.CreateVpnConnectionRequest {
+						return awsec2.CreateVpnConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: vpn(withSpec(specParams())),
+			},
+			want: want{
+				cr:  vpn(withSpec(specParams())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.vpn}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.VPNConnection
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RoutesUpdated": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribeVpnConnections: func(input *awsec2.DescribeVpnConnectionsInput) awsec2.DescribeVpnConnectionsRequest {
+						stale := route(awsec2.VpnStateAvailable)
+						stale.DestinationCidrBlock = aws.String("10.0.0.0/16")
+						return awsec2.DescribeVpnConnectionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(connection(awsec2.VpnStateAvailable, vpnTags(), stale))},
+						}
+					},
+					MockDeleteVpnConnectionRoute: func(input *awsec2.DeleteVpnConnectionRouteInput) awsec2.DeleteVpnConnectionRouteRequest {
+						if diff := cmp.Diff("10.0.0.0/16", aws.StringValue(input.DestinationCidrBlock)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.DeleteVpnConnectionRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVpnConnectionRouteOutput{}},
+						}
+					},
+					MockCreateVpnConnectionRoute: func(input *awsec2.CreateVpnConnectionRouteInput) awsec2.CreateVpnConnectionRouteRequest {
+						if diff := cmp.Diff(cidr, aws.StringValue(input.DestinationCidrBlock)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateVpnConnectionRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateVpnConnectionRouteOutput{}},
+						}
+					},
+				},
+				cr: vpn(withExternalName(vpnID), withSpec(specParams())),
+			},
+			want: want{
+				cr: vpn(withExternalName(vpnID), withSpec(specParams())),
+			},
+		},
+		"CreateRouteFailed": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribeVpnConnections: func(input *awsec2.DescribeVpnConnectionsInput) awsec2.DescribeVpnConnectionsRequest {
+						return awsec2.DescribeVpnConnectionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(connection(awsec2.VpnStateAvailable, vpnTags()))},
+						}
+					},
+					MockCreateVpnConnectionRoute: func(input *awsec2.CreateVpnConnectionRouteInput) awsec2.CreateVpnConnectionRouteRequest {
+						return awsec2.CreateVpnConnectionRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: vpn(withExternalName(vpnID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  vpn(withExternalName(vpnID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errCreateRoute),
+			},
+		},
+		"TagsUpdated": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribeVpnConnections: func(input *awsec2.DescribeVpnConnectionsInput) awsec2.DescribeVpnConnectionsRequest {
+						return awsec2.DescribeVpnConnectionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(connection(awsec2.VpnStateAvailable, nil, route(awsec2.VpnStateAvailable)))},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+				},
+				cr: vpn(withExternalName(vpnID), withSpec(specParams())),
+			},
+			want: want{
+				cr: vpn(withExternalName(vpnID), withSpec(specParams())),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribeVpnConnections: func(input *awsec2.DescribeVpnConnectionsInput) awsec2.DescribeVpnConnectionsRequest {
+						return awsec2.DescribeVpnConnectionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: vpn(withExternalName(vpnID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  vpn(withExternalName(vpnID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.vpn}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.VPNConnection
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDeleteVpnConnection: func(input *awsec2.DeleteVpnConnectionInput) awsec2.DeleteVpnConnectionRequest {
+						return awsec2.DeleteVpnConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVpnConnectionOutput{}},
+						}
+					},
+				},
+				cr: vpn(withExternalName(vpnID)),
+			},
+			want: want{
+				cr: vpn(withExternalName(vpnID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{},
+				cr:  vpn(withExternalName(vpnID), withStatus(observation(v1alpha1.VPNConnectionStateDeleting))),
+			},
+			want: want{
+				cr: vpn(withExternalName(vpnID), withStatus(observation(v1alpha1.VPNConnectionStateDeleting)),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDeleteVpnConnection: func(input *awsec2.DeleteVpnConnectionInput) awsec2.DeleteVpnConnectionRequest {
+						return awsec2.DeleteVpnConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.VPNConnectionIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: vpn(withExternalName(vpnID)),
+			},
+			want: want{
+				cr: vpn(withExternalName(vpnID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDeleteVpnConnection: func(input *awsec2.DeleteVpnConnectionInput) awsec2.DeleteVpnConnectionRequest {
+						return awsec2.DeleteVpnConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: vpn(withExternalName(vpnID)),
+			},
+			want: want{
+				cr:  vpn(withExternalName(vpnID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.vpn}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpngateway
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VPNGateway resource"
+	errDescribe         = "failed to describe VPNGateway"
+	errNotSingleItem    = "either no or multiple VPNGateways retrieved for the given vpnGatewayId"
+	errSpecUpdate       = "cannot update spec of the VPNGateway resource"
+	errCreate           = "failed to create the VPNGateway resource"
+	errDelete           = "failed to delete the VPNGateway resource"
+	errAttach           = "failed to attach the VPNGateway to the VPC"
+	errDetach           = "failed to detach the VPNGateway from the VPC"
+	errUpdateTags       = "failed to update tags for the VPNGateway resource"
+	errDeleteTags       = "failed to delete tags for VPNGateway resource"
+)
+
+// SetupVPNGateway adds a controller that reconciles VPNGateways.
+func SetupVPNGateway(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.VPNGatewayGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.VPNGateway{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.VPNGatewayGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPNGatewayClient}, awscommon.DeletionTierNetwork)))),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.VPNGatewayClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.VPNGateway)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.VPNGatewayClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.VpnGateway, error) {
+	response, err := e.client.DescribeVpnGatewaysRequest(&awsec2.DescribeVpnGatewaysInput{
+		VpnGatewayIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.VpnGateways) != 1 {
+		return nil, errors.New(errNotSingleItem)
+	}
+	return &response.VpnGateways[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.VPNGateway)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsVPNGatewayNotFoundErr, err), errDescribe)
+	}
+
+	if string(observed.State) == v1alpha1.VPNGatewayStateDeleted {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeVPNGateway(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateVPNGatewayObservation(*observed)
+
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.VPNGatewayStatePending:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha1.VPNGatewayStateAvailable:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.VPNGatewayStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsVPNGatewayUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.VPNGateway)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	gw, err := e.client.CreateVpnGatewayRequest(ec2.GenerateCreateVPNGatewayInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(gw.VpnGateway.VpnGatewayId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.VPNGateway)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(ec2.IsVPNGatewayNotFoundErr, err), errDescribe)
+	}
+
+	// A virtual private gateway can only be attached to a single VPC, so a
+	// change of the VPC detaches the gateway first and attaches it once the
+	// detachment is observed.
+	if attached := ec2.AttachedVPCID(*observed); attached != aws.StringValue(cr.Spec.ForProvider.VPCID) {
+		if attached != "" {
+			if _, err := e.client.DetachVpnGatewayRequest(&awsec2.DetachVpnGatewayInput{
+				VpnGatewayId: aws.String(meta.GetExternalName(cr)),
+				VpcId:        aws.String(attached),
+			}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errDetach)
+			}
+		} else {
+			if _, err := e.client.AttachVpnGatewayRequest(&awsec2.AttachVpnGatewayInput{
+				VpnGatewayId: aws.String(meta.GetExternalName(cr)),
+				VpcId:        cr.Spec.ForProvider.VPCID,
+			}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errAttach)
+			}
+		}
+	}
+
+	addTags, removeTags := awscommon.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.VPNGateway)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.State == v1alpha1.VPNGatewayStateDeleted ||
+		cr.Status.AtProvider.State == v1alpha1.VPNGatewayStateDeleting {
+		return nil
+	}
+
+	// The gateway has to be detached from its VPC before it can be deleted.
+	for _, a := range cr.Status.AtProvider.VPCAttachments {
+		if a.State != v1alpha1.VPNGatewayAttachmentStateAttaching && a.State != v1alpha1.VPNGatewayAttachmentStateAttached {
+			continue
+		}
+		if _, err := e.client.DetachVpnGatewayRequest(&awsec2.DetachVpnGatewayInput{
+			VpnGatewayId: aws.String(meta.GetExternalName(cr)),
+			VpcId:        aws.String(a.VPCID),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(resource.Ignore(ec2.IsVPNGatewayNotFoundErr, err), errDetach)
+		}
+	}
+
+	_, err := e.client.DeleteVpnGatewayRequest(&awsec2.DeleteVpnGatewayInput{
+		VpnGatewayId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(ec2.IsVPNGatewayNotFoundErr, err), errDelete)
+}
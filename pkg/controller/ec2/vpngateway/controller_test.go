@@ -0,0 +1,666 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpngateway
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	gwID    = "vgw-0123456789"
+	vpcID   = "vpc-0123456789"
+	asn     = int64(64512)
+	errBoom = errors.New("boom")
+)
+
+type gwModifier func(*v1alpha1.VPNGateway)
+
+func withExternalName(name string) gwModifier {
+	return func(r *v1alpha1.VPNGateway) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) gwModifier {
+	return func(r *v1alpha1.VPNGateway) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.VPNGatewayParameters) gwModifier {
+	return func(r *v1alpha1.VPNGateway) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.VPNGatewayObservation) gwModifier {
+	return func(r *v1alpha1.VPNGateway) { r.Status.AtProvider = s }
+}
+
+func gw(m ...gwModifier) *v1alpha1.VPNGateway {
+	cr := &v1alpha1.VPNGateway{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specTags() []v1beta1.Tag {
+	return []v1beta1.Tag{{Key: "key1", Value: "value1"}}
+}
+
+func gwTags() []awsec2.Tag {
+	return []awsec2.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}}
+}
+
+func specParams() v1alpha1.VPNGatewayParameters {
+	return v1alpha1.VPNGatewayParameters{
+		AmazonSideASN: &asn,
+		VPCID:         &vpcID,
+		Tags:          specTags(),
+	}
+}
+
+func describeOutput(state string, tags []awsec2.Tag, attachments ...awsec2.VpcAttachment) *awsec2.DescribeVpnGatewaysOutput {
+	return &awsec2.DescribeVpnGatewaysOutput{
+		VpnGateways: []awsec2.VpnGateway{
+			{
+				VpnGatewayId:   aws.String(gwID),
+				AmazonSideAsn:  &asn,
+				State:          awsec2.VpnState(state),
+				Type:           awsec2.GatewayTypeIpsec1,
+				VpcAttachments: attachments,
+				Tags:           tags,
+			},
+		},
+	}
+}
+
+func attachment(state awsec2.AttachmentStatus) awsec2.VpcAttachment {
+	return awsec2.VpcAttachment{VpcId: aws.String(vpcID), State: state}
+}
+
+func observation(state string, attachments ...v1alpha1.VPNGatewayAttachment) v1alpha1.VPNGatewayObservation {
+	return v1alpha1.VPNGatewayObservation{
+		VPNGatewayID:   gwID,
+		State:          state,
+		VPCAttachments: attachments,
+	}
+}
+
+func attachedObservation() v1alpha1.VPNGatewayAttachment {
+	return v1alpha1.VPNGatewayAttachment{VPCID: vpcID, State: v1alpha1.VPNGatewayAttachmentStateAttached}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	gw   ec2.VPNGatewayClient
+	kube client.Client
+	cr   *v1alpha1.VPNGateway
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.VPNGateway
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{},
+				cr: gw(),
+			},
+			want: want{
+				cr: gw(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDescribeVpnGateways: func(input *awsec2.DescribeVpnGatewaysInput) awsec2.DescribeVpnGatewaysRequest {
+						return awsec2.DescribeVpnGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.VPNGatewayIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID)),
+			},
+			want: want{
+				cr: gw(withExternalName(gwID)),
+			},
+		},
+		"Deleted": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDescribeVpnGateways: func(input *awsec2.DescribeVpnGatewaysInput) awsec2.DescribeVpnGatewaysRequest {
+						return awsec2.DescribeVpnGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.VPNGatewayStateDeleted, gwTags())},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: gw(withExternalName(gwID), withSpec(specParams())),
+			},
+		},
+		"Available": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDescribeVpnGateways: func(input *awsec2.DescribeVpnGatewaysInput) awsec2.DescribeVpnGatewaysRequest {
+						return awsec2.DescribeVpnGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.VPNGatewayStateAvailable, gwTags(), attachment(awsec2.AttachmentStatusAttached))},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: gw(withExternalName(gwID), withSpec(specParams()),
+					withStatus(observation(v1alpha1.VPNGatewayStateAvailable, attachedObservation())),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotAttached": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDescribeVpnGateways: func(input *awsec2.DescribeVpnGatewaysInput) awsec2.DescribeVpnGatewaysRequest {
+						return awsec2.DescribeVpnGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.VPNGatewayStateAvailable, gwTags())},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: gw(withExternalName(gwID), withSpec(specParams()),
+					withStatus(observation(v1alpha1.VPNGatewayStateAvailable)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDescribeVpnGateways: func(input *awsec2.DescribeVpnGatewaysInput) awsec2.DescribeVpnGatewaysRequest {
+						return awsec2.DescribeVpnGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.VPNGatewayStatePending, nil)},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: gw(withExternalName(gwID), withSpec(specParams()),
+					withStatus(observation(v1alpha1.VPNGatewayStatePending)),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDescribeVpnGateways: func(input *awsec2.DescribeVpnGatewaysInput) awsec2.DescribeVpnGatewaysRequest {
+						return awsec2.DescribeVpnGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.VPNGatewayStateAvailable, gwTags())},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: gw(withExternalName(gwID)),
+			},
+			want: want{
+				cr:  gw(withExternalName(gwID), withSpec(v1alpha1.VPNGatewayParameters{AmazonSideASN: &asn, Tags: specTags()})),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDescribeVpnGateways: func(input *awsec2.DescribeVpnGatewaysInput) awsec2.DescribeVpnGatewaysRequest {
+						return awsec2.DescribeVpnGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID)),
+			},
+			want: want{
+				cr:  gw(withExternalName(gwID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.gw}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.VPNGateway
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockCreateVpnGateway: func(input *awsec2.CreateVpnGatewayInput) awsec2.CreateVpnGatewayRequest {
+						return awsec2.CreateVpnGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateVpnGatewayOutput{
+								VpnGateway: &awsec2.VpnGateway{VpnGatewayId: aws.String(gwID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: gw(withSpec(specParams())),
+			},
+			want: want{
+				cr: gw(withExternalName(gwID), withSpec(specParams())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockCreateVpnGateway: func(input *awsec2.CreateVpnGatewayInput) awsec2.CreateVpnGatewayRequest {
+						return awsec2.CreateVpnGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: gw(withSpec(specParams())),
+			},
+			want: want{
+				cr:  gw(withSpec(specParams())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"SpecUpdateFailed": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockCreateVpnGateway: func(input *awsec2.CreateVpnGatewayInput) awsec2.CreateVpnGatewayRequest {
+						return awsec2.CreateVpnGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateVpnGatewayOutput{
+								VpnGateway: &awsec2.VpnGateway{VpnGatewayId: aws.String(gwID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: gw(withSpec(specParams())),
+			},
+			want: want{
+				cr:  gw(withExternalName(gwID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.gw}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.VPNGateway
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"TagsUpdated": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDescribeVpnGateways: func(input *awsec2.DescribeVpnGatewaysInput) awsec2.DescribeVpnGatewaysRequest {
+						return awsec2.DescribeVpnGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.VPNGatewayStateAvailable, []awsec2.Tag{{Key: aws.String("old"), Value: aws.String("value")}}, attachment(awsec2.AttachmentStatusAttached))},
+						}
+					},
+					MockDeleteTags: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTagsOutput{}},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: gw(withExternalName(gwID), withSpec(specParams())),
+			},
+		},
+		"Attached": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDescribeVpnGateways: func(input *awsec2.DescribeVpnGatewaysInput) awsec2.DescribeVpnGatewaysRequest {
+						return awsec2.DescribeVpnGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.VPNGatewayStateAvailable, gwTags())},
+						}
+					},
+					MockAttachVpnGateway: func(input *awsec2.AttachVpnGatewayInput) awsec2.AttachVpnGatewayRequest {
+						if diff := cmp.Diff(vpcID, aws.StringValue(input.VpcId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.AttachVpnGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.AttachVpnGatewayOutput{}},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID), withSpec(specParams())),
+			},
+			want: want{
+				cr: gw(withExternalName(gwID), withSpec(specParams())),
+			},
+		},
+		"AttachFailed": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDescribeVpnGateways: func(input *awsec2.DescribeVpnGatewaysInput) awsec2.DescribeVpnGatewaysRequest {
+						return awsec2.DescribeVpnGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.VPNGatewayStateAvailable, gwTags())},
+						}
+					},
+					MockAttachVpnGateway: func(input *awsec2.AttachVpnGatewayInput) awsec2.AttachVpnGatewayRequest {
+						return awsec2.AttachVpnGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  gw(withExternalName(gwID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errAttach),
+			},
+		},
+		"Detached": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDescribeVpnGateways: func(input *awsec2.DescribeVpnGatewaysInput) awsec2.DescribeVpnGatewaysRequest {
+						return awsec2.DescribeVpnGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.VPNGatewayStateAvailable, gwTags(), attachment(awsec2.AttachmentStatusAttached))},
+						}
+					},
+					MockDetachVpnGateway: func(input *awsec2.DetachVpnGatewayInput) awsec2.DetachVpnGatewayRequest {
+						if diff := cmp.Diff(vpcID, aws.StringValue(input.VpcId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.DetachVpnGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DetachVpnGatewayOutput{}},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID), withSpec(v1alpha1.VPNGatewayParameters{Tags: specTags()})),
+			},
+			want: want{
+				cr: gw(withExternalName(gwID), withSpec(v1alpha1.VPNGatewayParameters{Tags: specTags()})),
+			},
+		},
+		"CreateTagsFailed": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDescribeVpnGateways: func(input *awsec2.DescribeVpnGatewaysInput) awsec2.DescribeVpnGatewaysRequest {
+						return awsec2.DescribeVpnGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: describeOutput(v1alpha1.VPNGatewayStateAvailable, nil, attachment(awsec2.AttachmentStatusAttached))},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  gw(withExternalName(gwID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errUpdateTags),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDescribeVpnGateways: func(input *awsec2.DescribeVpnGatewaysInput) awsec2.DescribeVpnGatewaysRequest {
+						return awsec2.DescribeVpnGatewaysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID), withSpec(specParams())),
+			},
+			want: want{
+				cr:  gw(withExternalName(gwID), withSpec(specParams())),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.gw}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.VPNGateway
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDeleteVpnGateway: func(input *awsec2.DeleteVpnGatewayInput) awsec2.DeleteVpnGatewayRequest {
+						return awsec2.DeleteVpnGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVpnGatewayOutput{}},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID)),
+			},
+			want: want{
+				cr: gw(withExternalName(gwID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DetachedFirst": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDetachVpnGateway: func(input *awsec2.DetachVpnGatewayInput) awsec2.DetachVpnGatewayRequest {
+						return awsec2.DetachVpnGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DetachVpnGatewayOutput{}},
+						}
+					},
+					MockDeleteVpnGateway: func(input *awsec2.DeleteVpnGatewayInput) awsec2.DeleteVpnGatewayRequest {
+						return awsec2.DeleteVpnGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVpnGatewayOutput{}},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID), withStatus(observation(v1alpha1.VPNGatewayStateAvailable, attachedObservation()))),
+			},
+			want: want{
+				cr: gw(withExternalName(gwID), withStatus(observation(v1alpha1.VPNGatewayStateAvailable, attachedObservation())),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DetachFailed": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDetachVpnGateway: func(input *awsec2.DetachVpnGatewayInput) awsec2.DetachVpnGatewayRequest {
+						return awsec2.DetachVpnGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID), withStatus(observation(v1alpha1.VPNGatewayStateAvailable, attachedObservation()))),
+			},
+			want: want{
+				cr: gw(withExternalName(gwID), withStatus(observation(v1alpha1.VPNGatewayStateAvailable, attachedObservation())),
+					withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDetach),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{},
+				cr: gw(withExternalName(gwID), withStatus(observation(v1alpha1.VPNGatewayStateDeleting))),
+			},
+			want: want{
+				cr: gw(withExternalName(gwID), withStatus(observation(v1alpha1.VPNGatewayStateDeleting)),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDeleteVpnGateway: func(input *awsec2.DeleteVpnGatewayInput) awsec2.DeleteVpnGatewayRequest {
+						return awsec2.DeleteVpnGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.VPNGatewayIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID)),
+			},
+			want: want{
+				cr: gw(withExternalName(gwID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				gw: &fake.MockVPNGatewayClient{
+					MockDeleteVpnGateway: func(input *awsec2.DeleteVpnGatewayInput) awsec2.DeleteVpnGatewayRequest {
+						return awsec2.DeleteVpnGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: gw(withExternalName(gwID)),
+			},
+			want: want{
+				cr:  gw(withExternalName(gwID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.gw}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
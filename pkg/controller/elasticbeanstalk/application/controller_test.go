@@ -0,0 +1,355 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselasticbeanstalk "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	appName        = "web"
+	appARN         = "arn:aws:elasticbeanstalk:us-east-1:123456789012:application/web"
+	appDescription = "legacy web application"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	elasticbeanstalk elasticbeanstalk.ApplicationClient
+	kube             *test.MockClient
+	cr               resource.Managed
+}
+
+type appModifier func(*v1alpha1.Application)
+
+func withConditions(c ...runtimev1alpha1.Condition) appModifier {
+	return func(r *v1alpha1.Application) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDescription(s *string) appModifier {
+	return func(r *v1alpha1.Application) { r.Spec.ForProvider.Description = s }
+}
+
+func withARN() appModifier {
+	return func(r *v1alpha1.Application) { r.Status.AtProvider.ARN = appARN }
+}
+
+func application(m ...appModifier) *v1alpha1.Application {
+	cr := &v1alpha1.Application{
+		Spec: v1alpha1.ApplicationSpec{
+			ForProvider: v1alpha1.ApplicationParameters{
+				Region:      "us-east-1",
+				Description: aws.String(appDescription),
+			},
+		},
+	}
+	meta.SetExternalName(cr, appName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeApplications(apps []awselasticbeanstalk.ApplicationDescription, err error) func(*awselasticbeanstalk.DescribeApplicationsInput) awselasticbeanstalk.DescribeApplicationsRequest {
+	return func(*awselasticbeanstalk.DescribeApplicationsInput) awselasticbeanstalk.DescribeApplicationsRequest {
+		return awselasticbeanstalk.DescribeApplicationsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.DescribeApplicationsOutput{Applications: apps}, Error: err},
+		}
+	}
+}
+
+func observedApplication() []awselasticbeanstalk.ApplicationDescription {
+	return []awselasticbeanstalk.ApplicationDescription{{
+		ApplicationArn:  aws.String(appARN),
+		ApplicationName: aws.String(appName),
+		Description:     aws.String(appDescription),
+	}}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationClient{MockDescribeApplications: describeApplications(observedApplication(), nil)},
+				cr:               application(),
+			},
+			want: want{
+				cr:     application(withARN(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationClient{MockDescribeApplications: describeApplications(observedApplication(), nil)},
+				kube:             &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:               application(withDescription(nil)),
+			},
+			want: want{
+				cr:     application(withARN(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationClient{MockDescribeApplications: describeApplications(observedApplication(), nil)},
+				cr:               application(withDescription(aws.String("new"))),
+			},
+			want: want{
+				cr:     application(withDescription(aws.String("new")), withARN(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationClient{MockDescribeApplications: describeApplications(nil, nil)},
+				cr:               application(),
+			},
+			want: want{
+				cr: application(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationClient{MockDescribeApplications: describeApplications(nil, errBoom)},
+				cr:               application(),
+			},
+			want: want{
+				cr:  application(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elasticbeanstalk}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	create := func(err error) func(*awselasticbeanstalk.CreateApplicationInput) awselasticbeanstalk.CreateApplicationRequest {
+		return func(in *awselasticbeanstalk.CreateApplicationInput) awselasticbeanstalk.CreateApplicationRequest {
+			if diff := cmp.Diff(appName, aws.StringValue(in.ApplicationName)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awselasticbeanstalk.CreateApplicationRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.CreateApplicationOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationClient{MockCreateApplication: create(nil)},
+				cr:               application(),
+			},
+			want: want{
+				cr: application(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationClient{MockCreateApplication: create(errBoom)},
+				cr:               application(),
+			},
+			want: want{
+				cr:  application(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elasticbeanstalk}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	update := func(err error) func(*awselasticbeanstalk.UpdateApplicationInput) awselasticbeanstalk.UpdateApplicationRequest {
+		return func(in *awselasticbeanstalk.UpdateApplicationInput) awselasticbeanstalk.UpdateApplicationRequest {
+			if diff := cmp.Diff("new", aws.StringValue(in.Description)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awselasticbeanstalk.UpdateApplicationRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.UpdateApplicationOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationClient{MockUpdateApplication: update(nil)},
+				cr:               application(withDescription(aws.String("new"))),
+			},
+		},
+		"ClientError": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationClient{MockUpdateApplication: update(errBoom)},
+				cr:               application(withDescription(aws.String("new"))),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elasticbeanstalk}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteApplication := func(err error) func(*awselasticbeanstalk.DeleteApplicationInput) awselasticbeanstalk.DeleteApplicationRequest {
+		return func(*awselasticbeanstalk.DeleteApplicationInput) awselasticbeanstalk.DeleteApplicationRequest {
+			return awselasticbeanstalk.DeleteApplicationRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.DeleteApplicationOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationClient{MockDeleteApplication: deleteApplication(nil)},
+				cr:               application(),
+			},
+			want: want{
+				cr: application(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationClient{MockDeleteApplication: deleteApplication(errBoom)},
+				cr:               application(),
+			},
+			want: want{
+				cr:  application(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elasticbeanstalk}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
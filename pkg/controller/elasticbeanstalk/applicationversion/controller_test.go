@@ -0,0 +1,375 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationversion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselasticbeanstalk "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	appName      = "web"
+	versionLabel = "v1"
+	versionARN   = "arn:aws:elasticbeanstalk:us-east-1:123456789012:applicationversion/web/v1"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	elasticbeanstalk elasticbeanstalk.ApplicationVersionClient
+	kube             *test.MockClient
+	cr               resource.Managed
+}
+
+type versionModifier func(*v1alpha1.ApplicationVersion)
+
+func withConditions(c ...runtimev1alpha1.Condition) versionModifier {
+	return func(r *v1alpha1.ApplicationVersion) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDescription(s *string) versionModifier {
+	return func(r *v1alpha1.ApplicationVersion) { r.Spec.ForProvider.Description = s }
+}
+
+func withStatus(s awselasticbeanstalk.ApplicationVersionStatus) versionModifier {
+	return func(r *v1alpha1.ApplicationVersion) {
+		r.Status.AtProvider = v1alpha1.ApplicationVersionObservation{ARN: versionARN, Status: string(s)}
+	}
+}
+
+func applicationVersion(m ...versionModifier) *v1alpha1.ApplicationVersion {
+	cr := &v1alpha1.ApplicationVersion{
+		Spec: v1alpha1.ApplicationVersionSpec{
+			ForProvider: v1alpha1.ApplicationVersionParameters{
+				Region:          "us-east-1",
+				ApplicationName: aws.String(appName),
+				Description:     aws.String("first release"),
+				SourceBundle: v1alpha1.SourceBundle{
+					S3Bucket: aws.String("web-releases"),
+					S3Key:    "web-v1.zip",
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, versionLabel)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeVersions(status awselasticbeanstalk.ApplicationVersionStatus, err error) func(*awselasticbeanstalk.DescribeApplicationVersionsInput) awselasticbeanstalk.DescribeApplicationVersionsRequest {
+	return func(*awselasticbeanstalk.DescribeApplicationVersionsInput) awselasticbeanstalk.DescribeApplicationVersionsRequest {
+		out := &awselasticbeanstalk.DescribeApplicationVersionsOutput{}
+		if status != "" {
+			out.ApplicationVersions = []awselasticbeanstalk.ApplicationVersionDescription{{
+				ApplicationName:       aws.String(appName),
+				ApplicationVersionArn: aws.String(versionARN),
+				VersionLabel:          aws.String(versionLabel),
+				Description:           aws.String("first release"),
+				Status:                status,
+			}}
+		}
+		return awselasticbeanstalk.DescribeApplicationVersionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out, Error: err},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Processed": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationVersionClient{MockDescribeApplicationVersions: describeVersions(awselasticbeanstalk.ApplicationVersionStatusProcessed, nil)},
+				cr:               applicationVersion(),
+			},
+			want: want{
+				cr:     applicationVersion(withStatus(awselasticbeanstalk.ApplicationVersionStatusProcessed), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Processing": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationVersionClient{MockDescribeApplicationVersions: describeVersions(awselasticbeanstalk.ApplicationVersionStatusProcessing, nil)},
+				cr:               applicationVersion(),
+			},
+			want: want{
+				cr:     applicationVersion(withStatus(awselasticbeanstalk.ApplicationVersionStatusProcessing), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Failed": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationVersionClient{MockDescribeApplicationVersions: describeVersions(awselasticbeanstalk.ApplicationVersionStatusFailed, nil)},
+				cr:               applicationVersion(),
+			},
+			want: want{
+				cr:     applicationVersion(withStatus(awselasticbeanstalk.ApplicationVersionStatusFailed), withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationVersionClient{MockDescribeApplicationVersions: describeVersions(awselasticbeanstalk.ApplicationVersionStatusProcessed, nil)},
+				kube:             &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:               applicationVersion(withDescription(nil)),
+			},
+			want: want{
+				cr:     applicationVersion(withStatus(awselasticbeanstalk.ApplicationVersionStatusProcessed), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationVersionClient{MockDescribeApplicationVersions: describeVersions("", nil)},
+				cr:               applicationVersion(),
+			},
+			want: want{
+				cr: applicationVersion(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationVersionClient{MockDescribeApplicationVersions: describeVersions("", errBoom)},
+				cr:               applicationVersion(),
+			},
+			want: want{
+				cr:  applicationVersion(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elasticbeanstalk}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	create := func(err error) func(*awselasticbeanstalk.CreateApplicationVersionInput) awselasticbeanstalk.CreateApplicationVersionRequest {
+		return func(in *awselasticbeanstalk.CreateApplicationVersionInput) awselasticbeanstalk.CreateApplicationVersionRequest {
+			want := &awselasticbeanstalk.S3Location{S3Bucket: aws.String("web-releases"), S3Key: aws.String("web-v1.zip")}
+			if diff := cmp.Diff(want, in.SourceBundle); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awselasticbeanstalk.CreateApplicationVersionRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.CreateApplicationVersionOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationVersionClient{MockCreateApplicationVersion: create(nil)},
+				cr:               applicationVersion(),
+			},
+			want: want{
+				cr: applicationVersion(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationVersionClient{MockCreateApplicationVersion: create(errBoom)},
+				cr:               applicationVersion(),
+			},
+			want: want{
+				cr:  applicationVersion(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elasticbeanstalk}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	update := func(err error) func(*awselasticbeanstalk.UpdateApplicationVersionInput) awselasticbeanstalk.UpdateApplicationVersionRequest {
+		return func(in *awselasticbeanstalk.UpdateApplicationVersionInput) awselasticbeanstalk.UpdateApplicationVersionRequest {
+			if diff := cmp.Diff("hotfix", aws.StringValue(in.Description)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awselasticbeanstalk.UpdateApplicationVersionRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.UpdateApplicationVersionOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationVersionClient{MockUpdateApplicationVersion: update(nil)},
+				cr:               applicationVersion(withDescription(aws.String("hotfix"))),
+			},
+		},
+		"ClientError": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationVersionClient{MockUpdateApplicationVersion: update(errBoom)},
+				cr:               applicationVersion(withDescription(aws.String("hotfix"))),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elasticbeanstalk}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteVersion := func(err error) func(*awselasticbeanstalk.DeleteApplicationVersionInput) awselasticbeanstalk.DeleteApplicationVersionRequest {
+		return func(*awselasticbeanstalk.DeleteApplicationVersionInput) awselasticbeanstalk.DeleteApplicationVersionRequest {
+			return awselasticbeanstalk.DeleteApplicationVersionRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.DeleteApplicationVersionOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationVersionClient{MockDeleteApplicationVersion: deleteVersion(nil)},
+				cr:               applicationVersion(),
+			},
+			want: want{
+				cr: applicationVersion(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				elasticbeanstalk: &fake.MockApplicationVersionClient{MockDeleteApplicationVersion: deleteVersion(errBoom)},
+				cr:               applicationVersion(),
+			},
+			want: want{
+				cr:  applicationVersion(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elasticbeanstalk}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
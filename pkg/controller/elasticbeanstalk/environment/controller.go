@@ -0,0 +1,224 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environment
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselasticbeanstalk "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an Elastic Beanstalk Environment resource"
+
+	errDescribe         = "failed to describe the Environment resource"
+	errDescribeSettings = "failed to describe the configuration settings of the Environment resource"
+	errCreate           = "failed to create the Environment resource"
+	errUpdate           = "failed to update the Environment resource"
+	errDelete           = "failed to terminate the Environment resource"
+	errSpecUpdate       = "cannot update spec of Environment custom resource"
+)
+
+// SetupEnvironment adds a controller that reconciles Environments.
+func SetupEnvironment(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.EnvironmentGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Environment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.EnvironmentGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: elasticbeanstalk.NewEnvironmentClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) elasticbeanstalk.EnvironmentClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client elasticbeanstalk.EnvironmentClient
+}
+
+// describe returns the environment, or nil if it doesn't exist or is
+// terminated.
+func (e *external) describe(ctx context.Context, cr *v1alpha1.Environment) (*awselasticbeanstalk.EnvironmentDescription, error) {
+	rsp, err := e.client.DescribeEnvironmentsRequest(&awselasticbeanstalk.DescribeEnvironmentsInput{
+		ApplicationName:  cr.Spec.ForProvider.ApplicationName,
+		EnvironmentNames: []string{meta.GetExternalName(cr)},
+		IncludeDeleted:   aws.Bool(false),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rsp.Environments {
+		if rsp.Environments[i].Status != awselasticbeanstalk.EnvironmentStatusTerminated {
+			return &rsp.Environments[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// settings returns the observed option settings of the environment. They are
+// only needed, and thus only described, if some option settings are desired.
+func (e *external) settings(ctx context.Context, cr *v1alpha1.Environment) ([]awselasticbeanstalk.ConfigurationOptionSetting, error) {
+	if len(cr.Spec.ForProvider.OptionSettings) == 0 {
+		return nil, nil
+	}
+	rsp, err := e.client.DescribeConfigurationSettingsRequest(&awselasticbeanstalk.DescribeConfigurationSettingsInput{
+		ApplicationName: cr.Spec.ForProvider.ApplicationName,
+		EnvironmentName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp.ConfigurationSettings) == 0 {
+		return nil, nil
+	}
+	return rsp.ConfigurationSettings[0].OptionSettings, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	elasticbeanstalk.LateInitializeEnvironment(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = elasticbeanstalk.GenerateEnvironmentObservation(*observed)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.EnvironmentStatusReady:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.EnvironmentStatusLaunching:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha1.EnvironmentStatusTerminating:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	settings, err := e.settings(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeSettings)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  elasticbeanstalk.IsEnvironmentUpToDate(cr.Spec.ForProvider, *observed, settings),
+		ConnectionDetails: elasticbeanstalk.GetEnvironmentConnectionDetails(*cr),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateEnvironmentRequest(elasticbeanstalk.GenerateCreateEnvironmentInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+// Update deploys the desired version, platform and option settings to the
+// environment. The environment can only be updated while it is ready.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Status.AtProvider.Status != v1alpha1.EnvironmentStatusReady {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	settings, err := e.settings(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeSettings)
+	}
+	if elasticbeanstalk.IsEnvironmentUpToDate(cr.Spec.ForProvider, *observed, settings) {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.UpdateEnvironmentRequest(elasticbeanstalk.GenerateUpdateEnvironmentInput(meta.GetExternalName(cr), cr.Spec.ForProvider, *observed, settings)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Environment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.Status == v1alpha1.EnvironmentStatusTerminating {
+		return nil
+	}
+	_, err := e.client.TerminateEnvironmentRequest(&awselasticbeanstalk.TerminateEnvironmentInput{
+		EnvironmentName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(err, errDelete)
+}
@@ -0,0 +1,517 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselasticbeanstalk "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	appName      = "web"
+	envName      = "web-prod"
+	envID        = "e-abcdef1234"
+	envARN       = "arn:aws:elasticbeanstalk:us-east-1:123456789012:environment/web/web-prod"
+	envCNAME     = "web-prod.us-east-1.elasticbeanstalk.com"
+	solution     = "64bit Amazon Linux 2 v3.1.0 running Go 1"
+	versionLabel = "v1"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	elasticbeanstalk elasticbeanstalk.EnvironmentClient
+	kube             *test.MockClient
+	cr               resource.Managed
+}
+
+type envModifier func(*v1alpha1.Environment)
+
+func withConditions(c ...runtimev1alpha1.Condition) envModifier {
+	return func(r *v1alpha1.Environment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSolutionStackName(s *string) envModifier {
+	return func(r *v1alpha1.Environment) { r.Spec.ForProvider.SolutionStackName = s }
+}
+
+func withVersionLabel(s string) envModifier {
+	return func(r *v1alpha1.Environment) { r.Spec.ForProvider.VersionLabel = aws.String(s) }
+}
+
+func withOptionSettings(s ...v1alpha1.OptionSetting) envModifier {
+	return func(r *v1alpha1.Environment) { r.Spec.ForProvider.OptionSettings = s }
+}
+
+func withStatus(s awselasticbeanstalk.EnvironmentStatus) envModifier {
+	return func(r *v1alpha1.Environment) {
+		r.Status.AtProvider = v1alpha1.EnvironmentObservation{
+			ARN:           envARN,
+			EnvironmentID: envID,
+			Status:        string(s),
+			Health:        string(awselasticbeanstalk.EnvironmentHealthGreen),
+			CNAME:         envCNAME,
+		}
+	}
+}
+
+func environment(m ...envModifier) *v1alpha1.Environment {
+	cr := &v1alpha1.Environment{
+		Spec: v1alpha1.EnvironmentSpec{
+			ForProvider: v1alpha1.EnvironmentParameters{
+				Region:            "us-east-1",
+				ApplicationName:   aws.String(appName),
+				Description:       aws.String("production"),
+				SolutionStackName: aws.String(solution),
+				VersionLabel:      aws.String(versionLabel),
+			},
+		},
+	}
+	meta.SetExternalName(cr, envName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeEnvironments(status awselasticbeanstalk.EnvironmentStatus, err error) func(*awselasticbeanstalk.DescribeEnvironmentsInput) awselasticbeanstalk.DescribeEnvironmentsRequest {
+	return func(*awselasticbeanstalk.DescribeEnvironmentsInput) awselasticbeanstalk.DescribeEnvironmentsRequest {
+		out := &awselasticbeanstalk.DescribeEnvironmentsOutput{}
+		if status != "" {
+			out.Environments = []awselasticbeanstalk.EnvironmentDescription{{
+				ApplicationName:   aws.String(appName),
+				EnvironmentName:   aws.String(envName),
+				EnvironmentId:     aws.String(envID),
+				EnvironmentArn:    aws.String(envARN),
+				Description:       aws.String("production"),
+				SolutionStackName: aws.String(solution),
+				VersionLabel:      aws.String(versionLabel),
+				CNAME:             aws.String(envCNAME),
+				Health:            awselasticbeanstalk.EnvironmentHealthGreen,
+				Status:            status,
+			}}
+		}
+		return awselasticbeanstalk.DescribeEnvironmentsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out, Error: err},
+		}
+	}
+}
+
+func describeSettings(settings []awselasticbeanstalk.ConfigurationOptionSetting, err error) func(*awselasticbeanstalk.DescribeConfigurationSettingsInput) awselasticbeanstalk.DescribeConfigurationSettingsRequest {
+	return func(*awselasticbeanstalk.DescribeConfigurationSettingsInput) awselasticbeanstalk.DescribeConfigurationSettingsRequest {
+		return awselasticbeanstalk.DescribeConfigurationSettingsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.DescribeConfigurationSettingsOutput{
+				ConfigurationSettings: []awselasticbeanstalk.ConfigurationSettingsDescription{{OptionSettings: settings}},
+			}, Error: err},
+		}
+	}
+}
+
+var instanceType = v1alpha1.OptionSetting{
+	Namespace:  "aws:autoscaling:launchconfiguration",
+	OptionName: "InstanceType",
+	Value:      "t3.small",
+}
+
+func observedSettings(value string) []awselasticbeanstalk.ConfigurationOptionSetting {
+	return []awselasticbeanstalk.ConfigurationOptionSetting{{
+		Namespace:  aws.String("aws:autoscaling:launchconfiguration"),
+		OptionName: aws.String("InstanceType"),
+		Value:      aws.String(value),
+	}}
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(envCNAME),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Ready": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{MockDescribeEnvironments: describeEnvironments(awselasticbeanstalk.EnvironmentStatusReady, nil)},
+				cr:               environment(),
+			},
+			want: want{
+				cr:     environment(withStatus(awselasticbeanstalk.EnvironmentStatusReady), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"Launching": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{MockDescribeEnvironments: describeEnvironments(awselasticbeanstalk.EnvironmentStatusLaunching, nil)},
+				cr:               environment(),
+			},
+			want: want{
+				cr:     environment(withStatus(awselasticbeanstalk.EnvironmentStatusLaunching), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"Terminating": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{MockDescribeEnvironments: describeEnvironments(awselasticbeanstalk.EnvironmentStatusTerminating, nil)},
+				cr:               environment(),
+			},
+			want: want{
+				cr:     environment(withStatus(awselasticbeanstalk.EnvironmentStatusTerminating), withConditions(runtimev1alpha1.Deleting())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"Terminated": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{MockDescribeEnvironments: describeEnvironments(awselasticbeanstalk.EnvironmentStatusTerminated, nil)},
+				cr:               environment(),
+			},
+			want: want{
+				cr: environment(),
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{MockDescribeEnvironments: describeEnvironments(awselasticbeanstalk.EnvironmentStatusReady, nil)},
+				kube:             &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:               environment(withSolutionStackName(nil)),
+			},
+			want: want{
+				cr:     environment(withStatus(awselasticbeanstalk.EnvironmentStatusReady), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"VersionOutdated": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{MockDescribeEnvironments: describeEnvironments(awselasticbeanstalk.EnvironmentStatusReady, nil)},
+				cr:               environment(withVersionLabel("v2")),
+			},
+			want: want{
+				cr:     environment(withVersionLabel("v2"), withStatus(awselasticbeanstalk.EnvironmentStatusReady), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"OptionSettingsOutdated": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{
+					MockDescribeEnvironments:          describeEnvironments(awselasticbeanstalk.EnvironmentStatusReady, nil),
+					MockDescribeConfigurationSettings: describeSettings(observedSettings("t3.micro"), nil),
+				},
+				cr: environment(withOptionSettings(instanceType)),
+			},
+			want: want{
+				cr:     environment(withOptionSettings(instanceType), withStatus(awselasticbeanstalk.EnvironmentStatusReady), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"NotFound": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{MockDescribeEnvironments: describeEnvironments("", nil)},
+				cr:               environment(),
+			},
+			want: want{
+				cr: environment(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{MockDescribeEnvironments: describeEnvironments("", errBoom)},
+				cr:               environment(),
+			},
+			want: want{
+				cr:  environment(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"DescribeSettingsError": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{
+					MockDescribeEnvironments:          describeEnvironments(awselasticbeanstalk.EnvironmentStatusReady, nil),
+					MockDescribeConfigurationSettings: describeSettings(nil, errBoom),
+				},
+				cr: environment(withOptionSettings(instanceType)),
+			},
+			want: want{
+				cr:  environment(withOptionSettings(instanceType), withStatus(awselasticbeanstalk.EnvironmentStatusReady), withConditions(runtimev1alpha1.Available())),
+				err: errors.Wrap(errBoom, errDescribeSettings),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elasticbeanstalk}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	create := func(err error) func(*awselasticbeanstalk.CreateEnvironmentInput) awselasticbeanstalk.CreateEnvironmentRequest {
+		return func(in *awselasticbeanstalk.CreateEnvironmentInput) awselasticbeanstalk.CreateEnvironmentRequest {
+			if diff := cmp.Diff(envName, aws.StringValue(in.EnvironmentName)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awselasticbeanstalk.CreateEnvironmentRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.CreateEnvironmentOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{MockCreateEnvironment: create(nil)},
+				cr:               environment(),
+			},
+			want: want{
+				cr: environment(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{MockCreateEnvironment: create(errBoom)},
+				cr:               environment(),
+			},
+			want: want{
+				cr:  environment(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elasticbeanstalk}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	update := func(err error) func(*awselasticbeanstalk.UpdateEnvironmentInput) awselasticbeanstalk.UpdateEnvironmentRequest {
+		return func(in *awselasticbeanstalk.UpdateEnvironmentInput) awselasticbeanstalk.UpdateEnvironmentRequest {
+			if diff := cmp.Diff("v2", aws.StringValue(in.VersionLabel)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awselasticbeanstalk.UpdateEnvironmentRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.UpdateEnvironmentOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{
+					MockDescribeEnvironments: describeEnvironments(awselasticbeanstalk.EnvironmentStatusReady, nil),
+					MockUpdateEnvironment:    update(nil),
+				},
+				cr: environment(withVersionLabel("v2"), withStatus(awselasticbeanstalk.EnvironmentStatusReady)),
+			},
+		},
+		"NotReady": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{},
+				cr:               environment(withVersionLabel("v2"), withStatus(awselasticbeanstalk.EnvironmentStatusUpdating)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{MockDescribeEnvironments: describeEnvironments("", errBoom)},
+				cr:               environment(withVersionLabel("v2"), withStatus(awselasticbeanstalk.EnvironmentStatusReady)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"ClientError": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{
+					MockDescribeEnvironments: describeEnvironments(awselasticbeanstalk.EnvironmentStatusReady, nil),
+					MockUpdateEnvironment:    update(errBoom),
+				},
+				cr: environment(withVersionLabel("v2"), withStatus(awselasticbeanstalk.EnvironmentStatusReady)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elasticbeanstalk}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	terminate := func(err error) func(*awselasticbeanstalk.TerminateEnvironmentInput) awselasticbeanstalk.TerminateEnvironmentRequest {
+		return func(*awselasticbeanstalk.TerminateEnvironmentInput) awselasticbeanstalk.TerminateEnvironmentRequest {
+			return awselasticbeanstalk.TerminateEnvironmentRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.TerminateEnvironmentOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{MockTerminateEnvironment: terminate(nil)},
+				cr:               environment(withStatus(awselasticbeanstalk.EnvironmentStatusReady)),
+			},
+			want: want{
+				cr: environment(withStatus(awselasticbeanstalk.EnvironmentStatusReady), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyTerminating": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{},
+				cr:               environment(withStatus(awselasticbeanstalk.EnvironmentStatusTerminating)),
+			},
+			want: want{
+				cr: environment(withStatus(awselasticbeanstalk.EnvironmentStatusTerminating), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				elasticbeanstalk: &fake.MockEnvironmentClient{MockTerminateEnvironment: terminate(errBoom)},
+				cr:               environment(withStatus(awselasticbeanstalk.EnvironmentStatusReady)),
+			},
+			want: want{
+				cr:  environment(withStatus(awselasticbeanstalk.EnvironmentStatusReady), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elasticbeanstalk}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -43,6 +43,7 @@ const (
 
 	errDescribe      = "cannot describe ELB with given name"
 	errDescribeTags  = "cannot describe tags for ELB with given name"
+	errDescribeAttrs = "cannot describe attributes of ELB with given name"
 	errMultipleItems = "retrieved multiple ELBs for the given name"
 	errCreate        = "cannot create the ELB resource"
 	errUpdate        = "cannot update ELB resource"
@@ -59,9 +60,10 @@ func SetupELB(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1alpha1.ELB{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.ELBGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elb.NewClient}),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: elb.NewClient}, awscommon.DeletionTierWorkload)))),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -115,9 +117,17 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elb.IsELBNotFound, err), errDescribeTags)
 	}
 
+	attrsResponse, err := e.client.DescribeLoadBalancerAttributesRequest(&awselb.DescribeLoadBalancerAttributesInput{
+		LoadBalancerName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elb.IsELBNotFound, err), errDescribeAttrs)
+	}
+
 	// update the CRD spec for any new values from provider
 	current := cr.Spec.ForProvider.DeepCopy()
 	elb.LateInitializeELB(&cr.Spec.ForProvider, &observed, tagsResponse.TagDescriptions[0].Tags)
+	elb.LateInitializeELBAttributes(&cr.Spec.ForProvider, attrsResponse.LoadBalancerAttributes)
 	if !cmp.Equal(current, &cr.Spec.ForProvider) {
 		if err := e.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
@@ -135,7 +145,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: upToDate,
+		ResourceUpToDate: upToDate && elb.AreELBAttributesUpToDate(cr.Spec.ForProvider, attrsResponse.LoadBalancerAttributes),
 	}, nil
 }
 
@@ -215,7 +225,7 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 				Interval:           aws.Int64(cr.Spec.ForProvider.HealthCheck.Interval),
 				Target:             aws.String(cr.Spec.ForProvider.HealthCheck.Target),
 				Timeout:            aws.Int64(cr.Spec.ForProvider.HealthCheck.Timeout),
-				UnhealthyThreshold: aws.Int64(cr.Spec.ForProvider.HealthCheck.HealthyThreshold),
+				UnhealthyThreshold: aws.Int64(cr.Spec.ForProvider.HealthCheck.UnhealthyThreshold),
 			},
 		}).Send(ctx); err != nil {
 			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
@@ -228,6 +238,14 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
+	// NOTE: ModifyLoadBalancerAttributes only changes the given attributes,
+	// so it is safe to call it whenever the load balancer is not up to date.
+	if cr.Spec.ForProvider.CrossZoneLoadBalancing != nil {
+		if _, err := e.client.ModifyLoadBalancerAttributesRequest(elb.GenerateModifyELBAttributesInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
 	if len(patch.Tags) != 0 {
 		if err := e.updateTags(ctx, cr.Spec.ForProvider.Tags, tagsResponse.TagDescriptions[0].Tags, meta.GetExternalName(cr)); err != nil {
 			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
@@ -87,6 +87,18 @@ func elbResource(m ...elbModifier) *v1alpha1.ELB {
 	return cr
 }
 
+func describeAttributes(crossZone bool) func(*awselb.DescribeLoadBalancerAttributesInput) awselb.DescribeLoadBalancerAttributesRequest {
+	return func(*awselb.DescribeLoadBalancerAttributesInput) awselb.DescribeLoadBalancerAttributesRequest {
+		return awselb.DescribeLoadBalancerAttributesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerAttributesOutput{
+				LoadBalancerAttributes: &awselb.LoadBalancerAttributes{
+					CrossZoneLoadBalancing: &awselb.CrossZoneLoadBalancing{Enabled: aws.Bool(crossZone)},
+				},
+			}},
+		}
+	}
+}
+
 func TestObserve(t *testing.T) {
 
 	type want struct {
@@ -121,12 +133,14 @@ func TestObserve(t *testing.T) {
 							}},
 						}
 					},
+					MockDescribeLoadBalancerAttributesRequest: describeAttributes(false),
 				},
 				cr: elbResource(withExternalName(elbName)),
 			},
 			want: want{
 				cr: elbResource(withSpec(v1alpha1.ELBParameters{
-					AvailabilityZones: availabilityZones,
+					AvailabilityZones:      availabilityZones,
+					CrossZoneLoadBalancing: aws.Bool(false),
 				}),
 					withExternalName(elbName),
 					withConditions(corev1alpha1.Available())),
@@ -196,13 +210,15 @@ func TestObserve(t *testing.T) {
 							}},
 						}
 					},
+					MockDescribeLoadBalancerAttributesRequest: describeAttributes(false),
 				},
 				cr: elbResource(withExternalName(elbName)),
 			},
 			want: want{
 				cr: elbResource(withExternalName(elbName),
 					withSpec(v1alpha1.ELBParameters{
-						AvailabilityZones: availabilityZones,
+						AvailabilityZones:      availabilityZones,
+						CrossZoneLoadBalancing: aws.Bool(false),
 					})),
 				err: errors.Wrap(errBoom, errSpecUpdate),
 			},
@@ -229,6 +245,7 @@ func TestObserve(t *testing.T) {
 							}},
 						}
 					},
+					MockDescribeLoadBalancerAttributesRequest: describeAttributes(false),
 				},
 				cr: elbResource(withExternalName(elbName),
 					withSpec(v1alpha1.ELBParameters{
@@ -238,8 +255,52 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: elbResource(withExternalName(elbName),
 					withSpec(v1alpha1.ELBParameters{
-						AvailabilityZones: availabilityZones,
-						SecurityGroupIDs:  securityGroups,
+						AvailabilityZones:      availabilityZones,
+						CrossZoneLoadBalancing: aws.Bool(false),
+						SecurityGroupIDs:       securityGroups,
+					}),
+					withConditions(corev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"CrossZoneNotUpToDate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(input *awselb.DescribeLoadBalancersInput) awselb.DescribeLoadBalancersRequest {
+						return awselb.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancersOutput{
+								LoadBalancerDescriptions: []awselb.LoadBalancerDescription{loadBalancer},
+							}},
+						}
+					},
+					MockDescribeTagsRequest: func(input *awselb.DescribeTagsInput) awselb.DescribeTagsRequest {
+						return awselb.DescribeTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeTagsOutput{
+								TagDescriptions: []awselb.TagDescription{
+									{LoadBalancerName: &elbName},
+								},
+							}},
+						}
+					},
+					MockDescribeLoadBalancerAttributesRequest: describeAttributes(false),
+				},
+				cr: elbResource(withExternalName(elbName),
+					withSpec(v1alpha1.ELBParameters{
+						AvailabilityZones:      availabilityZones,
+						CrossZoneLoadBalancing: aws.Bool(true),
+					})),
+			},
+			want: want{
+				cr: elbResource(withExternalName(elbName),
+					withSpec(v1alpha1.ELBParameters{
+						AvailabilityZones:      availabilityZones,
+						CrossZoneLoadBalancing: aws.Bool(true),
 					}),
 					withConditions(corev1alpha1.Available())),
 				result: managed.ExternalObservation{
@@ -248,6 +309,38 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"DescribeAttributesError": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(input *awselb.DescribeLoadBalancersInput) awselb.DescribeLoadBalancersRequest {
+						return awselb.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancersOutput{
+								LoadBalancerDescriptions: []awselb.LoadBalancerDescription{loadBalancer},
+							}},
+						}
+					},
+					MockDescribeTagsRequest: func(input *awselb.DescribeTagsInput) awselb.DescribeTagsRequest {
+						return awselb.DescribeTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeTagsOutput{
+								TagDescriptions: []awselb.TagDescription{
+									{LoadBalancerName: &elbName},
+								},
+							}},
+						}
+					},
+					MockDescribeLoadBalancerAttributesRequest: func(input *awselb.DescribeLoadBalancerAttributesInput) awselb.DescribeLoadBalancerAttributesRequest {
+						return awselb.DescribeLoadBalancerAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: elbResource(withExternalName(elbName)),
+			},
+			want: want{
+				cr:  elbResource(withExternalName(elbName)),
+				err: errors.Wrap(errBoom, errDescribeAttrs),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -399,6 +492,48 @@ func TestUpdate(t *testing.T) {
 					})),
 			},
 		},
+		"UpdateCrossZoneLoadBalancing": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(input *awselb.DescribeLoadBalancersInput) awselb.DescribeLoadBalancersRequest {
+						return awselb.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancersOutput{
+								LoadBalancerDescriptions: []awselb.LoadBalancerDescription{loadBalancer},
+							}},
+						}
+					},
+					MockDescribeTagsRequest: func(input *awselb.DescribeTagsInput) awselb.DescribeTagsRequest {
+						return awselb.DescribeTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeTagsOutput{
+								TagDescriptions: []awselb.TagDescription{
+									{LoadBalancerName: &elbName},
+								},
+							}},
+						}
+					},
+					MockModifyLoadBalancerAttributesRequest: func(input *awselb.ModifyLoadBalancerAttributesInput) awselb.ModifyLoadBalancerAttributesRequest {
+						if !aws.BoolValue(input.LoadBalancerAttributes.CrossZoneLoadBalancing.Enabled) {
+							t.Errorf("expected cross-zone load balancing to be enabled")
+						}
+						return awselb.ModifyLoadBalancerAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.ModifyLoadBalancerAttributesOutput{}},
+						}
+					},
+				},
+				cr: elbResource(withExternalName(elbName),
+					withSpec(v1alpha1.ELBParameters{
+						AvailabilityZones:      availabilityZones,
+						CrossZoneLoadBalancing: aws.Bool(true),
+					})),
+			},
+			want: want{
+				cr: elbResource(withExternalName(elbName),
+					withSpec(v1alpha1.ELBParameters{
+						AvailabilityZones:      availabilityZones,
+						CrossZoneLoadBalancing: aws.Bool(true),
+					})),
+			},
+		},
 		"UpdateSubnet": {
 			args: args{
 				elb: &fake.MockClient{
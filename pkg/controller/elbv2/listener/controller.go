@@ -0,0 +1,238 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listener
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+const (
+	errUnexpectedObject   = "The managed resource is not a Listener resource"
+	errDescribe           = "failed to describe Listener"
+	errNotSingleItem      = "either no or multiple Listeners retrieved for the given ARN"
+	errSpecUpdate         = "cannot update spec of the Listener resource"
+	errCreate             = "failed to create the Listener resource"
+	errModify             = "failed to modify the Listener resource"
+	errDelete             = "failed to delete the Listener resource"
+	errAddCertificates    = "failed to add certificates to the Listener resource"
+	errRemoveCertificates = "failed to remove certificates from the Listener resource"
+)
+
+// SetupListener adds a controller that reconciles Listeners.
+func SetupListener(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ListenerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Listener{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ListenerGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: elbv2.NewListenerClient}, awscommon.DeletionTierWorkload)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) elbv2.ListenerClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Listener)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client elbv2.ListenerClient
+}
+
+// describe returns the listener with the given ARN and the ARNs of its
+// additional certificates.
+func (e *external) describe(ctx context.Context, arn string) (*awselbv2.Listener, []string, error) {
+	response, err := e.client.DescribeListenersRequest(&awselbv2.DescribeListenersInput{
+		ListenerArns: []string{arn},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.Listeners) != 1 {
+		return nil, nil, errors.New(errNotSingleItem)
+	}
+
+	var certs []awselbv2.Certificate
+	in := &awselbv2.DescribeListenerCertificatesInput{ListenerArn: aws.String(arn)}
+	for {
+		out, err := e.client.DescribeListenerCertificatesRequest(in).Send(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		certs = append(certs, out.Certificates...)
+		if out.NextMarker == nil {
+			break
+		}
+		in.Marker = out.NextMarker
+	}
+	return &response.Listeners[0], elbv2.AdditionalCertificateARNs(certs), nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Listener)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, certs, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elbv2.IsListenerNotFoundErr, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	elbv2.LateInitializeListener(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = elbv2.GenerateListenerObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: elbv2.IsListenerUpToDate(cr.Spec.ForProvider, *observed, certs),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Listener)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	response, err := e.client.CreateListenerRequest(elbv2.GenerateCreateListenerInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if len(response.Listeners) != 1 {
+		return managed.ExternalCreation{}, errors.New(errNotSingleItem)
+	}
+
+	// NOTE: The additional certificates are added by the first update.
+	meta.SetExternalName(cr, aws.StringValue(response.Listeners[0].ListenerArn))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Listener)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := meta.GetExternalName(cr)
+	observed, certs, err := e.describe(ctx, arn)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	if !elbv2.IsListenerConfigUpToDate(cr.Spec.ForProvider, *observed) {
+		if _, err := e.client.ModifyListenerRequest(elbv2.GenerateModifyListenerInput(arn, cr.Spec.ForProvider)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+		}
+	}
+
+	add, remove := elbv2.DiffListenerCertificates(cr.Spec.ForProvider, certs)
+	if len(remove) > 0 {
+		if _, err := e.client.RemoveListenerCertificatesRequest(&awselbv2.RemoveListenerCertificatesInput{
+			ListenerArn:  aws.String(arn),
+			Certificates: certificates(remove),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveCertificates)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.AddListenerCertificatesRequest(&awselbv2.AddListenerCertificatesInput{
+			ListenerArn:  aws.String(arn),
+			Certificates: certificates(add),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddCertificates)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Listener)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteListenerRequest(&awselbv2.DeleteListenerInput{
+		ListenerArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(elbv2.IsListenerNotFoundErr, err), errDelete)
+}
+
+func certificates(arns []string) []awselbv2.Certificate {
+	res := make([]awselbv2.Certificate, len(arns))
+	for i, arn := range arns {
+		res[i] = awselbv2.Certificate{CertificateArn: aws.String(arn)}
+	}
+	return res
+}
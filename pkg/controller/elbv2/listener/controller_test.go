@@ -0,0 +1,464 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listener
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+var (
+	listenerARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2"
+	lbARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"
+	tgARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"
+	certARN1    = "arn:aws:acm:us-east-1:123456789012:certificate/1"
+	certARN2    = "arn:aws:acm:us-east-1:123456789012:certificate/2"
+	sslPolicy   = "ELBSecurityPolicy-2016-08"
+	errBoom     = errors.New("boom")
+)
+
+type listenerModifier func(*v1alpha1.Listener)
+
+func withExternalName(name string) listenerModifier {
+	return func(r *v1alpha1.Listener) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.ListenerParameters) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.ListenerObservation) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Status.AtProvider = s }
+}
+
+func listener(m ...listenerModifier) *v1alpha1.Listener {
+	cr := &v1alpha1.Listener{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specParams(certs ...string) v1alpha1.ListenerParameters {
+	return v1alpha1.ListenerParameters{
+		LoadBalancerARN: aws.String(lbARN),
+		Port:            443,
+		Protocol:        "HTTPS",
+		SSLPolicy:       aws.String(sslPolicy),
+		CertificateARNs: certs,
+		DefaultActions: []v1alpha1.Action{{
+			Type:           "forward",
+			TargetGroupARN: aws.String(tgARN),
+		}},
+	}
+}
+
+func describeRequest(*awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+	return awselbv2.DescribeListenersRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeListenersOutput{
+			Listeners: []awselbv2.Listener{{
+				ListenerArn:     aws.String(listenerARN),
+				LoadBalancerArn: aws.String(lbARN),
+				Port:            aws.Int64(443),
+				Protocol:        awselbv2.ProtocolEnumHttps,
+				SslPolicy:       aws.String(sslPolicy),
+				Certificates:    []awselbv2.Certificate{{CertificateArn: aws.String(certARN1)}},
+				DefaultActions: []awselbv2.Action{{
+					Type:           awselbv2.ActionTypeEnumForward,
+					Order:          aws.Int64(1),
+					TargetGroupArn: aws.String(tgARN),
+				}},
+			}},
+		}},
+	}
+}
+
+func describeCertificatesRequest(certs ...string) func(*awselbv2.DescribeListenerCertificatesInput) awselbv2.DescribeListenerCertificatesRequest {
+	return func(*awselbv2.DescribeListenerCertificatesInput) awselbv2.DescribeListenerCertificatesRequest {
+		out := &awselbv2.DescribeListenerCertificatesOutput{
+			Certificates: []awselbv2.Certificate{{CertificateArn: aws.String(certARN1), IsDefault: aws.Bool(true)}},
+		}
+		for _, c := range certs {
+			out.Certificates = append(out.Certificates, awselbv2.Certificate{CertificateArn: aws.String(c), IsDefault: aws.Bool(false)})
+		}
+		return awselbv2.DescribeListenerCertificatesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	listener elbv2.ListenerClient
+	kube     client.Client
+	cr       *v1alpha1.Listener
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Listener
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				listener: &fake.MockListenerClient{},
+				cr:       listener(),
+			},
+			want: want{
+				cr: listener(),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				listener: &fake.MockListenerClient{
+					MockDescribeListeners:            describeRequest,
+					MockDescribeListenerCertificates: describeCertificatesRequest(certARN2),
+				},
+				cr: listener(withSpec(specParams(certARN1, certARN2)), withExternalName(listenerARN)),
+			},
+			want: want{
+				cr: listener(withSpec(specParams(certARN1, certARN2)), withExternalName(listenerARN),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha1.ListenerObservation{ListenerARN: listenerARN})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CertificatesOutdated": {
+			args: args{
+				listener: &fake.MockListenerClient{
+					MockDescribeListeners:            describeRequest,
+					MockDescribeListenerCertificates: describeCertificatesRequest(),
+				},
+				cr: listener(withSpec(specParams(certARN1, certARN2)), withExternalName(listenerARN)),
+			},
+			want: want{
+				cr: listener(withSpec(specParams(certARN1, certARN2)), withExternalName(listenerARN),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha1.ListenerObservation{ListenerARN: listenerARN})),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				listener: &fake.MockListenerClient{
+					MockDescribeListeners: func(*awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+						return awselbv2.DescribeListenersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awselbv2.ErrCodeListenerNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: listener(withSpec(specParams(certARN1)), withExternalName(listenerARN)),
+			},
+			want: want{
+				cr: listener(withSpec(specParams(certARN1)), withExternalName(listenerARN)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				listener: &fake.MockListenerClient{
+					MockDescribeListeners: func(*awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+						return awselbv2.DescribeListenersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listener(withSpec(specParams(certARN1)), withExternalName(listenerARN)),
+			},
+			want: want{
+				cr:  listener(withSpec(specParams(certARN1)), withExternalName(listenerARN)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.listener}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Listener
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				listener: &fake.MockListenerClient{
+					MockCreateListener: func(input *awselbv2.CreateListenerInput) awselbv2.CreateListenerRequest {
+						if diff := cmp.Diff([]awselbv2.Certificate{{CertificateArn: aws.String(certARN1)}}, input.Certificates); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.CreateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateListenerOutput{
+								Listeners: []awselbv2.Listener{{ListenerArn: aws.String(listenerARN)}},
+							}},
+						}
+					},
+				},
+				cr: listener(withSpec(specParams(certARN1, certARN2))),
+			},
+			want: want{
+				cr: listener(withSpec(specParams(certARN1, certARN2)), withExternalName(listenerARN),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				listener: &fake.MockListenerClient{
+					MockCreateListener: func(*awselbv2.CreateListenerInput) awselbv2.CreateListenerRequest {
+						return awselbv2.CreateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listener(withSpec(specParams(certARN1))),
+			},
+			want: want{
+				cr:  listener(withSpec(specParams(certARN1)), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.listener}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Listener
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AddCertificates": {
+			args: args{
+				listener: &fake.MockListenerClient{
+					MockDescribeListeners:            describeRequest,
+					MockDescribeListenerCertificates: describeCertificatesRequest(),
+					MockAddListenerCertificates: func(input *awselbv2.AddListenerCertificatesInput) awselbv2.AddListenerCertificatesRequest {
+						if diff := cmp.Diff([]awselbv2.Certificate{{CertificateArn: aws.String(certARN2)}}, input.Certificates); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.AddListenerCertificatesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.AddListenerCertificatesOutput{}},
+						}
+					},
+				},
+				cr: listener(withSpec(specParams(certARN1, certARN2)), withExternalName(listenerARN)),
+			},
+			want: want{
+				cr: listener(withSpec(specParams(certARN1, certARN2)), withExternalName(listenerARN)),
+			},
+		},
+		"ModifyAndRemoveCertificates": {
+			args: args{
+				listener: &fake.MockListenerClient{
+					MockDescribeListeners:            describeRequest,
+					MockDescribeListenerCertificates: describeCertificatesRequest(certARN1),
+					MockModifyListener: func(input *awselbv2.ModifyListenerInput) awselbv2.ModifyListenerRequest {
+						if diff := cmp.Diff([]awselbv2.Certificate{{CertificateArn: aws.String(certARN2)}}, input.Certificates); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.ModifyListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.ModifyListenerOutput{}},
+						}
+					},
+					MockRemoveListenerCertificates: func(input *awselbv2.RemoveListenerCertificatesInput) awselbv2.RemoveListenerCertificatesRequest {
+						if diff := cmp.Diff([]awselbv2.Certificate{{CertificateArn: aws.String(certARN1)}}, input.Certificates); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.RemoveListenerCertificatesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.RemoveListenerCertificatesOutput{}},
+						}
+					},
+				},
+				cr: listener(withSpec(specParams(certARN2)), withExternalName(listenerARN)),
+			},
+			want: want{
+				cr: listener(withSpec(specParams(certARN2)), withExternalName(listenerARN)),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				listener: &fake.MockListenerClient{
+					MockDescribeListeners:            describeRequest,
+					MockDescribeListenerCertificates: describeCertificatesRequest(),
+					MockModifyListener: func(*awselbv2.ModifyListenerInput) awselbv2.ModifyListenerRequest {
+						return awselbv2.ModifyListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listener(withSpec(specParams(certARN2)), withExternalName(listenerARN)),
+			},
+			want: want{
+				cr:  listener(withSpec(specParams(certARN2)), withExternalName(listenerARN)),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.listener}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Listener
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				listener: &fake.MockListenerClient{
+					MockDeleteListener: func(*awselbv2.DeleteListenerInput) awselbv2.DeleteListenerRequest {
+						return awselbv2.DeleteListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteListenerOutput{}},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				listener: &fake.MockListenerClient{
+					MockDeleteListener: func(*awselbv2.DeleteListenerInput) awselbv2.DeleteListenerRequest {
+						return awselbv2.DeleteListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr:  listener(withExternalName(listenerARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.listener}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listenerrule
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a ListenerRule resource"
+	errDescribe         = "failed to describe ListenerRule"
+	errNotSingleItem    = "either no or multiple ListenerRules retrieved for the given ARN"
+	errSpecUpdate       = "cannot update spec of the ListenerRule resource"
+	errCreate           = "failed to create the ListenerRule resource"
+	errModify           = "failed to modify the ListenerRule resource"
+	errSetPriority      = "failed to set the priority of the ListenerRule resource"
+	errDelete           = "failed to delete the ListenerRule resource"
+)
+
+// SetupListenerRule adds a controller that reconciles ListenerRules.
+func SetupListenerRule(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ListenerRuleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ListenerRule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ListenerRuleGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: elbv2.NewListenerRuleClient}, awscommon.DeletionTierWorkload)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) elbv2.ListenerRuleClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ListenerRule)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client elbv2.ListenerRuleClient
+}
+
+func (e *external) describe(ctx context.Context, arn string) (*awselbv2.Rule, error) {
+	response, err := e.client.DescribeRulesRequest(&awselbv2.DescribeRulesInput{
+		RuleArns: []string{arn},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.Rules) != 1 {
+		return nil, errors.New(errNotSingleItem)
+	}
+	return &response.Rules[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.ListenerRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elbv2.IsListenerRuleNotFoundErr, err), errDescribe)
+	}
+
+	cr.Status.AtProvider = elbv2.GenerateListenerRuleObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: elbv2.IsListenerRuleUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.ListenerRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	response, err := e.client.CreateRuleRequest(elbv2.GenerateCreateListenerRuleInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if len(response.Rules) != 1 {
+		return managed.ExternalCreation{}, errors.New(errNotSingleItem)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(response.Rules[0].RuleArn))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.ListenerRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := meta.GetExternalName(cr)
+	observed, err := e.describe(ctx, arn)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	if !elbv2.AreListenerRuleConditionsUpToDate(cr.Spec.ForProvider, *observed) ||
+		!elbv2.AreActionsUpToDate(cr.Spec.ForProvider.Actions, observed.Actions) {
+		if _, err := e.client.ModifyRuleRequest(&awselbv2.ModifyRuleInput{
+			RuleArn:    aws.String(arn),
+			Conditions: elbv2.GenerateConditions(cr.Spec.ForProvider.Conditions),
+			Actions:    elbv2.GenerateActions(cr.Spec.ForProvider.Actions),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+		}
+	}
+
+	if !elbv2.IsListenerRulePriorityUpToDate(cr.Spec.ForProvider, *observed) {
+		if _, err := e.client.SetRulePrioritiesRequest(&awselbv2.SetRulePrioritiesInput{
+			RulePriorities: []awselbv2.RulePriorityPair{{
+				RuleArn:  aws.String(arn),
+				Priority: aws.Int64(cr.Spec.ForProvider.Priority),
+			}},
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetPriority)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.ListenerRule)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteRuleRequest(&awselbv2.DeleteRuleInput{
+		RuleArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(elbv2.IsListenerRuleNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,431 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listenerrule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+var (
+	ruleARN     = "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener-rule/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2/9683b2d02a6cabee"
+	listenerARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2"
+	tgARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"
+	errBoom     = errors.New("boom")
+)
+
+type ruleModifier func(*v1alpha1.ListenerRule)
+
+func withExternalName(name string) ruleModifier {
+	return func(r *v1alpha1.ListenerRule) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) ruleModifier {
+	return func(r *v1alpha1.ListenerRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.ListenerRuleParameters) ruleModifier {
+	return func(r *v1alpha1.ListenerRule) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.ListenerRuleObservation) ruleModifier {
+	return func(r *v1alpha1.ListenerRule) { r.Status.AtProvider = s }
+}
+
+func rule(m ...ruleModifier) *v1alpha1.ListenerRule {
+	cr := &v1alpha1.ListenerRule{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specParams(priority int64, path string) v1alpha1.ListenerRuleParameters {
+	return v1alpha1.ListenerRuleParameters{
+		ListenerARN: aws.String(listenerARN),
+		Priority:    priority,
+		Conditions: []v1alpha1.RuleCondition{{
+			Field:  elbv2.ConditionFieldPathPattern,
+			Values: []string{path},
+		}},
+		Actions: []v1alpha1.Action{{
+			Type:           "forward",
+			TargetGroupARN: aws.String(tgARN),
+		}},
+	}
+}
+
+func describeRequest(*awselbv2.DescribeRulesInput) awselbv2.DescribeRulesRequest {
+	return awselbv2.DescribeRulesRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeRulesOutput{
+			Rules: []awselbv2.Rule{{
+				RuleArn:  aws.String(ruleARN),
+				Priority: aws.String("10"),
+				Conditions: []awselbv2.RuleCondition{{
+					Field:             aws.String(elbv2.ConditionFieldPathPattern),
+					PathPatternConfig: &awselbv2.PathPatternConditionConfig{Values: []string{"/api/*"}},
+				}},
+				Actions: []awselbv2.Action{{
+					Type:           awselbv2.ActionTypeEnumForward,
+					Order:          aws.Int64(1),
+					TargetGroupArn: aws.String(tgARN),
+				}},
+			}},
+		}},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	rule elbv2.ListenerRuleClient
+	kube client.Client
+	cr   *v1alpha1.ListenerRule
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ListenerRule
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				rule: &fake.MockListenerRuleClient{},
+				cr:   rule(),
+			},
+			want: want{
+				cr: rule(),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				rule: &fake.MockListenerRuleClient{
+					MockDescribeRules: describeRequest,
+				},
+				cr: rule(withSpec(specParams(10, "/api/*")), withExternalName(ruleARN)),
+			},
+			want: want{
+				cr: rule(withSpec(specParams(10, "/api/*")), withExternalName(ruleARN),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha1.ListenerRuleObservation{RuleARN: ruleARN})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PriorityOutdated": {
+			args: args{
+				rule: &fake.MockListenerRuleClient{
+					MockDescribeRules: describeRequest,
+				},
+				cr: rule(withSpec(specParams(20, "/api/*")), withExternalName(ruleARN)),
+			},
+			want: want{
+				cr: rule(withSpec(specParams(20, "/api/*")), withExternalName(ruleARN),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha1.ListenerRuleObservation{RuleARN: ruleARN})),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				rule: &fake.MockListenerRuleClient{
+					MockDescribeRules: func(*awselbv2.DescribeRulesInput) awselbv2.DescribeRulesRequest {
+						return awselbv2.DescribeRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awselbv2.ErrCodeRuleNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: rule(withSpec(specParams(10, "/api/*")), withExternalName(ruleARN)),
+			},
+			want: want{
+				cr: rule(withSpec(specParams(10, "/api/*")), withExternalName(ruleARN)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				rule: &fake.MockListenerRuleClient{
+					MockDescribeRules: func(*awselbv2.DescribeRulesInput) awselbv2.DescribeRulesRequest {
+						return awselbv2.DescribeRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rule(withSpec(specParams(10, "/api/*")), withExternalName(ruleARN)),
+			},
+			want: want{
+				cr:  rule(withSpec(specParams(10, "/api/*")), withExternalName(ruleARN)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rule}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ListenerRule
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				rule: &fake.MockListenerRuleClient{
+					MockCreateRule: func(*awselbv2.CreateRuleInput) awselbv2.CreateRuleRequest {
+						return awselbv2.CreateRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateRuleOutput{
+								Rules: []awselbv2.Rule{{RuleArn: aws.String(ruleARN)}},
+							}},
+						}
+					},
+				},
+				cr: rule(withSpec(specParams(10, "/api/*"))),
+			},
+			want: want{
+				cr: rule(withSpec(specParams(10, "/api/*")), withExternalName(ruleARN),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				rule: &fake.MockListenerRuleClient{
+					MockCreateRule: func(*awselbv2.CreateRuleInput) awselbv2.CreateRuleRequest {
+						return awselbv2.CreateRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rule(withSpec(specParams(10, "/api/*"))),
+			},
+			want: want{
+				cr:  rule(withSpec(specParams(10, "/api/*")), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rule}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ListenerRule
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyConditions": {
+			args: args{
+				rule: &fake.MockListenerRuleClient{
+					MockDescribeRules: describeRequest,
+					MockModifyRule: func(input *awselbv2.ModifyRuleInput) awselbv2.ModifyRuleRequest {
+						if diff := cmp.Diff([]string{"/v2/*"}, input.Conditions[0].PathPatternConfig.Values); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.ModifyRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.ModifyRuleOutput{}},
+						}
+					},
+				},
+				cr: rule(withSpec(specParams(10, "/v2/*")), withExternalName(ruleARN)),
+			},
+			want: want{
+				cr: rule(withSpec(specParams(10, "/v2/*")), withExternalName(ruleARN)),
+			},
+		},
+		"SetPriority": {
+			args: args{
+				rule: &fake.MockListenerRuleClient{
+					MockDescribeRules: describeRequest,
+					MockSetRulePriorities: func(input *awselbv2.SetRulePrioritiesInput) awselbv2.SetRulePrioritiesRequest {
+						if diff := cmp.Diff(int64(20), aws.Int64Value(input.RulePriorities[0].Priority)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.SetRulePrioritiesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.SetRulePrioritiesOutput{}},
+						}
+					},
+				},
+				cr: rule(withSpec(specParams(20, "/api/*")), withExternalName(ruleARN)),
+			},
+			want: want{
+				cr: rule(withSpec(specParams(20, "/api/*")), withExternalName(ruleARN)),
+			},
+		},
+		"SetPriorityFail": {
+			args: args{
+				rule: &fake.MockListenerRuleClient{
+					MockDescribeRules: describeRequest,
+					MockSetRulePriorities: func(*awselbv2.SetRulePrioritiesInput) awselbv2.SetRulePrioritiesRequest {
+						return awselbv2.SetRulePrioritiesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rule(withSpec(specParams(20, "/api/*")), withExternalName(ruleARN)),
+			},
+			want: want{
+				cr:  rule(withSpec(specParams(20, "/api/*")), withExternalName(ruleARN)),
+				err: errors.Wrap(errBoom, errSetPriority),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rule}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ListenerRule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rule: &fake.MockListenerRuleClient{
+					MockDeleteRule: func(*awselbv2.DeleteRuleInput) awselbv2.DeleteRuleRequest {
+						return awselbv2.DeleteRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteRuleOutput{}},
+						}
+					},
+				},
+				cr: rule(withExternalName(ruleARN)),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				rule: &fake.MockListenerRuleClient{
+					MockDeleteRule: func(*awselbv2.DeleteRuleInput) awselbv2.DeleteRuleRequest {
+						return awselbv2.DeleteRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awselbv2.ErrCodeRuleNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: rule(withExternalName(ruleARN)),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rule}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+const (
+	errUnexpectedObject  = "The managed resource is not a LoadBalancer resource"
+	errDescribe          = "failed to describe LoadBalancer"
+	errNotSingleItem     = "either no or multiple LoadBalancers retrieved for the given ARN"
+	errSpecUpdate        = "cannot update spec of the LoadBalancer resource"
+	errCreate            = "failed to create the LoadBalancer resource"
+	errDelete            = "failed to delete the LoadBalancer resource"
+	errSetSecurityGroups = "failed to set security groups of the LoadBalancer resource"
+	errSetSubnets        = "failed to set subnets of the LoadBalancer resource"
+	errSetIPAddressType  = "failed to set IP address type of the LoadBalancer resource"
+	errAddTags           = "failed to add tags to the LoadBalancer resource"
+	errRemoveTags        = "failed to remove tags from the LoadBalancer resource"
+)
+
+// SetupLoadBalancer adds a controller that reconciles LoadBalancers.
+func SetupLoadBalancer(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.LoadBalancerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LoadBalancer{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LoadBalancerGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: elbv2.NewLoadBalancerClient}, awscommon.DeletionTierWorkload)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) elbv2.LoadBalancerClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client elbv2.LoadBalancerClient
+}
+
+func (e *external) describe(ctx context.Context, arn string) (*awselbv2.LoadBalancer, []awselbv2.Tag, error) {
+	response, err := e.client.DescribeLoadBalancersRequest(&awselbv2.DescribeLoadBalancersInput{
+		LoadBalancerArns: []string{arn},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.LoadBalancers) != 1 {
+		return nil, nil, errors.New(errNotSingleItem)
+	}
+
+	tags, err := e.client.DescribeTagsRequest(&awselbv2.DescribeTagsInput{
+		ResourceArns: []string{arn},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(tags.TagDescriptions) == 0 {
+		return &response.LoadBalancers[0], nil, nil
+	}
+	return &response.LoadBalancers[0], tags.TagDescriptions[0].Tags, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, tags, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elbv2.IsLoadBalancerNotFoundErr, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	elbv2.LateInitializeLoadBalancer(&cr.Spec.ForProvider, observed, tags)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = elbv2.GenerateLoadBalancerObservation(*observed)
+
+	switch awselbv2.LoadBalancerStateEnum(cr.Status.AtProvider.State) {
+	case awselbv2.LoadBalancerStateEnumProvisioning:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awselbv2.LoadBalancerStateEnumActive, awselbv2.LoadBalancerStateEnumActiveImpaired:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awselbv2.LoadBalancerStateEnumFailed:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  elbv2.IsLoadBalancerUpToDate(cr.Spec.ForProvider, *observed, tags),
+		ConnectionDetails: elbv2.GetLoadBalancerConnectionDetails(*cr),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	// NOTE: CreateLoadBalancer returns the existing load balancer if there is
+	// one with the same name and parameters.
+	response, err := e.client.CreateLoadBalancerRequest(elbv2.GenerateCreateLoadBalancerInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if len(response.LoadBalancers) != 1 {
+		return managed.ExternalCreation{}, errors.New(errNotSingleItem)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(response.LoadBalancers[0].LoadBalancerArn))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := meta.GetExternalName(cr)
+	observed, tags, err := e.describe(ctx, arn)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	p := cr.Spec.ForProvider
+
+	if !elbv2.AreLoadBalancerSubnetsUpToDate(p, *observed) {
+		if _, err := e.client.SetSubnetsRequest(&awselbv2.SetSubnetsInput{
+			LoadBalancerArn: aws.String(arn),
+			Subnets:         p.SubnetIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetSubnets)
+		}
+	}
+
+	if !elbv2.AreLoadBalancerSecurityGroupsUpToDate(p, *observed) {
+		if _, err := e.client.SetSecurityGroupsRequest(&awselbv2.SetSecurityGroupsInput{
+			LoadBalancerArn: aws.String(arn),
+			SecurityGroups:  p.SecurityGroupIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetSecurityGroups)
+		}
+	}
+
+	if p.IPAddressType != nil && *p.IPAddressType != string(observed.IpAddressType) {
+		if _, err := e.client.SetIpAddressTypeRequest(&awselbv2.SetIpAddressTypeInput{
+			LoadBalancerArn: aws.String(arn),
+			IpAddressType:   awselbv2.IpAddressType(*p.IPAddressType),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetIPAddressType)
+		}
+	}
+
+	addTags, removeTags := elbv2.DiffTags(p.Tags, tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.RemoveTagsRequest(&awselbv2.RemoveTagsInput{
+			ResourceArns: []string{arn},
+			TagKeys:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.AddTagsRequest(&awselbv2.AddTagsInput{
+			ResourceArns: []string{arn},
+			Tags:         addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteLoadBalancerRequest(&awselbv2.DeleteLoadBalancerInput{
+		LoadBalancerArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(elbv2.IsLoadBalancerNotFoundErr, err), errDelete)
+}
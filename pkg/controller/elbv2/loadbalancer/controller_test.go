@@ -0,0 +1,620 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+var (
+	lbARN     = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"
+	lbName    = "my-lb"
+	dnsName   = "my-lb-123456789.us-east-1.elb.amazonaws.com"
+	subnetA   = "subnet-a"
+	subnetB   = "subnet-b"
+	sgID      = "sg-0123456789"
+	lbType    = "application"
+	scheme    = "internet-facing"
+	ipv4      = "ipv4"
+	dualstack = "dualstack"
+	errBoom   = errors.New("boom")
+)
+
+type lbModifier func(*v1alpha1.LoadBalancer)
+
+func withExternalName(name string) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.LoadBalancerParameters) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.LoadBalancerObservation) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Status.AtProvider = s }
+}
+
+func lb(m ...lbModifier) *v1alpha1.LoadBalancer {
+	cr := &v1alpha1.LoadBalancer{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specTags() []v1alpha1.Tag {
+	return []v1alpha1.Tag{{Key: "key1", Value: aws.String("value1")}}
+}
+
+func lbTags() []awselbv2.Tag {
+	return []awselbv2.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}}
+}
+
+func specParams() v1alpha1.LoadBalancerParameters {
+	return v1alpha1.LoadBalancerParameters{
+		Name:             lbName,
+		Type:             &lbType,
+		Scheme:           &scheme,
+		IPAddressType:    &ipv4,
+		SubnetIDs:        []string{subnetA, subnetB},
+		SecurityGroupIDs: []string{sgID},
+		Tags:             specTags(),
+	}
+}
+
+func loadBalancer(state awselbv2.LoadBalancerStateEnum) awselbv2.LoadBalancer {
+	return awselbv2.LoadBalancer{
+		LoadBalancerArn:  aws.String(lbARN),
+		LoadBalancerName: aws.String(lbName),
+		DNSName:          aws.String(dnsName),
+		Type:             awselbv2.LoadBalancerTypeEnum(lbType),
+		Scheme:           awselbv2.LoadBalancerSchemeEnum(scheme),
+		IpAddressType:    awselbv2.IpAddressType(ipv4),
+		AvailabilityZones: []awselbv2.AvailabilityZone{
+			{SubnetId: aws.String(subnetB)},
+			{SubnetId: aws.String(subnetA)},
+		},
+		SecurityGroups: []string{sgID},
+		State:          &awselbv2.LoadBalancerState{Code: state},
+	}
+}
+
+func describeRequest(state awselbv2.LoadBalancerStateEnum) func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+	return func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+		return awselbv2.DescribeLoadBalancersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeLoadBalancersOutput{
+				LoadBalancers: []awselbv2.LoadBalancer{loadBalancer(state)},
+			}},
+		}
+	}
+}
+
+func describeTagsRequest(tags []awselbv2.Tag) func(*awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+	return func(*awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+		return awselbv2.DescribeTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeTagsOutput{
+				TagDescriptions: []awselbv2.TagDescription{{ResourceArn: aws.String(lbARN), Tags: tags}},
+			}},
+		}
+	}
+}
+
+func observation(state awselbv2.LoadBalancerStateEnum) v1alpha1.LoadBalancerObservation {
+	return v1alpha1.LoadBalancerObservation{
+		LoadBalancerARN: lbARN,
+		DNSName:         dnsName,
+		State:           string(state),
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	lb   elbv2.LoadBalancerClient
+	kube client.Client
+	cr   *v1alpha1.LoadBalancer
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LoadBalancer
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				lb: &fake.MockLoadBalancerClient{},
+				cr: lb(),
+			},
+			want: want{
+				cr: lb(),
+			},
+		},
+		"Available": {
+			args: args{
+				lb: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeRequest(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeTags:          describeTagsRequest(lbTags()),
+				},
+				cr: lb(withSpec(specParams()), withExternalName(lbARN)),
+			},
+			want: want{
+				cr: lb(withSpec(specParams()), withExternalName(lbARN),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(observation(awselbv2.LoadBalancerStateEnumActive))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(dnsName),
+					},
+				},
+			},
+		},
+		"Provisioning": {
+			args: args{
+				lb: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeRequest(awselbv2.LoadBalancerStateEnumProvisioning),
+					MockDescribeTags:          describeTagsRequest(lbTags()),
+				},
+				cr: lb(withSpec(specParams()), withExternalName(lbARN)),
+			},
+			want: want{
+				cr: lb(withSpec(specParams()), withExternalName(lbARN),
+					withConditions(runtimev1alpha1.Creating()),
+					withStatus(observation(awselbv2.LoadBalancerStateEnumProvisioning))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(dnsName),
+					},
+				},
+			},
+		},
+		"TagsOutdated": {
+			args: args{
+				lb: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeRequest(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeTags:          describeTagsRequest(nil),
+				},
+				cr: lb(withSpec(specParams()), withExternalName(lbARN)),
+			},
+			want: want{
+				cr: lb(withSpec(specParams()), withExternalName(lbARN),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(observation(awselbv2.LoadBalancerStateEnumActive))),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(dnsName),
+					},
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				lb: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeRequest(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeTags:          describeTagsRequest(lbTags()),
+				},
+				cr: lb(withSpec(v1alpha1.LoadBalancerParameters{Name: lbName}), withExternalName(lbARN)),
+			},
+			want: want{
+				cr: lb(withSpec(v1alpha1.LoadBalancerParameters{
+					Name:             lbName,
+					Type:             &lbType,
+					Scheme:           &scheme,
+					IPAddressType:    &ipv4,
+					SubnetIDs:        []string{subnetB, subnetA},
+					SecurityGroupIDs: []string{sgID},
+					Tags:             specTags(),
+				}), withExternalName(lbARN),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(observation(awselbv2.LoadBalancerStateEnumActive))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(dnsName),
+					},
+				},
+			},
+		},
+		"LateInitFailedKubeUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				lb: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeRequest(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeTags:          describeTagsRequest(lbTags()),
+				},
+				cr: lb(withSpec(v1alpha1.LoadBalancerParameters{Name: lbName}), withExternalName(lbARN)),
+			},
+			want: want{
+				cr: lb(withSpec(v1alpha1.LoadBalancerParameters{
+					Name:             lbName,
+					Type:             &lbType,
+					Scheme:           &scheme,
+					IPAddressType:    &ipv4,
+					SubnetIDs:        []string{subnetB, subnetA},
+					SecurityGroupIDs: []string{sgID},
+					Tags:             specTags(),
+				}), withExternalName(lbARN)),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"NotFound": {
+			args: args{
+				lb: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+						return awselbv2.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awselbv2.ErrCodeLoadBalancerNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: lb(withSpec(specParams()), withExternalName(lbARN)),
+			},
+			want: want{
+				cr: lb(withSpec(specParams()), withExternalName(lbARN)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				lb: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+						return awselbv2.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lb(withSpec(specParams()), withExternalName(lbARN)),
+			},
+			want: want{
+				cr:  lb(withSpec(specParams()), withExternalName(lbARN)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lb}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LoadBalancer
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				lb: &fake.MockLoadBalancerClient{
+					MockCreateLoadBalancer: func(*awselbv2.CreateLoadBalancerInput) awselbv2.CreateLoadBalancerRequest {
+						return awselbv2.CreateLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateLoadBalancerOutput{
+								LoadBalancers: []awselbv2.LoadBalancer{loadBalancer(awselbv2.LoadBalancerStateEnumProvisioning)},
+							}},
+						}
+					},
+				},
+				cr: lb(withSpec(specParams())),
+			},
+			want: want{
+				cr: lb(withSpec(specParams()), withExternalName(lbARN),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				lb: &fake.MockLoadBalancerClient{
+					MockCreateLoadBalancer: func(*awselbv2.CreateLoadBalancerInput) awselbv2.CreateLoadBalancerRequest {
+						return awselbv2.CreateLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lb(withSpec(specParams())),
+			},
+			want: want{
+				cr:  lb(withSpec(specParams()), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"UpdateFail": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				lb: &fake.MockLoadBalancerClient{
+					MockCreateLoadBalancer: func(*awselbv2.CreateLoadBalancerInput) awselbv2.CreateLoadBalancerRequest {
+						return awselbv2.CreateLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateLoadBalancerOutput{
+								LoadBalancers: []awselbv2.LoadBalancer{loadBalancer(awselbv2.LoadBalancerStateEnumProvisioning)},
+							}},
+						}
+					},
+				},
+				cr: lb(withSpec(specParams())),
+			},
+			want: want{
+				cr: lb(withSpec(specParams()), withExternalName(lbARN),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lb}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LoadBalancer
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	params := specParams()
+	params.SubnetIDs = []string{subnetA}
+	params.IPAddressType = &dualstack
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lb: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeRequest(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeTags: describeTagsRequest([]awselbv2.Tag{
+						{Key: aws.String("key2"), Value: aws.String("value2")},
+					}),
+					MockSetSubnets: func(input *awselbv2.SetSubnetsInput) awselbv2.SetSubnetsRequest {
+						if diff := cmp.Diff([]string{subnetA}, input.Subnets); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.SetSubnetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.SetSubnetsOutput{}},
+						}
+					},
+					MockSetIpAddressType: func(input *awselbv2.SetIpAddressTypeInput) awselbv2.SetIpAddressTypeRequest {
+						if input.IpAddressType != awselbv2.IpAddressType(dualstack) {
+							t.Errorf("unexpected IP address type %s", input.IpAddressType)
+						}
+						return awselbv2.SetIpAddressTypeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.SetIpAddressTypeOutput{}},
+						}
+					},
+					MockRemoveTags: func(input *awselbv2.RemoveTagsInput) awselbv2.RemoveTagsRequest {
+						if diff := cmp.Diff([]string{"key2"}, input.TagKeys); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.RemoveTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.RemoveTagsOutput{}},
+						}
+					},
+					MockAddTags: func(input *awselbv2.AddTagsInput) awselbv2.AddTagsRequest {
+						if diff := cmp.Diff(lbTags(), input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.AddTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.AddTagsOutput{}},
+						}
+					},
+				},
+				cr: lb(withSpec(params), withExternalName(lbARN)),
+			},
+			want: want{
+				cr: lb(withSpec(params), withExternalName(lbARN)),
+			},
+		},
+		"SetSubnetsFail": {
+			args: args{
+				lb: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeRequest(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeTags:          describeTagsRequest(lbTags()),
+					MockSetSubnets: func(*awselbv2.SetSubnetsInput) awselbv2.SetSubnetsRequest {
+						return awselbv2.SetSubnetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lb(withSpec(params), withExternalName(lbARN)),
+			},
+			want: want{
+				cr:  lb(withSpec(params), withExternalName(lbARN)),
+				err: errors.Wrap(errBoom, errSetSubnets),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				lb: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+						return awselbv2.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lb(withSpec(params), withExternalName(lbARN)),
+			},
+			want: want{
+				cr:  lb(withSpec(params), withExternalName(lbARN)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lb}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.LoadBalancer
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lb: &fake.MockLoadBalancerClient{
+					MockDeleteLoadBalancer: func(*awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+						return awselbv2.DeleteLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteLoadBalancerOutput{}},
+						}
+					},
+				},
+				cr: lb(withExternalName(lbARN)),
+			},
+			want: want{
+				cr: lb(withExternalName(lbARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				lb: &fake.MockLoadBalancerClient{
+					MockDeleteLoadBalancer: func(*awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+						return awselbv2.DeleteLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awselbv2.ErrCodeLoadBalancerNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: lb(withExternalName(lbARN)),
+			},
+			want: want{
+				cr: lb(withExternalName(lbARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				lb: &fake.MockLoadBalancerClient{
+					MockDeleteLoadBalancer: func(*awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+						return awselbv2.DeleteLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lb(withExternalName(lbARN)),
+			},
+			want: want{
+				cr:  lb(withExternalName(lbARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lb}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
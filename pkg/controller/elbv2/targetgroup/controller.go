@@ -0,0 +1,226 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetgroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TargetGroup resource"
+	errDescribe         = "failed to describe TargetGroup"
+	errNotSingleItem    = "either no or multiple TargetGroups retrieved for the given ARN"
+	errSpecUpdate       = "cannot update spec of the TargetGroup resource"
+	errCreate           = "failed to create the TargetGroup resource"
+	errModify           = "failed to modify the TargetGroup resource"
+	errDelete           = "failed to delete the TargetGroup resource"
+	errAddTags          = "failed to add tags to the TargetGroup resource"
+	errRemoveTags       = "failed to remove tags from the TargetGroup resource"
+)
+
+// SetupTargetGroup adds a controller that reconciles TargetGroups.
+func SetupTargetGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.TargetGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.TargetGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TargetGroupGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(awscommon.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: elbv2.NewTargetGroupClient}, awscommon.DeletionTierAttachment)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) elbv2.TargetGroupClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.TargetGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client elbv2.TargetGroupClient
+}
+
+func (e *external) describe(ctx context.Context, arn string) (*awselbv2.TargetGroup, []awselbv2.Tag, error) {
+	response, err := e.client.DescribeTargetGroupsRequest(&awselbv2.DescribeTargetGroupsInput{
+		TargetGroupArns: []string{arn},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.TargetGroups) != 1 {
+		return nil, nil, errors.New(errNotSingleItem)
+	}
+
+	tags, err := e.client.DescribeTagsRequest(&awselbv2.DescribeTagsInput{
+		ResourceArns: []string{arn},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(tags.TagDescriptions) == 0 {
+		return &response.TargetGroups[0], nil, nil
+	}
+	return &response.TargetGroups[0], tags.TagDescriptions[0].Tags, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.TargetGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, tags, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elbv2.IsTargetGroupNotFoundErr, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	elbv2.LateInitializeTargetGroup(&cr.Spec.ForProvider, observed, tags)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = elbv2.GenerateTargetGroupObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: elbv2.IsTargetGroupHealthCheckUpToDate(cr.Spec.ForProvider, *observed) &&
+			elbv2.AreTagsUpToDate(cr.Spec.ForProvider.Tags, tags),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.TargetGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	// NOTE: CreateTargetGroup returns the existing target group if there is
+	// one with the same name and parameters.
+	response, err := e.client.CreateTargetGroupRequest(elbv2.GenerateCreateTargetGroupInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if len(response.TargetGroups) != 1 {
+		return managed.ExternalCreation{}, errors.New(errNotSingleItem)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(response.TargetGroups[0].TargetGroupArn))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.TargetGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := meta.GetExternalName(cr)
+	observed, tags, err := e.describe(ctx, arn)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	if !elbv2.IsTargetGroupHealthCheckUpToDate(cr.Spec.ForProvider, *observed) {
+		if _, err := e.client.ModifyTargetGroupRequest(elbv2.GenerateModifyTargetGroupInput(arn, cr.Spec.ForProvider)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+		}
+	}
+
+	addTags, removeTags := elbv2.DiffTags(cr.Spec.ForProvider.Tags, tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.RemoveTagsRequest(&awselbv2.RemoveTagsInput{
+			ResourceArns: []string{arn},
+			TagKeys:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.AddTagsRequest(&awselbv2.AddTagsInput{
+			ResourceArns: []string{arn},
+			Tags:         addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.TargetGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteTargetGroupRequest(&awselbv2.DeleteTargetGroupInput{
+		TargetGroupArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(elbv2.IsTargetGroupNotFoundErr, err), errDelete)
+}
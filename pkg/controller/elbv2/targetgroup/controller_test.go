@@ -0,0 +1,477 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetgroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+var (
+	tgARN   = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"
+	lbARN   = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"
+	tgName  = "my-tg"
+	vpcID   = "vpc-1"
+	errBoom = errors.New("boom")
+)
+
+type tgModifier func(*v1alpha1.TargetGroup)
+
+func withExternalName(name string) tgModifier {
+	return func(r *v1alpha1.TargetGroup) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) tgModifier {
+	return func(r *v1alpha1.TargetGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.TargetGroupParameters) tgModifier {
+	return func(r *v1alpha1.TargetGroup) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.TargetGroupObservation) tgModifier {
+	return func(r *v1alpha1.TargetGroup) { r.Status.AtProvider = s }
+}
+
+func tg(m ...tgModifier) *v1alpha1.TargetGroup {
+	cr := &v1alpha1.TargetGroup{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func healthCheck(path string) *v1alpha1.HealthCheck {
+	return &v1alpha1.HealthCheck{
+		Enabled:                 aws.Bool(true),
+		IntervalSeconds:         aws.Int64(30),
+		Path:                    aws.String(path),
+		Port:                    aws.String("traffic-port"),
+		Protocol:                aws.String("HTTP"),
+		TimeoutSeconds:          aws.Int64(5),
+		HealthyThresholdCount:   aws.Int64(5),
+		UnhealthyThresholdCount: aws.Int64(2),
+		Matcher:                 aws.String("200"),
+	}
+}
+
+func specParams(path string) v1alpha1.TargetGroupParameters {
+	return v1alpha1.TargetGroupParameters{
+		Name:        tgName,
+		TargetType:  aws.String("ip"),
+		Protocol:    aws.String("HTTP"),
+		Port:        aws.Int64(80),
+		VPCID:       aws.String(vpcID),
+		HealthCheck: healthCheck(path),
+		Tags:        []v1alpha1.Tag{{Key: "key1", Value: aws.String("value1")}},
+	}
+}
+
+func targetGroup() awselbv2.TargetGroup {
+	return awselbv2.TargetGroup{
+		TargetGroupArn:             aws.String(tgARN),
+		TargetGroupName:            aws.String(tgName),
+		TargetType:                 awselbv2.TargetTypeEnumIp,
+		Protocol:                   awselbv2.ProtocolEnumHttp,
+		Port:                       aws.Int64(80),
+		VpcId:                      aws.String(vpcID),
+		HealthCheckEnabled:         aws.Bool(true),
+		HealthCheckIntervalSeconds: aws.Int64(30),
+		HealthCheckPath:            aws.String("/"),
+		HealthCheckPort:            aws.String("traffic-port"),
+		HealthCheckProtocol:        awselbv2.ProtocolEnumHttp,
+		HealthCheckTimeoutSeconds:  aws.Int64(5),
+		HealthyThresholdCount:      aws.Int64(5),
+		UnhealthyThresholdCount:    aws.Int64(2),
+		Matcher:                    &awselbv2.Matcher{HttpCode: aws.String("200")},
+		LoadBalancerArns:           []string{lbARN},
+	}
+}
+
+func describeRequest(*awselbv2.DescribeTargetGroupsInput) awselbv2.DescribeTargetGroupsRequest {
+	return awselbv2.DescribeTargetGroupsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeTargetGroupsOutput{
+			TargetGroups: []awselbv2.TargetGroup{targetGroup()},
+		}},
+	}
+}
+
+func describeTagsRequest(*awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+	return awselbv2.DescribeTagsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeTagsOutput{
+			TagDescriptions: []awselbv2.TagDescription{{
+				ResourceArn: aws.String(tgARN),
+				Tags:        []awselbv2.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+			}},
+		}},
+	}
+}
+
+func observation() v1alpha1.TargetGroupObservation {
+	return v1alpha1.TargetGroupObservation{
+		TargetGroupARN:   tgARN,
+		LoadBalancerARNs: []string{lbARN},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	tg   elbv2.TargetGroupClient
+	kube client.Client
+	cr   *v1alpha1.TargetGroup
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TargetGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				tg: &fake.MockTargetGroupClient{},
+				cr: tg(),
+			},
+			want: want{
+				cr: tg(),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				tg: &fake.MockTargetGroupClient{
+					MockDescribeTargetGroups: describeRequest,
+					MockDescribeTags:         describeTagsRequest,
+				},
+				cr: tg(withSpec(specParams("/")), withExternalName(tgARN)),
+			},
+			want: want{
+				cr: tg(withSpec(specParams("/")), withExternalName(tgARN),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(observation())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"HealthCheckOutdated": {
+			args: args{
+				tg: &fake.MockTargetGroupClient{
+					MockDescribeTargetGroups: describeRequest,
+					MockDescribeTags:         describeTagsRequest,
+				},
+				cr: tg(withSpec(specParams("/healthz")), withExternalName(tgARN)),
+			},
+			want: want{
+				cr: tg(withSpec(specParams("/healthz")), withExternalName(tgARN),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(observation())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				tg: &fake.MockTargetGroupClient{
+					MockDescribeTargetGroups: describeRequest,
+					MockDescribeTags:         describeTagsRequest,
+				},
+				cr: tg(withSpec(v1alpha1.TargetGroupParameters{Name: tgName}), withExternalName(tgARN)),
+			},
+			want: want{
+				cr: tg(withSpec(specParams("/")), withExternalName(tgARN),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(observation())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				tg: &fake.MockTargetGroupClient{
+					MockDescribeTargetGroups: func(*awselbv2.DescribeTargetGroupsInput) awselbv2.DescribeTargetGroupsRequest {
+						return awselbv2.DescribeTargetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awselbv2.ErrCodeTargetGroupNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: tg(withSpec(specParams("/")), withExternalName(tgARN)),
+			},
+			want: want{
+				cr: tg(withSpec(specParams("/")), withExternalName(tgARN)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				tg: &fake.MockTargetGroupClient{
+					MockDescribeTargetGroups: func(*awselbv2.DescribeTargetGroupsInput) awselbv2.DescribeTargetGroupsRequest {
+						return awselbv2.DescribeTargetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tg(withSpec(specParams("/")), withExternalName(tgARN)),
+			},
+			want: want{
+				cr:  tg(withSpec(specParams("/")), withExternalName(tgARN)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.tg}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TargetGroup
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				tg: &fake.MockTargetGroupClient{
+					MockCreateTargetGroup: func(*awselbv2.CreateTargetGroupInput) awselbv2.CreateTargetGroupRequest {
+						return awselbv2.CreateTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateTargetGroupOutput{
+								TargetGroups: []awselbv2.TargetGroup{targetGroup()},
+							}},
+						}
+					},
+				},
+				cr: tg(withSpec(specParams("/"))),
+			},
+			want: want{
+				cr: tg(withSpec(specParams("/")), withExternalName(tgARN),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				tg: &fake.MockTargetGroupClient{
+					MockCreateTargetGroup: func(*awselbv2.CreateTargetGroupInput) awselbv2.CreateTargetGroupRequest {
+						return awselbv2.CreateTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tg(withSpec(specParams("/"))),
+			},
+			want: want{
+				cr:  tg(withSpec(specParams("/")), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.tg}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TargetGroup
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				tg: &fake.MockTargetGroupClient{
+					MockDescribeTargetGroups: describeRequest,
+					MockDescribeTags:         describeTagsRequest,
+					MockModifyTargetGroup: func(input *awselbv2.ModifyTargetGroupInput) awselbv2.ModifyTargetGroupRequest {
+						if diff := cmp.Diff("/healthz", aws.StringValue(input.HealthCheckPath)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.ModifyTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.ModifyTargetGroupOutput{}},
+						}
+					},
+				},
+				cr: tg(withSpec(specParams("/healthz")), withExternalName(tgARN)),
+			},
+			want: want{
+				cr: tg(withSpec(specParams("/healthz")), withExternalName(tgARN)),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				tg: &fake.MockTargetGroupClient{
+					MockDescribeTargetGroups: describeRequest,
+					MockDescribeTags:         describeTagsRequest,
+					MockModifyTargetGroup: func(*awselbv2.ModifyTargetGroupInput) awselbv2.ModifyTargetGroupRequest {
+						return awselbv2.ModifyTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tg(withSpec(specParams("/healthz")), withExternalName(tgARN)),
+			},
+			want: want{
+				cr:  tg(withSpec(specParams("/healthz")), withExternalName(tgARN)),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.tg}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.TargetGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				tg: &fake.MockTargetGroupClient{
+					MockDeleteTargetGroup: func(*awselbv2.DeleteTargetGroupInput) awselbv2.DeleteTargetGroupRequest {
+						return awselbv2.DeleteTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteTargetGroupOutput{}},
+						}
+					},
+				},
+				cr: tg(withExternalName(tgARN)),
+			},
+			want: want{
+				cr: tg(withExternalName(tgARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				tg: &fake.MockTargetGroupClient{
+					MockDeleteTargetGroup: func(*awselbv2.DeleteTargetGroupInput) awselbv2.DeleteTargetGroupRequest {
+						return awselbv2.DeleteTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tg(withExternalName(tgARN)),
+			},
+			want: want{
+				cr:  tg(withExternalName(tgARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.tg}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsemr "github.com/aws/aws-sdk-go-v2/service/emr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/emr/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/emr"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an EMR Cluster resource"
+
+	errDescribe               = "failed to describe the Cluster resource"
+	errListInstanceGroups     = "failed to list the instance groups of the Cluster resource"
+	errListInstanceFleets     = "failed to list the instance fleets of the Cluster resource"
+	errGetScalingPolicy       = "failed to get the managed scaling policy of the Cluster resource"
+	errCreate                 = "failed to create the Cluster resource"
+	errSetVisibility          = "failed to set the visibility of the Cluster resource"
+	errSetProtection          = "failed to set the termination protection of the Cluster resource"
+	errModify                 = "failed to modify the Cluster resource"
+	errAddTags                = "failed to add tags to the Cluster resource"
+	errRemoveTags             = "failed to remove tags from the Cluster resource"
+	errPutScalingPolicy       = "failed to put the managed scaling policy of the Cluster resource"
+	errRemoveScalingPolicy    = "failed to remove the managed scaling policy of the Cluster resource"
+	errModifyInstanceGroups   = "failed to resize the instance groups of the Cluster resource"
+	errModifyInstanceFleet    = "failed to resize an instance fleet of the Cluster resource"
+	errDelete                 = "failed to terminate the Cluster resource"
+	errSpecUpdate             = "cannot update spec of the Cluster custom resource"
+	errInstanceCollectionType = "cannot determine whether the Cluster resource uses instance groups or fleets"
+)
+
+// SetupCluster adds a controller that reconciles Clusters.
+func SetupCluster(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ClusterGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Cluster{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ClusterGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: emr.NewClusterClient}))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) emr.ClusterClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client emr.ClusterClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	res, err := e.client.DescribeClusterRequest(&awsemr.DescribeClusterInput{
+		ClusterId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(emr.IsNotFound, err), errDescribe)
+	}
+	observed := res.Cluster
+	cr.Status.AtProvider = emr.GenerateClusterObservation(*observed)
+
+	// Terminated clusters are still described for a while. A keep-alive
+	// cluster that terminated is started again, whereas a transient cluster
+	// is done once it terminated and is kept as is until the Cluster is
+	// deleted.
+	if observed.Status != nil && emr.IsTerminated(observed.Status.State) {
+		if meta.WasDeleted(cr) || emr.IsKeepAlive(cr.Spec.ForProvider) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	emr.LateInitializeCluster(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.ClusterStateRunning, v1alpha1.ClusterStateWaiting:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.ClusterStateTerminating:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	default:
+		// The cluster cannot be modified before it is running.
+		cr.SetConditions(runtimev1alpha1.Creating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	upToDate := emr.IsClusterUpToDate(cr.Spec.ForProvider, *observed)
+	if upToDate {
+		if upToDate, err = e.isScalingUpToDate(ctx, cr, observed.InstanceCollectionType); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+// isScalingUpToDate returns whether the managed scaling policy and the
+// capacities of the instance groups or fleets of the cluster are the desired
+// ones.
+func (e *external) isScalingUpToDate(ctx context.Context, cr *v1alpha1.Cluster, collection awsemr.InstanceCollectionType) (bool, error) {
+	id := aws.String(meta.GetExternalName(cr))
+	policy, err := e.client.GetManagedScalingPolicyRequest(&awsemr.GetManagedScalingPolicyInput{ClusterId: id}).Send(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, errGetScalingPolicy)
+	}
+	if !emr.IsManagedScalingPolicyUpToDate(cr.Spec.ForProvider.ManagedScalingPolicy, policy.ManagedScalingPolicy) {
+		return false, nil
+	}
+	// Managed scaling resizes the cluster on its own.
+	if cr.Spec.ForProvider.ManagedScalingPolicy != nil {
+		return true, nil
+	}
+	groups, fleets, err := e.modifications(ctx, cr, collection)
+	return len(groups) == 0 && len(fleets) == 0, err
+}
+
+// modifications returns the modifications that resize the instance groups or
+// fleets of the cluster to their desired capacities.
+func (e *external) modifications(ctx context.Context, cr *v1alpha1.Cluster, collection awsemr.InstanceCollectionType) ([]awsemr.InstanceGroupModifyConfig, []awsemr.InstanceFleetModifyConfig, error) {
+	id := aws.String(meta.GetExternalName(cr))
+	switch collection {
+	case awsemr.InstanceCollectionTypeInstanceGroup:
+		res, err := e.client.ListInstanceGroupsRequest(&awsemr.ListInstanceGroupsInput{ClusterId: id}).Send(ctx)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errListInstanceGroups)
+		}
+		return emr.GenerateInstanceGroupModifications(cr.Spec.ForProvider.Instances.InstanceGroups, res.InstanceGroups), nil, nil
+	case awsemr.InstanceCollectionTypeInstanceFleet:
+		res, err := e.client.ListInstanceFleetsRequest(&awsemr.ListInstanceFleetsInput{ClusterId: id}).Send(ctx)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errListInstanceFleets)
+		}
+		return nil, emr.GenerateInstanceFleetModifications(cr.Spec.ForProvider.Instances.InstanceFleets, res.InstanceFleets), nil
+	}
+	return nil, nil, errors.New(errInstanceCollectionType)
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	res, err := e.client.RunJobFlowRequest(emr.GenerateRunJobFlowInput(cr.GetName(), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(res.JobFlowId))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	id := aws.String(meta.GetExternalName(cr))
+	res, err := e.client.DescribeClusterRequest(&awsemr.DescribeClusterInput{ClusterId: id}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	observed := res.Cluster
+	p := cr.Spec.ForProvider
+
+	if aws.BoolValue(p.VisibleToAllUsers) != aws.BoolValue(observed.VisibleToAllUsers) {
+		if _, err := e.client.SetVisibleToAllUsersRequest(&awsemr.SetVisibleToAllUsersInput{
+			JobFlowIds:        []string{aws.StringValue(id)},
+			VisibleToAllUsers: aws.Bool(aws.BoolValue(p.VisibleToAllUsers)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetVisibility)
+		}
+	}
+	if aws.BoolValue(p.Instances.TerminationProtected) != aws.BoolValue(observed.TerminationProtected) {
+		if _, err := e.client.SetTerminationProtectionRequest(&awsemr.SetTerminationProtectionInput{
+			JobFlowIds:           []string{aws.StringValue(id)},
+			TerminationProtected: aws.Bool(aws.BoolValue(p.Instances.TerminationProtected)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetProtection)
+		}
+	}
+	if p.StepConcurrencyLevel != nil && aws.Int64Value(p.StepConcurrencyLevel) != aws.Int64Value(observed.StepConcurrencyLevel) {
+		if _, err := e.client.ModifyClusterRequest(&awsemr.ModifyClusterInput{
+			ClusterId:            id,
+			StepConcurrencyLevel: p.StepConcurrencyLevel,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+		}
+	}
+
+	add, remove := emr.DiffTags(p.Tags, observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.RemoveTagsRequest(&awsemr.RemoveTagsInput{ResourceId: id, TagKeys: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.AddTagsRequest(&awsemr.AddTagsInput{ResourceId: id, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	policy, err := e.client.GetManagedScalingPolicyRequest(&awsemr.GetManagedScalingPolicyInput{ClusterId: id}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetScalingPolicy)
+	}
+	if !emr.IsManagedScalingPolicyUpToDate(p.ManagedScalingPolicy, policy.ManagedScalingPolicy) {
+		if p.ManagedScalingPolicy == nil {
+			if _, err := e.client.RemoveManagedScalingPolicyRequest(&awsemr.RemoveManagedScalingPolicyInput{ClusterId: id}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveScalingPolicy)
+			}
+		} else {
+			if _, err := e.client.PutManagedScalingPolicyRequest(&awsemr.PutManagedScalingPolicyInput{
+				ClusterId:            id,
+				ManagedScalingPolicy: emr.GenerateManagedScalingPolicy(p.ManagedScalingPolicy),
+			}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errPutScalingPolicy)
+			}
+		}
+	}
+	if p.ManagedScalingPolicy != nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	groups, fleets, err := e.modifications(ctx, cr, observed.InstanceCollectionType)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if len(groups) > 0 {
+		if _, err := e.client.ModifyInstanceGroupsRequest(&awsemr.ModifyInstanceGroupsInput{ClusterId: id, InstanceGroups: groups}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyInstanceGroups)
+		}
+	}
+	for i := range fleets {
+		if _, err := e.client.ModifyInstanceFleetRequest(&awsemr.ModifyInstanceFleetInput{ClusterId: id, InstanceFleet: &fleets[i]}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyInstanceFleet)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Cluster)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.State == v1alpha1.ClusterStateTerminating {
+		return nil
+	}
+	_, err := e.client.TerminateJobFlowsRequest(&awsemr.TerminateJobFlowsInput{
+		JobFlowIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(emr.IsNotFound, err), errDelete)
+}
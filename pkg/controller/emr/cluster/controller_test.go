@@ -0,0 +1,539 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsemr "github.com/aws/aws-sdk-go-v2/service/emr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/emr/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/emr"
+	"github.com/crossplane/provider-aws/pkg/clients/emr/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	clusterID  = "j-1234"
+	clusterARN = "arn:aws:elasticmapreduce:us-east-1:123456789012:cluster/j-1234"
+	groupID    = "ig-1234"
+	deletedAt  = metav1.Now()
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	emr  emr.ClusterClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type clusterModifier func(*v1alpha1.Cluster)
+
+func withConditions(c ...runtimev1alpha1.Condition) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(name string) clusterModifier {
+	return func(r *v1alpha1.Cluster) { meta.SetExternalName(r, name) }
+}
+
+func withKeepAlive(b bool) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.Instances.KeepJobFlowAliveWhenNoSteps = aws.Bool(b) }
+}
+
+func withCoreCount(n int64) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.Instances.InstanceGroups[1].InstanceCount = n }
+}
+
+func withDeletionTimestamp() clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.SetDeletionTimestamp(&deletedAt) }
+}
+
+func withState(s string) clusterModifier {
+	return func(r *v1alpha1.Cluster) {
+		r.Status.AtProvider = v1alpha1.ClusterObservation{ClusterARN: clusterARN, State: s}
+	}
+}
+
+func cluster(m ...clusterModifier) *v1alpha1.Cluster {
+	cr := &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			ForProvider: v1alpha1.ClusterParameters{
+				ReleaseLabel: "emr-5.30.0",
+				Instances: v1alpha1.ClusterInstances{
+					InstanceGroups: []v1alpha1.InstanceGroup{
+						{InstanceRole: "MASTER", InstanceType: "m5.xlarge", InstanceCount: 1},
+						{InstanceRole: "CORE", InstanceType: "m5.xlarge", InstanceCount: 2},
+					},
+					TerminationProtected: aws.Bool(false),
+				},
+				ServiceRole:          aws.String("EMR_DefaultRole"),
+				StepConcurrencyLevel: aws.Int64(1),
+				VisibleToAllUsers:    aws.Bool(true),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedCluster(state awsemr.ClusterState) *awsemr.Cluster {
+	return &awsemr.Cluster{
+		Id:                     aws.String(clusterID),
+		ClusterArn:             aws.String(clusterARN),
+		ServiceRole:            aws.String("EMR_DefaultRole"),
+		StepConcurrencyLevel:   aws.Int64(1),
+		VisibleToAllUsers:      aws.Bool(true),
+		TerminationProtected:   aws.Bool(false),
+		InstanceCollectionType: awsemr.InstanceCollectionTypeInstanceGroup,
+		Status:                 &awsemr.ClusterStatus{State: state},
+	}
+}
+
+func describe(state awsemr.ClusterState) func(*awsemr.DescribeClusterInput) awsemr.DescribeClusterRequest {
+	return func(*awsemr.DescribeClusterInput) awsemr.DescribeClusterRequest {
+		return awsemr.DescribeClusterRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsemr.DescribeClusterOutput{Cluster: observedCluster(state)}},
+		}
+	}
+}
+
+func noScalingPolicy(*awsemr.GetManagedScalingPolicyInput) awsemr.GetManagedScalingPolicyRequest {
+	return awsemr.GetManagedScalingPolicyRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsemr.GetManagedScalingPolicyOutput{}},
+	}
+}
+
+func listGroups(core int64) func(*awsemr.ListInstanceGroupsInput) awsemr.ListInstanceGroupsRequest {
+	return func(*awsemr.ListInstanceGroupsInput) awsemr.ListInstanceGroupsRequest {
+		return awsemr.ListInstanceGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsemr.ListInstanceGroupsOutput{
+				InstanceGroups: []awsemr.InstanceGroup{
+					{Id: aws.String("ig-master"), InstanceGroupType: awsemr.InstanceGroupTypeMaster, RequestedInstanceCount: aws.Int64(1)},
+					{Id: aws.String(groupID), InstanceGroupType: awsemr.InstanceGroupTypeCore, RequestedInstanceCount: aws.Int64(core)},
+				},
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Waiting": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockDescribeCluster:         describe(awsemr.ClusterStateWaiting),
+					MockGetManagedScalingPolicy: noScalingPolicy,
+					MockListInstanceGroups:      listGroups(2),
+				},
+				cr: cluster(withExternalName(clusterID)),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID), withState("WAITING"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CoreGroupResized": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockDescribeCluster:         describe(awsemr.ClusterStateRunning),
+					MockGetManagedScalingPolicy: noScalingPolicy,
+					MockListInstanceGroups:      listGroups(2),
+				},
+				cr: cluster(withExternalName(clusterID), withCoreCount(4)),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID), withCoreCount(4), withState("RUNNING"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Starting": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockDescribeCluster: describe(awsemr.ClusterStateStarting),
+				},
+				cr: cluster(withExternalName(clusterID)),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID), withState("STARTING"),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TransientTerminated": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockDescribeCluster: describe(awsemr.ClusterStateTerminated),
+				},
+				cr: cluster(withExternalName(clusterID)),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID), withState("TERMINATED"),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"KeepAliveTerminated": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockDescribeCluster: describe(awsemr.ClusterStateTerminatedWithErrors),
+				},
+				cr: cluster(withExternalName(clusterID), withKeepAlive(true)),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID), withKeepAlive(true), withState("TERMINATED_WITH_ERRORS")),
+			},
+		},
+		"TerminatedWhileDeleting": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockDescribeCluster: describe(awsemr.ClusterStateTerminated),
+				},
+				cr: cluster(withExternalName(clusterID), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID), withDeletionTimestamp(), withState("TERMINATED")),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockDescribeCluster: func(*awsemr.DescribeClusterInput) awsemr.DescribeClusterRequest {
+						return awsemr.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsemr.ErrCodeInvalidRequestException, "Cluster id 'j-1234' is not valid.", nil)},
+						}
+					},
+				},
+				cr: cluster(withExternalName(clusterID)),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockDescribeCluster: func(*awsemr.DescribeClusterInput) awsemr.DescribeClusterRequest {
+						return awsemr.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: cluster(withExternalName(clusterID)),
+			},
+			want: want{
+				cr:  cluster(withExternalName(clusterID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.emr, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockRunJobFlow: func(input *awsemr.RunJobFlowInput) awsemr.RunJobFlowRequest {
+						if diff := cmp.Diff(int64(2), aws.Int64Value(input.Instances.InstanceGroups[1].InstanceCount)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsemr.RunJobFlowRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsemr.RunJobFlowOutput{
+								JobFlowId: aws.String(clusterID),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   cluster(),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockRunJobFlow: func(*awsemr.RunJobFlowInput) awsemr.RunJobFlowRequest {
+						return awsemr.RunJobFlowRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.emr, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ResizeCoreGroup": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockDescribeCluster:         describe(awsemr.ClusterStateRunning),
+					MockGetManagedScalingPolicy: noScalingPolicy,
+					MockListInstanceGroups:      listGroups(2),
+					MockModifyInstanceGroups: func(input *awsemr.ModifyInstanceGroupsInput) awsemr.ModifyInstanceGroupsRequest {
+						want := []awsemr.InstanceGroupModifyConfig{{InstanceGroupId: aws.String(groupID), InstanceCount: aws.Int64(4)}}
+						if diff := cmp.Diff(want, input.InstanceGroups); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsemr.ModifyInstanceGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsemr.ModifyInstanceGroupsOutput{}},
+						}
+					},
+				},
+				cr: cluster(withExternalName(clusterID), withCoreCount(4)),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID), withCoreCount(4)),
+			},
+		},
+		"EnableProtection": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockDescribeCluster: describe(awsemr.ClusterStateWaiting),
+					MockSetTerminationProtection: func(input *awsemr.SetTerminationProtectionInput) awsemr.SetTerminationProtectionRequest {
+						if diff := cmp.Diff(true, aws.BoolValue(input.TerminationProtected)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsemr.SetTerminationProtectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsemr.SetTerminationProtectionOutput{}},
+						}
+					},
+					MockGetManagedScalingPolicy: noScalingPolicy,
+					MockListInstanceGroups:      listGroups(2),
+				},
+				cr: cluster(withExternalName(clusterID), func(r *v1alpha1.Cluster) {
+					r.Spec.ForProvider.Instances.TerminationProtected = aws.Bool(true)
+				}),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID), func(r *v1alpha1.Cluster) {
+					r.Spec.ForProvider.Instances.TerminationProtected = aws.Bool(true)
+				}),
+			},
+		},
+		"ClientError": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockDescribeCluster: func(*awsemr.DescribeClusterInput) awsemr.DescribeClusterRequest {
+						return awsemr.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: cluster(withExternalName(clusterID)),
+			},
+			want: want{
+				cr:  cluster(withExternalName(clusterID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.emr, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockTerminateJobFlows: func(*awsemr.TerminateJobFlowsInput) awsemr.TerminateJobFlowsRequest {
+						return awsemr.TerminateJobFlowsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsemr.TerminateJobFlowsOutput{}},
+						}
+					},
+				},
+				cr: cluster(withExternalName(clusterID)),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyTerminating": {
+			args: args{
+				cr: cluster(withExternalName(clusterID), withState("TERMINATING")),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID), withState("TERMINATING"), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				emr: &fake.MockClusterClient{
+					MockTerminateJobFlows: func(*awsemr.TerminateJobFlowsInput) awsemr.TerminateJobFlowsRequest {
+						return awsemr.TerminateJobFlowsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: cluster(withExternalName(clusterID)),
+			},
+			want: want{
+				cr:  cluster(withExternalName(clusterID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.emr, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package availabilityzones
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/facts"
+	"github.com/crossplane/provider-aws/pkg/clients/facts/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	region = "us-east-1"
+
+	deletionTimestamp = metav1.Now()
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ec2 facts.AvailabilityZonesClient
+	cr  resource.Managed
+}
+
+type zonesModifier func(*v1alpha1.AvailabilityZones)
+
+func withConditions(c ...runtimev1alpha1.Condition) zonesModifier {
+	return func(r *v1alpha1.AvailabilityZones) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation() zonesModifier {
+	return func(r *v1alpha1.AvailabilityZones) {
+		r.Status.AtProvider = v1alpha1.AvailabilityZonesObservation{
+			Names: []string{"us-east-1a", "us-east-1b"},
+			IDs:   []string{"use1-az4", "use1-az6"},
+			Zones: []v1alpha1.AvailabilityZoneObservation{
+				{Name: "us-east-1a", ID: "use1-az4", State: "available", GroupName: region},
+				{Name: "us-east-1b", ID: "use1-az6", State: "available", GroupName: region},
+			},
+		}
+	}
+}
+
+func withDeletionTimestamp() zonesModifier {
+	return func(r *v1alpha1.AvailabilityZones) { r.SetDeletionTimestamp(&deletionTimestamp) }
+}
+
+func zones(m ...zonesModifier) *v1alpha1.AvailabilityZones {
+	cr := &v1alpha1.AvailabilityZones{
+		Spec: v1alpha1.AvailabilityZonesSpec{
+			ForProvider: v1alpha1.AvailabilityZonesParameters{Region: region},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				ec2: &fake.MockAvailabilityZonesClient{
+					MockDescribeAvailabilityZones: func(*ec2.DescribeAvailabilityZonesInput) ec2.DescribeAvailabilityZonesRequest {
+						return ec2.DescribeAvailabilityZonesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &ec2.DescribeAvailabilityZonesOutput{
+								AvailabilityZones: []ec2.AvailabilityZone{
+									{ZoneName: aws.String("us-east-1b"), ZoneId: aws.String("use1-az6"), State: ec2.AvailabilityZoneStateAvailable, GroupName: aws.String(region)},
+									{ZoneName: aws.String("us-east-1a"), ZoneId: aws.String("use1-az4"), State: ec2.AvailabilityZoneStateAvailable, GroupName: aws.String(region)},
+								},
+							}},
+						}
+					},
+				},
+				cr: zones(),
+			},
+			want: want{
+				cr: zones(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				cr: zones(withDeletionTimestamp()),
+			},
+			want: want{
+				cr: zones(withDeletionTimestamp()),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ec2: &fake.MockAvailabilityZonesClient{
+					MockDescribeAvailabilityZones: func(*ec2.DescribeAvailabilityZonesInput) ec2.DescribeAvailabilityZonesRequest {
+						return ec2.DescribeAvailabilityZonesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: zones(),
+			},
+			want: want{
+				cr:  zones(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ec2}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
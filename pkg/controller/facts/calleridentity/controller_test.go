@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calleridentity
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/facts"
+	"github.com/crossplane/provider-aws/pkg/clients/facts/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	accountID = "123456789012"
+	userARN   = "arn:aws:iam::123456789012:user/crossplane"
+	userID    = "AIDAEXAMPLE"
+
+	deletionTimestamp = metav1.Now()
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	sts facts.CallerIdentityClient
+	cr  resource.Managed
+}
+
+type identityModifier func(*v1alpha1.CallerIdentity)
+
+func withConditions(c ...runtimev1alpha1.Condition) identityModifier {
+	return func(r *v1alpha1.CallerIdentity) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation() identityModifier {
+	return func(r *v1alpha1.CallerIdentity) {
+		r.Status.AtProvider = v1alpha1.CallerIdentityObservation{
+			AccountID: accountID,
+			ARN:       userARN,
+			UserID:    userID,
+			Partition: "aws",
+		}
+	}
+}
+
+func withDeletionTimestamp() identityModifier {
+	return func(r *v1alpha1.CallerIdentity) { r.SetDeletionTimestamp(&deletionTimestamp) }
+}
+
+func identity(m ...identityModifier) *v1alpha1.CallerIdentity {
+	cr := &v1alpha1.CallerIdentity{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				sts: &fake.MockCallerIdentityClient{
+					MockGetCallerIdentity: func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+						return sts.GetCallerIdentityRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &sts.GetCallerIdentityOutput{
+								Account: aws.String(accountID),
+								Arn:     aws.String(userARN),
+								UserId:  aws.String(userID),
+							}},
+						}
+					},
+				},
+				cr: identity(),
+			},
+			want: want{
+				cr: identity(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				cr: identity(withDeletionTimestamp()),
+			},
+			want: want{
+				cr: identity(withDeletionTimestamp()),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sts: &fake.MockCallerIdentityClient{
+					MockGetCallerIdentity: func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+						return sts.GetCallerIdentityRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: identity(),
+			},
+			want: want{
+				cr:  identity(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sts}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package regioninfo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/facts"
+	"github.com/crossplane/provider-aws/pkg/clients/facts/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	region   = "ap-east-1"
+	endpoint = "ec2.ap-east-1.amazonaws.com"
+
+	deletionTimestamp = metav1.Now()
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ec2 facts.RegionInfoClient
+	cr  resource.Managed
+}
+
+type regionModifier func(*v1alpha1.RegionInfo)
+
+func withConditions(c ...runtimev1alpha1.Condition) regionModifier {
+	return func(r *v1alpha1.RegionInfo) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withOptInStatus(s string) regionModifier {
+	return func(r *v1alpha1.RegionInfo) {
+		r.Status.AtProvider = v1alpha1.RegionInfoObservation{
+			Partition:   "aws",
+			Endpoint:    endpoint,
+			OptInStatus: s,
+		}
+	}
+}
+
+func withDeletionTimestamp() regionModifier {
+	return func(r *v1alpha1.RegionInfo) { r.SetDeletionTimestamp(&deletionTimestamp) }
+}
+
+func regionInfo(m ...regionModifier) *v1alpha1.RegionInfo {
+	cr := &v1alpha1.RegionInfo{
+		Spec: v1alpha1.RegionInfoSpec{
+			ForProvider: v1alpha1.RegionInfoParameters{Region: region},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(optInStatus ...string) func(*ec2.DescribeRegionsInput) ec2.DescribeRegionsRequest {
+	return func(*ec2.DescribeRegionsInput) ec2.DescribeRegionsRequest {
+		out := &ec2.DescribeRegionsOutput{}
+		for _, s := range optInStatus {
+			out.Regions = append(out.Regions, ec2.Region{
+				RegionName:  aws.String(region),
+				Endpoint:    aws.String(endpoint),
+				OptInStatus: aws.String(s),
+			})
+		}
+		return ec2.DescribeRegionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				ec2: &fake.MockRegionInfoClient{MockDescribeRegions: describe("opted-in")},
+				cr:  regionInfo(),
+			},
+			want: want{
+				cr: regionInfo(withOptInStatus("opted-in"), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotOptedIn": {
+			args: args{
+				ec2: &fake.MockRegionInfoClient{MockDescribeRegions: describe("not-opted-in")},
+				cr:  regionInfo(),
+			},
+			want: want{
+				cr: regionInfo(withOptInStatus("not-opted-in"), withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				ec2: &fake.MockRegionInfoClient{MockDescribeRegions: describe()},
+				cr:  regionInfo(),
+			},
+			want: want{
+				cr:  regionInfo(),
+				err: errors.New(errNotFound),
+			},
+		},
+		"Deleted": {
+			args: args{
+				cr: regionInfo(withDeletionTimestamp()),
+			},
+			want: want{
+				cr: regionInfo(withDeletionTimestamp()),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ec2: &fake.MockRegionInfoClient{
+					MockDescribeRegions: func(*ec2.DescribeRegionsInput) ec2.DescribeRegionsRequest {
+						return ec2.DescribeRegionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: regionInfo(),
+			},
+			want: want{
+				cr:  regionInfo(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ec2}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcdefaults
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/facts"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a VPCDefaults resource"
+
+	errDescribeVPC            = "failed to describe the default VPC"
+	errDescribeSubnets        = "failed to describe the default subnets"
+	errDescribeSecurityGroups = "failed to describe the default security group"
+)
+
+// SetupVPCDefaults adds a controller that reconciles VPCDefaults.
+func SetupVPCDefaults(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.VPCDefaultsGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.VPCDefaults{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.VPCDefaultsGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(&connector{kube: mgr.GetClient(), newClientFn: facts.NewVPCDefaultsClient})),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) facts.VPCDefaultsClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.VPCDefaults)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client facts.VPCDefaultsClient
+}
+
+// Observe records the default VPC of the region, its default subnets and its
+// default security group. A region without a default VPC is observed, but not
+// available.
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.VPCDefaults)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	vpcs, err := e.client.DescribeVpcsRequest(facts.GenerateDescribeDefaultVPCInput()).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeVPC)
+	}
+	if len(vpcs.Vpcs) == 0 {
+		cr.Status.AtProvider = v1alpha1.VPCDefaultsObservation{}
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+	vpc := vpcs.Vpcs[0]
+
+	subnets, err := e.client.DescribeSubnetsRequest(facts.GenerateDescribeDefaultSubnetsInput(aws.StringValue(vpc.VpcId))).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeSubnets)
+	}
+	sgs, err := e.client.DescribeSecurityGroupsRequest(facts.GenerateDescribeDefaultSecurityGroupInput(aws.StringValue(vpc.VpcId))).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeSecurityGroups)
+	}
+
+	cr.Status.AtProvider = facts.GenerateVPCDefaultsObservation(vpc, subnets.Subnets, sgs.SecurityGroups)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// Create is a no-op, since a VPCDefaults is read-only.
+func (e *external) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+// Update is a no-op, since a VPCDefaults is read-only.
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete is a no-op, since a VPCDefaults is read-only.
+func (e *external) Delete(_ context.Context, _ resource.Managed) error {
+	return nil
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcdefaults
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/facts/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/facts"
+	"github.com/crossplane/provider-aws/pkg/clients/facts/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	region    = "us-east-1"
+	vpcID     = "vpc-0123456789abcdef0"
+	cidrBlock = "172.31.0.0/16"
+	sgID      = "sg-0123456789abcdef0"
+
+	deletionTimestamp = metav1.Now()
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ec2 facts.VPCDefaultsClient
+	cr  resource.Managed
+}
+
+type defaultsModifier func(*v1alpha1.VPCDefaults)
+
+func withConditions(c ...runtimev1alpha1.Condition) defaultsModifier {
+	return func(r *v1alpha1.VPCDefaults) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation() defaultsModifier {
+	return func(r *v1alpha1.VPCDefaults) {
+		r.Status.AtProvider = v1alpha1.VPCDefaultsObservation{
+			VPCID:           vpcID,
+			CIDRBlock:       cidrBlock,
+			SubnetIDs:       []string{"subnet-a", "subnet-b"},
+			SecurityGroupID: sgID,
+		}
+	}
+}
+
+func withDeletionTimestamp() defaultsModifier {
+	return func(r *v1alpha1.VPCDefaults) { r.SetDeletionTimestamp(&deletionTimestamp) }
+}
+
+func defaults(m ...defaultsModifier) *v1alpha1.VPCDefaults {
+	cr := &v1alpha1.VPCDefaults{
+		Spec: v1alpha1.VPCDefaultsSpec{
+			ForProvider: v1alpha1.VPCDefaultsParameters{Region: region},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeVpcs(vpcs ...ec2.Vpc) func(*ec2.DescribeVpcsInput) ec2.DescribeVpcsRequest {
+	return func(*ec2.DescribeVpcsInput) ec2.DescribeVpcsRequest {
+		return ec2.DescribeVpcsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &ec2.DescribeVpcsOutput{Vpcs: vpcs}},
+		}
+	}
+}
+
+func describeSubnets(*ec2.DescribeSubnetsInput) ec2.DescribeSubnetsRequest {
+	return ec2.DescribeSubnetsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &ec2.DescribeSubnetsOutput{
+			Subnets: []ec2.Subnet{
+				{SubnetId: aws.String("subnet-b"), AvailabilityZone: aws.String("us-east-1b")},
+				{SubnetId: aws.String("subnet-a"), AvailabilityZone: aws.String("us-east-1a")},
+			},
+		}},
+	}
+}
+
+func describeSecurityGroups(*ec2.DescribeSecurityGroupsInput) ec2.DescribeSecurityGroupsRequest {
+	return ec2.DescribeSecurityGroupsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []ec2.SecurityGroup{{GroupId: aws.String(sgID)}},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				ec2: &fake.MockVPCDefaultsClient{
+					MockDescribeVpcs:           describeVpcs(ec2.Vpc{VpcId: aws.String(vpcID), CidrBlock: aws.String(cidrBlock)}),
+					MockDescribeSubnets:        describeSubnets,
+					MockDescribeSecurityGroups: describeSecurityGroups,
+				},
+				cr: defaults(),
+			},
+			want: want{
+				cr: defaults(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NoDefaultVPC": {
+			args: args{
+				ec2: &fake.MockVPCDefaultsClient{
+					MockDescribeVpcs: describeVpcs(),
+				},
+				cr: defaults(withObservation()),
+			},
+			want: want{
+				cr: defaults(withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				cr: defaults(withDeletionTimestamp()),
+			},
+			want: want{
+				cr: defaults(withDeletionTimestamp()),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"DescribeVPCError": {
+			args: args{
+				ec2: &fake.MockVPCDefaultsClient{
+					MockDescribeVpcs: func(*ec2.DescribeVpcsInput) ec2.DescribeVpcsRequest {
+						return ec2.DescribeVpcsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: defaults(),
+			},
+			want: want{
+				cr:  defaults(),
+				err: errors.Wrap(errBoom, errDescribeVPC),
+			},
+		},
+		"DescribeSubnetsError": {
+			args: args{
+				ec2: &fake.MockVPCDefaultsClient{
+					MockDescribeVpcs: describeVpcs(ec2.Vpc{VpcId: aws.String(vpcID)}),
+					MockDescribeSubnets: func(*ec2.DescribeSubnetsInput) ec2.DescribeSubnetsRequest {
+						return ec2.DescribeSubnetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: defaults(),
+			},
+			want: want{
+				cr:  defaults(),
+				err: errors.Wrap(errBoom, errDescribeSubnets),
+			},
+		},
+		"DescribeSecurityGroupsError": {
+			args: args{
+				ec2: &fake.MockVPCDefaultsClient{
+					MockDescribeVpcs:    describeVpcs(ec2.Vpc{VpcId: aws.String(vpcID)}),
+					MockDescribeSubnets: describeSubnets,
+					MockDescribeSecurityGroups: func(*ec2.DescribeSecurityGroupsInput) ec2.DescribeSecurityGroupsRequest {
+						return ec2.DescribeSecurityGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: defaults(),
+			},
+			want: want{
+				cr:  defaults(),
+				err: errors.Wrap(errBoom, errDescribeSecurityGroups),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ec2}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
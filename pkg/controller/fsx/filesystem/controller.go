@@ -0,0 +1,220 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesystem
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsfsx "github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/fsx/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/fsx"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an FSx FileSystem resource"
+
+	errDescribe      = "failed to describe the FileSystem resource"
+	errNotSingleItem = "either no or multiple FileSystems retrieved for the given ID"
+	errCreate        = "failed to create the FileSystem resource"
+	errUpdate        = "failed to update the FileSystem resource"
+	errDelete        = "failed to delete the FileSystem resource"
+	errAddTags       = "failed to add tags to the FileSystem resource"
+	errRemoveTags    = "failed to remove tags from the FileSystem resource"
+	errSpecUpdate    = "cannot update spec of the FileSystem custom resource"
+)
+
+// SetupFileSystem adds a controller that reconciles FileSystems.
+func SetupFileSystem(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.FileSystemGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.FileSystem{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FileSystemGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: fsx.NewFileSystemClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) fsx.FileSystemClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.FileSystem)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client fsx.FileSystemClient
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.FileSystem) (*awsfsx.FileSystem, error) {
+	rsp, err := e.client.DescribeFileSystemsRequest(&awsfsx.DescribeFileSystemsInput{
+		FileSystemIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp.FileSystems) != 1 {
+		return nil, errors.New(errNotSingleItem)
+	}
+	return &rsp.FileSystems[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.FileSystem)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The ID of a file system is generated by FSx and set as the external
+	// name once the file system is created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(fsx.IsFileSystemNotFound, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	fsx.LateInitializeFileSystem(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = fsx.GenerateFileSystemObservation(*observed)
+	switch observed.Lifecycle {
+	case awsfsx.FileSystemLifecycleAvailable, awsfsx.FileSystemLifecycleUpdating:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsfsx.FileSystemLifecycleCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsfsx.FileSystemLifecycleDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  fsx.IsFileSystemUpToDate(cr.Spec.ForProvider, *observed),
+		ConnectionDetails: fsx.GetFileSystemConnectionDetails(*cr),
+	}, nil
+}
+
+// Create creates a file system and sets its ID as the external name of the
+// FileSystem. The UID of the FileSystem is the idempotency token of the
+// request, so that the same file system is returned if the external name
+// could not be saved.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.FileSystem)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.CreateFileSystemRequest(fsx.GenerateCreateFileSystemInput(string(cr.GetUID()), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.FileSystem.FileSystemId))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+// Update updates the tags and the maintenance and backup windows of the file
+// system. FSx rejects updates while a file system is not available.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.FileSystem)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Status.AtProvider.Lifecycle != string(awsfsx.FileSystemLifecycleAvailable) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	add, remove := fsx.DiffTags(cr.Spec.ForProvider.Tags, observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.UntagResourceRequest(&awsfsx.UntagResourceInput{ResourceARN: observed.ResourceARN, TagKeys: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.TagResourceRequest(&awsfsx.TagResourceInput{ResourceARN: observed.ResourceARN, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	// Tags are up to date at this point, so the remaining difference is in
+	// the configuration of the file system.
+	observed.Tags = fsx.GenerateTags(cr.Spec.ForProvider.Tags)
+	if fsx.IsFileSystemUpToDate(cr.Spec.ForProvider, *observed) {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.UpdateFileSystemRequest(fsx.GenerateUpdateFileSystemInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.FileSystem)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.Lifecycle == string(awsfsx.FileSystemLifecycleDeleting) {
+		return nil
+	}
+	_, err := e.client.DeleteFileSystemRequest(&awsfsx.DeleteFileSystemInput{
+		FileSystemId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(fsx.IsFileSystemNotFound, err), errDelete)
+}
@@ -0,0 +1,592 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesystem
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsfsx "github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/fsx/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/fsx"
+	"github.com/crossplane/provider-aws/pkg/clients/fsx/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	fileSystemID  = "fs-0123456789abcdef0"
+	fileSystemARN = "arn:aws:fsx:us-east-1:123456789012:file-system/fs-0123456789abcdef0"
+	dnsName       = "fs-0123456789abcdef0.fsx.us-east-1.amazonaws.com"
+	mountName     = "abcdefgh"
+	window        = "1:05:00"
+	uid           = types.UID("some-uid")
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	fsx  fsx.FileSystemClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type fileSystemModifier func(*v1alpha1.FileSystem)
+
+func withConditions(c ...runtimev1alpha1.Condition) fileSystemModifier {
+	return func(r *v1alpha1.FileSystem) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) fileSystemModifier {
+	return func(r *v1alpha1.FileSystem) { meta.SetExternalName(r, n) }
+}
+
+func withLifecycle(l awsfsx.FileSystemLifecycle) fileSystemModifier {
+	return func(r *v1alpha1.FileSystem) {
+		r.Status.AtProvider = v1alpha1.FileSystemObservation{
+			ARN:       fileSystemARN,
+			DNSName:   dnsName,
+			MountName: mountName,
+			Lifecycle: string(l),
+		}
+	}
+}
+
+func withWindow(w string) fileSystemModifier {
+	return func(r *v1alpha1.FileSystem) {
+		r.Spec.ForProvider.LustreConfiguration.WeeklyMaintenanceStartTime = aws.String(w)
+	}
+}
+
+func withoutLateInit() fileSystemModifier {
+	return func(r *v1alpha1.FileSystem) {
+		r.Spec.ForProvider.StorageType = nil
+		r.Spec.ForProvider.LustreConfiguration.DeploymentType = nil
+	}
+}
+
+func withTags(tags ...v1alpha1.Tag) fileSystemModifier {
+	return func(r *v1alpha1.FileSystem) { r.Spec.ForProvider.Tags = tags }
+}
+
+func fileSystem(m ...fileSystemModifier) *v1alpha1.FileSystem {
+	cr := &v1alpha1.FileSystem{
+		Spec: v1alpha1.FileSystemSpec{
+			ForProvider: v1alpha1.FileSystemParameters{
+				FileSystemType:  "LUSTRE",
+				StorageCapacity: 1200,
+				StorageType:     aws.String("SSD"),
+				SubnetIDs:       []string{"subnet-1"},
+				LustreConfiguration: &v1alpha1.LustreConfiguration{
+					DeploymentType:             aws.String("SCRATCH_2"),
+					WeeklyMaintenanceStartTime: aws.String(window),
+				},
+			},
+		},
+	}
+	cr.SetUID(uid)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(l awsfsx.FileSystemLifecycle, tags ...awsfsx.Tag) func(*awsfsx.DescribeFileSystemsInput) awsfsx.DescribeFileSystemsRequest {
+	return func(*awsfsx.DescribeFileSystemsInput) awsfsx.DescribeFileSystemsRequest {
+		return awsfsx.DescribeFileSystemsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsfsx.DescribeFileSystemsOutput{
+				FileSystems: []awsfsx.FileSystem{{
+					FileSystemId:    aws.String(fileSystemID),
+					FileSystemType:  awsfsx.FileSystemTypeLustre,
+					ResourceARN:     aws.String(fileSystemARN),
+					DNSName:         aws.String(dnsName),
+					Lifecycle:       l,
+					StorageCapacity: aws.Int64(1200),
+					StorageType:     awsfsx.StorageTypeSsd,
+					LustreConfiguration: &awsfsx.LustreFileSystemConfiguration{
+						DeploymentType:             awsfsx.LustreDeploymentTypeScratch2,
+						MountName:                  aws.String(mountName),
+						WeeklyMaintenanceStartTime: aws.String(window),
+					},
+					Tags: tags,
+				}},
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	details := managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(dnsName),
+		fsx.ConnectionDetailsMountNameKey:                    []byte(mountName),
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{MockDescribeFileSystems: describe(awsfsx.FileSystemLifecycleAvailable)},
+				cr:  fileSystem(withExternalName(fileSystemID)),
+			},
+			want: want{
+				cr: fileSystem(withExternalName(fileSystemID), withLifecycle(awsfsx.FileSystemLifecycleAvailable),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{MockDescribeFileSystems: describe(awsfsx.FileSystemLifecycleCreating)},
+				cr:  fileSystem(withExternalName(fileSystemID)),
+			},
+			want: want{
+				cr: fileSystem(withExternalName(fileSystemID), withLifecycle(awsfsx.FileSystemLifecycleCreating),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"Failed": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{MockDescribeFileSystems: describe(awsfsx.FileSystemLifecycleFailed)},
+				cr:  fileSystem(withExternalName(fileSystemID)),
+			},
+			want: want{
+				cr: fileSystem(withExternalName(fileSystemID), withLifecycle(awsfsx.FileSystemLifecycleFailed),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				fsx:  &fake.MockFileSystemClient{MockDescribeFileSystems: describe(awsfsx.FileSystemLifecycleAvailable)},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   fileSystem(withExternalName(fileSystemID), withoutLateInit()),
+			},
+			want: want{
+				cr: fileSystem(withExternalName(fileSystemID), withLifecycle(awsfsx.FileSystemLifecycleAvailable),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{MockDescribeFileSystems: describe(awsfsx.FileSystemLifecycleAvailable)},
+				cr:  fileSystem(withExternalName(fileSystemID), withWindow("7:23:30")),
+			},
+			want: want{
+				cr: fileSystem(withExternalName(fileSystemID), withWindow("7:23:30"), withLifecycle(awsfsx.FileSystemLifecycleAvailable),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"NotCreatedYet": {
+			args: args{
+				cr: fileSystem(),
+			},
+			want: want{
+				cr: fileSystem(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{
+					MockDescribeFileSystems: func(*awsfsx.DescribeFileSystemsInput) awsfsx.DescribeFileSystemsRequest {
+						return awsfsx.DescribeFileSystemsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsfsx.ErrCodeFileSystemNotFound, "", nil)},
+						}
+					},
+				},
+				cr: fileSystem(withExternalName(fileSystemID)),
+			},
+			want: want{
+				cr: fileSystem(withExternalName(fileSystemID)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{
+					MockDescribeFileSystems: func(*awsfsx.DescribeFileSystemsInput) awsfsx.DescribeFileSystemsRequest {
+						return awsfsx.DescribeFileSystemsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: fileSystem(withExternalName(fileSystemID)),
+			},
+			want: want{
+				cr:  fileSystem(withExternalName(fileSystemID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.fsx, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{
+					MockCreateFileSystem: func(input *awsfsx.CreateFileSystemInput) awsfsx.CreateFileSystemRequest {
+						if aws.StringValue(input.ClientRequestToken) != string(uid) {
+							return awsfsx.CreateFileSystemRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+							}
+						}
+						return awsfsx.CreateFileSystemRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsfsx.CreateFileSystemOutput{
+								FileSystem: &awsfsx.FileSystem{FileSystemId: aws.String(fileSystemID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   fileSystem(),
+			},
+			want: want{
+				cr: fileSystem(withExternalName(fileSystemID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{
+					MockCreateFileSystem: func(*awsfsx.CreateFileSystemInput) awsfsx.CreateFileSystemRequest {
+						return awsfsx.CreateFileSystemRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: fileSystem(),
+			},
+			want: want{
+				cr:  fileSystem(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.fsx, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	update := func(err error) func(*awsfsx.UpdateFileSystemInput) awsfsx.UpdateFileSystemRequest {
+		return func(*awsfsx.UpdateFileSystemInput) awsfsx.UpdateFileSystemRequest {
+			return awsfsx.UpdateFileSystemRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsfsx.UpdateFileSystemOutput{}},
+			}
+		}
+	}
+	tag := func(err error) func(*awsfsx.TagResourceInput) awsfsx.TagResourceRequest {
+		return func(*awsfsx.TagResourceInput) awsfsx.TagResourceRequest {
+			return awsfsx.TagResourceRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsfsx.TagResourceOutput{}},
+			}
+		}
+	}
+	untag := func(err error) func(*awsfsx.UntagResourceInput) awsfsx.UntagResourceRequest {
+		return func(*awsfsx.UntagResourceInput) awsfsx.UntagResourceRequest {
+			return awsfsx.UntagResourceRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsfsx.UntagResourceOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"MaintenanceWindow": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{
+					MockDescribeFileSystems: describe(awsfsx.FileSystemLifecycleAvailable),
+					MockUpdateFileSystem: func(input *awsfsx.UpdateFileSystemInput) awsfsx.UpdateFileSystemRequest {
+						if aws.StringValue(input.FileSystemId) != fileSystemID || aws.StringValue(input.LustreConfiguration.WeeklyMaintenanceStartTime) != "7:23:30" {
+							return update(errBoom)(input)
+						}
+						return update(nil)(input)
+					},
+				},
+				cr: fileSystem(withExternalName(fileSystemID), withWindow("7:23:30"), withLifecycle(awsfsx.FileSystemLifecycleAvailable)),
+			},
+			want: want{
+				cr: fileSystem(withExternalName(fileSystemID), withWindow("7:23:30"), withLifecycle(awsfsx.FileSystemLifecycleAvailable)),
+			},
+		},
+		"OnlyTags": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{
+					MockDescribeFileSystems: describe(awsfsx.FileSystemLifecycleAvailable, awsfsx.Tag{Key: aws.String("old"), Value: aws.String("v")}),
+					MockTagResource:         tag(nil),
+					MockUntagResource:       untag(nil),
+					MockUpdateFileSystem:    update(errBoom),
+				},
+				cr: fileSystem(withExternalName(fileSystemID), withTags(v1alpha1.Tag{Key: "new", Value: "v"}), withLifecycle(awsfsx.FileSystemLifecycleAvailable)),
+			},
+			want: want{
+				cr: fileSystem(withExternalName(fileSystemID), withTags(v1alpha1.Tag{Key: "new", Value: "v"}), withLifecycle(awsfsx.FileSystemLifecycleAvailable)),
+			},
+		},
+		"NotAvailable": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{},
+				cr:  fileSystem(withExternalName(fileSystemID), withWindow("7:23:30"), withLifecycle(awsfsx.FileSystemLifecycleCreating)),
+			},
+			want: want{
+				cr: fileSystem(withExternalName(fileSystemID), withWindow("7:23:30"), withLifecycle(awsfsx.FileSystemLifecycleCreating)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"TagError": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{
+					MockDescribeFileSystems: describe(awsfsx.FileSystemLifecycleAvailable),
+					MockTagResource:         tag(errBoom),
+				},
+				cr: fileSystem(withExternalName(fileSystemID), withTags(v1alpha1.Tag{Key: "new", Value: "v"}), withLifecycle(awsfsx.FileSystemLifecycleAvailable)),
+			},
+			want: want{
+				cr:  fileSystem(withExternalName(fileSystemID), withTags(v1alpha1.Tag{Key: "new", Value: "v"}), withLifecycle(awsfsx.FileSystemLifecycleAvailable)),
+				err: errors.Wrap(errBoom, errAddTags),
+			},
+		},
+		"ClientError": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{
+					MockDescribeFileSystems: describe(awsfsx.FileSystemLifecycleAvailable),
+					MockUpdateFileSystem:    update(errBoom),
+				},
+				cr: fileSystem(withExternalName(fileSystemID), withWindow("7:23:30"), withLifecycle(awsfsx.FileSystemLifecycleAvailable)),
+			},
+			want: want{
+				cr:  fileSystem(withExternalName(fileSystemID), withWindow("7:23:30"), withLifecycle(awsfsx.FileSystemLifecycleAvailable)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.fsx, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{
+					MockDeleteFileSystem: func(*awsfsx.DeleteFileSystemInput) awsfsx.DeleteFileSystemRequest {
+						return awsfsx.DeleteFileSystemRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsfsx.DeleteFileSystemOutput{}},
+						}
+					},
+				},
+				cr: fileSystem(withExternalName(fileSystemID), withLifecycle(awsfsx.FileSystemLifecycleAvailable)),
+			},
+			want: want{
+				cr: fileSystem(withExternalName(fileSystemID), withLifecycle(awsfsx.FileSystemLifecycleAvailable),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{},
+				cr:  fileSystem(withExternalName(fileSystemID), withLifecycle(awsfsx.FileSystemLifecycleDeleting)),
+			},
+			want: want{
+				cr: fileSystem(withExternalName(fileSystemID), withLifecycle(awsfsx.FileSystemLifecycleDeleting),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{
+					MockDeleteFileSystem: func(*awsfsx.DeleteFileSystemInput) awsfsx.DeleteFileSystemRequest {
+						return awsfsx.DeleteFileSystemRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsfsx.ErrCodeFileSystemNotFound, "", nil)},
+						}
+					},
+				},
+				cr: fileSystem(withExternalName(fileSystemID)),
+			},
+			want: want{
+				cr: fileSystem(withExternalName(fileSystemID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				fsx: &fake.MockFileSystemClient{
+					MockDeleteFileSystem: func(*awsfsx.DeleteFileSystemInput) awsfsx.DeleteFileSystemRequest {
+						return awsfsx.DeleteFileSystemRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: fileSystem(withExternalName(fileSystemID)),
+			},
+			want: want{
+				cr:  fileSystem(withExternalName(fileSystemID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.fsx, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
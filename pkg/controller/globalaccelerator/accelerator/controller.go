@@ -0,0 +1,234 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accelerator
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsga "github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	ga "github.com/crossplane/provider-aws/pkg/clients/globalaccelerator"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Global Accelerator Accelerator resource"
+
+	errDescribe   = "failed to describe the Accelerator resource"
+	errCreate     = "failed to create the Accelerator resource"
+	errUpdate     = "failed to update the Accelerator resource"
+	errDisable    = "failed to disable the Accelerator resource"
+	errDelete     = "failed to delete the Accelerator resource"
+	errListTags   = "failed to list the tags of the Accelerator resource"
+	errAddTags    = "failed to add tags to the Accelerator resource"
+	errRemoveTags = "failed to remove tags from the Accelerator resource"
+	errSpecUpdate = "cannot update spec of the Accelerator custom resource"
+)
+
+// SetupAccelerator adds a controller that reconciles Accelerators.
+func SetupAccelerator(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.AcceleratorGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Accelerator{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AcceleratorGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: ga.NewAcceleratorClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ga.AcceleratorClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.Accelerator); !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, ga.APIRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ga.AcceleratorClient
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.Accelerator) (*awsga.Accelerator, error) {
+	rsp, err := e.client.DescribeAcceleratorRequest(&awsga.DescribeAcceleratorInput{
+		AcceleratorArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Accelerator, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Accelerator)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The ARN of an accelerator is generated by Global Accelerator and set as
+	// the external name once the accelerator is created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ga.IsAcceleratorNotFound, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ga.LateInitializeAccelerator(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = ga.GenerateAcceleratorObservation(*observed)
+	switch {
+	case meta.WasDeleted(cr):
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	case cr.Status.AtProvider.Status == v1alpha1.AcceleratorStatusDeployed:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case cr.Status.AtProvider.Status == v1alpha1.AcceleratorStatusInProgress:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	tags, err := e.client.ListTagsForResourceRequest(&awsga.ListTagsForResourceInput{ResourceArn: observed.AcceleratorArn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := ga.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  len(add) == 0 && len(remove) == 0 && ga.IsAcceleratorUpToDate(cr.Spec.ForProvider, *observed),
+		ConnectionDetails: ga.GetAcceleratorConnectionDetails(*cr),
+	}, nil
+}
+
+// Create creates an accelerator and sets its ARN as the external name of the
+// Accelerator. The UID of the Accelerator is the idempotency token of the
+// request, so that the same accelerator is returned if the external name
+// could not be saved.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Accelerator)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.CreateAcceleratorRequest(ga.GenerateCreateAcceleratorInput(string(cr.GetUID()), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.Accelerator.AcceleratorArn))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+// Update updates the tags and the settings of the accelerator. The
+// accelerator can only be updated while no change is being deployed.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Accelerator)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Status.AtProvider.Status != v1alpha1.AcceleratorStatusDeployed {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	arn := aws.String(meta.GetExternalName(cr))
+	tags, err := e.client.ListTagsForResourceRequest(&awsga.ListTagsForResourceInput{ResourceArn: arn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := ga.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.UntagResourceRequest(&awsga.UntagResourceInput{ResourceArn: arn, TagKeys: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.TagResourceRequest(&awsga.TagResourceInput{ResourceArn: arn, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if ga.IsAcceleratorUpToDate(cr.Spec.ForProvider, *observed) {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.UpdateAcceleratorRequest(ga.GenerateUpdateAcceleratorInput(*arn, cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+// Delete disables the accelerator and deletes it once it is disabled, since
+// Global Accelerator refuses to delete accelerators that accept traffic.
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Accelerator)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ga.IsAcceleratorNotFound, err), errDescribe)
+	}
+	if aws.BoolValue(observed.Enabled) {
+		_, err := e.client.UpdateAcceleratorRequest(&awsga.UpdateAcceleratorInput{
+			AcceleratorArn: observed.AcceleratorArn,
+			Enabled:        aws.Bool(false),
+		}).Send(ctx)
+		return errors.Wrap(resource.Ignore(ga.IsAcceleratorNotFound, err), errDisable)
+	}
+	if observed.Status == awsga.AcceleratorStatusInProgress {
+		return nil
+	}
+	_, err = e.client.DeleteAcceleratorRequest(&awsga.DeleteAcceleratorInput{AcceleratorArn: observed.AcceleratorArn}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ga.IsAcceleratorNotFound, err), errDelete)
+}
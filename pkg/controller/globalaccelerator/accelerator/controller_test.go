@@ -0,0 +1,550 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accelerator
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsga "github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	ga "github.com/crossplane/provider-aws/pkg/clients/globalaccelerator"
+	"github.com/crossplane/provider-aws/pkg/clients/globalaccelerator/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	acceleratorName = "example"
+	acceleratorARN  = "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh"
+	dnsName         = "a1234567890abcdef.awsglobalaccelerator.com"
+	uid             = types.UID("some-uid")
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ga   ga.AcceleratorClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type acceleratorModifier func(*v1alpha1.Accelerator)
+
+func withConditions(c ...runtimev1alpha1.Condition) acceleratorModifier {
+	return func(r *v1alpha1.Accelerator) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) acceleratorModifier {
+	return func(r *v1alpha1.Accelerator) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s string) acceleratorModifier {
+	return func(r *v1alpha1.Accelerator) {
+		r.Status.AtProvider = v1alpha1.AcceleratorObservation{ARN: acceleratorARN, DNSName: dnsName, Status: s}
+	}
+}
+
+func withName(n string) acceleratorModifier {
+	return func(r *v1alpha1.Accelerator) { r.Spec.ForProvider.Name = n }
+}
+
+func withEnabled(e bool) acceleratorModifier {
+	return func(r *v1alpha1.Accelerator) { r.Spec.ForProvider.Enabled = aws.Bool(e) }
+}
+
+func withIPAddressType(t string) acceleratorModifier {
+	return func(r *v1alpha1.Accelerator) { r.Spec.ForProvider.IPAddressType = aws.String(t) }
+}
+
+func withTags(tags ...v1alpha1.Tag) acceleratorModifier {
+	return func(r *v1alpha1.Accelerator) { r.Spec.ForProvider.Tags = tags }
+}
+
+func accelerator(m ...acceleratorModifier) *v1alpha1.Accelerator {
+	cr := &v1alpha1.Accelerator{}
+	cr.SetUID(uid)
+	cr.Spec.ForProvider.Name = acceleratorName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(status awsga.AcceleratorStatus, enabled bool) func(*awsga.DescribeAcceleratorInput) awsga.DescribeAcceleratorRequest {
+	return func(*awsga.DescribeAcceleratorInput) awsga.DescribeAcceleratorRequest {
+		return awsga.DescribeAcceleratorRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.DescribeAcceleratorOutput{
+				Accelerator: &awsga.Accelerator{
+					AcceleratorArn: aws.String(acceleratorARN),
+					DnsName:        aws.String(dnsName),
+					Name:           aws.String(acceleratorName),
+					Enabled:        aws.Bool(enabled),
+					IpAddressType:  awsga.IpAddressTypeIpv4,
+					Status:         status,
+				},
+			}},
+		}
+	}
+}
+
+func notFound(*awsga.DescribeAcceleratorInput) awsga.DescribeAcceleratorRequest {
+	return awsga.DescribeAcceleratorRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+			Error: awserr.New(awsga.ErrCodeAcceleratorNotFoundException, "", nil)},
+	}
+}
+
+func noTags(*awsga.ListTagsForResourceInput) awsga.ListTagsForResourceRequest {
+	return awsga.ListTagsForResourceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.ListTagsForResourceOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	details := managed.ConnectionDetails{runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(dnsName)}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{},
+				cr: accelerator(),
+			},
+			want: want{
+				cr: accelerator(),
+			},
+		},
+		"Deployed": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockDescribeAccelerator: describe(awsga.AcceleratorStatusDeployed, true),
+					MockListTagsForResource: noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   accelerator(withExternalName(acceleratorARN)),
+			},
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withEnabled(true), withIPAddressType("IPV4"),
+					withStatus(v1alpha1.AcceleratorStatusDeployed), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"InProgress": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockDescribeAccelerator: describe(awsga.AcceleratorStatusInProgress, true),
+					MockListTagsForResource: noTags,
+				},
+				cr: accelerator(withExternalName(acceleratorARN), withEnabled(true), withIPAddressType("IPV4")),
+			},
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withEnabled(true), withIPAddressType("IPV4"),
+					withStatus(v1alpha1.AcceleratorStatusInProgress), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"NameChanged": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockDescribeAccelerator: describe(awsga.AcceleratorStatusDeployed, true),
+					MockListTagsForResource: noTags,
+				},
+				cr: accelerator(withExternalName(acceleratorARN), withName("other"), withEnabled(true), withIPAddressType("IPV4")),
+			},
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withName("other"), withEnabled(true), withIPAddressType("IPV4"),
+					withStatus(v1alpha1.AcceleratorStatusDeployed), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockDescribeAccelerator: describe(awsga.AcceleratorStatusDeployed, true),
+					MockListTagsForResource: noTags,
+				},
+				cr: accelerator(withExternalName(acceleratorARN), withEnabled(true), withIPAddressType("IPV4"), withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withEnabled(true), withIPAddressType("IPV4"), withTags(v1alpha1.Tag{Key: "k", Value: "v"}),
+					withStatus(v1alpha1.AcceleratorStatusDeployed), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{MockDescribeAccelerator: notFound},
+				cr: accelerator(withExternalName(acceleratorARN)),
+			},
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockDescribeAccelerator: func(*awsga.DescribeAcceleratorInput) awsga.DescribeAcceleratorRequest {
+						return awsga.DescribeAcceleratorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: accelerator(withExternalName(acceleratorARN)),
+			},
+			want: want{
+				cr:  accelerator(withExternalName(acceleratorARN)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ga, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockCreateAccelerator: func(input *awsga.CreateAcceleratorInput) awsga.CreateAcceleratorRequest {
+						if diff := cmp.Diff(string(uid), aws.StringValue(input.IdempotencyToken)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsga.CreateAcceleratorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.CreateAcceleratorOutput{
+								Accelerator: &awsga.Accelerator{AcceleratorArn: aws.String(acceleratorARN)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   accelerator(),
+			},
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockCreateAccelerator: func(*awsga.CreateAcceleratorInput) awsga.CreateAcceleratorRequest {
+						return awsga.CreateAcceleratorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.CreateAcceleratorOutput{
+								Accelerator: &awsga.Accelerator{AcceleratorArn: aws.String(acceleratorARN)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   accelerator(),
+			},
+			want: want{
+				cr:  accelerator(withExternalName(acceleratorARN), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockCreateAccelerator: func(*awsga.CreateAcceleratorInput) awsga.CreateAcceleratorRequest {
+						return awsga.CreateAcceleratorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: accelerator(),
+			},
+			want: want{
+				cr:  accelerator(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ga, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InProgress": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{},
+				cr: accelerator(withExternalName(acceleratorARN), withName("other"), withStatus(v1alpha1.AcceleratorStatusInProgress)),
+			},
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withName("other"), withStatus(v1alpha1.AcceleratorStatusInProgress)),
+			},
+		},
+		"ChangeName": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockListTagsForResource: noTags,
+					MockDescribeAccelerator: describe(awsga.AcceleratorStatusDeployed, true),
+					MockUpdateAccelerator: func(input *awsga.UpdateAcceleratorInput) awsga.UpdateAcceleratorRequest {
+						if diff := cmp.Diff("other", aws.StringValue(input.Name)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsga.UpdateAcceleratorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.UpdateAcceleratorOutput{}},
+						}
+					},
+				},
+				cr: accelerator(withExternalName(acceleratorARN), withName("other"), withStatus(v1alpha1.AcceleratorStatusDeployed)),
+			},
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withName("other"), withStatus(v1alpha1.AcceleratorStatusDeployed)),
+			},
+		},
+		"AddTags": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockListTagsForResource: noTags,
+					MockDescribeAccelerator: describe(awsga.AcceleratorStatusDeployed, true),
+					MockTagResource: func(input *awsga.TagResourceInput) awsga.TagResourceRequest {
+						if diff := cmp.Diff([]awsga.Tag{{Key: aws.String("k"), Value: aws.String("v")}}, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsga.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.TagResourceOutput{}},
+						}
+					},
+				},
+				cr: accelerator(withExternalName(acceleratorARN), withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withStatus(v1alpha1.AcceleratorStatusDeployed)),
+			},
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withStatus(v1alpha1.AcceleratorStatusDeployed)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockListTagsForResource: noTags,
+					MockDescribeAccelerator: describe(awsga.AcceleratorStatusDeployed, true),
+					MockUpdateAccelerator: func(*awsga.UpdateAcceleratorInput) awsga.UpdateAcceleratorRequest {
+						return awsga.UpdateAcceleratorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: accelerator(withExternalName(acceleratorARN), withName("other"), withStatus(v1alpha1.AcceleratorStatusDeployed)),
+			},
+			want: want{
+				cr:  accelerator(withExternalName(acceleratorARN), withName("other"), withStatus(v1alpha1.AcceleratorStatusDeployed)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ga, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Disable": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockDescribeAccelerator: describe(awsga.AcceleratorStatusDeployed, true),
+					MockUpdateAccelerator: func(input *awsga.UpdateAcceleratorInput) awsga.UpdateAcceleratorRequest {
+						if diff := cmp.Diff(false, aws.BoolValue(input.Enabled)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsga.UpdateAcceleratorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.UpdateAcceleratorOutput{}},
+						}
+					},
+				},
+				cr: accelerator(withExternalName(acceleratorARN)),
+			},
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"WaitForDisable": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockDescribeAccelerator: describe(awsga.AcceleratorStatusInProgress, false),
+				},
+				cr: accelerator(withExternalName(acceleratorARN)),
+			},
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Delete": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockDescribeAccelerator: describe(awsga.AcceleratorStatusDeployed, false),
+					MockDeleteAccelerator: func(*awsga.DeleteAcceleratorInput) awsga.DeleteAcceleratorRequest {
+						return awsga.DeleteAcceleratorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.DeleteAcceleratorOutput{}},
+						}
+					},
+				},
+				cr: accelerator(withExternalName(acceleratorARN)),
+			},
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{MockDescribeAccelerator: notFound},
+				cr: accelerator(withExternalName(acceleratorARN)),
+			},
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ga: &fake.MockAcceleratorClient{
+					MockDescribeAccelerator: describe(awsga.AcceleratorStatusDeployed, false),
+					MockDeleteAccelerator: func(*awsga.DeleteAcceleratorInput) awsga.DeleteAcceleratorRequest {
+						return awsga.DeleteAcceleratorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: accelerator(withExternalName(acceleratorARN)),
+			},
+			want: want{
+				cr:  accelerator(withExternalName(acceleratorARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ga, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,430 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package endpointgroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsga "github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	ga "github.com/crossplane/provider-aws/pkg/clients/globalaccelerator"
+	"github.com/crossplane/provider-aws/pkg/clients/globalaccelerator/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	listenerARN      = "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh/listener/0123vxyz"
+	endpointGroupARN = listenerARN + "/endpoint-group/098765zyxwvu"
+	lbARN            = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/example/50dc6c495c0c9188"
+	uid              = types.UID("some-uid")
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ga   ga.EndpointGroupClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type endpointGroupModifier func(*v1alpha1.EndpointGroup)
+
+func withConditions(c ...runtimev1alpha1.Condition) endpointGroupModifier {
+	return func(r *v1alpha1.EndpointGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) endpointGroupModifier {
+	return func(r *v1alpha1.EndpointGroup) { meta.SetExternalName(r, n) }
+}
+
+func withObservation() endpointGroupModifier {
+	return func(r *v1alpha1.EndpointGroup) {
+		r.Status.AtProvider = v1alpha1.EndpointGroupObservation{
+			ARN:       endpointGroupARN,
+			Endpoints: []v1alpha1.EndpointObservation{{EndpointID: lbARN, HealthState: "HEALTHY"}},
+		}
+	}
+}
+
+func withTrafficDial(p int64) endpointGroupModifier {
+	return func(r *v1alpha1.EndpointGroup) { r.Spec.ForProvider.TrafficDialPercentage = aws.Int64(p) }
+}
+
+func withHealthCheck() endpointGroupModifier {
+	return func(r *v1alpha1.EndpointGroup) {
+		r.Spec.ForProvider.HealthCheckProtocol = aws.String("TCP")
+		r.Spec.ForProvider.HealthCheckPort = aws.Int64(443)
+		r.Spec.ForProvider.HealthCheckIntervalSeconds = aws.Int64(30)
+		r.Spec.ForProvider.ThresholdCount = aws.Int64(3)
+	}
+}
+
+func endpointGroup(m ...endpointGroupModifier) *v1alpha1.EndpointGroup {
+	cr := &v1alpha1.EndpointGroup{}
+	cr.SetUID(uid)
+	cr.Spec.ForProvider = v1alpha1.EndpointGroupParameters{
+		ListenerARN:            aws.String(listenerARN),
+		EndpointGroupRegion:    "us-east-1",
+		EndpointConfigurations: []v1alpha1.EndpointConfiguration{{EndpointID: aws.String(lbARN)}},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(*awsga.DescribeEndpointGroupInput) awsga.DescribeEndpointGroupRequest {
+	return awsga.DescribeEndpointGroupRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.DescribeEndpointGroupOutput{
+			EndpointGroup: &awsga.EndpointGroup{
+				EndpointGroupArn:           aws.String(endpointGroupARN),
+				EndpointGroupRegion:        aws.String("us-east-1"),
+				TrafficDialPercentage:      aws.Float64(100),
+				HealthCheckProtocol:        awsga.HealthCheckProtocolTcp,
+				HealthCheckPort:            aws.Int64(443),
+				HealthCheckIntervalSeconds: aws.Int64(30),
+				ThresholdCount:             aws.Int64(3),
+				EndpointDescriptions: []awsga.EndpointDescription{{
+					EndpointId:  aws.String(lbARN),
+					Weight:      aws.Int64(128),
+					HealthState: awsga.HealthStateHealthy,
+				}},
+			},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				ga: &fake.MockEndpointGroupClient{},
+				cr: endpointGroup(),
+			},
+			want: want{
+				cr: endpointGroup(),
+			},
+		},
+		"Available": {
+			args: args{
+				ga:   &fake.MockEndpointGroupClient{MockDescribeEndpointGroup: describe},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   endpointGroup(withExternalName(endpointGroupARN)),
+			},
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN), withTrafficDial(100), withHealthCheck(), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TrafficDialChanged": {
+			args: args{
+				ga: &fake.MockEndpointGroupClient{MockDescribeEndpointGroup: describe},
+				cr: endpointGroup(withExternalName(endpointGroupARN), withTrafficDial(0), withHealthCheck()),
+			},
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN), withTrafficDial(0), withHealthCheck(), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				ga: &fake.MockEndpointGroupClient{
+					MockDescribeEndpointGroup: func(*awsga.DescribeEndpointGroupInput) awsga.DescribeEndpointGroupRequest {
+						return awsga.DescribeEndpointGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsga.ErrCodeEndpointGroupNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: endpointGroup(withExternalName(endpointGroupARN)),
+			},
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ga: &fake.MockEndpointGroupClient{
+					MockDescribeEndpointGroup: func(*awsga.DescribeEndpointGroupInput) awsga.DescribeEndpointGroupRequest {
+						return awsga.DescribeEndpointGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: endpointGroup(withExternalName(endpointGroupARN)),
+			},
+			want: want{
+				cr:  endpointGroup(withExternalName(endpointGroupARN)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ga, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ga: &fake.MockEndpointGroupClient{
+					MockCreateEndpointGroup: func(input *awsga.CreateEndpointGroupInput) awsga.CreateEndpointGroupRequest {
+						if diff := cmp.Diff(string(uid), aws.StringValue(input.IdempotencyToken)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(listenerARN, aws.StringValue(input.ListenerArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsga.CreateEndpointGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.CreateEndpointGroupOutput{
+								EndpointGroup: &awsga.EndpointGroup{EndpointGroupArn: aws.String(endpointGroupARN)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   endpointGroup(),
+			},
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ga: &fake.MockEndpointGroupClient{
+					MockCreateEndpointGroup: func(*awsga.CreateEndpointGroupInput) awsga.CreateEndpointGroupRequest {
+						return awsga.CreateEndpointGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: endpointGroup(),
+			},
+			want: want{
+				cr:  endpointGroup(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ga, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ga: &fake.MockEndpointGroupClient{
+					MockUpdateEndpointGroup: func(input *awsga.UpdateEndpointGroupInput) awsga.UpdateEndpointGroupRequest {
+						if diff := cmp.Diff(float64(0), aws.Float64Value(input.TrafficDialPercentage)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsga.UpdateEndpointGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.UpdateEndpointGroupOutput{}},
+						}
+					},
+				},
+				cr: endpointGroup(withExternalName(endpointGroupARN), withTrafficDial(0)),
+			},
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN), withTrafficDial(0)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ga: &fake.MockEndpointGroupClient{
+					MockUpdateEndpointGroup: func(*awsga.UpdateEndpointGroupInput) awsga.UpdateEndpointGroupRequest {
+						return awsga.UpdateEndpointGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: endpointGroup(withExternalName(endpointGroupARN)),
+			},
+			want: want{
+				cr:  endpointGroup(withExternalName(endpointGroupARN)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ga, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ga: &fake.MockEndpointGroupClient{
+					MockDeleteEndpointGroup: func(*awsga.DeleteEndpointGroupInput) awsga.DeleteEndpointGroupRequest {
+						return awsga.DeleteEndpointGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.DeleteEndpointGroupOutput{}},
+						}
+					},
+				},
+				cr: endpointGroup(withExternalName(endpointGroupARN)),
+			},
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				ga: &fake.MockEndpointGroupClient{
+					MockDeleteEndpointGroup: func(*awsga.DeleteEndpointGroupInput) awsga.DeleteEndpointGroupRequest {
+						return awsga.DeleteEndpointGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsga.ErrCodeEndpointGroupNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: endpointGroup(withExternalName(endpointGroupARN)),
+			},
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ga: &fake.MockEndpointGroupClient{
+					MockDeleteEndpointGroup: func(*awsga.DeleteEndpointGroupInput) awsga.DeleteEndpointGroupRequest {
+						return awsga.DeleteEndpointGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: endpointGroup(withExternalName(endpointGroupARN)),
+			},
+			want: want{
+				cr:  endpointGroup(withExternalName(endpointGroupARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ga, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
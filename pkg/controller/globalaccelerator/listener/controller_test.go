@@ -0,0 +1,411 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listener
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsga "github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	ga "github.com/crossplane/provider-aws/pkg/clients/globalaccelerator"
+	"github.com/crossplane/provider-aws/pkg/clients/globalaccelerator/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	acceleratorARN = "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh"
+	listenerARN    = acceleratorARN + "/listener/0123vxyz"
+	uid            = types.UID("some-uid")
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ga   ga.ListenerClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type listenerModifier func(*v1alpha1.Listener)
+
+func withConditions(c ...runtimev1alpha1.Condition) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) listenerModifier {
+	return func(r *v1alpha1.Listener) { meta.SetExternalName(r, n) }
+}
+
+func withARN() listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Status.AtProvider.ARN = listenerARN }
+}
+
+func withProtocol(p string) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Spec.ForProvider.Protocol = p }
+}
+
+func withClientAffinity(a string) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Spec.ForProvider.ClientAffinity = aws.String(a) }
+}
+
+func listener(m ...listenerModifier) *v1alpha1.Listener {
+	cr := &v1alpha1.Listener{}
+	cr.SetUID(uid)
+	cr.Spec.ForProvider = v1alpha1.ListenerParameters{
+		AcceleratorARN: aws.String(acceleratorARN),
+		Protocol:       "TCP",
+		PortRanges:     []v1alpha1.PortRange{{FromPort: 443, ToPort: 443}},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(*awsga.DescribeListenerInput) awsga.DescribeListenerRequest {
+	return awsga.DescribeListenerRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.DescribeListenerOutput{
+			Listener: &awsga.Listener{
+				ListenerArn:    aws.String(listenerARN),
+				Protocol:       awsga.ProtocolTcp,
+				ClientAffinity: awsga.AffinityNone,
+				PortRanges:     []awsga.PortRange{{FromPort: aws.Int64(443), ToPort: aws.Int64(443)}},
+			},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				ga: &fake.MockListenerClient{},
+				cr: listener(),
+			},
+			want: want{
+				cr: listener(),
+			},
+		},
+		"Available": {
+			args: args{
+				ga:   &fake.MockListenerClient{MockDescribeListener: describe},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withClientAffinity("NONE"), withARN(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ProtocolChanged": {
+			args: args{
+				ga: &fake.MockListenerClient{MockDescribeListener: describe},
+				cr: listener(withExternalName(listenerARN), withProtocol("UDP"), withClientAffinity("NONE")),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withProtocol("UDP"), withClientAffinity("NONE"), withARN(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				ga: &fake.MockListenerClient{
+					MockDescribeListener: func(*awsga.DescribeListenerInput) awsga.DescribeListenerRequest {
+						return awsga.DescribeListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsga.ErrCodeListenerNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ga: &fake.MockListenerClient{
+					MockDescribeListener: func(*awsga.DescribeListenerInput) awsga.DescribeListenerRequest {
+						return awsga.DescribeListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr:  listener(withExternalName(listenerARN)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ga, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ga: &fake.MockListenerClient{
+					MockCreateListener: func(input *awsga.CreateListenerInput) awsga.CreateListenerRequest {
+						if diff := cmp.Diff(string(uid), aws.StringValue(input.IdempotencyToken)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(acceleratorARN, aws.StringValue(input.AcceleratorArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsga.CreateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.CreateListenerOutput{
+								Listener: &awsga.Listener{ListenerArn: aws.String(listenerARN)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   listener(),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ga: &fake.MockListenerClient{
+					MockCreateListener: func(*awsga.CreateListenerInput) awsga.CreateListenerRequest {
+						return awsga.CreateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: listener(),
+			},
+			want: want{
+				cr:  listener(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ga, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ga: &fake.MockListenerClient{
+					MockUpdateListener: func(input *awsga.UpdateListenerInput) awsga.UpdateListenerRequest {
+						if diff := cmp.Diff(awsga.ProtocolUdp, input.Protocol); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsga.UpdateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.UpdateListenerOutput{}},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN), withProtocol("UDP")),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withProtocol("UDP")),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ga: &fake.MockListenerClient{
+					MockUpdateListener: func(*awsga.UpdateListenerInput) awsga.UpdateListenerRequest {
+						return awsga.UpdateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr:  listener(withExternalName(listenerARN)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ga, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ga: &fake.MockListenerClient{
+					MockDeleteListener: func(*awsga.DeleteListenerInput) awsga.DeleteListenerRequest {
+						return awsga.DeleteListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsga.DeleteListenerOutput{}},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				ga: &fake.MockListenerClient{
+					MockDeleteListener: func(*awsga.DeleteListenerInput) awsga.DeleteListenerRequest {
+						return awsga.DeleteListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsga.ErrCodeListenerNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ga: &fake.MockListenerClient{
+					MockDeleteListener: func(*awsga.DeleteListenerInput) awsga.DeleteListenerRequest {
+						return awsga.DeleteListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr:  listener(withExternalName(listenerARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ga, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
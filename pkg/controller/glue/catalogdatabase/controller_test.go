@@ -0,0 +1,378 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogdatabase
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsglue "github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/glue"
+	"github.com/crossplane/provider-aws/pkg/clients/glue/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	catalogDatabaseName = "events"
+	locationURI         = "s3://example-bucket/events/"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsglue.ErrCodeEntityNotFoundException, "CatalogDatabase not found", nil)
+)
+
+type args struct {
+	glue glue.CatalogDatabaseClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type catalogDatabaseModifier func(*v1alpha1.CatalogDatabase)
+
+func withConditions(c ...runtimev1alpha1.Condition) catalogDatabaseModifier {
+	return func(r *v1alpha1.CatalogDatabase) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withLocationURI(l string) catalogDatabaseModifier {
+	return func(r *v1alpha1.CatalogDatabase) { r.Spec.ForProvider.LocationURI = aws.String(l) }
+}
+
+func catalogDatabase(m ...catalogDatabaseModifier) *v1alpha1.CatalogDatabase {
+	cr := &v1alpha1.CatalogDatabase{
+		Spec: v1alpha1.CatalogDatabaseSpec{
+			ForProvider: v1alpha1.CatalogDatabaseParameters{
+				Description: aws.String("raw events"),
+			},
+		},
+	}
+	meta.SetExternalName(cr, catalogDatabaseName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getCatalogDatabase(*awsglue.GetDatabaseInput) awsglue.GetDatabaseRequest {
+	return awsglue.GetDatabaseRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.GetDatabaseOutput{
+			Database: &awsglue.Database{
+				Name:        aws.String(catalogDatabaseName),
+				Description: aws.String("raw events"),
+			},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				glue: &fake.MockCatalogDatabaseClient{MockGetDatabase: getCatalogDatabase},
+				cr:   catalogDatabase(),
+			},
+			want: want{
+				cr:     catalogDatabase(withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LocationChanged": {
+			args: args{
+				glue: &fake.MockCatalogDatabaseClient{MockGetDatabase: getCatalogDatabase},
+				cr:   catalogDatabase(withLocationURI(locationURI)),
+			},
+			want: want{
+				cr:     catalogDatabase(withLocationURI(locationURI), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				glue: &fake.MockCatalogDatabaseClient{MockGetDatabase: getCatalogDatabase},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr: catalogDatabase(func(r *v1alpha1.CatalogDatabase) {
+					r.Spec.ForProvider.Description = nil
+				}),
+			},
+			want: want{
+				cr:  catalogDatabase(),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"NotFound": {
+			args: args{
+				glue: &fake.MockCatalogDatabaseClient{
+					MockGetDatabase: func(*awsglue.GetDatabaseInput) awsglue.GetDatabaseRequest {
+						return awsglue.GetDatabaseRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: catalogDatabase(),
+			},
+			want: want{
+				cr: catalogDatabase(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				glue: &fake.MockCatalogDatabaseClient{
+					MockGetDatabase: func(*awsglue.GetDatabaseInput) awsglue.GetDatabaseRequest {
+						return awsglue.GetDatabaseRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: catalogDatabase(),
+			},
+			want: want{
+				cr:  catalogDatabase(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.glue}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				glue: &fake.MockCatalogDatabaseClient{
+					MockCreateDatabase: func(in *awsglue.CreateDatabaseInput) awsglue.CreateDatabaseRequest {
+						if diff := cmp.Diff(catalogDatabaseName, aws.StringValue(in.DatabaseInput.Name)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsglue.CreateDatabaseRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.CreateDatabaseOutput{}},
+						}
+					},
+				},
+				cr: catalogDatabase(),
+			},
+			want: want{
+				cr: catalogDatabase(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				glue: &fake.MockCatalogDatabaseClient{
+					MockCreateDatabase: func(*awsglue.CreateDatabaseInput) awsglue.CreateDatabaseRequest {
+						return awsglue.CreateDatabaseRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: catalogDatabase(),
+			},
+			want: want{
+				cr:  catalogDatabase(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.glue}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				glue: &fake.MockCatalogDatabaseClient{
+					MockUpdateDatabase: func(in *awsglue.UpdateDatabaseInput) awsglue.UpdateDatabaseRequest {
+						if diff := cmp.Diff(locationURI, aws.StringValue(in.DatabaseInput.LocationUri)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsglue.UpdateDatabaseRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.UpdateDatabaseOutput{}},
+						}
+					},
+				},
+				cr: catalogDatabase(withLocationURI(locationURI)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				glue: &fake.MockCatalogDatabaseClient{
+					MockUpdateDatabase: func(*awsglue.UpdateDatabaseInput) awsglue.UpdateDatabaseRequest {
+						return awsglue.UpdateDatabaseRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: catalogDatabase(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.glue}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				glue: &fake.MockCatalogDatabaseClient{
+					MockDeleteDatabase: func(*awsglue.DeleteDatabaseInput) awsglue.DeleteDatabaseRequest {
+						return awsglue.DeleteDatabaseRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.DeleteDatabaseOutput{}},
+						}
+					},
+				},
+				cr: catalogDatabase(),
+			},
+			want: want{
+				cr: catalogDatabase(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				glue: &fake.MockCatalogDatabaseClient{
+					MockDeleteDatabase: func(*awsglue.DeleteDatabaseInput) awsglue.DeleteDatabaseRequest {
+						return awsglue.DeleteDatabaseRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: catalogDatabase(),
+			},
+			want: want{
+				cr: catalogDatabase(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				glue: &fake.MockCatalogDatabaseClient{
+					MockDeleteDatabase: func(*awsglue.DeleteDatabaseInput) awsglue.DeleteDatabaseRequest {
+						return awsglue.DeleteDatabaseRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: catalogDatabase(),
+			},
+			want: want{
+				cr:  catalogDatabase(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.glue}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,394 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crawler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsglue "github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/glue"
+	"github.com/crossplane/provider-aws/pkg/clients/glue/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	crawlerName = "events"
+	roleARN     = "arn:aws:iam::123456789012:role/glue-crawler"
+	schedule    = "cron(15 12 * * ? *)"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsglue.ErrCodeEntityNotFoundException, "Crawler not found", nil)
+)
+
+type args struct {
+	glue glue.CrawlerClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type crawlerModifier func(*v1alpha1.Crawler)
+
+func withConditions(c ...runtimev1alpha1.Condition) crawlerModifier {
+	return func(r *v1alpha1.Crawler) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withState(s string) crawlerModifier {
+	return func(r *v1alpha1.Crawler) { r.Status.AtProvider.State = s }
+}
+
+func withSchedule(s string) crawlerModifier {
+	return func(r *v1alpha1.Crawler) { r.Spec.ForProvider.Schedule = aws.String(s) }
+}
+
+func crawler(m ...crawlerModifier) *v1alpha1.Crawler {
+	cr := &v1alpha1.Crawler{
+		Spec: v1alpha1.CrawlerSpec{
+			ForProvider: v1alpha1.CrawlerParameters{
+				RoleARN:      aws.String(roleARN),
+				DatabaseName: aws.String("events"),
+				Targets: v1alpha1.CrawlerTargets{S3Targets: []v1alpha1.S3Target{
+					{Path: "s3://example-bucket/events/"},
+				}},
+				Description: aws.String("raw events"),
+			},
+		},
+	}
+	meta.SetExternalName(cr, crawlerName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getCrawler(*awsglue.GetCrawlerInput) awsglue.GetCrawlerRequest {
+	return awsglue.GetCrawlerRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.GetCrawlerOutput{
+			Crawler: &awsglue.Crawler{
+				Name:         aws.String(crawlerName),
+				Role:         aws.String("glue-crawler"),
+				DatabaseName: aws.String("events"),
+				Targets: &awsglue.CrawlerTargets{S3Targets: []awsglue.S3Target{
+					{Path: aws.String("s3://example-bucket/events/")},
+				}},
+				Description: aws.String("raw events"),
+				State:       awsglue.CrawlerStateReady,
+			},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				glue: &fake.MockCrawlerClient{MockGetCrawler: getCrawler},
+				cr:   crawler(),
+			},
+			want: want{
+				cr:     crawler(withState("READY"), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ScheduleChanged": {
+			args: args{
+				glue: &fake.MockCrawlerClient{MockGetCrawler: getCrawler},
+				cr:   crawler(withSchedule(schedule)),
+			},
+			want: want{
+				cr:     crawler(withSchedule(schedule), withState("READY"), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				glue: &fake.MockCrawlerClient{MockGetCrawler: getCrawler},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr: crawler(func(r *v1alpha1.Crawler) {
+					r.Spec.ForProvider.Description = nil
+				}),
+			},
+			want: want{
+				cr:  crawler(),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"NotFound": {
+			args: args{
+				glue: &fake.MockCrawlerClient{
+					MockGetCrawler: func(*awsglue.GetCrawlerInput) awsglue.GetCrawlerRequest {
+						return awsglue.GetCrawlerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: crawler(),
+			},
+			want: want{
+				cr: crawler(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				glue: &fake.MockCrawlerClient{
+					MockGetCrawler: func(*awsglue.GetCrawlerInput) awsglue.GetCrawlerRequest {
+						return awsglue.GetCrawlerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: crawler(),
+			},
+			want: want{
+				cr:  crawler(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.glue}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				glue: &fake.MockCrawlerClient{
+					MockCreateCrawler: func(in *awsglue.CreateCrawlerInput) awsglue.CreateCrawlerRequest {
+						if diff := cmp.Diff(crawlerName, aws.StringValue(in.Name)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsglue.CreateCrawlerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.CreateCrawlerOutput{}},
+						}
+					},
+				},
+				cr: crawler(),
+			},
+			want: want{
+				cr: crawler(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				glue: &fake.MockCrawlerClient{
+					MockCreateCrawler: func(*awsglue.CreateCrawlerInput) awsglue.CreateCrawlerRequest {
+						return awsglue.CreateCrawlerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: crawler(),
+			},
+			want: want{
+				cr:  crawler(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.glue}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				glue: &fake.MockCrawlerClient{
+					MockUpdateCrawler: func(in *awsglue.UpdateCrawlerInput) awsglue.UpdateCrawlerRequest {
+						if diff := cmp.Diff(schedule, aws.StringValue(in.Schedule)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsglue.UpdateCrawlerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.UpdateCrawlerOutput{}},
+						}
+					},
+				},
+				cr: crawler(withSchedule(schedule)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				glue: &fake.MockCrawlerClient{
+					MockUpdateCrawler: func(*awsglue.UpdateCrawlerInput) awsglue.UpdateCrawlerRequest {
+						return awsglue.UpdateCrawlerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: crawler(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.glue}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				glue: &fake.MockCrawlerClient{
+					MockDeleteCrawler: func(*awsglue.DeleteCrawlerInput) awsglue.DeleteCrawlerRequest {
+						return awsglue.DeleteCrawlerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.DeleteCrawlerOutput{}},
+						}
+					},
+				},
+				cr: crawler(),
+			},
+			want: want{
+				cr: crawler(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				glue: &fake.MockCrawlerClient{
+					MockDeleteCrawler: func(*awsglue.DeleteCrawlerInput) awsglue.DeleteCrawlerRequest {
+						return awsglue.DeleteCrawlerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: crawler(),
+			},
+			want: want{
+				cr: crawler(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				glue: &fake.MockCrawlerClient{
+					MockDeleteCrawler: func(*awsglue.DeleteCrawlerInput) awsglue.DeleteCrawlerRequest {
+						return awsglue.DeleteCrawlerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: crawler(),
+			},
+			want: want{
+				cr:  crawler(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.glue}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
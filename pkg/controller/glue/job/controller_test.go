@@ -0,0 +1,391 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsglue "github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/glue"
+	"github.com/crossplane/provider-aws/pkg/clients/glue/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	jobName = "events-etl"
+	roleARN = "arn:aws:iam::123456789012:role/glue-job"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsglue.ErrCodeEntityNotFoundException, "Job not found", nil)
+)
+
+type args struct {
+	glue glue.JobClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type jobModifier func(*v1alpha1.Job)
+
+func withConditions(c ...runtimev1alpha1.Condition) jobModifier {
+	return func(r *v1alpha1.Job) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withNumberOfWorkers(n int64) jobModifier {
+	return func(r *v1alpha1.Job) { r.Spec.ForProvider.NumberOfWorkers = aws.Int64(n) }
+}
+
+func job(m ...jobModifier) *v1alpha1.Job {
+	cr := &v1alpha1.Job{
+		Spec: v1alpha1.JobSpec{
+			ForProvider: v1alpha1.JobParameters{
+				RoleARN: aws.String(roleARN),
+				Command: v1alpha1.JobCommand{
+					Name:         "glueetl",
+					ScriptBucket: aws.String("example-bucket"),
+					ScriptKey:    aws.String("scripts/etl.py"),
+				},
+				WorkerType:      aws.String("G.1X"),
+				NumberOfWorkers: aws.Int64(2),
+			},
+		},
+	}
+	meta.SetExternalName(cr, jobName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getJob(*awsglue.GetJobInput) awsglue.GetJobRequest {
+	return awsglue.GetJobRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.GetJobOutput{
+			Job: &awsglue.Job{
+				Name: aws.String(jobName),
+				Role: aws.String(roleARN),
+				Command: &awsglue.JobCommand{
+					Name:           aws.String("glueetl"),
+					ScriptLocation: aws.String("s3://example-bucket/scripts/etl.py"),
+				},
+				WorkerType:      awsglue.WorkerTypeG1x,
+				NumberOfWorkers: aws.Int64(2),
+			},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				glue: &fake.MockJobClient{MockGetJob: getJob},
+				cr:   job(),
+			},
+			want: want{
+				cr:     job(withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"WorkersChanged": {
+			args: args{
+				glue: &fake.MockJobClient{MockGetJob: getJob},
+				cr:   job(withNumberOfWorkers(10)),
+			},
+			want: want{
+				cr:     job(withNumberOfWorkers(10), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				glue: &fake.MockJobClient{MockGetJob: getJob},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr: job(func(r *v1alpha1.Job) {
+					r.Spec.ForProvider.NumberOfWorkers = nil
+				}),
+			},
+			want: want{
+				cr:  job(),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"NotFound": {
+			args: args{
+				glue: &fake.MockJobClient{
+					MockGetJob: func(*awsglue.GetJobInput) awsglue.GetJobRequest {
+						return awsglue.GetJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				cr: job(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				glue: &fake.MockJobClient{
+					MockGetJob: func(*awsglue.GetJobInput) awsglue.GetJobRequest {
+						return awsglue.GetJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				cr:  job(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.glue}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				glue: &fake.MockJobClient{
+					MockCreateJob: func(in *awsglue.CreateJobInput) awsglue.CreateJobRequest {
+						if diff := cmp.Diff(jobName, aws.StringValue(in.Name)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsglue.CreateJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.CreateJobOutput{}},
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				cr: job(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				glue: &fake.MockJobClient{
+					MockCreateJob: func(*awsglue.CreateJobInput) awsglue.CreateJobRequest {
+						return awsglue.CreateJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				cr:  job(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.glue}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				glue: &fake.MockJobClient{
+					MockUpdateJob: func(in *awsglue.UpdateJobInput) awsglue.UpdateJobRequest {
+						if diff := cmp.Diff(int64(10), aws.Int64Value(in.JobUpdate.NumberOfWorkers)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsglue.UpdateJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.UpdateJobOutput{}},
+						}
+					},
+				},
+				cr: job(withNumberOfWorkers(10)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				glue: &fake.MockJobClient{
+					MockUpdateJob: func(*awsglue.UpdateJobInput) awsglue.UpdateJobRequest {
+						return awsglue.UpdateJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.glue}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				glue: &fake.MockJobClient{
+					MockDeleteJob: func(*awsglue.DeleteJobInput) awsglue.DeleteJobRequest {
+						return awsglue.DeleteJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.DeleteJobOutput{}},
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				cr: job(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				glue: &fake.MockJobClient{
+					MockDeleteJob: func(*awsglue.DeleteJobInput) awsglue.DeleteJobRequest {
+						return awsglue.DeleteJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				cr: job(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				glue: &fake.MockJobClient{
+					MockDeleteJob: func(*awsglue.DeleteJobInput) awsglue.DeleteJobRequest {
+						return awsglue.DeleteJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				cr:  job(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.glue}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
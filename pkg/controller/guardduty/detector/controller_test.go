@@ -0,0 +1,399 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detector
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsguardduty "github.com/aws/aws-sdk-go-v2/service/guardduty"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/guardduty"
+	"github.com/crossplane/provider-aws/pkg/clients/guardduty/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	detectorID = "some-id"
+	frequency  = "SIX_HOURS"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	guardduty guardduty.DetectorClient
+	kube      *test.MockClient
+	cr        resource.Managed
+}
+
+type detectorModifier func(*v1alpha1.Detector)
+
+func withConditions(c ...runtimev1alpha1.Condition) detectorModifier {
+	return func(r *v1alpha1.Detector) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(name string) detectorModifier {
+	return func(r *v1alpha1.Detector) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(enable bool, frequency *string) detectorModifier {
+	return func(r *v1alpha1.Detector) {
+		r.Spec.ForProvider.Enable = enable
+		r.Spec.ForProvider.FindingPublishingFrequency = frequency
+	}
+}
+
+func withStatus(status string) detectorModifier {
+	return func(r *v1alpha1.Detector) { r.Status.AtProvider.Status = status }
+}
+
+func detector(m ...detectorModifier) *v1alpha1.Detector {
+	cr := &v1alpha1.Detector{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				guardduty: &fake.MockDetectorClient{
+					MockGet: func(*awsguardduty.GetDetectorInput) awsguardduty.GetDetectorRequest {
+						return awsguardduty.GetDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsguardduty.GetDetectorOutput{
+								Status:                     awsguardduty.DetectorStatusEnabled,
+								FindingPublishingFrequency: awsguardduty.FindingPublishingFrequencySixHours,
+							}},
+						}
+					},
+				},
+				cr: detector(withExternalName(detectorID), withSpec(true, &frequency)),
+			},
+			want: want{
+				cr: detector(withExternalName(detectorID), withSpec(true, &frequency),
+					withStatus("ENABLED"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitAndNotUpToDate": {
+			args: args{
+				guardduty: &fake.MockDetectorClient{
+					MockGet: func(*awsguardduty.GetDetectorInput) awsguardduty.GetDetectorRequest {
+						return awsguardduty.GetDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsguardduty.GetDetectorOutput{
+								Status:                     awsguardduty.DetectorStatusDisabled,
+								FindingPublishingFrequency: awsguardduty.FindingPublishingFrequencySixHours,
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   detector(withExternalName(detectorID), withSpec(true, nil)),
+			},
+			want: want{
+				cr: detector(withExternalName(detectorID), withSpec(true, &frequency),
+					withStatus("DISABLED"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: detector(),
+			},
+			want: want{
+				cr: detector(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				guardduty: &fake.MockDetectorClient{
+					MockGet: func(*awsguardduty.GetDetectorInput) awsguardduty.GetDetectorRequest {
+						return awsguardduty.GetDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: detector(withExternalName(detectorID)),
+			},
+			want: want{
+				cr:  detector(withExternalName(detectorID)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.guardduty, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				guardduty: &fake.MockDetectorClient{
+					MockCreate: func(*awsguardduty.CreateDetectorInput) awsguardduty.CreateDetectorRequest {
+						return awsguardduty.CreateDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsguardduty.CreateDetectorOutput{
+								DetectorId: aws.String(detectorID),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   detector(),
+			},
+			want: want{
+				cr: detector(withExternalName(detectorID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				guardduty: &fake.MockDetectorClient{
+					MockCreate: func(*awsguardduty.CreateDetectorInput) awsguardduty.CreateDetectorRequest {
+						return awsguardduty.CreateDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: detector(),
+			},
+			want: want{
+				cr:  detector(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.guardduty, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				guardduty: &fake.MockDetectorClient{
+					MockUpdate: func(*awsguardduty.UpdateDetectorInput) awsguardduty.UpdateDetectorRequest {
+						return awsguardduty.UpdateDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsguardduty.UpdateDetectorOutput{}},
+						}
+					},
+				},
+				cr: detector(withExternalName(detectorID)),
+			},
+			want: want{
+				cr: detector(withExternalName(detectorID)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				guardduty: &fake.MockDetectorClient{
+					MockUpdate: func(*awsguardduty.UpdateDetectorInput) awsguardduty.UpdateDetectorRequest {
+						return awsguardduty.UpdateDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: detector(withExternalName(detectorID)),
+			},
+			want: want{
+				cr:  detector(withExternalName(detectorID)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.guardduty, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				guardduty: &fake.MockDetectorClient{
+					MockDelete: func(*awsguardduty.DeleteDetectorInput) awsguardduty.DeleteDetectorRequest {
+						return awsguardduty.DeleteDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsguardduty.DeleteDetectorOutput{}},
+						}
+					},
+				},
+				cr: detector(withExternalName(detectorID)),
+			},
+			want: want{
+				cr: detector(withExternalName(detectorID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				guardduty: &fake.MockDetectorClient{
+					MockDelete: func(*awsguardduty.DeleteDetectorInput) awsguardduty.DeleteDetectorRequest {
+						return awsguardduty.DeleteDetectorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: detector(withExternalName(detectorID)),
+			},
+			want: want{
+				cr:  detector(withExternalName(detectorID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.guardduty, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
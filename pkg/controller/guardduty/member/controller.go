@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsguardduty "github.com/aws/aws-sdk-go-v2/service/guardduty"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/guardduty"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Member resource"
+
+	errGet          = "failed to get the Member resource"
+	errCreate       = "failed to create the Member resource"
+	errInvite       = "failed to invite the member account"
+	errDisassociate = "failed to disassociate the member account"
+	errDelete       = "failed to delete the Member resource"
+)
+
+// SetupMember adds a controller that reconciles Members.
+func SetupMember(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.MemberGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Member{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.MemberGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(&connector{kube: mgr.GetClient(), newClientFn: guardduty.NewMemberClient}, v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) guardduty.MemberClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Member)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client guardduty.MemberClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Member)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	res, err := e.client.GetMembersRequest(&awsguardduty.GetMembersInput{
+		DetectorId: cr.Spec.ForProvider.DetectorID,
+		AccountIds: []string{cr.Spec.ForProvider.AccountID},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(guardduty.IsNotFound, err), errGet)
+	}
+
+	// accounts that are not members of the detector are reported as
+	// unprocessed instead.
+	if len(res.Members) != 1 {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed := res.Members[0]
+	cr.SetConditions(runtimev1alpha1.Available())
+	cr.Status.AtProvider = guardduty.GenerateMemberObservation(observed)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: guardduty.IsMemberUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Member)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateMembersRequest(&awsguardduty.CreateMembersInput{
+		DetectorId: cr.Spec.ForProvider.DetectorID,
+		AccountDetails: []awsguardduty.AccountDetail{{
+			AccountId: aws.String(cr.Spec.ForProvider.AccountID),
+			Email:     aws.String(cr.Spec.ForProvider.Email),
+		}},
+	}).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Member)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// The only update that can be made on a member is to invite it.
+	if !aws.BoolValue(cr.Spec.ForProvider.Invite) {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err := e.client.InviteMembersRequest(guardduty.GenerateInviteMembersInput(cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errInvite)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Member)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DisassociateMembersRequest(&awsguardduty.DisassociateMembersInput{
+		DetectorId: cr.Spec.ForProvider.DetectorID,
+		AccountIds: []string{cr.Spec.ForProvider.AccountID},
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(guardduty.IsNotFound, err), errDisassociate)
+	}
+
+	_, err = e.client.DeleteMembersRequest(&awsguardduty.DeleteMembersInput{
+		DetectorId: cr.Spec.ForProvider.DetectorID,
+		AccountIds: []string{cr.Spec.ForProvider.AccountID},
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(guardduty.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,406 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsguardduty "github.com/aws/aws-sdk-go-v2/service/guardduty"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/guardduty"
+	"github.com/crossplane/provider-aws/pkg/clients/guardduty/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	detectorID = "some-detector"
+	accountID  = "123456789012"
+	masterID   = "210987654321"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	guardduty guardduty.MemberClient
+	cr        resource.Managed
+}
+
+type memberModifier func(*v1alpha1.Member)
+
+func withConditions(c ...runtimev1alpha1.Condition) memberModifier {
+	return func(r *v1alpha1.Member) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withInvite(invite bool) memberModifier {
+	return func(r *v1alpha1.Member) { r.Spec.ForProvider.Invite = aws.Bool(invite) }
+}
+
+func withStatus(status string) memberModifier {
+	return func(r *v1alpha1.Member) {
+		r.Status.AtProvider = v1alpha1.MemberObservation{RelationshipStatus: status, MasterID: masterID}
+	}
+}
+
+func member(m ...memberModifier) *v1alpha1.Member {
+	cr := &v1alpha1.Member{
+		Spec: v1alpha1.MemberSpec{
+			ForProvider: v1alpha1.MemberParameters{
+				DetectorID: aws.String(detectorID),
+				AccountID:  accountID,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getMembers(status ...string) func(*awsguardduty.GetMembersInput) awsguardduty.GetMembersRequest {
+	return func(*awsguardduty.GetMembersInput) awsguardduty.GetMembersRequest {
+		out := &awsguardduty.GetMembersOutput{}
+		for _, s := range status {
+			out.Members = append(out.Members, awsguardduty.Member{
+				AccountId:          aws.String(accountID),
+				MasterId:           aws.String(masterID),
+				RelationshipStatus: aws.String(s),
+			})
+		}
+		return awsguardduty.GetMembersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				guardduty: &fake.MockMemberClient{MockGet: getMembers("Enabled")},
+				cr:        member(withInvite(true)),
+			},
+			want: want{
+				cr: member(withInvite(true), withStatus("Enabled"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotInvited": {
+			args: args{
+				guardduty: &fake.MockMemberClient{MockGet: getMembers(guardduty.RelationshipStatusCreated)},
+				cr:        member(withInvite(true)),
+			},
+			want: want{
+				cr: member(withInvite(true), withStatus(guardduty.RelationshipStatusCreated),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				guardduty: &fake.MockMemberClient{MockGet: getMembers()},
+				cr:        member(),
+			},
+			want: want{
+				cr: member(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				guardduty: &fake.MockMemberClient{
+					MockGet: func(*awsguardduty.GetMembersInput) awsguardduty.GetMembersRequest {
+						return awsguardduty.GetMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr:  member(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.guardduty}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				guardduty: &fake.MockMemberClient{
+					MockCreate: func(*awsguardduty.CreateMembersInput) awsguardduty.CreateMembersRequest {
+						return awsguardduty.CreateMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsguardduty.CreateMembersOutput{}},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr: member(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				guardduty: &fake.MockMemberClient{
+					MockCreate: func(*awsguardduty.CreateMembersInput) awsguardduty.CreateMembersRequest {
+						return awsguardduty.CreateMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr:  member(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.guardduty}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Invite": {
+			args: args{
+				guardduty: &fake.MockMemberClient{
+					MockInvite: func(*awsguardduty.InviteMembersInput) awsguardduty.InviteMembersRequest {
+						return awsguardduty.InviteMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsguardduty.InviteMembersOutput{}},
+						}
+					},
+				},
+				cr: member(withInvite(true)),
+			},
+		},
+		"NoInvite": {
+			args: args{
+				guardduty: &fake.MockMemberClient{},
+				cr:        member(),
+			},
+		},
+		"ClientError": {
+			args: args{
+				guardduty: &fake.MockMemberClient{
+					MockInvite: func(*awsguardduty.InviteMembersInput) awsguardduty.InviteMembersRequest {
+						return awsguardduty.InviteMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: member(withInvite(true)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errInvite),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.guardduty}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	disassociate := func(*awsguardduty.DisassociateMembersInput) awsguardduty.DisassociateMembersRequest {
+		return awsguardduty.DisassociateMembersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsguardduty.DisassociateMembersOutput{}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				guardduty: &fake.MockMemberClient{
+					MockDisassociate: disassociate,
+					MockDelete: func(*awsguardduty.DeleteMembersInput) awsguardduty.DeleteMembersRequest {
+						return awsguardduty.DeleteMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsguardduty.DeleteMembersOutput{}},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr: member(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"DisassociateError": {
+			args: args{
+				guardduty: &fake.MockMemberClient{
+					MockDisassociate: func(*awsguardduty.DisassociateMembersInput) awsguardduty.DisassociateMembersRequest {
+						return awsguardduty.DisassociateMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr:  member(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDisassociate),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				guardduty: &fake.MockMemberClient{
+					MockDisassociate: disassociate,
+					MockDelete: func(*awsguardduty.DeleteMembersInput) awsguardduty.DeleteMembersRequest {
+						return awsguardduty.DeleteMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr:  member(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.guardduty}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,404 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publishingdestination
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsguardduty "github.com/aws/aws-sdk-go-v2/service/guardduty"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/guardduty"
+	"github.com/crossplane/provider-aws/pkg/clients/guardduty/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	detectorID    = "some-detector"
+	destinationID = "some-destination"
+	bucketARN     = "arn:aws:s3:::findings"
+	keyARN        = "arn:aws:kms:us-east-1:123456789012:key/some-key"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	guardduty guardduty.PublishingDestinationClient
+	kube      *test.MockClient
+	cr        resource.Managed
+}
+
+type destinationModifier func(*v1alpha1.PublishingDestination)
+
+func withConditions(c ...runtimev1alpha1.Condition) destinationModifier {
+	return func(r *v1alpha1.PublishingDestination) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(name string) destinationModifier {
+	return func(r *v1alpha1.PublishingDestination) { meta.SetExternalName(r, name) }
+}
+
+func withDestination(arn string) destinationModifier {
+	return func(r *v1alpha1.PublishingDestination) { r.Spec.ForProvider.DestinationARN = aws.String(arn) }
+}
+
+func withStatus(status string) destinationModifier {
+	return func(r *v1alpha1.PublishingDestination) { r.Status.AtProvider.Status = status }
+}
+
+func destination(m ...destinationModifier) *v1alpha1.PublishingDestination {
+	cr := &v1alpha1.PublishingDestination{
+		Spec: v1alpha1.PublishingDestinationSpec{
+			ForProvider: v1alpha1.PublishingDestinationParameters{
+				DetectorID:      aws.String(detectorID),
+				DestinationType: string(awsguardduty.DestinationTypeS3),
+				KMSKeyARN:       keyARN,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(status awsguardduty.PublishingStatus, arn string) func(*awsguardduty.DescribePublishingDestinationInput) awsguardduty.DescribePublishingDestinationRequest {
+	return func(*awsguardduty.DescribePublishingDestinationInput) awsguardduty.DescribePublishingDestinationRequest {
+		return awsguardduty.DescribePublishingDestinationRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsguardduty.DescribePublishingDestinationOutput{
+				DestinationId:   aws.String(destinationID),
+				DestinationType: awsguardduty.DestinationTypeS3,
+				DestinationProperties: &awsguardduty.DestinationProperties{
+					DestinationArn: aws.String(arn),
+					KmsKeyArn:      aws.String(keyARN),
+				},
+				Status: status,
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Publishing": {
+			args: args{
+				guardduty: &fake.MockPublishingDestinationClient{
+					MockDescribe: describe(awsguardduty.PublishingStatusPublishing, bucketARN),
+				},
+				cr: destination(withExternalName(destinationID), withDestination(bucketARN)),
+			},
+			want: want{
+				cr: destination(withExternalName(destinationID), withDestination(bucketARN),
+					withStatus("PUBLISHING"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"UnableToPublishAndNotUpToDate": {
+			args: args{
+				guardduty: &fake.MockPublishingDestinationClient{
+					MockDescribe: describe(awsguardduty.PublishingStatusUnableToPublishFixDestinationProperty, "arn:aws:s3:::other"),
+				},
+				cr: destination(withExternalName(destinationID), withDestination(bucketARN)),
+			},
+			want: want{
+				cr: destination(withExternalName(destinationID), withDestination(bucketARN),
+					withStatus("UNABLE_TO_PUBLISH_FIX_DESTINATION_PROPERTY"),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: destination(),
+			},
+			want: want{
+				cr: destination(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				guardduty: &fake.MockPublishingDestinationClient{
+					MockDescribe: func(*awsguardduty.DescribePublishingDestinationInput) awsguardduty.DescribePublishingDestinationRequest {
+						return awsguardduty.DescribePublishingDestinationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsguardduty.ErrCodeBadRequestException, "The request is rejected because the one or more input parameters have invalid values.", nil)},
+						}
+					},
+				},
+				cr: destination(withExternalName(destinationID)),
+			},
+			want: want{
+				cr: destination(withExternalName(destinationID)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				guardduty: &fake.MockPublishingDestinationClient{
+					MockDescribe: func(*awsguardduty.DescribePublishingDestinationInput) awsguardduty.DescribePublishingDestinationRequest {
+						return awsguardduty.DescribePublishingDestinationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: destination(withExternalName(destinationID)),
+			},
+			want: want{
+				cr:  destination(withExternalName(destinationID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.guardduty, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				guardduty: &fake.MockPublishingDestinationClient{
+					MockCreate: func(input *awsguardduty.CreatePublishingDestinationInput) awsguardduty.CreatePublishingDestinationRequest {
+						if diff := cmp.Diff(bucketARN, aws.StringValue(input.DestinationProperties.DestinationArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsguardduty.CreatePublishingDestinationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsguardduty.CreatePublishingDestinationOutput{
+								DestinationId: aws.String(destinationID),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   destination(withDestination(bucketARN)),
+			},
+			want: want{
+				cr: destination(withDestination(bucketARN), withExternalName(destinationID),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				guardduty: &fake.MockPublishingDestinationClient{
+					MockCreate: func(*awsguardduty.CreatePublishingDestinationInput) awsguardduty.CreatePublishingDestinationRequest {
+						return awsguardduty.CreatePublishingDestinationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: destination(withDestination(bucketARN)),
+			},
+			want: want{
+				cr:  destination(withDestination(bucketARN), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.guardduty, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				guardduty: &fake.MockPublishingDestinationClient{
+					MockUpdate: func(input *awsguardduty.UpdatePublishingDestinationInput) awsguardduty.UpdatePublishingDestinationRequest {
+						if diff := cmp.Diff(destinationID, aws.StringValue(input.DestinationId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsguardduty.UpdatePublishingDestinationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsguardduty.UpdatePublishingDestinationOutput{}},
+						}
+					},
+				},
+				cr: destination(withExternalName(destinationID), withDestination(bucketARN)),
+			},
+			want: want{
+				cr: destination(withExternalName(destinationID), withDestination(bucketARN)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				guardduty: &fake.MockPublishingDestinationClient{
+					MockUpdate: func(*awsguardduty.UpdatePublishingDestinationInput) awsguardduty.UpdatePublishingDestinationRequest {
+						return awsguardduty.UpdatePublishingDestinationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: destination(withExternalName(destinationID)),
+			},
+			want: want{
+				cr:  destination(withExternalName(destinationID)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.guardduty, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				guardduty: &fake.MockPublishingDestinationClient{
+					MockDelete: func(*awsguardduty.DeletePublishingDestinationInput) awsguardduty.DeletePublishingDestinationRequest {
+						return awsguardduty.DeletePublishingDestinationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsguardduty.DeletePublishingDestinationOutput{}},
+						}
+					},
+				},
+				cr: destination(withExternalName(destinationID)),
+			},
+			want: want{
+				cr: destination(withExternalName(destinationID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				guardduty: &fake.MockPublishingDestinationClient{
+					MockDelete: func(*awsguardduty.DeletePublishingDestinationInput) awsguardduty.DeletePublishingDestinationRequest {
+						return awsguardduty.DeletePublishingDestinationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: destination(withExternalName(destinationID)),
+			},
+			want: want{
+				cr:  destination(withExternalName(destinationID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.guardduty, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,352 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamaccesskey
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an IAMAccessKey resource"
+
+	errGet        = "failed to get access keys of the user"
+	errCreate     = "failed to create the access key"
+	errUpdate     = "failed to update the access key"
+	errRotate     = "failed to rotate the access key"
+	errReplace    = "failed to replace the unpublished access key"
+	errRetire     = "failed to delete the retired access key"
+	errDelete     = "failed to delete the access key"
+	errSpecUpdate = "cannot update spec of IAMAccessKey custom resource"
+	errGetSecret  = "cannot get connection secret of IAMAccessKey custom resource"
+)
+
+// publishGracePeriod is how long a new access key may take to show up in the
+// connection secret before it is considered lost and replaced.
+const publishGracePeriod = 2 * time.Minute
+
+// SetupIAMAccessKey adds a controller that reconciles IAMAccessKeys.
+func SetupIAMAccessKey(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.IAMAccessKeyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.IAMAccessKey{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.IAMAccessKeyGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: iam.NewAccessKeyClient}))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.AccessKeyClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, awsclients.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client iam.AccessKeyClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.IAMAccessKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	rsp, err := e.client.ListAccessKeysRequest(&awsiam.ListAccessKeysInput{
+		UserName: aws.String(cr.Spec.ForProvider.UserName),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+
+	var key *awsiam.AccessKeyMetadata
+	for i := range rsp.AccessKeyMetadata {
+		if aws.StringValue(rsp.AccessKeyMetadata[i].AccessKeyId) == meta.GetExternalName(cr) {
+			key = &rsp.AccessKeyMetadata[i]
+			break
+		}
+	}
+	if key == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	iam.LateInitializeAccessKey(&cr.Spec.ForProvider, *key)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	unpublished, err := e.isUnpublished(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// The rotation trigger is only known to the custom resource, so it is
+	// kept from the previous observation. An unknown trigger, e.g. of an
+	// imported key, is recorded instead of causing a rotation.
+	trigger := cr.Status.AtProvider.RotationTrigger
+	if trigger == nil {
+		trigger = aws.String(aws.StringValue(cr.Spec.ForProvider.RotationTrigger))
+	}
+	cr.Status.AtProvider = iam.GenerateAccessKeyObservation(*key)
+	cr.Status.AtProvider.RotationTrigger = trigger
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	// The secret of an access key cannot be read again, so a key whose
+	// connection details were not published within the grace period is
+	// replaced. Until then, neither it nor the key it replaced is changed.
+	upToDate := iam.IsAccessKeyUpToDate(cr, *key) && cr.GetAnnotations()[iam.AnnotationKeyRetiredAccessKeyID] == ""
+	if unpublished {
+		upToDate = time.Since(aws.TimeValue(key.CreateDate)) < publishGracePeriod
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.IAMAccessKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	key, err := e.createAccessKey(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	return managed.ExternalCreation{ConnectionDetails: iam.GetAccessKeyConnectionDetails(*key)}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.IAMAccessKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	if cr.GetAnnotations()[iam.AnnotationKeyUnpublishedAccessKeyID] != "" {
+		return e.replace(ctx, cr)
+	}
+
+	if id := cr.GetAnnotations()[iam.AnnotationKeyRetiredAccessKeyID]; id != "" {
+		return managed.ExternalUpdate{}, errors.Wrap(e.retire(ctx, cr, id), errRetire)
+	}
+
+	if iam.IsAccessKeyRotationRequired(cr) {
+		return e.rotate(ctx, cr)
+	}
+
+	_, err := e.client.UpdateAccessKeyRequest(&awsiam.UpdateAccessKeyInput{
+		AccessKeyId: aws.String(meta.GetExternalName(cr)),
+		UserName:    aws.String(cr.Spec.ForProvider.UserName),
+		Status:      awsiam.StatusType(aws.StringValue(cr.Spec.ForProvider.Status)),
+	}).Send(ctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.IAMAccessKey)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	// An access key that was replaced by a rotation is not kept for the
+	// connection secret of a deleted IAMAccessKey.
+	if id := cr.GetAnnotations()[iam.AnnotationKeyRetiredAccessKeyID]; id != "" {
+		_, err := e.client.DeleteAccessKeyRequest(&awsiam.DeleteAccessKeyInput{
+			AccessKeyId: aws.String(id),
+			UserName:    aws.String(cr.Spec.ForProvider.UserName),
+		}).Send(ctx)
+		if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return errors.Wrap(err, errRetire)
+		}
+	}
+
+	_, err := e.client.DeleteAccessKeyRequest(&awsiam.DeleteAccessKeyInput{
+		AccessKeyId: aws.String(meta.GetExternalName(cr)),
+		UserName:    aws.String(cr.Spec.ForProvider.UserName),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+// rotate creates a new access key in place of the current one, which is
+// recorded as retired and kept until the new key is published. This way the
+// connection secret never holds a deleted key.
+func (e *external) rotate(ctx context.Context, cr *v1alpha1.IAMAccessKey) (managed.ExternalUpdate, error) {
+	meta.AddAnnotations(cr, map[string]string{iam.AnnotationKeyRetiredAccessKeyID: meta.GetExternalName(cr)})
+	key, err := e.createAccessKey(ctx, cr)
+	if err != nil {
+		meta.RemoveAnnotations(cr, iam.AnnotationKeyRetiredAccessKeyID)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRotate)
+	}
+
+	return managed.ExternalUpdate{ConnectionDetails: iam.GetAccessKeyConnectionDetails(*key)}, nil
+}
+
+// replace deletes the current access key, whose connection details were never
+// published, and creates a new one in its place.
+func (e *external) replace(ctx context.Context, cr *v1alpha1.IAMAccessKey) (managed.ExternalUpdate, error) {
+	_, err := e.client.DeleteAccessKeyRequest(&awsiam.DeleteAccessKeyInput{
+		AccessKeyId: aws.String(meta.GetExternalName(cr)),
+		UserName:    aws.String(cr.Spec.ForProvider.UserName),
+	}).Send(ctx)
+	if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errReplace)
+	}
+
+	key, err := e.createAccessKey(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errReplace)
+	}
+
+	return managed.ExternalUpdate{ConnectionDetails: iam.GetAccessKeyConnectionDetails(*key)}, nil
+}
+
+// retire deletes the access key with the given ID that was replaced by a
+// rotation. It is only called once the current access key is published.
+func (e *external) retire(ctx context.Context, cr *v1alpha1.IAMAccessKey, id string) error {
+	_, err := e.client.DeleteAccessKeyRequest(&awsiam.DeleteAccessKeyInput{
+		AccessKeyId: aws.String(id),
+		UserName:    aws.String(cr.Spec.ForProvider.UserName),
+	}).Send(ctx)
+	if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+		return err
+	}
+
+	meta.RemoveAnnotations(cr, iam.AnnotationKeyRetiredAccessKeyID)
+	return errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+// isUnpublished returns whether the current access key of the given
+// IAMAccessKey was created by this controller and its connection secret does
+// not hold it yet. The mark of a published access key is removed.
+func (e *external) isUnpublished(ctx context.Context, cr *v1alpha1.IAMAccessKey) (bool, error) {
+	id := cr.GetAnnotations()[iam.AnnotationKeyUnpublishedAccessKeyID]
+	if id == "" {
+		return false, nil
+	}
+	if ref := cr.GetWriteConnectionSecretToReference(); ref != nil && id == meta.GetExternalName(cr) {
+		s := &corev1.Secret{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); resource.IgnoreNotFound(err) != nil {
+			return false, errors.Wrap(err, errGetSecret)
+		}
+		if string(s.Data[runtimev1alpha1.ResourceCredentialsSecretUserKey]) != id {
+			return true, nil
+		}
+	}
+	meta.RemoveAnnotations(cr, iam.AnnotationKeyUnpublishedAccessKeyID)
+	return false, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+// createAccessKey creates an access key with the desired status and records
+// it as the external name of the given IAMAccessKey. The secret of an access
+// key cannot be read again, so a new key that cannot be recorded is deleted
+// rather than leaked.
+func (e *external) createAccessKey(ctx context.Context, cr *v1alpha1.IAMAccessKey) (*awsiam.AccessKey, error) {
+	rsp, err := e.client.CreateAccessKeyRequest(&awsiam.CreateAccessKeyInput{
+		UserName: aws.String(cr.Spec.ForProvider.UserName),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := rsp.AccessKey
+
+	if err := e.recordAccessKey(ctx, cr, key); err != nil {
+		_, _ = e.client.DeleteAccessKeyRequest(&awsiam.DeleteAccessKeyInput{
+			AccessKeyId: key.AccessKeyId,
+			UserName:    aws.String(cr.Spec.ForProvider.UserName),
+		}).Send(ctx)
+		return nil, err
+	}
+
+	// The status is set after the spec update, which overwrites it with the
+	// stored one.
+	cr.Status.AtProvider.RotationTrigger = aws.String(aws.StringValue(cr.Spec.ForProvider.RotationTrigger))
+	return key, nil
+}
+
+// recordAccessKey applies the desired status to the given new access key and
+// records it as the external name of the given IAMAccessKey, marked as
+// unpublished. The IAMAccessKey is left unchanged if this fails.
+func (e *external) recordAccessKey(ctx context.Context, cr *v1alpha1.IAMAccessKey, key *awsiam.AccessKey) error {
+	// Access keys are always created active.
+	if aws.StringValue(cr.Spec.ForProvider.Status) == v1alpha1.AccessKeyStatusInactive {
+		if _, err := e.client.UpdateAccessKeyRequest(&awsiam.UpdateAccessKeyInput{
+			AccessKeyId: key.AccessKeyId,
+			UserName:    aws.String(cr.Spec.ForProvider.UserName),
+			Status:      awsiam.StatusTypeInactive,
+		}).Send(ctx); err != nil {
+			return err
+		}
+	}
+
+	annotations := cr.DeepCopy().GetAnnotations()
+	meta.SetExternalName(cr, aws.StringValue(key.AccessKeyId))
+	meta.AddAnnotations(cr, map[string]string{iam.AnnotationKeyUnpublishedAccessKeyID: aws.StringValue(key.AccessKeyId)})
+	if err := e.kube.Update(ctx, cr); err != nil {
+		cr.SetAnnotations(annotations)
+		return err
+	}
+	return nil
+}
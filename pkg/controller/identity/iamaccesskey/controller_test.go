@@ -0,0 +1,758 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamaccesskey
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	userName  = "some-user"
+	keyID     = "AKIAOLDKEY"
+	newKeyID  = "AKIANEWKEY"
+	newSecret = "secret"
+	now       = time.Now().Round(time.Second)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam  iam.AccessKeyClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type accessKeyModifier func(*v1alpha1.IAMAccessKey)
+
+func withConditions(c ...runtimev1alpha1.Condition) accessKeyModifier {
+	return func(r *v1alpha1.IAMAccessKey) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) accessKeyModifier {
+	return func(r *v1alpha1.IAMAccessKey) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s string) accessKeyModifier {
+	return func(r *v1alpha1.IAMAccessKey) { r.Spec.ForProvider.Status = aws.String(s) }
+}
+
+func withRotationTrigger(t string) accessKeyModifier {
+	return func(r *v1alpha1.IAMAccessKey) { r.Spec.ForProvider.RotationTrigger = aws.String(t) }
+}
+
+func withObservation(o v1alpha1.IAMAccessKeyObservation) accessKeyModifier {
+	return func(r *v1alpha1.IAMAccessKey) { r.Status.AtProvider = o }
+}
+
+func withRetiredKey(id string) accessKeyModifier {
+	return func(r *v1alpha1.IAMAccessKey) {
+		meta.AddAnnotations(r, map[string]string{iam.AnnotationKeyRetiredAccessKeyID: id})
+	}
+}
+
+func withUnpublishedKey(id string) accessKeyModifier {
+	return func(r *v1alpha1.IAMAccessKey) {
+		meta.AddAnnotations(r, map[string]string{iam.AnnotationKeyUnpublishedAccessKeyID: id})
+	}
+}
+
+func withConnectionSecret(name string) accessKeyModifier {
+	return func(r *v1alpha1.IAMAccessKey) {
+		r.SetWriteConnectionSecretToReference(&runtimev1alpha1.SecretReference{Namespace: "crossplane-system", Name: name})
+	}
+}
+
+func accessKey(m ...accessKeyModifier) *v1alpha1.IAMAccessKey {
+	cr := &v1alpha1.IAMAccessKey{
+		Spec: v1alpha1.IAMAccessKeySpec{
+			ForProvider: v1alpha1.IAMAccessKeyParameters{
+				UserName: userName,
+			},
+		},
+	}
+	meta.SetExternalName(cr, keyID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listAccessKeys(in *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+	return listAccessKeysCreatedAt(nil)(in)
+}
+
+func listAccessKeysCreatedAt(t *time.Time) func(*awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+	return func(*awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+		return awsiam.ListAccessKeysRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+				AccessKeyMetadata: []awsiam.AccessKeyMetadata{
+					{AccessKeyId: aws.String("AKIAOTHERKEY"), Status: awsiam.StatusTypeInactive},
+					{AccessKeyId: aws.String(keyID), Status: awsiam.StatusTypeActive, CreateDate: t},
+				},
+			}},
+		}
+	}
+}
+
+func createAccessKey(*awsiam.CreateAccessKeyInput) awsiam.CreateAccessKeyRequest {
+	return awsiam.CreateAccessKeyRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateAccessKeyOutput{
+			AccessKey: &awsiam.AccessKey{
+				AccessKeyId:     aws.String(newKeyID),
+				SecretAccessKey: aws.String(newSecret),
+				Status:          awsiam.StatusTypeActive,
+				UserName:        aws.String(userName),
+			},
+		}},
+	}
+}
+
+func deleteAccessKey(t *testing.T, ids ...string) func(*awsiam.DeleteAccessKeyInput) awsiam.DeleteAccessKeyRequest {
+	t.Cleanup(func() {
+		if len(ids) != 0 {
+			t.Errorf("access keys %v were not deleted", ids)
+		}
+	})
+	return func(input *awsiam.DeleteAccessKeyInput) awsiam.DeleteAccessKeyRequest {
+		if len(ids) == 0 {
+			t.Errorf("unexpected deletion of access key %s", aws.StringValue(input.AccessKeyId))
+		} else {
+			if diff := cmp.Diff(aws.String(ids[0]), input.AccessKeyId); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			ids = ids[1:]
+		}
+		return awsiam.DeleteAccessKeyRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteAccessKeyOutput{}},
+		}
+	}
+}
+
+func getSecret(user string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+		s := obj.(*corev1.Secret)
+		s.Data = map[string][]byte{runtimev1alpha1.ResourceCredentialsSecretUserKey: []byte(user)}
+		return nil
+	}
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(newKeyID),
+		runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(newSecret),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockListAccessKeysRequest: listAccessKeys,
+				},
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive),
+					withObservation(v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("")})),
+			},
+			want: want{
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive),
+					withObservation(v1alpha1.IAMAccessKeyObservation{Status: v1alpha1.AccessKeyStatusActive, RotationTrigger: aws.String("")}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"UnknownRotationTrigger": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockListAccessKeysRequest: listAccessKeys,
+				},
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive), withRotationTrigger("1")),
+			},
+			want: want{
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive), withRotationTrigger("1"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{Status: v1alpha1.AccessKeyStatusActive, RotationTrigger: aws.String("1")}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RetiredKeyPending": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockListAccessKeysRequest: listAccessKeys,
+				},
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive), withRetiredKey("AKIAOTHERKEY"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("")})),
+			},
+			want: want{
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive), withRetiredKey("AKIAOTHERKEY"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{Status: v1alpha1.AccessKeyStatusActive, RotationTrigger: aws.String("")}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"Published": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockListAccessKeysRequest: listAccessKeys,
+				},
+				kube: &test.MockClient{
+					MockGet:    getSecret(keyID),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive), withUnpublishedKey(keyID), withConnectionSecret("key"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("")})),
+			},
+			want: want{
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive), withConnectionSecret("key"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{Status: v1alpha1.AccessKeyStatusActive, RotationTrigger: aws.String("")}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"UnpublishedInGracePeriod": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockListAccessKeysRequest: listAccessKeysCreatedAt(&now),
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "key")),
+				},
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive), withUnpublishedKey(keyID), withRetiredKey("AKIAOTHERKEY"),
+					withConnectionSecret("key"), withObservation(v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("")})),
+			},
+			want: want{
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive), withUnpublishedKey(keyID), withRetiredKey("AKIAOTHERKEY"),
+					withConnectionSecret("key"), withObservation(v1alpha1.IAMAccessKeyObservation{
+						Status: v1alpha1.AccessKeyStatusActive, CreateDate: &metav1.Time{Time: now}, RotationTrigger: aws.String(""),
+					}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"UnpublishedAfterGracePeriod": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockListAccessKeysRequest: listAccessKeys,
+				},
+				kube: &test.MockClient{
+					MockGet: getSecret("AKIAOTHERKEY"),
+				},
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive), withUnpublishedKey(keyID), withConnectionSecret("key"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("")})),
+			},
+			want: want{
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive), withUnpublishedKey(keyID), withConnectionSecret("key"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{Status: v1alpha1.AccessKeyStatusActive, RotationTrigger: aws.String("")}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"GetSecretError": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockListAccessKeysRequest: listAccessKeys,
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive), withUnpublishedKey(keyID), withConnectionSecret("key")),
+			},
+			want: want{
+				cr:  accessKey(withStatus(v1alpha1.AccessKeyStatusActive), withUnpublishedKey(keyID), withConnectionSecret("key")),
+				err: errors.Wrap(errBoom, errGetSecret),
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockListAccessKeysRequest: listAccessKeys,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   accessKey(),
+			},
+			want: want{
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive),
+					withObservation(v1alpha1.IAMAccessKeyObservation{Status: v1alpha1.AccessKeyStatusActive, RotationTrigger: aws.String("")}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockListAccessKeysRequest: listAccessKeys,
+				},
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive), withRotationTrigger("2"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("1")})),
+			},
+			want: want{
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusActive), withRotationTrigger("2"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{Status: v1alpha1.AccessKeyStatusActive, RotationTrigger: aws.String("1")}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: accessKey(withExternalName("")),
+			},
+			want: want{
+				cr: accessKey(withExternalName("")),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockListAccessKeysRequest: listAccessKeys,
+				},
+				cr: accessKey(withExternalName("AKIADELETED")),
+			},
+			want: want{
+				cr: accessKey(withExternalName("AKIADELETED")),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockListAccessKeysRequest: func(*awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: accessKey(),
+			},
+			want: want{
+				cr:  accessKey(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockCreateAccessKeyRequest: createAccessKey,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   accessKey(withExternalName(""), withRotationTrigger("1")),
+			},
+			want: want{
+				cr: accessKey(withExternalName(newKeyID), withUnpublishedKey(newKeyID), withRotationTrigger("1"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("1")}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{ConnectionDetails: connectionDetails()},
+			},
+		},
+		"Inactive": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockCreateAccessKeyRequest: createAccessKey,
+					MockUpdateAccessKeyRequest: func(input *awsiam.UpdateAccessKeyInput) awsiam.UpdateAccessKeyRequest {
+						if diff := cmp.Diff(awsiam.StatusTypeInactive, input.Status); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.UpdateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateAccessKeyOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   accessKey(withExternalName(""), withStatus(v1alpha1.AccessKeyStatusInactive)),
+			},
+			want: want{
+				cr: accessKey(withExternalName(newKeyID), withUnpublishedKey(newKeyID), withStatus(v1alpha1.AccessKeyStatusInactive),
+					withObservation(v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("")}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{ConnectionDetails: connectionDetails()},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockCreateAccessKeyRequest: func(*awsiam.CreateAccessKeyInput) awsiam.CreateAccessKeyRequest {
+						return awsiam.CreateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: accessKey(withExternalName("")),
+			},
+			want: want{
+				cr:  accessKey(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"RecordError": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockCreateAccessKeyRequest: createAccessKey,
+					MockDeleteAccessKeyRequest: deleteAccessKey(t, newKeyID),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   accessKey(withExternalName("")),
+			},
+			want: want{
+				cr:  accessKey(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Status": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockUpdateAccessKeyRequest: func(input *awsiam.UpdateAccessKeyInput) awsiam.UpdateAccessKeyRequest {
+						if diff := cmp.Diff(aws.String(keyID), input.AccessKeyId); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.UpdateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateAccessKeyOutput{}},
+						}
+					},
+				},
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusInactive)),
+			},
+			want: want{
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusInactive)),
+			},
+		},
+		"Rotate": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockCreateAccessKeyRequest: createAccessKey,
+					MockDeleteAccessKeyRequest: deleteAccessKey(t),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr: accessKey(withRotationTrigger("2"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("1")})),
+			},
+			want: want{
+				cr: accessKey(withExternalName(newKeyID), withRetiredKey(keyID), withUnpublishedKey(newKeyID), withRotationTrigger("2"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("2")})),
+				result: managed.ExternalUpdate{ConnectionDetails: connectionDetails()},
+			},
+		},
+		"RotateCreateError": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockCreateAccessKeyRequest: func(*awsiam.CreateAccessKeyInput) awsiam.CreateAccessKeyRequest {
+						return awsiam.CreateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+					MockDeleteAccessKeyRequest: deleteAccessKey(t),
+				},
+				cr: accessKey(withRotationTrigger("2"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("1")})),
+			},
+			want: want{
+				cr: accessKey(withRotationTrigger("2"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("1")})),
+				err: errors.Wrap(errBoom, errRotate),
+			},
+		},
+		"Retire": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockDeleteAccessKeyRequest: deleteAccessKey(t, "AKIAOTHERKEY"),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   accessKey(withRetiredKey("AKIAOTHERKEY"), withConnectionSecret("key")),
+			},
+			want: want{
+				cr: accessKey(withConnectionSecret("key")),
+			},
+		},
+		"Replace": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockCreateAccessKeyRequest: createAccessKey,
+					MockDeleteAccessKeyRequest: deleteAccessKey(t, keyID),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   accessKey(withUnpublishedKey(keyID), withRetiredKey("AKIAOTHERKEY"), withConnectionSecret("key")),
+			},
+			want: want{
+				cr: accessKey(withExternalName(newKeyID), withUnpublishedKey(newKeyID), withRetiredKey("AKIAOTHERKEY"), withConnectionSecret("key"),
+					withObservation(v1alpha1.IAMAccessKeyObservation{RotationTrigger: aws.String("")})),
+				result: managed.ExternalUpdate{ConnectionDetails: connectionDetails()},
+			},
+		},
+		"ReplaceRecordError": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockCreateAccessKeyRequest: createAccessKey,
+					MockDeleteAccessKeyRequest: deleteAccessKey(t, keyID, newKeyID),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   accessKey(withUnpublishedKey(keyID), withConnectionSecret("key")),
+			},
+			want: want{
+				cr:  accessKey(withUnpublishedKey(keyID), withConnectionSecret("key")),
+				err: errors.Wrap(errBoom, errReplace),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockUpdateAccessKeyRequest: func(*awsiam.UpdateAccessKeyInput) awsiam.UpdateAccessKeyRequest {
+						return awsiam.UpdateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: accessKey(withStatus(v1alpha1.AccessKeyStatusInactive)),
+			},
+			want: want{
+				cr:  accessKey(withStatus(v1alpha1.AccessKeyStatusInactive)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockDeleteAccessKeyRequest: func(*awsiam.DeleteAccessKeyInput) awsiam.DeleteAccessKeyRequest {
+						return awsiam.DeleteAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteAccessKeyOutput{}},
+						}
+					},
+				},
+				cr: accessKey(),
+			},
+			want: want{
+				cr: accessKey(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"RetiredKey": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockDeleteAccessKeyRequest: deleteAccessKey(t, "AKIAOTHERKEY", keyID),
+				},
+				cr: accessKey(withRetiredKey("AKIAOTHERKEY")),
+			},
+			want: want{
+				cr: accessKey(withRetiredKey("AKIAOTHERKEY"), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccessKeyClient{
+					MockDeleteAccessKeyRequest: func(*awsiam.DeleteAccessKeyInput) awsiam.DeleteAccessKeyRequest {
+						return awsiam.DeleteAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: accessKey(),
+			},
+			want: want{
+				cr:  accessKey(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
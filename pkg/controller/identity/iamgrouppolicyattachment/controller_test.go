@@ -25,13 +25,16 @@ import (
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
 	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
 )
@@ -45,8 +48,9 @@ var (
 )
 
 type args struct {
-	iam iam.GroupPolicyAttachmentClient
-	cr  resource.Managed
+	iam  iam.GroupPolicyAttachmentClient
+	kube client.Client
+	cr   resource.Managed
 }
 
 type groupPolicyModifier func(*v1alpha1.IAMGroupPolicyAttachment)
@@ -67,6 +71,10 @@ func withStatusPolicyArn(s string) groupPolicyModifier {
 	return func(r *v1alpha1.IAMGroupPolicyAttachment) { r.Status.AtProvider.AttachedPolicyARN = s }
 }
 
+func withExternalName(name string) groupPolicyModifier {
+	return func(r *v1alpha1.IAMGroupPolicyAttachment) { meta.SetExternalName(r, name) }
+}
+
 func groupPolicy(m ...groupPolicyModifier) *v1alpha1.IAMGroupPolicyAttachment {
 	cr := &v1alpha1.IAMGroupPolicyAttachment{}
 	for _, f := range m {
@@ -102,11 +110,43 @@ func TestObserve(t *testing.T) {
 						}
 					},
 				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
 				cr: groupPolicy(withGroupName(groupName),
 					withSpecPolicyArn(policyArn)),
 			},
 			want: want{
-				cr: groupPolicy(withGroupName(groupName),
+				cr: groupPolicy(withExternalName(awscommon.JoinExternalName(groupName, policyArn)),
+					withGroupName(groupName),
+					withSpecPolicyArn(policyArn),
+					withConditions(runtimev1alpha1.Available()),
+					withStatusPolicyArn(policyArn)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ImportedByExternalName": {
+			args: args{
+				iam: &fake.MockGroupPolicyAttachmentClient{
+					MockListAttachedGroupPolicies: func(input *awsiam.ListAttachedGroupPoliciesInput) awsiam.ListAttachedGroupPoliciesRequest {
+						return awsiam.ListAttachedGroupPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAttachedGroupPoliciesOutput{
+								AttachedPolicies: []awsiam.AttachedPolicy{{PolicyArn: &policyArn}},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: groupPolicy(withExternalName(awscommon.JoinExternalName(groupName, policyArn))),
+			},
+			want: want{
+				cr: groupPolicy(withExternalName(awscommon.JoinExternalName(groupName, policyArn)),
+					withGroupName(groupName),
 					withSpecPolicyArn(policyArn),
 					withConditions(runtimev1alpha1.Available()),
 					withStatusPolicyArn(policyArn)),
@@ -160,7 +200,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -239,7 +279,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -333,7 +373,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -28,6 +29,7 @@ import (
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
@@ -42,6 +44,8 @@ const (
 	errGet    = "failed to get groups for user"
 	errAdd    = "failed to add the user to group"
 	errRemove = "failed to remove the user to group"
+
+	errKubeUpdateFailed = "cannot late initialize GroupUserMembership"
 )
 
 // SetupIAMGroupUserMembership adds a controller that reconciles
@@ -54,10 +58,10 @@ func SetupIAMGroupUserMembership(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1alpha1.IAMGroupUserMembership{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.IAMGroupUserMembershipGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewGroupUserMembershipClient}),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: iam.NewGroupUserMembershipClient}))),
 			managed.WithConnectionPublishers(),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -86,6 +90,12 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 
+	// The parts of the composite external name of an imported resource
+	// identify it in case they're not specified.
+	current := cr.Spec.ForProvider.DeepCopy()
+	awscommon.LateInitializeFromExternalName(meta.GetExternalName(cr),
+		&cr.Spec.ForProvider.GroupName, &cr.Spec.ForProvider.UserName)
+
 	observed, err := e.client.ListGroupsForUserRequest(&awsiam.ListGroupsForUserInput{
 		UserName: &cr.Spec.ForProvider.UserName,
 	}).Send(ctx)
@@ -107,6 +117,13 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		}, nil
 	}
 
+	nameChanged := awscommon.SetCompositeExternalName(cr, cr.Spec.ForProvider.GroupName, cr.Spec.ForProvider.UserName)
+	if nameChanged || !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
 	cr.Status.AtProvider = v1alpha1.IAMGroupUserMembershipObservation{
 		AttachedGroupARN: aws.StringValue(attachedGroupObject.Arn),
 	}
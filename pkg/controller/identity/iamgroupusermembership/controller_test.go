@@ -25,13 +25,16 @@ import (
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
 	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
 )
@@ -45,8 +48,9 @@ var (
 )
 
 type args struct {
-	iam iam.GroupUserMembershipClient
-	cr  resource.Managed
+	iam  iam.GroupUserMembershipClient
+	kube client.Client
+	cr   resource.Managed
 }
 
 type userGroupModifier func(*v1alpha1.IAMGroupUserMembership)
@@ -67,6 +71,10 @@ func withStatusGroupArn(s string) userGroupModifier {
 	return func(r *v1alpha1.IAMGroupUserMembership) { r.Status.AtProvider.AttachedGroupARN = s }
 }
 
+func withExternalName(name string) userGroupModifier {
+	return func(r *v1alpha1.IAMGroupUserMembership) { meta.SetExternalName(r, name) }
+}
+
 func userGroup(m ...userGroupModifier) *v1alpha1.IAMGroupUserMembership {
 	cr := &v1alpha1.IAMGroupUserMembership{}
 	for _, f := range m {
@@ -103,11 +111,43 @@ func TestObserve(t *testing.T) {
 						}
 					},
 				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
 				cr: userGroup(withGroupName(groupName),
 					withSpecUserName(userName)),
 			},
 			want: want{
-				cr: userGroup(withGroupName(groupName),
+				cr: userGroup(withExternalName(awscommon.JoinExternalName(groupName, userName)),
+					withGroupName(groupName),
+					withSpecUserName(userName),
+					withConditions(runtimev1alpha1.Available()),
+					withStatusGroupArn(groupArn)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ImportedByExternalName": {
+			args: args{
+				iam: &fake.MockGroupUserMembershipClient{
+					MockListGroupsForUser: func(input *awsiam.ListGroupsForUserInput) awsiam.ListGroupsForUserRequest {
+						return awsiam.ListGroupsForUserRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListGroupsForUserOutput{
+								Groups: []awsiam.Group{{Arn: &groupArn, GroupName: &groupName}},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: userGroup(withExternalName(awscommon.JoinExternalName(groupName, userName))),
+			},
+			want: want{
+				cr: userGroup(withExternalName(awscommon.JoinExternalName(groupName, userName)),
+					withGroupName(groupName),
 					withSpecUserName(userName),
 					withConditions(runtimev1alpha1.Available()),
 					withStatusGroupArn(groupArn)),
@@ -161,7 +201,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -240,7 +280,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -334,7 +374,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -26,13 +26,16 @@ import (
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	v1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
 	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
 )
@@ -47,8 +50,9 @@ var (
 )
 
 type args struct {
-	iam iam.RolePolicyAttachmentClient
-	cr  resource.Managed
+	iam  iam.RolePolicyAttachmentClient
+	kube client.Client
+	cr   resource.Managed
 }
 
 type rolePolicyModifier func(*v1beta1.IAMRolePolicyAttachment)
@@ -69,6 +73,10 @@ func withStatusPolicyArn(s *string) rolePolicyModifier {
 	return func(r *v1beta1.IAMRolePolicyAttachment) { r.Status.AtProvider.AttachedPolicyARN = *s }
 }
 
+func withExternalName(name string) rolePolicyModifier {
+	return func(r *v1beta1.IAMRolePolicyAttachment) { meta.SetExternalName(r, name) }
+}
+
 func rolePolicy(m ...rolePolicyModifier) *v1beta1.IAMRolePolicyAttachment {
 	cr := &v1beta1.IAMRolePolicyAttachment{}
 	for _, f := range m {
@@ -104,10 +112,44 @@ func TestObserve(t *testing.T) {
 						}
 					},
 				},
-				cr: rolePolicy(withSpecPolicyArn(&specPolicyArn)),
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: rolePolicy(withRoleName(&roleName),
+					withSpecPolicyArn(&specPolicyArn)),
 			},
 			want: want{
-				cr: rolePolicy(withSpecPolicyArn(&specPolicyArn),
+				cr: rolePolicy(withExternalName(awscommon.JoinExternalName(roleName, specPolicyArn)),
+					withRoleName(&roleName),
+					withSpecPolicyArn(&specPolicyArn),
+					withConditions(corev1alpha1.Available()),
+					withStatusPolicyArn(&specPolicyArn)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ImportedByExternalName": {
+			args: args{
+				iam: &fake.MockRolePolicyAttachmentClient{
+					MockListAttachedRolePoliciesRequest: func(input *awsiam.ListAttachedRolePoliciesInput) awsiam.ListAttachedRolePoliciesRequest {
+						return awsiam.ListAttachedRolePoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAttachedRolePoliciesOutput{
+								AttachedPolicies: []awsiam.AttachedPolicy{{PolicyArn: &specPolicyArn}},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: rolePolicy(withExternalName(awscommon.JoinExternalName(roleName, specPolicyArn))),
+			},
+			want: want{
+				cr: rolePolicy(withExternalName(awscommon.JoinExternalName(roleName, specPolicyArn)),
+					withRoleName(&roleName),
+					withSpecPolicyArn(&specPolicyArn),
 					withConditions(corev1alpha1.Available()),
 					withStatusPolicyArn(&specPolicyArn)),
 				result: managed.ExternalObservation{
@@ -160,7 +202,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -239,7 +281,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -294,7 +336,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -387,7 +429,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
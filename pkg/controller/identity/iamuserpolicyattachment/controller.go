@@ -29,6 +29,7 @@ import (
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
@@ -57,9 +58,10 @@ func SetupIAMUserPolicyAttachment(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1alpha1.IAMUserPolicyAttachment{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.IAMUserPolicyAttachmentGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewUserPolicyAttachmentClient}),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: iam.NewUserPolicyAttachmentClient}))),
 			managed.WithConnectionPublishers(),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -88,6 +90,12 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 
+	// The parts of the composite external name of an imported resource
+	// identify it in case they're not specified.
+	current := cr.Spec.ForProvider.DeepCopy()
+	awscommon.LateInitializeFromExternalName(meta.GetExternalName(cr),
+		&cr.Spec.ForProvider.UserName, &cr.Spec.ForProvider.PolicyARN)
+
 	observed, err := e.client.ListAttachedUserPoliciesRequest(&awsiam.ListAttachedUserPoliciesInput{
 		UserName: aws.String(cr.Spec.ForProvider.UserName),
 	}).Send(ctx)
@@ -109,9 +117,9 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		}, nil
 	}
 
-	current := cr.Spec.ForProvider.DeepCopy()
 	iam.LateInitializeUserPolicy(&cr.Spec.ForProvider, attachedPolicyObject)
-	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+	nameChanged := awscommon.SetCompositeExternalName(cr, cr.Spec.ForProvider.UserName, cr.Spec.ForProvider.PolicyARN)
+	if nameChanged || !cmp.Equal(current, &cr.Spec.ForProvider) {
 		if err := e.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
 		}
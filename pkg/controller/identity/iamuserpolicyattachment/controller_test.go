@@ -26,13 +26,16 @@ import (
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
 	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
 )
@@ -46,8 +49,9 @@ var (
 )
 
 type args struct {
-	iam iam.UserPolicyAttachmentClient
-	cr  resource.Managed
+	iam  iam.UserPolicyAttachmentClient
+	kube client.Client
+	cr   resource.Managed
 }
 
 type userPolicyModifier func(*v1alpha1.IAMUserPolicyAttachment)
@@ -68,6 +72,10 @@ func withStatusPolicyArn(s string) userPolicyModifier {
 	return func(r *v1alpha1.IAMUserPolicyAttachment) { r.Status.AtProvider.AttachedPolicyARN = s }
 }
 
+func withExternalName(name string) userPolicyModifier {
+	return func(r *v1alpha1.IAMUserPolicyAttachment) { meta.SetExternalName(r, name) }
+}
+
 func userPolicy(m ...userPolicyModifier) *v1alpha1.IAMUserPolicyAttachment {
 	cr := &v1alpha1.IAMUserPolicyAttachment{}
 	for _, f := range m {
@@ -103,11 +111,43 @@ func TestObserve(t *testing.T) {
 						}
 					},
 				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
 				cr: userPolicy(withUserName(userName),
 					withSpecPolicyArn(policyArn)),
 			},
 			want: want{
-				cr: userPolicy(withUserName(userName),
+				cr: userPolicy(withExternalName(awscommon.JoinExternalName(userName, policyArn)),
+					withUserName(userName),
+					withSpecPolicyArn(policyArn),
+					withConditions(runtimev1alpha1.Available()),
+					withStatusPolicyArn(policyArn)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ImportedByExternalName": {
+			args: args{
+				iam: &fake.MockUserPolicyAttachmentClient{
+					MockListAttachedUserPolicies: func(input *awsiam.ListAttachedUserPoliciesInput) awsiam.ListAttachedUserPoliciesRequest {
+						return awsiam.ListAttachedUserPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAttachedUserPoliciesOutput{
+								AttachedPolicies: []awsiam.AttachedPolicy{{PolicyArn: &policyArn}},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: userPolicy(withExternalName(awscommon.JoinExternalName(userName, policyArn))),
+			},
+			want: want{
+				cr: userPolicy(withExternalName(awscommon.JoinExternalName(userName, policyArn)),
+					withUserName(userName),
 					withSpecPolicyArn(policyArn),
 					withConditions(runtimev1alpha1.Available()),
 					withStatusPolicyArn(policyArn)),
@@ -161,7 +201,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -240,7 +280,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -333,7 +373,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -0,0 +1,216 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceprofile
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an InstanceProfile resource"
+
+	errGet        = "failed to get the IAM instance profile"
+	errCreate     = "failed to create the IAM instance profile"
+	errAddRole    = "failed to add the role to the IAM instance profile"
+	errRemoveRole = "failed to remove a role from the IAM instance profile"
+	errDelete     = "failed to delete the IAM instance profile"
+	errSpecUpdate = "cannot update spec of the InstanceProfile custom resource"
+)
+
+// SetupInstanceProfile adds a controller that reconciles IAM instance
+// profiles.
+func SetupInstanceProfile(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.InstanceProfileGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.InstanceProfile{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.InstanceProfileGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: iam.NewInstanceProfileClient}))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewUniqueExternalNameInitializer(mgr.GetClient(), awsclients.MaxLengthIAMInstanceProfileName), managed.NewNameAsExternalName(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.InstanceProfileClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, awsclients.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client iam.InstanceProfileClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1beta1.InstanceProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.GetInstanceProfileRequest(&awsiam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+	if rsp.InstanceProfile == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	profile := *rsp.InstanceProfile
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	iam.LateInitializeInstanceProfile(&cr.Spec.ForProvider, profile)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = iam.GenerateInstanceProfileObservation(profile)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: iam.IsInstanceProfileUpToDate(cr.Spec.ForProvider, profile),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1beta1.InstanceProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	_, err := e.client.CreateInstanceProfileRequest(&awsiam.CreateInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+		Path:                cr.Spec.ForProvider.Path,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	if cr.Spec.ForProvider.RoleName == nil {
+		return managed.ExternalCreation{}, nil
+	}
+	_, err = e.client.AddRoleToInstanceProfileRequest(&awsiam.AddRoleToInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+		RoleName:            cr.Spec.ForProvider.RoleName,
+	}).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errAddRole)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1beta1.InstanceProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.GetInstanceProfileRequest(&awsiam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil || rsp.InstanceProfile == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGet)
+	}
+
+	// NOTE: An instance profile can contain only one role, so the old role
+	// is removed before the new one is added.
+	add, remove := iam.DiffInstanceProfileRoles(cr.Spec.ForProvider, *rsp.InstanceProfile)
+	if err := e.removeRoles(ctx, meta.GetExternalName(cr), remove); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	for _, r := range add {
+		if _, err := e.client.AddRoleToInstanceProfileRequest(&awsiam.AddRoleToInstanceProfileInput{
+			InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+			RoleName:            aws.String(r),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddRole)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.InstanceProfile)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	// An instance profile cannot be deleted while it contains a role.
+	rsp, err := e.client.GetInstanceProfileRequest(&awsiam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+	if rsp.InstanceProfile != nil {
+		_, remove := iam.DiffInstanceProfileRoles(v1beta1.InstanceProfileParameters{}, *rsp.InstanceProfile)
+		if err := e.removeRoles(ctx, meta.GetExternalName(cr), remove); err != nil {
+			return err
+		}
+	}
+
+	_, err = e.client.DeleteInstanceProfileRequest(&awsiam.DeleteInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+func (e *external) removeRoles(ctx context.Context, name string, roles []string) error {
+	for _, r := range roles {
+		_, err := e.client.RemoveRoleFromInstanceProfileRequest(&awsiam.RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: aws.String(name),
+			RoleName:            aws.String(r),
+		}).Send(ctx)
+		if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return errors.Wrap(err, errRemoveRole)
+		}
+	}
+	return nil
+}
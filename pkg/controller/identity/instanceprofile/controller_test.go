@@ -0,0 +1,476 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceprofile
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	profileName = "web"
+	profileARN  = "arn:aws:iam::123456789012:instance-profile/web"
+	profileID   = "AIPAEXAMPLE"
+	roleName    = "web-role"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam  iam.InstanceProfileClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type profileModifier func(*v1beta1.InstanceProfile)
+
+func withConditions(c ...runtimev1alpha1.Condition) profileModifier {
+	return func(r *v1beta1.InstanceProfile) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRoleName(n *string) profileModifier {
+	return func(r *v1beta1.InstanceProfile) { r.Spec.ForProvider.RoleName = n }
+}
+
+func withPath(p *string) profileModifier {
+	return func(r *v1beta1.InstanceProfile) { r.Spec.ForProvider.Path = p }
+}
+
+func withObservation() profileModifier {
+	return func(r *v1beta1.InstanceProfile) {
+		r.Status.AtProvider = v1beta1.InstanceProfileObservation{ARN: profileARN, InstanceProfileID: profileID}
+	}
+}
+
+func instanceProfile(m ...profileModifier) *v1beta1.InstanceProfile {
+	cr := &v1beta1.InstanceProfile{
+		Spec: v1beta1.InstanceProfileSpec{
+			ForProvider: v1beta1.InstanceProfileParameters{
+				Path:     aws.String("/"),
+				RoleName: aws.String(roleName),
+			},
+		},
+	}
+	meta.SetExternalName(cr, profileName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getInstanceProfile(roles ...string) func(*awsiam.GetInstanceProfileInput) awsiam.GetInstanceProfileRequest {
+	return func(*awsiam.GetInstanceProfileInput) awsiam.GetInstanceProfileRequest {
+		p := &awsiam.InstanceProfile{
+			Arn:                 aws.String(profileARN),
+			InstanceProfileId:   aws.String(profileID),
+			InstanceProfileName: aws.String(profileName),
+			Path:                aws.String("/"),
+		}
+		for _, r := range roles {
+			p.Roles = append(p.Roles, awsiam.Role{RoleName: aws.String(r)})
+		}
+		return awsiam.GetInstanceProfileRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetInstanceProfileOutput{InstanceProfile: p}},
+		}
+	}
+}
+
+func removeRole(t *testing.T, want string) func(*awsiam.RemoveRoleFromInstanceProfileInput) awsiam.RemoveRoleFromInstanceProfileRequest {
+	return func(input *awsiam.RemoveRoleFromInstanceProfileInput) awsiam.RemoveRoleFromInstanceProfileRequest {
+		if diff := cmp.Diff(aws.String(want), input.RoleName); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		return awsiam.RemoveRoleFromInstanceProfileRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.RemoveRoleFromInstanceProfileOutput{}},
+		}
+	}
+}
+
+func addRole(*awsiam.AddRoleToInstanceProfileInput) awsiam.AddRoleToInstanceProfileRequest {
+	return awsiam.AddRoleToInstanceProfileRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.AddRoleToInstanceProfileOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: getInstanceProfile(roleName),
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr: instanceProfile(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: getInstanceProfile(roleName),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   instanceProfile(withPath(nil)),
+			},
+			want: want{
+				cr: instanceProfile(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: getInstanceProfile("old-role"),
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr: instanceProfile(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: func(*awsiam.GetInstanceProfileInput) awsiam.GetInstanceProfileRequest {
+						return awsiam.GetInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsiam.ErrCodeNoSuchEntityException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr: instanceProfile(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: func(*awsiam.GetInstanceProfileInput) awsiam.GetInstanceProfileRequest {
+						return awsiam.GetInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr:  instanceProfile(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	createInstanceProfile := func(*awsiam.CreateInstanceProfileInput) awsiam.CreateInstanceProfileRequest {
+		return awsiam.CreateInstanceProfileRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateInstanceProfileOutput{}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockCreateInstanceProfileRequest:    createInstanceProfile,
+					MockAddRoleToInstanceProfileRequest: addRole,
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr: instanceProfile(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"NoRole": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockCreateInstanceProfileRequest: createInstanceProfile,
+				},
+				cr: instanceProfile(withRoleName(nil)),
+			},
+			want: want{
+				cr: instanceProfile(withRoleName(nil), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockCreateInstanceProfileRequest: func(*awsiam.CreateInstanceProfileInput) awsiam.CreateInstanceProfileRequest {
+						return awsiam.CreateInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr:  instanceProfile(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplaceRole": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest:            getInstanceProfile("old-role"),
+					MockRemoveRoleFromInstanceProfileRequest: removeRole(t, "old-role"),
+					MockAddRoleToInstanceProfileRequest:      addRole,
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr: instanceProfile(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: getInstanceProfile(),
+					MockAddRoleToInstanceProfileRequest: func(*awsiam.AddRoleToInstanceProfileInput) awsiam.AddRoleToInstanceProfileRequest {
+						return awsiam.AddRoleToInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr:  instanceProfile(),
+				err: errors.Wrap(errBoom, errAddRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteInstanceProfile := func(*awsiam.DeleteInstanceProfileInput) awsiam.DeleteInstanceProfileRequest {
+		return awsiam.DeleteInstanceProfileRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteInstanceProfileOutput{}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest:            getInstanceProfile(roleName),
+					MockRemoveRoleFromInstanceProfileRequest: removeRole(t, roleName),
+					MockDeleteInstanceProfileRequest:         deleteInstanceProfile,
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr: instanceProfile(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: func(*awsiam.GetInstanceProfileInput) awsiam.GetInstanceProfileRequest {
+						return awsiam.GetInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsiam.ErrCodeNoSuchEntityException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr: instanceProfile(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfileRequest: getInstanceProfile(),
+					MockDeleteInstanceProfileRequest: func(*awsiam.DeleteInstanceProfileInput) awsiam.DeleteInstanceProfileRequest {
+						return awsiam.DeleteInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr:  instanceProfile(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openidconnectprovider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an OpenIDConnectProvider resource"
+
+	errGet            = "failed to get the OpenID Connect provider"
+	errThumbprint     = "failed to compute the thumbprint of the OpenID Connect provider"
+	errCreate         = "failed to create the OpenID Connect provider"
+	errUpdate         = "failed to update the thumbprints of the OpenID Connect provider"
+	errAddClientID    = "failed to add a client ID to the OpenID Connect provider"
+	errRemoveClientID = "failed to remove a client ID from the OpenID Connect provider"
+	errDelete         = "failed to delete the OpenID Connect provider"
+	errSpecUpdate     = "cannot update spec of the OpenIDConnectProvider custom resource"
+)
+
+// SetupOpenIDConnectProvider adds a controller that reconciles IAM OpenID
+// Connect providers.
+func SetupOpenIDConnectProvider(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.OpenIDConnectProviderGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.OpenIDConnectProvider{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.OpenIDConnectProviderGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: iam.NewOpenIDConnectProviderClient}))),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.OpenIDConnectProviderClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, awsclients.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, thumbprint: iam.Thumbprint}, nil
+}
+
+type external struct {
+	client     iam.OpenIDConnectProviderClient
+	kube       client.Client
+	thumbprint func(url string) (string, error)
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.OpenIDConnectProvider)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	rsp, err := e.client.GetOpenIDConnectProviderRequest(&awsiam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	iam.LateInitializeOpenIDConnectProvider(&cr.Spec.ForProvider, *rsp.GetOpenIDConnectProviderOutput)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = iam.GenerateOpenIDConnectProviderObservation(meta.GetExternalName(cr), *rsp.GetOpenIDConnectProviderOutput)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: iam.IsOpenIDConnectProviderUpToDate(cr.Spec.ForProvider, *rsp.GetOpenIDConnectProviderOutput),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.OpenIDConnectProvider)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	// NOTE: The computed thumbprint is late initialized into the spec once
+	// the provider is observed.
+	thumbprints := cr.Spec.ForProvider.ThumbprintList
+	if len(thumbprints) == 0 {
+		t, err := e.thumbprint(cr.Spec.ForProvider.URL)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errThumbprint)
+		}
+		thumbprints = []string{t}
+	}
+
+	rsp, err := e.client.CreateOpenIDConnectProviderRequest(&awsiam.CreateOpenIDConnectProviderInput{
+		Url:            aws.String(cr.Spec.ForProvider.URL),
+		ClientIDList:   cr.Spec.ForProvider.ClientIDList,
+		ThumbprintList: thumbprints,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.OpenIDConnectProviderArn))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.OpenIDConnectProvider)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := aws.String(meta.GetExternalName(cr))
+	rsp, err := e.client.GetOpenIDConnectProviderRequest(&awsiam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: arn,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGet)
+	}
+
+	if !iam.IsThumbprintListUpToDate(cr.Spec.ForProvider.ThumbprintList, rsp.ThumbprintList) {
+		if _, err := e.client.UpdateOpenIDConnectProviderThumbprintRequest(&awsiam.UpdateOpenIDConnectProviderThumbprintInput{
+			OpenIDConnectProviderArn: arn,
+			ThumbprintList:           cr.Spec.ForProvider.ThumbprintList,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	add, remove := iam.DiffClientIDs(cr.Spec.ForProvider.ClientIDList, rsp.ClientIDList)
+	for _, id := range add {
+		if _, err := e.client.AddClientIDToOpenIDConnectProviderRequest(&awsiam.AddClientIDToOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: arn,
+			ClientID:                 aws.String(id),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddClientID)
+		}
+	}
+	for _, id := range remove {
+		if _, err := e.client.RemoveClientIDFromOpenIDConnectProviderRequest(&awsiam.RemoveClientIDFromOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: arn,
+			ClientID:                 aws.String(id),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveClientID)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.OpenIDConnectProvider)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteOpenIDConnectProviderRequest(&awsiam.DeleteOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
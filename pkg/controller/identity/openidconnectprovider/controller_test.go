@@ -0,0 +1,490 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openidconnectprovider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	providerARN = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"
+	issuerURL   = "https://oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"
+	clientID    = "sts.amazonaws.com"
+	thumbprint  = "9e99a48a9960b14926bb7f3b02e22da2b0ab7280"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam        iam.OpenIDConnectProviderClient
+	kube       *test.MockClient
+	thumbprint func(string) (string, error)
+	cr         resource.Managed
+}
+
+type providerModifier func(*v1alpha1.OpenIDConnectProvider)
+
+func withConditions(c ...runtimev1alpha1.Condition) providerModifier {
+	return func(r *v1alpha1.OpenIDConnectProvider) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) providerModifier {
+	return func(r *v1alpha1.OpenIDConnectProvider) { meta.SetExternalName(r, n) }
+}
+
+func withClientIDList(l ...string) providerModifier {
+	return func(r *v1alpha1.OpenIDConnectProvider) { r.Spec.ForProvider.ClientIDList = l }
+}
+
+func withThumbprintList(l ...string) providerModifier {
+	return func(r *v1alpha1.OpenIDConnectProvider) { r.Spec.ForProvider.ThumbprintList = l }
+}
+
+func withObservation() providerModifier {
+	return func(r *v1alpha1.OpenIDConnectProvider) {
+		r.Status.AtProvider = v1alpha1.OpenIDConnectProviderObservation{ARN: providerARN}
+	}
+}
+
+func provider(m ...providerModifier) *v1alpha1.OpenIDConnectProvider {
+	cr := &v1alpha1.OpenIDConnectProvider{
+		Spec: v1alpha1.OpenIDConnectProviderSpec{
+			ForProvider: v1alpha1.OpenIDConnectProviderParameters{
+				URL:            issuerURL,
+				ClientIDList:   []string{clientID},
+				ThumbprintList: []string{thumbprint},
+			},
+		},
+	}
+	meta.SetExternalName(cr, providerARN)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getProvider(*awsiam.GetOpenIDConnectProviderInput) awsiam.GetOpenIDConnectProviderRequest {
+	return awsiam.GetOpenIDConnectProviderRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetOpenIDConnectProviderOutput{
+			Url:            aws.String(issuerURL),
+			ClientIDList:   []string{clientID},
+			ThumbprintList: []string{thumbprint},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProviderRequest: getProvider,
+				},
+				cr: provider(),
+			},
+			want: want{
+				cr: provider(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProviderRequest: getProvider,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   provider(withThumbprintList()),
+			},
+			want: want{
+				cr: provider(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProviderRequest: getProvider,
+				},
+				cr: provider(withClientIDList(clientID, "other")),
+			},
+			want: want{
+				cr: provider(withClientIDList(clientID, "other"), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: provider(withExternalName("")),
+			},
+			want: want{
+				cr: provider(withExternalName("")),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProviderRequest: func(*awsiam.GetOpenIDConnectProviderInput) awsiam.GetOpenIDConnectProviderRequest {
+						return awsiam.GetOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsiam.ErrCodeNoSuchEntityException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: provider(),
+			},
+			want: want{
+				cr: provider(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProviderRequest: func(*awsiam.GetOpenIDConnectProviderInput) awsiam.GetOpenIDConnectProviderRequest {
+						return awsiam.GetOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: provider(),
+			},
+			want: want{
+				cr:  provider(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube, thumbprint: tc.thumbprint}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	createProvider := func(input *awsiam.CreateOpenIDConnectProviderInput) awsiam.CreateOpenIDConnectProviderRequest {
+		if diff := cmp.Diff([]string{thumbprint}, input.ThumbprintList); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		return awsiam.CreateOpenIDConnectProviderRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateOpenIDConnectProviderOutput{
+				OpenIDConnectProviderArn: aws.String(providerARN),
+			}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockCreateOpenIDConnectProviderRequest: createProvider,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   provider(withExternalName("")),
+			},
+			want: want{
+				cr: provider(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ComputedThumbprint": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockCreateOpenIDConnectProviderRequest: createProvider,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				thumbprint: func(url string) (string, error) {
+					if diff := cmp.Diff(issuerURL, url); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return thumbprint, nil
+				},
+				cr: provider(withExternalName(""), withThumbprintList()),
+			},
+			want: want{
+				cr: provider(withThumbprintList(), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ThumbprintError": {
+			args: args{
+				thumbprint: func(string) (string, error) { return "", errBoom },
+				cr:         provider(withExternalName(""), withThumbprintList()),
+			},
+			want: want{
+				cr:  provider(withExternalName(""), withThumbprintList(), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errThumbprint),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockCreateOpenIDConnectProviderRequest: func(*awsiam.CreateOpenIDConnectProviderInput) awsiam.CreateOpenIDConnectProviderRequest {
+						return awsiam.CreateOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: provider(withExternalName("")),
+			},
+			want: want{
+				cr:  provider(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube, thumbprint: tc.thumbprint}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProviderRequest: getProvider,
+					MockUpdateOpenIDConnectProviderThumbprintRequest: func(input *awsiam.UpdateOpenIDConnectProviderThumbprintInput) awsiam.UpdateOpenIDConnectProviderThumbprintRequest {
+						if diff := cmp.Diff([]string{"a031c46782e6e6c662c2c87c76da9aa62ccabd8e"}, input.ThumbprintList); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.UpdateOpenIDConnectProviderThumbprintRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateOpenIDConnectProviderThumbprintOutput{}},
+						}
+					},
+					MockAddClientIDToOpenIDConnectProviderRequest: func(input *awsiam.AddClientIDToOpenIDConnectProviderInput) awsiam.AddClientIDToOpenIDConnectProviderRequest {
+						if diff := cmp.Diff(aws.String("new"), input.ClientID); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.AddClientIDToOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.AddClientIDToOpenIDConnectProviderOutput{}},
+						}
+					},
+					MockRemoveClientIDFromOpenIDConnectProviderRequest: func(input *awsiam.RemoveClientIDFromOpenIDConnectProviderInput) awsiam.RemoveClientIDFromOpenIDConnectProviderRequest {
+						if diff := cmp.Diff(aws.String(clientID), input.ClientID); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.RemoveClientIDFromOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.RemoveClientIDFromOpenIDConnectProviderOutput{}},
+						}
+					},
+				},
+				cr: provider(withClientIDList("new"), withThumbprintList("a031c46782e6e6c662c2c87c76da9aa62ccabd8e")),
+			},
+			want: want{
+				cr: provider(withClientIDList("new"), withThumbprintList("a031c46782e6e6c662c2c87c76da9aa62ccabd8e")),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProviderRequest: getProvider,
+					MockAddClientIDToOpenIDConnectProviderRequest: func(*awsiam.AddClientIDToOpenIDConnectProviderInput) awsiam.AddClientIDToOpenIDConnectProviderRequest {
+						return awsiam.AddClientIDToOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: provider(withClientIDList(clientID, "new")),
+			},
+			want: want{
+				cr:  provider(withClientIDList(clientID, "new")),
+				err: errors.Wrap(errBoom, errAddClientID),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube, thumbprint: tc.thumbprint}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockDeleteOpenIDConnectProviderRequest: func(*awsiam.DeleteOpenIDConnectProviderInput) awsiam.DeleteOpenIDConnectProviderRequest {
+						return awsiam.DeleteOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteOpenIDConnectProviderOutput{}},
+						}
+					},
+				},
+				cr: provider(),
+			},
+			want: want{
+				cr: provider(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockDeleteOpenIDConnectProviderRequest: func(*awsiam.DeleteOpenIDConnectProviderInput) awsiam.DeleteOpenIDConnectProviderRequest {
+						return awsiam.DeleteOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: provider(),
+			},
+			want: want{
+				cr:  provider(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube, thumbprint: tc.thumbprint}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,425 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package samlprovider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	providerARN = "arn:aws:iam::123456789012:saml-provider/corporate-idp"
+	document    = "<EntityDescriptor/>"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam  iam.SAMLProviderClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type providerModifier func(*v1alpha1.SAMLProvider)
+
+func withConditions(c ...runtimev1alpha1.Condition) providerModifier {
+	return func(r *v1alpha1.SAMLProvider) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) providerModifier {
+	return func(r *v1alpha1.SAMLProvider) { meta.SetExternalName(r, n) }
+}
+
+func withDocument(d *string) providerModifier {
+	return func(r *v1alpha1.SAMLProvider) { r.Spec.ForProvider.SAMLMetadataDocument = d }
+}
+
+func withObservation() providerModifier {
+	return func(r *v1alpha1.SAMLProvider) {
+		r.Status.AtProvider = v1alpha1.SAMLProviderObservation{ARN: providerARN}
+	}
+}
+
+func samlProvider(m ...providerModifier) *v1alpha1.SAMLProvider {
+	cr := &v1alpha1.SAMLProvider{
+		Spec: v1alpha1.SAMLProviderSpec{
+			ForProvider: v1alpha1.SAMLProviderParameters{
+				Name:                 "corporate-idp",
+				SAMLMetadataDocument: aws.String(document),
+			},
+		},
+	}
+	meta.SetExternalName(cr, providerARN)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getSAMLProvider(*awsiam.GetSAMLProviderInput) awsiam.GetSAMLProviderRequest {
+	return awsiam.GetSAMLProviderRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetSAMLProviderOutput{
+			SAMLMetadataDocument: aws.String(document + "\n"),
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProviderRequest: getSAMLProvider,
+				},
+				cr: samlProvider(),
+			},
+			want: want{
+				cr: samlProvider(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProviderRequest: getSAMLProvider,
+				},
+				cr: samlProvider(withDocument(aws.String("<EntityDescriptor entityID=\"new\"/>"))),
+			},
+			want: want{
+				cr: samlProvider(withDocument(aws.String("<EntityDescriptor entityID=\"new\"/>")), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: samlProvider(withExternalName("")),
+			},
+			want: want{
+				cr: samlProvider(withExternalName("")),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProviderRequest: func(*awsiam.GetSAMLProviderInput) awsiam.GetSAMLProviderRequest {
+						return awsiam.GetSAMLProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsiam.ErrCodeNoSuchEntityException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: samlProvider(),
+			},
+			want: want{
+				cr: samlProvider(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProviderRequest: func(*awsiam.GetSAMLProviderInput) awsiam.GetSAMLProviderRequest {
+						return awsiam.GetSAMLProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: samlProvider(),
+			},
+			want: want{
+				cr:  samlProvider(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockCreateSAMLProviderRequest: func(input *awsiam.CreateSAMLProviderInput) awsiam.CreateSAMLProviderRequest {
+						if diff := cmp.Diff(aws.String(document), input.SAMLMetadataDocument); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.CreateSAMLProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateSAMLProviderOutput{
+								SAMLProviderArn: aws.String(providerARN),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   samlProvider(withExternalName("")),
+			},
+			want: want{
+				cr: samlProvider(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"NoDocument": {
+			args: args{
+				cr: samlProvider(withExternalName(""), withDocument(nil)),
+			},
+			want: want{
+				cr:  samlProvider(withExternalName(""), withDocument(nil), withConditions(runtimev1alpha1.Creating())),
+				err: errors.New("one of samlMetadataDocument, samlMetadataDocumentSecretRef or samlMetadataDocumentConfigMapRef must be set"),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockCreateSAMLProviderRequest: func(*awsiam.CreateSAMLProviderInput) awsiam.CreateSAMLProviderRequest {
+						return awsiam.CreateSAMLProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: samlProvider(withExternalName("")),
+			},
+			want: want{
+				cr:  samlProvider(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockUpdateSAMLProviderRequest: func(input *awsiam.UpdateSAMLProviderInput) awsiam.UpdateSAMLProviderRequest {
+						if diff := cmp.Diff(aws.String(providerARN), input.SAMLProviderArn); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.UpdateSAMLProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateSAMLProviderOutput{}},
+						}
+					},
+				},
+				cr: samlProvider(),
+			},
+			want: want{
+				cr: samlProvider(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockUpdateSAMLProviderRequest: func(*awsiam.UpdateSAMLProviderInput) awsiam.UpdateSAMLProviderRequest {
+						return awsiam.UpdateSAMLProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: samlProvider(),
+			},
+			want: want{
+				cr:  samlProvider(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockDeleteSAMLProviderRequest: func(*awsiam.DeleteSAMLProviderInput) awsiam.DeleteSAMLProviderRequest {
+						return awsiam.DeleteSAMLProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteSAMLProviderOutput{}},
+						}
+					},
+				},
+				cr: samlProvider(),
+			},
+			want: want{
+				cr: samlProvider(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockDeleteSAMLProviderRequest: func(*awsiam.DeleteSAMLProviderInput) awsiam.DeleteSAMLProviderRequest {
+						return awsiam.DeleteSAMLProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: samlProvider(),
+			},
+			want: want{
+				cr:  samlProvider(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalakesettings
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslakeformation "github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lakeformation"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Lake Formation DataLakeSettings resource"
+
+	errDescribe = "failed to describe the DataLakeSettings resource"
+	errPut      = "failed to put the DataLakeSettings resource"
+	errReset    = "failed to reset the DataLakeSettings resource to its defaults"
+)
+
+// SetupDataLakeSettings adds a controller that reconciles DataLakeSettings.
+func SetupDataLakeSettings(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DataLakeSettingsGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.DataLakeSettings{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DataLakeSettingsGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: lakeformation.NewDataLakeSettingsClient}, awsclients.DeletionTierAttachment), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) lakeformation.DataLakeSettingsClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DataLakeSettings)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client lakeformation.DataLakeSettingsClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.DataLakeSettings)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.GetDataLakeSettingsRequest(&awslakeformation.GetDataLakeSettingsInput{
+		CatalogId: cr.Spec.ForProvider.CatalogID,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+	observed := awslakeformation.DataLakeSettings{}
+	if rsp.DataLakeSettings != nil {
+		observed = *rsp.DataLakeSettings
+	}
+
+	// The settings of a Data Catalog always exist. They are considered gone
+	// once they have been reset to the defaults during deletion.
+	if meta.WasDeleted(cr) && lakeformation.IsDataLakeSettingsUpToDate(lakeformation.DefaultDataLakeSettingsParameters(), observed) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: lakeformation.IsDataLakeSettingsUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.DataLakeSettings)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.PutDataLakeSettingsRequest(&awslakeformation.PutDataLakeSettingsInput{
+		CatalogId:        cr.Spec.ForProvider.CatalogID,
+		DataLakeSettings: lakeformation.GenerateDataLakeSettings(cr.Spec.ForProvider),
+	}).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errPut)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.DataLakeSettings)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	_, err := e.client.PutDataLakeSettingsRequest(&awslakeformation.PutDataLakeSettingsInput{
+		CatalogId:        cr.Spec.ForProvider.CatalogID,
+		DataLakeSettings: lakeformation.GenerateDataLakeSettings(cr.Spec.ForProvider),
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errPut)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.DataLakeSettings)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.PutDataLakeSettingsRequest(&awslakeformation.PutDataLakeSettingsInput{
+		CatalogId:        cr.Spec.ForProvider.CatalogID,
+		DataLakeSettings: lakeformation.GenerateDataLakeSettings(lakeformation.DefaultDataLakeSettingsParameters()),
+	}).Send(ctx)
+	return errors.Wrap(err, errReset)
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalakesettings
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslakeformation "github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/lakeformation"
+	"github.com/crossplane/provider-aws/pkg/clients/lakeformation/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	adminARN = "arn:aws:iam::123456789012:role/lake-admin"
+	deleted  = metav1.Now()
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	lakeformation lakeformation.DataLakeSettingsClient
+	cr            resource.Managed
+}
+
+type settingsModifier func(*v1alpha1.DataLakeSettings)
+
+func withConditions(c ...runtimev1alpha1.Condition) settingsModifier {
+	return func(r *v1alpha1.DataLakeSettings) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDeletionTimestamp(t metav1.Time) settingsModifier {
+	return func(r *v1alpha1.DataLakeSettings) { r.SetDeletionTimestamp(&t) }
+}
+
+func dataLakeSettings(m ...settingsModifier) *v1alpha1.DataLakeSettings {
+	cr := &v1alpha1.DataLakeSettings{
+		Spec: v1alpha1.DataLakeSettingsSpec{
+			ForProvider: v1alpha1.DataLakeSettingsParameters{
+				DataLakeAdmins: []string{adminARN},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getSettings(s *awslakeformation.DataLakeSettings) func(*awslakeformation.GetDataLakeSettingsInput) awslakeformation.GetDataLakeSettingsRequest {
+	return func(*awslakeformation.GetDataLakeSettingsInput) awslakeformation.GetDataLakeSettingsRequest {
+		return awslakeformation.GetDataLakeSettingsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslakeformation.GetDataLakeSettingsOutput{DataLakeSettings: s}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				lakeformation: &fake.MockDataLakeSettingsClient{
+					MockGetDataLakeSettings: getSettings(lakeformation.GenerateDataLakeSettings(dataLakeSettings().Spec.ForProvider)),
+				},
+				cr: dataLakeSettings(),
+			},
+			want: want{
+				cr:     dataLakeSettings(withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Defaults": {
+			args: args{
+				lakeformation: &fake.MockDataLakeSettingsClient{
+					MockGetDataLakeSettings: getSettings(lakeformation.GenerateDataLakeSettings(lakeformation.DefaultDataLakeSettingsParameters())),
+				},
+				cr: dataLakeSettings(),
+			},
+			want: want{
+				cr:     dataLakeSettings(withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ResetAfterDeletion": {
+			args: args{
+				lakeformation: &fake.MockDataLakeSettingsClient{
+					MockGetDataLakeSettings: getSettings(lakeformation.GenerateDataLakeSettings(lakeformation.DefaultDataLakeSettingsParameters())),
+				},
+				cr: dataLakeSettings(withDeletionTimestamp(deleted)),
+			},
+			want: want{
+				cr: dataLakeSettings(withDeletionTimestamp(deleted)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lakeformation: &fake.MockDataLakeSettingsClient{
+					MockGetDataLakeSettings: func(*awslakeformation.GetDataLakeSettingsInput) awslakeformation.GetDataLakeSettingsRequest {
+						return awslakeformation.GetDataLakeSettingsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dataLakeSettings(),
+			},
+			want: want{
+				cr:  dataLakeSettings(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lakeformation}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lakeformation: &fake.MockDataLakeSettingsClient{
+					MockPutDataLakeSettings: func(in *awslakeformation.PutDataLakeSettingsInput) awslakeformation.PutDataLakeSettingsRequest {
+						if diff := cmp.Diff(adminARN, aws.StringValue(in.DataLakeSettings.DataLakeAdmins[0].DataLakePrincipalIdentifier)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awslakeformation.PutDataLakeSettingsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslakeformation.PutDataLakeSettingsOutput{}},
+						}
+					},
+				},
+				cr: dataLakeSettings(),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lakeformation: &fake.MockDataLakeSettingsClient{
+					MockPutDataLakeSettings: func(*awslakeformation.PutDataLakeSettingsInput) awslakeformation.PutDataLakeSettingsRequest {
+						return awslakeformation.PutDataLakeSettingsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dataLakeSettings(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lakeformation}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lakeformation: &fake.MockDataLakeSettingsClient{
+					MockPutDataLakeSettings: func(in *awslakeformation.PutDataLakeSettingsInput) awslakeformation.PutDataLakeSettingsRequest {
+						if !lakeformation.IsDataLakeSettingsUpToDate(lakeformation.DefaultDataLakeSettingsParameters(), *in.DataLakeSettings) {
+							t.Errorf("settings are not reset to the defaults")
+						}
+						return awslakeformation.PutDataLakeSettingsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslakeformation.PutDataLakeSettingsOutput{}},
+						}
+					},
+				},
+				cr: dataLakeSettings(),
+			},
+			want: want{
+				cr: dataLakeSettings(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lakeformation: &fake.MockDataLakeSettingsClient{
+					MockPutDataLakeSettings: func(*awslakeformation.PutDataLakeSettingsInput) awslakeformation.PutDataLakeSettingsRequest {
+						return awslakeformation.PutDataLakeSettingsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dataLakeSettings(),
+			},
+			want: want{
+				cr:  dataLakeSettings(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errReset),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lakeformation}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
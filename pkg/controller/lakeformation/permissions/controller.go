@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissions
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslakeformation "github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lakeformation"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Lake Formation LakeFormationPermissions resource"
+
+	errDescribe = "failed to list the LakeFormationPermissions resource"
+	errGrant    = "failed to grant the LakeFormationPermissions resource"
+	errRevoke   = "failed to revoke the LakeFormationPermissions resource"
+)
+
+// SetupLakeFormationPermissions adds a controller that reconciles
+// LakeFormationPermissions.
+func SetupLakeFormationPermissions(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.LakeFormationPermissionsGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LakeFormationPermissions{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LakeFormationPermissionsGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: lakeformation.NewPermissionsClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) lakeformation.PermissionsClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LakeFormationPermissions)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client lakeformation.PermissionsClient
+}
+
+// observe returns the permissions and permissions with grant option that
+// the principal currently has on the resource.
+func (e *external) observe(ctx context.Context, p v1alpha1.LakeFormationPermissionsParameters) (permissions, withGrantOption []awslakeformation.Permission, err error) {
+	var all []awslakeformation.PrincipalResourcePermissions
+	in := &awslakeformation.ListPermissionsInput{
+		CatalogId: p.CatalogID,
+		Principal: lakeformation.GeneratePrincipal(p),
+		Resource:  lakeformation.GenerateResource(p.Resource),
+	}
+	for {
+		rsp, err := e.client.ListPermissionsRequest(in).Send(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		all = append(all, rsp.PrincipalResourcePermissions...)
+		if aws.StringValue(rsp.NextToken) == "" {
+			break
+		}
+		in.NextToken = rsp.NextToken
+	}
+	permissions, withGrantOption = lakeformation.ObservedPermissions(all)
+	return permissions, withGrantOption, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.LakeFormationPermissions)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	permissions, withGrantOption, err := e.observe(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(lakeformation.IsNotFound, err), errDescribe)
+	}
+	if len(permissions) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: lakeformation.ArePermissionsUpToDate(cr.Spec.ForProvider, permissions, withGrantOption),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.LakeFormationPermissions)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.GrantPermissionsRequest(lakeformation.GenerateGrantPermissionsInput(cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errGrant)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.LakeFormationPermissions)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	permissions, withGrantOption, err := e.observe(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if in := lakeformation.GenerateRevokePermissionsInput(cr.Spec.ForProvider, permissions, withGrantOption); in != nil {
+		if _, err := e.client.RevokePermissionsRequest(in).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRevoke)
+		}
+	}
+	_, err = e.client.GrantPermissionsRequest(lakeformation.GenerateGrantPermissionsInput(cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errGrant)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.LakeFormationPermissions)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	permissions, withGrantOption, err := e.observe(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(lakeformation.IsNotFound, err), errDescribe)
+	}
+	in := lakeformation.GenerateRevokeDeclaredPermissionsInput(cr.Spec.ForProvider, permissions, withGrantOption)
+	if in == nil {
+		return nil
+	}
+	_, err = e.client.RevokePermissionsRequest(in).Send(ctx)
+	return errors.Wrap(resource.Ignore(lakeformation.IsNotFound, err), errRevoke)
+}
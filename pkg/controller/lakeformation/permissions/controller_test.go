@@ -0,0 +1,402 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissions
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awslakeformation "github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/lakeformation"
+	"github.com/crossplane/provider-aws/pkg/clients/lakeformation/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	principal = "arn:aws:iam::123456789012:role/analyst"
+
+	sel   = awslakeformation.PermissionSelect
+	alter = awslakeformation.PermissionAlter
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awslakeformation.ErrCodeEntityNotFoundException, "Table clicks not found", nil)
+)
+
+type args struct {
+	lakeformation lakeformation.PermissionsClient
+	cr            resource.Managed
+}
+
+type permissionsModifier func(*v1alpha1.LakeFormationPermissions)
+
+func withConditions(c ...runtimev1alpha1.Condition) permissionsModifier {
+	return func(r *v1alpha1.LakeFormationPermissions) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func permissions(m ...permissionsModifier) *v1alpha1.LakeFormationPermissions {
+	cr := &v1alpha1.LakeFormationPermissions{
+		Spec: v1alpha1.LakeFormationPermissionsSpec{
+			ForProvider: v1alpha1.LakeFormationPermissionsParameters{
+				Principal: aws.String(principal),
+				Resource: v1alpha1.PermissionsResource{
+					Table: &v1alpha1.TableResource{DatabaseName: aws.String("events"), Name: "clicks"},
+				},
+				Permissions: []v1alpha1.Permission{"SELECT"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listPermissions(perms ...awslakeformation.Permission) func(*awslakeformation.ListPermissionsInput) awslakeformation.ListPermissionsRequest {
+	return func(*awslakeformation.ListPermissionsInput) awslakeformation.ListPermissionsRequest {
+		out := &awslakeformation.ListPermissionsOutput{}
+		if len(perms) != 0 {
+			out.PrincipalResourcePermissions = []awslakeformation.PrincipalResourcePermissions{{Permissions: perms}}
+		}
+		return awslakeformation.ListPermissionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				lakeformation: &fake.MockPermissionsClient{MockListPermissions: listPermissions(sel)},
+				cr:            permissions(),
+			},
+			want: want{
+				cr:     permissions(withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ExtraPermission": {
+			args: args{
+				lakeformation: &fake.MockPermissionsClient{MockListPermissions: listPermissions(sel, alter)},
+				cr:            permissions(),
+			},
+			want: want{
+				cr:     permissions(withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NotGranted": {
+			args: args{
+				lakeformation: &fake.MockPermissionsClient{MockListPermissions: listPermissions()},
+				cr:            permissions(),
+			},
+			want: want{
+				cr: permissions(),
+			},
+		},
+		"ResourceNotFound": {
+			args: args{
+				lakeformation: &fake.MockPermissionsClient{
+					MockListPermissions: func(*awslakeformation.ListPermissionsInput) awslakeformation.ListPermissionsRequest {
+						return awslakeformation.ListPermissionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr: permissions(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lakeformation: &fake.MockPermissionsClient{
+					MockListPermissions: func(*awslakeformation.ListPermissionsInput) awslakeformation.ListPermissionsRequest {
+						return awslakeformation.ListPermissionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr:  permissions(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lakeformation}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lakeformation: &fake.MockPermissionsClient{
+					MockGrantPermissions: func(in *awslakeformation.GrantPermissionsInput) awslakeformation.GrantPermissionsRequest {
+						if diff := cmp.Diff([]awslakeformation.Permission{sel}, in.Permissions); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awslakeformation.GrantPermissionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslakeformation.GrantPermissionsOutput{}},
+						}
+					},
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr: permissions(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lakeformation: &fake.MockPermissionsClient{
+					MockGrantPermissions: func(*awslakeformation.GrantPermissionsInput) awslakeformation.GrantPermissionsRequest {
+						return awslakeformation.GrantPermissionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr:  permissions(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errGrant),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lakeformation}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	grant := func(*awslakeformation.GrantPermissionsInput) awslakeformation.GrantPermissionsRequest {
+		return awslakeformation.GrantPermissionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslakeformation.GrantPermissionsOutput{}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RevokeExtra": {
+			args: args{
+				lakeformation: &fake.MockPermissionsClient{
+					MockListPermissions: listPermissions(sel, alter),
+					MockRevokePermissions: func(in *awslakeformation.RevokePermissionsInput) awslakeformation.RevokePermissionsRequest {
+						if diff := cmp.Diff([]awslakeformation.Permission{alter}, in.Permissions); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awslakeformation.RevokePermissionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslakeformation.RevokePermissionsOutput{}},
+						}
+					},
+					MockGrantPermissions: grant,
+				},
+				cr: permissions(),
+			},
+		},
+		"GrantMissing": {
+			args: args{
+				lakeformation: &fake.MockPermissionsClient{
+					MockListPermissions:  listPermissions(alter),
+					MockGrantPermissions: grant,
+				},
+				cr: permissions(func(r *v1alpha1.LakeFormationPermissions) {
+					r.Spec.ForProvider.Permissions = []v1alpha1.Permission{"SELECT", "ALTER"}
+				}),
+			},
+		},
+		"RevokeError": {
+			args: args{
+				lakeformation: &fake.MockPermissionsClient{
+					MockListPermissions: listPermissions(sel, alter),
+					MockRevokePermissions: func(*awslakeformation.RevokePermissionsInput) awslakeformation.RevokePermissionsRequest {
+						return awslakeformation.RevokePermissionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: permissions(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errRevoke),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lakeformation}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lakeformation: &fake.MockPermissionsClient{
+					MockListPermissions: listPermissions(sel, alter),
+					MockRevokePermissions: func(in *awslakeformation.RevokePermissionsInput) awslakeformation.RevokePermissionsRequest {
+						if diff := cmp.Diff([]awslakeformation.Permission{sel}, in.Permissions); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awslakeformation.RevokePermissionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslakeformation.RevokePermissionsOutput{}},
+						}
+					},
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr: permissions(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"OnlyUndeclaredGranted": {
+			args: args{
+				lakeformation: &fake.MockPermissionsClient{MockListPermissions: listPermissions(alter)},
+				cr:            permissions(),
+			},
+			want: want{
+				cr: permissions(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyRevoked": {
+			args: args{
+				lakeformation: &fake.MockPermissionsClient{MockListPermissions: listPermissions()},
+				cr:            permissions(),
+			},
+			want: want{
+				cr: permissions(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lakeformation: &fake.MockPermissionsClient{
+					MockListPermissions: listPermissions(sel),
+					MockRevokePermissions: func(*awslakeformation.RevokePermissionsInput) awslakeformation.RevokePermissionsRequest {
+						return awslakeformation.RevokePermissionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr:  permissions(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errRevoke),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lakeformation}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslightsail "github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/lightsail/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lightsail"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Lightsail Database resource"
+
+	errDescribe   = "failed to describe the Database resource"
+	errCreate     = "failed to create the Database resource"
+	errUpdate     = "failed to update the Database resource"
+	errDelete     = "failed to delete the Database resource"
+	errSpecUpdate = "cannot update spec of Database custom resource"
+	errPassword   = "cannot get the master password of the Database resource"
+)
+
+// SetupDatabase adds a controller that reconciles Databases.
+func SetupDatabase(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DatabaseGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Database{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DatabaseGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: lightsail.NewDatabaseClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) lightsail.DatabaseClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Database)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client lightsail.DatabaseClient
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.Database) (*awslightsail.RelationalDatabase, error) {
+	rsp, err := e.client.GetRelationalDatabaseRequest(&awslightsail.GetRelationalDatabaseInput{
+		RelationalDatabaseName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rsp.RelationalDatabase == nil {
+		return nil, errors.New(errDescribe)
+	}
+	return rsp.RelationalDatabase, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Database)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(lightsail.IsNotFound, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	lightsail.LateInitializeDatabase(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = lightsail.GenerateDatabaseObservation(*observed)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.DatabaseStateAvailable, v1alpha1.DatabaseStateBackingUp:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.DatabaseStateCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha1.DatabaseStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	_, pwdChanged, err := lightsail.GetPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errPassword)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  !pwdChanged && lightsail.IsDatabaseUpToDate(cr.Spec.ForProvider, *observed),
+		ConnectionDetails: lightsail.GetDatabaseConnectionDetails(*cr),
+	}, nil
+}
+
+// Create creates the database with the referenced or a generated master
+// password.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Database)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	pw, _, err := lightsail.GetPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPassword)
+	}
+	if pw == "" {
+		pw, err = password.Generate()
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errPassword)
+		}
+	}
+	if _, err := e.client.CreateRelationalDatabaseRequest(lightsail.GenerateCreateRelationalDatabaseInput(meta.GetExternalName(cr), pw, cr.Spec.ForProvider)).Send(ctx); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(cr.Spec.ForProvider.MasterUsername),
+			runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, nil
+}
+
+// Update updates the modifiable settings and the master password of the
+// database. The database can only be updated while it is available.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Database)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Status.AtProvider.State != v1alpha1.DatabaseStateAvailable {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	pw, pwdChanged, err := lightsail.GetPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPassword)
+	}
+	if !pwdChanged && lightsail.IsDatabaseUpToDate(cr.Spec.ForProvider, *observed) {
+		return managed.ExternalUpdate{}, nil
+	}
+	in := lightsail.GenerateUpdateRelationalDatabaseInput(meta.GetExternalName(cr), cr.Spec.ForProvider, *observed)
+	if pwdChanged {
+		in.MasterUserPassword = aws.String(pw)
+	}
+	if _, err := e.client.UpdateRelationalDatabaseRequest(in).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+	if !pwdChanged {
+		return managed.ExternalUpdate{}, nil
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{
+			runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Database)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.State == v1alpha1.DatabaseStateDeleting {
+		return nil
+	}
+	_, err := e.client.DeleteRelationalDatabaseRequest(lightsail.GenerateDeleteRelationalDatabaseInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return errors.Wrap(resource.Ignore(lightsail.IsNotFound, err), errDelete)
+}
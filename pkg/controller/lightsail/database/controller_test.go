@@ -0,0 +1,529 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awslightsail "github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lightsail/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/lightsail"
+	"github.com/crossplane/provider-aws/pkg/clients/lightsail/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	databaseName = "dev-db"
+	databaseARN  = "arn:aws:lightsail:us-east-1:123456789012:RelationalDatabase/dev-db"
+	endpoint     = "ls-example.us-east-1.rds.amazonaws.com"
+	username     = "dbadmin"
+	pwd          = "some-password"
+	backupWindow = "16:00-16:30"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awslightsail.ErrCodeNotFoundException, "", nil)
+)
+
+type args struct {
+	lightsail lightsail.DatabaseClient
+	kube      *test.MockClient
+	cr        resource.Managed
+}
+
+type databaseModifier func(*v1alpha1.Database)
+
+func withConditions(c ...runtimev1alpha1.Condition) databaseModifier {
+	return func(r *v1alpha1.Database) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withState(s string) databaseModifier {
+	return func(r *v1alpha1.Database) { r.Status.AtProvider.State = s }
+}
+
+func withObservation(state string) databaseModifier {
+	return func(r *v1alpha1.Database) {
+		r.Status.AtProvider = v1alpha1.DatabaseObservation{
+			ARN:           databaseARN,
+			State:         state,
+			Engine:        "mysql",
+			EngineVersion: "8.0.20",
+			Endpoint:      endpoint,
+			Port:          3306,
+		}
+	}
+}
+
+func withBackupWindow(s *string) databaseModifier {
+	return func(r *v1alpha1.Database) { r.Spec.ForProvider.PreferredBackupWindow = s }
+}
+
+func withPubliclyAccessible(b bool) databaseModifier {
+	return func(r *v1alpha1.Database) { r.Spec.ForProvider.PubliclyAccessible = aws.Bool(b) }
+}
+
+// withPassword references the master password secret, and the connection
+// secret that holds the current password.
+func withPassword() databaseModifier {
+	return func(r *v1alpha1.Database) {
+		r.Spec.ForProvider.MasterUserPasswordSecretRef = &runtimev1alpha1.SecretKeySelector{
+			SecretReference: runtimev1alpha1.SecretReference{Name: "password", Namespace: "default"},
+			Key:             "password",
+		}
+		r.Spec.WriteConnectionSecretToReference = &runtimev1alpha1.SecretReference{Name: "connection", Namespace: "default"}
+	}
+}
+
+// getSecrets returns the referenced password, and the given password as the
+// one of the connection secret.
+func getSecrets(current string) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+		s := obj.(*corev1.Secret)
+		if key.Name == "connection" {
+			s.Data = map[string][]byte{runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(current)}
+			return nil
+		}
+		s.Data = map[string][]byte{"password": []byte(pwd)}
+		return nil
+	}
+}
+
+func database(m ...databaseModifier) *v1alpha1.Database {
+	cr := &v1alpha1.Database{
+		Spec: v1alpha1.DatabaseSpec{
+			ForProvider: v1alpha1.DatabaseParameters{
+				Region:                     "us-east-1",
+				AvailabilityZone:           aws.String("us-east-1a"),
+				BlueprintID:                "mysql_8_0",
+				BundleID:                   "micro_2_0",
+				MasterDatabaseName:         "app",
+				MasterUsername:             username,
+				PreferredBackupWindow:      aws.String(backupWindow),
+				PreferredMaintenanceWindow: aws.String("Tue:17:00-Tue:17:30"),
+				PubliclyAccessible:         aws.Bool(false),
+				BackupRetentionEnabled:     aws.Bool(true),
+			},
+		},
+	}
+	meta.SetExternalName(cr, databaseName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+		runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte("3306"),
+		runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(username),
+	}
+}
+
+func getDatabase(state string, err error) func(*awslightsail.GetRelationalDatabaseInput) awslightsail.GetRelationalDatabaseRequest {
+	return func(*awslightsail.GetRelationalDatabaseInput) awslightsail.GetRelationalDatabaseRequest {
+		return awslightsail.GetRelationalDatabaseRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslightsail.GetRelationalDatabaseOutput{
+				RelationalDatabase: &awslightsail.RelationalDatabase{
+					Arn:                        aws.String(databaseARN),
+					Name:                       aws.String(databaseName),
+					State:                      aws.String(state),
+					Engine:                     aws.String("mysql"),
+					EngineVersion:              aws.String("8.0.20"),
+					Location:                   &awslightsail.ResourceLocation{AvailabilityZone: aws.String("us-east-1a")},
+					MasterEndpoint:             &awslightsail.RelationalDatabaseEndpoint{Address: aws.String(endpoint), Port: aws.Int64(3306)},
+					PreferredBackupWindow:      aws.String(backupWindow),
+					PreferredMaintenanceWindow: aws.String("Tue:17:00-Tue:17:30"),
+					PubliclyAccessible:         aws.Bool(false),
+					BackupRetentionEnabled:     aws.Bool(true),
+				},
+			}, Error: err},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{MockGetRelationalDatabase: getDatabase(v1alpha1.DatabaseStateAvailable, nil)},
+				cr:        database(),
+			},
+			want: want{
+				cr:     database(withObservation(v1alpha1.DatabaseStateAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"Creating": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{MockGetRelationalDatabase: getDatabase(v1alpha1.DatabaseStateCreating, nil)},
+				cr:        database(),
+			},
+			want: want{
+				cr:     database(withObservation(v1alpha1.DatabaseStateCreating), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{MockGetRelationalDatabase: getDatabase(v1alpha1.DatabaseStateAvailable, nil)},
+				kube:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:        database(withBackupWindow(nil)),
+			},
+			want: want{
+				cr:     database(withObservation(v1alpha1.DatabaseStateAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{MockGetRelationalDatabase: getDatabase(v1alpha1.DatabaseStateAvailable, nil)},
+				cr:        database(withPubliclyAccessible(true)),
+			},
+			want: want{
+				cr: database(withPubliclyAccessible(true),
+					withObservation(v1alpha1.DatabaseStateAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"PasswordChanged": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{MockGetRelationalDatabase: getDatabase(v1alpha1.DatabaseStateAvailable, nil)},
+				kube:      &test.MockClient{MockGet: getSecrets("old-password")},
+				cr:        database(withPassword()),
+			},
+			want: want{
+				cr:     database(withPassword(), withObservation(v1alpha1.DatabaseStateAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"NotFound": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{MockGetRelationalDatabase: getDatabase("", errNotFound)},
+				cr:        database(),
+			},
+			want: want{
+				cr: database(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{MockGetRelationalDatabase: getDatabase("", errBoom)},
+				cr:        database(),
+			},
+			want: want{
+				cr:  database(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lightsail}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr      resource.Managed
+		details managed.ConnectionDetails
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"CreateWithReferencedPassword": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{
+					MockCreateRelationalDatabase: func(in *awslightsail.CreateRelationalDatabaseInput) awslightsail.CreateRelationalDatabaseRequest {
+						if diff := cmp.Diff(pwd, aws.StringValue(in.MasterUserPassword)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awslightsail.CreateRelationalDatabaseRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslightsail.CreateRelationalDatabaseOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: getSecrets("")},
+				cr:   database(withPassword()),
+			},
+			want: want{
+				cr: database(withPassword(), withConditions(runtimev1alpha1.Creating())),
+				details: managed.ConnectionDetails{
+					runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(username),
+					runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pwd),
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{
+					MockCreateRelationalDatabase: func(*awslightsail.CreateRelationalDatabaseInput) awslightsail.CreateRelationalDatabaseRequest {
+						return awslightsail.CreateRelationalDatabaseRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: database(),
+			},
+			want: want{
+				cr:  database(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lightsail}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.details != nil {
+				if diff := cmp.Diff(tc.want.details, o.ConnectionDetails); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	update := func(check func(*awslightsail.UpdateRelationalDatabaseInput), err error) func(*awslightsail.UpdateRelationalDatabaseInput) awslightsail.UpdateRelationalDatabaseRequest {
+		return func(in *awslightsail.UpdateRelationalDatabaseInput) awslightsail.UpdateRelationalDatabaseRequest {
+			check(in)
+			return awslightsail.UpdateRelationalDatabaseRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslightsail.UpdateRelationalDatabaseOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Update": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{
+					MockGetRelationalDatabase: getDatabase(v1alpha1.DatabaseStateAvailable, nil),
+					MockUpdateRelationalDatabase: update(func(in *awslightsail.UpdateRelationalDatabaseInput) {
+						if diff := cmp.Diff(aws.Bool(true), in.PubliclyAccessible); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+					}, nil),
+				},
+				cr: database(withPubliclyAccessible(true), withState(v1alpha1.DatabaseStateAvailable)),
+			},
+		},
+		"UpdatePassword": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{
+					MockGetRelationalDatabase: getDatabase(v1alpha1.DatabaseStateAvailable, nil),
+					MockUpdateRelationalDatabase: update(func(in *awslightsail.UpdateRelationalDatabaseInput) {
+						if diff := cmp.Diff(pwd, aws.StringValue(in.MasterUserPassword)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+					}, nil),
+				},
+				kube: &test.MockClient{MockGet: getSecrets("old-password")},
+				cr:   database(withPassword(), withState(v1alpha1.DatabaseStateAvailable)),
+			},
+			want: want{
+				result: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pwd),
+					},
+				},
+			},
+		},
+		"NotAvailable": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{},
+				cr:        database(withPubliclyAccessible(true), withState(v1alpha1.DatabaseStateBackingUp)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{
+					MockGetRelationalDatabase:    getDatabase(v1alpha1.DatabaseStateAvailable, nil),
+					MockUpdateRelationalDatabase: update(func(*awslightsail.UpdateRelationalDatabaseInput) {}, errBoom),
+				},
+				cr: database(withPubliclyAccessible(true), withState(v1alpha1.DatabaseStateAvailable)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lightsail}
+			o, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteDatabase := func(err error) func(*awslightsail.DeleteRelationalDatabaseInput) awslightsail.DeleteRelationalDatabaseRequest {
+		return func(*awslightsail.DeleteRelationalDatabaseInput) awslightsail.DeleteRelationalDatabaseRequest {
+			return awslightsail.DeleteRelationalDatabaseRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslightsail.DeleteRelationalDatabaseOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{MockDeleteRelationalDatabase: deleteDatabase(nil)},
+				cr:        database(),
+			},
+			want: want{
+				cr: database(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: database(withState(v1alpha1.DatabaseStateDeleting)),
+			},
+			want: want{
+				cr: database(withState(v1alpha1.DatabaseStateDeleting), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{MockDeleteRelationalDatabase: deleteDatabase(errNotFound)},
+				cr:        database(),
+			},
+			want: want{
+				cr: database(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lightsail: &fake.MockDatabaseClient{MockDeleteRelationalDatabase: deleteDatabase(errBoom)},
+				cr:        database(),
+			},
+			want: want{
+				cr:  database(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lightsail}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awslightsail "github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lightsail/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/lightsail"
+	"github.com/crossplane/provider-aws/pkg/clients/lightsail/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	instanceName = "dev-box"
+	instanceARN  = "arn:aws:lightsail:us-east-1:123456789012:Instance/dev-box"
+	keyPairName  = "dev-key"
+	publicIP     = "203.0.113.10"
+	privateIP    = "172.26.0.10"
+	username     = "ec2-user"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awslightsail.ErrCodeNotFoundException, "", nil)
+)
+
+type args struct {
+	lightsail lightsail.InstanceClient
+	kube      *test.MockClient
+	cr        resource.Managed
+}
+
+type instanceModifier func(*v1alpha1.Instance)
+
+func withConditions(c ...runtimev1alpha1.Condition) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withKeyPairName(s *string) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Spec.ForProvider.KeyPairName = s }
+}
+
+func withObservation(state string) instanceModifier {
+	return func(r *v1alpha1.Instance) {
+		r.Status.AtProvider = v1alpha1.InstanceObservation{
+			ARN:              instanceARN,
+			State:            state,
+			PublicIPAddress:  publicIP,
+			PrivateIPAddress: privateIP,
+			Username:         username,
+		}
+	}
+}
+
+func instance(m ...instanceModifier) *v1alpha1.Instance {
+	cr := &v1alpha1.Instance{
+		Spec: v1alpha1.InstanceSpec{
+			ForProvider: v1alpha1.InstanceParameters{
+				Region:           "us-east-1",
+				AvailabilityZone: "us-east-1a",
+				BlueprintID:      "amazon_linux_2",
+				BundleID:         "nano_2_0",
+				KeyPairName:      aws.String(keyPairName),
+			},
+		},
+	}
+	meta.SetExternalName(cr, instanceName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(publicIP),
+		runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(username),
+	}
+}
+
+func getInstance(state string, err error) func(*awslightsail.GetInstanceInput) awslightsail.GetInstanceRequest {
+	return func(*awslightsail.GetInstanceInput) awslightsail.GetInstanceRequest {
+		return awslightsail.GetInstanceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslightsail.GetInstanceOutput{
+				Instance: &awslightsail.Instance{
+					Arn:              aws.String(instanceARN),
+					Name:             aws.String(instanceName),
+					SshKeyName:       aws.String(keyPairName),
+					PublicIpAddress:  aws.String(publicIP),
+					PrivateIpAddress: aws.String(privateIP),
+					Username:         aws.String(username),
+					State:            &awslightsail.InstanceState{Name: aws.String(state)},
+				},
+			}, Error: err},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				lightsail: &fake.MockInstanceClient{MockGetInstance: getInstance(v1alpha1.InstanceStateRunning, nil)},
+				cr:        instance(),
+			},
+			want: want{
+				cr:     instance(withObservation(v1alpha1.InstanceStateRunning), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"Creating": {
+			args: args{
+				lightsail: &fake.MockInstanceClient{MockGetInstance: getInstance(v1alpha1.InstanceStatePending, nil)},
+				cr:        instance(),
+			},
+			want: want{
+				cr:     instance(withObservation(v1alpha1.InstanceStatePending), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"Stopped": {
+			args: args{
+				lightsail: &fake.MockInstanceClient{MockGetInstance: getInstance(v1alpha1.InstanceStateStopped, nil)},
+				cr:        instance(),
+			},
+			want: want{
+				cr:     instance(withObservation(v1alpha1.InstanceStateStopped), withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				lightsail: &fake.MockInstanceClient{MockGetInstance: getInstance(v1alpha1.InstanceStateRunning, nil)},
+				kube:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:        instance(withKeyPairName(nil)),
+			},
+			want: want{
+				cr:     instance(withObservation(v1alpha1.InstanceStateRunning), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"NotFound": {
+			args: args{
+				lightsail: &fake.MockInstanceClient{MockGetInstance: getInstance("", errNotFound)},
+				cr:        instance(),
+			},
+			want: want{
+				cr: instance(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lightsail: &fake.MockInstanceClient{MockGetInstance: getInstance("", errBoom)},
+				cr:        instance(),
+			},
+			want: want{
+				cr:  instance(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lightsail}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lightsail: &fake.MockInstanceClient{
+					MockCreateInstances: func(in *awslightsail.CreateInstancesInput) awslightsail.CreateInstancesRequest {
+						if diff := cmp.Diff([]string{instanceName}, in.InstanceNames); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awslightsail.CreateInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslightsail.CreateInstancesOutput{}},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lightsail: &fake.MockInstanceClient{
+					MockCreateInstances: func(*awslightsail.CreateInstancesInput) awslightsail.CreateInstancesRequest {
+						return awslightsail.CreateInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lightsail}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteInstance := func(err error) func(*awslightsail.DeleteInstanceInput) awslightsail.DeleteInstanceRequest {
+		return func(*awslightsail.DeleteInstanceInput) awslightsail.DeleteInstanceRequest {
+			return awslightsail.DeleteInstanceRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslightsail.DeleteInstanceOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lightsail: &fake.MockInstanceClient{MockDeleteInstance: deleteInstance(nil)},
+				cr:        instance(),
+			},
+			want: want{
+				cr: instance(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: instance(withObservation(v1alpha1.InstanceStateShuttingDown)),
+			},
+			want: want{
+				cr: instance(withObservation(v1alpha1.InstanceStateShuttingDown), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				lightsail: &fake.MockInstanceClient{MockDeleteInstance: deleteInstance(errNotFound)},
+				cr:        instance(),
+			},
+			want: want{
+				cr: instance(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lightsail: &fake.MockInstanceClient{MockDeleteInstance: deleteInstance(errBoom)},
+				cr:        instance(),
+			},
+			want: want{
+				cr:  instance(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lightsail}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package staticip
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslightsail "github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/lightsail/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lightsail"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Lightsail StaticIP resource"
+
+	errDescribe = "failed to describe the StaticIP resource"
+	errCreate   = "failed to allocate the StaticIP resource"
+	errAttach   = "failed to attach the StaticIP resource"
+	errDetach   = "failed to detach the StaticIP resource"
+	errDelete   = "failed to release the StaticIP resource"
+)
+
+// SetupStaticIP adds a controller that reconciles StaticIPs.
+func SetupStaticIP(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.StaticIPGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.StaticIP{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.StaticIPGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: lightsail.NewStaticIPClient}, awsclients.DeletionTierAttachment), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) lightsail.StaticIPClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.StaticIP)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client lightsail.StaticIPClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.StaticIP)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.GetStaticIpRequest(&awslightsail.GetStaticIpInput{
+		StaticIpName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(lightsail.IsNotFound, err), errDescribe)
+	}
+	if rsp.StaticIp == nil {
+		return managed.ExternalObservation{}, errors.New(errDescribe)
+	}
+
+	cr.Status.AtProvider = lightsail.GenerateStaticIPObservation(*rsp.StaticIp)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: lightsail.IsStaticIPUpToDate(cr.Spec.ForProvider, *rsp.StaticIp),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.StaticIP)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.AllocateStaticIpRequest(&awslightsail.AllocateStaticIpInput{
+		StaticIpName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+// Update attaches the static IP to the desired instance, or detaches it if
+// there is none. Attaching it to another instance moves it there.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.StaticIP)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	name := aws.String(meta.GetExternalName(cr))
+	if cr.Spec.ForProvider.InstanceName == nil {
+		_, err := e.client.DetachStaticIpRequest(&awslightsail.DetachStaticIpInput{StaticIpName: name}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDetach)
+	}
+	_, err := e.client.AttachStaticIpRequest(&awslightsail.AttachStaticIpInput{
+		StaticIpName: name,
+		InstanceName: cr.Spec.ForProvider.InstanceName,
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errAttach)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.StaticIP)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.ReleaseStaticIpRequest(&awslightsail.ReleaseStaticIpInput{
+		StaticIpName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(lightsail.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,397 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package staticip
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awslightsail "github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lightsail/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/lightsail"
+	"github.com/crossplane/provider-aws/pkg/clients/lightsail/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	staticIPName = "dev-ip"
+	staticIPARN  = "arn:aws:lightsail:us-east-1:123456789012:StaticIp/dev-ip"
+	ipAddress    = "203.0.113.10"
+	instanceName = "dev-box"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awslightsail.ErrCodeNotFoundException, "", nil)
+)
+
+type args struct {
+	lightsail lightsail.StaticIPClient
+	cr        resource.Managed
+}
+
+type staticIPModifier func(*v1alpha1.StaticIP)
+
+func withConditions(c ...runtimev1alpha1.Condition) staticIPModifier {
+	return func(r *v1alpha1.StaticIP) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withInstanceName(s *string) staticIPModifier {
+	return func(r *v1alpha1.StaticIP) { r.Spec.ForProvider.InstanceName = s }
+}
+
+func withObservation(attachedTo string) staticIPModifier {
+	return func(r *v1alpha1.StaticIP) {
+		r.Status.AtProvider = v1alpha1.StaticIPObservation{ARN: staticIPARN, IPAddress: ipAddress, AttachedTo: attachedTo}
+	}
+}
+
+func staticIP(m ...staticIPModifier) *v1alpha1.StaticIP {
+	cr := &v1alpha1.StaticIP{
+		Spec: v1alpha1.StaticIPSpec{
+			ForProvider: v1alpha1.StaticIPParameters{
+				Region:       "us-east-1",
+				InstanceName: aws.String(instanceName),
+			},
+		},
+	}
+	meta.SetExternalName(cr, staticIPName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getStaticIP(attachedTo string, err error) func(*awslightsail.GetStaticIpInput) awslightsail.GetStaticIpRequest {
+	return func(*awslightsail.GetStaticIpInput) awslightsail.GetStaticIpRequest {
+		return awslightsail.GetStaticIpRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslightsail.GetStaticIpOutput{
+				StaticIp: &awslightsail.StaticIp{
+					Arn:        aws.String(staticIPARN),
+					Name:       aws.String(staticIPName),
+					IpAddress:  aws.String(ipAddress),
+					IsAttached: aws.Bool(attachedTo != ""),
+					AttachedTo: aws.String(attachedTo),
+				},
+			}, Error: err},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Attached": {
+			args: args{
+				lightsail: &fake.MockStaticIPClient{MockGetStaticIp: getStaticIP(instanceName, nil)},
+				cr:        staticIP(),
+			},
+			want: want{
+				cr:     staticIP(withObservation(instanceName), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsAttach": {
+			args: args{
+				lightsail: &fake.MockStaticIPClient{MockGetStaticIp: getStaticIP("", nil)},
+				cr:        staticIP(),
+			},
+			want: want{
+				cr:     staticIP(withObservation(""), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NeedsDetach": {
+			args: args{
+				lightsail: &fake.MockStaticIPClient{MockGetStaticIp: getStaticIP(instanceName, nil)},
+				cr:        staticIP(withInstanceName(nil)),
+			},
+			want: want{
+				cr:     staticIP(withInstanceName(nil), withObservation(instanceName), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				lightsail: &fake.MockStaticIPClient{MockGetStaticIp: getStaticIP("", errNotFound)},
+				cr:        staticIP(),
+			},
+			want: want{
+				cr: staticIP(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lightsail: &fake.MockStaticIPClient{MockGetStaticIp: getStaticIP("", errBoom)},
+				cr:        staticIP(),
+			},
+			want: want{
+				cr:  staticIP(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lightsail}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	allocate := func(err error) func(*awslightsail.AllocateStaticIpInput) awslightsail.AllocateStaticIpRequest {
+		return func(in *awslightsail.AllocateStaticIpInput) awslightsail.AllocateStaticIpRequest {
+			if diff := cmp.Diff(staticIPName, aws.StringValue(in.StaticIpName)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awslightsail.AllocateStaticIpRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslightsail.AllocateStaticIpOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lightsail: &fake.MockStaticIPClient{MockAllocateStaticIp: allocate(nil)},
+				cr:        staticIP(),
+			},
+			want: want{
+				cr: staticIP(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lightsail: &fake.MockStaticIPClient{MockAllocateStaticIp: allocate(errBoom)},
+				cr:        staticIP(),
+			},
+			want: want{
+				cr:  staticIP(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lightsail}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	attach := func(err error) func(*awslightsail.AttachStaticIpInput) awslightsail.AttachStaticIpRequest {
+		return func(in *awslightsail.AttachStaticIpInput) awslightsail.AttachStaticIpRequest {
+			if diff := cmp.Diff(instanceName, aws.StringValue(in.InstanceName)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awslightsail.AttachStaticIpRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslightsail.AttachStaticIpOutput{}, Error: err},
+			}
+		}
+	}
+	detach := func(err error) func(*awslightsail.DetachStaticIpInput) awslightsail.DetachStaticIpRequest {
+		return func(*awslightsail.DetachStaticIpInput) awslightsail.DetachStaticIpRequest {
+			return awslightsail.DetachStaticIpRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslightsail.DetachStaticIpOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Attach": {
+			args: args{
+				lightsail: &fake.MockStaticIPClient{MockAttachStaticIp: attach(nil)},
+				cr:        staticIP(),
+			},
+		},
+		"Detach": {
+			args: args{
+				lightsail: &fake.MockStaticIPClient{MockDetachStaticIp: detach(nil)},
+				cr:        staticIP(withInstanceName(nil)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"AttachError": {
+			args: args{
+				lightsail: &fake.MockStaticIPClient{MockAttachStaticIp: attach(errBoom)},
+				cr:        staticIP(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errAttach),
+			},
+		},
+		"DetachError": {
+			args: args{
+				lightsail: &fake.MockStaticIPClient{MockDetachStaticIp: detach(errBoom)},
+				cr:        staticIP(withInstanceName(nil)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDetach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lightsail}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	release := func(err error) func(*awslightsail.ReleaseStaticIpInput) awslightsail.ReleaseStaticIpRequest {
+		return func(*awslightsail.ReleaseStaticIpInput) awslightsail.ReleaseStaticIpRequest {
+			return awslightsail.ReleaseStaticIpRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslightsail.ReleaseStaticIpOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lightsail: &fake.MockStaticIPClient{MockReleaseStaticIp: release(nil)},
+				cr:        staticIP(),
+			},
+			want: want{
+				cr: staticIP(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				lightsail: &fake.MockStaticIPClient{MockReleaseStaticIp: release(errNotFound)},
+				cr:        staticIP(),
+			},
+			want: want{
+				cr: staticIP(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				lightsail: &fake.MockStaticIPClient{MockReleaseStaticIp: release(errBoom)},
+				cr:        staticIP(),
+			},
+			want: want{
+				cr:  staticIP(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lightsail}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsmacie2 "github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/macie2"
+	"github.com/crossplane/provider-aws/pkg/clients/macie2/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	serviceRole = "arn:aws:iam::123456789012:role/aws-service-role/macie.amazonaws.com/AWSServiceRoleForAmazonMacie"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsmacie2.ErrCodeAccessDeniedException, "Macie is not enabled", nil)
+)
+
+type args struct {
+	macie2 macie2.AccountClient
+	kube   *test.MockClient
+	cr     resource.Managed
+}
+
+type accountModifier func(*v1alpha1.Account)
+
+func withConditions(c ...runtimev1alpha1.Condition) accountModifier {
+	return func(r *v1alpha1.Account) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withServiceRole(s string) accountModifier {
+	return func(r *v1alpha1.Account) { r.Status.AtProvider.ServiceRole = s }
+}
+
+func withFrequency(f *string) accountModifier {
+	return func(r *v1alpha1.Account) { r.Spec.ForProvider.FindingPublishingFrequency = f }
+}
+
+func withStatus(s *string) accountModifier {
+	return func(r *v1alpha1.Account) { r.Spec.ForProvider.Status = s }
+}
+
+func account(m ...accountModifier) *v1alpha1.Account {
+	cr := &v1alpha1.Account{
+		Spec: v1alpha1.AccountSpec{
+			ForProvider: v1alpha1.AccountParameters{
+				Region:                     "us-east-1",
+				FindingPublishingFrequency: aws.String(string(awsmacie2.FindingPublishingFrequencyOneHour)),
+				Status:                     aws.String(string(awsmacie2.MacieStatusEnabled)),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getMacieSession(err error) func(*awsmacie2.GetMacieSessionInput) awsmacie2.GetMacieSessionRequest {
+	return func(*awsmacie2.GetMacieSessionInput) awsmacie2.GetMacieSessionRequest {
+		return awsmacie2.GetMacieSessionRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.GetMacieSessionOutput{
+				FindingPublishingFrequency: awsmacie2.FindingPublishingFrequencyOneHour,
+				ServiceRole:                aws.String(serviceRole),
+				Status:                     awsmacie2.MacieStatusEnabled,
+			}, Error: err},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				macie2: &fake.MockAccountClient{MockGetMacieSession: getMacieSession(nil)},
+				cr:     account(),
+			},
+			want: want{
+				cr:     account(withServiceRole(serviceRole), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				macie2: &fake.MockAccountClient{MockGetMacieSession: getMacieSession(nil)},
+				kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:     account(withFrequency(nil), withStatus(nil)),
+			},
+			want: want{
+				cr:     account(withServiceRole(serviceRole), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsPause": {
+			args: args{
+				macie2: &fake.MockAccountClient{MockGetMacieSession: getMacieSession(nil)},
+				cr:     account(withStatus(aws.String(string(awsmacie2.MacieStatusPaused)))),
+			},
+			want: want{
+				cr: account(withStatus(aws.String(string(awsmacie2.MacieStatusPaused))),
+					withServiceRole(serviceRole), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NotEnabled": {
+			args: args{
+				macie2: &fake.MockAccountClient{MockGetMacieSession: getMacieSession(errNotFound)},
+				cr:     account(),
+			},
+			want: want{
+				cr: account(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				macie2: &fake.MockAccountClient{MockGetMacieSession: getMacieSession(errBoom)},
+				cr:     account(),
+			},
+			want: want{
+				cr:  account(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.macie2}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				macie2: &fake.MockAccountClient{
+					MockEnableMacie: func(in *awsmacie2.EnableMacieInput) awsmacie2.EnableMacieRequest {
+						if diff := cmp.Diff(awsmacie2.FindingPublishingFrequencyOneHour, in.FindingPublishingFrequency); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsmacie2.EnableMacieRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.EnableMacieOutput{}},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr: account(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				macie2: &fake.MockAccountClient{
+					MockEnableMacie: func(*awsmacie2.EnableMacieInput) awsmacie2.EnableMacieRequest {
+						return awsmacie2.EnableMacieRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr:  account(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.macie2}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Pause": {
+			args: args{
+				macie2: &fake.MockAccountClient{
+					MockUpdateMacieSession: func(in *awsmacie2.UpdateMacieSessionInput) awsmacie2.UpdateMacieSessionRequest {
+						if diff := cmp.Diff(awsmacie2.MacieStatusPaused, in.Status); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsmacie2.UpdateMacieSessionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.UpdateMacieSessionOutput{}},
+						}
+					},
+				},
+				cr: account(withStatus(aws.String(string(awsmacie2.MacieStatusPaused)))),
+			},
+		},
+		"ClientError": {
+			args: args{
+				macie2: &fake.MockAccountClient{
+					MockUpdateMacieSession: func(*awsmacie2.UpdateMacieSessionInput) awsmacie2.UpdateMacieSessionRequest {
+						return awsmacie2.UpdateMacieSessionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.macie2}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	disableMacie := func(err error) func(*awsmacie2.DisableMacieInput) awsmacie2.DisableMacieRequest {
+		return func(*awsmacie2.DisableMacieInput) awsmacie2.DisableMacieRequest {
+			return awsmacie2.DisableMacieRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.DisableMacieOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				macie2: &fake.MockAccountClient{MockDisableMacie: disableMacie(nil)},
+				cr:     account(),
+			},
+			want: want{
+				cr: account(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDisabled": {
+			args: args{
+				macie2: &fake.MockAccountClient{MockDisableMacie: disableMacie(errNotFound)},
+				cr:     account(),
+			},
+			want: want{
+				cr: account(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				macie2: &fake.MockAccountClient{MockDisableMacie: disableMacie(errBoom)},
+				cr:     account(),
+			},
+			want: want{
+				cr:  account(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.macie2}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,377 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classificationjob
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmacie2 "github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/macie2"
+	"github.com/crossplane/provider-aws/pkg/clients/macie2/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	jobName = "weekly-data-lake-scan"
+	jobID   = "3c9d0a9ad4e2d4b1e7f5a2c8b6d0e1f2"
+	jobARN  = "arn:aws:macie2:us-east-1:123456789012:classification-job/3c9d0a9ad4e2d4b1e7f5a2c8b6d0e1f2"
+	uid     = types.UID("f2c3b9f4-b1e0-4c5d-9a34-0c2f4b8e4f4b")
+	now     = metav1.Now()
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	macie2 macie2.ClassificationJobClient
+	kube   *test.MockClient
+	cr     resource.Managed
+}
+
+type jobModifier func(*v1alpha1.ClassificationJob)
+
+func withConditions(c ...runtimev1alpha1.Condition) jobModifier {
+	return func(r *v1alpha1.ClassificationJob) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) jobModifier {
+	return func(r *v1alpha1.ClassificationJob) { meta.SetExternalName(r, n) }
+}
+
+func withObservation(s awsmacie2.JobStatus) jobModifier {
+	return func(r *v1alpha1.ClassificationJob) {
+		r.Status.AtProvider = v1alpha1.ClassificationJobObservation{JobARN: jobARN, JobStatus: string(s)}
+	}
+}
+
+func withPaused(p bool) jobModifier {
+	return func(r *v1alpha1.ClassificationJob) { r.Spec.ForProvider.Paused = aws.Bool(p) }
+}
+
+func withDeletionTimestamp() jobModifier {
+	return func(r *v1alpha1.ClassificationJob) { r.SetDeletionTimestamp(&now) }
+}
+
+func job(m ...jobModifier) *v1alpha1.ClassificationJob {
+	cr := &v1alpha1.ClassificationJob{
+		ObjectMeta: metav1.ObjectMeta{Name: jobName, UID: uid},
+		Spec: v1alpha1.ClassificationJobSpec{
+			ForProvider: v1alpha1.ClassificationJobParameters{
+				Region:  "us-east-1",
+				JobType: string(awsmacie2.JobTypeOneTime),
+				BucketDefinitions: []v1alpha1.S3BucketDefinition{{
+					AccountID: "123456789012",
+					Buckets:   []string{"data-lake"},
+				}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeJob(s awsmacie2.JobStatus, err error) func(*awsmacie2.DescribeClassificationJobInput) awsmacie2.DescribeClassificationJobRequest {
+	return func(*awsmacie2.DescribeClassificationJobInput) awsmacie2.DescribeClassificationJobRequest {
+		return awsmacie2.DescribeClassificationJobRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.DescribeClassificationJobOutput{
+				JobArn:    aws.String(jobARN),
+				JobId:     aws.String(jobID),
+				JobStatus: s,
+			}, Error: err},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Running": {
+			args: args{
+				macie2: &fake.MockClassificationJobClient{MockDescribeClassificationJob: describeJob(awsmacie2.JobStatusRunning, nil)},
+				cr:     job(withExternalName(jobID)),
+			},
+			want: want{
+				cr:     job(withExternalName(jobID), withObservation(awsmacie2.JobStatusRunning), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsPause": {
+			args: args{
+				macie2: &fake.MockClassificationJobClient{MockDescribeClassificationJob: describeJob(awsmacie2.JobStatusIdle, nil)},
+				cr:     job(withExternalName(jobID), withPaused(true)),
+			},
+			want: want{
+				cr: job(withExternalName(jobID), withPaused(true), withObservation(awsmacie2.JobStatusIdle),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"Cancelled": {
+			args: args{
+				macie2: &fake.MockClassificationJobClient{MockDescribeClassificationJob: describeJob(awsmacie2.JobStatusCancelled, nil)},
+				cr:     job(withExternalName(jobID)),
+			},
+			want: want{
+				cr:     job(withExternalName(jobID), withObservation(awsmacie2.JobStatusCancelled), withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"CompleteAndDeleted": {
+			args: args{
+				macie2: &fake.MockClassificationJobClient{MockDescribeClassificationJob: describeJob(awsmacie2.JobStatusComplete, nil)},
+				cr:     job(withExternalName(jobID), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: job(withExternalName(jobID), withDeletionTimestamp(), withObservation(awsmacie2.JobStatusComplete)),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: job(),
+			},
+			want: want{
+				cr: job(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				macie2: &fake.MockClassificationJobClient{MockDescribeClassificationJob: describeJob("", errBoom)},
+				cr:     job(withExternalName(jobID)),
+			},
+			want: want{
+				cr:  job(withExternalName(jobID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.macie2}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				macie2: &fake.MockClassificationJobClient{
+					MockCreateClassificationJob: func(in *awsmacie2.CreateClassificationJobInput) awsmacie2.CreateClassificationJobRequest {
+						if diff := cmp.Diff(jobName, aws.StringValue(in.Name)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(string(uid), aws.StringValue(in.ClientToken)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsmacie2.CreateClassificationJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.CreateClassificationJobOutput{
+								JobArn: aws.String(jobARN),
+								JobId:  aws.String(jobID),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   job(),
+			},
+			want: want{
+				cr: job(withExternalName(jobID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				macie2: &fake.MockClassificationJobClient{
+					MockCreateClassificationJob: func(*awsmacie2.CreateClassificationJobInput) awsmacie2.CreateClassificationJobRequest {
+						return awsmacie2.CreateClassificationJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				cr:  job(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.macie2}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func updateJob(t *testing.T, s awsmacie2.JobStatus, err error) func(*awsmacie2.UpdateClassificationJobInput) awsmacie2.UpdateClassificationJobRequest {
+	return func(in *awsmacie2.UpdateClassificationJobInput) awsmacie2.UpdateClassificationJobRequest {
+		if diff := cmp.Diff(s, in.JobStatus); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		return awsmacie2.UpdateClassificationJobRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.UpdateClassificationJobOutput{}, Error: err},
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Pause": {
+			args: args{
+				macie2: &fake.MockClassificationJobClient{MockUpdateClassificationJob: updateJob(t, awsmacie2.JobStatusPaused, nil)},
+				cr:     job(withExternalName(jobID), withPaused(true)),
+			},
+		},
+		"Resume": {
+			args: args{
+				macie2: &fake.MockClassificationJobClient{MockUpdateClassificationJob: updateJob(t, awsmacie2.JobStatusRunning, nil)},
+				cr:     job(withExternalName(jobID)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				macie2: &fake.MockClassificationJobClient{MockUpdateClassificationJob: updateJob(t, awsmacie2.JobStatusRunning, errBoom)},
+				cr:     job(withExternalName(jobID)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.macie2}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				macie2: &fake.MockClassificationJobClient{MockUpdateClassificationJob: updateJob(t, awsmacie2.JobStatusCancelled, nil)},
+				cr:     job(withExternalName(jobID)),
+			},
+			want: want{
+				cr: job(withExternalName(jobID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				macie2: &fake.MockClassificationJobClient{MockUpdateClassificationJob: updateJob(t, awsmacie2.JobStatusCancelled, errBoom)},
+				cr:     job(withExternalName(jobID)),
+			},
+			want: want{
+				cr:  job(withExternalName(jobID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.macie2}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmq "github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/mq/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/mq"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an Amazon MQ Broker resource"
+
+	errDescribe     = "failed to describe the Broker resource"
+	errCreate       = "failed to create the Broker resource"
+	errUpdate       = "failed to update the Broker resource"
+	errDelete       = "failed to delete the Broker resource"
+	errAddTags      = "failed to add tags to the Broker resource"
+	errRemoveTags   = "failed to remove tags from the Broker resource"
+	errListUsers    = "failed to list the users of the Broker resource"
+	errDescribeUser = "failed to describe a user of the Broker resource"
+	errCreateUser   = "failed to create a user of the Broker resource"
+	errUpdateUser   = "failed to update a user of the Broker resource"
+	errDeleteUser   = "failed to delete a user of the Broker resource"
+	errSpecUpdate   = "cannot update spec of the Broker custom resource"
+	errPassword     = "cannot get the user passwords of the Broker resource"
+)
+
+// SetupBroker adds a controller that reconciles Brokers.
+func SetupBroker(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.BrokerGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Broker{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BrokerGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: mq.NewBrokerClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) mq.BrokerClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Broker)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client mq.BrokerClient
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.Broker) (*awsmq.DescribeBrokerOutput, error) {
+	rsp, err := e.client.DescribeBrokerRequest(&awsmq.DescribeBrokerInput{
+		BrokerId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.DescribeBrokerOutput, nil
+}
+
+// users returns the users of the broker with the given ID, including the
+// changes that are pending for them.
+func (e *external) users(ctx context.Context, id string) ([]awsmq.DescribeUserOutput, error) {
+	var res []awsmq.DescribeUserOutput
+	in := &awsmq.ListUsersInput{BrokerId: aws.String(id)}
+	for {
+		rsp, err := e.client.ListUsersRequest(in).Send(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, errListUsers)
+		}
+		for _, u := range rsp.Users {
+			usr, err := e.client.DescribeUserRequest(&awsmq.DescribeUserInput{BrokerId: aws.String(id), Username: u.Username}).Send(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, errDescribeUser)
+			}
+			res = append(res, *usr.DescribeUserOutput)
+		}
+		if aws.StringValue(rsp.NextToken) == "" {
+			return res, nil
+		}
+		in.NextToken = rsp.NextToken
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Broker)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The ID of a broker is generated by Amazon MQ and set as the external
+	// name once the broker is created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(mq.IsBrokerNotFound, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	mq.LateInitializeBroker(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = mq.GenerateBrokerObservation(*observed)
+	switch cr.Status.AtProvider.BrokerState {
+	case v1alpha1.BrokerStateRunning:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.BrokerStateCreationInProgress:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha1.BrokerStateDeletionInProgress:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	add, remove := mq.DiffTags(cr.Spec.ForProvider.Tags, observed.Tags)
+	upToDate := len(add) == 0 && len(remove) == 0 && mq.IsBrokerUpToDate(cr.Spec.ForProvider, *observed)
+
+	// The users of a RabbitMQ broker can't be changed once it is created, and
+	// the users of an ActiveMQ broker can only be listed while it runs.
+	if upToDate && !mq.IsRabbitMQ(cr.Spec.ForProvider) && cr.Status.AtProvider.BrokerState == v1alpha1.BrokerStateRunning {
+		_, changed, err := mq.GetUserPasswords(ctx, e.kube, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errPassword)
+		}
+		users, err := e.users(ctx, meta.GetExternalName(cr))
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		create, update, del := mq.DiffUsers(cr.Spec.ForProvider.Users, users, changed)
+		upToDate = len(create) == 0 && len(update) == 0 && len(del) == 0
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: mq.GetBrokerConnectionDetails(*cr),
+	}, nil
+}
+
+// Create creates a broker with the referenced user passwords and sets its ID
+// as the external name of the Broker.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Broker)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	pwds, _, err := mq.GetUserPasswords(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPassword)
+	}
+	in := mq.GenerateCreateBrokerInput(cr.GetName(), pwds, cr.Spec.ForProvider)
+	rsp, err := e.client.CreateBrokerRequest(in).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.BrokerId))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errSpecUpdate)
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: mq.GetUserConnectionDetails(*cr, cr.Spec.ForProvider.Users[:len(in.Users)], pwds),
+	}, nil
+}
+
+// Update updates the tags, the modifiable settings and the users of the
+// broker. The broker can only be updated while it runs. Most changes are
+// applied during the next maintenance window of the broker.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Broker)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Status.AtProvider.BrokerState != v1alpha1.BrokerStateRunning {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	id := meta.GetExternalName(cr)
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	arn := aws.String(cr.Status.AtProvider.BrokerARN)
+	add, remove := mq.DiffTags(cr.Spec.ForProvider.Tags, observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsmq.DeleteTagsInput{ResourceArn: arn, TagKeys: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsmq.CreateTagsInput{ResourceArn: arn, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	if !mq.IsBrokerUpToDate(cr.Spec.ForProvider, *observed) {
+		if _, err := e.client.UpdateBrokerRequest(mq.GenerateUpdateBrokerInput(id, cr.Spec.ForProvider, *observed)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	if mq.IsRabbitMQ(cr.Spec.ForProvider) {
+		return managed.ExternalUpdate{}, nil
+	}
+	pwds, changed, err := mq.GetUserPasswords(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPassword)
+	}
+	users, err := e.users(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	create, update, del := mq.DiffUsers(cr.Spec.ForProvider.Users, users, changed)
+	for _, u := range del {
+		if _, err := e.client.DeleteUserRequest(&awsmq.DeleteUserInput{BrokerId: aws.String(id), Username: aws.String(u)}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteUser)
+		}
+	}
+	published := make([]v1alpha1.User, 0, len(create)+len(update))
+	for _, u := range create {
+		if _, err := e.client.CreateUserRequest(mq.GenerateCreateUserInput(id, u, pwds[u.Username])).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateUser)
+		}
+		published = append(published, u)
+	}
+	for _, u := range update {
+		pwd := ""
+		if changed[u.Username] {
+			pwd = pwds[u.Username]
+		}
+		if _, err := e.client.UpdateUserRequest(mq.GenerateUpdateUserInput(id, u, pwd)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateUser)
+		}
+		if pwd != "" {
+			published = append(published, u)
+		}
+	}
+	return managed.ExternalUpdate{ConnectionDetails: mq.GetUserConnectionDetails(*cr, published, pwds)}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Broker)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.BrokerState == v1alpha1.BrokerStateDeletionInProgress {
+		return nil
+	}
+	_, err := e.client.DeleteBrokerRequest(&awsmq.DeleteBrokerInput{BrokerId: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	return errors.Wrap(resource.Ignore(mq.IsBrokerNotFound, err), errDelete)
+}
@@ -0,0 +1,628 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsmq "github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/mq/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/mq"
+	"github.com/crossplane/provider-aws/pkg/clients/mq/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	brokerName   = "some-broker"
+	brokerID     = "b-1234"
+	brokerARN    = "arn:aws:mq:us-east-1:123456789012:broker:some-broker:b-1234"
+	endpoint     = "ssl://b-1234-1.mq.us-east-1.amazonaws.com:61617"
+	consoleURL   = "https://b-1234-1.mq.us-east-1.amazonaws.com:8162"
+	version      = "5.15.12"
+	instanceType = "mq.t3.micro"
+	username     = "admin"
+	pwd          = "some-password"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	mq   mq.BrokerClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type brokerModifier func(*v1alpha1.Broker)
+
+func withConditions(c ...runtimev1alpha1.Condition) brokerModifier {
+	return func(r *v1alpha1.Broker) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.BrokerObservation) brokerModifier {
+	return func(r *v1alpha1.Broker) { r.Status.AtProvider = o }
+}
+
+func withExternalName(n string) brokerModifier {
+	return func(r *v1alpha1.Broker) { meta.SetExternalName(r, n) }
+}
+
+func withTags(t ...v1alpha1.Tag) brokerModifier {
+	return func(r *v1alpha1.Broker) { r.Spec.ForProvider.Tags = t }
+}
+
+func withUsers(u ...v1alpha1.User) brokerModifier {
+	return func(r *v1alpha1.Broker) { r.Spec.ForProvider.Users = u }
+}
+
+func user(name string) v1alpha1.User {
+	return v1alpha1.User{
+		Username: name,
+		PasswordSecretRef: runtimev1alpha1.SecretKeySelector{
+			SecretReference: runtimev1alpha1.SecretReference{Name: "password", Namespace: "default"},
+			Key:             "password",
+		},
+	}
+}
+
+func broker(m ...brokerModifier) *v1alpha1.Broker {
+	cr := &v1alpha1.Broker{
+		Spec: v1alpha1.BrokerSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				WriteConnectionSecretToReference: &runtimev1alpha1.SecretReference{Name: "connection", Namespace: "default"},
+			},
+			ForProvider: v1alpha1.BrokerParameters{
+				EngineType:              v1alpha1.EngineTypeActiveMQ,
+				EngineVersion:           version,
+				HostInstanceType:        instanceType,
+				DeploymentMode:          string(awsmq.DeploymentModeSingleInstance),
+				AutoMinorVersionUpgrade: aws.Bool(false),
+				PubliclyAccessible:      aws.Bool(false),
+				Users:                   []v1alpha1.User{user(username)},
+			},
+		},
+	}
+	cr.SetName(brokerName)
+	meta.SetExternalName(cr, brokerID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observation(state string) v1alpha1.BrokerObservation {
+	return v1alpha1.BrokerObservation{
+		BrokerARN:       brokerARN,
+		BrokerID:        brokerID,
+		BrokerState:     state,
+		BrokerInstances: []v1alpha1.BrokerInstance{{ConsoleURL: consoleURL, Endpoints: []string{endpoint}}},
+	}
+}
+
+func describe(state awsmq.BrokerState) func(*awsmq.DescribeBrokerInput) awsmq.DescribeBrokerRequest {
+	return func(*awsmq.DescribeBrokerInput) awsmq.DescribeBrokerRequest {
+		return awsmq.DescribeBrokerRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmq.DescribeBrokerOutput{
+				BrokerId:                aws.String(brokerID),
+				BrokerArn:               aws.String(brokerARN),
+				BrokerState:             state,
+				EngineType:              awsmq.EngineTypeActivemq,
+				EngineVersion:           aws.String(version),
+				HostInstanceType:        aws.String(instanceType),
+				DeploymentMode:          awsmq.DeploymentModeSingleInstance,
+				AutoMinorVersionUpgrade: aws.Bool(false),
+				PubliclyAccessible:      aws.Bool(false),
+				BrokerInstances: []awsmq.BrokerInstance{
+					{ConsoleURL: aws.String(consoleURL), Endpoints: []string{endpoint}},
+				},
+			}},
+		}
+	}
+}
+
+func listUsers(names ...string) func(*awsmq.ListUsersInput) awsmq.ListUsersRequest {
+	return func(*awsmq.ListUsersInput) awsmq.ListUsersRequest {
+		out := &awsmq.ListUsersOutput{}
+		for _, n := range names {
+			out.Users = append(out.Users, awsmq.UserSummary{Username: aws.String(n)})
+		}
+		return awsmq.ListUsersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func describeUser(in *awsmq.DescribeUserInput) awsmq.DescribeUserRequest {
+	return awsmq.DescribeUserRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmq.DescribeUserOutput{
+			BrokerId: in.BrokerId,
+			Username: in.Username,
+		}},
+	}
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+		runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(username),
+		mq.ConnectionDetailsEndpoints:                        []byte(endpoint),
+		mq.ConnectionDetailsConsoleURL:                       []byte(consoleURL),
+	}
+}
+
+// getSecrets returns the referenced password, and the given password as the
+// one of the admin user in the connection secret.
+func getSecrets(current string) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+		s := obj.(*corev1.Secret)
+		if key.Name == "connection" {
+			s.Data = map[string][]byte{mq.ConnectionDetailsUserPasswordPrefix + username: []byte(current)}
+			return nil
+		}
+		s.Data = map[string][]byte{"password": []byte(pwd)}
+		return nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: broker(withExternalName("")),
+			},
+			want: want{
+				cr: broker(withExternalName("")),
+			},
+		},
+		"Available": {
+			args: args{
+				mq: &fake.MockBrokerClient{
+					MockDescribeBroker: describe(awsmq.BrokerStateRunning),
+					MockListUsers:      listUsers(username),
+					MockDescribeUser:   describeUser,
+				},
+				kube: &test.MockClient{MockGet: getSecrets(pwd)},
+				cr:   broker(),
+			},
+			want: want{
+				cr: broker(withObservation(observation(v1alpha1.BrokerStateRunning)), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				mq: &fake.MockBrokerClient{
+					MockDescribeBroker: describe(awsmq.BrokerStateRunning),
+				},
+				cr: broker(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: broker(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withObservation(observation(v1alpha1.BrokerStateRunning)), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"PasswordChanged": {
+			args: args{
+				mq: &fake.MockBrokerClient{
+					MockDescribeBroker: describe(awsmq.BrokerStateRunning),
+					MockListUsers:      listUsers(username),
+					MockDescribeUser:   describeUser,
+				},
+				kube: &test.MockClient{MockGet: getSecrets("old-password")},
+				cr:   broker(),
+			},
+			want: want{
+				cr: broker(withObservation(observation(v1alpha1.BrokerStateRunning)), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				mq: &fake.MockBrokerClient{
+					MockDescribeBroker: describe(awsmq.BrokerStateCreationInProgress),
+				},
+				cr: broker(),
+			},
+			want: want{
+				cr: broker(withObservation(observation(v1alpha1.BrokerStateCreationInProgress)), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				mq: &fake.MockBrokerClient{
+					MockDescribeBroker: func(*awsmq.DescribeBrokerInput) awsmq.DescribeBrokerRequest {
+						return awsmq.DescribeBrokerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsmq.ErrCodeNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: broker(),
+			},
+			want: want{
+				cr: broker(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				mq: &fake.MockBrokerClient{
+					MockDescribeBroker: func(*awsmq.DescribeBrokerInput) awsmq.DescribeBrokerRequest {
+						return awsmq.DescribeBrokerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: broker(),
+			},
+			want: want{
+				cr:  broker(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.mq, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr      resource.Managed
+		details managed.ConnectionDetails
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Create": {
+			args: args{
+				mq: &fake.MockBrokerClient{
+					MockCreateBroker: func(input *awsmq.CreateBrokerInput) awsmq.CreateBrokerRequest {
+						want := []awsmq.User{{Username: aws.String(username), Password: aws.String(pwd)}}
+						if diff := cmp.Diff(want, input.Users); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsmq.CreateBrokerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmq.CreateBrokerOutput{
+								BrokerId: aws.String(brokerID),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: getSecrets(""), MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   broker(withExternalName("")),
+			},
+			want: want{
+				cr: broker(withConditions(runtimev1alpha1.Creating())),
+				details: managed.ConnectionDetails{
+					runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pwd),
+					mq.ConnectionDetailsUserPasswordPrefix + username:    []byte(pwd),
+				},
+			},
+		},
+		"ClientError": {
+			args: args{
+				mq: &fake.MockBrokerClient{
+					MockCreateBroker: func(*awsmq.CreateBrokerInput) awsmq.CreateBrokerRequest {
+						return awsmq.CreateBrokerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: getSecrets("")},
+				cr:   broker(withExternalName("")),
+			},
+			want: want{
+				cr:  broker(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.mq, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.details, o.ConnectionDetails); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr      resource.Managed
+		details managed.ConnectionDetails
+		err     error
+	}
+
+	running := withObservation(observation(v1alpha1.BrokerStateRunning))
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateTagsAndUsers": {
+			args: args{
+				mq: &fake.MockBrokerClient{
+					MockDescribeBroker: describe(awsmq.BrokerStateRunning),
+					MockCreateTags: func(input *awsmq.CreateTagsInput) awsmq.CreateTagsRequest {
+						if diff := cmp.Diff(map[string]string{"k": "v"}, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsmq.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmq.CreateTagsOutput{}},
+						}
+					},
+					MockListUsers:    listUsers(username, "old"),
+					MockDescribeUser: describeUser,
+					MockDeleteUser: func(input *awsmq.DeleteUserInput) awsmq.DeleteUserRequest {
+						if diff := cmp.Diff("old", aws.StringValue(input.Username)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsmq.DeleteUserRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmq.DeleteUserOutput{}},
+						}
+					},
+					MockCreateUser: func(input *awsmq.CreateUserInput) awsmq.CreateUserRequest {
+						if diff := cmp.Diff("app", aws.StringValue(input.Username)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsmq.CreateUserRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmq.CreateUserOutput{}},
+						}
+					},
+					MockUpdateUser: func(input *awsmq.UpdateUserInput) awsmq.UpdateUserRequest {
+						if diff := cmp.Diff(pwd, aws.StringValue(input.Password)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsmq.UpdateUserRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmq.UpdateUserOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: getSecrets("old-password")},
+				cr:   broker(running, withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withUsers(user(username), user("app"))),
+			},
+			want: want{
+				cr: broker(running, withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withUsers(user(username), user("app"))),
+				details: managed.ConnectionDetails{
+					runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pwd),
+					mq.ConnectionDetailsUserPasswordPrefix + username:    []byte(pwd),
+					mq.ConnectionDetailsUserPasswordPrefix + "app":       []byte(pwd),
+				},
+			},
+		},
+		"UpdateBroker": {
+			args: args{
+				mq: &fake.MockBrokerClient{
+					MockDescribeBroker: describe(awsmq.BrokerStateRunning),
+					MockUpdateBroker: func(input *awsmq.UpdateBrokerInput) awsmq.UpdateBrokerRequest {
+						if diff := cmp.Diff("mq.m5.large", aws.StringValue(input.HostInstanceType)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsmq.UpdateBrokerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmq.UpdateBrokerOutput{}},
+						}
+					},
+					MockListUsers:    listUsers(username),
+					MockDescribeUser: describeUser,
+				},
+				kube: &test.MockClient{MockGet: getSecrets(pwd)},
+				cr:   broker(running, func(r *v1alpha1.Broker) { r.Spec.ForProvider.HostInstanceType = "mq.m5.large" }),
+			},
+			want: want{
+				cr: broker(running, func(r *v1alpha1.Broker) { r.Spec.ForProvider.HostInstanceType = "mq.m5.large" }),
+			},
+		},
+		"NotRunning": {
+			args: args{
+				cr: broker(withObservation(observation(v1alpha1.BrokerStateRebootInProgress))),
+			},
+			want: want{
+				cr: broker(withObservation(observation(v1alpha1.BrokerStateRebootInProgress))),
+			},
+		},
+		"ClientError": {
+			args: args{
+				mq: &fake.MockBrokerClient{
+					MockDescribeBroker: describe(awsmq.BrokerStateRunning),
+					MockCreateTags: func(*awsmq.CreateTagsInput) awsmq.CreateTagsRequest {
+						return awsmq.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: broker(running, withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr:  broker(running, withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+				err: errors.Wrap(errBoom, errAddTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.mq, kube: tc.kube}
+			o, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.details, o.ConnectionDetails); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				mq: &fake.MockBrokerClient{
+					MockDeleteBroker: func(*awsmq.DeleteBrokerInput) awsmq.DeleteBrokerRequest {
+						return awsmq.DeleteBrokerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmq.DeleteBrokerOutput{}},
+						}
+					},
+				},
+				cr: broker(),
+			},
+			want: want{
+				cr: broker(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: broker(withObservation(observation(v1alpha1.BrokerStateDeletionInProgress))),
+			},
+			want: want{
+				cr: broker(withObservation(observation(v1alpha1.BrokerStateDeletionInProgress)), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				mq: &fake.MockBrokerClient{
+					MockDeleteBroker: func(*awsmq.DeleteBrokerInput) awsmq.DeleteBrokerRequest {
+						return awsmq.DeleteBrokerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsmq.ErrCodeNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: broker(),
+			},
+			want: want{
+				cr: broker(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				mq: &fake.MockBrokerClient{
+					MockDeleteBroker: func(*awsmq.DeleteBrokerInput) awsmq.DeleteBrokerRequest {
+						return awsmq.DeleteBrokerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: broker(),
+			},
+			want: want{
+				cr:  broker(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.mq, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
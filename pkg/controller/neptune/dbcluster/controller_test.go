@@ -0,0 +1,487 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsneptune "github.com/aws/aws-sdk-go-v2/service/neptune"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/neptune/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/neptune"
+	"github.com/crossplane/provider-aws/pkg/clients/neptune/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	clusterName = "some-cluster"
+	clusterARN  = "arn:aws:rds:us-east-1:123456789012:cluster:some-cluster"
+	endpoint    = "some-cluster.cluster-abc.us-east-1.neptune.amazonaws.com"
+	snapshot    = "some-snapshot"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	neptune neptune.DBClusterClient
+	kube    *test.MockClient
+	cr      resource.Managed
+}
+
+type clusterModifier func(*v1alpha1.DBCluster)
+
+func withConditions(c ...runtimev1alpha1.Condition) clusterModifier {
+	return func(r *v1alpha1.DBCluster) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s string) clusterModifier {
+	return func(r *v1alpha1.DBCluster) {
+		r.Status.AtProvider = v1alpha1.DBClusterObservation{DBClusterARN: clusterARN, Status: s, Endpoint: endpoint, Port: 8182}
+	}
+}
+
+func withSpec(p v1alpha1.DBClusterParameters) clusterModifier {
+	return func(r *v1alpha1.DBCluster) { r.Spec.ForProvider = p }
+}
+
+func cluster(m ...clusterModifier) *v1alpha1.DBCluster {
+	cr := &v1alpha1.DBCluster{}
+	meta.SetExternalName(cr, clusterName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// lateInitialized are the parameters that observed() late initializes.
+func lateInitialized() v1alpha1.DBClusterParameters {
+	return v1alpha1.DBClusterParameters{
+		Port:                  aws.Int64(8182),
+		BackupRetentionPeriod: aws.Int64(1),
+	}
+}
+
+func observed(status string) awsneptune.DBCluster {
+	return awsneptune.DBCluster{
+		DBClusterIdentifier:   aws.String(clusterName),
+		DBClusterArn:          aws.String(clusterARN),
+		Status:                aws.String(status),
+		Endpoint:              aws.String(endpoint),
+		Port:                  aws.Int64(8182),
+		BackupRetentionPeriod: aws.Int64(1),
+	}
+}
+
+func describe(status string) func(*awsneptune.DescribeDBClustersInput) awsneptune.DescribeDBClustersRequest {
+	return func(*awsneptune.DescribeDBClustersInput) awsneptune.DescribeDBClustersRequest {
+		return awsneptune.DescribeDBClustersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsneptune.DescribeDBClustersOutput{
+				DBClusters: []awsneptune.DBCluster{observed(status)},
+			}},
+		}
+	}
+}
+
+func noTags(*awsneptune.ListTagsForResourceInput) awsneptune.ListTagsForResourceRequest {
+	return awsneptune.ListTagsForResourceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsneptune.ListTagsForResourceOutput{}},
+	}
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+		runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte("8182"),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{
+					MockDescribeDBClusters:  describe(v1alpha1.DBClusterStateAvailable),
+					MockListTagsForResource: noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   cluster(),
+			},
+			want: want{
+				cr: cluster(withSpec(lateInitialized()), withStatus(v1alpha1.DBClusterStateAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"NeedsModification": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{
+					MockDescribeDBClusters:  describe(v1alpha1.DBClusterStateAvailable),
+					MockListTagsForResource: noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr: cluster(withSpec(v1alpha1.DBClusterParameters{
+					EnableCloudwatchLogsExports: []string{v1alpha1.LogTypeAudit},
+				})),
+			},
+			want: want{
+				cr: cluster(withSpec(v1alpha1.DBClusterParameters{
+					Port:                        aws.Int64(8182),
+					BackupRetentionPeriod:       aws.Int64(1),
+					EnableCloudwatchLogsExports: []string{v1alpha1.LogTypeAudit},
+				}), withStatus(v1alpha1.DBClusterStateAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{
+					MockDescribeDBClusters:  describe(v1alpha1.DBClusterStateCreating),
+					MockListTagsForResource: noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   cluster(),
+			},
+			want: want{
+				cr: cluster(withSpec(lateInitialized()), withStatus(v1alpha1.DBClusterStateCreating), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{
+					MockDescribeDBClusters: func(*awsneptune.DescribeDBClustersInput) awsneptune.DescribeDBClustersRequest {
+						return awsneptune.DescribeDBClustersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsneptune.ErrCodeDBClusterNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{
+					MockDescribeDBClusters: func(*awsneptune.DescribeDBClustersInput) awsneptune.DescribeDBClustersRequest {
+						return awsneptune.DescribeDBClustersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.neptune, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Create": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{
+					MockCreateDBCluster: func(*awsneptune.CreateDBClusterInput) awsneptune.CreateDBClusterRequest {
+						return awsneptune.CreateDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsneptune.CreateDBClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"RestoreFromSnapshot": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{
+					MockRestoreDBClusterFromSnapshot: func(input *awsneptune.RestoreDBClusterFromSnapshotInput) awsneptune.RestoreDBClusterFromSnapshotRequest {
+						if diff := cmp.Diff(snapshot, aws.StringValue(input.SnapshotIdentifier)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsneptune.RestoreDBClusterFromSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsneptune.RestoreDBClusterFromSnapshotOutput{}},
+						}
+					},
+				},
+				cr: cluster(withSpec(v1alpha1.DBClusterParameters{SnapshotIdentifier: aws.String(snapshot)})),
+			},
+			want: want{
+				cr: cluster(withSpec(v1alpha1.DBClusterParameters{SnapshotIdentifier: aws.String(snapshot)}), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{
+					MockCreateDBCluster: func(*awsneptune.CreateDBClusterInput) awsneptune.CreateDBClusterRequest {
+						return awsneptune.CreateDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.neptune, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	audit := v1alpha1.DBClusterParameters{EnableCloudwatchLogsExports: []string{v1alpha1.LogTypeAudit}}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Modify": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{
+					MockListTagsForResource: noTags,
+					MockDescribeDBClusters:  describe(v1alpha1.DBClusterStateAvailable),
+					MockModifyDBCluster: func(input *awsneptune.ModifyDBClusterInput) awsneptune.ModifyDBClusterRequest {
+						want := &awsneptune.CloudwatchLogsExportConfiguration{EnableLogTypes: []string{v1alpha1.LogTypeAudit}}
+						if diff := cmp.Diff(want, input.CloudwatchLogsExportConfiguration); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsneptune.ModifyDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsneptune.ModifyDBClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(withSpec(audit), withStatus(v1alpha1.DBClusterStateAvailable)),
+			},
+			want: want{
+				cr: cluster(withSpec(audit), withStatus(v1alpha1.DBClusterStateAvailable)),
+			},
+		},
+		"NotAvailable": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{},
+				cr:      cluster(withSpec(audit), withStatus(v1alpha1.DBClusterStateModifying)),
+			},
+			want: want{
+				cr: cluster(withSpec(audit), withStatus(v1alpha1.DBClusterStateModifying)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{
+					MockListTagsForResource: noTags,
+					MockDescribeDBClusters:  describe(v1alpha1.DBClusterStateAvailable),
+					MockModifyDBCluster: func(*awsneptune.ModifyDBClusterInput) awsneptune.ModifyDBClusterRequest {
+						return awsneptune.ModifyDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: cluster(withSpec(audit), withStatus(v1alpha1.DBClusterStateAvailable)),
+			},
+			want: want{
+				cr:  cluster(withSpec(audit), withStatus(v1alpha1.DBClusterStateAvailable)),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.neptune, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{
+					MockDeleteDBCluster: func(*awsneptune.DeleteDBClusterInput) awsneptune.DeleteDBClusterRequest {
+						return awsneptune.DeleteDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsneptune.DeleteDBClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(withStatus(v1alpha1.DBClusterStateAvailable)),
+			},
+			want: want{
+				cr: cluster(withStatus(v1alpha1.DBClusterStateAvailable), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{},
+				cr:      cluster(withStatus(v1alpha1.DBClusterStateDeleting)),
+			},
+			want: want{
+				cr: cluster(withStatus(v1alpha1.DBClusterStateDeleting), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{
+					MockDeleteDBCluster: func(*awsneptune.DeleteDBClusterInput) awsneptune.DeleteDBClusterRequest {
+						return awsneptune.DeleteDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsneptune.ErrCodeDBClusterNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: cluster(withStatus(v1alpha1.DBClusterStateAvailable)),
+			},
+			want: want{
+				cr: cluster(withStatus(v1alpha1.DBClusterStateAvailable), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				neptune: &fake.MockDBClusterClient{
+					MockDeleteDBCluster: func(*awsneptune.DeleteDBClusterInput) awsneptune.DeleteDBClusterRequest {
+						return awsneptune.DeleteDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: cluster(withStatus(v1alpha1.DBClusterStateAvailable)),
+			},
+			want: want{
+				cr:  cluster(withStatus(v1alpha1.DBClusterStateAvailable), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.neptune, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,430 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbinstance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsneptune "github.com/aws/aws-sdk-go-v2/service/neptune"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/neptune/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/neptune"
+	"github.com/crossplane/provider-aws/pkg/clients/neptune/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	instanceName  = "some-instance"
+	instanceARN   = "arn:aws:rds:us-east-1:123456789012:db:some-instance"
+	clusterName   = "some-cluster"
+	instanceClass = "db.r5.large"
+	address       = "some-instance.abc.us-east-1.neptune.amazonaws.com"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	neptune neptune.DBInstanceClient
+	kube    *test.MockClient
+	cr      resource.Managed
+}
+
+type instanceModifier func(*v1alpha1.DBInstance)
+
+func withConditions(c ...runtimev1alpha1.Condition) instanceModifier {
+	return func(r *v1alpha1.DBInstance) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s string) instanceModifier {
+	return func(r *v1alpha1.DBInstance) {
+		r.Status.AtProvider = v1alpha1.DBInstanceObservation{DBInstanceARN: instanceARN, DBInstanceStatus: s, EndpointAddress: address, EndpointPort: 8182}
+	}
+}
+
+func withClass(c string) instanceModifier {
+	return func(r *v1alpha1.DBInstance) { r.Spec.ForProvider.DBInstanceClass = c }
+}
+
+func withLateInit() instanceModifier {
+	return func(r *v1alpha1.DBInstance) { r.Spec.ForProvider.PromotionTier = aws.Int64(1) }
+}
+
+func instance(m ...instanceModifier) *v1alpha1.DBInstance {
+	cr := &v1alpha1.DBInstance{
+		Spec: v1alpha1.DBInstanceSpec{
+			ForProvider: v1alpha1.DBInstanceParameters{
+				DBInstanceClass:     instanceClass,
+				DBClusterIdentifier: aws.String(clusterName),
+			},
+		},
+	}
+	meta.SetExternalName(cr, instanceName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(status string) func(*awsneptune.DescribeDBInstancesInput) awsneptune.DescribeDBInstancesRequest {
+	return func(*awsneptune.DescribeDBInstancesInput) awsneptune.DescribeDBInstancesRequest {
+		return awsneptune.DescribeDBInstancesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsneptune.DescribeDBInstancesOutput{
+				DBInstances: []awsneptune.DBInstance{{
+					DBInstanceIdentifier: aws.String(instanceName),
+					DBInstanceArn:        aws.String(instanceARN),
+					DBInstanceClass:      aws.String(instanceClass),
+					DBInstanceStatus:     aws.String(status),
+					DBClusterIdentifier:  aws.String(clusterName),
+					PromotionTier:        aws.Int64(1),
+					Endpoint:             &awsneptune.Endpoint{Address: aws.String(address), Port: aws.Int64(8182)},
+				}},
+			}},
+		}
+	}
+}
+
+func noTags(*awsneptune.ListTagsForResourceInput) awsneptune.ListTagsForResourceRequest {
+	return awsneptune.ListTagsForResourceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsneptune.ListTagsForResourceOutput{}},
+	}
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(address),
+		runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte("8182"),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				neptune: &fake.MockDBInstanceClient{
+					MockDescribeDBInstances: describe(v1alpha1.DBInstanceStateAvailable),
+					MockListTagsForResource: noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   instance(),
+			},
+			want: want{
+				cr: instance(withLateInit(), withStatus(v1alpha1.DBInstanceStateAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"InstanceClassChanged": {
+			args: args{
+				neptune: &fake.MockDBInstanceClient{
+					MockDescribeDBInstances: describe(v1alpha1.DBInstanceStateAvailable),
+					MockListTagsForResource: noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   instance(withClass("db.r5.xlarge")),
+			},
+			want: want{
+				cr: instance(withClass("db.r5.xlarge"), withLateInit(), withStatus(v1alpha1.DBInstanceStateAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				neptune: &fake.MockDBInstanceClient{
+					MockDescribeDBInstances: func(*awsneptune.DescribeDBInstancesInput) awsneptune.DescribeDBInstancesRequest {
+						return awsneptune.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awsneptune.ErrCodeDBInstanceNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				neptune: &fake.MockDBInstanceClient{
+					MockDescribeDBInstances: func(*awsneptune.DescribeDBInstancesInput) awsneptune.DescribeDBInstancesRequest {
+						return awsneptune.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.neptune, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				neptune: &fake.MockDBInstanceClient{
+					MockCreateDBInstance: func(input *awsneptune.CreateDBInstanceInput) awsneptune.CreateDBInstanceRequest {
+						if diff := cmp.Diff(clusterName, aws.StringValue(input.DBClusterIdentifier)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsneptune.CreateDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsneptune.CreateDBInstanceOutput{}},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				neptune: &fake.MockDBInstanceClient{
+					MockCreateDBInstance: func(*awsneptune.CreateDBInstanceInput) awsneptune.CreateDBInstanceRequest {
+						return awsneptune.CreateDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.neptune, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ChangeInstanceClass": {
+			args: args{
+				neptune: &fake.MockDBInstanceClient{
+					MockListTagsForResource: noTags,
+					MockDescribeDBInstances: describe(v1alpha1.DBInstanceStateAvailable),
+					MockModifyDBInstance: func(input *awsneptune.ModifyDBInstanceInput) awsneptune.ModifyDBInstanceRequest {
+						if diff := cmp.Diff("db.r5.xlarge", aws.StringValue(input.DBInstanceClass)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsneptune.ModifyDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsneptune.ModifyDBInstanceOutput{}},
+						}
+					},
+				},
+				cr: instance(withClass("db.r5.xlarge"), withStatus(v1alpha1.DBInstanceStateAvailable)),
+			},
+			want: want{
+				cr: instance(withClass("db.r5.xlarge"), withStatus(v1alpha1.DBInstanceStateAvailable)),
+			},
+		},
+		"NotAvailable": {
+			args: args{
+				neptune: &fake.MockDBInstanceClient{},
+				cr:      instance(withClass("db.r5.xlarge"), withStatus(v1alpha1.DBInstanceStateModifying)),
+			},
+			want: want{
+				cr: instance(withClass("db.r5.xlarge"), withStatus(v1alpha1.DBInstanceStateModifying)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				neptune: &fake.MockDBInstanceClient{
+					MockListTagsForResource: noTags,
+					MockDescribeDBInstances: describe(v1alpha1.DBInstanceStateAvailable),
+					MockModifyDBInstance: func(*awsneptune.ModifyDBInstanceInput) awsneptune.ModifyDBInstanceRequest {
+						return awsneptune.ModifyDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: instance(withClass("db.r5.xlarge"), withStatus(v1alpha1.DBInstanceStateAvailable)),
+			},
+			want: want{
+				cr:  instance(withClass("db.r5.xlarge"), withStatus(v1alpha1.DBInstanceStateAvailable)),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.neptune, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				neptune: &fake.MockDBInstanceClient{
+					MockDeleteDBInstance: func(*awsneptune.DeleteDBInstanceInput) awsneptune.DeleteDBInstanceRequest {
+						return awsneptune.DeleteDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsneptune.DeleteDBInstanceOutput{}},
+						}
+					},
+				},
+				cr: instance(withStatus(v1alpha1.DBInstanceStateAvailable)),
+			},
+			want: want{
+				cr: instance(withStatus(v1alpha1.DBInstanceStateAvailable), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				neptune: &fake.MockDBInstanceClient{},
+				cr:      instance(withStatus(v1alpha1.DBInstanceStateDeleting)),
+			},
+			want: want{
+				cr: instance(withStatus(v1alpha1.DBInstanceStateDeleting), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				neptune: &fake.MockDBInstanceClient{
+					MockDeleteDBInstance: func(*awsneptune.DeleteDBInstanceInput) awsneptune.DeleteDBInstanceRequest {
+						return awsneptune.DeleteDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: instance(withStatus(v1alpha1.DBInstanceStateAvailable)),
+			},
+			want: want{
+				cr:  instance(withStatus(v1alpha1.DBInstanceStateAvailable), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.neptune, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	es "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/opensearchservice/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/opensearchservice"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an OpenSearch Service Domain resource"
+
+	errDescribe       = "failed to describe the Domain resource"
+	errCreate         = "failed to create the Domain resource"
+	errUpdateConfig   = "failed to update the configuration of the Domain resource"
+	errSoftwareUpdate = "failed to start the service software update of the Domain resource"
+	errDelete         = "failed to delete the Domain resource"
+	errListTags       = "failed to list the tags of the Domain resource"
+	errAddTags        = "failed to add tags to the Domain resource"
+	errRemoveTags     = "failed to remove tags from the Domain resource"
+	errSpecUpdate     = "cannot update spec of the Domain custom resource"
+	errPassword       = "cannot get the master user password of the Domain resource"
+)
+
+// SetupDomain adds a controller that reconciles Domains.
+func SetupDomain(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DomainGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Domain{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DomainGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: opensearchservice.NewDomainClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) opensearchservice.DomainClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Domain)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client opensearchservice.DomainClient
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.Domain) (*es.ElasticsearchDomainStatus, error) {
+	rsp, err := e.client.DescribeElasticsearchDomainRequest(&es.DescribeElasticsearchDomainInput{
+		DomainName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.DomainStatus, nil
+}
+
+// Observe reports the domain as up to date while a blue/green deployment, a
+// version upgrade or a service software update is in progress. The observed
+// configuration may not match the desired one until the change is complete,
+// and reporting a drift would only make Update retry a change that can't be
+// applied until then.
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Domain)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(opensearchservice.IsDomainNotFound, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	opensearchservice.LateInitializeDomain(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = opensearchservice.GenerateDomainObservation(*observed)
+	switch {
+	case cr.Status.AtProvider.Deleted:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	case cr.Status.AtProvider.Endpoint == "" && cr.Status.AtProvider.VPCEndpoint == "":
+		cr.SetConditions(runtimev1alpha1.Creating())
+	default:
+		// A domain keeps serving requests during blue/green deployments.
+		cr.SetConditions(runtimev1alpha1.Available())
+	}
+	if cr.Status.AtProvider.Deleted || opensearchservice.IsChangeInProgress(*observed) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: opensearchservice.GetDomainConnectionDetails(*cr),
+		}, nil
+	}
+
+	tags, err := e.client.ListTagsRequest(&es.ListTagsInput{ARN: observed.ARN}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := opensearchservice.DiffTags(cr.Spec.ForProvider.Tags, tags.TagList)
+	_, pwdChanged, err := opensearchservice.GetMasterUserPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errPassword)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: len(add) == 0 && len(remove) == 0 && !pwdChanged &&
+			opensearchservice.IsDomainUpToDate(cr.Spec.ForProvider, *observed) &&
+			!opensearchservice.IsServiceSoftwareUpdateNeeded(cr.Spec.ForProvider, *observed),
+		ConnectionDetails: opensearchservice.GetDomainConnectionDetails(*cr),
+	}, nil
+}
+
+// Create creates the domain with the referenced master user password, if
+// any. Tags can't be set on creation and are added by a subsequent update.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Domain)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	pw, _, err := opensearchservice.GetMasterUserPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPassword)
+	}
+	if _, err := e.client.CreateElasticsearchDomainRequest(opensearchservice.GenerateCreateElasticsearchDomainInput(meta.GetExternalName(cr), pw, cr.Spec.ForProvider)).Send(ctx); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if pw == "" {
+		return managed.ExternalCreation{}, nil
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, nil
+}
+
+// Update updates the tags and the configuration of the domain. A configuration
+// change starts a blue/green deployment, during which the domain can't be
+// changed again. An available service software update is therefore only
+// started once the configuration is up to date.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Domain)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if aws.BoolValue(observed.Deleted) || opensearchservice.IsChangeInProgress(*observed) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	tags, err := e.client.ListTagsRequest(&es.ListTagsInput{ARN: observed.ARN}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := opensearchservice.DiffTags(cr.Spec.ForProvider.Tags, tags.TagList)
+	if len(remove) > 0 {
+		if _, err := e.client.RemoveTagsRequest(&es.RemoveTagsInput{ARN: observed.ARN, TagKeys: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.AddTagsRequest(&es.AddTagsInput{ARN: observed.ARN, TagList: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	pw, pwdChanged, err := opensearchservice.GetMasterUserPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPassword)
+	}
+	if !pwdChanged && opensearchservice.IsDomainUpToDate(cr.Spec.ForProvider, *observed) {
+		if !opensearchservice.IsServiceSoftwareUpdateNeeded(cr.Spec.ForProvider, *observed) {
+			return managed.ExternalUpdate{}, nil
+		}
+		_, err := e.client.StartElasticsearchServiceSoftwareUpdateRequest(&es.StartElasticsearchServiceSoftwareUpdateInput{
+			DomainName: aws.String(meta.GetExternalName(cr)),
+		}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errSoftwareUpdate)
+	}
+	if !pwdChanged {
+		pw = ""
+	}
+	if _, err := e.client.UpdateElasticsearchDomainConfigRequest(opensearchservice.GenerateUpdateElasticsearchDomainConfigInput(meta.GetExternalName(cr), pw, cr.Spec.ForProvider, *observed)).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateConfig)
+	}
+	if !pwdChanged {
+		return managed.ExternalUpdate{}, nil
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{
+			runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Domain)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.Deleted {
+		return nil
+	}
+	_, err := e.client.DeleteElasticsearchDomainRequest(&es.DeleteElasticsearchDomainInput{
+		DomainName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(opensearchservice.IsDomainNotFound, err), errDelete)
+}
@@ -0,0 +1,642 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	es "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/opensearchservice/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/opensearchservice"
+	"github.com/crossplane/provider-aws/pkg/clients/opensearchservice/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	domainName   = "some-domain"
+	domainARN    = "arn:aws:es:us-east-1:123456789012:domain/some-domain"
+	endpoint     = "search-some-domain-abc.us-east-1.es.amazonaws.com"
+	version      = "7.9"
+	instanceType = "r5.large.elasticsearch"
+	username     = "admin"
+	pwd          = "some-password"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	es   opensearchservice.DomainClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type domainModifier func(*v1alpha1.Domain)
+
+func withConditions(c ...runtimev1alpha1.Condition) domainModifier {
+	return func(r *v1alpha1.Domain) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.DomainObservation) domainModifier {
+	return func(r *v1alpha1.Domain) { r.Status.AtProvider = o }
+}
+
+func withSpec(p v1alpha1.DomainParameters) domainModifier {
+	return func(r *v1alpha1.Domain) { r.Spec.ForProvider = p }
+}
+
+// withPassword references the master user password secret, and the
+// connection secret that holds the current password.
+func withPassword() domainModifier {
+	return func(r *v1alpha1.Domain) {
+		r.Spec.ForProvider.AdvancedSecurityOptions = &v1alpha1.AdvancedSecurityOptions{
+			Enabled:        true,
+			MasterUserName: aws.String(username),
+			MasterUserPasswordSecretRef: &runtimev1alpha1.SecretKeySelector{
+				SecretReference: runtimev1alpha1.SecretReference{Name: "password", Namespace: "default"},
+				Key:             "password",
+			},
+		}
+		r.Spec.WriteConnectionSecretToReference = &runtimev1alpha1.SecretReference{Name: "connection", Namespace: "default"}
+	}
+}
+
+func domain(m ...domainModifier) *v1alpha1.Domain {
+	cr := &v1alpha1.Domain{}
+	meta.SetExternalName(cr, domainName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// lateInitialized are the parameters that observed() late initializes.
+func lateInitialized() v1alpha1.DomainParameters {
+	return v1alpha1.DomainParameters{ElasticsearchVersion: aws.String(version)}
+}
+
+func observation() v1alpha1.DomainObservation {
+	return v1alpha1.DomainObservation{ARN: domainARN, Endpoint: endpoint, Created: true}
+}
+
+type statusModifier func(*es.ElasticsearchDomainStatus)
+
+func observed(m ...statusModifier) *es.ElasticsearchDomainStatus {
+	o := &es.ElasticsearchDomainStatus{
+		DomainName:           aws.String(domainName),
+		ARN:                  aws.String(domainARN),
+		ElasticsearchVersion: aws.String(version),
+		Created:              aws.Bool(true),
+		Endpoint:             aws.String(endpoint),
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func processing(o *es.ElasticsearchDomainStatus) { o.Processing = aws.Bool(true) }
+
+func softwareUpdateAvailable(o *es.ElasticsearchDomainStatus) {
+	o.ServiceSoftwareOptions = &es.ServiceSoftwareOptions{UpdateAvailable: aws.Bool(true), UpdateStatus: es.DeploymentStatusEligible}
+}
+
+func describe(m ...statusModifier) func(*es.DescribeElasticsearchDomainInput) es.DescribeElasticsearchDomainRequest {
+	return func(*es.DescribeElasticsearchDomainInput) es.DescribeElasticsearchDomainRequest {
+		return es.DescribeElasticsearchDomainRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &es.DescribeElasticsearchDomainOutput{
+				DomainStatus: observed(m...),
+			}},
+		}
+	}
+}
+
+func noTags(*es.ListTagsInput) es.ListTagsRequest {
+	return es.ListTagsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &es.ListTagsOutput{}},
+	}
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+		runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte("443"),
+	}
+}
+
+// getSecrets returns the referenced password, and the given password as the
+// one of the connection secret.
+func getSecrets(current string) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+		s := obj.(*corev1.Secret)
+		if key.Name == "connection" {
+			s.Data = map[string][]byte{runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(current)}
+			return nil
+		}
+		s.Data = map[string][]byte{"password": []byte(pwd)}
+		return nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	scaled := v1alpha1.DomainParameters{
+		ElasticsearchVersion: aws.String(version),
+		ClusterConfig:        &v1alpha1.ClusterConfig{InstanceType: instanceType, InstanceCount: aws.Int64(3)},
+	}
+	autoUpdate := v1alpha1.DomainParameters{
+		ElasticsearchVersion:      aws.String(version),
+		AutoServiceSoftwareUpdate: aws.Bool(true),
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDescribeElasticsearchDomain: describe(),
+					MockListTags:                    noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   domain(),
+			},
+			want: want{
+				cr: domain(withSpec(lateInitialized()), withObservation(observation()), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDescribeElasticsearchDomain: describe(),
+					MockListTags:                    noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   domain(withSpec(scaled)),
+			},
+			want: want{
+				cr: domain(withSpec(scaled), withObservation(observation()), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"BlueGreenDeployment": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDescribeElasticsearchDomain: describe(processing),
+				},
+				cr: domain(withSpec(scaled)),
+			},
+			want: want{
+				cr: domain(withSpec(scaled), withObservation(func() v1alpha1.DomainObservation {
+					o := observation()
+					o.Processing = true
+					return o
+				}()), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"ServiceSoftwareUpdateAvailable": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDescribeElasticsearchDomain: describe(softwareUpdateAvailable),
+					MockListTags:                    noTags,
+				},
+				cr: domain(withSpec(autoUpdate)),
+			},
+			want: want{
+				cr: domain(withSpec(autoUpdate), withObservation(func() v1alpha1.DomainObservation {
+					o := observation()
+					o.ServiceSoftware = v1alpha1.ServiceSoftwareObservation{UpdateAvailable: true, UpdateStatus: string(es.DeploymentStatusEligible)}
+					return o
+				}()), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDescribeElasticsearchDomain: describe(func(o *es.ElasticsearchDomainStatus) { o.Endpoint = nil }),
+					MockListTags:                    noTags,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   domain(),
+			},
+			want: want{
+				cr: domain(withSpec(lateInitialized()), withObservation(v1alpha1.DomainObservation{ARN: domainARN, Created: true}), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDescribeElasticsearchDomain: func(*es.DescribeElasticsearchDomainInput) es.DescribeElasticsearchDomainRequest {
+						return es.DescribeElasticsearchDomainRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(es.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDescribeElasticsearchDomain: func(*es.DescribeElasticsearchDomainInput) es.DescribeElasticsearchDomainRequest {
+						return es.DescribeElasticsearchDomainRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr:  domain(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.es, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr      resource.Managed
+		details managed.ConnectionDetails
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Create": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockCreateElasticsearchDomain: func(*es.CreateElasticsearchDomainInput) es.CreateElasticsearchDomainRequest {
+						return es.CreateElasticsearchDomainRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &es.CreateElasticsearchDomainOutput{}},
+						}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateWithMasterUser": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockCreateElasticsearchDomain: func(input *es.CreateElasticsearchDomainInput) es.CreateElasticsearchDomainRequest {
+						want := &es.MasterUserOptions{MasterUserName: aws.String(username), MasterUserPassword: aws.String(pwd)}
+						if diff := cmp.Diff(want, input.AdvancedSecurityOptions.MasterUserOptions); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return es.CreateElasticsearchDomainRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &es.CreateElasticsearchDomainOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: getSecrets("")},
+				cr:   domain(withPassword()),
+			},
+			want: want{
+				cr: domain(withPassword(), withConditions(runtimev1alpha1.Creating())),
+				details: managed.ConnectionDetails{
+					runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pwd),
+				},
+			},
+		},
+		"ClientError": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockCreateElasticsearchDomain: func(*es.CreateElasticsearchDomainInput) es.CreateElasticsearchDomainRequest {
+						return es.CreateElasticsearchDomainRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr:  domain(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.es, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.details, o.ConnectionDetails); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr      resource.Managed
+		details managed.ConnectionDetails
+		err     error
+	}
+
+	scaled := v1alpha1.DomainParameters{
+		ClusterConfig: &v1alpha1.ClusterConfig{InstanceType: instanceType, InstanceCount: aws.Int64(3)},
+	}
+	autoUpdate := v1alpha1.DomainParameters{AutoServiceSoftwareUpdate: aws.Bool(true)}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateConfig": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDescribeElasticsearchDomain: describe(),
+					MockListTags:                    noTags,
+					MockUpdateElasticsearchDomainConfig: func(input *es.UpdateElasticsearchDomainConfigInput) es.UpdateElasticsearchDomainConfigRequest {
+						want := &es.ElasticsearchClusterConfig{InstanceType: es.ESPartitionInstanceType(instanceType), InstanceCount: aws.Int64(3)}
+						if diff := cmp.Diff(want, input.ElasticsearchClusterConfig); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return es.UpdateElasticsearchDomainConfigRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &es.UpdateElasticsearchDomainConfigOutput{}},
+						}
+					},
+				},
+				cr: domain(withSpec(scaled)),
+			},
+			want: want{
+				cr: domain(withSpec(scaled)),
+			},
+		},
+		"UpdatePassword": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDescribeElasticsearchDomain: describe(func(o *es.ElasticsearchDomainStatus) {
+						o.AdvancedSecurityOptions = &es.AdvancedSecurityOptions{Enabled: aws.Bool(true)}
+					}),
+					MockListTags: noTags,
+					MockUpdateElasticsearchDomainConfig: func(input *es.UpdateElasticsearchDomainConfigInput) es.UpdateElasticsearchDomainConfigRequest {
+						if diff := cmp.Diff(pwd, aws.StringValue(input.AdvancedSecurityOptions.MasterUserOptions.MasterUserPassword)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return es.UpdateElasticsearchDomainConfigRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &es.UpdateElasticsearchDomainConfigOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockGet: getSecrets("old-password")},
+				cr:   domain(withPassword()),
+			},
+			want: want{
+				cr: domain(withPassword()),
+				details: managed.ConnectionDetails{
+					runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pwd),
+				},
+			},
+		},
+		"StartServiceSoftwareUpdate": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDescribeElasticsearchDomain: describe(softwareUpdateAvailable),
+					MockListTags:                    noTags,
+					MockStartElasticsearchServiceSoftwareUpdate: func(input *es.StartElasticsearchServiceSoftwareUpdateInput) es.StartElasticsearchServiceSoftwareUpdateRequest {
+						if diff := cmp.Diff(domainName, aws.StringValue(input.DomainName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return es.StartElasticsearchServiceSoftwareUpdateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &es.StartElasticsearchServiceSoftwareUpdateOutput{}},
+						}
+					},
+				},
+				cr: domain(withSpec(autoUpdate)),
+			},
+			want: want{
+				cr: domain(withSpec(autoUpdate)),
+			},
+		},
+		"ChangeInProgress": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDescribeElasticsearchDomain: describe(processing, softwareUpdateAvailable),
+				},
+				cr: domain(withSpec(scaled)),
+			},
+			want: want{
+				cr: domain(withSpec(scaled)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDescribeElasticsearchDomain: describe(),
+					MockListTags:                    noTags,
+					MockUpdateElasticsearchDomainConfig: func(*es.UpdateElasticsearchDomainConfigInput) es.UpdateElasticsearchDomainConfigRequest {
+						return es.UpdateElasticsearchDomainConfigRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: domain(withSpec(scaled)),
+			},
+			want: want{
+				cr:  domain(withSpec(scaled)),
+				err: errors.Wrap(errBoom, errUpdateConfig),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.es, kube: tc.kube}
+			o, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.details, o.ConnectionDetails); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleted := v1alpha1.DomainObservation{ARN: domainARN, Created: true, Deleted: true}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDeleteElasticsearchDomain: func(*es.DeleteElasticsearchDomainInput) es.DeleteElasticsearchDomainRequest {
+						return es.DeleteElasticsearchDomainRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &es.DeleteElasticsearchDomainOutput{}},
+						}
+					},
+				},
+				cr: domain(withObservation(observation())),
+			},
+			want: want{
+				cr: domain(withObservation(observation()), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				es: &fake.MockDomainClient{},
+				cr: domain(withObservation(deleted)),
+			},
+			want: want{
+				cr: domain(withObservation(deleted), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDeleteElasticsearchDomain: func(*es.DeleteElasticsearchDomainInput) es.DeleteElasticsearchDomainRequest {
+						return es.DeleteElasticsearchDomainRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(es.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: domain(withObservation(observation())),
+			},
+			want: want{
+				cr: domain(withObservation(observation()), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				es: &fake.MockDomainClient{
+					MockDeleteElasticsearchDomain: func(*es.DeleteElasticsearchDomainInput) es.DeleteElasticsearchDomainRequest {
+						return es.DeleteElasticsearchDomainRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: domain(withObservation(observation())),
+			},
+			want: want{
+				cr:  domain(withObservation(observation()), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.es, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
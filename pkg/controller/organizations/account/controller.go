@@ -0,0 +1,212 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsorganizations "github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an Organizations Account resource"
+
+	errDescribeCreate = "failed to describe the creation status of the Account resource"
+	errCreateFailed   = "failed to create the Account resource: %s"
+	errDescribe       = "failed to describe the Account resource"
+	errGetParent      = "failed to get the parent of the Account resource"
+	errCreate         = "failed to create the Account resource"
+	errMove           = "failed to move the Account resource"
+	errRemove         = "failed to remove the Account resource from the organization"
+	errSpecUpdate     = "cannot update spec of the Account custom resource"
+)
+
+// SetupAccount adds a controller that reconciles Accounts.
+func SetupAccount(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.AccountGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Account{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AccountGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: organizations.NewAccountClient}))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) organizations.AccountClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, awsclients.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client organizations.AccountClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Account)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	// Accounts are created asynchronously, so the external name is the ID of
+	// the create request until the account exists.
+	if organizations.IsCreateAccountRequestID(id) {
+		rsp, err := e.client.DescribeCreateAccountStatusRequest(&awsorganizations.DescribeCreateAccountStatusInput{
+			CreateAccountRequestId: aws.String(id),
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errDescribeCreate)
+		}
+		status := rsp.CreateAccountStatus
+		cr.Status.AtProvider = organizations.GenerateCreateAccountObservation(*status)
+		switch status.State {
+		case awsorganizations.CreateAccountStateInProgress:
+			cr.SetConditions(runtimev1alpha1.Creating())
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		case awsorganizations.CreateAccountStateFailed:
+			// There is nothing to remove for an account that was never
+			// created.
+			if meta.WasDeleted(cr) {
+				return managed.ExternalObservation{}, nil
+			}
+			return managed.ExternalObservation{}, errors.Errorf(errCreateFailed, status.FailureReason)
+		}
+		meta.SetExternalName(cr, aws.StringValue(status.AccountId))
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+		id = aws.StringValue(status.AccountId)
+	}
+
+	rsp, err := e.client.DescribeAccountRequest(&awsorganizations.DescribeAccountInput{
+		AccountId: aws.String(id),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(organizations.IsAccountNotFound, err), errDescribe)
+	}
+	parentID, err := organizations.GetParentID(ctx, e.client, id)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetParent)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	organizations.LateInitializeAccount(&cr.Spec.ForProvider, aws.String(parentID))
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = organizations.GenerateAccountObservation(*rsp.Account)
+	switch rsp.Account.Status {
+	case awsorganizations.AccountStatusActive:
+		cr.SetConditions(runtimev1alpha1.Available())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: organizations.IsAccountUpToDate(cr.Spec.ForProvider, parentID),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Account)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.CreateAccountRequest(organizations.GenerateCreateAccountInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.CreateAccountStatus.Id))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Account)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := meta.GetExternalName(cr)
+	if organizations.IsCreateAccountRequestID(id) || cr.Spec.ForProvider.ParentID == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	parentID, err := organizations.GetParentID(ctx, e.client, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetParent)
+	}
+	if parentID == aws.StringValue(cr.Spec.ForProvider.ParentID) {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.MoveAccountRequest(&awsorganizations.MoveAccountInput{
+		AccountId:           aws.String(id),
+		SourceParentId:      aws.String(parentID),
+		DestinationParentId: cr.Spec.ForProvider.ParentID,
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errMove)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Account)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	id := meta.GetExternalName(cr)
+	if organizations.IsCreateAccountRequestID(id) {
+		return nil
+	}
+	_, err := e.client.RemoveAccountFromOrganizationRequest(&awsorganizations.RemoveAccountFromOrganizationInput{
+		AccountId: aws.String(id),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(organizations.IsAccountNotFound, err), errRemove)
+}
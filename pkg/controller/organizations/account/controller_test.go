@@ -0,0 +1,536 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsorganizations "github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	accountID  = "123456789012"
+	accountARN = "arn:aws:organizations::111111111111:account/o-exampleorgid/123456789012"
+	requestID  = "car-0123456789abcdef0123456789abcdef"
+	rootID     = "r-ab12"
+	ouID       = "ou-ab12-cdef3456"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	organizations organizations.AccountClient
+	kube          *test.MockClient
+	cr            resource.Managed
+}
+
+type accountModifier func(*v1alpha1.Account)
+
+func withConditions(c ...runtimev1alpha1.Condition) accountModifier {
+	return func(r *v1alpha1.Account) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) accountModifier {
+	return func(r *v1alpha1.Account) { meta.SetExternalName(r, n) }
+}
+
+func withParentID(id *string) accountModifier {
+	return func(r *v1alpha1.Account) { r.Spec.ForProvider.ParentID = id }
+}
+
+func withObservation(o v1alpha1.AccountObservation) accountModifier {
+	return func(r *v1alpha1.Account) { r.Status.AtProvider = o }
+}
+
+func account(m ...accountModifier) *v1alpha1.Account {
+	cr := &v1alpha1.Account{
+		Spec: v1alpha1.AccountSpec{
+			ForProvider: v1alpha1.AccountParameters{
+				Name:     "sample",
+				Email:    "aws+sample@example.com",
+				ParentID: aws.String(ouID),
+			},
+		},
+	}
+	meta.SetExternalName(cr, accountID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var activeObservation = v1alpha1.AccountObservation{
+	ARN:                accountARN,
+	Status:             string(awsorganizations.AccountStatusActive),
+	JoinedMethod:       string(awsorganizations.AccountJoinedMethodCreated),
+	CreateAccountState: string(awsorganizations.CreateAccountStateSucceeded),
+}
+
+func describeAccount(*awsorganizations.DescribeAccountInput) awsorganizations.DescribeAccountRequest {
+	return awsorganizations.DescribeAccountRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.DescribeAccountOutput{Account: &awsorganizations.Account{
+			Id:           aws.String(accountID),
+			Arn:          aws.String(accountARN),
+			Status:       awsorganizations.AccountStatusActive,
+			JoinedMethod: awsorganizations.AccountJoinedMethodCreated,
+		}}},
+	}
+}
+
+func listParents(id string) func(*awsorganizations.ListParentsInput) awsorganizations.ListParentsRequest {
+	return func(*awsorganizations.ListParentsInput) awsorganizations.ListParentsRequest {
+		return awsorganizations.ListParentsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.ListParentsOutput{
+				Parents: []awsorganizations.Parent{{Id: aws.String(id)}},
+			}},
+		}
+	}
+}
+
+func describeCreateAccountStatus(s awsorganizations.CreateAccountStatus) func(*awsorganizations.DescribeCreateAccountStatusInput) awsorganizations.DescribeCreateAccountStatusRequest {
+	return func(*awsorganizations.DescribeCreateAccountStatusInput) awsorganizations.DescribeCreateAccountStatusRequest {
+		return awsorganizations.DescribeCreateAccountStatusRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.DescribeCreateAccountStatusOutput{CreateAccountStatus: &s}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				organizations: &fake.MockAccountClient{
+					MockDescribeAccount: describeAccount,
+					MockListParents:     listParents(ouID),
+				},
+				cr: account(),
+			},
+			want: want{
+				cr: account(withObservation(activeObservation), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				organizations: &fake.MockAccountClient{
+					MockDescribeAccount: describeAccount,
+					MockListParents:     listParents(ouID),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   account(withParentID(nil)),
+			},
+			want: want{
+				cr: account(withObservation(activeObservation), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				organizations: &fake.MockAccountClient{
+					MockDescribeAccount: describeAccount,
+					MockListParents:     listParents(rootID),
+				},
+				cr: account(),
+			},
+			want: want{
+				cr: account(withObservation(activeObservation), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"CreateInProgress": {
+			args: args{
+				organizations: &fake.MockAccountClient{
+					MockDescribeCreateAccountStatus: describeCreateAccountStatus(awsorganizations.CreateAccountStatus{
+						Id:    aws.String(requestID),
+						State: awsorganizations.CreateAccountStateInProgress,
+					}),
+				},
+				cr: account(withExternalName(requestID)),
+			},
+			want: want{
+				cr: account(withExternalName(requestID), withConditions(runtimev1alpha1.Creating()),
+					withObservation(v1alpha1.AccountObservation{CreateAccountState: string(awsorganizations.CreateAccountStateInProgress)})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CreateSucceeded": {
+			args: args{
+				organizations: &fake.MockAccountClient{
+					MockDescribeCreateAccountStatus: describeCreateAccountStatus(awsorganizations.CreateAccountStatus{
+						Id:        aws.String(requestID),
+						AccountId: aws.String(accountID),
+						State:     awsorganizations.CreateAccountStateSucceeded,
+					}),
+					MockDescribeAccount: describeAccount,
+					MockListParents:     listParents(rootID),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   account(withExternalName(requestID)),
+			},
+			want: want{
+				cr: account(withObservation(activeObservation), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				organizations: &fake.MockAccountClient{
+					MockDescribeCreateAccountStatus: describeCreateAccountStatus(awsorganizations.CreateAccountStatus{
+						Id:            aws.String(requestID),
+						State:         awsorganizations.CreateAccountStateFailed,
+						FailureReason: awsorganizations.CreateAccountFailureReasonEmailAlreadyExists,
+					}),
+				},
+				cr: account(withExternalName(requestID)),
+			},
+			want: want{
+				cr: account(withExternalName(requestID), withObservation(v1alpha1.AccountObservation{
+					CreateAccountState: string(awsorganizations.CreateAccountStateFailed),
+					FailureReason:      string(awsorganizations.CreateAccountFailureReasonEmailAlreadyExists),
+				})),
+				err: errors.Errorf(errCreateFailed, awsorganizations.CreateAccountFailureReasonEmailAlreadyExists),
+			},
+		},
+		"NotFound": {
+			args: args{
+				organizations: &fake.MockAccountClient{
+					MockDescribeAccount: func(*awsorganizations.DescribeAccountInput) awsorganizations.DescribeAccountRequest {
+						return awsorganizations.DescribeAccountRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsorganizations.ErrCodeAccountNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr: account(),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: account(withExternalName("")),
+			},
+			want: want{
+				cr: account(withExternalName("")),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockAccountClient{
+					MockDescribeAccount: func(*awsorganizations.DescribeAccountInput) awsorganizations.DescribeAccountRequest {
+						return awsorganizations.DescribeAccountRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr:  account(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				organizations: &fake.MockAccountClient{
+					MockCreateAccount: func(*awsorganizations.CreateAccountInput) awsorganizations.CreateAccountRequest {
+						return awsorganizations.CreateAccountRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.CreateAccountOutput{
+								CreateAccountStatus: &awsorganizations.CreateAccountStatus{Id: aws.String(requestID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   account(withExternalName("")),
+			},
+			want: want{
+				cr: account(withExternalName(requestID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockAccountClient{
+					MockCreateAccount: func(*awsorganizations.CreateAccountInput) awsorganizations.CreateAccountRequest {
+						return awsorganizations.CreateAccountRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: account(withExternalName("")),
+			},
+			want: want{
+				cr:  account(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Move": {
+			args: args{
+				organizations: &fake.MockAccountClient{
+					MockListParents: listParents(rootID),
+					MockMoveAccount: func(input *awsorganizations.MoveAccountInput) awsorganizations.MoveAccountRequest {
+						want := &awsorganizations.MoveAccountInput{
+							AccountId:           aws.String(accountID),
+							SourceParentId:      aws.String(rootID),
+							DestinationParentId: aws.String(ouID),
+						}
+						if diff := cmp.Diff(want, input); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsorganizations.MoveAccountRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.MoveAccountOutput{}},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr: account(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockAccountClient{
+					MockListParents: listParents(rootID),
+					MockMoveAccount: func(*awsorganizations.MoveAccountInput) awsorganizations.MoveAccountRequest {
+						return awsorganizations.MoveAccountRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr:  account(),
+				err: errors.Wrap(errBoom, errMove),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				organizations: &fake.MockAccountClient{
+					MockRemoveAccountFromOrganization: func(*awsorganizations.RemoveAccountFromOrganizationInput) awsorganizations.RemoveAccountFromOrganizationRequest {
+						return awsorganizations.RemoveAccountFromOrganizationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.RemoveAccountFromOrganizationOutput{}},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr: account(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"CreateRequest": {
+			args: args{
+				cr: account(withExternalName(requestID)),
+			},
+			want: want{
+				cr: account(withExternalName(requestID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockAccountClient{
+					MockRemoveAccountFromOrganization: func(*awsorganizations.RemoveAccountFromOrganizationInput) awsorganizations.RemoveAccountFromOrganizationRequest {
+						return awsorganizations.RemoveAccountFromOrganizationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr:  account(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errRemove),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationalunit
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsorganizations "github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an Organizations OrganizationalUnit resource"
+
+	errDescribe   = "failed to describe the OrganizationalUnit resource"
+	errGetParent  = "failed to get the parent of the OrganizationalUnit resource"
+	errCreate     = "failed to create the OrganizationalUnit resource"
+	errUpdate     = "failed to update the OrganizationalUnit resource"
+	errDelete     = "failed to delete the OrganizationalUnit resource"
+	errSpecUpdate = "cannot update spec of the OrganizationalUnit custom resource"
+)
+
+// SetupOrganizationalUnit adds a controller that reconciles
+// OrganizationalUnits.
+func SetupOrganizationalUnit(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.OrganizationalUnitGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.OrganizationalUnit{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.OrganizationalUnitGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: organizations.NewOrganizationalUnitClient}))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) organizations.OrganizationalUnitClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, awsclients.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client organizations.OrganizationalUnitClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.OrganizationalUnit)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	rsp, err := e.client.DescribeOrganizationalUnitRequest(&awsorganizations.DescribeOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(organizations.IsOrganizationalUnitNotFound, err), errDescribe)
+	}
+
+	// The parent of an imported organizational unit is looked up in case
+	// it's not specified.
+	if cr.Spec.ForProvider.ParentID == nil {
+		parentID, err := organizations.GetParentID(ctx, e.client, meta.GetExternalName(cr))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetParent)
+		}
+		cr.Spec.ForProvider.ParentID = aws.String(parentID)
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = organizations.GenerateOrganizationalUnitObservation(*rsp.OrganizationalUnit)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: organizations.IsOrganizationalUnitUpToDate(cr.Spec.ForProvider, *rsp.OrganizationalUnit),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.OrganizationalUnit)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.CreateOrganizationalUnitRequest(organizations.GenerateCreateOrganizationalUnitInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.OrganizationalUnit.Id))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.OrganizationalUnit)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	_, err := e.client.UpdateOrganizationalUnitRequest(&awsorganizations.UpdateOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(meta.GetExternalName(cr)),
+		Name:                 aws.String(cr.Spec.ForProvider.Name),
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.OrganizationalUnit)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteOrganizationalUnitRequest(&awsorganizations.DeleteOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(organizations.IsOrganizationalUnitNotFound, err), errDelete)
+}
@@ -0,0 +1,438 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationalunit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsorganizations "github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	ouID   = "ou-ab12-cdef3456"
+	ouARN  = "arn:aws:organizations::111111111111:ou/o-exampleorgid/ou-ab12-cdef3456"
+	ouName = "workloads"
+	rootID = "r-ab12"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	organizations organizations.OrganizationalUnitClient
+	kube          *test.MockClient
+	cr            resource.Managed
+}
+
+type ouModifier func(*v1alpha1.OrganizationalUnit)
+
+func withConditions(c ...runtimev1alpha1.Condition) ouModifier {
+	return func(r *v1alpha1.OrganizationalUnit) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) ouModifier {
+	return func(r *v1alpha1.OrganizationalUnit) { meta.SetExternalName(r, n) }
+}
+
+func withName(n string) ouModifier {
+	return func(r *v1alpha1.OrganizationalUnit) { r.Spec.ForProvider.Name = n }
+}
+
+func withParentID(id *string) ouModifier {
+	return func(r *v1alpha1.OrganizationalUnit) { r.Spec.ForProvider.ParentID = id }
+}
+
+func withObservation() ouModifier {
+	return func(r *v1alpha1.OrganizationalUnit) {
+		r.Status.AtProvider = v1alpha1.OrganizationalUnitObservation{ARN: ouARN}
+	}
+}
+
+func organizationalUnit(m ...ouModifier) *v1alpha1.OrganizationalUnit {
+	cr := &v1alpha1.OrganizationalUnit{
+		Spec: v1alpha1.OrganizationalUnitSpec{
+			ForProvider: v1alpha1.OrganizationalUnitParameters{
+				Name:     ouName,
+				ParentID: aws.String(rootID),
+			},
+		},
+	}
+	meta.SetExternalName(cr, ouID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func ou() *awsorganizations.OrganizationalUnit {
+	return &awsorganizations.OrganizationalUnit{
+		Id:   aws.String(ouID),
+		Arn:  aws.String(ouARN),
+		Name: aws.String(ouName),
+	}
+}
+
+func describeOrganizationalUnit(*awsorganizations.DescribeOrganizationalUnitInput) awsorganizations.DescribeOrganizationalUnitRequest {
+	return awsorganizations.DescribeOrganizationalUnitRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.DescribeOrganizationalUnitOutput{OrganizationalUnit: ou()}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				organizations: &fake.MockOrganizationalUnitClient{
+					MockDescribeOrganizationalUnit: describeOrganizationalUnit,
+				},
+				cr: organizationalUnit(),
+			},
+			want: want{
+				cr: organizationalUnit(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				organizations: &fake.MockOrganizationalUnitClient{
+					MockDescribeOrganizationalUnit: describeOrganizationalUnit,
+					MockListParents: func(*awsorganizations.ListParentsInput) awsorganizations.ListParentsRequest {
+						return awsorganizations.ListParentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.ListParentsOutput{
+								Parents: []awsorganizations.Parent{{Id: aws.String(rootID)}},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   organizationalUnit(withParentID(nil)),
+			},
+			want: want{
+				cr: organizationalUnit(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				organizations: &fake.MockOrganizationalUnitClient{
+					MockDescribeOrganizationalUnit: describeOrganizationalUnit,
+				},
+				cr: organizationalUnit(withName("renamed")),
+			},
+			want: want{
+				cr: organizationalUnit(withName("renamed"), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				organizations: &fake.MockOrganizationalUnitClient{
+					MockDescribeOrganizationalUnit: func(*awsorganizations.DescribeOrganizationalUnitInput) awsorganizations.DescribeOrganizationalUnitRequest {
+						return awsorganizations.DescribeOrganizationalUnitRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsorganizations.ErrCodeOrganizationalUnitNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: organizationalUnit(),
+			},
+			want: want{
+				cr: organizationalUnit(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockOrganizationalUnitClient{
+					MockDescribeOrganizationalUnit: func(*awsorganizations.DescribeOrganizationalUnitInput) awsorganizations.DescribeOrganizationalUnitRequest {
+						return awsorganizations.DescribeOrganizationalUnitRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: organizationalUnit(),
+			},
+			want: want{
+				cr:  organizationalUnit(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				organizations: &fake.MockOrganizationalUnitClient{
+					MockCreateOrganizationalUnit: func(*awsorganizations.CreateOrganizationalUnitInput) awsorganizations.CreateOrganizationalUnitRequest {
+						return awsorganizations.CreateOrganizationalUnitRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.CreateOrganizationalUnitOutput{OrganizationalUnit: ou()}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   organizationalUnit(withExternalName("")),
+			},
+			want: want{
+				cr: organizationalUnit(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockOrganizationalUnitClient{
+					MockCreateOrganizationalUnit: func(*awsorganizations.CreateOrganizationalUnitInput) awsorganizations.CreateOrganizationalUnitRequest {
+						return awsorganizations.CreateOrganizationalUnitRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: organizationalUnit(withExternalName("")),
+			},
+			want: want{
+				cr:  organizationalUnit(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				organizations: &fake.MockOrganizationalUnitClient{
+					MockUpdateOrganizationalUnit: func(input *awsorganizations.UpdateOrganizationalUnitInput) awsorganizations.UpdateOrganizationalUnitRequest {
+						if diff := cmp.Diff(aws.String("renamed"), input.Name); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsorganizations.UpdateOrganizationalUnitRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.UpdateOrganizationalUnitOutput{}},
+						}
+					},
+				},
+				cr: organizationalUnit(withName("renamed")),
+			},
+			want: want{
+				cr: organizationalUnit(withName("renamed")),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockOrganizationalUnitClient{
+					MockUpdateOrganizationalUnit: func(*awsorganizations.UpdateOrganizationalUnitInput) awsorganizations.UpdateOrganizationalUnitRequest {
+						return awsorganizations.UpdateOrganizationalUnitRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: organizationalUnit(),
+			},
+			want: want{
+				cr:  organizationalUnit(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				organizations: &fake.MockOrganizationalUnitClient{
+					MockDeleteOrganizationalUnit: func(*awsorganizations.DeleteOrganizationalUnitInput) awsorganizations.DeleteOrganizationalUnitRequest {
+						return awsorganizations.DeleteOrganizationalUnitRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.DeleteOrganizationalUnitOutput{}},
+						}
+					},
+				},
+				cr: organizationalUnit(),
+			},
+			want: want{
+				cr: organizationalUnit(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockOrganizationalUnitClient{
+					MockDeleteOrganizationalUnit: func(*awsorganizations.DeleteOrganizationalUnitInput) awsorganizations.DeleteOrganizationalUnitRequest {
+						return awsorganizations.DeleteOrganizationalUnitRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: organizationalUnit(),
+			},
+			want: want{
+				cr:  organizationalUnit(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
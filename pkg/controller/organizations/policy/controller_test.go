@@ -0,0 +1,438 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsorganizations "github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	policyID      = "p-abcd1234"
+	policyARN     = "arn:aws:organizations::111111111111:policy/o-exampleorgid/service_control_policy/p-abcd1234"
+	policyName    = "deny-leave-organization"
+	policyContent = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"organizations:LeaveOrganization","Resource":"*"}]}`
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	organizations organizations.PolicyClient
+	kube          *test.MockClient
+	cr            resource.Managed
+}
+
+type policyModifier func(*v1alpha1.Policy)
+
+func withConditions(c ...runtimev1alpha1.Condition) policyModifier {
+	return func(r *v1alpha1.Policy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) policyModifier {
+	return func(r *v1alpha1.Policy) { meta.SetExternalName(r, n) }
+}
+
+func withName(n string) policyModifier {
+	return func(r *v1alpha1.Policy) { r.Spec.ForProvider.Name = n }
+}
+
+func withDescription(d *string) policyModifier {
+	return func(r *v1alpha1.Policy) { r.Spec.ForProvider.Description = d }
+}
+
+func withObservation() policyModifier {
+	return func(r *v1alpha1.Policy) {
+		r.Status.AtProvider = v1alpha1.PolicyObservation{ARN: policyARN}
+	}
+}
+
+func policy(m ...policyModifier) *v1alpha1.Policy {
+	cr := &v1alpha1.Policy{
+		Spec: v1alpha1.PolicySpec{
+			ForProvider: v1alpha1.PolicyParameters{
+				Name:        policyName,
+				Description: aws.String("Deny leaving the organization"),
+				Type:        "SERVICE_CONTROL_POLICY",
+				Content:     policyContent,
+			},
+		},
+	}
+	meta.SetExternalName(cr, policyID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func policyObject() *awsorganizations.Policy {
+	return &awsorganizations.Policy{
+		PolicySummary: &awsorganizations.PolicySummary{
+			Id:          aws.String(policyID),
+			Arn:         aws.String(policyARN),
+			Name:        aws.String(policyName),
+			Description: aws.String("Deny leaving the organization"),
+			Type:        awsorganizations.PolicyTypeServiceControlPolicy,
+		},
+		Content: aws.String(policyContent),
+	}
+}
+
+func describePolicy(*awsorganizations.DescribePolicyInput) awsorganizations.DescribePolicyRequest {
+	return awsorganizations.DescribePolicyRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.DescribePolicyOutput{Policy: policyObject()}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				organizations: &fake.MockPolicyClient{
+					MockDescribePolicy: describePolicy,
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr: policy(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				organizations: &fake.MockPolicyClient{
+					MockDescribePolicy: describePolicy,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   policy(withDescription(nil)),
+			},
+			want: want{
+				cr: policy(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				organizations: &fake.MockPolicyClient{
+					MockDescribePolicy: describePolicy,
+				},
+				cr: policy(withName("renamed")),
+			},
+			want: want{
+				cr: policy(withName("renamed"), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				organizations: &fake.MockPolicyClient{
+					MockDescribePolicy: func(*awsorganizations.DescribePolicyInput) awsorganizations.DescribePolicyRequest {
+						return awsorganizations.DescribePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsorganizations.ErrCodePolicyNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr: policy(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockPolicyClient{
+					MockDescribePolicy: func(*awsorganizations.DescribePolicyInput) awsorganizations.DescribePolicyRequest {
+						return awsorganizations.DescribePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr:  policy(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				organizations: &fake.MockPolicyClient{
+					MockCreatePolicy: func(*awsorganizations.CreatePolicyInput) awsorganizations.CreatePolicyRequest {
+						return awsorganizations.CreatePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.CreatePolicyOutput{Policy: policyObject()}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   policy(withExternalName("")),
+			},
+			want: want{
+				cr: policy(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockPolicyClient{
+					MockCreatePolicy: func(*awsorganizations.CreatePolicyInput) awsorganizations.CreatePolicyRequest {
+						return awsorganizations.CreatePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: policy(withExternalName("")),
+			},
+			want: want{
+				cr:  policy(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				organizations: &fake.MockPolicyClient{
+					MockUpdatePolicy: func(input *awsorganizations.UpdatePolicyInput) awsorganizations.UpdatePolicyRequest {
+						if diff := cmp.Diff(aws.String(policyID), input.PolicyId); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsorganizations.UpdatePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.UpdatePolicyOutput{}},
+						}
+					},
+				},
+				cr: policy(withName("renamed")),
+			},
+			want: want{
+				cr: policy(withName("renamed")),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockPolicyClient{
+					MockUpdatePolicy: func(*awsorganizations.UpdatePolicyInput) awsorganizations.UpdatePolicyRequest {
+						return awsorganizations.UpdatePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr:  policy(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				organizations: &fake.MockPolicyClient{
+					MockDeletePolicy: func(*awsorganizations.DeletePolicyInput) awsorganizations.DeletePolicyRequest {
+						return awsorganizations.DeletePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.DeletePolicyOutput{}},
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr: policy(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockPolicyClient{
+					MockDeletePolicy: func(*awsorganizations.DeletePolicyInput) awsorganizations.DeletePolicyRequest {
+						return awsorganizations.DeletePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr:  policy(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
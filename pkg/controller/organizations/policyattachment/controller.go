@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyattachment
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsorganizations "github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an Organizations PolicyAttachment resource"
+
+	errListTargets = "failed to list the targets of the policy"
+	errAttach      = "failed to attach the policy to the target"
+	errDetach      = "failed to detach the policy from the target"
+	errSpecUpdate  = "cannot update spec of the PolicyAttachment custom resource"
+)
+
+// SetupPolicyAttachment adds a controller that reconciles PolicyAttachments.
+func SetupPolicyAttachment(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.PolicyAttachmentGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.PolicyAttachment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PolicyAttachmentGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: organizations.NewPolicyAttachmentClient}))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) organizations.PolicyAttachmentClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, awsclients.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client organizations.PolicyAttachmentClient
+}
+
+// target returns the target with the given ID that the policy with the given
+// ID is attached to, or nil if it isn't attached to it.
+func (e *external) target(ctx context.Context, policyID, targetID string) (*awsorganizations.PolicyTargetSummary, error) {
+	in := &awsorganizations.ListTargetsForPolicyInput{PolicyId: aws.String(policyID)}
+	for {
+		rsp, err := e.client.ListTargetsForPolicyRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if t := organizations.FindPolicyTarget(rsp.Targets, targetID); t != nil {
+			return t, nil
+		}
+		if rsp.NextToken == nil {
+			return nil, nil
+		}
+		in.NextToken = rsp.NextToken
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.PolicyAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The parts of the composite external name of an imported resource
+	// identify it in case they're not specified.
+	current := cr.Spec.ForProvider.DeepCopy()
+	awsclients.LateInitializeFromExternalName(meta.GetExternalName(cr),
+		&cr.Spec.ForProvider.PolicyID, &cr.Spec.ForProvider.TargetID)
+
+	t, err := e.target(ctx, cr.Spec.ForProvider.PolicyID, cr.Spec.ForProvider.TargetID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(organizations.IsPolicyNotFound, err), errListTargets)
+	}
+	if t == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	nameChanged := awsclients.SetCompositeExternalName(cr, cr.Spec.ForProvider.PolicyID, cr.Spec.ForProvider.TargetID)
+	if nameChanged || !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = v1alpha1.PolicyAttachmentObservation{TargetType: string(t.Type)}
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.PolicyAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.AttachPolicyRequest(&awsorganizations.AttachPolicyInput{
+		PolicyId: aws.String(cr.Spec.ForProvider.PolicyID),
+		TargetId: aws.String(cr.Spec.ForProvider.TargetID),
+	}).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errAttach)
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	// Both fields of a policy attachment are immutable.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.PolicyAttachment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DetachPolicyRequest(&awsorganizations.DetachPolicyInput{
+		PolicyId: aws.String(cr.Spec.ForProvider.PolicyID),
+		TargetId: aws.String(cr.Spec.ForProvider.TargetID),
+	}).Send(ctx)
+	if organizations.IsPolicyNotAttached(err) || organizations.IsPolicyNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDetach)
+}
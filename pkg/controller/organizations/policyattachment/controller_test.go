@@ -0,0 +1,364 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyattachment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsorganizations "github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	policyID = "p-abcd1234"
+	targetID = "ou-ab12-cdef3456"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	organizations organizations.PolicyAttachmentClient
+	kube          *test.MockClient
+	cr            resource.Managed
+}
+
+type attachmentModifier func(*v1alpha1.PolicyAttachment)
+
+func withConditions(c ...runtimev1alpha1.Condition) attachmentModifier {
+	return func(r *v1alpha1.PolicyAttachment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) attachmentModifier {
+	return func(r *v1alpha1.PolicyAttachment) { meta.SetExternalName(r, n) }
+}
+
+func withSpec(p v1alpha1.PolicyAttachmentParameters) attachmentModifier {
+	return func(r *v1alpha1.PolicyAttachment) { r.Spec.ForProvider = p }
+}
+
+func withTargetType(t string) attachmentModifier {
+	return func(r *v1alpha1.PolicyAttachment) { r.Status.AtProvider.TargetType = t }
+}
+
+func attachment(m ...attachmentModifier) *v1alpha1.PolicyAttachment {
+	cr := &v1alpha1.PolicyAttachment{
+		Spec: v1alpha1.PolicyAttachmentSpec{
+			ForProvider: v1alpha1.PolicyAttachmentParameters{
+				PolicyID: policyID,
+				TargetID: targetID,
+			},
+		},
+	}
+	meta.SetExternalName(cr, awsclients.JoinExternalName(policyID, targetID))
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listTargets(ids ...string) func(*awsorganizations.ListTargetsForPolicyInput) awsorganizations.ListTargetsForPolicyRequest {
+	return func(*awsorganizations.ListTargetsForPolicyInput) awsorganizations.ListTargetsForPolicyRequest {
+		targets := make([]awsorganizations.PolicyTargetSummary, len(ids))
+		for i, id := range ids {
+			targets[i] = awsorganizations.PolicyTargetSummary{TargetId: aws.String(id), Type: awsorganizations.TargetTypeOrganizationalUnit}
+		}
+		return awsorganizations.ListTargetsForPolicyRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.ListTargetsForPolicyOutput{Targets: targets}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				organizations: &fake.MockPolicyAttachmentClient{
+					MockListTargetsForPolicy: listTargets("r-ab12", targetID),
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withTargetType(string(awsorganizations.TargetTypeOrganizationalUnit)), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Imported": {
+			args: args{
+				organizations: &fake.MockPolicyAttachmentClient{
+					MockListTargetsForPolicy: listTargets(targetID),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   attachment(withSpec(v1alpha1.PolicyAttachmentParameters{})),
+			},
+			want: want{
+				cr: attachment(withTargetType(string(awsorganizations.TargetTypeOrganizationalUnit)), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotAttached": {
+			args: args{
+				organizations: &fake.MockPolicyAttachmentClient{
+					MockListTargetsForPolicy: listTargets("r-ab12"),
+				},
+				cr: attachment(withExternalName("")),
+			},
+			want: want{
+				cr: attachment(withExternalName("")),
+			},
+		},
+		"PolicyNotFound": {
+			args: args{
+				organizations: &fake.MockPolicyAttachmentClient{
+					MockListTargetsForPolicy: func(*awsorganizations.ListTargetsForPolicyInput) awsorganizations.ListTargetsForPolicyRequest {
+						return awsorganizations.ListTargetsForPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsorganizations.ErrCodePolicyNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockPolicyAttachmentClient{
+					MockListTargetsForPolicy: func(*awsorganizations.ListTargetsForPolicyInput) awsorganizations.ListTargetsForPolicyRequest {
+						return awsorganizations.ListTargetsForPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(),
+				err: errors.Wrap(errBoom, errListTargets),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				organizations: &fake.MockPolicyAttachmentClient{
+					MockAttachPolicy: func(input *awsorganizations.AttachPolicyInput) awsorganizations.AttachPolicyRequest {
+						want := &awsorganizations.AttachPolicyInput{PolicyId: aws.String(policyID), TargetId: aws.String(targetID)}
+						if diff := cmp.Diff(want, input); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsorganizations.AttachPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.AttachPolicyOutput{}},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockPolicyAttachmentClient{
+					MockAttachPolicy: func(*awsorganizations.AttachPolicyInput) awsorganizations.AttachPolicyRequest {
+						return awsorganizations.AttachPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errAttach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				organizations: &fake.MockPolicyAttachmentClient{
+					MockDetachPolicy: func(*awsorganizations.DetachPolicyInput) awsorganizations.DetachPolicyRequest {
+						return awsorganizations.DetachPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.DetachPolicyOutput{}},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotAttached": {
+			args: args{
+				organizations: &fake.MockPolicyAttachmentClient{
+					MockDetachPolicy: func(*awsorganizations.DetachPolicyInput) awsorganizations.DetachPolicyRequest {
+						return awsorganizations.DetachPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsorganizations.ErrCodePolicyNotAttachedException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				organizations: &fake.MockPolicyAttachmentClient{
+					MockDetachPolicy: func(*awsorganizations.DetachPolicyInput) awsorganizations.DetachPolicyRequest {
+						return awsorganizations.DetachPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDetach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.organizations, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
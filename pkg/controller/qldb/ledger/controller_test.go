@@ -0,0 +1,401 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ledger
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsqldb "github.com/aws/aws-sdk-go-v2/service/qldb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/qldb/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/qldb"
+	"github.com/crossplane/provider-aws/pkg/clients/qldb/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	ledgerName = "audit-ledger"
+	ledgerARN  = "arn:aws:qldb:us-east-1:123456789012:ledger/audit-ledger"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsqldb.ErrCodeResourceNotFoundException, "", nil)
+)
+
+type args struct {
+	qldb qldb.LedgerClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type ledgerModifier func(*v1alpha1.Ledger)
+
+func withConditions(c ...runtimev1alpha1.Condition) ledgerModifier {
+	return func(r *v1alpha1.Ledger) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDeletionProtection(b *bool) ledgerModifier {
+	return func(r *v1alpha1.Ledger) { r.Spec.ForProvider.DeletionProtection = b }
+}
+
+func withObservation(state awsqldb.LedgerState) ledgerModifier {
+	return func(r *v1alpha1.Ledger) {
+		r.Status.AtProvider = v1alpha1.LedgerObservation{ARN: ledgerARN, State: string(state)}
+	}
+}
+
+func ledger(m ...ledgerModifier) *v1alpha1.Ledger {
+	cr := &v1alpha1.Ledger{
+		Spec: v1alpha1.LedgerSpec{
+			ForProvider: v1alpha1.LedgerParameters{
+				Region:             "us-east-1",
+				PermissionsMode:    string(awsqldb.PermissionsModeAllowAll),
+				DeletionProtection: aws.Bool(true),
+			},
+		},
+	}
+	meta.SetExternalName(cr, ledgerName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeLedger(state awsqldb.LedgerState, err error) func(*awsqldb.DescribeLedgerInput) awsqldb.DescribeLedgerRequest {
+	return func(*awsqldb.DescribeLedgerInput) awsqldb.DescribeLedgerRequest {
+		return awsqldb.DescribeLedgerRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsqldb.DescribeLedgerOutput{
+				Arn:                aws.String(ledgerARN),
+				Name:               aws.String(ledgerName),
+				DeletionProtection: aws.Bool(true),
+				State:              state,
+			}, Error: err},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				qldb: &fake.MockLedgerClient{MockDescribeLedger: describeLedger(awsqldb.LedgerStateActive, nil)},
+				cr:   ledger(),
+			},
+			want: want{
+				cr:     ledger(withObservation(awsqldb.LedgerStateActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Creating": {
+			args: args{
+				qldb: &fake.MockLedgerClient{MockDescribeLedger: describeLedger(awsqldb.LedgerStateCreating, nil)},
+				cr:   ledger(),
+			},
+			want: want{
+				cr:     ledger(withObservation(awsqldb.LedgerStateCreating), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				qldb: &fake.MockLedgerClient{MockDescribeLedger: describeLedger(awsqldb.LedgerStateActive, nil)},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   ledger(withDeletionProtection(nil)),
+			},
+			want: want{
+				cr:     ledger(withObservation(awsqldb.LedgerStateActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				qldb: &fake.MockLedgerClient{MockDescribeLedger: describeLedger(awsqldb.LedgerStateActive, nil)},
+				cr:   ledger(withDeletionProtection(aws.Bool(false))),
+			},
+			want: want{
+				cr: ledger(withDeletionProtection(aws.Bool(false)),
+					withObservation(awsqldb.LedgerStateActive), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"Deleted": {
+			args: args{
+				qldb: &fake.MockLedgerClient{MockDescribeLedger: describeLedger(awsqldb.LedgerStateDeleted, nil)},
+				cr:   ledger(),
+			},
+			want: want{
+				cr: ledger(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				qldb: &fake.MockLedgerClient{MockDescribeLedger: describeLedger("", errNotFound)},
+				cr:   ledger(),
+			},
+			want: want{
+				cr: ledger(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				qldb: &fake.MockLedgerClient{MockDescribeLedger: describeLedger("", errBoom)},
+				cr:   ledger(),
+			},
+			want: want{
+				cr:  ledger(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.qldb}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				qldb: &fake.MockLedgerClient{
+					MockCreateLedger: func(in *awsqldb.CreateLedgerInput) awsqldb.CreateLedgerRequest {
+						if diff := cmp.Diff(ledgerName, aws.StringValue(in.Name)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsqldb.CreateLedgerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsqldb.CreateLedgerOutput{}},
+						}
+					},
+				},
+				cr: ledger(),
+			},
+			want: want{
+				cr: ledger(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				qldb: &fake.MockLedgerClient{
+					MockCreateLedger: func(*awsqldb.CreateLedgerInput) awsqldb.CreateLedgerRequest {
+						return awsqldb.CreateLedgerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: ledger(),
+			},
+			want: want{
+				cr:  ledger(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.qldb}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DisableDeletionProtection": {
+			args: args{
+				qldb: &fake.MockLedgerClient{
+					MockUpdateLedger: func(in *awsqldb.UpdateLedgerInput) awsqldb.UpdateLedgerRequest {
+						if diff := cmp.Diff(aws.Bool(false), in.DeletionProtection); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsqldb.UpdateLedgerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsqldb.UpdateLedgerOutput{}},
+						}
+					},
+				},
+				cr: ledger(withDeletionProtection(aws.Bool(false))),
+			},
+		},
+		"ClientError": {
+			args: args{
+				qldb: &fake.MockLedgerClient{
+					MockUpdateLedger: func(*awsqldb.UpdateLedgerInput) awsqldb.UpdateLedgerRequest {
+						return awsqldb.UpdateLedgerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: ledger(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.qldb}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteLedger := func(err error) func(*awsqldb.DeleteLedgerInput) awsqldb.DeleteLedgerRequest {
+		return func(*awsqldb.DeleteLedgerInput) awsqldb.DeleteLedgerRequest {
+			return awsqldb.DeleteLedgerRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsqldb.DeleteLedgerOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				qldb: &fake.MockLedgerClient{MockDeleteLedger: deleteLedger(nil)},
+				cr:   ledger(),
+			},
+			want: want{
+				cr: ledger(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: ledger(withObservation(awsqldb.LedgerStateDeleting)),
+			},
+			want: want{
+				cr: ledger(withObservation(awsqldb.LedgerStateDeleting), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				qldb: &fake.MockLedgerClient{MockDeleteLedger: deleteLedger(errNotFound)},
+				cr:   ledger(),
+			},
+			want: want{
+				cr: ledger(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				qldb: &fake.MockLedgerClient{MockDeleteLedger: deleteLedger(errBoom)},
+				cr:   ledger(),
+			},
+			want: want{
+				cr:  ledger(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.qldb}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
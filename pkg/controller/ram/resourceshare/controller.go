@@ -0,0 +1,285 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceshare
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsram "github.com/aws/aws-sdk-go-v2/service/ram"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ram/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ram"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a RAM ResourceShare resource"
+
+	errDescribe         = "failed to describe the ResourceShare resource"
+	errListAssociations = "failed to list the associations of the ResourceShare resource"
+	errCreate           = "failed to create the ResourceShare resource"
+	errUpdate           = "failed to update the ResourceShare resource"
+	errAssociate        = "failed to associate resources and principals with the ResourceShare resource"
+	errDisassociate     = "failed to disassociate resources and principals from the ResourceShare resource"
+	errAddTags          = "failed to add tags to the ResourceShare resource"
+	errRemoveTags       = "failed to remove tags from the ResourceShare resource"
+	errDelete           = "failed to delete the ResourceShare resource"
+	errSpecUpdate       = "cannot update spec of the ResourceShare custom resource"
+)
+
+// SetupResourceShare adds a controller that reconciles ResourceShares.
+func SetupResourceShare(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ResourceShareGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ResourceShare{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ResourceShareGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: ram.NewResourceShareClient}, awsclients.DeletionTierAttachment), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ram.ResourceShareClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ResourceShare)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ram.ResourceShareClient
+}
+
+// describe returns the resource share with the given ARN, or nil if it
+// doesn't exist.
+func (e *external) describe(ctx context.Context, arn string) (*awsram.ResourceShare, error) {
+	rsp, err := e.client.GetResourceSharesRequest(&awsram.GetResourceSharesInput{
+		ResourceOwner:     awsram.ResourceOwnerSelf,
+		ResourceShareArns: []string{arn},
+	}).Send(ctx)
+	if err != nil {
+		return nil, resource.Ignore(ram.IsResourceShareNotFound, err)
+	}
+	if len(rsp.ResourceShares) == 0 || ram.IsResourceShareDeleted(rsp.ResourceShares[0]) {
+		return nil, nil
+	}
+	return &rsp.ResourceShares[0], nil
+}
+
+// associations returns the resources or principals that are associated with
+// the resource share with the given ARN.
+func (e *external) associations(ctx context.Context, arn string, t awsram.ResourceShareAssociationType) ([]string, error) {
+	in := &awsram.GetResourceShareAssociationsInput{
+		AssociationType:   t,
+		ResourceShareArns: []string{arn},
+	}
+	var entities []string
+	for {
+		rsp, err := e.client.GetResourceShareAssociationsRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range rsp.ResourceShareAssociations {
+			if ram.IsAssociated(a) {
+				entities = append(entities, aws.StringValue(a.AssociatedEntity))
+			}
+		}
+		if rsp.NextToken == nil {
+			return entities, nil
+		}
+		in.NextToken = rsp.NextToken
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.ResourceShare)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	share, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+	if share == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	resources, err := e.associations(ctx, meta.GetExternalName(cr), awsram.ResourceShareAssociationTypeResource)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListAssociations)
+	}
+	principals, err := e.associations(ctx, meta.GetExternalName(cr), awsram.ResourceShareAssociationTypePrincipal)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListAssociations)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ram.LateInitializeResourceShare(&cr.Spec.ForProvider, share)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = ram.GenerateResourceShareObservation(*share)
+	switch share.Status {
+	case awsram.ResourceShareStatusActive:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsram.ResourceShareStatusPending:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	associateResources, disassociateResources := ram.DiffAssociations(ram.GenerateResourceARNs(cr.Spec.ForProvider), resources)
+	associatePrincipals, disassociatePrincipals := ram.DiffAssociations(cr.Spec.ForProvider.Principals, principals)
+	add, remove := ram.DiffTags(cr.Spec.ForProvider.Tags, share.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: ram.IsResourceShareUpToDate(cr.Spec.ForProvider, *share) &&
+			len(associateResources) == 0 && len(disassociateResources) == 0 &&
+			len(associatePrincipals) == 0 && len(disassociatePrincipals) == 0 &&
+			len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.ResourceShare)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.CreateResourceShareRequest(ram.GenerateCreateResourceShareInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.ResourceShare.ResourceShareArn))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.ResourceShare)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	arn := meta.GetExternalName(cr)
+	share, err := e.describe(ctx, arn)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if share == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	if !ram.IsResourceShareUpToDate(cr.Spec.ForProvider, *share) {
+		if _, err := e.client.UpdateResourceShareRequest(&awsram.UpdateResourceShareInput{
+			ResourceShareArn:        aws.String(arn),
+			Name:                    aws.String(cr.Spec.ForProvider.Name),
+			AllowExternalPrincipals: cr.Spec.ForProvider.AllowExternalPrincipals,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	resources, err := e.associations(ctx, arn, awsram.ResourceShareAssociationTypeResource)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListAssociations)
+	}
+	principals, err := e.associations(ctx, arn, awsram.ResourceShareAssociationTypePrincipal)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListAssociations)
+	}
+	associateResources, disassociateResources := ram.DiffAssociations(ram.GenerateResourceARNs(cr.Spec.ForProvider), resources)
+	associatePrincipals, disassociatePrincipals := ram.DiffAssociations(cr.Spec.ForProvider.Principals, principals)
+	if len(disassociateResources) != 0 || len(disassociatePrincipals) != 0 {
+		if _, err := e.client.DisassociateResourceShareRequest(&awsram.DisassociateResourceShareInput{
+			ResourceShareArn: aws.String(arn),
+			ResourceArns:     disassociateResources,
+			Principals:       disassociatePrincipals,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDisassociate)
+		}
+	}
+	if len(associateResources) != 0 || len(associatePrincipals) != 0 {
+		if _, err := e.client.AssociateResourceShareRequest(&awsram.AssociateResourceShareInput{
+			ResourceShareArn: aws.String(arn),
+			ResourceArns:     associateResources,
+			Principals:       associatePrincipals,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAssociate)
+		}
+	}
+
+	add, remove := ram.DiffTags(cr.Spec.ForProvider.Tags, share.Tags)
+	if len(remove) != 0 {
+		if _, err := e.client.UntagResourceRequest(&awsram.UntagResourceInput{
+			ResourceShareArn: aws.String(arn),
+			TagKeys:          remove,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.TagResourceRequest(&awsram.TagResourceInput{
+			ResourceShareArn: aws.String(arn),
+			Tags:             add,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.ResourceShare)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteResourceShareRequest(&awsram.DeleteResourceShareInput{
+		ResourceShareArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ram.IsResourceShareNotFound, err), errDelete)
+}
@@ -0,0 +1,542 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceshare
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsram "github.com/aws/aws-sdk-go-v2/service/ram"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ram/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/ram"
+	"github.com/crossplane/provider-aws/pkg/clients/ram/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	shareName = "network"
+	shareARN  = "arn:aws:ram:us-east-1:123456789012:resource-share/0123abcd-01ab-23cd-45ef-0123456789ab"
+	accountID = "123456789012"
+	principal = "210987654321"
+	tgwARN    = "arn:aws:ec2:us-east-1:123456789012:transit-gateway/tgw-0123456789abcdef0"
+	subnetARN = "arn:aws:ec2:us-east-1:123456789012:subnet/subnet-0123456789abcdef0"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ram  ram.ResourceShareClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type shareModifier func(*v1alpha1.ResourceShare)
+
+func withConditions(c ...runtimev1alpha1.Condition) shareModifier {
+	return func(r *v1alpha1.ResourceShare) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) shareModifier {
+	return func(r *v1alpha1.ResourceShare) { meta.SetExternalName(r, n) }
+}
+
+func withAllowExternalPrincipals(b *bool) shareModifier {
+	return func(r *v1alpha1.ResourceShare) { r.Spec.ForProvider.AllowExternalPrincipals = b }
+}
+
+func withSubnetARNs(arns ...string) shareModifier {
+	return func(r *v1alpha1.ResourceShare) { r.Spec.ForProvider.SubnetARNs = arns }
+}
+
+func withPrincipals(p ...string) shareModifier {
+	return func(r *v1alpha1.ResourceShare) { r.Spec.ForProvider.Principals = p }
+}
+
+func withTags(t ...v1alpha1.Tag) shareModifier {
+	return func(r *v1alpha1.ResourceShare) { r.Spec.ForProvider.Tags = t }
+}
+
+func withObservation() shareModifier {
+	return func(r *v1alpha1.ResourceShare) {
+		r.Status.AtProvider = v1alpha1.ResourceShareObservation{
+			ARN:             shareARN,
+			OwningAccountID: accountID,
+			Status:          string(awsram.ResourceShareStatusActive),
+		}
+	}
+}
+
+func resourceShare(m ...shareModifier) *v1alpha1.ResourceShare {
+	cr := &v1alpha1.ResourceShare{
+		Spec: v1alpha1.ResourceShareSpec{
+			ForProvider: v1alpha1.ResourceShareParameters{
+				Name:                    shareName,
+				AllowExternalPrincipals: aws.Bool(false),
+				TransitGatewayARNs:      []string{tgwARN},
+				Principals:              []string{principal},
+			},
+		},
+	}
+	meta.SetExternalName(cr, shareARN)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getResourceShares(*awsram.GetResourceSharesInput) awsram.GetResourceSharesRequest {
+	return awsram.GetResourceSharesRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsram.GetResourceSharesOutput{
+			ResourceShares: []awsram.ResourceShare{{
+				ResourceShareArn:        aws.String(shareARN),
+				Name:                    aws.String(shareName),
+				OwningAccountId:         aws.String(accountID),
+				AllowExternalPrincipals: aws.Bool(false),
+				Status:                  awsram.ResourceShareStatusActive,
+			}},
+		}},
+	}
+}
+
+func getAssociations(input *awsram.GetResourceShareAssociationsInput) awsram.GetResourceShareAssociationsRequest {
+	entity := principal
+	if input.AssociationType == awsram.ResourceShareAssociationTypeResource {
+		entity = tgwARN
+	}
+	return awsram.GetResourceShareAssociationsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsram.GetResourceShareAssociationsOutput{
+			ResourceShareAssociations: []awsram.ResourceShareAssociation{
+				{AssociatedEntity: aws.String(entity), Status: awsram.ResourceShareAssociationStatusAssociated},
+				{AssociatedEntity: aws.String("disassociated"), Status: awsram.ResourceShareAssociationStatusDisassociated},
+			},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				ram: &fake.MockResourceShareClient{
+					MockGetResourceShares:            getResourceShares,
+					MockGetResourceShareAssociations: getAssociations,
+				},
+				cr: resourceShare(),
+			},
+			want: want{
+				cr: resourceShare(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				ram: &fake.MockResourceShareClient{
+					MockGetResourceShares:            getResourceShares,
+					MockGetResourceShareAssociations: getAssociations,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   resourceShare(withAllowExternalPrincipals(nil)),
+			},
+			want: want{
+				cr: resourceShare(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ResourcesNotUpToDate": {
+			args: args{
+				ram: &fake.MockResourceShareClient{
+					MockGetResourceShares:            getResourceShares,
+					MockGetResourceShareAssociations: getAssociations,
+				},
+				cr: resourceShare(withSubnetARNs(subnetARN)),
+			},
+			want: want{
+				cr: resourceShare(withSubnetARNs(subnetARN), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"TagsNotUpToDate": {
+			args: args{
+				ram: &fake.MockResourceShareClient{
+					MockGetResourceShares:            getResourceShares,
+					MockGetResourceShareAssociations: getAssociations,
+				},
+				cr: resourceShare(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: resourceShare(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				ram: &fake.MockResourceShareClient{
+					MockGetResourceShares: func(*awsram.GetResourceSharesInput) awsram.GetResourceSharesRequest {
+						return awsram.GetResourceSharesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsram.GetResourceSharesOutput{
+								ResourceShares: []awsram.ResourceShare{{ResourceShareArn: aws.String(shareARN), Status: awsram.ResourceShareStatusDeleted}},
+							}},
+						}
+					},
+				},
+				cr: resourceShare(),
+			},
+			want: want{
+				cr: resourceShare(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				ram: &fake.MockResourceShareClient{
+					MockGetResourceShares: func(*awsram.GetResourceSharesInput) awsram.GetResourceSharesRequest {
+						return awsram.GetResourceSharesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsram.ErrCodeUnknownResourceException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resourceShare(),
+			},
+			want: want{
+				cr: resourceShare(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ram: &fake.MockResourceShareClient{
+					MockGetResourceShares: func(*awsram.GetResourceSharesInput) awsram.GetResourceSharesRequest {
+						return awsram.GetResourceSharesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resourceShare(),
+			},
+			want: want{
+				cr:  resourceShare(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ram, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				ram: &fake.MockResourceShareClient{
+					MockCreateResourceShare: func(input *awsram.CreateResourceShareInput) awsram.CreateResourceShareRequest {
+						if diff := cmp.Diff([]string{tgwARN, subnetARN}, input.ResourceArns); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsram.CreateResourceShareRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsram.CreateResourceShareOutput{
+								ResourceShare: &awsram.ResourceShare{ResourceShareArn: aws.String(shareARN)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   resourceShare(withExternalName(""), withSubnetARNs(subnetARN)),
+			},
+			want: want{
+				cr: resourceShare(withSubnetARNs(subnetARN), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ram: &fake.MockResourceShareClient{
+					MockCreateResourceShare: func(*awsram.CreateResourceShareInput) awsram.CreateResourceShareRequest {
+						return awsram.CreateResourceShareRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resourceShare(withExternalName("")),
+			},
+			want: want{
+				cr:  resourceShare(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ram, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateAssociations": {
+			args: args{
+				ram: &fake.MockResourceShareClient{
+					MockGetResourceShares:            getResourceShares,
+					MockGetResourceShareAssociations: getAssociations,
+					MockDisassociateResourceShare: func(input *awsram.DisassociateResourceShareInput) awsram.DisassociateResourceShareRequest {
+						if diff := cmp.Diff([]string{principal}, input.Principals); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsram.DisassociateResourceShareRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsram.DisassociateResourceShareOutput{}},
+						}
+					},
+					MockAssociateResourceShare: func(input *awsram.AssociateResourceShareInput) awsram.AssociateResourceShareRequest {
+						if diff := cmp.Diff([]string{subnetARN}, input.ResourceArns); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsram.AssociateResourceShareRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsram.AssociateResourceShareOutput{}},
+						}
+					},
+				},
+				cr: resourceShare(withSubnetARNs(subnetARN), withPrincipals()),
+			},
+			want: want{
+				cr: resourceShare(withSubnetARNs(subnetARN), withPrincipals()),
+			},
+		},
+		"UpdateAttributesAndTags": {
+			args: args{
+				ram: &fake.MockResourceShareClient{
+					MockGetResourceShares:            getResourceShares,
+					MockGetResourceShareAssociations: getAssociations,
+					MockUpdateResourceShare: func(input *awsram.UpdateResourceShareInput) awsram.UpdateResourceShareRequest {
+						if diff := cmp.Diff(aws.Bool(true), input.AllowExternalPrincipals); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsram.UpdateResourceShareRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsram.UpdateResourceShareOutput{}},
+						}
+					},
+					MockTagResource: func(input *awsram.TagResourceInput) awsram.TagResourceRequest {
+						if diff := cmp.Diff([]awsram.Tag{{Key: aws.String("k"), Value: aws.String("v")}}, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsram.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsram.TagResourceOutput{}},
+						}
+					},
+				},
+				cr: resourceShare(withAllowExternalPrincipals(aws.Bool(true)), withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: resourceShare(withAllowExternalPrincipals(aws.Bool(true)), withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ram: &fake.MockResourceShareClient{
+					MockGetResourceShares:            getResourceShares,
+					MockGetResourceShareAssociations: getAssociations,
+					MockAssociateResourceShare: func(*awsram.AssociateResourceShareInput) awsram.AssociateResourceShareRequest {
+						return awsram.AssociateResourceShareRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resourceShare(withSubnetARNs(tgwARN, subnetARN)),
+			},
+			want: want{
+				cr:  resourceShare(withSubnetARNs(tgwARN, subnetARN)),
+				err: errors.Wrap(errBoom, errAssociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ram, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				ram: &fake.MockResourceShareClient{
+					MockDeleteResourceShare: func(*awsram.DeleteResourceShareInput) awsram.DeleteResourceShareRequest {
+						return awsram.DeleteResourceShareRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsram.DeleteResourceShareOutput{}},
+						}
+					},
+				},
+				cr: resourceShare(),
+			},
+			want: want{
+				cr: resourceShare(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ram: &fake.MockResourceShareClient{
+					MockDeleteResourceShare: func(*awsram.DeleteResourceShareInput) awsram.DeleteResourceShareRequest {
+						return awsram.DeleteResourceShareRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resourceShare(),
+			},
+			want: want{
+				cr:  resourceShare(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ram, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/healthcheck"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a HealthCheck resource"
+	errKubeUpdate       = "failed to update the HealthCheck custom resource"
+	errGet              = "failed to get the HealthCheck resource"
+	errListTags         = "failed to list the tags of the HealthCheck resource"
+	errCreate           = "failed to create the HealthCheck resource"
+	errUpdate           = "failed to update the HealthCheck resource"
+	errTag              = "failed to update the tags of the HealthCheck resource"
+	errDelete           = "failed to delete the HealthCheck resource"
+
+	// resourceType is the Route 53 resource type of health checks in the
+	// tagging API.
+	resourceType = route53.TagResourceTypeHealthcheck
+)
+
+// SetupHealthCheck adds a controller that reconciles HealthChecks.
+func SetupHealthCheck(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.HealthCheckGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.HealthCheck{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.HealthCheckGroupVersionKind),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: healthcheck.NewClient}))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) healthcheck.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, awscommon.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client healthcheck.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.HealthCheck)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	res, err := e.client.GetHealthCheckRequest(&route53.GetHealthCheckInput{
+		HealthCheckId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(healthcheck.IsNotFound, err), errGet)
+	}
+	hc := *res.HealthCheck
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	healthcheck.LateInitialize(&cr.Spec.ForProvider, hc)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdate)
+		}
+	}
+
+	tags, err := e.client.ListTagsForResourceRequest(&route53.ListTagsForResourceInput{
+		ResourceId:   aws.String(meta.GetExternalName(cr)),
+		ResourceType: resourceType,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	var observedTags []route53.Tag
+	if tags.ResourceTagSet != nil {
+		observedTags = tags.ResourceTagSet.Tags
+	}
+	add, remove := healthcheck.DiffTags(cr.Spec.ForProvider.Tags, observedTags)
+
+	cr.Status.AtProvider = healthcheck.GenerateObservation(hc)
+	cr.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: healthcheck.IsUpToDate(cr.Spec.ForProvider, hc) && len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.HealthCheck)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	res, err := e.client.CreateHealthCheckRequest(healthcheck.GenerateCreateHealthCheckInput(string(cr.GetUID()), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(res.HealthCheck.Id))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errKubeUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.HealthCheck)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := meta.GetExternalName(cr)
+
+	if _, err := e.client.UpdateHealthCheckRequest(healthcheck.GenerateUpdateHealthCheckInput(id, cr.Spec.ForProvider)).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	tags, err := e.client.ListTagsForResourceRequest(&route53.ListTagsForResourceInput{
+		ResourceId:   aws.String(id),
+		ResourceType: resourceType,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTags)
+	}
+	var observedTags []route53.Tag
+	if tags.ResourceTagSet != nil {
+		observedTags = tags.ResourceTagSet.Tags
+	}
+	add, remove := healthcheck.DiffTags(cr.Spec.ForProvider.Tags, observedTags)
+	if len(add) == 0 && len(remove) == 0 {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.ChangeTagsForResourceRequest(&route53.ChangeTagsForResourceInput{
+		ResourceId:    aws.String(id),
+		ResourceType:  resourceType,
+		AddTags:       add,
+		RemoveTagKeys: remove,
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errTag)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.HealthCheck)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteHealthCheckRequest(&route53.DeleteHealthCheckInput{
+		HealthCheckId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(healthcheck.IsNotFound, err), errDelete)
+}
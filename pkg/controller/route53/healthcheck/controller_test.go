@@ -0,0 +1,516 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/healthcheck"
+	"github.com/crossplane/provider-aws/pkg/clients/healthcheck/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	hcID     = "abcdef11-2222-3333-4444-555555fedcba"
+	callRef  = "a96abeca-8da3-40fc-a2d5-08d72084eb65"
+	ip       = "192.0.2.10"
+	path     = "/health"
+	interval = int64(30)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	route53 healthcheck.Client
+	kube    *test.MockClient
+	cr      resource.Managed
+}
+
+type healthCheckModifier func(*v1alpha1.HealthCheck)
+
+func withConditions(c ...runtimev1alpha1.Condition) healthCheckModifier {
+	return func(r *v1alpha1.HealthCheck) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) healthCheckModifier {
+	return func(r *v1alpha1.HealthCheck) { meta.SetExternalName(r, n) }
+}
+
+func withRequestInterval(i *int64) healthCheckModifier {
+	return func(r *v1alpha1.HealthCheck) { r.Spec.ForProvider.RequestInterval = i }
+}
+
+func withResourcePath(p string) healthCheckModifier {
+	return func(r *v1alpha1.HealthCheck) { r.Spec.ForProvider.ResourcePath = &p }
+}
+
+func withTags(t ...v1alpha1.Tag) healthCheckModifier {
+	return func(r *v1alpha1.HealthCheck) { r.Spec.ForProvider.Tags = t }
+}
+
+func withObservation() healthCheckModifier {
+	return func(r *v1alpha1.HealthCheck) {
+		r.Status.AtProvider = v1alpha1.HealthCheckObservation{CallerReference: callRef, HealthCheckVersion: 1}
+	}
+}
+
+func healthCheck(m ...healthCheckModifier) *v1alpha1.HealthCheck {
+	cr := &v1alpha1.HealthCheck{
+		Spec: v1alpha1.HealthCheckSpec{
+			ForProvider: v1alpha1.HealthCheckParameters{
+				Type:            string(awsroute53.HealthCheckTypeHttp),
+				IPAddress:       aws.String(ip),
+				ResourcePath:    aws.String(path),
+				RequestInterval: aws.Int64(interval),
+			},
+		},
+	}
+	meta.SetExternalName(cr, hcID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getHealthCheck(*awsroute53.GetHealthCheckInput) awsroute53.GetHealthCheckRequest {
+	return awsroute53.GetHealthCheckRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsroute53.GetHealthCheckOutput{
+			HealthCheck: &awsroute53.HealthCheck{
+				Id:                 aws.String(hcID),
+				CallerReference:    aws.String(callRef),
+				HealthCheckVersion: aws.Int64(1),
+				HealthCheckConfig: &awsroute53.HealthCheckConfig{
+					Type:            awsroute53.HealthCheckTypeHttp,
+					IPAddress:       aws.String(ip),
+					ResourcePath:    aws.String(path),
+					RequestInterval: aws.Int64(interval),
+				},
+			},
+		}},
+	}
+}
+
+func listTags(tags ...awsroute53.Tag) func(*awsroute53.ListTagsForResourceInput) awsroute53.ListTagsForResourceRequest {
+	return func(*awsroute53.ListTagsForResourceInput) awsroute53.ListTagsForResourceRequest {
+		return awsroute53.ListTagsForResourceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsroute53.ListTagsForResourceOutput{
+				ResourceTagSet: &awsroute53.ResourceTagSet{Tags: tags},
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockGetHealthCheckRequest:      getHealthCheck,
+					MockListTagsForResourceRequest: listTags(),
+				},
+				cr: healthCheck(),
+			},
+			want: want{
+				cr: healthCheck(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockGetHealthCheckRequest:      getHealthCheck,
+					MockListTagsForResourceRequest: listTags(),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   healthCheck(withRequestInterval(nil)),
+			},
+			want: want{
+				cr: healthCheck(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ConfigNotUpToDate": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockGetHealthCheckRequest:      getHealthCheck,
+					MockListTagsForResourceRequest: listTags(),
+				},
+				cr: healthCheck(withResourcePath("/ready")),
+			},
+			want: want{
+				cr: healthCheck(withResourcePath("/ready"), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"TagsNotUpToDate": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockGetHealthCheckRequest:      getHealthCheck,
+					MockListTagsForResourceRequest: listTags(awsroute53.Tag{Key: aws.String("k"), Value: aws.String("old")}),
+				},
+				cr: healthCheck(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: healthCheck(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockGetHealthCheckRequest: func(*awsroute53.GetHealthCheckInput) awsroute53.GetHealthCheckRequest {
+						return awsroute53.GetHealthCheckRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsroute53.ErrCodeNoSuchHealthCheck, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: healthCheck(),
+			},
+			want: want{
+				cr: healthCheck(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockGetHealthCheckRequest: func(*awsroute53.GetHealthCheckInput) awsroute53.GetHealthCheckRequest {
+						return awsroute53.GetHealthCheckRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: healthCheck(),
+			},
+			want: want{
+				cr:  healthCheck(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.route53, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockCreateHealthCheckRequest: func(input *awsroute53.CreateHealthCheckInput) awsroute53.CreateHealthCheckRequest {
+						if diff := cmp.Diff(path, aws.StringValue(input.HealthCheckConfig.ResourcePath)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsroute53.CreateHealthCheckRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsroute53.CreateHealthCheckOutput{
+								HealthCheck: &awsroute53.HealthCheck{Id: aws.String(hcID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   healthCheck(withExternalName("")),
+			},
+			want: want{
+				cr: healthCheck(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockCreateHealthCheckRequest: func(*awsroute53.CreateHealthCheckInput) awsroute53.CreateHealthCheckRequest {
+						return awsroute53.CreateHealthCheckRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: healthCheck(withExternalName("")),
+			},
+			want: want{
+				cr:  healthCheck(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.route53, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	updateHealthCheck := func(*awsroute53.UpdateHealthCheckInput) awsroute53.UpdateHealthCheckRequest {
+		return awsroute53.UpdateHealthCheckRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsroute53.UpdateHealthCheckOutput{}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockUpdateHealthCheckRequest:   updateHealthCheck,
+					MockListTagsForResourceRequest: listTags(awsroute53.Tag{Key: aws.String("old"), Value: aws.String("v")}),
+					MockChangeTagsForResourceRequest: func(input *awsroute53.ChangeTagsForResourceInput) awsroute53.ChangeTagsForResourceRequest {
+						if diff := cmp.Diff([]string{"old"}, input.RemoveTagKeys); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsroute53.ChangeTagsForResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsroute53.ChangeTagsForResourceOutput{}},
+						}
+					},
+				},
+				cr: healthCheck(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: healthCheck(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockUpdateHealthCheckRequest: func(*awsroute53.UpdateHealthCheckInput) awsroute53.UpdateHealthCheckRequest {
+						return awsroute53.UpdateHealthCheckRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: healthCheck(),
+			},
+			want: want{
+				cr:  healthCheck(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+		"TagError": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockUpdateHealthCheckRequest:   updateHealthCheck,
+					MockListTagsForResourceRequest: listTags(),
+					MockChangeTagsForResourceRequest: func(*awsroute53.ChangeTagsForResourceInput) awsroute53.ChangeTagsForResourceRequest {
+						return awsroute53.ChangeTagsForResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: healthCheck(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr:  healthCheck(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+				err: errors.Wrap(errBoom, errTag),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.route53, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockDeleteHealthCheckRequest: func(*awsroute53.DeleteHealthCheckInput) awsroute53.DeleteHealthCheckRequest {
+						return awsroute53.DeleteHealthCheckRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsroute53.DeleteHealthCheckOutput{}},
+						}
+					},
+				},
+				cr: healthCheck(),
+			},
+			want: want{
+				cr: healthCheck(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockDeleteHealthCheckRequest: func(*awsroute53.DeleteHealthCheckInput) awsroute53.DeleteHealthCheckRequest {
+						return awsroute53.DeleteHealthCheckRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsroute53.ErrCodeNoSuchHealthCheck, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: healthCheck(),
+			},
+			want: want{
+				cr: healthCheck(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockDeleteHealthCheckRequest: func(*awsroute53.DeleteHealthCheckInput) awsroute53.DeleteHealthCheckRequest {
+						return awsroute53.DeleteHealthCheckRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: healthCheck(),
+			},
+			want: want{
+				cr:  healthCheck(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.route53, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
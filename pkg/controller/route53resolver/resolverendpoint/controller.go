@@ -0,0 +1,226 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolverendpoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsr53r "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	r53r "github.com/crossplane/provider-aws/pkg/clients/route53resolver"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a ResolverEndpoint resource"
+
+	errDescribe    = "failed to describe the ResolverEndpoint resource"
+	errListIPs     = "failed to list the IP addresses of the ResolverEndpoint resource"
+	errCreate      = "failed to create the ResolverEndpoint resource"
+	errUpdate      = "failed to update the ResolverEndpoint resource"
+	errAssociateIP = "failed to associate an IP address with the ResolverEndpoint resource"
+	errRemoveIP    = "failed to disassociate an IP address from the ResolverEndpoint resource"
+	errDelete      = "failed to delete the ResolverEndpoint resource"
+	errSpecUpdate  = "cannot update spec of the ResolverEndpoint custom resource"
+)
+
+// SetupResolverEndpoint adds a controller that reconciles ResolverEndpoints.
+func SetupResolverEndpoint(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ResolverEndpointGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ResolverEndpoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ResolverEndpointGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: r53r.NewResolverEndpointClient}, awsclients.DeletionTierAttachment), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) r53r.ResolverEndpointClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ResolverEndpoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client r53r.ResolverEndpointClient
+}
+
+// ipAddresses returns the IP addresses of the resolver endpoint with the
+// given ID.
+func (e *external) ipAddresses(ctx context.Context, id string) ([]awsr53r.IpAddressResponse, error) {
+	in := &awsr53r.ListResolverEndpointIpAddressesInput{ResolverEndpointId: aws.String(id)}
+	var ips []awsr53r.IpAddressResponse
+	for {
+		rsp, err := e.client.ListResolverEndpointIpAddressesRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ips = append(ips, rsp.IpAddresses...)
+		if rsp.NextToken == nil {
+			return ips, nil
+		}
+		in.NextToken = rsp.NextToken
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.ResolverEndpoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	rsp, err := e.client.GetResolverEndpointRequest(&awsr53r.GetResolverEndpointInput{
+		ResolverEndpointId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(r53r.IsNotFound, err), errDescribe)
+	}
+	endpoint := *rsp.ResolverEndpoint
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	r53r.LateInitializeResolverEndpoint(&cr.Spec.ForProvider, endpoint)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = r53r.GenerateResolverEndpointObservation(endpoint)
+	switch endpoint.Status {
+	case awsr53r.ResolverEndpointStatusOperational, awsr53r.ResolverEndpointStatusUpdating:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsr53r.ResolverEndpointStatusCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsr53r.ResolverEndpointStatusDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	// The IP addresses of an endpoint can only be changed once it is
+	// operational.
+	if endpoint.Status != awsr53r.ResolverEndpointStatusOperational {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	ips, err := e.ipAddresses(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListIPs)
+	}
+	associate, disassociate := r53r.DiffIPAddresses(cr.Spec.ForProvider.IPAddresses, ips)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: r53r.IsResolverEndpointUpToDate(cr.Spec.ForProvider, endpoint) &&
+			len(associate) == 0 && len(disassociate) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.ResolverEndpoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.CreateResolverEndpointRequest(r53r.GenerateCreateResolverEndpointInput(string(cr.GetUID()), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.ResolverEndpoint.Id))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.ResolverEndpoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := meta.GetExternalName(cr)
+	if _, err := e.client.UpdateResolverEndpointRequest(&awsr53r.UpdateResolverEndpointInput{
+		ResolverEndpointId: aws.String(id),
+		Name:               cr.Spec.ForProvider.Name,
+	}).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	ips, err := e.ipAddresses(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListIPs)
+	}
+	associate, disassociate := r53r.DiffIPAddresses(cr.Spec.ForProvider.IPAddresses, ips)
+	// NOTE: An endpoint needs at least two IP addresses, so the new ones are
+	// associated before the stale ones are disassociated.
+	for i := range associate {
+		if _, err := e.client.AssociateResolverEndpointIpAddressRequest(&awsr53r.AssociateResolverEndpointIpAddressInput{
+			ResolverEndpointId: aws.String(id),
+			IpAddress:          &associate[i],
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAssociateIP)
+		}
+	}
+	for i := range disassociate {
+		if _, err := e.client.DisassociateResolverEndpointIpAddressRequest(&awsr53r.DisassociateResolverEndpointIpAddressInput{
+			ResolverEndpointId: aws.String(id),
+			IpAddress:          &disassociate[i],
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveIP)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.ResolverEndpoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteResolverEndpointRequest(&awsr53r.DeleteResolverEndpointInput{
+		ResolverEndpointId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(r53r.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,534 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolverendpoint
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsr53r "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	r53r "github.com/crossplane/provider-aws/pkg/clients/route53resolver"
+	"github.com/crossplane/provider-aws/pkg/clients/route53resolver/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	endpointID   = "rslvr-out-0123456789abcdef0"
+	endpointName = "outbound"
+	endpointARN  = "arn:aws:route53resolver:us-east-1:123456789012:resolver-endpoint/rslvr-out-0123456789abcdef0"
+	vpcID        = "vpc-0123456789abcdef0"
+	subnetA      = "subnet-a"
+	subnetB      = "subnet-b"
+	sgID         = "sg-0123456789abcdef0"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	r53r r53r.ResolverEndpointClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type endpointModifier func(*v1alpha1.ResolverEndpoint)
+
+func withConditions(c ...runtimev1alpha1.Condition) endpointModifier {
+	return func(r *v1alpha1.ResolverEndpoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) endpointModifier {
+	return func(r *v1alpha1.ResolverEndpoint) { meta.SetExternalName(r, n) }
+}
+
+func withSecurityGroupIDs(ids ...string) endpointModifier {
+	return func(r *v1alpha1.ResolverEndpoint) { r.Spec.ForProvider.SecurityGroupIDs = ids }
+}
+
+func withIPAddresses(ips ...v1alpha1.IPAddress) endpointModifier {
+	return func(r *v1alpha1.ResolverEndpoint) { r.Spec.ForProvider.IPAddresses = ips }
+}
+
+func withObservation(s awsr53r.ResolverEndpointStatus) endpointModifier {
+	return func(r *v1alpha1.ResolverEndpoint) {
+		r.Status.AtProvider = v1alpha1.ResolverEndpointObservation{
+			ARN:            endpointARN,
+			HostVPCID:      vpcID,
+			IPAddressCount: 2,
+			Status:         string(s),
+		}
+	}
+}
+
+func resolverEndpoint(m ...endpointModifier) *v1alpha1.ResolverEndpoint {
+	cr := &v1alpha1.ResolverEndpoint{
+		Spec: v1alpha1.ResolverEndpointSpec{
+			ForProvider: v1alpha1.ResolverEndpointParameters{
+				Name:             aws.String(endpointName),
+				Direction:        string(awsr53r.ResolverEndpointDirectionOutbound),
+				SecurityGroupIDs: []string{sgID},
+				IPAddresses: []v1alpha1.IPAddress{
+					{SubnetID: aws.String(subnetA)},
+					{SubnetID: aws.String(subnetB)},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, endpointID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getEndpoint(s awsr53r.ResolverEndpointStatus) func(*awsr53r.GetResolverEndpointInput) awsr53r.GetResolverEndpointRequest {
+	return func(*awsr53r.GetResolverEndpointInput) awsr53r.GetResolverEndpointRequest {
+		return awsr53r.GetResolverEndpointRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsr53r.GetResolverEndpointOutput{
+				ResolverEndpoint: &awsr53r.ResolverEndpoint{
+					Id:               aws.String(endpointID),
+					Arn:              aws.String(endpointARN),
+					Name:             aws.String(endpointName),
+					HostVPCId:        aws.String(vpcID),
+					IpAddressCount:   aws.Int64(2),
+					SecurityGroupIds: []string{sgID},
+					Status:           s,
+				},
+			}},
+		}
+	}
+}
+
+func listIPAddresses(*awsr53r.ListResolverEndpointIpAddressesInput) awsr53r.ListResolverEndpointIpAddressesRequest {
+	return awsr53r.ListResolverEndpointIpAddressesRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsr53r.ListResolverEndpointIpAddressesOutput{
+			IpAddresses: []awsr53r.IpAddressResponse{
+				{IpId: aws.String("rni-a"), SubnetId: aws.String(subnetA), Ip: aws.String("10.0.0.5")},
+				{IpId: aws.String("rni-b"), SubnetId: aws.String(subnetB), Ip: aws.String("10.0.1.5")},
+			},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				r53r: &fake.MockResolverEndpointClient{
+					MockGetResolverEndpointRequest:             getEndpoint(awsr53r.ResolverEndpointStatusOperational),
+					MockListResolverEndpointIpAddressesRequest: listIPAddresses,
+				},
+				cr: resolverEndpoint(),
+			},
+			want: want{
+				cr: resolverEndpoint(withObservation(awsr53r.ResolverEndpointStatusOperational), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				r53r: &fake.MockResolverEndpointClient{
+					MockGetResolverEndpointRequest:             getEndpoint(awsr53r.ResolverEndpointStatusOperational),
+					MockListResolverEndpointIpAddressesRequest: listIPAddresses,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   resolverEndpoint(withSecurityGroupIDs()),
+			},
+			want: want{
+				cr: resolverEndpoint(withObservation(awsr53r.ResolverEndpointStatusOperational), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"IPAddressesNotUpToDate": {
+			args: args{
+				r53r: &fake.MockResolverEndpointClient{
+					MockGetResolverEndpointRequest:             getEndpoint(awsr53r.ResolverEndpointStatusOperational),
+					MockListResolverEndpointIpAddressesRequest: listIPAddresses,
+				},
+				cr: resolverEndpoint(withIPAddresses(v1alpha1.IPAddress{SubnetID: aws.String(subnetA)}, v1alpha1.IPAddress{SubnetID: aws.String(subnetA)})),
+			},
+			want: want{
+				cr: resolverEndpoint(
+					withIPAddresses(v1alpha1.IPAddress{SubnetID: aws.String(subnetA)}, v1alpha1.IPAddress{SubnetID: aws.String(subnetA)}),
+					withObservation(awsr53r.ResolverEndpointStatusOperational),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				r53r: &fake.MockResolverEndpointClient{
+					MockGetResolverEndpointRequest: getEndpoint(awsr53r.ResolverEndpointStatusCreating),
+				},
+				cr: resolverEndpoint(),
+			},
+			want: want{
+				cr: resolverEndpoint(withObservation(awsr53r.ResolverEndpointStatusCreating), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				r53r: &fake.MockResolverEndpointClient{
+					MockGetResolverEndpointRequest: func(*awsr53r.GetResolverEndpointInput) awsr53r.GetResolverEndpointRequest {
+						return awsr53r.GetResolverEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsr53r.ErrCodeResourceNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resolverEndpoint(),
+			},
+			want: want{
+				cr: resolverEndpoint(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				r53r: &fake.MockResolverEndpointClient{
+					MockGetResolverEndpointRequest: func(*awsr53r.GetResolverEndpointInput) awsr53r.GetResolverEndpointRequest {
+						return awsr53r.GetResolverEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resolverEndpoint(),
+			},
+			want: want{
+				cr:  resolverEndpoint(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.r53r, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				r53r: &fake.MockResolverEndpointClient{
+					MockCreateResolverEndpointRequest: func(input *awsr53r.CreateResolverEndpointInput) awsr53r.CreateResolverEndpointRequest {
+						if diff := cmp.Diff(2, len(input.IpAddresses)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsr53r.CreateResolverEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsr53r.CreateResolverEndpointOutput{
+								ResolverEndpoint: &awsr53r.ResolverEndpoint{Id: aws.String(endpointID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   resolverEndpoint(withExternalName("")),
+			},
+			want: want{
+				cr: resolverEndpoint(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				r53r: &fake.MockResolverEndpointClient{
+					MockCreateResolverEndpointRequest: func(*awsr53r.CreateResolverEndpointInput) awsr53r.CreateResolverEndpointRequest {
+						return awsr53r.CreateResolverEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resolverEndpoint(withExternalName("")),
+			},
+			want: want{
+				cr:  resolverEndpoint(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.r53r, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	updateEndpoint := func(*awsr53r.UpdateResolverEndpointInput) awsr53r.UpdateResolverEndpointRequest {
+		return awsr53r.UpdateResolverEndpointRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsr53r.UpdateResolverEndpointOutput{}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				r53r: &fake.MockResolverEndpointClient{
+					MockUpdateResolverEndpointRequest:          updateEndpoint,
+					MockListResolverEndpointIpAddressesRequest: listIPAddresses,
+					MockAssociateResolverEndpointIpAddressRequest: func(input *awsr53r.AssociateResolverEndpointIpAddressInput) awsr53r.AssociateResolverEndpointIpAddressRequest {
+						if diff := cmp.Diff(subnetA, aws.StringValue(input.IpAddress.SubnetId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsr53r.AssociateResolverEndpointIpAddressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsr53r.AssociateResolverEndpointIpAddressOutput{}},
+						}
+					},
+					MockDisassociateResolverEndpointIpAddressRequest: func(input *awsr53r.DisassociateResolverEndpointIpAddressInput) awsr53r.DisassociateResolverEndpointIpAddressRequest {
+						if diff := cmp.Diff("rni-b", aws.StringValue(input.IpAddress.IpId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsr53r.DisassociateResolverEndpointIpAddressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsr53r.DisassociateResolverEndpointIpAddressOutput{}},
+						}
+					},
+				},
+				cr: resolverEndpoint(withIPAddresses(v1alpha1.IPAddress{SubnetID: aws.String(subnetA)}, v1alpha1.IPAddress{SubnetID: aws.String(subnetA)})),
+			},
+			want: want{
+				cr: resolverEndpoint(withIPAddresses(v1alpha1.IPAddress{SubnetID: aws.String(subnetA)}, v1alpha1.IPAddress{SubnetID: aws.String(subnetA)})),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				r53r: &fake.MockResolverEndpointClient{
+					MockUpdateResolverEndpointRequest: func(*awsr53r.UpdateResolverEndpointInput) awsr53r.UpdateResolverEndpointRequest {
+						return awsr53r.UpdateResolverEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resolverEndpoint(),
+			},
+			want: want{
+				cr:  resolverEndpoint(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+		"AssociateError": {
+			args: args{
+				r53r: &fake.MockResolverEndpointClient{
+					MockUpdateResolverEndpointRequest:          updateEndpoint,
+					MockListResolverEndpointIpAddressesRequest: listIPAddresses,
+					MockAssociateResolverEndpointIpAddressRequest: func(*awsr53r.AssociateResolverEndpointIpAddressInput) awsr53r.AssociateResolverEndpointIpAddressRequest {
+						return awsr53r.AssociateResolverEndpointIpAddressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resolverEndpoint(withIPAddresses(v1alpha1.IPAddress{SubnetID: aws.String(subnetA)}, v1alpha1.IPAddress{SubnetID: aws.String(subnetA)})),
+			},
+			want: want{
+				cr:  resolverEndpoint(withIPAddresses(v1alpha1.IPAddress{SubnetID: aws.String(subnetA)}, v1alpha1.IPAddress{SubnetID: aws.String(subnetA)})),
+				err: errors.Wrap(errBoom, errAssociateIP),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.r53r, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				r53r: &fake.MockResolverEndpointClient{
+					MockDeleteResolverEndpointRequest: func(*awsr53r.DeleteResolverEndpointInput) awsr53r.DeleteResolverEndpointRequest {
+						return awsr53r.DeleteResolverEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsr53r.DeleteResolverEndpointOutput{}},
+						}
+					},
+				},
+				cr: resolverEndpoint(),
+			},
+			want: want{
+				cr: resolverEndpoint(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				r53r: &fake.MockResolverEndpointClient{
+					MockDeleteResolverEndpointRequest: func(*awsr53r.DeleteResolverEndpointInput) awsr53r.DeleteResolverEndpointRequest {
+						return awsr53r.DeleteResolverEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsr53r.ErrCodeResourceNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resolverEndpoint(),
+			},
+			want: want{
+				cr: resolverEndpoint(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				r53r: &fake.MockResolverEndpointClient{
+					MockDeleteResolverEndpointRequest: func(*awsr53r.DeleteResolverEndpointInput) awsr53r.DeleteResolverEndpointRequest {
+						return awsr53r.DeleteResolverEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resolverEndpoint(),
+			},
+			want: want{
+				cr:  resolverEndpoint(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.r53r, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,465 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolverrule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsr53r "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	r53r "github.com/crossplane/provider-aws/pkg/clients/route53resolver"
+	"github.com/crossplane/provider-aws/pkg/clients/route53resolver/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	ruleID     = "rslvr-rr-0123456789abcdef0"
+	ruleName   = "corp"
+	ruleARN    = "arn:aws:route53resolver:us-east-1:123456789012:resolver-rule/rslvr-rr-0123456789abcdef0"
+	accountID  = "123456789012"
+	endpointID = "rslvr-out-0123456789abcdef0"
+	targetIP   = "10.1.0.2"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	r53r r53r.ResolverRuleClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type ruleModifier func(*v1alpha1.ResolverRule)
+
+func withConditions(c ...runtimev1alpha1.Condition) ruleModifier {
+	return func(r *v1alpha1.ResolverRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) ruleModifier {
+	return func(r *v1alpha1.ResolverRule) { meta.SetExternalName(r, n) }
+}
+
+func withName(n *string) ruleModifier {
+	return func(r *v1alpha1.ResolverRule) { r.Spec.ForProvider.Name = n }
+}
+
+func withTargetIPs(t ...v1alpha1.TargetAddress) ruleModifier {
+	return func(r *v1alpha1.ResolverRule) { r.Spec.ForProvider.TargetIPs = t }
+}
+
+func withObservation(s awsr53r.ResolverRuleStatus) ruleModifier {
+	return func(r *v1alpha1.ResolverRule) {
+		r.Status.AtProvider = v1alpha1.ResolverRuleObservation{
+			ARN:         ruleARN,
+			OwnerID:     accountID,
+			ShareStatus: string(awsr53r.ShareStatusNotShared),
+			Status:      string(s),
+		}
+	}
+}
+
+func resolverRule(m ...ruleModifier) *v1alpha1.ResolverRule {
+	cr := &v1alpha1.ResolverRule{
+		Spec: v1alpha1.ResolverRuleSpec{
+			ForProvider: v1alpha1.ResolverRuleParameters{
+				Name:               aws.String(ruleName),
+				RuleType:           string(awsr53r.RuleTypeOptionForward),
+				DomainName:         "corp.example.com",
+				ResolverEndpointID: aws.String(endpointID),
+				TargetIPs:          []v1alpha1.TargetAddress{{IP: targetIP, Port: aws.Int64(53)}},
+			},
+		},
+	}
+	meta.SetExternalName(cr, ruleID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getRule(s awsr53r.ResolverRuleStatus) func(*awsr53r.GetResolverRuleInput) awsr53r.GetResolverRuleRequest {
+	return func(*awsr53r.GetResolverRuleInput) awsr53r.GetResolverRuleRequest {
+		return awsr53r.GetResolverRuleRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsr53r.GetResolverRuleOutput{
+				ResolverRule: &awsr53r.ResolverRule{
+					Id:                 aws.String(ruleID),
+					Arn:                aws.String(ruleARN),
+					Name:               aws.String(ruleName),
+					OwnerId:            aws.String(accountID),
+					ShareStatus:        awsr53r.ShareStatusNotShared,
+					DomainName:         aws.String("corp.example.com."),
+					RuleType:           awsr53r.RuleTypeOptionForward,
+					ResolverEndpointId: aws.String(endpointID),
+					TargetIps:          []awsr53r.TargetAddress{{Ip: aws.String(targetIP), Port: aws.Int64(53)}},
+					Status:             s,
+				},
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				r53r: &fake.MockResolverRuleClient{
+					MockGetResolverRuleRequest: getRule(awsr53r.ResolverRuleStatusComplete),
+				},
+				cr: resolverRule(),
+			},
+			want: want{
+				cr: resolverRule(withObservation(awsr53r.ResolverRuleStatusComplete), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				r53r: &fake.MockResolverRuleClient{
+					MockGetResolverRuleRequest: getRule(awsr53r.ResolverRuleStatusComplete),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   resolverRule(withName(nil), withTargetIPs(v1alpha1.TargetAddress{IP: targetIP})),
+			},
+			want: want{
+				cr: resolverRule(withObservation(awsr53r.ResolverRuleStatusComplete), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TargetIPsNotUpToDate": {
+			args: args{
+				r53r: &fake.MockResolverRuleClient{
+					MockGetResolverRuleRequest: getRule(awsr53r.ResolverRuleStatusComplete),
+				},
+				cr: resolverRule(withTargetIPs(v1alpha1.TargetAddress{IP: "10.1.0.3"})),
+			},
+			want: want{
+				cr: resolverRule(withTargetIPs(v1alpha1.TargetAddress{IP: "10.1.0.3"}), withObservation(awsr53r.ResolverRuleStatusComplete), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"Failed": {
+			args: args{
+				r53r: &fake.MockResolverRuleClient{
+					MockGetResolverRuleRequest: getRule(awsr53r.ResolverRuleStatusFailed),
+				},
+				cr: resolverRule(),
+			},
+			want: want{
+				cr: resolverRule(withObservation(awsr53r.ResolverRuleStatusFailed), withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				r53r: &fake.MockResolverRuleClient{
+					MockGetResolverRuleRequest: func(*awsr53r.GetResolverRuleInput) awsr53r.GetResolverRuleRequest {
+						return awsr53r.GetResolverRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsr53r.ErrCodeResourceNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resolverRule(),
+			},
+			want: want{
+				cr: resolverRule(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				r53r: &fake.MockResolverRuleClient{
+					MockGetResolverRuleRequest: func(*awsr53r.GetResolverRuleInput) awsr53r.GetResolverRuleRequest {
+						return awsr53r.GetResolverRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resolverRule(),
+			},
+			want: want{
+				cr:  resolverRule(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.r53r, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				r53r: &fake.MockResolverRuleClient{
+					MockCreateResolverRuleRequest: func(*awsr53r.CreateResolverRuleInput) awsr53r.CreateResolverRuleRequest {
+						return awsr53r.CreateResolverRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsr53r.CreateResolverRuleOutput{
+								ResolverRule: &awsr53r.ResolverRule{Id: aws.String(ruleID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   resolverRule(withExternalName("")),
+			},
+			want: want{
+				cr: resolverRule(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				r53r: &fake.MockResolverRuleClient{
+					MockCreateResolverRuleRequest: func(*awsr53r.CreateResolverRuleInput) awsr53r.CreateResolverRuleRequest {
+						return awsr53r.CreateResolverRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resolverRule(withExternalName("")),
+			},
+			want: want{
+				cr:  resolverRule(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.r53r, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				r53r: &fake.MockResolverRuleClient{
+					MockUpdateResolverRuleRequest: func(input *awsr53r.UpdateResolverRuleInput) awsr53r.UpdateResolverRuleRequest {
+						if diff := cmp.Diff(ruleName, aws.StringValue(input.Config.Name)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsr53r.UpdateResolverRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsr53r.UpdateResolverRuleOutput{}},
+						}
+					},
+				},
+				cr: resolverRule(),
+			},
+			want: want{
+				cr: resolverRule(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				r53r: &fake.MockResolverRuleClient{
+					MockUpdateResolverRuleRequest: func(*awsr53r.UpdateResolverRuleInput) awsr53r.UpdateResolverRuleRequest {
+						return awsr53r.UpdateResolverRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resolverRule(),
+			},
+			want: want{
+				cr:  resolverRule(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.r53r, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				r53r: &fake.MockResolverRuleClient{
+					MockDeleteResolverRuleRequest: func(*awsr53r.DeleteResolverRuleInput) awsr53r.DeleteResolverRuleRequest {
+						return awsr53r.DeleteResolverRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsr53r.DeleteResolverRuleOutput{}},
+						}
+					},
+				},
+				cr: resolverRule(),
+			},
+			want: want{
+				cr: resolverRule(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				r53r: &fake.MockResolverRuleClient{
+					MockDeleteResolverRuleRequest: func(*awsr53r.DeleteResolverRuleInput) awsr53r.DeleteResolverRuleRequest {
+						return awsr53r.DeleteResolverRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: resolverRule(),
+			},
+			want: want{
+				cr:  resolverRule(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.r53r, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,367 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolverruleassociation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsr53r "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	r53r "github.com/crossplane/provider-aws/pkg/clients/route53resolver"
+	"github.com/crossplane/provider-aws/pkg/clients/route53resolver/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	associationID = "rslvr-rrassoc-0123456789abcdef0"
+	ruleID        = "rslvr-rr-0123456789abcdef0"
+	vpcID         = "vpc-0123456789abcdef0"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	r53r r53r.ResolverRuleAssociationClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type associationModifier func(*v1alpha1.ResolverRuleAssociation)
+
+func withConditions(c ...runtimev1alpha1.Condition) associationModifier {
+	return func(r *v1alpha1.ResolverRuleAssociation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) associationModifier {
+	return func(r *v1alpha1.ResolverRuleAssociation) { meta.SetExternalName(r, n) }
+}
+
+func withName(n *string) associationModifier {
+	return func(r *v1alpha1.ResolverRuleAssociation) { r.Spec.ForProvider.Name = n }
+}
+
+func withStatus(s awsr53r.ResolverRuleAssociationStatus) associationModifier {
+	return func(r *v1alpha1.ResolverRuleAssociation) { r.Status.AtProvider.Status = string(s) }
+}
+
+func association(m ...associationModifier) *v1alpha1.ResolverRuleAssociation {
+	cr := &v1alpha1.ResolverRuleAssociation{
+		Spec: v1alpha1.ResolverRuleAssociationSpec{
+			ForProvider: v1alpha1.ResolverRuleAssociationParameters{
+				Name:           aws.String("corp"),
+				ResolverRuleID: aws.String(ruleID),
+				VPCID:          aws.String(vpcID),
+			},
+		},
+	}
+	meta.SetExternalName(cr, associationID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getAssociation(s awsr53r.ResolverRuleAssociationStatus) func(*awsr53r.GetResolverRuleAssociationInput) awsr53r.GetResolverRuleAssociationRequest {
+	return func(*awsr53r.GetResolverRuleAssociationInput) awsr53r.GetResolverRuleAssociationRequest {
+		return awsr53r.GetResolverRuleAssociationRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsr53r.GetResolverRuleAssociationOutput{
+				ResolverRuleAssociation: &awsr53r.ResolverRuleAssociation{
+					Id:             aws.String(associationID),
+					Name:           aws.String("corp"),
+					ResolverRuleId: aws.String(ruleID),
+					VPCId:          aws.String(vpcID),
+					Status:         s,
+				},
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				r53r: &fake.MockResolverRuleAssociationClient{
+					MockGetResolverRuleAssociationRequest: getAssociation(awsr53r.ResolverRuleAssociationStatusComplete),
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(withStatus(awsr53r.ResolverRuleAssociationStatusComplete), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				r53r: &fake.MockResolverRuleAssociationClient{
+					MockGetResolverRuleAssociationRequest: getAssociation(awsr53r.ResolverRuleAssociationStatusCreating),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   association(withName(nil)),
+			},
+			want: want{
+				cr: association(withStatus(awsr53r.ResolverRuleAssociationStatusCreating), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				r53r: &fake.MockResolverRuleAssociationClient{
+					MockGetResolverRuleAssociationRequest: func(*awsr53r.GetResolverRuleAssociationInput) awsr53r.GetResolverRuleAssociationRequest {
+						return awsr53r.GetResolverRuleAssociationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsr53r.ErrCodeResourceNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				r53r: &fake.MockResolverRuleAssociationClient{
+					MockGetResolverRuleAssociationRequest: func(*awsr53r.GetResolverRuleAssociationInput) awsr53r.GetResolverRuleAssociationRequest {
+						return awsr53r.GetResolverRuleAssociationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr:  association(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.r53r, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				r53r: &fake.MockResolverRuleAssociationClient{
+					MockAssociateResolverRuleRequest: func(input *awsr53r.AssociateResolverRuleInput) awsr53r.AssociateResolverRuleRequest {
+						if diff := cmp.Diff(vpcID, aws.StringValue(input.VPCId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsr53r.AssociateResolverRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsr53r.AssociateResolverRuleOutput{
+								ResolverRuleAssociation: &awsr53r.ResolverRuleAssociation{Id: aws.String(associationID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   association(withExternalName("")),
+			},
+			want: want{
+				cr: association(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				r53r: &fake.MockResolverRuleAssociationClient{
+					MockAssociateResolverRuleRequest: func(*awsr53r.AssociateResolverRuleInput) awsr53r.AssociateResolverRuleRequest {
+						return awsr53r.AssociateResolverRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: association(withExternalName("")),
+			},
+			want: want{
+				cr:  association(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.r53r, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				r53r: &fake.MockResolverRuleAssociationClient{
+					MockDisassociateResolverRuleRequest: func(input *awsr53r.DisassociateResolverRuleInput) awsr53r.DisassociateResolverRuleRequest {
+						if diff := cmp.Diff(ruleID, aws.StringValue(input.ResolverRuleId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsr53r.DisassociateResolverRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsr53r.DisassociateResolverRuleOutput{}},
+						}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				r53r: &fake.MockResolverRuleAssociationClient{
+					MockDisassociateResolverRuleRequest: func(*awsr53r.DisassociateResolverRuleInput) awsr53r.DisassociateResolverRuleRequest {
+						return awsr53r.DisassociateResolverRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsr53r.ErrCodeResourceNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				r53r: &fake.MockResolverRuleAssociationClient{
+					MockDisassociateResolverRuleRequest: func(*awsr53r.DisassociateResolverRuleInput) awsr53r.DisassociateResolverRuleRequest {
+						return awsr53r.DisassociateResolverRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr:  association(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.r53r, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
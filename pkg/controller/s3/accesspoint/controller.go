@@ -0,0 +1,220 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an AccessPoint resource"
+
+	errGet          = "failed to get the access point"
+	errGetPolicy    = "failed to get the policy of the access point"
+	errCreate       = "failed to create the access point"
+	errPutPolicy    = "failed to put the policy of the access point"
+	errDeletePolicy = "failed to delete the policy of the access point"
+	errDelete       = "failed to delete the access point"
+	errSpecUpdate   = "cannot update spec of the AccessPoint custom resource"
+)
+
+// SetupAccessPoint adds a controller that reconciles AccessPoints.
+func SetupAccessPoint(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.AccessPointGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.AccessPoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AccessPointGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: s3.NewAccessPointClient}))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) s3.AccessPointClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AccessPoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client s3.AccessPointClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.AccessPoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	accountID, err := s3.AccessPointAccountID(ctx, e.client, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	rsp, err := e.client.GetAccessPointRequest(&s3control.GetAccessPointInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(s3.IsErrorAccessPointNotFound, err), errGet)
+	}
+
+	policy := ""
+	prsp, err := e.client.GetAccessPointPolicyRequest(&s3control.GetAccessPointPolicyInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	switch {
+	case s3.IsErrorAccessPointPolicyNotFound(err):
+	case err != nil:
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPolicy)
+	default:
+		policy = aws.StringValue(prsp.Policy)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	cr.Spec.ForProvider.AccountID = awsclients.LateInitializeStringPtr(cr.Spec.ForProvider.AccountID, aws.String(accountID))
+	s3.LateInitializeAccessPoint(&cr.Spec.ForProvider, *rsp.GetAccessPointOutput)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = s3.GenerateAccessPointObservation(
+		s3.AccessPointARN(cr.Spec.ForProvider.Region, accountID, meta.GetExternalName(cr)), *rsp.GetAccessPointOutput)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: s3.IsAccessPointPolicyUpToDate(cr.Spec.ForProvider, policy),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.AccessPoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	accountID, err := s3.AccessPointAccountID(ctx, e.client, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	_, err = e.client.CreateAccessPointRequest(s3.GenerateCreateAccessPointInput(meta.GetExternalName(cr), accountID, cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	if cr.Spec.ForProvider.Policy == nil {
+		return managed.ExternalCreation{}, nil
+	}
+	_, err = e.client.PutAccessPointPolicyRequest(&s3control.PutAccessPointPolicyInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(meta.GetExternalName(cr)),
+		Policy:    cr.Spec.ForProvider.Policy,
+	}).Send(ctx)
+
+	return managed.ExternalCreation{}, errors.Wrap(err, errPutPolicy)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.AccessPoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	accountID, err := s3.AccessPointAccountID(ctx, e.client, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// The policy is the only field of an access point that can be changed.
+	if cr.Spec.ForProvider.Policy == nil {
+		_, err = e.client.DeleteAccessPointPolicyRequest(&s3control.DeleteAccessPointPolicyInput{
+			AccountId: aws.String(accountID),
+			Name:      aws.String(meta.GetExternalName(cr)),
+		}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(s3.IsErrorAccessPointPolicyNotFound, err), errDeletePolicy)
+	}
+
+	_, err = e.client.PutAccessPointPolicyRequest(&s3control.PutAccessPointPolicyInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(meta.GetExternalName(cr)),
+		Policy:    cr.Spec.ForProvider.Policy,
+	}).Send(ctx)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, errPutPolicy)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.AccessPoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	accountID, err := s3.AccessPointAccountID(ctx, e.client, cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.client.DeleteAccessPointRequest(&s3control.DeleteAccessPointInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(s3.IsErrorAccessPointNotFound, err), errDelete)
+}
@@ -0,0 +1,518 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspoint
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	name       = "some-access-point"
+	region     = "us-east-1"
+	accountID  = "123456789012"
+	bucketName = "some-bucket"
+	vpcID      = "vpc-12345"
+	policy     = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"arn:aws:s3:us-east-1:123456789012:accesspoint/some-access-point/object/*"}]}`
+	created    = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	s3   s3.AccessPointClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type accessPointModifier func(*v1alpha1.AccessPoint)
+
+func withConditions(c ...runtimev1alpha1.Condition) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAccountID(id *string) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) { r.Spec.ForProvider.AccountID = id }
+}
+
+func withPolicy(p *string) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) { r.Spec.ForProvider.Policy = p }
+}
+
+func withPublicAccessBlock(c *v1alpha1.AccessPointPublicAccessBlockConfiguration) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) { r.Spec.ForProvider.PublicAccessBlockConfiguration = c }
+}
+
+func withObservation() accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) {
+		t := metav1.NewTime(created)
+		r.Status.AtProvider = v1alpha1.AccessPointObservation{
+			ARN:           "arn:aws:s3:us-east-1:123456789012:accesspoint/some-access-point",
+			NetworkOrigin: string(s3control.NetworkOriginVpc),
+			CreationDate:  &t,
+		}
+	}
+}
+
+func accessPoint(m ...accessPointModifier) *v1alpha1.AccessPoint {
+	cr := &v1alpha1.AccessPoint{
+		Spec: v1alpha1.AccessPointSpec{
+			ForProvider: v1alpha1.AccessPointParameters{
+				Region:           region,
+				AccountID:        aws.String(accountID),
+				BucketName:       aws.String(bucketName),
+				VPCConfiguration: &v1alpha1.AccessPointVPCConfiguration{VPCID: aws.String(vpcID)},
+				PublicAccessBlockConfiguration: &v1alpha1.AccessPointPublicAccessBlockConfiguration{
+					BlockPublicACLs: aws.Bool(true),
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, name)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getAccessPoint(*s3control.GetAccessPointInput) s3control.GetAccessPointRequest {
+	return s3control.GetAccessPointRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &s3control.GetAccessPointOutput{
+			Bucket:        aws.String(bucketName),
+			CreationDate:  &created,
+			Name:          aws.String(name),
+			NetworkOrigin: s3control.NetworkOriginVpc,
+			PublicAccessBlockConfiguration: &s3control.PublicAccessBlockConfiguration{
+				BlockPublicAcls: aws.Bool(true),
+			},
+			VpcConfiguration: &s3control.VpcConfiguration{VpcId: aws.String(vpcID)},
+		}},
+	}
+}
+
+func getAccessPointPolicy(p string) func(*s3control.GetAccessPointPolicyInput) s3control.GetAccessPointPolicyRequest {
+	return func(*s3control.GetAccessPointPolicyInput) s3control.GetAccessPointPolicyRequest {
+		if p == "" {
+			return s3control.GetAccessPointPolicyRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New("NoSuchAccessPointPolicy", "", nil)},
+			}
+		}
+		return s3control.GetAccessPointPolicyRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &s3control.GetAccessPointPolicyOutput{Policy: aws.String(p)}},
+		}
+	}
+}
+
+func putAccessPointPolicy(*s3control.PutAccessPointPolicyInput) s3control.PutAccessPointPolicyRequest {
+	return s3control.PutAccessPointPolicyRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &s3control.PutAccessPointPolicyOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockGetAccessPointRequest:       getAccessPoint,
+					MockGetAccessPointPolicyRequest: getAccessPointPolicy(policy),
+				},
+				cr: accessPoint(withPolicy(aws.String(policy))),
+			},
+			want: want{
+				cr: accessPoint(withPolicy(aws.String(policy)), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockGetCallerIdentityRequest: func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+						return sts.GetCallerIdentityRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &sts.GetCallerIdentityOutput{Account: aws.String(accountID)}},
+						}
+					},
+					MockGetAccessPointRequest:       getAccessPoint,
+					MockGetAccessPointPolicyRequest: getAccessPointPolicy(""),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   accessPoint(withAccountID(nil), withPublicAccessBlock(nil)),
+			},
+			want: want{
+				cr: accessPoint(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockGetAccessPointRequest:       getAccessPoint,
+					MockGetAccessPointPolicyRequest: getAccessPointPolicy(policy),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockGetAccessPointRequest: func(*s3control.GetAccessPointInput) s3control.GetAccessPointRequest {
+						return s3control.GetAccessPointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New("NoSuchAccessPoint", "", nil)},
+						}
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockGetAccessPointRequest: func(*s3control.GetAccessPointInput) s3control.GetAccessPointRequest {
+						return s3control.GetAccessPointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+		"PolicyClientError": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockGetAccessPointRequest: getAccessPoint,
+					MockGetAccessPointPolicyRequest: func(*s3control.GetAccessPointPolicyInput) s3control.GetAccessPointPolicyRequest {
+						return s3control.GetAccessPointPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(),
+				err: errors.Wrap(errBoom, errGetPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockCreateAccessPointRequest: func(input *s3control.CreateAccessPointInput) s3control.CreateAccessPointRequest {
+						if diff := cmp.Diff(aws.String(vpcID), input.VpcConfiguration.VpcId); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return s3control.CreateAccessPointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &s3control.CreateAccessPointOutput{}},
+						}
+					},
+					MockPutAccessPointPolicyRequest: putAccessPointPolicy,
+				},
+				cr: accessPoint(withPolicy(aws.String(policy))),
+			},
+			want: want{
+				cr: accessPoint(withPolicy(aws.String(policy)), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockCreateAccessPointRequest: func(*s3control.CreateAccessPointInput) s3control.CreateAccessPointRequest {
+						return s3control.CreateAccessPointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutPolicy": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockPutAccessPointPolicyRequest: putAccessPointPolicy,
+				},
+				cr: accessPoint(withPolicy(aws.String(policy))),
+			},
+			want: want{
+				cr: accessPoint(withPolicy(aws.String(policy))),
+			},
+		},
+		"DeletePolicy": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockDeleteAccessPointPolicyRequest: func(*s3control.DeleteAccessPointPolicyInput) s3control.DeleteAccessPointPolicyRequest {
+						return s3control.DeleteAccessPointPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &s3control.DeleteAccessPointPolicyOutput{}},
+						}
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockPutAccessPointPolicyRequest: func(*s3control.PutAccessPointPolicyInput) s3control.PutAccessPointPolicyRequest {
+						return s3control.PutAccessPointPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: accessPoint(withPolicy(aws.String(policy))),
+			},
+			want: want{
+				cr:  accessPoint(withPolicy(aws.String(policy))),
+				err: errors.Wrap(errBoom, errPutPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockDeleteAccessPointRequest: func(*s3control.DeleteAccessPointInput) s3control.DeleteAccessPointRequest {
+						return s3control.DeleteAccessPointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &s3control.DeleteAccessPointOutput{}},
+						}
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockDeleteAccessPointRequest: func(*s3control.DeleteAccessPointInput) s3control.DeleteAccessPointRequest {
+						return s3control.DeleteAccessPointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New("NoSuchAccessPoint", "", nil)},
+						}
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockDeleteAccessPointRequest: func(*s3control.DeleteAccessPointInput) s3control.DeleteAccessPointRequest {
+						return s3control.DeleteAccessPointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountpublicaccessblock
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an AccountPublicAccessBlock resource"
+
+	errGet        = "failed to get the public access block configuration of the account"
+	errPut        = "failed to put the public access block configuration of the account"
+	errDelete     = "failed to delete the public access block configuration of the account"
+	errSpecUpdate = "cannot update spec of the AccountPublicAccessBlock custom resource"
+)
+
+// SetupAccountPublicAccessBlock adds a controller that reconciles
+// AccountPublicAccessBlocks.
+func SetupAccountPublicAccessBlock(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.AccountPublicAccessBlockGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.AccountPublicAccessBlock{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AccountPublicAccessBlockGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(&connector{kube: mgr.GetClient(), newClientFn: s3.NewAccountPublicAccessBlockClient}))),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) s3.AccountPublicAccessBlockClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AccountPublicAccessBlock)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client s3.AccountPublicAccessBlockClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.AccountPublicAccessBlock)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	accountID, err := s3.AccountPublicAccessBlockAccountID(ctx, e.client, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	rsp, err := e.client.GetPublicAccessBlockRequest(&s3control.GetPublicAccessBlockInput{AccountId: aws.String(accountID)}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(s3.IsErrorAccountPublicAccessBlockNotFound, err), errGet)
+	}
+
+	if cr.Spec.ForProvider.AccountID == nil {
+		cr.Spec.ForProvider.AccountID = aws.String(accountID)
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	config := s3control.PublicAccessBlockConfiguration{}
+	if rsp.PublicAccessBlockConfiguration != nil {
+		config = *rsp.PublicAccessBlockConfiguration
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: s3.IsAccountPublicAccessBlockUpToDate(cr.Spec.ForProvider, config),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.AccountPublicAccessBlock)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	return managed.ExternalCreation{}, e.put(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.AccountPublicAccessBlock)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	return managed.ExternalUpdate{}, e.put(ctx, cr)
+}
+
+func (e *external) put(ctx context.Context, cr *v1alpha1.AccountPublicAccessBlock) error {
+	accountID, err := s3.AccountPublicAccessBlockAccountID(ctx, e.client, cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	_, err = e.client.PutPublicAccessBlockRequest(s3.GeneratePutAccountPublicAccessBlockInput(accountID, cr.Spec.ForProvider)).Send(ctx)
+	return errors.Wrap(err, errPut)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.AccountPublicAccessBlock)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	accountID, err := s3.AccountPublicAccessBlockAccountID(ctx, e.client, cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.client.DeletePublicAccessBlockRequest(&s3control.DeletePublicAccessBlockInput{AccountId: aws.String(accountID)}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(s3.IsErrorAccountPublicAccessBlockNotFound, err), errDelete)
+}
@@ -0,0 +1,344 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountpublicaccessblock
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	region    = "us-east-1"
+	accountID = "123456789012"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(s3control.ErrCodeNoSuchPublicAccessBlockConfiguration, "", nil)
+)
+
+type args struct {
+	s3   s3.AccountPublicAccessBlockClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type blockModifier func(*v1alpha1.AccountPublicAccessBlock)
+
+func withConditions(c ...runtimev1alpha1.Condition) blockModifier {
+	return func(r *v1alpha1.AccountPublicAccessBlock) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAccountID(id *string) blockModifier {
+	return func(r *v1alpha1.AccountPublicAccessBlock) { r.Spec.ForProvider.AccountID = id }
+}
+
+func withBlockPublicPolicy(b *bool) blockModifier {
+	return func(r *v1alpha1.AccountPublicAccessBlock) { r.Spec.ForProvider.BlockPublicPolicy = b }
+}
+
+func block(m ...blockModifier) *v1alpha1.AccountPublicAccessBlock {
+	cr := &v1alpha1.AccountPublicAccessBlock{
+		Spec: v1alpha1.AccountPublicAccessBlockSpec{
+			ForProvider: v1alpha1.AccountPublicAccessBlockParameters{
+				Region:          region,
+				AccountID:       aws.String(accountID),
+				BlockPublicACLs: aws.Bool(true),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getPublicAccessBlock(c *s3control.PublicAccessBlockConfiguration, err error) func(*s3control.GetPublicAccessBlockInput) s3control.GetPublicAccessBlockRequest {
+	return func(*s3control.GetPublicAccessBlockInput) s3control.GetPublicAccessBlockRequest {
+		return s3control.GetPublicAccessBlockRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err,
+				Data: &s3control.GetPublicAccessBlockOutput{PublicAccessBlockConfiguration: c}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				s3: &fake.MockAccountPublicAccessBlockClient{
+					MockGetPublicAccessBlockRequest: getPublicAccessBlock(&s3control.PublicAccessBlockConfiguration{
+						BlockPublicAcls:   aws.Bool(true),
+						BlockPublicPolicy: aws.Bool(false),
+					}, nil),
+				},
+				cr: block(),
+			},
+			want: want{
+				cr: block(withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				s3: &fake.MockAccountPublicAccessBlockClient{
+					MockGetCallerIdentityRequest: func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+						return sts.GetCallerIdentityRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &sts.GetCallerIdentityOutput{Account: aws.String(accountID)}},
+						}
+					},
+					MockGetPublicAccessBlockRequest: getPublicAccessBlock(&s3control.PublicAccessBlockConfiguration{BlockPublicAcls: aws.Bool(true)}, nil),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   block(withAccountID(nil)),
+			},
+			want: want{
+				cr: block(withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				s3: &fake.MockAccountPublicAccessBlockClient{
+					MockGetPublicAccessBlockRequest: getPublicAccessBlock(&s3control.PublicAccessBlockConfiguration{BlockPublicAcls: aws.Bool(true)}, nil),
+				},
+				cr: block(withBlockPublicPolicy(aws.Bool(true))),
+			},
+			want: want{
+				cr: block(withBlockPublicPolicy(aws.Bool(true)), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				s3: &fake.MockAccountPublicAccessBlockClient{
+					MockGetPublicAccessBlockRequest: getPublicAccessBlock(nil, errNotFound),
+				},
+				cr: block(),
+			},
+			want: want{
+				cr: block(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockAccountPublicAccessBlockClient{
+					MockGetPublicAccessBlockRequest: getPublicAccessBlock(nil, errBoom),
+				},
+				cr: block(),
+			},
+			want: want{
+				cr:  block(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				s3: &fake.MockAccountPublicAccessBlockClient{
+					MockPutPublicAccessBlockRequest: func(in *s3control.PutPublicAccessBlockInput) s3control.PutPublicAccessBlockRequest {
+						if diff := cmp.Diff(accountID, aws.StringValue(in.AccountId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(true, aws.BoolValue(in.PublicAccessBlockConfiguration.BlockPublicAcls)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return s3control.PutPublicAccessBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &s3control.PutPublicAccessBlockOutput{}},
+						}
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				cr: block(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockAccountPublicAccessBlockClient{
+					MockPutPublicAccessBlockRequest: func(*s3control.PutPublicAccessBlockInput) s3control.PutPublicAccessBlockRequest {
+						return s3control.PutPublicAccessBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				cr:  block(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				s3: &fake.MockAccountPublicAccessBlockClient{
+					MockDeletePublicAccessBlockRequest: func(*s3control.DeletePublicAccessBlockInput) s3control.DeletePublicAccessBlockRequest {
+						return s3control.DeletePublicAccessBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &s3control.DeletePublicAccessBlockOutput{}},
+						}
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				cr: block(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				s3: &fake.MockAccountPublicAccessBlockClient{
+					MockDeletePublicAccessBlockRequest: func(*s3control.DeletePublicAccessBlockInput) s3control.DeletePublicAccessBlockRequest {
+						return s3control.DeletePublicAccessBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				cr: block(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockAccountPublicAccessBlockClient{
+					MockDeletePublicAccessBlockRequest: func(*s3control.DeletePublicAccessBlockInput) s3control.DeletePublicAccessBlockRequest {
+						return s3control.DeletePublicAccessBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				cr:  block(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
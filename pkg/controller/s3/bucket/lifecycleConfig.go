@@ -137,12 +137,17 @@ func GenerateLifecycleRules(in []v1beta1.LifecycleRule) []awss3.LifecycleRule {
 			rule.Transitions = make([]awss3.Transition, len(local.Transitions))
 			for tIndex, transition := range local.Transitions {
 				rule.Transitions[tIndex] = awss3.Transition{
-					Date:         &transition.Date.Time,
 					Days:         transition.Days,
 					StorageClass: awss3.TransitionStorageClass(transition.StorageClass),
 				}
+				if transition.Date != nil {
+					rule.Transitions[tIndex].Date = &transition.Date.Time
+				}
 			}
 		}
+		// NOTE: AWS requires either a filter or a prefix on every rule and
+		// reports an empty prefix filter for rules that apply to all objects.
+		rule.Filter = &awss3.LifecycleRuleFilter{Prefix: aws.String("")}
 		if local.Filter != nil {
 			rule.Filter = &awss3.LifecycleRuleFilter{
 				Prefix: local.Filter.Prefix,
@@ -143,6 +143,29 @@ func TestGenerateLifecycleConfiguration(t *testing.T) {
 				input: generateAWSLifecycle(true).Rules,
 			},
 		},
+		"DaysOnlyNoFilter": {
+			args: args{
+				b: s3Testing.Bucket(s3Testing.WithLifecycleConfig(&v1beta1.BucketLifecycleConfiguration{
+					Rules: []v1beta1.LifecycleRule{{
+						Status: enabled,
+						Transitions: []v1beta1.Transition{{
+							Days:         aws.Int64(days),
+							StorageClass: storage,
+						}},
+					}},
+				})),
+			},
+			want: want{
+				input: []s3.LifecycleRule{{
+					Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("")},
+					Status: s3.ExpirationStatusEnabled,
+					Transitions: []s3.Transition{{
+						Days:         aws.Int64(days),
+						StorageClass: s3.TransitionStorageClassOnezoneIa,
+					}},
+				}},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -266,6 +289,32 @@ func TestLifecycleObserve(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"NoUpdateExistsEmptyFilter": {
+			args: args{
+				b: s3Testing.Bucket(s3Testing.WithLifecycleConfig(&v1beta1.BucketLifecycleConfiguration{
+					Rules: []v1beta1.LifecycleRule{{
+						Status:     enabled,
+						Expiration: &v1beta1.LifecycleExpiration{Days: aws.Int64(days)},
+					}},
+				})),
+				cl: NewLifecycleConfigurationClient(fake.MockBucketClient{
+					MockGetBucketLifecycleConfigurationRequest: func(input *s3.GetBucketLifecycleConfigurationInput) s3.GetBucketLifecycleConfigurationRequest {
+						return s3.GetBucketLifecycleConfigurationRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.GetBucketLifecycleConfigurationOutput{Rules: []s3.LifecycleRule{{
+								Expiration: &s3.LifecycleExpiration{Days: aws.Int64(days)},
+								Filter:     &s3.LifecycleRuleFilter{Prefix: aws.String("")},
+								ID:         aws.String(id),
+								Status:     s3.ExpirationStatusEnabled,
+							}}}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+				err:    nil,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -18,6 +18,7 @@ package bucket
 
 import (
 	"context"
+	"strings"
 
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -144,7 +145,7 @@ func LateInitializeQueue(external []awss3.QueueConfiguration, local []v1beta1.Qu
 			Events:   LateInitializeEvents(local[i].Events, v.Events),
 			Filter:   LateInitializeFilter(local[i].Filter, v.Filter),
 			ID:       aws.LateInitializeStringPtr(local[i].ID, v.Id),
-			QueueArn: aws.LateInitializeString(local[i].QueueArn, v.QueueArn),
+			QueueArn: aws.LateInitializeStringPtr(local[i].QueueArn, v.QueueArn),
 		}
 	}
 }
@@ -170,7 +171,7 @@ func NewNotificationConfigurationClient(client s3.BucketClient) *NotificationCon
 }
 
 func emptyConfiguration(external *awss3.GetBucketNotificationConfigurationResponse) bool {
-	return external == nil || len(external.TopicConfigurations) == 0 || len(external.QueueConfigurations) == 0 || len(external.LambdaFunctionConfigurations) == 0
+	return external == nil || (len(external.TopicConfigurations) == 0 && len(external.QueueConfigurations) == 0 && len(external.LambdaFunctionConfigurations) == 0)
 }
 
 func bucketStatus(config *v1beta1.NotificationConfiguration, external *awss3.GetBucketNotificationConfigurationResponse) ResourceStatus { // nolint:gocyclo
@@ -197,6 +198,7 @@ func (in *NotificationConfigurationClient) Observe(ctx context.Context, bucket *
 	}
 
 	generated := GenerateConfiguration(config)
+	normalizeFilterRules(external)
 
 	if cmp.Equal(external.LambdaFunctionConfigurations, generated.LambdaFunctionConfigurations) &&
 		cmp.Equal(external.QueueConfigurations, generated.QueueConfigurations) &&
@@ -207,6 +209,29 @@ func (in *NotificationConfigurationClient) Observe(ctx context.Context, bucket *
 	return NeedsUpdate, nil
 }
 
+// normalizeFilterRules lowercases the filter rule names returned by AWS, which
+// reports them as "Prefix" and "Suffix" while accepting either case.
+func normalizeFilterRules(external *awss3.GetBucketNotificationConfigurationResponse) {
+	filters := make([]*awss3.NotificationConfigurationFilter, 0)
+	for i := range external.LambdaFunctionConfigurations {
+		filters = append(filters, external.LambdaFunctionConfigurations[i].Filter)
+	}
+	for i := range external.QueueConfigurations {
+		filters = append(filters, external.QueueConfigurations[i].Filter)
+	}
+	for i := range external.TopicConfigurations {
+		filters = append(filters, external.TopicConfigurations[i].Filter)
+	}
+	for _, f := range filters {
+		if f == nil || f.Key == nil {
+			continue
+		}
+		for i := range f.Key.FilterRules {
+			f.Key.FilterRules[i].Name = awss3.FilterRuleName(strings.ToLower(string(f.Key.FilterRules[i].Name)))
+		}
+	}
+}
+
 func copyEvents(src []string) []awss3.Event {
 	if len(src) == 0 {
 		return nil
@@ -230,7 +255,7 @@ func generateFilter(src *v1beta1.NotificationConfigurationFilter) *awss3.Notific
 	out.Key.FilterRules = make([]awss3.FilterRule, len(src.Key.FilterRules))
 	for i, v := range src.Key.FilterRules {
 		out.Key.FilterRules[i] = awss3.FilterRule{
-			Name:  awss3.FilterRuleName(v.Name),
+			Name:  awss3.FilterRuleName(strings.ToLower(v.Name)),
 			Value: v.Value,
 		}
 	}
@@ -288,7 +313,7 @@ func GenerateQueueConfigurations(config *v1beta1.NotificationConfiguration) []aw
 	for _, v := range config.QueueConfigurations {
 		conf := awss3.QueueConfiguration{
 			Id:       v.ID,
-			QueueArn: aws.String(v.QueueArn),
+			QueueArn: v.QueueArn,
 		}
 		if v.Events != nil {
 			conf.Events = copyEvents(v.Events)
@@ -81,7 +81,7 @@ func generateNotificationConfig() *v1beta1.NotificationConfiguration {
 			Events:   generateNotificationEvents(),
 			Filter:   generateNotificationFilter(),
 			ID:       &id,
-			QueueArn: queueArn,
+			QueueArn: &queueArn,
 		}},
 		TopicConfigurations: []v1beta1.TopicConfiguration{{
 			Events:   generateNotificationEvents(),
@@ -217,6 +217,28 @@ func TestNotificationObserve(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"NoUpdateExistsCapitalizedFilterRule": {
+			args: args{
+				b: s3Testing.Bucket(s3Testing.WithNotificationConfig(generateNotificationConfig())),
+				cl: NewNotificationConfigurationClient(fake.MockBucketClient{
+					MockGetBucketNotificationConfigurationRequest: func(input *s3.GetBucketNotificationConfigurationInput) s3.GetBucketNotificationConfigurationRequest {
+						n := generateAWSNotification()
+						n.QueueConfigurations[0].Filter.Key.FilterRules[0].Name = "Prefix"
+						return s3.GetBucketNotificationConfigurationRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.GetBucketNotificationConfigurationOutput{
+								LambdaFunctionConfigurations: n.LambdaFunctionConfigurations,
+								QueueConfigurations:          n.QueueConfigurations,
+								TopicConfigurations:          n.TopicConfigurations,
+							}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+				err:    nil,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -302,3 +324,83 @@ func TestNotificationCreateOrUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestNotificationLateInitialize(t *testing.T) {
+	type args struct {
+		cl *NotificationConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3Testing.Bucket(),
+				cl: NewNotificationConfigurationClient(fake.MockBucketClient{
+					MockGetBucketNotificationConfigurationRequest: func(input *s3.GetBucketNotificationConfigurationInput) s3.GetBucketNotificationConfigurationRequest {
+						return s3.GetBucketNotificationConfigurationRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.GetBucketNotificationConfigurationOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, notificationGetFailed),
+				cr:  s3Testing.Bucket(),
+			},
+		},
+		"NoLateInitEmpty": {
+			args: args{
+				b: s3Testing.Bucket(),
+				cl: NewNotificationConfigurationClient(fake.MockBucketClient{
+					MockGetBucketNotificationConfigurationRequest: func(input *s3.GetBucketNotificationConfigurationInput) s3.GetBucketNotificationConfigurationRequest {
+						return s3.GetBucketNotificationConfigurationRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.GetBucketNotificationConfigurationOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				cr: s3Testing.Bucket(),
+			},
+		},
+		"LateInitPartial": {
+			args: args{
+				b: s3Testing.Bucket(),
+				cl: NewNotificationConfigurationClient(fake.MockBucketClient{
+					MockGetBucketNotificationConfigurationRequest: func(input *s3.GetBucketNotificationConfigurationInput) s3.GetBucketNotificationConfigurationRequest {
+						return s3.GetBucketNotificationConfigurationRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.GetBucketNotificationConfigurationOutput{
+								QueueConfigurations: generateAWSNotification().QueueConfigurations,
+							}),
+						}
+					},
+				}),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithNotificationConfig(&v1beta1.NotificationConfiguration{
+					QueueConfigurations: generateNotificationConfig().QueueConfigurations,
+				})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
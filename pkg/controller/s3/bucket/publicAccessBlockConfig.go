@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	publicAccessBlockGetFailed    = "cannot get Bucket public access block configuration"
+	publicAccessBlockPutFailed    = "cannot put Bucket public access block configuration"
+	publicAccessBlockDeleteFailed = "cannot delete Bucket public access block configuration"
+)
+
+// PublicAccessBlockConfigurationClient is the client for API methods and reconciling the PublicAccessBlockConfiguration
+type PublicAccessBlockConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// LateInitialize does nothing because the resource might have been deleted by
+// the user.
+func (*PublicAccessBlockConfigurationClient) LateInitialize(_ context.Context, _ *v1beta1.Bucket) error {
+	return nil
+}
+
+// NewPublicAccessBlockConfigurationClient creates the client for Public Access Block Configuration
+func NewPublicAccessBlockConfigurationClient(client s3.BucketClient) *PublicAccessBlockConfigurationClient {
+	return &PublicAccessBlockConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *PublicAccessBlockConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	config := bucket.Spec.ForProvider.PublicAccessBlockConfiguration
+	external, err := in.client.GetPublicAccessBlockRequest(&awss3.GetPublicAccessBlockInput{Bucket: aws.String(meta.GetExternalName(bucket))}).Send(ctx)
+	if err != nil {
+		if s3.PublicAccessBlockConfigurationNotFound(err) && config == nil {
+			return Updated, nil
+		}
+		return NeedsUpdate, errors.Wrap(resource.Ignore(s3.PublicAccessBlockConfigurationNotFound, err), publicAccessBlockGetFailed)
+	}
+
+	switch {
+	case external.PublicAccessBlockConfiguration != nil && config == nil:
+		return NeedsDeletion, nil
+	case external.PublicAccessBlockConfiguration == nil && config == nil:
+		return Updated, nil
+	case external.PublicAccessBlockConfiguration == nil && config != nil:
+		return NeedsUpdate, nil
+	}
+
+	// NOTE: AWS treats the settings that are not given as false.
+	e := external.PublicAccessBlockConfiguration
+	if awsgo.BoolValue(e.BlockPublicAcls) != awsgo.BoolValue(config.BlockPublicACLs) ||
+		awsgo.BoolValue(e.BlockPublicPolicy) != awsgo.BoolValue(config.BlockPublicPolicy) ||
+		awsgo.BoolValue(e.IgnorePublicAcls) != awsgo.BoolValue(config.IgnorePublicACLs) ||
+		awsgo.BoolValue(e.RestrictPublicBuckets) != awsgo.BoolValue(config.RestrictPublicBuckets) {
+		return NeedsUpdate, nil
+	}
+	return Updated, nil
+}
+
+// GeneratePutPublicAccessBlockInput creates the input for the PutPublicAccessBlock request for the S3 Client
+func GeneratePutPublicAccessBlockInput(name string, config *v1beta1.PublicAccessBlockConfiguration) *awss3.PutPublicAccessBlockInput {
+	return &awss3.PutPublicAccessBlockInput{
+		Bucket: aws.String(name),
+		PublicAccessBlockConfiguration: &awss3.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       config.BlockPublicACLs,
+			BlockPublicPolicy:     config.BlockPublicPolicy,
+			IgnorePublicAcls:      config.IgnorePublicACLs,
+			RestrictPublicBuckets: config.RestrictPublicBuckets,
+		},
+	}
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS.
+func (in *PublicAccessBlockConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.PublicAccessBlockConfiguration == nil {
+		return nil
+	}
+	input := GeneratePutPublicAccessBlockInput(meta.GetExternalName(bucket), bucket.Spec.ForProvider.PublicAccessBlockConfiguration)
+	_, err := in.client.PutPublicAccessBlockRequest(input).Send(ctx)
+	return errors.Wrap(err, publicAccessBlockPutFailed)
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *PublicAccessBlockConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	_, err := in.client.DeletePublicAccessBlockRequest(
+		&awss3.DeletePublicAccessBlockInput{
+			Bucket: aws.String(meta.GetExternalName(bucket)),
+		},
+	).Send(ctx)
+	return errors.Wrap(err, publicAccessBlockDeleteFailed)
+}
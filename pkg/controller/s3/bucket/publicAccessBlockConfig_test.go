@@ -0,0 +1,302 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+	clients3 "github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3Testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &PublicAccessBlockConfigurationClient{}
+
+func generatePublicAccessBlockConfig() *v1beta1.PublicAccessBlockConfiguration {
+	return &v1beta1.PublicAccessBlockConfiguration{
+		BlockPublicACLs:       aws.Bool(true),
+		BlockPublicPolicy:     aws.Bool(true),
+		RestrictPublicBuckets: aws.Bool(true),
+	}
+}
+
+func generateAWSPublicAccessBlock() *s3.PublicAccessBlockConfiguration {
+	return &s3.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       aws.Bool(true),
+		BlockPublicPolicy:     aws.Bool(true),
+		IgnorePublicAcls:      aws.Bool(false),
+		RestrictPublicBuckets: aws.Bool(true),
+	}
+}
+
+func TestPublicAccessBlockObserve(t *testing.T) {
+	type args struct {
+		cl *PublicAccessBlockConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3Testing.Bucket(s3Testing.WithPublicAccessBlockConfig(generatePublicAccessBlockConfig())),
+				cl: NewPublicAccessBlockConfigurationClient(fake.MockBucketClient{
+					MockGetPublicAccessBlockRequest: func(input *s3.GetPublicAccessBlockInput) s3.GetPublicAccessBlockRequest {
+						return s3.GetPublicAccessBlockRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.GetPublicAccessBlockOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errors.Wrap(errBoom, publicAccessBlockGetFailed),
+			},
+		},
+		"UpdateNeededNotExists": {
+			args: args{
+				b: s3Testing.Bucket(s3Testing.WithPublicAccessBlockConfig(generatePublicAccessBlockConfig())),
+				cl: NewPublicAccessBlockConfigurationClient(fake.MockBucketClient{
+					MockGetPublicAccessBlockRequest: func(input *s3.GetPublicAccessBlockInput) s3.GetPublicAccessBlockRequest {
+						return s3.GetPublicAccessBlockRequest{
+							Request: s3Testing.CreateRequest(awserr.New(clients3.PublicAccessBlockErrCode, "", nil), &s3.GetPublicAccessBlockOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    nil,
+			},
+		},
+		"UpdateNeededDrift": {
+			args: args{
+				b: s3Testing.Bucket(s3Testing.WithPublicAccessBlockConfig(generatePublicAccessBlockConfig())),
+				cl: NewPublicAccessBlockConfigurationClient(fake.MockBucketClient{
+					MockGetPublicAccessBlockRequest: func(input *s3.GetPublicAccessBlockInput) s3.GetPublicAccessBlockRequest {
+						c := generateAWSPublicAccessBlock()
+						c.BlockPublicPolicy = aws.Bool(false)
+						return s3.GetPublicAccessBlockRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.GetPublicAccessBlockOutput{PublicAccessBlockConfiguration: c}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    nil,
+			},
+		},
+		"NeedsDelete": {
+			args: args{
+				b: s3Testing.Bucket(s3Testing.WithPublicAccessBlockConfig(nil)),
+				cl: NewPublicAccessBlockConfigurationClient(fake.MockBucketClient{
+					MockGetPublicAccessBlockRequest: func(input *s3.GetPublicAccessBlockInput) s3.GetPublicAccessBlockRequest {
+						return s3.GetPublicAccessBlockRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.GetPublicAccessBlockOutput{PublicAccessBlockConfiguration: generateAWSPublicAccessBlock()}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+				err:    nil,
+			},
+		},
+		"NoUpdateNotExists": {
+			args: args{
+				b: s3Testing.Bucket(s3Testing.WithPublicAccessBlockConfig(nil)),
+				cl: NewPublicAccessBlockConfigurationClient(fake.MockBucketClient{
+					MockGetPublicAccessBlockRequest: func(input *s3.GetPublicAccessBlockInput) s3.GetPublicAccessBlockRequest {
+						return s3.GetPublicAccessBlockRequest{
+							Request: s3Testing.CreateRequest(awserr.New(clients3.PublicAccessBlockErrCode, "", nil), &s3.GetPublicAccessBlockOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+				err:    nil,
+			},
+		},
+		"NoUpdateExists": {
+			args: args{
+				b: s3Testing.Bucket(s3Testing.WithPublicAccessBlockConfig(generatePublicAccessBlockConfig())),
+				cl: NewPublicAccessBlockConfigurationClient(fake.MockBucketClient{
+					MockGetPublicAccessBlockRequest: func(input *s3.GetPublicAccessBlockInput) s3.GetPublicAccessBlockRequest {
+						return s3.GetPublicAccessBlockRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.GetPublicAccessBlockOutput{PublicAccessBlockConfiguration: generateAWSPublicAccessBlock()}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+				err:    nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPublicAccessBlockCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *PublicAccessBlockConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3Testing.Bucket(s3Testing.WithPublicAccessBlockConfig(generatePublicAccessBlockConfig())),
+				cl: NewPublicAccessBlockConfigurationClient(fake.MockBucketClient{
+					MockPutPublicAccessBlockRequest: func(input *s3.PutPublicAccessBlockInput) s3.PutPublicAccessBlockRequest {
+						return s3.PutPublicAccessBlockRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.PutPublicAccessBlockOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, publicAccessBlockPutFailed),
+			},
+		},
+		"NoConfig": {
+			args: args{
+				b:  s3Testing.Bucket(s3Testing.WithPublicAccessBlockConfig(nil)),
+				cl: NewPublicAccessBlockConfigurationClient(fake.MockBucketClient{}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"SuccessfulCreate": {
+			args: args{
+				b: s3Testing.Bucket(s3Testing.WithPublicAccessBlockConfig(generatePublicAccessBlockConfig())),
+				cl: NewPublicAccessBlockConfigurationClient(fake.MockBucketClient{
+					MockPutPublicAccessBlockRequest: func(input *s3.PutPublicAccessBlockInput) s3.PutPublicAccessBlockRequest {
+						return s3.PutPublicAccessBlockRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.PutPublicAccessBlockOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPublicAccessBlockDelete(t *testing.T) {
+	type args struct {
+		cl *PublicAccessBlockConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3Testing.Bucket(s3Testing.WithPublicAccessBlockConfig(generatePublicAccessBlockConfig())),
+				cl: NewPublicAccessBlockConfigurationClient(fake.MockBucketClient{
+					MockDeletePublicAccessBlockRequest: func(input *s3.DeletePublicAccessBlockInput) s3.DeletePublicAccessBlockRequest {
+						return s3.DeletePublicAccessBlockRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.DeletePublicAccessBlockOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, publicAccessBlockDeleteFailed),
+			},
+		},
+		"SuccessfulDelete": {
+			args: args{
+				b: s3Testing.Bucket(s3Testing.WithPublicAccessBlockConfig(generatePublicAccessBlockConfig())),
+				cl: NewPublicAccessBlockConfigurationClient(fake.MockBucketClient{
+					MockDeletePublicAccessBlockRequest: func(input *s3.DeletePublicAccessBlockInput) s3.DeletePublicAccessBlockRequest {
+						return s3.DeletePublicAccessBlockRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.DeletePublicAccessBlockOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.Delete(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -39,6 +39,7 @@ func NewSubresourceClients(client s3.BucketClient) []SubresourceClient {
 		NewLifecycleConfigurationClient(client),
 		NewLoggingConfigurationClient(client),
 		NewNotificationConfigurationClient(client),
+		NewPublicAccessBlockConfigurationClient(client),
 		NewReplicationConfigurationClient(client),
 		NewRequestPaymentConfigurationClient(client),
 		NewSSEConfigurationClient(client),
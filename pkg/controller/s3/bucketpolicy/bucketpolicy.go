@@ -23,7 +23,6 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -46,6 +45,8 @@ const (
 	errDelete           = "failed to delete the policy for bucket"
 	errGet              = "failed to get BucketPolicy for bucket with name"
 	errUpdate           = "failed to update the policy for bucket"
+	errRawAndStatement  = "rawPolicy and statement are mutually exclusive"
+	errNoPolicy         = "either rawPolicy or statement is required"
 )
 
 // SetupBucketPolicy adds a controller that reconciles
@@ -58,10 +59,11 @@ func SetupBucketPolicy(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1alpha1.BucketPolicy{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.BucketPolicyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(),
+			managed.WithExternalConnecter(awscommon.WithConditionReasons(awscommon.WithCreateGracePeriod(&connector{kube: mgr.GetClient(),
 				newClientFn:    s3.NewBucketPolicyClient,
-				newIAMClientFn: iam.NewClient}),
+				newIAMClientFn: iam.NewClient}))),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), awscommon.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -117,12 +119,21 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	// If our version and the external version are the same, we return ResourceUpToDate: true
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: cmp.Equal(*policyData, *resp.Policy),
+		ResourceUpToDate: s3.IsBucketPolicyUpToDate(*policyData, aws.StringValue(resp.Policy)),
 	}, nil
 }
 
 // formatBucketPolicy parses and formats the bucket.Spec.BucketPolicy struct
 func (e *external) formatBucketPolicy(original *v1alpha1.BucketPolicy) (*string, error) {
+	if original.Spec.PolicyBody.RawPolicy != nil {
+		if len(original.Spec.PolicyBody.PolicyStatement) != 0 {
+			return nil, errors.New(errRawAndStatement)
+		}
+		return original.Spec.PolicyBody.RawPolicy, nil
+	}
+	if len(original.Spec.PolicyBody.PolicyStatement) == 0 {
+		return nil, errors.New(errNoPolicy)
+	}
 	c := original.DeepCopy()
 	iamUsername := aws.StringValue(c.Spec.PolicyBody.UserName)
 	accountID, err := e.iamclient.GetAccountID()
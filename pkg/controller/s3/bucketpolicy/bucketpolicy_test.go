@@ -59,6 +59,19 @@ var (
 			},
 		},
 	}
+	rawParams = v1alpha1.BucketPolicyParameters{
+		RawPolicy: aws.String(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "s3:ListBucket",
+      "Resource": "arn:aws:s3:::test.s3.crossplane.com"
+    }
+  ]
+}`),
+	}
 	errBoom = errors.New("boom")
 )
 
@@ -129,6 +142,28 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"RawPolicy": {
+			args: args{
+				s3: &fake.MockBucketPolicyClient{
+					MockGetBucketPolicyRequest: func(input *awss3.GetBucketPolicyInput) awss3.GetBucketPolicyRequest {
+						return awss3.GetBucketPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awss3.GetBucketPolicyOutput{
+								Policy: &policy,
+							}},
+						}
+					},
+				},
+				cr: bucketPolicy(withPolicy(&rawParams)),
+			},
+			want: want{
+				cr: bucketPolicy(withPolicy(&rawParams),
+					withConditions(corev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -221,6 +256,34 @@ func TestCreate(t *testing.T) {
 					withConditions(corev1alpha1.Creating())),
 			},
 		},
+		"RawAndStatement": {
+			args: args{
+				cr: bucketPolicy(withPolicy(&v1alpha1.BucketPolicyParameters{
+					RawPolicy:       rawParams.RawPolicy,
+					PolicyStatement: params.PolicyStatement,
+				})),
+			},
+			want: want{
+				cr: bucketPolicy(
+					withPolicy(&v1alpha1.BucketPolicyParameters{
+						RawPolicy:       rawParams.RawPolicy,
+						PolicyStatement: params.PolicyStatement,
+					}),
+					withConditions(corev1alpha1.Creating())),
+				err: errors.Wrap(errors.New(errRawAndStatement), errAttach),
+			},
+		},
+		"NoPolicy": {
+			args: args{
+				cr: bucketPolicy(withPolicy(&v1alpha1.BucketPolicyParameters{})),
+			},
+			want: want{
+				cr: bucketPolicy(
+					withPolicy(&v1alpha1.BucketPolicyParameters{}),
+					withConditions(corev1alpha1.Creating())),
+				err: errors.Wrap(errors.New(errNoPolicy), errAttach),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -77,6 +77,11 @@ func Client(m ...ClientModifier) *fake.MockBucketClient {
 				Request: CreateRequest(nil, &awss3.PutBucketAclOutput{}),
 			}
 		},
+		MockGetPublicAccessBlockRequest: func(input *awss3.GetPublicAccessBlockInput) awss3.GetPublicAccessBlockRequest {
+			return awss3.GetPublicAccessBlockRequest{
+				Request: CreateRequest(awserr.New(s3.PublicAccessBlockErrCode, "", nil), &awss3.GetPublicAccessBlockOutput{}),
+			}
+		},
 	}
 	for _, v := range m {
 		v(client)
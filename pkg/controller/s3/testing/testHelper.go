@@ -110,6 +110,11 @@ func WithNotificationConfig(s *v1beta1.NotificationConfiguration) BucketModifier
 	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.NotificationConfiguration = s }
 }
 
+// WithPublicAccessBlockConfig sets the PublicAccessBlockConfiguration for an S3 Bucket
+func WithPublicAccessBlockConfig(s *v1beta1.PublicAccessBlockConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.PublicAccessBlockConfiguration = s }
+}
+
 // Bucket creates a v1beta1 Bucket for use in testing
 func Bucket(m ...BucketModifier) *v1beta1.Bucket {
 	cr := &v1beta1.Bucket{
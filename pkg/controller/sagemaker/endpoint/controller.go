@@ -0,0 +1,198 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package endpoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssagemaker "github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/sagemaker"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a SageMaker Endpoint resource"
+
+	errDescribe   = "failed to describe the Endpoint resource"
+	errCreate     = "failed to create the Endpoint resource"
+	errUpdate     = "failed to deploy the endpoint configuration of the Endpoint resource"
+	errDelete     = "failed to delete the Endpoint resource"
+	errListTags   = "failed to list the tags of the Endpoint resource"
+	errAddTags    = "failed to add tags to the Endpoint resource"
+	errDeleteTags = "failed to delete tags from the Endpoint resource"
+)
+
+// SetupEndpoint adds a controller that reconciles Endpoints.
+func SetupEndpoint(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.EndpointGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Endpoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.EndpointGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(&connector{kube: mgr.GetClient(), newClientFn: sagemaker.NewEndpointClient}, v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) sagemaker.EndpointClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Endpoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client sagemaker.EndpointClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Endpoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	res, err := e.client.DescribeEndpointRequest(&awssagemaker.DescribeEndpointInput{
+		EndpointName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(sagemaker.IsNotFound, err), errDescribe)
+	}
+	observed := res.DescribeEndpointOutput
+	cr.Status.AtProvider = sagemaker.GenerateEndpointObservation(*observed)
+
+	switch cr.Status.AtProvider.EndpointStatus {
+	case v1alpha1.EndpointStatusInService:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.EndpointStatusCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	case v1alpha1.EndpointStatusUpdating, v1alpha1.EndpointStatusSystemUpdating, v1alpha1.EndpointStatusRollingBack:
+		// The previous endpoint configuration keeps serving the traffic
+		// until a deployment completed, but no other deployment can be
+		// started in the meantime.
+		cr.SetConditions(runtimev1alpha1.Available())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	case v1alpha1.EndpointStatusDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	default:
+		// Failed endpoints cannot be updated but only deleted.
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	tags, err := e.client.ListTagsRequest(&awssagemaker.ListTagsInput{ResourceArn: observed.EndpointArn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := sagemaker.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: sagemaker.IsEndpointUpToDate(cr.Spec.ForProvider, *observed) && len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Endpoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateEndpointRequest(sagemaker.GenerateCreateEndpointInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+// Update updates the tags of the endpoint and deploys the desired endpoint
+// configuration if the endpoint serves a different one. SageMaker performs
+// the deployment blue/green: the new configuration is provisioned next to
+// the current one, which keeps serving until the traffic has been shifted,
+// and the endpoint is rolled back if the new configuration fails.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Endpoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := aws.String(cr.Status.AtProvider.EndpointARN)
+	tags, err := e.client.ListTagsRequest(&awssagemaker.ListTagsInput{ResourceArn: arn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := sagemaker.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awssagemaker.DeleteTagsInput{ResourceArn: arn, TagKeys: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.AddTagsRequest(&awssagemaker.AddTagsInput{ResourceArn: arn, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	if aws.StringValue(cr.Spec.ForProvider.EndpointConfigName) == cr.Status.AtProvider.EndpointConfigName {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.UpdateEndpointRequest(sagemaker.GenerateUpdateEndpointInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Endpoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.EndpointStatus == v1alpha1.EndpointStatusDeleting {
+		return nil
+	}
+	_, err := e.client.DeleteEndpointRequest(&awssagemaker.DeleteEndpointInput{
+		EndpointName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(sagemaker.IsNotFound, err), errDelete)
+}
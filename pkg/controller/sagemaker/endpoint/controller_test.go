@@ -0,0 +1,462 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package endpoint
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awssagemaker "github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/sagemaker"
+	"github.com/crossplane/provider-aws/pkg/clients/sagemaker/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	endpointName = "some-endpoint"
+	endpointARN  = "arn:aws:sagemaker:us-east-1:123456789012:endpoint/some-endpoint"
+	blueConfig   = "config-blue"
+	greenConfig  = "config-green"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	sagemaker sagemaker.EndpointClient
+	kube      *test.MockClient
+	cr        resource.Managed
+}
+
+type endpointModifier func(*v1alpha1.Endpoint)
+
+func withConditions(c ...runtimev1alpha1.Condition) endpointModifier {
+	return func(r *v1alpha1.Endpoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withConfig(name string) endpointModifier {
+	return func(r *v1alpha1.Endpoint) { r.Spec.ForProvider.EndpointConfigName = aws.String(name) }
+}
+
+func withStatus(s, config string) endpointModifier {
+	return func(r *v1alpha1.Endpoint) {
+		r.Status.AtProvider = v1alpha1.EndpointObservation{EndpointARN: endpointARN, EndpointStatus: s, EndpointConfigName: config}
+	}
+}
+
+func endpoint(m ...endpointModifier) *v1alpha1.Endpoint {
+	cr := &v1alpha1.Endpoint{
+		Spec: v1alpha1.EndpointSpec{
+			ForProvider: v1alpha1.EndpointParameters{
+				EndpointConfigName: aws.String(blueConfig),
+			},
+		},
+	}
+	meta.SetExternalName(cr, endpointName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(status awssagemaker.EndpointStatus, config string) func(*awssagemaker.DescribeEndpointInput) awssagemaker.DescribeEndpointRequest {
+	return func(*awssagemaker.DescribeEndpointInput) awssagemaker.DescribeEndpointRequest {
+		return awssagemaker.DescribeEndpointRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.DescribeEndpointOutput{
+				EndpointName:       aws.String(endpointName),
+				EndpointArn:        aws.String(endpointARN),
+				EndpointConfigName: aws.String(config),
+				EndpointStatus:     status,
+			}},
+		}
+	}
+}
+
+func noTags(*awssagemaker.ListTagsInput) awssagemaker.ListTagsRequest {
+	return awssagemaker.ListTagsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.ListTagsOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InService": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{
+					MockDescribeEndpoint: describe(awssagemaker.EndpointStatusInService, blueConfig),
+					MockListTags:         noTags,
+				},
+				cr: endpoint(),
+			},
+			want: want{
+				cr: endpoint(withStatus("InService", blueConfig), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"EndpointConfigChanged": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{
+					MockDescribeEndpoint: describe(awssagemaker.EndpointStatusInService, blueConfig),
+					MockListTags:         noTags,
+				},
+				cr: endpoint(withConfig(greenConfig)),
+			},
+			want: want{
+				cr: endpoint(withConfig(greenConfig), withStatus("InService", blueConfig), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DeploymentInProgress": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{
+					MockDescribeEndpoint: describe(awssagemaker.EndpointStatusUpdating, blueConfig),
+				},
+				cr: endpoint(withConfig(greenConfig)),
+			},
+			want: want{
+				cr: endpoint(withConfig(greenConfig), withStatus("Updating", blueConfig), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{
+					MockDescribeEndpoint: describe(awssagemaker.EndpointStatusCreating, blueConfig),
+				},
+				cr: endpoint(),
+			},
+			want: want{
+				cr: endpoint(withStatus("Creating", blueConfig), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Failed": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{
+					MockDescribeEndpoint: describe(awssagemaker.EndpointStatusFailed, blueConfig),
+				},
+				cr: endpoint(),
+			},
+			want: want{
+				cr: endpoint(withStatus("Failed", blueConfig), withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{
+					MockDescribeEndpoint: func(*awssagemaker.DescribeEndpointInput) awssagemaker.DescribeEndpointRequest {
+						return awssagemaker.DescribeEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New("ValidationException", "Could not find endpoint", nil)},
+						}
+					},
+				},
+				cr: endpoint(),
+			},
+			want: want{
+				cr: endpoint(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{
+					MockDescribeEndpoint: func(*awssagemaker.DescribeEndpointInput) awssagemaker.DescribeEndpointRequest {
+						return awssagemaker.DescribeEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: endpoint(),
+			},
+			want: want{
+				cr:  endpoint(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{
+					MockCreateEndpoint: func(input *awssagemaker.CreateEndpointInput) awssagemaker.CreateEndpointRequest {
+						if diff := cmp.Diff(blueConfig, aws.StringValue(input.EndpointConfigName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssagemaker.CreateEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.CreateEndpointOutput{EndpointArn: aws.String(endpointARN)}},
+						}
+					},
+				},
+				cr: endpoint(),
+			},
+			want: want{
+				cr: endpoint(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{
+					MockCreateEndpoint: func(*awssagemaker.CreateEndpointInput) awssagemaker.CreateEndpointRequest {
+						return awssagemaker.CreateEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: endpoint(),
+			},
+			want: want{
+				cr:  endpoint(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DeployNewEndpointConfig": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{
+					MockListTags: noTags,
+					MockUpdateEndpoint: func(input *awssagemaker.UpdateEndpointInput) awssagemaker.UpdateEndpointRequest {
+						if diff := cmp.Diff(greenConfig, aws.StringValue(input.EndpointConfigName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssagemaker.UpdateEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.UpdateEndpointOutput{EndpointArn: aws.String(endpointARN)}},
+						}
+					},
+				},
+				cr: endpoint(withConfig(greenConfig), withStatus("InService", blueConfig)),
+			},
+			want: want{
+				cr: endpoint(withConfig(greenConfig), withStatus("InService", blueConfig)),
+			},
+		},
+		"OnlyTagsChanged": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{
+					MockListTags: noTags,
+					MockAddTags: func(*awssagemaker.AddTagsInput) awssagemaker.AddTagsRequest {
+						return awssagemaker.AddTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.AddTagsOutput{}},
+						}
+					},
+				},
+				cr: endpoint(withStatus("InService", blueConfig), func(r *v1alpha1.Endpoint) {
+					r.Spec.ForProvider.Tags = []v1alpha1.Tag{{Key: "k", Value: "v"}}
+				}),
+			},
+			want: want{
+				cr: endpoint(withStatus("InService", blueConfig), func(r *v1alpha1.Endpoint) {
+					r.Spec.ForProvider.Tags = []v1alpha1.Tag{{Key: "k", Value: "v"}}
+				}),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{
+					MockListTags: noTags,
+					MockUpdateEndpoint: func(*awssagemaker.UpdateEndpointInput) awssagemaker.UpdateEndpointRequest {
+						return awssagemaker.UpdateEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: endpoint(withConfig(greenConfig), withStatus("InService", blueConfig)),
+			},
+			want: want{
+				cr:  endpoint(withConfig(greenConfig), withStatus("InService", blueConfig)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{
+					MockDeleteEndpoint: func(*awssagemaker.DeleteEndpointInput) awssagemaker.DeleteEndpointRequest {
+						return awssagemaker.DeleteEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.DeleteEndpointOutput{}},
+						}
+					},
+				},
+				cr: endpoint(withStatus("InService", blueConfig)),
+			},
+			want: want{
+				cr: endpoint(withStatus("InService", blueConfig), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{},
+				cr:        endpoint(withStatus("Deleting", blueConfig)),
+			},
+			want: want{
+				cr: endpoint(withStatus("Deleting", blueConfig), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockEndpointClient{
+					MockDeleteEndpoint: func(*awssagemaker.DeleteEndpointInput) awssagemaker.DeleteEndpointRequest {
+						return awssagemaker.DeleteEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: endpoint(withStatus("InService", blueConfig)),
+			},
+			want: want{
+				cr:  endpoint(withStatus("InService", blueConfig), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,398 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package endpointconfig
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awssagemaker "github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/sagemaker"
+	"github.com/crossplane/provider-aws/pkg/clients/sagemaker/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	configName = "some-config"
+	configARN  = "arn:aws:sagemaker:us-east-1:123456789012:endpoint-config/some-config"
+	keyID      = "1234abcd-12ab-34cd-56ef-1234567890ab"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	sagemaker sagemaker.EndpointConfigClient
+	kube      *test.MockClient
+	cr        resource.Managed
+}
+
+type endpointConfigModifier func(*v1alpha1.EndpointConfig)
+
+func withConditions(c ...runtimev1alpha1.Condition) endpointConfigModifier {
+	return func(r *v1alpha1.EndpointConfig) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTags(tags ...v1alpha1.Tag) endpointConfigModifier {
+	return func(r *v1alpha1.EndpointConfig) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withARN() endpointConfigModifier {
+	return func(r *v1alpha1.EndpointConfig) { r.Status.AtProvider.EndpointConfigARN = configARN }
+}
+
+func endpointConfig(m ...endpointConfigModifier) *v1alpha1.EndpointConfig {
+	cr := &v1alpha1.EndpointConfig{
+		Spec: v1alpha1.EndpointConfigSpec{
+			ForProvider: v1alpha1.EndpointConfigParameters{
+				ProductionVariants: []v1alpha1.ProductionVariant{{
+					VariantName:          "primary",
+					ModelName:            aws.String("some-model"),
+					InstanceType:         "ml.m5.large",
+					InitialInstanceCount: 1,
+				}},
+				KMSKeyID: aws.String(keyID),
+			},
+		},
+	}
+	meta.SetExternalName(cr, configName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(*awssagemaker.DescribeEndpointConfigInput) awssagemaker.DescribeEndpointConfigRequest {
+	return awssagemaker.DescribeEndpointConfigRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.DescribeEndpointConfigOutput{
+			EndpointConfigName: aws.String(configName),
+			EndpointConfigArn:  aws.String(configARN),
+			KmsKeyId:           aws.String(keyID),
+		}},
+	}
+}
+
+func listTags(tags ...awssagemaker.Tag) func(*awssagemaker.ListTagsInput) awssagemaker.ListTagsRequest {
+	return func(*awssagemaker.ListTagsInput) awssagemaker.ListTagsRequest {
+		return awssagemaker.ListTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.ListTagsOutput{Tags: tags}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				sagemaker: &fake.MockEndpointConfigClient{
+					MockDescribeEndpointConfig: describe,
+					MockListTags:               listTags(awssagemaker.Tag{Key: aws.String("k"), Value: aws.String("v")}),
+				},
+				cr: endpointConfig(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: endpointConfig(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withARN(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				sagemaker: &fake.MockEndpointConfigClient{
+					MockDescribeEndpointConfig: describe,
+					MockListTags:               listTags(),
+				},
+				cr: endpointConfig(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: endpointConfig(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withARN(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				sagemaker: &fake.MockEndpointConfigClient{
+					MockDescribeEndpointConfig: func(*awssagemaker.DescribeEndpointConfigInput) awssagemaker.DescribeEndpointConfigRequest {
+						return awssagemaker.DescribeEndpointConfigRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New("ValidationException", "Could not find endpoint configuration", nil)},
+						}
+					},
+				},
+				cr: endpointConfig(),
+			},
+			want: want{
+				cr: endpointConfig(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockEndpointConfigClient{
+					MockDescribeEndpointConfig: func(*awssagemaker.DescribeEndpointConfigInput) awssagemaker.DescribeEndpointConfigRequest {
+						return awssagemaker.DescribeEndpointConfigRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: endpointConfig(),
+			},
+			want: want{
+				cr:  endpointConfig(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				sagemaker: &fake.MockEndpointConfigClient{
+					MockCreateEndpointConfig: func(input *awssagemaker.CreateEndpointConfigInput) awssagemaker.CreateEndpointConfigRequest {
+						if diff := cmp.Diff(configName, aws.StringValue(input.EndpointConfigName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssagemaker.CreateEndpointConfigRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.CreateEndpointConfigOutput{EndpointConfigArn: aws.String(configARN)}},
+						}
+					},
+				},
+				cr: endpointConfig(),
+			},
+			want: want{
+				cr: endpointConfig(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockEndpointConfigClient{
+					MockCreateEndpointConfig: func(*awssagemaker.CreateEndpointConfigInput) awssagemaker.CreateEndpointConfigRequest {
+						return awssagemaker.CreateEndpointConfigRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: endpointConfig(),
+			},
+			want: want{
+				cr:  endpointConfig(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplaceTags": {
+			args: args{
+				sagemaker: &fake.MockEndpointConfigClient{
+					MockListTags: listTags(awssagemaker.Tag{Key: aws.String("old"), Value: aws.String("v")}),
+					MockDeleteTags: func(input *awssagemaker.DeleteTagsInput) awssagemaker.DeleteTagsRequest {
+						if diff := cmp.Diff([]string{"old"}, input.TagKeys); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssagemaker.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.DeleteTagsOutput{}},
+						}
+					},
+					MockAddTags: func(input *awssagemaker.AddTagsInput) awssagemaker.AddTagsRequest {
+						want := []awssagemaker.Tag{{Key: aws.String("new"), Value: aws.String("v")}}
+						if diff := cmp.Diff(want, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssagemaker.AddTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.AddTagsOutput{}},
+						}
+					},
+				},
+				cr: endpointConfig(withARN(), withTags(v1alpha1.Tag{Key: "new", Value: "v"})),
+			},
+			want: want{
+				cr: endpointConfig(withARN(), withTags(v1alpha1.Tag{Key: "new", Value: "v"})),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockEndpointConfigClient{
+					MockListTags: func(*awssagemaker.ListTagsInput) awssagemaker.ListTagsRequest {
+						return awssagemaker.ListTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: endpointConfig(withARN()),
+			},
+			want: want{
+				cr:  endpointConfig(withARN()),
+				err: errors.Wrap(errBoom, errListTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				sagemaker: &fake.MockEndpointConfigClient{
+					MockDeleteEndpointConfig: func(*awssagemaker.DeleteEndpointConfigInput) awssagemaker.DeleteEndpointConfigRequest {
+						return awssagemaker.DeleteEndpointConfigRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.DeleteEndpointConfigOutput{}},
+						}
+					},
+				},
+				cr: endpointConfig(),
+			},
+			want: want{
+				cr: endpointConfig(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockEndpointConfigClient{
+					MockDeleteEndpointConfig: func(*awssagemaker.DeleteEndpointConfigInput) awssagemaker.DeleteEndpointConfigRequest {
+						return awssagemaker.DeleteEndpointConfigRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: endpointConfig(),
+			},
+			want: want{
+				cr:  endpointConfig(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
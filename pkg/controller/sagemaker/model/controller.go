@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssagemaker "github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr"
+	"github.com/crossplane/provider-aws/pkg/clients/sagemaker"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a SageMaker Model resource"
+
+	errDescribe   = "failed to describe the Model resource"
+	errCreate     = "failed to create the Model resource"
+	errDelete     = "failed to delete the Model resource"
+	errListTags   = "failed to list the tags of the Model resource"
+	errAddTags    = "failed to add tags to the Model resource"
+	errDeleteTags = "failed to delete tags from the Model resource"
+	errSpecUpdate = "cannot update spec of the Model custom resource"
+
+	errImageNotFound = "image %s does not exist in its ECR repository"
+)
+
+// SetupModel adds a controller that reconciles Models.
+func SetupModel(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ModelGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Model{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ModelGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(&connector{kube: mgr.GetClient(), newClientFn: sagemaker.NewModelClient, newImageClientFn: ecr.NewImageClient}, v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube             client.Client
+	newClientFn      func(config aws.Config) sagemaker.ModelClient
+	newImageClientFn func(config aws.Config) ecr.ImageClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Model)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, cfg: *cfg, newImageClientFn: c.newImageClientFn}, nil
+}
+
+type external struct {
+	kube             client.Client
+	client           sagemaker.ModelClient
+	cfg              aws.Config
+	newImageClientFn func(config aws.Config) ecr.ImageClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Model)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	res, err := e.client.DescribeModelRequest(&awssagemaker.DescribeModelInput{
+		ModelName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(sagemaker.IsNotFound, err), errDescribe)
+	}
+	observed := res.DescribeModelOutput
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	sagemaker.LateInitializeModel(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+	cr.Status.AtProvider = sagemaker.GenerateModelObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	tags, err := e.client.ListTagsRequest(&awssagemaker.ListTagsInput{ResourceArn: observed.ModelArn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := sagemaker.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Model)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	if aws.BoolValue(cr.Spec.ForProvider.VerifyImages) {
+		if err := e.verifyImages(ctx, cr.Spec.ForProvider); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+	_, err := e.client.CreateModelRequest(sagemaker.GenerateCreateModelInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+// verifyImages returns an error if the ECR image of one of the containers of
+// the model doesn't exist.
+func (e *external) verifyImages(ctx context.Context, p v1alpha1.ModelParameters) error {
+	containers := p.Containers
+	if p.PrimaryContainer != nil {
+		containers = append([]v1alpha1.ContainerDefinition{*p.PrimaryContainer}, containers...)
+	}
+	for _, c := range containers {
+		img, err := ecr.ParseImageURI(aws.StringValue(c.Image))
+		if err != nil {
+			continue
+		}
+		exists, err := ecr.ImageExists(ctx, e.cfg, e.newImageClientFn, img)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.Errorf(errImageNotFound, aws.StringValue(c.Image))
+		}
+	}
+	return nil
+}
+
+// Update updates the tags of the model, which is the only thing that can be
+// changed after creation.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Model)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := aws.String(cr.Status.AtProvider.ModelARN)
+	tags, err := e.client.ListTagsRequest(&awssagemaker.ListTagsInput{ResourceArn: arn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := sagemaker.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awssagemaker.DeleteTagsInput{ResourceArn: arn, TagKeys: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.AddTagsRequest(&awssagemaker.AddTagsInput{ResourceArn: arn, Tags: add}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Model)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteModelRequest(&awssagemaker.DeleteModelInput{
+		ModelName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(sagemaker.IsNotFound, err), errDelete)
+}
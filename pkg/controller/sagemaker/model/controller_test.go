@@ -0,0 +1,482 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsecr "github.com/aws/aws-sdk-go-v2/service/ecr"
+	awssagemaker "github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr"
+	ecrfake "github.com/crossplane/provider-aws/pkg/clients/ecr/fake"
+	"github.com/crossplane/provider-aws/pkg/clients/sagemaker"
+	"github.com/crossplane/provider-aws/pkg/clients/sagemaker/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	modelName = "some-model"
+	modelARN  = "arn:aws:sagemaker:us-east-1:123456789012:model/some-model"
+	roleARN   = "arn:aws:iam::123456789012:role/sagemaker"
+	image     = "123456789012.dkr.ecr.us-east-1.amazonaws.com/inference:latest"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	sagemaker sagemaker.ModelClient
+	ecr       ecr.ImageClient
+	kube      *test.MockClient
+	cr        resource.Managed
+}
+
+type modelModifier func(*v1alpha1.Model)
+
+func withConditions(c ...runtimev1alpha1.Condition) modelModifier {
+	return func(r *v1alpha1.Model) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTags(tags ...v1alpha1.Tag) modelModifier {
+	return func(r *v1alpha1.Model) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withVerifyImages() modelModifier {
+	return func(r *v1alpha1.Model) { r.Spec.ForProvider.VerifyImages = aws.Bool(true) }
+}
+
+func withContainerImage(image string) modelModifier {
+	return func(r *v1alpha1.Model) {
+		r.Spec.ForProvider.Containers = append(r.Spec.ForProvider.Containers, v1alpha1.ContainerDefinition{Image: aws.String(image)})
+	}
+}
+
+func withARN() modelModifier {
+	return func(r *v1alpha1.Model) { r.Status.AtProvider.ModelARN = modelARN }
+}
+
+func model(m ...modelModifier) *v1alpha1.Model {
+	cr := &v1alpha1.Model{
+		Spec: v1alpha1.ModelSpec{
+			ForProvider: v1alpha1.ModelParameters{
+				ExecutionRoleARN:       aws.String(roleARN),
+				EnableNetworkIsolation: aws.Bool(false),
+				PrimaryContainer: &v1alpha1.ContainerDefinition{
+					Image:        aws.String(image),
+					ModelDataURL: aws.String("s3://bucket/model.tar.gz"),
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, modelName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(*awssagemaker.DescribeModelInput) awssagemaker.DescribeModelRequest {
+	return awssagemaker.DescribeModelRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.DescribeModelOutput{
+			ModelName:              aws.String(modelName),
+			ModelArn:               aws.String(modelARN),
+			ExecutionRoleArn:       aws.String(roleARN),
+			EnableNetworkIsolation: aws.Bool(false),
+		}},
+	}
+}
+
+func listTags(tags ...awssagemaker.Tag) func(*awssagemaker.ListTagsInput) awssagemaker.ListTagsRequest {
+	return func(*awssagemaker.ListTagsInput) awssagemaker.ListTagsRequest {
+		return awssagemaker.ListTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.ListTagsOutput{Tags: tags}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				sagemaker: &fake.MockModelClient{
+					MockDescribeModel: describe,
+					MockListTags:      listTags(awssagemaker.Tag{Key: aws.String("k"), Value: aws.String("v")}),
+				},
+				cr: model(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: model(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withARN(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				sagemaker: &fake.MockModelClient{
+					MockDescribeModel: describe,
+					MockListTags:      listTags(),
+				},
+				cr: model(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: model(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withARN(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				sagemaker: &fake.MockModelClient{
+					MockDescribeModel: func(*awssagemaker.DescribeModelInput) awssagemaker.DescribeModelRequest {
+						return awssagemaker.DescribeModelRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New("ValidationException", "Could not find model", nil)},
+						}
+					},
+				},
+				cr: model(),
+			},
+			want: want{
+				cr: model(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockModelClient{
+					MockDescribeModel: func(*awssagemaker.DescribeModelInput) awssagemaker.DescribeModelRequest {
+						return awssagemaker.DescribeModelRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: model(),
+			},
+			want: want{
+				cr:  model(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				sagemaker: &fake.MockModelClient{
+					MockCreateModel: func(input *awssagemaker.CreateModelInput) awssagemaker.CreateModelRequest {
+						if diff := cmp.Diff(modelName, aws.StringValue(input.ModelName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssagemaker.CreateModelRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.CreateModelOutput{ModelArn: aws.String(modelARN)}},
+						}
+					},
+				},
+				cr: model(),
+			},
+			want: want{
+				cr: model(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockModelClient{
+					MockCreateModel: func(*awssagemaker.CreateModelInput) awssagemaker.CreateModelRequest {
+						return awssagemaker.CreateModelRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: model(),
+			},
+			want: want{
+				cr:  model(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"ImageNotFound": {
+			args: args{
+				ecr: &ecrfake.MockImageClient{
+					MockDescribeImages: func(*awsecr.DescribeImagesInput) awsecr.DescribeImagesRequest {
+						return awsecr.DescribeImagesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ecr.ImageNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: model(withVerifyImages()),
+			},
+			want: want{
+				cr:  model(withVerifyImages(), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Errorf(errImageNotFound, image),
+			},
+		},
+		"ImagesVerified": {
+			args: args{
+				ecr: &ecrfake.MockImageClient{
+					MockDescribeImages: func(input *awsecr.DescribeImagesInput) awsecr.DescribeImagesRequest {
+						want := &awsecr.DescribeImagesInput{
+							RegistryId:     aws.String("123456789012"),
+							RepositoryName: aws.String("inference"),
+							ImageIds:       []awsecr.ImageIdentifier{{ImageTag: aws.String("latest")}},
+						}
+						if diff := cmp.Diff(want, input); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsecr.DescribeImagesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecr.DescribeImagesOutput{
+								ImageDetails: []awsecr.ImageDetail{{RepositoryName: input.RepositoryName}},
+							}},
+						}
+					},
+				},
+				sagemaker: &fake.MockModelClient{
+					MockCreateModel: func(*awssagemaker.CreateModelInput) awssagemaker.CreateModelRequest {
+						return awssagemaker.CreateModelRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.CreateModelOutput{ModelArn: aws.String(modelARN)}},
+						}
+					},
+				},
+				cr: model(withVerifyImages(), withContainerImage("docker.io/library/inference:latest")),
+			},
+			want: want{
+				cr: model(withVerifyImages(), withContainerImage("docker.io/library/inference:latest"), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"VerifyImagesError": {
+			args: args{
+				ecr: &ecrfake.MockImageClient{
+					MockDescribeImages: func(*awsecr.DescribeImagesInput) awsecr.DescribeImagesRequest {
+						return awsecr.DescribeImagesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: model(withVerifyImages()),
+			},
+			want: want{
+				cr:  model(withVerifyImages(), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, "cannot describe the image in the ECR repository"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube, cfg: aws.Config{Region: "eu-west-1"}, newImageClientFn: func(cfg aws.Config) ecr.ImageClient {
+				if diff := cmp.Diff("us-east-1", cfg.Region); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				return tc.ecr
+			}}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplaceTags": {
+			args: args{
+				sagemaker: &fake.MockModelClient{
+					MockListTags: listTags(awssagemaker.Tag{Key: aws.String("old"), Value: aws.String("v")}),
+					MockDeleteTags: func(input *awssagemaker.DeleteTagsInput) awssagemaker.DeleteTagsRequest {
+						if diff := cmp.Diff([]string{"old"}, input.TagKeys); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssagemaker.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.DeleteTagsOutput{}},
+						}
+					},
+					MockAddTags: func(input *awssagemaker.AddTagsInput) awssagemaker.AddTagsRequest {
+						want := []awssagemaker.Tag{{Key: aws.String("new"), Value: aws.String("v")}}
+						if diff := cmp.Diff(want, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssagemaker.AddTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.AddTagsOutput{}},
+						}
+					},
+				},
+				cr: model(withARN(), withTags(v1alpha1.Tag{Key: "new", Value: "v"})),
+			},
+			want: want{
+				cr: model(withARN(), withTags(v1alpha1.Tag{Key: "new", Value: "v"})),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockModelClient{
+					MockListTags: func(*awssagemaker.ListTagsInput) awssagemaker.ListTagsRequest {
+						return awssagemaker.ListTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: model(withARN()),
+			},
+			want: want{
+				cr:  model(withARN()),
+				err: errors.Wrap(errBoom, errListTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				sagemaker: &fake.MockModelClient{
+					MockDeleteModel: func(*awssagemaker.DeleteModelInput) awssagemaker.DeleteModelRequest {
+						return awssagemaker.DeleteModelRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.DeleteModelOutput{}},
+						}
+					},
+				},
+				cr: model(),
+			},
+			want: want{
+				cr: model(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockModelClient{
+					MockDeleteModel: func(*awssagemaker.DeleteModelInput) awssagemaker.DeleteModelRequest {
+						return awssagemaker.DeleteModelRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: model(),
+			},
+			want: want{
+				cr:  model(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notebookinstance
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssagemaker "github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/sagemaker"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a SageMaker NotebookInstance resource"
+
+	errDescribe   = "failed to describe the NotebookInstance resource"
+	errCreate     = "failed to create the NotebookInstance resource"
+	errUpdate     = "failed to update the NotebookInstance resource"
+	errStart      = "failed to start the NotebookInstance resource"
+	errStop       = "failed to stop the NotebookInstance resource"
+	errDelete     = "failed to delete the NotebookInstance resource"
+	errListTags   = "failed to list the tags of the NotebookInstance resource"
+	errAddTags    = "failed to add tags to the NotebookInstance resource"
+	errDeleteTags = "failed to delete tags from the NotebookInstance resource"
+	errSpecUpdate = "cannot update spec of the NotebookInstance custom resource"
+)
+
+// SetupNotebookInstance adds a controller that reconciles NotebookInstances.
+func SetupNotebookInstance(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.NotebookInstanceGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.NotebookInstance{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.NotebookInstanceGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: sagemaker.NewNotebookInstanceClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) sagemaker.NotebookInstanceClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.NotebookInstance)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client sagemaker.NotebookInstanceClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.NotebookInstance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	res, err := e.client.DescribeNotebookInstanceRequest(&awssagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(sagemaker.IsNotFound, err), errDescribe)
+	}
+	observed := res.DescribeNotebookInstanceOutput
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	sagemaker.LateInitializeNotebookInstance(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+	cr.Status.AtProvider = sagemaker.GenerateNotebookInstanceObservation(*observed)
+
+	switch cr.Status.AtProvider.NotebookInstanceStatus {
+	case v1alpha1.NotebookInstanceStatusInService:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.NotebookInstanceStatusStopped:
+		// A stopped notebook instance is either updated or started again.
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	case v1alpha1.NotebookInstanceStatusPending:
+		cr.SetConditions(runtimev1alpha1.Creating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	case v1alpha1.NotebookInstanceStatusDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	default:
+		// The notebook instance cannot be modified while it is stopping,
+		// updating or after it failed.
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	tags, err := e.client.ListTagsRequest(&awssagemaker.ListTagsInput{ResourceArn: observed.NotebookInstanceArn}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTags)
+	}
+	add, remove := sagemaker.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: sagemaker.IsNotebookInstanceUpToDate(cr.Spec.ForProvider, *observed) && len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.NotebookInstance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateNotebookInstanceRequest(sagemaker.GenerateCreateNotebookInstanceInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+// Update updates the tags of the notebook instance right away. Other
+// settings can only be changed while the notebook instance is stopped, so it
+// is stopped first, updated once it stopped and started again afterwards.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.NotebookInstance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	name := aws.String(meta.GetExternalName(cr))
+	res, err := e.client.DescribeNotebookInstanceRequest(&awssagemaker.DescribeNotebookInstanceInput{NotebookInstanceName: name}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	observed := res.DescribeNotebookInstanceOutput
+
+	if err := e.updateTags(ctx, cr, observed.NotebookInstanceArn); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	upToDate := sagemaker.IsNotebookInstanceUpToDate(cr.Spec.ForProvider, *observed)
+	switch observed.NotebookInstanceStatus {
+	case awssagemaker.NotebookInstanceStatusInService:
+		if upToDate {
+			return managed.ExternalUpdate{}, nil
+		}
+		_, err := e.client.StopNotebookInstanceRequest(&awssagemaker.StopNotebookInstanceInput{NotebookInstanceName: name}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errStop)
+	case awssagemaker.NotebookInstanceStatusStopped:
+		if upToDate {
+			_, err := e.client.StartNotebookInstanceRequest(&awssagemaker.StartNotebookInstanceInput{NotebookInstanceName: name}).Send(ctx)
+			return managed.ExternalUpdate{}, errors.Wrap(err, errStart)
+		}
+		_, err := e.client.UpdateNotebookInstanceRequest(sagemaker.GenerateUpdateNotebookInstanceInput(aws.StringValue(name), cr.Spec.ForProvider, *observed)).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) updateTags(ctx context.Context, cr *v1alpha1.NotebookInstance, arn *string) error {
+	tags, err := e.client.ListTagsRequest(&awssagemaker.ListTagsInput{ResourceArn: arn}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(err, errListTags)
+	}
+	add, remove := sagemaker.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awssagemaker.DeleteTagsInput{ResourceArn: arn, TagKeys: remove}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.AddTagsRequest(&awssagemaker.AddTagsInput{ResourceArn: arn, Tags: add}).Send(ctx); err != nil {
+			return errors.Wrap(err, errAddTags)
+		}
+	}
+	return nil
+}
+
+// Delete stops the notebook instance if it is running since only stopped or
+// failed notebook instances can be deleted.
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.NotebookInstance)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	name := aws.String(meta.GetExternalName(cr))
+	switch cr.Status.AtProvider.NotebookInstanceStatus {
+	case v1alpha1.NotebookInstanceStatusInService:
+		_, err := e.client.StopNotebookInstanceRequest(&awssagemaker.StopNotebookInstanceInput{NotebookInstanceName: name}).Send(ctx)
+		return errors.Wrap(resource.Ignore(sagemaker.IsNotFound, err), errStop)
+	case v1alpha1.NotebookInstanceStatusStopped, v1alpha1.NotebookInstanceStatusFailed:
+		_, err := e.client.DeleteNotebookInstanceRequest(&awssagemaker.DeleteNotebookInstanceInput{NotebookInstanceName: name}).Send(ctx)
+		return errors.Wrap(resource.Ignore(sagemaker.IsNotFound, err), errDelete)
+	}
+	return nil
+}
@@ -0,0 +1,504 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notebookinstance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awssagemaker "github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/sagemaker"
+	"github.com/crossplane/provider-aws/pkg/clients/sagemaker/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	notebookName = "some-notebook"
+	notebookARN  = "arn:aws:sagemaker:us-east-1:123456789012:notebook-instance/some-notebook"
+	roleARN      = "arn:aws:iam::123456789012:role/sagemaker"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	sagemaker sagemaker.NotebookInstanceClient
+	kube      *test.MockClient
+	cr        resource.Managed
+}
+
+type notebookModifier func(*v1alpha1.NotebookInstance)
+
+func withConditions(c ...runtimev1alpha1.Condition) notebookModifier {
+	return func(r *v1alpha1.NotebookInstance) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withInstanceType(t string) notebookModifier {
+	return func(r *v1alpha1.NotebookInstance) { r.Spec.ForProvider.InstanceType = t }
+}
+
+func withStatus(s string) notebookModifier {
+	return func(r *v1alpha1.NotebookInstance) {
+		r.Status.AtProvider = v1alpha1.NotebookInstanceObservation{NotebookInstanceARN: notebookARN, NotebookInstanceStatus: s}
+	}
+}
+
+func notebook(m ...notebookModifier) *v1alpha1.NotebookInstance {
+	cr := &v1alpha1.NotebookInstance{
+		Spec: v1alpha1.NotebookInstanceSpec{
+			ForProvider: v1alpha1.NotebookInstanceParameters{
+				InstanceType:         "ml.t3.medium",
+				RoleARN:              aws.String(roleARN),
+				DirectInternetAccess: aws.String("Enabled"),
+				RootAccess:           aws.String("Enabled"),
+				VolumeSizeInGB:       aws.Int64(5),
+			},
+		},
+	}
+	meta.SetExternalName(cr, notebookName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(status awssagemaker.NotebookInstanceStatus) func(*awssagemaker.DescribeNotebookInstanceInput) awssagemaker.DescribeNotebookInstanceRequest {
+	return func(*awssagemaker.DescribeNotebookInstanceInput) awssagemaker.DescribeNotebookInstanceRequest {
+		return awssagemaker.DescribeNotebookInstanceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.DescribeNotebookInstanceOutput{
+				NotebookInstanceName:   aws.String(notebookName),
+				NotebookInstanceArn:    aws.String(notebookARN),
+				NotebookInstanceStatus: status,
+				InstanceType:           awssagemaker.InstanceTypeMlT3Medium,
+				RoleArn:                aws.String(roleARN),
+				DirectInternetAccess:   awssagemaker.DirectInternetAccessEnabled,
+				RootAccess:             awssagemaker.RootAccessEnabled,
+				VolumeSizeInGB:         aws.Int64(5),
+			}},
+		}
+	}
+}
+
+func noTags(*awssagemaker.ListTagsInput) awssagemaker.ListTagsRequest {
+	return awssagemaker.ListTagsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.ListTagsOutput{}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InService": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockDescribeNotebookInstance: describe(awssagemaker.NotebookInstanceStatusInService),
+					MockListTags:                 noTags,
+				},
+				cr: notebook(),
+			},
+			want: want{
+				cr: notebook(withStatus("InService"), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"InstanceTypeChanged": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockDescribeNotebookInstance: describe(awssagemaker.NotebookInstanceStatusInService),
+					MockListTags:                 noTags,
+				},
+				cr: notebook(withInstanceType("ml.t3.large")),
+			},
+			want: want{
+				cr: notebook(withInstanceType("ml.t3.large"), withStatus("InService"), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Stopped": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockDescribeNotebookInstance: describe(awssagemaker.NotebookInstanceStatusStopped),
+				},
+				cr: notebook(),
+			},
+			want: want{
+				cr: notebook(withStatus("Stopped"), withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockDescribeNotebookInstance: describe(awssagemaker.NotebookInstanceStatusPending),
+				},
+				cr: notebook(),
+			},
+			want: want{
+				cr: notebook(withStatus("Pending"), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockDescribeNotebookInstance: func(*awssagemaker.DescribeNotebookInstanceInput) awssagemaker.DescribeNotebookInstanceRequest {
+						return awssagemaker.DescribeNotebookInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New("ValidationException", "RecordNotFound", nil)},
+						}
+					},
+				},
+				cr: notebook(),
+			},
+			want: want{
+				cr: notebook(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockDescribeNotebookInstance: func(*awssagemaker.DescribeNotebookInstanceInput) awssagemaker.DescribeNotebookInstanceRequest {
+						return awssagemaker.DescribeNotebookInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: notebook(),
+			},
+			want: want{
+				cr:  notebook(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockCreateNotebookInstance: func(input *awssagemaker.CreateNotebookInstanceInput) awssagemaker.CreateNotebookInstanceRequest {
+						if diff := cmp.Diff(notebookName, aws.StringValue(input.NotebookInstanceName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssagemaker.CreateNotebookInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.CreateNotebookInstanceOutput{}},
+						}
+					},
+				},
+				cr: notebook(),
+			},
+			want: want{
+				cr: notebook(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockCreateNotebookInstance: func(*awssagemaker.CreateNotebookInstanceInput) awssagemaker.CreateNotebookInstanceRequest {
+						return awssagemaker.CreateNotebookInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: notebook(),
+			},
+			want: want{
+				cr:  notebook(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"StopRunningInstance": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockDescribeNotebookInstance: describe(awssagemaker.NotebookInstanceStatusInService),
+					MockListTags:                 noTags,
+					MockStopNotebookInstance: func(*awssagemaker.StopNotebookInstanceInput) awssagemaker.StopNotebookInstanceRequest {
+						return awssagemaker.StopNotebookInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.StopNotebookInstanceOutput{}},
+						}
+					},
+				},
+				cr: notebook(withInstanceType("ml.t3.large")),
+			},
+			want: want{
+				cr: notebook(withInstanceType("ml.t3.large")),
+			},
+		},
+		"UpdateStoppedInstance": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockDescribeNotebookInstance: describe(awssagemaker.NotebookInstanceStatusStopped),
+					MockListTags:                 noTags,
+					MockUpdateNotebookInstance: func(input *awssagemaker.UpdateNotebookInstanceInput) awssagemaker.UpdateNotebookInstanceRequest {
+						if diff := cmp.Diff(awssagemaker.InstanceTypeMlT3Large, input.InstanceType); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssagemaker.UpdateNotebookInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.UpdateNotebookInstanceOutput{}},
+						}
+					},
+				},
+				cr: notebook(withInstanceType("ml.t3.large")),
+			},
+			want: want{
+				cr: notebook(withInstanceType("ml.t3.large")),
+			},
+		},
+		"StartUpdatedInstance": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockDescribeNotebookInstance: describe(awssagemaker.NotebookInstanceStatusStopped),
+					MockListTags:                 noTags,
+					MockStartNotebookInstance: func(*awssagemaker.StartNotebookInstanceInput) awssagemaker.StartNotebookInstanceRequest {
+						return awssagemaker.StartNotebookInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.StartNotebookInstanceOutput{}},
+						}
+					},
+				},
+				cr: notebook(),
+			},
+			want: want{
+				cr: notebook(),
+			},
+		},
+		"AddTags": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockDescribeNotebookInstance: describe(awssagemaker.NotebookInstanceStatusInService),
+					MockListTags:                 noTags,
+					MockAddTags: func(input *awssagemaker.AddTagsInput) awssagemaker.AddTagsRequest {
+						want := []awssagemaker.Tag{{Key: aws.String("k"), Value: aws.String("v")}}
+						if diff := cmp.Diff(want, input.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssagemaker.AddTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.AddTagsOutput{}},
+						}
+					},
+				},
+				cr: notebook(func(r *v1alpha1.NotebookInstance) { r.Spec.ForProvider.Tags = []v1alpha1.Tag{{Key: "k", Value: "v"}} }),
+			},
+			want: want{
+				cr: notebook(func(r *v1alpha1.NotebookInstance) { r.Spec.ForProvider.Tags = []v1alpha1.Tag{{Key: "k", Value: "v"}} }),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockDescribeNotebookInstance: func(*awssagemaker.DescribeNotebookInstanceInput) awssagemaker.DescribeNotebookInstanceRequest {
+						return awssagemaker.DescribeNotebookInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: notebook(),
+			},
+			want: want{
+				cr:  notebook(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"StopFirst": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockStopNotebookInstance: func(*awssagemaker.StopNotebookInstanceInput) awssagemaker.StopNotebookInstanceRequest {
+						return awssagemaker.StopNotebookInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.StopNotebookInstanceOutput{}},
+						}
+					},
+				},
+				cr: notebook(withStatus("InService")),
+			},
+			want: want{
+				cr: notebook(withStatus("InService"), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteStopped": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockDeleteNotebookInstance: func(*awssagemaker.DeleteNotebookInstanceInput) awssagemaker.DeleteNotebookInstanceRequest {
+						return awssagemaker.DeleteNotebookInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssagemaker.DeleteNotebookInstanceOutput{}},
+						}
+					},
+				},
+				cr: notebook(withStatus("Stopped")),
+			},
+			want: want{
+				cr: notebook(withStatus("Stopped"), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"WaitWhileStopping": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{},
+				cr:        notebook(withStatus("Stopping")),
+			},
+			want: want{
+				cr: notebook(withStatus("Stopping"), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sagemaker: &fake.MockNotebookInstanceClient{
+					MockDeleteNotebookInstance: func(*awssagemaker.DeleteNotebookInstanceInput) awssagemaker.DeleteNotebookInstanceRequest {
+						return awssagemaker.DeleteNotebookInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: notebook(withStatus("Failed")),
+			},
+			want: want{
+				cr:  notebook(withStatus("Failed"), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sagemaker, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awssecurityhub "github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/securityhub"
+	"github.com/crossplane/provider-aws/pkg/clients/securityhub/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	hubARN       = "arn:aws:securityhub:us-east-1:123456789012:hub/default"
+	subscribedAt = "2020-10-01T12:00:00.000Z"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awssecurityhub.ErrCodeInvalidAccessException, "Account 123456789012 is not subscribed to AWS Security Hub", nil)
+)
+
+type args struct {
+	securityhub securityhub.AccountClient
+	kube        *test.MockClient
+	cr          resource.Managed
+}
+
+type accountModifier func(*v1alpha1.SecurityHubAccount)
+
+func withConditions(c ...runtimev1alpha1.Condition) accountModifier {
+	return func(r *v1alpha1.SecurityHubAccount) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.SecurityHubAccountObservation) accountModifier {
+	return func(r *v1alpha1.SecurityHubAccount) { r.Status.AtProvider = o }
+}
+
+func account(m ...accountModifier) *v1alpha1.SecurityHubAccount {
+	cr := &v1alpha1.SecurityHubAccount{
+		Spec: v1alpha1.SecurityHubAccountSpec{
+			ForProvider: v1alpha1.SecurityHubAccountParameters{
+				Region:                 "us-east-1",
+				EnableDefaultStandards: aws.Bool(false),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeHub(err error) func(*awssecurityhub.DescribeHubInput) awssecurityhub.DescribeHubRequest {
+	return func(*awssecurityhub.DescribeHubInput) awssecurityhub.DescribeHubRequest {
+		return awssecurityhub.DescribeHubRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssecurityhub.DescribeHubOutput{
+				HubArn:       aws.String(hubARN),
+				SubscribedAt: aws.String(subscribedAt),
+			}, Error: err},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Enabled": {
+			args: args{
+				securityhub: &fake.MockAccountClient{MockDescribeHub: describeHub(nil)},
+				cr:          account(),
+			},
+			want: want{
+				cr: account(withConditions(runtimev1alpha1.Available()), withObservation(v1alpha1.SecurityHubAccountObservation{
+					HubARN:       hubARN,
+					SubscribedAt: subscribedAt,
+				})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotEnabled": {
+			args: args{
+				securityhub: &fake.MockAccountClient{MockDescribeHub: describeHub(errNotFound)},
+				cr:          account(),
+			},
+			want: want{
+				cr: account(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				securityhub: &fake.MockAccountClient{MockDescribeHub: describeHub(errBoom)},
+				cr:          account(),
+			},
+			want: want{
+				cr:  account(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.securityhub}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				securityhub: &fake.MockAccountClient{
+					MockEnableSecurityHub: func(in *awssecurityhub.EnableSecurityHubInput) awssecurityhub.EnableSecurityHubRequest {
+						if diff := cmp.Diff(aws.Bool(false), in.EnableDefaultStandards); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssecurityhub.EnableSecurityHubRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssecurityhub.EnableSecurityHubOutput{}},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr: account(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				securityhub: &fake.MockAccountClient{
+					MockEnableSecurityHub: func(*awssecurityhub.EnableSecurityHubInput) awssecurityhub.EnableSecurityHubRequest {
+						return awssecurityhub.EnableSecurityHubRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr:  account(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.securityhub}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	disableSecurityHub := func(err error) func(*awssecurityhub.DisableSecurityHubInput) awssecurityhub.DisableSecurityHubRequest {
+		return func(*awssecurityhub.DisableSecurityHubInput) awssecurityhub.DisableSecurityHubRequest {
+			return awssecurityhub.DisableSecurityHubRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssecurityhub.DisableSecurityHubOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				securityhub: &fake.MockAccountClient{MockDisableSecurityHub: disableSecurityHub(nil)},
+				cr:          account(),
+			},
+			want: want{
+				cr: account(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDisabled": {
+			args: args{
+				securityhub: &fake.MockAccountClient{MockDisableSecurityHub: disableSecurityHub(errNotFound)},
+				cr:          account(),
+			},
+			want: want{
+				cr: account(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				securityhub: &fake.MockAccountClient{MockDisableSecurityHub: disableSecurityHub(errBoom)},
+				cr:          account(),
+			},
+			want: want{
+				cr:  account(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.securityhub}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
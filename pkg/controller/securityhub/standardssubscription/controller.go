@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standardssubscription
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssecurityhub "github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/securityhub"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a SecurityHubStandardsSubscription resource"
+
+	errDescribe   = "failed to describe the SecurityHubStandardsSubscription resource"
+	errCreate     = "failed to create the SecurityHubStandardsSubscription resource"
+	errDelete     = "failed to delete the SecurityHubStandardsSubscription resource"
+	errSpecUpdate = "cannot update spec of SecurityHubStandardsSubscription custom resource"
+)
+
+// SetupSecurityHubStandardsSubscription adds a controller that reconciles
+// SecurityHubStandardsSubscriptions.
+func SetupSecurityHubStandardsSubscription(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.SecurityHubStandardsSubscriptionGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.SecurityHubStandardsSubscription{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SecurityHubStandardsSubscriptionGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: securityhub.NewStandardsSubscriptionClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) securityhub.StandardsSubscriptionClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.SecurityHubStandardsSubscription)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client securityhub.StandardsSubscriptionClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.SecurityHubStandardsSubscription)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	rsp, err := e.client.GetEnabledStandardsRequest(&awssecurityhub.GetEnabledStandardsInput{
+		StandardsSubscriptionArns: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(securityhub.IsNotFound, err), errDescribe)
+	}
+	if len(rsp.StandardsSubscriptions) == 0 {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+	sub := rsp.StandardsSubscriptions[0]
+
+	cr.Status.AtProvider = securityhub.GenerateStandardsSubscriptionObservation(sub)
+	switch sub.StandardsStatus {
+	case awssecurityhub.StandardsStatusReady:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awssecurityhub.StandardsStatusPending:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awssecurityhub.StandardsStatusDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	// All parameters of a subscription are immutable.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.SecurityHubStandardsSubscription)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.BatchEnableStandardsRequest(securityhub.GenerateBatchEnableStandardsInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if len(rsp.StandardsSubscriptions) == 0 {
+		return managed.ExternalCreation{}, nil
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.StandardsSubscriptions[0].StandardsSubscriptionArn))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.SecurityHubStandardsSubscription)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	// A subscription that is already being removed can't be disabled again.
+	if cr.Status.AtProvider.StandardsStatus == string(awssecurityhub.StandardsStatusDeleting) {
+		return nil
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.BatchDisableStandardsRequest(&awssecurityhub.BatchDisableStandardsInput{
+		StandardsSubscriptionArns: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(securityhub.IsNotFound, err), errDelete)
+}
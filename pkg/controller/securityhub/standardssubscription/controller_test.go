@@ -0,0 +1,341 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standardssubscription
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssecurityhub "github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/securityhub"
+	"github.com/crossplane/provider-aws/pkg/clients/securityhub/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	standardsARN    = "arn:aws:securityhub:us-east-1::standards/aws-foundational-security-best-practices/v/1.0.0"
+	subscriptionARN = "arn:aws:securityhub:us-east-1:123456789012:subscription/aws-foundational-security-best-practices/v/1.0.0"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	securityhub securityhub.StandardsSubscriptionClient
+	kube        *test.MockClient
+	cr          resource.Managed
+}
+
+type subscriptionModifier func(*v1alpha1.SecurityHubStandardsSubscription)
+
+func withConditions(c ...runtimev1alpha1.Condition) subscriptionModifier {
+	return func(r *v1alpha1.SecurityHubStandardsSubscription) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) subscriptionModifier {
+	return func(r *v1alpha1.SecurityHubStandardsSubscription) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s awssecurityhub.StandardsStatus) subscriptionModifier {
+	return func(r *v1alpha1.SecurityHubStandardsSubscription) { r.Status.AtProvider.StandardsStatus = string(s) }
+}
+
+func subscription(m ...subscriptionModifier) *v1alpha1.SecurityHubStandardsSubscription {
+	cr := &v1alpha1.SecurityHubStandardsSubscription{
+		Spec: v1alpha1.SecurityHubStandardsSubscriptionSpec{
+			ForProvider: v1alpha1.SecurityHubStandardsSubscriptionParameters{
+				Region:       "us-east-1",
+				StandardsARN: standardsARN,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getEnabledStandards(s ...awssecurityhub.StandardsStatus) func(*awssecurityhub.GetEnabledStandardsInput) awssecurityhub.GetEnabledStandardsRequest {
+	return func(*awssecurityhub.GetEnabledStandardsInput) awssecurityhub.GetEnabledStandardsRequest {
+		out := &awssecurityhub.GetEnabledStandardsOutput{}
+		for _, status := range s {
+			out.StandardsSubscriptions = append(out.StandardsSubscriptions, awssecurityhub.StandardsSubscription{
+				StandardsArn:             aws.String(standardsARN),
+				StandardsSubscriptionArn: aws.String(subscriptionARN),
+				StandardsStatus:          status,
+			})
+		}
+		return awssecurityhub.GetEnabledStandardsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Ready": {
+			args: args{
+				securityhub: &fake.MockStandardsSubscriptionClient{MockGetEnabledStandards: getEnabledStandards(awssecurityhub.StandardsStatusReady)},
+				cr:          subscription(withExternalName(subscriptionARN)),
+			},
+			want: want{
+				cr: subscription(withExternalName(subscriptionARN), withStatus(awssecurityhub.StandardsStatusReady),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Pending": {
+			args: args{
+				securityhub: &fake.MockStandardsSubscriptionClient{MockGetEnabledStandards: getEnabledStandards(awssecurityhub.StandardsStatusPending)},
+				cr:          subscription(withExternalName(subscriptionARN)),
+			},
+			want: want{
+				cr: subscription(withExternalName(subscriptionARN), withStatus(awssecurityhub.StandardsStatusPending),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Failed": {
+			args: args{
+				securityhub: &fake.MockStandardsSubscriptionClient{MockGetEnabledStandards: getEnabledStandards(awssecurityhub.StandardsStatusFailed)},
+				cr:          subscription(withExternalName(subscriptionARN)),
+			},
+			want: want{
+				cr: subscription(withExternalName(subscriptionARN), withStatus(awssecurityhub.StandardsStatusFailed),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: subscription(),
+			},
+			want: want{
+				cr: subscription(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				securityhub: &fake.MockStandardsSubscriptionClient{MockGetEnabledStandards: getEnabledStandards()},
+				cr:          subscription(withExternalName(subscriptionARN)),
+			},
+			want: want{
+				cr: subscription(withExternalName(subscriptionARN)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				securityhub: &fake.MockStandardsSubscriptionClient{
+					MockGetEnabledStandards: func(*awssecurityhub.GetEnabledStandardsInput) awssecurityhub.GetEnabledStandardsRequest {
+						return awssecurityhub.GetEnabledStandardsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: subscription(withExternalName(subscriptionARN)),
+			},
+			want: want{
+				cr:  subscription(withExternalName(subscriptionARN)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.securityhub}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				securityhub: &fake.MockStandardsSubscriptionClient{
+					MockBatchEnableStandards: func(in *awssecurityhub.BatchEnableStandardsInput) awssecurityhub.BatchEnableStandardsRequest {
+						if diff := cmp.Diff(standardsARN, aws.StringValue(in.StandardsSubscriptionRequests[0].StandardsArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssecurityhub.BatchEnableStandardsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssecurityhub.BatchEnableStandardsOutput{
+								StandardsSubscriptions: []awssecurityhub.StandardsSubscription{{
+									StandardsSubscriptionArn: aws.String(subscriptionARN),
+								}},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   subscription(),
+			},
+			want: want{
+				cr: subscription(withExternalName(subscriptionARN), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				securityhub: &fake.MockStandardsSubscriptionClient{
+					MockBatchEnableStandards: func(*awssecurityhub.BatchEnableStandardsInput) awssecurityhub.BatchEnableStandardsRequest {
+						return awssecurityhub.BatchEnableStandardsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: subscription(),
+			},
+			want: want{
+				cr:  subscription(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.securityhub}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	batchDisableStandards := func(err error) func(*awssecurityhub.BatchDisableStandardsInput) awssecurityhub.BatchDisableStandardsRequest {
+		return func(in *awssecurityhub.BatchDisableStandardsInput) awssecurityhub.BatchDisableStandardsRequest {
+			if diff := cmp.Diff([]string{subscriptionARN}, in.StandardsSubscriptionArns); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awssecurityhub.BatchDisableStandardsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssecurityhub.BatchDisableStandardsOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				securityhub: &fake.MockStandardsSubscriptionClient{MockBatchDisableStandards: batchDisableStandards(nil)},
+				cr:          subscription(withExternalName(subscriptionARN)),
+			},
+			want: want{
+				cr: subscription(withExternalName(subscriptionARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: subscription(withExternalName(subscriptionARN), withStatus(awssecurityhub.StandardsStatusDeleting)),
+			},
+			want: want{
+				cr: subscription(withExternalName(subscriptionARN), withStatus(awssecurityhub.StandardsStatusDeleting)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				securityhub: &fake.MockStandardsSubscriptionClient{MockBatchDisableStandards: batchDisableStandards(errBoom)},
+				cr:          subscription(withExternalName(subscriptionARN)),
+			},
+			want: want{
+				cr:  subscription(withExternalName(subscriptionARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.securityhub}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
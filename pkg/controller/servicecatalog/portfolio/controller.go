@@ -0,0 +1,234 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portfolio
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsservicecatalog "github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/servicecatalog/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/servicecatalog"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Service Catalog Portfolio resource"
+
+	errDescribe       = "failed to describe the Portfolio resource"
+	errListPrincipals = "failed to list the principals of the Portfolio resource"
+	errCreate         = "failed to create the Portfolio resource"
+	errUpdate         = "failed to update the Portfolio resource"
+	errAssociate      = "failed to associate a principal with the Portfolio resource"
+	errDisassociate   = "failed to disassociate a principal from the Portfolio resource"
+	errDelete         = "failed to delete the Portfolio resource"
+	errSpecUpdate     = "cannot update spec of the Portfolio custom resource"
+)
+
+// SetupPortfolio adds a controller that reconciles Portfolios.
+func SetupPortfolio(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.PortfolioGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Portfolio{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PortfolioGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: servicecatalog.NewPortfolioClient}, awsclients.DeletionTierAttachment), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) servicecatalog.PortfolioClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Portfolio)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client servicecatalog.PortfolioClient
+}
+
+// principals returns the ARNs of the principals that are associated with the
+// portfolio with the given ID.
+func (e *external) principals(ctx context.Context, id string) ([]string, error) {
+	in := &awsservicecatalog.ListPrincipalsForPortfolioInput{PortfolioId: aws.String(id)}
+	var arns []string
+	for {
+		rsp, err := e.client.ListPrincipalsForPortfolioRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range rsp.Principals {
+			arns = append(arns, aws.StringValue(p.PrincipalARN))
+		}
+		if rsp.NextPageToken == nil {
+			return arns, nil
+		}
+		in.PageToken = rsp.NextPageToken
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Portfolio)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	rsp, err := e.client.DescribePortfolioRequest(&awsservicecatalog.DescribePortfolioInput{
+		Id: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(servicecatalog.IsNotFound, err), errDescribe)
+	}
+	if rsp.PortfolioDetail == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	principals, err := e.principals(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListPrincipals)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	servicecatalog.LateInitializePortfolio(&cr.Spec.ForProvider, rsp.PortfolioDetail)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = servicecatalog.GeneratePortfolioObservation(*rsp.PortfolioDetail)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	associate, disassociate := servicecatalog.DiffAssociations(cr.Spec.ForProvider.PrincipalARNs, principals)
+	add, remove := servicecatalog.DiffTags(cr.Spec.ForProvider.Tags, rsp.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: servicecatalog.IsPortfolioUpToDate(cr.Spec.ForProvider, *rsp.PortfolioDetail) &&
+			len(associate) == 0 && len(disassociate) == 0 &&
+			len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Portfolio)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.CreatePortfolioRequest(servicecatalog.GenerateCreatePortfolioInput(string(cr.GetUID()), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.PortfolioDetail.Id))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Portfolio)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := meta.GetExternalName(cr)
+	rsp, err := e.client.DescribePortfolioRequest(&awsservicecatalog.DescribePortfolioInput{Id: aws.String(id)}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	add, remove := servicecatalog.DiffTags(cr.Spec.ForProvider.Tags, rsp.Tags)
+	if rsp.PortfolioDetail == nil || !servicecatalog.IsPortfolioUpToDate(cr.Spec.ForProvider, *rsp.PortfolioDetail) || len(add) != 0 || len(remove) != 0 {
+		if _, err := e.client.UpdatePortfolioRequest(servicecatalog.GenerateUpdatePortfolioInput(id, cr.Spec.ForProvider, add, remove)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	principals, err := e.principals(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListPrincipals)
+	}
+	associate, disassociate := servicecatalog.DiffAssociations(cr.Spec.ForProvider.PrincipalARNs, principals)
+	for _, arn := range disassociate {
+		if _, err := e.client.DisassociatePrincipalFromPortfolioRequest(&awsservicecatalog.DisassociatePrincipalFromPortfolioInput{
+			PortfolioId:  aws.String(id),
+			PrincipalARN: aws.String(arn),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDisassociate)
+		}
+	}
+	for _, arn := range associate {
+		if _, err := e.client.AssociatePrincipalWithPortfolioRequest(&awsservicecatalog.AssociatePrincipalWithPortfolioInput{
+			PortfolioId:   aws.String(id),
+			PrincipalARN:  aws.String(arn),
+			PrincipalType: awsservicecatalog.PrincipalTypeIam,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAssociate)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Portfolio)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	id := meta.GetExternalName(cr)
+
+	// A portfolio can only be deleted once it has no principals left.
+	principals, err := e.principals(ctx, id)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(servicecatalog.IsNotFound, err), errListPrincipals)
+	}
+	for _, arn := range principals {
+		if _, err := e.client.DisassociatePrincipalFromPortfolioRequest(&awsservicecatalog.DisassociatePrincipalFromPortfolioInput{
+			PortfolioId:  aws.String(id),
+			PrincipalARN: aws.String(arn),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(resource.Ignore(servicecatalog.IsNotFound, err), errDisassociate)
+		}
+	}
+	_, err = e.client.DeletePortfolioRequest(&awsservicecatalog.DeletePortfolioInput{Id: aws.String(id)}).Send(ctx)
+	return errors.Wrap(resource.Ignore(servicecatalog.IsNotFound, err), errDelete)
+}
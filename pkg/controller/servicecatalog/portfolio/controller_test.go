@@ -0,0 +1,525 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portfolio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsservicecatalog "github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/servicecatalog/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/servicecatalog"
+	"github.com/crossplane/provider-aws/pkg/clients/servicecatalog/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	portfolioID   = "port-abcdefghijklm"
+	portfolioARN  = "arn:aws:catalog:us-east-1:123456789012:portfolio/port-abcdefghijklm"
+	displayName   = "platform"
+	description   = "curated products"
+	providerName  = "platform team"
+	principalARN  = "arn:aws:iam::123456789012:role/developer"
+	principalARN2 = "arn:aws:iam::123456789012:role/operator"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client servicecatalog.PortfolioClient
+	kube   *test.MockClient
+	cr     resource.Managed
+}
+
+type portfolioModifier func(*v1alpha1.Portfolio)
+
+func withConditions(c ...runtimev1alpha1.Condition) portfolioModifier {
+	return func(r *v1alpha1.Portfolio) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) portfolioModifier {
+	return func(r *v1alpha1.Portfolio) { meta.SetExternalName(r, n) }
+}
+
+func withDescription(d *string) portfolioModifier {
+	return func(r *v1alpha1.Portfolio) { r.Spec.ForProvider.Description = d }
+}
+
+func withPrincipalARNs(arns ...string) portfolioModifier {
+	return func(r *v1alpha1.Portfolio) { r.Spec.ForProvider.PrincipalARNs = arns }
+}
+
+func withTags(t ...v1alpha1.Tag) portfolioModifier {
+	return func(r *v1alpha1.Portfolio) { r.Spec.ForProvider.Tags = t }
+}
+
+func withObservation() portfolioModifier {
+	return func(r *v1alpha1.Portfolio) {
+		r.Status.AtProvider = v1alpha1.PortfolioObservation{ARN: portfolioARN}
+	}
+}
+
+func portfolio(m ...portfolioModifier) *v1alpha1.Portfolio {
+	cr := &v1alpha1.Portfolio{
+		Spec: v1alpha1.PortfolioSpec{
+			ForProvider: v1alpha1.PortfolioParameters{
+				DisplayName:   displayName,
+				Description:   aws.String(description),
+				ProviderName:  providerName,
+				PrincipalARNs: []string{principalARN},
+			},
+		},
+	}
+	meta.SetExternalName(cr, portfolioID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describePortfolio(*awsservicecatalog.DescribePortfolioInput) awsservicecatalog.DescribePortfolioRequest {
+	return awsservicecatalog.DescribePortfolioRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.DescribePortfolioOutput{
+			PortfolioDetail: &awsservicecatalog.PortfolioDetail{
+				ARN:          aws.String(portfolioARN),
+				Id:           aws.String(portfolioID),
+				DisplayName:  aws.String(displayName),
+				Description:  aws.String(description),
+				ProviderName: aws.String(providerName),
+			},
+		}},
+	}
+}
+
+func listPrincipals(*awsservicecatalog.ListPrincipalsForPortfolioInput) awsservicecatalog.ListPrincipalsForPortfolioRequest {
+	return awsservicecatalog.ListPrincipalsForPortfolioRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.ListPrincipalsForPortfolioOutput{
+			Principals: []awsservicecatalog.Principal{{PrincipalARN: aws.String(principalARN), PrincipalType: awsservicecatalog.PrincipalTypeIam}},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockPortfolioClient{
+					MockDescribePortfolio:          describePortfolio,
+					MockListPrincipalsForPortfolio: listPrincipals,
+				},
+				cr: portfolio(),
+			},
+			want: want{
+				cr: portfolio(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				client: &fake.MockPortfolioClient{
+					MockDescribePortfolio:          describePortfolio,
+					MockListPrincipalsForPortfolio: listPrincipals,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   portfolio(withDescription(nil)),
+			},
+			want: want{
+				cr: portfolio(withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PrincipalsNotUpToDate": {
+			args: args{
+				client: &fake.MockPortfolioClient{
+					MockDescribePortfolio:          describePortfolio,
+					MockListPrincipalsForPortfolio: listPrincipals,
+				},
+				cr: portfolio(withPrincipalARNs(principalARN, principalARN2)),
+			},
+			want: want{
+				cr: portfolio(withPrincipalARNs(principalARN, principalARN2), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"TagsNotUpToDate": {
+			args: args{
+				client: &fake.MockPortfolioClient{
+					MockDescribePortfolio:          describePortfolio,
+					MockListPrincipalsForPortfolio: listPrincipals,
+				},
+				cr: portfolio(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: portfolio(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockPortfolioClient{
+					MockDescribePortfolio: func(*awsservicecatalog.DescribePortfolioInput) awsservicecatalog.DescribePortfolioRequest {
+						return awsservicecatalog.DescribePortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsservicecatalog.ErrCodeResourceNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: portfolio(),
+			},
+			want: want{
+				cr: portfolio(),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: portfolio(withExternalName("")),
+			},
+			want: want{
+				cr: portfolio(withExternalName("")),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				client: &fake.MockPortfolioClient{
+					MockDescribePortfolio: func(*awsservicecatalog.DescribePortfolioInput) awsservicecatalog.DescribePortfolioRequest {
+						return awsservicecatalog.DescribePortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: portfolio(),
+			},
+			want: want{
+				cr:  portfolio(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockPortfolioClient{
+					MockCreatePortfolio: func(input *awsservicecatalog.CreatePortfolioInput) awsservicecatalog.CreatePortfolioRequest {
+						if diff := cmp.Diff(aws.String(displayName), input.DisplayName); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsservicecatalog.CreatePortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.CreatePortfolioOutput{
+								PortfolioDetail: &awsservicecatalog.PortfolioDetail{Id: aws.String(portfolioID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   portfolio(withExternalName("")),
+			},
+			want: want{
+				cr: portfolio(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				client: &fake.MockPortfolioClient{
+					MockCreatePortfolio: func(*awsservicecatalog.CreatePortfolioInput) awsservicecatalog.CreatePortfolioRequest {
+						return awsservicecatalog.CreatePortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: portfolio(withExternalName("")),
+			},
+			want: want{
+				cr:  portfolio(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdatePrincipals": {
+			args: args{
+				client: &fake.MockPortfolioClient{
+					MockDescribePortfolio:          describePortfolio,
+					MockListPrincipalsForPortfolio: listPrincipals,
+					MockDisassociatePrincipalFromPortfolio: func(input *awsservicecatalog.DisassociatePrincipalFromPortfolioInput) awsservicecatalog.DisassociatePrincipalFromPortfolioRequest {
+						if diff := cmp.Diff(aws.String(principalARN), input.PrincipalARN); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsservicecatalog.DisassociatePrincipalFromPortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.DisassociatePrincipalFromPortfolioOutput{}},
+						}
+					},
+					MockAssociatePrincipalWithPortfolio: func(input *awsservicecatalog.AssociatePrincipalWithPortfolioInput) awsservicecatalog.AssociatePrincipalWithPortfolioRequest {
+						if diff := cmp.Diff(aws.String(principalARN2), input.PrincipalARN); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsservicecatalog.AssociatePrincipalWithPortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.AssociatePrincipalWithPortfolioOutput{}},
+						}
+					},
+				},
+				cr: portfolio(withPrincipalARNs(principalARN2)),
+			},
+			want: want{
+				cr: portfolio(withPrincipalARNs(principalARN2)),
+			},
+		},
+		"UpdateAttributesAndTags": {
+			args: args{
+				client: &fake.MockPortfolioClient{
+					MockDescribePortfolio:          describePortfolio,
+					MockListPrincipalsForPortfolio: listPrincipals,
+					MockUpdatePortfolio: func(input *awsservicecatalog.UpdatePortfolioInput) awsservicecatalog.UpdatePortfolioRequest {
+						if diff := cmp.Diff(aws.String("new"), input.Description); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff([]awsservicecatalog.Tag{{Key: aws.String("k"), Value: aws.String("v")}}, input.AddTags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsservicecatalog.UpdatePortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.UpdatePortfolioOutput{}},
+						}
+					},
+				},
+				cr: portfolio(withDescription(aws.String("new")), withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: portfolio(withDescription(aws.String("new")), withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				client: &fake.MockPortfolioClient{
+					MockDescribePortfolio:          describePortfolio,
+					MockListPrincipalsForPortfolio: listPrincipals,
+					MockAssociatePrincipalWithPortfolio: func(*awsservicecatalog.AssociatePrincipalWithPortfolioInput) awsservicecatalog.AssociatePrincipalWithPortfolioRequest {
+						return awsservicecatalog.AssociatePrincipalWithPortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: portfolio(withPrincipalARNs(principalARN, principalARN2)),
+			},
+			want: want{
+				cr:  portfolio(withPrincipalARNs(principalARN, principalARN2)),
+				err: errors.Wrap(errBoom, errAssociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockPortfolioClient{
+					MockListPrincipalsForPortfolio: listPrincipals,
+					MockDisassociatePrincipalFromPortfolio: func(*awsservicecatalog.DisassociatePrincipalFromPortfolioInput) awsservicecatalog.DisassociatePrincipalFromPortfolioRequest {
+						return awsservicecatalog.DisassociatePrincipalFromPortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.DisassociatePrincipalFromPortfolioOutput{}},
+						}
+					},
+					MockDeletePortfolio: func(*awsservicecatalog.DeletePortfolioInput) awsservicecatalog.DeletePortfolioRequest {
+						return awsservicecatalog.DeletePortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.DeletePortfolioOutput{}},
+						}
+					},
+				},
+				cr: portfolio(),
+			},
+			want: want{
+				cr: portfolio(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				client: &fake.MockPortfolioClient{
+					MockListPrincipalsForPortfolio: func(*awsservicecatalog.ListPrincipalsForPortfolioInput) awsservicecatalog.ListPrincipalsForPortfolioRequest {
+						return awsservicecatalog.ListPrincipalsForPortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.ListPrincipalsForPortfolioOutput{}},
+						}
+					},
+					MockDeletePortfolio: func(*awsservicecatalog.DeletePortfolioInput) awsservicecatalog.DeletePortfolioRequest {
+						return awsservicecatalog.DeletePortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: portfolio(),
+			},
+			want: want{
+				cr:  portfolio(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
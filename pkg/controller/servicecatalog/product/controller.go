@@ -0,0 +1,369 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package product
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsservicecatalog "github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/servicecatalog/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/servicecatalog"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Service Catalog Product resource"
+
+	errDescribe           = "failed to describe the Product resource"
+	errListPortfolios     = "failed to list the portfolios of the Product resource"
+	errListConstraints    = "failed to list the constraints of the Product resource"
+	errDescribeConstraint = "failed to describe a launch constraint of the Product resource"
+	errCreate             = "failed to create the Product resource"
+	errUpdate             = "failed to update the Product resource"
+	errAssociate          = "failed to associate the Product resource with a portfolio"
+	errDisassociate       = "failed to disassociate the Product resource from a portfolio"
+	errCreateConstraint   = "failed to create a launch constraint of the Product resource"
+	errUpdateConstraint   = "failed to update a launch constraint of the Product resource"
+	errDeleteConstraint   = "failed to delete a constraint of the Product resource"
+	errDelete             = "failed to delete the Product resource"
+	errSpecUpdate         = "cannot update spec of the Product custom resource"
+)
+
+// SetupProduct adds a controller that reconciles Products.
+func SetupProduct(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ProductGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Product{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ProductGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: servicecatalog.NewProductClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) servicecatalog.ProductClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Product)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client servicecatalog.ProductClient
+}
+
+// portfolios returns the IDs of the portfolios the product with the given ID
+// is associated with.
+func (e *external) portfolios(ctx context.Context, id string) ([]string, error) {
+	in := &awsservicecatalog.ListPortfoliosForProductInput{ProductId: aws.String(id)}
+	var ids []string
+	for {
+		rsp, err := e.client.ListPortfoliosForProductRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range rsp.PortfolioDetails {
+			ids = append(ids, aws.StringValue(p.Id))
+		}
+		if rsp.NextPageToken == nil {
+			return ids, nil
+		}
+		in.PageToken = rsp.NextPageToken
+	}
+}
+
+// constraints returns the constraints of the product with the given ID in the
+// portfolio with the given ID.
+func (e *external) constraints(ctx context.Context, portfolioID, id string) ([]awsservicecatalog.ConstraintDetail, error) {
+	in := &awsservicecatalog.ListConstraintsForPortfolioInput{
+		PortfolioId: aws.String(portfolioID),
+		ProductId:   aws.String(id),
+	}
+	var constraints []awsservicecatalog.ConstraintDetail
+	for {
+		rsp, err := e.client.ListConstraintsForPortfolioRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, rsp.ConstraintDetails...)
+		if rsp.NextPageToken == nil {
+			return constraints, nil
+		}
+		in.PageToken = rsp.NextPageToken
+	}
+}
+
+// launchConstraint returns the launch constraint of the product with the
+// given ID in the portfolio with the given ID along with the ARN of its role,
+// or nil if there is none.
+func (e *external) launchConstraint(ctx context.Context, portfolioID, id string) (*awsservicecatalog.ConstraintDetail, string, error) {
+	constraints, err := e.constraints(ctx, portfolioID, id)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errListConstraints)
+	}
+	for i := range constraints {
+		if aws.StringValue(constraints[i].Type) != servicecatalog.ConstraintTypeLaunch {
+			continue
+		}
+		rsp, err := e.client.DescribeConstraintRequest(&awsservicecatalog.DescribeConstraintInput{
+			Id: constraints[i].ConstraintId,
+		}).Send(ctx)
+		if err != nil {
+			return nil, "", errors.Wrap(err, errDescribeConstraint)
+		}
+		return &constraints[i], servicecatalog.GetLaunchRoleARN(rsp.ConstraintParameters), nil
+	}
+	return nil, "", nil
+}
+
+// isLaunchConstraintUpToDate returns whether the launch constraint of the
+// product with the given ID in the portfolio with the given ID uses the
+// desired launch role.
+func (e *external) isLaunchConstraintUpToDate(ctx context.Context, portfolioID, id string, p v1alpha1.ProductParameters) (bool, error) {
+	c, roleARN, err := e.launchConstraint(ctx, portfolioID, id)
+	if err != nil {
+		return false, err
+	}
+	if p.LaunchRoleARN == nil {
+		return c == nil, nil
+	}
+	return c != nil && roleARN == aws.StringValue(p.LaunchRoleARN), nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Product)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	rsp, err := e.client.DescribeProductAsAdminRequest(&awsservicecatalog.DescribeProductAsAdminInput{
+		Id: aws.String(id),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(servicecatalog.IsNotFound, err), errDescribe)
+	}
+	if rsp.ProductViewDetail == nil || rsp.ProductViewDetail.ProductViewSummary == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	servicecatalog.LateInitializeProduct(&cr.Spec.ForProvider, rsp.ProductViewDetail.ProductViewSummary)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = servicecatalog.GenerateProductObservation(*rsp.DescribeProductAsAdminOutput)
+	switch rsp.ProductViewDetail.Status {
+	case awsservicecatalog.StatusAvailable:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsservicecatalog.StatusCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	add, remove := servicecatalog.DiffTags(cr.Spec.ForProvider.Tags, rsp.Tags)
+	if !servicecatalog.IsProductUpToDate(cr.Spec.ForProvider, *rsp.ProductViewDetail.ProductViewSummary) || len(add) != 0 || len(remove) != 0 {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+	portfolios, err := e.portfolios(ctx, id)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListPortfolios)
+	}
+	if associate, disassociate := servicecatalog.DiffAssociations(cr.Spec.ForProvider.PortfolioIDs, portfolios); len(associate) != 0 || len(disassociate) != 0 {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+	for _, portfolioID := range portfolios {
+		upToDate, err := e.isLaunchConstraintUpToDate(ctx, portfolioID, id, cr.Spec.ForProvider)
+		if err != nil || !upToDate {
+			return managed.ExternalObservation{ResourceExists: true}, err
+		}
+	}
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Product)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.CreateProductRequest(servicecatalog.GenerateCreateProductInput(string(cr.GetUID()), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.ProductViewDetail.ProductViewSummary.ProductId))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Product)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := meta.GetExternalName(cr)
+	rsp, err := e.client.DescribeProductAsAdminRequest(&awsservicecatalog.DescribeProductAsAdminInput{Id: aws.String(id)}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	add, remove := servicecatalog.DiffTags(cr.Spec.ForProvider.Tags, rsp.Tags)
+	if rsp.ProductViewDetail == nil || rsp.ProductViewDetail.ProductViewSummary == nil ||
+		!servicecatalog.IsProductUpToDate(cr.Spec.ForProvider, *rsp.ProductViewDetail.ProductViewSummary) || len(add) != 0 || len(remove) != 0 {
+		if _, err := e.client.UpdateProductRequest(servicecatalog.GenerateUpdateProductInput(id, cr.Spec.ForProvider, add, remove)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	portfolios, err := e.portfolios(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListPortfolios)
+	}
+	associate, disassociate := servicecatalog.DiffAssociations(cr.Spec.ForProvider.PortfolioIDs, portfolios)
+	for _, portfolioID := range disassociate {
+		if err := e.disassociate(ctx, portfolioID, id); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	for _, portfolioID := range associate {
+		if _, err := e.client.AssociateProductWithPortfolioRequest(&awsservicecatalog.AssociateProductWithPortfolioInput{
+			PortfolioId: aws.String(portfolioID),
+			ProductId:   aws.String(id),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAssociate)
+		}
+	}
+	for _, portfolioID := range cr.Spec.ForProvider.PortfolioIDs {
+		if err := e.syncLaunchConstraint(ctx, cr, portfolioID); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// syncLaunchConstraint creates, updates or deletes the launch constraint of
+// the given product in the portfolio with the given ID so that it uses the
+// desired launch role.
+func (e *external) syncLaunchConstraint(ctx context.Context, cr *v1alpha1.Product, portfolioID string) error {
+	id := meta.GetExternalName(cr)
+	c, roleARN, err := e.launchConstraint(ctx, portfolioID, id)
+	if err != nil {
+		return err
+	}
+	desired := cr.Spec.ForProvider.LaunchRoleARN
+	switch {
+	case desired == nil && c == nil:
+		return nil
+	case desired == nil:
+		_, err := e.client.DeleteConstraintRequest(&awsservicecatalog.DeleteConstraintInput{Id: c.ConstraintId}).Send(ctx)
+		return errors.Wrap(resource.Ignore(servicecatalog.IsNotFound, err), errDeleteConstraint)
+	case c != nil && roleARN == aws.StringValue(desired):
+		return nil
+	}
+	params, err := servicecatalog.GenerateLaunchConstraintParameters(aws.StringValue(desired))
+	if err != nil {
+		return errors.Wrap(err, errUpdateConstraint)
+	}
+	if c != nil {
+		_, err := e.client.UpdateConstraintRequest(&awsservicecatalog.UpdateConstraintInput{
+			Id:         c.ConstraintId,
+			Parameters: aws.String(params),
+		}).Send(ctx)
+		return errors.Wrap(err, errUpdateConstraint)
+	}
+	_, err = e.client.CreateConstraintRequest(&awsservicecatalog.CreateConstraintInput{
+		IdempotencyToken: aws.String(string(cr.GetUID()) + "-" + portfolioID),
+		Parameters:       aws.String(params),
+		PortfolioId:      aws.String(portfolioID),
+		ProductId:        aws.String(id),
+		Type:             aws.String(servicecatalog.ConstraintTypeLaunch),
+	}).Send(ctx)
+	return errors.Wrap(err, errCreateConstraint)
+}
+
+// disassociate deletes the constraints of the product with the given ID in the
+// portfolio with the given ID and then disassociates the product from it.
+func (e *external) disassociate(ctx context.Context, portfolioID, id string) error {
+	constraints, err := e.constraints(ctx, portfolioID, id)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(servicecatalog.IsNotFound, err), errListConstraints)
+	}
+	for _, c := range constraints {
+		if _, err := e.client.DeleteConstraintRequest(&awsservicecatalog.DeleteConstraintInput{Id: c.ConstraintId}).Send(ctx); err != nil {
+			return errors.Wrap(resource.Ignore(servicecatalog.IsNotFound, err), errDeleteConstraint)
+		}
+	}
+	_, err = e.client.DisassociateProductFromPortfolioRequest(&awsservicecatalog.DisassociateProductFromPortfolioInput{
+		PortfolioId: aws.String(portfolioID),
+		ProductId:   aws.String(id),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(servicecatalog.IsNotFound, err), errDisassociate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Product)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	id := meta.GetExternalName(cr)
+
+	// A product can only be deleted once it is no longer in any portfolio.
+	portfolios, err := e.portfolios(ctx, id)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(servicecatalog.IsNotFound, err), errListPortfolios)
+	}
+	for _, portfolioID := range portfolios {
+		if err := e.disassociate(ctx, portfolioID, id); err != nil {
+			return err
+		}
+	}
+	_, err = e.client.DeleteProductRequest(&awsservicecatalog.DeleteProductInput{Id: aws.String(id)}).Send(ctx)
+	return errors.Wrap(resource.Ignore(servicecatalog.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,650 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package product
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsservicecatalog "github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/servicecatalog/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/servicecatalog"
+	"github.com/crossplane/provider-aws/pkg/clients/servicecatalog/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	productID     = "prod-abcdefghijklm"
+	productARN    = "arn:aws:catalog:us-east-1:123456789012:product/prod-abcdefghijklm"
+	productViewID = "prodview-abcdefghijklm"
+	artifactID    = "pa-abcdefghijklm"
+	productName   = "website"
+	owner         = "platform"
+	description   = "static website"
+	portfolioID   = "port-abcdefghijklm"
+	portfolioID2  = "port-nopqrstuvwxyz"
+	constraintID  = "cons-abcdefghijklm"
+	roleARN       = "arn:aws:iam::123456789012:role/launch"
+	roleARN2      = "arn:aws:iam::123456789012:role/launch2"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client servicecatalog.ProductClient
+	kube   *test.MockClient
+	cr     resource.Managed
+}
+
+type productModifier func(*v1alpha1.Product)
+
+func withConditions(c ...runtimev1alpha1.Condition) productModifier {
+	return func(r *v1alpha1.Product) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) productModifier {
+	return func(r *v1alpha1.Product) { meta.SetExternalName(r, n) }
+}
+
+func withDescription(d *string) productModifier {
+	return func(r *v1alpha1.Product) { r.Spec.ForProvider.Description = d }
+}
+
+func withPortfolioIDs(ids ...string) productModifier {
+	return func(r *v1alpha1.Product) { r.Spec.ForProvider.PortfolioIDs = ids }
+}
+
+func withLaunchRoleARN(arn *string) productModifier {
+	return func(r *v1alpha1.Product) { r.Spec.ForProvider.LaunchRoleARN = arn }
+}
+
+func withObservation(s awsservicecatalog.Status) productModifier {
+	return func(r *v1alpha1.Product) {
+		r.Status.AtProvider = v1alpha1.ProductObservation{
+			ARN:                    productARN,
+			ProductViewID:          productViewID,
+			ProvisioningArtifactID: artifactID,
+			Status:                 string(s),
+		}
+	}
+}
+
+func product(m ...productModifier) *v1alpha1.Product {
+	cr := &v1alpha1.Product{
+		Spec: v1alpha1.ProductSpec{
+			ForProvider: v1alpha1.ProductParameters{
+				Name:        productName,
+				Owner:       owner,
+				Description: aws.String(description),
+				ProvisioningArtifact: v1alpha1.ProvisioningArtifactParameters{
+					TemplateBucket: aws.String("templates"),
+					TemplateKey:    aws.String("website.yaml"),
+				},
+				PortfolioIDs:  []string{portfolioID},
+				LaunchRoleARN: aws.String(roleARN),
+			},
+		},
+	}
+	meta.SetExternalName(cr, productID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeProduct(s awsservicecatalog.Status) func(*awsservicecatalog.DescribeProductAsAdminInput) awsservicecatalog.DescribeProductAsAdminRequest {
+	return func(*awsservicecatalog.DescribeProductAsAdminInput) awsservicecatalog.DescribeProductAsAdminRequest {
+		return awsservicecatalog.DescribeProductAsAdminRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.DescribeProductAsAdminOutput{
+				ProductViewDetail: &awsservicecatalog.ProductViewDetail{
+					ProductARN: aws.String(productARN),
+					Status:     s,
+					ProductViewSummary: &awsservicecatalog.ProductViewSummary{
+						Id:               aws.String(productViewID),
+						ProductId:        aws.String(productID),
+						Name:             aws.String(productName),
+						Owner:            aws.String(owner),
+						ShortDescription: aws.String(description),
+					},
+				},
+				ProvisioningArtifactSummaries: []awsservicecatalog.ProvisioningArtifactSummary{{Id: aws.String(artifactID)}},
+			}},
+		}
+	}
+}
+
+func listPortfolios(*awsservicecatalog.ListPortfoliosForProductInput) awsservicecatalog.ListPortfoliosForProductRequest {
+	return awsservicecatalog.ListPortfoliosForProductRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.ListPortfoliosForProductOutput{
+			PortfolioDetails: []awsservicecatalog.PortfolioDetail{{Id: aws.String(portfolioID)}},
+		}},
+	}
+}
+
+func listConstraints(*awsservicecatalog.ListConstraintsForPortfolioInput) awsservicecatalog.ListConstraintsForPortfolioRequest {
+	return awsservicecatalog.ListConstraintsForPortfolioRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.ListConstraintsForPortfolioOutput{
+			ConstraintDetails: []awsservicecatalog.ConstraintDetail{{ConstraintId: aws.String(constraintID), Type: aws.String(servicecatalog.ConstraintTypeLaunch)}},
+		}},
+	}
+}
+
+func noConstraints(*awsservicecatalog.ListConstraintsForPortfolioInput) awsservicecatalog.ListConstraintsForPortfolioRequest {
+	return awsservicecatalog.ListConstraintsForPortfolioRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.ListConstraintsForPortfolioOutput{}},
+	}
+}
+
+func describeConstraint(*awsservicecatalog.DescribeConstraintInput) awsservicecatalog.DescribeConstraintRequest {
+	return awsservicecatalog.DescribeConstraintRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.DescribeConstraintOutput{
+			ConstraintParameters: aws.String(`{"RoleArn":"` + roleARN + `"}`),
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockDescribeProductAsAdmin:      describeProduct(awsservicecatalog.StatusAvailable),
+					MockListPortfoliosForProduct:    listPortfolios,
+					MockListConstraintsForPortfolio: listConstraints,
+					MockDescribeConstraint:          describeConstraint,
+				},
+				cr: product(),
+			},
+			want: want{
+				cr: product(withObservation(awsservicecatalog.StatusAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockDescribeProductAsAdmin: describeProduct(awsservicecatalog.StatusCreating),
+				},
+				cr: product(),
+			},
+			want: want{
+				cr: product(withObservation(awsservicecatalog.StatusCreating), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockDescribeProductAsAdmin:      describeProduct(awsservicecatalog.StatusAvailable),
+					MockListPortfoliosForProduct:    listPortfolios,
+					MockListConstraintsForPortfolio: listConstraints,
+					MockDescribeConstraint:          describeConstraint,
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   product(withDescription(nil)),
+			},
+			want: want{
+				cr: product(withObservation(awsservicecatalog.StatusAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PortfoliosNotUpToDate": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockDescribeProductAsAdmin:   describeProduct(awsservicecatalog.StatusAvailable),
+					MockListPortfoliosForProduct: listPortfolios,
+				},
+				cr: product(withPortfolioIDs(portfolioID, portfolioID2)),
+			},
+			want: want{
+				cr: product(withPortfolioIDs(portfolioID, portfolioID2), withObservation(awsservicecatalog.StatusAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"LaunchConstraintNotUpToDate": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockDescribeProductAsAdmin:      describeProduct(awsservicecatalog.StatusAvailable),
+					MockListPortfoliosForProduct:    listPortfolios,
+					MockListConstraintsForPortfolio: listConstraints,
+					MockDescribeConstraint:          describeConstraint,
+				},
+				cr: product(withLaunchRoleARN(aws.String(roleARN2))),
+			},
+			want: want{
+				cr: product(withLaunchRoleARN(aws.String(roleARN2)), withObservation(awsservicecatalog.StatusAvailable), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockDescribeProductAsAdmin: func(*awsservicecatalog.DescribeProductAsAdminInput) awsservicecatalog.DescribeProductAsAdminRequest {
+						return awsservicecatalog.DescribeProductAsAdminRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsservicecatalog.ErrCodeResourceNotFoundException, "", nil), Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: product(),
+			},
+			want: want{
+				cr: product(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockDescribeProductAsAdmin: func(*awsservicecatalog.DescribeProductAsAdminInput) awsservicecatalog.DescribeProductAsAdminRequest {
+						return awsservicecatalog.DescribeProductAsAdminRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: product(),
+			},
+			want: want{
+				cr:  product(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockCreateProduct: func(input *awsservicecatalog.CreateProductInput) awsservicecatalog.CreateProductRequest {
+						if diff := cmp.Diff("https://templates.s3.amazonaws.com/website.yaml", input.ProvisioningArtifactParameters.Info["LoadTemplateFromURL"]); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsservicecatalog.CreateProductRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.CreateProductOutput{
+								ProductViewDetail: &awsservicecatalog.ProductViewDetail{
+									ProductViewSummary: &awsservicecatalog.ProductViewSummary{ProductId: aws.String(productID)},
+								},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   product(withExternalName("")),
+			},
+			want: want{
+				cr: product(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockCreateProduct: func(*awsservicecatalog.CreateProductInput) awsservicecatalog.CreateProductRequest {
+						return awsservicecatalog.CreateProductRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: product(withExternalName("")),
+			},
+			want: want{
+				cr:  product(withExternalName(""), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AssociatePortfolio": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockDescribeProductAsAdmin:   describeProduct(awsservicecatalog.StatusAvailable),
+					MockListPortfoliosForProduct: listPortfolios,
+					MockAssociateProductWithPortfolio: func(input *awsservicecatalog.AssociateProductWithPortfolioInput) awsservicecatalog.AssociateProductWithPortfolioRequest {
+						if diff := cmp.Diff(aws.String(portfolioID2), input.PortfolioId); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsservicecatalog.AssociateProductWithPortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.AssociateProductWithPortfolioOutput{}},
+						}
+					},
+					MockListConstraintsForPortfolio: func(input *awsservicecatalog.ListConstraintsForPortfolioInput) awsservicecatalog.ListConstraintsForPortfolioRequest {
+						if aws.StringValue(input.PortfolioId) == portfolioID2 {
+							return noConstraints(input)
+						}
+						return listConstraints(input)
+					},
+					MockDescribeConstraint: describeConstraint,
+					MockCreateConstraint: func(input *awsservicecatalog.CreateConstraintInput) awsservicecatalog.CreateConstraintRequest {
+						if diff := cmp.Diff(aws.String(portfolioID2), input.PortfolioId); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(aws.String(`{"RoleArn":"`+roleARN+`"}`), input.Parameters); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsservicecatalog.CreateConstraintRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.CreateConstraintOutput{}},
+						}
+					},
+				},
+				cr: product(withPortfolioIDs(portfolioID, portfolioID2)),
+			},
+			want: want{
+				cr: product(withPortfolioIDs(portfolioID, portfolioID2)),
+			},
+		},
+		"DisassociatePortfolio": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockDescribeProductAsAdmin:      describeProduct(awsservicecatalog.StatusAvailable),
+					MockListPortfoliosForProduct:    listPortfolios,
+					MockListConstraintsForPortfolio: listConstraints,
+					MockDeleteConstraint: func(input *awsservicecatalog.DeleteConstraintInput) awsservicecatalog.DeleteConstraintRequest {
+						if diff := cmp.Diff(aws.String(constraintID), input.Id); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsservicecatalog.DeleteConstraintRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.DeleteConstraintOutput{}},
+						}
+					},
+					MockDisassociateProductFromPortfolio: func(input *awsservicecatalog.DisassociateProductFromPortfolioInput) awsservicecatalog.DisassociateProductFromPortfolioRequest {
+						if diff := cmp.Diff(aws.String(portfolioID), input.PortfolioId); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsservicecatalog.DisassociateProductFromPortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.DisassociateProductFromPortfolioOutput{}},
+						}
+					},
+				},
+				cr: product(withPortfolioIDs()),
+			},
+			want: want{
+				cr: product(withPortfolioIDs()),
+			},
+		},
+		"UpdateLaunchConstraint": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockDescribeProductAsAdmin:      describeProduct(awsservicecatalog.StatusAvailable),
+					MockListPortfoliosForProduct:    listPortfolios,
+					MockListConstraintsForPortfolio: listConstraints,
+					MockDescribeConstraint:          describeConstraint,
+					MockUpdateConstraint: func(input *awsservicecatalog.UpdateConstraintInput) awsservicecatalog.UpdateConstraintRequest {
+						if diff := cmp.Diff(aws.String(`{"RoleArn":"`+roleARN2+`"}`), input.Parameters); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsservicecatalog.UpdateConstraintRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.UpdateConstraintOutput{}},
+						}
+					},
+				},
+				cr: product(withLaunchRoleARN(aws.String(roleARN2))),
+			},
+			want: want{
+				cr: product(withLaunchRoleARN(aws.String(roleARN2))),
+			},
+		},
+		"UpdateAttributes": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockDescribeProductAsAdmin: describeProduct(awsservicecatalog.StatusAvailable),
+					MockUpdateProduct: func(input *awsservicecatalog.UpdateProductInput) awsservicecatalog.UpdateProductRequest {
+						if diff := cmp.Diff(aws.String("new"), input.Description); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsservicecatalog.UpdateProductRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.UpdateProductOutput{}},
+						}
+					},
+					MockListPortfoliosForProduct:    listPortfolios,
+					MockListConstraintsForPortfolio: listConstraints,
+					MockDescribeConstraint:          describeConstraint,
+				},
+				cr: product(withDescription(aws.String("new"))),
+			},
+			want: want{
+				cr: product(withDescription(aws.String("new"))),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockDescribeProductAsAdmin:   describeProduct(awsservicecatalog.StatusAvailable),
+					MockListPortfoliosForProduct: listPortfolios,
+					MockAssociateProductWithPortfolio: func(*awsservicecatalog.AssociateProductWithPortfolioInput) awsservicecatalog.AssociateProductWithPortfolioRequest {
+						return awsservicecatalog.AssociateProductWithPortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: product(withPortfolioIDs(portfolioID, portfolioID2)),
+			},
+			want: want{
+				cr:  product(withPortfolioIDs(portfolioID, portfolioID2)),
+				err: errors.Wrap(errBoom, errAssociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockListPortfoliosForProduct:    listPortfolios,
+					MockListConstraintsForPortfolio: listConstraints,
+					MockDeleteConstraint: func(*awsservicecatalog.DeleteConstraintInput) awsservicecatalog.DeleteConstraintRequest {
+						return awsservicecatalog.DeleteConstraintRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.DeleteConstraintOutput{}},
+						}
+					},
+					MockDisassociateProductFromPortfolio: func(*awsservicecatalog.DisassociateProductFromPortfolioInput) awsservicecatalog.DisassociateProductFromPortfolioRequest {
+						return awsservicecatalog.DisassociateProductFromPortfolioRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.DisassociateProductFromPortfolioOutput{}},
+						}
+					},
+					MockDeleteProduct: func(*awsservicecatalog.DeleteProductInput) awsservicecatalog.DeleteProductRequest {
+						return awsservicecatalog.DeleteProductRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.DeleteProductOutput{}},
+						}
+					},
+				},
+				cr: product(),
+			},
+			want: want{
+				cr: product(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				client: &fake.MockProductClient{
+					MockListPortfoliosForProduct: func(*awsservicecatalog.ListPortfoliosForProductInput) awsservicecatalog.ListPortfoliosForProductRequest {
+						return awsservicecatalog.ListPortfoliosForProductRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicecatalog.ListPortfoliosForProductOutput{}},
+						}
+					},
+					MockDeleteProduct: func(*awsservicecatalog.DeleteProductInput) awsservicecatalog.DeleteProductRequest {
+						return awsservicecatalog.DeleteProductRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: product(),
+			},
+			want: want{
+				cr:  product(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
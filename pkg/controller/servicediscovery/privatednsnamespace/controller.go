@@ -0,0 +1,159 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privatednsnamespace
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssd "github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/servicediscovery"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a PrivateDNSNamespace resource"
+
+	errFind               = "failed to find the PrivateDNSNamespace resource"
+	errGet                = "failed to get the PrivateDNSNamespace resource"
+	errCreate             = "failed to create the PrivateDNSNamespace resource"
+	errDelete             = "failed to delete the PrivateDNSNamespace resource"
+	errExternalNameUpdate = "cannot update the external name of the PrivateDNSNamespace custom resource"
+)
+
+// SetupPrivateDNSNamespace adds a controller that reconciles
+// PrivateDNSNamespaces.
+func SetupPrivateDNSNamespace(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.PrivateDNSNamespaceGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.PrivateDNSNamespace{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PrivateDNSNamespaceGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: servicediscovery.NewNamespaceClient}, awsclients.DeletionTierNetwork), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) servicediscovery.NamespaceClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.PrivateDNSNamespace)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client servicediscovery.NamespaceClient
+}
+
+// Observe observes the namespace by its ID. Until the ID is known, it finds
+// the namespace that was created for the PrivateDNSNamespace and records its
+// ID as the external name.
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.PrivateDNSNamespace)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	var ns *awssd.Namespace
+	if id := meta.GetExternalName(cr); id != "" {
+		rsp, err := e.client.GetNamespaceRequest(&awssd.GetNamespaceInput{Id: aws.String(id)}).Send(ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(servicediscovery.IsNamespaceNotFound, err), errGet)
+		}
+		ns = rsp.Namespace
+	} else {
+		found, err := servicediscovery.FindNamespace(ctx, e.client, awssd.NamespaceTypeDnsPrivate, cr.Spec.ForProvider.Name, string(cr.GetUID()))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errFind)
+		}
+		if found == nil {
+			return managed.ExternalObservation{}, nil
+		}
+		meta.SetExternalName(cr, aws.StringValue(found.Id))
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errExternalNameUpdate)
+		}
+		ns = found
+	}
+
+	cr.Status.AtProvider = servicediscovery.GeneratePrivateDNSNamespaceObservation(*ns)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	// Namespaces can't be updated.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// Create starts the creation of the namespace. A request that is already in
+// progress is not an error, since the namespace is only found once its
+// creation has finished.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.PrivateDNSNamespace)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreatePrivateDnsNamespaceRequest(servicediscovery.GenerateCreatePrivateDNSNamespaceInput(string(cr.GetUID()), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(resource.Ignore(servicediscovery.IsDuplicateRequest, err), errCreate)
+}
+
+// Update is a no-op, since namespaces can't be updated.
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the namespace. Cloud Map refuses to delete a namespace that
+// still contains services.
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.PrivateDNSNamespace)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteNamespaceRequest(&awssd.DeleteNamespaceInput{Id: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	return errors.Wrap(resource.Ignore(servicediscovery.IsDuplicateRequest, resource.Ignore(servicediscovery.IsNamespaceNotFound, err)), errDelete)
+}
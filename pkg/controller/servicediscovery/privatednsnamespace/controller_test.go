@@ -0,0 +1,403 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privatednsnamespace
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awssd "github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/servicediscovery"
+	"github.com/crossplane/provider-aws/pkg/clients/servicediscovery/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	nsID   = "ns-abcdefghijklmnop"
+	nsName = "example.local"
+	nsARN  = "arn:aws:servicediscovery:us-east-1:123456789012:namespace/ns-abcdefghijklmnop"
+	nsUID  = types.UID("some-uid")
+	zoneID = "Z0123456789"
+	vpcID  = "vpc-1"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	sd   servicediscovery.NamespaceClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type namespaceModifier func(*v1alpha1.PrivateDNSNamespace)
+
+func withConditions(c ...runtimev1alpha1.Condition) namespaceModifier {
+	return func(r *v1alpha1.PrivateDNSNamespace) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) namespaceModifier {
+	return func(r *v1alpha1.PrivateDNSNamespace) { meta.SetExternalName(r, n) }
+}
+
+func withObservation() namespaceModifier {
+	return func(r *v1alpha1.PrivateDNSNamespace) {
+		r.Status.AtProvider = v1alpha1.PrivateDNSNamespaceObservation{ARN: nsARN, HostedZoneID: zoneID}
+	}
+}
+
+func namespace(m ...namespaceModifier) *v1alpha1.PrivateDNSNamespace {
+	cr := &v1alpha1.PrivateDNSNamespace{
+		Spec: v1alpha1.PrivateDNSNamespaceSpec{
+			ForProvider: v1alpha1.PrivateDNSNamespaceParameters{Name: nsName, VPC: aws.String(vpcID)},
+		},
+	}
+	cr.SetUID(nsUID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(creatorRequestID string) *awssd.Namespace {
+	return &awssd.Namespace{
+		Id:               aws.String(nsID),
+		Arn:              aws.String(nsARN),
+		Name:             aws.String(nsName),
+		CreatorRequestId: aws.String(creatorRequestID),
+		Type:             awssd.NamespaceTypeDnsPrivate,
+		Properties:       &awssd.NamespaceProperties{DnsProperties: &awssd.DnsProperties{HostedZoneId: aws.String(zoneID)}},
+	}
+}
+
+func get(creatorRequestID string) func(*awssd.GetNamespaceInput) awssd.GetNamespaceRequest {
+	return func(input *awssd.GetNamespaceInput) awssd.GetNamespaceRequest {
+		return awssd.GetNamespaceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssd.GetNamespaceOutput{
+				Namespace: observed(creatorRequestID),
+			}},
+		}
+	}
+}
+
+func list(names ...string) func(*awssd.ListNamespacesInput) awssd.ListNamespacesRequest {
+	return func(input *awssd.ListNamespacesInput) awssd.ListNamespacesRequest {
+		out := &awssd.ListNamespacesOutput{}
+		for _, n := range names {
+			out.Namespaces = append(out.Namespaces, awssd.NamespaceSummary{Id: aws.String(nsID), Name: aws.String(n)})
+		}
+		return awssd.ListNamespacesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				sd: &fake.MockNamespaceClient{MockGetNamespace: get(string(nsUID))},
+				cr: namespace(withExternalName(nsID)),
+			},
+			want: want{
+				cr: namespace(withExternalName(nsID), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Found": {
+			args: args{
+				sd: &fake.MockNamespaceClient{
+					MockListNamespaces: list("other.local", nsName),
+					MockGetNamespace:   get(string(nsUID)),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   namespace(),
+			},
+			want: want{
+				cr: namespace(withExternalName(nsID), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotCreatedYet": {
+			args: args{
+				sd: &fake.MockNamespaceClient{
+					MockListNamespaces: list(nsName),
+					MockGetNamespace:   get("other-uid"),
+				},
+				cr: namespace(),
+			},
+			want: want{
+				cr: namespace(),
+			},
+		},
+		"ExternalNameUpdateError": {
+			args: args{
+				sd: &fake.MockNamespaceClient{
+					MockListNamespaces: list(nsName),
+					MockGetNamespace:   get(string(nsUID)),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   namespace(),
+			},
+			want: want{
+				cr:  namespace(withExternalName(nsID)),
+				err: errors.Wrap(errBoom, errExternalNameUpdate),
+			},
+		},
+		"NotFound": {
+			args: args{
+				sd: &fake.MockNamespaceClient{
+					MockGetNamespace: func(*awssd.GetNamespaceInput) awssd.GetNamespaceRequest {
+						return awssd.GetNamespaceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awssd.ErrCodeNamespaceNotFound, "", nil)},
+						}
+					},
+				},
+				cr: namespace(withExternalName(nsID)),
+			},
+			want: want{
+				cr: namespace(withExternalName(nsID)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sd: &fake.MockNamespaceClient{
+					MockListNamespaces: func(*awssd.ListNamespacesInput) awssd.ListNamespacesRequest {
+						return awssd.ListNamespacesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: namespace(),
+			},
+			want: want{
+				cr:  namespace(),
+				err: errors.Wrap(errBoom, errFind),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sd, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				sd: &fake.MockNamespaceClient{
+					MockCreatePrivateDnsNamespace: func(input *awssd.CreatePrivateDnsNamespaceInput) awssd.CreatePrivateDnsNamespaceRequest {
+						if diff := cmp.Diff(string(nsUID), aws.StringValue(input.CreatorRequestId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(vpcID, aws.StringValue(input.Vpc)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssd.CreatePrivateDnsNamespaceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssd.CreatePrivateDnsNamespaceOutput{}},
+						}
+					},
+				},
+				cr: namespace(),
+			},
+			want: want{
+				cr: namespace(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InProgress": {
+			args: args{
+				sd: &fake.MockNamespaceClient{
+					MockCreatePrivateDnsNamespace: func(*awssd.CreatePrivateDnsNamespaceInput) awssd.CreatePrivateDnsNamespaceRequest {
+						return awssd.CreatePrivateDnsNamespaceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awssd.ErrCodeDuplicateRequest, "", nil)},
+						}
+					},
+				},
+				cr: namespace(),
+			},
+			want: want{
+				cr: namespace(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sd: &fake.MockNamespaceClient{
+					MockCreatePrivateDnsNamespace: func(*awssd.CreatePrivateDnsNamespaceInput) awssd.CreatePrivateDnsNamespaceRequest {
+						return awssd.CreatePrivateDnsNamespaceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: namespace(),
+			},
+			want: want{
+				cr:  namespace(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sd, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				sd: &fake.MockNamespaceClient{
+					MockDeleteNamespace: func(input *awssd.DeleteNamespaceInput) awssd.DeleteNamespaceRequest {
+						if diff := cmp.Diff(nsID, aws.StringValue(input.Id)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssd.DeleteNamespaceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssd.DeleteNamespaceOutput{}},
+						}
+					},
+				},
+				cr: namespace(withExternalName(nsID)),
+			},
+			want: want{
+				cr: namespace(withExternalName(nsID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				sd: &fake.MockNamespaceClient{
+					MockDeleteNamespace: func(*awssd.DeleteNamespaceInput) awssd.DeleteNamespaceRequest {
+						return awssd.DeleteNamespaceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awssd.ErrCodeNamespaceNotFound, "", nil)},
+						}
+					},
+				},
+				cr: namespace(withExternalName(nsID)),
+			},
+			want: want{
+				cr: namespace(withExternalName(nsID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sd: &fake.MockNamespaceClient{
+					MockDeleteNamespace: func(*awssd.DeleteNamespaceInput) awssd.DeleteNamespaceRequest {
+						return awssd.DeleteNamespaceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: namespace(withExternalName(nsID)),
+			},
+			want: want{
+				cr:  namespace(withExternalName(nsID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sd, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
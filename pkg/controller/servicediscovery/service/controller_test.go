@@ -0,0 +1,446 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awssd "github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/servicediscovery"
+	"github.com/crossplane/provider-aws/pkg/clients/servicediscovery/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	serviceID   = "srv-abcdefghijklmnop"
+	serviceName = "backend"
+	serviceARN  = "arn:aws:servicediscovery:us-east-1:123456789012:service/srv-abcdefghijklmnop"
+	serviceUID  = types.UID("some-uid")
+	nsID        = "ns-abcdefghijklmnop"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	sd   servicediscovery.ServiceClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type serviceModifier func(*v1alpha1.Service)
+
+func withConditions(c ...runtimev1alpha1.Condition) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) serviceModifier {
+	return func(r *v1alpha1.Service) { meta.SetExternalName(r, n) }
+}
+
+func withObservation() serviceModifier {
+	return func(r *v1alpha1.Service) {
+		r.Status.AtProvider = v1alpha1.ServiceObservation{ARN: serviceARN}
+	}
+}
+
+func withTTL(ttl int64) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Spec.ForProvider.DNSConfig.DNSRecords[0].TTL = ttl }
+}
+
+func withRoutingPolicy(p string) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Spec.ForProvider.DNSConfig.RoutingPolicy = aws.String(p) }
+}
+
+func service(m ...serviceModifier) *v1alpha1.Service {
+	cr := &v1alpha1.Service{
+		Spec: v1alpha1.ServiceSpec{
+			ForProvider: v1alpha1.ServiceParameters{
+				Name:        serviceName,
+				NamespaceID: aws.String(nsID),
+				DNSConfig: &v1alpha1.DNSConfig{
+					DNSRecords: []v1alpha1.DNSRecord{{Type: "A", TTL: 60}},
+				},
+			},
+		},
+	}
+	cr.SetUID(serviceUID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func get(*awssd.GetServiceInput) awssd.GetServiceRequest {
+	return awssd.GetServiceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssd.GetServiceOutput{
+			Service: &awssd.Service{
+				Id:          aws.String(serviceID),
+				Arn:         aws.String(serviceARN),
+				Name:        aws.String(serviceName),
+				NamespaceId: aws.String(nsID),
+				DnsConfig: &awssd.DnsConfig{
+					RoutingPolicy: awssd.RoutingPolicyMultivalue,
+					DnsRecords:    []awssd.DnsRecord{{Type: awssd.RecordTypeA, TTL: aws.Int64(60)}},
+				},
+			},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				sd:   &fake.MockServiceClient{MockGetService: get},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withRoutingPolicy("MULTIVALUE"), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RecordsChanged": {
+			args: args{
+				sd: &fake.MockServiceClient{MockGetService: get},
+				cr: service(withExternalName(serviceID), withRoutingPolicy("MULTIVALUE"), withTTL(300)),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withRoutingPolicy("MULTIVALUE"), withTTL(300), withObservation(), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotCreated": {
+			args: args{
+				sd: &fake.MockServiceClient{},
+				cr: service(),
+			},
+			want: want{
+				cr: service(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				sd: &fake.MockServiceClient{
+					MockGetService: func(*awssd.GetServiceInput) awssd.GetServiceRequest {
+						return awssd.GetServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awssd.ErrCodeServiceNotFound, "", nil)},
+						}
+					},
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sd: &fake.MockServiceClient{
+					MockGetService: func(*awssd.GetServiceInput) awssd.GetServiceRequest {
+						return awssd.GetServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr:  service(withExternalName(serviceID)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sd, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				sd: &fake.MockServiceClient{
+					MockCreateService: func(input *awssd.CreateServiceInput) awssd.CreateServiceRequest {
+						if diff := cmp.Diff(string(serviceUID), aws.StringValue(input.CreatorRequestId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(nsID, aws.StringValue(input.NamespaceId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssd.CreateServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssd.CreateServiceOutput{
+								Service: &awssd.Service{Id: aws.String(serviceID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   service(),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"SpecUpdateError": {
+			args: args{
+				sd: &fake.MockServiceClient{
+					MockCreateService: func(input *awssd.CreateServiceInput) awssd.CreateServiceRequest {
+						return awssd.CreateServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssd.CreateServiceOutput{
+								Service: &awssd.Service{Id: aws.String(serviceID)},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   service(),
+			},
+			want: want{
+				cr:  service(withExternalName(serviceID), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sd: &fake.MockServiceClient{
+					MockCreateService: func(*awssd.CreateServiceInput) awssd.CreateServiceRequest {
+						return awssd.CreateServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: service(),
+			},
+			want: want{
+				cr:  service(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sd, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ChangeRecords": {
+			args: args{
+				sd: &fake.MockServiceClient{
+					MockUpdateService: func(input *awssd.UpdateServiceInput) awssd.UpdateServiceRequest {
+						if diff := cmp.Diff(int64(300), aws.Int64Value(input.Service.DnsConfig.DnsRecords[0].TTL)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssd.UpdateServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssd.UpdateServiceOutput{}},
+						}
+					},
+				},
+				cr: service(withExternalName(serviceID), withTTL(300)),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withTTL(300)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sd: &fake.MockServiceClient{
+					MockUpdateService: func(*awssd.UpdateServiceInput) awssd.UpdateServiceRequest {
+						return awssd.UpdateServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: service(withExternalName(serviceID), withTTL(300)),
+			},
+			want: want{
+				cr:  service(withExternalName(serviceID), withTTL(300)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sd, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				sd: &fake.MockServiceClient{
+					MockDeleteService: func(input *awssd.DeleteServiceInput) awssd.DeleteServiceRequest {
+						if diff := cmp.Diff(serviceID, aws.StringValue(input.Id)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssd.DeleteServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssd.DeleteServiceOutput{}},
+						}
+					},
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				sd: &fake.MockServiceClient{
+					MockDeleteService: func(*awssd.DeleteServiceInput) awssd.DeleteServiceRequest {
+						return awssd.DeleteServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{},
+								Error: awserr.New(awssd.ErrCodeServiceNotFound, "", nil)},
+						}
+					},
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr: service(withExternalName(serviceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sd: &fake.MockServiceClient{
+					MockDeleteService: func(*awssd.DeleteServiceInput) awssd.DeleteServiceRequest {
+						return awssd.DeleteServiceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: service(withExternalName(serviceID)),
+			},
+			want: want{
+				cr:  service(withExternalName(serviceID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sd, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
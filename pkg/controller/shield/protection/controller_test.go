@@ -0,0 +1,309 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protection
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsshield "github.com/aws/aws-sdk-go-v2/service/shield"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/shield/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/shield"
+	"github.com/crossplane/provider-aws/pkg/clients/shield/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	protectionName = "public-alb"
+	protectionID   = "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+	resourceARN    = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/public-alb/50dc6c495c0c9188"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsshield.ErrCodeResourceNotFoundException, "", nil)
+)
+
+type args struct {
+	shield shield.ProtectionClient
+	kube   *test.MockClient
+	cr     resource.Managed
+}
+
+type protectionModifier func(*v1alpha1.Protection)
+
+func withConditions(c ...runtimev1alpha1.Condition) protectionModifier {
+	return func(r *v1alpha1.Protection) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) protectionModifier {
+	return func(r *v1alpha1.Protection) { meta.SetExternalName(r, n) }
+}
+
+func protection(m ...protectionModifier) *v1alpha1.Protection {
+	cr := &v1alpha1.Protection{
+		ObjectMeta: metav1.ObjectMeta{Name: protectionName},
+		Spec: v1alpha1.ProtectionSpec{
+			ForProvider: v1alpha1.ProtectionParameters{
+				ResourceARN: aws.String(resourceARN),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeProtection(err error) func(*awsshield.DescribeProtectionInput) awsshield.DescribeProtectionRequest {
+	return func(*awsshield.DescribeProtectionInput) awsshield.DescribeProtectionRequest {
+		return awsshield.DescribeProtectionRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsshield.DescribeProtectionOutput{
+				Protection: &awsshield.Protection{
+					Id:          aws.String(protectionID),
+					Name:        aws.String(protectionName),
+					ResourceArn: aws.String(resourceARN),
+				},
+			}, Error: err},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Exists": {
+			args: args{
+				shield: &fake.MockProtectionClient{MockDescribeProtection: describeProtection(nil)},
+				cr:     protection(withExternalName(protectionID)),
+			},
+			want: want{
+				cr:     protection(withExternalName(protectionID), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: protection(),
+			},
+			want: want{
+				cr: protection(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				shield: &fake.MockProtectionClient{MockDescribeProtection: describeProtection(errNotFound)},
+				cr:     protection(withExternalName(protectionID)),
+			},
+			want: want{
+				cr: protection(withExternalName(protectionID)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				shield: &fake.MockProtectionClient{MockDescribeProtection: describeProtection(errBoom)},
+				cr:     protection(withExternalName(protectionID)),
+			},
+			want: want{
+				cr:  protection(withExternalName(protectionID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.shield}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				shield: &fake.MockProtectionClient{
+					MockCreateProtection: func(in *awsshield.CreateProtectionInput) awsshield.CreateProtectionRequest {
+						if diff := cmp.Diff(&awsshield.CreateProtectionInput{
+							Name:        aws.String(protectionName),
+							ResourceArn: aws.String(resourceARN),
+						}, in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsshield.CreateProtectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsshield.CreateProtectionOutput{
+								ProtectionId: aws.String(protectionID),
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   protection(),
+			},
+			want: want{
+				cr: protection(withExternalName(protectionID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				shield: &fake.MockProtectionClient{
+					MockCreateProtection: func(*awsshield.CreateProtectionInput) awsshield.CreateProtectionRequest {
+						return awsshield.CreateProtectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: protection(),
+			},
+			want: want{
+				cr:  protection(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.shield}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteProtection := func(err error) func(*awsshield.DeleteProtectionInput) awsshield.DeleteProtectionRequest {
+		return func(*awsshield.DeleteProtectionInput) awsshield.DeleteProtectionRequest {
+			return awsshield.DeleteProtectionRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsshield.DeleteProtectionOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				shield: &fake.MockProtectionClient{MockDeleteProtection: deleteProtection(nil)},
+				cr:     protection(withExternalName(protectionID)),
+			},
+			want: want{
+				cr: protection(withExternalName(protectionID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				shield: &fake.MockProtectionClient{MockDeleteProtection: deleteProtection(errNotFound)},
+				cr:     protection(withExternalName(protectionID)),
+			},
+			want: want{
+				cr: protection(withExternalName(protectionID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				shield: &fake.MockProtectionClient{MockDeleteProtection: deleteProtection(errBoom)},
+				cr:     protection(withExternalName(protectionID)),
+			},
+			want: want{
+				cr:  protection(withExternalName(protectionID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.shield}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
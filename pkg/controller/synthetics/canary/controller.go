@@ -0,0 +1,202 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package canary
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssynthetics "github.com/aws/aws-sdk-go-v2/service/synthetics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/synthetics/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/synthetics"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Canary resource"
+
+	errDescribe   = "failed to describe the Canary resource"
+	errCreate     = "failed to create the Canary resource"
+	errUpdate     = "failed to update the Canary resource"
+	errStart      = "failed to start the Canary resource"
+	errStop       = "failed to stop the Canary resource"
+	errDelete     = "failed to delete the Canary resource"
+	errSpecUpdate = "cannot update spec of the Canary custom resource"
+)
+
+// SetupCanary adds a controller that reconciles Canaries.
+func SetupCanary(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.CanaryGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Canary{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CanaryGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(awsclients.WithDeleteThrottle(&connector{kube: mgr.GetClient(), newClientFn: synthetics.NewCanaryClient}, awsclients.DeletionTierWorkload), v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) synthetics.CanaryClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Canary)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client synthetics.CanaryClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Canary)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.GetCanaryRequest(&awssynthetics.GetCanaryInput{
+		Name: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(synthetics.IsNotFound, err), errDescribe)
+	}
+	observed := *rsp.Canary
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	synthetics.LateInitializeCanary(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = synthetics.GenerateCanaryObservation(observed)
+	switch awssynthetics.CanaryState(cr.Status.AtProvider.State) {
+	case awssynthetics.CanaryStateCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awssynthetics.CanaryStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	case awssynthetics.CanaryStateError:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	default:
+		cr.SetConditions(runtimev1alpha1.Available())
+	}
+
+	upToDate := inTransition(cr.Status.AtProvider.State) ||
+		(synthetics.IsCanaryUpToDate(cr.Spec.ForProvider, observed) && synthetics.IsCanaryRunStateUpToDate(cr.Spec.ForProvider, observed))
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+// inTransition returns whether a canary in the given state is in transition.
+// AWS rejects changes to such canaries until the transition has finished.
+func inTransition(state string) bool {
+	switch awssynthetics.CanaryState(state) {
+	case awssynthetics.CanaryStateCreating, awssynthetics.CanaryStateUpdating,
+		awssynthetics.CanaryStateStarting, awssynthetics.CanaryStateStopping,
+		awssynthetics.CanaryStateDeleting:
+		return true
+	}
+	return false
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Canary)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateCanaryRequest(synthetics.GenerateCreateCanaryInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Canary)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	name := aws.String(meta.GetExternalName(cr))
+	rsp, err := e.client.GetCanaryRequest(&awssynthetics.GetCanaryInput{Name: name}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	observed := *rsp.Canary
+
+	// The canary is in transition after each of these calls, so the next
+	// one is left to a later reconcile.
+	switch {
+	case !synthetics.IsCanaryUpToDate(cr.Spec.ForProvider, observed):
+		_, err = e.client.UpdateCanaryRequest(synthetics.GenerateUpdateCanaryInput(*name, cr.Spec.ForProvider)).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	case synthetics.ShouldStart(cr.Spec.ForProvider, observed):
+		_, err = e.client.StartCanaryRequest(&awssynthetics.StartCanaryInput{Name: name}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errStart)
+	case synthetics.ShouldStop(cr.Spec.ForProvider, observed):
+		_, err = e.client.StopCanaryRequest(&awssynthetics.StopCanaryInput{Name: name}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errStop)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Canary)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	name := aws.String(meta.GetExternalName(cr))
+
+	// A canary has to be stopped before it can be deleted.
+	switch awssynthetics.CanaryState(cr.Status.AtProvider.State) {
+	case awssynthetics.CanaryStateRunning:
+		_, err := e.client.StopCanaryRequest(&awssynthetics.StopCanaryInput{Name: name}).Send(ctx)
+		return errors.Wrap(resource.Ignore(synthetics.IsNotFound, err), errStop)
+	case awssynthetics.CanaryStateStopping, awssynthetics.CanaryStateDeleting:
+		return nil
+	}
+	_, err := e.client.DeleteCanaryRequest(&awssynthetics.DeleteCanaryInput{Name: name}).Send(ctx)
+	return errors.Wrap(resource.Ignore(synthetics.IsNotFound, err), errDelete)
+}
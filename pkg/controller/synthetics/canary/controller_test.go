@@ -0,0 +1,472 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package canary
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awssynthetics "github.com/aws/aws-sdk-go-v2/service/synthetics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/synthetics/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/synthetics"
+	"github.com/crossplane/provider-aws/pkg/clients/synthetics/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	canaryName     = "web"
+	canaryID       = "0123-4567"
+	handler        = "pageLoadBlueprint.handler"
+	roleARN        = "arn:aws:iam::123456789012:role/canary"
+	runtimeVersion = "syn-nodejs-2.0"
+	rate           = "rate(5 minutes)"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	synthetics synthetics.CanaryClient
+	kube       *test.MockClient
+	cr         resource.Managed
+}
+
+type canaryModifier func(*v1alpha1.Canary)
+
+func withConditions(c ...runtimev1alpha1.Condition) canaryModifier {
+	return func(r *v1alpha1.Canary) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withState(s awssynthetics.CanaryState) canaryModifier {
+	return func(r *v1alpha1.Canary) {
+		r.Status.AtProvider.ID = canaryID
+		r.Status.AtProvider.State = string(s)
+	}
+}
+
+func withSchedule(e string) canaryModifier {
+	return func(r *v1alpha1.Canary) { r.Spec.ForProvider.Schedule.Expression = e }
+}
+
+func canary(m ...canaryModifier) *v1alpha1.Canary {
+	cr := &v1alpha1.Canary{
+		Spec: v1alpha1.CanarySpec{
+			ForProvider: v1alpha1.CanaryParameters{
+				ArtifactS3Location: aws.String("s3://results/web"),
+				Code:               v1alpha1.CanaryCode{Handler: handler, ZipFile: []byte("zip")},
+				ExecutionRoleARN:   aws.String(roleARN),
+				RuntimeVersion:     runtimeVersion,
+				Schedule:           v1alpha1.CanarySchedule{Expression: rate, DurationInSeconds: aws.Int64(0)},
+				RunConfig:          &v1alpha1.CanaryRunConfig{TimeoutInSeconds: 60, MemoryInMB: aws.Int64(960)},
+
+				FailureRetentionPeriodInDays: aws.Int64(31),
+				SuccessRetentionPeriodInDays: aws.Int64(31),
+			},
+		},
+	}
+	meta.SetExternalName(cr, canaryName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getCanary(s awssynthetics.CanaryState) func(*awssynthetics.GetCanaryInput) awssynthetics.GetCanaryRequest {
+	return func(*awssynthetics.GetCanaryInput) awssynthetics.GetCanaryRequest {
+		return awssynthetics.GetCanaryRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssynthetics.GetCanaryOutput{
+				Canary: &awssynthetics.Canary{
+					Id:               aws.String(canaryID),
+					Name:             aws.String(canaryName),
+					Code:             &awssynthetics.CanaryCodeOutput{Handler: aws.String(handler)},
+					ExecutionRoleArn: aws.String(roleARN),
+					RuntimeVersion:   aws.String(runtimeVersion),
+					Schedule:         &awssynthetics.CanaryScheduleOutput{Expression: aws.String(rate), DurationInSeconds: aws.Int64(0)},
+					RunConfig:        &awssynthetics.CanaryRunConfigOutput{TimeoutInSeconds: aws.Int64(60), MemoryInMB: aws.Int64(960)},
+					Status:           &awssynthetics.CanaryStatus{State: s},
+
+					FailureRetentionPeriodInDays: aws.Int64(31),
+					SuccessRetentionPeriodInDays: aws.Int64(31),
+				},
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Running": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{MockGetCanary: getCanary(awssynthetics.CanaryStateRunning)},
+				cr:         canary(),
+			},
+			want: want{
+				cr:     canary(withState(awssynthetics.CanaryStateRunning), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ReadyToStart": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{MockGetCanary: getCanary(awssynthetics.CanaryStateReady)},
+				cr:         canary(),
+			},
+			want: want{
+				cr:     canary(withState(awssynthetics.CanaryStateReady), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ScheduleChanged": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{MockGetCanary: getCanary(awssynthetics.CanaryStateRunning)},
+				cr:         canary(withSchedule("rate(1 hour)")),
+			},
+			want: want{
+				cr:     canary(withSchedule("rate(1 hour)"), withState(awssynthetics.CanaryStateRunning), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"Creating": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{MockGetCanary: getCanary(awssynthetics.CanaryStateCreating)},
+				cr:         canary(withSchedule("rate(1 hour)")),
+			},
+			want: want{
+				cr:     canary(withSchedule("rate(1 hour)"), withState(awssynthetics.CanaryStateCreating), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{MockGetCanary: getCanary(awssynthetics.CanaryStateRunning)},
+				kube:       &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr: canary(func(r *v1alpha1.Canary) {
+					r.Spec.ForProvider.RunConfig = nil
+				}),
+			},
+			want: want{
+				cr:  canary(),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"NotFound": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{
+					MockGetCanary: func(*awssynthetics.GetCanaryInput) awssynthetics.GetCanaryRequest {
+						return awssynthetics.GetCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awssynthetics.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: canary(),
+			},
+			want: want{
+				cr: canary(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{
+					MockGetCanary: func(*awssynthetics.GetCanaryInput) awssynthetics.GetCanaryRequest {
+						return awssynthetics.GetCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: canary(),
+			},
+			want: want{
+				cr:  canary(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.synthetics}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{
+					MockCreateCanary: func(in *awssynthetics.CreateCanaryInput) awssynthetics.CreateCanaryRequest {
+						if diff := cmp.Diff(canaryName, aws.StringValue(in.Name)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssynthetics.CreateCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssynthetics.CreateCanaryOutput{}},
+						}
+					},
+				},
+				cr: canary(),
+			},
+			want: want{
+				cr: canary(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{
+					MockCreateCanary: func(*awssynthetics.CreateCanaryInput) awssynthetics.CreateCanaryRequest {
+						return awssynthetics.CreateCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: canary(),
+			},
+			want: want{
+				cr:  canary(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.synthetics}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ScheduleChanged": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{
+					MockGetCanary: getCanary(awssynthetics.CanaryStateReady),
+					MockUpdateCanary: func(in *awssynthetics.UpdateCanaryInput) awssynthetics.UpdateCanaryRequest {
+						if diff := cmp.Diff("rate(1 hour)", aws.StringValue(in.Schedule.Expression)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssynthetics.UpdateCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssynthetics.UpdateCanaryOutput{}},
+						}
+					},
+				},
+				cr: canary(withSchedule("rate(1 hour)")),
+			},
+		},
+		"Start": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{
+					MockGetCanary: getCanary(awssynthetics.CanaryStateReady),
+					MockStartCanary: func(*awssynthetics.StartCanaryInput) awssynthetics.StartCanaryRequest {
+						return awssynthetics.StartCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssynthetics.StartCanaryOutput{}},
+						}
+					},
+				},
+				cr: canary(),
+			},
+		},
+		"Stop": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{
+					MockGetCanary: getCanary(awssynthetics.CanaryStateRunning),
+					MockStopCanary: func(*awssynthetics.StopCanaryInput) awssynthetics.StopCanaryRequest {
+						return awssynthetics.StopCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: canary(func(r *v1alpha1.Canary) { r.Spec.ForProvider.StartCanary = aws.Bool(false) }),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errStop),
+			},
+		},
+		"ClientError": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{
+					MockGetCanary: getCanary(awssynthetics.CanaryStateRunning),
+					MockUpdateCanary: func(*awssynthetics.UpdateCanaryInput) awssynthetics.UpdateCanaryRequest {
+						return awssynthetics.UpdateCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: canary(withSchedule("rate(1 hour)")),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.synthetics}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteCanary := func(err error) func(*awssynthetics.DeleteCanaryInput) awssynthetics.DeleteCanaryRequest {
+		return func(*awssynthetics.DeleteCanaryInput) awssynthetics.DeleteCanaryRequest {
+			return awssynthetics.DeleteCanaryRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssynthetics.DeleteCanaryOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{MockDeleteCanary: deleteCanary(nil)},
+				cr:         canary(withState(awssynthetics.CanaryStateStopped)),
+			},
+			want: want{
+				cr: canary(withState(awssynthetics.CanaryStateStopped), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"StopFirst": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{
+					MockStopCanary: func(*awssynthetics.StopCanaryInput) awssynthetics.StopCanaryRequest {
+						return awssynthetics.StopCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssynthetics.StopCanaryOutput{}},
+						}
+					},
+				},
+				cr: canary(withState(awssynthetics.CanaryStateRunning)),
+			},
+			want: want{
+				cr: canary(withState(awssynthetics.CanaryStateRunning), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Stopping": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{},
+				cr:         canary(withState(awssynthetics.CanaryStateStopping)),
+			},
+			want: want{
+				cr: canary(withState(awssynthetics.CanaryStateStopping), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{MockDeleteCanary: deleteCanary(awserr.New(awssynthetics.ErrCodeResourceNotFoundException, "", nil))},
+				cr:         canary(),
+			},
+			want: want{
+				cr: canary(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				synthetics: &fake.MockCanaryClient{MockDeleteCanary: deleteCanary(errBoom)},
+				cr:         canary(),
+			},
+			want: want{
+				cr:  canary(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.synthetics}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webacl
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awswafv2 "github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/wafv2"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a WebACL resource"
+
+	errList       = "failed to list WebACLs"
+	errGet        = "failed to get WebACL"
+	errCreate     = "failed to create the WebACL resource"
+	errUpdate     = "failed to update the WebACL resource"
+	errDelete     = "failed to delete the WebACL resource"
+	errSpecUpdate = "cannot update spec of WebACL custom resource"
+)
+
+// SetupWebACL adds a controller that reconciles WebACLs.
+func SetupWebACL(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.WebACLGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.WebACL{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.WebACLGroupVersionKind),
+			managed.WithExternalConnecter(awsclients.WithConditionReasons(awsclients.WithCreateGracePeriod(awsclients.WithPartitionGate(&connector{kube: mgr.GetClient(), newClientFn: wafv2.NewWebACLClient}, v1alpha1.Group)))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewProviderConfigDefaulter(mgr.GetClient(), mgr.GetScheme()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) wafv2.WebACLClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.WebACL)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client wafv2.WebACLClient
+}
+
+// getWebACL looks up the ID of the WebACL with the external name of the
+// given resource and returns its latest state together with the lock token
+// that is required to modify it. It returns a nil output if no such WebACL
+// exists.
+func (e *external) getWebACL(ctx context.Context, cr *v1alpha1.WebACL) (*awswafv2.GetWebACLOutput, error) {
+	name := meta.GetExternalName(cr)
+	scope := awswafv2.Scope(cr.Spec.ForProvider.Scope)
+	input := &awswafv2.ListWebACLsInput{Scope: scope}
+	for {
+		res, err := e.client.ListWebACLsRequest(input).Send(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, errList)
+		}
+		for _, s := range res.WebACLs {
+			if aws.StringValue(s.Name) != name {
+				continue
+			}
+			out, err := e.client.GetWebACLRequest(&awswafv2.GetWebACLInput{
+				Id:    s.Id,
+				Name:  s.Name,
+				Scope: scope,
+			}).Send(ctx)
+			if wafv2.IsNotFound(err) {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, errors.Wrap(err, errGet)
+			}
+			return out.GetWebACLOutput, nil
+		}
+		if aws.StringValue(res.NextMarker) == "" {
+			return nil, nil
+		}
+		input.NextMarker = res.NextMarker
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.WebACL)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	out, err := e.getWebACL(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if out == nil || out.WebACL == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	wafv2.LateInitializeWebACL(&cr.Spec.ForProvider, out.WebACL)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+	cr.Status.AtProvider = wafv2.GenerateWebACLObservation(*out.WebACL)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: wafv2.IsWebACLUpToDate(cr.Spec.ForProvider, *out.WebACL),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.WebACL)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateWebACLRequest(wafv2.GenerateCreateWebACLInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.WebACL)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	out, err := e.getWebACL(ctx, cr)
+	if err != nil || out == nil || out.WebACL == nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	_, err = e.client.UpdateWebACLRequest(wafv2.GenerateUpdateWebACLInput(meta.GetExternalName(cr),
+		aws.StringValue(out.WebACL.Id), aws.StringValue(out.LockToken), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.WebACL)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	out, err := e.getWebACL(ctx, cr)
+	if err != nil || out == nil || out.WebACL == nil {
+		return err
+	}
+
+	_, err = e.client.DeleteWebACLRequest(&awswafv2.DeleteWebACLInput{
+		Id:        out.WebACL.Id,
+		LockToken: out.LockToken,
+		Name:      out.WebACL.Name,
+		Scope:     awswafv2.Scope(cr.Spec.ForProvider.Scope),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(wafv2.IsNotFound, err), errDelete)
+}
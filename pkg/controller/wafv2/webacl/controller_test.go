@@ -0,0 +1,456 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webacl
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awswafv2 "github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/wafv2"
+	"github.com/crossplane/provider-aws/pkg/clients/wafv2/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	aclName   = "some-acl"
+	aclID     = "some-id"
+	aclARN    = "some-arn"
+	lockToken = "some-token"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	waf wafv2.WebACLClient
+	cr  resource.Managed
+}
+
+type webACLModifier func(*v1alpha1.WebACL)
+
+func withConditions(c ...runtimev1alpha1.Condition) webACLModifier {
+	return func(r *v1alpha1.WebACL) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(name string) webACLModifier {
+	return func(r *v1alpha1.WebACL) { meta.SetExternalName(r, name) }
+}
+
+func withDefaultAction(a string) webACLModifier {
+	return func(r *v1alpha1.WebACL) { r.Spec.ForProvider.DefaultAction = a }
+}
+
+func withStatus(id, arn string) webACLModifier {
+	return func(r *v1alpha1.WebACL) {
+		r.Status.AtProvider = v1alpha1.WebACLObservation{ID: id, ARN: arn}
+	}
+}
+
+func webACL(m ...webACLModifier) *v1alpha1.WebACL {
+	cr := &v1alpha1.WebACL{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listOutput(names ...string) func(*awswafv2.ListWebACLsInput) awswafv2.ListWebACLsRequest {
+	return func(*awswafv2.ListWebACLsInput) awswafv2.ListWebACLsRequest {
+		out := &awswafv2.ListWebACLsOutput{}
+		for _, n := range names {
+			out.WebACLs = append(out.WebACLs, awswafv2.WebACLSummary{Name: aws.String(n), Id: aws.String(aclID)})
+		}
+		return awswafv2.ListWebACLsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func getOutput(defaultAction *awswafv2.DefaultAction) func(*awswafv2.GetWebACLInput) awswafv2.GetWebACLRequest {
+	return func(*awswafv2.GetWebACLInput) awswafv2.GetWebACLRequest {
+		return awswafv2.GetWebACLRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.GetWebACLOutput{
+				LockToken: aws.String(lockToken),
+				WebACL: &awswafv2.WebACL{
+					ARN:              aws.String(aclARN),
+					Id:               aws.String(aclID),
+					Name:             aws.String(aclName),
+					DefaultAction:    defaultAction,
+					VisibilityConfig: &awswafv2.VisibilityConfig{MetricName: aws.String("")},
+				},
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				waf: &fake.MockWebACLClient{
+					MockList: listOutput(aclName),
+					MockGet:  getOutput(&awswafv2.DefaultAction{Allow: &awswafv2.AllowAction{}}),
+				},
+				cr: webACL(withExternalName(aclName), withDefaultAction(v1alpha1.ActionAllow)),
+			},
+			want: want{
+				cr: webACL(withExternalName(aclName), withDefaultAction(v1alpha1.ActionAllow),
+					withStatus(aclID, aclARN),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				waf: &fake.MockWebACLClient{
+					MockList: listOutput(aclName),
+					MockGet:  getOutput(&awswafv2.DefaultAction{Block: &awswafv2.BlockAction{}}),
+				},
+				cr: webACL(withExternalName(aclName), withDefaultAction(v1alpha1.ActionAllow)),
+			},
+			want: want{
+				cr: webACL(withExternalName(aclName), withDefaultAction(v1alpha1.ActionAllow),
+					withStatus(aclID, aclARN),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				waf: &fake.MockWebACLClient{
+					MockList: listOutput("other-acl"),
+				},
+				cr: webACL(withExternalName(aclName)),
+			},
+			want: want{
+				cr: webACL(withExternalName(aclName)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ListError": {
+			args: args{
+				waf: &fake.MockWebACLClient{
+					MockList: func(*awswafv2.ListWebACLsInput) awswafv2.ListWebACLsRequest {
+						return awswafv2.ListWebACLsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: webACL(withExternalName(aclName)),
+			},
+			want: want{
+				cr:  webACL(withExternalName(aclName)),
+				err: errors.Wrap(errBoom, errList),
+			},
+		},
+		"GetError": {
+			args: args{
+				waf: &fake.MockWebACLClient{
+					MockList: listOutput(aclName),
+					MockGet: func(*awswafv2.GetWebACLInput) awswafv2.GetWebACLRequest {
+						return awswafv2.GetWebACLRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: webACL(withExternalName(aclName)),
+			},
+			want: want{
+				cr:  webACL(withExternalName(aclName)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.waf, kube: &test.MockClient{MockUpdate: test.NewMockClient().MockUpdate}}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				waf: &fake.MockWebACLClient{
+					MockCreate: func(*awswafv2.CreateWebACLInput) awswafv2.CreateWebACLRequest {
+						return awswafv2.CreateWebACLRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.CreateWebACLOutput{}},
+						}
+					},
+				},
+				cr: webACL(withExternalName(aclName)),
+			},
+			want: want{
+				cr: webACL(withExternalName(aclName), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				waf: &fake.MockWebACLClient{
+					MockCreate: func(*awswafv2.CreateWebACLInput) awswafv2.CreateWebACLRequest {
+						return awswafv2.CreateWebACLRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: webACL(withExternalName(aclName)),
+			},
+			want: want{
+				cr:  webACL(withExternalName(aclName), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.waf}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				waf: &fake.MockWebACLClient{
+					MockList: listOutput(aclName),
+					MockGet:  getOutput(&awswafv2.DefaultAction{Allow: &awswafv2.AllowAction{}}),
+					MockUpdate: func(input *awswafv2.UpdateWebACLInput) awswafv2.UpdateWebACLRequest {
+						if aws.StringValue(input.LockToken) != lockToken {
+							return awswafv2.UpdateWebACLRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+							}
+						}
+						return awswafv2.UpdateWebACLRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.UpdateWebACLOutput{}},
+						}
+					},
+				},
+				cr: webACL(withExternalName(aclName)),
+			},
+			want: want{
+				cr: webACL(withExternalName(aclName)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				waf: &fake.MockWebACLClient{
+					MockList: listOutput(aclName),
+					MockGet:  getOutput(&awswafv2.DefaultAction{Allow: &awswafv2.AllowAction{}}),
+					MockUpdate: func(*awswafv2.UpdateWebACLInput) awswafv2.UpdateWebACLRequest {
+						return awswafv2.UpdateWebACLRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: webACL(withExternalName(aclName)),
+			},
+			want: want{
+				cr:  webACL(withExternalName(aclName)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.waf}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				waf: &fake.MockWebACLClient{
+					MockList: listOutput(aclName),
+					MockGet:  getOutput(&awswafv2.DefaultAction{Allow: &awswafv2.AllowAction{}}),
+					MockDelete: func(*awswafv2.DeleteWebACLInput) awswafv2.DeleteWebACLRequest {
+						return awswafv2.DeleteWebACLRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.DeleteWebACLOutput{}},
+						}
+					},
+				},
+				cr: webACL(withExternalName(aclName)),
+			},
+			want: want{
+				cr: webACL(withExternalName(aclName), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				waf: &fake.MockWebACLClient{
+					MockList: listOutput(),
+				},
+				cr: webACL(withExternalName(aclName)),
+			},
+			want: want{
+				cr: webACL(withExternalName(aclName), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				waf: &fake.MockWebACLClient{
+					MockList: listOutput(aclName),
+					MockGet:  getOutput(&awswafv2.DefaultAction{Allow: &awswafv2.AllowAction{}}),
+					MockDelete: func(*awswafv2.DeleteWebACLInput) awswafv2.DeleteWebACLRequest {
+						return awswafv2.DeleteWebACLRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: webACL(withExternalName(aclName)),
+			},
+			want: want{
+				cr:  webACL(withExternalName(aclName), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.waf}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webaclassociation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awswafv2 "github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/wafv2"
+	"github.com/crossplane/provider-aws/pkg/clients/wafv2/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	resourceARN = "some-resource-arn"
+	webACLARN   = "some-acl-arn"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	waf wafv2.WebACLAssociationClient
+	cr  resource.Managed
+}
+
+type associationModifier func(*v1alpha1.WebACLAssociation)
+
+func withConditions(c ...runtimev1alpha1.Condition) associationModifier {
+	return func(r *v1alpha1.WebACLAssociation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(resourceARN, webACLARN string) associationModifier {
+	return func(r *v1alpha1.WebACLAssociation) {
+		r.Spec.ForProvider.ResourceARN = resourceARN
+		r.Spec.ForProvider.WebACLARN = webACLARN
+	}
+}
+
+func association(m ...associationModifier) *v1alpha1.WebACLAssociation {
+	cr := &v1alpha1.WebACLAssociation{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getOutput(arn *string) func(*awswafv2.GetWebACLForResourceInput) awswafv2.GetWebACLForResourceRequest {
+	return func(*awswafv2.GetWebACLForResourceInput) awswafv2.GetWebACLForResourceRequest {
+		out := &awswafv2.GetWebACLForResourceOutput{}
+		if arn != nil {
+			out.WebACL = &awswafv2.WebACL{ARN: arn}
+		}
+		return awswafv2.GetWebACLForResourceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				waf: &fake.MockWebACLAssociationClient{
+					MockGetForResource: getOutput(aws.String(webACLARN)),
+				},
+				cr: association(withSpec(resourceARN, webACLARN)),
+			},
+			want: want{
+				cr: association(withSpec(resourceARN, webACLARN),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotAssociated": {
+			args: args{
+				waf: &fake.MockWebACLAssociationClient{
+					MockGetForResource: getOutput(nil),
+				},
+				cr: association(withSpec(resourceARN, webACLARN)),
+			},
+			want: want{
+				cr: association(withSpec(resourceARN, webACLARN)),
+			},
+		},
+		"AssociatedWithAnotherWebACL": {
+			args: args{
+				waf: &fake.MockWebACLAssociationClient{
+					MockGetForResource: getOutput(aws.String("other-arn")),
+				},
+				cr: association(withSpec(resourceARN, webACLARN)),
+			},
+			want: want{
+				cr: association(withSpec(resourceARN, webACLARN)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				waf: &fake.MockWebACLAssociationClient{
+					MockGetForResource: func(*awswafv2.GetWebACLForResourceInput) awswafv2.GetWebACLForResourceRequest {
+						return awswafv2.GetWebACLForResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: association(withSpec(resourceARN, webACLARN)),
+			},
+			want: want{
+				cr:  association(withSpec(resourceARN, webACLARN)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.waf}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				waf: &fake.MockWebACLAssociationClient{
+					MockAssociate: func(*awswafv2.AssociateWebACLInput) awswafv2.AssociateWebACLRequest {
+						return awswafv2.AssociateWebACLRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.AssociateWebACLOutput{}},
+						}
+					},
+				},
+				cr: association(withSpec(resourceARN, webACLARN)),
+			},
+			want: want{
+				cr: association(withSpec(resourceARN, webACLARN), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				waf: &fake.MockWebACLAssociationClient{
+					MockAssociate: func(*awswafv2.AssociateWebACLInput) awswafv2.AssociateWebACLRequest {
+						return awswafv2.AssociateWebACLRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: association(withSpec(resourceARN, webACLARN)),
+			},
+			want: want{
+				cr:  association(withSpec(resourceARN, webACLARN), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errAssociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.waf}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				waf: &fake.MockWebACLAssociationClient{
+					MockDisassociate: func(*awswafv2.DisassociateWebACLInput) awswafv2.DisassociateWebACLRequest {
+						return awswafv2.DisassociateWebACLRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awswafv2.DisassociateWebACLOutput{}},
+						}
+					},
+				},
+				cr: association(withSpec(resourceARN, webACLARN)),
+			},
+			want: want{
+				cr: association(withSpec(resourceARN, webACLARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				waf: &fake.MockWebACLAssociationClient{
+					MockDisassociate: func(*awswafv2.DisassociateWebACLInput) awswafv2.DisassociateWebACLRequest {
+						return awswafv2.DisassociateWebACLRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom, Retryer: aws.NoOpRetryer{}},
+						}
+					},
+				},
+				cr: association(withSpec(resourceARN, webACLARN)),
+			},
+			want: want{
+				cr:  association(withSpec(resourceARN, webACLARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDisassociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.waf}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,361 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package group
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsxray "github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/xray/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/xray"
+	"github.com/crossplane/provider-aws/pkg/clients/xray/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	groupName = "checkout"
+	groupARN  = "arn:aws:xray:us-east-1:123456789012:group/checkout/ABCDEF"
+	filter    = `service("checkout")`
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsxray.ErrCodeInvalidRequestException, "Group not found", nil)
+)
+
+type args struct {
+	xray xray.GroupClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type groupModifier func(*v1alpha1.XRayGroup)
+
+func withConditions(c ...runtimev1alpha1.Condition) groupModifier {
+	return func(r *v1alpha1.XRayGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withARN(arn string) groupModifier {
+	return func(r *v1alpha1.XRayGroup) { r.Status.AtProvider.GroupARN = arn }
+}
+
+func withFilter(f *string) groupModifier {
+	return func(r *v1alpha1.XRayGroup) { r.Spec.ForProvider.FilterExpression = f }
+}
+
+func group(m ...groupModifier) *v1alpha1.XRayGroup {
+	cr := &v1alpha1.XRayGroup{
+		Spec: v1alpha1.XRayGroupSpec{
+			ForProvider: v1alpha1.XRayGroupParameters{
+				FilterExpression: aws.String(filter),
+			},
+		},
+	}
+	meta.SetExternalName(cr, groupName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getGroup(*awsxray.GetGroupInput) awsxray.GetGroupRequest {
+	return awsxray.GetGroupRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.GetGroupOutput{
+			Group: &awsxray.Group{
+				GroupName:        aws.String(groupName),
+				GroupARN:         aws.String(groupARN),
+				FilterExpression: aws.String(filter),
+			},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				xray: &fake.MockGroupClient{MockGetGroup: getGroup},
+				cr:   group(),
+			},
+			want: want{
+				cr:     group(withARN(groupARN), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FilterRemoved": {
+			args: args{
+				xray: &fake.MockGroupClient{MockGetGroup: getGroup},
+				cr:   group(withFilter(nil)),
+			},
+			want: want{
+				cr:     group(withFilter(nil), withARN(groupARN), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				xray: &fake.MockGroupClient{
+					MockGetGroup: func(*awsxray.GetGroupInput) awsxray.GetGroupRequest {
+						return awsxray.GetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				xray: &fake.MockGroupClient{
+					MockGetGroup: func(*awsxray.GetGroupInput) awsxray.GetGroupRequest {
+						return awsxray.GetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.xray}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				xray: &fake.MockGroupClient{
+					MockCreateGroup: func(in *awsxray.CreateGroupInput) awsxray.CreateGroupRequest {
+						if diff := cmp.Diff(groupName, aws.StringValue(in.GroupName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsxray.CreateGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.CreateGroupOutput{}},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				xray: &fake.MockGroupClient{
+					MockCreateGroup: func(*awsxray.CreateGroupInput) awsxray.CreateGroupRequest {
+						return awsxray.CreateGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.xray}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RemoveFilter": {
+			args: args{
+				xray: &fake.MockGroupClient{
+					MockUpdateGroup: func(in *awsxray.UpdateGroupInput) awsxray.UpdateGroupRequest {
+						if diff := cmp.Diff(aws.String(""), in.FilterExpression); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsxray.UpdateGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.UpdateGroupOutput{}},
+						}
+					},
+				},
+				cr: group(withFilter(nil)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				xray: &fake.MockGroupClient{
+					MockUpdateGroup: func(*awsxray.UpdateGroupInput) awsxray.UpdateGroupRequest {
+						return awsxray.UpdateGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.xray}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteGroup := func(err error) func(*awsxray.DeleteGroupInput) awsxray.DeleteGroupRequest {
+		return func(*awsxray.DeleteGroupInput) awsxray.DeleteGroupRequest {
+			return awsxray.DeleteGroupRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.DeleteGroupOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				xray: &fake.MockGroupClient{MockDeleteGroup: deleteGroup(nil)},
+				cr:   group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				xray: &fake.MockGroupClient{MockDeleteGroup: deleteGroup(errNotFound)},
+				cr:   group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				xray: &fake.MockGroupClient{MockDeleteGroup: deleteGroup(errBoom)},
+				cr:   group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.xray}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
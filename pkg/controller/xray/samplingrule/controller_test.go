@@ -0,0 +1,411 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package samplingrule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsxray "github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/xray/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/xray"
+	"github.com/crossplane/provider-aws/pkg/clients/xray/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	ruleName  = "checkout"
+	ruleARN   = "arn:aws:xray:us-east-1:123456789012:sampling-rule/checkout"
+	nextToken = "next"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	xray xray.SamplingRuleClient
+	kube *test.MockClient
+	cr   resource.Managed
+}
+
+type ruleModifier func(*v1alpha1.SamplingRule)
+
+func withConditions(c ...runtimev1alpha1.Condition) ruleModifier {
+	return func(r *v1alpha1.SamplingRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withARN(arn string) ruleModifier {
+	return func(r *v1alpha1.SamplingRule) { r.Status.AtProvider.RuleARN = arn }
+}
+
+func withFixedRate(rate string) ruleModifier {
+	return func(r *v1alpha1.SamplingRule) { r.Spec.ForProvider.FixedRate = rate }
+}
+
+func withMatchers() ruleModifier {
+	return func(r *v1alpha1.SamplingRule) {
+		p := &r.Spec.ForProvider
+		p.ServiceType = aws.String("*")
+		p.Host = aws.String("*")
+		p.HTTPMethod = aws.String("*")
+		p.URLPath = aws.String("*")
+		p.ResourceARN = aws.String("*")
+	}
+}
+
+func samplingRule(m ...ruleModifier) *v1alpha1.SamplingRule {
+	cr := &v1alpha1.SamplingRule{
+		Spec: v1alpha1.SamplingRuleSpec{
+			ForProvider: v1alpha1.SamplingRuleParameters{
+				Priority:      100,
+				FixedRate:     "0.05",
+				ReservoirSize: 1,
+				ServiceName:   aws.String("checkout"),
+			},
+		},
+	}
+	meta.SetExternalName(cr, ruleName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func record(name string) awsxray.SamplingRuleRecord {
+	return awsxray.SamplingRuleRecord{
+		SamplingRule: &awsxray.SamplingRule{
+			RuleName:      aws.String(name),
+			RuleARN:       aws.String(ruleARN),
+			Priority:      aws.Int64(100),
+			FixedRate:     aws.Float64(0.05),
+			ReservoirSize: aws.Int64(1),
+			ServiceName:   aws.String("checkout"),
+			ServiceType:   aws.String("*"),
+			Host:          aws.String("*"),
+			HTTPMethod:    aws.String("*"),
+			URLPath:       aws.String("*"),
+			ResourceARN:   aws.String("*"),
+			Version:       aws.Int64(1),
+		},
+	}
+}
+
+func getSamplingRules(in *awsxray.GetSamplingRulesInput) awsxray.GetSamplingRulesRequest {
+	out := &awsxray.GetSamplingRulesOutput{
+		SamplingRuleRecords: []awsxray.SamplingRuleRecord{record("Default")},
+		NextToken:           aws.String(nextToken),
+	}
+	if aws.StringValue(in.NextToken) == nextToken {
+		out = &awsxray.GetSamplingRulesOutput{SamplingRuleRecords: []awsxray.SamplingRuleRecord{record(ruleName)}}
+	}
+	return awsxray.GetSamplingRulesRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDateOnSecondPage": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{MockGetSamplingRules: getSamplingRules},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   samplingRule(),
+			},
+			want: want{
+				cr:     samplingRule(withMatchers(), withARN(ruleARN), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FixedRateChanged": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{MockGetSamplingRules: getSamplingRules},
+				cr:   samplingRule(withMatchers(), withFixedRate("0.5")),
+			},
+			want: want{
+				cr:     samplingRule(withMatchers(), withFixedRate("0.5"), withARN(ruleARN), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"LateInitSpecUpdateFailed": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{MockGetSamplingRules: getSamplingRules},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   samplingRule(),
+			},
+			want: want{
+				cr:  samplingRule(withMatchers()),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+		"NotFound": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockGetSamplingRules: func(*awsxray.GetSamplingRulesInput) awsxray.GetSamplingRulesRequest {
+						return awsxray.GetSamplingRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.GetSamplingRulesOutput{
+								SamplingRuleRecords: []awsxray.SamplingRuleRecord{record("Default")},
+							}},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr: samplingRule(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockGetSamplingRules: func(*awsxray.GetSamplingRulesInput) awsxray.GetSamplingRulesRequest {
+						return awsxray.GetSamplingRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr:  samplingRule(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.xray}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockCreateSamplingRule: func(in *awsxray.CreateSamplingRuleInput) awsxray.CreateSamplingRuleRequest {
+						if diff := cmp.Diff(ruleName, aws.StringValue(in.SamplingRule.RuleName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(0.05, aws.Float64Value(in.SamplingRule.FixedRate)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsxray.CreateSamplingRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.CreateSamplingRuleOutput{}},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr: samplingRule(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockCreateSamplingRule: func(*awsxray.CreateSamplingRuleInput) awsxray.CreateSamplingRuleRequest {
+						return awsxray.CreateSamplingRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr:  samplingRule(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.xray}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockUpdateSamplingRule: func(in *awsxray.UpdateSamplingRuleInput) awsxray.UpdateSamplingRuleRequest {
+						if diff := cmp.Diff(0.5, aws.Float64Value(in.SamplingRuleUpdate.FixedRate)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsxray.UpdateSamplingRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.UpdateSamplingRuleOutput{}},
+						}
+					},
+				},
+				cr: samplingRule(withFixedRate("0.5")),
+			},
+		},
+		"ClientError": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockUpdateSamplingRule: func(*awsxray.UpdateSamplingRuleInput) awsxray.UpdateSamplingRuleRequest {
+						return awsxray.UpdateSamplingRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.xray}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	deleteSamplingRule := func(err error) func(*awsxray.DeleteSamplingRuleInput) awsxray.DeleteSamplingRuleRequest {
+		return func(*awsxray.DeleteSamplingRuleInput) awsxray.DeleteSamplingRuleRequest {
+			return awsxray.DeleteSamplingRuleRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.DeleteSamplingRuleOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{MockDeleteSamplingRule: deleteSamplingRule(nil)},
+				cr:   samplingRule(),
+			},
+			want: want{
+				cr: samplingRule(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{MockDeleteSamplingRule: deleteSamplingRule(awserr.New(awsxray.ErrCodeInvalidRequestException, "Sampling rule not found", nil))},
+				cr:   samplingRule(),
+			},
+			want: want{
+				cr: samplingRule(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{MockDeleteSamplingRule: deleteSamplingRule(errBoom)},
+				cr:   samplingRule(),
+			},
+			want: want{
+				cr:  samplingRule(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.xray}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package examples generates example managed resources from the OpenAPI
+// schemas of their CustomResourceDefinitions.
+package examples
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"sigs.k8s.io/yaml"
+)
+
+// Header is prepended to every generated example.
+const Header = "# Code generated by go generate. DO NOT EDIT.\n" +
+	"# Only the required fields of the kind are set.\n"
+
+const (
+	categoryManaged = "managed"
+
+	// exampleName is the name of the generated resources and the value of
+	// their string fields.
+	exampleName   = "example"
+	exampleRegion = "us-east-1"
+
+	errReadCRD       = "cannot read CustomResourceDefinition"
+	errParseCRD      = "cannot parse CustomResourceDefinition"
+	errNoSchema      = "CustomResourceDefinition has no OpenAPI schema"
+	errMarshal       = "cannot marshal example"
+	errWriteExample  = "cannot write example"
+	errListCRDs      = "cannot list CustomResourceDefinitions"
+	errGenerate      = "cannot generate example"
+	errCreateOutDir  = "cannot create output directory"
+	errRemoveExample = "cannot remove stale example"
+)
+
+// LoadCRDs returns the CustomResourceDefinitions in the YAML files of the
+// given directory.
+func LoadCRDs(dir string) ([]*v1beta1.CustomResourceDefinition, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, errors.Wrap(err, errListCRDs)
+	}
+	sort.Strings(files)
+	crds := make([]*v1beta1.CustomResourceDefinition, 0, len(files))
+	for _, f := range files {
+		b, err := ioutil.ReadFile(filepath.Clean(f))
+		if err != nil {
+			return nil, errors.Wrap(err, errReadCRD)
+		}
+		crd := &v1beta1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(b, crd); err != nil {
+			return nil, errors.Wrapf(err, "%s: %s", errParseCRD, f)
+		}
+		crds = append(crds, crd)
+	}
+	return crds, nil
+}
+
+// IsManaged returns whether the given CustomResourceDefinition defines a
+// managed resource.
+func IsManaged(crd *v1beta1.CustomResourceDefinition) bool {
+	for _, c := range crd.Spec.Names.Categories {
+		if c == categoryManaged {
+			return true
+		}
+	}
+	return false
+}
+
+// Schema returns the OpenAPI schema and the name of the storage version of
+// the given CustomResourceDefinition.
+func Schema(crd *v1beta1.CustomResourceDefinition) (*v1beta1.JSONSchemaProps, string, error) {
+	version := crd.Spec.Version
+	schema := crd.Spec.Validation
+	for _, v := range crd.Spec.Versions {
+		if !v.Storage {
+			continue
+		}
+		version = v.Name
+		if v.Schema != nil {
+			schema = v.Schema
+		}
+	}
+	if schema == nil || schema.OpenAPIV3Schema == nil {
+		return nil, "", errors.New(errNoSchema)
+	}
+	return schema.OpenAPIV3Schema, version, nil
+}
+
+// Generate returns an example of the custom resource defined by the given
+// CustomResourceDefinition. Only the required fields of its spec are set,
+// using the first allowed value of enums, the minimum of numbers and a
+// placeholder for strings, and the resource references the ProviderConfig
+// named example.
+func Generate(crd *v1beta1.CustomResourceDefinition) (map[string]interface{}, error) {
+	schema, version, err := Schema(crd)
+	if err != nil {
+		return nil, err
+	}
+	spec := map[string]interface{}{}
+	if s, ok := schema.Properties["spec"]; ok {
+		spec, _ = value("spec", &s).(map[string]interface{})
+	}
+	if _, ok := schema.Properties["spec"].Properties["providerConfigRef"]; ok {
+		spec["providerConfigRef"] = map[string]interface{}{"name": exampleName}
+	}
+	return map[string]interface{}{
+		"apiVersion": crd.Spec.Group + "/" + version,
+		"kind":       crd.Spec.Names.Kind,
+		"metadata":   map[string]interface{}{"name": exampleName},
+		"spec":       spec,
+	}, nil
+}
+
+// value returns a value of the field with the given name that satisfies the
+// given schema. Only the required properties of objects are set.
+func value(name string, s *v1beta1.JSONSchemaProps) interface{} {
+	if len(s.Enum) > 0 {
+		var v interface{}
+		_ = json.Unmarshal(s.Enum[0].Raw, &v)
+		return v
+	}
+	switch s.Type {
+	case "string":
+		if name == "region" {
+			return exampleRegion
+		}
+		v := exampleName
+		if s.MaxLength != nil && int64(len(v)) > *s.MaxLength {
+			v = v[:*s.MaxLength]
+		}
+		return v
+	case "integer", "number":
+		v := int64(1)
+		if s.Minimum != nil && int64(*s.Minimum) > v {
+			v = int64(*s.Minimum)
+		}
+		if s.Maximum != nil && int64(*s.Maximum) < v {
+			v = int64(*s.Maximum)
+		}
+		return v
+	case "boolean":
+		return false
+	case "array":
+		items := []interface{}{}
+		if s.Items != nil && s.Items.Schema != nil {
+			n := int64(1)
+			if s.MinItems != nil && *s.MinItems > n {
+				n = *s.MinItems
+			}
+			for i := int64(0); i < n; i++ {
+				items = append(items, value(name, s.Items.Schema))
+			}
+		}
+		return items
+	default:
+		obj := map[string]interface{}{}
+		for _, r := range s.Required {
+			p, ok := s.Properties[r]
+			if !ok {
+				continue
+			}
+			obj[r] = value(r, &p)
+		}
+		return obj
+	}
+}
+
+// Path returns the path of the example of the given CustomResourceDefinition
+// relative to the output directory, i.e. <group>/<kind>.yaml where group is
+// the first label of the API group.
+func Path(crd *v1beta1.CustomResourceDefinition) string {
+	group := strings.SplitN(crd.Spec.Group, ".", 2)[0]
+	return filepath.Join(group, strings.ToLower(crd.Spec.Names.Kind)+".yaml")
+}
+
+// Render returns the YAML of the given example, with the generated header.
+func Render(example map[string]interface{}) ([]byte, error) {
+	b, err := yaml.Marshal(example)
+	if err != nil {
+		return nil, errors.Wrap(err, errMarshal)
+	}
+	return append([]byte(Header+"---\n"), b...), nil
+}
+
+// Write generates an example of each managed resource defined by the given
+// CustomResourceDefinitions into the given directory. Examples of kinds
+// that no longer exist are removed.
+func Write(crds []*v1beta1.CustomResourceDefinition, dir string) error {
+	want := map[string]bool{}
+	for _, crd := range crds {
+		if !IsManaged(crd) {
+			continue
+		}
+		ex, err := Generate(crd)
+		if err != nil {
+			return errors.Wrapf(err, "%s: %s", errGenerate, crd.GetName())
+		}
+		b, err := Render(ex)
+		if err != nil {
+			return err
+		}
+		p := filepath.Join(dir, Path(crd))
+		want[p] = true
+		if err := os.MkdirAll(filepath.Dir(p), 0750); err != nil {
+			return errors.Wrap(err, errCreateOutDir)
+		}
+		if err := ioutil.WriteFile(p, b, 0600); err != nil {
+			return errors.Wrap(err, errWriteExample)
+		}
+	}
+	stale, err := filepath.Glob(filepath.Join(dir, "*", "*.yaml"))
+	if err != nil {
+		return errors.Wrap(err, errListCRDs)
+	}
+	for _, f := range stale {
+		if want[f] {
+			continue
+		}
+		if err := os.Remove(f); err != nil {
+			return errors.Wrap(err, errRemoveExample)
+		}
+	}
+	return nil
+}
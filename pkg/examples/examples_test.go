@@ -0,0 +1,177 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package examples
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	crdDir      = "../../package/crds"
+	examplesDir = "../../examples/generated"
+)
+
+func int64Ptr(i int64) *int64 { return &i }
+
+func float64Ptr(f float64) *float64 { return &f }
+
+func TestExamples(t *testing.T) {
+	crds, err := LoadCRDs(crdDir)
+	if err != nil {
+		t.Fatalf("LoadCRDs(...): %s", err)
+	}
+	managed := 0
+	for _, crd := range crds {
+		if !IsManaged(crd) {
+			continue
+		}
+		managed++
+		t.Run(crd.GetName(), func(t *testing.T) {
+			ex, err := Generate(crd)
+			if err != nil {
+				t.Fatalf("Generate(...): %s", err)
+			}
+			b, err := Render(ex)
+			if err != nil {
+				t.Fatalf("Render(...): %s", err)
+			}
+
+			// The example must be valid once it is read back.
+			obj := map[string]interface{}{}
+			if err := yaml.Unmarshal(b, &obj); err != nil {
+				t.Fatalf("cannot parse example: %s", err)
+			}
+			schema, _, err := Schema(crd)
+			if err != nil {
+				t.Fatalf("Schema(...): %s", err)
+			}
+			spec := schema.Properties["spec"]
+			if errs := Validate(&spec, obj["spec"], field.NewPath("spec")); len(errs) != 0 {
+				t.Errorf("example is not valid: %s", errs.ToAggregate())
+			}
+
+			// The checked in example must be up to date.
+			current, err := ioutil.ReadFile(filepath.Join(examplesDir, Path(crd)))
+			if err != nil {
+				t.Fatalf("cannot read example, run make examples: %s", err)
+			}
+			if diff := cmp.Diff(string(b), string(current)); diff != "" {
+				t.Errorf("example is out of date, run make examples: -want, +got:\n%s", diff)
+			}
+		})
+	}
+	if managed == 0 {
+		t.Errorf("no managed resource CustomResourceDefinitions in %s", crdDir)
+	}
+}
+
+// TestStructural ensures that the API server prunes and rejects the unknown
+// fields of every CustomResourceDefinition, which requires a structural
+// schema.
+func TestStructural(t *testing.T) {
+	crds, err := LoadCRDs(crdDir)
+	if err != nil {
+		t.Fatalf("LoadCRDs(...): %s", err)
+	}
+	for _, crd := range crds {
+		t.Run(crd.GetName(), func(t *testing.T) {
+			if crd.Spec.PreserveUnknownFields == nil || *crd.Spec.PreserveUnknownFields {
+				t.Errorf("spec.preserveUnknownFields must be false, run make generate")
+			}
+			if crd.Spec.Validation == nil || crd.Spec.Validation.OpenAPIV3Schema == nil {
+				t.Fatalf("CustomResourceDefinition has no validation schema")
+			}
+			in := &apiextensions.JSONSchemaProps{}
+			if err := v1beta1.Convert_v1beta1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(crd.Spec.Validation.OpenAPIV3Schema, in, nil); err != nil {
+				t.Fatalf("cannot convert schema: %s", err)
+			}
+			s, err := schema.NewStructural(in)
+			if err != nil {
+				t.Fatalf("schema.NewStructural(...): %s", err)
+			}
+			if errs := schema.ValidateStructural(nil, s); len(errs) != 0 {
+				t.Errorf("schema is not structural: %s", errs.ToAggregate())
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	schema := &v1beta1.JSONSchemaProps{
+		Type:     "object",
+		Required: []string{"name", "size"},
+		Properties: map[string]v1beta1.JSONSchemaProps{
+			"name": {Type: "string", MaxLength: int64Ptr(5)},
+			"size": {Type: "integer", Minimum: float64Ptr(1)},
+			"mode": {Type: "string", Enum: []v1beta1.JSON{{Raw: []byte(`"fast"`)}, {Raw: []byte(`"slow"`)}}},
+			"tags": {Type: "array", MinItems: int64Ptr(1), Items: &v1beta1.JSONSchemaPropsOrArray{
+				Schema: &v1beta1.JSONSchemaProps{Type: "string", Pattern: "^[a-z]+$"},
+			}},
+		},
+	}
+
+	cases := map[string]struct {
+		v    string
+		want int
+	}{
+		"Valid": {
+			v: `{"name": "abc", "size": 2, "mode": "slow", "tags": ["a"]}`,
+		},
+		"MissingRequired": {
+			v:    `{"name": "abc"}`,
+			want: 1,
+		},
+		"UnknownField": {
+			v:    `{"name": "abc", "size": 1, "color": "red"}`,
+			want: 1,
+		},
+		"Bounds": {
+			v:    `{"name": "abcdef", "size": 0, "tags": []}`,
+			want: 3,
+		},
+		"EnumAndPattern": {
+			v:    `{"name": "abc", "size": 1, "mode": "medium", "tags": ["A"]}`,
+			want: 2,
+		},
+		"WrongType": {
+			v:    `{"name": 1, "size": "1"}`,
+			want: 2,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := map[string]interface{}{}
+			if err := yaml.Unmarshal([]byte(tc.v), &v); err != nil {
+				t.Fatalf("cannot parse value: %s", err)
+			}
+			errs := Validate(schema, v, field.NewPath("spec"))
+			if diff := cmp.Diff(tc.want, len(errs)); diff != "" {
+				t.Errorf("Validate(...): -want, +got:\n%s\n%s", diff, errs.ToAggregate())
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package examples
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate returns the violations of the given schema by the given value,
+// which must be decoded from JSON or YAML. It checks the subset of OpenAPI
+// that the CustomResourceDefinitions of this provider use, i.e. types,
+// required properties, enums, bounds of numbers, lengths of strings and
+// arrays, and patterns.
+func Validate(s *v1beta1.JSONSchemaProps, v interface{}, path *field.Path) field.ErrorList { // nolint:gocyclo
+	var errs field.ErrorList
+	if len(s.Enum) > 0 && !inEnum(s.Enum, v) {
+		errs = append(errs, field.NotSupported(path, v, enumValues(s.Enum)))
+	}
+	switch s.Type {
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			return append(errs, field.Invalid(path, v, "must be a string"))
+		}
+		if s.MinLength != nil && int64(len(str)) < *s.MinLength {
+			errs = append(errs, field.Invalid(path, v, "is too short"))
+		}
+		if s.MaxLength != nil && int64(len(str)) > *s.MaxLength {
+			errs = append(errs, field.TooLong(path, v, int(*s.MaxLength)))
+		}
+		if s.Pattern != "" {
+			if re, err := regexp.Compile(s.Pattern); err == nil && !re.MatchString(str) {
+				errs = append(errs, field.Invalid(path, v, "must match "+s.Pattern))
+			}
+		}
+	case "integer", "number":
+		n, ok := number(v)
+		if !ok {
+			return append(errs, field.Invalid(path, v, "must be a number"))
+		}
+		if s.Minimum != nil && n < *s.Minimum {
+			errs = append(errs, field.Invalid(path, v, "is less than the minimum"))
+		}
+		if s.Maximum != nil && n > *s.Maximum {
+			errs = append(errs, field.Invalid(path, v, "is greater than the maximum"))
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			errs = append(errs, field.Invalid(path, v, "must be a boolean"))
+		}
+	case "array":
+		items, ok := v.([]interface{})
+		if !ok {
+			return append(errs, field.Invalid(path, v, "must be an array"))
+		}
+		if s.MinItems != nil && int64(len(items)) < *s.MinItems {
+			errs = append(errs, field.Invalid(path, v, "has too few items"))
+		}
+		if s.Items != nil && s.Items.Schema != nil {
+			for i, item := range items {
+				errs = append(errs, Validate(s.Items.Schema, item, path.Index(i))...)
+			}
+		}
+	case "object":
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return append(errs, field.Invalid(path, v, "must be an object"))
+		}
+		for _, r := range s.Required {
+			if _, ok := obj[r]; !ok {
+				errs = append(errs, field.Required(path.Child(r), ""))
+			}
+		}
+		for k, val := range obj {
+			p, ok := s.Properties[k]
+			if !ok {
+				if len(s.Properties) > 0 && s.AdditionalProperties == nil && !preservesUnknownFields(s) {
+					errs = append(errs, field.NotFound(path.Child(k), val))
+				}
+				continue
+			}
+			errs = append(errs, Validate(&p, val, path.Child(k))...)
+		}
+	}
+	return errs
+}
+
+func preservesUnknownFields(s *v1beta1.JSONSchemaProps) bool {
+	return s.XPreserveUnknownFields != nil && *s.XPreserveUnknownFields
+}
+
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+func inEnum(enum []v1beta1.JSON, v interface{}) bool {
+	for _, e := range enum {
+		var ev interface{}
+		if err := json.Unmarshal(e.Raw, &ev); err != nil {
+			continue
+		}
+		if reflect.DeepEqual(ev, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func enumValues(enum []v1beta1.JSON) []string {
+	res := make([]string, len(enum))
+	for i, e := range enum {
+		res[i] = string(e.Raw)
+	}
+	return res
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	databasev1beta1 "github.com/crossplane/provider-aws/apis/database/v1beta1"
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// Groups of the managed resources of other AWS providers whose kinds can be
+// converted. The providers generated from the Terraform AWS provider share
+// the layout of their fields.
+const (
+	upboundRDSGroup = "rds.aws.upbound.io"
+	upboundS3Group  = "s3.aws.upbound.io"
+	upboundEC2Group = "ec2.aws.upbound.io"
+	jetRDSGroup     = "rds.aws.jet.crossplane.io"
+	jetS3Group      = "s3.aws.jet.crossplane.io"
+	jetEC2Group     = "ec2.aws.jet.crossplane.io"
+)
+
+var rdsInstanceFields = []FieldMapping{
+	Field("region", "region"),
+	Field("instanceClass", "dbInstanceClass"),
+	Field("engine", "engine"),
+	Field("engineVersion", "engineVersion"),
+	Field("allocatedStorage", "allocatedStorage"),
+	Field("storageType", "storageType"),
+	Field("iops", "iops"),
+	Field("storageEncrypted", "storageEncrypted"),
+	Field("kmsKeyId", "kmsKeyId"),
+	Field("username", "masterUsername"),
+	Field("dbName", "dbName"),
+	Field("name", "dbName"),
+	Field("port", "port"),
+	Field("multiAz", "multiAZ"),
+	Field("availabilityZone", "availabilityZone"),
+	Field("publiclyAccessible", "publiclyAccessible"),
+	Field("dbSubnetGroupName", "dbSubnetGroupName"),
+	Field("vpcSecurityGroupIds", "vpcSecurityGroupIds"),
+	Field("parameterGroupName", "dbParameterGroupName"),
+	Field("optionGroupName", "optionGroupName"),
+	Field("licenseModel", "licenseModel"),
+	Field("characterSetName", "characterSetName"),
+	Field("timezone", "timezone"),
+	Field("caCertIdentifier", "caCertificateIdentifier"),
+	Field("autoMinorVersionUpgrade", "autoMinorVersionUpgrade"),
+	Field("backupRetentionPeriod", "backupRetentionPeriod"),
+	Field("backupWindow", "preferredBackupWindow"),
+	Field("maintenanceWindow", "preferredMaintenanceWindow"),
+	Field("copyTagsToSnapshot", "copyTagsToSnapshot"),
+	Field("deletionProtection", "deletionProtection"),
+	Field("skipFinalSnapshot", "skipFinalSnapshotBeforeDeletion"),
+	Field("finalSnapshotIdentifier", "finalDBSnapshotIdentifier"),
+	Field("monitoringInterval", "monitoringInterval"),
+	Field("enabledCloudwatchLogsExports", "enableCloudwatchLogsExports"),
+	Field("performanceInsightsEnabled", "enablePerformanceInsights"),
+	Field("iamDatabaseAuthenticationEnabled", "enableIAMDatabaseAuthentication"),
+	{From: []string{"tags"}, To: []string{"tags"}, Convert: TagList},
+}
+
+var bucketFields = []FieldMapping{
+	Field("region", "locationConstraint"),
+	Field("acl", "acl"),
+	Field("objectLockEnabled", "objectLockEnabledForBucket"),
+	{From: []string{"tags"}, To: []string{"tagging", "tagSet"}, Convert: TagList},
+}
+
+var vpcFields = []FieldMapping{
+	Field("region", "region"),
+	Field("cidrBlock", "cidrBlock"),
+	Field("enableDnsSupport", "enableDnsSupport"),
+	Field("enableDnsHostnames", "enableDnsHostNames"),
+	Field("instanceTenancy", "instanceTenancy"),
+	{From: []string{"tags"}, To: []string{"tags"}, Convert: TagList},
+}
+
+// Conversions are the supported conversions of managed resources of other
+// AWS providers.
+var Conversions = []Conversion{
+	{From: schema.GroupKind{Group: upboundRDSGroup, Kind: "Instance"}, To: databasev1beta1.RDSInstanceGroupVersionKind, Fields: rdsInstanceFields},
+	{From: schema.GroupKind{Group: jetRDSGroup, Kind: "Instance"}, To: databasev1beta1.RDSInstanceGroupVersionKind, Fields: rdsInstanceFields},
+	{From: schema.GroupKind{Group: upboundS3Group, Kind: "Bucket"}, To: s3v1beta1.BucketGroupVersionKind, Fields: bucketFields},
+	{From: schema.GroupKind{Group: jetS3Group, Kind: "Bucket"}, To: s3v1beta1.BucketGroupVersionKind, Fields: bucketFields},
+	{From: schema.GroupKind{Group: upboundEC2Group, Kind: "VPC"}, To: ec2v1beta1.VPCGroupVersionKind, Fields: vpcFields},
+	{From: schema.GroupKind{Group: jetEC2Group, Kind: "VPC"}, To: ec2v1beta1.VPCGroupVersionKind, Fields: vpcFields},
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migration converts managed resources of other AWS providers to the
+// managed resources of this provider.
+package migration
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+)
+
+const (
+	errFmtUnsupportedKind  = "cannot convert unsupported kind %s"
+	errFmtConvertField     = "cannot convert spec.forProvider.%s"
+	errMissingExternalName = "cannot convert a resource without an external name, it would be recreated"
+)
+
+// A FieldMapping copies the value of a spec.forProvider field of a source
+// resource to a spec.forProvider field of the converted resource.
+type FieldMapping struct {
+	// From is the path of the field in the source resource.
+	From []string
+
+	// To is the path of the field in the converted resource.
+	To []string
+
+	// Convert converts the value of the field, if the layouts of the
+	// fields differ. The value is copied as is if it is nil.
+	Convert func(v interface{}) (interface{}, error)
+}
+
+// Field returns a FieldMapping from the given field to the given field.
+func Field(from, to string) FieldMapping {
+	return FieldMapping{From: []string{from}, To: []string{to}}
+}
+
+// A Conversion converts a kind of another provider to a kind of this
+// provider.
+type Conversion struct {
+	// From is the group kind of the source resources.
+	From schema.GroupKind
+
+	// To is the kind of this provider the source resources are converted to.
+	To schema.GroupVersionKind
+
+	// Fields are the mappings of the spec.forProvider fields. Fields that
+	// are not mapped are reported as ignored.
+	Fields []FieldMapping
+}
+
+// A Result is a converted resource.
+type Result struct {
+	// Object is the converted resource.
+	Object *unstructured.Unstructured
+
+	// Ignored are the spec.forProvider fields of the source resource that
+	// have no counterpart in the converted resource.
+	Ignored []string
+}
+
+// A Converter converts managed resources of other AWS providers to the
+// managed resources of this provider.
+type Converter struct {
+	conversions map[schema.GroupKind]Conversion
+}
+
+// NewConverter returns a Converter with the given conversions.
+func NewConverter(c ...Conversion) *Converter {
+	cv := &Converter{conversions: make(map[schema.GroupKind]Conversion, len(c))}
+	for _, conv := range c {
+		cv.conversions[conv.From] = conv
+	}
+	return cv
+}
+
+// Convert the given managed resource. The name, labels, annotations, and
+// the providerConfigRef, deletionPolicy and writeConnectionSecretToRef of
+// the resource are kept as is. The external name of the resource must be set
+// so that the converted resource adopts the existing external resource
+// instead of creating a new one.
+func (c *Converter) Convert(in *unstructured.Unstructured) (*Result, error) {
+	conv, ok := c.conversions[in.GroupVersionKind().GroupKind()]
+	if !ok {
+		return nil, errors.Errorf(errFmtUnsupportedKind, in.GroupVersionKind().GroupKind())
+	}
+	if meta.GetExternalName(in) == "" {
+		return nil, errors.New(errMissingExternalName)
+	}
+
+	out := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	out.SetGroupVersionKind(conv.To)
+	out.SetName(in.GetName())
+	out.SetLabels(in.GetLabels())
+	out.SetAnnotations(in.GetAnnotations())
+	for _, f := range []string{"providerConfigRef", "deletionPolicy", "writeConnectionSecretToRef"} {
+		if v, ok, _ := unstructured.NestedFieldCopy(in.Object, "spec", f); ok {
+			_ = unstructured.SetNestedField(out.Object, v, "spec", f)
+		}
+	}
+
+	forProvider, _, _ := unstructured.NestedMap(in.Object, "spec", "forProvider")
+	mapped := map[string]bool{}
+	for _, fm := range conv.Fields {
+		mapped[fm.From[0]] = true
+		v, ok, _ := unstructured.NestedFieldCopy(forProvider, fm.From...)
+		if !ok {
+			continue
+		}
+		if fm.Convert != nil {
+			var err error
+			if v, err = fm.Convert(v); err != nil {
+				return nil, errors.Wrapf(err, errFmtConvertField, fm.From[0])
+			}
+		}
+		if err := unstructured.SetNestedField(out.Object, v, append([]string{"spec", "forProvider"}, fm.To...)...); err != nil {
+			return nil, errors.Wrapf(err, errFmtConvertField, fm.From[0])
+		}
+	}
+	if _, ok := out.Object["spec"]; !ok {
+		out.Object["spec"] = map[string]interface{}{}
+	}
+	if _, ok, _ := unstructured.NestedMap(out.Object, "spec", "forProvider"); !ok {
+		_ = unstructured.SetNestedMap(out.Object, map[string]interface{}{}, "spec", "forProvider")
+	}
+
+	res := &Result{Object: out}
+	for f := range forProvider {
+		if !mapped[f] {
+			res.Ignored = append(res.Ignored, f)
+		}
+	}
+	sort.Strings(res.Ignored)
+	return res, nil
+}
+
+// TagList converts a map of tags to a list of key and value pairs, sorted by
+// key.
+func TagList(v interface{}) (interface{}, error) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("tags must be a map")
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	tags := make([]interface{}, len(keys))
+	for i, k := range keys {
+		tags[i] = map[string]interface{}{"key": k, "value": m[k]}
+	}
+	return tags, nil
+}
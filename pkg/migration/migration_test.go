@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestConvert(t *testing.T) {
+	type want struct {
+		res *Result
+		err error
+	}
+
+	cases := map[string]struct {
+		in   map[string]interface{}
+		want want
+	}{
+		"RDSInstance": {
+			in: map[string]interface{}{
+				"apiVersion": "rds.aws.upbound.io/v1beta1",
+				"kind":       "Instance",
+				"metadata": map[string]interface{}{
+					"name":        "db",
+					"labels":      map[string]interface{}{"app": "example"},
+					"annotations": map[string]interface{}{"crossplane.io/external-name": "db-identifier"},
+				},
+				"spec": map[string]interface{}{
+					"deletionPolicy":    "Orphan",
+					"providerConfigRef": map[string]interface{}{"name": "default"},
+					"forProvider": map[string]interface{}{
+						"region":               "us-east-1",
+						"instanceClass":        "db.t3.micro",
+						"engine":               "postgres",
+						"allocatedStorage":     int64(20),
+						"username":             "admin",
+						"skipFinalSnapshot":    true,
+						"autoGeneratePassword": true,
+						"tags":                 map[string]interface{}{"b": "2", "a": "1"},
+					},
+				},
+			},
+			want: want{res: &Result{
+				Object: &unstructured.Unstructured{Object: map[string]interface{}{
+					"apiVersion": "database.aws.crossplane.io/v1beta1",
+					"kind":       "RDSInstance",
+					"metadata": map[string]interface{}{
+						"name":        "db",
+						"labels":      map[string]interface{}{"app": "example"},
+						"annotations": map[string]interface{}{"crossplane.io/external-name": "db-identifier"},
+					},
+					"spec": map[string]interface{}{
+						"deletionPolicy":    "Orphan",
+						"providerConfigRef": map[string]interface{}{"name": "default"},
+						"forProvider": map[string]interface{}{
+							"region":                          "us-east-1",
+							"dbInstanceClass":                 "db.t3.micro",
+							"engine":                          "postgres",
+							"allocatedStorage":                int64(20),
+							"masterUsername":                  "admin",
+							"skipFinalSnapshotBeforeDeletion": true,
+							"tags": []interface{}{
+								map[string]interface{}{"key": "a", "value": "1"},
+								map[string]interface{}{"key": "b", "value": "2"},
+							},
+						},
+					},
+				}},
+				Ignored: []string{"autoGeneratePassword"},
+			}},
+		},
+		"Bucket": {
+			in: map[string]interface{}{
+				"apiVersion": "s3.aws.jet.crossplane.io/v1alpha2",
+				"kind":       "Bucket",
+				"metadata": map[string]interface{}{
+					"name":        "bucket",
+					"annotations": map[string]interface{}{"crossplane.io/external-name": "bucket-1234"},
+				},
+				"spec": map[string]interface{}{
+					"forProvider": map[string]interface{}{
+						"region": "eu-west-1",
+						"tags":   map[string]interface{}{"env": "prod"},
+					},
+				},
+			},
+			want: want{res: &Result{
+				Object: &unstructured.Unstructured{Object: map[string]interface{}{
+					"apiVersion": "s3.aws.crossplane.io/v1beta1",
+					"kind":       "Bucket",
+					"metadata": map[string]interface{}{
+						"name":        "bucket",
+						"annotations": map[string]interface{}{"crossplane.io/external-name": "bucket-1234"},
+					},
+					"spec": map[string]interface{}{
+						"forProvider": map[string]interface{}{
+							"locationConstraint": "eu-west-1",
+							"tagging": map[string]interface{}{
+								"tagSet": []interface{}{
+									map[string]interface{}{"key": "env", "value": "prod"},
+								},
+							},
+						},
+					},
+				}},
+			}},
+		},
+		"MissingExternalName": {
+			in: map[string]interface{}{
+				"apiVersion": "ec2.aws.upbound.io/v1beta1",
+				"kind":       "VPC",
+				"metadata":   map[string]interface{}{"name": "vpc"},
+			},
+			want: want{err: errors.New(errMissingExternalName)},
+		},
+		"UnsupportedKind": {
+			in: map[string]interface{}{
+				"apiVersion": "ec2.aws.upbound.io/v1beta1",
+				"kind":       "Subnet",
+				"metadata":   map[string]interface{}{"name": "subnet"},
+			},
+			want: want{err: errors.Errorf(errFmtUnsupportedKind, "Subnet.ec2.aws.upbound.io")},
+		},
+		"InvalidTags": {
+			in: map[string]interface{}{
+				"apiVersion": "ec2.aws.upbound.io/v1beta1",
+				"kind":       "VPC",
+				"metadata": map[string]interface{}{
+					"name":        "vpc",
+					"annotations": map[string]interface{}{"crossplane.io/external-name": "vpc-1"},
+				},
+				"spec": map[string]interface{}{
+					"forProvider": map[string]interface{}{"tags": "invalid"},
+				},
+			},
+			want: want{err: errors.Wrapf(errors.New("tags must be a map"), errFmtConvertField, "tags")},
+		},
+	}
+
+	c := NewConverter(Conversions...)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			res, err := c.Convert(&unstructured.Unstructured{Object: tc.in})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.res, res); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package modelmarkers keeps the validation markers of API fields in sync
+// with the AWS API models that the AWS SDK is generated from. A field that is
+// annotated with
+//
+//	// +crossplane:aws:model=<service>.<shape>.<member>
+//
+// gets the enum and minimum constraints of the given member of the SDK
+// shape as kubebuilder validation markers, which controller-gen turns into
+// the OpenAPI schema of the CustomResourceDefinition.
+package modelmarkers
+
+import (
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	sdkServicePath = "github.com/aws/aws-sdk-go-v2/service/"
+
+	errFindService = "cannot find the SDK package of the service"
+	errParse       = "cannot parse the SDK package of the service"
+	errInvalidRef  = "model reference must be <service>.<shape>.<member>"
+	errNoShape     = "shape does not exist in the service"
+	errNoMember    = "member does not exist in the shape"
+	errMinTag      = "cannot parse the min tag of the member"
+)
+
+// Kinds of members, which determine the marker of their minimum.
+const (
+	KindString  = "string"
+	KindInteger = "integer"
+	KindList    = "list"
+	KindOther   = "other"
+)
+
+// Constraints are the validation constraints of a member of an SDK shape.
+type Constraints struct {
+	// Kind of the member.
+	Kind string
+
+	// Enum are the allowed values of an enum member, in model order.
+	Enum []string
+
+	// Min is the minimum length of a string, value of an integer or number
+	// of items of a list member, if the model defines one.
+	Min *int64
+}
+
+// A Model resolves model references using the SDK packages of the services.
+type Model struct {
+	services map[string]*service
+}
+
+type service struct {
+	shapes map[string]*ast.StructType
+	enums  map[string][]string
+}
+
+// NewModel returns a Model that loads the SDK packages on first use.
+func NewModel() *Model {
+	return &Model{services: map[string]*service{}}
+}
+
+// Constraints returns the constraints of the member that the given
+// <service>.<shape>.<member> reference points to.
+func (m *Model) Constraints(ref string) (*Constraints, error) {
+	parts := strings.Split(ref, ".")
+	if len(parts) != 3 {
+		return nil, errors.Errorf("%s: %s", errInvalidRef, ref)
+	}
+	svc, err := m.service(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	shape, ok := svc.shapes[parts[1]]
+	if !ok {
+		return nil, errors.Errorf("%s: %s", errNoShape, ref)
+	}
+	for _, f := range shape.Fields.List {
+		for _, n := range f.Names {
+			if n.Name == parts[2] {
+				return svc.constraints(f)
+			}
+		}
+	}
+	return nil, errors.Errorf("%s: %s", errNoMember, ref)
+}
+
+func (m *Model) service(name string) (*service, error) {
+	if s, ok := m.services[name]; ok {
+		return s, nil
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, errors.Wrap(err, errFindService)
+	}
+	pkg, err := build.Default.Import(sdkServicePath+name, wd, build.FindOnly)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s: %s", errFindService, name)
+	}
+	pkgs, err := parser.ParseDir(token.NewFileSet(), pkg.Dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s: %s", errParse, name)
+	}
+	s := &service{shapes: map[string]*ast.StructType{}, enums: map[string][]string{}}
+	for _, p := range pkgs {
+		for _, f := range p.Files {
+			s.add(f)
+		}
+	}
+	m.services[name] = s
+	return s, nil
+}
+
+// add indexes the struct types and the values of the enum types of the
+// given file.
+func (s *service) add(f *ast.File) {
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			switch sp := spec.(type) {
+			case *ast.TypeSpec:
+				if st, ok := sp.Type.(*ast.StructType); ok {
+					s.shapes[sp.Name.Name] = st
+				}
+			case *ast.ValueSpec:
+				typ, ok := sp.Type.(*ast.Ident)
+				if !ok || gd.Tok != token.CONST {
+					continue
+				}
+				for _, v := range sp.Values {
+					lit, ok := v.(*ast.BasicLit)
+					if !ok || lit.Kind != token.STRING {
+						continue
+					}
+					if val, err := strconv.Unquote(lit.Value); err == nil {
+						s.enums[typ.Name] = append(s.enums[typ.Name], val)
+					}
+				}
+			}
+		}
+	}
+}
+
+func (s *service) constraints(f *ast.Field) (*Constraints, error) {
+	tag := reflect.StructTag("")
+	if f.Tag != nil {
+		tag = reflect.StructTag(strings.Trim(f.Tag.Value, "`"))
+	}
+	c := &Constraints{Kind: kind(f.Type)}
+	if tag.Get("enum") == "true" {
+		if id, ok := f.Type.(*ast.Ident); ok {
+			c.Kind = KindString
+			c.Enum = s.enums[id.Name]
+		}
+	}
+	if v, ok := tag.Lookup("min"); ok && c.Kind != KindOther {
+		min, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, errMinTag)
+		}
+		c.Min = &min
+	}
+	return c, nil
+}
+
+func kind(e ast.Expr) string {
+	if st, ok := e.(*ast.StarExpr); ok {
+		e = st.X
+	}
+	switch t := e.(type) {
+	case *ast.ArrayType:
+		return KindList
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return KindString
+		case "int64", "int32", "int":
+			return KindInteger
+		}
+	}
+	return KindOther
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelmarkers
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Marker is the marker that references the model of a field.
+const Marker = "+crossplane:aws:model="
+
+const (
+	markerEnum      = "+kubebuilder:validation:Enum="
+	markerMinLength = "+kubebuilder:validation:MinLength="
+	markerMinimum   = "+kubebuilder:validation:Minimum="
+	markerMinItems  = "+kubebuilder:validation:MinItems="
+
+	errReadFile  = "cannot read file"
+	errWriteFile = "cannot write file"
+)
+
+var modelRE = regexp.MustCompile(`^(\s*)// ` + regexp.QuoteMeta(Marker) + `(\S+)\s*$`)
+
+// A ConstraintsFn returns the constraints of a model reference.
+type ConstraintsFn func(ref string) (*Constraints, error)
+
+// Rewrite returns the given Go source with the validation markers of every
+// field with a model marker set to the constraints of its model. Existing
+// markers are replaced in place and missing ones are added after the model
+// marker. Markers the model has no constraint for are left as they are.
+func Rewrite(src []byte, fn ConstraintsFn) ([]byte, error) {
+	lines := strings.Split(string(src), "\n")
+	for i := 0; i < len(lines); i++ {
+		m := modelRE.FindStringSubmatch(lines[i])
+		if m == nil {
+			continue
+		}
+		indent, ref := m[1], m[2]
+		c, err := fn(ref)
+		if err != nil {
+			return nil, err
+		}
+		first, last := i, i
+		for first > 0 && isComment(lines[first-1]) {
+			first--
+		}
+		for last < len(lines)-1 && isComment(lines[last+1]) {
+			last++
+		}
+		at := i + 1
+		for _, mk := range markers(c) {
+			if j := findMarker(lines[first:last+1], mk[0]); j >= 0 {
+				lines[first+j] = indent + "// " + mk[0] + mk[1]
+				continue
+			}
+			lines = append(lines[:at], append([]string{indent + "// " + mk[0] + mk[1]}, lines[at:]...)...)
+			at++
+			last++
+		}
+		i = last
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// HasMarkers returns true if the given Go source has a model marker.
+func HasMarkers(src []byte) bool {
+	return bytes.Contains(src, []byte(Marker))
+}
+
+// markers returns the validation markers and their values for the given
+// constraints.
+func markers(c *Constraints) [][2]string {
+	var res [][2]string
+	// An enum marker on a list field would constrain the list rather than its
+	// items.
+	if len(c.Enum) > 0 && c.Kind == KindString {
+		res = append(res, [2]string{markerEnum, strings.Join(c.Enum, ";")})
+	}
+	if c.Min == nil {
+		return res
+	}
+	min := strconv.FormatInt(*c.Min, 10)
+	switch c.Kind {
+	case KindString:
+		res = append(res, [2]string{markerMinLength, min})
+	case KindInteger:
+		res = append(res, [2]string{markerMinimum, min})
+	case KindList:
+		res = append(res, [2]string{markerMinItems, min})
+	}
+	return res
+}
+
+func findMarker(block []string, marker string) int {
+	for i, l := range block {
+		if strings.HasPrefix(strings.TrimSpace(l), "// "+marker) {
+			return i
+		}
+	}
+	return -1
+}
+
+func isComment(l string) bool {
+	return strings.HasPrefix(strings.TrimSpace(l), "//")
+}
+
+// RewriteDir rewrites the validation markers of the Go files in the given
+// directory tree that have model markers.
+func RewriteDir(dir string, fn ConstraintsFn) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".go") {
+			return err
+		}
+		src, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return errors.Wrap(err, errReadFile)
+		}
+		if !HasMarkers(src) {
+			return nil
+		}
+		out, err := Rewrite(src, fn)
+		if err != nil {
+			return errors.Wrap(err, path)
+		}
+		if bytes.Equal(src, out) {
+			return nil
+		}
+		return errors.Wrap(ioutil.WriteFile(path, out, info.Mode()), errWriteFile)
+	})
+}
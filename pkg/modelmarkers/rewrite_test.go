@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelmarkers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func int64Ptr(i int64) *int64 { return &i }
+
+func TestRewrite(t *testing.T) {
+	errBoom := errors.New("boom")
+	model := map[string]*Constraints{
+		"svc.Shape.Mode":  {Kind: KindString, Enum: []string{"on", "off"}},
+		"svc.Shape.Name":  {Kind: KindString, Min: int64Ptr(1)},
+		"svc.Shape.Count": {Kind: KindInteger, Min: int64Ptr(0)},
+		"svc.Shape.Items": {Kind: KindList, Enum: []string{"a", "b"}, Min: int64Ptr(1)},
+		"svc.Shape.Other": {Kind: KindOther, Min: int64Ptr(1)},
+	}
+	fn := func(ref string) (*Constraints, error) {
+		if c, ok := model[ref]; ok {
+			return c, nil
+		}
+		return nil, errBoom
+	}
+
+	type want struct {
+		src string
+		err error
+	}
+	cases := map[string]struct {
+		src  string
+		want want
+	}{
+		"NoMarkers": {
+			src:  "type T struct {\n\t// Mode is a mode.\n\tMode string\n}\n",
+			want: want{src: "type T struct {\n\t// Mode is a mode.\n\tMode string\n}\n"},
+		},
+		"ReplaceEnum": {
+			src:  "\t// Mode is a mode.\n\t// +crossplane:aws:model=svc.Shape.Mode\n\t// +kubebuilder:validation:Enum=off\n\t// +optional\n\tMode *string\n",
+			want: want{src: "\t// Mode is a mode.\n\t// +crossplane:aws:model=svc.Shape.Mode\n\t// +kubebuilder:validation:Enum=on;off\n\t// +optional\n\tMode *string\n"},
+		},
+		"ReplaceEnumAboveModelMarker": {
+			src:  "\t// +kubebuilder:validation:Enum=off\n\t// +crossplane:aws:model=svc.Shape.Mode\n\tMode string\n",
+			want: want{src: "\t// +kubebuilder:validation:Enum=on;off\n\t// +crossplane:aws:model=svc.Shape.Mode\n\tMode string\n"},
+		},
+		"InsertEnum": {
+			src:  "\t// +crossplane:aws:model=svc.Shape.Mode\n\t// +optional\n\tMode *string\n",
+			want: want{src: "\t// +crossplane:aws:model=svc.Shape.Mode\n\t// +kubebuilder:validation:Enum=on;off\n\t// +optional\n\tMode *string\n"},
+		},
+		"InsertMinLength": {
+			src:  "\t// +crossplane:aws:model=svc.Shape.Name\n\tName string\n",
+			want: want{src: "\t// +crossplane:aws:model=svc.Shape.Name\n\t// +kubebuilder:validation:MinLength=1\n\tName string\n"},
+		},
+		"ReplaceMinimum": {
+			src:  "\t// +crossplane:aws:model=svc.Shape.Count\n\t// +kubebuilder:validation:Minimum=5\n\tCount *int64\n",
+			want: want{src: "\t// +crossplane:aws:model=svc.Shape.Count\n\t// +kubebuilder:validation:Minimum=0\n\tCount *int64\n"},
+		},
+		"ListSkipsEnum": {
+			src:  "\t// +crossplane:aws:model=svc.Shape.Items\n\tItems []string\n",
+			want: want{src: "\t// +crossplane:aws:model=svc.Shape.Items\n\t// +kubebuilder:validation:MinItems=1\n\tItems []string\n"},
+		},
+		"OtherKindUnchanged": {
+			src:  "\t// +crossplane:aws:model=svc.Shape.Other\n\tOther *Other\n",
+			want: want{src: "\t// +crossplane:aws:model=svc.Shape.Other\n\tOther *Other\n"},
+		},
+		"ModelError": {
+			src:  "\t// +crossplane:aws:model=svc.Shape.Missing\n\tMissing string\n",
+			want: want{err: errBoom},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Rewrite([]byte(tc.src), fn)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.src, string(got)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
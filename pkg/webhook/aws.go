@@ -0,0 +1,44 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	cachev1beta1 "github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	databasev1beta1 "github.com/crossplane/provider-aws/apis/database/v1beta1"
+	s3v1alpha1 "github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+)
+
+// Setup registers the validating admission webhooks of the AWS managed
+// resources that have constraints between their fields with the supplied
+// manager.
+func Setup(mgr ctrl.Manager) error {
+	for _, o := range []runtime.Object{
+		&cachev1beta1.ReplicationGroup{},
+		&databasev1beta1.RDSInstance{},
+		&s3v1alpha1.BucketPolicy{},
+		&sqsv1beta1.Queue{},
+	} {
+		if err := ctrl.NewWebhookManagedBy(mgr).For(o).Complete(); err != nil {
+			return err
+		}
+	}
+	return nil
+}